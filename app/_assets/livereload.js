@@ -0,0 +1,53 @@
+// Injected by the app into its HTML pages when running in debug mode
+// with TemplateDebug enabled. The app pushes a message over a WebSocket
+// every time its templates or assets change. Stylesheets are reloaded
+// in place when they are the only changes, otherwise the whole page is
+// reloaded. The page is also reloaded when the app is restarted.
+(function () {
+    var PATH = '/_gondola_live_reload';
+    var retry = 500;
+    var lost = false;
+
+    function reloadStyles() {
+        var links = document.getElementsByTagName('link');
+        var now = new Date().getTime();
+        for (var ii = 0; ii < links.length; ii++) {
+            var link = links[ii];
+            if (!link.rel || link.rel.toLowerCase() != 'stylesheet' || !link.href) {
+                continue;
+            }
+            var href = link.href.replace(/([?&])_gondola_reload=\d+&?/, '$1').replace(/[?&]$/, '');
+            link.href = href + (href.indexOf('?') >= 0 ? '&' : '?') + '_gondola_reload=' + now;
+        }
+    }
+
+    function connect() {
+        var proto = location.protocol == 'https:' ? 'wss:' : 'ws:';
+        var ws = new WebSocket(proto + '//' + location.host + PATH);
+        ws.onopen = function () {
+            if (lost) {
+                // The app was restarted
+                location.reload(true);
+                return;
+            }
+            retry = 500;
+        };
+        ws.onmessage = function (e) {
+            var msg = JSON.parse(e.data);
+            if (msg.styles) {
+                reloadStyles();
+            } else {
+                location.reload(true);
+            }
+        };
+        ws.onclose = function () {
+            lost = true;
+            setTimeout(connect, retry);
+            retry = Math.min(retry * 2, 5000);
+        };
+    }
+
+    if (window.WebSocket) {
+        connect();
+    }
+})();
@@ -0,0 +1,88 @@
+// Injected by the development server into the pages served by the
+// app. Build errors are shown on top of the current page, which is
+// reloaded once the app restarts or its templates or assets change.
+// Stylesheets are reloaded in place when they are the only changes.
+(function () {
+    var OVERLAY_ID = 'gondola-dev-overlay';
+    var built, started, reloaded;
+    var failed = false;
+
+    function escapeHTML(s) {
+        return String(s).replace(/&/g, '&amp;').replace(/</g, '&lt;').replace(/>/g, '&gt;').replace(/"/g, '&quot;');
+    }
+
+    function showErrors(errors) {
+        var overlay = document.getElementById(OVERLAY_ID);
+        if (!overlay) {
+            overlay = document.createElement('div');
+            overlay.id = OVERLAY_ID;
+            overlay.style.cssText = 'position: fixed; top: 0; left: 0; right: 0; bottom: 0; z-index: 2000000000; ' +
+                'overflow: auto; padding: 20px 30px; background: rgba(0, 0, 0, 0.85); color: #eee; text-align: left; ' +
+                'font: normal 13px/20px Monaco, Monospace;';
+            document.body.appendChild(overlay);
+        }
+        var html = '<h2 style="color: #ff6b6b; font-size: 18px; margin: 0 0 15px 0;">' +
+            errors.length + (errors.length == 1 ? ' error' : ' errors') + ' building the app</h2>';
+        for (var ii = 0; ii < errors.length; ii++) {
+            var e = errors[ii];
+            html += '<div style="margin-bottom: 10px; padding: 10px; background: rgba(255, 255, 255, 0.08);">';
+            if (e.location) {
+                html += '<div style="color: #ffd93d;">' + escapeHTML(e.location) + '</div>';
+            }
+            html += '<div>' + escapeHTML(e.error) + '</div></div>';
+        }
+        html += '<small style="color: #aaa;">The page will be reloaded once the errors are fixed.</small>';
+        overlay.innerHTML = html;
+    }
+
+    function reloadStyles() {
+        var links = document.getElementsByTagName('link');
+        var now = new Date().getTime();
+        for (var ii = 0; ii < links.length; ii++) {
+            var link = links[ii];
+            if (!link.rel || link.rel.toLowerCase() != 'stylesheet' || !link.href) {
+                continue;
+            }
+            var href = link.href.replace(/([?&])_gondola_reload=\d+&?/, '$1').replace(/[?&]$/, '');
+            link.href = href + (href.indexOf('?') >= 0 ? '&' : '?') + '_gondola_reload=' + now;
+        }
+    }
+
+    function update(resp) {
+        if (!resp || resp.building) {
+            return;
+        }
+        if (resp.errors && resp.errors.length) {
+            failed = true;
+            showErrors(resp.errors);
+            return;
+        }
+        if (failed || resp.exited) {
+            location.reload(true);
+            return;
+        }
+        if (built === undefined) {
+            built = resp.built;
+            started = resp.started;
+            reloaded = resp.reloaded;
+            return;
+        }
+        if (resp.built != built || resp.started != started) {
+            location.reload(true);
+            return;
+        }
+        if (resp.reloaded != reloaded) {
+            reloaded = resp.reloaded;
+            if (resp.styles) {
+                reloadStyles();
+            } else {
+                location.reload(true);
+            }
+        }
+    }
+
+    appStatus(update);
+    setInterval(function () {
+        appStatus(update);
+    }, 500);
+})();
@@ -0,0 +1,177 @@
+package app
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// ACL is an IP based access control list. Requests from addresses
+// in its deny list are always rejected, while requests from addresses
+// not in its allow list are rejected only if the allow list is not
+// empty. ACLs can be attached to a path prefix with App.AddACL or to
+// a Handler with HandlerOptions.ACL. Rejected requests receive a 403
+// error, rendered with the error templates (see App.SetErrorTemplate),
+// and they're logged with Warning level.
+//
+// The client address is determined after resolving the X-Real-IP and
+// X-Forwarded-For headers, so if the app runs behind a proxy it must
+// be configured to trust them (see App.SetTrustXHeaders). Otherwise,
+// all the requests will appear as originating from the proxy.
+type ACL struct {
+	allow []*net.IPNet
+	deny  []*net.IPNet
+}
+
+// NewACL returns a new ACL with the given allow and deny lists.
+// Each entry might be either an IP address (e.g. 10.0.0.1) or a
+// network in CIDR notation (e.g. 10.8.0.0/16 or fd00::/8).
+func NewACL(allow []string, deny []string) (*ACL, error) {
+	a, err := parseACLNets(allow)
+	if err != nil {
+		return nil, err
+	}
+	d, err := parseACLNets(deny)
+	if err != nil {
+		return nil, err
+	}
+	return &ACL{allow: a, deny: d}, nil
+}
+
+// MustACL works like NewACL, but panics if there's an error.
+func MustACL(allow []string, deny []string) *ACL {
+	acl, err := NewACL(allow, deny)
+	if err != nil {
+		panic(err)
+	}
+	return acl
+}
+
+func parseACLNets(values []string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if !strings.Contains(v, "/") {
+			ip := net.ParseIP(v)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address %q in ACL", v)
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q in ACL: %s", v, err)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
+
+func aclContains(nets []*net.IPNet, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, v := range nets {
+		if v.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allows returns true iff the ACL allows access from
+// the given IP address.
+func (a *ACL) Allows(ip net.IP) bool {
+	if aclContains(a.deny, ip) {
+		return false
+	}
+	return len(a.allow) == 0 || aclContains(a.allow, ip)
+}
+
+type aclRule struct {
+	prefix string
+	acl    *ACL
+}
+
+// cleanPathPrefix returns the given prefix with a leading
+// slash and without trailing slashes.
+func cleanPathPrefix(prefix string) string {
+	if prefix == "" || prefix[0] != '/' {
+		prefix = "/" + prefix
+	}
+	for len(prefix) > 1 && prefix[len(prefix)-1] == '/' {
+		prefix = prefix[:len(prefix)-1]
+	}
+	return prefix
+}
+
+// hasPathPrefix returns true iff path is prefix or it's
+// inside it. prefix must be cleaned with cleanPathPrefix.
+func hasPathPrefix(path string, prefix string) bool {
+	return prefix == "/" || path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// aclPath returns the path used for matching the ACLs, which
+// is cleaned and lowercased, so requests which only reach a
+// handler after being normalized (see NormalizePolicy) are
+// also matched.
+func aclPath(p string) string {
+	return strings.ToLower(path.Clean("/" + p))
+}
+
+// AddACL restricts the access to the paths under the given prefix
+// (e.g. /admin matches /admin and /admin/users, but not /administrator)
+// with the given ACL. Requests must be allowed by all the ACLs which
+// match their path. Prefixes are matched against the cleaned path
+// without taking case into account, so e.g. /Admin and //admin/ are
+// also restricted by the ACL for /admin. ACLs are checked before
+// running the context processors.
+func (app *App) AddACL(prefix string, acl *ACL) {
+	app.acls = append(app.acls, &aclRule{prefix: strings.ToLower(cleanPathPrefix(prefix)), acl: acl})
+}
+
+// checkACLs returns true if the ACLs added with AddACL allow
+// the request. Otherwise, it sends a 403 error and returns false.
+func (app *App) checkACLs(ctx *Context) bool {
+	if len(app.acls) == 0 || ctx.R == nil {
+		return true
+	}
+	p := aclPath(ctx.R.URL.Path)
+	var ip net.IP
+	for _, v := range app.acls {
+		if !hasPathPrefix(p, v.prefix) {
+			continue
+		}
+		if ip == nil {
+			ip = net.ParseIP(ctx.RemoteAddress())
+		}
+		if !v.acl.Allows(ip) {
+			app.aclDenied(ctx, v.prefix)
+			return false
+		}
+	}
+	return true
+}
+
+// checkHandlerACL returns true if the handler ACL, if any, allows
+// the request. Otherwise, it sends a 403 error and returns false.
+func (app *App) checkHandlerACL(info *handlerInfo, ctx *Context) bool {
+	if info.acl == nil || info.acl.Allows(net.ParseIP(ctx.RemoteAddress())) {
+		return true
+	}
+	app.aclDenied(ctx, info.re.String())
+	return false
+}
+
+func (app *App) aclDenied(ctx *Context, rule string) {
+	ctx.Logger().Warningf("ACL denied %s %s from %s (rule %s, request %s)",
+		ctx.R.Method, ctx.R.URL.Path, ctx.RemoteAddress(), rule, ctx.RequestID())
+	app.handleHTTPError(ctx, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+}
@@ -8,10 +8,11 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httputil"
-	"net/http/pprof"
 	"os"
 	"path"
 	"regexp"
@@ -23,6 +24,7 @@ import (
 
 	"gnd.la/app/cookies"
 	"gnd.la/app/profile"
+	"gnd.la/app/report"
 	"gnd.la/blobstore"
 	"gnd.la/cache"
 	"gnd.la/crypto/cryptoutil"
@@ -31,12 +33,14 @@ import (
 	"gnd.la/internal"
 	"gnd.la/internal/runtimeutil"
 	"gnd.la/internal/templateutil"
+	"gnd.la/loaders"
 	"gnd.la/log"
 	"gnd.la/net/mail"
 	"gnd.la/orm"
 	"gnd.la/signal"
 	"gnd.la/template"
 	"gnd.la/template/assets"
+	"gnd.la/tracing"
 	"gnd.la/util/stringutil"
 
 	"gopkgs.com/vfs.v1"
@@ -95,13 +99,46 @@ type ErrorHandler func(*Context, string, int) bool
 type LanguageHandler func(*Context) string
 
 type handlerInfo struct {
-	host      string
-	name      string
-	path      string
-	pathMatch []int
-	re        *regexp.Regexp
-	rc        *regexpCache
-	handler   Handler
+	host               string
+	name               string
+	path               string
+	pathMatch          []int
+	re                 *regexp.Regexp
+	rc                 *regexpCache
+	handler            Handler
+	included           bool
+	maxRequestBodySize int64
+	maxResponseSize    int64
+	normalize          *NormalizePolicy
+	noAutoHead         bool
+	noAutoOptions      bool
+	methods            []string
+	acl                *ACL
+	cache              *CacheDirectives
+}
+
+// match returns the submatch indexes if the handler matches
+// the given path, or nil if it doesn't.
+func (h *handlerInfo) match(path string) []int {
+	if h.path != "" {
+		if h.path == path {
+			return h.pathMatch
+		}
+		return nil
+	}
+	// Use FindStringSubmatchIndex, since this way we can
+	// reuse the slices used to store context arguments
+	return h.re.FindStringSubmatchIndex(path)
+}
+
+// route returns the name used for identifying the
+// handler in the metrics, its name if it has one
+// or its pattern otherwise.
+func (h *handlerInfo) route() string {
+	if h.name != "" {
+		return h.name
+	}
+	return h.re.String()
 }
 
 type includedApp struct {
@@ -152,6 +189,10 @@ type App struct {
 	ContextProcessors []ContextProcessor
 	ContextFinalizers []ContextFinalizer
 	RecoverHandlers   []RecoverHandler
+	// ErrorReporters receive the panics and the 5xx errors
+	// generated while serving requests. See gnd.la/app/report
+	// for more information and the available reporters.
+	ErrorReporters []report.Reporter
 
 	// Logger to use when logging requests. By default, it's
 	// gnd.la/log.Std, but you can set it to nil to avoid
@@ -182,7 +223,12 @@ type App struct {
 	handlers           []*handlerInfo
 	trustXHeaders      bool
 	appendSlash        bool
+	methodOverride     bool
+	normalizePolicy    NormalizePolicy
 	errorHandler       ErrorHandler
+	limitErrorTemplate string
+	errorTemplates     map[int]string
+	errorPageTemplate  *Template
 	languageHandler    LanguageHandler
 	name               string
 	userFunc           UserFunc
@@ -190,7 +236,10 @@ type App struct {
 	templatesFS        vfs.VFS
 	templatesMutex     sync.RWMutex
 	templatesCache     map[string]*Template
+	templatesWatched   bool
+	liveReload         *liveReloader
 	templateProcessors []TemplateProcessor
+	templateFuncMap    template.FuncMap
 	namespace          *namespace
 	hooks              []*template.Hook
 	started            time.Time
@@ -200,6 +249,13 @@ type App struct {
 	o                  *orm.Orm
 	store              *blobstore.Blobstore
 	prepared           bool
+	errorGroups        report.Groups
+	reports            reportQueue
+	metrics            *routeCounters
+	acls               []*aclRule
+	maintenance        maintenance
+	server             *http.Server
+	bg                 background
 
 	// Used for included apps
 	included  []*includedApp
@@ -228,19 +284,25 @@ func (app *App) HandleOptions(pattern string, handler Handler, opts *HandlerOpti
 		panic(fmt.Errorf("handler for pattern %q can't be nil", pattern))
 	}
 	re := regexp.MustCompile(pattern)
-	var host string
-	var name string
-	if opts != nil {
-		host = opts.Host
-		name = opts.Name
-	}
 	info := &handlerInfo{
-		host:    host,
-		name:    name,
 		re:      re,
 		rc:      newRegexpCache(re),
 		handler: handler,
 	}
+	if opts != nil {
+		info.host = opts.Host
+		info.name = opts.Name
+		info.maxRequestBodySize = opts.MaxRequestBodySize
+		info.maxResponseSize = opts.MaxResponseSize
+		info.normalize = opts.Normalize
+		info.noAutoHead = opts.NoAutoHead
+		info.noAutoOptions = opts.NoAutoOptions
+		info.acl = opts.ACL
+		info.cache = opts.Cache
+		for _, v := range opts.Methods {
+			info.methods = append(info.methods, strings.ToUpper(v))
+		}
+	}
 	if p := literalRegexp(re); p != "" {
 		info.path = p
 		info.pathMatch = []int{0, len(p)}
@@ -293,6 +355,14 @@ func (app *App) AddRecoverHandler(rh RecoverHandler) {
 	app.RecoverHandlers = append(app.RecoverHandlers, rh)
 }
 
+// Include mounts the included app at the given prefix, rendering its
+// templates inside containerTemplate, which must contain an {{ app }} node.
+// Several apps might be included into the same parent, as long as their
+// names and prefixes are unique (app names are compared without regard
+// to case). The templates and assets of each included app are namespaced
+// by its name, so they never collide with the ones from the parent or
+// from other included apps. See App.Reverse for reversing the handlers
+// of a specific included app.
 func (app *App) Include(prefix string, included *App, containerTemplate string) {
 	if err := app.include(prefix, included, containerTemplate); err != nil {
 		panic(err)
@@ -333,7 +403,7 @@ func (app *App) include(prefix string, child *App, containerTemplate string) err
 		if v.prefix == prefix {
 			return fmt.Errorf("can't include app at prefix %q, app %q is already using it", prefix, v.app.name)
 		}
-		if v.app.name == child.name {
+		if strings.EqualFold(v.app.name, child.name) {
 			return fmt.Errorf("duplicate app name %q", v.app.name)
 		}
 	}
@@ -376,6 +446,7 @@ func (app *App) include(prefix string, child *App, containerTemplate string) err
 	}
 	// All checks passed, add the included app handler
 	app.HandleOptions("^"+prefix, includedAppHandler(child, prefix), nil)
+	app.handlers[len(app.handlers)-1].included = true
 	return nil
 }
 
@@ -507,7 +578,10 @@ func (app *App) TemplatesFS() vfs.VFS {
 // associated with this app. By default, templates will be loaded from the
 // tmpl directory relative to the application binary.
 func (app *App) SetTemplatesFS(fs vfs.VFS) {
+	app.templatesMutex.Lock()
 	app.templatesFS = fs
+	app.templatesWatched = false
+	app.templatesMutex.Unlock()
 }
 
 // AddTemplateProcessor adds a new template processor. Template processors
@@ -516,6 +590,22 @@ func (app *App) AddTemplateProcessor(processor TemplateProcessor) {
 	app.templateProcessors = append(app.templateProcessors, processor)
 }
 
+// AddTemplateFuncs adds functions which will be available to the
+// templates loaded by this app. Functions which receive a *Context
+// as their first argument are called with the current context, so
+// it doesn't need to be passed explicitly from the template (e.g.
+// a function declared as func(ctx *Context, perm string) bool is
+// called as {{ has_permission "edit" }}). You must call this function
+// before any templates have been loaded.
+func (app *App) AddTemplateFuncs(funcs template.FuncMap) {
+	if app.templateFuncMap == nil {
+		app.templateFuncMap = make(template.FuncMap)
+	}
+	for k, v := range funcs {
+		app.templateFuncMap[k] = v
+	}
+}
+
 // AddTemplateVars adds additional variables which will be passed
 // to the templates executed by this app. The values in the map might
 // either be values or functions which receive a *Context instance and return
@@ -566,15 +656,10 @@ func (app *App) LoadTemplate(name string) (*Template, error) {
 				return nil, fmt.Errorf("error hooking %q: %s", v.Template.Root(), err)
 			}
 		}
-		if profile.On {
-			if profileHook != nil {
-				tmpl.tmpl.Hook(profileHook)
-			}
-		}
 		if err := tmpl.prepare(); err != nil {
 			return nil, err
 		}
-		if !app.cfg.TemplateDebug {
+		if !app.cfg.TemplateDebug || loaders.IsStatic(app.templatesFS) || app.watchTemplates() {
 			app.templatesMutex.Lock()
 			if app.templatesCache == nil {
 				app.templatesCache = make(map[string]*Template)
@@ -586,6 +671,35 @@ func (app *App) LoadTemplate(name string) (*Template, error) {
 	return tmpl, nil
 }
 
+// watchTemplates starts watching the templates VFS for changes if it
+// implements loaders.Watchable, clearing the templates cache every time
+// a template changes. It returns true iff the templates are being watched.
+func (app *App) watchTemplates() bool {
+	app.templatesMutex.Lock()
+	defer app.templatesMutex.Unlock()
+	if app.templatesWatched {
+		return true
+	}
+	if _, ok := app.templatesFS.(loaders.Watchable); !ok {
+		return false
+	}
+	err := loaders.Watch(app.templatesFS, func(name string, op loaders.Op) {
+		log.Debugf("template %s changed (%s), clearing templates cache", name, op)
+		app.templatesMutex.Lock()
+		app.templatesCache = make(map[string]*Template)
+		app.templatesMutex.Unlock()
+		if app.liveReload != nil {
+			app.liveReload.fileChanged(name)
+		}
+	})
+	if err != nil {
+		log.Warningf("error watching templates: %s", err)
+		return false
+	}
+	app.templatesWatched = true
+	return true
+}
+
 func (app *App) loadTemplate(fs vfs.VFS, manager *assets.Manager, name string) (*Template, error) {
 	t := newTemplate(app, fs, manager)
 	var vars map[string]interface{}
@@ -727,11 +841,18 @@ func (app *App) SetAddress(address string) {
 // that /favicon.ico and /robots.txt will be handled too, but they
 // will must be in the directory which contains the rest of the assets.
 func (app *App) HandleAssets(prefix string, dir string) {
-	fs, err := vfs.FS(dir)
+	fs, err := loaders.Dir(dir)
 	if err != nil {
 		panic(err)
 	}
 	manager := assets.New(fs, prefix)
+	if app.cfg.TemplateDebug {
+		if err := manager.Watch(); err != nil {
+			log.Warningf("error watching assets: %s", err)
+		} else {
+			app.watchLiveReload(manager)
+		}
+	}
 	app.SetAssetsManager(manager)
 	app.addAssetsManager(manager, true)
 }
@@ -763,6 +884,14 @@ func (app *App) MustReverse(name string, args ...interface{}) string {
 // would return "/article/42/the-ultimate-answer-to-life-the-universe-and-everything/"
 // If the handler is also restricted to a given hostname, the return value
 // will be a scheme relative url e.g. //www.example.com/article/...
+//
+// Handlers are looked up in the app and then in its included apps, in the
+// order they were included. To reverse a handler from a specific included
+// app, prefix its name with the app name and a colon (e.g. "docs:source").
+// Namespaces might be nested (e.g. "admin:users:sign-in") and are resolved
+// relative to this app and then to its parents, so an included app might
+// reverse the handlers of its siblings. Finally, an empty namespace (e.g.
+// ":index") reverses the handlers of the topmost app.
 func (app *App) Reverse(name string, args ...interface{}) (string, error) {
 	return app.reverse(name, args)
 }
@@ -771,7 +900,16 @@ func (app *App) reverse(name string, args []interface{}) (string, error) {
 	if name == "" {
 		return "", errors.New("can't reverse, no handler name specified")
 	}
-	found, s, err := app.reverseHandler(name, args)
+	target := app
+	handler := name
+	if sep := strings.LastIndex(name, ":"); sep >= 0 {
+		ns := name[:sep]
+		handler = name[sep+1:]
+		if target = app.namespacedApp(ns); target == nil {
+			return "", fmt.Errorf("can't reverse %q, no included app named %q", name, ns)
+		}
+	}
+	found, s, err := target.reverseHandler(handler, args)
 	if err != nil {
 		return "", err
 	}
@@ -781,6 +919,46 @@ func (app *App) reverse(name string, args []interface{}) (string, error) {
 	return s, nil
 }
 
+// namespacedApp returns the app for the given reverse namespace
+// (the colon separated names of the included apps), or nil if
+// there's no such app. See App.Reverse for the details.
+func (app *App) namespacedApp(ns string) *App {
+	if ns == "" {
+		root := app
+		for root.parent != nil {
+			root = root.parent
+		}
+		return root
+	}
+	names := strings.Split(ns, ":")
+	for a := app; a != nil; a = a.parent {
+		if found := a.includedByName(names); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// includedByName returns the app included with the given
+// names, descending one level per name.
+func (app *App) includedByName(names []string) *App {
+	cur := app
+	for _, name := range names {
+		var next *App
+		for _, v := range cur.included {
+			if strings.EqualFold(v.app.name, name) {
+				next = v.app
+				break
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		cur = next
+	}
+	return cur
+}
+
 func (app *App) reverseHandler(name string, args []interface{}) (bool, string, error) {
 	for _, v := range app.handlers {
 		if v.name == name {
@@ -796,11 +974,11 @@ func (app *App) reverseHandler(name string, args []interface{}) (bool, string, e
 				}
 				return true, "", fmt.Errorf("error reversing handler %q: %s", name, err)
 			}
-			if app.childInfo != nil {
-				// Don't use path.Join, it will remove any trailing
-				// slashes. Since the prefix has been sanitized in
-				// Include, we can just prepend it.
-				reversed = app.childInfo.prefix + reversed
+			// Don't use path.Join, it will remove any trailing
+			// slashes. Since the prefixes have been sanitized in
+			// Include, we can just prepend them.
+			for a := app; a.childInfo != nil; a = a.parent {
+				reversed = a.childInfo.prefix + reversed
 			}
 			if v.host != "" {
 				reversed = fmt.Sprintf("//%s%s", v.host, reversed)
@@ -817,7 +995,10 @@ func (app *App) reverseHandler(name string, args []interface{}) (bool, string, e
 }
 
 // ListenAndServe starts listening on the configured address and
-// port (see Address() and Port).
+// port (see Address() and Port). If the GONDOLA_LISTEN_FD environment
+// variable is set, the app serves requests using the already listening
+// socket with that file descriptor instead. This is used by the development
+// server to keep the socket open while the app is rebuilt and restarted.
 func (app *App) ListenAndServe() error {
 	if err := app.Prepare(); err != nil {
 		return err
@@ -825,6 +1006,10 @@ func (app *App) ListenAndServe() error {
 	if err := app.checkPort(); err != nil {
 		return err
 	}
+	listener, err := app.listen()
+	if err != nil {
+		return err
+	}
 	signal.Emit(WILL_LISTEN, app)
 	app.started = time.Now().UTC()
 	if app.Logger != nil && os.Getenv("GONDOLA_DEV_SERVER") == "" {
@@ -834,16 +1019,46 @@ func (app *App) ListenAndServe() error {
 			app.Logger.Infof("Listening on port %d", app.cfg.Port)
 		}
 	}
-	var err error
 	time.AfterFunc(500*time.Millisecond, func() {
 		if err == nil {
 			signal.Emit(DID_LISTEN, app)
 		}
 	})
-	err = http.ListenAndServe(app.address+":"+strconv.Itoa(app.cfg.Port), app)
+	server := &http.Server{Handler: app}
+	app.mu.Lock()
+	app.server = server
+	app.mu.Unlock()
+	if limit := app.cfg.MaxHeaderSize; limit > 0 {
+		// Let net/http read up to twice the configured limit, so
+		// the request reaches the App and the client receives a
+		// structured error rather than the net/http one.
+		server.MaxHeaderBytes = 2 * limit
+	}
+	err = server.Serve(listener)
+	if err == http.ErrServerClosed {
+		// Stopped by App.Shutdown
+		err = nil
+	}
 	return err
 }
 
+// listen returns the listener used by ListenAndServe. See
+// ListenAndServe for the details.
+func (app *App) listen() (net.Listener, error) {
+	if fd := os.Getenv("GONDOLA_LISTEN_FD"); fd != "" {
+		// Don't pass the socket to any child processes
+		os.Setenv("GONDOLA_LISTEN_FD", "")
+		n, err := strconv.Atoi(fd)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GONDOLA_LISTEN_FD %q: %s", fd, err)
+		}
+		f := os.NewFile(uintptr(n), "listener")
+		defer f.Close()
+		return net.FileListener(f)
+	}
+	return net.Listen("tcp", app.address+":"+strconv.Itoa(app.cfg.Port))
+}
+
 // MustListenAndServe works like ListenAndServe, but panics if
 // there's an error
 func (app *App) MustListenAndServe() {
@@ -947,15 +1162,20 @@ func (app *App) readXHeaders(r *http.Request) {
 
 func (app *App) handleHTTPError(ctx *Context, error string, code int) {
 	ctx.statusCode = -code
+	removeCacheDirectives(ctx)
 	defer app.recover(ctx)
 	if app.errorHandler == nil || !app.errorHandler(ctx, error, code) {
-		http.Error(ctx, error, code)
+		app.writeError(ctx, error, code)
 	}
 }
 
 func (app *App) handleError(ctx *Context, err interface{}) bool {
 	if gerr, ok := err.(Error); ok {
 		log.Debugf("HTTP error: %s (%d)", gerr.Error(), gerr.StatusCode())
+		if code := gerr.StatusCode(); code >= 500 {
+			skip, _, _, _ := runtimeutil.GetPanic()
+			app.reportError(ctx, err, true, code, skip, "")
+		}
 		app.handleHTTPError(ctx, gerr.Error(), gerr.StatusCode())
 		return true
 	}
@@ -1078,6 +1298,7 @@ func (app *App) logError(ctx *Context, err interface{}) {
 		}
 	}
 	ctx.Logger().Error(buf.String())
+	app.reportError(ctx, err, true, http.StatusInternalServerError, skip, stack)
 	if app.cfg.Debug {
 		app.errorPage(ctx, elapsed, skip, stackSkip, req, err)
 	} else {
@@ -1085,40 +1306,53 @@ func (app *App) logError(ctx *Context, err interface{}) {
 	}
 }
 
-func (app *App) errorPage(ctx *Context, elapsed time.Duration, skip int, stackSkip int, req string, err interface{}) {
-	t := newInternalTemplate(app)
-	if terr := t.parse("panic.html", nil); terr != nil {
-		panic(terr)
-	}
-	if terr := t.prepare(); terr != nil {
-		panic(terr)
-	}
-	stack := runtimeutil.FormatStackHTML(stackSkip + 1)
-	location, code := runtimeutil.FormatCallerHTML(skip+1, 5, true, true)
-	ctx.statusCode = -http.StatusInternalServerError
-	data := map[string]interface{}{
-		"Error":    fmt.Sprintf("%v", err),
-		"Subtitle": fmt.Sprintf("(after %s)", elapsed),
-		"Location": location,
-		"Code":     code,
-		"Stack":    stack,
-		"Request":  req,
-	}
-	if err := t.Execute(ctx, data); err != nil {
-		panic(err)
-	}
-}
-
 // ServeHTTP is called from the net/http system. You shouldn't need
 // to call this function
 func (app *App) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := app.newContext(w, r)
+	// Must be the first deferred call, so it runs once
+	// everything else has finished writing the response.
+	ctx.asyncFinish = true
+	defer ctx.finishAsync()
+	if tracing.Enabled() {
+		span := tracing.StartRemote("HTTP "+r.Method, tracing.Server, tracing.Extract(r.Header))
+		defer endRequestSpan(ctx, span)
+		// Propagate the span using the request context,
+		// see Context.traceContext.
+		r = r.WithContext(tracing.NewContext(r.Context(), span))
+		ctx.R = r
+	}
 	if profile.On && shouldProfile(ctx) {
 		profile.Begin()
 		defer profile.End(0)
+		if inDevServer && showToolbar(ctx) {
+			tw := &toolbarWriter{ResponseWriter: w}
+			ctx.ResponseWriter = tw
+			defer app.injectToolbar(ctx, tw)
+		}
+	}
+	if app.liveReload != nil && showToolbar(ctx) && r.URL.Path != liveReloadPath {
+		// Pages reload by themselves when templates
+		// or assets change.
+		tw := &toolbarWriter{ResponseWriter: ctx.ResponseWriter}
+		ctx.ResponseWriter = tw
+		defer tw.finish(app.liveReload.scriptTag())
 	}
+	app.startRecording(ctx)
 	defer app.closeContext(ctx)
 	defer app.recover(ctx)
+	if !app.checkACLs(ctx) {
+		return
+	}
+	if !app.checkMaintenance(ctx) {
+		return
+	}
+	if !app.checkHeaderSize(ctx) {
+		return
+	}
+	if !app.overrideMethod(ctx) {
+		return
+	}
 	if app.runProcessors(ctx) {
 		return
 	}
@@ -1133,8 +1367,20 @@ func (app *App) serveOrNotFound(path string, ctx *Context) {
 }
 
 func (app *App) serve(path string, ctx *Context) bool {
-	if handler := app.matchHandler(path, ctx); handler != nil {
-		handler(ctx)
+	if info := app.matchHandler(path, ctx); info != nil {
+		switch {
+		case info.included:
+			app.serveHandler(info, ctx)
+		case ctx.R.Method == "HEAD" && !info.noAutoHead:
+			app.serveHead(info, ctx)
+		case ctx.R.Method == "OPTIONS" && !info.noAutoOptions:
+			app.serveOptions(path, ctx)
+		default:
+			app.serveHandler(info, ctx)
+		}
+		return true
+	}
+	if app.methodNotAllowed(path, ctx) {
 		return true
 	}
 
@@ -1147,28 +1393,22 @@ func (app *App) serve(path string, ctx *Context) bool {
 			return true
 		}
 	}
-	return false
+	return app.normalize(path, ctx)
 }
 
-func (app *App) matchHandler(path string, ctx *Context) Handler {
+func (app *App) matchHandler(path string, ctx *Context) *handlerInfo {
 	for _, v := range app.handlers {
 		if v.host != "" && v.host != ctx.R.Host {
 			continue
 		}
-		if v.path != "" {
-			if v.path == path {
-				ctx.reProvider.reset(v.re, path, v.pathMatch)
-				ctx.handlerName = v.name
-				return v.handler
-			}
-		} else {
-			// Use FindStringSubmatchIndex, since this way we can
-			// reuse the slices used to store context arguments
-			if m := v.re.FindStringSubmatchIndex(path); m != nil {
-				ctx.reProvider.reset(v.re, path, m)
-				ctx.handlerName = v.name
-				return v.handler
-			}
+		if !v.accepts(ctx.R.Method) {
+			continue
+		}
+		if m := v.match(path); m != nil {
+			ctx.reProvider.reset(v.re, path, m)
+			ctx.handlerName = v.name
+			ctx.route = v.route()
+			return v
 		}
 	}
 	return nil
@@ -1208,10 +1448,21 @@ func (app *App) NewContext(p ContextProvider) *Context {
 // don't call NewContext() yourself, you don't need to call
 // CloseContext().
 func (app *App) CloseContext(ctx *Context) {
+	if !ctx.asyncFinish {
+		ctx.finish()
+	}
 	for _, v := range app.ContextFinalizers {
 		v(ctx)
 	}
+	app.recordRequest(ctx)
 	ctx.Close()
+	if !ctx.background && ctx.R != nil {
+		elapsed := ctx.Elapsed()
+		observeRequest(ctx.route, ctx.statusCode, elapsed)
+		if app.metrics != nil {
+			app.metrics.add(ctx.route, ctx.statusCode, elapsed)
+		}
+	}
 	if !ctx.background && app.Logger != nil && ctx.R != nil && ctx.R.URL.Path != devStatusPage && ctx.R.URL.Path != monitorAPIPage {
 		// Log at most with Warning level, to avoid potentially generating
 		// an email to the admin when running in production mode. If there
@@ -1260,12 +1511,13 @@ func (app *App) importAssets(included *includedApp) error {
 		if err != nil {
 			return err
 		}
-		defer src.Close()
-		seeker, err := assets.Seeker(src)
+		h := hashutil.NewWriter(fnv.New32a())
+		_, err = io.Copy(h, src)
+		src.Close()
 		if err != nil {
 			return err
 		}
-		sum := hashutil.Fnv32a(seeker)
+		sum := h.Sum()
 		nonExt := p[:len(p)-len(path.Ext(p))]
 		dest := path.Join(prefix, nonExt+".gen."+sum+path.Ext(p))
 		renames[p] = dest
@@ -1278,10 +1530,13 @@ func (app *App) importAssets(included *includedApp) error {
 			return err
 		}
 		defer f.Close()
-		if _, err := seeker.Seek(0, os.SEEK_SET); err != nil {
+		// Load the asset again rather than keeping it in
+		// memory while hashing it.
+		if src, err = im.Load(p); err != nil {
 			return err
 		}
-		if _, err := io.Copy(f, seeker); err != nil {
+		defer src.Close()
+		if _, err := io.Copy(f, src); err != nil {
 			return err
 		}
 		return nil
@@ -1300,8 +1555,10 @@ func (app *App) locked(f func()) {
 }
 
 // Signer returns a *cryptoutil.Signer using the given salt and
-// the App Hasher and Secret to sign values. If salt is smaller
-// than 16 bytes or the App has no Secret, an error is returned.
+// the App Hasher and Secret to sign values. The Config.OldSecrets
+// are also accepted when verifying signatures, so the secret can be
+// rotated. If salt is smaller than 16 bytes or the App has no Secret,
+// an error is returned.
 func (app *App) Signer(salt []byte) (*cryptoutil.Signer, error) {
 	if len(salt) < 16 {
 		return nil, fmt.Errorf("salt must be at least 16 bytes, it's %d", len(salt))
@@ -1310,24 +1567,35 @@ func (app *App) Signer(salt []byte) (*cryptoutil.Signer, error) {
 	if secret == "" {
 		return nil, errNoSecret
 	}
+	var oldKeys [][]byte
+	for _, v := range app.cfg.OldSecrets {
+		oldKeys = append(oldKeys, []byte(v))
+	}
 	return &cryptoutil.Signer{
-		Hasher: app.Hasher,
-		Salt:   salt,
-		Key:    []byte(secret),
+		Hasher:  app.Hasher,
+		Salt:    salt,
+		Key:     []byte(secret),
+		OldKeys: oldKeys,
 	}, nil
 }
 
 // Encrypter returns a *cryptoutil.Encrypter using the App
-// Cipherer and Key to encrypt values. If the App has no
-// Key, an error will be returned.
+// Cipherer and Key to encrypt values. The Config.OldEncryptionKeys
+// are used as its OldKeys, so the key can be rotated together with
+// the secret. If the App has no Key, an error will be returned.
 func (app *App) Encrypter() (*cryptoutil.Encrypter, error) {
 	key := app.cfg.EncryptionKey
 	if key == "" {
 		return nil, errNoKey
 	}
+	var oldKeys [][]byte
+	for _, v := range app.cfg.OldEncryptionKeys {
+		oldKeys = append(oldKeys, []byte(v))
+	}
 	return &cryptoutil.Encrypter{
 		Cipherer: app.Cipherer,
 		Key:      []byte(key),
+		OldKeys:  oldKeys,
 	}, nil
 }
 
@@ -1476,12 +1744,17 @@ func New() *App {
 		appendSlash:    true,
 		templatesCache: make(map[string]*Template),
 	}
+	if cfg.Tracing != nil {
+		configureTracing.Do(func() {
+			if err := tracing.Configure(cfg.Tracing); err != nil {
+				log.Errorf("error configuring tracing: %s", err)
+			}
+		})
+	}
 	// Used to automatically reload the page on panics when the server
 	// is restarted.
 	if cfg.Debug || profile.On {
-		a.Handle("^/debug/pprof/cmdline", wrap(pprof.Cmdline))
-		a.Handle("^/debug/pprof/profile", wrap(pprof.Profile))
-		a.Handle("^/debug/pprof", wrap(pprof.Index))
+		a.addPprofHandlers(func(handler Handler) Handler { return handler })
 		a.Handle("^/debug/profile", profileInfoHandler)
 		a.Handle(devStatusPage, func(ctx *Context) {
 			ctx.WriteJSON(map[string]interface{}{
@@ -1492,12 +1765,12 @@ func New() *App {
 		a.Handle(monitorAPIPage, monitorAPIHandler)
 		a.Handle(monitorPage, monitorHandler)
 		a.addAssetsManager(internalAssetsManager, false)
+		// The development server already reloads the
+		// pages when templates or assets change.
+		if cfg.Debug && cfg.TemplateDebug && !inDevServer {
+			a.liveReload = newLiveReloader()
+			a.Handle("^"+liveReloadPath+"$", a.liveReload.handler)
+		}
 	}
 	return a
 }
-
-func wrap(f func(w http.ResponseWriter, r *http.Request)) Handler {
-	return func(ctx *Context) {
-		f(ctx, ctx.R)
-	}
-}
@@ -4,4 +4,4 @@ import "gnd.la/internal/vfsutil"
 
 // AUTOMATICALLY GENERATED WITH gondola bake -dir=_assets -name=appAssets -o=app_assets.go -- DO NOT EDIT!
 
-var appAssets = vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec\xbd\xef\x9e\xdb6\xb2 :\x9f\xfd\x14\xb4r2C\xba%\xb5\xa4n\xff\xebv\xdb?\xc7vNr\xd7q\xb2v6;\x99v\x1f\xff(\x91R\xb3\x9b\x12e\x92\xea\x96<\xf1\xf9r\x9fi\xbf\xddO\xfb\x00\xf7\xbeҭ?\x00\b\x80 \xa5\xb6\xe3\xcc\xcc\xee\xce9q\x8b@\xa1P(\x14\n\x05\xa0P\x18\x87E\xdc?/\xe7韾\xdc\xff\x06\xc3\x01\xfe\xefO\x83\x81\xf3\xef\xdd\xc3\xc3\xc3?\rG\a\xf7\xee\x8e\x0eFÃ!\xc0\x0f\a\a\x87\u007f\xf2\x06\u007f\xfa\x03\xfe\xb7*\xca0\aR>\xbb\x91V\xe3\xfeE\xfe\xf7\xf7\xbf\xef߹\xe5y\xc9b\x92\xae\xa2\xf8ț\xaf\xd6\xfd\x8b\xe2֝\xfd\x8f\x1fo=\xba\xfd\xfc\xc7g?\xff\xfa\xd3\v\x0f\x05\xe4\xf1\xadG\xf2O\x1cF\xf0\xa7L\xca4~\xfc\xf7\xbf{e<_\xa6a\x19{\x1dJ\xeax\xfd\x8f\x1f\x1f\xeds\xf6\xadGE\xb9Ic\xaf\xdc,\xe3\x93N\x19\xaf\xcb\xfdIQt\x1e\xdfڿ\x03X\xcb\xe5\xd1\xfe\xfe<\xde\xc4\xf9u<\xeeO\xb2\xf9~\x9c'\x93\xfd2\xcb\xd2\x02\xe1\xf6\xf3\xb8\x88\xcb} л\x1a\xf5\a\xdeo\xde\b\x85c8\xba\x87I/\x93I\xbc(\x80\xe8E\xb6\x88=\u007f\xb9\x1a\xa7\xc9ċ\xb2y\x98,\x02h\u00ad[Hp\xd7\x1bgѦ\xebE\xc9U\xd7+\x96\xe1\xa2\xeb\x85\xcbe\x1a\x97]/\x1b_\xc4\x13\xf8\x9bL\xf3p\x1ewo\x9d\x0f\xbb\xde\xf9\b\xfe;\x80\xff\x0eΏ\xf0\u07fd\xae\xb7\x04\x1ci6\xb9|\xbf\xca\xca\x18>s\x80\r\x01\xcdx\x9cÿ\x93<[l\xe6\xf0#\x8a\x80\xdc\x02`\x93Yכ$\b:\xc9\"\x80\x8db\xa0\"\x9aB\xcd1\xc0%s\xc8N\x16\x00x9\x8e\xba\xde{\xa0\n\xfe?\x9c/\xbb\xb7\x8ay\x98\x02hQ\xe6\xc9eL\u007f\xb3\x05\x00\x17\xab1\xfe\x03d\x94@\xedU\x98woA\xc2\n\xb0@\r\xf1\xa2\x8c!!\xc2* 7\x02\x94\x19\xfc^\xc1\u007fiҽ5M\xe24*\xb0\xb5\xd3,\x87\xda\xd3p\x8cԤ\xf1,^D\xdd[e8N\x91\xcepY&\x19\x10X2\xb3\xcai\x96A\x91\x12\xbb\x1a\xfe\xe4\xf8\x13\xfe\x83\x02a^&\x13,\x12\x16ID%\x17W!4 \x8a\xcb0I\vl\xe28\x8e\xb0\xde\xd9\n\xf8\xe4\xc1_\x85\x1c\x91\x02\xad\x1eb\xa5\xbf\xb3<\x83Vݚ\xc7\vh\xcc\"\x84\x0e\xcaV\xe5r\x055\xe7\xab1\x90Q@\xf7P\xc9b5\x9f\x87\xf9\x06\xe8M\xa0\xa3<\xf8}\t\x14\xac\xa2$\x03~\x00\x1d\x99\xf7w\x90\bH\x9e%\x8b#op\f\x1fK\xe8\x8fd1\x13_\xe3,\x87*\xc5\xc74[\x94\xbd\"\xf9\x00\xa2\x03\xaa\xe9k\x99t\x04\xbdr\x0e\x02Xb\xc2U\x8c\r\r\xd3^\x98&3\xc0\x89\x8a2M\x16\xf1\xf1\xad\x8f(\xbb\xdf\xfd\xfc\xc3˻ S\x05H\xfe\xa6\x97g \xe2$\xab\xc8e\xe0?\xd4\xe5\x8d\xf3캈\xf3\xc2\x03I\xb4\xb9\xa6\xb8e\xb0\x87Yv\xab\x81M\x9e\xc6&\xc1\x18j\xb5 \xe2\x88e\x14\xe9\xc3>\xa4,$\xb8w\x1e'\xb3sh\xdc\x10\xb3X4Df\x01\\\xc0\xd1\xc9#\x88Jjb\xfe\x9e\xa0\xe8\xa3pA\x1c\x8dchl\xac\x8f\x8d\xa3pJ\xc2\xf8^\xe5\xbd\xe7$B5\x01\x1e\xc7\xc8\xe6\xbf\xfc\xe5X\xff\x94\xa8I\x16\t\x92{\xab7\xc9\xd24\\\xe2\b\x97\xbf\xaa\xae\xec\xc1P\x9e\xc8\xfe\xa5.y\xb1\x88\xbcl*\xba\x01x\xae\xb8@\xdd=\r\xe7I\nL\xfa%Σp\x11\xdabpo\xb9&\xdc\xe1\xe4\x12\xb9\xbd\x88\x8e\xbc\xaf\x9e?\xc3\xffC\xec\xe7\xc3\nѵ`\xe7\x18z\xd9\xc2r0`,,\x87\xbdqV\x96\xd9\x1ce\f\x93\x01\xcbA\x85\x85\v\x8cDN?\xce\xf3\x8c\x99dP\xf0\xed\xb7\xcf\x06Ͼ!\x88\xeb0_@s]0\xdf>\xfd\x96x\xd0O\x16\xd3\xcc\x01\xf0\f\xff\x8f\x00P\x1d9\x00\x06\xdf>\xf8\xf6[\x05П\xaf\xd229Z\x94\xe7\xbd\xc9y\x92F~\x16EAk\xbd,\xa8\x04\xa2F\xddH\xf0B\xf4\x96\xe2\xc5r\xed\x15Y\x9aD:{\xfb\xc5j2\x01\xd5Y\xaf\xe4\xf9\xb7\xdf\x0e\x9e?@\x18P\xba\xbah\xe4a\x94\xac@,\xefr-\xf6\x18@\xe9J3\x18\xef_=\xfb\xf6\xf97/\x9eUC|\x01Z0L\xbd\xe1h\xb9\xde\x1f\xc1?\xde\x0f\xd9\"\x9cd]\xf8\x93\xa1H\xc5\xde\xedd\xbe\xcc\xf22\\\x94\xa6D\xf4$\xca\xd1\x14\xff\xcf\xcaL\xe6\xe1\fz\x14\xc4c|\x99\x94\xbd<^\xc6a\t\x9c\xe8\xe1\x18\f\xf3\xde\f)\x06q\xf7\xcb\f\x86\xf2W`\xf3x\xc0!\xf1\v\t\xe9J\xc4\xd6\xd7\xe1\xe1r\x1d4T6\xcf>\xfcQ5\x15\u007fPE\xd9\x1fS\xcf\x17\xa8\xa4\x9ap\xbc\xe1]]\x11\x80\xd4\xc7s\x9ey2\x98V\xa6iv}\x04\x93W\x99\xa1\\Ӕo\xeb\x85\xe1\xb0I\xacт\x92S\x12O\xfdF=R\xd3\f\xbd\n\xafB\x92,x\x02\xab\x06ǽ{\xf7\x8e\xcdy\x93\aZ\u007f\xb2\xcas\xc0^\x1f\x90\x0f\xbeA\xebV\xc7!\xd5K\xad\xa2\x9e\"\xfa:\x89\xca\xf3j\xae\x95\x13\x12\xf2q\v\xe3\x06^\x8f\x93\x98.\xb4\xb8t-\xa0k\x95\b~ǑN\x0f4\xa2@\x12\x97Y\u008c\x02\x93v\x9f&<0K\xf7\x85\x15\x8b\xb3\xc4\xe3[`\xc5\x02c\xc1\"\xf2\xd0\xee\xdd\xe7D\x80!\x83\xf7O\xff\v\xffo\xbc\x02\r\x0f\xcc\xff\x92k\xc0-\xeb\xbf\xd1\xe1݁\xbd\xfe\xbb?\x1c\xfe\x9f\xf5\xdf\x1f\xb8\xfec\xe1/\xd8\xcc%Y\xd0\x17\x85y\x9cfa\xd4\xe7\xd5T\xb1L\x16\fA\x8bD\x189Q<\x85\xe1\xaeV\u007f\x1f?~#\x84ʃ\xcc\xfe+XZAZ\xbf\xdf\xc7A&F\x18,ƼI\x1a\x16\xc5IG\x18\x0f\xc2\x04\x80\x95\xa1\xe7=:\x1f>n\xc0\x01crH \x88\x81\xc62b\x10\xfad\x80\xea\x0f\x86{\x91\xa0qLt\x83\x8e\xbf\x8a\x8f\t\xab(\x94D'\x1dl\xc3\"\xce;\x8f\x1f\xedC\x12\xe1\xe3\x1f\xf2\x0fi\xcfǯФ\xf5~>O\n\xd0Q\xb3\u0603\xbf\xa8\xb6\xe7!\xad\fҍ`\fh\x9dl\x01\x86\x83F\xa9\x87RU\x16@.c\xbae,\x93\x15\va\xa9L\xec(&y\xb2,\xf5E\xf2E\b\xab*J\x05\xdaa\xc5\xe7e˲\xf0N\x94U\x0f]5\x84U\xea\xfe>\x90\x17{\x8b\x15,\xbar\xb4~)\xcf+3/\xca\xc3k\x84\x8d\x173Խ\xa3\x81\x02\xe6$\x04\x8e\xc3\xc99\x95\xd0ut\x05\x87\x9dZ\x9e'\x93K@Y\x00\x88\xb4\xb9\x0e*\x18NB\\\xb0T\U00108a70\xf2\xcda\xa9C3D\x0e\xdfH)\xc1?\xa3O\x8ff\x12\xc4\xe8\xf9\x83~\u007f\x18 \xe2\xac\f\x91\xd3\x1a^L\xc1\x05N6\x9d\x829O\x93K\xcek\x1e\x89n\b\x13 N0\xd7I\x01\xeb\x8c\x1e~\x02f\xd0\xf3*\xb5\x9a\xa4\xfe\xf2\x15\fɿP\xb1\xaf\xf2\xd9\xd8\x03[\xfb\xab<\x9f\xcd\xc6\xf43\xcc\xf3p\x83m hli\xb1\x8c\xe3HV\xf4\x1a\t\x06\t@!\x8da\xd1\x12\xe14\x9c\xc3\xf2\xedȻ\xc7\x14?\xc5%\xce\f\xa6u\x04\xc2Y\x19\x84\x05\xb1\x9c\x87\x11N\xf5e\xbe\x8a\t\uefdf\xc7\xc0\xa6\x1c\xbb\afW\x94\xfaP\x00\xe1\xacx\x1d\xc2\bH\xdd\xe0\xab\"\xf6\xce\xc3<\x82U\x00̂\b\x17\xe7\xc4 l#\x8e#\x94;h\xa7\x10\xec\xbf(F>{\xf3\x86\x01\x10\v\xfc\x01\xc3\x01\u007fao\tX\xc0\xf0\xe1{ f\rB\x12?T\x05?\xf4\x12L\xf4|\x18\xdd!,\b\b\xc1h \xff\x87\xdd\x06\x96\x12Tyw\xf0\xb5\xa8.[\xaa\xb1\xa7\x86\x1e\x96Z\x86hK\x900NKQ\x02\v\xbc\x84\xcf\xd6\x12\x1f\x8fI\xf4a(\xcd`Iw\x02S\xfcd\x05\x8b\xe0\xb2\x0f\x9f/\xd2\x18\u007f~\xb3\xf9>\xf2U\xb3\x03. >\xa1\xc4\"\xbe\xf6\xde\xf0\x97\x8f#(\xe8c\x9e\xcf\x18\x01\x1a\xc6'\r2k\x80j\nO\f\xd1\xffU\xe7\xff\xe8\xa0\u007f5\xea_\x14\u007f\xfaG\xed\xff\xde\x1f\x1d\xc2\u007f\xd6\xfc?8<8\xf8?\xf3\xff\x1f\xf1?\u007f\xbaZ\x90Z\xf5y\x89/?\xbd\xe8\xe0\x1d\xe9\r\u007fRf9\xeeyfK\xdc\x11\x8b\v\x86C\x15\xb8\x11\xbf<\xda\xf4\xf2q\xe4]\xc6\x1b\x98\x05\x1c\xd0\xf8\xbf\x1fi\xbb\xb5\xcf\xe6\xc2O\f\xb2!\xfc}(Pf8\xfdu\x11EW+\xe4yWa\xba\x02\xddV\xe1<\x05\x90\xb3\xae\x06\x11\xc3\xfc\a\xfap\x8c;Y\xd30%\xcd\xcf\xff\xfbH+3\xfaE\u007f?z\x93\xb0\x84IϏ+\xc2L\x02@iT5qa,\xfa\xd1\xe2\r\xcd\x19ϲ\xe5\xc6_\x16\xf1*\xca\xe8[\xe2DN$\x80\xa8\a\x13\xc8\x02\x11V }\x9e}\xbbb\xd29\xf1Nϸ\x92\xeb\xf3$\x8d=\u007fo/\xf1\x1ey\x8b\x80\xf3\xfb\xcbUq\xae\xd7p\x9a\x9c\x89\x16\xe5q\xb9\xca\x17\fv\xdcD\xe0\x9b4\x99\xc4.\nE\xe9\xa7\\\x89l{\xbf@\xf8>\xda6F!\x17\xfe\x1f\xc2%\x8a\x8c\x9d\x9cD\xa0\x94\x13\xe8\xd6Ȫ*r!\x01\xeb\xa2\xf0-@Ls\xc2´X\x83\x854\x17,\xfd\xcer\xffʆ\x87F\xc2<\u007f坜\x9cx\x1dY\xa2\xe3=\x81\xa4#\xcf\x1a\nZ\xb9+!\b\xae\xba\xf2x\f3\xa5\x98R\xc0<\xceV`\x02\xe0fmy\x9e\xd9LpԀ\xb2B\x12\x0e\xb2\xc0e\xfax<\xb1\xf1%\"\xc0K\x93^\xa1DYu\xbd\xc8\x102\x05\xad\x103\xe5\x11cl!\x9a\rF\u007fmѷ\xf6nÜ\xb9JS\xef\xcf\u007f\xf6n'`U\xbc\xf2\xd7\xce\xee\xff\x90,_R\xad\xf5\x8e\x16\xe4\xb8J\x15\xcb4\x81Uz^/\x04=B\x15\xbbJ\xc9\xcd_\xbf\xb0J\x15\xfd2O\xe6~\xd0\xcfc\x98\xb8A\xd2\xf7\xdf\x16{\xfb\xb3\xae\xd7\xf1:N\xaa\xf3p1\x8b\xdf\xe1n\xc0,\xce߀\x9c\xc7\x15\vH}A'\f\x8d\xf1\xb8\xf6\xee@\xea\xd7\xde0\x80?w w`\f\xbfKW-\xb8\x0f\x82Z\xc65>d\u07bb\xf8\n7\x9b\xe4\xa7$B\x81r\xb6)&\x1f\x80:ܴ\x8fѪ\xee\x1a\x1a\xe6\x83\xd2+\xa9\x94\x12[\xa1$S\xcfO\x11\x14tH?\x83\x84T\b\x1a\x0e\xb8\x161\x93$V\xba\x90\xa9Q\x94\x90\x12\xeb\xaa\xefo6\xb4\x06bۋ\x15\x05\x17\xa5&A͐\xa5\xc6\xc1\x02\xcf\xddTQ\xb3\xb9\xa9\xd6\\F\x8av\x1f\x15\t\xa0\xf5\x92LlWm(<\xf2F\x81l\x00\ts\n\x15\xeb%R}bJ\x99*)\u007f\"Qk\x9f\xfa\xcd\x1a\xd2\x1f0\xf7\xabd\xb2\x95\u007f\x9c\x02ڠ\x0fK\x04\x98d|\xbbL\xe2\xed\x81\x14\x05\xf5\nD\xdb\xf2x\x9e]\xc5\xdc<s\xd2\x12\x14+&U\x98iz\xb0\xf0\x14\x82G\xfa$\x8a\xab&\t&Q\a[:ڐs\xea;\xa7\xa6\xc5\xed\xf4\xf2\xdd\x12\x16gI\x81=\xba\x06[\xc1\x1a\xa6K\xaf\x87\x03\xe9\x897\x04\x16\xfc\x10\x96\xe7\xfdi\x9a\x81v\xa6\x9fi6\x83<\x91\xbe̮}\\\x816\x02\x06\xde>g\xbc|5\x1c\x04\x80w\x19XI\xb0j\vZ\b\xc5\x19\xe09\xafh\x1c\xdao\xcf\xebtZ\n\xd39\x9cO\xba՞\xea)\xb1\x0f\x06S\xf9\xbd\x10\x85N\xbf\x03b:\x85\xbc\xc4{|\xe2\rp\x92!\xa0b5\xc6\x03\xde\xc5\xccO\x90\\_+.\xc6p\aK\x96u\xe3\x00\x10y\xd0Ē\xfb\xbd\x86\xcd\xeb\x9dx\a(\x99{\xdeA`\x9a\t%\x88\xd7\x15H\f̠\xfd\x8b\fV@\x9dn'\x00\xb8isk\u007f\xca\xc1N[\xfb\x11 \xb4U\xa4\xd1W\xf4\x11\x8e\v\xff\x01t\a\x80ށʍ\xba\xa5\x18\x16\xa8o\x8f\x90\x1b\xde\x03`\x86\xd2\x01\x91>\x14\xd5|\xb0Ϻ\x95\x8d\xb6\xa3m\xd0w4hi\x19\x16\x9b\xf98\x83\xf5t\xd4<\r\xc6a\x11\xa3\x99;_\xfaӦ\xa9\xba\xacY\x03\xa5\xf7\x88\xfbs\x00\x84\x95عC\x12n\xa0\xd2/\x83\xe3-\xd5Ɏ\xb8A\x85C\xe0\xec\xd4\xc7\u007fwA?M\xc1ʾ\t\xfa\xfe]\xe0\x9f\x0f\xad\xc2_\xd01\xfe\b\xbeK\x94\xcd\x11U\x8c\xffr\xd2\xd6ڕ\xf9WږWc\x91e\x06sP\xbc;\xb5J\xf8\xc0؊\xb7\x12T$\x8b\x1a-\xc8H\xc22\xc9\nh\xf6\x1d\xfe\xf8\xe9{\x90\xb9QЈ)^/k\x98\x14-#\xd0Y\x03\xe6bOjy\xb7\xb0\xd1\xe6X3I\xc5\xfb\xbc\xe4\x9e&\x867S\x83\xaa&\x99\xf8\xa1\xa6ni\xeb\x83\x19\xd2<).a\xfc\xf6\x0fﶂ\r+\xa6\x87\x95E\x04#\n\xd72\xc0\xa5C\xc1u/\x86\xb5\x96J%\xb1\x11\xac\f\xe4\xcf\x10;`\b\xb9\xa1\xa9\x14Z\xa5}\x0f\xaa\xbdSgn\xafTh\x11+\xf1\xba\xc0\xa4\x91ч˭B\x81'Z\x951\x89L\xb8\r_؎a\xff\xfe`x\xf7\xc1\xf1Κ\xe0\x0e\xfd\xe7\xfb\x05\xcd\xee\xf4\x89Dm\xa5 \x83\x94\xba\x18\xe0\x18Df\x8d\xfa\xf7q$\xde\xef\u07fd7\xba+\xea(I\xd9@\x1f:\xf2\x91\x15H\xfc]\x91Ǆ\xecy\b&J\xa9\xbcz\xb9Q\u007fT+\xf8\xf0\x80\x8a\xd6 \xef9@\x1f\x1c\x02\xb0\xb3\xa9h5<\v\xa1\xa5\xa92e\xa3\x83>\x1b\x82E\x99-q\x13 \x9c\x85\xbc$:6\xf3\x979\xfd\x95\x93u\xd0X\xc1\x1bZ(\xf9\xfa(@\xf3S\"\xea\xca1!&Q\xecf\x986\xa9\xd0\v\x04\b\b\xbc0\xed\x9d\xc6ڞK\xe3^\xec!j\xb5J\x03\xaa\xb2}e\n\x9b\x8b\x03\xb6\xd5\xed\x01\xe7^\xffˢ\xa7\n\x1c\xec\xf63jW\xe3\"BpP|\xf6\xb3\xa9nj\x83\x99\xff\xa12\xf3?\xd4dY\x01\xc6C}\x8aշy\x14{\a\xc8á\xceD\x8d\xe1\xc7\xfa\xcḛ\xafvo\xf9\x87\x9e+K\x106#C6\x1e˃\xc5vV-U\x8c6Te>z\xd3dAg2\u007fo\xa8`\xa0\x01KS\xc1e\xea\xeafp}\x0f\"\\\x14h\x1e\xf9s\xbd\xe3s\xe4ǩ7\xef\x836\x9e\xf7\xc7\x1e\xac\x86\xf2\xa1H\x9a`R\x84I\x97k\xee=\x85\xe4\x15\xb9\xa2$\x1fb?\x1f\x80\xc1w\xf9\xc1\xca\u007f\x9e\x95\x90\x83\xb80w\xd3XZO}\x96\xcd\xc7\xc9\x020\xc2\xc2\x10\xcb\xf6.?\x80\x85\xfc\xdboޠ\xd2\xf8\xf9\xe0tp\x06\xa37\x1f\x9e\x0e\xcf@\xda\xe0/\u007f\x0f\xe0[\x93\v\x06\xc3E\xe6q\x954\xb4\x92\xa0U\xe6\xf7\a\xfd\x9b9\x8d+\xcc>\x1f\xf2@#\xfcK\\\v\xf0\xecP\x86\x8b\x91OX\xbb\\\x1fZ\x1dZV\x8fh\xeb2\xa9\x01\xaa\x1c\x83A\xf1,\x8f\xe5.\x1dUBY)׃\xdcǍ\x98\xfe\xd4;\xd3@\xc8\f\xa5\xec\xcb5\xb1\xd5ȼ\x84\x81{\x82\xa9\x06\x85\x97\x1f\x10\xb2\xa1zOx\x156\n\v\xf6#H\xc6\xd8R\xf6!s}\x8c\u007f`\xc6#\xce\xc2\xd7\xf0\xac\x15Y\xd5\xed\xa1\xd3,'\x03\xc2Q{(\x8d7\x94\x80Km\x82\xc7\xea\xf7O*\xfb\x99\b\xa9\x12>n\xdd\xee\xa8\xc9\x1e\xb6\x15\xf8%\xeb\xa0\x1a\xf6Np\xff\x84\x1a{|KU\xa3R\x87g殦\xab\x1er\xe2\x00k\x11z\x97\x97\xba\xbcV6\x99\xba\xa0\xbd\x87\x13\xaf\xa3\xa8\xc2\xdd=\xd0\x03Z\xe9\x9fe\x16\xf4\x9c\x99\xe3\xaav\xb5\xd0\x00^\xf1\xbe\x99ޛc\xe0\xfc\x18W\xb8h*\xedA\xa7<\xa1)\xdcǴ0\x90\xc2QW\xb3\xeb\x9a\x06\x86u0\x15\x01\x86\xb4\xd8\x0f\x06=\xcfh\xed\xf2e\xc8!q\x9a\x87k_.\xf2\xe6h\xcauu2[\x17\x04\xcf\xf04յ\a\x96\xcf\xc6~\xde\xf5f\xf5!!\xa6\xcd\xd7\x1a\x80\v\xf3\xeb:\x02V1\xd0\xe4\\\x1b\xcd3\xf8\x9ei\xdf\xc4\x1b\xe7\xbe\xe0l\xfc\xee<^\xd7\xf6\x8b\xaf\xd0\x1e\xbb\a,\xec\f:rKBp\xe4*\xe8\x97\xd9\x1b^y\x0f\xef)\xb5\x85<\x1aݽ[\xcbo\xaau\x19\xd2Z\x90Vܠ\xe6f0p\u038bԘ\\\xd8r\x98\xf1\x9f1\xff\x99C?\xccG]\x12wn\xe0\x1c'\x9c}\xff4\xec}8\xdb\v\xde\xfa~\xffN\xf06\xd8O\xfa\xf1:\x9e\x88\n4\r0\xd7&\xf9\xf9\b\xf7\x9e\x87\xa7\xa3\xb3>m\xd0\xd2怲\xf9\xaf\x13:0\x99\x0f\x8d\xc9\xc1\x9b\x80\x19\xebu\x80\xd6Α\x9aW\xf5\xa9Wp\x10\x00|j\xe3\xb7i\x16\x96\xfe|\x84:\xbe\xeb\x99I\x88x\x1f\xdd\xc3쌑\xcc\bꓷ\xa0\x00X\xd6N\x01J\x9b\xcel1\x84%%\xee\xacas\x16\xd0\xe4\xa0棦,\x91\xbf\xac\x87$\n\xfc*h\xf7RtD\xa0S\xb7\xa0\xed?\xee\xcc\xfeL\xfc\x1dk\x9dŅ\xf4\xbdyN\xe9O\xce\xc3\xfci\xe9\x0f\x02>\xd0\xf8\xaaSuPUN\xae\xeb\x10\xe4\xd0\xd8=A\xf3\xd0@4\xd4Z\x96\xa3vΫ\xefY\rz\x14\xe8\xb9{j\xacI5dB\x1f\x04z\xeeމTrj\x1d\xe9\xa6\xf8~\x03\xc5վ\x17\f\x85\x83\xc0Ig\x05s\xd0\xf5\xee\x06N\xea*\x18\x18\xb4\xf7k;\xafX#\t\xc0\xf7`hC\x1f\x89\xa1,+RY3#k\xacg\x8d\xb5,c6ͷ\xa89\xd2J0~,U\x87J\x01Ԍ\x9c\xf0Q\xe3\xe48a\x93ڙ\xa9_c\xf1+\xc0\xeb\x12k\x05\r\xdaK\xe2\xeb\xe2\xb1\ve\xf6\x10!h\x1e\xba\x8a\x82\xfb\xee>\xa6\xeea*\x0e\xc0Q%\x8a\xda\xf6\x1bm\x8c\xcbM#ܰ\xd3\v\x1dq\nn\x1d\xa9\n\x02}\x03>\xc7Y\x1a\xb2\x02\x0f\xd7K\xfe\f@\xc6XW\x04\x18\xe0\xeb\x11\xd0\xffĻ\x87\xf3Up\\\xc9\xc7\xcc,\x85\x93Z.K\x8d\x04\x1c\xe5\xe4\x903\x939\x87\xb2\xe2s\xb4M\xef\r\x8c\xed\v\xbd9Xt\xe0\xe8)\xe8\f\xec\b\xc1\x9f\xc6\xceJ\xc3\xfa\xc4V)\x81\xf5惟\v\x1e\xcc\xcc\xe4\x99H\x1e\x9b\xc9R\a`\x8f\x8b\xc5\x03$S5~\xffp8:\xbc{\xef\x10\xedv\\\x85\x1fܽ\u007f\xf7\xfe\xbd!|\xce\xf0s\xf8`\x80\xabr\x9a\xa4\x91\v\aX\xea\xdd_\xa1\xcf76\xa2\xd1pt\xef\xfe\xe8\xa1Dt\u007fxwxw4\x92\x88\x06\xf7G\xc3\x1a\x9e_\x01\xcf\a\x1b\xcf`\xf8\xf0\xe0\xe0@\xe1\x19\x0e\x1f\x0e\x1f*4\x0f\xef\x0e\x0e\x06\x87C\v\xcf\xdf\xccM!N\xf5\x870\xe5\xde\x01:{0n`\xdc\x0ehc\reh\x03Վ\xf8\x13s?\x04\x8d=\xc1\xbc6's5J\x02ܿ\xed\x0f\x0e\a\x87(\xb99\xce0\xa3>\x90zTm9\xf9Ԅ\x01\xc2B.\xfe\x80\x9a\xfb\x87\xedӸ\x98#&\xfa@\x9dJM\xc0s\xda\xc4l\xf0D*ǉTy\xb8{\xc8\xca\xfc\xeb\x8e\\\x83\x90ǜ?ō\xae>Rt\xe4\u07b6\xd7%\xd4iV}\xb7E\x84\xbf\xab# \xb3\tGŹ\xbeH\xaa\xb6K\xe8\x9b\x0e\xea\x1a(\"c\xcfB\xaa@\xae\xfcss\xc2:\xf7\x1e{\a\xf7\x068\xba\xf1,\x03Fj5\xf4qc\x92r\xf68\xc7(\xf6\xc8\xc3R\xa2\xb9s\xdcA\x84\xa9\x1a\xb5\x0e\xedɝC'\xd6K\xc0\x10\xa9\x8a\x8c\xec\xdc\xd1a\vB\x1fr\xe1\xe3<0\x10+h]\x83T\x8d5Z\xab\xdb\xd8ܿ\x94}\x87\xe4\xd3>jek\x8fS\xa93\xbc\xaf+\x164\xf2\x86\xba\t3\xc4IE\x01̕\a\x15\xa2\xfbR\xa1\xc2%H\xaa\x81\x88\xa3P2\x0eS\x1a18ZRl=\xb2\xa3\b\b\x1e~\x00#0\xb5\xd0\xecP\x1c\xf6)rkd\x8fo\x14\a\xe4\x04n\x97\x8ep׃\xd8\xc2\u007fP\xf8!\xcd)\x9b\xe7\x13\x92\xcdI\xb3pk\x00N\xe1\xae#p\n\xf7\x04\xbe';\t\xf7$%m\xe5\xa6J\xa8\xb2\xb4[\x9d4\xd0\xe4SmS\x93\xf8\xdd\xc1\xb2jG\x9b\x04`\xe2$_ \v\x1b\xd7L/5\x00\x17\x82\x97u\x04f\xfb\xc47.\x1b\xc3]\xd6L\xa8\xbf\xb17-\xa4(\xaf8\xc5\xf8(\x1b\xb80\x82\x96\xa2\x1a\xc7\xf9kC;\xfb\xfb\xa8\xd2y\xfe@->F\x03c dV\xccr\x88\x1bU\xf8Zl\xb9\xd0\xdc\xc5\x10\x1b\x13b\xa3A\xfc\xca\x10\x1fL\x88\x0f\x1a\xc4\xdf\\\xe2(&1\xfcy\xd0\x1f\xe1\xacp\x88\xf2\x8b\x13ΰ\u007f\xf7\xe0\xfe\xf0\xe0\xc1]1\x1f\xf5\x0f\x1f>\xb8{0\xc4I\xf7\x03/\x10d\xc9^\xff\u1f47\xa3{\xf7\xa8 4\xbc\xff\xe0\xfe\xbd\xc1p\xf0\x80\n\xe2Tr8\xbc{\xf7^\xbd\x1cN-\xf7\x0e\x0f\x0eE\x85\xfd\x11LK\xa3\xbb\x0fE1\x9cy\xee\x8fFwGT\xb0I0hp\xb8\x85C\x8c\x1cm+k\x8c[A\xf2\xc8\x1c\x04\xf1\x0e\nbW\xdb;£\x97\x10\xea\x1e\xd3\\\xdd4\x9c\xb4\x1e\xb2\xce\xd0\x1fc#\xee=xx0\x80V=\xf1\xd6\xd42\xfc\xef\x88'\xf1C\xec\xef\x87H\xc2\xfd\xfe\xfd\a\xf7\a\a\xf7]\xf8\xa5Y\xe1\xc4?\x18<xp\xf7\x9e\x9c\x1eq\xbe^wi\x97\xe3 \xa03\vF+\xba\x82\xebk\xaa\x83lpש\x1e+e\xd0ƨ\xf0r\xb6\x18ЄA\xedH\xd6\x02\x9a9Gl\x1b\xe8\x87UyW\x9c\xe0\x1c\xa2{\x02\x99\x10N\x06\x16q\xca\xfe\xec>y\x15\x14\xd5\xf9\x88p\xdd[\x8d\xd9\xff\x92\xb3\xbbF\x99\x9f\xa4ǞiN0hkm\xe2W\x96\xfb\xf2\xc7v\x17\xb5J\x96\xb8\x8c*\xda%\x15Ѷ\aT\xaf\xf7i\x9a~F\xd5z\xe9\x9b\xd4\x1e\x96e.\xbcb\f\xf7\r\x05\x8d\x00\xaf`q\xad\xd5\xcd[J\xe4\x95\xf3\x14r\x93\xf1\xaa4\xdcs>\xba1\xbcz\xb3\x05\a\x00\xd0\n\x9fn\x9b\x8a\xd5~\x9aM´\x19\xef\xb3lQ\xe0\x85T\x1bs\x11\x97&i\xb2y[1թ\xd4q5\x92\xb8\x15\xff\xb7n\x0fǵ\xf2qi\xf79Ccf-}\x01\x83\xb6.`ð\x89\v\xeb\xed\x14\x1a\x1c\xf8\x9dil\xe9\xe2\x06\xba[8n\xb6Em,\xf5\x17E\xff\xfd*L\x93\xe9F\x97J\xb9s\xc9.\xa5b\xc7艆\x10>*Q\x05\r&?\xf0\xa0X\xb8Ǌ\xb2\x96\x8bl\rE\xc5I\x81F&\xc0g\r\xb8\x12<\x01,\x13\xda\a.\xe9\xc08z\xed\xde\xec\a\xd3\xe7u<{\xb1^\xfa\x1d\xff\xc9\xd1\u007f\xfc\xf6\xf6m\xb1\x17\xe0N-\xb0'\x8f)$\x81(\xb7\xe7!\x04\xe6\xff\xf6oA\xa7\xebuf\x9d`\xa7\x9a[\xb5\x86\x80q\f\xcf\xca\x05\xbc\xc9\x1f\x13\xf1\x9e&gB\xccZ\x86\x95\xa8\xa4adI/\xd0]\xa5\xb7\x9d\f\xa7\xacQ_\nO[\xde &?۠?\x0f\x97\xbe\x8bg\xaf*qD\x12\x17\x12\x87~\xdemyd\xbbE\xcej\xbawds|\xa7.\xac\x9d\x15Ѷ\xba؞m\x145\xf71\xc9\x02\x03\xba\x98\xd2\xc0\x1a\x01\xd7\r\x98\xd9'4/\x93\xa2\f̱\b\xad\xe9\x87Q$\b\x81\x968=>\x914\x13\xd5+\x92\xbf\t\xda\xe1\x93>^\xcf\xfc\x05Ɣ\xd8\n\x86\xf4+L\x1f\x13v\x99y$\x170L\x99E,\xb6\xa9rT\xac6\xbc\x94+\fd\x97qQ\xfa\x93\xab 06\u0529.\xdd\x1f\x1b\x12\xf6\xd0\xd7\x1a\xfe5\x1a\xa1x\x02\xe6hE\x16\xd0y%ԟ\xd9:Ωo\xa9Wk\xffɕN\x89\x83\x0e\xe5\x01\x8e1G\xd0\xfb;0\x1b\xf5)\x94|\xdcɬ\xc1+\xa8\xba\x8e\xc0\xab2I\x96'妦-\b\xd6edP\x86\x10\au/\xa6\xd9\xd2 \xf0F\x93\x80p\xc1\xd4b \xaa\x13\u05cc\xf9\vL\xe1-\r\xd4\xe7\xc3\x06\xd2\xd7\rd7Lu\x8a\xcd[g4\xa3\xb9\xb8+\xa23\xb6\xbdߗ\x0e\xee\xd6:\\\x02Y}\x1e\x01\x96\x92\xdb|\x8a\xc5Ϝ}!\v7t4\x97\x94}Ҋ\xe1\xf7\xed\xd0:\xf5U\x17*\xa2\xd6;\xf4\x93Ν\xad]e\xb7\xc5;\xaa1\xa8\xbd\xc3\"X\xfe\xbe\x82\xc1\xee\xe3\x0fˎ\x90\\yGP\xef\xde\x1dy\xf8w\x97\xe1?MR\xbc\xce\xf2\xe9\v\x9a\x1f\xd0\xd5(.\x04\xf3\x15\x9eݖTY^>\xcb\xe6\xcb0\x0fqA7Q?\r\xcfF\xdb\xdd\f\x14\xa1\x02dC2,&\xf1\x02\xefڻ\xa7<}[\xa1ڹ\xae\xea\r\xf1@2\xecK\xe6\xe1\xc9\x13$\x8cU\xc2n\x8dA\x0f5\xba\xff'\xef)pP\xb2U^\x1fV\xd9\xe25Ϝ\xa6<_\xe7 \xc7t\xeb\xd7\x1eY\xcc\t\x91o8\xb7U\xb6;y\xb1\xbd\x14u\vRD\t\xf5\xa3\xffoڴ\xd20\x8aͣ`\x8d\xe6\xa70\xf7Wu\xab\f\x81\xda7fh\xba\xf0\xeft\xa9\xd3=ڪT\xe8\xe3\x02\xbdl\x84\xdd 9\u007f\xdc\xe8\xc5'\x99,\x86>[5\x88\xe5&.uY\xd31\xb80\xfb0\xd8\x14w\x06\xe3\xd6}\x1feQٗ|\xe9P[\xd0\xcb\xed\xc8(r\xf5\x8c\xa1l\xea݄\xf3\xb8\x10\x1ee\xf7\x8a\xbcwڽ\x1d{\x9f]ػ\x9dw\xefP\xe9\xecy\\\x17Z\xd8t;2\xd1nvT{\xef\xf2R\x06\xdf\x1d\xe5k\x94\xeaL\x19/\n\x99\x96\xa4\xac\x1bt\x1a\xc9\x04(2Ƀ\xf6\x01\x82\xc1\x1a\xd4f\x10r\v\xbd\x98\xd5ؐ\x17p/\x84\u007f\bz\xbe\x10\xac4\xba!\xe7\x917\x87?{{A\xfd\xde.\xc1\xaa2\xa7\x17g\x86\xc7*\xa5\xaa\xdb)د\xc7\x1e-\x1f\xe0\x8f\x8eN\xb8>p\xbfS!\xbc\xaf\xaa\x88\x15b\x96t\xbd\x8b\xc05Xn\xb0\x8bE\x91\x8f|\xf5ݸy\xa6 \xba\x8e\xf2\r\xbbh\n\xaa\xd1\xd9-\xd1\xf6\xed\xa0=\x18!1\xa9V\x17m;xUq\xbbr\ntp\x1dǋ¸:\xd7\xf5\xe2ʽV],\xecPx\x11\\Ƃ\xe2\xc6\xcbB\xe8T\xae\xf9\x87R\r/\xc2B\xa8\b!\t\t\x1e\xf7'\x91\x91\x86\x84\xa3\xd8&s\x13\x96H\xa9\xdfԣds\xe1\xd3z\xf7\x8e[T\xdd\xdb\xd3\xf5\xb1\xa8B\x19\x8a\fj,\x8a\x84e\xc19\xd5\xc56\xa6\xc2:v\xab\xc4Fy\xf4\x8a\xb6\b樦8Vo\x8eYRz\x82+.z\x92\xcb\xed\x96\n'\x93g!\xc2\v\r\x1b\x1d\xb4\\\xb1l#\x9d\xdc\xc9+'nc\x8a\xbcAG\x18\x92A\xba\x845\xae\x94MM\x12\xab\x9b\x9avu\xdbɆF\xa3(\xe5UX\x81\x98\x96hQ\xb3\x05T\xd3m\xd6Z\x9bԅ\xa6b\xb4\xc5\n\x8c\x81:~u_2\x9b\\\xf6\xc3\t\x85\x14y\fb\xafX\x81\x17\x0f|c\xad\xaa\x83V#D\xccB,|\xa0)_ \x91\x8a8\nSP[Z\x1bkne_\x13\xa7\xb95t\x9d\xce.!j\x89#\xedb\x9fA\xa0\xe6\xf5f\x045\xa8:\x8b\x98Q\xab\xc8^\x90\xdf.\x13PÒeA\xd5]\x98\xdeEuO\x8a\xec\xb8\xeeE7t8\xe0U]a\xa0m\xed\x89\xdb''\xed}Az\x10\xcf\x0f\x05:\xaf\x87\x96\x16F.\xd8\xf7\xa2\x15\a\xbd\xe9ҍ\r\x1c]~\x19\xf0\x14%\x19\xa8o1\x19\x1b^\v\x9e\xa6MƋR\xa7\xbd\xde\xe2Lg\x9eɂ\x8fV{\xf8ʟ\x8d\xab\xde\x14\xcf\x1cu\xdfG5ᒽ\aZ\xbc\xb5\xef\\\x98\x06&@\xbd\x9ft\xc2?\xba\x86O\xb6\xf4\xeb\x9du\xbbף\xfe\xa2`Jj\xb5'\xccJM\x85\xbc\xdbUH\xd4\xc4\x16G\xe2\xa68\xf5\xa7\xb4U飫\xbaV%\xab\xaeFjP\x1e\x84YA\xb9\xf8O`у\x97\x1c|\a\x9d\"h\x962\x95I\x0e1֔\xbe\xb3\x85\x8d\x854-n\b;\xc75\x98\xd3{{\x15\x8f\x8c\x15\x01\xb4\xeb\x11H\xa6\x10\xdd'\xb6\x8e:\xaaF\x1c\xe5\b^X\xc3N\x8e\xef\x8f\xf6\x88\xdcj Y*\u07b7\xcdD\xda0\x8elC\xe1\xfb\xa8ـ\xa8z\xcb\xc8z^\xeb53[\xa4\xcbI\xc1\x96]2\xed\xe5P\xa8\xd5)\x01h\x80\xabka\xf6t\xc1\xeb\x8a\xd6ɢN\xb2!u\xc7N\xb0\x06A\x94\xc0\x92\xa5\xd5xՅ\xb2kJ\x8ca\xec~\f\x9a\xd8\xd1Ɖ\xca\xfep\xb6FkHS3\xcc\x16l\x8b\xb2\x82#\x95\xf6\xab\"\"\xde\xde*\tq\xeb\xb9\xd3\xc1\x15\xbe\x84n^\xbcP\xb6\xb8\xab1\xe6C\xad\x9a\u007f\x02[\x9a\x98\xdd|\xc5\xc3yRC\xc3\xe8\x1dX&K\xf3\xea\x1f\x8f5\xec\b\xbc\xc9\xf3\x1cPA\xcf\\\xfbx\xa3~(\xe4\x94J\xbe_\xc5r\xdfLL\x11\xa5\xa6\xd3\xe5\x10>!<=(\xdb/\xcfc#\x84\x84\x04\x81\xc9\x00r\xc5$\x05\xbf\xa6)L\xe2\x1e%\xaa\x95\x8f\xd4\x00jm;d\x80E\xbc.\xedU\xa8>\xe0\x88RB\xe8\xa3;\x01Т\xb9\xe2\x12\xdccothځI\xf1m\xb2Hʘ\x01\fcc\x92\xc6a\xfe3 \xcdV|I\x88\U00017720\xefmXY\xe8I\x16\x97\xb5\x82\xc8|\xa1 j\x8e\xd3\n\x88\xfa\xf4\x8av\xddݎ\xb7.\xc8ᱝI!\xfaͪ\x83\xe6\xf0L&\xe3*\xe9(\a\x9e<9qH\x03\x05\xbc\xc7!\xf3\xfdb\x8a,\xdc4\x8a\a\x99\x01\xa2\xabu\x06\x8b\xe9R\xa1\x1do\xbe\x8fN5A\x00\xa5w\xa6\xedʐ\x14\xa0\xf3]9 Q\xa8\x84\x82\xa6\n\x9d6[^jl\xf4$\xf1\xca+\x1c\xbf\xbbRrq;CJ\xa9E\x81O\\\x81\xe6\x99\xe8\xeb+s9\x02lnϳU\x11\xff\x84Qv}\f\xea\x1e&\xb4}g\x1c\xb7\x15W\xe8\xf2\xacr\xfb\xd95\xfc\xfb\xe6\x97\u007f\x17a\xfcp\xf2V\x99\x95\x88C\xa9\xfe$\x8fa\f\x03(U`n\xf9Qd_\x14\xce\x1a\x9co,5%\x8d\xefƫ\xd9\xe1\xe1\xe0\xc1\x01\xf9<\x82\x0e\xf3\xaf\x93E\x94]\xf7\x8bI\x9e\xa5\xe9_\x91\f#\xe5Wc\xf8p#@g\t?\x18\x15\x8f\x10\xe3\x05\a\xb8\xd2\x03C\x0e\x16\xe7W\xb3N\xc0\xa7\x19~G\xc67\xc4\xc5z8.\xb2tU\xc6U.\xd8`\x901P\xdf\x18!\x91\x12\x8e\r\xfbiRι\x95\xa7\x833\xf8\u007f\\O\xbf\x81\xf6Ƌg?\xff\xe0\x9b\xe3\xd6j\xe7\x89wۇ\xd2\xfd)q\x18~\xe8\xa6-\xb2M\xac\xb7\x9d!gj\xe8tf\x10\xef\xfbk\xbaM\x8d\xd1Q\xffzlemT֯\x8d\"\xab#\x99\xa4\x18\u007f\xbb\x01\rg\xfejS)\x05\x80A\xe7a\x99'ku\xbd\xaf\x12F\x8ba\xfdd!\x82\xa1\xd8K\xdaSIQW\xd5~f\xebg\x8cCj\xc82 \xff\x06\xbdŒ\xc5\xec\x19\x91\xf9\x1a\x85ô\xd9N\xab\x06\xe2\x9d\x05\x8c\x83\x87}\r\xbf+<\x9c\x8fQ1\xbbU\x8b%4H\x8a\x03\x18cn:\xef\x8b.\xb2\xcc\x19\x9f\x8dn\xbf\xbe\xc0h\xbf(\xbe\xf4R\x8a>J\xd1(\xa5@\x9b\x98C7oq\x99P\xa5\xf0\x1f\xcdYݼ\xb7\xc9\xe5\x1fq\xa1'\xd0ha\xe5\xd2\xf7\x19\xe8\xb4S\xfa\xd9\x15\x80Nʉ\xc2\xd7\x18\xa8˧\x9fv\xcc/L\xebS /n\x06Z\xd9v\x9aϦ\xb6\xd1Z\r\xc8op\xc9C\x88w\v\f\xd6\xc4\xed\x04#\x02>\f\xe3y\xc0\xbb\xa2\xc9P\xf1DcF\xd7[\x0f*^%Ⱦ\xf5PK\xc0[\xceѺRo\x90\xf9\b\x8ah\x06+\x8e\x84\x04\xf1#\x9ed(+Z\x1f\xeb\x00끨h=\x94\x15\x18\xe7m\xb4\t\v\x84\xe3T\x87\x8dYc<\x11\xa8F\xabGQ(`D\xa0\xa5u\xa5x*\x9a%\xc8$NR\xc0\xe5:\x82e\xe0v\x9e\xaa@\x0eug\xcb\xe6\x82\x1c\x9c_u\a\xf5\x1e>\x9e\xa3\xccĮG\xe1{jgDyL\b\xac#\"F\xa7ϑF\x1f\x8a\n\xd4\x16 \xd8VtyX\x10\x83\x91j\x04\x86J\xbaލ\x13N\xebz\xc6\xcd\\\xd4\x10H\x19#\xa8_\xdae\x14\x8e\xcb\xc5\xead\x84^¡\xd3\n\x17\x0f\x8c\x92\x06K\xaa\x99oa \x10\x05%\x1e\v\x81y\x11\xda\u058b\xd4T\xb7ˀ\x19EO\x95`\xf2\xfdu\xed2\x83|ᇨ\x137\x15hh\x92R.\xf5\xdd\xceM\r+\x15\xf27\x81\xb1\xe9\xc8Ź]z\xf1\xf5\xae{\xbc\x95\xf8V\x92\x8fË\x1c\x9c\xb8Wl\x86(\xf9rPB\x8c\xfe$B\xa8\xa4\xaaI\xa0Yߴj\nX\xddP\u007f]\x8bB\xae\xde\xf3\xbe)\b\x84\xcbU\x13\v\xf7\xa74\x92J\xaa\xe5\xb4@s\x93F\x9a\xa3\xec\xe6\x04X\x17\xfey\xa8\xe8(oB\fn}\x16:\x19s\xe7V\xb7\xa6\xcd~\xc6\x12j8ϛ\x90~˗~o\x8a\x99\x8b\xb5\xa3\x17\x93\x83\xc3=\xc1=\vZռ\xc2I\xf1\x06,\x9adˍ\xbb:w3vP\xf7\xae\xc0-\x06\tm\xd3\xc9k\x8e\xd8JI]\xa1\x1d\xeb\xfb\x12\xb9\x03\nlx\xa2\xa9#\u007f\xd0\xf0\xc0/\x8dF\xfc$*;[)yE\\V\x82\x1b\xad\x9d\xc1\xf5\xf8*\x82\n\x82\x18գ \x0e\xad{\x94k\\ۨC^\x9cڏ\x9c\xe3ļ\xf2 l\x00\xdch\xe7\x8b7\xebZL=\xb4\x01vAŶ\x82\vS\x8b/\x88%\xc9l\x03V\x82\xaco\xf2\xb0\xe5w\xd2`\xd1\xf2;\u007f\xb8n\xa0d\x8c4ғ\xbf٦\x8d\x97NN[\xe1&\t\xcb>V\xad\xf3\x1b\x8f[s4\"\xe6\x90L w\bce\xd7a6^\x18\x19\xde\r\xb8.\x0e\x1c[\xf93J\x80\x03\r\xe0\xae#\xff\xbe\x96/\xaeҩf\xc8\x06\x10\xab\xab\xd4}*\x10\x184Ul8\xd1\xdbY%\x9b\x85\xf0R\x1fU\xea\xf5\xef\x1a\bF\x88\xa0B+OH)s\x97\x1e-꽩\x8d72\xbd\xaa\xd3\xd2\x1d$\"إҚB\xac\xd5̑\x00\xfcN\xb7o\a\xf9蹤b\a\xc2D\xe0\n%3t\x03l\x18\x90\x1b\xfa\xb4M-Hc\xf2\x06֞6,V\xd0=\x06\xa4\xaf-\xe4\xc4O\x8al\x81\xbe\x1d:\x18\xd5\u0081\x96\xe8\xd7\xf0,\xd85JL\xe2\xaf \xb5\xd5\xc7\xcb2\x9e?\xbdm\xa7\xc2-\x05\xff\nG\x97˝\x8d\xf9\x9e\xdcT\xa4\x9d\rf\xc6%\x86\xbfR<\xb2\xd7G\xd5\x12\x8f#\xf8\x06U\x10U\xdbF\xe3\xaa\x1a\xc0>\x9a\x01\xde.phk\x11\x98V|\xea\xeb\xec\xb8\vZc\xabλ\xd0b\x8f$\\\xacލ\xb2\x8c\xf8RE>n\xebQ\xe9?\x04Cb\x9c\x14\xbc\xc1\xc5=\x85\xd7\xeb(\xa2S\xafڙ\x95\xfd\x0f5\xf8+\xfcg\x17)\xc0\xf1#\xa7R\xf8]w\x91nXM\b\xc9\xe1\x18\xc0\xb5%Œ\xb6\xfa!\x13\xb5y\xfb\x92\xa2\x8e\x1a'\x00F/\xc0\xad\x86\xfc\x0e\xeb\v\x81\x9e1\xf8|o\x02\xaaU]\t\x94\xa3Չ\xea\x9b\xefb\xeb\xfcZ\xe8\x8bM\xf8V\xa6\xb3\xa3՞U\x15\xaf`\x90\xd1m\v\xba\xddLC\x13\xb3i(Z\r\xec:W\xfe;\xd3P3\xa9M\x11m\x9a\xfc-\"\xf0\xc0G :5\xfc@\xb9<z1\xe6\x1buX\x12\xd8\ue5c9s\xae\xa4\x90A\x17\xae\xa9\x97\xf4ꊶ\"\xaf\r\xe8+3M\xa9\xd6\xca;bFNDF\x9f\x9b\xe7\xf2\xd4\x0e\xf1\x16\x04`J\x02\xc39\x80\x9c\xf8\xc8\x13\x0f\xa4\xe7\xe2\xd8\v\xb4\xe78\xa0\xee\x87\xc7\xf0\xe7\xb1\a\xe6\xc7e\xaf\x17\xd4qa\xecd\xc3\xeb\xd3ڢ\xad*`\xf4\xe4\xf1g\xd40\xc4\x1a\x1e\x91\x85s\x89\x99۪ho\x90檀\x95\x90\x1b\xe21\x978Mp\x80\xac\xa4ס\x05yA~l\x88X\xba<r!և\xc0\x82\xabc\xef\x02Y\xa0\x15\x94\x12\xc2\xe5D\xa0\xf6\xba\u007fbu\x02\x82p;/\xd8\x16]\x11eh\x17G\xad\xa9,\xae\v\x02\xe3\xdc\x12\xea~\xa8\x14\xcd\xd4\x007\xe2\x02\xa2Eӧ\xd7\x02\xf6\xf5a\x06\xdaH(+\x8eU\xee\x94F\xd0H~L\xf7\xcc\xe2\xdep\xa4\xdb\xca\x14\xc0\x1c\xb3\xf4\x1c\x1c\x16h$\x1f7\x04\x18m\n\xf8\x8d\xa20%\x1d\x1f\xa1\xa5\x84c\x12gJ\fI-l8\xac\x8e#\xb4\xd7\xc3w\xdex\xad\xa9\xa6\"*\x84Z\v\x15\xa53$\xe8\x0e\xeb\xc7\xd69oY\xbbǮž\xaf\xc2o\x98\v\xbbV\x8c\x8b\x1aƞ\x86\xf2\xb1B\xd9\xdb\x15g5\rBϭ\x97\xd9\"\xae\x0e\xdbv\x9d\x9e\x01ǲa~^\xea\x82\r\xdf?\x91F\x14\xd5\xe0a\xcb\xf5\xd8\x01\x817\xe9\x15\xd4'L\xec\xe3\u007f\xd0\xcc>\x0e\xda\xe6cdǧO\x86;\xee/\xe9MC\xd9\xfeB\xfbL;U\xd3lT\x18ۑN\xd3®\xc0\xb5\x17\xe5\xaaS\x8a\xcd'u\xab,\xackR%%&E\x9a5\xd6&\xe3h\xdf\xe9\xb0;J{\x03\x87x\x9b\xe3\xb3ԟf\xf6J\xf5g\xd5\xfd{\xea@ɍ\xa6\vR\xf5\xd1+\xf5bO\xed\xd2\xf4\xd6\xe4SpdD\xfd\x88\xb7\xaf9\xb2<\xc2{,\xe6\xb23\xdfY\xb7\xf12\xca\xf7\xe9\xf6\ay\xb2\xaf)\xa02\u007f\xa3S\xfaZ\xae\xcfآ\x01%\xc3{r\xde\xd7\xc6\x12\xf4\xac\xe1\xcae\xbc,|uN\x1a/\x1d]&6\xea\x8d\xe5-\xeb\x1a\xc5\xc0\xc41\xa7\xf2!\xab\xda\xccIj\xfe\x85ձ&\xb6E-\xc8\xe9\xcf7\xe1\"\xfa\xb2\xc7+\x9aU.n\"\xdb/\x06\xd5_R[\xab\xc5\xfd:i{\xe8\xe86\xf7\xceyX\xf8\xeb\x84\xd6V\t\x86\x8f\xd6\xfa,\xb1:-qk\xe4S\x96\x96~)C\x90\v\x89\x17\xc9\xe1\x1fz\xfe#\xfbE\xf7\xfeeB\f'\xd7\xf2HmSW7Ď\xaa\xeb\a\xa6\xf9\xb4u\x06\"T\xe4Tc\xccC\xe8\"\xc1\x0fw\xefb\xdd\nP\x9dr\xdd\xe1`\xad\xfb\x1a\xac)\x1c\xb8ؤ\xf5\v\xf6| H\xb4e|e\xce\x0e\xbb\xf5#x4\x1c%Y6#y\xa8\x99E\x1e\xd1\x11\xaf_\r\x95lI\x11/\xe96\xb01|d\v S\f\xd4O\xee\x17f\xe6\xef\xd8;XyC\xe7t\xf1\xc05\xce\u007f\xfa\xac\xaej\x00>\b\f\xe4\x14\xea0\xaa.\x92Jw\x19\xda\f\x13\xfd\x8a\x0e\x0f\xa2\xafe\xcfK\x80\x9eg\xca\x00y\xbePV\x9b4\xd8\x12 \xa9ߣ tF\xdb\xdd\xf2`u\xb3^\xc2\xeag\n[\xca\x14\x05B\xb3\xbb7\x04\x85,\b\x8c\xf4\x9c\x8bS&\x1băz\xf3w\x94\x0e:\xa4\xfa\xdfFD\xb4M\x9bϒ\x16>\xe6\xa1;\xd2:\x1a\f\xadހ\xc5<ti\x923\xedH\x8f\xf1\xe3\xabC\x9f)i\x1aN\x97\xd0\xed$u\x95\x94\xfcΊ\xa9\xd5\x1e\xb5l\x8d:\x86\x17r\xb7o\xbbM+\xf6\x01\xe5Ԍ\xdbo\x9fe\"7\x18\x8e.\xeb\xb8\xc9<w[\xa4\xefW\xe1\xa2\x04\xa3\xc5ļ\xa3\x0f\xd3eu/\xd7:\xefx\xaf\x0e\"\xcc[P\xe59`:\xcf\xd2\xc8\xdc\vUv\x13n\u07bd\x0f4\xb0\xd3K\xde2\xa3\xa3\x80\x1a\xb1\x97 \xb1\xef?\xc3A\x88}\xd4\xe9iQ|\xa2`]\x05C\x87\xb4c\x97\xf1]\x1dHT4b\xac\xa2\x9akf\x95\xfd\xa5=\x83D\xe0\x87\xd6=-\xf1\x80j\xa4]\xec\xe9c\xcc\x06_\x8f\xb9\xf0/\xe2X$\xa5\xa0h\x1d:6\xff?q\xe05\x8c\x8fOt\xfa`l\x1f@\x06\xd1or\xd84\xda\xda\xd7\u007f\xce\xc70\x12\xc3m\x00_\xc2\xe1\x10\x97\xe8k\x19\x04\xee\xf5^}Pӻ@\xfa\xb8ŉJ\xfal\xaa\x19\xc1\x86\xa9\x9f\xc4Ն i\x8b\xb5z*\xf5\xb3\a\xc3){\x9e\x0e=\xa5B\xc8\vuo\xad\x1eu\xf1\xd8\x1d\x15R\xd6u\x1f\xe1\u007fv\x11\xbf\xa9|\xd6$\xea\x13\xe5S\r\x9a-\xd3A\xbb\x80:\x0emM\tl\xea\xfew\x9f\xa9\v\xdf\xdd\xc4\x1e\xf8\xa4\x8a\x9d=\xbbk\xb5\xbb\xf7jS?4\xce\xf7\xcd]Z\xbd\x82n\xb8\xd8+@\x95_\xef\xcd=ӕ[\x00V\x1b\xde2\xe5\xd8\xccWݡR>}$\xb5\xba\xc3J\xfc\xf6\xbce\xd2\xf5\xd1\"\xefw\xf6\x924\xf0~\x11GIU\xc3\xee\xf2cw\xbaKnt.\xd5D\xe7j\xf6.\xcc'\xdf/\x16q\xfe:\x8c\x92U\xe1x[=\xa9r[p\xfc\x88\v\x99F\x1cY\x95ۂ\xe3\r\xaeW\x9e.f8\r\xd7P\x14*\xb3\x05ËE\xd4T>\x16YM\xa5\xb1\x9b\xfce\x9e]\x98\xf1]\x14\x18\xe5둼t=\x19\xcf\xe6\xe6\xe3\xe9\x9eL\x13gΝ\x1f:\x86\x9f\x91V\x93O\xd7~\nt\x1e\x88\x17\xf8\xa0uP;\n\xa6}+\x81O8#-\xe5\xee\x98\xf4P\x92\xfb\x95H\xa2\xb2ϣ\xae7\x15\x01ΉXt\xb6\xc4c\u05cd\x99\xb6i\x8c\xd5i\xc6ى\xe2i\x82!\x0fT\xe4$\xbe\xb2\x8eU\x9e&g\xf58\x13L$s\xe0\xd4ۛ\xae\x8d\x92\b߅\xd4M-\xd5;\xab\xbb\a`\xfd\x02\x9fT\x1ezU\xaa\v\x8ek\xf5\x1bK\x90z\xe0(\a\xe2\x1a2\x15\xa7gfl6<\xa9R\xf8\x89\xeb\x0e\x1e\x16T\x8f\xba\u007f\xb4\xdfR\x91\x82\xf6W\xf5(\x8aL\xf9\x15o\xb5\x12w\xe5\xcd\xf6\x95\xe9\x99f\x82\xbf\x14\xe7\xa6\x1a\xc0\u007f\x897\xa6\x8f]\x9f\x02\x86\b\xa1\xc27x\xef\x8b'\x18\xa0,]\x80\xbb\xf9\xbc\xa8\x8c\x9b\xb5c>D\xbc\x86\x06\xa2\x8a6\x9fT\x91\xba)\xbfٹ\xa2\x8a}\x9f`hpY5\x01)T\xbbU\xddp=\xe0\xdd'\\\x0f\x80^4\"\x16q\xe0\x9fwVП\xa0\xb5\xe3\x89n1jl0\xbfQ\xa4\xbeW\x19Y\xce\xf1\x93\xdeѩSM\xea\x02\x14\xac\x9d\xf8R\xc9\xde\xcdy\"ʪ\x1d\x05\x85jk\x97X\xe9M\xca\xfe\xaf\xf5IB\xac*\x9aJ\xfc\xea(1l-\xf1Rz)\x90\x867\xcb\n\x9dÊ\xe3e\x83\x8b\xae\x81\xe7Y\x9aaxh'\xb6\xe6J\xf7\xbc\xce\xdf:m\xc8ߔ\xf1\xf2\x9bx\x9a居Z\x1e\x85\x89\xbd\x1fCIv\xe9YoN\xa2\xad\xd3e\x88ϧ\xe1uV\xfa\xecta\xc2[\xe2\xde\xea\x99\xfb\xb5\xe1%\x1d\xa9\xd2\xdc\xf8\v\x80\xfa\x1a\xba\xe4,\xa0\xf3\x98\xcew\x84c`\xb9\x06SI\xa9o\xb75\xec\xe9\x147M\xfe1\xed\xfa\xce\xd1.\xc2\xf1\x8b\xc0\xf1\x89\xedz\x16\xf26\xe1\x8f\xcbXZ\x0f\x95\xf1\xe0\x142\xb59~(\\=ݢr$)ŇRu\xb8\xef\xe2|\x8e\xf1\x1d\x04:\xf6Z\x1bv-\xf4x\x18\xd6u\x11\xfa3.\f\x00\xb0F\xecN\xcd4\xc4~׆\x1e\xec\xd6\xd0ACC}\xddtQ\xb0\x81l\xf1\x96f\x9eJ\xf46\u007fF 6`\xdf/&\xb0\f\x90\xed9\u0558~\x16ܐ76W\xf0\xb6R\xa6\x85\xc4\xfa2ܑ\x95\xfen]m#.\x05\x16=\xac\xacLӼ\x10qz2\x9bv\xfbĳ\xe1\xfe\xfc\xe7\xed0{x\xc4\xe4Xe9\xf9c\xdbv\xefWad\xab\x11G%J\x95tp\xf4\x0f,-S}\xa2\xe6\xe3\xf0\x18\xa2<\x01P\xa0\x0e|\xad4\xa9b\x95 W\xb0\xf2\x8at\xaaa\x0f\xaa\xf8\xafx\xaf\xc4G\x95\x85\x91d\xc4\xeb\xdb#~\xaff\x0f\x95\x18g\x0fE\xf6Б\xbd\xe4\xee\x97\x1f՞\x9aN\xbb\x96\x8a\x84\x8d\xecK\xe16\xa3\x1f\xebQ\xccJ\xbd\x91\x1a\xa6\n\xfd2\xd1\xf0\xef\xed\x1d\xdb\xcd|\xc6\xed\x180\xa9%?\xe7]\xb5o\xc0\x8a\xac\xe4WƵv3[,h\xc1\r\x03\xb6\x91\t\xca\x1d\x99\x9bM\xae\xcaVc\xc9i\xb8K\x84\x1bQ,\x9cͳ\xb8\xa1g\xa8־\xf9ݨ\xb7ߎ5\xa5\x88\xee\xad7\xf4\x82C\xc0\xf6\x88\x96&\xf9\xda#\x9a깩A\\ZQ\xf7ў\x13wу\x8djG\x8f\xc4#`Ģ\x19\x9f\x1d\xa33K\x05J~\x16K\x1cdCkt\x8e\xcc\xe1Ic\xd0a<\xb8-\x82\xbf\x1b\xe3F\xed\xa1s%\xea\x15@\xbd\x8e\x8a\xdfJ\xa4\xc4\x1a\x1a\x9f\xca\xf2\x97#\x16\x01\x92\xfb\xa0\xab\x12\x87\"\x11E\xe0\xccu\x05Gbk\xe3\xe87a\x91\x14\x96\xc1T[\"\xf31\xfbn\x8aR\x0e\x92\xa1\xdb\xdaJLV\xd3\xfe\xfe2\xa1\xdf\x1b\xf1\x1bY\xbe\\\x93\x11Ɓ(\xf8?_/;$\xdb\xcaC\xc8\rAn\x06]\xf5\x1f\xe1\xf0tc\x0e˓)\au\x9cU\xf1\xdet\x1e|\x13\u007fH\xd0t\f\x89L\xf4]\x90\xdb$\x8d\x1d\x9c\xb8\xbap\xb9\xee\x17\xe7\xc9T\xdbM\x80\x14\xb6+\x92ʶ\x85\xd4M\rn\xa3\xe0\xb4;\x1c\xbb\xd3)Ʒ\xf6&\x8cA\xb96\xe5\xfd\xa3i\xbc\xa9\x05LH5\xf3\xb7MP\x0fo\"\xa8R@\xacM\xb5\x9a\xd0*\x81\x1ch\"7p\xae\t\x0ev\x10\x107\xabk\x8cefU+\f\xadAϳ\xf2\xd0w\xb3\xfd\x00\x89\xad\x14\xef\xee\x856r'\xb2\xd7K\xfe\x15%\xff\x93\xa4ʹȖ\xa2\xd1*\x15\x180\v\x83\x9e\x1d\xea\x02\xa2\xa4\xc3%\x1a\x87\r\x1c\xf4\xbe\xf6\x16\x9f%\x1e$\x8e7\x91\x0e\xa1\ro\"\x1bR\xd8\xdd\xc21߱i\xffZ\x02\xb2ZDi\xdcfc\xd4\xc4¼\x04\xbc\xb0\xdeC1V\x01ڜ\xa7\x928~S\x95\xb48\xe3\xa9\x1f\xe7\xafhc\xa4\xd3쏳\x9d\x14\xd1%P\xe8\xda\xd7?17\xf6\x97\xae\xa1\xcb\xe6h\x02&\xd1B3D9\x06\x80܊\xbb#\xedKӊB\x8f\x04\xbc~^R\x14\x86@/>\xb4\x8b\x0f\x9d\xc57\xaa\xf8\xa6-j\u007f\x93\xd1\xd2փ$ՎWKC\xb6`\xc7ܠ\x90-\xd61\xd3\x17b\x14\x02\xfc\x1a\xf1\xd7\x01\u007f\x1d\x9cm\xd5%\x86\xfc\x81\xf8\xa9\xe8B\xda&ѳ\x9d\x87\x1d\x86\u07ba\xd9@\x1dv\xe9\x01\xf3\x1b\x94\x18ݸ\x8eэ\xeb8\xb8q\x1d\aXG\xfbV_\x9a-a`\x92\xedn?¾\x1c\x1a\x96\xf1>\xa5h\x06t\x1b^\xbe\xaa\xfb<\x99N\xe3<^L\xe2\xa2y+\xf1\xc25\xf4yV\xa0Y\xc0^\xf1\x0f\xcd5\x05n!\xccy\x805\xb5ˡf\xb5\xd0\xc3s\xbc\xab\nK\x9a\b\x87T\xe4FC\v\x10\xb3\xee\x04\x1f\xfd=\xc3\v$\xfb\xe6\xf2]\xe0\x8b\x8c=\xcay\x1b\xb3~\xc8\x16Y\x99-bk1ֲ\x06\x8b\xf8\taz\xac}n\x92\xdc\xc8\xf9J\xc1]4+\xdb&&\xb9\xf9\"\xb5_\xd7b\x8a~\x12\xc3\x0f\x1a\x8f\xe9t\xfb\x11^>\xbd\xa7+Q\xc1\xac\xb9(\xa9\xbb)\u05ee7\x87\f\x881P\xb4\xd0\xecc\xbd\xb8\x91C\xaf\xd8\xe8O$\x9b\xb7\xb9\xf1\x91\xf5\x87\xd6a(\xb6\x12 \x0f\xe4ELzf\xb90\xceF\x05\xc5\x05\"\xb6\xd2e\x13\n\xb3\xb2\x8f\xae̓\x86\xc5ŉ\xcet$G2Yy\xb2]`?BE\x81\x98ь|\xf6zKhG\xf9\x8c6:`\xd4ޓ\x0f\xc1\x13\xe9w\xe8OuVn\xaf\x94\v\xdc\x1c\xc4wi\x18\x98??}Y,e\xbb\xf5,\xe7\v\xee\xaf6\x8d\xadV\xed\x85\x1e\x17j\x8fذe1\xa5\u0558ͻR,\x1am~=\x10\xaan;\xe42Qs\xd2\vU\x9a~\xb4\x80~#\xd3i\x11\x97\xc7:NV\x82\xb9|\xe2\x1a\x1f\xb2\x0f\x03\x13bh@\xe0C\xf6\xa1Ӱ#Қ\xfdE\xe2\xb0\xcd\xe3\x83\xf2\xbf\xa0\xc7\xc7P\xdb\xec\xefz/\r\xe8\xd7\xc2\xf7\xbeVhP\xb9\xe5\x1b\xc5;\u007f\xeb\xec\xee620\xbe\x86;y\x91\f,7\x92\x01\xf9\x91X\xa9\x1bJ%\x8fL,p\x82\x01C\xf12}ݕɫ\xb9\x14|\xe4\xa8#\xaa\x82!U\x80\xa86\x8cj\xb3\rզ\x01\xd5\x06Q\xa1\xf9\xf5%\xbd\\\x06R\xf1\x90\x8b9\xf0\xcb\xe1벡\xac͠\xdd\xe1Eb\x1cj\x8e3C\xb7\xe7\xcc\xf0\x86\xae3\x83\x9b\xfa\xce\f,\xe7\x19ϐ\x99]\x9cj\x06_ҫfPs\xabY\x0f\xeb\x9e6\"\x82\xeef\xf8\a\xb9\xdc\xd4Ǳ\t\x05\x83\x1d\x8f\x81^v\\\xde9\xa8u>\xd5;ghxI\x13+lo\tDoxKp}\x83O\xabp`T\xb8c]\xc3\xcfm\xdc\xee\xed\xfaD\xe7#U\x15\x89\xce\xe6\x06\xf5}\x1a\x1f7\x03\xa3\xc2\x1d\xeb\x1a~n\xe3vo\xd7\x1f\xe2[\xe5\xae\xfa\u007fcߪmzD\x9a\x02\x1c\x1b\xa0\x16a\xf6\xa5\x05͞\x01\xa0^\xc14\xc1\xa86/;;u\xc0\x1f\xe2\xc4U\xab\xdaJw\xd9o\x93\xf3,\x8f\xded\xab|\xe2\xf4\x17\xa6\x8cֲ?\x03\xddq\xe9([RFk\xd9FW\xe7\xbc\xd5˙i\xfe<?g\xc2\xf1\x19\x9eΰ&\x98e\x8b0\xfd\xa9\xb2-m$\xa7\x80\x06cL\xc8\xc7\b\xda\xd0\xf0\"CCV7\xa9\x9b\x1f'\xa60'\xb86Pe\x1a\x9e\x86\xeeҲ\"\xa2\xad\x93\x90~\xb5.$T;\xaceD\xd7^5\xa8\xc7\x16\x9a\x9aYl\xe6\xe3,}\x83wo\xac\xe6\xdc;l/\xf33\xe8\x17\xbbLg\x92\xe4\x934\ued17|F@~\x01\x95\xea\xab6\x15F\x9e\x97\xf3\x90+\xd7\xf7?}o\xba\x92u~\x18\xd0YO\x8e\xa7>Oկ*\r\x96\xc2\xc3\xee\xd0c\xa8\xden`y\xbb+a\xb8N\x8a\xbf\xeaϟ\xaa\xdb\x1f*\xad\x1f\x96e\xeewJ\xf9\\F\xa7\xeb\f\xd1%\x1bAp\xb4v\xc2\xea\xd7\x1c\xa3\xab\xd3\x1d\x04\x1d\xfdy\xab&Z~\xd5i\xd9\xfc~\xb4036\x82\x9a\xddhy\xb3\x1aG\xc9U\x12\xc52\x98\a\xddN\xd1B\xc3\x16\xab\xb1\x1d:\x105\xeb\x1c\xbd\x97\xf4\bs\xa6\x03M[lB3f\x10^\xf7\x16U\x98\v=\x1d9\xafs|\x8ea\xc7\xee$\xf4\x93\xf7_\xf6\xf6\xe6]\xef\xa2\xeb]m_E\xa9\xe0x\xf3c\xaf\u05fb\xe0X\x80\xf5\x87\xff|\fT\xb8\xa7\xe2\xec\xf5\xbc\v\xdc\xf3\x0f\xf0y\xab*\x9c\xa1\xfd\xe6\xa1h\x85x\xb72h\u007f|\xf0\xa3\xee\xad\xd3\xeb%\"\x88\xeb\xb1G1Q=b\xafEŞ\xa4\xe2Q\x15\xc4\xd8$\xbe\x89\x04\xc7\x11\x85\x04u\xc9\xc68>\x0f\xaf\x92,\u007f\xf7!\xcb\xe6\xcf\xe3\xb4\f}\xe3\x15\xf5\x1aHreDM\xb73\x8dw\x82:\xf8>P\xa7z \b\xa4\xafz\x02\xe8*)\x12\f\xfb[n0p\xf8y\x12E\xf1\xa2\xf9\x81\xa0\xf38\x99\x9d\x97F\xd2u\x12\x95\xe7FJ\x06\x86\xc84ͮ{\x84\x92_2j\xaa^!\xec\x8c\x06\x83\xc1r\r9\xf8\x84\x9d\x1f\xf4\x970\xdd/J|3\xdf^\xe9\xc5\xda\xd3\xe4\xf4\xfeW)\xcc\x05\xfd\x99q\aSģJ?S\xf8\x86a|p\xdc\x02*\xdf:\xa6\u05ff\xfd*\xde<\xd5\xc6\xc5\xf1iжJ\xe4kc\x13\xc4\xe3q\xb4\x05\xad\xd3\x04\xa2\xb8\u007f}\x1e\xc7)\xf59\xdan=\xbc\xbcP\x86I\n\x82w\xd7\xf5։l\xae-Bi\xb8\xc9V\xe5\xbb1\x1dK\xfe\x04\x13\x01\xc6\u05fat=\xaf\x83\xe9\xc2$\xeaz1EH\xa0$\xb6t\xba^:\tY\x85\x18(_\xc6a\x81\xef\xfdϳ\xc5S܂\xc7\xcd\x14\x117\np\x04\xfa-$\xfd}\x1d\xa5\x1a8\r\x1f`\x05\xfc\xda~\xb0 \x8a_\x90\xe5N7\xf6\xf7\xc4\xd0\xe2 \x1a\xb5\xfbŭ^WضZ\x85\xd2myI~\xcb\xfc\xecl\xbc\xa8n\xc93C\xf0IT\x9d\x98\x1d\xb7\x12\r\x8eI6\x15\xf4N\xa3\xde\x13\xa1̑{oT\x93|\xc9Q\xafW\xb4D\x00ܖ\xafU\xcb\xc6(D\xcc$\xaaG6D<\x0ei\xb1T\xd6\xc4?\x1cmlF)\xcdp\t\xb0\x95\x01.\x91\xd1\xcf[)h\v\xad\xb8\xc6j\x95\x10V\xfe7!\x0e\xfe\xc2!\x8a\x15cш\x1bo\a\x1b\xa3c\xdc+\xe6\ac\xed/\xf1\x81*Q\x18R\xc7Fjq\x0el\x89DV\xb5\xd9$:C`:\x11\xa54Y\u058b\x85\x95\xea\xf2\x9c\x95\xcb<\x17\t\x0e\xa1\xab\x90\xb7\xf0\x1d\xe6\xb6I\xfc<\x0fg\xfc\xf2\xab\xb2]\xa2\xfe4Y\xc3z\xef7ᖻ\xad8*\xeaZ\xe1?\vg\xe3\xb6\xc2?\xe0\x83m8\x018\xeb>ܭ\xf8\xaatV~\xb0\xad\xf4\xd3\xc9d5_\x91a\x86N\xac\xd0\xe7\xe9\xf2\x1c\x04nr\x8e\x9a\xcd8\xf4\x98\xacy\x1fp\xb2\xa9\x8e\xe7\xb0L\x9fa\xabD\x9a~)'\x8déin-\x84\xe0Q6}\xb0\x1dE?\x95\x1d%-\xac\xc9v[i\"\v\x1b\xde\x17H¤z\xa9\x1e\x1faK\x16\xab\xd8xw\xaf\x81\r\x93\x1a\x0f\xaaRzs\xf7N\xbc\x89\xf8]\x01\x00\x8f\xb4t\x98\x8f\xe0\xe7Z\xcb\xdeԳ7mN\xc5\xfd\xa5\xf9\x84b\x13k\x05i\xf2q\xbe=\x19`(\\\x80\x05K\x0f\x91ʇ!,\xd8M;\xecG+\x8c\rq\x06\xe9f֜j\x98(R\x9f\xea\x02\x8bS\x15\xdc3),\x97\xeaA!\xa2\xf7\x12\xb0\xeamP\xb9\x1bG\xeeF\x1f\xec\\\x15\n\xe7\x04\xdf/\xd1j\xd6e\x14\x85\x16\xfe\xa9\xe5\xb7\r\x8e\x970\xc5?O@/,8b\xfa\xa5\xb5\x1a\x1d\rv\xc1\xf0\xa6\xccI\xac]\x18ڔ\x03\xd4;\xb9tܲc\u07b4\x15r\\\xb4\xebo\xb6\x15\xfa\x11U\x88\xf0\xf9\xad4\t\xef\xe0\x8a\x81\x1dц\xf0vLy\x14\xe7\xf2\xf18\xfd\x00\xb0\x16\x1e\xb3:%\v\xb6\"\xa5\xfd\x89\xbf\xc5yf\xa0\x94/ \xf4\x84\xab\b\x9d2\rΔ&\xd9\f\\\u0383\x17\xeca\xb7\x19\x9c^h\xbe\x06\xc6nr\x1b-?\x84\xeb\xefQ\xda\xfd0\xcfÍ\xed\xcb2TO]\xe0\x9a\x82@\xa4+\xda%+;J3\xac/-`\xfb\x02WW\x899\xe4\xfdK\x85)9#/\x9c\xc7ޕ>\xfa\xb1\xc2D{\x81T\x1f_\x8eu\xd5Ŷ\x16\xbe\x8e\xa3\xd5$~\xb3\x9a\xbbv\xe8(ϷJ\x00\xac|\x05\xb5\r1\xa2\xc4\x17\x88m\a\x00܋j\xb8\f*\x8a\x9f\xc3@\xccfy8\xff&Y\xbc\x81B\xa0~\xe4\x03yWa\xba\x8a\x1d7:\x1d%\xbfřQ\x96\xab\x9e\x04P\x91\xb8\x19\x95ڇU\x81\xb8G\xe4k\x11\xecH\x9fQ\x8b\xe1\xe0\xb8\x16\xb2\x8a\xee*{\xd5#.(\xba\xbe|\xc9\x054\xf0\x18\xab^p\x80\xf7\x9a\xf4\xae\x85\x0f\xe2\xf4tMN3\xa0\x1b\xd7\xe8\xdbb>\x00\xb3\v\xa9\xfc\n\x8e\x93}\xe8\xffJ\x8e&\"\x97\xde\x1bD\xc7\x12\t\xdd\xdeWq\x1e\xe6\x93\xf3\x8d\b:\x9c\x8dqw\xb2\xeb\xa9t\xa5`\u052b]5\x10\\\ag9\xads'\xd0\xf2\b\x94(\xfe\xa6\xea\xe5Y=\x17\xea\xa3b5\xadY\x85\x05\x15pa\x00K\vĵ[\xe5@\xf0\xfd\x02O\x17p\xa7'_\xd9\xf1\xf1}\v%\u007f\x06~\xe4\x8c$¹\xbbp\xed\x99h\xafc\xf0IV\xec\x82\xe6\x17d\x95\x03\a\xb1p\x17\x04o0\xf4\x99\xc3\xdbs\xcc(@N\xc3ݑQO\xd0ڨ>R\xfb\xf3\x18\xc63g\x9aQ\x92y\xb1U\xdbO\x14\xe9\x8a\x1d\xb8\rpzfEX\xa6L\x87\x9f\x83qvOk\xfa#\xb1\xb6\xeb\xea/^\xd0\xd2\xfe\xc8#,\xd5\xceX-$s\xabRX\xa2\xcak\xe0b\xa8\xb88\xde\xcaE\xc4\xf3\xfd\xa2\x88-Ld\x96\xe3\xd4\xd0'\x88w\xd5k\xe0z\n.\x948u,R\x97yLs\x93\x99*`\x85\xd1=1a\xc7\xdbZɛ\x03:u\xbb\xd3\xd0\xda\xea\x12\xcf\xe8&eQk99v\x8f\xfbk\x92õp\xe8\x1e\x83\x8d\x82ߠ?\xa2\x9cX\x83\xfb\xee\xe3~n\xc6F\xc7c\f\xf8\xa7\x87H\xd0\xcf\x1a\u007fm\xc8e\x1a\xa6\xd6\xfe`0\xdc\xd6\xdcd\fzaf\xeeS(\xd81\x05\xf6\\\x18+\xdd\xf5\x0f\x89\xf1\xd6;\xed[`\xbd\xf4#\xef\x12\x80Zk\xac\xc1\xc6\xd0\x1f\x02\x11\xd0{\x1atX9\x87o\\\xb87\x1a\ue34e{\xe3½\xd1po*\xdc\xd5r\xe4\xb6/\xd5\x1c\x81ɑG簐g\xad\xe1T\x88\xc8joB\xac\xf6\x04\x1f\xe4\x83\xfd]\xd9\xd4^\x95\xb2\xb1A65\x10v{\x85\xb5ZB\x9b\xeahXqML\x04\xd8S/\xc2ɹov\x1a\xaa ѮP-\x1b\xa5\x93\x1d{\x8d\xf4B))\xec\xfd LC\xeeP\xe9$GW\x11̣\x84\xb1\xc2W\xdd\x12\x1d\xf7Y@\xf3*a\xa3{\xb62ұ\xe1&\x8bhG\xee\xf5\xed\xe8̵|\xc5v\xfd\x94\x86b\xe8\x01K\xb4\xe5*W0\t\x9a\xcaU\x1aE\x87\t\xcd\x01:\xd9Rz\x82\xa3\xd2\xf4í\xab#\xe3q\xa0\x03e\xe8ZW{[ۥ\xad\xf3\x8dS\v2\xbb\v~\xe1\x1dlnr\xad\x02\x03\xab\x18U\x17\xbd\xadS\x15]\xdf\x1d\x83\xe9\x8c\xfb\x9d\xe3c\xb2\xa1/\xb4,\xc4eӧ^\xd9wk\xa8\v\xe4\xa4]\xc2S\xe4\x19\xe4\x10\xb3\xf28\xbc4\x13?\xder\xff\xe6\x18\xac\x84\xe7D\x17<\xadm\x97\x1a\xeb\xb1\xf3\xf0\x91'lۅ\x95\x06k\x03-\x05YUo斆^\xba\x1b\xeal\x91َ\x1dZ\xe8by\x81\x0f\xafC\x9f\x82\xb2\xc1\x1e>\xc1\xdf\u007f\xfe3\x0e.H\x871\x1b4OGح\x81\xf09q\x03!GL)\xa6j{=\xe3t\xac\xfe\xcc\xd6n\xe3I\x8e\x8a\x89\x95T\x1b\x9c\xcd'p\x1f\xcdM8\x9ft\xe8\x1e\xcf\x01|\xed\x9a\xf67\xfc\r\xa7o\xb4\xf4\xbc\xd28\xfa\xeb\\\xae\x01\xe8\xdaJ\x9b\xe0\x14\x85;+U\u0086\x12Զ\xd5$\xd7\xe7\x92\tL\x1f\x13\x9ar\xab\x93v\xc4q\x870\xedQq\xfc\xbd1\xdf\x1c\xe2\x99\a\x99\x94oW\xe3\xffm\x91JE\xde2C\xa3\xb27\xa6`\xaaðH\xb4\x14\xa1\xec\x16\xed{\xc5U\xed\x06\xe6(N\xe32\xb6+8nȣa\xd8^\xc7\xcf\xf2T\x9d\xaaa\xe7ۮg\x9cL)\xcbך\x8f+\xf6\xd1\xec\xa3\xf6\xd68E˥\xad\x1c#ws\xac\xf7\xc5\x1d\xb5\x85@\x9b\xa9j\xba\xff{\xc3C\x1f\x12\xc2\nem\xef\xdc65T\x16'\x17a\xd9\xdeJDZ\x98e\xce~\xba}8V\xf6\xdf\x04͚v{Q{jtL\xe7\xda\x00\x8e.f\x9b\xc0r\xb7\tyRWXq\xd8H+rOZ\x91\xea\xf03DFF\xea\x8a\b\xe0\xc6\xef\xb1\x1eL\x1f\xc9\xeaߥkGc\xb4CC\xba\xae\x81\xe1\xed\xa3\x89\xf0z\xae\x06\x93~\xc1\x83@(z\x01\x14\xdcCh\x8au?\xc6\x01\x8a\x1fw<\x81\x90\xe0\x02\x1d\xad>\xbeO\xc8\xf6\x01u\"\xdb`6aB\xa2\x12\x92\x85\xb8f\x13\xb9\xc7 2\xf0\xa9\xa9\x19u\x94UC\x15\x96\xad}:IW\x05L4\xbf\xd6dN\ue652\xdf\x12k\x1b\x01\xa1\xf9\x9eX\x8b>\xf9\x82=\xa6\xaa\xba\xdbT\x87\xa8\xfd\xafM\xb5+1\x17\xdb`\xfa\xeb\f\xee\xbaI\xedQ\xfd\x92_\xb8Q\x06}\xe1\x181\xedD\xbd\x8c\xa7e\xed`\xb4]\x11XT\xa3`[\xd5\xf2U\x1bGEjD\xa2\x17\xc9\xd16\xd5(J\xbeF߄\x9diTV\xbb\x83LߡT\x02\x17\xb1\\\xa5\xa2vA\x01\xacw\xa0\xb8\xcc\xe3\xf8M\f\v\xff\x90\xfaϹB\x97g\xbf8\xe2\xc5\xef' \x80G\xadG\x82\x88\xf8Ku\x95\xd6)\xa2\x85}\xaa\xaf\x8f\xb1\x90\xc3h\vU_\xbew\xacn\xf8\x04\x1a\xdfĸ]$&\xbeI6G\xb6\xef\xceD}\xaej\xa4\xd3T\xe8\x94\xdb\xc5\xddV\xfd6\u07b6[.\x822\xae\xcc\xd8{\xd4\x1aaLj\xb2-]\x8f;\xe01j\x01ݜ\x14\xde\aT\xa8\xfdڠ\xe8\x90\x1d\x04\x9cz|\x9e\x15\r;Pk\xda}Z\xef \xccM8\xd4|\xba\x05\xc7\xf38^\xc6MdD\xf1\x92n\x9f\x8e\xf9\xd7\x16T\xbf$ERr`B!%a\x9a\x8e\xc1\x12\xa8\xedà\xa7V)\x80\xd0\xecJ\xb2U!vn\x1c2\xd0(T7\x16+C\xb0d\xc5τ\xa4\xe0\x91\xb4\xe6DX\xf5t\xb3\xc0y\x9e\x143M\xa2\x8cE05\xd4U\xa1y)\xc9\"\xa5U\xd4<\xc5\xd7\x06\x0e\x1a\x9eE\x1a\xa7\xe9\xcc}\xdb(\xa7x\x14\xb6&\xa2(\x15\xc2\xcb\xe0\\DC\x1ft[\x14TR\uf1ae\xde*\xc1\xd2^/A\xafHm\xbc9\xf8\xc9J\xeaX\a\xe8C\x93\xd3d\x8eV2\x91ffγ\xa8\x96\xc3-\xd8\x13\x88\xfb\xe2\x13\x85\x87\x9a\xa32\xf8{\xbb\x81\x8b$\xfd\x90]\x81}+<sX{t\xb9\"\xb5\xd3*2\xd1\xc2\x12?\xf5\xd6\b\xc5Ri\xe2cM\x9b\xca'\x8f\x88R0\x10\tj\xb1\x9a\x8fc\xdc\x1eU\xe88E\xee\x9e\xc9T\xbdYU\xcc\u007f\xd1I\x94\xd5sd)&i\xacc7.\x17\xbb)\xc7\xe6u\v\xbb\x94\xdf\xd4U2\x97ܒ\x04[\x9a\a \xc4ԤZT\xcd\xf8\x9ac\x19LluP\x98\xdb\xe4\xcf-\x14\xcd\xc3\xe5Oa\xf4\n\x86\x85!\xf1\xd6q\xc4Z̕ky\n\xb1\x11\t\x1b\x99\x10I\x90H\xc1D\x12(\xda4\xfb\xe1\xd7H\x91\x03\xda|?L\xaeE\xd4^\xb3\xc8?=8\xe3U\x88\xda(\x96\x19\x03\x19^EPU\xbd`%BFh\b\"\x85\x81\x16\x10\x15\n\xedc\xa4\xf9nG\xf4\x9e\xa8\xb6\u007f\x8e\xfd\x1f\xad\xab0\v\x1eW=\xb07\xaa\x01\xbdQ\x90\x16\xb9\xd1\xc6(\xb8\xb1\v\xd6\xfbB\xef\x06\xa3\aL\xe6\xb7\xf1=*\xae\xfc\b%:\x81\xb9\xaa\xeb͓y\x8c\x97\x1aj\xd3\x14\u00ad\xf2\xb4>\x91\xd1\x01i\x19\xafKΖ\b\xb4\xe5\x0ef\x1a\xdb\xc5Rec\x06\xceT\x80\x1b\x85\xb8\x88\x194p?)\xaaH\xe1w\xbb_g\xd7~\x9e]\xd7\x1f.ɮ\xf9\x88\x8d\xa0\xe8|1\xe0K\xa6\xaa\x99mh\xf9@\xd2$Y\xbd\xba¯;@k\xc1H`\x90'\xde_:\u007f\xc1P6\xf0\x05˭%\xad\xf3\xf7\xdfv\xf6g]\xc8\xe9\xfc\x05/\x10 \xc4\x11A\xd8~\xaey\xfcS\xc8W\xbe\xf0\x91\xd2\xd7\xf1\xec\xc5z\xe9w\xde\xe6o\x17\xbf\x9dR\x1c/I1^C\xc0\xe43<^\x9eu\xf0\x8eK\xac^\xa8\xd0\xca\xfe唨1\xcbA\xa9\xa0[\xa5=cM\xab\xbe\xc9\xd1\b\x13\x9f\x96\xbe|\x1aH\xf5\x88~\bm2\x05\xe9?\aS9έ\x13gU\x16z\xa8\xa0B\x9a,@\xef\xd0\x15f\xd3PMLC\x02Qg\xf8\x9e\xdcǮ\xe18\xc3\xd5Y\x9b7.\xa7\x99\xec\x94AO/\xce(\x8a@v\r\xbf\xf4\x02\xf2\xb8\xbbv\x8fZ'\x83qpyG\xe1ʁ\u0530K\xccsu\x83\x1963\x81/\x8e\xb8\x03ev\x19\x9bw\xdf\xf9\xe1>\x12\x95~\x1a\x16%9\xf6\xa0\xb1@Rg\xdd\xc7{\xf1㷦\x8fa\x9c\xa5&{!\x01)\t\xa1\xbd\x8ef\xbd\xf8\xf1e\xbdU\x95\x13S\x8d\x0e\xb32\xa2H\x13\xa8\x8b\x80<j\x0f\x0e\xeb=\x8cvх\xa3\x17\x93\xbd=\f\xb4\xaa7\xad\xbe\xd7nד\xb8\xebi\x04'W\x19:y\xc0\"\xce\x13\x01-\x18m\xfb\xa1@\xbdkN(\xee\xc9\xc8>\x02\x9ax\xa2\xcbj\x94\x1c[g\f\x13j\xcd\xf0\xc0n\rw\x9d\xee\xe6\xd1\xde\xc6\x11\xb3e8\b\xeaT\x9a\xcd\xd3\xe2\bLT\x99]*\xffX\x17!\xa2\xa4X\x8d\x8b2\x87\x19ӿ\xc0&⨯\x14dG(ȿ\x04M\xb26\xd7d-^\xc7\x13\xd6>\xa6\xb0\xcd]r\x8d\x01\x96L\x95F\xddl\xa8\xbf\xe3\xed4\x83\xc6awQ'\x85\xae.\xd7$\xf6\xf8\xd6\x16\xec\xce8\x1e0\xf2\x84҃1,~\xe5\xac6\xf06\xc1\x82\x17\x1ax1#K+\x9d['d`\xed\x0e\xf8\x14v\x98\xb5\n3\x03\xf0\xdb\v\xc1Њ\xf6 ʖ\x02\xfe%\xddRs\x15\xc6\xc3X\xbaRP\x1aR\xac\xd5\xe9b v\xdf\x14qަ\xa3\xad)\xae\xb9\x17{{\x18\xa3\xaa\xee\b\x8dL\xe0:\xc2\x1a\xe3\xaaY\xbf\xa8)\xdei\xed\r'\x04sY\f\x85f2\x80\xa6\x16\x06\x03L\x9d\x1d\xf7\xb3\xf5\x85\xf3td\x16g\xef\xf0\xc69];/(\xf4\x04\xfa\x97\xb2\r\xd2pOV\xf8c\xd94q21\x1dq\xe1\x9b\xe3?^/~ʳe\x9c\x97\x1b\xe9\xd4U\x92\xa5\xf6\x84aN\xb5\xc43\x85\xf7Ƞ\xa2\xe5\",ƫ{\xc7WW}\xbe\xe0l\xdfk\x9d\x8b;\xa2\x94\x89\x96Eh~Z\xb9\xe6\xfdS\xdc\xd3\xff\xa4\x92{f\xc9\x0f\x9d&\xce\xd3ae\xe1gڬ*·\xabܟ\x9d\xccd>\x06^\x1d\xf44\x93\xecD\xac\xed5\u007f\x1b\x87\xc0\xa7\xd8 \xc0\"\xad\x0f\x1f\xf3\xb8\xcc7;c{\x96\xa5\xe22\xab\x81W\x85&\xc7\xc1\x93\xf5\xa7\f,\xee}\x8a7/\xc2*F\xb9\xe3 դ\f\xa3\xdeԈs/\xfa\xabR\xff.\xe0w\xa3Q`O>\x93\xca]\x89{I\x8f\x83\x90\xb6m!j\x92\xf1s\xbc卨\x9a\x8a\v\xec\xbcK\x16V\xde\x1e\xed$\xfd\x00\xa30\xf9\xa2tI$t\xa8H\xcc\x12^\xdes:\x0f\x94e\xc9J\x86?\xa6K\x85٪1X̎k\xaf[Z\xf7S\x96nf\xedr\xf0;5\x8d\xd6\xf5\u007f\\\xeb\xe8q}\xb3Y\x06B\xa3][\x86\xf6͘D\r\xfdr\xa29\x03ӷ|\x9aO>!Ȇ^\xfc\x13\xe2l\xe8\xc5\xf5\xe0)~\xfdaK-Ě\xb5\x02\xfdF\x1d<\xc3|]ҹ5\x9e)_v\xbd\xa7zN\x84\x17\xddE\x06\x9aHO\xf1'\x85\xb0\xfd\x86~\ry\xf3\x88\x927*y\x03\xc9\x1fDr\xa1\x92\x8bͰ\x16\x82\x82#G\x96\xe1b\xe4o(*¾l!\xce\\\xe1\xa2\xe8\xea \x1f\xba\xdaa\xf9Z\xb8\xc6\xe3i\xf5&\xa8\x97\xf4\xce\xea!\xb1\xf8\n\xcc\x04\xff)\xf0\x9fKL\xba܈wc\x91\xec\t\xfeS\xe0?\xd48jJ\xd4U\xde\x12ZȘH\\\x1er?GI3huI\r\x1d\xb3\x86*\xe2$1Vp9\xc4\xe0\x1b\xea\xe0\x1fW\xec\xcaӓ(\x190\xe7\xf0\x9c\x99~\x03\x81z\xd8\x0e\xf921\xfe\xcf\xdeO0\x9f\xd0\xd4Hg\xd1l\x88ZÞH\x03I\x1fUB\x91\x978\f\xb0\xc9d\xbc\x1cZ\xc1bôW\x92'\x1b\x03\v\xc7o\xe2\xf0\xc1\x16\x16\xb5þ1pm*\\\x97T\x8b`A\x15[\xebrS%\x17Urd\xdcS\xf5\x1c\xf1\x8e\x1ay\xc3㮕7C\x837\xc3V\xde\f\r\xde\f5\xde\x18X8\xde\xd4\x16\xde\x18\xb86C\x8d7Cb\u0090x3\xacxS%\x17U\xf2\ry\xe3\xce\xdeM'\xc55\xb7\xee\x84OS\x9bU\x98\x90MX\xb0\x88\x9eP>\xb4\x89\x1aq\xbey\x01;i h\xfe\x0eWD\x80\x8eb\n\xf9\xb3<\x89\xec\xdd\xf0\x01\xab\xaf\x81q\xa0\x84\vus cQ\x9fbT;n\x1c\x9c\x92G\x93wv\xec\b\xf3\xb7\xa6\x1d\x01cC`ͮY\xba\xbf\xe8\xa65$.{z\xf5\xec\x02\x06\x8e֙x\xfe\xee\x1c\xba\xfbٳ\xff\xee'\xb8\x9b0\x82\xff\x0e\xba\xd5\x153\n=\xac9]\x83\xb6\xc3Mh\x11B\x02j\xba:M\xa4\xdf3N\xb2U\xe8\xd21}\xc9<\x01*\xb7\xf9'\x06h\xe4\x02=\x10\x9f\xb1\x01:5@坐)_\x9c\xba\x83\xbb,=/dg(\x95\x16s\xdaco\xd0(\vK6\x1e\xbe_\x14\x18jf\xc9\r\xb6\xc3a\x8f\xf9\xc8\"$\xb7\x98;\xea堐\xae\xe5=\xc2|\xed\xfb\x8ezo(l\n\x95m\xd6,<l\xfd\x89\n\xeel\xc8#\x8dV~\xe8e\xa4\xe29\xad\x0f\xc8n\xa3\x9f\x87h\xaeq$|\x86\xc5k\x87\x9b\x91\x88\xf8\x04?\x19\x96~2,\xfe\\\x0f\x0f8\xe0!\xcc\x13\x18^|D\x11HG\xf8\x89Q\xd3\x0f)\xf7P\xe4n\bx\x83\xc0\x1b\xfc$\xe0\r\x02\xe3D\xb8!\xe0͡\xc8]\xd13A\x88\xe1\x0e\x15\xec\x11\xc0\x1d\xac\x91\xdc\xd1\xc4\u05c8\xaaf\xa8\xd1\xd0\x1c\xbb\xa7H\xd8\x1eb\"@j\x99\xf8ĺ\x9d\xf7Ю\xb2<[d\xc9;\xb0\xf1\x8a8%5\x83\xafq'\x134\x8c\xedS\x16\xe4웤\xa4\xbb\rrL\xa5\xa0G\x8e<YƼWte\xed\xb2;\xae\x15\xd1\xee֑\x97\xe8\x17\x8a\xe0\xfb\n{FK\xda`\xd2\xf0\xccu\xbb\xa8\x8f\x97ߪ:uI0\\'\xf0\xb0\v}\x18\x9fx=\xf4K\u0084\xc7\"\x81\xbf\xd7\x04\xb0\xae\x00\xd6\x04\xb0\x16\x00\x95R\t\xba\xea^BYfs\xe4\b\x87\r\xd5U=\xed\xa5E\xb3\xf8%\xf0\xa7ƮSո4\x9e\x96/\x16\x11\x97\x97\x899\xfa\xa2\xd4R\xf1BǑ;.\xad\xac\xa8/\xd0A\x85*i\x82SC\xfc]\x98N1I\xd8䝴\xa3\xed\x88)XY\xf1\xa7\x96\x17Փ\x83r\riK}\xfdT/ \xb0\xb8\xf0\xe3?\xab\x05\xbf\xd1a\xc3w\xebx\xab\xd5UW9a\xeb\xad\xd1\xd8\x19Gx\x05\x15\xf5\xd9\x16\x89\x8d\xa9\x1c\x81\xeb\xd7\xe3\xa0\xe0\x11\xfd\xab\xa7\xe2\xb0@\xf1ֻ\x91z\xbd\x9e\x94k\"dJx\xd5\xff\xe84\xaf\x91\x9c\xc2\x1csn\x90{\x1e\x13'S-\x98\xfa9\xbb\x8b\xa7\xda\xed\x1a\x8f\xbe\b\xf2<6\x13\x8d\xa4\x8f\xba\x90~\x83\xabF\xad\xf2\xa5\xbdw{\x1e\xb7\xf6a\x94Y\xc7ZT\x95N\xd4Gi3@\xdem\x87\xfc\xe0>$\xee\xf6p\u008fS\x80\xebz\xcb \xd0\xdb\xcaH\xd3\xdaַ\xa3QQ\x9cjͩ\xf3Q0C\xa7\x10\xbf\x04\xdb\xf4:\xe0\v\xc5\xc12\x05?\x1a\xa3\xb9\xb1*E`U\x91\xc1\xf7\x1d\n\xa6\u0382\xaf\xe3\x19\x14ڡ8\xd3\xceă\xae#\x05߯T\x1b\xa8>\x01\xd4\xcf\t\xe5)|\xa2\xa0\x9f\xb9[\xfa\xe5\xeaզ\xabl\xb9\xcc\n\x00R\xb4\xd8/\x0eVJ\x18EFS\xc0c\xba9\xa3\x91W\xd0\x1d([\x9a\x17\xf1\xf5\v\xeeӿ\x1b\xc74\xdc6\xf3H\n\xe4\b\x90\x18I0\x9a\x8b\x91~>\xa5g\xc7K\a\x06[\x1f\xd45\x82q\xeb\xe5ؠ\x96\xfc;\x8a\x11y\x18\x16C\xe5\xb2\x0f)\x1bN\xc1\x8b\x80\x04\x14\xe1\xa46@\xef\xe050\xb8\x17\xad1\x83\x9e\x88\xdfȌ\rel\xaa\x1a\x04/\xfah\x85\"v\xe9\xfd\x8ex\xd9\xcd}\x8f\xbcPL\xc7~\xb4\xe9\xf4X*\x14\x90\x89\xaa\x0f\xa3\x8d\xe5\xcf)*\b\xed\xab_2c\xec\tǔh\xedʞx\xfb'F\x96\xe3\x1c]G\xe5\xac#\xf4\x84\u05cc\xdet\xbb\x8e\x8d\xfb\x1c\x8e\x8f\xe2\x19ҡ\xb7\x85\xad\xaa\xcf6)H]\x9c\xd6\xc4.F\x13\x112\xfb<\x1d\xc5#\xfa\x1cѧ\xc9\xca\xdb1\xbdJ{;\xa6\xab^\xf1P\f\x12\xd4ZPd\xa4>\x03K\x80[\xbc\x06\xaa(\xa9\x80\x0e\xadF@\x83\xd7\"\xe0k\xcc_\xa1IC\xed\xa9\x12\xfb\xb8v\x87\xea\xd6\xfc\xb8\x83\x0f\x95L\xb4*G\xfc\x055\xd3f\x14ز\x12N\xe5\x84L\x9a(\x15\n\xb8x\x98s\x03$\x03\x90\x8b\x944ғ\xc0\x86\xb1\x18\n\x05\xc9F\x04h\xf8K<\xc5\x1f\xc4M\xfc\x01\xb3\x0f\xa6\xac\x05\xc8\xda:\xf6M\xb9\xe3tщ\x89\x90V\xa9\x14\xc5F\xb5b\xa3&~\x89ɏ4\xe4\ts\x0f\xc3X\xaa\xb6\xf5-_\b\x1d\x1e\x9b\x90\x92\xbe\xe4P\xd4i\x87D\xa8\r$\xefܨG\x1d\xf6\x12z\x88%f\xc4\x00t\x14\x83\xa4\x16[G\x90d\xcc\"8\xd9\xdbÅ\xe7b\x1e,e\xb6,\x98+\xb1\xd6\xd3&\xbf\x96d\xd4\v\xc8-\xac\x12S\x8bd\x95\x93\x0fæc\xe5\xdbm\xc8\x1a\x98:hBFʩ~\x9b\x95F놞U#=/\x9b\x857\xfa\xd7K\xd1\xda*\x19T\xfd4,\x84\xd7p8ήb}\xab\xa4\x89pԘ\x8f`b\x00Aq\xe4<>\xf1j\x1e\x1a\x12\xb5\xa8\f)$\t\x1d\xd3\x04\xb1\xdczUT\x96_\xb2k%\xcd1T\x17\xfc;\xa9{\x9c\b\x02\xeb.7\x12\xcfm\xfa\xd1\xecE#c\x8b#T\x1d\x8bh\xc5p\xf7\xcbǷ\xb1\x88\x8d\x88g\xe9\x82\xfc\x11Dw\xa0\xfa\x92\x82\x9a\xf6\xd7\xc7N&0\xdb|\xecO\xe2\x1e\x85_\xa0ߛ%*]\xc4yG$ћE#\x01\xb7OY{\x02\x01\xfc\x1b|\x01\xd6}l\xd3m\xd8\xe4\r\xe9\xb7\xfe\x84ڊS\xca\x12Ű\x1c*\x99݀\".G.Y-i{$\xd5\xe5Z'D\x92X\xe2\x8e,\xfc\xf3\x18\xd1\xdc\xc1\u007f\xf6\xb0(\xfc:hQP\xd6\xd8\x06\xb3\x87\xf1\x1dYMV\n)^Dt\xfa\xe6\\1\u2fe5!;d\xb6\xc6\xcbS\xb2M\xd1lJ\xca\xda\xfc-@\\V-\x9b\xb4fd\b\xc3i\x15\v\xd7\x17\xb7rOW\xb7n\xbb^ikM\xb6\x16\x99ߕ\xad\xc8*Dg\xb2\xe0UuJT\xb7\xf1\x1a\xadn\x8am\xfb_W\xf1*n\xd9\xfc\xa8\xadh\xcf\x05+\xbb^FQ̬5\x19/\xa7k서\rƤ\x14\x19\xe4x\x9d\x19Qڭg\xd4A\xff\xa5\xbc-S\x91I{\v\x90N\x01k\x8b\xd28\xdfL\x1d\x01\x18x\x91\xb0.\x05\xbcu\xcd\x04{X\x91\xf5\x98\x00\xc5\a\xe8ъ\xde\x13=\aT\xaaP\f\xa2\x007\u05f64<\x87oR\x83&\xady\x14~t\x8c\bj\xad\x88X\x9bP\xc4\xdas\x97h\xb5\xac\x96k\xbc-\x1a9[\x98|\xc56\xa7\x14\r\xfb6m<\x88\x80n\x1aq\x85FڰNV<_\x96\x9b\xa3\xd6ǚ,B\xe4\rA:K\xa8\xe1C\xbeS\x81/\xd0X+\\\x9d\x14\x13n\xff\xc9\tm\xe5\b\x9aS\xf92`\xdbrõ\xd70O\x16\r\xdc s)\x8d\xe7uj\xb5\xd7Ӛ\f8,\xa8\xc4Ѳ\xe4(\x8f$\xb8Ş\x03\xb6\xe6\xe1\xa4\xfc\xa1\x91\xbc\x86β^\xe35Ԍ\xda \u008dQ\tS\xdbE8\x11Iutb\x99\xdf\b\xd5\xc5\xdc\xef\x17%\x9exU%\xd21\xee4\xe6\xf4o*>\xf8\x8bw\x162\r\x16\xc8 \xb3\x14\x9f_\x9a/\xe9\xa1ܫ*\x17\r\xda\xf9\x96ò\xdb\x1aCH\xd6}\xe3\u052c\"\xd0\xecV<\xf0\x0e\\gh\xb2\xbdx3\xaf\x8e\x1a\x95\x93\x80\xa0\xa5P\x85\x9eWDU\x1ei.-\x13\xf0\xc9̵Ypm\x10\x8c\f\xb3\xb7\t\xbf\xe1\x18P\\\\\xb3Rr\v\x96\xccO\x1f1\x18\x91{\xca\x1a\f\xef@ِ\xd8ɴ}\xc8\xfd\xe4S\xe7\xd4k\x95\xbdز\x05\x1e7\xee\u007f\xf3\x84槆<\xe8/\x13K\x1aԞ@3,\xbdlf\xaa\v\xad\xc7@\x1f\xdb\r4\xf2\rJ@\xee\xa8Vu滬Z\xeet\x8c\x15\xdddJ\xf4\r\xb8\x93\xff^ܑP<\xe2v推\xa9*}c\x0e\xb4)\x06\xebty\x87q*\xc5^\x03R\nQwMN]\xe3\xc3\xee\xe7\x9d\xc6E\xee\x86\xca[GO\xb5ml\xa3+\xe9]\x03\xe70\xb3P^\xd1a\xc3\"\x12\xd3E\x95A\xfc\x97f4\xe1\x17\xebv\xfa\xc9f\xb4\xfĕ\t\x9fW\xf0\xb9\x1b^\x11\xe7\x18\x1a\xd6\xc0\xb1H6JZyK\x9c0a\xb4\x9b\x92\a|\xa3\xe3C`K\xdf\xda\xcbDM\x8fC'+\xf5\x91Ɍ.3c\xf5\xcbL\xc5\x02\xc6\xd5c\xaa1︤ҩ\xc3\x00͍\xf5\xd7r\xde6@\x1bG\xa8\xd9'q\xd7s-^\x96\xf33\xb3g\\Z\xefs\xd4\xde.z\xafY\xf1\x01\xcf\xdcC\xbe]\xfd\xfc\xfe\xfa\xa7\x81\x90\x9a%oX\xf1zp\x032ES\xe78\xb7\xcf\xe1\x82c\xd6A\xaes\xb5c/m\xd6(\xdaEp\xb9\xf6TC\xb7ݩs\xfe\x0e\x03\x88\xe3U\xdaW\xf4\xe8\x8a\xfb./Fg?\xa2\xeb<]\xedi\x89B_%.y\xddm\x1e\xb0\xb7VHq\x0f\xfc\xa9\xbc\xd3,\xdd\x11\xd7\xf8\x84\xfc\xc8x\xfbf\xea;AL'\xb5\x82C\x89\xe1\xee\xcaz\xc4\xc7\x1a\xe8LHq\xc40q\xa3\x12\xed\xfb\xf7\xd4\x16Wh\x04\xf2\x89i$[\x83\xaaH+\xd6Xi\xe0\xc46\xdc\t\x1b:\xb0 \x16\xd9\xd0&l\xa3\x9d\xb0\x8d\x04m\xc5F`\x1d\xb9\xb1\x1d\xec\x84\xed\xe0L\xb6Pu\u008e\xd2źh\xd9|W|\xa9\xf9\x92l\xf0S\xf8\x918\xe5\xa8L\xe6\xf1\xbbU9Q\xf2\x8a\xef\xc3\xf5߉\v\xae\xcf\xc9\r\xcfz\x84\x10\xe3[zO(\xaf\xff\xdf~~\xd6\xf0\xae\x1cz\x94ȏ&\x0f'\xaa\x9c\xef\xff<\x1d\x8f1\x88\x04ַ\b\xe7\xf6\xc5\x1dL\xd2nr\xc12\xf4\xa0\x19!_j\x8e\xe8=X\x9cl\xf8eX.ڭ\x9eO'wL\xd2T\x9a\x9f\xb7\x84W!#\xe6\xf4\x9c\x0e\x16u=\x8ac\xc5\xe3@9\xb8\xc0\xcd߹Z\xd9VQc&:z\xfd\xc6\xe0ޞ)H\xb8\xd88\xb8\xaf\xebڥxGW\xb5./N\rT\x02\x8d\xf5\xce\xc5\xed%.b(\xb2\xe5R\xf0\xa3\xe2B\xc0;\xa152\x8d+\x89\xb7U\xe3\xf5ۥxc\xac\xbe\x94\xed\ro\x1aW^\xeb\xfe\xd7\xdc\xed\xf6\xed'\xfd\x82\xf6\u007f\xf8O\x8e\xf0j\x12\xc1\x91\xdf\x15\xc5\b\u007f\xbf\xcaJy\u05fc\xf3[G\xbc\x1d\a6y\xd2\xd9&s/\xb3\xecr\xb54+\xa6[\x90\xe1R\xc8?\xc0\xff\x10.͇ݸ~\x870\xa8\xf0,P\xbe_\xc4%#\xc6;>e\xf62\xbb\x8e\xf3g!\xbe.\x8e>b\x86\x1b\x1b\x80\xb7\xcb\xf2\u007f\x8f\xe3\xcb(\xdc\xf0\x10\xb1:2\xd1.=Q\t\x05\xf8\xday?\x916\x014q\xd2\xc1\xe9Ƨ\xe8\xefj\xb0%\x81I\xee\x02\xc6~\x82\x91\x13\x16\xd5s\nx\x82>ܩ\x11;\x90\xbf3\xe1_\x9a\xe4\x1f\xb2Ey\xbe\x1b\xd7\xe7\x15\xe8n\xe4\x9b\x05vo\x06\x11ҟ\xbb1\xb1@\xd33\xb8\xd4RC\xeeP\xf0,\x1e\x04\xbb2a\xa7\xe6ߠ\xe1\xbfG\x93\xbfDc_f\xf8\xb8\xe3\xb7\x18\x8f\xa5\xa1\xc5\xd5\x1b\x12\xb5\xb9\xc6T.E\u007f\x02$\x89Kg\x81\x8ei\x17\x12h\xe2\xfd|\x12֟A\xc2\xcf\xf0\xfd;\x90\xf0\xd7O\"\x01\xbb\xe0\xd78̷\t\x1e\a\x1f\xdaM\xf2\x14l\x83\xe8\x91\xc8x\x87\x8d\x12\x88\x06\xf0\xdeB\xdc\f\xbb\xb9l\xfdc\x9a3jm\x8e\xc4\x15\xaf\x97\xe1\"\"\n\xa9\x857\x1f>\x1a\x86\xdau4r\x97\x02\xab\xf1\xde\x03t-~8\x18`\x98\xc4x\x9b\x05G\x8a\xe7\x155\xf2\x9f\x89m\xa8\x87\x16\xec\xb9?\xfc4Ax\xbe}\x16\xfc#\x1b\x14}\x9eX\u007f\x97\xad\xf2\xd1\xe1?S\x83\xbe\xfb\xbc\x06\xfd\x90,Ve\\\xfc3\xb5\xe8\x87\xcfkћx\x92\xe1M\xee\u007f\xa2\x16\xbd\xf9\xdc>JS\xdck\xfa\xc74렱Y/?\xafYO\xe7?͛\x9ac\x93\x1a\x02\xacf\x025\xd0z\x02}`YF\x16\xe9\x95C2\xdd\x02\xe1v\xd0\xfa\xa7u\x96\xfe\x90-\xb4{\xcbH\x1c\xdeٍ\x90\x164\x1c0\x9b\x1ff\xc3Y\xff\x03\x9e\x19\u007f\x10\xfe\xb6\x9d^\a\xea\xe9\xec\xc1\x1a\xed\xc39\xa4\xff\xe7\u007fV~\x95\x1fЩ\xf1\xde\x00\x8b\xcc\xd5\x1dWN\xff\x1a\xd2\r\xd2?\x14\xfcL=\x933\x05\x89\x18\xf9\x1f\xce\x03G\xe2|\xdbB\xf0\xfb\"{\x15\x96\xc9\x15\xdb0\xf6\xf4E\x17;\xb3\xef\xdf\xfc(͘Fl\xb4\x91z\x15\xa6~\x8a\x16\x14vb\x8c\xe7.\x1c Ѿ_\x9d\xd31\xa0^\xa1\xf0V\xc1K\xa9\x84\x803\xc1\xa4B\xf7!v\xf0\xf0\xa3\x81\xee\x17\xa0\x91\x88\x8eR\x03t\x90\x1aR`oHx\x82\tG\x90\xe0\f\xbcF\xaf\x9b\x99\xb5#\xb9\x94\xa4\b\x10k\xe0R\x9a\x828\xe9\xa1m\xed\xa4\xc0Y\x8d$\x9b\x84\xfa\xd2]\x97^˞h\xf3\xe5\xce5\xf0\x8b\x81%\x06\xe9\xc1\xeb\xd6\xd6UuD\x8a\xd7ݰ\xb5%\n\x16&\x14F\xd8\x1d\xbam]\x9a\xaf\xb6TG\xd4X\xfe\x11\xa0\xae;2\xdc\xf6\xb9g\t\x06\x05\x14\xea\x0e\x18\xbfx\xeeW\xee\xa0\xed\x11\x84\xb1\x85O\xed\xc7\xe4\xae\x11\x88\xaaqS\xbcNNKE]7z+z\x0f!hf)\xed\n\xbaت\xbf\f\xae\u052c\xa6\x99\xa0\x9c\xe9\xad\x0f\tf/\x9b \xf0A\x1b\x8e\xe5\xa0\xe6\x96\xc1}\v\x00\x8a\x8aj\x9bj\x9a,\xc24u\x93\xf2<\xac\xfc\xa5\xf4\xdd(\x92\xea\xfe4\xcd輈\xbe\x8e\xb5\f\x1a\x92\x14zn%\xaf\xf2p\x06\x8a\x8f\x90\"=\x99\x85[\rN\x03\x95\x88\x9e\x9aW\x11N+V0\b\xff\xb6u\a\xb1\x9d\x00D[\x91=\x92b\xc57L\x94\xd4:1P\xa6\x86A\xb4\xc0\t\x8by\x1a\xa8j\x95\x13X8\xaaid\xe8-\xadzVz\xf6H\x1e\xb7*L\xc2<\x8f\xcb\xf3,j\n\xd9T\xd3 _J\f\xa3P\xf7\xb5\x93{tD\x1bK\xe2e\xd0\u007f\xf7\xc9b\xd8Ȋ\x02\xd7\xdc\xf8\x12,,\xa1\xba\xa2\xbe\xa2+\xe2c\xd6&\x0f\x86^\xd7\"Xqy\xdf|\xa4\x8b\x80\xfb\xc9\x02O\xa8\xf5\xd8\x04\xf5\xe2\x061\xa4Q\x18\xa1(\f%\xcc\xc8\\\x8c9\xca\xe6!\xbd\xcb\xe5\xc2̾\xc6\xd6\x11B`\xd2+0\xf8\x81\xd8ֵ\x88P\x83ބ^\xdbS\x04\x15pзH\xccx\x15\xf3Z\xbb\xf5v`\xfd\xf4\xfd\x0e\xcb\xf9FV\xd0m\xf5\xe6\x9aWWf]|*\x93ɥ\x11\x8ern\b4\xf9H\xadK~R\xde`\xc2\vJ\xb5H1\x0e\r0^V6\xf5\xe6\x14+\xae#+\xe8\xd8\x0eq\x05,\xd2\xd1A\x99\xf0\xf1ut\xf1\x1bMV\x15\xbd\x82\xc0\xf6\xbdyW\x84\\\x90\xc7\xef\x06Qo`\x8a)d!\xeb\xa48A\xb3\xb2\x0em\xf7\xbe\x90\xf2\xfe\x06e\x96\t\x01ѷp\xddNli!>B/n\x91\x16\xed\xce\x0e>\xe0*Z\xb7\xef\xa0\xeb4\x11OC<\xf2\x14\xb8\v\xea\fJ\x8b\xf6\x06^\xaf\xa7\xbd\xab\x8b֪h\x8d\xe1\x19{I\xb1\x11u\xbfƹ\x8c\x96\xa8\xcd\f\xb5\xa9y\xeek\xddR\xcd\xeeU\xbfa\xf8J\xed\x1d\x1c[ʾ\xad\xc5ૉ<+\x16G\xf9I\xb6ܴ\x96ti,*\x85\xc3\xc3V\\\xce@~\xeamWB\xd1\xf5\xa4\xd6\xeb\x10O:\xf2\xc7k\x9c\xc3\xe8(\xa7\n\nB\xaf\xbe\xa6\xe1|\xd9r\xba\xa3\x8f\x19\x1e-F(y\f\xe8\x81bM9\xc4\xe1\x82=SD\n\xff\x91\xc7\x04=ϊ&\xc1\xe5\x1fq\xa1'\xde)'\b$\xf8\xae\xc5)\xfd\xec\n\xc0\xb3v2\x9f\x1bᕴc/\x91\xd1^\x9a\xfbY\x84P,ڦ\xce\xfa\xf3Lr\x83\xb6j'\xbf*,\xd251\x16\xb6\xe7\xed)H\x1e#\v\fH\x18\tg\x96*\x9e\x02_\x19\xf4x\x97y\xefM\\\xd2֡\xf1dv\xa4\x1fBo\xc8S[ڲ\x11\x9e\xaf\xa9=⍹\xa6\x8d\xda\xeb\xfawQ\x97\xb1x\xdd\xc8ūB\x8a뮁\xad\x88+:Ū̝\xabE\x97\x95O\x87\xf3\x1e(\xae\xd1(\xba\x05\xaf\xd2\x06[\xba\xf7\xbf\xfd\xfcl;g\xe49\xbcb\x91\xce#H\xffD6A\xc9vN騝\xcc2\xa8w\xf1K\a\xb89\xcbhn\xa0\xc6/0 \xb9\xfc\xe5R]\x02\xe3\x9eZ(1\x12\xfc\xa7\xb2\"\xa3lB6\x8a\xf0\x1e{\x91\xc6\xf8\xe5w\xa2\xe4\xaa\x13\xf4\x8br\x03\xba\x118\xaa\x02Xv\xb2e8I\xcaM\x87\xd8\xde\x11\xfaț\x84\xe5\xe4\xdc\xf3\xe3<\xaf\x1e\x15\xa0>\x03\xab\x02q\xbc[\xe6Y\x99\xe1|\r\x94>{\xf3\xe6\r&>\x8fA\xa7\xf1sC}\x95߭\xcah\xf52\x13-T:a\xc7r\xf7\xab\x05Fg\x12\x9eR\x8b\x97\xd2\xf1%\x8f$ˡQF0\xc8\x1a\r}\xf4\x8d\x12\x1e\x1fZq<z\xefh8\xac\xa1\x1f\x1d\xa8\xab1`L\x16t\xb1\xbc3\xea\x0f\a\xfd\x83\x8e\xec\x0e\xc1)zў[J?\xdf\xe0\x95\b\xab\xbbD\x96\xaf\xfaM\xfe\x10=Ǐ\x81\xbc\xa2g\xa5q\xbeE\xdf$\f/jvS`\xa1\xd3*}\x06S\x9a\xa4]\xa7\xeb\xcdj\f\xbdU\xf0&B\xff\x1ds\xf8\xdd;\x98\v\xaa\xa0,\b\x87\x9c\x10\xbcW\xcfq\xd0\xfb\xfeU\x91\x93\n\x84\xc9:ڎC]\xc8X\xca\xce\x04\xdb[\x83\xa2r\xa72\xf3L\xaf\xa3JU\xf2O\xef\xa5-uy0c\xe3\xba\xfc!\x8eM:.c\"A\x96\x93\xde\x0f\x90\xdc\x15\x89\xa7\xf0\xfb\xcc\xed\xf4\xc04\xbc#\x86\xfa\xd2݂\xab>\x0f\v\x8d\x1d\x80\xc2e\x89\x00\x1e|7q\x9a\xe0--A\t\n\xa6|P\x8d\xfe\xd0sݭ\x98\xb0ȩ\x03ݙ\x81\xa7\xb0\xf1\b\xc1\xdf\x1d\x1dƮ\xd2B\x02w\x05K\xe6\xd9U\xdcD!6\xea\xc4\xd5Tk\xca\xd5\x1aO\xcf;\xf0\xf3\x92DI\xad!\x90P8/\xa6\x88\xfe47\xc8\xc8%L\xbe\xb8\xe9&\x92Q\xf2n\x14f؏I\xe8T\x9a}C\xech&\x86\xb3w#\xa7\xd6\x1b\x12;\xd3ř\x8d\x941\xa8A\x1bh\x11\f\x9d\xdbH\x9c\xc8\xffd\xeaDy&O\xdf^\x04\xf0#\xfcǈ\xad\x83\xa5\x8f\xf8O\xb5_\xd8\xdc\x1e\x81\xdch\x90\xa0Kk\xd0\xd4\x11\xedU\x93$\xfbA\x04Ȳ¼\x9f\x9cX\xc2\xf9,\x8b\xack\xc5S}\xbe@\f\xd5)\x05\xaeb\x94\x88\x06mo\x9bq\xfb\x84\x1eֆ\x02,w\xdf\x0e:\xdd:\r\xf6\xa0\xa9\xbf\xb8\x01\xba\x8f\xf8@[k\x00+>D\x16/Q\x8c\xd7#h\xe9\a\x86=E\x19\xb4#\x13\n\xf70{\xabC.\x89ܮb\x8c\xf2\x94A\xf4\xd2`~\x9f1Ub\xa9dV.\xff\x8a\x92\x96r\xd5¿\x8aƄ\xc5$^\xe0K:z{\\/\xaeaL0\x15\x11\f\xe3\x81\xc9pa\xe8\u0588\x1fx\xa4\xf0*|\xa5#\x87\x19v7\xecx\xfb:\x94\xd8\xf1\x82{(\xb0\xd3\xc5\xfaЍ}\x1e\x87\xc6ޒ\x98\x17\xa7\xf6\xc1\x18ϭ\x92\xe9!\xbbn\x0e*\u07fd\x8b\xea؏B\xb3\xd8^\xadV\xc0\x01\xbb\xa7DTs\xb1\xe5\x1f\xca\n1\xd6/\xccyx\xd2\xe6c\x80\x8e9ڪ{{\x17\xce\aP\xb7\xe3\x14CE\xb4Qր'qm\xb5\x18\x9e\x96\xc0\xc59p\x11\x96\xce \xf5\x8b82y\x89aA۸\xe9d\r\x1d\x8dH\xf3\x88\xf9Lq\xef\xe4\xa3_F\xce4I\xf1\x01>\xd5.S4\xf5^\xa27;\xfb\xefW\xe1\xa2\x04\xbe0)\x1c\xddN\x97X\xd0\x10\xfd\xbbAc\x8b\x92\xad¡;oքd\xfc\xa9\x12AW\nM9Pg\xa9\xbf\xfd\x06\xc2|\x1br\xa0\xd3\x10\u00a0\xbcI\x10\xfc\xb1\x81\xeb\xb6\xc0\x05\xd5\xd08dL\xe3\x1d䪢\xacE\x9a~\aZ۰\xb7So\b\x84i\x8a\xae\xff\x97\xefM\xd2{\xff\xb2\xbd頾\xb17\xd5f\xf6gthW\xbe\x8e\xff9}:٩_'\x9f\u05f7\xb6\xa9T\x97\xfb\xca\xc3\xff\x11\xe6L\xf4\x9c\x8f7\x91\x84\xc9?\x834\xfc.\xed5ùB\x87O<cm\x9a\x87\x8bH\vg\xb7\xc0\x1dG=0\xc3\xff\xfc\x1f]\xef\xff\xfb\xbfM\xab\xdce\x85\xe9g%\v g\x14@1=̍\xcc\x00i\xfa\x9f\xffC\x8f\x13do\xa6\xdaG*\xfc\"}\xde\x18\xf8q-\xfdX\xb8)>^\x9d\x1a\x99\x11\x99\xbd\xea%\xf76\xa0\x9cb9kq\xeb\x1d\xa1$oS\xc0\x8d\x9cf\xec\xda!&4l\x0f\x9b}\x87\xd0TaN\xe8\x99\x18u\xf0\x91\xa3mQ\xddP\xfch, \x00\xe0\xd5\x1f\xdf\a\x14\aLJ\x82\x92\x8a>K\x83\x1f\xec\xd2Uzl\x97x\xbd\xf4+^H\x967\xb48ɯ\x93\xc5w0\x18\x8e\xda\x0e\x10\x9du\xaa\x05U\xc1\x96([\xa0\xc6S\x19\x05\x9atF\xcf\xd7z\xad\xc0\x938\x9b6m\x88\x14\xab\xf96\x15[h\x0fY\xd8\x1aV\u007f\xda\xfaS\xed\xe2\xdbI\x01F;\xa9\xa6=\xcd*\xa6ƅ;[\xc2:\x96V;\xd8\xc0k\x1c\xcd\xe8\xaaCڕ:sx\x8d\xaf\x05VC\ue83f\xa8\xc8\xd1\x0eCp\b.\xf9\r\xb5s9:\xc9\r\xc3\xff\x0e,\xd2+\xb9\x91S\x9c\xf2\x11Q\x97\xee*\u007f\a\xbf\xcf\r{\x17ݰ\xae0,\x15ƫ\x92%\xf0\xdc\xf5\nM\xda+\x9d\xe0\x12D\xa0Xf\xe6\xf3\x8f\xf3\x10\x16\xca\xeb\x9a\xdf}\xffC\xb2\x14\x17\xef\xa2\x03X\xea0\x94\x8e\r\x00\\[\xe1\xc2q\xc91<\x03u\xcc*73\x8c\xd0/\xf29H\xb6\xb9+1\xa1\x957T\xf6\x92\x91t=\xf8]\x88\xed§\xb4A;\x0f(\x12\fK\xbdc\xbbA>6\xb9\xa0\xb2\xe8)\x98\xf0a\xab\x8edAH.\xf8\xf1\x15}\x90\x01\xf0\xe9ř\xb1t\xbe8\xd3\x0e\xaf\x1c\x13\r\xe2\xd7Y\xa5]!\xaf\xef\x8b\xd4\xee\xef\x1a!ja\x00\xc1\x04\x90f $\xb5\xf75k#\xe9\x91w\x10\x00\xac\x19\x85\xae\x01\xf20\x00\x8c\xfa\xc33\xb6'\x18\xe0ydU*vk\x93\x88\\\x8c@\xe8\x00\xc3\xe3Ǐ\xcdY\x84ހ\x13\xa3\vߪ\x01\xf03|@\x96BZ\xae\x05}\x88\x03_\b\xe0AK\x84@RKp\x9e4\xdb\x1a\x06\xf8_\x93U\x18\xdd\xc5ͮ\xa0\xe2\v\xb3\xc9\xe0\xdcN\xac\xd2\xceG\xb4\xcd-M\x1c5\xe1\xac\xf6\x12k\xf7+\x8e\xd5\xf6\x98\x82Ǉ\xf9y\xfbH\x96\xa7\x9b\xf1\x06\x8c\x8e\xff5v\x96U\x80:P\xee\x93%y\xb1\x93%\xef\x9ef\x94\xbd\x8c\xa7\xed;,ҦL\x9b\xda\x03po\x9e\xe9.Fք\xd1\xf5L\x1b\xfd\xb1\x19\xf5/\xdcb\x8a\x1a˘4\xfc\x97n\xfa\xa3\x93\xcfh\xfb\".\x9cn$\xea\xcc\x18\xf7\x81D\xcdQ\xbcԟ\x8d\xa5\xcd-L½=:\x1d\xb0\xbc\u007f\xf2,MW\xe8B\xc1?\xb8\x15X\xa1\x98\xf0q\x82\xc4ݠ_\xf8\b\xe0\x89\xa7m\x10U\xe9|\xd1\x1c2\x8e-\xff\x06\xf7\x9a\x92OS\x90\x9cS\"no\xef\x8c\x12\u007f\xe1\xf3O>\xaf\x12[\xf5\xc57\x9b\xffB\xe0\xfaU`iB\xf0S\xc9M\v)S\xbd\xa9C\f\r-y\xedˊ\x99$q\xf4fl-\xdaJ\xab:\xce\x10\xe7m\xc7\xed!)\xab\xfa\n\xad\xbe.,\xb6Deͻ\xeefqב\x86\xc0u%:\xa2\xaa9;\x95\xb983\xa3\x8cH\xb6\xb1\x904\x1eZdN\u007fdq\x94\x01\xc6\xd0\xf2\xe6b6\x0f\x97\xbah\x84\xe2aW\x94 \xee]\xf1\xcb\x12\x88c\xddL\x11'\"\x80\xca\x18I78\xb8)\x8e\xf4V\xd0q\x87l\x8a\xeb0Gk\x9c \x0fVֻ\xbd\xfc!\xe0\xfd\xb0O\x87N\xe3~\xeby\\h\xbfs&F<>\x83\xab\x0e\x02\x15\xb7\xf4\xcf_D\x8f\xf2\xe8e4X\xd8>O\x16c\xd9Z0izc`:\x89\x11\x8e\xeadm\v\x1e\x8d\xa9\x15>7N\x1e\xfa\xf5iT?\xbb\x8cl\xfe`\xc9:*\x8d\x1dչy\x1e\xc5Z\xf4q%U\x9aL\xd2J\x01\xdd\xc5\xf3\xfa\x03\xee\xcd\x15\xfd\"\xb5G[U\nhw\xdcB\xff\x9e8\x0f\x03\xab\xccm\xb8\xact9s\\Z\xec\xd1Ǝ\xe3\x90\xd9>{$hב\xb2u\x8c,k\xbb\xaa\xf1Ȫ\xafv\x8e\xec\xacQ\u05efr\x98\x9a\x15W\xa7\xc4j\x97\xb3.\xaaV\xdd\xf5cbg\xe5\xce\xd3`[\xa1\x88S`I\xdc-}P\xd7\x0f~%\x8d\xcb8\x9f\xaf\xca\xd8a\xc5\xd0{Df\xf8\v\x01,Ǻ>\x97\n\xe8\xd6\xe8\x17\xb28\xaf\xe0\xc4L\xc6\xe5\xf0P\xd3 U\u009agT\xf9\xacN\xa9\xed\xdcH\xab\xc2\xcaW\xaa?\xc9\x16\x93\xb0\x14Kb$[\x94\xd21cdխ\x96\x1c\x97\x13mפF\xb9H5\xd9\x16-\xa6\x1d\xeduM\x85\xe7\x16\x12Q\xca!\xba\x83]'g\xce+a(\x98\x9b\xb1\xb6\xab\x83\xe1\x8bP\xb3\bh\xf5olu\x88s=\xddE\xa2У\xdb5\xfbO8,G\xc2dmߚ}\xa9\xf9\xca\xf2%\xa5\xd6sGZ\x05\xea7\xc6\xec\xddB7\xa7\xab\xf6\n\xc7^\xaa\xf5XKe\x0f\xe0\x81\xab-\xb4\x05N\xe5z\f\x88ۡ\\7\x18\xe8\xdf/\xa6\x18}\x15\xe6\xa1\xf2<Ϯ\xc92|\x81\xae~~'ᬘ/\xc2t\xb4\xa0\xab\x92\v(D\x97\xe2P\x9f.ˠ_\xf3,\xceߐ#\xb5\xba\xecHl\t\xb4\x83k\xe1\x9dMT\xdf9\xc1\xd7T\x89>\xf93柕\xfcQ\x12E\xf7\x91o\xd1_H&\xe0+&T\x80c\xf3>&L\x01SȽ|\x01ͽ\fĒv[\xf9G\x8d\xe5oٷ\xb8\f\xb1\xe0\xa8=\x86`X\xe3\xbb\x00\xa0e\x1a\xe2\xe3\xb8\xe8\x02A\xf0\xf0\xb7\xebu\u07be\xfd\xb7?w\x02{\xd1\\\x81\x00\xd6\xfdӷo\xdf\xfe\xc7\xdb\u007f{{\xe7\xed\xde\xdb'o\u007f{{\xfa\xf6\xec\xad\xff6x\xdb\u007f\xfb\xf7\xb7\x1f\xcf\xf6g\x92\x0eq\x83\xaa\xba\xb7ҭ\x06\xa1v\xb9\x977h\xe9\xbe&\xee\x9c\xd3\xf6\x19\xa5-\xb3k\u007f\x88뾀ސ]\xc0ZD\x875\xb4\xcf\xfa\xdc\xd8`Z\xe5)\xee#\xa0\xf3\xa4\xeb\xd99h\x8fXx\xfc\xf5\x87\x97ߕ\xe5\xf254PM\xbdͣE⢭\bD>\xe7\xabH\x14=\xb9\n\xedD\xa9\u007f\xfe3V\xd3Ϯ\xe2<O\xa2\xf8\aH\xfb\x99\\\b]\xa9TD\xf5+\xe4/\xe3\x85\xdf\xf9\xf7\x17?w\xba\x1e\xb5\x85\x82\xf9V\xe4\x118A\xc2\xc2C\x10\xff]\x1c\x82q\xe2w\x9eN&`\xf9v\x988\x1d\xe7\"\a\b\x8c\xad\\Ɠs[w\x98\xda\x11\xe1\t\xfaMI\xb7Hap\x1e֮\xbb\xe0\xb54\xa4\x00@V\x85#\xac\xfc\xedB2!\x8f\x8be\xb6\x00\xee\xfc\xf6\x1b\x14\x83\xa5\xc4h0\xa0\b\xe5\xc8\xd6\xc1\x80\x93\xb1\x92\x83\xc1!.Y\xa1{8D\x9f;V3\xb7\x1b\xc3\xfcJ\x10\xb5\xcb\xcb1\xd4w\x91\x83\xea>&\xb6\x13[\xec\x88N\x88\x94Xm\xf8\x19\xaa0.\x80mW\xae\x96\xd8\x1c\xab\xf0\xdas\U000d9bcf\xc2Y\x16\xa5Y'\x9d(4\xdayQd\x8bZ;\xed\x16\n~pf\a'\xefdB^\xd0\xfbX\xbc\xa3ݶB0G뉛O\xbc\xff\xeb͏\xaf\xfa\x1c\x85\x86\x01\x8d\xbe\xf9h\x10v^\xce\xd3\x1b\x12\x86?\xf7\xb1\\3Et\t\v\x89q\x9c\x8e\xeas\x80\xe5e\xfe\x9a.\xb8\xea\x87>\xa4I\x8b\x18\xdf\xf6\xa78\x92\xaa\xc08\xd3\xde\x1f\xc0\xb8\xab$G\f\xcfȞe\vL]\x85\xe9\xb7y8#\xef\xf5\xb2\x12\x85jUip\xcfɣ\xb5\x83E\xbf\xaf\x8c\x82V\xfbǊ\xa8\x987\x16\xc5Oҏ\x90+*\xaefG^\xe7\x1c4\xee\xd1\xfe\xfe\xf5\xf5u\xff\xfa\xa0\x9f\xe5\xb3}\xd0\a\x83}\xc8\xec\xb0%\xbeFqp\x02\x0e\x1f>|\xb8\xbffia\xd04Y\\\xb6\x80b\xb6\x04m\xc0\t\xdcB\xe0\a\xfb\x14Rn\t\xd3bU`Q4\xd3K\xd9\xfb\x1d}C\xafz˔\xdd\"\x8ft.0\xd2\xf7 A\xc9T\u007ff@\x0f\xc9X\xed\xb0QTF2\xed\u007f\x9c\xfa\x9d\xa3N\xd0\xf5\x94S&\xe6\xe9\x96ZR{:\xc7\x005\x83;&\x9a\x9ccn\x1d(\xd1\xee\x8b\xd4n\xcfi\r\ua7c7ŏ\xd7\vui\x83+\r@ehF\"\xf2\xd3`\xc3)\x83i/\xb2\xd25\xe6#\xa2B\xd9\xd6GZ#\xf5\x03\xd9(\x01\x8cx\xbb\xc01w\x91\xd4U\xf9bcQ\xa6؋\v\xd7\xf9\xb9\xbdb\x90eO\x1d\xae\xa32\xef]|Ew\xe1ħu\x0fH\xa4\xea\xee\xf82M[`\x99ڜ\xef\xa7`\x90\xf0x\x11\xe7\xf6N8\x15P\x82\xd1G\xc1\x10\xdd\xd8\xe9T\x16Bb\xee\xca\v\b\xbe\xaa\xd2\xd0\xd1\xe2ڌ\x05\xa3I\x8c\xb5\x1e\xa9\xaf\x0e\xa0\xe7\xc9\xdf\x18Q\x9c\xf5\x95h\x1f9R\xb5\xf6\xe9\xbaqZ\xbb[Y,\xe3I2ML>\xccE\x17\xa3_1\x95\x00\xd3T\x84\x95Q\xe00\x9b$iJ;\xa4\u0603\xc3343:^\a\x1f\x85\x99-T\xfa\x01\xa7c\xc0\x14\x03\xfe.\xac'\xae\x93\b\xcf\x0e\xbc=N\xba\aI\x93l>\x0f\x15\xd0\xfd3\x1a\x95\x93\x04/ܨ\xd4\axݗ\x99\xc9\t\x0fqW\x0f\xd7!\xec\xc9\\\xac\xa6¹\xba\xd3\xf5\xc42\x85\x9e\xb8\x02\x03\xb2\xeaA\x8570\xaa\xd8S\x1f\x86\xa7wU\x8e\x9a\xa1\x9d\x04s\xa3:\x83\x8e\x11\a\x15\x9b\x11\x88\x06\xf6\x8c\x13x_$\xe2y\xc9>\x98}\xc6e\xf7\xeb\x84n\xf6\xe8Wf`\xee\x00ˮ\xb3\xe8\x1cɹD\xb0\bmVK\xb6:3E\x85\x1e\x88\x9a1|\xad0(^\r\xd4*\xb2\xe2\xd9\xd7\x1d\x1b\xe9\xb4\x05\xe9\xf2S\x91\xe6-H#\x85\xb4\xea=\xbd\xb5z\u007f\r\x9a\xd1\x145ڪ0\xaf\xaddT\xb3:\x83\x11\x1c\x1a\x01\xb75\xa1\xb1Y.\xbe\xab!\x83\t\x05\x9d\x94pw\xc2003\x9f\xc7\xd3p\x95\x96\xc7\xce{\xaf\xd6}\v\xd29\x82\x19@\b\xef\xa7|\x8d2$\nv:\xfaA\xc1\"\x9eQL ylC\xaf¡\v\x9e܈\xe9\t\xfc2\xa4\x11\x8eY]\x80\x99c\xd6{c|qK\xcc|J\x9b\xf0\xb4\xe3\xabKx\x92C\xd6F\f\xdd\xe5\xa2i\x8d\xef^\x9b;2\x9a\xb0H\xa8\xcd|\x9c\xa5\x8d\xbbA\x8c\xf3Ή\x1e8\xc1:\xfc\x11ʶ\x992\xc7p\x16\xefƈSM\xc6#5\xf0\x9ebk\xed\xb0\x9e\xde\x06\x92z\x9a\x06x\xa0h\xf0+G\x109\xf4\x15\xc2a \xa2\xff2\x15{\xfa\x05+K\x97Ht\x95\f\xcd`\xa5\xbe\xac\xf1Q\xc2)\x11\xb0\x90:\xf7\xd5~\xe7:Z\xb8\xf8\xa5y\xf6\xd1q1\n7~H\xba\x8e\xddN\vj\x8e\xc3\xed\x17\xffɑ\u007f\xfa\x1f\u007f?\v\x9e\xf8\xa7\x8f\x1e\x9f\xfc\xc7Y\x80\xbf\xf6\xde\xf6<L\xfa\n\xfe\x1b`\u00a0\xf7\xf0l\x0f~tῷ}\xf5y\x1a\xf6><\xed\xfd\xedk\x80ݯ\xd7@*A\xf8!\x85K\xb1O>;2\xf6p\x96\xb5c\"\f\x84\xfa\x93\x14^_\xbe\x1f!\xafy\xedR\xfa\xc5\x1a\xd6-`L$aj\x97\x9f\xeeR\xfe\xdbd\x1dGv\xc9|[I\xb9IE\xb9\x86T\xa9\xa1\xc8\x05\x03U\x05͓\xf3p\x8df\x11\xff\x86~\x1e\r\bH\x8f1\x1f\xd4x\xcbZ\x88\xf7\x90\xbd\x0e^l\xee|\xc0\u007fB\xfcg\x8a\xff,\xf1\x1f\\\x97w\xfe\xdf\xff\a\xff\x9d\xe3?\xf8\xdf%\xfe\xf3\x03\xfe\xf3\xef\xf8\x0fn\nu~\xc2\u007f^\xe0?\u007f\xc3\u007f~\xedxg2b\x87^_`\xd8Uj5fjq]\xf5\xd8n\x17\x95]\xe1P\xf8Ju\a\x95\xba\xeb\x19{\xc8\x1a\xe2j\xec\n\xae\x8b\xba]\\\xaf\x91U\xa9D\x9c\xc1a\xbch\x06\v>\x15<\x92I\xe8d+(\xdd甗\xaf\x86\x03\xa3\xb8\xea\xc3\xde\xe8P\xefE\xf9!̠\x84\x9c;8\xb85ݣJ\xa4Mt\x80\xee\x8b\a\x81\xcb*\xaeu\xf7\xe9\x03(\x9d`\xa13{T\xc7`\x04\xd0\xc3\x03,z\xf4\xb9\xccҍ?\n\xba*a\xb2\x1a'\x13\x1b\xe0@\x03\x88x\x92n\xb9\x99/!\x93\bG\x18\xdfa\xb7\xe9\xb0\xc7<\a\r9\xb2k၅4\x1c\x19\x14\xa9\xa5mt\xd4\x1e\xdbD\xb5\xd9\x18\xa9\xd4\xc8]J\x12\xa0yk\xb8\xe1\x118\xab \x80\x99Zi\xbdܥX,_\x98\x95\x84&\xf9$\x8dw\xa2\x94 \xcd:\xd1\xd7\t۩\xb0s\x02\xe7\xe2\xeeK\x95\x85_\"\x1d\xc6\xcad\xa7*\x19\xb2A\t\x11\xc4\\\xde\xdc\xd4z\xba\x93\x80\xe5^\x93\x11\xae;[\x95UV\x1ec`\x01\xf1\xb2\n\x94\xeaAnGϞ\u2d9aȆ\xbc\x1e!v\x1e\u007f\x9bt\x8b\x92\xbeU\x11\x94\xa8iT<\x11\x0eM\x1f^}\xf7Ľw\xd2\xc3%2\x0e\x10\xde&\x81\x11\xed\xda\x14\x11;\x0e\xec\x87\xdb\x04\xc9\vf\x8c\xb0\t\x8d\x13fu58ٖ\x96\x86\xb4>n\x18t\xae\x8dc\xec\n\x82\x9f\x1b\xf0\xfa\x18\xad\xcb\x14\x86\xe6\xf1\xe7\xbe<\x89\xa5W\xfek\x87\xb5\x05\x86s\x90^kʏ\x18\x8c\x92\xc0XiӮ\x85\xb6\xb7'}\xa4Q}\x99\x87\x8a\xd8r\x9dó\xfaN\xab\x88\n\xf1\xea\x8dO\xbb`}i\"_ͺ\xb8\x001\xf7D\xfcݪ\x02\xc3\x03O7\x9e\x96\x90:^\x95\xb1\xdfQ\x85:2\x84k\xa0\xaf)\xb05\xb3\ns\u007f\f<\xfb\x05\xe3\vf\x8b\"K\x13\f\x98\xe3\xd7=P8`\x9d,\xe4\xe3\x867z٠˷wd\xe4}/\xfaFm\xea\xfa\x1a\x11rs\xa7\xaa\xbe\xea\x11\x19\xa3\xbdEGs\xdb0\x06\x93\x8f\xcfp\xd0=w\x95D\xafn\x006#\x97\xbf)\xab\xb8\x8c\xaf\xffZ\xe5\xe0'\xa7\xd3:\xa6J\xa7%\x13\xbd\xe0aO\x01\x8a\xea\xe7\xf1,\x8f\xc9H\x19>\x18\xc8\x19\xf4\xa7\xef\xbbNF\xa8\xcd\xce\xf0\xc8\x1b\nmu\xe4\r\x84\xaaT\xbf\"\x95\x1b\xab4\xb0\xea\x06\x9a0j1\xa8\x9an9˱m@gyu\x13|jl\xe1\xf5zb\f\xe3\xaa؟:\n\x9e&g\\A\x10x\xe6\x12\xf7\xd8M\x19\x87Ko\xa2o\x8c\xdb(\xa1{\xad\\\xd6t_\b\xfd\x80Oi\x97\xb6\xc5oV\xf9\xda>C\xfd\xf4\x1a\xb5\xa3SUy\xd0^{]j\xed\x0e\xc1 v]\xef\x02\x86\xe3\x80}t\x8b\xa1\xf8+N\xe4ߋ\xbf\x8b\xae\xf4>\x04AҪ\x10ט]\xe1\xa3ɍ\x87mP\xa99\x1d\x05i\xd3o\x1cX\xcf\xe1\xd2\x19)\xcf\x00\x81'<,ƚ\x1e/$\xa9\x12H\xe9\x85\xf7\xb6\xdbar\xe4)\x19\xbb\xa5\xbdb\x8ba\xe6j~\u007f2d\xac~tY\f\x1a\x89W\xad\xb6\xb7u\n\f6<V^$\xce\x16H\x9a+>\xf1\xce\xf6{\xf5P\xb0\xbf\x85mxs\x11\x86G\xc2\xf7>\f\x06\xa2\x03\xee{\xed\xa2\a\x12\x95\xf5\u05f8\xd54\xa7\xe7\xc6\xcc\xe5y\xd6w\xbcE\\\x9cB2=<,\xefL\xdaoO3\x04\xf9\xed\xed\x9dx\x99\xfdl\xbdz\xae\x19\xa0\xccH\xbe\xaa\xdd\x174Y\xd3m\x8a\vٌ\x8b\x00H\xbf8\xeb'\xbd\xde\xd6G\xadk\x14\xe0R\\\xd1\xddF\xce\xe8\xc6\xe4\xe0p\x1d\x1d\xef\xfa\xe0\xfd\r8H^\x8f&\xf3\x9a\x1b+\x80wl'\xdf\xd1\xfal\xd6;.l\xbcw\xbe\xc5\xedy\v\xbd\xa8\x86\xa7\xd6\"\x12G\xa7\x8a\xf6\xf9\x95\x934\v\xc1\xbc\xe2\a7\xb4\x94\xac\xaf\rw\xdd9\xc9\xfd\xec\x18\x8d\x84\xfe2[\xfaƶ\\{\u05f8\xba\xa5ր\x1d\xba\xa3\xad+$+\x15\xc34\xf5\xd1p\xbdP\xba\xff\xe0\v\x1bUh\xfa\xf7\x18\xc0k\xed\xb5\xbf\xa5=v\\\x92l\x9dvt\xf5\xadk\x98\xe2ԗ\xca%P\xcd\xf7\xcbZ\xb8Y\xf1\xe8Xg\xcb\x1c\xf5\xb3ː\xb4\xa7)\xc7l\xf4\x94e\xa72\xfcB\x90\x92o\xec\xc41\xae\x1c\xf0,\xe3ie\x8dA\n\xde\x01\xf9FO\xc9\x19\x86m2|T\x96\x00\xe4\xe75\xe7\xa2]\x06\x1f\x9c\xc7\x1f\x97\"\x87#u^\x8a\xacjØ\xb6\xf8C\xec\xae\xdb'P-\xfe\x80\xc5\x02\xa4\fe\xcaP\xd3\xc5b\x9a\xd0mɮǋ\x84N\xb7\xfa\x19tZg\xbb\xa11\xcd9\xc7\x17\x91\xd4e\x82\x02u5\xcd3\xd0\x1c\xec\x84f\xc8h\x86\x15\x9a\xc0\xb8\xd2J\fP\xed\xde\xda\\4u\xc7l\xe2\x06Ϋ\xb1\xb2L\xa7\xe6\x1f\x91\xd3e\xfe|l9Ba@\x98\x1c\xe32\x80=\x1c\xe0/\x98&\x0e\xee\r4o/H\xeb\xa1\x00H\x90P\x82\xb4Z\x14\x94V\xb0^A\x82\xa5y\xaf\xf7\x12\xe0\x1dm\xe7b\x1e\xa2\xc0\xb5\xf0OoSs\xc5\xf0;\xb7X\xa7xsM\xbc\xb9\xd6\xf0\xec\xd6(\xb10\xb9y\x9b\xaeC\x1c(-m\xbanmS\xb5 \xbanjҥ\x1cU\x97R\xba.娺4\xc5\fm*G\x15\xbc\xb6\xfa\x84\xf1\xb5\x00\x16\x1cng\xc1%\x8f\xb1˶1\xb6؍\x9b\x97<\xce.\xb7\x8c3\xe6\xc4m\xdc_Ev\x8c\x05;\x86\xad\x9cVK\xccK\x17\xa7\r{tی\xa1\xdf-˚\xee~\xfd\xce\xd3\xc7\xebٸi\xe2\b\xc5^\xf5l\fsñXq\xa9\xa4\xb1\xe6\x82\x1c\xe6tA6\xefz\xe1\x8c~\xcd\xe0\x17\xdd\x13\xec\x8f\x01!\xe6\x8e\xfb9\xf4\x15\x86a\x1e\xcf\xe8s\x86\x9f\x007\x1e\xd3'\xea\x90p\xbc\xebZ\xae\xf3U\x87_b\x01Jޝ\xc7k__\xdc帺\xcbiy\x174C\xcd\x10j\xb6\r\n\xfbt\xccK\xc5-\x8c\xfc\xaeH\xb70\xf2\xbcHmFb\x92\xce\xc8\xf3\x011\xed\\\xac'\xc3~\xd1\xf5R\xfe\x05\x03\xeb|H\xac³\xb6s\xb9~\x83%\x11\xba\x92\xc3gʟ)\x9e\xc4i\xa7\x16PH(f\xf8ճu7\xa4=\x02y\x13ٺ\xde\xde\xde\t\xc02\xa0\x1f\xd9\xe6\x03\xdd{\x88\x00\xf8D\xa4\xef!q\xf4\x91\xe2GJ\x1f4^:\xed\\|\x19n\x13\xc74\xac\x89#&\x19\xe2\x98J\x8e\x85tA\xb6\x1f\x1a\xe2\x98*>\xe1+:c\xba\xb1\xda\xc7i.\f?Q\x1c\x81\x13@\x03q\x02\xea\xde\xc3*\xa8\xf1!m4\x84\xe2C\x93\xa5\x1d8\xf1\xddd\xab<M\xea\xf241\xe5)<\x97\xf2\x14R\x94\xa2\xfe\xa4\xabqg|\xae\xe4)\x04\x901\x85\xd0\xe9O\xf0sb\U000694a7\U00079527\xf1y]\x9e\xc6\xe7J\x9e\xe0\xe7\xcd\xe5i\x92\xbe\xa3\x0e\xc6cd@\xc0\x8c\x9b\xe0\xc7D|T\xfc݅\x8bOE\xf0\xb8F\xcbx-,≴\x8cC\xed\xae?|\xb28\xa8ρ:>\xc3(\x15*]\xedTt\xbd\xa4\xbeyC\x9a{ \xcc\xff\xb5\xb8\nh\x90\xe9\x87t\xe5fL\x97r5\x04\xa2l(\xcaNĭ'\xb5/\xa1\x03\x8dM\xa0\xb1\x02\xba\xc9\x1ae`\"Y\xe3\x1eamn\x99\xb4\xf3\xfcGyϸu\x1b\x13\xaf\x80N\xe4UP\xad5\x97xc-4\x8dPJ3n\xa3&\xa7\x97g\xb5\xbd\x9do6\xaf\xc2y\xec_\x06\xc0\xceKb\xe7\xe5Yи\xfa\x9c0\n\x04u-\x86+b,\x8b\xf8\xb6$1\xa8#\x1b7 \xdb\xd6\x05\x84\x91\xd8Nh\xb0u;\xb0]\xec]\xd7w\xb7\xe8\x92Jo\xef\xec\x89\xff\xe4\xe8m\xb4\xf7\xb6\xff\xe4mt\xe77\xfa\xb3\x17@\xdai\xfc\xe2\x8c\xf2\xf1\xfb\x89\xba\xb0b\xec\x0e\xd31\xbd\xabc\xbb[\x82p&\x8b\xa2\f\x17\x13|0\x84G\x1f\x86\v\xae\x0fJ.Lmi\xc7(_\x1f\ti1\xdf\xe1\xed\xbf\x0e-\x878cld\x04\xf5\xfa\xc4\xcbj\xa1\x88 NvZ\xe7&U\x9b5P`I\xb2\x16\xf8\xb1\xee\xf3\xb0\x00\xa5\x8b\x04\xed\xff\x87\xff\xd5o\x90\xf1\xd6\xff\rfƷ~\xb0\xdf/\xe3\xa2\xc4\xdc#\x933\x80\xe0Y\x96b\f\xf7\x1a\xb5\xafq\x16ّ7Fh$\xc2%RPm\xed\x8d\x1d\u0605E\\U\xc0\x11\xd1$=7;\xb4\xe1s\x18\xa0\xb7R\xc5\xea\x12\x96iW\x82\xdd7săuF\xa9\xc0\v0\x16\xaf\xd0J\x95\\\xf7s44s\xb41\xf3>qV\xf4\x06\xdf\xd0\xe8\xd0B\xae+\x12\xbb\x9a\x95R\x81\xfa\xff\xf9\x9f\x00\xf1\x9f\xff9\xc3\u007f\xc6\xf5\xdb^\xb3\xf1OZ\x18}\xae\xbf\xafG\xd6\x17\xa7f\xc42\xc1>\xbdP\u007fL\xd1M̳\x12u\xa9\xe1R\xbf\xdfտ߭\xb3\xe1\t\xc0\x1c\xa9\x9d.\xba\xe0\x81\xaer\xc4\xed.\x1d?\xd2\xef\x19\a\xe3\xa0\xdfc\xf6\xee>\xd0,\xbf\xdb\xe4\x88x{F\xff\x8e\x03m\x9aE\x16$tr\x91h~\xb3\x04\x9e\xc3,\x80O\xe2\xa0\x16\xaa\xb2\bǌ\xb3ff֘\xe36Rָ\xca2+\xab|L\xee\xde5\x9cLr\xba\xcf\x17\xe8^(\x16\xc4l+Ę!\x8cSH\xa37\xa20\xbf\xfc\x9d\xfa\xc2\xd1,&\xe2\x12-\x12\xea\xa0\xc0 N\xa5\xcf\x1a\xd2\xc7-\x84\x9f\x9bk\x8a\xba[\v-Y\n\x0e~\x8d\xa2!\xc5B`nD\xbcˁ\xac\xbd\xc4\x12\xads$\xce\\\x89\xcea\xc5\x1a\xd3\xf6\xc0\bq\x87wL7\xcf;_M\a\xd3\aө\xb8\x80\x821\xd6ޯbX\x03\x97\x9c\x19\xc6\xe3\xe8\xbe\xcc|\xbf\n1q0\x98N\xab\x12\x908\x0f\xa1m\x04\u007f\x1f2\xa2C\x99\xf5a\x95\xcb*\xaa\x02\xe38\x99q\xea\xdd\xe9\xddh\"S\x93⽠g\x1a\x1fN$\x8aqJN*X'\xfe\xafJ]L\xce\xe3(L\xe7\xd9\"\x12\x85ƓHe3&,QU\v\x89WI\x96b\xcc\xff\xceW\x0f\xc2\xd18\x1eɬ<\xbb\xc6\xc7+\xbe\n\xef\x8e\xc2Q(SWy\xba\xb9\xce2\xc2\x1f\xc5\xe3\a\x0f$\x1f&a\x14\x97\xb2\x92\xbbӇq(\tØ\xe4e\x1e\xaf\nŌA\x95\x95MhB t\xa3{\x0f\x87\xf2\xd2\xcf$\xcb\xf12\n6\xe2\xfe\xf4\xee\xa0J]\x80\xe4^ǹ\xac\xe9\xde\xe1ûq\xa4e\x17Iz\xc9\xe5\xa6\x0f\x14#'y2/\xfe\u007f\xf6\u07bd\xbf\x8d\x1bY\x14<\xffn>E\x9b\xbb\x99\x90\x16E\x93\xd4[\xb2\x9c\x9f\xe3$\x93\xdc\x13'\xd9ȓIFW\xe3_\x93ݔ\xda\"\xd9\f\x9b\x94HO\xf4\xdd\x17U\x85G\xe1\xd1͇\xe5d\xceݙ\x9cc\xb1\xf1(\x14\n\x85B\xa1\x00Ta,\x8e\xff;\xe9w\xf6\xf7t\xfa2\x1e{\x83\a\x13\x95S\xeb\xb8\xc72L\x8d㞕q\x9d\x0f\x93t<%\xca\b\xba\x1c\xb6\xad\\\xa1k 1O\xe0?+#M%\xc0\xc3}M\x17\x99\x13\xaer{\x13\xdff\xd8J\xd2;:䭌\xe2k!4\x90\x1d\x8f{\x0e\xe2\xf90\xbbKuk\a\a\x87\xbd.\xefp\x8e\x8f\xec\x88p\xc7}\v\x91|ڿɰ[''{\xdd~\x9feM\xd3D5fU)\x90\t!+=99<\x8ayV\x1ak,\x8e\a\xbd\xfe1\xc7\x02\xedǊ\xf6\xfb\xc7{\x89\xd5\x05\xccU\x94\xec\x0e\xf6\xc5\u007f~n\x1a\xce\x15\xd2\xe4\xb7y\x9e\x15rT\xfbi\xd2a\xb9f\x0e\x9c\x88AH\xf6TV\x9aN&\xf4\xb0M\x90\xa5\xb3\u007f\xc23\x8aۥ\xe1\x92\x1ec\x9fl\xa4p<<\x81\xffxz\x1aHϓk\xc3ϝ\xf4\xc4L\xcfA6M\xc5\"N\x93\xbdׅ\xff\xa9\x9c!\xcc\x0f#\x91\x06\x83x\xa0\x06@\xe8\xf2B\xdd\xd3D\xeev\x8f{\xa6\u07bc\u007fSd1\xd5a\x82\xe0:\x16\xaaN/\x9f\xe64E\xe0?\x95s\x93\x173\xd3\xd01\x93\x8b\xc0\xf2\x04)9\xd2\xc3o̓$\x16\xd2C\xe7H\xaa\x1c\xb7\xe1?\x9d\xa8\xf9\xff\xd8\xf0\x10\xa6.ӡ\x98\xea8\x05\x92\xc1@s\xab\"\xa2\x05\xe6&\x1f\xa7\xcb$\xbdׂ\xd5d\xcc\xcc\b\x1e\x9e\xf4\x94\f\xcd\xc6\xe0%^\xf2o?9\xe8\x1f\xf4Y\xce5\xd2a\x1f搢\\v\x97O\x97\x92\xd6\x06\xbc\x9e\x8b\x83vzx\xac@\f\xe3\xbbt\x9c\xa4S\x9c\x00\x87\xe9\xe1 v2\xc4h\x177\x12X{p\xa0s\xefǚ\x1eG\xfd\x81\x99\x86\xc3TL'1\v\a\x03\x9aU0\xdeZ\xb2\x0fA\xcbS\xfc\x13'\xc9qz\xc8s\x8c$\xe5\x04\xa3,)\xccR.\xfe0G\x8f\xa2\x19\x04\xc1aqҵ\n\xc9\x01M\xf6\xe0?;G\xf6⤝\n\x86v\xb2J*\x99q\xea\x1d\xf6;<ǈ\x13\xd1\xef\xb6\x16'\x94\xc7\xe4I\xb7\xdd\xeb\xc6v\xae\x99\xa4\xc7G\xfdt`gryrtt||r\x12\xc8N˲gi:T\xc0{\xed\xfe~\x92\xf2lF8\xf1\xbf\xd4\xd0`\x94\xaa\x15\xa7\xcd\x13u'\x84\x88M\xf6\f\x9dǔ\n\xf3[\x8f+\x93\xf3\xd6,\x16*GNt:\xe6\x9a\x01DD\x98\x8fl\x9d\xe4\xf0\xb0\x9fhRQ\x01\xbe\xe6i\xe6\xa2,\xb3\x04\xf4\xe2\x83\x03=l\x949\x99O'C\xacy\xb2w\xd4NzV&\x1f\x9e\xbd~o\xef\xa8cgs\x81\u007f\xd4;<NS;\u007f\x02\n\"\x13\x12\x03\xb1\x18Z%,\xc1\xbe\u007f\x9ct\xf4\x02E\xf9$\xda\xd5D?\xea\x1c\x1c\xab\xe96ʒ1\x9f:\x9d\x93\xceɑ\xa6\x99ح¥ʑT\xca\x06\x9auFY1[N\xf3B\xebe\xa9\xeeR\xde\xef\xc7x\x13\x9a2z\xaa\xa5q|\x17\xbf˙\xb4N\xd281yK\xadi\xa8\xd6\xc5\xec\x1b\xe2\x83]Q8\x19\x1c\xe8\x81\xc7E\\\t\xbfv\x9b\xa7&Ӹ\x87\x03\xdb;N\xbbj\x80\xf8\xaa\x1e\x1f\x98\n\x98,i2\x18\xec\xf3\x1c5\xd0I,\xc6R\xb5;\x89\x87\xa9%\xd8\xd34=\xd6샹zƋE\xfd\xe4\x98\xe5X\x03\x14\x0fD͔\xe5Z\xc3#\xf4\x99\xb6^c'\xf1$^Ƃh\x13I\xcfA\xa2\xe89I\xe3\xfe\xcdd>\x18Hjƽ\x13\x9d3\x9d\x93D?>\xd8S\xd3\xc2\b\x96~\xbb\xaf\xd8s2\x9c\xe3\xd8&I\xdcN\xd4`\x88\xfdWb\xd6\xe2^;5s\xcep\xf91\x1f)MD6\xdf\x04s,\xb5\xfa\f\x1a\x8e\xd6q\xa6\xf92\xd62c\xbfsx\xa2y\xa7\x10\x82{\x98\xeaZǽ\xfd\x83Ξ\xce\xd3\xe2/>n\x1fuu\xf281\xed\f\xf6\xe3\xfdC\x85\x81%\x14\xd3\xe3\xde\xc1\x91\xc9(nҡ\u052c\x05g\xa9\xb1(\xb2t<F\x89\x12\xb7\x0f\xba\xddD'\x0f\xefh\x1d\x13\xa4\x13\xff\xa9d[\xac\xa6\x8a\xa8\xd6t>\x8c\x0f\xcc\"e\xcbZA?\xbd*\xd8b\xd6\xca\x19k\x01\x1a\xeb\xe9\xe7K\x04\xb1APy\\$\xef\x1f\x1ew\xf5r?\xa3\x85.\x11)z\x95\x9e\xa5\xb4.\xb6\xf9\xba\b\xbb\xc6\x19\x8d\xb3P>\a\x89\xe2\xe4Y>\x8ag\xb9T#\xf6\xf6\x15=m\xe9#\xf8%Q\x80\x8cJ)\xa6JW\xd3\xf9\xfe&\x8dg$S\xc4\xf6iO\xa7\x1aEάĘZ\x8c\xf2[\xbd3\u052a\x82\xbd\xbahΣd3\x17c\\Jؽ}f0\\#~\x97U^ǻ\xb3\f]\xe6\xf5\x90k\xe7jX.{\x1d#\x02\x9c-6\xa3ẖ\xb8\x1b\xc7\x12\aǜ\x9f\xcb\xc6\xea7p\xb2t\x03\x87\x947\xada\xd8\x12w\xd3dv\n\x85\xa5,\n vD\x81\x1d\x01`g\xe8\xd9\vD\xb6c\x86\x13\x8d\xaf2\xc3\xf1J\x8fk\x86\xb3N=\xc9\xc6q#--\x85\xfc;\xd4ީ\x1eB\xe8|\x14;T\b\x19m\\\x1a\x96#\xe3\x18h}ے:\xdb\r\xf7\xd4vz\xd3wY\xac\xff!,\xd6W,\xd6\xd7,\xd6\xd7,斆\x13\xe3\xcf\xd5\t,Հ\xa3r8\xf3\xbd\xd1\xe6a\x95W\x97>\x13\x80\x1f\xe1\x9cQ~\xa3\r\xfb\xa6\xd1\x00\x13\xda\r\xd8Ϡf#\x00\a` \xc7\xf6\xc3\x1c\xdb\xf78\xb6\xbf\x9ac\xfb\xebr\xacuNj\x8fK\x9f\xd9G;\xed\xb6\xe6\xc7\x1d\xd5\xfb\xff\x06\xcfb\xa5Le\xdb -\x8c*\x98v\x1d|襨\xc4f\xf7\x83\xb1Y͵\xf2\x04\xd9\xc5Fr-\x9dV\xd8\xfe\xb7-\x88C\xf2q\xbd.\xef\x0e+\xb8\xb1>\x04\x0e\x1a\x02\a\r\x89\x83\x86\x15\x9c\xcek\xc0\xeci\xdd0\x81\x8a\xbc:4\xbc:$^\x1d\x02\xaf\x0e9\x97\xd7w\x04\x80\x1d\xd1\xe2\x8eo\u007f%\xc2\xc3\xc3\x16'\xf1\x17\x91\xd8:9h\xef\x1f5Uү\xe4\xa8C~\xfd\x03\xbeZ\xed\xe3\xe3\xe3=\xbb\xaa\xc3\xed\xa2\xf7\xab\xb8\x9dWZ\x97ۡ_\x1f\xc4ݲB\x1c4\x8c[\x18\xad\xc5\xed\x06\x9f\xad\xb8{}lVr\xbb\xbauB\x18\x94\x03V!\xa2\xd1\xf1\x97\xf5\xe2,\xe0\x92\xb0\xf5\xdb<\x9d./\xb0l>\xad\x17\xea R\x03x9\x1cn\x06CT\b\x80\xf9)\xcfg\xfc%\x9d\x13a\x99\x95|\rNdR\x19G\xd3Tn\xa1s\x99\xb4P\xcd\xc8w\x84\xac\xc0}ڻ\xcdd\xf5\x8ab\xa3\xfc\xfd\xea2\xc5\xca\"\xb9S\xc2\xeb\x81\xe5\xc6w,\x141\u007fD\xad\x0eK\xa7\xf5PP\x0f\xa5rz\"$\xc8E\xf6\xfe\xfd\x90\x9c\x13\xd6\x14\xd8\x1a\xd3 \xab\x87[\xb7J`(O^\x05e\xfe\xce\xcelh\x15c\xef\x00l\xcd\xc7pvs\x01^\xc5y\x13\x8d0`C!Y\xdd\x19\\'V6U\x12(p\x11ty\xa5|\xf1\xaa\xdc\xea飋\xc1\xf0Y\xbe|U\x86%\u0382\xcd\xcay\xebg\xb4\x02\xe4\x97}\xb1\xae\xe6\xcf{\xe8\xc2C;?\x97\xdf\xf8\v\xa2\x8a7#\xf9\r\x1fg\x96\xe3\x1b\xc1\x03\nd\xf4\xc4\xe1\x02\x9da\xe3\xfd\xb6\xd0\xf3Z!\xe38\x8d~\xc7\xc2\xf1\x90\\\x91\xaf\xa9(@\x8e\x15eG#/\xef\xc5\xcaO\xec\x8ay\xff%S\xc1\x85\"\xbc\x91\xa57\xd8uU\x12]c\xbd\xbbj\xb0\xec37\x88\x0fw\\F\xb0\fR\x99\x1f\xb5\aC}Q3X\x1a#U\xf0\xa7@\x06%\x89\xb6,\xadh\"'\x1eR\x1f\xfem\xbd}\x9bĳ\xf8\xed[t\x8b\xecFqQ\xf5\xff\U00097a26\n\xd6\xe0F\xcf\x18\x83\x15\xcbl\r\x03\x16F\xfe]\x19c\xc1\xc6\xd4zW\x17\x8ac\x1c\xe4n=.\x96\xc3\xear\x96u\xe7\xf8F\\\xfb\xb8\\\x8a+\xc7#0\xaa\xae\xe4p]\U000e3c56?%T\x84\xff\xfaz\\f\xb1Yx\x0e\x8d\xd9\\\xf9\b\xdc\x10\xcffS\xd7Ӏc\xa7X\xed&\x9bq\x01\xf9\xe0\xb3\xeej\xd9^\x9d$aq0\xe1w\xdd\xf7\xd4H\xcf\xeb\xa5\x0fI\xf2}\xe0ŴC\x9f\x05\xe8S\x11\x1c\x18H?\x03\xfa\x19\xfd\xf7\x17urj\x00\x8e\x19\x9b\xac0z@pK\xdbM<\xd8\xec\x04\xfe\\2j\xafAX\x83y\xb4nq2\x10Q\xd9j\xa0\xe0%\xfe\xbej\xb8\xb7\xfb\xa0z\xe0eY\x15T>\x1e\xabƲ?\x8c\x8b\"M\xfe\xe4\xe1\xa4iV\xe7^7E\xd5Q\xbdA\xde\xf3\xeb\xcf\xfe\xf9\xfb\xff.v\x9e]\xc3\xe6[^\xad\xcd,\xafp\xdc\xed\x8e\x14\xa3س\xef\xb2bf\xcf\xc3`\f@\xf2s\xd5\xcf\xc738uE<(\x98\xa0\xba\xa0i<\x10Vż\xb1\x9a\xfe\x9e\xf9\"\xb5\x10T\xfe\x17\x8c\xd3^U\xd9\xca>[\x85\xb45\xf0r\x1c\u007fJ5\xf2t\xc5PrAYG\xdc)\u009d\xf5m\xc1\xd4\x12\x8b\xc7\xe7k\x05x\x13\xd6.f\xcbaZ\xc2\xd8\xe0B)˧\xe8S\xdf\r\xe8\x1e\xf2\u007f\xaa\x82\x84\xee\x95s\xfc\x93\x12\x8eg\x81G\xd58\x1b\x97\u007f\x92\xba\n\x99\x95\x04\xc6>\xe9\xe2\x92\xc2\xea\xf3ʢL\t\xb5\xed0?\x121Y\xea>\x1b'\xf9=ȹW\xf9h\"\xa4\\r\x81\xed\xd93\x15X\x16\xca(\xf7\xb6\x18\x04Ɩ\x87\xba?\xa6\xaf\xeb\x8c2\xf5.<L\xabF{\"\xb1\xf9Ȓ\x8cw\x80(r\t\xa5\xae\xce6\x9f,\n\xe3ǟ-\x1a\xf2&\xd3\xc5u\x11o\x91\xadܷmG\xfa\xb6\x95\xd4@0\xe8\x12|<\x93\xfem\tAIR9\x05,uO@\b\xbc\xb0\xc6\xf89Z(\x92c\x1f\x00\xc7l\xe0\xc6C/\xba\x85a-\x9fCU\xfd\x90\xbbVSQOɃ\xb2BB\xe5\aZ\x0f@Ԝ\x1c~\x11\x1e\xc2\xc1\xf87|XE~\xd7A\xfc\x96\xe4\xcf\xc6\xe3t\xfa͛\xd7\xdf\xfd\xc1\xc47\xed>\x0e\xe99\xbcu\bo\xb5\xbf\x01\xd9E\xd7\xd2qR\xe67K\xfb\x99\xa7b\xbe\x8b12\xaca\xe6+\xb1F'\xf5R\xffO4Hʑ\xfa\xdf~\xfa\x96&\xbc\xed,\xcfiOT\xfb\x80\x16K\xb4Y\xfb\x1d\xea\n\xed\x997J\u052b[Z\xb4\xc2\x13\xc2!\xe2\xcfU\xf4\x16\xeaU:\x9d\xf9B\xba\x97\xc2%9\x8f\xeeT\xbc\x84\n\x94\xf9\x05\xd6ܜ\xf0\xcc\nW\xa7\xd6\xc9>Z2\"\xd4X鈬\x87Kɐ\xac\x87ʇ\x8e\x95\xea\x01xlğ\xab\xc6j\x9a\x8e\xf2\xbbt\xd5;\x8f\xd4:\xb2\xb6E\b\xedJ\x95\xae\xef\x1br\xd0\x13&\xa66dY\xd9(\xb16\xd2`͉\f;\xe4\x80\x03O\x88\xe1\xe62UO\xa89ui\x96\xc0?_\x8a\xba\xcesk\u007f\xe3O\x06\x05]\xbe\xe4\xed\xdfX\x14\x03%\xa9\xc3\xfdi\xca\xc4\xf9\x04\\\xadA\xff\x95y$\x85\a8V\xc2\"\x9b\xf1oc\x04\x8069\xd9X\xc7\xf8\xbe\n\xc3f\xfe\xac\xbd\xfc\x9a\b\xa2*4\xa6\x82l\x02i\xbe\vt\xcb\xd1O\xe5f+l\xfa\x88\";\xa0\xa81_0cH\xc0\x92\xe1n\x8el\xf4\xf1\xf9\x94\x02\xdcp\xdd\xfbh:]\xbe\xdbٹ\xf2L\x1e%~~\x9c\x16\xecؤh\x94\v;\xe71=4\x91\x01\u007fv<+?TQl\xb4\x0e\xc5\xf4\x18\x98\x01\xe7##\x83\xaf}\b\xd5\x18\x03\xcaH\xe7\xc6^\xc4`\xf0\x18\xb1\x8e\x83\xa3\x90\xc1\x92s\xa6\x1c\x1d\xcd\xd6Ԍ\x19-jU\x9f\"T\x8c\x95\v\xc3Rp\xa1}\x8cS\xa3\xdaw\xd0\xf4:\xba\n\x83OJ\x99\x84\xe4\xd1\xc6#\xee͑\x15cU\x90\xbd\xa1\x8c\xcb\x11k5\x97\xaa=Hy\xd4\fa\xd7v\xd1s\xa6뙓\x15`\xc6\x10#6\x02\x13n5\xbb\x04\xd9\xf2\xff\x18\x8er\u0604\x1eT\x8f\x1c\xb7r\xeb\xe3Ǉ\xc0\xc2q3\f\x83X\x8d;!\xb4V3\xa1\x8b\xb9?\xa0\xc1\xb6\xd5_S\xbdE5m\x10g~1\xcb\xda͊\xda\x19\x1as;\xd95\x98[\xf0I\xbe\x9b\xa64\x89\xa9\x15\xcaf-\xea|hLVV\xcd6\xdc(\xc7\xfcL\x81\x9dL\x94\x9c\xe4=q\xb7{\\E1\v<\xbd\x9a\x1e{Gg\xed+e,m\xac\x17/\xbc\xf2\xf8\x02[4\xb33tHU\xe1\xc7\xdf%\x04\xd2\xd7eqL\xac_^iM\xc9)@Y@\xdd@\x86w\xb4\x14\xda\xeb\x9a.\x95S\xc2(\x87\x8a\x90\x99\xb2\xab\xb1e\xba\xe9qQ\xf8(\xf0!\xe4kk\xabƃ\x9e\xf9$S*r\x96\xbb\xc2\xc6\x12\xd6\x01\xbb\xaa\xba\xc8f\xd55E\x81P\xe4e\xaa\xbfZ)\x9f\x8f\xcaN\xc8ݐݛZ<\xb4\x95\xcb\x1c\xae\xaa\x90J\xa1<N\xc7\n\x94\a\xd9\xd0!&\xa5\xacw\xcaYu\xd8)!\xbbG\x9d\xbaAk2P\xaaj;p\xc2\xd9\x0e\x1cp\xca\xe3M\xa1\x12\xff9G\xf1\xed\xd0q\xa9Ԇ2\x8e\x94\fx\xebI\x9c\xbf\xfcE\x92\xa3\xfa\b\xb4\xea$\xdc\xd6\xe2\x1f\xfd\xec\x13\x03\x9d\x87&\xccG8~\xe6t\x04W\xac\x82\x14d\xa3\xb57Ug\x91\xf2\xe9\xbc\xe1\x914\x16\x00\x88\x82\xecc\x8a\")\xa5;|]d\xbd!z\x1d\x87\x0f.\xe8,S\x87\x1c\"\x1e\xf0\x91B\xbd#\xa2k\x1fD+\xfbv\xd5qNnۍ\xfa\xf9H \x15\xb3\v\b&Ż6 \xea\xbeҹ\x95\xf6̍\xc6Q\x0e\x17\xe2\xc6\x11\xf2\r2\xc87\xf5\x95\xdcU\x11\xee\x0e\xa2`N\x04\xc0\xf41N\xac\xf0\xe6\xd2\x1a'V\xaaq;\n\x9b\xa6\x93\xc4\b\x8e:(TVɁ\x04\f\x17\x15\xa5\x00p\x97\xf2\xeb\x8aE\xbb\xdb\xf6Ъ>\xb6ϒ/\x85\xc8\a\xbb\xf6\x0e\xb6DBe\xdcҊ\x8aB\xd9\xea\xd0:\x87*\x15\x03\xb2jX\x01\x98źN8ӀDzKv{dQc*\xc5\xc9\x06\x8e\xc5\xfd\x00\xa5U\x12\x13*\xaciF\x03(U\xa8\xea\xe6\xf8\x1c2'j\xe8@7rg\x96?\x1fV\x11l4\xb1O\xf2\\\xff6\xceU\x90\nPR\x02\x96vh\xabE\xb5Zno\xb0\f\xb2\xeb\x10\xfe\xc6\xca\xec\xaa\xd5\x05\x16&N\x03BT\xed\xb2\xaa\x0e\xf9\xc0%lVv\x03r\xdd\x1b\\\x1f\xefN\xe0\xa3\xddǲ\xb5\x02\u007f\x1d\xfcܳ~\xd0%\x9f&KM\xd2a\xbc4\xa1.\x90l_B\x9aUh>\xc5\xd8\xd1n9\x99\xcc\ue304#w;\x02\xc0@0:I\xd3\xce\xf86\x81\xab\xb8;;V\xe2\xf7b\xc1\xfd6iF_\x82R?\xce\xef덒[\xde\xf2V\xab\xb7\x85\x02\xaf^\xf2\xd8\"\xba\x8a\xae\x1a.\x0fA%\xf0\x87mi\x88\xd6=g\xeb\x8el\xd5EA%\a\x9c;\x80\xbe/-\xabqu\xa6a\xa0\x9d\xfa]\xd0\xc0T\x17웻+n0~\x10b֕D\x1f7\xef\x96_\x83c\xe8\x0e\x11m\x86\xab\xef0\xb7\xcaw\xd1ᢥ\x97\x96\x9d\xd6ܡw\xb2͙iՑ\xea* \xfa \xb0\xea\x9cp\x15\x10\xb5NT\xac\"\xab@\x8c\x1dN\xf6\x16\x8fU\x00\x1e\xed:\xf7|R\xb6\x89\xdcJ\xdeJp\xc1}\x9cټ\u007f\xe0\x16\xb1̖\xf6\a\xddЖ}$\xbbT\xd9um\x17\xc7UW\xb7\xff-\xefe\xf3xD\x99\xff\xbe\xc1Y\"h5 U\xc4];\xfcD\x19\xce\x16\x97\xb6\x8al\xb9\xa2\x89\x12݃\x922_\xe9\x88y\x10\x97\xab^ðt\xbb2$Z\xa3\x19XJi\xea\x85qi\x86\x97Բ*2۩\xa5Q\ncˣSeAU\xb8\\O\xe6\xd1\xc1<\x9dJ\xd7Ye\xd1\xfa\xdc\x1b\xa1\xcf##\xb8\x99>\x00\x8b\x8ay\xc0r\xea\xafC\xbc,_\xfbL\xba\xbb\x02\x04\xba]A\x90G\x93t\xffS_\xac<\xeak\x81\xa0\xf5\xab\xf2\xcdI\x8b\xed\xee\x02\xef\x02<ێ\xff\xe8\xa4U\xfd\xf2\xa4D̹R\xcd?\xe3;\xd5ckeI\x05\x1a\xc1&\xae\xe2\xccUg*\xe1\xaa\xcc<\x80\xd4c\v۰\x9eMWy\x12\xf9c\x96\x8d\xd2\x06I2i\x13\x10\xbf\x1a~T\xbb\xec\xd1\x1f\xcbHj\x16\xffy6\xa3(\xb1\xf9\x848[\xeb\xf1ͥ}\x88\xaa;v\xab\xe2-\x18łS\xe4Vt%w\xcfЫ\xe7\x893S\npE\xed\x14X1_֙1\xf6\x9c\t\xde\x0f\xf8\xa3\xe7\xc3\xff/OT\x1e\xcdB\xb4\xf6AI\xf5\xea\xf0XG&\x1f\x91O\x1e\xe5YY\xe9\xfd{\x80\xfe\xe6>M\xc7\xccA\xce\f\xbe\xa5wxv\xfb\xde{F\xb1\xc6%|\x03\x9e\xd0v\xa0\xb3+\xfe\xeb\x11\x02a\xb9\xd4\xf8\xfeBP\x182\xfc\xfbɺ\xf9\x04c\xf6\xf2\xbb\x8c\x10\xe3\x13k\x11\xaf\x90q6A\xb30\xb9\xe9\xf5\x9e\xb5\xb9\x1d\x1f\xa0\tDu\xe9'\xba\x16\xfay$]\xff\xe2\xa7\x03A>\x13\x81\v\xdbȫ\x01\a\xf7\x91\xba\xa9\xea\xb4ތ\x06\xf5\x19;A\x0fߏV\xfd\x85Ⱥ\x1f\xd4\xe3\x95\x17\xa5\xb7\xa2B\xd5]\xdfm(S\n\xaf\x8aZh\\.\xbb4\xfc\xfdE\xe0\x94\n{V\xaf\x01u[p\x82\xa2\x98ξ\xf3mh\x0f\u05fe\xd5\xd7j\xbd\xe8\u007f\xfc{,\xec\x01M3\xf3\x04+$G؋,\xdd/\xf7\x1eG\xe51\xd7Z\x0f\xa8\x18:+\x85\x0eCd\xadq\n\xca\x1f)}\xfc\xc1*\x91\xcbbt\x02\x1d\t0\x1c6\xa99\x8e\x9dxm4\xb7\xab\x9e\xafU\xbf[\xdbh\xaa\x13\xb24\xe1\u007f\xe4/\xad6\x99\xdb\b\xa30\xc80\xd1\xe7\f\x15\x9f\xd6+\xc7n\x9dG\\\x9c\xf0P\xbeVJ\xef\xc0\x93\xa6\xea\x97D요\x19\x98\x86z\xf6\xb3n'6xwPK\xc7\t\xb3o\xf0\xae8/\x11\xf8\xddy:>|\xcb\x1a\x17{`\xd8}O\xfc\xa7\nBu\x9a\xac\xf7(!ԕDڸ*\a\xa4\xec\xdcw\xcdW[\xa1Sa\xb3\x85Џ\xb8\xf8\xad|W_tΊ\xa3\xdfU\xaci8\xb5\xaa[ϓ!\xcf9\x92\xaejV\x11j5\xa5\x8cu\xef\xcf!\x96i_\xbf\xdb\xe84\xb7\xa3]#@<\x0f(\x16\\AI\x83\xd3fĬ>\xe6\r<\x14\x028\x8a\xaf\xb5\xd9\xd0]\x02 \x96\x88\x89\x18\xe3\xe9\xa0X\xfek.I\x9aQ\xec?)\xecy\xf2\xc1\x11\xbe\xfc\xf9 \xc6\u05ec\xd5(^\x8d%\x8dO)\xef\x0e\x03u\xf3\x88Y\x82\xbc&\x90\x8f\xa78\"\b\x19\xf8\xc7CQǠ\x17\x80{\x01\xc0\xbdFh)\xb6b\x01q\xa6\xb3\b\x82\x91~L\xc7To\xbe\x87\xcfS\b\x97\xb2s\x8eQ\x88\x18\x82\xbe\xf5\x9f\xf5\x1f\"d\xf1,\xb1皾͘y\x1f\x13zK\xb4\xf8\xff끥\xfd6O\xe9\xf20F\xe54u\xa1\xafw1O\x82\u007f\xf3\xb9:\xd8Ŵ\xd0U\x8a&\x19.@\xb96[\x93r\x95\x80+l\xe1͜\x92 <\x82ܓ\xac\xf8:\x1bgb 0W\xbb40\xafP\x91Y\xcd\xf9\xb7\xab\t+\xf4-\xda\\\xea\xfb,Yr\xc5\xec\vXV0\x00\xfeh\xa9B8\xc4\xee\x05\x92H\x16\x92\b\xc0\x9f3+C\xf5\x06\xffZ\xc1EKQ\x81(M;lT\xaf8\xdej\xfc\xdc\x1b@\xa7\xfaW\x93\xd1\xe4\x14\xffU)\xeaJ\x037.\xc1]\xbdS\a<\xbf\xf0\xab\x9cM\xd8lb\xbaﲋ@\xad?L\xe3\xe9\x1b\xfa\xac\xbb\xf9z\xbe{0\xc1\x9d\xa0\x979\x98\x82.\xab?\x8bY:ac\xfb\xc0\x99\xb35\x00/\xfee7[D\x9f'E\x9a\x80ؾ\xb78E0n\xc7#\xf0\x19\x0f}>c\xb1^%\x18\\\t\xee\xa3]Q\xb7\xc5ǜ\xf4\x8b\x0e\x8dz\x03r\x15R\xe2\xa7\x1a\xa0\xba\x04b\xdePw\xa8\x00\x8c\x05g\\C\x05\x00R\xf7%\x82!\x91\x00 \x15\xe0i*zP\xcc^\x8e\xb3\x11.\x1f_c\xf6￫|\xf2~\xf7ӊR\xa3\xfc\xfd\xaa\"\xf9J\x18Ei\x89\xd2\vYB)V\x9cc\x04L\xe7\xc8Z\x9aF\xf9\xbc\xb0ou\x91\xeb\x96t\xeak\vX\xf6\xc7\\\xf0\x98)\x852.\xbd\x13\x82\xe7\"\x9fO\xfbi\xe0\xb6\fV{ۛ_\xef\ufdcf\xf7 r\xde\xdf\xd3\xde\u007fg3\x19\xbam\x1c\xdfe\xd7x\x99T\x14\x9b\xbe\xbc\xc6'\xac\x9fG\xbb\x1d!\xccۊ'\xf3\xb9\xeb\u070fa sW\x9b\xb6\f\x18\x17iՂ\xbd\xaf\x92\xc5?7WNTS\x18[\xc9\xecJ \xd5ђ\x81L\xd4P\x98lTG\xbb\xf9\x80l!\xae\x04J\xd9 C\xe9\x8a\x05X\x92\xb3\xb6c\r\xa5\xc6\bRѭ\x16}I\a\x82&\xeb\x95M%\xb4 \xe4B<]\xe1\xb2\x0fJ\xbe\xa5\x92\xf5KX\x03;\x91\xd8}\x9b_v\xb8\xbb&\xdd\xfelx\xad\xb7\x86\xf9\xf5Z-\xe5\xd7u\a\xc1\xba|ǭ\xf2'\xfe\xf5\x1e\x95\xf5u>\x15Ӂ\xec2\x03\xfc]\xaf\xb5\xdaiMߺ\xd20\xc0\xf13\xc7g\xe1 \xa4\x9dCwDG\x17\xf6eH\x05d\xbc\nȮ\x05ew\x11 \x8b\x03\xa1\x8c,\x00#@\x96N\x00b\xf1\xdbtV\tҴ,8=]L\xf2\xb1`\xaaz\xeb \x00+\x9f&\xd98\x1e\xae\x83\xa1,Z\x87S\x06\xbd\x80\x9fF5\f\xc0PSkb,XS0gt\xe5\xef\xf3d\x93}\xf0\x91\x9fO\x97\x9d\xf6:\x9dP\xad6Z\xd8\x0e,g\x06@\x05\xf4\xee\x87B\xefVC\xef}0\xf8^%\xfc\xfe\a\xc3\xef{\xb3Ȣ\xfc%\x84\t\x19\x1c\x1dA\\\x01\x19\x9bL\xcc#\xf8\xd9\xed\xc7\xedn\x1f\u007f&\x87ݣ\xee1\xfe<\xd9?<\xea%\xf8\xf3\xb8\u007fp\xb8\xdfß\xe9\xde\xd1Q\xbf\x8b?\x8f\x06G\x18\xbe\x00\x03E\xf4\x92.\xa5v\x8ezi\u007fP\xa3\xa0\x98\x0e\x1e\xdd\x00\x1eq\xda?J\x8f]\x94\x06\x83^\xef\xe8\xd8\xc5\xee\xe48\x19\x1c\xc7.\xa2\x83\xc1\xc9\xf1ɡ\x8bs\xff\xa0׆\xa0\x1b6\xfa\xfd\xfd\x93\xfeɾۓ\xc1Q\xef0\xf1:\xd5?\x82\xff\xdc\xfe%\xbd\xa4w\x9c\xf0\xaeb\xc3i\x12\xa7\ae\xbd\xee\xc9n\xef\x9d\xec\xf5\x8eN\xb0\xc2A\xf7`?\xdeß\x87\xbdC\r\xa6/\x00\x11\x05\x0e\xf7\x8eN\xf6N$\xfeq\xf7\x80Z\xef\x1d\xf4\a\x87\xb2+i\xd2;\xe9\xcb\x02\x87\xc9^\x87\n$'\xa9\xac\x96\x1e\xf5bY-=\xea\xf7d\xb7\x8f\xf7\xf7\xfa\xb2@\x9c\xec\x9f\xec+r\x1ev$\xdc\xf4\xe8\xe4P\xc2\x15c\xd49\"$ッ\x8e\x84\xd0O\x0f\x13I\xe4$\x15\xf8\x1e\x96u\xbb\xaf\xba\xdd9\xee\xca\n\x87\xbd\x18*\x10\xc9\xfaqJH\v\xf4{)Q =<8hS\xd9Ar\x9c\xec\xf5\xe5\xcf8\x95\xe8\r\x92\xa4\x1dS\xaf\xf6:\xf1\xde\x01\xe1t\xb4\xdf\xdf?\"\xb8q'99\x91$:JO \xee\a\x1488\xec\xf5:\xb2ᓸ\u007f\xac\xc75\x91\x9c\x1f\x8b6z\x92\xf4\xf0\x1f\x95\xc5pg\x92\xb2=\xdd\xed\x13\xf8\xaf\x16]Ys\xf9\xb79\x04[\x1c\xa6\xeb\xc8VU\x16\x85\xeb\xe5U@2P\x89\xf7\x1b@{\x9f\x82+\xed\x8eY\xca\x03Pg7Ӵ\xb8ɇ\xc9:`ua\xa1&\xb4\x0e\xb8\x92\x10\x80L\x8aL\xe5[\x04\rX\x95\xad\x87\xe1\xdd][\x9a\xcd\xddu+\x9e\x06ţ9\xe4\x9a\xf6\x1d+\xe6\x14D\r\xba:\xfa)N\xb2yQ\xf2\x92\xa9\x19Ma?!T\xe8\x95\x05c\x80X\xcc\xe2\xa9Pѯ\x87e\xae\x9eȣ\xba\xc0Y(\x94\xfd\x1f\x06\x03\xa1\xa3\x8blh#\x1d'\x1bWL\xc0\x95@]T\u007f\x0e̓\xdd\x15S\xe2\xb6D'\xeeH\xe0I\xdc\xc0_\xbb\"\x1d\x94\xab\x01\xa6\x89j\xa8\xaf\xfc\xf8-\xf8\x97j\x83\x83\xa9\x1á\xbev\xc4\xd2ϋ:\xd6(tR\x01q\xd9!\xa9߱Ju\xa0T\xc7*\xd5q\rR\xa2\xc9\x17\xe7\xac\x1f\xaf\xe3\x05\xc4\"nC\xf3\xaf\xdbM\f,\xdc\x01\x9b\xd3K\xf3\x93\xa5Fm\xc1\xbe\x9d\x88\n\xee\xae]\x92\x92\x14\xfc\xb6\xa9\xd56\xb5ںV[\xc3_\xb7$%\xfd\x03\xc9\xf7\xb1{\x81\xadH\x82\xc9ԧ0^\xac\xe2S\x18\xac*\x98\x18vv\x80\xe9\x9d\xc8\x00\xe98@\xf0\xfb;\xd9A;\xbf\xad\xf3K\xc9\xc3\x1a\xa1\x0f\x02\xd2v\x80p\xd2\xfd!\xfdi7\xdb5j\xd3\xf3)`\xa4Aę\xf4[\x93\xde\xe4\x92\xc0*\xf4\x83Io2)`\x95\xb9\xd0\xc9M=ۭ\x02_\xc9D\xc2L$\xb4l\x9c\x8c9\xdf\t\x98\xef\xfb7\x90\x13\x8e\xd5\xd7f\x15\xb7\x9b\b5\x9f\n\xa0\xcel\x15\bX\xef\xd5\x01!\xbb\xff\x9b#\xc4\xea\xab\xd6<\x92n\x84\x90E\xeb\xcd\xf11\xd5\xf5\xf5|g\xf06\u0086\r\xeb渤\xd6\xf0G\x0e\x8fl\x84G_\xc0\x9f\xe6Y\x12vH\xa0\xe2\x9d\xd7W/\x80b\xaa\xac^\xfc\x1aѳ\xa8+\x12\x00\xe4:+\xe0\x8a\xa5\x0e\xc1\x05\xd6;\xa7ߗl\xf1i\x88\t>m\xb2\xa5\a\x13\xa2\xab\x90\xbf\x05I/˜\xc0\x9b\x81\xfb\x8ajY\xc4~\xd9\xebչ\xc0\xed\xa9^7w\xa3N\xba{\xc8T\x11ت\xaf\xd0q\x04,(\x05\xbb3\xa5\xe94B\x18A\xa1o\xb5\xa5%\x9fza\xbd\xc9,p\xca\xcb\u007f\x87I\xb4\xf9\xaeQ\xfen\u007f\x98\x17iR\v\x94{\x859\xb24\u0603w\xc9i\x9e]\xf6Bd\xd0#u^2\x1e\b\xcc\xfc\x82/!Y\x06Ԏ\x8b\xac\xb0\n|\x01)\x12\b\xe6\xee\xe6\x93t\\\xf3\xcb\xfc0Q\x96vY.\xd4\a,ɻЛ\x8f\x13\x88o\xc7\xcb`\x92\n\xe3M\xdbc\xab\xc0+\x99([Se\x02\x88\xa9\x92\f7]:\x84\x9e*\xcf1\x1c\xe5\xe3|\x96\x8fm\x1c_\xcbD\x1e\xcb.8\xfe\xebĵ\xa3cV\x91N\x0e\xd1\xceX\"!\t\x06X\x89\xef\xff#M\xb0\xe0\x01\xcfD\xb4s8\x10\xa9\xfcE\xfa>KQ\x15F\x95\xbc+&\xc6\x1eh\xe6\xf8\xa7MV\xc1P\x8d\xae\xaa!\x8bvW\xd6\xd8\xe35\x0e\xad\xa6\x0e͆JN\xb4\xd6T\x88\xa5\xb0\xb5\nz!\xe7\xa23\xe7\xd4\uf7f0\xae\x94\xa6\x1a\x1a.B\xf8\xb5\xc0Ӹt\x96\xcaOV0\x96b\x19?\x96v\xb9\xa5%l \xc92%zs\xaa5M\xef\xd2i\xe1 \xaa\xa7R\xa0\x1e\xa6\x97W#\xb0\xd6\xee(\x8dW\x8b$(\x15\x16I\f\xcc\n\x82˖\x1c\x88A\x82khHp\xfc2\x04\xa7OV\xd0VZ(\xbb\xed\x14o\xb3\xf2\xb6NA\xf9\x1d\xa7|\x87\x95W\x03\x8a\x1fK\xbb\xe0\x92\x95\xb3\x94\x03\xcau\xd0Xr4\xd8\nN\x99\x0e\x0eˎ\xb32\xa5\xb1K\xf5\xfeM>M*7\xb7X\"p\xbf\xab\xa0\v\xf7x\xb3X\xae\xb1\x05\x1ew\xc8\xcb\x02\xcdh\xe6\x97\x10\xfd\xbbƝe\xe0:\x01i\xe8Ek\x02\xea8l\xa9\v\b\x91&\xbe;\xf0/\xee.ş6\xac\xf0\xf5\x14nH\x16\x10\x8f\bOs\xfa\xf3\xe9]j\x97\xa7\x9fm8\xbb\xf0rg\xf0s\x86\xb9ԒJ\xc0<li&[\xa2\xba,\xdf@n\xf8z\xbe&\x9a\xeev\x91\x0e\a\xcdh\xa0.XY\x044\xf7\xd1\atՂ\nK\xea\x81\x06E\f\xcc3\xcd}\xfb,`\x14()\xb8\x86U`\xfd\x9aΨ\x99\xfbrBS\x98\x9aw\x10q\xfb\x14\f\x05\xe6\xbbs\n\xf6\x02\xfd=i\x83\xe5~\xaat\x1dm\t\xd0)\xd2\x10\x10\xb1\xc7\x17\x93\x8e_\xa7\xe3\xd5\xe94\xe4i@ٝd\xc9<<L\xa0\x99#-\xa0)\\~!\x8bG\f\xec@ߝ\xf0\x05g\xc1>\x82#&\xa1\xfb)rOi6\x8alG\xb9S\x8f\xa3\x17J\xcfk\xb0\xdd\xe5$\xd8\f\xf1\xe1TH\x83I\x1b\xacF\xe2o\xc7o\xef\xff\x15+\x1b\x81\x9ft\xdc\xfd'MN#>q^_\xc8\x19K\xf3\xd2\xc9|#'\xeb\xd4ُb\xe6\xe3nF\x11\xa4\x91כon\xa6֞o\xba\xcev\x0f۴4x\xc2B\x13j\x8b\xed\x1eV\xd5[=F\xf1\x8d\xb1\xd0#\xb29\x16TU\xdfX`C\xbb9-\xfe\xb4\xedo\x19F\u007f\xc6\x16\xd8\xc7\xc5\xcd\xe0K\xaa\xd0B\xae\xf3\x92CN=\x9fU\xa1\xc0\xc2:A\xa1\x8e\xbc\xe3\xdd\xc0\x13\xd3\x1e4X\x1a\xd3@.\xbc\x8b\xaaOڭ%\x88\x80\xbd\xd6Rn\x9d\xf1\xcd\x13J\x0e#\xae\x17\xa7\"A(E:ay\x1a\x8d\x94\xdct\v\xee\x95\x17\x14\biW8\xd0\xce\x04w\x90\x93i\xfeN>\xab\x0e.\xf3\x13p@$\x84\xde+\xfa\xe8\xe0\aI\xae\xcb.\xffػz<Af\x902\x05\xd4@\xfc\xa8\xf3d\\C\x99\x1e\x10\a\x8b\xc7\x13\a\vτ-\x9b\xb5c,*\\|\xa1\xb0x<\xa1\xb0!.\x1617\xc7g\xe2\xd0;\xb2Gg\xb1\x0e.\x81\xbc\xd0D\\\xb1\xab`\xf3թXo\xac\xcb3\xbc\x1c\xbc\xd2\x0ePiS\xf2\x95\xbb!`M\xd5\x1d\x8ch\x0fd\xf0\xaa\xdbDm\x80:좴\x1e1\xd5M.u\xa9\x8be\xf1{O\xfc\xa6\x93\xcc.\x96\xa3^^-\r\xa9\x88#\v\x95\xdb8\xd9E*\x83o\xc2\xeaڳ\x03\x97~\r\x15\xd0U\x17~\x95M\xfbôQ/\xb2\xf7\x81\xe2\u07bdS\xe3oGCx\x83\x8e\xe4\n:\b\xb5r.D\x1aA\xa0\uf5ac\xbe\xc5̔\xce\x1cp^j\x1cJg%5g\r\x9dĠp\xcekחS\xba/\x11\xeb\xeb&\x18x9\xae\xb1Q\x8e\x9ekb\xec\xe3\b\x9d\x86\x86M\x1aզyQ\xb0h2\x80\x9eoi&\xe5\xff\xb7\xe9\f\xf3Św\x80\v_p\xe5\xd9\xddCۭ\xd2\xc9w\xf1\xe77\xe6\xe7\xcfv\xa1o\xb4\xe2\xfe\xb3S\\\x17\xf9Y\x17q\n\xdb@vC\x85MCz'\xd9T\x82b\x94\x8f\x93\xea\xae/C}\xaf\x83\x01\xd9\xe6\xe2x\xbc׆\xb8\xd5S\xb0/\x8bZ\xa1|\x8fX\xea\x04qi\x0e\xea\x16t\xe0V\xa3}\f\xedi\x96z\xb1\u07b5\xf2\x9d\xfe\x14b\xd35M7\x1cɊQtG\xf0;{\xabE\x89Qh\xff\xe5T\x0f\x11\xbf6\x9bfh\xb3\xd9M\xf2{\xb0\xd3Va\xbd\b\r\x82-+D\xc6\x1e\x90\x1f\xc6K\x94\u007f\x1a\xc8\x0e\xf6\x94\x93\x98\x8f\x00\x1b\x18\x8b\xf2,\xbd\xb4G\xf3ɟݟ\xddp\x87J\xfa\xe3t\x87Y\xb1\xd9\xea\x02r\xbapEt\x01v\xea\xa2\xde\b\x8a\"B\x92\xf7t\xaf\xd1\fL\vUd\x16\x8f\xeb{mv4\xf3,\xea\x1c\xb79&\xf1\"+\xaa\xaf\x89\x88\x02\xf5kC\xed\xebҐ<\x84\xef\xb5\xd4H\xe8\xe5\x0f{\xcef^J\xf4o\xa1I\xf8\xabb\xc6\xe8\x87+\xf2\xc6\r\x16\xb1\xbe\xe4\xb1\x18\xa64\xb1\xeeK\xb50\xbcE\xd7EX4\xc9GqF\xbe\x8c\fx*\xae/Ś\x8f\xb7\xc1\x86eA?i\x15\n\xf4\xacF6-[\xb0\xbb^\xcc{\xaa\xf7ʦ \xa8{1\xef%\xd9]\x96\xa4\x1c\xb4\xc4Z\xe75\x9a\xaa6xj`N\x01k\x10\x18(\x9f\xd6\x1a\x18\xa3\xa8\xae\x9ahJtL\xbd\xaf\xa4??\xf9IN\xfb0\xa6\x1b\x06\xc1\u00831\xb8\xa9u-@a\xa0\xd7\x1a\x86ń$lV5Ca\x14k\xf9$\xeeg\xb3\xa5Ȇ\xc3>\u058c\xf2\xa0߲\x9d9P\x9b\"\xb3\xde(\x83\xa1\xa2\xa1\x18`\u007f3\x9e\xfa\x03\xe0Bp\x02\xcc\xe6\x12\xecZ\xd1\xca%\x14\xa7R\x90D\xd7@\x8c\x89\x98J\x15d(\xa5\xc1f\x04\xa8\xe8}iש\xd6\x1b(\t\u05femJ\xe0\xe5W\xc9x\xc0f?\xe1eX\xfc\t\x1b\x06\xd1)\x8f\xb1h6)j]\xc2\t\x95,\xaa\xa8\x04\xbf5\x95d\x14;E \x87\x87\x140\x02P\xd25\xc8rF\x101\x04\xab\xaf\xbc\xfc\x9bO\x96\xc8 \xf0\xd5V\x0fjߊ-s<\x9d\xbd}\v\xda4\xd58\xb3\x9fD\x9b\x12\xe7~\x015\xee\xba\v8\x17\x82Ӡ֨\xaa\x87\x0f\x9e\x9d\x0e\x00,\xceUTC\xcaG\xd9R\x13Ki\xa2\x98\xc1\xf7\xca\xc9'\xd8\xc8L*\x8fZŧ\xd4u#{d\xe1\xf2\xa6\xa9\x1a\x95\xaaj\xda\xedTq\x9f\xcd\xfa7Q=\x9ff\xf8.\x85\xf9~\xe9\x83;\xbcZ/\x9f\xcd\xf2Q\xed\x94\xf9\x84\xb1\x9d\x15Ylj\v\xc3_\xce\\\x97G\x8c\xca8\x1cK\xb8\xc8\n\x89\xafA \xc1\x8e\xa6\x11\xac#{A\x95\x16P\xa9\xddX\x17\x84\x1e3\xafB\xfc.XASZU\xa85͛\\\xab\"\xa0\x01\xde\xc3Eڏq\x92\xa8\x87\x98v\xdbk\xe1^\x81\x8a]ߪ\xbe=b\x00XBI\x00L\xadu\xd4IGz\x9a@\xf6n<\x06\xdb\x15d\x8e\xb2$\x19\xa65\a\x86\x99\xfb\n\x12\x94\xa5[n \x90\xa4\x81\ru(\x1a\xf8\x04p\xc3=H\xfb\x1bS\x8cLo?;Ŝ\xc6z\xd34\xbe-\t\xc4E\x9c:\xcb'\x1f\x95Mw\x1f\x81Ow7f\xd4\xddM9u\xb7\xbe6Kl\xc1\xac\xbb\x1fƭ\xdb#\xe71l\xfbc\xb2\xeb\xee\x9a\xfc\xba\xfb!\f;L\a\xb3\xed8\xf6ו\x1c\xbb؞c\x9dZ|\xf4\xdb+8v\xb11\xc7.>\x02\xc7:\bTu ȱ\x9b\xe0\xc3x\xbb\xbdR\xc4\xeeu\xcbyV\xa8\x1c\x1b0\xac˟M\x97\x8b\xbfi\xff\xec2\xec7\x1f̰\xd3\xec\xfa\xe6\xe3r\xecV\f\xfb\x81\xfc\xba)\xbb>\xaa*\xf0\x81\xac\xba6.\x8fƧx|\xba\x01\xa7n˨[\xf1\xe9\x83庋Y\x1cl\xa7\x86\x86\xf5\xc8$\xcf\xd9V\xedF\xacF\x19ߕ\xd6\xe8x5`\xe7\xb8nykB\xac\x89\x95=!6lhm\xdc\x02\xcetє\xb5Л/z\x8b\xf9E\x8cQ\xcf\xf1\xb4w\xc1\xadP\x89\xeb\xfaT\x99\xea\xb12d\xef\bhր\x9e\xad=X\x8bM\xc6i\x11\xf2]\xe9\x9d¨\xa7\xe5\x81\xc7ɴK\x02\aer[\xe4L`\x91s\xe8\xc8#\x93\xa4f\x81N\x90sS$\xec\xb9&(<-\xef\xe0-RnZ\x93\x8eX\x98Mʱ,\xa1'\x14\xba\x9e\xb7\x00\x97=\xb2+[\x1c\xc8@M}\"#\xc1\xb8G\xa2І}3\u007f\x81\xf1\xab$\x916o\x95\xaa\xeaw\x13\n\xd0z\xed*k\\\xc0\x9a\xb9\xe2\xf8\xcb\"\xbd\xf1\xc8\xe2\f\x88\xae\xbf66zض8\x92\xd3\xd59>\x1a\xe0\xfa\x14Ѧ\xd2\xedppL\x9f\x96\xbdu\x03,.܃\xc1f\xb4lF\xef7\xc1DO\xb23vT\x10r\xfb\bO\x168\xbe|v\xee,\xac\x1c6I\xc7\xd1\v\x8c\x17\xb9\xb3\x94F_\xaf={\x0eCyx\xa5\xb6c\x02Z\x8d\xafʪ\xae\xa4\x8f\x11\x05\xdb\r\x93\xac\xcfQ5 w6\x18'&H\xb6\xc3DC\xe0\xb8p\xb0\xab\xb1q\xd2\xf9\x1d\x80\u07b4\xc4k\x8f\x89\xd8\x0f%>\xf0\x8c\xa3\x19\xf5\xae=\x03*x\x97\x81[\xa9\xa0\xa7\xdbFԁ_X\xe8L\x82@n\xc1\xd9{\xaf\xe04\x85\xe3-U\x90\xbe\xb8\x19\x9b\x85ѻVfb\xf4\x8c\x92Nw\xd1\xc1\v\x1a3\xc5Z'@\x88\xce\xd5\xf0f\x04\x1c\x19\x12\xa9D&\xfeE\xad\x8dJ\xe0\xf5\b\xfc\x0e\x151\xcd\xf5\xae=c\xf0T\xa0\xed[R-\xc2H\x14\xef\xb2\"\xebeC2f\xd7n\xc4~<\x1d\x9b\xdc\xfe|Z\x906\x89\x87\xeb7q6\xe5\x1a\xe5`ݖ5\x95=\xb8`y\xb7\xac\xbb\xef=\x90\xfe\xd1H\x99Ƣ\x0e\x0fit\xe85&S$\xfc\xe6W\x00\x92\xdb\"\xa4\xfa+\xacs\x99\\Y\x00\x83\xdd^\xac\x86\xfc\xec2\xbd\xbfR\x8f^\x12\xf42\xb4'\x83\x89\xd9\xf0\xf5f`\x15\xc0\u007f^\x8e\x8b\xabu\x01\xdeg\xc9\f\xf8\xe9P%ܤ\xb8cĔJΰFJ\x96L\xb2b2\x8c\x97\x8a?)FS\x1dp\xa8\x8d\xf3qZs\xa3\xa4\xd1(㙌>{\xb1#\xe4-\x9cp\xe5\xde\t\x8a\xadM\x8a\x19`(\x9fb\x94l\xbb\x9f\xb8a٥\xac3\x8bx\xc94\xbe\xff\xa5~\xdd8+ٚ,Wa\xb2\fc\xb2t0\xd1\x04\xaeF\xe5\xd7\x12T(\x97e>\x84]!\xearL\xaa\x06\xa5\x98\xdc2*\x95\xbb\x94\xc1Ԕ\u0092\xe83\x11f\x15\xcd\xe7˝g\xa9\xe1\xe1+n\x96\xd3\x05\xfeY\xb0\x02\xb4kl\xd4\xd6\xea\xc4/\xa1^p\x89͆\x9cZk_q\xa2\x86\xe4|\xb35~\x01S\xb5\xd9*^XSV3\nA\xea`gvC\x80\x83\xb8\xfe\xba\x1e\xaeK\v\xd7\xce*\\S\x89뽍\xaba%\x8dl\xc7F\xb6S\x82\xacY>\xd8\xe2\xaasqE\xf2V]\xeb\xc6\x1e.d\xad\xfe\rp~\xf2\x86;*+qV\xa6\xee\xf8\xd5\xd5k\x1e\xe5\xb6\f\xe8{\xaao\b\xca\xec\x86\xeb2[\xe3v\x9b.a\xad\xac\xbbN\xbf5N\xa2\xc0+\xf4\xe5*\xf6h]?l\xce\x131J\xd7\xd7\x18\xfa\xd8\xde\xe2\xf6U\xc0=[R\xca]\x8d\xa8\x81\x8cpn1F\xb0X\xc7)\xd6q\x8a)\x04\xe0\xd5n\x89\xedN\xfb\x82{\x15\x8f\xfb\xe9\xb0\xde(\x0f\xc4\xcf\t3\x9f\xacI\x16\xf4\xb8\xaa\xd18\x8f\x1c:\x99\xfe\xee\x94\xf7\xd7\xf4v\xa7\xbc\xb7\xac\xaf\xed\xb3\x0f\xeb\x1f\xb2,-\x11\x0ec*\xbfv\x92m\x9b\x11\x94J\xfc\xa0\xc6@\x8f\x1c\x9f#\xd9\xdd\xc5\xfa\xb2\xb7\x94\xeft\x95\ntx\x01\xdeM{\x9d(a0k0\xe2\xe1\xec\xbfӥˁX\x9bذa\xd8\xf12\xaas\xe1cD*\x8e\x88\xb4\xde\xd4\xf9\x94\xb7\x8at\xa8HtU\xc6\xd2z\xf0v\xea\x9a\x10\xcfe\xf3\xd0@\x15\x9f{u;\xacn\xe7\xaa\u1c834L\x95L5\x9b\x8e\xb8@\t2\xfe\x02\xcc\n#ڡ6\x9a\xd1\x02\f\xa2\xae\xee\xe3/ߑ\xe6\x83\xd9t\x9e\xaej\xe6W\xaf\x99%\xdc\xe9\b5\xf3\xeb\x16\xcd`\x99\x100\a\x16\xf2\xc7['\xb8\x10\\\xf0=\x15Z;\xe9\xfeV\xd6\b\xa3\x0e\xe9i\xf6\xb9ԣ\xc1w\x89\\\xe1\xcb\x02n\x95\xcd3\x8b\x06\xf2FT\xe6N\xb9\xea\xdb,\xe8\xc8HY\x8c\xa5\xbb\"e-n\x8a٤\x9dWKN\x82X\xf7\xf2\n\xb1a\xdc\xcc^ɠ\xcc(\x1b\x8b\u007f\xe2\x85=\xa5\xc3\xf3M\xe0 \xa4\xb0j\xcbү:\x90S\x90u\xdb/`\xc6M4\xc7]{\xc3;:\xfa\xc8\xc6u|L\x87,\x9f]5\x1a\xeb 4B?\ru\x00\xbac\x10\x03K*\xbfI\x1d4\xde\x02P%\x17\x18\xf5\xca1\x83˼r\x16\"\x19E\xba}\x0e\a\x105\xa4\xe7\x98\xef\b#BWd\xd8s\x9fh\x12\"k\x00o\x05%X\xbcd\xa2\xf0\x11\xc7(\x1f\xd0\xe2\xef\xbfی\x81鱻7\xc0\x12_\xe2=\xa7\xc02n\x01\xf6zf\x81?\xb7\x99\xcc\xe83\xc1\t\x1e^\xa9R4\xad\x1b\xfc\xd8\xf2\xb5\x81} \xa8\xb1o\xbd\xd926\x93Z/O\x96\x81]\xb8S\xe1\xde\x18(\x00um}\xa0h\x1b:o>\t\xe4\xa0~WR\x8bt\xbfq\x12Ȓ\xfa]8\xc7m\xc8`\x1a\x90\x9b\\j\xc2Q\xed'!1X\xa6\x88<0\x8b\xa5~\xf7D\x0fA\xb0\xfc\x1b\xf3\x16\xcaxٗ\xab\xbbTaeI\xb0\xbaRz>\xd0\xca/w\xd2\xe6X\xb3Te\xb50\xa9\xea\xd4\"\x18\x9d\xe6\xa3:\xcb\xffE\x14x\xf2\xec\x9f\xf5\xf1\xefEC\xed\xc1T\x1e\x86\x12[\x98\xb2\xbfʲ\xe9\xef\xf7\xe1\xb2\xe0f\xc0\xa8l\xbc]\xb4\xb8\xa4I][p\x9aR\xc24\xa5\x14\xe7\nXn=\xfa\x06\x1a\xde[\x9d$\aҍ\x12\xee\xd2S%\xccbj@\xcb\xd8̩\xee\xf1\x9a]\xdf\xe57\xf9\x1db9\xfcjpW\xe0!I\x1fЬ\xdar\x13\xa9\xb3\xce>\xa9Х\xdar\x17\xa7\xb34O\x1a\u007f\xf9f\xd0\xecu9\x05q\xbb\xf3\xec\xde\x1b\\\xc1\x8cK\xcc\xfa\xe7\xd8\xcdb\xc8(_C\x97Z\x1e\xe2\x1a\xec\xa2ߴ\xb2\x97.\xbe\x91\xdf?N\r\x82WI\x02\x82\x19跧AkU\x92\xa0\xb4\x8aa\xd6g\"\xb6B\xc0\xa2\x8c\\W\xc2Z\xd2f\xb5\x18\xe5\x13\xc7\xce3˰'\xb1\xb8\xbc\xa2#\xfbO\\y\x15XD\xc2\x02\xcc\x1c\x8db&\xf3.\xfe\xa5\xe8S<\xeb\xdf\xd4\x11V\xd3j\xaf\xa9uL&6\x1bt6LǘK\xfdKڽ͕\x11\xac\xf0\x13\xa52\x1eAnB\xe79m퍳\r\x01\xfc\x9bւMh\xd3Bf\x1dE\xaf}\xe0\xa4\x17l\xa8\xfe\xde\x1cXT\xa1\xf9d\x11=\u007f\x1eu\xa2ߣ'\xcb+\xe7\x8c\x03\vZ\x87\x1c\x84\xddr+\xec\x96\n\xfa\xf2\xe3b\xa7\x89\x1eB\x11\xf8\x01\\Ԁ\xdb\x19\xf0\x11\x03\xae_fg\xd5\xf8\x1b\xfe|\xaf\xe7\xa7Ա\xb4VVi\xb2]\xc0f\xe0=\xc9@h\x1a\xbf<k\x02\xb6Ρ\xbb\xfa(\x82\xe1\xf2T\xc2*7\xc7 .\xadl|\x97Nņ\x1f\xeb\xab\xcf\xfa\x02\xbc\x86`}\x93\xd4i\x04\xea\x83\x1bSQ\x18\xdd\xd2 \xe9\x10LGV\x9e\xad\xa3\xd1:\xfb\xfc\xa5\xa6\al\x86\x96\x9a\x1e\x9d\xcd\xe8\xb1l;+\x86\x84UnwB\\4=\xb0\xbe\xfa\xac/\x81\x1eX\xdf$\x85\xe8\xb1\x04z,%=\x90\x85\x10LGV^I\x0f5WQ\xd7\x10\x9a*\x88\x86\x05\x81A\xe1\xb0 HB<\b\xedu\x81\x05\x88a1a\t\xd5.%\xeb\x9aUF\x81w6\x00a\xb1\xc3\xd9\xdd\xe2Tͧ\x8cI\xcf܁\xa4\xe1o\x9bR\x8b\x8e\xc5w\x1c\x05\xb9\xec\x13\xdfX\x19\x1d\x95\xd1qf\x8eí\x9cMm\xfe܌7-5\x04\xcaR\x98/\xcb\xc4\r5Md0CT\x8f\x83\x97\x86N\xcb0\x9d\x16\x8a\xbbچ-\x97\x1d\xab\x98K'\\\xfc\x97\x01:Q\x86C'\x97\x8b9\xfb\xda|\xbb\x19\xcfZ\n\x18\x94u\xe9$\xb1\t\xd1i\x85\\Ƙ9\xe1\x9b.\x15\xfbVg\xe0\x1c\xfc\x9c\xf1s\xd0l\xaf\xbbb\xc0\xb61\x8c\x19\x9f\xad6*\xe7N\xebb)Xڥ:n\xa9N\xd0\xf1\xa5Х\xa6iOl\a\x94>\x82J\x8a\xd0?ry\xc4\xe8\xbe\tgG\xb0:J\xd2X\xa8M\xe3bW\xaaod \x03U*\xbd\xb7ӊ@\xb9\xfb@\xb91$\x8e\xef\x8b\xd4I\x06\x98\xe3\xb4p\xa1\xa6\xc1\xd2ŽS\xba\xac3?i%\x00$Vm\x8c\xc7\xe4xo\x13\xfe\xb9G]\x95\xfe\xa5T\xfa\xf7\xbeFr\x8d\x8a\xea\xaf1Uį+훰\x97\xde\xc4w\x19Q\xec\xc1Ik\xc1&\xa6\xda\xed\x8c(Pw\xe2F\xda\x17\x17\xa0D\xad\x19\xe9\x04\xefڂW x*\xa5\xb3C\x87R\xa8\xc1\xfb\xb6\u007f\xe3\xd8\xc6Du\f\x1fD}\x9b\xd8\aQ\x93FKE\rW]\x9f\x04O\xd4'V\x10\x9c\xf3s\x05\x8e\x1f\xa7;\xa7X\x93\xf2\x03, \x85w\x8cAq\xa5\xdc~h\xed\x11\xea\xa0A\xe9\xcc\xeb\xbc$K\x93nwN\xf8N\xed-\n\xe9d\x89\xe9\x1d\x9e\xceE1Y\xaf\u007f?\a\x00\xbf\x8b\xd2\xee\xc6\f\xec\x17\x93\xd5\x06\x93*\x13\f\x8d>\xd7\tO\x95/\x1f}\xde³\x97\xa7ʻ\x8f>m\xe1ى\xa8\x9e,\xac\x14\x88z\xb6\f\x18x\x82\xd4wMsՈ\x97\x9a\x8eJ\xcc\xfa\xe5\x04r\x0e\x81\x94Y\xe9\xdc2\xb44\xa4ѭ?\x84'\x86r\xde\xe0G\x13\xed\x8f\xc1K\x01X\xc5093\x8a\x00\x80]\xbc\x9c-sO#fq\x91്\xcd\x05\x01f\x93R\b\xf3\x89U\xbf\x94䈼E\xf0r\x12\x05\xfa\x1e\x04^f\x96[il\xf3\x8cw|,\x9a\xba\x8f<K\x1ebc\x90\xca\u0e77\xe0^&!\x94\xf5\xab\xc9珚\xa4ꨨ\xbd\x96ml\xfb\x11UB\xe6CFU2\xbf\xc3vx\x88\x8a\x1d\xb3\x8c^\xcar$\xcd0҉\xb67\x00A[\x13\xfd\x14\xdb~[p\xc9\xe4\xa5%\xb7\"\xd7*\xf4\xaf\x10DR\xf6C\xaa\xec\x13\xd9\xdfF)\x13\x96\xd9e\x80\x1cA\xb3\xcc\x1a\xb6\x16\xa8\xdbTr\x90\x83j\x1a\x19\xd3`\x86T\xa3\x03BnK\xa7ou=\xfbڜth@\x9e\xa7,\xd1L\xb5zF]\bjg\\\x99x\x9f\xe7\xa3Je\x02\nT+\x13P\xc2W&\xf0\xf3\xfe&M\x87V\x01Lq\rɼ\x801\x04\u007f\xf9\xc3\xebאrџ\xe6\xc3R(IoH\xe2G\xe6\xaboO\xa5\x91\xf9&ŵG\xf3\x02\x01s\xb4_?\xa8\x13A\\~W7\xd1\xce\xe6\xebr\xbd\xd7w\xb2\xe4\x8d\x00yl[\x97˾\xc9\xee\x98\xec\xe8*\xd8\x1e\x89\xaaa\xa0\xb1!\xb4\xf5TVޱ\xdal\x8a\xccN8\xd3LY\xd7\xdb-\x14}\x93\xd7\xd9C\x1f\xf5hA\x1flb\xc2WjO\xc1\x0e9y\x06\xec1\x8bFI@`\x85\x87hh\"\xb04m\r\xb5X6\xab\v\xef\x12\x1e\xd2\x12u\x87l\x8boulG+VC\xb3\xbdq]6J\x11\x803Ǟ\xb5bG/6\xdd\xcaiʢ-\x03\xa49\x81\x1c\x17\x81Kx\xf5E٠\x9b\vu\bE\x00\x95[eK\x80\x8b=\xb2\xd8ƪ\x96\x97%-/C-/\xcb\xf8\xc9iy鷬\x97\xcfɔ\xc4c:\x88\xe7Ù#z}\xc9K3\xc5\xf8?\x80֤\xc7\x19\x93\xaaq:5?\xab/\x17V\xee6\xb4\xec\xb0\x14\x17\xb5|s\xe7\x15\x8c\xc3\xdcKl\xc0pLs\xb6X\xe1m\xb9\xc2$\xd7ao\x83 \x95\xcdUړ'\a\xfd\xd3Z\x9e\x11Ph-]g\x1d5U\xc2\v\xa9\xa9\x8f\xa6\x0e\x86p\xfe\xd8\xea\xa0Vךk\x1d`\x86\xd7\x1d\x87\xe8\xf2\x13\x98\x03\xbc\xf5+\xf1\xee\xf2\x8e\xee\xa3\f\xb6;\xc8\xfb\xf3b\xbdc\x1f\x9b\x91pms\x1fVi\xae\x05\xb3\x98\xfe\x1d\xc6\a\x9eW4\xacwe\x82\xd5uLQ\x8aE\xa2\xd6\xff\xb7\xd0\xc9/\xd3\xe1,\xaeC\xb4\x93V\xbb\xddm\xa8e\xc1\x17\xb3֔\xa1W\x1c\f1\xddUk\xde\xe0H\xba\xc1Y\xca{\xefLa\xab\xafF\xcbr\x85\xb6\\\xf3\x9d\xf04\x13\xe6\xc9R\xe1k\x8d\xe1\xc4#\x93\xe6\xa8\xe2&\x1b\xc0\x89\xa5\xf2k\x85w\xf2\xa4\x10\x13\x04\xea\x06\x893\xe1\x02d[2\x18\x05\xc3\xe9N\xc8\x03\xa7\xea\x96\x1bD\xdb\xeaW[=#=\vQ\xf5_\xfa)\xa8\x84\xe9G\b\xb1\xee_\x9aʗ<\xda\xf0\x15'\xec̻\xed^.50»lY\xbe.\x03\xd9\xd5q\xa5\xa9\x8c\xef\r%!\xcev\xf4<:h\xb7\x1bޣى\n{,O5\xad\x017\x19\xf6\x83]\xa5\xdax\xa3[9\xc2\x1b\x8d\xb2m\x000\xdd\xc0\xc0\xe5\xb6|\f1\x843/V\xf1\x03\xba\x97\xb6Ȁ\tf\xe8&m>v|,&\x1dV\x15\xf4\x05ǀ\xd7\x11\xc0:\x0e\xb0N\bXDX\x80\xd6\xdb&\xe3Ѥ\xc3n\xc0N\xdad2\x9atBw^\x87\xb2\xeaPV\x1d\xf2\xaaCYu\x18\xac\xea\xcfe9\xac\xce\xfb\xee\x87\xd0\fn\x03\xa9\x1a\u058cP\xc3\xc4\xec\xfc\x1b\xcem\x1c-\xd5\n\xdb\xf0r\xf1\xd9\xd4\xdatG\xbdD\x97\u007f\xbfRGĮ\xdc\xfev<\xc8\xc6\xd9l\xd9,\xdf\xc8B\xb1\xa6\xd4\xc8\xe0h\xca=LV\xfd#l\xa1X\x8b#\xba\xc5\xfbDUۓ5xv\v\x9a\xe6\xf7\xf3Q/\x9d\xba\xfef\xa1mkG\x8b\xc8<\xf2\xebj\xefadE\xab_y'\xf3\x9b\xb5\xfd\x95u\xd4n\x83\\0O\x8ae\xa3\n\a\xaa\x1b\xd3k˫\x18Z?\xa6\x93T\xfd\x85G\x87҇\xdcZ\xedoy\xd9B\xb7\xbf\xb4\xda_nҾg\x01!ޯ:\x9f\xb2\xb5\xa0\xec\xaeY>\x18r\xd2\xeaouR3\x8c\x97\xf9|\xc6\xcfi(\xa5Eq\xc4*B&\xe9\xf4a6\xe6\xde3P\xc8\nm\r\x0f\x98\x84\x88\xc8 \x96\x9c\x98\xabc\x8a\x15u\xab\xa8\xa7\xf5\xcd\x1b\x88\a\\\xdf\xd9\xc9Ē8nP\xdd\xd6\x04\x9e\xe8\x8a\xde\x106o\t\x9b\x1fE\x165g݂V\xc7&P\x91\x91\xd4\xe9\xd0\xea BӔ\x8a\xd6\xc3!p\n\xa4R3\u008f\x8b\xf9Hu\x10\xbf\xbf\x1d'x\x17\x0e\x86\x0f\xf7\xb6\xe3\x86\tR\xa32\xa1\xf4-\xbe'\x00A\x965\xa3w\xaa\v\x88\x1c\x01\xd4W\xc9T\x9b&閶\x06D\xd12\xfa\xb1\x1b\x06T\xfc\x9dU\x9cUx\xe7V\x80*;p\x19y6\xcd\x16\x82ė\uf0afN4\x01h\x94\xf8\x8bH\xab\xc7j\x105EX\xc1[hh\x11\xb2\x98\x16\xf9t\xf6W\xec;G\x8dA\x85\x02F\xb7\xb3\x03\xe4p\xdf!\x1aN]#|\x19_\xb1\xf1\xbb\xecq5\xca?\xc4Q\xd8\\(\x06\xe0\xed\xd8=\xf5T\xce\xc4y\xc4 8q%\xe2\x16\xea\xbaѺ\x19\x0e\xc0\xde|\xf5l%\xf0\xa1\xb2/\xc0:u;h\xe3D\xbe\xfe\u007f\nL\xf0,\xba\xe5\xf7\xd96\xe12\xbc\x05\xb2\x19\x9bQD\x03x\xe9\xaeI\x88\x0f.\x92w,\x1a\x17%'Ȇ\xcd\xe8.Ҍ\tI\t\xa4Ųi\x8c\x12\x85\xac{'zs\xeb\xf8\xb2!2\x8aZ\xf0\x04\x14\xcf De}\x96\xafUth\xedT`\xd5t=J\xa9\x9cwN\x8e\x0e\x12cǐ\xe2![\xechR:\n\xe1it\x17ve\xe3\xcc1@\xda\xc54\x88'\xc7ea\xe1b0\xb1\x0e0%\x1ah׃+=b\xf8?\t\xe0\x834\x9d؎#\xf4\xecX\x93?`H3\xeepc5k\xe8 $f\xf4\xcc\xe0\xc1\xc0kۋ)\xf0N\x17ȼ\x8bm2N\rv\x1a\xae\x8eȃo+f\xa4~\x96\x89\x92\x98D\x97U\xef\xb9UM(>v=\x15:\xe5T\x85\x8fsr)\xf5T\x96\xb22\x1f\x84\xaaT\x06LF\xab+\x01F\u007fm`\x8d\xea;q\xaep{\x85\xfd\x05U\x06\xc5S\xd9[c\xca\xd4hJ*UK4&ͨ\x95z=nY4݉\xe2\x96=\x18\xb4A\xea\xb9\xc5z~\xb1\x92\a\xe9\xc0?j\xa9\x87՚0\x95R\xbfP\xf2S\xa8#M-\x00Qܙ\xe5\xa2i\xcb\xdf&\xeb\x00\x8f\xf1D\x80\xb6ү\xa9\xaa\xc2\u007f\x8cϴ\x14\xb4\x05\xbe\x8b\xa0OGS\xd2J\x82V\r\xf8\x9enEH\xaa\xc9\a\xb8\x8fq$\x80\x01\xb5x\x14\xd4\f\xe9\xb7\f`\xa4\xaa\x9b F\f\xe0\xe3\xe1x\xc1\x14\xc1\xed\xd0\xd4\x108\xa6\x1c\xac\x8f\xec\x868\xbeR\xf5\xb6C\x90\xb3y\x14Y\x00\x17\xdc\xc8\xd2\x0fJ\x8d\x95\x18\xf6=\xec\x1c\xe4\f\\\xa5\x8a\x87 \x17\x01\xd0\xfe\xc88\xa0\x95\nW\n\x9a\x8f̊\bjrK!\xb4\xbe~\xbabK1\x99\x0f\v\xa1\xfb\xe2\xed*\xe7\x88SW\xfbm\x1e'Ұ\x01\xb7i\x85\x04\\v]\xf3!\x94i\xd1\xcbsx\x8dh\x03\xb4<\xeea\xc9>\xac\xecP\b#\xca.u\xf2R%\xc3\xcb+\x90<\x10T\u0604\x97H0N\x06ĵH0\x00\xcbһNT_tAg耙=\x81\xb7\x9c\xb3\x9bt\x16\xbb\xab( s\xab\x1b\xbd\x01\xd9M\xe5\xe1\x1f\xfb\x9a7b3Y\xc0\x1bYl\xfd6\x94\xbd\xc4쥟\x1d|)i\xfb6p\xa8'\xa4kV|\r\xbbഞ\x8c\x1b\x95\x98c\x8dW\x1f\r\xfd\x8a\x1b\xebO\x18\xe5\xce*\x03\x85\xea\xfb}\xec^X\x02\x18\xb1\xf3%=w\x13\xc0\x85e\xe8\xdbw\xc8\xca\xf0 I\xc8\x06\xffQ\x8fbtXP\x8d\xad\xae\xa5͈5}\xbb\x04}\xe6+_\xb5\xfa\x95\xf5%\x18\x05;\x18\xf0\x1a\xafu\xc4\xc3ɍ\xd0\x16\x06\xd3LE\xfdj\x9d\xa0O\xfc\xdb/3\x01j\xac\"\xd0\xc9\xdd?6\xff\x1d˥\xb2\x17\xb3\xa9\xb4\xb7\x87ʪ\\P\x01p\x00\x85\xba\xba\xd7\x06E \xbe#\x8bH\v,\x88\xc0\xbd\xf0\xfbX\xecx\xe0ŖP0\x9a8jj{\x8f\xf6\x06\xf9;\x91\xedcHQ\x02_(\xf8Rr\x10!e\x1c\x8a\x12YT\xc7\xfeGO\xa1\xdb'\r1\x87Z\xed\xf6\x81wC\x10\xa2H\x86.\t\x02i\xb9B\x88\xc0N\xe9\x0f\xe82\xc1˃\x81y\xf2\xe08\xc9\xc3N\xcbE\x81\x824rsM3\xfa\rM\x16\xb9\xe8\xba \x9b\xa0\x8b\xb2ep\x1e\x8a\xea\x19\xde6\x8b`\x1b0:\x8b\xc4~\xc0\xba\xb6\xa5\x80^r%\x1d\xa8\x9b\xb7\xec8\x84\x11ޞ\xcf[vD@ڜ\xd2խ\xa2ŞL/1y\x89\xc9K\xfb\x84y\x88{ç\xb0\x97\x89`\x06:\xcfc [\x0e\x87\x19Sx\x94\xfdT\x8c\xd3Њ\xbd3\x14\xc2b\xd7\xf0\x00ؠ\x84\xf0\xb4\x1e}/`P\xad\x94\xa5\x97\x82\xe8\x13Nu`\x92\xa2u\x8f~u *\xd6L\xfd\xde\xd1\xc9\xf6Cz\xec\xe4y\xe4\x89\x12A\r\xb4\xa8(\xa0\xf0R\xf2\xd6>$\x82x\x9b^\xcdж\xe0\x96\x91D\xce\x16\u05ec\x04\xf3\x1a\x0e6\x9eq\x972K\x95\xd1q22\xc74\x80\x8d4*\xf6\x8c\xc4)Ȑ\x97\xf6v.\xa7~\xe2\xc6U\xfcn\xb8tȩ\x97xm$\x87Т+{K\xb3\u05f9\x1f\xc0\x85\xc9\xcb~\u007f>\x9a\xa3\a\xaa\xdfH\xee]\xa7\xf9\xa8\x05\x02Z\xf0\v\xad\xedpf%\x85\x9a\x94\x06\x8d\xf2\xeeW\xf5\x1bU\x95:\xef=\xdc\x1a_\xa4\x9e\x87\xdc\xdfZ\xe0&\r\x9eՒ\x86\x917\xd6\xdd\xf8\xad\xb9o\x0f\x8f\x00^\xc7\fa\x94\xa3\xed>\x17\v\x8f;\x1c\x98\xba\xact8\x91Ӕ\xa8縒ʿ\xe78\xc00\x82j\x9dp!\xcb*KY\x85\x9aZ\x96Tq\xe9 o^\xc0\xb1\xbbw\x03\x88V\xb1OB\x12\xd6\xddtr\xa9\xaf\x96\x8d\xcduo\xac\xa97\x84\x12\x8c{\u0603\x8d\x04\x9aU+\xd4\xe6\xcdbMՊ\x02\xb3n\xb3\x8f\x19\xc9q\x93\xae2\x15a\xbb\x1e+\x00\xbc\xe3\xb6\xdeQ\x1aU\xb2\f\xaf\x84\xe1\xe4!\xeav\x80\xe9-\xdbu@\x01\xe0\x1d\xb0\x95\xa1\x8d;\xc0T\xb1\xcdqr'\x1b\x03\xb6\xee\xc0j\x1dm\xf3\xd6m%Y\x03\x82\xa9\x9c\x0f\xf0\xa8\xf2<\xaa)\xb0\xb5\xe8s\x91t\ua7e4\x96!f\xd4\xc5\xcd1\x93u͉\x8e\x02\xb5n\xe3J;\xdd\xe2\x1c\x1bj\xea3l\tf\xddf\x95*\xb9y\xb3X\x93\x1b\n0\xc1\xdd\xcb.\xc01rCk\xac\x8b3Z\x11\xb4\x06\x1bp\xc4 \xab\xb8*2\xdd3\n(\xc9rCR\xae&/Jܳ\xb4\xe0:\xc1\xb4n\xd4\xf8F\xc9\xcb\xcbR\xb1\b-\x87u\u007fv\xe9Y\x06@KDc\xe3\x02c3\xdbA.\xa5Z\x0e\x1a`/\x9f\xa2!H\xfe\xaeC\b\xf5w\xf2Pu\xc42\x8c\xa6\xbe(1\xb5\x8f\x1a4|Y\xf1}\xfc}}\xc1\xeb^\xbe\xbb\xba\xd4\xc8\\5|/\a\xbaߨ\x0fO\xe3q\x82\xf7\xea\x9fZ\xd2ܻ|\xa9\x91\xf6\x1e\x9f\xe9\x96m5\x82\xf7\xf8\xd2\xd2\xfcpw\xf1\x8ev\x17xn\x00\xbb\x8bw\xaeZ\xc4;\xe4\x81x\xa8\x007\n\x82\x83A0\x9b\x96w\xce\vwӘھ\xb4\xf0x抎\x0f\xd4\xee\xa5Q^KZ\xb5\xedZ\x04\xaa\xb1\xa6\xa5\xc0\x80ˮB[;8fn\xae\xb5\xc1\xbb7\x8a}3\xba1\xca|Ӵ!\xf6\u007fe\xbb\xbd\xb1\xb7\xdbs\xd4\xd8L\x9e\x82g\xecQ\x8e\xda\xee\x9c\xfb\x0f\x9c\xf5>\xcb\x1aF\xbdE\xb3R\xb7\xddy\xe2\xdd=Z'r\x1dC_\xac\x16c\xbc\xb2Rk\xb0T\xd9\x11\x95P\x06D\xdf^\xb6\x80\xe8\xf3*\x05\x84\x12\xae\xac\xcb\xdazW)QU\xea\x03\x0f\n\x9eñ2\xdb9\xf3\r빥\aT\xd4\xda\xd9\xd1\xecJ䷳$O\xdaY\x0fk\x8fz\xf9\xbe\x81\xc4\x0e)\xf5\xb4]\xd0r\x10\x9d\xe9\xde7\xc2\xe5\x97X~i\x95\a'97%\xe5'Ԁ\xdaB\x94\x14\"\xa8j\xd3\xe0\xf6S\xee\xe2,.c\x03\xad\xb4\fK\xb5\xe0TXM'\xdd\x06\x8d\xdd\x0e}\xf1aST\xb4G\xef\xa1\xec=\xda\xc6Mڊ\x93\xbfC\xe2\v\x9dk\x1a\xe4+\x1eeU>\xe9gzE\xbd\xd5i\x04\x97\xcd|\xb26\x88v\bBٻr\xe6&\xb5\x11\xc9B\xee{\xf4zC>z\x83;Gt\x17t\xb6\x94\x0f\xb4\xd8\xe39\xc72\xf0\xa5\xca2%ݷ\x90\xfa\xb9]\xb02x\x05;\v\xbeL\x13\xf5\xa7t\xbe\xe0\xd7|\xad\n\xb0\xfb\xfe\xfa8\xa2\xac\xf8\\ \x89\xbc\x85t\xa8\xbey\x86\xd5\xca\x1f\xdf\xc9ӏ\x89\xe8x\xe6\xee\x19\xbc\x13\x10=e\x81\x9b\xd1^\x98\xe0\x89\x84}Ǫ/ԔD\b.);Z\xea\x9bo\x89Qb,\xac\x94\xa5*\x9f\xa4\x13\xb1\xf5yʞ\x93S2Z\xc1\xed:x\x18bʑ\tH\xb6\xfe\x97\xbfD\xf51\xce\fJP\uab6b\x97\xe9;mͨ\xdf\xe4\x9e\xfe\xb1AlF\xdd\x12\x10)\xcfx\xca)wL\\e\t҄\xeb3\x84P\x1a\x00\xfd MB|\xaa(z\xe6^\xe4J\xca\xe6\xb6{\x84\x00\xd4sΨ\xaaF\x85\xdao?\x0e\t˩\x91\xf0\xa7yIS\xa2\xc9H\xd1(\xd3\xcd;&\xfe\x82\xfb\xdcP1\xadsC\v\xb5miw\xb9\xc9\xd2i<\xed\xdf,\xb90N\xb8\x14\xb6\x98\x9a.\xa8\xb7\x9bFw2FPCY\xbc\xb4\xef\xeeƙ\xd9\xc7\x1c\xb6\xe8\xe6IN\xc9٦S\xeb\xfe\xb1\nA\xd0]\xfb\xa8\x96\x19\xddJX\x84(ɣ\xd1\xfd\x89D\x8a\xae\xd64\xfd\v\n\x95\xac\xfa@U\xe4\xd7!\x0e\x8a7xw*\xee\x11\xe4\xda\xef\nݻx\x12\xd9\x1d\xacBc,\xc0:Clv\x85\x00\x1dv\x8c;j\xf17\xb7\xad|ۂ\xc9Soҝ\x8b\xf5\xf0\bG\x97\xb1\xda\xc0+z\xaa\ruo\xcboA\xe5\x94\xc3W%ം\xb5\x05\xec\xb8\xd7\x12Ku\x9dhe5\x9e\xb9\xf7W\x91\x8c\x92=Μ;C\xd1\x13\xba\x97Рjt\r\b3\x00[\xc3\x04\x82\xaa\x17\"\xf5\x8b\xe5\xcfR\x18\xea!\x81MI`H\b1غ\xed\xaa\xdflS#\xba\xb6\n\x00b\x02\xa8\xd3uB\xf8\xf1\xee\xaalL\xa7}G\xbf\v_\xe5\xf4\x9cQ3\xb1\n0.\xbd;z\xd0\xeei\xa4\xd0\xf0\xaf݁`\xd3\xdd+\xbb\xc7\x17\x87\xaf\xf1\xc5(\xd8\xe1Xÿ\xb0\xf7\xe0\xca\x17@\xcf\x15/\xb4h\x9cGt-\x9fn7E\xe5\xa3ִ\xd8\x1d\xddSi\u058c\u0605R)\x832\xb5\xcem#~\xb0\xa6\x19\"\x02\xe3\t\xa0̶\xba@\x93\xb2\x0f\xdb\xddy\xe1\xb7]\xd6m\x8f\x93d\x8bVuu\xb3\xade\x00\xd7\xc1\x80\r\xc2\xe6\xed\xab\xca\xfa\x1d\xb4\x01\xb6\xaam;\x99?F\b\xcfy\xffa\x81\xe8j\xf8lܼԇ\x12J\xcaÜt\xee\xe3\xa7\xd3l3IOs\x8d$=\x02\xaf\x94\xf5x\xe9\x03\xe0SCk\xb5\x90إ\xee\xbc\xdb\xdfګ\xc1\xc2B\x03\v6\xa3\xa5\x9f\x18]\x05\xafs[\x98\xe6\xd3$EK\x19\xfe\xb0`P'\x14\xfd4\x9bi\xd2\xed\xb5&\xe9t4\x9f\xa5uJkJ`LőT`E\x15P\xa7(\xa2\x82\xceP\x10\x17r\xa3\xb2\x1a\x19u\xef@\x92\x99ۥ(\t\xfcܨT\xb2b\xd9ƧJ\xeb\x1d>\xf9`8h\x90x\x9b<\u05c8\x16\xf8M\x18R.\x0f\x8f\xc3v՝\xf2]uI[\x99jk\xe7\\5\x80\x17\xa1e#\xba\xd5\xcco\xb5d+\x0e\x1c\x1f\x94\xe7ҥ\xabڹ\xca!\xb2\xc4:\xe2\xf6\x03$K?\bM\xe3\xc1\xc6-\x85\xe9\xffH\xa7y3\xa2\xd7An\x81\xf9\x8c\xbc\xf4:\u9fd0\xc7^'U\x06v\xc6߭\xbb\xed\x03^\xdeY\xc1.\xef\xca\x02]b;\x96Т\x96\x15I\xb6qj#j\x1a\x9f6\x04\xa6\xfa|)D\xf7\xa2u\x9dΠ\xd5\xdf\u007f\xaf\x1a\x97u\xbb\xa3\x06o\x8b\xfeX\xee\xd25\xa0\xcdzD\xb3\xa7\xa2K\x9a\x89\xd6\xec\xd0\xe3\xbaa\xael\xeaq}*W\x0f\xd3|\xb6Uc\xa2\x9e\x1e \x04\xb1\xbaA7#\xb0:3f\x94\xce\x05ĲE\bղq!\xe4Ǯ(Vc\x8a6\x88\x1cg\x9b\xac\x96^[6S\xe8\r\xbd&;m\xbe\x8e\x17\xf8\x9e\a_ō\x8a\x8a\x92?\xa5ɼ\x9f^\xccG\x8d\xa6\xbf+\x197\xd6{s \x90\xb9\x8c1\x12\x89\xf8\xd13[\b\xf0\xf8\x80vF\xa1\xc7R|f\xfa-\x12\xd5\x1d?J\xae>_\xf0\x17\x01|\xe5\"\xdf19G\x03\xa2\xbd\xe7\x12\xaa\x13c\\\xe4\xec\x9c#=\x9c\xa3%@\x87N\x83\xdeU\x87ٖ}\bC\x91=\t\x00*\xf1%+˃\xb7\x9c)\x04Vh\xf5\xf3q?\x9eA\x17\xd4j\xffД\xac\x86EJ\xf9\x84\x99\x14\xbdݤ\x81o\x81\xac%$\xfej\xa7U\xc2\xf1\x13\xa5\x03\x85\xf8Z\xeb \x16c\x17\xd9\xf0&\x9f\xa7\xb3Y\xba\x19[\x8fd\x02WC\n\xf3\xec\x93\xf8\xbd\xadU\x13\xe9\xe7\xd7a\x9aJ\x1dŰ\x15i$\x86\xbb\xb2\x9d\x9d\x06)\x0er\x17I\x1a\x82s\x0f+zAQi\b\x95\x9c?\xc3TÞ7\x82'8\x95h-\xdbtxZ\a\xb8\xbb\x8a\xb3\x1a\xfcN\x9f\xc39˶5\x94\xf7\xd95\xeeU7\xa1\xf6\xc2P\x9bH\xbf0\x03aS\x1a\x14,\xf01\xd0\x15\xff\xbfG\xe6d\xd0S\xda\xde\x00,\xdb\xe4\xa8\x18\x95\xbd6\xb7Xj\x1at\xd6\x19\x9a\xa2\xe3\xcf|\x91V>8V\xe5.\xfdE\xcb\xf0\x02J\xa2\x8f/\xf1\x8bT\xc1\xf6U\xb9^\x89p\xe4\xa3\xdfb\x0fF\xc4j\x10.\xa3\xaao\x02F\xde$\xf0\x9dg\"\x14\xed\xe8V\x00\xce\x00\xf0\xad{\xb0-\xc0\xed(x\xb7\x0e\xbc[\a\x9e\x13y\x9f\xdb+\xba(z\xf6\xa0\xbd0)\x1e<\xae\xca1\x1a\x16\xc4\xd1\x16\xb5\x9f\xc1/|q`\xd9ŉ\xbb\x9f\x8bQk\xd0\xc8\xe5\xebr1\xb5\xb2\v\x03~Vɣ\xe9b\x12\x8f\x93\x0f\x97\b\xb7\xf2\r\xc5\xf8\xcf\x14\x04\x8d\xd0\n\x85Um\x86y\x06\x94\xa4Ud\xbd\n\xe7\xe6\xf6\xecz\x94\xf7\xfd\x80;t\u007f/\x14\xc2\xd3r]Q\xcbwn\xf9.f\xf9\xf54\xae\xf6\xf3\xa8K\x85\xcd\xc3=\xa1\xddH\xc1\xed[\x8a1eڌ\xa4\xf3\x19\x15l\x0e\xffN\xf9!\x00U\xa5=\xfb\xecf\x9a\x167\xf9\x10\xdf3\t\xf0c\xbb(V\xb6k\xf4 \x9c\x1cw\x87 \xed\x12\x9c\xbd\fT\x16\xaa\x9e\xb8l0M\u007f\x9b\xa7\xe3>\xf8t\xea\x88\xf9\"\xb9n\x11\xbc\xcak\xa9\x1a=\xf4\xc1\t$ [%\xbf\x15#R\xe9\x98̴\x8c\x8fmQ\x1c\xd4!\xd7\xcd\xe4\xfbT\xc8_\x86no\xa0o7\xf7\xce\xd6&\x17\xa1\x17\xdcF\xea>BZD/L\xc0?\x8c9\x15=7!\xff\\YǺ\x0f\xe7\xbeYAQ\xdcU\x8f\xf0@\r\xeeR5P蝹u\xe9F\xf9\xad\x9fN\xee\rh\xbe\xacy\xf9ZiZ\x02\x17\uf34d\x1e^\x8a\xec(yg\xcaL\xb5Đ\xd6\x06[\xb3\xfdO\xc4oĈ\xe1\"_d\xe3\vѼ~\xaa\xa23>\xd4d;\xb5\xf6\xe4\xd3\xc0\x9e\\7emX\f\x02j\xcam\x8e\x00\xd1D7\xc7(Tz\av\x152RVl\x8e\v\x11_\aORCa\xef\xab\xe5\xb5 ~\x1a\x82X\x87\xac\x8a\xc11\xfc\x1a.\xdfץ|1\x9d{\xa0=\xfaֽ\xe6\xfc\xb7\xcd\x15`Y\xdb\xdc\x016\xe0\x9e<Y\x8f\x1fB\x99\x0f\xeeb`\x0eG\xab\x9e]B\xf8+uR\x88\x87\xb0\xf2:M\xe0\x80\xbb\xe0\xa7\xd5(\xc1u#t\x84$!4\b\x843\xb7d\xc9o\xc7\xc3l\x8c\xe7\xfd\xa2\x82\xf5\x9e\xdf\x1c\x06}\xe2\x1cװ\x9b\v\xe7Ԅ\xf5\x02\x80\xe4\v\x9e\xca{'\xed\x85}\xce^\xacsQ\xc1=\xc5Wˡ\xf1\x12C\xb8\xec\x800L֓\xd2p\x92\xa5\x88M\x98\xe0\xc1\xdb;Em\xcb՜\x8c4 \xf1\xe0Y})K\xad\xf2w\xa8\xf5\xb4\xacC!7\xd6\x11\x18\x03\x1a\xa2\xba>\x81t\xae\x84Iw\x01\xec\xfe\x85XU\x02\u05cb=W\x10V\r~R\\\xc2\x1b`\x00+\x8b\xd1bz\xeb\xb9U@\xc0\xf2j\x8c\x04\xb4\xde\x05\x8c\xbbǻ\x80\xe1\x8c}\xf9\xc0\xe3\x80(\x9c\xad\x1b)\xef\x1a\xeb\xd0\xf4\xae\x82\x94\x153\n'ݩ\x9c-\xabH\xber\xd0պ\x15\x1c\x10s\xc9\"\t_\r\xb9dqѤ\x88\xc1\xab\x1f6\xb7W\xdd\xee\xf0\x8e[u\x9bpl\xd6\xe4C\x1e(\xf3\xca0\x80\xecX\xa0\xd0\xcffƘ\xab,\x1f\xf3\x88T\xb7\xe2,-\xfa\x1a\x8d\xe9\xd36\xcf;\xec\xab`\fئx|\xe4\x93\xe9U\xcd˩\xc3\x11\x98\xe6\xf9\x8c[\xcdhnA*\xc4\x1cw\xe0Crx\xbdd\xed\x06\x8c\xbe\xee\x94b\x81\xea\xf9m\xbe\x15\xa7\xb2\x13<\x94\xdd\xe2\xd2T\x13\x11\u05f7\x82\x8dosH\xc6\xddE\xdbJ\xb1\xf6\x13\xa6\x17\xf0\xd6\xf2gx\xf2\xf8r\x001t\x88Ff\xf7n=\xe0lM\xad\x87\xbbr\x11\t;u\xad\x02ώ\xb5E\xef/\xb2\x9e\xa0ߵ}\xf0Y~i\xfe\x96__\x03\x93\f\xf6n*vm\xf7\x141[\u007f\xdb7l\x94{\x15g\xf3\x84c\n\xfd2~\xbbn\xedg\xb6[ъ\xa8\x05+\xed\xf4\xac,b\xf1\a\x90胰\xda-\xc7j\x1b\xe2>x\x83\x0e(\xbf\x01\a\x9f\x83|:\x92\bݓ\x0f\xa2\x1b\xfa\x03\x9b\xec\xdbǹ\xb6GJ\x8aC/PY\\OD\xe1\xcb}\xfdۏ|\xaf\xcf9M\xc2\x16\x03^\x83\xde~\xb0נ\x9d\xb7\xab\xda^\xe3:!\x9c\xb3W\xde$\xec\x0f\xe7\xc5,\x9dV\xca4Y\xe6qĚ\x18F!\xc0\xb3|^|OW\x9di8o\x17\xe2\xff\x97\x9b\xca3\u05ff\xcb:\x97\xa4}E\xd0\xd5\x01\xed\xa7V\xeaN\xb5\xc1I\xd2\xe3\x17\r\xc4R\xc8\xf5\x95k\xaf¯\xa1\n\x81\xe3*\xdd$\xa7\x14\x9e/\x83\x199\x15\xfb\x83\x98\xdd\x15\xe7\x85\x1a\xb6\x81\x98!c\xa5Zp\xdd}\xc6C\xf0\x12\xcd0\x1d\xccB}\xfaN\xa4\xd3\xfa,FZ>R\xf2\n\xfd\x04\x19\xaa\x14zB\x04p\xe4\x9f\xc2t\a\xd2$\x10\xe9\xe1\f\xfd\xd4b\x02\xf83\xe0e1\xb1\x89`\xac\x13\x97m\x19G\x93\xbc.\u007f)\x87\u007f\x10ϓ~?U\v\xd8\xd9'\x1eu\xebP\xa8.\xd7\xe5\xcfU\xfa3\xb5R\x9fF\x9d\x86\x06\xc0\"h?\xa6Ĥp\x12r\xa7\x04\xbf\x9b\x8c^֠\x00e.tV\x89$\x95#ײ`l!Ruus\xb9\x8a\x01tūl\xd5v\\\xa50\xf9\x88b=\xd4\xeeJ\xe9*+\xad\x10\xb0@\xedJ\xe9\x8a\xde1lѪ\xf3\x06ٴ\x98\xfd=\x1e\xde:s]*\x10\xeb\x8a>1Q\x94\x03cLG\x16(\x97\x87+7\xc6R\xf87\t?\xdcm\xf1\xd7\x17\\\xc8cf3\xc2G{3\fui\xea\xc8m\\ӳxW[S\xe4\xe6\xc6~\xefa\u06dd\r\xddd\v\x16:\xceKS\x86[<\x99\x88\xb9\x84\xcfI\x02\xf5L7\x1c\bV)\x85W\xd9i\xa03\x11!\x02\x85m\xbeR\xf4\x1de\t\xf9\xe2:\x8fZ\a\xe0\xb1\xc6P\x8eF\x10\"\xf6\f\xb3\x91\x90\x8c}/\xd1\xf3HV\xc1:\xe8\x1cA\xeeo\b\xe2\xb9\xcbin\x83\xa5\xab\x90\x02\u007f\x16\x82>ʁ:vS\xbb\xba\x9f\x16\xc1\xfcU\xd1\xc71X\xb1ja\xfd\x93\xe9P~\xe0a.\xf5\xa6\xfd|\x9c\xb0\x19\xbf\b\xaeRf\x16\x1bT\x16g\x1f\xa8\x06\xad9\xed\x03O\x92\xe4C*\x86\x95\x18\xe9\xf5\xa73\xeb\xb4\xfd\x82kS\xea\x99\xe9\x1b\x1c\f6\u007f\x9dw\xf6\x95L\x81\xd7G\xb3\x89$d3\xba\xcb\xd9\xef\x10\x93@\x91\x91\xa2;Ys[\x96p,\x10\x9a\x80i\xa8\x05/\f01\xb7\x13\x11\xbeh\xc5.\x89\x89\xb9\x9d\b\x82$@s\xc2\xd0\x16:\xa4\x8f\x89\x9cFS\xf6\xcc\xceG\xa5Nd4\xa8\x83\x827\xe0\xc3}\xf4\x9f\x8fJ*\xe6#g\xfa\x13ł8\xe4\x13\xbf\xac\xec\x16\x93ˮ%<\xa2\xeeZ\xa41s\x12\xa5\x8a\xa1\xae\x164@vkʊ\xcaH\x80@H}\x82\xef\x18\x17B\x02\xfc5:\x15\xb4\x92^J\xc4\t>1\x8a\xe6<\x95\x80\r8\rG\x84\xe2\xb9?\x98dV,\xcb{\xb0I\x93\xe1U7\x8bi\x1c\xe2Q+\x16\x03:%躜\xc5cn\t\x82\x91\x97\xc0p}KJFzR\xc6\x05>{)\xc0p\x06\x1d'4\xd6e\xbc\x02+\vІ\xc69\x9fTc2\t`\xa2Y7\xc0\xe7>\"\x93\xb3\x922\x1a\x91\t\"2*U5\\\x96\xae\xf0]\xa1j\x05]\x12noX\xdcd\xafT\xa9z\x1a\xb9\xef\xbc\xf0R\x88ӱD\xd3ށ\x8a9)6\xab<Q\xd0\xceI\xa1\x88\xbeN\"N\x00'\x8d\x8e\x8bO],\xc5^,\xbc\x80Sy8ȁ\x1ds\xd5\x1b1\xa3J\xe2\xfe\xd5lc\xf4\x9aE\xe4\xdaŽ^P\x05\v\xee\x9fi\x17\x06C\xe6\x99\b\x15;\x8e\xf2\"\xbc\xad^Q\x17'\x95\xac\x9c\xa4\xe9d\x93\xba_\x8a\xf2i\xf1\xd1\xf6\xe9M\x8a\xfd\x02X\xc93\xdd\xdf\u007f7\x87i\u007f\xd2\ue75d0?\x03\xfc\xbc\x8d\xba\xc0,\x1d\xa6\xb34\xb0{\xfaw\xde\xc3#/\xfe\xb1\x1bxC\x9a\a\x8e\xc3Gܺ{-\xaeܷC\x8d56\xed\xa3xR\xfd\xfe\x0eb=\xd5\xcd\x16\xfb\xd66\x8d\xf2\x1bl\x8eR\xad\xf7\xbdB5\x8cW\xbb\x98\x84R\xc0ہ]oC;7\xa8\xc3%ֶ\x90wm!иKS\xda\x1aJ\x18\xe4\xd6\x05>\xf0\\\x18S\x9f\x9f\xebz\x1c\x9f\xa0\v\x84\xe2\xb7y<\xcd\x06\xcb\r\xbc \x94\x9d\xbeW\x18]\x01\xdc4\xed\xcf\xe8\x14\x87ڂU\xec^\xdeŘ\xa6\x10 \x97\xac\xe4\x1aL1\xcc\xfa)\xbc\xfa\x97\xb4\xed\t)&\xf2M\xf4\xb4\xa2\x9fOŸ\xcf\xf5\x91H&ēh\xa5\x05W\xa3\xe9ǲamkh\x84uk\xaa\x14x\x8f\x95\xe5-\xa7\x15\u070fp~\xafH\xee\xfb\xb1\xc0}\x96\x86\xaa\xfb\xef\xe9\x9a\x00\x04/|\xa8\x81\xd7u.\xc7x\a\xcf\xd2\x1eu\x93;\xe7l\xcc=\xbf\xe4H\x05\x01\xeb>\x17˚\x90\xab\xf7\x82\"B\xe5\x11L\x00\x04\xf3#\xed(,'\xf9\xa4\xeeh\xab\x92\xc2\bq\xe5\xde]\xa3\xb7{N\x1d\x03x\x1e\x8a\xccg\x04\xa1F4o\xd2Q\xac\xd3~\xf5H\x9eyͫ\xb0\x93%\xa3ú\xa4\x98f\x95~\x06\x145\x90K\x1c\x93\xd8\x1d\xb1\xa2\xf0\xae\x89ك=\x99\x81\xdb\xd5\xf3{5!\x1b\xd5\xd3\x16<\xf4\xfdY\xd3v\x9dɪ\xe6\xf6G\x9f{\xdeT\"F\xac\x9ax[\xcc2\xcayo\x1c@\xfc\xab\x82\xc7U\xe1\xcfu\x17OU\x17\x15\xd3<\xf1\xa4\xc5\x16\xdc\xfd\xb0\x16?IN\xa9\xe6'Kxد\xce\x19\x02\x02{\xf1\u007f\xfaY\xcat\x84\x17\x8c\x8d@\xe6+\xa4\xe9\xc1\xea\xf5\x90\x9c?O\xa9\x96X\x04\xb111\x84B!\x9fe\xe3\xb9c؞\x8a\xea\xd0tC!0u\xf3_ \x92\r\x85\xaa\xb7\xcd*\xc07\xb9~P;\x87\xaf\xb9\xfb\xcaP\x8c\x9e>g\x9f\x03\x8f\x02,\xf1\a\xf4$x\xbe!\x96\x1fPG)+\x1b\xab\xac\x06\x1c\xd8\xd9\xd2\xe6\xa1\xc41\x94#\x0f\x87\x82?\xcb\x15\x0eCN:`E~Zгc\xfc\xbd\xa4\xbbqs\xe0\xba|.\x94\"\xcd5\xcf`HO\xf1\x89\x05\x97\x01sp\xd6'\x19\xd4\x1d\fD\x06\xf4\x89;\xa0\xa5\x94\xbf\b_~\xacwK2\xd7#z\xe6y\xcc\x0e\xb8\xcb^\xdaI\xe8\xb0\xeaγI\xe6tm\xdf^'`\x93\xa2&\xdb.\xdck\xbf\xd3t\xc8\x15\x15\xee\x90\n\xc1\xb0\xbe\xb9\x98\xad\xe7N0\rlgGQچϝ\x83\x02\xed\xd1n\xe2$&\xe8\xa7۽t\xb9\x92\xce\vN\xe7\xc5\x1fB\xe7\x85K祤\xf3ң\xf3R\xd3\x01\x9c\x8f/\xb7\xa3\xb3\xbe\x85\xc5FZ\x11\xdai\xc0\xa1\xe9\"H\xe8\x85M\xe8`,fR\xffˮ7\x92\x90\x14?\xc5P\xf0\xbb\x90\x1ft\x85\vS\x12\x165\xc0\xce`Q\x03,?C\x12\x95\x86\u007f\x81αW\xe0zzI\x18^5usO5|yZm/\xa7\xba\x01t\xdaDk\x03\xf8fRj\x87݈\xc6HL\u007f]\xf1\xdcv\xdb\xfe\x81>\xbd\x90{\x14\xa3\xe1G |\x8a\xb9D\x03\xcb/~{\xbbg1\xbe?\xc6\xc9\xf7\x98O8+^kjܛR\x80\xab\x038p\x98f\xee\xcb\x1d(Ge\x12\xdcG\xdf\xe6\x8a6\xbc\xbd5\xb4[\x1d\xb4\x8c]ML\xbe\xae\xba83\xc1\xe7\xa4d>$\xc8\xcaVm\f$~\xac\x94\x89\x1d\xe48Ha\xd9\xdci\xb0\x80\xb4/\xca\xe7\x1f\x13\xf7\xf9\xc7e$А\xff\x17]\t\x18\x93F\xa9\x1fe\xd1\xc3W\xf9\x18\xfc\xc1μ\x1ezf\x01\x0f\x83E0\xb4\xe9\xda7\xb70\xfc\xb7\xe8\x04\xbb\xc9\x05\xbbv\x16\x06\xae\xb1\x06\xa9D\aѹ\x19{\x10\xd3\xf0=M\xb0\xa1\x14\x15\xa8\xbcM6\xf4[}\x89At\xe9\xff\xe4\xbcP\xf4\x81\xd1`\x9f\x1bp\x9b\x9a\x81[<B\u009aO\xd4K-#\xe0\b\xe0B)Q\x94p\xea\x16[\x8d\x9a\x99\xc7[8\x9b\x82\xaa\xc6є\x04\xb4\xb0R\xa48\xf3c\xcdU\xd2K\x8a\x90m\x1em\x89\xf2\xe6\xcd\x16\x81YG0\xace\x02\xc3\xf9\x1d\xb6\x82\xf5\x8b;\x92\x97IqW\xaf51bU\xba\x98=\x13\xe95\xf5\xectf\x95\xf9\xbft\x99Y\xdcە&\xc24٥\xb7\x89\xba\xd2u\x9ak_W\xf2\xf6\xb5Hz;\x8d\x93,\xc6\xd7d*\xee\uece8s\xdc6\x95Z\xf1\xfbl4\x9f\xdd\xc4\xc3J\x93\x9c.U\xef\xe7\xf9TL;\x81\x83\xf3\x96\tM\xd5,\x17^F>u\xf1\xd8\xc5\xc8\xd3K\xb7h\xc7/*\xb6o\b\x11\xf1\xee\xe7E}\xd1\x01\xa3\xadI+\x84.\x84i\xfd\xa5Un\x89\xe5\x96V9L\xeb\xc3\v\xa4\x11\\̃\x00v\xb5|:\xbb\x81W^\x93\x9b\xac\x8f\x9e\x13\x97m\xb0O/a)\xea\xe3\xef>Z\xac\x01\x8dS\xb9\xdc\xf5\xe9V5\x02A\xb1\x00הR\x0e\x05\xee\xeb\xe2j\xd6\xef\x83 0%\xaf\xc7\xf9(\x1f\x9bB\x02\x1b\x9e\x9d\xfe6\xcf\xc8\a\xf7\f\x85L_\xa1\x1fC\x9f\x040x@\xf5\xb9\xf8\xffg\xa6W}R\xf0\\8\xf1\x10\x14\xc0\x9a\x9a\xf3\xb8\xa2v\x19ZP\xa1C\xbb\x97[\xdd\xcb\x02\xa3\xffɤz\xe1\xf4\u007fWRDPǵ\xca_\x92\x02$\x03\\\xcd\xe023\x84*\xa2\xd3x\x99\xb3\xb4r\xc4`_\xb9\xfa\tu\xc0\x19\x14\xf0\"\x05>\x91\x15\xa0\xf3\xa8\xdb\x06_)\n\x12\xca\xe1\xfdc\x91\xd4=h\x83\x14F\xdej\x03G\xc05f\xf5\xf0[\xf3n+\x1bߥ\xf6+\x94rnF\xbb\xb0\xcdͻV\xf7\xe0\x14\x04\xf1\"\xba\xd5\x1d~\u07b5z\xcc\nO\xac\xa7\x01vd0z%W\xc1^\xda\xe1a,8\xa5>)g\xb1\xd2B\x0e\xa3MʹǴ\x05̆z\x1a\xc22ip\x8d\xa1\xe8\xf3\x89\xd6W\xf3LOǾ\xcf0\xf5E[\xa9{\x80a\x17\x89P\xf4\x814O\xd5\xd4\xebK\x9a\xc8Y\t\\\xfb̓\x10\x06\x13Q\x9e\xca\xee\n\xbd@\xe0\x8eU\xfb\x12\xdc3\xece;\x00B3\xa2\xcb)\x92\x1f\xb7\b\xad\xcb\x0e\x9f%\x10\x18\xdeZ\xcd\xf5\x8c\xa9\x9a*A\x808\u007fKoaPո\v\x93\x80\x8c\xa3*th\x81\xc9!\x19m\xbc\x97\x98b\x9d\xf2b},g\xa4\xaf\xd9p\x16&\ae\xb0\xf7\x9eh\x15\r\u052c\xdfB瀚\xd6\x06\r\xae\xfd/6l\x9f\v\x9a-\"\xe0\xa8\xda\xda\xc1\xba%\xb7v\x16(\"\xc5\x1f#\x0fW`\xe6f*\x97\xf1\x97`S\x12\x02\xd0\xd24pm\x1f\xf6\xc8\xd1V\xc5\u008eE\xca\xe5 n\xbaaIp&\xce\xd3\xc8\x13\x8e\xc3\xf1u\xbb\xe1ʷW\"\x03\x04\xc9X\t\x93\x82|ܗ\xc3\xea\\\xe1D\x1d\x97\xae3\x13\x0eJ\xa8\xdbe\xabN]\x17\x04Ɯ\x81w\x85I\xbbQ\xb9\x14\xb1\x97\xb1\xc3\\l_lZ\b!\xdc!\xcd\xcc\xc6\x1eT\xb2\xe10\x1d\xcaG\x187Y\xb7\xba\x14<\xce\x12ͷC\xa5\xf4\x84kF\x05\x9f=ж\\\x1et\x970M\xc7\xe0\x12\u052f\x00h\xac\x1ec\xbd\xe9.\xf4\xa6\x9b\x1a\xe8\x1a\xff\xe0\xaf@A\x83\x81\x11E\xd4\xf0\xe9)?i\xaf\x1eb\xe8_p\x1c\x89\xe1\xdc\xf5_K\xa9\xcbh\xf7\xe4\xb8\x19\xed\x1dӮJ\x92\f3\xba'\xad\x83f\xb4\u007f \x90\xbf2*A'ݫR\t\x80,\xf8\xc6\xf8\x95\x00\xa7\x14\x02D\xe1\xdfD\x1bhc\\\x11X\x10\x97M\x9cm|]\xc4\xfcF\x99\xce\x00u\x9fb\t\u007f\x89Ev\x10\xac\x0e#P\xbdt\xe2\xf8M伂1\x1ck\x1fN\xe35\x96L\xa2\xe5\xe3/W+$\xa4\x9a\xa0!d8\xdbl\x13l^\xd66\xe6\x06΅[c\xf5\xd1W3>\xb1\xac\x96\xff\xc4u\xcc\xc7)@)\u007f\xc9\xfa[\x11\xaf\xb1j\x89R\xe5Sv\x88\xb7h\xec9\x8bb\xd7\xdbz:8\x83\xe8\x8a^D\apuC(\xc3\xc5-\xb8\xd3\x00hϣ\xdd\xce>\xa4\xde\xc4\xf7q\x96A\xaa(\xf9<\xea\x82/\xb1\xc9\\Ȓ\xfc\xa7\xac\x9fc\xe9\xfbt\xba\u007fܰ\xb0sE\x9e,\xa4#\xbaʮ+\xe6A\x0f\xf1ԼW\xc2,\xfc\xbb\x9dC!\xde\x0ea\xf17l/\xd2\x0f\x84\xa4<<\x88\xae\x180\x89\xf5J`]\x0f\x98\x90ǝc\v\x16\xebm\x15<\x00\xd7Y\x01N\x0f\xe5\aL\x10IJ\x82`.S\xd8\xc9\xc6\bIdU\xc9Bε\x0eu\x1eQɫb:̪uZ\a\x8d \xc3Co\xf4,\xa9+4LJ#$ \xacJ\x1fD\x06\xd6\x107\xa1&p\xe6\xe3\x90\n\xccA\xe9\x9e\xf6\x0e\bS\x04\x0fD\x16l^\xf8`=R\x9a\xac\xcb\bO\xe6\xf6۰mK\xde#\xb8\x9d\xa8sD\x9fѕKh\xaff\xe7Ī\xd9m{5\xd9X8\xb5w\xa2\x83c\xab\xf6\xfe\x9eW\xdb\x1d\xa8\xa0\x86m\xf3d\xdd!\x9a'\xb4z\xf9x\\\xfd\x14\rKT\xeb\x17k\x9a\xceV[\xce\xe0\xbc\xcerͲ\xec\x84\x0e$\xfa`\x0eZv\xe8\xf8\xf0+\x19\xa4\xdc\xec\xe3\x805&v\xdcsK\xf1\xfe\xaaa\a\u07b6\x94\xed\xaf@\xd9\xeewJ\xcf\\1L9k\x8b\x83z\xca_\xac=|\fcӆ֤e\xa7\xa9\xfb\x82\xe3\xf8\xef\xa09V\x8fo_\x8fo\x99\xee\x88ʽ3\x84\x86#\x9c\x81G{̈́\x9b\x9eP7\xed7\x945\xd2\x1eEo\xb8\x9dA\x05\xa0ς\xe3\xef\r\xf7\"\xa0\xba.W\xe9\xa44Hj\xc9\xd9J\x94.;^#\xda\xe0ё\x1e7\x11\u007f\xb0\xb0\xa1\xady\xe1\xcdCW\xe4 Z\x01D?@w\xbe\x84\xa1\tP\xa8]J\"i\xfeY\xac\xb4\xfc,\xaa\x8c>+z\xf4\xb1Uݲv\xff<E\xd7ǈgx\x86\x1a͜\xf5\xfd\x03o5\x01\v-\\\xa8\x88\xc7\xd7\xf3a\\\xed@\xc2)\xbb\xd1\n\xf3,\xda;\x94+ʮ#t0\xeb\xecc\xcaރ*\xd9K\x95\x9c\xbe\xfd;I]M\x12A& H3ڥ_KG\x14\xb9}\xf8\xd83\xc3i\xaf\x12\x97?o\xb6TaY^\x84ϑQ:\xed\xc7\xf2\xd5{\xe9\xe4P\x85\xb6\x9e\x15u\x14\xf1\xc3\xfc\xba\xaee\xbd9F݇Ut\x85&\xf6,\xea\x06L(\x8d\xad痾\t\xb9\v\xa60}%\f> \x9c\xec#N>E\xbb\u007f\xefYg\x1f\x87\xe1\xaft1\xa9\xeb\xc9\xe8-\xc9\x01{\xd6nt\xd2vf\xad\xee\xfcǞ\xae\xaa\xa1p\xeb\u007f\xde\x04\r\xe2\x15\xc8\xe3Sr\x12\xe3%\xe9*\an\xb3\x1b\xc7\x1d\a\x8bh\x8c\xfe\x06~\x12c2/\xbc\xb0Ƙ\xf7*\x9b\xf6\x87\xd2\xe3 \x80z\xdbǄ:\xabX\x12\x92\xd0\xec\x1cE\xb57\xa29\xe6蓮\xd6\x17\xf3!\xb0xo>\x18\xa4Su\xe1\xfbs\xf5\xfdN\xb4P\xaf\xd5\x1a\xf2\x1a\x80\xf6\xaf\x8b\xb9\x8eSFi\xdc*t\x1c\x1d\xf7\xd6\xf14\u007f\a\ue7c3\x93HY \xa9\x8c;\xd7$\x1e\xcdZ\xa3\xe4>\xf3py\x9d\x8f_N\xd3\n\xabX1\x87\xa7\xe5\xb1S\x06f&\xdd\x1ao\xcbgU&o\xc5\xe5q\x00\xb8\x1b\x80\x98y;nQ\xb2\n\xefWb\xa8\xa6y\x96\x94\xe3.\vj\x8bӨ%S\x9c\xae\xa0KuC\xc3F\xa3\xa9\x1ez\xc9\xf2xA\x16\xdfH\xc86Y\x96J\xaa\xd3\v.|\xc0E\xbf\x9f\xd2%\xf0\x0e\xb0\xc1.\x9c\xc5\xe0\xe2!\x8b\xe3\\Z\xf2\x048\xc1y/i\xbd%m\xcd\x06j\xbd\xaeg~\xd7\xcfܷn6\t\xd8c\xb6\x0f \x05\xdf\xd7\xedZ4\xe1\xfb\xcb]\x8b8&\xe7\xbd\xe2\x9f\xf2M`\x13\xf6\xb1\x87B\x9a\xbf/9\xae\x8bK\x99^\x02\xa1E\xa2W\xd4+\xe8\xe7\x11O\x12ɳ\xdcZ\xa2*ڇ\xd5w]\t\x05g9\xba\x05\xd7v\n\xf6lp\xd3\xef\xca\x15lS\x8a.s\xc4\ar\xb3\xae\xba\xf9u\x1a\x8b~\xa6\xafr\xb1\xa9@\xd8,\xb0D\xeeZ\a\x06T\x18\x83\xe5\xb5ԇ\xfdjD\xa5:\x1c\xea\xf3\xa8\x14\x91\xf8\xa8G\x8bWU\x1b8\x12\xa8\x9dΦK\x16+\xb9i#]\x86\xaa\x06\x97\x1b \x1e\x8c\x1f\x81\xb6e\x108r\xb5\xd75M{\x01\x913\x8b5|~\x13\xaf\x85,\xcf*\xdbA\x93\x8b\x01\x88te\xdf6i\xcb\xe7\u007f5u\xad\x0e8\xa2vE\x0f\xbe\xcb\xc6\xe9\xc5l\x9a\x8d\xaf?z\x0f\x1c\x8c\x1bkrNY\xb7j\xdfq\x10\xaa\n\u007f1i\x0f\xd3G\xef)\x84`\xeaY\xe5!\xf4\xd8z\xcfC\xec\x9a\xce\xd6\xc3~3\xf2\xce\xf3ۅa)-\x00\x1e\xed\xab\xa8\xcf0{G\xe19B\xf4\xb7\x1b\x80\xa0K\xee\x10\x84\a\x81y\x0e2\x13\x13\x05\xec\xffiÀO}K\xc7\x02\xce/\x1a!\xdf6U\x03\xf3xC\xa3ڹ\xb4\x15Yx\xd1\f\x97+\xffQ[\xe1\xf5ɑx\u007f\xfc\x00b\xaa\x9b\x01\x01\\\xff]&\x98;R\x8e\xbb-\x17y\x0f\xa4\x13!\x8f\xfc\b\xdbxH\x1e\x9bP\xdd\xcd\xd8l\x15\xa3\xb1\x0e\x80W\x83I)\xab9X߆\xb9m#~\xb3\xdd\x05Up\xdf_\xe5b\xbf\x9e2#U\x83L\xb2\xa0\xf9\xb49Фo\xa7Ҙ\xfa\xb00\xb9\x8fx\xf9\x99\xbd\xd0\x19\xa5\xa26A\xa5\x93\xf4\xee\x0fV\xda\xd4\xc3\xe3棪o\xeaŵ\u009a\xabn\xfe\x8b$KK_S\x8bcU\xd7P\xe6*E\x8e\xda\x1e\xb3\x8d\xae\xadȝm+\xc8h[\f7\xc7?\x86\x16\a\xd0w\xce\xcb6\xe8AJ\xeb\xcd\xf2\xd6\xf3Bw\xe9\xf1'\x88\xec\x8f\x1eR{nT\xf6EFb\xd3\xcfRq\x15\x91\xbbB>k\xd8v\xb4b\xe6\xac`\x14\x0e\xf8C\xf8\x8e#i\xf6\xc7\xda`\x11\xe6A\xb6\u007fe\x1bbx\xf8\xa1\xbe\xbaWM\xbe#\xb6\xb3\x8cIncf6\x92\xa2\x92\x9bUc\xdaQ\xf8\x92\xfe\xbc\x97\xb1.\xb7dv\xbe U\x10\xadt\x02\xc8\xd7\xe5A#\x82|\x11\x1d4#\x80!\x81\xe7u\x83A \xad\x83\xe5\xf7t\x96\xfc\x9e\xd1\xda\x12\xe7ȉ\xd6v=d\x85\xb5\n\xf8^\xed\x15\xad\x1e8LˀPf\xda-\t\x87\xddp\xec\x0f\x8b3\xf7\xb8\xdd\xce\xdfa/\xcf\xd76S\x94\xd8b\xbc\xee8\xe9\xf6\xa5\x98\xf98\xb1\xfa'\xd7\x16\xd5K\xe6\xbc\u0378\xae\xd0A\x91M\x92\xf2Ҷk\x92(\x86\xf2\xae\xed\xdfA\n\njW5\xc6|\x9b\x81\x19ɦ\xf0B\xb0,9P\x93x,ά\xdc\x17\xca\x17\x9bB\xc1\xca_\xb2\xb0\xca\x1a\xed\xa5]\xe2\x05\xa0ڐ\xf8*?\x14\r\x8bv\x97\xe2?r\xfc&\x81\b\xb1p\x19I\xf7nPQ\xca\x02\xf7i\x1f\xf5\x13d[\xa1\x9d\x03j\xb9h\x95\x91\xa9\xcdOJ\x94\x8ePa\x93O\xd5B\x8b\x8fU\xcf/@\x15\xf9\x8eܪ`2\xa8\xa0\xb7\u007f\xb7J;\xb9\xac\x8a\xb4̬\x86\xadE\xa7\x0fXfQ\xe1\x10@LS\xd9!02ՙ\x03}5\xd5*B@\xd0\xe4\x13\xe9\x8eM\xf3.+\xb2\x9e\x9a\x96\x9eA3\x9e\xf6[\U0010caef\x8b<\x8f\xack\x1a\x0fn\xac\x89lRn\\_\xb5[\x83ړ4\x91~\xc2&b\x81\x98\x88ғn3J \xb8pg\xb5\r;\xe9\xa05\x9c\xa3\xdd\xf5\xb6hN\xf84QGw\xaa\xe1;\x9c\x16\x9b \x89\x97\x8c\xdfF#r-\x16\xc0\xd9\xcbi\xff\xdb\xf1,\x9d\x8a\x85\a\xee\a\x12\xb6\x8dz=\x817b\xec \x96\x12\x92N\xa3a-B\x16\\Q\xd1\n\xf2\x00\xf7c&\x1d\xe7\xc1r\xd0=\x17\x96\x9at]\v\xc2\x13\x01\x02\\>\xc9FB^\xae\xa2\xb5\xbb&\xcb]\xda\xd0p\x17\x06\xa3$\xfal\x8e:\x13rޘt\xe8w\xc3u\xb7N=[\xe5\xdc(\xc1\x97&\xde%=\xacm\x9f\xc5\xe8\x937|\xf1+\x11m[\x8e6D\x96 Ť\x8b\xb1\xb2\xcf\xe1-\x84\x8c\xa9*\x92::\xa9\x83IO\xea\xac\\\xc7/ס\xd7C\x86\x8e\xf60v\xca\xd65\xb1\xe1\x89G\x93\xa1\xa6e\xa3\xe4Q\x90*U5\x8b\xda哈L\x1c\nL\x82O\xab\xc0\x0f\x83sf\x0e\xbe\x18,UM\xd5(\x9a\xe6}\x04̤\"\x9fO\xfb\xec\x96o\xf9\xe4\xd3\x10\xec\x9a\xd6\xe4#\xcfw\xaefƕF'\xc2\xf8;\xea\xed\b\xdd\xe4H\xf8J?\x8c\xa4\x89\xe4,j\x98\x0e\xd30\xe8\xb2`\xc4r\a\x84!G]m\x9c\x85\x87*\xa9xBD\xb7\xac\xc0E\xab\x98/\xd8\xe7\x13\x98\xe4\xadv\xa7\x19\x99\x17\xf1*\xd7\u007fy.\x900g3jʡ+Q\x8eXk\x16O\xafS\x8c\xa8\x94%B\xed\x14\x8a\x88D\x96dz\v\x98\x89\xcb\xfe$\xa8\xdf)\xbc\x1d%\xcf#Ī\xc3m\x1dp#\x9b\xc83n\xf0\xa2c$\xd4\x03\xdbq\xc92\x1f\xed0\t\x8f\xd2T+\x8d\xd6 \x1bb\x94#\x8fP\fo\r\a\xfc\xe5ó\x82\x1fz\xa0V\xb7\xfa@~A\x83F\x93\xc1g&\x0e\x91\xbc\xf1\xc9\x12\xb3\x1b-\xa5\\*\xdb#j\xfct\xf9*\xfc\x18P\xf53\x88_\xe5i\x92\n\xf9'\xd7\u007f{{\t\xcd\xe7\x8f\u007fD\xa5\x86H6\xeaw?`\x84\x14\x98\xf0\x05\vX\xe8T@\x85\xbf\x96\xa7iS\xd3\x12k\x01\xd7\xd0\x1fr^\x85\xea͊\xadx\xb8\x13\xa5\xa3YjK\x0e\x8e\xe9\xe3\x9c?陣gMY\x80:\xe5\x8a\xc4ۑ?\xfc\xc1\x1d\xff\xb0\x83\x02\xd3\xe1*\xac\xe1Z\xc7\xc7\x19\xb1G\xc0~\xe5\b\x05\xba\xf8\xb0\xf6\xf8\x9a\xae\xffyC\xfcH&\xf9-\x96\x05\xcf,\xb9\x8e\xfc\xa5\x96\xb9\xa53/\xb1\xd8˕\xfa#z[\xf0W\xfa'\xabVzoIG$\xed\xd0]\x84w<\xbe\xde\xf2~\xa2T~\x8c\x0f$\xa5\x15ՍҴ\xb3h\xacz\x01\x10@M\xbbHjMe4/,\x83ZU3\xaaM\xa6i_,2\xd0s\xd7b\xa44\xad\xca\xfd\xb2Q\xc7,\xcd\x1b\x94i\x95\xa5w\x98%*\x13\xbd\xb3\x86m\xeb\x8cB\xcc\x10Bp5Ե\x99^¦\xe6\xcaѯ\xeb3\x8cW\x8a;n\u0602\xb2\x99\x87\nn\xc66g3\xa3\xa0y\xe5&\x9e3\x1d3\x8fh5\xad\x99\xf5\xa4f\xd6\xd4\x15+\xea\x83\x1f\xf2\x19\x98\xcb({\x8aN\x17\x98N\xbby\xd2e\xfd2o0]\xee\xf65\xa5\xce\xf16\x96\xab6\xb3~\xfb\x80̎5\x9f\xaa\x1d\x8b7`\xa1\x91\xb7\xa6\x90\xea\x8a3\x85X\xcbj*\xe1f\x94~\xafҜ\x19|E\x86\n\xf8R\xeb\xc7\x1d-\xfd^S3\xe7@\xb4\r\xc4~\t\xaa\t\xa2\x87l\xf3\xb0\xa7T\xd5D̖\x80ޞ\x95R\xf3\xc9F\xd4t\xbb\xa5p\x0ewD\xf3\xd5\xe6\x1d\xa1\xaa\xfa\xf6\xb2\x02\xf4\xb6|؞l4l\x9bu\x843\xff\x16\xb1h\xb9\x90\t\nT\x0e\xff\xed*\xb1\x1bD5\x90\xe7IWf\xfbg\x06JSj\xa4\x9dȩ\x8b\x92\xe0\xfbY\x8c;\xef\xf5\xf5\x14N\x8d\x04\x03OgܖO\x0e\\E\"\xec;\t\xfd\xd1[Y\xfd\x02ұ\xa2tb\x02VDtɪoΒ_\x94D\x1eF%\xf24j\x82\t\xdf\xc7\xdf\xc3ϥ\xfa\x99\x19\xff\xaeIn\x86\x80{}\x85\xf1\x80\xf6\xea\xf8\xa8\x18\x9a\xd8\xc5X\xa1\x19\xc8\xee\x8e_\xca)\xd1-\x85\xa3J\xec\aa\xa8\xdcc\x9e\x9b\xa1@9\xb4\xac\xa1\xf8\xa0\x16zt\x0e\x86\xd7\xe8s\xf8\xe74b\xa6\xb7\xc4rb+m\x8b\x06ډ\a\xad\xed\xd4Eҝ\xc3[E\x17\xb8g\xa8L\x16Z`\xeb!\xfbra]\x99I\x96\x81\"KV\x84\xbb\xf6\x14\xf0\x9e\x10\x02B[\x135\xf1ci\x19\xe6h\t\xa4˽\x11\xb7\xfb҈s\x97\xcb4\xf0\xc6ٵj\t\x0f\nMA<\x1bT\xa5\x1e\xd4b\x8d\x16\xb5'\xf0>Sp%\xbe2\x11\x1fK\xfb\b\xa6\x1f8_atP\x1ep\xc1\x9b\x01\xfe\xdf.}\xec\xd2W\x9b\xfd\x1f\xcb\x11\x8c\x8a6(\x97d\xcaB\xb5\xdb\xf1\xeb\xb55x\rZ\xe6 4>/o\xc0\xa5)\x9b\x94\xf0J'\xeb\x1b\x83$\xc6\xe3\x92iJu~\x1e홧\xa3\xecr\xf1\x10\xa3\x148\xa5ń\xa3t\xf8\x17Y\x1f\x8f\x19\n9y\xc5Lǀ\xfd\xf0;C\xd3\xe6\r\xcdXt$\b\xfe\x05!$Q\x17=\xab߉eQԐ\xb7\xb8\xd0v\bs\xb5s\x16ex\x027\x06s\xa1\xf3,Da#8\f\x06\xed\xb9F\xef\xf2\xe6\n\x1f\a\x19^\x82\x86\xb3\xc0Dq@\x9c\x9f\xaf\x80\xc1˷\xdd&E\xc2\xe7\x118\xfd\xb8\t:\xb8V}jW\xf5\x89f\xee\x8d\xefP\x1f_\x10;\xe8\xee:\xd8\xea\xf7\xbb\x1d\x1f\xd5]\aU\xbd\xa8\xe0\x88\xd1Tcao`\xd7p\xca\x1fs\xe3xu\x1aF\xc5\x14z{\xba8\x8d2'\x16\x13uVB\xc5\xc0J\x9a\xff\xc4\x00\xf7\xfcS*\xb9Cٍz\xf4\xcb>\x01\xa5\a\t\x00\f6\xb9\xac\xc0\x9d\x95\x81\xc8P\xc6\xdc\xc89\x97\x8d&>\xcd\xdf\x190\x99\x05\x86\x86#\x06\x960\xf9\xd8>\xe7\t\x9b\xd2w\x95\x94v\xc7\xf0\xaer\f\x05\xec./\xfdn\x15l\xb7t%l<\xbc\x06ם\v\xf9\xa0\xff)\xfc\xf3\xe2\x1c\x1a}\n\xff\xec\x00@\x91\xd8u\x03\xabX\xa4rn&\x86N\xb7\xa8\xc2<\\\xc1\x1a\xdf\xcc\x1a_\x13f&\xb3b\xf3\x8a\x84w\x81;\xc5n˕p\x1d\xa8\x16L>eQ\x80\xd1\xd4Pη\xcd,n\"\xef\xc8\xc9\xdc\xc5\xd3\f{*\xcb\xe6ߩ\xae?\xc1e\x9cӓ\xc1w\n\xf3CΝ\x9d n\x13ҥ\x04\x00n\xfaA\xfc\u03a2w\x8c\xdbW\xa0u.\xd1r\x05\x8e\\\x1c\x9f\xa8\xd5\tV\x93W\xaf\xfe^\xc76/\v\b\xb1\xd8UB\x9e>\xf10ц\xde\xd4\x1ch\x9d\xb9\xed\xee\x16\x13/҇\x04\xb4\xb3se\xc6\xee\x9d5'\xf9\xfb\x9a\xe1\x92=\u007fqe\xab\x80nOs(O\x84\xd63\x82\xdaˮ\xae,\xc1\xc5.\x10:ڱ~\x10\xb4\xe2\xa1+\xdf\xc3\xcb{V\x81\r\xeb\x1aG\x81\xb1sTJq\xa7\xc8+\x83m\xf7D\xb5\xb8\x17.\xde\t\x14o_\xad\x11\xf8\f\xafJy\x87\u007f~\xf3\x99-Yz%\xf5:\x81z\xed\xab\x92\xc3\xd6zOT\x8a\xc1\xb4\xd4:\xb0\x8dZ\x8cN\xec\xea\x98&\xf0\xedfW\x16\xeckmq\x19\x11\x9bQ\xff\xacz\x0fGw\xb4\xe1\xdc\xfc\xd0\xee\xa6\xfd@\xac\x9a\xe0\xe7Q\x8fӱ\xfcn:\xec\x8eb\x1a\x89\x1e\xc9\xf9\x1e}\xc5\xf6\x02\x02CQǂ;X\x02\b\xda\xe7\x0f\xee(\xbfC\xf9\x1d;?\xe0h\xe5)\\\xb4\x87'ӷ\xcekhkX\x9c#\xcdb\x8eVXg`Ɠ\xf9l\xf5\x03\x00:\r\x8fK\x06\xa5\x87\u07b6\x935\x82\x16AktY\x1e\r\xc22\x02\x16\vx%3t\x1c\xa7\xf6\xfa\x03\x81\xc0/\xf1\xc1\a\xfe\xd4<\x01H\x9a\xa2\xeek\x82\xaag\x02\x89\x06k\xbf\x05\xc0}\x93\x14\xc7R\x18};.\xb2$\x857Ө]\xb9\x17D\x90]\xc3U\xfa%U\f5ح\x12Vy\x96N\v|\x88\x85!n\tDeDd\vZ#\x10\xac\xae\xbc[e8~\x18\x86\x1c?<\xc5\x0f]\x03\xb5\xa6\xe3\x83\xfbN\x19\x9b\x0f\x99V\x9c\xab\x10\xd6\xfaq\x97O\xf3q\x9eUm\xc9\xd8#\xe6\x82\ufdec3$O\x87VˠR\x9a\x05Ed[o\x05\x1eE:D;\xb3\x02\xc6\xee;:\xf1\xe8\n1\x15\x8b.\xed\xcfpo\x06\xfb4\xcb̑\xb6bi0\x10\xbb\xf6\xb4\xd5\x03\x95\xd1zbR\x80\x8a\x9b\xb6\xd2I\x8b\x85\xfa*\xba*qXj_0\x15\x87\xa1\x8aӐ\rAc\xc31@%\xbb\x00\x8bF\xd1i-\xf1\xf9qzxfk\xeci\xab/\xa6'\xe0\x0fz\xaf\xa3D\x8bFE\xdd.ֵ\xabvݪ\xddr\xf7e\x1c\x8b\x85\x87Œc\x11\xa3\x1e\ued23\xb0X\xb8X,\xbbn\xd5n(6\xf5]\x87b\x90\xe0V\x1b\xac\fw]J\xc0=w\xc4\xf6\u007f\xc4m\x97ik\x9a^\v\xa6h\rI庒\xfaR\a\xaa6ʋOˋ;\xd7XU]\x9b\x9be\xaa\xe5\xf4\x01\x8fT\x17\xde&\x16\xdc\xf6{\xbb`\a3\x1dM\xcf\xcc1\xeb\xf0Q\x1f\x88\xb1\xbd\xed\x1dm\xad\xfa\xa0\xae\xd2z\xdagO\x00\\#\xb0j\xa8js\xbbj{\x1b>ݵh\xa0A<\xc9\xd0Z\xaa\x01\xc9\xf6\xa5b%\x93_\b&\xd9\xed\xb4]\xa4\x1f\x1a\xae\xddJ\t\x86|\x82\xd1\xedL\xd4\xf0\xe1iT\x9b\xca#\xa4\xa9\xf8=\xac\xb9\xf2+I\x87\xf1|\x1c/W\t\xb04\xb9N\xb7\x92^\xe0q&\xc3\xfe\x14L\xb9\xdfF\x9a!\n\xa5,\xad\xf8\xc7\xe3a\x87o\x11\x8a\xcfP\x90\xec\xb1\xeb\x9d͘Mɿ\x8ck\x19\xaf\x02\x84\x8fȨ\b\xfeC\xd9S\xc7`\x87\xbd\x15\xf4\x90\xdd\xe5\xc3\x06\xcc%R\xdao\x8eğ\x9d\x1dވ\x1eMeɽkbU\xa1֨\x1f\xe0\xf9\xf3*\xba*9{\xd7~j$\x1coI\xfdm\x1e'2\xf6=\x13(\xb0ut\xad\x8c\xde)q6.R\b\xfb\x8dѭ\xc2e\xa5I\x0e\xe36OZ\v\xbc-\xa7\xbe\xc0\x8cO\xe8\xf1\xf5\x11B\xb3\xc6\x03\xf7\xb6\x03\xf0Ƙ\x9et\xd8&\x98;\xffƲvCq\x87\xa1Աٝ\x88\xa5.1u\t'˭v\xc7S\xf8\xb0W\xaf \xb4h\xb8k+\x83\x05KD\xbd\xab\xcb\x01\xe8w\x95\xd07E\xa6ʌd\x90\x9a\xaca\xfcY\xbb\xdd`\x00\u0095\xb5m\x9d\t]Z\xa1\x19mѥ\x1d+\x04\x96\x81D\x8c\x13\xb4ԉꅈ\x18RP\x9b\x8a\x05{\xca\"\x06\x14\xd3ddֺ\xccx\x8e\xb7\av\xa8\xaa\xf6Ώ<\xe6\x06g\xc4 \xc2-\n~\x98]\x01\xa7\xd6٧9\x97Q3\xe6{\x91U\xb7\x0f\xb8\xe5K\x16R]ԛ!RF,\xa3\xa8z\xd1B\x9a\xd6R\x16$\xdd\xc9<n\xa9\x98^\x9e5\xc7\xde\tJ\xe317k\xe1\xdc\x02\x9dSN?m\xf8]4\x1a\xe6\xd0AV\xc4\xe8\x91Nw\xf1\xe6c\xc3\x00s\xb7\xf0b6\x1d\xd8\xd3\xde+\x01\x9a枥iB\x8f\x91>\x96\x0e\x89\xae>;՚!\x16\xf2#]\x13\xb0\xae\xffpi\xd53\xba\t7w\xba[F`\xb8\xe7`\xbc\xa7\xb6ŧ_\x02^*\x81\x93\xd8%\x95X\x86`\xbc@\x0eG\x1cK`\xbc@v_v]\x18\x0f\x968$O\xd2]pWܑǸK\xf8\xe2T\x94G\x84/\"8\x13\\\x12\x8d\xc5DH,\xb6\xc4Y\xe7\x1e\xfa\x19\xb6\x92\x01AÜ/\x17\x18\x88\xba\x19'\x96\ri\xc2\xf8\x80\x98\x18JU\xf01\x03\x05\x97c\xad\xab\fl-p\xf1\xf8\x19\xca\xd6\a\x14\x0e\xb4\x1a\xb6dl\xa51(\xac\xedU\x12R\xf9\x029\xcbF\xa9\x1b\xf0M\xa6})4\xa8\xa6\xfa~\x9b\xc4ˋ娗\xcb\xf0\x05\xb5\x8b\xf9X$AP\xb9\u05f9\xfa\xf5f\x9e\x16\xf2\xe7\xdf\xd3d\xac?\xde\xdç\xea\xf7\xd7\xd3L\xfe\xba\x80\xbb\xd5\xf0[\x1dHRC\xf3\x19\\\x92\xc8g\xb9\f\xb2H\x94\xb9Ng\x80\xcfiT\xae\"½\x99\xd6[\xb1\xe4\xcf\xfe\xf6\xe6\u0557\xccm\xb9\xbc~\x88 \x96\x1b@X\xfa\x00\xbe\x16\xabݯi<]\x1b\x8a\xaa\xe0\x83\xfa&\x17DY\x1b\x0e\x96\xf6\x81\xbcΆìH\xfbb\bև\xc5+\x85@\x8e\xe7x#lmhX>\x00(\x1f\xcfn\xd6\a\x03\xa5} \x17\x1b\xf6\xed\xa2\xac[o\x04o\xad\a\x05J\x86\xeb\xbf\xcf\xc7\xe9\x0f\x83A\x91Ϊ \xb5\xad\xaa\x18_\xf1\x87\xc1\x1aM˒N\xd3E\x05\xe3\xab)\xa3\xa7K\xab\xd0\xcc\xcf.\x93\xb5\xde\xf2\xebd>\xf4\xe5\x86\xc0\x97kî\x9c.e\r\xa8Jk\xb7R>\x93ʚ\xc0\x1ak\xc3_9\xc9ʚ\xe1\x157h\xad|\xfe\x957\x84u\xd6o\xa3tj\x96\xb6\x005ֆ\u007f\xb1\x05\xb1.6\xa4S\xe9\x84\x0e6\x00\xa5W\x81v\x8c 6\x10\xb9 \x9a\xa5\xc9-)\xd6\xddQ\x8cs\xf5\r\xad\x9f\xb5O\xe3\xe8\xd3^\xf4i\x1a}\xfa\xcd駯O?\xbd\x88>\xfd\xb5\xd6\fT\xc0£g\x9f&\xcf>]z\x0544\t\xa4\xe66,\x96Ђ4\x18g\xa1\xb6\x16\uf5fd\xde4\xbd\xcb F\xed\xd8)\xae\x95a֪.\x9e6\f\x98\x110\x81\\\xff\xffW<\x9e\xc7SZ\xd2\xd3\xdeT\xfd~\r\xb1j\xe1\xc7\xcb\xc94\x1bR\nf\xfc\xaf\xf98\xa5\xbfC\xfc~9\xbf\x9e\x173\xd4\x03\xd2\xc9,Ũ\xc8\xe2\xe3\x87\xfe,\x97?\xbf\xcf\xeft\xf2\x97i\x9f~˫P\x06\x9d\xb2~\x11\xae\xd5=c:P\x8b2\xb9V&\x90\x9a\x80\x15\xc93\nP\xd1z\xc2\xf2\xe46\x0foX\xab\xcbLr\xb3\"-\"\xfdf4X\xe3\x10\n\xee\xa2\xcav[\xfd\x9bx\xfaJ\xe8\xa1/g\xf5\f#S\xef\x1d9~\xaa\xb0=\xb2\x9b\xe8Z\xe0u\t\xd3\xeb\xef\xc0\xfeԌ\xea\x03F\x16½\xb8\x84#\r\xab!\xd1\b\x1c\x86_5\xa2ϣ\x81\xec۩\x15ځ\x8e\xa720\xc6\x04\xb6ן\xac\x8f\x92\xe7ѕ\xea(\xf7\xb8\xee\xc6o\xccQu\xae1\x88\xbe\x80\x0f\xfc²\xed\x10<\xff^?۔\x9dB|\x98\xb6\xb9\xa74:\x8d\xd8W\x02\x81m\xf5\xd77V\xdek\xeb\xeb\xc2\xfa\xfaN|\xe9'/Q\xc6Ȏ8\x82\xedVu\xa4){m\\ب\xab]O\xce\x15A\x9c\xbb\xbf\xdc\xd2\x02Ek\x93\x9a\xd8{DI#JZ\xdf@[\xe2\xdfO\xa3\x0e\\\xcbIZ\x10e\xa3ӵ\xc2\xf6\x90\xa0\x19\xa7\xf7\n+\x95\x9b\xe0\x18\x99պ\x9e\xb4\x96Mxn\x04\xff\x98\xc38U\x8cTQ\xd1\x1ad\xbf\x86\u007f.\xe0\x9f\xef\xdcaM\xb4\xcf\xe8\ak\xbcf\xf9\x85\x9a'\x15:\x91\xa4S\xe8\xf8\x8c\xe0\x9c\x85\xc4\xf5\xfb\x81Xr)l\xa2\x9c\xd1\xf5Z\xbb\x9bԘ\x10\xc3\"{N\x91=\xbfȾSd\xdf*R\x04\x1a\x82v\x02\xc2\xf9\xa7ԛ\u007f?\xa5u.\u007f\x1b\x01I\xbd\xb2\x96%\xf8\\!]]\x9bd\xa3[\xe7\xbb<\xbf\x9dO\xbcz\x94\xbc\xa2\xee:8\xfb\xe2:\fe\x03<lh\xe1\x15\xd9x5\x89O\x83o\xa8M,\xf6 i/\x93\x16\xed\x1c\xeb\x8d+K\ay\xb9.\xb8r\x10\xbd\xb5@\xf8]%\x80r\xcbd\x83\xfcb}\x90U`\xfa\xa7\xce\xcaX\x0f\xeb9\xf2\xaeg\xb2V\xab4;\xeb\x92\x1a<\x80\x19AIׂR\xac\x80\xf2\xcdƸ\xc8͵\r\xe6\xdbm\xc1\x90\x10\xfe\xfdw\xf1\xaf\r\xf1\xdd\xfa\x10\xf70Ⱥ\x1a\x01\xc1C?\fH2;H~\xb7\tH\x1amk\xf3oC\x1bm\xdce\xc9;\xa0\x13ؠ^o\x0eJ\xd9\x11l@\x93j@\x9c\xee/\u0381\xf0\x9fG\xb5\x1f_ׄ\xeeR{\xf9\xbaf\x81\xba\xd8\x18\xa7\x8b0\x9d\xfe\xb6\x11 9\x88\x05\x1a\xccJ\xc6\xf1~u/}{\xd4߷Ac\x94W\xa0\xb1Xc\xd6\xcb\x19\xffˊ\xa2L8,7\xa6\xbb\xb1\x9a\xc1dj\xb7m,\u007f]\x1f\xde~\b^\xbao\xc3\xfbǩ\xa9\x94\x8f\xa5Ӂڧ\xb5\xcafD~ն\x11\xf4\xbd\xa9\xb5\xf4XY\u007fO\xd3[\xbd\xd0\xe8\xe5$TD/\x14V\xe6k\xb3$h\xb1\xef\x17`\xa2\x9ce}\x97C\x18\x11 \x89\x96\xddV\xfe\x97\xaaѴ,\xe3\x1b'\x03&`w_\x8b\xcdҼ\xef\\$\x99,\xd2\x12\xc8\xef\xc6\xf7s\xd8\xfai\xb9\xe2\x80@\xa9\xa1e\x85\x95\xf9r\xf4\xe3HO}+\xe7\x827\xba\b\x12\b\xcd߆ս|`p\xcd\xdfV>p\x9a\xe6T+G\xf1a\x88e\xc6\xd8KT\xa1\x9e\xfd\xf3\u007f\x17O\xffw\xb2\xf3\xcc-\x13\x8b\x0e1\xf5\bw\xb7\x92\xc1\xcc\x0ef\"~v>Q\xe7\xe3\xf6$m\xcdg\xfd\xed\xf7\xb8\xe0\x91\x84=\xf6\xd2'\x05\xcct\u007ff\x1d\xe2Pk\x81-G\x04Y\xad\xb7P\x97E\x96\x89L\x04RA\xe1\xba(\xc2b\x01\x0e\xb2q<\x1c\x86\x11\xf8\x92\x01y\xf0\xe2\xe3\nXD..\xadt\xcf7\xdbKnL\x02\xb9\xedB,\b\xbc\x82\xe9\xf5\x1a\x8b\xc2C7\xd8h\xbdݺ\xe7e\x9b-BA%\xac\xbb\xa3\xa2\xaco\x8b\xdc#!\xf0R\xbd\xf6鯻\x9f\x8ev?M\xde(\xfbT\xeb\xd3\xef\xfeQ\v\xb1^\x860l#\x9a@\xe7ۋ\x1f$\x8a\x9f\xfb\x8d~/\xf4ݻ4:\xf5s\xf8\x91\x91S|\xd5(:\xbba\xd4!\xad1Q\x0f\xad\xf1\x18\x99\xa6\xc0\xe7\xb8\xfd\x06D$\xc1\x1f\xaa\x10`T\xf7\x8aX#\xa0\x95\x03\x94G\xac8>9\xbe\x13\x93\xc0,Al**\x8b\x80\x99Xt%d0\xcc\xf3)\x96\xa4\x80\xbcp\xab\x00\xfe \xc2\xec\x12T\x82\x16\x88\x1c\x8f2\x14L\xb5\xbd\xc7\xe3\x0f\xfc\xd0g!\xean\t\x81W\xd5\xcaA;h*`\xd6ٌ-\x9d\xe4\"\xc0x\x8cRZ\xd3x|\x9d\x06JJA\xe6\x94\x06\xa1\xe2\xd5\x18\xe1\x12\xf1\xb8\xb4=\x14:\x84 \xc0\xa1T%>\x02m\xcb@\x97\xd0\xd6:\x85\xb3iK\xfd紥\x942J\xb9\xb4\x95\xa5\x83\xb4\xbd\xa1'\xdd\xebQ\x16\xe6\xddL\x1e\xa3I\xc9\xdf\xf2N\xd60\x96\xb4\nj\x17\x18\x8b\xc0`\xec\x1d\xa6\a\x10\xf3M\xc2\xc1\xf8u\xfa\xf7S\xcc\xfeX\xa3T\n\xbbd\x98\xd81\xae=H@H>D\xf0\x1d&\xb7;<X288I\xbc\xdchl\xa8<'v\xe7\xe4\xa8m,\x94\"\xdf6\x13\xcaN\x19\xed\xbai\xf8\x916\x86&\xc1کk\xaa,7\x19\x17\x04a\xc3\x13\xe3\"\xcbn0\b\xb2\xea.ٱ\xedqЇ)\xfa3HWw\x10\xa0\\\xd9\x18\xd0^\xcbr\xe0\xe7\x10~I\xf9\xaa\xceR\x11צ\x16c@\xe2\xfc]\xaa\xb8\x1b\xd5K\xa7\x12\x15\te)\x19#\xe6\xcf\xf1\xe1~j\x85\x8fu\x0f\x92\xfc[\xa8\xb011\x97[%\xa3\t\x1a\xcc\xf2\xef \xc6\xf9\xab\xb8\xd0G\xe8`\x06?\x12\x88d杸bE\xd3\xe7KQ\xf9jmf\x8d\xa8\xc76\xfd\xa9D\xa3\x8cQ\x18\x95p'-8'\x83\xdd\xe0Q\x85\xd9z\x05_\xae\xe2̠\xc48j\x84\x81;\xc7\x15r\xea\xba\f\xe1\x18\x02¼\xe1[\x8d\x10\xfe\x0eP`i\xfa-F\xfeH\xd2e\x89\xefN\xb3\x86\xfbBD\x8f\x8d\xa8U+jW\xf8\xe4\x88Fưz\xa8\xa0\x9c\x1e\xba\xb057\xbc\n\x84&\x82\xffw\xa0\t;s\xb0\x85ýؒs\xa5\x03-:n\x81\xc2+\xe1K\x05,\xe7\xaa0T6(E\xa0\xbc\x16#!\x83\x92\xb5\x8a\x83\xe8]{.\x95M\xa43_\xf4v\\\xe9-5\xe15\xc57-\t\xce\x02\xb1\x95\x00gw\x85\x1cUG\x1dP[\t\x01E\x87\x0e\x87\x1d=\a\xcb\x06\xe9\xaf\x05\xf4\xe3Q\x94\xfa\x00\x8e:>\x8c\xaa\x15\xab\xf1V\xb4\xb5/\xac\xd9\xe4\x052\x14\xce\x1c\f\x13˥-\x96\xb4H\xcb\xf7\x98\x18\xd7\xf6b\x96N\xe8n\x81\xd8V4\xa3\x03\xf8\xa7\x83\xff\xee\xa5\xfbMX\xab\xe0\xd7A3:\x81\u007f:\xc7\xf0/\xa8_\xe2w\x1b?\xba\x1d\xfc\xda\xdf\xeb\xc2\x1f\\\xd4D\xdeQ\x173\x0f\xdb\xfbT\xe8छ\x1e6\xa3\xa3\xa3\xa3C\xf8\xbb\xd79\x10@\x0e\xe9\n\xa2\x87\x13Zz^\xa7\xb3\x9b<!\xd4.\x9d\x1d\x87h\x80\xfc\xec\xbb\xc9\a\xe1\xe4NI\xfa^\xdbN'\x9dۃ\xae\x92\x0fJJ\x97\xa4\xbb\xd0Ae\xf4`S\xe2^(\xf10X\xbdk\xa7\xa2J\xd0\t\xa49\xe5@\x96\xf9\x1dCseI\xaa\x83\x13\xf0\x12\x16\xad\x1a\xb4\xaf\xf5\xd9\x1f\x1f4uR\xfb\xe9\xafp\x96\xeb[w\xf5S\x12\xf2p\xf0`7\xack\u007fQY;qET\t\x94^\xf4i\xb2\x1a\x90\xd4&\x9eHgSe\xc0\xe25\x81\x81\xe6\x03&\xa6\r@\u007f\x1b}:Y\r\x9a\xefl\xca\x00\x9d~\xfaz\r\xcaY;\xd90(\xb8\x06\xb5\x12\x90mn\b\x03j}\xfa\xdd:\x18\xb9\xf7e\x1fʙ/\x1b\xebU\x1a\x13ZCL\xa9\xf3\xbb\x03\x0e\x97\xb2\x95\x05\xb3\xbe\xb6\xcfS<\xa6np-ݓSj\xb12/\xd1\x16\xb3t<k\x9a\xf7\xe3\xeey\xaa\xc1\xbb\x95\xe4\xa38SU\xac\xcbSX\xea\xaf\xe9\f\xd6\x06\xa1eϲ\xfemQ\x1f5\xfc2\x17\xb2\x8c{\xcb\xdc\x0f\x82^\x85O}-\xfa\xc9.\x97\x93\xb6\x11\xb4ib)\xb8\u0528%{95\xadg\x89.\t/\xa3\x11\xbc/\x11\v\x9b \xaf\x0e\x80\xfe\xd0(k\xb0J*)s\xea\xf6\x92IBXC:\xd9\xf7\xad\xab\x80\xad'\xa4\xf4\x85\xfbja\"a\xc6\xeb\xc3\xe4\xe2j\xb3F֓Z\xf6\xcd\xfaJxk\t/\xf7>|\x05ĵd\x98{\xa3\xbd\x02\xdeZ\xa2,x\xfb\xbf\\\x9ai\x96]GD\x19\xfe\xf6\x05\x94\x99l\x1fS<\x89V֑PH\xd4*!垒}\x90\xa02]o\x96\x10Wa\xf1\xd0\x10\xe3\xf1_\xff\xf9\xdf\xff\x01\xffK\xa7\xd3\\l\x82nf\xa3\xe1Gk\xa3\xddi\xc3\xff\xfe\xab\xdd\x0e\xfem\x1fu:\xff\xd5\xe9\xee\x1d\x1et\xf7\xba\x9d\xbd\x8e(\xdf\xd9\xef\x1e\xfeW\xd4\xfe#\b0\a\x0f\xae\x02\x95\x0f\xee\xa4ӹ\xff!\xff\xfb\u05ff\x9e=\x15\x93\x1a\x05\x15\xbc\x83\xe8\xc5E\x8a\xdc\xf0\t\xbc\x17\xec\x0f\xe7Iz*\xc4\xc90\x8f\x13J~\xfa\xec\xe1\xe1\x93\u007f\xfd+Jҁ\x10#Qm\x96͆i-zx\xf8\n9)\xeaͳa\x02'\x8a\xa2L\xebG\n\x15\xd8\xfa>\x1e\xa5\xa2\x88H\x12\xad\x88\x1f\x9f<O\xb2\xbb\xa8?\x8c\x8b\xe2\xbcv\x93\xc6I:\x8d\x90\x13k/D\xbb\xcfo:/\xa0\xf6\xab|>\x9e\x89ҔU\r\xfa\xf93Q\xe9\x93\xe7\xcf\x04\xdc\x17\x80\x1enߣ\x96D\xea\x01N\x9c\x03m\xf6\xf3$\x8dF\x10\x15\x01\x1b\x86\xa6\xf7\xa0\xe9\xfblv\x13\xb5@\xbd\xc2;\x06\x0f\x0f/gب\xf8u\x1a\xe9^@\n6@\xed\xef\x11\b]\x1dn\xc4S\xcb\by2M_<\x87\x06_HHϟ\xe1\xd7\xf3g\x90\xa3\xaaJ\xfa\x88\xf2\xba+2I\xfcRW\x01\xa2\x1a\x1b\x91\x1a\x02\xfbd\xdb\xf9\xbf\xc8fi\xf2\xe7\xce\xff\x83\xfd=w\xfe\xef\x1d\x1d\xfdg\xfe\xff\x0f\x9b\xff\xc8I\x1bLq\xaa@\x93\x05'\"\xce'\x91('\x0eMi='\xe5T\xfb=\x9a\xe5o\x01\x13=\xe9\xccL\xa1y\xf7\xc3|\x06\x0eҪ\xe6<\x9b\xedT\xdaL\xdf\xd5\xf3\xf4\x0f\x9a\x99\u007f\xcc\xffF\xf98\x9b\xe5ӏ*\x00V\xcc\xff\xeeѡ\xb7\xfe\x1fv\xda\xff\x99\xff\u007f\xee\xfc/\x9d\xe8\x17\xb3x6/\xd8D\x17\xfb\x89[\x90\x11\xe7\xb5b\xb6\x1c\xa6\xc5M\x9a\xcej\x18\xd0\xe3\xbc6\x13\x90\x9f\xf5\x8b\xa2\x16\xddL\xd3\xc1yMT\x13\x931\x9d\x89\x89\x02۞\x9b\xf8\x1e\x8c\xbf-,\xf1\xf0 \xe6\xe5s\x84\xe1ֆy\x87\xaf\xbffr\x873\x8a\xa7\xd7\xd9\xf84\xea\xb6'\x8b\xa8M\u007f\x8e>\x957\xca\xc4\f\x9c\x9db\x8cY\xe5p\xa0\xc8(\x86\x8f\xc0\x12/>Q\xfa}\x96\xc0\xeb\xd2\xfd\xb6\xacx\x93\x82ϐ\xd3h\xaf-\xc0\xa9\xdbi\xe3\xd9\xee \x1ee\xc3\xe5i\x14\xbd\x9cf\xf1\xb0\x19}\x93\x0e\xefR\xb1m\x03\x1f\xdf\xf1\xb8\xd8-\xd2i6`\xe5\x8b콐\x9a\x9d.\x01y0\xb8\xdf\xec9\xe8\xefJ\xfc\xdbQ\xe7\xc0j\xb2\x14D+^d\x85\x84b\xfa\x15\xf7\x8a|8W\xe7\xc1\xefw\xa5;k\x98x\x944\xcb'\xa7\xea\xc17y<џ\x9a\n\xbc\xad!l~\xa5\v\xd8!>\x8c\x16\xb5n\\\\\xfa\xf1\xf8.^\x85\ro\x1a\xc6D~\b\xc6y\x86c\xfd\"\xb4T\xdc\xc7\xd31\x04\x81Q\x8b\xc5_\xf3q\x92\x0f\xe3H\x90\xfa.\x85\a\x8c\xc0\x83\x96ҷZ\xbd{~\xd3}\xf1:\x1d\xe5ӥ\xa8\xd8}\xe1,\x0fإ\x1a\x1c/Ż\x93a>;\xaf\x8d\xd2Q\xeb\xe5p\x98\xf7\xd9r\xf1j>\x9d\x8a-\xfdp\x19Ő\x13\xc3\n\xd6[\xce\xd2B\xad \x12\x9bu`\u007f\x93\xc6\x13\n\xeaT\xb0\x16\xbe\x83{\x849%o\x01\xf4bɁ}\x01\xa8E}\x8d\xf4,\xbeM\xc7\xd1`\x9a\x8f\xa2bY\x88Uk\x8b\x16^cϋ&\xfc\xfe\x1a\"'\xb1\xf6d^\x14\vрy\xd5\xf0\x81\xb3j/\xd4\x00\xca?\xc5H\x00y\xf1}\x0e\xbe\x04\xde\xdc\bf\x9f\xc4B\x95\x17\u007f\xf31\x90\xfd.ΆqO\x88\bxR\x98\xf6\xe6\xd7\xd1H\fsK0\x13\xd6\x13\xf5\xfb\xd3l2\xe3\x12䝨D\xa9\xb5\xa8\x98\xf6\xb9\x14\x1a\xcd\x17\xadwR\xf6\b\x10XhS\x18\xc9^\xeb\xae\xfb\xc1P,y\xf8\xa1\xc0Բ\xee\xc3)Y\xff\xdf\x15\xff\xf5g\xe9\xff\xfb\a{]w\xfd\xef\x1e\xfcg\xff\xff\x87\xfc\x0f,\xa9\xaf~\xf8\xdb\xf7o\xa2\xf3\bֽ\xb3\xe8\xd93\xb1\x16\xb5#u\xd15\x1f\xe0\xfc\xff\x04\n~\xfb\xfd\x9b\xaf~\xfa\xf9\xe5w\x10\x83\x00W\x96OX\x94\xb2xrs\x91B\xf0\xb9z:L\xc1]\x81c\x8c\x94\xa9`\xdc}9\x9bM\xb3\x9eX \xea\x9fi\xd9\xf2Y\xa3UL\x86٬\xfeY\xf3\xb3\xc6\xd9'\x0f\f6x\x8e\x12\xeb\xaeX\x11\xff\n\xad\x14u\xcb=#\x81ų\x91\xbc?WM|%\x93\xbfX\xbe\x02Y\x03V\x82\xfag(\xcf>k躈4wΈ\xee\xbb\xe2a:\xd3w\xae\u007fR\xb3\xf2U>\x14\xd3\xe4Gʬs\xff\xf9xQL\xb9k\x87\x87\xf2\n%\x1d\x1a8\xcblO\x82\x847x\x1e\x94%/3\xee\xe7\n\xb2q\x95g]\xa2\xa8\u007f\xb2W\x82l\xd9\xddg\xec^>\x94\x86;\x16\x8c\xb0(b?kF\x9fA\x1e/\x9b\x0e\xc1\xbd\x84\xd0\xdd\xc8'\x1cd7\xec\xb6媾v\xebT\xbe\xac}ʭ\xc0\x80\n88\x14\xa9\fjx\xe9\x10\x06\x03\x1dZ\xcc\xd6p\xa24\xa3wG\x19\xcd\xe0\x1d\xb8r4\x03\xf1\xceq\xe9H\x1e:0\xd8\"\x85\f\x19\xc7ૣ\xb8|\xf7\ue287\x9c\xb4=~]\xbb\xbc\x81lY\xb7\xc1ʡ=\x95\xd4iZ\x99R\xe5\x12\x84\xa0{/\u007f\x87o\xbb\x88\xd2Eu\x99o0\xc1.$Vu\xa1\xe7\xa4B;\xfb\f<\xb3\u007ff\xe7\x16\xb3i~+\xba\x03gq\xcd@\x9fO\xed^\x10A[_g\x8b4\xf9r>\x8d\xe9ABJ\xf1$\xe5\xachz\xee\xb5#\xbc\xc2\xfc\xad\xbcht\xaaeD\xd3+7\x8a\x17_\x8aَ\xbe\xebD\xdb(u\x9aAh_\x88Mȩy\xf2\xd0`כ\x9f\xa1\xe4\xb1\x1dt7\x82aB%ƭ>L\\\xee\x1e\xfd\xba\xa5\x86\\\xcft\xf9\xcb津\x9c\x83\xfeP\xb7^\xc2|\xfb\xd5\x19q\xe4\xca\xd3\xe8\xda\xee\x13\x9c\xee\xd0\xe9ɩ\x81\"hLa\xa3\xe9Ř<\x1e\xfb\xef\xd7_8\x04\xd1L\x04\xa8\x04{\x89H\xb6\x88\x0f\xacN\xa2h\x03\xb9\x02\xf3\x85{\xaa\x10\xac\xf4\xad{-,\xaa[^\xaf\x05\xb4\x9f\xd2\xdf\xe6i!\xe6\xfa\xb3\xb7פz\xbf\x95\xaa\xc2\xdbx\x92\x89y\r\xafL\xd8!\xde4\xfd͝X\xf2\xf5\n\xc6.\x81w.\xff\xab\xa0r\x02\xdbb\x92\x8f\x8b\xf4\x8dPb\x1c\x1f\x9ba\x81J\x9d)\x15\xa7\xd6܄\xa2JD8r\xd5+\xf7%a\xf7\xaf\x87p\x19-\x83\x02\x8b\x1b\x82n\xf8\xf5J$P\xca\x02\xba\x86\xc5\x10o\x18\xeenR\x15\x10Dg\xc1r\xba\x03\x97\xb78\xc4\xe9\xecK\xb1\xadK\x93\xffN\xf1\x16\x9fؚ\xde\x06\xd0{\xf0R\xc4*/6kik\x98_\xd75\xcc@M\xccS3'N\x12(\xb6F\x05\x9f3\x1d\xef\xa8b\x85x'\x18\xe33s\xdbXI\x10G\v\b\xf6\x90)\x02\xb7)^\x88\xbfUjD\xeb\xb3\xcaE\x1a\x8a\x97r\x94d\xdb\x04\xc9+\n\xc2`_\x05\x02\xab\xa0f$\x90\xf45\x94\xb3O\xfeg\xe8\u007f\xb4\xff\xf9\xb8m\xac\xd2\xff\xbbݶ\xa3\xff\xb7\xf7\x8e\xba\xff\xd1\xff\xff(\xfd\xff\xaf?|\xff\xe5\x0f\u07fd|\xfb\xe5W?\xbf\xbd\x10S\ufadf\xde^\xbcy\xf9\xe6o\x17b\n0\xf9\x9f\xa4wo\xc9\xfc\xf2\x96\xcc/\x9f\x89\r\x00\xd4\xff\xe5\xf5w\xdf\xccf\x93\xaf\xe3\xbeX\x1e\xa4\xd6f?҅\xe5\x85=\x91\x92\x15\xd4\x1aӐ\xcf\xdbˊ\xbf\xec\x83\xc9\xee\x172\x95\xd4k\xaf\x8b\xc5h\xd8m\x01\x907o~\xacmS{o\xeb\xdaY\u007f\x9a\x17\xf9\xe0\xffc\xef\xdf\xfb۶\x91\xc5q\xf8\xfc\x9dW\xc1\xb0][\x8c(Y\x92-ۑ\xc2\xe4\x9bK/9\xa7M\xf24\xe9n\xfbq\xbd\xf9P\x12e\xb3\x91E\xad(\xc5V%\xedk\u007f\xe6\x02\x80\x00HJ\xb2\x9b^v\u007f\xed\x9e\x13\x8b\xb8\f\x80\xc1`03\x18\ff\x1a\x80{\xe7\xba\"Ģ\xb2\xc8\x15\xd5t6\x05\xad_\xc2؍\x80\xd4\xc5\x1c\xcaFk)\xb72\xaf\xfa\xd2EqՈ\r\x049Y\xa5P\xb2퇳\xfe\xa5S\x89\xec\x8d\xc9~IK\xafӃ\xc1~(\xe0\x8b\xa2}\x93\x81\xebb\xc5|:\xa2\x1bW\xc0\xc4\xfb\xe1h\xd4\v\xfb\x06/\a)\x01ߩ)Be\x16\xf4\xf8\xbe%s\xe8\xf1˳[\xd5W\xe4\xd2\"\x989\xc5\xdcx\xfd\xf6\x1dE\xdd\xf8\xea\x8bw\xae\xf4h\xffW=\x01\x85\xa4\u0085}\x87\xba\x87b\xb3\xd6\x1c\xd67\x9b\xfb\x17\xaa<bD_\x93ű\xb2\xff\x1c\xb0\x05\xe2A\r\xcd4\xa0\xc9b \xbf\x98O\x91\x0fnj\xd7\xd7\xd75\x14\xf5j\x00?\x1a\xa3ur \xb4\xd1u֏1\x8cz\xb0\xc0\x15\x16\x81\xbe\x8a\xa7\u0601S,\xa7Q\x94k\x92\xa7\xa0\x02Z\xe5\xf1\x9dh\xe7(7\x81\x02\xc1$\xa3ٳ\xb8\xbeW\x02\xe9\xbe\x05\x89\x87;\x1e0\x1e$\x96\xf5\xf9̈́<\xb4N\xe9\xcfr\xfe\xef\xdb\u05ef\xd0;\x0e\xff\xf2\x9d\xe7\x82\xe0\xfdY&\xd7/ \xab\be\xd6\xfd\xca>ޛ\x84\"\xf0g߳\x8d\x05\x80q>\xa0\xa8\u0604\xa5\xd3_)˳\x05[ǖl\x99<\xf1\x8cώY\x9e\xa1\x91\xd9#MF\xa3|:\xb0!o\xe3s\x83\xb25z\x1dog\x19Z\x9b\xeft\x92\x9fp\v_\x93d4\xfa\x8e\xc6c\xb0)\xf4\xb5\x98\x897\x8f#\xf1\xa4P\x86ZM\xecO'6I\u07b7\x13\xf1\xbf\x83\x03\xe7i\x9aί\"gv\x19!$\xe72L%x2\x17\xa71\xbe\x87\x8e\xb9S\x9e$\xbb>(c\xbd\b\xdd?`\t]\x87SX<\xce,\x91\xe0|\xfa\x01\xebe0\x8a\xa6\xc4P1z\xa6\rb\x12\xce.\x9dA\x02[\xd48\x999\x11\xa8N\xb3\xbaQf$\\>\xea<\xc5\x15\x8d\a\x94\xce\x11\xa1\n&\b\x87]\xa7\x8f\xae\xa5\x86\xf3 E\t\x03\xa5\xf9G\"\x8a\xd43c\xd5\xef0\v\xc6LP\xf0|\xee#,\xc1\xac\x93\xb8\xc2\xe9\a=\x04Q\x91\xbd\x94eʾ\xef\ar@^\x91\n\xb3\x05\x81\x96\xf0\x9f\t\xfdm\x8a\x9d\xb3\xbe\xf7\x97G\xde\xef\xfb\xdf$\x1c\xc7\xfd\xdf\xd6\xfdg\x9b\xfc\xdf<j\x1f\xe5\xec\xff\xcd\xe6_\xf2\xff\u007f\x96\xff\xcf\x1b\xa4\xa4[\xb8\xff\xc8\xf2\xec\xb4\xf3v\xde#H\xe8\xee'N\x00\x95\xfb\x8d\xfadз\xf7\v\x02^\xa8;\xf3\xed\xe4\x17$<\xfe\xb4j\xbf\x8b\x8b\x9f\xc0\x06\xbd5^\xd6S\xf3l\xfc\xf01\x95V\a\xadw\xf2_\xe2f\x85tT\xdap<\x1e&\xaaUQ\xf8W\xb4\xbb\xe5\xa4\xf7\"\x1aG\xd3p\n{\x8ey\xd2\xeb\xfc\xe32\x1a;\xd3\xf9\x18\x91\x80y\x93i2\x98\xb34\x83\x05|\xe9/\nu\x81t'\t\xed[RT\x18\\\xc5c\xd8\xf6\xa7!\xe8CNt\x15ƣ\xec\xe8\xf8?ّ\xeb\xae\xfc\u007f\x9a\f\xe3Q\x84\x0e8\u007f\x18\xff/\xf0\xff\x02\xb9\xf9/\xfe\xff{\xfc\xf7\x99\xb0\xee\xd4\x04!\xd4z\xf3\xd9\f\x16\x92\xb8\xbb\x1d\xa7\xb0\x1a\x16\xb0-\x80\\\xf7AsO\xba\x16\xa7S\xbdd4\x90NE \x91Ok\xd3p\x10\xcfa\x1fiIo&\xd4D.\xa6\xc9|<\x80}\xe4\xa2\x17\xe2Mr\xf1\u007f\xf5\xb6'\x1f\xdc\x1d%ӎ\xf3Y\x14E\xb6\xc3\xd6\x10O\xa34?\"\xe5%5\xe5\x0el\xf2\x9a\xc23\xa0\x01:\x8ck\xc5Po\xac\x85\xa3\xf8\x02`\xe3\x93\xcbѴ\xc0e\n\x8f\xb6AG\x92\xb8y/p\xf3\x1e\xf9\x1f\xc5ǲ\xd13N\xc6ц*\xf1p\x1a^Eﯧx\xec)ݪvA\x8c٫\xe66\xe4䝬r\xce^\x02m\xa6\xeb\x17@\x17\x1ep\xc9\xc7h:\x1c%\xd7\x1d'\x9c\xcf\x123\xad\x06\x03M\xfb\xd3DZ\xad\xcaҁ8z\x1f\xe2Y\rx\xecX\xf64\x99\x84\xfdx\xb6\x80\xa1\xb5R'\x02\x19\x03\x06&J_\xa5;\x96\xdc^j\xcb\x04\bė\xa2J`\xe34\xf3\xfe\xbb\noj\"\xf5\xa1D\x91\xe1y\x98aɢ\u007fEpEKh3\x86\xda;c\xa8\xbd\x13\x86\xda\x06\x86J\xf8\xff\x1f\xe8\xff\xdb89\xc9\xf1\xff\xf6\xf1_\xfe\xbf\xbf\x9b\xfc\xef\f\x92\xf1\xfe̙\xa7Q&\xfe\x90\x1fY\xea;i\xe2\\G\xe8Àv\xe0\x01\xd1\x16\x85xL\xae\xe2\x19\nTW\x0e)\x04\xb7\xf5\x01V\x87\n\x14\xa1\x04@\xbe\x17\x8ek\x9a<\xa2\x1c\x82wr|\xdb\n\xf1\xb6.u(\xb2\x02\xdcϑ\x818\x9d k@\xe7,\xce\xe7\u007f\xc7\x18\"\xcfg7,4\xa3\xe9\xec\xfd\xfb⢁\xb3\xaf\xe0\xad\x1c<\xf4ċI\xfb\xdd{Z\x8fB\x81\xa2\xac\x1f\x9d\f\xdc\xdb\xcb\xe4\xfa\rC|\t@*\x9e\xebă\xc0-\xde\xc1\xddǲ\xb1\xfa\x17\xa3p\x92F(r;ULxt\x10*?\xc0\f;\u007f\x98\xfe\xdfj\x1f\x1e\xe7\xf4\xffÿ\xf4\xff\xdf\xe5?e\a\xde@f\x9a%?+\xf5u<\x88\x8cRۏb\xa4\xfc\xb3\x93c\x99(\\'vR\x97\xbbY\xa0\xf6j\x91\x1d\xa3]u\u007f\a\xa9k_\x8b\xea\xc6\xd2@y7\xb8\xc0\xbe:\xfc\xc1\xaf\xb2\x8e\x88\\\xde\xfes\xc9\xf4\xef\xb3⼭]\xdf7\x8a\x8f\xb9Ϫr6\x1e\x92H\xcbG\x83ٚ/\xc4զ\x86\xa9\xacV4d\xe2\xc0s`\xd2\xc0\x0fDi\xbd\x8c:a\xdbǣ5=\aV\xd6E4\xcb:\x8dCЃ\xeb\xe15\xad\r\xb3\x80\xf9j\x12\xf0\xa3.\u07b7\xdaG^\xbd\xafg\b\xe4\x90;\xa9\x91Ao\xd5AN\tSև\xaa\xb9$R]\x8b\x10\x8d|\x1a\x0f\x1fݩ\xee\xf7\x92\xc1\xc2(\x85`e0\xad\xd2B\x02\xbcIk\xc9X\x1e0\x15\x9d\x00\x18\x04\xc9~\x82\x19\x8e\xfb|\x00\xf9\x0fP\x1c\x92\xeb\xba\xd9.K\xe9\xecH\x88\x87g\x93\x9b\xfdn1Ԍ̛\xfa*&D\xa5\xf3\x1el\xfe\x15\x9d\xa2&!\xde/\xc0\xa7H\xeb\xd3\xe8\n\x14\x83\x1c\x02ʖs\xd3<\x8d\xda\xc0_\f\x9f\xdfbo\xdfg\x8b\x97\x83\xcaN\xcc@;\u05cdF:n\xa3Q\xd9`\fGS+ʉuN)\x8e\x05\xff\x13,F:\x92~+%`\xcb\xfe\xdfn7l\xf9\xbf\xd5>\xfe\xeb\xfe\xef\xef\xf2ߣ\xfb/^?\u007f\xf7\xe3\x9b/\x1c\x9c|\x90@Ʉ>\n\xc7\x17\x81\x1b\x8d\x85\xb17\n\a\xc20\x0e\xbc>tЍ\x1eD\xeb\xc0\x9dφ\xb5SW\xcfB\xaf\x8fZ\xf4\xafy\xfc1p\u007f\xa8}\xff\xb4\xf6<\xb9\x9a\x84\xb3\xb8\x87g\x04\x825\x05\xee\xcb/\x02|/ߨ\x89\x1c<p?\xc6\xd15\x1al\xb5¤\u007f\a\x83\xe8c\u070fX\x19\xf7區\x1a\x85'\t\x9a\xf5F\x01\xa8A\xc4\x024\xb0\x15\rZAA\xd0\xe1/\x93iA\x99b\x9d\x86\x05\xf4\x83\x83q4\x1b\x8cC`\xac\xc9\f\xcdɓ\xfe`\f\xcc\xf7\xea@%\x1c\x1c֛\xf5&*>Y\x9a\xba\xec(\x9a\xa03\x8f\xc7\xcc\xe7К\x8d\xac\x0e]\x81\xa1ӏ\x0e8\x93\vnUU\f'\xa6Yrq1\x8a\xde\xe15)\x8b\xbfQhk\xba>\x15X\xbcN\xfb9\x06\xf8oa\xce\xf0m7\xed\xb7\xe9\xe8@P\xea}y\xbb\xa2N\x96\xaa\xd7\xc3\xca~\x1f\xf6\x18R8\xf6饛\x86yLlU\xc3'\xf3,@\xd3\bT\xd0~T\xd9w2H\xbe\xb3\xbfo\x1c#C\xdf\xe3\xf18\x9a~\xfd\xee[\xbc\x8e\xb2\xbf\xf7\xd9\xc3\xe3v\xa3\xbb\xbf\xf1\xac\xden\xbb\n\x15\xb5F\xb6\xc1?6\xe1\x1b\x8f\x19d:\x1cOW\xd9%V\xfc\xaf.56R\x05\xb3\x0e^\x83\x9cX\xc3\x1d\xaaÎU5L\xc8\x1aԌQ\x99\xc1.\xeb\xc7lZ\n\xb7\xbc\xd2d\x1a\x95ֺ\x8cgQ-\x85\x9d:\xea`9\xea\x99]_U\x8e>\xa2\xd7\xd3(Ng\x1a\b\xdd\xc4\xc9&R\xab6\xd7\xe2\x83?}\xa2Jl̂\x84\xa3)\u07bd\x1dI3\xee,\x99\xe8\xd9\xc2\xe6[\x13\x96Φ2\xe6\x99\xd9d\x05|Xog\xb9Y\xaf\x04A80\xf8\xb1\xd1/e̋\xc7\x18D\xa9\xa6\xd9\xf4v\x85\xbe\xd9\xe0\x9e\xb3*;\xf7\xe3+d\x88\xe1x\x96맢\\\xad\xb2\xbc6\xd2\xe8\xe6\xe1Y\xbd\xcdl\xbd\x97\xf1`\x10\x8ds\x04ElBw3̬\x8b\xe1hD\x96E\xbbN\b\xab+1V\x9df\x97?l\xe9S\xc1vԢiR+\x8ao\a\xe3O\xb9\x05=B9V\xac\xb1P\x9eNN\xe6\xa3\x11\xc3q\xa8u7oDa\xe6V\xdf ^\xba\x8f\xf7\xf0\xeaI\xda%\xeb\b5\xa0\xdfT\x85Q\x840\xe3S\xb5\x88\xf5\\<\xb6\xac\xf1\x19\xa9\xca\x17\x87\xdcE\x9c]\x1epϒY8\xe2\xa8\x17\xcaB#\xcf\"\xe5!7cBܝ\x15\a\xc8\"\xb6ͻ\x186\x93\x8b4;\u007f\xe6\x1b\xce\bO\x04\xc5\xd1N\xd2'\xd3x<\x1b:\xee\xdf\x06\x0e-\xba\xd4u*\xa3h\fMӗ\x87\xa5kԗw\xd4+\xac,\x8dQ\x9f\xb9N2\xee\x8f\xe2\xfe\a`f\xda\u0382\x1eV^\xd7\x14z\x01\x8d̉\x11\x8d\xda`Z\x1a^\x98\xb0\x04\xee\xf8\x83\xfe\xad\xe1\xdb&\x93h\xa0\xe1P\x1f/\xf7T\x1f.C\x9b>\xb6\xbc\x90\x1e\xcd\x06\x12\xba\\\xca\xee\xe3G\xb8\x98\x1f琍\x89\xb0\xd3\x0e6\xc0\xd0\xf9\xa3\xfb8\xe7\xf1d\x0e'\xcf\x0f\v\xaa\xe8\xa3\xc2\xd3\xefܠ6\f\xaeh\x90\x19\x17%\xab\x1fP\x06{R\x14\rL\xab\xff\x18\xcf\xec\x8bG\tP\xf0\x95[\xa7B\x97)\x00\"\xba[\xba\xbe\xeb9\xf8\xc81\x81\xa6\xf3\xfe\xd2\x06 gZ2p\xe5\xff\x90\xab\x81\x88\xccMD\xae\t\x1bt\x1e\xa4\x05\xca.\x80\xab$\x99]\xe2\xb3g\x9a\xab\xc9w\xe8\f@.\x05\xc6*\x14\x84\x9b\xdd_?`\x16\x04Y$0\xff\x87\x9f\xffk^\x0e\u007f\x94\xfd\x17Խ\x9c\xfd\xf7\xf0\xaf\xf3\x9f\xdfI\xff\xdb\xe5\f$s\x8a\x91\xe1\x12꼚t\xe7a\xfd\x1c\xe3/\xbf\xca\xff\x98\xf5oE\xe1\xf9\xfd\xd7?\xac\xfe\x86\x1d\xff\xa1ux\xd8\xfek\xfd\xff\x1e\xff\xd5%\x01\xbc盳\xf5A\x04\x82\xeeh9I\xe8\x1c\x95嘴Cڛr<Q\xf1}P\xcdit\xa5\x97J\xab\xab)|¥\x05\xffWoz]\xe1\x86\xd2\xe8\xb22\xda\x04i_\xd3'\xa4=\xb8\xdej\xa7\x0e\x87\xa7\xed֮\x92_j\xdb\xca$[K\xe4]-\n\x8a\xadK\xd0P\x8f\xc7!]\x00[\x8aJ\x9dFYQ\xa7\x0e\xea2\x1f\x98hś\xe5\xc5oޏ\xc2^4Z\xea\x11\xa68\xbe\x94\x16S\xca\xf4*9\x04\xacI\x97\xa6c\xf8-[\xa9\xb7EAī\x03s\x13\x0f\x9cϢ\x06\xfe\xaf\x9b\xe9_\xe4\x16\x95\x9fCm\xce>\x1b\x0e\x87]]\xef\x1f'\xa8\xf4o\x1e\xf22\x0fR#\x88\xd2\xfe\x03\xf6\xa3+\xbbw\x1bq\xa1\xa6\xa0\x8c̎\xbc.{\x92\xd18r\x18\xb1\x8a\n\xfd\x93<\x80\x9a\xd0\x15\xf1\x8d$]\xc3\xef\xdb\xe2!7\xf5e\xdd<\xf56\x03\xea\xf4\"P\x15\xa3\xa5\xb0GvܟZ\xed~\xcb-\x98:\xea{\xad\x8e\x88\xe4\x81\xeb͜xb\xadm \xd9A2[r\xa1#\xc0\xbe\xb0\x1d\xe0O\x1d95-\x81\xb0\x83\xd3hNl\xbb\x98\xb2\x92\x9b\x1a\xb4:H\xae;\x18\xe1\f&ؘ\x83c/G{\x02*w\xa9\x955C\x96\x80\x0eMcW\x9a3\x88%e\x00j\xa0\xa0N:\xd2\xfe\x02Mo\x1c\xb5\x9c,\t\x8cL#v\x8d\x8c\xb2eظ\x1c\xcc\xf4\xe3\x85\t\xa3\xab\xac*lT\xc9\xf7\xe2\xe6=\x86\x84X\x961S\x8bQ6$\n\x98N\x01\x85\x03\xba\x04o`\xb2\xe5u\v\xf8uY\xd3N\x9dTł\x1e\xdce5\x02\xefɯ\x14\x83~\x98\\hT\xd0\u007f\xadW\xe1x\x9c\xcc\xc8\"B\xc1Ƒ\x15/\x05\r6\xb3\xb9G\xb4\xe4ٚF\x8fd:\xda4U\x05\xed8\xf5,\xb1\x00\x11\xa2\x17\xc8cy\x1a\x8e\xed5\x81\xf8ٰ\x18t\xba̭\xccV\xbb\x80\x03h\x9d$Dl\xa1\x8fZ\xd67\x93DZ\x85\xfc\xee\xd03\xd6\xcd\xd6\xe6wZ\x1fF-2g,\xcb\x05\x00c\xd9\xee0\xb8\xed\x8dݱ\x8f\xa2\x1aE\xd5\xe9O\xa3h\xbc,\xc1\xcc\x16\xb2q\xe4Q\xfb\xd2\xe6bb\x13B7b\xb5J\x1e\xaa\x8d\x8f\xc8c3g<\xf5\x8a6N\x9b³m6\xbf\xcbgB\x82s\xca\xffO\x18n\xe2\x0f&\xe0fS\x11P\xf3\x18W\x901A\xfd\xf94\x851\b\xaer\a\xa4\x14mb\xbd\xc2M\x8c7\\썶y\x93\x9b\xb6\xc2P\r\xd7\u007f3\x87\xa4ݻ%\xe6\xdc߹B\xe7\x12\xf9\xf8r\xe32\x86Y\x12h\xba#\xe1\x88F\xe44\xb6\x1b\xb7\x1ePF\x83e\x8b`\xa7q\x96\x80\xe9\x16\xf6L,\xab\xc5{\x8c<\xe4\x17\xec1\x98\xfe~p\xb8\x1cƣQ\t\xb7Y\xd0F\x94:\xf4\xf6\x85_\xb2O!\x10.\xb1\x14\x91\xac\f\x96\x02\xe2\x03'k\xe2\x02\x00\x99D5\x8e9\x83\xf4ߟ\xc2xjx֜\xee\xb6C.\xe0\a\xb0\xce\xd2~an\xd6-\x89\x9fZ\xb3[\xd0Ö\xd5A$q\x910\b\x01\xect\n\x88n:\xcd\xd2^\xe0m\xe5M\x9d\xc0\xfc\f˲\v\x9b1^\x06S!\xbc\x18(\x94[\x94\x03\xc5պ\x11(\x16Xj\x12CN)\xd9Yx\xa9_\x80l%$\x18\xbf\xacC\\\x88\x1a\xa5\x91\x10+ָ\xb2\xce]\x14oi0wi\xb5۾\xfc\u007fط|̪m\xc9ߐ\xdd\u061c\xb9\rr\xa3,\xb3\xb6=w\x03\xecR\xec\xc6c`\ai\xb4\x15\xc1\xb2\x9c\x86cm\xe7\xb39\xb8\x8dc\xc5@s\xd85s\n3\x1ae\xc9\xe5p\x1a\xf9\xe4ڦ\xf4BH\x1a\xceF\xd1\x05\xb0\x98\xbc\xe6nӵ\xae\x8dj2\xc2Q\x03\xff\xa76\\\xe3,Y\xee\xdcX\xdfi\xe7d\xcb\xd6\x16Q\x97{fm,\xcdF\xae\x84SO\xaf1ދ\xd0\xfeH\x84\x96\xa2wC\xb5Z\xa2<\xb7\xbc\x02x$\xb4r\xa8h\x8a\x14Mc\x92 1¶y.\x8f\xe1\xaeK\xa0\xa8\xbe\x15\"\xc886>,BP\x11X\xb2\xb4\x88\x9b;\x8d\"\x85\xc5lK\x9b\xc8x|\t{\xc9,/ѓE\x89ϕ\xc5Dˢ\xba\xf7\xc2\x11\xb0\x1b\x1d\x15c<\x04\x1e\xa9i6y\x91\xc5\xf8d\xef\xd9\tWL\xaa\xe2\xa2ǥ%\x97\x06\x8e\xea-\xd3\xce\xd2\xd0\rG-K\xd2\xd3\v\x1e\x95\xceP\x1d\xb0\x85\al\x83\xac7G\xf9\xa2\xf3\xd1\x12\xcf?Yy\xa7\x808\xbc\xa5\xa8iȐ \x92h͔I\x9e\x02\xea(^\xaaz\x8e\x10\x9e\xbbW0Z\xa6\xe4S\x1c\xdeFӍ\x82\x93\x93\xf1:yV\xd9(\x12\xc5\v\x81\ted#\xb4V\x11\xb0?\x91\xfd\xff7\xba\x04\xb2\xd1\xfe\xdf<>i\xb5\xed\xf8o\xad\xe6\xc9\xe1_\xf6\xff\xdf\xe3?\xf4\a\x94aI\x03\n\u007f\xcb\xcbF9\x9e\xab$?\xe9\xfd\xec-9B\xf2t\x96\x06*C\x04\\t\xeb\xae\xd7\x15\xd9\xe8F)\xa1\x02K\x99rp\xb3\xa0\xe9s\b\xb3\x80 \xc8xf\xf1#\xf9\xa3Z\xe5\x06D\xbc\xfa7P\x8a\x8b\x9e\xc5\xe7]\x06{\xa6\xe5\x9d\a\x05i\xab\xd5r-\xca\x16e\xaf\x85\xb7\ng\xad}\x8c\xee\x98\rV\r\x11\x93\x83\xb3s\xd5w\xf8\xc6H\x03\x98O\x81#)Z0\xfc\xf2\xba\x02\x1e\xa6\xae}\x0e\xa3\x91\xc1\x1bD\xe9,\x1e\x93\xa6\xe5\xa7\xc9|ڏ\xbc\xa5\x049\x99&\x93h:#\xb82O+\u007f&\xf3\xcf\x03\xce\xcd\x12\xe4\x18\xb4\xd2k\xbf?\x02\xdej\x8d$\x1fY\x8b~\xa2\x9b\xcd\xf8\"\x1e.\xa8\x94\xb7^w\xe3a\x05\xf9s2\xc4\x00\n\xf3Qt?\b\\`a\x14\xe2c\xe0\xee\xedqj=\xbaAW\xb4\x94Q48\f0PS<\x8d*\xee\xe0\x10\xe6\xde,\xa4\xe6\u007f\x9d\xc5)\x02\xb1\xbc\x17\x8e(D\xda͌\xa1\xbc\x9f%o\xa9;\x01\ax\xab\xc3(g\tv\xa6.s\xfcW\xdf\u007f\xf3\xcd{\xf4Q\x0e\xdcW\xf3\xd1\xc8\xf5\xbf\u007f\xf5\xe2\x8b/_\xbe\xfa\xe2\x85H\xfd^u\xd5\u007f\xf6\xfa\xf57_<}%2\x9e%\tH$c\x17@|\xfb\xec\x8b\xef\x14\x10\f\xba\xeb\xfao\xdf}\xf7\xf2\xd5W\"\x91\xdbr\xfd\xd7\xcf\xfe\xf7\x8b\xe7\xefD\"\xf7\xc9\xf5\xbf\xfc\xfe\xd5\xf3w/_\xbfz\xff\xfc\x9b\xa7o\xdf\x06\xee\x19\xbf\xcf\xe0|)Fv\xeev\xb3h\xe5\xe9\x97\xda$@)5\x0fj\xacu\f\xd1%s\x83 0\xc1\xaf\x15(&\xa7\xdbR\x11L&\xff\xaa_\x86\xe9\xeb\xeb\xf1\x1bQ\xa4\"\xcbz\xdent\xd6-\xa03\xd57$x5@h\xf2\x1dL\x99\xfc\x06\xf2\xd1\xd0\xe8-g\x97S\xd0\xc70\x9c\"\x96\xa2x-k\x11\xd9l>\x9a\x19\vM\x1f\x8f\x06\x9d\u007f\x96\x0fh)@\xf1\xc2T\xe9k\xb9TDv\xd6}\uebb7L\xafc\x105\xf1W?\x04}\x06c\xb2uD\x1dEv]\xca\xfa\x98\x80\x18ܐ\x99&\t\xd2hȅB,\xa3\xa4+\x00\xe37\xc3v{\x82\x18%\b\x9dT\xa9\tw̄\x99u@\x11-\xe7\xf3\xc2U\xf9\x1a\xfd\xcaqjh_k\x14\xa9\x16\x88M\x92\xb2\xbb\x94\x1a\x18\xab\x9eƔ\x8e\xe2~\x14<ES\x85\xb62)5\xa3x\x10\xde\xe8&\x0ez\x1d\xa6\x95\xe1\x98W6\xed\x0f\xc1p\xac\xd6q\x05_8E\x9c\x1c\xfc\xf3\xec\xa7\xf4\xa7\xca\xf9\x03\t\xe1\xec\x9f\xf0\xf1S\xa5r\xf6O\xef\xfc\x81\xf7\x93w\xe0\x9d5ϕ\x1f\xf8\xc1O\xf0\xbf\xfa\x83'g?M\u007f\x1a\x9f\xaf\xe0\xebA\xe5I\xa7\xbe\xe2o\xef\xc1\x93\x9f\x1e\xfctppỮ\xa7\xd5I\xab\"I\xecN\xbe\xabx5\xf5L\xec>A\xd0\xdcۻO)g\x8d\xf3'g\xe7\x1d\xfa\x9d!\x0f\xa5G\x18\x93/\xefi1\xd7zϗ\xde`x\x12hvS\x8bJ\x84\xc1|2\xc0\xe0\xe5g=P\xc2*\xb2\x82O>\x93\xe7\xbeDY\xaa:\xa5]3\x1b-ȵ\xd2\x0f\x81~U?\x048\xb2\x1aau\xc1\x85\xe9\xfb\x1b\x1e\n\xfd\x16\xe3\x92;-\x96\x94\x1b-\x88ţ\xa8\xc2\x1f\xb5\x9aG\xc5\xcf4\x00U\xce:\xa7Jg\xe2C\xf6\x8f\xcaeݹ\x8a\xa6\x17fo\xe8w@\xa4\xc1\xfc\x8d3\x1bj\x84\xf9\x11d\xe0\bI\x80\xe5\xbe\xdc\x1b`\xcd+\x1c\x89\xfe?j\xed\xed餬\xf2a\xe2<O\x124 \xaekM\x115f\xf6L\xd4\xf4[^\xe9\xfc\xc9\x11^\xa4\x05\xb3%\xa1\x8b\xe9\x12ݦ\x19\xa3\vjW\x93\xd9Bn\x03\x81\x06zM}\xa3')\x02\xabŗo\u07ffx\xfd\xea\xdd\x17\xb0\xea\xdf?\xfb\xfe\xab\xaf~\xd4\v(\xf6\b|q)\x17T\xa7\xb9&DMp\xe1\xcaD\xd7\xd3\xfdq\xd7\xdaE\xb5u\xc5\xcb\xd6l:\xef\x91\xe3'\xf6Ɋ][QR\x1e\xcaP\x14\x0eS0\xd48B^\xcd\xcb_\x8c\\C\r\xf4D\xdb\xfb\xb2*4=R\"S\xa9\xf5\xf42\x1e\xce\xf4.}\x10\xfd\xc1)\xacg\x11\xb5\x8d\x15\xa1Z[\x8b\xbd\x91*\xf9\x84кx\xbd\xd8\xebRb=\x9dCS\xec\xdd*\xd4t\x99\xceC\xa7\xc1`\xaf9\x17\xf6\x02\x91\x91q:Q1K\x10 \xb2\x02\xb8\xa9\xc9z\xdd\xd9t\xb1\x145\xb2F\x84\xac\x88\x1fޚ\x82\xecV`G\x80\x95\xad\xc4\xe2\x86\x12\x8b3\xec\x14\xc9\xc6\xdc6(\xbfb\xa0:\x8e1\x18=\f\xe5\xbe\xd5?\r\x8f^yV`Ы=F<\x1c\x80\x9dg\x8e\x11\x84\x03\xcaSB/~d\xe4\xa3\xf5L\x8a)\xb4\x94\xc6}\x10 \xa0.Ml6+{{VB֤Np$h\bx8\xc4\xdc:\xa15 $\x1e\xb9\n\ue5cb\x93\x9e\x86e\x9a\x1am\xe9t3Htq\xf8\xf5\xb0\x00\x90\xc8\xc9\xc3\x11\x19\xaew\x87\xb9]\xea\xc2O`L\xacOp\xf3\xd2\x19\xb2H\x81Zd\x8cj\xe5Qqoo\xcfܖ9\x15\xd6\"\xf0\x8a\xcf\t\xe7.7*\xd8$\xe5w\xb9)\xde\xf3$\xbc+%-h\fI\xee\n\xa2\x03gW\xe7%\xebt\x9dIc>7\xe5q+\x12\x8f\x01\xf1~\xc1NE\x9aUR\xe9\bzQ\xa5\x1e\x88\xb2k\xa2&5M\x9a\\K@\xd6\xda\x06!~/\x99\xdfu\xf8\x8f/h\xb7\xb3\xcc踓\xfd\\\xaf\x91\u007f\x02\xce\r%&S\x89\n\x93\xeb\xcc\xe9\xe9\xdf5^G\xb3\x8a\xe9\xd9k\xaf\"U&\xaf\xab^\nQZv\xc5՞\"\xc2;\x95\\\xf9\x1b\xbca\xa0\x95\xb7\xf3\xf4M$\xd3\xf2\xe4\xddi[ϻ_q\xfb\n*F\xdd+\xbe\x9a\uf1ae\a2wF\"xm\xd3[\xba\x18A\x06eTP\x99h\x9fS\xb0Pb\x0f4\xd0>M\x13\xa7\xaa\x19s}|[\xe3\xf9l\xfa\x06S\x02\x02Z\xc7\xfbw\xa2\xd9ՊRėw\xa6`\x9c\xa3i\x02ꉥ\xeaC\x17\xdeM㫀\xe9C+W\x87\x84\xab\xd5*O\xc6D:Jv\xfc'\b\x8f+\xf8\xff\xcfY\x82\\\x039O_\xf2\xedF\x16\x85u\x90\xe2ڣ\x06\x15}\xb8xi\x89\xe5όO,vd\r\xbc\xf4y\xdd㮉\xca\x0e\x96a\x8e\bK\x1e6t\x86\"\xfa\x17KE\xa6\xd6\\\xfb/^\u007f\xfb\xc5M6\xaf\xc4/\x81H\xd2\xf0\"\x12\x9b'\xddk\xa5\xed\x8a>1\fc@\xb5\xfa\x11݇=üs\xce\x145\x03\xf1w\xed\xf7/\xa3\xfe\x87wɇh\xfct<\xf8\n\xa3\x11\xc3\x00\xb3\xe6\xd4$\xfa3,\xc3T\x85\xbfP\bqu]\x8fZ\xac\xb8o\u007f|\xf5\xee\xe9\x0f\xef\xbf\xf8\xee;\x90\xc1\x9f\xe2k^\xb0\x1eA\x95J\x00?\xa3Qt\x11\x8e\x1c\xd6k\x9ck\x8c\b=\x89\xfa\xf10\x06j\xf4\xd6\x00\x19\xe4\xf8\x83\xfa\f#vsk\x05\xf0_\xbe\xfa\xfb\xd3o^\xbex\xff\xfc\xeb\xa7\xdf=}\xfe\x0e\x14&n\x8ag\xdf\x117\xb7\xf0IB\xd54^`\x0e\xfb3d\x81\xebL\xbc\x15S\xcc\"\xa2=̵_\xb0\xa4\x90^y\xa6\x91\xb0\xae\xa2\xc1s!W\b\ndPX*\xbbt\xea\xf9R\xf80\xeb<1?\x85\xdaBz\x8c\xd79;\xf7%-I\xa9\xa2\x84\x9c\x98\t\xe2v$\n\xa2d\xc0\xac\xf1=\v\xe0\xea=4\x9d5\x98}d\x82\xcd\xf4\xb6\xf5\xdaח2,N\x85\fm)\x804\x95\x15\xfb\n\xdfS\x9a\x06\xf9\xa5\x863\xa7j\xf3\x15\xb2u\x97\xa6R\aE6\x02-\xa1k\xb6O~\x92\x19\xf0\xd8X\xc80\xe2\xd5\n\xc5ֵ]K҂\xb6x\x98\x86\xe9O\x15\xe8WJ6\x85\x8b\x80\xf76\xae\x02\x9c\x13֢\xdd\x00l\x17\x1b`\x03=o\x85\x1b \\}\x1a9\xb9[8\x8383v\x178dņ^p\xe8\x15\\\xd3[;C\"=&\xde\xd7z\x85\x84\t\\\x92\xd2\xfd\xe6-z\xc6W\a?!~\x00\xdb\x02\b\ufb42\x8b\xe3\xf8ezA'\x84(Q\xb2\t୷\x8a\xeb\x00c\xe8\xb2\xd9\t\xf6\x95:o\x90\xd2\xec$l\xd4\xfa\xde\xf6\"J\xfb\xc1\xf2\"\x9au\xac\x05\xe0Gc\xd8@\xa7xX֡g\u0600\x04\x87\xf1\xc5<KY\x93\xd6P\xd8PE\xdf\x0f\x8d\x158\xf1s\xcd+\xdd\xe2\x86XrtSgk\x12\"\xac\xd5<:>m\x1d\xb6\xda-o\x99\xabXϺ\x18\xf0\x1d\xceOљ5M\x89\xa3p\xa8o\x99\xef\xdf3hF\xd1\xfb\xf7\xcc~$\xec\\v\xa5\xa41\xce\xf7Pp\xba\xa6h7\xa6%\xfb\xbb\x97\xcf\xff\xef\xed\xd7O\xff\xf1\xfe\xd5\xeb\xf7\xcf_\u007f\xfb\xe6黜\xb4\x93/\xb2Z\x95\xd66\xadc\xde\xd2z\x1d\xd2\x14\xbb\xd6\x1b\x058z$J\x97\xda(!#I6\xa8\xa0:G\xe6\x1b\xf9\x9e&m}\x16\x14\xe0\xa7\xd1\x0067g\x1a\r#\xd0<\xf1\t\x85\x04\xf7:Q\xc9\xe5\xf5)\xbe\x02\x1d\x1e\xe7\xf0\x8b^\x9c\xc1\xbf9\x1d\x06\x1a\x92qvI\a\xb1\x93d\xc4\x0ecn?\x9c\x0e\xe2q8r}~\x97\xb0\xe3\xfe\x12M\x13\u05ff\x8a\xc7\x1d\x85\x1f\xff*\xbcѾ&ӈ^\x0e\xea\xb0Q\"\x1b8)wF{\x1e>\x86\xf7E\b\x94\xac\xd0\xf1\x81\xd7\xfb\xd9\aa\x99\xfa\x00\xfcݨ\x03)k\xb6\xacqߙ\x1c\x82\xe5\x9a?\x05o\x12\xcfb\x90\xd6\xcfϻ\x8d\x86\xb4\xd3u-\x8b\x83=\x11\x94M\x92\x03\x80\x11\xcf\xc0i\xf8\xf2tD\nǼ\x02\xf6\x82\xcd\xc9B\xc3x\x048\xcdF\x98\xcaR\xf7Su\xb6\xbe\xf6\xd6\x12\xf0\xec-\xf4\xaa\"|5\xa8e\x8e\x83\"|\n(\x85\u007f\xaf=c\xbe\xebj\xb1\x10\xb3tMw\x1eW\x14\xfe\x18\xa7\xc1\xe0\x10\xda\x19\xe1\v;z}O\x84\xaa\xaa\xb8\xe9ǋ\x0e\xfc\xbf\v)\xb3ٴ\xc2QY\\_\xa0\x1b~\xcb\f\xee\x87\xc8\xe1\x0fOY\xf7)L\x17\b\xbb\xd6s\x8a߉g,\x99\xe6\xb1\xd0ju\xbf\xa4\x8c}\x06\x80\xa5=O=RD\xc7\nAI\xdd3,L\x8a\xf3\xfd)\xb40ʹE\xf3K\xbcZ\x97A\xa5\xb9\x9bF\x17\x80\xc8h*\xa1U\x90\x03L+K~\xf7\x11\x8b\xac=!jѻ@\xa2\x14͎|\xa8s\xb5rS\x8c\xd6\xee\xb2\x01T,\xb58\xed\xa3+\xc2w\xe8\x9e\\\x91\xd3n\x12\\FP\x82\xe8\bUl\x98\x8fS\xfa+sP\x8d\x13O\x17\x82\xac3C\x15\x1d\x19\x9a\xf9̧ǻ\x18\x97{\x1b_\x8cC|\x90\xb2\xdcj\"\xb4\xfb\x8c\xe2\x91\x15\xc9V\xf89\x95P\x18\x89;\x8e[\xb5\xe0\x92Q\x94\\:\xd2\xe7\xc9\x1cX\x8f\\\a\xf6R\x17\xe3\xaa\x18]\xe7NI\xd9_\xb6*HTk\x9dO1\xa8y\xd4\x1dp-\xe1[Ef5|vf\x9c\xd0kPX\xd2:\x10MI\xeb\xb0\xf1\xcap,@\xf4\x9eT\xc1\xd0m\x88\\\x990p\x13\xf0\xd7Y\xe3\xbc~C\xa4\xba\xd0R\x16\xda\xe6\x05\x12\x97<\rR[\xd2\"K\xdbۃ\x0f2\xcd\xcaN\xdd\xd0\x1b:\v'\xb3\x1c\xe1\xcb͌r'\xbdL棁\xd3\xc3\xf3-\xac\xcf؍\xc2\x01\x96q\xab\xb2ͪK@T\xc2\x02q\xc1\xfd\x13\xea\xc6\xe3\xe0\x90\xadm\xdc\xe9\x16\f\xe3\x91\xf8݄߫\x95\xf6\xf1H\x1b\xab\xca0\xa0\xd5\xecbE\x18\xe6\xfd\r\xf64\xe8}ʡ\xfc\x93\xb1s\xe3\x90Q'\xa5\x97~Dq~\xbb\x17&\x9f\xf6\\\x90@|\xa1]\xf0\"\x03`/\x12\xbc\xe2o\x1b\xde1'\xd0Y\xf8\x95n\xfdJ3\x06N0\xd6\xec\xe4\x00\xbb\x1d\xf2L\xf8C\x0fb\x99u\x06\xaa\u0380\xc6\x05|\x81+\x857T)\xbc\xd9Ri\xa0c\bks\xce\x194\x87;\xeb\xf9\xba\x80q\xe4\x86Ń\x15B\xb1`\x9e\x9cX\x11\x8c\x87zC1\xb4\xea|\x0f\xb2\xe2\xc9\x12\xfc\a\xa6\xa4N\x97&*g\r\x8dן\x8b\xfa\x8b\xad\xf5\x17\xaa\xbe\xb6\x1f\xf8\r\x05\x00Pp\x01\xfb\xee|\x10\x95\x83:\x93\xb0\x00\x975\xed\xb7\xaf~7\x8d\xf4s\xbb\xcf\xdc깤\x04F\x86\x8d.b\xcb\x11=k*\x88\x01\x13\xe8\x95\xd3b6\xdd51\xab\x1e:-\x129\xf2\x8cN=N\xb6T\xcfuyj\xc7\xe7\xda\xfa\xd4u\xcd.m%a\x96B\xe8ĵ\x98\xc4\x04-\x97\xe7\xdb\"\xca\xc0ԓ\xde\xd3!\x10\xa4\xcaU&\xe4/6c\xb3\xb4\x17\b\x8b,\u007fѶ+?\xa4.Y\xb6\rd{\x9c(B\xa4\xe1-3Ш:\x01\xc2h\xe42\xf5\x89\xda\xe4\xc8\x1b\x86\x9f\xaa\xeb\xe0\xbf\xddB䔴\xeb\x93n[\xd0z\xb6c\xd2$\x10ߢ\xb2$Sd9\xde2\xfb\x9do\x0409\x80\xa5\xa3\x83\xae@\x02N\xffZ\xcaoj\xa6\xeb\x97I\xf2Ap\xcd\x1c$\xd8\xfe\xa6\xa0\x8e\x12\x0e\xe8w}\xa8\xf6\xe9\xd1\xd0?\xc3\f {\x9e\x15\x8d\xc0i*L\xf2\x9d\x8f)_\xcc\xcb{%|`\"\x05\xfba \xa3p\x91\xccg\xb8V\xf9\x17w\x142\xc5'\xab\x04Լ\xf8\x8d\x97 \xb2\x95\xc5\xc5xb\xd6z\x86\xf6{\xb5\xd2f\xcc\xc2C8\x9c\xf1sم\x88\xd0\x01n\xc5G\x1c4\xba\xb7$B)\x9a\x8b#V)\xc5P\xb3\xfa\b\xce\xe2j\xf5ܘ\xca\xfc(\xa5\xa5IK\x12\xab?\x8f\xfc\x1c\xafb\x91P\xeb}\xf7\x16\x02\x96V,\xd0~\xcb=Z\x1dO\r+Z\xeeޞ\x91{_\xaf\xa9\xb6m\x1e\x8a\u070f\xfbt\xcd\b\x04\xae\x8f\x913\x88\x87C\xba\x92\xa3$\x10%\x9d\xe0\x96\xad\x01\x03!\xe4cJ\xbb\xb8\xd6\\\xd5\xed\x02\xd4(\xf7V=\xde<\xa8x\xae\xb5\xd5[D\x13\xd0\x02A\x1b*Q\x0f\xfc\x90\x88f\xef\x16}a\xa2\x04\x96i\x90\xf5\x9bo\xe3\xb1\xd05UJx#\x8c\xfb\xe9\xcb1o\xbc\x14\x05\xb6\xa0\xe6\xde\xde\x00\xe4\x1b;\xd5\xcb*\xb2\xb9%W3\xbc\xa1\x9a\x8fs\xed\xda5\xe5\xe9\x80L6N\xfby\x8c\xc9\xf8{\xdeL\nv\x9e\u009d\x8amǲ\x8c\xda2M\xd5'О\x1e\x94\xaa\x1b\xa3T~\v\xea̾\xc9/\xd3J;S\xec\x87\x14\xb3@~\xae\xe5\xb9\x05\xdbʢ\x02\xcbH\xa6\x05\xafV\x9a\xfe\xab\xf6\x15V\x99\xd9\xcb\xe4\xceֆ\x0f\xa8\xab\"\x8c'\xc2\xee\xd0\xf9 \x8fj\x9e\x882\x9d\x8d\x86\x88\rz\xa0\xc9~q\xfc\xc2'&WSC\xb6\xaf\xc6/D\xf4i\x10\xb82\xdb\xf5\x96\x06Р@?-\x9eΊQOXS\xd5\x1e\xa1\xcf\u05f9\\\xeb}\xd4(\xf0Ո!Ч#K\xc0\xba\x9d\xae\xcdN\xe4A\xac\xb3\xee\x93cN\xe0\xb2\xe2f\xf7?\x9b\u007f1\x8f\xa6=\xc4&\n\x82\x85\xff\x10\xa5em\xf0\x02\x02uI\x99\xa3\xa4\xc0\xb7\x00\xce*\x96\xd7E4C\x13\xde\x1cԋ\xb7\x98nXA|~\xa9\x95#OS\xca?(&-\xb9Ծ\x1c\xc3vGA\xad\x01\x86\xb4J\xfc\x1d\x95\x13i$\xf1\xfcfè\xcd\x01\xb8\xb7W\x17\xa6\x14\xaa\xbfΤ\xef@\xa7}\xbdC\xab\x15\xde6\xd1D\xde@[\x19\xaa\xe8\xd7\xe2\xb3\xd5n(\xf3\x8fp\xc5\xf8\x88F\xf5ۛwֶ\x01M\xcc\xd7\xe6S\xec/\xe3\x1b4\r\xa4\xf5\xe7xyF\xb7\x86\x9a9\xfa\xe6\xc7\xf3\u07ff\x8cеP\xf2\x13\xf1]ǣC\xe0|Wͣ\xe0\xcc\xfd,\xea\xf7N\x1e\x1e\xbb\xbe\xfb٠\u007f:<i\xe0\xaf^+<\xe2_\x0f[\xa7'\xed\x10\u007f\x9d4\x8f\xfbG\x0f\xa9\\+\x1a\x9c\xb6\xa8\\/::>\xc5_as\xd0h\x0f\xf0Wt\xd2\xefE\f\xef4\f\a\xf4+<==\xedS\x8d\x87\x83~kp\x88\xbf\x8e\x8f\x1eF=\x82wxz\x12\x86\x87.:2\xd2e9\xa9\x1b\xd8\xfdm5\x1a\r\xecq\xfb\xe4\xb0q<Ě\xed\xe6Q\xff䘡\x1d\xb7O\t\xee\xc9\xe1\xe9\xe1\xc3#J\xeb=\xec\x9fP\x9fN\x8fz\xc7\xc7m\xea\xc9I?l\xf3\x18\x87\xd1\xc9\x11ՍZ\xc3v\x8bF1\x1c\x0eOZ\x9c\xd6\x1f\f\x1aTnp\xd4k6\t\xca :=\x15iё\xf8\xd5\u007f\xd8l7\t\xf2\xc3\x10\xaf3P\x0fz\x8d\xa3\x16\x8d\xac}ڀ\xce\xd0\x18\x9b\x8d\xd3V\xcf=/\x1dY\x13G\xd6\x1a\xb6\x8e\x0eid\x87\xfdV\xbfMp\x8f\xc2\xc3\x13\xc6q\xfb\xb8\xdd\xe2Y9\xee\x1d\xf7N\xfa\xd4V\xeba\xfb\x84j\x9c\x1e\x03\xb2#\x9e\x8b^\xbfM\xbfz\xa7\x83\x87m\xc2\xc5\xe00j\x1cQZ\xbf\x1f\x0eZ!\xff:=jҬ\xf4\x9b\xed\x16\x8f1\x1c\x1c\x9e\xb6\x9a\x04/l6\x9a\xdc\xdai\xf3\xa4yB=8lFM\x82r8h\x9e6\xa9W\x87\xad\x064h\x8f\x8c\xec\xa9q\xff!\x8e\xea\xa8u88\x1a\xf2X\x8eO\x8f\x1b<#\xa7C\xc6L\xd8\xea\x9d\x1c\xd2X\x06\x83~\xafMs\xd8o\x87\x87-\xaaq2\x80i\xa5\x19yx|\xd8k1~\xfb\xadc\x9e\xa5\xa3\x87\xcd\xc1!\xf5\f0\xd7>\n\xcbi\b\xe3m\xf3k\xc0\xc1\xb2\xdd8\xec\xc0\f\x03\x1d\xe0,\xc1\xbf\x1d\xa4\xe9\xc3a\xf3\b\xbf\x1a\x1d\xa4\x8d\xc3\xe3&\xf4䨉_\xb0g\xf5#\xc0\xd8Q\xe3!~\xb5\xa0\xd1>~!\x94\xe1Q\xfb$:ůS\xcck\x02\x1a[\xf8\xd5D(\x0f[\x830¯6\x95<\xe9G=\xfc:\xea\xe0\x8ah\x85\x0f\x87\xf8E\xed\xb5\x8e\x0f\xfb0\xa2C\xaaw<\f\x1b\xad#\xfc\u009e\x9d\x9e\xf4\x0f\x81r\xe0\xeb\x04\xeb5\x06\xa7G}\xfcB(\xad\xd3^\xbb\r_-\x82\xd2\f\x8f\x86'G\xf8u\x8cy'@zC\xfcB\x98\xedV8\xe8?\xc4/\x84y\xd2\u007fx\xc2y8\x86\xb0\xdd;\xed\r\xf0\va\xf6\x9b@\xfaM\xd7\xe2\x19tq\xef\xfa2\x8aF8\xa3\xbdv\xef8\xa4\xd9;m\x9f\x9e\x9c\x10\r\x9d\x9c\xb6\x87G\x87<Sm@\n\xcd\xcf1\x10=\xaf\xc5v\xefa\xc8\x14{\xd8o\x1c2\xfd\xf5\xda\xcd\xc3\rs\xd6O\x12j\xad\x1d=\x1c\bjPu3\xc8'\xbd\xfea\x8fy\xd0\xc3\xd3SA\xe3M\xc0(s\x9e\xa3\x93\xa3\x87LS\x8d\x87\xc3^ۦӫ\xf98\x1ec#\x8dF\xbf\xcf˷\xd18>\xee\x1d2;8\x15Kz8\x94\xb9\x87\x87\x8d\xc6C\x1a\xfaÇ\xf2W\xbf?\x1c\xcar\x92\t\xc0ʇ\xff1\xbcӡ\x84|t\xca\f\xa9w\xd8\x0e9\xadw(s\x8f{\xd0r\x8f\xea\x0e{\x872W\xc2\xebE\xf8?\x86\x8c\xfd\xe2_\xfd\x87á\xe8\x9fh\rԇc\xfe\x15\x86X\x92\x98Y\x84\xa3\x93#\xe2\xdcc\xf8O\xf6\xb97\xe4r\x8d\x06\x8e\x93Gt|,G\x89\xe3t\xcfwݤ@\xbe\x1e$W\xa8T\x14\xeeTY\xb6v\xbe\x1d_E\xf2)o\xe1\t9`\xd5O\xcf\t\xf4\x8fժ)\xd4\xect\xc6 I\x14\b`!P:\x96}\x16\xa6Q\xf0m8\xbb\xac\x0fGI2\xad\xd01\x04\x80\x8d<\x14!\xde\xc5x\xf8~Ќ\x0e\x05Չ65-\x87\f\x14tN\xa2\xb5@\x009\xa1\xe2=h6\x1a\xd5f\xbbjuCs\x1d\x90&[\xa1+ҁ8jo \xf05\xbb\xc5\xc6\n\xa9N\xeb-Oc4\xf1[\x8d\xb7x\xac\x1f\x03\xad\u007f\a\xadvU4P\xad:\xd5\n\xd5\xe8')\xbb!<\x10Y\x0f\x9a̓\x87\xc7\r\xafڂ!\xb4\xedR\a'\x94q\x92KorFS*ͤ\x02-o:\f[\x9f\x9f\xaa\x9c\x00\u007f\xd1\xf9X5G\xb1FÂ=q\xda\x10\x1e\xd4O\xdbJ\x9dB\x1f\x85\xa2iٌ:io`\xcfi\x94\xe6Ew\xaa\x06\x15\xadw%j$\x96Br\xc6\f\xdb\xe80\xfb\xe55\x19t\x82<\xc1\xfd\x92\x8c#΄\xe9;n\x14\x9d\xae^%\xe3\xd9e\n\x1c\xe9\u007f\xf1:\x96\xfb%n\x19\xee\xb7\xe1\x14}\xc2&S\xfa\xbd\x80\u007f\xffw>\xa6\u007fG\x98>\xbf@7\xaeh\x02\xff\xbe\xc6[X\xee\xab\xe4#\xfc\xfb\"\xeaKv\a\\\x0e\xaf\x0fѽŎ;\x88@\x06\x00^\x92F\xf8V]\xda9=\x86m\xe8\xc1\xe1q\xbb\xdej\x03I\xfb\x1c\x80\x1bM\x00\x05VM%\x8d\x0fpT\u07ff{\xfe%\b\xfd?\x92!\xfa\x00\xea\x82\b\x0e \xd6k_\xb4\xb5\x88\xb0\xefE-mn&\x0f=\x83I8\xca\x01mԷ\x80$c\x1b\xe3\xf7L\x82\xff\x16?+\xdey\x06\xfc:\x8a>ذOv\x00\xcc%p\xba\xd1ث\xc0\rp\xba\fh\xbb\x8d\x9b\x00ip\x8e\x9d\xcbd\xaea\xf2\x10\xb8\xf7\x83\xe3\x9d\xfbE\xecN\xefW\x1e\xda\xdda5\xdbx\xcc\x03:a\x06\xf0\xb8\x01\x8c\xe5\xee\x10\xf3\xe0v\xc3ڷT/\xad\x98\x9dc(\x19\xb4掴\xf7\x96\xcbW\xbc\xaa\x9b\xba\x19\xc4\x1c\xb8;A\xd3Vf\xc6A\xf0\x8b\x9c\x1f\x8d3\x04Z\xbd\xb9\x83\x06LU\xe5dE\xd4\xdec~\xe6k\xed)\x06\xc8Me\x14\x1a\x14u\U000f03bcQ\x94\xaa\xb8\u007f\xeb9\u007f\x03\xa6\x87ӢW79\x9e>\xcaY\x82\x83\xb4n\xb0U~\x1aT;\xf0\xff^\x87\xee\xaaI\x83U\x14\x8f\xcc\xfd\xdf\xe7ѐ\xef\x03\xac\xf6/\xe7\xc8_\xd9I\x01c\x04D\x034T\xa8\xa1\x05\x81\xe0\x01\xe8]$K\a\x92\xddV\bb\x95J\x8b9\xaa5\x81+\xe1V/\xa0ee\x1b*\r\xed%:\xc3\xc9 \xe7X\x91]\x87\xb9H\xae\x82`.vij\xb7i\xa7~\r\v2\xcdwGRt.C\x92SA\x8d\xd1(N\xb5\\q\xb3S\x142d\x9f\xb5\x85U\xe2\xd6\u007f*\xa46\xfe\xcc\xd8\x13\x99,&\x01M\x13\x8e\x0et\x1cy\x0f\xf4\xaf[\t\x1c\xf5o\x92~8*\x15;8\xfb\xff\xd3\xc2\xc7o(y\xfc\x96bǟS\xe6\xf8Kี\xc0\xf1\t\xa5\x8dO(j\xfc\xf7\xcb\x19\xbf\x9b\x90\x81\v\xe9S\uf1b9\r&\xcb*ޔ\x8a\xf7\xb0\xa2\r\x8fZ3wX^\xd5&\xbc\x02a\xa5HR)ٳ\x8d\r\xfb\x16\xa2\xc5o#\xb0\xfd\xd6\xd8l\xfe\x19P\xf7\x9bHe\xbb\xf4\xf1\x8f\xc5\xf3\x1f&}\x89\xb87E\x92\x17g\t\x1e\xf5\u007f\xdf>{\x97q\x18q\xdf%\xec\xa5\xef\x17l\xa9\x83\x9f\x90J\xf7\x8f1\xf1qЌ\x9a-\xc5\xc9\x16\a\xf8Yu߹\xea\n\x88*\xf6\xd0(\xf5\xb0\xea>+(tl\x14:\xae\xba\xdf\x16\x14:4\n\x1dV\xdd\xff\xb3\n=j\xee\xed-\x1e7\xb2b\xc0`a\xb8Ѡ\xd2\xf2̒A\x10\xa8b.CQ\x95t\xdc\x16#\v\xcdo\xcdF\xeb\xe8\xff\xbe\xfd\xeaݛ[a\xadqs\xd4\xd0\xfe\xd3\x06d\xe5T\xdd7y\f4\x1e>l7\x9bǭ\x93\x93\x13\x03azz\xe1$4N\x0eO\x8e\x9a\xa7\xad#\xa3\x96L\xac\xba_\x15T9:m[\xadPJ\xe1\xd44,\xc8\b\U000f77dc\xe2{ңdZ\u007f\x13\x8e\xf0\xa2\x90yIZ˰\xfd\bؖ\x8d\a\xcf\xc6\r\x1f\xf3P\xbakz\x1bl8\x97\x0e\b\x96\xcc<\xe3{#\xf4q.<W\xf8k\xb52\nj'S\xc2f<\x1f\xe3\xe3d|\x1bW\x9c\xdc_D\xe3h\x1aΒ\xa9L\x95\x8e1ٕ\x1d\xf4gH&\xc2M\x8bT#\x82\xcf\xde_Z7\x95\xc31\x1b\xf9\xfd\x9f}\xd1}\x11\x9b\t\xbd\xe5\xe2GZe\xben**\xf3M\xc5\f\x1a\x85\xa3@@\xaa\x87\xe82\xa8\xf5\xea\xebtd\x95\xf7\x8d\xcfj\xf3\x9co\x8d\xfc\x1c4\xbb??*\x1fT\xf7g\xbb\x1b\xaa\xc9J\xf3\xa0\xbc\xde\x03\n|V\xd6\xfd\"Ĝ\x1b\xc7x\x01\xff\x11\xce.\x18M\xbb\x14\xa7ҙ\xc9\xf0e\xc0\xd8q\x86\x90*Z\x86ty\xbbI\xef\x8b>\xfbժ,\xa1\x8d\x8c\xa8\xb2\xe2\xadV\xea\x88Nye\x98e\xac\x80\x00\xd6U\a\xada\x19R\xa7\xab\x16\x84\xf2T\xb3\xe8.\xb0\x91\xf0\xa0\x85w4yě\xe7\xbd\x00\x9cI\t\x8ds\xafRo{%\xf4\x9ek8h1\x8b\xf8$m\x17\x14@\xba,\xebO\xb5\xba\xd6'\x9f\x1d\xe9\xb0\x1fj\xbf\xa7\xafm\\\x8b\xaf,=\xfd9\xbc\xc9\xdd\x10\xa4\xd4\xecr\x13G\xbd\x11A{\x96\x99\xfbM\xa7\xe8\xfe\x1a\x9e,}\xff\xdd7\xec\x12$>\xa4\x8b \x9dDQ\x06\xffւ\x19h\x1a\x8a\xf2(DO)`\x9d\x91\xac\"\xbf\x8d\xc8\n\xaa4]\xe5\x96E飨\x9c\xe2\xa7\xf2\x84\xec_s\xbc\xf5\xef\xad}\xf1\xb3\xa3\xd5\xf9\xbc\x1e\x02\"*\xcb\xf9t\xd4\xd1\a\xe7\xe3_\x8c\xec\xd6q\u007fN\x931\xe8\x97\xf3~?Jӎ\b4C\x1fu\n\"BNz~\x84\xdd\xe1\\\xfa\xa9孡i\xce\xd6\x1a\xc6H8\t^QH.*.\xe5:\xf8\xf8\x1d\xfa\xb5\x8a.\xa07\xab\xde%\xcf@\x83\x00\xad\xfae\x9c\xfe\xf9)9r\b\u007fnmj\xf8l0\xc3S]\xf7\xff}/\xeec\xb3\x93+á\xec\x8e]^z\xff\x91?`\x90\xfd\\\xad\x8c\x8d\x8e(\xad\xa2*땬K\x0e\xd9\xcc\xcbq\x89\xcet\n\xb6ԁ\xa21\xf2\xee\xd6ܘ\xf5\xab\xa7\xeaΫ8\U00094d07U6\xb84g\xd0\xfe/\xc2+U\xc0Eѝ\x19\x97\x17\x81Ty\x9eq\xc1Hޝ\xc5\xe8\x9e0\x95\xfc \xbc[r~=\xc8Ɓ\x8d\f\xb8\x91A\u0588\xc8\x11Mh\x97\x97r\r(\x8fr\x04\x14\xc8zz\b \x8a\xb4\xe5\x12\xbfp}\x97j\xf9\x04\xd2=\xefj\x91\x84r\x9d\x9c\x10S\x1f\x9cMν\x14\xfe\t\xf0\x17\xde+\xc0\xc3be.\"bX{;\xb1!~)\"*`E\"\xa7H|\xb2)\x8c\xa7\x98~\x1a\xd7SAV\x92\x8fRt\xf4\x9b\xc9\xeb\"#.\x11\x90d\x17\x12@]\xd6/\xbd\xe9Z\xf0\x00\x86\x9b\xb9FX\x86\x14\xf1\f\x86\x0fdN\xa5\xf1\xc8}4\xe4\vj\xf8}\x1eX\xdf \xfd\xf5\x92\x1bXl\xe8Tn\xe5\xd5)Gx\x13\xc8'?\xb4\x16:\xb2\x15\xfbޏ\xc6pL\xd7e\xd5@\x81\xdb2w\x97\xe3m\xc9\x11۽eߒh8\xe3\f^\xd37\\\x15\x89\x12\xb3\x1e5V+\xfc\xfbX/\".T\xa1\x15\x8a\xbd\xaf\xb3\x87H\xe4MᥖV4!\xea\xb9\x1d\xc0~\xbez\x9d}`E\x14\xf6\xc0\xc5@\xe1\xeeZ+Ǹ̍\x1a\x92\xa9C\xf0\x97\xfb(\xa3\x03\xd9\t\x1b\xba\xa3\x96\x06]\xfd,\x1a\x9a\xe6\xbb\x1b\xe4\xf3\x83\xb2(I\x83\xf8#\f\xb6\xe4*v\x06\xc7\xf5\xcah\x9a/_?\xbf\x8cG\x83\x8a쌂\aP\xe8El\x04\x89r\b\x86n\x8a\xf1fqv+\x86\x03+\x98\x8ds\x14\x0eh\x9f.A\x99\x93a\xcdۦ\xa2\x9fp>\xecfp\xe7\xa5\xfb\r\u07ba\x94P\x88\x88\xf1\x9f\xaa;\xb9qw (z<\xc0\xddu\x04\xfa\x84c\xcfe\xc4\x04$\x10E\x15\x05\x05\xeeF\n\x82'h\x05\xe21L(\x86\xbe\"\xe0\"\xbfh\x94\x85\x14b\xcd\xe8\x96NmY\xb7\xd6\xc3`PAc\fEݰ\xe0\x11c!\x14\x8da\a\xb5\xc9`K߶\xadb\xfbm\xaf\xed\xbd\xcb\x11\xe6z\xad\xf7\xcf\xe4\x9ft\xa9\xe6\xed\fÐ#\xadu\vr\xbf\x18\x0fؼ\x837\x81\r\xae*a\xfae\x8c\xb4k0Ҭ\xad\xc7\xf9&t\uea9a\xa5.\x15v\x96;\x04\x12\xf2-:\x04\x8aֺ`||\xd3!\xdfz\xad\xa8\xe1\xfc|i\xab\xb5\xa8\x02\xafޒZ\xd7EMS\u007fJ\xaae\xc4!\x82\x00\x19\\\xbe\x9c\xce\vJ\xe7VE\x11\xdbY{\xe6\xbd3F\xa9\xbcqUѶq¸\x88\u007fK\xd7|w\x11\xbdn⼇\x9e\x95U$|Yb\xd3\x16\x01\xecL\x9c\xa3ѳ>\xefp\rfqb̴\xd5\xca\x05V\x1a\x8fED\x99!\x9a\xeb\xb0\x0f\xdf\xe3\t\x9c(\xcf_\xcaU5K\x16\xd63K\xd50\x0e\xfe\x85L6A\xf1v\x1a\x03\xa6\x14p\xcb\x17`.\x9b\xe0\x03x:u\xa3\x9f]\xfbڻ\xa4qu\xeb]\xf8\x9e\x02\xf5\xb1\xe9<\xe0\x9c\xec\x029,\x83\xae8.\xb47\x869ml\x9a\xe3\xad\x0e\xe8`\xael\U000cf0d6\xb7\xa4cI\xfcg\xb5\x9a\xaf3ٛS\xb2\x1e\x9fe?3;\xd3:\x9b\x11\xf6kH7]\xed/\x1c#5\x9f\x9f&a6*\xc0\xb1\xa8G~\xb4Av\xf2P)\xc0\x8fg\x1eE0N\xd9<\xf5\x0e\xba\x1cd\x88ĜD\x8c@\v\xf1Φ\xc4~fDdr\xe9\u007f`\x97YB\t\xb5\xaeA\xe5#ήގ\xaaa\x1c\x13\x1d\xb4\xba\xa2I!xS\x9c\x88\x8e*L\x80:\xf8\xcfZ\xc5\xfe\x13\x15J\xc3\x03\x98\xd2Y\xfe\xf21\x88\x02u\x0e\xfb\xfb*\x19D\x82\x9d\x88=ٳ\"\xf2\xa8\xe8C\x121\xf6\\\xc3L\xc8\x01\xe4ZJ\xc4ed\x8d\xa9'\x1c2\xd5+\x15\xd75#\xde\xed\x84\x14\x8d\xe3\x15\xb6\xc8L\xb8D\xa4\xe1\xe7\xc4JE\x1e\x02h\xb2\x17Op\x8d\xd9h\x9b\xc0Be\xec\x06)Qef\xf2SB\xab\xab\xae\xdc\v\x94\xff\x90\x1c\a\x1d*jR\xb5&+\x10\xac\xad\x12\x85\x12\xbb\xa8\x9c\"\x13\xa2>\x99\xb7\xdeq\x8b\x90\xf6\x95\xdbl\x11?\x94\xec\x0f?l\xde\x1c\xf0\xab\x17Mg\x97ߡ\xcaYo\xee\x14\x04\xabx3I\xa61\f\x925N\xb6\xf3e\t\xb0k̒\x89\xd83&\xc0\x8cF\xe9\x9bhJ\v\x98\x8a\x1aI\xab\xd5I[\x9da\x10yx\xf2B7|a\x89Tۙ\x8c\x92\xb4\xb8E\xf1\xec;\xb0\xf23\xceJ7HU.~\xadVG\xb7\xdf\ne\x17\x94R\xb0%\xda\xda{\x19DD]OV\x97L5c\x9b\x19\x90\xcbh\xa04 W\xd1\xc5\xccm\x979i\r\xb9\xbe\x15\x16\xccQOxZ\x9ai ;\a\xcc\x1d\xf9\xbb\x11i\x8cم|\x9b.p\xe5\xe3t\xee]\x82\x98i\xf1\x1c\x11\x17\xdaҁ\xcf\xf5mWҭn\r\xdf7\xe2\x9f\t\xeeY0w\xc6\xe03\xeeoM\xe3\x1d&\xe7A\xa5YU\vS\x04\x0e\xa2o\xfdҏV\\\x95=hyESB\x1c\xbc\xd6\xe4r,\xb7\x96mt\xea|\x87pK\xe0\xef\a\xc6\rn\x12\xbf=sF\xc3\xf9,\xe1P\x96\xdcY$\x1e\"ߏ\x17u\xfc]\xf1D\x90\x13]^\xf1\x04\x93\xa8\xd8\x1c\x04D\xef\x1b\xb1\b\xbfdW*\xb5\x00\xf9[;=\xb8Q\x87\x137kO\x9dNi\xec@\x81\xe2\xef\\\xbe\xb6\xe0\x03\x9bӬV6\xbe5\xb4\x1c\xe4\xf9\xd9\xedfj\xb5\x127\xba\xf0\x15AܝT\xf7un\x1a\x10\xf3dֽ\x10\x1e\xb0\x1a\r\ty\x8e\xfb\xc5I]\x05\x10\xea\xe2O<\xf9\xaa\xb8U1\xfb\xbe[\x15p\xaa\xae'\x8eӷ\xd4p\x1aPPv\xce\xe4s\x1f\x89\f\x90I=\x1d\x8d*\xee\x03z\x80\x85t)q_\xfd#K\x9b\x8aae\xecJ\xb0\x9e37{%V,\x02\x93՞g\x11YeU\xd5_\xa8 \u007fz\xe2a\x0f9\xdf\xdaT\xa7\x1e\xb3\xf8y\x0fćx\x80\xfeB\x8a\xe7W\x8c\xfd@,6|^\x97XE\xd1|\xf0\v\xe5\xee\x93f\a\x1d\x92\n\xd0o\xb0\xaa̓w\xd5S\xbe\xee]\xfb/\xfb\xeayFl\r\x11a \u05fdu\x01'3\xc3Y\xab\x9dhY\x10A\xc1\x88\u007f\xba=\x86Bi\xf8\x84\xbb\x04@Ȏ\x86\x90\xdf\x18&\xba\xdbGcX\xeb\xe1\x14*\xe5\xf1\x14\xece\xef\x99\xccy\xe7X\vG\x8d\xbb\xc5H\xd0Ĺ\x1fKļ\x1f\xefx\x1e|'Q\x8e^\x14\xfd\xaf\x15\xe6T\x05\xdeh\x82\xf2\x8d'\xf3\xddX\x88\x895D\xe9\xdbJ\x85w\x91\xf7Jd7~\x96\xfdO*\xb8\x95\x99\xa7v\x12\xe3|ѓΝ$\xb8_\xcf\xcd\xca\x04\xbeO\x11\x1c\xa6\x9c\xb7\xdd)V\xccn\xc1bl\xe6\xf6\xc0$䝙[\xc9&x\xb7`2\xc8`\xcd~\x18\"\xb0^\xd2\xean\xb1\xfc\x84үk.@A\xf1 YYb1\xfa\x9a \xb9uJEbnْ\x89\x19\x0f\x1b\x84\xe2;\b\x99\xdcP\xa9\x94I\"\xb6\xd8ʧ\xe15n\x04z텔\x060\xf3+\x10\x11H\xb0\xd8QD\xf0\x15\xc8\f\x0fZ\xfc\xc3R\xe9\x9e\xcb\xec.\xd6[\xdc\xf6n\xf2\xfb\xc6I߉l\f!ܔ\x803\x82E1XIĿ\xa98,\x1e\xd8\xff3\xc8\xc2\xf2e\x14\x80#\x88\x02IIc\xbfHS˭\x123\xcb\fy\x81\x99\x10{;yyA\xf2\xf2'\x10\x96w\xf5~!\x01\xab\xfe\x16){P&\u007f\x89\xec\x121\xac\xb0\x8a_(\x9c\xf1\xab\\9\xe9[\xf8\bC\x1b\xf6\v\x04\xd9S8\xec\\\xe6rO\x1c\xf1,o\xeaP-W\xc6$'\x10\x19\xb4\xae\xd5\x04b'\xdfB,2\x88\x95e\x9b;\xa5\xa9jk\xee\xba\x10i\x8b\x18\x88\x18\x9b\xceG\x06?\xcfS\xdc\\\xa9[Y\x0f\xeb\xfdd\xb2\xa8\xc8\x10\xbe\x9c$\xccي$es:\b\xaf\x90F%N\x89T3^\x1e\x0f\xbc\xa5\x04\x82Y\xeb\x1d\t\xe2Yt\x19~\x8c\x93\xa9\x8c?\xf95\xd0\xf6\x887\xde\xee\xaeEoaX\xe5\xd7\xe5\xe5\x1b\x9e\xf8\xbb\x9b7⒓\xd8\xdbp\x18\x05\xe2uI\xf6\xa3\xf8\x06O\xfeQ6\xe1S1\x11\xb9\x94]\x80\xd9\rOO\xd2d[\xf6\x0f\xa3T\xfdr\x9b\xe6H\xfb\xddEO/\xe5C\xee\x14\x9202\x1a\xfe\x0f\xb8S\xa5~\xea\xe9\xef\a)\u007f+\x85\x05r]\xd1\x0e\xb6F\xderT/wm\xb9J\xe6i\x84\x1a\xab\xe9ނ\x82\xb6\x1a\xad\x14\xf5\xe8\xfe\x80\x86\x84\x11[\xe6\x19\x81t\x9a[p\xb4\x82\xc9Z\xdc\xdf\x11\xac\x04\xf2^X\xca'p\x1e7\xf6\xf6\xb4\x93\x00;p\xee\xde^\x85ωy\xb6e\xf6\x93\xa2DY\x87\xe4\x03#\x10?\xfb5k͈\x9a\xe2l\x90:Rkv\xef\x11T`\xb3\x17\xf8\x10\x87\xac\xa4\x00\xe8ΕyX\xc2_T+\xef7\xf1\xf8\xbf\x9b/Jg\x18\xc2\x13S\x1c\x96\xad\x15\xb9\x9d\xe9C\xe3\xf8\x9d\x1b\xf2V+=\x89\xdd\xc9s)\x81A\x93\x95\\>\x1e\xa6i\xbdT\a\xea\xc2]\xa8\xbb\x9d\x82\xe6\xb3\x1c\x01\xdd\xdfNA\xb4\x8cv$\"\x83|\xf6\xf6h\f֓\x15\xae1s\xaeA\x01\x05\x136I&\x15\xaf[:\x91yZ\xf0\x1b\xbe|\xa7a\x10\xa1{\xae\x93/\x83\"̆\xe9\x82\u0558\x9b\x9c\r\xc5כ'f\xad\xe44Ɵ`z[\x90)T\xc0<׀,\xf4i\xbd\v\xc3~=\x1d\x987\xe06\x14\xfb\x94\x8c\xdaV?\xeb\x9f\x17\xed\xe5VT՟\xff\u007f\xf3h\xbap\u0099\xaa\xe4\xae\xf3\x80\xea\xf3xwX\u07ff\xd4\xc1AUw\xfd\xb9\xaet\u007f^1\xc9<\x9d\x81x\x9fLg\x14\x02|)\xdeO\xc3\x15\xd6qY\x05\x06\xb93\x19ES\f\x80\x05I\t\xed\x12\xae\xcf\x04\x90m\xc3\x11Λ?\x8f\rJ?;\xef\x165\x87ݭ\xb8\xa3\x18ļ\xc8 \b~q\x8c\xdfE\xc4u\x8b\xbfL?q#\x80\x97\x9e\xbd\xf64\xef\x83R\xf6\xda\xecƏ\xd17\xa1V\x13\xa4\xa7\x17\xc2W\x19\xad\xf8[E\x14\x0f-\x15\rJ0\xb6\xb7\xa4\x1d\x10\xaa\ro\xfcB\x03\b\xf6\xf6\xb2\xd4\x1c\xa1\xb7\xa1\x8cEB\xff.\xc8\x13J\xafP\xe7/w<`\xb6\xd7\xc5;r\xd5ܾ~\xdeYO\xbd\xfd\xfa\x05$\x05\x88\xa7\xe3\xfe\xa5~\x89\x89Y\xaexf\x18s6<Y\xda\xe5\"\x9aw\x80\xbb\xf7Y\xb3\xd1h\x1cu]\x99g;\x18\x8a\xc8ʼWe\xee\x99@\t\xb3g\x11\xd0TTኾQ`\x18O\xd3\x19\xb9\a\xa8F\x931\xb9\xe7\x06\xd6\ue8f3R\x15^\xde`\xbe\xd1X\xc4\xdf7Z\xc8{\xaf\xc9\xdan\x16\xbfY#\xb0[=<%\x1f\\\x0f\x9ara\x15t\xb3\xbcG\x849\xad;k\xedZ\x8d\bo؋F\x81Q\x1b\xe8[LU\xfal\xf1.\xbc\xa0G\x04]\xa0J@>\n'T\xa5\x10\x89\x9ax\vj\xf7\xeb\xd9e4\xc5M;\r\x8aP\xcb\x175\nJgO\xac\x8b\x9b\x8e\xb9\xdd\xde~\xacy\x14\xe4\xca\x00\x8f\xe8\x9as\x8ar\x80dBKu\xb3u\x94\x9fq\x9e\xb3\xc2qPx\xf9\x1eP\xf3\x8759\xab\x16w\xffב\xccNҍM\xb0\xf9\xc1-G\x05D\xb2\v\x85\b\x9aݭ\x17\xa3\xfc8w[\x17\xa8\xe7\xe5D\x91lG\xfd\xfc\xbe\xf9>\xe0\xe7\x15SL\xd17C\xdc*\xcbs+\xf8\x14\xc5tV\xb4\x01\xcecz\xbc\x94\x97\x84;N$M\xebr\xe9G\xf6/\xff\xc8O\xcf\xd3\xdf\x17\x1ca^X\xc2gɤ\bv\xcaq\x04\xf0\x85\rm\x1f\x91-\xceǹ6\x01V\xab\xdd \xfc\xdfN\"c6̂\x18[\x15!Q\xee\x029\xdf9\x93\x05\x15\xdc\xdfR\xf4by#\x94m\xd5ș\x84\x98\x93\x8cG\v'\x19G\xf2\xd5\t\xb2\xfd\xad\xb3\xa5\xc5˧h\xab\xeeJ\x02\xd2\x1b\xd5*\xf2\x1b\vś\xbc<H\xd5Fm>\xfd#R\xe7yd\x98uv\x13c\xbfF\xd5\xf7E\x04\xc2\xd7(\xbf\xf9j\x99\xb7?\v\xdc\xe9\xba\xd6͗\xd2}\x8e\xb3\xb2\xefBg\f\xe5bwC\xceuf\x04=\x81\xb9\x85\x05rQ\x04R;f\v\xf8\xb9\xb3'\x8b\x8e\x11+\xc0\xf0k4\x8e\xbcnq\x13\x83\x02\xff\xb8\x03F\xaf:\xbc\x88\x912\x88xv\xf0\xfd\xe3\xd5\x13\xa6\xac\xa1\xb0¨f[*\xa1ivq\xf2\xeder-\xef\xc4\xe2o\x99\xfeu<P\xd7j\xf1\xb7L\xe7\x97\x1ad\xcewڛPC\x13\x8f\xc3\f\x8df\xf6\xba(Ēx\xef\xe8\xc6_\xa8\xdc\xc1\x0f\xd9\xcf\x1f}=ʔR\xf7\xaango\xdcK']\xb7\x9a\x15]\xe7\x85~`aA$\xfa\xa1p\xc3wh\fǪ\fo|\x912\xaa\x03\xf3\x04\x81\xa0>N\x06\x11N\x8e\f\x88\xf4\xcf\xca$\x9c]\xaeҏ\x17\xab)\xc8ѫ~<\xed\x03+\xfe\xfc\xc0\xb8\xabn\x933\xd3\b\xc2\xff!\x88īD?\xacV\x11>a\x14M\u007fȲ\u007fT\xd9?\xaa\xec\x1f)\xfb砡\xbd\xb0#]x1\xc2L\x94\xce\xde`b7\xcf\xe8\xb6=3e?\xdd%*k\xcf\b\xfd\\\xad\x9e\xab\xab\xa5\x82\xf5\xe9#eW\xeb\x1f\x02\xe9\xf6\x1b\x8f\x81\x13\xccxC\xf8\xc1ӊ\x90\xcaϖ\xd6\rϬ\xc9g\xf8\xe8\x1au\x9d\x1f\xf8\xaa\x91m\xa8~\xa3?\xaaf>\xe1'N\xa4Ч\xfc&\x86\x89\x8a\xd8\x1e\xc51\x9d1\xc0M\xc5\xee\x83H\xf8\xc1\xe3+0v\xcd\xc0\x80\xef\xd9\xf9\xb5ZW\xe2Mk\t\xaf\xdf\xd8%W+\xab\xaf\xba\x16h\x17\x06\x99\xcf(\xdbe%\x93\xdf\xf8:_\xad\xc4/\f\x00@\xb2\x18v]d\xd6o\x1e\t\xaf\xf7\x1f\xf6\xf6T\xb1\xfa\xcdc9Υ\xd5Y\f\x05#\xf2j\n\x86\xf7\xa8$\x8f`yO\xe2\x0e\xfc\x12r\xa0j\x9b\xf2T\xeb\xd0\xe5jUH\xff\xb5\xdaZ\x16\xa3\x96\x1f5\xbc\xac\x17\"\xc26\xf9\xdb\x13 \x95u.\xcdų,2w\xfa/ *\xfa5I\xae+\xaa\x97\xd2!\x9c\xc0@\x0fk\x82\xee\xfc\x96W-+\xbd\x10\xa5\x17U\xf1\xb7!\xaa\xfd\x88\u0558\x94\xb4\xddF\xac$}\xbf\xb16$~E3Wca\xd7X\x985h!\aˌ\xe9сb'\x83\xabF\xa5qC.\x93A2^\x87{\xa2\u007fȕ(\x06\xe9u\xe4\x0fy7_0^\xbe\x9c@\xff\xfa\x12\xe7\x1d\xf9\xc3O\xd0\n\xd5\xf9٧\x98y\x1a\xffe/\a\x9d\xfb\xacV\xb2\xd2#=\xb9.S9ȖL添\xd6\xcc\xcc\xd8XB\xbf\xd5#~6x\xc9s\f\xd8\xe2\xa1c\xda!ufd\x14\x12X\xa4\x02\x16\xb2͂V\xa6颢)\xecn\xa9\x8b\xab$G\xb9\x16\xf8~\x82\xbe\x9b\x8bWn\x84O\x89x\a\xad\xc3\u007f|\xde\xfe\xe5\x17Y\x8b\u007f\xe80E\xf3\u05cf\xfc\xf5\xa3o\x13\x8d\xf5-\x9e\xa5\xfc\xa1#\xfe\xa2\xc0~\t[y\xc7\x16\x04\xa5\x8c\xc1r\xe6\xa6g\x9b㱺\x82\x9bi+\xbaĐ\u007f\x04\x8a3(\x84c\n\xf2Ei\xe34}%mK\rjS\xf3$\xc8\x145\x8f\x19Լ\xedSQ.w\xea[,\xfb\x93\xd1om\xc1\n\x92\xb5\xad\x18\x93,\x90\xaa \xcb,x\xa3z\xc7NТ\x94$\xf5}Ԧ\xccI!I\x1a%\u007f,,\xf9\xe3\xaf%^\xbd\xa77\x82\x84\x89'~C&\x93\xcdB-\x17\xd2eڛ\xf7d7qeV֗\x8d\xebM\x17q\xb9\xa6x2\x11\xd4\xc8-} MS\xeb\x01~\xbb\xfa\x81\x14\x8fP{\xb5\x10&l\x1e\x8e~\f\xb62Sc\x16\xbd\x8e\xf1\xc9\xedf\xc33e_)\xe7\x1a\xd8\xf5E\xc3\xf6z\xb6\x19>\xd7\x12#\xcb\\\x87tw\x1b\xb3k\x8d\xaa\xd9S\x8d\x11\x15!\x98\fڂ\x83nS[\xa0\x84\xa1\xb2$3\x97Ҳn\x99\xbd\xd42{\xb4\x9b\xf0\xf9\xafq\nW\xda1\xa8\xab\xad\x1e^W a\x14L\xb08+s\v\xfa'\xb3\x84\x0f\x021\x85<\x17\x11\xeaM\x11\x1f\xe1,\xf9\x02\x9co\xe8S\x9dbCÖC@dj\xe6)`\tG\x14\xfa~\xe4\xe9\x06Lyָ\xc5x\xaf\x030\xea\x1b5\xefp\\\x89\xf7\v)\x94\xd9;ґ\xf0\xb1w+\t\xe6\xf5\n\x0fg^\bRz#\\;+\xf9\x86\xbd=\xba\xb1\xf8\xe2\xf5\xf3\xef\xbf\xfd\xe2ջ\xf7o^\xbf}\xf9\xee\xe5\xebW\uf7ff~\xf5\xee\xe9\xcbWo=1\x92K\xb1\x99\xe4\x11\xb1\xa3\xe7\x04\xbe\x8a\xfe&o\xbb\xa0\xe4\x1d]g~\x0eo\xfc\xe5ݣAM~m8\xa8\xdd\x06\xfa\r\x99\xd0\xf2#\xe5\xf4\xa2;y\x85\xe6\v\xc3Ix\x9b\x91\xc6<*)\x11\"\x94\xa3\xf0H\xf6\x90\xacؐ\xcfP\xe9W\x19\xfb\x99\x8f\xdc\xe2;\x92d\xf7\xd4\xf9\xbb\xa1\x02\x97\xbd\xa4\xbe\xd6\\^\xc7!\xa4\x85#:r\x95O\xdc\x04\xcacB\xbc\xa0%̓d\x0f\x97W։\x13\x8c5띮Vo\f|\x81\x87\x8b\xdd,\b\x003+\x8an\xd1-x\xecwi\x96\xac\x06\xaer\xa7q٥\xf9\x1a\xcd\x13[X7\x17\xd2\x1b\xe4\x14W\xe6\b6\r\xda\xff\x05\xa9\xcd\x05\xac:\x17\x14\x86\xab\xea\x87*e\x9d\xe0\x93\x14q\x8c\xa2\x8f\x9a僑%\x1eh\xdaF\xbe\xd5\x11K\x04#\"-\x83\x160܇~@\x90E\xf5\x92\x00\x93o\x84\xd9]\x8b\xaa`E\xfd\xc1\xc1P\xac\x91@\x86X\xe9\x90w\x8e\xa1:\xad\xbb\xd2<\x9c^F\xa3\x8f\xf1\xf8B\x18\xa7\xe5\xa7f\xa5~\xcf\x1d3\xb3-3-\x90\x98\x04x)\xbd\r\x98\x1e͔\"w\x04\x86\xcf\xc7\xc1l:'\x9dJ$o\x8a[&\xcd\xe9H\xc7\x15:\xa6.\xdfQv<\xb2\xe5\xe0\x1a\xa3\xb8\xd0\xcfA˼\x9b\xa9\xf8\xd7\xf3*v\xbf\x9d#\xbdl\x1am\xf9\xfe\xb9\xf6\xa9v\xc7:)/\xeaY\xb99PDP\xe0\xf6q\x9fx!\xc3'X\xee\x10\xeah=%\xacU\x96d\x05#\xcf-\xff*\x1ewT\xb4\x03\xff*\xbc\xe9\xa8p\t\xac٧\x9d\xb3,_\xe5\x9d\xfb\x04\xab\xe8\x00\a\x03d\xc7,8\xa6P\xf2Q\x90}5\xd4U~\xe9>\xab^\x946J\xd9\xd9\xe1M\xa0\x83\xec\x9aG\x19[\x90\x81\x06$9\x80\xc0\xec̲\xa0\x1b\xf2\xecl\x9d\xd5k\x1a\xf5\x9a\xf9z\xd8?U\x8f\x03\xd5\t\x9c\xa3\xddQ\x8f\x04\xa3\xb9\r\xb3\xbf~\xce\xe2|\x17J\xe0\x9e\x05\xf9\x99v\x93\tI\xa2\xbe\xcbE\\o+唃\x00Rq\xfd,\x84\xc6\xe6\xb2\u135bQ\vMB\x1e\u05ec\xc3.\xd5|da7\xd6\xf9\xf2\x80c\xb3|\xf3<\x8b|\xb2\xdea肜w\x95\x81\xe4\xbb\xd2\x05\fH>\v\xfd)/\xad\x81Ȝ\xaa\vk\xe2C\x98\xfdė.s\v\xe7K\xf94\xb5\x95X\x14\f\xb3\xfe\xbd̕\x8fig5\xa5\x1b\x8f\xfdV\xb5حބ\xb3\xcb/A\x05J\xa6\v\x9dLe\xf6\xdb\xd94\xf9\x10\x15\x16\x90O\x8a\xeb\xa9\xcc\x00\x96bP\x9d\xfa\xa9\x9f\x12\x00\xba\xd0\xd3i\xf9\xba_\xa9?\x81}\x056\xba\xce\x12\x0f\x8b덦O\x8e\xf8\x9d\x86\xcfWX)\tm\x0f\x9d\xc6Z\x80\xe9\x90D\xef\xe3\xa3\xfa\xfc\x13\xf4-&\x93N\xc1\xeb\x91\xdaA\x05ِ\x8d\x8b@Zf>\x9db\x9a\xf2j\"G\x196J\xea\a\x1f|M\r\bY\xcb7\x1e\xf0\x16\x98\xb8\xe9\x9c!]\xd3\x1d\xacs\u007f\xa1\u007f\xadI\x8e\xa0\xb5\x92\x01\x11\x96HZ\x11\xb9ԅUvQ\xd5?\x1a\xa2\x8cY\xd3.\xa3\rb\xeb3\x8eE\x11F\x91q\xa2\x89ʶPa\xbc\xd1Eu\x80M@\x91\xc5#\xec\xabG\x1d^P\xc2c\xec\x98G\xbd[\xb0p-\xbc\xd6\xf0\xec\xe6\x11b\xc1#Th\xa9Z!\xcb\r\x0e\x8f.\x10E\x1eᩴ\f\xb4\x830kA\x05\xcbը\x11\xbe\xe0!H\x8fL[]̬\x96\x97!\xaa\xec\xd2P4:\xb9B\x16\x17\xb8x\x8b\xca}\x82\x99\x1d3\x13\xaf\xcf\xd3h\xf5dds\x19\x91<\xc1\xfc\x8e\x99\xdf5\x9d\xa2\xf4VV+l\xe6Q\xc3[.xPX\xbd\xb6\xc8w\x98\x97\xcfڂd\xb6\x8d@h\xd4e@`M\xae3\x1aF\xb4\xf8\x88 \xa2a,\xeec\xc5\xf3R\xeb\xa9y\xe7\xb1to\xcb\a\xd8]\xadt\xfd\x8c7\xc0X\x94\x80\x1f2\xfb#\xde-(\xbfͤ\x0e\xe0\x82۹\x95\x95\xa9\x83\xcc\x16D\xf8\b<\xf4M\x03\xabu<\xf7\x85\x0e\fT4\xe4z\x84\x9e\xa6\x15\xf3~\xac(ŗ\x86\x06\xe2\x0eU\x8e\x11W\xc49\x13:z\x95\xca\xe6b\xa9\x16\xa8\x83\x96\xab)\xc0\r\xa8\xcbȴb<\xb0\x15a\x99QrawH\xe5B\xef\xab0\xc9Yf\xa7P}E\x1e,\x03x\x8dFOt-\xb0\xc3j\x12O0\xf1my\xab\x10\u007f\x17\x16\xd5\xfa\x8a7\x14\x9f\x02~\xe2\xde\x1c\xef\xb8!t\x978\xbe\xb7\xa1\x14\x83v\xc56\xb1\xbddM\xbf\xf1\xa9mQ\x9bj\x8a;^\xb2\xfbRC\x05l\xa9\xadu\xf3*0C\xe5\xb2!H\xec\xa10\xe3\xf9)\xa6\b\xfc\xca\na\xb9\xe8c&\xcb\x1c\x14\x91?P\xbf\xf4\xddLOȚ\xa2T-\xa2\xacn\xe9\xb4J\x01\xefIz?\x03\x8d\xbbv\xa4ߢ\xd2Ec\xd0\xfax\xf6ᜐ!?\xee\xeb\xbcP\xcf\xe8\xe8U\x8cRzF\xa7\xa8\v\x90.\x89X\xdd\x05㑨\x06\x8a\x9b\xcd\xda,n\xb0ca5WJ\xcb+\xec\xd9Z-.\xbc\x82\xabIE\xd9\xf2\xd2\xe8\x90\xd6u\xf6}\xdf`\xdd6\xd5\x06\xda\xef5\xc1\u007f'\x04\xb0,\xf82'\xb03!\xff.\x80)\xe5T\xf1w}Ki\xba\xfe\r\xc7_ݔ\xbd\x9b)UV\x91ϱ\x91\xe9\xab@\xe2\xe4+r\x8aO+(\xd1\xcd\fy\xae\xb8A\xe7\xf9KC\xf0\xcc\xe4G)W\xf2=\xe8-2q\xe9.6\xe4\xb2\xf2\xd21v\x16\xf8\xfeM\xa5\xe0\x8d\x02u\x06\\\xbf\x01E\xb2\xbe(/\xb3@9\v\xcbh\xf7\xda\xf4\r=K\xc6y\x95SW\xd1\xe7\xd1늞\xd5\xc54\xef\xedY\t\x95\u0087\xde\x16\xf7Y\xbe\xcb\xc2B\x8aj\xb7\xa5\x87\xb7\x88\xf3\r\x04A\xf9w\xa3\b\x9a\xce_E\x12D\x05D\x0f\x86r!)\x85\xf5\x8bOE\x13!\fjW\x9ahl!\x8a\x06\x95jn)U\x95%\xff\x83\xe9\xe7Y\xb8AC\xc7ܻ\xd1N\x0f\xdf'-\xa7\x1c\x92\x1dEnPN?\x17ᄢ}\xd4\x1bm\x9bh\xe4\xad6\tEi\xf9\x12E\"}\xedo\xbdf]\xf0\x8c\x93hXX\x19\xf8C\xee\xfa\xfc\xd55\xaf;\xdb*\xb21P\xb6\x16\xc9a1\xed\xd2\x19\xd6x&\x9f\xeb\x17\x81\x1c\xec\xe4J\xb1:\x9d\xf9\xe5I\x0f\a<\x16\xc6V\xea7g\xcd\xf3j\xc0\xaf\x93Ulp |_\x006\xe6\x83H\x91\rW[\xfb0a\xf6^\xa9\x8b\xa2;vW\x1e\xd9cWE\xc8\x0e\x01\xd7\bTk\x94#E\xb4Z\xde\xd5\a\x95fM\xc7|v\x05\\\xc2\xfe3kH\xe6\xf8\xe5W\xc5r\x0f%{\xa0(\xfe\x83\x88\xf1\xd6\xd0.r\xbf\x157\xad12\xef]n\xf1h\xe3|ftH\xac\xaa'\xb2c\a\xb9\xf6:2ˊ\x92a\xbf\x89r\x15\x82\x18\u007f\x13\x9c5\xfd\x06\xfc\x0f8ԣƓZ\xb3\xd3T\x1f\x82qf\x13\x9b\xf9\x05\xe26\xec=hu\x1aRfv\x19Zŭ\xf2\x0f\x11\xf4\xc2w=\n\xbd\xf1+T\xb6\xads\xb2\xa3\x02\xaaSpn.\x8a9u\xb1\xbe:%\xd1_\xe8\xab7\xdaQ\u007f\xf1\xeeU\xcd(d-k-6\xd4\xe2\xed\xacj\xa1\xba\x92͏\xb7\xb6\xa2P\x9adb\x87\xe9)o\xa8tb\xd7\x1b\x03\x95t\xf9\xf1\xb1\xc94\x99%\xa8&\xc9I\xe5\xf8\x1eR\xab\xce\xda\x05\x01{\\\xa8\xc0ڗĥqF\x90\xb8\x97!\xae\x1a\x98c\xcctr\x9b\v\x15\xd9<c\x91G\xab\x83^\xfa3o\x8d\x99\x1e\xc4ꪘ\xac\x16hN\xbb\x99\xe3oׄz&?σ\xb2\f\xb4~\x95\xe5U\xab\xebB\xae\xbd\xa4\x80\u06dd\x86\xaf\xa6\xaaӴ\x95f\x13f\x81\xbe\xc9GT9~M\xa0\x1f\xd9=:\xf7\x96e\xbd\xc8\x15\xd5z\x15\xaf\xb5\x80\xe96\x80ۊ8OAb\xd9 \xe3`\xf6݄\x1c\x948?\x89\xcad\xdb\xdc\rq\xf9\xbfM@\xfe\x03e\xe3m'.\u007f\x80\xfa\xf9\xa7CQ>\xfcY!V\xb2pM\x9b\xa4\x9f\xabhv\x99\fLQ\xc3\xe5\xdd\xcf\xed\xb8|\x85\xda\xd56\xdcB\xa8\xfa\xb6[,\x0f\xab\x9d\xf5\x8c\x1b<\x97\xc1\xa3|\xf7\x02V>\xc1\xbe\x9b\x91\xd8\n>\x152+\x91[\x8b\xb0\x85.oՀA}\xf9&\xf8\x81\x9d\xb5\xdcO\xb6\\\x9b\xfc\x03M\xd4ٛz\x04]6\x98Ű\xd43e\x9a[g\x14\x8aA\x17\xec\xdc9\xe4n\x03\xc8\b3\x00\xb2\xc9[\xa6)˵\x14\xd9\xf0s\xb5*\x8bg\xc4\xfd\xf0\xddވ,+\x95z\xb3\xd5\xf6LX\xa5\xb6\xedu6\x11\x99\xf5zy\a\x83\xf7\xad\xcd@}rr~3Jf\x9b\x8cAY\xa9;\x9a\x84\x18\xc0\x04\x9b\xf9\xb4\xb6¼\x95\xa8\xe4\xec\x1a\u007f٧\xd7\xf0\x03Up\x0e\rz\x84\x1b\xe6V\xa5\xdfT\xdf\xff@\xfd\x91}\xbe\xc9\xda\xc06m\xfeبX\xfe:\xf5\xe7\xf7\xd3m\xf8nf\x16\xc2o\xbbz\xa3t\x85\xfeV\xa5&+;-*\n\xa91\xf4\xf1ɠ>\xed\b\x9c\xae\u007f3eC\xb2\xcc[\xae\xdaoa\xf9\xc4\x1b\xd6+\xe5\xdfm\xa5^\x11\xe8ۮ\x83\xdf\xde\xfc_f(\xdb\xe0\x1f\xc2Uԍ[\xf4\x8c\x9d\xc8ȧ\xfc!\xefT\xbf\xa7\xaa\x15ND\xcfB\xfc\x9b_&\x94\xaeݼ\xa5\xef\xfa'>\xc6\xdezeW\x1a\x04\xf9a_1H\xf6\x1a\xe5\x0fO\x1c\x86\x93\xd3\x06\xecVx\xbdU\x163\x9aח\xe4\rz\u007f\xc9K\x94\xe8\x1c\x81\x15Û]*6U\xc5\xc5mZ\\h-.n\xd3\xe2B\xb4\xb8\x93\x0f\x84\x98靤u\xe6\xe6_1\x9d\x00\xe7\xfe\xb52\x14N\xa5\x0e\xf3̊\xe5w\xce}\x01\x12u/g\xb3I\xe7\xe0\xe0\xfa\xfa\xba~}XO\xa6\x17\a\xadF\xa3q\x80A\xbb\xd56P\xec\x9e\xfd\xeame\x9c\xb2\xbc\xaav\a\xf6\x812\xfc\xab?\xca\xf89\xb2q!3\xbf\x97\xdf\xf9\xdeu7\xf6=Xʟ\x1d\xf9CzY\x9f\x9d\xfb\xd0\\'\x13\xc0>\x12\x8f\xdb\b\xae0l\xa0\x9f\x85\xfd\x11\xab\x17\xd6\xf2k:\xf5f\xa3\x83\x0e\xb2\xec\x98^\xd5\x0e\x8c\x0e\xd0I\xbbX\xe9\xfc\xf6?\xad\xeeL\xb3\xe1<\x92fI\xd76\xb8@\x8a\xbe\xa8[\xb9\x84\xbaְ\x89O\b2\x92\xcc\xc2$\xa5u\xde_\xc6\xe4 FmG߁\x05/\xe1\xc6\xed\xf0\x91\xe2\xa2C\xb8H\xe63\\x\xfc\x8b+\n\xf6\xa9\xfb\xe8)\xf6\xdd\x1f\x81\x84\\\xe1\xd2́\xb6I\x1520\xa6(\xc5ش\x01\xea\xfe{T\xfe\x9c.͈\xf9\xb7&$\xafi\xee\x12(&\xaf}H\x9c\x14\x04\xc3ź\x8e\xf5з,\x8e\xf78\x99\u007f8\xfb\xb4[\xee\xab,\x8aFd\x87%ޤ\xe7\x16\xefIF\uab77\xa6OBt\x16\xd5\xc8k\xcf\xd6\rtX\xe4\\\x10\x03\xf4{\x1b/+0i\x05r@\xf8º\xfa\x81t\xb4\xde\xfd\x96\x94ᴰEs\x91E\xee\xee\xdb\xf0\x1b\xe8,9\x01\xe7\xce:ˡ\xe9\xc4{\a\r\xe6/\xb7\x8a-v-\xb1A\xbeHf\xe9\x8eƭO\xa1e\x15\xb2\x8f\x9b\xffZEˊ\x8b\xfck\xf4.\x8a?\xa1\x82NXJ\x18b\xafFʗm=*V\xd7\xdc\xeb\xcbx\x86~S\xe32\xafƍ@\xb6Y}̫I\xd2z\xc6h\xa0\xd0|;-\xc6Oc\x02\xfd\x95ΰ\u007f\xa4\xbd\U0005365e\xf57.\b\xffV\x1a2\x81ʮ\xdb\xd4۫$\xc1\x18\x8b\xf9\xddI\xe6|ҋ,\xe5/\x04\x85\x17\x17\xd3肸&\x87qj\x9a\x17\xe9\x8aT\xe8\xfae\x92|\xe0\x9dY\xbc\x85+\fyrP\x1c`F^\x0f\xef\xb4\x1b\xfe\x90}+\xd5\tmd]\x81.\xbezg\x8696\xde\xc5(\n.\x9c\xbbR\x87\xf7\xe8\x9at\u007f\xae\xd9h\x94_\x8e\xe3\xfb\x9bьcH\xc9Ke^Y\x84>\x8f}5\xa9t\xee%\x13$b\xfc\xf5H\xc5\x11\xa4O\aDb'\x19\xf2\x1b\xd1\x1dǭR\xea\xbax\n\xf8\x15\x05\xfd\x12\x91\n{\xad!\xd0>F\x96(ʁS\xc1VIS`\a\x10QFH\xf0\xa08\r6\\,y\xa1\x8e\xa9\xb3zoU\x0e\xd6\x06N8\x8a\xb4\xd2\xd2>\xc1\x95>^\xfc\x104|\xf8\xf3\xa3\xf0?!O\xa3@+.\"\x8e7\xfc\xc2\x11\xc0L`v\xe1\xbd\x16\x04^\r`\xf39\xe02\xc25\x05\x1b\xc3䅑\fk\xb3h\x04\x82\x88o:\b\xcc_\xe0\x9f\x1fa\x96M$q\xa1\xa2\xeaV\x14\x06\xf9\xba\x89Q{G\xb6\xa0\xee\x83\xe5\xf9\x82\xca\xda-\xf8q\xe9\x8bX\xf6*\x0e\x87x\xf9X_\xc6\xea\xca\x1al\xcc\x05Dv\u007f\xc3c\x05\x06\xa1m')\x8d\x02\x8a\xe6\x16O1\x83\xc6ZS\xbb\a\x8c\xca-\xf7\x95yjt\x14rJ\tK%1\xa2\xf8\xe5\x14l\x0f\xe4\xefQ4\x9bE>\xdf]\x04\x8c\x8b\x1f\x81\xf8\x9by\xf8\x89\x92\x81qӏ\xae\xdb\xd7\xdfpVE\x14Qo7]E\xcf\xc24\n\x84/\xbf\x80\x98\xa5\x1b\x01؟hO:\xa9\xe7\xa6=\x8cp\x8c\xc1Y+\xde\x01\x06\xc5\xec\xd80ԣ\xf9\x99\x9f]\xbe1\x95g\xb4\a\xf0:E\xa5\xd4\x03n\xef\xb4Ċ^\u0093Q\xec\xf6\xf6Dkd\xa5P\xd7\x12\xf6\xf6Xx\x8bS\xfa+\xac\x05\xcbb\x92\xe1\xc8\xec24\xc3K\xf8\xe2$e \xf7ErǪ\x92]\x92\xa0\xe02H+\x85Oݸ\x12\xac\xa7\x14z,\xeb\xae9&\r\xc5K\xc8~\n\x9fM1\x93\x9cVQ\r\x88x>4\\\xf5k\xb5:\xa3[\x87*A0$\xe8MC\x04\x0f\xe3\x04\xf1\x01SJA\xf3*\xdeㆸ\x89\xd18\xaf\x17c\auP\x11=g\xa033L\xaf\xdf\x00;\xa3\x15\xa4BD\x17u\xa1\xa8\xbaA\x9f\xb5\x8a\xfa\x943\xbcZ5<\x01\x9c\xd1!C\xe3{\x1b\xdfd\x901\t\xf8\xd1J(\xfelA\x11\xb93\xfc\x89\xf9D\xae`-\xc4\x1b\xe9\xf4\x84\x91\x06-<Y\x0eG|\x95+\x87+j`\xa9\x9e\xf2\xd3\xda\xe7\xa6M\"c~H\x81\xf12{\x93\f\x00UD\xa2y,ެV\xfc\xbc\xc0\x83\x02\x046\xbd\xaa\x81e@'\xfbnI\\\xa0?Vv\x0e\xe4\xcb\xd1\xe6o\xee:\x82D\x98|$\xad\x98_b\xbe\x9f\x14\xa4\xe1M\xdd\f\x17\x1d\v]\xa6'\x9aF\xac\xec\x87&f\x1b=\xcex\x81Q-\xbd_\xf1\xb9\xb8ݢ\x8d^[\xaa\x1f\xe5-\x16\x9d\x0f\xc5\x1f\x91\x9b\xa9j\x88\x1e\xedV\x8cV\x83\xd9\xe7\xda\x1f̯&\xb6\xfcH\x8bp)K\x99$\xed\xeb\xcdur\x1d |\xa0\xb5z\xbdq\xc69Z\xeb5\x92\v\xba\xa0\xe1ո\x8cQp|E5\x9b\x146\x88 ftR\xb2\x9a\x05Ȓ\x05M?\x16\xb4/R\x01\xea*\x17\x13\x15\xed-\xd3\x1f%\xe1\xa0`/\xa7\xea\x06\xdf.\x98\x8a\\\xa1\xb5^\x13qiO\x87\x91\xa9JS7\x05\x8f\xe7.o\xe2\xf4\x9f\x94\x9f\x88}b\x9d\x13\t\xea\xbfD\xd3\xe4K\xbc\x13\x99SG풨\xf4K\xb3uC\x17A\xb4|\x1b\n_\x80$*\xb9Q\xda\xf0f\x9b\xbdv\xeaǦO!\\\xc7\x1c\xc5\x15\x94\x19T\xc6G\x8b\n\xddH'd\x96\x1e\x86I\xa9\x17\x18[\x16\xcd6W}\x83\x8d\b\x97\xaeW\n\x9f\x9cL\xf1\xb0mP&\xc1\xd1y%E\xb9\xe5\xc2\xf7\x83\x1b\x14넸\x1f\xfb\r\x1fy$\x904\xe2\x89m\xbc\x18hv'\xf9\xaeN\xb1\xba_̧\xa1xIcc\xfe6\x1b/\xd7\xf9\xb5R`&p\x14\vW[\x9e\xd93z,\x8f\x18ԣ{:(\xd7[\xe7\xdb\x02\xda\xc0]\xf3\r\a\xba\xfcu\x8d\x19\xb0\xe4K\u007f\u007fN\xf9\xb6P\x18-¿\xe2$\xbb픂\xd1\xf4\xe7S|\x8fH9\xd3X\xa5\xbaz\xa1\x97J4\xb1Ji/\x1c\xea\xf0\x1a\x05\xb5\x1b\x8cks2\v\xa7\xf8w\x94\xb1\xbbv\u05eb\xd2P\xa4\xf7\x1d\x12צ\xdc(\x1e\xee4\xba]\xb3ayy\xe1Ȏ\xe4h\x000\x9fX\x16\"\xb3\x06\xefQ\xbe\x8a.\xc5\xec\xd0#z\xb1\xa9\xc9\x0f6\xed:\xf2\x1d%CД\xe9v\x150\xbeJ%VO\x97n\x95\f\x1b~܉5\xa7\xa0\x02!Y\x9c\xe4HYYs\x05\x81ϝgPm\xb76ε[Ǽ%\xd9\x10\x1f\x17\xe1\x9d\xfdϦɄ\x9do\xb0\xe3\xf2+wJ\xbb\x15y\xcaN\xd4T\xcf\\\xe9\xb3\x06\xe4\xb7MH6F\x8b\xfb\xcd\xff\xfc\xf5\xdfo\xf2_:\x01Q\xe3rv5\xfa\r\xdbh\xe0\x1b[\x8d\xc6\xff4\x1aE\u007f[\x87G\xc7\xed\xffi\xb6\x0e\x8fۭ\xc3V\xf3\xb0\t囍\x93\xe3\xffq\x1a\xbf\a\x02\xe6\xf8R\x0ft\xe5W\x0f\xd2\x1a\xdc\u007f\xc8\u007f\x8f\xd2\xfe4\x9e\xcc\x1e\xdf;x\xf0\xe0\x9e\xf3\xc0y\x9eL\x16tp\xedT\xfa\x9eӂ\xa9\xa8\xc1?GΗ\xd1(\xbeq\xbe\x1a\x83P\x86ž\x81\xf5=N\xa3\x81\x83\xdcf\nK6r\xbe}\xf9\xce\x19q2\x948\xb8\x97\xb1\x87i\x92\xcc|y\x1a\xeb9\xcb{\xf7\x1c\xe7\x00\x9b\xba\xbaJ\xc6\xff\xfb\x16\v;N<t\xe4V\x12\xddL\x92\xe9,u\x82\xc0\xd9\xe7Mr\xdfs\x9c\xabd0\x1fEu\x95)\x01V<\x01\xef\xe9\xb7/D!\x86(L)\n,\xb3E\x82*\xbb\xb6\xef\xec\xed\x89\xf4zx5\xf0\xc4\xef\x8a쫀\xfc\fd2\x90\xbc\x9c\x8bQ\xd2\vG\x1at\x1cY\xfd\xed\x84\xe2f\x1a=Z\xdf#\xb6\v\xa3V\xec\r\xc6\xed8\xee\x1cj\xa5\xb3i\f\x1b\u007f\x17\xa1Sl\xf3)\xb4z\x13\xe1\x98\xce\xf6\xaf\xa3އx\xb6\xef;\xfb\xdf&\xbf\xe0\x9f\xab\x14\xff}\xbd\u007f\x8e=\xf9;(S\x89V\x83\xba\xe28\xbe\x13\x8e\xf1)\v\x14>\x00\xcarM\xf8\x90I\xce\x14p\x92:\x1f\xa2\x05LXo\x81\xb3\x15O\xc9t\x96Շ~=Oӧ\x19\x14\x00\xf0\x8f\xcb\bω\x9cY\x82\xd9\xce\xf3\xb7o\xf5f\x12\xb4\\\xcb\xf7\xa6\x10\x10\xe1\xea\x01\xc2{\xe0|?\x8bG\xf1l\xa1\x86\x8f0X\x96\x97\xe1\xf9Һ\xf3r\xe8\x8c\x13g\x16^p_\xe2Թ\x88?Fc\x9fA\x84\u038b\x97\u007f\xc7D\xae7\xa8;\xafI\xba\nG\xa3\x05 \x80\x02\xb5\xa0,\xdc\x0f\xc7N/r&@\x99P\x88\xea\xe2\xa0T\xcb\xd2Ӯ\x02\r\xf9T\x91\xa7\x82q\x1f\x8d\x00_%AS\xb1g\xab\x95\xb3?\x88?\xee{T\x0315\xbeG?QP\x19;\xf1X@\x8cFg\xe3s\x00\x85_\xf0\x8b\x8a\x88\xfd,\x1a\xc1\xd7\xda@\xcfS:\xa7\x85\xbe\xa3_\x1f\xecs\x80ׁ(\x9e\xd2b\xe2\xc75\vF\x13\x8f\xd3\ng\xe2\fQ\xfd\xa6\xcf\u007f[\xbeS\xaf\xd7\x1f\x1c\xc8ᡏ\x93\x90\xa5\xa0\b\x85Ǜ3\xe6\x85H\xecď\xc6\xf0O\xb5*\a'Z\xd5}\x0eU%\xbc\xcfwO\x1f\x16\x17\xce\r\xed%]\xecA\xd3,h\x06t\xe2\x9b^F\xd1\f\t\xe02\x19\rht\xff\x0fHq8\xc59\x87.\xfe\xfd\xdbo\x98@\xb3\xd1ҩ\b\xd5\n\x9c\x8a\xb5\x82\xb4iS3\xbbO\xed\xc02Y\xe2Rw:\xce\xfe,\xba\x99\x1d\xf4\xd3t\u007f\xcdcC\xb4\xa9Y.|kp\xff2\n\a\xfbx\x1b\xdb\a\xe8\x9e9\x81u\xee\r\x10\x03\xfe\xa6clL\xc0\xc1W<\xcf\x18\xffs\xeaT\n3\njT\xd8\xc7E\xa0F\x1b\x1a+Ҙ\xf4\x18X\x1a\xae\x02F\x82\xf36\x1e\xf7#\xe0f\xe9\f\xfe\xe9\x81\x14\xe7\xfc\x83\xf8B\xea\\\x86\x1f#\xd41\xe3\xf1\x05,\x8et\x0eM]ǳ\xcb\fvm\x10\x8d\u0085XDב\\vi\x04\x13\x86?\x98\x19 q\xe0\xf3\xaa\x14(\xf9 \x8d.\xae\x8a鍢\xe8\nȕp4\xb9\f}\xbc\b\x1e\x8f|'\xf6\xa9r\xaa\xcf\v-ddcb\xec\xfb\xaa\xf4\xbf\xff\xcd\xd5\x1f\x800\xe0e\x95\xcf\xf9z\xf7~M[a\xf4v\x1e@i\xd4\x1bM\xa7\xea\xc4\aT\x12F\x03UU\xa1_\xa0\x804\xb5T\x9aNͩ4kԀ\xe7\x1cp\x9bP\xa1\x025j\x04\x0e\x9a\xe4\\\x05\x80y(@\xb1Y_=\x9d\xf7R~\xad\xac\x91g\x8cu\xb2\x13\xbf\x1eV\xf6Uھ\x87o\x9c}\x93\\G\xd3\xe7!F\xab\xd6\xdb \x9e@-\xc1V\x03Ä\x01\x89\xef*}\"\x83\xd9煅{\xd5\xfd\x8cÞ\xf1K\xcc\x02\xbb\x0e\xaf\b\xf1\xf6\xe8w0\x89\x15\x91\xee8\xfb\xffO\x80E\x98\x92\xdaR\a\x13iB u\t\x1fY\xf9\xc6ߖb\x82:X\xe8\x17,\xb1\xd6K\xf0\x14@\xaaY\x90P\x98+\\\xa1\xd2U\x9c.Ϭ\xd3,*G\x93\xe39\u007f\xc3\xe9ܵ\x89}(\xbb\xad\xcf\xf0\xe5\v$\xc1\xca\xff\x8eX\x8a0\x11\xdc\x13\x85l\xe4\xc2\xe44)km\xb06\xcc\xcb1\xb6wdy\x01\"\x8f\x939\xfc\xb56b\x9b\x81SXH\aT;\x94V`\xdb\x15\x1b֢`\x891\xa8J4\xca\xefN\xb8\x99K\x8e\x93\x11\xd5D\xfd\x8c\xb9\xdbXM\xec=\xf5\xfee8}:\xab4\xe8\xd9=`\xe4\x82$\x89> \x9b\xa3\x874=\xb5\x87\xa1\xa9\x136\x029\x90lo\x80mA\x91\xded\xa2Ș^9\xad\",\x91\x87\xae\x14g\x93ɹ\x03\xba\xa7\x93)\x9fj\x9b\x98\b\x04ge\xa1nii\x86kb\xfem\x04|\x8f\xdct'\xa3\x88\xf7\x14]\x00\bgN\x02ܲh\xebO\xd3\x1cZծ\x98m\xdeb \x12\xd1gڄ\x8c\xbd\xd5\xca\xd8\u05f7l\xech\xa3N\x11\xb0p6\x15\xe1\x85\v\xfav\x15M/\xa2\n\x88\xb7E\xdb5\xa0\xdfy\xe4\x14l\xd8\xfa\xa4\x88@:\xd09}\x93\x16\x99\xe60\xa1\x98\xa7\xd6\t\xb2\x19h\x97\xe4\x15s\x12d*\x96\x97B\xccZ\x1f1\x14\xc8\r\xf9;\x8d\xea\xc3^\x9a\x8c\xe63\x94_.\xa2\x1a\xbf\xa5\xe7\x90q(b\xd9NJ\u007f\x05\b\x99$8Y:\xf5'(\xca:7\x1d|\xf8\x97@}\x13\rA\x91Xd\t\xef\x80\uee43lꨐ\\\xa0\xb2ߐ\x88\xe2ɡ'\xf5\x9bj`\x01K\xea\v-\r\xe0\x193\x9cl\x1c-nK\x0e\x1d!9\xc3ir\xa5\x8d\x92\xb7\x0f\x94nB\xb2\xed\xe5GK\xefy\xe3\x894\x1fA9\xf1\xe0F\x0e]\x9aAXka\xf8\xa8\xb40\xd0}\xe7\x89H\xeb\xf0\xdf3\xa8\n̔~\vB9W\xdd>p\x9e\xcd\xe3Ѭ\x96Qd*\xb5\x0e\xf9\x8dH\xa6vi\x97\xed8M\x90#\xb5\xff\x00\xc4;\x18ט\xa2\n\xe1\\\xf2f\f\xe2\xdc`\x1a^sE>\x9dtN\x8a*r&VT\xe2\x06O\x18\xb9;;m\xa3\x8e\xaa\x84\x98\x05\x05\xaa\xff\x01\xdaJ\x19+\xe1 \x9ec\xf7\x1a~\xbe<gJJcu\x8c\xfdb\xb9n2\xc38\xe1N\xc3\xee\xe0w\x98\x81\xd3\xc1\xd3O\x85\xfbɔޖq\x9a\xbe]\x18\x96\n\xf6ǩ4\xeau\xc1B\xf90\xd1\xd9\xff\ft\xfe}_+\xfd\xd9\xf4\xa2\x87\x04\xf0\xd9tzq\xd1\xebQ\xe1A<\xe5\xe7\xdf\r\xe0P\xb8\ts9J\xfa\x1f\xae\xe34\xf2\x9d\x1a~bd\x8ch\xaaR\xa9~:\x89\xa2\x81ձ\xack\xa9\x03\x1c\x11\xe4\xbc~2\x1ePq\xdah\x11c6ʞ\xa23\x11(\xb3\xd7X\x034vPt\xb8\x01\xb9\xc1:̓#\xa3\x83\xaf\x85(+0L4\xc0\\k\x02\x98j\x99\x88\xa5\x1a_\xb2\f\x92u\t\x96(\xac\x8cy\x8a\vC{\xa8\x98\xd1\xf8\v\x1b\x03\x9dV\xf4\xd07\xc0|\x0f\x8ag\xe8\xe0\x03\f\xce/5\x12\xbdP\x83\x15\xb4\xcb3 oN\xc3,\xa4\xac\x8a\xc3D@U\xd4W)\x13\x89\x15\xfe\xc4\x17\xa4\x86b\xff\x05\x17b,%\x13\xa8\xd9n\xfcm\xdf\xc2R\x9f\xbcj\x1d|{*F_\xe5\x85 \xf6\xe1,_>+~\x99L\xe3_\x12@\xa9\xac0\x91\xfe\x95ξd\x8f\xfbTAtB\x15\xd0x\r\x115 \r\x96\xfc\x1cM\n\x16\xf3\x10\x16\x87J\"Y\x06\xbf\x954\xa1\xc5,6\x16\x14,\x97k_\x96U\xf12}\xb5\xf0=\x8dK|Ŗ\r\xc5\x13f\x97\xb8\xab\xc2Ч\xf1 \"\x94\xf5\x04\x1fI;P\xc3\x06J6\a\x04ō\xcbl\xe5\xf3\xed\xb3\xd1G\xf1Qҁ\a\x03f\xa3b\xd2\xe4\xdc0\a\xe5\x17`\xd5v\x81\xb6\x02\x1c$*r\xf4\xa2!\x1a\x06\xc2\x11\xa86\x83\x85\x04Hp\x80\xba\x80\x99\xce({>KPڣ\xb9s\xd8Ax\xc0\x9c\x1a\x15)|X\x9bD9\xd1T\xcf\xc1\x82P_\xc1\x03\xca\x00\xc1\x89\xae,\x90ա.r\x0e\xc4b\x8caN3g\a\x82\x92\xed\xce\xc2S\x1cA\xdc\xd36l\xf4\xca\x03la\xaeڐ}\xda\xe9\xc8_\x0f\xe7PK\xa7\xedL8͢\xc2\v\xf2\x8cRza\xcd-5\xdaO\xb2\x10\x02kPv\x96\x19\xd9%u\xf9ۗ\\\x17\xea\xca\x15\x97\xd4\xf9\xd7\xda\xd3ڽ\x8a\a\xd0\\Rg\xbeZMľ\x02?\b\x80\x1c\x90\x94\xaf\x96\xaa*\xaf\x8e\x84\"\u007f\xfb*\x95\x96X\x82A\xafE\xd2Z\xe1\x84\xecs\x16\xea\x1c1%B\xd7y\x16\x810\x13QC\"\x9d\x84k\xb2O\x80t\x86\x91\xd2%\xd8{\x9a(\xa7{\xf1\xefO\x13\xb2\x0f\xec\x03E^L\x81\x85\xf7©R8\xb3w[\xa8\r5\x0fF\x1f\xef\xdbz\xa0\xde]X@\xaf\x12\xd3>&E\u007fR \r~G$\ft\xabj\x93̇\xea\uef4c\x9fdJp%ᾁ\x86\v\xda\x15\xaa\xb4\xf0+\xa9\xab\xad\x04\xd5ݖQ\x934(#\x05\x984M'\xfc5\xd3\xd1f9I\x0f\x92:\xed,F^\x02\x9dD\xa9\x1f\xd4\xea\xa4.\xf6\x05l\xab2|\x90\xd4Y\xbf>\xc0\xad\xc53\xdb\x16\xb5\x86\a\xa2\xdb\xf7Tv\xb7\x92\x19\x15\x00K\x15\x1d\x81\x0eJ\xb6]\xedS\x89\xaf?#b\xba\xf0\xe7\x91# \u0087.\x05\vŎ\xb4F\xdb\"\x10\x83֣\xe1\xefg\xc4\x1f\xf5\x10\xb5\xcf!|\xd0 Q\b\x94\x03\xbcg@\x15\xa4@YD\x1b?c\x9d\f\xf7\x00\x9e\xa6I\xe0\x1c\x00\xe9\xd8X߳ ̈́\xad4[\xd2{{:i Z\xc8P\x82\xe7%\a01^\x06n\xedU2*\xe7\xbf\xea\xbe\xe8hȒ\xba\x9f\xe3\xb1oa\xc9I\xdd\x14\xd9\x1f3\\ɞMvF\x8b4gl\xd3\xccm³_[\x14\x99\xb8\xce\x1bq\x14N\xe5XԹ(|\x98\xaa\a\xacM6\x1d\xe2\xd3sh8\xd5>\xc55\x0e\xf9\xb8zVQ\xb4\xedh\xeaJ16\x14c-\xc0\xc6K\xc1\xc9Eh\"\xde\xe4P\x8aM\x85\xd88\x88?ƃ9\x14\xe0W\x9c\x9c\u007f\x806\x87\xb6e\xdc\b\xaf\xa7\xf1l\x16\x8d%,\x10\xa8\xd1p9\x84}\x01\x9f\xec\u0083\a\xd8##\x90\xa9L\xb4\n\xa1:\xbb\xce0B*1\x90k\xae\xfd\xbb\xae|d\\\x17\x92|\xd5J#o(\xa1b\xa4\x97\xe1 \xb9\xd6gL\xe0\xcc\xd8V<\xdfXZEҋ\xaf\xe5\x8b\r\xa5bo\x11d\b\x9a\xdc\x18e9\x8e \xee\x04T\xa4\xa0D\xf6\xfa\x99Pn\x8c\xcc\xe4\xe6-\r\xa1#\x86\xa2g\xaa\x1b\x17\xaf\xa7\xf1\x05n\xcc\xfb\xb8\x05\xed\x17\x16\x81L\xd6\b*hH\x82\xf5vxܐ\xfc\xeaA\f#\xe0\\\x1a\xc2 \xba\xf0\xb8\xe6HVP\xdb\"\xf6ީ\xee\xfb\r\xcf\x1c\x04]\xab\xf9N\xe8+\x80\x19\xa1Q\x10\uf811?~ܔ\xf8\xd1\x16\xb8\xbd\x89\x11\x0fdK\x80b}\xb1\xc9\xfa`\xcam\xb1\xe0\x96\xf3G\xab\xbeY\xfdwEt\xed\xa0U4s\x1a\xea\x92\xfa\xe5u\xd8\xef\xc3Z|\xe2\xec+\xc4\x1c\x0e*\x18\xfa\x130\x81\xd6w\xa3\xae\xd2)\x14\x97\xd5sՆ\xd9\xc9\x1fA\x01w4\xcc\xcf\x19\x00GlA\xbe2T\xc6&g\xf6%\xcah4d\tm\xcam\x0eSR\x87\x9f\xbf\x87u<D_\xb2Ș\b\xd3TR\x97\v\a\x0f\x11\x00\xe3>!\x9cV\x96T\xfb\xf6\x1bN\xc39\x9a\xdcPC\x9c\x88\xf3@\xc8m\x11\x9d\xac5^\x8ep\x90\x0f(x\x04KY\x04\x92\xba\xb4\tx\x02r\x13 \x83:\x192\x8e}\xd0=\xf7=\xafd;\x10\fzW\xf6\x17\x0e~\x9e\xa73f\x80I\xa6\xe4\x85\x0e\xeai#\xd6\xf4$G+\xe2\x87;3BE\x06\x06+\x8c}\xb4\x90e$\x8d\bG\x82\xc7G\nq\x13xE\xd1Մ\x15\xd7LEOE~\x1eL\xf6;@X\x999\x17'\xd2<:\x8bg\xd0Պ\x97)#\x9b\xce)C\x1a\x17\x9e\x01\x8a\x11d\x06\xdb+q\x98\x88w+\xb3\xbeKn\xaaN\xa5\x1e!5 \x00$\xc1\x9b\xab\x11\x06\t\x81\x12\x9d\xb4\u007f\x19]\x85i\xed*\xeeO\x934\x19ҋ\xad\x1d\x80겾\x1a\xb8\xa8[\xd40\xe1\xf1\xbehE\xb7Rg\xe2\xa6Z\x97)\xe8g3값;a\xe9\xc0\x18\xb0\x14\x1d6\r\xc5Q\x9b<~\xed\xdc\xcb\xce\x14\xa0(\x1d(\xec\xd7e\xc3H\xd3=~\x1bqڙOG\x95τ\xaa\xf7\x19\xc0\x00\xea\xe3\x8e\xe4\xf4<\xb1a\x05\x1b\xf7\xbb)ɢ\x96F\xa1mc\x90\xddz0\xcdme\x17\xd3I\xa5d\xf3\xd2\xf8\t\xce\xcd>\x054\xd8\xf7-\x19\xb1\x9f\x00cN\xd1e\x14ڐ\\!\xf5\xf3e\x12\xb1\x85Ԧ\xaaXm\xaa\vv\x9e^i)\xf7@P\xad\xe5\x0e\x97j\xc2_\x8e\xafs\xc4\xe4)4\x01#\xadIƫ\x94,\xefA\xcb\xde\x1b|G\xb2yB\x82\xa1\xddil\x9d\x999\x83\xf6\x85*\xc6_\x86r\x17\xe7P\vL\xa8\x02+qpC\xac\b\u07fd\xd4P\x87\\\xeaB\x1f0&\xe8}\x99\n#Zǁ}\x14d\x12)\xb6\x80\x90$v\xd0}ٛ\u007f\xff{pcb/\x03G3G{?\xf2p\x9c\xbdp\xda\xe7\xe9R[\xe8ڳ'U\x93A\xa6~.ǒ8\xf2\x05\xa4\xbe\xca;z>\x9f0Z˶\xed|\tFXG\xfc\xb5\xb2\xed\xb1\n\xfaD~\x8f\x03\x14\xc6\xc3bΟ\xdf7K\xa0\xf1ut\x84\xa7\xaa4\xd6\x1er\tb\x0f\xc2[\x80K!\u007fóG\xb6 \xf7F\xb0\x1d\xa2=n>I\xb2\xe3\xea\xfe%\xde~5Z\xd2\xd5\x1a\xaf\x9c\xb2\x8d\xed\xf2\x9e\xa1ˡ\x90\xcb\xc7\x1a\x81)\xcd\x18J\x12\x91a\xad%\x14\xf5x\xd0y\xf1\xc3˫\xf0\"z'\xf9\\\xfd[\xc53\x9f\x8d\xe6\xd3\xca$\xbe\x89F<yA˿\n?D\xdcz\xd0\xf4\xf9\x87\x18TP?T\\k[\x8f\xb8\x1f\x9e\xc9\xd5\xe5\x9e}ap\xe2<\x03\xcc\xf6\xa2\x82}.\xc7\n\xfb|Z\x91\xd93\xe4I\x05\xb1H\x1e\x01\x8aArI\xadVJS@\\\xf71\x0f\x04V\xd80\xfbE\xfb\xa5\xa6\x9b!\xff06\xcfjr\xde\xe5d\x04\xd2\xd7\xfaP\x92l\bE}\x0f\xf2\xf2\xfb\xae\x94F\xd6Ҵ\xc8\xeeMI/\xb2\xc5T\xc1\xa3q=\xab}\xc6\xd9\xcf\xef4,\x8d\x91\x05F\x1c\xcb\x118_ȞH\x13\xfb\x1ev\x95\x92a'\xfb\xd9\xcb6z馕\x93,\x03\xc7\x02\x16fg\xf9؞\x98t1\xbd\xf7\xee\xe1\xed\x89{\x8f\x0e\xa4\xaf\xdc_n\xa3\u007f\xfd\xf7\xd7\u007f\u007f\xfd\xf7_\xf0\xdf\xff?\x00\x00\xff\xffw\xc4\xfd\xa2\x00L\x05\x00")
+var appAssets = vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec\xfd\xddz#7\x92(\x8a\xce\xed\xa9\xa7\x88b\xafqgJI\x8a\xa4\xa4\xfa\xa1\x8a\xae\xcf.\xdb\xd3>\xcbv{\xbb<\xbd\xbaG\xd6ԗ\xcc\x04\xc5,\x91\x99tfR\"ˮus\x9eiߝ\xab\xfd\x00g\xbf\xd2\xf9\"\xf0\x8fD&)UUw\xcf\xda\xcb=Sb\x02\x81@ \x10\x00\x02\x81@`\x16Wl\xb0\xa8W\xcb\x7f\xf9t\xff\rG\xc3\xe1\x93'g\xff2\xe4\xff\xb9\x7f\x9f<9;\xfb\x97\xd1\xf9\xf8\xc9\xd9\xf8\xe9\xf8tt\xfe/\xc3\xd1h\xf8t\xf8/0\xfc\x97\xbf\xc3\x7f\x9b\xaa\x8e\xcb\x7f\x19~p]n\xe3\xfe\x8b\xfc\xf7\xdbo'G\x8f\x00\xb2<YnR6\x81\xd5f;x[=::y\xff\xfeы\xc7_\xfd\xf9\xd5\xcf\x7f\xfb\xf1k@\x01\xf9\xfc\xd1\v\xf9\x87\xc5\xe9\xe7\x8f^\xd4Y\xbdd\x9f\xff\xf6\x1b\xd4l\xb5^\xc65\x83\x1e%\xf5`\xf0\xfe\xfd\x8b\x13\x9e\xfd\xe8EU\xef\x96\f\xeaݚM{5\xdb\xd6'IU\xf5>\x7ftr\x04\x8b\xba^ONNVl\xc7\xca;6\x1b$\xc5ꄕYrR\x17ŲB\xb8\x93\x92U\xac>y\x04\x00\xb7\xe3\xc1\x10~\x871\n\xc7h\xfc\x04\x93\xbe\xcb\x12\x96Wl\x02y\x913\b֛\xd92K -Vq\x96\x87\x8f\x8eN\x1e=B\x82#\x98\x15\xe9.\x824\xbb\x8d\xa0Z\xc7y\x04\xf1z\xbddu\x04\xc5\xec-K\xea\b\xb2y\x19\xafX\xf4h1\x8a`1\x8e`q\x1a\xc1\xe2,\x82\xc5y\x04\x8b'\x11\xac#\x98-\x8b\xe4\xe6\xd7MQ\xb3\b\xd6%\x8b\x1e\xc5\x11ĳY\x19A\x9c\x94E\xbe[E\x10\xa7iɪ*\x82Yv\x1dA\x92!hR\xa4,z\x94\xb2e\x04\xe9<\x8f\x80\xad\"\xc8V\xd7\x11dy\x15\xc1\xcd,\x8d\xe0\xd7\b\xaa\b\xaax\xb5\x8e\x1eU\xabx\xb9\x8c\xa0\xaa\xcb\xec\x86\xd1\xdf\"\xbf\x8e\xa0\xda\xcc\xf0\x9fu\x04u\x1d\xc1m\\F\x8ff\x11l\"\xc8\"HX^\xb32z\x94b\x15u\x04i\x1aA\xb1\x8c`\xb3\x8c`\x99E\x8f\xe6\x19[\xa6\x15\xb6v^\x94\xab\b\x96\xf1\f\xa9Y\xb2k\x96\xa7ѣ:\x9e-\x91\xcex]gE\x1eA͙Uϋ\xa2\x8e\xa0Ʈ\x8e\xa0.\xf1g\x04u\x1a=\x8a\xcb:K\xb0H\\e)\x95\xcco\xe3*\x82\x94\xd5q\xb6\xac\xb0\x893\x96b\xbdכ\x92E0Ϯ\x15rD\xca\xca\b\x10+\xfd\xbd.\x8b\xcd:z\xb4b\xf9&\x82<\xbe\x8d\xa0\xd8\xd4\xebM\x1dA\xb9\x99\xed\"\xa8X\xc2KV\x9b\xd5*.wѣ:[\xb1\bVqy\x13A\xbcI\xb3\"\x82\xdb,e\x05\xfc\xf6\b0\xf9:\xcb'0\xbcx\x04\xb0\x8e\xd34˯\xc5\u05ec(SV\x8a\x8fy\x91\xd7\xfd*{\xc7&0\x1a\x0e\xffU&M \xcb\x17\xac\xccjL\xb8e\xd8\xd0xُ\x97\xd9u>\x01\x9c(\x97Y\xce.\x1e\xbdG\xd9\xfd\xd3\xcf\xdf\x7fw\x0eiV\xad\x97\xf1\xae_\x16K\x06$\xab\xc8e(\x96)+aV\x16w\x15++8:ipMq\xcbb\x0fg٣\x166\x81\xc1&\xc1\x18j\xb5 b\xc2e\x14\xe9\xc3>\xa4,$\xb8\xbf`\xd9\xf5\xa2\x9e\xc0\b\xb3\xb8h\x88̪\xee\xd3\xe8\xe4#\x88J\x1ab\xfe+A\xd1G僘\xccؼ\xc0.6\x92\xe29\t\xe3\xaf*\xefW\x9eD\xa8\x92\"\xaf\x19\xb2\xf9\x8f\x7f\xbc0?%j\x92E\xf8M\xf5V?)\x96\xcbx\x8d#\\\xfe\xd2]ٯ\xd6q\"\xfb\x97\xba\xe4\xeb<\x85b.\xba\xe1\xe8Ds\x81\xba{\x1e\xaf\xb2\xe5n\x02\x7fae\x1a\xe7\xb1+\x06O\xd6[\xc2\x1d'7\xc8\xed<\x9d\xc0\x1f\xbez\x85\xffC싑Ft'\xd89+\x96\xa9\x83\xe5tȱp9\xecϊ\xba.V(c\x98\xfc\xfe\xd1\xe2\x14~\xb3\v\x8cE\u0380\x95e\xc1\x99dQ\xf0\xcd7\xaf\x86\xaf\xbe$\x88\xbb\xb8̳\xfc\xda\a\xf3\xcd\x17\xdf\x10\x0f\x06Y>/<\x00\xaf\xf0\x7f\x04\x80ӑ\a`\xf8ͳo\xbeQ\x00\x83\xd5fYg\x93\xbc^\xf4\x93E\xb6L\x83\"M\xc3\xcez\xb9\xa0\xc2o\xe6\xa8\x1b\v^\x88\xdeR\xbcXo\xa1*\x96Yj\xb2wPm\x92\x84UU\xb3\x92\xaf\xbe\xf9f\xf8\xd53\x84Y\x97\x96h\x94q\x9am\xaa\t\x9c\xf3Z\xdc1\x00(1E9\x81?\xbc\xfa\xe6\xab/\xbf~\xa5\x87x^\x94\xabx\t\xa3\xf1z{2\x1e\xaf\xb7\xf0}\x91\xc7I\x11}_\xe4\x05\x8a\x14\x83\xc7\xd9j]\x94u\x9c\u05f6D\xf4%\xca\xf1\x1c\xff\xe7df\xab\xf8\x9aM\xa0\x7f\xc7f7Y\xdd/ٚ\xc5u\x96_\xf7q\f\xc6e\xff\x1a)fy\x1d\xd4\xc5:\x82?\x9c\x9d\x9d\xc1p\xbd\x15\xbf\x90\x90H\"v\xbe\xce\xce\xd6۰\xa5\xb2U\xf1\xee\xefUS\xf5w\xaa\xa8\xf8\xfb\xd4\xf3\t*\xd1\v\x0e\x8c\xce͉`\x02#\xb6\xe2+Oq\xcb\xca\xf9\xb2\xb8\x9b@\xbc\xa9\v\x94kZ\xf2\xddya4j\x13kԠ\xe4\x92ė~\xab\x1e9ӌ@\xe3UH\xb2\x9c/`zp<y\xf2\xe4\xc2^7\xf9@\x1b$\x9b\xb2dy\xdd\x1c\x90ϾD\xed\xd6\xc4!\xa7\x97FE}E\xf4]\x96\xd6\v\xbd\xd6\xca\x05\t\xf9\xb8\x87qC\xe8\xf3$N\x17j\\\xf0\x9b\x7fVI\x8b\xbaf\xa9IO\xb2)+$q]d\x9cQ\x84d ג\xd4\xe6\x8e\\\x85\x06\x8b,\xaf\x1b\x1d\"H\xb5\x18G3\xba\x06\xf7T7 \xc5\x12\x16g\n\x9f\x9e\x80N\xdb& \x87%\x82#]\xd8'\v\x94\xabΉ\x9d\x83\x0e\xe6\x9b\\+\x0e\x9e\xe5LC.\x8b$V\x90N\xb39̠\xaaS\xd94\t\xf0\xfc\xf9s\a\xa0\xa3J\xce\t\xb5\xf2\x0fn\xe3\xe5\x86U\xf0\x9b#\xd0Zgs\x987\xf42\xcf\xc1V/ڗn\xc1\xe5~)\xe4Q,Y\xae\xeaW\x17k\x92\xe2EV3R;Ha\xba+\xe3u\xb36.TwE\x99\xf6g%\x8bo&@\x7f\xfa\xf1\x92\x1a\xfa\xe2\x84\x14\xae\xcf\x1f\xbd8\x11\xbb(\xd4R>\x7f\xf4\xdbo\xc0\xb65\xcbS\xc0}\xd7\tO|q\xc27\\\x9fr\xff7\xdbdK\xe4\xc1\xa7\xb4\x01\xec\xd9\xff\x8f\xcf·\xff2\x1a\x9f\x9d\x9d?\x1d\x9e\x9f\x9f\xd1\xfe\xff\xe9ٓ\xff\xbd\xff\xff;\xee\xff\xb9\xf0U|\x9bC\xb2`\x1a\x05J\xb6,\xe2t\xc0w\xd3\xd5:\xcb9\x04\x19\t~\xfb\rR6\xcfr\xbd\xfb\x7f\xff\xfeK!T\xf0\xdbo0\xf8\x01\xa7\x93\xf7\xef\a\x83\x01\n\xb9\x90\xf04\xbb\x85d\x19Wմ'\x94G\xa1\x02\xf6>\x7f\x04\xf0b1\xfa\xbc\x05ǋ\x93ň@\x10\x03\x8d%\xc4 \xc7/\x0e`X\x17U\x86\x13\x0e\xd1\x1d\xd7\xd9-\xbb \xac\xa2P\x96N{؆\x9c\x95\xbd\xcf_\x9c\xa4\xd9-\xe1\xe3?\xe4\x1fZ=?\xff\x01\xb74\xf0\xf3\"\xab`\x1d_3\xc8*Z\xb6W1M\x0f˝`\fK\xa1\xc8\x13fR\n(Uu5xq\xc21=\xb2\xcc$\x8a\x85=\x18\x10;\xaa\xa4\xccֵi$y\x1b\xdf\xc6<\xb5\xf7\xf9\xa3۸\x84b]W0U\xbb\xbaj\x02\xa3\xd3\bNN\xe0\xe7\x05\x83|\xb3\x9a\xb1\x12\x8a9σ\xba\x80\xb4\x8c\xef\x10\x96\xe5\u05f8\xf6\x8e\x87\n\x98'!0\x8b\x93\x05\x950\xd7h\r\x87\x9dZ/\xb2\xe4&gU\xf5\b@\xeaܧ\x1a\x86'!\xaez\xc1\x80\x98\nIV&KF\x8bB\x99\xb3\x12)%\xf8W\xf4\t\xb48!F\b\x86\x83\xc1(D\xc4E\x1d#\xa7\r\xbc\x98\x82\x8bF1\x9fW\xac\xa6u\xba\xe4{^\x89n4\x81\x04\x15\x8c\xbb\xacb\x11\xf4\xf1\xb3\xd8\xe0ҨR\xf5\xba\xf4\xc7?\f\x87\xc3?R\xb1?\x94\xd73(J\xf8CY^_\xcf\xe8g\\\x96\xf1\x0e\xdb@\xd0\xd8\xd2j\xcdX*+\xfa\t\t\xae`\x8dBʒ\"O\x1f\x01\xd4e\x9c-'\xf0\x84S\xfc\x05nq\xaf\x97\xc5\x1d\x02\xa1V\x16_c\xe5\xd5\"NQի\xcb\r#\xb8\xff\xb1`\xf5\x82\x95P\x17P\xb2\x1c\xa5>\x16@\x8f\x00\x16wq\x92\xb0\xa5\x1f|S1X\xc4ez\x17\x97\f\b\x8e\x95ĠG\xc0\xc7\x11\xca\xdd\x04\xfe(\x04\xfb\x8f\x8a\x91\xaf^\xbf\xe6\x00\x88%\xae\xaa\xec:\xc7_\xd8[\x02\xf6\x11\xc0\xbbo\xf3\x94m'0f\xcfU\xc1w\xfd\f\x13!H\xd9<\xde,kB0\x1e\xca\xff\xb0\xdb\xeab=\x81?\x9e\x0f\xffUTW\xac\xd5\xd8SC\x0fK\xadc\xd4%I\x18\xe7\xb5(\x81\x05\xbec\xf3\xba\xb3\xc4\xfb\v\x12\xfd:.\xafY\rSH\x8bd\xb3by=\xb8f\xf5\xd7K\x86?\xbf\xdc}\x9b\x06\xaa\xd9!/ >a\n9\xbb\x83\xd7\xfc+\xc0\x11\x14\x0e0/\xe0\x18\xc3\v\\\x8fi\x909\x03Ԙ\xf0\xc4\x10\xfd\x97\xffE\xffKO\a\xb7\xe3\xc1\xdb\xeaS\xd6ѹ\xfe?\x1d\x9f\r\x9f\x8e\x9d\xf5\x7fx\xf6\xbf\xed\xff\x7f\x9f\xff\x02\xa9\x9e\a\xdc\xc4#?!=}C\xf3F\x90\xd4E\x896\xefb\x8dj1\xab8\x1c@]\xee\xc4/ \xa3g\x80#\xef\x86\xed \xcb=\xd0\xf8ߟ\xc9\xdc>\xe0\xea\u008f\x1cdG\xf8\a벨\v\\\xfe\"D\x11\x19\x85\x00H\xb5\x9e\x188/o\xd8\xee*2 X\xbeY\xb1\x12\xf5\xf0\t\xcc\xe3%\xcd\xfc\xfc\xbf\xf7\xb43\xa7_\xf4\xf7=$q\x9d, `\x9a0\x9b\x00\x98\x1a5]<\x92E\xdf;\xbc\xa15\xe3U\xb1\xde\x05\xeb\x8am҂\xbe%N\xe4D\x06S\xe8\x8f\"\xc8\x11\xa1\x06\x19\xf0\xd57\x12\x8b\xce\x14.\xafx%w\x8bl\xc9 8>\xce\xe0\x05\xe4!\xcf\x1f\xac7\xd5¬\xe12\xbb\x12-*Y\xbd)s\x0ev\xd1F\xe0\xebe\x960\x1f\x85\xa2\xf4\x17\xbc\x12\xd9\xf6A\x85\xf0\x03\xd4m\xacB>\xfc\xdf\xc7k\x14\x1979KY^g\xf5.H\x9d\xaaR\x1f\x92z\x91U\x81\x03\x88i^\xd8r\xc3\x1a\xb0\xe5\x86\xf9`\xe9wQ\x06\xb7.\xfcn͊9\xdc\xc2t:\x85\x9e,у\x97p\v\x13p\x86\x82Q\xeeV\b\x82\xaf\xae\x92Ͳ<\x15KJ\x04U\xb1)\x13\x16\xc1\x8aՋ\xc2e\x82\xa7\x06\x94\x15\x92p\x98\x8a2\x03<\x9e\xda\x05\x12Q\\^ӢW)QV]/2\x84L\xc1K\xb9RN8\xc6\x0e\xa2\xb9\xc2\x18l\x1d\xfa\xb6\xf0x\n\xf9f\xb9\x84\xcf>\x83\xc7Y\xf5C\xfcC\xb0\xf5v\xff\xbbl\xfd\x1d\xd5\xda\xechA\x8e\xafT\xb5^fu\xcd\xcaf!\x98\xf2\x8a}\xa5\xa4\xc1&\xa8\x9cRՠ.\xb3U\x10\x0eJ\xb6^\xc6\t\vN~\xa9\x8eO\xae#\xe8A\xcfKu\x19\xe7\xd7\xec\r\x1aP\xaeY\xf9:\x89\x97L\xb3\x80\xa6/\x98\xe21\x891\x1e\xb7p\x047\xf0\xaf0\n\xe1\x06\x8e\xa60\x1aZ\xc3\xef\xc6W\v\x9a\x94p\x96\xf1\x8d\x0f\x99\xf7\x86ݢ\xb1Q~J\"\x14(϶\xc5\xe4\x1dL\xe9І\xa1V\x1dY3\xcc;5\xaf,\xa5\x94\xb8\x13J6\x87`\x89\xa0\x97\xd9ՠ\xc8CX\nA\xc3\x01\xd7!f\x92D=\x17rj\x14%4\x89E\xea\xfb\xcb\x1d큸\xee\xc5'\n^\x94\x9a4(\x90\\5\x0er<wUE\xed\xe6.a\xea E\xbd\x8f\x8a\x84\x11d\x92LlWc(\xbc\x80q(\x1b@¼\x1c\x14\xb9Yb\xa9\xeb\x02\xca\x04-\x7f\"\xd1h\x9f\xfa\xcdg\xc8`ȹ\xaf\x93IW\xfe\xf3<X\x86\xe1 )\xf2$\xae\x03\xb7L\x06\xc70\n\xc3f\x05\xa2m%[\x15\xb7\x8c7\xcf^\xb4\x04ŊI\x1a3-\x0f\x0e\x9eJ\xf0\xc8\\Dq\xd7$\xc1$\xeapOG[rN}\xe7\x9di\xd1 W\xbfY\x97,\xc9*\xec\xd1m\x04kg\x98\xae\xa1\x8f\x03\xe9%\x8c\xe0\x18\xbe\x8f\xeb\xc5`\xbe,\x8a2\xa0\x9f\xcb\xe2:\xd8\xca\xf4uq\x17\xe0\x0e\xb4\x150\x84\x13\x9e\xf1\xdd\x0f\xa3a\b}X\x87N\xd2\x04Fa\a\xa1\xb8\x02|\xc5w4\x9e\xd9\xef\x18z\xbd\x8e\xc2t\x0e\x1b\xd0\xdc\xea.\xf5\x948X\xc6U\xfd\xad\x10\x85ޠ\x17F0\x87)d\xf0\xf9\x14\x86\xf0R\x00U\x9b\x19\x1e\xf0\xe7\xd7A\x86\xe4\x06Fq1\x86{X\xb2n*\a\x19|\x0e\xc3\x10j\xde\xef\rlП\xc2i\x04(h\xa7\xa1\xad&ԃ\x92ݲ\xb2bA8x[dyЋz!\x1cü\xbd\xb5?\x96l\x9em\x834\x82̝\"\xad\xbe\xa2\x8fxV\x05Ϡ\x8f\xa0Gpj\xd7\xcd\xcb\x02T8\xdfN\x90\x1b\xf0\f^\xea9 5\x87\xa2Z\x0fN\xf8܊\xff\xbd\x87\xc9>\xe8#\x03Zj\x86\xd5n5+\x96\x13HۗA\x16W\f\xd5\xdc\xd5:\x98\xb7-\xd5uC\x1b\xa8\xe1\x05\xef\xcf!L\xa0\xc6\xce\x1d\x91pO`\x1e\xd4\xe1Ş\xeadGܣ\xc2\x11\xf4a\x1e࿇\xa0\x9f/YR\xdf\a\xfd\xe0\x1c\x8e \xa8\xe1\x05\xfez\t\xf3`\fGP\xa3l\x8e\xa9b\xfc\x97'\xed\xad]\xa9\x7f\xb5S}\xddZd],w\x01s\xc0;\xa8U\xc2WG\xc0\xf6\x12Tey\x83\x16d$aI\x8a*\xa8\xe1\x88\x7f\xfc\xf8-\x9c\xc08l\xc5Ķ\xeb\x06&E\xcb8\x82ѐs\xb1/gy\xbf\xb0\x91q\xac\x9d\xa4\xeaײ\xe6=M\fo\xa7\x06\xa7\x9a,\tbc\xba%\xd3\xc7\xc5#\xb9`\xf8\x17\xc55Lapv\xde\t6\xd2L\x8f\xb5F\x04P\xc1\x14\xd6p\x02g\x82\xeb\xc0\x96\x15S\xa9$6\x82\x95\xa1\xfc\x19c\a\x8c\xe0\x04b{R\xe8\x94\xf6c\x88\xe1\xa8\xc9\xdc~\xad\xd0\"V\xe2u\x85Ic\xab\x0f\xd7{\x85\x02\x0fɴ2\x89Lx\\\x85Ԏ\xd1\xe0\xe9pt\xfe\xec\xe2\xe0\x99\xe0\x88\xfe?\b*Z\xdd\xe9\x13\x89\xdaKA\xb1ɓ\xa6\x18\xe0\x18Df\x8d\aOq$>\x1d\x9c?\x19\x9f\x8b:j\x9al^\xc0ؓ\x8f\xac@\xe2\xcfE\x1e'\xe4\x18\x10L\x94\x1a\x9c\xb7\x96\x1b\x0fƍ\x82\xcfO\xa9h\x03\xf2\x89\a\xf4\xd9\xd9\xe9\xd3soSQkx\x15\xe7\t[*U6=\x1dP\U000a0a8b5\x1a\x01\xe2\xeb\x98o\x89.\xec\xfcuI\x7f\xe5b\x1d\xb6V\xf0\x9a6J\x819\n\x18L\x15\xa2\b*k\x11\xc5nf\x03\xbe\xbb\xfa\x1a\x01B\x02\xafl}\xa7\xb5\xb6\xaf\xa4r/l\x88F\xadR\x81Һ\xafL\xe1\xea\xe2\x90\xeb\xea\xee\x80\xf3\xef\xffe\xd1K\x05~\x99]]\xc1\xd4D\xebn\"\x04\a\xc5砘\x9b\xaav\xbd\xc8\xdei5\xff]C\x96\x15 3\x86\xbfm\xe6Q\xec\x1d\xc2\x14\xd8\xc8d\xa2\xc1\xf0\v\x03\x9a\x8d\x06\xcaz\xcb\x7f\x98\xb9\xb2\x04a\xb32d\xe3\xb1\xfcnͮ\xf4V\xc5j\x83.\xf3\x1e\xe6YNg2\xbf\xb5T04\x80ů\xf7>U\xd7T\x83\x9b6\x888\xafP=\nVfǗȏKX\r\xe2\bV\x83\x19\\EP\x8eDR\x82I)&\xddlaj!\xf9\x81\x0e\xb3\xb3w,(\x87a\x047\xef\x9c\xfc\xaf\x8a:(\x87\x88\vsw\xad\xa5\xcd\xd4W\xc5j\x96\xe5,(G\x11`\xd9\xfeͻ0\x84\xdf\x7f\x87\xa1\x9e\xf1\xcb\xe1\xe5\xf0\n\x8e\xa0\x1c]\x8e\xae\xe0\x05\xfe\xe5\xdf\xc3\xcbѕ!\x17\x1c\f7\x99\x17:i\xe4$\xddl\x9d\xefw\xe67\xe74\xee0\a\xfc\x90\a\xa6\x10\xdc\xe0^\x80\xaf\x0eu\x9c\x8f\x03\xc2\x1a\xf1\xfaP\xeb0\xb2\xfaD[\xc4I\rqʱ\x18ĮK&\xadtT\te-y=\xc8}\x86ܟÕ\x01Bj(e\xdfl\x89\xadV\xe6\r\xbb\x83)\xa6Z\x14\u07bcCȖ\xeaAx\x95\xb6\n\v\xf6c\x1c\xc1̙\xecc\xce\xf5\x19\xfe9\x86\x988\v\xb3\xcb\xd1U'2\xdd\xed\xb1W-'\x05\xc2S{\x1c\x86Z\x02n\x8c\x05\x1e\xab?\x99j\xfd\x99\b\xd1\t\xef\xf7\x9a;\x1a\xb2\x87m\x8d@\xd5A5\x1cO\xe1F4\xf6⑪F\xa5\x8e\xael\xab\xa6\xaf\x1e\xf2{Y\x17ػ|\xab\xcb\xf7\xca6Ss\xb2=L\xa1\xa7\xa8B\xeb^z:0J\xff,\xb3`\xe2\xe4\xf8\xaa\xdd\xe4\x06\xc0\x0f\xdcnf\xf6\xe6\f\xa60\xc3\x1dn\fS8\x8eC\xda\n\x9c@\x80iq(\x85\xa39\xcdn\x1b3p\xb0\xe5E\x8e`֡?X\xf4\xbc\xa2\xbd˧!\x87\xc4i\x15o\x03\xb9\xc9[\xa1*\x17\x99dvn\b^\xe1i\xaa\xcf\x06V^ς2\x82\xeb\xe6\x90\x10\xcb\xe6O\x06\x80\x0f\xf3OM\x04|\x8a\x81)\x94\xc6h\xbe\x86)\\\x1b\xdf\xc4\x1b\x1f\xc2\xf2z\xf6f\xc1\xb6\r{\xf1-\xeacO\xe0%\xf4\x86=8\xb68r\x1b\x0e\xea\xe25\xdfy\x8f\x9e\xa8i\vy4>?o\xe4\xb7պ\x8ei/H;\xee\b\xca\xebY\x04\x8bji-.\\s\xb8\xe6\x7ff\xfc\xcfj\x14\xc1j\x1c\x91\xb8\xf3\x06\xaep\xc19\t.\xe3\xfe\xbb\xab\xe3\xf0\x97 \x18\x1c\x85\xbf\x84'ـmY\"*0f\x80\x95\xb1ȯ\xc60\x85\xd5\xe8r|5 \x03-\x19\a\x94\xce\x7f\x97с\xc9jd-\x0e\x90\xc4\x15\x83ޢZ\xf6&2Igj\x0e.\xaae@m\xfcfY\xc4u\xb0\x1a\xe3\x1c\x1f\x81\x9d\x84\x88O\xd0=\xd0\xcd\x18ˌ\xb0\xb9x\v\n\xca\xebY7\x05(m&\xb3\xc5\x10\x96\x94\xf8\xb3F\xedY\xe3\xab\xd0C\xcd{c\xb2D\xfe\xf2yH\xa2\xc0\xaf\x8a\xac\x97\xa2#B\x93\xba\x9c\xcc\x7f\xbc3\a\xd7\xe2\xef\xcc\xe8,^ȴ\xcd\xf3\x94A\xb2\x88\xcb/\xea`\x18\xf2\x03\x8d?\xf4t\a\xe9rr_\x87 g\x96\xf5\x04\xa6\x0e\xa2\x91Ѳ\x12\x8e\xd5h\xc2\xff\xae\x1b\xd0\xe3\xd0\xcc=VcMNC6\xf4ih\xe6\x1eO\xe5$\xa7\xf6\x91~\x8a\x9f\xb6P\xac\xed^\xa3\bNC/\x9d\x1a\xe64\x82\xf3\xd0K\x9d\x869\x8f\xe0i\xc3\xf2\x8a5\x92\x00|\x9b\xd78爡,+RY\xd7V\xd6\xcc̚\x19Y\xd6jZ\xee\x99\xe6hV\xaa\x96\xeeT\x87\x93\xc2*\xcba\xaag\x9c\x12\x17l\x9av\xaeկ\x99\xf8\x15F\xb0\x8a\xb7\n:\xde*|\x11\xa48\xf0c\x9c\xcfWY\x1e\xc1\x82\xae\"-a\n\x01\xa6\x1ec*\x0e\xc0\xb1\x16E\xc3\xfcF\x86qi4J\xe1\xc4*4\xe1)h:R\x15\x84\xa6\x01\xbe\x84)\xd5\x1d\xc2\x02뻆>\xb6\xf0\x04R8Ư\x170\x83\x97\xf0\x04\u05eb\xf0B\xcbǵ]\n\x17\xb5R\x96\x1a\v8\xca)\xa1\x0f\xd72\xe7LV\xbc@\xdd\xf4\xc9\xd02_\x98\xcd\xc1\xa2COO\xa5\xa7\xd4\x11\x82?\xad\x9d\xb5\x8c\x9b\v\x9b\x9e\x04\xb6\xbbwA)xpm'_\x8b䙝,\xe7\x00\xecq\xb1y\xd8\xee\xdeQ5\xc1\xe0l4>;\x7fr\x06G8Rapz\xfe\xf4\xfc\xe9\x93\x11\x1c\xe1P\x84\xc1\xe8\xd9\x10w\xe5\xb4H#\x17N\xb1ԛ\xbf\x86\x11\xec\\D\xe3\xd1\xf8\xc9\xd3\xf1s\x89\xe8\xe9\xe8|t>\x1eKDç\xe3Q\x03\xcf\xdf\xc2\b\u07b9x\x86\xa3秧\xa7\n\xcfh\xf4|\xf4\\\xa1y~><\x1d\x9e\x8d\x1c<\xffa\x1b\x85xj0\x1a=\x81#ء%\xedI\x04\xe7C2\xac\xa1\f\xed\xc2\b\xc6\xfc\x13s߅\xad=\xc1ym/\xe6j\x94\x84h\xbf\x1d\fφg(\xb9%\xae0\xe3\xc1\xf31L\xb4\xc9)\xa0&\f\x11\xf6\x04F\xf8#\x82\xf1\xe0\xac{\x19\x17kDb\x0e\xd49L\xcd5-\xb1\x1b\x9c\xc8\xc91\x91S\x1eZ\x0f\xf9d\xfe\xaf=\xb9\a!\x8f\xb9`\x8e\x86\xae\x01R4\xf1\x9b\xedM\t\xf5\xaaU\x7f\xda#\xc2\x7fj\" \xb5\tG\xc5\xc2\xdc$is\t}\xd3A]\vE\xa4\xec9H\x15\xc8m\xb0\xb0\x17\xac\x05|\x0e\xa7O\x868\xba\xf1,\xe3\xc9\xd0\x18\xfah\x98\xa4\x9cc\x9ec\x15{\x01XJ4w\x85\x16\xc4`Es\x0f\xd9\xe4\x16p\x02\xcd\x12\xa3gF\x91\xb1\x9b;>\xeb@\x18\x8cφЇEh!V\xd0\xe6\f\xa2\x1bk\xb5\xd6Աy\xffR\xf6\x11ɧ{\xd4ʵ=\x9eJ\x9d\x01\xff\xaaY\xd0\xca\x1b\xea&x\xa1N**\xf8\\\x1dT\x88\xee[\x8a)\\\x82,\r\x90\xe5\xc5#\xa5\x1c.i\xc4\xe0hYb\xeb\x91\x1dUH\xf0\xc7PA\x9fR+C\x0f\xc5a\xbf\x84\xbe\"Z\x8fo\x14\a\xe4\x04\x9aK\xc7h\xf5 \xb6\xf0?(\xfc\xe3\xa1\x7fP/\x12\x92ͤ]\xb8\r\x00\xafp7\x11x\x85;\x81)$\a\tw\xb2\xa4\xd9\xcaO\x95\x98ʖ\x91>i\xa0\xc5G\x9b\xa9I\xfc\x8e\xb0\xac\xb2h\x93\x00$^\xf2\x05\xb2\xb8u\xcf\xf4\x9d\x01\xe0C\xf0]\x13\x81\xdd>\xf1\x1d\xc3\x14b\xe3\xbbuτ\xf3wy\xdd@\x8a\xf2\x8aKL\x80\xb2\x81\x1b\xa3\x13\x18\xe14\x8e\xeb\u05ce,\xfb'8\xa5\xf3\xf5\x03g\xf1\x19*\x18C!\xb3b\x95[\xc6|\n\xdf\n\x93\v\xad]\x1cbgC\xec\f\x88\xbfq\x88w6\xc4;\x03\xe2?|\xe2(\x161\xfcy:\x18\xe3\xaap\x86\xf2\x8b\v\xcehp~\xfatt\xfa\xec\\\xacG\x83\xb3\xe7\xcf\xceOG\xb8\xe8\xbe\xe3\x1b\x04Y\xb2?x\xfe\xe4\xf9\xf8\xc9\x13*x\f\xa3\xc1\xb3\xa7O\x86\xa3\xe13*\x88K\xc9\xd9\xe8\xfc\xfcI\xb3\x1c.-O\xceN\xcfD\x85\x83\xf1\xf0l8>\x7f.\x8a\xe1\xca\xf3t<>\x1fS\xc16\xc1\xa0\xc1\xe1\x17\x0e1r\fS\xd6\fMA\xf2\xc8\xfc\xc7o\xe1\b\x0512lG1\x1cA\f\xc70\xa3\xb5\xbam8\x19=䜡\x7f\x8e\x8dx\xf2\xec\xf9\xe9\xf0\xf4\f^\xc2\x16\x8e\xc4\xffO\xf8\"~\x86\xfd\xfd\x1cIx:x\xfa\xec\xe9\xf0\xf4\xa9\x0f\xbfT+\xbc\xf8\x87\xc3g\xcfΟ\xc0K\xbd^o#\xb2r\x9c\x860QhEW\xf0\xfa\xda\xea \x1d\xdcw\xaa\xc7'\xe5\xf19\x1d~\x94\\c@\x15\x06^\nm\xe1\bJ\x98p\xdd\xc0<\xac*#q\x82s\x16B\x9f\xab\x10^\x06Vl\xc9\xfd\xd9\x03\xf2*\xa8\xf4\xf9\x88p\xdd\xdb̸\xff%ώ\xac2?J\x8f=[\x9dࠝ\xb5\x89_E\x19\xc8\x1f\xfb]Դ,\xf12\xaahDSD\x97\r\xa8Y\xef\x17\xcb\xe5\aTm\x96\xbeO\xedq]\x97\xc2+\xc6r\xdfP\xd0\b\xf0\xc3f\xb94\xea\xe6&%\xf2\xca\xf9\xa2\xae\xcbl\xb6\xa9-\xf7\x9c\xf7~\f?\xbcރ\xe3\x87\xd7|\xc7O7\xc9\xc4n\x1fo\xdb-\xdb\xf1\xbe*\xf2\n/$\xbb\x98+Vۤ\xc9\xe6\xed\xc5\xf4\xc3\xeb.\\\xad$\xee\xc5\xff\x8d\xdf\xc3q\xab|\\\xba}\xceP\x99\xd9J_\xc0\xb0\xab\v\xb8b\xd8ƅ\xed~\n\x7fx\xfd\xe9h\xec\xe8\xe2\x16\xba;8n\xb7E\x19\x96\x06y5\xf8u\x13/\xb3\xf9ΔJi\xb9\xe4.\xa5\x9cHxi \x84\x97\x86\xa8\xc2D}\xc0D\xb9Ǌ\xb2\x8e\x8bl\x03\x85\xe6$L\xac\x04\x984\x81\xb5\xe0\xc1\xc4J\xe8\x1e\xb84\a\xb2\xf4'\xbf\xb1\x9f\xdd\xc1O\xec\xfa\xeb\xed:\xe8\x05/'\xff\xf9\xfb/\xbfT\xc7!Zj\xd3\xd3A\xc9($\x85(w\f\b\x81\xf9\xbf\xff\xb7\xb0\x17A\xef\xba\x17\x1eTs\xe7\xac!`<\xc3S\xbb\x80\xb7\xf9c\"\xde\xcb\xecJ\x88Yǰ\x12\x95\xb4\x8c,\xe9\x05z\xa8\xf4v\x93\xe1\x955\xfc#=m\xb9\x81\x98\xfcl\xc3\xc1*^\a>\x9e\xfd\xa0\xc5\x11I\xcc%\x0e\xf3\xbc\xdb\xf1\xc8\xf6\x8b\x9c\xd3t\x98\xb8\x1c?\xa8\v\x1bgEHT)̳\xad\xa2\xe6?&\xc91\xa0\x8f-\r|FH\xb0\x91\x18=\x83\xd0|\x97Uuh\x8fŗ\x90\f\xe24\x15\x84L \xf1z|\"i6\xaa\x1fH\xfe\x92\x19L!\x19\xe0\xf5̿\xc4Ki\n\x8e \xb9\xc5\xf4\x19\xbc42'r\x03\xc3)s\x88\x05(\rGEm\xf0R\xae0%\x1bԬ\xaa\x83\xe464K\x01\xaf\xcb\xf4\xc7Nnq\\\x01\x0e8\xab\x11\x8a'\xb3\xd0 k\nɭ\x98\xfe\xec\xd6\xf1\x9c\xa6I]\xef\xfd\x93[\x93\x12\x0f\x1d\xca\x03\xbcd\xdc\xfb;\xbcx\xf4\xa1\x94\xbc?H\xad\xc1+\xa8\xe6\x1c\x11\xc1\xbå2\xabw\x8dق`}J\x06e\bqP\xf7b\xda5\r\x02oU\t\bW\xc5j\vQ\x93\xb8v̟`\t\xefh\xa0\xb9\x1e\xb6\x90\xbem!\xbbe\xa9Sl\u07bb\xa2Yͅ\x89\xcd\xd8\xee~_{\xb8\xdb\xe8p\t\xe4\xf4yʖ\xac\xe6m\xbe\xc4\xe2W\u07be\x90\x85[:\x9a\x97\x94}҉\xe1\xe3vh\x93z݅\x8a\xa8\xed\x01\xfddrgoW\xb9m\x81I\x83A\xdd\x1d\x96\xc6u\xfcC\x91\xb2\x00\x7f8z\x04\xff\x00xCPo\xdeL\x00\xff\x1e2\xfc\xe7\xd9\x12\xaf\xb3<|C\xf3=\xba\x1a\xb1J0_\xe19lKU\x94\xf5\xabb\xb5\x8e\xcb\x187t\x89\xfaiy6\xba\xeef!h@\xaeH\xc6U\xc2r\xbck\xef_\xf2L\xb3\x82\xca\xd58\x82\x18\x0f$\xe3\x81d^\x043L\x98\xa9\x84\xc3\x1a\x83\x1ejt\xffO\xdeS\xe0A\xe96esX\x15\xf9O|\xe5\xb4\xe5\x19\x03r\xac\x19\xb6\xc9\x1dY\x9c\x13\"_\x17\xb2\xb6g\xe4\xc5\xf6\x9d\xa8[\x90\"J\xa8\x1f\x83\xfff,+-\xa3\xd8>\n6h\xfe\"M\r\x82U\x86@\x1dX+46\xa7\xf0\xbaԙ\x1em:5.\xaf+\xf4\xb2\x11z\x83\xe4\xfcE\xab\x17\x9fd\xb2\x18\xfa\\\xabA,\xf7q\xa9+ڎ\xc1\x85\xdaW\xa4Lt\x06\xc7m\xfa>ʢ\xb2/\xf9\xa5CcC/͑i\xea\xeb\x19k\xb2iv\x13L\x95\xf0(\xbdW\xe4\xbd1\xee\xed\xb8vv\xa1\xef\xf6\u07bc\xc1I\xe7\x18x]\x196\x02oGf\xc6\xcd\x0em{\x97\x972\xf8\xddQ\x02\xd4g\xcaxQ\xc8\xd6$e\xdd\xf0\x92\xcb\x04L\x14\x0f\xba\a\b\x06kP\xc6 \xe4\x16z1\xab\xb1!/\xe0\xbe\x15\xfe!0\x15\xd6 \xa9t\xc3[x\x01\xab\vx{|\x1c6\xef\xed\x12\xac*s\xf9\xf6\xca\xf2X\xa5Tu;\x05\xfb\xf5\x02h\xfbp\x01\x99\x89\x8e3D\xf4;\x15\xc2\xfb\xaa\x8aX!fY\x04oC\xdf`\xb9\x87\x15\x8b\xe5z\xda͊\xbc\xd5x\xa6 \"O\xf9\x16+\x9a\x82juv\xcb\f\xbb]\x04Y\x1aA\x9d\xadX+\x11\x86\x05O\x17w+\xc7^\xa8\xef\x18\xcb+\xeb\xea\\\x04L\xbbת\x8b\x85=\n/\x82\xdbX\x96\xa7xY\x88\xc5\x15\x83\xa9]\xc3\xd7q%\xa6\b!\tY\nS\xc8R+\r\t\x87)\xd1o\xa7#)͛z\x94lo|:\xef\xde\xf1\x16\xe9{{\xe6|,\xaaP\x8a\"\a\xb56EB\xb3\xe09\xfab\x1b\xa7\xc29v\xd3b\xa3<zE[\x04sTS<\xbb7\xcf*)\xd02\xc5E\x90\\\xee\xd6Tx2y\x16\"\xbc\x98a\xd3ӎ+\x96]\xa4\x93;\xb9\"\xdd^\"\xef\xd1\x11\x96d\xd0\\\xc2g\\)\x9b\x86$ꛚnu\xfb\xc9NOI\xa2J\x1dV\x80\xf1Xk\xed\x1aPcns\xf6\xda4]\xa8\xd2\xd6f%.\xeb&~\xc9\x0e\x8c\x023\x88\x13\n)\xf29d\xa9b\x05^<\b\xac\xbd\xaa\t\xaaG\b\xffO\b\u07fc(\xbfF\"\x15q\x14\xa6\xa0\xb1\xb5\xb6\xf6\xdcJ\xbf&N\xf3\xd6\xd0u:\xb7\x84\xa8\x85\xa5\xc6\xc5>\x8b@\xbd\xb0\xdaA\rtg\x113\x1a\x15\xb9\x1b\xf2\xc7u\x96\xdc(\x96\x85\xba\xbb0=\xc2\xe9\x1e?\xadb\xf2RNs\xad7\xba\xc2B\xdb\xd9\x13\x8f\xa7\xd3\uefa0y\x10\xa6 \xd1A\x1fR\xb6\x8cw\xe45\xb2\xe1Ao\"`0\xa5\xd1\x18\xd4!_\xa2$\x03M\x13\x93e\xf0\xca\xf92m3^\x94\xba\xec\xf7\xf3+\x93y6\v\xde;\xed\xe1W\xfe\\\\ͦ\x80=\xea\xbeM\x1b\xc2%{\x8f\xe5ig\xdf\xf90\rm\x80f?\x99\x84\xbf\xf7\r\x9fb\x1d4;\xebq\xbfO\xfdE\xc1\x94\xd4nO\xa8\x95\xc6\x14\xf2\xe6P!Q\v\x1bK\xc5Mq\xeaO\xa9\xab\xd2G\xa4\xbaV%\xab\xaeFjP\x1e\x84ZA\xb9\xf8O\xe8Ѓ\x97\x1c\x02\x0f\x9d\"h\x96\xfc\x8f\xcb!ƚ2\x12\xa9\xb1\x13\x18j\xf2\x85s\\\x8b:}|\xacyd\xed\b\xe2\x1d\x9e\x14J\xd1}\xe9\xceQ\x13=\xe2(G\xf0\xc2\x19vr|\xbfwG\xe4^\x05ə\xe2\x03WM$\x83q\xea*\nߦ\xed\n\x84\xee-+\xeb\xabF\xaf\xd9\xd9\"].\n\xae\xec\x92j\x9f\xa5\x9e\xec\xaf\xc5\x12\x8b\x004\xc0յ0w\xb9\xe0\xfb\x8a\xceŢI\xb2%u\x17^\xb0\x16A\x94\xc0\x92\xa5z\xbc\x9aB\x19\xd9\x12c)\xbb\xef\xc36vtqB\xeb\x1f\xde\xd6\x18\rik\x86݂}QVp\xa4\x92\xbd*%\xe2]SI\x8c\xa6\xe7^\x0fw\xf8\x12\xba}\xf3B\xd9\xe2\xaeƌ\x1fj5\xfc\x13\xb8\xa6\x89\xd9\xedW<\xbc'54\x8c\xdeT5[\xdbW\xff\xf8XÎ\xb8\x83)|\x15\xd7\xd83wA\x18A=\x82\xa9.\xf9\xeb\x86I\xbb\x99X\"jcN\x17h\xa8o\xef\xa0\x0f\xf5hP/\x98\x15BB\x82|>\xc5\\\xb1Hգ\xc1|\xb9\xa9\x16@\x89j\xe7#g\x00Y\x9eH\xa9G\x83\x9cmkw\x17j\x0e8\xa2\x94\x10\x06!\xf4\x91\x16\xc3\x15\x97\xe0>\x87\xf1\x99\xad\af\xd57Y\x9eՌ\x03X\xcaF\xb2dq\xf9s\xb6bŦ\x0e\x14\xfe\x9a'\x98\xb6\r'\v\xa6P\xb1\xbaQ\x10\x99/&\x88\x86\xe3\xb4\x02\xa2>\xbd\x8d\x97z\xc9r\x1co}\x90\xa3\v7\x93\"\xca\xdaU\x87\xed\xe1\x99l\xc6i騇 ON<\xd2@\x0f\x1e\xe40\x85o\xf39\xb2p\xd7*\x1e\xa4\x06\x88\xae֩\xca(\xa4\xd0\xcevߦ\x97\x86 \f\xb2\xf4J\xb3\x99K\x01:\xdf\xd5C\x12\x05-\x140\xd1H\x886W^\x1al\x04I\xbc\xf2\n\xc7\xefHJ.\x1ck)u(\b\x88+\xf5(\xb4\xd17w\xe6r\x04\xb8\xdc^\x15\x9b\x8a\xfdXdy\x1d`P\xff8#\xf3\x9du\xdcV\xdd^\xc3\x14T\ue838\xcbY\xf9\xfa/\xff&\xc2\xf8\xe1\xe2\xad2\xb5\x88W\xb7׃\xa4dq\xcd^\xff\xe5ߨ\x02\xdb\xe4G\xc1\x90Q8\x1bp\x81\xb5Ք4\xbe\x99m\xae\xcfΆ\xcfN\xc9\xe7\xf1\xb3\xcf \xb8\xcb\xf2\xb4\xb8\x1bTIY,\x97\x7fE2\xac\x94\xbfYÇ7\"=\x1d\b?\x18\x15\x8f\x10\xe3\xf5\x86\xb8\xd3cy\x1a\xf4\xaa\xdb\xeb^\xc8O3\x82\x9e\x8co\x88\x9b\xf5xV\x15\xcbM\xcdtn]\xac{\x11\f\xd5\xf7\x92\xcdkJ\xb8\xb0\xf4\xa7\xa4^\xf1V^\x0e\xaf.\x87W\xb8\x9f~\x9d\x94\x8c\xe5\xaf~\xfe>\xb0ǭ\xd3\xce)<\x0e\x92\x1a\xef@\"\x87\xeb\xd5\xc0Tm\x91mb\xbf\xed\r9\xd3@g2\x83x?\xd8\xd2mj\x8c\x8e\xfa\xd7\v'k\xa7\xb2\xfe\xd6*\xb2&\x92d\x99\xb1\xbcnA\xc33\xff\xe6R)\x05\x80\x83\xae\xe2\xba̶\xeaz\x9f\x16F\x87a\x83,\x17\xc1P\xdc-\xed\xa5\xa4(R\xb5_\xb9\xf3sɒڒ\xe5kV\x7f\x89\xdebY~\xfd\x8a\xc8\xfc\t\x85\xc3\xd6\xd9.u\x03\xa1O(\x06\xd8\xd7\xd07\xf0\xf0|\x8c\x8a\x19\xe9\x16K\xe8\xbaX{\x801\xe6\xa6\xf7\xbeh^\x14\xde\xf8lt\xfb\xf5k\x8c\xf6[\a\xe2\xa5\x1cs\x94\xa2R\x8a\"N9t\xf3\x16\xb7\t:\x85\xff1\x9c\xd5\xed{\x9b\xbc\xfc\v^\xe8%\\\x82\xd0r\xe9\xfb\n&\x94R\xac#\x01襜(\xfc\t\x03u\x05\xf4Ӎ\xf9\x85i\x03\n\xe4ś\x01/\x9bi\x01W\xb5\xad\xd6\x1a@A\x8bK\x1eB\xbc\xc91X\x13og\x04\xf8a)\xcfCn\x15\xcdF\x8a'\x063\"\xd8\x0e5\xaf2d\xdfvd$\xe0-\xe7t\xab\xa7\xb7\xed\b^\xc0\xd6ؕ\xa68\x122ďx\xb2\x91\xach{a\x02l\x87\xa2\xa2\xedHV`\x9d\xb7!j$\x1c\xa6D?V\xd3\xc7j\x8cz\x14\x85\x02F\x04Z\xda\xea\x89G\xd3,A\x12\x96-\x83\xed\xc8w\x04ˁ\xbby\xaa\x0294\x9d-\xdb\v\xf2\xc7\x19TwP\xefE`\xa8\x89\x11P\xf8\x9e\xc6\x19Q\xc9\b\x81sD\xc4љk\xa4Շ\xa2\x02\xf1\x15\xa2n\x05/51\x18\xa9F`\xd0\xd2\xf5f\x96\xf1\xb4\b\xac\x9b\xb90\xe5\x94q\x04\xcdK\xbb0i\xa4\xf3\xfb#\xead\x84^B\xa2\xd3\n\x1f\x0f\xac\x92\x16K\xf4ʗ[\bDA\x89\xc7A`_\x84v\xe7Ej\xaa\xdfe\xc0\x8e\xa2\xa7Jp\xf2\x83m\xe32\x03ψ8u<\x93\x0fM\x9a\x94k\xd3ڹk`\xa5B\xc1.\xb4\x8c\x8e\xbc8o\x97Y|{\xa8\x8dW\x8b\xaf\x96|\x1c^\xe4\xe0\xc4{\xc5e\x88\x92/\x0f%\xc4\xe8\a\x11B%UM\x02\xcd\xf6\xbeUS\xc0\xea\x96\xfa\x9b\xb3h\xb0\r͞\x0flA \\\xbe\x9a\xb8p?\xa4\x91TRm\xa7\x05\x9a\xfb4\xd2\x1ee\xf7'\xc0\xb9\xf0χ\x8a\x89\xf2>Ġ\xe9\xb32\xc9XyM\xdd\xc6l\xf63\x96P\xc3yՆ\xf4\x1b~\xe9\xf7\xbe\x98y\xb1n\xf4bq\xf0\xb8'\xf8WA\xa7\x9a\x1fpQ\xbc\a\x8b\x92b\xbd\xf3W\xe7o\xc6\x01ӽ/p\x8bEB\xd7r\xf2\x13\x8f\xd8JI\x91\x98\x1d\x9bv\x89\xd2\x03\x15A\x8fh\xea\xc9\x1f4<\xf0ˠ\x11?\x89\xca\xde^J~ .+\xc1M\xb7\xde\xe0z\xfc*\x82\n\x82\x986\xa3 \x8e\x9c{\x94[\xdcۨC^\\\xda'\xdeqb_y\x10:\x00\x9c@\xca/\xdel\x1b1\xf5P\a8\x04\x15\xd7\x15|\x98:|A\x1cI\xe6:\xa0\x16d\xd3\xc8\xc35\xbfi\x8bF\xcb\xdfy\x84\xa9\x80\xc3H#}\xf9\x9b\xeb\xb4l\xed\xe5\xb4\x13n\x92\xb0\x9c\xc0\xca\xe6w\x18\x01+K\x98\xc2\nNxEG\x84Q\xebu\xac\xe4\x17FF\xe7!\xe5\x88\xc0\xb1ڟQ\x02\x9c\x1a\x00\xe7\x9e\xfc\xa7F\xbe\xb8J\xa7\x9a\x01S\x83\xd5:\xf5\x84\n\x84\x16M\x9a\rS\xb3\x9d:\xd9.\x04ǢR\x18\x9c[\bƈ@\xa3\x15\x1d\xce3\x0f\xe9Ѫٛ\xc6x#\xd5K\x9f\x96\x1e \x11\xe1!\x956&\xc4F\xcd<\x12@Ћ\x06n\x90\x8f\xbeO*\x0e L\x04\xaeP2C7\xc0F!\xb9\xa1ϻ\xa6\x05\xa9L\xdeC\xdb3\x86\xc5\x06\xa66d`l\xe4\xc4O\x8al\x91\xc1\xd4\xc4\xc1UC\x82\xe2\xbfFW\xe1\xa1Qb\xb2`\x13l;\xe3\xc0\xb8\xca\xf3\xc3\xdbv)\xdcR\xf0\xafpt\xb99X\x99\xefK\xa3\"Y683n0\xfc\x95⑻?\xd2[<\x1e\xc17\xd4AT]\x1d\x8dW\xd5\x02\xf6\xde\x0e\xf0\xf6\x16\x87\xb6\x11\x81i\xc3O}\xbd\x1d\xf7\x96\xf6ت\xf3\xde\x1a\xb1G2^\xacٍ\xb2\x8c\xf8\xba\n-2:zT\xfa\x0f\xa5\xa7\x83YVq\x03\x17\xef)\xbc^G\x11\x9d\x14\x13u\xff_\xbe\xbd\n6\xf8\xcf!R\x80\xe3G.\xa5\xcb\xe2\xba\xe9\"ݲ\x9b\x10\x92\xc3c\x007\xb6\x14k2\xf5/\x8bk\x9cͻ\xb7\x14MԸ\x00p\xf4\x02\xdci\xc8G\xd8_\b\xf4\x1cC\xc0\xefM\xac\x8b;Օ\xcb\xe2\x1a\xa6\xb0\xc5\xe9\x9b\xdf\xc56\xf9\x95\x9b\x9b\xcdeq\xadTgO\xab\xc1\xa9\x8a\xef`\x90\xd1]\x1b\xba\xc3TC\x1b\xb3\xad(:\r\x8c\xbc;\xff\x83ih\xa8Զ\x88\xb6-\xfe\x0e\x11x\xe0#\x10]Z~\xa0\xbc<z1\x96;uX\x12\xba\ue5d9w\xad\xa4\x90Ao}K/ͫ\x1b2E\xdeYзv\x9a\x9aZ\xb5w\xc459\x11Y}nR\x03\xbc\x1d\xe2-\x88\xe2.\xc8B\x03\x85p\xe2#O<x\x01o/ 4\x9e\xe3\x80)<\xbf\x80\x1b\xf4}\xb8\x80\x9b~?l\xe2\x82#\xb8\xb1\xbc>\x1d\x13\xad\xae\x80\xa3'\x8f?\xab\x86\x11\xd6\xf0\x824\x9c\x9b\xe3\xe3\xfdUt7\xc8pU\xc0J\xc8\r\U000425f8\xccp\x80l\xa4ס\x03\xf9\x16\xa6\x02\xb1ty\xe4\x85\xf8|\b\x9f\xc3\xed\x05\xbc\xed\xf7\xad\x82RBx9\x11\xa8\xbd韨O@\x10\xee\xe0\r[\x1e\x89(C\x878j\xcdeqS\x108\xce=\xa1\xeeGj\xa2\x99[\xe0V\\@\xd4h\x06\xf4Z\xc0\x899̂PNV<V\xb9W\x1a\xe1%\x04\x8c\ue671\xfehl\xea\xca\x14\xc0\x1c\xb3\xcc\x1c\x1c\x16\xa8$_\xb4\x04\x18m\v\xf8\x8d\xa20\xa79>EM\t\xc7$\xae\x94\xf0R\xb4+ \x0f\n\x1e\xa1\x1d\x1a\xe1;\xef\xbd\xd7TK\x11\x15\xc2Y\v'JoH\xd0\x03\xf6\x8f\x9dk\u07baq\x8f݈}\xaf\xc3o\xd8\x1b\xbbN\x8cy\x03c\xdf@\xf9\xb9B\xd9?\x14\xa7^\x06#`\xdbu\x913}\xd8v\xe8\xf2\xbc.\xee\xd6-\xeb\xf3\xda\x14\xecuq\xf7#͈\xa2\x1a<l\xb9\x9by Fp\xa2\x89y\xc0\xc2>\xfb\a\xad쳰k=Fv<|1<оd6-\b[\fA\x1fng:\xa8\x9av\xa5\xc22GzU\v\xb7\x02\x9f-\xcaW\xa7\x14\x9b\au\xab,lΤJJl\x8a\fm\xacK\xc6a\n[\x13\xf6@io\xe1\x10\xfd\xf90S\x9b\xa1\xf6\xca\xe9ϩ\xfbc\u0381\x92\x1bm\x17\xa4\x9a\xa3W\u038b}e\xa5\xe9oɧ`bE\xfd`\xfb\xf7\x1cE\x99\xe2=\x16{\xdbY\x1e<\xb7\xf1mT\x10\xd0\xed\x0f\xf2d\xdfR@e\xfe\x8dN\xe9[\xb9?\xe3\x1a\xcd6\f\xb9M\x0e\xfe\xd5ڂ^\xb5\\\xb9d\xeb*P\xe7\xa4l\xed\xe92a\xa8\xb7\xb6\xb7|\xaeQ\f\xcc<k*!\xd5Ɯ\xac\xe1_\xa8\x8f5\xb1-jCN\x7f\xbe\x8c\xf3\xf4\xd3\x1e\xaf\x18Z\xb9\xb8\x89\xec\xbe\x18\xd4|Im\xab6\xf7۬롣Ǽw\x16q\x15l3\xda[e\x18>\xda\xe8\xb3\xcc\xe9\xb4\xcc?#_ri\x19\xd42\x04\xb9\x90x\x91\x1c\xff]\xcf\x7fd\xbf\x98\u07bf\x9c\x10\xcbɵ\x9e(3\xb5J\x8b'\xfa\xfa\x81\xad>\xed]\x81\b\x159\xd5X\xeb\xd06\x92\xefg\x1f\xa2\xdd\nP\x93r\xd3\xe1`k\xfa\x1al)\x1c\xb80\xd2\x06\x15\xf7| H\xd4e\x02\xa5Ύ\xa2\xe6\x11<*\x8e\x92,\x97\x91|\xa8\xd9E^\xd0\x11o\xa0\x87J\xb1\xc6J\xc6t\x1b\xd8\x1a>\xb2\x05'0\x16\x03\xf5\xc1\xfd\u0099\xf9\x11{\a+o\xe9\x9c\b\x8a\r\xdeu\xfa\xa0\xaej\x01>\r-\xe4\x14\xea0\xd5\x17I\xa5\xbb\f\x19\xc3D\xbf\xc2\v\xd5ײ\xe7%@\x1fl\x19 \xcf\x17\xca\xea\x92\x06W\x02$\xf5\xc7\x14\x84\xcej\xbb_\x1e\x9cn6K8\xfd\x8c\\\x10\x14\x85bf\xf7\x1b\x04\x85,\b\x8c\xc1H\x93\x15\x1e\"\x1eԛ\x1fQ:\xe8\x90\xea\xff1\"b\x18m>HZ\xf81\x0fݑ6\xd1@\xbf\x15\x8b}\xe8\xd2&gƑ\x1eǏ\xaf\x0e}\xa0\xa4\x198}Bw\x90\xd4i)\xf9\xc8\x13S\xa7>\xea\xe8\x1aM\f_Kk\xdf~\x9d\x96\x83\x06riF\xf3\xdb\a\xa9\xc8-\x8a\xa3O;nS\xcf\xfd\x1a鯛8\xaf\xb3%\xb31\x1f\xe8\xc3t\xa3\xef\xe5:\xe7\x1d\xbf\xaa\x83\b\xfb\x16T\xbd(Y\xb5(\x96\xa9m\vUz\x13\x1a\xef~\r\r\xb0\xcb\x1bn2\xa3\xa3\x80\x06\xb17p\x02\xbf~\x80\x83\x10\xf7Q\xa7\xa7Ea\n\xc7[\x1d\f\xfd\x87\xf8\x87\v\x9f\xf2\xad\x0f$4\x8d\x18\xab\xa8ᚩ\xb3?\xb5g\x90\b\xfc\xd0i\xd3\x12\x0f\xa8\xa6\xc6Ş\x01\xc6l\b̘\v\xffE\x1c\x8b\xa4\x14T\x9dC\xc7\xe5\xff\x03\a^\xcb\xf8x\xa0\xd3\a\xc7\xf6\x8e\x05\xe479j\x1bm\xdd\xfb?\xefc\x18\x99\xe56\x80/\xe1\xf0\x10\x97\xe8k\x19\x86\xfe\xfd^sPӻ@\xe6\xb8ŅJ\xfal\xaa\x15\xc1\x85i\x9e\xc45\x86 \xcd\x16[\xf5T\xea\a\x0f\x86K\xeey:\x025\x85\x90\x17\xea\xf1V=\xea\x02@\xee\xa8\xc7\xdb\xcbm\xd3G\xf8\x9f]\xc4\xef+\x9f\r\x89z\xa0|\xaaA\xb3g9\xe8\x16Pϡ\xad-\x81m\xdd\xff\xe6\x03\xe7\xc27\xf7\xd1\a\x1eT\xb1\xb7g\x0f\xad\xf6\xf0^m\xeb\x87\xd6\xf5\xbe\xbdK\xf5+薋\xbd\x02T\xf9\xcd\xde<\xb6]\xb9\x05\xa06x˔\v;_u\x87Jy\xf8H\xeat\x87\x95\xf8\xddu˦\xeb\xbdC\xdeG\xf6\x92\xb4\xf0~\x12GIU\xc3\xe1\xf2\xe3v\xbaOnL.5D\xe7\xf6\xfaM\\&\xdf\xe69+\x7f\x8a\xd3lSy\xdeV\xcftn\a\x8e?oj\t\xe5\xc1Ql\xea\x03p\xbc\xc6\xfd\xca\x17\xf9\xf5\x92yPT*\xb3\x03\xc3\xd7y\xdaV\x9e\xe5igi\xec\xa6`]\x16o\xed\xf8.\n\x8c\xf2\xcdH^F^ŮW\xf6\xe3\xe9 \xd3ęs\xef\xfb\x9e\xe5gd\xd4\x14е\x9f*\x8c\xa0f9>h\x1d6\x8e\x82\xc9n%\xf0\tg\xa4u\x91\xe9\x0f\xd3^\x89$*\xfd<\x8d`.\x02\x9c\x13\xb1\xe8l\x89Ǯ;;m\xd7\x1a\xabӎ\xb3\x93\xb2y\x86!\x0fT\xe4$~e\x1d\xab\xbc̮\x9aq&8\x91\x9c\x03\x97p<\xdfZ%\x11>\x82\xe3\xf9\xae\x91\nWM\xf7\x00\xac_\xe0\x93\x93\x87Y\x95ꂋF\xfd\xd6\x16\xa4\x198ʃ\xb8\x81L\xce\xc1\xb2O9 \xbc\xd4)\xfc\x89\xeb^\b\x13\xe3Q\xf7\xf7\xee[*R\xd0\xfe\xaa\x1eE\x91)\x7f\x8b@pW\xdel\xdf؞i6\xf8w\xe2\xdc\xd4\x00\xf8\xeflg\xfb\xd8\r(`\x88\x10*@\xdfNN\x15\x96\xa7\vp\xf7_\x17\x95r\xb3\xf5\xac\x87\x88ך\x81\xa8\xa2݃*R7\xe5w\aW\xa4\xd9\xf7\x00E\x83\x97U\v\x90BuX\xd5-\xd7\x03\xde<\xe0z\xc0\x7fg;+b\x11\x0f\xfc\xf3\xc6\t\xfa\x13vv<\xd1-F\x8d\v\x16\xb4\x8aԷ*\xa3(y\xfc\xa47t\xeaԐ\xbapp\xa3\x89\xec䋖\xbd\xfb\xf3D\x94\x95\xf5hT{\xbb\xc4Io\x9b\xec\xff\xda\\$Į\xa2\xad\xc4\xdf<%F\x9d%\xbe\x93^\n4\xc3\xdbey\xa2\x988\xbe\xeb\x85\xfb\xf1\xbcZ\x16\x18\x1eڋ\xad\xbd\xd2c\xe8\xfdG\xaf\v\xf9뚭\xbfd\xf3\xa2d\x0ejy\x14&l?\xd6$\x19\xc1Z%\x91\xe9t\x1d\xd7\v\x9ciaM\x9f\xbd\xa8\x17\xc1\x1am\xabW\xfe׆\xd7t\xa4Jk\xe3_z\x11\x04\x06\xba\xec*\xa4\xf3\x98ޟ\b\xc7\xd0q\r\xa6\x92r\xbe\xddװ/\xe6h4\xf9Ǵ\xebO\x9ev\x11\x8e\xbf\b\x1c\x0fl\u05eb\x98\x9b\t\xff\xbcfR{\xd0ʃWȔq\xfc\f^\x9a\x98\x1cQ\x99HJ\xf1\xa1T\x13\xeeO\xac\\e\xb5\x94\x0f\xe1\xb56\x8a\x1c\xf4x\x18\x16\xf9\b\xfd\x197\x06y]5\x88=\xa8\x99\x96\xd8\x1f\xda\xd0\xd3\xc3\x1a:lih`\xaa.\n6\x94-\xde\xd3\xccK\x89\xde\xe5\xcf\xf8\n\xae\x06I\x91'q\xad\xdasi0\xfd*\xbc'o\\\xaeD\x90\x10\xb7>-wd\xa5\x1f\xad\xab]ĵ\xc0b\x86\x95\x95i\xba\x15#\\\x9e\xec\xa6=\x9e\x82\v\xf7\xd9g\xfba\x8ea\xec\xddey\xf9\xe3\xeav\xbfn\xe2\x14\xa6{+QSI\x0fG\xffЙe\xf4'\xce|<<\x86(O\x005&\xe0k\xa5\x99\x8eU\x82\\\xc1\xca5\xe9T\xc3\xf1\x14z\xff\a\xde+\tpʂ>\xd4\xe2\xf5\xed1\x7f\xaf\xe6\x18'1\x9e=\x12\xd9#O\xf6\x9aw\xbf\xfc\xd065\x93v#5\x03uc\xe8}k\xa7}nF1\xab\xcdF\x1a\x984\xfauf\xe0?>\xbep\x9b\xf9\x8a\xb7c\xc8I\xa5v\x9a\xed\x1b\xf2\x89\x8c\x1ah\xb5\x9b\xb3Ł\x16ܰ`[\x99\xa0ܑy\xb3\xc9U\xd9i,9\rGD\xb8\x15\xc5\xc2\xdb<\x87\x1bf\x86j\xed\xeb\x8fF\xbd\xfbv\xac-EجeK/x\x04\xec\x98hi\x93\xafc\xa2\xa9\x99\xbb\xb4\x88[j\xea\u07bbk\xe2!\xf3`\xeb\xb4cF\xe2\x110b\xd3\x1cÔ\x9fY*P\xf2\xb3X\xe3 \x1b9\xa3sl\x0fO\x1a\x83\x1e\xe5\xc1\xaf\x11\xfcf\x8d\x1beC界\xd5\xe7\xd8T\x14d\xaa\x12)\xb1\x87\x8e\xe1\b\x82\xf5\x98\x8b\x00\xc9}\x18\xa9đHD\x11\xb8\xf2]\xc1\x91غ8\xfae\\e\x95\xa305\xb6\xc8\xfc\x98\xfd\xb0\x89R\x0e\x92\x91_\xdb\xcalV\x93}\x7f\x9d\xd1\xef\x9d\xf8\x8d,_oa*\x8f\x03\xc4\xff\af\xd9\x11\xe9V\x80\x90;\x82\xdc\r#\xf5\xff\x84\x03Len;\x14\xaa\xdcn(\x159\x97\a_\xb2w\x19\xaa\x8e1\x91\x89\xbe\v\xbbpO\ag\xbe.\\o\a\xd5\"\x9b\x1bք\xf5V\xe8\x15\x99\xd6m\x01ֻ\x06\xdcN\xc1\x19w8\x0e\xa7S\x8co\xe3M\x18\x8brc\xc9\xfbG\xd3x_\r\x98\x90\x1a\xeao\x97\xa0\x9e\xddGP\xa5\x808F\xb5\x86\xd0*\x81\x1c\x1a\"7\xf4\xee\tN\x0f\x10\x10?\xab\x1b\x8c\xe5\xcc\xd2;\f\xa3A_\x15\xf5Y\xe0g\xfb)\x12\xab'\xde\xc3\v\xed\xa4%\xb2\xdf\xcf\xfe+J\xfe\x83\xa4ʻɖ\xa2\xd1)\x15+\x98B\x8e\xcf\xff\x99\x02\xa2\xa4\xc3'\x1ag-\x1c\x84\x7f\x85\xfc\x83\xc4\x03+\x84\xfbH\x87\x98\r\xef#\x1bp\xd5%\x1c\xab\x03\x9b\xf6_K@6y\xbad]:FC,\xecK\xc0\xb9\xf3\x1e\x8a\xb5\v0\xd6<\x95\xc4\xe37\xe9\xa4\xfc\x8a/\xfd\xb8~\xa5;+\x9dV\x7f\\\xed\xa4\x88\xae#\xa8}v\xfd\xa9m\xd8_\xfb\x86.WG38\x81\\'\x91\xc66\x95͆#\xa9_\xdaZ\x14z$\xe0\xf5\xf3\x9a\xa20\x84f\xf1\x91[|\xe4-\xbeS\xc5w]Q\xfb۔\x96\xae\x1e$\xa9\xf6\xbcZ\x1as\rv\xc6\x1b\x14s\x8du\xc6\xe9\x8b/\xc7\xfck̿N\xf9\xd7\xe9\xd5\u07b9Ē\xbfm\x04*\xba\x90a$zu\xf0\xb0\xc3\xd0[\xf7\x1b\xa8\xa3\bv\xf7+1\xbew\x1d\xe3{\xd7qz\xef:N\xb1\x8enS߲X\xb3\x80\xeb\xeeN\xd7\x06둥\x19\x9fP\x8a\xa1@w\xe1\xe5Wu\xbf\xca\xe6sV\xb2<aU\xbb)\xf1\xado\xe8\xf3U\x81V\x01w\xc7?\xb2\xf7\x14)La\xc5\aX[\xbb<Ӭ\x11zx\x85wU\xa7\x10\xa48\xa4R?\x1aڀ\xd8ugp\f\xa3+\xbc@rbo\xdf\x05\xbeԲQ\xae\xba\x98\xf5}\x91\x17u\x913g3ֱ\aK\xf9\x13\xc2\xf4X\xfb\n\xa6\aq^Opo\xdb'\xdb6&\xf9\xf9\"g\xbf\xc8a\x8ay\x12\xc3\x1f4\x9e\xd1\xe9\xf6\v\xbc|\xfaDcU\xccZ\x89\x92\xa6\x9br\xe3zs\xcc\x01\xaf\xe0\x04\x8c\xd0\xec3\xb3\xb8\x95\x83̲\x9eH\xb6osW\xf09<w\x0eC\xb1\x95p\x04\xa7pb<\xb3\\Yg\xa3\x82\xe2\n\x11;\xe9\xb2\t\x95]\xd9{\x9f\xf1\xa0es15\x99\x8e\xe4H&+O\xb6\xb7؏\xc70\nŊf\xe5s\xaf\xb7\f\xfa\"\x9fF\xed\x13\xf9\x10<\x91~D\x7f\xf4Y\xb9\xbbS\xae\xd088\x8c$0\xff|\xf8\xb6X\xcav\xe7Y\xce'\xb4\xaf\xb6\x8d\xad\xce\xd9\v=.\xe2\xa5C\xb2\x8ab۩̖\x11\xc4\xcdn\xb5t~3\x10\xaa\xa9;\x942\xd1pҋU\x9ay\xb4\x80~#\xf3y\xc5\xea\v\x13'\x9f\x04K8\xd2\x0f\xd9ǡ\r1\xb2 \xf0!\xfbث\xd8\x11i\xed\xfe\",\xee\xf2\xf8\xa0\xfcO\xe8\xf112\x8c\xfd\x11|gA\xff$|\xef\x1b\x85\x86\xda-\xdf*\xde\xfb\x8f\xde\xe1n#C\xebkt\x90\x17\xc9\xd0q#\x19\x92\x1f\x89\x93\xba\xa3T\xf2\xc8\xc4\x02S\f\x18\n/}\xaeL\xd0p)xϣ\x8e\xa8\nFT\x01\xa2\xdaqT\xbb}\xa8v-\xa8v\xa3\x90\xab_\x9f\xd2\xcbe('\x1er1\x9fo\x87\x1e_\x97\x1de\xed\x86\xdd\x0e/\x12\xe3\xc8p\x9c\x19\xf9=gF\xf7t\x9d\x19\xde\xd7wf\xe88π%3\x878\xd5\f?\xa5WͰ\xe1V\xb3\x1d5\x92v\"\x82\xeen\xf4wr\xb9i\x8ec\x1b*\x82\xef`\n\xbd\xefz>\xef\x1c\x9cu\x1e\xea\x9d3\xb2\xbc\xa4\xb7#\x8f\xb7\x04\xa2\xb7\xbc%x}ÇU8\xb4*<\xb0\xaeч6\xee\xf0v=\xd0\xf9HUE\xa2\xb3\xbbG}\x0f\xe3\xe3nhUx`]\xa3\x0fm\xdc\xe1\xed\xfa\xbb\xf8V\xf9\xab\xfe\x7f\xb0oվyD\xaa\x02<6@#\xc2\xecw\x0e4\xf7\f\x80\x97\xd0\xfb\xbe\a\x13\x9c\x7f\x0eꀿ\x8b\x13W\xa3j'ݧ\xbf%\x8b\xa2L_\x17\x9b2\xf1\xfa\vSFgٟ\xe3\x12\xbb\xa2Y\xb6\xa6\x8cβ\xad\xae\xcee\xa7\x973\xa7\xf9\xc3\xfc\x9c\t\xc7\ax:\xa7Y|]\xe4\xf1\xf2G\xad[\xbaH.!\xc5\a\tR\xf9\x18A\x17\x1a\xbe\xc90\x905U\xea\xf6ǉ)\xcc\tLA\x97iy\x1a:\x02z:\x98L'1\xfd\xea\xdcH\xa8v8ۈ\xc8\xdd5\xa8\xc7\x16ښY\xedV\xb3b\xf9\x1a\xef\xde8\xcdyr\xd6]\xe6\xe7ݺQ\xa6\x97de\xb2d\xbd\ue4af\b(\xa8\xb2wV@J\x15F\x9eo\xe7\xb3wL\xee\xef\x7f\xfc\xd6v%\xeb}?\xa4\xb3\x9e\x12O}\xbeP\xbft\x1a\fa\x14\x8d\x80C\xf5\x0f\x03+\xbb]\t\xe3mV\xfd\xd5|\xfeT\xdd\xfePi\x83\xb8\xaeˠW\xcb\xe72z\x917D\x97l\x04\xc1\xd1\xde\t\xab\xdf\xf2\x18]\xbdh\x18\xf6\xcc\xe7\xad\xdah\xf9\x9bI\xcb\xee\xe3\xd1\u0099\xb1\x13\xd4\x1cF\xcb\xeb\xcd,\xcdn\xb3\x94\xc9`\x1et;\xc5\b\r[mfn\xe8@\x9cYW\xe8\xbddF\x98\xb3\x1dh\xbab\x13\xda1\x83\xc2HUao\xf4L\xe4|\x9fC/3V\u009d\x84~r\xfb\xcb\xf1\xf1*\x82\xb7\x11\xdc\xee\xdfE\xa9\xe0x\xab\v\xe8\xf7\xdf\xf2X\x80͇\xff\x82[\xdc\x0f\xa98{}x\vG\x90\x86\xf0\xf9T\xc7\x1cn\xbcy(Z!ޭ\f\xbb\x1f\x1f|oz\xeb\xf4\xfb\x99\b\xe2z\x01\x14\x13\x15\x88\xbd\x0e\x15ǒ\x8a\x17:\x88\xb1M|\x1b\t\x9e#\n\t\ua4cd\x19[ķYQ\xbeyW\x14\xab\xafز\x8e\x03\xeb\x15\xf5\x06HvkEMw3\xadw\x82z\xf8>PO?\x10\x94f\xb7\xfa\t\xa0۬\xca0\xeco\xbd\xc3\xc0\xe1\x8b,MY\xde\xfe@Ђe\u05cb\xdaJ\xba\xcb\xd2za\xa5\x14\xb7\xac\x9c/\x8b\xbb>\xa1\xe4/\x19\xb5U\xaf\x10\xf6\xc6\xc3\xe1p\xbd\xed\x85\xf4\x90]\x10\x0e\xd6q\xc9\xf2\x1a\xdf\xccwwz\xccx\x9a\x9c\xde\xff\xaa\x85\xba`>3\xeea\x8axT\xe9g\n\xdf0b\xa7\x17\x1d\xa0\xf2\xadcz\xfd;\xd0\xf1\xe6\xa96^\x1c\xfaݕ\b\xb2!A<\xc0\xa3-\x18\x9d&\x10\xb1\xc1݂\xb1%\xf59\xean}\xbc\xbcP\xc7\xd9\x12\x8e\xe0\xdc\xf7։l\xae+B\xcbxWl\xea73:\x96\xfc1\xae\x17\x18_\xeb\xc6\xf7\xbc\x0e\xa6\v\x95(\x02\x96\xa72\x89k:\x11,\x93\x18\xa6\r\x94߱\xb8\xc2\xf7\xfeWE\xfe\x05\x9a\xe0јB\x18\tGh\xdeB2\xdf\xd7QS\x03O\xc3\aX\x97\x89aE\x93D\xd1_\xd1\xe9\x96}O\f-\xcao\xb8[\xdetz]a\xdb\x1a\x15\n\xf0jM~\xcb\xfc\xd9Y\x96\xeb[\xf2\x9c!\xf8$\xaaÍ\xa6D\x8bc\x92M\x15\xbd\xd3h\xf6D,s\xa4\xed\x8dj\x92/9\x9a\xf5\x8a\x96\b\x80\xc7\xf2\xb5j\xd9\x18\x85\x883\x89\xea\x91\r\xc9\xf9\xe3\x90\x0eKeM\xfc\x87\xa7\x8d\xed(\xa5\x1a.\x01\xf62\xc0'2\xe6y+\x05m\xa1\x1d\xd7L\xed\x12b\xed\x7f\x13\xe3\xe0\xaf<\xa2\xa8\x19\x8bJ\xdcl?\xd8,\x8c86\x98\n\xac\x835>P%\n\xc3\x14fVj\xb5\x88K\x96\x8a,ml\x12\x9d!0ME)C\x96\xcdb\xb1\x9e\xba\xc0[\xb9\xcc\xf3\x91\xe0\x11:\x8d\xbc\x83\xef\xf3\xa2L\xd8We|\xcd_~U\xbaK:\x98g[\x96\xc2\xef\xc2-w_q\x9c\xa8\x1b\x85?\x13\xce\xc6]\x85\xbf\xc7\a\xdbp\x01\xf0\xd6}vX\xf1M\xed\xad\xfct_\xe9/\x92d\xb3ڐb\x86N\xac\x11\xc4\xcb\xf5\"\x8e Y\xe0\xccf\x1dz$[n\aLv\xfax\x0e\xcb\f8\xacN\xa4\xe5\x97r\x96,\x9e\xdb\xeaV.\x04\x8f\xb2\xe9\x83\xebQ\xf4S\xe9QR\xc3J\xf6\xebJ\x89,ly_ \t\x89~\xa9\x1e\x1fa\xcb\xf2\r\xb3\xde\xddkaC\xd2\xe0\x81.e6\xf7x\n\x89\xf8\xad\x01\x92\xad\x99\x0eG\xf8skd\xef\x9aٻ.\xa7\xe2\xc1\xda~B\xb1\x8d\xb5\x00\x1az@4\xf0\x00Cq\x9e\x16+z\x88T>\f\xe1\xc0\xee\xbaa\xdf;al\x883H7gͥ\x81\x89\"\xf5\xa9.p8\xa5\xe1^Ia\xb9\xb9xd\xf2\xec\x06\x8e\xac6\xa8ܝ'wg\x0ev^\x15\ng\xb2\x85\x13\xf1i\xf4\vO@\xa1Mv\xcd\xfc\xae\xc1\xf1]\x96\xdf|\x95U5Nߖj \xe6\x97\xf1\xf0\x10\f\xaf\xeb\x92\xc4ڇ\xa1kr\xa8\xea8\xb9\xf1ܲ\x1bl\xf7\x15\xf2\\\xb4\x1b\xec\xf6\x15\xfa3N!\xc2\xe7W\xcf$܂+\x06vJ\x06\xe1\xfd\x98ʔ\x95\xf2\xf18\xf3\x00\xb0\x11\x1eS\x9f\x92\x85{\x91\x92}\xe2?XYX(\xe5\v\b}\xe1*\x82y\xf8ަ\x9cIvC\x9f\xf3\xe0[\xeea\xb7\x1b^\xbe5|\r,kr\x17-\xdf\xc7\xdboQڃ\xb8,\xe3\x9d\xeb\xcb2RO]\xdc\xc2\x14\bD\xba\xa2\xdd\xf0Ɏ\xd2,\xed\xcb\b؞\xe3\xee*\xb3\x87|p\xa30eW\xe4\x85\xf39\x18\xbb\x19\xa0\n3\xe3\x05Rs|y\xf6Uo\xf7\xb5\xf0'\x96n\x12\xf6z\xb3\xf2Y\xe8(/pJ\xbcެ\xe4+\xa8]\x88\x11\xe5:\x02\x17\xeb\x1amQ-\x97AE\xf1EV\xd5\xc5u\x19\xaf\xbe\xcc\xf2\xd7\xf5\x06\xa7\x1f\xf9@\xdem\xbc\xdc0ύNO\xc9ope\x94\xe5\xf4\x93\x00*\x127G%\x85R\a\xe2\x1e\x93\xafEx }V-\x96\x83\xe3V\xc8\xea\f7\xcd\xfa\x11\x17\x14\xdd@\xbe\xe4\x02}\x98a\xd59\x0f\xf0ސޭ\xf0A\x9c_n\xc9i\x06\x8e`\x8b\xbe-\xf6\x030\x87\x90\xca_\xc1\xf1\xb2\x0f\xfd_\xc9\xd1D\xe4\xd2{\x83\xe8X\"\xa1\xbb\xfb\x8a\x95q\x99,v\"\xe8p1C\xebd\x04*]M0\xeaծ\x06\b\ue0cb\x92\xf6\xb9\xc9\"[\xa6%\xa3\x17y\xa9zyV\xcf\v\rpb\xb5\xb5Y\x85\x05'\xe0\xca\x02\x96\x1a\x88\xcfZ\xe5A\xf0m\x8e\xa7\v0\x05<\xdeṭ\x81\x83\x92\x7f\x86A\xea\x8d$\xc2s\x0f\xe1\xda+\xd1^\xcf\xe0\x93\xac8\x04\xcd_\x90U\x1e\x1c\xc4\xc2C\x10\xbc\xc6\xd0g\x1eo\xcf\x19G\x01}\x88\x0fGF=A{\xa3\xe6H\x1d\xacXy\xcdx\xa6\x1d%\x99o\xb6\x1a\xf6D\x91\xae\u0601f\x80\xcb+'\xc22ez\xfc\x1c\xac\xb3{\xda\xd3O\xc4\xde.2r\xf8\xd6~\x02\x84E\xa5\xbfo\x84d\xee\x9c\x14\xd68\xe5\xb5p1V\\\x9c\xed\xe5\"\xe2\xf96\xaf\x98\x83\x89\xd4r\x98B< \x887\xfa5p3\x05\xa6rn\x98\x89\xd4u\xc9hm\xb2S\x05\xacP\xba\x13\x1bv\xb6\xaf\x95\xdc8`Rw8\r\x9d\xad\xae\xf1\x8c.\xa9\xabF\xcbɱ{6ؒ\x1cn\x85C\xf7l\xb0\xa3\xef]\x04iI\xac)qn\x1c\x94vl\xf4\x12\x8e\xf0\x9f>\"A?k\xfc\xb5\xc3_\xf8>\xff`8\x1c\xedkn6[f\xf9\xb5m\xa7P\xb03\n\xecif\x01l\xbfϬ\xb7\xde1\x97h\xa7\x1feD\x00j\xaf\xb1\xfd>ޚ\x0f\x81\b\xe8c\x03:\xd6\xce\xe1;\x1f\ue741{g\xe2\xde\xf9p\xef\f\xdc;\x8d[oG\x1e\ar\x9a#09\xf2\xe8\x1c\xf6q\xe0\xee\xe1T\x88Hm\x9b\x10\xbb=\xc1\a\xf9`\x7f$\x9b\xda\xd7);\x17d\xd7\x00\xe1n\xafI\x04\x19\x19\xd5Q\xb1\xe25q\"\xe6E\xf9u\x9c,\x02\xbb\xd3p\n\x12\xed\x8aնQ:\xd9q\xaf\x91~,%\x85{?\bՐw\xa8t\x92Cv\xe4\xf6Q\xc2L\xe1ӷDg\x03.\xa0\xa5N0PJ\xa43\xcbM\x16ю\xfd\xfb\xdb\xf1\x95o\xfb\x8a\xed\xfaq\x19\x8b\xa1\x17A\x12j(^A\x12\xb6\x95\xd33\x8a\t\x13\xdb\x034\xd9S:\xc1Qi\xfb\xe16\xa7#0\x1f\a:U\x8a\xaes\xb5\xb7\xb3]\xc6>ߨN\xa8\xdd\x15\x7f\xe1}\x18\x01\xb9V\x8d\"\xa8\xc6\xfa\xa2\xb7s\xaab\xcew\x17\xf0\x96읳\vҡ\xdf\x1aY\x88˥O\xbd\xb2\uf7e1\xde\"'\xdd\x12\xa0\xc8\x1b]8\x19\xb3\x92\xc57v\xe2\xfbG\xfe\xdf<\x06+ᙚ\x82g\xb4\xed\x06\xa6V\xe7]\xc0\r\xb5\xed\xad\x936\x85\x1b#\x05Y\xd5l枆\xde\xf8\x1b\xeam\x91ݎ\x03Z\xe8cy5\x82\x17ا\xbf\xffN=<\xc5ߟ}\x86\x83\v^\xe0\x1c\x1f\xb6/Gح\xa1\xf09\xf1\x03!Gl)\xc6\xff\xb2~\xdfL\xf0<\xb3u\xd8x\x92\xa3\"q\x92\x1a\x83\xb3\xfd\x04\xee\xbdm\x84\vh\x0e=\xe6k\x00\xbfvM\xf6\x8d`\xc7\xd3wFz\xa9g\x1c\xf3u.\xdf\x00\xf4\x99\xd2\x12\\\xa2в\xa2\x13v\x94\xa0\xccVIi\xae%I\x19ABK\xae>iG\x1cG\x84阊\xe3\xef\x9d\xfd\xe6\x10_y\x90I\xe5\xfei\xfc\xdf\xf3\xa5\x9c\xc8;Vh\x9c\xec\xad%\x98\xea\xb04\x12#ELvy\xb7\xadX\xd7naNْ\xd5̭\xe0\xa2%\x8f\x86aw\x1d?\xcbSu\xaa\x86;\xdfF`\x9dL)\xcd\xd7Y\x8f5\xfbh\xf5Q\xb65\x9eb\xe4\x92)\xc7\xca\xdd]\x98}q\xa4L\bdLU\xcb\xfdo-\x0f}H\b'\x94\xb5k\xb9mk\xa8,N.²\xbdZD:\x98e\xaf~\xa6~8S\xfa_\x82jM\xb7\xbe\xa8ۚ\xce\xe8\\;ݒ\x8b\xd9.\xb4ۜ\xc6TJc\xc5a#\xb5\xc8c\xa9E\xaa\xc3\xcf\x18\x19\x99\xaa+\"錾gf0}$kpN\u05cef\xa8\x87\xc6t]c\x8cx\x12\xe1\xf5\xac\a\x93y\xc1\x83@(zA:ê\x93\x90b\xdd\xcfp\x80\xe2\xc7\x11\b\x84\x04\x17\x9ah\xcd\xf1\x8dLB\xd2U\x1b\xec&$$*\xf1`'A\x90a;\xe3\x8dhgf4Q\xa6\xb3\x06\x96\xbd}\x9a,7U\xcdʿ5dN\xdaL\xe1X\xda\"$\x84\xe1{\xe2l\xfaD\x19JUu\x87\x17{k\xffk[\xedJ̅\x19\xcc|\x9d\xc1_7M{T\xbf\xe4\x17\x1a\xca\xe0\xc47b\xba\x89\xfa\x8e\xcd\xeb\xc6\xc1h\xf7D\xe0P\x8d\x82\xedT\v/[*R#\x12\xbdH&\xfb\xa6FQ\xf2'\xf4M8\x98\xc6\b\xda\xc9\f<\x93J\b/۪T\xd4\xe6\x14\xc0\xfa\x00\x8a뒱\xd7l\x1d\x971\xf5\x9fw\x87.\xcf~qċ\xdf/a\x04\x13\x18\xefA\xfc\xa9\xba\xca\xe8\x14\xd1\xc2\x01\xd57\xc0X\xc8q\xba\x87\xaaO\xdf;N7<\x80\xc6\xd7\f\xcdEb\xe1K\x8a\x15\xb2\xfdp&\x9akU+\x9d\xf6\x84N\xb9\x11\xe4\x91u\x1bo\xdf-\x17A\x19\xaf\f\xa6\xfeFX\x8b\x9alK\x04\xbc\x03>\x87\xa1\x89Ty\x1fP\xa1\xeek\x83\xa2C\x0e\x10p\xea\xf1UQ\xb5X\xa0\xb6d}\xda\x1e \xccm8\xd4z\xba\a\xc7W\x8c\xadY\x1b\x19)[\xd3\xed\xd3\x19\xff\xb5\a\xd5_\xb2*\xaby`B!%\xf1r9\x8b\x93\x9b\x86\x1d\x06=\xb5j\x01\x84jWVl*a\xb9\xf1\xc8@\xabP\xdd[\xac,\xc1\x92\x15\xbf\x12\x92\x82G҆\x13\xa1\xee\xe9v\x81\x03\x90bfH\x94\xb5\t\xa6\x86\xfa*\xb4/%9\xa4t\x8a\x1a(\xbe\xb6p\xd0\xf2,28Mg\xee\xfbF9ţpg\"\x8aR!\xbc\f\x16\"\x1a\xfa0꘠\xb2f7Df\xab\x04K\xfb\xfd\f\xbd\"\x8d\xf1\xe6\xe1'\x9f\xa4.L\x80\xc1\xbad\xcbl\x85Z2\x91fg\xae\x8a\xb4\x91C\x1f\x98\xcaA\xc4'\x04\xa29*\x83\x7f\xefWp\x91\xa4\xef\x8b[\x16H\x17\">{D\xbc\"\xd9\x1e\x99\tS\xf5\xd3l\x8d\x98X\xf4L|a̦\x82͜\xd2\x13\xe0\xd6\xc0|\xb3\x9a\xb1\x12\xfa\x1a\x1dO\x11\x04\xabT\xb3Y:\xe6\xbf\xe8$\xca\xea{\xb2\x14\x93\f\xd6Q\x86\x97ݔ\xe3\xf2\xba\x83]\xcao\xea6[InI\x82\x9d\x99\xe76[\x89\xa5I\xb5H\xaf\xf8\x86c\x19\xbc\xf4\x80\xc2D\xa1\xddC\xd1*^\xff\x18\xa7?l\x96KK\xe2\x9d㈭X+\xb7\xf2\x14b'\x12v2!\x95 \xa9\x82I%P\xbak\xf7\xc3o\x90\"\a\xb4\xfd~\x98܋([\xb3ȿ<\xbd\xe2\xbb\x10e(\x96\x19C\x19^EP\xa5_\xb0\x12!#\f\x04\xa9\u0090\ue32c\xa1\t76|\xb7SzO\u0530\x9fc\xff\xa7[\x1df\x01x\xd5C\xd7P\x9d\xee살\xc9MwV\xc1\x9d[\xb0\xd9\x17f7X=`3\xbf\x8b\xefiu\x1b\xa4(\xd1Y\xcd\xca\bVي᥆\xc62\x85p\x9br\xd9\\\xc8耴fۚgK\x04\xc6v\a3-s\xb1\x9c\xb21\x03W\xaa\xb4\xbaE!\xae\x18\a\r\xfdO\x8a*R\xf8\xbb\xdd?\x15wAY\xdc5\x1f.)\xee\xf8\x11\x1bA\xd1\xf9b\xc8/\x99\xaafv\xa1\xa5\x02\x0e\xc9\x123\xe3\xaf;\fjT\x128\xc8K\xf8c\xef\x8fp\f\xf85(ٚ\xf6\xf9'\xbf\xf4N\xae#\xf8c\xaf\xf7\xc7\x10\x8e\tbB\x10\xae\x9fk\xc9~\x8c\xf9\x95\xaf\x9c\xdd\xc1O\xec\xfa\xeb\xed:\xe8\xfdR\xfe\x92\xff~Iq\xbc$\xc5x\r\x01\x93\xaf\xf0x\xf9\xba\x17F\x8a\x18\xbb\xec\x1f/{\x7fl\x94˯\xb0\x80J{\xc5gZ\xf5M\x8eF\x98\xf8E\x1dȧ\x81T\x8f\x98\x87\xd06S\x90\xfe\x05\x8bSV:'Ϊ\xecO\xc5]E\x85\fY(\x8b;\xba\xc2l+\xaaV\x02G]\xc0\x14~{\x1fY\x8e3\xbc:\xc7x\xe3s\x9a).9\xe8\xe5\xdb+\x8a\"P\xdc]\xbe\xb5t\x11AjѸGm\x92\xc1q\xf0\xf2\x9e\xc2ځ\xd4\xd2K\xecsu\x8b\x19.3#\x98{\xe2\x0e\xd4\xc5\r\xcb\x03\x97EBT\x06˸\xaaɱ\a\x95\x05\x92:\xe7>\xde\xd7\x7f\xfe\xc6\xf61d\xc5\xd2f/+\x96HI\xbc\xac\x98\xa7Y_\xff\xf9\xbbf\xab\xb4\x13S\x83\x0e\xbb2\xa2\xc8\x10\xa8\xb7!yԞ\x9e5{8C\xa3\xb7\xa7\x17\xb3\xe3cxa7\xadikw\xeb\xc9\xfc\xf5\xb4\x82\x93\xab\f<\x16E\xbc'\x02F0\xda\xeeC\x81f\xd7L\x01+\x18\xbbG@\tL\xc1OɅsƐPkF\xa7nkxיn\x1e\xddm\x1cs\xb6\x8c\x86a\x93J\xbbyF\x1c\x81D\x959\xa4\xf2\xf7M\x11\"J\xaaͬ\xaa\xcb,\xbf\x0e\xdeb\x13q\xd4\xeb\t\xb2'&\xc8?\x86m\xb2\xb62d\x8dmY\xc2g\x1f[\xd8V>\xb9\xc6\x00K\xf6\x94F\xddlM\x7f\x17\xfbi\x8e`\xc5\xddE\xbd\x14\xfa\xbaܐ؋G{\xb0{\xe3x|\xfd\xe7\xefĤ\xf7\xf5\x9f\xbf\x11\xbf\xca\xe2N\xde&\xc8\xf9F\xa3\x8e\xb0\xa1\x17\x8f\xda\t\x19:ց\xa0\x86\xa9\x9cU83\xbe\xfe\xf37\xeeF0v\xa2=\x88\xb2\xb5\x80\xff\x8en\xa9\xf9\n\x03\xc4\xfcJAmI\xb1Q\xa7\x8f\x81\xd8}s\xc4\xf9\x98\x8e\xb6\xe6\xb8\xe7Ώ\x8f\xc3\xd0\xe7\b\x8dL\xe0u\xc4\r\xc6\xe9U\xbfjL\xbc\xf3\xc6\x1bN\b\xe6\xd3\x18*Ce\xf8\xa9\xb8\x13\nC\uf5fc\xe7\x7f\xb6\xbe\xf2\x9e\x8e\\\xb3\xe2\r\xde8\xa7k\xe7\x15\x85\x9e@\xffR\xae\x83\xb4ܓ\x15\xfeX.M<\x99\x98\x8e\xb8\xf0\xcd\xf1?\xdf\xe5?\x96Ś\x95\xf5N\x94\x1aԤ\xa9\xbd\xe40\x97F\xe2\x95\xc2;\xb1\xa8\xe8\xb8\b\x8b\xf1\xea\xde\xf0\xab\xab\x01\xbf\xe0\xec\xdek]\x89;\xa2\x94\x89\x9aEl\x7f:\xb9\xf6\xfdS\xb4\xe9?\xa8\xe4\xb1]\xf2]\xaf\x8d\xf3tXY\x05\x85\xb1\xaa\x8a\xf3a\x9d\xfb\xb3\x97\x99\x9c\x8f!4A/\v\xc9N\xc4\xda]\xf37,\xae7%\xb3\bpH\x1b\\\xb3b\xc5\xearw0\xb6W\xc5R\\f\xb5\xf0\xaa\xd0\xe41L\xa1\x18\xcc9p\x15\x99o^\xc4:F\xb9\xe7 զ\f\xa3\xde4\x88\xf3o\xfau\xa9\x7f\x13\xf0\x87\xd1(\xb0g\x1fH\xe5\xa1\xc4}G\x8f\x83\xd0l\xdbATR\xf0\xe7x\xeb{Q5\x17\x17ع\x95,\xd6\xde\x1e\xdd$}\xbfY\xd6\xd9'\xa5K\"\xa1CEb\x96\xf0\xf2^\xd1y\xa0,KZ\xf2\x05\xbc5˺\xad\x9a]\xbe\xbd\xf2\\{\xddӺ\x1f\x8b\xe5\xee\xba\xc8\xff\x0eM\xbb\x1c\xfe][G\x8f\xeb\xdbͲ\x10Z\xed\xda3\xb4\xef\xc7$j\xe8\xa7\x13\xcd\xeb\x92\xc5\xf5\x17e\xf2\x80 \x1bf\xf1\a\xc4\xd90\x8b\x9b\xc1S\x82\xe6Ö*\x97\x05\xce\x0e\xf4Ku\xf0\x9c\xe5AM\xe7\xd6x\xa6|\x13\xc1\x17fN\n}\xa8E\xc6\x16\xa6\xf0\x05\xfe\xa4\x10\xb6_ү\x117\x1eQ\xf2N%\xefF\x11\xbc\x13ɕJ\xaev\xa3F\b\n\xaa(\xae\xe3|\x1c\xec(*\u0089l!\xae\\q^E&Ȼ\xc88,\xdf\n\xd7x<\xadޅ͒p\xd5\f\x89ů\xc0$\xf8O\x85\xff\xdc`\xd2\xcdN\xbc\x1b\x8bd'\xf8O\x85\xffP\xe3\xa8)i\xa4\xbc%4C\a\xa9\xb8<\xe4\x7f\x8e\x92VP}I\xed\x06\xa60\x82\x13\x93\xb1\x82\xcb1\x06\xdfP\a\xff\xb8cW\x9e\x9eDɐs\x0e\x8e\x91l8B\x02Ͱ\x1d\xf2eb\xfcϵ'\xd8Oh\x1a\xa4s\xd1l\x89Z\xc3=\x91\x8605*\xc1\xaf7<\f\xb0\xcd\xe40\x82J\xc3bÌW\x92\x93\x9d\x85eǱ\x8c<Xd\x89jg\xe1\xdai\\7T\x8b`\x81\x8e\xadu\xb3\xd3ɕNN\xad{\xaa\xe0\x89w\xd4\xca\x1b>\xee:y3\xb2x3\xea\xe4\xcd\xc8\xe2\xcd\xc8\xe0\x8d\x85e7:\x807\x16\xae\xdd\xc8\xe0\xcd\b\xa6B0\x12\x1d\v\xecf\xa7\x93\xab\xed聼\xf1g\x1f6'\xb1\x86[w\x06\xd3vp\x9c\u0084l\x06q(zB\xf9\xd0fj\xc4\x05\xf6\x05쬅\xa0\xd5\x1b\xdc\x11\x15\x9b\x92b\n\x05\xd7e\x96\xba\xd6\xf0!\x9f\xbe\x86ց\x12n\xd4큌E\x03\x8aQ\xed\xb9qpI\x1eMpu\xe1\t\xf3\xb7%\x8b\x80e\x10\xd8r\xd7,\xd3_t\xd7\x19\x12\x97{z\xf5\xdd\x02\x16\x8eΕx\xf5f\xb1Y._\xbd\xfa\x1fA\x86քq\x04\xd9i\x04\xb7&/j\xc3\xe9:\x8d\x80E\xfc\x8a\x11ߍ\xde^f\xd2\xef9\x86)\xe8Х3\xfa\x1a]Y\xa0\xd2̟X\xa0\xa9\x0f\xf4T|2\vtn\x81\xca;!s~q\xea\b\x82\x04\xfa\x10sg(\x95\xc6x\xda\xe70l\x95\x855W\x1e\xbeͫ,e\xc1\x9a7\xd8\r\x87=\xe3G\x161\xb9\xc5\x1c\xa9\x97\x83b\xba\x96\xf7\x02\xf3\x8d\xef#\xf5\xdeP\xdc\x16*ۮYx\xd8\x06\x89\n\xeel\xc9#\x8dV\xfe\xd0\xcbX\xc5sڞ\x92\xdeF?\xcf`JQ\xd8E\xb8Ƅ\xae\x1d\xee\xc6\"\xe2S\x04;\x0eK?9,\xfe\u070eNy\xc0\xc3>l1\xbc\xf8\x18\xcbn\xc7\xf8\x89Q\xd3\xcf(\xf7L\xe4\xee\bx\x87\xc0;\xfc$\xe0\x1d\x02\xe3B\xb8#\xe0ݙ\xc8\xdd\xd03A\x88\xe1\x88\n\xf6\t\xe0\bk$w4\xf15\xa6\xaa9\xd4xd\x8f\xddK$\xec\x181\x11 \xb5L|b\xdd\xde{h\xb7EY\xe4E\xf6\xa6fUŖ4\xcdܲ\xb2\xce\x12V5OY\x90\xb3\xaf\xb3\x9aU0\x15)\x00ˬ\xaa' \xcb\xd8\xf7\x8an\x1d+\xbb\xe7Z\x11Y\xb7&\x90\x99\x17\x8a\xb6\x13\xb8Ş1\x92v\x984\xba\xf2\xdd.\x1aTxmH\xd5iJ\x82\xe5:\x81\x87]\xe8\xc3\xf8\x12\xfa#\x98P\xc2\xe7\"\x81\x7fo\t`\xab\x01\xb6\xf0\xb9H\xc0o=\xa9\x84\x91\xba\x97P\xd7\xc5\n9\xc2Æ\x9aS=\xd9\xd2\xd2k\xf6]V\xd5\rv]\xaa\xc6-ټ\xfe:Oyy\x99X\xa2/J#5˳z\xe2\x8fK++\x1a\bt0\xd5I\t.\r\xecO\xf1r\x8eIB'\xef-{\x86EL\xc1ʊ\x1fZ^TO\x0e\xca\r\xa4\x1d\xf5\r\x960m`\xf1\xe1\xc7\x7f69\x7f\xa3Å\x8f\x9ax\xf5\xee*RN\xd8fk\fv\xb2\xf4\x9aE\x80\xf3\xd9\x1e\x89eT\x8e\xc0\x8dT,8\xa1\x7f\xcdT\x1c\x16(\xdef7\xe2\x7f\xcbfRi\x88\x90-\xe1\xba\xff+V\x9a\x12\xb0\x9cE\xb0\xb0\xc8]0\xe2\xe4\xd2\b\xa6\xbe\xe0\xee\xe2K\xe3v\r\xd0\x17A.\x98\x9dh%\xbd7\x85\xf4K\xdc5\x1a\x95\xaf]\xdb\xed\x82u\xf6aZ8\xc7ZT\x95I\xd4{\xa93,\x18<\xf6\xc8\x0f\xda!\xd1\xda\xc3\x13\xfe<\x0f\x16x2\x1f\x86\x17\x8f\\\xa4ˆ\xe9\xdbӨ\x94-\x8d\xe64\xf98(\x1b\x14.\x98b\x9bYǂ\rP\x1c\x1cU\xf0\xbd5\x9a[\xabR\x04\x0e\xcaFId\xe2\x01\x05\x97ނ?\xb1\xeb\xac\xc8\x0f(\xcei\xe7\xc4\xc3K>\xc1\x0f\xf4\xd4\x06\x13\t4(\t\xe5\xe5\x82\rPЯ\xfc-\xfdt\xf5\x1a\xcbU\xb1^\x17UV3E\x8b\xfb⠞\x84Qd\x8c\txF7g\f\xf2*\xba\x03\xe5Js\xce\xee\xbe\xe6}\xfa\x9buL\xc3\xdbf\xa6\xd1h\xaeF\x91\x95TN\xa0\x1a\x1b)\xef\xcdl\xb6\xf6`p\xe7\x83\xe6\x8c`\xddz\xb9\xb0\xa8%\xff\x8ejL\x1e\x86\xd5H\xb9\xecWc\xf2ٯFx\xc93&\xa0\x14\x17\xb5!\xbc\xc4\x1f\x13\xe8\xa7[\xcc@\xd8t'3v\x94\xb1\xd35\b^\f\x12D9\x1a(\xefw\xc4\v\xe4\xe6~L^(\xb6c?\xeatf,\x15\n\xc8D\xd5\xc7\xe9\xce\xf1\xe7\x14\x15\xc4\xee\xd5/\x991\x03ᘒn}\xd9\t\x9cL\xad,\xcf9\xba\x89jt\xd1R9yͤ;_6\xafc\xe7?\x87\xe3G\xf1\x1c\xd23o\v]\xd5\\m\x96\xa3\bز!vl\x04S`\xcbр/GlL\x9f\xe3\x013\x10\x8b[\xa6\x8c^\xa5}\xcc\xe8\xaa\x17\x1b\x89A\x82\xb3\xd6\x14\xd8X}\x86\x8e\x00wx\r\xe8(\xa9l4@\xad\x91\x8d\a3\xe8\xe3\u05cc\x7f\xc56\r\x8d\xa7JF\xc3{W\xb7\xe5\x8f;\x04l4H\x8c*\xc7\xfck4\xa0\x00\x0fi\x04;\t\xa7rbN\x9a(\x15\v86*y\x03$\x03\x90\x8b%X<A\xd6G\xe00\x94\x8dJ\xd2\x11\xd9\x18\xff\x12O\xf1\aq\x13\x7f|\xf6\x19\xa5l\x05\xc8\xd6n)[\xf2\x8e\xbb0ӈ\x90N\xa9\x14\xc5ƍb\xe36~\x89ŏf\xc8)\xe7\x1e\x86\xb1Tm\x1b8\xbe\x10&<6aI\xf3%\x0fE\xbd\xec\x91\bu\x81\x94\xbd{\xf5\xa8G_B\x0f\xb1̎\x18\x80\x8ebY^w\xe8:\x82$k\x15\xc1\xc5\xde\x1d.|-惥.\xd6\x15\xe7\n3z\xda\xe6ך\x94z\x01\xb9\x87U\vf\xf0a\xe9\xe7è\xedX\xf9q\x17\xb2\x16\xa6\x0eې\xd1\xe4Լ\xcdJ\xa3uGϪ\xd1</\x9b\xb5C_õh\xadN\xdeF0\x8f+\xe15\x1cϊ[f\x9aJ\xda\b\xc7\x19\xf3\x05\fQP<9\x9fO]\xdf|P\xa8EeH!I\xe8\x8c\x16\x88\xf5ޫ\xa2\xb2\xfc\x9a\xbbV\xd2\x1aCu\x01\x1b$M\x8f\x13A\xa0K\x85\xc6\xf3\x98~\xb4{шv\x13T\x13\x8bh\xc5\xe8\xf0\xcbǏ\xb1\x88\x8b\x88\xaf\xd2\x15Luw@_\v\xear\xb0\xbd\xf02\x81\xb3-\xc0\xfe$\xeeQ\xf8\x05\xfa\xbd[㤋8\x8fD\x12\xbdY4\x16p'\x94u,\x10\xb0\xc1,\xfc\x04\xac{\xdf5\xb7a\x93w4\xbf\r\x12j+.)k\x14\xc3z\xa4dv\xb7\x8c\xa0\x1e\xfbd\xb5&\xf3\xc8Ҕk\x93\x10Ib\x8d\x16\xd9z\x84\xa3\x1a\x9b^\x8f\xe1\x18\x8b\x1eA}\xda1A9c\x1b^\n|\x13\xa7\xc9jBbyJ\xa7o\xde\x1d#\xfe[[\xb2\x83y\x03\xb6\xbe\xc4\\z\xe1*\xab\x1b\xeb\xb7\x00\xf1i\xb5\\\xa5\xb5#C\x80鴊\x85\x9b\x9b[i\xd35\xb5\xdb\bjw\xd6\xe4\xda\"\xe7\xb7\xd6\x15\xf9\x14b2\x99\xd7n\x9c\x125u\xbcV\xad\x9bb\xdb\xfe\x1f\x1b\xb6a\x1dƏƎv!X\x19AAQ̜=\x19\xdfN7ع`\x83]Uǥ\xc8 \xc7\xeb\u008a\xd2\xee<\xa3>\x8c\x88\x14\x98\x1ad\x92m!\x02\xda>\xf3\r\xac>\xdf\\z\x020\xf0M¶\x16\xf0\xce5\x13\xecaE\xd6\xe7\x04(>~\xffݠwj\xe6|\xf6\x19\x88\x89A\x14\xe0\xcdu5\r\xf0\xf8&\xb5̤\r\x8f\xc2\xf7\x9e\x11A\xad\x15\x11k3\x8aX\xbb`\xe1\xbdv\xcb\r\xdeV\xad\x9c\xadl\xbeb\x9b\x97\x14\r\xfb1\x19\x1eD@7\x83\xb8\xca m\xd4$\x8b\xad\xd6\xf5n\xd2\xf9X\x93C\x88 \x80\x9f%4\xf0!ߩ\xc0'h\xac\x13\xaeN\x8a\to\xff\x14\xdb/\a<\xf1\x84\x1e\xc7\xeb\xdan\xf8l\r\xab,o\xe1\x06\x92̖lդ\xd6x=\xadM\x81ÂJ\x1c\x1dM\x8e\xf2H\x82;\xf49\xb6\xad\xcb8\xa9\xbfo%\xaf\xa5\xb3\x9c\xd7x\xadiF\x19\x88\xd00*a\x1aV\x84\xa9Hj\xa2\x13\xdb\xfcV\xa8\bs\xbf\xcdk<\xf1\xd2%\x96\xb3<\x8d\xa0\xa4\x7f\x97\xe2\x83\x7fq\xcbBa\xc0Ί\x9a\xd4\xd2\bj\xb6Z\xd3C\xb9\xb7:\x17\x15\xda՞ò\xc7\x06CH\xd6\x03\xeb\xd4L\x13hw+\x1ex\x87\xbe34\xd9^\xbc\x99\xd7D\x8d\x93\x93\x80\xa0\xad\x90F?\xd8\xd9yө\x9d\xf9\xd9g*sk\x17\xdcZ\x04#\xc3\\3\xe1\x97<\x06\x14/\x1e\x1a\xa2\xe8\xc0\x92\xfa\x19 \x06+rO݀\xe1\x16(\x17\x92\xc1\x94\x9b\x0fy?\x05\xd49\xcdZe/v\x98\xc0Y\xab\xfd\x9b/h\xc1Ғ\a\x03p-iP6\x81vXz\xd9̞.\x8c\x1eK\xd9\xd2m\xa0\x95oQ\xb2\x8ex\xad\xea\xccw\xad[\xeeu\x8c\x15\xdddK\xf4=\xb8S~,\xeeH(>\xe2\x0e推I\x97\xbe7\a\xba&\x06\xe7t\xf9\x80q*\xc5\xde\x00R\x13b`Կ\xf4\x8d\x0f\xb7\x9f\x0f\x1a\x17\xa5\x1f\xaa\xec\x1c=\xdal좫\x8b\xb5\th\x0e3\a\xe5-\x1d6\xe4\xa9X.t\x06\xf1_\xaaф_\xec\xdb\xe9'W\xa3o\xc36\xf8R×~xE\x9cgh8\x03\xc7!\xd9*\xe9\xe4\xadW@;\x04[\xf2fEMǇu\xb1\x1e8\xb6L\x9c\xe9q\xe8\x14\x86\xf2)\x19]\x17\xd6\xee\x973\x15\v\x98\xa9\xbcƲ\xe7\x93J\xef\x1cV\x17\xeb{\xcf_\xebU\xd7\x00m\x1d\xa1v\x9f\xb0\b|\x9b\x97\xf5\xea\xca\xee\x19߬\xf7!\xd3\xde!\xf3^\xfb\xc47+j\xff\x90\xef\x9e~>\xfe\xfc\xd3BHC\x93\xb7\xb4x3\xb8\x01\xa9\xa2K\xef8w\xcf\xe1\xc2\v>\a\xf9\xce\xd5.`\xd9>\xa3\xe8&\xaa\xbd\xa7\x1a\xba\xddN\x9d\xab7\x18@\xbc.\x19\xfb\x81\x1e]\xf1\xdf\xe5\xc5\xe8\xec\x13\xba\xce\x13\x19OKT\xe6.q\xcd\xf7\xdd\xf6\x01{g\x85\x14\xf7 \x98\xcb;\xcd\xd2\x1dq\x8bOȏ\xad\xb7o\xe6\x81\x17\xc4vR\xabx(\xb1\x11\x1c\xc3v̏5\"\xa8x\x1c1LܩD\xf7\xfe=\xfeS\xf9B#\x90OL+\xd9\x06\x94&\xad\xdab\xa5\xa1\x17\xdb\xe8 l\xa3+\x8eE6\xb4\r\xdb\xf8 lcA[\xb5\x13X\xc7~l\xa7\aa;\xbd\x92-T\x9dp\xa0t\xf1\xb9h\xdd~W|m\xf8\x92\xec\xf0st\xd5.Gu\xb6bo6u\xa2\xe4\x15߇\x1b\xbc\x11\x17\\\xbf\"7<\xe7\x11B\x8co\t/)o\xf0\xef?\xbfjyW\x0e&\xfa\xa3\xcdÉ*\xe7\xf7\x7f\xbe\x98\xcd0\x88\x04֗\xc7+\xf7\xe2\x0e&\x197\xb9\x86\x11\x9c\xb6#䗚Sz\x0f\x16\x17\x1b\xfe2,/\x1a\xe9\xe7\xd3\xc9\x1d\x93f*\xc3\xcf[«\x90\x11+\x98\x8a\xa2\xbeGq\x9cx\x1c(\ao\xd1\xf8\xbbR;[\x1d5&1ћ7\x06\x8f\x8fmA\x02\xbc\x1f\xf9Tc\xe53\xb5պ\xb2\xba\xb4P\t4\xce;\x17\x8f\u05f8\x89\xa1Ȗk\xc1\x0fͅ\x90[B\x1bdZW\x12\x1f\xabƛ\xb7K\xf1\xc6Xs+\xdb\x1f\xdd7\xae\xbc\xd1\xfd?\xf1nwo?\x99\x17\xb4\xff3x9\xc1\xabI\x04G~W\x14#\xfc\xd7MQ˻\xe6\xbd\xdf{\xe2\xed\xb8\bzYo\x9f\xcc}W\x147\x9b\xb5]1݂\x8c\xd7B\xfe\xd3\xd37\xdf\xc7k\xfba7^\xbfG\x18Tx\x96U\xbc\x1eT\xac\xe6\x88\xf1\x8eO]|Wܱ\xf2U\x8c\xaf\x8bG\x90\xd9nl\xabx\xdd-\xcb\xff\x83\xb1\x9b4\xde\xf1!\xe2tdf\\z\xa2\x12\n\xf0'\xef\xfdDl_\x0eS/8\xdd\xf8\x14\xfd\xad\a[\x16\xda\xe4\xe6\xf0\x1228\x9eB\xae\x9fS\x80\x89\xe8\xfe\xbd\x8d8\x80\xfc\x83\t\xff\xd4$\x7f_\xe4\xf5\xe20\xae\xaf4\xe8a\xe4\xdb\x05\x0eo\x06\x112X\xf91q\x81\xa6gp\xa9\xa5\x96܅Q\x83\a\xe1\xa1L8\xa8\xf9\xf7h\xf8\xc7h\xf2\xa7h\xecw\x05>\xee\xf8\r\xc6cii\xb1~C\xa2\xb1\xd6ؓK5H\x06u!.\x9d\x85&\xa6CH\xa0\x85\xf7\xc3I\xd8~\x00\t?g\xab\x8fA\xc2_\x1fD\x02v\xc1\xdfX\\\xee\x13<\x1e|\xe80\xc9S\xb0-\xa2G\"\x03g\xad\x12\xb8\x83)\x1c\xe7\xe2f\xd8\xfde\xeb\x1fӜqgs$.\xb6]\xc7yJ\x14R\v\xef?|\f\f\x8d\xebh\xe4.\x05\x9fÓg\xe8Z\xfc|8\xc40\x89\xec4<`\xe2\xf9\x81\x1a\xf9\xcfĶ\x15p\xb6@\x1fF\xf7\xe6\x125\xec\xab\xfd\xab\xe0߳A釉\xf5\x9f\x8aM9>\xfbgjП>\xacA\xdfg\xf9\xa6f\xd5?S\x8b\xbe\xff\xb0\x16\xbdfI\x817\xb9\xff\x89Z\xf4\xfaC\xfbh\xb9̪\x7fT\xb3N[\x9b\xf5݇5\xeb\x8bՏ\xab\xb6渤ƫ\x1fW\x86\n\xd4B\xeb\x14ơ\xa3\x199\xa4k\x87d\xba\x05\xc2\xdbA\xfb\x9f\xceU\xfa]\x91\x1b\xf7\x96\x918\xbc\xb3\x9b\"-\xa88`6\x7f\x98\rW\xfdw\x15L\xe1\x9d\xf0\xb7\xed\xf5{0\x81\xdeq/\x82w\v\x98\xc2\xff\xfc\x9fگ\xf2\x1d:5>\x19b\x91\x95\xba\xe3\xca\xd3\xff\x15\x9eد\xb1\xbd\xab\xe0X\x933ϖ\xcbq\xf0n\x11z\x12W\xfb6\x82\xdfV\xc5\x0fq\x9d\xddr\x1d\xc6]\xbe\x90!u\xf1\xed\xeb?K5\xa6\x15\x1b\x19Ro\xe3e\xb0D\r\n;\x91\xe1\xb9\v\x0f\x90\xe8ޯ.\xe9\x18Ь\x90\xf31\x1d\xc2\x14\b\x01ό \x1d\xc1T8x\x04\xe9\xd0\xf4\v0H\x84>\x96|\x81\xc0}\x9e\xf0\x12\x13&\x90\x8e\xbc\x81\xd7\xe8u3\xbbv$\x97\x92\x14\x01b\x0f\\KU\x10\x17\xbd0l\xa1\xc0[\x8d$\x9b\x84\xfa\xc6_\x97Y˱h\xf3\xcd\xc15\xf0\x17\x03\xeba\x045^\xb7v\xae\xaa#R\x98\xf2\xd6\xd6(X\x98PYaw\xe8\xb6um\xbfڢ\x8f\xa8\xb1\xfc\v\xa8GMG\x86\xc7\x01\xefY\x82A\x01M\xeb0\xe4\xf8\xc5s\xbf҂vL\x10\x96\t\x9fڏ\xc9&3;\x8c\xe2Mr:*\x8a\xfc\xe8\x9d\xe8=\x84\xa0\x9d\xa5d\x15\xf4\xb1\xd5|\x19\\M\xb3\xc6̴\xa9\x13\xdb[\x7fS'v/\xdb \x9b:!\x83c=l\xb8e\xf0\xbe\xddԉ\xa2B\xb5\x05\xe6Y\x1e/\x97~R\xbeR\xc2b[\xa3H\xaa\a\xf3eA\xe7E\xf4uadА\x84)\x1f\x9af\x06\x8a\x8f\x90\"3\x99\v\xb7\x1a\x9c\x16*\x11=\xb5\xd4\x11N5+8\b\xff\xed\xce\x1d\xc4v\x02\x10mE\xf6H\x8a\x15\xdf0QR\xeb\xc5@\x99\x06\x06\xd1\x02/,\xe6\x19\xa0\xaaU^`\x9ek\x80[-\xd5=+={$\x8f;'L¼b\xf5\xa2H\xdbB65f\x90O%\x86\xa9!;\xdaFG\xb4qI\xbc\t\ao\x1e,\x86\xad\xac\xa8pύ/\xc1\xb2\xb8\x8cD}U$\xe2c6\x16\x0f\x0e\xbdmD\xb0\xe2\xe5\x03\xfb\x91.\x02\x1ed9\x9eP\x9b\xb1\t\x9a\xc5-bhF\xe1\bE\xe1`\x1bڑ\xb98\xe6\xb4X\xc5Yނ\x99\xfb\x1a;G\b\xa1M\xaf\xc0\x10\x84¬\xeb\x10\xa1\x06\xbd\r\xbdu\x97\b*\xe0\xa1/\xcf\xecx\x15\xabF\xbb\xcdv`\xfd\xf4\xfd\x06\xcb\x05VV\x18uzs\xad\xf4\x95Y\x1f\x9f\xea,\xb9\xb1\xc2Q\xae,\x81F\x19eۚ?)o1\xe1kJuH\xb1\x0e\r0^V1\x87\x15Ŋ\xeb\xc9\nz\xaeC\\\xb5\x8e\xb1\x9fx-\xfc:\xba\xf8\x8d*\xab\x8a^A`'\xb0\x8aD\xc8\x05y\xfcn\x11\xf5\xbaf\xebJ\x16rN\x8a3\x98N\xa1\t\xed\xf6\xbe\x90\xf2\xc1\x0ee\x96\x13\x12\xc1\xca\xc1\xf58s\xa5\x85\xf8\x18\xac\xf6I\x8bqg\a\x1fp\x15\xad;\xf1\xd0u\x99\x89\xa7!^\x80\x02\xf7A]\xc1\x89lo\b\xfd\xbe\xf1\xae\xee\n\xa6\xb25\x96g\xec\r\xa6_\x8e\xae\x1cH\xdc\x16\x18+Cci^\x05F\xb7\xe8\xd5]\xf7\x1b\x86\xaf4\xde\xc1q\xa5\xec\x9bF\f\xbe\x86\xc8\xf3\x89\xc5S>)ֻΒ\xbe\x19\x8bJ\xe1\xf0p'.o ?\xf5\xb6+\xa1\x88@\xcez=\xe2IO\xfe\xf8\t\xd70\xfc2\xe2\x87\xe0g\xb2\x8cW\xeb\x8e\xd3\x1ds\xcc\xf0\xd1b\x85\x92\xafc\x9a\x06y\x0eq\xb8\xe2\x9e)\"\x85\xff\x11\xb2J\x82aQ\xcf˿\xe0\x85^\xc2%O\x10H\xae`B)\xe8\x03\xc9\x01\xaf\xba\xc9\xfc\xca\n\xafd\x1c{\x89\x8c\xeeҼ\x9fE\bŪk\xe9l>\xcf$\n\x19\xed\xe4\xaf\n\x8btC\x8c\x85\xee\xf9x~9\xba\xe2\xc8B\v\xb2\xdfװ\xb2\xeeˡ\x00\xbd8d\xdd{\xcdj2\x1dZOf\xa7\xe6!\xf4\x8e<\xb5\xa5.\x9b\xe2\xf9\x9a\xb2\x11\xef\xec=m\xda]\u05ff\x89\xba\xac\xcd\xebNn^\x15\xd20\xe2;\xb1\x16:Ů̟kD\x97\x154\xf1+\xa3\xb4G\xa3\xe8\x16|\x976\xdcӽ\xff\xfe\xf3\xab\xfd\x9c\x91\xe7\xf0\x8aE&\x8f\xfe\xfd\xe7W\x0fdӿ\xff\xfc\xaa\x9bS&j/\xb3,\xea}\xfc2\x01\xee\xcf2Z\x1b\xa8\xf19\x06$\x97\xbf|S\x97\xc0x\xac6J\x1c\xc9\xc5#S\x8bL\x8b\x84t\x14\xe1=\xf6\xf5\x92\xe1W\xd0K\xb3\xdb^8\xa8\xeaݒ!GU\x00\xcb^\xb1\x8e\x93\xac\xde\xf5\x88\xed=1\x1fA\x12\xd7\xc9\x02\x02V\x96Ei\xf2\r\xb5\n\xc4\xf1f]\x16u\x81\xeb5L\xe1\xd5\xebׯ1\xf1+\x96,\xc5sC\x03\x95\x1f\xe92F\xbd0\xd5\xc9\n\xd4$\xecBZ\xbf:`L&\xe1)\xb5x)=\x82u\x99\x15eV\xef\xac`\x90\r\x1a\x06\xe8\x1b%<>\x8c\xe2x\xf4\xde3p8C?=UWcnYY\xd1\xc5\xf2\xdex0\x1a\x0eN{\xb2;\x04\xa7\xe8E{\x98\xaa\x9f\xaf\xf1J\x84\xd3]\"+P\xfd&\x7f\x88\x9e㏁\xfc@\xcfJ\xe3z\x9b\x17)\xc5ȷ\xbb)t\xd0\x19\x95\xbe*\xd6;I\xbbI\xd7\xeb\xcd,Y\xc6\x157\"\f\xdep\x0e\xbfy\x03/5O\t.\x02\xc5{Y\v\xa5\x1bE\xa6\x1a\x84\x935ُC]\xc8X\xcb\xce\xccr\x13\x8a\xca]\xca\xcc+\xb3\x0e\x9d\xaa\xe4\x9f\xdeK[\x9b\xf2`\xc7\xc6\xf5\xf9C\\\xd8t\xdc0\"A\x96\x93\xde\x0f7l\x17\x89\xc4\xcb\x1b\xb6\xbb\xf2;=p\x1a\xde\x10C\x03\xe9n\xc1\xab^ĕ\xc1\x8e\x1b\xb6\xf3i\"\xabx\x8d\xef&γ-\x1cKJP0\xe5\x83j\xf4\x87\x9e\xeb\xeeĄE.=\xe8\xae,<\x95\x8bG\b\xfe\xe1\xe8`\n\xb7FH\xe0H\xb0dUܲ6\no\x98\x10J\x17\x99\xb3\xe4\x1a\x8d\xa7\xe7\x1d\xf8\xf3\x92DI\xa3!7lWy/\xa6\x88\xfe\xb4\rd\x88C\xbd\xb8\xe9'\x92\xa3\xe4\xd6(\xccp\x1f\x930\xa9\xb4\xfb\x86\xd8\xd1N\f\xcf>\x8c\x9cFoH\xec\x9c.\x9e\xd9J\x19\a\xb5hc9\x85\xcem%N\xe4?\x98:Q\x9e\x93\xa7\x93\x89\x99\x13\xfcǊ\xad\x83\xa5'\xfc\x8fJ~\xdf\xde\x1e\x81\xdcj\x90\xa0\xcbh\xd0\xdc\x13\xedՐ$\xf7A\x84\x1b\xb6s¼O\xa7\x8ep\xbe*\xec\x90B\x00ss\xbd@\f\xfa\x94\x02w1JDî\xb7\xcdx\xfb\xc4<\xack\x83)\xf4~\x19\xf6\xa2&\r\xee\xa0i\xbe\xb8\x91\x9e\x0e\x88\x0fdZKO߈\x0f\x91ŷ(\xe6\xccȷ~\x11\xf0(\x83ndB\xe1\x1e\xe6\x9a:\xe4\x96\xc8\xef*\xc6Q^r\x10\xb3\xf4eF\x8fh\xa4\xa7o\xc4Vɮ\\\xfe\x15%\x9d\xc9\xd5\b\xff*\x1a\x13W\t\xcb\xf1%\x1d\xb3=\xbe\x17\xd7\xe0\x05\xccTD0\x8c\a&Å\xa1[#~\xe0\x91\xc2\x0f\xf1\x0f&\xf2\x94\x1d\x88\x1do_\xc7\x12;^p\x8f\x05v\xbaX\x1f\xfb\xb1\xafXlٖĺ8w\x0f\xc6(]1=殛C\xed\xbb\xf7V\x1f\xfb\xa1$\xbb\xfd\xe4\x06\x1cp{JD5\x17&\xffXV\x88\xb1~C\xa0\x97\xa9\x82\x18\xfa\xb0B]\xf5\xf8\xf8\xad\xf7\x01\xd4\xfd8\xc5P\x11m\x945\xe0I\\W-\x96\xa7%\xbc\x84\x15L`\x93\xa7l\x9e\xe5,\xb5y\x99f\xdd\xdc\xf4\xb2\x86\x8eF\xa4z\xc4\xf9Lq\xef\x84\xd4\xd99\xf3lY\xb3R\xb7\xcb\x16M\xb3\x97\xe8\xcd\xce\xc1\xaf\x9b8\xaf\xb3%\xe3\xa4\xf0\xe8v\xa6Ć\x11\f\xce\xc3\xd6\x16e{\x85\xc3t\xdel\b\xc9\xec\xa1\x12AW\nm9Pg\xa9\xbf\xff\x0e1<\x9eB\x1c\x86\x80\x10\x16\xe5m\x82\x10\xcc,\\\x8f\x05\xae\xcf>\xe3\xe3\x90c\x9a\x1d W\x9a\xb2\x0ei\xfa\b\xb4va\xef\xa6\xde\x12\b[\x15\xdd\xfe/ߛ4\xef\xfd\x97\xedM\x0f\xf5\xad\xbd\xa9\x8c\xd9\x1fС\x91|\x1d\xffC\xfa49\xa8_\x93\x0f\xeb[WUjʽ\xccIp\x91\r!1s\xde\xdfG\x12\x92\x7f\x06i\xf8(\xed\xb5ù\xc6\x11$`\xedM\xcb8O\x8dpv9Z\x1c\xcd\xc0\f\xff\xd7\xff\x19\xc1\xff\xfd\xff\xb1\xb5r\x9f\x16f\x9e\x95\xe4\xf0\x02\xc6!\xfc\xdf\xff\x1f3̍\xcc\x18\x85\xf0\x7f\xfd\x9fZSh\x1aS\xdd#\x15\xfe\"}\xd9\x1a\xf8q+\xfdXxS\x82\x10\x8e`lGd\x06\xd8\x1d\x02T\xc2\x14\xac\xb8\xf5\x9eP\x92\x8f)\xe0FI+v\xe3\x10\xf3\xff\xfa?\xe1\x18\x9b}Dht\x98\x93\xfeX~\xe2\xc1G\x89\xba\x85\xbe\xa1\xf8\xde\xda@,\x8b\xeb\x1f\xfe\xfe}\x80\x18\x95$(\xa9\x18pi\b\xc2C\xbaʌ\xed¶\xeb@\xf3B\xb2\xbc\xa5\xc5Yy\x97\xe5\x7f\x8a\x97\xcbI\xd7\x01\xa2\xb7N\xb5\xa1\xaa\xb8&\xca5P멌\n\x8e\x9d\x9eo\xf4Z\x85'q.m\xc6\x10\xa96\xab}Sle<d\xe1ΰ\xe6\xd3\xd6\x0fՋ\x1fg\xd5\x0f\xf1\x0f45\x1d\x1bZ15.>X\x136\xb1t\xea\xc1\x16^\xebhƜ:\xa4^i2\x87\xef\xf1\x8d\xc0j\xc8\x1d\xf4\x17\x159\xc6a\b\x0e\xc15\x7fCm!G'\xb9a\x04\x7f\n#\xb8\x95\x86\x9c꒠\xaf\"\xc0\x8a\xfe\x04}XX\xfa.\xc3\x17b\xe1\x18\x18\x1c\xc9J.\x17x\xeez\x8b*\xed\xadIp]\xc6y\xb5.\xec\xe7\x1fWq]fۆ\xdf\xfd\xe0]\xb6\x16\x17\xef\xd2\xd3\b\x04\x94\x89\xed]\xb6\xf6\x99\u0085\xe3\x92gx\x86\xea\x98U\x1a3\xac\xd0/\xf29H\xaesk1\xa1\x9d\xf7\xbbl\xfd\x1dG\x12\xc1\xbbl]\ts\xe1\x17d\xa0]\x85\x14\t\x86K\xbd\xc7\xdc \x1f\x9b̩,z\nf\xfc\xb0\xd5D\x92\x13\x92\xb7\xfc\xf1\x15\x8d\x04\x10\xf8\xf2핵u~{e\x1c^y\x16\x1a\xc4o\xb2ʸB\u07b4\x8b4\xee\xefZ!j\xe3\b\x17\x80e\x11\xc1\xa2\xf1\xbefc$\xbd\x80\xd3\x10\x96\x859\xb3\xb5B\x9e\x85\xb0\xc8̇g\\O\xb0e\x01/\x9cJ\x85\xb56K\xc9\xc5\b\x8e\x11\xc3\xe7\x9f\x7fn\xaf\"X\x9f\x1c]\x11ė\xab,\xbd\x8a\xb0\x10\x9e~o\x05}\x88\x03_\b\xe0\x83\x96\bYeiGp\x9ee\xb17\f\xf0\x7fMVm\xe1\x05\xf8\xd9\x15j\xbep6Y\x9c;\x88U\xc6\xf9\x88a\xdc2\xc4\xd1\x10NmKlܯ\xb8P\xe61\x05\x8f\x0f\xf3\xc3\xd4\xc4F7\xe3-\x18\x13\xffO\xd8YN\x01\xea@Qb\x9e\x95\xd5A\x9a\xbc\x7f\x99\x91)t\xda~\xc0&m\xceiS6\x00\xbf\xf1\xcct1r\x16\x8c\bl\x1d\xfds;\xea_\xbcG\x15\xb5\xb61\xcb\xf8\xbft\xd3_L?\xa0\xed9\xab\xbcn$2\x01\xcfqd\xcd)[\xd7\v\x9b4JB\xdb\x1e\x9d\x0e8\xde?e\xb1\\nЅ\x82\xff\xe0\xad\xc0\nł\x8f\v$Z\x83\xe8\r\xc5\n^\x82a \xd2\xe9\xfc\xa2y\x19\xef.\x1c\xff\x06\xff\x9e\x92\x9f\xa6 9\x97D\xdc\xf1\xf1\x15%\xfe\x85\x9f\x7f\xf2\xf3*a\xaa\xaf\xbe\xdc\xfdw\xb6\x13ː<\x9b\x92*\x04\x7f*\xb9m#eOo\xea\x10\xc3@K^\xfb\xb2bN\x928z\xb3L\x8b\ue925\x8f38px\xd1\x1d\x92R\xd7W\x19\xf5Ep)\x9a\n\xedVw\xbb\xb8\xefHC\xe0\xba\x15\x1d\xa1k..e.\xae\xcc(#\x92m\\HZ\x0f-\n\xaf?\xb28\xca\bV\xf1Zb8\\\xccV\xf1\xda\x14\x8dX<\xec\x8a\x12\xc4{W\xfcr\x04\xe2\xc2TSĉ\xc8*\xb6B\xa0\xc4\xf78\xb8\xa9&f+\xe8\xb8C6\xc5w\x98c4N\x90\x17B|\xd8\xcb\x1f\x02>\x88\aH\a\xcc\x06\x9d\xe7q\xb1\xfbΙ\x18\xf1\xf8\f\xae:\bT\xdc2?\xff\"z\x94\x8f^\x8e\x06\v\xbb\xe7\xc9b,;\x1b&c\xde\x18\xdaNb\x84C\x9f\xac\xed\xc1c0U\xe3\xf3\xe3\xe4C\xbf\xb9\x8c\x9ag\x97\xa9\xcb\x1f,\xd9De\xb0C\xe1+ʔ\x19\xd1ǕT\x192I;\x05\x98\x02\x81\x1e\\\xd1_\xe4\xec\xd1U\x95\x02:\x1c7\xef8\xaf\xd2\v\xa03\xf7\xe1r\xd2\xe5\xcaq\xe3\xb0\xc7\x18;\x9eCf\xf7쑠}G\xca\xce1\xb2\xac\xed\xb6\xc1#\xa7\xbe\xc69\xb2\xb7Fs~\x95\xc3ԮX\x9f\x12˪=\xa2\xea\xd4\xdd<&\xf6V\xee=\rv'\x14q\n,\x89{d\x0e\xea\xe6\xc1\xaf\xa4q\xcd\xcaզf\x1e-\x86\xde#\xb2\xc3_\b`9\xd6͵T@wF\xbf\x90\xc5\xf9\x0eN\xacd\xbc\x1c\x1ejZ\xa4JX\xfb\x8c\xaa\xbcnR\xea:7ҮP\xfbJ\r\x92\"O\xe2Zl\x89\x91lQ\xcaČ\x91U\xf7jr\xbc\x9ch\xbb!5\xe2#j\xd5-:T;\xb2uͅ\xe7\x16\x12Q\xb3\xd2\xcf<\x8f^'W\xce[\xa1(\xd8\xc6X\xd7\xd5\xc1\x102\x8fF@\xbb\x7f\xcb\xd4!\xce\xf5L\x17\x89ʌn\xd7\xee?\xe1\xd1\x1c\t\x93c\xbe\xb5\xfb\xd2\xf0\x95\x8d\xe8\x92R\xe7\xb9#\xed\x02\xcd\x1bc\xae\xb5\xd0\xcfi\xdd^\xe1\xd8K\xb5^\x18\xa9\xdc\x03x\xe8k\v\xa2\r\xa8\\\x9f\x03\xa29\x94\xd7=\x9d·\xf9<\xcb\xc9?\xae^\x94\xc5\x1di\x86_\xa3\xab_\xd0\xcbx\x16\xe3\x17azF\xd0U\xc9\x05\x14\xa2\x1bq\xa8\x8fIt\xfd嚕\xafɑZ]v$\xb6\x84\xc6\xc15\xc7ĩ>\x9a\xc2\r\xe7\x9f\xfa\xc9\xf8O-\x7f\x94D\xd1}\xe4[\xf4o%\x13\xe0X\x14\xe0\xb1y?'L!\xa7\x90\xf7\xf2[8A\xbfr.9\xfbʿh-\xffȽ\xc5e\x89\x05\x8f\xdac\t\x863\xbe\xabA\xc9\xd6\xcb\x18\x1f\xc7=}#\xe0ߔ,\x82\xde/\xbf\xfc\xb7\xcfz\xa1\xbbi\xd6 0\x85\x93\xcb_~\xf9\xe5?\x7f\xf9o\xbf\x1c\xfdr\xfc\xcb\xcb_~\xff\xe5\xf2\x97\xab_\x82_\xc2_\x06\xbf\xfc\xf6\xcb\xfb\xab\x93kI\x87\xb8A\xa5\xef\xadD\x90;\x84\xe4\xf0R\x18h\x11\x98\x1e\x8e%\xf3\x19\xa5\xad\x8b\xbb`\x84\xfb\xbe\x90ސ\xcdab\xc1Z\xb3\xcfva\x19\x986\xe5\x12\xed\b+\xe6\x7fv\xaed\xbf\x8a\x8d\xc7_\xbf\xff\xeeOu\xbd\xfe\x89\xfd\xbaQKo\xfbh\x91\xb8`*\x90\xaf\xf8U$\x8a\x9e\xacC;Q\xeag\x9fa5\x83▕e\x96\xb2\xef\xb3\x159H\x86\xdeT*\xa2\xfa\xf5\xd7A\xb1fy\xd0\xfb\xb7\xaf\x7f\xeeE@m\xa1`\xbe\x9a<\x02'ȊՂ\xf8?\xb18ee\xd0\xfb\"Iغ\xeeq\xe2L\x9cy\xc9\xe2\x14c+\xd7,Y\xb8s\x87=;\"<A\xbf\xae\xe9\x16\xe9t\ng\x1aB۽\x89\x82:\xae7\x95'\xac\xfc\xe3J2\xa1dպ\xc8+\x86\xc7'\x15n%\xc6\xc3!\xe6U\xc8\xd6\xe1\x90'c%\xa7\xc33ܲ\xb2_\x81\x87\xe8\xf3\xc7j\xe6\xed\xc60\xbf\x12DYyy\f\xf5C\xe4@\xc2\x00\xb5\x13[\xec\x89N\x88\x948m\xf8\x99mk\xeb\x02\xd8\xfe\xc9\xd5\x11\x9b\v\x15^{e?\xf3\xf5^8ˢ4\x9b\xa4\x13\x85V;\xdfVE\xdeh\xa7\xdbB\xc1\x0f\x9e\xd9\xc3\xc5;K\xc8\v\xfa\x04\x8b\xf7\x8c\xdbV\b\xe6i=&\xc3K\xf8\x7f\xbf\xfe\xf3\x0f\x03\x1e\x85\x86\x03Z}\xf3\xde\"lQ\xaf\x96\xf7$\f\x7f\x9e`\xb9v\x8a\x90Ř\xe2;\x1d5\xd7\x00\xc7\xcb\xfc'L\xb6\x0e}0aP\xb1%Kj\x8a#\xa9\n\xcc\n\xe3\xfd\x01\x00!G\x1c\x9e#{U䘺\x89\x97ߔ\xf15\x16\xe2D\xba\xbbJ\x8b{^\x1em=,\xfa\xb82\xfa\xd7\xef\xbf\xfbǊ\xa8X7\xf2\xeaG\xe9G\xc8+\xaan\xaf'\xd0[\xd4\xf5zrrrww7\xb8;\x1d\x14\xe5\xf5\xc9x8\x1c\x9eT\xb7\xd7=\xae\x89oQ\x1c\xbc\x80\xa3\xe7ϟ\x9fl\xb9\xb4p\xd0e\x96\xdft\x80b\xb6\x04m\xc1\xf9\xd7\xef\xbfC\xe0g'\x14Rn\x1d'L\x17ȫvz)\xfb\xa4g\x1a\xf4\xf4[\xa6\xdc-rbr\x81#\xfdu\x13/\xb3\xb9\xf9̀\x19\x92Q[\xd80u@\xaa\xfd\x9f\xe7Ao\xd2\v#PN\x99\x98gjjY\xe3\xe9\x1c\v\xd4\x0e\xee\x98\x19r\x8e\xb9M\xa0̸/Ҹ=g4h\xb0\x88\xab?\xdf\xe5\xea\xd2\x06\xaf4\x84\x97\xa6\x92\x88\xfc\xb4\xd8p\xc9\xc1\x8c\x17Y\xe9\x1a\U000c4a10u\xc2\xc4h\xa4y \x9bf՚n\x17x\xd6.\x92:\x9d/\f\x8b2\xc5\xdd\\\xf8\xce\xcf\xdd\x1d\x83,{\xe9q\x1d\x95yo\xd8-݅\x13\x9f\xce= \x91j\xba\xe3\xcb4c\x83e\xcf\xe65\xddOYfU\xcdrV\xba\x96p*\xa0\x04c\x80\x82!\xba\xb1\xd7\xd3\x1aBf[\xe5\x05\x04\x95m\xebhqmƁ1$\xc6ُ4w\a\xf0\x92\xfb\x1b#\x8a\xab\x81\x12\xed\x89'\xd5h\x9f97\xce\x1bw+\xab5K\xb2yf\xf3a%\xba\x18\xfd\x8a\xa9ěR\x86\x95Q\xe0\x11`\x84\x12\xb2\x90b\x0f\x8e\xaeP\xcd\xe8A/\x82*\xbb\xceU\xfa)Oǀ)\x16\xfc\xf9U\x04wY\x8ag\ap̓\x9e\\E\x90\x14\xabU\xac\x80\x9e^ѨL2\xbcp\xa3R\x9f]E\x92\x99<\xe19Z\xf5p\x1f\xc2=\x99\xab\xcd\\8W\xf7\"\x10\xdb\x14lr\xbc\xac\x98\xeeA\x857\xb4\xaa8V\x1f\x96\xa7\xb7.G\xcd0N\x82y\xa3zÞ\x15\a\x15\x9b\x11\x8a\x06\xf6\xad\x13\xf8@$\xc2\b\xb5\xee3\xab\ufafb\x8cn\xf6\x98Wf \x89+\x06\xbd\xbc7\x91k\x89`\x11ꬎl\xf5\xae\x15\x15f j\x8e\xe1_\x15\x06ū\xa1\xdaEj\x9e\xfdk\xcfE:\xef@\xba~(Ҳ\x03i\xaa\x90\xea\xde3[k\xf6װ\x1dMՠ\xad?:\x88\f\xbd\xaas0\x82C%\xe0\xb1!4.\xcbŷ\x1e2\x98P\xd1I\t\xef\xce\xdf\x7fw2\xbfb\xf3x\xb3\xac/\xbc\xf7^\x9d\xfb\x164\xe7\bf|\xf6\x99\xb0\xa7\xfc+ʐ(\xd8\xeb\x99\a\x059\xbb\xa6\x98@\xf2\xd8\x06w\xd4X\x8e\xa3EN\b\xfc2\xa4\x11\x8eYS\x809ǜ\xf7\xc6\xf8\xc5-\xb1\xf2\xa9ل/;\x81\xba\x84'9\xe4\x18b\xe8.\x17-k\x84۱\xc8\x18\xc2\"\xa1v\xabY\xb1l\xb5\x06q\x9cGS3p\x82s\xf8#&\xdbv\xca<Ù7R\x9ejr<r\x06>Vlm\x1c\xd6#&5O\xd3\x00\x0f\x15\r\x81v\x04\x91C_!\x1c\x85\"\xfa/\xa7\xe2ؼ`\xe5\xcc%\x12\x9d\x96\xa1\xeb\xb2ج\x1b|\x94p\x92V\x17\xa9\u05ee\xf6\x91\xeb\xe8\xe0\xe2\xa7\xe6\xd9{\xcf\xc5(8\x16\xd2u\xe1wZPk\x1c\x9a_\x82\x97\x93\xe0\xf2?\x7f\xbb\n_\x06\x97/>\x9f\xfe\xe7U\x88\xbf\x8e\x7f\xe9\x03&\xfd!|\x19\f1a\xd8\x7f~u\x1c\xbe\f\xa2\xf0e\xf0\xcb@}^\xc6\xfdw_\xf4\xff\xe3_\xaf\u0097'\xcd\x1ahJ\x10~H\xf1Z\xd8ɯ'\x96\rg\xdd8&\xc2@\xa8?J\xe1\r\xe4\xfb\x11\xf2\x9a\xd7!\xa5\xbfޮ\x8b\x9c\xe5u\x16/\xdd\xf2\xf3C\xca\x7f\x93mY\xea\x96,\xf7\x95\x94F*ʵ\xa4J\rE^0TU\xd0:\xb9\x8a\xb7\xa8\x16\xf1\xdfY\x1e\x8c\x87\x04dƘ\x0f\x1b\xbc\xe5\xb3\x10\xb7!C\x0f/6\xf7\xde\xe1?1\xfe3\xc7\x7f\xd6\xf8\x0f\xee\xcb{\xff\xbf\xff/\xfe\xbb\xc2\x7f\xf0\xffo\xf0\x9f\xef\xf1\x9f\x7f\xc3\x7f\xd0(\xd4\xfb\x11\xff\xf9\x1a\xff\xf9\x0f\xfc\xe7o=\xb8\x92\x11;\xcc\xfaBK\xafR\xbb1{\x167\xa7\x1e\xd7\xedB\xeb\x15\x9e\t_Mݡ\x9e\xee\xfa\x96\r\xd9@\xacǮຨ\xdb\xc7\xf5\x06YzJ\xc4\x15\x1c\x8eM\x85\x05\x9f\n\x1eñv\xb2\x15\x94\x9e\xf0\x94\xef~\x18\r\xad\xe2\xaa\x0f\xfb\xe33\xb3\x17\xcf\"\x13+\x86\x9f\x9fRL=Ғa\x02\x99ԉN\xd1}\xf14\xf4iō\xee\xbe|\x06ǐa\xa1+wT\xb3\xb8b\xf4\xf0\x00L\xd5\xe7\xbaX\xee\x82q\x18\xa9\x84d3\xcb\x12\x17\xe0\xd4\x00H\xf9\"\xddq3_Bf)\x8e\xb0z\xe7\xa3\xc3\x1d\xf3<h\xc8ĭ\x85\x0f,\xa4abQ\xa4\xb6\xb6\xe9\xa4;\xb6\x89j\xb35R\xa9\x91\x87\x94$@\xabh\x95\xe5\x87\x14\xac\xb2\xdc*ƶ\xebC\x8a\xb1\xed\xda*\x96de\xb2d\aQJ\x90v\x9d\xe8\xeb\x84\xedT\xd8y\x02\xcfE\xeb\x8b\xce\xc2/\x91^l\xf2\xe4\xa0*9d\xcb$D\x10\xab\"m\xf4t/\xcb{\x93\x86\x8c\xf0\xba\x8bM\xad\xb3J\x86\x81\x05\xc4\xcb*\xbd,\xef\x17\x9b\xbagf\xcfѬ&\xb2\x8bM\xdd'\xc4\xde\xe3o\x9bnQ2p*\n\xe6\xcd\x19\x15O\x84cۇ״\x9e\xf8m'}\xdc\"\xd70\x05n&\x81\x97^\xa3\x88\xb08p?\xdc6H\xbea\x86\tqM\xa8\xd5zpr]Z*\xd2\xe6\xb8\xe1\xa0+\x98Z]A\xf0+\v\xde\x1c\xa3M\x99\xc2\xd0<\xc1*\x90'\xb1\xf4\xca\x7f㰶Zf\t\x93^kʏx\x14\x8a\x05J\x9d\xac\xdf\xf2\xebCҶ'}\xa4q\xfa\xb2\x0f\x15\xb1\xe5&\x87\xaf\x9b\x96V\x11\x15\xe2\x87\xd7\x01Y\xc1\x06RE\xbe\xbd\x8ep\x03b\xdbD\x82ê\x02\xb8\xc6Ӎ/\xea\xba\xccf\x9b\x9a\x05=U\xa8'C\xb8\x86\xe6\x9e\x02[s\xad1\x0ffq\xc5\xfe\x82\xf1\x05\x8b\xbc*\x96Y\x1a\xd7,hz\xa0\xf0\x80u\xb2PP\xc3K\xa8\a\xdc\xe5\x1b&V\u07b7\xa2o\x94Q70\x88\x90\xc6\x1d]\xbd\xee\x11\x19\xa3\xbdc\x8e\xe6m[\"\x89=8\xe6\xf7\xdcU\x12\xbd\xbaQ\x16\xb5\x95˿)\xab\xbaaw\x7f\xd59\xf8\xc9\xd3i\x1f\xa3\xd3\xf1\x932z\xee\x12\xa0\xa8\xfe\x8a]\x97\x8c\x94\x94ѳ\xa1\\A\x7f\xfc6\xf22B\x19;\xe3\t\x8c\xc4l5\x81!\xff\x95\xa8_\xa9\xcae*m>\x81\xa1!\x8cF\f\xaa\xb6[\xcerl[\xd0E\xa9o\x82\xcf-\x13^\xbf/\xc60\ue283\xb9\xa7\xe0ev\xc5+\bC\xb0\xb7\xb8\x17~\xcax\xb8\xf46\xfafhF\x89\xfd{\xe5\xba1\xf7\xc5p\f38\x82\xda\xd5\xf8\xed*\x7fr\xcfP\x1f^\xa3qt\xaa*\x0f\xbbkoJ\xad\xdb!\xab\b\xb2\b\xdeFP\r\xb9\x8fn5\x12\x7fŉ\xfc\xaf\xe2o\x1eI\xef\xc3\xf4\xf4\x8dQ\x85\xb8\xc6\xec\v\x1fMn<\\\a\x953\xa7\xa7 \x19\xfdf\xa1\xf3\x1c.\x9d\x91\xf2\x15 \x04\xe1a13\xe6\xf1J\x92*\x81Լ\xf0\xab\xebv\x98M@ɘJ\xdcN(\xcc\\\xc3\xefOTe\x1d]VC\x98\xeek\xb5k֩\x86\xf0\x02fʋ\xc4ۂ0t\xf9\xc4-ۿ\x8ab\x17\x10\xeca[\x1c\x868<2~\xef\xc3b`\x81\x88\x8c\x8b\x1eHT1\xd8\u0094\xc7\xd7s}p\x8b\x81\xe7-\xe2\xea\xb2\x18\xf0\x87\x87\xe5\x9dI\x1b\x06\x80C\x90\xdf\xde\xf1\x14\n\xf7\xd9z\xf5\\s1\xc8\xecH\xbe\xaa\xddoi\xb1\xa6\xdb\x14oe3ކ\xf0\xeb\xe5۫A\xd6\xef\xef}ԺA\x01\x1c\x83\xa6\xbb\x8b\x9c\xf1\xbd\xc9\xc1\xe1:\xbe8\xf4\xc1\xfb{p\xf0\n\x1a\xccko\xec\x15ܧ\x9d@w\xb4>\x98\xf5\x9e\v\x1b\xbfz\xdf\xe2\x06\xc8͢\x06\x9eF\x8bH\x1c\xbdSt\xc0_9Y\x16q\x1d\xac\xf8\x83\x1bFJ10\x86\xbb\xe9\x9c\xe4\x7fv\x8cF\xc2`]\xac\x03\xcb,\xd7\xdd5\xbeni4\xe0\x80\xee\xe8\xea\x8a\xf7\x8f\x1c\x86\x19\xd3G\xcb\xf5B\xe9\xfes9TT\xc3K\xf8\x15\x03xm\xa1\xfb-\xed\x99\xe7\x92d\xe7\xb2cN\xdf\xe6\fS]\x06rr\tU\xf3\x83\xba\x11nV<:\xd6۳F\xfd\xecS$\xddeʳ\x1a}\xc1eG+~q\x18\xc1\x97n\xe2\fw\x0e1L\xe1\v\xad\x8dEP\xcf`\n_\x9a)%\x87\xe1:Y\x04%\a\x90\x9fw<\x17\xf5\xb2\b\xeex\x1e\xff\xb8\x119<R\xe7\x8d\xc8\xd2\x06c2\xf1\xc7\xd8]\x8f\xa7P\xcf\xf0\xc7\xef\xbfC\x1d_\x8ed\xcaȘ\x8b\xc52a\xea\x92\x11\xf0MB/\xd2?\xc3^\xe7j7\xb2\x969\xef\xf8\"\x92\"NP\xa8\xae\xa6\x81\x85\xe6\xf4 4#\x8ef\xa4ф֕Vb\x80j\xf7\xde\xe6\xc21vα\xd1Fg\xd4\xc92\xbd\x86\x7fDI\x97\xf9˙\xe3\b\x15C\x1f\xbb\xf3sԇC\xfcu<\x85\xd3'C\xc3۫\x9c!H\xac@b\tҩQPZ\xc5\xe7\x15$X\xaa\xf7f/A\x1f\xc6\xfb\xb9X\xc6(p\x1d\xfc3\xdb\xd4^1\x1cS\xebL\xd6)\xde\xdc\x11o\xee\f<\x875JlL\xeeߦ\xbb\x18\aJG\x9b\xee:ۤ7DwmM\xba\x91\xa3\xeaFJ\u05cd\x1cU7\xb6\x98\xe50\xf5U\xc1\xf7V\x0f\x18_9\xf4\xe1l?\vn\xf8\x18\xbb\xe9\x1ac\xf9aܼ\xe1\xe3\xecf\xcf8\xa3\xaa\x90\x01#b\xc7L\xb0c\xd4\xc9i\xb5ż\xf1q\xda\xd2G\xf7\xad\x18\xe6ݲ\xa2\xed\xee\xd7G^>~\xba\x9e\xb5-\x1c\xb1\xb0U_ςX`\x99餙\xe1\x82\x1c\x97tA\xb6\x8c \xbe\xa6_\xd7\x11\xc43\xfa5\x8b`\x86\xb9\xb3A\t}\x88\xcb\bf\xd7\xf4y\x8d\x9f\xd7\x11\xccf\xf4\x89sH<;t/\xd7\xfbC\x8f\xbf\xc4R^\xcf\xde,\xd8607w%\x1cc\x9d\xb8\xbd\vۡ\xae\x11\xeaz\x1f\x14\xf6\xe9\x8co\x15\xf70\xf2O\xd5r\x0f#\x17\xd5\xd2e$&\x99\x8c\\\f\x89i\v\xb1\x9f\x8c\aU\x04K\xfek\x19\xc1bD\xac³\xb6\x85ܿ\xcd\x06\x15\xf4\x01\xb7sK\xfe\xb9\x84>,\x8dS\x8b\xc5HN̋\x11\xf4\xf9ĬE~1\x82\x17З\xd9漽\xbf\x13\xd2\xd37\x8bj\x89l\v\x16C8F\x04GP\x13\xe9\xc7P\x89\x8f%~,\xe9\x83\xc6K\xaf\x9b\x8b\xdf\xc5\xfb\xc4q\x197\xc4\x11\x93,q\\J\x8e\xc51\xfd\x8a-q\\*>\xc5\xcb\bf1}\xe22\x17\xc7\x0f\x14\xc7\xf4\xf4\xcd2\x9e\x11'\xe2%J̒7>&CC,>\fY:\x80\x13\x7fJ\xf6\xcaSҔ\xa7Ė\xa7x!\xe5)\xa6(E\x83$2\xb83[(y\x8a\x17\x11\xcc\x12\xfaL\xf03q\xf8\xa4\xe5i\xb6\x90\xf24[4\xe5i\xb6P\xf24[<@\x9e\x92\xe5\x1b\xea\xe0\x05\xf2j!\x18\x97\xe0G\">4\x7f\x0f\xe1\xe2\x17\"x\\\xabf\xbc\x15\x1aq\"\xfe\xe6\xb1q\xd7?\x82\x9c\x8b\x83\xfa\x1c\xaa\xe33\x8cR\xa1\xd2%D\x18A\xd64\xde\xd0\xcc=\x14\xea\xffV\\\x05\xb4\xc8\fb\xbar3\xa3K\xb9\x06\x02Q6\x16e\x13q\xebI\xd9%L\xa0\x99\r4S@\xf7٣\fm$[\xb4\x116֖\xa4\x9b\xe7\x7f\x96\xf7\x8c;͘\xbf\xbd\xe7Lǿ7Fkn \xcb!\xb6\x95PJ\x9b\x85\xe6\xda\x7fysհ\xed|\xb9\xfb!^\xb1\xe0&\f\xe2\xcb\x1bb獾\x7f\xdc\xd8}&\x1c\x05\x82\xfa6Ú\x18G#~,I\f\x9b\xc8f-\xc8\xf6u\x01a\xccB\x89\x06[w\x00ۅ\xed\xbaiݢK*\xfd㫗\xc1\xcb\xc9/\xe9\xf1/\x83\x97\xbf\xa4G\xbfӟ\xe30x9\xb9d__Q>~\xbfT\x17V,\xeb0\x8e\ao\xc7F{\x82pfyU\xc7y\u008a9?\x99\xa1p\xc1\xcdA\xc9\vS[\xba1\xca\xd7Gb\xda\xcc\xf7\xb8\xf9\xafG\xdb!\x9e1\xb32\xc2f}\xe2e\xb5XD\x10'=\xadw\x9f\xaa\xed\x1a(\xb0$i\v\xfc\xb1\xeeE\\\x053:\xc4:\xf9\xcf\xe0\x0f\xbf\x97׳_\x82\xdf\x17\xd5\xf2\x97 <\x19Ԭ\xaa1wbs&=}\xf3\xaaX\x16\xa5\x87ڟ\xaeg\x9c\x96\x03\b\xb4B#\x11.\x912Ô\x99\a\xbbЈu\x05<\"\x9a\xa4\xe7~\x876\xfc\x1c\xe6z\x16\xe8\xa9X]²\xf5\xca2\x82\xeb&\xf9\xfe(\x15\xf0\x12J\x87W\xa8\xa5J\xae\a%*\x9a%\xea\x98\xe5\x808+z\x83\xdf\xd0\xe8\xd1F.\x12\x89\x91\xa1\xa5h\xd0\xe0\x7f\xfe\xcf2\x82\xff\xf9?\xaf\xf1\x9fY\xf3\xb6\xd7\xf5\xecG#\x8c>\xaf\x7f`F\xd6\x17\xa7f\xc42\xc1>\xb3\xd0`F\xd1M\xec\xb3\x12u\xa9\xe1Ƽ\xdf5x\x1a5\xd9\xf0\x12n`\xa2,]H\x15b\xe2\u070e\xe0Z\xfe\xbe\xe6\xc18\xe8\xf7\x8c{w\x9f\x1a\x9a\xdfcrD||M\xff\xceBc\x99E\x16dtr\x91\x19~\xb3\x04^\xc2\v\xc8B\xaa/\xd3Y\x84\xe3\x9ag]\xdbY3\xa0\xb8\x8d\x945\xd3Yve\xda\xc7\xe4\xfc\xdcr2)\xe9>_\x18A+\xc4\xf5^\x88\x19\x87\xb0N!\xad\xdeH\xe3\xf2\xe6#\xf5\x85\xa7Y\x9c\x88\x1b8\xe2\x1dQ\x86\x11\xf8ү[\xd2g\x1d\x84/\xec=Eӭ\x85\xb6,\xd52\x90\xa2!\xc5B`nE|ȁ\xac\xbb\xc5\x12\xad\xf3$^\xfb\x12\xbdÊϘ\xae\aF\x8c\x16\xde\x19\xdd<\xef\xfda>\x9c?\x9b\xcf\xc5\x05\x948\xaf\xb3_7\xecn\x91\xd5<3f\xb3\xf4\xa9\xcc\xfcu\x13c\xe2p8\x9f\xeb\x12\xbfn\xe2U\\f9\xc1?\x9d\xcf\xe7\xe9\x99\xccz\xb7)e\x15\xba\xc0\x8ce\xd7<\xf5|~\x9e&25\xab~\x15\xf4\xcc\xd9Y\"Q̖䤂u\xe2\x7f:5O\x16,\x8d\x97\xab\"OE\xa1Y\x92\xaal\x8e\tK\xe8j\x97\x1bv\x9b\x15KVcֳx<cc\x99U\x16w9\xa6\xc6\xe7\xe3x\x1c\xcb\xd4M\xb9\xdc\xdd\x15\x05\xe1O\xd9\xec\xd93ɇ$NY-+9\x9f?g\xb1$\fc\x92\xd7%\xdbT\x8a\x19C\x9dU$\xb4 \x10\xba\xf1\x93\xe7#y\xe9')\xcax\xc9\x1b\xf1t~>ԩ\xf9|\x89\x0f\xbdʚ\x9e\x9c=?g\xa9\x91]e\xcb\x1b^n\xfeL12)\xb3UEoq\xfc!MFg\xa7*}\x17\xe7\x8d\xceÁjr\xeb\xd9\xcc\xc8\xd0%\x9eͬ\x8c\xebb\x99\xb2\xbc䜙={\xf6dh\xe5\x96\xf1\x8e\x98\xf9\x1c\xffge0&\x10>9\x1b\x0e\xed\x1c\x7f\x91\x9bE|\x93Q-\xe9\xec\xe9\x13\xb3\x96U|\xcd\xf2\x9a\xc4\xf1\xd9\xcc!\xbcXf\xb7L\xd5v~\xfed66\x1b\\\xd0%;θg\x89EHQ&\x8b\x8c\x9a\xf5\xfc\xf9\xe98I\x8c\xac\x92\xa5\xb22\xabHEB\x88Y\xec\xf9\xf3'Oc3\x8bŊ\x8ag\xf3Y\xf2̤\x82\xecǒ\xf7g\xcfN\xd3g37Wrr<?\x9b\x9f͛\xb9̟[o\xca_7EV\x89^MX:2r\xf5\x18x~6\x1c\xa6\xa72\x8b\xb1\xf5\x9a_l\xfb\xc3|>:{nfT7;-%3C|\xb2\x95\xa4\xf1\xc9s\xfc\x9f\x99\xce<\xe9Ez\xad\xe5yĞ\xeb\xe19\xcfJ6+3>\xd8gc\xfcO\xe6,q|\xe8\x19i>\x8f\xe7\xb2\x03\xe6EɪZ1y<~6\xd3\xe56ɢ\xcab^Ƙ\b\xae\xe3,\xaffEY\xf0!\x82\xff\x939\x8b\xa2\xaauEόy\x11E\x9ecJ\x9f\x0e\x87F\xa2\x1a\ai\x1c\x9f\x8fU\x8e\xe0ʳ!\xfeO%*\xf9\x7f6\x1cZ\xa9;\xb6\\\x16w\x98\x17\xa7\xf3\xb9\x92V\xc9D\v͢\xc8\xd9.ewjb\xd5\x19\xb5\xee\xc1'\xcfgr\x0e\xcdr\x8c\x12/\xe47Iϓ\xf3\xc4ȹ&>\x9c\xe1\x18\x92\x9c\xcbn\x8br'x\xadѫ\xb18\x1f\xb2'\xcf$\x8ae|\xcb\U00094558\xc1\x9e\xb0'\xf3\xd8ɘ-7\xd5B \x1b\xce\xcfU\xee]\xae\xf8\xf14\x99\xeba\xb8d\xab\"O\x16\xd9|^䲿\xd5̾D-O\xcaO\x9c\xa6\xcf\xd8\x133GϤ&\xc3x\x96\x98̘9\xfdQ\x8e\xeaE\xdd\t\xf3x\x1e\xa7c\vHthz\x8a\xff\xb3sD+\x9e\x0f\x19{>t\xb2Z\n\xe9~\x9a=IFf\x8e\x9eN\xe6\xf3x\xf84\xb6\xf2\x8c\xf9d<\x9c\x8dc;W\x0f\xd2gO\x136\xb73\xcd\xf9\xe4\xe9\xd3gϞ?\xf7d\xb3\xb6욱\xa5D>\x1b&g)3\xb3\r\xc6\xcd\xe7s\xa6y\xb0br\xc5\x19\x9a\x89\xaa\x11\xa7\xe3$=\xd5|\xcey*\x8eoկ\xc6<o\x8d\xe2U\\\x16\x9cO\xcfL\xcd\x00_Dجl\x9d\xe4ɓ$\x8dc\v\xc0\\\xf3\x92\xd4\xca\xd2K\xc0,>?OO\xad\xcc\xf5\xa6\\/\xa9\xe4\xf3ӧ\xc3tfe\x9a\xdds\x9a\xccN\x9f\x8e\xecls\xc2\x7f:{\xf2\x8c1;\x7f\x8d\n\xa21I\xcc\xe3\xe76\xd5\xd6\xc4~\xf6,\x1d%\x89\x95ϧv9П\x8eΟ\xc9\xe1\xb6\xca\xd2\xdc\x1c:\xa3\xe7\xa3\xe7O\x15ϲ\xbcF\xa7ʕP\xca\xe6JtVYU\xefʢRz\x19SM*\x92$&Oh\x9e1\x935\xe5\xf1m\xfc\xb60f\xeb\x94ũ\xce\xdb)MC\xd6^,\xd3%]\xd8\xed\xfda\x9e\xce\xcfU\xc7\xd3\".'\xbf\xe1\xd0LM\xcbxF\x1d;{\xc6Ʋ\x83\xccU=>\x1f\x0e\xadd\xc1\x93\xf9\xfc\xcc̑\x1d\x9d\xc6O\x87\xa9\xacw\x1d/\x995\xb13ƞű\x99\xabF\xfc\xb3\xf9\xec\xf93#\xc7\xea\xa0x\xce\x18cF\xae\xd5=\xe9\xec\xe9P\xad\xb1\xebx\x1d\xef\xe2\xbbE\xb6\x16\xfc\x9c\xa7\x92\x9fk\x16'\x8b\xf5f>\x17܌g\xcfUN\xb9\xe13\xfa\xb3\xf3S9,\xf4Ē\f\x13)\x9e\xeb\xe5\x86\xfa6M\xe3a*;c]ܥz-\x9e\r\x99\x1esZʟ\x99=\xa5\x98h\x8c\xb7\xb2\xa8vJ}F\rG\xe98e\xb1\x8b՜q6z\xf2\\\xc9N\x15\xa7钩R\xcffg\xe7\xa3S\x95\xa7\xa6\xbf\xf8\xd9\xf0\xe9X%穮g~\x16\x9f=\x91\x14X\x93\"{6;\x7f\xaa3\xaa\x05[\n\xcdz~\xae\xfa\xa2\xcaX\x9eӌ\x12\x0f\xcf\xc7\xe3T%/o\xf9:\x96\f\xf1\x7f2ٞV\x99d\xaa5\x9c\x9f\xc4\xe7z\x91\xb2\xe7\xda\xe1\xb3\xe1\xf3\xa1\x9d\xc3<9\xb9\x9a@c5\xfc\x9a3\xc2\xfc\xa9d\xaf5%\x9f=y6V\xcb}\xcd\x17\xbat<;S\xabt\xcd\xf8\xba84\xd7E\xdc5ּ\x9f\xd3g\xb3y*%\xb9.Vq]\b5\xe2\xf4L\xf2Ӟ}\x86l\x98JDZ\xa5d\xec\xd9X\xf1\xf9n\xc1\xe2\x9a\xcf))\x9b\x9d\xaaT\xad\xc8镘R\xabUq\xa3v\x86JU\xb0W\x97\xe1\xd0J\xd6c1\xa6\xa5\xc4\xf0\xdb7\f\x86\a\xbc\xdfe\xc1\xab\xf7\xee,C\x97\xbe=\xe4ڹB+d\xafcD\xc0\xb3\xc5\b\x96\x87Z\xe2\x16\x8e%\x0e\x8f9_\x8aʂ\x05\x9e,-\xf0\x90r1X\xfa-q\x8bȰSH*\x05(\xa28^Dp\\Ep\xbcl\xd8\v\x16\xd5\xd21\xc3\xfd\xa9Z\xee3Ù\x85>\xae\x19\xce:\xf5\xe46\x8e\x85\xb0\xb4T\xe2\xefRE\xa7z\xef#\xe7\x93ء|\xc4(\xe3Ҳ\x9d\x18\xc7@۴-ɳ]\x7fK\xed\xa07\x89+bɇ\x88X\"E,Q\"\x96(\x11s\xa1\xf1\xc4\xf8\xa5<\x81\xe5%\xf0\xa8\x1c\xcf|\x17\xca<,\xf3\x02\x113\x01\xe5\x11\xcf\x19\xc57ٰ\x17a\x88&\xb4\xc5\xe0\x9a\x97\f=x\x16\x89\x90\xd8\xc4/\xb1ICb\x93\xfd\x12\x9b\x1c*\xb1\xd69\xa9\xdd/\x89a\x1f\x1d\r\x87J\x1e\x8fe\xeb\xff;\x1cA\xd0*T\xb6\rr\x91\x1c&\xb4\x87\xd0\xc3o\x8a\nj\xfa\x1fL\xcd~\xa9\x15'\xc8.5Bj\xf9i\x85\x1d\x7f\xdb¸\xe41\xae\x0f\x95\xdde\x874\x06K\x94\xa0%JВKвC\xd2\xcd\x128z\x06\vcB%Y]jY]rY]\x0e\xae\x15nQ\xe7\xf12\x82\xe38\xc2\xc3\x1fW:9\xe3\xf1b\x8b\x93\xf8W\x98\xc2\xe0\xf9\xf9\xf0\xeci$\x93\xfe\x06\x14\xa8C|\xfd\a~\r\x86Ϟ=;\xb5\x8b:\xd2\xfe]\xbc\xf7\x98\xc4,t\xa8\xb4c\xbb>H\xbaE\x81\xd8k\x18\xb7(:H\xda5=\x0f\x92\xeeé\xd9+\xed\xd2\xeb\x84SЎX\xf4\x18\x0f\xfce\xa2\xac<!\t\a\xbfnX\xb9{M\xb0E\x19T\xf2 R!\xf8b\xb9\xbc\x1f\x8e/\x96K\x0f\x9a\x9f\x8a\xa26o\xd29/,\x1b\x90\xdfc\x10\x19&\xde\xd1ԅ\a\x14\\\x86U\xb2\x1aq\x8f\xd0\x00\xb8c\xb3\x9b\xac\xfe~\x1fتx\xb7\x1f\xa6\xda\vR8\x10\x8d\x16Xa|\xf3\b\xaaf\x8fZ\r\x16A\xeb#\x10̻x\xa4\x83\x9e\x14sx\x9d\xbd{\xb7\xe4\xc1\t{\x12m\xcf\xd0 \xbb\xbb[\xd5\xca\xd1\xf0<\xe1\nj\xc4;\xbb\xb0\xb1u\xf4\xbd\x83p\xb0\xc9\xf1\xec\xe65F\x157\xab\b\xfd\x885\x87Dq\xa7s\x9d\xb7\xb2y\xa1\xac\xc8\xcd)\xe8\xf2J\xc6╹\xdd\xc3G\x81a\xf7Y\xb1|e\x865\x9dy\xab\x15㶙1\xf0\xb0_\xb4\xc5r\xcd\xdf\xcc(\x84\x87\n~.\xbe\xe9W^\xa4,\x02\xf1\x8d\x1f\x17V\xe0\x9bb\x0e\x12%<v\xa4@e\xd8t\xbf\xa9Ը\x96\xc48A\xa3\xdf\x1a\xcf\xf1\xf0yEܦ\xe2\x0f\xe4X\xaf\xec(\xe2\x85_\xac\xf8\xa4\xa6\xe8\xfb_\"\x15C(\xe2\x1dY~\a;\x90\x90X\xc7\xe5۫\xd0Ⱦp\x1f\xf11\x03\x97q\\\x9a\xa8\xac\xf9j\x0f\xf2'\xe7\xd5\x104\xbdT\xa1\xb3M\x92\x04\xd9\x02Z\xf2D\f<\xe2>\xfe;x\xf3&\x8d\xeb\xf8\xcd\x1b\n\x8b\xec\xbe\xe2\"\xcb\x7f\xf6\x19\xf4$`\x0f\xb2\x9c\x8a\x86\xb2\x1f\x15\x0e\x98\xda8;\xdfX\xb0)\xb5\xee\xd5\xf9\xde1\xf6J\xb7\xea\x17+`u\xbbȺc\xfc^R\xfbq\xa5\x94V\x8e\x8f \xa8\xaa\x90#u\xd1'\x13\xad搐/\xfc\a\x87I\x99%f\xfe1\x94\x1bc\xe5\x13HC\\ץ\x1bi\xc0\xb1S\xec\x0f\x93mHAN1\xf8,_-;\xaa\x93`,u&\xfe\x0e\x9a\x91\x1a\xf9\xf5z\x11C\x92(j\xbei\x87\xa9\x03\x8a\xa9\b/9c\xaf\x8dk\xf4?\xbc\xa6b\x03\n\xcc\x18\x19\xc0\x14\x01\xc1\x85\xb6\xabxo\x8b\xd3r\xc3h\x98#\b\xa7\x1aͣ\x81%\xc9\xc8Di\xabA\xc0K\xfa}\x15\xba\xde}X\xdcs\xb3\xac\v\xab\xd9\x1f\xfb\xfa2Y\xc6U\xc5\xd2\x7fpw\xf2a\x16\x98Q7\xeb2[\x05!\x8f\x9e\x1f\x9c\xfc\xe7\xef\xbfT\xc7'\xd7a(*\xb7_\x10\xb4\xc3\xee\x88i\x94Z\xf6]V\xd5\xf68\xf4\xbe\x01H\x051\x1eB\x8d\xa7\xaeD\a\x7fLP:h\xea\b\x84\xd0\xf1\xe6\x8dU\xf5\x0fF,R\x8b@\x19\x7fA\a핅\xad\xec\x8b}D[\x1d/\xfa\xf1'\xa6\x88\xe7.\x86B\n\xda\x1a\xe2\x0e\x113X\xdf\x03\x84ZP\xf1\xf1\xe5Z\"\xbe\x8fhW\xf5n\xc9Z\x04;\x82u\x99\x15%\xc5\xd4w\x1ft\xf7\xc5?\x95\x8f\x84\x9e\xb6K\xfc\xe3\x16\x897\x1e\x1e\x95\xfd\xacC\xfe\t\xeeJb\xf62\x98ڤ\xc0\x05\x87\xe5\xe7\x95ř\x16n\xdb\xcf\xfc\b\xc2\x04\xd4]\x96\xa7\xc5\x1d\xces\xaf\x8a\xd5zS\xb3\xf45\xd5g\x8fT\x14Y\x84\x91\xe1m\xe9\x11\x18{>T\xed\xd1m=\xa4\x97y\xeb\xfcݴ\xaf\xb7ׂ\x9aO<\x93\x99\r\xe0\x1c\xb9D\xa8\xab\x8b\xfb\x0f\x16I\xf1\xc7\x1f-\n\xf3}\x86\x8b\x1b\"\xdeb[{l\xdb\x11\xbc4\xb9Ah($x^\xc3\xc4 P\xb0T\f\x01K݃\x97\xbe\x1b\xd6\xf4~\x8e\x9a\x14y`\x1fDg\xd8\xc0\x15\x97\xa8\x1a\xb3\xe6)\x16U\x17\xb9{=\xf9\xea)\x8f\xa0,\x89\x90\xf9\x9e\xda=\x18\x95$\xc3\xe4\xc0\x12F|\xc3\xf7\xfb\xd8\xef\x06\x88\x7f \xfb\xb3<g\xe5\x9f~\xfe\xfe\xbb\xbf3\xf3u\xbd\x1f\x87\xf5&\xbeC\x18o\xd5\x7f\x0f\xb6\xc7\xeb5\xcbӶ\xb8Y2\x118X3\xc4\x18\xd5\xcd3_-\xb2e\x1a\xb4\xc6\x7f\xe2\x9d$\x03\xa9\xff\xfbO\xdf\xf2\x01o\a\xcbs\xea\xfb\xe1\xf5\x87\xd4آ\xcdZ\x15\xeeӞ\xcdJ9\xf7\x02K\x8b\x96t\xc2D\xfc\xdc\xc7\xef,\xafXY7'\xe9\x19C'\xb9\x06\xdf9x\v\x17x\xe6\x97T\xf2\xfe\x8c7\xacp\x01\xaf\x9d\xdbG[z\x84W\xd6\xda#\x87\xd1\xd2\xd2%\x87\x91\xf2\xa1}%[\x00\x13\xf1s__\x95lUܲ}\xf7<\x98udmO!|W*u\xfd\xa6!\x87\"aRj(`E\xa5\\\xb4\x89\a\a\x0ed\xdc!{\x02x\xe2\x1bn\xaePͲ<\r\x84Y\x82\xfe|\x15ױMx\xd6\xdc\xf8s\x83\x82\x82o\xb9\xfb\x97G\xb0B%id\xc6\xd3\x14\x89\x9bu\x8a7m\x8aT=\xee\xc5\xf2\x9a\x95V\xc26\xab\xcdom\x04\xc0:M\xb6\x19\r3\xf7U\xf4l\xe6_T\x94_\xfd\x82\xa8|\x1aSb\x96\xdf\xfc\x81x\xb7Y\x8e~*6[~\xd3\a\x80\xfd\xa0\xa86_\x18\xc6\x10\x8f%\xc3\xdd\x1c\xd9\xe4\xd3\xf5)\x89\xd8y\x90\x144\x9f.\xdf\x1e\x1f_5L\x1e-q~\x9c\x1a\xec\xb7I13\xf4\a\xe7\xd1-\xd4/\x03\xfeŉ\xac\xfc\xbe\x8bc\xabC8\xa6\xfa@w\xb8\xd93\xe2\xf1\xb5\x0f\xe1\x9a!\x80\xe2\xa5sm/2p\x98oĆ\x17\r\x0e\xba\x06KS2\xf9\x7fZ\xacy5\xba\xb7x\xad\xea\x14\xa1\xa3\xaf\\\x1c\x96\x82\x8b\xf5c^ \xeb\x0f/\xba\x1b\xba\x8f\x82G\xadB\xc2\xe7\xa3{\xf7xc\x8c\xec髊\xdb\x1bڤ<\xbb\x92\xa2`\xc4\x1e\xf3G\x90jp\xd3G\xdd\xd0%\xcf\x19\xae\x17N\x96G\x18}\x82\x18\xc2o\x0f\x10\x17\xafX\xfe/#Q\x8e\x98\xf0\v\xd5+'\xac\xdc\xe1\xf4\x99]`\xd1x?\n\xbdT\xe5#\x1fY\xfb\x85Х\xbc١\u07ba\xdf?r\x8b\x0fxI\x1b\xc5E\x13̲v\x1b\xa0v\x86\xa2\xdcNv\r\xe6\x16~>\xbf\xeb\xaa\x14\x8by-<ۨQ\xe5ce\xa2\xb0\xac6t_96\xcf\x14\x8c\x93\x89\x96\x93\xbc\xc7\xeev\xcfTQ\xf4\x02\xcfoM獣\xb3\xe1\x954\x96\x86\x87\xbd\x17\xdey|A5\xea\xd1\xe9;\xa4\xea\x88\xe3\xef2\x82\xf8\xeb\x8a8%\x06\x97WJSr\x00x\x16rד\xd18Z\xf2\xeduu\x93\xda9\xa1\x95C\xc9\xc8L\xdaՌe:jH\x91\xff(\xf0\xbd/\xd6փ*\xf7F\xe6\x13B)\xd9\xd9\x1e\n\x9b \xac\x03vYt\x9b\xd5\xdd%\xb7\x99\xf7\xe5e^~\xbfR\xbeY\xb5\x9d\x90\xbbOv\xdf\xd7⡬\\\xfapU>\xa9\xe4\xcb3\xf9\xd8A\xf2<[:\xcc\xe4)\x87\x9drv\x1dv\n\xcc\xeeQ\xa7\xaa\xd0\x1a\f<U\xd6\xed9\xe1\x1cz\x0e8\xc5\xf1\xe6\xdb\xe3\xe3\x7f\xccQ\xfc\xd0w\\*\xb4\xa1\xcc$\x8a\xf3\xa59\xe3|\xf6\x99`G\xf7\x11h\xd7I\xb8\xad\xc5\x7f\xf4\xb3Oz\xe8\xdc7`>\xc1\xf1\xb3\xc9G\f\xc5\x1aA\xcem\xb4\xf6\xa6\xea\x02dL\xe7{\x1eI\x13\x00b\xfc\xec3\x8e\x19E\x93\xb8\x8d_\xaf\xb3ْ\xa2\x8e\xe3\x879\xd1Y\xa6\x0e\xd1E惏\xf8\x9f \xf4\xe0\x83hi\xdf\xee:\xce)l\xbbQR\xac\xd6q\x19\x1b\x0e\b:\xa5\xe16P\x94\xf5+\x95\xdbiϼW?\x8a\xee\"\xdaL\x82\x9a\x06\x19\x92\x9b`\xaftu<w\x17A\x12\xaf\xebM\xc9>Ɖ\x15\xfe9\xe4\xc4JVn\xbf¦\xf8$(\x82,'\x8c\xadG\x1dE.A\xf9\x03p\x97\xe2\xebJ7\xef\xc1\x87VAn\x9f%_\xf6\u07bc)r\x8ax\x89\xef\xeaѤ\x92\x0f\x94\xa2\"I\xb6\x1atȡJG\x87\xec\xebVDf\x89\xae\xf3\x9c\xa9gFzC\x04p\x11U\xe5\xf8`\xc3\xc0\xe2\xcd\aJ\xbbfL,p\xa0\x19\r\xb1t\x91\xaa\xaa3ǐ>Q\xa3\x00\xba\xe0\x8e\xac\xe6x\xd8ǰ\xd5\xda>\xc9s\xe3\xdb8\xae \x1d\xa8\xc4\f\xd8ڠ\a-\xaa\xdd\xf3\xf6=\x96A\xc3\x1d\xa2\xb9\xb1һj\xd1ls:\xf5L\xa2r\x97\xd5\xc1\r\n\t\x9b\xb5y@\x1e\xea\xc1\xf5\xe9|\x02?\x9a?\x96\xad\x154\xd7\xc1\x97\r\xeb\aw\xf2\x89\x8cԔ-\xe3\x9d~\xea\x82\xd8\xf6\x15\xa6Y@\x9b\x92ގv\xe1D\xb2^\xfcZ^\xeev&\x00\x8dA\xeb$\x91\x9d\xf1m\x8a\xae\xb8\xc7\xc7V\xe2\x0fl[\x7f\x9bF\xf0\x15*\xf5yq\x17\x84-^\xde«\xb5\xb1\x85\x82K\xe5q\rWp\x15\xba2\x84\x850\x1e\xb6\xa5!Z~Ζ\x8fl\x97\xa3\xa0\x9c\a\x1c\x1f\xc0f,-\xabr\x81\xd8\xc06i6Af\xaa&؞\xbb{<\x18?\x880\xcb%\xb1I[\xc3\xcb/\x84\xab\xf6.\xe2\x9b\xe1n\x1f\xe6A\xfb.\xda\x0f\xda\xea\xb4\xec\xd4\xe6v\xbd\x93\xad\xcfL\xbb\x8eT\xf7!Q\a\x81]\xe7\x84\xfb\x90\xc8u\xa2c\x15ه\"w$\xb9\xb1x\xecC\xf0\xd1ܹ7\xeb\xb6M\xe4\x83\xe6[\x81λ\x8fӛ\xf7\x0f\xdc\"\xb6\xd9\xd2\xfeN\x1eڢ\x8d\xdc.\xd5\xe6\xae\xedҸ\xcfu\xfb\x9f\xd2/\xdb|\x8f(k\xdeo\x88\xc0\xb3\x1apU\xc4];\x9a\x89\xe29[Z\xda:\xb2Ŋ\x06S\x18\x9f\xb7\xc0|\xad^\xccc1\xde\xf4\xa5g\xe9\xfa\xe2I\xb40\xf2,\xa50u\x135-\x91\x7fIm+\"\xb2\x9dR\x8a$?\xb5\xe6\xebT\x99W\x15nד\xcd\xd7\xc1\x1a:\x95*\xb3Ϣ\xf5\xb2\xd1C/AO\xdc:#\xc0EEe\xc0\xa4\xb9\x0e\x99\xb0\xe6ڧ\xd3\xdd\x15\xc0\xd3\xec\x0e\x86|\xb4\x99\xee\xbfꍕ\x8fz[\xc0k\xfd\xea\xbcs20vw\x9e{\x01\r\xdbN\xf3\xd2ɠ\xfb\xe6I\xcb4\xe7\xcej\xcd3\xbe\x89\xea[+K(Є6u\x15gSu\xe6\x10\xae\xcal> \xf5\xb1'[\xbf\x9e\xcd]yR\xf1\xa3\xceV,\xe43\x99\xb0\tT,\b\x9b\xaf\xdae\x1f\xfd\xb2\x8c\xe0f\xf5\xbf\xaf\xcdHN\xdc\x7f@\\\x1ct\xf9\xe6\xd2>DU\r\xbb\x11\xed(\xb4bar\xe4\x06^@a7e\xdf8qFJ\x85\xa1\xa8\x1d\x80=\xe3\xe5\x90\x11c\x8f\x19\xaf\x7f\xc0\xdf{<\xfc?\xf2D\xe5\xa3Y\x88\x0e>(\xe9^\x1d>֑\xc9'\x94\x93\x8fr\xad\xac\xd5\xff\x1e\xb1\xff|\xc7Xn\x04ȩ\xf1[D\x877\xbc\xef\x1b\xd7(\x0ep\xc2\xd7\xe89\xd9\x0ev\xc3\xc5\xff0F\x10.\x97\x1b?\xbc\x8e\x80\xb06\xfd\x93U\xf5)v\xb7\xe5\xcb8\x87)/\xc5e\x85\x1bgS2\v\x13\xe9\xcdkmn\xc3\xe7d\x02\x91M\xfa\x89\xbb\x85\xbe\x04\u07bb\xdcA\xca\xc1 \xae\x89\xa0\xc36ɪ'\xc0=HOU\xa7\xf6\b\xe6Am\x9c\xa0\xfb\xfd\xa3e{\x7fx\xfd\x81-\xde\xeb(\xfd .t\xf9\xfa>\x843\xad\xf8\xba\xb8E\xc6\xe56\xa7\xe1\x1f^{N\xa9\xa8eA\x0f\xb9;\xe8\xc11H\xa1\xb3}\xbe5\xefa\xa2\xbf\xf6\xebE\xff\xe5\xefcQ\v\xf80\x93\x10\xfeyĸ\x91\xa5\xda\xe5\xfaqt\x1es\x1dt\x81\xca g\xef\xa4c\x10rP?y\xe7\x1f1\xfb4;\xabe^>\r}\r\xf1\b\x1cU\xa9$\xce8\xf1\xba\xd7\xd8\ueebe\xd6}o\xed^C\x9d\x13\xcb\a\xfc\x8f\xe6M\xab\xfb\x8cm\xc2Q\xb1\xdaB\xc0\a\xb3\xd3U\xe6\xb0\xde\xdbw\x87\\\xe22\x19\x8f\xf0\xbdV~{\xae4u\xdf$2ܤtǄ\xf2\xdaϡ\x8d\xb8ǽ\x83\x1e\xcbSþы\x9ae\xc4M\x04\xd3w\x9e\x1f\x1f\xbe1*\x7f\xf3\x86v\xdf\xeb\xe6U\x850\x84\xf5a\x97\x12|MI\x85\x8d\xab\xb3C\xda\xce}\x0f\xbc\xb5\xe5;\x15\xd6[\b\x98\x9a\xbdb8|\x0f\xdaϊ\xe1w\xf9\xd64\x9eZ\x05\xd6\xf5d\xccs\x8e\xa4\xbb\xaa\x95\x8c\xda\xcf)m\xdd\xfb\xc70Kׯ\xeem\x8c\xa2\x87\xf1.\xf40\xaf\x81\x94\x00\xf7pR\xd3t?fv\x1f\xf3z.\n!\x1e)\xd7\xcal\xe8.\x01\xb3\b\x8c\x17c\x1a:(\xc1\x7fc\xce$\x11\xc4\xcd+\x85\xb3\xc6\xfc\xe0L\xbe\xe6\xf5Az_\xb3\xd7\xe3\xef\xd5X\xb3\xf1\x84\xe7\xddb\x96\xf5bV\x04\xb7\xfa!\x9f\x86\xe2H(\xc4\xc3?\r\x12\x05\x01\x84x\xe6A<\v}K\xb1\xf5\x16\x90)t\x16C\xe8\xa5\x1f\xdd0ٚ\x1f\xf0s\x02\x01\xbd\x9f\xda\xebE&\x81M\xeb\xbf\xd1~|!\xcb\xcc\xcaV\xac|\x93\x19\xe6}J\x98\xed\xbeM\xc5cZ*\xed\xd7\r\xe3\xce\xc3\xf4*\xa7.\x8bm\xbd\x8d\xcd$\xfc\xb7\xd8ȃ]J\xf3\xb9RD\xdcp\x81ʵޚ\xb4\xab\x04\xa6\xc2\xe6\xdf\xcc\xc9\x19\xc4|A\xeeqV}\x93\xe5Y\xcd\x02\xcaU!\r\xf4-T\x12V}\xfe\xedj\u0092|\x8b7\x97\xb2\r\x83,\xbd2\xec\v\x04\xfb\xd9g\xbc\xd0@\x02Q\x17\xbb\x0e$ \x80\x04\x01\xf8\xe7\xc2ʐ\xad\xa1\xbf\xd6㢭\xa4\xc0T\x9c\xef\x8b^\xbd\x82i\xb3\xff\\\x0f\xa0\x89\xfa\x15\x19<\x99п2E\xba4\x98\xc6%\xf4՛8\xe8M\x87_\x19l\xc2\x16\x13\xdd|W\\`\nɒ\xc5\xe5\xcf\xfc3p\xf3\xd5xo\xe0\x84)\x8c\x1a\x99\xf3\x12uY\xf5Y\xd5lm\xf4\xed{S8\as\x8c\xe2\xdf\xe6\xd9\u0096\xf1\xbab)N\xdbw\x96\xa4DP\x8f\x1a\f\xbe0\x9f>\xaf\x8d\xb7^\x05\x1aZ\t\xee\xa0\x0f\xf5h`\xf69\xd7/F\xbc\xd7C̕D\xd5#%H\x81@\xa2XA\x04\xd4#r\xbc4\x05Ws\x01\x91\x04\xcd\x19A\xb3\b\xa6R\x01.ٯ\x1bV\xd5_\xe4ي\x96\x8fo(\xfb\xf7\xdfe>\x8f~\xf7\xd3\x1e\xa8U\xf1n\x1fH\xb1\x17G\xd5\n\xd1\xea\x90U\xb1ZJ\x8e\x9e`FO\xad\xa5iUl*۫\x8b\x87naeS[ \xd8\x1f\x8b,\xaf5\x14\xcdq\xec\x96\xe5\xf5\xebbS&\xcc\xe3-C\xc5\xde\xcc6\xd7gg\xc3g\xa70\x85\x93\xff\xc1f\xff=\xab\xc5\xd3my|\x9b]\x933\xe9\xa6b\xe5\x17\xd7t\x85\xf5%\xf4G0\x81\xa1\x94\xc9b\xe3\x06\xf73(\x10\xb9\xfbM[\x1a\x8dK\xb4\xac\xc1\xdeW\t\xf0\x97\xda\xe5DVEo+)Z(\xd5ђ\x91M0U\xedo\xb0\x8d\x97Qa>0{\x90\xa5,\xaf\xb3yF\xb3+\x01\x18I\xce\xdaN%\xa4\x1a\x03\x13\xe1\xd5\"\xbb\x95\x1eMV+\x9bL\x18,\xb3\x9c\xc5垐}\b\xf9\x86C\x06\x97\xb8\x06\x8e\xe0*\x02\xfd\xcb~\xee.\xe2ޟa\xa3\xf6\xc1\xb2\xb8>\xa8\xa6\xe2:p\b\f\xc4=n\x99\xbfn\xba\xf7Ȭo\x8ar\x15sFc\xd4\xefU\\\a\xbd\xc1\x90\xf5\x94ו\u0081\x81\x9fMz\xb6\x0eA*8\xf4h\x18\xc1\xd6v\x86\x94H\xf2}H\xfa\x16\x96\xfe\xd6\xc3\x16\aC\x1b[\x10\x87\x87-#\x0f\xc6\xeaײ\xeeD\xa9k\x0e\xc2\x01ۮ\x8b\x9c\xe5u08\xf7\xe0*\xca4\xcb\xe3\xe5!\x14\n\xd0\x00O\x19\xd4\x02>\x81\x1e=\xc0Гkb<\x81K\xb8\xbc\x82\xab\xe6>OT\x99\xc45\xbb.\xca\xddhxH#d\xad\xe1\x80\xea\xc1\xe5L#\xe8\xc0>\xfeP\xec\xe3n\xec\xb3\x0fF?\xebğ|0\xfe\xa41\x8a,\xce_\xe23!\xf3\xa7O\xf1]\x01\xf16ِ\xd1\xcfq\x12\x0f\xc7\t\xfdL\x9f\x8c\x9f\x8e\x9f\xd1\xcf\xe7gO\x9e\xceR\xfa\xf9,9\x7fr6\xa3\x9f\xec\xf4\xe9\xd3dL?\x9fΟ\xd2\xf3\x05\xf4P\xc4,\x1d\xf3\xd4\xd1\xd3\x19K\xe6=\xb8\xf2\xd01\xf6\xd0\x11\xb3\xe4){\xe6\x924\x9f\xcffO\x9f\xb9\xd4=\x7f\x96Ο\xc5.\xa1\xf3\xf9\xf3gϟ\xb84'\xe7\xb3az\ue49f\x9c=O\x9e\x9f\xb9-\x99?\x9d=I\x1b\x8dJ\x9e\xe2\xff\xdc\xf6\xa5\xb3t\xf6,5\x9bJ\x15\xb34f\xe7m\xad\x9e\x89f\x9f>?\x9d=}N\x05\xce\xc7\xe7g\xf1)\xfd|2{\xa2\xd0$\xcfY\xca9\xf0\xe4\xf4\xe9\xf3\xd3\xe7\x82\xfex|\xcek\x9f\x9d'\xf3'\xa2),\x9d=O\x04\xc0\x93\xf4t\xc4\x01\xd2\xe7L\x14cOg\xb1(ƞ&3\xd1\xecgg\xa7\x89\x00\x88ӳ\xe7g\x92\x9dOF\x02/{\xfa\xfc\x89\xc0\xfbtv6zʉ\x8c\xcf\xcfG\x02C\u009e\xa4\x82\xc9){\xce\xd2'm\xcdNd\xb3G\xcfƢ\xc0\x93Y\x8c\x058˒\x98q\xa2\x93'\xe9\x8cq\x0e\xb0'\xe7\xe7C\x0e;O\x9f\xa5\xa7\x89\xf8\x193A\xde<M\x871o\xd5\xe9(>=\xe74==KΞr\xbc\xf1(}\xfe\\\xb0\xe8){\x9e\f9\xc0\xf9\x93\xd9l$*~\x1e'\xcfT\xbf\xa6B\xf2\xe34f3\xc1z\xfc\x1f\x87\xa5\xe7\xce\x04gg\xaa\xd9\xcf\xf1\x7f=\xb8\xb2\xc6\xf2\xaf\x9b8\xaf\xb3%;dn\x95\xb04\xb9^^yf\x06\x0e\xf1\xee\x1e\xd8\xde1\f\xa5=\xd2K\xb9\ak\xbd(Y\xb5(\x96\xe9!h\x15pp\t\x83s\xb8\xea\xc4\xcc\x15\x99zw\bb\t\x1b\xf8\xf1\xdd^[\x9a\xcd\xed\xf5 .\xbdӣL\x80\xb8L\x1c+f\x89S\r\x85:\xfa)N\xb3M\xd5r\x93)\x82r\x04S(6\xf5^\xc0\x181Vu\\\xd6_\xe4\xd7˶PO<\xa2zu{\xfd&.\x93?\xcf\xe7\x15\xab#\x88\xb1\x0e\x96\xa7\xf7.\x98\xc60\x85 \x1e\xc1\v\xac\x1e\xed\xae\x94\x12\x0f\x059\xf1H O\xe3\x90~\xf5!FSY:\xa74x\xc1\xb5\x9e\x1f\xbf\x85\x97\xd0\x1b\xf6`\x02=\x1c\x03\x89\nĒ\x14U@%*\x95Te9OJF\x16\xd4\b\xa1F\x16\xd4\xc85H\xa51ޮ\xd3\xed\xf8>\xde\xc2K싗\xd0\xfb~\x18\xd1\xc3\xc2#8\x86\xde\x17\xfa\xa7\x91\nC\x18E#\xe0\x80\xfd\x83!y\x92\xc4?ԥ\x86\xba\xd4P\x95\x1a*\xfc\x87B\xf2\xa4\xff \xf6}\xeaVP-\x82a\"\xf5\b\xfb\xcb(x\x84\x9dՅ\x13?\xd29\xa5\x8f@#\x199H\xe8\xfb;\xd1@;\x7f\xa8\xf2[\xd9cT2\x04U(\x19:HL\xd6\xfd]\xda3\x8c\x86=^g#\xa6\x80\x9e\r\xc0\x14\xd2ouzd\xce\x04\x16Пuzd\xcc\x02\x16\xcck\x95\x1c\xa9\xd1n\x01|-\x129eq\x99\fl\x9a\xb49\xdfy0\xbf\x19\xdf@\f8\xa3\xbc2\xab\xb8\xcd$\xacE\x19ܺ\xa35.\x13\xeb\xbe:\x12d\xb7\xff\xfe\x04\x19\xe5em\r\x96ދ \x8b\xd7\xf7\xa7G\x17\x97u\xb9\x9dw/j\x8cn\xbd?-\xcc\xea~pd\xe4^t$,\xaf\xcb\"\xf3.\xe3\xfa\xbd\xf3`\xff\x02\b\xc7\a,~!\x9c\xc08\x02Z\x8c\x0eY\x01\xf7,u\x84γ\xde9\xed\xbe4\x16\x9f\x10\x8e\xa0\x8c\x8c\xa5\x87\x12\xe0\xca\x17oA\xf0\xcb2'\x98\xd5\xc0Tl\xe3\x7f\xfc\x96\xb7\xcb^\xaf\xa60\x86#\xb5n\xf6a\xc4\xfaO\fU\x04\xb7\xea{t\x9c\xdbk2\xaf\xe0\xeeLj:\xa1\x8f\"\x04\xfaVYZ\x8a\xb2\xf1\xac77\vLL\xf8\xef(\x89o\xbe{<\xbf\x9f,\x8b\x8a\xa5=\x0f\xdc+\xca\x11\xd0h\x0f\xee\xf3\xa0y6\xec뚭\xf9%u\x132\x9e\u05ecl\x02~\x81\xc9\x1cn\x16WYe\x01|\x89)\x02\t\xe5\xf6\x8b5\xcb{M\x98?\xafYn\xc1\xf9\xda@\x90f\x13f\x9b<]2\x1b\x86\x92\"q\xe3\x96o\x8f-\x80W\"Q\xd4&a<\x84IH\x836\x05\xed#O\u009b\x14\xae\x8a\xbc\xa8\x8bܦ\xf1{\x91h\xbee\xe7\xed\xffC\u07b5\xa3\x8f\xc1\r\xdb\xf1\x80h\x17F\"'\x12\r\xb0\x82\xde\xff&L\xb0\x18\x01O\xbfh\xe7H q\xf9K\xf6.c\xa4\n\x93J>\x86\x138\x8d`\xc4\xff\f\xb9U\xd0Wb,K\b\xd0\xf1\xde\x12\xa7f\x89'VUO\xe0\xca\x19h\x832N3\xbf\xb5\n[!Ƣ3\xe6\xe4\uf7e8\xac\x98M\x156Z\x84\xe8kK\xa7q\xacf\xe2\xd3\x00\x8cŴL\x1f;\x1bngM6\x98d\x99\x12\x1bcjP\xb2[VV\x0e\xa1j(y\xcaQz{1\x8e\xd6\xda\x1d\xb1x\xff\x94\x84P\xfe)\xc9@\xb3\x87\xe1\xa2&\a\xa3\x97\xe1\n\x1b1\x9c\xbe4\xc3\xf9\xa7\x01h+-<{\xe8\x80\x0f\rx[\xa7\xe0\xf9#\a~d\xc0\xcb\x0e\xa5\x8f\x9d\r\xb83\xe0,\xe5\x80\xe7:d\xecL2\x8c\x15\x9cg:4\xecF\xce\xca\xc4b\x97\xebɢ(\xd3\xce\xcd-Ax\xfc\xbb*\xeepO\x9e\xc5b\x8d\xad\xe8\xb8C8\vDP7!긼f\xb5\x80p\x16\\\xae\xa1W\x835\xaa㸥\xae\x06e\x84\xdf#\xfc\x97v\x97\xd5 \x1e\xe2\n\x1f0\xf4\x90\xac\x02Ĉ\xa79ɦ\xbce6<\xff9ĳ\x8bFn\x8d?k\xca\xe55\xc9\x04ʣ\x9ajQ\x13/k\xe4k\xccaS\xcfWLSͮ\xd8r\x1e\xc1<\x02\x0f\x03\xb5?\xfa\x9c\xbbZp`\xc1\xbd\x12\xa6\xc0\x05\xd8̔\x858\x8ck\x14h\x01<\xc0*pxI\xa7\xd7d\x8b\x00\xca\t\x94\xfa\x1eD<\x9c\xa0\xa1@\x7f\x8f&h/P\xdf\xeb!Z\xeeK82Ԭa\x18\xe9\x14a\b\x00\xe3\xf2\xc5z\xd4,3j\x94\x19\x85\xe24\xa0\xcd'Y\b\x8f\xf9L\xa0\x1e#\x03\xe4):\xbfp\x8bG\x8c\xe2\xc0\xbfG^d(>e\x04k\x9f\x7f\x8a\xd8Sꍢ\xb1\xa3<\x0eb\xf8\\\xeay\xa1\xb1\xbb\\{\xab\xe1rX\x0e#X\x0f\xd1j\x14\xc1zԬ\xef\xff\x80\xa1\xd8\x0e\xafG\xee\xfe\x93\x0fN=}Ҹ~-F,\x1f\x97N\xe6\xcfb\xb0\x96\xce~\x942?\xeef\x94P\xea\xf9\xfa\xfe\x9b\x9b\xd2\xda\xf3\x95\x87l\xf7\xa8NK\x83\xe7T(F=`\xbbGEe=&\xc7\xefM\x85\xea\x91\xfbS\xc1\x8b\xcaz̮\xbd?/\xfea\xdb\xdf6\x8a\xfe\x11[\xe0&-n\x86\xb9\xa4\xa6Y|]\xb4\x1cr\xca\x04\x90@\x9e\x85uM\x93:\xc9N\xc3\x03/\x82\xf5)LE\x9fzrW0\x85`=\x1c\xecp\n8\x1d\xec\xc4֙\xee<\xd1\xcc!+\x02\xd8N`=\x1cl\xf5캛\xc0JΛ.\xe0i;\xe0\xfa\x14T(\x1c\xacgM;\xc8uY\xbc\x15ת\xbd\xcb\xfc\x1a\x03\x10\x1dC\xef\x15\xff\x18\xd1\a\x9f\xb9.\xc7\xe6\xc7\xe9\xd5Ǜ\xc84Q\x1a@vď*\x8f\xd7'\xd3=\xd3\xc1\xf6\xe3M\aۆ\t[TkI\x9b\xa2\xa59)l?ޤpOZ,fޟ\x9e\xb5\xc3o\xb0{g{\b-\x9e<\xdf@ܳ\xab0ƫS0\b\x0f\x95\x19\x13\xee\r\x026\xb9t_\xf6\xb5\x87!0\xaa\n\x1c\x8a\xf8\x1eH\xd3\x15\xd8L\rQ\x1dvI:\x8c\x99ғK:u\x19Y\xa6ߓ\xe9\xe9$\xb2\xab\xddjVtφ\x1cę\v\x05A\xb2\x89\x1c\x86\xee\x84\x05*\xb2\x839\xfb\x85\xf2AW\x05\xfc*+\x93%\v\x83*{\xe7\x01o\xf8\x9d\xeax;\n\xc3\xcf\x14H\xae\xca\xde5r^g\xef\xc4\xc8\xe6\xdf\x03Q\xfc\x01#S\x04s\xc0\xff4\r\xad\xa3\x92Wgu\x9d\xa0\xa0\xca\xde=\x8c\x82J\xb5\x05\x8c\xb6އ\x82F\x8ekl\x14\xbd\xe7\x9a\x18\x13ꡉ\xafۄQ\xad,\xaa\xcaxM\x06\xc9kZ\x9a\xb9\xf2\xffkYS>\x9c\xc09-|ޕ\xa7\x7fJ\xb6[\xa9\x93\xf7\xe9\xe7\x9f\xf4Ͽ\xd8@\x7fR\x8a\xfb_\x1cp\x05\xf2\x17\x05\xe2\x00\xdbH\xfa>`]\x91\xdaIFr\xa2X\x15y\xda\xdd\xf4\x9d\xaf\xed\x01\x1a\x90m)\x8e\xf3\xd3a\x88\x1b\xa5-L\xb1\x94/\xbf\xc1,y\x82\xb8\xd3\au[~\xe0\x86\xbf{\xf2\xdcp\xa7\x16뾕ﴧ\xfau\x13\x97\xec\x9e=\xd9ыn\x0f~go\xb5\xfa\xa5\"\xcb\xdd\x7f9\xc5}\xcc\xef\xd5eF6\x9b~Z\xdc\xe5\xbdn\xaa\xb7\xbeN\xb0\xe7\x8a_\xcb\xfa4\x8cx\x7f\x95[8\xf2d{[j\xb2\xd8\xec\x01\xa3c,\xce\x1b\xe9\xad-ڬ\xff\xd1\xed\xe9\xfb\x1b\xd4\xd2\x1e\xa79\x86\x15\xdbX]p\x9e\xae\xdc)\xbaB;u\x15\x84ީ\x88\x13i\xb6\xf44\x8c<\xc3B\x82\xd4q\x1e\x9c\x0e\x8d\xa3\x99\x13\x18=\x1b\x9a\x94\xc4۬\xeav\x13\xd9fUp-\x93\x01\xae[\x9f\xe4\xe1\xf4^\v\x8d\x84\xdf\xfc1\xae\xb3\xe9\x9b\x12\xc9\rV\x89\x7f\xe5\x9b1\xea\xe2\x8a\xf0\xb8!\x10\xebK\x1c\x8bQJDe\xbf\x90\v\xc3\x1b\n]D\xa0i\xb1\x8a3\x1e\xcbH\xa3\xe7\xe0\xca)V\x7f\xbc\xf1V,\x00\x9bI\xfbH\xe0\xd7jDբ\x06\xbb\xe9\xd5f&[/m\n۬z\xbd\x99\xa5\xd9m\x962\x13\xb5\xa0Z兑,\rS\xb86\x82\x02\xf6\xf0a\xa0\xa2\xec\x85\xf4FQ \xab\x88\x049\xba\xdc\xd7\"\x9e\x9f\xf8\xe4A\xfb\x82P\xc4\xc2\v\xe8`\xac\x17A\xef\xba\x17R\x8c\x80\xa0G\xcfbb\x12U+\xab\xe1\xcf(\xf6\x8au\x9cd\xf5\xae\x17\xd1a\x9fQ\x8d\x8c\xa0?\xb0\x839\xf0:\xb7Y\x1d\x84m8\xe4k(\x1aٿ\xebH\xfd\x1et><\x1eas\x19v-y\xe52\xca䒗E\xd7Ȍu\\/:\xd8\xd0ʃ\xfb1\xa0\xa3\xf5\xadM\xe7\xa5~FHt\xfb\xb69AίB\xf0P\xcc~\xc2o.q\xb8a\x88\xebEC\xb0\xf8h\x92ܺ\xc4\x13*\x01*\xb9\x84\xbf\x15\x97\xc4+v\x92A\x8e\fId\x1cAK\xd30\xcb\xe9A\xa2p\x04S\x10ο\xc5zG\x02\x82_Cy\xa1\xf6͛d\x11\x97\xf5\x9b7\xa8M\xf3\x12\x17\xf6\x95h\r1m\x02\xc8~WM\xa0\xb1\xe0\x1d\x06\xbd\xb0\xab\x1c]xv\x1a\x80\xb8L\xa9\xe2%\xc4\xfc(j\x8a\bJ1Ew~\x03N\\\xc1&a\x92y\xbcV\xbaJ\x1d\xe8\xb9G\x00\xb7W͋q\xa8\xae\xaa\xddFUwY\x9d, (ʌ\xee\xa5\xe8\xf9\x1f\x92\xb8bЛ\x15u]\xacz\x13\x9dn\xc2\x00\xd8bjO\x86\x7f\xbdpC\x1e\x19\\\xa6\xeeء#+&~\x8f\x13\x12\xeehBo\x19\xd1\n^h\x8b\x85\x86\xe1\xa1(T\x9f5\n\xc4o\xbd\x05\x14\xa7e\x81^\xa4\xef\xe4Z\x05\x91\f8&d?\xc6i*/b\xdau\x1fD{\a)vy\xab\xf8\xc3\tC\xc4\x02K\x8ahz\x83\xa7#\xb6R\xc3\x04\xb3\xfbq\x8e\xb6+\xcc\\ei\xbad=\a\x87\x1e\xfb\x12S/\x12\xca1MP\xc2\xc0\x16\xf5\x04%_\xe7)\xd2F{\x90\xe1\x9f4\xd8\xe8JmK\f0\xa7\xb2Y\xc9⛖\x87\xb8\xb8\xa4\xd6\xc5\xfa\x93\x8ai\xff#\xc8i\xffނڿ\xaf\xa4\xf6\x83\x83E\xe2\x01\xc2\xda\xff0i}8q\r\x81\x1d~Jq\xed\x1f(\xaf\xfd\x0f\x11\xd8%\x9b\xd7\x0f\x93ؿ\xed\x95\xd8\xed\xc3%\xd6)e\xf6\xfep\x8f\xc4n\xef-\xb1\xdbO \xb1\x0e\x01]\r\xf0J\xec}\xe81d{\xb8w\x8a=\x1d\xb7\xcb,\xcb\xd3{\b\xac+\x9f\x91+\xc5\x7f\x1a\xfe\xc5\x15\xd8?}\xb0\xc0\x96\xd9\xf5\xe2\xd3J\xec\x83\x04\xf6\x03\xe5\xf5\xbe\xe2\xfaQU\x81\x0f\x14Ճi\xf9hrJǧ\xf7\x90ԇ\n\xea\x83\xe4\xf4\xbd\x15\xba˰8X\x8a\xad\xa1>s\x93\xbc)\xb6r7bUj\xc8]k\x89Q\xa3\x04\xee\x1c\x0f\x85\xb7\x06āT\xd9\x03\xe2\x9e\x15\x1dL\x9b'\x98.\x99\xb2\xb6j\xf3\xc5\xefb~\x19ӫ\xe7tڻ5\xadP\xa9\xcd|m\xaa\xa7\u0098}\f\xe9\xd6\xeaЋ\x83;k{\x9f~\xda\xfabW6Na\xe4\xd5r\xcf\xe5d\xbeK\x82\xa9\xda\x169\x03\x18\xa6\xf0D$e\xb9\x9b$G\x81J\x10c\x13\xa6pꚠ\xe8\xb4|D^\xa4\xa6iM\x04b1lR\x8ee\x89\"\xa1<\x02\x002\xf5\xa9[\xf2\x0f8\x90\xc1\x92\xeaDF\xa0q\x8fD\xb1\x0e\xdb3\x7fK\xefW\t&ݿV^T֢\x10\x1dV\xaf\xb4\xc6y\xac\x99ݵڬ\xd7\x11Y\x9c\x0eQ\xe5\x0f\xa6Fu\xdb\xfd9\xa1\x8b\x9b\xf4(\x84\x87sD\x99J\x1fF\x83c\xfa4\x92\xeeE\xc5k\xf7`0\x82]\x04\xef\xeeC\x89\x1ad\x17\xc6Q\x81/\xec#^Y0\xe95G\xe7\xf1\xd6\xca1\x06i\x0e\x9f\xd3{\x91\xc7;\x98\xd8\xc5\xcc\x12z\f#<\xdeR;V\xd5_\xe6WmE\xf7\xf2GO\x05\x0f\xeb&Q\xde$U\xa3<\xbeG?\x19\x13\xc9\xc3(Q\x18LZL\xb4\xfb\xa9q\xd2M\x1f\x80Y\xd9\x12\xb5G&\x00A|\xe0\x19G\x04\xb3\xeb\x86\x01\x15\xa3ˠWj\x9e\xbaF\xd4y\x13\x98mk\x96\xd7.`\xfd\xae\x01X2<ޒ\x80\xfc\xcb4c3\xbdb]K31EFae\x9f\x02\xbc\x9013^.{\xe1\xa0ȃ\x1eyF\xe0\x91!gU/\xe2\f!\xad\x8dC\x90{\x04}\xfb@tu\xb3\xeb\x861\xb8dIݴ\xa4Z\x8c\x11$\xdefU6˖ܘ\xdd[di\xcar\x9d\x9blʊk\x93t\xb8\xbe\x88\xb3\xd2\xd4(\xe7\x87֬\xb8\xdc\xc0\x8b\x96w\x13e\xfd\xae\x81\xb2y4Ҧ\xb1\xc8\xc3C\xde;\xfc6\xa6\xa1H4\xab߃Hl\x8b\x88믨\xcceze!\xf46{\xbb\x1f\xf3\xc9%\xbb\xbb\x92\x97^R\x8a2t*\x1e\x13\xb3\xf1\xab\xcd\xc0>\x84\xffy\x99WW\x87\"\xbc\xcb\xd2\x1a\xe5\xe9\x89LX0\xda1RJ\xa7dX=% ӬZ/㝔O\xfeFS\x804\xf4\xf2\"g=\xf7\x954\xde\xcbt&\xa3\xce^\xec\x17\xf2\xb6v\x1b\x9b'(\xb669\xbb68\xcf\xe8\x95l\xbb\x9d\xb4a\xe9\xf3\xac\v\x8byi\x19\xdf\xfd5\xb8\xf6蛜\x92\xdd>Jv~Jv\x0e%\x8a\xc1ݤ\xfc\xad\x85\x14\x9ekd\xbe\xf7\x87BTpƬ\xea\x9d\xc58U\xb5T\xb9[\x05L\x0e)\x82\xa4\x98\x898\xaa\xf8x\xbe<>aZ\x86\xafL\xb3\x9c\x02\xf8\xcf\xca\x00\xe0\xbbưwP#\xfe\xeak\x859c\x1b]\xcek\x1b^\x99L\xf5\xcd\xf3\xd1 \xff\x1c\x87j4\xa8>\xb7\x86\xac\x12\x14\x8eiD\x8d\xe9\xfb\x10{i\xfd\xdba\xb4\xee,ZG\xfbhe\x82\xd6;\x9bV-J\x8aؑM쨅X\xbd|\x18\x8b\xabʥ\x15\xa9\xb1\xeaZ\x1e{\xb4\x90\r\x92\x05J~\xfa\xb3\x19\xa8\f\xfc\xc1ʤ\x8f_ o\xf3\x88\xef\x10\xf9;Q\x1e\x82\";tCf+\xdan\xd8\x0e\xd7\xca\xc0\r\xfa\xadh\xbaa\xbbW\x14\xcbu\n\xa7\xe3\xe6\xb39\x8f\xd32\xbe\xbeƕ\xda\xca\x02H\xe4\x83{\xf6L)v5\xd7YN\x820\xb5\x04\xc3\v6r\xc0F\x0e\x98$\x00\xa6\xda\xd9\xc5\x1e\xe3\xa0b\xc1\xbd\x8a\xf3\x84-\x83\xb0\xfd!~\x931\x9b\xf5\x81l\xa1\x88\xab\x8a\x8c)8|\xd2\xed=no\xafn\xedq{ku%0\xbc\xf8\xb0\xf6\x91\xc8\xf2%\xc2\x11L\x19\xd7N\x88m\x04\b\x956\x1f5F~\x14t\x1d\xc9n.\x95\x17\xad\xe5\xf9NS9\xc0\xc8\x040\x9b\xf9\xfe\xd1\x01\x02fuF\xbc\xac\xff;s\x16\x14Q\x9a\x8ba\xa8\xc5\xf1\x12\x02s\xf2\x81c\xabG\x84\xf5&0\x87\xbc\x052\xe2 p\xd5&Ҫ\xf3\x8e\x03ň\x17\xa2z\xac\xa0K\xce\x1beGF\xd9\xd1U芃0L\xb5\f5\x9b\x8f\xb4@e\xf9\xf5_QX\xb1GG\xbc\x8e\b\xb6h\x10uu\x9f\xe6\xf2\rJ\x0e\xear\xc3\xf6U\xf3\xb7F5;\xf4\xe9\xf0U\xf3\xb7\aTC0>d\x0e.\x92\x8f7\xce\xe3B\xe8\xe0;\x81\x9e\xd0\xfd\xad\xac\x15\xbd:\xa4\x86\xd9K\xa1G\xc3D꾽\xb6\a\xb7\xdaƙ\xc5\x03\xe1\x11\x95\xb9C\xaeۛ\x85\x02\x19I\x8b\xb1\bW$\xad\xc5\x11\xac\v\x15\xbcZH\x12\xbeu/\\\x88\xb5\xe0f\xf6J\x860\xab,\x8f`\x15o\xed!\xed\x1fo\xe5\x10gaY\x97\xa5_\x8d0\xa7\xe2\xd6\xed&\x80\xee\xb7Uf\xc5\v\xc7{t\xfc#\xcb\x03\xbaLG\"\x9f]\x85\xe1!\x04\xad(NC\x80H\x8f5a!\x1c[\x9e\xd4^\xe3-\"\x95\xf3\x82\xc1\xbdv\xcaЙW\x8cBb\xe3*\xcb\xc3\xf0\xc2\xc1\xa80\xbd\xa0|\xb0e\x8e\x93\xbb\xca,\xd6I\x9e\xf8\xd8\xea\xa1[b\xf1\x82\xb7\f\x14\xb3\xc7\xe9\x95\x0f\xac\xf1\xf7\xdfm\xc1\xa0\xf4\xd8\xdd\x1b\x10\xc4W\xe4\xe7\xe4Y\xc6-č\x96Y觶\x90i}\xc6;\xc0\xfd+\x15#Ӻ\xa6\xcfX\xbe\xeea\x1f\xf0j\xec\x0f\xdeli\x9bIoV\xa4;\xcf.\xdc)p\xa7\r\x14H\xba\xb2>\x10\x98\xce۬=9\xa4ߵ\x94\xa2<\x96\xa7\x9e,\xa1\xdf\xf9s܊4\xa5\x9eyӜ5\xf1\xa8\xf6\x91o\x1alSD\xde\x1b\x16Ku\xef\x89_\x04!\xf8\x9f\xf5](\x1de_\xac\xee\x1c>\x14\x90o`\xca\x7f\f\x8a\xb9R~\xcd m\x8e5K\x16\x96\v\x13L\xcd\x1a\xd1\xe8\xb4Y\x05F\xfe_a\n\x8fO\xfe3\xc8\x7f\xafB\xb9\a\x93y\xf4\x94\xd8V\xc3\xfeM\xc0\xb2\xdf\xef\xfc\xb0\xbb\xc8T\xd9\xccz\xc9\xe2\xc2\xd2@Yp\"1\xc3Db\x167\x15\xb0º\xf4\x8d<\xbc\xb3\x1a\xc9\x03H\x87-ҥ\x86\x8a_\xc4d\x87\xb6\x89\x99S\xbc!kvyW\xdeķO\xe4\xe8+4C\x81\xfbfz\x8ff5\x14\x9bH\x95u\xf1\xa8C\x97\x1a\x8a]\x9cʺxdͰ\xa6\xd6\xe2\xae\xcbl\vS8>\xb9ktn\x04\x14}\xe5\xf8\xe4?s7\xcb F\xc6\x1a\xbaT\xf3!\xad\xc1.\xf9\x91\x95\xbds酫\x8b.np|\x9d,\xe08=\xednh\xd0J\x95\xe4X\x06\xd52K\x8c)\xb6c\x82\xa59\xf2\xd0\x19֚m\xf6O\xa3\xe6\xc0\xb1\xf3\xf42ܘ\xb1\xcc\xf9\x8a\x1f\xd9?r\xe7+\xcf\"\xe2\x9f\xc0\xf4\xd1(e\x1a\xd1ſʪu\\'\x8b\x80pEV}\x91\xd21\x8di3\xe4g\xc3\xfc\x18s\xa7~\t\xbb\xb7v\x19\xa1\x02?\xf1TCFH\x9a(x\xcePE\xe3\x1c\xc2\x15\\Eւ\xcd\xc9\xe6\v\x99u\x14}\xf0\x81\x93Z\xb0\xb1\xf8;\xf9\xd1M\xe6\xe3-\xbcx\x01#\xf8\x1d\x1eﮜ3\x0e\x02\xb4\x0e98u\xbb\aQ\xb7\x93\xd8w\x9f\x96:\xc5t\x1f\x89(\x0f\x18\xa2\x06\xc3\xce`\x8c\x18\f\xfdR_t\xd3/\xcb\x02\xbc\x83\xa9\xd5eZ+\xeb4\xd9n\x87\xd8b>\ab\xd5\xf4հ&P\xed&v\x1b\x89@cΧ\x02W\xbb9\x86h\x19d\xf9-+됗\x97\x9f\xc1v\x18\x8a\xf2:i\x14zʏ\xe0\x05l\x87!Й\xf60\x12hF\xa2p}\x88F\xeb\xec\xf3w\x8a\x1f\xb8\x19\xda)~\x8c\xeeǏ\xdd\xd0Y1\x04\xaev\xbb\x13Ѣ\xf8A\xe5\xe5g\xb0\x1b\x86\xa2\xbcN\xf2\xf1c\x87\xfc\xd8\t~\x90\b\x11\x9a\x91(\xbc\x97\x1fr\xac\x92\xae\x01/ij\xd8r449l9\xa6+@\x9b\xe0\x16^\x8a\xec\xed\x88\x12vX\xecR\x88\xae^e$zg\x03\xe0\x9fv.\x1e\xb5H\xaa\x92SCH/\u070e\xe4\xdd?\xd4Pۑ%w&\tb\xd9\xe7rce\x8cd\xc6\xc8\x199\x8e\xb4\x9abj\xcb\xe7\xfdd\xd3\x1c6\x04˟\xf92\x87\x0e\x95\xd4/\x83i\xa66$x\xa7\xf9\xb4\xf3\xf3i+\xa5k\xa8\xc5r7\xb2\xc0\\>\xd1\xe2\xbf\xf3\xf0\x89g8|r\xa5\xd8\x14_[n\xef'\xb3\xe6p\"X\x97O\x82\x1a\x1f\x9f\xf6\xcc\xcb\xf4f\x8e\xdfӥc\xdf\xeat\x9cC\x9f\xd3\x7f\x0e\x99\xc3CW\f\xdc6\xfa)3G\xabM\xcaԩ\xfd\xf7\xdf\xf9\xe0\xb4)\x9c\xfa\xa6#'r\xc0\xe9\xa0d\xb3,O\xa5>BJJ\x04\xbdB\x1c1\xbaw\u008d#X\xf5JR>\x81^^\xf5\x85\xfa\xc6\rd\xa8J\xb1;;\xad\xf2\xc0\xddy\xe0rL\xcc\xef*\xe6$#ΜU.V慮\xee\x1c\xe8\xb6\xc6\xfc\xa4\x94\x00\x9c\xb1z9\x1d\x93\xe3?\xa4\xa6\xde\xe1?9\xff\x97\xa7\xf2\x7f\xefz|^\xe3\xa0\xea+\xe7\x05\xe9\xebJ\xc5&\x9c\xb1E|\x9bq\x8e\xbdw\xd2\x06\xb8\x89\xe9\x0e;S\xc6ׁ\xf3n\xa4\xed\xb8\x80\x10\xbd\bTB\xc3m\xa1\x01\xe0=\x95RپC)\xd2\xe0\x9b\xb6\x7f\x1d\xd8F\xbf\xea\xe8?\x88\xfa6\xb5\x0f\xa2\xd6\xe1@\xbe\x1a.\x9b\xbe\xf6\x9e\xa8\xaf\xadGp\xa6S\x89\xce<NwN\xb1\xd6\xed\aXȊ\xc61\x06-\xf7\x8dv(\xed\x11ːA\xe9\xa2\xd1x\xc1\x96\x88{w\xae͝\xda\x1b\x9a\xa4\xd3\x1d\xa5\x8f\xccts*\xe6\xd6\xebߧ\x88\xe0wHw\xee\xc6\xec\r\x16\xdfo0\xe92\xc1\xf0\xde7ҷ\x13N\xe9\xb1>o1\xb3w\x13\x19\xddG\x9d\xb6\x98\xd9\xe9v\x02\xe9\xd6J\xc1W\xcfv\x1e\x03\x8f\x97\xfb\xaei\xae\x9b\xf0V\xd3Q\x8bY\xbf\x9dA\xce!\x904+M-CK(\x8cn\xc9\x12\xaf\x18\x8aqC\x1f\x11\xd9\x1f\xbdN\x01TD\v\xb9a\x14A\x04}r\xce\x16\xb9\x130,.\x02\xbd\xb2\xb1\xb9(\xd0lҊa\xb3\xb6ʷ\xb2\x9c\x88\xb7\x18\xde\xce\"O۽\xc8\xdb\xccr{\x8dm\r\xe3\x9d\xd9\x17\x91j\xa3\x99ŧ\v\xfeH\xa5\xf7\xdc\xfbrxe\xcc\x10\xd2\xfa\x15\x99\xe3G\x0eRyT4<\xc86\xf6\xf0\x1e\x95\x93̇\xf4\xaa\x10~G\xec\xe8\x10\x95\x1af\x19\xbd\xa4\xe5\x88g\xc9 ڍ\x0e\xf0ښ\xf8\xcf\xc1֙\xb8D\xf2Κ\xb7\xc0\xb5\n\xfd\xe6\xc3ȕ}\x9f*\xfbX\xb47l\x15\xc26\xbb\f\xb2\xc3k\x969\xc0ւe#9\x0f\x9a\xa8\"=Ǆ\x86!U뀘;P\xe9\x0frϾ\xd6'\x1d\nQ#RV\x19_w\xabg\xbc\t^\xed\xccT&\xde\x15ŪS\x99@\x80ne\x02!\x9a\xca\x04}\xde-\x18[Z\x00\x94\xe2\x1a\x92M\x00m\b\xfe\xea\xcf\xdf\x7f\x8f)\xaf\x93\xb2X\xb6bIgK>\xfd\x88|\xf9\xddPiD\xbeNq\xed\xd1&\x80\xc7\x1c\xdd,\xefՉ\xf0]~W7Q\xc1\xe6\x03\xb1\xde+\x9f,\xe1\x11 \x8em\x03\xb1\xec\xeb\xec\x91Ά+o}|\xaaZz*[b]G\xa2\xf0\xb1Ug\x04\xcbˑ?st\xd5R\x11\x81\xfe\\\x04\xc6E\x1f^\xda8ؤ\x84\xaf\xe5\x9e\xc28\xe443p\x8fY\x85~\xee):~.\x82u\x04F\xb3\x96jZ֫\x8b\xd9$:\xa4\xe5\xdc]\x1a[|\xaba\xc7J\xb1Z\xea\xed\x8d\x1b\xb2QL\x014r\xecQ\xbb\x1d\x85\xb0\x1dɠ)ۡx \xcdy\xc8q\xebq\xc2\v\xb6m\x9d\xae\x1d\xea\b\xcbv(\xb7\xca\xd6\x04\xbe\x1b\x85\xb0S5\xefZj\xde\xf9j\u07b5ɓS\xf3\xaeY\xb3Z>\xd7%\x9f\x1e\xd9<\xde,kg\xeamμ|\xa4\xa8T\xaaMD\x9cѩ\x8a\xa6\x89\xfe\xd9\xed\\ع\xdbPs\x87\x91\xa9==\xcc\xe0\x15\x86\x84\xb9Nl(p\x1a\xd2\x16\x857\xed\n\x93X\x87\x1b\x1b\x04\xa1l\xeeӞ\x1a\xf3`\xf3\xb4\xd6\xcc\xf0(\xb4\x96\xaes\x88\x9a*\xf0\xf9\xd4ԏ\xa6\x0e\xfah\xfe\xd4\xea\xa0Rע\x83\x0e0\xfd\xeb\x8e\xc3t\xf1\x89\xc2\x01S=\xbd\xbb\xb2\xa3\xdaȫ\x1a̋dS\x1dv\xecc\v\x12\xadm\xee\xc5*%\xb5h\x16S\xbf\xfd\xf4\xe0\xf5\x8aкW\xf6s\x11\xa87E\xf9[$r\xfd\x7f\x83\x8d\xfc\x8a-\xeb8\b\xe1\b\x06\xc3\xe18\x94\xcbBs\x9a\xfd\xb9p\xaa\x89\fbtS\xadqC=\xe9>\xce\xd2\xdezg\b[m\xd5Z\x96SR\xae\xf9\xce\xf34k#\x92\xa5\xa4\xd7\xea\xc3u\x83MJ\xa2\xaaE6\xc7\x13K\x19\u05ca|\xf2\xc4$\x06G0\xf62gmN \x0fe\x83V0\x9c\xe6\xf8\"p\xcaf\xb9\x8fh[\xed\x1a\xcak\xa4\x17>\xae\xfe\xa6\xae\x82\n\x9c\xcd\x17B,\xffK]\xf8\xd2|m\xf8\xcadl\xdd\xf0vo\x9f5P\xbee\xcd\\\x19\xa6\xb9k\xe4Φ\xe2}o\x84\xac\xb3\x15\x83\x17p>\x1c\x86\x8dK\xb3k\xf9\xec\xb18մ:\\g\xd8\x17v\xa5j\xd3\xe8\xdd\xce\x1e\xbeW/\xdb\x06\x00\xdd\fz\xb8ܞ\x1f}\x02ጋ}\xf2@\xe1\xa5-6P\x82\xee\xba\xf5\xd0\xec;\xb3/\xd6#\xa3\xe8\xe8J\xd7)\xf8;\x8a`9r\x90\x8d|ȀS\x81Z\xef\x90\x1b\x8f\xd6#\xc3\x03v=\xe4&\xa3\xf5\xc8\xe7\xf3\xba\x14E\x97\xa2\xe8\xd2,\xba\x14E\x97ޢͱ,\xbaչ\xdf\xfd\xde7\x82\x87Ȫ\xf0⑧\x9b\f;\xff=\xc76\xf5\x96\xac\xc5\xd8\xf0\x9a\xd3g\xa4\xb4\xe9\x91\xf8)\x93\xbe\x96G\xc4\xee\xbc\xfdm>\xcf\xf2\xac\xdeE\xed\x1bY\x04\x8b\x84F\x16F\xfc\xd4\xc9<L\x96\xed\xe3\xd4\"\xd8\xc0$\xf4\x01\xf7\x13e\xe9\xc6\\Cg\xb7\xa8i\xfe\xb0Y\xcdX\xe9ƛź\xad\x1d-\x11\xf3\x91oW7.Fv\xd4\xfau\xe3d\xfe~u\x7fm\x1d\xb5\xdb(\xb7F$Ŷ^\x85\xc9\x03\xf8\xf5@W\f\xa5\x1f\xf3\x93T\xf5EG\x87\"\x86\xdcA\xf5?\xd0\xd9Bտ\xb3\xea\xdfݧ\xfe\x86\x05\x84\xcb~\xd7\xf9\x94\xad\x05e\xb7Q{g\x88A\xab\xbe\xe5I\xcd2\xde\x15\x9b\xda<\xa7\xe1)\x03\xfe\x8eXǓI*}\x99\xe5f\xf4\f\x9ad\xe3zA\aLW\x11d0\x85\xfe(\x82\x9c\xbf\x15u#\xb9\xa7\xf4\xcdE\xb6d\x10\x1c\x1fg\xf0\x02\xf2\x90\x97\x1d\xac\xf1\x8anz\xfa\x86S\xf3\x86S\xf3c\\/xu\x96\x17\xb4<6\xc1\x82\x06K\x9d\x06\xed\x7fD\xa8d\x1c4\xf0?\x81S\x11\x97\"\xa0\x8fכ\x95l }\x7f\x9b\xa7\xe4\v\x97\x9e\x8a\xbdm\x1e\xeaGjd&B\xdf\xd0}\x02\x9cȲ\b\xde\xca&\x10q\x1c\xa1LRu\xea\xa4\x1b\xbe5\xe0\x1cm\xe3\x9f\xe1a\xc0\xc1\xdfZ\xe0F\x81\xb7n\x01,r\x8c\xce\xc8u\x99m/\xb3\xab˷\xde['\x8a\x01\xbc\x97\xcc\x1b\x91V\x8be'*\x8e\x18\x807X\xd1\xd6g1\xad\x8a\xb2\xfe7j\xbbI\x9a\x81\x15\x01\xb4ng?\x90c\x88\x83\xc6\x13(\x82/\xe3+\xa3\xff.g\xa6\x1a\xf5>l\xa3\xe6\xb5\x14\x00\xb3\x1e\xbb\xa5\r\x953u.1\x00\xa4{\t\xb7HW\x95\x06\xba;\x90z\xfdeQ\xef\\\xb6h\xb4\x05E'\xb0\x1fm\\\x8b\xdb\xffG\x90\xa3\x0erc\xfa\xb3\xddG\xca\xc8\v\xe4~b\xc6_4\x80\xa9ѭt\xe1\"}k\xbc\xc6œS\x12\xc3\bnA\t&&\xa5\x98\x16\x8b\xaa\xe9\x95(\x12\xdd[8\xd2\r1\xbb\xa9\xbaL38\x86\x1e\x9dA\xa4o\xaf\xd4Y\xbe\xfc/\xc3\xda&\x90f\x91\x1bQJ\xe6\xbcur\xd4#1\xf6\x1bR`<\xd9b\xbf&\x05\xf2\x15\xc2\t\xdc\xfaC\xd98c\f\x89v)\xf5\xd2iҲ\xb5hєX\a\x98\x82\f\xb2\xeb\xa1K\xcf\t\xdc<\xf2\xd0C<]ہ#\xd4\xe88P>\xb0K33\xe0\xc6~\xd1P\x8f\x90\xe8\xdeӝ\x87\x1d\xafl/\x1a\xe0\xad\x02\xc8\x1a\x8em\x1c߀\x1a\x8d\xae#\xbc\xf8\xc0z3\x12\xc0\x9c\x89\xf9\xd4e\x95{a\x15\x83\x97N9\xf9t\xcaD\x80EN.O\x9d\b(+\xf3=LZ\x91\xf1\xbfm\xc8\xf8_\x1bY\xd8\xed\x13\xe7Nn\xaf\xa8\xbd!\x94\f\xbf\x82\xb6\xbb\xc6<S\x91)\xb8\xd4=\xa3\x19\xb3\x19\xaf%\b\xe2\x81\xc5\xd3c\x88\avg\xf0\r\xd2\xcc\x05\x9b5\xc1Z.\xa4\xa3\xfcȥ\x1eWkN\xa9\x98\xf5+9\x7fF\x90Gj\x02\xa4\xe9N/\x17\x91=\xffFF\x03\xcc7\x9e8\xa2\a\xe9\u05fc\xa8\xa4?\xa7kZ\x12ۖ\xeeE\xf0OGSRJ\x82R\r\xcc=ݞ'\xa9\xd6\x1f\x10>ƙ\x014\xaa\xedG!M\xb3\xfe\x81\x0f\x18\xc9\xe2\xfa\x11#\x03\xe1ǣ\xf1\xb5\xa1\b>\x8cL\x85\xc1\xa4\xd4D\xdb$\xf6\x9e4\xbe\x92\xe5\x1eF\xa0)\xe6\x00\x16\u00adidI\xbc\xb3\xc6^\n\x93\x06u\x0eq\x1a\xafT\xc5}\x98+\x0f\xeaf\xcf8\xa8\xa5\n\u05ca\xda\xec\x99=/\xa8\x89-żp\x1e\xdd\xf2l)֛eł\x9c\xbc\xab\x9c#NU\xec\xd7M\x9c\n\xc3\x06zӎ#؍]\xf3!\xc2\f\xf8\xcds\xbc\x8dh#\x043\xe2\x1eA&\xb8\xb2#\x10\xbd(\xbbS\xc9;\x99\x8c7\xafr\x98ң\xc2\xfay\x89\x94\xde\xc9\xd8\xc21\x969\x82t\xd7p'\n\xb6c\xd4\x19FhfOs\\\x15\x17\xac\x8e\xddU\x14\x89\xb9Q\x95.p\xee\xe6\xf0\xf8υ\x05JԬ\xb7\xd0'\x8f0G}\x93\xd9;\xca\xde5\xb3\xbd7%\xcdu\xaf\xc1\xbd\xcf>\x83\xac\xfa\x06w\xc1,H\xf3\xb0\x93r*\xf1ꓑ\xdf\xe1\xb1\xfe\xd8\xe0\xdcE\xe7C\xa1ʿ\xcf\xf0\vK\ak\xb1\x19\xe5\x06>5vS\xa4\xc5\xc8P\xdew$\xcax!i\xb3b\xcdK=R\xd0qAն\xba\x812#\xf6\x94w\t\xc5̗\xb1j\xd5-\xebK\x18E0\xa2\a\xafɭ#^\xae\x17q\x04\xf32\x93\xaf~\r\x9eSL\xfc\x9b\xaf\xb2\xaaF\x03;7\x06\x8a\xdd?U\xff\x9d\x91\xcba_ץ\xb0\xb7\xfb`en\x04\x9c\x8b\xa8\xae\x9e\x0eQ\x11\x88o\xb9Ed0\x8a\xb8\xf4\xe2\xefg\x11Ѝ\xad\xdbx\x19Q\xaf\xc9\xed=\xd6$\x7f\xa7\xa2~zR\x94\xa3\xaf$~1spF\x8aw(Z梀\xda\x0fG\xd8\xec\xe7!\xbc\xc0s\xab\xf3\x86\x87\xe0\x00\xfd\x06=N\x82\xc8ZS!$d\x13\xfe\a\xa60\xf4:\x0fz\xc6\xc9{'H\x1e5Z,\n\xfc\x91F\xd3\\\x13\xc1\xafd\xb2(\"\xa8\"\xa8#XJ[\x86)C\x10dH\xc2\x05\xe06`u\x01\xc7\xc7\xd6.\xb8\x90H/M%\x1d\xb9[\f\xecw\b\x01jJ\xb5_\x04\xe4\x9bS\xee\xbaUi\xa9淜\xb8\xebV\xa5e\x9a3{I{\xc3#\xdc\xcb\x00\x8e@\xe7z\ff\x8b\xee\xd0}\x8a\x97\xb2\x8f \xa0\xb2zr\\\x86!\xf4\xb5\f\xa0\r\nN\xc0\xba\xf4\xbd\xc5N\xb5Rv\x8d\x14\"\x9f\xd3\x14\xa0\x90T\x83;\x8a\xab\x03'\x10\xd4\xf2\xf7\xb1J\xb6/\xd2S#\xa7ИJ\xaa\x01\xed\xd5\x14\xd2\x11\xf4\xe1&\xb4!vp\xdc(\xe9\xdb\x16\xdc\x18,\x11\xa3\xc55+\xe1\xb8ƃ\x8d\x13\x18\u06dd@\x19#'#sL\x03TIرg\xe4\x92B\x02yio\xe7\n\xdeNڸ\x16\x83m\xe8\xf2\xa1\xe0\xad$\xb7\x91b\xb0s\xf2}\xad\xe5\xa3\xd7\xf1\x0f0'\x93/\x92d\xb3\xdaP\x04\xaa_\xf9\xbcw͊\xd5\x00'\xe8\xbad|m\xc73+1\xa9\x89\xd9 lo~W\xbbIU\t\xcc֣\xd7\xf8\x965\"\xe4\xfe:\xc00iu 5\x8c\"<t\xe3w\xe0\xbe\xdd\xdf\x03H_ᣨ \xdb}1Xo\xdd\xee\xa0\xd4]g\xc0\x89\x82\x0f\x89\xa0\xa0\x95T\xfc\x9dR\ac\x0f\xcau\xc2\xc5,\x8a\xecD\x11^ծ\xa5\x88\xcb\a\xe1y\x81\xc7\xee\r\x0f \xbe\x8a=\xf2Ͱ\xee\xa6Ӝ\xf5\xe5\xb2q\x7fݛJ\xaa\r\xa1@\xe3\x1e\xf6P%\x9ej\xe5\nu\xffj\xa9\xa4\xacE\xa29\xb4ڏ\xf9\x92\xe3}\x9aj\xa8\b\x0fk\xb1D`6\xdc\xd6;Z_\x95l\xa3+5hj\x10\xea6\xc0\xd0[\x1e\xd6\x00\x89\xc0l\x80\xad\fݻ\x01\x86*v\x7f\x9a\xdc\xc1f ;\xb4c\x95\x8ev\xff\xdam%Y!¡\\\xcc\xe9\xa8r\n=\x89\xb6\a/a\v\x93\xe6Ij\x1baZ]\xbc?e\xa2\xac>ё\xa8\x0e\xad\\j\xa7\xf7\xaf\x9aJ^(\xa7g\x8e\xe6\xd0j\xa5*y\xffj\xa9\xa4i(\xa0\x04w/\xbb\xc5\xc0ȡ\xd2X\xb7\x17|E\x90\xdfCO \x06Q\xc4U\x91\xb9\x9f\x91GI\x16\x1b\x92v5y\xdb\x12\x9ee\x80\xee\x04e\xa0\xd5\xf8\xb0\xe5\xe6e봈5\xfbu\x7f\xc3\xe9Y<\x80\x96f+\x96W\xf46\xb3\xfdȥP\xcbQ\x03\x9c\x15%\x19\x82\xc4\xef \v\xe5a\v\xd7\xd2\x15\x90\xd2Է-\xa6\xf6UȻ/\xab~\x88\x7f\b\xb6f\xd9˷W\x97\x8a\x98\xab\xb0\x19\xe5@\xb5\x9b\xf4\xe12\xceS\xf2\xab?\xb2f\xf3\x86\xf3\xa5\"\xbaq\xf9L\xd5l\xe6\x80\xd5\xe2KK\xf3\xa3\xdd\xc5[\x12\x0f\xc0\xc6主xk\x97\x06\xabA\r\x14\xef;Э\xbc\xe8\xb0\x13\xf4\xa6\xe5\xed\xd5EKer\xfb2\xa0\xe3\x99+~| w/a{)\x0e\xe0\x94\xe2\xa8\xc2\x03-\x05\x1a]v\xe5\xdb\xda\xe11st\xd0\x06\xefN+\xf6\x11,\xb42\x1f\xe9:\"(\xdav{yc\xb7稱\x998\x05\xcft\xc3\n\xb9ݙ6/8\xab}\x96Սj\x8bf\xa5>t牒(։B\xbd\xa1?\x85^N.+\xbd\xd0H\x15\r\x91\tmH\x94\xf7\xb2\x85D\xa6*$<\xe1\xcar\xd6V\xbbJA\xaaT\x1f\xccG\xc1\v<V֥\xac\r\xeb\xd4\xd2\x03:J\x1d\x1f+q\xe5췳\x84L\xdaY\xef\x0f\xee\xf5\xf6}\x03\x9fv\xb8RϷ\vj\x1e\xa4`\xbaw\xa1\x1f~G\xf0;\v\x1e\x83\xe4,Z\xe0\u05fc\x02\xb9\x85h\x01\xe2X\xe5\xa6\xc1m\xa7\xd8\xc5YRft\xb4\xd42,\xd5\xc2\xe4\xc2~>\xa9:x\xdf\x1d\xf3/\xb3\xdb$\x17\xed\xde{\xdfv\x1f\xed\xdeUڊSs\x87d.t\xaei\xd0\\\xf1xV\xe7\x95~C\xaf\b\x06\xa3лl\x16\xeb\x83Q\f}\x18\xda\xee\x95\x1baRC\x10@\xee}\xf4 \x14\x97\xde\xd0\xe7\x88\xfb\x82\xd6;qA˸<\xe7X\x06\xbe\x92Y\x1aҽ\v\xa9\xae\xdby\v\xb3<\r/\xbc7ӊ[V\xf2\xf3\x85f\xc9\xef%\x80\xe1﯎#\xda\xc07u\xc8e\x8b\xf8\xd0\xedyF\xc5\xda/\xdfq\xe4xo\xbc\xce\xdc=C\xe3\x04D\rY\x94f\xb2\x17\xa6\xf8\xff;\xdb\xc7*Yd˴dr\x95\x1a\xc8osKL3\xc6\xd6J\xd9I\xf8\x94\xad\xeb\x05\x1dXX\xf9t\x1e\x92\xdae\xe80D\xc3q\x13\x90\xa8\xfd\xb3\xcf \xc8id\xf0\x04\xa9\u07baz\x99\xf2i\x8b \x89\xccH\xffT!U#\xbd\x04\xd2-\x9c\x98)\x13\x18z\xb43\x8f%H1.1\b\xa2\xd9\x00\xf9\x87i\x02\xe3\x91\xe4\xe8\x85\xebȕ\xb6\x8dm\xf7\b\x01\xb9\xe7\x9cQu\xf5\n\xaf\x7f\xf8qX\xd8\u038dԼ\x9a\x97F\x82L\x83\x15a\x9bn>\xd2\xef/\xb8\xd7\r\xa5\xd0:\x1eZ\xa4m\v\xbb\xcb\"ce\\&\x8b\x9d9\x19\xa7\xe6,l\t5wP\x1fFZw\xd2FP\xcdY\x84j\xb8,\x1af\x1f}آ\xaa\x87\xa91\xdaTj\xd0<V\xe1\x18T\xd3>\xa9eF\xd5\xe2\x9fB\xe4̣\xc8\xfd\x89O)\xaaX\xa4\xdb\xe7\x9dT\xb2\xee\x03\xd5uƂ4\xae\xe3F\xe7\xdd\xcaw\x8f0\u05feWh\xf7\xb4\"\xf6\x98\x8a\xf0>^g\xcc\xe9b\xbd+D\xec1\xae\xd0r\xf1\xd7\xdeVMۂΓw\xd2\x1d\xc7z\x98\x180V\x1d\xe4\xa2'\xeb\x90~[\xcd\x1adN;~\t\x11B߬\v\xc5\xf1tPmV\x01\xe7\x95Uy\xe6\xfa\xaf\x12\x1b\x85x\\8>C\xf0\x98\xfb%\x84\xbc\x18w\x03\xc2\x7f\x88Z-\x04댽.\xca\xfa\xcb\xdd_\xc4d\xa8\xba\x047%\x9e.\xe1\x84\xe1֭/\x7f\x1b\x9b\x1a\x98\xecE@\x94 \xe9ܝ\x10\x7f\xbc\xbdj\xeb\xd32q\xf4;\xbf+g#\x18\xb51\xad\"\x8eˆ\x8f\x1e\xd6;\x01IF\xd3\xed.\x85\xa9n^\x9b\x1f_\xecw\xe3\x8bib\xc7c\x8d\xa6\xc3\xde{w~A\xf2\xdc\xe9\x85*\x86)p\xb7|\xee\xdd\x04\xed\xbd\x16Y\xe2\x8eӜ\x16M0\x1cJ\xc5\x1c\x94\xc9u\xee!\xd3\x0f\x95\xd4]\xb4\xdcx'\xa0̶\xba`\x95\xa2\r\x0f\xf3y1\xbd]\x0e\xad\xcfd\xc9\x03jU\xc5U\xdd&\xc2C(0:\xe1\xfe\xf5\xcb²\x1e\x03پ\xba\xedd\xf32\x82\x7f\xcc7/\x16Tu\xec?\x1b\x97\t@\x10r\x96\xc71\xe9\xf8\xe3\xb32\xbb\xdfL\xcf\xc7\x1a\x9f\xe9\ty\xe7\\ON\x1f\x88\x9fWtP\r\xa9\ru\xeb@)\xb8K\xd8Zd\x10`\x04\xbbf\"\\yݹ-J\x8b2ed)\xa3\x1f\x16\x0e\xde\b\xc9?%f\x8au\xa7\x835+W\x9b\x9a\x05<-\x12\xc8\f\x15Gp\xc1\x00\x95H\x1dP\"\x85\x82\xa1\x10-\xf4\xeb\x00b\xa4߁`\xb3i\x97\xe2I\x18\xe7F\xa6r+\x96m|\xea\xb4\xde\x15\x1b\x9b\x06\x85\x92\xbc\xc9\vEhEߜB\x9ek>\x8fc\xec\xaaG\xed\xbbꖺ2Y\xd7\xf1TV@\x8eТ\x12Uk֬\xb5e+\x8e\x12\xef\x9d\xcfEHW\xb9s\x15]dM\xebD۟1Y\xc4A\x88t\x04\x1b\x17\x8a\xd2\xff\x83\x95E\x04\xc5\xc6\a\xb0\xa9y\x94^'\xfd\xaf<b\xaf\x93*\x1ev\xa6߃ۇ?xyk=vy\xdb\xf6\xd0%\xd5cMZ\xbcfɒ\x87\x04\xb5IY\xa9c\xdap4\xdd\xe7K>\xbeW\x83kVc\xad\xbf\xff\xde\xd5/\x876Gv\xde\x03\xdac\x85KW\x88\xee\xd7\">z:\x9a\xa4\x84\xe8\xc0\x06}\xdc0̝U}ܘ\xca\xddݴ\xa9\x1fTY\xb1\xd1\x1d\xb4\xa9\x0f\xaa\xd0\xcd\xf0\xacΆ0\x8a\xe0\x02\xf1Z\x1c\x9f\xf5\xb2\xbc\xcaR\xd6/6u\xcfP\xb4q\xcaq\xb6\xc9r\xe9\xb5\xe7f\xfe\xf4\x86Z\x93\x9d:\xbf\x8f\xb7t\x9f\x87nŭ\xaa\x0eȟX\xbaI\xd8\xeb\xcd*\x8c\x9a\xbb\x92<<\xec\xce\xc1*\xde^\xc6\xf4\x12I\xbc\xbd\x9c\xe9-D\x18\x01\xb73\x0e#\xe0\xef3\xf3\xdfu\xb1\x96>~<\xb9\xfb|\xa1\xb9\b\xd0-\x17q\x8f\xc99\x1a(\xd6\xf0B`5K\x00Qr<%~8GKH\x0e?\rz\xdb\xfd̶h\x83\x1f\x8bh\x89\aQK,Y\x01_\xb2[V\xe2\xc3\n\x83\xa4ȓ\xb8\xc6&\xc8\xd5\xfe}$D\x8d@Z\xe5\xc40)6v\x93\x1a\xbf\x85\xb2\x97\xf2\xe9\xaf7\xe9\x9a\x1c\x1fI\x1d\xc8'\xd7J\a\xb1\x04\xbbʖ\x8bb\xc3\xea\x9a\xddO\xacW\"\xc1TC*}\xeds\x15ˋr\\5\x11q~\x1d\xa1\xe9\xd4Q\xb4Xq\x8dDKWv|\x1cr\xc5A\xec\"\xb9\x86\xe0\xf8a\xc1\xe7\xfcU\x1aNJ\xa1s+u9\xb3\b\xbd'8\x9dd\xed\x86\xfc\xf04@\xbc})Y\xa1\xe9\xd3\xe7H\xcenhu\xe5]v}\xbd\xbc'\xb7\xb7\x9aۜ\xf5[\xdd\x116\xa7Q\xc1\x1aEP\x8d#\xa8N\xb99\x19\xf5\x94a\xa3\x03vC\x1e\xa8\x98\x94\xbd\xa1i\xb1T<\x18\x1d\xd25ը9\xf2\xabQG\xe7X\x85\xc7\xfc/Y\x86\xb7\bI1\xbe\xb6\x97o\xb9*8\xbcj\xd7+\t\x8f\xb8\xf4[\x9db\x8fX\x15B_\x13\xc0\x91\xf1h\x12t\xcf3݆\x17p\x03/ C\xc476b@t\xc7\x12ߍ\x83\xef\xc6\xc1缼o\xfc\xae\xc64\xf5\x9c\xc2\x11/\xd9d\xc5\xfb\x86T\x15\xf4\x1a\x16\xbe\xa3]\x8d\xe1\x04\x7f!\xb1\xb6]\x9cK\xf7\v(\x86!\xef\xb9\xe2P)\xe6\xb5\xf4\xb1\xc3/:e\x94m\xd7q\x9e~\xf8\x8cp#\xeeP\xe4\xffȉ \xf4\xadPT\xd4*\x05'\xc8I\xbe\x8a\x1cV`\n7\xf7\xe3\xfc\x14\xf6\xf0\xfd\x1d+\x8bI\xbb\xae\xa8\xe6w\xd3\xf2]\xd5\xc5u\x19w\xc7yTP~\xf3\xf0,\xcb\xe5\xc4ݴ\x14SJ\x19\x81\b>#\x1f\x9b\xa3\xbf\xa5y\b\xc0\x8b\xf2={\xbd(Y\xb5(\x96t\x9fi\x96\xe5\xb9\rJ\x85\xed\x12\xb3,\xb7\xc3!\xf0LK\xbc4V\xe3\xa9z.e\xf3\x92\xfd\xbaay\x821\x9dF0\x91R\xb7\xf5\xba\xf2Z\xaa\xc6,\xcb9\x8d\xc2Vyi\xc8\xcf,\xcb\xf91\x99\xae\x99.\xdb\xd2t\x10`\xae\x9bi\xeeS1\x7f\xe7\xf3ޠ\xd8n\xae\xcf\xd6}\x1c\xa1\xb7\xa6\x8dԽ\x84\xb4\x85\xcf\xf5\x83\x7f\xf4\xe6\x14\xbc\xd0O\xfeو\xac\xe6\xe3\xb9oV\xf1W\xdce\x8b\xe8@\r}\xa9B\x9a\xf4.ܲܣ\xfc\xa6\x99\xce\xc3\x1b\xf0\xf1r\xa0\xf3\xb5Դ\xb2\xbca\x97\xd5\xdd\xcb_v\x14\xb2S\x1a\xa6Z.\x90\xd6\x06[\x89\xfdO\\\u07b8 \xfaA\xbe\xcc\xf2\xd7\xf5F_UQ\x19\x1fj\xb2-\xad=y\xe9ٓ\xab\xaa\xac\r\x8b&@\x0e\xb9\xfb\x13\xc0y\xa2\xaa38\xd4\xea\x03\xbb\x8f\x181Wܟ\x16\xce|Y\x9b\xea\n{_-܂\xcc\xd3\x10\xa2\xdagU\xf4\xf6\xe17\xe8|\x1f\x88\xf9e\x1b\x9a\xc7#\x1f\xd2jS\xfe\x1e\xe2\x02,Jk\x1f`\x8d\xee\xf1\xe3\xc3\xe4\xc1\x97\xf9\xde]\f\xf4\xe1h\u05f5K|\xfeJ\x9e\x14\xd2!\xacp\xa7\xf1\x1cpW\xe6i5\xcd\u0a92\b\f\f!G\xe1\x8c-\x01\xf9m\xbe\xccr:\xef\x8f\xebغϯ\x0f\x83\x1e9\xc75\x86\xe7\u0094Wa\xe6\b\x05\x1e\x7f\x86\x8d\x93\xf6\xca>g\xaf\x0eqTpO\xf1\xe5r\xa8\xa3\xc4pZ\x8eq2L\x0f\x9b\xa5S\x98*fsJ\xe8\xe0\xed\xad\xe4\xf6\x85\t+^\x1a\x10t\x98Y\x89\x98K-\xf8[\xd2z\x06֡\x90\xfb\xd6\x11\x1a\x03BH\xf4\t\xa4\xe3\x12F\x85C\xd3\xffb\n\xb7\x1e\xf7\xe2F(\b\xab\x84yR\xdc\"\x1bh\x00k{\xa3E\xb7\xb6\x11V\x81\x10\v\xd7\x18\x81\xe80\a\x8cۏ\xe7\x80\xe1\xf4}{\xc7S\x87H\x9a-\x8f\x94\xb7\xe1!<\xbd\xed`eǈ\u0096\xc3D\x8c\x96},\xdf\xdb\xe9r\xdd\xf2v\x88\xaaܼLk\xba\x86\\\x1a\uf889)\x86\\?li\xef\xf2\xeeh\x1c\xb7\xaa:\xf1\xd8,2\xbb\xdc\x03\xf3J\v\x80h\x98\a\xe8/zĨ\xb4OzD\xaajq\x96\x16Y\xb7Ѧ\x87\\\xef\xb0]\xc1\fd\xf7\xa5\xe3\x13\x9fL\ufafed\r\x02ʢ\xa8M\xab\x19\x1f[\x98\x8ao\x8e;\xf81ٿ^\x1a\xf5z\x8c\xbe\xee\x902\x1e\xaa7\xbd\xf9\xf6\x9cʮ\xe9P\xf6\x01NS\x11\x11\xae\xbc\x82ulsL\xa6\xdd\xc5\xd0J\xb1\xf6\x13\xba\x15u\xc9\xd8_\xb2*\xab\xbf\x98\u05ec\x14<һw\xeb\x02破.\xee\x8aE\xc4\x1fԵ\v\xbd\xce\xc6ֿ\xcef\xcb,\xbf\xb6\x0f>\u06dd\xe6oL\xf754\xc9P\xebJ8\x81\xbb\b\xaco\xdb\xc3F\x86Wq6Oԧ\xd8.\x1d\xb7\xebƾf\xfb ^qn\xe1J[^\xb4\xbdX\xfc\x01,\xfa \xaa\xfa\xedT=\x84\xb9\xef\x1b\x9d\x8e$\xff\\\xc6y5/ʕ \xe8\x8e\xc7 Z\xf0?\xb8ɾ\xf98n{\\Iq\xf8\x85*\x8b\x1b\x89\xc8\xefܗ\xdc|b\xbf>\xe74\x89j\xf4D\rz\xf3\xc1Q\x83\x8e\xdf\xec\xab\xfb\x00wB<g\xef\xf4$L\x96\x9b\xaafe\xe7\x9c&`>δ\x16\x01\xbe3\x90\x15\x9b\xea\a\xee\xea̻\xf3f\x1b\xc1\xcd\xee\xbe\xf3\x99\x1b\xdf\xe5\x10'\xe9\xa6\"\xe8\xea\x80\xf6U+\xe9S\xadi\x12\xfc\xf8\xabB\x12^\xb8\x05v\xbe\x02\x7f\xf3\x15\xf0\x1cW\xa9*MN\xd1\xf92\x9a\x91\xd9:.c\xc3W\xdc\x04\nm\x03\xb1A\x8c\x95j\xe1u\xf7\x19\xef\xbdN4K6\xaf}m\xfa\x8e\xcdk\xbe>GP\x8aKJ\r\xa0\x9f0CBQ$DD\xc7\xe3S\xe8\xe6`\x9a@\"\"\x9cQ\x9cZJ\xc0x\x06&,%F\x84\xc6:qy\xa8\xe0(\x96\a\xe2\x97\f\xf8\x87\xefy\xf2\xdfGr\x01\xbbx\xd4\xe0n\x80@\x81X\x97_\xca\xf4\x13\xb9RO`\x14*\x04\xc6\v\xda\x1fs\xc6\xe4\xcfI\x88\x9d\x12\xfe\x8e\f~\xc1\xd4\xe1\xcck\x95\xd52\x93\x8a\x9e\x1bX8\x1e0\xa5\xaa\xe2ڹ\xca@\xe8N\xaf\xa2V;p\x95\xa4\xe4\x13N\xeb\xbez\xf7ή\xa2О\t\x16\xb9\xdd9\xbb\"\x803\xb5\xaa\xbcyVV\xf5\xff\x88\x977\xceX\x17\nġS_\x04*\x801\xa5\x93\b\xb4χ{7\xc6b\xf2\x8f8}\xb4\xdb2JX\x93<eF@\x97\xf6\xea\x82\xd6\x19UFl㢆Żۚ\"67\xf6}\x8f\v\v@\xf3M\xd4`\x91\xe3\xdc45h\x8b\xd7뢤n\xf2\x95\xd3\xcdp0XP\x92\xae\xb6\xd3@g \xe2\v\x14\xb6\xf9J\xf2w\x95\xa5<\x16\xd7\x14\x06\xe7p\x04\x81\xe6\x1c\xef\xc1\xc1\xbad\xcbl\x05ǐ4\x12\x1b\x11\xc9:D\a\xff\x93\xfb\x1b\x8eq\xeaJ\x9a[a\xeb*$\xd1_\xf8\xb0\xaf\n\xe4\x8e]U_\xb5\xd3bXsUl\xd2\xe8-ص\xb0\xfe\x83\xf9\xd0~\u085dzYR\xe4\xa91\xe2\xb7\xdeUJ\x8fbM\xca\xf6\xe2\x03ՠ\x03\x87\xbd\xe7J\x92\xb8HeP\xb5*\xd2Ç\xb3\xd1h\xfb\x06\xd7}\xb9\xa7\x87\xaf\xb73\x8c\xf1\xebܳ\xef\x14\nr\x1f\xcdւ\x91\x11\xdc\x16\xc6o\x9f\x90 \xc8J\xf2\x9d[s\a\xd6\xe4X\x11\xb6\xdbl\xad\xb9\x15AEho\v;\x91\xf0\xdff+\x1b\x92\x12\v;\x11'\x12\x0f\xcf9\x85\xf6\xa4\xc3\xf5\xb1\xdb\f\x9d\xc7x\xcb\xec|R\xean\xb3u\xc8\x1b\xf8\xd9g\bո\xf4_\xacZ\n\x16+g\xf8s\x8eyi(\xd6MX\xd1,c^v-\xe1\xc0\x9bk\xb1F\x8fI\x9aU4w\xd5D\x83l\xb7\x86\xecm\xb6\"\x06x\x9e\xd4\xe7\xf8\x1d\xe3\x82o\x02\xff\x9e\x82\nZI_\b\xc29~.(J\xf2d\x02U\xe0T\f\x9c\xc4i\xb33)\xafh\xcb{\xff\xc8\xc6A\xaen\x96\xd0\\<\xf2\xd4b\t\xa0\x03\xc1\xdd\xe5,\x19s!8\x8e\xa2\x05\x87\x1b[R\b\xd2\xe36)h\x8a\x97D\\/J\x16\xa7\xbc\xaf\xdbd\x05W\x16\xe4\r\xef\xe7b\xddM\xc9\xdaC\x89\x12]\x8f\x9c7\tY_\xb4\xc0(B\xd6DȪU\xd5pE\xba#v\x85,\xe5\rI\xf8p\xc3\xe2}\xf6J\x9d\xaa\xa7\x9e\xf7\x9d\x1b^\x92p~,\x11\xd9;\xd0e\xb6\x9a\x80\x15\x85}U\xa4N\n\x7f\xd1\xd7I\xa4\x01\xe0\xa4\xf1\xe3\xe2\x89K%\xbclY\xc09<\x1c\x93\x8bȰ뎘V%i\xffz\xf1\xa8\xb1fqv\xf5\xf1\x8f_\x05\xf3\xee\x9f\xf9.\f\xbb\xaca\"\x94\xe2\xb8**\xff\xb6zOY\x1aT\xa2p\xca\xd8\xfa>e\xbfblͪO\xb6O\x8f\xf8\xdb/H\x958\xd3\xfd\xfdw}\x98\xf6\x0fڽ\x1b'\xcc'H_c\xa3\x0e\x90\xb2%\xab\x99g\xf7\xf4ϼ\x87'Y\xfc\xfbn\xe05kޛ4|\u00ad{\xa3ƽ\xfbv,q\xc0\xa6}\x15\xaf\xbb\xef\xdf%\xf1R\x1f\xf0Fpc\x9bFM\x0f6G\xa9V\xfb\u07b8d\xf1\xfe\x10\x93\b\x85\xb2\xed\xd9\xf5\x86*\xb8A\x80N\xacCx\tC\x98X!M\tz p\xf0\xb0.\xf8A\xe7\u0094\xfab\xaaʙ\xf4xC T\xbfn\xe22\x9b\xef\xee\x11\x05\xa1\xed\xf4\xbd\xc3\xe8\x8a\xe8J\x96\xd4\xfc\x14\x87ׅ\xab؝\xf0\xc5(\x19>\x90˭\xe4\nM\xb5\xcc\x12\x86\xb7\xfe\x05og\xacB\x04\xfa\xf5\xb4*)J\x16\xc1F\x1d\x89dy\x80\xb5\f\xd05\x9a\xff\u0605ֶ\x86\xf7\xb0\xaaMBm\xe1H\xc2[A+\f\xa6\x97ŝdy3\x8e\x05\xed\xb3\x14V\xd5\xfe\x86\xae\x89H\xc8\xe1Cv\xbc*s\x99\x93\x0f^x\xe1@S\x95\xc7S\xa3\xcf\x1bqɉ\v0\x85\xbb\xa2\xac\xd0${\x17\xc1&\fQ\b\x90a͗v$\x95\xebb\x1d\x84\x8es\x1f\xe70aܻwW\xe4\xf5\xa7\xbca\x88\xafA\xa2\x113\x82\x93\xc6y\x1e\xf1\xa3X\xa7\xfe\ue7bchT/\x9f\x9dl\xe9\x1d\xa3IRh\xf6\xe9g\xc8Q\x8d\xb9%0\x89\xdd\x10\xeb\x15\xde\x03){o\x0ff\x94vy\xfd^\x0eȰ{\xd8b\x84\xbe\x7f\u0530=d\xb0ʱ\xfd\xc9\xc7^c(qA\xec\x1ax\x0f\x18e<\xe7\x9d\x0e\x00\xf1[\x87\x8cK\xe0\x97\xaa\x89\x13\xd9D)4\x8f\x1b\xb3\xc5\x03\xa4\xfb\xfdA\xf2$$\xa5[\x9e\xac\xc9þun\x10\x10A\x19A\xa9\xae\xa5\x94\xab,7Ɩ\xed\xe3\xad[\xb0\x7f=\xe4\xc1\x9fK^\xea2\xbb\xa2\xca\xc2\x10\x92\"\xaf\xb3|\xe3\x18\xb6KxAU\x87\x92\x80\xd2\xcd\xff\x9c\x88\f%\xa9\x8dmV\x05GSP\x17j7\xf8\xb5qo\x19\xc2K}ξ\x81#\x8e\xeb\bHO\x82\x13\xa8\"\xa8P\x1d\xe5YY.\xb3B<\xb0\xb3g\x9b\xf7-\x81\xa1\x9c\xf9p\xb9\xa9\x16\xed\n\x87f'?`%y\xda\xf2k\xc7\xf4{\xc7}\xe36(u\xc5&O\x03%5'إ\xb8\xbbҷI\x90M\x1b\x98N\xa5\x80\xba\x9dAĠ>q\v\x9f\v\x98]H\xf8\xc5\xc7a^\x92\x85\xeaыF\xc4lO\xb8읝D\x01\xabn\x1b6ɂ\xbb\xed\xdb\xeb\x04nRD[p{\x80\xbc\x90|($\x17n\x89\v\xdeg}\x8b\xc1;\xe1}~a\xa4\xa5[\xee\xf8\xd7\xc4\x7fal\xe8\x91\xf7plQ*\x98\x04}#\xb5\xb1\x86\xb6\xf0yk\xf2y\xfbw\xe1\xf3\xd6\xe5\xf3N\xf0y\xd7\xe0\xf3N\xf1a\a}\xd8=\x8c\xcf\xca\v\xcb\xe8i\xc9h\xa7\x02\x87\xa7[/\xa3\xb76\xa3}\x83N\xa8\xffm\xee\x8d|\x92d\x15v\x85\xe9\v\xf9A.\\\x94\x92\x1a\xaf\x06\xd8\x19ƫ\x01气\xa4\x84M\a:\xc7^A\xeb\xe9%\xa7\xf0*R\xd5\x1d)\xfc\xe2\xb4\xda^NU\x05\x14\xb4\x89\xaf\r0Q\xfb\x80ЮDQ\xb4\v5\x93\xa6v\xd8\xf6\x0f\x8c\xe9E\xd2#\x05\x8d><ϧh'\x1a\\~黱{^\xc5\xeb\x1f\xe3\xf4\a\xca\xe74KY\x8b\x14푘\xc0\xe5\x01\xdc\b\x8e\r\x7f\xb9s\x19\xa8L\xa0\xfb\xe4\xdb\xdcU\xbcn쭱\xde\xeeG\xcb\f\xd7\xc4\xf4\x9b.Ǚ5V+̇\x1c\xb3\xb4Uk\x03I\U000ed535\xfdȱ\x97â\xba\x89\x17 \xe0u\x88\xeb\x1fk\xf7\xfa\xc7%\xac#\xf9\x7fp\x05\x13X\x87\xadq\x94\xd7q\xfa\xaaȫ:\xce\xebF\v\x1bf\x81\x06\x05[\xefӦ\a{n!\v\xb1\x11\x86'\x17L\xb5\x9f\"\xbdY\xb7\x9fU0\xe1\xd1S\x8d\v1a3҄ѕ0\x01\x0eo\xb3\x8d\xe2V_\xd2#\xba\xfc\xffĸ\x90\xfc\xc1\xde0>\xef!mr\x04\xde_\xccy\xc9\xc7\U000a659e\xe08\u00adT\xa2x\xc2\xc4\x05\xdbO\x9a\x1e\xc7\xf7\x1f\x82TT\rB\x89hk\xa5d\xac孹N~\x89)\xe4\x01\xfc\u0092\xaa&\x81搉\xe1 \x13\x18\x8do\xbf\x15,\xa9n\xf9|\x99V\xb7A/\xa2\x17\xabض>I\xaa۞\xbcvZ[0\xff/\x05Sǳ\xbe0\x11\xb2\xb4\xcf\xef&\xaaB\u05ecP\xb1\xae\x84\xf7\xf55+ޔq\x9a\xc5t\x9bL\xbe\xbb{\x02\xa3gC]h\x10\xbf\xcbV\x9bz\x11/;Mr\n*H\x8a\xa2L\xb3<\xaeݻLd\xaa6r\xf1f\xe4\x91KG\x9f^\x9e\u07b9\xa0\xa3&h\x04\xc9v$\xe9N\x8a*؎\xd0h\xabӪ,\xe7i\xc9\u0382\xdb\x11\xdc\u0382\xa3\xb4$\x81)\x9e\x840z\xc0\xaeW\x94\xf5\x02oy\xad\x17Y\x82\xe3\xba\xda\r\xe1\x88\n\x1eCB\xbf\x13\xb2X#\x19\x13\xb1\xdc%ܫ\x9a\x90дPլd&\x16\xf4ץ\xd5,Ip\"А\xd7y\xb1*r\r\x94$V6\xfbu\x93\xf1\x18\xdc5M2\x89$?\xc66%\t\xd2\x0f/!Q\xaf\xe5ey\x90p\x05\xcf\xc5\x13/Q\x01\xec\xc1KcE\x1d\x1bda\x81\x11߽ܨVV\xf4\xfa\x9fH\n*\xa7\xfd}\xc1\x91j7r\xad\xf2\x97\\\x01\x12\x0f\\\xd5e\x9cW˸\x16qHE\xce\xce\xca\x19]I3\xba\xd6Ox\x03\x9cN\x89\x80\xc7D\x96\x88\xa60\x1e\x0e#\x8d\x89\xe6\xe1\xb3g\xc3\b\xc6\xe7C\x9c\x85I\xb6\x86(\x11\xe8\xc6,/~+\xd9\x1dd\xf9-\xb3o\xa1\xb4K3مmi\xee[\xcd\xc3S\x10\xa2\x8b\xf3-p\xe4\xb9o\xb5\xd8\x00^[W\x03\xec\x97\xc1\xf8-\xb9\x0e\xf1R\x01\x0f\xe3:\u0383u\xbb\x88\xb5\x029\x82\xb6n\x97\x1e]\x17\n\x1b\xe9i\x84K\xa7\xa1\x1bC\x95\x98\x03-\x91\xe3L\rǤ)0\xc1v\bǚ\xc211\xa1J\x905Gr\xe8%\x82'bT&!\x0f\x1fj\xcf\x10\x9a\x92$\x11\xb0}\b\xd6\xf0\x92\x17M\x04\xba\x13j\xe5ЃB\t\xa2+)B\x1e\x1f\xf0\xb4\xaeq\xf8,\x90`\xf7\xf6z\x0e\x17TU-\x04p\xc9\x7f`\xb40,\xaaÅ\tD:P\x15\x05\xb4\xa0d\xdf\x1c\xad\xa3\x97h\xb0Q;XBpz\xf6\xd5\x1b\xceJ\xe7\xd0\x1cܸO\xb4\x8f\ar\xd4?@\xe7\xc0\x92\xd6\x06\r\xa6\xcd'w\xf6\xd5oN4\xf7\xa7A\x95\x96\xb5\xda\xf3\xd6\xf1\x96\xa6\xc8\xe3\xad1\x1f\xee\xa1\xcc͔!\xe3/a\x18\xc1\x10\xae,M\x83\xd6\xf6\xe5\x8c\a\xda\xeaX\xd8\t\xa4}\x1e\xa4M7.\t\xce\xc09\x82\xc6\xe4\xb8̯\x87\xa1;\xbf\xbd\x82>M$9\x1ciQ\xe8\xc45\xba\xa2\x81\x9a\xb7\xae3k\x13U\x1d\xb6\xae:\x81\x02D\xc1\xacC\xe8\xc3z\x18v.E\xc6\xcd\xd8e\x11\xa7\x81͋\xf5\"\x1bq\xcd̦\x1eU\xb2\xe5\x92-\xc5%\x8cE6\xee\x86\xc2\xcbY˸\x1e\xfa\xa0Ԁ\x8b\xa02G\x0f\xd6-\x96\a\xd5$J\x93lB\xeew \xd4V\x8f\\m\xba+8\xb6*\x18\xeb\xf8\xe0\xaf`\n4}±\xea>5\xe4\xd7\xc3\xfd]\x8c\xed\xf3\xf6#\x178w\xfdW\xb3\xd4%\xf4\x9f?\x8b\xe0\xf4\x19\xdfU\t\x96Q\xc6\xf8\xf9\xe0<\x82\xb3\xf3\xc19\\i\x95`\xc4N\xbbT\x02d\v\xdd1~\x15\xc1Z*\x04D\xc2?\x8960D\xf05.\x88\xbb\x88F\x9b\xb9.R~ئ3`\xd9#\x82h.\xb1\xd8n8\x86\x1a{\xa0{\xe9\xa4\xfe[\x8bq\x85}\x98\xab\x18N\xf9\x01K&\xe7\xe5\xc7_\xae\xf6̐r\x80\xfa\x881\xc5\xe6!\x8f͋\xd2\xda\xdc`Jჩ\xfa䫙9\xb0\xac\x9a\xff\x81\xebX\x93&\x0f\xa7\x9aKֿW\xf1\x01\xabֿWq\xfb\x90]\x92\x17\x8d=fi\xdaml=\x1d\x9aq\xea\x82\xcf\xe1\x1c]7\xe2e\\\xdd\xc40!l/\xa0?:\xc3\xd4E|\x17g\x19\xa6\xc65\xbc\x801\xc6\x12[oXY\x17?eIA\xd0w\xac<{\x16ZԹS\x9e\x00\x82\xa9\xddt)<\b\"\xaao@腿?z2\x8c\xe0\t.\xfeZ\xec\x83K8?\x8f\xe0\xc99\\\x19\xc8\x04\xd5{\x91\x8d\x1bȞE0zf\xe12Zۅ\x0fэ\xf6\xa0S]\xf9\x01\x03D\xb0\x92c\b\xf4\x92h%k#$g\xabL\x86#\x18<Qy\x9cK\x8d\"\xba\xc1F\xb1\xd1\xe0<\xf4\n<\xb6F\x8d\x92@\x92\xa1SB\xdf\x04a\x15\xfa 6\x18\x15\x99&\xd4\x14\xcf|\x1cV\xc1\t_@Et@\x1c\"t \xb25\xc6E\x13m\x83\x95:\xeb\x12\xe8d\xeel\x88۶\xf4\x1d\xa1;\x86\xd1S\xfe\tW.\xa3\x1b%Gϭ\x92\xe3a\xa3\xa4\xd1\x17N\xe9c8\x7ff\x95>;m\x94v;ʫa\xdb2\x198LkLZ\xb3\"ϻ\xaf\xa2\x11D\xb7~q\xa0\xe9l\xbf\xe5\f\xcf\xeb\xac\xd0,\xbb\x91\xef@\"\x19\xa1\xce1\xe2Ǉ_\x8bG\xca\xf5>\x0eEcm\xbf{n)\xde_\x87\xf6\xc3ۖ\xb2\xfd5*\xdbɨ\xf5̕\x9e)7\xea2Q\x1d\x997\xd6\xde\x7f\nc\xd3=\xadI\xbbQ\xa4\xdaB\xfd\xf8Ϡ9v\xf7o\xa2\xfa\xb7Mw$\xe5\xde\xe9B-\x11NǓ\xbdf\rG\x8en\x9a\x84p\xe2\xeb\xc5Fw;\x9d\x8aHO\xbc\xfd\xdf\xe8\xee\xadGu\xdd\xed\xd3Iy'\xc9%\xe7AS\xe9nԨDҘ\x8cD\xc4M\xa2\x1f-ldk\xde6ơ;\xe5\x10Y\x1eB?@w\xbeĮ\xf1ph\xd8\xca\"a\xfe\xd9\xee\xb5\xfcl\xbb\x8c>{Z\xf4\xa9Uݶz\xffq\x8an\x93\"3\xa3a\xa8Q\xc2\x19\x9c\x9d7V\x13\xb4Ж,\xa9\xe3\xfcz\xb3\x8c\xbb\x03H8\xb0\xf7ZaN\xe0\xf4\x89XQ\xfaΤCY\x17\x9fr\xee=\xef\x9a{y!\xa7m\xffL\xb3\xaeb\xc9\xe9\x13T0\xb6\x11\xf4\xf9\xaf\x9d3\x15\xb9m\xf8\xd4#é\xaf\x93\x96\x7f\xdch颲\x1d\xc4\x1c#+V&\xb1\xb8\xf5\xde:8$ЃGE@S\xfc\xb2\xb8\x0e\xd4\\\xaf\x8fQ\xcf\xe0x\xaf&v\x02c\x8f\t%|\xf0\xf8R\x9e\x90}4\x85)\x970\xfc\xc0\xe7d?\xe2\xe0\x93\xbc\xfb\xe7\x1eu\xf6q\x18\xfdb\xdbu\xa0\x06ccInv\x06\xf4\xe1\xf9\xd0\x19\xb5\xaa\xf1\x9fz\xb8ʊ\xfc\xb5\xff\xe3\x06\xa8\x97.O\x9e9$\xd7q\xbd0\t\xf5\x04p\xab\x17N8\x0e\xe3Ec\x8a7\xf0S\x9cf\x9b\xaa\xf1\xac1\xe5\xbd\xca\xcad)\"\x0e\"\xaa7\t%\x04F\xc1\x96'\t\xf5\xce1\xae\x17?\xef\xd6\xcc\b\xf4\xc9]\xeb\xabͲ\x86)\xcc6\xf39+\x05#\xe1\xa5\xfc~[dy\xd0\xeb\x85\xc2\r@\x16\xe5\xb9NPFaܪ\xd4;:\xae\xd7qY\xbceI\xed\x1fD\xa2\xb8\x80qǚ\xa0#\xea\x85~\xcc\xc5rw]\xe4_\x94\xac\xc3*VmV0\x85\u0601\xc1\x91ɽƇ\xe2Z\x95\xce\xdb\xe3<\x8e\b\xfb\x1e\x8cYc\xc7]mV]t\xbfby]\x16Y\xdaN\xbb\x00T\x16\xa7\xd5@\xa48M\xa1\x90ꚇa\x18ɋ^\x02\x9e\x1cd鎄\xa8\xd3ȒI\x01\xbf\xc1E\x17\xb8\xf8\xef#\xee\x04>B1\xe8\xe3Y\f-\x1e\x02\x9c\xc6\xd2\xceL\xc0\x13\x9cw\x82\xd7\x0f\xe4\xedo\x8f\xf4\xbd\x86C\x9a\x9e5\x9b~\xe1\xdeu\xb3Y\xa0\xb3?\x84\x15澮o\xf1\xc4\xdc_\xf6-\xe6\xe8\x9cwR~\xda7\x81\x11\xec\"x\x02G\xf0\xae\xe5\xb8.n\x15z\x81\x84/\x12\xb3*\xe8\xe0_\x83y\x82I\r˭5U\xc1\x19\xae\xbe\x87\xcePx\x96\xa3jpm\xa7h\xcf\x0e\xa3\xe6\xbcBu\x8a\xa9K\x1f\xf1\xe1\xbc\x19\xc8f~\xc3\xe2zS\xb2W\xc5r\xc9q\x1b\x0fK\x14\xaeu`\u0381+zy^~طFd\xaa#\xa1M\x19\x15S$]\xeaQӫ,\x8d\x12\x89\xdcfu\xb93\xdeJ\x8el\xa2\xdbHU\xe8\n\x8d\xa4\x81\xe3G\xe4m\x1b\x06\x93\xb8\xde\xf7=\xc5\xfb\xa0\x18\x98\xc2bu_\xb3\x8a\xef7\xcb:묇L.\x1a!\xf1\xd5\xf8\xb6Y\xdb>\xfe\xbb\xb9k5\xc0\x99j\xf7\xb4\xe0\xbb,g\xaf\xeb2˯?y\v\x1c\x8a\xc3\x03%\xa7\xadY\xbd\xefL\x14\xb2\x88yc\xd2\xee\xa6O\xde\xd2\b\xaa\xcd̂ǧ\xc7\x0e\xbb\x1eb\x97t\xb6\x1e\xf6\x9d\x91\xb7\x8d\xb8]\xf4,\xe5f֤\xc8\x04j\xe7\xbeA\xd9[\xfe<\x87\x8f\xffv\x05\x97o\x9b]\xe0\xef\x04#r\x90\x1e\x984\xc1\xfe\xaf\xd6\rtշ\xb5/\xf0\xfc\"\xf4Ŷ\xe9꘏\xd75\xb2\x9eK[\x91\xc5\x1b\xcd0\x85\xde\x7f\xf4\xf6D}rf\xbc\xbf\x7f\aR\xaa\x9b\x81\x0f\xb8\xfe\xb3\f0\xb7\xa7\xec^n\x10\xdf@鼐\xc7\xe3\b\xdbt\b\x19[\xf3\xb2\xf7\x13\xb3}\x82f4\x00\xa3\x1a\xac[E͡\xfa\xc6/m\xf7\x927S\xe2:\xa5\xef\xdf\xc4b\x7f\x982#T\x83L\x88\xa0\xfe\xb4%P\xa7?L\xa5\xd1\xe5qar/\xf1\x9ag\xf6%\x8b\x85\xa2\xb6&\xa5\x93\xeb\xdd\x1f\xac\xb4\t<\xc3裪o\xf2Ƶ\xa4\xdaTݚ7\x92,-\xfd@-\xce(z\x802\xd79\xe5\xc8\xed\xb1\xb1ѵ\x15\xb9\x8b\x87Nd|[\x8c\x9e\xe3\x9fB\x8bC\xec\xc7Ӷ\r\xba\x97\xd3j\xb3\xfc\xe0q\xa1\x9a\xf4\xf1\a\x88h\x8f\xeaR{lt\xb6E\xbcĦ\xae\xa5\xd2*\"v\x85\xe6\xa81\xb6\xa3\x1d#g\x8f\xa0\x98\x88?D\xeeL\"a\xda0X\xf8e\xd0ؿ\x1a\x1bb8\xd1_\xe3\xab\xc8\xdc\x11\xdbYpՠ\xf2Pa\xd63E\xa74\xcb\xcaT\xa0\xf0\x1d\xff\xf3\x8e\xffy\xa8\xb0kZ\xa0\x8bi\xad\x03@\xdc.\xf7\x1a\x11čh\xaf\x19\x01\xe0\x9d\x957\xf6>\x02i\x1d,\xbf\xe3g\xc9\xef\f^[\xd39I\xa2\xb5]\xf7Ya-\x80fT{ɫ\xf7&Nˀ\xd0f\xdamy\x0e;t\xec\x0f\xdb\v\xf7\xb8\xdd\xce\xd7Fg8\xdcL\xd1b\x8bi4\xc7I\xb7\x9db6yj\xb5O\xac-\xb2\x95F\xf06\x1d\xbaB=\x8a\xac\x93d\x94\xb6\xbeN\xe2o(\xf7\xed\xf8\x0eb\xa2\xe0\xf5\xcaʌ\xd8fhF\xb29\xbc\x85\x17\"\x80\x9a\xa0c{a\xe5~\xce\xeb\x0e\x15\tV\xfe\xcexVY\x91\xbd\xb3!>GRCA\xef\xee\xc2\x12/%\x8b\x97T\xbdj\xfbU\x04\x97\xbcF\xdeP1\x17\xb8W\xfbx;qn\xabTp@5/Z0\"5zԢt\xf8\x80u~\xf4\xa8m\xf1\xb1\xca5\x01xAsGn\x15\xd0\x19\xd1#5\xbd\xb5B;\xb9\xd1#\xd72\xb3\x1f\xb7\x9a:\x9b\x88E\x16\a\xf6!\xa44\x99\xedC#R\x9d1\x90ȡ\xd6\xf1\x04\x04\x1f|!\xfc\xe6\xd84o\xb3*\x9b\xc9a\xd90h\xc6e2\xe0\u05f8\x12\x05\xf2\x02,7\r\a_\xb2\xcc\xd6\xed\xc6\xf5}\xbb5,\xbdf\xa9\x88\x13\xb6\x1eF\xb0\x1eE\xb0\x1eG\x90\xe2\xe3£\xfd6\xect\x04S\x87\xecqc\x8b\xe6<\x9f\x96\x8et\xa3\xc2f\xc0\xe9Q(\xe9\x12\xef\xb7\xf1\x1e\xb9.Y\\\x7fQ&\xdf\xe65+\xd7\x05\xf9\arj\xc3 H\x87\xd0\a\xe3 \x96'\xa4\xa3\xd04\x96\x83\x8dw=\xb6\xf2\xe8\xfe\xc3z\xe4\\X\xf6\x86\xe7\"\xa8\xf5ص <^\x0f)䓨\xc4\x17\xe5\n\x0en\x9a\x80\xbb\xb4\xb1\xd1.\f{)\f\x02}ԙ\xf2\xe0\x8d\xe9\x88\xff\x0e\xddp\xeb\xbce\xfb\x82\x1b\xa5\b\x966\x9c\xf4\xd6C\xa7?\xf5\xdaM|\x90\x84\x0e\xad@\x1b\xeb\x11\xb2b=\xa6\xb7\xb2\xa7x\x17B\xbc\xa9\xba\x1e_\x8eT҈\x92\x1e\a\x06ܨ\t7\u2dc74\x1f\xedn\x1c\xb5\xadk%\xab\xe2\xd5z\xa9x\x19\xb6\\\n\x92P]\xa3h\xd8>\x88\xb8\x89C\xa2\xc1\xc1\x94S\x1c\x06\x9bg\x14\x8b\xc1R\xd5d\x89*\xd2\xf7#p$UŦL\f/\xdf\xf6\xc1\xa70\xd8%\xad\xc1\xc7#߹\x9a\x99\xa94:/\x8c\xbf\xe5\xad\xc5eO\xe1\x97\xfa!\b\x13\xc9\x05\x84\xba\xc1\xbc\x1b\x14,\x1a\xb1\xdc\x0e1\x88\xe3M\r/\xfc]\x95v\\!\xe2^V\x11\xa4\xec\xbad\xd4\xe6\xe78\xc8\a\xc3Q\x04\xfaF\xbc\xccm\xde<\x8f\xcbD\x9f\xcd\xc8!\x17\x84\x0ea\x83:.\xaf\x19\xbd\xa8\x94\xa5,\xaf\xb3Zn-\xf8\x9c>@a2\xe7\xfeԫ\xdfI\xba\x1d%\xaf\xc1\x88}\x87\xdb\xea\xc1\x8dl-θ1\x8a\x8e\x9e\xa1\xde\x1b;.\x01\xf3\xc9\x0e\x93\xe8(M\xd6\x12\x0e\xe6ْ^9j0ʠ[\xe1\xc1x\xf9\x05L\xe1\xcf3T\xab\a\t\xb2\x9f\x05E\x18\x19\xf8\r\x13G\x04ŽO\x96\f\xbb\xd1N\xccKm{DE\x9f\x82\xef\xa2\xcf@*\x7fz\xe9\xeb<M\x12\x15\xca\xf5\xdf\xde^b\xf5\xc5\xc7?\xa2\x92]$*m6\xbf9\x9d!%\x1a?P\xac\x94\t\x14\x03\xfckE\x9a\xd6%\xadi\xcd\x13\x1a\xfaCΫH\xbdٳ\x15\xf77\xa2\xb57[m\xc9\xde>\xfd8\xe7Oj\xe4\xa8Q\xd3\xf6@\x9dhS\xdaؑ\xbf\xff;7\xfc\xc3\x0e\nt\x83\xbb\xa8F\xb7\x8eO\xd3c\x1f\x81\xfa\xbd=\xe4i\xe2\xfb\x83\xfbW7\xfd\x1f\xd7\xc5\x1f\xc9$\xff\x80e\xa1a\x96<d\xfe\xe55\xeb\x0f\xabM\xd6\x1e\\\xacԟ0\xdaBs\xa5\x7f\xbco\xa5o,\xe9D\xa4\xfdt\x17\xa7;ί\x1f\xe8\x9f(\x94\x1fU\x93Ҋ\x02\xad4\x1do\xc3}7\x00<\xa4\xc9\nN\a\xa5x͋`H\xab\x8a\xa0\xb7.Y\x92U\xd4r\xd7b$5\xad\xce\xfd\xb2V\xc7,\xcd;\x85\xa9\xcaR;\xcc\x16\x95\x89߳\xc6mk͟\x98\xe1\x04\xc1\t\xa4\xae\xcd\xf4\x12\xd6C\xb8r\xf4렦\xf7Ji\xc7=\n\xcd\x12\\\xc1͌\xcdY\xad\x15\xb4\x06ܺ\x11LG\x8f#\xbe\x9a\xf6\xf4z\xd2\xd3k\xea\x9e\x15\xf5}\xf3\xc9g\x14.\xad\xecI>\xbd\xa6t\xbe\x9b\xe7\xbal\x13\xe6gJ\x17\xbb}ũ)yc\xb9j\xb3\xd1\xee&\"\xbdc-J\xb9cit\x98\xaf\xe7\xad!$\x9b\xe2\f!\xa3f9\x94h3\xca\x7f\xefӜ\r\xfc\x92\r\x1d\xf89H@;Z\xfe\xfb@\xcd\xdcD\xa2l \xf6MP\xc5\x10\xd5e\xf7\x7f\xf6\x94\x17\xd5/f\vDo.Z\xb9\xf9\xf8^\xdct\x9b%i\xf67D\xc9\xd5\xfd\x1b\u008b\xca\xda\x14\xa27\xed\xdd\xf6\xf8^\xddv\xbf\x86\x98\xc2\x7f\xff\xb6X\x93\x8cwB5\xf1\xbf\xd97\xedzI\xf5\xe45fW\xc3\xf6o\x18(5\xd4J\x05\x91\x13߃\xa4\xc8\xebbc]\xb4\xb8.\xf1Ԩ\xaa\xe3\xb26m\xf9<\x80k\\ָ\xef\xe4\xe4\xafވ\xe2\xaf1\x9d\n\x8a &\x97W\xfcܩR\x9e\xb3<.J*\x0e\xa3Rq\x1a\xb5\xa6\x84\x1f\xe2\x1f\xf0\xe7N\xfe\xcct|״\xd0]\xa0Sy\x7f`}\x01]*\xc6*\xfa\xf4Vh\x86s\xf7\xa8\t\xe5@\x8c[\xf1H\x883/\x0e\x99\xfb\xcc\xcc\xcdhBybYC\xb1YԢ)\x1a^\xe1%\xfe3ф\x81\xe0\x80s\xa7Rc{\xde\xc06t\xca\x12\xeb\xa6Sh\"o\x18*\xd3-L\xdd.\xfbjk\xb9̤;\x0f\xc8\xce\x001C{\xa6[x\xcc\t\xf8\xec3,I\x1f;\x93\xe2\x84/\x81ܹ\x17L\xbb/\xefq3\xe42\xef\xf8t\xe7\xd6D\a\x85\x1a\x90\xce\x06%\xd4{\xb9X\xa3\x80a\xfd[\x94J\x941\xfc\xd8\xd9G0\x89\xe7|\xc5\xe0\x83\x8c\x80\x8b\xd1\f\xe8\xff\xfa\xfc\xa3Ͽ\x86\xc6\xff\x199\xff\x7f\xf6\u07bd\xbfm\x1bY\x18\xee\xdf\xfe\x14\b\xbb\xb1ň\xa2I\xf9\x1a\xc9t\xde4\xe9%{r\xe9\x13\xbb\xbb\xed\xe3\xfa\xe4G\x89\x90ń\"\xb5$eK\x95u>\xfb\xfb\x9b\xc1\x85\x00/\x92\x9c\xde\xf6\xec\xd3\xec9\xb5\b\f\x06\xc0`0\x18\f\x06\x83\xb7\xfe[\xb4A\x95I&,T\x1d\xb7ZΑ\xe8%j\x9e\x83\xd8\xd4y9\x9eE\xda\xd5\xd6[\x9a\xe6\xe1\xb00H\xe2{\\<M\xa8\xceg䠸:\xaa8\x17G4&\x1e)A[d\xca\xd2\xe1\xbf\xc8\xfax̐\xf1ɛ\xe5\xec\xc1~\xf8\x1d\xa2is\xccf,\x06\x12\x84\xf8\x82\xf0$Q\x17#\xab\xdfZķHƽ\xb8\xd0v\x18\x12\x98\x85$\xc4\x13\xb8\x18̅\xa5k!\xa25W\xe15\fڙl\xde\xd5\xf8\x1a/\a\t`\x82\x15\x875\x13\xa5\x84\xc2\xf36\xe0P\xe1\x9dr\x95\xce5yFB\xd2#\xe3\xda\x00עOκ>\xb1\x99;\xae\x06\xd4\xc7\x1bĥ\xe6vJ\xad\x15\xb0s\xb7\xda\xd4N\xa9\xa9rQ\xc1\x11cS\xad\xe8+\xee\x1az\xeaen\x1c/\xd7,T\xcc0\x0e\xe8\xbcG\xc2\xd2[L\xab\x1d\x05+>\xac$\xf9ϷȠzJ\xc5w(\x1d2`\xbf\xf4\x13Pv!\x01\x90\xc1&W\x01\xb8\xd52\xb01\xfd\x1d\xf12\x89S\xcfF\xd3*\xcd?\x16hB\r\r\x1b\x0e\x9fxj>\xd6o\xaaQ\n4J߮\xa5ty\fo\u05ce!!\xf3\xae\n\xfdq\x13\xee2\xf4Z\xdcxx\xed\x92'd\xce/\xf4?\x81\xff\x9c{P\xe9\x13\xf8O\x1b\x10>!\x8bn\xf9a\x15\x8dT%\xcfĺ\xd3-V`V_@\x1b\xdfP\x1b_B\xc4`\x86j\x02\f\xfb\xc7\x1a\x9f\xe2r\xcdk\xf1\x96\xb0j8\xd5)\x8b\x02\x8cM\x8d\xb1Yb)\xc7\"\x1f\x8b\xc9\xdc\xc5\xd3\f}*\xf3\xea?\x8a\xae?\xc2e\xdcT\x1a\xa9\xe0/\x01\xab\x87\x9c\xedvmۦL\x97\x1a~\xd2L?ؾ>\xf9\xa8p\xfb\x86fy\xbcYe\x81\xc3\x17\xc7Gbu\x82\xd5\xe4ŋ\x7f\xb6\xb0Ϋl\n!݄\x90g\x9fx\x98\xa8c\xb7$\ajgn\x9dN6\xad\xbc\xf4\xc1\x11\xb5\xdb\xd7\xc4+\xe3\xa9ޯ\x89\x16\xca\xf5\x97\xb2lͦ\xa5i\x0e\xf0\x8c\xd0rF\xb0\xfa\xc2\xebkMp)\x0e\x84%\xedX^\b\xdap\xd1UI\x12\x9e\x9d5\x1b\xd6-\x8e\x02}=\x9d\xbf;Ţ2\xe8vOT\x8b\a\xf5\xe0n\r\xb8s\xbd\xc5\xc3g\xe8*U9\xfc\xabV\x1f\xea\x92e\xd0Pέ)\xe7\\7\x1c\xb6\xb6\x06\xa4C|\x93<!\xf6\x91n\xd4R褸\x8eI\x02\x7fz\x98˂\xee\xd6\xe67\x11\xd1\"\xc3\xfe\xfa=\x1c\xf3цs\xf3c\xf2\xa4\xc2\x02-\xd3܆\xe0\x1e\x19\xa8tl\xf6M\x1f\x12\x8f\xf8l$\x06L\xce\x0fؗ\xaf/ 0\x14-\x04l#\x04\x10t\xa8,\x19<\xdfe\xf9\xae\x9e_\xbdcG\x9e\x90O\x16^\x99\xfeT\xba\r\xad\rK\xe9H3\x9b\xa1\x15\xb640\xf1t\x96o\xbe\x00\xc0N\xc3\xfd\x86A\x19`\xb4\xed`3i\xb16\xe6,\x8f\x06a\xfe\x02VA\t\x91!\xdfqr\xb6\x1f\bD~՚\x88\x9f\x92'\xa0\x91\x05h\xf96\xc1\xbak\x02\x81D\xab\xdf\x05\xc0}\x13\x17\xc7\\\x18\xbd\x8a\xb30\xa0\xad\x80qo\xe5\xfd\\d\xd7\xfa\"Æ\"\x055\x14\xaf\x12\xa5pN\xd3\f/b\xe1\x13\xb7\f\xc5\xda\x17\x915lf\xcdcu\xcd\xddjj\xe3\xafk\xa1\xda><ůs\x03զ\xe3\xaa|O\x19\xab\xaf3\xad\x94\\!\xb4\xf5\xe36I\x938\t\xd7mɔK̙\xba\xdf\xd2ΐ*:\xf4յ\xae4\a\a\x1fx]\x1fr\x9ae4B;\xb3@\xa6\xf8;\x96ޣ\xcb\\\x8bd]\xb6?ý\x19\xec\xd343\a\xb5}n0\xd8\xdd%\xd4\x1e\x80ʨ]1\xc9@ť6\x9d\xda\xcaS_YW$F\x8d\xf6\x85̭\xc0\xa8\x05\xd3:\x1b\x82l\x8d\xda\x02T\xb23\xb0hd\xae\xbd\xc0\xeb\xc7\xf4\xb8\xafk\xec\xd4\x1e\x92\x0e\xb6\x1f\xf4ޒ\x12\x9du\xa1l\x17\xcb\xeaE\xbb\xe5\xa2\xdd\xe6\xf0ej+\xe6\x95V,\xd4V\xf8\xa8\x87\x97\xea\x11\xad\x98\x97[\xb1薋v\xebަ\xbeu\xd9\x1b$\xb8\xd5\x06+\xc3m\x97%\xe0\x9e\x9b(\xfb?\xc6mW\xd4N\xe9M\x98\xc4v\xc4T\xaek\xae/\xb9P\xd4l\x06O\x9b\xc1Kn\xac\xa2\xac\xce\xcd<U\v\xfa\x80G\xaa\xf3\xca&\x16\xc2\xf6Wv\xc1\xa5\x96\xc9\xd7\xf4\x8a9\xa6\x1d>\xca\x031eo{˶VCPW\xd9z:T\xae\x00\x94\x8d\xc0\xa2\xa2u\x9b\xdbM\xdb\xdb\xfa\xd3]\x8d\x06\x12ţ\x10\xad\xa5\x12\x11\xaf\x9f+V<\xf9\x9c\xb8\xb4\xe3:\xe5F\xaf̲\xddJ\b\x86d\x8a\xaf\xdb\x15\xaf\x86G=b\xa4\xfc\b)\xed\x11#2\xca\xf2+\xa0\x91?\x8b\xfd\xc5&\x01F\x83\x1b\xfaY\xd2\xcb\"y\x1ab\x7f2E\xb9\xff\x1ci\x86Mhdi\xc1?\x15\x1e.\xf1-b\xa92\x14$W\xd8\xf5VgL\x8b\xf3\xafµ\n\xaf\x02\x86ߑQ\x11\xfd\xafeO\xf1\v\xf7V\xd0Cŗ\x0f+(\x9cH\xd9~s\xd2'\x1f\xdbm\xb5\x129\x9a\u0092{kaѫ\x8f\xd7\xe2\aD\xfe\xbc&\xd7\rg\xef2N\r\xc7SYR\xff5\xf3\x03\xfe\xf6\xbd\"P`\xebX\xb62VN\x89\xc38\xa3\xf0\xec7\xbenU\x0f\xcbMr\xf8n\xf3Ԟ\xa3\xb7\x9c\xf8\x023>k\x9e\xba>\xc2Ӭ\xfe\xa8\xec\xed\x00\xbc\x11\xb3+\x1d\xba\t\xe6\xb6\xea\xb1,\xc3P\xdc\xe2S\xeaXm\x9b(\xa9\vL]\x98\xe4\f\xbc\x15+\n\x1f\xf6\xea\x05<-Zߵ\x8d\x8f\x05\xf3\x86V\\\x97k\xb0߮\xc5\xfe\xd0Ƭ3#\x15\x8d\x9ana\xfcٺ\xde\xda\a\b7\x96\xd6u&\fi\x85f\xb4y\x97\xedX\xe1a\x19H\xc4w\x82\x162Q\xdc\x10\x99\xdasP\x9b\xb2\xb9r\x95ej/0\x8d\xbf\xcc\xda\xe2\x19g\xe8=\xd0fEE\xa3\x19\x8f\x95\x1fg\xc4G\x84m\xf6\xf8ax\r\x9c\xdaR>\x8bs\x191c\xde&\x01m\xe9\a\xdc\xfc&\vS]ĝ!\xa6\x8chFQq\xa3\x85iZ\v\x0e\xc8t\xa7\xe2r˚\xe9U\xb1\xe6\xe8;An<V\xcdZ8\xb7@\xe7\xe4\xd3O\x1a~\xe7\xa6Y\x1c:\xf0\x82\xf8zd\xa9\xbb\xe8\xf9h\x16\xc8\xca[xrF\x8e\xf4i_\x81\x00M\xf3@\xd34\xa1\xc7H\x1fM\x87\xc4P\x9f\xeez\xcd\x10\x81\xaa/]3d\xdd\xea\xc5%\xb2\xe1\x1a\xddT5w\x96\xb7\x8c\xc0pg`\xbcguO\xedy\x15bA\xce\b\x04\x89]0\x88E\x1d\x8es\xe4\xf0y\xb7\x19\xc79\xb2\xfb\xa2[Ʊ\xd2\xc4!\x8b$݅p\xc5.?\xc6]\xc0\x97JE~DxN\x82\x05G\x88\xb3)\xd0\xd8\x12g]\xf9Я`+\xfe h=\xe7\xb3B\x00b\xfb\x81fC\x9a*|\xc0\x98\x18\xa0\xd6\xf0\xb1\x82\n\x9cc5W\x06e-(\xb7\xe3\x1f\x00\xdb\x1a\xb1\xe7@\xd7\xe3\xe6\x8c-4\x06\xd1j}\x95\x84Tu\x81\xcc\xc3\t-?\xf8\xc6\xd3^\xfa9\xb5\xc4\xf7\x87\xc0_\\,&\x83\x84?_`\\\xcc\xe2\xc0_\xc0\xa3ro\x12\xf1\xebrF3\xfe\xf3\x9f4\x88\xe5\xc7\xe5x\x96\x8a\xdfߤ!\xffu\x01\xbe\xd5\xf0[\x1cH\xb2\x8af98I$y\xc2\x1fYd\x94\xb9\xa19\xb4\xa7G\x9aUD\xf0\x9b\xb1?\xd874\xff\xe1\xf2\xc5K%l\xf9\xca*P,\x1e\x80aQE\xf0\xcd,\x8a~\xa2~\xba5\x16Q\xa0\x8a\xea\xbbd\x96f[\xe3A\xe8*\x927a\x14\x85\x19\x1d&q\xb0=.\xb5P\x1d\xcax\x96Ӈ`C\xf8\x1aDI\x9c\x8f\xb7G\x03\xd0U$\x17\x0f\xec\xdbES\xb7.\xc3ɖ\xdc\x03\x90\xf5\xe5\x7fIb\xfan4\xcah\xbe\x0e\x93\xa3\x15\xc5\xf7\x15ߍ\xb6\xa8\x9aC\x96\xaa\xce\xd60\xbe\x982r\xbaؙd~ř\xcc\xfe\xa0\xba\x93U\xb1/\x1e\x88|\xb15\xee\xb5ӥ\xa9\x02Qh\xebZ\x9agRS\x15Xbk\xfc\x1b'YS5j\xc1\a\xd4\xd6<\xff\x9a+\xc22\xdb\xd7\xd185\x1bk\x80\x12[\xe3\xbf\xf8\fb]<\x90N\x8d\x13\xba\xb6\x02\x80ބ\xbad\x04ё\xf0\x05\xb1X\x9aʐ\xa3$\x9d\xf88W/\xd9\xfai<\xf6\xc9\xe3\x01yL\xc9\xe3\xefz\x8f\xdf\xf4\x1e_\x90\xc7?\x19VM\x01\x04\x9e\xec?\x0e\xf6\x1f/*\x00\x12\x1bGb\x94+\x0e\xfcEF<\xf5\x93/\xd4\xda\xe2\xfd|0H\xe9m\bo\xd4\xc6%p\xa9\f+\xb5Jpj\x16h&\xc0\x04|\xfd\xff\xbb\x1f\xcf\xfc\x94-\xe9t\x90\x8a\xdfo\xe0\xadZ\xf8\xf1|\x9a\x86\x11K\xc1\x8c\xbf\xcfb\xca\xfeF\xf8\xfd|v3\xcbr\xd4\x03\xe84\xa7\xf8*\xb2E\x8cw\xc3<\xe1?\xdf&\xb72\xf9%\x1d\xb2\xdf\xe4\xbaԜ\xa6~\xb1\xb6\xae\uf662\x03\xd9,S\xd5\xcar:\x99\x82\x15\xa9b\x14`\xa0\xad@\xc9\xe3\xdb<\xf4\xb0\x16\xceL|\xb3\xc2-\"C\x8b\x8c\xb68\x84\x1a\x11Y\xaf=\x1c\xfb\xe9\x8b$\xa0\xcf\xf3V\x88/S\x1f\x9c\x94\xe2Ta}\xccn\"KA\xd4%Lo}\x04\xfb\x93EZ#\x85,\xac\xed\xd9ՐxD\xab\xe8y\x0e\xed1\xafM\xf2\x8c\x8cx\xdfz\xda\xd3\x0e\xecx*\x04cL\xcd\xf6zg\xfb&U\"\xba\xb22\"<ny\xe3\x17\xabM-\xb91L\xfc\x1cb\xe0g\x9am\x87\xe1\xab\xfa\xf5+\x9b\xb2\x1e\xbc\x0f\xe3\x14~J\x93\x1eQ\xbe\x02x\xd8V~}\xa7\xe5\xbdѾ.\xb4\xaf\xd7=\xe2\xc8+/$TȎm\x04ۭ\xe8\x88\xc5{]\x84\xb0\x11\xae]\x8f<A\x90\x92\xef\xafji\x01Pcj\x900&\x81I\x02\xfb;\xa8\xcb\xfe\x8e<&.\xb8\xe5\x046\xbc\xb2\xe1v\xb5g{\x98\xa0\x89\xe9\x9dh\x95\xc8\rp\x8c\x8aպ\x15\xd8\v\v\xae\x1b\xc1\x7f\x02\xb3\f\xc6T\xd1\xc0\xfe\x0e\xb2\xdf\xc0\x7f.\xe0?\xaf\xcb\xc3\x1aȘ\xd1+m\xbc\xf2\xe4B̓5:\x11\xa7S\xdd\xf1\x19\xc3ӯ\x13\u05ff\x8c\xc2(b\xcf&\xf2\x19\xdd2\x9cn`\x98\x96\x0erP\x029\xa8\x82\x1c\x96@\x0e5\x90\xac\xa6\"\xa8\xa7F8\xbf\xa7\x95\xf9\xf7\x9e\xb6T\xf9k\xd6Hꍥ4\xc1W\x16\xd2\xebK3\xd9X.\xf3:I>ͦ\x95r,yC\xd9m\xda\\\x15\xd7\xf5X\x1e\xd0\x0e\x1d[\xfd\x8a\\D5\xf1{\xb5w\xa8\x8b\xb7\xd8kI{\x15\xd8l\xe7\xd82\xaf5\x1d\xe4\xf9\xb6\xe8\x9aQ\f\xb6BQ\xed*CȷL:ʯ\xb6G\xb9\x0eͰWZ\x19[\xf5z\x0e\xf7\xf5\f\xb6\xaa\x95\xcd\xce\x16\xa7\x86\xfa\x80\x19\xc3B\xb7\u0092m\xc0\xf2݃\xdb\xc27\xd7:\x9aW\x9f\x8b\x86\t\xe1\xfb{\xe2vu\x8c\x1f\xb7\xc7x\x80\x8f\xac\x8b\x11\b\xfcŻ\x11\x93̥F\xbe~\bJ6\xda\xda\xe6_\xc76yp\x979\xef\x80N\xa0\xa3z\xf3pT\u008e\xa0#\x9a\xaeG\xa4\xd2\xfd\xdc\x03\xc2?#\xc6\xf7o\f\xd2#\xc6\xf37\x86\x86\xea\xe2\xc1m\xba\xa8\xa7\xd3\x0f\x0fB\xc4\a1\x9b\xc5\xcd\xe3x\xb7\xb9\x97U{\xd4??\xa7\x19\x93dM3\xe6[\xccz>\xe3\x7f\xdc\x00\xaa\b\x87Ń\xe9^X\xcd`29\x8e\xdeʟ\xb6\xc7wX\x87\x8f\x1e\xea\xf8\xfeo\xaf(\x94\xc4<\xe8\x80\xf1\xd8X[\x8d\xf1\xd8X\xb7m\x04}/Ֆ\x1e-럔~\x92\v\x8d\\N\xea@\xe4B\xa1e\xbe)\x96\x04)\xf6\xab\x00\x8a(W\xb2^'\xf0\x8c\b\x90D\xcan-\xff\xa5\xa8\x946e|Wʀ\t\xd8=\x94b\xb31\xefu\xb9\x91\x8a,\x92\x12\xa8ڍ\xb73\xd8\xfaI\xb9RB\x81RC\xca\n-\xf3\xf9\xe4\xfb\x89\x9c\xfaZ΅Z鼖@h\xfe.X\xbd\x92\x0f\f.\xf9[\xcb\aN\x93\x9c\xaa\xe5\b>\xacc\x99\x18{\x89*\xd4\xfe\x7f\xff\x9c=\xf99h\xef\x97a\xfc\xc9\xf7\x13E=\xc2\xdd-g\xb0b\a3\x9d\xf4\x88\xbb#\xce\xc7\xf5Ij\xcf\xf2\xe1\xe7\xefq\xf3t!\x7f\x13\xe5\xa4@1\xdd\xf7\xb5C\x1cV[͖\x83@\x96\xfd\x01\xca\xfa9\xad\xdc֏\x80\u00adY^l?Wd\x14\xc6~\x14\xd57ूdUy\x1fw\xe8G\x8c\\\xaa\xb4\x92=\x7f\xd8^\xf2\xc1$\xe0\xdb.l\x05C/pVz\x8d\xa0\xbb\xbbl\xa3\xf5\xe1\xb3{\u07b4\xd9bM\x10\t\xdb\xee\xa8X֫,!^\r/\xb5\x8c\xc7?u\x1eO:\x8f\x83Ka\x9f\xb2\x1f\xbf\xfe\xbfF\x1d\xeb\x85Y\xc2\x1b\\\x18\xd1\xec<yu\xf1\x8e7\xf1Y\xb5ҷ~\x1e\xdeRҫ\xe6\xa8GF%\xf0M\xa3X\xda\r\xa3\x0e\xa9\x8d\t\xa7\n;FfS\xe0\x19n\xbf\xa1!\x9c\xe0\xabu\rP\xa8^\x01\xd1F@*\a(\x8f\x14p\xbcr|\xebG\x8a\xe3\x972\x15y\x03\x95\x89\xc5\\BFQ\x92\xa4\b\xc9\x1e\xe4\x05\xaf\x02\xf8\x83\rV\x9c\xa0\x00\xc2\"\t\x1ee\b\x9cb{\x8f\xc7\x1f\xf8!\xcfB\x84o\tC/\x8a5\xa3.5S \xd3\xcefVf\xb5\xff\x19\xf1J)v\xea\xc77\xb4\x06\x92\v\xb2\x124\b\x95J\x89\t.\x11\xbf-m\x8f\xe9!\x10\xe0\x98\x1e\xd6\x12\xe07\xa0m\x13\xea\x06\xdaj\xa7p+\xb3\xda\x7f\x95\xb6,\xa5\x89Re\xdar\xe8Zڎٕ\xee\xed(\v\xf3.\xe7\xc7hēM\xd7O\xd6\xf0-i\xf1\xa8]\xcdX\xd4\f\xc6\xc11=\"\x1d\x89\x1aHZ\xfc~\x82ٿ\xd7(5\xe2n\x18&\xe5\x18we\x96\t\xa9\x0e\x11|ד\xbb<<\bY;8\x81\xbfx\xd0\xd80x\x95\xd8\xee\xd3\x13\xa7\xb0P\x06\xfeB7\x13\xf2N\xc9\x04\xb0\xe7\b~d\x1b\xc3\"A۩K\xaa,\x1e2.\x88B\xc7G\xda\x02\xf6\x01\x83\xc0\x8bv\x88[\x1d\ay\x98\"?k\xe9Z\x1e\x04\x80k\x1a\x03\xb6\xd7\"^}ۀ\xf0\v\x96/\xca,\x04quj)\f\x88\xb9\xa4\xc3\nvH\xabq*1\x90\xba,!c\xc8>9=>\xa4\xda\xf3\xb1僤\xaa\x17*lL\n\xe7V\xceh\xfe\xc2Γ\xd7\xf0\xc6\xf9\v?\x93G\xe8!\xf1\xc8\t鈻\x94\xecb\x0fcŢ\xcfW\x81\xbf\xb8ޚY\t\xeb\xb1N\x7f\x06a61\x8aB%\xdcI\x936\xb4\xff19Yc\xb6\xde\xc0\x97\x9b8\xb3Vb\x9c\x98\xf5\xc8K\xc7\x15\xfeB\xe9]\xc1\x10%C@=oT\xadF\x88\xbf\r\x14X\x14\xfd&\xfb\xe4\x84\xd3e\x81\xf7NC\xb3|CD\x8e\ri\x13#3\xae\xf1\xca\x11\x1b\x99\x82\xd5\xeb\x00\xf9\xf4\x90\xc0\xdaܨ\x14`\xcdD\xf4\xff\x0e4Q\xce\x1ct\xe1pG\xe9'U\xe9@\x8bN\x19 \xab@T\xa5\x02\u0095U\x18\x06[+E\x00^\x8a\x91:\x83\x92\xb6\x8a\x83\xe8\xddz.5M\xa4~U\xf4\xbae\xe9\xcd5\xe1-\xc57[\x12J\v\xc4g\tp\xc5W\xa8\xa4\xea\x88\x03j-\xa1F\xd1A\xb8\xb2\x9e\x83\xb0\xb5\xf4\x97\x02\xfa\xb7\xa3(\xeb\x03\x04\xea\xf8uT]\xb3\x1a\x7f\x16mu\x87\xb5\x95Y&CV\x9a\x83\xf5\xc4*\xd3\x16!5Ҫ{L|\xd7\xf6\"\xa7S\xe6[\xe0\xd2\x03\x8b\x1c\xc1\x7f\\\xfc\xef\x01=\xb4`\xad\x82_G\x16y\n\xffqOῠ~Y\xc4u\xf0\xa3\xeb\xe2\xd7\xe1A\x17\xfe\xe0\xa2f\x11\xf7\xa4\x8b\x99\xc7\xce!\x03:zڥ\xc7\x169999\x86\xbf\a\xee\xd1\xc11=&\u05f5mBK\xcf\x1b\x9a\x8f\x93\x805\xed\xaa\xb4㰈\xcb\xe2엓\x8f\xea\x93݆\xf4\x03GOg:w\x05\xbbH>j\x80nH/c\a\x95\xb1\x82\x9b%\x1e\xd4%\x1e\xd7\x16\xefꩨ\x12\xb85i%8\x90eՎ\xa1\xb9\xb2!\xb5\xd4&\xe0%\x04]7h\xdfȳ?u\xd0\xc4I\xed\xe3\x9f\fS\x9d\x0e\xa5\xb9\x90\xa7,\xc2\xc1J\xafX\x96\xfejm\xe9\xa0,\xa2\x1a\xb0\f\xc8\xe3`3\"\xaeM<\xe2\xc1\xa6\x9a\x90\xf9[\"\x03\xcdgwW|m\x85\xfa\x15y<\u074cZ\xdd\xd94!\xea=~\xb3\x05崝l=*p\x83ڈH77\xd4#\xb2\x1f\xbfަEe\x7f\xd9U3\xf3\x85\xb1\\\xa51\xc1\x8e0\xa5\xa5\xfa\x0e\x94\xb8\x94xz\xd67\xfayJ\x85\xa9MUK\xaf\xc8)\xb1X\xc9>\xd1yN\xe3\xdc\"\x93rߪ\xed\xb6\x83d⇢\x88\xe6<\x85P\xdf\xd2\x1c\xd6\x06Ӵ\xf3p\xf8)kM\xcc*\xcc\x05\x87){\x99W\x1fA_מ\xd6V\xf4\xe3]n&\xadYk\xd3D(pj\x94\x92\xbd\x99\x9aڵ\xc42\t\xaf\xc8\x04\xee\x97\xcc\xf2\xa1E&\xf2\x01\xf4\x95\xd9T\xe1:\xa9$̩\x9f/\x998\x86-\xa4\x93\xeeo\xbd\x0e\xd9vBJ:ܯ\x17&\x1c\xa7\xbf=NU\\=\xac\x92\xed\xa4\x96\xeeY\xbf\x16\xdfV«\xec\x0f\xbf\x06\xe3V2\xac\xecѾ\x06\xdfV\xa2\xac\xd6\xfb\xbfY\x9aI\x96\xddFD\x15\xfc]\x15P\xc5d\xfb=\xc5\xd3\x0f\x97/\xb6\x91PH\xd4uB\xaa|J\xf6\xab\x04U\xd1u\xab\x81\xb8\xa2\x15+\xb3e\xf6\xbf\xf8\xeb\xdf\x7f\xc0?\x9a\xa6I\xfaa\xea\xdfP{\x9cO\xa2ߥ\x0e\xc7u\x9c\xe3\xe3\xc3/\x1c\xf6\xaf\xfc\xd7=t\x8e\xbep\x8f\xbaǇ\aG\a'G\a_8n\xb7{r\xf2\x05q\xfe\b\x02\xcc \x82\xeb\x17ί\xae\xabܹ\xff%\xff\xce\x1e\xbd|\xf7\xe2\xf2\xa7\xef\xbf&0\xfc\xe7;g\xe2\x0f\xf5\x83\xf3\x9d\xb3\t\xcd}\x02^\xd7\x19\xcd=c\x96\x8f:\xa7\xc6\xf9\xceY\x1e\xe6\x11=_.\x89}\x91\xfb\xf9,#\xab\x15\x81\xafKH'\xab\xd5\xd9>\x83\xd89\xcb\xf2ED1\xb8\xb7g\xe4t\x9e\xef\x0f\xb3\xcc8\xdf\x19$\xc1\x82,\xc9\xc4Oo¸G\x9c>\x99\xfaA\x80\xafH8}2\xf0\x87\x9fnRxf\xacG\xbe\x1c\x1d\xc1\xff\xfad\x98DI\xda#_\x1e\x1c\x1c\xf4\xc9(\x89\xf3\xceȟ\x84ѢG\x8c\xefhtK\xf3p蓷t\x06\xce\xfb2\xc1\"\xcf\xd3Џ,\x92\xf9q\xd6\xc9h\x1a\x8e\xfad\xb5c#\xdbc\x03杻0\x80;&ǎ3\x9d\xf7e\x93ܮ3\x9d\x13\x7f\x96'Z\xeb\x0e\x10Ho\xe0h\xd4'\x83$\rh\xdaɓi\x8f\x1cN\xe7$K\xa20 _\x0e\x9e\x1e\xfa\x87\xa7P\xe5\xd8U\xfbK\x1c\xe2\"&\xecH\x16\xfeB{\xa4{*\x13\xee(\\^\xee\x91\x18\xc4n\x04ŧ\x9bJ\xbbǐ\x00\xa2\xbd3\xe6\xa5]\xfb\x10;\x9b\xd2\x7f\xcdh\x96w\u0080,%\x19\x9f>}\xaa\x97\xefB\xf9\xd5\xce\xd9>\x0e\xd9\xf9\xce\xd9>\xe7\x01\x18\xab\xf3\x9d\xb3 \xbc%\xc3\xc8\xcf2\xcf@\xea\x19\xe7;\x84\x9c\x8dݵl0v\x01j\xb9$\xe1\x88\xc4\xf4_\xc4~C\xb3̿\xa1\n\xcc\x14\x11\x88t(\x84)4\x0e\xc8j\xc5\n߅\xf9\x98\xd8\xefY7^\xbd\xc4R\xa2-E\xe7\x8cs\x0eA^\xbd\xeca;*\xd8\xce\xf6\x83\xf0\x16\xba\xc6\xfb\xb4ϸ\xfd\xffu\xf9\x9f\xfd~\xb2\x7f\v\xf9\uf738\xee\x17n\xf7\xf0\xf0\xe8\xc49::<\xfa\xc2q\xdd\xc3ヿ\xe4\xff\x1f\xf1o\xb9\xdc\x7f\xb2C\b*\xaap\x0fn\xe0gL\x13\xd8!$\x8c\x87\xd1,\xa0=\x92\xd2(\xf1\x03\x96\xfcd\x7f\xb5\xdaY.I@GaL\x89\x81\x82\xde \xab\xd5\xd7\xc8Id0\v#\x10\x958\x01\xbfgO\xc5\xdao\xfd\tLme\"*\xd2\x04\xc4\fMIU\xa8\xbcHfq\x0e2\x85nF\xcdD\r\x9f\xdf\xcb%A\xf3-\xb1y\xa3P\x90\xd4\xd49L\x02J&\xf0*\x0eV\fU\x1f\x9cK\x81\x03\xdbkP\xab\xc9j\xf5<\x17\x02\xa5Gd/\x96K^\x01\xab\xff\x80\xa1\x90\xc5\xe1F\x14\xab\x191OSz~\x06\x15\x9eKф_g\xfb\x90#\x8aJ\xb1Wt\x85'-\x97\xf2\xae\t1\x94\x111\x10\xd9\xe7\xca0:\x0fs\x1a\xfc\xb9\xf3\xff\xe8\xf0\xa02\xff\x0f\x0e\xff\x9a\xff\xff\xcb\xe6?r\xd2\x03\xa68+\xc0&\vND\x9cO\xf30\xe7\x13Gh\x0f|N\xf2\xa9vO\xf2\xe4\x03\xb4DN\xbab\xa6\xb0y\xf7n\x96Og\xf9\xda9\xaf\xccv\x06]L\xdf\xcd\xf3\xf4\x0f\x9a\x99\x7f̿(\xbc\xa5\xbc\xc9\x1f\xb3?g\xfe\x1f\x1c\x1e\xca\xfd_\xd7=8\x81\xf9\x7fr\xe4\xfe5\xff\xff\x88\x7f\xfb\xfb\xe4U\f\v)\r\xc8`A\xf21%\xfetJ\xc28OH\x98g\xe4\xbb\xcb7\xaf\t\x98\a2r7\xa61Igq\fKp\x18\x93\x80\x0ef7d\x92\x04tg\x7f\x9fͽK>\r^b\x16\x8d\xfd\x01<\x82J.9V\xb8lK3\xe2\x93\t\xd7\xf6\x93[\x9a\x12\x9f\xfc\x93\x0e.\x92\xe1'\x9a\x03\"zK\xd3\x05\xfa\xc5a\x03\xc4\xcc\xcaH\x92\x12?\xcbh\x9e\xc1\x864\xbe\xa16\xb9\x80\x9dJ6\xa6\x90槔\xcb)\x1a\x00\x9a0&\xd3\xc8\x1fR\xd6\xec|L\x17\b\x02\x1dL\xe2h\xc1q\xc0Ki\xf9\x98\xa6wa\xc6\xf2\xee\xc6ID\xb1\xc3$\xcc\x00\x8f\xc0\xc9z\xc13\x88\x1fe\x89̒U0\xd2e$\xa5\xc0T\xb0\xae\xeeH\x93<i\xa9\x8eT\xdf?\xbf\x84\x9b\xb0{\xfb\x1fn\x928H\"\xff\x03L\xc4\x0f\f\xe3^န\xf2\xc75\x8f\x1c\xa7\xaf8\x8agy\x110\xab\xfcd/``\x84i\x95#\xb7Ea̼>\x92!\x06\x14\x00C\xeb\xd7\x11\x85\x9f\xd9W\x8bK\xff\x06\x94\xa9\xd6\x1e\x80홺wx\x9cܩ^\xc8f9\xfe\x88\x16[/\x14\xc1\xcb\xf1e\b\xa8T\xbe\x92\x1b\x86zP=\xb5i\xc4c\xc0W\xa1\x1e\xf5\x89\a\xfe\x85<;\xa5\x11\xdcX\x13\xbfu?.\xb0\xb7\xefe\x92)\xf6\x00\x92\x15\x1b\xa7tT\xae\x16\xfe\x95\xc3\xc4ׅ\xfc\x85\xe6Ay\xde<\xc4e\xa7\x14\xb9\xab\xb5ߺz\xb6{m\xcaad\xf4\xf7~\x0eڻ\xcf\xf6-\xb2\xf77w\xcf,\x80\x01\xf6o\x90\xbcW\x8a-'\x11\x13\x8f\xd5\xd5&-\xac\a#9\xbe\x1b\xb5\xf6\x9e\xed\xe1\xbd1\x87<#{\xbb{\xa4G0\xa5M\xf6\xcaU\xef\x916\x8cV\xf9\xe6\xfb\xaa\xc4(\xc3$\x8e!\xacp\x99G\xd0\xe3\x9d;\xe1\x03 s\x81\x1f&\x11\xf1<\xb27\xce\xf3i\xd6ۃF\xdce\xf0\xa3\a?z{:\xb3\xdce\x9cW\xe4\xc4n1\xb4m\xb2\xb7\xbf\x0f\r\x94\xd8\xc7\xc0\xcbm\x9c\x0f\nI\xee2;\x89\x93)U\xa3ӓV]8h\x98\vu\x03\xbb\xbf/\xa5Ν\xaf\xcc\xc8\n\xa0l\t#_\v\x0e\xae\xcc~\x05L\x0f\x8eX\xc7&\x95\xa9Jԧ^y\x9f\x84\xe0S\xbbE\xeb\xe6\xc3$\xbb!\x1e\xf9\xfbŻ\xb7\xfc.\x06\xf8(\xe4\xbeY\x9d\x17\x93\xec\xc6f<_G\a]\x1e\x94\xda_\x170qK\x9a\xac\x1a\xbb8\x8c\x92\x8c\xae\x1d7.\xbf\xc4\x11a\xf1\x8f\xbb4'\xb3\xbc\xc5\xd9\xd3bd-U.H\x8d\x8es\x930n\xb1\x84'\xa4k\x01\xf9\x1d\xb32\x00\x9c\xfb\x81^wa\x1c$w\xb6dM\xb5urR\x88Rx\xd2\xf1\x9fl\x1f\x9a$q\x98'\xe9\xef\xba\x01ܠ\xffuO\x8e+\xf6\x9f\xe3ã\xbf\xf4\xbf?w\xff\u05f8\xd1c\xb6^e\xa3\x87kwJ#\xcf(\xd6^\xa3l\xf3\xc7e\xcd3\x96K\xa6\xc4\x11#\x85cϱ\x7f\a\x13\xd8F\x88\xd5\xcah>1 \x04\xa3\xbf\xe4|\xb6\n[8Z\xe9\x1d\xf6\xe7\xe41\xbfQ\x16%~\xde#\x11\x1d\xe5\"\xe0`\x16\xb27|S\x1a\xe1\xc5'\x96\xce-\xff\x87\x0e/(\f\xe7\ax\x14\xc0p\xa9\a\r\xe20A9^P\xce\x15\nxŢ\xbe\xc3D%o\xfb\xf8\xa0\xd4\xfc\x0eo\xbfCܣ\xe9|\x1b\x14\xb6?\x0f3\xb2,\xf5\xcb\x1fdI4\x13\xfe\xe0\xbft\xf8sV\xae#\x16#<\x98\xe0\xbfY\xc4S\xf9)\xa9\xa0\xd6\x15Q?\xe5\xb5\xe0\xef\x1e\x94\x1a\x97\xdb2\xf4\xe3[\x7fSkԪaL\xf8\x87z\xd4Pݥ\xdf\xf9)\xec3\xa4\xb1\xe0[\xa6䐌\xa6\xb0gȐ\a5\xa3\xdff\xf3\xdeٸ{\xfe\x86N\x92tq\xb6?\ue797\xcc\x03\xd8%\x83\xc0*ۙFI\xee\x19\x13:\xb1\x9fGQ2T\xcc\x05/fiJ\xe3<Z\x10?\xc2e\x12\xf7M9̈́\x05\x81\xb7f\x1b\xdc\xdfQ\x7f\xca\x1euΔ\x1a^\x87\xb7\x94$,\xf93\x90^,Td_A\xd3\xc8P6:\xf7?ј\x8c\xd2dB\xb2E\x96\xd3\xc9g\xd4\xf0\x06{\x9eY\xf0\xfb\x9b\x94R\xb5>\x9eG\xfc8 \x98\xb7\x1e?p\x96q.Oe؟l\xe2G\xd1\xf9\xdb$\xa7=r9\x0e3\xb9\xe9\xc2}\x9b\x7f\xeb\x87\x11\xec+\xf5-\xa8}\xb6\xcf\xca\xed\x9ce\xc34\x9c\xe6\xaa\x04\xf9\xe8\xdf\xfa,\xd5 Y:T\xa5\xd0d6\xb7?r\xd9s\xb6π\x1e\x8a#8\xb0o\xbb\xbf\x1a\x8b&\x0f\x7f-2\xb1\xacW\xf14\xac\xff\xbf\x9b\xf1g\xf3\xfa\x7fxt\xd0-\xaf\xff\a\xee_\xe7?\x7f\xc8?\xd8k\xbcx\xf7\xc3\xdbK\xe2\x11X\xf7\xfa\xb0sr\x8f\x1c\".\xba&#\x9c\xff;\x00\xf8\xea\xed\xe5\xd7\xef\xff\xf1\xfc5\xf1\xf8ʲ\xa3\xbcR\xeeO\xc7\x174\ri֢̤PrF\xe2\xa9`8x\x9e\xe7i8\x98崵'e˞ig\xd3(\xcc[{\x16l\x8eW\n\xee0\x0e\xf3Џ\xc2_\xe8\xb7PK\xa6YR8\xdaf\xb3\xc6\v\x905̰\x81\xf2lϔe\xb1\xd1\xea\xe3\f\x908\xf5#\x9a\xcb;\xd7\xefŬ|\x01\xe7\xe6\xf6\xf7,\xb3\xa5\xbe\x9fW\xb1x\x88&5\x1a=X\xbb\x89'!u\x8b\ad\xe3*\xaft\x89\xbd\xfa\xcf{\xd5\xda\v\xc2[Մ\x00\xd0v\xa6\x11\x16E\xec\x9eE\xf6 O\x85\xa5\x11\x84\x97\xa4q\xc0b\xc2Cv\xc9\xd6\xc3W\xf5\xadkg\xf0M\xf5\xb3\xdc5-`\x00\xa56d\xc8J\xca\xd0\xc8\f╘\xad\xce\x02\xf5\x91\xbff\xf8\x11\x9er(\x06\xe2\xe3Ǫ\xf5\x89\xd5ğ\f\x8d\xfd\t\xed\x91\xec\xea\xe3\xc7\xeb\x95\xd9\x14\xf1\xfb\xa6\xcc\x1bȖ-\x1d-\x1f\xda\x1e\xa7\x8e\xa5er\x95\x8bF6\xbb\xf7\xf2O\xf8\xd6A\x84.*a\xbe\xc3\x04\xab\xb4\x11\x8e\x03\x9aҴG\xf6\xfc\x94\xfa{zn\x96\xa7\xc9'\xdaÍ\xb6U\xd3\xe7\x9e\xde\vFP\xfb\x9bpN\x83\x97\xb3\xd4g\x01\t0\xd1\x12\xb3ª\xb1\x16\x80\xa9\xf6\x15\xbfhԓ2ª\xc0M\xfc\xf9K?\xf71v}\xd6cRǪ\xc5\xf6\x95\x9f\xd1^\xad\xb1\x91\xec\xa3\xe4\xd1J\xad\xccb\x9c\xd4g\x95Y\x8bmtxQM\x1f7\xb6\x18r9\xd3\xf9/\x9d\xd3\x16|\x0eV\x87\xda~\x0e\xf3\xed\xa7҈#W\xf6ȍ\xde'\xf0\xeedޓ\xbd\x02\xcb7\xe1<\x9f\xa54\xb3Y\xc4\x18\xee\x1e\xfb_o\xbe\xba\xb4\xea\x99\b\x9aR\xdbKl\xa4\xcd\xf8@\xeb$\x8a6\x90+0_\xd4H\x95\x19\xcd_\x95\xaf\x85\xe96\x9a\x8c\xc6\x01w\xa5i)\x16j\xae*|\xf0\xa7ចQ&\x14'ޔ\xfe\xabΌ\x05\xe2\x1d\xe9\x9bf\xf4\xef\x19\x83\xb3S\x9aM\x938\xa3\x97t\x9e\x97,;\xf5\x02\x95uf\xad\rY\x13\xe9\xc4S\xfa\xdfo\x86{\xc9Z\xb7\\\xd5\xc3H\x19T\xb3\xb8!\xea\x1a;a\x83\x04b\f\xb7N\f\xa9\x15\x83%\x9c\x15\x01Aԯ\x85\x93\x1d\xb8\xfa\x84CL\xf3\x97I\x9e\xd3\xe0\xbf(\xde\xe2\xf3-\xf2\xa9\xa6y\xab:\vx\x96\x80SprӒ8kJb\x9e\x989~\x10\x00\xd8\x16\x05\xaa\x9cY\xb2\x1eZd\xefc\x96\xc4{\xc5mc!AJZ@m\x0f\x15E\xe0\x13\xc5\v\xf1\x9f\x84\x1aa\xef\xad]\xa4\x01\xbc\x91\xa38\xdb\x06H^\xba\xc0\xc1\xbe\xaeyX\x15\x00\xa0\x91U\r\xe5\x7f\x8bѐ\xed\x7f~\xdf:6\xe9\xffݮS\xd2\xff\x9d\xc3\xee_\xfe\xbf\x7f\x98\xfe\xff\xed\xbb\xb7/߽~\xfe\xe1\xe5\xd7\xff\xf8p\xf1\xf5\xfb\x7f|\xfd\xfe\xc3\xc5\xe5\xf3\xcb\x1f.\xf4\x13ʀ\xde~`\xe6\x97\x0f\xcc\xfc\xb2\xd7\xdf\xc1m\xc1\x8fo^\x7f\x97\xe7\xd3o\xfca\x9e\b\xadM?l\x82\xe5E\t\x91\xc2\v\x885\xc6\\Yk\xc1\x9f\x0f\xc1d\xf7#3\x95\xb4\x8c7\xd9|\x12um@ry\xf9\xbd\xf19\xa5\x0f>\xbbt8L\x93,\x19\xe5\n\x82\x9dku#\xc4Te\x9eˋ\xa9bj>\x89\xe0\x10M{\x90\xaa^B\x95\xc9\xda(\xad\xf4P_\U0002fa24\x8c\x04$Y\xabV\xb3\x1d\xfa\xf9p\\s\x1aU=\"-\xca\fR\xea\x7f\xaa\x91\x8b\xbc~]\x80\xabj\xc5,\x8d0\xe2\x8ao\x91\xa1\x1fE\xe0O\xad\x12)\xa5\xff\"^=)\x8bG\x8f\x1e\x95t\x0e\xf5\x88\xae\x88\xaa6\xc1+-\\\x98c\xcc\xcdw\x17\x97\x18u\xf3ۯ/\rq\xa3\xfd_6\x9c6\xb6\x18\xb0E\xb0yʩ\x17T\a\xe5\xf5\xea\xfe\x05[\x1eޣ\xef\xd0\xe2\xd8\xda{\x91\xc49\x8d\xf3\x0e\x98i\xf6\xac=\b\xe4\x1f\xb2ô\xfdy\xe7\xee\xee\xae\x03\xaa^g\x96F4\x06\xebd\xb0g*\xed\xc5v\xc4)\xf5\x83\x05\xcc0\xca|\x14\x1a\xcfҠUL\x9f\xf2\x83\x05X\xe5)\xf1<rX\x19@N`\x805\xab\xc7\xc2\r\x98\x1e\x950\xb1\xee\xc6AK9\x86\\i\xe3[(y`\x9d\x12e\x015\x1cc\xc2\xed\xb8\xe28\xb3:nJ&+_\xc3V\x14tֽ\x16\x1c \x03\b\x1c'\x9bec\x81?\x9d\xb2\x03\x8aV\x99\xb1T\xfek\x14yeŖ\x945[ƞp,Z~\xb3\xac #\x13\x8f8\x18N\xf3p@E\xeaxԝƲ\xda\xf0u\xfc\xaduhe\xbc\xb3iu\xc0K\xf4\x9a&Q\xf4\x9e\x1d\xf3\xaa3\x10|ms\x8b\xf0Ss\x86\xa4 \xad\xa2\xf6g\xd32K>*'\xf2\xf3\xf8\xe7Y6\x9bP\xe9'3\xf63\x81\x1e\xcd\xc5Y\x18\x0fY.w\xe9/\x97\x0f32\xa0a|\x03\xe2\xf2\xceO\x03\x1a\x90<\x11\xe8,\xfc1\xf6\xe3 \xa2)@\xe0c5e\x14S?\x1f\x93 \xa1\x19\x89\x93\x9c\xd0y\x98\xe5\xf6\xce\x03N\xbek\xc7\bIE<\x1c'\x1b?J\xa7ڼ\x93\x1cB#)\xa9<\x12Y\xb7=\xd3f\xfd\x16\xa3\xa0\x8d\x04>\x9e\xc7ڸ\xbb\xab4\x12f8\xfe\xc0\x87 [\xa2\x95\x02\xa6\xe9\x1b\xa3\xfe\xe3O\xd3\xfcծ\x03\x85\xd2\x7f\x84\xa7\xf6\xab\xff\x97\xafb\xfc)\xff\xc0\x03/\xf2\x17\x7f\xa6\xfd\xff\xf8\xf0\xf8X\xf1\xff<f\xf6\xff\xbf\xfc?\xff,\xffπ\xde\xd2(\x99\x82\x95C\x1c\xb7\xa2;h\xce\xfd\x1f3\x96*\xe0\xc1IҟNm\xf2\x15\\\xcf\x10w5\xfc\x94\x92l\x9c\xdc\xc5$\x89\xe1\x04\x98$#D\xc0\x8f\"\x11\x91\x05\xef\xcb\f\xc7%GK\x92\xc4\xc3b\x95\xe0\x8e[\xe8\xfd\xb9\xc1\x1d\x14\x904y\x84n\xeb\x0e\xda\xe8\xb0\xf9\xee\x1f_\xbf\x7f\xfd\xfc\xa7\x0f\xaf^¦\x88o\x89:\x01\xbd\xed\xf0\x19\xa48m\xeak\xa7%\x1bQ@\x8c\xfc0\xa2A\x93\x0f'͆\xfe\x94\x82\xe7m+\xabQ\x94XP\xd6V\xa6\xf8\x15\xee\xee\xdfXdoןL\xfb\xaa\xbb\xe1\x19K\x8er-\xf5\x9c\xa5\xde\xe8\xa9\x06K\xfd\xd7,\x81\xf4~\xad\xcf \f(\xbbQ\xd3b\xc3\\V\x8c8-\xea\xcfb\xbeZ\xbc\nZ\x05\x1dM]oz\xc4˖\x17\x95\x1a\x94\xebO#\x94Bv\b4.\xaa\xac\aB'\x04\xf0@\x01]\nF\xb7pd\x18\x81-\xbc/<\x18\xa4\xf7\x02{\xc1\x16\x7f\x15\xbe\x14\x85\xd7EW\\lq\xfad\x8f\xb4+k\xe4\x1e\xd4<\x8a\x92\xbb\x1e\xde/Un\x97\xa27H\xf5\x8aiz3\xf0!\x02\x17\xff?\xfb\xf4\xc8,\xee\xc3RJ\xfb\xa8\x0ew\xfc(\xbc\x89\xb9\xdfK}\xc5\xe0Z\"n\x95\x12\xf7`:\xdf\xc7\x1a\xdf$\xb1?L,\xf8\x9bdS\x7fH\xfb{:\xa9$\xe9\xe1ޤvx#F\xad\xe9\xac\x04ojxd\xefl\xdc%Hh\xcf\x10\r\x1f\x8d\x8e\a\xc7\x03\xfd\x12\xea\xa9z\x05W\xb8\xc5\x10\xa7o\x9c\x97\xfb\xc3/.\xf2\xd7lۤ\xa5'x\x1eq\xc1K\x95\xc1\xa1\x9f*/¼f\x8b\xcbd\\ҠK\xc8\xde&gf\xad\x92fofس1Ъ\x05\x1a)\xd2\x06\x92\x80'\x04\xa7\t\xebrGp\x13\xbb\xdd+\xd9\u00ad\xe7\x88\xee\x11\xc6\t\x13\xffql\xe7\xd4\x04JU}D\xa9-\xf4\xb2:\x9d\xad\xb6E\xc5(\x05O\x0f\x026\x00\xaadRQ\xb6\xc9\x1es\xde\xd8[\xe7 \xabUSE\x87\xf4RpU0\xaev\xaa\x98\xd0\xe7\xa3\xdcd\xdf\xf7\xfbƹt\u05ff\v\xa3\x88\fh\xcd\x02\xa3\xacU8ӥ\x13\x89R\xab\x14%qLSh*xh瓨^B\xfe\xe5~\xff\x97\xfb\xfdV\xee\xf7\xb3i\x00\x03ٸ\x7f\x06\xdaɽZ\xa0E\xf6\xafZ\xbbt\xa21+D6\xb59{\x8b\x1d\x9c&\xbb\xca\xe8\xa4FR\xe3\x95]\xac\xfa\n\x1e\xb3\xff\x80\xe6p\xec\xa2O\xec\xd2i\xd5-|\xe3\xd6q}-|#\xeeyd\x163\xaf\xd5J\x1d\xbfv\xafN\xa4K}\x01\xa4\xabw\x0f\x18\x1f}\x1b.\xa9S\xb3\xd3\xfe\xad)\xa5\xb5\x1b*\x12\xbf\xab\\\xb6M_%\xca?\xf5\x1e\x82:\xcf\n#\t\x9bhk\x8c+\xa4\xc1\xba\xa2\x15,,\x14\xffa\x17\x02\xa6~\x1c\x0e\x7f\xdf\xebߛ\xf6\xffG\xee\xc9!\xdb\xffwO\xba\a.\xfa\xffu\xff\xf2\xff\xff\xb3\xfd\xff\x1fz\xff\xfb{\xe0\xa4\a\\\xff\x16\xf0\xec\xd2\xf6\xc5l\x90\xf3\x880\x84{\x00\xcb\xeb\xd7\xf2\x93\xa1~\xf8\xbd\xf0p\xa4\x05s\xd8\xea^8\x8f\xf8\xa0\x14\xfbCB<0\xbcߤ\xfe\x846\x87\xadP\x9d\xe3Yk/r\x7f\xf8\x89\x9ceS?\x96\xb0a\x9c\x1b\xe7\xada\x14\x0e?\x91$&>\x19\x01V\x92'\xb8\xa2\xa35%Kf鐚g\xfbP\xf0\\\xeb \x0f\xa0\xa1\xf4Ni\abℽȡ\xed$ˋ\xbb\xff\xbca\xacq\x87\xa0oC#<#Onn\"\x8a\x9d\xc3ǭM\xe3\\k\xb2\x10\xc9\x06\xd2\xef\x1b\xfe\xc5x\x00\x1a\xa8wP\xac\x0e\xc6ye\xa0Dw\x0e\x8b\x86\xf0\xd6\xea#&FM\xa0\x1c&Q\xe4O3\x1a\x18\xca8\xcap\x04\xe5\xb1,\x8f'!rL\xb7\x1fj6r[\x8f\xf4Zb\xbe\xf7\xefH\xb6\x81\x13\xf8\xf0W\xc7|#\x1d\xb6\f\x85\xc0\xba\xf5m\x92&\xb3<\x8ci\xf6\x1b\xf5MA\xf8\xa7w\xee{?\xf5'\xcd\x1d\v\xe3Q\xa2\xccM\x84\xa69M3m\x82\x15!#p\r\xee\xdc\xfaьf\x86\x98rk\x84C\x92N\xb6\xad\x1b`\x7f\x9bZ/h\x96\xad\x13\x9f\xa5\x8a9\xf8oS7;\\ߚ\xe0\x1c\xfc\xb7\xa9[\xc4\x14ۮ\xee\xf5ӓ\xa3\xfa\xb3\xf8w\xc3͚\x1b\x1a\xd3\xd4OiP\xbaYC\xfeY\n\xfc0M\x93`\xc6D3\x00X\xaa\x1d%\xa5\xd3\x04w/\xe2h6\x98\x84q\x98婟')\xa1\x13?\x8c\xb6\xbd\xaa\x03}\x90J\xbaJN\x1a\xa9\x96y\xa0\fx\xd2\xdb1\x9d\vs\xcaE8\x88\xe4\xd3q\xb8\xa7\x9e\xa6\xb4\xfc2f\xbf\xe4\x001M\xa9=\x14\x97\x05\x8a\x9d\xbf$7\xb7\x00\x14h\xb4\x02\xc4ӿ\xa5\xd5AA\xa0X\x1eJ{\x1e\x1d\x15\x98\xb6HQ\xach(ޙ\xe3\x17y6D\x9dQT4\x9d\xe3\x19#\xe7x\x85\x8as\x14\xcfZ\xb3\xf6\xe7\xe9\xf9Y>FN\x93\x01\xb7\xf2\xf1\xf9Y\x1e`\xda?\x00\x01K\f\xce\xcf\xf6\xf3\xb4n\x05\xc4*U~\xfc\xe2\xaf\x7f\x7f\xfa\xbfi\x9a\x8c\xc2\b^\n\x19%\xbf\xd76p\xe3\xfe\xcfu\xc5\xfe\uf21d\xffv\x8f\xbb\x7f\x9d\xff\xfey\xf1_I\xe4\xc77\x9eAc\xbeY\xc38\xa0(\a\xea\xe3\xc1\x16Y\xe0\xf5ס\xff\x9a\x85\xb7\x9e\xf1c\xe7\x87\xe7\x9d\x17\xc9d\xea\xe7\xe1\x00\xf6\x88C\xe6\x1b\xe7\x19\xaf\xbe\xf6hpC\xb5\x92p\xf3\xc63nCz\a\v\x88\x02\x8cwe\xbc\x80ކC\xcab\xb5Z\xe2FX\a\xc3S{\xae\xedԠ\n(\x93\x94\xb0C(\xb0\xd5\x00\xfa\xb3|\x9c\xa450\xf5\xf7\xda\xd9=\xf6\xfd\xfd\x98\xe6A\xecۃ$\xc9ay\x9b\x0e\x83\xd8\x1e&\x93}\x99\xb0\x7f`\xbb\xb6\v\xd7\u05cb4yٝW\xc1\xc2\xe4~\x8f\x93\x10V\xd7W1\xbb\n\x82\xaa\x13\xcbd\x80\x1bWJB*\xab\xe5%\b\\e\xb5\x14+&\x93\xfd\xb8fN\xfd\x94\xc6\xf9[X敟\xb0\x92\xf2%T\xfd\xad\x1f\xd8\"\x96\x87-\x98\xf0\xafT\x8cx\xe5\x94b\xe1$\xb5+'\xfbG#\xedDfo\xf7˧\xc7G\x8eznYcU,\xd7]\xbb\xce6\xe3?\xd6\xf1k\x8f\xd9\x16\xeb2~5\x061\x80\x7f\xb6p\x1f\x00\xa1\xab4\xf0.I\x83\xce]\xeaO{̱\xb6\x03\tE\x85J\xacb\xd7\x11!\v\x8av\xe4i#\xde\xe6B\xa0w4\x95\x1a\x879\xed\xe0qp\x0f\xe0\xb0e\xe5\xf2\xb20\xbd\x05\xaf\xd7(\xccr\x05\x85zb\x19'1\xad\x94f\xa5\x98\xe1G\x1d(-\b\xf2 \x894{\xf3-M\xf3p\xe8G\xe2\xbc;O\xa6j6?5\xed\xf03zW\x86rг\xf1L\xff\xa9}4\x9dW[\xc5\x19\x82\xa0\x9e\xae\xb6+\b\xb3i\xe4/z$\x8c1\xd2\xf2 J\x86\x9f\x1e\x88]\xf2\x9b\x82Y\\\xf6S\"\aɪJu\x14\xbe\x03\xe30\bh\\a\x03\x9cܪs\xb8\x1f˸\fQD\x1c\xfb(\xeb\x97Y\x97\x85a\x80\x9fB\xd6\xf3\xa8\xcfe\xc3\x0f\xc8H?\x8ci*\x99Yͅ\xedD\x87\xed\x8b4\x13\x90[/ᄡ/Or?b\xd1\x1f9e\n\xab\x9f0\xf6\x95l+\xaa\x9az\x19N\xc2\xf8&S\xad:\x10\xe9A\xd1U\x15\x8b\xe24\r\xe3|D\x8c\xc7\x01A\xe6\xcb\fҊhL\xec\xaf\xf1\xcb\x04\xe8\x0e\vb\x8d\xad\x82\xc2>\x97\xf9_\x1a\xe5\xed\x1d\x93\xb0\xb8\xbd\xeb\xcb'\xaaѥ\xc78\xe7\x12\xe9l\xdf?W:\xd3U\xe8\xa2\xe9\xe0\xec\x03\xff\xdb\xc9\xf24\x9c\xc2\xfeN\xe1,%\xa6-\xb6T7b1\x15]K\x80\xa4@`\xa7Ŗ\x11w\x96\x15b\xb3\xedf\x1e\xac\xc1\xa1\xca\t\xe3\xbcrX\xa3w\xa7*\x17j\x8a\xa8\xbd\x82]i\xa5Sk:W\xd7\xc9B\x9a0\x9b\xa0\x12\x8e>XS\xfe\\\xdd]\xeb\xbd\\.\xc9\xc7$\x8cI\v/\x95\x11\x1b]\x84\f\xcb0\x89a\x11\x03Q\xa3ѷ\xb1\x82b3T\xe9\xb8f7\xd4J\xb0\x8dR%\xb9TE\x19u\x15e\tU\xc5V9\xee\x9ec\xf4\xc3L5\xb9\xbf\x87M:n\xf5W\xab*\xe3\x16q<\xfe\x8a\xa1\xfe\x9f\xf0O1\x1c\xfcnul\x8a\xff\xecv\x8f+\xf1\x9fݿ\xce\xff\xfe\x90\x7f\x9b7\x16\x9a\x9dI\x84\xcb\xe1\xb6!\xf5\xf2H\x7f\xa71\xc8\xcc_\xff\xfem\xff\x95\xa3\xb0\xfd\xf1\xf3\xdf\xed\x1e;\xe5\xf8?݃\x93\xee_\xf3\xff\x8f\xf8g\v\x06\xf8\xc0\"'\xd8\x01\xcd\xfd0ZN\x13|;\x98\xe9oY\x0fwo\xd2/Z\xc6w\x83m\x8e\xd3\x17\x8e\xcfݾ\xb2\xe1\xe3>\xcb\xf0?\xdb5\xfbܳ\xd5\xe9\xb3ͨ;\x9d\xf7\x95\x9dI2\xf5\x87a\xbe v\xf7(#\xecy\xb2~g\x92\xfc\xd2\xd9\x04\x93l\x84\xb8\xa3\x83Oa\xbe\x01l\xd5@\x06;\x8c}\xbc\x00\xbc\xe4\x85zN\x13(\xb1ÜN\xec\x12\xb8\xdb\f>\xff\x10\xf9\x03\x1a-\xd5\b\x83,\xbe\xa0\x12S\x90?+\x94\xfaA8\xcbz\a\xd3y_8\x05\xc3s?\xa2\x16\xfb\x88\x03\xf6\xdc\xe2\xe9!\xea\xc0\xff\xfa\x85w5\x06\x13\xac\x8e\xa12f\xf8\x94\x91\xba\xef\x8f\x13\xd8\xf4\xaf\xef\xf2\xb2\x8aRa\x88\xc6\xf6\xdb\xdd#:)\xb7n--\xe4\x104\xb1١\xd9g.\xc0؏\nEJ\xa0\xdc\xe5\x1a}\xfa]:\x11\xdf\xc0\xd2\x1d\xf8~(\x1d*C\xdf\xd4\xccSs=\xa2ހ\x8e\x92\x94.\xb9=\xb2g\xfc\xdc=\x1av\x8d\x9a\xa1öwl $\xeb\xb8Z͉\xc9\xe7\xda\x1a\x96\r\x92|ɀ\x0e\xa7\xf3>\xb7B\xc0O\x958\x1d%\x01\xa9\x03è\x0f\xecQ=g%\xf3N6\xf6\x83\xe4\xae\a\xae\xfc\xdd\xe9\\\x1b\x83c\xb3\xc2{\x1c+kR\xb7\xa8\x06\r\x14=\x1cƾ0\x8c\xa0H*\x10t\x86Q8\xed\t\xfb\xcb \x99\xaf\xed\xb5\x18,\x81\f\x8d,\xe5\x12\x05g\x8b\xb0\xa1\x15\x9c\xd9퍎\xa3/\xed3\xcc<Sm\xc5\xfc\x03\x84\x04Z6\tӒ\xa0t\x04\t\x18\x9fN\xe7$\xc0 (\x1a%\xbbf\xbfF^7UMl\xdc\"״\xe0sf\xa3}T3S4\xfea삽r\xa7\xea\xb0\xf8q\x9c\xe4h\t\xc2\xc7&A\x14/9\x0f\xba\xc5\xd8\x03Y\xaabM\xe1G\xb4\xf0\xad\x1b\xaa\x9az\x88]$\xd6\x10\x82\xb7\x02d,\x1b\x86\xe3\xf2\x9c\x00\xfa\xac\x99\f*_Vff\xf7\xa8F\x02(\x8dDBl\xe0\x8fN\xd16\x9dE\xba\xb5\xf2\xee\xc0\xd4\xe6\xcd\xc6귚\x1fZ)4\xe3,\x9b\x15\x00m\xdanѹ͕}f\x1by1\x8c\xaa6L)\x8d\x97\r\x94\xd9\xc06\xc4\xe6\x02zY\x96b|\x11\x82\xfb_r\x96<\x95\v\x1f\xb2\xc7z\xc9xj\xd6-\x9ce\x0e/\x96\xd9\xea*_(\t\xe4\x94\xfd?R\x18\xefW1\x06v]\xc9@\xee1\xcc m\x80\x86\xb34K\xd2\x1e\x97*\x9fA\x94\xbaElP\xbb\x88\xb1\x05\x17Z\xa3,\xdex\x9fMR\xa8\x03\xf3߭\x10i\xfbf\xf11\xb7\xb6.\xd0\x1b\x83\x1c_\xae\x9dƧ\xa6 \xd3g2\x0e\xafD\f\xe3\x91\xf3\xe0\x0e\x15<\xd84\t\xb6\xeag\x03\x9a~m\xcb\xf8\xb4Z|\x80\xc8sV\xcd\x1a\x03\xe9\x1f\x82\x83\xe5(\x8c\xa2\x06i\xb3\xc0\x85(#\xf8\xf6\xb1հN\x01\x12\x06\xb1\xe4\x91\f5\x91rl\xf6Y\xb2\xa2.dc\x7fJ;,\xe6\x18\xf0\xff0\r\xb3i\aΚ\xb3\xedV\xc8Ň\x9b4\f\x9a\xdb\x05\xb9E\xb3\x04}:n\xbf\xa6\x85\xddR\x03\x81\xc5yB\xe0gc?M\xfdE\xcf%nc+\xa6~>^\xd7\b\xc8/\xa8,\x9a\xb0\x9e\xe2M8%\xc1\xeb\x91ޤ\xfe\xa2\x19)\xccֵH\x01`\xa9h\f\x95M\xc9\xd6ʋ}\x13%w\\\x83\xb1\x9a\x1aĀ\xb0R\xec\t\x8abE*\xab\xd2E\xca\x16\xa7\xb8T)\xfe\xdfvM\v\xb2:\x1b\xf2\xd7d;\xeb37av\x9a2;\x9bs\xd7\xe0n\xa4n\x18\xdf\xd24\xa3\x1b\t,\xe0\x14\x1a++_Y\x82\x97i,\x05h\x85\xbazNm\x86Ӕ܌ǩ&w֥\xd7bRh\x16\xd1\x1b\x1a\a՝{\x99\xaf\xd5ݨ\xa2#\x1c:\xf0?\xb9\xe0jg\xc9b\xe5\x86\xf2䨢[v7\xa8\xba\xace\xa5\x85\xc5u*\x10\xc4\xce\xee\xfc|8\xe6\xbb?T\xa1\x85\xea\xed\xc8Z\x1b6\xcf]\xb3\x06\x1f*\xad\xec\xa9\x00|)@}\x89\u0605\x17\x16\xf4sy\xb8\xd7݀E\xb6\xad\x96@\\\vgX\x0e\xea\bT\x87\x16--\xachϩ۰\xe8u)\x03\x19\xc6c\x9a\x86yU\xa3G\x8b\x12s\x9a\xe1\x03-@U\xef\x85C\xc7\xd1H\xc1_t\x16ìˢ\x92\xe0\x13\xad\xf7\x87\x8aJ\"\xa5\xe8q#\xe4R\xa3\x91\xdd\xd5\xed,\x8ej8\xea\x964=\x15\xf0\xb0q\x84\xec \xcc\xf0i\xb7\xa25\x87U\xd0Y\xb4\x84s_\xb6yǀhlI\x91\xc3P\x10\x81'\xe1\x9ci\xd2<9\xd6(\\\xcar\x84+\xcf\xfdI\x18\xf3E\xf6\x14\xba\xb7\xd6t#\xf1Tt\xbc^UT:u\xaax-2\xbe\x19Y\x8b\xad[\x87\xec\xdf\xc8\xfe\xff;\x05\x01Zk\xffw\x8fO\xbaG\xe5\xf8\x9f\xdd\xee\x81\xf3\x97\xfd\xff\x8f\xf8\a\xfe\x80\",\xb5\x87\xe1\xcfٴ\x91\xb1\xc5d\x92\x95\f>\x9aK\x16!?\xcd3Of\xf0\x80\xbb\x86m\x98}\x9e\rn\x94\x02k\x7f\x94\xa4,,\x81\xe7Z\xec\xf2\xb9\x87\x18DP\x82\xf0L\xfch\xb7Y\x05<H\xd0\xf7~\x9a3Ы\xf0\xba\xcf\xd0^)y\xd7^M\xda\xfd\xfdr\xc5a\xeb\xb2W\xdcK\x87e\xad,\x88\xee[tVv\x11\x92\xbd\xabk\xd9\xf6OtA\xc2\x18\x9ee1!\x8bE\x8b\xffD\x17f\x9f\xe3\x83ԕŮQ\x16\xf8\x02\x9a\xe5a\x8c;-\x8bߵ[\n\x94\xd34\x99\xd24G\xbc\"O\x81\xbf\x12\xf9\xd7\x1e\xcb-\x12D\x1f\x14\xe8\x955\x8c\x92\x98\x96zR\x8d\xac\x88?3\f \x14\x8e\x16\be\xaeV\xfdp\xd4\x02\xf9\x9c\x8c\xe0B\xc7,\xa2\x8f<ϐw\xe8\x8d\xdd]\x96j\xd39\xf8\xe6f\x8cD\xc1\x81\a\x81\xfa\u0094\xb6\x8c\xe0\xc00\xfb:\x90\x1c\xffU\x11\xa7\xee&J\x06~\x84!2\xe79\xc3\xf2!OX<#\x8f\x05\xf8d\xcf\rBcl\x91c\xbd\xfd\xe1\xf5\xeb\x0f\xe0\xa3\xec\x19ogQdX?\xbc}\xf9\xf57\xaf\xde~\xfd\x92\xa7\xfe \x9bj}\xf5\xee\xdd믟\xbf\xe5\x19_%ID\xfdذ\xde\xfe\xf0櫯\xdfK$\x10tݰ..߿z\xfb-Odu\x19ֻ\xaf\xfe\xfe\xf5\x8bK\x9e\xc8\xdadX\xdf\xfc\xf0\xf6\xc5\xe5\xabwo?\xbcx\xfd\xfc\xe2\xc23\xae\xd8\xfb<D\\Q\xbc6\xfa\xc5k\x15\xd97\xca \xd0a.\xc7A\xf6\xd5\x1e\xfaQ$r=\xcf\xd3ѯ$*\xc6N\x0f\xe5\xa2p\xd4b\xbf챟\xbd\xbb\x8b\xbf\xe7 -\x01k\x9a\xdb\xf1Y\xbf\x86\xcfdۀ\xe1e\a\xc3Q\xebr1\xa5\xe2\xfb\x91\xe7)d4\x97\xf98M\xee0z\t@\xe1}\xdd\x15\x8fl9\x8brm\xa2\xa9\xfdQ\xb0\xb3\x9f\xcd\x1dZrTlb\xca\xf4\x95\x98*<\xbbh>k\xae\xb9\xcc\xee\xc2|8\x86_C?\xa3\x18\xfc\xb3\xc7\xcbH\xb6\xebc\xd6m\x12\x06\xc4\x11\x99:\vboЅ\x82O\xa3\xa4\xcf\x11\xc37\xc3m\f83\n\x14*\xabb\x15F\xcc\x18\xb3h\x80dZ\x96\xcf&\xae\xccW\xf8W\xf4S!\xfbJ\xe1H9A\xca,)\x9a\x8b\xa9\x9e6\xeb\xb1OY\x14\x0e\xa9\xf7\x1cL\x15\xca\xcc\xc4Ԃ\xe3\xfd\xf4\x06\xe3ـ\xb7e\xd6\x1a\xc5lf\xe3\xfa\xe0\x8db9\x8f[\xa6=\x01\xbd\xbe\xb5\xff\xdfW?g?\xb7\xae\x9f\b\fW\xffݺ~\xf2s\xabu\xf5\xdf\xe6\xf5\x13\xf3gs\u07fcr\xaf\x8b\xb0-?\xef\xff\xbco?yv\xf5s\xfas|}\xff\xf3\xfe\xcfOZ\xcfz\xf6=\xfb6\x9f<\xfb\xf9\xc9\xcf\xfb\xfb7\x96a(\xa1^~\xce\xda<\x89\xafN\x96!e5\xb6\x8c\xaf>\x9e\xe7\xee\xee>\u0094+\xe7\xfa\xd9\xd5u\x0f\x7f\x17\xc4\x03\xed\xb15\x8a-\xf8;\xa5)\x97Z\x1fX\xa4`o\x14\v\xa4E\xacN\x84\xf0=\x1e\xe1\xe5j\x10\xc6AK\x14\xb0\xd0W\xf4\xda\x12$\xcbd\xa38~\b\xae\x15-Х\xd4\xf2\xcdժ\x1c0\x06\xadFP\x9cKa\xfc~ͺ\x82\xbfy\xbf\xc4J\v\x90<\t\xd4∶\xd8G\xa7c\"\xf8\x95\x82\xa0Ͳ\xae\xb1\xd0\x15\xff\x10\xedC\xb8\xa29\x13\x9a\xde\xe8\xad\xc1\xdf\x1e\xb2\x06\x93o,ӑ=\xac\xf6\xa0@\x87D\x1a\xc5\xd6P\xac\r\xe1\xa8%i\xc4\xdb\x7f\xd6\xdd\xddUYY\xe6_9צ)\x18z\x1cf\xfd\xd2\x10aez\xcbxI\xabk6\x8e\x9f\xe8\xe1MV3Z\x02;\x1f.\xdel\x1c1(M'\xd3|!\x96\x01OA\xbd¶\xe1\x93D^\xa9\xc6W\x17\x1f^\xbe{{\xf9\xf5\xdb\x1f\xde|\xf8\xea\x87o\xbf\xfdI\x05\x90⑄\xf1RL\xa8\x9e\xbbBBMa\xe2\x8aD\xc3T\xfd\x90\x85\\\xc8\xd3\x19]\xb5\xccb\xcef\xb3\x01:\xbcB\x9bJ\xb1\xcb[R\xcb\x03\x1d\n\xc3!s\x81\x1aR\x90\xd5l\xfa\xf3\x9e+\xa4\tG-e\xed+\x8a\xe0\xf0\b\x8dL\xa6\xda\xd98\x1c\xe5j\x93>\xf1\xf6\xc0\x10\xdaŋ\nڌ\x90\xb5\xad\xf8ڈ\x85,$\xa8\xfd\x06\x87$3\xfb\x98hg\xb3)M\x99W/ߦ\x8bt\xd6u\xec\f\xb4\x9a\xe5\x9aK\x91QH:^\xb0H\xe0(\n\x00X\xd4D\xb9~\x9e.\x96\xbcDQ\t\xd7\x15\xe1\xc3\\a\x90\xf5\x165\x97\xabU\xa1\x16;R-.\xa8S\xa7\x1b\xb3\xba\xfd \xe0\x1dUi\f\x8f\x91\x84\xa3֣R\xfb\x14:\x9a\xcdY\x9eƯ\xe5>\xc2\xe1@\x96\xa7\xb3a\x9e\xa4\x1e\xe6I\xa5\x17>\n\xf6QZ&\xd4\x14\x9cJ\xf1\x90fP\x16\a\xb6\x18\x95\xdd\xddRBQ\xa5\xcap\xa8hp|\xd0\xc5\xca<\xc19\xc0\x00\xe4Z\xf3\xa8Y\x9d4\x15*\xe3\xd0(S\xa7_`\xc2;\xb1\xefF5\x88xN\x15\x0f\xcf0\xcc\xcf\x18ۥ\xaa\xfcx\xda\xc0Z\x88\xb7\xaa\x9d\x81\x88\xe4\xa4\x05\xc1(g\x1e\x82\x9b\xbb\xbb\xfa\xb2\xccR\xaf\x9ck\xcf3\xfe\x8647X\xa5\\Lb~\x9fU\xc5\xd6<\x81o\"\xb5\x05E \xf1\x14р\xab\xc9u\xc3<]\x15ژŪ2Y-\x82\x8e\x1e\xca~\x96%\xd2J\x90r\x8f\xa0\x82\x8aD\x01\xbbBn\x92ä赈d\xa5,\x10\xfc\xf7\x92ɻ\x1e\xfbcq\xde\xed-\v>\xee\x15?W+\x90\x9f\xe1H\xdf\xc4\x14[\xa2\xdad\x9bIz\xfc\xef\x8aF\x19-\x81\xa9\xd9+\xb3%\xb6Lf_\xfc\xb2\xe5.\xbbe(O\xd1\xc1\x9dJV\xf85ܬ0\xfb\x8dy\xea\"R\xec\xf2D\x04\xc2\xf2>\xefQ\xcb\x18J\xaca\xdc\x14r\xd5\xf0\r\xd34\x97\x05\x8b\xc0\xb5Msi\xcc2J@G\x1d\xe6\x06\xaes\x12\x17h잂\xda\xc2ab\xa9r\xc4\f\x8bFt\xf2\"O\xbf\x87\x14\x0f\x91\xdap\xff\x8eW{\x7f\x8f)\xfc˼\x928\xae\xc14\xf1\"O\xf9T\xb5\xb2<\xbdLÉ\xc7\xf8C\x81\xb3\xf34\x9c\xdc\xdfW\xd9\x18YG\xea\x8e\xff\xfds־\xff9k\xff\x8di\x90+\xcbO\xd3W\xecv#S\x85U\x94\xfcڣ\x82\x15|\xb8̥2\xfd\x99\xe0\xe3\x93\x1dD\x03\x9b\xfal\xdeê\t\x9b\x1d\x80a\x12\xf1*\xbc\xf6<\x8fa\xe1\xed\v\xc5F\xa6㮬\x97\xef\xde|=/\xc6\x15\xe5%\x7fW\x9e/\x9ex\xaf\x15\xd2\xfb\xf89L\x02\xeaa\xa9!\xc5\xfb\xb0W\x90w\xcd2yI\x8f\xff]Y\xc31\x1d~\xbaL>\xd1\xf8y\x1c|\v\x01\xd3\x02\xaaT'\a\xd1\xca\x01\x86q\x15\xfc\x02%\xc4P\xf7zXc˸\xf8\xe9\xed\xe5\xf3\x1f?|\xfd\xfe\xbda\x19\xcfc\x12Ʒ>\x9c($)\t\xa3\x88\xde\xf8\x11a\xfb\x1a|\xa8?\x9b\xd2a8\ni`\x98\xabp\x04z\xfc\xbe\x9d\xd3,gu\x985\xf8_\xbd\xfd\xc7\xf3ׯ^~x\xf1\xdd\xf3\xf7\xcf_\\~\xfd\x9eW\xc5F\x9f\xf0\x1bk\x19\xf1\x8b\xaa\xe1\x02\xb3?\xccA\x04\xae\n\xf5\x96\x0f1S\x11\xcb\xdd\\Y5S\x8aFb\xa4\x81\xb1&4x\xc1\xf5\n\u0381\f\x15@\x15\x97NMK(\x1fz\x99g\xfa'߶\xe0>\xc6\xec]][\x82\x97x\xe9&vbB\x10\x96#\x0e\b\x9a\x01\x13\x8d\x1f\x98\x02.\xdf\xc3TE\x83\xdeFưžm\xb5\xb2ԩ\x9c'\x9e$\x862\x15\xbc\xab\xeb\x02\xec[\x9a\xe74\xf5\xaaS\rFN\x96fW\xe7V}\x1cJ\x15\x15\xda\b\x94\x84\xbe^?\xfaI\x16\xc8Cm\"_\x85\xd7\xf7\xf7\xa0\xb6\xaeʥ\x04/\x14%9\x0f㟶g\x18B\xb3\xa9\x9d\x04lmcE\x1ey^ǭT\xe0\a\xc1\x1a\xdcᨵ\x11\xaf\ax\xd5ad\xc9\xfd\xda\x11\x84\x91)7!\xa5\x93䖮i\x05\n&\x9c\xd3\x1b\x1b\x83*=$>RZ\x05\x8c9\xa4,\xddr\x1f\xd02ve\xf27\xa4\x8f\x1f\x04\x1c\t[[\xb9\x14\x87\xfe\x8b\xf4\x9aFpU\xa2a\x11\x80\xdb~-\x83\x18&\x1aEٺb\xb3\x05R\x98\x9d\xb8\x8dZ]\xdb^\xd2l\xe8-oh\xde+M\x00\x8bƳ\tMᰬ\x87\xcfp\x0e\x93x\x14\xde̊\x94\x15\xee\x1aj+j\xa9\xeb\xa16\x03\xa7V\xa5z\xb9\xb7\x98\xa3H\xa6s\x9bY\x93\x80`]\xf7\xf0\xf8\xb4{\xd0=\xea\x9a\xcbJA\xbbh\xa2\xc7\xee\xae\xfe\x16\x8dY\xe1\x90\x10ICu\xc9\xfc\xf0\x81\xa1f$\xfa\xf0\xc1\\\xaa\xb8+٭\x86\xcaX\xbe\t\x8a\xd3]\x18\aɝn\xc9~\xff\xea\xc5\x7f]|\xf7\xfc\x9f\x1f\u07be\xfb\xf0\xe2ݛ\xef\x9f_V\xb4\x9d*\xc8\xfd}ci\xdd:f.K\xaf\x03\xebj\xd7j\xad\x02\x87\x8f\x04\xaaZ\x1b&\x14,\xc9\f*\xb0\x9d\x83_\xb6xO\x19\x97\xbe\x12\x16\x12S\x1ad\xc4')\x1dєbd\xeb\x04\xd6:^\xc8`\xf3\x93\x7fy*>\x96\xc3^td\x19\xec7K\x0f\xe8\xc8G\xe3\xec\x12\x0fb\xa7I\xc4\x1cƌ\xa1\x9f\x06a\xecG\x86\xc5ޥ\xed\x19\xbf\xd041\xacI\x18\xf7$}\xac\x89?W\xbe\xa6)\xc57#z\xcc(Qt\x1c7wZ}&<\x86\xfa\xb5?\x1c\x17\xda\xe5'6߯>q\xcbԧ\xeb\xfb{\xad\xccէ\xeb\x15\xb3\xac\xb1\xb63v\xf0\x96+\xf6\xc9e\x13\x7f\x16\tw\xfd\xecy\xcfh\x84+]\xbfdq(\x0f\x04f\xa3\xe6\xe0\xe7\x94?\x03\xaa\xd0\xcbT\t\xc9\x1d\xf3j\xc4\vT'\x80Fa\x94Ӵ\xe8a&\xa0\x1ee\xf2l}e\xae\x04\xe2\xfc\"\xfc\x85\xb6\xb8\xaf\x06\u058c?-\xeeS\x80)\xec\xf7\xca\xd4\xc6ۖ\x93\x05\x85\xa5\xa1\xbb\xf3\x18\x1c\xf86̼\xe0\xc0\xcehD\x87yK-o\xf2X\xff-#\xbb\xbd\xe9e\xb77\x86i\xfby\x9e\xb6XT\x16\xc3\xe2\xe4\x0e\xf2\xb1\xc8`\xed\xe09\xecÔ\xd6}\x98\f\xa0\xec\xea<l\xbf\xe7\xcf\x183\x9e\a\xa0\xfb\xfbG\r0\xe53\x00\x806M\x19H\x1cjI\xbd\x86\xb2W\x00\x8c\x1b\xe7G\xe9\xfd\xfd\xa3\xb4\xd8-\xea_\xfc\xd5\xd2\x02+\x8e]Jo\xc2,\xa7\xa9\xc0\xd6\x02\t\x90\xb6\x96\xec\xdd_\x00Y\x99\\\xd5\xc2w\xe18\x14\x8e\x8ex\xa8\xf9\xfe\xde\xc0\x00\x8d\x063\x80\xf2\xa9\x16fCpEx\xef\xc77\xb0r\xf6k\x18\xae`(\xcetH*f\x98\x0f3\xfc+r`\x1b\xc7~\x920\xcerآ\x83@ӟy6\xd9*\xc6\xe0.\u009b\xd8\xcfg)m\xb6\x9a\xf0\xdd}\xc1\xf1 \x8aD-\xec9-\x9f\x1b\x89{\xc4h\x97\xf0\xa2Q\x14]:\xb2\x17\xc9,\xce\xfbb\x1e\x94\xa7:\xefWKk:k\x94\xd0\xfdE\xad\x9cE\x95\xda\xd9)\x06V\x0f{\a\x98K~\ue5ca\x8d}\x80\xc7\xd7\x00\x01\xb2t \x9a\x99\xab\x1a\xba2<%D\x90V\xd7\xf52FV\x18)0\xf7\xd8וsmϑU\x17J\xcaBY\xbc\xe6\x8f<q\x1a$\x97\xa4E\x91\xb6\xbb\xbbx\xe4\xa1iV4j\x8eo\xa8-Ha9\"Ɉ\x93\x9cd\xe3d\x16\x05d@\t+ϨK\xfd\x00`\x8c\xb6\xa8\xb3m \x12\x99\xb0\x00Z\xb0\xf6\xf1\xedƹw`.e\xe2U\xf7ڞ\x9f\xf1\xdf\xee\xb5=\xbf\xbfW>Δ\xbe\xca\f\r[\xa7\fVGa\xb6\xbe\xe5\t\xb4>c\xa1\x05\x93\x98\xcc\t\x8bY\x87\x8f\x1epp\xf6v\xbb\xd1f\xfb\xdfՊ/\t|:\x01\xb2\x97\t\x846(\x1b\xde!\xc7SE\xf8ğֈf\xc2p\xac\x98\x93\xc3$\x8cAfN\xc2\x18\x1fD\xd4\xcb\x04\xb2L\x80\xfdZ\x99\xbc\x90?\xc7B\xfe|C\xa1@\xa5\x10\x94f9W\x930\x86\x95\xf5zU#8*\xddb\x9d\xe5J1\x17\x9e,\xb1\xc5\x05\x0f\xb6\x06ch\xd9\xec\x1ed\xcb\x14\x10\xec\x8f=7m\xbc4Ѻr\x14Y\x7f\xcd\xcb/6\x96_\xc8\xf2\xcaz`9\x12\x81=\xf1o\xe20\x9f\x05\xb4\x19Օ\xc0u\xe5\\w\x94ߖ\xfc\xedj\xe9\xd7\xe56\xb3Z\xaf\x05'0b\x94Ʌb\x99\xe2\xb3֜\x19 \x01>[\xf5b\xba\xafSV>t]\xa7rT\x05\x9d|\x9cr)~\xb5L\xb9\xe2\xb3\xd2\xea\xd0\xf5\xf5&mda\xa6\x85\xb4\xccF\x16\xe3\xbcܜ_VQ\x02}\x9f\xf4\x01\x0f\x81Z\x81)f\x19\xfb\xd3\xe7\x119Q\xdb\xf3\xb8E\x96}\xe1\xb2+>̥\xda\xda\xea2P\xacq\x1c\x04Y\xc3\\\x16\xa8a\xeb\xb42W\xd8s\x91\xfaL.r\xe8\rÞ*\xed\xc1\x7f\xfb\xb5\xc4i\xa8\xd7½mM\xedŊ\x89\x83\x80r\vaQ\xa7(r\xcce\xf1\xbbZI`.\x03{ᩨ[\x81\xbd\x80\xe1_\xad\xcc\xd2H\xdb\xe3$\xf9ĥf\x05\x13\x8d\xf3ta.\x91\x06\xf8\xdb\x1e\xc9u:\x1aYW\x90qmr\x89\xa508\x0e\x85ξ\xb3\x18\xf3\xf9\xb8|\x90\xca\a$b\x90#\x86$\xf2\x17\xc9,\x87\xb9\xca~\xb1\x86\xb6\xcc>\xffd[\x02\xac\x9e\xff6\xfbJ\xc5\x1e\x03c\x03\xb3R3\x94\xdf\xf7\xf7ʈ\x95\xe8\xe0\x8fr\x9a6\x12BE\xb8\x91\x1e\xa1\xe7\xf4\x1fȄB5\xe7G\xacB\x8b\xc1j\xd5\x1e\\\x85\xed\xf6\xb56\x94\xd5^\nK\x93\x92\xb4\xea7\x10\xbf\"\xab\x98J\xa8\xb4\xbe\xff\x00\x05K\x01\xf3\x94\xdfb\x8d\x96\xc7S\xa3\x96\x92\xbb\xbb\xab\xe5>RK\xcae\x9buE\xac\xc7C\xbcfD\xc6\xfe-%A8\x1a\xe1\x95\x1c\xa9\x81H\xed\x04\x96l\x05Y\xdb \xb7\x19\xae\xe2Jum\xa3O2J\xcbJ,\b\xa9\xa8e\x1a\xa5\xa5\xbe\xc44\x1eN\x10\xb0\xa1\"\xf7\xf4\xae\xc4\xf2\xc9d\x98\xa7NL\xd0\xc0\x8a\x1d\xa4=\x7f\x13\xc6\xf7\xf7z\x8a?\xe7\xc6\xfd\xecU\xcc\x16^|2\xa7\xa6\xe4\xeen`\xcf\xcfʩfQ\x90\x99[*%\xfd9\x96<\xaf\xd4[.)N\aD\xb2v\xda\xcf\xfa\x98\xc4?\xb0Ťf\xe5\xa9]\xa9\x98\xedX\xc0\xc8%S\xdf\xfa\x14\xd8R\xb9uc$\x15ߙW\xfaF\xbf\xccRڕ\xf8\x85\x1a۵'>W\xe2܂\xd9\xcah\x8de\xa4\xd8\x05\xdf\xdf+\xfb_s\xa9m\x99\x11|\xf5\xd9ֆO\xb0W\x05\x1cϸݡ\xf7I\x1c\xd5<\xe30\xbd\xb5\x86\x885\xfb@]\xfcB\xff\xb9OL\xa5\xa4BlK\xf6\x9f\xab詧\xbc\x9a`.5\xa4^\xcd\xfe\xb4~8[Z9nM\x95k\x84:^\xd7b\xae\x0faG\x11\xef\xe5d\x14\xc6\x01\x11\x10\xc4h\xa7+\xbd\x11U\x14\xab\xa2\xf9\xe8\x98\xe3\x19l\xe3Vn\x7f1\xfe|\x1cu{H\x99)\x10\x17\xfc\a9\xad\xa8\x83M\xa0G\x9eW<\n\xc5\x15>x4\x8e\xe5\xda74\a\x13\xde,\xa7\xec\x8d\"\xcd\nb\xb1\x97\xba\xa1\x10O\xf9'ƤE\x97\xdaWq\xdeBT\x80CX%0\x1e\xb60\x92\x98\x96\xebh\xa5\xbfCV\xdd\\\x9c\x9bR\xb0\xfc\xaaо=\x95\xf7\xd5\x06\xdd\xdf\xc3m\x13E\xe5\xf5\x94\x99q\x7f\xaf\xd5~\x7f\xdf=r\xa4\xf9\x87\xbbb܆\x19\xb7\xe2<ȼ\xb3*\x1b\xd0\xf8x\xad?\xc5\xfe&\x9c\x83i \xb3_\xc0\xe5\x19\xd5\x1a\xaa程\x1f\x1b\xffᘂk\xa1\x90'\xfcۆ\xa3\xc3<\x9dM\xdcC\xef\xca\xf8\x92\x0e\a'O\x8f\r\xcb\xf82\x18\x9e\x8eN\x1c\xf85\xe8\xfa\x87\xec\xd7\xd3\xee\xe9ɑ\x0f\xbfN\xdc\xe3\xe1\xe1S\x84\xeb\xd2ഋp\x03zx|\n\xbf|7p\x8e\x02\xf8EO\x86\x03\xca\xf0\x9d\xfa~\x80\xbf\xfc\xd3\xd3\xd3!\x96x\x1a\f\xbb\xc1\x01\xfc:>|J\a\x88\xef\xe0\xf4\xc4\xf7\x0f\fpd\xc4\xcbr-\xb3\xbe\xbd\xf0\\(\xb4\xf8\xe8\xe4\xc09\x1eA\xc9#\xf7pxr̰\x1d\x1f\x9d\"ޓ\x83Ӄ\xa7\x87\x986x:<\xc16\x9d\x1e\x0e\x8e\x8f\x8f\xe0\x97\x7f2\xf4\x8fX\x1fG\xf4\xe4\x10\xcb\xd2\xee訋\xbd\x18\x8dF']\x966\f\x02\a\xe1\x82Á\xeb\"\x96\x80\x9e\x9e\xf24z\xc8\x7f\r\x9f\xbaG.b~\xea\xc3u\x06l\xc1\xc09\xecbώN\x9d\xd3\x03\xd6G\xd79\xed\x0e\x8c\xebƞ\xb9г\xee\xa8{x\x80=;\x18v\x87G\x88\xf7\xd0?8a4>:>\xea\xb2Q\x81\xf7@O\x86XW\xf7\xe9\xd1\t\x968=\xf6\x83c\xca\xc6b0<\xc2_\x83\xd3\xe0\xe9\x11\xd2\"8\xa0\xce!\xa6\r\x87~\xd0\xf5ٯ\xd3C\x17Ge\xe8\x1euY\x1f\xfd\xe0\xe0\xb4\xeb\">\xdfu\\V۩{\xe2\x9e`\v\x0e\\\xea\"\x96\x83\xc0=u\xb1U\a]\xc7w+=C{j8|\n\xbd:\xec\x1e\x04\x87#֗\xe3\xd3c\x87\x8d\xc8\xe9\x88Q\xc6\xef\x0eN\x0e\xb0/A0\x1c\x1c\xe1\x18\x0e\x8f\xfc\x83.\x968\t\x8eN\x0fpD\x9e\x1e\x1f\f\xba\x8c\xbe\xc3\xee1\x1b\xa5çnp\x80-뎺G\x87~3\x0f\x8d\xf3\x9c\xbfW\xe6-\x8f\x9c\x83\x9e\xf1%\xf5\x8f\x1c\x18\xa5#\xa7\xdb\x03\x9e>\x18\xb9\x87\xf0\xe5\xf4\x807\x0e\x8e\xdd\x03\xc3:tዎ\xfc!\xf5\r\xeb\xd0y\n_ݧn0\x84/\xc02:<:\xa1\xa7\xf0u\nyn\xd7\r\xba\xf0\xe5\x02\x96\xa7\xdd\xc0\xa7\xf0u\x84\x90'C:\x80\xaf\xc3\x1ë\xae\xfft\x04_X_\xf7\xf8`xlX\aX\xeex\xe4;\xddC\xf8\x82\x96\x9d\x9e\f\x0f\xba\x03\xf8:\x81rNpz8\x84/\xc0\xd2=\x1d\x1c\x1d\r\r\xab\x8bX\\\xffptr\b_ǐwrz\x005t\x11\xe7Q\xd7\x0f\x86O\xe1\vp\x9e\f\x9f\x9e\xb0<\xe8\x83\x7f48\x1d\x04\xf0\x058\x87\xee0\b\\\xa3$3\xf0\xe2\xdeݘ\xd2\bFtp48\xf6q\xf4N\x8fNON\x90\x87NN\x8fF\x87\al\xa4\x8e\x8eN\x90K\xe04m\xc0\xe6\xe2\xd1\xe0\xa9\xcf8\xf6`\xe8\x1c0\xfe\x1b\x1c\xb9\ak\xc6l\x98$X\xdb\x11}\x1atGz\xd9\x02\xf3\xc9`x0`2\xe8\xe9\xe9)\xe7qw\xd0=f\x92\xe7\xf0\xe4\xf0)\xe3)\xe7\xe9hpT\xe6\xd3\xc9,\x0ec\xa8\xc4q\x86C6}\xe1\x0e\xd6\xe0\x80\x89\x83S>\xa5G#\x91{p\xe08O\xb1\xebO\x9f\x8a_\xc3\xe1h$\xe0\x84\x108u\xe0\x7f\f\xdf\xe9H`><e\x02ipp䳴\xc1\x81\xc8=\x1e8\xce\xf1\x00ˎ\x06\a\"W\xe0\x1bP\xf8\x1f\xc3\f\xedb\xbf\x86OG#\xde>^\xdbhD\x8f\xd9/\xdf\aH\xf8E)\xf4N\xf4\x88\xe5\x1e\x1f\x1f\x1f\x8b6\x0fF\f\xceq\xa0\x9f\xacG\xc7Ǣ\x97\xd0O\xe3z\xdbE\xea\xbd\x1f\a\xc9\x046\x15\xb5+U\x91\xad\x9co\x87\x13*^\x1b䞐\x01\xdb\xfa\xa99\x9e\xfaq\x7f\xef\xf2mv\x963\x94\xa8\nx]\xc7\xc1t\x80\xfd\xcaϨ\xf7\xc6\xcf\xc7\xf6(J\x92\xb4\xd5\x12\xaf\xc2*\x8f\xc2\xee\xbb\xf4\x80s\x1d\xafS\xd9堁\x02\xb0\xa5J\r\x88\x90%\xb4\xcc'\xae\xe3\xb4ݣv\xa9\x19\x8a\xeb\x800\xd9\xf2\xbd\"d\fa\xf7FS\xcf\xed\xd7\x1b+\xc4vZ\xad9\r\xfdxX\xae\xbc\xcb\xfaz\xeb)\xed\xdb\xef\x1e\xb5y\x05\xed6i\xb7\xb0\xc40ɘ\x1b\xc2\x13\x9e\xf5\xc4u\xf7\x9f\x1e;f\xbbk>q\x8f\xcaP\xfb'\x98qRIwY\x86+6\u0378\x05Z\xce{\f\xb7:>m1\x00֢w\xdb\xd6{\xb1\x02\xc3By\xe0\x94.<\xb1O\x8fV}\xc5G\xa1nX֓\x8e\xb7\x8f{N\x836ϛ\xd3ָh\xb5-S\x03\xb3Բ3d\x94\x8d\x0e\xf9/\xefР\xe3U\x19\xee\x97$\xa6,\xb3e>9v\xeaNW'I\x9c\x8f3\xef\xca\xf8;\\\xc72\xbe\x81%\xc3x\xe3\xa7\xe0\x136M\xf1\xf7°\x8c\xbf\xcfb\xfco\x04\xe9\xb3\x1bp\xe3\xa2S\xc32\xde\xc1-,\xe3mrkX\xc6K:\x14\xe2n\x16\x87p}\b\xef-\xf6\x8c\x80\x0e\xfd\x80\x1aVF\x87\t<fxz|\xe88O\x0e\x8e\x8f\xec\xee\xd1\x13ױX\xe0q0\x01\xd4X5\xa56\x1e@\xaf~\xb8|\xf1\xcd,\x8a~BC\xf4\xbe\xeb\x80\n\xfe\xc4uV+\x8b\u05f5\xa0~Z_\xd3\xfaj\xaa\xd8\v\x9cH\xa3\nR\xc7ހ\x12(\xcd\xe9{%п\x81ϖy] \xbf\xa3\xf4S\x19\xf7\xc9\x16\x88\x19\x04\f7\x18{%\xba\xc0_\x94\xb0m\xd7oD\xa4\xe09&\xe3d\xa6P\xf2\xe0\xd8q\x9e\x1co\xdd.\x14wj\xbb\xaa\xd8>\x1f\x97{\x04\xc7<\xb3\\a\xa9c\xe7\x89{\xf4\xf9\x18\xab趣\xda\x1b,\x97\xb5\xf4\xc61,\x056wK\u07bb`\xf0-\xb3mdF\x81\xb1\x82\uecf0)3\xb3\x90 \xf0\x85Ώ\xda\x19\x02\xa4V}! U\u0089\x82\xb0{\x0fsv\xb0gJ\x01Ȫ*8ԫk\xe6\x81\r\xb2\x91C\xb5\x8c\xc7\x03\xf2\x98\x1af+Ћ\xeb\x12O\xede\x9e@'K7\xd8Z?\a\xed\xde\xcfA\xdb\xec\xe1]5\x8ejH\xc3H_\xff-\xd6\x1b\xf4}\xa0~\xfa\xcd\f\xe4+sRH\xc0\x1e\x11\x80\xa1Bv\xcd\xf3\xb8\f0\x97\x05\xb4'_}G\x8cm\x84\xe6c\xd4q\xcd'\xb8\xd4sl\x05\xac#\xd3\xecL\x178\x05\xe6\x8a(*\x97aR\xa4R\x80%W\xa0\xb1^\xb7\x9c\xfa]2K\xb3js\x04GW2\x04;Ք\x88\xa20Sr\xd9\x00\t M\xf7Y\x95\xa8\x8a\xd2\xfaߊ\xa8ο3\xf5x&S\x93h\x18!\x8d\xf6U\x1a\x99Oԯ\a)\x1c\xf8RkԨv\xb0\xec\xff\xa7\x95\x8f\xdfQ\xf3\xf8=Վ\x7fO\x9d\xe3/\x85\xe3\xc1\n\xc7o\xa8m\xfc\x86\xaa\xc6\x7f\xbe\x9e\xf1\x87)\x190\x91~\xebհ\xb2\xc0\x14Y\xf5\x8bR\xfd\x1aV\xb7\xe0am\xfa\n\x8bI\xfa\xd2Z\xa7\xac\xd4i*\rk\xb6\xb6`?@\xb5\xf8}\x14\xb6ߛ\x9a\xee\xbf\x03\xe9~\x17\xadl\x9b6\xfe\xb9t\xfeӴ/\x1e\xf7\xc6\xec7eq\x19\xf5_o\xbe\xba,$\f\xbf\xef\xe2\x0f\xb2\x0f\vf\xa9\xf3\aYk\x81\x97\x830\xf1\xdcs\xa9ە\x92l\xb1\x0f\x9fm\xe3ҐW@$\xd8S\r\xeai\xdb\xf8\xaa\x06\xe8X\x03:n\x1boj\x80\x0e4\xa0\x83\xb6\xf1_%\xa03wwwq\xee\x14`v\x9e|\x13\xcei\xd0\xea\x9a:\xa4\xe7y\x12\xcc`Xd\xa1\xd5j\x13\xb1\xc0\xfc\xe6:\xdd\xc3\xffz\xf3\xed\xe5\xf7\x0f\xa2\x9a3?t\x94\x7fJ\x87J9m\xe3\xfb*\x05\x9c\xa7O\x8f\\\xf7\xb8{rr\xa2\x11LM\xaf\x1d\x04\xe7\xe4\xe0\xe4\xd0=\xed\x1ej\xa5Db\xdb\xf8\xb6\xa6\xc8\xe1\xe9Q\xa9\x16L\xa9\x1d\x1a\xa7\x84\xb9{\xf8G\fN\xfd=\xe9(I\xed\xef\xfd\x88\xe69\xd5/I+\x19e?\x02fˆ\x83g톏~(\xdd\u05fd\r֜K{\x88Kd^\xf9xo\x04?\xae\xef\uf56f\xfb{\rP9\x99b\xd8\xf8\xfb\xeb\xec6.?\xb9g/\xb6\xe7I*R\x85cLqe\a\xfc\x19\x92)wӂ\xae1\xfc\x98\xe0)͔\x0e\xc7\xcc\xc8o}\xb4x\xf3yl&\xf0\x96\vϔ\xc2\xec\xba)/\xccn*\x16\xd80\x1c\x05 \x92-\x04\x97A\xa5U\xdfeQ\t\xde\xd2>\xdb\xee5\xbb5\xf2\xd1s\xfb\x1fϚ;\xd5\xffXn\x86\xac\xb2\xe5\xee7\x97{\x82\x81Ϛ\x9a_G\x98k\xed\x18\xcfc\x7f\xb8\xb3K\x92\x83\x0fU\x03M\x853\x93\xe6\xcb\x00\xb1\xe34%\x95\xd7\xfc\x89.\xc4\xed&\xb5-\xea\xe8\xb7\xdb\x02B\xe9\x19re˼\xbf\x97Gt\xab~-L) @骃R\xb1\b\xa9ӗ\x13Bz\xaa\x95\xf8\xce+\x13\xe1I\x17\xeeh\xb2\x1e\xaf\x1f\xf7\x1at:'8\xd7f\xcb>2\x1b\xf8\xbdR\xb1\xd7e\"\xe27\xa9\xbb\x06\xa0\xed6\xb7\xa7\xdd^\xa9\x83\xcf\x1c\xe9\xa0\x1dr\xbdǯMR\x8b]Yz\xfeџWn\bbjq\xb9\x89E\xbd\xe1A{\x96\x85\xfbM\xaf\xee\xfeZ\xe0\xe7\xfe\x0f\xef_3\x97 \xfe!\\\x04\xf1$\n3\xd8\xef\xfb\xfb\xba\x1d\x8a\xf4(\x04O\xa9\x88\xe6T\x14\x11\xdfZd\x05\t\x8dW\xb9\x05(~\xd4\xc1Iy\xca>!\xb2 \xcd`\xefe\xf1\x9f=\xa5\xcc\xdfl\xff\xa3?o-gi\xd4S;g\xc1_\x88\xec\xd63>fIlX\xd9l8\xa4Yƀ\xf8\x87\x8dAD Ŵ(4\x87\xe5\xe2O%oe\xaex\xb6R\xf10\x89\xb3$\xa2v\x94ܴ\f\xcc%Q\xe2\a\xe0\xd7ʛ\x00ެj\x93L\x8d\f\x1c\xb5l\x97v\xfage\xe8\xc8\xc1\xfd\xb9\x95\xa1\xc1\\\xb3\xa0\x93\xad\xfa\xff~\xe0\xf7\xb1\xb1T\x8f\xe1\xc1\xec^\x19^x\xff\xa1?\xa0W\xfc\xbc\xbf\xd7\x16:䴖,\xac\x16*]r(F^\xf4\x8b7\xa6W\xb3\xa4\x06\x92\xc7\xe0W_qcV\x9aؗw^ٷ\x88e\x85Eָ4\x17\xd8\xfe\x8b\u0095\xaaOt\x01\xee\xcc0\xbd\x10\xa5\xcc3\xb5\vF\xe2\xee,D\xf7LR\xc2\x1e\x847\x1aί\x83\xa2\x1fPI\xc0*\t\x8aJx\x0e\xafB\xb9\xbcT\xa9@z\x94\x03\"O\x94SC\x00a\xa4-\x03\xe5\x85a\x19X\xcaB\x94\xc6u\xbf\x00\xa96r\x8aB=\xb8\x9a^\x9b\xd9\xd5\xf4ڃ_p\xaf\x00\x0e\x8b\xa5\xb9\b\x99aen%\x86\xd8K\x11\xb4F\x14\xf1\x9c:\xf5\xa9\xccal\x88\xf1\xa7v=5\xf3\x96\xe2Q\x8a\x9ez3yUg\xc4E\x06\x12\xe2B \xb0E\xf9ƛ\xae5\x0f`\x18\x85kDɐ\u009f\xc1\xb0h\x1c 4\x1c\xb9G#vA\r\xbe\xaf\xbd\xd2\xf7\xfd\xfdr\x90\xcci\x86N\xe5\xa5<\x1bs\xb87\x01\xc7\xdcSj\xe8\x89Z\xca\xf7~\x14\x81\xa3\xbb.\xcb\njܖYsY\xbc-\xd1\xe3rk\x91\xac\x11\x1d\xe5,\x83\xcd\xe99+\nL\tYg\xce\xfd=\xfc=WA\xf8\x85*\xb0B!\x83\x15U\x88\x910\x97JZ݀\xc8\xe7v\f\xb3_-n3\x1fX\x1e\x85\xdd3\xe2$\xa6\xc6J\x81c\xb4\xac\xf4z\x90\xb0{:\x83\x84\xb7QD\a*'\xaci\x8e\x9c\x1ax\xf5\xb3\xaek\x8a\xef\xaeW\xcd\xf7\x9a\xa2$\x05\xe1\xada\xf6\x1b\xaeb\x17x\f\xb3\x89\xa7\xd9\xe5\xeb\x17\xe30\nZ\xa21\x12\x9f\x1f\x04\xf8\x128\xa0\xa41M!tS\b7\x8b%q\xa8\xb9\x14\xc0E\xe5,\nG\xcb`\x97\xa0\xf4\xc1(\x8d\xdb:\xd0\xdfp<\xca\xd5\xc0ʋ\xf7\x1b\xccU#\xa3 \x13\xc3\x7f\xda\xc6tnl\xc1P\xf8x\x80\xb1m\x0f\xd4\x01\x87\x96\xf3\xdf(\xe6%W\xd4\x00|\x1e+p\x99\xa0\x00\x84qLS\b}\x85\xc8y~]/k9\xa44\xa2\x1b\x1a\xb5aޖ\x1e\x063̾\"\x18\xea\x9aQ\u0087\x82\x05I\x14\a\xe6\xb2\xcc\x06\x1bڶi\x16\x97\xdf\xf6\xdaܺ\nc\xaeVj\xfbt\xf9\x89\x97j.r?e\xbc֯\xc9\xfd:\x0e\x98yg\x12\xc6-M\xaa\n\x9cV\x93 \xedk\x82\xb4\xa8\xeb\xbcZ\x85\xb9\xac\xa6\xb1&\xd56\x965ȟ?\xa4A\xce5\xbb\xa3^B\xc8n:Tk\xef\xd4U\\\x1d/e\xb6\xd6\x15`\xb3\xb7\xa1\xd4]]\xd5؞\x86b\x05s\xf0 @\x9a\x94o\xe6\xf3\x1a\xe8ʬ\xa8\x13;+S\xbfw\xc6H*n\\\xb5\x94e\x1c)\xce\xe3\xdf\xe25\xdfmT\xafyX\xf5\xd0+e\xd5)_%\xb5i\x83\x02v\xc5\xcf\xd1\xf0Y\x9fK\x98\x83E\x9c\x18=\xed\xfeޘF~\x18\xf3\x882#0\xd7A\x1b~\x88C\tϾ\xa4\xabj\x91̭g\xa5\xad\x86v\xf0\xcf\xd0\xfaSPo\xd3\xd0ϩD^\xf2\x05\x98\x89*\xd8\x01<\xa0gG|\xfd\xf2\xb5w\xc1\xe3\xf2\xd6;\xf7=\xbd\xa1ܸﱜ\xe2\x02\xf9\x95s\xdd\xe7ǅ\xe5\x85a\x86\v\x9b\xe2x\xab\"ڟI\xdb\xfc\xb9\xd75\x97\x80\x03\x8f\x12\xef\xefg\xabB\xf7f)E\x8b\xaf\x8a\x9f\x85\x9diU\x8c\b\xf3k\xc8\xd6]\xed\xaf\xed#V_\x1d\xa6\xfb\xfb&\x1a\xf3r\xe8G\xeb\x15'\x0f\xad\x1a\xfa\x98\xfaQ\x04\xa3)3O]\x86\xc3O^AH\xc8Ix\x0f\x94\x10\xef̔8,\x8c\x88\x8c]\x86\x9f\x98\xcb,\x92\x04kW\xb0\xb2#ξZ\x8f,\xa1\x1d\x13\xedw\xfb\xbcJ\xaexc\x9c\x88\x9e\x04FD=\xf8\xcfJ\xc6\xfe\xe3\x05\x1a\xc3\x03\xe8\xdaY\x851@ͲY\xd8߷I@\xb98\xe1k\xb2Y\x8a\xc8#\xa3\x0f\t\u0094Ǻe\xca\x0eTjJ\xf8edE\xa8',d\xaa٨\xae+F\xbc\x87))\x8aī\xad\x91\t\xe1\x06\x95\x86='֨\xf2 B]\xbc\x98\\j\xe4\xd1&\x85\x05a\xca\x15b\xa2\xcc,\xf4\xa7\x04g\x97-\xdd\v\xa4\xff\x90\xe8\a\x1e*\x9a\xfd:]\x01qm\xd4(\xa4څp\x92M\x90\xfbD\xdej\xcb%B\xd8W\x1e\xb2D\xfcذ>\xfc\xb8~q\x80\xaf\x01M\xf3\xf1{\xd8r\xda\xeeVA\xb0\xea\x17\x93$\ri\xccw\x9c\x98\xa7$\xdc\xdf\x1by2\xe5k\xc64\x9c\xd3(\xfb\x9e\xa68\x81\x11TK\xba\xbf?9\x92g\x18\xc8\x1e\xa6\xb8Н\x87C\x80Ȕ\x95I\x83\xc4\xc9\xcd\xc1\x8bo\xaf\x94_HV\xbcA*s\xe1\xeb\xfe\xfe\xf0\xe1K\xa1h\x82\xdc\x14l\x88\xb6\xf6A\x04\x11\x91דE\xbe\x1a\x10J\x0fȥU\xd0\x18\x90\xab\xeeb\xe6\xa6˜8\x87\f\xab\x14\x16\x8c\xc8'<K;SO4\xeeʹ\x06\xf9\xae\xe6qq!ަ\xf3\f\xf18\x9d\xf19A̔x\x8e@\ve\xea܆\xd9\xea\xa13\xe9A\xb7\x86\x1f\xa9\xbd\x12ҳf\xec\xb4\xce\x17ҿ4\x8c\x9f18OZn[NL\x1e8\b\xbf\xd5K?\n\xb8\x84\xdd\xef\x9auC\x82\x12\xbc\xe328\xa6\xb76-t\xf2|\ai\x8b\xe8\x1fy\xda\rnT\xbfM}D\xfdY\x9e\xb0P\x96\xac\xb1\xfe\x9c\xb3\xef\xed\x8d\r\xbf[&\x0fr\xa2\xea+&\x17\x12\xad\xb2\x041\xfbP\x06'\xdc7̕JN@\xf6\xad\x9c\x1e\xcc\xe5\xe1\xc4|e\xca\xd3)E\x1c\xf8s\xed\xbb\x92\xafLx\xaf,i\xee\xef\xcb\xf4VȲ_\x95g\x0f\x1b\xa9\xfb{~\xa3+\xf5\xe3\fV'\xd9|U\x9az(<\x99\xe8^p\x0fX\x85\x87\xee\xef\x95v\xb1\xa4\xbeD\xe8\x19\xf83\x82\xd9a\xb4\xf9\xe8[F\x9b\xe3i\x1b&?N\xdfP\x828\xa6\xb1\x12\x8d\xd3\xe5\xdcm\x98q!\xf5<\x8aZ\xc6\x13\xc3\xe4\xcaO\xcb\\I\x00U`\xdd\x18%\xd1se\x14\xaf\xc4\xf2I\xa0\x8b\xda\xeb\"\"\xab(*\xdbkX\xf2\xa7\xc9\x1f\xf6\x10\xe3\xad\fuf2\x11?\x1b\x04\xe1m\x18\x80\xbf\x90\x94\xf9-m=\xe0\x93\xed&\r\x03\xf8\xf4\xeaƃ\xbdPn<s{\xe0\x90TC~MT\xad\xef\xbc!\x9f\xf25>\xb7\xfd\xa2\xad\xa6\xa9\xc5\xd6\xe0\x11\x06*\xcd[\xd5H2=\x9c\xb5\\\x89\x965\x11\x14\xb4\xf8\xa7\x9bc(4\x86O\xf8\x9c\x00\b\xc5\xd1\x10\xc8\x1b\xcdD\xf7\xf0h\f+5\x9cB\xab9\x9eByڛ\xbap\xde:\xd6¡\xf3y1\x12\x14u\xee\xa7\x065\xef\xa7\xcf<\x0f\xfe,U\x0e_\x14\xfd\x8fU\xe6d\x01\xb6\xd0x\xcd\vOự\xe0\x03\xab\xa9\xd2\x0f\xd5\n?G\xdfk\xd0\xddس\xec\xff\xa6\x8a[\x93yj+5\xce\xe2-\xe9}\x96\x06\xf7\xeb\xa5Y\x93\xc2\xf7[\x04\x87i\x96m\x9f\x15+f\xbb`1e\xe1\xf6Dg䭅[\xc3\"\xf8y\xc1d@\xc0\xea\xed\xd0T`\x15\xb2\xd4\xdcz\xfd\t\xb4_\xc3\\\xd6p|\x9eLKj\xf1\xcab\xec\xd6kT\x89Y\xcd%\x9d\x98\xd1a\x8dR\xfc\x19J&\xab\xa8Q\xcbD\x15\x9b/\xe5\xa9\x7f\a\v\x81Zza\xf6\x8b\xcco\xd30@\xc5bK\x15\xc1\x92(\v:(\xf1\x0f\x1b\xb5{\x06\xb3\xbdZ_\x92\xb6\x9f\xa7\xbf\xaf\x1d\xf4\xad\xd8FS\xc2u\r\xb8`XP\x83\xa5F\xfc\xbb\xaa\xc3\xfc\x81\xfd\x7f\a]\x98\xafp\x80geIVR\xc4/\xf0\xd4r\xa3\xc6\xcct\x86\xaa\u008c\x84}\x98\xbe\xbc@}\xf97P\x96\xb7\xf5~A\x05˾\x00\xce\x0e\x9a\xf4/\x9eݠ\x86\xd5\x16\xb1j\x953\xf6*WE\xfb\xe6>\xc2~D\xcb/\x10\x14O\xe10\xe72\x83\xb5\x84\xf0gy3\x82\xa5\fS\xb8%\x02\x8a\x02[\xbfT\x05P\xa7ZC\xc83P\x94\x15\x8b;\xa6\xc9b+\xd6t\xae\xd2\xd6\t\x10\xde7U\x8e\x04\x1fg\x19,\xaeج\xa2\x85\xf60\x99.Z\"\x84/Kb\xbf\v\x96\x14թ(\xccZ\x1e\x154EV-dy\x18\x98K\x81\x04\xb2V[2\xc4Wt\xec߆I*\xe2O~\x17ތ#\xb6\xf0\xf6\xb7\x05}\x80a\x95\xbd.ϒ\xd9\xef~Ո\x8bNb\x17\xfe\x88z\xfcuI\xe6G\xf1:\x8c)F\b\xc74\x11\xb9\x94\xb9\x00#B-I\xd1m\x99\x7f\x18\xa6\xaa\x97\xdb\x14G\xda\xf77\x03\x15\xca\n\x0e\xec\xf4fЂ\xc8h\xf0?\xc34[\xf6\xa9\xa9\xbe\x1f$\xfd\xad$\x15\xd0uE9؊\xcced7\xbb\xb6L\x92YFaǪ\xbb\xb7\x80\xa2-{+T=\xbc?\xa0\x10!b\x96yF@<ͭ9Z\x81d%\xeeo\xe4y\x1e$\xe1\a&\x9f;\xbb\xbb\xcaI@9p\xee\xeen\x8b\x9d\x13\xb3\xd1\x16\xd9\xcf\xea\x12E\x19\xd4\x0f\xb4@\xfc̯Y\xa9\x86\x97\xe4g\x83ؐ\x8e\xdb\xdfA\xacI\x1aބ\xb1\x1f\x89B\x12\x81\xea\\Y\xc5\xc5\xfdE\x15x˅\xe3\xff~\x15\x14\xcf0\xd8oqX\xb6\x92\xecv\xa5v\x8d\xc5\xef\\\x93w\x7f\xaf&!`\xbf\x92\xe2i<٪\xe4\xc3a\x9a\xd2Jy\xa0\xce݅\xfa\x9b9h\x96W\x18\xe8\xd1f\x0e\xc2i\xb4%\x13i쳻\v\xff-?Yah#gh\x1cP3`\xd3d\xda2\xfb\x8d\x03Y\xe5\x05\xcb\xe1.\xc0f?\xa0\x11\xcd)\xa9\u0080\n\xb3f\xb8\xcceupր\xaf\xd6\x0f\xccJ\xeai\x8c~\xe6R\xf9h$&\xdf\x02V\xa5F+\xc2Xٟ#\xb0ߥ\x81~\x03n\r\xd8o)\xa8\xcb\xdbO\xfbouky)\xaa\xea\xc7\xff3\xa3\xe9\x82\xf8\xb9,d\xac\xaa\x88\xecY\xb8=\xae\x1f^\xa9\xe8\xecYh\xac\xfe\xa6n\xba\xff\xd6\xd2\xd9<\xcbM;K\xd2\x1cf%sb\xf5\xc3\x18fX\xcf`[`\xc3ʓ\x88\xa6~<\xa4=\x03\xc38\x83\x8cf\fP,\xc3\x14\xc6͚\x85\x1a\xa7_]\xf7몃涌(4L\x9bj\f\xc1^\x1cc\xef\"¼\x85_\xba\x9f\xb8\x16\xc0K\xcd^\x99\x8a\xf7A\xa3xu\xfb\xe19\xf8&t:\xe6\xb2\x02\x04\xaf2\x96\xe2o\xd5q\xfcʬ\xed\x14\x17l\x17\xb8;@R\xaf\xccM\x06\x10h\xed\xb8\xd1\x1c\xa1\xd6!\x8dE|\xff]\x93\xc77\xbd\f\xc0\x1boy\xc0\\\x9e\x17\x97誹y\xfe\\\x96\x9ez\xfb\xf5\x13H(\x10\xcf\xe3\xe1X\xbd\xc4\xc4D.\x7ff\x18r\xd6<Y\xdag \x8aw\x80\xb1\xfb\xa5\xeb8\xcea\xdf\x10ye\aC\x1eY\x99\xadU\x85{fF\xd3\xfc+:JR\xdab\x05-\r`\x14\xa6Y\x8e\xee\x01\xb2\xd2$F\xf7\\\xaf\xb4\xfa\xa8\xa2T\x86\x97ׄ/\x8dy\xfc}\xad\x86\xaa\xf7\x9a(m\x14\xf1\x9b\x15\x06{\xd0\xc3S\xe2\xc1u\xcf\x15\x13\xab\xa6\x99\xcd-B\xca)\xcdY)\xd7jxx\xc3\x01\x8d<\xad\xf4\r\xcd\xf9Pe_-.\xfd\x1b|D\xd0Ȧ~l\xa0r\x82Ej\x89\xa8\xa8\xb7ϣ\xe8]>\xa6),ڙWGZvQ\xa3\x06\xda\\\xea\xeeI\xd5վ\xfcXs\xe4U`\xaeB|\bB\xa9\x18\xf4\x00[\x84\xef\x937[\xa3ꈳ1\xab\xed\a\x86\x97\x1f\xa4\xd4\xff\xb4Bg\xd5\xfa\xe6\xff:\x96\xd9J\xbb1\x97\x9b:\xb7\x8cj\x98d\x1b\x0e\xe1<\xbb]+\xa2j?\xb7\x9b\x17\xb0ϫ\xa8\"Ŋ\xfa\xb7G\xfa\xfb\x80\x7fk\xe9j\x8a\xba\x18\x9a\xcbu\xb9\xade\x96\xfbi^\xb7\x00\xceB|\xbc\x94M\t#N\x04O\x1b\x96\x0el.\xf1\x8f=M\xf1\xefK\x16a\x9e[\xc2\xf3dZ\x87;cq\x04\x92Y\xae\xae#\xa2\xc6Y\\\xa9\xd3\\Y\xdd#\a\xe9\xff0\x8d\x8c\x89a\xa6\x881\xab\xa2\x1f\x04b\x15\xa8\xf8\xce\xe9\"\xa8\xe6\xfe\x96䗒7B\xd3R\r\x92\x89\xab9I\x1c-H\x12S\xf1\xea\x04\xda\xfeV\xc5\xd4bӧn\xa9\xee\v\x06R+U\n\xb27\x16\xea\x17\xf9U\xbf\xd2k\xfd\xe9\x1f\x9e:\xab\x12C/\xb3\x9d\x1a\xfb\x1dl}_\xd2\xdc\x0f\xa3\xea\xe2\xabd>\xfc,p\xab\xebZ\xf3o\x84\xfb\x1c\xcb*\xbek\x9d1\xa4\x8b\xdd\x1c\x9d\xeb\xf4\bz\x9cr\x8b\x12\xcaE\x1dJ\xe5\x98\xcdcϝ=[\xf4\xb4X\x01\x9a_\xa3v\xe4\xf5\x80\x9b\x18\x18\xf8\xc7\b\x18y\xe5\xe1E\b\x9c\x81̳\x85\xef\x1f\x9b=~\xc6v(l\xc3(G[lB\xb3\xe2\xe2\xe4\xc58\xb9\x13wb\xe1\xb7H\xff.\f\xe4\xb5Z\xf8-\xd2\xd9K\r\"\xe7\xbd\xf2&\xd4H\xa7\xe3\xa8 \xa3\x9e\xbd\xaa\v\xb1\xc4\xdf;\x9a[\v\x99\x1b\xfcX\xfc\xfc\xc9R\xa3L\xc9\xed^\xdb\xe8\xedƃl\xda7\xda\x05說\xf4\x9bK\xea\xd1\xfb{\x9d6\xec\x0e\x8d\xe6XUЍ]\xa4\xa4v\xee\xa774\xb7\xe3$\xa008\" \xd2\x7f\xb7\xa6~>\xbe\xcfno\xeeS:\xcc\xef\x87a:\x8c\xa8\xf9\xb7}\xed\xaez\x99\x9d1\x11\x85\xe4\x8f\x1e\xe5\xaf\x12\xfdx\x7fO\xed\xc8_\xd0\xf4\xc7\"\xfb'\x99\xfd\x93\xcc\xfe\t\xb3?z\x8e\xf2\u008ep፩\x9f\xd2,\xff\x1e\x12\xfbUA\xb7险\xf2\xd3]\xbc\xb0\xf2\x8c\xd0\xc7v\xfbZ^-\xe5\xa2O\xed)s\xb5\xfe\xd1\x13n\xbfa|KӜ-\b?\x9a\n\bn\xf9\x99\xa5u\xcd3k\xe2\x19>\xbcFm\xb3\a\xbe:h\x1b\xb2\xe7\xea\xa3j\xfa\x13~\xac\x1e\xf4)\x9f\x87\x13?\xa7X\x19\x8f\xe9\x9c\xcc\xe2\xa0Un\x03O\xf8\xd1dW`\xca%=\r\xbfY\xce\xeft\xfa\x82nJM\x930\xae`\xba\xbf/\xb5U\xdd\x05\x96\x81\xfb\xe1\x99\x06\xdbg\x9bL$\n<(\xce\x7fA\x00\x00\xd4Š\xe9<Ӟ\x9fq\xaf\xf7\x1fww%\x98=?\x17\xfd\\\x96\x1a\v\xa1`x^G\xe20\xcf\x1a\xf2\x10\x97\xf9,\xec\x85m\x97끲\uedab\xd6n.\xc3v\x9bk\xff\x9d\xceJ\x80a\xcdg\x8eY\xb4\x82G\xd8F\x7f{D$\xb3\xae\x85\xb98/\"sg\xffJsv\xf1a\x9aܵd+\x85C8\xa2\xb1\xe7f\x87\xf3\x9d\xd55\xdbM\xd0\v\x0e\xbdh\xf3\xbf\x0e/\xf6\x13\x14c\xac\xa4\xac6|&\xa9\xebMiA\xc2\x12\x8bJ\x89E\xb9\xc4B/\x81\x13\xd9[\x16B\x0f\x0f\x14{\x05^\xd9+E\x1a2\x98\x02\x93\xf6:\xdc3\xf5C\xccD\xdeI\xb3'~\x88\xbb\xf9쏅\xc9,\xd3\x124\xef\x89\x1fV\x92\xc2a\xf4G\vc\xe6)\xf2\x97y9\xa8\xd2\xe7\xfe^\x14:S\x93m\x91j.\xd5d\xf6v\xd7\n\xffˍ%\xf8[>\xe2WF/d\x8e\x86\x9b?t\x8c+\xa4*\x8c4 NE\x04(\x11[\a,e\xea.*ʆ\xddhtq\x15\xec(\xe6\x02\xbb\x9f\xa0\xae\xe6\xfc\x95\x1b\xeeS\xc2\xdfA\xeb\xb1?\x16[\xfe\xc5\x17Z\x8b\x7f\xec1\x8ef_?\xb1\xaf\x9f\xac2Ӕ\xbe\xf9\xb3\x94?\xf6\xf8_P\xd8\xc7a@{eEP\xe8\x18L\xcf\\\xf7ls\x18\xcb+\xb8\xc5nE\xd5\x18\xaa\x8f@\xb1\f\fᘍ\x93\xbb\xc6\xcaq\xf8\x1a\xea\x16;\xa8uգ\"SW=d`\xf5e\x9f\x8af\xbdS]b\x99?\x19\xfe.\x92\x19\xe3V\xad\x18\xd3\"\x90*g\xcb\"x\xa3|ǎ\xf3\xa2\xd0$\xd5u\xb4̙\xd3Z\x96\xd4 \x7f\xaa\x85\xfc\xe9\xd72\xaf\xda\xd29ga\x94\x89\xaf\xd1d\xb2^\xa9e@\xaaN;\xff\x80v\x13Cd\x15mY;\xdfT\x15\x97\x95d\x92\x19\xb6\x91\x1b\xda\x00 j\v\xe0\xdbP\x0f\xa4X\x0f\x95W\v\xfda>\U000e37fc\x8d\xc2T\x1bE\xb3\xa7}\xb2z\x8b\xee麯\xd0s5\xeaZ\xbc\xe2\xf2|.\v|V\x8a\xf7\xacp\x1dR\xddm\xf4\xa69m\xbd\xa5\x8a \xaa#0\xe0\x15\xbaڦmK\x90\xe8[\x96$70\xadh\x96\xdeJ%s\x80\xab\t;\xff\xd5N\xe1\x1a\x1b\x16$\xb92{ؼ2\x97u\x03\xcc\xcfʌ\x9a\xf6\x89,\ue0c0B\xa1*E\xf8\xf6\xa6N\x8e\xb0,\xf1\x02\x9c\xa5\xed\xa7z\xf5\x86\x86\r\x87\x80 \xd4\xf4S\xc0\x06\x89\xc8\xf7\xfb\xd4T\r\x98\xe2\xacq\x83\xf1^E\xa0\x957\xfb۷\xb4\uee12\xda\xe8\xa0I\x83K\xdc#\xc1c\xef\xa5${\x98L\xa6~J_rV\xfa\x9e\xbbv\xb6\xaa\x15\x9b\xbbxc\xf1\xe5\xbb\x17?\xbc\xf9\xfa\xed\xe5\x87\xef\xdf]\xbc\xba|\xf5\xee\xed\x87\x17\xef\xde^>\x7f\xf5\xf6\xc2\xe4=\x19\xf3ŤJ\x88-='\xe0U\xf4\ufaf6\vL\xde\xd2u\xe6\xa3?\xb7\x96\x9f\x1f\rj\xfak\xc3Am\xd7\xd1\xd7hB\xab\xf6\x94\xa5\xd7\xddɫ5_hN\u009b\x8c4\xfaQI\x83\x12!\x1d\x85#\xd1B(\x16\x85\x19\xaf\x19\x7f5\x89\x9fYd\xd4ߑ\x84R\xa6*ߵ-p\xd3K\xea+\xc5\xe55\xf6\xf3Y\xeaGx\xe4*\x9e\xb8\xf1\xa4\xc7\x04\x7fA\x8b\x9b'\xd1\x1e.\xae\xac\xa3$\x88\x15띺\xad^\x1b\xf8\x02\x0e\x17\xfbE\x10\x00&\xac\xe0[\t\xcdT>\x8d\x91\x90mϐ\xee4\x06si\xbe\xf3\xf3\xe1x\x83\xe8f@j\x85,\xc5\x109\\L\xfb\xc3O7\xb8m\xae\x11Օ\xa00\xac\xa8z\xa8\xd2\xd4\bv\x92\u008fQ\xd4^3\xfd *\xa9\a\xcan\xa3Zk\xc44\x82\bYKM\xc7p\x1f\xea\x01A\x11\xd5K L^s\xb3\xbb\x12U\xa1\x14\xf5\a:\x83\xb1F<\x11b\xa5\a_\xfa\xd6i\xd5\x17\xe6\xe1lL\xa3\xdb0\xbe1\x97ڧb\xa5\xfe\xc0\x1a\xa6g\x97̴-s%\x10\x8e\x85\xb7\x01\xe3G=\xa5\xce\x1d\x81\xe1g\xc7\xc1\x11\x9a\xceqOœ\xd7\xc5-\x13\xe6t\xe0\xe3\x16\x1eS7\xaf([\x1eٲ\xe0\x1aQX\xeb\xe7\xa0d~\x9e\xa9\xf8\xd7\xcb*L\x1d̀_\xd6\xf5\xb6y\xfd\\YX\xbaW:)\xafkY\xb39\x90GP`\xf5\xc3:\xf1R\x84O(\xb9Cȣ\xf5\f\xa9\xd6Z\xa2\x15\f=\xb7\xacI\x18\xf7d\xb4\x03k\xe2\xcf{2\\\x02\xdb\xd9g\xbd\xab\"_\xe6][\x88\xab\xee\x00\a\x02d\x87Lq̮\xdc\xeb3\xaf\xf8r\xe4U~\xe1>+_\x94֠\xca\xd9\xfe\xdcSQ\xf6\xf5\xa3\x8c\r\xc4\x00\x03\x92耧7fY\xd3\fqv\xb6*ʹZ9\xb7ZΟ+\xe5X\xa0:Ns\xb0;\xaa\x91`\x14\xb7a\xe6\xaf_\xb18\x7f\x0e'\xb0\x96yՑ6\x92)j\xa2\x96\xc1@\fs#\xe74\xa3\x98\x84\xb1aIRn\x80\xf5\xe7F\xc1-8\bUZ\xb3=\xecR\x8eG\x11vcU\x85\xf7\xe7%x\xf7\xba\x88|\xb2ڢ뜝\xb7ՁĻ\xd25\x02\x88\xe7\xfc\xa6\x97\xd6r\x1ag\xf2\xc2\x1a\xff\xe0f?\xfe\xa5\xea\xdc\xdc\xf9R<M]J\xac\v\x86i\xff r\xc5c\xdaEI\xe1\xc6S~\xab\x9a\xafV\xdf\xfb\xf9\xf8\x1b\x7f\x98'\xe9BeS\x91}\x91\xa7\xc9'Z\v \x9e\x14WS\x99\x00X\xf2N\xf5\xecS+C\x04x\xa1\xa7\u05f5T\xbfRk\xea\aA\x18\xdf\xf4\x96pXl;\xae\x85\x8e\xf8=\xc7bWX1\tl\x0f=g\xc5\xd1\xf4P\xa3\xb7Fa\x14\xb1\x9f\xab\x15\xe7\xc3^\xcd\xeb\x91\xcaA\x05ڐ\xb5\x8b@Jf5\x1dR\xb9\xe8AG\x19f\x94T\x0f>\xd85\xb5p\xd4R\xf2\xb5\a\xbc9%\xe6\xbd+\xe0k\xbc\x83um-ԯ\x15\xea\x118W\n$\xdc\x12\x893\xa2\x92\xba(\xc1.\xda\xea\x87\xc3a\xfc\xf9:\x18\xa5\x13\x1b\x9fq\xac\x8b0\n\x82\x13LTe\v\x15\xc4\x1b]\xb4\x03\xa8\"\x1c\xb5\x16g\xd0V\x13\x1b\xbc\xc0\x84sh\x98\x89\xad[0\xe5\x9a{\xad\xc1\xd9\xcd\x19P\xc1\x84\xffxJ\xaa\x02Tr\x83\x83\xa3\v \x91\t\xff\xf1\x1aaVf\x1fpv\xbc\x16\xc0u\xb0\x12v\xc1\x83\xb3\x1e\x9a\xb6\xfa\x90\xd9n\x86A\xae\xeccW\x14>\x99\x80\x88\xf3\f\x7f\x96'\xc63\xc8\xec\xe9\x99p}\x1e{\xab&\xfbs\x95I\x9eA~O\xcf\xef\xebNQj-\xf7\xf7P͙c.\x17\xacSP\xbc\xb3\xa86\x98M\x9fU\t\x93^7 \xc1^7!ɓ\xe9\xaa\xe0a \x8b\x05\x04B\x1e\x06p\v\n^7ZO\xf5;\x8f\x8dk[5\xc0\xee\xfd\xbd\xba?c\v`\xc8!nC\x99}\vw\v\x9ao3\xc9\x038\xefaneM\xdbA&\x16x\xf8\x888\th\xe6\x95j\x87s_\xc3\xc4\xf5\x96\x89 \x9b\xc6P\xa3~?\x96C\xb1KC\x01\xbfCU\x11\xc4-~\xce\x04\x8e^\x8d\xba9\x9f\xaa5\xdb\xc1\x92\xab\xa9\x9f\x8f=l2\b\xad\x10\x0elyXf\xd0\\\x98;\xa4\xc0&\xa2\xc5Yjf\xafv\xfb\n2X\x04\xf0\x8a\xa2g\xea.\xb0ǶIl\x80Qn3@\xf6\xbb\x16Ti\xab\x9d\xd1\xfcy\x9e\xa7\xe1`\x96Ӗ\x01\xd8\r\x94\xf8\xe6\x1a(\x86\xda\xe0\xcb\xc4fȎz\xe3SY\xa2֕\xe4w\xbcD\xf3\xc5\x0e\xd5\\Yri]?\v\xf4P\xb9X\xb5XC[f\xcd!<F\xe0\x97V\x88\x92\x8b>d2\x9d\x03#\xf2{\U00097e9a\xa9\tEU\x98\xaaD\x94U-\x9d%(\xcf3\x92\xc1G:\x84ˊk\x9b\x8f\xd0u}P\xdax\xf5\xe9\x1a\x89!>\x1e\xa9\xb2P\xcd\xe8\xa9E4(5\xa3Wׄ\xabOׂ\x89\xe5]0\xd6\x13YA}\xb5E\x9d\xf5\x15\xf6JT\xad@)y\xb5-[\xc9\xc9\x05Wp\x15\xad\xa8\x98^E\"\x9b\xd7\xc5\xf7#Mt\x97\xb9\xd6S~\xaf\x10\xff%W\xc0$n\xae\x91!^\xfe\xbb\x06'\xcf\xf1\xf8\xdf\xd5\x03\xb5i\xfb5\x8b\xbf\xba.{;S\xaa(\"\x9ecC\xd3W\x8d\xc6ɮ\xc8I9-\xb1\xd0y\x0e2\x97e\xb7Lk\xa9)\x9e\x85\xfe(\xf4Jv\x0fz\x83Nܸ\x8a\x8d\x18\xac\xb8t\f\x8dm\x99\xf6\xbcU\xf3F\x81<\x03\xb6\xe7\xe6ʴ\x17\xcd0\vг\x00F\xb9צ.\xe8E2\x8c\xab\x18\xba\x96:\x8ef\x9f\xb7\xcc\xe6ü\xbb[Jh\xd5>\xf4\xb6x\xc4\xf4\xbb\",$/\xf6P~\xb8\x00\x9a\xafa\b\xcc\xff<\x8e\xc0\xe1\xfcU,\x81\\\x80\xfc\xa0m.\x04\xa7\xe0\xd7o\xc6\x13~J\xfdmy\xc2\xd9\xc0\x14\x0eB\xb9\x1b\xa0\xda\x02\xf2\x7f1\xff|\xe5\xaf١C\xee\xe7\xf1\xce\xc0O\xd7q\x0e\xea\x8e<\xd7k\xe6\x9f\x1b\x7f\x8a\xd1>l\xe7\xa8\xcc4\xe2V\x9b\xc0\"w\xf9\x82D<}em\xbcf]\xf3\x8c\x13\xaf\x98[\x19؇X\xf5\xd9W_\xbf\xee\\\xde\"k\x1d\xc5<Ot\x8b\xf1.\x9eaŹx\xae\x9f\ar('\xb7\xea\xb7Ӆ_\x9e\xf0p0\xfb\xac\x16{~\xe5^\xb7=\xf6:Y\xab\x8cΞ\xf87q\x98\xcf\x02*ن\x15[Y\x03?-\xaf\x95\xaa*\xbaeső=4\x95\x87\xec\xe0x\xb5@\xb5\x1a\x1cnD\xdb\xcdM}\xd2r;*\xe5\x8b+\xe0\x02\xf7\xbf\xf3\x0eI\xef\xbf\xf8j\x95\xdcC\xd1\x1e\xc8\xc1\x7f\xe41\xde\x1c\xe5\"\xf7\x05\xbfi\r\x91y?\xe7\x16\x8f\xd2ϯ\xb4\x06\xf1Y\xf5L4l\xbfR_Od\x95\xa2d\x94\xdfD\x99\xf8y\x1aν+\xd7r,\xc7\n\xecř\xf3\xac\xe3\xf6\\\xf9\xc1\x05g1\xb0\x85_ ,\xc3\xe6\x93n\xcf\x11:\xb3\xc1\xb0\xb5\x8c6\xfb\xc1\x83^X\x86\x89\xa17~Ŗm\xe3\x98l\xb9\x01U9\xb82\x16\xf5\x92\xba~\xbf\x9a\xa2\xea\xcf\xf7\xabsêA\xa2\xae^\xed\x82CV\xa2\xd4°\xd6/TN\xbbD\xeaV1>\xe6\xaa\x14\x85Rg\x93r\x98\x9e\xe6\x8a\x1a\av\xb56PI\x9f=>6M\x93<\x81m\x92\x18T\x16\xdfC쪋zcs\x19\xd7n`˗ąq\x86\xb3\xb8Y\x10\xae\xed\xe9},\xf6\xe4e)Tg\xf3\fy\x1e\xce\x0e|\xe9O\xbf5\xa6{\x10˫b\xa2\x98\xa78\xed\x16\x8e\xbf}\x1d\xeb\x95\xf8\xbc\xf6\x9a2\xc0\xfaՔ\xd7n\xafj\xa5\xf6\x12\x03n\xf7\x1cK\x0eU\xcf-o\x9au\x9c5\xfbMvDU\x91\u05c8\xfa\xacܢks\xd9Ԋ\n\xa8Ҫp\xa5\x04L/#x\xa8\x8a\xf3<\xa5\xfe\x1a\x1d\a\xb2?O\xc9\x01\x8d\xf37\xd92\x95m\ue6ba\xfc\x9f\xa6 \xff\x89\xba\xf1\xa6\x13\x97?a\xfb\xf9oG\xa2j\xf8\xb3Z\xaa\x14\xe1\x9a\xd6i?\x13\x9a\x8f\x93@W5\f\xb6\xfa\x19=\x83]\xa16\x94\x05\xb7\x16\xab\xba\xec\xd6\xeb\xc3re\xbdb\x15^\x8b\xe0Q\x96qc\x98}\xc4\xfdyF\xe2R\xf0)\x9f\x89\x12\xb1\xb4p[\xe8\xf2A\x15h\xdcW\xad\x82=\xb0\xb3\x12\xebɆk\x93\x7f\xa2\x89\xbaxS\x0f\xb1\x8b\n\x8b\x18\x96j\xa6H3lFB\xde隕\xbbB\xdcM\b\x19\xc14\x84\xcc\xe4-Ҥ\xe5Z\xa8l\xf0y\x7f\xdf\x14ψ\xb5\xc32\x06\x11ZVZ\xb6\xdb=2u\\\x8d\xb6\xedU1\x10\x85\xf5z\xf9\x19\x06\xef\a\x9b\x81\x86\xe8\xe4\xfc}\x94\xe4\xeb\x8cA\x05\xd4g\x9a\x84\x18\x82)T\xf3\xdb\xda\n\xabV\xa2\x86\xb3k\xf8U>\xbd\xb6\x1d\x17\xb6\xe0,4\xe8\xe1\xca\xdcbӯo\xdf\xff\xc4\xfd#\xf3\xf9Fk\x03\xe2\xe5\x1fk7\x96\xbfn\xfb\xf3\xc7\xedm\xd8\xdd\xcc\"\x84\xdf\xe6\xed\x8d\xdc+\f7nj\nش\x0e\xd4H\x8d0&\xc1\xb3\xc0N{\x9c\xa6\xab\xdfm\xb3!D\xe6\x03g\xed\x9bY\x94\x87k\xe6+\xe6\x7f\xdeL\x9d \xea\x87\u0383\xdf\xdf\xfc\xdfd([\xe3\x1f\u008a\xc8\x1b\xb7\xe0\x19;\x15\x91Oه\xb8S\xfd\x01\x8b\xb6X\xa2\xd9g\x7f\xab\xd3\x04ӕ\x9b\xb7\xf8m\xff\xc6\xc7\xd8\x1b\xaf\xec\n\x83 {ؗw\x92y\x8d\xb2\x0f\x93\x1f\x86\xa3\xd3Fp\x80\xd7[\x05\x98V\xbd:%\xe7\xe0\xfd%.Q\x82s\x04\x14\xf4\xe7\xdb\x14te\xc1\xc5Cj\\(5.\x1eR\xe3\x82\u05f8\x95\x0f\x04\x1f魴u&Ϳe|\xb2\\\xfdj\x1d\n\x86R\xc5yU\x8a\xe5w\xcd\xda\x12g\x9e1\xce\xf3io\x7f\xff\xee\xeeξ;\xb0\x93\xf4f\xbf\xeb8\xce>\x04\xed\x96\xcb@\xbd{\xf6ۋV\x9c1}U\xae\x0e\xcc\aJ\xf3\xaf\xbe\x15\xf1sD\xe5\\g\xfe \xbe\xab\xad\xeb\xafm\xbb\xb7\x14?{\xe2\x87𲾺\xb6nìW(`\xb7(\xe3֢\xab\r\x1bh\x15a\x7f\xf8콺\xee\xbf\xc3SoftPQ6\x1d\xd3\xcbҞ\xd6\x00<i\xe73\x1d+\xc5\xdf\xca[\xc7,\x0f\xb5Y\xdckkR 3\x97\xec\xefZ)!\xaf5\xac\x93\x13\x9c\x8d\x84\xb0\xd0YiU\xf5\x97\xd1%\x88V\x9a\xa8+0\x97%\xac\xf2r\xf8H־\xc8_$\xb3\x1c&\x1e\xfb\xc5\nr\xf1\xa9\xfa\xe8I\xf1=\x8c\x92\x98\xb6\x184\x93@\x9b\xb4\n\x11\x18\x93C1j\x96\x11\xaa\xfe{\b\x7f\x8d\x97f\xf8\xf8\x97\x06\xa4\xba\xd3\xdc&PLu\xf7!hR\x13\f\x17ʒ\xd2C\xdf\x02\x9c\x8c\x12.?\xc8\x1e\xae\x96{2\v\xa3\x11\x95\xc3\x12\xaf\xdb\xe7֯IZꃗ\xa6߄\xe9J\\#\xae=\x97n\xa0\x87Y\x8f\x01B\x80~s\xede\x05\xc6Z\x9e\xe8\x10\xbc\xb0.\x7f\x00\x1f\xad\xb6\xbf%\xa59-lع\b\x90\xcf\xf7m\xf8\x1d\xf6,\x15\x05\xe7\xb3\xf7,\a\xba\x13\xefg\xec`\xfer\xab\xd8`\xd7\xe2\v\xe4\xcb$϶4n\xfd\x16\xbb\xacZ\xf11\xff\x8f\xddh\x95\xe2\"\xff\x9a}\x17Ɵ\x10\x04-o\u0080z\x1d\xdc|\x95\xadG\xf5\xdb5\xe3n\x1c\xe6Ԩf\x97lC\rH6Y}\xf4\xabI\xc2z\xc6Ȁ\xa1\xf9\xb6\x9a\x8c\xbf\x8d\t\xf4W:\xc3\xfe\x99\xf6\xc6\xdf\xcc\xf4\xac\xbeq\x81\xf4/\xa5\x81\x10hm\xbbL]L\x92$\x1f\xd7]d\x119\xbf\xe9E\x96\xe6\x17\x82\xfc\x9b\x9b\x94ޠ\xd4da\x9c\\\xfd\"]\xdd\x16\xda\x1e'\xc9'\xb62\xf3\xb7p\xb9!Ot\x8a\x05\x98\x11\xd7\xc3{G\x8e5b\xbe\x95\U00084596\xae@\xd7_\xbd\xd3\xc3\x1ck\xefb\xd4\x05\x17\xae\\\xa9\x83{t.ޟs\x1d\xa7\xf9r\x1c\x16\x06\xefN \x80\xbc'g6E\xe83\x99\xaf&BW^2\tG\xecי\x8c#\x88\x9f$\x99\xe5$\x19\xb17\xa2{\xc4hc\xea\xaa~\b0O\xbbY$j\xb7\x14\x02\x96\x8f\x91\x05\x89*\xe8d\xb0U\xdc)0\a\x10\x0e\xc35\xf8\xab\xeb~\xb0\xe6b\xc9KyL]\x94\xbb\x909P\xfan\x1cFT\x81\x16\xf6\tV\xe8\xf6\xe6Gϱ\xfcۛ\x9f\xb8\xff\tz\x1ay\n8\x8f8\xeeX\xb5=0\xfbX\xa0\xf6^\v o{\x81=\xdfg0\xac\xe2>T\x06\xc9\v-ye\xf6\xebz\xc0\x99x\xde\x03d\xd6\x02\xfe\xfc\xb42W:\x91\x18P]\xf1R\x14\x06Nk\xbd\xf4\x96bA\xde\a\xab\xca\x05\x99\xb5]\xf0\xe3\xc6\x17\xb1ʳ\xd8\x1f\xe54զ\xb1\xbc\xb2fX\xa3\x1a&{\xb4\xe6\xb1\x02\x8d\xd16\xb3T\xf1\xbbvl\xe1\x14\xd3sVʶ;`\xa4\xdcp_\x99\r\x8dJB\x96\xd2 RQ\x8d\xa8\x7f9\x05곦~D\xf3\x9cZ\xec\xeebf.\xf9\x0f\x8f\xff-<\xfc8\xa4\xa7\xdd\xf4\xc3\xeb\xf6\xf6\xf7,\xab\xc5A\xe4\xdbM\x13\xfa\x95\x9fQ\x8f\xfb\xf2s\x8cE\xba\x16\x80\xfd\x99\xf2\xa4\x93|nڴoXp֖\xb9\x0fA1{e\x1c\xf2\xd1\xfc\xc2ϮZ\x99\xcc\xd3\xeas\xe9A\xaf\x0eJ>\xe0v\xa9$\xb6T\bSD\xb1\xdb\xdd\xe5\xb5\xc1Gq-aw\x97)oa\x86\x7f\xb9\xb5`Y\xcf2,2\xbb\b\xcd\xf0*\xa7\x13\x96$\r\xe4\x16O\ue54a\x14\x97$\xe0\x1by\xa5\xf6\xa9\x1bC\xa05\xe5\x86\x1e`\x8d\x15\x16c\x8f\x14\x14?\xb9\xcf&\x1fI\x96֒\x15\xf0x>\xd8]\xf9\xeb\xfe\xfe\no\x1d\xca\x04.\x90<\xcfsx\xf00\x96\xc0?nh\x8eA\xf3Z\xe6\xb9\xc3ob8\xd7v=u`\x0fʣ\xe7\x04\xaa0\x83t{n-z8\x83d\x88\xe8\xba&\xd4\x15\xd7\xf8\xb3Ӓ\x9fb\x84\xef\xef\x1d\x93#g\xe4\x10\xa1\xf1͵o2\x88\x98\x04\x98\x06\xe0_-0\"wA?>\x9e \x15J\x13q.\x9c\x9e \xd2`\x89N%\x87#v\x95\xabB+\xac`)\x9f\xf2S\xeagU\xebL\xc6\xe4!\xfcG\xb17\x89\x00Pu,Z\xa5\xe2\xfc\xfe\x9e=/𤆀\xae\xd9֨l-z\xccwK\xd0\x02\xfc\xb1\x8as K\xf4\xb6zs\x97p\x16\xd9\xddUyE\xff\xe2\xe3\xfd\xac&\rn\xea\x16\xb4\xe8\x95ȥ{\xa2)\xcc\xca\xfc\xd0\xf8h\x83\xc7\x19\x9b`XJmWx\xcdo\xb7(\xbd/*\toͥ$\x83\x94C\xe1-H3Y\f\xc8S\x14ɕ\x12L|\xae\xac`6\x99\x96\xf5G\x9c\x84K\x01\xa5\xb3\xb4\xa5V\u05eb4\x00\xe9\x01\xd6\xea\xd5\xda\x11g\xd1Z\xef\x80]\xbc%\xbb\x1aW\b\n\x16_Q\x8e&\x86\rB\x8c\x05\x9f4\xccf\x8e\xb2aB\xe3\x8f\x05\xae\x8b\b\x80Me`\xbc`yɴ\xa2\xc4\x0fj\xd6r,\xae\xc9횡\xa8\x00\xadԒ@\xcb\xf2ph\x99\x12\x1a\x9bi.\x8b\xdfk%\xfdo*O\xf8:\xb1\xaa\xa8\x04\xf6/4M\xbe\x81;\x91\x95\xedh\x19\x126\xfd<\xd3rT\x15D\xc9/ca\x17 \x91K\xe6r7\xbc\xdef\xaf\x9c\xfa1\xd3'W\xaeC\x16\xc5u\xe2\xcfa3\x1e-Zx#\x1d\x89\xd9x\x18&\xb4^\xd3\\\x16\xd1l+\xc5\xd7؈`ꚍ\xf8\xd1\xc9\x14\x0eۂ&\r\x0e\xcf+1\xca-\x03~\xe4\xcdA\xad\xe3\xea~h9\x16\xc8Hk\xd1\x03:1\x1b/\x04\x9a\xddJ\xbf\xb31V\xf7\xcbY\xea\xf3\x974\xd6\xe6o\xb2\xf1\xb22\xbfV\v,\x14\x8ez\xe5j\xc33{Z\x8b\xc5\x11\x83|tOEe\x98\xabj]\x13\x7f\x0e\xab\xe6\xf7,\xd0寫L\xc3%^\xfa\xfb\xf7\xd4ok\x95\xd1:\xfaKI\xb2\xddJ\xc9\x05\xcdp\x96\xa64.\x9ciJP}\x15\xe8\x95TMJP\xca\v\x87*>\xa7\xa6\xb4\xc3h\xad\x0ff\xed\x10\xff\x81:v\xbf\xdc\xf4\xb6\xe7ji\xd8\xf6\xb6\xe7\xaet\xbd\x91?ܩ5\xbbS\xc6eV\x95\xa3r$G\r\x81\xfe\xc4\xf2\xeen\x19\xdfY\xb5\x88\xaa\xc5l\xd1\"|\xb1\xc9e\x0f6m\xdb\xf3-5\xc3Y\xccnW-\xe7\xbdV+\xec\xb85\x9d\xaf\xd5\f\x1d+셊SP\x8d\x92\xccOr\x84\xae\xac\xb8\x82X\xf3\xedGP.\xb7e\x9a+\xb7\x8eْT\xc6x^GwL\v\xd2dʜo\xa0\xe1\xe2\xabrJ\xbb\x91x\xd2N\xe4\xcag\xae\xd4Q\xf3܍J\xb2\xd6[Xo\xbe\xf8\xeb\xdf\xef\xf2/\x9b\x86\xb1=\xce'\xd1\xefX\x87\xe3:\xce\xf1\xf1\xe1\x17\x0e\xfbW\xfa\xdb=8<>\xfa\xc2\xed\x1e\x1e\x1e\x9d8GG\x87G_8\xae\xeb\x1e\x1c|A\x9c?\x82\x00\xb3,\xf7\xd3/\x9c_]W\xb9s\xffK\xfe\x9de\xc34\x9c\xe6\xe7;\xfbO\x9e\xec\x90'\xe4E2]\xe0\xc15i\rM\xd2u\\\xb7\xd3u\xdcC\xf2\r\x8d\xc29\xf96\xf6\xb3\f\xc0^\x87C\x1ag4  mR\x92\x8f)y\xf3\xea\x92D,y\x87<\xd9\xdf)\xc4C\x9a$\xb9%NcM\xb2\xdc\xd9!d\x1f\xaa\x9aL\x92\xf8\xef\x17\x00LH8\"b)\xa1\xf3i\x92\xe6\x19\xf1<\xb2\xc7\x16\xc9=\x93\x90I\x12\xcc\"j\xcbL\x81\xb0er|\xcf\u07fc\xe4@\f#7\xa5H\xb4L,\"VѴ=\xb2\xbb\xcb\xd3m\x7f\x12\x98\xfcwK\xb4\x95c\xfe*M\xee2\x9a\x92\x9b(\x19\xf8\x91\x82\x1dzf_L1n\xa6֢\xd5\x0e\x8a]\x8b\x14\xe2\x8d,w\b1f\x19%Y\x9e\x86\xc3\xdc\xe8\x03v\x8cm\x9e\xd2Q8\xa7Ч\xab\xbd;:\xf8\x14\xe6{\x16\xd9{\x93\xfc\x02\x7f&\x19\xfc\xf7\xdd\xde5\xb4\xe4\x1f4\x0e\x12\xa5\x046\x85\x10\x8b\xf8q8A\x15\x11\xb0,WH\x0f\x91D\xd2YD3\xf2\x89.h@\x06\v\x18\xad0E\xd3YQ~\x96\xd1\x17Y\xf6\xbc\xc0\xb2\xff\x84\xfcsL\xf31\x8cn\x02\xd9\xe4\xc5ŅZM\x92\x92\xe2\xbd)@\x84\xb4z\x02\xf8\x9e\x90\x1f\xf20\n\xf3\x85\xec>\xe0`\xba\xbc\bϗ\xd9\xe4Ո\xc4\t\xc9\xfd\x1b֖0#7\xe1-\x8d-\x86\xc2'/_\xfd\x03\x12Y\xb9\xc0&\xefP\xbb\xf2\xa3hA\xa6,PKH32\xf4c2\xa0d\xeag\x19\rl,\v\x9d\x925\vO\xbbV\xee\xdfXX\x90\r\x05\xa3=\x8d\x88G\x1a\x82\xa6B\xcb\xee\xef\xc9^\x10\xde\xee\x99X\x02(\x15\xef\xe0OPTb\x12\xc6\x1c#\x8d\xae\xe2k\xe2\xe1\xd7U|\x8d |=\xa3\xd1\x0e!+\x8d<\xcf\xf1\x9c6#C\xf0\xebKiL\xfc8\xe0\xe0\x19N&\xf6\xb8fMo\xc28k\xb1L\x18!,\xefZ\xeco\xd7\"\xb6m?\xd9\x17\xdd\x1b%)Ẕk\x11\f\x8f7c\x94\xe7*1\t\xcf\xe2>\x01\xeb\x10\xef\x1c\xafU\xf59\x94\x85\xe0>ߎ\xda-\x06\\\xe9\xda+\xbc\xd8C|\xdc\xc3\xe0\x89o6\xa64'yB\xc6I\x14`\xef\xfe\xbfOt1Ja̓\x94\xfc\xe3\xcdkƠEo\xa1Ѭ\x94GZ\xa5\x19\xa4\f\x9b\x1c\xd9=\xacg\xcf\"K\x98\xea\xa4G\xf6r:\xcf\xf7\x87Y\xb6\xb7b}\x03\xb2\xc9Q\xae}kpoL\xfd`\x0fnc[\x84F\xa6>\x806k\xcd\xfd=\xfe\xc6clH\x80ηLS\xeb\xff\vlTF\xfc\x98$S\x7f\b\x93@\xf6\xd6\xd7f\xa46\xe8a\x9e\xe1,`D \x17a<\xa4d\x92d9\x99$\x830\xa2\xe4\x9f(\x1722\xf6o)\xec1\xc3\xf8\x86\x84Y6\xa3\x19\xb9\v\xf3q\x81\xbb\x13\xd0\xc8_\xf0ItGŴ\xcb\xe8\xd4O\xe1\a\x13\x06\xc0\x1c\xf0\xbc*\x06J\xde\xcf\xe8ͤ\x9e\xdf0\x8a.\xc7\xdc\xf2\xa3\xe9طH\x9e\xfaad\x91\xd0\xc2\u0099:.8\x91A\x8c\xf1\xbe\xefI\xe8\xff\xf9\x1fV\xfc\x89\xeb8fQ\xf8\x9a]\xef\xde\xeb(3\fVd\x18z\xc7v\\\xd2&\xe1>B\x92'\xc4u\x1c\t\xf4\v\xf1\x880\xb5\xb4\\\xd2!-\xb7\x83\x15\x98d\x9f\xd5I\x9e\x90\x96\xeb8\x1dDgZ\x84\xe5J\x04L\x86\x12\xaf\"\xfa\xecl6\xc8\xd8keNU0\xdah'~7j\xedɴ=Ӵ\xf3\xe4urG\xd3\x17>D\xabV\xeb \x9e\xa8iw\x97\xecu\xf6H[|\xb7\xf1\x13\x04\xcc\x1e\x9bX\xb0V=*$\xec\x15{\x89\x99S\x97\xb0\x19\xc1\xdf\x1e}?\x8bh\x8b\xa7\x13\xb2\xf7\xffq\xb4\x80Sp[F \x11\a\xa4M\xf6\x96{\xa4]\xc0;\x8f\x97|\x80z\x00\xf4\v@\xacT\b6\x04m\xb2\xa7\x03\"\t+\xc0-\x84n\xc3p\x99z\x19\xb7\x0e\x0e\a\xc7$\x8fa8\xb7\xadb\xcfu6\xb6yo\xb5gq\"\r\xb3\xec=\x8a\x14n\"\xd8\xe1@e\xe2\x12\x8f\xb8\x98\xb5\xd2D\x1b\xe4U\x04\xdb%Z^n\xfd4Lf\x19\xb9--\xc4e\x01\x8ea!I6\x9b\x82\xb6B\x03\xb1`-j\xa6\x18CբQuu\x82\xc5\\H\x9c\x82\xa9\xa6\xf2gȚ\r\xc5\xf8\xdac\x0f\xc7~\xfa<o9\xf8\xec\xdet*X\x12\xf9#\x99\xf2\xe8!\xae)\xd700u\x92\xf0Lt\xa4X\x1b\xda\xed\x82\xf5\xa6S\xc9\xc6\xf8\xcai\x1bp\xf1<p\xa5\xb8\x9aN\xaf\xc9#\xcf#\xc5\xe6S.\x13SN\xe0\x026M\x9a\xa1\x19^\x9d\xf2\x174\xcf\b\xba\xe9N#\xca\xd6\x14U\x01\xf0s\x92\xc4CZ\xb7\xf4gY\x85\xacrU,\x16o\xde\x11A\xe8+e@b\xf3\xfe^[\xd77,\xec`\xa3\xce\x001w6\xe5\xe1\x85k\xda6\xa1\xe9\rm%\x83\x8fu\xcbu\x9f\x84\xe4\x8c\xd4,\xd8\xea\xa0\xf0@:\xc4#\xea\"\xcd3\xf5n\x06td\xcay\x02b&\x19|D}E\x1f\x04\x91\n\xf0B\x89Y\xa9=N\x06\x1f+]~\xafp\xbd?Ȓh\x96\x83\xferC;\xec-=\x82\xc6!\xcat;\xa1\xfd\xd5\x10d\x9a\xc0`\xa9ܟ\x80*K\xe6=\x1a\xf1g\xf9^\xd3Qn\x91E\x91p\x99Ly\x03\x99\xa9\xa3\x85z\x81\xcc\xfe\x1eU\x14St=\xb1\xe7m\xaf\x84,\xb1\x17J\xdae2\xd5F8Y\xdb[X\x96\b\x1e!\x91Q\x9aL\x94^\xb2\xe5\x83$)\xf1ѶW\xed-\xbe\xe7\r'\xd2\xec\b\x8a\x84\xc1\\t\x9d\xd7\xcdw-\f?lZ\x18\xd2=\xf2\x8c\xa7\xf5\xd8߫0\x98\x93\xc7\xec7g\x94k\xd9\xec}\xf2\xd5,\x8c\xf2N\xc1\x91\x99\xd8u\x88o\xe2\xf1zq\x95\xed\x11\xb7k\x11\xe5\xdf\xfe>\xb9\x1cS\x12cT!\x18K\x04#yB\x82Կc\x05\xd9\xe9$9\xa9+\xc82\xa1\xa0T7\u0600\xa1\xbb39\xb2H]!\xa0l>\x0e\x87\x9fb\x9ae\x8c*~\x10Πy\x8eU\x85g\x99\x82\xd3\xd8v\x8c\xf9Ų\xb2I\xee\xe7\xb4G\x9cr\x03\xdfC\x06\f\a\x1b~\x04\x1e&)\xbe-C\\\xab\f<\x8b\x03h\x0fi9\xb6\xcdE(;L${_:\x8e\xb3g)\xd0_\xa67\x03\x92\xa4\xe4\xcb4\xbd\xb9\x19\f\x108\bS\xf6\xfc\xbb\x86|\x7f\x9f\xb8=2\x8c\x92᧻0\xa3\x16\xe9\xc0g2\x8bs\x9a\xcaT,\x9fM)\rJ\r+\x9a\x96\x91)MIF\x87I\x1c 8.\xb4@\xb12ɞ\x8fr\x9a\xdeD\xc9\x1d\x94\x18\xd28\xf7oX\x05b\x81%\xee\xfe\xa1\xd6\xc0w,CP\x18y\x80I\xadi\xd6#]\x9d\xb0X\xe2\x1b\xa6\x83\x14M\"wc\x1a\x93Y\x06\x13Cy\xa8\x98\x91\xf1\x17f\f$]\xfa\xd4\xd2\xd0\xfc\x90Q\xe2\x13x\x80\x81\xfc\xd2A\xd5\vv\xb0\x9cw\xd9\b\x88\x9b\xd3=\xb2\x97\xb1\xad\xf8\x9e\x05Ea\xbf\x8a\x99$O\x88\x9fe\xe1\rnC\xa1\xfd\\\n1*%\xd3\x1e\xd9;r\x1e\uf5684D\xafZrK\xd3<\x04_\xe5\x05g\xf6Q^\x85/\xc0\xc7I\x1a\xfe\x92Ĺ,0\x15\xfe\x95dO\x88\xc7=,\xc0\x1b!\x01\x14Y\x83L=L\xe2,Og`R(\t\x0fnqh%Bd\xb0\xb7\x92\xa68\x99\xf9\xc2\x02\x8a\xe5re\tX\x19/Ӓ\x13\xdfT\xa4ķ̲!\xb2H>\x86U\xf5\x96\xa6i\x18P$ـˑ\xac\xb7C*H\xd1\xe6\x00\xa8X\xe5\"[\xfa|[\xcc\xe8#\xe5(\ue043\x80\x89Q>hbl\x98\x04e/\xc0\xca\xe5\x02l\x05\xd0I\x12\xc6\xecEC\x12fďR\xea\a\v\x81\x10\xf1\x84\xf1\x8dE\xc2\x1c\xb3gy2\xf1\xf9\xd8\x11\xe6 \x1c0I\x1d\xe6\x19\x81\x87\xb5Q\x95\xe3U\r\b\x00\x86\xf1\x8dė'Ӗɂ\xf7\xa0\xd5\xc1\xe69\xfb|2\x86q\xaf\xb0\xef0,\xc5\xea\xcc=\xc5\x01Ŏ\xb2`\x83W\x1e\xf10wG0\x8f\x85+\x1d\xe4\xe0\x18*\xe94\x12\x19\xf8\v\xf4\x19\xb9\xe9u,\xb2Tx?)B\b\xacL\x8b,\v\xb6Kl\xf1\xdb\x12Rױ\xe4\x8cKl\xf6ke*\xf5N\u0080x$\xb1\x99\\m'|]i'\xec\xf9\x05\xd1!\xa1_-eQ6;\x12\x8c\xfcm\xc9T\x9cb\x89\x9dK\x9dq%i\x82\xf6\xb9\x12\xe9\b\x1f\x12\xbe\xd7\xf9\x8a\x8e\x92\x94bE<\x1d\x95k\xb4O\xdc\xdfc\xa4t\x81vGQ\xe5T/\xfe\xbd4A\xfb\xc0\xde4MnR\x9ae\x03?\x95\x1b\xce\xe2\xdd\x16\xacC\x8e\x83\xd6\xc6G\xe5}\xa0\xda\xdc\xfd}\xf26\xd1\xedcB\xf5\xc7\r\xa4&\uf405\xa9\x1f\xc8Ҩ\xf3\xc1vW\xa6\xa8\x9b\xe0V\xc2\xdaF:\xc45qKK:$\xb1\xe5R\x02\xdbݮV\x12wPZ\xcah\x9a\xe1p\x8e\xa6\x99\x9e\x0e6\xcbi\xb6\x9fظ\xb2hyI\x96S\xd0\xfa[n'\xb1\xf9\xba\x00u\xb5FO\x12\x9b\xed\xaf\xf7ai1\xf5\xbay\xa9\xd1>o\xf6\x8e\xcc\xee\xb7\n\xa3B\x1cNZ*\x01\th\xb6}\xe5S\xaa\xaf\x1f\x810}\xf2\x91\x9c\x11\x8e\xb1O>\xaaZ0\xfb\x87}\xaeX\x04B\xd2V\xe9\xf7\x11\xe8\x87-\x84\xdd\xe7\x88<a\x9d\xb4H\xd1\xc1\x1d\r+g\x05\xccB\xde\xf8H\x9e\xa8\xb4'm6L\x9c\xe6\x16ITj\xacvJ\x98r\xc6\x02ʔ\xde\xddUY\x03Ȃ\x86\x12\x17\xee\r\x8f\xa6\x99Y\xa0[\x99\xad\x82\xcb\tQ\xf4D\xc0\x85)+\xab\"c/\xf2d*\xf6\xa6 \xfe\x98\xc0\x15\xe2Y\x17g8I+\xc66\xc5\xdc\xc6=\xfb\x95IQ\xa8\xebl!\xa6~*\xfa\"\xcfE\x93Y\xaeo=hd3\xd3!<=g\x12\xed\x93_\xe3\x10\x8f\xab\x17\x05y\xddDٮ\xd4SC\n\xd6\x1aj\xbc⒜\x87&b\x8b\x1ch\xb1\x19W\x1b\x83\xf06\ff~\xc4\xf4\x1b\x9b\xfc3\x8c\"2\xa0\xb8\x10ޥa\x9e\xd3X\xe0\nc4\\\x8e\xfc(\x82'\xbb\xe0\xe0\x81\x92\x01\x8d\x92;\x9d\xac\\\xa9\x96t\x05.Jt\xe2\xeas\xffsg>\b\xae\x1b\xc1\xbe\xa2:\x8c#/\xb6\x18\xd9\xd8\x0f\x92;u\xc48ʹeŴ\xb4\xa9U\xa7\xbdXJ>_PZ\xe5%\x02\rAӹ\x06\xcb\xe2\b\xf6\b\a\xa9\x81(^?\xe3\x9b\x1b-3\x99_`\x17z\xbc+j\xa6\xbcq\xf1.\ro`aރ%h\xaf\x16\xa4G\xf6؎\xa0\x05\x86\xa4\xff\xf9\x9f\xd6\xc1\xb1#\xe4Փ\xb0\x9d\xd8,\x17\xbb\x10\xd0\x1b\x93\x95\x8cD\x01\xb9,B\xebI{\xcfrL\xbd\x13x\xad\xe6=߯\xb4\x12\x9b\xef(\xc8\x13\xd1\xf3\xf3sW\xd0G\x99\xe0\xe5E\fe \xb3\x04H\xd1\x17\xea\xa2/\xa37\xc4\xfbUㇳ\xdem\xffO\x8b7m\xbf[7r\n\xe9\x12{|\xe7\x0f\x874\"\xcfȞ$\xccAЂП\x8e\xb9\a\xd6w\xad\xac\xdcSH)\xab\xe6\xca\x05\xb3W=\x82\xda\xdd\xd5\xcd\xcf\x05\x02\u0097 K\x1a*C]2[\x82d\xd8\x1b\xb4\x84\xbab\x99\x83\x94\f'&z)\x8f\xc28̩6\x10\xba\xa9\xc4\x16\x13\a\x0e\x112zc!\xc1qf\x89mߞC\x1cr8\x9dcE,\x11\xc6\x01\x89\xdbE>Y)\xb2\x1c\xf0\x80\x1c\x90\xf8\x10\x97\xb4\b$\xb6\xb0\t\x98\x1c\xb3;\x9d\x93\xf4f\xe03\x1a[\xb6k\xee\x99f\xc3r@\xa3\a\x89??\xf88\xcbr&\x00\x93b\x93\xe7\x13اEl\xa7'$Z\x9d<\xdcZ\x10J6\xd0Dah\x81\x85\xac`i 80<<R\b\x8b\xc0[\x8c\xaeƭ\xb8z*x*\xb2\xe7\xc1D\xbb=\xc0U\x98sa \xf5\xa3\xb30\xffǛ\xd7-\xb3،\xac;\xa7\xf4\xb1_\xb9\x7f#z an'\xfc0\xd1\xcf\xf3\xb4h\xbb\x90\xa6\xf2T\xea\f\xb8\x01\x10\x00\v\xce'\x11\x04\t\x99\xa5q/\x1b\x8e\xe9\xc4\xcf:\x93p\x98&Y2\xc2\x17[{\xb7\x93\xc8`\xfbUπ\xbdE\a\x12\xce\xf7x-;\x8ah(\xd4M9/32\x98\xe5\xd8`\xa9w\xfaA@|\x84\xc2æ\x11?j\x13ǯ\xbd\x9d\xe2L\xc1\x0f\x02<PسE\xc5\xc0\xd3\x03\xf66bڛ\xa5Q\xebK\xbe\xd5\xfb\xf2\x1fo^\x9b{|\x8aT\xf6y|\xc1\xf2֮w)ꢥ\x1d\x85\xb2\x8c\x11\x8ft\x9f\xa4\x95\xa5\xec&\x9d\xb6\x1a\x16/\x99Fpl\xf60\xa0\xc1\x9eU\xd2\x11\x87I\x92\x06\x19\xb8\x8c\x92LJ\x85̪\xc2$|\t\xe9\xa4\x12\xac\x93\xaa\x8a\x9d\xa9\x16Z\x8a50\xb3\xe4\n\x97)\xca_E\xae\xb3\x88\xc9\xe9M\x18\x13\x8ft\x84\xe0\x95\x9b,\xf3I\xb7\xbc6XD\x88y$\x82\xb6\xbbS\xc4:\x13\xe6\f\xb5ŷb\xecK\xdb܅\x15\xd2f\xf4\xa6\x15Z$\x98\xa3(\xcai\xaa\xd2\x19\xa4ԍ\xdaaHPےr#Z\x8f\x1c\x1c;d_H^P\x92\xf8\n\xba'Z\xf3?\xff\x13\xccu\xea\x15\xe8p\xe4p\xed\a\x19\x0e\xa3\xe7\xa7C6\\r\t]\x99\xe5AUt\x90Ԫ\xe4\x944\x8e*\x80د\xb2\x15\xbd\x9a\x8f\x14\xed\x14\xcbv\x15\x82\x11\xac\xc7\xff\x96\xb2\xcb}\xe5\xfc\t\xf2\x1e:ȍ\x87\xf5\x92\xbf\xban6`c\xd7\xd1\x01\x9f,\xe2\xacL\x90\x12(\x1e\xb8\xb7\x00\x83\"yB\xe0\xec\x11\xeb!\x83\x88R\x88YAfӤ8\xae\x1e\x8e\xe1\xf6\xabV\x93\xb9S\xf7\xbb\xc2\xd9\xdar\xb9\xa3\xed\xe5B8jCe\xc6ӵ\x19m\x93\x84l\xd8\xe9\xf2\x8dz\x18\xf4^\xfe\xf8j\xe2\xdf\xd0K!\xe7\xec7Rf~\x15\xcd\xd2\xd64\x9cӈ\r\x9e\u05f5&\xfe'\xcaj\xf7\\\x8b\xfd\xe0\x9d\xf2\xec\x03)\xb56\xb5\x88\xb5\xc3ԥ\xbaX\xb3o4I\\\x15\x80\xc5ZT\xb3\xceUDᐝV\x14\xf6\f\x9e\x97\xa0\x88d=\x005HL\xa9\xfb{\xe2(\xb4\x1eB^\xd8N\xc8\x19\x19֭\x97\xcaތx\x1a\xc8U\xd8N\xae\xfb,\x19\x90\f\x9564$kJ\xd1\xd0$ÚuWh#+aZd\xeeMɀ\x96\xd5T.\xa3a>\xcbu\x86\xecUW\x1a\xa6\x8d\xa1\x05\x86\x1f\xcb!:\x8b\xeb\x9e\xc0\x13{&4\x15\x93m?\xf8h\x16\v\xbdpӪh\x96\x1e)!\xf3\x8b\xb3\xfc\x9d\x1d9\xe8|xwv\xe0\xf6\xc4\xceپ\xf0\x95\xfb\x0f\xf2\xff̓$\x1a\xf8\xe9\xef\xea\x02\xba\xde\xff\xd39v\x9d\xee\x17\xeeQ\xf7\xf8\xb0{r\xd4u\x1d\xf0\xff<v\xba\x7f\xf9\x7f\xfe\x11\xff\x96\xcb}0f\x80\x93%\rH\x18\xe7\t\x81\a\xc0IJ\xb3i\x12g4\xb3H\x96\x90O\x94N\t\xbd\xa5\xe9\"\x1f\xe3\xf9\xcd0\x99J\xe7\xcf/o\x928H\"\xbf\xc3y\x89<\xd9_\xadv\xce\xd89=\bE\xcf\x10\xeeX\xc6\xf9N\x05\x1a\x84T\xa1\u0380\x87A\x00\x96@\x1e3\x898\xfd\x1d\xb1L\xe3O\x16U\x89\xfd\xe6'Bx\xc4\xe58\x984J\xe2\xbcG\xe2$\x9d\xf8\x11q\xbb\xd3\xf9\xbe{:\x9d\x937I\xec\x0f\x13\xebM\x12'xM\t@\xf9\xea\xfb%\xa5\xf8\tm\xec\xf8Qx\x13\xf7\xb0\xbe\xfeΪ\xdaX\xfb&\xef\x88F\xab\xc6\n\xbe;#\xfc\xff\xecS\x13P\x8a\x90P\xb85t\x9d\xe9\x1c\xab\x9d\xa5\x19\xd4;M\xf0dam5\xf0j;\xd6\xc5Ը\x0e\xef\xbc{4\x9d\xaf-\a\x7fi\x9a&\xac\xa1\xa2\xa3\xa3\xe3\xe3\xc6RS?\xa6\x11B\xf3\xd7\u0601\x881\xedo\xe8\xe7S\xec\xe7ğw\x84\xaau\xec\u070e!\r\xf6\x88\xa3\b\x8c5p\x0e\xa3Q\xe3\x88S\x83\x93\xa4\xda\"hP2\xa5qS\xcb\x06p*Zە\xf1\xa1B\xae\x1e\xab\xc5!Gӹ\xe0\x8d\x0e\xd3(\xdd\x03>\x18\x9c4O\x87\xa3Z|9\x04\xb8a\xa3\x8dV\x1d\b<\x14\xf9ӌ\xf6\x88\xf8\x05h\xb8\x06\nNJ\xf5h\xc6Vu\x9a\xe4\x01Y\xaadq%Y\xa0рV\x1c=\n\xb6̓i=\xa7\x96\x98\xb9\xb6\x05X\x19\xc6B\xea\xe0\x14\x80\x01\xbeK\xfdi-\xf84\xa5\x1a!\x9d~\xb9\xf04\xa5\x1dV\x9c\x90\xbb$\r:\x83\x94\xfa\x9fz\x04\xfft\xfc(\xaa\x99b*'I\xeeB\xba\xf2\x1a$-\x94\xb9\x1c\xc6c\x9a\x86\xcdӑ\x85\xf1\xd3\xf8#\x8cA]\xeap6)\xb6\x01\xa7\xd3y\xb9\x19b\xe4\xcf\xf6Q^\x9d\xef\x9c\x05\xe1-\t\x03\xcf(\xd5e\x9c\xef\x10\x82\x99|\x8fΦ\x9aA\x92x\x18\x85\xc3O\x9e\x81\x01\x1cTo\xe2\xc2\xcf\xf4\xabū\xa0\xb5W¸g\xf6I^\x9c\xda\x11O\xfbzF\xf6ЖƧ\xc2^\x1f\x1b@\xc8\x19Ȅ\xf3\xe5\x92\xd8o\x98\x19g\xb5\"\xf0\x051\x9d\xc8ju\xb6\x8f\xf9\x05\xe8rI\xc2\x11\xb9\xc9)\x81\xf7\xe0\xf2Y\xf6\"\t(9t\x1c(Xt\x05\xa5\x85\xb1\\\x12\x1a\x03J\xac@\x81\xafA\x8c _\xe3\x14\b\x1a\xf3\xffό]X\\\xadȿ\xd8\xcf*\xdc\xd0\x1f\x8e)\xf6\xe1\x05\xfc\xfa.\xcc\x11~\f\x7f\xf7\x8b\xf47a\x961L\x13\xfcU[a\x04B\xe3\r\xcd2\xff\x86\xc1F\xc9\r\x99\xf0\xef\xa2\xc4\xd9~\x10\xde\xd6\f(\xca\x1aA\xe8\xf1\xe1\xf9%\xfaٞ\xed\x8f\x0fy\x1a\xca\x03\xf6\x9b@\xd30P\x10\xb1\x19\x1cTȳ\x004=\x97\x1f\xf09F\x82\xe0\xd8\x02\xb5\xf2\xb1\x9e\x1d`6>\x88\xc2\xf2\x83\xba\xfc\xcb$\xf7\xa3\xe6\xfci\x1a\xc6\xf9\x88\x18\x8fmw\xf4\xf8\xb1A\xec\xef\x99\x7fG}\t&\xb6<\xe3\xc8yl\x9c#\t\x15R\xe0\xb42\x98\xb7\x9dgp\x01W\xaaB\xab\x00p0\x02\xebU\x9d\xed\x17\x94\x90\xfc\xb5SC$ \x11\x06\xa6\xcat\xea@\xdfJ(95\xdeS\b3\f\n\xc9jU\x85X\x9fR4\xebl_\x19\xd5bL/\x98%Y\x19T6\x95\xec\xafoi\x9c\xebc=>d\xa3\x13\xe6\x11\x1b]\xc10U\xa6\xd1+\xa9\xe2\xaa\xe1\x1d\xb5\xcb\xea\x84˃:\xa8RR]\xfd\xd5v\xbcMrZiƚ\xe6\x94ٺ\xe8\xb9\xce\xd7\xe5Ɓ\x9f)+@\xe7\x8c+!\xa1\xae+U\xe6)\xb5\\c$\xadD]w\xabU\x94QWQ\x96P\xe9\x00\x95/\xf4\xc2We\xcfN\xc1\x1c\xaf\x93\x1b\x95'\xca#R\x8c\x83ޣ\n\xe1\x05\xb9_\xd3[\x1aՒ[#2oL#\x9du\x12T&gi^\xc8L.=\xf9\x9f/\xfe\xfa\xf7\u05ff\xbf\xfe\xfd\xf5\xef\xaf\x7f\xff\v\xff\xfd\xff\x03\x00)%\xbb\xdf\x00t\x05\x00")
@@ -1,9 +1,21 @@
 package app_test
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"gnd.la/app"
+	"gnd.la/app/report"
 	"gnd.la/app/tester"
+	"gnd.la/tracing"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 )
@@ -48,3 +60,729 @@ func TestGoWait(t *testing.T) {
 	tt.Get("/wait", nil).Expect("43")
 	tt.Get("/nowait", nil).Expect("42")
 }
+
+func TestErrorReporters(t *testing.T) {
+	a := app.New()
+	a.Handle("/panic", func(ctx *app.Context) {
+		panic("boom")
+	})
+	a.Handle("/unavailable", func(ctx *app.Context) {
+		ctx.Error(503, "maintenance")
+	})
+	a.Handle("/notfound", func(ctx *app.Context) {
+		ctx.NotFound("nothing here")
+	})
+	reports := make(chan *report.Report, 10)
+	a.ErrorReporters = append(a.ErrorReporters, report.ReporterFunc(func(r *report.Report) error {
+		reports <- r
+		return nil
+	}))
+	tt := tester.New(t, a)
+	next := func() *report.Report {
+		select {
+		case r := <-reports:
+			return r
+		case <-time.After(time.Second):
+			t.Fatal("no report received")
+		}
+		return nil
+	}
+	tt.Get("/panic", nil).Expect(500)
+	r := next()
+	if !r.Panic || r.Message != "boom" || r.Type != "string" || r.StatusCode != 500 {
+		t.Errorf("unexpected panic report %+v", r)
+	}
+	if culprit := r.Culprit(); !strings.Contains(culprit, "TestErrorReporters") {
+		t.Errorf("unexpected culprit %q", culprit)
+	}
+	if r.Request == nil || r.Request.URL != "http://localhost/panic" || r.Count != 1 {
+		t.Errorf("unexpected request in report %+v", r)
+	}
+	tt.Get("/panic", nil).Expect(500)
+	if r2 := next(); r2.Fingerprint != r.Fingerprint || r2.Count != 2 {
+		t.Errorf("expecting same fingerprint with count 2, got %s (%d)", r2.Fingerprint, r2.Count)
+	}
+	tt.Get("/unavailable", nil).Expect(503)
+	r = next()
+	if r.Panic || r.Message != "maintenance" || r.Type != "HTTP 503" || !strings.Contains(r.Culprit(), "TestErrorReporters") {
+		t.Errorf("unexpected error report %+v", r)
+	}
+	tt.Get("/notfound", nil).Expect(404)
+	select {
+	case r := <-reports:
+		t.Errorf("unexpected report for 404 %+v", r)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestHandleDebug(t *testing.T) {
+	a := app.New()
+	a.HandleDebug(app.DebugTokenAuth("secret"))
+	a.HandleNamed("^/hello$", func(ctx *app.Context) {
+		ctx.WriteString("hello")
+	}, "hello")
+	tt := tester.New(t, a)
+	tt.Get("/hello", nil).Expect("hello")
+	tt.Get("/hello", nil).Expect("hello")
+	tt.Get("/missing", nil).Expect(404)
+	tt.Get("/debug/vars", nil).Expect(403)
+	tt.Get("/debug/pprof/", nil).Expect(403)
+	tt.Get("/debug/vars", nil).AddHeader("Authorization", "Bearer wrong").Expect(403)
+	tt.Get("/debug/vars", nil).AddHeader("Authorization", "Bearer secret").Expect(200).Contains(`"goroutines":`)
+	tt.Get("/debug/pprof/", map[string]interface{}{"token": "secret"}).Expect(403)
+	tt.Get("/debug/pprof/", nil).AddHeader("Authorization", "Bearer secret").Expect(200).Contains("goroutine")
+	tt.Get("/debug/pprof/goroutine", map[string]interface{}{"debug": "1"}).AddHeader("Authorization", "Bearer secret").Expect(200).Contains("goroutine profile")
+	tt.Get("/debug/pprof/missing", nil).AddHeader("Authorization", "Bearer secret").Expect(404)
+	tt.Get("/debug/pprof/cmdline", nil).AddHeader("Authorization", "Bearer secret").Expect(200).Contains(os.Args[0])
+	// Nothing must be registered in http.DefaultServeMux
+	if _, pattern := http.DefaultServeMux.Handler(&http.Request{Method: "GET", URL: &url.URL{Path: "/debug/pprof/"}}); pattern != "" {
+		t.Errorf("unexpected handler for /debug/pprof/ in http.DefaultServeMux: %s", pattern)
+	}
+	metrics := make(map[string]*app.RouteMetrics)
+	for _, v := range a.RouteMetrics() {
+		metrics[v.Route] = v
+	}
+	if m := metrics["hello"]; m == nil || m.Requests != 2 || m.ClientErrors != 0 {
+		t.Errorf("unexpected metrics for hello %+v", m)
+	}
+	if m := metrics["(not found)"]; m == nil || m.Requests != 1 || m.ClientErrors != 1 {
+		t.Errorf("unexpected metrics for not found requests %+v", m)
+	}
+}
+
+func TestHandleMetrics(t *testing.T) {
+	a := app.New()
+	a.HandleMetrics("", app.DebugTokenAuth("secret"))
+	a.HandleNamed("^/hello$", func(ctx *app.Context) {
+		ctx.WriteString("hello")
+	}, "metrics-hello")
+	tt := tester.New(t, a)
+	tt.Get("/hello", nil).Expect("hello")
+	tt.Get("/metrics", nil).Expect(403)
+	tt.Get("/metrics", nil).AddHeader("Authorization", "Bearer secret").Expect(200).
+		Contains(`gondola_http_requests_total{route="metrics-hello",status="200"} 1`).
+		Contains(`gondola_http_request_duration_seconds_count{route="metrics-hello"} 1`)
+}
+
+type spanRecorder []*tracing.Span
+
+func (r *spanRecorder) Export(span *tracing.Span) {
+	*r = append(*r, span)
+}
+
+func TestTracing(t *testing.T) {
+	var spans spanRecorder
+	tracing.SetExporter(&spans)
+	defer tracing.SetExporter(nil)
+	a := app.New()
+	a.HandleNamed("^/traced$", func(ctx *app.Context) {
+		ctx.Cache().GetBytes("traced")
+		done := make(chan struct{})
+		go func() {
+			tracing.Start(ctx.R.Context(), "background", tracing.Internal).End()
+			close(done)
+		}()
+		<-done
+		ctx.WriteString("traced")
+	}, "traced")
+	tt := tester.New(t, a)
+	tt.Get("/traced", nil).AddHeader("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01").Expect("traced")
+	if len(spans) != 3 {
+		t.Fatalf("expecting 3 spans, got %d", len(spans))
+	}
+	sp := spans[2]
+	for _, v := range spans[:2] {
+		if v.Context.TraceID != sp.Context.TraceID || v.Parent != sp.Context.SpanID {
+			t.Errorf("span %s is not a child of the request span", v.Name)
+		}
+	}
+	if spans[0].Name != "cache GET" || spans[1].Name != "background" {
+		t.Errorf("unexpected spans %s and %s", spans[0].Name, spans[1].Name)
+	}
+	if sp.Name != "GET traced" || sp.Kind != tracing.Server || sp.Attributes["http.status_code"] != 200 {
+		t.Errorf("unexpected span %+v", sp)
+	}
+	if sp.Context.TraceID.String() != "4bf92f3577b34da6a3ce929d0e0e4736" || sp.Parent.String() != "00f067aa0ba902b7" {
+		t.Errorf("span does not continue the incoming trace %+v", sp.Context)
+	}
+}
+
+func TestListenFd(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	f, err := ln.(*net.TCPListener).File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("GONDOLA_LISTEN_FD", strconv.Itoa(int(f.Fd())))
+	defer os.Setenv("GONDOLA_LISTEN_FD", "")
+	a := app.New()
+	a.Handle("^/$", func(ctx *app.Context) {
+		ctx.WriteString("inherited")
+	})
+	go a.ListenAndServe()
+	resp, err := http.Get("http://" + ln.Addr().String() + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "inherited" {
+		t.Errorf("expecting response \"inherited\", got %q", string(data))
+	}
+	if fd := os.Getenv("GONDOLA_LISTEN_FD"); fd != "" {
+		t.Errorf("GONDOLA_LISTEN_FD should have been cleared, it's %q", fd)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	a := app.New()
+	a.Config().MaxRequestBodySize = 8
+	a.Config().MaxResponseSize = 8
+	echo := func(ctx *app.Context) {
+		data, err := ioutil.ReadAll(ctx.R.Body)
+		if err != nil {
+			return
+		}
+		ctx.Write(data)
+	}
+	a.Handle("^/echo$", echo)
+	a.HandleOptions("^/unlimited$", echo, &app.HandlerOptions{MaxRequestBodySize: -1, MaxResponseSize: -1})
+	tt := tester.New(t, a)
+	tt.Post("/echo", "12345678").Expect(200).Expect("12345678")
+	tt.Post("/echo", "123456789").Expect(413)
+	tt.Post("/echo", "123456789").AddHeader("Accept", "application/json").Expect(413).Contains(`"limit":8`)
+	tt.Post("/unlimited", "123456789").Expect(200).Expect("123456789")
+}
+
+func TestLimitErrorHeaders(t *testing.T) {
+	a := app.New()
+	a.Logger = nil
+	a.Config().MaxResponseSize = 8
+	a.SetErrorHandler(func(ctx *app.Context, message string, code int) bool {
+		ctx.WriteHeader(code)
+		ctx.WriteString(message)
+		return true
+	})
+	a.Handle("^/$", func(ctx *app.Context) {
+		ctx.Header().Set("Content-Type", "application/x-gzip")
+		ctx.Header().Set("Content-Encoding", "gzip")
+		ctx.Header().Set("Content-Length", "9")
+		ctx.WriteString("123456789")
+	})
+	// The headers set by the handler must not be sent with the error
+	tt := tester.New(t, a)
+	tt.Get("/", nil).Expect(500).
+		ExpectHeader("Content-Type", "text/plain; charset=utf-8").
+		ExpectHeader("Content-Encoding", "").
+		ExpectHeader("Content-Length", "")
+}
+
+func TestHeaderSizeLimit(t *testing.T) {
+	a := app.New()
+	a.Config().MaxHeaderSize = 256
+	processed := 0
+	a.AddContextProcessor(func(ctx *app.Context) bool {
+		processed++
+		return false
+	})
+	a.Handle("^/$", func(ctx *app.Context) {
+		ctx.WriteString("ok")
+	})
+	tt := tester.New(t, a)
+	tt.Get("/", nil).Expect(200).Expect("ok")
+	if processed != 1 {
+		t.Errorf("expecting 1 processed request, got %d", processed)
+	}
+	processed = 0
+	tt.Get("/", nil).AddHeader("X-Large", strings.Repeat("x", 512)).Expect(431)
+	if processed != 0 {
+		t.Errorf("context processors ran %d times for a rejected request", processed)
+	}
+}
+
+func TestLimitsHijack(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	a := app.New()
+	a.Config().MaxResponseSize = 8
+	a.Handle("^/$", func(ctx *app.Context) {
+		if _, ok := ctx.ResponseWriter.(http.CloseNotifier); !ok {
+			t.Error("http.CloseNotifier is not implemented by the limited response")
+		}
+		hj, ok := ctx.ResponseWriter.(http.Hijacker)
+		if !ok {
+			t.Error("http.Hijacker is not implemented by the limited response")
+			return
+		}
+		conn, _, err := hj.Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		conn.Write([]byte("HTTP/1.1 200 OK\r\nContent-Length: 10\r\nConnection: close\r\n\r\nhijacked!!"))
+	})
+	go http.Serve(ln, a)
+	resp, err := http.Get("http://" + ln.Addr().String() + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "hijacked!!" {
+		t.Errorf("expecting response \"hijacked!!\", got %q", string(data))
+	}
+}
+
+func TestAppGo(t *testing.T) {
+	a := app.New()
+	a.Logger = nil
+	started := make(chan struct{})
+	a.Go(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+	})
+	a.Go(func(ctx context.Context) {
+		panic("recovered")
+	})
+	<-started
+	if err := a.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if c := a.BackgroundCount(); c != 0 {
+		t.Errorf("expecting 0 background goroutines after Shutdown, got %d", c)
+	}
+}
+
+func TestContextHooks(t *testing.T) {
+	a := app.New()
+	var calls []string
+	served := make(chan struct{})
+	done := make(chan struct{})
+	a.Handle("^/$", func(ctx *app.Context) {
+		ctx.Defer(func() { calls = append(calls, "first") })
+		ctx.Defer(func() {
+			// Hooks must not delay the response
+			select {
+			case <-served:
+			case <-time.After(5 * time.Second):
+				t.Error("hooks ran before the response was sent")
+			}
+			calls = append(calls, "second")
+		})
+		ctx.OnFinish(func(status int, bytes int64) {
+			calls = append(calls, fmt.Sprintf("finish %d %d", status, bytes))
+			close(done)
+		})
+		ctx.WriteString("Hello world")
+	})
+	tt := tester.New(t, a)
+	tt.Get("/", nil).Expect("Hello world")
+	close(served)
+	<-done
+	if s := strings.Join(calls, ", "); s != "second, first, finish 200 11" {
+		t.Errorf("unexpected hook calls %q", s)
+	}
+}
+
+func TestErrorPages(t *testing.T) {
+	a := app.New()
+	a.Handle("^/missing$", func(ctx *app.Context) {
+		ctx.NotFound("nothing here")
+	})
+	tt := tester.New(t, a)
+	tt.Get("/missing", nil).AddHeader("Accept", "text/html").Expect(404).Contains("404 Not Found").Contains("nothing here")
+	tt.Get("/missing", nil).AddHeader("Accept", "application/json").Expect(404).Contains(`"message":"nothing here"`).Contains(`"request_id"`)
+	tt.Get("/missing", nil).AddHeader("Accept", "text/plain").Expect(404).Expect("nothing here\n")
+}
+
+func TestNormalizePolicy(t *testing.T) {
+	a := app.New()
+	a.SetAppendSlash(false)
+	hello := func(ctx *app.Context) {
+		ctx.WriteString("Hello world")
+	}
+	a.Handle("^/foo/bar/$", hello)
+	a.HandleOptions("^/strict$", hello, &app.HandlerOptions{Normalize: &app.NormalizePolicy{}})
+	tt := tester.New(t, a)
+	tt.Get("/foo//bar/", nil).Expect(404)
+	tt.Get("/Strict", nil).Expect(404)
+	a.SetNormalizePolicy(app.NormalizePolicy{
+		TrailingSlash:    app.NormalizeRedirect,
+		DuplicateSlashes: app.NormalizeRewrite,
+		Case:             app.NormalizeRewrite,
+	})
+	tt.Get("/foo//bar/", nil).Expect("Hello world")
+	tt.Get("/Foo/Bar/", nil).Expect("Hello world")
+	tt.Get("/foo/bar", nil).Expect(301).ExpectHeader("Location", "/foo/bar/")
+	tt.Post("/Foo//bar", nil).Expect(308).ExpectHeader("Location", "/foo/bar/")
+	tt.Get("/Strict", nil).Expect(404)
+}
+
+func TestAutoHeadOptions(t *testing.T) {
+	a := app.New()
+	a.Handle("^/hello$", func(ctx *app.Context) {
+		ctx.WriteString("Hello world")
+	})
+	a.HandleOptions("^/manual$", func(ctx *app.Context) {
+		ctx.WriteString(ctx.R.Method)
+	}, &app.HandlerOptions{NoAutoOptions: true})
+	tt := tester.New(t, a)
+	tt.Request("HEAD", "/hello", nil).Expect(200).ExpectHeader("Content-Length", "11").Expect("")
+	tt.Request("OPTIONS", "/hello", nil).Expect(204).ExpectHeader("Allow", "DELETE, GET, HEAD, OPTIONS, PATCH, POST, PUT")
+	tt.Request("OPTIONS", "/manual", nil).Expect("OPTIONS")
+}
+
+func TestMethodRouting(t *testing.T) {
+	a := app.New()
+	a.GET("^/item$", func(ctx *app.Context) {
+		ctx.WriteString("get")
+	})
+	a.POST("^/item$", func(ctx *app.Context) {
+		ctx.WriteString("post")
+	})
+	tt := tester.New(t, a)
+	tt.Get("/item", nil).Expect("get")
+	tt.Post("/item", nil).Expect("post")
+	tt.Request("HEAD", "/item", nil).Expect(200).ExpectHeader("Content-Length", "3")
+	tt.Request("DELETE", "/item", nil).Expect(405).ExpectHeader("Allow", "GET, HEAD, OPTIONS, POST")
+	tt.Request("OPTIONS", "/item", nil).Expect(204).ExpectHeader("Allow", "GET, HEAD, OPTIONS, POST")
+}
+
+func TestSignedValues(t *testing.T) {
+	a := app.New()
+	a.Config().Secret = strings.Repeat("s", 32)
+	a.Handle("^/confirm$", func(ctx *app.Context) {
+		var email string
+		if err := ctx.GetSignedParam("confirm", &email); err != nil {
+			ctx.WriteHeader(http.StatusBadRequest)
+			ctx.WriteString(err.Error())
+			return
+		}
+		ctx.WriteString(email)
+	})
+	a.Handle("^/cookie$", func(ctx *app.Context) {
+		var email string
+		if err := ctx.GetSigned("confirm", &email); err != nil {
+			ctx.WriteHeader(http.StatusBadRequest)
+			ctx.WriteString(err.Error())
+			return
+		}
+		ctx.WriteString(email)
+	})
+	token, err := a.Sign("confirm", "foo@example.com", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tt := tester.New(t, a)
+	tt.Get("/confirm?confirm="+token, nil).Expect(200).Expect("foo@example.com")
+	tt.Get("/cookie", nil).AddHeader("Cookie", "confirm="+token).Expect(200).Expect("foo@example.com")
+	// Cookies must not be overridden by request parameters
+	tt.Get("/cookie?confirm="+token, nil).Expect(400).Contains(app.ErrNoSignedValue.Error())
+	tt.Get("/confirm?confirm="+token+"x", nil).Expect(400)
+	tt.Get("/confirm", nil).Expect(400)
+	// Values signed for another purpose must be rejected
+	other, err := a.Sign("unsubscribe", "foo@example.com", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tt.Get("/confirm?confirm="+other, nil).Expect(400)
+	expired, err := a.Sign("confirm", "foo@example.com", time.Nanosecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var email string
+	if err := a.Unsign("confirm", expired, &email); err != app.ErrSignedExpired {
+		t.Errorf("expecting ErrSignedExpired, got %v", err)
+	}
+}
+
+func TestSecretRotation(t *testing.T) {
+	oldSecret := strings.Repeat("o", 32)
+	a := app.New()
+	a.Config().Secret = oldSecret
+	token, err := a.Sign("rotation", 42, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Config().Secret = strings.Repeat("n", 32)
+	var value int
+	if err := a.Unsign("rotation", token, &value); err == nil {
+		t.Error("expecting an error when verifying with a different secret")
+	}
+	a.Config().OldSecrets = []string{oldSecret}
+	if err := a.Unsign("rotation", token, &value); err != nil || value != 42 {
+		t.Errorf("expecting 42 when verifying with an old secret, got %v (%v)", value, err)
+	}
+	// New values must be signed with the current secret
+	token, err = a.Sign("rotation", 43, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Config().OldSecrets = nil
+	if err := a.Unsign("rotation", token, &value); err != nil || value != 43 {
+		t.Errorf("expecting 43 when verifying with the current secret, got %v (%v)", value, err)
+	}
+}
+
+func TestEncryptionKeyRotation(t *testing.T) {
+	salt := []byte("encryption-rotation")
+	oldSecret := strings.Repeat("o", 32)
+	oldKey := strings.Repeat("k", 32)
+	a := app.New()
+	a.Config().Secret = oldSecret
+	a.Config().EncryptionKey = oldKey
+	es, err := a.EncryptSigner(salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := es.EncryptSign([]byte("gondola"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Config().Secret = strings.Repeat("n", 32)
+	a.Config().OldSecrets = []string{oldSecret}
+	a.Config().EncryptionKey = strings.Repeat("e", 32)
+	es, err = a.EncryptSigner(salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dec, err := es.UnsignDecrypt(enc); err == nil && string(dec) == "gondola" {
+		t.Error("expecting an error when decrypting without the old encryption key")
+	}
+	a.Config().OldEncryptionKeys = []string{oldKey}
+	es, err = a.EncryptSigner(salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dec, err := es.UnsignDecrypt(enc); err != nil || string(dec) != "gondola" {
+		t.Errorf("expecting gondola when decrypting with an old key, got %q (%v)", dec, err)
+	}
+	// New values must be encrypted with the current key
+	enc, err = es.EncryptSign([]byte("gondola2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Config().OldSecrets = nil
+	a.Config().OldEncryptionKeys = nil
+	es, err = a.EncryptSigner(salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dec, err := es.UnsignDecrypt(enc); err != nil || string(dec) != "gondola2" {
+		t.Errorf("expecting gondola2 when decrypting with the current key, got %q (%v)", dec, err)
+	}
+}
+
+func TestRecordRequests(t *testing.T) {
+	dir, err := ioutil.TempDir("", "record-requests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a := app.New()
+	a.Logger = nil
+	a.Config().RecordRequests = dir
+	a.Handle("^/fail$", func(ctx *app.Context) {
+		ctx.WriteHeader(http.StatusInternalServerError)
+		ctx.WriteString("failed")
+	})
+	a.Handle("^/ok$", func(ctx *app.Context) {
+		ctx.WriteString("ok")
+	})
+	tt := tester.New(t, a)
+	tt.Post("/ok", "body").Expect(200)
+	tt.Post("/fail?a=b", "the body").AddHeader("X-Foo", "bar").Expect(500)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expecting 1 recorded request, got %d", len(files))
+	}
+	f, err := os.Open(filepath.Join(dir, files[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	rec, err := app.ReadRecordedRequest(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Method != "POST" || rec.RequestURI != "/fail?a=b" || rec.StatusCode != 500 || string(rec.Body) != "the body" || rec.Header.Get("X-Foo") != "bar" {
+		t.Errorf("unexpected recorded request %+v", rec)
+	}
+	req, err := rec.Request()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.URL.Path != "/fail" || req.URL.Query().Get("a") != "b" {
+		t.Errorf("unexpected replayed request URL %s", req.URL)
+	}
+}
+
+func TestRecordRequestsRedacted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "record-requests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a := app.New()
+	a.Logger = nil
+	a.Config().RecordRequests = dir
+	a.Handle("^/fail$", func(ctx *app.Context) {
+		ctx.WriteHeader(http.StatusInternalServerError)
+	})
+	tt := tester.New(t, a)
+	tt.Form("/fail?access_token=foo&a=b", map[string]interface{}{"Username": "bar", "Password": "baz"}).
+		AddHeader("Authorization", "Bearer foo").
+		AddHeader("Cookie", "session=foo").
+		AddHeader("X-Foo", "bar").
+		Expect(500)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expecting 1 recorded request, got %d", len(files))
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, files[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte("foo")) || bytes.Contains(data, []byte("baz")) {
+		t.Errorf("recorded request contains credentials: %s", string(data))
+	}
+	rec, err := app.ReadRecordedRequest(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Header.Get("X-Foo") != "bar" || rec.Header.Get("Authorization") == "" {
+		t.Errorf("unexpected recorded headers %v", rec.Header)
+	}
+	if rec.RequestURI != "/fail?access_token=%5BREDACTED%5D&a=b" {
+		t.Errorf("unexpected recorded request URI %q", rec.RequestURI)
+	}
+	if !bytes.Contains(rec.Body, []byte("Username=bar")) {
+		t.Errorf("unexpected recorded body %q", string(rec.Body))
+	}
+}
+
+func TestACL(t *testing.T) {
+	a := app.New()
+	a.SetTrustXHeaders(true)
+	a.AddACL("/admin", app.MustACL([]string{"10.8.0.0/16"}, []string{"10.8.1.1"}))
+	a.Handle("^/admin/", func(ctx *app.Context) {
+		ctx.WriteString("admin")
+	})
+	a.HandleOptions("^/internal$", func(ctx *app.Context) {
+		ctx.WriteString("internal")
+	}, &app.HandlerOptions{ACL: app.MustACL([]string{"127.0.0.1", "::1"}, nil)})
+	a.Handle("^/administrator$", func(ctx *app.Context) {
+		ctx.WriteString("public")
+	})
+	tt := tester.New(t, a)
+	tt.Get("/admin/users", nil).AddHeader("X-Real-IP", "10.8.2.3").Expect("admin")
+	tt.Get("/admin/users", nil).AddHeader("X-Real-IP", "10.8.1.1").Expect(403)
+	tt.Get("/admin/users", nil).AddHeader("X-Real-IP", "8.8.8.8").Expect(403)
+	tt.Get("/administrator", nil).AddHeader("X-Real-IP", "8.8.8.8").Expect("public")
+	// Paths which reach the handler after being normalized
+	a.SetNormalizePolicy(app.NormalizePolicy{Case: app.NormalizeRewrite, DuplicateSlashes: app.NormalizeRewrite})
+	tt.Get("/Admin/users", nil).AddHeader("X-Real-IP", "10.8.2.3").Expect("admin")
+	tt.Get("/Admin/users", nil).AddHeader("X-Real-IP", "8.8.8.8").Expect(403)
+	tt.Get("/admin//users", nil).AddHeader("X-Real-IP", "8.8.8.8").Expect(403)
+	tt.Get("/foo/../admin/users", nil).AddHeader("X-Real-IP", "8.8.8.8").Expect(403)
+	tt.Get("/internal", nil).AddHeader("X-Real-IP", "127.0.0.1").Expect("internal")
+	tt.Get("/internal", nil).AddHeader("X-Real-IP", "10.8.2.3").Expect(403)
+	if _, err := app.NewACL([]string{"10.0.0.0/33"}, nil); err == nil {
+		t.Error("expecting an error with an invalid network")
+	}
+}
+
+func TestMaintenance(t *testing.T) {
+	dir, err := ioutil.TempDir("", "maintenance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a := app.New()
+	a.Handle("^/", func(ctx *app.Context) {
+		ctx.WriteString("ok")
+	})
+	a.AddMaintenanceException("/health")
+	tt := tester.New(t, a)
+	tt.Get("/", nil).Expect("ok")
+	a.SetMaintenance(true)
+	tt.Get("/", nil).Expect(503).ExpectHeader("Retry-After", "300")
+	tt.Get("/health", nil).Expect("ok")
+	a.SetMaintenance(false)
+	tt.Get("/", nil).Expect("ok")
+	a2 := app.New()
+	a2.Handle("^/", func(ctx *app.Context) {
+		ctx.WriteString("ok")
+	})
+	a2.Config().MaintenanceFile = filepath.Join(dir, "maintenance")
+	a2.Config().MaintenanceRetryAfter = 60
+	if err := ioutil.WriteFile(a2.Config().MaintenanceFile, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	tt2 := tester.New(t, a2)
+	tt2.Get("/", nil).Expect(503).ExpectHeader("Retry-After", "60")
+}
+
+func TestCacheDirectives(t *testing.T) {
+	a := app.New()
+	a.HandleOptions("^/public$", func(ctx *app.Context) {
+		ctx.WriteString("public")
+	}, &app.HandlerOptions{Cache: app.CacheControl(5*time.Minute, true).SurrogateControl(time.Hour)})
+	a.HandleOptions("^/private$", func(ctx *app.Context) {
+		ctx.WriteString("private")
+	}, &app.HandlerOptions{Cache: app.CacheControl(time.Minute, false)})
+	a.HandleOptions("^/secret$", func(ctx *app.Context) {
+		ctx.WriteString("secret")
+	}, &app.HandlerOptions{Cache: app.NoStore()})
+	a.HandleOptions("^/missing$", func(ctx *app.Context) {
+		ctx.NotFound("missing")
+	}, &app.HandlerOptions{Cache: app.CacheControl(time.Hour, true)})
+	tt := tester.New(t, a)
+	tt.Get("/public", nil).Expect("public").ExpectHeader("Cache-Control", "public, max-age=300").ExpectHeader("Surrogate-Control", "max-age=3600")
+	tt.Get("/private", nil).Expect("private").ExpectHeader("Cache-Control", "private, max-age=60").ExpectHeader("Surrogate-Control", "")
+	tt.Get("/secret", nil).Expect("secret").ExpectHeader("Cache-Control", "no-store")
+	tt.Get("/missing", nil).Expect(404).ExpectHeader("Cache-Control", "")
+}
+
+func TestMethodOverride(t *testing.T) {
+	a := app.New()
+	a.PUT("^/item$", func(ctx *app.Context) {
+		fmt.Fprintf(ctx, "put %s %s", ctx.FormValue("name"), ctx.OriginalMethod())
+	})
+	a.DELETE("^/item$", func(ctx *app.Context) {
+		ctx.WriteString("delete")
+	})
+	a.POST("^/item$", func(ctx *app.Context) {
+		ctx.WriteString("post")
+	})
+	tt := tester.New(t, a)
+	// Disabled by default
+	tt.Form("/item", map[string]interface{}{"_method": "PUT"}).Expect("post")
+	a.SetMethodOverride(true)
+	tt.Form("/item", map[string]interface{}{"_method": "put", "name": "foo"}).Expect("put foo POST")
+	tt.Post("/item", nil).AddHeader("X-HTTP-Method-Override", "DELETE").Expect("delete")
+	tt.Form("/item", map[string]interface{}{"_method": "GET"}).Expect("post")
+	tt.Request("PUT", "/item", "name=bar").AddHeader("Content-Type", "application/x-www-form-urlencoded").Expect("put bar PUT")
+	a.SetMethodOverride(false)
+	tt.Form("/item", map[string]interface{}{"_method": "PUT"}).Expect("post")
+}
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"gnd.la/metrics"
+)
+
+var (
+	backgroundGoroutines = metrics.NewGauge("gondola_app_background_goroutines",
+		"Number of goroutines started with App.Go which are still running")
+)
+
+// background tracks the goroutines started with App.Go.
+type background struct {
+	mu     sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	count  int64
+}
+
+// context returns the context passed to the goroutines, initializing
+// it if required.
+func (b *background) context() context.Context {
+	b.mu.Lock()
+	if b.ctx == nil {
+		b.ctx, b.cancel = context.WithCancel(context.Background())
+	}
+	ctx := b.ctx
+	b.mu.Unlock()
+	return ctx
+}
+
+// stop cancels the context passed to the goroutines.
+func (b *background) stop() {
+	b.context()
+	b.mu.Lock()
+	b.cancel()
+	b.mu.Unlock()
+}
+
+// Go runs f in a new goroutine tied to the App lifecycle. The
+// context received by f is canceled when the App is shut down
+// (see App.Shutdown), so long running functions should return
+// once it's done. Panics in f are recovered, logged and sent to
+// the ErrorReporters, rather than crashing the whole process.
+// Handlers should use Go instead of starting goroutines on their
+// own when the work might outlive the request. To run a function
+// which needs to access the *Context, use Context.Go instead.
+func (app *App) Go(f func(ctx context.Context)) {
+	ctx := app.bg.context()
+	app.bg.wg.Add(1)
+	atomic.AddInt64(&app.bg.count, 1)
+	backgroundGoroutines.Inc()
+	go func() {
+		defer func() {
+			backgroundGoroutines.Dec()
+			atomic.AddInt64(&app.bg.count, -1)
+			app.bg.wg.Done()
+		}()
+		defer app.recoverBackground()
+		f(ctx)
+	}()
+}
+
+// BackgroundCount returns the number of goroutines started
+// with App.Go which haven't finished yet.
+func (app *App) BackgroundCount() int {
+	return int(atomic.LoadInt64(&app.bg.count))
+}
+
+// Shutdown stops the App. It stops accepting new connections (when
+// the App was started with ListenAndServe), waits for the in-flight
+// requests, cancels the context passed to the functions started
+// with App.Go and waits for them to return. If ctx is done before
+// everything finishes, Shutdown returns its error.
+func (app *App) Shutdown(ctx context.Context) error {
+	app.mu.Lock()
+	server := app.server
+	app.mu.Unlock()
+	if server != nil {
+		if err := server.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
+	app.bg.stop()
+	done := make(chan struct{})
+	go func() {
+		app.bg.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// recoverBackground recovers panics in goroutines started with
+// App.Go, logging them and sending them to the ErrorReporters.
+func (app *App) recoverBackground() {
+	if err := recover(); err != nil {
+		if app.Logger != nil {
+			app.Logger.Errorf("panic in background goroutine: %v", err)
+		}
+		ctx := &Context{app: app}
+		app.reportError(ctx, err, true, http.StatusInternalServerError, 3, "")
+	}
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"strconv"
+	"time"
+)
+
+// CacheDirectives represent the caching headers sent with the
+// responses from a Handler, both for browsers (Cache-Control) and
+// for CDNs and other intermediate caches (Surrogate-Control). Use
+// CacheControl, NoStore or SurrogateControl to create them and set
+// them in HandlerOptions.Cache, rather than setting these headers
+// manually in every Handler. e.g.
+//
+//	a.HandleOptions("^/articles/(\\d+)/$", ArticleHandler, &app.HandlerOptions{
+//		Cache: app.CacheControl(5*time.Minute, true).SurrogateControl(time.Hour),
+//	})
+//
+// The headers are set before the Handler runs, so it might still
+// change them. They're removed when the Handler responds with an
+// error (e.g. Context.NotFound or a panic), since error responses
+// must not be cached with the same policy as successful ones.
+type CacheDirectives struct {
+	cacheControl     string
+	surrogateControl string
+}
+
+// CacheControl returns CacheDirectives which allow caching the
+// responses for up to maxAge. If public is true, the responses might
+// be stored by shared caches. Otherwise, they're marked as private
+// and only the browser caches them.
+func CacheControl(maxAge time.Duration, public bool) *CacheDirectives {
+	value := "private"
+	if public {
+		value = "public"
+	}
+	return &CacheDirectives{cacheControl: value + ", max-age=" + cacheSeconds(maxAge)}
+}
+
+// NoStore returns CacheDirectives which forbid storing the
+// responses in any cache.
+func NoStore() *CacheDirectives {
+	return &CacheDirectives{cacheControl: "no-store"}
+}
+
+// SurrogateControl returns CacheDirectives which only set the
+// Surrogate-Control header, allowing CDNs to cache the responses
+// for up to maxAge without changing the Cache-Control header.
+func SurrogateControl(maxAge time.Duration) *CacheDirectives {
+	return (&CacheDirectives{}).SurrogateControl(maxAge)
+}
+
+// SurrogateControl returns a copy of the CacheDirectives which also
+// lets CDNs cache the responses for up to maxAge, independently of
+// the maximum age for browsers.
+func (d *CacheDirectives) SurrogateControl(maxAge time.Duration) *CacheDirectives {
+	cp := *d
+	cp.surrogateControl = "max-age=" + cacheSeconds(maxAge)
+	return &cp
+}
+
+// CacheControlValue returns the value for the Cache-Control
+// header, or an empty string if it's not set.
+func (d *CacheDirectives) CacheControlValue() string {
+	return d.cacheControl
+}
+
+// SurrogateControlValue returns the value for the
+// Surrogate-Control header, or an empty string if
+// it's not set.
+func (d *CacheDirectives) SurrogateControlValue() string {
+	return d.surrogateControl
+}
+
+func (d *CacheDirectives) apply(ctx *Context) {
+	h := ctx.Header()
+	if d.cacheControl != "" {
+		h.Set("Cache-Control", d.cacheControl)
+	}
+	if d.surrogateControl != "" {
+		h.Set("Surrogate-Control", d.surrogateControl)
+	}
+	ctx.cacheDirectives = d
+}
+
+// removeCacheDirectives removes the headers set by the
+// CacheDirectives for the current handler, if any.
+func removeCacheDirectives(ctx *Context) {
+	if d := ctx.cacheDirectives; d != nil {
+		h := ctx.Header()
+		if d.cacheControl != "" {
+			h.Del("Cache-Control")
+		}
+		if d.surrogateControl != "" {
+			h.Del("Surrogate-Control")
+		}
+		ctx.cacheDirectives = nil
+	}
+}
+
+func cacheSeconds(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	return strconv.FormatInt(int64(d/time.Second), 10)
+}
@@ -15,6 +15,20 @@ type Config struct {
 	// are not bundled and templates are recompiled each
 	// time they are loaded.
 	TemplateDebug bool `help:"Enable template debug mode. This disables asset bundling and template caching"`
+	// TemplateCacheDir, if non-empty, indicates a directory where
+	// the results of the template asset pipeline and the compiled
+	// templates are persisted, so they're reused after restarting
+	// the app. It's ignored when TemplateDebug is enabled. See
+	// gnd.la/template.Template.CacheDir.
+	TemplateCacheDir string `help:"Directory for persisting compiled templates and their assets across restarts"`
+	// TemplateMaxDepth, TemplateMaxIterations and TemplateTimeout limit
+	// the nesting of template calls, the number of loop iterations and
+	// the time in milliseconds spent rendering each template, so a bug
+	// in a template produces an error instead of hanging the request.
+	// See gnd.la/template.Template.MaxDepth, MaxIterations and Timeout.
+	TemplateMaxDepth      int `help:"Maximum nesting of template calls, 0 for the default"`
+	TemplateMaxIterations int `help:"Maximum number of loop iterations when rendering a template, 0 for no limit"`
+	TemplateTimeout       int `help:"Maximum time in milliseconds for rendering a template, 0 for no limit"`
 	// Language indicates the language used for
 	// translating strings when there's no LanguageHandler
 	// or when it returns an empty string.
@@ -28,16 +42,73 @@ type Config struct {
 	// which is used for signed cookies. It should be a
 	// random string with at least 32 characters.
 	// You can use gondola random-string to generate one.
-	Secret string `help:"Secret used for, among other things, hashing cookies"`
+	Secret string `help:"Secret used for, among other things, hashing cookies" secret:"true"`
+	// OldSecrets are previous values of Secret, which are still
+	// accepted when verifying signed values (e.g. signed cookies,
+	// values returned by App.Sign or CSRF tokens) but never used
+	// for signing. To rotate the secret, move the current one to
+	// the start of OldSecrets and set a new Secret. Once all the
+	// values signed with an old secret have expired, it can be
+	// removed from the list.
+	OldSecrets []string `help:"Previous secrets, only used for verifying signed values" secret:"true"`
 	// EncriptionKey is the encryption key for used by the
 	// app for, among other things, encrypted cookies. It should
 	// be a random string of 16 or 24 or 32 characters.
-	EncryptionKey string `help:"Key used for encryption (e.g. encrypted cookies)"`
+	EncryptionKey string `help:"Key used for encryption (e.g. encrypted cookies)" secret:"true"`
+	// OldEncryptionKeys are previous values of EncryptionKey, which
+	// are still used for decrypting signed and encrypted values (e.g.
+	// encrypted cookies) but never for encrypting. Since the encrypted
+	// data doesn't indicate its key, each one is only used for values
+	// signed with the secret at the same position in OldSecrets, so
+	// both must be rotated at the same time.
+	OldEncryptionKeys []string `help:"Previous encryption keys, only used for decrypting values" secret:"true"`
+	// Release identifies the deployed code (e.g. the commit id)
+	// while Version indicates the version of the app. Both values
+	// are included in the error reports. See gnd.la/app/report.
+	Release string `help:"Release identifier (e.g. commit id) included in error reports"`
+	Version string `help:"App version included in error reports"`
+	// Tracing configures the exporter for distributed tracing.
+	// See gnd.la/tracing.Configure for its format.
+	Tracing *config.URL `help:"Tracing collector (e.g. otlp://localhost:4318?service=myapp&sample=0.5)"`
+	// MaxRequestBodySize, MaxHeaderSize and MaxResponseSize limit
+	// the size in bytes of the request body, the request headers and
+	// the response. Requests exceeding them receive a 413 or a 431
+	// response, while responses exceeding the limit are replaced by
+	// a 500 error when nothing has been sent yet. Zero or negative
+	// values disable the limit. See also HandlerOptions and LimitError.
+	MaxRequestBodySize int64 `help:"Maximum size of request bodies in bytes, 0 for no limit"`
+	MaxHeaderSize      int   `help:"Maximum size of request headers in bytes, 0 for no limit"`
+	MaxResponseSize    int64 `help:"Maximum size of responses in bytes, 0 for no limit"`
+	// RecordRequests, if non-empty, makes the app record the requests
+	// which fail with a 5xx error, including their headers and body.
+	// Its value is either a directory where the requests are written
+	// or "blobstore", to store them in the app Blobstore. Recorded
+	// requests can be replayed with the replay-request command. The
+	// credentials in the recordings are redacted by default (see
+	// RecordRedactedHeaders and RecordRedactedFields), but note
+	// that they might still include other sensitive data.
+	RecordRequests string `help:"Directory or \"blobstore\" for recording failed requests, for debugging"`
+	// MaintenanceFile, if non-empty, is a file which puts the app in
+	// maintenance mode while it exists. It can be created and removed
+	// with the maintenance command. See App.Maintenance.
+	MaintenanceFile string `help:"File which enables maintenance mode while it exists"`
+	// MaintenanceRetryAfter is the value in seconds of the Retry-After
+	// header sent while the app is in maintenance mode. If zero, it
+	// defaults to 300.
+	MaintenanceRetryAfter int `help:"Retry-After in seconds for responses in maintenance mode, 0 for the default"`
 }
 
 var (
+	// buildRelease and buildVersion are set by gondola build
+	// using the linker -X flag, and used as the defaults for
+	// Config.Release and Config.Version.
+	buildRelease string
+	buildVersion string
+
 	defaultConfig = Config{
-		Port: 8888,
+		Port:    8888,
+		Release: buildRelease,
+		Version: buildVersion,
 	}
 )
 
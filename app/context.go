@@ -1,11 +1,14 @@
 package app
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -21,6 +24,7 @@ import (
 	"gnd.la/log"
 	"gnd.la/net/urlutil"
 	"gnd.la/orm"
+	"gnd.la/util/stringutil"
 	"gnd.la/util/types"
 )
 
@@ -41,6 +45,7 @@ type Context struct {
 	provider        ContextProvider
 	reProvider      *regexpProvider
 	handlerName     string
+	route           string
 	app             *App
 	statusCode      int
 	started         time.Time
@@ -48,21 +53,46 @@ type Context struct {
 	user            User
 	translations    *table.Table
 	hasTranslations bool
+	language        string
+	hasLanguage     bool
 	background      bool
 	wg              *sync.WaitGroup
 	values          map[string]interface{}
+	written         int64
+	requestID       string
+	deferred        []func()
+	onFinish        []func(int, int64)
+	asyncFinish     bool
+	recording       *recordingBody
+	cacheDirectives *CacheDirectives
+	originalMethod  string
+	requestOrm      *orm.Orm
+	identityMap     *orm.IdentityMap
 }
 
 func (c *Context) reset() {
 	c.ResponseWriter = nil
 	c.R = nil
 	c.statusCode = 0
+	c.route = ""
 	c.started = time.Now()
 	c.cookies = nil
 	c.user = nil
 	c.translations = nil
 	c.hasTranslations = false
+	c.language = ""
+	c.hasLanguage = false
 	c.values = nil
+	c.written = 0
+	c.requestID = ""
+	c.deferred = nil
+	c.onFinish = nil
+	c.asyncFinish = false
+	c.recording = nil
+	c.cacheDirectives = nil
+	c.originalMethod = ""
+	c.requestOrm = nil
+	c.identityMap = nil
 }
 
 // Count returns the number of elements captured
@@ -169,6 +199,35 @@ func (c *Context) MustParseFormValue(name string, arg interface{}) {
 	c.mustParseValue(name, -1, val, arg)
 }
 
+// Bind decodes the JSON request body into obj, which must be a pointer
+// to a struct, and then validates it using the form tags in its fields.
+// If validation fails, the returned error is a gnd.la/form/input.Errors
+// with its messages translated to the current language, which might be
+// directly sent to the client e.g.
+//
+//  if err := ctx.Bind(&req); err != nil {
+//	ctx.WriteHeader(http.StatusBadRequest)
+//	ctx.WriteJSON(map[string]interface{}{"errors": err})
+//	return
+//  }
+//
+// See gnd.la/form/input.ValidateStruct for the validation rules.
+func (c *Context) Bind(obj interface{}) error {
+	if c.R == nil || c.R.Body == nil {
+		return errors.New("request has no body")
+	}
+	if err := json.NewDecoder(c.R.Body).Decode(obj); err != nil {
+		return err
+	}
+	if err := input.ValidateStruct(obj, c); err != nil {
+		if errs, ok := err.(input.Errors); ok {
+			return errs.Translate(c)
+		}
+		return err
+	}
+	return nil
+}
+
 func (c *Context) mustParseValue(name string, idx int, val string, arg interface{}) {
 	if !c.parseTypedValue(val, arg) {
 		t := reflect.TypeOf(arg)
@@ -365,9 +424,14 @@ func (c *Context) Cookies() *cookies.Cookies {
 }
 
 // Cache is a shorthand for ctx.App().Cache(), but panics in case
-// of error, instead of returning it.
+// of error, instead of returning it. When tracing is enabled, the
+// spans for the cache operations are children of the request span.
 func (c *Context) Cache() *cache.Cache {
-	return c.cache()
+	ca := c.cache()
+	if tc := c.traceContext(); tc != nil {
+		ca = ca.WithContext(tc)
+	}
+	return ca
 }
 
 // Blobstore is a shorthand for ctx.App().Blobstore(), but panics in
@@ -377,9 +441,40 @@ func (c *Context) Blobstore() *blobstore.Blobstore {
 }
 
 // Orm is a shorthand for ctx.App().Orm(), but panics in case
-// of error, rather than returning it.
+// of error, rather than returning it. When the App has a UserFunc,
+// the id of the signed in user is recorded as the actor in the
+// audit trail of the changes made with the returned Orm (see
+// gnd.la/orm.Options.Audit).
+//
+// The returned Orm has an identity map scoped to the Context, so
+// repeated lookups of the same object using Orm.Get return the same
+// instance without querying the database. The identity map is
+// cleared when the Context is closed. See gnd.la/orm.IdentityMap.
+// When tracing is enabled, the spans for the queries are children
+// of the request span.
 func (c *Context) Orm() *orm.Orm {
-	return c.orm()
+	if c.requestOrm == nil {
+		c.identityMap = orm.NewIdentityMap()
+		o := c.orm().WithIdentityMap(c.identityMap)
+		if c.app.userFunc != nil {
+			o = o.WithActorFunc(c.ormActor)
+		}
+		if tc := c.traceContext(); tc != nil {
+			o = o.WithContext(tc)
+		}
+		c.requestOrm = o
+	}
+	return c.requestOrm
+}
+
+func (c *Context) ormActor() string {
+	if c.R == nil {
+		return ""
+	}
+	if user := c.User(); user != nil {
+		return strconv.FormatInt(user.Id(), 10)
+	}
+	return ""
 }
 
 // Execute loads the template with the given name using the
@@ -416,6 +511,23 @@ func (c *Context) Elapsed() time.Duration {
 	return time.Since(c.started)
 }
 
+// RequestID returns an identifier for the current request, which is
+// included in the error pages and reports. If the App trusts X headers
+// (see App.SetTrustXHeaders) and the request has an X-Request-Id header,
+// its value is used. Otherwise, a random identifier is generated the
+// first time RequestID is called.
+func (c *Context) RequestID() string {
+	if c.requestID == "" {
+		if c.R != nil && c.app.trustXHeaders {
+			c.requestID = c.R.Header.Get("X-Request-Id")
+		}
+		if c.requestID == "" {
+			c.requestID = stringutil.Random(16)
+		}
+	}
+	return c.requestID
+}
+
 // RemoteAddress returns the remote IP without the port number.
 func (c *Context) RemoteAddress() string {
 	if c.R != nil {
@@ -462,7 +574,9 @@ func (c *Context) IsXHR() bool {
 // It's automatically called by the App, so you
 // don't need to call it manually
 func (c *Context) Close() {
-	// currently a no-op
+	if c.identityMap != nil {
+		c.identityMap.Clear()
+	}
 }
 
 // BackgroundContext returns a copy of the given Context
@@ -477,6 +591,9 @@ func (c *Context) backgroundContext() *Context {
 	ctx.provider = c.provider
 	ctx.reProvider = c.reProvider
 	ctx.ResponseWriter = discard
+	// Keep the language, it might have been changed by SetLanguage
+	ctx.language = c.language
+	ctx.hasLanguage = c.hasLanguage
 	return ctx
 }
 
@@ -604,7 +721,21 @@ func (c *Context) Write(data []byte) (int, error) {
 		// code will be overriden if < 0
 		c.WriteHeader(http.StatusOK)
 	}
-	return c.ResponseWriter.Write(data)
+	n, err := c.ResponseWriter.Write(data)
+	c.written += int64(n)
+	return n, err
+}
+
+// Flush implements http.Flusher, sending any buffered data
+// to the client. It's a no-op if the underlying
+// http.ResponseWriter doesn't support flushing.
+func (c *Context) Flush() {
+	if f, ok := c.ResponseWriter.(http.Flusher); ok {
+		if c.statusCode <= 0 {
+			c.WriteHeader(http.StatusOK)
+		}
+		f.Flush()
+	}
 }
 
 func urlHost(u string) string {
@@ -40,6 +40,10 @@ var (
 
 func (c *Context) error(code int, message string) {
 	c.statusCode = -code
+	if code >= 500 {
+		// Skip error and Error or Errorf
+		c.app.reportError(c, message, false, code, 2, "")
+	}
 	c.app.handleHTTPError(c, message, code)
 }
 
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"context"
+	"net/http"
+)
+
+// Defer schedules f to be called once the response has been sent to
+// the client. Deferred functions run in last-in first-out order, like
+// the Go defer statement, even if the handler panics. They're intended
+// for cleanup work which shouldn't delay the response (e.g. releasing
+// resources acquired by a middleware). Panics in deferred functions
+// are logged and reported, but they don't prevent other deferred
+// functions or OnFinish hooks from running.
+//
+// For requests served by the App, deferred functions and OnFinish hooks
+// run in a goroutine started with App.Go after the request has been
+// served, so they must not use the Context's http.ResponseWriter.
+func (c *Context) Defer(f func()) {
+	c.deferred = append(c.deferred, f)
+}
+
+// OnFinish registers f to be called after the response has been sent
+// to the client, once all the functions registered with Defer have
+// finished. f receives the response status code and the number of
+// bytes written in the response body. Hooks run in the order they
+// were registered and they're intended for post-response work, like
+// recording metrics or populating a write-behind cache.
+func (c *Context) OnFinish(f func(status int, bytes int64)) {
+	c.onFinish = append(c.onFinish, f)
+}
+
+// BytesWritten returns the number of bytes written so far
+// in the response body.
+func (c *Context) BytesWritten() int64 {
+	return c.written
+}
+
+// hasHooks returns true iff there are functions
+// registered with Defer or OnFinish.
+func (c *Context) hasHooks() bool {
+	return len(c.deferred) > 0 || len(c.onFinish) > 0
+}
+
+// finishAsync runs the functions registered with Defer and OnFinish
+// for a request served by ServeHTTP. It must be deferred before any
+// other function in ServeHTTP, so the hooks run after the response
+// has been completely written (including e.g. the toolbar). They run
+// in a goroutine, so they don't delay the end of the response.
+func (c *Context) finishAsync() {
+	if !c.hasHooks() {
+		return
+	}
+	c.ResponseWriter = discard
+	c.app.Go(func(_ context.Context) {
+		c.finish()
+	})
+}
+
+// finish flushes the response and then runs the functions
+// registered with Defer and OnFinish. It's safe to call it
+// more than once, functions run only the first time.
+func (c *Context) finish() {
+	if !c.hasHooks() {
+		return
+	}
+	if c.ResponseWriter != nil {
+		c.Flush()
+	}
+	deferred := c.deferred
+	c.deferred = nil
+	for ii := len(deferred) - 1; ii >= 0; ii-- {
+		c.runHook(func() { deferred[ii]() })
+	}
+	onFinish := c.onFinish
+	c.onFinish = nil
+	status := c.statusCode
+	if status < 0 {
+		status = -status
+	}
+	if status == 0 {
+		status = http.StatusOK
+	}
+	for _, v := range onFinish {
+		f := v
+		c.runHook(func() { f(status, c.written) })
+	}
+}
+
+// runHook runs f, recovering any panics from it. Since the
+// response has been already sent, the error is just logged
+// and reported.
+func (c *Context) runHook(f func()) {
+	defer func() {
+		if err := recover(); err != nil {
+			c.Logger().Errorf("panic running deferred function: %v", err)
+			c.app.reportError(c, err, true, http.StatusInternalServerError, 3, "")
+		}
+	}()
+	f()
+}
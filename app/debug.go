@@ -0,0 +1,161 @@
+package app
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// DebugAuthFunc is used to authorize the requests to
+// the debug handlers. See App.HandleDebug.
+type DebugAuthFunc func(ctx *Context) bool
+
+type runtimeMetrics struct {
+	Goroutines int     `json:"goroutines"`
+	NumCPU     int     `json:"num_cpu"`
+	GOMAXPROCS int     `json:"gomaxprocs"`
+	CgoCalls   int64   `json:"cgo_calls"`
+	Uptime     float64 `json:"uptime"`
+	GC         struct {
+		Count        uint32 `json:"count"`
+		PauseTotalNs uint64 `json:"pause_total_ns"`
+		LastPauseNs  uint64 `json:"last_pause_ns"`
+		LastGC       uint64 `json:"last_gc"`
+		NextGC       uint64 `json:"next_gc"`
+	} `json:"gc"`
+	Heap struct {
+		Alloc    uint64 `json:"alloc"`
+		Sys      uint64 `json:"sys"`
+		Idle     uint64 `json:"idle"`
+		InUse    uint64 `json:"inuse"`
+		Released uint64 `json:"released"`
+		Objects  uint64 `json:"objects"`
+	} `json:"heap"`
+}
+
+func (app *App) runtimeMetrics() *runtimeMetrics {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	m := &runtimeMetrics{
+		Goroutines: runtime.NumGoroutine(),
+		NumCPU:     runtime.NumCPU(),
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		CgoCalls:   runtime.NumCgoCall(),
+	}
+	if !app.started.IsZero() {
+		m.Uptime = time.Since(app.started).Seconds()
+	}
+	m.GC.Count = stats.NumGC
+	m.GC.PauseTotalNs = stats.PauseTotalNs
+	if stats.NumGC > 0 {
+		m.GC.LastPauseNs = stats.PauseNs[(stats.NumGC+255)%256]
+	}
+	m.GC.LastGC = stats.LastGC
+	m.GC.NextGC = stats.NextGC
+	m.Heap.Alloc = stats.HeapAlloc
+	m.Heap.Sys = stats.HeapSys
+	m.Heap.Idle = stats.HeapIdle
+	m.Heap.InUse = stats.HeapInuse
+	m.Heap.Released = stats.HeapReleased
+	m.Heap.Objects = stats.HeapObjects
+	return m
+}
+
+// HandleDebug adds the debug handlers to the App, under the /debug/
+// prefix, and starts collecting the metrics for each route (see
+// App.RouteMetrics). The following handlers are added:
+//
+//  /debug/pprof/ - the same handlers provided by net/http/pprof, without
+//	registering them in http.DefaultServeMux
+//  /debug/vars - the variables published with expvar, the runtime metrics
+//	(goroutines, GC and heap) and the counters for each route, as JSON
+//
+// The auth function is called for every request to the debug handlers
+// and, if it returns false, the request is rejected with a 403 status
+// code. If auth is nil, requests are only allowed when the App is in
+// debug mode or when the current user is an admin. To allow external
+// scrapers, use an auth function which checks the request credentials
+// (e.g. see DebugTokenAuth). Note that handlers are matched in the same
+// order they were added, so HandleDebug should be called before adding
+// any handlers which might also match the /debug/ prefix.
+func (app *App) HandleDebug(auth DebugAuthFunc) {
+	if auth == nil {
+		auth = defaultDebugAuth
+	}
+	protect := func(handler Handler) Handler {
+		return func(ctx *Context) {
+			if !auth(ctx) {
+				ctx.Forbidden()
+				return
+			}
+			handler(ctx)
+		}
+	}
+	app.addPprofHandlers(protect)
+	app.Handle("^/debug/vars$", protect(debugVarsHandler))
+	if app.metrics == nil {
+		app.metrics = &routeCounters{routes: make(map[string]*RouteMetrics)}
+	}
+}
+
+// DebugTokenAuth returns a DebugAuthFunc which authorizes the requests
+// with the given token, sent in the Authorization header as a bearer
+// token (Authorization: Bearer {token}). Tokens in the query string
+// are not accepted, since they would end up in the logs.
+func DebugTokenAuth(token string) DebugAuthFunc {
+	return func(ctx *Context) bool {
+		if token == "" || ctx.R == nil {
+			return false
+		}
+		h := ctx.R.Header.Get("Authorization")
+		if !strings.HasPrefix(h, "Bearer ") {
+			return false
+		}
+		value := strings.TrimSpace(h[len("Bearer "):])
+		return subtle.ConstantTimeCompare([]byte(value), []byte(token)) == 1
+	}
+}
+
+func defaultDebugAuth(ctx *Context) bool {
+	if ctx.app.cfg.Debug {
+		return true
+	}
+	user := ctx.User()
+	return user != nil && user.IsAdmin()
+}
+
+// debugVarsHandler writes the variables published via expvar,
+// using the same format as expvar, plus the runtime metrics and
+// the route counters.
+func debugVarsHandler(ctx *Context) {
+	var buf bytes.Buffer
+	buf.WriteString("{\n")
+	expvar.Do(func(kv expvar.KeyValue) {
+		fmt.Fprintf(&buf, "%q: %s,\n", kv.Key, kv.Value)
+	})
+	for ii, v := range []struct {
+		key   string
+		value interface{}
+	}{
+		{"runtime", ctx.app.runtimeMetrics()},
+		{"routes", ctx.app.RouteMetrics()},
+	} {
+		data, err := json.Marshal(v.value)
+		if err != nil {
+			panic(err)
+		}
+		if ii > 0 {
+			buf.WriteString(",\n")
+		}
+		fmt.Fprintf(&buf, "%q: %s", v.key, data)
+	}
+	buf.WriteString("\n}\n")
+	ctx.Header().Set("Content-Type", "application/json; charset=utf-8")
+	ctx.Header().Set("Cache-Control", "no-cache")
+	ctx.Write(buf.Bytes())
+}
@@ -0,0 +1,181 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// ErrorTemplatesDir is the directory, relative to the templates
+	// VFS, where the App looks for the error page templates. See
+	// App.SetErrorTemplate for the lookup order.
+	ErrorTemplatesDir = "errors"
+)
+
+// ErrorData is the data passed to the templates used for rendering
+// error pages. See App.SetErrorTemplate.
+type ErrorData struct {
+	// Status is the HTTP status code (e.g. 404).
+	Status int
+	// Title is the standard text for the status
+	// code (e.g. "Not Found").
+	Title string
+	// Message is the error message, which might be the same as Title
+	// when the error was not created with a more specific message.
+	Message string
+	// RequestID is the identifier of the request which caused the
+	// error. See Context.RequestID.
+	RequestID string
+}
+
+// SetErrorTemplate sets the template used for rendering the error
+// pages with the given status code. If code is zero, the template is
+// used for any status code without a more specific template.
+//
+// When responding with an error (e.g. Context.NotFound) and the App
+// ErrorHandler doesn't handle it, the App sends a JSON object if the
+// client prefers JSON over HTML (see the Accept header). Otherwise, the
+// template for the error page is looked up in the following order,
+// using the first one which exists:
+//
+//  - The template set with SetErrorTemplate for the code (e.g. 404).
+//  - errors/<code>.html (e.g. errors/404.html).
+//  - errors/<class>.html (e.g. errors/4xx.html).
+//  - The template set with SetErrorTemplate for the code 0.
+//  - errors/error.html.
+//  - The same lookup in the parent App, for included apps.
+//  - The built-in error page.
+//
+// Templates receive an *ErrorData as their data.
+func (app *App) SetErrorTemplate(code int, name string) {
+	if app.errorTemplates == nil {
+		app.errorTemplates = make(map[int]string)
+	}
+	if name == "" {
+		delete(app.errorTemplates, code)
+		return
+	}
+	app.errorTemplates[code] = name
+}
+
+// ErrorTemplate returns the template set with SetErrorTemplate
+// for the given status code, if any.
+func (app *App) ErrorTemplate(code int) string {
+	return app.errorTemplates[code]
+}
+
+// errorTemplateNames returns the candidate template names for
+// the error page with the given status code, in lookup order.
+func (app *App) errorTemplateNames(code int) []string {
+	var names []string
+	if name := app.errorTemplates[code]; name != "" {
+		names = append(names, name)
+	}
+	names = append(names,
+		fmt.Sprintf("%s/%d.html", ErrorTemplatesDir, code),
+		fmt.Sprintf("%s/%dxx.html", ErrorTemplatesDir, code/100),
+	)
+	if name := app.errorTemplates[0]; name != "" {
+		names = append(names, name)
+	}
+	return append(names, ErrorTemplatesDir+"/error.html")
+}
+
+// findErrorTemplate returns the first error page template for the
+// given status code which exists in the App or its parents, or nil
+// if there are none.
+func (app *App) findErrorTemplate(ctx *Context, code int) *Template {
+	for a := app; a != nil; a = a.parent {
+		fs := a.TemplatesFS()
+		for _, name := range a.errorTemplateNames(code) {
+			if _, err := fs.Stat(name); err != nil {
+				continue
+			}
+			tmpl, err := a.LoadTemplate(name)
+			if err != nil {
+				ctx.Logger().Errorf("error loading error template %q: %s", name, err)
+				continue
+			}
+			return tmpl
+		}
+	}
+	return nil
+}
+
+// defaultErrorTemplate returns the built-in error page template.
+func (app *App) defaultErrorTemplate() (*Template, error) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	if app.errorPageTemplate == nil {
+		t := newInternalTemplate(app)
+		if err := t.parse("error_page.html", nil); err != nil {
+			return nil, err
+		}
+		if err := t.prepare(); err != nil {
+			return nil, err
+		}
+		app.errorPageTemplate = t
+	}
+	return app.errorPageTemplate, nil
+}
+
+// writeError sends the error page for the given message and status
+// code, either as JSON or HTML depending on the client preferences.
+func (app *App) writeError(ctx *Context, message string, code int) {
+	if ctx.R == nil {
+		http.Error(ctx, message, code)
+		return
+	}
+	data := &ErrorData{
+		Status:    code,
+		Title:     http.StatusText(code),
+		Message:   message,
+		RequestID: ctx.RequestID(),
+	}
+	if prefersJSON(ctx.R) {
+		ctx.WriteJSON(map[string]interface{}{
+			"error": map[string]interface{}{
+				"status":     data.Status,
+				"message":    data.Message,
+				"request_id": data.RequestID,
+			},
+		})
+		return
+	}
+	if !acceptsHTML(ctx.R) {
+		http.Error(ctx, message, code)
+		return
+	}
+	tmpl := app.findErrorTemplate(ctx, code)
+	if tmpl == nil {
+		var err error
+		if tmpl, err = app.defaultErrorTemplate(); err != nil {
+			ctx.Logger().Errorf("error loading default error template: %s", err)
+			http.Error(ctx, message, code)
+			return
+		}
+	}
+	ctx.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := tmpl.Execute(ctx, data); err != nil {
+		ctx.Logger().Errorf("error executing error template for status %d: %s", code, err)
+		http.Error(ctx, message, code)
+	}
+}
+
+// acceptsHTML returns true iff the client accepts an HTML response,
+// either explicitly or because it doesn't send an Accept header.
+func acceptsHTML(r *http.Request) bool {
+	accept := r.Header.Get("Accept")
+	if accept == "" {
+		return true
+	}
+	for _, v := range strings.Split(accept, ",") {
+		typ := strings.ToLower(strings.TrimSpace(strings.Split(v, ";")[0]))
+		switch typ {
+		case "text/html", "text/*", "*/*":
+			return true
+		}
+	}
+	return false
+}
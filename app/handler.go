@@ -19,6 +19,35 @@ type HandlerOptions struct {
 	// Host specifies the host the Handler will match. If non-empty,
 	// only requests to this specific host will match the Handler.
 	Host string
+	// Methods, if non-empty, restricts the HTTP methods accepted
+	// by the Handler. Requests matching the Handler pattern with
+	// any other method receive a 405 error with an Allow header,
+	// unless another Handler accepts them. See also App.GET,
+	// App.POST, App.PUT, App.DELETE and App.PATCH.
+	Methods []string
+	// MaxRequestBodySize and MaxResponseSize override the
+	// limits set in the App Config for this Handler. Zero
+	// means using the App limit, while a negative value
+	// disables the limit for this Handler.
+	MaxRequestBodySize int64
+	MaxResponseSize    int64
+	// Normalize, if non-nil, overrides the App NormalizePolicy
+	// for this Handler. See App.SetNormalizePolicy.
+	Normalize *NormalizePolicy
+	// NoAutoHead and NoAutoOptions disable the automatic handling of
+	// HEAD and OPTIONS requests for this Handler, so it receives them
+	// like any other request. By default, HEAD requests run the Handler
+	// discarding the response body while setting its Content-Length,
+	// and OPTIONS requests receive an empty response with an Allow header
+	// listing the methods accepted by the Handlers matching the path.
+	NoAutoHead    bool
+	NoAutoOptions bool
+	// ACL, if non-nil, restricts the addresses which can access
+	// this Handler. See ACL and App.AddACL.
+	ACL *ACL
+	// Cache, if non-nil, sets the caching headers for the
+	// responses from this Handler. See CacheDirectives.
+	Cache *CacheDirectives
 }
 
 type HandlerInfo struct {
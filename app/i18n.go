@@ -1,15 +1,70 @@
 package app
 
 import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gnd.la/app/cookies"
 	"gnd.la/i18n"
 	"gnd.la/i18n/table"
 )
 
+const (
+	// LanguageCookieName is the name of the cookie used
+	// for storing the language selected by the user. See
+	// Context.SetPreferredLanguage and NegotiateLanguage.
+	LanguageCookieName = "lang"
+)
+
+// Language returns the language used for the current request. The language is
+// determined the first time this method is called using the App LanguageHandler
+// or, if there's no handler, the App Language. Use SetLanguage to change it.
 func (c *Context) Language() string {
-	if c.app.languageHandler != nil {
-		return c.app.languageHandler(c)
+	if !c.hasLanguage {
+		var lang string
+		if c.app.languageHandler != nil {
+			lang = c.app.languageHandler(c)
+		} else if c.app.cfg != nil {
+			lang = c.app.cfg.Language
+		}
+		c.setLanguage(lang)
+	}
+	return c.language
+}
+
+func (c *Context) setLanguage(lang string) {
+	c.language = lang
+	c.hasLanguage = true
+	c.translations = nil
+	c.hasTranslations = false
+	if lang != "" && c.ResponseWriter != nil {
+		c.Header().Set("Content-Language", HTMLLanguage(lang))
 	}
-	return c.app.cfg.Language
+}
+
+// SetLanguage changes the language used for the remainder of the current
+// request, switching the translation table and the Content-Language header.
+// Note that the header is only sent to the client if nothing has been
+// written to the response yet. To also use the language for subsequent
+// requests, see SetPreferredLanguage.
+func (c *Context) SetLanguage(lang string) {
+	c.setLanguage(lang)
+}
+
+// SetPreferredLanguage works like SetLanguage, but it also stores the language
+// in a cookie, so it's used for subsequent requests when the App uses the
+// LanguageHandler returned by NegotiateLanguage. Passing an empty string
+// removes the cookie.
+func (c *Context) SetPreferredLanguage(lang string) {
+	if lang == "" {
+		c.Cookies().Delete(LanguageCookieName)
+		c.hasLanguage = false
+		return
+	}
+	c.Cookies().SetOpts(LanguageCookieName, lang, &cookies.Options{Path: "/", Expires: cookies.Permanent})
+	c.setLanguage(lang)
 }
 
 func (c *Context) TranslationTable() *table.Table {
@@ -34,3 +89,159 @@ func (c *Context) Tc(context string, str string) string {
 func (c *Context) Tnc(context string, singular string, plural string, n int) string {
 	return i18n.Tnc(c, context, singular, plural, n)
 }
+
+// HTMLLanguage returns the given language code in the format used by the
+// HTML lang attribute and the Content-Language header (e.g. es_ES is
+// returned as es-ES).
+func HTMLLanguage(lang string) string {
+	return strings.Replace(lang, "_", "-", -1)
+}
+
+type acceptedLanguage struct {
+	lang string
+	q    float64
+}
+
+type byQuality []acceptedLanguage
+
+func (b byQuality) Len() int           { return len(b) }
+func (b byQuality) Less(i, j int) bool { return b[i].q > b[j].q }
+func (b byQuality) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+
+// parseAcceptLanguage returns the languages in the given Accept-Language
+// header, sorted by their quality, in the xx or xx_YY format.
+func parseAcceptLanguage(header string) []string {
+	var accepted []acceptedLanguage
+	for _, v := range strings.Split(header, ",") {
+		parts := strings.Split(strings.TrimSpace(v), ";")
+		lang := strings.TrimSpace(parts[0])
+		if lang == "" || lang == "*" {
+			continue
+		}
+		q := 1.0
+		for _, p := range parts[1:] {
+			p = strings.TrimSpace(p)
+			if strings.HasPrefix(p, "q=") {
+				if val, err := strconv.ParseFloat(p[2:], 64); err == nil {
+					q = val
+				}
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		accepted = append(accepted, acceptedLanguage{normalizeLanguageTag(lang), q})
+	}
+	sort.Stable(byQuality(accepted))
+	langs := make([]string, len(accepted))
+	for ii, v := range accepted {
+		langs[ii] = v.lang
+	}
+	return langs
+}
+
+// normalizeLanguageTag converts a BCP 47 language tag to the xx or
+// xx_YY format. Script subtags are skipped (e.g. zh-Hant-TW is
+// returned as zh_TW), as well as any subtags after the region.
+func normalizeLanguageTag(tag string) string {
+	subtags := strings.Split(strings.Replace(tag, "_", "-", -1), "-")
+	lang := strings.ToLower(subtags[0])
+	rest := subtags[1:]
+	if len(rest) > 0 && len(rest[0]) == 4 {
+		// Script (e.g. Hant)
+		rest = rest[1:]
+	}
+	if len(rest) > 0 && len(rest[0]) == 2 {
+		lang += "_" + strings.ToUpper(rest[0])
+	}
+	return lang
+}
+
+// matchLanguage returns the first language in available which matches
+// lang. Exact matches are preferred, then languages with the same
+// language code (e.g. es_ES matches es and es_AR).
+func matchLanguage(lang string, available []string) string {
+	for _, v := range available {
+		if strings.EqualFold(v, lang) {
+			return v
+		}
+	}
+	if len(lang) < 2 {
+		return ""
+	}
+	for _, v := range available {
+		if len(v) >= 2 && strings.EqualFold(v[:2], lang[:2]) {
+			return v
+		}
+	}
+	return ""
+}
+
+// addVary adds the given values to the Vary header,
+// skipping the ones which are already present.
+func addVary(h http.Header, values ...string) {
+	var missing []string
+	for _, v := range values {
+		found := false
+		for _, hv := range h["Vary"] {
+			for _, f := range strings.Split(hv, ",") {
+				if strings.EqualFold(strings.TrimSpace(f), v) {
+					found = true
+				}
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	if len(missing) > 0 {
+		h.Add("Vary", strings.Join(missing, ", "))
+	}
+}
+
+// NegotiateLanguage returns a LanguageHandler which selects the language
+// for each request from the available ones, in the following order:
+//
+//  - The language stored in the cookie set by Context.SetPreferredLanguage.
+//  - The languages in the Accept-Language header, ordered by their quality.
+//  - The App Language.
+//
+// If no languages are provided, the languages with a registered translation
+// table are used, in addition to the App Language. Since the response depends
+// on the request headers, Accept-Language and Cookie are added to the Vary
+// header, so shared caches don't serve a response in the wrong language.
+func NegotiateLanguage(available ...string) LanguageHandler {
+	return func(ctx *Context) string {
+		langs := available
+		var def string
+		if ctx.app.cfg != nil {
+			def = ctx.app.cfg.Language
+		}
+		if len(langs) == 0 {
+			langs = table.Registered()
+			if def != "" {
+				langs = append(langs, def)
+			}
+		}
+		if ctx.R == nil {
+			return def
+		}
+		if ctx.ResponseWriter != nil {
+			// The response depends on these headers, make
+			// sure shared caches don't mix the languages.
+			addVary(ctx.Header(), "Accept-Language", "Cookie")
+		}
+		var preferred string
+		if ctx.Cookies().Get(LanguageCookieName, &preferred) == nil && preferred != "" {
+			if lang := matchLanguage(preferred, langs); lang != "" {
+				return lang
+			}
+		}
+		for _, v := range parseAcceptLanguage(ctx.R.Header.Get("Accept-Language")) {
+			if lang := matchLanguage(v, langs); lang != "" {
+				return lang
+			}
+		}
+		return def
+	}
+}
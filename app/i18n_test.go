@@ -0,0 +1,43 @@
+package app_test
+
+import (
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+)
+
+func TestNegotiateLanguage(t *testing.T) {
+	a := app.New()
+	a.Config().Language = "en"
+	a.SetLanguageHandler(app.NegotiateLanguage("en", "es", "pt_BR"))
+	a.Handle("^/$", func(ctx *app.Context) {
+		ctx.WriteString(ctx.Language())
+	})
+	a.Handle("^/switch$", func(ctx *app.Context) {
+		prev := ctx.Language()
+		// Content-Language can't change once the body is written
+		ctx.SetLanguage("es")
+		ctx.WriteString(prev + " " + ctx.Language())
+	})
+	tt := tester.New(t, a)
+	tt.Get("/", nil).Expect("en").ExpectHeader("Content-Language", "en").ExpectHeader("Vary", "Accept-Language, Cookie")
+	tt.Get("/", nil).AddHeader("Accept-Language", "es-ES,es;q=0.9,en;q=0.8").Expect("es").ExpectHeader("Content-Language", "es")
+	tt.Get("/", nil).AddHeader("Accept-Language", "fr;q=0.9,pt-br;q=0.5,en;q=0.1").Expect("pt_BR").ExpectHeader("Content-Language", "pt-BR")
+	tt.Get("/", nil).AddHeader("Accept-Language", "en;q=0.2,pt;q=0.8").Expect("pt_BR")
+	tt.Get("/", nil).AddHeader("Accept-Language", "de").Expect("en")
+	tt.Get("/switch", nil).Expect("en es").ExpectHeader("Content-Language", "es")
+}
+
+func TestNegotiateLanguageScript(t *testing.T) {
+	a := app.New()
+	a.Config().Language = "en"
+	a.SetLanguageHandler(app.NegotiateLanguage("en", "zh_CN", "zh_TW"))
+	a.Handle("^/$", func(ctx *app.Context) {
+		ctx.WriteString(ctx.Language())
+	})
+	tt := tester.New(t, a)
+	tt.Get("/", nil).AddHeader("Accept-Language", "zh-Hant-TW,en;q=0.5").Expect("zh_TW").ExpectHeader("Content-Language", "zh-TW")
+	tt.Get("/", nil).AddHeader("Accept-Language", "zh-Hans-CN,en;q=0.5").Expect("zh_CN")
+	tt.Get("/", nil).AddHeader("Accept-Language", "zh_TW").Expect("zh_TW")
+}
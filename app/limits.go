@@ -0,0 +1,323 @@
+package app
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// LimitError indicates that a request or its response exceeded one
+// of the size limits configured for the App (see Config) or for the
+// handler (see HandlerOptions). Reading the request body returns a
+// LimitError once its limit has been exceeded. Its status code is 413
+// for request bodies, 431 for request headers and 500 for responses.
+type LimitError struct {
+	// Status is the status code sent to the client.
+	Status int
+	// What indicates what exceeded the limit (e.g. "request body").
+	What string
+	// Limit is the limit which was exceeded, in bytes.
+	Limit int64
+}
+
+func (e *LimitError) StatusCode() int {
+	return e.Status
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s exceeds the limit of %d bytes", e.What, e.Limit)
+}
+
+// limitedBody wraps a request body, returning a *LimitError
+// when more than limit bytes are read from it.
+type limitedBody struct {
+	io.ReadCloser
+	limit     int64
+	remaining int64
+	err       *LimitError
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+	// Read one more byte than allowed, to detect
+	// bodies which exceed the limit.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	if int64(n) > b.remaining {
+		n = int(b.remaining)
+		b.remaining = 0
+		b.err = &LimitError{Status: http.StatusRequestEntityTooLarge, What: "request body", Limit: b.limit}
+		return n, b.err
+	}
+	b.remaining -= int64(n)
+	return n, err
+}
+
+// limitedResponse wraps an http.ResponseWriter, failing to write
+// more than limit bytes. The status code is not sent until the
+// first write succeeds, so the response can still be replaced
+// by an error when the first write exceeds the limit.
+type limitedResponse struct {
+	http.ResponseWriter
+	limit     int64
+	remaining int64
+	status    int
+	err       *LimitError
+}
+
+func (w *limitedResponse) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+}
+
+func (w *limitedResponse) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	if int64(len(p)) > w.remaining {
+		w.err = &LimitError{Status: http.StatusInternalServerError, What: "response", Limit: w.limit}
+		return 0, w.err
+	}
+	w.writeHeader()
+	w.remaining -= int64(len(p))
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *limitedResponse) Flush() {
+	if w.err == nil {
+		w.writeHeader()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker. Once the connection has been
+// hijacked, the response limit is no longer enforced.
+func (w *limitedResponse) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := w.ResponseWriter.(http.Hijacker); ok {
+		// The status must not be sent after hijacking
+		w.status = -1
+		return h.Hijack()
+	}
+	return nil, nil, errors.New("http.ResponseWriter does not implement http.Hijacker")
+}
+
+// CloseNotify implements http.CloseNotifier. If the wrapped
+// http.ResponseWriter doesn't implement it, the returned channel
+// never receives a value.
+func (w *limitedResponse) CloseNotify() <-chan bool {
+	if cn, ok := w.ResponseWriter.(http.CloseNotifier); ok {
+		return cn.CloseNotify()
+	}
+	return make(chan bool)
+}
+
+func (w *limitedResponse) writeHeader() {
+	if w.status > 0 {
+		w.ResponseWriter.WriteHeader(w.status)
+		w.status = -1
+	}
+}
+
+// written returns true iff anything has been
+// sent to the wrapped http.ResponseWriter.
+func (w *limitedResponse) written() bool {
+	return w.status < 0
+}
+
+// handlerLimit returns the effective limit given the App
+// and the handler ones. See HandlerOptions.
+func handlerLimit(appLimit int64, handlerLimit int64) int64 {
+	if handlerLimit != 0 {
+		return handlerLimit
+	}
+	return appLimit
+}
+
+// rootConfig returns the configuration of the topmost App, which
+// sets the limits for the included apps too.
+func (app *App) rootConfig() *Config {
+	root := app
+	for root.parent != nil {
+		root = root.parent
+	}
+	return root.cfg
+}
+
+// serveHandler runs the handler for the given route, enforcing
+// the request body and response size limits.
+func (app *App) serveHandler(info *handlerInfo, ctx *Context) {
+	if !app.checkHandlerACL(info, ctx) {
+		return
+	}
+	if info.cache != nil {
+		info.cache.apply(ctx)
+	}
+	if info.included {
+		// The included app enforces the limits
+		info.handler(ctx)
+		return
+	}
+	cfg := app.rootConfig()
+	bodyLimit := handlerLimit(cfg.MaxRequestBodySize, info.maxRequestBodySize)
+	responseLimit := handlerLimit(cfg.MaxResponseSize, info.maxResponseSize)
+	if bodyLimit <= 0 && responseLimit <= 0 {
+		info.handler(ctx)
+		return
+	}
+	var body *limitedBody
+	if bodyLimit > 0 && ctx.R.Body != nil {
+		if ctx.R.ContentLength > bodyLimit {
+			app.limitError(ctx, &LimitError{Status: http.StatusRequestEntityTooLarge, What: "request body", Limit: bodyLimit})
+			return
+		}
+		body = &limitedBody{ReadCloser: ctx.R.Body, limit: bodyLimit, remaining: bodyLimit}
+		ctx.R.Body = body
+		defer func() {
+			ctx.R.Body = body.ReadCloser
+		}()
+	}
+	var response *limitedResponse
+	if responseLimit > 0 {
+		response = &limitedResponse{ResponseWriter: ctx.ResponseWriter, limit: responseLimit, remaining: responseLimit}
+		ctx.ResponseWriter = response
+		defer func() {
+			ctx.ResponseWriter = response.ResponseWriter
+			if response.err != nil {
+				ctx.Logger().Errorf("error serving %s: %s", ctx.R.URL.Path, response.err)
+				if !response.written() {
+					app.limitError(ctx, response.err)
+				}
+				return
+			}
+			response.writeHeader()
+		}()
+	}
+	info.handler(ctx)
+	if body != nil && body.err != nil && ctx.statusCode <= 0 {
+		// The handler didn't send any response after
+		// the request body exceeded its limit.
+		app.limitError(ctx, body.err)
+	}
+}
+
+// checkHeaderSize sends an error and returns false if the request
+// headers exceed the size limit configured for the App.
+func (app *App) checkHeaderSize(ctx *Context) bool {
+	limit := app.cfg.MaxHeaderSize
+	if limit <= 0 || ctx.R == nil {
+		return true
+	}
+	r := ctx.R
+	// Request line and Host header
+	size := len(r.Method) + len(r.RequestURI) + len(r.Proto) + 4 + len("Host: ") + len(r.Host) + 2
+	for k, values := range r.Header {
+		for _, v := range values {
+			size += len(k) + len(v) + 4
+		}
+	}
+	if size > limit {
+		app.limitError(ctx, &LimitError{Status: http.StatusRequestHeaderFieldsTooLarge, What: "request headers", Limit: int64(limit)})
+		return false
+	}
+	return true
+}
+
+// SetLimitErrorTemplate sets the template used for responding to requests
+// which exceed a size limit, when the client prefers HTML over JSON. The
+// template receives a LimitError as its data. If no template is set, the
+// error page for the status code is used (see App.SetErrorTemplate).
+func (app *App) SetLimitErrorTemplate(name string) {
+	app.limitErrorTemplate = name
+}
+
+// LimitErrorTemplate returns the template set with SetLimitErrorTemplate.
+func (app *App) LimitErrorTemplate() string {
+	return app.limitErrorTemplate
+}
+
+// limitError responds to a request which exceeded a size limit. The
+// error handler (see App.SetErrorHandler) gets the first chance to
+// handle the error, then the client receives either a JSON object
+// or a page rendered from the limit error template, depending on
+// the Accept header. Any headers describing the handler output
+// are reset, since it's replaced by the error.
+func (app *App) limitError(ctx *Context, err *LimitError) {
+	ctx.statusCode = -err.Status
+	header := ctx.Header()
+	header.Set("Content-Type", "text/plain; charset=utf-8")
+	header.Del("Content-Length")
+	header.Del("Content-Encoding")
+	message := err.Error()
+	if app.errorHandler != nil && app.errorHandler(ctx, message, err.Status) {
+		return
+	}
+	if prefersJSON(ctx.R) {
+		ctx.WriteJSON(map[string]interface{}{
+			"error": map[string]interface{}{
+				"status":  err.Status,
+				"message": message,
+				"limit":   err.Limit,
+			},
+		})
+		return
+	}
+	if app.limitErrorTemplate != "" {
+		terr := ctx.Execute(app.limitErrorTemplate, err)
+		if terr == nil {
+			return
+		}
+		ctx.Logger().Errorf("error executing limit error template %q: %s", app.limitErrorTemplate, terr)
+	}
+	app.writeError(ctx, message, err.Status)
+}
+
+// prefersJSON returns true iff the client prefers a JSON response
+// over an HTML one, either because of its Accept header or, when it
+// doesn't mention any of them, because the request body is JSON.
+func prefersJSON(r *http.Request) bool {
+	if r == nil {
+		return false
+	}
+	var jsonQ, htmlQ float64
+	for _, v := range strings.Split(r.Header.Get("Accept"), ",") {
+		parts := strings.Split(v, ";")
+		typ := strings.ToLower(strings.TrimSpace(parts[0]))
+		q := 1.0
+		for _, p := range parts[1:] {
+			p = strings.TrimSpace(p)
+			if strings.HasPrefix(p, "q=") {
+				if val, err := strconv.ParseFloat(p[2:], 64); err == nil {
+					q = val
+				}
+			}
+		}
+		switch {
+		case typ == "application/json" || strings.HasSuffix(typ, "+json"):
+			if q > jsonQ {
+				jsonQ = q
+			}
+		case typ == "text/html":
+			if q > htmlQ {
+				htmlQ = q
+			}
+		}
+	}
+	if jsonQ == 0 && htmlQ == 0 {
+		typ, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		return typ == "application/json"
+	}
+	return jsonQ > htmlQ
+}
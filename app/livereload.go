@@ -0,0 +1,244 @@
+package app
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"gnd.la/log"
+	"gnd.la/template/assets"
+)
+
+const (
+	// liveReloadPath is the WebSocket endpoint used by the
+	// live reload script.
+	liveReloadPath = "/_gondola_live_reload"
+	// liveReloadDelay is used for coalescing changes, so
+	// saving several files at once reloads only once.
+	liveReloadDelay = 100 * time.Millisecond
+
+	webSocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+)
+
+// liveReloader notifies the browsers connected to the live reload
+// WebSocket when templates or assets change. It's only enabled in
+// debug mode with TemplateDebug, since the changes are picked by
+// the app without restarting it. Browsers reload the stylesheets
+// in place when only CSS files changed and the whole page otherwise.
+type liveReloader struct {
+	mu      sync.Mutex
+	conns   map[*liveReloadConn]struct{}
+	changed []string
+	timer   *time.Timer
+}
+
+func newLiveReloader() *liveReloader {
+	return &liveReloader{conns: make(map[*liveReloadConn]struct{})}
+}
+
+// fileChanged records a changed template or asset,
+// notifying the browsers after liveReloadDelay.
+func (l *liveReloader) fileChanged(name string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.changed = append(l.changed, name)
+	if l.timer != nil {
+		l.timer.Stop()
+	}
+	l.timer = time.AfterFunc(liveReloadDelay, l.notify)
+}
+
+func (l *liveReloader) notify() {
+	l.mu.Lock()
+	styles := true
+	for _, v := range l.changed {
+		if strings.ToLower(path.Ext(v)) != ".css" {
+			styles = false
+			break
+		}
+	}
+	msg, _ := json.Marshal(map[string]interface{}{
+		"styles": styles,
+		"files":  l.changed,
+	})
+	l.changed = nil
+	l.timer = nil
+	conns := make([]*liveReloadConn, 0, len(l.conns))
+	for c := range l.conns {
+		conns = append(conns, c)
+	}
+	l.mu.Unlock()
+	log.Debugf("templates or assets changed, notifying %d browsers", len(conns))
+	for _, v := range conns {
+		if err := v.write(opText, msg); err != nil {
+			v.conn.Close()
+		}
+	}
+}
+
+// scriptTag returns the HTML tag which loads the
+// live reload script.
+func (l *liveReloader) scriptTag() []byte {
+	src := internalAssetsManager.URL("livereload.js")
+	return []byte(`<script type="text/javascript" src="` + src + `"></script>`)
+}
+
+// handler upgrades the connection to a WebSocket and keeps
+// it open until the browser closes it, so notifications
+// can be pushed to it.
+func (l *liveReloader) handler(ctx *Context) {
+	conn, err := upgradeWebSocket(ctx)
+	if err != nil {
+		ctx.WriteHeader(http.StatusBadRequest)
+		ctx.WriteString(err.Error())
+		return
+	}
+	l.mu.Lock()
+	l.conns[conn] = struct{}{}
+	l.mu.Unlock()
+	conn.run()
+	l.mu.Lock()
+	delete(l.conns, conn)
+	l.mu.Unlock()
+	conn.conn.Close()
+}
+
+// WebSocket opcodes, see RFC 6455.
+const (
+	opText  = 0x1
+	opClose = 0x8
+	opPing  = 0x9
+	opPong  = 0xA
+)
+
+// liveReloadConn is a minimal server side WebSocket connection,
+// which only supports sending unfragmented messages.
+type liveReloadConn struct {
+	conn net.Conn
+	rw   *bufio.ReadWriter
+	mu   sync.Mutex
+}
+
+func upgradeWebSocket(ctx *Context) (*liveReloadConn, error) {
+	r := ctx.R
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") ||
+		!strings.Contains(strings.ToLower(r.Header.Get("Connection")), "upgrade") {
+		return nil, errors.New("expecting a WebSocket upgrade")
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		return nil, errors.New("unsupported WebSocket version")
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		return nil, errors.New("missing Sec-WebSocket-Key")
+	}
+	hj, ok := ctx.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, errors.New("http.ResponseWriter does not implement http.Hijacker")
+	}
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	h := sha1.Sum([]byte(key + webSocketGUID))
+	rw.WriteString("HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + base64.StdEncoding.EncodeToString(h[:]) + "\r\n\r\n")
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &liveReloadConn{conn: conn, rw: rw}, nil
+}
+
+func (c *liveReloadConn) write(opcode byte, payload []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	header := []byte{0x80 | opcode, 0}
+	switch n := len(payload); {
+	case n < 126:
+		header[1] = byte(n)
+	case n <= 0xFFFF:
+		header[1] = 126
+		header = append(header, 0, 0)
+		binary.BigEndian.PutUint16(header[2:], uint16(n))
+	default:
+		header[1] = 127
+		header = append(header, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(header[2:], uint64(n))
+	}
+	c.rw.Write(header)
+	c.rw.Write(payload)
+	return c.rw.Flush()
+}
+
+// run reads the frames sent by the browser, answering pings,
+// until the connection is closed.
+func (c *liveReloadConn) run() {
+	var header [8]byte
+	for {
+		if _, err := io.ReadFull(c.rw, header[:2]); err != nil {
+			return
+		}
+		opcode := header[0] & 0x0F
+		masked := header[1]&0x80 != 0
+		n := uint64(header[1] & 0x7F)
+		switch n {
+		case 126:
+			if _, err := io.ReadFull(c.rw, header[:2]); err != nil {
+				return
+			}
+			n = uint64(binary.BigEndian.Uint16(header[:2]))
+		case 127:
+			if _, err := io.ReadFull(c.rw, header[:8]); err != nil {
+				return
+			}
+			n = binary.BigEndian.Uint64(header[:8])
+		}
+		var mask [4]byte
+		if masked {
+			if _, err := io.ReadFull(c.rw, mask[:]); err != nil {
+				return
+			}
+		}
+		if n > 1<<16 {
+			// The browser never sends messages, just drop it
+			return
+		}
+		payload := make([]byte, n)
+		if _, err := io.ReadFull(c.rw, payload); err != nil {
+			return
+		}
+		for ii := range payload {
+			payload[ii] ^= mask[ii%4]
+		}
+		switch opcode {
+		case opClose:
+			c.write(opClose, payload)
+			return
+		case opPing:
+			if err := c.write(opPong, payload); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// watchLiveReload makes the live reloader observe the given
+// assets manager, which must be already watching its files.
+func (app *App) watchLiveReload(manager *assets.Manager) {
+	if app.liveReload != nil {
+		manager.OnChange(app.liveReload.fileChanged)
+	}
+}
@@ -0,0 +1,203 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newLiveReloadServer(t *testing.T) (*liveReloader, *httptest.Server) {
+	a := New()
+	a.Logger = nil
+	l := newLiveReloader()
+	a.Handle("^/ws$", l.handler)
+	return l, httptest.NewServer(a)
+}
+
+// dialWebSocket performs the WebSocket handshake using the RFC 6455
+// sample key, returning the connection and the response.
+func dialWebSocket(t *testing.T, s *httptest.Server, version string) (net.Conn, *bufio.Reader, *http.Response) {
+	conn, err := net.Dial("tcp", strings.TrimPrefix(s.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := "GET /ws HTTP/1.1\r\n" +
+		"Host: localhost\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: keep-alive, Upgrade\r\n" +
+		"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n" +
+		"Sec-WebSocket-Version: " + version + "\r\n\r\n"
+	if _, err := io.WriteString(conn, req); err != nil {
+		t.Fatal(err)
+	}
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, br, resp
+}
+
+// writeFrame writes a masked frame, as browsers do.
+func writeFrame(t *testing.T, w io.Writer, opcode byte, payload []byte) {
+	header := []byte{0x80 | opcode, 0x80}
+	switch n := len(payload); {
+	case n < 126:
+		header[1] |= byte(n)
+	case n <= 0xFFFF:
+		header[1] |= 126
+		header = append(header, 0, 0)
+		binary.BigEndian.PutUint16(header[2:], uint16(n))
+	default:
+		header[1] |= 127
+		header = append(header, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(header[2:], uint64(n))
+	}
+	mask := []byte{1, 2, 3, 4}
+	masked := make([]byte, len(payload))
+	for ii, v := range payload {
+		masked[ii] = v ^ mask[ii%4]
+	}
+	if _, err := w.Write(append(append(header, mask...), masked...)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readFrame(t *testing.T, r io.Reader) (byte, []byte) {
+	var header [8]byte
+	if _, err := io.ReadFull(r, header[:2]); err != nil {
+		t.Fatal(err)
+	}
+	if header[0]&0x80 == 0 || header[1]&0x80 != 0 {
+		t.Fatalf("expecting an unmasked final frame, got header %x", header[:2])
+	}
+	opcode := header[0] & 0x0F
+	n := uint64(header[1] & 0x7F)
+	switch n {
+	case 126:
+		if _, err := io.ReadFull(r, header[:2]); err != nil {
+			t.Fatal(err)
+		}
+		n = uint64(binary.BigEndian.Uint16(header[:2]))
+	case 127:
+		if _, err := io.ReadFull(r, header[:8]); err != nil {
+			t.Fatal(err)
+		}
+		n = binary.BigEndian.Uint64(header[:8])
+	}
+	payload := make([]byte, n)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		t.Fatal(err)
+	}
+	return opcode, payload
+}
+
+func TestLiveReloadHandshake(t *testing.T) {
+	_, s := newLiveReloadServer(t)
+	defer s.Close()
+	conn, _, resp := dialWebSocket(t, s, "13")
+	defer conn.Close()
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("expecting status 101, got %d", resp.StatusCode)
+	}
+	// Expected value from RFC 6455, section 1.3
+	if accept := resp.Header.Get("Sec-WebSocket-Accept"); accept != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Errorf("unexpected Sec-WebSocket-Accept %q", accept)
+	}
+	if !strings.EqualFold(resp.Header.Get("Upgrade"), "websocket") {
+		t.Errorf("unexpected Upgrade %q", resp.Header.Get("Upgrade"))
+	}
+	bad, _, resp := dialWebSocket(t, s, "8")
+	bad.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expecting status 400 for an unsupported version, got %d", resp.StatusCode)
+	}
+	resp, err := http.Get(s.URL + "/ws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expecting status 400 without an upgrade, got %d", resp.StatusCode)
+	}
+}
+
+func TestLiveReloadFrames(t *testing.T) {
+	_, s := newLiveReloadServer(t)
+	defer s.Close()
+	conn, br, _ := dialWebSocket(t, s, "13")
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	for _, v := range [][]byte{[]byte("hello"), bytes.Repeat([]byte("a"), 300)} {
+		writeFrame(t, conn, opPing, v)
+		opcode, payload := readFrame(t, br)
+		if opcode != opPong || !bytes.Equal(payload, v) {
+			t.Errorf("expecting pong with %d bytes, got opcode %x with %d bytes", len(v), opcode, len(payload))
+		}
+	}
+	// Text frames are ignored
+	writeFrame(t, conn, opText, []byte("ignored"))
+	writeFrame(t, conn, opClose, []byte{0x03, 0xE8})
+	opcode, payload := readFrame(t, br)
+	if opcode != opClose || !bytes.Equal(payload, []byte{0x03, 0xE8}) {
+		t.Errorf("expecting close echo, got opcode %x with payload %x", opcode, payload)
+	}
+	if _, err := br.ReadByte(); err != io.EOF {
+		t.Errorf("expecting EOF after close, got %v", err)
+	}
+}
+
+func TestLiveReloadNotify(t *testing.T) {
+	l, s := newLiveReloadServer(t)
+	defer s.Close()
+	conn, br, _ := dialWebSocket(t, s, "13")
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	// The connection is registered after the handshake
+	for ii := 0; ; ii++ {
+		l.mu.Lock()
+		n := len(l.conns)
+		l.mu.Unlock()
+		if n == 1 {
+			break
+		}
+		if ii == 100 {
+			t.Fatal("connection was not registered")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for _, v := range []struct {
+		files  []string
+		styles bool
+	}{
+		{[]string{"style.css"}, true},
+		{[]string{"style.css", "index.html"}, false},
+	} {
+		for _, f := range v.files {
+			l.fileChanged(f)
+		}
+		opcode, payload := readFrame(t, br)
+		if opcode != opText {
+			t.Fatalf("expecting a text frame, got opcode %x", opcode)
+		}
+		var msg struct {
+			Styles bool     `json:"styles"`
+			Files  []string `json:"files"`
+		}
+		if err := json.Unmarshal(payload, &msg); err != nil {
+			t.Fatal(err)
+		}
+		if msg.Styles != v.styles || !reflect.DeepEqual(msg.Files, v.files) {
+			t.Errorf("expecting styles = %v and files %v, got %+v", v.styles, v.files, msg)
+		}
+	}
+}
@@ -2,35 +2,37 @@ package app
 
 import (
 	"bytes"
+	"errors"
+	"io"
 	"strings"
 
 	"gnd.la/net/mail"
 )
 
+var errNoAssetsManager = errors.New("app has no assets manager")
+
 // SendMail is a shorthand function for sending an email from a template.
 // If the loaded gnd.la/template.Template.ContentType() returns a string
 // containing "html", the gnd.la/net/mail.Message HTMLBody field is set, other
 // the TextBody field is used. Note that if template is empty, the msg is
 // passed unmodified to mail.Send(). Other Message fields are never altered.
+// See also SendTemplate, which also inlines CSS, generates a text alternative
+// and attaches the images referenced from HTML templates.
 //
 // Note: mail.Send does not work on App Engine, users must always use this function instead.
 func (c *Context) SendMail(template string, data interface{}, msg *mail.Message) error {
 	if template != "" {
-		t, err := c.app.LoadTemplate(template)
-		if err != nil {
-			return err
-		}
 		if msg == nil {
 			msg = &mail.Message{}
 		}
-		var buf bytes.Buffer
-		if err := t.ExecuteTo(&buf, c, data); err != nil {
+		body, contentType, err := c.RenderTemplate(template, data)
+		if err != nil {
 			return err
 		}
-		if strings.Contains(t.tmpl.ContentType(), "/html") {
-			msg.HTMLBody = buf.String()
+		if strings.Contains(contentType, "/html") {
+			msg.HTMLBody = body
 		} else {
-			msg.TextBody = buf.String()
+			msg.TextBody = body
 		}
 	}
 	c.prepareMessage(msg)
@@ -43,3 +45,46 @@ func (c *Context) MustSendMail(template string, data interface{}, msg *mail.Mess
 		panic(err)
 	}
 }
+
+// SendTemplate is a shorthand for gnd.la/net/mail.SendTemplate(c, template, data, msg).
+// See gnd.la/net/mail.SendTemplate for the details.
+func (c *Context) SendTemplate(template string, data interface{}, msg *mail.Message) error {
+	return mail.SendTemplate(c, template, data, msg)
+}
+
+// RenderTemplate executes the template with the given name and data, returning
+// its output as a string as well as the template content type.
+func (c *Context) RenderTemplate(name string, data interface{}) (string, string, error) {
+	t, err := c.app.LoadTemplate(name)
+	if err != nil {
+		return "", "", err
+	}
+	var buf bytes.Buffer
+	if err := t.ExecuteTo(&buf, c, data); err != nil {
+		return "", "", err
+	}
+	return buf.String(), t.tmpl.ContentType(), nil
+}
+
+// BlobstoreAttachment returns a *mail.Attachment with the given name which
+// reads its data from the file with the given id in the App's blobstore when
+// the message is sent, so the file is never completely loaded into memory.
+func (c *Context) BlobstoreAttachment(id string, name string) *mail.Attachment {
+	return mail.NewOpenerAttachment(name, func() (io.ReadCloser, error) {
+		f, err := c.Blobstore().Open(id)
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
+	})
+}
+
+// LoadAsset opens the asset with the given name using the
+// App's assets manager.
+func (c *Context) LoadAsset(name string) (io.ReadCloser, error) {
+	manager := c.app.AssetsManager()
+	if manager == nil {
+		return nil, errNoAssetsManager
+	}
+	return manager.Load(name)
+}
@@ -0,0 +1,131 @@
+package app
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// MaintenanceTemplate is the template, relative to the templates
+	// VFS, used for the responses sent while the App is in maintenance
+	// mode. If it doesn't exist, the error page for the 503 status
+	// code is used. See App.SetErrorTemplate.
+	MaintenanceTemplate = ErrorTemplatesDir + "/maintenance.html"
+
+	// defaultMaintenanceRetryAfter is the Retry-After value, in
+	// seconds, when Config.MaintenanceRetryAfter is zero.
+	defaultMaintenanceRetryAfter = 300
+	// maintenanceFileInterval is the minimum interval between
+	// the checks for Config.MaintenanceFile.
+	maintenanceFileInterval = time.Second
+)
+
+// maintenance holds the maintenance mode state of an App.
+type maintenance struct {
+	mu         sync.Mutex
+	on         bool
+	exceptions []string
+	fileOn     bool
+	checked    time.Time
+}
+
+// SetMaintenance enables or disables maintenance mode at runtime.
+// While the App is in maintenance mode, all the requests which
+// don't match a path added with AddMaintenanceException receive a
+// 503 response with a Retry-After header, rendered using the
+// MaintenanceTemplate. Note that maintenance mode might also be
+// enabled by Config.MaintenanceFile, see App.Maintenance.
+func (app *App) SetMaintenance(on bool) {
+	app.maintenance.mu.Lock()
+	app.maintenance.on = on
+	app.maintenance.mu.Unlock()
+}
+
+// Maintenance returns true iff the App is in maintenance mode,
+// either because it was enabled with SetMaintenance or because
+// the file indicated by Config.MaintenanceFile exists. The file
+// is checked at most once per second, so it can be created or
+// removed (e.g. with the maintenance command) to toggle maintenance
+// mode in a running App, without restarting it.
+func (app *App) Maintenance() bool {
+	m := &app.maintenance
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.on {
+		return true
+	}
+	if name := app.cfg.MaintenanceFile; name != "" {
+		if now := time.Now(); now.Sub(m.checked) >= maintenanceFileInterval {
+			_, err := os.Stat(name)
+			m.fileOn = err == nil
+			m.checked = now
+		}
+		return m.fileOn
+	}
+	return false
+}
+
+// AddMaintenanceException makes the paths under the given prefix
+// (e.g. /admin matches /admin and /admin/users, but not /administrator)
+// available while the App is in maintenance mode. This is usually
+// used for health checks and administration pages.
+func (app *App) AddMaintenanceException(prefix string) {
+	app.maintenance.mu.Lock()
+	app.maintenance.exceptions = append(app.maintenance.exceptions, cleanPathPrefix(prefix))
+	app.maintenance.mu.Unlock()
+}
+
+// checkMaintenance returns true if the request should be served.
+// Otherwise, it sends the maintenance page and returns false.
+func (app *App) checkMaintenance(ctx *Context) bool {
+	if ctx.R == nil || !app.Maintenance() {
+		return true
+	}
+	path := ctx.R.URL.Path
+	if hasPathPrefix(path, assetsPrefix) {
+		// Let the maintenance page load the internal assets
+		return true
+	}
+	app.maintenance.mu.Lock()
+	exceptions := app.maintenance.exceptions
+	app.maintenance.mu.Unlock()
+	for _, v := range exceptions {
+		if hasPathPrefix(path, v) {
+			return true
+		}
+	}
+	// These aren't failures, don't record them
+	ctx.recording = nil
+	retryAfter := app.cfg.MaintenanceRetryAfter
+	if retryAfter <= 0 {
+		retryAfter = defaultMaintenanceRetryAfter
+	}
+	ctx.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	const message = "The site is undergoing maintenance, please try again later"
+	code := http.StatusServiceUnavailable
+	if !prefersJSON(ctx.R) && acceptsHTML(ctx.R) {
+		if _, err := app.TemplatesFS().Stat(MaintenanceTemplate); err == nil {
+			tmpl, err := app.LoadTemplate(MaintenanceTemplate)
+			if err == nil {
+				data := &ErrorData{
+					Status:    code,
+					Title:     http.StatusText(code),
+					Message:   message,
+					RequestID: ctx.RequestID(),
+				}
+				ctx.Header().Set("Content-Type", "text/html; charset=utf-8")
+				ctx.WriteHeader(code)
+				if err := tmpl.Execute(ctx, data); err != nil {
+					ctx.Logger().Errorf("error executing maintenance template: %s", err)
+				}
+				return false
+			}
+			ctx.Logger().Errorf("error loading maintenance template: %s", err)
+		}
+	}
+	app.handleHTTPError(ctx, message, code)
+	return false
+}
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"mime"
+	"strings"
+)
+
+const (
+	// MethodOverrideParameter is the form field used for overriding
+	// the method of POST requests. See App.SetMethodOverride.
+	MethodOverrideParameter = "_method"
+	// MethodOverrideHeader is the header used for overriding the
+	// method of POST requests. See App.SetMethodOverride.
+	MethodOverrideHeader = "X-HTTP-Method-Override"
+)
+
+// SetMethodOverride enables or disables method overriding. When
+// enabled, POST requests with a MethodOverrideHeader header or a
+// MethodOverrideParameter field in an application/x-www-form-urlencoded
+// body (usually a hidden input) are
+// routed and handled as if they had been sent with the method in
+// its value, which must be either PUT, PATCH or DELETE. This lets
+// HTML forms, which can only be submitted with GET or POST, use the
+// handlers registered with App.PUT, App.PATCH and App.DELETE.
+// e.g.
+//
+//	<form method="post" action="/articles/42/">
+//	  <input type="hidden" name="_method" value="PUT">
+//	  ...
+//	</form>
+//
+// Note that looking for the form field requires parsing the request
+// body before routing it, so only the request body size limit set in
+// the App Config applies while doing so. Multipart bodies are not
+// parsed and must use the header instead. The original method can be
+// retrieved with Context.OriginalMethod. The default is false.
+func (app *App) SetMethodOverride(b bool) {
+	app.methodOverride = b
+}
+
+// MethodOverride returns if the app overrides the method of
+// POST requests. See SetMethodOverride for a more detailed
+// description.
+func (app *App) MethodOverride() bool {
+	return app.methodOverride
+}
+
+// OriginalMethod returns the method the request was sent with,
+// which might be different from ctx.R.Method if it was overridden.
+// See App.SetMethodOverride.
+func (c *Context) OriginalMethod() string {
+	if c.originalMethod != "" {
+		return c.originalMethod
+	}
+	if c.R != nil {
+		return c.R.Method
+	}
+	return ""
+}
+
+// overrideMethod changes the request method if it's overridden by
+// the client. It returns false if the request body couldn't be read
+// because it exceeded the App limit, after sending an error.
+func (app *App) overrideMethod(ctx *Context) bool {
+	r := ctx.R
+	if !app.methodOverride || r == nil || r.Method != "POST" {
+		return true
+	}
+	method := r.Header.Get(MethodOverrideHeader)
+	if method == "" {
+		ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if ct != "application/x-www-form-urlencoded" {
+			return true
+		}
+		if limit := app.cfg.MaxRequestBodySize; limit > 0 && r.Body != nil {
+			body := &limitedBody{ReadCloser: r.Body, limit: limit, remaining: limit}
+			r.Body = body
+			r.ParseForm()
+			r.Body = body.ReadCloser
+			if body.err != nil {
+				app.limitError(ctx, body.err)
+				return false
+			}
+		}
+		r.ParseForm()
+		method = r.PostForm.Get(MethodOverrideParameter)
+	}
+	switch m := strings.ToUpper(strings.TrimSpace(method)); m {
+	case "PUT", "PATCH", "DELETE":
+		ctx.originalMethod = r.Method
+		r.Method = m
+	case "", "POST":
+	default:
+		ctx.Logger().Debugf("ignoring method override to %q, must be PUT, PATCH or DELETE", method)
+	}
+	return true
+}
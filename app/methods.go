@@ -0,0 +1,193 @@
+package app
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// defaultMethods are the methods allowed by the handlers
+// which don't restrict the methods they accept.
+var defaultMethods = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+
+// GET is a shorthand for HandleOptions, registering a handler
+// which only accepts GET requests (and HEAD, unless NoAutoHead
+// is set). To register a named handler for a method, use
+// HandleOptions with HandlerOptions.Methods.
+func (app *App) GET(pattern string, handler Handler) {
+	app.handleMethod("GET", pattern, handler)
+}
+
+// POST is a shorthand for HandleOptions, registering a
+// handler which only accepts POST requests.
+func (app *App) POST(pattern string, handler Handler) {
+	app.handleMethod("POST", pattern, handler)
+}
+
+// PUT is a shorthand for HandleOptions, registering a
+// handler which only accepts PUT requests.
+func (app *App) PUT(pattern string, handler Handler) {
+	app.handleMethod("PUT", pattern, handler)
+}
+
+// DELETE is a shorthand for HandleOptions, registering a
+// handler which only accepts DELETE requests.
+func (app *App) DELETE(pattern string, handler Handler) {
+	app.handleMethod("DELETE", pattern, handler)
+}
+
+// PATCH is a shorthand for HandleOptions, registering a
+// handler which only accepts PATCH requests.
+func (app *App) PATCH(pattern string, handler Handler) {
+	app.handleMethod("PATCH", pattern, handler)
+}
+
+func (app *App) handleMethod(method string, pattern string, handler Handler) {
+	app.HandleOptions(pattern, handler, &HandlerOptions{Methods: []string{method}})
+}
+
+// hasMethod returns true iff the handler was registered
+// explicitly for the given method.
+func (h *handlerInfo) hasMethod(method string) bool {
+	for _, v := range h.methods {
+		if v == method {
+			return true
+		}
+	}
+	return false
+}
+
+// accepts returns true iff the handler should serve a
+// request with the given method.
+func (h *handlerInfo) accepts(method string) bool {
+	switch {
+	case h.methods == nil || h.hasMethod(method):
+		return true
+	case method == "HEAD":
+		return !h.noAutoHead && h.hasMethod("GET")
+	case method == "OPTIONS":
+		return !h.noAutoOptions
+	}
+	return false
+}
+
+// allowedMethods returns the methods accepted by the handler,
+// including the ones which are handled automatically.
+func (h *handlerInfo) allowedMethods() []string {
+	if h.methods == nil {
+		return defaultMethods
+	}
+	methods := append([]string(nil), h.methods...)
+	if !h.noAutoHead && h.hasMethod("GET") && !h.hasMethod("HEAD") {
+		methods = append(methods, "HEAD")
+	}
+	if !h.noAutoOptions && !h.hasMethod("OPTIONS") {
+		methods = append(methods, "OPTIONS")
+	}
+	return methods
+}
+
+// allowHeader returns the value for the Allow header for the given
+// path, computed from all the handlers which match it.
+func (app *App) allowHeader(path string, ctx *Context) string {
+	seen := make(map[string]bool)
+	var methods []string
+	for _, v := range app.handlers {
+		if v.host != "" && v.host != ctx.R.Host {
+			continue
+		}
+		if v.match(path) == nil {
+			continue
+		}
+		for _, m := range v.allowedMethods() {
+			if !seen[m] {
+				seen[m] = true
+				methods = append(methods, m)
+			}
+		}
+	}
+	sort.Strings(methods)
+	return strings.Join(methods, ", ")
+}
+
+// methodNotAllowed responds with a 405 error if any handler
+// matches the given path, returning true iff it does.
+func (app *App) methodNotAllowed(path string, ctx *Context) bool {
+	allow := app.allowHeader(path, ctx)
+	if allow == "" {
+		return false
+	}
+	ctx.Header().Set("Allow", allow)
+	app.handleHTTPError(ctx, "Method Not Allowed", http.StatusMethodNotAllowed)
+	return true
+}
+
+// serveOptions answers an OPTIONS request with the methods
+// allowed by the handlers for the given path.
+func (app *App) serveOptions(path string, ctx *Context) {
+	header := ctx.Header()
+	header.Set("Allow", app.allowHeader(path, ctx))
+	header.Set("Content-Length", "0")
+	ctx.WriteHeader(http.StatusNoContent)
+}
+
+// serveHead answers a HEAD request by running the given handler
+// without sending its body, while setting the Content-Length
+// header to the length of the discarded body.
+func (app *App) serveHead(info *handlerInfo, ctx *Context) {
+	w := &headResponse{ResponseWriter: ctx.ResponseWriter}
+	ctx.ResponseWriter = w
+	defer func() {
+		ctx.ResponseWriter = w.ResponseWriter
+		w.send()
+	}()
+	app.serveHandler(info, ctx)
+}
+
+// headResponse is an http.ResponseWriter which discards the
+// response body, counting its length. The status code is not
+// sent until the response is flushed or the handler finishes,
+// so the Content-Length header can be set.
+type headResponse struct {
+	http.ResponseWriter
+	status int
+	length int64
+	sent   bool
+}
+
+func (w *headResponse) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+}
+
+func (w *headResponse) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.length += int64(len(p))
+	return len(p), nil
+}
+
+func (w *headResponse) Flush() {
+	w.send()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *headResponse) send() {
+	if w.sent {
+		return
+	}
+	w.sent = true
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	header := w.ResponseWriter.Header()
+	if header.Get("Content-Length") == "" && w.status != http.StatusNoContent && w.status != http.StatusNotModified {
+		header.Set("Content-Length", strconv.FormatInt(w.length, 10))
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+}
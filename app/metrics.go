@@ -0,0 +1,191 @@
+package app
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"gnd.la/metrics"
+)
+
+// notFoundRoute is the route used in RouteMetrics for
+// the requests which didn't match any handler.
+const notFoundRoute = "(not found)"
+
+var (
+	// LatencyBuckets are the upper bounds of the buckets used
+	// for counting the request latencies in RouteMetrics.
+	LatencyBuckets = []time.Duration{
+		10 * time.Millisecond,
+		50 * time.Millisecond,
+		100 * time.Millisecond,
+		500 * time.Millisecond,
+		time.Second,
+		5 * time.Second,
+	}
+
+	requestsTotal = metrics.NewCounter("gondola_http_requests_total",
+		"Number of HTTP requests served, by route and status code", "route", "status")
+	requestDuration = metrics.NewHistogram("gondola_http_request_duration_seconds",
+		"Time spent serving HTTP requests, by route", nil, "route")
+)
+
+// RouteMetrics contains the counters for the requests served
+// by a handler. Routes are identified by their handler name
+// or, if the handler has no name, by its pattern. See
+// App.RouteMetrics.
+type RouteMetrics struct {
+	Route string `json:"route"`
+	// Requests is the total number of served requests.
+	Requests uint64 `json:"requests"`
+	// ClientErrors and ServerErrors count the responses
+	// with 4xx and 5xx status codes, respectively.
+	ClientErrors uint64 `json:"client_errors"`
+	ServerErrors uint64 `json:"server_errors"`
+	// Total and Max are the total and the maximum
+	// time spent serving the requests.
+	Total time.Duration `json:"total_ns"`
+	Max   time.Duration `json:"max_ns"`
+	// Buckets contains the number of requests which took
+	// less or equal than the corresponding duration in
+	// LatencyBuckets. The last element counts the requests
+	// which took longer than the last bucket.
+	Buckets []uint64 `json:"buckets"`
+}
+
+// Mean returns the mean time spent serving the requests.
+func (r *RouteMetrics) Mean() time.Duration {
+	if r.Requests == 0 {
+		return 0
+	}
+	return r.Total / time.Duration(r.Requests)
+}
+
+func (r *RouteMetrics) add(code int, elapsed time.Duration) {
+	r.Requests++
+	switch {
+	case code >= 500:
+		r.ServerErrors++
+	case code >= 400:
+		r.ClientErrors++
+	}
+	r.Total += elapsed
+	if elapsed > r.Max {
+		r.Max = elapsed
+	}
+	ii := 0
+	for ; ii < len(LatencyBuckets); ii++ {
+		if elapsed <= LatencyBuckets[ii] {
+			break
+		}
+	}
+	r.Buckets[ii]++
+}
+
+type routeMetricsList []*RouteMetrics
+
+func (r routeMetricsList) Len() int {
+	return len(r)
+}
+
+func (r routeMetricsList) Less(i, j int) bool {
+	return r[i].Route < r[j].Route
+}
+
+func (r routeMetricsList) Swap(i, j int) {
+	r[i], r[j] = r[j], r[i]
+}
+
+type routeCounters struct {
+	mu     sync.Mutex
+	routes map[string]*RouteMetrics
+}
+
+// observeRequest records the request in the metrics
+// exported via gnd.la/metrics. See App.HandleMetrics.
+func observeRequest(route string, code int, elapsed time.Duration) {
+	if code < 0 {
+		code = -code
+	}
+	if code == 0 {
+		code = http.StatusOK
+	}
+	if route == "" {
+		route = notFoundRoute
+	}
+	requestsTotal.Inc(route, strconv.Itoa(code))
+	requestDuration.Observe(elapsed.Seconds(), route)
+}
+
+func (m *routeCounters) add(route string, code int, elapsed time.Duration) {
+	if code < 0 {
+		code = -code
+	}
+	if route == "" {
+		route = notFoundRoute
+	}
+	m.mu.Lock()
+	rm := m.routes[route]
+	if rm == nil {
+		rm = &RouteMetrics{Route: route, Buckets: make([]uint64, len(LatencyBuckets)+1)}
+		m.routes[route] = rm
+	}
+	rm.add(code, elapsed)
+	m.mu.Unlock()
+}
+
+func (m *routeCounters) list() []*RouteMetrics {
+	m.mu.Lock()
+	list := make(routeMetricsList, 0, len(m.routes))
+	for _, v := range m.routes {
+		rm := *v
+		rm.Buckets = append([]uint64(nil), v.Buckets...)
+		list = append(list, &rm)
+	}
+	m.mu.Unlock()
+	sort.Sort(list)
+	return list
+}
+
+// RouteMetrics returns the counters for each route served by
+// the App, sorted by route. Note that metrics are only collected
+// after calling App.HandleDebug, otherwise RouteMetrics returns
+// nil.
+func (app *App) RouteMetrics() []*RouteMetrics {
+	if app.metrics == nil {
+		return nil
+	}
+	return app.metrics.list()
+}
+
+// HandleMetrics adds a handler which exposes the metrics registered
+// in gnd.la/metrics.Default using the Prometheus text format. Gondola
+// automatically instruments the requests (by route and status code),
+// the ORM, the cache and the tasks. If pattern is empty, ^/metrics$
+// is used. The auth function works like in App.HandleDebug, so a nil
+// auth only allows requests in debug mode or from admin users. Use
+// DebugTokenAuth to let Prometheus scrape the metrics using a bearer
+// token.
+func (app *App) HandleMetrics(pattern string, auth DebugAuthFunc) {
+	if pattern == "" {
+		pattern = "^/metrics$"
+	}
+	if auth == nil {
+		auth = defaultDebugAuth
+	}
+	app.Handle(pattern, func(ctx *Context) {
+		if !auth(ctx) {
+			ctx.Forbidden()
+			return
+		}
+		var buf bytes.Buffer
+		if err := metrics.Write(&buf); err != nil {
+			panic(err)
+		}
+		ctx.Header().Set("Content-Type", metrics.ContentType)
+		ctx.Write(buf.Bytes())
+	})
+}
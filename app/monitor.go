@@ -21,7 +21,8 @@ func monitorAPIHandler(ctx *Context) {
 	var stats runtime.MemStats
 	runtime.ReadMemStats(&stats)
 	data := map[string]interface{}{
-		"mem": &stats,
+		"mem":        &stats,
+		"background": ctx.app.BackgroundCount(),
 	}
 	if _, err := ctx.WriteJSON(data); err != nil {
 		panic(err)
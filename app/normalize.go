@@ -0,0 +1,176 @@
+package app
+
+import (
+	"net/http"
+	"strings"
+)
+
+// NormalizeAction indicates what the App does with requests which
+// only match a handler after normalizing their path. See NormalizePolicy.
+type NormalizeAction int
+
+const (
+	// NormalizeNone disables the normalization, so the
+	// request receives a 404 error.
+	NormalizeNone NormalizeAction = iota
+	// NormalizeRedirect redirects the client to the normalized
+	// path, using a 301 status code for GET and HEAD requests
+	// and 308 for the rest of methods, so they're retried with
+	// the same method and body.
+	NormalizeRedirect
+	// NormalizeRewrite serves the request with the handler
+	// for the normalized path, without redirecting the client.
+	NormalizeRewrite
+)
+
+// NormalizePolicy indicates how the App handles requests whose path
+// doesn't match any handler, but would match one after being normalized.
+// Each field controls one kind of normalization. When a path needs
+// several of them, all of them must be enabled and the request is
+// redirected if any of them redirects.
+//
+// Note that App.SetAppendSlash is checked before the NormalizePolicy,
+// so it must be disabled in order to rewrite the requests which
+// lack a trailing slash.
+type NormalizePolicy struct {
+	// TrailingSlash adds or removes the trailing slash (e.g.
+	// /foo matches ^/foo/$ and /foo/ matches ^/foo$).
+	TrailingSlash NormalizeAction
+	// DuplicateSlashes collapses consecutive slashes
+	// (e.g. /foo//bar matches ^/foo/bar$).
+	DuplicateSlashes NormalizeAction
+	// Case lowercases the path (e.g. /Foo matches ^/foo$).
+	Case NormalizeAction
+}
+
+const (
+	normalizeDuplicateSlashes = 1 << iota
+	normalizeCase
+	normalizeTrailingSlash
+	normalizeAll = normalizeDuplicateSlashes | normalizeCase | normalizeTrailingSlash
+)
+
+// action returns the action for a path which requires the given
+// normalizations, as a bitmask.
+func (p *NormalizePolicy) action(mask int) NormalizeAction {
+	result := NormalizeRewrite
+	for _, v := range []struct {
+		bit    int
+		action NormalizeAction
+	}{
+		{normalizeDuplicateSlashes, p.DuplicateSlashes},
+		{normalizeCase, p.Case},
+		{normalizeTrailingSlash, p.TrailingSlash},
+	} {
+		if mask&v.bit == 0 {
+			continue
+		}
+		switch v.action {
+		case NormalizeNone:
+			return NormalizeNone
+		case NormalizeRedirect:
+			result = NormalizeRedirect
+		}
+	}
+	return result
+}
+
+// NormalizePolicy returns the default policy for normalizing the
+// request paths. See SetNormalizePolicy.
+func (app *App) NormalizePolicy() NormalizePolicy {
+	return app.normalizePolicy
+}
+
+// SetNormalizePolicy sets the default policy for normalizing the
+// request paths in this App. Handlers might override it using
+// HandlerOptions.Normalize. Since each included App has its own
+// policy, included apps might be used to apply a policy to a group
+// of handlers. The default policy doesn't normalize any paths.
+func (app *App) SetNormalizePolicy(policy NormalizePolicy) {
+	app.normalizePolicy = policy
+}
+
+// normalizedPath applies the normalizations in mask to p. If
+// any of them doesn't change the path, it returns an empty string.
+func normalizedPath(p string, mask int) string {
+	if mask&normalizeDuplicateSlashes != 0 {
+		if !strings.Contains(p, "//") {
+			return ""
+		}
+		for strings.Contains(p, "//") {
+			p = strings.Replace(p, "//", "/", -1)
+		}
+	}
+	if mask&normalizeCase != 0 {
+		lower := strings.ToLower(p)
+		if lower == p {
+			return ""
+		}
+		p = lower
+	}
+	if mask&normalizeTrailingSlash != 0 {
+		switch {
+		case p == "/" || p == "":
+			return ""
+		case strings.HasSuffix(p, "/"):
+			p = p[:len(p)-1]
+		default:
+			p += "/"
+		}
+	}
+	return p
+}
+
+// normalize tries to serve a request whose path didn't match any
+// handler by normalizing it. It returns true iff the request was
+// served, either by redirecting or by rewriting it.
+func (app *App) normalize(path string, ctx *Context) bool {
+	// Try the candidates requiring less changes first
+	for count := 1; count <= 3; count++ {
+		for mask := 1; mask <= normalizeAll; mask++ {
+			if bitCount(mask) != count {
+				continue
+			}
+			p := normalizedPath(path, mask)
+			if p == "" {
+				continue
+			}
+			info := app.matchHandler(p, ctx)
+			if info == nil {
+				continue
+			}
+			policy := &app.normalizePolicy
+			if info.normalize != nil {
+				policy = info.normalize
+			}
+			switch policy.action(mask) {
+			case NormalizeRedirect:
+				code := http.StatusMovedPermanently
+				if m := ctx.R.Method; m != "GET" && m != "HEAD" {
+					code = http.StatusPermanentRedirect
+				}
+				u := *ctx.R.URL
+				// Keep the prefix for included apps
+				u.Path = u.Path[:len(u.Path)-len(path)] + p
+				u.RawPath = ""
+				ctx.Header().Set("Location", u.String())
+				ctx.WriteHeader(code)
+				return true
+			case NormalizeRewrite:
+				app.serveHandler(info, ctx)
+				return true
+			}
+			ctx.handlerName = ""
+			ctx.route = ""
+		}
+	}
+	return false
+}
+
+func bitCount(x int) int {
+	c := 0
+	for ; x != 0; x &= x - 1 {
+		c++
+	}
+	return c
+}
@@ -6,6 +6,8 @@ import (
 	"gnd.la/html/paginator"
 )
 
+const defaultPageParameter = "page"
+
 type pager struct {
 	name   string
 	params []interface{}
@@ -71,3 +73,29 @@ func (c *Context) pager() (paginator.Pager, error) {
 		ctx:    c,
 	}, nil
 }
+
+// Paginator returns a *paginator.Paginator with the given number of
+// pages, which reads the current page number from the query parameter
+// named param ("page" if empty). The URLs for each page preserve the
+// rest of the query parameters in the request, so it works with
+// filtered or sorted listings served by any handler. Page numbers
+// out of the [1, count] range are clamped. It's also available in
+// templates as the paginator function e.g.
+//
+//  {{ with paginator .PageCount }}
+//	{{ .Render }}
+//  {{ end }}
+func (c *Context) Paginator(count int, param string) *paginator.Paginator {
+	if param == "" {
+		param = defaultPageParameter
+	}
+	var page int
+	c.ParseFormValue(param, &page)
+	if page > count {
+		page = count
+	}
+	if page < 1 {
+		page = 1
+	}
+	return paginator.New(count, page, paginator.Query(c.R.URL, param))
+}
@@ -0,0 +1,163 @@
+package app
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"time"
+
+	"gnd.la/internal/runtimeutil"
+)
+
+const (
+	// errorSourceLines is the number of lines shown before
+	// and after each call site in the debug error page.
+	errorSourceLines = 5
+	// maxFormValueLength is the maximum length of the
+	// form values shown in the debug error page.
+	maxFormValueLength = 1024
+)
+
+type errorFrame struct {
+	Function string
+	Location string
+	Code     template.HTML
+	// Std indicates if the frame belongs to the
+	// standard library or the runtime.
+	Std bool
+}
+
+type errorValue struct {
+	Name  string
+	Value string
+}
+
+type errorValues []*errorValue
+
+func (e errorValues) Len() int {
+	return len(e)
+}
+
+func (e errorValues) Less(i, j int) bool {
+	return e[i].Name < e[j].Name
+}
+
+func (e errorValues) Swap(i, j int) {
+	e[i], e[j] = e[j], e[i]
+}
+
+func (e *errorValues) add(name string, values ...string) {
+	for _, v := range values {
+		if len(v) > maxFormValueLength {
+			v = v[:maxFormValueLength] + "..."
+		}
+		*e = append(*e, &errorValue{Name: name, Value: v})
+	}
+}
+
+func (app *App) errorPage(ctx *Context, elapsed time.Duration, skip int, stackSkip int, req string, err interface{}) {
+	t := newInternalTemplate(app)
+	if terr := t.parse("panic.html", nil); terr != nil {
+		panic(terr)
+	}
+	if terr := t.prepare(); terr != nil {
+		panic(terr)
+	}
+	stack := runtimeutil.FormatStackHTML(stackSkip + 1)
+	location, code := runtimeutil.FormatCallerHTML(skip+1, errorSourceLines, true, true)
+	ctx.statusCode = -http.StatusInternalServerError
+	data := map[string]interface{}{
+		"Error":      fmt.Sprintf("%v", err),
+		"Subtitle":   fmt.Sprintf("(after %s)", elapsed),
+		"Location":   location,
+		"Code":       code,
+		"Frames":     errorFrames(skip + 1),
+		"Stack":      stack,
+		"Goroutines": runtimeutil.FormatGoroutines(),
+		"Request":    req,
+	}
+	if ctx.R != nil {
+		data["Headers"] = errorHeaders(ctx.R)
+		data["Params"] = errorParams(ctx)
+		data["Form"] = errorForm(ctx.R)
+		data["Session"] = errorSession(ctx)
+	}
+	if err := t.Execute(ctx, data); err != nil {
+		panic(err)
+	}
+}
+
+// errorFrames returns the frames to be shown in the debug error page,
+// skipping the given number of frames from the caller of errorFrames.
+func errorFrames(skip int) []*errorFrame {
+	var frames []*errorFrame
+	for _, v := range runtimeutil.Stack(skip + 1) {
+		code, _ := v.SourceHTML(errorSourceLines)
+		frames = append(frames, &errorFrame{
+			Function: v.Function,
+			Location: v.Location(),
+			Code:     code,
+			Std:      v.IsStd(),
+		})
+	}
+	return frames
+}
+
+func errorHeaders(r *http.Request) errorValues {
+	var values errorValues
+	for k, v := range r.Header {
+		values.add(k, v...)
+	}
+	if r.Host != "" {
+		values.add("Host", r.Host)
+	}
+	sort.Stable(values)
+	return values
+}
+
+func errorParams(ctx *Context) errorValues {
+	var values errorValues
+	for _, v := range ctx.Params() {
+		if v != "" {
+			values.add(v, ctx.ParamValue(v))
+		}
+	}
+	return values
+}
+
+func errorForm(r *http.Request) errorValues {
+	// The body has been restored by httputil.DumpRequest,
+	// so it can be parsed again. Errors are ignored, since
+	// an invalid form shouldn't prevent the error page
+	// from being shown.
+	r.ParseMultipartForm(32 << 20) // 32 MiB, as stdlib
+	var values errorValues
+	for k, v := range r.Form {
+		values.add(k, v...)
+	}
+	if r.MultipartForm != nil {
+		for k, v := range r.MultipartForm.File {
+			for _, f := range v {
+				values.add(k, fmt.Sprintf("file %s (%s)", f.Filename, f.Header.Get("Content-Type")))
+			}
+		}
+	}
+	sort.Stable(values)
+	return values
+}
+
+// errorSession returns the cookies sent with the request and the
+// current user, if it has been already loaded. The user is not
+// loaded here because UserFunc might be the cause of the panic.
+func errorSession(ctx *Context) errorValues {
+	var values errorValues
+	for _, v := range ctx.R.Cookies() {
+		values.add("Cookie "+v.Name, v.Value)
+	}
+	sort.Stable(values)
+	if ctx.user != nil {
+		values.add("User", fmt.Sprintf("%d (%T)", ctx.user.Id(), ctx.user))
+	}
+	return values
+}
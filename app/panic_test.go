@@ -0,0 +1,105 @@
+package app
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func errorValuesMap(values errorValues) map[string][]string {
+	m := make(map[string][]string)
+	for _, v := range values {
+		m[v.Name] = append(m[v.Name], v.Value)
+	}
+	return m
+}
+
+func TestErrorValues(t *testing.T) {
+	var values errorValues
+	long := strings.Repeat("a", maxFormValueLength+10)
+	values.add("b", "1", "2")
+	values.add("a", long)
+	if len(values) != 3 {
+		t.Fatalf("expecting 3 values, got %d", len(values))
+	}
+	if v := values[2].Value; v != long[:maxFormValueLength]+"..." {
+		t.Errorf("expecting truncated value with %d bytes, got %d", maxFormValueLength+3, len(v))
+	}
+	r, _ := http.NewRequest("GET", "http://example.com/", nil)
+	r.Header.Set("X-B", "b")
+	r.Header.Set("X-A", "a")
+	headers := errorHeaders(r)
+	var names []string
+	for _, v := range headers {
+		names = append(names, v.Name)
+	}
+	if expect := []string{"Host", "X-A", "X-B"}; !reflect.DeepEqual(names, expect) {
+		t.Errorf("expecting headers %v, got %v", expect, names)
+	}
+}
+
+func TestErrorForm(t *testing.T) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	w.WriteField("name", "foo")
+	fw, err := w.CreateFormFile("upload", "a.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fw.Write([]byte("contents"))
+	w.Close()
+	r, _ := http.NewRequest("POST", "http://example.com/?q=1", &buf)
+	r.Header.Set("Content-Type", w.FormDataContentType())
+	expect := map[string][]string{
+		"name":   {"foo"},
+		"q":      {"1"},
+		"upload": {"file a.txt (application/octet-stream)"},
+	}
+	if m := errorValuesMap(errorForm(r)); !reflect.DeepEqual(m, expect) {
+		t.Errorf("expecting form %v, got %v", expect, m)
+	}
+	// Invalid forms are ignored
+	r, _ = http.NewRequest("POST", "http://example.com/", strings.NewReader("%zz"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if values := errorForm(r); len(values) != 0 {
+		t.Errorf("expecting no values for an invalid form, got %v", errorValuesMap(values))
+	}
+}
+
+func TestPanicPage(t *testing.T) {
+	a := New()
+	a.Logger = nil
+	a.Config().Debug = true
+	a.Handle("^/(?P<id>\\d+)$", func(ctx *Context) {
+		panic("boom")
+	})
+	r, _ := http.NewRequest("GET", "http://example.com/42?q=search", nil)
+	r.AddCookie(&http.Cookie{Name: "flavor", Value: "chocolate"})
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expecting status 500, got %d", w.Code)
+	}
+	body := w.Body.String()
+	for _, v := range []string{
+		"boom",
+		"TestPanicPage",
+		"panic_test.go",
+		"Goroutines",
+		"Parameters",
+		"<th>id</th>",
+		"<td>42</td>",
+		"<td>search</td>",
+		"Cookie flavor",
+		"<td>chocolate</td>",
+		"<td>example.com</td>",
+	} {
+		if !strings.Contains(body, v) {
+			t.Errorf("panic page does not contain %q", v)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The pprof handlers are implemented here rather than using
+// net/http/pprof, since importing the latter registers its
+// handlers in http.DefaultServeMux without any authorization.
+
+const pprofPrefix = "/debug/pprof/"
+
+// addPprofHandlers adds the pprof handlers under /debug/pprof/,
+// wrapping them with protect.
+func (app *App) addPprofHandlers(protect func(Handler) Handler) {
+	app.Handle("^/debug/pprof/cmdline$", protect(pprofCmdlineHandler))
+	app.Handle("^/debug/pprof/profile$", protect(pprofProfileHandler))
+	app.Handle("^/debug/pprof/symbol$", protect(pprofSymbolHandler))
+	app.Handle("^/debug/pprof/trace$", protect(pprofTraceHandler))
+	app.Handle("^/debug/pprof/", protect(pprofIndexHandler))
+}
+
+func pprofCmdlineHandler(ctx *Context) {
+	ctx.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	ctx.WriteString(strings.Join(os.Args, "\x00"))
+}
+
+func pprofSeconds(ctx *Context, def int) time.Duration {
+	secs, err := strconv.Atoi(ctx.R.FormValue("seconds"))
+	if err != nil || secs <= 0 {
+		secs = def
+	}
+	return time.Duration(secs) * time.Second
+}
+
+// pprofProfileHandler responds with the CPU profile
+// for the number of seconds in the seconds parameter
+// (30 by default).
+func pprofProfileHandler(ctx *Context) {
+	var buf bytes.Buffer
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		ctx.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		ctx.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(ctx, "could not enable CPU profiling: %s\n", err)
+		return
+	}
+	time.Sleep(pprofSeconds(ctx, 30))
+	pprof.StopCPUProfile()
+	ctx.Header().Set("Content-Type", "application/octet-stream")
+	ctx.Header().Set("Content-Disposition", `attachment; filename="profile"`)
+	ctx.Write(buf.Bytes())
+}
+
+// pprofTraceHandler responds with the execution trace
+// for the number of seconds in the seconds parameter
+// (1 by default).
+func pprofTraceHandler(ctx *Context) {
+	var buf bytes.Buffer
+	if err := trace.Start(&buf); err != nil {
+		ctx.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		ctx.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(ctx, "could not enable tracing: %s\n", err)
+		return
+	}
+	time.Sleep(pprofSeconds(ctx, 1))
+	trace.Stop()
+	ctx.Header().Set("Content-Type", "application/octet-stream")
+	ctx.Header().Set("Content-Disposition", `attachment; filename="trace"`)
+	ctx.Write(buf.Bytes())
+}
+
+// pprofSymbolHandler looks up the program counters listed in the
+// request body (for POST) or in the query string, separated by +,
+// and responds with a table mapping each one to its function name.
+func pprofSymbolHandler(ctx *Context) {
+	ctx.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	var buf bytes.Buffer
+	// This value is ignored by pprof, it just
+	// indicates that symbols are available.
+	buf.WriteString("num_symbols: 1\n")
+	var b *bufio.Reader
+	if ctx.R.Method == "POST" {
+		b = bufio.NewReader(ctx.R.Body)
+	} else {
+		b = bufio.NewReader(strings.NewReader(ctx.R.URL.RawQuery))
+	}
+	for {
+		word, err := b.ReadSlice('+')
+		if err == nil {
+			word = word[:len(word)-1]
+		}
+		pc, _ := strconv.ParseUint(string(word), 0, 64)
+		if pc != 0 {
+			if f := runtime.FuncForPC(uintptr(pc)); f != nil {
+				fmt.Fprintf(&buf, "%#x %s\n", pc, f.Name())
+			}
+		}
+		if err != nil {
+			if err != io.EOF {
+				fmt.Fprintf(&buf, "reading request: %v\n", err)
+			}
+			break
+		}
+	}
+	ctx.Write(buf.Bytes())
+}
+
+// pprofIndexHandler responds with the profile named after the
+// prefix (e.g. /debug/pprof/heap) or, if there's no name, with
+// a list of the available profiles.
+func pprofIndexHandler(ctx *Context) {
+	name := strings.TrimPrefix(ctx.R.URL.Path, pprofPrefix)
+	if name != "" && name != ctx.R.URL.Path {
+		p := pprof.Lookup(name)
+		if p == nil {
+			ctx.NotFound("unknown profile")
+			return
+		}
+		debug, _ := strconv.Atoi(ctx.R.FormValue("debug"))
+		if debug != 0 {
+			ctx.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		} else {
+			ctx.Header().Set("Content-Type", "application/octet-stream")
+			ctx.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+		}
+		if name == "heap" && ctx.R.FormValue("gc") != "" {
+			runtime.GC()
+		}
+		p.WriteTo(ctx, debug)
+		return
+	}
+	var buf bytes.Buffer
+	buf.WriteString("<html><head><title>/debug/pprof/</title></head><body>\n<p>Profiles:</p>\n<table>\n")
+	for _, v := range pprof.Profiles() {
+		name := html.EscapeString(v.Name())
+		fmt.Fprintf(&buf, "<tr><td>%d</td><td><a href=\"%s?debug=1\">%s</a></td></tr>\n", v.Count(), name, name)
+	}
+	buf.WriteString("<tr><td></td><td><a href=\"cmdline\">cmdline</a></td></tr>\n")
+	buf.WriteString("<tr><td></td><td><a href=\"profile\">profile</a></td></tr>\n")
+	buf.WriteString("<tr><td></td><td><a href=\"trace\">trace</a></td></tr>\n")
+	buf.WriteString("</table>\n</body></html>\n")
+	ctx.Header().Set("Content-Type", "text/html; charset=utf-8")
+	ctx.Write(buf.Bytes())
+}
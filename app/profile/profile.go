@@ -69,8 +69,13 @@ func currentEvent() *Timed {
 	if ctx != nil {
 		ctx.Lock()
 		defer ctx.Unlock()
-		if len(ctx.events) > 0 {
-			return ctx.events[len(ctx.events)-1]
+		// Skip events which already finished, since zero
+		// length events (e.g. log messages) might be started
+		// and ended while another one is ongoing.
+		for ii := len(ctx.events) - 1; ii >= 0; ii-- {
+			if ev := ctx.events[ii]; ev.ended.IsZero() {
+				return ev
+			}
 		}
 	}
 	return nil
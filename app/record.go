@@ -0,0 +1,258 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gnd.la/log"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// recordBlobstore is the Config.RecordRequests value which
+	// stores the recorded requests in the app blobstore.
+	recordBlobstore = "blobstore"
+	// maxRecordedBodySize is the maximum number of bytes of
+	// the request body which are recorded.
+	maxRecordedBodySize = 1 << 20
+	// redactedValue replaces the redacted headers
+	// and form fields in the recorded requests.
+	redactedValue = "[REDACTED]"
+)
+
+var (
+	// RecordRedactedHeaders are the headers which have their values
+	// replaced before recording a request. See Config.RecordRequests.
+	RecordRedactedHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization"}
+	// RecordRedactedFields are the form fields, either in the query
+	// string or in an urlencoded body, which have their values replaced
+	// before recording a request. Fields are matched when their name,
+	// case insensitively, contains any of these strings.
+	RecordRedactedFields = []string{"password", "passwd", "secret", "token"}
+)
+
+// RecordedRequest is a request which failed with a 5xx error, as
+// recorded when Config.RecordRequests is enabled. Recorded requests
+// can be replayed against a local instance of the app with the
+// replay-request command, to reproduce the error.
+type RecordedRequest struct {
+	// Time is the time when the request was received.
+	Time time.Time `json:"time"`
+	// StatusCode is the status code sent in the response.
+	StatusCode int `json:"status_code"`
+	// Handler is the name of the handler which served
+	// the request, if any.
+	Handler       string      `json:"handler,omitempty"`
+	RemoteAddress string      `json:"remote_address"`
+	Method        string      `json:"method"`
+	Host          string      `json:"host"`
+	RequestURI    string      `json:"request_uri"`
+	Proto         string      `json:"proto"`
+	Header        http.Header `json:"header"`
+	Body          []byte      `json:"body,omitempty"`
+	// BodyTruncated is true when the request body was bigger
+	// than the maximum recorded size (1MiB).
+	BodyTruncated bool `json:"body_truncated,omitempty"`
+}
+
+// ReadRecordedRequest reads a RecordedRequest previously
+// written by the app from the given io.Reader.
+func ReadRecordedRequest(r io.Reader) (*RecordedRequest, error) {
+	var req RecordedRequest
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// Request returns a new *http.Request which can be passed to
+// App.ServeHTTP to replay the recorded request.
+func (r *RecordedRequest) Request() (*http.Request, error) {
+	req, err := http.NewRequest(r.Method, r.RequestURI, bytes.NewReader(r.Body))
+	if err != nil {
+		return nil, err
+	}
+	req.RequestURI = r.RequestURI
+	req.Host = r.Host
+	if r.Proto != "" {
+		if major, minor, ok := http.ParseHTTPVersion(r.Proto); ok {
+			req.Proto = r.Proto
+			req.ProtoMajor = major
+			req.ProtoMinor = minor
+		}
+	}
+	req.RemoteAddr = r.RemoteAddress
+	for k, v := range r.Header {
+		req.Header[k] = append([]string(nil), v...)
+	}
+	return req, nil
+}
+
+// recordingBody wraps a request body, keeping a copy of
+// the first maxRecordedBodySize bytes read from it.
+type recordingBody struct {
+	io.ReadCloser
+	buf       bytes.Buffer
+	truncated bool
+}
+
+func (b *recordingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.record(p[:n])
+	return n, err
+}
+
+func (b *recordingBody) record(p []byte) {
+	if rem := maxRecordedBodySize - b.buf.Len(); len(p) > rem {
+		p = p[:rem]
+		b.truncated = true
+	}
+	b.buf.Write(p)
+}
+
+// body returns the recorded body, reading the part which
+// wasn't consumed by the handler.
+func (b *recordingBody) body() ([]byte, bool) {
+	if !b.truncated {
+		// Read one more byte than allowed, so
+		// record() detects bodies which exceed it
+		var buf bytes.Buffer
+		io.CopyN(&buf, b.ReadCloser, int64(maxRecordedBodySize-b.buf.Len()+1))
+		b.record(buf.Bytes())
+	}
+	return b.buf.Bytes(), b.truncated
+}
+
+// startRecording wraps the request body if the app records
+// failed requests, so its contents are available if the
+// request fails.
+func (app *App) startRecording(ctx *Context) {
+	if app.cfg.RecordRequests == "" || ctx.R == nil {
+		return
+	}
+	rb := &recordingBody{ReadCloser: ctx.R.Body}
+	if rb.ReadCloser == nil {
+		rb.ReadCloser = ioutil.NopCloser(strings.NewReader(""))
+	}
+	ctx.R.Body = rb
+	ctx.recording = rb
+}
+
+// recordRequest records the request if it failed with a 5xx
+// error. See Config.RecordRequests.
+func (app *App) recordRequest(ctx *Context) {
+	rb := ctx.recording
+	if rb == nil || ctx.statusCode < 500 {
+		return
+	}
+	ctx.recording = nil
+	body, truncated := rb.body()
+	r := ctx.R
+	rec := &RecordedRequest{
+		Time:          ctx.started.UTC(),
+		StatusCode:    ctx.statusCode,
+		Handler:       ctx.handlerName,
+		RemoteAddress: r.RemoteAddr,
+		Method:        r.Method,
+		Host:          r.Host,
+		RequestURI:    r.RequestURI,
+		Proto:         r.Proto,
+		Header:        redactHeader(r.Header),
+		Body:          body,
+		BodyTruncated: truncated,
+	}
+	if rec.RequestURI == "" {
+		rec.RequestURI = r.URL.RequestURI()
+	}
+	if p := strings.IndexByte(rec.RequestURI, '?'); p >= 0 {
+		rec.RequestURI = rec.RequestURI[:p+1] + redactQuery(rec.RequestURI[p+1:])
+	}
+	if ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); ct == "application/x-www-form-urlencoded" {
+		rec.Body = []byte(redactQuery(string(rec.Body)))
+	}
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		log.Errorf("error encoding recorded request: %s", err)
+		return
+	}
+	var dest string
+	if app.cfg.RecordRequests == recordBlobstore {
+		bs, err := app.Blobstore()
+		if err == nil {
+			dest, err = bs.Store(data, nil)
+		}
+		if err != nil {
+			log.Errorf("error storing recorded request in the blobstore: %s", err)
+			return
+		}
+		dest = "blobstore id " + dest
+	} else {
+		dir := app.cfg.RecordRequests
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			log.Errorf("error creating directory for recorded requests: %s", err)
+			return
+		}
+		name := fmt.Sprintf("%s-%d-%s.json", rec.Time.Format("20060102T150405.000000000"), rec.StatusCode, stringutil.Random(8))
+		dest = filepath.Join(dir, name)
+		if err := ioutil.WriteFile(dest, data, 0600); err != nil {
+			log.Errorf("error writing recorded request: %s", err)
+			return
+		}
+	}
+	log.Infof("recorded failed request %s %s to %s", r.Method, rec.RequestURI, dest)
+}
+
+// redactHeader returns a copy of h with the values of
+// the RecordRedactedHeaders replaced.
+func redactHeader(h http.Header) http.Header {
+	redacted := make(http.Header, len(h))
+	for k, v := range h {
+		redacted[k] = append([]string(nil), v...)
+	}
+	for _, v := range RecordRedactedHeaders {
+		vals := redacted[http.CanonicalHeaderKey(v)]
+		for ii := range vals {
+			vals[ii] = redactedValue
+		}
+	}
+	return redacted
+}
+
+// redactQuery replaces the values of the RecordRedactedFields in
+// the given urlencoded query, keeping the rest of it verbatim.
+func redactQuery(q string) string {
+	pairs := strings.Split(q, "&")
+	for ii, v := range pairs {
+		key := v
+		if p := strings.IndexByte(v, '='); p >= 0 {
+			key = v[:p]
+		}
+		if k, err := url.QueryUnescape(key); err == nil {
+			key = k
+		}
+		if isRedactedField(key) {
+			pairs[ii] = url.QueryEscape(key) + "=" + url.QueryEscape(redactedValue)
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+func isRedactedField(name string) bool {
+	name = strings.ToLower(name)
+	for _, v := range RecordRedactedFields {
+		if strings.Contains(name, strings.ToLower(v)) {
+			return true
+		}
+	}
+	return false
+}
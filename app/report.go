@@ -0,0 +1,123 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sync"
+
+	"gnd.la/app/report"
+	"gnd.la/internal/runtimeutil"
+	"gnd.la/log"
+)
+
+// maxQueuedReports is the maximum number of error reports waiting
+// to be delivered. Once it's reached, new reports are dropped, so
+// an error storm can't exhaust the memory.
+const maxQueuedReports = 100
+
+type queuedReport struct {
+	reporters []report.Reporter
+	report    *report.Report
+}
+
+// reportQueue delivers the error reports from a single goroutine,
+// which only runs while there are reports waiting in the queue.
+type reportQueue struct {
+	mu      sync.Mutex
+	queue   []*queuedReport
+	running bool
+}
+
+// add queues the report for delivery, returning false
+// if it was dropped because the queue is full.
+func (q *reportQueue) add(reporters []report.Reporter, r *report.Report) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.queue) >= maxQueuedReports {
+		return false
+	}
+	q.queue = append(q.queue, &queuedReport{reporters: reporters, report: r})
+	if !q.running {
+		q.running = true
+		go q.run()
+	}
+	return true
+}
+
+func (q *reportQueue) run() {
+	for {
+		q.mu.Lock()
+		if len(q.queue) == 0 {
+			q.running = false
+			q.mu.Unlock()
+			return
+		}
+		qr := q.queue[0]
+		q.queue[0] = nil
+		q.queue = q.queue[1:]
+		q.mu.Unlock()
+		for _, v := range qr.reporters {
+			if err := v.Report(qr.report); err != nil {
+				log.Warningf("error delivering error report with %T: %s", v, err)
+			}
+		}
+	}
+}
+
+// reportError creates a report for the given error and delivers it to
+// the app ErrorReporters, if any. The skip argument indicates the number
+// of frames to skip, starting at the caller of reportError. If stack is
+// empty, it's generated from the frames.
+func (app *App) reportError(ctx *Context, err interface{}, panicked bool, code int, skip int, stack string) {
+	if len(app.ErrorReporters) == 0 {
+		return
+	}
+	r := &report.Report{
+		Message:    fmt.Sprint(err),
+		Panic:      panicked,
+		StatusCode: code,
+		Release:    app.cfg.Release,
+		Version:    app.cfg.Version,
+	}
+	if panicked {
+		r.Type = fmt.Sprintf("%T", err)
+	} else {
+		r.Type = fmt.Sprintf("HTTP %d", code)
+	}
+	r.Hostname, _ = os.Hostname()
+	for _, v := range runtimeutil.Stack(skip + 1) {
+		r.Frames = append(r.Frames, &report.Frame{
+			Function: v.Function,
+			File:     v.File,
+			Line:     v.Line,
+			InApp:    !v.IsStd(),
+		})
+	}
+	if stack == "" {
+		var buf bytes.Buffer
+		for _, v := range r.Frames {
+			fmt.Fprintf(&buf, "%s\n\t%s:%d\n", v.Function, v.File, v.Line)
+		}
+		stack = buf.String()
+	}
+	r.Stack = stack
+	if ctx.R != nil {
+		r.Request = &report.Request{
+			Method:        ctx.R.Method,
+			URL:           report.FilterURL(ctx.URL()),
+			Header:        report.FilterHeader(ctx.R.Header),
+			RemoteAddress: ctx.RemoteAddress(),
+			Handler:       ctx.handlerName,
+		}
+		// Don't call ctx.User(), since the UserFunc
+		// might be the cause of the error.
+		if ctx.user != nil {
+			r.Request.UserId = ctx.user.Id()
+		}
+	}
+	app.errorGroups.Add(r)
+	if !app.reports.add(app.ErrorReporters, r) {
+		log.Warningf("too many pending error reports, dropping report for %q", r.Message)
+	}
+}
@@ -0,0 +1,152 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"gnd.la/net/mail"
+)
+
+type digestEntry struct {
+	report *Report
+	count  int
+}
+
+type digestEntries []*digestEntry
+
+func (d digestEntries) Len() int {
+	return len(d)
+}
+
+func (d digestEntries) Less(i, j int) bool {
+	return d[i].count > d[j].count
+}
+
+func (d digestEntries) Swap(i, j int) {
+	d[i], d[j] = d[j], d[i]
+}
+
+// EmailDigest is a Reporter which groups the reports by their
+// fingerprint and sends a single email with all of them at most
+// once every interval. Use NewEmailDigest to initialize an
+// EmailDigest.
+type EmailDigest struct {
+	to       string
+	interval time.Duration
+	mu       sync.Mutex
+	entries  map[string]*digestEntry
+	timer    *time.Timer
+}
+
+// NewEmailDigest returns a new EmailDigest which sends the reports
+// to the given address, or to the administrator email (see
+// gnd.la/net/mail.AdminEmail) if it's empty. The first report
+// received after a digest is sent starts a new one, which is sent
+// after the given interval. If interval is zero, it defaults to
+// one hour.
+func NewEmailDigest(to string, interval time.Duration) *EmailDigest {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &EmailDigest{
+		to:       to,
+		interval: interval,
+	}
+}
+
+// Report implements the Reporter interface.
+func (e *EmailDigest) Report(r *Report) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.entries == nil {
+		e.entries = make(map[string]*digestEntry)
+	}
+	entry := e.entries[r.Fingerprint]
+	if entry == nil {
+		// Keep the first report for each group
+		entry = &digestEntry{report: r}
+		e.entries[r.Fingerprint] = entry
+	}
+	entry.count++
+	if e.timer == nil {
+		e.timer = time.AfterFunc(e.interval, func() {
+			e.Flush()
+		})
+	}
+	return nil
+}
+
+// Flush sends the pending reports immediately. If there
+// are no pending reports, no email is sent.
+func (e *EmailDigest) Flush() error {
+	e.mu.Lock()
+	if e.timer != nil {
+		e.timer.Stop()
+		e.timer = nil
+	}
+	entries := make(digestEntries, 0, len(e.entries))
+	for _, v := range e.entries {
+		entries = append(entries, v)
+	}
+	e.entries = nil
+	e.mu.Unlock()
+	if len(entries) == 0 {
+		return nil
+	}
+	sort.Stable(entries)
+	return e.send(entries)
+}
+
+func (e *EmailDigest) send(entries digestEntries) error {
+	to := e.to
+	if to == "" {
+		to = mail.AdminEmail()
+	}
+	host, _ := os.Hostname()
+	from := mail.DefaultFrom()
+	if from == "" {
+		from = fmt.Sprintf("errors@%s", host)
+	}
+	total := 0
+	for _, v := range entries {
+		total += v.count
+	}
+	var buf bytes.Buffer
+	for ii, v := range entries {
+		if ii > 0 {
+			buf.WriteString("\n\n")
+		}
+		writeDigestEntry(&buf, v)
+	}
+	return mail.Send(&mail.Message{
+		From:     from,
+		To:       to,
+		Subject:  fmt.Sprintf("%d errors (%d different) on %s", total, len(entries), host),
+		TextBody: buf.String(),
+	})
+}
+
+func writeDigestEntry(buf *bytes.Buffer, entry *digestEntry) {
+	r := entry.report
+	fmt.Fprintf(buf, "%s (%d times, %s)\n", r.Message, entry.count, r.Type)
+	if culprit := r.Culprit(); culprit != "" {
+		fmt.Fprintf(buf, "At %s\n", culprit)
+	}
+	fmt.Fprintf(buf, "Fingerprint: %s\n", r.Fingerprint)
+	fmt.Fprintf(buf, "First seen: %s\n", r.FirstSeen.Format(time.RFC3339))
+	if r.Release != "" || r.Version != "" {
+		fmt.Fprintf(buf, "Release: %s, version: %s\n", r.Release, r.Version)
+	}
+	if req := r.Request; req != nil {
+		fmt.Fprintf(buf, "Request: %s %s from %s\n", req.Method, req.URL, req.RemoteAddress)
+	}
+	if r.Stack != "" {
+		buf.WriteString("Stack:\n")
+		buf.WriteString(r.Stack)
+		buf.WriteByte('\n')
+	}
+}
@@ -0,0 +1,157 @@
+package report
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const sentryTimeFormat = "2006-01-02T15:04:05"
+
+// Sentry is a Reporter which sends the reports to a Sentry
+// compatible endpoint. Use NewSentry to initialize a Sentry.
+type Sentry struct {
+	endpoint  string
+	publicKey string
+	secretKey string
+}
+
+// NewSentry returns a new Sentry reporter from the given DSN,
+// which must have the form:
+//
+//  {scheme}://{public_key}[:{secret_key}]@{host}[/{path}]/{project_id}
+func NewSentry(dsn string) (*Sentry, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return nil, fmt.Errorf("sentry DSN %q has no public key", dsn)
+	}
+	p := strings.TrimSuffix(u.Path, "/")
+	slash := strings.LastIndex(p, "/")
+	if slash < 0 || slash == len(p)-1 {
+		return nil, fmt.Errorf("sentry DSN %q has no project id", dsn)
+	}
+	project := p[slash+1:]
+	secret, _ := u.User.Password()
+	endpoint := url.URL{
+		Scheme: u.Scheme,
+		Host:   u.Host,
+		Path:   p[:slash] + "/api/" + project + "/store/",
+	}
+	return &Sentry{
+		endpoint:  endpoint.String(),
+		publicKey: u.User.Username(),
+		secretKey: secret,
+	}, nil
+}
+
+// Report implements the Reporter interface.
+func (s *Sentry) Report(r *Report) error {
+	data, err := json.Marshal(sentryEvent(r))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", s.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Sentry-Auth", s.auth(time.Now()))
+	return doRequest(req)
+}
+
+func (s *Sentry) auth(now time.Time) string {
+	values := []string{
+		"sentry_version=7",
+		"sentry_client=gondola/1.0",
+		"sentry_timestamp=" + strconv.FormatInt(now.Unix(), 10),
+		"sentry_key=" + s.publicKey,
+	}
+	if s.secretKey != "" {
+		values = append(values, "sentry_secret="+s.secretKey)
+	}
+	return "Sentry " + strings.Join(values, ", ")
+}
+
+func sentryEvent(r *Report) map[string]interface{} {
+	// Sentry expects the frames with the outermost call first
+	frames := make([]map[string]interface{}, len(r.Frames))
+	for ii, v := range r.Frames {
+		frames[len(frames)-ii-1] = map[string]interface{}{
+			"function": v.Function,
+			"filename": v.File,
+			"lineno":   v.Line,
+			"in_app":   v.InApp,
+		}
+	}
+	event := map[string]interface{}{
+		"event_id":    newEventId(),
+		"timestamp":   r.Time.UTC().Format(sentryTimeFormat),
+		"level":       "error",
+		"logger":      "gnd.la/app",
+		"platform":    "go",
+		"message":     r.Message,
+		"culprit":     r.Culprit(),
+		"server_name": r.Hostname,
+		"fingerprint": []string{r.Fingerprint},
+		"exception": map[string]interface{}{
+			"values": []interface{}{
+				map[string]interface{}{
+					"type":       r.Type,
+					"value":      r.Message,
+					"stacktrace": map[string]interface{}{"frames": frames},
+				},
+			},
+		},
+		"tags": map[string]string{
+			"status_code": strconv.Itoa(r.StatusCode),
+		},
+		"extra": map[string]interface{}{
+			"count":      r.Count,
+			"first_seen": r.FirstSeen.UTC().Format(sentryTimeFormat),
+		},
+	}
+	if r.Release != "" {
+		event["release"] = r.Release
+	}
+	if r.Version != "" {
+		event["tags"].(map[string]string)["version"] = r.Version
+	}
+	if req := r.Request; req != nil {
+		headers := make(map[string]string, len(req.Header))
+		for k, v := range req.Header {
+			headers[k] = strings.Join(v, ", ")
+		}
+		event["request"] = map[string]interface{}{
+			"url":     req.URL,
+			"method":  req.Method,
+			"headers": headers,
+			"env":     map[string]string{"REMOTE_ADDR": req.RemoteAddress},
+		}
+		if req.UserId != 0 {
+			event["user"] = map[string]interface{}{"id": strconv.FormatInt(req.UserId, 10)}
+		}
+	}
+	return event
+}
+
+func newEventId() string {
+	var b [16]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		panic(err)
+	}
+	// UUID version 4
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return hex.EncodeToString(b[:])
+}
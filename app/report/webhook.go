@@ -0,0 +1,39 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+)
+
+// Webhook is a Reporter which sends each report encoded as
+// JSON in the body of a POST request to the given URL.
+type Webhook struct {
+	// URL is the webhook URL.
+	URL string
+	// Header contains additional headers sent
+	// with each request (e.g. for authentication).
+	Header http.Header
+}
+
+// NewWebhook returns a new Webhook which posts to the given URL.
+func NewWebhook(url string) *Webhook {
+	return &Webhook{URL: url}
+}
+
+// Report implements the Reporter interface.
+func (w *Webhook) Report(r *Report) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", w.URL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	for k, v := range w.Header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return doRequest(req)
+}
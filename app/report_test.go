@@ -0,0 +1,57 @@
+package app
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gnd.la/app/report"
+)
+
+func TestReportQueue(t *testing.T) {
+	var q reportQueue
+	started := make(chan struct{}, 1)
+	unblock := make(chan struct{})
+	var delivered int32
+	reporters := []report.Reporter{report.ReporterFunc(func(r *report.Report) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-unblock
+		atomic.AddInt32(&delivered, 1)
+		return nil
+	})}
+	if !q.add(reporters, &report.Report{}) {
+		t.Fatal("first report was dropped")
+	}
+	// Wait until the report is being delivered
+	<-started
+	for ii := 0; ii < maxQueuedReports; ii++ {
+		if !q.add(reporters, &report.Report{}) {
+			t.Fatalf("report %d was dropped", ii)
+		}
+	}
+	if q.add(reporters, &report.Report{}) {
+		t.Error("expecting report to be dropped with a full queue")
+	}
+	close(unblock)
+	deadline := time.Now().Add(5 * time.Second)
+	for atomic.LoadInt32(&delivered) != maxQueuedReports+1 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := atomic.LoadInt32(&delivered); n != maxQueuedReports+1 {
+		t.Errorf("expecting %d delivered reports, got %d", maxQueuedReports+1, n)
+	}
+	running := func() bool {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		return q.running
+	}
+	for running() && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if running() {
+		t.Error("delivery goroutine still running with an empty queue")
+	}
+}
@@ -59,3 +59,48 @@ func BenchmarkReverse(b *testing.B) {
 		runReverseTests(tb, a, m)
 	}
 }
+
+func TestReverseNamespaced(t *testing.T) {
+	newApp := func(name string) *App {
+		a := New()
+		a.SetName(name)
+		a.HandleNamed("^/$", helloHandler, "index")
+		a.HandleNamed("^/source/(.+)$", helloHandler, "source")
+		return a
+	}
+	root := newApp("")
+	docs := newApp("Docs")
+	users := newApp("Users")
+	admin := newApp("Admin")
+	auth := newApp("Auth")
+	admin.Include("/auth/", auth, "")
+	root.Include("/docs/", docs, "")
+	root.Include("/users/", users, "")
+	root.Include("/admin/", admin, "")
+	testReverse(t, "/", root, "index", nil)
+	testReverse(t, "/docs/source/a.go", root, "docs:source", []interface{}{"a.go"})
+	testReverse(t, "/users/source/b.go", root, "users:source", []interface{}{"b.go"})
+	testReverse(t, "/admin/auth/", root, "admin:auth:index", nil)
+	// Unqualified names are resolved in the app itself first
+	testReverse(t, "/docs/", docs, "index", nil)
+	// Namespaces are also resolved relative to the parents
+	testReverse(t, "/users/", docs, "users:index", nil)
+	testReverse(t, "/admin/auth/", auth, "auth:index", nil)
+	testReverse(t, "/", users, ":index", nil)
+	testReverse(t, "/", auth, ":index", nil)
+	testReverse(t, "", root, "blog:index", nil)
+	testReverse(t, "", root, "docs:missing", nil)
+	testReverse(t, "", root, "auth:index", nil)
+}
+
+func TestIncludeDuplicateName(t *testing.T) {
+	a := New()
+	a1 := New()
+	a1.SetName("Docs")
+	a2 := New()
+	a2.SetName("docs")
+	a.Include("/docs/", a1, "")
+	if err := a.include("/more-docs/", a2, ""); err == nil {
+		t.Error("expecting an error when including apps with names differing only in case")
+	}
+}
@@ -0,0 +1,138 @@
+package app
+
+import (
+	"encoding/binary"
+	"errors"
+	"net/http"
+	"time"
+
+	"gnd.la/app/cookies"
+	"gnd.la/encoding/codec"
+)
+
+const signedSaltPrefix = "gnd.la/app/signed:"
+
+var (
+	// ErrSignedExpired is returned when retrieving a signed
+	// value after its expiration.
+	ErrSignedExpired = errors.New("signed value has expired")
+	// ErrNoSignedValue is returned by Context.GetSigned and
+	// Context.GetSignedParam when the request has no value
+	// with the given name.
+	ErrNoSignedValue = errors.New("no signed value")
+)
+
+func (app *App) signedCodec() *codec.Codec {
+	if app.CookieCodec != nil {
+		return app.CookieCodec
+	}
+	return codec.Get("gob")
+}
+
+// Sign encodes and signs the given value using the App Secret,
+// returning a string which can be safely included in URLs (e.g.
+// in email confirmation or unsubscribe links). The name is used
+// for salting the signature, so a value signed for a given purpose
+// can't be used for another one. If ttl is positive, the expiration
+// time is encoded in the signed payload and Unsign will reject the
+// value after it. See also Context.SetSigned.
+func (app *App) Sign(name string, value interface{}, ttl time.Duration) (string, error) {
+	signer, err := app.Signer([]byte(signedSaltPrefix + name))
+	if err != nil {
+		return "", err
+	}
+	data, err := app.signedCodec().Encode(value)
+	if err != nil {
+		return "", err
+	}
+	var expires int64
+	if ttl > 0 {
+		expires = time.Now().Add(ttl).Unix()
+	}
+	payload := make([]byte, 8+len(data))
+	binary.BigEndian.PutUint64(payload, uint64(expires))
+	copy(payload[8:], data)
+	return signer.Sign(payload)
+}
+
+// Unsign verifies a value previously returned by Sign with the
+// same name and, if the signature is valid and the value hasn't
+// expired, decodes it into out. Expired values return
+// ErrSignedExpired.
+func (app *App) Unsign(name string, signed string, out interface{}) error {
+	signer, err := app.Signer([]byte(signedSaltPrefix + name))
+	if err != nil {
+		return err
+	}
+	payload, err := signer.Unsign(signed)
+	if err != nil {
+		return err
+	}
+	if len(payload) < 8 {
+		return errors.New("invalid signed value")
+	}
+	if expires := int64(binary.BigEndian.Uint64(payload)); expires != 0 && time.Now().Unix() >= expires {
+		return ErrSignedExpired
+	}
+	return app.signedCodec().Decode(payload[8:], out)
+}
+
+// SetSigned signs the given value with App.Sign and stores it in
+// a cookie with the given name, which expires after the ttl (if
+// positive). Since the value is signed rather than encrypted, the
+// client can read it but it can't tamper with it.
+func (c *Context) SetSigned(name string, value interface{}, ttl time.Duration) error {
+	signed, err := c.app.Sign(name, value, ttl)
+	if err != nil {
+		return err
+	}
+	if len(signed) > cookies.MaxSize {
+		return cookies.ErrCookieTooBig
+	}
+	opts := c.app.CookieOptions
+	if opts == nil {
+		opts = cookies.Defaults()
+	}
+	cookie := &http.Cookie{
+		Name:     name,
+		Value:    signed,
+		Path:     opts.Path,
+		Domain:   opts.Domain,
+		Expires:  opts.Expires,
+		MaxAge:   opts.MaxAge,
+		Secure:   opts.Secure,
+		HttpOnly: opts.HttpOnly,
+	}
+	if ttl > 0 {
+		cookie.Expires = time.Now().Add(ttl)
+		cookie.MaxAge = int(ttl / time.Second)
+	}
+	c.Cookies().SetCookie(cookie)
+	return nil
+}
+
+// GetSigned retrieves a value stored with SetSigned in the cookie
+// with the given name and decodes it into out. If there's no such
+// cookie, ErrNoSignedValue is returned. See App.Unsign for the rest
+// of the errors. Note that request parameters are never used, use
+// GetSignedParam for values signed with App.Sign and passed in URLs.
+func (c *Context) GetSigned(name string, out interface{}) error {
+	cookie, err := c.Cookies().GetCookie(name)
+	if err != nil {
+		return ErrNoSignedValue
+	}
+	return c.app.Unsign(name, cookie.Value, out)
+}
+
+// GetSignedParam retrieves a value signed with App.Sign using the given
+// name from the request parameter with the same name (e.g. a token in
+// a link sent by email) and decodes it into out. If there's no such
+// parameter, ErrNoSignedValue is returned. See App.Unsign for the rest
+// of the errors.
+func (c *Context) GetSignedParam(name string, out interface{}) error {
+	signed := c.FormValue(name)
+	if signed == "" {
+		return ErrNoSignedValue
+	}
+	return c.app.Unsign(name, signed, out)
+}
@@ -1,14 +1,21 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"io"
 	"os"
+	"time"
 
 	"gnd.la/app/profile"
+	"gnd.la/html/paginator"
+	"gnd.la/i18n"
 	"gnd.la/internal/templateutil"
+	"gnd.la/log"
 	"gnd.la/template"
 	"gnd.la/template/assets"
+	"gnd.la/tracing"
+	"gnd.la/util/types"
 
 	"gopkgs.com/vfs.v1"
 )
@@ -16,15 +23,25 @@ import (
 var (
 	reservedVariables     = []string{"Ctx", "App", "Apps"}
 	internalAssetsManager = assets.New(appAssets, assetsPrefix)
-	profileHook           *template.Hook
 	errNoLoadedTemplate   = errors.New("this template was not loaded from App.LoadTemplate nor NewTemplate")
 
 	templateFuncs = template.FuncMap{
-		"!t":   template_t,
-		"!tn":  template_tn,
-		"!tc":  template_tc,
-		"!tnc": template_tnc,
-		"app":  nop,
+		"!t":                                template_t,
+		"!tn":                               template_tn,
+		"!tc":                               template_tc,
+		"!tnc":                              template_tnc,
+		"!lang":                             template_lang,
+		"!format_number":                    template_format_number,
+		"!format_percent":                   template_format_percent,
+		"!format_currency":                  template_format_currency,
+		"!format_date":                      template_format_date,
+		"!format_time":                      template_format_time,
+		"!format_datetime":                  template_format_datetime,
+		"!translated":                       template_translated,
+		"!paginator":                        template_paginator,
+		"user":                              template_user,
+		"user_has":                          template_user_has,
+		"app":                               nop,
 		templateutil.BeginTranslatableBlock: nop,
 		templateutil.EndTranslatableBlock:   nop,
 	}
@@ -88,7 +105,10 @@ func (t *Template) reverse(name string, args ...interface{}) (string, error) {
 
 // Execute executes the template, writing its result to the given
 // *Context. Note that Template uses an intermediate buffer, so
-// nothing will be written to the *Context in case of error.
+// nothing will be written to the *Context in case of error, unless
+// the template contains {{ defer }} blocks. In that case, the output
+// is streamed and an error in a deferred block is returned after
+// the rest of the template has been sent.
 func (t *Template) Execute(ctx *Context, data interface{}) error {
 	return t.ExecuteTo(ctx, ctx, data)
 }
@@ -107,9 +127,31 @@ func (t *Template) ExecuteTo(w io.Writer, ctx *Context, data interface{}) error
 		tvars = make(map[string]interface{})
 	}
 	tvars["Ctx"] = ctx
+	if tracing.Enabled() {
+		var parent context.Context
+		if ctx != nil {
+			parent = ctx.traceContext()
+		}
+		span := tracing.Start(parent, "template "+t.tmpl.Root(), tracing.Internal)
+		err = t.tmpl.ExecuteContext(w, data, ctx, tvars)
+		span.SetError(err).End()
+		return err
+	}
 	return t.tmpl.ExecuteContext(w, data, ctx, tvars)
 }
 
+func template_paginator(ctx *Context, count int, param ...string) (*paginator.Paginator, error) {
+	var name string
+	switch len(param) {
+	case 0:
+	case 1:
+		name = param[0]
+	default:
+		return nil, errors.New("paginator accepts at most one parameter name")
+	}
+	return ctx.Paginator(count, name), nil
+}
+
 func template_t(ctx *Context, str string) string {
 	return ctx.T(str)
 }
@@ -122,16 +164,117 @@ func template_tc(ctx *Context, context string, str string) string {
 	return ctx.Tc(context, str)
 }
 
+func template_lang(ctx *Context) string {
+	return HTMLLanguage(ctx.Language())
+}
+
 func template_tnc(ctx *Context, context string, singular string, plural string, n int) string {
 	return ctx.Tnc(context, singular, plural, n)
 }
 
+// template_user implements {{ user }}, returning the
+// currently signed in user (or nil if there's none).
+func template_user(ctx *Context) User {
+	if ctx == nil {
+		return nil
+	}
+	return ctx.User()
+}
+
+// template_user_has implements {{ user_has "perm" }}, returning
+// whether the currently signed in user has the given permission.
+func template_user_has(ctx *Context, perm string) bool {
+	if ctx == nil {
+		return false
+	}
+	return ctx.UserHas(perm)
+}
+
+func template_translated(ctx *Context, t i18n.Translatable) string {
+	return t.Value(ctx)
+}
+
+func templateDecimals(decimals []int) int {
+	if len(decimals) > 0 {
+		return decimals[0]
+	}
+	return -1
+}
+
+func templateDateStyle(style []string) (i18n.DateStyle, error) {
+	if len(style) > 0 {
+		return i18n.ParseDateStyle(style[0])
+	}
+	return i18n.DateMedium, nil
+}
+
+// template_format_number implements {{ format_number $n }} and
+// {{ format_number $n $decimals }}
+func template_format_number(ctx *Context, n interface{}, decimals ...int) (string, error) {
+	val, err := types.ToFloat(n)
+	if err != nil {
+		return "", err
+	}
+	return i18n.FormatNumber(ctx, val, templateDecimals(decimals)), nil
+}
+
+func template_format_percent(ctx *Context, n interface{}, decimals ...int) (string, error) {
+	val, err := types.ToFloat(n)
+	if err != nil {
+		return "", err
+	}
+	return i18n.FormatPercent(ctx, val, templateDecimals(decimals)), nil
+}
+
+func template_format_currency(ctx *Context, amount interface{}, currency string) (string, error) {
+	val, err := types.ToFloat(amount)
+	if err != nil {
+		return "", err
+	}
+	return i18n.FormatCurrency(ctx, val, currency), nil
+}
+
+// template_format_date implements {{ format_date $t }} and
+// {{ format_date $t "long" }}. The default style is "medium".
+func template_format_date(ctx *Context, t time.Time, style ...string) (string, error) {
+	s, err := templateDateStyle(style)
+	if err != nil {
+		return "", err
+	}
+	return i18n.FormatDate(ctx, t, s), nil
+}
+
+func template_format_time(ctx *Context, t time.Time, style ...string) (string, error) {
+	s, err := templateDateStyle(style)
+	if err != nil {
+		return "", err
+	}
+	return i18n.FormatTime(ctx, t, s), nil
+}
+
+func template_format_datetime(ctx *Context, t time.Time, style ...string) (string, error) {
+	s, err := templateDateStyle(style)
+	if err != nil {
+		return "", err
+	}
+	return i18n.FormatDateTime(ctx, t, s), nil
+}
+
 func newTemplate(app *App, fs vfs.VFS, manager *assets.Manager) *Template {
 	t := &Template{tmpl: template.New(fs, manager), app: app}
 	if app.cfg != nil {
 		t.tmpl.Debug = app.cfg.TemplateDebug
+		if !t.tmpl.Debug {
+			t.tmpl.CacheDir = app.cfg.TemplateCacheDir
+		}
+		t.tmpl.MaxDepth = app.cfg.TemplateMaxDepth
+		t.tmpl.MaxIterations = app.cfg.TemplateMaxIterations
+		t.tmpl.Timeout = time.Duration(app.cfg.TemplateTimeout) * time.Millisecond
 	}
 	t.tmpl.Funcs(templateFuncs).Funcs(template.FuncMap{"#reverse": t.reverse})
+	if app.templateFuncMap != nil {
+		t.tmpl.Funcs(app.templateFuncMap)
+	}
 	return t
 }
 
@@ -159,20 +302,13 @@ func LoadTemplate(app *App, fs vfs.VFS, manager *assets.Manager, name string) (*
 func nop() interface{} { return nil }
 
 func init() {
+	// Allow declaring functions which receive the
+	// *Context without prefixing their names with !
+	template.AddContextType((*Context)(nil))
 	if profile.On {
 		inDevServer = os.Getenv("GONDOLA_DEV_SERVER") != ""
 		if inDevServer {
-			t := newInternalTemplate(&App{})
-			t.tmpl.Funcs(template.FuncMap{
-				"_gondola_profile_info": getProfileInfo,
-				"_gondola_internal_asset": func(arg string) string {
-					return internalAssetsManager.URL(arg)
-				},
-			})
-			if err := t.parse("profile.html", nil); err != nil {
-				panic(err)
-			}
-			profileHook = &template.Hook{Template: t.tmpl, Position: assets.Bottom}
+			log.Std.AddWriter(toolbarLogWriter{})
 		}
 	}
 }
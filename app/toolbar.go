@@ -0,0 +1,267 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"gnd.la/app/profile"
+	"gnd.la/log"
+)
+
+const (
+	// toolbarLog is the profile event name used for
+	// recording the log messages for a request.
+	toolbarLog = "log"
+	// toolbarCacheResult is the note title used by gnd.la/cache
+	// for indicating if a GET was a hit or a miss.
+	toolbarCacheResult = "result"
+)
+
+// toolbarSections are the timings shown in their own
+// section in the toolbar, in the same order.
+var toolbarSections = []struct {
+	Name  string
+	Title string
+}{
+	{"orm", "SQL"},
+	{"cache", "Cache"},
+	{"template", "Templates"},
+	{"signal", "Signals"},
+	{"http", "HTTP"},
+}
+
+// toolbarWriter wraps the http.ResponseWriter when the development
+// toolbar is enabled. HTML responses are buffered, so the toolbar
+// can be injected before </body>, while any other responses are
+// directly written to the underlying http.ResponseWriter.
+type toolbarWriter struct {
+	http.ResponseWriter
+	code      int
+	decided   bool
+	buffering bool
+	buf       bytes.Buffer
+}
+
+func (w *toolbarWriter) decide(data []byte) {
+	if w.decided {
+		return
+	}
+	w.decided = true
+	header := w.Header()
+	ct := header.Get("Content-Type")
+	if ct == "" && len(data) > 0 {
+		// Sniff the type like net/http does, so the decision
+		// matches what the client will receive.
+		ct = http.DetectContentType(data)
+		header.Set("Content-Type", ct)
+	}
+	w.buffering = strings.HasPrefix(ct, "text/html") && header.Get("Content-Encoding") == ""
+	if !w.buffering && w.code != 0 {
+		w.ResponseWriter.WriteHeader(w.code)
+	}
+}
+
+func (w *toolbarWriter) WriteHeader(code int) {
+	if w.code == 0 {
+		w.code = code
+	}
+}
+
+func (w *toolbarWriter) Write(data []byte) (int, error) {
+	w.decide(data)
+	if w.buffering {
+		return w.buf.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *toolbarWriter) Flush() {
+	if w.buffering {
+		return
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		w.decide(nil)
+		f.Flush()
+	}
+}
+
+func (w *toolbarWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := w.ResponseWriter.(http.Hijacker); ok {
+		w.decided = true
+		return h.Hijack()
+	}
+	return nil, nil, errors.New("http.ResponseWriter does not implement http.Hijacker")
+}
+
+// finish writes the buffered response, if any, with the given
+// toolbar HTML injected before the last </body> tag or, if there's
+// no </body>, at the end of the response.
+func (w *toolbarWriter) finish(toolbar []byte) {
+	w.decide(nil)
+	if !w.buffering {
+		return
+	}
+	body := w.buf.Bytes()
+	w.Header().Del("Content-Length")
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	w.ResponseWriter.WriteHeader(w.code)
+	pos := bytes.LastIndex(bytes.ToLower(body), []byte("</body>"))
+	if pos < 0 {
+		pos = len(body)
+	}
+	w.ResponseWriter.Write(body[:pos])
+	w.ResponseWriter.Write(toolbar)
+	w.ResponseWriter.Write(body[pos:])
+}
+
+// showToolbar returns true iff the development toolbar
+// should be injected into the response for ctx.
+func showToolbar(ctx *Context) bool {
+	path := ctx.R.URL.Path
+	return path != devStatusPage && path != monitorAPIPage && !strings.HasPrefix(path, "/debug/")
+}
+
+type toolbarTiming struct {
+	Name    string
+	Count   int
+	Total   time.Duration
+	Percent float64
+}
+
+type toolbarSection struct {
+	Title  string
+	Events []*profile.Event
+}
+
+type toolbarMessage struct {
+	Level   string
+	Message string
+}
+
+type toolbarInfo struct {
+	Method      string
+	Path        string
+	StatusCode  int
+	Elapsed     time.Duration
+	Remaining   time.Duration
+	Timings     []*toolbarTiming
+	Sections    []*toolbarSection
+	Queries     int
+	CacheHits   int
+	CacheMisses int
+	Messages    []*toolbarMessage
+}
+
+func (t *toolbarInfo) section(name string) *toolbarSection {
+	for ii, v := range toolbarSections {
+		if v.Name == name {
+			return t.Sections[ii]
+		}
+	}
+	return nil
+}
+
+// newToolbarInfo returns the toolbar information for the current
+// request. Since it calls profile.Timings, it must be called when
+// the request has finished processing.
+func newToolbarInfo(ctx *Context) *toolbarInfo {
+	info := &toolbarInfo{
+		Method:     ctx.R.Method,
+		Path:       ctx.R.URL.Path,
+		StatusCode: ctx.statusCode,
+		Elapsed:    ctx.Elapsed(),
+	}
+	for _, v := range toolbarSections {
+		info.Sections = append(info.Sections, &toolbarSection{Title: v.Title})
+	}
+	info.Remaining = info.Elapsed
+	for _, v := range profile.Timings() {
+		if v.Name == toolbarLog {
+			for _, ev := range v.Events {
+				for _, n := range ev.Notes {
+					info.Messages = append(info.Messages, &toolbarMessage{Level: n.Title, Message: n.Text})
+				}
+			}
+			continue
+		}
+		total := v.Total()
+		info.Remaining -= total
+		info.Timings = append(info.Timings, &toolbarTiming{Name: v.Name, Count: v.Count(), Total: total})
+		if s := info.section(v.Name); s != nil {
+			s.Events = append(s.Events, v.Events...)
+		}
+		for _, ev := range v.Events {
+			for _, n := range ev.Notes {
+				switch {
+				case v.Name == "orm" && n.Title == "SQL":
+					info.Queries++
+				case v.Name == "cache" && n.Title == toolbarCacheResult:
+					if n.Text == "hit" {
+						info.CacheHits++
+					} else {
+						info.CacheMisses++
+					}
+				}
+			}
+		}
+	}
+	if info.Remaining < 0 {
+		info.Remaining = 0
+	}
+	if info.Elapsed > 0 {
+		for _, v := range info.Timings {
+			v.Percent = float64(v.Total) / float64(info.Elapsed) * 100
+		}
+	}
+	return info
+}
+
+// injectToolbar renders the development toolbar for the given
+// context and writes it to the toolbarWriter.
+func (app *App) injectToolbar(ctx *Context, w *toolbarWriter) {
+	var buf bytes.Buffer
+	t := newInternalTemplate(app)
+	err := t.parse("toolbar.html", nil)
+	if err == nil {
+		err = t.prepare()
+	}
+	if err == nil {
+		err = t.ExecuteTo(&buf, ctx, newToolbarInfo(ctx))
+	}
+	if err != nil {
+		log.Errorf("error rendering development toolbar: %s", err)
+		buf.Reset()
+	}
+	w.finish(buf.Bytes())
+}
+
+// toolbarLogWriter is a log.Writer which records the log
+// messages in the profiling data for the current goroutine,
+// so they can be shown in the development toolbar.
+type toolbarLogWriter struct{}
+
+func (w toolbarLogWriter) Write(level log.LLevel, flags int, data []byte) (int, error) {
+	if profile.On && profile.Profiling() {
+		msg := data
+		// Strip the date and the level, they're already
+		// shown by the toolbar.
+		if flags&(log.Lshortlevel|log.Llevel) != 0 {
+			if idx := bytes.Index(msg, []byte("] ")); idx >= 0 {
+				msg = msg[idx+2:]
+			}
+		}
+		profile.Start(toolbarLog).Note(level.String(), strings.TrimSpace(string(msg))).End()
+	}
+	return len(data), nil
+}
+
+func (w toolbarLogWriter) Level() log.LLevel {
+	return log.LDebug
+}
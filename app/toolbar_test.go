@@ -0,0 +1,41 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestToolbarWriter(t *testing.T) {
+	cases := []struct {
+		ct       string
+		body     string
+		expected string
+	}{
+		{"text/html; charset=utf-8", "<html><body>hello</BODY></html>", "<html><body>hello<toolbar></BODY></html>"},
+		{"", "<html><body>hello</body></html>", "<html><body>hello<toolbar></body></html>"},
+		{"text/html", "hello", "hello<toolbar>"},
+		{"application/json", "{}", "{}"},
+	}
+	for _, v := range cases {
+		rec := httptest.NewRecorder()
+		w := &toolbarWriter{ResponseWriter: rec}
+		if v.ct != "" {
+			w.Header().Set("Content-Type", v.ct)
+		}
+		w.Header().Set("Content-Length", "100")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(v.body))
+		w.finish([]byte("<toolbar>"))
+		if s := rec.Body.String(); s != v.expected {
+			t.Errorf("expecting %q, got %q", v.expected, s)
+		}
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("expecting status code 404, got %d", rec.Code)
+		}
+		injected := v.body != v.expected
+		if hasLength := rec.HeaderMap.Get("Content-Length") != ""; hasLength == injected {
+			t.Errorf("unexpected Content-Length %q for %q", rec.HeaderMap.Get("Content-Length"), v.ct)
+		}
+	}
+}
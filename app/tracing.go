@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"gnd.la/tracing"
+)
+
+// configureTracing is used to configure gnd.la/tracing
+// only once, even when several apps are created.
+var configureTracing sync.Once
+
+// endRequestSpan ends the span for the request served by ctx,
+// setting its name and attributes once the handler is known.
+func endRequestSpan(ctx *Context, span *tracing.Span) {
+	code := ctx.statusCode
+	if code < 0 {
+		code = -code
+	}
+	if code == 0 {
+		code = http.StatusOK
+	}
+	name := ctx.R.Method
+	if ctx.route != "" {
+		name += " " + ctx.route
+		span.SetAttribute("http.route", ctx.route)
+	}
+	span.SetName(name).
+		SetAttribute("http.method", ctx.R.Method).
+		SetAttribute("http.target", ctx.R.URL.RequestURI()).
+		SetAttribute("http.host", ctx.R.Host).
+		SetAttribute("http.status_code", code)
+	if code >= 500 {
+		span.SetError(fmt.Errorf("status code %d", code))
+	}
+	span.End()
+}
+
+// traceContext returns a context.Context carrying the tracing
+// span for the request served by c, or nil if there's none.
+// The returned context is not cancelled when the request
+// finishes, so it can be used by background operations.
+func (c *Context) traceContext() context.Context {
+	if c.R == nil {
+		return nil
+	}
+	span := tracing.FromContext(c.R.Context())
+	if span == nil {
+		return nil
+	}
+	return tracing.NewContext(context.Background(), span)
+}
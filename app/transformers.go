@@ -22,20 +22,46 @@ func SignedIn(handler Handler) Handler {
 		h.Add("Vary", "Cookie")
 		h.Add("Cache-Control", "private")
 		if ctx.User() == nil {
-			signIn := ctx.MustReverse("sign-in")
-			u, err := url.Parse(signIn)
-			if err != nil {
-				panic(err)
-			}
-			from := ctx.URL().String()
-			u.RawQuery += fmt.Sprintf("%s=%s", SignInFromParameterName, url.QueryEscape(from))
-			ctx.Redirect(u.String(), false)
+			redirectToSignIn(ctx)
 			return
 		}
 		handler(ctx)
 	}
 }
 
+// Permission returns a new Handler which requires a signed in user
+// with the given permission (as reported by Context.UserHas) to be
+// executed. Anonymous users are redirected to the sign in handler,
+// like in SignedIn, while signed in users without the permission
+// receive a 403 Forbidden error.
+func Permission(handler Handler, perm string) Handler {
+	return func(ctx *Context) {
+		h := ctx.Header()
+		h.Add("Vary", "Cookie")
+		h.Add("Cache-Control", "private")
+		if ctx.User() == nil {
+			redirectToSignIn(ctx)
+			return
+		}
+		if !ctx.UserHas(perm) {
+			ctx.Forbidden()
+			return
+		}
+		handler(ctx)
+	}
+}
+
+func redirectToSignIn(ctx *Context) {
+	signIn := ctx.MustReverse("sign-in")
+	u, err := url.Parse(signIn)
+	if err != nil {
+		panic(err)
+	}
+	from := ctx.URL().String()
+	u.RawQuery += fmt.Sprintf("%s=%s", SignInFromParameterName, url.QueryEscape(from))
+	ctx.Redirect(u.String(), false)
+}
+
 // Anonymous returns a new handler which redirects signed in users
 // to the previous page (or the root page if there's no referrer).
 func Anonymous(handler Handler) Handler {
@@ -24,6 +24,15 @@ type User interface {
 	IsAdmin() bool
 }
 
+// PermissionsUser is implemented by Users which can be
+// granted permissions. See Context.UserHas.
+type PermissionsUser interface {
+	User
+	// HasPermission returns whether the user has been
+	// granted the given permission.
+	HasPermission(ctx *Context, perm string) bool
+}
+
 // UserFunc is called when getting the current signed
 // in user. It receives the current context and the
 // user id and must return the current user (if any).
@@ -43,6 +52,24 @@ func (c *Context) User() User {
 	return c.user
 }
 
+// UserHas returns true iff there's a signed in user and it has
+// been granted the given permission. Administrators are considered
+// to have every permission, while users which don't implement
+// PermissionsUser don't have any.
+func (c *Context) UserHas(perm string) bool {
+	user := c.User()
+	if user == nil {
+		return false
+	}
+	if user.IsAdmin() {
+		return true
+	}
+	if pu, ok := user.(PermissionsUser); ok {
+		return pu.HasPermission(c, perm)
+	}
+	return false
+}
+
 // SignIn sets the cookie for signin in the given user. The default
 // cookie options for the App are used.
 func (c *Context) SignIn(user User) error {
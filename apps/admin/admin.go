@@ -0,0 +1,245 @@
+package admin
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/orm"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	defaultPerPage = 25
+)
+
+var (
+	registry struct {
+		sync.RWMutex
+		models []*Model
+	}
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// Model describes how an ORM model is managed in the admin. Only
+// the model type is required, all the other fields have sensible
+// defaults.
+type Model struct {
+	// Name is the model name used in URLs. If empty, it defaults
+	// to the lowercased model type name.
+	Name string
+	// Title is the model name shown to users. If empty, it's
+	// generated from the model type name.
+	Title string
+	// ListFields are the fields shown in the list page. If empty,
+	// the first 5 fields which can be displayed and are not
+	// excluded (see Exclude) are used.
+	ListFields []string
+	// SearchFields are the fields which are matched against the search
+	// query, using a case sensitive substring match. If empty, the
+	// search box is not shown.
+	SearchFields []string
+	// Filters are the fields which can be used for filtering
+	// in the list page. Boolean fields show links for filtering,
+	// while other fields can be filtered by passing their value in
+	// the query string (e.g. ?UserId=7).
+	Filters []string
+	// Sort is the field used for sorting the list page, prefixed
+	// with a - for sorting in descending order. If empty, the
+	// primary key in descending order is used.
+	Sort string
+	// PerPage is the number of objects shown per page. If zero,
+	// it defaults to 25.
+	PerPage int
+	// Fields are the fields shown in the edit form. If empty, all
+	// the fields with a type supported by gnd.la/form except the
+	// primary key and the excluded ones (see Exclude) are used.
+	Fields []string
+	// Inlines are the related objects shown in the detail page.
+	Inlines []*Inline
+	// Exclude are the qualified names of the fields which are never
+	// shown in the detail page nor used by default in the list page
+	// and the edit form. Fields tagged with form:"-" or json:"-" and
+	// the ones named like any of DefaultExclude are always excluded.
+	Exclude []string
+	// ReadOnly disables the add, edit and delete pages.
+	ReadOnly bool
+	// Permission is the prefix for the permissions required for each
+	// operation, which are checked with app.Context.UserHas. Viewing
+	// objects requires Permission + ".view", while adding, editing and
+	// deleting them require Permission + ".add", ".edit" and ".delete".
+	// If empty, it defaults to "admin." + Name.
+	Permission string
+	typ        reflect.Type
+}
+
+// Inline represents a model whose objects are listed in the
+// detail page of another one. If the related model is also
+// registered in the admin, its objects are only listed for
+// users with its view permission.
+type Inline struct {
+	// Model is the related model type (e.g. &Comment{}).
+	Model interface{}
+	// Field is the field in the related model which references
+	// the primary key of the parent one (e.g. "PostId").
+	Field string
+	// Title is the title shown above the related objects. If empty,
+	// it's generated from the model type name.
+	Title string
+	// ListFields are the fields shown for each related object. If
+	// empty, the first 5 fields which can be displayed are used.
+	ListFields []string
+	typ        reflect.Type
+}
+
+// Type returns the model type.
+func (m *Model) Type() reflect.Type {
+	return m.typ
+}
+
+// Can returns true iff the current user has the permission
+// for the given operation, which must be one of "view", "add",
+// "edit" and "delete".
+func (m *Model) Can(ctx *app.Context, op string) bool {
+	if m.ReadOnly && op != "view" {
+		return false
+	}
+	return ctx.UserHas(m.Permission + "." + op)
+}
+
+func (m *Model) table(ctx *app.Context) *orm.Table {
+	return ctx.Orm().TypeTable(m.typ)
+}
+
+func (m *Model) perPage() int {
+	if m.PerPage > 0 {
+		return m.PerPage
+	}
+	return defaultPerPage
+}
+
+func (m *Model) listFields(tbl *orm.Table) []string {
+	if len(m.ListFields) > 0 {
+		return m.ListFields
+	}
+	return defaultListFields(tbl, m)
+}
+
+func (m *Model) formFields(tbl *orm.Table) []string {
+	if len(m.Fields) > 0 {
+		return m.Fields
+	}
+	pk := tbl.PrimaryKey()
+	var fields []string
+	for _, v := range tbl.Fields() {
+		if v == pk || !isFormField(m.typ, v) || m.isExcluded(v) {
+			continue
+		}
+		fields = append(fields, v)
+	}
+	return fields
+}
+
+// Register adds the given model, which must have been previously
+// registered with the ORM, to the admin. The model parameter must
+// be a pointer to a struct of the model type (e.g. &Post{}), while
+// m might be nil to use the defaults. Registering the same model
+// type or name twice or using fields which don't exist in the model
+// in SearchFields or Filters will panic.
+func Register(model interface{}, m *Model) *Model {
+	if m == nil {
+		m = &Model{}
+	}
+	typ := modelType(model)
+	m.typ = typ
+	if m.Name == "" {
+		m.Name = strings.ToLower(typ.Name())
+	}
+	if m.Title == "" {
+		m.Title = stringutil.CamelCaseToWords(typ.Name(), " ")
+	}
+	if m.Permission == "" {
+		m.Permission = "admin." + m.Name
+	}
+	for _, fields := range [][]string{m.SearchFields, m.Filters} {
+		for _, v := range fields {
+			if _, ok := structField(typ, v); !ok {
+				panic(fmt.Errorf("admin model %s has no field named %q", typ, v))
+			}
+		}
+	}
+	for _, v := range m.Inlines {
+		v.typ = modelType(v.Model)
+		if v.Title == "" {
+			v.Title = stringutil.CamelCaseToWords(v.typ.Name(), " ")
+		}
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	for _, v := range registry.models {
+		if v.typ == typ {
+			panic(fmt.Errorf("model %s already registered in admin", typ))
+		}
+		if v.Name == m.Name {
+			panic(fmt.Errorf("duplicate admin model name %q", m.Name))
+		}
+	}
+	registry.models = append(registry.models, m)
+	return m
+}
+
+// Models returns the models registered with Register, sorted
+// by their title.
+func Models() []*Model {
+	registry.RLock()
+	defer registry.RUnlock()
+	models := append([]*Model(nil), registry.models...)
+	sort.Sort(modelsByTitle(models))
+	return models
+}
+
+func modelNamed(name string) *Model {
+	registry.RLock()
+	defer registry.RUnlock()
+	for _, v := range registry.models {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+func modelWithType(typ reflect.Type) *Model {
+	registry.RLock()
+	defer registry.RUnlock()
+	for _, v := range registry.models {
+		if v.typ == typ {
+			return v
+		}
+	}
+	return nil
+}
+
+func modelType(model interface{}) reflect.Type {
+	typ := reflect.TypeOf(model)
+	if typ == nil {
+		panic(fmt.Errorf("can't register nil model in admin"))
+	}
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		panic(fmt.Errorf("admin model must be a struct, not %s", typ))
+	}
+	return typ
+}
+
+type modelsByTitle []*Model
+
+func (m modelsByTitle) Len() int           { return len(m) }
+func (m modelsByTitle) Less(i, j int) bool { return m[i].Title < m[j].Title }
+func (m modelsByTitle) Swap(i, j int)      { m[i], m[j] = m[j], m[i] }
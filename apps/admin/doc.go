@@ -0,0 +1,30 @@
+// Package admin implements an app for managing the objects of ORM models.
+//
+// Models are added to the admin with Register, which generates a list, detail,
+// edit and delete page for them. List pages support searching, filtering and
+// pagination, while detail pages might include the related objects from
+// other models (see Inline). e.g.
+//
+//  admin.Register(&Post{}, &admin.Model{
+//	ListFields:   []string{"Title", "Published", "Created"},
+//	SearchFields: []string{"Title", "Body"},
+//	Filters:      []string{"Published", "AuthorId"},
+//	Sort:         "-Created",
+//	Inlines: []*admin.Inline{
+//		{Model: &Comment{}, Field: "PostId"},
+//	},
+//  })
+//
+// All the pages require a signed in user with the appropriate permission,
+// as reported by app.Context.UserHas (see Model.Permission). Admin users are
+// always allowed, while other users might be granted the permissions using e.g.
+// the roles in gnd.la/apps/users.
+//
+// The admin app must be included into another app, usually under a prefix. e.g.
+//
+//  myapp.Include("/admin/", admin.App, "admin-base.html")
+//
+// Since the forms are rendered using gnd.la/form, importing one of the
+// packages in gnd.la/frontend (like gnd.la/frontend/bootstrap) will make them
+// match the rest of the site.
+package admin
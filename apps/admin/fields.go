@@ -0,0 +1,217 @@
+package admin
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gnd.la/orm"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	defaultListFieldCount = 5
+)
+
+// DefaultExclude contains the names of the fields which are excluded
+// from every model (see Model.Exclude). It defaults to the fields
+// used by gnd.la/apps/users for storing secrets.
+var DefaultExclude = []string{"Password", "TwoFactorSecret", "RecoveryCodes"}
+
+// Column is a column in a list of objects.
+type Column struct {
+	Field string
+	Title string
+}
+
+// Row is an object in a list, with its values already
+// formatted for display.
+type Row struct {
+	Id     string
+	Values []string
+}
+
+func columns(fields []string) []*Column {
+	cols := make([]*Column, len(fields))
+	for ii, v := range fields {
+		cols[ii] = &Column{
+			Field: v,
+			Title: stringutil.CamelCaseToWords(v[strings.LastIndex(v, ".")+1:], " "),
+		}
+	}
+	return cols
+}
+
+func rows(tbl *orm.Table, objects reflect.Value, fields []string) []*Row {
+	pk := tbl.PrimaryKey()
+	rs := make([]*Row, objects.Len())
+	for ii := range rs {
+		obj := objects.Index(ii)
+		r := &Row{Id: formatValue(fieldValue(obj, pk))}
+		for _, f := range fields {
+			r.Values = append(r.Values, formatValue(fieldValue(obj, f)))
+		}
+		rs[ii] = r
+	}
+	return rs
+}
+
+// defaultListFields returns the fields shown by default when
+// listing objects in tbl, which contains objects of m.
+func defaultListFields(tbl *orm.Table, m *Model) []string {
+	var fields []string
+	for _, v := range tbl.Fields() {
+		if isDisplayable(tbl.FieldType(v)) && !m.isExcluded(v) {
+			fields = append(fields, v)
+			if len(fields) == defaultListFieldCount {
+				break
+			}
+		}
+	}
+	return fields
+}
+
+func isDisplayable(typ reflect.Type) bool {
+	if typ == timeType {
+		return true
+	}
+	switch typ.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// isFormField returns true iff the given field can be
+// edited using gnd.la/form.
+func isFormField(typ reflect.Type, qname string) bool {
+	field, ok := structField(typ, qname)
+	if !ok || tagName(field, "form") == "-" {
+		return false
+	}
+	return isDisplayable(field.Type)
+}
+
+// isExcluded returns true iff the field with the given qualified
+// name should never be shown. See Model.Exclude.
+func (m *Model) isExcluded(qname string) bool {
+	name := qname[strings.LastIndex(qname, ".")+1:]
+	for _, v := range DefaultExclude {
+		if v == name {
+			return true
+		}
+	}
+	for _, v := range m.Exclude {
+		if v == qname {
+			return true
+		}
+	}
+	field, ok := structField(m.typ, qname)
+	return ok && (tagName(field, "form") == "-" || tagName(field, "json") == "-")
+}
+
+// tagName returns the name in the given tag of
+// the field, ignoring any options.
+func tagName(field reflect.StructField, key string) string {
+	tag := field.Tag.Get(key)
+	if idx := strings.IndexByte(tag, ','); idx >= 0 {
+		tag = tag[:idx]
+	}
+	return tag
+}
+
+func structField(typ reflect.Type, qname string) (reflect.StructField, bool) {
+	var field reflect.StructField
+	for _, v := range strings.Split(qname, ".") {
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			return field, false
+		}
+		f, ok := typ.FieldByName(v)
+		if !ok {
+			return field, false
+		}
+		field = f
+		typ = f.Type
+	}
+	return field, true
+}
+
+// fieldValue returns the value for the field with the given qualified
+// name. If a nil pointer is found while traversing the object, an
+// invalid reflect.Value is returned.
+func fieldValue(obj reflect.Value, qname string) reflect.Value {
+	for _, v := range strings.Split(qname, ".") {
+		for obj.Kind() == reflect.Ptr {
+			if obj.IsNil() {
+				return reflect.Value{}
+			}
+			obj = obj.Elem()
+		}
+		obj = obj.FieldByName(v)
+		if !obj.IsValid() {
+			return obj
+		}
+	}
+	return obj
+}
+
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+	switch x := v.Interface().(type) {
+	case time.Time:
+		if x.IsZero() {
+			return ""
+		}
+		return x.Format("2006-01-02 15:04:05")
+	case fmt.Stringer:
+		return x.String()
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// parseValue parses the given string as a value of the given
+// type. Only the types which can be displayed are supported.
+func parseValue(typ reflect.Type, s string) (interface{}, error) {
+	val := reflect.New(typ).Elem()
+	switch typ.Kind() {
+	case reflect.String:
+		val.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, err
+		}
+		val.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		val.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		val.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		val.SetFloat(f)
+	default:
+		return nil, fmt.Errorf("can't parse values of type %s", typ)
+	}
+	return val.Interface(), nil
+}
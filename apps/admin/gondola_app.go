@@ -0,0 +1,37 @@
+package admin
+
+// AUTOMATICALLY GENERATED WITH gondola gen-app -release -- DO NOT EDIT!
+
+import (
+	"gnd.la/app"
+	"gnd.la/internal/vfsutil"
+	"gnd.la/template"
+	"gnd.la/template/assets"
+)
+
+var _ = vfsutil.Bake
+var _ = template.New
+var _ = assets.New
+var (
+	App = app.New()
+)
+
+func init() {
+	App.SetName("Admin")
+	App.AddTemplateVars(map[string]interface{}{
+		"Add":    AddHandlerName,
+		"Delete": DeleteHandlerName,
+		"Detail": DetailHandlerName,
+		"Edit":   EditHandlerName,
+		"Index":  IndexHandlerName,
+		"List":   ListHandlerName,
+	})
+	App.HandleOptions("^/$", IndexHandler.Handler, IndexHandler.Options)
+	App.HandleOptions("^/(\\w+)/$", ListHandler.Handler, ListHandler.Options)
+	App.HandleOptions("^/(\\w+)/add/$", AddHandler.Handler, AddHandler.Options)
+	App.HandleOptions("^/(\\w+)/([^/]+)/$", DetailHandler.Handler, DetailHandler.Options)
+	App.HandleOptions("^/(\\w+)/([^/]+)/edit/$", EditHandler.Handler, EditHandler.Options)
+	App.HandleOptions("^/(\\w+)/([^/]+)/delete/$", DeleteHandler.Handler, DeleteHandler.Options)
+	templatesFS := vfsutil.OpenBaked("\x1f\x8b\b\x00\xe8\xa6\xd1j\x02\xff\xecY[o\xdb6\x14\xf6\xb3\x7fŁ\x10`-0\xdb\xf2\xa5.6\xc8ڂt\x05\x02t\xc5\xd0v{\xa7M:\xe2@\x91.E9\v\f\xff\xf7\x81\x14)Q\xb7\\\x9afYQ\xf3\xa1\x95E\xea\xdcH~\xe7\x9c/\x980\xa2\xc88Q)\x1b<\xd5\b\xa7a\xb8\\.\x06a1\x9a\xffO\x17\xe1r0}5[.\xe6\xb3\xf9\xfcU8\b\xa7\xd3E8\x1f@8\xf8\x0fF\x9e)$\a\xe1\xa3u5\x9d\xfbF\xc6\xe1\x00\x98l)'\x10|\xa2\x8a\x91\x00\x8e\xc7\xc3\x01ƿ\vL\xd8ؼ\x82\xe3\x11\xf4\xabK\\\xcc\x11\xae\x1f\x86\x91`\xb0a(\xcbV\xc1Z\x12\x8472O\xd7A<\x04\x88\x18\x8d#\x04\x89$\xdbUp8\x80${\"3\x02\xbf^rL\xfe\x81\xe31\x88\x0f\aP\x10\x9c\xe3\x94r\x9a)\x89\x14\x15\\\xab\x8e&(\x8e&\x8c\xde*\xe6\x1d͔\xb3\xf0=J\x89\x93\xd80\xfa^\xb2\xde\x10\x85(\xabI+\x1c-$^\xe2\xb6 \xe75\xda(\xba'Η7\xe6\"\x15>\xe8\xa5\xd1D\xb0x\x18%\xd3r\xb9vv\xa4L\x90\xe3\xdbB\x1cM\x92i<\x8c\xb6B\xa6\xf5o\xb1U\x91\x12\x95\b\xbc\nv\"S\x01h3\x04oz\xa5Wvz\xa5}ع\xf8K\x027\"\x87,\xb7\x0f\u05c8+P\x02\nM\xa0\x12\x9a\x81X\xffM6\xea\x17\xf8\xa4\x7f\x14\xca`\x83\xf8\x0f\n\xd6\x04r\x8e\x05'\xe3\xc2\xed\x9d\x16\xae\xddx+d:\xfe@8&R\x9f\x14\x80h\x9d+%\xb8sg\xad8\xac\x15\x1faį\x88\xec\x8a`\xb1\xde\x18\x8bZ_\x91-ʙ\n\x1e\xbc\x9b\n\x82\v\xc47\x84\xb9\x936\x8c&:\xca\xf1p\xf0\xdd\x0el\"\xf6\xac\xf8?{=\r\xdb\xf8?;\xe1\xff\t\xff\xbf&\xfew\xc0\xb6\xc3\xdb\xc7\xe1\xb5\x16\x9f\xedP\x89n\xbb\x9c\xb1\x91\xa4W\x892\xd10\x90H\xb70\xbe@\xfc7L\x95\xd6\xd8´\x9d\xa4)\x927]\x98f\xbe\xe9G4=\xed\"WmN]\xadM\a]\x8a-\x04wbio\x0ei\xe3u]w4\xd1\xf1\x88\x876\x93)\xb4f\xc4).~\xb8\x84\xa6\xc1'\xb0iCjS`\xfc\x96\x12\x863\xe7D\xa4d\x11D\xfd\x98\x98}\xa8\xf6W%\xd5\x1c6s\x7f!\x96\xdb9\\\xccE\x93B\x82wp'ƆxX\xe9\xbc\xe4\x8crb\x95Fɼ~\x06\xa8\x99\xf5\x8fBeB2\x8f\x87e\xa8?\x88k\xcf\xf0\xb6\xd7\xe6\xdfQ\xa6$\xdd\x11\f\xbe\xf0\xc0s\x92 \xec~\xd5\xfc\xb7{jM\xbe\x10,O\xb9V\xd7\x19\x97\xfaQ\xf0\x03a\x9f}5\x91Z\v|S\xe99\x1c\xe0,\xd5\xdb\x0e?\xaf\xec\x01\xf0%UF\xf8\x0ew\xda[Ȣ\xd8\b\xba\xc4\xf5\xb5\x9e\xa43J\x7f\x84\xb3\xbdYf\xf60k.-\xf7\x98n\x01q\xec\f|A>\xeb\x8f!|Y\x1c\xefޚ\xe0,\xad\xce\xf1\x19-\xcf\xf1\xd9\xde;\xbf,#\x05ę\xb7e\b\xab\xc3\xe4\xd9݈n=\xbe\xed\x15\xd1ċqy\x02\x01*\xb5Ō+\xcd\xde\v[xe\xb0\x159Ǎ\x12\xcbʮ\x9e\xbe\x14\xff\t\xa6\xeai\xb3\xff\xdd\xfd\xdf\xfcU+\xff\x87\xcb\xc5)\xff\xff\x7f\xf2\xff7\x9a\xf5-,\xbf'\xd7\xe5\xfd\xeak\xdf\xce1\xaez\xb7\xf6\xb5\xfcZ\xed\xe3-\x16T\x89\xbc2\xc1%\xac\x87\x95&\x85\xdb\\(\xe7zG\xf9\xd6\xdbd\x12cFo\x8bY\xc5\xd3\x0f\xc39ƍ\xad\xa9\xc1\xe9]\xb5LiU\xf0\xd0\x0e\xd2\xd5M6\x86\x1fѾ\xd1@\x9e:\xbc\xdb\a\u0557\xf3\x89\x13\xc0\x1d\xf8\x1f.\xa7\xb3&\xfe\xcf\x16\xcb\x13\xfe?#\xfew´\x87H\xfdX\xd4\x03\xf0\x1am,|\x98\xeb\xefj\xed{Vɦx˪~\xca֟\xbe\xa8V\xf5\xa9\xeb\xc5[2I-\x87ԳGU\xeeUE]\xbd\xbf\xa9:\x88*M\x94\xb5ۧ\x84H\x02H\x12\xe0\x02\n\xcb\r\xbb\xb6A\x1cR\xc4ѕG\x98=\xbe\x82{\xdc`4{\xee\xfao\xbe\x98\xben\xde\xff\xe9,<\xdd\xffg\xbc\xff\xcfZ\xffu15\xcd\"\xef\x8b(\x9b(K\x11c\xf1\v=u!r\xae\xb9\x98\x97Ѥx;\xf4\x19\x13]\xd0\xdc\xc2Ӏ\xc7\xf1tx\xdc.\x87Ze\xa6O\x98\xd8Z\fӽS'\xc5u\x11S\xef\x9d5\xee-e\x8aH\x8dy\x1b\xc1F)\x1e\xfdT\x81\x90}3\x9d\xd5\v\xaaұ¤\x8f\x04\xc9MR\xa7Y\x00:J\xc1\xcc,\xac\x8a\xc1+\xd2\xf3\xe7\x86\xceҼ\xc2a\xcaw\xb9r\xa2\xb5\x9a\xd1Fp%\x05\v@\xdd\xec\xc8*p\x8a8J\xc9*\xf8\x1c\xc0^S\x00F\x87\xb5VK\x84\x1dC\x1b\x92\b\x86\x89\\\x05\xb6\xe0\xb3_z\n]\xc5\xd7\xee\xc2;X\x9a\xfbg \r\x94\xbeS\r\x9e\xa6\x9b\xf9\xf8R\xae\xa6\xc9&4\xf8\x9a6cS\xf0,:~\x15e㶢\x93>j37\x1d\x1e\xdc\xc1\xde<\x90\xbf\xf1\x19\x1c\xc7\xda\xdcE\xda\xf0\xafB\xd7t\x136\xcd w\xad\xaa\xd165\xe2Ʈ\x1f\xff\x81\xae(GJ\xc8Z\xbb\xd2\xea \xefI\xed4\xeb\fL\xf7\x1e0Uw\xdf,\xf5\xd1\xc1^\xfd\xb9=\xad\xdbbe\x10\x0f\x1b\x1bՔa\xe4$\x8b&\xab\xb9\xf0\x8eZ^\"\xbe\xbe\x03\xa3\x9cg\xea\x86\x11\x1c\xf4𒉠\x9b\xf6\xf6G\x8cZ'\xce\r\xaa\xc3\xf1\u0600\xf9\xd2\xf1Z\x16\x19\xff\xf9\xe1]O\x95\xe6:\xea\xfe\xbd\xcbY<\xec\x9a\xf5\xe3Zb\xb0y58\x8d\xd38\x8d\xd3\xf8^ƿ\x03\x00\xcc3}\x13\x00&\x00\x00")
+	App.SetTemplatesFS(templatesFS)
+}
@@ -0,0 +1,407 @@
+package admin
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gnd.la/app"
+	"gnd.la/form"
+	"gnd.la/html/paginator"
+	"gnd.la/orm"
+	"gnd.la/orm/query"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	IndexHandlerName  = "admin-index"
+	ListHandlerName   = "admin-list"
+	AddHandlerName    = "admin-add"
+	DetailHandlerName = "admin-detail"
+	EditHandlerName   = "admin-edit"
+	DeleteHandlerName = "admin-delete"
+
+	IndexTemplateName  = "index.html"
+	ListTemplateName   = "list.html"
+	DetailTemplateName = "detail.html"
+	EditTemplateName   = "edit.html"
+	DeleteTemplateName = "delete.html"
+
+	// SearchParameterName is the query string parameter
+	// used for the search query in the list page.
+	SearchParameterName = "q"
+	// PageParameterName is the query string parameter
+	// used for the page number in the list page.
+	PageParameterName = "page"
+
+	// maximum number of related objects shown for each inline
+	maxInlineObjects = 100
+)
+
+var (
+	IndexHandler  = app.NamedHandler(IndexHandlerName, app.SignedIn(indexHandler))
+	ListHandler   = app.NamedHandler(ListHandlerName, app.SignedIn(listHandler))
+	AddHandler    = app.NamedHandler(AddHandlerName, app.SignedIn(addHandler))
+	DetailHandler = app.NamedHandler(DetailHandlerName, app.SignedIn(detailHandler))
+	EditHandler   = app.NamedHandler(EditHandlerName, app.SignedIn(editHandler))
+	DeleteHandler = app.NamedHandler(DeleteHandlerName, app.SignedIn(deleteHandler))
+)
+
+// Filter is a filter shown in the list page.
+type Filter struct {
+	// Field is the qualified name of the filtered field.
+	Field   string
+	Title   string
+	Choices []*FilterChoice
+	typ     reflect.Type
+}
+
+// FilterChoice is one of the choices in a Filter.
+type FilterChoice struct {
+	Title  string
+	URL    string
+	Active bool
+}
+
+// FieldValue is a field in the detail page.
+type FieldValue struct {
+	Title string
+	Value string
+}
+
+// InlineObjects contains the related objects for an Inline
+// in the detail page.
+type InlineObjects struct {
+	Title   string
+	Model   *Model
+	Columns []*Column
+	Rows    []*Row
+}
+
+type listPager struct {
+	base   string
+	values url.Values
+}
+
+func (p *listPager) URL(page int) string {
+	values := url.Values{}
+	for k, v := range p.values {
+		values[k] = v
+	}
+	if page > 1 {
+		values.Set(PageParameterName, strconv.Itoa(page))
+	} else {
+		values.Del(PageParameterName)
+	}
+	return urlWithValues(p.base, values)
+}
+
+func urlWithValues(base string, values url.Values) string {
+	if len(values) == 0 {
+		return base
+	}
+	return base + "?" + values.Encode()
+}
+
+// requestModel returns the model named by the first handler argument,
+// checking that the user has the permission for the given operation.
+// If there's no such model or the user lacks the permission, the error
+// is sent to the client and nil is returned.
+func requestModel(ctx *app.Context, op string) *Model {
+	m := modelNamed(ctx.IndexValue(0))
+	if m == nil {
+		ctx.NotFound("")
+		return nil
+	}
+	if !m.Can(ctx, op) {
+		ctx.Forbidden()
+		return nil
+	}
+	return m
+}
+
+// requestObject returns a pointer to the object with the primary
+// key indicated by the second handler argument. If the object can't
+// be found, a 404 error is sent and an invalid value is returned.
+func requestObject(ctx *app.Context, m *Model, tbl *orm.Table) reflect.Value {
+	pk := tbl.PrimaryKey()
+	if pk == "" {
+		ctx.NotFound("")
+		return reflect.Value{}
+	}
+	id, err := parseValue(tbl.FieldType(pk), ctx.IndexValue(1))
+	if err != nil {
+		ctx.NotFound("")
+		return reflect.Value{}
+	}
+	obj := reflect.New(m.typ)
+	if !ctx.Orm().Table(tbl).Filter(orm.Eq(pk, id)).MustOne(obj.Interface()) {
+		ctx.NotFound("")
+		return reflect.Value{}
+	}
+	return obj
+}
+
+func indexHandler(ctx *app.Context) {
+	var models []*Model
+	for _, v := range Models() {
+		if v.Can(ctx, "view") {
+			models = append(models, v)
+		}
+	}
+	data := map[string]interface{}{
+		"Models": models,
+	}
+	ctx.MustExecute(IndexTemplateName, data)
+}
+
+func listHandler(ctx *app.Context) {
+	m := requestModel(ctx, "view")
+	if m == nil {
+		return
+	}
+	tbl := m.table(ctx)
+	base := ctx.MustReverse(ListHandlerName, m.Name)
+	values := url.Values{}
+	var conditions []query.Q
+	search := strings.TrimSpace(ctx.FormValue(SearchParameterName))
+	if search != "" && len(m.SearchFields) > 0 {
+		var or []query.Q
+		for _, v := range m.SearchFields {
+			or = append(or, orm.Contains(v, search))
+		}
+		conditions = append(conditions, orm.Or(or...))
+		values.Set(SearchParameterName, search)
+	}
+	var filters []*Filter
+	for _, v := range m.Filters {
+		typ := tbl.FieldType(v)
+		if typ == nil {
+			continue
+		}
+		current := ctx.FormValue(v)
+		if current != "" {
+			val, err := parseValue(typ, current)
+			if err != nil {
+				ctx.BadRequest(err)
+				return
+			}
+			conditions = append(conditions, orm.Eq(v, val))
+			values.Set(v, current)
+		}
+		filters = append(filters, &Filter{
+			Field: v,
+			Title: stringutil.CamelCaseToWords(v[strings.LastIndex(v, ".")+1:], " "),
+			typ:   typ,
+		})
+	}
+	// Generate the choices after all the values have been parsed,
+	// so they preserve the rest of the filters and the search.
+	for _, v := range filters {
+		field := v.Field
+		current := values.Get(field)
+		choices := [][2]string{{ctx.Tc("admin filter", "All"), ""}}
+		if v.typ.Kind() == reflect.Bool {
+			choices = append(choices, [2]string{ctx.T("Yes"), "true"}, [2]string{ctx.T("No"), "false"})
+		} else if current != "" {
+			choices = append(choices, [2]string{current, current})
+		}
+		for _, c := range choices {
+			fv := url.Values{}
+			for k, vv := range values {
+				fv[k] = vv
+			}
+			if c[1] == "" {
+				fv.Del(field)
+			} else {
+				fv.Set(field, c[1])
+			}
+			v.Choices = append(v.Choices, &FilterChoice{
+				Title:  c[0],
+				URL:    urlWithValues(base, fv),
+				Active: c[1] == current,
+			})
+		}
+	}
+	q := orm.And(conditions...)
+	if len(conditions) == 0 {
+		q = nil
+	}
+	o := ctx.Orm()
+	count := o.Table(tbl).Filter(q).MustCount()
+	perPage := m.perPage()
+	pages := int((count + uint64(perPage) - 1) / uint64(perPage))
+	page := 1
+	ctx.ParseFormValue(PageParameterName, &page)
+	if page < 1 || (page > pages && pages > 0) {
+		ctx.NotFound("")
+		return
+	}
+	sq := o.Table(tbl).Filter(q).Limit(perPage).Offset((page - 1) * perPage)
+	if m.Sort != "" {
+		if strings.HasPrefix(m.Sort, "-") {
+			sq.Sort(m.Sort[1:], orm.DESC)
+		} else {
+			sq.Sort(m.Sort, orm.ASC)
+		}
+	} else if pk := tbl.PrimaryKey(); pk != "" {
+		sq.Sort(pk, orm.DESC)
+	}
+	objects := reflect.New(reflect.SliceOf(reflect.PtrTo(m.typ)))
+	sq.MustAll(objects.Interface())
+	fields := m.listFields(tbl)
+	data := map[string]interface{}{
+		"Model":     m,
+		"Columns":   columns(fields),
+		"Rows":      rows(tbl, objects.Elem(), fields),
+		"Count":     count,
+		"Search":    search,
+		"Filters":   filters,
+		"Paginator": paginator.New(pages, page, &listPager{base: base, values: values}),
+		"CanAdd":    m.Can(ctx, "add"),
+	}
+	ctx.MustExecute(ListTemplateName, data)
+}
+
+func detailHandler(ctx *app.Context) {
+	m := requestModel(ctx, "view")
+	if m == nil {
+		return
+	}
+	tbl := m.table(ctx)
+	obj := requestObject(ctx, m, tbl)
+	if !obj.IsValid() {
+		return
+	}
+	var fields []*FieldValue
+	for _, v := range tbl.Fields() {
+		if !isDisplayable(tbl.FieldType(v)) || m.isExcluded(v) {
+			continue
+		}
+		fields = append(fields, &FieldValue{
+			Title: stringutil.CamelCaseToWords(v[strings.LastIndex(v, ".")+1:], " "),
+			Value: formatValue(fieldValue(obj, v)),
+		})
+	}
+	id := fieldValue(obj, tbl.PrimaryKey()).Interface()
+	var inlines []*InlineObjects
+	for _, v := range m.Inlines {
+		itbl := ctx.Orm().TypeTable(v.typ)
+		if itbl == nil {
+			continue
+		}
+		im := modelWithType(v.typ)
+		if im != nil && !im.Can(ctx, "view") {
+			// The user can't view the related objects
+			continue
+		}
+		objects := reflect.New(reflect.SliceOf(reflect.PtrTo(v.typ)))
+		ctx.Orm().Table(itbl).Filter(orm.Eq(v.Field, id)).Limit(maxInlineObjects).MustAll(objects.Interface())
+		listFields := v.ListFields
+		if len(listFields) == 0 {
+			lm := im
+			if lm == nil {
+				// Not registered in the admin, but
+				// the exclusions still apply.
+				lm = &Model{typ: v.typ}
+			}
+			listFields = defaultListFields(itbl, lm)
+		}
+		inlines = append(inlines, &InlineObjects{
+			Title:   v.Title,
+			Model:   im,
+			Columns: columns(listFields),
+			Rows:    rows(itbl, objects.Elem(), listFields),
+		})
+	}
+	data := map[string]interface{}{
+		"Model":     m,
+		"Id":        ctx.IndexValue(1),
+		"Fields":    fields,
+		"Inlines":   inlines,
+		"CanEdit":   m.Can(ctx, "edit"),
+		"CanDelete": m.Can(ctx, "delete"),
+	}
+	ctx.MustExecute(DetailTemplateName, data)
+}
+
+func addHandler(ctx *app.Context) {
+	m := requestModel(ctx, "add")
+	if m == nil {
+		return
+	}
+	saveObject(ctx, m, m.table(ctx), reflect.New(m.typ), true)
+}
+
+func editHandler(ctx *app.Context) {
+	m := requestModel(ctx, "edit")
+	if m == nil {
+		return
+	}
+	tbl := m.table(ctx)
+	obj := requestObject(ctx, m, tbl)
+	if !obj.IsValid() {
+		return
+	}
+	saveObject(ctx, m, tbl, obj, false)
+}
+
+func saveObject(ctx *app.Context, m *Model, tbl *orm.Table, obj reflect.Value, isNew bool) {
+	f := form.NewOpts(ctx, &form.Options{Fields: m.formFields(tbl)}, obj.Interface())
+	if f.Submitted() && f.IsValid() {
+		var err error
+		if isNew {
+			_, err = ctx.Orm().Insert(obj.Interface())
+		} else {
+			_, err = ctx.Orm().Save(obj.Interface())
+		}
+		if err != nil {
+			panic(err)
+		}
+		id := formatValue(fieldValue(obj, tbl.PrimaryKey()))
+		if id == "" {
+			ctx.MustRedirectReverse(false, ListHandlerName, m.Name)
+			return
+		}
+		ctx.MustRedirectReverse(false, DetailHandlerName, m.Name, id)
+		return
+	}
+	data := map[string]interface{}{
+		"Model": m,
+		"Form":  f,
+		"New":   isNew,
+	}
+	if !isNew {
+		data["Id"] = ctx.IndexValue(1)
+	}
+	ctx.MustExecute(EditTemplateName, data)
+}
+
+func deleteHandler(ctx *app.Context) {
+	m := requestModel(ctx, "delete")
+	if m == nil {
+		return
+	}
+	tbl := m.table(ctx)
+	obj := requestObject(ctx, m, tbl)
+	if !obj.IsValid() {
+		return
+	}
+	if ctx.R.Method == "POST" {
+		f := form.New(ctx)
+		if !f.IsValid() {
+			ctx.Forbidden()
+			return
+		}
+		ctx.Orm().MustDelete(obj.Interface())
+		ctx.MustRedirectReverse(false, ListHandlerName, m.Name)
+		return
+	}
+	data := map[string]interface{}{
+		"Model": m,
+		"Id":    ctx.IndexValue(1),
+		"Form":  form.New(ctx),
+	}
+	ctx.MustExecute(DeleteTemplateName, data)
+}
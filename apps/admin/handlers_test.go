@@ -0,0 +1,224 @@
+package admin
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/config"
+	"gnd.la/orm"
+	_ "gnd.la/orm/driver/sqlite"
+)
+
+type adminPost struct {
+	Id        int64 `orm:",primary_key,auto_increment"`
+	Title     string
+	Published bool
+	// Draft is not stored, so it's ignored when filtering
+	Draft bool `orm:"-"`
+}
+
+type adminComment struct {
+	Id     int64 `orm:",primary_key,auto_increment"`
+	PostId int64
+	Text   string
+}
+
+type adminUser struct {
+	id    int64
+	perms []string
+}
+
+func (u *adminUser) Id() int64     { return u.id }
+func (u *adminUser) IsAdmin() bool { return false }
+
+func (u *adminUser) HasPermission(ctx *app.Context, perm string) bool {
+	for _, v := range u.perms {
+		if v == perm {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	adminUsers = map[int64]*adminUser{
+		1: {id: 1},
+		2: {id: 2, perms: []string{"admin.post.view"}},
+		3: {id: 3, perms: []string{"admin.post.view", "admin.comment.view"}},
+		4: {id: 4, perms: []string{"admin.post.view", "admin.post.add", "admin.post.edit", "admin.post.delete"}},
+	}
+	// adminApp is shared by all the tests, since the
+	// models can only be registered once per process.
+	adminApp *app.App
+)
+
+func init() {
+	orm.Register(&adminPost{}, nil)
+	orm.Register(&adminComment{}, nil)
+	Register(&adminPost{}, &Model{
+		Name:    "post",
+		Filters: []string{"Draft", "Published"},
+		Inlines: []*Inline{{Model: &adminComment{}, Field: "PostId"}},
+	})
+	Register(&adminComment{}, &Model{Name: "comment"})
+}
+
+func TestMain(m *testing.M) {
+	dir, err := ioutil.TempDir("", "admin-handlers")
+	if err != nil {
+		panic(err)
+	}
+	adminApp = app.New()
+	adminApp.Logger = nil
+	adminApp.Config().Database = config.MustParseURL("sqlite://" + filepath.Join(dir, "admin.db"))
+	adminApp.Config().Secret = strings.Repeat("s", 32)
+	adminApp.Handle("^/sign-in/(\\d+)/$", func(ctx *app.Context) {
+		id, _ := strconv.ParseInt(ctx.IndexValue(0), 10, 64)
+		ctx.MustSignIn(adminUsers[id])
+	})
+	adminApp.Include("/admin/", App, "")
+	adminApp.SetUserFunc(func(ctx *app.Context, id int64) app.User {
+		if u := adminUsers[id]; u != nil {
+			return u
+		}
+		return nil
+	})
+	if err := adminApp.Prepare(); err != nil {
+		panic(err)
+	}
+	ctx := adminApp.NewContext(nil)
+	ctx.Orm().MustInsert(&adminPost{Title: "Visible post"})
+	ctx.Orm().MustInsert(&adminComment{PostId: 1, Text: "Hidden comment"})
+	adminApp.CloseContext(ctx)
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// adminRequest performs a request to the given path in the
+// admin, signed in as the user with the given id.
+func adminRequest(t *testing.T, method string, path string, userId int64) *httptest.ResponseRecorder {
+	r, _ := http.NewRequest("GET", "http://localhost/sign-in/"+strconv.FormatInt(userId, 10)+"/", nil)
+	w := httptest.NewRecorder()
+	adminApp.ServeHTTP(w, r)
+	cookies := (&http.Response{Header: w.Header()}).Cookies()
+	if len(cookies) == 0 {
+		t.Fatalf("signing in as user %d did not set a cookie", userId)
+	}
+	r, _ = http.NewRequest(method, "http://localhost/admin"+path, nil)
+	for _, v := range cookies {
+		r.AddCookie(v)
+	}
+	w = httptest.NewRecorder()
+	adminApp.ServeHTTP(w, r)
+	return w
+}
+
+func TestPermissions(t *testing.T) {
+	cases := []struct {
+		method string
+		path   string
+		userId int64
+		code   int
+	}{
+		{"GET", "/post/", 1, http.StatusForbidden},
+		{"GET", "/post/", 2, http.StatusOK},
+		{"GET", "/post/1/", 1, http.StatusForbidden},
+		{"GET", "/post/1/", 2, http.StatusOK},
+		{"GET", "/post/add/", 2, http.StatusForbidden},
+		{"GET", "/post/add/", 4, http.StatusOK},
+		{"GET", "/post/1/edit/", 2, http.StatusForbidden},
+		{"GET", "/post/1/edit/", 4, http.StatusOK},
+		{"GET", "/post/1/delete/", 2, http.StatusForbidden},
+		{"POST", "/post/1/delete/", 2, http.StatusForbidden},
+		{"GET", "/post/1/delete/", 4, http.StatusOK},
+		{"GET", "/comment/", 2, http.StatusForbidden},
+		{"GET", "/comment/", 3, http.StatusOK},
+	}
+	for _, v := range cases {
+		w := adminRequest(t, v.method, v.path, v.userId)
+		if w.Code != v.code {
+			t.Errorf("%s %s as user %d: expecting code %d, got %d", v.method, v.path, v.userId, v.code, w.Code)
+		}
+	}
+	// The post must still exist after the rejected POST
+	ctx := adminApp.NewContext(nil)
+	defer adminApp.CloseContext(ctx)
+	if !ctx.Orm().MustOne(orm.Eq("Id", int64(1)), &adminPost{}) {
+		t.Error("post was deleted without permission")
+	}
+}
+
+func TestDetailInlinePermission(t *testing.T) {
+	// User 2 can view posts but not comments
+	body := adminRequest(t, "GET", "/post/1/", 2).Body.String()
+	if !strings.Contains(body, "Visible post") {
+		t.Errorf("detail page does not contain the post:\n%s", body)
+	}
+	if strings.Contains(body, "Hidden comment") {
+		t.Errorf("detail page shows comments to a user without permission:\n%s", body)
+	}
+	body = adminRequest(t, "GET", "/post/1/", 3).Body.String()
+	if !strings.Contains(body, "Hidden comment") {
+		t.Errorf("detail page does not show comments to a user with permission:\n%s", body)
+	}
+}
+
+func TestListFilters(t *testing.T) {
+	cases := []struct {
+		query   string
+		code    int
+		visible bool
+	}{
+		{"", http.StatusOK, true},
+		{"?Published=false", http.StatusOK, true},
+		{"?Published=true", http.StatusOK, false},
+		{"?Published=foo", http.StatusBadRequest, false},
+	}
+	for _, v := range cases {
+		w := adminRequest(t, "GET", "/post/"+v.query, 2)
+		if w.Code != v.code {
+			t.Errorf("%s: expecting code %d, got %d", v.query, v.code, w.Code)
+			continue
+		}
+		if w.Code != http.StatusOK {
+			continue
+		}
+		body := w.Body.String()
+		if strings.Contains(body, "Visible post") != v.visible {
+			t.Errorf("%s: expecting post visible = %v", v.query, v.visible)
+		}
+		if !strings.Contains(body, "Published=true") {
+			t.Errorf("%s: list page does not contain the Published filter:\n%s", v.query, body)
+		}
+	}
+}
+
+func TestRegisterUnknownField(t *testing.T) {
+	type unknownFieldModel struct {
+		Id int64 `orm:",primary_key,auto_increment"`
+	}
+	for _, v := range []*Model{
+		{Name: "unknown-filter", Filters: []string{"Bogus"}},
+		{Name: "unknown-search", SearchFields: []string{"Bogus"}},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expecting panic when registering model %s", v.Name)
+				}
+			}()
+			Register(&unknownFieldModel{}, v)
+		}()
+	}
+	if modelNamed("unknown-filter") != nil || modelNamed("unknown-search") != nil {
+		t.Error("model with unknown fields was registered")
+	}
+}
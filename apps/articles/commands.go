@@ -0,0 +1,71 @@
+package articles
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"gnd.la/app"
+	"gnd.la/commands"
+)
+
+func commentsPending(ctx *app.Context) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, '\t', tabwriter.Debug)
+	fmt.Fprint(w, "ID\tArticle\tName\tEmail\tCreated\tText\n")
+	for _, v := range PendingComments(ctx) {
+		text := v.Text
+		if len(text) > 60 {
+			text = text[:57] + "..."
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%q\n", v.Id, v.ArticleId, v.Name, v.Email, v.Created.Format("2006-01-02 15:04"), text)
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+}
+
+func commentApprove(ctx *app.Context) {
+	var id int64
+	ctx.MustParseIndexValue(0, &id)
+	if err := ApproveComment(ctx, id); err != nil {
+		panic(err)
+	}
+}
+
+func commentSpam(ctx *app.Context) {
+	var id int64
+	ctx.MustParseIndexValue(0, &id)
+	if err := MarkCommentSpam(ctx, id); err != nil {
+		panic(err)
+	}
+}
+
+func commentDelete(ctx *app.Context) {
+	var id int64
+	ctx.MustParseIndexValue(0, &id)
+	if err := DeleteComment(ctx, id); err != nil {
+		panic(err)
+	}
+}
+
+func init() {
+	commands.Register(commentsPending, &commands.Options{
+		Name: "articles-comments-pending",
+		Help: "List the article comments awaiting moderation",
+	})
+	commands.Register(commentApprove, &commands.Options{
+		Name:  "articles-comment-approve",
+		Usage: "<id>",
+		Help:  "Approve an article comment",
+	})
+	commands.Register(commentSpam, &commands.Options{
+		Name:  "articles-comment-spam",
+		Usage: "<id>",
+		Help:  "Mark an article comment as spam",
+	})
+	commands.Register(commentDelete, &commands.Options{
+		Name:  "articles-comment-delete",
+		Usage: "<id>",
+		Help:  "Delete an article comment and its replies",
+	})
+}
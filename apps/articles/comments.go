@@ -0,0 +1,257 @@
+package articles
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/apps/articles/article"
+	"gnd.la/i18n"
+	"gnd.la/orm"
+	"gnd.la/signal"
+)
+
+const (
+	// COMMENT_ADDED is emitted after a comment is added, either
+	// pending moderation or already approved. The object is a
+	// *CommentEvent.
+	COMMENT_ADDED = "gnd.la/apps/articles.comment-added"
+	// COMMENT_APPROVED is emitted after a comment is approved, including
+	// comments which are approved automatically. The object is a
+	// *CommentEvent.
+	COMMENT_APPROVED = "gnd.la/apps/articles.comment-approved"
+
+	// ModeratePermission is the permission required for
+	// moderating comments. See app.Context.UserHas.
+	ModeratePermission = "articles.comments.moderate"
+
+	commentsKey = "articles-comments"
+)
+
+var (
+	// ModerateComments indicates if new comments must be approved
+	// by a moderator before they're shown. Comments from users with
+	// ModeratePermission are always approved.
+	ModerateComments = true
+	// CommentMinSubmitTime is the minimum time between showing
+	// the comment form and submitting it. Faster submissions are
+	// rejected, since they're most likely coming from bots.
+	CommentMinSubmitTime = 3 * time.Second
+
+	errNoSuchComment = i18n.NewError("no such comment")
+
+	spamCheckers struct {
+		sync.RWMutex
+		checkers []SpamChecker
+	}
+)
+
+// Comment is a comment on an article. Replies to another
+// comment have its Id as their ParentId.
+type Comment struct {
+	Id        int64  `orm:",primary_key,auto_increment"`
+	ArticleId string `orm:",index"`
+	ParentId  int64  `orm:",index"`
+	UserId    int64  `orm:",omitempty,nullempty"`
+	Name      string `orm:",max_length=255"`
+	Email     string `orm:",omitempty,nullempty"`
+	Text      string
+	Approved  bool `orm:",index"`
+	Spam      bool
+	IP        string `orm:",omitempty,nullempty"`
+	Created   time.Time
+}
+
+// Thread is a comment with its replies.
+type Thread struct {
+	*Comment
+	Replies []*Thread
+}
+
+// CommentEvent is the object emitted with the
+// comment signals.
+type CommentEvent struct {
+	// Ctx is the request context
+	Ctx *app.Context
+	// Comment is the comment which triggered the signal
+	Comment *Comment
+}
+
+// SpamChecker is a function which checks if a comment is spam,
+// before it's saved. Comments marked as spam are saved, but never
+// approved automatically.
+type SpamChecker func(ctx *app.Context, c *Comment) (bool, error)
+
+// AddSpamChecker adds a function to check new comments
+// for spam (e.g. using an external service). Checkers
+// are called in the same order they were added.
+func AddSpamChecker(f SpamChecker) {
+	spamCheckers.Lock()
+	defer spamCheckers.Unlock()
+	spamCheckers.checkers = append(spamCheckers.checkers, f)
+}
+
+func isSpam(ctx *app.Context, c *Comment) (bool, error) {
+	spamCheckers.RLock()
+	checkers := spamCheckers.checkers
+	spamCheckers.RUnlock()
+	for _, v := range checkers {
+		spam, err := v(ctx, c)
+		if err != nil {
+			return false, err
+		}
+		if spam {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// EnableComments enables comments on the articles of the
+// given App, which must be the articles app or a clone of it.
+func EnableComments(a *app.App) {
+	a.Set(commentsKey, true)
+}
+
+func commentsEnabled(a *app.App) bool {
+	enabled, _ := a.Get(commentsKey).(bool)
+	return enabled
+}
+
+func emitCommentEvent(name string, ctx *app.Context, c *Comment) {
+	signal.Emit(name, &CommentEvent{Ctx: ctx, Comment: c})
+}
+
+// Comments returns the approved comments for the given article,
+// as threads sorted by their creation date.
+func Comments(ctx *app.Context, art *article.Article) []*Thread {
+	var comments []*Comment
+	q := orm.And(orm.Eq("ArticleId", articleId(art)), orm.Eq("Approved", true))
+	ctx.Orm().Query(q).Sort("Created", orm.ASC).MustAll(&comments)
+	return threads(comments)
+}
+
+// threads arranges the comments into threads. Replies
+// to missing comments are ignored.
+func threads(comments []*Comment) []*Thread {
+	byId := make(map[int64]*Thread, len(comments))
+	for _, v := range comments {
+		byId[v.Id] = &Thread{Comment: v}
+	}
+	var roots []*Thread
+	for _, v := range comments {
+		t := byId[v.Id]
+		if v.ParentId == 0 {
+			roots = append(roots, t)
+		} else if parent := byId[v.ParentId]; parent != nil {
+			parent.Replies = append(parent.Replies, t)
+		}
+	}
+	return roots
+}
+
+// PendingComments returns the comments awaiting moderation,
+// excluding the ones marked as spam, from the oldest to the newest.
+func PendingComments(ctx *app.Context) []*Comment {
+	var comments []*Comment
+	q := orm.And(orm.Eq("Approved", false), orm.Eq("Spam", false))
+	ctx.Orm().Query(q).Sort("Created", orm.ASC).MustAll(&comments)
+	return comments
+}
+
+// AddComment saves a new comment, checking it for spam and
+// approving it if required. It emits COMMENT_ADDED and, if
+// the comment was approved, COMMENT_APPROVED.
+func AddComment(ctx *app.Context, c *Comment) error {
+	c.Name = strings.TrimSpace(c.Name)
+	c.Text = strings.TrimSpace(c.Text)
+	if c.Created.IsZero() {
+		c.Created = time.Now().UTC()
+	}
+	if c.ParentId != 0 {
+		parent, err := findComment(ctx, c.ParentId)
+		if err != nil {
+			return err
+		}
+		if parent.ArticleId != c.ArticleId {
+			return errNoSuchComment
+		}
+	}
+	spam, err := isSpam(ctx, c)
+	if err != nil {
+		return err
+	}
+	c.Spam = spam
+	c.Approved = !spam && (!ModerateComments || ctx.UserHas(ModeratePermission))
+	if _, err := ctx.Orm().Insert(c); err != nil {
+		return err
+	}
+	emitCommentEvent(COMMENT_ADDED, ctx, c)
+	if c.Approved {
+		emitCommentEvent(COMMENT_APPROVED, ctx, c)
+	}
+	return nil
+}
+
+func findComment(ctx *app.Context, id int64) (*Comment, error) {
+	var c *Comment
+	if !ctx.Orm().MustOne(orm.Eq("Id", id), &c) {
+		return nil, errNoSuchComment
+	}
+	return c, nil
+}
+
+// ApproveComment approves the comment with the given id, emitting
+// COMMENT_APPROVED. Approving an already approved comment does
+// nothing.
+func ApproveComment(ctx *app.Context, id int64) error {
+	c, err := findComment(ctx, id)
+	if err != nil {
+		return err
+	}
+	if c.Approved {
+		return nil
+	}
+	c.Approved = true
+	c.Spam = false
+	if _, err := ctx.Orm().Save(c); err != nil {
+		return err
+	}
+	emitCommentEvent(COMMENT_APPROVED, ctx, c)
+	return nil
+}
+
+// MarkCommentSpam marks the comment with the given id as
+// spam, hiding it if it was approved.
+func MarkCommentSpam(ctx *app.Context, id int64) error {
+	c, err := findComment(ctx, id)
+	if err != nil {
+		return err
+	}
+	c.Approved = false
+	c.Spam = true
+	_, err = ctx.Orm().Save(c)
+	return err
+}
+
+// DeleteComment deletes the comment with the given id and
+// all its replies.
+func DeleteComment(ctx *app.Context, id int64) error {
+	c, err := findComment(ctx, id)
+	if err != nil {
+		return err
+	}
+	var replies []*Comment
+	ctx.Orm().Query(orm.Eq("ParentId", c.Id)).MustAll(&replies)
+	for _, v := range replies {
+		if err := DeleteComment(ctx, v.Id); err != nil {
+			return err
+		}
+	}
+	return ctx.Orm().Delete(c)
+}
+
+func init() {
+	orm.Register(&Comment{}, nil)
+}
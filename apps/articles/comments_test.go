@@ -0,0 +1,193 @@
+package articles
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/apps/articles/article"
+	"gnd.la/config"
+	_ "gnd.la/orm/driver/sqlite"
+	"gnd.la/signal"
+)
+
+func init() {
+	AddSpamChecker(func(ctx *app.Context, c *Comment) (bool, error) {
+		return strings.Contains(c.Text, "cheap pills"), nil
+	})
+}
+
+// commentsApp is shared by all the tests, since the
+// models can only be registered once per process.
+var commentsApp *app.App
+
+func TestMain(m *testing.M) {
+	dir, err := ioutil.TempDir("", "articles-comments")
+	if err != nil {
+		panic(err)
+	}
+	commentsApp = app.New()
+	commentsApp.Config().Database = config.MustParseURL("sqlite://" + filepath.Join(dir, "comments.db"))
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func commentsContext() (*app.Context, func()) {
+	ctx := commentsApp.NewContext(nil)
+	return ctx, func() {
+		commentsApp.CloseContext(ctx)
+	}
+}
+
+func threadTexts(threads []*Thread) []string {
+	var texts []string
+	for _, v := range threads {
+		texts = append(texts, v.Text)
+		for _, r := range threadTexts(v.Replies) {
+			texts = append(texts, v.Text+"/"+r)
+		}
+	}
+	return texts
+}
+
+func TestThreads(t *testing.T) {
+	comments := []*Comment{
+		{Id: 1, Text: "a"},
+		{Id: 2, Text: "b"},
+		{Id: 3, ParentId: 1, Text: "c"},
+		{Id: 4, ParentId: 3, Text: "d"},
+		{Id: 5, ParentId: 1, Text: "e"},
+		{Id: 6, ParentId: 42, Text: "orphan"},
+	}
+	expect := []string{"a", "a/c", "a/c/d", "a/e", "b"}
+	if texts := threadTexts(threads(comments)); !reflect.DeepEqual(texts, expect) {
+		t.Errorf("expecting threads %v, got %v", expect, texts)
+	}
+}
+
+func TestAddComment(t *testing.T) {
+	ctx, cleanup := commentsContext()
+	defer cleanup()
+	var added, approved []int64
+	addedTok := signal.Listen(COMMENT_ADDED, func(name string, obj interface{}) {
+		added = append(added, obj.(*CommentEvent).Comment.Id)
+	})
+	defer signal.Stop(COMMENT_ADDED, addedTok)
+	approvedTok := signal.Listen(COMMENT_APPROVED, func(name string, obj interface{}) {
+		approved = append(approved, obj.(*CommentEvent).Comment.Id)
+	})
+	defer signal.Stop(COMMENT_APPROVED, approvedTok)
+
+	art := &article.Article{Id: "intro"}
+	now := time.Now().UTC()
+	first := &Comment{ArticleId: "intro", Name: "  Alice ", Text: "First! ", Created: now}
+	if err := AddComment(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+	if first.Name != "Alice" || first.Text != "First!" || first.Approved || first.Spam {
+		t.Errorf("unexpected moderated comment %+v", first)
+	}
+	spam := &Comment{ArticleId: "intro", Name: "Bot", Text: "buy cheap pills", Created: now.Add(time.Second)}
+	if err := AddComment(ctx, spam); err != nil {
+		t.Fatal(err)
+	}
+	if !spam.Spam || spam.Approved {
+		t.Errorf("expecting comment to be marked as spam, got %+v", spam)
+	}
+	if pending := PendingComments(ctx); len(pending) != 1 || pending[0].Id != first.Id {
+		t.Errorf("expecting only comment %d to be pending, got %v", first.Id, pending)
+	}
+	if threads := Comments(ctx, art); len(threads) != 0 {
+		t.Errorf("expecting no approved comments, got %d", len(threads))
+	}
+	if err := ApproveComment(ctx, first.Id); err != nil {
+		t.Fatal(err)
+	}
+	// Approving twice doesn't emit the signal again
+	if err := ApproveComment(ctx, first.Id); err != nil {
+		t.Fatal(err)
+	}
+	ModerateComments = false
+	reply := &Comment{ArticleId: "intro", ParentId: first.Id, Name: "Bob", Text: "Reply", Created: now.Add(2 * time.Second)}
+	err := AddComment(ctx, reply)
+	ModerateComments = true
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reply.Approved {
+		t.Errorf("expecting reply to be approved without moderation")
+	}
+	if texts := threadTexts(Comments(ctx, art)); !reflect.DeepEqual(texts, []string{"First!", "First!/Reply"}) {
+		t.Errorf("unexpected threads %v", texts)
+	}
+	if expect := []int64{first.Id, spam.Id, reply.Id}; !reflect.DeepEqual(added, expect) {
+		t.Errorf("expecting COMMENT_ADDED for %v, got %v", expect, added)
+	}
+	if expect := []int64{first.Id, reply.Id}; !reflect.DeepEqual(approved, expect) {
+		t.Errorf("expecting COMMENT_APPROVED for %v, got %v", expect, approved)
+	}
+	// Replies must belong to the same article
+	other := &Comment{ArticleId: "other", ParentId: first.Id, Name: "Eve", Text: "Misplaced"}
+	if err := AddComment(ctx, other); err != errNoSuchComment {
+		t.Errorf("expecting errNoSuchComment replying from another article, got %v", err)
+	}
+	if err := AddComment(ctx, &Comment{ArticleId: "intro", ParentId: 9999, Text: "Lost"}); err != errNoSuchComment {
+		t.Errorf("expecting errNoSuchComment replying to a missing comment, got %v", err)
+	}
+}
+
+func TestModerateComments(t *testing.T) {
+	ctx, cleanup := commentsContext()
+	defer cleanup()
+	art := &article.Article{Id: "moderate"}
+	ModerateComments = false
+	defer func() { ModerateComments = true }()
+	var comments []*Comment
+	for ii, parent := range []int{-1, 0, 1, -1} {
+		c := &Comment{ArticleId: "moderate", Name: "User", Text: strings.Repeat("x", ii+1), Created: time.Now().UTC().Add(time.Duration(ii) * time.Second)}
+		if parent >= 0 {
+			c.ParentId = comments[parent].Id
+		}
+		if err := AddComment(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+		comments = append(comments, c)
+	}
+	if err := MarkCommentSpam(ctx, comments[3].Id); err != nil {
+		t.Fatal(err)
+	}
+	if texts := threadTexts(Comments(ctx, art)); !reflect.DeepEqual(texts, []string{"x", "x/xx", "x/xx/xxx"}) {
+		t.Errorf("unexpected threads after marking spam %v", texts)
+	}
+	if pending := PendingComments(ctx); len(pending) != 0 {
+		t.Errorf("spam should not be pending, got %v", pending)
+	}
+	// Deleting a comment removes its replies
+	if err := DeleteComment(ctx, comments[1].Id); err != nil {
+		t.Fatal(err)
+	}
+	if texts := threadTexts(Comments(ctx, art)); !reflect.DeepEqual(texts, []string{"x"}) {
+		t.Errorf("unexpected threads after deleting %v", texts)
+	}
+	if _, err := findComment(ctx, comments[2].Id); err != errNoSuchComment {
+		t.Errorf("reply was not deleted, got error %v", err)
+	}
+	// Approving clears the spam flag
+	if err := ApproveComment(ctx, comments[3].Id); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := findComment(ctx, comments[3].Id); err != nil || !c.Approved || c.Spam {
+		t.Errorf("expecting approved comment, got %+v (err %v)", c, err)
+	}
+	for _, f := range []func(*app.Context, int64) error{ApproveComment, MarkCommentSpam, DeleteComment} {
+		if err := f(ctx, 9999); err != errNoSuchComment {
+			t.Errorf("expecting errNoSuchComment for a missing comment, got %v", err)
+		}
+	}
+}
@@ -66,4 +66,25 @@
 //  if _, err := articles.LoadDir(tutorialsApp, pathutil.Relative("tutorials")); err != nil {
 //	panic(err)
 //  }
+//
+// Comments
+//
+// Comments can be enabled on the articles of an app by calling EnableComments.
+// Comments are threaded (users might reply to other comments) and stored using
+// the ORM, so the app must have a database configured.
+//
+//  articles.EnableComments(articlesApp)
+//
+// By default, new comments must be approved before they're shown (see
+// ModerateComments). Pending comments can be moderated by users with
+// the ModeratePermission permission at the URL for ModerateCommentsHandler
+// (/comments/moderate/ relative to the articles app) or using the
+// articles-comments-pending, articles-comment-approve, articles-comment-spam
+// and articles-comment-delete commands. Besides the honeypot and submission
+// time checks performed by the comment form, additional spam filters
+// might be added with AddSpamChecker.
+//
+// The COMMENT_ADDED and COMMENT_APPROVED signals are emitted when a comment is
+// added and approved, respectively. They might be used e.g. for notifying
+// moderators or the author of the parent comment.
 package articles
@@ -19,11 +19,13 @@ var (
 func init() {
 	App.SetName("Articles")
 	App.AddTemplateVars(map[string]interface{}{
-		"Article": ArticleHandlerName,
-		"List":    ArticleListHandlerName,
+		"Article":          ArticleHandlerName,
+		"List":             ArticleListHandlerName,
+		"ModerateComments": ModerateCommentsHandlerName,
 	})
 	App.HandleOptions("^/$", ArticleListHandler.Handler, ArticleListHandler.Options)
+	App.HandleOptions("^/comments/moderate/$", ModerateCommentsHandler.Handler, ModerateCommentsHandler.Options)
 	App.HandleOptions("^/(.+)/$", ArticleHandler.Handler, ArticleHandler.Options)
-	templatesFS := vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xecU]k\xdb0\x14\xf5s\u007f\xc5\xc5t\xd0\xc1\xecJ\x8e\xa3@q\f\xdb`O}\xdb\xf6<T[\x99\xc5T۳\x94\x8eR\xfa\xdfwe\xc9J۴\xdd\xc6\xc8X\xc1\xe7%\xd2\xfd8\xf7\xd8V\x8e\xf8`d\xa5DژK\x15\x1d\b\x84\x12\xc2X\x1e\x11B\xe8jI\xee\xfeZP\x9ae\x11\xcd\x16,_-\x19[`\x9cR\x86! \xd1?\xc0V\x1b>\xa0\x94\xbf~H\x87\xf0\xfbBps\x03\xb5\xd8\xc8V@\xfcI\x1a%b\xb8\xbd\xc5X:n\xdcZ\xb45.\x8epu\xa1\xba\xea\x1b\xc4oݙ\t\xf5EC\xa1R\\\xebu쏓N̘,\xefR\x15\xa7\r-w|E-\xaf\xf6\xda\xfc\"\xb9\xe8\xea\xeb\xb8<\x02\xb0\x04\xefp3v\x9cbKi\x85\x1cW\x83\xe0F\xd4p\xb6\x86\xd4\xcbI\xdf\xfb\x98\xd3*7\xbb\xaaǧ%۾ƴ\x9e\xe6\x1c\xbb\xfd}\xd2s\xae\xcd\xe71nY\xc6:d\x16\xdfw\xe4\xa1m\xcc\x03\x14\xbd}\xc8~\x90\xad\xd9\xc0\x89\x81x\xd2յ\xf0Jǯ\xe1djM?t\xc3%Ǌ\f\xff\x1f\t\xa1\tɀ.\xcfH\x8eE\xf6u\xf5^\x98PZ<\xc9n\x05\x82\x93\xe0&\xbc\x81jo\xa0\x97\xf8\xdc\xc0?W\xe5?c\xf8(>\x10\xcdxAPR\x9b\x83\x9a\xff\xaf\xfd\x9f\xb0\x9cY\xff_\xac\xd8*_:\xff\xc7\v`\xf6\xff\xff\xdc\xff\xcf\xf1\xe8<{\aس\xf5;\x17\xc1V=\xda:\xf9\xf2\xc0ۯ\"8\xb2\x0eN\xa8\xe4S\xd7\xc7\xd89\xd64YYph\x06\xb1YǖJ\\\x89A\x8b/\xbe\x0eR${\xa8\x8d\x97\xa8/\x9b\x180\xf7C\x9a\x06ҏ\xd7m\xd7k\x19\xc6;3\xdeS\xa0}Y\x90\xe0I\xd2{}\xceD}ʿ\x06\x97P\xf2\xa1\xbdnU9{\xea\x8c\x193\x0e\x80\x9f\x01\x00\x00\xff\xffN)\xd3\xcd\x00\x0e\x00\x00")
+	templatesFS := vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xecWmo۶\x13\xf7\xeb|\x8a\x03\x9b\x7f\x91\x00\x7f;\x92\xed(@+k\xeb\xb2\r(\xd0\rEӽ\x1eh\xeb\x1c\x11\xa5H\x8d\xa4\x93\x1a\x86\xbf\xfb@\x89\xa4\xfc ;\xe9\x8a\f\x1d\x1a\xbeḢ\xbb\xfb\xf1xw\xfa\x1dU\x86\xcd8\x0e\nS\xf2\xde\x13\x8d(\x8e\xa2$\x19\xf7\xa2f\xec\xfe\x1f\x8d\xe3\xa4\x17_\x0e\x93\xf1h8J\xae.{Q\x1c'Q܃\xa8\xf7/\x8c\x856T\xf5\xa2\xaf\xb6\xb5{\xb9\xff\xc8X\xad \xc79\x13\b\xe4#3\x1c\t\xac\u05eb\x15\f\xeaI\xf3\x1bE\x0e\xeb\xf5\xc9j\x05S.g\x9f\x80\xbcib&\x9cO\x8b\x18f\x9cj=!.\x9ct\xdfԛ٦\xaa\xf4\xa2\x88\xb3V_\x9a\xb3\xbb=1\xf7\xa3?\x95\xf9\x92d'\x00V\xc1O2_\xd6\x12\x179\xbb\xcb,\x90әBj0\x87W\x13\x1888\x83k\xb7\xd6`e\xf3\xf6T\xb7\xb5\xfe\xa2ʩA\xed\xed\x9c6\xf3m\xa5\xef\xa86\x7f\xd4\xebVK}\x8e\xcd\x01\xffj\x95\a\xb1z\x1f \xad\xec%+ń\x99Ù\x01\xe2qI\x01\xff\xd3\xe4\x1cμ\xe8\xe0W\xa9Jj\x80\f\xa3(\xe9Gq?\x1aB|\xf9*\x1a\x93\xf3\xda]\x95\x03\x86\\\xe3A\xed\x16 4\x10\x1a\v\xff\x87ٞA\a\xf1\x98\xc1/G%\xf2\xedGi\xe3\x84\xcdap-\xcb\x12\x85\xb1ʂ\xffY>!\xb3f]\x93\xbd\xb7\x0f;\xd6@Z\x8c\xecE\xad\xfb\x82@\x1dB#g^Qq\x8b\xc1\x8c\xf6\xfe\xb12XVܺcO5\x81Ax\xc5m\xa7\xee\x81\x11r\x03O\x03\xe4c\x81\n\x81*\x04!\xc1o\xc2\x12̀t9\xc6Ǌ\x87\xf8\x1eE\xce\xc4m\xb0\xb9\x19\x90\x1c\x95\x81\xfao\x9f\x89\xb9l-R\xf1I\xc3\\*Xʅ\xf2F\a\xf0\xd6\xc0=\xe3\x1c\xa6\b\xba\x90\xf7\x02\xa4\x98!0\x03\x05\xd50E\x14@\xabJ\xc9;\xcc\x1d\xb6\xfa\x8d\xb6ѥs\xfb4\a\x1e\xa1o7\t\x94h\n\x99OH%\xb5!@g\x86I1!\xd6\xfbx\x87J#\xfc\xe8\xf2\xa4M\x98\x1b\xbe\xb0w|\xb1\xf5\x9aM\x1eo\x84\xc4\xe0\x03\x8a\x1cUp\xc6ta\x8c\x14\x1e\xcd\xd4\b\x98\x1aѯ\x14+\xa9Zzw\xbc\x97\xda\xc0\xb5\x7fJ{\xadF\xac\x8e\x97\v\x8b8\xeb\fG_\xe0\xf6á#0\xfb\x16\xe9[+z0B\t\xe4\xd4\xd0\xe0*+\xdc\ne'\x9d\xf1\xe4\x0f\x97h(\xc9Rm\x94\x14\xb7uy\xfc\x9d\x96Mutk\x8d\xab\x1e\xca\xc56\xe2:\xeb\xa87g\xf0\xb3qU\x18?\x9b\xcdPH\xe9A!\x85\x15_\x12(\x14\xce'\xe4\x87z6\t\x17|\xb1\x9b\x16\x1f\x9a\xd3V5\xcdڨ\xb7\xcb\fug\xb8w\x19d\xe8Ce3\xbdw\xb4<>\xc3\xdd\xc9\x10\xed\x00\xe1\xe6GjW\xef\xbb\x1b\x9ci\xf3\xa4\xe4\xefa\xfe\x17%\xe3\xa4\x17\x0fG\xa3\xab\xe4j|9\x8a,\xff\x8bG\xd13\xff\xfb\xc6\xf9\xdf;\xa6\xcdQ\x0ehc\xeb1Dp\xc1;E\xc9\xf6\x97\xdeYm+\ng\x87\xe8c($i1\xccR\xea*Y\xfb\xd9\xfa\x93\xfa\xafV]\xb1\xb7\xb1\xd1,\xbd(\x86\x1b\xa5螙\x02\x067K!+ͶJQG\x9d\xd7\xeeX\x80\xe0?~[rM\xe5\xee\xaaQ\x9c햨\x05Ϟ\xb0.\x952GE͓6\x80\x0f\xe4\x7f\x9c$\x97\xbb\xfd\xdfU2|\xce\xffo+\xff\xbf8\xc3\xebF\xad&\x99\xb6\xa1\xf2\x8d\xc0!\xea~\x8c \xc0\xeeB\xbfjȴ˲\xaf\xe2[!\xc3\x7f))\xe3\xb0^\xc3Kn^\xbb\x94}yk^\a\x17<\x9a\x99\xc1\xd9j\x15\nV͚\xceC\xca\xffC\xba\xe6\xf9\xfaÌ\xfc7\x97\xd1\x1b\xee\xdd$V\xa7\xf3}\xfa\r\x902Q-\f\x98e\x85\x13R\xb0<GA@\xd0\x12'\x84\xe5\x04\xee(_\xe0.\xcb=B\xdc\xf5b6C\xad\xbd\x8a\x06fP\xe3z\x13O ߸\xe9\x0e\xa3?\xa2\xfe\x9e*a_\xbf[\xbd\xaeh\xe9u\xdf\xd8ߏW\x9c\xdb\xc8T\a\xf4\xe6\xc8\xd1\x04\xd4?7\xb3=ݾ\x11\t\x84s\xab\xd7L\xab\xd0\xdau5\x93\xf4\x9e2c\x9bDW\x96\x99\x14ms\xf9=\xd3\xd4\xe7\xf1<\x9e\xc7\x13\x8c\xbf\a\x00쾩\x9c\x00\x18\x00\x00")
 	App.SetTemplatesFS(templatesFS)
 }
@@ -2,10 +2,12 @@ package articles
 
 import (
 	"bytes"
+	"fmt"
 	"html/template"
 	"path"
 
 	"gnd.la/app"
+	"gnd.la/form"
 	"gnd.la/log"
 
 	"gnd.la/apps/articles/article"
@@ -14,15 +16,24 @@ import (
 )
 
 const (
-	ArticleHandlerName     = "articles-article"
-	ArticleListHandlerName = "articles-list"
+	ArticleHandlerName          = "articles-article"
+	ArticleListHandlerName      = "articles-list"
+	ModerateCommentsHandlerName = "articles-moderate-comments"
 )
 
 var (
-	ArticleHandler     = app.NamedHandler(ArticleHandlerName, articleHandler)
-	ArticleListHandler = app.NamedHandler(ArticleListHandlerName, articleListHandler)
+	ArticleHandler          = app.NamedHandler(ArticleHandlerName, articleHandler)
+	ArticleListHandler      = app.NamedHandler(ArticleListHandlerName, articleListHandler)
+	ModerateCommentsHandler = app.NamedHandler(ModerateCommentsHandlerName, app.Permission(moderateCommentsHandler, ModeratePermission))
 )
 
+type commentForm struct {
+	Name     string `form:",max_length=255,label=Name"`
+	Email    string `form:",optional,email,label=Email (not shown)"`
+	Text     string `form:",label=Comment"`
+	ParentId int64  `form:",optional,hidden"`
+}
+
 func articleHandler(ctx *app.Context) {
 	slug := ctx.IndexValue(0)
 	var art *article.Article
@@ -68,6 +79,46 @@ func articleHandler(ctx *app.Context) {
 		"Title":   art.Title(),
 		"Body":    template.HTML(body),
 	}
+	if commentsEnabled(ctx.App()) {
+		cf := &commentForm{}
+		// Replies are started by following a link with ?reply=<id>
+		ctx.ParseFormValue("reply", &cf.ParentId)
+		f := form.NewOpts(ctx, &form.Options{
+			Honeypot:      "website",
+			MinSubmitTime: CommentMinSubmitTime,
+		}, cf)
+		if f.Submitted() && f.IsValid() {
+			c := &Comment{
+				ArticleId: articleId(art),
+				ParentId:  cf.ParentId,
+				Name:      cf.Name,
+				Email:     cf.Email,
+				Text:      cf.Text,
+				IP:        ctx.RemoteAddress(),
+			}
+			if user := ctx.User(); user != nil {
+				c.UserId = user.Id()
+			}
+			if err := AddComment(ctx, c); err != nil {
+				if err == errNoSuchComment {
+					ctx.BadRequest(err)
+					return
+				}
+				panic(err)
+			}
+			u := ctx.MustReverse(ArticleHandlerName, art.Slug())
+			if c.Approved {
+				u += fmt.Sprintf("#comment-%d", c.Id)
+			} else {
+				u += "?pending=1#comments"
+			}
+			ctx.Redirect(u, false)
+			return
+		}
+		data["Comments"] = Comments(ctx, art)
+		data["CommentForm"] = f
+		data["CommentPending"] = ctx.FormValue("pending") != ""
+	}
 	ctx.MustExecute("article.html", data)
 }
 
@@ -78,3 +129,41 @@ func articleListHandler(ctx *app.Context) {
 	}
 	ctx.MustExecute("list.html", data)
 }
+
+func moderateCommentsHandler(ctx *app.Context) {
+	f := form.New(ctx)
+	if f.Submitted() && f.IsValid() {
+		var id int64
+		if !ctx.ParseFormValue("id", &id) {
+			ctx.BadRequest("invalid comment id")
+			return
+		}
+		var err error
+		switch ctx.FormValue("action") {
+		case "approve":
+			err = ApproveComment(ctx, id)
+		case "spam":
+			err = MarkCommentSpam(ctx, id)
+		case "delete":
+			err = DeleteComment(ctx, id)
+		default:
+			ctx.BadRequest("invalid action")
+			return
+		}
+		if err != nil {
+			if err == errNoSuchComment {
+				ctx.NotFound(err)
+				return
+			}
+			panic(err)
+		}
+		ctx.MustRedirectReverse(false, ModerateCommentsHandlerName)
+		return
+	}
+	data := map[string]interface{}{
+		"Comments": PendingComments(ctx),
+		"Form":     f,
+		"Title":    ctx.T("Moderate Comments"),
+	}
+	ctx.MustExecute("moderate.html", data)
+}
@@ -0,0 +1,121 @@
+package articles
+
+import (
+	"html"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/config"
+)
+
+var (
+	formRe  = regexp.MustCompile(`(?s)<form.*?</form>`)
+	inputRe = regexp.MustCompile(`<input[^>]*>`)
+	nameRe  = regexp.MustCompile(`name="([^"]*)"`)
+	valueRe = regexp.MustCompile(`value="([^"]*)"`)
+)
+
+type moderator struct{}
+
+func (m *moderator) Id() int64     { return 1 }
+func (m *moderator) IsAdmin() bool { return false }
+
+func (m *moderator) HasPermission(ctx *app.Context, perm string) bool {
+	return perm == ModeratePermission
+}
+
+func TestModerateCommentsHandler(t *testing.T) {
+	dir, err := ioutil.TempDir("", "articles-handlers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a := app.New()
+	a.Logger = nil
+	a.Config().Database = config.MustParseURL("sqlite://" + filepath.Join(dir, "articles.db"))
+	a.Config().Secret = strings.Repeat("s", 32)
+	a.Config().EncryptionKey = strings.Repeat("k", 32)
+	a.Handle("^/sign-in/$", func(ctx *app.Context) {
+		ctx.MustSignIn(&moderator{})
+	})
+	a.Include("/articles/", App, "")
+	a.SetUserFunc(func(ctx *app.Context, id int64) app.User {
+		if id == 1 {
+			return &moderator{}
+		}
+		return nil
+	})
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := a.NewContext(nil)
+	pending := &Comment{ArticleId: "intro", Name: "Alice", Text: "Pending"}
+	err = AddComment(ctx, pending)
+	a.CloseContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cookies []*http.Cookie
+	do := func(r *http.Request) *httptest.ResponseRecorder {
+		for _, v := range cookies {
+			r.AddCookie(v)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		cookies = append(cookies, (&http.Response{Header: w.Header()}).Cookies()...)
+		return w
+	}
+	r, _ := http.NewRequest("GET", "http://localhost/sign-in/", nil)
+	do(r)
+	r, _ = http.NewRequest("GET", "http://localhost/articles/comments/moderate/", nil)
+	w := do(r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expecting code 200 loading the moderation page, got %d", w.Code)
+	}
+	form := formRe.FindString(w.Body.String())
+	if form == "" {
+		t.Fatalf("moderation page does not contain a form:\n%s", w.Body.String())
+	}
+	values := url.Values{}
+	for _, v := range inputRe.FindAllString(form, -1) {
+		name := nameRe.FindStringSubmatch(v)
+		value := valueRe.FindStringSubmatch(v)
+		if name != nil && value != nil {
+			values.Set(html.UnescapeString(name[1]), html.UnescapeString(value[1]))
+		}
+	}
+	post := func(id string) int {
+		values.Set("id", id)
+		values.Set("action", "approve")
+		r, _ := http.NewRequest("POST", "http://localhost/articles/comments/moderate/", strings.NewReader(values.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return do(r).Code
+	}
+	cases := []struct {
+		id   string
+		code int
+	}{
+		{"9999", http.StatusNotFound},
+		{"foo", http.StatusBadRequest},
+		{"", http.StatusBadRequest},
+		{values.Get("id"), http.StatusFound},
+	}
+	for _, v := range cases {
+		if code := post(v.id); code != v.code {
+			t.Errorf("moderating comment %q: expecting code %d, got %d", v.id, v.code, code)
+		}
+	}
+	ctx = a.NewContext(nil)
+	defer a.CloseContext(ctx)
+	if c, err := findComment(ctx, pending.Id); err != nil || !c.Approved {
+		t.Errorf("expecting approved comment, got %+v (err %v)", c, err)
+	}
+}
@@ -0,0 +1,32 @@
+package contact
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"gnd.la/app"
+	"gnd.la/commands"
+)
+
+func contactSubmissions(ctx *app.Context) {
+	var limit int
+	ctx.ParseParamValue("n", &limit)
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, '\t', tabwriter.Debug)
+	fmt.Fprint(w, "ID\tDate\tName\tEmail\tSubject\tIP\n")
+	for _, v := range Submissions(ctx, limit) {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", v.Id, v.Created.Format("2006-01-02 15:04"), v.Name, v.Email, v.Subject, v.IP)
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+}
+
+func init() {
+	commands.Register(contactSubmissions, &commands.Options{
+		Help: "List the messages sent using the contact form",
+		Flags: commands.Flags(
+			commands.IntFlag("n", 20, "Maximum number of messages to list, 0 for no limit"),
+		),
+	})
+}
@@ -0,0 +1,173 @@
+package contact
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/net/mail"
+	"gnd.la/orm"
+	"gnd.la/util/stringutil"
+)
+
+var (
+	// To is the address where the submissions are sent. It defaults
+	// to the administrator email (see gnd.la/net/mail.AdminEmail). If
+	// it's empty, submissions are only stored.
+	To = mail.Admin
+	// Subject is the subject used for the emails with the submissions.
+	// It might contain a %s, which is replaced by the submission Subject
+	// field, if the form has one.
+	Subject = "Contact form: %s"
+	// MaxSubmissions is the maximum number of submissions accepted from
+	// the same IP address during RateLimitPeriod. If zero, there's no
+	// limit.
+	MaxSubmissions = 5
+	// RateLimitPeriod is the period used for limiting the number
+	// of submissions from the same IP address.
+	RateLimitPeriod = time.Hour
+	// MinSubmitTime is the minimum time between showing the form and
+	// submitting it. Faster submissions are rejected, since they're
+	// most likely coming from bots.
+	MinSubmitTime = 3 * time.Second
+
+	formType = struct {
+		sync.RWMutex
+		typ reflect.Type
+	}{typ: reflect.TypeOf(Form{})}
+)
+
+// Form is the default contact form. Use SetForm to
+// change the fields shown to users.
+type Form struct {
+	Name    string `form:",max_length=255,label=Name"`
+	Email   string `form:",email,label=Email"`
+	Subject string `form:",max_length=255,label=Subject"`
+	Message string `form:",label=Message"`
+}
+
+// Submission is a message sent using the contact form. Fields named
+// Name, Email and Subject in the form are copied to the fields with
+// the same name, while every field is also included in Data.
+type Submission struct {
+	Id      int64  `orm:",primary_key,auto_increment"`
+	Name    string `orm:",omitempty,nullempty"`
+	Email   string `orm:",omitempty,nullempty"`
+	Subject string `orm:",omitempty,nullempty"`
+	// Fields contains the field names, in the same order
+	// they appear in the form.
+	Fields  []string          `orm:",codec=json"`
+	Data    map[string]string `orm:",codec=json"`
+	IP      string            `orm:",omitempty,nullempty"`
+	Created time.Time         `orm:",index"`
+}
+
+// Values returns the submission data as pairs of field
+// labels and values, in the same order they were in
+// the form.
+func (s *Submission) Values() [][2]string {
+	values := make([][2]string, len(s.Fields))
+	for ii, v := range s.Fields {
+		values[ii] = [2]string{stringutil.CamelCaseToWords(v, " "), s.Data[v]}
+	}
+	return values
+}
+
+// SetForm sets the form shown to users. The form parameter must be a
+// struct or a pointer to a struct, which will be used as a gnd.la/form
+// form. Every exported field is stored and sent by email, formatted
+// with fmt.Sprint.
+func SetForm(form interface{}) {
+	typ := reflect.TypeOf(form)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		panic(fmt.Errorf("contact form must be a struct, not %T", form))
+	}
+	formType.Lock()
+	formType.typ = typ
+	formType.Unlock()
+}
+
+func newForm() reflect.Value {
+	formType.RLock()
+	defer formType.RUnlock()
+	return reflect.New(formType.typ)
+}
+
+// newSubmission creates a Submission from the
+// given form value, which must be a pointer.
+func newSubmission(ctx *app.Context, form reflect.Value) *Submission {
+	s := &Submission{
+		Data:    make(map[string]string),
+		IP:      ctx.RemoteAddress(),
+		Created: time.Now().UTC(),
+	}
+	val := form.Elem()
+	typ := val.Type()
+	for ii := 0; ii < typ.NumField(); ii++ {
+		field := typ.Field(ii)
+		if field.PkgPath != "" || field.Tag.Get("form") == "-" {
+			continue
+		}
+		value := fmt.Sprint(val.Field(ii).Interface())
+		s.Fields = append(s.Fields, field.Name)
+		s.Data[field.Name] = value
+		switch field.Name {
+		case "Name":
+			s.Name = value
+		case "Email":
+			s.Email = value
+		case "Subject":
+			s.Subject = value
+		}
+	}
+	return s
+}
+
+func rateLimitKey(ctx *app.Context) string {
+	return "gnd:la:contact:rate:" + ctx.RemoteAddress()
+}
+
+// rateLimited returns true iff the current IP address
+// has exceeded the maximum number of submissions.
+func rateLimited(ctx *app.Context) bool {
+	if MaxSubmissions <= 0 {
+		return false
+	}
+	var count int
+	ctx.Cache().Get(rateLimitKey(ctx), &count)
+	return count >= MaxSubmissions
+}
+
+func countSubmission(ctx *app.Context) {
+	if MaxSubmissions <= 0 {
+		return
+	}
+	// This is a bit racy, but it's good enough for
+	// limiting abuse.
+	key := rateLimitKey(ctx)
+	var count int
+	ctx.Cache().Get(key, &count)
+	ctx.Cache().Set(key, count+1, int(RateLimitPeriod/time.Second))
+}
+
+// Submissions returns the stored submissions, from the newest to
+// the oldest. If limit is greater than zero, at most limit
+// submissions are returned.
+func Submissions(ctx *app.Context, limit int) []*Submission {
+	var submissions []*Submission
+	q := ctx.Orm().All().Sort("Created", orm.DESC)
+	if limit > 0 {
+		q.Limit(limit)
+	}
+	q.MustAll(&submissions)
+	return submissions
+}
+
+func init() {
+	orm.Register(&Submission{}, nil)
+}
@@ -0,0 +1,162 @@
+package contact
+
+import (
+	"html"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"gnd.la/config"
+	"gnd.la/net/mail"
+	_ "gnd.la/orm/driver/sqlite"
+)
+
+var (
+	inputRe = regexp.MustCompile(`<input[^>]*>`)
+	nameRe  = regexp.MustCompile(`name="([^"]*)"`)
+	valueRe = regexp.MustCompile(`value="([^"]*)"`)
+
+	sent struct {
+		sync.Mutex
+		envelopes []*mail.Envelope
+	}
+)
+
+type testBackend struct{}
+
+func (b *testBackend) Send(env *mail.Envelope) error {
+	sent.Lock()
+	sent.envelopes = append(sent.envelopes, env)
+	sent.Unlock()
+	return nil
+}
+
+func sentEnvelopes() []*mail.Envelope {
+	sent.Lock()
+	defer sent.Unlock()
+	return append([]*mail.Envelope(nil), sent.envelopes...)
+}
+
+func TestMain(m *testing.M) {
+	dir, err := ioutil.TempDir("", "contact")
+	if err != nil {
+		panic(err)
+	}
+	mail.RegisterBackend("contacttest", func(u *config.URL) (mail.Backend, error) {
+		return &testBackend{}, nil
+	})
+	mail.Config.MailServer = "contacttest://"
+	mail.Config.DefaultFrom = "site@example.com"
+	To = "admin@example.com"
+	// Don't wait before submitting the form
+	MinSubmitTime = 0
+	App.Logger = nil
+	App.Config().Secret = strings.Repeat("s", 32)
+	App.Config().Database = config.MustParseURL("sqlite://" + filepath.Join(dir, "contact.db"))
+	App.Config().Cache = config.MustParseURL("memory://")
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// submit loads the contact form and submits it with the given
+// values, plus the hidden ones from the form (e.g. the CSRF
+// protection).
+func submit(t *testing.T, values url.Values) *httptest.ResponseRecorder {
+	r, _ := http.NewRequest("GET", "http://localhost/", nil)
+	w := httptest.NewRecorder()
+	App.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expecting code 200 loading the form, got %d", w.Code)
+	}
+	for _, v := range inputRe.FindAllString(w.Body.String(), -1) {
+		if !strings.Contains(v, `type="hidden"`) {
+			continue
+		}
+		name := nameRe.FindStringSubmatch(v)
+		value := valueRe.FindStringSubmatch(v)
+		if name != nil && value != nil {
+			values.Set(html.UnescapeString(name[1]), html.UnescapeString(value[1]))
+		}
+	}
+	cookies := (&http.Response{Header: w.Header()}).Cookies()
+	r, _ = http.NewRequest("POST", "http://localhost/", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	for _, v := range cookies {
+		r.AddCookie(v)
+	}
+	w = httptest.NewRecorder()
+	App.ServeHTTP(w, r)
+	return w
+}
+
+func countSubmissions() int {
+	ctx := App.NewContext(nil)
+	defer App.CloseContext(ctx)
+	return len(Submissions(ctx, 0))
+}
+
+func contactValues() url.Values {
+	return url.Values{
+		"name":    {"Alice"},
+		"email":   {"alice@example.com"},
+		"subject": {"Hello"},
+		"message": {"Hello from the contact form"},
+	}
+}
+
+func TestContact(t *testing.T) {
+	prevMails := len(sentEnvelopes())
+	prevSubmissions := countSubmissions()
+	w := submit(t, contactValues())
+	if w.Code != http.StatusFound {
+		t.Fatalf("expecting a redirect after submitting, got code %d:\n%s", w.Code, w.Body.String())
+	}
+	if loc := w.Header().Get("Location"); !strings.Contains(loc, "sent=1") {
+		t.Errorf("expecting redirect to the sent page, got %q", loc)
+	}
+	if n := countSubmissions(); n != prevSubmissions+1 {
+		t.Errorf("expecting %d submissions, got %d", prevSubmissions+1, n)
+	}
+	envelopes := sentEnvelopes()
+	if len(envelopes) != prevMails+1 {
+		t.Fatalf("expecting 1 email, got %d", len(envelopes)-prevMails)
+	}
+	msg := envelopes[len(envelopes)-1].Message
+	if msg.To != "admin@example.com" {
+		t.Errorf("expecting email to admin@example.com, got %q", msg.To)
+	}
+	if !strings.Contains(msg.Subject, "Hello") {
+		t.Errorf("expecting the submission subject in the email subject, got %q", msg.Subject)
+	}
+	if !strings.Contains(msg.ReplyTo, "alice@example.com") {
+		t.Errorf("expecting Reply-To with the submission email, got %q", msg.ReplyTo)
+	}
+	if !strings.Contains(msg.TextBody, "Hello from the contact form") {
+		t.Errorf("expecting the message in the email body, got %q", msg.TextBody)
+	}
+}
+
+func TestContactHoneypot(t *testing.T) {
+	prevMails := len(sentEnvelopes())
+	prevSubmissions := countSubmissions()
+	values := contactValues()
+	values.Set("website", "http://spam.example.com")
+	w := submit(t, values)
+	if w.Code != http.StatusOK {
+		t.Errorf("expecting the form to be shown again, got code %d", w.Code)
+	}
+	if n := countSubmissions(); n != prevSubmissions {
+		t.Errorf("expecting no new submissions, got %d", n-prevSubmissions)
+	}
+	if n := len(sentEnvelopes()); n != prevMails {
+		t.Errorf("expecting no emails, got %d", n-prevMails)
+	}
+}
@@ -0,0 +1,27 @@
+// Package contact implements an app with a contact form.
+//
+// Messages sent using the form are stored using the ORM and sent by email
+// to the address in To (by default, the administrator). The stored messages
+// can be listed using the contact-submissions command.
+//
+// To use this app, include it into your main app:
+//
+//  App.Include("/contact/", contact.App, "base.html")
+//
+// The default form (see Form) asks for a name, an email, a subject and a
+// message. Other fields can be used by calling SetForm with a struct, which
+// is rendered and validated using gnd.la/form. e.g.
+//
+//  type ContactForm struct {
+//	Name    string `form:",max_length=255"`
+//	Email   string `form:",email"`
+//	Company string `form:",optional,max_length=255"`
+//	Message string
+//  }
+//
+//  contact.SetForm(&ContactForm{})
+//
+// Spam is prevented using a honeypot field, a minimum time between showing
+// the form and submitting it (see MinSubmitTime) and a limit on the number
+// of submissions per IP address (see MaxSubmissions and RateLimitPeriod).
+package contact
@@ -0,0 +1,27 @@
+package contact
+
+// AUTOMATICALLY GENERATED WITH gondola gen-app -release -- DO NOT EDIT!
+
+import (
+	"gnd.la/app"
+	"gnd.la/internal/vfsutil"
+	"gnd.la/template"
+	"gnd.la/template/assets"
+)
+
+var _ = vfsutil.Bake
+var _ = template.New
+var _ = assets.New
+var (
+	App = app.New()
+)
+
+func init() {
+	App.SetName("Contact")
+	App.AddTemplateVars(map[string]interface{}{
+		"Contact": ContactHandlerName,
+	})
+	App.HandleOptions("^/$", ContactHandler.Handler, ContactHandler.Options)
+	templatesFS := vfsutil.OpenBaked("\x1f\x8b\b\x00\xf0\xa7\xd1j\x02\xff\xec\x94Mk\xdbL\x14\x85\xb5\xf6\xaf8h\U000ee90clK\x01\xe3\x84\x17\xd2\x16\xb2(\x84ƴ\xeb\xb1\xe6\xca\x12\x91f\xcc̵\x93 \xf4ߋ>\xfc\x81KW\xa5.\x01\x9d\x85F\x9a\x19\xdd{\xe60<\xa9\xd1,S\x0es\xaeJ\xef/IDB$\xc9\xdc\x13\xbd.\xc7(\x123/\x8a\xa7\xc9|6\x9d\xdd&\xc2\x13Q\x14\xdf\xce<\b\xef\n\xda9\x96\xd6\x13\x7f\xdc\xeb\xf2p\x1fDu\rEY\xa1\t\xfe\xaa\xe0\x92|4M]\x83\xe1?\xf47c\x98 \xad\xd04\x93\xa5*\xf6HK\xe9ܝoͫ\x7f?\x01\xce\xe7RS\x06\x95\n\x12\f/&\xcb\x1cq0\xc3pς\xccت\xfb\vX\xe6\xd1\xfd/\xad\x967y\xd4/\xd75\x8a\f\xe13in;\xa3\xd3y/Y\x92et\xcf\xc0\xedҔ\x9c\U000c70ab\\\xea\x17\x87\xccX\xbc\x9b\x9dEE\xce\xc9\r\x85\xf8A\xff\x95%6\xc4X\xcb\xf4\x05l\xdauH\ag\x8cnǭq\xaeX\x97\x14\xf6fT\xb1?\xba\xa1\xd2\xd1\xc9I]\xe3\xb5\xe0\x1c\xe1gk\x8dm7\xffƚ\x92zC\xb6s\x16\x1ek\x9e\x12\x1d\xce\xd5悊87\xea\xce\xdf\x1a\xc7>dʅ\xd1w~]\xc3Ҟ\xac#\xfc?$\x85\xa6\x19B\x1c\xac\x84_\x8c\xad\xc2o\xa4\x15\xd9SU`\xb9\xde1\x1b}\xf0\xb5f\x8d5\xeb`k\x8bJ\xda\xf7C\\ϤU\x7f\xde~\xfb\xa1\xf4\xf2\xa6\xb5u\n\xe0\xe0x\xc8e\x18\xbcQ\x1fW\a\xfe\xf3\x1b{\xff\x88\xffb*\xe2K\xfe\xcfc1\xf2\xffJ\xfc\xb7-\xa0\x10~\x97\xe5\x8e\\O\xfbB+zC\b\x81\xa6YL\xce&\xa2\x16\x00\x93#\v\x82`\xf2\xf8\xb4\xe8\x00\xf4\xf8\xd4.}\x92L\xfd\xf7\x83%ɤ:0I\x86?\x15\"\tD\x14\x88)\xa2x!\xe6\v\x11\xe3\xeb\xf3\xaa\xc5\xceH\x90Q\xa3F\x8d\xba\xbe~\x0e\x00\x05\x14\xc1\x04\x00\x0e\x00\x00")
+	App.SetTemplatesFS(templatesFS)
+}
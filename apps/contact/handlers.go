@@ -0,0 +1,96 @@
+package contact
+
+import (
+	"fmt"
+	"mime"
+	stdmail "net/mail"
+	"strings"
+
+	"gnd.la/app"
+	"gnd.la/form"
+	"gnd.la/i18n"
+	"gnd.la/net/mail"
+)
+
+const (
+	ContactHandlerName = "contact-contact"
+
+	ContactTemplateName     = "contact.html"
+	ContactMailTemplateName = "contact.txt"
+)
+
+var (
+	ContactHandler = app.NamedHandler(ContactHandlerName, contactHandler)
+
+	errRateLimited = i18n.NewError("you've sent too many messages, please try again later")
+)
+
+func contactHandler(ctx *app.Context) {
+	fval := newForm()
+	f := form.NewOpts(ctx, &form.Options{
+		Honeypot:      "website",
+		MinSubmitTime: MinSubmitTime,
+	}, fval.Interface())
+	var sent bool
+	var err error
+	if ctx.FormValue("sent") != "" {
+		sent = true
+	} else if f.Submitted() && f.IsValid() {
+		if rateLimited(ctx) {
+			err = i18n.TranslatedError(errRateLimited, ctx)
+		} else {
+			s := newSubmission(ctx, fval)
+			ctx.Orm().MustInsert(s)
+			countSubmission(ctx)
+			if To != "" {
+				sendSubmission(ctx, s)
+			}
+			ctx.Redirect(ctx.MustReverse(ContactHandlerName)+"?sent=1", false)
+			return
+		}
+	}
+	data := map[string]interface{}{
+		"Form":  f,
+		"Sent":  sent,
+		"Error": err,
+	}
+	ctx.MustExecute(ContactTemplateName, data)
+}
+
+func sendSubmission(ctx *app.Context, s *Submission) {
+	subject := Subject
+	if strings.Contains(subject, "%s") {
+		subject = fmt.Sprintf(subject, headerText(s.Subject))
+	}
+	msg := &mail.Message{
+		To:      To,
+		ReplyTo: replyTo(s),
+		Subject: mime.QEncoding.Encode("utf-8", headerText(subject)),
+	}
+	ctx.MustSendMail(ContactMailTemplateName, s, msg)
+}
+
+// headerText returns s with all the line breaks replaced
+// by spaces, so user provided values can't inject
+// additional headers.
+func headerText(s string) string {
+	return strings.TrimSpace(strings.Map(func(r rune) rune {
+		if r == '\r' || r == '\n' {
+			return ' '
+		}
+		return r
+	}, s))
+}
+
+// replyTo returns the Reply-To header for the given
+// submission, with the name encoded as RFC 2047 if
+// required. If the submission has no valid email,
+// an empty string is returned.
+func replyTo(s *Submission) string {
+	addr, err := stdmail.ParseAddress(s.Email)
+	if err != nil || strings.ContainsAny(s.Email, "\r\n") {
+		return ""
+	}
+	addr.Name = headerText(s.Name)
+	return addr.String()
+}
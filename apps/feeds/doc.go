@@ -0,0 +1,33 @@
+// Package feeds implements generating and serving feeds in the RSS 2.0,
+// Atom and JSON Feed formats.
+//
+// A Feed contains the feed metadata and an ItemProvider, which returns its
+// items. Feeds backed by ORM models can use Query to build the provider. e.g.
+//
+//  var postsFeed = &feeds.Feed{
+//	Name:        "posts",
+//	Title:       "My Blog",
+//	Description: "The latest posts in my blog",
+//	Link:        "/",
+//	Items: feeds.Query(&Post{}, orm.Eq("Published", true), "Created", func(ctx *app.Context, obj interface{}) *feeds.Item {
+//		post := obj.(*Post)
+//		return &feeds.Item{
+//			Title:     post.Title,
+//			Link:      ctx.MustReverse("post", post.Slug),
+//			Content:   post.HTML,
+//			Published: post.Created,
+//		}
+//	}),
+//  }
+//
+// Then, the feed might be served in all the formats by calling Register:
+//
+//  feeds.Register(App, "/feed", postsFeed) // serves /feed.rss, /feed.atom and /feed.json
+//
+// Alternatively, Handler returns a handler for a single format, which might be
+// registered at any path.
+//
+// Items might include media files (e.g. podcast episodes) using Enclosures,
+// which are rendered as enclosures in RSS and Atom and as attachments in JSON Feed.
+// Relative URLs in items are made absolute using the current request.
+package feeds
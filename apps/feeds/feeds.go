@@ -0,0 +1,213 @@
+package feeds
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/orm"
+	"gnd.la/orm/query"
+)
+
+const (
+	defaultMaxItems = 20
+)
+
+// Format represents a feed format.
+type Format int
+
+const (
+	// RSS 2.0 (https://www.rssboard.org/rss-specification)
+	RSS Format = iota + 1
+	// Atom (RFC 4287)
+	Atom
+	// JSON Feed 1.1 (https://www.jsonfeed.org/version/1.1/)
+	JSON
+)
+
+// ContentType returns the MIME type for the format.
+func (f Format) ContentType() string {
+	switch f {
+	case RSS:
+		return "application/rss+xml"
+	case Atom:
+		return "application/atom+xml"
+	case JSON:
+		return "application/feed+json"
+	}
+	return ""
+}
+
+// Extension returns the file extension used for
+// the format, including the dot.
+func (f Format) Extension() string {
+	switch f {
+	case RSS:
+		return ".rss"
+	case Atom:
+		return ".atom"
+	case JSON:
+		return ".json"
+	}
+	return ""
+}
+
+func (f Format) String() string {
+	switch f {
+	case RSS:
+		return "rss"
+	case Atom:
+		return "atom"
+	case JSON:
+		return "json"
+	}
+	return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// Formats lists all the supported formats.
+var Formats = []Format{RSS, Atom, JSON}
+
+// Person is the author of a feed or an item.
+type Person struct {
+	Name  string
+	Email string
+	URL   string
+}
+
+// Enclosure is a media file attached to an item
+// (e.g. a podcast episode).
+type Enclosure struct {
+	URL string
+	// Type is the MIME type of the file (e.g. audio/mpeg).
+	Type string
+	// Length is the file size in bytes.
+	Length int64
+	// Duration is the media duration, if applicable. It's only
+	// included in JSON Feed.
+	Duration time.Duration
+}
+
+// Item is an entry in a feed. Id, Title and Link are required,
+// either Description or Content should also be provided.
+type Item struct {
+	// Id uniquely identifies the item. If empty, Link is used.
+	Id    string
+	Title string
+	// Link is the item URL. If it's not absolute, it's made
+	// absolute using the current request.
+	Link string
+	// Description is a summary of the item, as plain text.
+	Description string
+	// Content is the full item content, as HTML.
+	Content    string
+	Author     *Person
+	Categories []string
+	Published  time.Time
+	// Updated is the last time the item was modified. If
+	// zero, Published is used.
+	Updated time.Time
+	// Image is the URL of the main image for the item.
+	Image      string
+	Enclosures []*Enclosure
+}
+
+func (i *Item) id() string {
+	if i.Id != "" {
+		return i.Id
+	}
+	return i.Link
+}
+
+func (i *Item) updated() time.Time {
+	if !i.Updated.IsZero() {
+		return i.Updated
+	}
+	return i.Published
+}
+
+// ItemProvider returns the items for a feed, sorted from the newest
+// to the oldest. The limit parameter indicates the maximum number of
+// items which should be returned.
+type ItemProvider func(ctx *app.Context, limit int) ([]*Item, error)
+
+// Feed represents a feed which might be rendered in any
+// of the supported formats.
+type Feed struct {
+	// Name is used to generate the handler names when calling
+	// Register (e.g. feeds-<name>-rss), so it must be unique
+	// per app.
+	Name        string
+	Title       string
+	Description string
+	// Link is the URL for the site or page the feed belongs to. If
+	// it's not absolute, it's made absolute using the current request.
+	Link     string
+	Author   *Person
+	Language string
+	// Image is the URL of the feed logo or icon.
+	Image string
+	// Items returns the feed items.
+	Items ItemProvider
+	// MaxItems is the maximum number of items in the feed.
+	// If zero, it defaults to 20.
+	MaxItems int
+}
+
+func (f *Feed) maxItems() int {
+	if f.MaxItems > 0 {
+		return f.MaxItems
+	}
+	return defaultMaxItems
+}
+
+// Query returns an ItemProvider backed by an ORM query. The model
+// parameter is a pointer to a struct of the model type (e.g. &Post{}),
+// q is the query used to select the objects (nil selects all of them)
+// and sort is the field used for sorting the objects in descending
+// order (e.g. "Published"). Each object is converted into an *Item
+// by calling fn with a pointer to it.
+func Query(model interface{}, q query.Q, sort string, fn func(ctx *app.Context, obj interface{}) *Item) ItemProvider {
+	typ := reflect.TypeOf(model)
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return func(ctx *app.Context, limit int) ([]*Item, error) {
+		o := ctx.Orm()
+		tbl := o.TypeTable(typ)
+		if tbl == nil {
+			return nil, fmt.Errorf("type %s is not registered with the ORM", typ)
+		}
+		objects := reflect.New(reflect.SliceOf(reflect.PtrTo(typ)))
+		oq := o.Table(tbl).Filter(q).Limit(limit)
+		if sort != "" {
+			oq.Sort(sort, orm.DESC)
+		}
+		if err := oq.All(objects.Interface()); err != nil {
+			return nil, err
+		}
+		values := objects.Elem()
+		items := make([]*Item, 0, values.Len())
+		for ii := 0; ii < values.Len(); ii++ {
+			if item := fn(ctx, values.Index(ii).Interface()); item != nil {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	}
+}
+
+// absoluteURL makes u absolute using the current request.
+func absoluteURL(ctx *app.Context, u string) string {
+	if u == "" || strings.Contains(u, "://") {
+		return u
+	}
+	if cur := ctx.URL(); cur != nil {
+		if strings.HasPrefix(u, "//") {
+			return cur.Scheme + ":" + u
+		}
+		return cur.Scheme + "://" + cur.Host + "/" + strings.TrimPrefix(u, "/")
+	}
+	return u
+}
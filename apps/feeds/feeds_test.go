@@ -0,0 +1,320 @@
+package feeds
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/config"
+	"gnd.la/orm"
+	_ "gnd.la/orm/driver/sqlite"
+)
+
+var (
+	testPublished = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	testUpdated   = time.Date(2026, 10, 2, 8, 30, 0, 0, time.UTC)
+)
+
+func testItems(ctx *app.Context, limit int) ([]*Item, error) {
+	items := []*Item{
+		{
+			Title:      "Second",
+			Link:       "/posts/2",
+			Content:    "<p>Second post</p>",
+			Author:     &Person{Name: "Alice", Email: "alice@example.com"},
+			Categories: []string{"news"},
+			Published:  testPublished,
+			Updated:    testUpdated,
+			Image:      "/images/2.png",
+			Enclosures: []*Enclosure{{URL: "/media/2.mp3", Type: "audio/mpeg", Length: 1024, Duration: time.Minute}},
+		},
+		{
+			Id:          "urn:post:1",
+			Title:       "First",
+			Link:        "https://other.example.com/posts/1",
+			Description: "First post",
+			Published:   testPublished.Add(-24 * time.Hour),
+		},
+	}
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	return items, nil
+}
+
+func newTestFeed() *Feed {
+	return &Feed{
+		Name:        "test",
+		Title:       "Test Feed",
+		Description: "A feed for testing",
+		Link:        "/",
+		Author:      &Person{Name: "Bob", URL: "https://bob.example.com"},
+		Language:    "en",
+		Items:       testItems,
+	}
+}
+
+func feedsApp(f *Feed) *app.App {
+	a := app.New()
+	a.Logger = nil
+	Register(a, "/blog/feed", f)
+	return a
+}
+
+func getFeed(a *app.App, path string, headers map[string]string) *httptest.ResponseRecorder {
+	r, _ := http.NewRequest("GET", "http://localhost"+path, nil)
+	for k, v := range headers {
+		r.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	return w
+}
+
+func TestRSS(t *testing.T) {
+	w := getFeed(feedsApp(newTestFeed()), "/blog/feed.rss", nil)
+	if ct := w.Header().Get("Content-Type"); ct != "application/rss+xml; charset=utf-8" {
+		t.Errorf("unexpected Content-Type %q", ct)
+	}
+	var rss struct {
+		Channel struct {
+			Title string `xml:"title"`
+			// Also matches atom:link, which comes after link
+			Links         []string `xml:"link"`
+			LastBuildDate string   `xml:"lastBuildDate"`
+			Items         []struct {
+				Title string `xml:"title"`
+				Link  string `xml:"link"`
+				GUID  struct {
+					IsPermaLink bool   `xml:"isPermaLink,attr"`
+					Value       string `xml:",chardata"`
+				} `xml:"guid"`
+				Description string `xml:"description"`
+				Content     string `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+				Author      string `xml:"author"`
+				Enclosure   struct {
+					URL    string `xml:"url,attr"`
+					Length int64  `xml:"length,attr"`
+				} `xml:"enclosure"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	if err := xml.Unmarshal(w.Body.Bytes(), &rss); err != nil {
+		t.Fatal(err)
+	}
+	ch := rss.Channel
+	if ch.Title != "Test Feed" || len(ch.Links) != 2 || ch.Links[0] != "http://localhost/" || ch.LastBuildDate != testUpdated.Format(time.RFC1123Z) {
+		t.Errorf("unexpected channel %+v", ch)
+	}
+	if len(ch.Items) != 2 {
+		t.Fatalf("expecting 2 items, got %d", len(ch.Items))
+	}
+	first, second := ch.Items[0], ch.Items[1]
+	if first.Link != "http://localhost/posts/2" || !first.GUID.IsPermaLink || first.GUID.Value != first.Link {
+		t.Errorf("unexpected link or guid in %+v", first)
+	}
+	if first.Content != "<p>Second post</p>" || first.Description != first.Content || first.Author != "alice@example.com (Alice)" {
+		t.Errorf("unexpected content in %+v", first)
+	}
+	if first.Enclosure.URL != "http://localhost/media/2.mp3" || first.Enclosure.Length != 1024 {
+		t.Errorf("unexpected enclosure %+v", first.Enclosure)
+	}
+	if second.GUID.IsPermaLink || second.GUID.Value != "urn:post:1" || second.Link != "https://other.example.com/posts/1" {
+		t.Errorf("unexpected guid or link in %+v", second)
+	}
+}
+
+func TestAtom(t *testing.T) {
+	w := getFeed(feedsApp(newTestFeed()), "/blog/feed.atom", nil)
+	if ct := w.Header().Get("Content-Type"); ct != "application/atom+xml; charset=utf-8" {
+		t.Errorf("unexpected Content-Type %q", ct)
+	}
+	type link struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+	}
+	var feed struct {
+		Id      string `xml:"id"`
+		Updated string `xml:"updated"`
+		Links   []link `xml:"link"`
+		Author  struct {
+			Name string `xml:"name"`
+			URI  string `xml:"uri"`
+		} `xml:"author"`
+		Entries []struct {
+			Id        string `xml:"id"`
+			Updated   string `xml:"updated"`
+			Published string `xml:"published"`
+			Links     []link `xml:"link"`
+			Summary   string `xml:"summary"`
+			Content   struct {
+				Type  string `xml:"type,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"content"`
+		} `xml:"entry"`
+	}
+	if err := xml.Unmarshal(w.Body.Bytes(), &feed); err != nil {
+		t.Fatal(err)
+	}
+	if feed.Id != "http://localhost/blog/feed.atom" || feed.Updated != "2026-10-02T08:30:00Z" || feed.Author.URI != "https://bob.example.com" {
+		t.Errorf("unexpected feed %+v", feed)
+	}
+	if len(feed.Links) != 2 || feed.Links[0] != (link{"http://localhost/", "alternate"}) || feed.Links[1] != (link{"http://localhost/blog/feed.atom", "self"}) {
+		t.Errorf("unexpected feed links %+v", feed.Links)
+	}
+	if len(feed.Entries) != 2 {
+		t.Fatalf("expecting 2 entries, got %d", len(feed.Entries))
+	}
+	first, second := feed.Entries[0], feed.Entries[1]
+	if first.Updated != "2026-10-02T08:30:00Z" || first.Published != "2026-10-01T12:00:00Z" || first.Content.Type != "html" {
+		t.Errorf("unexpected entry %+v", first)
+	}
+	if len(first.Links) != 2 || first.Links[1] != (link{"http://localhost/media/2.mp3", "enclosure"}) {
+		t.Errorf("unexpected entry links %+v", first.Links)
+	}
+	if second.Id != "urn:post:1" || second.Updated != "2026-09-30T12:00:00Z" || second.Summary != "First post" {
+		t.Errorf("unexpected entry %+v", second)
+	}
+}
+
+func TestJSONFeed(t *testing.T) {
+	w := getFeed(feedsApp(newTestFeed()), "/blog/feed.json", nil)
+	if ct := w.Header().Get("Content-Type"); ct != "application/feed+json; charset=utf-8" {
+		t.Errorf("unexpected Content-Type %q", ct)
+	}
+	var feed jsonFeedData
+	if err := json.Unmarshal(w.Body.Bytes(), &feed); err != nil {
+		t.Fatal(err)
+	}
+	if feed.Version != jsonFeed || feed.FeedURL != "http://localhost/blog/feed.json" || feed.HomePageURL != "http://localhost/" {
+		t.Errorf("unexpected feed %+v", feed)
+	}
+	if len(feed.Items) != 2 {
+		t.Fatalf("expecting 2 items, got %d", len(feed.Items))
+	}
+	first, second := feed.Items[0], feed.Items[1]
+	if first.Image != "http://localhost/images/2.png" || first.ContentHTML == "" || first.ContentText != "" {
+		t.Errorf("unexpected item %+v", first)
+	}
+	if len(first.Attachments) != 1 || first.Attachments[0].Duration != 60 || first.Attachments[0].MimeType != "audio/mpeg" {
+		t.Errorf("unexpected attachments %+v", first.Attachments)
+	}
+	if second.ContentText != "First post" || second.DateModified != "" {
+		t.Errorf("unexpected item %+v", second)
+	}
+}
+
+func TestMaxItems(t *testing.T) {
+	f := newTestFeed()
+	f.MaxItems = 1
+	var feed jsonFeedData
+	if err := json.Unmarshal(getFeed(feedsApp(f), "/blog/feed.json", nil).Body.Bytes(), &feed); err != nil {
+		t.Fatal(err)
+	}
+	if len(feed.Items) != 1 {
+		t.Errorf("expecting 1 item, got %d", len(feed.Items))
+	}
+}
+
+func TestConditionalRequests(t *testing.T) {
+	a := feedsApp(newTestFeed())
+	w := getFeed(a, "/blog/feed.rss", nil)
+	etag := w.Header().Get("ETag")
+	lastMod := w.Header().Get("Last-Modified")
+	if etag == "" || lastMod != testUpdated.Format(http.TimeFormat) {
+		t.Fatalf("unexpected ETag %q and Last-Modified %q", etag, lastMod)
+	}
+	cases := []struct {
+		headers map[string]string
+		code    int
+	}{
+		{map[string]string{"If-None-Match": etag}, http.StatusNotModified},
+		{map[string]string{"If-None-Match": `"other", W/` + etag}, http.StatusNotModified},
+		{map[string]string{"If-None-Match": "*"}, http.StatusNotModified},
+		{map[string]string{"If-None-Match": `"other"`}, http.StatusOK},
+		{map[string]string{"If-Modified-Since": lastMod}, http.StatusNotModified},
+		{map[string]string{"If-Modified-Since": testUpdated.Add(-time.Second).Format(http.TimeFormat)}, http.StatusOK},
+		// If-Modified-Since is ignored when If-None-Match is present
+		{map[string]string{"If-None-Match": `"other"`, "If-Modified-Since": lastMod}, http.StatusOK},
+	}
+	for _, v := range cases {
+		if code := getFeed(a, "/blog/feed.rss", v.headers).Code; code != v.code {
+			t.Errorf("expecting status %d with headers %v, got %d", v.code, v.headers, code)
+		}
+	}
+	// Each format has its own ETag
+	if getFeed(a, "/blog/feed.atom", nil).Header().Get("ETag") == etag {
+		t.Error("expecting different ETags for RSS and Atom")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	f := newTestFeed()
+	a := feedsApp(f)
+	for _, v := range Formats {
+		name := HandlerName(f, v)
+		if name != "feeds-test-"+v.String() {
+			t.Errorf("unexpected handler name %q", name)
+		}
+		ctx := a.NewContext(nil)
+		if p, err := ctx.Reverse(name); err != nil || p != "/blog/feed"+v.Extension() {
+			t.Errorf("expecting %s to reverse to /blog/feed%s, got %q (err %v)", name, v.Extension(), p, err)
+		}
+		a.CloseContext(ctx)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("expecting a panic registering a feed without a name")
+		}
+	}()
+	Register(a, "/other", &Feed{})
+}
+
+type testPost struct {
+	Id        int64 `orm:",primary_key,auto_increment"`
+	Title     string
+	Published bool
+	Created   time.Time
+}
+
+func init() {
+	orm.Register(&testPost{}, nil)
+}
+
+func TestQuery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "feeds-query")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a := app.New()
+	a.Config().Database = config.MustParseURL("sqlite://" + filepath.Join(dir, "feeds.db"))
+	ctx := a.NewContext(nil)
+	defer a.CloseContext(ctx)
+	for ii, v := range []bool{true, false, true, true} {
+		post := &testPost{Title: strings.Repeat("x", ii+1), Published: v, Created: testPublished.Add(time.Duration(ii) * time.Hour)}
+		ctx.Orm().MustInsert(post)
+	}
+	provider := Query(&testPost{}, orm.Eq("Published", true), "Created", func(ctx *app.Context, obj interface{}) *Item {
+		post := obj.(*testPost)
+		return &Item{Title: post.Title, Published: post.Created}
+	})
+	items, err := provider(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || items[0].Title != "xxxx" || items[1].Title != "xxx" {
+		t.Errorf("unexpected items %+v", items)
+	}
+	if _, err := Query(&Item{}, nil, "", nil)(ctx, 1); err == nil {
+		t.Error("expecting an error with an unregistered type")
+	}
+}
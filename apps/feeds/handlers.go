@@ -0,0 +1,94 @@
+package feeds
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"gnd.la/app"
+)
+
+// HandlerName returns the name of the handler for the given feed
+// and format, as registered by Register.
+func HandlerName(f *Feed, format Format) string {
+	return "feeds-" + f.Name + "-" + format.String()
+}
+
+// Register adds handlers for serving the feed in all the supported
+// formats to the given app. The path parameter is the feed path without
+// the extension, which is added for each format (e.g. "/blog/feed" serves
+// /blog/feed.rss, /blog/feed.atom and /blog/feed.json). The handlers can
+// be reversed using the names returned by HandlerName.
+func Register(a *app.App, path string, f *Feed) {
+	if f.Name == "" {
+		panic(fmt.Errorf("can't register a feed without a name"))
+	}
+	for _, v := range Formats {
+		pattern := "^" + regexp.QuoteMeta(path+v.Extension()) + "$"
+		a.HandleOptions(pattern, Handler(f, v), &app.HandlerOptions{Name: HandlerName(f, v)})
+	}
+}
+
+// Handler returns an app.Handler which serves the feed in the given
+// format. Conditional requests are supported using both the ETag and
+// Last-Modified headers.
+func Handler(f *Feed, format Format) app.Handler {
+	return func(ctx *app.Context) {
+		items, err := f.Items(ctx, f.maxItems())
+		if err != nil {
+			panic(err)
+		}
+		for _, v := range items {
+			v.Link = absoluteURL(ctx, v.Link)
+			v.Image = absoluteURL(ctx, v.Image)
+			for _, e := range v.Enclosures {
+				e.URL = absoluteURL(ctx, e.URL)
+			}
+		}
+		self := ctx.URL()
+		self.RawQuery = ""
+		data, err := f.Render(format, items, absoluteURL(ctx, f.Link), self.String())
+		if err != nil {
+			panic(err)
+		}
+		h := sha1.Sum(data)
+		etag := `"` + hex.EncodeToString(h[:]) + `"`
+		header := ctx.Header()
+		header.Set("ETag", etag)
+		lastMod := lastModified(items)
+		if !lastMod.IsZero() {
+			header.Set("Last-Modified", lastMod.UTC().Format(http.TimeFormat))
+		}
+		if notModified(ctx.R, etag, lastMod) {
+			ctx.WriteHeader(http.StatusNotModified)
+			return
+		}
+		header.Set("Content-Type", format.ContentType()+"; charset=utf-8")
+		ctx.Write(data)
+	}
+}
+
+// notModified returns true iff the request conditions indicate
+// that the client already has the current version of the feed.
+// As RFC 7232 requires, If-Modified-Since is ignored when the
+// request includes If-None-Match.
+func notModified(r *http.Request, etag string, lastMod time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, v := range strings.Split(inm, ",") {
+			v = strings.TrimSpace(v)
+			if v == "*" || strings.TrimPrefix(v, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !lastMod.IsZero() {
+		t, err := http.ParseTime(ims)
+		return err == nil && !lastMod.Truncate(time.Second).After(t)
+	}
+	return false
+}
@@ -0,0 +1,374 @@
+package feeds
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+const (
+	atomNS    = "http://www.w3.org/2005/Atom"
+	mediaNS   = "http://search.yahoo.com/mrss/"
+	contentNS = "http://purl.org/rss/1.0/modules/content/"
+	jsonFeed  = "https://jsonfeed.org/version/1.1"
+)
+
+// Render renders the feed with the given items in the given format.
+// The link and self parameters are the absolute URLs for the site and
+// for the feed itself, respectively. Item links must already be absolute.
+func (f *Feed) Render(format Format, items []*Item, link string, self string) ([]byte, error) {
+	switch format {
+	case RSS:
+		return encodeXML(f.rss(items, link, self))
+	case Atom:
+		return encodeXML(f.atom(items, link, self))
+	case JSON:
+		return json.MarshalIndent(f.json(items, link, self), "", "  ")
+	}
+	return nil, fmt.Errorf("unknown feed format %s", format)
+}
+
+func encodeXML(data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// lastModified returns the most recent update time
+// for the given items.
+func lastModified(items []*Item) time.Time {
+	var t time.Time
+	for _, v := range items {
+		if u := v.updated(); u.After(t) {
+			t = u
+		}
+	}
+	return t
+}
+
+// RSS
+
+type rssFeed struct {
+	XMLName xml.Name    `xml:"rss"`
+	Version string      `xml:"version,attr"`
+	Atom    string      `xml:"xmlns:atom,attr"`
+	Media   string      `xml:"xmlns:media,attr"`
+	Content string      `xml:"xmlns:content,attr"`
+	Channel *rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string     `xml:"title"`
+	Link          string     `xml:"link"`
+	Description   string     `xml:"description"`
+	Language      string     `xml:"language,omitempty"`
+	LastBuildDate string     `xml:"lastBuildDate,omitempty"`
+	Self          *atomLink  `xml:"atom:link"`
+	Image         *rssImage  `xml:"image,omitempty"`
+	Items         []*rssItem `xml:"item"`
+}
+
+type rssImage struct {
+	URL   string `xml:"url"`
+	Title string `xml:"title"`
+	Link  string `xml:"link"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type rssMedia struct {
+	URL string `xml:"url,attr"`
+}
+
+type rssContent struct {
+	Value string `xml:",cdata"`
+}
+
+type rssItem struct {
+	Title       string          `xml:"title"`
+	Link        string          `xml:"link"`
+	GUID        *rssGUID        `xml:"guid"`
+	Description string          `xml:"description,omitempty"`
+	Content     *rssContent     `xml:"content:encoded,omitempty"`
+	Author      string          `xml:"author,omitempty"`
+	Categories  []string        `xml:"category"`
+	PubDate     string          `xml:"pubDate,omitempty"`
+	Enclosures  []*rssEnclosure `xml:"enclosure"`
+	Thumbnail   *rssMedia       `xml:"media:thumbnail,omitempty"`
+}
+
+func rssDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC1123Z)
+}
+
+func rssAuthor(p *Person) string {
+	// RSS requires an email, with the name in parenthesis
+	if p == nil || p.Email == "" {
+		return ""
+	}
+	if p.Name != "" {
+		return fmt.Sprintf("%s (%s)", p.Email, p.Name)
+	}
+	return p.Email
+}
+
+func (f *Feed) rss(items []*Item, link string, self string) *rssFeed {
+	ch := &rssChannel{
+		Title:         f.Title,
+		Link:          link,
+		Description:   f.Description,
+		Language:      f.Language,
+		LastBuildDate: rssDate(lastModified(items)),
+		Self:          &atomLink{Href: self, Rel: "self", Type: RSS.ContentType()},
+	}
+	if f.Image != "" {
+		ch.Image = &rssImage{URL: f.Image, Title: f.Title, Link: link}
+	}
+	for _, v := range items {
+		item := &rssItem{
+			Title:       v.Title,
+			Link:        v.Link,
+			GUID:        &rssGUID{IsPermaLink: v.Id == "" || v.Id == v.Link, Value: v.id()},
+			Description: v.Description,
+			Author:      rssAuthor(v.Author),
+			Categories:  v.Categories,
+			PubDate:     rssDate(v.Published),
+		}
+		if v.Content != "" {
+			item.Content = &rssContent{Value: v.Content}
+			if item.Description == "" {
+				item.Description = v.Content
+			}
+		}
+		for _, e := range v.Enclosures {
+			item.Enclosures = append(item.Enclosures, &rssEnclosure{URL: e.URL, Length: e.Length, Type: e.Type})
+		}
+		if v.Image != "" {
+			item.Thumbnail = &rssMedia{URL: v.Image}
+		}
+		ch.Items = append(ch.Items, item)
+	}
+	return &rssFeed{
+		Version: "2.0",
+		Atom:    atomNS,
+		Media:   mediaNS,
+		Content: contentNS,
+		Channel: ch,
+	}
+}
+
+// Atom
+
+type atomFeed struct {
+	XMLName  xml.Name     `xml:"feed"`
+	Xmlns    string       `xml:"xmlns,attr"`
+	Title    string       `xml:"title"`
+	Subtitle string       `xml:"subtitle,omitempty"`
+	Id       string       `xml:"id"`
+	Links    []*atomLink  `xml:"link"`
+	Updated  string       `xml:"updated"`
+	Author   *atomPerson  `xml:"author,omitempty"`
+	Logo     string       `xml:"logo,omitempty"`
+	Entries  []*atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href   string `xml:"href,attr"`
+	Rel    string `xml:"rel,attr,omitempty"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length int64  `xml:"length,attr,omitempty"`
+}
+
+type atomPerson struct {
+	Name  string `xml:"name"`
+	Email string `xml:"email,omitempty"`
+	URI   string `xml:"uri,omitempty"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomEntry struct {
+	Title      string          `xml:"title"`
+	Id         string          `xml:"id"`
+	Links      []*atomLink     `xml:"link"`
+	Updated    string          `xml:"updated"`
+	Published  string          `xml:"published,omitempty"`
+	Author     *atomPerson     `xml:"author,omitempty"`
+	Summary    *atomText       `xml:"summary,omitempty"`
+	Content    *atomText       `xml:"content,omitempty"`
+	Categories []*atomCategory `xml:"category"`
+}
+
+func atomDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func newAtomPerson(p *Person) *atomPerson {
+	if p == nil {
+		return nil
+	}
+	return &atomPerson{Name: p.Name, Email: p.Email, URI: p.URL}
+}
+
+func (f *Feed) atom(items []*Item, link string, self string) *atomFeed {
+	updated := lastModified(items)
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	feed := &atomFeed{
+		Xmlns:    atomNS,
+		Title:    f.Title,
+		Subtitle: f.Description,
+		Id:       self,
+		Links: []*atomLink{
+			{Href: link, Rel: "alternate"},
+			{Href: self, Rel: "self", Type: Atom.ContentType()},
+		},
+		Updated: atomDate(updated),
+		Author:  newAtomPerson(f.Author),
+		Logo:    f.Image,
+	}
+	for _, v := range items {
+		entry := &atomEntry{
+			Title:     v.Title,
+			Id:        v.id(),
+			Links:     []*atomLink{{Href: v.Link, Rel: "alternate"}},
+			Updated:   atomDate(v.updated()),
+			Published: atomDate(v.Published),
+			Author:    newAtomPerson(v.Author),
+		}
+		if v.Description != "" {
+			entry.Summary = &atomText{Type: "text", Value: v.Description}
+		}
+		if v.Content != "" {
+			entry.Content = &atomText{Type: "html", Value: v.Content}
+		}
+		for _, c := range v.Categories {
+			entry.Categories = append(entry.Categories, &atomCategory{Term: c})
+		}
+		for _, e := range v.Enclosures {
+			entry.Links = append(entry.Links, &atomLink{Href: e.URL, Rel: "enclosure", Type: e.Type, Length: e.Length})
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	return feed
+}
+
+// JSON Feed
+
+type jsonFeedData struct {
+	Version     string          `json:"version"`
+	Title       string          `json:"title"`
+	HomePageURL string          `json:"home_page_url,omitempty"`
+	FeedURL     string          `json:"feed_url,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Icon        string          `json:"icon,omitempty"`
+	Language    string          `json:"language,omitempty"`
+	Authors     []*jsonAuthor   `json:"authors,omitempty"`
+	Items       []*jsonFeedItem `json:"items"`
+}
+
+type jsonAuthor struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+type jsonAttachment struct {
+	URL      string  `json:"url"`
+	MimeType string  `json:"mime_type"`
+	Size     int64   `json:"size_in_bytes,omitempty"`
+	Duration float64 `json:"duration_in_seconds,omitempty"`
+}
+
+type jsonFeedItem struct {
+	Id            string            `json:"id"`
+	URL           string            `json:"url,omitempty"`
+	Title         string            `json:"title,omitempty"`
+	ContentHTML   string            `json:"content_html,omitempty"`
+	ContentText   string            `json:"content_text,omitempty"`
+	Summary       string            `json:"summary,omitempty"`
+	Image         string            `json:"image,omitempty"`
+	DatePublished string            `json:"date_published,omitempty"`
+	DateModified  string            `json:"date_modified,omitempty"`
+	Authors       []*jsonAuthor     `json:"authors,omitempty"`
+	Tags          []string          `json:"tags,omitempty"`
+	Attachments   []*jsonAttachment `json:"attachments,omitempty"`
+}
+
+func jsonAuthors(p *Person) []*jsonAuthor {
+	if p == nil {
+		return nil
+	}
+	return []*jsonAuthor{{Name: p.Name, URL: p.URL}}
+}
+
+func (f *Feed) json(items []*Item, link string, self string) *jsonFeedData {
+	feed := &jsonFeedData{
+		Version:     jsonFeed,
+		Title:       f.Title,
+		HomePageURL: link,
+		FeedURL:     self,
+		Description: f.Description,
+		Icon:        f.Image,
+		Language:    f.Language,
+		Authors:     jsonAuthors(f.Author),
+		Items:       []*jsonFeedItem{},
+	}
+	for _, v := range items {
+		item := &jsonFeedItem{
+			Id:            v.id(),
+			URL:           v.Link,
+			Title:         v.Title,
+			ContentHTML:   v.Content,
+			Summary:       v.Description,
+			Image:         v.Image,
+			DatePublished: atomDate(v.Published),
+			DateModified:  atomDate(v.Updated),
+			Authors:       jsonAuthors(v.Author),
+			Tags:          v.Categories,
+		}
+		if item.ContentHTML == "" {
+			// Items must have either content_html or content_text
+			item.ContentText = v.Description
+		}
+		for _, e := range v.Enclosures {
+			item.Attachments = append(item.Attachments, &jsonAttachment{
+				URL:      e.URL,
+				MimeType: e.Type,
+				Size:     e.Length,
+				Duration: e.Duration.Seconds(),
+			})
+		}
+		feed.Items = append(feed.Items, item)
+	}
+	return feed
+}
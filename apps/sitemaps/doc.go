@@ -0,0 +1,35 @@
+// Package sitemaps implements an app which serves sitemap.xml, generated
+// from the URLs returned by the registered providers.
+//
+// Apps add their URLs to the sitemap by registering a Provider, which receives
+// a function that must be called once for each URL. e.g.
+//
+//  sitemaps.Register("posts", func(ctx *app.Context, add func(u *sitemaps.URL)) error {
+//	iter := ctx.Orm().All().Iter()
+//	var post *Post
+//	for iter.Next(&post) {
+//		add(&sitemaps.URL{
+//			Loc:      ctx.MustReverse("post", post.Slug),
+//			LastMod:  post.Updated,
+//			Priority: 0.8,
+//		})
+//	}
+//	return iter.Err()
+//  })
+//
+// Then, the sitemaps app must be included at the root of the site:
+//
+//  App.Include("/", sitemaps.App, "")
+//
+// Sitemaps are cached for CacheTimeout seconds and served gzipped to the
+// clients which support it. When there are more than MaxURLs URLs, they're
+// split into several sitemaps (sitemap-1.xml, sitemap-2.xml...) and
+// sitemap.xml becomes a sitemap index.
+//
+// To notify search engines when the sitemap changes, set BaseURL and
+// PingURLs and schedule the ping task:
+//
+//  sitemaps.BaseURL = "https://www.example.com"
+//  sitemaps.PingURLs = []string{"https://search.example.com/ping?sitemap=%s"}
+//  sitemaps.SchedulePing(App, time.Hour)
+package sitemaps
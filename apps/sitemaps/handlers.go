@@ -0,0 +1,61 @@
+package sitemaps
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"strings"
+
+	"gnd.la/app"
+)
+
+const (
+	SitemapHandlerName     = "sitemaps-sitemap"
+	SitemapPageHandlerName = "sitemaps-sitemap-page"
+)
+
+var (
+	SitemapHandler     = app.NamedHandler(SitemapHandlerName, sitemapHandler)
+	SitemapPageHandler = app.NamedHandler(SitemapPageHandlerName, sitemapPageHandler)
+)
+
+func sitemapHandler(ctx *app.Context) {
+	serveSitemap(ctx, 0)
+}
+
+func sitemapPageHandler(ctx *app.Context) {
+	var page int
+	if !ctx.ParseIndexValue(0, &page) || page < 1 {
+		ctx.NotFound("")
+		return
+	}
+	serveSitemap(ctx, page)
+}
+
+func serveSitemap(ctx *app.Context, page int) {
+	data, err := sitemap(ctx, page)
+	if err != nil {
+		panic(err)
+	}
+	if data == nil {
+		ctx.NotFound("")
+		return
+	}
+	ctx.SetHeader("Content-Type", "application/xml; charset=utf-8")
+	ctx.Header().Add("Vary", "Accept-Encoding")
+	if strings.Contains(ctx.R.Header.Get("Accept-Encoding"), "gzip") {
+		ctx.SetHeader("Content-Encoding", "gzip")
+		ctx.Write(data)
+		return
+	}
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		panic(err)
+	}
+	defer r.Close()
+	plain, err := ioutil.ReadAll(r)
+	if err != nil {
+		panic(err)
+	}
+	ctx.Write(plain)
+}
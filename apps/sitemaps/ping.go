@@ -0,0 +1,98 @@
+package sitemaps
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/log"
+	"gnd.la/net/httpclient"
+	"gnd.la/tasks"
+)
+
+const (
+	pingHashKey = "gnd:la:sitemaps:ping-hash"
+)
+
+var (
+	// PingURLs are the URLs requested to notify search engines
+	// about changes in the sitemap. Each one must contain a %s,
+	// which is replaced by the escaped sitemap URL. It's empty
+	// by default, since Google and Bing have retired their ping
+	// endpoints. Search engines still discover sitemaps listed
+	// in robots.txt.
+	PingURLs []string
+)
+
+// Ping notifies the search engines in PingURLs about the
+// sitemap, regardless of whether it has changed. All the
+// URLs are requested, even if some of them fail. In that
+// case, the first error is returned.
+func Ping(ctx *app.Context) error {
+	if len(PingURLs) == 0 {
+		return nil
+	}
+	u, err := sitemapURL(ctx)
+	if err != nil {
+		return err
+	}
+	client := httpclient.New(ctx)
+	var first error
+	for _, v := range PingURLs {
+		pingURL := fmt.Sprintf(v, url.QueryEscape(u))
+		resp, err := client.Get(pingURL)
+		if err == nil {
+			resp.Close()
+			if !resp.IsOK() {
+				err = fmt.Errorf("error pinging %s: %s", pingURL, resp.Status)
+			}
+		}
+		if err != nil {
+			log.Warningf("error pinging search engine: %s", err)
+			if first == nil {
+				first = err
+			}
+		}
+	}
+	return first
+}
+
+// PingHandler is a task handler which invalidates the cached
+// sitemaps and pings the search engines if the sitemap has changed
+// since the last time it ran. See SchedulePing.
+func PingHandler(ctx *app.Context) {
+	Invalidate(ctx)
+	if len(PingURLs) == 0 {
+		return
+	}
+	h, err := hash(ctx)
+	if err != nil {
+		log.Errorf("error hashing sitemap: %s", err)
+		return
+	}
+	var prev string
+	ctx.Cache().Get(pingHashKey, &prev)
+	if prev == h {
+		return
+	}
+	// Don't ping the first time the task runs after starting the
+	// app, since we don't know if the sitemap has changed.
+	if prev != "" {
+		log.Debugf("sitemap changed, pinging search engines")
+		if err := Ping(ctx); err != nil {
+			// Errors are already logged by Ping. Don't
+			// store the hash, so the ping is retried the
+			// next time the task runs.
+			return
+		}
+	}
+	ctx.Cache().Set(pingHashKey, h, 0)
+}
+
+// SchedulePing schedules PingHandler to run at the given interval
+// in the given app. Note that BaseURL must be set, since there's no
+// request while running the task.
+func SchedulePing(a *app.App, interval time.Duration) *tasks.Task {
+	return tasks.Schedule(a, PingHandler, &tasks.Options{Name: "sitemaps-ping", MaxInstances: 1}, interval, true)
+}
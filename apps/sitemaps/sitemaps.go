@@ -0,0 +1,313 @@
+package sitemaps
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// MaxURLs is the maximum number of URLs in a sitemap. When
+	// there are more URLs, they're split into several sitemaps
+	// and sitemap.xml becomes a sitemap index.
+	MaxURLs = 50000
+
+	xmlns = "http://www.sitemaps.org/schemas/sitemap/0.9"
+)
+
+// Change frequencies for URL.ChangeFreq.
+const (
+	Always  = "always"
+	Hourly  = "hourly"
+	Daily   = "daily"
+	Weekly  = "weekly"
+	Monthly = "monthly"
+	Yearly  = "yearly"
+	Never   = "never"
+)
+
+var (
+	// BaseURL is the scheme and host used for generating absolute
+	// URLs when there's no request (e.g. when pinging search engines
+	// from a task), like "https://www.example.com". If empty, the
+	// URLs are generated from the current request.
+	BaseURL = ""
+	// CacheTimeout is the number of seconds the generated
+	// sitemaps are cached.
+	CacheTimeout = 3600
+
+	errNoBaseURL = errors.New("sitemaps.BaseURL must be set for generating sitemaps without a request")
+
+	providers struct {
+		sync.RWMutex
+		names     []string
+		providers []Provider
+	}
+)
+
+// URL represents an URL in the sitemap. Only Loc is required.
+type URL struct {
+	// Loc is the URL location. If it's not absolute,
+	// it's made absolute using the current request or
+	// BaseURL.
+	Loc string
+	// LastMod is the time the URL was last modified.
+	LastMod time.Time
+	// ChangeFreq is the expected change frequency. Use
+	// one of the constants defined in this package.
+	ChangeFreq string
+	// Priority is the URL priority relative to the other
+	// URLs in the site, between 0 and 1. If zero, it's omitted.
+	Priority float64
+}
+
+// Provider is a function which generates the URLs for a sitemap,
+// by calling add once for each URL. Returning an error aborts the
+// sitemap generation.
+type Provider func(ctx *app.Context, add func(u *URL)) error
+
+// Register adds a Provider with the given name. Providers are
+// called in the same order they were registered. Registering
+// two providers with the same name will panic.
+func Register(name string, p Provider) {
+	providers.Lock()
+	defer providers.Unlock()
+	for _, v := range providers.names {
+		if v == name {
+			panic(fmt.Errorf("duplicate sitemap provider %q", name))
+		}
+	}
+	providers.names = append(providers.names, name)
+	providers.providers = append(providers.providers, p)
+}
+
+// URLs returns all the URLs generated by the registered
+// providers, with their Loc already made absolute.
+func URLs(ctx *app.Context) ([]*URL, error) {
+	base, err := baseURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	providers.RLock()
+	ps := append([]Provider(nil), providers.providers...)
+	providers.RUnlock()
+	var urls []*URL
+	add := func(u *URL) {
+		if u.Loc != "" && !strings.Contains(u.Loc, "://") {
+			u.Loc = base + "/" + strings.TrimPrefix(u.Loc, "/")
+		}
+		urls = append(urls, u)
+	}
+	for _, p := range ps {
+		if err := p(ctx, add); err != nil {
+			return nil, err
+		}
+	}
+	return urls, nil
+}
+
+func baseURL(ctx *app.Context) (string, error) {
+	if BaseURL != "" {
+		return strings.TrimSuffix(BaseURL, "/"), nil
+	}
+	if u := ctx.URL(); u != nil {
+		return u.Scheme + "://" + u.Host, nil
+	}
+	return "", errNoBaseURL
+}
+
+// sitemapURL returns the absolute URL for sitemap.xml.
+func sitemapURL(ctx *app.Context) (string, error) {
+	base, err := baseURL(ctx)
+	if err != nil {
+		return "", err
+	}
+	return base + ctx.MustReverse(SitemapHandlerName), nil
+}
+
+type xmlURL struct {
+	Loc        string `xml:"loc"`
+	LastMod    string `xml:"lastmod,omitempty"`
+	ChangeFreq string `xml:"changefreq,omitempty"`
+	Priority   string `xml:"priority,omitempty"`
+}
+
+type xmlURLSet struct {
+	XMLName xml.Name  `xml:"urlset"`
+	Xmlns   string    `xml:"xmlns,attr"`
+	URLs    []*xmlURL `xml:"url"`
+}
+
+type xmlSitemap struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type xmlSitemapIndex struct {
+	XMLName  xml.Name      `xml:"sitemapindex"`
+	Xmlns    string        `xml:"xmlns,attr"`
+	Sitemaps []*xmlSitemap `xml:"sitemap"`
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func encodeXML(data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	if err := xml.NewEncoder(&buf).Encode(data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// sitemaps generates the sitemap.xml contents followed by the
+// contents for each page when there are more than MaxURLs.
+func sitemaps(ctx *app.Context) ([][]byte, error) {
+	urls, err := URLs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pages [][]byte
+	var index xmlSitemapIndex
+	for ii := 0; ii < len(urls) || ii == 0; ii += MaxURLs {
+		end := ii + MaxURLs
+		if end > len(urls) {
+			end = len(urls)
+		}
+		set := &xmlURLSet{Xmlns: xmlns}
+		var lastMod time.Time
+		for _, v := range urls[ii:end] {
+			u := &xmlURL{
+				Loc:        v.Loc,
+				LastMod:    formatTime(v.LastMod),
+				ChangeFreq: v.ChangeFreq,
+			}
+			if v.Priority > 0 {
+				u.Priority = strconv.FormatFloat(v.Priority, 'f', 1, 64)
+			}
+			set.URLs = append(set.URLs, u)
+			if v.LastMod.After(lastMod) {
+				lastMod = v.LastMod
+			}
+		}
+		data, err := encodeXML(set)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, data)
+		if len(urls) > MaxURLs {
+			base, err := baseURL(ctx)
+			if err != nil {
+				return nil, err
+			}
+			index.Sitemaps = append(index.Sitemaps, &xmlSitemap{
+				Loc:     base + ctx.MustReverse(SitemapPageHandlerName, len(pages)),
+				LastMod: formatTime(lastMod),
+			})
+		}
+	}
+	if len(pages) == 1 {
+		return pages, nil
+	}
+	index.Xmlns = xmlns
+	data, err := encodeXML(&index)
+	if err != nil {
+		return nil, err
+	}
+	return append([][]byte{data}, pages...), nil
+}
+
+func cachePrefix(ctx *app.Context) string {
+	// Include the base URL, since it's used to generate the sitemaps
+	base, _ := baseURL(ctx)
+	return "gnd:la:sitemaps:" + url.QueryEscape(base) + ":"
+}
+
+// cacheVersion returns the version included in the cache keys for
+// the sitemap pages. Invalidate changes it, so all the previously
+// cached pages are ignored, even if some of them have been evicted.
+func cacheVersion(ctx *app.Context) string {
+	key := cachePrefix(ctx) + "version"
+	if data, err := ctx.Cache().GetBytes(key); err == nil && len(data) > 0 {
+		return string(data)
+	}
+	return newCacheVersion(ctx)
+}
+
+func newCacheVersion(ctx *app.Context) string {
+	version := stringutil.Random(8)
+	ctx.Cache().SetBytes(cachePrefix(ctx)+"version", []byte(version), 0)
+	return version
+}
+
+func cacheKey(ctx *app.Context, version string, page int) string {
+	return cachePrefix(ctx) + version + ":" + strconv.Itoa(page)
+}
+
+// sitemap returns the given sitemap page, gzipped. Page 0 is
+// sitemap.xml, either the sitemap itself or the sitemap index.
+// If there's no such page, nil is returned.
+func sitemap(ctx *app.Context, page int) ([]byte, error) {
+	c := ctx.Cache()
+	version := cacheVersion(ctx)
+	if data, err := c.GetBytes(cacheKey(ctx, version, page)); err == nil && len(data) > 0 {
+		return data, nil
+	}
+	pages, err := sitemaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var data []byte
+	for ii, v := range pages {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(v); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		c.SetBytes(cacheKey(ctx, version, ii), buf.Bytes(), CacheTimeout)
+		if ii == page {
+			data = buf.Bytes()
+		}
+	}
+	return data, nil
+}
+
+// Invalidate discards the cached sitemaps, so they're generated
+// again on the next request.
+func Invalidate(ctx *app.Context) {
+	newCacheVersion(ctx)
+}
+
+// hash returns a hash of the current sitemap URLs, used to
+// detect changes when pinging search engines.
+func hash(ctx *app.Context) (string, error) {
+	pages, err := sitemaps(ctx)
+	if err != nil {
+		return "", err
+	}
+	h := sha1.New()
+	for _, v := range pages {
+		h.Write(v)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,164 @@
+package sitemaps
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/config"
+)
+
+const testBaseURL = "http://www.example.com"
+
+// testURLCount is the number of URLs returned
+// by the test provider.
+var testURLCount int
+
+func TestMain(m *testing.M) {
+	Register("test", func(ctx *app.Context, add func(u *URL)) error {
+		for ii := 0; ii < testURLCount; ii++ {
+			add(&URL{Loc: "/page/" + strconv.Itoa(ii), Priority: 0.5})
+		}
+		return nil
+	})
+	BaseURL = testBaseURL
+	App.Logger = nil
+	App.Config().Cache = config.MustParseURL("memory://")
+	os.Exit(m.Run())
+}
+
+// setURLCount changes the number of URLs in the sitemap,
+// invalidating the cached ones.
+func setURLCount(n int) {
+	testURLCount = n
+	ctx := App.NewContext(nil)
+	defer App.CloseContext(ctx)
+	Invalidate(ctx)
+}
+
+func getSitemap(path string, gzipped bool) *httptest.ResponseRecorder {
+	r, _ := http.NewRequest("GET", testBaseURL+path, nil)
+	if gzipped {
+		r.Header.Set("Accept-Encoding", "gzip, deflate")
+	}
+	w := httptest.NewRecorder()
+	App.ServeHTTP(w, r)
+	return w
+}
+
+func decodeURLSet(t *testing.T, data []byte) *xmlURLSet {
+	var set xmlURLSet
+	if err := xml.Unmarshal(data, &set); err != nil {
+		t.Fatalf("error decoding sitemap: %s\n%s", err, data)
+	}
+	return &set
+}
+
+func TestSitemap(t *testing.T) {
+	setURLCount(2)
+	w := getSitemap("/sitemap.xml", false)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expecting code 200, got %d", w.Code)
+	}
+	if ce := w.Header().Get("Content-Encoding"); ce != "" {
+		t.Errorf("expecting no Content-Encoding without Accept-Encoding, got %q", ce)
+	}
+	set := decodeURLSet(t, w.Body.Bytes())
+	if len(set.URLs) != 2 {
+		t.Fatalf("expecting 2 URLs, got %d", len(set.URLs))
+	}
+	if u := set.URLs[1]; u.Loc != testBaseURL+"/page/1" || u.Priority != "0.5" {
+		t.Errorf("unexpected URL %+v", u)
+	}
+	// No pages when there's a single sitemap
+	if w := getSitemap("/sitemap-1.xml", false); w.Code != http.StatusNotFound {
+		t.Errorf("expecting code 404 for sitemap page, got %d", w.Code)
+	}
+}
+
+func TestSitemapIndex(t *testing.T) {
+	setURLCount(MaxURLs + 1)
+	defer setURLCount(0)
+	w := getSitemap("/sitemap.xml", false)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expecting code 200, got %d", w.Code)
+	}
+	var index xmlSitemapIndex
+	if err := xml.Unmarshal(w.Body.Bytes(), &index); err != nil {
+		t.Fatalf("error decoding sitemap index: %s", err)
+	}
+	if len(index.Sitemaps) != 2 {
+		t.Fatalf("expecting 2 sitemaps in the index, got %d", len(index.Sitemaps))
+	}
+	for ii, v := range index.Sitemaps {
+		if expect := testBaseURL + "/sitemap-" + strconv.Itoa(ii+1) + ".xml"; v.Loc != expect {
+			t.Errorf("expecting sitemap %d at %s, got %s", ii, expect, v.Loc)
+		}
+	}
+	for ii, count := range []int{MaxURLs, 1} {
+		w := getSitemap("/sitemap-"+strconv.Itoa(ii+1)+".xml", false)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expecting code 200 for sitemap %d, got %d", ii+1, w.Code)
+		}
+		if set := decodeURLSet(t, w.Body.Bytes()); len(set.URLs) != count {
+			t.Errorf("expecting %d URLs in sitemap %d, got %d", count, ii+1, len(set.URLs))
+		}
+	}
+	if w := getSitemap("/sitemap-3.xml", false); w.Code != http.StatusNotFound {
+		t.Errorf("expecting code 404 for missing sitemap page, got %d", w.Code)
+	}
+}
+
+func TestSitemapGzip(t *testing.T) {
+	setURLCount(3)
+	plain := getSitemap("/sitemap.xml", false).Body.Bytes()
+	w := getSitemap("/sitemap.xml", true)
+	if ce := w.Header().Get("Content-Encoding"); ce != "gzip" {
+		t.Fatalf("expecting Content-Encoding gzip, got %q", ce)
+	}
+	if vary := w.Header().Get("Vary"); vary != "Accept-Encoding" {
+		t.Errorf("expecting Vary: Accept-Encoding, got %q", vary)
+	}
+	r, err := gzip.NewReader(w.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, plain) {
+		t.Errorf("gzipped sitemap does not match the plain one:\n%s\n%s", data, plain)
+	}
+	if set := decodeURLSet(t, data); len(set.URLs) != 3 {
+		t.Errorf("expecting 3 URLs, got %d", len(set.URLs))
+	}
+}
+
+func TestInvalidateEvicted(t *testing.T) {
+	setURLCount(MaxURLs + 1)
+	defer setURLCount(0)
+	if w := getSitemap("/sitemap.xml", false); w.Code != http.StatusOK {
+		t.Fatalf("expecting code 200, got %d", w.Code)
+	}
+	// Evict the index from the cache, the pages must
+	// still be invalidated.
+	ctx := App.NewContext(nil)
+	ctx.Cache().Delete(cacheKey(ctx, cacheVersion(ctx), 0))
+	App.CloseContext(ctx)
+	setURLCount(MaxURLs + 2)
+	w := getSitemap("/sitemap-2.xml", false)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expecting code 200, got %d", w.Code)
+	}
+	if set := decodeURLSet(t, w.Body.Bytes()); len(set.URLs) != 2 {
+		t.Errorf("expecting 2 URLs after invalidating, got %d", len(set.URLs))
+	}
+}
@@ -379,6 +379,9 @@
                         container.fadeIn();
                     }
                     button.prop('disabled', false);
+                } else if (data.redirect) {
+                    // Sign in requires another step
+                    window.location = data.redirect;
                 } else {
                     ns._onSignedIn(data);
                 }
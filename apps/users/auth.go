@@ -0,0 +1,212 @@
+package users
+
+import (
+	"reflect"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/crypto/password"
+	"gnd.la/i18n"
+	"gnd.la/log"
+	"gnd.la/orm"
+	"gnd.la/orm/driver"
+)
+
+var (
+	ErrAccountLocked = i18n.NewError("too many failed sign in attempts, try again later")
+)
+
+// AuthBackend is the interface implemented by the authentication
+// backends used for signing in users with a username (or email)
+// and a password. Backends are tried in the order they appear in
+// AuthBackends, until one of them returns a user or an error
+// other than ErrNoUser.
+type AuthBackend interface {
+	// Authenticate verifies the given credentials. If the backend
+	// does not know about the user, it must return ErrNoUser. If the
+	// user exists, it must be returned even when the password does
+	// not match, as a pointer to the type set with SetType, together
+	// with ErrInvalidPassword or ErrNoPassword. This allows counting
+	// failed attempts for locking out the account.
+	Authenticate(ctx *app.Context, username string, plain string) (interface{}, error)
+}
+
+// DatabaseBackend authenticates users against the passwords stored
+// in the database. Passwords are checked with the algorithm they were
+// encoded with and, after a successful sign in, they're transparently
+// encoded again using PasswordHasher if they need it.
+type DatabaseBackend struct{}
+
+// Authenticate implements the AuthBackend interface.
+func (b DatabaseBackend) Authenticate(ctx *app.Context, username string, plain string) (interface{}, error) {
+	user, ok := findUser(ctx, username)
+	if !ok {
+		return nil, ErrNoUser
+	}
+	userVal := reflect.ValueOf(user)
+	pw := getUserValue(userVal, "Password").(password.Password)
+	if !pw.IsValid() {
+		return user, ErrNoPassword
+	}
+	if pw.Check(plain) != nil {
+		return user, ErrInvalidPassword
+	}
+	if pw.NeedsRehash(PasswordHasher) {
+		if p, err := newPassword(plain); err == nil {
+			setUserValue(userVal, "Password", p)
+			ctx.Orm().MustSave(user)
+		} else {
+			log.Errorf("error encoding password for user %v: %s", username, err)
+		}
+	}
+	return user, nil
+}
+
+// ExternalUser contains the information about a user
+// authenticated by an ExternalBackend.
+type ExternalUser struct {
+	// Username is the username of the user in the local database.
+	// If empty, the username passed to Verify is used.
+	Username string
+	// Email is used for finding the local user when there's none
+	// with the given Username, as well as for new users.
+	Email string
+}
+
+// ExternalBackend authenticates users against an external service
+// (e.g. an LDAP directory or an OAuth provider supporting the password
+// grant) and links them to a local user. The actual verification is
+// performed by Verify, which should return ErrNoUser if the user is
+// not known by the service and ErrInvalidPassword if the password
+// does not match.
+//
+// The local user is looked up using the identity returned by Verify,
+// first by its username and then by its email. If it's not in the
+// database, a new one is created when Create is true. Local users
+// created this way have no password, so they can't sign in using
+// DatabaseBackend. Since the local user is not known until Verify
+// succeeds, failed attempts with an ExternalBackend don't count
+// towards MaxFailedSignIns and the external service should
+// implement its own limits.
+type ExternalBackend struct {
+	Verify func(ctx *app.Context, username string, plain string) (*ExternalUser, error)
+	Create bool
+}
+
+// Authenticate implements the AuthBackend interface.
+func (b *ExternalBackend) Authenticate(ctx *app.Context, username string, plain string) (interface{}, error) {
+	ext, err := b.Verify(ctx, username, plain)
+	if err != nil {
+		return nil, err
+	}
+	if ext.Username == "" {
+		ext.Username = username
+	}
+	local, found := findUserBy(ctx, "User.NormalizedUsername", ext.Username)
+	if !found && ext.Email != "" {
+		local, found = findUserBy(ctx, "User.NormalizedEmail", ext.Email)
+	}
+	if !found {
+		if !b.Create {
+			return nil, ErrNoUser
+		}
+		userVal := newUser(FindFreeUsername(ctx, ext.Username))
+		setUserValue(userVal, "Email", ext.Email)
+		ctx.Orm().MustInsert(userVal.Interface())
+		return userVal.Interface(), nil
+	}
+	return local, nil
+}
+
+// findUser returns the user with the given username or email.
+func findUser(ctx *app.Context, username string) (interface{}, bool) {
+	norm := Normalize(username)
+	_, userVal := newEmptyUser()
+	var ok bool
+	o := ctx.Orm()
+	q1 := orm.Eq("User.NormalizedUsername", norm)
+	q2 := orm.Eq("User.NormalizedEmail", norm)
+	if o.Driver().Capabilities()&driver.CAP_OR != 0 {
+		ok = o.MustOne(orm.Or(q1, q2), userVal)
+	} else {
+		ok = o.MustOne(q1, userVal)
+		if !ok {
+			ok = o.MustOne(q2, userVal)
+		}
+	}
+	return userVal, ok
+}
+
+// findUserBy returns the user whose field matches
+// the normalized value.
+func findUserBy(ctx *app.Context, field string, value string) (interface{}, bool) {
+	_, userVal := newEmptyUser()
+	ok := ctx.Orm().MustOne(orm.Eq(field, Normalize(value)), userVal)
+	return userVal, ok
+}
+
+// Authenticate tries the backends in AuthBackends in order, returning
+// the first authenticated user. It also implements the account lockout
+// enabled by MaxFailedSignIns, returning ErrAccountLocked while the user
+// is locked out, even if the password matches.
+func Authenticate(ctx *app.Context, username string, plain string) (interface{}, error) {
+	for _, b := range AuthBackends {
+		user, err := b.Authenticate(ctx, username, plain)
+		if user == nil {
+			if err == ErrNoUser {
+				continue
+			}
+			return nil, err
+		}
+		return user, checkLockout(ctx, reflect.ValueOf(user), err)
+	}
+	return nil, ErrNoUser
+}
+
+// checkLockout updates the failed sign in count for the given
+// user, returning ErrAccountLocked if the account is locked.
+// Otherwise, err is returned. Both invalid passwords and invalid
+// two factor authentication codes count as failed attempts.
+func checkLockout(ctx *app.Context, user reflect.Value, err error) error {
+	if MaxFailedSignIns <= 0 {
+		return err
+	}
+	now := time.Now().UTC()
+	if until := getUserValue(user, "LockedUntil").(time.Time); now.Before(until) {
+		return ErrAccountLocked
+	}
+	failed := getUserValue(user, "FailedSignIns").(int)
+	if err == nil {
+		if failed > 0 {
+			setUserValue(user, "FailedSignIns", 0)
+			setUserValue(user, "LockedUntil", time.Time{})
+			ctx.Orm().MustSave(user.Interface())
+		}
+		return nil
+	}
+	if err != ErrInvalidPassword && err != ErrInvalidCode {
+		return err
+	}
+	failed++
+	if failed >= MaxFailedSignIns {
+		setUserValue(user, "LockedUntil", now.Add(LockoutDuration))
+		failed = 0
+		err = ErrAccountLocked
+	}
+	setUserValue(user, "FailedSignIns", failed)
+	ctx.Orm().MustSave(user.Interface())
+	return err
+}
+
+// newPassword returns plain encoded using PasswordHasher.
+func newPassword(plain string) (password.Password, error) {
+	return password.NewHasher(plain, PasswordHasher)
+}
+
+func mustNewPassword(plain string) password.Password {
+	p, err := newPassword(plain)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
@@ -3,11 +3,14 @@ package users
 import (
 	"fmt"
 	"os"
+	"reflect"
+	"strings"
 	"text/tabwriter"
 
 	"gnd.la/app"
 	"gnd.la/commands"
-	"gnd.la/crypto/password"
+	"gnd.la/orm"
+	"gnd.la/orm/query"
 
 	"github.com/bgentry/speakeasy"
 )
@@ -24,21 +27,10 @@ func registerUser(ctx *app.Context) {
 		// Creating a new one
 		userVal = newUser(username)
 	}
-	var askPassword bool
-	ctx.ParseParamValue("p", &askPassword)
-	if !updating || askPassword {
-		password1, err := speakeasy.Ask("Password:")
-		if err != nil {
-			panic(err)
-		}
-		password2, err := speakeasy.Ask("Confirm Password:")
-		if err != nil {
-			panic(err)
-		}
-		if password1 != password2 {
-			panic(fmt.Errorf("passwords don't match"))
-		}
-		setUserValue(userVal, "Password", password.New(password1))
+	var changePassword bool
+	ctx.ParseParamValue("p", &changePassword)
+	if !updating || changePassword {
+		setUserValue(userVal, "Password", mustNewPassword(askPassword(ctx)))
 	}
 	var admin bool
 	ctx.ParseParamValue("s", &admin)
@@ -54,15 +46,143 @@ func registerUser(ctx *app.Context) {
 	ctx.Logger().Infof("saved user as %+v", userVal.Interface())
 }
 
+// readPassword prompts for a password without echoing it.
+var readPassword = speakeasy.Ask
+
+// askPassword returns the password provided in the "password"
+// flag or, if it's empty, prompts for it twice.
+func askPassword(ctx *app.Context) string {
+	var password string
+	ctx.ParseParamValue("password", &password)
+	if password != "" {
+		return password
+	}
+	password1, err := readPassword("Password:")
+	if err != nil {
+		panic(err)
+	}
+	password2, err := readPassword("Confirm Password:")
+	if err != nil {
+		panic(err)
+	}
+	if password1 != password2 {
+		panic(fmt.Errorf("passwords don't match"))
+	}
+	return password1
+}
+
+// mustFindUser returns the user with the given username,
+// panicking if it doesn't exist.
+func mustFindUser(ctx *app.Context, username string) reflect.Value {
+	userVal, ptr := newEmptyUser()
+	if !ctx.Orm().MustOne(ByUsername(username), ptr) {
+		panic(fmt.Errorf("user %q does not exist", username))
+	}
+	return userVal
+}
+
+func createUser(ctx *app.Context) {
+	checkUserType(userType)
+	username := ctx.RequireIndexValue(0)
+	if _, ptr := newEmptyUser(); ctx.Orm().MustOne(ByUsername(username), ptr) {
+		panic(fmt.Errorf("user %q already exists", username))
+	}
+	userVal := newUser(username)
+	var email string
+	ctx.ParseParamValue("email", &email)
+	if email != "" {
+		setUserValue(userVal, "Email", email)
+	}
+	var admin bool
+	ctx.ParseParamValue("admin", &admin)
+	setUserValue(userVal, "Admin", admin)
+	setUserValue(userVal, "Password", mustNewPassword(askPassword(ctx)))
+	ctx.Orm().MustSave(userVal.Interface())
+	fmt.Printf("created user %s (id %d)\n", username, asGondolaUser(userVal).Id())
+}
+
+func setPassword(ctx *app.Context) {
+	checkUserType(userType)
+	username := ctx.RequireIndexValue(0)
+	userVal := mustFindUser(ctx, username)
+	setUserValue(userVal, "Password", mustNewPassword(askPassword(ctx)))
+	ctx.Orm().MustSave(userVal.Interface())
+	fmt.Printf("changed password for user %s\n", username)
+}
+
+func grantRole(ctx *app.Context) {
+	checkUserType(userType)
+	username := ctx.RequireIndexValue(0)
+	role := ctx.RequireIndexValue(1)
+	user := mustFindUser(ctx, username).Interface()
+	var revoke bool
+	ctx.ParseParamValue("revoke", &revoke)
+	if revoke {
+		if err := RemoveRole(ctx, user, role); err != nil {
+			panic(err)
+		}
+		fmt.Printf("removed role %s from user %s\n", role, username)
+		return
+	}
+	if err := AddRole(ctx, user, role); err != nil {
+		panic(fmt.Errorf("error granting role %q: %s", role, err))
+	}
+	fmt.Printf("granted role %s to user %s\n", role, username)
+}
+
 func listUsers(ctx *app.Context) {
 	checkUserType(userType)
+	var conditions []query.Q
+	var admins bool
+	ctx.ParseParamValue("admin", &admins)
+	if admins {
+		conditions = append(conditions, orm.Eq("User.Admin", true))
+	}
+	var search string
+	ctx.ParseParamValue("q", &search)
+	if search = Normalize(search); search != "" {
+		conditions = append(conditions, orm.Or(
+			orm.Contains("User.NormalizedUsername", search),
+			orm.Contains("User.NormalizedEmail", search),
+		))
+	}
+	var roleName string
+	ctx.ParseParamValue("role", &roleName)
+	if roleName != "" {
+		role, err := FindRole(ctx, roleName)
+		if err != nil {
+			panic(fmt.Errorf("error finding role %q: %s", roleName, err))
+		}
+		var userRoles []*UserRole
+		ctx.Orm().Query(orm.Eq("RoleId", role.Id)).MustAll(&userRoles)
+		ids := make([]int64, len(userRoles))
+		for ii, v := range userRoles {
+			ids[ii] = v.UserId
+		}
+		if len(ids) == 0 {
+			// No users with the role
+			ids = append(ids, -1)
+		}
+		conditions = append(conditions, orm.In("User.UserId", ids))
+	}
+	var q query.Q
+	if len(conditions) > 0 {
+		q = orm.And(conditions...)
+	}
 	userVal, ptr := newEmptyUser()
-	iter := ctx.Orm().All().Iter()
+	iter := ctx.Orm().Query(q).Sort("User.UserId", orm.ASC).Iter()
 	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, '\t', tabwriter.Debug)
-	fmt.Fprint(w, "ID\tUsername\tEmail\tAdmin?\n")
+	fmt.Fprint(w, "ID\tUsername\tEmail\tAdmin?\tRoles\n")
 	for iter.Next(ptr) {
 		val := userVal.Elem().FieldByName("User").Interface().(User)
-		fmt.Fprintf(w, "%d\t%s\t%s\t%v\n", val.UserId, val.Username, val.Email, val.Admin)
+		var roles []string
+		for _, r := range Roles(ctx, ptr) {
+			roles = append(roles, r.Name)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%v\t%s\n", val.UserId, val.Username, val.Email, val.Admin, strings.Join(roles, ", "))
+	}
+	if err := iter.Err(); err != nil {
+		panic(err)
 	}
 	if err := w.Flush(); err != nil {
 		panic(err)
@@ -79,7 +199,36 @@ func init() {
 			commands.StringFlag("e", "", "Email for the created user"),
 		),
 	})
+	commands.Register(createUser, &commands.Options{
+		Usage: "[-admin] [-email email] [-password password] <username>",
+		Help:  "Creates a new user, prompting for its password if it's not provided",
+		Flags: commands.Flags(
+			commands.BoolFlag("admin", false, "Make the user an admin"),
+			commands.StringFlag("email", "", "Email for the created user"),
+			commands.StringFlag("password", "", "Password for the created user. If empty, it's read from the terminal"),
+		),
+	})
+	commands.Register(setPassword, &commands.Options{
+		Usage: "[-password password] <username>",
+		Help:  "Changes the password of a user, prompting for it if it's not provided",
+		Flags: commands.Flags(
+			commands.StringFlag("password", "", "New password for the user. If empty, it's read from the terminal"),
+		),
+	})
+	commands.Register(grantRole, &commands.Options{
+		Usage: "[-revoke] <username> <role>",
+		Help:  "Assigns a role to a user",
+		Flags: commands.Flags(
+			commands.BoolFlag("revoke", false, "Remove the role from the user rather than assigning it"),
+		),
+	})
 	commands.Register(listUsers, &commands.Options{
-		Help: "List all registered users",
+		Usage: "[-admin] [-role role] [-q text]",
+		Help:  "List the registered users, optionally filtered",
+		Flags: commands.Flags(
+			commands.BoolFlag("admin", false, "List only admin users"),
+			commands.StringFlag("role", "", "List only the users with the given role"),
+			commands.StringFlag("q", "", "List only the users whose username or email contain the given text"),
+		),
 	})
 }
@@ -0,0 +1,202 @@
+package users
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/config"
+	"gnd.la/orm"
+	_ "gnd.la/orm/driver/sqlite"
+)
+
+type testUser struct {
+	User
+}
+
+func init() {
+	orm.Register(&testUser{}, nil)
+}
+
+type commandProvider struct {
+	args   []string
+	params map[string]string
+}
+
+func (c *commandProvider) Count() int {
+	return len(c.args)
+}
+
+func (c *commandProvider) Arg(idx int) string {
+	if idx < len(c.args) {
+		return c.args[idx]
+	}
+	return ""
+}
+
+func (c *commandProvider) Param(name string) string {
+	return c.params[name]
+}
+
+func (c *commandProvider) Params() []string {
+	var names []string
+	for k := range c.params {
+		names = append(names, k)
+	}
+	return names
+}
+
+// commandsApp is shared by all the tests, since the
+// models can only be registered once per process.
+var commandsApp *app.App
+
+func TestMain(m *testing.M) {
+	SetType(&testUser{})
+	dir, err := ioutil.TempDir("", "users-commands")
+	if err != nil {
+		panic(err)
+	}
+	commandsApp = app.New()
+	commandsApp.Config().Database = config.MustParseURL("sqlite://" + filepath.Join(dir, "users.db"))
+	// Use a real cache, so the permissions are cached
+	commandsApp.Config().Cache = config.MustParseURL("memory://")
+	// Required for signing and encrypting tokens
+	commandsApp.Config().Secret = strings.Repeat("s", 32)
+	commandsApp.Config().EncryptionKey = strings.Repeat("k", 32)
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func runCommand(handler app.Handler, params map[string]string, args ...string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	ctx := commandsApp.NewContext(&commandProvider{args: args, params: params})
+	defer commandsApp.CloseContext(ctx)
+	handler(ctx)
+	return nil
+}
+
+func findTestUser(t *testing.T, username string) *testUser {
+	ctx := commandsApp.NewContext(nil)
+	defer commandsApp.CloseContext(ctx)
+	var user *testUser
+	if !ctx.Orm().MustOne(ByUsername(username), &user) {
+		t.Fatalf("user %s does not exist", username)
+	}
+	return user
+}
+
+func withPasswords(passwords ...string) func() {
+	prev := readPassword
+	readPassword = func(prompt string) (string, error) {
+		if len(passwords) == 0 {
+			return "", fmt.Errorf("unexpected prompt %q", prompt)
+		}
+		p := passwords[0]
+		passwords = passwords[1:]
+		return p, nil
+	}
+	return func() {
+		readPassword = prev
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	params := map[string]string{"email": "foo@example.com", "admin": "true", "password": "secret1"}
+	if err := runCommand(createUser, params, "create1"); err != nil {
+		t.Fatal(err)
+	}
+	user := findTestUser(t, "create1")
+	if user.Email != "foo@example.com" || !user.Admin || !user.Password.Matches("secret1") {
+		t.Errorf("unexpected created user %+v", user)
+	}
+	if err := runCommand(createUser, params, "create1"); err == nil {
+		t.Error("expecting an error when creating an existing user")
+	}
+	defer withPasswords("secret2", "secret2")()
+	if err := runCommand(createUser, nil, "create2"); err != nil {
+		t.Fatal(err)
+	}
+	if user := findTestUser(t, "create2"); user.Admin || !user.Password.Matches("secret2") {
+		t.Errorf("unexpected created user %+v", user)
+	}
+}
+
+func TestSetPassword(t *testing.T) {
+	if err := runCommand(createUser, map[string]string{"password": "secret1"}, "setpass"); err != nil {
+		t.Fatal(err)
+	}
+	if err := runCommand(setPassword, map[string]string{"password": "secret2"}, "setpass"); err != nil {
+		t.Fatal(err)
+	}
+	if user := findTestUser(t, "setpass"); !user.Password.Matches("secret2") {
+		t.Error("password was not changed")
+	}
+	defer withPasswords("secret3", "other")()
+	if err := runCommand(setPassword, nil, "setpass"); err == nil {
+		t.Error("expecting an error with mismatched passwords")
+	}
+	if err := runCommand(setPassword, map[string]string{"password": "secret2"}, "missing"); err == nil {
+		t.Error("expecting an error for a missing user")
+	}
+}
+
+func TestRegisterUser(t *testing.T) {
+	restore := withPasswords("secret1", "secret1")
+	if err := runCommand(registerUser, map[string]string{"e": "register@example.com"}, "register"); err != nil {
+		t.Fatal(err)
+	}
+	restore()
+	if user := findTestUser(t, "register"); user.Email != "register@example.com" || user.Admin || !user.Password.Matches("secret1") {
+		t.Errorf("unexpected registered user %+v", user)
+	}
+	// Updating a user doesn't ask for the password
+	// unless -p is provided.
+	restore = withPasswords()
+	if err := runCommand(registerUser, map[string]string{"s": "true"}, "register"); err != nil {
+		t.Fatal(err)
+	}
+	restore()
+	if user := findTestUser(t, "register"); !user.Admin || !user.Password.Matches("secret1") {
+		t.Errorf("unexpected updated user %+v", user)
+	}
+	defer withPasswords("secret2", "secret2")()
+	if err := runCommand(registerUser, map[string]string{"p": "true"}, "register"); err != nil {
+		t.Fatal(err)
+	}
+	if user := findTestUser(t, "register"); !user.Password.Matches("secret2") {
+		t.Error("password was not updated")
+	}
+}
+
+func TestGrantRole(t *testing.T) {
+	if err := runCommand(createUser, map[string]string{"password": "secret1"}, "grant"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := commandsApp.NewContext(nil)
+	defer commandsApp.CloseContext(ctx)
+	DefineRole(ctx, "editor", "edit")
+	if err := runCommand(grantRole, nil, "grant", "missing"); err == nil {
+		t.Error("expecting an error granting a missing role")
+	}
+	if err := runCommand(grantRole, nil, "grant", "editor"); err != nil {
+		t.Fatal(err)
+	}
+	if roles := Roles(ctx, findTestUser(t, "grant")); len(roles) != 1 || roles[0].Name != "editor" {
+		t.Errorf("expecting role editor, got %v", roles)
+	}
+	if err := runCommand(grantRole, map[string]string{"revoke": "true"}, "grant", "editor"); err != nil {
+		t.Fatal(err)
+	}
+	if roles := Roles(ctx, findTestUser(t, "grant")); len(roles) != 0 {
+		t.Errorf("expecting no roles, got %v", roles)
+	}
+}
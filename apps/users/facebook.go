@@ -58,7 +58,10 @@ func signInFacebookTokenHandler(ctx *app.Context, client *oauth2.Client, token *
 	if err != nil {
 		panic(err)
 	}
-	ctx.MustSignIn(asGondolaUser(user))
+	if next := signInUser(ctx, user); next != "" {
+		ctx.Redirect(next, false)
+		return
+	}
 	redirectToFrom(ctx)
 }
 
@@ -77,8 +80,7 @@ func jsSignInFacebookHandler(ctx *app.Context) {
 	if err != nil {
 		panic(err)
 	}
-	ctx.MustSignIn(asGondolaUser(user))
-	writeJSONEncoded(ctx, user)
+	writeSignedIn(ctx, user)
 }
 
 func fetchFacebookUser(ctx *app.Context, token *oauth2.Token) (*Facebook, error) {
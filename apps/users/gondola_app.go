@@ -19,7 +19,7 @@ var (
 func init() {
 	App.SetName("Users")
 	var manager *assets.Manager
-	assetsFS := vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec;\u007fs۶\x92\xf9۟\x02I}\x115\x91(Ɏ\xd3F\xae_\xf3\xb3\xad;i\x9c\xab\x93\xe9\u0378~\x1a\x88\x84$\xda\x14ɐ\x90e7\xd6w\xbf]\x00\xa4@\x10\xa4d\xe7\uef79\xb9(\x19[\x06w\x17\x8b\xc5\xfe\x06\xb8\xc8X\x9a\xb9\x17ك\xff\xc5O\u007f\xd0\xef?{\xf6\xf4A\xbf\xdf\x1f|\u007f\xd0\xd7\u007f\xc3g\xff\xa0\xbf?x0\xd8\xdb\u007f\xba\xb7\xbf\xf7\xec\xfb\xa7\xfb\x00?\x00\x94\a\xa4\xff\xe0_\xf0Yd\x9c\xa6\xc0\xcaW/R~\x8a\xdf\xffG>\xced\x11y<\x88#g\xb7C\":gm\xf2e\x87\x90+\x9a\x92Q\x16L\xa3 \"G\xa4\xf5\xe5\vI\xd9\x15h\n#/Na\xf48\"\xabU\xebP\a\x8c\x17\xdc\x06y\x02\xc3\n\x14\x1e\x05\x13\xf2\xe2g\xea\xb1q\x1c_\xbeL\x12x\x02\xe3\x8a\xc8d\f#Ǿ\"\xa2\x83\xb90\xaah\x14\xb0\x1fX:\xcf\x00V\aű \xcb`1\xd9\xedE\x16#\x8f\x1a\xceE\x96é\x15\x18\xdc\xfev*\xc7s\xa8ʔ\xafg4\x8aX\xf8)\rM\xd4\x1cEAh\vf\x91/\x17\xa9\x16\xffK\x1cOCf.\xfd\"\x93\xe3͌I\x18\x1bqI&By\xec\x9e\xe1&\x9ek\xdfnoɮ\xf3eՖk\x892\xf7\xe7W\xa3?\x8f\u07fd\x1b\xbd;y\xf9\x06\xe7\x92.`2\xee.\x830\xec\x861\xf5[:,\x82\xbd-\x03\"\fӠ~99\xf9\xe5\xdd[\x1bթ\xe0\xd9FY\xe1\x98\xd4\x15\x829\xc3\xe9\xf1/\xef\u07fe\x19\x1d\xbf_C\xa2\xd21\xbf\x1bD\x15\xa8\x93O\x1f+`\xa0\x9e\x00\x97\x03\x8e|6\xa1\x8b\x90\v\r\x12\x83\xf8\xa1\v\x1eO\xc6C2\xa1a\xc6:\xa4\xd7\x13#\xc0\xcb\x14\xac@P\x83\x9fA4%\xf9~\x17\x98\x17ِ\xf0t!\x91\x00\x92\xfcvJxL2ܡI\f\x9aZ@&q\xb2HLh9\x88\x90$\x8b\xbd\x80\x86\x04\xb9\x86\xa5\xad\xf1\xe6\xf18\bY\x8e\xbd\xe6\xb0\x19\x9d\x80\x11HL\x8d\x90OC9?\xe2\xfbA\x96\x84\xf4\x86P\xf9@\x12\x11v?%A$\xb0V\x9a\xdc\xe4\xf6\x8c\xe4\xf6\x80\xf0\x04#\xeb\xe7A\x14\xa0\x17(|J\x9c௬\xad\t\x19\xac\xc0AJ\b\n\xac\x06\u007f3_\u007f\x8c\x9f\x94\xf1E\x1a\x1d\x16c\xab\xe2\x1b\"*\x9a\xa8\xe1.\xbb\xe6 b\xd0\xeeNiW;$\x9f\xf8\xb0\x84\xaa\xcd\t\xe8(\x82\xc3\x12_\xfc&a\xf1d\xed\x8a\x1e\x1e\xa1\x1aE@6\x00\x1dj\x91Ǐ\x8bg&\xcf\xcb \xf2\xe3%\x18\xc7\xcb\xec&\xf2\x8e\r9\x98\xd0\xf8\xf9\xf9\x95\x10\x97S}\"t\x11'\x19\x16\xd3u\xac@^ᑆe\ae\a\x87H\xc7\x17\xb9\xee\xd9\t\x82R\a\xac\t\xe2z2\x9e+\xf5\xa9<_i\xe2\xd6V\xf9\xf6\x8aE\xdc\xcd\x16\xe3\xccK\x831sZ`U3\x17\u007f\xfc\xc1\xb2\x04v\x89!\xe3S\xd6\xea\xac\x05\x96\xaa'6\xc1\xe9Z\xa4\xf6ٕ\xa6[\a]\xa8NT\x0e\x01\xebi\x0e\xadx+\xc2@\xbd7\x10\xf5f̻\xd4CPV\xc4T\xe24q\x94\x13\x98\x8cG9\x1f\xa03\xf9\xd7\xc3Z\xbcU\x1d\xb7[m\b\xcc\xc8\xd3`:e\xa9\xa3\xfbw\x03rU\xfe\xb3\x82T\xb8\xfav\x05p\x84ۜp\xa7\xd5\xeby1\xe8\xa3\xc7݉\x92\x8e\x1b1\xdec\xd1\xe8\xd3i\x8f\x86!d\x9d\xad\xb6\xcd\xcauS4B\xa3a\x915V8\x1a\to}\x12\xadq\x99/\x02kI\xc1l\x9b\x03.\xf1\xe3,\xc8\n@\x02\xdf=`\x16<\x06\xcd\xc0\xbd\xc2\b\xfc\xe63F\xc6\v\xce\xc5s\x1b\x8d\x14\xdc\x12K\x01\tւ\xc0\xc8\x0f\x92\xa2aʨ\u007fCdT\x02\x0f\xeb\x92\xdf \x03%\x98@\xa5\xccF\t\x1c\x89NcF\xb3\xa8ŉ\x17\x06\xde%\x10\xd0\x18q\x18\xda\xd9\x02x\xbd!K+\xa9y0\x9dqB}XJD\xd0\nc\xe9\xce[\xca]\xb6\xdd\x1d\x9b\x9d\xa9\xadX\xbb\xffS\x11\x1f^+\x16\x8e\f'\t\xf9\xc6\xc3\x1a\xd8:k0\x1d~\xbdF#?\xb8u\xae\x17\xfb\xb5\xcea\xd7M\xe2\x8c;\x86\xeetH\v\x91\x8eZ\xe4\t)Hh.ǧ\x9cv\b\x87\x90r*\xfcd\x87\\|\xfe\xaf_\xff\xd8\xecSr\xf5\x12\x14\xeal\xb3\xbdiu\x86\xc5\x15\x9a,UY.\xe4$z\a\x81wS`\xb1\xc5\xe9r\xac\xb3\x9bu)#3\xfd\xc1\xceF\xbcm\\\u008csH_z\xbd$\\\xe4\xa9\x1elüw\x91\xf5@\xa3A}\x87\xf8$\x8e\x18\xf8\x86\x9f\xe2\b\xd9?\xb2\x89\xc0\xee7p&\xca9\xf5f\"\xe4d\x8e\x82Z\xed\x94\xf9\xd0\x05\x98\xa5\x9e.C̣1\xb5\xf0co1ǰ偽r\xf66d\xf8\x97Ӓ\x04\xf4\xe9A\x14`\x1f\x98o\xa2\xea\xf4.\xe8\x15U@:\fŬ\xa0\xb2\x0f\x90\x9d\xa68\n?\x0fK,$:\vS\xc6\xd5\xfc٫\x9b\x8ft\xfa\x1e\xd2\xfa5'g\xfd\xf35n\xe2&\x14\\\x0f\u007f\x0f\xaa\r\xd9\x05\x88\x8d\xbfb\x90\xd21\a\xd49\xa9\x88\x03\xe4\xfaA\xe6\x8e5*%\rSO\xbb\\\x95lB*$Ӹ\xec\r\xcb.y\x9c\x00\x1eX\xbe\x0e\xa9\xa7\xac\x95\xa9\xf5}\xd2g\x87U\xeb\f\xc0\x9fR\x14\xb2\x8c)d2\x8e\xfd\x9bv%\xa7,\xd6\xe3\xb4M\xc3\xf8\xca\xf4N\xf1\xe2\x02\xa4\xef\xb4\\\x99fwU\x9aMh\x11\xe1ZmW\xb8f-\x05\xa8uS\xccMR\xe1\xb3\xdfȴթ\xf1\x1d\xb0\xb2I9m\xb19\x93\x8a\xc1\xeel˻\n\x02m\x97\xc1\x8el\xc8]P9)\xea1\xc4\xc8C\xeb\xd3 \xe2,\xbd\xa2X#g\x8c\x1f\xab\xbf\xb6H\x89\xf2M,\xb9\xae&\uf2f3\xad\x8b\x81/\xab\xfa\x8ciס.(\x1cd\x9f\v\xce2c\xa1\x0e\x88\x85]w\b\x02lJאG\x84s\xb1\xb4\x16)-O\x9d~\x87\x1c\xb4\t\xea\x12\x06\x80nk\x13\x11\xfc(\xbe\xcf*\xc4\x0e\xdaX\xbb\x8ba\x10ۢ!\r\xb4\a\xc8MI\xa2\x12\xc7\x1d\xd4\xf4\xae\xea\xba\xc5욗\xaa2\xb2M\xcal\xcdCj\x82\xdc6<Mi\x12\xb8\xb2\xecue\xeaVb\xd2VOVJ\xa7\x90ѴP\xf7\xdc\n\xea\xd2\x01\x8b\x01\x83\x16\xf5M#n\x1fnm\xc6\xdb\xdb\xef.\x1a/\x96\xcf\x0e~\xb1p\x88Z.\x80\\Th\xa7%\\x\xab]\x9bn\t\xd0;\xe9\x13\xb2\xb1\x10}4\x89\x8c\xfa\x0e\xd9A\xca&\xad\x06\x11\x8b&\x17\xd8J\x81&\xd9áMh\xcb\xc0\xe73\x03O,\xab+\x9elB\x9f1\x91:\xdb\xf0\xe5\xa3&\x02\xa0\xb0q¢\x97P\xf0\x82>\x05\x1eE\x01\xfd)\xf2;\a\x85\xf0\x84\xb4~\x92\xe9\xdeѠ%\x9b\xb0\x1d\xc9pGM\xdc@|[\xab\xdc\"\x01\xad\xad\xc5\xf4\xa0~\x91\x99\xfb*ě\xd2$\x81\xea\xe4H\xd3\xd0\xef\x84j\x82\x84\xb0\x01\xd6U\x10\xa6\x9c\x90\xbcz\xe4\x86,\x9a\xf2Y\x9d\xd6fyoԜ!\x88\xc4\f\xb6\x1dX\x83b\x94\xeb*\x86\xee\x12\xa3\xe5\xac\x10\u007f}v\xb2\xe0%Id\tc~\xa79\x15/\xb2y\xb5D\xa4\x03\xf1\xbbB\xe6\x0e\x9b\xb6\xed\xa6\xaf\xb6\x94G\x10\xddE\x1e\xfaB\xbeF \x9a\\\xffM\xf2X\xd58V\xd4%⊊\xa3\v1y\x1e\xf0B>\xaa\x85\x04\x00\xa7\xe2\x81AQ\x93\xee\x19\xba\xb2\xa3GXj\x16\x87#`\xe6\x8fη\x95\xf56+\xca\xcb\\5\x01x\x8eE\x18\u07bf\xa0E#cW\xa2\xad*\xd2r\xa7\xd4P\xaf\x91|\xb9L\x05d\xe4\xa16\x8d͋FvUC\x0e\xdd\xc1Cv\xe5bU!\xd6\xfdAJ\x81\xf9N\xbbѾ$\x1ba,\x9d+\xc4pL\x1f\x9d{7ʶ\xf3\x85\xa2cn\xb2\u0560\x05\x10\xa6\xffǕ \xcaO8\xaaeAu\x11\xeb\xf2\xcb섚\xad1\xb3\xf7\x9a\xf7]pܕ}d\x91\xf1\xaa\x0e\x9f\xaa\x9e\xb0\xef\x13dE?\xa4m\v\x15\xa8\x95X-\xa7\xecsъ\x11T\xf5\x86\xb0Z\xd3\x1f\xec\xf3\x82e\xbc\xbc\xacuw\xa7\xbc\x84\x0e\x91\n\u007f\x1f\xfd\xdf\xdc\xc8i\x12f\xb5\x03\\\xea0,<\x8fe\x953\x90\xfc\f\xd3d\xe7\xe7W.$\xa3N\xab7g\xbdd}\xa0i6\xc7\xeb\xc2d\xa2\xa6\x17\x1d.\\G\xa9?\x90\u007f\xf0\x94\xc7\x11\xd5Z\x00\xb0\xfdC\xfc\xfdcq\xac\xaa\"1\x8e>y\xd2T\xae=\x14\xb3\x9d\xe5xgAp~\xded\xa6\xb2\x9d\xb0\xadMVF\x94$\x1b\xf5\xdcֱ\xef\xf5\xc8\xfb\x18&\xff\xbc\b\xb0%\xabIug\x03yc\xa7'\xb56c\xf6\x90\xc0E\x98EiӦ#\xf8Y+\xf3 Ola\xfdW\xec\xc4E\x1cDN\xa33\xd2O\x0f\xc0\x04\x8d!\xbb\xad\x9a\xd3[\xcfF\fJ\x96\xae^\xf9\xe0\xa2h\xa4l\xd8\rPpq\xa2\xea\xd8f\x15\x95VV\xbf\x03\xd3\xf29\xb9U\xfeuG\xed\xe6ޔ7\x1fe?\x14\xd7\tT\xd3\x18\a\x8a{\x04r#Z\xa4\xd5.\x1f\x8bɮe\xe0\x0f奅bNue\xa1c\x9ck\xfa\xa0\x80\x1e\xd8@\x00\xf0\xe8\xc5\xe6\xa0o\x14O\xbeJpT\xe8!v\xab\x00,\x9eLB\x90\xaa\x01\"\x0f\xea\x92\x18\xc2\xc8\r\x00\xe1\xa9t\xc8F3 9\x8a!\xc8BRg\xc0\xd30\x1cS\xef\x12`k\x0eG\f\x84`>\an)g\xea\xb0\x19\x8d(\x86\x02&]\x06\xf0\a\xfaj\x82\x87\xfa\xf00\x90\x87Ƌ\b\aar<\\\x153\xecX\xaa\xdd{u\x88\xb6\xee\f\x01\x8f\xa7\x8c\x81p\xe6\xd8$\x84\x89H\xcdbw\xbe\xb6\xa9 \x1a\x06\xe2\x1cSf\xb2\x8e\xa1\xb6U\a\xb6\xb1?`6\x00\xca\x17<\n\v\xa8+%uk\x80\xaa\xd2^G\x1a^j\x82\x81X\x89h\x84\xc1Xt\xa2x\xfc.^\xb2\xf45\xcd\x18l\x05d,#\x19\x8dG\xc5U\v%\xebO\xa0\t\xa2\x97\xc0cB\xaf\xe2\xc0\a\r\x9f\xb0\x94E\x1e\xde\x1e\xc0\xa3)\xa4\x97%`\xe1:\xda$\x8d\xe7\xe2\xa9Т\x19\x8d\xfc\x90\xa5;\xe5T\xeelr^Z\x10phwZE\xcc\x16 FB\xceB\xc6ٚ\xe2a\x9dF.\xc5-'%\xdcue^_\x85\x03\x82[\xf4\xa2y\xc0Cq\x00\xf0Z:X\xb1\xf8\x98\xe4\xf2D\t\xba\xae\xab\x89NuՁ\xc8auokv\xb3v\x1f\xf3\xce\xc6\xef\x14\x94q\x0eNJ\x0e\xdcޒg\xfd~\a\xfcZ\xcaX\xe4\xca\xc1.\x19\xf4u\x15+\xda\x1a\x05\xb2\x1a\x01\xec\x83\xc1\x1a[\x8d\x9a\xe8(\xba\x90M\x90\x80\xa3\xcf\xd3\xdbkw\x9d\xfc[\x19\x9cǉ\x06-\xe9\n\xf0\xe2\xabML\x98ۣl\xa4(Z\x90\v\xb5$\xbc\xc8 \x15w 掘T\f\xca\x05\xe3\x18\xec\xd1bLӣ~\a\x82U\xf07\x1d\x87\f\xbe\xf38\x0e\xe5(\xb0$0\x905\x84\xc7\x15\x89\x01\xfcb\x9e[d\x96\xa3\xe4ܷZn\xb94%\xc39\x1a¡\xf2:\xed\x8et\xb5\x9b\xd2\x19\x91r*d\xe0#\xffZ\x89\xcfx\xb1\x91Kwv\x1c5d5%\xb8\xe6\xa0\n\xb6\n\xeb\x8a9y\xf12\f\xe3%8\x80T\xc6\xe26\x940\x9f\x89\x03\x89#yq*:\x93\x1f!|A\x9e3h\xeb\xe1WQ\xda\xcd8\x19\x1e\x11\xd1x/\xc1\x93\xbe\x05\x1a\xe6\x05ڀ\xe3\xe2\xc9\x17y\x94g\f\x8fLح\x0eKЭ\xa2LM\xaa2@\xd4\xd1\xd4\xf3\x8e:\x8a\x16\xccu\x9fS\xbfч\x93\xfe*ݞ\x98\xbb\xb8h\xf8\x15]Ö\x14\xab\x9b\x13\xec(9\xbb\xe2@\xecOa\r\xabUy\xf4Wi8\xab\x95eA\xeb\xc8SwH_\x85\xc2\xd5\xca[d\xd8\\^\xb7\xe7Ęޟ\x13\xb5\x83\x18\xadi\xfa\xed⡧\xa3\x8afX\x9c\x80\x15\xeb\\g\tw(\xefDkCr\xb5\x8bM\x88\xb9\xac\xf0\xec\xe5t\xf9\b\x99\xd9Z\xf8,t=(\x14Z\xea\xf2\x1c\x8a?\x8a#k\x13\x1a@\xb1c\x16\xf9\x1fc\xe3\xf4\xd2\xd2Щ\xcaL\xf6\x18\x9cV6\x8b\x97&\xf9\x15\xbaA>\x0f\xcd\xf1\xfa\xebsR\xe4\x16\x92\x85/X;,\xcd\x11\x10ۙ\xf0Ç\x85\xdf2h\xa8N\x90\x1d[?\x87UM\xa3\rwz\xd4|\nڶ\xac\xfc\x94\xbah=\x15\xac\xb04\x8dS\b˜\x02\xe9tӒ@\xfe?B\xb6\x12A\xaeF\xb3\xec\xe8ь\x85Iw\x1c\xc6\xe0f\x05\xa1\xaeJ\xd7\xe5_\x8f\xfe\xf1c\x0f\xa1\xffQ\x11\xe2(\xc8~\x17gߛe\xd8{\x19\xf9)dN\xb7K6>9\xbd\r>\xcc@\x8f\xe0\x17\xf5\xe1G\xec\u07fe\n\xc1\xb3\xbf\x82\xf9nn\x8f\xdfJ\xa2\xb7'P\xc0R\xf2{\x10\x05\xbd\xc0\xe5,\xe3ND\xaf\x82)\xe5q*d\xffr\n:f\xe1I\x1d\xd4odJ_A\xf5\n\xc5:\xe5jJ\xd0\xee\xe4\n\x9a<\x81|\x063\xb4f\x81\xefC\xce0V}\xb7\x92/\xb0\x19\xbd\xc4L\xd9<\xbebM-\x83\x8aa\xc0D\xcc~\xc1\xa4\xd2\xed,kdC\a\xf5\xf8}\xf9*\xea\xba\x13\xda!gH\xe5ܐ\x89\x1e\xe4\xdb\r\t\x80\x90|GT)\x96\n]K\x13\xb6-\xcfE]\x80\x19\xbaH\x88G\x9f\xc1\xcd៦\x93\xc1{r'oN\x86\x90\"\xce\u061cuS\x16B\x84\xbab\x18\xee\xb2J\xf5&\b\xe2\x95\x11\xfcr\xd6?\x17\xfd\x88^+\xbf1\x82u\xabx$\x8eы\x11\xb3\xab\x8b\xedԶ\xb5\x0fl\x83D\xf5\x04\x92\xc6\xc6\x17\xc1\xffN}fX\xed\xf1D]\xb0\x86\xf8\x9a2\x8am\x81%k\xa5P\xc5pQ̠\x86\xe77\xa6\r\xd4\x04}\xc62\x80\xeax\xc1\t\x95\xd2Mh\n\x91\x9a\xe3\xde\xc9f5T\r5\xd7\xffD\x9d\xedQ\xbc\xe9M\x8b\x1e\x02V\x18=w\xe7~\x8d\xf0Um+\xf3sVr\x0f\x97\xa6Mc\xeb֜#\x83\xc2֛\xe5\x17\x18\x06m\x17\x82b\xc0\x9d\xd6c]g\xec\x1dGZn5V5\x11\xbd\x15žbN\xf4\xc8v\x82\x98(2\x10JȞm\xff<\b\x01Ad\x16\xf2\xab*!\xa5\x9b\x976\"\xca?\xfa\f/\x0e~\xfa\xe3\xf8u<O\xc0[\x83\x9d'g\x83s\x105\xe4\x02\x1esz\u007f=\xe9M;\xe4\x11y\xd4\xde \xf6\xe6\xd8%l\xc0\xa8\x03+.\x16\r;\x05\xcfE\xb97sz\xff\x14\xf7\xeb~\x1a\xfe\xd5\xfb\xabw\xf6\xcf\xde\xf9\x93^\xb5\x9c\x9a\x93\x9f\x880\xc1\xa1\x9a\xb4z2P\x1cp\x95t\x81UtA>8\x12o8\xa83\xf1\x8b\xac+\x87+\xe9\x9e\x1c\xde\xfe\xaa\u007f\xd3\xe1\aήn\xbdZ\xae1\b\x0f\x86\xa7xG\nH\xdd\xe4\xc8\xe4ឡ\x95\xf3\xfcB\x858\xa6\x9e\xa6\xb18 \x96a\xe35\xe6\x01\x10\x10h\xd6\x15!\xbf\x0e\xb5\x94\x1d\x00v~:Z\x1f\xa0\x14Ӗ𤇦\x9c\xfd4ND\xb2\x89\xb5\xabߪx{\xedXEp\x05\x11A\xbe\xe5\xa0\xd3\xddu\xe9\x05\xbd6\xde6P=\xc6\x0f'\xa7\x1f\x8d\xfe\xdf\x02\xdf)\x90{V~\x80\x13\r\xe5Q\x8b\xad{>\xbc\xd7\xf9\vj\b\x82KIfug\b\x85\x13\xb9\xc4\xde\xde\x16\b\xeb\x96c\"^O\xd36M\f\x89W\xa4D\xb1\u007f\x89\x05\xd5\xf9\xa6{ ~\x80\xe1]\xa0\xae\x95\xaa\xac9\x93 \xcd\x1a\xefF\x01\r\x97\xfa~\x93n٦\xf6\xb4,\xb6\x92\xd96\xcdW \xba\xb8\x15\xba\x98\xcf.Ϸ\xc2۶֩b\xaeK\x9f\xe0j+\x04u!`\xeb\x83\xdb&+1\x9b)[\xbdձݕn\xe3\xe6L\xd9\x12\x84p5;\x10Z_6\x04\x01\xf2q\x96\xc6\xcbȦ\xba4d)ĺ\xb7\x92\x90hN\x15\xc8\x16n\xee\"\x80U\xc5ѬvVm\xe7\xe2?\x17,\xbd騗\xe6ps\x1f|\xfb\xfc\u007f\xf8ȗ$C\xf0\xdc\xff\xae\xf7\xbf\xfb\xcf\xf6\xf7\x9f\xaa\xf7\xbf\x9f\x0e\x9e\x0e\xc4\xfb\xdf\xfb\xfb\a\xdf\xde\xff\xfeW|^L\xc6^\x1c\xa2\x9b\xf9n\u007f|\xf0\xfc\xf9\x0f\x87;/\xa6\xf9\x88\xef?\x1d\xef?\x87\x11\xbe,\x80\xf6=o2A\xa0Y1D\xf1\x1f\fI?4J\xa1\x98\xc1\xd7\f\x9f&א]\xba\x10\x17\xa1\xf4\xf4\xbb^\x9cF\xf8\n\xad\xf3\"\a8H\xae\xa5\xf7\xeb.\xd9\xf82\xe0\xddq\x9c\xfa,\xed\xe6\xcf\x15 \xba\xa9\xee<\xfe\xbb\xe1qݓ\x956?\xb6\U000db630M\xd2\xe5q\x82x\x02L\xbaK\x83W\x00\x90\x945z\x12p#\x80e\xbaq\f\x12\x9c7\xcf(a\x9a'\xad\x85A\x81\xa8\x9b\xc3\xf9kc/\xc4>\xe620\xb7\xcb)慠\x19\xd8S\x98\nH\x10\xb6\xc0\xaf\x8e\xe7l\xe3\xe95M!?\x02\x02X,Ix\xbc\xad\x93^\xb2\xa8\xf8s\xd0\xff\x8f\xb6\x85zw\x9e}%\x85{`\v!\x0e\xc9 \xb9\x86z?\f|\x13v\x0f`K\xb2\f\xbc\xaa$\xb1\xf0O\x19\xc8q\x0e\xd9\"\x13=\x02\xc8<)\x94\xf2\x92H\x16\x93%S\xe7\xa63z\x85\xa7\x864\"\xe9tL%DN\x042]\xc4}}z\xeaVֆ\xe9\x923\x0f\xae\v\xce\x10\xdd\xd9;8\x00\x1e\x8b\x1f}wp\xd0n\xe3\n\x05ۚ\x9a\xa4\xd8\xffoX\xe7\xc0\\\xa7j\xe3u\x881\x00\xffQ\xccj\xe5s\x9aNaL*!\x90/\xb4\xf3\xb1\xe8O\x16w\xd6\u05c9OImu\x86\xbec\a\xf8O/\x8d\x8b\xf3\x1d\r\xbf\xac\xd3N\xee\xd4\xf4\xeb\xf3\xaeأr\xae\xa5o\x9f\x8ed뎨#\xa0\x86I\xa7\xf7\x98s\xda8%_\x06\x9cC\xc6_?\xa7r\xccw\x9bt\x8dd_h\xc0g\x8bq\xd3Bg\xf7Y\xe9\xaciR\xaa\xbf\xf3%+\x8d!\x11\xadw\xed\xe56\xa8\x9a\xc0J@=\xfa\xa0\x1fk\xad\x12\x9a\xd5!\x8f\x87WA\x16p\xbc\x82\xfcx8\x83\xaa:5\x0f\\U\xbc\x9a`\xfc*\x97\x95\x11\ao%\x8da\x1c\x87\xbe\xe5q\x06\xe54\xcc\xfcL\x9fT0\x15Ü\x90\xe5\x0fI\xde\x015\x9eK\xa6\xbbʧ\xf7M|qN=${\xdf?7\x9fH+\x1a\x92>\xfc+/v\xb5A\ued88g\xf7\xe3\x15\x91K;\xee\x1a\x92//\x94\x8e\xc16\xc1\xa5\x95\x9f\xcb\x05\xf6˃\x10\xfc*cj\xc5O+\xa2\x98\xa9\x1d@?e\x90\x81\xea\xa7KCp\x1bC\xe2a\x9b65ދ\t,E\x94\xb6m\xfb\x95\xb9\xf4\xf9\xecO\xc1\xf3\xaa\x8b\x05s|A[t\xd8\xd64\xd1+\v\x9fe\xc3\xc36\x05\xbalPA<\xb8\r\x15\xcf\xea^\xc82N/ݺ\xabK\xd8\xc0\x1b\xe2\x8b\xf3\xa84n6\xa7\xd9\f\xbe\xcc\xe9\x94\xfe\r\xfb\"ޕ\xdd\xeb\x0f\xf6{\xfd\x1fz\xfd\xe7\xbd|'\xba\xe2\xde\x15\x16\xd1a7\x9f\xb4[Lڅ\xfa\xbd\xf7\xdd\xdbk\xd8\xe1Htl\xab\xef\x05l\xde~]#\xf1\xcd\xf1\xea\xd3M\nR\xa7\x0f\xb5\xca#\xfa\x83r\x8b,O\xf2X\xd1\xdf\xd4ڮ\x86\xafr\xb42\x16\xaf4\xcbꐪ\xe2Q\xe4U@\x83\x18\xa1\xd0\xea܂\x10@w\xafH\xc5\x14Cx\xd2h\xb2\xa3͒\v\x1e\xdd\x00x\x03\xf1S\x10(\x9f\xab\x01\x05\xe9\xc1\xf3Qy3ɲ\x10\xec\xe0\xe4\v\x90(\x82@\xd7\a)\xc5S\rcN\xaf\xbb\xca`\xf7\u007f(\xec$G2\xdfA3\xaf%\xe9\xb6,$\xf5\x9d|\xaf\x02\fd\x1a\x8b\xcc0\x0f⺏\xdd˧\xd1\xcd^(\xc2a\xad\x84,.|\xf5\xad\x85\xf1\xed\xf3\xed\xf3\xed\xf3\xed\xd3\xf0\xf9\xef\x00\x00\x00\xff\xff\x15g\xef\xd1\x00R\x00\x00")
+	assetsFS := vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec<ks۶\xb2\xf9\xec_\xb1IsLj\"Q\xb2\x1d'\xa7Ru\x9aG\xd3֝4έ\x93\xe9\x9dq}<\x10\tI\xb0)\x80\x01 ۩\xad\xff~g\x01\x90\xe2[rz\x1esg\xc2\xcc\xd82\xb8/,\x16\xbb\x8b](KE\xa5\n.ԃ\x7f\xe33\xd8\x1b\f\x9e={\xfa``\x9f\xd2\xef\x83\xc3\xfd\xc3\xe7\x0f\xf6\x0e\xf7\x9f==\xd8\x1f\x1c<{\x8e\xf0ϟ\xef?\x80\xc1\x83\xff\xc0\xb3T\x9a\xc8\a\x83\xbf̫<\xb9\xff'\x8f?]\xf2P3\xc1\xfd\xc7]\xe0dA;p\xbb\x03pE$\x9c+6\xe3\x8c\xc3\x18\xbc\xdb[\x90\xf4\x8aJE\xe1\xc5\t\x9b\xf1#\x0e\xab\x957\xca\x03\x8a\xa5\xae\x83<^\xea\x14\xf4\xf6\x16\xd8\x14^\xfcHB:\x11\xe2\xf2e\x92\xc0j\xb5\x03\xe0\x88L'/\x93\xe4(rD\xf2`\xc1Q\x94\xd2\xc8`\xdfS\xb9P0\x86<(\x8e1\xa5\x98\xe0\xea\xeeB\t\x941\x87s\xa1R87\x83\x92\xb4\xbf\x9c\xd8\xf1\x14\xaa\xc2\xf2\xf5\x9cpN\xe3\x8f2.\xa3\xa6(\x0e\"7a\xca#;I7\xf9\x9f\x84\x98Ŵ<\xf5\ve\xc7\xdb\x05\xb30u\xc4-\x19\x8e\xfax|\x8a\x8bx\x96\xfbtw\a\x8f\xfd\xdbU\xc7΅\xab\xe0\xc7W\xe7\xbf\x1f\xbd}{\xfe\xf6\xf8\xe5\x0f\xc8˺\x80\xe9\xa4w\xcd\xe2\xb8\x17\v\x12yyX\x04{S\x04D\x18\x9a\x83\xfa\xe9\xf8\xf8\xa7\xb7o\xea\xa8Ό\xccu\x94\x1dN\x99\xbaC(s89\xfa\xe9ݛ\x1fΏޭ!\xd1\xe8h\xd4c\xbc\x02u\xfc\xf1C\x05L,\xb57\xdaI\x01\xcf#:%\xcbX\x1b\v2\x83\xf8\x90\xa5\x16\xd3\xc9\x10\xa6$V\xb4\v\xfd\xbe\x19\xe9\xc5b\xc68\x18j\xb0T\x8c\xcf ]\xef\f\xf3B\rA˥EZ*\n\xbf\x9c\x80\x16\xa0p\x85\xa6B.T\x06\x99\x88d\x99\x94\xa1\xed B\x82\x12!#1\xa0\xd4=\xc6\xd7x\v1a1M\xb1\xd7\x12\xb6\xa3\x83\xe0\x0e3G(\"\xb1\xe5\x8f\xf8\x11SIL>\x03\xb1/,\x11\xb3\xefg\xc0\xb8\xc1Z\xe5\xf4f\x97\xe7\xdc.\x0f\x8c\xad \xeb\xf7\x8c3\x8d\xa3\xa9O\x11\t\xfeR\x9d\x9c\x92\xd9\x14|\xa4\x84\xa0\x8c\xc4\xecO\x1a\xe5_\xe3#\xa9^J>\xca\xc6V\xd9'Dt4\xd1\xc2\x03z\xa3)\x8f\xfc\xdbU\xb7\xb0\xaa]H\x19\x8f\n\xa89\x9e06*\x18\x15\xe4ҟ\x13*\xa6kW\xf4p\x8cf\xc4#:e\x9cF\x1e\xec\xeef\xef\xca2_3\x1e\x89\xeb`:y\xa9>\xf3𨤇24>?\xbe2\xea\xf2\xabo\xf0!\xc8d\x98\xb1\xeb\xd6\x02\x85\x99G\x1a\x16\x1dT=\xb8\xd2D/S۫'(\xc4%\xa3m\x107\xd3\xc9\u0099O\xe5\xfd*\xa7\xee\xdc,\xdf\\Q\xae\x03\xb5\x9c\xa8P\xb2\t\xf5=\xb2\xd4\xf3\x00\x7f\xfcFU\"\xb8\xa2(\xf8\x8czݵ¤{S\xa7\xb8\xbc\x15\xb9u\x0e\xec\xd6m\x82\xceL\x87\x17C\xc0\x9aͨ\x16o\x054Vt\x03\xd1pN\xc3\xcb|\bRYL\x05\xbfM\xa2\x94\xc0tr\x9e\xca\x01cH?\x8e\x1a\xf1VM\xd2n\xb5 \\\x05Z\xb2ٌJ?\xef\xdfK\x90\xab\xd1N+R\xe6\xea;\x15\xc0s\\\xe6D\xfb^\xbf\x1f\n\xcei\xa8\x83\xa9\xd3N\xc0\xa9\xeeS~\xfe\xf1\xa4O\xe28\xb8P^\xa7n\x97\xe7\xb7b)4\x96vd\xc3.<?7\xde\xfa\x98\xafqid\x02k\xc1\xc0\xea\x16\xa7߇\x0fs\xa62@`\nB\x12\xc74\x02\xa2@\t\xc1\xf1\xb7\x9eS\x98,\xb56\xef\xebhH\xca#*i\x84sA`\x94\aI\x91XR\x12}\x06\x1b\x95\x80\xf1\x00~Y*\r\x98@IZG\x89\xe9\x02\x8d9Q\xdc\xd3\x10\xc6,\xbc\xa4Q^\x10\x9f\xe2>[\x928\xfe\f\u05f5\xa4\x16l6\xd7@\xa2\b\b\a܅ºsϹ\xcbN\xb0S\xb7\xcf\xdcR\xac\xdd\xff\x89\x89\x0f\xaf\x9d\b\xe3\xe2\x92`\xbe\xf1\xb0\x01\xb6i7\x94\x1d~\xb3E\xa3<\xb8tA(\xa2F\xe7\xf08H\x84\xd2~\xc9v\xba\xe0!\xd2\u0603'\x90\x91ȹ\x9c\x88h\xd2\x05Mo\xf4\x89\xf1\x93]\xb8\xf8\xf4\xbf?\xff\xb6٧\xa4\xe6e(4\xed\xcdΦ٭F\xf5\x96lM\xd9N䘿\x15$\xda\x14X\xea\xe2t1\xd6\xd5o\xebBFV\xf6\a;\x1b\xf1\xb6q\ts\xad\x135\xec\xf7\x93x\x99\xa6zA(\x16\xfd\v\xd5\x0fcF\xb9\x1e\xe2\x1b\xc1ip\xa1\xbe\x17\x1c\xc5\x1fש\xa0\xdeo '\xa25\t\xe7&\xe4(\xdfA\xadv\x8ar\xe4\x15\xa8d\x98\xd7!\xe6\xd1\n\xc6\x10\x89p\xb9\xc0\xb0\x15JJ4}\x13S\xfc\xcb\xf7,\x81<{\x15\xe0\xfe\x801xh:\xfd\vrE\x1cP\x1e\x86`VPY\a\x15(\x89\xa3J\x86\xa3\x82\bI^\x84\x19Վ\xbfz\xf5\xf9\x03\x99\xbd#\v\xba\x96\xe4tp\xb6\xc6M\x82\x84H\xca\xf5;\x11рqE\xa5~E\xa7BR_u!\xa9\xa8c\xa9\xe8{\x9b;6\x98\x94ݘ\xf9\xb4+p\xc9\xe6\xee\xaeK\xe3\xd4\x0fT]j\x91\xf8\x1d\xdc\xf9y\xc8|\xcaZa\x9d_\xa7<\xf7H\x14\xd6#\x12\xa1U\x85=\xc6d:\x99\x88\xe8s\xa7\x92Sf\xf3\xf1;\xe5\x8d\xf1\x17\xd3;'K0e<\xf2\xbd\xc0\xa6\xd9=\x97f\x03\xc9\"\x9c\xd7\t\x8ckΥ\x00\x8dn\x8a\x06\x894>\xfb\a\x9b\xb6\xfa\r\xbe\x83\xab\x8c\xbcK[\xea\x9cIe\xc3\xeel+\xbb\v\x02\x9d\x80\x92p\xbe!wA\xe3$h\xc7s\xa6F\xb5o\x19\xd7T^\x91\x18͚\xea#\xf7\xd7\x16)Q\xba\x88\x05\xd7\xd5\xe6}\x91\xdb\xfa0p\xbbjΘ\x1e\xfb$ ZK6Yj\xaaJ\x13\xf5\x19\x8f\xe8M\x17\x10`S\xba\x862\"\\\x80Gk\x93\xd2j\xe9\x0f\xbap\xd8\x01\xb4%\f\x00=o\x13\x11|\x9cܧ\x15b\x87\x1d<\xbb\x9b\xe1+\x12/[\xd2\xc0\xfa\x00\xb9)It긇\x99\xde\xd7\\\xb7\xe0\x9e\xf3RUA\xb6I\x99k\xf3\x90\x86 \xb7\x8dL3\x92\xb0\xc0\x1e{\x03\x9b\xba\x15\x84\xac;O\x96\x9f0\xa6Df\xe6\x9e\ue0a6t\xa0f\x03w\xe1pP\xdeĝ\xd1\xd6\xdbx\xfb\xfd\xfb\x187/\x1e\x9f}\xfcP#!Z\xb9\x01\nР}ϸp\xafӘn\x19\xd0{\xd9\x13\x8a\xb14u4\x8b\x8c\xf6\xee{sI\xa7^gԊ\x85{%C\xb3\xe2\xe1\xd0&\xb4k\x16\xe9y\t\xcfL\xabg\xdelB\x9fS\x93:\xd7\xe1\xdbWm\x04\xb8\nDB\xf9˥\x9eS\xaeYHPA\xbf\x9b\xfc\xceG%<\x01\xef{\x9b\xee\x8d\xf7<[\x84\xedZ\x81\xbb\x8eq\v\xf1mw\xe5\x16\th\xe3Y,\x1f\xd4/Ty]\x8dz%I\x12*a\x9c\xb3\xd0o\x8ci.\x93\x1e\x16\xc0z\x0e\xa2\xac'$\xef^\x051\xe53=o\xb2Z\x95\xd6F\xcb\x1c\x187\x1c\xeaV`\r\x8aQ\xae\xe7\x04\xbaO\x8c\xb6\\\x83)\x89\xe8\xf1R\x174\xa1\x12J\xa3n{*\x9e>\xe9\x14\x91\xce\x11\xaf\x92\xb9Ǣm\xbb\xe8\xab-\xf5\xc1\xf8}\xf4\x91\x9f\xc8_QHN\xaf\xff%}\xac\x1a\x1c+\xda\x12\x04\xe6\xc4\xd1S\xcbɂ\xe9L?\xae\x84$\xe4\xe2ļ\xe8\x8c\x1ah\x90Ste\xe3Gx\xd4̚#O\xc0{t\xb6\xad\xae\xb7\x99Qz\xccu\f\xba\xc0\x97q\xfc\xe5\aZ\xdcd\xf4ʔUMZ\xee\x17\n\xea\r\x9a/\x1eSaldhLc\xd3C#\xbdj \x87\xee\xe0!\xbd\n\x98r\xf3~o\xb5@#\xbfӺ\xbf\xac\x18\xb1\xb0\xce5\x90\x14\xd3G\xff\x8b\ve\xdb\xf9BS1/\x8b\xd5b\x05\x8c\xff덀\xa7\x1d\x8e걠:\x89\xd5NS%\xb4\\\x1a+\xd7^Ӻ\v\x8e\a\xb6\x8el2^W\xe1s\xa7'\xac\xfb0\x95\xd5C:u\xa1\x02\xad\x12\xc6\xe0I\xfa)+\xc5\x18\xaa\xf9\x82\xb0\x9b\xd3o\xf4Ӓ*=ک3\xfbr?\xaf\v\xd6\xe0\xbf\xc4\xfe7\x17rڔY\xad\x00\x17*\f\xcb0\xa4\xaa\xd2\x03I{\x98eq~|\x15\x90\x84\xf9^\x7fA\xfbɺ\xa1Y.\x8e7\x85\xc9ıG\x18\x93\xa7\x14\xea\x03\xe93\x15\x12|\x04g\f\xc60\x18\xe1\xefﲶ\xaa\x8b\xc48\xfa\xe4I\xdbq\xed\xa1\xe1v\x9a\xe2\x9d2vvֶMm9a\xdb=Y\x19q\x9al\xb5\xf3\xba\x8a}\xbf\x0f\xef\x04H\xfai\xc9$\x8d \xa7՝\r\xe4K+=m\xdc3\xe5\x1a\x92Ht\xf9Pڶ\xe8\b~\xea\xa9P$\xd4\xc3\xf3_\xb6\x12\x17\x82q\xbf\xd5\x19\xe5\xbb\a\xbb\xbb\xe5\x86B\xfd^-\xb3\xaf퍔(\xd5T\xf5\x8a\x8d\x8b\xac\x90\xb2a5~|\x15\x98\x8e\xaa_\xc7՜\xb4T\xf3\n̊}\xf2Z\xfd7\xb5\xda\xcbkS\\|\xd4\xfd\x10q\x1d\xe2\t\x0ed\xf7\b\xecBx\xe0u\x8am1[\xb5d\xd1\x10\xbc5\xea\xfa\xcaB\xb7\xd4\u05cc\x98\xa4\xa1^J6\x04\x0f\xbd\u0602*Ef\xb4\x04G\x8c\x1db\xb5j\b\x9e\x98Nc\xc6\xcb \xb6Q\x97\x88\x98\x85\x9f\x87\xe0aW:\xa6\xe7s\xa1\xf4\xb9\x90l\xc6x\x19\x9e\xc4\U00044117C\xf0\x1a\x9a#%\x04\xb6XЈ\x11M]\xb3\x197\x91\xd0s*\xaf\x99\xa2\x80\xbe\x1a\xb0\xa9\x0fS\xc2l\xd3x\xc9qPH\xd3\\5\x1c\xd6kX,n\u07bbB\xb4ue\xa8߇\x13J!\x14\v,\x12\x02\xe3\xd00ٖ\x9a\xf9vE\x05S00}L\x9b\xc9\xfa%\xb3\xad:\xb0\x8d\xf5\x81r\x01\xa0x\xc1c\xb5\xb3\xe1(\x99\xdf\rK\x19ן#K^j\x8a\x81ة\xe8\x1c\x831\xe2\x04Z\xbc\x15\xd7T\xbe&\x8a\xfa\x1d\xccX\xcem4>ϮZ8]\x7fT\xd4\x14\x02A\v W\x82E \xe9\x94J\xcaC\xc6g\xa65\x85\xf4TBB\x9aG\x9bJ\xb10o\x8d\x15\xcd\t\x8fb*w\x8a\xa9\xdc\xe9\xf4\xac0!Ee\xbd\xd3\xcab\xb6\x01)%\xe44\xa6\x9a\xae)\x8e\x9a,\xf2\xda\xdcrr\xca]\x9f̛O\xe1\u05cc\aY-Z3\x1d\x9b\x06\xc0k\xeb`\xcd\xe4\x05\xa4\xfaD\r\x06A\x90S\x9d\r\x83HdT]ۆ\xd5l\\Ǵ\xb2\xf1+\xd1\xf3`\xc1\xb8o\a\xee\xee\xe0\xd9`\xd0\x05\x15JJy`\a{\xb07țXV\xd6Ȑ\xdd\xc8\xdd\x1d\x1c\ueb71\xddh\x19\x1dU\x17\xd3)\x12\xf0\xf3|\xfa\xfb\x9d\x9e\x9f~*\x82k\x91\xe4\xa0-]\x03\x9e}\xacS\x13\xe6\xf6\xa8\x1b\xab\n\xcf\xeb\x82g\xe1M\x06i?\xa2\x9a\xbb\x86\xa9\x194\x9f\xcc\u0602\xf2\xe5\x84\xc8\xf1\xa0+\xa9b\x7f\x92ILǃ\xae\x16\"\xb6\xa3Z$\x06\x03ECx\x9c\x91\x19\xc0\x0f待\xaai%\xa7\xbe\xb5\xe6\x96K[2\x9c\xa2!\x1c\x1a\xaf\xdf\xe9ZW\xbb)\x9d1)\xa7C\x86qF\xa7\x12\x9f\xf1b\xa3\xb6\xee숷d5\x05\xb8\xf6\xa0Jx\x04>\x17\x1a^\xbc\x8ccq\xfdQQicq\a|\xfa\t\xfc\x98rxqb*\x93\x1f>'Tu`\xaf\x93\x0f\xbf\x8e\xd2c\xa5a8\x06Sx/\xc0à\x06\x9aM\x81~B\x9c\x00;_\xf0(\xcd\x18\x1e\x95aa\x9bf\xc9\xed\xad\xd5i\x99\xaa\r\x10M4\xf3yG\x13\xc5\x1a\xccu\x9d3\x7f\xa3\x0f\x99\xfelݞ\xe1\x9d]4,1\xbdO\xd5л\xbd]O\x063\x0f\xa7\xe7\xc04\xc4~7\xbba\xb5*\x8e\xfel7\xcejU3\xa1u\xe4)nǦ\xf8\x94\xce֜\x89Mqy]\x9e3c\xf9\xfa\x9c9;\x98ц\xa2\xdfclz\xfa\xee\xd0\xdc\x05\xcf\xc0\x9ay\xae\xb3\x84{\x1c\xefP0j\xa5z\x8cE\x88\x85A\xee\xd4\x1f\xa7\x8b-dZW§q\x10*\xe5{\xee\xf2\x1c\xaa\x9f\v^[\x84\xa6q\x80\x153\x1e}\x10\xa5\xeeeMA\xa7\xaa3[c\xf0=5\x17\xd7e\xf2+t\x83z\x11\x97Ǜ\xaf\xcfY\x95א\xcc|\xc1\xdaa\xe5\x1c\x01\xd4\xf5\x84\x1f>\xcc\xfcV\x89\x86\xab\x04\xd5c\xe7\xfb\xb0\xaeh\xb4\xe1N\x8f\xe3\xe7\xa0\xeb\xa6\xe5 ֥\xa7L\x14*\xa5\x90\xaf\x05ׄ\xf1\x16\xa1\x1c\x81Ǿ\xf7\x9dJ\b\x870&J\x8d\x1f\xcdi\x9c\xf4&\xb1\b/\xc1\x10\xea\xb9t\xdd\xfe\xf5\xe8\x1f\xdf\xf5\x11\xfa\x1f\x15%\x9e3\xf5\xab\xe9}o\xd6a\xff%\x8f\xa4`\xd1\xdd5\x9d\x1c\x9fܱ\xf7s\xc1\xe9\x1d{O\xa2;\xf6^Dw\xafb\x12^\xbe\xa2R~\xbe;zc\x89\xde\x1d'T\x12\xf8\x95q\xd6g\x81\xa6J\xfb\x9c\\\xb1\x19\xd1B\x1aݿ\x9cQ\xaekdr\x8d\xfa\x8dB\xe5gP\xbdB\xb1N\xb9\xda\x12\xb4{\xb9\x826O`\xdf\t\xee{s\x16E\x94\a\x13Ww+\xf8\x82\xbaMo1%]\x88+\xdaV2\xa8l\x8c9\x8bh\xfd\x05\x93J\xb5\xb3h\x91-\x15ԣwū\xa8\xebJh\x17N\x91\xcaYI'\xf9 \xdfiI\x00\x8c\xe6\xbb\xe6\x94\xd2\x195B\xdd\xe3xn\xce\x05\x98\xa1\x9b\x84\xf8\xfc\x93\xf2=\xfc\xb3\xecd\xf0\x9e\xdc\xf1\x0f\xc7CP\xe1\x9c.hOҘhvE1ܩ\xca\xe9\xcd\x10\xdcݵ\x1fN\ag\xa6\x1e\xd1\xf7\xd2\x1b#xn5\xafL\x1b=\x1b)Wu\xb1\x9cک\xad\x03\xd7A\xa2yJ\xb1(-|\x16\xfc\xefUg\xee\xf7\xe1h\xea.X/(HJ\x94\xe0pM=I\x81hs\x98A\vOoL\x97P\x13\xf4\x19\xd7L\xcf\xc5R\x03\xb1\xdaM\x88$\v\xaaq\xedl\xb1\x9a\xf1Y\xc3\xf5?s\xce\x0e\xc9RQ Y\r\x01\xb4\x80~\xb0Q\x11\xf5\x85\xf0Uc)\xf3\x93*\xb8\x87\xcb\xf2\x9e&0\xae\xf0P\x94\xc8p\x9e^`\xd8\xeb\x04*\x89\x99\xf6\xbdݼ\xcd\xd4W\x1cI\xb1\xd4X\xb5D\xf4V\x04\xeb\x8a)\xd1q]\a1qd\xe0\xe1\x18\xf6\xeb\xd6/\x14\\3^>ȯ\xaa\x84\x9cm^\xd6\x11q\xfe1\xa2xq\xf0\xe3oG\xaf\xc5\"\x11\x1c\xf7yr\xbaw\x16H\x9a\xc4$\xa4~\xff\x8f'\xfdY\x17\x1e\xc1\xa3\xce\x06\xb5\xb7\xc7.\xb3\aJ\xe7\xc0\x8a\x8bō-\xe3`At8\xf7\xfb\xff4\xf7\xeb\xbe\x1f\xfe\xd1\xff\xa3\x7f\xfa\xcf\xfeٓ~\xf58\xb5\x80\xef\xc1l\xc1\xa1cZ\xed\fd\r\xae\x82-Њ-\xd8\x17c\xf3\r\a\xd7\x13\xbfP=;\\I\xf7\xec\xf0\xf6W\xfdۚ\x1f\xc8\xdd\xddz\xad\xb9ƀoQ$\x18; w\x93C\xd9\xe6^\xc9*\x17\xe9\x85\n\xfcܛIa\x1a\xc46l\xbc\xc6<\xc0\xf7\xe6D\xf5L\xc8oB-d\a^'\xeb\x8e6\a('tMxʇ\xa6T|)\x12\x93l\xe2\xd95\xf2*\xde>\xd7V1R)*\xed\xb7\x1c\xf2t\x1f\a\xe4\x82ܔ\xbem\xe0j\x8c\xef\x8fO>\x94\xea\x7fK\xfcN\x81]\xb3\xe2\vd44?\xbbu\xd5\xf3\xe1\x17\xf5_\xd0B\x10\xdcjR5\xf5\x102'r\t\x8c\xc3\x16\b\xeb\x92c\xb2ԩz좙!\xf3\x15)sؿ\xc4\x03\xd5٦{ \x11\xc3\xf0nP\xd7FU\xb4\x9c)\x93\xaa\xf5nTĮ\x02\x12Em\xb6U\xc7:\xcce\xb1\x95̶\x8d_\x86\x18\xe0R\xe4\xd5|zy\xb6\x15\u07b6g\x9d*\xe6\xfa\xe8î\xb6Bp\x17\x02\xb6nܶ\xed\x92r1\xa5&\a0\xcaHCj\x93\x11aU\xd9\x06\xf7\xb4\x87\xa4\x80pS\f\a\xa5i\xd2\xd6\x18O\x83$\x8c\xa1\xc0\xabQ\xaaۦ\xde\xf9\x16\x17\xcdK\xf7y\x8a\xfb\xd3,ynw\x9a\xbdXܞ\x06\xe4\xc3\\\x8ak^\xa7\v\x12S\xa9}\xef\x8d%dJf\x19r\x8d4\xf7Y\x96U\xc5\xfd\xadvV\x1d\xff\xe2\x7f\x96T~\ueeaf\xf2\xa1\xc9=\xf8\xfa\xfck\x1f\xfb%ɘ*\xf5\xdf\xfa\xfe\xf7\xe0\xf9\xfeӃ\xf4\xfb\xdf\xfbO\x0f\xf6\x1f\f\xf6\xf6\x0e\xf6\xf6\xbe~\xff\xfb?\xf1\xbc\x98NB\x11\xe3\x86\xfe\xe6`r\xf8\xed\xb7\x7f\x1f\xed\xbc\x98\xa5#Q\xf4tr\xf0\xedh煾\u0380\x0e\xc2p:E\xa0y6D\xf0\xdfh\xe7\x85\xc0\x86N:zH\xf0\xdfh\xe7\x85\xf5\x03\xe7\x92D\f\xbf|\xf84\xb9\x19\xed\xec\x04R\xe0\x814\xea\x85Br*\x15\xf8/R\x80\xc3\xe4\xc6z\x9f\xde5\x9d\\2ݛ\b\x19Q\xd9K\xdf;\xc0\x11B,ğ-\xaf\x9bެr\xfc\xb1\xc8\xdf&D\x1d\x93\x9e\x16\t\xe2\x19\xb0\x91\x05*ʪEb)\xe7\xe8Y\xc0\x8d\x005\xec&Bk\xb1h\xe7haڙ6\u00a0B\xdc}\xe2\xf4\xcbd/\xcc:\xa6:(/\x97_\\U糱\xd203\x90C\xb0\xf8\xd5\xf1Tl\xeci\x13ٛ!\x01<BYx\xbc\xc3#/)\xcf\xfe\xdc\x1b\xfc\xadSC\xbd\xb7P\x7f\x91\xc2\x17`\x1b%\x0ea/\xb9\x01%b\x16\x95a\xf7\a\x7f\xeb\x14t\xc9ª&\xb1\x1c i/\x14\x8bd\xa9\xa9\xa9\x1cL\x19'18\"J\xc05u\xdd\xd49\xb9b|\x06\x84\x83\x9cM\x88\x85H\x890np_\x9f\x9c\x04\x95\xb9a\x12\xe5/\xd8M&\x19\xa2\xfb\xfb\x87\x87]X\xff\x18\x04{\x87\x9d\x0e\xceЈ\x9d3\x13\x89]\x81\x96y\xee\x95\xe7\xe9\x8a{](\r\xf4\x18G5\xbb\x99/\x88\x9c1\xee\x8cp\b{\x99u\ue6aae\x8a\x94K<\nf\x9b\x17\xe8\x1bz\x88\xff\xf2\a\xe6\xac\xeb\x93\xc3/ڴ\x9f\xba\xba\xfc\xa5\xfa\xc0\xacQ1\xd7\xc9/_\x1e\xa9\xaef\xe2\x1aC-Lg_\xc0s\xd6\xcaR_3\xad\xa9lᩯ\xbf\x80\xe9\x1a\xa9~\xa2Lϗ\x93.\x04\xc6\xcb\xf7\xec\x9fm\xf3\x9e\x7f\xc9\xc4\xe7\xad2\x18\xd6-<\xd7\x01\xe8~l\vx՜t\xd7\u0379\xc6Ķ4\xb3&1\xb61\xb7\xa6\xcaQ*U\xc5\x02\xb7\xb3\xc2\xedE\x9am#\x91\xfdIr\xc4܁q\b\xa6\x83\xb2\xc6NH\x84\xc5\xce!\xec\r\x92\x9b\x9c\x1b0s\xea\xc2\xee\xf0\x8a)\xa6\xf1&\xf9\xeep.\xae\n\x86n\x0f\x8b6\xc1\x98b\x1aR\xac\x0epݻ\xa6\xd6{MD\x1cռV\xecO:\x84\xbdg\xc9M\xf1e\"\x14\xc3c\xd1\x10\xd2Bv\xe9\xbd\x15\xba\xe7\x82\xf0\xa0\x8co\xae\x1b\fa\xff\xf9\xb7\xe57\xd6\xed\ra\x00\x83\xd2dW\x1bL\xb4.E\xa9\x0f\xbc\x15\x95[\xc7\xdb+i\xbe8Q2Q\"^\xea\xd2D\xed\x04\a\xc5A-\x92ʘ\x9b\xf1ӊ*\xe6n\x050\xb0\x94\xc8\xd0\x1b\xdd#1\x9b\xf1!\x84Xm\x97%{d5\xb6\x98[\xb6\x83\n\xaf<\xbf\xfa\xb7\xfd\xbe\x03\x80\xc5Ri0\x85\xd25M`\x1cL\x90\xa9Û\nib\xec\x15\x95\xd8\x7f\x8f\x9d\xcc\xeezϵ\x90\x97A\xd3\r4\xac\xc3\x0e\xf1\xff?@\xa3\tԂ\xa89\xe3\xb3\x05\x99\x91?\x19\xb7_y\xde\x1f\xec\x1d\xf4\a\x7f\xef\x0f\xbe\xed\xa7+\xd13\xd7\xe7\xb0\x16\x12\xf7R\xa6\xbd\x8ci/T\xaa\xff͛\x9b$&\xdc\xd4\x14v\xaa\x95\xa5\x8d˟\xb7H\xfc\x0f\x00\xaao7\x19H\x93=4\x1a\x0f>.\xa5\xa8y\x93\x06\xf7\xc1&\xf7R\xcd7\x8a\xe9Ei\xf2\xa3\x9df\x87TU\x8f#o\xe9bPwhMn\xc1(\xa0\xb7\x9f\xe5\xceN l\x18\x97\xc5\xc9qI\x15\x8fn\x00\x06`~\x1a\x02\xc5\xf6(\xdcZ\xd93\xb2\xf6\x82Y\xcdD\xb0\x10\x97N\xc0\xa2\x18\x02\xbd\x88\x91X\xccr\x18\vr\xd3s\x1b\xf6\xe0\xef\xd9>I\x91\xca_%,\xdf.\xcb\xefe\xa3\xa9o\xec\xd7c\xa6B΄I\xe5Ӑ\x98\xf7\xb1\xfb)\x9b\xfc\xb67\x860j\xd4P\x8d\v_}\xad\xf9|}\xbe>_\x9f\xafϿ\xe3\xf9\xbf\x01\x00\x066\x92<\x00T\x00\x00")
 	const prefix = "/assets/"
 	manager = assets.New(assetsFS, prefix)
 	App.SetAssetsManager(manager)
@@ -49,6 +49,10 @@ func init() {
 		"SignInTwitter":       SignInTwitterHandlerName,
 		"SiteName":            func() string { return SiteName },
 		"GoogleApp":           func() interface{} { return GoogleApp },
+		"SignInTwoFactor":     SignInTwoFactorHandlerName,
+		"TwoFactor":           TwoFactorHandlerName,
+		"VerifyEmail":         VerifyEmailHandlerName,
+		"SignInOAuth":         SignInOAuthHandlerName,
 	})
 	App.HandleOptions("^/sign-in/$", SignInHandler.Handler, SignInHandler.Options)
 	App.HandleOptions("^/sign-in/facebook/$", SignInFacebookHandler.Handler, SignInFacebookHandler.Options)
@@ -65,11 +69,15 @@ func init() {
 	App.HandleOptions("^/sign-out/$", SignOutHandler.Handler, SignOutHandler.Options)
 	App.HandleOptions("^/forgot/$", ForgotHandler.Handler, ForgotHandler.Options)
 	App.HandleOptions("^/reset/$", ResetHandler.Handler, ResetHandler.Options)
+	App.HandleOptions("^/verify-email/$", VerifyEmailHandler.Handler, VerifyEmailHandler.Options)
+	App.HandleOptions("^/sign-in/two-factor/$", SignInTwoFactorHandler.Handler, SignInTwoFactorHandler.Options)
+	App.HandleOptions("^/two-factor/$", TwoFactorHandler.Handler, TwoFactorHandler.Options)
+	App.HandleOptions("^/sign-in/oauth/(\\w+)/$", SignInOAuthHandler.Handler, SignInOAuthHandler.Options)
 	template.AddFuncs(template.FuncMap{
 		"__users_get_social": getSocial,
 		"user_image":         Image,
 	})
-	templatesFS := vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec[ks\xdb6\xd6\xf6g\xfd\n\x84\x93\xf7\x8d\xdd1iݵ\xe3\xc8J\xb2i\xbaqgg\x9b\xb1\x9dv:;;\x1eH\x84$\xd8\x14\xc1\x12\x90\x15M\xeb\xff\xbe\xe7\x00 ś\xac\\j;ْm,\n\x00\xc1\x03\xe2\\\x9e\xe7\x1cj*\xe2\x99P\xde\\-\x82\xbd\xfb:\x9a\xadf\xb3\xdf\xef\xee5\x9b\xcd֠\xd7\xcc~\xe2\xd1\xea\x0f\xfa{\xadv\xa7\xdd\xeb\xb5:\x83V\x1fƷz\xcd\xfe\x1ei\xee=\xc0\xb1\x94\x8a\xc6 \xca\x17/\xd2\x1c\xe9\xe77r\xfc\xfe;\xf1ٔ\x87\x8c8\x17\\\x05\xcc!\xb7\xb7Ц\x88\xf3\x83\xd6\f\xb2\x16˘DTʕ\x88\xfd\x17\xb6\x9b\x85>\x9c4\x86>\xbf!\x93\x00:O\x9cX\xac\x9cQ\x83\x90l\xdbD\x04\xee\xc2w\xfbĞ\x88\xe9T2\xe5v\xf4w\xb9p\xff\x96\x9c؎6\x91|\x16\xba\xcb\xc8\x05\xb5\\\xe8\xe9\xec\x84\xdc?q\x8c\xaaf\xbb\b\x01Y\xf8\x94x\xe7,T(\x10!\xb9\xe6S\xf9fAy\x90\xed\x81\xf9\xa2\x11tG1\x0fՔ\xec\xc3B\u007fa\xcfn\x18\x918\x05\r\t\xd3W(A\xfe\xef\xc6#\xef\x02F%;$S\x11\x04bEԜ\x11\x1eJ\x15/'\x8a\x8bP\xc2\x17\xc2\x15\x0e\x8e\x19ȟ\u007fV\x9es@\xbc\xf7\x92\xc5^\"\xc4\xf0(\x1aeEd\x81d\x9f'\x1b\nB}\x1f\xee*\xe1\xd63.\x15\x8b\x99\xff\xe7J\x8d\u007fB\xba`U\x82\x9b\xedߺ\x90\xe1\xbc;ڡD\xc3#\x18\xd3ȯ\x1b\xc6\xff*\x96ς\x00\x04\x9b0\x0e\vO\u05fc\xe2jN(\txx\x8d\x82\xa3ؔ\x84l\x95N\xea\x14\xc5\x1c\xa2\x9e\x90\x05Ss\x01\xba\x13\t\xa9\x1cB\xf5\x038q\xe0^1\xbba1H\xfd\xd2Jx{\xeb\x8c2\x1b\x01#<\xd3\x03\u007f\x17\xde\x19\xac\x98Ņ\xbd\x1a/\x95\x12a\xa2\xebKx\\ҕ\xcb\xf1\x02\x9e\xedX\x85\xf8υ\x9d\\\xd0x\xed\xd8՝\xeb^#\xaa\xb9:#\xef\x11\n\xbc\xed1\x0f\x8f\xc0\n\xb4y\x99\x13\xfb\xf1\xe5\xf6\xcf'\xa0\x11\xf7\xeb\xfew\xf9\u007f\xec6\xfe\xbf\xdf\xef\xb7:=\xf4\xff\x9df\xbb\xf6\xff\x0f\xec\xff\xcfń\xd3\xe0\x14\x14Bk(O4{Jɔ\xbah\x11\xdaJ\x86G|\xb4Qν\xfa\xf8\xa6\x8f+\xe9Nh\x10\x8c\xe9\xe4\xfa\u07bc\xc0.\xfb\xef\xb6;h\xff\xdd6 \xc0n\xab\r\xe3\u06ddN\xb7\xb6\xff\x87\xb1\xff\xa3\xef \xac\xb0\x0f\n\fZ\x1e\x93P\x84\xac\xf1\xdd\x11\x82\xbb'\xdf\xff\xf4\xfa\xe2\xd7wo\b\xea\x05\x84\x1c\xf3\x81\x81O\xf8k}\"'1\x8f\x00I\xac#v\xe2(\x98\xe3\xe8\x8a\xdeP\xd3jéEb\xef\xe8:\x104\x83\x1aV<\xf4\xc5\xca\x13\x11\vY\xfcot.\xaf\xad\x1a\u0098\xff\xecc\xc3暃\xe7\x8drH\xf4Y\xc0\x14\xdb1\x91\xb9Ў\x99\x04B\xb2}=\xd9\xf0\xc8HiB\xaaY\x10@\x12\xbd¿\x94\xfdk\b\xf8\xb8\xf1\xbf\xdd췋\xfc\xafӪ\xf9\xdf#\xf3\xbf\xb329xL\xf6\xa7\x15\xb5\x92\xfc\xbd\xf9\x10qd?\x95\x04\xe4\x8c\xfd\xb6dR\x919\x95\xe0\xe4\xf4\xc0;\xe8\xc7Ŝ\xcbt\xb5\x96\x1e\xc5\xe5\x19\x0eI\xa4)V\xdag\xb8\b\xb8N\xaf\xc0C\x12r\x04r\x86\xc02\xbc\x9fi\xc0wH\n\x12\xe0\xd0\x1b\x1c\xf9\x99\xa2f\xa7\xf0\xc8\xe9\x14w\x11v\x85O\xae\x91$\x02\x1d\xd4<j\x1a\x8b\x85\xd9_ñ`\x00\xf0\xccC\xa2\xe25\xecL\xb4\xe6\xe1\f\b\x98\x8f7Qx\xce\xd5\x1d\xab\xf3\xbe\x87\xd5W/\f{\x9e\xdc\xcd\xf96*\xa6\x1f\U000d8c50,#\x9f*\x90\x17E\x00)\x91\a\xebvT\x12h\xe6\xe16i*\x858Ot\xb9L\x1asB}$k4\xc6Q \x8dC\x1eFKE\x904õ\x8e\x8d\x8bs\xee\xfb\f 5\xecƒ\xe9Y2\x91\xadD:\xdfY\xd1\xfeT\xdaɾ.\xceY\x1f_Y\xfc\xbfL\xb3?\xea\x83zx\xfc\xdfkv1\xfew\xfa\x83~\xb7\xd3F\xfc\xdfi\xb5zu\xfc\u007f@\xfc_\xc5\x00\xc0\x1b\x8cٌ\x87*\xa6\xa1D\xa7\xf0\x96\x1f6\x1a\xaf\xb6\x05\x1dp%\xc6\xc3\xd2\xc9D,1a\xa9С\xbc<\xe7\x8a\xfd\xcb\xe4\x11ɊJ\x9d\xdb\vU\xb0N\x92|\xbeװ\x11\xca\xe7~\xf8\f\xa2W\xc8\x15W\xe0\xfb!Tq\x99̟\xc6\\p\xff\"\xb6}:py\x8d\xc6EUJ\xf0\x90 )\xd0\xf1\xcedB1\x88\xe9\xc8\xc7C#\xe9\x18`\v\xf8\xcf\xe3F\x03]\xef\xfb\xb3\u007f\xe2*\x1bglFc_\x1e6.\xe0\xca\xe2\n.\x18]4\x8c\x9fL\x9f˷l\xff\x1ar\xf1P\x03\xab\xfb\xa2\x01;\xec\xbf\xdb6\xf6߅\xff[\xf0\x1f\xf2\xff\xee\xa0\xce\xff=\xa4\xfd\xf3p\x12,}v\x9c\x83\xe0Z\x1f\x0e\xc9&E|H\xa4\xce\x11\xba\x06H\xe86\x9b,\xc8s\x01b\xd5j\x1b'h\xb5\x13x?\xef&]\x89&*MC\nu\x90s\xe8$\xa7\xa1\xaepH\xe7 g\x93\x1b\x04\a\xd7<\xfd\x01Q\xed\xf1\t\xf1\xf4\x89\x852\xd8\xf1\n]\x00\x165p.\x98\n\xc7\x14\xdb6\xc3u\xc1\xc13)\xd1\v\xc0qr\x83²\xeb\xb1\xcf\xc3\xcan\b\xc9\xd3\xf2\xac\x04aZf\x94AYN\x0ey\x817\x991\x9dc\xcdCB\x98\x91\xfdF<\xbdX\xe7\x1fB\xcc\fI\xcb\f2\"\x8d\x86O\\\x97\xcch\xc4=\xbc\x11\xe0\xe3\xd8\xc0G\xf0\x85\xd7\x12\xc8\t\xb8\\\xc0\x8d\xe3@L\xae\x01(\xb3\x05xaI\\w\x94\x9b\t\xe6\xa2\xc9\xe2f\xf6f\x80é+\x81\x12\x18\xf8z%\xc0\u007f\xbe4\x92\x9cc\xab$\x0eA\x91\x9c\xc2L\xc4\\ix\x05r8t\xa6\xe6\xbaWQ\xe4\x9d\xeag`\xc6\x00\xa9\x02b5Q˘\x1bؽ`R\xd2\x19\xdb2%\x84\x18\xe87\xf8\x1a\xd8#8\xf5D̉\x10לE\x02\xc8\xce\x1au*\x84\x9b]\xcea\xc2K\x11s\bf\xdbd\xb49\xa3\x13\xe7\xf2RC\xea\x9fB\xbb@\xcd6N+\xae\x9b\xc7l\x9a'\x05f\xbb\xcdu\x86)k-zj5\xf1\x05\x12\xae\x13\x9bG\xcf*Aiꡌh\x8a\xf0\xd1\xfc\f\x92g\x8b(\xc0\xb0\x98\xcb\xd4\xdb]r\xa3`)\r\xcaǋG\xa59\xab\xedI\v\xa8-j\x9bj!\xfc\xa7\xf9\xe9Rbpg-S7\x17e\xfe\xbbv\x1cN\x85\x9a\xe7j\x8a\xe5\x96\xcc=\xf3]6\t\xb0Œ\v֚\tv\x19\xe3Ks\f[\xfa\xabi\xa1֛+\xe9V\xd1\xc3\x1f\xcfS1\xaa\xf9\xe3\xa6{T|d\x9e\xe9\xdbJ\x00?\x8f\x02\x9a\xfd\xae\xa6\x81\xc5\xc7x\xa6+\xca1E\xb1+\xd4!\xab\x9c\xc9\x13\x9b\xb3 r6|\xff\x19\xa6KL\xfd֢\xc1\x17\x15\x8a\x95\xf75d&\\\x1b}\xd2U\xf0p*\xf4\xc9\a\xd0\xedj\x83{\x1feL\xcd\xdbajF\xc0\xd71C\x95\x14!3σ\x8e\x88\xe9\x00\xbf&\x19X\x95/\x9fT\xdaAٲʊ\xbbyD\xa8Qfo\xec\x9b\v\x88=\x9d\x11\xac\xb9\xbc\x92L\x15zgᜎʒ\x14\x99|\xc1D\xf3\x1anû\xbb\x8ai\x14\xb1\xd8!\xe0I\x03\xf0\xa4>\x97`\xac\xebcd\x00\xc5\xc4\xc4ƐK\b\xa1`\u0379[o1\xdb:\xa3\xf0\xb5\xe1\xff\x85\xf0ipO\x04`\xd7\xfb_\xcd\xfe\xa0\x90\xffo\xf7;u\xfe\xff\xf1\xea\u007f)\x1f\x90ǤD\x103\x80?\x1b3\xb5\x029\x89;\xd7\xdfȔ\xfa\xacD\x01t\x97\xeb\x03\x18\x10\xb3l\x92?\xd7\rN\x18\x04R\xa9\x17*\x8f\x983\x98<\xceFq\x1b\x15\r*\x1c[\xa0\x91\xf0\x0e,\xfd٘\rnn\xc1Ӊ >ǜ\xba&K{\xe2\xa8x\t\"\xff\xbf\xe2\x00@\x9fWdL7|ň\xf1\x05l\xa5\xe8*KKĲd\x9e#\x14\xbcpv[r^83\xb19\xd5\xdc\xe0\xc8\xcb=\\\x8b\xfd+\x06\x98\xcd\xd1\xfd\xe5^\xdd\\;\xce\xff-\xff\u007f\xaf\x15\xe0]\xf9\xdfN\xbfUz\xff\xb7۬\xfd\xff\xc3\xe7\u007f\xb6\xfb\xfb\xedu\xe2\f\xb5\xf8\xe4\xdap\xb7P\x1b\xee&%\xe1~\xa16\xdc!\x15\xdc\xec#\x1db\r6w\xd9\u007f\x16\xcd?\x82\xfd\x0fz\xbd\"\xfe\xeb\xb5k\xfc\xf7 G%34\xe6jj\xe7\x19X\xf3#&\xfe\xb6\x02\x9a\xcfˑh\xfa\xbe=Gb\xbaSs\xf7L[En\xe43r\"\xb8\x9crBdgz\xe3U\x10\x03\xf4\\W&8\xaa\x13\x1a<\xfc\x94\x84\x86N\v}ZB#\x97\xddɦ\a\x92\xb4\xc0v\xdfg\xb7\xfdq\xe3\u007f\xc9\xfe[=\x80\x04\xb5\xfd\u007f\r\xf5\x9f]\xe1?\xb1\xa2{\x8f\xfd\xf9\xf7\xc2>2%U\xc7\xfe]\xf1\xbfT\xd0{\x84\xfcOk`\xeb\xbf\xe0\tz\x1d\x8c\xff\x83^\x1d\xff\x1f\xc5\xfe7\xc5^c\xf8\x8d\xd2\x0fD\x92\x12\x8e\xb6\xaf\xad%\x9e\x9f9[9d\xdfV\xd3.gL]\x1aU#\xde\x011\x0eź\x8bmw03\xa0?Ic\xaa~\xbb\x1bO-\xf4\xb0\x00#\x12\xd12\xb2o\xd6\xe1\xa9-En\xfa\xdc\x15\xf7\xd5<3\xe2\x17\xfc^\x98\x00\x82<\x9f\xcdUf\xd4[ݐ\x9dͼڇ\x03\x12\t\x1a\x15\x85@\xef-\r\xfd\x80\xc5vL\x12\xcb\xf7ohL\x1c\f\xe8\xce\xc1]\xe5\xc0O\xab\xffy\xf8\x91B\xb1$\xf0\xef\xaa\xf6%\xf27tq\xaf\xfe9\xcf_\xf60@y.\xc4\xfd\xfd\xfcg'\xfekw\x8b\xef\xff\xb7ۃ\x1a\xff=\xa4\xff\xd7\x15@\xf9\xc7x\x19\xfat\x1c@\x1c\xd0z\xe1A\x9b\xc4^\xfd[\x99l\xf7\xbe\u9fd2\a)>\xd4\xce\xe5%\x96\xe0\xd1\x13\xdd\xfd\xd3 \xf4\x84\x97&6\x90\x13pV\xde\x1fWR\x84\xb7\xb7\xcf\xd3\xdf\xe5\xd4.\xa9>\xea\xa3>\xea\xe3~\x8f\xff\x06\x00\x00\xff\xff\x14\x92\x82\x9d\x00F\x00\x00")
+	templatesFS := vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec\\{o۸\x96\xcf\xdf\xfe\x14\xa7\xc2̶\xb9\x88\x15\xbf\xb3\xc88\x9ev;\xed\x9d\\\\\xec\x14I:\x17\x83\xc5\"`\xa4c\x9b\x8dL\xea\x92t\\Ó\xef\xbe\xe0C\xb2\x9eq\x92&\xce̎\x05\xb4\x91E\x8a:$\xcf\xebw\x0e\xc91\x17\x13\xae\xfc\xa9\x9aE{\xcfu\xb5ڭ\xd6`\xd0\xdbk٫\xf8\xb7=8\x1a\xec\xb5;\xddN\xbf\xdf\xee\x1e\xb5\a{\xadv\xbb\xd7\xef\xedAko\v\xd7\\*\"\xf6Z\xdf\xfc\xadb\xe7\xfe$\xd7j\x05!\x8e)C\xf0.\xa8\x8aЃ\xdb\xdb\xd5\n\x14x\x1f\rg\xc0\x92\xcf\x05\xc4D\xca\x05\x17Ꮾ\x18Y\b\xb7\xb7\x8daHo \x88\x88\x94'\x9e\xe0\vo\xd4\x00\xc8>\vxԜ\x85\xcd\x01\xb8\x1b>\x1eKTͮ\xf9-g\xcd\xffLn\\A\a$\x9d\xb0\xe6<n\x8e\xb9\x98\x99\xe6\\\x834<\xf1,\xabf\x8b\x00V+\xa0c\xf0ϑ)M\x10@\xee\xf1\xa9\xfc0#4ʖ\x00\f\xe3\xd1j\x05\xb1\xa0L\x8d\xe1\x8d\x02\xef_\xf8\xfa\x06A\xea&\b\x034o(\x0e\xdf\xdf\xf8\xf0)B\"\xf1\x00\xc6<\x8a\xf8\x02\xd4\x14\x812\xa9\xc4<P\x943\t\x94\x01U\xba\xb2@\x89\x85\xb1\xf2\xbd}\xf0?K\x14~B\xc4\xf00\x1eeI\xc4H\xe2\xe3hӄ\x900\x14(%\b\x9cP\xa9P`\xf8\xb4T\xeb\xff\x18\x99a\x15\xe1v\xfak;2\x9c\xf6F\x1b\x98hx8\xed\x8d\x1a\xf9~+\xf0~\xe3\xf3\xd7Q\x04\x02\x03\xa47\xb8\xee\xf3\x82\xaa)\x10\x88(\xbbքk\xb2\t0\\\xa4\x8dzE2\x87\x9aO`\x86j\xca\xc3\x13/\xe6Ry@\xcc\x00\x9cx\xab\x15\b\xbcA!\x11\xde:\noo\xbdQf\"V+\xf0m\xc9G.f\xfe\x19\xb2\x10Ea\xae\xae\xe6Jq\x96\xf0\xfa\\\xa2\x90M9\xbf\x9aQ\x05W\x8a\xe9\x7f\xcdX\xd0\x19\x11K\xcf\xf5\xeeܔZR\xed\xdb\x19z\x0f5\xc1u\xc3<<\f\xe9ͨ\x91\u07b8?\xdf,\xfe{4\xe0L>\xaf\xfaߤ\xffu\xb1\xd5\xff\x83\xc1\xa0\xdd\xedk\xfd\xdf\xe9\xb7v\xfa\x7f\xcb\xfa\xff\x9c\a\x94D\xa7\x01g\x86Ci\xc2\xd9c\x02c\xd2\xd4\x12a\xa4dxHGk\xe6\xdc\xdb]\x7f\xea\xeb\x8bl\x06$\x8a\xaeHp\xfdlZ`\x93\xfc\xf7:]-\xff\xbdN\xa7\xdf\xef\xb5;{\xadv\xa7\xdb\xea\xec\xe4\x7f;\xf2\x7f\xf8\xb7\x06\x00~U\xc8By\f\x8c3l\xfc\xedP;w\xaf~\xfa\xe5\xfd\xc5o\x9f>\x80\xe6\x8bQch\xffh\xc3\xc7å\xb9\x91\x81\xa0\xb1\x02\xb5\x8c\xf1\xc4S\xf8U\x1d~!7\xc4>u\xe6\xd4yb\x9f\xc82\xe2$\xe35,(\v\xf9\xc2\xe712\x14\xff\xa3\x95\xcb{ǆp{\xfb\xbfoV\xab\xec;\xfb?4\xca&1\xc4\b\x15nhȾ\xe8\xea\x04\x11\x97\xf8\xc646<\xb4TZ\x93j;4<\xb4=\xfc+\x89\xff\x9eq\x01_\xd6\xfewZ\x83N\x11\xffu\x06;\xfc\xb7m\xfb_\xc0\x7fgep\xf0\x92\xe8\xcf0j%\xf8\xfb\xf05\xa6\x02S\xbd\x90\a g\xf8\xef9J\x05S\"\x01m\xc5;\xe0\xc7Ŕʴ\xb7\x0e\x1e\x89r\v\a\x10\x1b\x88\x95\x96Y,\xc2\x19\xfa\x05\x1c\x92\x80#:\x06\xc6\x15\xf8\xbf\x92\x88n\xa0\x94JS\xf5F\xd7|$\xa9\xd9&|8\x1d\xebY\x84 \xa2\xc1\xb5\x06\x89S\xb48j,\xf8\xccί\xc5XAD\x91\xa9\x03Pb\t\x01\x8f\x97\x94M\x80\xb0P\x7fD\xe9{\xaa\xee\xe8\x9d\xff\x13g5\x18P\x97\xbc\xba\x1b\xf3\xadY\xcc\f\xf3\x15\"\x83y\x1c\x12\x85\xa1!a\xc9\xe7\x1a\a\x9b\xe7\x9aI0\x04\xcaꨩ$\xe2<\xe1\xe52h\xcc\x11uO\xd4h\x85\xa3\x00\x1a\x87\x94\xc5s\x05\x1a4\x9fx\xb1\xe7\xec┆!2O\xcf\xc6\x1cO\xbc\xbce+\x81\xceO\x8e\xb4'\x85\x9d\xf8\xc7\u009c\xbb\xeb\x0ff\xff/\xd3\xe8\x8f\xfa\xaa\xb6n\xff[\xfdVO\xdb\xff\xee\xe0h\xd0\xebv\xb4\xff\xdfm\r\xba;\xfb\xbfE\xff\xbf\n\x01\xacVp\x85\x13ʔ Lj\xa5\xf03=h4\xde\xd5\x19\x9d1\x17VÒ \xe0s\x1d\xb0TZ\xa1\xbc=\xa7\n\xff\xdb\xc6\x11aA\xa4\x89\xed1\x15-\x93 _\xe87\x9c\x85\ni\xc8^+\xa0\x8c*\xaa\x88BP\xdaƹ\xf6S\x9bK'\x8c\vWf\f\x97\xdfh\\T\x85\x04\x0f@\x83\x02c\xefl$T\x1b1c\xf9(\xb3\x94^\t\xbe\x90(\x8e\x1b\r\xadz?\x9f\xfdS\xf7\xb2q\x86\x13\"Byи\x98b\xa9\a\x17Hf\r\xab'\xd3q\xf9\x13\x8b\xff\x9eq\xb9(3\x8e\xd5s\xc1\x80\r\xf2\xdf;\xea\xb6\xf7\xda\xfd\x8e\x11\xfe^\xd7\xe0\xff^\x7f\x87\xff\xb7)\xff\x94\x05\xd1<\xc4\xe3\x9c\vn\xf8\xe1\x00\xd6!\xe2\x03\x90&Fش\x8e\x84y\xe6\x82\x05y,\x00\x8e\xad\xea0A\xbb\x93\xb8\xf7\xd3^R\x94p\xa220\xa4\x90\a9\xa7\x13\x06\xa7\xccd8\xa4\xb7\x9f\x93ɵ\a\xb7Z\xc1w\x1f\xb5W{|\x02\xbe\xb9\xb9\xbdM\v\xdei\x15\xa0\x93\x1a\xba\xadSf\xea\x14\x9f\xad\xab\xd31p\x01\xbe\x8d\x89^,c\x94\xe0\xff\xf2n\xae\xa6\xef\xe2X&\xf5\xf2}sc\xe3\xfaa\xdb\xf8\xae\xfc\x05\xd0.[\xa6\x96\xf5\xb8\xbc\x9c\x17&\b\x9b`\xfe\xeb9'\xd06\x8e\xff\x06ߌ\x81\xf7w\xce'\x16\xbbe*Y\xeaF\xc3W\xcd&LHL}\xfdM\xca|a\xbd\xca\x05\x17\xd7\x128\x8b\x96\xc0\x19\\E<\xb8\x06\x8cp\x86LIh6G\xb9\x96\x00\x86$\xe9\xe7\xc4},$\x8a4e\xc0c\xeb\xd5~\xe1\x94\xc1[Kɹ~*\xc1\x03M\x92Wh\t\xec\x9b\x16nhh\xb7Z%ｋc\xff\xd4\f\x87\xad#0\xa4\x02\x035\x17\xd4z\xe33\x94\x92L\xb0\xa6I\x12\x04(\xa5u\xbb\xf9x\x1cQ\x96\x90\x19p~M1\xe6\x11\r\x96\x9a\xd5\xd8$\xc2\xcb)\x97\xea\x92\v:\xa1\xac\x8eF\x17J:\xf1./\x8d\xa7\xfd\vs\x1d4 \xe4\xb4⽩\xc0q\x1e+ؙ\xb7\xefY\x00m\xb2Y\xdf9\x06\xfdQ\xe3\xb0\x13\x17^\xcf\xf2C\xa9題I\xea\xf8k\xa9\xb4\x0e>\xce∨B\x00\xdf\xcdR3\x8e\xe6\xd2:\xff\xfa\xe5Q\xa9\xcdj13\x04\x1aA\xabc-\x8d\nH\xbe\xb9\x14/ܙ\xe2\x04\xa8\xa0\xf9\xbf\x8c>\xf1\xc0\xcf\xd7-\xa6\x1ak\x9e8i\xa9\x10\xcf<\xe3r2WS0\xff\x9bt\x86\xd3\x1f^휙\x16\xd3z\xf7\x9e\xb8\xc7Ι\xaf\xff\xa4J\xad<_\x9b\xe6\xca7\x11\x9cBﳳT\x1c\xbď\xe5\x8b\\d\xa5F=\x16\xd4^ƃ\xc8t>\r\xdcԔWcm#u_d\xb3\ns\xff\xe3<%\xa3\x1a\x94\xaf\x8bK\xe3\xe6۲ZT\xfd8\\mg\xa0\x1a[\x17\x87\xf1̤\xe9\x05\xd1dW\bS\x96M\x92\x11\x9bb\x14{\xeb \xcak\x1d\x83\xb2Iq\xe7b\xffX!\x96yM\r\x13\xdet&=\xed\x05ecnn\xbe\xca:\xd6\xff\x1cg\xf8\xdd\xdf\xc0\xef\x96\xc0\xf7\x025Cs\x86v<\xc8\bl\x01e 1\xe0,\x94\xaf*\xb5H%\x9f\x17\x84|=D4L\xe6\xc6-\a\xd1\x0e\xbd7\x1a\x92\x8a\x9edR\xfb\x1bW#\x90Q\x99\x92bx\xa4\xa0\xe0\xf2\x1c\xee|\xa6\xe6B\x908F\xe1\x81T\xcb\bO\xbc\x90\xca8\"\xcbc\r\xab\x8aў\xb5\x1a(\xb9]\x05]\x98\xfbt\x8d\xd8>*L\x93\xf0ڌ\x87$z&\x00\xb0i\xfdWkpT\x88\xffw\x06\xad]\xfc\x7f\x9b\xfe\x7f\x1e\xfd\xa7x@\x1eC\t f\x1c\xfe\xacz7\f\xe4%\x9a\xc7\xfc\x821\t\xb1\x04\x01LQ3\xa4$\xe2\x93l\x90?W\x1cp\xa6\x90\xa9T`\xca5\xa6HB\x14Y\x83\xe3\x14\xb8u\xff\xae\x9cG\x91\xe0\x0e\x9d\xfas\xe6%\xa4rFӆ< \x82\x92\xa6\x8dҞxJ\xcc\xd1\x1b\xfd\x87\xa23\x94?TDL\xd7xŒ\xf1\rh\xa5(ե.\xea\xb4d\x1e\x17\x14\x14\x06e5\n#\xd3\xf0\xf00\x05\x01\x87~np\x9d\x93_Q\xc1N\x8e)/\x97\x9a\xc7{\xbb\xeb\xffǕ\xa2\xee\x05o\x8eI\xa0\xb8xz#\xb0I\xffw\x06\xad$\xfe\xd3\xea\x0e\x8et\xfc\xb7\x7f\xb4\x8b\xffnI\xff\xd7\xe5\x7f/\x16\x1c>\x1a\x8e\x00\r\x834V\x0f\x8c\xf7\xfa\xa8,p\xaf\x90\x05\xee%\xc9\xdfA!\v܅\n\xc0P\xb26kv\xcdV\xcb&\xfc\xee$?\xa7\x84\x93<\xe4\a\xa6P\x98pq\xc0C̤G\xc9\xfa}.\x80\xc4q\x9aS\r3^\xb9\x89=h\x95OՁ)\f\bӱ\x1e\xed\x12\x03\x1fۦ\x04\x06\xfc\x06M\x825DY\xcc`\xde7\xfbh\xc1\xccł\xbb\x0e\x16\xc3G9\xb7=\x97\x95ԝ\xba#1\x99\xf8\xcae\x8c\xed\xd7@\xa7G\xc0\xa6_Q\xd0\xf1\xb2\n5e\x1d\xee]\xf6q\x9b\xfa\xffYW\x00m\xca\xffu\a\xed\xe2\xfa\x9f~k\xb0\xd3\xff[\xf4\xff7\xfa\xfb\xf5v\"\x13\x05ٲU\xb8\xa7C\xbcS \x9b\xe4?\x1bxx\x01\xf9?\xea\xf7\x8b\xf8\xbfw\xb4\xc3\xff[\xb9*\x83XV\\\xa7\xbd\x02\xac\xfd\x87\xce\xf0\xd4\x02\xdaǅsM\xa4\xb1\xde˱ţF6\x8c\xfb9\xae\xf0E\x1e\xe1\x87\xe8\ue53d\x90\x8d\x91\xd8w\x91@\x12.+c\xb1ձW\xca\x1e\x12{5\x11\xec\x87\xc5^s\x81\xe8l$3q\xa8\xeau\x9f\x9b\xf6\x97\xb5\xff%\xf9o\xf7;G;\xf9߾\xfd\xaf\xc8\xffo2\xff\x89\x14=\xbb\xedϯ\v\xbeg\xf4|g\xfb7\\\xe5\x05\x1d[\x97\xffv\xab}d\xf7\xfft\xbb\xfd~\xbf\xab\xed\xffQgg\xff_D\xfe\u05cb}\xac\xe07J\x1b\x04\x93\\\xbd\x91\xaf\xda\\\xfe\xaf\x14\x17\x1e\xbcq\xcb&.'\xa8.-\xab\x81\xbf\x0fV\xa18uQ\xf7\x05ۂ\xd6'\xa9M5\xbb{\xf4m\x9a\xb97\x0eF\xcc\xe3y\xecVV\xeb[\xb7\xe6d]\xd6\\\xd0PM35\xfe\xa5\x7f\x17\x1ahN\x91N\xa6*S\xebg\xf3 ۚ\r\xa2d\xd7\x0e4*V|\xf8?\x13\x16F(\n\xeb\x06\xde\xdc\x10\x01\x9e6\xe8\xde\xfe\xdd\xcb\a\xbe}\xd1\xc0ƥ\x02\ue346Y\x1f\xb0\xdb\xce\xf9\x97\xbd\x9e3\xee\x7fO\xfd\xdf\xeb\xa6\xeb?\xfb\xddޑ\xc1\x7f\x9d\xden\xfd\xe7\x96\xf4\xffv\xe2\xff\x0f\xd8\x05V\x1d\xdbO\x81\xea\x13\x86\xfeS\x94u\xe6\xc2\xf2\xefuT>\x17\xde\xce\xf4\x84D(\x14\x98\xff\x9b\v\"\x18e\x93\xfcz\xa6\xf5\xd6,\x94\bD`e\xcc\x1f>\x90`j\x92\x02:Ap\x85:G\x10\x02g\x01\x9a%\xf4v\x91\xe6\xc4\x1c\xd2a\x93\f:u\x9dI/ԥ$Ε]\x16\x8f3\xfd.\x01I\xc6\bqD\x02<\x00Iu\xf3j\x8aKX\x98\x94\xc5\x15\x82\x9c\xf2\x05\x032!\xe5\x9dT\xa67\xf3(\x9dVׁ\xa6\xe9@yq\x95[\x7f\xa7ۈ\xe8h\xa8k\x8d\x9c]\x1b\x1e\x9a_\xc3È\x8e\xaa\x96\xf5\f\x0f\xe7Q\xcd\x02\x9b\x8asF\xccn?F\xae\xa2\xc2n\xbf83\xf1\x965\xb2\x03\xa4\x17\\\xe9\xbd\x02\xee\xc5\xe2>\x85r\xe7\v\xa7\xb00\xb3E\xcd\xe2\xfd\xef\xc3\xfctB\x84c\xddB}\r\x99TI\x99\xec\x9f8V\xfb\xf9\x9f\xd5\x14\xa49)\xb21#\xa5\x19c\xa2w@k\xff@o\x82(\xd0\xc0\x85\xae\x11R\xa9\xc7\x00T\xdd8\xf9\x0f=F\xc5\x7f\x17\xb8\x05mUǧ|\xfb\x0e6\x97\xb6\x12\x98t.MW\xb5\x93\xe0\xc7\xdf\xeb\xbb]\xb72ϲ\x92ِ\xa9w]\x16w\x8fޗ\xc8P\xf3\xbdHht\x83[&𧤠\x96\x98\x82X\xe4םU\xac\xa2u\xa2PM\xfb\xfd\xa4A$\xefn\x16\x87\n\x02\x93O\x9ck\x15V\xb1\xc1\xc6h\xd5\x1aN}ÅݘC\x15p\xb7\x11'\x9er\x86\xfbf\xab\xa7\xae\x0e\x98O\xc5Re\xb4\x95Q\x7f\xc8\x1e\xcc\xc2\xf1h\r!2\xd6c.h&\x06\xe7\x7f>;MBj\xee\xde\xc6Ҫ\xc4\xf2ԥsu\x7f\x02\xa2\x15\xaa\xd4#\xa13\xbf\t\xe9T\xc25.aF\u061cD\xd1\xf2؆\aMwʤH\f\x04*\xfb\xedss\x9f՝O!\x8eO\x9d\x12~B\x19OU]\xbd\x94\x94%b\xb7M\xf5\xa9\xdc_=3Sί_\xcc\xffoig\xbf\x90\xff\xe9tw\xf1\xdfm\xc6\x7f\xccbe\xf9\xfb՜\x85Z\n\x8f\xcd&%\xe9G(\xa5.5g\xa5d\x8b\xdf\xd8\xf2/r?\x8d\x0f\x1b\r\xf3V\xef\x16\xd0\"|\xf7\xd10:\x12ricCp\x02\xab\x95\xff\xfb\x17\xc9\xd9\xed\xed\x0f\xe9\xb9,\xbb\x90\xc4v\xae\x1b\xb3\x18\xa7i7ҾL\xfe\xb7\xd3m\xf7\x1d\xfe\x1f\xb4Zݶ\x96\xff]\xfe\xe7\xc5\xf1\xbf]\xa6\x95= ĝ8\xf9\x92\xa7\xc0d\xd9u\xd3!\xa0뀀=\x80S\x9f\xa2Yw\fɣ\x8f\x03M\xf6\x93\xdb\x035o\xea\xc6\xec\xce\xc3@\xb3Ǩ\xdcq@̝\xe7\xa8d:\xf0[\xe9\xeb\xf0\xfd\xcd\xfa\\\x15C#\xc5\xf0^$\x95AG\xc5i;\x0f>o\xa7x\x8c\x8d%\xd6\x12\xe6 \x8a\x19\xd7L#հ\xa4|\x80\xea\x03\x8fӹ/%\xdb:Q\xa7f\x87#\x1d\xa7\x96u\xc3\x06\xba\xaa\x85\x14V\x92\x93Y\xae\xd8\x1c\xf7\xc4\xdb\xe24\xfd\xf6(\x86\xdc@Z\xa1\xadA\xc1w\x1fE\xb3\xf3\xf9\x9f\xd7\xfe_Z\xfb\xff,\xa7\xbf\xdc\xe3\xfc\xc7A7=\xff\xa150\xf6\xbf7\xd8\xd9\xffm\xfa\xff\xf7?\xff%\xb1\x81\x01gc*f\xa0\xa6\xf6\x9c\x97\xbc)\xd1\n\xb3l\x05+\x8e\x84i\\-M4H\xab\xc3\a\x9fԒ?7F\xfb\r0\x8f+>r\xe7\xd91\x7f\xa9\xe3^v\xd7\xee\xda]\xbb+\xbd\xfeo\x00bFf\xb9\x00d\x00\x00")
 	App.SetTemplatesFS(templatesFS)
 	tmpl_users_hook_html := template.New(templatesFS, manager)
 	tmpl_users_hook_html.Funcs(map[string]interface{}{
@@ -52,7 +52,10 @@ func signInGoogleTokenHandler(ctx *app.Context, client *oauth2.Client, token *oa
 	if err != nil {
 		panic(err)
 	}
-	ctx.MustSignIn(asGondolaUser(user))
+	if next := signInUser(ctx, user); next != "" {
+		ctx.Redirect(next, false)
+		return
+	}
 	redirectToFrom(ctx)
 }
 
@@ -67,8 +70,7 @@ func jsSignInGoogleHandler(ctx *app.Context) {
 	if err != nil {
 		panic(err)
 	}
-	ctx.MustSignIn(asGondolaUser(user))
-	writeJSONEncoded(ctx, user)
+	writeSignedIn(ctx, user)
 }
 
 func userFromGoogleToken(ctx *app.Context, token *oauth2.Token) (reflect.Value, error) {
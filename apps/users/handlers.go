@@ -1,11 +1,8 @@
 package users
 
 import (
-	"errors"
 	"fmt"
-	"net/url"
 	"reflect"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -14,13 +11,7 @@ import (
 	"gnd.la/crypto/password"
 	"gnd.la/form"
 	"gnd.la/i18n"
-	"gnd.la/net/mail"
 	"gnd.la/orm"
-	"gnd.la/util/stringutil"
-)
-
-var (
-	errResetExpired = errors.New("password reset expired")
 )
 
 const (
@@ -37,6 +28,11 @@ const (
 	SignOutHandlerName        = "users-sign-out"
 	ForgotHandlerName         = "users-forgot"
 	ResetHandlerName          = "users-reset"
+	VerifyEmailHandlerName    = "users-verify-email"
+
+	SignInTwoFactorHandlerName = "users-sign-in-two-factor"
+	TwoFactorHandlerName       = "users-two-factor"
+	SignInOAuthHandlerName     = "users-sign-in-oauth"
 
 	FacebookChannelHandlerName = "users-facebook-channel"
 	ImageHandlerName           = "users-image-handler"
@@ -46,12 +42,20 @@ var (
 	SiteName                = ""
 	Salt                    = []byte("gnd.la/apps/users")
 	PasswordResetExpiry     = 24 * time.Hour
+	EmailVerificationExpiry = 7 * 24 * time.Hour
 	SignInHandlerName       = app.SignInHandlerName
 	SignInTemplateName      = "sign-in.html"
 	SignInModalTemplateName = "sign-in-modal.html"
 	SignUpTemplateName      = "sign-up.html"
 	ForgotTemplateName      = "forgot.html"
 	ResetTemplateName       = "reset.html"
+	VerifyEmailTemplateName = "verify-email.html"
+
+	ResetMailTemplateName       = "reset_password.txt"
+	VerifyEmailMailTemplateName = "verify_email.txt"
+
+	SignInTwoFactorTemplateName = "sign-in-two-factor.html"
+	TwoFactorTemplateName       = "two-factor.html"
 
 	SignInHandler           = app.NamedHandler(app.SignInHandlerName, app.Anonymous(signInHandler))
 	SignInFacebookHandler   = app.NamedHandler(SignInFacebookHandlerName, app.Anonymous(signInFacebookHandler))
@@ -62,33 +66,46 @@ var (
 	SignOutHandler          = app.NamedHandler(SignOutHandlerName, app.SignOutHandler)
 	ForgotHandler           = app.NamedHandler(ForgotHandlerName, app.Anonymous(forgotHandler))
 	ResetHandler            = app.NamedHandler(ResetHandlerName, resetHandler)
+	VerifyEmailHandler      = app.NamedHandler(VerifyEmailHandlerName, verifyEmailHandler)
 	JSSignInHandler         = app.NamedHandler(JSSignInHandlerName, app.Anonymous(jsSignInHandler))
 	JSSignInFacebookHandler = app.NamedHandler(JSSignInFacebookHandlerName, app.Anonymous(jsSignInFacebookHandler))
 	JSSignInGoogleHandler   = app.NamedHandler(JSSignInGoogleHandlerName, app.Anonymous(jsSignInGoogleHandler))
 	JSSignUpHandler         = app.NamedHandler(JSSignUpHandlerName, app.Anonymous(jsSignUpHandler))
 	FacebookChannelHandler  = app.NamedHandler(FacebookChannelHandlerName, facebookChannelHandler)
 	UserImageHandler        = app.NamedHandler(ImageHandlerName, imageHandler)
+	SignInTwoFactorHandler  = app.NamedHandler(SignInTwoFactorHandlerName, app.Anonymous(signInTwoFactorHandler))
+	TwoFactorHandler        = app.NamedHandler(TwoFactorHandlerName, app.SignedIn(twoFactorHandler))
+	SignInOAuthHandler      = app.NamedHandler(SignInOAuthHandlerName, app.Anonymous(signInOAuthHandler))
 )
 
 func signInHandler(ctx *app.Context) {
 	modal := ctx.FormValue("modal") != ""
 	st := enabledSocialTypes()
-	if !modal && !AllowUserSignIn && len(st) == 1 {
+	oa := OAuthApps()
+	if !modal && !AllowUserSignIn && len(st)+len(oa) == 1 {
 		// Redirect to the only available social sign-in
-		ctx.MustRedirectReverse(false, st[0].HandlerName)
+		if len(st) == 1 {
+			ctx.MustRedirectReverse(false, st[0].HandlerName)
+		} else {
+			ctx.MustRedirectReverse(false, SignInOAuthHandlerName, oa[0].Name())
+		}
 		return
 	}
 	from := ctx.FormValue(app.SignInFromParameterName)
 	signIn := SignIn{From: from}
 	form := form.New(ctx, &signIn)
 	if AllowUserSignIn && form.Submitted() && form.IsValid() {
-		ctx.MustSignIn(asGondolaUser(reflect.ValueOf(signIn.User)))
+		if next := signInUser(ctx, reflect.ValueOf(signIn.User)); next != "" {
+			ctx.Redirect(next, false)
+			return
+		}
 		ctx.RedirectBack()
 		return
 	}
 	user, _ := newEmptyUser()
 	data := map[string]interface{}{
 		"SocialTypes":       st,
+		"OAuthApps":         oa,
 		"AllowUserSignIn":   AllowUserSignIn,
 		"AllowRegistration": AllowRegistration,
 		"From":              from,
@@ -110,9 +127,7 @@ func jsSignInHandler(ctx *app.Context) {
 	signIn := SignIn{}
 	form := form.New(ctx, &signIn)
 	if form.Submitted() && form.IsValid() {
-		user := reflect.ValueOf(signIn.User)
-		ctx.MustSignIn(asGondolaUser(user))
-		writeJSONEncoded(ctx, user)
+		writeSignedIn(ctx, reflect.ValueOf(signIn.User))
 		return
 	}
 	FormErrors(ctx, form)
@@ -158,7 +173,7 @@ func forgotHandler(ctx *app.Context) {
 		ctx.NotFound("")
 		return
 	}
-	var user *User
+	user, userVal := newEmptyUser()
 	var isEmail bool
 	var sent bool
 	var fields struct {
@@ -174,91 +189,34 @@ func forgotHandler(ctx *app.Context) {
 		} else {
 			field = "User.NormalizedUsername"
 		}
-		ok := c.Orm().MustOne(orm.Eq(field, username), &user)
+		ok := c.Orm().MustOne(orm.Eq(field, username), userVal)
 		if !ok {
 			if isEmail {
 				return i18n.Errorf("address %q does not belong to any registered user", username)
 			}
 			return i18n.Errorf("username %q does not belong to any registered user", username)
 		}
-		if user.Email == "" {
+		if getUserValue(user, "Email").(string) == "" {
 			return i18n.Errorf("username %q does not have any registered emails", username)
 		}
 		return nil
 	}
 	f := form.New(ctx, &fields)
 	if f.Submitted() && f.IsValid() {
-		se, err := ctx.App().EncryptSigner(Salt)
-		if err != nil {
-			panic(err)
-		}
-		values := make(url.Values)
-		values.Add("u", strconv.FormatInt(user.Id(), 36))
-		values.Add("t", strconv.FormatInt(time.Now().Unix(), 36))
-		values.Add("n", stringutil.Random(64))
-		payload := values.Encode()
-		p, err := se.EncryptSign([]byte(payload))
-		if err != nil {
-			panic(err)
-		}
-		abs := ctx.URL()
-		reset := fmt.Sprintf("%s://%s%s?p=%s", abs.Scheme, abs.Host, ctx.MustReverse(ResetHandlerName), p)
-		data := map[string]interface{}{
-			"URL": reset,
-		}
-		from := mail.DefaultFrom()
-		if from == "" {
-			from = fmt.Sprintf("no-reply@%s", abs.Host)
-		}
-		msg := &mail.Message{
-			To:      user.Email,
-			From:    from,
-			Subject: fmt.Sprintf(ctx.T("Reset your %s password"), SiteName),
-		}
-		ctx.MustSendMail("reset_password.txt", data, msg)
+		sendTokenMail(ctx, tokenReset, user, ResetHandlerName, ResetMailTemplateName,
+			fmt.Sprintf(ctx.T("Reset your %s password"), SiteName))
+		emitUserEvent(PASSWORD_RESET_REQUESTED, ctx, user)
 		sent = true
 	}
 	data := map[string]interface{}{
 		"ForgotForm": f,
 		"IsEmail":    isEmail,
 		"Sent":       sent,
-		"User":       user,
+		"User":       userVal,
 	}
 	ctx.MustExecute(ForgotTemplateName, data)
 }
 
-func decodeResetPayload(ctx *app.Context, payload string) (reflect.Value, error) {
-	se, err := ctx.App().EncryptSigner(Salt)
-	if err != nil {
-		return reflect.Value{}, err
-	}
-	value, err := se.UnsignDecrypt(payload)
-	if err != nil {
-		return reflect.Value{}, err
-	}
-	qs, err := url.ParseQuery(string(value))
-	if err != nil {
-		return reflect.Value{}, err
-	}
-	userId, err := strconv.ParseInt(qs.Get("u"), 36, 64)
-	if err != nil {
-		return reflect.Value{}, err
-	}
-	ts, err := strconv.ParseInt(qs.Get("t"), 36, 64)
-	if err != nil {
-		return reflect.Value{}, err
-	}
-	if time.Since(time.Unix(ts, 0)) > PasswordResetExpiry {
-		return reflect.Value{}, errResetExpired
-	}
-	user, userVal := newEmptyUser()
-	ok := ctx.Orm().MustOne(orm.Eq("User.UserId", userId), userVal)
-	if !ok {
-		return reflect.Value{}, errNoSuchUser
-	}
-	return user, nil
-}
-
 func resetHandler(ctx *app.Context) {
 	if !AllowUserSignIn {
 		ctx.NotFound("")
@@ -272,11 +230,11 @@ func resetHandler(ctx *app.Context) {
 	var err error
 	var done bool
 	if payload != "" {
-		user, err = decodeResetPayload(ctx, payload)
+		user, err = decodeToken(ctx, tokenReset, payload, PasswordResetExpiry)
 		if err == nil && user.IsValid() {
 			valid = true
 		} else {
-			if err == errResetExpired {
+			if err == errTokenExpired {
 				expired = true
 			}
 		}
@@ -285,8 +243,16 @@ func resetHandler(ctx *app.Context) {
 		passwordForm := &PasswordForm{User: user}
 		f = form.New(ctx, passwordForm)
 		if f.Submitted() && f.IsValid() {
+			setUserValue(user, "Password", mustNewPassword(string(passwordForm.Password)))
+			// Resetting the password also unlocks the account
+			setUserValue(user, "FailedSignIns", 0)
+			setUserValue(user, "LockedUntil", time.Time{})
 			ctx.Orm().MustSave(user.Interface())
-			ctx.MustSignIn(asGondolaUser(user))
+			emitUserEvent(PASSWORD_RESET, ctx, user)
+			if next := signInUser(ctx, user); next != "" {
+				ctx.Redirect(next, false)
+				return
+			}
 			done = true
 		}
 	}
@@ -301,6 +267,17 @@ func resetHandler(ctx *app.Context) {
 	ctx.MustExecute(ResetTemplateName, data)
 }
 
+// writeSignedIn signs in the user and writes it as JSON. If the
+// sign in process requires another step (e.g. two factor
+// authentication), the URL for it is sent in the "redirect" key.
+func writeSignedIn(ctx *app.Context, user reflect.Value) {
+	if next := signInUser(ctx, user); next != "" {
+		ctx.WriteJSON(map[string]interface{}{"redirect": next})
+		return
+	}
+	writeJSONEncoded(ctx, user)
+}
+
 func FormErrors(ctx *app.Context, frm *form.Form) {
 	errors := make(map[string]string)
 	for _, v := range frm.Fields() {
@@ -315,10 +292,13 @@ func FormErrors(ctx *app.Context, frm *form.Form) {
 }
 
 func saveNewUser(ctx *app.Context, user reflect.Value) {
-	setUserValue(user, "Password", password.New(string(getUserValue(user, "Password").(password.Password))))
+	setUserValue(user, "Password", mustNewPassword(string(getUserValue(user, "Password").(password.Password))))
 	setUserValue(user, "Created", time.Now().UTC())
 	ctx.Orm().MustInsert(user.Interface())
 	ctx.MustSignIn(asGondolaUser(user))
+	if VerifyEmails {
+		SendEmailVerification(ctx, user.Interface())
+	}
 }
 
 func delayedHandler(f func() app.Handler) app.Handler {
@@ -343,7 +323,12 @@ func delayedHandler(f func() app.Handler) app.Handler {
 func windowCallbackHandler(ctx *app.Context, user reflect.Value, callback string) {
 	inWindow := ctx.FormValue("window") != ""
 	if user.IsValid() {
-		ctx.MustSignIn(asGondolaUser(user))
+		if next := signInUser(ctx, user); next != "" {
+			// Continue the sign in process in
+			// the window, if any.
+			ctx.Redirect(next, false)
+			return
+		}
 	}
 	if inWindow {
 		var payload []byte
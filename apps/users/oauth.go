@@ -0,0 +1,315 @@
+package users
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/i18n"
+	"gnd.la/net/oauth2"
+	"gnd.la/orm"
+	"gnd.la/orm/index"
+	"gnd.la/social"
+)
+
+var (
+	errNoOAuthAccount = i18n.NewError("no oAuth account for this user")
+
+	// oauthTokenSalt is used for encrypting the tokens
+	// stored in the OAuthAccount.
+	oauthTokenSalt = []byte("gnd.la/apps/users.oauth-token")
+
+	oauthApps struct {
+		sync.RWMutex
+		apps []*OAuthApp
+	}
+)
+
+// OAuthApp links a social.OAuthProvider with the application
+// credentials obtained from the provider. Use AddOAuthProvider
+// to enable signing in with it.
+type OAuthApp struct {
+	Provider *social.OAuthProvider
+	Client   *oauth2.Client
+	// Scopes are the scopes requested when signing in. If empty,
+	// the default ones for the provider are used.
+	Scopes []string
+	// Icon is the icon name shown in the sign in button. If
+	// empty, the provider name is used.
+	Icon string
+}
+
+// Name returns the provider name.
+func (a *OAuthApp) Name() string {
+	return a.Provider.Name
+}
+
+// Title returns the provider title.
+func (a *OAuthApp) Title() string {
+	if a.Provider.Title != "" {
+		return a.Provider.Title
+	}
+	return a.Provider.Name
+}
+
+// IconName returns the icon name for the sign in button.
+func (a *OAuthApp) IconName() string {
+	if a.Icon != "" {
+		return a.Icon
+	}
+	return a.Provider.Name
+}
+
+func (a *OAuthApp) scopes() []string {
+	if len(a.Scopes) > 0 {
+		return a.Scopes
+	}
+	return a.Provider.Scopes
+}
+
+// OAuthAccount links a user with its account in an oAuth
+// provider, added with AddOAuthProvider. Use OAuthToken to
+// obtain its token.
+type OAuthAccount struct {
+	Id        int64  `orm:",primary_key,auto_increment"`
+	UserId    int64  `orm:",index"`
+	Provider  string `orm:",max_length=64"`
+	AccountId string `orm:",max_length=255"`
+	Email     string `orm:",omitempty,nullempty"`
+	// Token and Refresh are encrypted and signed
+	// with the App EncryptSigner.
+	Token   string
+	Refresh string `orm:",omitempty,nullempty"`
+	Expires time.Time
+}
+
+// AddOAuthProvider enables signing in with the given provider, which
+// might be one of the presets in gnd.la/social (e.g. social.GithubOAuth)
+// or a custom one. The id and secret parameters are the application
+// credentials obtained from the provider. Users sign in at the URL for
+// SignInOAuthHandler, receiving the provider name as its argument. Note
+// that the callback URL, which must be usually registered with the
+// provider, is the same one.
+//
+// Adding two providers with the same name will panic.
+func AddOAuthProvider(p *social.OAuthProvider, id string, secret string) *OAuthApp {
+	oauthApps.Lock()
+	defer oauthApps.Unlock()
+	for _, v := range oauthApps.apps {
+		if v.Provider.Name == p.Name {
+			panic(fmt.Errorf("duplicate oAuth provider %q", p.Name))
+		}
+	}
+	a := &OAuthApp{
+		Provider: p,
+		Client:   p.Client(id, secret),
+	}
+	oauthApps.apps = append(oauthApps.apps, a)
+	return a
+}
+
+// OAuthApps returns the OAuthApps added with AddOAuthProvider,
+// in the same order they were added.
+func OAuthApps() []*OAuthApp {
+	oauthApps.RLock()
+	defer oauthApps.RUnlock()
+	return append([]*OAuthApp(nil), oauthApps.apps...)
+}
+
+func oauthAppNamed(name string) *OAuthApp {
+	oauthApps.RLock()
+	defer oauthApps.RUnlock()
+	for _, v := range oauthApps.apps {
+		if v.Provider.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+func signInOAuthHandler(ctx *app.Context) {
+	a := oauthAppNamed(ctx.IndexValue(0))
+	if a == nil {
+		ctx.NotFound("")
+		return
+	}
+	oauth2.Handler(func(ctx *app.Context, client *oauth2.Client, token *oauth2.Token) {
+		acc, err := a.Provider.User(client.Clone(ctx), token)
+		if err != nil {
+			panic(err)
+		}
+		user, err := userWithOAuthAccount(ctx, a, acc, token)
+		if err != nil {
+			panic(err)
+		}
+		if next := signInUser(ctx, user); next != "" {
+			ctx.Redirect(next, false)
+			return
+		}
+		redirectToFrom(ctx)
+	}, a.Client, a.scopes())(ctx)
+}
+
+// userWithOAuthAccount returns the user linked with the given account,
+// creating it if required. Accounts are only linked to existing users
+// with the same email when the provider has verified it, and new users
+// don't get an unverified email which is already in use.
+func userWithOAuthAccount(ctx *app.Context, a *OAuthApp, acc *social.OAuthUser, token *oauth2.Token) (reflect.Value, error) {
+	o := ctx.Orm()
+	var account *OAuthAccount
+	q := orm.And(orm.Eq("Provider", a.Name()), orm.Eq("AccountId", acc.Id))
+	ok, err := o.One(q, &account)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	user, userVal := newEmptyUser()
+	if ok {
+		ok, err = o.One(ById(account.UserId), userVal)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+	} else {
+		account = &OAuthAccount{Provider: a.Name(), AccountId: acc.Id}
+		if acc.Email != "" && acc.EmailVerified {
+			ok, err = o.One(orm.Eq("User.NormalizedEmail", Normalize(acc.Email)), userVal)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+		}
+	}
+	if !ok {
+		// This is a bit racy, but we'll live with it for now
+		username := acc.Username
+		if username == "" {
+			username = strings.Replace(acc.Name, " ", "", -1)
+		}
+		if username == "" {
+			username = a.Name()
+		}
+		user = newUser(FindFreeUsername(ctx, username))
+		setUserValue(user, "AutomaticUsername", true)
+		email := acc.Email
+		if email != "" && !acc.EmailVerified {
+			// Don't take an unverified email which already
+			// belongs to another user.
+			exists, err := o.Exists(o.TypeTable(userType), ByEmail(email))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			if exists {
+				email = ""
+			}
+		}
+		setUserValue(user, "Email", email)
+		setUserValue(user, "EmailVerified", email != "" && acc.EmailVerified)
+		if _, err := o.Insert(user.Interface()); err != nil {
+			return reflect.Value{}, err
+		}
+	}
+	account.UserId = asGondolaUser(user).Id()
+	account.Email = acc.Email
+	if err := setOAuthToken(ctx, account, token); err != nil {
+		return reflect.Value{}, err
+	}
+	if _, err := o.Save(account); err != nil {
+		return reflect.Value{}, err
+	}
+	return user, nil
+}
+
+// setOAuthToken stores the given token in the account, encrypted. The
+// previous refresh token is kept if the new one doesn't include it.
+func setOAuthToken(ctx *app.Context, account *OAuthAccount, token *oauth2.Token) error {
+	es, err := ctx.App().EncryptSigner(oauthTokenSalt)
+	if err != nil {
+		return err
+	}
+	key, err := es.EncryptSign([]byte(token.Key))
+	if err != nil {
+		return err
+	}
+	account.Token = key
+	account.Expires = token.Expires
+	if token.Refresh != "" {
+		refresh, err := es.EncryptSign([]byte(token.Refresh))
+		if err != nil {
+			return err
+		}
+		account.Refresh = refresh
+	}
+	return nil
+}
+
+// oauthToken returns the token stored in the account by setOAuthToken.
+func oauthToken(ctx *app.Context, account *OAuthAccount) (*oauth2.Token, error) {
+	es, err := ctx.App().EncryptSigner(oauthTokenSalt)
+	if err != nil {
+		return nil, err
+	}
+	key, err := es.UnsignDecrypt(account.Token)
+	if err != nil {
+		return nil, err
+	}
+	token := &oauth2.Token{
+		Key:     string(key),
+		Expires: account.Expires,
+	}
+	if account.Refresh != "" {
+		refresh, err := es.UnsignDecrypt(account.Refresh)
+		if err != nil {
+			return nil, err
+		}
+		token.Refresh = string(refresh)
+	}
+	return token, nil
+}
+
+// OAuthAccounts returns the oAuth accounts linked to the given user.
+func OAuthAccounts(ctx *app.Context, user interface{}) []*OAuthAccount {
+	var accounts []*OAuthAccount
+	ctx.Orm().Query(orm.Eq("UserId", userId(user))).Sort("Provider", orm.ASC).MustAll(&accounts)
+	return accounts
+}
+
+// OAuthToken returns a valid token for the given user and provider name,
+// which can be used to perform requests to the provider API on behalf of
+// the user. Expired tokens are transparently refreshed when possible.
+func OAuthToken(ctx *app.Context, user interface{}, provider string) (*oauth2.Token, error) {
+	a := oauthAppNamed(provider)
+	if a == nil {
+		return nil, fmt.Errorf("no oAuth provider named %q", provider)
+	}
+	var account *OAuthAccount
+	q := orm.And(orm.Eq("UserId", userId(user)), orm.Eq("Provider", provider))
+	if !ctx.Orm().MustOne(q, &account) {
+		return nil, errNoOAuthAccount
+	}
+	token, err := oauthToken(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	// Leave some margin for the request using the token
+	if !token.Expires.IsZero() && time.Now().Add(time.Minute).After(token.Expires) && token.Refresh != "" {
+		refreshed, err := a.Client.Clone(ctx).Refresh(token.Refresh)
+		if err != nil {
+			return nil, err
+		}
+		if err := setOAuthToken(ctx, account, refreshed); err != nil {
+			return nil, err
+		}
+		ctx.Orm().MustSave(account)
+		token = refreshed
+	}
+	return token, nil
+}
+
+func init() {
+	orm.Register(&OAuthAccount{}, &orm.Options{
+		Indexes: []*index.Index{
+			index.NewUnique("Provider", "AccountId"),
+		},
+	})
+}
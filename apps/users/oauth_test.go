@@ -0,0 +1,44 @@
+package users
+
+import (
+	"testing"
+
+	"gnd.la/net/oauth2"
+	"gnd.la/social"
+)
+
+func TestOAuthAccountLinking(t *testing.T) {
+	if err := runCommand(createUser, map[string]string{"password": "secret1", "email": "victim@example.com"}, "victim"); err != nil {
+		t.Fatal(err)
+	}
+	victim := findTestUser(t, "victim")
+	ctx := commandsApp.NewContext(nil)
+	defer commandsApp.CloseContext(ctx)
+	a := &OAuthApp{Provider: &social.OAuthProvider{Name: "test"}}
+	token := &oauth2.Token{Key: "key"}
+	// An unverified email must never link the account to
+	// an existing user, otherwise anyone could take it over.
+	acc := &social.OAuthUser{Id: "1", Name: "Mallory", Email: "Victim@example.com"}
+	userVal, err := userWithOAuthAccount(ctx, a, acc, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := asGondolaUser(userVal).Id(); id == victim.Id() {
+		t.Fatal("unverified email was linked to an existing user")
+	}
+	if email := getUserValue(userVal, "Email").(string); email != "" {
+		t.Errorf("new user got the email of an existing user %q", email)
+	}
+	if getUserValue(userVal, "EmailVerified").(bool) {
+		t.Error("new user has an unverified email marked as verified")
+	}
+	// A verified one is linked
+	acc = &social.OAuthUser{Id: "2", Name: "Victim", Email: "Victim@example.com", EmailVerified: true}
+	userVal, err = userWithOAuthAccount(ctx, a, acc, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := asGondolaUser(userVal).Id(); id != victim.Id() {
+		t.Errorf("expecting verified email to be linked to user %d, got %d", victim.Id(), id)
+	}
+}
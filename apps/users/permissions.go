@@ -0,0 +1,251 @@
+package users
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gnd.la/app"
+	"gnd.la/i18n"
+	"gnd.la/orm"
+)
+
+var (
+	ErrNoSuchRole = i18n.NewError("no such role")
+
+	// PermissionsCacheTimeout is the number of seconds the
+	// permissions for each user are kept in the cache.
+	PermissionsCacheTimeout = 300
+)
+
+// Role represents a named set of permissions which can be
+// assigned to users. Permissions are arbitrary strings, usually
+// dot separated (e.g. "posts.edit"). A permission ending with
+// ".*" grants every permission with the same prefix, while "*"
+// grants all of them.
+type Role struct {
+	Id          int64    `orm:",primary_key,auto_increment"`
+	Name        string   `orm:",unique"`
+	Permissions []string `orm:",codec=json"`
+}
+
+// UserRole assigns a Role to a user.
+type UserRole struct {
+	UserId int64 `orm:",index"`
+	RoleId int64 `orm:",index"`
+}
+
+// UserPermission grants a permission to a user directly,
+// rather than via a Role.
+type UserPermission struct {
+	UserId     int64 `orm:",index"`
+	Permission string
+}
+
+// HasPermission implements the app.PermissionsUser interface,
+// which is used by app.Context.UserHas.
+func (u *User) HasPermission(ctx *app.Context, perm string) bool {
+	return hasPermission(Permissions(ctx, u), perm)
+}
+
+func hasPermission(perms []string, perm string) bool {
+	for _, v := range perms {
+		if v == perm || v == "*" {
+			return true
+		}
+		if strings.HasSuffix(v, ".*") && strings.HasPrefix(perm, v[:len(v)-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+func permissionsCacheKey(id int64) string {
+	return "gnd:la:user:permissions:" + strconv.FormatInt(id, 10)
+}
+
+func userId(user interface{}) int64 {
+	return user.(app.User).Id()
+}
+
+// Permissions returns all the permissions granted to the given user,
+// either directly or via its roles, sorted alphabetically. Note that
+// the result does not take into account if the user is an admin.
+func Permissions(ctx *app.Context, user interface{}) []string {
+	id := userId(user)
+	key := permissionsCacheKey(id)
+	var perms []string
+	if ctx.Cache().Get(key, &perms) == nil {
+		return perms
+	}
+	set := make(map[string]struct{})
+	var userRoles []*UserRole
+	ctx.Orm().Query(orm.Eq("UserId", id)).MustAll(&userRoles)
+	if len(userRoles) > 0 {
+		ids := make([]int64, len(userRoles))
+		for ii, v := range userRoles {
+			ids[ii] = v.RoleId
+		}
+		var roles []*Role
+		ctx.Orm().Query(orm.In("Id", ids)).MustAll(&roles)
+		for _, r := range roles {
+			for _, p := range r.Permissions {
+				set[p] = struct{}{}
+			}
+		}
+	}
+	var userPerms []*UserPermission
+	ctx.Orm().Query(orm.Eq("UserId", id)).MustAll(&userPerms)
+	for _, v := range userPerms {
+		set[v.Permission] = struct{}{}
+	}
+	perms = make([]string, 0, len(set))
+	for k := range set {
+		perms = append(perms, k)
+	}
+	sort.Strings(perms)
+	ctx.Cache().Set(key, perms, PermissionsCacheTimeout)
+	return perms
+}
+
+// HasPermission returns true iff the given user is an admin or it
+// has been granted the given permission.
+func HasPermission(ctx *app.Context, user interface{}, perm string) bool {
+	if user.(app.User).IsAdmin() {
+		return true
+	}
+	return hasPermission(Permissions(ctx, user), perm)
+}
+
+// FindRole returns the role with the given name.
+func FindRole(ctx *app.Context, name string) (*Role, error) {
+	var role *Role
+	if !ctx.Orm().MustOne(orm.Eq("Name", name), &role) {
+		return nil, ErrNoSuchRole
+	}
+	return role, nil
+}
+
+// DefineRole creates the role with the given name or, if it
+// already exists, replaces its permissions.
+func DefineRole(ctx *app.Context, name string, perms ...string) *Role {
+	role, err := FindRole(ctx, name)
+	if err != nil {
+		role = &Role{Name: name}
+	}
+	role.Permissions = perms
+	ctx.Orm().MustSave(role)
+	clearRolePermissions(ctx, role)
+	return role
+}
+
+// DeleteRole deletes the role with the given name, removing
+// it from all the users it was assigned to.
+func DeleteRole(ctx *app.Context, name string) error {
+	role, err := FindRole(ctx, name)
+	if err != nil {
+		return err
+	}
+	clearRolePermissions(ctx, role)
+	o := ctx.Orm()
+	if _, err := o.DeleteFrom(o.TypeTable(reflect.TypeOf(UserRole{})), orm.Eq("RoleId", role.Id)); err != nil {
+		return err
+	}
+	return o.Delete(role)
+}
+
+// Roles returns the roles assigned to the given user.
+func Roles(ctx *app.Context, user interface{}) []*Role {
+	var userRoles []*UserRole
+	ctx.Orm().Query(orm.Eq("UserId", userId(user))).MustAll(&userRoles)
+	if len(userRoles) == 0 {
+		return nil
+	}
+	ids := make([]int64, len(userRoles))
+	for ii, v := range userRoles {
+		ids[ii] = v.RoleId
+	}
+	var roles []*Role
+	ctx.Orm().Query(orm.In("Id", ids)).Sort("Name", orm.ASC).MustAll(&roles)
+	return roles
+}
+
+// AddRole assigns the role with the given name to the user.
+func AddRole(ctx *app.Context, user interface{}, name string) error {
+	role, err := FindRole(ctx, name)
+	if err != nil {
+		return err
+	}
+	id := userId(user)
+	q := orm.And(orm.Eq("UserId", id), orm.Eq("RoleId", role.Id))
+	if _, err := ctx.Orm().Upsert(q, &UserRole{UserId: id, RoleId: role.Id}); err != nil {
+		return err
+	}
+	clearPermissions(ctx, id)
+	return nil
+}
+
+// RemoveRole removes the role with the given name from the user.
+func RemoveRole(ctx *app.Context, user interface{}, name string) error {
+	role, err := FindRole(ctx, name)
+	if err != nil {
+		return err
+	}
+	id := userId(user)
+	o := ctx.Orm()
+	q := orm.And(orm.Eq("UserId", id), orm.Eq("RoleId", role.Id))
+	if _, err := o.DeleteFrom(o.TypeTable(reflect.TypeOf(UserRole{})), q); err != nil {
+		return err
+	}
+	clearPermissions(ctx, id)
+	return nil
+}
+
+// Grant grants the given permission to the user, independently
+// of its roles.
+func Grant(ctx *app.Context, user interface{}, perm string) error {
+	id := userId(user)
+	q := orm.And(orm.Eq("UserId", id), orm.Eq("Permission", perm))
+	if _, err := ctx.Orm().Upsert(q, &UserPermission{UserId: id, Permission: perm}); err != nil {
+		return err
+	}
+	clearPermissions(ctx, id)
+	return nil
+}
+
+// Revoke revokes a permission granted to the user with Grant.
+// Permissions granted via roles are not affected.
+func Revoke(ctx *app.Context, user interface{}, perm string) error {
+	id := userId(user)
+	o := ctx.Orm()
+	q := orm.And(orm.Eq("UserId", id), orm.Eq("Permission", perm))
+	if _, err := o.DeleteFrom(o.TypeTable(reflect.TypeOf(UserPermission{})), q); err != nil {
+		return err
+	}
+	clearPermissions(ctx, id)
+	return nil
+}
+
+func clearPermissions(ctx *app.Context, id int64) {
+	ctx.Cache().Delete(permissionsCacheKey(id))
+}
+
+// clearRolePermissions removes the cached permissions
+// for all the users with the given role.
+func clearRolePermissions(ctx *app.Context, role *Role) {
+	if role.Id == 0 {
+		return
+	}
+	var userRoles []*UserRole
+	ctx.Orm().Query(orm.Eq("RoleId", role.Id)).MustAll(&userRoles)
+	for _, v := range userRoles {
+		clearPermissions(ctx, v.UserId)
+	}
+}
+
+func init() {
+	orm.Register(&Role{}, nil)
+	orm.Register(&UserRole{}, &orm.Options{PrimaryKey: []string{"UserId", "RoleId"}})
+	orm.Register(&UserPermission{}, &orm.Options{PrimaryKey: []string{"UserId", "Permission"}})
+}
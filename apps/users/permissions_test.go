@@ -0,0 +1,132 @@
+package users
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHasPermission(t *testing.T) {
+	perms := []string{"posts.edit", "comments.*"}
+	cases := map[string]bool{
+		"posts.edit":          true,
+		"posts.delete":        false,
+		"posts":               false,
+		"comments.delete":     true,
+		"comments.spam.clear": true,
+		"comments":            false,
+		"commentsx.delete":    false,
+	}
+	for k, v := range cases {
+		if has := hasPermission(perms, k); has != v {
+			t.Errorf("expecting hasPermission(%v, %q) = %v, got %v", perms, k, v, has)
+		}
+	}
+	if !hasPermission([]string{"*"}, "anything.at.all") {
+		t.Error("* should grant every permission")
+	}
+	if hasPermission(nil, "posts.edit") {
+		t.Error("no permissions should grant nothing")
+	}
+}
+
+func TestPermissions(t *testing.T) {
+	for _, v := range []string{"perms1", "perms2", "permsadmin"} {
+		if err := runCommand(createUser, map[string]string{"password": "secret1"}, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx := commandsApp.NewContext(nil)
+	defer commandsApp.CloseContext(ctx)
+	user1 := findTestUser(t, "perms1")
+	user2 := findTestUser(t, "perms2")
+	expect := func(user *testUser, perms ...string) {
+		got := Permissions(ctx, user)
+		if len(got) == 0 && len(perms) == 0 {
+			return
+		}
+		if !reflect.DeepEqual(got, perms) {
+			t.Errorf("expecting permissions %v for %s, got %v", perms, user.Username, got)
+		}
+	}
+	expect(user1)
+	DefineRole(ctx, "moderator", "comments.*", "posts.view")
+	DefineRole(ctx, "writer", "posts.edit", "posts.view")
+	if err := AddRole(ctx, user1, "missing"); err != ErrNoSuchRole {
+		t.Errorf("expecting ErrNoSuchRole, got %v", err)
+	}
+	// Roles are expanded and duplicate permissions removed
+	if err := AddRole(ctx, user1, "moderator"); err != nil {
+		t.Fatal(err)
+	}
+	if err := AddRole(ctx, user1, "writer"); err != nil {
+		t.Fatal(err)
+	}
+	// Adding a role twice is a no-op
+	if err := AddRole(ctx, user1, "writer"); err != nil {
+		t.Fatal(err)
+	}
+	expect(user1, "comments.*", "posts.edit", "posts.view")
+	if !user1.HasPermission(ctx, "comments.delete") || user1.HasPermission(ctx, "users.delete") {
+		t.Error("unexpected permissions for user with roles")
+	}
+	expect(user2)
+	if err := Grant(ctx, user2, "posts.view"); err != nil {
+		t.Fatal(err)
+	}
+	expect(user2, "posts.view")
+	// Permissions granted directly are merged with the roles
+	if err := Grant(ctx, user1, "users.delete"); err != nil {
+		t.Fatal(err)
+	}
+	expect(user1, "comments.*", "posts.edit", "posts.view", "users.delete")
+	if err := Revoke(ctx, user1, "users.delete"); err != nil {
+		t.Fatal(err)
+	}
+	// Revoking doesn't affect the permissions from roles
+	if err := Revoke(ctx, user1, "posts.edit"); err != nil {
+		t.Fatal(err)
+	}
+	expect(user1, "comments.*", "posts.edit", "posts.view")
+	if err := RemoveRole(ctx, user1, "writer"); err != nil {
+		t.Fatal(err)
+	}
+	expect(user1, "comments.*", "posts.view")
+	// Redefining a role updates its users
+	DefineRole(ctx, "moderator", "comments.approve")
+	expect(user1, "comments.approve")
+	if err := DeleteRole(ctx, "moderator"); err != nil {
+		t.Fatal(err)
+	}
+	expect(user1)
+	if roles := Roles(ctx, user1); len(roles) != 0 {
+		t.Errorf("expecting no roles after deleting it, got %v", roles)
+	}
+	admin := findTestUser(t, "permsadmin")
+	admin.Admin = true
+	if !HasPermission(ctx, admin, "anything") || HasPermission(ctx, user2, "anything") {
+		t.Error("admins should have every permission")
+	}
+}
+
+func TestPermissionsCache(t *testing.T) {
+	if err := runCommand(createUser, map[string]string{"password": "secret1"}, "permscache"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := commandsApp.NewContext(nil)
+	defer commandsApp.CloseContext(ctx)
+	user := findTestUser(t, "permscache")
+	if perms := Permissions(ctx, user); len(perms) != 0 {
+		t.Fatalf("expecting no permissions, got %v", perms)
+	}
+	// Bypass Grant, so the cache is not cleared
+	ctx.Orm().MustInsert(&UserPermission{UserId: user.Id(), Permission: "cached.view"})
+	if perms := Permissions(ctx, user); len(perms) != 0 {
+		t.Errorf("expecting cached permissions, got %v", perms)
+	}
+	if err := Grant(ctx, user, "cached.edit"); err != nil {
+		t.Fatal(err)
+	}
+	if perms := Permissions(ctx, user); !reflect.DeepEqual(perms, []string{"cached.edit", "cached.view"}) {
+		t.Errorf("expecting permissions to be reloaded after Grant, got %v", perms)
+	}
+}
@@ -0,0 +1,188 @@
+package users
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/form"
+	"gnd.la/net/mail"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// PASSWORD_RESET_REQUESTED is emitted after sending an email with
+	// a password reset link. The object is a *UserEvent.
+	PASSWORD_RESET_REQUESTED = "gnd.la/apps/users.password-reset-requested"
+	// PASSWORD_RESET is emitted after a user sets a new password using
+	// a password reset link. The object is a *UserEvent.
+	PASSWORD_RESET = "gnd.la/apps/users.password-reset"
+	// EMAIL_VERIFICATION_SENT is emitted after sending an email with an
+	// email verification link. The object is a *UserEvent.
+	EMAIL_VERIFICATION_SENT = "gnd.la/apps/users.email-verification-sent"
+	// EMAIL_VERIFIED is emitted after a user verifies its email address.
+	// The object is a *UserEvent.
+	EMAIL_VERIFIED = "gnd.la/apps/users.email-verified"
+
+	tokenReset  = "reset"
+	tokenVerify = "verify"
+)
+
+var (
+	errTokenExpired = errors.New("token expired")
+	errTokenInvalid = errors.New("token is not valid anymore")
+)
+
+// newToken returns a signed and encrypted token for the given user,
+// which is only valid for the given kind of operation. The token
+// also includes a fingerprint of the user data it acts upon (the
+// password for resets, the email for verifications), so it can't
+// be used anymore once that data changes.
+func newToken(ctx *app.Context, kind string, user reflect.Value) (string, error) {
+	se, err := ctx.App().EncryptSigner(Salt)
+	if err != nil {
+		return "", err
+	}
+	values := make(url.Values)
+	values.Add("k", kind)
+	values.Add("u", strconv.FormatInt(asGondolaUser(user).Id(), 36))
+	values.Add("t", strconv.FormatInt(time.Now().Unix(), 36))
+	values.Add("f", tokenFingerprint(kind, user))
+	values.Add("n", stringutil.Random(32))
+	return se.EncryptSign([]byte(values.Encode()))
+}
+
+// decodeToken decodes a token generated by newToken, returning
+// its user. Tokens older than expiry are rejected.
+func decodeToken(ctx *app.Context, kind string, token string, expiry time.Duration) (reflect.Value, error) {
+	se, err := ctx.App().EncryptSigner(Salt)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	value, err := se.UnsignDecrypt(token)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	qs, err := url.ParseQuery(string(value))
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if qs.Get("k") != kind {
+		return reflect.Value{}, errTokenInvalid
+	}
+	userId, err := strconv.ParseInt(qs.Get("u"), 36, 64)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	ts, err := strconv.ParseInt(qs.Get("t"), 36, 64)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if time.Since(time.Unix(ts, 0)) > expiry {
+		return reflect.Value{}, errTokenExpired
+	}
+	user, userVal := newEmptyUser()
+	if !ctx.Orm().MustOne(ById(userId), userVal) {
+		return reflect.Value{}, errNoSuchUser
+	}
+	if qs.Get("f") != tokenFingerprint(kind, user) {
+		return reflect.Value{}, errTokenInvalid
+	}
+	return user, nil
+}
+
+func tokenFingerprint(kind string, user reflect.Value) string {
+	var data string
+	switch kind {
+	case tokenReset:
+		data = fmt.Sprint(getUserValue(user, "Password"))
+	case tokenVerify:
+		data = Normalize(getUserValue(user, "Email").(string))
+	}
+	h := sha1.Sum([]byte(kind + data))
+	return hex.EncodeToString(h[:8])
+}
+
+// sendTokenMail sends an email to the given user using the given template,
+// which receives the URL for the handler with the given name, including a
+// new token in its p parameter.
+func sendTokenMail(ctx *app.Context, kind string, user reflect.Value, handlerName string, template string, subject string) {
+	token, err := newToken(ctx, kind, user)
+	if err != nil {
+		panic(err)
+	}
+	abs := ctx.URL()
+	u := fmt.Sprintf("%s://%s%s?p=%s", abs.Scheme, abs.Host, ctx.MustReverse(handlerName), url.QueryEscape(token))
+	data := map[string]interface{}{
+		"URL":  u,
+		"User": user.Interface(),
+	}
+	from := mail.DefaultFrom()
+	if from == "" {
+		from = fmt.Sprintf("no-reply@%s", abs.Host)
+	}
+	msg := &mail.Message{
+		To:      getUserValue(user, "Email").(string),
+		From:    from,
+		Subject: subject,
+	}
+	ctx.MustSendMail(template, data, msg)
+}
+
+// SendEmailVerification sends an email to the given user, which should be a
+// pointer to the type set with SetType, with a link for verifying its email
+// address. The link is valid for EmailVerificationExpiry.
+func SendEmailVerification(ctx *app.Context, user interface{}) {
+	userVal := reflect.ValueOf(user)
+	sendTokenMail(ctx, tokenVerify, userVal, VerifyEmailHandlerName, VerifyEmailMailTemplateName,
+		fmt.Sprintf(ctx.T("Verify your %s email address"), SiteName))
+	emitUserEvent(EMAIL_VERIFICATION_SENT, ctx, userVal)
+}
+
+func verifyEmailHandler(ctx *app.Context) {
+	var valid, expired, sent bool
+	var user reflect.Value
+	var resend *form.Form
+	if ctx.User() != nil {
+		// The form has no fields, it only provides CSRF protection
+		// for the requests to send a new verification email.
+		resend = form.New(ctx)
+	}
+	if payload := ctx.FormValue("p"); payload != "" {
+		var err error
+		user, err = decodeToken(ctx, tokenVerify, payload, EmailVerificationExpiry)
+		if err == nil {
+			valid = true
+			if !getUserValue(user, "EmailVerified").(bool) {
+				setUserValue(user, "EmailVerified", true)
+				ctx.Orm().MustSave(user.Interface())
+				emitUserEvent(EMAIL_VERIFIED, ctx, user)
+			}
+		} else if err == errTokenExpired {
+			expired = true
+		}
+	} else if resend != nil && resend.Submitted() && resend.IsValid() {
+		// Send a new verification email
+		user = reflect.ValueOf(ctx.User())
+		if !getUserValue(user, "EmailVerified").(bool) && getUserValue(user, "Email").(string) != "" {
+			SendEmailVerification(ctx, user.Interface())
+			sent = true
+		}
+	}
+	data := map[string]interface{}{
+		"Valid":   valid,
+		"Expired": expired,
+		"Sent":    sent,
+		"Form":    resend,
+	}
+	if user.IsValid() {
+		data["User"] = user.Interface()
+	}
+	ctx.MustExecute(VerifyEmailTemplateName, data)
+}
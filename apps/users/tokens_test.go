@@ -0,0 +1,139 @@
+package users
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+)
+
+// tokenAt returns a token like the ones returned by newToken,
+// but created at the given time.
+func tokenAt(t *testing.T, ctx *app.Context, kind string, user reflect.Value, ts time.Time) string {
+	se, err := ctx.App().EncryptSigner(Salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := make(url.Values)
+	values.Add("k", kind)
+	values.Add("u", strconv.FormatInt(asGondolaUser(user).Id(), 36))
+	values.Add("t", strconv.FormatInt(ts.Unix(), 36))
+	values.Add("f", tokenFingerprint(kind, user))
+	token, err := se.EncryptSign([]byte(values.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestTokens(t *testing.T) {
+	if err := runCommand(createUser, map[string]string{"password": "secret1", "email": "tokens@example.com"}, "tokens"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := commandsApp.NewContext(nil)
+	defer commandsApp.CloseContext(ctx)
+	user := findTestUser(t, "tokens")
+	userVal := reflect.ValueOf(user)
+	for _, kind := range []string{tokenReset, tokenVerify} {
+		token, err := newToken(ctx, kind, userVal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := decodeToken(ctx, kind, token, time.Hour)
+		if err != nil {
+			t.Fatalf("error decoding %s token: %s", kind, err)
+		}
+		if id := asGondolaUser(decoded).Id(); id != user.Id() {
+			t.Errorf("expecting %s token for user %d, got %d", kind, user.Id(), id)
+		}
+	}
+	// Tokens are only valid for their kind
+	token, err := newToken(ctx, tokenReset, userVal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decodeToken(ctx, tokenVerify, token, time.Hour); err != errTokenInvalid {
+		t.Errorf("expecting errTokenInvalid decoding a reset token as a verification one, got %v", err)
+	}
+}
+
+func TestTokenExpiry(t *testing.T) {
+	if err := runCommand(createUser, map[string]string{"password": "secret1"}, "tokenexpiry"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := commandsApp.NewContext(nil)
+	defer commandsApp.CloseContext(ctx)
+	userVal := reflect.ValueOf(findTestUser(t, "tokenexpiry"))
+	token := tokenAt(t, ctx, tokenReset, userVal, time.Now().Add(-2*time.Hour))
+	if _, err := decodeToken(ctx, tokenReset, token, time.Hour); err != errTokenExpired {
+		t.Errorf("expecting errTokenExpired, got %v", err)
+	}
+	if _, err := decodeToken(ctx, tokenReset, token, 3*time.Hour); err != nil {
+		t.Errorf("expecting a valid token with a longer expiry, got %v", err)
+	}
+}
+
+func TestTokenFingerprint(t *testing.T) {
+	if err := runCommand(createUser, map[string]string{"password": "secret1", "email": "fingerprint@example.com"}, "fingerprint"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := commandsApp.NewContext(nil)
+	defer commandsApp.CloseContext(ctx)
+	user := findTestUser(t, "fingerprint")
+	userVal := reflect.ValueOf(user)
+	reset, err := newToken(ctx, tokenReset, userVal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verify, err := newToken(ctx, tokenVerify, userVal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Changing the password invalidates the reset tokens,
+	// so they can only be used once.
+	user.Password = mustNewPassword("secret2")
+	ctx.Orm().MustSave(user)
+	if _, err := decodeToken(ctx, tokenReset, reset, time.Hour); err != errTokenInvalid {
+		t.Errorf("expecting errTokenInvalid after changing the password, got %v", err)
+	}
+	if _, err := decodeToken(ctx, tokenVerify, verify, time.Hour); err != nil {
+		t.Errorf("expecting verification token to be valid after changing the password, got %v", err)
+	}
+	// Changing the email invalidates the verification tokens
+	user.Email = "other@example.com"
+	ctx.Orm().MustSave(user)
+	if _, err := decodeToken(ctx, tokenVerify, verify, time.Hour); err != errTokenInvalid {
+		t.Errorf("expecting errTokenInvalid after changing the email, got %v", err)
+	}
+}
+
+func TestTokenTampered(t *testing.T) {
+	if err := runCommand(createUser, map[string]string{"password": "secret1"}, "tampered"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := commandsApp.NewContext(nil)
+	defer commandsApp.CloseContext(ctx)
+	token, err := newToken(ctx, tokenReset, reflect.ValueOf(findTestUser(t, "tampered")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Don't change the last character, since some of its
+	// bits might be ignored when decoding the signature.
+	for _, ii := range []int{0, len(token) / 2, len(token) - 2} {
+		b := []byte(token)
+		if b[ii] == 'A' {
+			b[ii] = 'B'
+		} else {
+			b[ii] = 'A'
+		}
+		if user, err := decodeToken(ctx, tokenReset, string(b), time.Hour); err == nil || user.IsValid() {
+			t.Errorf("expecting an error decoding token tampered at %d", ii)
+		}
+	}
+	if _, err := decodeToken(ctx, tokenReset, "", time.Hour); err == nil {
+		t.Error("expecting an error decoding an empty token")
+	}
+}
@@ -0,0 +1,382 @@
+package users
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/crypto/password"
+	"gnd.la/crypto/totp"
+	"gnd.la/form"
+	"gnd.la/i18n"
+	"gnd.la/log"
+	"gnd.la/signal"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// TWO_FACTOR_ENABLED is emitted when a user enables two factor
+	// authentication. The object is a *TwoFactorEvent.
+	TWO_FACTOR_ENABLED = "gnd.la/apps/users.two-factor-enabled"
+	// TWO_FACTOR_DISABLED is emitted when a user disables two factor
+	// authentication. The object is a *TwoFactorEvent.
+	TWO_FACTOR_DISABLED = "gnd.la/apps/users.two-factor-disabled"
+	// TWO_FACTOR_SUCCEEDED is emitted when a user provides a valid
+	// code while signing in. The object is a *TwoFactorEvent.
+	TWO_FACTOR_SUCCEEDED = "gnd.la/apps/users.two-factor-succeeded"
+	// TWO_FACTOR_FAILED is emitted when a user provides an invalid
+	// code while signing in or managing two factor authentication.
+	// The object is a *TwoFactorEvent.
+	TWO_FACTOR_FAILED = "gnd.la/apps/users.two-factor-failed"
+	// RECOVERY_CODE_USED is emitted when a user signs in using a
+	// recovery code. The object is a *TwoFactorEvent.
+	RECOVERY_CODE_USED = "gnd.la/apps/users.recovery-code-used"
+	// RECOVERY_CODES_GENERATED is emitted when a new set of recovery
+	// codes is generated for a user. The object is a *TwoFactorEvent.
+	RECOVERY_CODES_GENERATED = "gnd.la/apps/users.recovery-codes-generated"
+
+	twoFactorCookieName       = "users-two-factor"
+	twoFactorEnrollCookieName = "users-two-factor-enroll"
+)
+
+var (
+	ErrInvalidCode = i18n.NewError("invalid code")
+	// ErrTwoFactorLocked is returned while a user can't enter
+	// two factor authentication codes, after entering
+	// MaxFailedTwoFactorCodes invalid ones.
+	ErrTwoFactorLocked = i18n.NewError("too many invalid codes, try again later")
+
+	// RecoveryCodeCount is the number of recovery codes
+	// generated when enabling two factor authentication.
+	RecoveryCodeCount = 10
+	// TwoFactorTimeout is the maximum time between entering
+	// the password and the two factor authentication code.
+	TwoFactorTimeout = 5 * time.Minute
+	// TwoFactorSkew is the number of time steps before and after
+	// the current one whose codes are also accepted, to allow for
+	// clock drift.
+	TwoFactorSkew = 1
+	// MaxFailedTwoFactorCodes is the number of consecutive invalid
+	// two factor authentication or recovery codes after which no
+	// more codes are accepted for the user during
+	// TwoFactorLockoutDuration. Unlike MaxFailedSignIns, it's
+	// enabled by default, since only someone knowing the user
+	// password can enter codes.
+	MaxFailedTwoFactorCodes = 5
+	// TwoFactorLockoutDuration is the cool-down after entering
+	// MaxFailedTwoFactorCodes invalid codes.
+	TwoFactorLockoutDuration = 15 * time.Minute
+)
+
+// TwoFactorEvent is the object emitted with the two factor
+// authentication signals, intended for audit logging.
+type TwoFactorEvent struct {
+	// Ctx is the request context
+	Ctx *app.Context
+	// User is the user which triggered the signal, as a
+	// pointer to the type set with SetType.
+	User interface{}
+}
+
+func emitTwoFactor(name string, ctx *app.Context, user reflect.Value) {
+	signal.Emit(name, &TwoFactorEvent{Ctx: ctx, User: user.Interface()})
+}
+
+type pendingSignIn struct {
+	Id      int64
+	Expires int64
+}
+
+// TwoFactorEnabled returns true iff the given user has two
+// factor authentication enabled.
+func TwoFactorEnabled(user interface{}) bool {
+	s, _ := getUserValue(reflect.ValueOf(user), "TwoFactorSecret").(string)
+	return s != ""
+}
+
+// signInUser signs in the given user, unless it has two factor authentication
+// enabled or required. In that case, the user is stored as pending in an
+// encrypted cookie and it must enter a valid code in SignInTwoFactorHandler
+// (enabling two factor authentication first, if it's required but not enabled
+// yet) before being signed in. If the returned string is not empty, the user
+// must be redirected to it.
+func signInUser(ctx *app.Context, user reflect.Value) string {
+	from := ctx.FormValue(app.SignInFromParameterName)
+	if TwoFactorEnabled(user.Interface()) || getUserValue(user, "TwoFactorRequired").(bool) {
+		pending := &pendingSignIn{
+			Id:      asGondolaUser(user).Id(),
+			Expires: time.Now().Add(TwoFactorTimeout).Unix(),
+		}
+		if err := ctx.Cookies().SetEncrypted(twoFactorCookieName, pending); err != nil {
+			panic(err)
+		}
+		return withFrom(ctx.MustReverse(SignInTwoFactorHandlerName), from)
+	}
+	ctx.MustSignIn(asGondolaUser(user))
+	return ""
+}
+
+func withFrom(u string, from string) string {
+	if from == "" {
+		return u
+	}
+	return u + "?" + app.SignInFromParameterName + "=" + url.QueryEscape(from)
+}
+
+// verifyTwoFactor checks the given code against the user TOTP secret
+// and, if it doesn't match, against its recovery codes. Used recovery
+// codes are removed. Invalid codes are counted and, after
+// MaxFailedTwoFactorCodes of them, ErrTwoFactorLocked is returned
+// without checking the code until TwoFactorLockoutDuration elapses.
+// The user is saved when its data changes.
+func verifyTwoFactor(ctx *app.Context, user reflect.Value, code string) error {
+	if MaxFailedTwoFactorCodes > 0 {
+		if until := getUserValue(user, "TwoFactorLocked").(time.Time); time.Now().UTC().Before(until) {
+			return ErrTwoFactorLocked
+		}
+	}
+	err := checkTwoFactorCode(ctx, user, code)
+	failures := getUserValue(user, "TwoFactorFailures").(int)
+	if err == nil {
+		if failures > 0 {
+			setUserValue(user, "TwoFactorFailures", 0)
+			ctx.Orm().MustSave(user.Interface())
+		}
+		return nil
+	}
+	if MaxFailedTwoFactorCodes <= 0 {
+		return err
+	}
+	failures++
+	if failures >= MaxFailedTwoFactorCodes {
+		setUserValue(user, "TwoFactorLocked", time.Now().UTC().Add(TwoFactorLockoutDuration))
+		failures = 0
+		err = ErrTwoFactorLocked
+	}
+	setUserValue(user, "TwoFactorFailures", failures)
+	ctx.Orm().MustSave(user.Interface())
+	return err
+}
+
+func checkTwoFactorCode(ctx *app.Context, user reflect.Value, code string) error {
+	secret := getUserValue(user, "TwoFactorSecret").(string)
+	last := getUserValue(user, "TwoFactorStep").(int64)
+	if step, ok := totp.Verify(secret, code, time.Now(), TwoFactorSkew); ok && step > last {
+		setUserValue(user, "TwoFactorStep", step)
+		ctx.Orm().MustSave(user.Interface())
+		return nil
+	}
+	code = normalizeRecoveryCode(code)
+	codes := recoveryCodes(user)
+	for ii, v := range codes {
+		if v.Matches(code) {
+			codes = append(codes[:ii], codes[ii+1:]...)
+			setRecoveryCodes(user, codes)
+			ctx.Orm().MustSave(user.Interface())
+			emitTwoFactor(RECOVERY_CODE_USED, ctx, user)
+			return nil
+		}
+	}
+	return ErrInvalidCode
+}
+
+func recoveryCodes(user reflect.Value) []password.Password {
+	var codes []password.Password
+	for _, v := range strings.Split(getUserValue(user, "RecoveryCodes").(string), ",") {
+		if v != "" {
+			codes = append(codes, password.Password(v))
+		}
+	}
+	return codes
+}
+
+func setRecoveryCodes(user reflect.Value, codes []password.Password) {
+	s := make([]string, len(codes))
+	for ii, v := range codes {
+		s[ii] = v.String()
+	}
+	setUserValue(user, "RecoveryCodes", strings.Join(s, ","))
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.Replace(strings.ToLower(strings.TrimSpace(code)), "-", "", -1)
+}
+
+// newRecoveryCodes generates RecoveryCodeCount new recovery codes for
+// the given user, replacing the previous ones. Only their hashes are
+// stored, so the returned codes must be shown to the user right away.
+func newRecoveryCodes(ctx *app.Context, user reflect.Value) []string {
+	codes := make([]string, RecoveryCodeCount)
+	hashed := make([]password.Password, RecoveryCodeCount)
+	for ii := range codes {
+		c := strings.ToLower(stringutil.Random(10))
+		codes[ii] = c[:5] + "-" + c[5:]
+		hashed[ii] = password.New(c)
+	}
+	setRecoveryCodes(user, hashed)
+	emitTwoFactor(RECOVERY_CODES_GENERATED, ctx, user)
+	return codes
+}
+
+func signInTwoFactorHandler(ctx *app.Context) {
+	var pending pendingSignIn
+	err := ctx.Cookies().GetEncrypted(twoFactorCookieName, &pending)
+	if err != nil || time.Now().Unix() > pending.Expires {
+		// Start again
+		ctx.MustRedirectReverse(false, SignInHandlerName)
+		return
+	}
+	user, userVal := newEmptyUser()
+	if !ctx.Orm().MustOne(ById(pending.Id), userVal) {
+		ctx.MustRedirectReverse(false, SignInHandlerName)
+		return
+	}
+	if !TwoFactorEnabled(userVal) {
+		if getUserValue(user, "TwoFactorRequired").(bool) {
+			// The user must enable two factor authentication
+			// before being signed in.
+			manageTwoFactor(ctx, user, true)
+			return
+		}
+		ctx.MustRedirectReverse(false, SignInHandlerName)
+		return
+	}
+	var fields struct {
+		Code         string `form:",singleline,label=Authentication code"`
+		ValidateCode func(*app.Context) error
+	}
+	fields.ValidateCode = func(c *app.Context) error {
+		err := verifyTwoFactor(c, user, fields.Code)
+		if err != nil {
+			emitTwoFactor(TWO_FACTOR_FAILED, c, user)
+		}
+		if err = checkLockout(c, user, err); err == ErrAccountLocked || err == ErrTwoFactorLocked {
+			// Require the password again
+			c.Cookies().Delete(twoFactorCookieName)
+		}
+		return err
+	}
+	f := form.New(ctx, &fields)
+	if f.Submitted() && f.IsValid() {
+		ctx.Cookies().Delete(twoFactorCookieName)
+		ctx.MustSignIn(asGondolaUser(user))
+		emitTwoFactor(TWO_FACTOR_SUCCEEDED, ctx, user)
+		redirectToFrom(ctx)
+		return
+	}
+	data := map[string]interface{}{
+		"From": ctx.FormValue(app.SignInFromParameterName),
+		"Form": f,
+	}
+	ctx.MustExecute(SignInTwoFactorTemplateName, data)
+}
+
+func twoFactorHandler(ctx *app.Context) {
+	manageTwoFactor(ctx, reflect.ValueOf(ctx.User()), false)
+}
+
+// manageTwoFactor lets the user enable or disable two factor
+// authentication, as well as generate new recovery codes. If
+// pending is true, the user has entered its password but it's
+// not signed in yet, because two factor authentication is
+// required for it. In that case, the user is only signed in
+// after enabling it.
+func manageTwoFactor(ctx *app.Context, user reflect.Value, pending bool) {
+	enabled := TwoFactorEnabled(user.Interface())
+	var secret string
+	if !enabled {
+		// Keep the secret in a cookie until the user enters
+		// a valid code, so reloading the page doesn't
+		// invalidate the one added to the authenticator.
+		if err := ctx.Cookies().GetEncrypted(twoFactorEnrollCookieName, &secret); err != nil || secret == "" {
+			secret = totp.NewSecret()
+			if err := ctx.Cookies().SetEncrypted(twoFactorEnrollCookieName, secret); err != nil {
+				panic(err)
+			}
+		}
+	}
+	var fields struct {
+		Code         string `form:",singleline,label=Authentication code"`
+		ValidateCode func(*app.Context) error
+	}
+	fields.ValidateCode = func(c *app.Context) error {
+		if enabled {
+			if err := verifyTwoFactor(c, user, fields.Code); err != nil {
+				emitTwoFactor(TWO_FACTOR_FAILED, c, user)
+				return err
+			}
+			return nil
+		}
+		step, ok := totp.Verify(secret, fields.Code, time.Now(), TwoFactorSkew)
+		if !ok {
+			emitTwoFactor(TWO_FACTOR_FAILED, c, user)
+			return ErrInvalidCode
+		}
+		setUserValue(user, "TwoFactorStep", step)
+		return nil
+	}
+	f := form.New(ctx, &fields)
+	var codes []string
+	if f.Submitted() && f.IsValid() {
+		switch {
+		case !enabled:
+			setUserValue(user, "TwoFactorSecret", secret)
+			codes = newRecoveryCodes(ctx, user)
+			ctx.Cookies().Delete(twoFactorEnrollCookieName)
+			emitTwoFactor(TWO_FACTOR_ENABLED, ctx, user)
+			enabled = true
+			if pending {
+				ctx.Cookies().Delete(twoFactorCookieName)
+				ctx.MustSignIn(asGondolaUser(user))
+				pending = false
+			}
+		case pending:
+			// Only enabling is allowed before signing in
+			ctx.Forbidden(ctx.T("you must sign in first"))
+			return
+		case ctx.FormValue("disable") != "":
+			if getUserValue(user, "TwoFactorRequired").(bool) {
+				ctx.Forbidden(ctx.T("two factor authentication is required for your account"))
+				return
+			}
+			setUserValue(user, "TwoFactorSecret", "")
+			setUserValue(user, "TwoFactorStep", int64(0))
+			setRecoveryCodes(user, nil)
+			emitTwoFactor(TWO_FACTOR_DISABLED, ctx, user)
+			enabled = false
+		default:
+			codes = newRecoveryCodes(ctx, user)
+		}
+		ctx.Orm().MustSave(user.Interface())
+	}
+	data := map[string]interface{}{
+		"Enabled":       enabled,
+		"Required":      getUserValue(user, "TwoFactorRequired").(bool),
+		"Form":          f,
+		"RecoveryCodes": codes,
+		"RecoveryLeft":  len(recoveryCodes(user)),
+		"From":          ctx.FormValue(app.SignInFromParameterName),
+		"Action":        ctx.MustReverse(TwoFactorHandlerName),
+	}
+	if pending {
+		data["Action"] = ctx.MustReverse(SignInTwoFactorHandlerName)
+	}
+	if !enabled {
+		account, _ := getUserValue(user, "Username").(string)
+		data["Secret"] = secret
+		data["URI"] = totp.URI(secret, SiteName, account)
+	}
+	ctx.MustExecute(TwoFactorTemplateName, data)
+}
+
+func init() {
+	// Log the failures, so they're visible even if the
+	// app doesn't listen to the signals.
+	signal.Listen(TWO_FACTOR_FAILED, func(_ string, obj interface{}) {
+		ev := obj.(*TwoFactorEvent)
+		log.Warningf("invalid two factor authentication code for user %d from %s",
+			asGondolaUser(reflect.ValueOf(ev.User)).Id(), ev.Ctx.RemoteAddress())
+	})
+}
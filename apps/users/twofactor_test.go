@@ -0,0 +1,60 @@
+package users
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"gnd.la/crypto/totp"
+)
+
+func TestTwoFactorLockout(t *testing.T) {
+	if err := runCommand(createUser, map[string]string{"password": "secret1"}, "twofactor"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := commandsApp.NewContext(nil)
+	defer commandsApp.CloseContext(ctx)
+	// Password lockout is disabled by default, codes
+	// must be limited regardless.
+	if MaxFailedSignIns > 0 {
+		t.Fatalf("MaxFailedSignIns should be disabled by default, it's %d", MaxFailedSignIns)
+	}
+	user := findTestUser(t, "twofactor")
+	user.TwoFactorSecret = totp.NewSecret()
+	ctx.Orm().MustSave(user)
+	userVal := reflect.ValueOf(user)
+	code := func() string {
+		c, err := totp.Code(user.TwoFactorSecret, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	for ii := 1; ii < MaxFailedTwoFactorCodes; ii++ {
+		if err := verifyTwoFactor(ctx, userVal, "wrong"); err != ErrInvalidCode {
+			t.Fatalf("attempt %d: expecting ErrInvalidCode, got %v", ii, err)
+		}
+	}
+	if err := verifyTwoFactor(ctx, userVal, "wrong"); err != ErrTwoFactorLocked {
+		t.Fatalf("expecting ErrTwoFactorLocked after %d invalid codes, got %v", MaxFailedTwoFactorCodes, err)
+	}
+	// The lock is stored, so requesting a new pending
+	// sign in doesn't reset it.
+	user = findTestUser(t, "twofactor")
+	userVal = reflect.ValueOf(user)
+	if err := verifyTwoFactor(ctx, userVal, code()); err != ErrTwoFactorLocked {
+		t.Fatalf("expecting ErrTwoFactorLocked with a valid code, got %v", err)
+	}
+	if user.TwoFactorStep != 0 {
+		t.Error("valid code was consumed while locked")
+	}
+	// After the cool-down, valid codes are accepted again
+	user.TwoFactorLocked = time.Now().UTC().Add(-time.Second)
+	ctx.Orm().MustSave(user)
+	if err := verifyTwoFactor(ctx, userVal, code()); err != nil {
+		t.Fatalf("expecting valid code after the cool-down, got %v", err)
+	}
+	if user.TwoFactorFailures != 0 {
+		t.Errorf("expecting no failures after a valid code, got %d", user.TwoFactorFailures)
+	}
+}
@@ -83,12 +83,21 @@ type User struct {
 	NormalizedUsername string            `form:"-" orm:",unique" json:"-"`
 	Email              string            `form:",max_length=50,label=Email" json:"-"`
 	NormalizedEmail    string            `form:"-" orm:",unique" json:"-"`
+	EmailVerified      bool              `form:"-" orm:",default=false" json:"-"`
 	Password           password.Password `form:"-,min_length=6,label=Password" json:"-"`
 	Created            time.Time         `json:"-" form:"-"`
 	AutomaticUsername  bool              `form:"-" json:"-"`
 	Admin              bool              `form:"-" orm:",default=false" json:"admin"`
 	Image              string            `form:"-" orm:",omitempty,nullempty" json:"-"`
 	ImageFormat        string            `form:"-" orm:",omitempty,nullempty" json:"-"`
+	FailedSignIns      int               `form:"-" orm:",default=0" json:"-"`
+	LockedUntil        time.Time         `form:"-" json:"-"`
+	TwoFactorSecret    string            `form:"-" orm:",omitempty,nullempty" json:"-"`
+	TwoFactorStep      int64             `form:"-" orm:",default=0" json:"-"`
+	TwoFactorRequired  bool              `form:"-" orm:",default=false" json:"-"`
+	TwoFactorFailures  int               `form:"-" orm:",default=0" json:"-"`
+	TwoFactorLocked    time.Time         `form:"-" json:"-"`
+	RecoveryCodes      string            `form:"-" orm:",omitempty,nullempty" json:"-"`
 }
 
 func (u *User) Id() int64 {
@@ -214,6 +223,20 @@ func newEmptyUser() (reflect.Value, interface{}) {
 	return user, user.Interface()
 }
 
+// UserEvent is the object emitted with the signals
+// sent by this app, intended for audit logging.
+type UserEvent struct {
+	// Ctx is the request context
+	Ctx *app.Context
+	// User is the user which triggered the signal, as a
+	// pointer to the type set with SetType.
+	User interface{}
+}
+
+func emitUserEvent(name string, ctx *app.Context, user reflect.Value) {
+	signal.Emit(name, &UserEvent{Ctx: ctx, User: user.Interface()})
+}
+
 func init() {
 	signal.Listen(app.DID_PREPARE, func() {
 		checkUserType(userType)
@@ -1,6 +1,9 @@
 package users
 
 import (
+	"time"
+
+	"gnd.la/crypto/password"
 	"gnd.la/social/facebook"
 	"gnd.la/social/github"
 	"gnd.la/social/google"
@@ -23,6 +26,26 @@ var (
 	// AllowRegistration can be used to disable user registration. Only existing users
 	// and social accounts will be able to log in.
 	AllowRegistration = true
+	// VerifyEmails makes the app send an email with a verification link
+	// to new users. Once they open it, their EmailVerified field is set.
+	// Use SendEmailVerification to send the email manually.
+	VerifyEmails = false
+
+	// AuthBackends are the backends used for authenticating users which
+	// sign in with a username and a password, tried in order.
+	AuthBackends = []AuthBackend{DatabaseBackend{}}
+	// PasswordHasher is used for encoding new passwords. Existing passwords
+	// encoded with another algorithm or with weaker parameters are encoded
+	// again after the user successfully signs in. If nil, passwords are
+	// encoded with password.New.
+	PasswordHasher password.Hasher
+	// MaxFailedSignIns is the number of consecutive failed sign in attempts
+	// after which an account is locked for LockoutDuration. It defaults to
+	// zero, which disables account lockout. Note that anyone knowing a
+	// username can lock its account out, so consider the trade-off
+	// before enabling it.
+	MaxFailedSignIns = 0
+	LockoutDuration  = 15 * time.Minute
 
 	SocialOrder = []string{SocialTypeFacebook, SocialTypeTwitter, SocialTypeGoogle, SocialTypeGithub}
 )
@@ -8,6 +8,7 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"time"
 
 	"gnd.la/blobstore/driver"
 	_ "gnd.la/blobstore/driver/file"
@@ -16,7 +17,9 @@ import (
 
 var (
 	imports = map[string]string{
+		"azure":  "gnd.la/blobstore/driver/azure",
 		"file":   "gnd.la/blobstore/driver/file",
+		"gcs":    "gnd.la/blobstore/driver/gcs",
 		"gridfs": "gnd.la/blobstore/driver/gridfs",
 		"s3":     "gnd.la/blobstore/driver/s3",
 	}
@@ -24,6 +27,9 @@ var (
 	// ErrNotIterable indicates that the current blobstore driver
 	// does not support iteration.
 	ErrNotIterable = errors.New("the blobstore driver does not support iteration")
+	// ErrNotSignable indicates that the current blobstore driver
+	// does not support presigned URLs.
+	ErrNotSignable = errors.New("the blobstore driver does not support presigned URLs")
 )
 
 const (
@@ -54,10 +60,11 @@ type Iter interface {
 // Blobstore represents a connection to a blobstore. Use New()
 // to initialize a Blobsore and Blobstore.Close to close it.
 type Blobstore struct {
-	drv       driver.Driver
-	srv       driver.Server
-	drvName   string
-	drvNoMeta bool
+	drv        driver.Driver
+	srv        driver.Server
+	drvName    string
+	drvNoMeta  bool
+	processors []Processor
 }
 
 // New returns a new *Blobstore using the given url as its configure
@@ -81,8 +88,9 @@ func New(url *config.URL) (*Blobstore, error) {
 		return nil, fmt.Errorf("error opening blobstore driver %q: %s", url.Scheme, err)
 	}
 	s := &Blobstore{
-		drv:     drv,
-		drvName: url.Scheme,
+		drv:        drv,
+		drvName:    url.Scheme,
+		processors: registeredProcessors(),
 	}
 	if srv, ok := drv.(driver.Server); ok {
 		s.srv = srv
@@ -100,6 +108,10 @@ func (s *Blobstore) Create() (*WFile, error) {
 // CreateId works like Create, but uses the given id rather than generating
 // a new one. If a file with the same id already exists, it's overwritten.
 func (s *Blobstore) CreateId(id string) (*WFile, error) {
+	return s.createId(id, true)
+}
+
+func (s *Blobstore) createId(id string, process bool) (*WFile, error) {
 	if strings.HasSuffix(id, metaSuffix) {
 		return nil, fmt.Errorf("invalid id %s, can't end with .meta", id)
 	}
@@ -110,12 +122,16 @@ func (s *Blobstore) CreateId(id string) (*WFile, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &WFile{
+	f := &WFile{
 		id:       id,
 		file:     w,
 		dataHash: newHash(),
 		store:    s,
-	}, nil
+	}
+	if process && len(s.processors) > 0 {
+		f.processing = &processing{}
+	}
+	return f, nil
 }
 
 // Open opens the file with the given id for reading. Note that
@@ -164,8 +180,18 @@ func (s *Blobstore) StoreId(id string, b []byte, meta interface{}) (string, erro
 	return f.Id(), nil
 }
 
-// Remove deletes the file with the given id.
+// Remove deletes the file with the given id, as well as
+// any files derived from it (see ProcessedFile.Derive).
 func (s *Blobstore) Remove(id string) error {
+	if f, err := s.Open(id); err == nil {
+		info, err := f.Info()
+		f.Close()
+		if err == nil {
+			for _, v := range info.Derived {
+				s.Remove(v)
+			}
+		}
+	}
 	s.drv.Remove(s.metaName(id))
 	return s.drv.Remove(id)
 }
@@ -219,6 +245,32 @@ func (s *Blobstore) Serve(w http.ResponseWriter, id string, rng *Range) error {
 	return nil
 }
 
+// URL returns a presigned URL which can be used for downloading
+// the file with the given id directly from the storage backend,
+// without any additional credentials, until expiry elapses. If
+// the underlying driver does not support presigned URLs,
+// ("", ErrNotSignable) will be returned.
+func (s *Blobstore) URL(id string, expiry time.Duration) (string, error) {
+	return s.signURL(id, "GET", expiry)
+}
+
+// UploadURL works like URL, but the returned URL allows uploading
+// the file with the given id using a PUT request. Note that files
+// uploaded this way don't contain any blobstore metadata, so they
+// can only be retrieved with URL or by reading the file from the
+// driver.
+func (s *Blobstore) UploadURL(id string, expiry time.Duration) (string, error) {
+	return s.signURL(id, "PUT", expiry)
+}
+
+func (s *Blobstore) signURL(id string, method string, expiry time.Duration) (string, error) {
+	signer, ok := s.drv.(driver.Signer)
+	if !ok {
+		return "", ErrNotSignable
+	}
+	return signer.SignURL(id, method, expiry)
+}
+
 // Iter returns an iterator which visits all the files
 // available in the blobstore. If the underlying driver
 // does not support iteration, (nil, ErrNotIterable) will be returned.
@@ -0,0 +1,295 @@
+package azure
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"gnd.la/blobstore/driver"
+	"gnd.la/blobstore/driver/internal/remote"
+	"gnd.la/config"
+)
+
+const (
+	apiVersion = "2020-12-06"
+	// requestExpiry is the validity of the SAS tokens
+	// used for the requests made by the driver.
+	requestExpiry = 15 * time.Minute
+	sasTimeFormat = "2006-01-02T15:04:05Z"
+)
+
+// Error represents an error returned by the server.
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *Error) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("azure error %s (status %d): %s", e.Code, e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("azure error with status %d", e.StatusCode)
+}
+
+type azureDriver struct {
+	account   string
+	container string
+	key       []byte
+	endpoint  string
+	scope     string
+	partSize  int
+	client    *http.Client
+	now       func() time.Time
+}
+
+func (d *azureDriver) time() time.Time {
+	if d.now != nil {
+		return d.now()
+	}
+	return time.Now()
+}
+
+// sas returns the query parameters for a service SAS token which
+// allows the given permissions on the given blob until expiry.
+func (d *azureDriver) sas(blob string, perms string, expiry time.Time) url.Values {
+	se := expiry.UTC().Format(sasTimeFormat)
+	toSign := strings.Join([]string{
+		perms,
+		"", // start
+		se,
+		"/blob/" + d.account + "/" + d.container + "/" + blob,
+		"", // identifier
+		"", // IP
+		"", // protocol
+		apiVersion,
+		"b", // resource
+		"",  // snapshot time
+		d.scope,
+		"", // Cache-Control
+		"", // Content-Disposition
+		"", // Content-Encoding
+		"", // Content-Language
+		"", // Content-Type
+	}, "\n")
+	h := hmac.New(sha256.New, d.key)
+	h.Write([]byte(toSign))
+	values := url.Values{
+		"sv":  {apiVersion},
+		"sr":  {"b"},
+		"sp":  {perms},
+		"se":  {se},
+		"sig": {base64.StdEncoding.EncodeToString(h.Sum(nil))},
+	}
+	if d.scope != "" {
+		values.Set("ses", d.scope)
+	}
+	return values
+}
+
+func (d *azureDriver) blobURL(blob string, perms string, expiry time.Time, query url.Values) (string, error) {
+	u, err := url.Parse(d.endpoint)
+	if err != nil {
+		return "", err
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + d.container + "/" + blob
+	values := d.sas(blob, perms, expiry)
+	for k, v := range query {
+		values[k] = v
+	}
+	u.RawQuery = values.Encode()
+	return u.String(), nil
+}
+
+func (d *azureDriver) do(method string, blob string, perms string, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	u, err := d.blobURL(blob, perms, d.time().Add(requestExpiry), query)
+	if err != nil {
+		return nil, err
+	}
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, u, r)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("X-Ms-Version", apiVersion)
+	client := d.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		defer resp.Body.Close()
+		e := &Error{StatusCode: resp.StatusCode}
+		data, _ := ioutil.ReadAll(resp.Body)
+		xml.Unmarshal(data, e)
+		return nil, e
+	}
+	return resp, nil
+}
+
+func (d *azureDriver) request(method string, blob string, perms string, query url.Values, header http.Header, body []byte) (http.Header, error) {
+	resp, err := d.do(method, blob, perms, query, header, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	return resp.Header, nil
+}
+
+func (d *azureDriver) Size(blob string) (int64, error) {
+	h, err := d.request("HEAD", blob, "r", nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(h.Get("Content-Length"), 10, 64)
+}
+
+func (d *azureDriver) Get(blob string, offset int64) (io.ReadCloser, error) {
+	var header http.Header
+	if offset > 0 {
+		header = http.Header{"X-Ms-Range": {fmt.Sprintf("bytes=%d-", offset)}}
+	}
+	resp, err := d.do("GET", blob, "r", nil, header, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (d *azureDriver) Put(blob string, data []byte) error {
+	if data == nil {
+		data = []byte{}
+	}
+	header := http.Header{"X-Ms-Blob-Type": {"BlockBlob"}}
+	_, err := d.request("PUT", blob, "cw", nil, header, data)
+	return err
+}
+
+func (d *azureDriver) Upload(blob string) (remote.Upload, error) {
+	return &upload{drv: d, blob: blob}, nil
+}
+
+// upload implements remote.Upload using blocks, which
+// are committed by Complete. Blocks which are never
+// committed are discarded by the service.
+type upload struct {
+	drv    *azureDriver
+	blob   string
+	blocks []string
+}
+
+func (u *upload) Part(n int, data []byte) error {
+	// All block ids must have the same length
+	id := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", n)))
+	query := url.Values{"comp": {"block"}, "blockid": {id}}
+	if _, err := u.drv.request("PUT", u.blob, "w", query, nil, data); err != nil {
+		return err
+	}
+	u.blocks = append(u.blocks, id)
+	return nil
+}
+
+func (u *upload) Complete() error {
+	body, err := xml.Marshal(struct {
+		XMLName xml.Name `xml:"BlockList"`
+		Latest  []string
+	}{Latest: u.blocks})
+	if err != nil {
+		return err
+	}
+	_, err = u.drv.request("PUT", u.blob, "w", url.Values{"comp": {"blocklist"}}, nil, append([]byte(xml.Header), body...))
+	return err
+}
+
+func (u *upload) Abort() error {
+	return nil
+}
+
+func (d *azureDriver) Create(id string) (driver.WFile, error) {
+	return remote.NewWFile(d, id, d.partSize), nil
+}
+
+func (d *azureDriver) Open(id string) (driver.RFile, error) {
+	return remote.NewRFile(d, id)
+}
+
+func (d *azureDriver) Remove(id string) error {
+	_, err := d.request("DELETE", id, "d", nil, nil, nil)
+	return err
+}
+
+func (d *azureDriver) SignURL(id string, method string, expiry time.Duration) (string, error) {
+	var perms string
+	switch method {
+	case "GET":
+		perms = "r"
+	case "PUT":
+		perms = "cw"
+	default:
+		return "", fmt.Errorf("can't sign URL for method %s", method)
+	}
+	return d.blobURL(id, perms, d.time().Add(expiry), nil)
+}
+
+func (d *azureDriver) Close() error {
+	return nil
+}
+
+func azureOpener(url *config.URL) (driver.Driver, error) {
+	p := strings.SplitN(url.Value, "/", 2)
+	if len(p) != 2 || p[0] == "" || p[1] == "" {
+		return nil, fmt.Errorf("please, provide an account and a container e.g. azure://myaccount/mycontainer")
+	}
+	k := url.Fragment.Get("key")
+	if k == "" {
+		return nil, fmt.Errorf("no Azure account key provided")
+	}
+	key, err := base64.StdEncoding.DecodeString(k)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Azure account key: %s", err)
+	}
+	endpoint := url.Fragment.Get("endpoint")
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", p[0])
+	}
+	partSize := remote.DefaultPartSize
+	if s := url.Fragment.Get("part_size"); s != "" {
+		size, ok := url.Fragment.Int("part_size")
+		if !ok || size <= 0 {
+			return nil, fmt.Errorf("invalid Azure part size %q, must be a positive integer", s)
+		}
+		partSize = size * (1 << 20)
+	}
+	return &azureDriver{
+		account:   p[0],
+		container: p[1],
+		key:       key,
+		endpoint:  endpoint,
+		scope:     url.Fragment.Get("encryption_scope"),
+		partSize:  partSize,
+	}, nil
+}
+
+func init() {
+	driver.Register("azure", azureOpener)
+}
@@ -0,0 +1,146 @@
+package azure
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gnd.la/config"
+)
+
+type fakeServer struct {
+	drv    *azureDriver
+	mu     sync.Mutex
+	blobs  map[string][]byte
+	blocks map[string][]byte
+}
+
+func (s *fakeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	blob := strings.TrimPrefix(r.URL.Path, "/"+s.drv.container+"/")
+	q := r.URL.Query()
+	perms := map[string]string{"GET": "r", "HEAD": "r", "PUT": "w", "DELETE": "d"}[r.Method]
+	expiry, _ := time.Parse(sasTimeFormat, q.Get("se"))
+	expected := s.drv.sas(blob, q.Get("sp"), expiry).Get("sig")
+	if q.Get("sig") != expected || !strings.Contains(q.Get("sp"), perms) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, "<Error><Code>AuthenticationFailed</Code><Message>invalid signature</Message></Error>")
+		return
+	}
+	body, _ := ioutil.ReadAll(r.Body)
+	switch {
+	case r.Method == "PUT" && q.Get("comp") == "block":
+		s.blocks[q.Get("blockid")] = body
+	case r.Method == "PUT" && q.Get("comp") == "blocklist":
+		var list struct {
+			Latest []string
+		}
+		if err := xml.Unmarshal(body, &list); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var data []byte
+		for _, v := range list.Latest {
+			data = append(data, s.blocks[v]...)
+		}
+		s.blobs[blob] = data
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == "PUT":
+		if r.Header.Get("X-Ms-Blob-Type") != "BlockBlob" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.blobs[blob] = body
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == "GET" || r.Method == "HEAD":
+		data, ok := s.blobs[blob]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var start int
+		fmt.Sscanf(r.Header.Get("X-Ms-Range"), "bytes=%d-", &start)
+		w.Header().Set("Content-Length", fmt.Sprint(len(data)-start))
+		if r.Method == "GET" {
+			w.Write(data[start:])
+		}
+	case r.Method == "DELETE":
+		delete(s.blobs, blob)
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func TestAzure(t *testing.T) {
+	fake := &fakeServer{
+		blobs:  make(map[string][]byte),
+		blocks: make(map[string][]byte),
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	u := config.MustParseURL("azure://account/container#key=c2VjcmV0&part_size=1&endpoint=" + server.URL)
+	drv, err := azureOpener(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.drv = drv.(*azureDriver)
+	for _, size := range []int{10, 3<<20 + 7} {
+		data := bytes.Repeat([]byte("0123456789"), size/10+1)[:size]
+		id := fmt.Sprintf("file-%d", size)
+		w, err := drv.Create(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(data)
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		r, err := drv.Open(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := r.Seek(5, 0); err != nil {
+			t.Fatal(err)
+		}
+		read, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Close()
+		if !bytes.Equal(read, data[5:]) {
+			t.Errorf("read data does not match written data for %s", id)
+		}
+	}
+	if len(fake.blocks) != 4 {
+		t.Errorf("expecting 4 blocks, got %d", len(fake.blocks))
+	}
+	signed, err := fake.drv.SignURL("file-10", "GET", requestExpiry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Get(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(data) != "0123456789" {
+		t.Errorf("expecting file data from signed URL, got %q", data)
+	}
+	if err := drv.Remove("file-10"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := drv.Open("file-10"); err == nil {
+		t.Error("expecting an error opening a removed file")
+	}
+	if _, err := azureOpener(config.MustParseURL("azure://account#key=c2VjcmV0")); err == nil {
+		t.Error("expecting an error without a container")
+	}
+}
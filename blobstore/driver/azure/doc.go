@@ -0,0 +1,25 @@
+// Package azure implements an Azure Blob Storage driver for the blobstore.
+//
+// To enable this driver, import it in your application:
+//
+//  import (
+//      _ "gnd.la/blobstore/driver/azure"
+//  )
+//
+// The URL format for this driver is:
+//
+//  azure://account/container#key={account_key}
+//
+// Where account_key is the base64 encoded storage account key. The
+// container must already exist. Additionally, the following options
+// are supported:
+//
+//  encryption_scope: Encryption scope used for encrypting the files.
+//  endpoint: Base URL for the blob service. Defaults to https://{account}.blob.core.windows.net.
+//  part_size: Size in MiB of the blocks used for uploading big files. Defaults to 8.
+//
+// All the requests are authenticated using short lived service SAS
+// tokens. This driver supports presigned URLs (see gnd.la/blobstore.Blobstore.URL),
+// which are also SAS tokens. Note that clients uploading files with a presigned
+// URL must set the x-ms-blob-type header to BlockBlob.
+package azure
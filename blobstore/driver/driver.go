@@ -4,6 +4,7 @@ package driver
 
 import (
 	"net/http"
+	"time"
 
 	"gnd.la/config"
 )
@@ -52,6 +53,16 @@ type Server interface {
 	Serve(w http.ResponseWriter, id string, rng Range) (bool, error)
 }
 
+// Signer is the interface implemented by drivers which can
+// generate presigned URLs, allowing clients to access the files
+// directly in the storage backend.
+type Signer interface {
+	// SignURL returns a URL which allows performing the given
+	// method (either "GET" or "PUT") on the given file without
+	// any additional credentials, until the expiry elapses.
+	SignURL(id string, method string, expiry time.Duration) (string, error)
+}
+
 func Register(name string, o Opener) {
 	registry[name] = o
 }
@@ -1,13 +1,12 @@
 // Package gcs provides a Google Cloud Storage driver for the Blobstore.
 //
-// Note that currently this package only works on App Engine.
 // To enable this driver, import it in your application:
 //
 //  import (
 //      _ "gnd.la/blobstore/driver/gcs"
 //  )
 //
-// The URL format for this driver is:
+// When running on App Engine, the URL format for this driver is:
 //
 //  gcs://bucket_name
 //
@@ -19,4 +18,19 @@
 // Settings in the left sidebar and scroll to the bottom. There's a section
 // labeled "Cloud Integration" just at the end with a "Create" button. Click
 // on it and the default GCS bucket will be created.
+//
+// Outside of App Engine, this driver uses the GCS XML API with HMAC keys
+// (which can be created from the Interoperability tab in the Cloud Storage
+// settings). The URL format is:
+//
+//  gcs://bucket_name#access_key={key}&secret_key={secret}
+//
+// The bucket must already exist. Additionally, the following options are
+// supported:
+//
+//  kms_key: Cloud KMS key used for encrypting the files (e.g. projects/p/locations/l/keyRings/r/cryptoKeys/k).
+//  part_size: Size in MiB of the parts used for uploading big files. Defaults to 8 and must be at least 5.
+//
+// Outside of App Engine, this driver supports presigned URLs (see
+// gnd.la/blobstore.Blobstore.URL).
 package gcs
@@ -0,0 +1,88 @@
+// +build !appengine
+
+package gcs
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"gnd.la/blobstore/driver"
+	"gnd.la/blobstore/driver/internal/remote"
+	"gnd.la/blobstore/driver/internal/s3api"
+	"gnd.la/config"
+)
+
+const (
+	endpoint = "https://storage.googleapis.com"
+	// GCS accepts any region when using
+	// the S3 compatible signatures.
+	region      = "auto"
+	minPartSize = 5
+)
+
+type gcsDriver struct {
+	client   *s3api.Client
+	partSize int
+}
+
+func (d *gcsDriver) Create(id string) (driver.WFile, error) {
+	return remote.NewWFile(d.client, id, d.partSize), nil
+}
+
+func (d *gcsDriver) Open(id string) (driver.RFile, error) {
+	return remote.NewRFile(d.client, id)
+}
+
+func (d *gcsDriver) Remove(id string) error {
+	return d.client.Delete(id)
+}
+
+func (d *gcsDriver) SignURL(id string, method string, expiry time.Duration) (string, error) {
+	return d.client.URL(id, method, expiry)
+}
+
+func (d *gcsDriver) Close() error {
+	return nil
+}
+
+func gcsOpener(url *config.URL) (driver.Driver, error) {
+	bucket := url.Value
+	if bucket == "" {
+		return nil, fmt.Errorf("please, provide a bucket name e.g. gcs://mybucket")
+	}
+	accessKey := url.Fragment.Get("access_key")
+	if accessKey == "" {
+		return nil, fmt.Errorf("no GCS HMAC access key provided")
+	}
+	secretKey := url.Fragment.Get("secret_key")
+	if secretKey == "" {
+		return nil, fmt.Errorf("no GCS HMAC secret provided")
+	}
+	header := make(http.Header)
+	if key := url.Fragment.Get("kms_key"); key != "" {
+		header.Set("X-Goog-Encryption-Kms-Key-Name", key)
+	}
+	partSize := remote.DefaultPartSize
+	if s := url.Fragment.Get("part_size"); s != "" {
+		size, ok := url.Fragment.Int("part_size")
+		if !ok || size < minPartSize {
+			return nil, fmt.Errorf("invalid GCS part size %q, must be an integer >= %d", s, minPartSize)
+		}
+		partSize = size * (1 << 20)
+	}
+	return &gcsDriver{
+		client: &s3api.Client{
+			BucketURL: endpoint + "/" + bucket,
+			Region:    region,
+			AccessKey: accessKey,
+			SecretKey: secretKey,
+			Header:    header,
+		},
+		partSize: partSize,
+	}, nil
+}
+
+func init() {
+	driver.Register("gcs", gcsOpener)
+}
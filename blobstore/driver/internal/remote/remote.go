@@ -0,0 +1,197 @@
+// Package remote implements the files used by the blobstore
+// drivers which store their data in remote object stores
+// accessed over HTTP (e.g. S3 or Azure).
+package remote
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gnd.la/blobstore/driver"
+)
+
+const (
+	// DefaultPartSize is the default size for the parts
+	// of the files uploaded in multiple requests.
+	DefaultPartSize = 8 * (1 << 20) // 8MiB
+)
+
+// Store is implemented by the object stores.
+type Store interface {
+	// Size returns the size of the object with the given key.
+	Size(key string) (int64, error)
+	// Get returns a reader for the object data, starting
+	// at the given offset.
+	Get(key string, offset int64) (io.ReadCloser, error)
+	// Put stores the given data in a single request.
+	Put(key string, data []byte) error
+	// Upload starts a multipart upload for the given key.
+	Upload(key string) (Upload, error)
+}
+
+// Upload represents an upload in multiple parts.
+type Upload interface {
+	// Part uploads the part with the given number,
+	// starting at 1.
+	Part(n int, data []byte) error
+	// Complete finishes the upload, making the object
+	// available.
+	Complete() error
+	// Abort cancels the upload, discarding the
+	// uploaded parts.
+	Abort() error
+}
+
+type rfile struct {
+	store  Store
+	key    string
+	offset int64
+	size   int64
+	body   io.ReadCloser
+}
+
+// NewRFile returns a driver.RFile which reads the object with the
+// given key. Data is requested lazily, starting at the current
+// offset, so seeking does not require downloading the whole object.
+func NewRFile(store Store, key string) (driver.RFile, error) {
+	size, err := store.Size(key)
+	if err != nil {
+		return nil, err
+	}
+	return &rfile{store: store, key: key, size: size}, nil
+}
+
+func (f *rfile) Metadata() ([]byte, error) {
+	return nil, driver.ErrMetadataNotHandled
+}
+
+func (f *rfile) Read(p []byte) (int, error) {
+	if f.offset >= f.size {
+		return 0, io.EOF
+	}
+	if f.body == nil {
+		body, err := f.store.Get(f.key, f.offset)
+		if err != nil {
+			return 0, err
+		}
+		f.body = body
+	}
+	n, err := f.body.Read(p)
+	f.offset += int64(n)
+	if err == io.EOF && f.offset < f.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (f *rfile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case os.SEEK_SET:
+	case os.SEEK_CUR:
+		offset += f.offset
+	case os.SEEK_END:
+		offset += f.size
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, errors.New("negative offset")
+	}
+	if offset != f.offset {
+		f.closeBody()
+		f.offset = offset
+	}
+	return offset, nil
+}
+
+func (f *rfile) closeBody() error {
+	if f.body != nil {
+		err := f.body.Close()
+		f.body = nil
+		return err
+	}
+	return nil
+}
+
+func (f *rfile) Close() error {
+	return f.closeBody()
+}
+
+type wfile struct {
+	store    Store
+	key      string
+	partSize int
+	buf      bytes.Buffer
+	upload   Upload
+	parts    int
+	// err is set when an upload fails, since
+	// the file can't be written anymore.
+	err error
+}
+
+// NewWFile returns a driver.WFile which stores its data in the object
+// with the given key. Files smaller than partSize are uploaded with a
+// single request when the file is closed, while bigger ones are uploaded
+// in parts of partSize bytes. If partSize is zero, DefaultPartSize is used.
+func NewWFile(store Store, key string, partSize int) driver.WFile {
+	if partSize <= 0 {
+		partSize = DefaultPartSize
+	}
+	return &wfile{store: store, key: key, partSize: partSize}
+}
+
+func (f *wfile) SetMetadata(_ []byte) error {
+	return driver.ErrMetadataNotHandled
+}
+
+func (f *wfile) Write(p []byte) (int, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	n, _ := f.buf.Write(p)
+	for f.buf.Len() > f.partSize {
+		if err := f.uploadPart(f.buf.Next(f.partSize)); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+func (f *wfile) uploadPart(data []byte) error {
+	if f.upload == nil {
+		upload, err := f.store.Upload(f.key)
+		if err != nil {
+			return err
+		}
+		f.upload = upload
+	}
+	f.parts++
+	if err := f.upload.Part(f.parts, data); err != nil {
+		f.upload.Abort()
+		f.err = err
+		return err
+	}
+	return nil
+}
+
+func (f *wfile) Close() error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.upload == nil {
+		return f.store.Put(f.key, f.buf.Bytes())
+	}
+	if f.buf.Len() > 0 {
+		if err := f.uploadPart(f.buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	if err := f.upload.Complete(); err != nil {
+		f.upload.Abort()
+		return err
+	}
+	return nil
+}
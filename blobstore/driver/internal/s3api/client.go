@@ -0,0 +1,268 @@
+// Package s3api implements a client for the S3 API, which is also
+// supported by other object stores like Google Cloud Storage. It's
+// used by the S3 and GCS blobstore drivers.
+package s3api
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"gnd.la/blobstore/driver/internal/remote"
+)
+
+// Client is an S3 API client for a single bucket.
+type Client struct {
+	// BucketURL is the base URL for the bucket
+	// (e.g. https://mybucket.s3.us-east-1.amazonaws.com
+	// or http://localhost:9000/mybucket).
+	BucketURL string
+	// Region is the region used for signing
+	// the requests.
+	Region    string
+	AccessKey string
+	SecretKey string
+	// Header contains the headers sent when
+	// creating objects (e.g. for encryption).
+	Header http.Header
+	// HTTPClient is the client used for the requests. If
+	// nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+	// now returns the current time, it's only
+	// overriden by the tests.
+	now func() time.Time
+}
+
+// Error represents an error returned by the server.
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *Error) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("S3 error %s (status %d): %s", e.Code, e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("S3 error with status %d", e.StatusCode)
+}
+
+// IsNotFound returns true iff err is an *Error
+// with a 404 status code.
+func IsNotFound(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.StatusCode == http.StatusNotFound
+}
+
+func (c *Client) time() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
+
+func (c *Client) newRequest(method string, key string, query url.Values, body []byte) (*http.Request, error) {
+	u, err := url.Parse(c.BucketURL)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + key
+	u.RawPath = uriEncode(u.Path, true)
+	u.RawQuery = canonicalQuery(query)
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, u.String(), r)
+	if err != nil {
+		return nil, err
+	}
+	// Use the same URL, so the escaped path is kept
+	req.URL = u
+	return req, nil
+}
+
+// do signs and sends the request, returning an *Error if the
+// response status is not 2xx. Note that the caller must close
+// the response body.
+func (c *Client) do(req *http.Request, body []byte) (*http.Response, error) {
+	payload := emptyPayload
+	if body != nil {
+		payload = hashHex(body)
+	}
+	c.sign(req, payload, c.time())
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		defer resp.Body.Close()
+		e := &Error{StatusCode: resp.StatusCode}
+		data, _ := ioutil.ReadAll(resp.Body)
+		xml.Unmarshal(data, e)
+		return nil, e
+	}
+	return resp, nil
+}
+
+func (c *Client) request(method string, key string, query url.Values, header http.Header, body []byte, out interface{}) (http.Header, error) {
+	req, err := c.newRequest(method, key, query, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := c.do(req, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if out != nil {
+		if err := xml.NewDecoder(resp.Body).Decode(out); err != nil {
+			return nil, err
+		}
+	} else {
+		io.Copy(ioutil.Discard, resp.Body)
+	}
+	return resp.Header, nil
+}
+
+// Size implements remote.Store.
+func (c *Client) Size(key string) (int64, error) {
+	h, err := c.request("HEAD", key, nil, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(h.Get("Content-Length"), 10, 64)
+}
+
+// Get implements remote.Store.
+func (c *Client) Get(key string, offset int64) (io.ReadCloser, error) {
+	req, err := c.newRequest("GET", key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, err := c.do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// Put implements remote.Store.
+func (c *Client) Put(key string, data []byte) error {
+	if data == nil {
+		data = []byte{}
+	}
+	_, err := c.request("PUT", key, nil, c.Header, data, nil)
+	return err
+}
+
+// Delete removes the object with the given key.
+func (c *Client) Delete(key string) error {
+	_, err := c.request("DELETE", key, nil, nil, nil, nil)
+	return err
+}
+
+// CreateBucket creates the bucket if it does not exist yet.
+func (c *Client) CreateBucket() error {
+	if _, err := c.request("HEAD", "", nil, nil, nil, nil); err == nil || !IsNotFound(err) {
+		return err
+	}
+	var body []byte
+	if c.Region != "us-east-1" && c.Region != "auto" {
+		body = []byte(fmt.Sprintf("<CreateBucketConfiguration><LocationConstraint>%s</LocationConstraint></CreateBucketConfiguration>", c.Region))
+	}
+	_, err := c.request("PUT", "", nil, nil, body, nil)
+	return err
+}
+
+// URL returns a presigned URL for performing the given method
+// on the object with the given key, valid for the given duration.
+func (c *Client) URL(key string, method string, expiry time.Duration) (string, error) {
+	req, err := c.newRequest(method, key, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	return c.presign(req, expiry, c.time()), nil
+}
+
+// Upload implements remote.Store, using a multipart upload.
+func (c *Client) Upload(key string) (remote.Upload, error) {
+	var result struct {
+		UploadId string
+	}
+	query := url.Values{"uploads": {""}}
+	if _, err := c.request("POST", key, query, c.Header, nil, &result); err != nil {
+		return nil, err
+	}
+	return &upload{client: c, key: key, id: result.UploadId}, nil
+}
+
+type part struct {
+	PartNumber int
+	ETag       string
+}
+
+type upload struct {
+	client *Client
+	key    string
+	id     string
+	parts  []part
+}
+
+func (u *upload) Part(n int, data []byte) error {
+	query := url.Values{
+		"partNumber": {strconv.Itoa(n)},
+		"uploadId":   {u.id},
+	}
+	h, err := u.client.request("PUT", u.key, query, nil, data, nil)
+	if err != nil {
+		return err
+	}
+	u.parts = append(u.parts, part{PartNumber: n, ETag: h.Get("ETag")})
+	return nil
+}
+
+func (u *upload) Complete() error {
+	body, err := xml.Marshal(struct {
+		XMLName xml.Name `xml:"CompleteMultipartUpload"`
+		Parts   []part   `xml:"Part"`
+	}{Parts: u.parts})
+	if err != nil {
+		return err
+	}
+	var result struct {
+		XMLName xml.Name
+		Code    string
+		Message string
+	}
+	if _, err := u.client.request("POST", u.key, url.Values{"uploadId": {u.id}}, nil, body, &result); err != nil {
+		return err
+	}
+	// Errors might be reported with a 200 status
+	if result.XMLName.Local == "Error" {
+		return &Error{StatusCode: http.StatusOK, Code: result.Code, Message: result.Message}
+	}
+	return nil
+}
+
+func (u *upload) Abort() error {
+	_, err := u.client.request("DELETE", u.key, url.Values{"uploadId": {u.id}}, nil, nil, nil)
+	return err
+}
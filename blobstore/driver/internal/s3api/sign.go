@@ -0,0 +1,51 @@
+package s3api
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"gnd.la/internal/sigv4"
+)
+
+const (
+	algorithm = sigv4.Algorithm
+	service   = "s3"
+	// emptyPayload is the payload hash for
+	// the requests without a body.
+	emptyPayload = sigv4.EmptyPayload
+)
+
+func hashHex(data []byte) string {
+	return sigv4.PayloadHash(data)
+}
+
+func uriEncode(s string, path bool) string {
+	return sigv4.EncodeURI(s, path)
+}
+
+func canonicalQuery(values url.Values) string {
+	return sigv4.CanonicalQuery(values)
+}
+
+func (c *Client) signer() *sigv4.Signer {
+	return &sigv4.Signer{
+		Region:    c.Region,
+		Service:   service,
+		AccessKey: c.AccessKey,
+		SecretKey: c.SecretKey,
+	}
+}
+
+// sign adds the Authorization header to the request, using the
+// given payload hash (hex encoded SHA256 of the request body).
+func (c *Client) sign(req *http.Request, payload string, t time.Time) {
+	req.Header.Set("X-Amz-Content-Sha256", payload)
+	c.signer().Sign(req, payload, t)
+}
+
+// presign returns the given request URL with the signature
+// in the query string, valid for the given duration.
+func (c *Client) presign(req *http.Request, expiry time.Duration, t time.Time) string {
+	return c.signer().Presign(req, expiry, t)
+}
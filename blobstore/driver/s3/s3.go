@@ -4,92 +4,85 @@
 //
 //  s3://bucket_name#access_key={key}&secret_key={secret}[&region={region}]
 //
-// Region is option, but if it's provided, it must be a valid one.
+// Region is optional and defaults to us-east-1. The bucket is created
+// if it does not exist yet. Additionally, the following options are
+// supported:
+//
+//  endpoint: Base URL for an S3 compatible server (e.g. http://localhost:9000).
+//  sse: Server side encryption, either AES256 or aws:kms.
+//  kms_key_id: The KMS key used when sse is aws:kms. If empty, the default one is used.
+//  part_size: Size in MiB of the parts used for uploading big files. Defaults to 8 and must be at least 5.
+//
+// This driver supports presigned URLs (see gnd.la/blobstore.Blobstore.URL).
+// Note that when using server side encryption, files uploaded using a
+// presigned URL are only encrypted if the bucket has default encryption
+// enabled.
 package s3
 
 import (
-	"bytes"
 	"fmt"
-	"gnd.la/blobstore/driver"
-	"gnd.la/config"
-	"launchpad.net/goamz/aws"
-	"launchpad.net/goamz/s3"
+	"net/http"
 	"strings"
 	"sync"
+	"time"
+
+	"gnd.la/blobstore/driver"
+	"gnd.la/blobstore/driver/internal/remote"
+	"gnd.la/blobstore/driver/internal/s3api"
+	"gnd.la/config"
+)
+
+const (
+	defaultRegion = "us-east-1"
+	minPartSize   = 5
 )
 
 // Avoid extra roundtrips to the server to make sure
 // the credentials are correct and the bucket exists.
-// Also, since the buckets are thread-safe we can share
+// Also, since the clients are thread-safe we can share
 // them among all connections.
-var buckets struct {
-	buckets map[string]*s3.Bucket
+var clients struct {
+	clients map[string]*s3api.Client
 	sync.RWMutex
 }
 
-type rfile bytes.Reader
-
-func (r *rfile) Metadata() ([]byte, error) {
-	return nil, driver.ErrMetadataNotHandled
-}
-
-func (r *rfile) Read(p []byte) (int, error) {
-	return (*bytes.Reader)(r).Read(p)
-}
-
-func (r *rfile) Seek(offset int64, whence int) (int64, error) {
-	return (*bytes.Reader)(r).Seek(offset, whence)
-}
-
-func (r *rfile) Close() error {
-	return nil
-}
-
-type wfile struct {
-	id     string
-	bucket *s3.Bucket
-	buf    bytes.Buffer
-}
-
-func (w *wfile) SetMetadata(_ []byte) error {
-	return driver.ErrMetadataNotHandled
-}
-
-func (w *wfile) Write(p []byte) (int, error) {
-	return w.buf.Write(p)
-}
-
-func (w *wfile) Close() error {
-	return w.bucket.Put(w.id, w.buf.Bytes(), "", s3.Private)
-}
-
 type s3Driver struct {
-	bucket *s3.Bucket
+	client   *s3api.Client
+	partSize int
 }
 
 func (d *s3Driver) Create(id string) (driver.WFile, error) {
-	return &wfile{
-		id:     id,
-		bucket: d.bucket,
-	}, nil
+	return remote.NewWFile(d.client, id, d.partSize), nil
 }
 
 func (d *s3Driver) Open(id string) (driver.RFile, error) {
-	data, err := d.bucket.Get(id)
-	if err != nil {
-		return nil, err
-	}
-	return (*rfile)(bytes.NewReader(data)), nil
+	return remote.NewRFile(d.client, id)
 }
 
 func (d *s3Driver) Remove(id string) error {
-	return d.bucket.Del(id)
+	return d.client.Delete(id)
+}
+
+func (d *s3Driver) SignURL(id string, method string, expiry time.Duration) (string, error) {
+	return d.client.URL(id, method, expiry)
 }
 
 func (d *s3Driver) Close() error {
 	return nil
 }
 
+func bucketURL(bucket string, region string, endpoint string) string {
+	if endpoint != "" {
+		return strings.TrimSuffix(endpoint, "/") + "/" + bucket
+	}
+	if strings.Contains(bucket, ".") {
+		// Virtual hosted buckets with dots don't
+		// match the wildcard TLS certificate.
+		return fmt.Sprintf("https://s3.%s.amazonaws.com/%s", region, bucket)
+	}
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com", bucket, region)
+}
+
 func s3Opener(url *config.URL) (driver.Driver, error) {
 	accessKey := url.Fragment.Get("access_key")
 	if accessKey == "" {
@@ -103,41 +96,59 @@ func s3Opener(url *config.URL) (driver.Driver, error) {
 	if value == "" {
 		return nil, fmt.Errorf("please, provide a bucket name e.g. s3://mybucket")
 	}
-	region := aws.USEast
-	if r := url.Fragment.Get("region"); r != "" {
-		reg, ok := aws.Regions[r]
-		if !ok {
-			var regions []string
-			for k := range aws.Regions {
-				regions = append(regions, fmt.Sprintf("%q", k))
+	region := url.Fragment.Get("region")
+	if region == "" {
+		region = defaultRegion
+	}
+	header := make(http.Header)
+	switch sse := url.Fragment.Get("sse"); sse {
+	case "":
+	case "AES256", "aws:kms":
+		header.Set("X-Amz-Server-Side-Encryption", sse)
+		if key := url.Fragment.Get("kms_key_id"); key != "" {
+			if sse != "aws:kms" {
+				return nil, fmt.Errorf("kms_key_id requires sse=aws:kms")
 			}
-			return nil, fmt.Errorf("invalid S3 region %q. valid regions are %s", r, strings.Join(regions, ", "))
+			header.Set("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id", key)
+		}
+	default:
+		return nil, fmt.Errorf("invalid S3 server side encryption %q, must be AES256 or aws:kms", sse)
+	}
+	partSize := remote.DefaultPartSize
+	if s := url.Fragment.Get("part_size"); s != "" {
+		size, ok := url.Fragment.Int("part_size")
+		if !ok || size < minPartSize {
+			return nil, fmt.Errorf("invalid S3 part size %q, must be an integer >= %d", s, minPartSize)
 		}
-		region = reg
+		partSize = size * (1 << 20)
 	}
-	key := value + accessKey + secretKey + region.Name
-	buckets.RLock()
-	bucket := buckets.buckets[key]
-	buckets.RUnlock()
-	if bucket == nil {
-		auth := aws.Auth{
+	endpoint := url.Fragment.Get("endpoint")
+	key := value + accessKey + secretKey + region + endpoint + header.Get("X-Amz-Server-Side-Encryption") +
+		header.Get("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id")
+	clients.RLock()
+	client := clients.clients[key]
+	clients.RUnlock()
+	if client == nil {
+		client = &s3api.Client{
+			BucketURL: bucketURL(value, region, endpoint),
+			Region:    region,
 			AccessKey: accessKey,
 			SecretKey: secretKey,
+			Header:    header,
 		}
-		s := s3.New(auth, region)
-		bucket = s.Bucket(value)
-		if err := bucket.PutBucket(s3.Private); err != nil {
+		if err := client.CreateBucket(); err != nil {
 			return nil, err
 		}
-		buckets.Lock()
-		if buckets.buckets == nil {
-			buckets.buckets = make(map[string]*s3.Bucket)
+		clients.Lock()
+		if clients.clients == nil {
+			clients.clients = make(map[string]*s3api.Client)
 		}
-		buckets.buckets[key] = bucket
-		buckets.Unlock()
+		clients.clients[key] = client
+		clients.Unlock()
 	}
 	return &s3Driver{
-		bucket: bucket,
+		client:   client,
+		partSize: partSize,
 	}, nil
 }
 
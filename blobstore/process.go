@@ -0,0 +1,276 @@
+package blobstore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"gnd.la/internal/bson"
+)
+
+const (
+	// infoKey is the metadata key used for
+	// storing the Info.
+	infoKey = "_info"
+	// headSize is the number of bytes always
+	// available to the processors.
+	headSize = 512
+)
+
+var (
+	// MaxProcessedSize is the maximum size of the files which
+	// have their data passed to the processors. While a file is
+	// written, its data is copied to a temporary file, never kept
+	// in memory. Files bigger than this size are still processed,
+	// but only the first bytes of their data are available. See
+	// ProcessedFile.
+	MaxProcessedSize = 32 * (1 << 20) // 32MiB
+
+	// ErrDataUnavailable is returned by ProcessedFile.Open when
+	// the file is bigger than MaxProcessedSize.
+	ErrDataUnavailable = errors.New("the file data is not available for processing")
+
+	processors struct {
+		sync.RWMutex
+		list []Processor
+	}
+)
+
+// Info contains the information about a file gathered by the
+// processors when it was stored. Use RFile.Info to retrieve it.
+type Info struct {
+	// ContentType is the detected MIME type of the file.
+	ContentType string `bson:"content_type,omitempty"`
+	// Format, Width and Height are set for images. Format is
+	// the image format name, as returned by image.DecodeConfig
+	// (e.g. jpeg).
+	Format string `bson:"format,omitempty"`
+	Width  int    `bson:"width,omitempty"`
+	Height int    `bson:"height,omitempty"`
+	// EXIF contains the EXIF tags found in the file.
+	EXIF map[string]string `bson:"exif,omitempty"`
+	// Derived contains the ids of the files generated by the
+	// processors from this one (e.g. thumbnails), keyed by name.
+	// Derived files are removed with their parent file.
+	Derived map[string]string `bson:"derived,omitempty"`
+}
+
+// ProcessedFile represents a file being processed. See Processor.
+type ProcessedFile struct {
+	// Id is the id of the stored file.
+	Id string
+	// Size is the file size in bytes.
+	Size uint64
+	// Head contains the first 512 bytes of the file.
+	Head []byte
+	// Info contains the information about the file which
+	// will be stored in its metadata. Processors should
+	// set its fields as they gather information.
+	Info       *Info
+	store      *Blobstore
+	processing *processing
+}
+
+// Open returns a reader for the file data, positioned at its
+// start. The data is copied to a temporary file while it's being
+// written, so it's never completely loaded into memory. If the file
+// is bigger than MaxProcessedSize, ErrDataUnavailable is returned.
+func (f *ProcessedFile) Open() (io.ReadSeeker, error) {
+	p := f.processing
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.skip {
+		return nil, ErrDataUnavailable
+	}
+	if p.tmp == nil {
+		// Empty file
+		return io.NewSectionReader(emptyReaderAt{}, 0, 0), nil
+	}
+	return io.NewSectionReader(p.tmp, 0, p.size), nil
+}
+
+type emptyReaderAt struct{}
+
+func (emptyReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	return 0, io.EOF
+}
+
+// Derive stores the given data as a file derived from the one being
+// processed with the given name (e.g. thumbnail-64x64), returning its
+// id. Derived files are not processed and are removed when their parent
+// file is removed.
+func (f *ProcessedFile) Derive(name string, data []byte, meta interface{}) (string, error) {
+	id := f.Id + "-" + name
+	w, err := f.store.createId(id, false)
+	if err != nil {
+		return "", err
+	}
+	if err := w.SetMeta(meta); err != nil {
+		return "", err
+	}
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	if f.Info.Derived == nil {
+		f.Info.Derived = make(map[string]string)
+	}
+	f.Info.Derived[name] = id
+	return id, nil
+}
+
+// Processor is the interface implemented by the types which process
+// the files stored in the blobstore (e.g. for extracting information
+// from them or generating derived files). Processors are run when a
+// file is closed after being written, in the order they were added.
+// If any of them returns an error, the file is removed and the error
+// is returned from WFile.Close. See gnd.la/blobstore/processor for
+// the processors included with Gondola.
+type Processor interface {
+	Process(f *ProcessedFile) error
+}
+
+// RegisterProcessor adds a processor which is run by all the Blobstore
+// instances created after this function is called. Note that on App
+// Engine, a new Blobstore is created for each request, so processors
+// should be registered this way. To add a processor to a single
+// Blobstore, use Blobstore.AddProcessor.
+func RegisterProcessor(p Processor) {
+	processors.Lock()
+	processors.list = append(processors.list, p)
+	processors.Unlock()
+}
+
+func registeredProcessors() []Processor {
+	processors.RLock()
+	defer processors.RUnlock()
+	return append([]Processor(nil), processors.list...)
+}
+
+// AddProcessor adds a processor which is run by this Blobstore
+// after the ones added with RegisterProcessor.
+func (s *Blobstore) AddProcessor(p Processor) {
+	s.processors = append(s.processors, p)
+}
+
+// processing accumulates the data passed to the processors
+// while a file is written. The data is stored in a temporary
+// file, which is removed by close.
+type processing struct {
+	head []byte
+	tmp  *os.File
+	size int64
+	skip bool
+	err  error
+}
+
+func (p *processing) Write(b []byte) {
+	if n := headSize - len(p.head); n > 0 {
+		if n > len(b) {
+			n = len(b)
+		}
+		p.head = append(p.head, b[:n]...)
+	}
+	if p.skip || p.err != nil || len(b) == 0 {
+		return
+	}
+	if p.size+int64(len(b)) > int64(MaxProcessedSize) {
+		p.skip = true
+		p.close()
+		return
+	}
+	if p.tmp == nil {
+		if p.tmp, p.err = ioutil.TempFile("", "blobstore-processing"); p.err != nil {
+			return
+		}
+	}
+	n, err := p.tmp.Write(b)
+	p.size += int64(n)
+	if err != nil {
+		p.err = err
+		p.close()
+	}
+}
+
+// close removes the temporary file, if any.
+func (p *processing) close() {
+	if p.tmp != nil {
+		p.tmp.Close()
+		os.Remove(p.tmp.Name())
+		p.tmp = nil
+	}
+}
+
+func (w *WFile) process() error {
+	defer w.processing.close()
+	f := &ProcessedFile{
+		Id:         w.id,
+		Size:       w.dataLength,
+		Head:       w.processing.head,
+		Info:       &Info{},
+		store:      w.store,
+		processing: w.processing,
+	}
+	for _, v := range w.store.processors {
+		if err := v.Process(f); err != nil {
+			for _, id := range f.Info.Derived {
+				w.store.Remove(id)
+			}
+			return fmt.Errorf("error processing file %s: %s", w.id, err)
+		}
+	}
+	w.info = f.Info
+	return nil
+}
+
+// metadata returns the encoded metadata for the file, merging
+// the one provided by the user with the Info, if any.
+func (w *WFile) metadata() ([]byte, error) {
+	hasMeta := w.meta != nil && !isNil(w.meta)
+	if w.info == nil {
+		if !hasMeta {
+			return nil, nil
+		}
+		return marshal(w.meta)
+	}
+	m := bson.M{}
+	if hasMeta {
+		data, err := marshal(w.meta)
+		if err != nil {
+			return nil, err
+		}
+		if err := unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+	}
+	m[infoKey] = w.info
+	return marshal(m)
+}
+
+// Info returns the information gathered by the processors when
+// the file was stored. If the file was stored without any processors,
+// an empty Info is returned.
+func (r *RFile) Info() (*Info, error) {
+	if err := r.decodeMeta(); err != nil {
+		return nil, err
+	}
+	var m struct {
+		Info *Info `bson:"_info"`
+	}
+	if r.metadataData != nil {
+		if err := unmarshal(r.metadataData, &m); err != nil {
+			return nil, err
+		}
+	}
+	if m.Info == nil {
+		return &Info{}, nil
+	}
+	return m.Info, nil
+}
@@ -0,0 +1,21 @@
+// Package processor implements blobstore processors for handling
+// the files stored by the users (e.g. uploaded images) consistently.
+//
+// Processors are added either to all the blobstores or to a single
+// one:
+//
+//  func init() {
+//	blobstore.RegisterProcessor(processor.ContentType())
+//	blobstore.RegisterProcessor(processor.Image())
+//	blobstore.RegisterProcessor(processor.Thumbnails(processor.Size{64, 64}, processor.Size{256, 256}))
+//  }
+//
+// Then, the information gathered by the processors can be retrieved
+// using gnd.la/blobstore.RFile.Info:
+//
+//  f, err := bs.Open(id)
+//  ...
+//  info, err := f.Info()
+//  ...
+//  thumbnail := info.Derived[processor.ThumbnailName(processor.Size{64, 64})]
+package processor
@@ -0,0 +1,193 @@
+package processor
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+const (
+	exifIFDPointer = 0x8769
+	gpsIFDPointer  = 0x8825
+	maxIFDEntries  = 1000
+)
+
+var (
+	exifHeader = []byte("Exif\x00\x00")
+
+	// exifTags contains the tags extracted from
+	// IFD0 and the Exif IFD.
+	exifTags = map[uint16]string{
+		0x010F: "Make",
+		0x0110: "Model",
+		0x0112: "Orientation",
+		0x0131: "Software",
+		0x0132: "DateTime",
+		0x013B: "Artist",
+		0x8298: "Copyright",
+		0x829A: "ExposureTime",
+		0x829D: "FNumber",
+		0x8827: "ISOSpeedRatings",
+		0x9003: "DateTimeOriginal",
+		0x9004: "DateTimeDigitized",
+		0x920A: "FocalLength",
+		0xA002: "PixelXDimension",
+		0xA003: "PixelYDimension",
+		0xA434: "LensModel",
+	}
+	// gpsTags contains the tags extracted from the GPS IFD.
+	gpsTags = map[uint16]string{
+		0x0001: "GPSLatitudeRef",
+		0x0002: "GPSLatitude",
+		0x0003: "GPSLongitudeRef",
+		0x0004: "GPSLongitude",
+		0x0005: "GPSAltitudeRef",
+		0x0006: "GPSAltitude",
+	}
+	// typeSizes contains the size of each
+	// TIFF field type, indexed by type.
+	typeSizes = []int{0, 1, 1, 2, 4, 8, 1, 1, 2, 4, 8}
+)
+
+// parseJPEGExif returns the EXIF tags found in the APP1
+// segment of the JPEG data read from r. Only the segments
+// before the image data are read. Any errors while parsing
+// the segment cause the remaining tags to be ignored.
+func parseJPEGExif(r io.Reader) map[string]string {
+	br := bufio.NewReader(r)
+	var hdr [4]byte
+	if _, err := io.ReadFull(br, hdr[:2]); err != nil || hdr[0] != 0xFF || hdr[1] != 0xD8 {
+		return nil
+	}
+	for {
+		if _, err := io.ReadFull(br, hdr[:]); err != nil || hdr[0] != 0xFF {
+			return nil
+		}
+		marker := hdr[1]
+		if marker == 0xDA || marker == 0xD9 {
+			// Start of scan or end of image
+			return nil
+		}
+		size := int64(binary.BigEndian.Uint16(hdr[2:])) - 2
+		if size < 0 {
+			return nil
+		}
+		if marker != 0xE1 {
+			if _, err := io.CopyN(ioutil.Discard, br, size); err != nil {
+				return nil
+			}
+			continue
+		}
+		segment := make([]byte, size)
+		if _, err := io.ReadFull(br, segment); err != nil {
+			return nil
+		}
+		if bytes.HasPrefix(segment, exifHeader) {
+			tags := make(map[string]string)
+			parseTIFF(segment[len(exifHeader):], tags)
+			return tags
+		}
+	}
+}
+
+func parseTIFF(data []byte, tags map[string]string) {
+	if len(data) < 8 {
+		return
+	}
+	var order binary.ByteOrder
+	switch string(data[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return
+	}
+	if order.Uint16(data[2:]) != 42 {
+		return
+	}
+	pointers := parseIFD(data, order, order.Uint32(data[4:]), exifTags, tags)
+	if off, ok := pointers[exifIFDPointer]; ok {
+		parseIFD(data, order, off, exifTags, tags)
+	}
+	if off, ok := pointers[gpsIFDPointer]; ok {
+		parseIFD(data, order, off, gpsTags, tags)
+	}
+}
+
+// parseIFD parses the IFD at the given offset, storing the known
+// tags in tags. It returns the offsets of the sub-IFDs pointers.
+func parseIFD(data []byte, order binary.ByteOrder, offset uint32, names map[uint16]string, tags map[string]string) map[uint16]uint32 {
+	if int(offset)+2 > len(data) {
+		return nil
+	}
+	count := int(order.Uint16(data[offset:]))
+	if count > maxIFDEntries {
+		return nil
+	}
+	pointers := make(map[uint16]uint32)
+	for ii := 0; ii < count; ii++ {
+		entry := int(offset) + 2 + ii*12
+		if entry+12 > len(data) {
+			break
+		}
+		tag := order.Uint16(data[entry:])
+		typ := int(order.Uint16(data[entry+2:]))
+		n := int(order.Uint32(data[entry+4:]))
+		if tag == exifIFDPointer || tag == gpsIFDPointer {
+			pointers[tag] = order.Uint32(data[entry+8:])
+			continue
+		}
+		name, ok := names[tag]
+		if !ok || typ <= 0 || typ >= len(typeSizes) || n <= 0 || n > len(data) {
+			continue
+		}
+		size := typeSizes[typ] * n
+		value := data[entry+8 : entry+12]
+		if size > 4 {
+			off := int(order.Uint32(value))
+			if off < 0 || off+size > len(data) {
+				continue
+			}
+			value = data[off : off+size]
+		} else {
+			value = value[:size]
+		}
+		if s := formatValue(order, typ, n, value); s != "" {
+			tags[name] = s
+		}
+	}
+	return pointers
+}
+
+func formatValue(order binary.ByteOrder, typ int, n int, value []byte) string {
+	switch typ {
+	case 2: // ASCII
+		return strings.TrimSpace(strings.TrimRight(string(value), "\x00"))
+	case 1, 7: // BYTE, UNDEFINED
+		return string(bytes.TrimRight(value, "\x00"))
+	}
+	values := make([]string, n)
+	for ii := range values {
+		switch typ {
+		case 3: // SHORT
+			values[ii] = strconv.Itoa(int(order.Uint16(value[ii*2:])))
+		case 4: // LONG
+			values[ii] = strconv.FormatUint(uint64(order.Uint32(value[ii*4:])), 10)
+		case 9: // SLONG
+			values[ii] = strconv.Itoa(int(int32(order.Uint32(value[ii*4:]))))
+		case 5: // RATIONAL
+			values[ii] = fmt.Sprintf("%d/%d", order.Uint32(value[ii*8:]), order.Uint32(value[ii*8+4:]))
+		case 10: // SRATIONAL
+			values[ii] = fmt.Sprintf("%d/%d", int32(order.Uint32(value[ii*8:])), int32(order.Uint32(value[ii*8+4:])))
+		default:
+			return ""
+		}
+	}
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,62 @@
+package processor
+
+import (
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"net/http"
+	"os"
+
+	"gnd.la/blobstore"
+)
+
+type processorFunc func(f *blobstore.ProcessedFile) error
+
+func (p processorFunc) Process(f *blobstore.ProcessedFile) error {
+	return p(f)
+}
+
+// ContentType returns a processor which detects the file MIME
+// type by sniffing its first bytes, using the algorithm
+// implemented by http.DetectContentType.
+func ContentType() blobstore.Processor {
+	return processorFunc(func(f *blobstore.ProcessedFile) error {
+		f.Info.ContentType = http.DetectContentType(f.Head)
+		return nil
+	})
+}
+
+// Image returns a processor which, for the files containing
+// images in any of the formats supported by the image package
+// (GIF, JPEG and PNG are registered by this package), sets their
+// format and dimensions. For JPEG files, it also extracts their
+// EXIF tags. Files which are not images are ignored.
+func Image() blobstore.Processor {
+	return processorFunc(func(f *blobstore.ProcessedFile) error {
+		r, err := f.Open()
+		if err != nil {
+			if err == blobstore.ErrDataUnavailable {
+				return nil
+			}
+			return err
+		}
+		cfg, format, err := image.DecodeConfig(r)
+		if err != nil {
+			// Not an image
+			return nil
+		}
+		f.Info.Format = format
+		f.Info.Width = cfg.Width
+		f.Info.Height = cfg.Height
+		if format == "jpeg" {
+			if _, err := r.Seek(0, os.SEEK_SET); err != nil {
+				return err
+			}
+			if tags := parseJPEGExif(r); len(tags) > 0 {
+				f.Info.EXIF = tags
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,181 @@
+package processor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"gnd.la/blobstore"
+	_ "gnd.la/blobstore/driver/file"
+	"gnd.la/config"
+)
+
+type testMeta struct {
+	Foo int
+}
+
+func testImage(width int, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{uint8(x), uint8(y), 0, 255})
+		}
+	}
+	return img
+}
+
+// exifJPEG returns a JPEG image with an APP1 segment containing
+// the Make and Orientation tags.
+func exifJPEG(t *testing.T, width int, height int, orientation uint16) []byte {
+	var img bytes.Buffer
+	if err := jpeg.Encode(&img, testImage(width, height), nil); err != nil {
+		t.Fatal(err)
+	}
+	order := binary.BigEndian
+	var tiff bytes.Buffer
+	tiff.WriteString("MM")
+	binary.Write(&tiff, order, uint16(42))
+	binary.Write(&tiff, order, uint32(8))
+	// IFD0 with 2 entries
+	binary.Write(&tiff, order, uint16(2))
+	// Make, ASCII with 8 bytes stored at offset 38
+	binary.Write(&tiff, order, []uint16{0x010F, 2})
+	binary.Write(&tiff, order, []uint32{8, 38})
+	// Orientation, SHORT stored inline
+	binary.Write(&tiff, order, []uint16{0x0112, 3})
+	binary.Write(&tiff, order, uint32(1))
+	binary.Write(&tiff, order, []uint16{orientation, 0})
+	// Next IFD offset
+	binary.Write(&tiff, order, uint32(0))
+	tiff.WriteString("Gondola\x00")
+	segment := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	var buf bytes.Buffer
+	buf.Write([]byte{0xFF, 0xD8, 0xFF, 0xE1})
+	binary.Write(&buf, order, uint16(len(segment)+2))
+	buf.Write(segment)
+	buf.Write(img.Bytes()[2:])
+	return buf.Bytes()
+}
+
+func newStore(t *testing.T) (*blobstore.Blobstore, func()) {
+	dir, err := ioutil.TempDir("", "processor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, err := blobstore.New(config.MustParseURL("file://" + dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs.AddProcessor(ContentType())
+	bs.AddProcessor(Image())
+	bs.AddProcessor(Thumbnails(Size{16, 16}, Size{100, 100}))
+	return bs, func() {
+		bs.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func openInfo(t *testing.T, bs *blobstore.Blobstore, id string) *blobstore.Info {
+	f, err := bs.Open(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	info, err := f.Info()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info
+}
+
+func TestProcessors(t *testing.T) {
+	bs, cleanup := newStore(t)
+	defer cleanup()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testImage(64, 32)); err != nil {
+		t.Fatal(err)
+	}
+	id, err := bs.Store(buf.Bytes(), &testMeta{Foo: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := bs.Open(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var meta testMeta
+	if err := f.GetMeta(&meta); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	if meta.Foo != 42 {
+		t.Errorf("expecting metadata Foo = 42, got %d", meta.Foo)
+	}
+	info := openInfo(t, bs, id)
+	if info.ContentType != "image/png" || info.Format != "png" || info.Width != 64 || info.Height != 32 {
+		t.Errorf("unexpected info %+v", info)
+	}
+	if len(info.Derived) != 1 {
+		t.Fatalf("expecting 1 derived file, got %v", info.Derived)
+	}
+	thumbId := info.Derived[ThumbnailName(Size{16, 16})]
+	data, err := bs.ReadAll(thumbId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thumb, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != "png" || thumb.Bounds().Dx() != 16 || thumb.Bounds().Dy() != 8 {
+		t.Errorf("unexpected %s thumbnail with size %s", format, thumb.Bounds().Size())
+	}
+	if err := bs.Remove(id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bs.Open(thumbId); err == nil {
+		t.Error("derived file was not removed")
+	}
+	// Non images only get the content type
+	id, err = bs.Store([]byte("hello world"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info := openInfo(t, bs, id); info.ContentType != "text/plain; charset=utf-8" || info.Width != 0 || len(info.Derived) != 0 {
+		t.Errorf("unexpected info for text file %+v", info)
+	}
+}
+
+func TestExif(t *testing.T) {
+	bs, cleanup := newStore(t)
+	defer cleanup()
+	id, err := bs.Store(exifJPEG(t, 64, 32, 6), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := openInfo(t, bs, id)
+	if info.ContentType != "image/jpeg" || info.Width != 64 || info.Height != 32 {
+		t.Errorf("unexpected info %+v", info)
+	}
+	if info.EXIF["Make"] != "Gondola" || info.EXIF["Orientation"] != "6" {
+		t.Errorf("unexpected EXIF tags %v", info.EXIF)
+	}
+	data, err := bs.ReadAll(info.Derived[ThumbnailName(Size{16, 16})])
+	if err != nil {
+		t.Fatal(err)
+	}
+	thumb, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Rotated 90 degrees
+	if format != "jpeg" || thumb.Bounds().Dx() != 8 || thumb.Bounds().Dy() != 16 {
+		t.Errorf("unexpected %s thumbnail with size %s", format, thumb.Bounds().Size())
+	}
+}
@@ -0,0 +1,207 @@
+package processor
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"strconv"
+
+	"gnd.la/blobstore"
+)
+
+const thumbnailQuality = 85
+
+// Size represents the maximum size of a thumbnail.
+type Size struct {
+	Width  int
+	Height int
+}
+
+func (s Size) String() string {
+	return fmt.Sprintf("%dx%d", s.Width, s.Height)
+}
+
+// ThumbnailName returns the name of the derived file for
+// the thumbnail with the given size. See Thumbnails.
+func ThumbnailName(s Size) string {
+	return "thumbnail-" + s.String()
+}
+
+// Thumbnails returns a processor which generates thumbnails
+// with the given sizes for the images, stored as derived files
+// (see gnd.la/blobstore.ProcessedFile.Derive) named by ThumbnailName.
+// Thumbnails keep the image aspect ratio, fitting inside their size,
+// and are rotated according to the EXIF orientation, if any. JPEG
+// images produce JPEG thumbnails, while other formats produce PNG
+// thumbnails. Images which already fit in a given size don't get a
+// thumbnail for it, since the original image should be used instead.
+func Thumbnails(sizes ...Size) blobstore.Processor {
+	for _, v := range sizes {
+		if v.Width <= 0 || v.Height <= 0 {
+			panic(fmt.Errorf("invalid thumbnail size %s", v))
+		}
+	}
+	return processorFunc(func(f *blobstore.ProcessedFile) error {
+		r, err := f.Open()
+		if err != nil {
+			if err == blobstore.ErrDataUnavailable {
+				return nil
+			}
+			return err
+		}
+		img, format, err := image.Decode(r)
+		if err != nil {
+			// Not an image
+			return nil
+		}
+		var orientation int
+		if format == "jpeg" {
+			if _, err := r.Seek(0, os.SEEK_SET); err != nil {
+				return err
+			}
+			orientation, _ = strconv.Atoi(parseJPEGExif(r)["Orientation"])
+		}
+		var rgba *image.RGBA
+		for _, v := range sizes {
+			width, height := fit(img.Bounds().Dx(), img.Bounds().Dy(), v, orientation)
+			if width == 0 {
+				continue
+			}
+			if rgba == nil {
+				rgba = toRGBA(img)
+			}
+			thumb := orient(resize(rgba, width, height), orientation)
+			var buf bytes.Buffer
+			if format == "jpeg" {
+				err = jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: thumbnailQuality})
+			} else {
+				err = png.Encode(&buf, thumb)
+			}
+			if err != nil {
+				return err
+			}
+			if _, err := f.Derive(ThumbnailName(v), buf.Bytes(), nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// rotated returns true iff the given EXIF orientation
+// swaps the image width and height.
+func rotated(orientation int) bool {
+	return orientation >= 5 && orientation <= 8
+}
+
+// fit returns the size of the image before applying the orientation
+// which makes it fit in the given size after it's applied. If the image
+// already fits, it returns zeroes.
+func fit(width int, height int, s Size, orientation int) (int, int) {
+	if rotated(orientation) {
+		width, height = height, width
+	}
+	if width <= s.Width && height <= s.Height {
+		return 0, 0
+	}
+	w, h := s.Width, height*s.Width/width
+	if h > s.Height {
+		w, h = width*s.Height/height, s.Height
+	}
+	if w < 1 {
+		w = 1
+	}
+	if h < 1 {
+		h = 1
+	}
+	if rotated(orientation) {
+		w, h = h, w
+	}
+	return w, h
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Bounds().Min == image.ZP {
+		return rgba
+	}
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+	return rgba
+}
+
+// resize scales down src to the given size, averaging
+// the source pixels covered by each destination pixel.
+func resize(src *image.RGBA, width int, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	for y := 0; y < height; y++ {
+		y0, y1 := y*sh/height, (y+1)*sh/height
+		if y1 == y0 {
+			y1++
+		}
+		for x := 0; x < width; x++ {
+			x0, x1 := x*sw/width, (x+1)*sw/width
+			if x1 == x0 {
+				x1++
+			}
+			var sum [4]int
+			for sy := y0; sy < y1; sy++ {
+				p := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					for c := 0; c < 4; c++ {
+						sum[c] += int(src.Pix[p+c])
+					}
+					p += 4
+				}
+			}
+			n := (y1 - y0) * (x1 - x0)
+			p := dst.PixOffset(x, y)
+			for c := 0; c < 4; c++ {
+				dst.Pix[p+c] = uint8(sum[c] / n)
+			}
+		}
+	}
+	return dst
+}
+
+// orient transforms img according to the given EXIF
+// orientation, so it's displayed upright.
+func orient(img *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	dw, dh := w, h
+	if rotated(orientation) {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // Mirror horizontal
+				dx, dy = w-1-x, y
+			case 3: // Rotate 180
+				dx, dy = w-1-x, h-1-y
+			case 4: // Mirror vertical
+				dx, dy = x, h-1-y
+			case 5: // Mirror horizontal and rotate 270 CW
+				dx, dy = y, x
+			case 6: // Rotate 90 CW
+				dx, dy = h-1-y, x
+			case 7: // Mirror horizontal and rotate 90 CW
+				dx, dy = h-1-y, w-1-x
+			case 8: // Rotate 270 CW
+				dx, dy = y, w-1-x
+			}
+			copy(dst.Pix[dst.PixOffset(dx, dy):dst.PixOffset(dx, dy)+4], img.Pix[img.PixOffset(x, y):img.PixOffset(x, y)+4])
+		}
+	}
+	return dst
+}
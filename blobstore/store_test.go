@@ -267,3 +267,62 @@ func benchmarkDriver(b *testing.B, drv string, size int, mode int, f func(int) [
 		}
 	}
 }
+
+type processorFunc func(f *ProcessedFile) error
+
+func (p processorFunc) Process(f *ProcessedFile) error {
+	return p(f)
+}
+
+func TestProcessedFileOpen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pool-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	u, err := config.ParseURL("file://" + dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := New(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	var data []byte
+	var tmp string
+	var openErr error
+	store.AddProcessor(processorFunc(func(f *ProcessedFile) error {
+		r, err := f.Open()
+		if err != nil {
+			openErr = err
+			return nil
+		}
+		tmp = f.processing.tmp.Name()
+		data, err = ioutil.ReadAll(r)
+		return err
+	}))
+	r := randData(dataSize)
+	if _, err := store.Store(r, nil); err != nil {
+		t.Fatal(err)
+	}
+	if openErr != nil {
+		t.Fatal(openErr)
+	}
+	if adler32.Checksum(data) != adler32.Checksum(r) {
+		t.Error("invalid data passed to the processor")
+	}
+	if _, err := os.Stat(tmp); !os.IsNotExist(err) {
+		t.Errorf("temporary file %s was not removed", tmp)
+	}
+	defer func(size int) {
+		MaxProcessedSize = size
+	}(MaxProcessedSize)
+	MaxProcessedSize = dataSize - 1
+	if _, err := store.Store(r, nil); err != nil {
+		t.Fatal(err)
+	}
+	if openErr != ErrDataUnavailable {
+		t.Errorf("expecting ErrDataUnavailable, got %v", openErr)
+	}
+}
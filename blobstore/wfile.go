@@ -18,6 +18,8 @@ type WFile struct {
 	dataLength uint64
 	store      *Blobstore
 	closed     bool
+	processing *processing
+	info       *Info
 }
 
 // Id returns the unique file identifier as a string.
@@ -30,6 +32,9 @@ func (w *WFile) Id() string {
 func (w *WFile) Write(p []byte) (int, error) {
 	w.dataHash.Write(p)
 	w.dataLength += uint64(len(p))
+	if w.processing != nil {
+		w.processing.Write(p)
+	}
 	return w.file.Write(p)
 }
 
@@ -39,9 +44,17 @@ func (w *WFile) SetMeta(meta interface{}) error {
 }
 
 // Close closes the file. Once the file is closed, it
-// might not be used again.
+// might not be used again. If the Blobstore has any
+// processors, they're run before closing the file.
 func (w *WFile) Close() error {
 	if !w.closed {
+		if w.processing != nil {
+			if err := w.process(); err != nil {
+				w.file.Close()
+				w.store.Remove(w.id)
+				return err
+			}
+		}
 		if err := w.putMeta(); err != nil {
 			return err
 		}
@@ -85,14 +98,13 @@ func (w *WFile) writeMeta(out io.Writer) error {
 	if err = bwrite(out, uint64(0)); err != nil {
 		return err
 	}
-	var metadata []byte
 	metadataLength := uint64(0)
 	metadataHash := uint64(0)
-	if w.meta != nil && !isNil(w.meta) {
-		metadata, err = marshal(w.meta)
-		if err != nil {
-			return err
-		}
+	metadata, err := w.metadata()
+	if err != nil {
+		return err
+	}
+	if metadata != nil {
 		metadataLength = uint64(len(metadata))
 		h := newHash()
 		h.Write(metadata)
@@ -1,6 +1,8 @@
 package cache
 
 import (
+	"context"
+	"crypto/cipher"
 	"errors"
 	"fmt"
 	"reflect"
@@ -12,10 +14,18 @@ import (
 	"gnd.la/encoding/codec"
 	"gnd.la/encoding/pipe"
 	"gnd.la/log"
+	"gnd.la/metrics"
+	"gnd.la/tracing"
 )
 
 var (
 	ErrNotFound = errors.New("item not found in cache")
+
+	operationsTotal = metrics.NewCounter("gondola_cache_operations_total",
+		"Number of cache operations, by operation", "operation")
+	hitsTotal   = metrics.NewCounter("gondola_cache_hits_total", "Number of keys found in the cache")
+	missesTotal = metrics.NewCounter("gondola_cache_misses_total", "Number of keys not found in the cache")
+	errorsTotal = metrics.NewCounter("gondola_cache_errors_total", "Number of failed cache operations")
 	imports     = map[string]string{
 		"memcache": "gnd.la/cache/driver/memcache",
 		"redis":    "gnd.la/cache/driver/redis",
@@ -25,6 +35,9 @@ var (
 const cache = "cache"
 
 type Cache struct {
+	// stats is accessed atomically. It's a pointer so
+	// it's shared with the copies returned by WithContext.
+	stats *Stats
 	// The Logger to log debug messages and, more importantly, errors.
 	// New() initialies the log.Logger to log.Std.
 	Logger    *log.Logger
@@ -33,6 +46,14 @@ type Cache struct {
 	driver    driver.Driver
 	codec     *codec.Codec
 	pipe      *pipe.Pipe
+	// compression and aead are optional transformations
+	// applied to the data after the pipe.
+	compression *compression
+	aead        cipher.AEAD
+	flights     *flightGroup
+	// ctx carries the parent tracing span for the
+	// operations, might be nil. See WithContext.
+	ctx context.Context
 }
 
 func (c *Cache) backendKey(key string) string {
@@ -75,18 +96,7 @@ func (c *Cache) Get(key string, obj interface{}) error {
 	if err != nil {
 		return err
 	}
-	cerr := c.codec.Decode(b, obj)
-	if cerr != nil {
-		derr := &cacheError{
-			op:    "decoding object",
-			key:   key,
-			codec: true,
-			err:   cerr,
-		}
-		c.error(derr)
-		return derr
-	}
-	return nil
+	return c.decode(key, b, obj)
 }
 
 // GetMulti returns several objects with only one trip to the cache.
@@ -126,7 +136,10 @@ func (c *Cache) GetMulti(out map[string]interface{}, typer Typer) error {
 			qkeys[ii] = c.backendKey(v)
 		}
 	}
+	operationsTotal.Inc("get_multi")
+	span := c.startSpan("GET MULTI", strings.Join(keys, " "))
 	data, err := c.driver.GetMulti(qkeys)
+	span.SetError(err).End()
 	if err != nil {
 		gerr := &cacheError{
 			op:  "getting multiple keys",
@@ -136,6 +149,14 @@ func (c *Cache) GetMulti(out map[string]interface{}, typer Typer) error {
 		c.error(gerr)
 		return gerr
 	}
+	hits := 0
+	for _, k := range qkeys {
+		if data[k] != nil {
+			hits++
+		}
+	}
+	hitsTotal.Add(float64(hits))
+	missesTotal.Add(float64(len(keys) - hits))
 	if typer == nil {
 		typer = mapTyper(out)
 	}
@@ -145,6 +166,9 @@ func (c *Cache) GetMulti(out map[string]interface{}, typer Typer) error {
 			delete(out, k)
 			continue
 		}
+		if value, err = c.decodeData(k, value); err != nil {
+			return err
+		}
 		typ := typer.Type(k)
 		if typ == nil {
 			derr := &cacheError{
@@ -178,21 +202,15 @@ func (c *Cache) SetBytes(key string, b []byte, timeout int) error {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(cache).Note("SET", key).End()
 	}
-	if c.pipe != nil {
-		var err error
-		b, err = c.pipe.Encode(b)
-		if err != nil {
-			perr := &cacheError{
-				op:  "encoding data with pipe",
-				key: key,
-				err: err,
-			}
-			c.error(perr)
-			return perr
-		}
+	b, err := c.encodeData(key, b)
+	if err != nil {
+		return err
 	}
 	k := c.backendKey(key)
-	err := c.driver.Set(k, b, timeout)
+	operationsTotal.Inc("set")
+	span := c.startSpan("SET", key)
+	err = c.driver.Set(k, b, timeout)
+	span.SetError(err).End()
 	if err != nil {
 		serr := &cacheError{
 			op:  "setting key",
@@ -208,10 +226,29 @@ func (c *Cache) SetBytes(key string, b []byte, timeout int) error {
 
 // GetBytes returns the byte array assocciated with the given key
 func (c *Cache) GetBytes(key string) ([]byte, error) {
+	var ev *profile.Timed
 	if profile.On && profile.Profiling() {
-		defer profile.Start(cache).Note("GET", key).End()
+		ev = profile.Start(cache).Note("GET", key)
+		defer ev.End()
 	}
+	operationsTotal.Inc("get")
+	span := c.startSpan("GET", key)
 	b, err := c.driver.Get(c.backendKey(key))
+	span.SetAttribute("cache.hit", b != nil).SetError(err).End()
+	if err == nil {
+		if b != nil {
+			hitsTotal.Inc()
+		} else {
+			missesTotal.Inc()
+		}
+	}
+	if ev != nil {
+		if b != nil {
+			ev.Note("result", "hit")
+		} else {
+			ev.Note("result", "miss")
+		}
+	}
 	if err != nil {
 		gerr := &cacheError{
 			op:  "getting key",
@@ -224,19 +261,7 @@ func (c *Cache) GetBytes(key string) ([]byte, error) {
 	if b == nil {
 		return nil, ErrNotFound
 	}
-	if c.pipe != nil {
-		b, err = c.pipe.Decode(b)
-		if err != nil {
-			perr := &cacheError{
-				op:  "decoding data with pipe",
-				key: key,
-				err: err,
-			}
-			c.error(perr)
-			return nil, perr
-		}
-	}
-	return b, nil
+	return c.decodeData(key, b)
 }
 
 // Delete removes the key from the cache. An error is returned only
@@ -246,7 +271,10 @@ func (c *Cache) Delete(key string) error {
 	if profile.On {
 		defer profile.Startf(cache, "DELETE %s", key).End()
 	}
+	operationsTotal.Inc("delete")
+	span := c.startSpan("DELETE", key)
 	err := c.driver.Delete(c.backendKey(key))
+	span.SetError(err).End()
 	if err != nil {
 		derr := &cacheError{
 			op:  "deleting",
@@ -279,6 +307,25 @@ func (c *Cache) Connection() interface{} {
 	return c.driver.Connection()
 }
 
+// WithContext returns a copy of the Cache which uses ctx for
+// obtaining the parent span of the tracing spans for its
+// operations. The copy shares its connection and statistics
+// with c. See gnd.la/tracing.
+func (c *Cache) WithContext(ctx context.Context) *Cache {
+	cpy := *c
+	cpy.ctx = ctx
+	return &cpy
+}
+
+// startSpan starts a tracing span for a cache operation.
+// See gnd.la/tracing.
+func (c *Cache) startSpan(op string, key string) *tracing.Span {
+	if !tracing.Enabled() {
+		return nil
+	}
+	return tracing.Start(c.ctx, "cache "+op, tracing.Client).SetAttribute("cache.key", key)
+}
+
 func (c *Cache) debugf(format string, arg ...interface{}) {
 	if c.Logger != nil {
 		c.Logger.Debugf(format, arg...)
@@ -292,6 +339,7 @@ func (c *Cache) warningf(format string, arg ...interface{}) {
 }
 
 func (c *Cache) error(err *cacheError) {
+	errorsTotal.Inc()
 	if c.Logger != nil {
 		c.Logger.Error(err)
 	}
@@ -299,7 +347,9 @@ func (c *Cache) error(err *cacheError) {
 
 func newConfig(conf *config.URL) (*Cache, error) {
 	cache := &Cache{
-		Logger: log.Std,
+		Logger:  log.Std,
+		stats:   new(Stats),
+		flights: new(flightGroup),
 	}
 
 	if codecName := conf.Fragment.Get("codec"); codecName != "" {
@@ -325,6 +375,13 @@ func newConfig(conf *config.URL) (*Cache, error) {
 			return nil, fmt.Errorf("unknown pipe %q, maybe you forgot an import?", pipeName)
 		}
 	}
+	var err error
+	if cache.compression, err = newCompression(conf); err != nil {
+		return nil, err
+	}
+	if cache.aead, err = newEncryption(conf); err != nil {
+		return nil, err
+	}
 	var opener driver.Opener
 	if conf.Scheme != "" {
 		opener = driver.Get(conf.Scheme)
@@ -337,7 +394,6 @@ func newConfig(conf *config.URL) (*Cache, error) {
 	} else {
 		opener = driver.Get("dummy")
 	}
-	var err error
 	if cache.driver, err = opener(conf); err != nil {
 		return nil, err
 	}
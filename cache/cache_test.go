@@ -3,14 +3,20 @@ package cache
 import (
 	"encoding/gob"
 	"fmt"
+	"io/ioutil"
 	"net"
+	"os"
 	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	_ "gnd.la/cache/driver/memcache"
 	_ "gnd.la/cache/driver/redis"
 	"gnd.la/config"
+	_ "gnd.la/encoding/codec/cbor"
 	_ "gnd.la/encoding/codec/msgpack"
 	"gnd.la/log"
 )
@@ -299,10 +305,64 @@ func TestMsgpackCodec(t *testing.T) {
 	testCache(t, "memory://#codec=msgpack")
 }
 
+func TestCborCodec(t *testing.T) {
+	testCache(t, "memory://#codec=cbor")
+}
+
 func TestCompress(t *testing.T) {
 	testCache(t, "memory://#min_compress=0&compress_level=9")
 }
 
+func TestCompressGzip(t *testing.T) {
+	testCache(t, "memory://#compress=gzip&min_compress=0")
+}
+
+func TestCompressSnappy(t *testing.T) {
+	testCache(t, "memory://#compress=snappy&min_compress=0")
+}
+
+func TestCompressZstd(t *testing.T) {
+	testCache(t, "memory://#compress=zstd&min_compress=0")
+}
+
+func TestEncrypt(t *testing.T) {
+	testCache(t, "memory://#encrypt=secret&pipe=zlib&min_compress=10")
+}
+
+func TestEncryptKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cache-encrypt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c1, err := newCache("file://" + dir + "#encrypt=secret1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2, err := newCache("file://" + dir + "#encrypt=secret2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c1.Logger = nil
+	c2.Logger = nil
+	s1 := simple{1, 2, 3}
+	if err := c1.Set("enc", s1, 0); err != nil {
+		t.Fatal(err)
+	}
+	var s2 simple
+	if err := c1.Get("enc", &s2); err != nil {
+		t.Error(err)
+	} else if !deepEqual(s1, s2) {
+		t.Errorf("expecting %v, got %v", s1, s2)
+	}
+	if err := c2.Get("enc", &s2); err == nil {
+		t.Error("expecting an error when decrypting with another key")
+	}
+	if _, err := newCache("memory://#compress=unknown"); err == nil {
+		t.Error("expecting an error with an unknown compressor")
+	}
+}
+
 func TestPrefix(t *testing.T) {
 	prefix := "foo"
 	c1, err := newCache("memory://#prefix=" + prefix)
@@ -345,7 +405,7 @@ func TestRedis(t *testing.T) {
 }
 
 func TestMemoryCacheMaxSize(t *testing.T) {
-	c, err := newCache("memory://#max_size=1K")
+	c, err := newCache("memory://maxsize#max_size=1K")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -353,11 +413,12 @@ func TestMemoryCacheMaxSize(t *testing.T) {
 	data2 := make([]byte, 512)
 	c.SetBytes("k1", data1, 0)
 	c.SetBytes("k2", data2, 0)
+	// Use k1, so k2 becomes the least recently used
+	if _, err := c.GetBytes("k1"); err != nil {
+		t.Error(err)
+	}
 	c.SetBytes("k3", data2, 0)
-	// Sleep for 0.1s so the goroutine has time
-	// to remove items.
-	time.Sleep(100 * time.Millisecond)
-	// Should have evicted k2 or k3
+	// Should have evicted k2
 	k1d, err := c.GetBytes("k1")
 	if err != nil {
 		t.Error(err)
@@ -365,10 +426,242 @@ func TestMemoryCacheMaxSize(t *testing.T) {
 	if len(k1d) != len(data1) {
 		t.Errorf("bad data for key k1")
 	}
-	_, err2 := c.GetBytes("k2")
-	_, err3 := c.GetBytes("k3")
-	if err2 == nil && err3 == nil {
-		t.Errorf("should have evicted k2 or k3")
+	if _, err := c.GetBytes("k2"); err != ErrNotFound {
+		t.Errorf("should have evicted k2, got error %v", err)
+	}
+	if _, err := c.GetBytes("k3"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMemoryCacheLFU(t *testing.T) {
+	c, err := newCache("memory://lfu#max_entries=2&eviction=lfu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetBytes("k1", []byte{1}, 0)
+	c.SetBytes("k2", []byte{2}, 0)
+	for ii := 0; ii < 3; ii++ {
+		c.GetBytes("k1")
+	}
+	// k2 was used more recently, but less frequently
+	c.GetBytes("k2")
+	c.SetBytes("k3", []byte{3}, 0)
+	if _, err := c.GetBytes("k1"); err != nil {
+		t.Errorf("k1 should not have been evicted, got error %v", err)
+	}
+	if _, err := c.GetBytes("k2"); err != ErrNotFound {
+		t.Errorf("should have evicted k2, got error %v", err)
+	}
+	if _, err := newCache("memory://lfu#eviction=fifo"); err == nil {
+		t.Error("expecting an error with an unknown eviction policy")
+	}
+}
+
+func TestMemoryCacheExpiration(t *testing.T) {
+	c, err := newCache("memory://expiration")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetBytes("k1", []byte{1}, 1)
+	c.SetBytes("k2", []byte{2}, 0)
+	time.Sleep(2500 * time.Millisecond)
+	if _, err := c.GetBytes("k1"); err != ErrNotFound {
+		t.Errorf("k1 should have expired, got error %v", err)
+	}
+	if _, err := c.GetBytes("k2"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	c, err := newCache("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var calls int32
+	fill := func() (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(50 * time.Millisecond)
+		return simple{1, 2, 3}, nil
+	}
+	var wg sync.WaitGroup
+	for ii := 0; ii < 10; ii++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var s simple
+			if err := c.Fetch("fetch", &s, 0, fill); err != nil {
+				t.Error(err)
+			} else if !deepEqual(s, simple{1, 2, 3}) {
+				t.Errorf("bad fetched value %v", s)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("expecting 1 call to fill, got %d", calls)
+	}
+	var s simple
+	if err := c.Fetch("fetch", &s, 0, fill); err != nil {
+		t.Error(err)
+	}
+	if calls != 1 {
+		t.Errorf("expecting 1 call to fill after hit, got %d", calls)
+	}
+	if st := c.Stats(); st.Fills != 1 || st.Hits == 0 {
+		t.Errorf("bad stats %+v", st)
+	}
+}
+
+func TestFetchStale(t *testing.T) {
+	c, err := newCache("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var calls int32
+	fill := func() (interface{}, error) {
+		return int(atomic.AddInt32(&calls, 1)), nil
+	}
+	var v int
+	if err := c.FetchStale("stale", &v, 1, 10, fill); err != nil {
+		t.Fatal(err)
+	}
+	if v != 1 {
+		t.Errorf("expecting 1, got %d", v)
+	}
+	time.Sleep(1100 * time.Millisecond)
+	// Should return the stale value and refresh it
+	if err := c.FetchStale("stale", &v, 1, 10, fill); err != nil {
+		t.Fatal(err)
+	}
+	if v != 1 {
+		t.Errorf("expecting stale value 1, got %d", v)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if err := c.FetchStale("stale", &v, 1, 10, fill); err != nil {
+		t.Fatal(err)
+	}
+	if v != 2 {
+		t.Errorf("expecting refreshed value 2, got %d", v)
+	}
+	if st := c.Stats(); st.StaleHits != 1 {
+		t.Errorf("expecting 1 stale hit, got %d", st.StaleHits)
+	}
+}
+
+func TestFetchPanic(t *testing.T) {
+	c, err := newCache("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Logger = nil
+	panicking := func() (interface{}, error) {
+		time.Sleep(50 * time.Millisecond)
+		panic("boom")
+	}
+	var wg sync.WaitGroup
+	for ii := 0; ii < 5; ii++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var v int
+			if err := c.Fetch("panic", &v, 0, panicking); err == nil || !strings.Contains(err.Error(), "boom") {
+				t.Errorf("expecting panic error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if st := c.Stats(); st.FillErrors != 1 {
+		t.Errorf("expecting 1 fill error, got %d", st.FillErrors)
+	}
+	var v int
+	if err := c.Fetch("panic", &v, 0, func() (interface{}, error) { return 42, nil }); err != nil {
+		t.Fatal(err)
+	}
+	if v != 42 {
+		t.Errorf("expecting 42 after a panicking fill, got %d", v)
+	}
+}
+
+func TestTags(t *testing.T) {
+	c, err := newCache("memory://#prefix=tags")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetWithTags("t1", simple{1, 2, 3}, 0, "user:42", "posts"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetWithTags("t2", simple{4, 5, 6}, 0, "posts"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetWithTags("t3", simple{7, 8, 9}, 0, "user:43"); err != nil {
+		t.Fatal(err)
+	}
+	var s simple
+	if err := c.GetTagged("t1", &s); err != nil {
+		t.Error(err)
+	} else if !deepEqual(s, simple{1, 2, 3}) {
+		t.Errorf("bad tagged value %v", s)
+	}
+	if err := c.InvalidateTags("posts"); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{"t1", "t2"} {
+		if err := c.GetTagged(v, &s); err != ErrNotFound {
+			t.Errorf("expecting ErrNotFound for %s, got %v", v, err)
+		}
+	}
+	if err := c.GetTagged("t3", &s); err != nil {
+		t.Error(err)
+	} else if !deepEqual(s, simple{7, 8, 9}) {
+		t.Errorf("bad tagged value %v", s)
+	}
+}
+
+func TestNamespace(t *testing.T) {
+	c, err := newCache("memory://#prefix=ns")
+	if err != nil {
+		t.Fatal(err)
+	}
+	templates := c.Namespace("templates")
+	other := c.Namespace("other")
+	if err := templates.Set("n1", simple{1, 2, 3}, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := templates.Set("n2", simple{4, 5, 6}, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := other.Set("n1", simple{7, 8, 9}, 0); err != nil {
+		t.Fatal(err)
+	}
+	var s simple
+	if err := templates.Get("n1", &s); err != nil {
+		t.Error(err)
+	} else if !deepEqual(s, simple{1, 2, 3}) {
+		t.Errorf("bad namespaced value %v", s)
+	}
+	if err := c.Get("n1", &s); err != ErrNotFound {
+		t.Errorf("expecting ErrNotFound outside of the namespace, got %v", err)
+	}
+	out := map[string]interface{}{"n1": simple{}, "n2": simple{}, "n3": simple{}}
+	if err := templates.GetMulti(out, nil); err != nil {
+		t.Error(err)
+	} else if len(out) != 2 || !deepEqual(out["n2"], simple{4, 5, 6}) {
+		t.Errorf("bad namespaced values %v", out)
+	}
+	if err := templates.Invalidate(); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{"n1", "n2"} {
+		if err := templates.Get(v, &s); err != ErrNotFound {
+			t.Errorf("expecting ErrNotFound for %s, got %v", v, err)
+		}
+	}
+	if err := c.Namespace("other").Get("n1", &s); err != nil {
+		t.Error(err)
+	} else if !deepEqual(s, simple{7, 8, 9}) {
+		t.Errorf("bad namespaced value %v", s)
 	}
 }
 
@@ -419,6 +712,10 @@ func BenchmarkMsgpackCache(b *testing.B) {
 	benchmarkCache(b, "memory://#codec=msgpack")
 }
 
+func BenchmarkCborCache(b *testing.B) {
+	benchmarkCache(b, "memory://#codec=cbor")
+}
+
 func BenchmarkPrefixCache(b *testing.B) {
 	benchmarkCache(b, "memory://#prefix=foo&codec=gob")
 }
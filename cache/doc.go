@@ -4,12 +4,16 @@
 //
 //  scheme://value?var=value&var2=value#anothervar=anothervalue
 //
-// While each driver might implement its own options, there are 3 options
+// While each driver might implement its own options, there are some options
 // which apply to all drivers and are specified after the # character. They are:
 //
 //  - codec: The codec used for encoding/decoding the cached objects. See gnd.la/encoding/codec for the available ones.
-//  - pipe: A pipe to pass the data trough, usually for compressing it. See gnd.la/encoding/pipe for the available ones.
+//  - pipe: A pipe to pass the data trough, usually for compressing or encrypting it. Several pipes might be chained by separating them with | (e.g. zstd|aes). See gnd.la/encoding/pipe for the available ones.
 //  - prefix: A prefix to be prepended to all keys stored.
+//  - compress: The compression algorithm for the stored data. Available ones are flate, gzip, zlib, snappy and zstd. Additional ones (e.g. lz4) might be added with RegisterCompressor.
+//  - min_compress: The minimum data size in bytes for compressing it. Defaults to 100. Setting this option enables compression with flate if compress is not specified.
+//  - compress_level: The compression level. Its meaning depends on the algorithm. Setting this option enables compression with flate if compress is not specified.
+//  - encrypt: A secret used for encrypting the stored data using AES-256-GCM, so sensitive data can be safely stored in a shared cache. Note that keys are not encrypted.
 //
 // Note that these options are not mandatory. For the available drivers, see gnd.la/cache/driver for the ones without
 // dependencies and its subpackages for the ones with external dependencies.
@@ -19,4 +23,5 @@
 //  memcache://localhost#codec=json&pipe=zlib
 //  memory://#max_size=1.5G
 //  file://cache#max_size=512M
+//  redis://localhost#compress=gzip&encrypt=mysecret
 package cache
@@ -8,9 +8,16 @@
 // The provided drivers are:
 //
 //  - dummy:// - a dummy driver which does not cache data, useful for development
-//  - memory://[#max_size={size} - a memory driver with an optional maximum size
+//  - memory://[name][#max_size={size}&max_entries={n}&eviction=lru|lfu] - an in-process memory driver
 //  - file://path[#max_size={size} a file based driver with an optional maximum size
 //
+// Memory caches opened with the same name (which might be empty) share the same items.
+// When the maximum size or number of entries are exceeded, items are evicted using
+// the selected policy: least recently used (lru, the default) or least frequently used
+// (lfu). Expired items are removed in the background. Limits and the eviction policy
+// are applied to all the caches sharing the name, with the last opened one taking
+// precedence. Hits, misses, evictions and expirations are exported via gnd.la/metrics.
+//
 // Sizes admit the K, M, G and T suffixes to represent Kilobytes, Megabytes, Gigabytes and
 // Terabytes, respectivelly. When there's no prefix, the value is assumed to be in bytes. Note
 // that real numbers can be used, like e.g. 1.5G
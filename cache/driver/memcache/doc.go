@@ -3,10 +3,19 @@
 // This package works both on standalone Go installations and Google App
 // Engine. The URL format for this driver is:
 //
-//  memcache://host1[:port][,host2][,hostn][#timeout={seconds}&max_idle={max}
+//  memcache://host1[:port][,host2][,hostn][#timeout={seconds}&max_idle={max}&vnodes={n}&eject_after={n}&retry_after={seconds}
 //
 // If no port is provided, memcached's default is used.
 // If no timeout is provided, 200ms is used as a default. Setting timeout
 // to zero disables timeouts. max_idle represents the maximum number of idle
 // connections kept per host. The default is 2.
+//
+// When several hosts are provided, keys are distributed among them using
+// consistent hashing, so adding or removing a host only moves the keys
+// assigned to it. vnodes is the number of points in the hash ring for each
+// host, which defaults to 160. Hosts failing eject_after consecutive times
+// (3 by default) are ejected from the ring, with their keys assigned to the
+// remaining ones, and retried after retry_after seconds (30 by default).
+// Setting eject_after to zero disables ejection. Note that the last remaining
+// host is never ejected, so hosts are never ejected when there's only one.
 package memcache
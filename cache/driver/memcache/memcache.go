@@ -3,30 +3,67 @@
 package memcache
 
 import (
+	"errors"
 	"net"
 	"strings"
+	"sync"
 	"time"
 
 	"gnd.la/cache/driver"
 	"gnd.la/config"
+	"gnd.la/log"
 
 	"gopkgs.com/memcache.v2"
 )
 
+const (
+	defaultEjectAfter = 3
+	defaultRetryAfter = 30 * time.Second
+)
+
+var errNoServers = errors.New("no memcache servers available")
+
+// memcacheNode is a memcache server, with its health.
+type memcacheNode struct {
+	addr   string
+	client *memcache.Client
+	// consecutive failed operations
+	failures int
+	// non-zero while the node is ejected
+	ejectedUntil time.Time
+}
+
 type memcacheDriver struct {
-	*memcache.Client
+	mu         sync.RWMutex
+	nodes      []*memcacheNode
+	ring       *hashRing
+	vnodes     int
+	ejectAfter int
+	retryAfter time.Duration
+	// true when there are ejected nodes, so
+	// they're checked for readmission
+	hasEjected bool
 }
 
 func (c *memcacheDriver) Set(key string, b []byte, timeout int) error {
+	node := c.pick(key)
+	if node == nil {
+		return errNoServers
+	}
 	item := memcache.Item{Key: key, Value: b, Expiration: int32(timeout)}
-	return c.error(c.Client.Set(&item))
+	return c.error(node, node.client.Set(&item))
 }
 
 func (c *memcacheDriver) Get(key string) ([]byte, error) {
-	item, err := c.Client.Get(key)
+	node := c.pick(key)
+	if node == nil {
+		return nil, errNoServers
+	}
+	item, err := node.client.Get(key)
 	if err != nil {
-		return nil, c.error(err)
+		return nil, c.error(node, err)
 	}
+	c.error(node, nil)
 	if item != nil {
 		return item.Value, nil
 	}
@@ -34,61 +71,210 @@ func (c *memcacheDriver) Get(key string) ([]byte, error) {
 }
 
 func (c *memcacheDriver) GetMulti(keys []string) (map[string][]byte, error) {
-	results, err := c.Client.GetMulti(keys)
-	if err != nil {
-		return nil, c.error(err)
+	byNode := make(map[*memcacheNode][]string)
+	for _, k := range keys {
+		node := c.pick(k)
+		if node == nil {
+			return nil, errNoServers
+		}
+		byNode[node] = append(byNode[node], k)
 	}
-	value := make(map[string][]byte, len(results))
-	for k, v := range results {
-		value[k] = v.Value
+	value := make(map[string][]byte, len(keys))
+	for node, nodeKeys := range byNode {
+		results, err := node.client.GetMulti(nodeKeys)
+		if err = c.error(node, err); err != nil {
+			return nil, err
+		}
+		for k, v := range results {
+			value[k] = v.Value
+		}
 	}
 	return value, nil
 }
 
 func (c *memcacheDriver) Delete(key string) error {
-	return c.error(c.Client.Delete(key))
+	node := c.pick(key)
+	if node == nil {
+		return errNoServers
+	}
+	return c.error(node, node.client.Delete(key))
+}
+
+func (c *memcacheDriver) Close() error {
+	var err error
+	for _, v := range c.nodes {
+		if cerr := v.client.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
 }
 
+// Connection returns the *memcache.Client when there's only
+// one server. Otherwise, it returns a map[string]*memcache.Client
+// with the client for each server.
 func (c *memcacheDriver) Connection() interface{} {
-	return c.Client
+	if len(c.nodes) == 1 {
+		return c.nodes[0].client
+	}
+	clients := make(map[string]*memcache.Client, len(c.nodes))
+	for _, v := range c.nodes {
+		clients[v.addr] = v.client
+	}
+	return clients
 }
 
 func (c *memcacheDriver) Flush() error {
-	return c.Client.Flush(0)
+	var err error
+	for _, v := range c.nodes {
+		if ferr := v.client.Flush(0); ferr != nil && err == nil {
+			err = ferr
+		}
+	}
+	return err
+}
+
+// pick returns the node for the given key, readmitting
+// the ejected nodes which should be retried.
+func (c *memcacheDriver) pick(key string) *memcacheNode {
+	c.mu.RLock()
+	node := c.ring.pick(key)
+	hasEjected := c.hasEjected
+	c.mu.RUnlock()
+	if hasEjected {
+		c.mu.Lock()
+		if c.readmit(time.Now()) {
+			node = c.ring.pick(key)
+		}
+		c.mu.Unlock()
+	}
+	return node
 }
 
-func (c *memcacheDriver) error(err error) error {
+// readmit readmits the ejected nodes which should be retried,
+// returning true if any node was readmitted. c.mu must be held.
+func (c *memcacheDriver) readmit(now time.Time) bool {
+	readmitted := false
+	c.hasEjected = false
+	for _, v := range c.nodes {
+		if v.ejectedUntil.IsZero() {
+			continue
+		}
+		if now.Before(v.ejectedUntil) {
+			c.hasEjected = true
+			continue
+		}
+		log.Infof("retrying ejected memcache server %s", v.addr)
+		v.ejectedUntil = time.Time{}
+		// Eject it again after a single failure
+		v.failures = c.ejectAfter - 1
+		readmitted = true
+	}
+	if readmitted {
+		c.rebuild()
+	}
+	return readmitted
+}
+
+// alive returns the non-ejected nodes. c.mu must be held.
+func (c *memcacheDriver) alive() []*memcacheNode {
+	alive := make([]*memcacheNode, 0, len(c.nodes))
+	for _, v := range c.nodes {
+		if v.ejectedUntil.IsZero() {
+			alive = append(alive, v)
+		}
+	}
+	return alive
+}
+
+// rebuild recreates the ring with the non-ejected
+// nodes. c.mu must be held.
+func (c *memcacheDriver) rebuild() {
+	c.ring = newHashRing(c.alive(), c.vnodes)
+}
+
+// report updates the health of node after an operation. Nodes
+// are ejected from the ring after ejectAfter consecutive failures
+// and they're retried after retryAfter. While a node is ejected,
+// its keys are assigned to the other nodes. The last non-ejected
+// node is never ejected, since there would be no nodes left to
+// assign its keys to.
+func (c *memcacheDriver) report(node *memcacheNode, failed bool) {
+	if !failed {
+		c.mu.RLock()
+		failures := node.failures
+		c.mu.RUnlock()
+		if failures == 0 {
+			return
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !failed {
+		node.failures = 0
+		return
+	}
+	node.failures++
+	if c.ejectAfter > 0 && node.failures >= c.ejectAfter && node.ejectedUntil.IsZero() && len(c.alive()) > 1 {
+		log.Warningf("ejecting memcache server %s after %d consecutive failures, retrying in %s", node.addr, node.failures, c.retryAfter)
+		node.ejectedUntil = time.Now().Add(c.retryAfter)
+		c.hasEjected = true
+		c.rebuild()
+	}
+}
+
+func (c *memcacheDriver) error(node *memcacheNode, err error) error {
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
+			c.report(node, false)
 			return nil
 		}
-		if nerr, ok := err.(net.Error); ok && nerr.Timeout() {
-			// Don't log these errors since they're so frequent
-			// in memcache that they end up generating a lot
-			// of logs
-			return nil
+		if nerr, ok := err.(net.Error); ok {
+			c.report(node, true)
+			if nerr.Timeout() {
+				// Don't log these errors since they're so frequent
+				// in memcache that they end up generating a lot
+				// of logs
+				return nil
+			}
 		}
+		return err
 	}
-	return err
+	c.report(node, false)
+	return nil
 }
 
 func memcacheOpener(url *config.URL) (driver.Driver, error) {
 	hosts := strings.Split(url.Value, ",")
-	conns := make([]string, len(hosts))
-	for ii, v := range hosts {
-		conns[ii] = driver.DefaultPort(v, 11211)
+	drv := &memcacheDriver{
+		ejectAfter: defaultEjectAfter,
+		retryAfter: defaultRetryAfter,
 	}
-	client, err := memcache.New(conns...)
-	if err != nil {
-		return nil, err
+	if vnodes, ok := url.Fragment.Int("vnodes"); ok {
+		drv.vnodes = vnodes
+	}
+	if ejectAfter, ok := url.Fragment.Int("eject_after"); ok {
+		drv.ejectAfter = ejectAfter
 	}
-	if tm, ok := url.Fragment.Int("timeout"); ok {
-		client.SetTimeout(time.Millisecond * time.Duration(tm))
+	if retryAfter, ok := url.Fragment.Int("retry_after"); ok {
+		drv.retryAfter = time.Second * time.Duration(retryAfter)
 	}
-	if maxIdle, ok := url.Fragment.Int("max_idle"); ok {
-		client.SetMaxIdleConnsPerAddr(maxIdle)
+	for _, v := range hosts {
+		addr := driver.DefaultPort(v, 11211)
+		client, err := memcache.New(addr)
+		if err != nil {
+			return nil, err
+		}
+		if tm, ok := url.Fragment.Int("timeout"); ok {
+			client.SetTimeout(time.Millisecond * time.Duration(tm))
+		}
+		if maxIdle, ok := url.Fragment.Int("max_idle"); ok {
+			client.SetMaxIdleConnsPerAddr(maxIdle)
+		}
+		drv.nodes = append(drv.nodes, &memcacheNode{addr: addr, client: client})
 	}
-	return &memcacheDriver{Client: client}, nil
+	drv.rebuild()
+	return drv, nil
 }
 
 func init() {
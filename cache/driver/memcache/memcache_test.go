@@ -0,0 +1,40 @@
+// +build !appengine
+
+package memcache
+
+import "testing"
+
+func TestEject(t *testing.T) {
+	nodes := testNodes("10.0.0.1:11211", "10.0.0.2:11211")
+	drv := &memcacheDriver{nodes: nodes, ejectAfter: 2, retryAfter: defaultRetryAfter}
+	drv.rebuild()
+	for ii := 0; ii < 2; ii++ {
+		drv.report(nodes[0], true)
+	}
+	if nodes[0].ejectedUntil.IsZero() {
+		t.Errorf("%s should have been ejected", nodes[0].addr)
+	}
+	if n := drv.pick("key"); n != nodes[1] {
+		t.Errorf("expecting %s for key, got %v", nodes[1].addr, n)
+	}
+	for ii := 0; ii < 10; ii++ {
+		drv.report(nodes[1], true)
+	}
+	if !nodes[1].ejectedUntil.IsZero() {
+		t.Errorf("%s is the last live node and should not have been ejected", nodes[1].addr)
+	}
+	if n := drv.pick("key"); n != nodes[1] {
+		t.Errorf("expecting %s for key, got %v", nodes[1].addr, n)
+	}
+	// Single host
+	single := testNodes("10.0.0.1:11211")
+	drv = &memcacheDriver{nodes: single, ejectAfter: 1, retryAfter: defaultRetryAfter}
+	drv.rebuild()
+	drv.report(single[0], true)
+	if !single[0].ejectedUntil.IsZero() {
+		t.Error("the only node should never be ejected")
+	}
+	if n := drv.pick("key"); n != single[0] {
+		t.Errorf("expecting %s for key, got %v", single[0].addr, n)
+	}
+}
@@ -0,0 +1,63 @@
+// +build !appengine
+
+package memcache
+
+import (
+	"hash/crc32"
+	"sort"
+	"strconv"
+)
+
+// defaultVirtualNodes is the default number of points
+// in the ring for each server.
+const defaultVirtualNodes = 160
+
+type ringPoint struct {
+	hash uint32
+	node *memcacheNode
+}
+
+// hashRing distributes the keys across the servers using
+// consistent hashing. Each server is assigned several points
+// (virtual nodes) in the ring and each key is stored in the
+// server owning the first point after the key hash. This way,
+// adding or removing a server only moves the keys assigned
+// to it, rather than reshuffling all of them.
+type hashRing struct {
+	points []ringPoint
+}
+
+func newHashRing(nodes []*memcacheNode, vnodes int) *hashRing {
+	if vnodes <= 0 {
+		vnodes = defaultVirtualNodes
+	}
+	points := make([]ringPoint, 0, len(nodes)*vnodes)
+	for _, n := range nodes {
+		for ii := 0; ii < vnodes; ii++ {
+			h := crc32.ChecksumIEEE([]byte(n.addr + "-" + strconv.Itoa(ii)))
+			points = append(points, ringPoint{hash: h, node: n})
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].hash != points[j].hash {
+			return points[i].hash < points[j].hash
+		}
+		// Make collisions deterministic
+		return points[i].node.addr < points[j].node.addr
+	})
+	return &hashRing{points: points}
+}
+
+// pick returns the node for the given key, or nil
+// if the ring is empty.
+func (r *hashRing) pick(key string) *memcacheNode {
+	if len(r.points) == 0 {
+		return nil
+	}
+	h := crc32.ChecksumIEEE([]byte(key))
+	idx := sort.Search(len(r.points), func(i int) bool { return r.points[i].hash >= h })
+	if idx == len(r.points) {
+		idx = 0
+	}
+	return r.points[idx].node
+}
@@ -0,0 +1,50 @@
+// +build !appengine
+
+package memcache
+
+import (
+	"strconv"
+	"testing"
+)
+
+func testNodes(addrs ...string) []*memcacheNode {
+	nodes := make([]*memcacheNode, len(addrs))
+	for ii, v := range addrs {
+		nodes[ii] = &memcacheNode{addr: v}
+	}
+	return nodes
+}
+
+func TestHashRing(t *testing.T) {
+	nodes := testNodes("10.0.0.1:11211", "10.0.0.2:11211", "10.0.0.3:11211")
+	ring := newHashRing(nodes, 0)
+	const keys = 10000
+	assigned := make(map[string]*memcacheNode, keys)
+	counts := make(map[*memcacheNode]int)
+	for ii := 0; ii < keys; ii++ {
+		k := "key" + strconv.Itoa(ii)
+		n := ring.pick(k)
+		assigned[k] = n
+		counts[n]++
+	}
+	for _, v := range nodes {
+		if c := counts[v]; c < keys/6 {
+			t.Errorf("server %s got only %d keys of %d", v.addr, c, keys)
+		}
+	}
+	// Removing a server must only move its keys
+	removed := nodes[1]
+	ring = newHashRing([]*memcacheNode{nodes[0], nodes[2]}, 0)
+	for k, prev := range assigned {
+		n := ring.pick(k)
+		if n == removed {
+			t.Fatalf("key %s assigned to removed server", k)
+		}
+		if prev != removed && n != prev {
+			t.Errorf("key %s moved from %s to %s", k, prev.addr, n.addr)
+		}
+	}
+	if n := newHashRing(nil, 0).pick("key"); n != nil {
+		t.Errorf("expecting no server from an empty ring, got %s", n.addr)
+	}
+}
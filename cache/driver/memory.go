@@ -1,61 +1,395 @@
 package driver
 
 import (
+	"container/heap"
+	"container/list"
 	"fmt"
-	"runtime"
-	"sort"
 	"sync"
 	"time"
 
 	"gnd.la/config"
+	"gnd.la/metrics"
 	"gnd.la/util/parseutil"
 )
 
+// Number of slots in the expiration wheel. Each
+// slot holds the items expiring in a given second.
+const wheelSlots = 256
+
+var (
+	memoryHits = metrics.NewCounter("gondola_cache_memory_hits_total",
+		"Number of keys found in the memory cache, by store", "store")
+	memoryMisses = metrics.NewCounter("gondola_cache_memory_misses_total",
+		"Number of keys not found in the memory cache, by store", "store")
+	memoryEvictions = metrics.NewCounter("gondola_cache_memory_evictions_total",
+		"Number of items evicted from the memory cache to honor its limits, by store", "store")
+	memoryExpirations = metrics.NewCounter("gondola_cache_memory_expirations_total",
+		"Number of expired items removed from the memory cache, by store", "store")
+	memoryBytes = metrics.NewGauge("gondola_cache_memory_bytes",
+		"Size of the data stored in the memory cache, by store", "store")
+	memoryEntries = metrics.NewGauge("gondola_cache_memory_entries",
+		"Number of items stored in the memory cache, by store", "store")
+
+	memoryStores struct {
+		sync.Mutex
+		stores map[string]*memoryStore
+	}
+)
+
 type item struct {
+	key     string
 	data    []byte
 	expires int64
+	// used by lruPolicy
+	elem *list.Element
+	// used by lfuPolicy
+	index int
+	hits  uint64
+	used  uint64
+}
+
+// evictionPolicy decides which item is evicted
+// when the store exceeds its limits.
+type evictionPolicy interface {
+	add(i *item)
+	access(i *item)
+	remove(i *item)
+	victim() *item
+}
+
+// lruPolicy evicts the least recently used item.
+type lruPolicy struct {
+	items list.List
+}
+
+func (p *lruPolicy) add(i *item) {
+	i.elem = p.items.PushFront(i)
+}
+
+func (p *lruPolicy) access(i *item) {
+	p.items.MoveToFront(i.elem)
+}
+
+func (p *lruPolicy) remove(i *item) {
+	p.items.Remove(i.elem)
+	i.elem = nil
+}
+
+func (p *lruPolicy) victim() *item {
+	if e := p.items.Back(); e != nil {
+		return e.Value.(*item)
+	}
+	return nil
+}
+
+// lfuPolicy evicts the least frequently used item,
+// using the least recently used one to break ties.
+type lfuPolicy struct {
+	items []*item
+	clock uint64
+}
+
+func (p *lfuPolicy) Len() int { return len(p.items) }
+
+func (p *lfuPolicy) Less(i, j int) bool {
+	a, b := p.items[i], p.items[j]
+	if a.hits != b.hits {
+		return a.hits < b.hits
+	}
+	return a.used < b.used
+}
+
+func (p *lfuPolicy) Swap(i, j int) {
+	p.items[i], p.items[j] = p.items[j], p.items[i]
+	p.items[i].index = i
+	p.items[j].index = j
+}
+
+func (p *lfuPolicy) Push(x interface{}) {
+	i := x.(*item)
+	i.index = len(p.items)
+	p.items = append(p.items, i)
+}
+
+func (p *lfuPolicy) Pop() interface{} {
+	last := len(p.items) - 1
+	i := p.items[last]
+	p.items[last] = nil
+	p.items = p.items[:last]
+	i.index = -1
+	return i
+}
+
+func (p *lfuPolicy) add(i *item) {
+	p.clock++
+	i.hits = 1
+	i.used = p.clock
+	heap.Push(p, i)
+}
+
+func (p *lfuPolicy) access(i *item) {
+	p.clock++
+	i.hits++
+	i.used = p.clock
+	heap.Fix(p, i.index)
+}
+
+func (p *lfuPolicy) remove(i *item) {
+	heap.Remove(p, i.index)
+}
+
+func (p *lfuPolicy) victim() *item {
+	if len(p.items) > 0 {
+		return p.items[0]
+	}
+	return nil
+}
+
+func newEvictionPolicy(name string) (evictionPolicy, error) {
+	switch name {
+	case "lru":
+		return &lruPolicy{}, nil
+	case "lfu":
+		return &lfuPolicy{}, nil
+	}
+	return nil, fmt.Errorf("invalid eviction policy %q, must be lru or lfu", name)
+}
+
+// expiryWheel is a timer wheel with one slot per second, which
+// lets the store remove the expired items without scanning all
+// of them. Items expiring after more than wheelSlots seconds share
+// the slot with the ones expiring in the same second modulo
+// wheelSlots, and they're skipped until they expire.
+type expiryWheel struct {
+	slots   [wheelSlots]map[*item]struct{}
+	count   int
+	last    int64
+	running bool
+}
+
+func (w *expiryWheel) add(i *item) {
+	slot := &w.slots[i.expires%wheelSlots]
+	if *slot == nil {
+		*slot = make(map[*item]struct{})
+	}
+	(*slot)[i] = struct{}{}
+	w.count++
+}
+
+func (w *expiryWheel) remove(i *item) {
+	slot := w.slots[i.expires%wheelSlots]
+	if _, ok := slot[i]; ok {
+		delete(slot, i)
+		w.count--
+	}
+}
+
+// expired returns the items which expired before now, advancing
+// the wheel up to now - 1.
+func (w *expiryWheel) expired(now int64) []*item {
+	var items []*item
+	from := w.last + 1
+	if from < now-wheelSlots {
+		from = now - wheelSlots
+	}
+	for sec := from; sec < now; sec++ {
+		for i := range w.slots[sec%wheelSlots] {
+			if i.expires < now {
+				items = append(items, i)
+			}
+		}
+	}
+	w.last = now - 1
+	return items
+}
+
+// memoryStore holds the items for all the memory
+// drivers opened with the same name.
+type memoryStore struct {
+	name       string
+	mu         sync.Mutex
+	items      map[string]*item
+	size       uint64
+	maxSize    uint64
+	maxEntries int
+	eviction   string
+	policy     evictionPolicy
+	wheel      expiryWheel
 }
 
-type keyedItem struct {
-	key  string
-	item *item
+func (s *memoryStore) set(key string, b []byte, expires int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if prev := s.items[key]; prev != nil {
+		s.removeLocked(prev)
+	}
+	size := uint64(len(b))
+	if s.maxSize > 0 && size > s.maxSize {
+		// Can't fit, even after evicting everything
+		s.updateGauges()
+		return
+	}
+	// Make room before adding the item, so it's
+	// never chosen as the victim.
+	s.evictLocked(size, 1)
+	i := &item{key: key, data: b, expires: expires}
+	s.items[key] = i
+	s.size += size
+	s.policy.add(i)
+	if expires != 0 {
+		s.wheel.add(i)
+		if !s.wheel.running {
+			s.wheel.running = true
+			s.wheel.last = time.Now().Unix() - 1
+			go s.expireWorker()
+		}
+	}
+	s.updateGauges()
 }
 
-type byExpirationAndSize []*keyedItem
+func (s *memoryStore) get(key string, now int64) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.items[key]
+	if i != nil && i.expires != 0 && i.expires < now {
+		s.removeLocked(i)
+		memoryExpirations.Inc(s.name)
+		s.updateGauges()
+		i = nil
+	}
+	if i == nil {
+		memoryMisses.Inc(s.name)
+		return nil
+	}
+	s.policy.access(i)
+	memoryHits.Inc(s.name)
+	return i.data
+}
 
-func (e byExpirationAndSize) Len() int {
-	return len(e)
+func (s *memoryStore) delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i := s.items[key]; i != nil {
+		s.removeLocked(i)
+		s.updateGauges()
+	}
 }
 
-func (e byExpirationAndSize) Less(i, j int) bool {
-	ei := e[i].item.expires
-	ej := e[j].item.expires
-	if ei != 0 && ej != 0 && ei != ej {
-		return ei < ej
+func (s *memoryStore) flush() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policy, _ = newEvictionPolicy(s.eviction)
+	s.items = make(map[string]*item)
+	s.size = 0
+	s.wheel = expiryWheel{running: s.wheel.running, last: s.wheel.last}
+	s.updateGauges()
+}
+
+// configure sets the limits and the eviction policy for
+// the store, evicting items if required.
+func (s *memoryStore) configure(maxSize uint64, maxEntries int, eviction string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if eviction != "" && eviction != s.eviction {
+		policy, err := newEvictionPolicy(eviction)
+		if err != nil {
+			return err
+		}
+		for _, v := range s.items {
+			s.policy.remove(v)
+			policy.add(v)
+		}
+		s.eviction = eviction
+		s.policy = policy
 	}
-	if ei != 0 && ej == 0 {
-		return true
+	s.maxSize = maxSize
+	s.maxEntries = maxEntries
+	s.evictLocked(0, 0)
+	s.updateGauges()
+	return nil
+}
+
+func (s *memoryStore) removeLocked(i *item) {
+	delete(s.items, i.key)
+	s.size -= uint64(len(i.data))
+	s.policy.remove(i)
+	if i.expires != 0 {
+		s.wheel.remove(i)
 	}
-	if ei == 0 && ej != 0 {
-		return false
+}
+
+// evictLocked evicts items until the given size and
+// number of entries can be added without exceeding
+// the store limits.
+func (s *memoryStore) evictLocked(size uint64, entries int) {
+	for (s.maxSize > 0 && s.size+size > s.maxSize) || (s.maxEntries > 0 && len(s.items)+entries > s.maxEntries) {
+		victim := s.policy.victim()
+		if victim == nil {
+			break
+		}
+		s.removeLocked(victim)
+		memoryEvictions.Inc(s.name)
 	}
-	return len(e[i].item.data) > len(e[j].item.data)
 }
 
-func (e byExpirationAndSize) Swap(i, j int) {
-	e[i], e[j] = e[j], e[i]
+func (s *memoryStore) updateGauges() {
+	memoryBytes.Set(float64(s.size), s.name)
+	memoryEntries.Set(float64(len(s.items)), s.name)
 }
 
-var cache struct {
-	sync.RWMutex
-	items map[string]*item
-	size  uint64
+// expireWorker removes the expired items every second, while
+// there are items with an expiration in the store.
+func (s *memoryStore) expireWorker() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for range ticker.C {
+		s.mu.Lock()
+		expired := s.wheel.expired(time.Now().Unix())
+		for _, v := range expired {
+			s.removeLocked(v)
+		}
+		if len(expired) > 0 {
+			memoryExpirations.Add(float64(len(expired)), s.name)
+			s.updateGauges()
+		}
+		if s.wheel.count == 0 {
+			s.wheel.running = false
+			s.mu.Unlock()
+			return
+		}
+		s.mu.Unlock()
+	}
 }
 
+// memoryStoreNamed returns the store with the given
+// name, creating it if it doesn't exist yet.
+func memoryStoreNamed(name string) *memoryStore {
+	memoryStores.Lock()
+	defer memoryStores.Unlock()
+	if s := memoryStores.stores[name]; s != nil {
+		return s
+	}
+	label := name
+	if label == "" {
+		label = "default"
+	}
+	s := &memoryStore{
+		name:     label,
+		items:    make(map[string]*item),
+		eviction: "lru",
+		policy:   &lruPolicy{},
+	}
+	if memoryStores.stores == nil {
+		memoryStores.stores = make(map[string]*memoryStore)
+	}
+	memoryStores.stores[name] = s
+	return s
+}
+
+// MemoryDriver implements an in-process cache. See
+// the package documentation for its options.
 type MemoryDriver struct {
-	maxSize uint64
-	prune   chan struct{}
-	mu      sync.Mutex
+	store *memoryStore
 }
 
 func (d *MemoryDriver) Set(key string, b []byte, timeout int) error {
@@ -63,92 +397,31 @@ func (d *MemoryDriver) Set(key string, b []byte, timeout int) error {
 	if timeout != 0 {
 		expires = time.Now().Unix() + int64(timeout)
 	}
-	prevSize := uint64(0)
-	cache.Lock()
-	if prev := cache.items[key]; prev != nil {
-		prevSize = uint64(len(prev.data))
-	}
-	cache.items[key] = &item{
-		data:    b,
-		expires: expires,
-	}
-	cache.size += uint64(len(b)) - prevSize
-	if d.maxSize > 0 && cache.size > d.maxSize {
-		d.mu.Lock()
-		// Unlock before sending over the channel,
-		// otherwise we might cause a deadlock since
-		// the pruneWorker might be waiting for the
-		// cache lock to be released while the send
-		// might be blocking waiting for the pruneWorker.
-		cache.Unlock()
-		d.prune <- struct{}{}
-		d.mu.Unlock()
-		return nil
-	}
-	cache.Unlock()
+	d.store.set(key, b, expires)
 	return nil
 }
 
 func (d *MemoryDriver) Get(key string) ([]byte, error) {
-	cache.RLock()
-	item := cache.items[key]
-	cache.RUnlock()
-	if item == nil {
-		return nil, nil
-	}
-	if item.expires != 0 && item.expires < time.Now().Unix() {
-		d.deleteItem(key, item)
-		return nil, nil
-	}
-	return item.data, nil
+	return d.store.get(key, time.Now().Unix()), nil
 }
 
 func (d *MemoryDriver) GetMulti(keys []string) (map[string][]byte, error) {
-	items := make(map[string]*item, len(keys))
-	cache.RLock()
-	for _, v := range keys {
-		items[v] = cache.items[v]
-	}
-	cache.RUnlock()
 	results := make(map[string][]byte, len(keys))
 	now := time.Now().Unix()
-	for k, v := range items {
-		if v != nil {
-			if v.expires != 0 && v.expires < now {
-				d.deleteItem(k, v)
-				continue
-			}
-			results[k] = v.data
+	for _, v := range keys {
+		if b := d.store.get(v, now); b != nil {
+			results[v] = b
 		}
 	}
 	return results, nil
 }
 
 func (d *MemoryDriver) Delete(key string) error {
-	cache.RLock()
-	item := cache.items[key]
-	cache.RUnlock()
-	if item == nil {
-		return nil
-	}
-	d.deleteItem(key, item)
+	d.store.delete(key)
 	return nil
 }
 
-func (d *MemoryDriver) deleteItem(key string, i *item) {
-	cache.Lock()
-	delete(cache.items, key)
-	cache.size -= uint64(len(i.data))
-	cache.Unlock()
-}
-
 func (d *MemoryDriver) Close() error {
-	if d.prune != nil {
-		d.mu.Lock()
-		defer d.mu.Unlock()
-		close(d.prune)
-		d.prune = nil
-	}
 	return nil
 }
 
@@ -157,58 +430,33 @@ func (d *MemoryDriver) Connection() interface{} {
 }
 
 func (d *MemoryDriver) Flush() error {
-	cache.Lock()
-	defer cache.Unlock()
-	cache.size = 0
-	cache.items = make(map[string]*item)
+	d.store.flush()
 	return nil
 }
 
-func (d *MemoryDriver) pruneWorker(ch <-chan struct{}) {
-	for _ = range ch {
-		d.pruneCache()
-	}
-}
-
-func (d *MemoryDriver) pruneCache() {
-	cache.Lock()
-	defer cache.Unlock()
-	if cache.size < d.maxSize {
-		return
-	}
-	items := make([]*keyedItem, 0, len(cache.items))
-	for k, v := range cache.items {
-		items = append(items, &keyedItem{
-			key:  k,
-			item: v,
-		})
-	}
-	sort.Sort(byExpirationAndSize(items))
-	threshold := uint64(float64(d.maxSize) * 0.9)
-	for _, v := range items {
-		delete(cache.items, v.key)
-		cache.size -= uint64(len(v.item.data))
-		if cache.size < threshold {
-			break
-		}
-	}
-}
-
 func openMemoryDriver(url *config.URL) (Driver, error) {
-	mdrv := &MemoryDriver{}
+	var maxSize uint64
 	if ms := url.Fragment.Get("max_size"); ms != "" {
-		maxSize, err := parseutil.Size(ms)
+		var err error
+		maxSize, err = parseutil.Size(ms)
 		if err != nil {
 			return nil, fmt.Errorf("invalid max_size %q", ms)
 		}
-		mdrv.maxSize = maxSize
-		mdrv.prune = make(chan struct{}, runtime.GOMAXPROCS(0))
-		go mdrv.pruneWorker(mdrv.prune)
 	}
-	return mdrv, nil
+	var maxEntries int
+	if me := url.Fragment.Get("max_entries"); me != "" {
+		var ok bool
+		if maxEntries, ok = url.Fragment.Int("max_entries"); !ok || maxEntries < 0 {
+			return nil, fmt.Errorf("invalid max_entries %q", me)
+		}
+	}
+	store := memoryStoreNamed(url.Value)
+	if err := store.configure(maxSize, maxEntries, url.Fragment.Get("eviction")); err != nil {
+		return nil, err
+	}
+	return &MemoryDriver{store: store}, nil
 }
 
 func init() {
-	cache.items = make(map[string]*item)
 	Register("memory", openMemoryDriver)
 }
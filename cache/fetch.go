@@ -0,0 +1,184 @@
+package cache
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"gnd.la/app/profile"
+)
+
+const fetchHeaderSize = 8
+
+var errInvalidFetchData = errors.New("invalid data for fetched item, was it stored with Set()?")
+
+// FillFunc is the function type used by Fetch and FetchStale for
+// generating a value when it's not found in the cache. The returned
+// value is stored in the cache with the cache codec.
+type FillFunc func() (interface{}, error)
+
+// Stats holds the counters for the operations performed
+// by Fetch and FetchStale. See Cache.Stats.
+type Stats struct {
+	// Hits is the number of fetches served with a fresh value
+	// from the cache.
+	Hits uint64
+	// StaleHits is the number of fetches served with a stale
+	// value while the value was refreshed in the background.
+	StaleHits uint64
+	// Misses is the number of fetches which didn't find
+	// the value in the cache.
+	Misses uint64
+	// Fills is the number of times a FillFunc was called.
+	Fills uint64
+	// FillErrors is the number of times a FillFunc returned
+	// an error.
+	FillErrors uint64
+	// Shared is the number of fetches which waited for a fill
+	// started by another caller rather than calling their own
+	// FillFunc.
+	Shared uint64
+}
+
+// Fetch retrieves the item with the given key from the cache and decodes
+// it into obj, like Get does. If the item is not found, fill is called to
+// generate it and the returned value is stored in the cache with the given
+// timeout (see Set for its semantics) and then decoded into obj. Concurrent
+// calls to Fetch for the same key on the same Cache only call fill once, while
+// the rest of the callers wait for its result.
+//
+// Items stored by Fetch include a small header, so they should only be
+// retrieved with Fetch or FetchStale, and never with Get or GetBytes.
+func (c *Cache) Fetch(key string, obj interface{}, timeout int, fill FillFunc) error {
+	return c.FetchStale(key, obj, timeout, 0, fill)
+}
+
+// FetchStale works like Fetch, but keeps items in the cache for stale
+// additional seconds after they expire. When a expired item is found
+// in the cache during that time, it's immediately decoded into obj
+// and a background goroutine calls fill to refresh it. If timeout is
+// zero, items never expire, so stale has no effect.
+func (c *Cache) FetchStale(key string, obj interface{}, timeout int, stale int, fill FillFunc) error {
+	if profile.On && profile.Profiling() {
+		defer profile.Start(cache).Note("FETCH", key).End()
+	}
+	data, _ := c.GetBytes(key)
+	if data != nil {
+		payload, freshUntil, err := decodeFetchData(data)
+		if err != nil {
+			derr := &cacheError{
+				op:  "decoding fetched data",
+				key: key,
+				err: err,
+			}
+			c.error(derr)
+			return derr
+		}
+		if freshUntil == 0 || time.Now().UnixNano() < freshUntil {
+			atomic.AddUint64(&c.stats.Hits, 1)
+			return c.decode(key, payload, obj)
+		}
+		atomic.AddUint64(&c.stats.StaleHits, 1)
+		c.flights.Go(key, func() ([]byte, error) {
+			return c.fill(key, timeout, stale, fill)
+		})
+		return c.decode(key, payload, obj)
+	}
+	atomic.AddUint64(&c.stats.Misses, 1)
+	payload, err, shared := c.flights.Do(key, func() ([]byte, error) {
+		return c.fill(key, timeout, stale, fill)
+	})
+	if shared {
+		atomic.AddUint64(&c.stats.Shared, 1)
+	}
+	if err != nil {
+		return err
+	}
+	return c.decode(key, payload, obj)
+}
+
+// Stats returns a snapshot of the counters for the operations
+// performed by Fetch and FetchStale in this Cache.
+func (c *Cache) Stats() Stats {
+	return Stats{
+		Hits:       atomic.LoadUint64(&c.stats.Hits),
+		StaleHits:  atomic.LoadUint64(&c.stats.StaleHits),
+		Misses:     atomic.LoadUint64(&c.stats.Misses),
+		Fills:      atomic.LoadUint64(&c.stats.Fills),
+		FillErrors: atomic.LoadUint64(&c.stats.FillErrors),
+		Shared:     atomic.LoadUint64(&c.stats.Shared),
+	}
+}
+
+// fill calls the FillFunc, encodes its result and stores it in the
+// cache. It returns the encoded value, without the fetch header. If
+// the FillFunc panics, the panic is recovered and returned as an
+// error, so the callers waiting for the same key receive it too.
+func (c *Cache) fill(key string, timeout int, stale int, fill FillFunc) (payload []byte, err error) {
+	atomic.AddUint64(&c.stats.Fills, 1)
+	defer func() {
+		if r := recover(); r != nil {
+			atomic.AddUint64(&c.stats.FillErrors, 1)
+			perr := &cacheError{
+				op:  "filling",
+				key: key,
+				err: fmt.Errorf("panic: %v", r),
+			}
+			c.error(perr)
+			payload, err = nil, perr
+		}
+	}()
+	value, err := fill()
+	if err != nil {
+		atomic.AddUint64(&c.stats.FillErrors, 1)
+		return nil, err
+	}
+	payload, err = c.codec.Encode(value)
+	if err != nil {
+		eerr := &cacheError{
+			op:    "encoding object",
+			key:   key,
+			codec: true,
+			err:   err,
+		}
+		c.error(eerr)
+		return nil, eerr
+	}
+	var freshUntil int64
+	if timeout > 0 {
+		freshUntil = time.Now().Add(time.Duration(timeout) * time.Second).UnixNano()
+		if stale > 0 {
+			timeout += stale
+		}
+	}
+	data := make([]byte, fetchHeaderSize+len(payload))
+	binary.BigEndian.PutUint64(data, uint64(freshUntil))
+	copy(data[fetchHeaderSize:], payload)
+	// Errors storing the item are logged by SetBytes, but
+	// the value can still be returned to the caller.
+	c.SetBytes(key, data, timeout)
+	return payload, nil
+}
+
+func (c *Cache) decode(key string, data []byte, obj interface{}) error {
+	if err := c.codec.Decode(data, obj); err != nil {
+		derr := &cacheError{
+			op:    "decoding object",
+			key:   key,
+			codec: true,
+			err:   err,
+		}
+		c.error(derr)
+		return derr
+	}
+	return nil
+}
+
+func decodeFetchData(data []byte) ([]byte, int64, error) {
+	if len(data) < fetchHeaderSize {
+		return nil, 0, errInvalidFetchData
+	}
+	return data[fetchHeaderSize:], int64(binary.BigEndian.Uint64(data)), nil
+}
@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"sync"
+)
+
+type flightCall struct {
+	wg   sync.WaitGroup
+	data []byte
+	err  error
+}
+
+// flightGroup deduplicates concurrent calls for the same key,
+// so only one of them executes the function while the rest
+// wait for it and receive its result. The zero flightGroup
+// is ready to use.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+// Do executes fn for the given key, unless another call for
+// the same key is already in progress. In that case, Do waits
+// for it and returns its results. The shared return value
+// indicates if the results were produced by another caller.
+func (g *flightGroup) Do(key string, fn func() ([]byte, error)) (data []byte, err error, shared bool) {
+	g.mu.Lock()
+	if call := g.calls[key]; call != nil {
+		g.mu.Unlock()
+		call.wg.Wait()
+		return call.data, call.err, true
+	}
+	call := new(flightCall)
+	call.wg.Add(1)
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	g.calls[key] = call
+	g.mu.Unlock()
+	g.run(key, call, fn)
+	return call.data, call.err, false
+}
+
+// Go works like Do, but executes fn in a new goroutine and
+// returns immediately. If there's already a call in progress
+// for the given key, Go does nothing and returns false.
+func (g *flightGroup) Go(key string, fn func() ([]byte, error)) bool {
+	g.mu.Lock()
+	if g.calls[key] != nil {
+		g.mu.Unlock()
+		return false
+	}
+	call := new(flightCall)
+	call.wg.Add(1)
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	g.calls[key] = call
+	g.mu.Unlock()
+	go g.run(key, call, fn)
+	return true
+}
+
+func (g *flightGroup) run(key string, call *flightCall, fn func() ([]byte, error)) {
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		call.wg.Done()
+	}()
+	call.data, call.err = fn()
+}
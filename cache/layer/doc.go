@@ -10,7 +10,10 @@
 // should bypass the Layer.
 //
 // This package provides the SimpleMediator, which implements the
-// Mediator protocol with enough knobs to satisty most common needs.
+// Mediator protocol with enough knobs to satisty most common needs, and
+// the PageMediator, which also supports varying the cached responses by
+// request headers and users, per handler expirations and invalidating
+// responses by tags (see TagMediator and Layer.Invalidate).
 // Users with more advanced requirements should write their own Mediator
 // implementation.
 //
@@ -74,22 +74,17 @@ func (la *Layer) Wrap(handler app.Handler) app.Handler {
 			return
 		}
 		key := la.mediator.Key(ctx)
-		data, _ := la.cache.GetBytes(key)
-		if data != nil {
-			// has cached data
-			var response *cachedResponse
-			err := layerCodec.Decode(data, &response)
-			if err == nil {
-				ctx.Set(internal.LayerServedFromCacheKey, true)
-				header := ctx.Header()
-				for k, v := range response.Header {
-					header[k] = v
-				}
-				header["X-Gondola-From-Layer"] = fromLayer
-				ctx.WriteHeader(response.StatusCode)
-				ctx.Write(response.Data)
-				return
+		tm, tagged := la.mediator.(TagMediator)
+		if response := la.cached(key, tagged); response != nil {
+			ctx.Set(internal.LayerServedFromCacheKey, true)
+			header := ctx.Header()
+			for k, v := range response.Header {
+				header[k] = v
 			}
+			header["X-Gondola-From-Layer"] = fromLayer
+			ctx.WriteHeader(response.StatusCode)
+			ctx.Write(response.Data)
+			return
 		}
 
 		rw := ctx.ResponseWriter
@@ -99,10 +94,14 @@ func (la *Layer) Wrap(handler app.Handler) app.Handler {
 		ctx.ResponseWriter = rw
 		if la.mediator.Cache(ctx, w.statusCode, w.header) {
 			response := &cachedResponse{w.header, w.statusCode, w.buf.Bytes()}
-			data, err := layerCodec.Encode(response)
-			if err == nil {
+			expiration := la.mediator.Expires(ctx, w.statusCode, w.header)
+			if tagged {
+				tags := tm.Tags(ctx, w.statusCode, w.header)
+				if err := la.cache.SetWithTags(key, response, expiration, tags...); err == nil {
+					ctx.Set(internal.LayerCachedKey, true)
+				}
+			} else if data, err := layerCodec.Encode(response); err == nil {
 				ctx.Set(internal.LayerCachedKey, true)
-				expiration := la.mediator.Expires(ctx, w.statusCode, w.header)
 				la.cache.SetBytes(key, data, expiration)
 			} else {
 				log.Errorf("Error encoding cached response: %v", err)
@@ -111,6 +110,28 @@ func (la *Layer) Wrap(handler app.Handler) app.Handler {
 	}
 }
 
+// Invalidate removes all the cached responses associated with any
+// of the given tags. Note that responses are only associated with tags
+// when the Layer's Mediator implements TagMediator.
+func (la *Layer) Invalidate(tags ...string) error {
+	return la.cache.InvalidateTags(tags...)
+}
+
+func (la *Layer) cached(key string, tagged bool) *cachedResponse {
+	var response *cachedResponse
+	if tagged {
+		if err := la.cache.GetTagged(key, &response); err != nil {
+			return nil
+		}
+		return response
+	}
+	data, _ := la.cache.GetBytes(key)
+	if data == nil || layerCodec.Decode(data, &response) != nil {
+		return nil
+	}
+	return response
+}
+
 func init() {
 	gob.Register(&cachedResponse{})
 }
@@ -1,9 +1,12 @@
 package layer
 
 import (
+	"net/http"
+	"strconv"
+	"strings"
+
 	"gnd.la/app"
 	"gnd.la/crypto/hashutil"
-	"net/http"
 )
 
 // Mediator is the interface which indicates the Layer
@@ -21,6 +24,18 @@ type Mediator interface {
 	Expires(ctx *app.Context, responseCode int, outgoingHeaders http.Header) int
 }
 
+// TagMediator is an optional interface which might be implemented
+// by a Mediator. When it is, the cached responses are associated with
+// the returned tags and Layer.Invalidate can be used to remove all the
+// responses associated with a given tag (e.g. the pages showing a given
+// object can be invalidated when the object changes).
+type TagMediator interface {
+	Mediator
+	// Tags returns the tags associated with the response with the given
+	// code and headers.
+	Tags(ctx *app.Context, responseCode int, outgoingHeaders http.Header) []string
+}
+
 // SimpleMediator implements a Mediator which caches GET and HEAD
 // request with a 200 response code for a fixed time and skips
 // the cache if any of the indicated cookies are present. Cache keys
@@ -57,3 +72,75 @@ func (m *SimpleMediator) Cache(ctx *app.Context, responseCode int, outgoingHeade
 func (m *SimpleMediator) Expires(ctx *app.Context, responseCode int, outgoingHeaders http.Header) int {
 	return m.Expiration
 }
+
+// PageMediator implements a Mediator and a TagMediator which caches GET
+// and HEAD requests with a 200 response code. Cache keys are generated
+// by hashing the request method, its URL and the values of the headers
+// listed in Vary. Expirations might be set per handler, using the
+// handler names (see app.Context.HandlerName).
+type PageMediator struct {
+	// Vary lists the request headers which, in addition to the method
+	// and the URL, make up the cache key (e.g. Accept-Language).
+	Vary []string
+	// PerUser indicates if the responses should be cached separately
+	// for every signed in user. If PerUser is false, requests made by
+	// signed in users skip the cache.
+	PerUser bool
+	// Expiration indicates the default cache expiration for cached
+	// requests.
+	Expiration int
+	// Handlers includes per handler expirations, keyed by handler name.
+	// A negative expiration makes the requests for the given handler
+	// skip the cache.
+	Handlers map[string]int
+	// TagFunc, if non-nil, returns the tags associated with
+	// the response generated for the given context.
+	TagFunc func(ctx *app.Context) []string
+}
+
+func (m *PageMediator) Skip(ctx *app.Context) bool {
+	if m := ctx.R.Method; m != "GET" && m != "HEAD" {
+		return true
+	}
+	if !m.PerUser && ctx.Cookies().Has(app.USER_COOKIE_NAME) {
+		return true
+	}
+	return m.expiration(ctx) < 0
+}
+
+func (m *PageMediator) Key(ctx *app.Context) string {
+	parts := []string{ctx.R.Method, ctx.R.URL.String()}
+	for _, v := range m.Vary {
+		parts = append(parts, v+": "+ctx.R.Header.Get(v))
+	}
+	if m.PerUser {
+		var id int64
+		if user := ctx.User(); user != nil {
+			id = user.Id()
+		}
+		parts = append(parts, "user: "+strconv.FormatInt(id, 10))
+	}
+	return hashutil.Md5(strings.Join(parts, "\n"))
+}
+
+func (m *PageMediator) Cache(ctx *app.Context, responseCode int, outgoingHeaders http.Header) bool {
+	return responseCode == http.StatusOK
+}
+
+func (m *PageMediator) Expires(ctx *app.Context, responseCode int, outgoingHeaders http.Header) int {
+	return m.expiration(ctx)
+}
+
+func (m *PageMediator) Tags(ctx *app.Context, responseCode int, outgoingHeaders http.Header) []string {
+	if m.TagFunc != nil {
+		return m.TagFunc(ctx)
+	}
+	return nil
+}
+
+func (m *PageMediator) expiration(ctx *app.Context) int {
+	if exp, ok := m.Handlers[ctx.HandlerName()]; ok {
+		return exp
+	}
+	return m.Expiration
+}
@@ -0,0 +1,204 @@
+package cache
+
+import (
+	"encoding/binary"
+	"reflect"
+	"strconv"
+	"time"
+
+	"gnd.la/app/profile"
+)
+
+const namespaceKeyPrefix = "gondola:ns:"
+
+// Namespace groups keys which can be invalidated at once, without
+// enumerating them. Namespaces are created with Cache.Namespace.
+//
+// Each namespace has a version, which is stored in the cache and
+// included in the backend keys of its items. Invalidate bumps the
+// version, so the previously stored items are no longer reachable
+// and they're eventually evicted by the backend. This makes
+// namespaces work with any driver, including memcache, which can't
+// enumerate keys (e.g. for invalidating all the cached templates
+// when deploying a new version of the app).
+//
+// Note that retrieving an item requires an additional trip to the
+// cache for obtaining the namespace version, but GetMulti fetches
+// it only once for all the keys.
+type Namespace struct {
+	cache *Cache
+	name  string
+}
+
+// Namespace returns the namespace with the given name. Namespaces
+// don't need to be created, so this function always succeeds and
+// calling it multiple times with the same name returns equivalent
+// namespaces.
+func (c *Cache) Namespace(name string) *Namespace {
+	return &Namespace{cache: c, name: name}
+}
+
+// Name returns the namespace name.
+func (n *Namespace) Name() string {
+	return n.name
+}
+
+// Cache returns the Cache the namespace belongs to.
+func (n *Namespace) Cache() *Cache {
+	return n.cache
+}
+
+// Set works like Cache.Set, but stores the item in the namespace.
+func (n *Namespace) Set(key string, object interface{}, timeout int) error {
+	k, err := n.key(key)
+	if err != nil {
+		return err
+	}
+	return n.cache.Set(k, object, timeout)
+}
+
+// Get works like Cache.Get, but retrieves the item from the namespace.
+func (n *Namespace) Get(key string, obj interface{}) error {
+	k, err := n.key(key)
+	if err != nil {
+		return err
+	}
+	return n.cache.Get(k, obj)
+}
+
+// SetBytes works like Cache.SetBytes, but stores the data in
+// the namespace.
+func (n *Namespace) SetBytes(key string, b []byte, timeout int) error {
+	k, err := n.key(key)
+	if err != nil {
+		return err
+	}
+	return n.cache.SetBytes(k, b, timeout)
+}
+
+// GetBytes works like Cache.GetBytes, but retrieves the data
+// from the namespace.
+func (n *Namespace) GetBytes(key string) ([]byte, error) {
+	k, err := n.key(key)
+	if err != nil {
+		return nil, err
+	}
+	return n.cache.GetBytes(k)
+}
+
+// GetMulti works like Cache.GetMulti, but retrieves the
+// items from the namespace.
+func (n *Namespace) GetMulti(out map[string]interface{}, typer Typer) error {
+	prefix, err := n.prefix()
+	if err != nil {
+		return err
+	}
+	if typer == nil {
+		typer = mapTyper(out)
+	}
+	nsOut := make(map[string]interface{}, len(out))
+	for k, v := range out {
+		nsOut[prefix+k] = v
+	}
+	if err := n.cache.GetMulti(nsOut, prefixTyper{prefix, typer}); err != nil {
+		return err
+	}
+	for k := range out {
+		if v, ok := nsOut[prefix+k]; ok {
+			out[k] = v
+		} else {
+			delete(out, k)
+		}
+	}
+	return nil
+}
+
+// Delete works like Cache.Delete, but removes the item from
+// the namespace.
+func (n *Namespace) Delete(key string) error {
+	k, err := n.key(key)
+	if err != nil {
+		return err
+	}
+	return n.cache.Delete(k)
+}
+
+// Invalidate invalidates all the items in the namespace by bumping
+// its version. The new version is always higher than the previous
+// one, so items stored by other processes using the old version are
+// invalidated too.
+func (n *Namespace) Invalidate() error {
+	if profile.On && profile.Profiling() {
+		defer profile.Start(cache).Note("INVALIDATE NAMESPACE", n.name).End()
+	}
+	current, err := n.version(false)
+	if err != nil {
+		return err
+	}
+	version := time.Now().UnixNano()
+	if version <= current {
+		version = current + 1
+	}
+	n.cache.debugf("Invalidating namespace %s, version %d => %d", n.name, current, version)
+	return n.setVersion(version)
+}
+
+func (n *Namespace) versionKey() string {
+	return namespaceKeyPrefix + n.name
+}
+
+func (n *Namespace) setVersion(version int64) error {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(version))
+	return n.cache.SetBytes(n.versionKey(), buf[:], 0)
+}
+
+// version returns the current version of the namespace. If create
+// is true and the namespace has no version, a new one is assigned.
+// Otherwise, 0 is returned for namespaces without a version.
+func (n *Namespace) version(create bool) (int64, error) {
+	b, err := n.cache.GetBytes(n.versionKey())
+	if err != nil && err != ErrNotFound {
+		return 0, err
+	}
+	if len(b) == 8 {
+		return int64(binary.BigEndian.Uint64(b)), nil
+	}
+	if !create {
+		return 0, nil
+	}
+	version := time.Now().UnixNano()
+	if err := n.setVersion(version); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// prefix returns the prefix for the keys stored in the
+// current version of the namespace.
+func (n *Namespace) prefix() (string, error) {
+	version, err := n.version(true)
+	if err != nil {
+		return "", err
+	}
+	return namespaceKeyPrefix + n.name + ":" + strconv.FormatInt(version, 36) + ":", nil
+}
+
+func (n *Namespace) key(key string) (string, error) {
+	prefix, err := n.prefix()
+	if err != nil {
+		return "", err
+	}
+	return prefix + key, nil
+}
+
+// prefixTyper wraps a Typer, removing the
+// namespace prefix from the keys.
+type prefixTyper struct {
+	prefix string
+	typer  Typer
+}
+
+func (t prefixTyper) Type(key string) reflect.Type {
+	return t.typer.Type(key[len(t.prefix):])
+}
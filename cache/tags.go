@@ -0,0 +1,207 @@
+package cache
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+
+	"gnd.la/app/profile"
+)
+
+const tagKeyPrefix = "gondola:tag:"
+
+var errInvalidTaggedData = errors.New("invalid data for tagged item, was it stored with SetWithTags()?")
+
+// SetWithTags works like Set, but also associates the item with the
+// given tags. Calling InvalidateTags with any of these tags will cause
+// the item to be invalidated.
+//
+// Tags are implemented by storing a version for each tag in the cache,
+// which gets recorded with the item and checked when the item is
+// retrieved, so they work with any driver, including memcache. Note that
+// this means tagged items should only be retrieved with GetTagged, never
+// with Get or GetBytes.
+func (c *Cache) SetWithTags(key string, object interface{}, timeout int, tags ...string) error {
+	b, err := c.codec.Encode(object)
+	if err != nil {
+		eerr := &cacheError{
+			op:    "encoding object",
+			key:   key,
+			codec: true,
+			err:   err,
+		}
+		c.error(eerr)
+		return eerr
+	}
+	versions, err := c.tagVersions(tags, true)
+	if err != nil {
+		return err
+	}
+	size := 2 + len(b)
+	for _, v := range tags {
+		size += 2 + len(v) + 8
+	}
+	data := make([]byte, 2, size)
+	binary.BigEndian.PutUint16(data, uint16(len(tags)))
+	var buf [8]byte
+	for ii, v := range tags {
+		binary.BigEndian.PutUint16(buf[:2], uint16(len(v)))
+		data = append(data, buf[:2]...)
+		data = append(data, v...)
+		binary.BigEndian.PutUint64(buf[:], uint64(versions[ii]))
+		data = append(data, buf[:]...)
+	}
+	data = append(data, b...)
+	return c.SetBytes(key, data, timeout)
+}
+
+// GetTagged retrieves an item stored with SetWithTags and decodes it into
+// obj, which must be addressable. If the item is not found or if any of
+// its tags has been invalidated after the item was stored, ErrNotFound
+// is returned.
+func (c *Cache) GetTagged(key string, obj interface{}) error {
+	data, err := c.GetBytes(key)
+	if err != nil {
+		return err
+	}
+	tags, versions, payload, err := decodeTaggedData(data)
+	if err != nil {
+		derr := &cacheError{
+			op:  "decoding tagged data",
+			key: key,
+			err: err,
+		}
+		c.error(derr)
+		return derr
+	}
+	if len(tags) > 0 {
+		current, err := c.tagVersions(tags, false)
+		if err != nil {
+			return err
+		}
+		for ii, v := range versions {
+			if current[ii] != v {
+				c.debugf("Tagged item %s invalidated by tag %s", key, tags[ii])
+				c.Delete(key)
+				return ErrNotFound
+			}
+		}
+	}
+	return c.decode(key, payload, obj)
+}
+
+// InvalidateTags invalidates all the items associated with any
+// of the given tags.
+func (c *Cache) InvalidateTags(tags ...string) error {
+	if profile.On && profile.Profiling() {
+		defer profile.Start(cache).Note("INVALIDATE TAGS", strings.Join(tags, ", ")).End()
+	}
+	version := time.Now().UnixNano()
+	for _, v := range tags {
+		if err := c.setTagVersion(v, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Cache) setTagVersion(tag string, version int64) error {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(version))
+	return c.SetBytes(tagKeyPrefix+tag, buf[:], 0)
+}
+
+// tagVersions returns the current versions for the given tags. If create
+// is true, tags without a version are assigned one. Otherwise, their version
+// is returned as 0.
+func (c *Cache) tagVersions(tags []string, create bool) ([]int64, error) {
+	versions := make([]int64, len(tags))
+	if len(tags) == 0 {
+		return versions, nil
+	}
+	keys := make([]string, len(tags))
+	for ii, v := range tags {
+		keys[ii] = tagKeyPrefix + v
+	}
+	data, err := c.getMultiBytes(keys)
+	if err != nil {
+		return nil, err
+	}
+	var now int64
+	for ii, v := range keys {
+		if b := data[v]; len(b) == 8 {
+			versions[ii] = int64(binary.BigEndian.Uint64(b))
+			continue
+		}
+		if create {
+			if now == 0 {
+				now = time.Now().UnixNano()
+			}
+			if err := c.setTagVersion(tags[ii], now); err != nil {
+				return nil, err
+			}
+			versions[ii] = now
+		}
+	}
+	return versions, nil
+}
+
+// getMultiBytes returns the raw data for the given keys, after
+// passing it trough decodeData. Keys not found in the cache
+// are not present in the returned map.
+func (c *Cache) getMultiBytes(keys []string) (map[string][]byte, error) {
+	qkeys := keys
+	if c.prefixLen > 0 {
+		qkeys = make([]string, len(keys))
+		for ii, v := range keys {
+			qkeys[ii] = c.backendKey(v)
+		}
+	}
+	data, err := c.driver.GetMulti(qkeys)
+	if err != nil {
+		gerr := &cacheError{
+			op:  "getting multiple keys",
+			key: strings.Join(keys, ", "),
+			err: err,
+		}
+		c.error(gerr)
+		return nil, gerr
+	}
+	results := make(map[string][]byte, len(data))
+	for ii, k := range keys {
+		b := data[qkeys[ii]]
+		if b == nil {
+			continue
+		}
+		if b, err = c.decodeData(k, b); err != nil {
+			return nil, err
+		}
+		results[k] = b
+	}
+	return results, nil
+}
+
+func decodeTaggedData(data []byte) ([]string, []int64, []byte, error) {
+	if len(data) < 2 {
+		return nil, nil, nil, errInvalidTaggedData
+	}
+	count := int(binary.BigEndian.Uint16(data))
+	data = data[2:]
+	tags := make([]string, count)
+	versions := make([]int64, count)
+	for ii := 0; ii < count; ii++ {
+		if len(data) < 2 {
+			return nil, nil, nil, errInvalidTaggedData
+		}
+		n := int(binary.BigEndian.Uint16(data))
+		data = data[2:]
+		if len(data) < n+8 {
+			return nil, nil, nil, errInvalidTaggedData
+		}
+		tags[ii] = string(data[:n])
+		versions[ii] = int64(binary.BigEndian.Uint64(data[n:]))
+		data = data[n+8:]
+	}
+	return tags, versions, data, nil
+}
@@ -0,0 +1,279 @@
+package cache
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"sync"
+
+	"gnd.la/config"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	defaultCompressor  = "flate"
+	defaultMinCompress = 100
+
+	dataStored     = 0
+	dataCompressed = 1
+)
+
+var (
+	compressorsMu sync.RWMutex
+	compressors   = map[string]*Compressor{
+		"flate": &Compressor{
+			NewWriter: func(w io.Writer, level int) (io.WriteCloser, error) {
+				return flate.NewWriter(w, level)
+			},
+			NewReader: func(r io.Reader) (io.ReadCloser, error) {
+				return flate.NewReader(r), nil
+			},
+		},
+		"gzip": &Compressor{
+			NewWriter: func(w io.Writer, level int) (io.WriteCloser, error) {
+				return gzip.NewWriterLevel(w, level)
+			},
+			NewReader: func(r io.Reader) (io.ReadCloser, error) {
+				return gzip.NewReader(r)
+			},
+		},
+		"zlib": &Compressor{
+			NewWriter: func(w io.Writer, level int) (io.WriteCloser, error) {
+				return zlib.NewWriterLevel(w, level)
+			},
+			NewReader: func(r io.Reader) (io.ReadCloser, error) {
+				return zlib.NewReader(r)
+			},
+		},
+		"snappy": &Compressor{
+			// snappy has no compression levels
+			NewWriter: func(w io.Writer, level int) (io.WriteCloser, error) {
+				return snappy.NewBufferedWriter(w), nil
+			},
+			NewReader: func(r io.Reader) (io.ReadCloser, error) {
+				return ioutil.NopCloser(snappy.NewReader(r)), nil
+			},
+		},
+		"zstd": &Compressor{
+			NewWriter: func(w io.Writer, level int) (io.WriteCloser, error) {
+				if level < 0 {
+					return zstd.NewWriter(w)
+				}
+				return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+			},
+			NewReader: func(r io.Reader) (io.ReadCloser, error) {
+				d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+				if err != nil {
+					return nil, err
+				}
+				return d.IOReadCloser(), nil
+			},
+		},
+	}
+	errInvalidCompressedData = errors.New("invalid compressed data, was it stored with compression enabled?")
+	errInvalidEncryptedData  = errors.New("invalid encrypted data, was it stored with the same encryption key?")
+)
+
+// Compressor represents a compression algorithm which can be used to
+// transparently compress the cached data. See RegisterCompressor.
+type Compressor struct {
+	// NewWriter returns a io.WriteCloser which compresses the data
+	// written to it using the given level and writes it to w. Level
+	// is -1 when no level has been specified in the configuration.
+	NewWriter func(w io.Writer, level int) (io.WriteCloser, error)
+	// NewReader returns a io.ReadCloser which decompresses the data
+	// read from r.
+	NewReader func(r io.Reader) (io.ReadCloser, error)
+}
+
+// RegisterCompressor registers a new Compressor with the given name, which
+// can then be used in the cache configuration (e.g. #compress=lz4).
+// The compressors "flate", "gzip", "zlib", "snappy" and "zstd" are always
+// available. If there's already a Compressor with the same name, it will
+// panic.
+func RegisterCompressor(name string, c *Compressor) {
+	compressorsMu.Lock()
+	defer compressorsMu.Unlock()
+	if _, ok := compressors[name]; ok {
+		panic(fmt.Errorf("there's already a cache compressor named %q", name))
+	}
+	compressors[name] = c
+}
+
+type compression struct {
+	compressor *Compressor
+	min        int
+	level      int
+}
+
+func newCompression(conf *config.URL) (*compression, error) {
+	name := conf.Fragment.Get("compress")
+	minCompress := conf.Fragment.Get("min_compress")
+	level := conf.Fragment.Get("compress_level")
+	if name == "" && minCompress == "" && level == "" {
+		return nil, nil
+	}
+	if name == "" {
+		name = defaultCompressor
+	}
+	compressorsMu.RLock()
+	compressor := compressors[name]
+	compressorsMu.RUnlock()
+	if compressor == nil {
+		return nil, fmt.Errorf("unknown compressor %q, maybe you forgot to register it?", name)
+	}
+	c := &compression{
+		compressor: compressor,
+		min:        defaultMinCompress,
+		level:      -1,
+	}
+	if minCompress != "" {
+		val, err := strconv.Atoi(minCompress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid min_compress %q: %s", minCompress, err)
+		}
+		c.min = val
+	}
+	if level != "" {
+		val, err := strconv.Atoi(level)
+		if err != nil {
+			return nil, fmt.Errorf("invalid compress_level %q: %s", level, err)
+		}
+		c.level = val
+	}
+	return c, nil
+}
+
+// encode compresses the data if it's at least c.min bytes long and
+// compressing makes it smaller. A byte indicating if the data is
+// compressed is prepended to the output.
+func (c *compression) encode(data []byte) ([]byte, error) {
+	if len(data) >= c.min {
+		var buf bytes.Buffer
+		buf.WriteByte(dataCompressed)
+		w, err := c.compressor.NewWriter(&buf, c.level)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		if buf.Len() < len(data)+1 {
+			return buf.Bytes(), nil
+		}
+	}
+	out := make([]byte, len(data)+1)
+	out[0] = dataStored
+	copy(out[1:], data)
+	return out, nil
+}
+
+func (c *compression) decode(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, errInvalidCompressedData
+	}
+	switch data[0] {
+	case dataStored:
+		return data[1:], nil
+	case dataCompressed:
+		r, err := c.compressor.NewReader(bytes.NewReader(data[1:]))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return ioutil.ReadAll(r)
+	}
+	return nil, errInvalidCompressedData
+}
+
+// newEncryption returns an AES-256-GCM cipher using a key derived
+// from the encrypt option, or nil if the option is not present.
+func newEncryption(conf *config.URL) (cipher.AEAD, error) {
+	key := conf.Fragment.Get("encrypt")
+	if key == "" {
+		return nil, nil
+	}
+	sum := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encodeData transforms the data before storing it into the cache,
+// by passing it trough the pipe, the compression and the encryption
+// in that order.
+func (c *Cache) encodeData(key string, data []byte) ([]byte, error) {
+	var err error
+	if c.pipe != nil {
+		if data, err = c.pipe.Encode(data); err != nil {
+			return nil, c.transformError("encoding data with pipe", key, err)
+		}
+	}
+	if c.compression != nil {
+		if data, err = c.compression.encode(data); err != nil {
+			return nil, c.transformError("compressing data", key, err)
+		}
+	}
+	if c.aead != nil {
+		nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(data)+c.aead.Overhead())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return nil, c.transformError("encrypting data", key, err)
+		}
+		// Use the key as additional data, so encrypted
+		// values can't be swapped between keys.
+		data = c.aead.Seal(nonce, nonce, data, []byte(key))
+	}
+	return data, nil
+}
+
+// decodeData performs the inverse transformation of encodeData.
+func (c *Cache) decodeData(key string, data []byte) ([]byte, error) {
+	var err error
+	if c.aead != nil {
+		ns := c.aead.NonceSize()
+		if len(data) < ns {
+			return nil, c.transformError("decrypting data", key, errInvalidEncryptedData)
+		}
+		if data, err = c.aead.Open(nil, data[:ns], data[ns:], []byte(key)); err != nil {
+			return nil, c.transformError("decrypting data", key, errInvalidEncryptedData)
+		}
+	}
+	if c.compression != nil {
+		if data, err = c.compression.decode(data); err != nil {
+			return nil, c.transformError("decompressing data", key, err)
+		}
+	}
+	if c.pipe != nil {
+		if data, err = c.pipe.Decode(data); err != nil {
+			return nil, c.transformError("decoding data with pipe", key, err)
+		}
+	}
+	return data, nil
+}
+
+func (c *Cache) transformError(op string, key string, err error) error {
+	terr := &cacheError{
+		op:  op,
+		key: key,
+		err: err,
+	}
+	c.error(terr)
+	return terr
+}
@@ -5,6 +5,8 @@ import (
 	"go/build"
 	"os"
 	"os/exec"
+	"path"
+	"path/filepath"
 	"reflect"
 	"strings"
 )
@@ -16,7 +18,24 @@ Before building a package, gondola build checks that all its dependencies
 exist and automatically downloads the missing ones.
 
 The -go option can be used to set the go command that will be called. All the
-remaining options are passed to go build unchanged.`
+remaining options are passed to go build unchanged.
+
+The -debug-file option builds a stripped binary and writes the unstripped
+one to {binary}.debug, adding a link to it to the stripped binary when
+objcopy is available. Gondola uses the debug file for formatting the stack
+traces when it's deployed alongside the binary.
+
+The -dist option builds a self-contained artifact for deployment into the
+given directory, which must be used with a single main package. The binary
+is stamped with the version and release (which default to the output of
+git describe and the current git commit), available at runtime from the
+app configuration. Then, the files listed in -include are copied next to
+the binary, the templates are precompiled into {dist}/template-cache
+(set template-cache-dir = template-cache in app.conf to use them) and the
+assets in {dist}/assets are fingerprinted and, unless -no-compress is
+used, precompressed with gzip, writing an asset manifest which is used
+by the app to serve them. The -os and -arch options can be used to cross
+compile the binary, while -archive also writes {dist}.tar.gz.`
 )
 
 type buildOptions struct {
@@ -30,9 +49,21 @@ type buildOptions struct {
 	GcFlags    string `name:"gcflags" help:"Arguments to pass on each 5g, 6g, or 8g compiler invocation"`
 	LDFlags    string `name:"ldflags" help:"Arguments to pass on each 5l, 6l, or 8l linker invocation"`
 	Tags       string `help:"A list of build tags to consider satisfied during the build"`
+	DebugFile  bool   `name:"debug-file" help:"Build a stripped binary and write its symbol tables to {binary}.debug"`
+	Dist       string `help:"Build a self-contained artifact for deployment into the given directory"`
+	Include    string `help:"Comma separated files and directories copied into the -dist directory, missing ones are skipped"`
+	Version    string `help:"Version stamped into the binary when using -dist, defaults to git describe"`
+	Release    string `help:"Release stamped into the binary when using -dist, defaults to the current git commit"`
+	OS         string `name:"os" help:"Target operating system when using -dist, for cross compiling"`
+	Arch       string `name:"arch" help:"Target architecture when using -dist, for cross compiling"`
+	NoCompress bool   `name:"no-compress" help:"Don't precompress the assets when using -dist"`
+	Archive    bool   `help:"Also write the -dist directory to {dist}.tar.gz"`
 }
 
-func runGoBuild(pkg string, opts *buildOptions) error {
+// runGoBuild runs go build for pkg. Any additional arguments
+// (e.g. -o) are passed before the package, while env, if non-empty,
+// is appended to the environment of the go command.
+func runGoBuild(pkg string, opts *buildOptions, env []string, extra ...string) error {
 	args := []string{"build"}
 	if opts.Race {
 		args = append(args, "-race")
@@ -51,14 +82,22 @@ func runGoBuild(pkg string, opts *buildOptions) error {
 			args = append(args, "-"+strings.ToLower(field), s)
 		}
 	}
+	args = append(args, extra...)
 	if pkg != "" && pkg != "." {
 		args = append(args, pkg)
 	}
 	cmd := exec.Command(opts.Go, args...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
 	if opts.Print || opts.Verbose {
-		fmt.Printf("running %s %s\n", opts.Go, strings.Join(args, " "))
+		var prefix string
+		if len(env) > 0 {
+			prefix = strings.Join(env, " ") + " "
+		}
+		fmt.Printf("running %s%s %s\n", prefix, opts.Go, strings.Join(args, " "))
 	}
 	return cmd.Run()
 }
@@ -147,14 +186,83 @@ func buildCommand(args []string, opts *buildOptions) error {
 	if opts.Go == "" {
 		opts.Go = "go"
 	}
+	if opts.Dist != "" && len(args) > 1 {
+		return fmt.Errorf("-dist can only be used with a single package")
+	}
 	cache := make(map[string]error)
 	for _, v := range args {
 		if err := checkImports(v, opts, cache); err != nil {
 			return fmt.Errorf("error getting %s dependencies: %s", v, err)
 		}
-		if err := runGoBuild(v, opts); err != nil {
+		if opts.Dist != "" {
+			return buildDist(v, opts, cache)
+		}
+		if err := runGoBuild(v, opts, nil); err != nil {
 			return fmt.Errorf("error building %s: %s", v, err)
 		}
+		if opts.DebugFile {
+			if err := buildStripped(v, opts); err != nil {
+				return fmt.Errorf("error building %s stripped binary: %s", v, err)
+			}
+		}
 	}
 	return nil
 }
+
+// buildStripped moves the binary already built for pkg to
+// {binary}.debug and builds it again with its symbol tables
+// stripped, adding a .gnu_debuglink when objcopy is available.
+func buildStripped(pkg string, opts *buildOptions) error {
+	p, err := importPackage(pkg, opts)
+	if err != nil {
+		return err
+	}
+	if p.Name != "main" {
+		return fmt.Errorf("%s is not a main package", pkg)
+	}
+	binary, err := binaryName(p, build.Default.GOOS)
+	if err != nil {
+		return err
+	}
+	debugFile := binary + ".debug"
+	if err := os.Rename(binary, debugFile); err != nil {
+		return err
+	}
+	stripped := *opts
+	stripped.LDFlags = strings.TrimSpace(opts.LDFlags + " -s")
+	if err := runGoBuild(pkg, &stripped, nil); err != nil {
+		return err
+	}
+	objcopy, err := exec.LookPath("objcopy")
+	if err != nil {
+		fmt.Printf("objcopy not found, %s won't have a link to %s\n", binary, debugFile)
+		return nil
+	}
+	args := []string{"--add-gnu-debuglink=" + debugFile, binary}
+	if opts.Print || opts.Verbose {
+		fmt.Printf("running %s %s\n", objcopy, strings.Join(args, " "))
+	}
+	cmd := exec.Command(objcopy, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// binaryName returns the name of the binary built by
+// go build for the main package p and the given GOOS.
+func binaryName(p *build.Package, goos string) (string, error) {
+	// go build names the binary after the last
+	// element of the import path
+	binary := path.Base(p.ImportPath)
+	if binary == "." || binary == "/" {
+		dir, err := filepath.Abs(p.Dir)
+		if err != nil {
+			return "", err
+		}
+		binary = filepath.Base(dir)
+	}
+	if goos == "windows" {
+		binary += ".exe"
+	}
+	return binary, nil
+}
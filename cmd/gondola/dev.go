@@ -134,6 +134,7 @@ func NewProject(dir string, config string) *Project {
 	a.Logger = nil
 	a.SetTemplatesFS(devAssets)
 	a.Handle("/_gondola_dev_server_status", p.StatusHandler)
+	a.Handle("^"+regexp.QuoteMeta(devOverlayScript)+"$", p.OverlayHandler)
 	a.Handle("/", p.Handler)
 	p.App = a
 	return p
@@ -158,6 +159,17 @@ type Project struct {
 	watcher      *fsnotify.Watcher
 	built        time.Time
 	started      time.Time
+	// listener is kept open while the project is rebuilt
+	// and passed to each new process, so requests are queued
+	// rather than refused while the project restarts. It's nil
+	// when the platform does not support inheriting sockets.
+	listener *os.File
+	// reloaded is the last time a template or asset changed,
+	// while stylesOnly indicates if only stylesheets changed.
+	reloaded    time.Time
+	stylesOnly  bool
+	changed     []string
+	reloadTimer *time.Timer
 	// runtime info
 	out      bytes.Buffer
 	runError error
@@ -264,6 +276,14 @@ func (p *Project) StartMonitoring() error {
 			return err
 		}
 	}
+	if runtime.GOOS != "darwin" {
+		// Already watched recursively on darwin
+		for _, v := range p.reloadDirs() {
+			if err := watchDir(watcher, v); err != nil {
+				log.Warningf("Error watching %s, browsers won't reload automatically: %s", v, err)
+			}
+		}
+	}
 	watcher.Watch(p.configPath)
 	p.watcher = watcher
 	go func() {
@@ -302,6 +322,12 @@ func (p *Project) StartMonitoring() error {
 					}
 					break
 				}
+				if p.isReloadFile(ev.Name) {
+					// Templates and assets are reloaded by the app
+					// itself, we only need to reload the browsers.
+					p.fileChanged(ev.Name)
+					break
+				}
 				if isSource(ev.Name) {
 					if t != nil {
 						t.Stop()
@@ -348,6 +374,11 @@ func (p *Project) ProjectCmd() *exec.Cmd {
 	cmd.Env = append(cmd.Env, os.Environ()...)
 	cmd.Env = append(cmd.Env, "GONDOLA_DEV_SERVER=1")
 	cmd.Env = append(cmd.Env, "GONDOLA_FORCE_TTY=1")
+	if p.listener != nil {
+		// Becomes fd 3 in the child, see gnd.la/app.App.ListenAndServe
+		cmd.ExtraFiles = []*os.File{p.listener}
+		cmd.Env = append(cmd.Env, "GONDOLA_LISTEN_FD=3")
+	}
 	if p.profile {
 		cmd.Env = append(cmd.Env, "GONDOLA_NO_CACHE_LAYER=1")
 	}
@@ -361,7 +392,9 @@ func (p *Project) Start() error {
 }
 
 func (p *Project) startLocked() error {
-	p.port = randomFreePort()
+	if p.listener == nil {
+		p.port = randomFreePort()
+	}
 	cmd := p.ProjectCmd()
 	log.Infof("Starting %s (%s)", p.Name(), cmdString(cmd))
 	p.cmd = cmd
@@ -395,7 +428,7 @@ func (p *Project) projectStarted() {
 		panic(err)
 	}
 	p.proxyChecked = false
-	p.proxy = httputil.NewSingleHostReverseProxy(u)
+	p.proxy = p.newProxy(u)
 	p.started = time.Now().UTC()
 }
 
@@ -610,6 +643,8 @@ func (p *Project) Handler(ctx *app.Context) {
 }
 
 func (p *Project) StatusHandler(ctx *app.Context) {
+	p.Lock()
+	defer p.Unlock()
 	built := formatTime(p.built)
 	started := formatTime(p.started)
 	if p.proxy == nil {
@@ -620,9 +655,23 @@ func (p *Project) StatusHandler(ctx *app.Context) {
 		}
 		started = "1"
 	}
+	// Used by the overlay, which shows the errors on top
+	// of the current page rather than reloading it.
+	var errs []map[string]interface{}
+	for _, v := range p.errors {
+		errs = append(errs, map[string]interface{}{
+			"location": v.Location(),
+			"error":    v.Error,
+		})
+	}
 	ctx.WriteJSON(map[string]interface{}{
-		"built":   built,
-		"started": started,
+		"built":    built,
+		"started":  started,
+		"building": p.buildCmd != nil,
+		"exited":   p.runError != nil,
+		"errors":   errs,
+		"reloaded": formatTime(p.reloaded),
+		"styles":   p.stylesOnly,
 	})
 }
 
@@ -701,7 +750,17 @@ func devCommand(opts *devOptions) error {
 	}
 	log.Infof("Using config file %s", configPath)
 	p := NewProject(path, configPath)
-	p.port = opts.Port
+	p.App.Config().Port = opts.Port
+	if runtime.GOOS != "windows" {
+		// Windows can't pass sockets to child processes,
+		// so a new port is used for every restart.
+		listener, port, err := listenerFile()
+		if err != nil {
+			return err
+		}
+		p.listener = listener
+		p.port = port
+	}
 	p.tags = opts.Tags
 	p.race = opts.Race
 	p.noDebug = opts.NoDebug
@@ -713,7 +772,7 @@ func devCommand(opts *devOptions) error {
 	if runtime.GOOS == "windows" {
 		eof = "Z"
 	}
-	log.Infof("Starting Gondola development server on port %d (press Control+%s to exit)", p.port, eof)
+	log.Infof("Starting Gondola development server on port %d (press Control+%s to exit)", opts.Port, eof)
 	if !opts.NoBrowser {
 		time.AfterFunc(time.Second, func() {
 			host := "localhost"
@@ -726,7 +785,7 @@ func devCommand(opts *devOptions) error {
 					}
 				}
 			}
-			browser.Open(fmt.Sprintf("http://%s:%d", host, p.port))
+			browser.Open(fmt.Sprintf("http://%s:%d", host, opts.Port))
 		})
 	}
 	p.Listen()
@@ -4,4 +4,4 @@ import "gnd.la/internal/vfsutil"
 
 // AUTOMATICALLY GENERATED WITH gondola bake -dir ../../app/_assets -name devAssets -o dev_assets.go -- DO NOT EDIT!
 
-var devAssets = vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec\xbd\xef\x9e\xdb6\xb2 :\x9f\xfd\x14\xb4r2C\xba%\xb5\xa4n\xff\xebv\xdb?\xc7vNr\xd7q\xb2v6;\x99v\x1f\xff(\x91R\xb3\x9b\x12e\x92\xea\x96<\xf1\xf9r\x9fi\xbf\xddO\xfb\x00\xf7\xbeҭ?\x00\b\x80 \xa5\xb6\xe3\xcc\xcc\xee\xce9q\x8b@\xa1P(\x14\n\x05\xa0P\x18\x87E\xdc?/\xe7韾\xdc\xff\x06\xc3\x01\xfe\xefO\x83\x81\xf3\xef\xdd\xc3\xc3\xc3?\rG\a\xf7\xee\x8e\x0e\x86\xf7\xee\r\x01~88<\xf8\x937\xf8\xd3\x1f\xf0\xbfUQ\x869\x90\xf2ٍ\xb4\x1a\xf7/\xf2\xbf\xbf\xff}\xff\xce-\xcfK\x16\x93t\x15\xc5G\xde|\xb5\xee_\x14\xb7\xee\xec\u007f\xfcx\xeb\xd1\xed\xe7?>\xfb\xf9ן^x( \x8fo=\x92\u007f\xe20\x82?eR\xa6\xf1\xe3\xbf\xff\xdd+\xe3\xf92\r\xcb\xd8\xebPR\xc7\xeb\u007f\xfc\xf8h\x9f\xb3o=*\xcaM\x1a{\xe5f\x19\x9ft\xcax]\xeeO\x8a\xa2\xf3\xf8\xd6\xfe\x1d\xc0Z.\x8f\xf6\xf7\xe7\xf1&ί\xe3q\u007f\x92\xcd\xf7\xe3<\x99\xec\x97Y\x96\x16\b\xb7\x9f\xc7E\\\xee\x03\x81\xdeը?\xf0~\xf3F(\x1c\xc3\xd1=Lz\x99L\xe2E\x01D/\xb2E\xec\xf9\xcb\xd58M&^\x94\xcd\xc3d\x11@\x13n\xddB\x82\xbb\xde8\x8b6]/J\xae\xba^\xb1\f\x17]/\\.Ӹ\xecz\xd9\xf8\"\x9e\xc0\xdfd\x9a\x87\xf3\xb8{\xeb|\xd8\xf5\xceG\xf0\xdf\x01\xfcw\b\xff݅\xff\xeeu\xbd%\xe0H\xb3\xc9\xe5\xfbUV\xc6\xf0\x99\x03l\bh\xc6\xe3\x1c\xfe\x9d\xe4\xd9b3\x87\x1fQ\x04\xe4\x16\x00\x9b̺\xde$A\xd0I\x16\x01l\x14\x03\x15\xd1\x14j\x8e\x01.\x99Cv\xb2\x00\xc0\xcbq\xd4\xf5\xde\x03U\xf0\xff\xe1|ٽU\xcc\xc3\x14@\x8b2O.c\xfa\x9b-\x00\xb8X\x8d\xf1\x1f \xa3\x04j\xaf¼{\v\x12V\x80\x05j\x88\x17e\f\t\x11V\x01\xb9\x11\xa0\xcc\xe0\xf7\n\xfeK\x93\xee\xadi\x12\xa7Q\x81\xad\x9df9Ԟ\x86c\xa4&\x8dg\xf1\"\xea\xde*\xc3q\x8at\x86\xcb2ɀ\xc0\x92\x99UN\xb3\f\x8a\x94\xd8\xd5\xf0'ǟ\xf0\x1f\x14\b\xf32\x99`\x91\xb0H\"*\xb9\xb8\n\xa1\x01Q\\\x86IZ`\x13\xc7q\x84\xf5\xceV\xc0'\x0f\xfe*\xe4\x88\x14h\xf5\x10+\xfd\x9d\xe5\x19\xb4\xea\xd6<^@c\x16!tP\xb6*\x97+\xa89_\x8d\x81\x8c\x02\xba\x87J\x16\xab\xf9<\xcc7@o\x02\x1d\xe5\xc1\xefK\xa0`\x15%\x19\xf0\x03\xe8ȼ\xbf\x83D@\xf2,Y\x1cy\x83c\xf8XB\u007f$\x8b\x99\xf8\x1ag9T)>\xa6٢\xec\x15\xc9\a\x10\x1dPM_ˤ#\xe8\x95s\x10\xc0\x12\x13\xaeblh\x98\xf6\xc24\x99\x01NT\x94i\xb2\x88\x8fo}D\xd9\xfd\xee\xe7\x1f^\xde\x05\x99*@\xf27\xbd<\x03\x11'YE.\x03\xff\xa1.o\x9cg\xd7E\x9c\x17\x1eH\xa2\xcd5\xc5-\x83=̲[\rl\xf246\t\xc6P\xab\x05\x11G,\xa3H\x1f\xf6!e!\xc1\xbd\xf38\x99\x9dC㆘Ţ!2\v\xe0\x02\x8eN\x1eATR\x13\xf3\xf7\x04E\x1f\x85\v\xe2h\x1cCcc}l\x1c\x85S\x12\xc6\xf7*\xef='\x11\xaa\t\xf08F6\xff\xe5/\xc7\xfa\xa7DM\xb2H\x90\xdc[\xbdI\x96\xa6\xe1\x12G\xb8\xfcUue\x0f\x86\xf2D\xf6/uɋE\xe4eS\xd1\r\xc0s\xc5\x05\xea\xeei8OR`\xd2/q\x1e\x85\x8b\xd0\x16\x83{\xcb5\xe1\x0e'\x97\xc8\xedEt\xe4}\xf5\xfc\x19\xfe\x1fb?\x1fV\x88\xae\x05;\xc7\xd0\xcb\x16\x96\x83\x01ca9썳\xb2\xcc\xe6(c\x98\fX\x0e*,\\`$r\xfaq\x9eg\xcc$\x83\x82o\xbf}6x\xf6\rA\\\x87\xf9\x02\x9a\xeb\x82\xf9\xf6\xe9\xb7ă~\xb2\x98f\x0e\x80g\xf8\u007f\x04\x80\xea\xc8\x010\xf8\xf6\xc1\xb7\xdf*\x80\xfe|\x95\x96\xc9Ѣ<\xefMΓ4\xf2\xb3(\nZ\xebeA%\x105\xeaF\x82\x17\xa2\xb7\x14/\x96k\xaf\xc8\xd2$\xd2\xd9\xdb/V\x93\t\xa8\xcez%Ͽ\xfdv\xf0\xfc\x01\u0080\xd2\xd5E#\x0f\xa3d\x05by\x97k\xb1\xc7\x00JW\x9a\xc1x\xff\xeaٷϿy\xf1\xac\x1a\xe2\vЂa\xea\rG\xcb\xf5\xfe\b\xfe\xf1~\xc8\x16\xe1$\xeb\u009f\fE*\xf6n'\xf3e\x96\x97\xe1\xa24%\xa2'Q\x8e\xa6\xf8\u007fVf2\x0fgУ \x1e\xe3ˤ\xec\xe5\xf12\x0eK\xe0D\x0f\xc7`\x98\xf7fH1\x88\xbb_f0\x94\xbf\x02\x9b\xc7\x03\x0e\x89_HHW\"\xb6\xbe\x0e\x0f\x97렡\xb2y\xf6Ꮺ\xa9\xf8\x83*\xca\xfe\x98z\xbe@%Մ\xe3\r\xef\xea\x8a\x00\xa4>\x9e\xf3̓\xc1\xb42M\xb3\xeb#\x98\xbc\xca\f嚦|[/\f\x87Mb\x8d\x16\x94\x9c\x92x\xea7ꑚf\xe8Ux\x15\x92d\xc1\x13X58\xeeݻwlΛ<\xd0\xfa\x93U\x9e\x03\xf6\xfa\x80|\xf0\rZ\xb7:\x0e\xa9^j\x15\xf5\x14\xd1\xd7IT\x9eWs\xad\x9c\x90\x90\x8f[\x187\xf0z\x9c\xc4t\xa1ťk\x01]\xabD\xf0;\x8etz\xa0\x11\x05\x92\xb8\xcc\x12f\x14\x98\xb4\xfb4\xe1\x81Y\xba/\xacX\x9c%\x1e\xdf\x02+\x16\x18\v\x16\x91\x87v\xef>'\x02\f\x19\xbc\u007f\xfa_\xf8\u007f\xe3\x15hx`\xfe\x97\\\x03nY\xff\x8d\x0e\xef\x0e\xec\xf5\xdf\xfd\xd1\xe0\xff\xac\xff\xfe\xc0\xf5\x1f\v\u007f\xc1f.ɂ\xbe(\xcc\xe34\v\xa3>\xaf\xa6\x8ae\xb2`\bZ$\xc2ȉ\xe2)\fw\xb5\xfa\xfb\xf8\xf1\x1b!T\x1ed\xf6_\xc1\xd2\n\xd2\xfa\xfd>\x0e21\xc2`1\xe6MҰ(N:\xc2x\x10&\x00\xac\f=\xef\xd1\xf9\xf0q\x03\x0e\x18\x93C\x02A\f4\x96\x11\x83\xd0'\x03T\u007f0܋\x04\x8dc\xa2\x1bt\xfcU|LXE\xa1$:\xe9`\x1b\x16q\xdey\xfch\x1f\x92\b\x1f\xff\x90\u007fH{>~\x85&\xad\xf7\xf3yR\x80\x8e\x9a\xc5\x1e\xfcE\xb5=\x0fie\x90n\x04c@\xebd\v0\x1c4J=\x94\xaa\xb2\x00r\x19\xd3-c\x99\xacX\bKebG1ɓe\xa9/\x92/BXUQ*\xd0\x0e+>/[\x96\x85w\xa2\xacz\xe8\xaa!\xacR\xf7\xf7\x81\xbc\xd8[\xac`ѕ\xa3\xf5Ky^\x99yQ\x1e^#l\xbc\x98\xa1\xee\x1d\r\x140'!p\x1cNΩ\x84\xae\xa3+8\xec\xd4\xf2<\x99\\\x02\xca\x02@\xa4\xcduP\xc1p\x12₥\xa2GL\x85\x95o\x0eK\x1d\x9a!r\xf8FJ\t\xfe\x19}z4\x93 F\xcf\x1f\xf4\xfb\xc3\x00\x11ge\x88\x9c\xd6\xf0b\n.p\xb2\xe9\x14\xccy\x9a\\r^\xf3HtC\x98\x00q\x82\xb9N\nXg\xf4\xf0\x130\x83\x9eW\xa9\xd5$\xf5\x97\xaf`H\xfe\x85\x8a}\x95\xcf\xc6\x1e\xd8\xda_\xe5\xf9l6\xa6\x9fa\x9e\x87\x1bl\x03AcK\x8be\x1cG\xb2\xa2\xd7H0H\x00\ni\f\x8b\x96\b\xa7\xe1\x1c\x96oG\xde=\xa6\xf8).qf0\xad#\x10\xce\xca ,\x88\xe5<\x8cp\xaa/\xf3ULp\xff\xfd<\x066\xe5\xd8=0\xbb\xa2ԇ\x02\bg\xc5\xeb\x10F@\xea\x06_\x15\xb1w\x1e\xe6\x11\xac\x02`\x16D\xb88'\x06a\x1bq\x1c\xa1\xdcA;\x85`\xffE1\xf2ٛ7\f\x80X\xe0\x0f\x18\x0e\xf8\v{K\xc0\x02\x86\x0f\xdf\x031k\x10\x92\xf8\xa1*\xf8\xa1\x97`\xa2\xe7\xc3\xe8\x0eaA@\bF\x03\xf9?\xec6\xb0\x94\xa0ʻ\x83\xafEu\xd9R\x8d=5\xf4\xb0\xd42D[\x82\x84qZ\x8a\x12X\xe0%|\xb6\x96\xf8xL\xa2\x0fCi\x06K\xba\x13\x98\xe2'+X\x04\x97}\xf8|\x91\xc6\xf8\xf3\x9b\xcd\xf7\x91\xaf\x9a\x1dp\x01\xf1\t%\x16\xf1\xb5\xf7\x86\xbf|\x1cAA\x1f\xf3|\xc6\b\xd00>i\x90Y\x03TSxb\x88\xfe\xaf:\xffG\a\xfd\xabQ\xff\xa2\xf8\xd3?j\xff\xf7\xfe\xe8\x10\xfe\xb3\xe6\xff\xc1\xe1\xe1\xe8\xff\xcc\xff\u007f\xc4\xff\xfc\xe9jAj\xd5\xe7%\xbe\xfc\xf4\xa2\x83w\xa47\xfcI\x99\xe5\xb8\xe7\x99-qG,.\x18\x0eU\xe0F\xfc\xf2h\xd3\xcbǑw\x19o`\x16p@\xe3\xff~\xa4\xed\xd6>\x9b\v?1Ȇ\xf0\xf7\xa1@\x99\xe1\xf4\xd7E\x14]\xad\x90\xe7]\x85\xe9\nt[\x85\xf3\x14@κ\x1aD\f\xf3\x1f\xe8\xc31\xeedMÔ4?\xff\xef#\xad\xcc\xe8\x17\xfd\xfd\xe8M\xc2\x12&=?\xae\b3\t\x00\xa5Q\xd5ą\xb1\xe8G\x8b74g<˖\x1b\u007fYī(\xa3o\x89\x139\x91\x00\xa2\x1eL \vDX\x81\xf4y\xf6\xed\x8aI\xe7\xc4;=\xe3J\xaeϓ4\xf6\xfc\xbd\xbd\xc4{\xe4-\x02\xce\xef/WŹ^\xc3ir&Z\x94\xc7\xe5*_0\xd8q\x13\x81o\xd2d\x12\xbb(\x14\xa5\x9fr%\xb2\xed\xfd\x02\xe1\xfbh\xdb\x18\x85\\\xf8\u007f\b\x97(2vr\x12\x81RN\xa0[#\xab\xaaȅ\x04\xac\x8b·\x001\xcd\t\v\xd3b\r\x16\xd2\\\xb0\xf4;\xcb\xfd+\x1b\x1e\x1a\t\xf3\xfc\x95wrr\xe2ud\x89\x8e\xf7\x04\x92\x8e<k(h宄 \xb8\xea\xca\xe31̔bJ\x01\xf38[\x81\t\x80\x9b\xb5\xe5yf3\xc1Q\x03\xca\nI8\xc8\x02\x97\xe9\xe3\xf1\xc4Ɨ\x88\x00/Mz\x85\x12e\xd5\xf5\"C\xc8\x14\xb4B̔G\x8c\xb1\x85h6\x18\xfd\xb5E\xdfڻ\rs\xe6*M\xbd?\xffٻ\x9d\x80U\xf1\xca_;\xbb\xffC\xb2|I\xb5\xd6;Z\x90\xe3*U,\xd3\x04V\xe9y\xbd\x10\xf4\bU\xec*%7\u007f\xfd\xc2*U\xf4\xcb<\x99\xfbA?\x8fa\xe2\x06I\xdf\u007f[\xec\xedϺ^\xc7\xeb8\xa9\xce\xc3\xc5,~\x87\xbb\x01\xb38\u007f\x03r\x1eW, \xf5\x05\x9d04\xc6\xe3ڻ\x03\xa9_{\xc3\x00\xfe܁܁1\xfc.]\xb5\xe0>\bj\x19\xd7\xf8\x90y\xef\xe2+\xdcl\x92\x9f\x92\b\x05\xca٦\x98|\x00\xeap\xd3>F\xab\xbakh\x98\x0fJ\xaf\xa4RJl\x85\x92L=?EP\xd0!\xfd\f\x12R!h8\xe0Z\xc4L\x92X\xe9B\xa6FQBJ\xac\xab\xbe\xbf\xd9\xd0\x1a\x88m/V\x14\\\x94\x9a\x045C\x96\x1a\a\v<wSE\xcd\xe6\xa6Zs\x19)\xda}T$\x80\xd6K2\xb1]\xb5\xa1\xf0\xc8\x1b\x05\xb2\x01$\xcc)T\xac\x97H\xf5\x89)e\xaa\xa4\xfc\x89D\xad}\xea7kH\u007f\xc0ܯ\x92\xc9V\xfeq\nh\x83>,\x11`\x92\xf1\xed2\x89\xb7\aR\x14\xd4+\x10m\xcb\xe3yv\x15s\xf3\xccIKP\xac\x98Ta\xa6\xe9\xc1\xc2S\b\x1e\xe9\x93(\xae\x9a$\x98D\x1dl\xe9hCΩ\uf71a\x16\xb7\xd3\xcbwKX\x9c%\x05\xf6\xe8\x1al\x05k\x98.\xbd\x1e\x0e\xa4'\xde\x10X\xf0CX\x9e\xf7\xa7i\x06ڙ~\xa6\xd9\f\xf2D\xfa2\xbb\xf6q\x05\xda\b\x18x\xfb\x9c\xf1\xf2\xd5p\x10\x00\xdee`%\xc1\xaa-h!\x14g\x80缢qh\xbf=\xaf\xd3i)L\xe7p>\xe9V{\xaa\xa7\xc4>\x18L\xe5\xf7B\x14:\xfd\x0e\x88\xe9\x14\xf2\x12\xef\xf1\x897\xc0I\x86\x80\x8a\xd5\x18\x0fx\x173?Ar}\xad\xb8\x18\xc3\x1d,Y\u058d\x03@\xe4A\x13K\xee\xf7\x1a6\xafw\xe2\x1d\xa0d\xeey\a\x81i&\x94 ^W 10\x83\xf6/2X\x01u\xba\x9d\x00\xe0\xa6ͭ\xfd)\a;m\xedG\x80\xd0V\x91F_\xd1G8.\xfc\a\xd0\x1d\x00z\a*7\xea\x96bX\xa0\xbe=Bnx\x0f\x80\x19J\aD\xfaPT\xf3\xc1>\xebV6ڎ\xb6A\xdfѠ\xa5eXl\xe6\xe3\f\xd6\xd3Q\xf34\x18\x87E\x8cf\xee|\xe9O\x9b\xa6\xea\xb2f\r\x94\xde#\xee\xcf\x01\x10Vb\xe7\x0eI\xb8\x81J\xbf\f\x8e\xb7T';\xe2\x06\x15\x0e\x81\xb3S\x1f\xff\xdd\x05\xfd4\x05+\xfb&\xe8\xfbw\x81\u007f>\xb4\n\u007fA\xc7\xf8#\xf8.Q6GT1\xfe\xcbI[kW\xe6_i[^\x8dE\x96\x19\xccA\xf1\xee\xd4*\xe1\x03c+\xdeJP\x91,j\xb4 #\t\xcb$+\xa0\xd9w\xf8\xe3\xa7\xefA\xe6FA#\xa6x\xbd\xacaR\xb4\x8c@g\r\x98\x8b=\xa9\xe5\xdd\xc2F\x9bc\xcd$\x15\xef\xf3\x92{\x9a\x18\xdeL\r\xaa\x9ad⇚\xba\xa5\xad\x0ffH\U000e4e04\xf1\xdb?\xbc\xdb\n6\xac\x98\x1eV\x16\x11\x8c(\\\xcb\x00\x97\x0e\x05\u05fd\x18\xd6Z*\x95\xc4F\xb02\x90?C\xec\x80!䆦Rh\x95\xf6=\xa8\xf6N\x9d\xb9\xbdR\xa1E\xac\xc4\xeb\x02\x93FF\x1f.\xb7\n\x05\x9ehU\xc6$2\xe16|a;\x86\xfd\xfb\x83\xe1\xdd\a\xc7;k\x82;\xf4\x9f\xef\x174\xbb\xd3'\x12\xb5\x95\x82\fR\xeab\x80c\x10\x995\xea\xdfǑx\xbf\u007f\xf7\xde讨\xa3$e\x03}\xe8\xc8GV \xf1wE\x1e\x13\xb2\xe7!\x98(\xa5\xf2\xea\xe5F\xfdQ\xad\xe0\xc3\x03*Z\x83\xbc\xe7\x00}p\b\xc0Φ\xa2\xd5\xf0,\x84\x96\xa6ʔ\x8d\x0e\xfal\b\x16e\xb6\xc4M\x80p\x16\xf2\x92\xe8\xd8\xcc_\xe6\xf4WN\xd6Ac\x05oh\xa1\xe4\xeb\xa3\x00\xcdO\x89\xa8+Ǆ\x98D\xb1\x9baڤB/\x10  \xf0´w\x1ak{.\x8d{\xb1\x87\xa8\xd5*\r\xa8\xca\xf6\x95)l.\x0e\xd8V\xb7\a\x9c{\xfd/\x8b\x9e*p\xb0\xdbϨ]\x8d\x8b\b\xc1A\xf1\xd9Ϧ\xba\xa9\rf\xfe\x87\xca\xcc\xffP\x93e\x05\x18\x0f\xf5)V\xdf\xe6Q\xec\x1d \x0f\x87:\x135\x86\x1f\xeb;3þڽ\xe5\x1fz\xae,A،\f\xd9x,\x0f\x16\xdbY\xb5T1\xdaP\x95\xf9\xe8M\x93\x05\x9d\xc9\xfc\xbd\xa1\x82\x81\x06,M\x05\x97\xa9\xab\x9b\xc1\xf5=\x88pQ\xa0y\xe4\xcf\xf5\x8eϑ\x1f\xa7\u07bc\x0f\xdax\xde\x1f{\xb0\x1aʇ\"i\x82I\x11&]\xae\xb9\xf7\x14\x92W䊒|\x88\xfd|\x00\x06\xdf\xe5\a+\xffyVB\x0e\xe2\xc2\xdcMci=\xf5Y6\x1f'\v\xc0\b\vC,ۻ\xfc\x00\x16\xf2o\xbfy\x83J\xe3\xe7\x83\xd3\xc1\x19\x8c\xde|x:<\x03i\x83\xbf\xfc=\x80oM.\x18\f\x17\x99\xc7U\xd2\xd0J\x82V\x99\xdf\x1f\xf4o\xe64\xae0\xfb|\xc8\x03\x8d\xf0/q-\xc0\xb3C\x19.F>a\xedr}huhY=\xa2\xadˤ\x06\xa8r\f\x06ų<\x96\xbbtT\te\xa5\\\x0fr\x1f7b\xfaS\xefL\x03!3\x94\xb2/\xd7\xc4V#\xf3\x12\x06\xee\t\xa6\x1a\x14^~@Ȇ\xea=\xe1U\xd8(,؏ \x19cKه\xcc\xf51\xfe\x81\x19\x8f8\v_óVdU\xb7\x87N\xb3\x9c\f\bG\xed\xa14\xdeP\x02.\xb5\t\x1e\xab\xdf?\xa9\xecg\"\xa4J\xf8\xb8u\xbb\xa3&{\xd8V\xe0\x97\xac\x83j\xd8;\xc1\xfd\x13j\xec\xf1-U\x8dJ\x1d\x9e\x99\xbb\x9a\xaezȉ\x03\xacE\xe8]^\xea\xf2Z\xd9d\xea\x82\xf6\x1eN\xbc\x8e\xa2\nw\xf7@\x0fh\xa5\u007f\x96Y\xd0sf\x8e\xab\xda\xd5B\x03x\xc5\xfbfzo\x8e\x81\xf3c\\ᢩ\xb4\a\x9d\xf2\x84\xa6p\x1f\xd3\xc2@\nG]ͮk\x1a\x18\xd6\xc1T\x04\x18\xd2b?\x18\xf4<\xa3\xb5˗!\x87\xc4i\x1e\xae}\xb9ț\xa3)\xd7\xd5\xc9l]\x10<\xc3\xd3T\xd7\x1eX>\x1b\xfbyכՇ\x84\x986_k\x00.̯\xeb\bX\xc5@\x93sm4\xcf\xe0{\xa6}\x13o\x9c\xfb\x82\xb3\xf1\xbb\xf3x]\xdb/\xbeB{\xec\x1e\xb0\xb03\xe8\xc8-\t\xc1\x91\xab\xa0_fox\xe5=\xbc\xa7\xd4\x16\xf2ht\xf7n-\xbf\xa9\xd6eHkAZq\x83\x9a\x9b\xc1\xc09/Rcra\xcba\xc6\u007f\xc6\xfcg\x0e\xfd0\x1fuIܹ\x81s\x9cp\xf6\xfdӰ\xf7\xe1l/x\xeb\xfb\xfd;\xc1\xdb`?\xe9\xc7\xebx\"*\xd04\xc0\\\x9b\xe4\xe7#\xdc{\x1e\x9e\x8e\xce\xfa\xb4AK\x9b\x03\xca\xe6\xbfN\xe8\xc0d>4&\ao\x02f\xac\xd7\x01Z;Gj^է^\xc1A\x00\xf0\xa9\x8dߦYX\xfa\xf3\x11\xea\xf8\xaeg&!\xe2}t\x0f\xb33F2#\xa8Oނ\x02`Y;\x05(m:\xb3\xc5\x10\x96\x94\xb8\xb3\x86\xcdY@\x93\x83\x9a\x8f\x9a\xb2D\xfe\xb2\x1e\x92(\xf0\xab\xa0\xddK\xd1\x11\x81N݂\xb6\xff\xb83\xfb3\xf1w\xacu\x16\x17\xd2\xf7\xe69\xa5?9\x0f\xf3\xa7\xa5?\b\xf8@\xe3\xabN\xd5AU9\xb9\xaeC\x90Cc\xf7\x04\xcdC\x03\xd1PkY\x8e\xda9\xaf\xbeg5\xe8Q\xa0\xe7\uea71&Ր\t}\x10\xe8\xb9{'Rɩu\xa4\x9b\xe2\xfb\r\x14W\xfb^0\x14\x0e\x02'\x9d\x15\xccA\u05fb\x1b8\xa9\xab``\xd0ޯ\xed\xbcb\x8d$\x00߃\xa1\r}$\x86\xb2\xacHe͌\xac\xb1\x9e5ֲ\x8c\xd94ߢ\xe6H+\xc1\xf8\xb1T\x1d*\x05P3r\xc2G\x8d\x93\xe3\x84Mjg\xa6~\x8dů\x00\xafK\xac\x154h/\x89\xaf\x8b\xc7.\x94\xd9C\x84\xa0y\xe8*\n\xee\xbb\xfb\x98\xba\x87\xa98\x00G\x95(j\xdbo\xb41.7\x8dp\xc3N/t\xc4)\xb8u\xa4*\b\xf4\r\xf8\x1cgi\xc8\n<\\/\xf93\x00\x19c]\x11`\x80\xafG@\xff\x13\xef\x1e\xceW\xc1q%\x1f3\xb3\x14Nj\xb9,5\x12p\x94\x93C\xceL\xe6\x1cʊ\xcf\xd16\xbd70\xb6/\xf4\xe6`с\xa3\xa7\xa03\xb0#\x04\u007f\x1a;+\r\xeb\x13[\xa5\x04֛\x0f~.x03\x93g\"yl&K\x1d\x80=.\x16\x0f\x90L\xd5\xf8\xfd\xc3\xe1\xe8\xf0\xee\xbdC\xb4\xdbq\x15~p\xf7\xfe\xdd\xfb\xf7\x86\xf09\xc3\xcf\xe1\x83\x01\xae\xcai\x92F.\x1c`\xa9w\u007f\x85>\xdf؈F\xc3ѽ\xfb\xa3\x87\x12\xd1\xfd\xe1\xdd\xe1\xdd\xd1H\"\x1a\xdc\x1f\rkx~\x05<\x1fl<\x83\xe1Ã\x83\x03\x85g8|8|\xa8\xd0<\xbc;8\x18\x1c\x0e-<\u007f37\x858\xd5\x1f\u0094{\a\xe8\xec\xc1\xb8\x81q;\xa0\x8d5\x94\xa1\rT;\xe2O\xcc\xfd\x104\xf6\x04\xf3ڜ\xcc\xd5(\tp\xff\xb6?8\x1c\x1c\xa2\xe4\xe68Ì\xfa@\xeaQ\xb5\xe5\xe4S\x13\x06\b\v\xb9\xf8\x03j\xee\x1f\xb6O\xe3b\x8e\x98\xe8\x03u*5\x01\xcfi\x13\xb3\xc1\x13\xa9\x1c'R\xe5\xe1\xee!+\xf3\xaf;r\rB\x1es\xfe\x147\xba\xfaHё{\xdb^\x97P\xa7Y\xf5\xdd\x16\x11\xfe\xae\x8e\x80\xcc&\x1c\x15\xe7\xfa\"\xa9\xda.\xa1o:\xa8k\xa0\x88\x8c=\v\xa9\x02\xb9\xf2\xcf\xcd\t\xeb\xdc{\xec\x1d\xdc\x1b\xe0\xe8Ƴ\f\x18\xa9\xd5\xd0ǍI\xca\xd9\xe3\x1c\xa3\xd8#\x0fK\x89\xe6\xceq\a\x11\xa6j\xd4:\xb4'w\x0e\x9dX/\x01C\xa4*2\xb2sG\x87-\b}ȅ\x8f\xf3\xc0@\xac\xa0u\rR5\xd6h\xadncs\xffR\xf6\x1d\x92O\xfb\xa8\x95\xad=N\xa5\xce\xf0\xbe\xaeX\xd0\xc8\x1b\xea&\xcc\x10'\x15\x050W\x1eT\x88\xeeK\x85\n\x97 \xa9\x06\"\x8eB\xc98Li\xc4\xe0hI\xb1\xf5Ȏ\" x\xf8\x01\x8c\xc0\xd4B\xb3Cqاȭ\x91=\xbeQ\x1c\x90\x13\xb8]:\xc2]\x0fb\v\xffA\xe1\x874\xa7l\x9eOH6'\xcd\u00ad\x018\x85\xbb\x8e\xc0)\xdc\x13\xf8\x9e\xec$ܓ\x94\xb4\x95\x9b*\xa1\xca\xd2nu\xd2@\x93O\xb5MM\xe2w\a˪\x1dm\x12\x80\x89\x93|\x81,l\\3\xbd\xd4\x00\\\b^\xd6\x11\x98\xed\x13߸l\fwY3\xa1\xfe\xc6\u07b4\x90\xa2\xbc\xe2\x14\xe3\xa3l\xe0\xc2\bZ\x8aj\x1c\xe7\xaf\r\xed\xec\xef\xa3J\xe7\xf9\x03\xb5\xf8\x18\r\x8c\x81\x90Y1\xcb!nT\xe1k\xb1\xe5Bs\x17ClL\x88\x8d\x06\xf1+C|0!>h\x10\u007fs\x89\xa3\x98\xc4\xf0\xe7A\u007f\x84\xb3\xc2!\xca/N8\xc3\xfe݃\xfbÃ\aw\xc5|\xd4?|\xf8\xe0\xee\xc1\x10'\xdd\x0f\xbc@\x90%{\xfd\x87\xf7\x1e\x8e\xeeݣ\x82\xd0\xf0\xfe\x83\xfb\xf7\x06\xc3\xc1\x03*\x88S\xc9\xe1\xf0\xee\xdd{\xf5r8\xb5\xdc;<8\x14\x15\xf6G0-\x8d\xee>\x14\xc5p\xe6\xb9?\x1a\xdd\x1dQ\xc1&\xc1\xa0\xc1\xe1\x16\x0e1r\xb4\xad\xac1n\x05\xc9#s\x10\xc4;(\x88]m\xef\b\x8f^B\xa8{Lsu\xd3p\xd2z\xc8:C\u007f\x8c\x8d\xb8\xf7\xe0\xe1\xc1\x00Z\xf5\xc4[S\xcb\xf0\xbf#\x9e\xc4\x0f\xb1\xbf\x1f\"\t\xf7\xfb\xf7\x1f\xdc\x1f\x1c\xdcw\xe1\x97f\x85\x13\xff`\xf0\xe0\xc1\xdd{rz\xc4\xf9zݥ]\x8e\x83\x80\xce,\x18\xad\xe8\n\xae\xaf\xa9\x0e\xb2\xc1]\xa7z\xac\x94A\x1b\xa3\xc2\xcb\xd9b@\x13\x06\xb5#Y\vh\xe6\x1c\xb1m\xa0\x1fV\xe5]q\x82s\x88\xee\tdB8\x19X\xc4)\xfb\xb3\xfb\xe4UPT\xe7#\xc2uo5f\xffK\xce\xee\x1ae~\x92\x1e{\xa69\xc1\xa0\xad\xb5\x89_Y\xee\xcb\x1f\xdb]\xd4*Y\xe22\xaah\x97TD\xdb\x1eP\xbdާi\xfa\x19U\xeb\xa5oR{X\x96\xb9\xf0\x8a1\xdc7\x144\x02\xbc\x82ŵV7o)\x91W\xceS\xc8Mƫ\xd2p\xcf\xf9\xe8\xc6\xf0\xea\xcd\x16\x1c\x00@+|\xbam*V\xfbi6\t\xd3f\xbcϲE\x81\x17Rm\xccE\\\x9a\xa4\xc9\xe6m\xc5T\xa7R\xc7\xd5H\xe2V\xfcߺ=\x1c\xd7\xcaǥ\xdd\xe7\f\x8d\x99\xb5\xf4\x05\fں\x80\r\xc3&.\xac\xb7Shp\xe0w\xa6\xb1\xa5\x8b\x1b\xe8n\xe1\xb8\xd9\x16\xb5\xb1\xd4_\x14\xfd\xf7\xab0M\xa6\x1b]*\xe5\xce%\xbb\x94\x8a\x1d\xa3'\x1aB\xf8\xa8D\x154\x98\xfc\xc0\x83b\xe1\x1e+\xcaZ.\xb25\x14\x15'\x05\x1a\x99\x00\x9f5\xe0J\xf0\x04\xb0Lh\x1f\xb8\xa4\x03\xe3\xe8\xb5{\xb3\x1fL\x9f\xd7\xf1\xec\xc5z\xe9w\xfc'G\xff\xf1\xdb۷\xc5^\x80;\xb5\xc0\x9e<\xa6\x90\x04\xa2ܞ\x87\x10\x98\xffۿ\x05\x9d\xaeיu\x82\x9djn\xd5\x1a\x02\xc61<+\x17\xf0&\u007fL\xc4{\x9a\x9c\t1k\x19V\xa2\x92\x86\x91%\xbd@w\x95\xdev2\x9c\xb2F})<my\x83\x98\xfcl\x83\xfe<\\\xfa.\x9e\xbd\xaa\xc4\x11I\\H\x1c\xfay\xb7\xe5\x91\xed\x169\xab\xe9ޑ\xcd\U0005deb0vVD\xdb\xeab{\xb6Q\xd4\xdc\xc7$\v\f\xe8bJ\x03k\x04\\7`f\x9fмL\x8a20\xc7\"\xb4\xa6\x1fF\x91 \x04Z\xe2\xf4\xf8D\xd2LT\xafH\xfe&h\x87O\xfax=\xf3\x17\x18Sb+\x18ү0}L\xd8e\xe6\x91\\\xc00e\x16\xb1ئ\xcaQ\xb1\xda\xf0R\xae0\x90]\xc6E\xe9O\xae\x82\xc0\xd8P\xa7\xbat\u007flH\xd8C_k\xf8\xd7h\x84\xe2\t\x98\xa3\x15Y@\xe7\x95P\u007ff\xeb8\xa7\xbe\xa5^\xad\xfd'W:%\x0e:\x94\a8\xc6\x1cA\xef\xef\xc0lԧP\xf2q'\xb3\x06\xaf\xa0\xea:\x02\xaf\xca$Y\x9e\x94\x9b\x9a\xb6 X\x97\x91A\x19B\x1cԽ\x98fK\x83\xc0\x1bM\x02\xc2\x05S\x8b\x81\xa8N\\3\xe6/0\x85\xb74P\x9f\x0f\x1bH_7\x90\xdd0\xd5)6o\x9dь\xe6\u2b88\xce\xd8\xf6~_:\xb8[\xebp\td\xf5y\x04XJn\xf3)\x16?s\xf6\x85,\xdc\xd0\xd1\\R\xf6I+\x86߷C\xeb\xd4W]\xa8\x88Z\xef\xd0O:w\xb6v\x95\xdd\x16\xef\xa8Ơ\xf6\x0e\x8b`\xf9\xfb\n\x06\xbb\x8f?,;Br\xe5\x1dA\xbd{w\xe4\xe1\xdf]\x86\xff4I\xf1:˧/h~@W\xa3\xb8\x10\xccWxv[Rey\xf9,\x9b/\xc3<\xc4\x05\xddD\xfd4<\x1bmw3P\x84\n\x90\rɰ\x98\xc4\v\xbck\xef\x9e\xf2\xf4m\x85j纪7\xc4\x03ɰ/\x99\x87'O\x900V\t\xbb5\x06=\xd4\xe8\xfe\x9f\xbc\xa7\xc0A\xc9Vy}Xe\x8b\xd7<s\x9a\xf2|\x9d\x83\x1cӭ_{d1'D\xbe\xe1\xdcV\xd9\xee\xe4\xc5\xf6R\xd4-H\x11%ԏ\xfe\xbfi\xd3J\xc3(6\x8f\x825\x9a\x9f\xc2\xdc_խ2\x04jߘ\xa1\xe9¿ӥN\xf7h\xabR\xa1\x8f\v\xf4\xb2\x11v\x83\xe4\xfcq\xa3\x17\x9fd\xb2\x18\xfal\xd5 \x96\x9b\xb8\xd4eM\xc7\xe0\xc2\xec\xc3`S\xdc\x19\x8c[\xf7}\x94Ee_\xf2\xa5CmA/\xb7#\xa3\xc8\xd53\x86\xb2\xa9w\x13\xce\xe3Bx\x94\xdd+\xf2\xdei\xf7v\xec}va\xefv\u07bdC\xa5\xb3\xe7q]ha\xd3\xed\xc8D\xbb\xd9Q\xed\xbd\xcbK\x19|w\x94\xafQ\xaa3e\xbc(dZ\x92\xb2n\xd0i$\x13\xa0\xc8$\x0f\xda\a\b\x06kP\x9bA\xc8-\xf4bVcC^\xc0\xbd\x10\xfe!\xe8\xf9B\xb0\xd2膜G\xde\x1c\xfe\xec\xed\x05\xf5{\xbb\x04\xabʜ^\x9c\x19\x1e\xab\x94\xaan\xa7`\xbf\x1e{\xb4|\x80?::\xe1\xfa\xc0\xfdN\x85\xf0\xbe\xaa\"V\x88Y\xd2\xf5.\x02\xd7`\xb9\xc1.\x16E>\xf2\xd5w\xe3時\xe8:\xca7\xec\xa2)\xa8Fg\xb7D۷\x83\xf6`\x84ĤZ]\xb4\xed\xe0U\xc5\xed\xca)\xd0\xc1u\x1c/\n\xe3\xea\\\u05cb+\xf7Zu\xb1\xb0C\xe1Ep\x19\v\x8a\x1b/\v\xa1S\xb9\xe6\x1fJ5\xbc\b\v\xa1\"\x84$$xܟDF\x1a\x12\x8eb\x9b\xccMX\"\xa5~S\x8f\x92ͅO\xeb\xdd;nQuoO\xd7Ǣ\ne(2\xa8\xb1(\x12\x96\x05\xe7T\x17ۘ\n\xebح\x12\x1b\xe5\xd1+\xda\"\x98\xa3\x9a\xe2X\xbd9fI\xe9\t\xae\xb8\xe8I.\xb7[*\x9cL\x9e\x85\b/4lt\xd0rŲ\x8dtr'\xaf\x9c\xb8\x8d)\xf2\x06\x1daH\x06\xe9\x12ָR65I\xacnj\xda\xd5m'\x1b\x1a\x8d\xa2\x94Wa\x05bZ\xa2E\xcd\x16PM\xb7YkmR\x17\x9a\x8a\xd1\x16+0\x06\xea\xf8\xd5}\xc9lr\xd9\x0f'\x14R\xe41\x88\xbdb\x05^<\xf0\x8d\xb5\xaa\x0eZ\x8d\x101\v\xb1\xf0\x81\xa6|\x81D*\xe2(LAmim\xac\xb9\x95}M\x9c\xe6\xd6\xd0u:\xbb\x84\xa8%\x8e\xb4\x8b}\x06\x81\x9aכ\x11Ԡ\xea,bF\xad\"{A~\xbbL@\rK\x96\x05Uwaz\x17\xd5=)\xb2\xe3\xba\x17\xdd\xd0\xe1\x80Wu\x85\x81\xb6\xb5'n\x9f\x9c\xb4\xf7\x05\xe9A<?\x14\xe8\xbc\x1eZZ\x18\xb9`ߋV\x1c\xf4\xa6K76pt\xf9e\xc0S\x94d\xa0\xbe\xc5dlx-x\x9a6\x19/J\x9d\xf6z\x8b3\x9dy&\v>Z\xed\xe1+\u007f6\xaezS<s\xd4}\x1fՄK\xf6\x1eh\xf1־sa\x1a\x98\x00\xf5~\xd2\t\xff\xe8\x1a>\xd9үw\xd6\xed^\x8f\xfa\x8b\x82)\xa9՞0+5\x15\xf2nW!Q\x13[\x1c\x89\x9b\xe2ԟ\xd2V\xa5\x8f\xae\xeaZ\x95\xac\xba\x1a\xa9Ay\x10f\x05\xe5\xe2?\x81E\x0f^r\xf0\x1dt\x8a\xa0Y\xcaT&9\xc4XS\xfa\xce\x166\x16Ҵ\xb8!\xec\x1c\xd7`N\xef\xedU<2V\x04ЮG \x99Bt\x9f\xd8:\xea\xa8\x1aq\x94#xa\r;9\xbe?\xda#r\xab\x81d\xa9x\xdf6\x13i\xc38\xb2\r\x85\xef\xa3f\x03\xa2\xea-#\xeby\xad\xd7\xccl\x91.'\x05[vɴ\x97C\xa1V\xa7\x04\xa0\x01\xae\xae\x85\xd9\xd3\x05\xaf+Z'\x8b:Ɇ\xd4\x1d;\xc1\x1a\x04Q\x02K\x96V\xe3U\x17ʮ)1\x86\xb1\xfb1hbG\x1b'*\xfb\xc3\xd9\x1a\xad!M\xcd0[\xb0-\xca\n\x8eTگ\x8a\x88x{\xab$ĭ\xe7N\aW\xf8\x12\xbay\xf1B\xd9\xe2\xaeƘ\x0f\xb5j\xfe\tlibv\xf3\x15\x0f\xe7I\r\r\xa3w`\x99,ͫ\u007f<ְ#\xf0&\xcfs@\x05=s\xed\xe3\x8d\xfa\xa1\x90S*\xf9~\x15\xcb}31E\x94\x9aN\x97C\xf8\x84\xf0\xf4\xa0l\xbf<\x8f\x8d\x10\x12\x12\x04&\x03\xc8\x15\x93\x14\xfc\x9a\xa60\x89{\x94\xa8V>R\x03\xa8\xb5\xed\x90\x01\x16\xf1\xba\xb4W\xa1\xfa\x80#J\t\xa1\x8f\xee\x04@\x8b\xe6\x8aKp\x8f\xbdѡi\a&ŷ\xc9\")c\x060\x8c\x8dI\x1a\x87\xf9π4[\xf1%!\xc2_r\x82\xbe\xb7ae\xa1'Y\\\xd6\n\"\U000c50a89N+ \xea\xd3+\xdauw;\u07ba \x87\xc7v&\x85\xe87\xab\x0e\x9a\xc33\x99\x8c\xab\xa4\xa3\x1cx\xf2\xe4\xc4!\r\x14\xf0\x1e\x87\xcc\xf7\x8b)\xb2p\xd3(\x1ed\x06\x88\xae\xd6\x19,\xa6K\x85v\xbc\xf9>:\xd5\x04\x01\x94ޙ\xb6+CR\x80\xcew\xe5\x80D\xa1\x12\n\x9a*t\xdaly\xa9\xb1ѓ\xc4+\xafp\xfc\xeeJ\xc9\xc5\xed\f)\xa5\x16\x05>q\x05\x9ag\xa2\xaf\xaf\xcc\xe5\b\xb0\xb9=\xcfVE\xfc\x13F\xd9\xf51\xa8{\x98\xd0\xf6\x9dq\xdcV\\\xa1˳\xca\xedg\xd7\xf0\xef\x9b_\xfe]\x84\xf1\xc3\xc9[eV\"\x0e\xa5\xfa\x93<\x861\f\xa0T\x81\xb9\xe5G\x91}Q8kp\xbe\xb1Ԕ4\xbe\x1b\xaff\x87\x87\x83\a\a\xe4\xf3\b:̿N\x16Qv\xdd/&y\x96\xa6\u007fE2\x8c\x94_\x8d\xe1Í\x00\x9d%\xfc`T<B\x8c\x17\x1c\xe0J\x0f\f9X\x9c_\xcd:\x01\x9ff\xf8\x1d\x19\xdf\x10\x17\xeb\xe1\xb8\xc8\xd2U\x19W\xb9`\x83A\xc6@}c\x84DJ86\xec\xa7I9\xe7V\x9e\x0e\xce\xe0\xffq=\xfd\x06\xda\x1b/\x9e\xfd\xfc\x83o\x8e[\xab\x9d'\xdem\x1fJ\xf7\xa7\xc4a\xf8\xa1\x9b\xb6\xc86\xb1\xdev\x86\x9c\xa9\xa1әA\xbc\xef\xaf\xe965FG\xfd뱕\xb5QY\xbf6\x8a\xac\x8ed\x92b\xfc\xed\x064\x9c\xf9\xabM\xa5\x14\x00\x06\x9d\x87e\x9e\xac\xd5\xf5\xbeJ\x18-\x86\xf5\x93\x85\b\x86b/iO%E]U\xfb\x99\xad\x9f1\x0e\xa9!ˀ\xfc\x1b\xf4\x16K\x16\xb3gD\xe6k\x14\x0e\xd3f;\xad\x1a\x88w\x160\x0e\x1e\xf65\xfc\xae\xf0p>F\xc5\xecV-\x96\xd0 )\x0e`\x8c\xb9\xe9\xbc/\xba\xc82g|6\xba\xfd\xfa\x02\xa3\xfd\xa2\xf8\xd2K)\xfa(E\xa3\x94\x02mb\x0eݼ\xc5eB\x95\xc2\u007f4gu\xf3\xde&\x97\u007fą\x9e@\xa3\x85\x95K\xdfg\xa0\xd3N\xe9gW\x00:)'\n_c\xa0.\x9f~\xda1\xbf0\xadO\x81\xbc\xb8\x19he\xdbi>\x9b\xdaFk5 \xbf\xc1%\x0f!\xde-0X\x13\xb7\x13\x8c\b\xf80\x8c\xe7\x01\xef\x8a&C\xc5\x13\x8d\x19]o=\xa8x\x95 \xfb\xd6C-\x01o9G\xebJ\xbdA\xe6#(\xa2\x19\xac8\x12\x12ďx\x92\xa1\xach}\xac\x03\xac\a\xa2\xa2\xf5PV`\x9c\xb7\xd1&,\x10\x8eS\x1d6f\x8d\xf1D\xa0\x1a\xad\x1eE\xa1\x80\x11\x81\x96֕\xe2\xa9h\x96 \x938I\x01\x97\xeb\b\x96\x81\xdby\xaa\x029ԝ-\x9b\vrp~\xd5\x1d\xd4{\xf8x\x8e2\x13\xbb\x1e\x85艹\x11\xe51!\xb0\x8e\x88\x18\x9d>G\x1a}(*P[\x80`[\xd1\xe5aA\fF\xaa\x11\x18*\xe9z7N8\xad\xeb\x197sQC e\x8c\xa0~i\x97Q8.\x17\xab\x93\x11z\t\x87N+\\<0J\x1a,\xa9f\xbe\x85\x81@\x14\x94x,\x04\xe6Eh[/RS\xdd.\x03f\x14=U\x82\xc9\xf7\u05f5\xcb\f\xf2\x85\x1f\xa2N\xdcT\xa0\xa1IJ\xb9\xd4w;75\xacT\xc8\xdf\x04Ʀ#\x17\xe7v\xe9\xc5\u05fb\xee\xf1V\xe2[I>\x0e/rp\xe2^\xb1\x19\xa2\xe4\xcbA\t1\xfa\x93\b\xa1\x92\xaa&\x81f}Ӫ)`uC\xfdu-\n\xb9z\xcf\xfb\xa6 \x10.WM,ܟ\xd2H*\xa9\x96\xd3\x02\xcdM\x1ai\x8e\xb2\x9b\x13`]\xf8硢\xa3\xbc\t1\xb8\xf5Y\xe8d̝[ݚ6\xfb\x19K\xa8\xe1<oB\xfa-_\xfa\xbd)f.\u058e^L\x0e\x0e\xf7\x04\xf7,hU\xf3\n'\xc5\x1b\xb0h\x92-7\xee\xea\xdc\xcd\xd8Aݻ\x02\xb7\x18$\xb4M'\xaf9b+%u\x85v\xac\xefK\xe4\x0e(\xb0ቦ\x8e\xfcA\xc3\x03\xbf4\x1a\xf1\x93\xa8\xecl\xa5\xe4\x15qY\tn\xb4v\x06\xd7\xe3\xab\b*\bbT\x8f\x828\xb4\xeeQ\xaeqm\xa3\x0eyqj?r\x8e\x13\xf3ʃ\xb0\x01p\xa3\x9d/ެk1\xf5\xd0\x06\xd8\x05\x15\xdb\n.L-\xbe \x96$\xb3\rX\t\xb2\xbe\xc9Ö\xdfI\x83E\xcb\xef\xfcẁ\x921\xd2HO\xfef\x9b6^:9m\x85\x9b$,\xfbX\xb5\xceo<n\xcdш\x98C2\x81\xdc!\x8c\x95]\x87\xd9xadx7\xe0\xba8pl\xe5\xcf(\x01\x0e4\x80\xbb\x8e\xfc\xfbZ\xbe\xb8J\xa7\x9a!\x1b@\xac\xaeR\xf7\xa9@`\xd0T\xb1\xe1Dog\x95l\x16\xc2K}T\xa9\u05ffk \x18!\x82\n\xad<!\xa5\xcc]z\xb4\xa8\xf7\xa66\xde\xc8\xf4\xaaNKw\x90\x88`\x97Jk\n\xb1V3G\x02\xf0;ݾ\x1d\xe4\xa3璊\x1d\b\x13\x81+\x94\xcc\xd0\r\xb0a@n\xe8\xd36\xb5 \x8d\xc9\x1bX{ڰXA\xf7\x18\x90\xbe\xb6\x90\x13?)\xb2\x05\xfav\xe8`T\v\aZ\xa2_ó`\xd7(1\x89\xbf\x82\xd4V\x1f/\xcbx\xfe\xf4\xb6\x9d\n\xb7\x14\xfc+\x1c].w6\xe6{rS\x91v6\x98\x19\x97\x18\xfeJ\xf1\xc8^\x1fUK<\x8e\xe0\x1bTATm\x1b\x8d\xabj\x00\xfbh\x06x\xbb\xc0\xa1\xadE`Z\U00069bf3\xe3.h\x8d\xad:\xefB\x8b=\x92p\xb1z7\xca2\xe2K\x15\xf9\xb8\xadG\xa5\xff\x10\f\x89qR\xf0\x06\x17\xf7\x14^\xaf\xa3\x88N\xbdjgV\xf6?\xd4\xe0\xaf\xf0\x9f]\xa4\x00Ǐ\x9cJ\xe1w\xddE\xbaa5!$\x87c\x00ז\x14K\xda\xea\x87L\xd4\xe6\xedK\x8a:j\x9c\x00\x18\xbd\x00\xb7\x1a\xf2;\xac/\x04z\xc6\xe0\xf3\xbd\t\xa8Vu%P\x8eV'\xaao\xbe\x8b\xad\xf3k\xa1/6\xe1[\x99ΎV{VU\xbc\x82AF\xb7-\xe8v3\rM̦\xa1h5\xb0\xeb\\\xf9\xefLCͤ6E\xb4i\xf2\xb7\x88\xc0\x03\x1f\x81\xe8\xd4\xf0\x03\xe5\xf2\xe8Řo\xd4aI`\xbb_&ι\x92B\x06]\xb8\xa6^ҫ+ڊ\xbc6\xa0\xaf\xcc4\xa5Z+\xef\x88\x199\x11\x19}n\x9e\xcbS;\xc4[\x10\x80)\t\f\xe7\x00r\xe2#O<\x90\x9e\x8bc/О〺\x1f\x1eß\xc7\x1e\x98\x1f\x97\xbd^Pǅ\xb1\x93\r\xafOk\x8b\xb6\xaa\x80ѓǟQ\xc3\x10kxD\x16\xce%fn\xab\xa2\xbdA\x9a\xab\x02VBn\x88\xc7\\\xe24\xc1\x01\xb2\x92^\x87\x16\xe4\x05\xf9\xb1!b\xe9\xf2ȅX\x1f\x02\v\xae\x8e\xbd\vd\x81VPJ\b\x97\x13\x81\xda\xeb\xfe\x89\xd5\t\b\xc2\xed\xbc`[tE\x94\xa1]\x1c\xb5\xa6\xb2\xb8.\b\x8csK\xa8\xfb\xa1R4S\x03܈\v\x88\x16M\x9f^\v\xd8ׇ\x19h#\xa1\xac8V\xb9S\x1aA#\xf91\xdd3\x8b{Ñn+S\x00s\xcc\xd2spX\xa0\x91|\xdc\x10`\xb4)\xe07\x8a\u0094t|\x84\x96\x12\x8eI\x9c)1$\xb5\xb0\xe1\xb0:\x8e\xd0^\x0f\xdfy㵦\x9a\x8a\xa8\x10j-T\x94ΐ\xa0;\xac\x1f[\xe7\xbce\xed\x1e\xbb\x16\xfb\xbe\n\xbfa.\xecZ1.j\x18{\x1a\xca\xc7\neoW\x9c\xd54\b=\xb7^f\x8b\xb8:l\xdbuz\x06\x1cˆ\xf9y\xa9\v6|\xffD\x1aQT\x83\x87-\xd7c\a\x04ޤWP\x9f0\xb1\x8f\xffA3\xfb8h\x9b\x8f\x91\x1d\x9f>\x19\uee3f\xa47\re\xfb\v\xed3\xedTM\xb3QalG:M\v\xbb\x02\xd7^\x94\xabN)6\x9f\u052d\xb2\xb0\xaeI\x95\x94\x98\x14i\xd6X\x9b\x8c\xa3}\xa7\xc3\xee(\xed\r\x1c\xe2m\x8e\xcfR\u007f\x9a\xd9+՟U\xf7\xef\xa9\x03%7\x9a.H\xd5G\xafԋ=\xb5K\xd3[\x93O\xc1\x91\x11\xf5#\u07be\xe6\xc8\xf2\bﱘ\xcb\xce|g\xdd\xc6\xcb(ߧ\xdb\x1f\xe4ɾ\xa6\x80\xca\xfc\x8dN\xe9k\xb9>c\x8b\x06\x94\f\xef\xc9y_\x1bKг\x86+\x97\xf1\xb2\xf0\xd59i\xbctt\x99ب7\x96\xb7\xack\x14\x03\x13ǜʇ\xacj3'\xa9\xf9\x17Vǚ\xd8\x16\xb5 \xa7?߄\x8b\xe8\xcb\x1e\xafhV\xb9\xb8\x89l\xbf\x18T\u007fIm\xad\x16\xf7\xeb\xa4\xed\xa1\xa3\xdb\xdc;\xe7a\xe1\xaf\x13Z[%\x18>Z\xeb\xb3\xc4\xea\xb4ĭ\x91OYZ\xfa\xa5\fA.$^$\x87\u007f\xe8\xf9\x8f\xec\x17\xdd\xfb\x97\t1\x9c\\\xcb#\xb5M]\xdd\x10;\xaa\xae\x1f\x98\xe6\xd3\xd6\x19\x88P\x91S\x8d1\x0f\xa1\x8b\x04?ܽ\x8bu+@u\xcau\x87\x83\xb5\xeek\xb0\xa6p\xe0b\x93\xd6/\xd8\xf3\x81 і\xf1\x959;\xec֏\xe0\xd1p\x94dٌ\xe4\xa1f\x16yDG\xbc~5T\xb2%E\xbc\xa4\xdb\xc0\xc6\xf0\x91-\x80L1P?\xb9_\x98\x99\xbfc\xef`\xe5\r\x9d\xd3\xc5\x03\xd78\xff鳺\xaa\x01\xf8 0\x90S\xa8è\xbaH*\xddeh3L\xf4+:<\x88\xbe\x96=/\x01z\x9e)\x03\xe4\xf9BYm\xd2`K\x80\xa4~\x8f\x82\xd0\x19mw˃\xd5\xcdz\t\xab\x9f)l)S\x14\b\xcd\xee\xde\x10\x14\xb2 0\xd2s.N\x99l\x10\x0f\xea\xcd\xdfQ:\xe8\x90\xea\u007f\x1b\x11\xd16m>KZ\xf8\x98\x87\xeeH\xebh0\xb4z\x03\x16\xf3ХIδ#=Ə\xaf\x0e}\xa6\xa4i8]B\xb7\x93\xd4UR\xf2;+\xa6V{Բ5\xea\x18^\xc8ݾ\xed6\xad\xd8\a\x94S3n\xbf}\x96\x89\xdc`8\xba\xac\xe3&\xf3\xdcm\x91\xbe_\x85\x8b\x12\x8c\x16\x13\xf3\x8e>L\x97ս\\\xeb\xbc\xe3\xbd:\x880oA\x95\xe7\x80\xe9<K#s/T\xd9M\xb8y\xf7>\xd0\xc0N/yˌ\x8e\x02j\xc4^\x82ľ\xff\f\a!\xf6Q\xa7\xa7E\xf1\x89\x82u\x15\f\x1dҎ]\xc6wu Qш\xb1\x8aj\xae\x99U\xf6\x97\xf6\f\x12\x81\x1fZ\xf7\xb4\xc4\x03\xaa\x91v\xb1\xa7\x8f1\x1b|=\xe6¿\x88c\x91\x94\x82\xa2u\xe8\xd8\xfc\xffā\xd70>>\xd1郱}\x00\x19D\xbf\xc9a\xd3hk_\xff9\x1f\xc3H\f\xb7\x01|\t\x87C\\\xa2\xafe\x10\xb8\xd7{\xf5AM\xef\x02\xe9\xe3\x16'*鳩f\x04\x1b\xa6~\x12W\x1b\x82\xa4-\xd6\xea\xa9\xd4\xcf\x1e\f\xa7\xecy:\xf4\x94\n!/Խ\xb5z\xd4\xc5cwTHY\xd7}\x84\xff\xd9E\xfc\xa6\xf2Y\x93\xa8O\x94O5h\xb6L\a\xed\x02\xea8\xb45%\xb0\xa9\xfb\xdf}\xa6.|w\x13{\xe0\x93*v\xf6\xec\xae\xd5\xeeޫM\xfd\xd08\xdf7wi\xf5\n\xba\xe1b\xaf\x00U~\xbd7\xf7LWn\x01Xmx˔c3_u\x87J\xf9\xf4\x91\xd4\xea\x0e+\xf1\xdb\xf3\x96I\xd7G\x8b\xbc\xdf\xd9K\xd2\xc0\xfbE\x1c%U\r\xbbˏ\xdd\xe9.\xb9ѹT\x13\x9d\xabٻ0\x9f|\xbfX\xc4\xf9\xeb0JV\x85\xe3m\xf5\xa4\xcam\xc1\xf1#.d\x1aqdUn\v\x8e7\xb8^y\xba\x98\xe14\\CQ\xa8\xcc\x16\f/\x16QS\xf9Xd5\x95\xc6n\xf2\x97yva\xc6wQ`\x94\xafG\xf2\xd2\xf5d<\x9b\x9b\x8f\xa7{2M\x9c9w~\xe8\x18~FZM>]\xfb)\xd0y ^\xe0\x83\xd6A\xed(\x98\xf6\xad\x04>ጴ\x94\xbbc\xd2CI\xeeW\"\x89\xca>\x8f\xba\xdeT\x048'b\xd1\xd9\x12\x8f]7fڦ1V\xa7\x19g'\x8a\xa7\t\x86<P\x91\x93\xf8\xca:Vy\x9a\x9c\xd5\xe3L0\x91́Soo\xba6J\"|\x17R7\xb5T\xef\xac\xee\x1e\x80\xf5\v|Ry\xe8U\xa9.8\xae\xd5o,Aꁣ\x1c\x88k\xc8T\x9c\x9e\x99\xb1\xd9\xf0\xa4J\xe1'\xae;xXP=\xea\xfe\xd1~KE\n\xda_գ(2\xe5W\xbc\xd5Jܕ7\xdbW\xa6g\x9a\t\xfeR\x9c\x9bj\x00\xff%ޘ>v}\n\x18\"\x84\n\xdf\xe0\xbd/\x9e`\x80\xb2t\x01\xee\xe6\xf3\xa22n\u058e\xf9\x10\xf1\x1a\x1a\x88*\xda|RE\xea\xa6\xfcf\xe7\x8a*\xf6}\x82\xa1\xc1e\xd5\x04\xa4P\xedVu\xc3\xf5\x80w\x9fp=\x00zшXā\u007f\xdeYA\u007f\x82\u058e'\xbaŨ\xb1\xc1\xfcF\x91\xfa^ed9\xc7OzG\xa7N5\xa9\vP\xb0v\xe2K%{7\xe7\x89(\xabv\x14\x14\xaa\xad]b\xa57)\xfb\xbf\xd6'\t\xb1\xaah*\xf1\xab\xa3İ\xb5\xc4K\xe9\xa5@\x1a\xde,+t\x0e+\x8e\x97\r.\xba\x06\x9egi\x86ᡝؚ+\xdd\xf3:\u007f\xeb\xb4!\u007fS\xc6\xcbo\xe2i\x96\xc7\x16jy\x14&\xf6~\f%٥g\xbd9\x89\xb6N\x97!>\x9f\x86\xd7Y\xe9\xb3Ӆ\to\x89{\xabg\xee׆\x97t\xa4Js\xe3/\x00\xeak蒳\x80\xcec:\xdf\x11\x8e\x81\xe5\x1aL%\xa5\xbe\xddְ\xa7S\xdc4\xf9Ǵ\xeb;G\xbb\b\xc7/\x02\xc7'\xb6\xebY\xc8ۄ?.ci=TƃS\xc8\xd4\xe6\xf8\xa1p\xf5t\x8bʑ\xa4\x14\x1fJ\xd5ᾋ\xf39\xc6w\x10\xe8\xd8kmص\xd0\xe3aX\xd7E\xe8ϸ0\x00\xc0\x1a\xb1;5\xd3\x10\xfb]\x1bz\xb0[C\a\r\r\xf5u\xd3E\xc1\x06\xb2\xc5[\x9ay*\xd1\xdb\xfc\x19\x81\u0600}\xbf\x98\xc02@\xb6\xe7Tc\xfaYpC\xde\xd8\\\xc1\xdbJ\x99\x16\x12\xeb\xcbpGV\xfa\xbbu\xb5\x8d\xb8\x14X\xf4\xb0\xb22M\xf3B\xc4\xe9\xc9l\xda\xed\x13φ\xfb\xf3\x9f\xb7\xc3\xec\xe1\x11\x93c\x95\xe5\xe4\x8fm۽_\x85\x91\xadF\x1c\x95(U\xd2\xc1\xd1?\xb0\xb4L\xf5\x89\x9a\x8f\xc3c\x88\xf2\x04@\x81:\xf0\xb5Ҥ\x8aU\x82\\\xc1\xca+ҩ\x86=\xa8\xe2\xbf\xe2\xbd\x12\x1fU\x16F\x92\x11\xafo\x8f\xf8\xbd\x9a=Tb\x9c=\x14\xd9CG\xf6\x92\xbb_~T{j:\xedZ*\x126\xb2/\x85ی~\xacG1+\xf5Fj\x98*\xf4\xcbDÿ\xb7wl7\xf3\x19\xb7c\xc0\xa4\x96\xfc\x9cwվ\x01+\xb2\x92_\x19\xd7\xda\xcdl\xb1\xa0\x057\f\xd8F&(wdn6\xb9*[\x8d%\xa7\xe1.\x11nD\xb1p6\xcf↞\xa1Z\xfb\xe6w\xa3\xde~;֔\"\xba\xb7\xde\xd0\v\x0e\x01\xdb#Z\x9a\xe4k\x8fh\xaa\xe7\xa6\x06qiE\xddG{N\xdcE\x0f6\xaa\x1d=\x12\x8f\x80\x11\x8bf|v\x8c\xce,\x15(\xf9Y,q\x90\r\xad\xd192\x87'\x8dA\x87\xf1\xe0\xb6\b\xfen\x8c\x1b\xb5\x87Ε\xa8W\x00\xf5:*~+\x91\x12kh|*\xcb_\x8eX\x04H\ue0eeJ\x1c\x8aD\x14\x813\xd7\x15\x1c\x89\xad\x8d\xa3߄ERX\x06Sm\x89\xcc\xc7\xec\xbb)J9H\x86nk+1YM\xfb\xfb˄~o\xc4od\xf9rMF\x18\a\xa2\xe0\xff|\xbd\xec\x90l+\x0f!7\x04\xb9\x19t\xd5\u007f\x84\xc3Ӎ9,O\xa6\x1c\xd4qV\xc5{\xd3y\xf0M\xfc!A\xd31$2\xd1wAn\x934vp\xe2\xea\xc2\xe5\xba_\x9c'Sm7\x01RخH*\xdb\x16R75\xb8\x8d\x82\xd3\xeep\xecN\xa7\x18\xdfڛ0\x06\xe5ڔ\xf7\x8f\xa6\xf1\xa6\x160!\xd5\xcc\xdf6A=\xbc\x89\xa0J\x01\xb16\xd5jB\xab\x04r\xa0\x89\xdc\xc0\xb9&8\xd8A@ܬ\xae1\x96\x99U\xad0\xb4\x06=\xcf\xcaC\xdf\xcd\xf6\x03$\xb6R\xbc\xbb\x17\xdaȝ\xc8^/\xf9W\x94\xfcO\x92*\xe7\"[\x8aF\xabT`\xc0,\fzv\xa8\v\x88\x92\x0e\x97h\x1c6p\xd0\xfb\xda[|\x96x\x908\xdeD:\x846\xbc\x89lHaw\v\xc7|Ǧ\xfdk\t\xc8j\x11\xa5q\x9b\x8dQ\x13\v\xf3\x12\xf0\xc2z\x0f\xc5X\x05hs\x9eJ\xe2\xf8MU\xd2⌧~\x9c\xbf\xa2\x8d\x91N\xb3?\xcevRD\x97@\xa1k_\xff\xc4\xdc\xd8_\xba\x86.\x9b\xa3\t\x98D\v\xcd\x10\xe5\x18\x00r+\ue3b4/M+\n=\x12\xf0\xfayIQ\x18\x02\xbd\xf8\xd0.>t\x16ߨ⛶\xa8\xfdMFK[\x0f\x92T;^-\rق\x1ds\x83B\xb6X\xc7L_\x88Q\b\xf0k\xc4_\a\xfcup\xb6U\x97\x18\xf2\a⧢\vi\x9bD\xcfv\x1ev\x18z\xebf\x03uإ\a\xccoPbt\xe3:F7\xae\xe3\xe0\xc6u\x1c`\x1d\xed[}i\xb6\x84\x81I\xb6\xbb\xfd\b\xfbrhX\xc6\xfb\x94\xa2\x19\xd0mx\xf9\xaa\xee\xf3d:\x8d\xf3x1\x89\x8b\xe6\xad\xc4\v\xd7\xd0\xe7Y\x81f\x01{\xc5?4\xd7\x14\xb8\x850\xe7\x01\xd6\xd4.\x87\x9a\xd5B\x0f\xcf\xf1\xae*,i\"\x1cR\x91\x1b\r-@̺\x13|\xf4\xf7\f/\x90\xec\x9b\xcbw\x81/2\xf6(\xe7m\xcc\xfa![de\xb6\x88\xad\xc5X\xcb\x1a,\xe2'\x84\xe9\xb1\xf6\xb9Ir#\xe7+\x05wѬl\x9b\x98\xe4\xe6\x8b\xd4~]\x8b)\xfaI\f?h<\xa6\xd3\xedGx\xf9\xf4\x9e\xaeD\x05\xb3梤\xee\xa6\\\xbb\xde\x1c2 \xc6@\xd1B\xb3\x8f\xf5\xe2F\x0e\xbdb\xa3?\x91l\xde\xe6\xc6G\xd6\x1fZ\x87\xa1\xd8J\x80<\x90\x171\xe9\x99\xe5\xc28\x1b\x15\x14\x17\x88\xd8J\x97M(\xcc\xca>\xba6\x0f\x1a\x16\x17':ӑ\x1c\xc9d\xe5\xc9v\x81\xfd\b\x15\x05bF3\xf2\xd9\xeb-\xa1\x1d\xe53\xda\xe8\x80Q{O>\x04O\xa4ߡ?\xd5Y\xb9\xbdR.ps\x10ߥa`\xfe\xfc\xf4e\xb1\x94\xedֳ\x9c/\xb8\xbf\xda4\xb6Z\xb5\x17z\\\xa8=bÖŔVc6\xefJ\xb1h\xb4\xf9\xf5@\xa8\xba\xed\x90\xcbD\xcdI/Ti\xfa\xd1\x02\xfa\x8dL\xa7E\\\x1e\xeb8Y\t\xe6\xf2\x89k|\xc8>\fL\x88\xa1\x01\x81\x0fهNÎHk\xf6\x17\x89\xc36\x8f\x0f\xca\xff\x82\x1e\x1fCm\xb3\xbf\xeb\xbd4\xa0_\v\xdf\xfbZ\xa1A\xe5\x96o\x14\xef\xfc\xad\xb3\xbb\xdb\xc8\xc0\xf8\x1a\xee\xe4E2\xb0\xdcH\x06\xe4Gb\xa5n(\x95<2\xb1\xc0\t\x06\f\xc5\xcb\xf4uW&\xaf\xe6R\U000118ce\xa8\n\x86T\x01\xa2\xda0\xaa\xcd6T\x9b\x06T\x1bD\x85\xe6ח\xf4r\x19H\xc5C.\xe6\xc0/\x87\xafˆ\xb26\x83v\x87\x17\x89q\xa89\xce\fݞ3\xc3\x1b\xba\xce\fn\xea;3\xb0\x9cg<Cfvq\xaa\x19|I\xaf\x9aAͭf=\xac{ڈ\b\xba\x9b\xe1\x1f\xe4rS\x1f\xc7&\x14\fv<\x06z\xd9qy\xe7\xa0\xd6\xf9T\uf721\xe1%M\xac\xb0\xbd%\x10\xbd\xe1-\xc1\xf5\r>\xad\u0081Q\xe1\x8eu\r?\xb7q\xbb\xb7\xeb\x13\x9d\x8fTU$:\x9b\x1b\xd4\xf7i|\xdc\f\x8c\nw\xack\xf8\xb9\x8d۽]\u007f\x88o\x95\xbb\xea\xff\x8d}\xab\xb6\xe9\x11i\npl\x80Z\x84ٗ\x164{\x06\x80z\x05\xd3\x04\xa3ڼ\xec\xec\xd4\x01\u007f\x88\x13W\xadj+\xdde\xbfMγ<z\x93\xad\xf2\x89\xd3_\x982Z\xcb\xfe\ftǥ\xa3lI\x19\xade\x1b]\x9d\xf3V/g\xa6\xf9\xf3\xfc\x9c\t\xc7gx:Ú`\x96-\xc2\xf4\xa7ʶ\xb4\x91\x9c\x02\x1a\x8c1!\x1f#hCË\f\rYݤn~\x9c\x98\u009c\xe0\xda@\x95ix\x1a\xbaKˊ\x88\xb6NB\xfaպ\x90P\xed\xb0\x96\x11]{\u0560\x1e[hjf\xb1\x99\x8f\xb3\xf4\r\u07bd\xb1\x9asﰽ\xccϠ_\xec2\x9dI\x92OҸ\xd3^\xf2\x19\x01\xf9\x05T\xaa\xaf\xdaT\x18y^\xceC\xae\\\xdf\xff\xf4\xbd\xe9J\xd6\xf9a@g=9\x9e\xfa<U\xbf\xaa4X\n\x0f\xbbC\x8f\xa1z\xbb\x81\xe5\xed\xae\x84\xe1:)\xfe\xaa?\u007f\xaan\u007f\xa8\xb4~X\x96\xb9\xdf)\xe5s\x19\x9d\xae3D\x97l\x04\xc1\xd1\xda\t\xab_s\x8c\xaeNw\x10t\xf4筚h\xf9U\xa7e\xf3\xfb\xd1\xc2\xcc\xd8\bjv\xa3\xe5\xcdj\x1c%WI\x14\xcb`\x1et;E\v\r[\xac\xc6v\xe8@\u052cs\xf4^\xd2#̙\x0e4m\xb1\t͘Ax\xdd[Ta.\xf4t\xe4\xbc\xce\xf19\x86\x1d\xbb\x93\xd0O\xde\u007f\xd9ۛw\xbd\x8b\xaew\xb5}\x15\xa5\x82\xe3͏\xbd^\xef\x82c\x01\xd6\x1f\xfe\xf31Pដ\xb3\xd7\xf3.p\xcf?\xc0筪p\x86\xf6\x9b\x87\xa2\x15\xe2\xddʠ\xfd\xf1\xc1\x8f\xba\xb7N\xaf\x97\x88 \xae\xc7\x1e\xc5D\xf5\x88\xbd\x16\x15{\x92\x8aGU\x10c\x93\xf8&\x12\x1cG\x14\x12\xd4%\x1b\xe3\xf8<\xbcJ\xb2\xfc݇,\x9b?\x8f\xd32\xf4\x8dW\xd4k ɕ\x115\xdd\xce4\xde\t\xea\xe0\xfb@\x9d\xea\x81 \x90\xbe\xea\t\xa0\xab\xa4H0\xeco\xb9\xc1\xc0\xe1\xe7I\x14ŋ\xe6\a\x82\xce\xe3dv^\x1aI\xd7IT\x9e\x1b)\x19\x18\"\xd34\xbb\xee\x11J~ɨ\xa9z\x85\xb03\x1a\f\x06\xcb5\xe4\xe0\x13v~\xd0_\xc2t\xbf(\xf1\xcd|{\xa5\x17kO\x93\xd3\xfb_\xa50\x17\xf4g\xc6\x1dL\x11\x8f*\xfdL\xe1\x1b\x86\xf1\xc1q\v\xa8|\xeb\x98^\xff\xf6\xabx\xf3T\x1b\x17ǧA\xdb*\x91\xaf\x8dM\x10\x8f\xc7\xd1\x16\xb4N\x13\x88\xe2\xfe\xf5y\x1c\xa7\xd4\xe7h\xbb\xf5\xf0\xf2B\x19&)\b\xde]\xd7['\xb2\xb9\xb6\b\xa5\xe1&[\x95\xef\xc6t,\xf9\x13L\x04\x18_\xeb\xd2\xf5\xbc\x0e\xa6\v\x93\xa8\xeb\xc5\x14!\x81\x92\xd8\xd2\xe9z\xe9$d\x15b\xa0|\x19\x87\x05\xbe\xf7?\xcf\x16Oq\v\x1e7SD\xdc(\xc0\x11跐\xf4\xf7u\x94j\xe04|\x80\x15\xf0k\xfb\xc1\x82(~A\x96;\xdd\xd8\xdf\x13C\x8b\x83h\xd4\xee\x17\xb7z]a\xdbj\x15J\xb7\xe5%\xf9-\xf3\xb3\xb3\xf1\xa2\xba%\xcf\f\xc1'Qubv\xdcJ48&\xd9T\xd0;\x8dzO\x842G\xee\xbdQM\xf2%G\xbd^\xd1\x12\x01p[\xbeV-\x1b\xa3\x101\x93\xa8\x1e\xd9\x10\xf18\xa4\xc5RY\x13\xffp\xb4\xb1\x19\xa54\xc3%\xc0V\x06\xb8DF?o\xa5\xa0-\xb4\xe2\x1a\xabUBX\xf9߄8\xf8\v\x87(V\x8cE#n\xbc\x1dl\x8c\x8eq\xaf\x98\x1f\x8c\xb5\xbf\xc4\a\xaaDaH\x1d\x1b\xa9\xc59\xb0%\x12Y\xd5f\x93\xe8\f\x81\xe9D\x94\xd2dY/\x16V\xaa\xcbsV.\xf3\\$8\x84\xaeB\xde\xc2w\x98\xdb&\xf1\xf3<\x9c\xf1˯\xcav\x89\xfa\xd3d\r\xeb\xbd߄[\xee\xb6⨨k\x85\xff,\x9c\x8d\xdb\n\xff\x80\x0f\xb6\xe1\x04\xe0\xac\xfbp\xb7\xe2\xab\xd2Y\xf9\xc1\xb6\xd2O'\x93\xd5|E\x86\x19:\xb1B\x9f\xa7\xcbs\x10\xb8\xc99j6\xe3\xd0c\xb2\xe6}\xc0ɦ:\x9e\xc32}\x86\xad\x12i\xfa\xa5\x9c4\x0e\xa7\xa6\xb9\xb5\x10\x82G\xd9\xf4\xc1v\x14\xfdTv\x94\xb4\xb0&\xdbm\xa5\x89,lx_ \t\x93\xea\xa5z|\x84-Y\xacb\xe3ݽ\x066Lj<\xa8J\xe9\xcd\xdd;\xf1&\xe2w\x05\x00<\xd2\xd2a>\x82\x9fk-{S\xcf\u07b49\x15\xf7\x97\xe6\x13\x8aM\xac\x15\xa4\xc9\xc7\xf9\xf6d\x80\xa1p\x01\x16,=D*\x1f\x86\xb0`7\xed\xb0\x1f\xad06\xc4\x19\xa4\x9bYs\xaaa\xa2H}\xaa\v,NUpϤ\xb0\\\xaa\a\x85\x88\xdeK\xc0\xaa\xb7A\xe5n\x1c\xb9\x1b}\xb0sU(\x9c\x13|\xbfD\xabY\x97Q\x14Z\xf8\xa7\x96\xdf68^\xc2\x14\xff<\x01\xbd\xb0\xe0\x88\xe9\x97\xd6jt4\xd8\x05Û2'\xb1vahS\x0eP\xef\xe4\xd2qˎy\xd3V\xc8qѮ\xbf\xd9V\xe8GT!\xc2\xe7\xb7\xd2$\xbc\x83+\x06vD\x1b\xc2\xdb1\xe5Q\x9c\xcb\xc7\xe3\xf4\x03\xc0Zx\xcc\xea\x94,؊\x94\xf6'\xfe\x16癁R\xbe\x80\xd0\x13\xae\"t\xca48S\x9ad3p9\x0f^\xb0\x87\xddfpz\xa1\xf9\x1a\x18\xbb\xc9m\xb4\xfc\x10\xae\xbfGi\xf7\xc3<\x0f7\xb6/\xcbP=u\x81k\n\x02\x91\xaeh\x97\xac\xec(Ͱ\xbe\xb4\x80\xed\v\\]%\xe6\x90\xf7/\x15\xa6䌼p\x1e{W\xfa\xe8\xc7\n\x13\xed\x05R}|9\xd6U\x17\xdbZ\xf8:\x8eV\x93\xf8\xcdj\xeeڡ\xa3<\xdf*\x01\xb0\xf2\x15\xd46Ĉ\x12_ \xb6\x1d\x00p/\xaa\xe12\xa8(~\x0e\x031\x9b\xe5\xe1\xfc\x9bd\xf1\x06\n\x81\xfa\x91\x0f\xe4]\x85\xe9*v\xdc\xe8t\x94\xfc\x16gFY\xaez\x12@E\xe2fTj\x1fV\x05\xe2\x1e\x91\xafE\xb0#}F-\x86\x83\xe3Z\xc8*\xba\xab\xecU\x8f\xb8\xa0\xe8\xfa\xf2%\x17\xd0\xc0c\xacz\xc1\x01\xdekһ\x16>\x88\xd3\xd359̀n\\\xa3o\x8b\xf9\x00\xcc.\xa4\xf2+8N\xf6\xa1\xff+9\x9a\x88\\zo\x10\x1dK$t{_\xc5y\x98O\xce7\"\xe8p6\xc6\xddɮ\xa7ҕ\x82Q\xafv\xd5@p\x1d\x9c\xe5\xb4Ν@\xcb#P\xa2\xf8\x9b\xaa\x97g\xf5\\\xa8\x8f\x8aմf\x15\x16T\xc0\x85\x01,-\x10\xd7n\x95\x03\xc1\xf7\v<]\xc0\x9d\x9e|e\xc7\xc7\xf7-\x94\xfc\x19\xf8\x913\x92\b\xe7\xeeµg\xa2\xbd\x8e\xc1'Y\xb1\v\x9a_\x90U\x0e\x1c\xc4\xc2]\x10\xbc\xc1\xd0g\x0eo\xcf1\xa3\x009\rwGF=Ak\xa3\xfaH\xed\xcfc\x18ϜiFI\xe6\xc5Vm?Q\xa4+v\xe06\xc0\xe9\x99\x15a\x992\x1d~\x0e\xc6\xd9=\xad\xe9\x8f\xc4ڮ\xab\xbfxAK\xfb#\x8f\xb0T;c\xb5\x90̭Ja\x89*\xaf\x81\x8b\xa1\xe2\xe2x+\x17\x11\xcf\xf7\x8b\"\xb60\x91Y\x8eSC\x9f \xdeU\xaf\x81\xeb)\xb8P\xe2ԱH]\xe61\xcdMf\xaa\x80\x15F\xf7Ą\x1dok%o\x0e\xe8\xd4\xedNCk\xabK<\xa3\x9b\x94E\xad\xe5\xe4\xd8=\xee\xafI\x0e\xd7¡{\f6\n~\x83\xfe\x88rb\r\ueecf\xfb\xb9\x19\x1b\x1d\x8f1\xe0\x9f\x1e\"A?k\xfc\xb5!\x97i\x98Z\xfb\x83\xc1p[s\x931腙\xb9O\xa1`\xc7\x14\xd8sa\xact\xd7?$\xc6[\xef\xb4o\x81\xf5ҏ\xbcK\x00j\xad\xb1\x06\x1bC\u007f\bD@\xefi\xd0a\xe5\x1c\xbeq\xe1\xdeh\xb87:\xee\x8d\v\xf7Fý\xa9pWˑ۾Ts\x04&G\x1e\x9d\xc3B\x9e\xb5\x86S!\"\xab\xbd\t\xb1\xda\x13|\x90\x0f\xf6weS{U\xca\xc6\x06\xd9\xd4@\xd8\xed\x15\xd6j\tm\xaa\xa3a\xc551\x11`O\xbd\b'\xe7\xbe\xd9i\xa8\x82D\xbbB\xb5l\x94Nv\xec5\xd2\v\xa5\xa4\xb0\xf7\x830\r\xb9C\xa5\x93\x1c]E0\x8f\x12\xc6\n_uKt\xdcg\x01ͫ\x84\x8d\xee\xd9\xcaHǆ\x9b,\xa2\x1d\xb9\u05f7\xa33\xd7\xf2\x15\xdb\xf5S\x1a\x8a\xa1\a,і\xab\\\xc1$h*Wi\x14\x1d&4\a\xe8dK\xe9\t\x8eJ\xd3\x0f\xb7\xae\x8e\x8cǁ\x0e\x94\xa1k]\xedmm\x97\xb6\xce7N-\xc8\xec.\xf8\x85w\xb0\xb9ɵ\n\f\xacbT]\xf4\xb6NUt}w\f\xa63\xeew\x8e\x8fɆ\xbeв\x10\x97M\x9fze߭\xa1.\x90\x93v\tO\x91g\x90C\xcc\xca\xe3\xf0\xd2L\xfcx\xcb\xfd\x9bc\xb0\x12\x9e\x13]\xf0\xb4\xb6]j\xac\xc7\xce\xc3G\x9e\xb0m\x17V\x1a\xac\r\xb4\x14dU\xbd\x99[\x1az\xe9n\xa8\xb3Ef;vh\xa1\x8b\xe5\x05>\xbc\x0e}\n\xca\x06{\xf8\x04\u007f\xff\xf9\xcf8\xb8 \x1d\xc6l\xd0<\x1da\xb7\x06\xc2\xe7\xc4\r\x84\x1c1\xa5\x98\xaa\xed\xf5\x8cӱ\xfa3[\xbb\x8d'9*&VRmp6\x9f\xc0}47\xe1|ҡ{<\a\xf0\xb5k\xda\xdf\xf07\x9c\xbe\xd1\xd2\xf3J\xe3\xe8\xafs\xb9\x06\xa0k+m\x82S\x14\xee\xacT\t\x1bJP\xdbV\x93\\\x9fK&0}LhʭN\xda\x11\xc7\x1d´G\xc5\xf1\xf7\xc6|s\x88g\x1edR\xbe]\x8d\xff\xb7E*\x15y\xcb\f\x8d\xcaޘ\x82\xa9\x0e\xc3\"\xd1R\x84\xb2[\xb4\xef\x15W\xb5\x1b\x98\xa38\x8d\xcbخ\xe0\xb8!\x8f\x86a{\x1d?\xcbSu\xaa\x86\x9do\xbb\x9eq2\xa5,_k>\xae\xd8G\xb3\x8f\xda[\xe3\x14-\x97\xb6r\x8c\xdcͱ\xde\x17w\xd4\x16\x02m\xa6\xaa\xe9\xfe\xef\r\x0f}H\b+\x94\xb5\xbds\xdb\xd4PY\x9c\\\x84e{+\x11ia\x969\xfb\xe9\xf6\xe1X\xd9\u007f\x134k\xda\xedE\xed\xa9\xd11\x9dk\x038\xba\x98m\x02\xcb\xdd&\xe4I]a\xc5a#\xad\xc8=iE\xaa\xc3\xcf\x10\x19\x19\xa9+\"\x80\x1b\xbf\xc7z0}$\xab\u007f\x97\xae\x1d\x8d\xd1\x0e\r\xe9\xba\x06\x86\xb7\x8f&\xc2\xeb\xb9\x1aL\xfa\x05\x0f\x02\xa1\xe8\x05Pp\x0f\xa1)\xd6\xfd\x18\a(~\xdc\xf1\x04B\x82\vt\xb4\xfa\xf8>!\xdb\aԉl\x83ل\t\x89JH\x16\xe2\x9aM\xe4\x1e\x83\xc8\xc0\xa7\xa6f\xd4QV\rUX\xb6\xf6\xe9$]\x150\xd1\xfcZ\x939\xb9gJ~K\xacm\x04\x84\xe6{b-\xfa\xe4\v\xf6\x98\xaa\xeanS\x1d\xa2\xf6\xbf6ծ\xc4\\l\x83\xe9\xaf3\xb8\xeb&\xb5G\xf5K~\xe1F\x19\xf4\x85cĴ\x13\xf52\x9e\x96\xb5\x83\xd1vE`Q\x8d\x82mU\xcbWm\x1c\x15\xa9\x11\x89^$G\xdbT\xa3(\xf9\x1a}\x13v\xa6QY\xed\x0e2}\x87R\t\\\xc4r\x95\x8a\xda\x05\x05\xb0ށ\xe22\x8f\xe371,\xfcC\xea?\xe7\n]\x9e\xfd\xe2\x88\x17\xbf\x9f\x80\x00\x1e\xb5\x1e\t\"\xe2/\xd5UZ\xa7\x88\x16\xf6\xa9\xbe>\xc6B\x0e\xa3-T}\xf9ޱ\xba\xe1\x13h|\x13\xe3v\x91\x98\xf8&\xd9\x1cپ;\x13\xf5\xb9\xaa\x91NS\xa1Sn\x17w[\xf5\xdbx\xdbn\xb9\bʸ2c\xefQk\x841\xa9ɶt=\xee\x80Ǩ\x05tsRx\x1fP\xa1\xf6k\x83\xa2Cv\x10p\xea\xf1yV4\xec@\xadi\xf7i\xbd\x8307\xe1P\xf3\xe9\x16\x1c\xcf\xe3x\x197\x91\x11\xc5K\xba}:\xe6_[P\xfd\x92\x14IɁ\t\x85\x94\x84i:\x06K\xa0\xb6\x0f\x83\x9eZ\xa5\x00B\xb3+\xc9V\x85عq\xc8@\xa3P\xddX\xac\f\xc1\x92\x15?\x13\x92\x82GҚ\x13a\xd5\xd3\xcd\x02\xe7yR\xcc4\x892\x16\xc1\xd4PW\x85\xe6\xa5$\x8b\x94VQ\xf3\x14_\x1b8hx\x16i\x9c\xa63\xf7m\xa3\x9c\xe2Qؚ\x88\xa2T\b/\x83s\x11\r}\xd0mQPI\xbd\x1b\xbaz\xab\x04K{\xbd\x04\xbd\"\xb5\xf1\xe6\xe0'+\xa9c\x1d\xa0\x0fMN\x939Z\xc9D\x9a\x999ϢZ\x0e\xb7`O \xee\x8bO\x14\x1ej\x8e\xca\xe0\xef\xed\x06.\x92\xf4Cv\x05\xf6\xad\xf0\xcca\xed\xd1\xe5\x8a\xd4N\xab\xc8D\vK\xfc\xd4[#\x14K\xa5\x89\x8f5m*\x9f<\"J\xc1@$\xa8\xc5j>\x8eq{T\xa1\xe3\x14\xb9{&S\xf5fU1\xffE'QVϑ\xa5\x98\xa4\xb1\x8eݸ\\\xec\xa6\x1c\x9b\xd7-\xecR~SW\xc9\\rK\x12li\x1e\x80\x10S\x93jQ5\xe3k\x8ee0\xb1\xd5Aan\x93?\xb7P4\x0f\x97?\x85\xd1+\x18\x16\x86\xc4[\xc7\x11k1W\xae\xe5)\xc4F$ldB$A\"\x05\x13I\xa0h\xd3\xec\x87_#E\x0eh\xf3\xfd0\xb9\x16Q{\xcd\"\xff\xf4\xe0\x8cW!j\xa3Xf\fdx\x15AU\xf5\x82\x95\b\x19\xa1!\x88\x14\x06Z@T(\xb4\x8f\x91\xe6\xbb\x1d\xd1{\xa2\xda\xfe9\xf6\u007f\xb4\xae\xc2,x\\\xf5\xc0ި\x06\xf4FAZ\xe4F\x1b\xa3\xe0\xc6.X\xef\v\xbd\x1b\x8c\x1e0\x99\xdf\xc6\xf7\xa8\xb8\xf2#\x94\xe8\x04檮7O\xe61^j\xa8MS\b\xb7\xca\xd3\xfaDF\a\xa4e\xbc.9[\"Ж;\x98il\x17K\x95\x8d\x198S\x01n\x14\xe2\"f\xd0\xc0\xfd\xa4\xa8\"\x85\xdf\xed~\x9d]\xfbyv]\u007f\xb8$\xbb\xe6#6\x82\xa2\xf3ŀ/\x99\xaaf\xb6\xa1\xe5\x03I\x93d\xf5\xea\n\xbf\xee\x00\xad\x05#\x81A\x9ex\u007f\xe9\xfc\x05C\xd9\xc0\x17,\xb7\x96\xb4\xce\xdf\u007f\xdbٟu!\xa7\xf3\x17\xbc@\x80\x10G\x04a\xfb\xb9\xe6\xf1O!_\xf9\xc2GJ_ǳ\x17\xeb\xa5\xdfy\x9b\xbf]\xfcvJq\xbc$\xc5x\r\x01\x93\xcf\xf0xy\xd6\xc1;.\xb1z\xa1B+\xfb\x97S\xa2\xc6,\a\xa5\x82n\x95\xf6\x8c5\xad\xfa&G#L|Z\xfa\xf2i \xd5#\xfa!\xb4\xc9\x14\xa4\xff\x1cL\xe58\xb7N\x9cUY衂\ni\xb2\x00\xbdCW\x98MC51\r\tD\x9d\xe1{r\x1f\xbb\x86\xe3\fWgm\u07b8\x9cf\xb2S\x06=\xbd8\xa3(\x02\xd95\xfc\xd2\v\xc8\xe3\xee\xda=j\x9d\f\xc6\xc1\xe5\x1d\x85+\aR\xc3.1\xcf\xd5\rf\xd8\xcc\x04\xbe8\xe2\x0e\x94\xd9el\xde}\xe7\x87\xfbHT\xfaiX\x94\xe4\u0603\xc6\x02I\x9du\x1f\xefŏߚ>\x86q\x96\x9a\xec\x85\x04\xa4$\x84\xf6:\x9a\xf5\xe2Ǘ\xf5VUNL5:\xccʈ\"M\xa0.\x02\xf2\xa8=8\xac\xf70\xdaE\x17\x8e^L\xf6\xf60Ъ\u07b4\xfa^\xbb]O\u2ba7\x11\x9c\\e\xe8\xe4\x01\x8b8O\x04\xb4`\xb4\xed\x87\x02\xf5\xae9\xa1\xb8'#\xfb\bh\xe2\x89.\xabQrl\x9d1L\xa85\xc3\x03\xbb5\xdcu\xba\x9bG{\x1bG̖\xe1 \xa8Si6O\x8b#0Qev\xa9\xfcc]\x84\x88\x92b5.\xca\x1cfL\xff\x02\x9b\x88\xa3\xbeR\x90\x1d\xa1 \xff\x124\xc9\xda\\\x93\xb5x\x1dOX\xfb\x98\xc26w\xc95\x06X2U\x1au\xb3\xa1\xfe\x8e\xb7\xd3\f\x1a\x87\xddE\x9d\x14\xba\xba\\\x93\xd8\xe3[[\xb0;\xe3x\xc0\xc8\x13J\x0fư\xf8\x95\xb3\xda\xc0\xdb\x04\v^h\xe0Ō,\xadtn\x9d\x90\x81\xb5;\xe0S\xd8a\xd6*\xcc\f\xc0o/\x04C+ڃ([\n\xf8\x97tK\xcdU\x18\x0fc\xe9JAiH\xb1V\xa7\x8b\x81\xd8}S\xc4y\x9b\x8e\xb6\xa6\xb8\xe6^\xec\xeda\x8c\xaa\xba#42\x81\xeb\bk\x8c\xabf\xfd\xa2\xa6x\xa7\xb57\x9c\x10\xcce1\x14\x9a\xc9\x00\x9aZ\x18\f0uv\xdc\xcf\xd6\x17\xceӑY\x9c\xbd\xc3\x1b\xe7t\xed\xbc\xa0\xd0\x13\xe8_\xca6H\xc3=Y\xe1\x8fe\xd3\xc4\xc9\xc4tąo\x8e\xffx\xbd\xf8)ϖq^n\xa4SWI\x96\xda\x13\x869\xd5\x12\xcf\x14\xde#\x83\x8a\x96\x8b\xb0\x18\xaf\xee\x1d_]\xf5\xf9\x82\xb3}\xafu.\xee\x88R&Z\x16\xa1\xf9i\xe5\x9a\xf7OqO\xff\x93J\xee\x99%?t\x9a8O\x87\x95\x85\x9fi\xb3\xaa8\x1f\xaer\u007fv2\x93\xf9\x18xu\xd0\xd3L\xb2\x13\xb1\xb6\xd7\xfcm\x1c\x02\x9fb\x83\x00\x8b\xb4>|\xcc\xe32\xdf\xec\x8c\xedY\x96\x8aˬ\x06^\x15\x9a\x1c\aO֟2\xb0\xb8\xf7)\u07bc\b\xab\x18厃T\x932\x8czS#ν\xe8\xafJ\xfd\xbb\x80ߍF\x81=\xf9L*w%\xee%=\x0eBڶ\x85\xa8I\xc6\xcf\xf1\x967\xa2j*.\xb0\xf3.YXy{\xb4\x93\xf4\x03\x8c\xc2\xe4\x8b\xd2%\x91С\"1Kxy\xcf\xe9<P\x96%+\x19\xfe\x98.\x15f\xab\xc6`1;\xae\xbdni\xddOY\xba\x99\xb5\xcb\xc1\xef\xd44Z\xd7\xffq\xad\xa3\xc7\xf5\xcdf\x19\b\x8dvm\x19\xda7c\x125\xf4ˉ\xe6\fL\xdf\xf2i>\xf9\x84 \x1bz\xf1O\x88\xb3\xa1\x17׃\xa7\xf8\xf5\x87-\xb5\x10k\xd6\n\xf4\x1bu\xf0\f\xf3uI\xe7\xd6x\xa6|\xd9\xf5\x9e\xea9\x11^t\x17\x19h\"=ş\x14\xc2\xf6\x1b\xfa5\xe4\xcd#Jި\xe4\r$\u007f\x10ɅJ.6\xc3Z\b\n\x8e\x1cY\x86\x8b\x91\xbf\xa1\xa8\b\xfb\xb2\x858s\x85\x8b\xa2\xab\x83|\xe8j\x87\xe5k\xe1\x1a\x8f\xa7՛\xa0^\xd2;\xab\x87\xc4\xe2+0\x13\xfc\xa7\xc0\u007f.1\xe9r#ލE\xb2'\xf8O\x81\xffP\xe3\xa8)QWyKh!c\"qy\xc8\xfd\x1c%͠\xd5%5t\xcc\x1a\xaa\x88\x93\xc4X\xc1\xe5\x10\x83o\xa8\x83\u007f\\\xb1+OO\xa2d\xc0\x9c\xc3sf\xfa\r\x04\xeaa;\xe4\xcb\xc4\xf8?{?\xc1|BS#\x9dE\xb3!j\r{\"\r$}T\tE^\xe20\xc0&\x93\xf1rh\x05\x8b\r\xd3^I\x9el\f,\x1c\xbf\x89\xc3\a[X\xd4\x0e\xfb\xc6\xc0\xb5\xa9p]R-\x82\x05Ul\xad\xcbM\x95\\TɑqO\xd5s\xc4;j\xe4\r\x8f\xbbV\xde\f\r\xde\f[y34x3\xd4xc`\xe1xS[xc\xe0\xda\f5\xde\f\x89\tC\xe2Ͱ\xe2M\x95\\T\xc97\xe4\x8d;{7\x9d\x14\xd7ܺ\x13>MmVaB6a\xc1\"zB\xf9\xd0&j\xc4\xf9\xe6\x05줁\xa0\xf9;\\\x11\x01:\x8a)\xe4\xcf\xf2$\xb2w\xc3\a\xac\xbe\x06Ɓ\x12.\xd4́\x8cE}\x8aQ\xed\xb8qpJ\x1eM\xdeٱ#\xccߚv\x04\x8c\r\x815\xbbf\xe9\xfe\xa2\x9b\u0590\xb8\xec\xe9ճ\v\x188Zg\xe2\xf9\xbbs\xe8\xeeg\xcf\xfe\xbb\x9f\xe0n\xc2\b\xfe;\xe8VW\xcc(\xf4\xb0\xe6t\r\xda\x0e7\xa1E\b\t\xa8\xe9\xea4\x91~\xcf8\xc9V\xa1K\xc7\xf4%\xf3\x04\xa8\xdc\xe6\x9f\x18\xa0\x91\v\xf4@|\xc6\x06\xe8\xd4\x00\x95wB\xa6|q\xea\x0e\xee\xb2\xf4\xbc\x90\x9d\xa1TZ\xcci\x8f\xbdA\xa3,,\xd9x\xf8~Q`\xa8\x99%7\xd8\x0e\x87=\xe6#\x8b\x90\xdcb\uea17\x83B\xba\x96\xf7\b\xf3\xb5\xef;꽡\xb0)T\xb6Y\xb3\xf0\xb0\xf5'*\xb8\xb3!\x8f4Z\xf9\xa1\x97\x91\x8a\xe7\xb4> \xbb\x8d~\x1e\xa2\xb9Ƒ\xf0\x19\x16\xaf\x1dnF\"\xe2\x13\xfcdX\xfaɰ\xf8s=<\xe0\x80\x870O`x\xf1\x11E \x1d\xe1'FM?\xa4\xdcC\x91\xbb!\xe0\r\x02o\xf0\x93\x807\b\x8c\x13ᆀ7\x87\"wE\xcf\x04!\x86;T\xb0G\x00w\xb0FrG\x13_#\xaa\x9a\xa1FCs\xec\x9e\"a{\x88\x89\x00\xa9e\xe2\x13\xebv\xdeC\xbb\xca\xf2l\x91%\xef\xc0\xc6+\xe2\x94\xd4\f\xbeƝL\xd00\xb6OY\x90\xb3o\x92\x92\xee6\xc81\x95\x82\x1e9\xf2d\x19\xf3^ѕ\xb5\xcb\xee\xb8VD\xbb[G^\xa2_(\x82\xef+\xec\x19-i\x83I\xc33\xd7\xed\xa2>^~\xab\xea\xd4%\xc1p\x9d\xc0\xc3.\xf4a|\xe2\xf5\xd0/\t\x13\x1e\x8b\x04\xfe^\x13\xc0\xba\x02X\x13\xc0Z\x00TJ%\xe8\xaa{\te\x99͑#\x1c6TW\xf5\xb4\x97\x16\xcd\xe2\x97\xc0\x9f\x1a\xbbNU\xe3\xd2xZ\xbeXD\\^&\xe6\xe8\x8bRK\xc5\v\x1dG\uee34\xb2\xa2\xbe@\a\x15\xaa\xa4\tN\r\xf1wa:\xc5$a\x93wҎ\xb6#\xa6`eşZ^TO\x0e\xca5\xa4-\xf5\xf5S\xbd\x80\xc0\xe2\u008f\xff\xac\x16\xfcF\x87\r߭\xe3\xadVW]儭\xb7Fcg\x1c\xe1\x15T\xd4g[$6\xa6r\x04\xae_\x8f\x83\x82G\xf4\xaf\x9e\x8a\xc3\x02\xc5[\xefF\xea\xf5zR\xae\x89\x90)\xe1U\xff\xa3ӼFr\ns̹A\xeeyL\x9cL\xb5`\xea\xe7\xec.\x9ej\xb7k<\xfa\"\xc8\xf3\xd8L4\x92>\xeaB\xfa\r\xae\x1a\xb5ʗ\xf6\xde\xedy\xdcڇQf\x1dkQU:Q\x1f\xa5\xcd\x00y\xb7\x1d\xf2\x83\xfb\x90\xb8\xdb\xc3\t?N\x01\xae\xeb-\x83@o+#Mk[ߎFEq\xaa5\xa7\xceG\xc1\f\x9dB\xfc\x12l\xd3\xeb\x80/\x14\a\xcb\x14\xfch\x8c\xe6ƪ\x14\x81UE\x06\xdfw(\x98:\v\xbe\x8egPh\x87\xe2L;\x13\x0f\xba\x8e\x14|\xbfRm\xa0\xfa\x04P?'\x94\xa7\xf0\x89\x82~\xe6n闫W\x9b\xae\xb2\xe52+\x00H\xd1b\xbf8X)a\x14\x19M\x01\x8f\xe9\xe6\x8cF^Aw\xa0li^\xc4\xd7/\xb8O\xffn\x1c\xd3p\xdb\xcc#)\x90#@b$\xc1h.F\xfa\xf9\x94\x9e\x1d/\x1d\x18l}P\xd7\bƭ\x97c\x83Z\xf2\xef(F\xe4aX\f\x95\xcb>\xa4l8\x05/\x02\x12P\x84\x93\xda\x00\xbd\x83\xd7\xc0\xe0^\xb4\xc6\fz\"~#36\x94\xb1\xa9j\x10\xbc\xe8\xa3\x15\x8aإ\xf7;\xe2e7\xf7=\xf2B1\x1d\xfbѦ\xd3c\xa9P@&\xaa>\x8c6\x96?\xa7\xa8 \xb4\xaf~Ɍ\xb1'\x1cS\xa2\xb5+{\xe2\xed\x9f\x18Y\x8est\x1d\x95\xb3\x8e\xd0\x13^3z\xd3\xed:6\xees8>\x8agH\x87\xde\x16\xb6\xaa>ۤ uqZ\x13\xbb\x18MD\xc8\xec\xf3t\x14\x8f\xe8sD\x9f&+o\xc7\xf4*\xed혮z\xc5C1HPkA\x91\x91\xfa\f,\x01n\xf1\x1a\xa8\xa2\xa4\x02:\xb4\x1a\x01\r^\x8b\x80\xaf1\u007f\x85&\r\xb5\xa7J\xec\xe3\xda\x1d\xaa[\xf3\xe3\x0e>T2Ѫ\x1c\xf1\x17\xd4L\x9bQ`\xcbJ8\x95\x132i\xa2T(\xe0\xe2a\xce\r\x90\f@.R\xd2HO\x02\x1b\xc6b(\x14$\x1b\x11\xa0\xe1/\xf1\x14\u007f\x107\xf1\a\xcc>\x98\xb2\x16 k\xeb\xd87\xe5\x8e\xd3E'&BZ\xa5R\x14\x1bՊ\x8d\x9a\xf8%&?Ґ'\xcc=\fc\xa9\xdaַ|!txlBJ\xfa\x92CQ\xa7\x1d\x12\xa16\x90\xbcs\xa3\x1eu\xd8K\xe8!\x96\x98\x11\x03\xd0Q\f\x92Zl\x1dA\x921\x8b\xe0do\x0f\x17\x9e\x8by\xb0\x94ٲ`\xae\xc4ZO\x9b\xfcZ\x92Q/ \xb7\xb0JL-\x92UN>\f\x9b\x8e\x95o\xb7!k`\xea\xa0\t\x19)\xa7\xfamV\x1a\xad\x1bzV\x8d\xf4\xbcl\x16\xde\xe8_/Ek\xabdP\xf5Ӱ\x10^\xc3\xe18\xbb\x8a\xf5\xad\x92&\xc2Qc>\x82\x89\x01\x04ő\xf3\xf8īyhHԢ2\xa4\x90$tL\x13\xc4r\xebUQY~ɮ\x954\xc7P]\xf0\xef\xa4\xeeq\"\b\xac\xbb\xdcH<\xb7\xe9G\xb3\x17\x8d\x8c-\x8ePu,\xa2\x15\xc3\xdd/\x1f\xdf\xc6\"6\"\x9e\xa5\v\xf2G\x10݁\xeaK\nj\xda_\x1f;\x99\xc0l\xf3\xb1?\x89{\x14~\x81~o\x96\xa8t\x11\xe7\x1d\x91Do\x16\x8d\x04\xdc>e\xed\t\x04\xf0o\xf0\x05X\xf7\xb1M\xb7a\x937\xa4\xdf\xfa\x13j+N)K\x14\xc3r\xa8dv\x03\x8a\xb8\x1c\xb9d\xb5\xa4\xed\x91T\x97k\x9d\x10Ib\x89;\xb2\xf0\xcfcDs\a\xff\xd9â\xf0\xeb\xa0EAYc\x1b\xcc\x1e\xc6wd5Y)\xa4x\x11\xd1\xe9\x9bsň\xff\x96\x86\xec\x90\xd9\x1a/O\xc96E\xb3))k\xf3\xb7\x00qY\xb5lҚ\x91!\f\xa7U,\\_\xdc\xca=]ݺ\xedz\xa5\xad5\xd9Zd~W\xb6\"\xab\x10\x9dɂW\xd5)Q\xdd\xc6k\xb4\xba)\xb6\xed\u007f]ū\xb8e\U000e3da2=\x17\xac\xecz\x19E1\xb3\xd6d\xbc\x9c\xae\xb1\x13r6\x18\x93Rd\x90\xe3ufDi\xb7\x9eQ\a\xfd\x97\xf2\xb6LE&\xed-@:\x05\xac-J\xe3|3u\x04`\xe0Eº\x14\xf0\xd65\x13\xecaE\xd6c\x02\x14\x1f\xa0G+zO\xf4\x1cP\xa9B1\x88\x02\xdc\\\xdb\xd2\xf0\x1c\xbeI\r\x9a\xb4\xe6Q\xf8\xd11\"\xa8\xb5\"bmB\x11k\xcf]\xa2ղZ\xae\xf1\xb6h\xe4la\xf2\x15ۜR4\xec۴\xf1 \x02\xbai\xc4\x15\x1ai\xc3:Y\xf1|Yn\x8eZ\x1fk\xb2\b\x917\x04\xe9,\xa1\x86\x0f\xf9N\x05\xbe@c\xadpuRL\xb8\xfd''\xb4\x95#hN\xe5ˀm\xcb\r\xd7^\xc3<Y4p\x83̥4\x9eש\xd5^Ok2ఠ\x12G˒\xa3<\x92\xe0\x16{\x0eؚ\x87\x93\xf2\x87F\xf2\x1a:\xcbz\x8d\xd7P3j\x83\b7F%Lm\x17\xe1D$\xd5щe~#T\x17s\xbf_\x94x\xe2U\x95HǸӘӿ\xa9\xf8\xe0/\xdeY\xc84X \x83\xccR|~i\xbe\xa4\x87r\xaf\xaa\\4h\xe7[\x0e\xcbnk\f!Y\xf7\x8dS\xb3\x8a@\xb3[\xf1\xc0;p\x9d\xa1\xc9\xf6\xe2ͼ:jTN\x02\x82\x96B\x15z^\x11Uy\xa4\xb9\xb4L\xc0'3\xd7f\xc1\xb5A02\xcc\xde&\xfc\x86c@qq\xcdJ\xc9-X2?}\xc4`D\xee)k0\xbc\x03eCb'\xd3\xf6!\xf7\x93O\x9dS\xafU\xf6b\xcb\x16xܸ\xff\xcd\x13\x9a\x9f\x1a\xf2\xa0\xbfL,iP{\x02Ͱ\xf4\xb2\x99\xa9.\xb4\x1e\x03}l7\xd0\xc87(\x01\xb9\xa3Zՙ\xef\xb2j\xb9\xd31Vt\x93)\xd17\xe0N\xfe{qGB\xf1\x88ۙ;\xa2\xa6\xaa\xf4\x8d9Ц\x18\xac\xd3\xe5\x1dƩ\x14{\rH)D\xdd59u\x8d\x0f\xbb\x9fw\x1a\x17\xb9\x1b*o\x1d=ն\xb1\x8d\xae\xa4w\r\x9c\xc3\xccByE\x87\r\x8bHL\x17U\x06\xf1_\x9aф_\xac\xdb\xe9'\x9b\xd1\xfa3\x1b&|^\xc1\xe7nxE\x9cchX\x03\xc7\"\xd9(i\xe5-q\u0084\xd1nJ\x1e\xf0\x8d\x8e\x0f\x81-}k/\x135=\x0e\x9d\xac\xd4G&3\xbǎ\xd5/3\x15\v\x18W\x8f\xa9Ƽ\xe3\x92J\xa7\x0e\x0347\xd6_\xcby\xdb\x00m\x1c\xa1f\x9f\xc4]ϵxY\xce\xcf̞qi\xbd\xcfQ{\xbb\xe8\xbdf\xc5\a<s\x0f\xf9v\xf5\xf3\xfb\xeb\x9f\x06Bj\x96\xbca\xc5\xeb\xc1\r\xc8\x14M\x9d\xe3\xdc>\x87\v\x8eY\a\xb9\xceՎ\xbd\xb4Y\xa3h\x17\xc1\xe5\xdaS\r\xddv\xa7\xce\xf9;\f \x8eWi_ѣ+\ueefc\x18\x9d\xfd\x88\xae\xf3t\xb5\xa7%\n}\x95\xb8\xe4u\xb7y\xc0\xdeZ!\xc5=\xf0\xa7\xf2N\xb3tG\\\xe3\x13\xf2#\xe3훩\xef\x041\x9d\xd4\n\x0e%\x86\xbb+\xeb\x11\x1fk\xa03!\xc5\x11\xc3čJ\xb4\xef\xdfS[\\\xa1\x11\xc8'\xa6\x91l\r\xaa\"\xadXc\xa5\x81\x13\xdbp'l\xe8\xc0\x82XdC\x9b\xb0\x8dv\xc26\x12\xb4\x15\x1b\x81u\xe4\xc6v\xb0\x13\xb6\x833\xd9B\xd5\t;J\x17\xeb\xa2e\xf3]\xf1\xa5\xe6K\xb2\xc1O\xe1G┣2\x99\xc7\xefV\xe5D\xc9+\xbe\x0f\xd7\u007f'.\xb8>'7<\xeb\x11B\x8co\xe9=\xa1\xbc\xfe\u007f\xfb\xf9Yûr\xe8Q\"?\x9a<\x9c\xa8r\xbe\xff\xf3t<\xc6 \x12X\xdf\"\x9c\xdb\x17w0I\xbb\xc9\x05\xcbЃf\x84|\xa99\xa2\xf7`q\xb2\xe1\x97a\xb9h\xb7z>\x9d\xdc1ISi~\xde\x12^\x85\x8c\x98\xd3s:X\xd4\xf5(\x8e\x15\x8f\x03\xe5\xe0\x027\u007f\xe7je[E\x8d\x99\xe8\xe8\xf5\x1b\x83{{\xa6 \xe1b\xe3ྮk\x97\xe2\x1d]պ\xbc85P\t4\xd6;\x17\xb7\x97\xb8\x88\xa1ȖK\xc1\x8f\x8a\v\x01\xef\x84\xd6\xc84\xae$\xdeV\x8d\xd7o\x97⍱\xfaR\xb67\xbci\\y\xad\xfb_s\xb7۷\x9f\xf4\v\xda\xff\xe1?9«I\x04G~W\x14#\xfc\xfd*+\xe5]\xf3\xceo\x1d\xf1v\x1c\xd8\xe4Ig\x9b̲̽\xcb\xd5Ҭ\x98nA\x86K!\xff\x00\xffC\xb84\x1fv\xe3\xfa\x1d\u00a0³@\xf9~\x11\x97\x8c\x18\xef\xf8\x94\xd9\xcb\xec:Ο\x85\xf8\xba8\xfa\x88\x19nl\x00\xde.\xcb\xff=\x8e/\xa3p\xc3C\xc4\xea\xc8D\xbb\xf4D%\x14\xe0k\xe7\xfdD\xda\x04\xd0\xc4I\a\xa7\x1b\x9f\xa2\xbf\xab\xc1\x96\x04&\xb9\v\x18\xfb\tFNXT\xcf)\xe0\t\xfap\xa7F\xec@\xfe΄\u007fi\x92\u007f\xc8\x16\xe5\xf9n\\\x9fW\xa0\xbb\x91o\x16ؽ\x19DH\u007f\xee\xc6\xc4\x02M\xcf\xe0RK\r\xb9C\xc1\xb3x\x10\xecʄ\x9d\x9a\u007f\x83\x86\xff\x1eM\xfe\x12\x8d}\x99\xe1\xe3\x8e\xdfb<\x96\x86\x16WoH\xd4\xe6\x1aS\xb9\x14\xfd\t\x90$.\x9d\x05:\xa6]H\xa0\x89\xf7\xf3IX\u007f\x06\t?\xc3\xf7\xef@\xc2_?\x89\x04\xec\x82_\xe30\xdf&x\x1c|h7\xc9S\xb0\r\xa2G\"\xe3\x1d6J \x1a\xc0{\vq3\xec\xe6\xb2\xf5\x8fiΨ\xb59\x12W\xbc^\x86\x8b\x88(\xa4\x16\xde|\xf8h\x18j\xd7\xd1\xc8]\n\xac\xc6{\x0fе\xf8\xe1`\x80a\x12\xe3m\x16\x1c)\x9eW\xd4\xc8\u007f&\xb6\xa1\x1eZ\xb0\xe7\xfe\xf0\xd3\x04\xe1\xf9\xf6Y\xf0\x8flP\xf4yb\xfd]\xb6\xcaG\x87\xffL\r\xfa\xee\xf3\x1a\xf4C\xb2X\x95q\xf1\xcfԢ\x1f>\xafEo\xe2I\x867\xb9\xff\x89Z\xf4\xe6s\xfb(Mq\xaf\xe9\x1fӬ\x83\xc6f\xbd\xfc\xbcf=\x9d\xff4oj\x8eMj\b\xb0\x9a\t\xd4@\xeb\t\xf4\x81e\x19Y\xa4W\x0e\xc9t\v\x84\xdbA\xeb\x9f\xd6Y\xfaC\xb6\xd0\xee-#qxg7BZ\xd0p\xc0l~\x98\rg\xfd\x0fxf\xfcA\xf8\xdbvz\x1d\xa8\xa7\xb3\ak\xb4\x0f\xe7\x90\xfe\x9f\xffY\xf9U~@\xa7\xc6{\x03,2Ww\\9\xfdkH7H\xffP\xf03\xf5L\xce\x14$b\xe4\u007f8\x0f\x1c\x89\xf3m\v\xc1\xef\x8b\xecUX&Wl\xc3\xd8\xd3\x17]\xec̾\u007f\xf3\xa34c\x1a\xb1\xd1F\xeaU\x98\xfa)ZP؉1\x9e\xbbp\x80D\xfb~uNǀz\x85\xc2[\x05/\xa5\x12\x02\xce\x04\x93\n݇\xd8\xc1Ï\x06\xba_\x80F\":J\r\xd0AjH\x81\xbd!\xe1\t&\x1cA\x823\xf0\x1a\xbdnf\u058e\xe4R\x92\"@\xac\x81Ki\n⤇\xb6\xb5\x93\x02g5\x92l\x12\xeaKw]z-{\xa2͗;\xd7\xc0/\x06\x96\x18\xa4\a\xaf[[W\xd5\x11)^w\xc3֖(X\x98P\x18aw\xe8\xb6ui\xbe\xdaR\x1dQc\xf9G\x80\xba\xee\xc8p\xdb\xe7\x9e%\x18\x14P\xa8;`\xfc\xe2\xb9_\xb9\x83\xb6G\x10\xc6\x16>\xb5\x1f\x93\xbbF \xaa\xc6M\xf1:9-\x15u\xdd\xe8\xad\xe8=\x84\xa0\x99\xa5\xb4+\xe8b\xab\xfe2\xb8R\xb3\x9af\x82r\xa6\xb7>$\x98\xbdl\x82\xc0\am8\x96\x83\x9a[\x06\xf7-\x00(*\xaam\xaai\xb2\b\xd3\xd4M\xca\xf3\xb0\xf2\x97\xd2w\xa3H\xaa\xfb\xd34\xa3\xf3\"\xfa:\xd62hHR蹕\xbc\xca\xc3\x19(>B\x8a\xf4d\x16n58\rT\"zj^E8\xadX\xc1 \xfc\xdb\xd6\x1d\xc4v\x02\x10mE\xf6H\x8a\x15\xdf0QR\xeb\xc4@\x99\x1a\x06\xd1\x02',\xe6i\xa0\xaaUN`ᨦ\x91\xa1\xb7\xb4\xeaY\xe9\xd9#yܪ0\t\xf3<.ϳ\xa8)dSM\x83|)1\x8cB\xdd\xd7N\xee\xd1\x11m,\x89\x97A\xff\xdd'\x8ba#+\n\\s\xe3K\xb0\xb0\x84\xea\x8a\xfa\x8a\xae\x88\x8fY\x9b<\x18z]\x8b`\xc5\xe5}\xf3\x91.\x02\xee'\v<\xa1\xd6c\x13ԋ\x1bĐFa\x84\xa20\x940#s1\xe6(\x9b\x87\xf4.\x97\v3\xfb\x1a[G\b\x81I\xaf\xc0\xe0\ab[\xd7\"B\rz\x13zmO\x11T\xc0A\xdf\"1\xe3U\xcck\xed\xd6ہ\xf5\xd3\xf7;,\xe7\x1bYA\xb7՛k^]\x99u\xf1\xa9L&\x97F8ʹ!\xd0\xe4#\xb5.\xf9Iy\x83\t/(\xd5\"\xc584\xc0xY\xd9ԛS\xac\xb8\x8e\xac\xa0c;\xc4\x15\xb0HG\ae\xc2\xc7\xd7\xd1\xc5o4YU\xf4\n\x02\xdb\xf7\xe6]\x11rA\x1e\xbf\x1bD\xbd\x81)\xa6\x90\x85\xac\x93\xe2\x04\xcd\xca:\xb4\xdd\xfbB\xca\xfb\x1b\x94Y&\x04D\xdf\xc2u;\xb1\xa5\x85\xf8\b\xbd\xb8EZ\xb4;;\xf8\x80\xabhݾ\x83\xae\xd3D<\r\xf1\xc8S\xe0.\xa83(-\xda\x1bx\xbd\x9e\xf6\xae.Z\xab\xa25\x86g\xec%\xc5F\xd4\xfd\x1a\xe72Z\xa263Ԧ湯uK5\xbbW\xfd\x86\xe1+\xb5wpl)\xfb\xb6\x16\x83\xaf&\xf2\xacX\x1c\xe5'\xd9r\xd3Zҥ\xb1\xa8\x14\x0e\x0f[q9\x03\xf9\xa9\xb7]\tEדZ\xafC<\xe9\xc8\x1f\xafq\x0e\xa3\xa3\x9c*(\b\xbd\xfa\x9a\x86\xf3e\xcb\xe9\x8e>fx\xb4\x18\xa1\xe41\xa0\a\x8a5\xe5\x10\x87\v\xf6L\x11)\xfcG\x1e\x13\xf4<+\x9a\x04\x97\u007fą\x9ex\xa7\x9c \x90\xe0\xbb\x16\xa7\xf4\xb3+\x00\xcf\xda\xc9|n\x84WҎ\xbdDF{i\xeeg\x11B\xb1h\x9b:\xeb\xcf3\xc9\rڪ\x9d\xfc\xaa\xb0H\xd7\xc4X؞\xb7\xa7 y\x8c,0 a$\x9cY\xaax\n|e\xd0\xe3]\xe6\xbd7qI[\x87Ɠّ~\b\xbd!Omi\xcbFx\xbe\xa6\xf6\x887\xe6\x9a6j\xaf\xeb\xdfE]\xc6\xe2u#\x17\xaf\n)\xae\xbb\x06\xb6\"\xae\xe8\x14\xab2w\xae\x16]V>\x1d\xce{\xa0\xb8F\xa3\xe8\x16\xbcJ\x1bl\xe9\xde\xff\xf6\xf3\xb3휑\xe7\xf0\x8aE:\x8f \xfd\x13\xd9\x04%\xdb9\xa5\xa3v2ˠ\xde\xc5/\x1d\xe0\xe6,\xa3\xb9\x81\x1a\xbf\xc0\x80\xe4\xf2\x97Ku\t\x8c{j\xa1\xc4H\xf0\x9fʊ\x8c\xb2\t\xd9(\xc2{\xecE\x1a\xe3\x97߉\x92\xabN\xd0/\xca\r\xe8F\xe0\xa8\n`\xd9ɖ\xe1$)7\x1db{G\xe8#o\x12\x96\x93sϏ\xf3\xbczT\x80\xfa\f\xac\n\xc4\xf1n\x99ge\x86\xf35P\xfa\xec͛7\x98\xf8<\x06\x9d\xc6\xcf\r\xf5U~\xb7*\xa3\xd5\xcbL\xb4P\xe9\x84\x1d\xcbݯ\x16\x18\x9dIxJ-^JǗ<\x92,\x87F\x19\xc1 k4\xf4\xd17Jx|h\xc5\xf1轣ᰆ~t\xa0\xaeƀ1Y\xd0\xc5\xf2Ψ?\x1c\xf4\x0f:\xb2;\x04\xa7\xe8E{n)\xfd|\x83W\"\xac\xee\x12Y\xbe\xea7\xf9C\xf4\x1c?\x06\U0008a795\xc6\xf9\x16}\x930\xbc\xa8\xd9M\x81\x85N\xab\xf4\x19Li\x92v\x9d\xae7\xab1\xf4V\xc1\x9b\b\xfdw\xcc\xe1w\xef`.\xa8\x82\xb2 \x1crB\xf0^=\xc7A\xef\xfbWEN*\x10&\xebh;\x0eu!c);\x13lo\r\x8aʝ\xca\xcc3\xbd\x8e*U\xc9?\xbd\x97\xb6\xd4\xe5\xc1\x8c\x8d\xeb\xf2\x8786鸌\x89\x04YNz?@rW$\x9e\xc2\xef3\xb7\xd3\x03\xd3\xf0\x8e\x18\xeaKw\v\xae\xfa<,4v\x00\n\x97%\x02x\xf0\xdd\xc4i\x82\xb7\xb4\x04%(\x98\xf2A5\xfaC\xcfu\xb7b\xc2\"\xa7\x0etg\x06\x9e\xc2\xc6#\x04\u007fwt\x18\xbbJ\v\t\xdc\x15,\x99gWq\x13\x85ب\x13WS\xad)Wk<=\xef\xc0\xcfK\x12%\xb5\x86@BἘ\"\xfa\xd3\xdc #\x970\xf9⦛HFɻQ\x98a?&\xa1Si\xf6\r\xb1\xa3\x99\x18\xceލ\x9cZoH\xecL\x17g6RƠ\x06m\xa0E0tn#q\"\xff\x93\xa9\x13\xe5\x99<}{\x11\xc0\x8f\xf0\x1f#\xb6\x0e\x96>\xe2?\xd5~as{\x04r\xa3A\x82.\xadASG\xb4WM\x92\xec\a\x11 \xcb\n\xf3~rb\t\xe7\xb3,\xb2\xae\x15O\xf5\xf9\x021T\xa7\x14\xb8\x8aQ\"\x1a\xb4\xbdm\xc6\xed\x13zX\x1b\n\xb0\xdc};\xe8t\xeb4\u0603\xa6\xfe\xe2\x06\xe8>\xe2\x03m\xad\x01\xac\xf8\x10Y\xbcD1^\x8f\xa0\xa5\x1f\x18\xf6\x14eЎL(\xdc\xc3\xec\xad\x0e\xb9$r\xbb\x8a1\xcaS\x06\xd1K\x83\xf9}\xc6T\x89\xa5\x92Y\xb9\xfc+JZ\xcaU\v\xff*\x1a\x13\x16\x93x\x81/\xe9\xe8\xedq\xbd\xb8\x861\xc1TD0\x8c\a&Å\xa1[#~\xe0\x91«\xf0\x95\x8e\x1cf\xd8ݰ\xe3\xed\xebPb\xc7\v\xee\xa1\xc0N\x17\xebC7\xf6y\x1c\x1a{Kb^\x9c\xda\ac<\xb7J\xa6\x87\xec\xba9\xa8|\xf7.\xaac?\n\xcdb{\xb5Z\x01\a\xec\x9e\x12Q\xcdŖ\u007f(+\xc4X\xbf0\xe7\xe1I\x9b\x8f\x01:\xe6h\xab\xee\xed]8\x1f@ݎS\f\x15\xd1FY\x03\x9eĵ\xd5bxZ\x02\x17\xe7\xc0EX:\x83\xd4/\xe2\xc8\xe4%\x86\x05m㦓5t4\"\xcd#\xe63Ž\x93\x8f~\x199\xd3$\xc5\a\xf8T\xbbL\xd1\xd4{\x89\xde\xec\xec\xbf_\x85\x8b\x12\xf8¤pt;]bAC\xf4\xef\x06\x8d-J\xb6\n\x87\xee\xbcY\x13\x92\xf1\xa7J\x04])4\xe5@\x9d\xa5\xfe\xf6\x1b\b\xf3mȁNC\b\x83\xf2&A\xf0\xc7\x06\xae\xdb\x02\x17TC\xe3\x901\x8dw\x90\xab\x8a\xb2\x16i\xfa\x1dhm\xc3\xdeN\xbd!\x10\xa6)\xba\xfe_\xbe7I\xef\xfd\xcb\xf6\xa6\x83\xfa\xc6\xdeT\x9bٟѡ]\xf9:\xfe\xe7\xf4\xe9d\xa7~\x9d|^\xdfڦR]\xee+\x0f\xffG\x983\xd1s>\xdeD\x12&\xff\f\xd2\xf0\xbb\xb4\xd7\f\xe7\n\x1d>\xf1\x8c\xb5i\x1e.\"-\x9c\xdd\x02w\x1c\xf5\xc0\f\xff\xf3\u007ft\xbd\xff\xef\xff6\xadr\x97\x15\xa6\x9f\x95,\x80\x9cQ\x00\xc5\xf4072\x03\xa4\xe9\u007f\xfe\x0f=N\x90\xbd\x99j\x1f\xa9\xf0\x8b\xf4yc\xe0ǵ\xf4c\xe1\xa6\xf8xujdFd\xf6\xaa\x97\xdcۀr\x8a\xe5\xacŭw\x84\x92\xbcM\x017r\x9a\xb1k\x87\x98а=l\xf6\x1dBS\x859\xa1gb\xd4\xc1G\x8e\xb6EuC\U00063c40\x00\x80W\u007f|\x1fP\x1c0)\tJ*\xfa,\r~\xb0KW\xe9\xb1]\xe2\xf5үx!Y\xde\xd0\xe2$\xbfN\x16\xdf\xc1`8j;@t֩\x16T\x05[\xa2l\x81\x1aOe\x14h\xd2\x19=_\xeb\xb5\x02O\xe2lڴ!R\xac\xe6\xdbTl\xa1=dakX\xfdi\xebO\xb5\x8bo'\x05\x18\xed\xa4\x9a\xf64\xab\x98\x1a\x17\xeel\t\xebXZ\xed`\x03\xafq4\xa3\xab\x0eiW\xea\xcc\xe15\xbe\x16X\r\xb9\x83\xfe\xa2\"G;\f\xc1!\xb8\xe47\xd4\xce\xe5\xe8$7\f\xff;\xb0H\xaf\xe4FNq\xcaGD]\xba\xab\xfc\x1d\xfc>7\xec]tú°T\x18\xafJ\x96\xc0s\xd7+4i\xaft\x82K\x10\x81b\x99\x99\xcf?\xceCX(\xafk~\xf7\xfd\x0f\xc9R\\\xbc\x8b\x0e`\xa9\xc3P:6\x00pm\x85\v\xc7%\xc7\xf0\f\xd41\xab\xdc\xcc0B\xbf\xc8\xe7 \xd9\xe6\xaeĄV\xdeP\xd9KF\xd2\xf5\xe0w!\xb6\v\x9f\xd2\x06\xed<\xa0H0,\xf5\x8e\xed\x06\xf9\xd8\xe4\x82ʢ\xa7`\u0087\xad:\x92\x05!\xb9\xe0\xc7W\xf4A\x06\xc0\xa7\x17g\xc6\xd2\xf9\xe2L;\xbcrL4\x88_g\x95v\x85\xbc\xbe/R\xbb\xbfk\x84\xa8\x85\x01\x04\x13@\x9a\x81\x90\xd4\xde\u05ec\x8d\xa4G\xdeA\x00\xb0f\x14\xba\x06\xc8\xc3\x000\xea\x0f\xcf؞`\x80\xe7\x91U\xa9حM\"r1\x02\xa1\x03\f\x8f\x1f?6g\x11z\x03N\x8c.|\xab\x06\xc0\xcf\xf0\x01Y\ni\xb9\x16\xf4!\x0e|!\x80\a-\x11\x02I-\xc1y\xd2lk\x18\xe0\u007fMVat\x177\xbb\x82\x8a/\xcc&\x83s;\xb1J;\x1f\xd16\xb74qԄ\xb3\xdaK\xacݯ8V\xdbc\n\x1e\x1f\xe6\xe7\xed#Y\x9en\xc6\x1b0:\xfe\xd7\xd8YV\x01\xea@\xb9O\x96\xe4\xc5N\x96\xbc{\x9aQ\xf62\x9e\xb6\xef\xb0H\x9b2mj\x0f\xc0\xbdy\xa6\xbb\x18Y\x13F\xd73m\xf4\xc7fԿp\x8b)j,c\xd2\xf0_\xba\xe9\x8fN>\xa3틸p\xba\x91\xa83c\xdc\a\x125G\xf1R\u007f6\x966\xb70\t\xf7\xf6\xe8t\xc0\xf2\xfeɳ4]\xa1\v\x05\xff\xe0V`\x85b\xc2\xc7\t\x12w\x83~\xe1#\x80'\x9e\xb6AT\xa5\xf3Es\xc88\xb6\xfc\x1b\xdckJ>MArN\x89\xb8\xbd\xbd3J\xfc\x85\xcf?\xf9\xbcJl\xd5\x17\xdfl\xfe\v\x81\xebW\x81\xa5\t\xc1O%7-\xa4L\xf5\xa6\x0e14\xb4\xe4\xb5/+f\x92\xc4ћ\xb1\xb5h+\xad\xea8C\x9c\xb7\x1d\xb7\x87\xa4\xac\xea+\xb4\xfa\xba\xb0\xd8\x12\x955ﺛ\xc5]G\x1a\x02ו航\xe6\xecT\xe6\xe2̌2\"\xd9\xc6B\xd2xh\x919\xfd\x91\xc5Q\x06\x18C˛\x8b\xd9<\\\xea\xa2\x11\x8a\x87]Q\x82\xb8w\xc5/K \x8eu3E\x9c\x88\x00*c$\xdd\xe0\xe0\xa68\xd2[A\xc7\x1d\xb2)\xae\xc3\x1c\xadq\x82<XY\xef\xf6\xf2\x87\x80\xf7\xc3>\x1d:\x8d\xfb\xad\xe7q\xa1\xfdΙ\x18\xf1\xf8\f\xae:\bT\xdc\xd2?\u007f\x11=ʣ\x97\xd1`a\xfb<Y\x8cek\xc1\xa4鍁\xe9$F8\xaa\x93\xb5-x4\xa6V\xf8\xdc8y\xe8קQ\xfd\xec2\xb2\xf9\x83%\xeb\xa84vT\xe7\xe6y\x14k\xd1ǕTi2I+\x05t\x17\xcf\xeb\x0f\xb87W\xf4\x8b\xd4\x1emU)\xa0\xddq\v\xfd{\xe2<\f\xac2\xb7\xe1\xb2\xd2\xe5\xccqi\xb1G\x1b;\x8eCf\xfb쑠]G\xca\xd61\xb2\xac\xed\xaa\xc6#\xab\xbe\xda9\xb2\xb3F]\xbf\xcaajV\\\x9d\x12\xab]κ\xa8Zu\u05cf\x89\x9d\x95;O\x83m\x85\"N\x81%q\xb7\xf4A]?\xf8\x954.\xe3|\xbe*c\x87\x15C\xef\x11\x99\xe1/\x04\xb0\x1c\xeb\xfa\\*\xa0[\xa3_\xc8⼂\x133\x19\x97\xc3CM\x83T\tk\x9eQ\xe5\xb3:\xa5\xb6s#\xad\n+_\xa9\xfe$[L\xc2R,\x89\x91lQJǌ\x91U\xb7Zr\\N\xb4]\x93\x1a\xe5\"\xd5d[\xb4\x98v\xb4\xd75\x15\x9e[HD)\x87\xe8\x0ev\x9d\x9c9\xaf\x84\xa1`n\xc6ڮ\x0e\x86/B\xcd\"\xa0տ\xb1\xd5!\xce\xf5t\x17\x89B\x8fn\xd7\xec?\xe1\xb0\x1c\t\x93\xb5}k\xf6\xa5\xe6+˗\x94Z\xcf\x1di\x15\xa8\xdf\x18\xb3w\vݜ\xae\xda+\x1c{\xa9\xd6c-\x95=\x80\a\xae\xb6\xd0\x168\x95\xeb1 n\x87r\xdd`\xa0\u007f\xbf\x98b\xf4U\x98\x87\xca\xf3<\xbb&\xcb\xf0\x05\xba\xfa\xf9\x9d\x84\xb3b\xbe\b\xd3т\xaeJ.\xa0\x10]\x8aC}\xba,\x83~ͳ8\u007fC\x8e\xd4\xea\xb2#\xb1%\xd0\x0e\xae\x85w6Q}\xe7\x04_S%\xfa\xe4Ϙ\u007fV\xf2GI\x14\xddG\xbeE\u007f!\x99\x80\xaf\x98P\x01\x8e\xcd\xfb\x980\x05L!\xf7\xf2\x054\xf72\x10K\xdam\xe5\x1f5\x96\xbfe\xdf\xe22Ă\xa3\xf6\x18\x82a\x8d\xef\x02\x80\x96i\x88\x8f\xe3\xa2\v\x04\xc1\xc3߮\xd7y\xfb\xf6\xdf\xfe\xdc\t\xecEs\x05\x02X\xf7O\u07fe}\xfb\x1fo\xff\xed흷{o\x9f\xbc\xfd\xed\xed\xe9۳\xb7\xfe\xdb\xe0m\xff\xed\xdf\xdf~<۟I:\xc4\r\xaa\xea\xdeJ\xb7\x1a\x84\xda\xe5^ޠ\xa5\xfb\x9a\xb8sN\xdbg\x94\xb6̮\xfd!\xae\xfb\x02zCv\x01k\x11\x1d\xd6\xd0>\xebsc\x83i\x95\xa7\xb8\x8f\x80Γ\xaeg\xe7\xa0=b\xe1\xf1\xd7\x1f^~W\x96\xcb\xd7\xd0@5\xf56\x8f\x16\x89\x8b\xb6\"\x10\xf9\x9c\xaf\"Q\xf4\xe4*\xb4\x13\xa5\xfe\xf9\xcfXM?\xbb\x8a\xf3<\x89\xe2\x1f \xedgr!t\xa5R\x11կ\x90\xbf\x8c\x17~\xe7\xdf_\xfc\xdc\xe9z\xd4\x16\n\xe6[\x91G\xe0\x04\t\v\x0fA\xfcwq\bƉ\xdfy:\x99\x80\xe5\xdba\xe2t\x9c\x8b\x1c 0\xb6r\x19O\xcem\xddajG\x84'\xe87%\xdd\"\x85\xc1yX\xbb\xee\x82\xd7Ґ\x02\x00Y\x15\x8e\xb0\xf2\xb7\vɄ<.\x96\xd9\x02\xb8\xf3\xdboP\f\x96\x12\xa3\xc1\x80\"\x94#[\a\x03N\xc6J\x0e\x06\x87\xb8d\x85\xee\xe1\x10}\xeeX\xcd\xdcn\f\xf3+A\xd4./\xc7P\xdfE\x0e\xaa\xfb\x98\xd8Nl\xb1#:!Rb\xb5\xe1g\xa8¸\x00\xb6]\xb9Zbs\xac\xc2k\xcf\xcdg\xbe>\ngY\x94f\x9dt\xa2\xd0h\xe7E\x91-j\xed\xb4[(\xf8\xc1\x99\x1d\x9c\xbc\x93\tyA\xefc\xf1\x8ev\xdb\n\xc1\x1c\xad'n>\xf1\xfe\xaf7?\xbe\xeas\x14\x1a\x064\xfa\xe6\xa3A\xd8y9OoH\x18\xfe\xdc\xc7r\xcd\x14\xd1%,$\xc6q:\xaa\xcf\x01\x96\x97\xf9k\xba\xe0\xaa\x1f\xfa\x90&-b|۟\xe2H\xaa\x02\xe3L{\u007f\x00㮒\x1c1<#{\x96-0u\x15\xa6\xdf\xe6ጼ\xd7\xcbJ\x14\xaaU\xa5\xc1='\x8f\xd6\x0e\x16\xfd\xbe2\nZ\xed\x1f+\xa2b\xdeX\x14?I?B\xae\xa8\xb8\x9a\x1dy\x9dsиG\xfb\xfb\xd7\xd7\xd7\xfd\xeb\x83~\x96\xcf\xf6A\x1f\f\xf6!\xb3Ö\xf8\x1a\xc5\xc1\t8|\xf8\xf0\xe1\xfe\x9a\xa5\x85A\xd3dq\xd9\x02\x8a\xd9\x12\xb4\x01'p\v\x81\x1f\xecSH\xb9%L\x8bU\x81E\xd1L/e\xefw\xf4\r\xbd\xea-Sv\x8b<ҹ\xc0H߃\x04%S\xfd\x99\x01=$c\xb5\xc3FQ\x19ɴ\xffq\xeaw\x8e:A\xd7SN\x99\x98\xa7[jI\xed\xe9\x1c\x03\xd4\f\xee\x98hr\x8e\xb9u\xa0D\xbb/R\xbb=\xa75\xa8\u007f\x1e\x16?^/ԥ\r\xae4\x00\x95\xa1\x19\x89\xc8O\x83\r\xa7\f\xa6\xbd\xc8Jט\x8f\x88\ne[\x1fi\x8d\xd4\x0fd\xa3\x040\xe2\xed\x02\xc7\xdcERW勍E\x99b/.\\\xe7\xe7\xf6\x8aA\x96=u\xb8\x8eʼw\xf1\x15݅\x13\x9f\xd6= \x91\xaa\xbb\xe3\xcb4m\x81ejs\xbe\x9f\x82A\xc2\xe3E\x9c\xdb;\xe1T@\tF\x1f\x05Ctc\xa7SY\b\x89\xb9+/ \xf8\xaaJCG\x8bk3\x16\x8c&1\xd6z\xa4\xbe:\x80\x9e'\u007fcDq\xd6W\xa2}\xe4H\xd5ڧ\xeb\xc6i\xedne\xb1\x8c'\xc941\xf90\x17]\x8c~\xc5T\x02LS\x11VF\x81\xc3l\x92\xa4)\xed\x90b\x0f\x0e\xcf\xd0\xcc\xe8x\x1d|\x14f\xb6P\xe9\a\x9c\x8e\x01S\f\xf8\xbb\xb0\x9e\xb8N\"<;\xf0\xf68\xe9\x1e$M\xb2\xf9<T@\xf7\xcfhTN\x12\xbcp\xa3R\x1f\xe0u_f&'<\xc4]=\\\x87\xb0's\xb1\x9a\n\xe7\xeaN\xd7\x13\xcb\x14z\xe2\n\fȪ\a\x15\xde\xc0\xa8bO}\x18\x9e\xdeU9j\x86v\x12̍\xea\f:F\x1cTlF \x1a\xd83N\xe0}\x91\x88\xe7%\xfb`\xf6\x19\x97ݯ\x13\xba٣_\x99\x81\xb9\x03,\xbb\u03a2s$\xe7\x12\xc1\"\xb4Y-\xd9\xea\xcc\x14\x15z j\xc6\xf0\xb5\u00a0x5P\xabȊg_wl\xa4\xd3\x16\xa4\xcbOE\x9a\xb7 \x8d\x14Ҫ\xf7\xf4\xd6\xea\xfd5hFS\xd4h\xab¼\xb6\x92Q\xcd\xea\fFph\x04\xdcք\xc6f\xb9\xf8\xae\x86\f&\x14tR\xc2\xdd\t\xc3\xc0\xcc|\x1eO\xc3UZ\x1e;\xef\xbdZ\xf7-H\xe7\bf\x00!\xbc\x9f\xf25ʐ(\xd8\xe9\xe8\a\x05\x8bxF1\x81\xe4\xb1\r\xbd\n\x87.xr#\xa6'\xf0ːF8fu\x01f\x8eY\xef\x8d\xf1\xc5-1\xf3)m\xc2ӎ\xaf.\xe1I\x0eY\x1b1t\x97\x8b\xa65\xbe{m\xee\xc8h\xc2\"\xa16\xf3q\x966\xee\x061\xce;'z\xe0\x04\xeb\xf0G(\xdbf\xca\x1c\xc3Y\xbc\x1b#N5\x19\x8f\xd4\xc0{\x8a\xad\xb5\xc3zz\x1bH\xeai\x1a\xe0\x81\xa2\xc1\xaf\x1cA\xe4\xd0W\b\x87\x81\x88\xfe\xcbT\xec\xe9\x17\xac,]\"\xd1U24\x83\x95\xfa\xb2\xc6G\t\xa7D\xc0B\xea\xdcW\xfb\x9d\xebh\xe1\xe2\x97\xe6\xd9G\xc7\xc5(\xdc\xf8!\xe9:v;-\xa89\x0e\xb7_\xfc'G\xfe\xe9\u007f\xfc\xfd,x\xe2\x9f>z|\xf2\x1fg\x01\xfe\xda{\xdb\xf30\xe9+\xf8o\x80\t\x83\xdeó=\xf8х\xff\xde\xf6\xd5\xe7i\xd8\xfb\xf0\xb4\xf7\xb7\xaf\x01v\xbf^\x03\xa9\x04\xe1\x87\x14.\xc5>\xf9\xec\xc8\xd8\xc3Y\u058e\x890\x10\xeaORx}\xf9~\x84\xbc\xe6\xb5K\xe9\x17kX\xb7\x801\x91\x84\xa9]~\xbaK\xf9o\x93u\x1c\xd9%\xf3m%\xe5&\x15\xe5\x1aR\xa5\x86\"\x17\fT\x154O\xce\xc35\x9aE\xfc\x1b\xfay4  =\xc6|P\xe3-k!\xdeC\xf6:x\xb1\xb9\xf3\x01\xff\t\xf1\x9f)\xfe\xb3\xc4\u007fp]\xde\xf9\u007f\xff\x1f\xfcw\x8e\xff\xe0\u007f\x97\xf8\xcf\x0f\xf8Ͽ\xe3?\xb8)\xd4\xf9\t\xffy\x81\xff\xfc\r\xff\xf9\xb5\xe3\x9dɈ\x1dz}\x81aW\xa9\u0558\xa9\xc5u\xd5c\xbb]Tv\x85C\xe1+\xd5\x1dT\xea\xaeg\xec!k\x88\xab\xb1+\xb8.\xeavq\xbdFV\xa5\x12q\x06\x87\xf1\xa2\x19,\xf8T\xf0H&\xa1\x93\xad\xa0t\x9fS^\xbe\x1a\x0e\x8c\xe2\xaa\x0f{\xa3C\xbd\x17\xe5\x870\x83\x12r\xee\xe0\xe0\xd6t\x8f*\x916\xd1\x01\xba/\x1e\x04.\xab\xb8\xd6ݧ\x0f\xa0t\x82\x85\xce\xecQ\x1d\x83\x11@\x0f\x0f\xb0\xe8\xd1\xe72K7\xfe(誄\xc9j\x9cLl\x80\x03\r \xe2I\xba\xe5f\xbe\x84L\"\x1ca|\x87ݦ\xc3\x1e\xf3\x1c4\xe4Ȯ\x85\a\x16\xd2pdP\xa4\x96\xb6\xd1Q{l\x13\xd5fc\xa4R#w)I\x80\xe6\xad\xe1\x86Gଂ\x00fj\xa5\xf5r\x97b\xb1|aV\x12\x9a\xe4\x934މR\x824\xebD_'l\xa7\xc2\xce\t\x9c\x8b\xbb/U\x16~\x89t\x18+\x93\x9d\xaad\xc8\x06%D\x10sysS\xeb\xe9N\x02\x96{MF\xb8\xeelUVYy\x8c\x81\x05\xc4\xcb*P\xaa\a\xb9\x1d={\x8a\xdbj\"\x1b\xf2z\x84\xd8y\xfcm\xd2-J\xfaVEP\xa2\xa6Q\xf1D84}x\xf5\xdd\x13\xf7\xdeI\x0f\x97\xc88@x\x9b\x04F\xb4kSD\xec8\xb0\x1fn\x13$/\x981\xc2&4N\x98\xd5\xd5\xe0d[Z\x1a\xd2\xfa\xb8aй6\x8e\xb1+\b~n\xc0\xebc\xb4.S\x18\x9aǟ\xfb\xf2$\x96^\xf9\xaf\x1d\xd6\x16\x18\xceAz\xad)?b0J\x02c\xa5M\xbb\x16\xdaޞ\xf4\x91F\xf5e\x1e*b\xcbu\x0e\xcf\xea;\xad\"*ī7>\xed\x82\xf5\xa5\x89|5\xeb\xe2\x02\xc4\xdc\x13\xf1w\xab\n\f\x0f<\xddxZB\xeaxU\xc6~G\x15\xea\xc8\x10\xae\x81\xbe\xa6\xc0\xd6\xcc*\xcc\xfd1\xf0\xec\x17\x8c/\x98-\x8a,M0`\x8e_\xf7@\xe1\x80u\xb2\x90\x8f\x1b\xde\xe8e\x83.\xdfޑ\x91\xf7\xbd\xe8\x1b\xb5\xa9\xebkD\xc8͝\xaa\xfa\xaaGd\x8c\xf6\x16\x1d\xcdm\xc3\x18L>>\xc3A\xf7\xdcU\x12\xbd\xba\x01،\\\xfe\xa6\xac\xe22\xbe\xfek\x95\x83\x9f\x9cN\xeb\x98*\x9d\x96L\xf4\x82\x87=\x05(\xaa\x9fǳ<&#e\xf8` gП\xbe\xef:\x19\xa16;\xc3#o(\xb4Ց7\x10\xaaR\xfd\x8aTn\xac\xd2\xc0\xaa\x1bh¨Šj\xba\xe5,Ƕ\x01\x9d\xe5\xd5M𩱅\xd7\xeb\x891\x8c\xabb\u007f\xea(x\x9a\x9cq\x05A\xe0\x99K\xdcc7e\x1c.\xbd\x89\xbe1n\xa3\x84\xee\xb5rY\xd3}!\xf4\x03>\xa5]\xda\x16\xbfY\xe5k\xfb\f\xf5\xd3kԎNU\xe5A{\xedu\xa9\xb5;\x04\x83\xd8u\xbd\v\x18\x8e\x03\xf6\xd1-\x86\xe2\xaf8\x91\u007f/\xfe.\xba\xd2\xfb\x10\x04I\xabB\\cv\x85\x8f&7\x1e\xb6A\xa5\xe6t\x14\xa4M\xbfq`=\x87Kg\xa4<\x03\x04\x9e\xf0\xb0\x18kz\xbc\x90\xa4J \xa5\x17\xde\xdbn\x87ɑ\xa7d\xec\x96\xf6\x8a-\x86\x99\xab\xf9\xfdɐ\xb1\xfa\xd1e1h$^\xb5\xda\xde\xd6)0\xd8\xf0Xy\x918[ i\xae\xf8\xc4;\xdb\xef\xd5C\xc1\xfe\x16\xb6\xe1\xcdE\x18\x1e\t\xdf\xfb0\x18\x88\x0e\xb8\ufd4b\x1eHT\xd6_\xe3VӜ\x9e\x1b3\x97\xe7Y\xdf\xf1\x16qq\n\xc9\xf4\xf0\xb0\xbc3i\xbf=\xcd\x10䷷w\xe2e\xf6\xb3\xf5\xea\xb9f\x802#\xf9\xaav_\xd0dM\xb7).d3.\x02 \xfd⬟\xf4z[\x1f\xb5\xaeQ\x80KqEw\x1b9\xa3\x1b\x93\x83\xc3ut\xbc\xeb\x83\xf77\xe0 y=\x9a\xcckn\xac\x00ޱ\x9d|G\xeb\xb3Y︰\xf1\xde\xf9\x16\xb7\xe7-\xf4\xa2\x1a\x9eZ\x8bH\x1c\x9d*\xda\xe7WN\xd2,\x04\xf3\x8a\x1f\xdc\xd0R\xb2\xbe6\xdcu\xe7$\xf7\xb3c4\x12\xfa\xcbl\xe9\x1b\xdbr\xed]\xe3\xea\x96Z\x03v莶\xae\x90\xacT\f\xd3\xd4G\xc3\xf5B\xe9\xfe\x83/lT\xa1\xe9\xdfc\x00\xaf\xb5\xd7\xfe\x96\xf6\xd8qI\xb2u\xda\xd1շ\xaea\x8aS_*\x97@5\xdf/k\xe1fţc\x9d-s\xd4\xcf.CҞ\xa6\x1c\xb3\xd1S\x96\x9d\xca\xf0\vAJ\xbe\xb1\x13Ǹr\xc0\xb3\x8c\xa7\x955\x06)x\a\xe4\x1b=%g\x18\xb6\xc9\xf0QY\x02\x90\x9fל\x8bv\x19|p\x1e\u007f\\\x8a\x1c\x8e\xd4y)\xb2\xaa\rc\xda\xe2\x0f\xb1\xbbn\x9f@\xb5\xf8\x03\x16\v\x902\x94)CM\x17\x8biB\xb7%\xbb\x1e/\x12:\xdd\xeag\xd0i\x9d\xed\x86\xc64\xe7\x1c_DR\x97\t\n\xd4\xd54\xcf@s\xb0\x13\x9a!\xa3\x19Vh\x02\xe3J+1@\xb5{ks\xd1\xd4\x1d\xb3\x89\x1b8\xaf\xc6\xca2\x9d\x9a\u007fDN\x97\xf9\xf3\xb1\xe5\b\x85\x01ar\x8c\xcb\x00\xf6p\x80\xbf`\x9a8\xb87м\xbd \xad\x87\x02 AB\t\xd2jQPZ\xc1z\x05\t\x96\xe6\xbd\xdeK\x80w\xb4\x9d\x8by\x88\x02\xd7\xc2?\xbdM\xcd\x15\xc3\xef\xdcb\x9d\xe2\xcd5\xf1\xe6Zó[\xa3\xc4\xc2\xe4\xe6m\xba\x0eq\xa0\xb4\xb4麵MՂ躩I\x97rT]J麔\xa3\xea\xd2\x143\xb4\xa9\x1cU\xf0\xda\xea\x13\xc6\xd7\x02Xp\xb8\x9d\x05\x97<\xc6.\xdb\xc6\xd8b7n^\xf28\xbb\xdc2Θ\x13\xb7q\u007f\x15\xd91\x16\xec\x18\xb6rZ-1/]\x9c6\xec\xd1m3\x86~\xb7,k\xba\xfb\xf5;O\x1f\xafg㦉#\x14{ճ1\xcc\r\xc7bť\x92ƚ\vr\x98\xd3\x05ټ\xeb\x853\xfa5\x83_tO\xb0?\x06\x84\x98;\xee\xe7\xd0W\x18\x86y<\xa3\xcf\x19~\x02\xdcxL\x9f\xa8C\xc2\xf1\xaek\xb9\xceW\x1d~\x89\x05(yw\x1e\xaf}}q\x97\xe3\xea.\xa7\xe5]\xd0\f5C\xa8\xd96(\xec\xd31/\x15\xb70\xf2\xbb\"\xdd\xc2\xc8\xf3\"\xb5\x19\x89I:#\xcf\aĴs\xb1\x9e\f\xfbE\xd7K\xf9\x17\f\xac\xf3!\xb1\n\xcf\xda\xce\xe5\xfa\r\x96D\xe8J\x0e\x9f)\u007f\xa6x\x12\xa7\x9dZ@!\xa1\x98\xe1W\xcf\xd6ݐ\xf6\b\xe4Md\xebz{{'\x00ˀ~d\x9b\x0ft\xef!\x02\xe0\x13\x91\xbe\x87\xc4\xd1G\x8a\x1f)}\xd0x\xe9\xb4s\xf1e\xb8M\x1cӰ&\x8e\x98d\x88c*9\x16\xd2\x05\xd9~h\x88c\xaa\xf8\x84\xaf\xe8\x8c\xe9\xc6j\x1f\xa7\xb90\xfcDq\x04N\x00\r\xc4\t\xa8{\x0f\xab\xa0Ƈ\xb4\xd1\x10\x8a\x0fM\x96v\xe0\xc4w\x93\xad\xf24\xa9\xcb\xd3Ĕ\xa7\xf0\\\xcaSHQ\x8a\xfa\x93\xaeƝ\U000794a7\x10@\xc6\x14B\xa7?\xc1ωŧJ\x9e\xc6\xe7R\x9e\xc6\xe7uy\x1a\x9f+y\x82\x9f7\x97\xa7I\xfa\x8e:\x18\x8f\x91\x01\x013n\x82\x1f\x13\xf1Q\xf1w\x17.>\x15\xc1\xe3\x1a-㵰\x88'\xd22\x0e\xb5\xbb\xfe\xf0\xc9\xe2\xa0>\a\xea\xf8\f\xa3T\xa8t\xb5S\xd1\xf5\x92\xfa\xe6\ri\xee\x810\xff\xd7\xe2*\xa0A\xa6\x1fҕ\x9b1]\xca\xd5\x10\x88\xb2\xa1(;\x11\xb7\x9eԾ\x84\x0e46\x81\xc6\n\xe8&k\x94\x81\x89d\x8d{\x84\xb5\xb9e\xd2\xce\xf3\x1f\xe5=\xe3\xd6mL\xbc\x02:\x91WA\xb5\xd6\\⍵\xd04B)\u0378\x8d\x9a\x9c^\x9e\xd5\xf6v\xbeټ\n\xe7\xb1\u007f\x19\x00;/\x89\x9d\x97gA\xe3\xeas\xc2(\x10Ե\x18\xae\x88\xb1,\xe2ےĠ\x8el܀l[\x17\x10Fb;\xa1\xc1\xd6\xed\xc0v\xb1w]\xdfݢK*\xbd\xbd\xb3'\xfe\x93\xa3\xb7\xd1\xde\xdb\xfe\x93\xb7ѝ\xdf\xe8\xcf^\x00i\xa7\xf1\x8b3\xca\xc7\xef'\xea\u008a\xb1;L\xc7\xf4\xae\x8e\xedn\t\u0099,\x8a2\\L\xf0\xc1\x10\x1e}\x18.\xb8>(\xb90\xb5\xa5\x1d\xa3|}$\xa4\xc5|\x87\xb7\xff:\xb4\x1c⌱\x91\x11\xd4\xeb\x13/\xab\x85\"\x828\xd9i\x9d\x9bTm\xd6@\x81%\xc9Z\xe0Ǻ\xcf\xc3\x02\x94.\x12\xb4\xff\x1f\xfeW\xbfA\xc6[\xff7\x98\x19\xdf\xfa\xc1~\xbf\x8c\x8b\x12s\x8fL\xce\x00\x82gY\x8a1\xdckԾ\xc6YdG\xde\x18\xa1\x91\b\x97HA\xb5\xb57v`\x17\x16qU\x01GD\x93\xf4\xdc\xecІ\xcfa\x80\xdeJ\x15\xabKX\xa6]\tv\xdf\xcc\x11\x0f\xd6\x19\xa5\x02/\xc0X\xbcB+Ur\xdd\xcf\xd1\xd0\xcc\xd1\xc6\xcc\xfb\xc4Y\xd1\x1b|C\xa3C\v\xb9\xaeH\xecjVJ\x05\xea\xff\xe7\u007f\x02\xc4\u007f\xfe\xe7\f\xff\x19\xd7o{\xcd\xc6?ia\xf4\xb9\xfe\xbe\x1eY_\x9c\x9a\x11\xcb\x04\xfb\xf4B\xfd1E71\xcfJԥ\x86K\xfd~W\xff~\xb7Ά'\x00s\xa4v\xba\xe8\x82\a\xba\xca\x11\xb7\xbbt\xfcH\xbfg\x1c\x8c\x83~\x8fٻ\xfb@\xb3\xfcn\x93#\xe2\xed\x19\xfd;\x0e\xb4i\x16Y\x90\xd0\xc9E\xa2\xf9\xcd\x12x\x0e\xb3\x00>\x89\x83Z\xa8\xca\"\x1c3Κ\x99Yc\x8e\xdbHY\xe3*ˬ\xac\xf21\xb9{\xd7p2\xc9\xe9>_\xa0{\xa1X\x10\xb3\xad\x10c\x860N!\x8dވ\xc2\xfc\xf2w\xea\vG\xb3\x98\x88K\xb4H\xa8\x83\x02\x838\x95>kH\x1f\xb7\x10~n\xae)\xean-\xb4d)8\xf85\x8a\x86\x14\v\x81\xb9\x11\xf1.\a\xb2\xf6\x12K\xb4Α8s%:\x87\x15kL\xdb\x03#\xc4\x1d\xde1\xdd<\xef|5\x1dL\x1fL\xa7\xe2\x02\n\xc6X{\xbf\x8aa\r\\rf\x18\x8f\xa3\xfb2\xf3\xfd*\xc4\xc4\xc1`:\xadJ@\xe2<\x84\xb6\x11\xfc}Ȉ\x0eeևU.\xab\xa8\n\x8c\xe3dƩw\xa7w\xa3\x89LM\x8a\xf7\x82\x9ei|8\x91(\xc6)9\xa9`\x9d\xf8\xbf*u19\x8f\xa30\x9dg\x8bH\x14\x1aO\"\x95͘\xb0DU-$^%Y\x8a1\xff;_=\bG\xe3x$\xb3\xf2\xec\x1a\x1f\xaf\xf8*\xbc;\nG\xa1L]\xe5\xe9\xe6:\xcb\b\u007f\x14\x8f\x1f<\x90|\x98\x84Q\\\xcaJ\xeeN\x1fơ$\fc\x92\x97y\xbc*\x143\x06UV6\xa1\t\x81Ѝ\xee=\x1c\xcaK?\x93,\xc7\xcb(؈\xfbӻ\x83*u\x01\x92{\x1d粦{\x87\x0f\xefƑ\x96]$\xe9%\x97\x9b>P\x8c\x9c\xe4ɼ\xf8\xff\xd9{\xf7\xfe6ndQ\xf0\xfc\xbb\xf9\x14m\xeefBZ\x14MRo\xc9r~\x8e\x93LrO\x9cd#O&\x19]\x8d\u007fMvSj\x8bd3lR\"=\xd1w_T\x15\x1e\x85G7\x1f\x96\x939wgr\x8e\xc5ƣP(\x14\n\x85\x02P\x85\xb18\xfe\xef\xa4\xdf\xd9\xdf\xd3\xe9\xcbx\xec\r\x1eLTN\xad\xe3\x1e\xcb05\x8e{V\xc6u>L\xd2\xf1\x94(#\xe8rضr\x85\xae\x81\xc4<\x81\xff\xac\x8c4\x95\x00\x0f\xf75]dN\xb8\xca\xedM|\x9ba+I\xef萷2\x8a\xaf\x85\xd0@v<\xee9\x88\xe7\xc3\xec.խ\x1d\x1c\x1c\xf6\xba\xbc\xc39>\xb2#\xc2\x1d\xf7-D\xf2i\xff&\xc3n\x9d\x9c\xecu\xfb}\x965M\x13\u0558U\xa5@&\x84\xac\xf4\xe4\xe4\xf0(\xe6Yi\xac\xb18\x1e\xf4\xfa\xc7\x1c\v\xb4\x1f+\xda\xef\x1f\xef%V\x170WQ\xb2;\xd8\x17\xff\xf9\xb9i8WH\x93\xdf\xe6yV\xc8Q\xed\xa7I\x87\xe5\x9a9p\"\x06!\xd9SYi:\x99\xd0\xc36A\x96\xce\xfe\t\xcf(n\x97\x86Kz\x8c}\xb2\x91\xc2\xf1\xf0\x04\xfe\xe3\xe9i =O\xae\r?w\xd2\x133=\a\xd94\x15\x8b8M\xf6^\x17\xfe\xa7r\x860?\x8cD\x1a\f\xe2\x81\x1a\x00\xa1\xcb\vuO\x13\xb9\xdb=\xee\x99z\xf3\xfeM\x91\xc5T\x87\t\x82\xebX\xa8:\xbd|\x9a\xd3\x14\x81\xffT\xceM^\xccLC\xc7L.\x02\xcb\x13\xa4\xe4H\x0f\xbf5\x0f\x92XH\x0f\x9d#\xa9r܆\xfft\xa2\xe6\xffc\xc3C\x98\xbaL\x87b\xaa\xe3\x14H\x06\x03ͭ\x8a\x88\x16\x98\x9b|\x9c.\x93\xf4^\vV\x9313#xx\xd2S24\x1b\x83\x97xɿ\xfd\xe4\xa0\u007f\xd0g9\xd7H\x87}\x98C\x8ar\xd9]>]JZ\x1b\xf0z.\x0e\xda\xe9\xe1\xb1\x021\x8c\xef\xd2q\x92Nq\x02\x1c\xa6\x87\x83\xd8\xc9\x10\xa3]\xdcH`\xed\xc1\x81ν\x1fkz\x1c\xf5\af\x1a\x0eS1\x9d\xc4,\x1c\fhV\xc1xk\xc9>\x04-O\xf1O\x9c$\xc7\xe9!\xcf1\x92\x94\x13\x8c\xb2\xa40K\xb9\xf8\xc3\x1c=\x8af\x10\x04\x87\xc5I\xd7*$\a4ك\xff\xec\x1cً\x93v*\x18\xda\xc9*\xa9dƩw\xd8\xef\xf0\x1c#ND\xbf\xdbZ\x9cP\x1e\x93'\xddv\xaf\x1b۹f\x92\x1e\x1f\xf5Ӂ\x9d\xc9\xe5\xc9\xd1\xd1\xf1\xf1\xc9I ;-˞\xa5\xe9P\x01\xef\xb5\xfb\xfbIʳ\x19\xe1\xc4\xffRC\x83Q\xaaV\x9c6Oԝ\x10\"6\xd93t\x1eS*\xcco=\xaeL\xce[\xb3X\xa8\x1c9\xd1\xe9\x98k\x06\x10\x11a>\xb2u\x92\xc3\xc3~\xa2IE\x05\xf8\x9a\xa7\x99\x8b\xb2\xcc\x12Ћ\x0f\x0e\xf4\xb0Q\xe6d>\x9d\f\xb1\xe6\xc9\xdeQ;\xe9Y\x99|x\xf6\xfa\xbd\xbd\xa3\x8e\x9d\xcd\x05\xfeQ\xef\xf08M\xed\xfc\t(\x88LH\f\xc4bh\x95\xb0\x04\xfb\xfeq\xd2\xd1\v\x14\xe5\x93hW\x13\xfd\xa8sp\xac\xa6\xdb(K\xc6|\xeatN:'G\x9afb\xb7\n\x97*GR)\x1bh\xd6\x19e\xc5l9\xcd\v\xad\x97\xa5\xbaKy\xbf\x1f\xe3Mh\xca詖\xc6\xf1]\xfc.g\xd2:I\xe3\xc4\xe4-\xb5\xa6\xa1Z\x17\xb3o\x88\x0fvE\xe1dp\xa0\a\x1e\x17q%\xfc\xdam\x9e\x9aL\xe3\x1e\x0el\xef8\xed\xaa\x01\xe2\xabz|`*`\xb2\xa4\xc9`\xb0\xcfs\xd4@'\xb1\x18K\xd5\xee$\x1e\xa6\x96`O\xd3\xf4X\xb3\x0f\xe6\xea\x19/\x16\xf5\x93c\x96c\rP<\x105S\x96k\r\x8f\xd0g\xdaz\x8d\x9dēx\x19\v\xa2M$=\a\x89\xa2\xe7$\x8d\xfb7\x93\xf9` \xa9\x19\xf7Nt\xcetN\x12\xfd\xf8`OM\v#X\xfa\xed\xbeb\xcf\xc9p\x8ec\x9b$q;Q\x83!\xf6_\x89Y\x8b{\xed\xd4\xcc9\xc3\xe5\xc7|\xa44\x11\xd9|\x13̱\xd4\xea3h8ZǙ\xe6\xcbXˌ\xfd\xce\xe1\x89\xe6\x9dB\b\xeea\xaak\x1d\xf7\xf6\x0f:{:O\x8b\xbf\xf8\xb8}\xd4\xd5\xc9\xe3Ĵ3؏\xf7\x0f\x15\x06\x96PL\x8f{\aG&\xa3\xb8I\x87R\xb3\x16\x9c\xa5Ƣ\xc8\xd2\xf1\x18%J\xdc>\xe8v\x13\x9d<\xbc\xa3uL\x90N\xfc\xa7\x92m\xb1\x9a*\xa2Z\xd3\xf90>0\x8b\x94-k\x05\xfd\xf4\xaa`\x8bY+g\xac\x05h\xac\xa7\x9f/\x11\xc4\x06A\xe5q\x91\xbc\u007fx\xdc\xd5\xcb\xfd\x8c\x16\xbaD\xa4\xe8Uz\x96Һ\xd8\xe6\xeb\"\xec\x1ag4\xceB\xf9\x1c$\x8a\x93g\xf9(\x9e\xe5R\x8d\xd8\xdbW\xf4\xb4\xa5\x8f\xe0\x97D\x012*\xa5\x98*]M\xe7\xfb\x9b4\x9e\x91L\x11ۧ=\x9dj\x149\xb3\x12cj1\xcao\xf5\xceP\xab\n\xf6\xea\xa29\x8f\x92\xcd\\\x8cq)a\xf7\xf6\x99\xc1p\x8d\xf8]Vy\x1d\xef\xce2t\x99\xd7C\xae\x9d\xaba\xb9\xecu\x8c\bp\xb6،\x86\xebZ\xe2n\x1cK\x1c\x1cs~.\x1b\xab\xdf\xc0\xc9\xd2\r\x1cR\u07b4\x86aK\xdcM\x93\xd9)\x14\x96\xb2(\x80\xd8\x11\x05v\x04\x80\x9d\xa1g/\x10َ\x19N4\xbe\xca\f\xc7+=\xae\x19\xce:\xf5$\x1bǍ\xb4\xb4\x14\xf2\xefP{\xa7z\b\xa1\xf3Q\xecP!d\xb4qiX\x8e\x8cc\xa0\xf5mK\xeal7\xdcS\xdb\xe9M\xdfe\xb1\xfe\x87\xb0X_\xb1X_\xb3X_\xb3\x98[\x1aN\x8c?W'\xb0T\x03\x8e\xca\xe1\xcc\xf7F\x9b\x87U^]\xfaL\x00~\x84sF\xf9\x8d6\xec\x9bF\x03Lh7`?\x83\x9a\x8d\x00\x1c\x80\x81\x1c\xdb\x0fsl\xdf\xe3\xd8\xfej\x8e\xed\xaf˱\xd69\xa9=.}f\x1f\xed\xb4ۚ\x1fwT\xef\xff\x1b<\x8b\x952\x95m\x83\xb40\xaa`\xdau𡗢\x12\x9b\xdd\x0f\xc6f5\xd7\xca\x13d\x17\x1bɵtZa\xfb߶ \x0e\xc9\xc7\xf5\xba\xbc;\xac\xe0\xc6\xfa\x108h\b\x1c4$\x0e\x1aVp:\xaf\x01\xb3\xa7u\xc3\x04*\xf2\xea\xd0\xf0\xea\x90xu\b\xbc:\xe4\\^\xdf\x11\x00vD\x8b;\xbe\xfd\x95\b\x0f\x0f[\x9c\xc4_Db\xeb䠽\u007f\xd4TI\xbf\x92\xa3\x0e\xf9\xf5\x0f\xf8j\xb5\x8f\x8f\x8f\xf7\xec\xaa\x0e\xb7\x8bޯ\xe2v^i]n\x87~}\x10w\xcb\nq\xd00na\xb4\x16\xb7\x1b|\xb6\xe2\xee\xf5\xb1Y\xc9\xed\xea\xd6\taP\x0eX\x85\x88F\xc7_\u058b\xb3\x80K\xc2\xd6o\xf3t\xba\xbc\xc0\xb2\xf9\xb4^\xa8\x83H\r\xe0\xe5p\xb8\x19\fQ!\x00\xe6\xa7<\x9f\xf1\x97tN\x84eV\xf258\x91Ie\x1cMS\xb9\x85\xcee\xd2B5#\xdf\x11\xb2\x02\xf7i\xef6\x93\xd5+\x8a\x8d\xf2\xf7\xab\xcb\x14+\x8b\xe4N\t\xaf\a\x96\x1b߱P\xc4\xfc\x11\xb5:,\x9d\xd6CA=\x94\xca鉐 \x17\xd9\xfb\xf7CrNXS`kL\x83\xac\x1en\xdd*\x81\xa1<y\x15\x94\xf9;;\xb3\xa1U\x8c\xbd\x03\xb05\x1f\xc3\xd9\xcd\x05x\x15\xe7M4\u0080\r\x85dugp\x9dX\xd9TI\xa0\xc0E\xd0\xe5\x95\xf2ūr\xab\xa7\x8f.\x06\xc3g\xf9\xf2U\x19\x968\v6+筟\xd1\n\x90_\xf6ź\x9a?\xef\xa1\v\x0f\xed\xfc\\~\xe3/\x88*ތ\xe47|\x9cY\x8eo\x04\x0f(\x90\xd1\x13\x87\vt\x86\x8d\xf7\xdbB\xcfk\x85\x8c\xe34\xfa\x1d\v\xc7CrE\xbe\xa6\xa2\x009V\x94\x1d\x8d\xbc\xbc\x17+?\xb1+\xe6\xfd\x97L\x05\x17\x8a\xf0F\x96\xde`\xd7UIt\x8d\xf5\xee\xaa\xc1\xb2\xcf\xdc >\xdcq\x19\xc12He~\xd4\x1e\f\xf5E\xcd`i\x8cT\xc1\x9f\x02\x19\x94$ڲ\xb4\xa2\x89\x9cxH}\xf8\xb7\xf5\xf6m\x12\xcf\xe2\xb7o\xd1-\xb2\x1b\xc5E\xd5\xff\xcb_\xa2\x9a*X\x83\x1b=c\fV,\xb35\fX\x18\xf9we\x8c\x05\x1bS\xeb]](\x8eq\x90\xbb\xf5\xb8X\x0e\xab\xcbY֝\xe3\x1bq\xed\xe3r)\xae\x1c\x8f\xc0\xa8\xba\x92\xc3u͏\xc6Z\xfe\x94P\x11\xfe\xeb\xebq\x99\xc5f\xe194fs\xe5#pC<\x9bM]O\x03\x8e\x9db\xb5\x9bl\xc6\x05\xe4\x83Ϻ\xabe{u\x92\x84\xc5\xc1\x84\xdfu\xdfS#=\xaf\x97>$\xc9\xf7\x81\x17\xd3\x0e}\x16\xa0OEp` \xfd\f\xe8g\xf4\xdf_\xd4ɩ\x018fl\xb2\xc2\xe8\x01\xc1-m7\xf1`\xb3\x13\xf8sɨ\xbd\x06a\r\xe6Ѻ\xc5\xc9@De\xab\x81\x82\x97\xf8\xfb\xaa\xe1\xde\xee\x83ꁗeUP\xf9x\xac\x1a\xcb\xfe0.\x8a4\xf9\x93\x87\x93\xa6Y\x9d{\xdd\x14UG\xf5\x06yϯ?\xfb\xe7\xef\xff\xbb\xd8yv\r\x9boy\xb56\xb3\xbc\xc2q\xb7;R\x8cbϾˊ\x99=\x0f\x831\x00\xc9\xcfU?\x1f\xcf\xe0\xd4\x15\xf1\xa0`\x82ꂦ\xf1@X\x15\xf3\xc6j\xfa{\xe6\x8b\xd4BP\xf9_0N{Ue+\xfbl\x15\xd2\xd6\xc0\xcbq\xfc)\xd5\xc8\xd3\x15C\xc9\x05e\x1dq\xa7\bwַ\x05SK,\x1e\x9f\xaf\x15\xe0MX\xbb\x98-\x87i\tc\x83\v\xa5,\x9f\xa2O}7\xa0{\xc8\xff\xa9\n\x12\xbaW\xce\xf1OJ8\x9e\x05\x1eU\xe3l\\\xfeI\xea*dV\x12\x18\xfb\xa4\x8bK\n\xab\xcf+\x8b2%Զ\xc3\xfcH\xc4d\xa9\xfbl\x9c\xe4\xf7 \xe7^壉\x90r\xc9\x05\xb6g\xcfT`Y(\xa3\xdc\xdbb\x10\x18[\x1e\xea\xfe\x98\xbe\xae3\xcaԻ\xf00\xad\x1a\xed\x89\xc4\xe6#K2\xde\x01\xa2\xc8%\x94\xba:\xdb|\xb2(\x8c\x1f\u007f\xb6hțL\x17\xd7E\xbcE\xb6r߶\x1d\xe9\xdbVR\x03\xc1\xa0K\xf0\xf1L\xfa\xb7%\x04%I\xe5\x14\xb0\xd4=\x01!\xf0\xc2\x1a\xe3\xe7h\xa1H\x8e}\x00\x1c\xb3\x81\x1b\x0f\xbd\xe8\x16\x86\xb5|\x0eU\xf5C\xeeZME=%\x0f\xca\n\t\x95\x1fh=\x00Qsr\xf8Ex\b\a\xe3\xdf\xf0a\x15\xf9]\a\xf1[\x92?\x1b\x8f\xd3\xe97o^\u007f\xf7\a\x13ߴ\xfb8\xa4\xe7\xf0\xd6!\xbc\xd5\xfe\x06d\x17]K\xc7I\x99\xdf,\xedg\x9e\x8a\xf9.\xc6Ȱ\x86\x99\xaf\xc4\x1a\x9d\xd4K\xfd?\xd1 )G\xea\u007f\xfb\xe9[\x9a\xf0\xb6\xb3<\xa7=Q\xed\x03Z,\xd1f\xedw\xa8+\xb4g\xde(Q\xafni\xd1\nO\b\x87\x88?W\xd1[\xa8W\xe9t\xe6\v\xe9^\n\x97\xe4<\xbaS\xf1\x12*P\xe6\x17Xss\xc23+\\\x9dZ'\xfbhɈPc\xa5#\xb2\x1e.%C\xb2\x1e*\x1f:V\xaa\a\xe0\xb1\x11\u007f\xae\x1a\xabi:\xca\xef\xd2U\xef<R\xeb\xc8\xda\x16!\xb4+U\xba\xbeo\xc8AO\x98\x98ڐee\xa3\xc4\xdaH\x835'2\xec\x90\x03\x0e<!\x86\x9b\xcbT=\xa1\xe6ԥY\x02\xff|)\xea:ϭ\xfd\x8d?\x19\x14t\xf9\x92\xb7\u007fcQ\f\x94\xa4\x0e\xf7\xa7)\x13\xe7\x13p\xb5\x06\xfdW\xe6\x91\x14\x1e\xe0X\t\x8blƿ\x8d\x11\x00\xda\xe4dc\x1d\xe3\xfb*\f\x9b\xf9\xb3\xf6\xf2k\"\x88\xaaИ\n\xb2\t\xa4\xf9.\xd0-G?\x95\x9b\xad\xb0\xe9#\x8a쀢\xc6|\xc1\x8c!\x01K\x86\xbb9\xb2\xd1\xc7\xe7S\np\xc3u\xef\xa3\xe9t\xf9ng\xe7\xca3y\x94\xf8\xf9qZ\xb0c\x93\xa2Q.\xec\x9c\xc7\xf4\xd0D\x06\xfc\xd9\xf1\xac\xfcPE\xb1\xd1:\x14\xd3c`\x06\x9c\x8f\x8c\f\xbe\xf6!Tc\f(#\x9d\x1b{\x11\x83\xc1c\xc4:\x0e\x8eB\x06KΙrt4[S3f\xb4\xa8U}\x8aP1V.\fK\xc1\x85\xf61N\x8dj\xdfA\xd3\xeb\xe8*\f>)e\x12\x92G\x1b\x8f\xb87GV\x8cUA\xf6\x862.G\xac\xd5\\\xaa\xf6 \xe5Q3\x84]\xdbEϙ\xaegNV\x80\x19C\x8c\xd8\bL\xb8\xd5\xec\x12d\xcb\xffc8\xcaa\x13zP=r\xdcʭ\x8f\x1f\x1f\x02\v\xc7\xcd0\fb5\xee\x84\xd0Z̈́.\xe6\xfe\x80\x06\xdbV\u007fM\xf5\x16մA\x9c\xf9\xc5,k7+jgh\xcc\xedd\xd7`n\xc1'\xf9n\x9a\xd2$\xa6V(\x9b\xb5\xa8\xf3\xa11YY5\xdbp\xa3\x1c\xf33\x05v2Qr\x92\xf7\xc4\xdd\xeeq\x15\xc5,\xf0\xf4jz\xec\x1d\x9d\xb5\xaf\x94\xb1\xb4\xb1^\xbc\xf0\xca\xe3\vl\xd1\xcc\xce\xd0!U\x85\x1f\u007f\x97\x10H_\x97\xc51\xb1~y\xa55%\xa7\x00e\x01u\x03\x19\xde\xd1Rh\xafk\xbaTN\t\xa3\x1c*BfʮƖ\xe9\xa6\xc7E\xe1\xa3\xc0\x87\x90\xaf\xad\xad\x1a\x0fz\xe6\x93L\xa9\xc8Y\xee\n\x1bKX\a\xec\xaa\xea\"\x9bU\xd7\x14\x05B\x91\x97\xa9\xfej\xa5|>*;!wCvoj\xf1\xd0V.s\xb8\xaaB*\x85\xf28\x1d+P\x1edC\x87\x98\x94\xb2\xde)g\xd5a\xa7\x84\xec\x1eu\xea\x06\xad\xc9@\xa9\xaa\xed\xc0\tg;p\xc0)\x8f7\x85J\xfc\xe7\x1cŷCǥR\x1b\xca8R2\xe0\xad'q\xfe\xf2\x17I\x8e\xea#Ъ\x93p[\x8b\u007f\xf4\xb3O\ft\x1e\x9a0\x1f\xe1\xf8\x99\xd3\x11\\\xb1\nR\x90\x8d\xd6\xdeT\x9dEʧ\xf3\x86G\xd2X\x00 \n\xb2\x8f)\x8a\xa4\x94\xee\xf0u\x91\xf5\x86\xe8u\x1c>\xb8\xa0\xb3L\x1dr\x88x\xc0G\n\xf5\x8e\x88\xae}\x10\xad\xec\xdbU\xc79\xb9m7\xea\xe7#\x81T\xcc. \x98\x14\xefڀ\xa8\xfbJ\xe7V\xda37\x1aG9\\\x88\x1bG\xc87\xc8 \xdf\xd4WrWE\xb8;\x88\x829\x11\x00\xd3\xc78\xb1\u009bKk\x9cX\xa9\xc6\xed(l\x9aN\x12#8\xea\xa0PY%\a\x120\\T\x94\x02\xc0]ʯ+\x16\xedn\xdbC\xab\xfa\xd8>K\xbe\x14\"\x1f\xec\xda;\xd8\x12\t\x95qK+*\ne\xabC\xeb\x1c\xaaT\fȪa\x05`\x16\xeb:\xe1L\x03\x12\xe9-\xd9\xed\x91E\x8d\xa9\x14'\x1b8\x16\xf7\x03\x94VIL\xa8\xb0\xa6\x19\r\xa0T\xa1\xaa\x9b\xe3sȜ\xa8\xa1\x03\xddȝY\xfe|XE\xb0\xd1\xc4>\xc9s\xfd\xdb8WA*@I\tXڡ\xad\x16\xd5j\xb9\xbd\xc12ȮC\xf8\x1b+\xb3\xabV\x17X\x988\r\bQ\xb5˪:\xe4\x03\x97\xb0Y\xd9\r\xc8uop}\xbc;\x81\x8fv\x1f\xcb\xd6\n\xfcu\xf0s\xcf\xfaA\x97|\x9a,5I\x87\xf1҄\xba@\xb2}\tiV\xa1\xf9\x14cG\xbb\xe5d2\xbb3\x12\x8e\xdc\xed\b\x00\x03\xc1\xe8$M;\xe3\xdb\x04\xae\xe2\xee\xecX\x89ߋ\x05\xf7ۤ\x19}\tJ\xfd8\xbf\xaf7Jny\xcb[\xad\xde\x16\n\xbcz\xc9c\x8b\xe8*\xbaj\xb8<\x04\x95\xc0\x1f\xb6\xa5!Z\xf7\x9c\xad;\xb2U\x17\x05\x95\x1cp\xee\x00\xfa\xbe\xb4\xac\xc6ՙ\x86\x81v\xeawA\x03S]\xb0o\uebb8\xc1\xf8A\x88YW\x12}ܼ[~\r\x8e\xa1;D\xb4\x19\xae\xbe\xc3\xdc*\xdfE\x87\x8b\x96^ZvZs\x87\xde\xc96g\xa6UG\xaa\xab\x80\xe8\x83\xc0\xaas\xc2U@\xd4:Q\xb1\x8a\xac\x021v8\xd9[<V\x01x\xb4\xeb\xdc\xf3I\xd9&r+y+\xc1\x05\xf7qf\xf3\xfe\x81[\xc42[\xda\x1ftC[\xf6\x91\xecRe\u05f5]\x1cW]\xdd\xfe\xb7\xbc\x97\xcd\xe3\x11e\xfe\xfb\x06g\x89\xa0ՀT\x11w\xed\xf0\x13e8[\\\xda*\xb2\xe5\x8a&Jt\x0fJ\xca|\xa5#\xe6A\\\xaez\r\xc3\xd2\xedʐh\x8df`)\xa5\xa9\x17ƥ\x19^R˪\xc8l\xa7\x96F)\x8c-\x8fN\x95\x05U\xe1r=\x99G\a\xf3t*]g\x95E\xebso\x84>\x8f\x8c\xe0f\xfa\x00,*\xe6\x01˩\xbf\x0e\xf1\xb2|\xed3\xe9\xee\n\x10\xe8v\x05A\x1eM\xd2\xfdO}\xb1\xf2\xa8\xaf\x05\x82֯\xca7'-\xb6\xbb\v\xbc\v\xf0l;\xfe\xa3\x93V\xf5˓\x121\xe7J5\xff\x8c\xefT\x8f\xad\x95%\x15h\x04\x9b\xb8\x8a3W\x9d\xa9\x84\xab2\xf3\x00R\x8f-l\xc3z6]\xe5I\xe4\x8fY6J\x1b$ɤM@\xfcj\xf8Q\xed\xb2G\u007f,#\xa9Y\xfc\xe7ٌ\xa2\xc4\xe6\x13\xe2l\xad\xc77\x97\xf6!\xaa\xeeح\x8a\xb7`\x14\vN\x91[ѕ\xdc=C\xaf\x9e'\xceL)\xc0\x15\xb5S`\xc5|Yg\xc6\xd8s&x?\xe0\x8f\x9e\x0f\xff\xbf<Qy4\v\xd1\xda\a%ի\xc3c\x1d\x99|D>y\x94ge\xa5\xf7\xef\x01\xfa\x9b\xfb4\x1d3\a93\xf8\x96\xde\xe1\xd9\xed{\xef\x19\xc5\x1a\x97\xf0\rxBہή\xf8\xafG\b\x84\xe5R\xe3\xfb\vAa\xc8\xf0\xef'\xeb\xe6\x13\x8c\xd9\xcb\xef2B\x8cO\xacE\xbcB\xc6\xd9\x04\xcd\xc2\xe4\xa6\xd7{\xd6\xe6v|\x80&\x10ե\x9f\xe8Z\xe8\xe7\x91t\xfd\x8b\x9f\x0e\x04\xf9L\x04.l#\xaf\x06\x1c\xdcGꦪ\xd3z3\x1a\xd4g\xec\x04=|?Z\xf5\x17\"\xeb~P\x8fW^\x94ފ\nUw}\xb7\xa1L)\xbc*j\xa1q\xb9\xec\xd2\xf0\xf7\x17\x81S*\xecY\xbd\x06\xd4m\xc1\t\x8ab:\xfbη\xa1=\\\xfbV_\xab\xf5\xa2\xff\xf1ﱰ\a4\xcd\xcc\x13\xac\x90\x1ca/\xb2t\xbf\xdc{\x1c\x95\xc7\\k=\xa0b\xe8\xac\x14:\f\x91\xb5\xc6)(\u007f\xa4\xf4\xf1\a\xabD.\x8b\xd1\tt$\xc0pؤ\xe68v\xe2\xb5\xd1ܮz\xbeV\xfdnm\xa3\xa9N\xc8҄\xff\x91\xbf\xb4\xdadn#\x8c\xc2 \xc3D\x9f3T|Z\xaf\x1c\xbbu\x1eqq\xc2C\xf9Z)\xbd\x03O\x9a\xaa_\x12\xb1kRf`\x1a\xea\xd9Ϻ\x9d\xd8\xe0\xddA-\x1d'̾\xc1\xbb\xe2\xbcD\xe0w\xe7\xe9\xf8\xf0-k\\\xec\x81a\xf7=\xf1\x9f*\b\xd5i\xb2ޣ\x84PW\x12i\xe3\xaa\x1c\x90\xb2s\xdf5_m\x85N\x85\xcd\x16B?\xe2\xe2\xb7\xf2]}\xd19+\x8e~W\xb1\xa6\xe1\u052an=O\x86<\xe7H\xba\xaaYE\xa8Ք2ֽ?\x87X\xa6}\xfdn\xa3\xd3\u070ev\x8d\x00\xf1<\xa0Xp\x05%\rN\x9b\x11\xb3\xfa\x987\xf0P\b\xe0(\xbe\xd6fCw\t\x80X\"&b\x8c\xa7\x83b\xf9\xaf\xb9$iF\xb1\xff\xa4\xb0\xe7\xc9\aG\xf8\xf2\xe7\x83\x18_\xb3V\xa3x5\x964>\xa5\xbc;\f\xd4\xcd#f\t\xf2\x9a@>\x9e\xe2\x88 d\xe0\x1f\x0fE\x1d\x83^\x00\xee\x05\x00\xf7\x1a\xa1\xa5؊\x05ę\xce\"\bF\xfa1\x1dS\xbd\xf9\x1e>O!\\\xca\xce9F!b\b\xfa\xd6\u007f\xd6\u007f\x88\x90ųĞk\xfa6c\xe6}L\xe8-\xd1\xe2\xff\xaf\a\x96\xf6\xdb<\xa5\xcb\xc3\x18\x95\xd3ԅ\xbe\xde\xc5<\t\xfe\xcd\xe7\xea`\x17\xd3BW)\x9ad\xb8\x00\xe5\xdalM\xcaU\x02\xae\xb0\x857sJ\x82\xf0\brO\xb2\xe2\xebl\x9c\x89\x81\xc0\\\xed\xd2\xc0\xbcBEf5\xe7߮&\xacзhs\xa9\xef\xb3d\xc9\x15\xb3/`Y\xc1\x00\xf8\xa3\xa5\n\xe1\x10\xbb\x17H\"YH\"\x00\u007fά\f\xd5\x1b\xfck\x05\x17-E\x05\xa24\xed\xb0Q\xbd\xe2x\xab\xf1so\x00\x9d\xea_MF\x93S\xfcW\xa5\xa8+\rܸ\x04w\xf5N\x1d\xf0\xfc¯r6a\xb3\x89\xe9\xbe\xcb.\x02\xb5\xfe0\x8d\xa7o\xe8\xb3\xee\xe6\xeb\xf9\xee\xc1\x04w\x82^\xe6`\n\xba\xac\xfe,f鄍\xed\x03g\xce\xd6\x00\xbc\xf8\x97\xddl\x11}\x9e\x14i\x02b\xfb\xde\xe2\x14\xc1\xb8\x1d\x8f\xc0g<\xf4\xf9\x8c\xc5z\x95`p%\xb8\x8fvE\xdd\x16\x1fs\xd2/:4\xea\r\xc8UH\x89\x9fj\x80\xea\x12\x88yCݡ\x020\x16\x9cq\r\x15\x00Hݗ\b\x86D\x02\x80T\x80\xa7\xa9\xe8A1{9\xceF\xb8||\x8dٿ\xff\xae\xf2\xc9\xfb\xddO+J\x8d\xf2\xf7\xab\x8a\xe4+a\x14\xa5%J/d\t\xa5Xq\x8e\x110\x9d#ki\x1a\xe5\xf3¾\xd5E\xae[ҩ\xaf-`\xd9\x1fs\xc1c\xa6\x14ʸ\xf4N\b\x9e\x8b|>\xed\xa7\x81\xdb2X\xedmo~\xbd\xbf\xdf>ރ\xc8y\u007fO{\xff\x9d\xcdd\xe8\xb6q|\x97]\xe3eRQl\xfa\xf2\x1a\x9f\xb0~\x1e\xedv\x840o+\x9e\xcc\xe7\xaes?\x86\x81\xcc]m\xda2`\\\xa4U\v\xf6\xbeJ\x16\xff\xdc\\9QMal%\xb3+\x81TGK\x062QCa\xb2Q\x1d\xed\xe6\x03\xb2\x85\xb8\x12(e\x83\f\xa5+\x16`I\xceڎ5\x94\x1a#HE\xb7Z\xf4%\x1d\b\x9a\xacW6\x95Ђ\x90\v\xf1t\x85\xcb>(\xf9\x96J\xd6/a\r\xecDb\xf7m~\xd9\xe1\xee\x9at\xfb\xb3\xe1\xb5\xde\x1a\xe6\xd7k\xb5\x94_\xd7\x1d\x04\xeb\xf2\x1d\xb7ʟ\xf8\xd7{T\xd6\xd7\xf9TL\a\xb2\xcb\f\xf0w\xbd\xd6j\xa75}\xebJ\xc3\x00\xc7\xcf\x1c\x9f\x85\x83\x90v\x0e\xdd\x11\x1d]ؗ!\x15\x90\xf1* \xbb\x16\x94\xddE\x80,\x0e\x842\xb2\x00\x8c\x00Y:\x01\x88\xc5o\xd3Y%HӲ\xe0\xf4t1\xc9ǂ\xa9\uab43\x00\xac|\x9ad\xe3x\xb8\x0e\x86\xb2h\x1dN\x19\xf4\x02~\x1a\xd50\x00CM\xad\x89\xb1`M\xc1\x9cѕ\xbfϓM\xf6\xc1G~>]v\xda\xebtB\xb5\xdaha;\xb0\x9c\x19\x00\x15л\x1f\n\xbd[\r\xbd\xf7\xc1\xe0{\x95\xf0\xfb\x1f\f\xbf\xef\xcd\"\x8b\xf2\x97\x10&dpt\x04q\x05dl21\x8f\xe0g\xb7\x1f\xb7\xbb}\xfc\x99\x1cv\x8f\xba\xc7\xf8\xf3d\xff\xf0\xa8\x97\xe0\xcf\xe3\xfe\xc1\xe1~\x0f\u007f\xa6{GG\xfd.\xfe<\x1a\x1ca\xf8\x02\f\x14\xd1K\xba\x94\xda9\xea\xa5\xfdA\x8d\x82b:xt\x03x\xc4i\xff(=vQ\x1a\fz\xbd\xa3c\x17\xbb\x93\xe3dp\x1c\xbb\x88\x0e\x06'\xc7'\x87.\xce\xfd\x83^\x1b\x82n\xd8\xe8\xf7\xf7O\xfa'\xfbnO\x06G\xbd\xc3\xc4\xebT\xff\b\xfes\xfb\x97\xf4\x92\xdeq»\x8a\r\xa7I\x9c\x1e\x94\xf5\xba'\xbb\xbdw\xb2\xd7;:\xc1\n\a݃\xfdx\x0f\u007f\x1e\xf6\x0e5\x98\xbe\x00D\x148\xdc;:\xd9;\x91\xf8\xc7\xdd\x03j\xbdw\xd0\x1f\x1cʮ\xa4I\xef\xa4/\v\x1c&{\x1d*\x90\x9c\xa4\xb2Zzԋe\xb5\xf4\xa8ߓ\xdd>\xde\xdf\xeb\xcb\x02q\xb2\u007f\xb2\xaf\xc8yؑpӣ\x93C\tW\x8cQ爐\x8c\x0f\x0e:\x12B?=L$\x91\x93T\xe0{X\xd6\xed\xbe\xeav\xe7\xb8++\x1c\xf6b\xa8@$\xeb\xc7)!-\xd0\xef\xa5D\x81\xf4\xf0\xe0\xa0Me\a\xc9q\xb2ח?\xe3T\xa27H\x92vL\xbd\xda\xeb\xc4{\a\x84\xd3\xd1~\u007f\xff\x88\xe0Ɲ\xe4\xe4D\x92\xe8(=\x81\xb8\x1fP\xe0\xe0\xb0\xd7\xebȆO\xe2\xfe\xb1\x1e\xd7Dr~,\xda\xe8I\xd2\xc3\u007fT\x16ÝI\xca\xf6t\xb7O\xe0\xbfZte\xcd\xe5\xdf\xe6\x10lq\x98\xae#[UY\x14\xae\x97W\x01\xc9@%\xdeo\x00\xed}\n\xae\xb4;f)\x0f@\x9d\xddL\xd3\xe2&\x1f&\xeb\x80Յ\x85\x9a\xd0:\xe0JB\x002)2\x95o\x114`U\xb6\x1e\x86wwmi6w\u05edx\x1a\x14\x8f\xe6\x90k\xdaw\xac\x98S\x105\xe8\xea\xe8\xa78\xc9\xe6E\xc9K\xa6f4\x85\xfd\x84P\xa1W\x16\x8c\x01b1\x8b\xa7BE\xbf\x1e\x96\xb9z\"\x8f\xea\x02g\xa1P\xf6\u007f\x18\f\x84\x8e.\xb2\xa1\x8dt\x9cl\\1\x01W\x02uQ\xfd94\x0fvWL\x89\xdb\x12\x9d\xb8#\x81'q\x03\u007f\xed\x8atP\xae\x06\x98&\xaa\xa1\xbe\xf2\xe3\xb7\xe0_\xaa\r\x0e\xa6j0\a\xfa\xda\x11K?/\xeaX\xa3\xd0I\x05\xc4e\x87\xa4~\xc7*ՁR\x1d\xabT\xc75H\x89&_\x9c\xb3~\xbc\x8e\x17\x10\x8b\xb8\r\u037fn71\xb0p\alN/\xcdO\x96\x1a\xb5\x05\xfbv\"*\xb8\xbbvIJR\xf0ۦV\xdb\xd4j\xebZm\r\u007fݒ\x94\xf4\x0f$\xdf\xc7\xee\x05\xb6\"\t&S\x9f\xc2x\xb1\x8aOa\xb0\xaa`b\xd8\xd9\x01\xa6w\"\x03\xa4\xe3\x00\xc1\xef\xefd\a\xed\xfc\xb6\xce/%\x0fk\x84>\bH\xdb\x01\xc2I\xf7\x87\xf4\xa7\xddlרMϧ\x80\x91\x06\x11g\xd2oMz\x93K\x02\xab\xd0\x0f&\xbdɤ\x80U\xe6B'7\xf5l\xb7\n|%\x13\t3\x91вq2\xe6|'`\xbe\xef\xdf@N8V_\x9bU\xdcn\"\xd4|*\x80:\xb3U `\xbdW\a\x84\xec\xfeo\x8e\x10\xab\xafZ\xf3H\xba\x11B\x16\xad7\xc7\xc7T\xd7\xd7\xf3\x9d\xc1\xdb\b\x1b6\xac\x9b\xe3\x92Z\xc3\x1f9<\xb2\x11\x1e}\x01\u007f\x9agI\xd8!\x81\x8aw^_\xbd\x00\x8a\xa9\xb2z\xf1kDϢ\xaeH\x00\x90묀+\x96:\x04\x17X\xef\x9c~_\xb2ŧ!&\xf8\xb4ɖ\x1eL\x88\xaeB\xfe\x16$\xbd,s\x02o\x06\xee+\xaae\x11\xfbe\xafW\xe7\x02\xb7\xa7z\xdd܍:\xe9\xee!SE`\xab\xbeB\xc7\x11\xb0\xa0\x14\xecΔ\xa6\xd3\ba\x04\x85\xbeՖ\x96|\xea\x85\xf5&\xb3\xc0)/\xff\x1d&\xd1\xe6\xbbF\xf9\xbb\xfda^\xa4I-P\xee\x15\xe6\xc8\xd2`\x0f\xde%\xa7yv\xd9\v\x91A\x8f\xd4y\xc9x 0\xf3\v\xbe\x84d\x19P;.\xb2\xc2*\xf0\x05\xa4H \x98\xbb\x9bO\xd2q\xcd/\xf3\xc3DY\xdae\xb9P\x1f\xb0$\xefBo>N \xbe\x1d/\x83I*\x8c7m\x8f\xad\x02\xafd\xa2lM\x95\t \xa6J2\xdct\xe9\x10z\xaa<\xc7p\x94\x8f\xf3Y>\xb6q|-\x13y,\xbb\xe0\xf8\xaf\x13\u05ce\x8eYE:9D;c\x89\x84$\x18`%\xbe\xff\x8f4\xc1\x82\a<\x13\xd1\xce\xe1@\xa4\xf2\x17\xe9\xfb,EU\x18U\xf2\xae\x98\x18{\xa0\x99\xe3\x9f6Y\x05C5\xba\xaa\x86,\xda]Yc\x8f\xd78\xb4\x9a:4\x1b*9\xd1ZS!\x96\xc2\xd6*腜\x8bΜS\xbf\u007fºR\x9ajh\xb8\b\xe1\xd7\x02O\xe3\xd2Y*?Y\xc1X\x8ae\xfcX\xda喖\xb0\x81$˔\xe8ͩ\xd64\xbdK\xa7\x85\x83\xa8\x9eJ\x81z\x98^^\x8d\xc0Z\xbb\xa34^-\x92\xa0TX$10+\b.[r \x06\t\xae\xa1!\xc1\xf1\xcb\x10\x9c>YA[i\xa1\xec\xb6S\xbc\xcd\xca\xdb:\x05\xe5w\x9c\xf2\x1dV^\r(~,\xed\x82KV\xceR\x0e(\xd7Ac\xc9\xd1`+8e:8,;\xceʔ\xc6.\xd5\xfb7\xf94\xa9\xdc\xdcb\x89\xc0\xfd\xae\x82.\xdc\xe3\xcdb\xb9\xc6\x16x\xdc!/\v4\xa3\x99_B\xf4\xef\x1aw\x96\x81\xeb\x04\xa4\xa1\x17\xad\t\xa8㰥. D\x9a\xf8\xee\xc0\xbf\xb8\xbb\x14\u007fڰ\xc2\xd7S\xb8!Y@<\"<\xcd\xe9ϧw\xa9]\x9e~\xb6\xe1\xec\xc2˝\xc1\xcf\x19\xe6RK*\x01\U000f0959l\x89\xea\xb2|\x03\xb9\xe1\xeb\xf9\x9ah\xba\xdbE:\x1c4\xa3\x81\xba`e\x11\xd0\xdcG\x1f\xd0U\v*,\xa9\a\x1a\x1410\xcf4\xf7\xed\xb3\x80Q\xa0\xa4\xe0\x1aV\x81\xf5k:\xa3f\xee\xcb\tMaj\xdeA\xc4\xedS0\x14\x98\xef\xce)\xd8\v\xf4\xf7\xa4\r\x96\xfb\xa9\xd2u\xb4%@\xa7HC@\xc4\x1e_L:~\x9d\x8eW\xa7Ӑ\xa7\x01ew\x92%\xf3\xf00\x81f\x8e\xb4\x80\xa6p\xf9\x85,\x1e1\xb0\x03}w\xc2\x17\x9c\x05\xfb\b\x8e\x98\x84\xee\xa7\xc8=\xa5\xd9(\xb2\x1d\xe5N=\x8e^(=\xaf\xc1v\x97\x93`3ćS!\r&m\xb0\x1a\x89\xbf\x1d\xbf\xbd\xffW\xacl\x04~\xd2q\xf7\x9f49\x8d\xf8\xc4y}!g,\xcdK'\U000cd72cSg?\x8a\x99\x8f\xbb\x19E\x90F^o\xbe\xb9\x99Z{\xbe\xe9:\xdb=l\xd3\xd2\xe0\t\vM\xa8-\xb6{XUo\xf5\x18\xc57\xc6B\x8f\xc8\xe6XPU}c\x81\r\xed\xe6\xb4\xf8Ӷ\xbfe\x18\xfd\x19[`\x1f\x177\x83/\xa9B\v\xb9\xceK\x0e9\xf5|V\x85\x02\v\xeb\x04\x85:\xf2\x8ew\x03OL{\xd0`iL\x03\xb9\xf0.\xaa>i\xb7\x96 \x02\xf6ZK\xb9u\xc67O(9\x8c\xb8^\x9c\x8a\x04\xa1\x14\xe9\x84\xe5i4Rr\xd3-\xb8W^P \xa4]\xe1@;\x13\xdcAN\xa6\xf9;\xf9\xac:\xb8\xccO\xc0\x01\x91\x10z\xaf裃\x1f$\xb9.\xbb\xfcc\xef\xea\xf1\x04\x99A\xca\x14P\x03\xf1\xa3Γq\rez@\x1c,\x1eO\x1c,<\x13\xb6l\u058e\xb1\xa8p\xf1\x85\xc2\xe2\xf1\x84\u0086\xb8X\xc4\xdc\x1c\x9f\x89C\xef\xc8\x1e\x9d\xc5:\xb8\x04\xf2B\x13qŮ\x82\xcdW\xa7b\xbd\xb1.\xcf\xf0r\xf0J;@\xa5M\xc9W\ue1805Uw0\xa2=\x90\xc1\xabn\x13\xb5\x01갋\xd2z\xc4T7\xb9ԥ.\x96\xc5\xef=\xf1\x9bN2\xbbX\x8ezy\xb54\xa4\"\x8e,Tn\xe3d\x17\xa9\f\xbe\t\xabk\xcf\x0e\\\xfa5T@W]\xf8U6\xed\x0f\xd3F\xbd\xc8\xde\a\x8a{\xf7N\x8d\xbf\x1d\r\xe1\r:\x92+\xe8 \xd4ʹ\x10i\x04\x81\xbe[\xb2\xfa\x163S:s\xc0y\xa9q(\x9d\x95Ԝ5t\x12\x83\xc29\xaf]_N\xe9\xbeD\xac\xaf\x9b`\xe0\xe5\xb8\xc6F9z\xae\x89\xb1\x8f#t\x1a\x1a6iT\x9b\xe6E\xc1\xa2\xc9\x00z\xbe\xa5\x99\x94\xffߦ3\xcc\x17k\xde\x01.|\xc1\x95gw\x0fm\xb7J'\xdfşߘ\x9f?ۅ\xbeъ\xfb\xcfNq]\xe4g]\xc4)l\x03\xd9\r\x156\r\xe9\x9ddS\t\x8aQ>N\xaa\xbb\xbe\f\xf5\xbd\x0e\x06d\x9b\x8b\xe3\xf1^\x1b\xe2VO\xc1\xbe,j\x85\xf2=b\xa9\x13ĥ9\xa8[Ё[\x8d\xf61\xb4\xa7Y\xea\xc5z\xd7\xcaw\xfaS\x88M\xd74\xddp$+F\xd1\x1d\xc1\xef\xec\xad\x16%F\xa1\xfd\x97S=D\xfc\xdal\x9a\xa1\xcdf7\xc9\xef\xc1N[\x85\xf5\"4\b\xb6\xac\x10\x19{@~\x18/Q\xfei ;\xd8SNb>\x02l`,ʳ\xf4\xd2\x1e\xcd'\u007fv\u007fv\xc3\x1d*\xe9\x8f\xd3\x1df\xc5f\xab\v\xc8\xe9\xc2\x15\xd1\x05ة\x8bz#(\x8a\bI\xdeӽF30-T\x91Y<\xae\xef\xb5\xd9\xd1̳\xa8s\xdc\xe6\x98ċ\xac\xa8\xbe&\"\n\u052f\r\xb5\xafKC\xf2\x10\xbe\xd7R#\xa1\x97?\xec9\x9by)ѿ\x85&ᯊ\x19\xa3\x1f\xae\xc8\x1b7X\xc4\xfa\x92\xc7b\x98\xd2ĺ/\xd5\xc2\xf0\x16]\x17a\xd1$\x1f\xc5\x19\xf922\u0a78\xbe\x14k>\xde\x06\x1b\x96\x05\xfd\xa4U(г\x1aٴl\xc1\xeez1\xef\xa9\xde+\x9b\x82\xa0\xeeż\x97dwY\x92r\xd0\x12k\x9d\xd7h\xaa\xdaੁ9\x05\xacA`\xa0|Zk`\x8c\xa2\xbaj\xa2)\xd11\xf5\xbe\x92\xfe\xfc\xe4'9\xedØn\x18\x04\v\x0f\xc6\xe0\xa6ֵ\x00\x85\x81^k\x18\x16\x13\x92\xb0Y\xd5\f\x85Q\xac哸\x9f͖\"\x1b\x0e\xfbX3ʃ~\xcbv\xe6@m\x8a\xccz\xa3\f\x86\x8a\x86b\x80\xfd\xcdx\xea\x0f\x80\v\xc1\t0\x9bK\xb0kE+\x97P\x9cJA\x12]\x031&b*U\x90\xa1\x94\x06\x9b\x11\xa0\xa2\xf7\xa5]\xa7Zo\xa0$\\\xfb\xb6)\x81\x97_%\xe3\x01\x9b\xfd\x84\x97a\xf1'l\x18D\xa7<Ƣ٤\xa8u\t'T\xb2\xa8\xa2\x12\xfc\xd6T\x92Q\xec\x14\x81\x1c\x1eR\xc0\b@I\xd7 \xcb\x19A\xc4\x10\xac\xbe\xf2\xf2o>Y\"\x83\xc0W[=\xa8}+\xb6\xcc\xf1t\xf6\xf6-h\xd3T\xe3\xcc~\x12mJ\x9c\xfb\x05Ը\xeb.\xe0\\\bN\x83Z\xa3\xaa\x1e>xv:\x00\xb08WQ\r)\x1feKM,\xa5\x89b\x06\xdf+'\x9f`#3\xa9<j\x15\x9fR\u05cd쑅˛\xa6jT\xaa\xaai\xb7S\xc5}6\xeb\xdfD\xf5|\x9a\xe1\xbb\x14\xe6\xfb\xa5\x0f\xee\xf0j\xbd|6\xcbG\xb5S\xe6\x13\xc6vVd\xb1\xa9-\f\u007f9s]\x1e1*\xe3p,\xe1\"+$\xbe\x06\x81\x04;\x9aF\xb0\x8e\xec\x05UZ@\xa5vc]\x10z̼\n\xf1\xbb`\x05MiU\xa1\xd64or\xad\x8a\x80\x06x\x0f\x17i?\xc6I\xa2\x1eb\xdam\xaf\x85{\x05*v}\xab\xfa\xf6\x88\x01`\t%\x010\xb5\xd6Q'\x1d\xe9i\x02ٻ\xf1\x18lW\x909ʒd\x98\xd6\x1c\x18f\xee+HP\x96n\xb9\x81@\x92\x066ԡh\xe0\x13\xc0\r\xf7 \xedoL12\xbd\xfd\xec\x14s\x1a\xebM\xd3\xf8\xb6$\x10\x17q\xea,\x9f|T6\xdd}\x04>\xddݘQw7\xe5\xd4\xdd\xfa\xda,\xb1\x05\xb3\xee~\x18\xb7n\x8f\x9cǰ\xed\x8fɮ\xbbk\xf2\xeb\xee\x870\xec0\x1d̶\xe3\xd8_Wr\xecb{\x8euj\xf1\xd1o\xaf\xe0\xd8\xc5\xc6\x1c\xbb\xf8\b\x1c\xeb PՁ \xc7n\x82\x0f\xe3\xed\xf6J\x11\xbb\xd7-\xe7Y\xa1rl\xc0\xb0.\u007f6].\xfe\xa6\xfd\xb3˰\xdf|0\xc3N\xb3뛏˱[1\xec\a\xf2\xeb\xa6\xec\xfa\xa8\xaa\xc0\a\xb2\xeaڸ<\x1a\x9f\xe2\xf1\xe9\x06\x9c\xba-\xa3nŧ\x0f\x96\xeb.fq\xb0\x9d\x1a\x1a\xd6#\x93<g[\xb5\x1b\xb1\x1ae|WZ\xa3\xe3Հ\x9d\xe3\xba\xe5\xad\t\xb1&V\xf6\x84ذ\xa1\xb5q\v8\xd3ES\xd6Bo\xbe\xe8-\xe6\x171F=\xc7\xd3\xde\x05\xb7B%\xae\xebSe\xaa\xc7ʐ\xbd#\xa0Y\x03z\xb6\xf6`-6\x19\xa7E\xc8w\xa5w\n\xa3\x9e\x96\a\x1e'\xd3.\t\x1c\x94\xc9m\x913\x81EΡ#\x8fL\x92\x9a\x05:A\xceM\x91\xb0皠\U00034f03\xb7H\xb9iM:ba6)ǲ\x84\x9eP\xe8z\xde\x02\\\xf6Ȯlq \x035\xf5\x89\x8c\x04\xe3\x1e\x89B\x1b\xf6\xcd\xfc\x05Ư\x92DڼU\xaa\xaa\xdfM(@뵫\xacq\x01k\xe6\x8a\xe3/\x8b\xf4\xc6#\x8b3 \xba\xfe\xda\xd8\xe8a\xdb\xe2HNW\xe7\xf8h\x80\xebSD\x9bJ\xb7\xc3\xc11}Z\xf6\xd6\r\xb0\xb8p\x0f\x06\x9bѲ\x19\xbd\xdf\x04\x13=\xc9\xce\xd8QA\xc8\xed#<Y\xe0\xf8\xf2ٹ\xb3\xb0r\xd8$\x1dG/0^\xe4\xceR\x1a}\xbd\xf6\xec9\f\xe5\xe1\x95ڎ\th5\xbe*\xab\xba\x92>F\x14l7L\xb2>GՀ\xdc\xd9`\x9c\x98 \xd9\x0e\x13\r\x81\xe3\xc2\xc1\xae\xc6\xc6I\xe7w\x00z\xd3\x12\xaf=&b?\x94\xf8\xc03\x8efԻ\xf6\f\xa8\xe0]\x06n\xa5\x82\x9en\x1bQ\a~a\xa13\t\x02\xb9\x05gｂ\xd3\x14\x8e\xb7TA\xfa\xe2fl\x16F\xefZ\x99\x89\xd13J:\xddE\a/h\xcc\x14k\x9d\x00!:WÛ\x11pdH\xa4\x12\x99\xf8\x17\xb56*\x81\xd7#\xf0;T\xc44\u05fb\xf6\x8c\xc1S\x81\xb6oI\xb5\b#Q\xbcˊ\xac\x97\rɘ]\xbb\x11\xfb\xf1tlr\xfb\xf3iA\xda$\x1e\xae\xdf\xc4ٔk\x94\x83u[\xd6T\xf6\xe0\x82\xe5ݲ\xee\xbe\xf7@\xfaG#e\x1a\x8b:<\xa4ѡטL\x91\xf0\x9b_\x01Hn\x8b\x90꯰\xceere\x01\fv{\xb1\x1a\xf2\xb3\xcb\xf4\xfeJ=zI\xd0\xcbО\f&f\xc3כ\x81U\x00\xffy9.\xae\xd6\x05x\x9f%3\xe0\xa7C\x95p\x93\xe2\x8e\x11S*9\xc3\x1a)Y2Ɋ\xc90^*\xfe\xa4\x18Mu\xc0\xa16\xce\xc7i͍\x92F\xa3\x8cg2\xfa\xecŎ\x90\xb7p\u0095{'(\xb66)f\x80\xa1|\x8aQ\xb2\xed~\xe2\x86e\x97\xb2\xce,\xe2%\xd3\xf8\xfe\x97\xfau\xe3\xacdk\xb2\\\x85\xc92\x8c\xc9\xd2\xc1D\x13\xb8\x1a\x95_KP\xa1\\\x96\xf9\x10v\x85\xa8\xcb1\xa9\x1a\x94br˨T\xeeR\x06SS\nK\xa2\xcfD\x98U4\x9f/w\x9e\xa5\x86\x87\xaf\xb8YN\x17\xf8g\xc1\nЮ\xb1Q[\xab\x13\xbf\x84z\xc1%6\x1brj\xad}ŉ\x1a\x92\xf3\xcd\xd6\xf8\x05L\xd5f\xabxaMY\xcd(\x04\xa9\x83\x9d\xd9\r\x01\x0e\xe2\xfa\xebz\xb8.-\\;\xabpM%\xae\xf76\xae\x86\x954\xb2\x1d\x1b\xd9N\t\xb2f\xf9`\x8b\xab\xce\xc5\x15\xc9[u\xad\x1b{\xb8\x90\xb5\xfa7\xc0\xf9\xc9\x1b\uea2c\xc4Y\x99\xba\xe3WW\xafy\x94\xdb2\xa0奄!(\xb3\x1b\xae\xcbl\x8d\xdbm\xba\x84\xb5\xb2\xee:\xfd\xd68\x89\x02\xafЗ\xabأu\xfd\xb09O\xc4(]_c\xe8c{\x8b\xdbW\x01\xf7lI)w5\xa2\x062¹\xc5\x18\xc1b\x1d\xa7X\xc7)\xa6\x10\x80W\xbb%\xb6;\xed\v\xeeU<\xee\xa7\xc3z\xa3<\x10?'\xcc|\xb2&Y\xd0\xe3\xaaF\xe3<r\xe8d\xfa\xbbS\xde_\xd3\u06dd\xf2\u07b2\xbe\xb6\xcf>\xac\u007fȲ\xb4D8\x8c\xa9\xfc\xdaI\xb6mFP*\xf1\x83\x1a\x03=r|\x8edw\x17\xeb\xcb\xdeR\xbe\xd3U*\xd0\xe1\x05x7\xedu\xa2\x84\xc1\xac\xc1\x88\x87\xb3\xffN\x97.\abmbÆa\xc7˨΅\x8f\x11\xa98\"\xd2zS\xe7S\xde*ҡ\"\xd1U\x19K\xeb\xc1۩kB<\x97\xcdC\x03U|\xee\xd5\xed\xb0\xba\x9d\xab\x86\xcb\x0e\xd20U2\xd5l:\xe2\x02%\xc8\xf8\v0+\x8ch\x87\xdahF\v0\x88\xba\xba\x8f\xbf|G\x9a\x0ff\xd3y\xba\xaa\x99_\xbdf\x96p\xa7#\xd4̯[4\x83eB\xc0\x1cX\xc8\x1fo\x9d\xe0Bp\xc1\xf7Th\xed\xa4\xfb[Y#\x8c:\xa4\xa7\xd9\xe7R\x8f\x06\xdf%r\x85/\v\xb8U6\xcf,\x1a\xc8\x1bQ\x99;\xe5\xaao\xb3\xa0##e1\x96\ue294\xb5\xb8)f\x93v^-9\tb\xdd\xcb+Ćq3{%\x832\xa3l,\xfe\x89\x17\xf6\x94\x0e\xcf7\x81\x83\x90ª-K\xbf\xea@NA\xd6m\xbf\x80\x197\xd1\x1cw\xed\r\xef\xe8\xe8#\x1b\xd7\xf11\x1d\xb2|v\xd5h\xac\x83\xd0\b\xfd4\xd4\x01\xe8\x8eA\f,\xa9\xfc&u\xd0x\v@\x95\\`\xd4+\xc7\f.\xf3\xcaY\x88d\x14\xe9\xf69\x1c@Ԑ\x9ec\xbe#\x8c\b]\x91a\xcf}\xa2I\x88\xac\x01\xbc\x15\x94`\xf1\x92\x89\xc2G\x1c\xa3|@\x8b\xbf\xffn3\x06\xa6\xc7\xee\xde\x00K|\x89\xf7\x9c\x02˸\x05\xd8\xeb\x99\x05\xfe\xdcf2\xa3\xcf\x04'xx\xa5JѴn\xf0c\xcb\xd7\x06\xf6\x81\xa0ƾ\xf5f\xcb\xd8Lj\xbd<Y\x06v\xe1N\x85{c\xa0\x00Ե\xf5\x81\xa2m\xe8\xbc\xf9$\x90\x83\xfa]I-\xd2\xfd\xc6I K\xeaw\xe1\x1c\xb7!\x83i@nr\xa9\tG\xb5\x9f\x84\xc4`\x99\"\xf2\xc0,\x96\xfa\xdd\x13=\x04\xc1\xf2o\xcc[(\xe3e_\xae\xeeR\x85\x95%\xc1\xeaJ\xe9\xf9@+\xbf\xdcI\x9bc\xcdR\x95\xd5¤\xaaS\x8b`t\x9a\x8f\xea,\xff\x17Q\xe0ɳ\u007f\xd6ǿ\x17\r\xb5\aSy\x18Jla\xca\xfe*˦\xbf߇˂\x9b\x01\xa3\xb2\xf1v\xd1\xe2\x92&um\xc1iJ\tӔR\x9c+`\xb9\xf5\xe8\x1bhxou\x92\x1cH7J\xb8KO\x950\x8b\xa9\x01-c3\xa7\xba\xc7kv}\x97\xdf\xe4w\x88\xe5\xf0\xab\xc1]\x81\x87$}@\xb3j\xcbM\xa4\xce:\xfb\xa4B\x97j\xcb]\x9c\xce\xd2<i\xfc\xe5\x9bA\xb3\xd7\xe5\x14\xc4\xedγ{op\x053.1\xeb\x9fc7\x8b!\xa3|\r]jy\x88k\xb0\x8b~\xd3\xca^\xba\xf8F~\xff85\b^%\t\bf\xa0ߞ\x06\xadUI\x82\xd2*\x86Y\x9f\x89\xd8\n\x01\x8b2r]\tkI\x9b\xd5b\x94O\x1c;\xcf,Þ\xc4\xe2\xf2\x8a\x8e\xec?q\xe5U`\x11\t\v0s4\x8a\x99̻\xf8\x97\xa2O\xf1\xac\u007fSGXM\xab\xbd\xa6\xd61\x99\xd8l\xd0\xd90\x1dc.\xf5/i\xf76WF\xb0\xc2O\x94\xcax\x04\xb9\t\x9d紵7\xce6\x04\xf0oZ\v6\xa1M\v\x99u\x14\xbd\xf6\x81\x93^\xb0\xa1\xfa{s`Q\x85\xe6\x93E\xf4\xfcyԉ~\x8f\x9e,\xaf\x9c3\x0e,h\x1dr\x10v˭\xb0[*\xe8ˏ\x8b\x9d&z\bE\xe0\apQ\x03ng\xc0G\f\xb8~\x99\x9dU\xe3o\xf8\U000fd79fR\xc7\xd2ZY\xa5\xc9v\x01\x9b\x81\xf7$\x03\xa1i\xfc\xf2\xac\t\xd8:\x87\xee\xea\xa3\b\x86\xcbS\t\xab\xdc\x1c\x83\xb8\xb4\xb2\xf1]:\x15\x1b~\xac\xaf>\xeb\v\xf0\x1a\x82\xf5MR\xa7\x11\xa8\x0fnLEatK\x83\xa4C0\x1dYy\xb6\x8eF\xeb\xec\xf3\x97\x9a\x1e\xb0\x19Zjzt6\xa3ǲ\xed\xac\x18\x12V\xb9\xdd\tq\xd1\xf4\xc0\xfa곾\x04z`}\x93\x14\xa2\xc7\x12豔\xf4@\x16B0\x1dYy%=\xd4\\E]Ch\xaa \x1a\x16\x04\x06\x85Â \t\xf1 \xb4\xd7\x05\x16 \x86ń%T\xbb\x94\xackV\x19\x05\xde\xd9\x00\x84\xc5\x0egw\x8bS5\x9f2&=s\a\x92\x86\xbfmJ-:\x16\xdfq\x14\xe4\xb2O|cetTFǙ9\x0e\xb7r6\xb5\xf9s3\u07b4\xd4\x10(Ka\xbe,\x137\xd44\x91\xc1\fQ=\x0e^\x1a:-\xc3tZ(\xeej\x1b\xb6\\v\xacb.\x9dp\xf1_\x06\xe8D\x19\x0e\x9d\\.\xe6\xeck\xf3\xedf<k)`P֥\x93\xc4&D\xa7\x15r\x19c\xe6\x84o\xbaT\xec[\x9d\x81s\xf0s\xc6\xcfA\xb3\xbd\xee\x8a\x01\xdb\xc60f|\xb6ڨ\x9c;\xad\x8b\xa5`i\x97긥:AǗB\x97\x9a\xa6=\xb1\x1dP\xfa\b*)B\xff\xc8\xe5\x11\xa3\xfb&\x9c\x1d\xc1\xea(Ic\xa16\x8d\x8b]\xa9\xbe\x91\x81\fT\xa9\xf4\xdeN+\x02\xe5\xee\x03\xe5Ɛ8\xbe/R'\x19`\x8e\xd3\u0085\x9a\x06K\x17\xf7N\xe9\xb2\xce\xfc\xa4\x95\x00\x90X\xb51\x1e\x93\xe3\xbdM\xf8\xe7\x1euU\xfa\x97R\xe9\xdf\xfb\x1a\xc95*\xaa\xbf\xc6T\x11\xbf\xae\xb4o\xc2^z\x13\xdfeD\xb1\a'\xad\x05\x9b\x98j\xb73\xa2@݉\x1bi_\\\x80\x12\xb5f\xa4\x13\xbck\v^\x81\u0a54\xce\x0e\x1dJ\xa1\x06\xef\xdb\xfe\x8dc\x1b\x13\xd51|\x10\xf5mb\x1fDM\x1a-\x155\\u}\x12<Q\x9fXAp\xce\xcf\x158~\x9c\xee\x9cbM\xca\x0f\xb0\x80\x14\xde1\x06ŕr\xfb\xa1\xb5G\xa8\x83\x06\xa53\xaf\xf3\x92,M\xba\xdd9\xe1;\xb5\xb7(\xa4\x93%\xa6wx:\x17\xc5d\xbd\xfe\xfd\x1c\x00\xfc.J\xbb\x1b3\xb0_LV\x1bL\xaaL04\xfa\\'<U\xbe|\xf4y\v\xcf^\x9e*\xef>\xfa\xb4\x85g'\xa2z\xb2\xb0R \xea\xd92`\xe0\tR\xdf5\xcdU#^j:*1\xeb\x97\x13\xc89\x04Rf\xa5s\xcb\xd0ҐF\xb7\xfe\x10\x9e\x18\xcay\x83\x1fM\xb4?\x06/\x05`\x15\xc3\xe4\xcc(\x02\x00v\xf1r\xb6\xcc=\x8d\x98\xc5E\x82\xd766\x17\x04\x98MJ!\xcc'V\xfdR\x92#\xf2\x16\xc1\xcbI\x14\xe8{\x10x\x99Yn\xa5\xb1\xcd3\xde\xf1\xb1h\xea>\xf2,y\x88\x8dA*\x83\xe7ނ{\x99\x84P֯&\x9f?j\x92\xaa\xa3\xa2\xf6Z\xb6\xb1\xedGT\t\x99\x0f\x19U\xc9\xfc\x0e\xdb\xe1!*v\xcc2z)ˑ4\xc3H'\xda\xde\x00\x04mM\xf4Sl\xfbm\xc1%\x93\x97\x96܊\\\xabпB\x10I\xd9\x0f\xa9\xb2Od\u007f\x1b\xa5LXf\x97\x01r\x04\xcd2k\xd8Z\xa0nS\xc9A\x0e\xaaidL\x83\x19R\x8d\x0e\b\xb9-\x9d\xbe\xd5\xf5\xecksҡ\x01y\x9e\xb2D3\xd5\xea\x19u!\xa8\x9dqe\xe2}\x9e\x8f*\x95\t(P\xadL@\t_\x99\xc0\xcf\xfb\x9b4\x1dZ\x050\xc55$\xf3\x02\xc6\x10\xfc\xe5\x0f\xaf_C\xcaE\u007f\x9a\x0fK\xa1$\xbd!\x89\x1f\x99\xaf\xbe=\x95F\xe6\x9b\x14\xd7\x1e\xcd\v\x04\xcc\xd1~\xfd\xa0N\x04q\xf9]\xddD;\x9b\xaf\xcb\xf5^\xdfɒ7\x02\xe4\xb1m].\xfb&\xbbc\xb2\xa3\xab`{$\xaa\x86\x81Ɔ\xd0\xd6SYy\xc7j\xb3)2;\xe1L3e]o\xb7P\xf4M^g\x0f}ԣ\x05}\xb0\x89\t_\xa9=\x05;\xe4\xe4\x19\xb0\xc7,\x1a%\x01\x81\x15\x1e\xa2\xa1\x89\xc0Ҵ5\xd4b٬.\xbcKxHK\xd4\x1d\xb2-\xbeձ\x1d\xadX\r\xcd\xf6\xc6u\xd9(E\x00\xce\x1c{֊\x1d\xbd\xd8t+\xa7)\x8b\xb6\f\x90\xe6\x04r\\\x04.\xe1\xd5\x17e\x83n.\xd4!\x14\x01Tn\x95-\x01.\xf6\xc8b\x1b\xabZ^\x96\xb4\xbc\f\xb5\xbc,\xe3'\xa7\xe5\xa5߲^>'S\x12\x8f\xe9 \x9e\x0fg\x8e\xe8\xf5%/\xcd\x14\xe3\xff\x00Z\x93\x1egL\xaa\xc6\xe9\xd4\xfc\xac\xbe\\X\xb9\xdbв\xc3R\\\xd4\xf2͝W0\x0es/\xb1\x01\xc31\xcd\xd9b\x85\xb7\xe5\n\x93\\\x87\xbd\r\x82T6WiO\x9e\x1c\xf4OkyF@\xa1\xb5t\x9du\xd4T\t/\xa4\xa6>\x9a:\x18\xc2\xf9c\xab\x83Z]k\xaeu\x80\x19^w\x1c\xa2\xcbO`\x0e\xf0֯Ļ\xcb;\xba\x8f2\xd8\xee \xefϋ\xf5\x8e}lFµ\xcd}X\xa5\xb9\x16\xccb\xfaw\x18\x1fx^Ѱޕ\tV\xd71E)\x16\x89Z\xff\xdfB'\xbfL\x87\xb3\xb8\x0e\xd1NZ\xedv\xb7\xa1\x96\x05_\xccZS\x86^q0\xc4tW\xady\x83#\xe9\x06g)\xef\xbd3\x85\xad\xbe\x1a-\xcb\x15\xdar\xcdw\xc2\xd3L\x98'K\x85\xaf5\x86\x13\x8fL\x9a\xa3\x8a\x9bl\x00'\x96ʯ\x15\xdeɓBL\x10\xa8\x1b$΄\v\x90m\xc9`\x14\f\xa7;!\x0f\x9c\xaa[n\x10m\xab_m\xf5\x8c\xf4,D\xd5\u007f駠\x12\xa6\x1f!ĺ\u007fi*_\xf2h\xc3W\x9c\xb03\xef\xb6{\xb9\xd4\xc0\b\xef\xb2e\xf9\xba\fdWǕ\xa62\xbe7\x94\x848\xdb\xd1\xf3\xe8\xa0\xddnx\x8ff'*\xec\xb1<մ\x06\xdcd\xd8\x0fv\x95j\xe3\x8dn\xe5\bo4ʶ\x01\xc0t\x03\x03\x97\xdb\xf21\xc4\x10μX\xc5\x0f\xe8^\xda\"\x03&\x98\xa1\x9b\xb4\xf9\xd8\xf1\xb1\x98tXU\xd0\x17\x1c\x03^G\x00\xeb8\xc0:!`\x11a\x01Zo\x9b\x8cG\x93\x0e\xbb\x01;i\x93\xc9h\xd2\t\xddy\x1dʪCYuȫ\x0ee\xd5a\xb0\xaa?\x97\xe5\xb0:\xef\xbb\x1fB3\xb8\r\xa4jX3B\r\x13\xb3\xf3o8\xb7q\xb4T+l\xc3\xcb\xc5gSk\xd3\x1d\xf5\x12]\xfe\xfdJ\x1d\x11\xbbr\xfb\xdb\xf1 \x1bg\xb3e\xb3|#\vŚR#\x83\xa3)\xf70Y\xf5\x8f\xb0\x85b-\x8e\xe8\x16\xef\x13UmO\xd6\xe0\xd9-h\x9a\xdf\xcfG\xbdt\xea\xfa\x9b\x85\xb6\xad\x1d-\"\xf3ȯ\xab\xbd\x87\x91\x15\xad~\xe5\x9d\xcco\xd6\xf6W\xd6Q\xbb\rr\xc1<)\x96\x8d*\x1c\xa8nL\xaf-\xafbh\xfd\x98NR\xf5\x17\x1e\x1dJ\x1frk\xb5\xbf\xe5e\v\xdd\xfe\xd2j\u007f\xb9I\xfb\x9e\x05\x84x\xbf\xea|\xcaւ\xb2\xbbf\xf9`\xc8I\xab\xbf\xd5I\xcd0^\xe6\xf3\x19?\xa7\xa1\x94\x16\xc5\x11\xab\b\x99\xa4Ӈ٘{\xcf@!+\xb45<`\x12\"\"\x83Xrb\xae\x8e)V\u052d\xa2\x9e\xd67o \x1ep}g'\x13K\xe2\xb8Au[\x13x\xa2+zCؼ%l~\x14YԜu\vZ\x1d\x9b@EFR\xa7C\xab\x83\bMS*Z\x0f\x87\xc0)\x90J\xcd\b?.\xe6#\xd5A\xfc\xfev\x9c\xe0]8\x18>\xdcێ\x1b&H\x8dʄҷ\xf8\x9e\x00\x04Y\u058cީ. r\x04P_%Sm\x9a\xa4[\xda\x1a\x10E\xcb\xe8\xc7n\x18P\xf1wVqV\xe1\x9d[\x01\xaa\xec\xc0e\xe4\xd94[\b\x12_\xbe\v\xbe:\xd1\x04\xa0Q\xe2/\"\xad\x1e\xabA\xd4\x14a\x05o\xa1\xa1E\xc8bZ\xe4\xd3\xd9_\xb1\xef\x1c5\x06\x15\n\x18\xdd\xce\x0e\x90\xc3}\x87h8u\x8d\xf0e|\xc5\xc6\xef\xb2\xc7\xd5(\xff\x10Gas\xa1\x18\x80\xb7c\xf7\xd4S9\x13\xe7\x11\x83\xe0ĕ\x88[\xa8\xebF\xebf8\x00{\xf3ճ\x95\xc0\x87ʾ\x00\xeb\xd4\xed\xa0\x8d\x13\xf9\xfa\xff)0\xc1\xb3\xe8\x96\xdfgۄ\xcb\xf0\x16\xc8flF\x11\rॻ&!>\xb8Hޱh\\\x94\x9c \x1b6\xa3\xbbH3&$%\x90\x16˦1J\x14\xb2\xee\x9d\xe8ͭ\xe3ˆ\xc8(j\xc1\x13P<\x83\x10\x95\xf5Y\xbeVѡ\xb5S\x81U\xd3\xf5(\xa5r\xde99:H\x8c\x1dC\x8a\x87l\xb1\xa3I\xe9(\x84\xa7\xd1]ؕ\x8d3\xc7\x00i\x17\xd3 \x9e\x1c\x97\x85\x85\x8b\xc1\xc4:\xc0\x94h\xa0]\x0f\xae\xf4\x88\xe1\xff$\x80\x0f\xd2tb;\x8eгcM\xfe\x80!\u0378Íլ\xa1\x83\x90\x98\xd13\x83\a\x03\xafm/\xa6\xc0;] \xf3.\xb6\xc985\xd8i\xb8:\"\x0f\xbe\xad\x98\x91\xfaY&Jb\x12]V\xbd\xe7V5\xa1\xf8\xd8\xf5T\xe8\x94S\x15>\xceɥ\xd4SY\xca\xca|\x10\xaaR\x190\x19\xad\xae\x04\x18\xfd\xb5\x815\xaa\xefĹ\xc2\xed\x15\xf6\x17T\x19\x14Oeo\x8d)S\xa3)\xa9T-ј4\xa3V\xea\xf5\xb8e\xd1t'\x8a[\xf6`\xd0\x06\xa9\xe7\x16\xeb\xf9\xc5J\x1e\xa4\x03\xff\xa8\xa5\x1eVk\xc2TJ\xfdB\xc9O\xa1\x8e4\xb5\x00Dqg\x96\x8b\xa6-\u007f\x9b\xac\x03<\xc6\x13\x01\xdaJ\xbf\xa6\xaa\n\xff1>\xd3R\xd0\x16\xf8.\x82>\x1dMI+\tZ5\xe0{\xba\x15!\xa9&\x1f\xe0>Ƒ\x00\x06\xd4\xe2QP3\xa4\xdf2\x80\x91\xaan\x82\x181\x80\x8f\x87\xe3\x05S\x04\xb7CSC\xe0\x98r\xb0>\xb2\x1b\xe2\xf8J\xd5\xdb\x0eA\xce\xe6Qd\x01\\p#K?(5Vb\xd8\xf7\xb0s\x903p\x95*\x1e\x82\\\x04@\xfb#\xe3\x80V*\\)h>2+\"\xa8\xc9-\x85\xd0\xfa\xfa\xe9\x8a-\xc5d>,\x84\ue2f7\xab\x9c#N]\xed\xb7y\x9cH\xc3\x06ܦ\x15\x12p\xd9u͇P\xa6E/\xcf\xe15\xa2\r\xd0\xf2\xb8\x87%\xfb\xb0\xb2C!\x8c(\xbb\xd4\xc9K\x95\f/\xaf@\xf2@Pa\x13^\"\xc18\x19\x10\xd7\"\xc1\x00,K\xef:Q}\xd1\x05\x9d\xa1\x03f\xf6\x04\xder\xcen\xd2Y쮢\x80̭n\xf4\x06d7\x95\x87\u007f\xeckވ\xcdd\x01od\xb1\xf5\xdbP\xf6\x12\xb3\x97~v\xf0\xa5\xa4\xed\xdb\xc0\xa1\x9e\x90\xaeY\xf15\xec\x82\xd3z2nTb\x8e5^}4\xf4+n\xac?a\x94;\xab\f\x14\xaa\xef\xf7\xb1{a\t`\xc4Η\xf4\xdcM\x00\x17\x96\xa1o\xdf!+Ã$!\x1b\xfcG=\x8a\xd1aA5\xb6\xba\x966#\xd6\xf4\xed\x12\xf4\x99\xaf|\xd5\xeaW֗`\x14\xec`\xc0k\xbc\xd6\x11\x0f'7B[\x18L3\x15\xf5\xabu\x82>\xf1o\xbf\xcc\x04\xa8\xb1\x8a@'w\xff\xd8\xfcw,\x97\xca^̦\xd2\xde\x1e*\xabrA\x05\xc0\x01\x14\xea\xea^\x1b\x14\x81\xf8\x8e,\"-\xb0 \x02\xf7\xc2\xefc\xb1\xe3\x81\x17[B\xc1h⨩\xed=\xda\x1b\xe4\xefD\xb6\x8f!E\t|\xa1\xe0K\xc9A\x84\x94q(JdQ\x1d\xfb\x1f=\x85n\x9f4\xc4\x1cj\xb5\xdb\a\xde\rA\x88\"\x19\xba$\b\xa4\xe5\n!\x02;\xa5?\xa0\xcb\x04/\x0f\x06\xe6Ƀ\xe3$\x0f;-\x17\x05\n\xd2\xc8\xcd5\xcd\xe874Y\xe4\xa2\xeb\x82l\x82.ʖ\xc1y(\xaagx\xdb,\x82m\xc0\xe8,\x12\xfb\x01\xebږ\x02zɕt\xa0n\u07b2\xe3\x10Fx{>o\xd9\x11\x01isJW\xb7\x8a\x16{2\xbd\xc4\xe4%&/\xed\x13\xe6!\xee\r\x9f\xc2^&\x82\x19\xe8<\x8f\x81l9\x1cfL\xe1Q\xf6S1NC+\xf6\xceP\b\x8b]\xc3\x03`\x83\x12\xc2\xd3z\xf4\xbd\x80A\xb5R\x96^\n\xa2O8ՁI\x8a\xd6=\xfaՁ\xa8X3\xf5{G'\xdb\x0f鱓\xe7\x91'J\x045Т\xa2\x80\xc2K\xc9[\xfb\x90\b\xe2mz5Cۂ[F\x129[\\\xb3\x12\xcck8\xd8x\xc6]\xca,UF\xc7\xc9\xc8\x1c\xd3\x006Ҩ\xd83\x12\xa7 C^\xda۹\x9c\xfa\x89\x1bW\xf1\xbb\xe1\xd2!\xa7^ⵑ\x1cB\x8b\xae\xec-\xcd^\xe7~\x00\x17&/\xfb\xfd\xf9h\x8e\x1e\xa8~#\xb9w\x9d\xe6\xa3\x16\bh\xc1/\xb4\xb6Ù\x95\x14jR\x1a4ʻ_\xd5oTU\xea\xbc\xf7pk|\x91z\x1er\u007fk\x81\x9b4xVK\x1aF\xdeXw\xe3\xb7\xe6\xbe=<\x02x\x1d3\x84Q\x8e\xb6\xfb\\,<\xeep`\xea\xb2\xd2\xe1DNS\xa2\x9e\xe3J*\xff\x9e\xe3\x00\xc3\b\xaau\u0085,\xab,e\x15jjYRť\x83\xbcy\x01\xc7\xee\xde\r Z\xc5>\tIXw\xd3ɥ\xbeZ66\u05fd\xb1\xa6\xde\x10J0\xeea\x0f6\x12hV\xadP\x9b7\x8b5U+\n̺\xcd>f$\xc7M\xba\xcaT\x84\xedz\xac\x00\xf0\x8e\xdbzGiT\xc92\xbc\x12\x86\x93\x87\xa8\xdb\x01\xa6\xb7l\xd7\x01\x05\x80w\xc0V\x866\xee\x00S\xc56\xc7ɝl\fغ\x03\xabu\xb4\xcd[\xb7\x95d\r\b\xa6r>\xc0\xa3\xca\xf3\xa8\xa6\xc0֢\xcfEҩ\u007f\x92Z\x86\x98Q\x177\xc7L\xd65':\nԺ\x8d+\xedt\x8bsl\xa8\xa9ϰ%\x98u\x9bU\xaa\xe4\xe6\xcdbMn(\xc0\x04w/\xbb\x00\xc7\xc8\r\xad\xb1.\xcehE\xd0\x1al\xc0\x11\x83\xac\xe2\xaa\xc8t\xcf(\xa0$\xcb\rI\xb9\x9a\xbc(q\xcf҂\xeb\x04ӺQ\xe3\x1b%//K\xc5\"\xb4\x1c\xd6\xfd٥g\x19\x00-\x11\x8d\x8d\v\x8c\xcdl\a\xb9\x94j9h\x80\xbd|\x8a\x86 \xf9\xbb\x0e!\xd4\xdf\xc9C\xd5\x11\xcb0\x9a\xfa\xa2\xc4\xd4>j\xd0\xf0e\xc5\xf7\xf1\xf7\xf5\x05\xaf{\xf9\xee\xeaR#s\xd5\xf0\xbd\x1c\xe8~\xa3><\x8d\xc7\tޫ\u007fjIs\xef\xf2\xa5F\xda{|\xa6[\xb6\xd5\b\xde\xe3KK\xf3\xc3\xdd\xc5;\xda]\xe0\xb9\x01\xec.\u07b9j\x11\xef\x90\a\xe2\xa1\x02\xdc(\b\x0e\x06\xc1lZ\xde9/\xdcMcj\xfb\xd2\xc2\xe3\x99+:>P\xbb\x97Fy-iնk\x11\xa8ƚ\x96\x02\x03.\xbb\nm\xed\xe0\x98\xb9\xb9\xd6\x06\xef\xde(\xf6\xcd\xe8\xc6(\xf3Mӆ\xd8\xff\x95\xed\xf6\xc6\xden\xcfQc3y\n\x9e\xb1G9j\xbbs\xee?p\xd6\xfb,k\x18\xf5\x16\xcdJ\xddv\xe7\x89w\xf7h\x9d\xc8u\f}\xb1Z\x8c\xf1\xcaJ\xad\xc1ReGTB\x19\x10}{\xd9\x02\xa2ϫ\x14\x10J\xb8\xb2.k\xeb]\xa5DU\xa9\x0f<(x\x0e\xc7\xcal\xe7\xcc7\xac\xe7\x96\x1ePQkgG\xb3+\x91\xdfΒ<ig=\xac=\xea\xe5\xfb\x06\x12;\xa4\xd4\xd3vA\xcbAt\xa6{\xdf\b\x97_b\xf9\xa5U\x1e\x9c\xe4ܔ\x94\x9fP\x03j\vQR\x88\xa0\xaaM\x83\xdbO\xb9\x8b\xb3\xb8\x8c\r\xb4\xd22,ՂSa5\x9dt\x1b4v;\xf4ŇMQ\xd1\x1e\xbd\x87\xb2\xf7h\x1b7i+N\xfe\x0e\x89/t\xaei\x90\xafx\x94U\xf9\xa4\x9f\xe9\x15\xf5V\xa7\x11\\6\xf3\xc9\xda \xda!\be\xefʙ\x9b\xd4F$\v\xb9\xef\xd1\xeb\r\xf9\xe8\r\xee\x1c\xd1]\xd0\xd9R>\xd0b\x8f\xe7\x1c\xcb\xc0\x97*˔t\xdfB\xea\xe7v\xc1\xca\xe0\x15\xec,\xf82Mԟ\xd2\xf9\x82_\xf3\xb5*\xc0\xee\xfb\xeb㈲\xe2s\x81$\xf2\x16ҡ\xfa\xe6\x19V+\u007f|'O?&\xa2㙻g\xf0N@\xf4\x94\x05nF{a\x82'\x12\xf6\x1d\xab\xbePS\x12!\xb8\xa4\xech\xa9o\xbe%F\x89\xb1\xb0R\x96\xaa|\x92N\xc4\xd6\xe7){NN\xc9h\x05\xb7\xeb\xe0a\x88)G& \xd9\xfa_\xfe\x12\xd5\xc783(A\xa9\xb7\xae^\xa6\xef\xb45\xa3~\x93{\xfa\xc7\x06\xb1\x19uK@\xa4<\xe3)\xa7\xdc1q\x95%H\x13\xae\xcf\x10Bi\x00\xf4\x834\t\xf1\xa9\xa2\xe8\x99{\x91+)\x9b\xdb\xee\x11\x02P\xcf9\xa3\xaa\x1a\x15j\xbf\xfd8$,\xa7F\u009f\xe6%M\x89&#E\xa3L7\xef\x98\xf8\v\xeesCŴ\xce\r-Զ\xa5\xdd\xe5&K\xa7\xf1\xb4\u007f\xb3\xe4\xc28\xe1R\xd8bj\xba\xa0\xden\x1a\xdd\xc9\x18A\re\xf1Ҿ\xbb\x1bgf\x1fsآ\x9b'9%g\x9bN\xad\xfb\xc7*\x04Aw\xed\xa3Zft+a\x11\xa2$\x8fF\xf7'\x12)\xbaZ\xd3\xf4/(T\xb2\xea\x03U\x91_\x878(\xde\xe0ݩ\xb8G\x90k\xbf+t\xef\xe2Idw\xb0\n\x8d\xb1\x00\xeb\f\xb1\xd9\x15\x02t\xd81\xee\xa8\xc5\xdfܶ\xf2m\v&O\xbdIw.\xd6\xc3#\x1c]\xc6j\x03\xaf\xe8\xa96Խ-\xbf\x05\x95S\x0e_\x95\x80\xd3\n\xd6\x16\xb0\xe3^K,\xd5u\xa2\x95\xd5x\xe6\xde_E2J\xf68s\xee\fEO\xe8^B\x83\xaa\xd15 \xcc\x00l\r\x13\b\xaa^\x88\xd4/\x96?Ka\xa8\x87\x046%\x81!!\xc4`붫~\xb3M\x8d\xe8\xda*\x00\x88\t\xa0N\xd7\t\xe1ǻ\xab\xb21\x9d\xf6\x1d\xfd.|\x95\xd3sF\xcd\xc4*\xc0\xb8\xf4\xee\xe8A\xbb\xa7\x91Bÿv\a\x82Mw\xaf\xec\x1e_\x1c\xbe\xc6\x17\xa3`\x87c\r\xff\xc2ރ+_\x00=W\xbcТq\x1eѵ|\xba\xdd\x14\x95\x8fZ\xd3bwtO\xa5Y3b\x17J\xa5\f\xca\xd4:\xb7\x8d\xf8\xc1\x9af\x88\b\x8c'\x802\xdb\xea\x02M\xca>lw\xe7\x85\xdfvY\xb7=N\x92-Z\xd5\xd5Ͷ\x96\x01\\\a\x036\b\x9b\xb7\xaf*\xebw\xd0\x06ت\xb6\xedd\xfe\x18!<\xe7\xfd\x87\x05\xa2\xab\xe1\xb3q\xf3R\x1fJ()\x0fsҹ\x8f\x9fN\xb3\xcd$=\xcd5\x92\xf4\b\xbcR\xd6\xe3\xa5\x0f\x80O\r\xad\xd5Bb\x97\xba\xf3n\u007fk\xaf\x06\v\v\r,،\x96~bt\x15\xbc\xcema\x9aO\x93\x14-e\xf8ÂA\x9dP\xf4\xd3l\xa6I\xb7ך\xa4\xd3\xd1|\x96\xd6)\xad)\x811\x15GR\x81\x15U@\x9d\xa2\x88\n:CA\\ȍ\xcajdԽ\x03Ifn\x97\xa2$\xf0s\xa3RɊe\x1b\x9f*\xadw\xf8\xe4\x83\xe1\xa0A\xe2m\xf2\\#Z\xe07aH\xb9<<\x0e\xdbUw\xcaw\xd5%me\xaa\xad\x9ds\xd5\x00^\x84\x96\x8d\xe8V3\xbfՒ\xad8p|P\x9eK\x97\xaej\xe7*\x87\xc8\x12\xeb\x88\xdb\x0f\x90,\xfd 4\x8d\a\x1b\xb7\x14\xa6\xff#\x9d\xe6͈^\a\xb9\x05\xe63\xf2\xd2\xeb\xa4\xffB\x1e{\x9dT\x19\xd8\x19\u007f\xb7\xee\xb6\x0fxyg\x05\xbb\xbc+\vt\x89\xedXB\x8bZV$\xd9Ʃ\x8d\xa8i|\xda\x10\x98\xea\xf3\xa5\x10\u074b\xd6u:\x83V\u007f\xff\xbdj\\\xd6\xed\x8e\x1a\xbc-\xfac\xb9K׀6\xeb\x11͞\x8a.i&Z\xb3C\x8f놹\xb2\xa9\xc7\xf5\xa9\\=L\xf3\xd9V\x8d\x89zz\x80\x10\xc4\xea\x06\u074c\xc0\xea̘Q:\x17\x10\xcb\x16!T\xcbƅ\x90\x1f\xbb\xa2X\x8d)\xda r\x9cm\xb2Zzm\xd9L\xa17\xf4\x9a\xec\xb4\xf9:^\xe0{\x1e|\x157**J\xfe\x94&\xf3~z1\x1f5\x9a\xfe\xaed\xdcX\xef́@\xe62\xc6H$\xe2G\xcfl!\xc0\xe3\x03\xda\x19\x85\x1eK\xf1\x99\xe9\xb7HTw\xfc(\xb9\xfa|\xc1_\x04\xf0\x95\x8b|\xc7\xe4\x1c\r\x88\xf6\x9eK\xa8N\x8cq\x91\xb3s\x8e\xf4p\x8e\x96\x00\x1d:\rzW\x1df[\xf6!\fE\xf6$\x00\xa8ė\xac,\x0f\xder\xa6\x10X\xa1\xd5\xcf\xc7\xfdx\x06]P\xab\xfdCS\xb2\x1a\x16)\xe5\x13fR\xf4v\x93\x06\xbe\x05\xb2\x96\x90\xf8\xab\x9dV\t\xc7O\x94\x0e\x14\xe2k\xad\x83X\x8c]dÛ|\x9e\xcef\xe9fl=\x92\t\\\r)̳O\xe2\xf7\xb6VM\xa4\x9f_\x87i*u\x14\xc3V\xa4\x91\x18\xee\xcavv\x1a\xa48\xc8]$i\b\xce=\xac\xe8\x05E\xa5!Tr\xfe\fS\r{\xde\b\x9e\xe0T\xa2\xb5l\xd3\xe1i\x1d\xe0\xee*\xcej\xf0;}\x0e\xe7,\xdb\xd6P\xdeg\u05f8W݄\xda\vCm\"\xfd\xc2\f\x84MiP\xb0\xc0\xc7@W\xfc\xff\x1e\x99\x93AOi{\x03\xb0l\x93\xa3bT\xf6\xda\xdcb\xa9i\xd0Ygh\x8a\x8e?\xf3EZ\xf9\xe0X\x95\xbb\xf4\x17-\xc3\v(\x89>\xbe\xc4/R\x05\xdbW\xe5z%\u0091\x8f~\x8b=\x18\x11\xabA\xb8\x8c\xaa\xbe\t\x18y\x93\xc0w\x9e\x89P\xb4\xa3[\x018\x03\xc0\xb7\xee\xc1\xb6\x00\xb7\xa3\xe0\xdd:\xf0n\x1dxN\xe4}n\xaf\xe8\xa2\xe8ك\xf6¤x\xf0\xb8*\xc7hX\x10G[\xd4~\x06\xbf\xf0Łe\x17'\xee~.F\xadA#\x97\xaf\xcb\xc5\xd4\xca.\f\xf8Y%\x8f\xa6\x8bI<N>\\\"\xdc\xca7\x14\xe3?S\x104B+\x14V\xb5\x19\xe6\x19P\x92V\x91\xf5*\x9c\x9b۳\xebQ\xde\xf7\x03\xee\xd0\xfd\xbdP\bO\xcbuE-߹廘\xe5\xd7Ӹ\xdaϣ.\x156\x0f\xf7\x84v#\x05\xb7o)Ɣi3\x92\xcegT\xb09\xfc;\xe5\x87\x00T\x95\xf6쳛iZ\xdc\xe4C|\xcf$\xc0\x8f\xed\xa2Xٮуpr\xdc\x1d\x82\xb4Kp\xf62PY\xa8z\xe2\xb2\xc14\xfdm\x9e\x8e\xfb\xe0ө#\xe6\x8b\xe4\xbaE\xf0*\xaf\xa5j\xf4\xd0\a'\x90\x80l\x95\xfcV\x8cH\xa5c2\xd32>\xb6EqP\x87\\7\x93\xefS!\u007f\x19\xba\xbd\x81\xbe\xdd\xdc;[\x9b\\\x84^p\x1b\xa9\xfb\bi\x11\xbd0\x01\xff0\xe6T\xf4܄\xfcse\x1d\xeb>\x9c\xfbf\x05EqW=\xc2\x035\xb8K\xd5@\xa1w\xe6֥\x1b\xe5\xb7~:\xb97\xa0\xf9\xb2\xe6\xe5k\xa5i\t\\\xbc76zx)\xb2\xa3\xe4\x9d)3\xd5\x12CZ\x1bl\xcd\xf6?\x11\xbf\x11#\x86\x8b|\x91\x8d/D\xf3\xfa\xa9\x8a\xce\xf8P\x93\xed\xd4ړO\x03{rݔ\xb5a1\b\xa8)\xb79\x02D\x13\xdd\x1c\xa3P\xe9\x1d\xd8U\xc8HY\xb19.D|\x1d<I\r\x85\xbd\xaf\x96ׂ\xf8i\bb\x1d\xb2*\x06\xc7\xf0k\xb8|_\x97\xf2\xc5t\xee\x81\xf6\xe8[\xf7\x9a\xf3\xdf6W\x80ems\a\u0600{\xf2d=~\be>\xb8\x8b\x819\x1c\xadzv\t\xe1\xaf\xd4I!\x1e\xc2\xca\xeb4\x81\x03\ue09fV\xa3\x04\u05cd\xd0\x11\x92\x84\xd0 \x10\xceܒ%\xbf\x1d\x0f\xb31\x9e\xf7\x8b\n\xd6{~s\x18\xf4\x89s\\\xc3n.\x9cS\x13\xd6\v\x00\x92/x*\uf774\x17\xf69{\xb1\xceE\x05\xf7\x14_-\x87\xc6K\f\xe1\xb2\x03\xc20YOJ\xc3I\x96\"6a\x82\ao\xef\x14\xb5-Ws2Ҁăg\xf5\xa5,\xb5\xcaߡ\xd6Ӳ\x0e\x85\xdcXG`\fh\x88\xea\xfa\x04ҹ\x12&\xdd\x05\xb0\xfb\x17bU\t\\/\xf6\\AX5\xf8Iq\to\x80\x01\xac,F\x8b\xe9\xad\xe7V\x01\x01˫1\x12\xd0z\x170\xee\x1e\xef\x02\x863\xf6\xe5\x03\x8f\x03\xa2p\xb6n\xa4\xbck\xacCӻ\nRV\xcc(\x9ct\xa7r\xb6\xac\"\xf9\xcaAW\xebVp@\xcc%\x8b$|5\xe4\x92\xc5E\x93\"\x06\xaf~\xd8\xdc^u\xbb\xc3;n\xd5m±Y\x93\x0fy\xa0\xcc+\xc3\x00\xb2c\x81B?\x9b\x19c\xae\xb2|\xcc#R݊\xb3\xb4\xe8k4\xa6O\xdb<ﰯ\x821`\x9b\xe2\xf1\x91O\xa6W5/\xa7\x0eG`\x9a\xe73n5\xa3\xb9\x05\xa9\x10s܁\x0f\xc9\xe1\xf5\x92\xb5\x1b0\xfa\xbaS\x8a\x05\xaa\xe7\xb7\xf9V\x9c\xcaN\xf0Pv\x8bKSMD\\\xdf\n6\xbe\xcd!\x19w\x17m+\xc5\xdaO\x98^\xc0[˟\xe1\xc9\xe3\xcb\x01\xc4\xd0!\x1a\x99ݻ\xf5\x80\xb35\xb5\x1e\xee\xcaE$\xecԵ\n<;\xd6\x16\xbd\xbf\xc8z\x82~\xd7\xf6\xc1g\xf9\xa5\xf9[~}\rL2ػ\xa9ص\xddS\xc4l\xfdm߰Q\xeeU\x9c\xcd\x13\x8e)\xf4\xcb\xf8\xed\xba\xb5\x9f\xd9nE+\xa2\x16\xac\xb4ӳ\xb2\x88\xc5\x1f@\xa2\x0f\xc2j\xb7\x1c\xabm\x88\xfb\xe0\r:\xa0\xfc\x06\x1c|\x0e\xf2\xe9H\"tO>\x88n\xe8\x0fl\xb2o\x1f\xe7\xda\x1e))\x0e\xbd@eq=\x11\x85/\xf7\xf5o?\xf2\xbd>\xe74\t[\fx\rz\xfb\xc1^\x83vޮj{\x8d\xeb\x84p\xce^y\x93\xb0?\x9c\x17\xb3tZ)\xd3d\x99\xc7\x11kb\x18\x85\x00\xcf\xf2y\xf1=]u\xa6\xe1\xbc]\x88\xff_n*\xcf\\\xff.\xeb\\\x92\xf6\x15AW\a\xb4\x9fZ\xa9;\xd5\x06'I\x8f_4\x10K!\xd7W\xae\xbd\n\xbf\x86*\x04\x8e\xabt\x93\x9cRx\xbe\ff\xe4T\xec\x0fbvW\x9c\x17j\xd8\x06b\x86\x8c\x95j\xc1u\xf7\x19\x0f\xc1K4\xc3t0\v\xf5\xe9;\x91N\xeb\xb3\x18i\xf9H\xc9+\xf4\x13d\xa8R\xe8\t\x11\xc0\x91\u007f\n\xd3\x1dH\x93@\xa4\x873\xf4S\x8b\t\xe0π\x97\xc5\xc4&\x82\xb1N\\\xb6e\x1cM\xf2\xba\xfc\xa5\x1c\xfeA<O\xfa\xfdT-`g\x9fx\u052dC\xa1\xba\\\x97?W\xe9\xcf\xd4J}\x1au\x1a\x1a\x00\x8b\xa0\xfd\x98\x12\x93\xc2Iȝ\x12\xfcn2zY\x83\x02\x94\xb9\xd0Y%\x92T\x8e\\˂\xb1\x85H\xd5\xd5\xcd\xe5*\x06\xd0\x15\xaf\xb2U\xdbq\x95\xc2\xe4#\x8a\xf5P\xbb+\xa5\xab\xac\xb4B\xc0\x02\xb5+\xa5+zǰE\xab\xce\x1bd\xd3b\xf6\xf7xx\xeb\xccu\xa9@\xac+\xfa\xc4DQ\x0e\x8c1\x1dY\xa0\\\x1e\xae\xdc\x18K\xe1\xdf$\xfcp\xb7\xc5__p!\x8f\x99\xcd\b\x1f\xed\xcd0ԥ\xa9#\xb7qM\xcf\xe2]mM\x91\x9b\x1b\xfb\xbd\x87mw6t\x93-X\xe88/M\x19n\xf1d\"\xe6\x12>'\t\xd43\xddp X\xa5\x14^e\xa7\x81\xceD\x84\b\x14\xb6\xf9J\xd1w\x94%\xe4\x8b\xeb<j\x1d\x80\xc7\x1aC9\x1aA\x88\xd83\xccFB2\xf6\xbdD\xcf#Y\x05\xeb\xa0s\x04\xb9\xbf!\x88\xe7.\xa7\xb9\r\x96\xaeB\n\xfcY\b\xfa(\a\xea\xd8M\xed\xea~Z\x04\xf3WE\x1f\xc7`Ū\x85\xf5O\xa6C\xf9\x81\x87\xb9ԛ\xf6\xf3q\xc2f\xfc\"\xb8J\x99YlPY\x9c}\xa0\x1a\xb4\xe6\xb4\x0f<I\x92\x0f\xa9\x18Vb\xa4ןά\xd3\xf6\v\xaeM\xa9g\xa6op0\xd8\xfcu\xde\xd9W2\x05^\x1f\xcd&\x92\x90\xcd\xe8.g\xbfCL\x02EF\x8a\xeed\xcdmY±@h\x02\xa6\xa1\x16\xbc0\xc0\xc4\xdcND\xf8\xa2\x15\xbb$&\xe6v\"\b\x92\x00\xcd\tC[\xe8\x90>&r\x1aM\xd93;\x1f\x95:\x91Ѡ\x0e\nހ\x0f\xf7\xd1\u007f>*\xa9\x98\x8f\x9c\xe9O\x14\v\xe2\x90O\xfc\xb2\xb2[L.\xbb\x96\xf0\x88\xbak\x91\xc6\xccI\x94*\x86\xbaZ\xd0\x00٭)+*#\x01\x02!\xf5\t\xbec\\\b\t\xf0\xd7\xe8T\xd0Jz)\x11'\xf8\xc4(\x9a\xf3T\x026\xe04\x1c\x11\x8a\xe7\xfe`\x92Y\xb1,\xef\xc1&M\x86W\xdd,\xa6q\x88G\xadX\f蔠\xebr\x16\x8f\xb9%\bF^\x02\xc3\xf5-)\x19\xe9I\x19\x17\xf8\xec\xa5\x00\xc3\x19t\x9c\xd0X\x97\xf1\n\xac,@\x1b\x1a\xe7|R\x8d\xc9$\x80\x89f\xdd\x00\x9f\xfb\x88L\xceJ\xcahD&\x88ȨT\xd5pY\xba\xc2w\x85\xaa\x15tI\xb8\xbdaq\x93\xbdR\xa5\xeai\xe4\xbe\xf3\xc2K!N\xc7\x12M{\a*\xe6\xa4ج\xf2DA;'\x85\"\xfa:\x898\x01\x9c4:.>u\xb1\x14{\xb1\xf0\x02N\xe5\xe1 \av\xccUoČ*\x89\xfbW\xb3\x8d\xd1k\x16\x91k\x17\xf7zA\x15,\xb8\u007f\xa6]\x18\f\x99g\"T\xec8ʋ\xf0\xb6zE]\x9cT\xb2r\x92\xa6\x93M\xea~)ʧ\xc5Gۧ7)\xf6\v`%\xcft\u007f\xff\xdd\x1c\xa6\xfdI\xbbwv\xc2\xfc\f\xf0\xf36\xea\x02\xb3t\x98\xce\xd2\xc0\xee\xe9\xdfy\x0f\x8f\xbc\xf8\xc7n\xe0\ri\x1e8\x0e\x1fq\xeb\ued78r\xdf\x0e5\xd6ش\x8f\xe2I\xf5\xfb;\x88\xf5T7[\xec[\xdb4\xcao\xb09J\xb5\xde\xf7\n\xd50^\xedb\x12J\x01o\av\xbd\r\xedܠ\x0e\x97X\xdbB\u07b5\x85@\xe3.Mik(a\x90[\x17\xf8\xc0saL}~\xae\xebq|\x82.\x10\x8a\xdf\xe6\xf14\x1b,7\xf0\x82Pv\xfa^at\x05pӴ?\xa3S\x1cj\vV\xb1{y\x17c\x9aB\x80\\\xb2\x92k0\xc50\xeb\xa7\xf0\xea_Ҷ'\xa4\x98\xc87\xd1ӊ~>\x15\xe3>\xd7G\"\x99\x10O\xa2\x95\x16\\\x8d\xa6\x1fˆ\xb5\xad\xa1\x11֭\xa9R\xe0=V\x96\xb7\x9cVp?\xc2\xf9\xbd\"\xb9\xef\xc7\x02\xf7Y\x1a\xaa\uefe7k\x02\x10\xbc\xf0\xa1\x06^\u05f9\x1c\xe3\x1d<K{\xd4M\ue7331\xf7\xfc\x92#\x15\x04\xac\xfb\\,kB\xae\xde\v\x8a\b\x95G0\x01\x10̏\xb4\xa3\xb0\x9c䓺\xa3\xadJ\n#ĕ{w\x8d\xde\xee9u\f\xe0y(2\x9f\x11\x84\x1aѼIG\xb1N\xfb\xd5#y\xe65\xaf\xc2N\x96\x8c\x0e\xeb\x92b\x9aU\xfa\x19P\xd4@.qLbwĊ»&f\x0f\xf6d\x06nW\xcf\xefՄlTO[\xf0\xd0\xf7gM\xdbu&\xab\x9a\xdb\x1f}\xeeyS\x89\x18\xb1j\xe2m1\xcb(\xe7\xbdq\x00\xf1\xaf\n\x1eW\x85?\xd7]<U]TL\xf3ē\x16[p\xf7\xc3Z\xfc$9\xa5\x9a\x9f,\xe1a\xbf:g\b\b\xec\xc5\xff\xe9g)\xd3\x11^06\x02\x99\xaf\x90\xa6\a\xab\xd7Cr\xfe<\xa5Zb\x11\xc4\xc6\xc4\x10\n\x85|\x96\x8d\xe7\x8ea{*\xaaC\xd3\r\x85\xc0\xd4\xcd\u007f\x81H6\x14\xaa\xde6\xab\x00\xdf\xe4\xfaA\xed\x1c\xbe\xe6\xee+C1z\xfa\x9c}\x0e<\n\xb0\xc4\x1fГ\xe0\xf9\x86X~@\x1d\xa5\xacl\xac\xb2\x1ap`gK\x9b\x87\x12\xc7P\x8e<\x1c\n\xfe,W8\f9\xe9\x80\x15\xf9iAώ\xf1\xf7\x92\xee\xc6́\xeb\xf2\xb9P\x8a4\xd7<\x83!=\xc5'\x16\\\x06\xcc\xc1Y\x9fdPw0\x10\x19\xd0'\ue016R\xfe\"|\xf9\xb1\xde-\xc9\\\x8f\xe8\x99\xe71;\xe0.{i'\xa1ê;\xcf&\x99ӵ}{\x9d\x80M\x8a\x9al\xbbp\xaf\xfdN\xd3!WT\xb8C*\x04\xc3\xfa\xe6b\xb6\x9e;\xc14\xb0\x9d\x1dEi\x1b>w\x0e\n\xb4G\xbb\x89\x93\x98\xa0\x9fn\xf7\xd2\xe5J:/8\x9d\x17\u007f\b\x9d\x17.\x9d\x97\x92\xceK\x8f\xceKM\ap>\xbe\u070e\xce\xfa\x16\x16\x1biEh\xa7\x01\x87\xa6\x8b \xa1\x176\xa1\x83\xb1\x98I\xfd/\xbb\xdeHBR\xfc\x14C\xc1\xefB~\xd0\x15.LIX\xd4\x00;\x83E\r\xb0\xfc\fIT\x1a\xfe\x05:\xc7^\x81\xeb\xe9%ax\xd5\xd4\xcd=\xd5\xf0\xe5i\xb5\xbd\x9c\xea\x06\xd0i\x13\xad\r\xe0\x9bI\xa9\x1dv#\x1a#1\xfdu\xc5s\xdbm\xfb\a\xfa\xf4B\xeeQ\x8c\x86\x1f\x81\xf0)\xe6\x12\r,\xbf\xf8\xed\xed\x9e\xc5\xf8\xfe\x18'\xdfc>\xe1\xacx\xad\xa9qoJ\x01\xae\x0e\xe0\xc0a\x9a\xb9/w\xa0\x1c\x95Ip\x1f}\x9b+\xda\xf0\xf6\xd6\xd0nu\xd02v51\xf9\xba\xea\xe2\xcc\x04\x9f\x93\x92\xf9\x90 +[\xb51\x90\xf8\xb1R&v\x90\xe3 \x85es\xa7\xc1\x02Ҿ(\x9f\u007fL\xdc\xe7\x1f\x97\x91@C\xfe_t%`L\x1a\xa5~\x94E\x0f_\xe5c\xf0\a;\xf3z\xe8\x99\x05<\f\x16\xc1Цk\xdf\xdc\xc2\xf0ߢ\x13\xec&\x17\xec\xdaY\x18\xb8\xc6\x1a\xa4\x12\x1dD\xe7f\xecAL\xc3\xf74\xc1\x86RT\xa0\xf26\xd9\xd0o\xf5%\x06ѥ\xff\x93\xf3B\xd1\aF\x83}n\xc0mj\x06n\xf1\b\tk>Q/\xb5\x8c\x80#\x80\v\xa5DQ©[l5jf\x1eo\xe1l\n\xaa\x1aGS\x12\xd0\xc2J\x91\xe2̏5WI/)B\xb6y\xb4%ʛ7[\x04f\x1d\xc1\xb0\x96\t\f\xe7w\xd8\n\xd6/\xeeH^&\xc5]\xbd\xd6ĈU\xe9b\xf6L\xa4\xd7ԳәU\xe6\xff\xd2efqoW\x9a\b\xd3d\x97\xde&\xeaJ\xd7i\xae}]\xc9\xdb\xd7\"\xe9\xed4N\xb2\x18_\x93\xa9\xb8\xbbϢ\xceq\xdbTj\xc5\xef\xb3\xd1|v\x13\x0f+Mr\xbaT\xbd\x9f\xe7S1\xed\x04\x0e\xce[&4U\xb3\\x\x19\xf9\xd4\xc5c\x17#O/ݢ\x1d\xbf\xa8ؾ!DĻ\x9f\x17\xf5E\a\x8c\xb6&\xad\x10\xba\x10\xa6\xf5\x97V\xb9%\x96[Z\xe50\xad\x0f/\x90Fp1\x0f\x02\xd8\xd5\xf2\xe9\xec\x06^yMn\xb2>zN\\\xb6\xc1>\xbd\x84\xa5\xa8\x8f\xbf\xfbh\xb1\x064N\xe5rק[\xd5\b\x04\xc5\x02\\SJ9\x14\xb8\xaf\x8b\xabY\xbf\x0f\x82\xc0\x94\xbc\x1e\xe7\xa3|l\n\tlxv\xfa\xdb<#\x1f\xdc3\x142}\x85~\f}\x12\xc0\xe0\x01\xd5\xe7\xe2\xff\x9f\x99^\xf5I\xc1s\xe1\xc4CP\x00kj\xce\xe3\x8a\xdaehA\x85\x0e\xed^nu/\v\x8c\xfe'\x93\xea\x85\xd3\xff]I\x11A\x1d\xd7*\u007fI\n\x90\fp5\x83\xcb\xcc\x10\xaa\x88N\xe3e\xce\xd2\xca\x11\x83}\xe5\xea'\xd4\x01gP\xc0\x8b\x14\xf8DV\x80Σn\x1b|\xa5(H(\x87\xf7\x8fER\xf7\xa0\rR\x18y\xab\r\x1c\x01ט\xd5\xc3oͻ\xadl|\x97گPʹ\x19\xed\xc267\xefZ݃S\x10ċ\xe8Vw\xf8y\xd7\xea1+<\xb1\x9e\x06ؑ\xc1\xe8\x95\\\x05{i\x87\x87\xb1\xe0\x94\xfa\xa4\x9c\xc5J\v9\x8c6)\xe7\x1e\xd3\x160\x1b\xeai\bˤ\xc15\x86\xa2\xcf'Z_\xcd3=\x1d\xfb>\xc3\xd4\x17m\xa5\xee\x01\x86]$B\xd1\a\xd2<US\xaf/i\"g%p\xed3OB\x18LDy*\xbb+\xf4\x02\x81;V\xedKpϰ\x97\xed\x00\b͈.\xa7H~\xdc\"\xb4.;|\x96@`xk5\xd73\xa6j\xaa\x04\x01\xe2\xfc-\xbd\x85AU\xe3.L\x022\x8e\xaaС\x05&\x87d\xb4\xf1^b\x8auʋ\xf5\xb1\x9c\x91\xbef\xc3Y\x98\x1c\x94\xc1\xde{\xa2U4P\xb3~\v\x9d\x03jZ\x1b4\xb8\xf6\xbfذ}.h\xb6\x88\x80\xa3jk\a\xeb\x96\xdc\xdaY\xa0\x88\x14\u007f\x8c<\\\x81\x99\x9b\xa9\\\xc6_\x82MI\b@K\xd3\xc0\xb5}\xd8#G[\x15\v;\x16)\x97\x83\xb8\xe9\x86%\xc1\x998O#O8\x0e\xc7\xd7\xed\x86+\xdf^\x89\f\x10$c%L\n\xf2q_\x0e\xabs\x85\x13u\\\xba\xceL8(\xa1n\x97\xad:u]\x10\x18s\x06\xde\x15&\xedF\xe5R\xc4^\xc6\x0es\xb1}\xb1i!\x84p\x8743\x1b{PɆ\xc3t(\x1fa\xdcd\xdd\xeaR\xf08K4\xdf\x0e\x95\xd2\x13\xae\x19\x15|\xf6@\xdbry\xd0]\xc24\x1d\x83KP\xbf\x02\xa0\xb1z\x8c\xf5\xa6\xbbЛnj\xa0k\xfc\x83\xbf\x02\x05\r\x06F\x14Qç\xa7\xfc\xa4\xbdz\x88\xa1\u007f\xc1q$\x86s\xd7\u007f-\xa5.\xa3ݓ\xe3f\xb4wL\xbb*I2\xcc螴\x0e\x9a\xd1\xfe\x81@\xfeʨ\x04\x9dt\xafJ%\x00\xb2\xe0\x1b\xe3W\x02\x9cR\b\x10\x85\u007f\x13m\xa0\x8dqE`A\\6q\xb6\xf1u\x11\xf3\x1be:\x03\xd4}\x8a%\xfc%\x16\xd9A\xb0:\x8c@\xf5҉\xe37\x91\xf3\n\xc6p\xac}8\x8d\xd7X2\x89\x96\x8f\xbf\\\xad\x90\x90j\x82\x86\x90\xe1l\xb3M\xb0yYۘ\x1b8\x17n\x8d\xd5G_\xcd\xf8ĲZ\xfe\x13\xd71\x1f\xa7\x00\xa5\xfc%\xeboE\xbcƪ%J\x95O\xd9!ޢ\xb1\xe7,\x8a]o\xeb\xe9\xe0\f\xa2+z\x11\x1d\xc0\xd5\r\xa1\f\x17\xb7\xe0N\x03\xa0=\x8fv;\xfb\x90z\x13\xdf\xc7Y\x06\xa9\xa2\xe4\xf3\xa8\v\xbe\xc4&s!K\xf2\x9f\xb2~\x8e\xa5\xef\xd3\xe9\xfeq\xc3\xc2\xce\x15y\xb2\x90\x8e\xe8*\xbb\xae\x98\a=\xc4S\xf3^\t\xb3\xf0\xefv\x0e\x85x;\x84\xc5߰\xbdH?\x10\x92\xf2\xf0 \xbab\xc0$\xd6+\x81u=`B\x1ew\x8e-X\xac\xb7U\xf0\x00\\g\x058=\x94\x1f0A$)\t\x82\xb9La'\x1b#$\x91U%\v9\xd7:\xd4yD%\xaf\x8a\xe90\xab\xd6i\x1d4\x82\f\x0f\xbdѳ\xa4\xae\xd00)\x8d\x90\x80\xb0*}\x10\x19XC܄\x9a\xc0\x99\x8fC*0\a\xa5{\xda; L\x11<\x10Y\xb0y\xe1\x83\xf5Hi\xb2.#<\x99\xdboö-y\x8f\xe0v\xa2\xce\x11}FW.\xa1\xbd\x9a\x9d\x13\xabf\xb7\xed\xd5dc\xe1\xd4މ\x0e\x8e\xad\xda\xfb{^mw\xa0\x82\x1a\xb6͓u\x87h\x9e\xd0\xea\xe5\xe3q\xf5S4,Q\xad_\xaci:[m9\x83\xf3:\xcb5˲\x13:\x90\xe8\x839h١\xe3ïd\x90r\xb3\x8f\x03֘\xd8q\xcf-\xc5\xfb\xab\x86\x1dx\xdbR\xb6\xbf\x02e\xbb\xdf)=s\xc50\xe5\xac-\x0e\xea)\u007f\xb1\xf6\xf01\x8cM\x1bZ\x93\x96\x9d\xa6\xee\v\x8e㿃\xe6X=\xbe}=\xbee\xba#*\xf7\xce\x10\x1a\x8ep\x06\x1e\xed5\x13nzBݴ\xdfP\xd6H{\x14\xbd\xe1v\x06\x15\x80>\v\x8e\xbf7܋\x80\xea\xba\\\xa5\x93\xd2 \xa9%g+Q\xba\xecx\x8dh\x83GGz\xdcD\xfc\xc1\u0086\xb6\xe6\x857\x0f]\x91\x83h\x05\x10\xfd\x00\xdd\xf9\x12\x86&@\xa1v)\x89\xa4\xf9g\xb1\xd2\xf2\xb3\xa82\xfa\xac\xe8\xd1\xc7Vu\xcb\xda\xfd\xf3\x14]\x1f#\x9e\xe1\x19j4s\xd6\xf7\x0f\xbc\xd5\x04,\xb4p\xa1\"\x1e_χq\xb5\x03\t\xa7\xecF+̳h\xefP\xae(\xbb\x8e\xd0\xc1\xac\xb3\x8f){\x0f\xaad/Ur\xfa\xf6\xef$u5I\x04\x99\x80 \xcdh\x97~-\x1dQ\xe4\xf6\xe1c\xcf\f\xa7\xbdJ\\\xfe\xbc\xd9R\x85ey\x11>GF\xe9\xb4\x1f\xcbW肋C\x15\xdazV\xd4Q\xc4\x0f\xf3뺖\xf5\xe6\x18u\x1fV\xd1\x15\x9aس\xa8\x1b0\xa14\xb6\x9e_\xfa&\xe4.\x98\xc2\xf4\x950\xf8\x80p\xb2\x8f8\xf9\x14\xed\xfe\xbdg\x9d}\x1c\x86\xbf\xd2Ť\xae'\xa3\xb7$\a\xecY\xbb\xd1Iۙ\xb5\xba\xf3\x1f{\xba\xaa\x86\u00ad\xffy\x134\x88W \x8fO\xc9I\x8c\x97\xa4\xab\x1c\xb8\xcdn\x1cw\x1c,\xa21\xfa\x1b\xf8I\x8cɼ\xf0\xc2\x1acޫl\xda\x1fJ\x8f\x83\x00\xeam\x1f\x13\xea\xacbIHB\xb3s\x14\xd5ވ昣O\xbaZ_̇\xc0\xe2\xbd\xf9`\x90NՅ\xef\xcf\xd5\xf7;\xd1B\xbdVk\xc8k\x00ڿ.\xe6:N\x19\xa5q\xab\xd0qt\xdc[\xc7\xd3\xfc\x1d\xb8\u007f\x0eN\"e\x81\xa42\xee\\\x93x4k\x8d\x92\xfb\xcc\xc3\xe5u>~9M+\xacb\xc5\x1c\x9e\x96\xc7N\x19\x98\x99tk\xbc-\x9fU\x99\xbc\x15\x97\xc7\x01\xe0n\x00b\xe6\xed\xb8E\xc9*\xbc_\x89\xa1\x9a\xe6YR\x8e\xbb,\xa8-N\xa3\x96Lq\xba\x82.\xd5\r\r\x1b\x8d\xa6z\xe8%\xcb\xe3\x05Y|#!\xdbdY*\xa9N/\xb8\xf0\x01\x17\xfd~J\x97\xc0;\xc0\x06\xbbp\x16\x83\x8b\x87,\x8esi\xc9\x13\xe0\x04罤\xf5\x96\xb45\x1b\xa8\xf5\xba\x9e\xf9]?sߺ\xd9$`\x8f\xd9>\x80\x14|_\xb7kф\xef/w-☜\xf7\x8a\u007f\xca7\x81M\xd8\xc7\x1e\ni\xfe\xbe\xe4\xb8..ez\t\x84\x16\x89^Q\xaf\xa0\x9fG<I$\xcfrk\x89\xaah\x1fV\xdfu%\x14\x9c\xe5\xe8\x16\\\xdb)س\xc1M\xbf+W\xb0M)\xba\xcc\x11\x1f\xc8ͺ\xea\xe6\xd7i,\xfa\x99\xbe\xcaŦ\x02a\xb3\xc0\x12\xb9k\x1d\x18Pa\f\x96\xd7R\x1f\xf6\xab\x11\x95\xeap\xa8ϣRD\xe2\xa3\x1e-^Um\xe0H\xa0v:\x9b.Y\xac䦍t\x19\xaa\x1a\\n\x80x0~\x04ږA\xe0\xc8\xd5^\xd74\xed\x05D\xce,\xd6\xf0\xf9M\xbc\x16\xb2<\xabl\aM.\x06 ҕ}ۤ-\x9f\xff\xd5Ե:\xe0\x88\xda\x15=\xf8.\x1b\xa7\x17\xb3i6\xbe\xfe\xe8=p0n\xac\xc99eݪ}\xc7A\xa8*\xfcŤ=L\x1f\xbd\xa7\x10\x82\xa9g\x95\x87\xd0c\xeb=\x0f\xb1k:[\x0f\xfb\xcd\xc8;\xcfo\x17\x86\xa5\xb4\x00x\xb4\xaf\xa2>\xc3\xec\x1d\x85\xe7\b\xd1\xdfn\x00\x82.\xb9C\x10\x1e\x04\xe69\xc8LL\x14\xb0\xff\xa7\r\x03>\xf5-\x1d\v8\xbfh\x84|\xdbT\r\xcc\xe3\r\x8dj\xe7\xd2Vd\xe1E3\\\xae\xfcGm\x85\xd7'G\xe2\xfd\xf1\x03\x88\xa9n\x06\x04p\xfdw\x99`\xeeH9\xee\xb6\\\xe4=\x90N\x84<\xf2#l\xe3!ylBu7c\xb3U\x8c\xc6:\x00^\r&\xa5\xac\xe6`}\x1b涍\xf8\xcdv\x17T\xc1}\u007f\x95\x8b\xfdzʌT\r2ɂ\xe6\xd3\xe6@\x93\xbe\x9dJc\xea\xc3\xc2\xe4>\xe2\xe5g\xf6Bg\x94\x8a\xda\x04\x95Nһ?XiS\x0f\x8f\x9b\x8f\xaa\xbe\xa9\x17\xd7\nk\xae\xba\xf9/\x92,-}M-\x8eU]C\x99\xab\x149j{\xcc6\xba\xb6\"w\xb6\xad \xa3m1\xdc\x1c\xff\x18Z\x1c@\xdf9/۠\a)\xad7\xcb[\xcf\vݥǟ \xb2?zH\xed\xb9Q\xd9\x17\x19\x89M?K\xc5UD\xee\n\xf9\xaca\xdbъ\x99\xb3\x82Q8\xe0\x0f\xe1;\x8e\xa4\xd9\x1fk\x83E\x98\a\xd9\xfe\x95m\x88\xe1\xe1\x87\xfa\xea^5\xf9\x8e\xd8\xce2&\xb9\x8d\x99\xd9H\x8aJnV\x8diG\xe1K\xfa\xf3^ƺܒ\xd9\xf9\x82TA\xb4\xd2\t _\x97\a\x8d\b\xf2EtЌ\x00\x86\x04\x9e\xd7\r\x06\x81\xb4\x0e\x96\xdf\xd3Y\xf2{FkK\x9c#'Z\xdb\xf5\x90\x15\xd6*\xe0{\xb5W\xb4z\xe00-\x03B\x99i\xb7$\x1cvñ?,\xce\xdc\xe3v;\u007f\x87\xbd<_\xdbLQb\x8b\xf1\xba\xe3\xa4ۗb\xe6\xe3\xc4\xea\x9f\\[T/\x99\xf36\xe3\xbaB\aE6I\xcaKۮI\xa2\x18ʻ\xb6\u007f\a)(\xa8]\xd5\x18\xf3m\x06f$\x9b\xc2\v\xc1\xb2\xe4@M\xe2\xb18\xb3r_(_l\n\x05+\u007f\xc9\xc2*k\xb4\x97v\x89\x17\x80jC\xe2\xab\xfcP4,\xda]\x8a\xff\xc8\xf1\x9b\x04\"\xc4\xc2e$ݻAE)\vܧ}\xd4O\x90m\x85v\x0e\xa8\xe5\xa2UF\xa66?)Q:B\x85M>U\v->V=\xbf\x00U\xe4;r\xab\x82ɠ\x82\xde\xfe\xdd*\xed\xe4\xb2*\xd22\xb3\x1a\xb6\x16\x9d>`\x99E\x85C\x001Me\x87\xc0\xc8Tg\x0e\xf4\xd5T\xab\b\x01A\x93O\xa4;6ͻ\xac\xc8zjZz\x06\xcdx\xdao\xd13\xae\xbe.\xf2<\xb2\xaei<\xb8\xb1&\xb2I\xb9q}\xd5n\rjO\xd2D\xfa\t\x9b\x88\x05b\"JO\xba\xcd(\x81\xe0\u009d\xd56줃\xd6p\x8ev\xd7ۢ9\xe1\xd3D\x1dݩ\x86\xefpZl\x82$^2~\x1b\x8dȵX\x00g/\xa7\xfdoǳt*\x16\x1e\xb8\x1fH\xd86\xea\xf5\x04ވ\xb1\x83XJH:\x8d\x86\xb5\bYpEE+\xc8\x03\u070f\x99t\x9c\a\xcbA\xf7\\Xj\xd2u-\bO\x04\bp\xf9$\x1b\ty\xb9\x8a\xd6\xee\x9a,wiC\xc3]\x18\x8c\x92\xe8\xb39\xeaL\xc8ycҡ\xdf\r\xd7\xdd:\xf5l\x95s\xa3\x04_\x9ax\x97\xf4\xb0\xb6}\x16\xa3O\xde\xf0ůD\xb4m9\xda\x10Y\x82\x14\x93.\xc6\xca>\x87\xb7\x102\xa6\xaaH\xea\xe8\xa4\x0e&=\xa9\xb3r\x1d\xbf\\\x87^\x0f\x19:\xda\xc3\xd8)[\xd7Ć'\x1eM\x86\x9a\x96\x8d\x92GA\xaaT\xd5,j\x97O\"2q(0\t>\xad\x02?\fΙ9\xf8b\xb0T5U\xa3h\x9a\xf7\x110\x93\x8a|>\xed\xb3[\xbe\xe5\x93OC\xb0kZ\x93\x8f<߹\x9a\x19W\x1a\x9d\b\xe3嘆#t\x93#\xe1+\xfd0\x92&\x92\xb3\xa8a:Là˂\x11\xcb\x1d\x10\x86\x1cu\xb5q\x16\x1e\xaa\xa4\xe2\t\x11ݲ\x02\x17\xadb\xbe`\x9fO`\x92\xb7ڝfd^ī\\\xff\xe5\xb9@\u009cͨ)\x87\xaeD9b\xadY<\xbdN1\xa2R\x96\b\xb5S(\"\x12Y\x92\xe9-`&.\xfb\x93\xa0~\xa7\xf0v\x94<\x8f\x10\xab\x0e\xb7u\xc0\x8dl\"ϸ\xc1\x8b\x8e\x91P\x0fl\xc7%\xcb|\xb4\xc3$<JS\xad4Z\x83l\x88Q\x8e<B1\xbc5\x1c\xf0\x97\x0f\xcf\n~\xe8\x81Z\xdd\xea\x03\xf9\x05\r\x1aM\x06\x9f\x998D\xf2\xc6'K\xccn\xb4\x94r\xa9l\x8f\xa8\xf1\xd3\xe5\xab\xf0c@\xd5\xcf ~\x95\xa7I*\xe4\x9f\\\xff\xed\xed%4\x9f?\xfe\x11\x95\x1a\"٨\xdf\xfd\x80\x11R`\xc2\x17,`\xa1S\x01\x15\xfeZ\x9e\xa6MMK\xac\x05\\C\u007f\xc8y\x15\xaa7+\xb6\xe2\xe1N\x94\x8ef\xa9-98\xa6\x8fs\xfe\xa4g\x8e\x9e5e\x01\xea\x94+\x12oG\xfe\xf0\aw\xfc\xc3\x0e\nL\x87\xab\xb0\x86k\x1d\x1fg\xc4\x1e\x01\xfb\x95#\x14\xe8\xe2\xc3\xda\xe3k\xba\xfe\xe7\r\xf1#\x99\xe4\xb7X\x16<\xb3\xe4:\xf2\x97Z\xe6\x96μ\xc4b/W\xea\x8f\xe8m\xc1_韬Z\xe9\xbd%\x1d\x91\xb4Cw\x11\xde\xf1\xf8z\xcb\xfb\x89R\xf91>\x90\x94VT7J\xd3\u03a2\xb1\xea\x05@\x005\xed\"\xa95\x95Ѽ\xb0\fjUͨ6\x99\xa6}\xb1\xc8@\xcf]\x8b\x91Ҵ*\xf7\xcbF\x1d\xb34oP\xa6U\x96\xdea\x96\xa8L\xf4\xce\x1a\xb6\xad3\n1C\b\xc1\xd5P\xd7fz\t\x9b\x9a+G\xbf\xae\xcf0^)\xee\xb8a\v\xcaf\x1e*\xb8\x19ۜ͌\x82敛x\xcet\xcc<\xa2մf֓\x9aYSW\xac\xa8\x0f~\xc8g`.\xa3\xec):]`:\xed\xe6I\x97\xf5˼\xc1t\xb9\xdbה:\xc7\xdbX\xae\xda\xcc\xfa\xed\x032;\xd6|\xaav,ހ\x85FޚB\xaa+\xce\x14b-\xab\xa9\x84\x9bQ\xfa\xbdJsf\xf0\x15\x19*\xe0K\xad\x1fw\xb4\xf4{M͜\x03\xd16\x10\xfb%\xa8&\x88\x1e\xb2\xcdÞRU\x131[\x02z{VJ\xcd'\x1bQ\xd3\xed\x96\xc29\xdc\x11\xcdW\x9bw\x84\xaa\xea\xdb\xcb\n\xd0\xdb\xf2a{\xb2Ѱm\xd6\x11\xce\xfc[Ģ\xe5B&(P9\xfc\xb7\xab\xc4n\x10\xd5@\x9e']\x99\xed\x9f\x19(M\xa9\x91v\"\xa7.J\x82\xefg1\xee\xbc\xd7\xd7S85\x12\f<\x9dq[>9p\x15\x89\xb0\xef$\xf4Goe\xf5\vHǊ҉\tX\x11\xd1%\xab\xbe9K~Q\x12y\x18\x95\xc8Ө\t&|\x1f\u007f\x0f?\x97\xeagf\xfc\xbb&\xb9\x19\x02\xee\xf5\x15\xc6\x03ګ\xe3\xa3bhb\x17c\x85f \xbb;~)\xa7D\xb7\x14\x8e*\xb1\x1f\x84\xa1r\x8fyn\x86\x02\xe5в\x86\xe2\x83Z\xe8\xd19\x18^\xa3\xcf\xe1\x9fӈ\x99\xde\x12ˉ\xad\xb4-\x1ah'\x1e\xb4\xb6S\x17Iw\x0eo\x15]\xe0\x9e\xa12Yh\x81\xad\x87\xec˅ue&Y\x06\x8a,Y\x11\xee\xdaS\xc0{B\b\bmM\xd4ď\xa5e\x98\xa3%\x90.\xf7F\xdc\xeeK#\xce].\xd3\xc0\x1bgת%<(4\x05\xf1lP\x95zP\x8b5ZԞ\xc0\xfbL\xc1\x95\xf8\xcaD|,\xed#\x98~\xe0|\x85\xd1Ay\xc0\x05o\x06\xf8\u007f\xbb\xf4\xb1K_m\xf6\u007f,G0*ڠ\\\x92)\v\xd5nǯ\xd7\xd6\xe05h\x99\x83\xd0\xf8\xbc\xbc\x01\x97\xa6lR\xc2+\x9d\xaco\f\x92\x18\x8fK\xa6)\xd5\xf9y\xb4g\x9e\x8e\xb2\xcb\xc5C\x8cR\xe0\x94\x16\x13\x8e\xd2\xe1_d}<f(\xe4\xe4\x153\x1d\x03\xf6\xc3\xef\fM\x9b74cё \xf8\x17\x84\x90D]\xf4\xac~'\x96EQC\xde\xe2B\xdb!\xcc\xd5\xceY\x94\xe1\t\xdc\x18̅γ\x10\x85\x8d\xe00\x18\xb4\xe7\x1a\xbd˛+|\x1cdx\t\x1a\xce\x02\x13\xc5\x01q~\xbe\x02\x06/\xdfv\x9b\x14\t\x9fG\xe0\xf4\xe3&\xe8\xe0Z\xf5\xa9]\xd5'\x9a\xb97\xbeC}|A젻\xeb`\xab\xdf\xefv|Tw\x1dT\xf5\xa2\x82#FS\x8d\x85\xbd\x81]\xc3)\u007f̍\xe3\xd5i\x18\x15S\xe8\xed\xe9\xe24ʜXL\xd4Y\t\x15\x03+i\xfe\x13\x03\xdc\xf3O\xa9\xe4\x0ee7\xea\xd1/\xfb\x04\x94\x1e$\x000\xd8\xe4\xb2\x02wV\x06\"C\x19s#\xe7\\6\x9a\xf84\u007fg\xc0d\x16\x18\x1a\x8e\x18X\xc2\xe4c\xfb\x9c'lJ\xdfUR\xda\x1dû\xca1\x14\xb0\xbb\xbc\xf4\xbbU\xb0\xddҕ\xb0\xf1\xf0\x1a\\w.\xe4\x83\xfe\xa7\xf0ϋsh\xf4)\xfc\xb3\x03\x00Eb\xd7\r\xacb\x91ʹ\x99\x18:ݢ\n\xf3p\x05k|3k|M\x98\x99̊\xcd+\x12\xde\x05\xee\x14\xbb-W\xc2u\xa0Z0\xf9\x94E\x01FSC9\xdf6\xb3\xb8\x89\xbc#'s\x17O3\xec\xa9,\x9b\u007f\xa7\xba\xfe\x04\x97qNO\x06\xdf)\xcc\x0f9wv\x82\xb8MH\x97\x12\x00\xb8\xe9\a\xf1;\x8b\xde1n_\x81ֹD\xcb\x158rq|\xa2V'XM^\xbd\xfa{\x1dۼ, \xc4bW\ty\xfa\xc4\xc3D\x1bzSs\xa0u涻[L\xbcH\x1f\x12\xd0\xceΕ\x19\xbbw֜\xe4\xefk\x86K\xf6\xfcŕ\xad\x02\xba=͡<\x11Z\xcf\bj/\xbb\xba\xb2\x04\x17\xbb@\xe8h\xc7\xfaAЊ\x87\xae|\x0f/\xefY\x056\xack\x1c\x05\xc6\xceQ)ŝ\"\xaf\f\xb6\xdd\x13\xd5\xe2^\xb8x'P\xbc}\xb5F\xe03\xbc*\xe5\x1d\xfe\xf9\xcdg\xb6d\xe9\x95\xd4\xeb\x04굯J\x0e[\xeb=Q)\x06\xd3R\xeb\xc06j1:\xb1\xabc\x9a\xc0\xb7\x9b]Y\xb0\xaf\xb5\xc5eDlF\xfd\xb3\xea=\x1c\xddцs\xf3C\xbb\x9b\xf6\x03\xb1j\x82\x9fG=N\xc7\xf2\xbb\xe9\xb0;\x8ai$z$\xe7{\xf4\x15\xdb\v\b\fE\x1d\v\xee`\t h\x9f?\xb8\xa3\xfc\x0e\xe5w\xec\xfc\x80\xa3\x95\xa7p\xd1\x1e\x9eL\xdf:\xaf\xa1\xadaq\x8e4\x8b9Za\x9d\x81\x19O\xe6\xb3\xd5\x0f\x00\xe84<.\x19\x94\x1ez\xdbN\xd6\bZ\x04\xad\xd1ey4\b\xcb\bX,\xe0\x95\xcc\xd0q\x9c\xda\xeb\x0f\x04\x02\xbf\xc4\a\x1f\xf8S\xf3\x04 i\x8a\xba\xaf\t\xaa\x9e\t$\x1a\xac\xfd\x16\x00\xf7MR\x1cKa\xf4\xed\xb8Ȓ\x14\xdeL\xa3v\xe5^\x10Av\rW\xe9\x97T1\xd4`\xb7JX\xe5Y:-\xf0!\x16\x86\xb8%\x10\x95\x11\x91-h\x8d@\xb0\xba\xf2n\x95\xe1\xf8a\x18r\xfc\xf0\x14?t\rԚ\x8e\x0f\xee;el>dZq\xaeBX\xeb\xc7]>\xcd\xc7yV\xb5%c\x8f\x98\v\xbe߲ΐ<\x1dZ-\x83Ji\x16\x14\x91m\xbd\x15x\x14\xe9\x10\xed\xcc\n\x18\xbb\xef\xe8ģ+\xc4T,\xba\xb4?ý\x19\xec\xd3,3Gڊ\xa5\xc1@\xec\xda\xd3V\x0fTF\xeb\x89I\x01*n\xdaJ'-\x16\xea\xab\xe8\xaa\xc4a\xa9}\xc1T\x1c\x86*NC6\x04\x8d\r\xc7\x00\x95\xec\x02,\x1aE\xa7\xb5\xc4\xe7\xc7\xe9᙭\xb1\xa7\xad\xbe\x98\x9e\x80?轎\x12-\x1a\x15u\xbbX\u05ee\xdau\xabv\xcbݗq,\x16\x1e\x16K\x8eE\x8cz\xb8ӎ\xc2b\xe1b\xb1\xec\xbaU\xbb\xa1\xd8\xd4w\x1d\x8aA\x82[m\xb02\xdcu)\x01\xf7\xdc\x11\xdb\xff\x11\xb7]\xa6\xadiz-\x98\xa25$\x95\xebJ\xeaK\x1d\xa8\xda(/>-/\xee\\cUumn\x96\xa9\x96\xd3\a<R]x\x9bXp\xdb\xef\xed\x82\x1d\xcct4=3Ǭ\xc3G} \xc6\xf6\xb6w\xb4\xb5ꃺJ\xebi\x9f=\x01p\x8d\xc0\xaa\xa1\xaa\xcd\xed\xaa\xedm\xf8tע\x81\x06\xf1$Ck\xa9\x06$ۗ\x8a\x95L~!\x98d\xb7\xd3v\x91~h\xb8v+%\x18\xf2\tF\xb73QÇ\xa7Qm*\x8f\x90\xa6\xe2\xf7\xb0\xe6ʯ$\x1d\xc6\xf3q\xbc\\%\xc0\xd2\xe4:\xddJz\x81Ǚ\f\xfbS0\xe5~\x1bi\x86(\x94\xb2\xb4\xe2\x1f\x8f\x87\x1d\xbeE(>CA\xb2Ǯw6c6%\xff2\xaee\xbc\n\x10>\"\xa3\"\xf8\x0feO\x1d\x83\x1d\xf6V\xd0Cv\x97\x0f\x1b0\x97Hi\xbf9\x12\u007fvvx#z4\x95%\xf7\xae\x89U\x85Z\xa3~\x80\xe7ϫ\xe8\xaa\xe4\xec]\xfb\xa9\x91p\xbc%\xf5\xb7y\x9c\xc8\xd8\xf7L\xa0\xc0\xd6ѵ2z\xa7\xc4ٸH!\xec7F\xb7\n\x97\x95&9\x8c\xdb<i-\xf0\xb6\x9c\xfa\x023>\xa1\xc7\xd7G\b\xcd\x1a\x0f\xdc\xdb\x0e\xc0\x1bcz\xd2a\x9b`\xee\xfc\x1b\xcb\xda\r\xc5\x1d\x86R\xc7fw\"\x96\xba\xc4\xd4%\x9c,\xb7\xda\x1dO\xe1\xc3^\xbd\x82Тᮭ\f\x16,\x11\xf5\xae.\a\xa0\xdfUB\xdf\x14\x99*3\x92Aj\xb2\x86\xf1g\xedv\x83\x01\bWֶu&ti\x85f\xb4E\x97v\xac\x10X\x06\x121N\xd0R'\xaa\x17\"bHAm*\x16\xec)\x8b\x18PL\x93\x91Y\xeb2\xe39\xde\x1eء\xaa\xda;?\xf2\x98\x1b\x9c\x11\x83\b\xb7(\xf8av\x05\x9cZg\x9f\xe6\\F͘\xefEV\xdd>\xe0\x96/YHuQo\x86H\x19\xb1\x8c\xa2\xeaE\viZKY\x90t'\xf3\xb8\xa5bzy\xd6\x1c{'(\x8d\xc7ܬ\x85s\vtN9\xfd\xb4\xe1w\xd1h\x98C\aY\x11\xa3G:\xddś\x8f\r\x03\xcc\xdd\u008b\xd9t`O{\xaf\x04h\x9a{\x96\xa6\t=F\xfaX:$\xba\xfa\xecTk\x86XȏtM\xc0\xba\xfeåU\xcf\xe8&\xdc\xdc\xe9n\x19\x81ឃ\xf1\x9e\xda\x16\x9f~\tx\xa9\x04Nb\x97Tb\x19\x82\xf1\x029\x1cq,\x81\xf1\x02\xd9}\xd9ua<X\xe2\x90<Iw\xc1]qG\x1e\xe3.\xe1\x8bSQ\x1e\x11\xbe\x88\xe0LpI4\x16\x13!\xb1\xd8\x12g\x9d{\xe8g\xd8J\x06\x04\rs\xbe\\` \xeaf\x9cX6\xa4\t\xe3\x03bb(U\xc1\xc7\f\x14\\\x8e\xb5\xae2\xb0\xb5\xc0\xc5\xe3g([\x1fP8\xd0jؒ\xb1\x95Ơ\xb0\xb6WIH\xe5\v\xe4,\x1b\xa5n\xc07\x99\xf6\xa5Р\x9a\xea\xfbm\x12//\x96\xa3^.\xc3\x17\xd4.\xe6c\x91\x04A\xe5^\xe7\xeaכyZȟ\u007fO\x93\xb1\xfexs3\x9f\xaa\xdf_O3\xf9\xeb\x02\xeeV\xc3ou I\r\xcdgpI\"\x9f\xe52\xc8\"Q\xe6:\x9d\x01>\xa7Q\xb9\x8a\b\xf7fZoŒ?\xfbۛW_2\xb7\xe5\xf2\xfa!\x82Xn\x00a\xe9\x03\xf8Z\xacv\xbf\xa6\xf1tm(\xaa\x82\x0f\xea\x9b\\\x10em8X\xda\a\xf2:\x1b\x0e\xb3\"\xed\x8b!X\x1f\x16\xaf\x14\x029\x9e㍰\xb5\xa1a\xf9\x00\xa0|<\xbbY\x1f\f\x94\xf6\x81\\lط\x8b\xb2n\xbd\x11\xbc\xb5\x1e\x14(\x19\xae\xff>\x1f\xa7?\f\x06E:\xab\x82Զ\xaab|\xc5\x1f\x06k4-K:M\x17\x15\x8c\xaf\xa6\x8c\x9e.\xadB3?\xbbL\xd6z˯\x93\xf9З\x1b\x02_\xae\r\xbbr\xba\x945\xa0*\xad\xddJ\xf9L*k\x02k\xac\r\u007f\xe5$+k\x86Wܠ\xb5\xf2\xf9W\xde\x10\xd6Y\xbf\x8dҩY\xda\x02\xd4X\x1b\xfe\xc5\x16ĺؐN\xa5\x13:\xd8\x00\x94^\x05\xda1\x82\xd8@\xe4\x82h\x96&\xb7\xa4XwG1\xce\xd57\xb4~\xd6>\x8d\xa3O{ѧi\xf4\xe97\xa7\x9f\xbe>\xfd\xf4\"\xfa\xf4\xd7Z3P\x01\v\x8f\x9e}\x9a<\xfbt\xe9\x15\xd0\xd0$\x90\x9a۰XB\v\xd2`\x9c\x85\xdaZ\xbc_\xf6z\xd3\xf4.\x83\x18\xb5c\xa7\xb8V\x86Y\xab\xbax\xda0`F\xc0\x04r\xfd\xff_\xf1x\x1eOiIO{S\xf5\xfb5Ī\x85\x1f/'\xd3lH)\x98\xf1\xbf\xe6\xe3\x94\xfe\x0e\xf1\xfb\xe5\xfcz^\xccP\x0fH'\xb3\x14\xa3\"\x8b\x8f\x1f\xfa\xb3\\\xfe\xfc>\xbf\xd3\xc9_\xa6}\xfa-\xafB\x19t\xca\xfaE\xb8V\xf7\x8c\xe9@-\xca\xe4Z\x99@j\x02V$\xcf(@E\xeb\t˓\xdb<\xbca\xad.3\xc9͊\xb4\x88\xf4\x9b\xd1`\x8dC(\xb8\x8b*\xdbm\xf5o\xe2\xe9+\xa1\x87\xbe\x9c\xd53\x8cL\xbdw\xe4\xf8\xa9\xc2\xf6\xc8n\xa2k\x81\xd7%L\xaf\xbf\x03\xfbS3\xaa\x0f\x18Y\b\xf7\xe2\x12\x8e4\xac\x86D#p\x18~Ո>\x8f\x06\xb2o\xa7Vh\a:\x9e\xca\xc0\x18\x13\xd8^\u007f\xb2>J\x9eGW\xaa\xa3\xdc\xe3\xba\x1b\xbf1Gչ\xc6 \xfa\x02>\xf0\v˶C\xf0\xfc{\xfdlSv\n\xf1a\xda\xe6\x9e\xd2\xe84b_\t\x04\xb6\xd5_\xdfXy\xaf\xad\xaf\v\xeb\xeb;\U000657fcD\x19#;\xe2\b\xb6[Ց\xa6\xec\xb5qa\xa3\xaev=9W\x04q\xee\xferK\v\x14\xadMjb\xef\x11%\x8d(i}\x03m\x89\u007f?\x8d:p-'iA\x94\x8dN\xd7\n\xdbC\x82f\x9c\xde+\xacTn\x82cdV\xebz\xd2Z6\xe1\xb9\x11\xfcc\x0e\xe3T1REEk\x90\xfd\x1a\xfe\xb9\x80\u007f\xbes\x875\xd1>\xa3\x1f\xac\xf1\x9a\xe5\x17j\x9eT\xe8D\x92N\xa1\xe33\x82s\x16\x12\xd7\xef\abɥ\xb0\x89rF\xd7k\xednRcB\f\x8b\xec9E\xf6\xfc\"\xfbN\x91}\xabH\x11h\b\xda\t\b\xe7\x9fRo\xfe\xfd\x94ֹ\xfcm\x04$\xf5\xcaZ\x96\xe0s\x85tum\x92\x8dn\x9d\xef\xf2\xfcv>\xf1\xeaQ\xf2\x8a\xba\xeb\xe0\xec\x8b\xeb0\x94\r\U00030845Wd\xe3\xd5$>\r\xbe\xa16\xb1\u0603\xa4\xbdLZ\xb4s\xac7\xae,\x1d\xe4\xe5\xba\xe0\xcaA\xf4\xd6\x02\xe1w\x95\x00\xca-\x93\r\xf2\x8b\xf5AV\x81\xe9\x9f:+c=\xac\xe7Ȼ\x9e\xc9Z\xad\xd2\xec\xacKj\xf0\x00f\x04%]\vJ\xb1\x02\xca7\x1b\xe3\"7\xd76\x98o\xb7\x05CB\xf8\xf7\xdfſ6\xc4w\xebC\xdc\xc3 \xebj\x04\x04\x0f\xfd0 \xc9\xec \xf9\xdd& i\xb4\xad\u037f\rm\xb4q\x97%\xef\x80N`\x83z\xbd9(eG\xb0\x01M\xaa\x01q\xba\xbf8\a\xc2\u007f\x1e\xd5~|]\x13\xbaK\xed\xe5\xeb\x9a\x05\xeabc\x9c.\xc2t\xfa\xdbF\x80\xe4 \x16h0+\x19\xc7\xfbս\xf4\xedQ\u007f\xdf\x06\x8dQ^\x81\xc6b\x8dY/g\xfc/+\x8a2\xe1\xb0ܘ\xee\xc6j\x06\x93\xa9ݶ\xb1\xfcu}x\xfb!x\xe9\xbe\r\xef\x1f\xa7\xa6R>\x96N\aj\x9f\xd6*\x9b\x11\xf9U\xdbF\xd0\xf7\xa6\xd6\xd2ce\xfd=Mo\xf5B\xa3\x97\x93P\x11\xbdPX\x99\xaf͒\xa0ž_\x80\x89r\x96\xf5]\x0eaD\x80$Zv[\xf9_\xaaFӲ\x8co\x9c\f\x98\x80\xdd}-6K\xf3\xbes\x91d\xb2HK \xbf\x1b\xdf\xcfa\xeb\xa7\xe5\x8a\x03\x02\xa5\x86\x96\x15V\xe6\xcbя#=\xf5\xad\x9c\v\xde\xe8\"H 4\u007f\x1bV\xf7\xf2\x81\xc15\u007f[\xf9\xc0i\x9aS\xad\x1cŇ!\x96\x19c/Q\x85z\xf6\xcf\xff]<\xfd\xdf\xc9\xce3\xb7L,:\xc4\xd4#\xdc\xddJ\x063;\x98\x89\xf8\xd9\xf9D\x9d\x8fۓ\xb45\x9f\xf5\xb7\xdf\xe3\x82G\x12\xf6\xd8K\x9f\x140\xd3\xfd\x99u\x88C\xad\x05\xb6\x1c\x11d\xb5\xdeB]\x16Y&2\x11H\x05\x85\xeb\xa2\b\x8b\x058\xc8\xc6\xf1p\x18F\xe0K\x06\xe4\xc1\x8b\x8f+`\x11\xb9\xb8\xb4\xd2=\xdfl/\xb91\t\xe4\xb6\v\xb1 \xf0\n\xa6\xd7k,\n\x0f\xdd`\xa3\xf5v랗m\xb6\b\x05\x95\xb0\ue38a\xb2\xbe-r\x8f\x84\xc0K\xf5ڧ\xbf\xee~:\xda\xfd4y\xa3\xecS\xadO\xbf\xfbG-\xc4z\x19°\x8dh\x02\x9do/~\x90(~\xee7\xfa\xbd\xd0w\xef\xd2\xe8\xd4\xcf\xe1GFN\xf1U\xa3\xe8\xec\x86Q\x87\xb4\xc6D=\xb4\xc6cd\x9a\x02\x9f\xe3\xf6\x1b\x10\x91\x04\u007f\xa8B\x80Q\xdd+b\x8d\x80V\x0eP\x1e\xb1\xe2\xf8\xe4\xf8NL\x02\xb3\x04\xb1\xa9\xa8,\x02fbѕ\x90\xc10ϧX\x92\x02\xf2\u00ad\x02\xf8\x83\b\xb3KP\tZ r<\xcaP0\xd5\xf6\x1e\x8f?\xf0C\x9f\x85\xa8\xbb%\x04^U+\a\xed\xa0\xa9\x80Yg3\xb6t\x92\x8b\x00\xe31JiM\xe3\xf1u\x1a()\x05\x99S\x1a\x84\x8aWc\x84K\xc4\xe3\xd2\xf6P\xe8\x10\x82\x00\x87R\x95\xf8\b\xb4-\x03]B[\xeb\x14Φ-\xf5\x9fӖR\xca(\xe5\xd2V\x96\x0e\xd2\xf6\x86\x9et\xafGY\x98w3y\x8c&%\u007f\xcb;Y\xc3X\xd2*\xa8]`,\x02\x83\xb1w\x98\x1e@\xcc7\t\a\xe3\xd7\xe9\xdfO1\xfbc\x8dR)\xec\x92abǸ\xf6 \x01!\xf9\x10\xc1w\x98\xdc\xee\xf0`\xc9\xe0\xe0$\xf1r\xa3\xb1\xa1\xf2\x9c\u061d\x93\xa3\xb6\xb1P\x8a|\xdbL(;e\xb4\xeb\xa6\xe1G\xda\x18\x9a\x04k\xa7\xae\xa9\xb2\xdcd\\\x10\x84\rO\x8c\x8b,\xbb\xc1 Ȫ\xbbdǶ\xc7A\x1f\xa6\xe8\xcf ]\xddA\x80rec@{-ˁ\x9fC\xf8%\xe5\xab:KE\\\x9bZ\x8c\x01\x89\xf3w\xa9\xe2nT/\x9dJT$\x94\xa5d\x8c\x98?Ǉ\xfb\xa9\x15>\xd6=H\xf2o\xa1\xc2\xc6\xc4\\n\x95\x8c&h0˿\x83\x18\xe7\xaf\xe2B\x1f\xa1\x83\x19\xfcH \x92\x99w\xe2\x8a\x15M\x9f/E嫵\x995\xa2\x1e\xdb\xf4\xa7\x12\x8d2FaT\u009d\xb4\xe0\x9c\fv\x83G\x15f\xeb\x15|\xb9\x8a3\x83\x12\xe3\xa8\x11\x06\xee\x1cWȩ\xeb2\x84c\b\b\xf3\x86o5B\xf8;@\x81\xa5\xe9\xb7\x18\xf9#I\x97%\xbe;\xcd\x1a\xee\v\x11=6\xa2V\xad\xa8]\xe1\x93#\x1a\x19\xc3ꡂrz\xe8\xc2\xd6\xdc\xf0*\x10\x9a\b\xfe߁&\xec\xcc\xc1\x16\x0e\xf7bKΕ\x0e\xb4\xe8\xb8\x05\n\xaf\x84/\x15\xb0\x9c\xab\xc2P٠\x14\x81\xf2Z\x8c\x84\fJ\xd6*\x0e\xa2w\xed\xb9T6\x91\xce|\xd1\xdbq\xa5\xb7Ԅ\xd7\x14ߴ$8\v\xc4V\x02\x9c\xdd\x15rT\x1du@m%\x04\x14\x1d:\x1cv\xf4\x1c,\x1b\xa4\xbf\x16ЏGQ\xea\x038\xea\xf80\xaaV\xac\xc6[\xd1־\xb0f\x93\x17\xc8P8s0L,\x97\xb6X\xd2\"-\xdfcb\\ۋY:\xa1\xbb\x05b[ь\x0e\xe0\x9f\x0e\xfe\xbb\x97\xee7a\xad\x82_\a\xcd\xe8\x04\xfe\xe9\x1cÿ\xa0~\x89\xdfm\xfc\xe8v\xf0k\u007f\xaf\v\u007fpQ\x13yG]\xcc<l\xefS\xa1\x83\x93nz،\x8e\x8e\x8e\x0e\xe1\xef^\xe7@\x009\xa4+\x88\x1eNh\xe9y\x9d\xcen\xf2\x84P\xbbtv\x1c\xa2\x01\xf2\xb3\xef&\x1f\x84\x93;%\xe9{m;\x9dtn\x0f\xbaJ>()]\x92\xeeB\a\x95уM\x89{\xa1\xc4\xc3`\xf5\xae\x9d\x8a*A'\x90\xe6\x94\x03Y\xe6w\f͕%\xa9\x0eN\xc0KX\xb4jо\xd6g\u007f|\xd0\xd4I\xed\xa7\xbf\xc2Y\xaeo\xdd\xd5OI\xc8\xc3\xc1\x83ݰ\xae\xfdEe\xed\xc4\x15Q%Pzѧ\xc9j@R\x9bx\"\x9dM\x95\x01\x8b\xd7\x04\x06\x9a\x0f\x98\x986\x00\xfdm\xf4\xe9d5h\xbe\xb3)\x03t\xfa\xe9\xeb5(g\xeddà\xe0\x1a\xd4J@\xb6\xb9!\f\xa8\xf5\xe9w\xeb`\xe4ޗ}(g\xbel\xacWiLh\r1\xa5\xce\xef\x0e8\\\xcaV\x16\xcc\xfa\xda>O\xf1\x98\xba\xc1\xb5tON\xa9\xc5ʼD[\xcc\xd2\xf1\xaciޏ\xbb\xe7\xa9\x06\xefV\x92\x8f\xe2LU\xb1.Oa\xa9\xbf\xa63X\x1b\x84\x96=\xcb\xfa\xb7E}\xd4\xf0\xcb\\\xc82\xee-s?\bz\x15>\xf5\xb5\xe8'\xbb\\N\xdaFЦ\x89\xa5\xe0R\xa3\x96\xec\xe5Դ\x9e%\xba$\xbc\x8cF\xf0\xbeD,l\x82\xbc:\x00\xfaC\xa3\xac\xc1*\xa9\xa4̩\xdbK&\ta\r\xe9d߷\xae\x02\xb6\x9e\x90\xd2\x17\ueac5\x89\x84\x19\xaf\x0f\x93\x8b\xab\xcd\x1aYOj\xd97\xeb+\xe1\xad%\xbc\xdc\xfb\xf0\x15\x10גa\xee\x8d\xf6\nxk\x89\xb2\xe0\xed\xffri\xa6Yv\x1d\x11e\xf8\xdb\x17Pf\xb2}L\xf1$ZYGB!Q\xab\x84\x94{J\xf6A\x82\xcat\xbdYB\\\x85\xc5CC\x8c\xc7\u007f\xfd\xe7\u007f\xff\a\xfc/\x9dNs\xb1\t\xba\x99\x8d\x86\x1f\xad\x8dv\xa7\r\xff\xfb\xafv;\xf8\xb7}\xd4\xe9\xfcW\xa7\xbbwx\xd0\xdd\xeb\x1c\x1evD\xf9\xce\xfe\xde\xc1\u007fE\xed?\x82\x00s\xf0\xe0*P\xf9\xe0N:\x9d\xfb\x1f\xf2\xbf\u007f\xfd\xeb\xd9S1\xa9QP\xc1;\x88^\\\xa4\xc8\r\x9f\xc0{\xc1\xfep\x9e\xa4\xa7B\x9c\f\xf38\xa1\xe4\xa7\xcf\x1e\x1e>\xf9\u05ff\xa2$\x1d\b1\x12\xd5f\xd9l\x98֢\x87\x87\xaf\x90\x93\xa2\xde<\x1b&p\xa2(ʴ~\xa4P\x81\xad\xef\xe3Q*\x8a\x88$ъ\xf8\xf1\xc9\xf3$\xbb\x8b\xfaø(\xcek7i\x9c\xa4\xd3\b9\xb1\xf6B\xb4\xfb\xfc\xa6\xf3\x02j\xbf\xca\xe7\xe3\x99(MY\u0560\x9f?\x13\x95>y\xfeL\xc0}\x01\xe8\xe1\xf6=jI\xa4\x1e\xe0\xc49\xd0f?O\xd2h\x04Q\x11\xb0ahz\x0f\x9a\xbe\xcff7Q\v\xd4+\xbcc\xf0\xf0\xf0r\x86\x8d\x8a_\xa7\x91\xee\x05\xa4`\x03\xd4\xfe\x1e\x81\xd0\xd5\xe1F<\xb5\x8c\x90'\xd3\xf4\xc5sh\xf0\x85\x84\xf4\xfc\x19~=\u007f\x069\xaa\xaa\xa4\x8f(\xaf\xbb\"\x93\xc4/u\x15 \xaa\xb1\x11\xa9!\xb0O\xb6\x9d\xff\x8bl\x96&\u007f\xee\xfc?\xd8\xdf\xf3\xe6\u007f\xfb\xf0?\xf3\xff\u007f\xd8\xfcGN\xda`\x8aS\x05\x9a,8\x11q>\x89D9qhJ\xeb9)\xa7\xda\xef\xd1,\u007f\v\x98\xe8Igf\nͻ\x1f\xe63p\x90V5\xe7\xd9l\xa7\xd2f\xfa\xae\x9e\xa7\u007f\xd0\xcc\xfcc\xfe7\xca\xc7\xd9,\x9f~T\x01\xb0b\xfew\x8f\x0e\xbd\xf5\xff\xb0s\xf4\x9f\xf9\xff\xe7\xce\xff҉~1\x8bg\xf3\x82Mt\xb1\x9f\xb8\x05\x19q^+f\xcbaZܤ鬆\x01=\xcek3\x01\xf9Y\xbf(j\xd1\xcd4\x1d\x9c\xd7D51\x19ә\x98(\xb0\xed\xb9\x89\xef\xc1\xf8\xdb\xc2\x12\x0f\x0fb^>G\x18nm\x98w\xf8\xfak&w8\xa3xz\x9d\x8dO\xa3n{\xb2\x88\xda\xf4\xe7\xe8Sy\xa3L\xcc\xc0\xd9)ƘU\x0e\a\x8a\x8cb\xf8\b,\xf1\xe2\x13\xa5\xdfg\t\xbc.\xddoˊ7)\xf8\f9\x8d\xf6\xda\x02\x9c\xba\x9d6\x9e\xed\x0e\xe2Q6\\\x9eF\xd1\xcbi\x16\x0f\x9b\xd17\xe9\xf0.\x15\xdb6\xf0\xf1\x1d\x8f\x8b\xdd\"\x9df\x03V\xbe\xc8\xde\v\xa9\xd9\xe9\x12\x90\a\x83\xfb͞\x83\xfe\xaeĿ\x1du\x0e\xac&KA\xb4\xe2EVH(\xa6_q\xafȇsu\x1e\xfc~W\xba\xb3\x86\x89GI\xb3|r\xaa\x1e|\x93\xc7\x13\xfd\xa9\xa9\xc0\xdb\x1a\xc2\xe6W\xba\x80\x1d\xe2\xc3hQ\xeb\xc6ť\x1f\x8f\xef\xe2U\xd8\xf0\xa6aL\xe4\x87`\x9cg8\xd6/BK\xc5}<\x1dC\x10\x18\xb5X\xfc5\x1f'\xf90\x8e\x04\xa9\xefRx\xc0\b<h)}\xabջ\xe77\xdd\x17\xaf\xd3Q>]\x8a\x8a\xdd\x17\xce\xf2\x80]\xaa\xc1\xf1R\xbc;\x19\xe6\xb3\xf3\xda(\x1d\xb5^\x0e\x87y\x9f-\x17\xaf\xe6ө\xd8\xd2\x0f\x97Q\f91\xac`\xbd\xe5,-\xd4\n\"\xb1Y\a\xf67i<\xa1\xa0N\x05k\xe1;\xb8G\x98S\xf2\x16@/\x96\x1c\xd8\x17\x80Z\xd4\xd7H\xcf\xe2\xdbt\x1c\r\xa6\xf9(*\x96\x85X\xb5\xb6h\xe15\xf6\xbch\xc2\xef\xaf!r\x12kO\xe6E\xb1\x10\r\x98W\r\x1f8\xab\xf6B\r\xa0\xfcS\x8c\x04\x90\x17\xdf\xe7\xe0K\xe0͍`\xf6I,Ty\xf17\x1f\x03\xd9\xef\xe2l\x18\xf7\x84\x88\x80'\x85io~\x1d\x8d\xc40\xb7\x043a=Q\xbf?\xcd&3.AމJ\x94Z\x8b\x8ai\x9fK\xa1\xd1|\xd1z'e\x8f\x00\x81\x856\x85\x91\xec\xb5\xee\xba\x1f\fŒ\x87\x1f\nL-\xeb>\x9c\x92\xf5\xff]\xf1_\u007f\x96\xfe\xbf\u007f\xb0\xd7u\xd7\xff\xee\xe1\u007f\xf6\xff\u007f\xc8\xff\xc0\x92\xfaꇿ}\xff&:\x8f`\xdd;\x8b\x9e=\x13kQ;R\x17]\xf3\x01\xce\xffO\xa0\xe0\xb7\u07ff\xf9꧟_~\a1\bpe\xf9\x84E)\x8b'7\x17)\x04\x9f\xab\xa7\xc3\x14\xdc\x158\xc6H\x99\n\xc6ݗ\xb3\xd94\xeb\x89\x05\xa2\xfe\x99\x96-\x9f5Z\xc5d\x98\xcd\xea\x9f5?k\x9c}\xf2\xc0`\x83\xe7(\xb1\xee\x8a\x15\xf1\xaf\xd0JQ\xb7\xdc3\x12X<\x1b\xc9\xfbs\xd5\xc4W2\xf9\x8b\xe5+\x905`%\xa8\u007f\x86\xf2쳆\xae\x8bHs\xe7\x8c\xe8\xbe+\x1e\xa63}\xe7\xfa'5+_\xe5C1M~\xa4\xcc:\xf7\x9f\x8f\x17Ŕ\xbbvx(\xafPҡ\x81\xb3\xcc\xf6$Hx\x83\xe7AY\xf22\xe3~\xae \x1bWy\xd6%\x8a\xfa'{%Ȗ\xdd}\xc6\xee\xe5Ci\xb8c\xc1\b\x8b\"\xf6\xb3f\xf4\x19\xe4\xf1\xb2\xe9\x10\xdcK\bݍ|\xc2Av\xc3n[\xae\xeak\xb7N\xe5\xcbڧ\xdc\n\f\xa8\x80\x83C\x91ʠ\x86\x97\x0ea0С\xc5l\r'J3zw\x94\xd1\fށ+G3\x10\xef\x1c\x97\x8e\xe4\xa1\x03\x83-RȐq\f\xbe:\x8a\xcbw\xef\xaex\xc8I\xdb\xe3\u05f5\xcb\x1bȖu\x1b\xac\x1c\xdaSI\x9d\xa6\x95)U.A\b\xba\xf7\xf2w\xf8\xb6\x8b(]T\x97\xf9\x06\x13\xecBbU\x17zN*\xb4\xb3\xcf\xc03\xfbgvn1\x9b淢;p\x16\xd7\f\xf4\xf9\xd4\xee\x05\x11\xb4\xf5u\xb6H\x93/\xe7Ә\x1e$\xa4\x14ORΊ\xa6\xe7^;\xc2+\xcc\xdfʋF\xa7ZF4\xbdr\xa3x\xf1\xa5\x98\xed\xe8\xbbN\xb4\x8dR\xa7\x19\x84\xf6\x85\u0604\x9c\x9a'\x0f\rv\xbd\xf9\x19J\x1e\xdbAw#\x18&Tb\xdc\xea\xc3\xc4\xe5\xeeѯ[j\xc8\xf5L\x97\xbflN[\xca9\xe8\x0fu\xeb%̷_\x9d\x11G\xae<\x8d\xae\xed>\xc1\xe9\x0e\x9d\x9e\x9c\x1a(\x82\xc6\x146\x9a^\x8c\xc9\xe3\xb1\xff~\xfd\x85C\x10\xcdD\x80J\xb0\x97\x88d\x8b\xf8\xc0\xea$\x8a6\x90+0_\xb8\xa7\n\xc1Jߺ\xd7¢\xba\xe5\xf5Z@\xfb)\xfdm\x9e\x16b\xae?{{M\xaa\xf7[\xa9*\xbc\x8d'\x99\x98\xd7\xf0ʄ\x1d\xe2M\xd3\xdf܉%_\xaf`\xec\x12x\xe7\xf2\xbf\n*'\xb0-&\xf9\xb8H\xdf\b%\xc6\xf1\xb1\x19\x16\xa8ԙRqj\xcdM(\xaaD\x84#W\xbdr_\x12v\xffz\b\x97\xd12(\xb0\xb8!\xe8\x86_\xafD\x02\xa5,\xa0kX\f\xf1\x86\xe1\xee&U\x01At\x16,\xa7;py\x8bC\x9cξ\x14ۺ4\xf9\xef\x14o\xf1\x89\xad\xe9m\x00\xbd\a/E\xac\xf2b\xb3\x96\xb6\x86\xf9u]\xc3\f\xd4\xc4<5s\xe2$\x81bkT\xf09\xd3\xf1\x8e*V\x88w\x821>3\xb7\x8d\x95\x04q\xb4\x80`\x0f\x99\"p\x9b\xe2\x85\xf8[\xa5F\xb4>\xab\\\xa4\xa1x)GI\xb6M\x90\xbc\xa2 \f\xf6U \xb0\njF\x02I_C9\xfb\xe4\u007f\x86\xfeG\xfb\x9f\x8f\xdb\xc6*\xfd\xbf\xdbm;\xfa\u007f{\xbf\xdd\xf9\x8f\xfe\xffG\xe9\xff\u007f\xfd\xe1\xfb/\u007f\xf8\xee\xe5\xdb/\xbf\xfa\xf9텘z_\xfd\xf4\xf6\xe2\xcd\xcb7\u007f\xbb\x10S\x80\xc9\xff$\xbd{K旷d~\xf9Ll\x00\xa0\xfe/\xaf\xbf\xfbf6\x9b|\x1d\xf7\xc5\xf2 \xb56\xfb\x91.,/쉔\xac\xa0֘\x86|\xde^V\xfce\x1fLv\xbf\x90\xa9\xa4^{],F\xc3n\v\x80\xbcy\xf3cm\x9b\xda{[\xd7\xce\xfaӼ\xc8\a\xb3\xff\x8f\xbd\u007f\xefo\xdbF\x16\xc7\xe1\xf3w^\x05\xc3vm1\xa2dI\xb6lG\n\x93o.\xbd\xe4\x9c6\xc9Ӥ\xbb\xed\xc7\xf5\xe6CI\x94\xcdF\x16\xb5\xa2\x14[\x95\xb4\xaf\xfd\x99\v\x00\x02 )\xc9nz\xd9\xfd\xb5{N,\xe22\x00\x06\x83\xc1\xcc`0\xd0\x00\xdc;\xd7\x15!\x16\x95E\xae\xa8\xa6\xb3)h\xfd\x12\xc6n\x04\xa4.\xe6P6ZK\xb9\x95y\u0557.\x8a\xabFl \xc8\xc9*\x85\x92m?\x9c\xf5/\x9dJdoL\xf6KZz\x9d\x1e\f\xf6C\x01_\x14\xed\x9b\f\\\x17+\xe6\xd3\x11ݸ\x02&\xde\x0fG\xa3^\xd87x9H\t\xf8NM\x11*\xb3\xa0\xc7\xf7-\x99C\x8f_\x9eݪ\xbe\"\x97\x16\xc1\xcc)\xe6\xc6\xeb\xb7\xef(\xea\xc6W_\xbcs\xa5G\xfb\xbf\xea\t($\x15.\xec;\xd4=\x14\x9b\xb5氾\xd9ܿP\xe5\x11#\xfa\x9a,\x8e\x95\xfd\xe7\x80-\x10\x0fjh\xa6\x01M\x16\x03\xf9\xc5|\x8a|pS\xbb\xbe\xbe\xae\xa1\xa8W\x03\xf8\xd1\x18\xad\x93\x03\xa1\x8d\xae\xb3~\x8caԃ\x05\xae\xb0\b\xf4U<\xc5\x0e\x9cb9\x8d\xa2\\\x93<\x05\x15\xd0*\x8f\xefD;G\xb9\t\x14\b&\x19͞\xc5\xf5\xbd\x12H\xf7-H<\xdc\xf1\x80\xf1 \xb1\xac\xcfo&\xe4\xa1uJ\u007f\x96\xf3\u007f\u07fe~\x85\xdeq\xf8\x97\xef<\x17\x04\xef\xcf2\xb9~\x01YE(\xb3\xeeW\xf6\xf1\xde$\x14\x81?\xfb\x9em,\x00\x8c\xf3\x01E\xc5&,\x9d\xfeJY\x9e-\xd8:\xb6d\xcb\xe4\x89g|v\xcc\xf2\f\x8d\xcc\x1ei2\x1a\xe5Ӂ\ry\x1b\x9f\x1b\x94\xad\xd1\xebx;\xcb\xd0\xda|\xa7\x93\xfc\x84[\xf8\x9a$\xa3\xd1w4\x1e\x83M\xa1\xaf\xc5L\xbcy\x1c\x89'\x852\xd4jb\u007f:\xb1I\U000be748\xff\x1d\x1c8O\xd3t~\x159\xb3\xcb\b!9\x97a*\xc1\x93\xb98\x8d\xf1=t̝\xf2$\xd9\xf5A\x19\xebE\xe8\xfe\x01K\xe8:\x9c\xc2\xe2qf\x89\x04\xe7\xd3\x0fX/\x83Q4%\x86\x8a\xd13m\x10\x93pv\xe9\f\x12آ\xc6\xc9̉@u\x9aՍ2#\xe1\xf2Q\xe7)\xaeh<\xa0t\x8e\bU0A8\xec:}t-5\x9c\a)J\x18(\xcd?\x12Q\xa4\x9e\x19\xab~\x87Y0f\x82\x82\xe7s\x1fa\tf\x9d\xc4\x15N?\xe8!\x88\x8a\xec\xa5,S\xf6}?\x90\x03\xf2\x8aT\x98-\b\xb4\x84\xffL\xe8oS\xec\x9c\xf5\xbd\xbf<\xf2~\xdf\xff&\xe18\xee\xff\xb6\xee?\xdb\xe4\xff\xe6Q\xfb(g\xff\a\x95\xe0/\xf9\xff?\xca\xff\xe7\rR\xd2-\xdc\u007fdyv\xday;\xef\x11$t\xf7\x13'\x80\xca\xfdF}2\xe8\xdb\xfb\x05\x01/ԝ\xf9v\xf2\v\x12\x1e\u007fZ\xb5\xdf\xc5\xc5O`\x83\xde\x1a/\xeb\xa9y6~\xf8\x98J\xab\x83\xd6;\xf9/q\xb3B:*m8\x1e\x0f\x13ժ(\xfc+\xda\xddr\xd2{\x11\x8d\xa3i8\x85=\xc7<\xe9u\xfeq\x19\x8d\x9d\xe9|\x8cH\xc0\xbc\xc94\x19\xccY\x9a\xc1\x02\xbe\xf4\x17\x85\xba@\xba\x93\x84\xf6-)*\f\xae\xe21l\xfb\xd3\x10\xf4!'\xba\n\xe3Qvt\xfc\x9f\xec\xc8uW\xfe?M\x86\xf1(B\a\x9c?\x8c\xff\x17\xf8\u007f\x1d5\x0f\xff\xe2\xff\xbf\xc7\u007f\x9f\t\xebNM\x10B\xad7\x9f\xcd`!\x89\xbb\xdbq\n\xaba\x01\xdb\x02\xc8u\x1f4\xf7\xa4kq:\xd5KF\x03\xe9T\x04\x12\xf9\xb46\r\a\xf1\x1c\xf6\x91\x96\xf4fBM\xe4b\x9a\xcc\xc7\x03\xd8G.z!\xde$\x17\xffWo{\xf2\xc1\xddQ2\xed8\x9fEQd;l\r\xf14J\xf3#R^RS\xee\xc0&\xaf)<\x03\x1a\xa0øV\f\xf5\xc6Z8\x8a/\x006>\xb9\x1cM\v\\\xa6\xf0h\x1bt$\x89\x9b\xf7\x027\xef\x91\xffQ|,\x1b=\xe3d\x1cm\xa8\x12\x0f\xa7\xe1U\xf4\xfez\x8aǞҭj\x17Ę\xbdjnCN\xde\xc9*\xe7\xec%\xd0f\xba~\x01t\xe1\x01\x97|\x8c\xa6\xc3Qr\xddq\xc2\xf9,1\xd3j0д?M\xa4ժ,\x1d\x88\xa3\xf7!\x9eՀǎeO\x93I؏g\v\x18Z+u\"\x901``\xa2\xf4U\xbac\xc9\xed\xa5\xb6L\x80@|)\xaa\x046N3￫\xf0\xa6&R\x1fJ\x14\x19\x9e\x87\x19\x96,\xfaW\x04W\xb4\x846c\xa8\xbd3\x86\xda;a\xa8m`\xa8\x84\xff\xff\x81\xfe\xbf\x8d\x93\x93\x1c\xffo\x1f\xff\xe5\xff\xfb\xbb\xc9\xff\xce \x19\xefϜy\x1ae\xe2\x0f\xf9\x91\xa5\xbe\x93&\xceu\x84>\fh\a\x1e\x10mQ\x88\xc7\xe4*\x9e\xa1@u\xe5\x90Bp[\x1f`u\xa8@\x11J\x00\xe4{Ḧ\xc9#\xca!x'Ƿ\xad\x10o\xebR\x87\"+\xc0\xfd\x1c\x19\x88\xd3\t\xb2\x06t\xce\xe2|\xfew\x8c!\xf2|v\xc3B3\x9a\xce\u07bf/.\x1a8\xfb\n\xde\xca\xc1CO\xbc\x98\xb4\u07fd\xa7\xf5(\x14(\xca\xfa\xd1\xc9\xc0\xbd\xbdL\xae\xdf0ė\x00\xa4\xe2\xb9N<\b\xdc\xe2\x1d\xdc},\x1b\xab\u007f1\n'i\x84\"\xb7SńG\a\xa1\xf2\x03̰\xf3\x87\xe9\xff\xad\xf6\xe1qN\xff?\xfaK\xff\xff]\xfeSv\xe0\rd\xa6Y\xf2\xb3R_ǃ\xc8(\xb5\xfd(F\xca?;9\x96\x89\xc2ub'u\xb9\x9b\x05j\xaf\x16\xd91\xdaU\xf7w\x90\xba\xf6\xb5\xa8n,\r\x94w\x83\v\xec\xab\xc3\x1f\xfc*\xeb\x88\xc8\xe5\xed?\x97L\xff>+\xce\xdb\xda\xf5}\xa3\xf8\x98\xfb\xac*g\xe3!\x89\xb4|4\x98\xad\xf9B\\mj\x98\xcajEC&\x0e<\a&\r\xfc@\x94\xd6˨\x13\xb6}<Z\xd3s`e]D\xb3\xac\xd38\x04=\xb8\x1e^\xd3\xda0\v\x98\xaf&\x01?\xea\xe2}\xab}\xe4\xd5\xfbz\x86@\x0e\xb9\x93\x1a\x19\xf4V\x1d\xe4\x940e}\xa8\x9aK\"յ\b\xd1ȧ\xf1\xf0ѝ\xea~/\x19,\x8cR\bV\x06\xd3*-$\xc0\x9b\xb4\x96\x8c\xe5\x01S\xd1\t\x80A\x90\xec'\x98\xe1\xb8\xcf\a\x90\xff\x00\xc5!\xb9\xae\x9b\xed\xb2\x94Ύ\x84xx6\xb9\xd9\xef\x16C\xcdȼ\xa9\xafbBT:\xef\xc1\xe6_\xd1)j\x12\xe2\xfd\x02|\x8a\xb4>\x8d\xae@1\xc8!\xa0l97\xcdӨ\r\xfc\xc5\xf0\xf9-\xf6\xf6}\xb6x9\xa8\xec\xc4\f\xb4s\xddh\xa4\xe36\x1a\x95\r\xc6p4\xb5\xa2\x9cX\xe7\x94\xe2X\xf0?\xc1b\xa4#\xe9\xb7R\x02\xb6\xec\xff\xedvÖ\xff[퓿\xee\xff\xfe.\xff=\xba\xff\xe2\xf5\xf3w?\xbe\xf9\xc2\xc1\xc9\a\t\x94L\xe8\xa3p|\x11\xb8\xd1X\x18{\xa3p \f\xe3\xc0\xebC\a\xdd\xe8A\xb4\x0e\xdc\xf9lX;u\xf5,\xf4\xfa\xa8E\xff\x9a\xc7\x1f\x03\xf7\x87\xda\xf7Okϓ\xabI8\x8b{xF XS\xe0\xbe\xfc\"\xc0\xf7\xf2\x8d\x9a\xc8\xc1\x03\xf7c\x1c]\xa3\xc1V+L\xfaw0\x88>\xc6\xfd\x88\x95q_\xde\b\xa8Qx\x92\xa0Yo\x14\x80\x1aD,@\x03[Ѡ\x15\x14\x04\x1d\xfe2\x99\x16\x94)\xd6iX@?8\x18G\xb3\xc18\x04ƚ\xccМ<\xe9\x0f\xc6\xc0|\xaf\x0eT\xc2\xc1a\xbdYo\xa2ⓥ\xa9ˎ\xa2\t:\xf3x\xcc|\x0e\xad\xd9\xc8\xea\xd0\x15\x18:\xfd\xe8\x803\xb9\xe0VU\xc5pb\x9a%\x17\x17\xa3\xe8\x1d^\x93\xb2\xf8\x1b\x85\xb6\xa6\xebS\x81\xc5봟c\x80\xff\x16\xe6\f\xdfv\xd3~\x9b\x8e\x0e\x04\xa5ޗ\xb7+\xead\xa9z=\xac\xec\xf7a\x8f!\x85c\x9f^\xbai\x98\xc7\xc4V5|2\xcf\x024\x8d@\x05\xedG\x95}'\x83\xe4;\xfb\xfb\xc612\xf4=\x1e\x8f\xa3\xe9\xd7\xef\xbe\xc5\xeb(\xfb{\x9f=<n7\xba\xfb\x1b\xcf\xea\xed\xb6\xabPQkd\x1b\xfcc\x13\xbe\xf1\x98A\xa6\xc3\xf1t\x95]b\xc5\xff\xeaRc#U0\xeb\xe05ȉ5ܡ:\xecXUÄ\xacA\xcd\x18\x95\x19\xec\xb2~̦\xa5p\xcb+M\xa6Qi\xad\xcbx\x16\xd5Rة\xa3\x0e\x96\xa3\x9e\xd9\xf5U\xe5\xe8#z=\x8d\xe2t\xa6\x81\xd0M\x9cl\"\xb5js->\xf8\xd3'\xaa\xc4\xc6,H8\x9a\xe2\xddۑ4\xe3Β\x89\x9e-l\xbe5a\xe9l*c\x9e\x99MV\xc0\x87\xf5v\x96\x9b\xf5J\x10\x84\x03\x83\x1f\x1b\xfdRƼx\x8cA\x94j\x9aMoW\xe8\x9b\r\xee9\xab\xb2s?\xbeB\x86\x18\x8eg\xb9~*\xca\xd5*\xcbk#\x8dn\x1e\x9e\xd5\xdb\xcc\xd6{\x19\x0f\x06\xd18GP\xc4&t7\xc3̺\x18\x8eFdY\xb4넰\xba\x12c\xd5iv\xf9Ö>\x15lG-\x9a&\xb5\xa2\xf8v0\xfe\x94[\xd0#\x94c\xc5\x1a\v\xe5\xe9\xe4d>\x1a1\x1c\x87Zw\xf3F\x14fn\xf5\r\xe2\xa5\xfbx\x0f\xaf\x9e\xa4]\xb2\x8eP\x03\xfaMU\x18E\b3>U\x8bX\xcf\xc5c\xcb\x1a\x9f\x91\xaa|q\xc8]\xc4\xd9\xe5\x01\xf7,\x99\x85#\x8ez\xa1,4\xf2,R\x1er3&\xc4\xddYq\x80,bۼ\x8ba3\xb9H\xb3\xf3g\xbe\xe1\x8c\xf0DP\x1c\xed$}2\x8dǳ\xa1\xe3\xfem\xe0ТK]\xa72\x8a\xc6\xd04}yX\xbaF}yG\xbd\xc2\xca\xd2\x18\xf5\x99\xeb$\xe3\xfe(\xee\u007f\x00f\xa6\xed,\xe8a\xe5uM\xa1\x17\xd0Ȝ\x18Ѩ\r\xa6\xa5\xe1\x85\tK\xe0\x8e?\xe8\xdf\x1a\xbem2\x89\x06\x1a\x0e\xf5\xf1rO\xf5\xe12\xb4\xe9c\xcb\v\xe9\xd1l \xa1˥\xec>~\x84\x8b\xf9q\x0e٘\b;\xed`\x03\f\x9d?\xba\x8fs\x1eO\xe6p\xf2\xfc\xb0\xa0\x8a>*<\xfd\xce\rj\xc3\xe0\x8a\x06\x99qQ\xb2\xfa\x01e\xb0'E\xd1\xc0\xb4\xfa\x8f\xf1̾x\x94\x00\x05_\xb9u*t\x99\x02 \xa2\xbb\xa5뻞\x83\x8f\x1c\x13h:\xef/m\x00r\xa6%\x03W\xfe\x0f\xb9\x1a\x88\xc8\xdcD䚰A\xe7AZ\xa0\xec\x02\xb8J\x92\xd9%>{\xa6\xb9\x9a|\x87\xce\x00\xe4R`\xacBA\xb8\xd9\xfd\xf5\x03fA\x90E\x02\xf3\u007f\xf8\xf9\xbf\xe6\xe5\xf0G\xd9\u007fA\xdd\xcb\xd9\u007f\x0f\xff:\xff\xf9\x9d\xf4\xbf]\xce@2\xa7\x18\x19.\xa1ΫIw\x1e\xd6\xcf1\xfe\xf2\xab\xfc\x8fY\xffV\x14\x9e\xdf\u007f\xfd\xc3\xeao\xd8\xf1\x1fZ\x87GG\u007f\xad\xff\xdf㿺$\x80\xf7|s\xb6>\x88@\xd0\x1d-'\t\x9d\xa3\xb2\x1c\x93vH{S\x8e'*\xbe\x0f\xaa9\x8d\xae\xf4Riu5\x85O\xb8\xb4\xe0\xff\xeaM\xaf+\xdcP\x1a]VF\x9b \xedk\xfa\x84\xb4\a\xd7[\xed\xd4\xe1\xf0\xb4\xdd\xdaU\xf2Km[\x99dk\x89\xbc\xabEA\xb1u\t\x1a\xea\xf18\xa4\v`KQ\xa9\xd3(+\xea\xd4A]\xe6\x03\x13\xadx\xb3\xbc\xf8\xcd\xfbQ؋FK=\xc2\x14Ǘ\xd2bJ\x99^%\x87\x805\xe9\xd2t\f\xbfe+\xf5\xb6(\x88xu`n\xe2\x81\xf3Y\xd4\xc0\xffu3\xfd\x8bܢ\xf2s\xa8\xcd\xd9g\xc3ᰫ\xeb\xfd\xe3\x04\x95\xfe\xcdC^\xe6Aj\x04Q\xda\u007f\xc0~te\xf7n#.\xd4\x14\x94\x91ّ\xd7eO2\x1aG\x0e#VQ\xa1\u007f\x92\aP\x13\xba\"\xbe\x91\xa4k\xf8}[<䦾\xac\x9b\xa7\xdef@\x9d^\x04\xaab\xb4\x14\xf6Ȏ\xfbS\xab\xddo\xb9\x05SG}\xaf\xd5\x11\x91<p\xbd\x99\x13O\xac\xb5\r$;HfK.t\x04\xd8\x17\xb6\x03\xfc\xa9#\xa7\xa6%\x10vp\x1a͉m\x17SVrS\x83V\a\xc9u\a#\x9c\xc1\x04\x1bsp\xec\xe5hO@\xe5.\xb5\xb2f\xc8\x12Сi\xecJs\x06\xb1\xa4\f@\r\x14\xd4IG\xda_\xa0鍣\x96\x93%\x81\x91iĮ\x91Q\xb6\f\x1b\x97\x83\x99~\xbc0at\x95U\x85\x8d*\xf9^ܼǐ\x10\xcb2fj1ʆD\x01\xd3)\xa0p@\x97\xe0\rL\xb6\xbcn\x01\xbf.kک\x93\xaaXЃ\xbb\xacF\xe0=\xf9\x95b\xd0\x0f\x93\v\x8d\n\xfa\xaf\xf5*\x1c\x8f\x93\x19YD(\xd88\xb2⥠\xc1f6\xf7\x88\x96<[\xd3\xe8\x91LG\x9b\xa6\xaa\xa0\x1d\xa7\x9e%\x16 B\xf4\x02y,Oñ\xbd&\x10?\x1b\x16\x83N\x97\xb9\x95\xd9j\x17p\x00\xad\x93\x84\x88-\xf4Q\xcb\xfaf\x92H\xab\x90\xdf\x1dzƺ\xd9\xda\xfcN\xebèE\xe6\x8ce\xb9\x00`,\xdb\x1d\x06\xb7\xbd\xb1;\xf6QT\xa3\xa8:\xfdi\x14\x8d\x97%\x98\xd9B6\x8e<j_\xda\\LlB\xe8F\xacV\xc9C\xb5\xf1\x11yl挧^\xd1\xc6iSx\xb6\xcd\xe6w\xf9LHpN\xf9\xff\t\xc3M\xfc\xc1\x04\xdcl*\x02j\x1e\xe3\n2&\xa8?\x9f\xa60\x06\xc1U\ue014\xa2M\xacW\xb8\x89\U000462fd\xd16or\xd3V\x18\xaa\xe1\xfao搴{\xb7Ĝ\xfb;W\xe8\\\"\x1f_n\\\xc60K\x02Mw$\x1cш\x9c\xc6v\xe3\xd6\x03\xcah\xb0l\x11\xec4\xce\x120\xdd\u009e\x89e\xb5x\x8f\x91\x87\xfc\x82=\x06\xd3\xdf\x0f\x0e\x97\xc3x4*\xe16\vڈR\x87\u07be\xf0K\xf6)\x04\xc2%\x96\"\x92\x95\xc1R@|\xe0dM\\\x00 \x93\xa8\xc61g\x90\xfe\xfbS\x18O\rϚ\xd3\xddv\xc8\x05\xfc\x00\xd6Y\xda/\xccͺ%\xf1Skv\vzز:\x88$.\x12\x06!\x80\x9dN\x01\xd1M\xa7Y\xda\v\xbc\xad\xbc\xa9\x13\x98\x9faYva3\xc6\xcb`*\x84\x17\x03\x85r\x8br\xa0\xb8Z7\x02\xc5\x02KMb\xc8)%;\v/\xf5\v\x90\xad\x84\x04\xe3\x97u\x88\vQ\xa34\x12b\xc5\x1aWֹ\x8b\xe2-\r\xe6.\xadvۗ\xff\x0f\xfb\x96\x8fY\xb5-\xf9\x1b\xb2\x1b\x9b3\xb7An\x94eֶ\xe7n\x80]\x8a\xddx\f\xec \x8d\xb6\"X\x96\xd3p\xac\xed|6\a\xb7q\xac\x18h\x0e\xbbfNaF\xa3,\xb9\x1cN#\x9f\\۔^\bI\xc3\xd9(\xba\x00\x16\x93\xd7\xdcm\xbaֵQMF8j\xe0\xffԆk\x9c%˝\x1b\xeb;\xed\x9cl\xd9\xda\"\xearϬ\x8d\xa5\xd9ȕp\xea\xe95\xc6{\x11\xda\x1f\x89\xd0R\xf4n\xa8VK\x94\xe7\x96W\x00\x8f\x84V\x0e\x15M\x91\xa2iL\x12$F\xd86\xcf\xe51\xdcu\t\x14շB\x04\x19\xc7ƇE\b*\x02K\x96\x16qs\xa7Q\xa4\xb0\x98mi\x13\x19\x8f/a/\x99\xe5%z\xb2(\U00079c98hYT\xf7^8\x02v\xa3\xa3b\x8c\x87\xc0#5\xcd&/\xb2\x18\x9f\xec=;\xe1\x8aIU\\\xf4\xb8\xb4\xe4\xd2\xc0Q\xbde\xdaY\x1a\xba\xe1\xa8eIzz\xc1\xa3\xd2\x19\xaa\x03\xb6\xf0\x80m\x90\xf5\xe6(_t>Z\xe2\xf9'+\xef\x14\x10\x87\xb7\x145\r\x19\x12D\x12\xad\x992\xc9S@\x1d\xc5KU\xcf\x11\xc2s\xf7\nF˔|\x8a\xc3\xdbh\xbaQpr2^'\xcf*\x1bE\xa2x!0\xa1\x8cl\x84\xd6*\x02\xf6'\xb2\xff\xffF\x97@6\xda\xff\x9b\xc7'\xad\xb6\x1d\xff\xad\xd5j\xb4\xfe\xb2\xff\xff\x1e\xff\xa1?\xa0\fK\x1aP\xf8[^6\xca\xf1\\%\xf9I\xefgo\xc9\x11\x92\xa7\xb34P\x19\"\xe0\xa2[w\xbd\xae\xc8F7J\t\x15Xʔ\x83\x9b\x05M\x9fC\x98\x05\x04A\xc63\x8b\x1f\xc9\x1f\xd5*7 \xe2տ\x81R\\\xf4,>\xef2\xd83-\xef<(H[\xad\x96kQ\xb6({-\xbcU8k\xedct\xc7l\xb0j\x88\x98\x1c\x9c\x9d\xab\xbe\xc37F\x1a\xc0|\n\x1cIт\xe1\x97\xd7\x15\xf00u\xeds\x18\x8d\f\xde Jg\xf1\x984-?M\xe6\xd3~\xe4-%\xc8\xc94\x99D\xd3\x19\xc1\x95yZ\xf93\x99\u007f\x1epn\x96 Ǡ\x95^\xfb\xfd\x11\xf0Vk$\xf9\xc8Z\xf4\x13\xddl\xc6\x17\xf1pA\xa5\xbc\xf5\xba\x1b\x0f+ȟ\x93!\x06P\x98\x8f\xa2\xfbA\xe0\x02\v\xa3\x10\x1f\x03wo\x8fS\xeb\xd1\r\xba\xa2\xa5\x8c\xa2\xc1a\x80\x81\x9a\xe2iTq\a\x870\xf7f!5\xff\xeb,N\x11\x88\xe5\xbdpD!\xd2nf\f\xe5\xfd,yK\xdd\t8\xc0[\x1dF9K\xb03u\x99\xe3\xbf\xfa\xfe\x9boޣ\x8frྚ\x8fF\xae\xff\xfd\xab\x17_|\xf9\xf2\xd5\x17/D\xea\xf7\xaa\xab\xfe\xb3\u05ef\xbf\xf9\xe2\xe9+\x91\xf1,I@\"\x19\xbb\x00\xe2\xdbg_|\xa7\x80`\xd0]\xd7\u007f\xfb\ueed7\xaf\xbe\x12\x89ܖ\xeb\xbf~\xf6\xbf_<\u007f'\x12\xb9O\xae\xff\xe5\xf7\xaf\x9e\xbf{\xf9\xfa\xd5\xfb\xe7\xdf<}\xfb6p\xcf\xf8}\x06\xe7K1\xb2s\xb7\x9bE+O\xbf\xd4&\x01J\xa9yPc\xadc\x88.\x99\x1b\x04\x81\t~\xad@19ݖ\x8a`2\xf9W\xfd2L__\x8f߈\"\x15Y\xd6\xf3v\xa3\xb3n\x01\x9d\xa9\xbe!\xc1\xab\x01B\x93\xef`\xca\xe47\x90\x8f\x86Fo9\xbb\x9c\x82>\x86\xe1\x14\xb1\x14\xc5kY\x8b\xc8f\xf3\xd1\xccXh\xfax4\xe8\xfc\xb3|@K\x01\x8a\x17\xa6J_˥\"\xb2\xb3\xeesw\xbdez\x1d\x83\xa8\x89\xbf\xfa!\xe83\x18\x93\xad#\xea(\xb2\xebR\xd6\xc7\x04\xc4\xe0\x86\xcc4I\x90FC.\x14b\x19%]\x01\x18\xbf\x19\xb6\xdb\x13\xc4(A\xe8\xa4JM\xb8c&̬\x03\x8ah9\x9f\x17\xae\xca\xd7\xe8W\x8eSC\xfbZ\xa3H\xb5@l\x92\x94ݥ\xd4\xc0X\xf54\xa6t\x14\xf7\xa3\xe0)\x9a*\xb4\x95I\xa9\x19Ń\xf0F7q\xd0\xeb0\xad\fǼ\xb2i\u007f\b\x86c\xb5\x8e+\xf8\xc2)\xe2\xe4\xe0\x9fg?\xa5?U\xce\x1fH\bg\xff\x84\x8f\x9f*\x95\xb3\u007fz\xe7\x0f\xbc\x9f\xbc\x03\xef\xacy\xae\xfc\xc0\x0f~\x82\xff\xd5\x1f<9\xfbi\xfa\xd3\xf8|\x05_\x0f*O:\xf5\x15\u007f{\x0f\x9e\xfc\xf4ৃ\x83\v\xdfu=\xadNZ\x15Ibw\xf2]ū\xa9gb\xf7\t\x82\xe6\xde\xde}J9k\x9c?9;\xef\xd0\xef\fy(=\u0098|yO\x8b\xb9\xd6{\xbe\xf4\x06Ó@\xb3\x9bZT\"\f\xe6\x93\x01\x06/?\xeb\x81\x12V\x91\x15|\xf2\x99<\xf7%\xcaR\xd5)\xed\x9a\xd9hA\xae\x95~\b\xf4\xab\xfa!\xc0\x91\xd5\b\xab\v.L\xdf\xdf\xf0P\xe8\xb7\x18\x97\xdci\xb1\xa4\xdchA,\x1eE\x15\xfe\xa8\xd5<*~\xa6\x01\xa8r\xd69U:\x13\x1f\xb2\u007fT.\xeb\xceU4\xbd0{C\xbf\x03\"\r\xe6o\x9c\xd9P#̏ \x03GH\x02,\xf7\xe5\xde\x00k^\xe1H\xf4\xffQkoO'e\x95\x0f\x13\xe7y\x92\xa0\x01q]k\x8a\xa81\xb3g\xa2\xa6\xdf\xf2J\xe7O\x8e\xf0\"-\x98-\t]L\x97\xe86\xcd\x18]P\xbb\x9a\xcc\x16r\x1b\b4\xd0k\xea\x1b=I\x11X-\xbe|\xfb\xfe\xc5\xebWﾀU\xff\xfe\xd9\xf7_}\xf5\xa3^@\xb1G\xe0\x8bK\xb9\xa0:\xcd5!j\x82\vW&\xba\x9e\ue3fb\xd6.\xaa\xad+^\xb6f\xd3y\x8f\x1c?\xb1OV\xecڊ\x92\xf2P\x86\xa2p\x98\x82\xa1\xc6\x11\xf2j^\xfeb\xe4\x1aj\xa0'\xdaޗU\xa1\xe9\x91\x12\x99J\xad\xa7\x97\xf1p\xa6w\xe9\x83\xe8\x0fNa=\x8b\xa8m\xac\b\xd5\xdaZ\xec\x8dT\xc9'\x84\xd6\xc5\xeb\xc5^\x97\x12\xeb\xe9\x1c\x9ab\xefV\xa1\xa6\xcbt\x1e:\r\x06{\u0379\xb0\x17\x88\x8c\x8cӉ\x8aY\x82\x00\x91\x15\xc0MM\xd6\xebΦ\x8b\xa5\xa8\x915\"dE\xfc\xf0\xd6\x14d\xb7\x02;\x02\xacl%\x167\x94X\x9ca\xa7H6\xe6\xb6A\xf9\x15\x03\xd5q\x8c\xc1\xe8a(\xf7\xad\xfeix\xf4ʳ\x02\x83^\xed1\xe2\xe1\x00\xec<s\x8c \x1cP\x9e\x12z\xf1##\x1f\xadgRL\xa1\xa54\xee\x83\x00\x01uib\xb3Y\xd9۳\x12\xb2&u\x82#AC\xc0\xc3!\xe6\xd6\t\xad\x01!\xf1\xc8Up\xbf\\\x9c\xf44,\xd3\xd4hK\xa7\x9bA\xa2\x8bï\x87\x05\x80DN\x1e\x8e\xc8p\xbd;\xcc\xedR\x17~\x02cb}\x82\x9b\x97ΐE\n\xd4\"cT+\x8f\x8a{{{\xe6\xb6̩\xb0\x16\x81W|N8w\xb9Q\xc1&)\xbf\xcbM\xf1\x9e'\xe1])iAcHrW\x10\x1d8\xbb:/Y\xa7\xebL\x1a\xf3\xb9)\x8f[\x91x\f\x88\xf7\vv*Ҭ\x92JGЋ*\xf5@\x94]\x135\xa9i\xd2\xe4Z\x02\xb2\xd66\b\xf1{\xc9\xfc\xae\xc3\u007f|A\xbb\x9deFǝ\xec\xe7z\x8d\xfc\x13pn(1\x99JT\x98\\gNO\xff\xae\xf1:\x9aUL\xcf^{\x15\xa92y]\xf5R\x88Ҳ+\xae\xf6\x14\x11ީ\xe4\xca\xdf\xe0\r\x03\xad\xbc\x9d\xa7o\"\x99\x96'\xefN\xdbz\xde\xfd\x8a\xdbWP1\xea^\xf1\xd5|7t=\x90\xb93\x12\xc1k\x9b\xde\xd2\xc5\b2(\xa3\x82\xcaD\xfb\x9c\x82\x85\x12{\xa0\x81\xf6i\x9a8U͘\xeb\xe3\xdb\x1a\xcfg\xd37\x98\x12\x10\xd0:\u07bf\x13ͮV\x94\"\xbe\xbc3\x05\xe3\x1cM\x13PO,U\x1f\xba\xf0n\x1a_\x05L\x1fZ\xb9:$\\\xadVy2&\xd2Q\xb2\xe3?Ax\\\xc1\xff\u007f\xce\x12\xe4\x1a\xc8y\xfa\x92o7\xb2(\xac\x83\x14\xd7\x1e5\xa8\xe8\xc3\xc5KK,\u007ff|b\xb1#k\xe0\xa5\xcf\xeb\x1ewMTv\xb0\fsDX\xf2\xb0\xa13\x14ѿX*2\xb5\xe6\xda\u007f\xf1\xfa\xdb/n\xb2y%~\tD\x92\x86\x17\x91\xd8<\xe9^+mW\xf4\x89a\x18\x03\xaaՏ\xe8>\xec\x19\xe6\x9ds\xa6\xa8\x19\x88\xbfk\xbf\u007f\x19\xf5?\xbcK>D\xe3\xa7\xe3\xc1W\x18\x8d\x18\x06\x985\xa7&џa\x19\xa6*\xfc\x85B\x88\xab\xebz\xd4b\xc5}\xfb\xe3\xabwO\u007fx\xff\xc5w߁\f\xfe\x14_\xf3\x82\xf5\b\xaaT\x02\xf8\x19\x8d\xa2\x8bp\xe4\xb0^\xe3\\cD\xe8Iԏ\x871P\xa3\xb7\x06\xc8 \xc7\x1f\xd4g\x18\xb1\x9b[+\x80\xff\xf2\xd5ߟ~\xf3\xf2\xc5\xfb\xe7_?\xfd\xee\xe9\xf3w\xa00qS<\xfb\x8e\xb8\xb9\x85O\x12\xaa\xa6\xf1\x02s؟!\v\\g⭘b\x16\x11\xeda\xae\xfd\x82%\x85\xf4\xca3\x8d\x84u\x15\r\x9e\v\xb9BP \x83\xc2R٥Sϗ\u0087Y\xe7\x89\xf9)\xd4\x16\xd2c\xbc\xceٹ/iIJ\x15%\xe4\xc4L\x10\xb7#Q\x10%\x03f\x8d\xefY\x00W\xef\xa1\xe9\xac\xc1\xec#\x13l\xa6\xb7\xad\u05fe\xbe\x94aq*dhK\x01\xa4\xa9\xac\xd8W\xf8\x9e\xd24\xc8/5\x9c9U\x9b\xaf\x90\xad\xbb4\x95:(\xb2\x11h\t]\xb3}\xf2\x93̀\xc7\xc6B\x86\x11\xafV(\xb6\xae\xedZ\x92\x16\xb4\xc5\xc34L\u007f\xaa@\xbfR\xb2)\\\x04\xbc\xb7q\x15\xe0\x9c\xb0\x16\xed\x06`\xbb\xd8\x00\x1b\xe8y+\xdc\x00\xe1\xea\xd3\xc8\xc9\xdd\xc2\x19ę\xb1\xbb\xc0!+6\xf4\x82C\xaf\xe0\x9a\xde\xda\x19\x12\xe91\xf1\xbe\xd6+$L\xe0\x92\x94\xee7o\xd13\xbe:\xf8\t\xf1\x03\xd8\x16@xo\x15\\\x1c\xc7/\xd3\v:!D\x89\x92M\x00o\xbdU\\\a\x18C\x97\xcdN\xb0\xaf\xd4y\x83\x94f'a\xa3\xd6\xf7\xb6\x17Q\xda\x0f\x96\x17Ѭc-\x00?\x1a\xc3\x06:\xc5ò\x0e=\xc3\x06$8\x8c/\xe6Yʚ\xb4\x86\u0086*\xfa~h\xac\xc0\x89\x9fk^\xe9\x167Ē\xa3\x9b:[\x93\x10a\xad\xe6\xd1\xf1i\xeb\xb0\xd5ny\xcb\\\xc5z\xd6ŀ\xefp~\x8aάiJ\x1c\x85C}\xcb|\xff\x9eA3\x8a\u07bfg\xf6#a\xe7\xb2+%\x8dq\xbe\x87\x82\xd35E\xbb1-\xd9\u07fd|\xfe\u007fo\xbf~\xfa\x8f\xf7\xaf^\xbf\u007f\xfe\xfa\xdb7O\xdf夝|\x91ժ\xb4\xb6i\x1d\xf3\x96\xd6됦ص\xde(\xc0\xd1#Q\xba\xd4F\t\x19I\xb2A\x05\xd592\xdf\xc8\xf74i볠\x00?\x8d\x06\xb0\xb99\xd3h\x18\x81\xe6\x89O($\xb8\u05c9J.\xafO\xf1\x15\xe8\xf08\x87_\xf4\xe2\f\xfe\xcd\xe90А\x8c\xb3K:\x88\x9d$#v\x18s\xfb\xe1t\x10\x8fÑ\xeb\xf3\xbb\x84\x1d\xf7\x97h\x9a\xb8\xfeU<\xee(\xfc\xf8W\xe1\x8d\xf65\x99F\xf4rP\x87\x8d\x12\xd9\xc0I\xb93\xda\xf3\xf01\xbc/B\xa0d\x85\x8e\x0f\xbc\xde\xcf>\b\xcb\xd4\a\xe0\xefF\x1dHY\xb3e\x8d\xfb\xce\xe4\x10,\xd7\xfc)x\x93x\x16\x83\xb4~~\xdem4\xa4\x9d\xaekY\x1c쉠l\x92\x1c\x00\x8cx\x06N×\xa7#R8\xe6\x15\xb0\x17lN\x16\x1a\xc6#\xc0i6\xc2T\x96\xba\x9f\xaa\xb3\xf5\xb5\xb7\x96\x80go\xa1W\x15\xe1\xabA-s\x1c\x14\xe1S@)\xfc{\xed\x19\xf3]W\x8b\x85\x98\xa5k\xba\xf3\xb8\xa2\xf0\xc78\r\x06\x87\xd0\xce\b_\xd8\xd1\xeb{\"TU\xc5M?^t\xe0\xff]H\x99ͦ\x15\x8e\xca\xe2\xfa\x02\xdd\xf0[fp?D\x0e\u007fxʺOa\xba@ص\x9eS\xfcN<c\xc94\x8f\x85V\xab\xfb%e\xec3\x00,\xedy\xea\x91\":V\bJ\xea\x9eaaR\x9c\xefO\xa1\x85i\xa6-\x9a_\xe2պ\f*\xcd\xdd4\xba\x00DFS\t\xad\x82\x1c`ZY\xf2\xbb\x8fXd\xed\tQ\x8b\xde\x05\x12\xa5hv\xe4C\x9d\xab\x95\x9bb\xb4v\x97\r\xa0b\xa9\xc5i\x1f]\x11\xbeC\xf7䊜v\x93\xe02\x82\x12DG\xa8b\xc3|\x9c\xd2_\x99\x83j\x9cx\xba\x10d\x9d\x19\xaa\xe8\xc8\xd0\xccg>=\xdeŸ\xdc\xdb\xf8b\x1c⃔\xe5V\x13\xa1\xddg\x14\x8f\xacH\xb6\xc2ϩ\x84\xc2H\xdcqܪ\x05\x97\x8c\xa2\xe4ґ>O\xe6\xc0z\xe4:\xb0\x97\xba\x18W\xc5\xe8:wJ\xca\xfe\xb2UA\xa2Z\xeb|\x8aAͣ\xee\x80k\t\xdf*2\xab\xe1\xb33\xe3\x84^\x83\u0092ցhJZ\x87\x8dW\x86c\x01\xa2\xf7\xa4\n\x86nC\xe4ʄ\x81\x9b\x80\xbf\xce\x1a\xe7\xf5\x1b\"Յ\x96\xb2\xd06/\x90\xb8\xe4i\x90ڒ\x16Y\xda\xde\x1e|\x90iVv\xea\x86\xde\xd0Y8\x99\xe5\b_nf\x94;\xe9e2\x1f\r\x9c\x1e\x9eoa}\xc6n\x14\x0e\xb0\x8c[\x95mV]\x02\xa2\x12\x16\x88\v\xee\x9fP7\x1e\a\x87lm\xe3N\xb7`\x18\x8f\xc4\xef&\xfc^\xad\xb4\x8fG\xdaXU\x86\x01\xadf\x17+\xc20\xefo\xb0\xa7A\xefS\x0e埌\x9d\x1b\x87\x8c:)\xbd\xf4#\x8a\xf3۽0\xf9\xb4\xe7\x82\x04\xe2\v\xed\x82\x17\x19\x00{\x91\xe0\x15\u007f\xdb\xf0\x8e9\x81\xce¯t\xebW\x9a1p\x82\xb1f'\a\xd8\xed\x90g\xc2\x1fz\x10ˬ3Pu\x064.\xe0\v\\)\xbc\xa1J\xe1͖J\x03\x1dCX\x9bsΠ9\xdcY\xcf\xd7\x05\x8c#7,\x1e\xac\x10\x8a\x05\xf3\xe4Ċ`<\xd4\x1b\x8a\xa1U\xe7{\x90\x15O\x96\xe0?0%u\xba4Q9kh\xbc\xfe\\\xd4_l\xad\xbfP\xf5\xb5\xfd\xc0o(\x00\x80\x82\v\xd8w烨\x1cԙ\x84\x05\xb8\xaci\xbf}\xf5\xbbi\xa4\x9f\xdb}\xe6V\xcf%%02lt\x11[\x8e\xe8YSA\f\x98@\xaf\x9c\x16\xb3鮉Y\xf5\xd0i\x91ȑgt\xeaq\xb2\xa5z\xae\xcbS;>\xd7֧\xaekvi+\t\xb3\x14B'\xae\xc5$&h\xb9<\xdf\x16Q\x06\xa6\x9e\xf4\x9e\x0e\x81 U\xae2!\u007f\xb1\x19\x9b\xa5\xbd@Xd\xf9\x8b\xb6]\xf9!uɲm \xdb\xe3D\x11\"\ro\x99\x81F\xd5\t\x10F#\x97\xa9O\xd4&G\xde0\xfcT]\a\xff\xed\x16\"\xa7\xa4]\x9ftۂֳ\x1d\x93&\x81\xf8\x16\x95%\x99\"\xcb\xf1\x96\xd9\xef|#\x80\xc9\x01,\x1d\x1dt\x05\x12p\xfa\xd7R~S3]\xbfL\x92\x0f\x82k\xe6 \xc1\xf67\x05u\x94p@\xbf\xebC\xb5O\x8f\x86\xfe\x19f\x00\xd9\xf3\xach\x04NSa\x92\xef|L\xf9b^\xde+\xe1\x03\x13)\xd8\x0f\x03\x19\x85\x8bd>õʿ\xb8\xa3\x90)>Y%\xa0\xe6\xc5o\xbc\x04\x91\xad,.\xc6\x13\xb3\xd63\xb4߫\x956c\x16\x1e\xc2ጟ\xcb.D\x84\x0ep+>\xe2\xa0ѽ%\x11J\xd1\\\x1c\xb1J)\x86\x9a\xd5Gp\x16W\xab\xe7\xc6T\xe6G)-MZ\x92X\xfdy\xe4\xe7x\x15\x8b\x84Zﻷ\x10\xb0\xb4b\x81\xf6[\xee\xd1\xeaxjX\xd1r\xf7\xf6\x8c\xdc\xfbzM\xb5m\xf3P\xe4~ܧkF p}\x8c\x9cA<\x1cҕ\x1c%\x81(\xe9\x04\xb7l\r\x18\b!\x1fS\xdaŵ\xe6\xaan\x17\xa0F\xb9\xb7\xea\xf1\xe6A\xc5s\xad\xad\xde\"\x9a\x80\x16\b\xdaP\x89z\xe0\x87D4{\xb7\xe8\v\x13%\xb0L\x83\xac\xdf|\x1b\x8f\x85\xae\xa9R\xc2\x1ba\xdcO_\x8ey\xe3\xa5(\xb0\x055\xf7\xf6\x06 \xdfة^V\x91\xcd-\xb9\x9a\xe1\r\xd5|\x9ck\u05ee)O\ad\xb2q\xda\xcfcL\xc6\xdf\xf3fR\xb0\xf3\x14\xeeTl;\x96eԖi\xaa>\x81\xf6\xf4\xa0T\xdd\x18\xa5\xf2[Pg\xf6M~\x99Vڙb?\xa4\x98\x05\xf2s-\xcf-\xd8V\x16\x15XF2-x\xb5\xd2\xf4_\xb5\xaf\xb0\xca\xcc^&w\xb66|@]\x15a<\x11v\x87\xce\ayT\xf3D\x94\xe9l4Dl\xd0\x03M\xf6\x8b\xe3\x17>1\xb9\x9a\x1a\xb2}5~!\xa2O\x83\xc0\x95ٮ\xb74\x80\x06\x05\xfai\xf1tV\x8cz\u009a\xaa\xf6\b}\xbe\xce\xe5Z\xef\xa3F\x81\xafF\f\x81>\x1dY\x02\xd6\xedtmv\"\x0fb\x9du\x9f\x1cs\x02\x97\x157\xbb\xff\xd9\xfc\x8by4\xed!6Q\x10,\xfc\x87(-k\x83\x17\x10\xa8K\xca\x1c%\x05\xbe\x05pV\xb1\xbc.\xa2\x19\x9a\xf0\xe6\xa0^\xbc\xc5t\xc3\n\xe2\xf3K\xad\x1cy\x9aR\xfeA1iɥ\xf6\xe5\x18\xb6;\nj\r0\xa4U\xe2靖H#\x89\xe77\x1bFm\x0e\xc0\xbd\xbd\xba0\xa5P\xfdu&}\a:\xed\xeb\x1dZ\xad\xf0\xb6\x89&\xf2\x06\xda\xcaPE\xbf\x16\x9f\xadvC\x99\u007f\x84+\xc6G4\xaa\xdf\u07bc\xb3\xb6\rhb\xbe6\x9fb\u007f\x19ߠi \xad?\xc7\xcb3\xba5\xd4\xcc\xd17?\x9e\xff\xfee\x84\xae\x85\x92\x9f\x88\xef:\x1e\x1d\x02\xe7\xbbj\x1e\x05g\xeegQ\xbfw\xf2\xf0\xd8\xf5\xdd\xcf\x06\xfd\xd3\xe1I\x03\u007f\xf5Z\xe1\x11\xffz\xd8:=i\x87\xf8\xeb\xa4y\xdc?zH\xe5Z\xd1\xe0\xb4E\xe5z\xd1\xd1\xf1)\xfe\n\x9b\x83F{\x80\xbf\xa2\x93~/bx\xa7a8\xa0_\xe1\xe9\xe9i\x9fj<\x1c\xf4[\x83C\xfcu|\xf40\xea\x11\xbc\xc3ӓ0<tё\x91.\xcbI\xdd\xc0\xeeo\xab\xd1h`\x8f\xdb'\x87\x8d\xe3!\xd6l7\x8f\xfa'\xc7\f\xed\xb8}JpO\x0eO\x0f\x1f\x1eQZ\xefa\xff\x84\xfatz\xd4;>nSON\xfaa\x9b\xc78\x8cN\x8e\xa8n\xd4\x1a\xb6[4\x8a\xe1px\xd2\xe2\xb4\xfe`Рr\x83\xa3^\xb3IP\x06\xd1\xe9\xa9H\x8b\x8eį\xfe\xc3f\xbbI\x90\x1f\x86x\x9d\x81z\xd0k\x1c\xb5hd\xed\xd3\x06t\x86\xc6\xd8l\x9c\xb6z\xeey\xe9Ț8\xb2ְutH#;\xec\xb7\xfam\x82{\x14\x1e\x9e0\x8e\xdb\xc7\xed\x16\xcf\xcaq\xef\xb8wҧ\xb6Z\x0f\xdb'T\xe3\xf4\x18\x90\x1d\xf1\\\xf4\xfam\xfa\xd5;\x1d<l\x13.\x06\x87Q\xe3\x88\xd2\xfa\xfdp\xd0\n\xf9\xd7\xe9Q\x93f\xa5\xdfl\xb7x\x8c\xe1\xe0\xf0\xb4\xd5$xa\xb3\xd1\xe4\xd6N\x9b'\xcd\x13\xea\xc1a3j\x12\x94\xc3A\xf3\xb4I\xbd:l5\xa0A{ddO\x8d\xfb\x0fqTG\xad\xc3\xc1ѐ\xc7r|z\xdc\xe0\x199\x1d2f\xc2V\xef\xe4\x90\xc62\x18\xf4{m\x9a\xc3~;<lQ\x8d\x93\x01L+\xcd\xc8\xc3\xe3\xc3^\x8b\xf1\xdbo\x1d\xf3,\x1d=l\x0e\x0e\xa9g\x80\xb9\xf6QXNC\x18o\x9b_\x03\x0e\x96\xed\xc6a\af\x18\xe8\x00g\t\xfe\xed M\x1f\x0e\x9bG\xf8\xd5\xe8 m\x1c\x1e7\xa1'GM\xfc\x82=\xab\x1f\x01Ǝ\x1a\x0f\xf1\xab\x05\x8d\xf6\xf1\v\xa1\f\x8f\xda'\xd1)~\x9db^\x13\xd0\xd8¯&By\xd8\x1a\x84\x11~\xb5\xa9\xe4I?\xea\xe1\xd7Q\aWD+|8\xc4/j\xafu|؇\x11\x1dR\xbd\xe3a\xd8h\x1d\xe1\x17\xf6\xec\xf4\xa4\u007f\b\x94\x03_'X\xaf18=\xea\xe3\x17Bi\x9d\xf6\xdam\xf8j\x11\x94fx4<9¯c\xcc;\x01\xd2\x1b\xe2\x17\xc2l\xb7\xc2A\xff!~!̓\xfe\xc3\x13\xce\xc31\x84\xed\xdeio\x80_\b\xb3\xdf\x04\xd2o\xba\x16Ϡ\x8b{חQ4\xc2\x19\xed\xb5{\xc7!\xcd\xdei\xfb\xf4\xe4\x84h\xe8\xe4\xb4=<:\xe4\x99j\x03Rh~\x8e\x81\xe8y-\xb6{\x0fC\xa6\xd8\xc3~\xe3\x90\xe9\xaf\xd7n\x1en\x98\xb3~\x92Pk\xed\xe8\xe1@P\x83\xaa\x9bA>\xe9\xf5\x0f{̃\x1e\x9e\x9e\n\x1ao\x02F\x99\xf3\x1c\x9d\x1c=d\x9aj<\x1c\xf6\xda6\x9d^\xcd\xc7\xf1\x18\x1bi4\xfa}^\xbe\x8d\xc6\xf1q\xef\x90\xd9\xc1\xa9X\xd2á\xcc=<l4\x1e\xd2\xd0\x1f>\x94\xbf\xfa\xfd\xe1P\x96\x93L\x00V>\xfc\x8f\xe1\x9d\x0e%\xe4\xa3SfH\xbd\xc3v\xc8i\xbdC\x99{܃\x96{Tw\xd8;\x94\xb9\x12^/\xc2\xff1d\xec\x17\xff\xea?\x1c\x0eE\xffDk\xa0>\x1c\xf3\xaf0Ē\xc4\xcc\"\x1c\x9d\x1c\x11\xe7\x1e\xc3\u007f\xb2Ͻ!\x97k4p\x9c<\xa2\xe3c9J\x1c\xa7{\xbe\xeb&\x05\xf2\xf5 \xb9B\xa5\xa2p\xa7ʲ\xb5\xf3\xed\xf8*\x92Oy\vO\xc8\x01\xab~zN\xa0\u007f\xacVM\xa1f\xa73\x06I\xa2@\x00\v\x81ұ\xec\xb30\x8d\x82o\xc3\xd9e}8J\x92i\x85\x8e!\x00l\xe4\xa1\b\xf1.\xc6\xc3\xf7\x83ft(\xa8N\xb4\xa9i9d\xa0\xa0s\x12\xad\x05\x02\xc8\t\x15\xefA\xb3Ѩ6\xdbU\xab\x1b\x9a\xeb\x804\xd9\n]\x91\x0e\xc4Q{\x03\x81\xaf\xd9-6VHuZoy\x1a\xa3\x89\xdfj\xbc\xc5c\xfd\x18h\xfd;h\xb5\xab\xa2\x81jթV\xa8F?I\xd9\r\xe1\x81\xc8z\xd0l\x1e<<nx\xd5\x16\f\xa1m\x97:8\xa1\x8c\x93\\z\x933\x9aRi&\x15hy\xd3a\xd8\xfa\xfcT\xe5\x04\xf8\x8b\xceǪ9\x8a5\x1a\x16\xec\x89ӆ\xf0\xa0~\xdaV\xea\x14\xfa(\x14M\xcbf\xd4I{\x03{N\xa34/\xbaS5\xa8h\xbd+Q#\xb1\x14\x923f\xd8F\x87\xd9/\xafɠ\x13\xe4\t\xee\x97d\x1cq&L\xdfq\xa3\xe8t\xf5*\x19\xcf.S\xe0H\xff\x8bױ\xdc/q\xcbp\xbf\r\xa7\xe8\x136\x99\xd2\xef\x05\xfc\xfb\xbf\xf31\xfd;\xc2\xf4\xf9\x05\xbaqE\x13\xf8\xf75\xde\xc2r_%\x1f\xe1\xdf\x17Q_\xb2;\xe0rx}\x88\xee-v\xdcA\x042\x00\xf0\x924·\xea\xd2\xce\xe91lC\x0f\x0e\x8f\xdb\xf5V\x1bH\xda\xe7\x00\xdch\x02(\xb0j*i|\x80\xa3\xfa\xfe\xdd\xf3/A\xe8\xff\x91\f\xd1\aP\x17Dp\x00\xb1^\xfb\xa2\xadE\x84}/jis3y\xe8\x19L\xc2Q\x0eh\xa3\xbe\x05$\x19\xdb\x18\xbfg\x12\xfc\xb7\xf8Y\xf1\xce3\xe0\xd7Q\xf4\xc1\x86}\xb2\x03`.\x81Ӎ\xc6^\x05n\x80\xd3e@\xdbm\xdc\x04H\x83s\xec\\&s\r\x93\x87\xc0\xbd\x1f\x1c\xef\xdc/bwz\xbf\xf2\xd0\xee\x0e\xab\xd9\xc6c\x1e\xd0\t3\x80\xc7\r`,w\x87\x98\a\xb7\x1b־\xa5zi\xc5\xec\x1cCɠ5w\xa4\xbd\xb7\\\xbe\xe2U\xdd\xd4\xcd \xe6\xc0\xdd\t\x9a\xb623\x0e\x82_\xe4\xfch\x9c!\xd0\xea\xcd\x1d4`\xaa*'+\xa2\xf6\x1e\xf33_kO1@n*\xa3Р\xa8\x9b\x87u䍢T\xc5\xfd[\xcf\xf9\x1b0=\x9c\x16\xbd\xba\xc9\xf1\xf4Q\xce\x12\x1c\xa4u\x83\xad\xf2Ӡځ\xff\xf7:tWM\x1a\xac\xa2xd\xee\xff>\x8f\x86|\x1f`\xb5\u007f9G\xfe\xcaN\n\x18# \x1a\xa0\xa1B\r-\b\x04\x0f@\xef\"Y:\x90\xec\xb6B\x10\xabTZ\xccQ\xad\t\\\t\xb7z\x01-+\xdbPih/\xd1\x19N\x069Ǌ\xec:\xccEr\x15\x04s\xb1KS\xbbM;\xf5kX\x90i\xbe;\x92\xa2s\x19\x92\x9c\nj\x8cFq\xaa力\x9d\xa2\x90!\xfb\xac-\xac\x12\xb7\xfeS!\xb5\xf1gƞ\xc8d1\th\x9apt\xa0\xe3\xc8{\xa0\u007f\xddJ\xe0\xa8\u007f\x93\xf4\xc3Q\xa9\xd8\xc1\xd9\xff\x9f\x16>~C\xc9\xe3\xb7\x14;\xfe\x9c2\xc7_\x02ǭ\x05\x8eO(m|BQ\xe3\xbf_\xce\xf8݄\f\\H\x9fz7\xccm0YV\xf1\xa6T\xbc\x87\x15mxԚ\xb9\xc3\xf2\xaa6\xe1\x15\b+E\x92Jɞmlط\x10-~\x1b\x81\xed\xb7\xc6f\xf3π\xba\xdfD*ۥ\x8f\u007f,\x9e\xff0\xe9KĽ)\x92\xbc8K\xf0\xa8\xff\xfb\xf6ٻ\x8cÈ\xfb.a/}\xbf`K\x1d\xfc\x84T\xba\u007f\x8c\x89\x8f\x83f\xd4l)N\xb68\xc0Ϫ\xfb\xceUW@T\xb1\x87F\xa9\x87U\xf7YA\xa1c\xa3\xd0q\xd5\xfd\xb6\xa0СQ\xe8\xb0\xea\xfe\x9fU\xe8Qsoo\xf1\xb8\x91\x15\x03\x06\vÍ\x06\x95\x96g\x96\f\x82@\x15s\x19\x8a\xaa\xa4\xe3\xb6\x18Yh~k6ZG\xff\xf7\xedW\xef\xde\xdc\nk\x8d\x9b\xa3\x86\xf6\x9f6 +\xa7\xea\xbe\xc9c\xa0\xf1\xf0a\xbb\xd9<n\x9d\x9c\x9c\x18\b\xd3\xd3\v'\xa1qrxr\xd4<m\x1d\x19\xb5db\xd5\xfd\xaa\xa0\xca\xd1i\xdbj\x85R\n\xa7\xa6aAF\x98\xbf\xfd\xe4\x14ߓ\x1e%\xd3\xfa\x9bp\x84\x17\x85\xccK\xd2Z\x86\xedG\xc0\xb6l<x6n\xf8\x98\x87\xd2]\xd3\xdb`ùt@\xb0d\xe6\x19\xdf\x1b\xa1\x8fs\xe1\xb9\xc2_\xab\x95QP;\x99\x126\xe3\xf9\x18\x1f'\xe3۸\xe2\xe4\xfe\"\x1aG\xd3p\x96Le\xaat\x8cɮ\xec\xa0?C2\x11nZ\xa4\x1a\x11|\xf6\xfeҺ\xa9\x1c\x8e\xd9\xc8\xef\xff\xec\x8b\xee\x8b\xd8L\xe8-\x17?\xd2*\xf3uSQ\x99o*f\xd0(\x1c\x05\x02R=D\x97A\xadW_\xa7#\xab\xbco|V\x9b\xe7|k\xe4\xe7\xa0\xd9\xfd\xf9Q\xf9\xa0\xba?\xdb\xddPMV\x9a\a\xe5\xf5\x1ePೲ\xee\x17!\xe6\xdc8\xc6\v\xf8\x8fpv\xc1hڥ8\x95\xceL\x86/\x03Ǝ3\x84T\xd12\xa4\xcb\xdbMz_\xf4ٯVe\tmdD\x95\x15o\xb5RGt\xca+\xc3,c\x05\x04\xb0\xae:h\rː:]\xb5 \x94\xa7\x9aEw\x81\x8d\x84\a-\xbc\xa3\xc9#\xde<\xef\x05\xe0LJh\x9c{\x95z\xdb+\xa1\xf7\\\xc3A\x8bY\xc4'i\xbb\xa0\x00\xd2eY\u007f\xaaյ>\xf9\xecH\x87\xfdP\xfb=}m\xe3Z|e\xe9\xe9\xcf\xe1M\xee\x86 \xa5f\x97\x9b8\xea\x8d\bڳ\xcc\xdco:E\xf7\xd7\xf0d\xe9\xfb\xef\xbea\x97 \xf1!]\x04\xe9$\x8a2\xf8\xb7\x16\xcc@\xd3P\x94G!zJ\x01\xeb\x8cd\x15\xf9mDVP\xa5\xe9*\xb7,J\x1fE\xe5\x14?\x95'd\xff\x9a\xe3\xad\u007fo틟\x1d\xad\xce\xe7\xf5\x10\x10QYΧ\xa3\x8e>8\x1f\xffbd\xb7\x8e\xfbs\x9a\x8cA\xbf\x9c\xf7\xfbQ\x9avD\xa0\x19\xfa\xa8S\x10\x11r\xd2\xf3#\xec\x0e\xe7\xd2O-o\rMs\xb6\xd60F\xc2I\xf0\x8aBrQq)\xd7\xc1\xc7\xefЯUt\x01\xbdY\xf5.y\x06\x1a\x04h\xd5/\xe3\xf4\xcfOɑC\xf8skS\xc3g\x83\x19\x9e\xea\xba\xff\xef{q\x1f\x9b\x9d\\\x19\x0eew\xec\xf2\xd2\xfb\x8f\xfc\x01\x83\xec\xe7jeltDi\x15UY\xafd]r\xc8f^\x8eKt\xa6S\xb0\xa5\x0e\x14\x8d\x91w\xb7\xe6Ƭ_=Uw^ő\xa7\xa4=\xac\xb2\xc1\xa59\x83\xf6\u007f\x11^\xa9\x02.\x8a\xee̸\xbc\b\xa4\xca\xf3\x8c\vF\xf2\xee,F\xf7\x84\xa9\xe4\a\xe1ݒ\xf3\xebA6\x0eld\xc0\x8d\f\xb2FD\x8ehB\xbb\xbc\x94k@y\x94#\xa0@\xd6\xd3C\x00Q\xa4-\x97\xf8\x85\xeb\xbbT\xcb'\x90\xeeyW\x8b$\x94\xeb䄘\xfa\xe0lr\xee\xa5\xf0O\x80\xbf\xf0^\x01\x1e\x16+s\x11\x11\xc3\xdaۉ\r\xf1K\x11Q\x01+\x129E\xe2\x93Ma<\xc5\xf4Ӹ\x9e\n\xb2\x92|\x94\xa2\xa3\xdfL^\x17\x19q\x89\x80$\xbb\x90\x00\xea\xb2~\xe9Mׂ\a0\xdc\xcc5\xc22\xa4\x88g0| s*\x8dG\xee\xa3!_P\xc3\xef\xf3\xc0\xfa\x06鯗\xdc\xc0bC\xa7r+\xafN9\u009b@>\xf9\xa1\xb5Б\xad\xd8\xf7~4\x86c\xba.\xab\x06\nܖ\xb9\xbb\x1coK\x8e\xd8\xee-\xfb\x96D\xc3\x19g\xf0\x9a\xbe\xe1\xaaH\x94\x98\xf5\xa8\xb1Z\xe1\xdf\xc7z\x11q\xa1\n\xadP\xec}\x9d=D\"o\n/\xb5\xb4\xa2\tQ\xcf\xed\x00\xf6\xf3\xd5\xeb\xec\x03+\xa2\xb0\a.\x06\nw\xd7Z9\xc6enԐL\x1d\x82\xbf\xdcG\x19\x1d\xc8N\xd8\xd0\x1d\xb54\xe8\xeag\xd1\xd04\xdf\xdd \x9f\x1f\x94EI\x1a\xc4\x1fa\xb0%W\xb138\xaeWF\xd3|\xf9\xfa\xf9e<\x1aTdg\x14<\x80B/b#H\x94C0tS\x8c7\x8b\xb3[1\x1cX\xc1l\x9c\xa3p@\xfbt\tʜ\fk\xde6\x15\xfd\x84\xf3a7\x83;/\xddo\xf0֥\x84BD\x8c\xffT\xddɍ\xbb\x03A\xd1\xe3\x01\xee\xae#\xd0'\x1c{.#& \x81(\xaa((p7R\x10<A+\x10\x8faB1\xf4\x15\x01\x17\xf9E\xa3,\xa4\x10kF\xb7tj˺\xb5\x1e\x06\x83\n\x1ac(\xea\x86\x05\x8f\x18\v\xa1h\f;\xa8M\x06[\xfa\xb6m\x15\xdbo{m\xef]\x8e0\xd7k\xbd\u007f&\xff\xa4K5og\x18\x86\x1ci\xad[\x90\xfb\xc5x\xc0\xe6\x1d\xbc\tlpU\t\xd3/c\xa4]\x83\x91fm=\xce7\xa1sW\xd5,u\xa9\xb0\xb3\xdc!\x90\x90o\xd1!P\xb4\xd6\x05\xe3\xe3\x9b\x0e\xf9\xd6kE\r\xe7\xe7K[\xadE\x15x\xf5\x96Ժ.j\x9a\xfaSR-#\x0e\x11\x04\xc8\xe0\xf2\xe5t^P:\xb7*\x8a\xd8\xce\xda3\xef\x9d1J卫\x8a\xb6\x8d\x13\xc6E\xfc[\xba滋\xe8u\x13\xe7=\xf4\xac\xac\"\xe1\xcb\x12\x9b\xb6\b`g\xe2\x1c\x8d\x9e\xf5y\x87k0\x8b\x13c\xa6\xadV.\xb0\xd2x,\"\xca\f\xd1\\\x87}\xf8\x1eO\xe0Dy\xfeR\xae\xaaY\xb2\xb0\x9eY\xaa\x86q\xf0/d\xb2\t\x8a\xb7\xd3\x180\xa5\x80[\xbe\x00s\xd9\x04\x1f\xc0ө\x1b\xfd\xec\xda\xd7\xde%\x8d\xab[\xef\xc2\xf7\x14\xa8\x8fM\xe7\x01\xe7d\x17\xc8a\x19t\xc5q\xa1\xbd1\xccic\xd3\x1cou@\ase\x9b\u007f\x1c\xb4\xbc%\x1dK\xe2?\xab\xd5|\x9d\xc9ޜ\x92\xf5\xf8,\xfb\x99ٙ\xd6ٌ\xb0_C\xba\xe9j\u007f\xe1\x18\xa9\xf9\xfc4\t\xb3Q\x01\x8eE=\xf2\xa3\r\xb2\x93\x87J\x01~<\xf3(\x82q\xca\xe6\xa9w\xd0\xe5 C$\xe6$b\x04Z\x88w6%\xf63#\"\x93K\xff\x03\xbb\xcc\x12J\xa8u\r*\x1fqv\xf5vT\r\xe3\x98\xe8\xa0\xd5\x15M\n\xc1\x9b\xe2DtTa\x02\xd4\xc1\u007f\xd6*\xf6\x9f\xa8P\x1a\x1e\xc0\x94\xce\xf2\x97\x8fA\x14\xa8s\xd8\xdfW\xc9 \x12\xecD\xecɞ\x15\x91GE\x1f\x92\x88\xb1\xe7\x1afB\x0e \xd7R\".#kL=ᐩ^\xa9\xb8\xae\x19\xf1n'\xa4h\x1c\xaf\xb0Ef\xc2%\"\r?'V*\xf2\x10@\x93\xbdx\x82k\xccF\xdb\x04\x16*c7H\x89*3\x93\x9f\x12Z]u\xe5^\xa0\xfc\x87\xe48\xe8PQ\x93\xaa5Y\x81`m\x95(\x94\xd8E\xe5\x14\x99\x10\xf5ɼ\xf5\x8e[\x84\xb4\xaf\xdcf\x8b\xf8\xa1d\u007f\xf8a\xf3\xe6\x80_\xbdh:\xbb\xfc\x0eU\xcezs\xa7 XśI2\x8da\x90\xacq\xb2\x9d/K\x80]c\x96LĞ1\x01f4J\xdfDSZ\xc0T\xd4HZ\xadN\xda\xea\f\x83\xc8Ó\x17\xba\xe1\vK\xa4\xda\xced\x94\xa4\xc5-\x8ag߁\x95\x9fqV\xbaA\xaar\xf1k\xb5:\xba\xfdV(\xbb\xa0\x94\x82-\xd1\xd6\xde\xcb \"\xeaz\xb2\xbad\xaa\x19\xdb̀\\F\x03\xa5\x01\xb9\x8a.fn\xbb\xccIk\xc8\xf5\xad\xb0`\x8ez\xc2\xd3\xd2L\x03\xd99`\xee\xc8ߍHc\xcc.\xe4\xdbt\x81+\x1f\xa7s\xef\x12\xc4L\x8b爸Ж\x0e|\xaeo\xbb\x92nuk\xf8\xbe\x11\xffLpς\xb93\x06\x9fq\u007fk\x1a\xef09\x0f*ͪZ\x98\"p\x10}\xeb\x97~\xb4\xe2\xaa\xecA\xcb+\x9a\x12\xe2\xe0\xb5&\x97c\xb9\xb5l\xa3S\xe7;\x84[\x02\u007f?0np\x93\xf8\xed\x993\x1a\xceg\t\x87\xb2\xe4\xce\"\xf1\x10\xf9~\xbc\xa8\xe3\xef\x8a'\x82\x9c\xe8\xf2\x8a'\x98D\xc5\xe6  z߈E\xf8%\xbbR\xa9\x05\xc8\xdf\xda\xe9\xc1\x8d:\x9c\xb8Y{\xeatJc\a\n\x14\u007f\xe7\xf2\xb5\x05\x1f\u061cf\xb5\xb2\xf1\xad\xa1\xe5 \xcf\xcfn7S\xab\x95\xb8х\xaf\b\xe2\ue93a\xafsӀ\x98'\xb3\xee\x85\xf0\x80\xd5hH\xc8s\xdc/N\xea*\x80P\x17\u007f\xe2\xc9Wŭ\x8a\xd9\xf7ݪ\x80Su=q\x9c\xbe\xa5\x86Ӏ\x82\xb2s&\x9f\xfbHd\x80L\xea\xe9hTq\x1f\xd0\x03,\xa4K\x89\xfb\xea\x1fY\xdaT\f+cW\x82\xf5\x9c\xb9\xd9+\xb1b\x11\x98\xac\xf6<\x8b\xc8*\xab\xaa\xfeB\x05\xf9\xd3\x13\x0f{\xc8\xf9֦:\xf5\x98\xc5\xcf{ >\xc4\x03\xf4\x17R<\xbfb\xec\ab\xb1\xe1\xf3\xba\xc4*\x8a\xe6\x83_(w\x9f4;\xe8\x90T\x80~\x83Um\x1e\xbc\xab\x9e\xf2u\xef\xda\u007f\xd9W\xcf3bk\x88\b\x03\xb9\xee\xad\v8\x99\x19\xceZ\xedD˂\b\nF\xfc\xd3\xed1\x14J\xc3'\xdc%\x00Bv4\x84\xfc\xc60\xd1\xdd>\x1a\xc3Z\x0f\xa7P)\x8f\xa7`/{\xcfd\xce;\xc7Z8j\xdc-F\x82&\xce\xfdX\"\xe6\xfdx\xc7\xf3\xe0;\x89r\xf4\xa2\xe8\u007f\xad0\xa7*\xf0F\x13\x94o<\x99\xef\xc6BL\xac!J\xdfV*\xbc\x8b\xbcW\"\xbb\xf1\xb3\xec\u007fR\xc1\xad\xcc<\xb5\x93\x18狞t\xee$\xc1\xfdznV&\xf0}\x8a\xe00\xe5\xbc\xedN\xb1bv\v\x16c3\xb7\a&!\xef\xcc\xdcJ6\xc1\xbb\x05\x93A\x06k\xf6\xc3\x10\x81\xf5\x92Vw\x8b\xe5'\x94~]s\x01\n\x8a\a\xc9\xca\x12\x8b\xd1\xd7\x04ɭS*\x12s˖L\xccx\xd8 \x14\xdfA\xc8\xe4\x86J\xa5L\x12\xb1\xc5V>\r\xafq#\xd0k/\xa44\x80\x99_\x81\x88@\x82Ŏ\"\x82\xaf@fx\xd0\xe2\x1f\x96J\xf7\\fw\xb1\xde\xe2\xb6w\x93\xdf7N\xfaNdc\b\xe1\xa6\x04\x9c\x11,\x8a\xc1J\"\xfeM\xc5a\xf1\xc0\xfe\x9fA\x16\x96/\xa3\x00\x1cA\x14HJ\x1a\xfbE\x9aZn\x95\x98Yf\xc8\v̄\xd8\xdb\xc9\xcb\v\x92\x97?\x81\xb0\xbc\xab\xf7\v\tX\xf5\xb7Hك2\xf9Kd\x97\x88a\x85U\xfcB\xe1\x8c_\xe5\xcaI\xdf\xc2G\x18ڰ_ Ȟ\xc2a\xe72\x97{\xe2\x88gyS\x87j\xb92&9\x81Ƞu\xad&\x10;\xf9\x16b\x91A\xac,\xdb\xdc)MU[sׅH[\xc4@\xc4\xd8t>2\xf8y\x9e\xe2\xe6J\xdd\xcazX\xef'\x93EE\x86\xf0\xe5$a\xceV$)\x9b\xd3Ax\x854*qJ\xa4\x9a\xf1\xf2x\xe0-%\x10\xccZ\xefH\x10Ϣ\xcb\xf0c\x9cLe\xfcɯ\x81\xb6G\xbc\xf1vw-z\v\xc3*\xbf./\xdf\xf0\xc4\xdfݼ\x11\x97\x9c\xc4ކ\xc3(\x10\xafK\xb2\x1f\xc57x\xf2\x8f\xb2\t\x9f\x8a\x89ȥ\xec\x02\xccnxz\x92&۲\u007f\x18\xa5\xea\x97\xdb4G\xda\xef.zz)\x1fr\xa7\x90\x84\x91\xd1\xf0\u007f\xc0\x9d*\xf5SO\u007f?H\xf9[),\x90\xeb\x8av\xb05\xf2\x96\xa3z\xb9k\xcbU2O#\xd4XM\xf7\x16\x14\xb4\xd5h\xa5\xa8G\xf7\a4$\x8c\xd82\xcf\b\xa4\xd3܂\xa3\x15L\xd6\xe2\xfe\x8e`%\x90\xf7\xc2R>\x81\U000f8c77\xa7\x9d\x04\u0601s\xf7\xf6*|N̳-\xb3\x9f\x14%\xca:$\x1f\x18\x81\xf8ٯYkF\xd4\x14g\x83ԑZ\xb3{\x8f\xa0\x02\x9b\xbd\xc0\x878d%\x05@w\xae\xcc\xc3\x12\xfe\xa2Zy\xbf\x89\xc7\xff\xdd|Q:\xc3\x10\x9e\x98\xe2\xb0l\xad\xc8\xedL\x1f\x1a\xc7\xefܐ\xb7Z\xe9I\xecN\x9eK\t\f\x9a\xac\xe4\xf2\xf10M\xeb\xa5:P\x17\xeeB\xdd\xed\x144\x9f\xe5\b\xe8\xfev\n\xa2e\xb4#\x11\x19䳷Gc\xb0\x9e\xacp\x8d\x99s\r\n(\x98\xb0I2\xa9x\xdd҉\xccӂ\xdf\xf0\xe5;\r\x83\b\xdds\x9d|\x19\x14a6L\x17\xac\xc6\xdc\xe4l(\xbe\xde<1k%\xa71\xfe\x04\xd3ۂL\xa1\x02\xe6\xb9\x06d\xa1O\xeb]\x18\xf6\xeb\xe9\xc0\xbc\x01\xb7\xa1اdԶ\xfaY\xff\xbch/\xb7\xa2\xaa\xfe\xfc\xff\x9bGӅ\x13\xceT%w\x9d\aT\x9fǻ\xc3\xfa\xfe\xa5\x0e\x0e\xaa\xba\xeb\xcfu\xa5\xfb\xf3\x8aI\xe6\xe9\f\xc4\xfbd:\xa3\x10\xe0K\xf1~\x1a\xae\xb0\x8e\xcb*0ȝ\xc9(\x9ab\x00,HJh\x97p}&\x80l\x1b\x8ep\xde\xfcylP\xfa\xd9y\xb7\xa89\xecn\xc5\x1d\xc5 \xe6E\x06A\xf0\x8bc\xfc.\"\xae[\xfce\xfa\x89\x1b\x01\xbc\xf4쵧y\x1f\x94\xb2\xd7f7~\x8c\xbe\t\xb5\x9a =\xbd\x10\xbe\xcah\xc5\xdf*\xa2xh\xa9hP\x82\xb1\xbd%\xed\x80Pmx\xe3\x17\x1a@\xb0\xb7\x97\xa5\xe6\b\xbd\re,\x12\xfawA\x9ePz\x85:\u007f\xb9\xe3\x01\xb3\xbd.ޑ\xab\xe6\xf6\xf5\xf3\xcez\xea\xed\xd7/ )@<\x1d\xf7/\xf5KL\xccr\xc53Ø\xb3\xe1\xc9\xd2.\x17Ѽ\x03ܽϚ\x8dF\xe3\xa8\xeb\xca<\xdb\xc1PDV\xe6\xbd*s\xcf\x04J\x98=\x8b\x80\xa6\xa2\nW\xf4\x8d\x02\xc3x\x9a\xce\xc8=@5\x9a\x8c\xc9=7\xb0v\x1f\x9d\x95\xaa\xf0\xf2\x06\xf3\x8d\xc6\"\xfe\xbe\xd1B\xde{M\xd6v\xb3\xf8\xcd\x1a\x81\xdd\xea\xe1)\xf9\xe0zД\v\xab\xa0\x9b\xe5=\"\xcci\xddYk\xd7jDx\xc3^4\n\x8c\xda@\xdfb\xaa\xd2g\x8bw\xe1\x05=\"\xe8\x02U\x02\xf2Q8\xa1*\x85H\xd4\xc4[P\xbb_\xcf.\xa3)n\xdaiP\x84Z\xbe\xa8QP:{b]\xdct\xcc\xed\xf6\xf6cͣ W\x06xDלS\x94\x03$\x13Z\xaa\x9b\xad\xa3\xfc\x8c\xf3\x9c\x15\x8e\x83\xc2\xcb\xf7\x80\x9a?\xac\xc9Y\xb5\xb8\xfb\xbf\x8edv\x92nl\x82\xcd\x0fn9* \x92](D\xd0\xecn\xbd\x18\xe5ǹۺ@=/'\x8ad;\xea\xe7\xf7\xcd\xf7\x01?\xaf\x98b\x8a\xbe\x19\xe2VY\x9e[\xc1\xa7(\xa6\xb3\xa2\rp\x1e\xd3㥼$\xdcq\"iZ\x97K?\xb2\u007f\xf9G~z\x9e\xfe\xbe\xe0\b\xf3\xc2\x12>K&E\xb0S\x8e#\x80/lh\xfb\x88lq>ε\t\xb0Z\xed\x06\xe1\xffv\x12\x19\xb3a\x16\xc4ت\b\x89r\x17\xc8\xf9Ι,\xa8\xe0\xfe\x96\xa2\x17\xcb\x1b\xa1l\xabF\xce$Ĝd<Z8\xc98\x92\xafN\x90\xedo\x9d--^>E[uW\x12\x90ިV\x91\xdfX(\xde\xe4\xe5A\xaa6j\xf3\xe9\x1f\x91:\xcf#ì\xb3\x9b\x18\xfb5\xaa\xbe/\"\x10\xbeF\xf9\xcdW˼\xfdY\xe0N\u05f5n\xbe\x94\xees\x9c\x95}\x17:c(\x17\xbb\x1br\xae3#\xe8\t\xcc-,\x90\x8b\"\x90\xda1[\xc0ϝ=Yt\x8cX\x01\x86_\xa3q\xe4u\x8b\x9b\x18\x14\xf8\xc7\x1d0z\xd5\xe1E\x8c\x94Aĳ\x83\xef\x1f\xaf\x9e0e\r\x85\x15F5\xdbR\tM\xb3\x8b\x93o/\x93ky'\x16\u007f\xcb\xf4\xafぺV\x8b\xbfe:\xbf\xd4 s\xbe\xd3ބ\x1a\x9ax\x1cfh4\xb3\xd7E!\x96\xc4{G7\xfeB\xe5\x0e~\xc8~\xfe\xe8\xebQ\xa6\x94\xbaWu;{\xe3^:\xe9\xbaլ\xe8:/\xf4\x03\v\v\"\xd1\x0f\x85\x1b\xbeCc8Vex㋔Q\x1d\x98'\b\x04\xf5q2\x88prd@\xa4\u007fV&\xe1\xecr\x95~\xbcXMA\x8e^\xf5\xe3i\x1fX\xf1\xe7\a\xc6]u\x9b\x9c\x99F\x10\xfe\x0fA$^%\xfaa\xb5\x8a\xf0\t\xa3h\xfaC\x96\xfd\xa3\xca\xfeQe\xffH\xd9?\a\r\xed\x85\x1d\xe9\u008b\x11f\xa2t\xf6\x06\x13\xbbyF\xb7\xed\x99)\xfb\xe9.QY{F\xe8\xe7j\xf5\\]-\x15\xacO\x1f)\xbbZ\xff\x10H\xb7\xdfx\f\x9c`\xc6\x1b\xc2\x0f\x9eV\x84T~\xb6\xb4nxfM>\xc3Gר\xeb\xfc\xc0W\x8dlC\xf5\x1b\xfdQ5\xf3\t?q\"\x85>\xe571LT\xc4\xf6(\x8e\xe9\x8c\x01n*v\x1fD\xc2\x0f\x1e_\x81\xb1k\x06\x06|\xcfίպ\x12oZKx\xfd\xc6.\xb9ZY}յ@\xbb0\xc8|F\xd9.+\x99\xfc\xc6\xd7\xf9j%~a\x00\x00\x92Ű\xeb\"\xb3~\xf3Hx\xbd\xff\xb0\xb7\xa7\x8a\xd5o\x1e\xcbq.\xad\xceb(\x18\x91WS0\xbcG%y\x04\xcb{\x12w\xe0\x97\x90\x03U۔\xa7Z\x87.W\xabB\xfa\xaf\xd5ֲ\x18\xb5\xfc\xa8\xe1e\xbd\x10\x11\xb6\xc9ߞ\x00\xa9\xacsi.\x9ee\x91\xb9\xd3\u007f\x01QѯIr]Q\xbd\x94\x0e\xe1\x04\x06zX\x13t緼jY\xe9\x85(\xbd\xa8\x8a\xbf\rQ\xedG\xacƤ\xa4\xed6b%\xe9\xfb\x8d\xb5!\xf1+\x9a\xb9\x1a\v\xbb\xc6¬A\v9XfL\x8f\x0e\x14;\x19\\5*\x8d\x1br\x99\f\x92\xf1:\xdc\x13\xfdC\xaeD1H\xaf#\u007fȻ\xf9\x82\xf1\xf2\xe5\x04\xfaח8\xef\xc8\x1f~\x82V\xa8\xce\xcf>\xc5\xcc\xd3\xf8/{9\xe8\xdcg\xb5\x92\x95\x1e\xe9\xc9u\x99\xcaA\xb6d2\xbfݵff\xc6\xc6\x12\xfa\xad\x1e\xf1\xb3\xc1K\x9ec\xc0\x16\x0f\x1d\xd3\x0e\xa93#\xa3\x90\xc0\"\x15\xb0\x90m\x16\xb42M\x17\x15MawK]\\%9ʵ\xc0\xf7\x13\xf4\xdd\\\xbcr#|J\xc4;h\x1d\xfe\xe3\xf3\xf6/\xbf\xc8Z\xfcC\x87)\x9a\xbf~\xe4\xaf\x1f}\x9bh\xaco\xf1,\xe5\x0f\x1d\xf1\x17\x05\xf6K\xd8\xca;\xb6 (e\f\x9637=\xdb\x1c\x8f\xd5\x15\xdcL[\xd1%\x86\xfc#P\x9cA!\x1cS\x90/J\x1b\xa7\xe9+i[jP\x9b\x9a'A\xa6\xa8y̠\xe6m\x9f\x8ar\xb9S\xdfbٟ\x8c~k\vV\x90\xacmŘd\x81T\x05Yf\xc1\x1b\xd5;v\x82\x16\xa5$\xa9\xef\xa36eN\nI\xd2(\xf9ca\xc9\x1f\u007f-\xf1\xea=\xbd\x11$L<\xf1\x1b2\x99l\x16j\xb9\x90.\xd3\u07bc'\xbb\x89+\xb3\xb2\xbel\\o\xba\x88\xcb5œ\x89\xa0Fn\xe9\x03i\x9aZ\x0f\xf0\xdb\xd5\x0f\xa4x\x84ګ\x850a\xf3p\xf4c\xb0\x95\x99\x1a\xb3\xe8u\x8cOn7\x1b\x9e)\xfbJ9\xd7\xc0\xae/\x1a\xb6׳\xcd\xf0\xb9\x96\x18Y\xe6:\xa4\xbbۘ]kT͞j\x8c\xa8\b\xc1d\xd0\x16\x1ct\x9b\xda\x02%\f\x95%\x99\xb9\x94\x96u\xcb쥖٣݄\xcf\u007f\x8dS\xb8ҎA]m\xf5\xf0\xba\x02\t\xa3`\x82\xc5Y\x99[\xd0?\x99%|\x10\x88)么Po\x8a\xf8\bg\xc9\x17\xe0|C\x9f\xea\x14\x1b\x1a\xb6\x1c\x02\"S3O\x01K8\xa2\xd0\xf7#O7`ʳ\xc6-\xc6{\x1d\x80Qߨy\x87\xe3J\xbc_H\xa1\xccޑ\x8e\x84\x8f\xbd[I0\xafWx8\xf3B\x90\xd2\x1b\xe1\xdaY\xc97\xec\xedэ\xc5\x17\xaf\x9f\u007f\xff\xed\x17\xaf\u07bd\u007f\xf3\xfa\xed\xcbw/_\xbfz\xff\xfc\xf5\xabwO_\xbez뉑\\\x8a\xcd$\x8f\x88\x1d='\xf0U\xf47y\xdb\x05%\xef\xe8:\xf3sx\xe3/\xef\x1e\rj\xf2k\xc3A\xed6\xd0oȄ\x96\x1f)\xa7\x17\xdd\xc9+4_\x18N\xc2ی4\xe6QI\x89\x10\xa1\x1c\x85G\xb2\x87dņ|\x86J\xbf\xca\xd8\xcf|\xe4\x16ߑ$\xbb\xa7\xce\xdf\r\x15\xb8\xec%\xf5\xb5\xe6\xf2:\x0e!-\x1cё\xab|\xe2&P\x1e\x13\xe2\x05-a\x9e${\xb8\xbc\xb2N\x9c`\xacY\xeft\xb5zc\xe0\v<\\\xecfA\x00\x98YQt\x8bn\xc1c\xbfK\xb3d5p\x95;\x8d\xcb.\xcd\xd7h\x9e\xd8º\xb9\x90\xde \xa7\xb82G\xb0i\xd0\xfe/Hm.`չ\xa00\\U?T)\xeb\x04\x9f\xa4\x88c\x14}\xd4,\x1f\x8c,\xf1@\xd36\xf2\xad\x8eX\"\x18\x11i\x19\xb4\x80\xe1>\xf4\x03\x82,\xaa\x97\x04\x98|#\xcc\xeeZT\x05+\xea\x0f\x0e\x86b\x8d\x042\xc4J\x87\xbcs\f\xd5iݕ\xe6\xe1\xf42\x1a}\x8c\xc7\x17\xc28-?5+\xf5{\ue619m\x99i\x81\xc4$\xc0K\xe9m\xc0\xf4h\xa6\x14\xb9#0|>\x0ef\xd39\xe9T\"yS\xdc2iNG:\xae\xd01u\xf9\x8e\xb2\xe3\x91-\a\xd7\x18Ņ~\x0eZ\xe6\xddLſ\x9eW\xb1\xfb\xed\x1c\xe9e\xd3h\xcb\xf7ϵO\xb5;\xd6IyQ\xcf\xcá\"\x82\x02\xb7\x8f\xfb\xc4\v\x19>\xc1r\x87PG\xeb)a\xad\xb2$+\x18yn\xf9W\U000788e2\x1d\xf8W\xe1MG\x85K`\xcd>\xed\x9ce\xf9*\xef\xdc'XE\a8\x18 ;f\xc11\x85\x92\x8f\x82쫡\xae\xf2K\xf7Y\xf5\xa2\xb4Q\xca\xce\x0eo\x02\x1dd\xd7<\xca\u0602\f4 \xc9\x01\x04fg\x96\x05ݐgg\xeb\xac^Ө\xd7\xcc\xd7\xc3\xfe\xa9z\x1c\xa8N\xe0\x1c\xed\x8ez$\x18\xcdm\x98\xfd\xf5s\x16\xe7\xbbP\x02\xf7,\xc8ϴ\x9bLH\x12\xf5].\xe2z[)\xa7\x1c\x04\x90\x8a\xebg!46\x97\ro܌Zh\x12\xf2\xb8f\x1dv\xa9\xe6#\v\xbb\xb1Η\a\x1c\x9b\xe5\x9b\xe7Y\xe4\x93\xf5\x0eC\x17伫\f$ߕ.`@\xf2Y\xe8Oyi\rD\xe6T]X\x13\x1f\xc2\xec'\xbet\x99[8_ʧ\xa9\xadĢ`\x98\xf5\xefe\xae|L;\xab)\xddx췪\xc5n\xf5&\x9c]~\t*P2]\xe8d*\xb3\xdfΦɇ\xa8\xb0\x80|R\\Oe\x06\xb0\x14\x83\xea\xd4O\xfd\x94\x00Ѕ\x9eN\xcb\xd7\xfdJ\xfd\t\xec+\xb0\xd1u\x96xX\\o4}r\xc4\xef4|\xbe\xc2JIh{\xe84\xd6\x02L\x87$z\x1f\x1f\xd5矠o1\x99t\n^\x8f\xd4\x0e*Ȇl\\\x04\xd22\xf3\xe9\x14ӔW\x139ʰQR?\xf8\xe0kj@\xc8Z\xbe\xf1\x80\xb7\xc0\xc4M\xe7\f\xe9\x9a\xee`\x9d\xfb\v\xfdkMr\x04\xad\x95\f\x88\xb0DҊȥ.\xac\xb2\x8b\xaa\xfe\xd1\x10e̚v\x19m\x10[\x9fq,\x8a0\x8a\x8c\x13MT\xb6\x85\n\xe3\x8d.\xaa\x03l\x02\x8a,\x1ea_=\xea\xf0\x82\x12\x1ec\xc7<\xea݂\x85kᵆg7\x8f\x10\v\x1e\xa1BK\xd5\nYnpxt\x81(\xf2\bO\xa5e\xa0\x1d\x84Y\v*X\xaeF\x8d\xf0\x05\x0fAzd\xda\xeabf\xb5\xbc\fQe\x97\x86\xa2\xd1\xc9\x15\xb2\xb8\xc0\xc5[T\xee\x13\xcc옙x}\x9eF\xab'#\x9bˈ\xe4\t\xe6w\xcc\xfc\xae\xe9\x14\xa5\xb7\xb2Za3\x8f\x1a\xder\xc1\x83\xc2\xea\xb5E\xbeü|\xd6\x16$\xb3m\x04B\xa3.\x03\x02kr\x9d\xd10\xa2\xc5G\x04\x11\rcq\x1f+\x9e\x97ZO\xcd;\x8f\xa5{[>\xc0\xeej\xa5\xebg\xbc\x01Ƣ\x04\xfc\x90\xd9\x1f\xf1nA\xf9m&u\x00\x17\xdcέ\xacL\x1dd\xb6 \xc2G\xe0\xa1o\x1aX\xad\xe3\xb9/t`\xa0\xa2!\xd7#\xf44\xad\x98\xf7cE)\xbe44\x10w\xa8r\x8c\xb8\"Ι\xd0ѫT6\x17K\xb5@\x1d\xb4\\M\x01n@]F\xa6\x15ね\bˌ\x92\v\xbbC*\x17z_\x85I\xce2;\x85\xea+\xf2`\x19\xc0k4z\xa2k\x81\x1dV\x93x\x82\x89o\xcb[\x85\xf8\xbb\xb0\xa8\xd6W\xbc\xa1\xf8\x14\xf0\x13\xf7\xe6x\xc7\r\xa1\xbb\xc4\xf1\xbd\r\xa5\x18\xb4+\xb6\x89\xed%k\xfa\x8dOm\x8b\xdaTS\xdc\xf1\x92ݗ\x1a*`Km\xad\x9bW\x81\x19*\x97\rAb\x0f\x85\x19\xcfO1E\xe0WV\b\xcbE\x1f3Y栈\xfc\x81\xfa\xa5\xeffzB\xd6\x14\xa5j\x11euK\xa7U\nxO\xd2\xfb\x19hܵ#\xfd\x16\x95.\x1a\x83\xd6ǳ\x0f\xe7\x84\f\xf9q_\xe7\x85zFG\xafb\x94\xd23:E]\x80tI\xc4\xea.\x18\x8fD5P\xdcl\xd6fq\x83\x1d\v\xab\xb9RZ^a\xcf\xd6jq\xe1\x15\\M*ʖ\x97F\x87\xb4\xae\xb3\xef\xfb\x06붩6\xd0~\xaf\t\xfe;!\x80e\xc1\x979\x81\x9d\t\xf9w\x01L)\xa7\x8a\xbf\xeb[J\xd3\xf5o8\xfe\xea\xa6\xec\xddL\xa9\xb2\x8a|\x8e\x8dL_\x05\x12'_\x91S|ZA\x89nf\xc8s\xc5\r:\xcf_\x1a\x82g&?J\xb9\x92\xefAo\x91\x89Kw\xb1!\x97\x95\x97\x8e\xb1\xb3\xc0\xf7o*\x05o\x14\xa83\xe0\xfa\r(\x92\xf5Ey\x99\x05\xcaYXF\xbbצo\xe8Y2Ϋ\x9c\xba\x8a>\x8f^W\xf4\xac.\xa6yo\xcfJ\xa8\x14>\xf4\xb6\xb8\xcf\xf2]\x16\x16RT\xbb-=\xbcE\x9co \bʿ\x1bE\xd0t\xfe*\x92 * z0\x94\vI)\xac_|*\x9a\baP\xbb\xd2Dc\vQ4\xa8TsK\xa9\xaa,\xf9\x1fL?\xcf\xc2\r\x1a:\xe6ލvz\xf8>i9\xe5\x90\xec(r\x83r\xfa\xb9\b'\x14\xed\xa3\xdeh\xdbD#o\xb5I(J˗(\x12\xe9k\u007f\xeb5\xeb\x82g\x9cD\xc3\xc2\xca\xc0\x1fr\xd7篮y\xdd\xd9V\x91\x8d\x81\xb2\xb5H\x0e\x8bi\x97ΰ\xc63\xf9\\\xbf\b\xe4`'W\x8a\xd5\xe9\xcc/Oz8\xe0\xb10\xb6R\xbf9k\x9eW\x03~\x9d\xacb\x83\x03\xe1\xfb\x02\xb01\x1fD\x8al\xb8\xdaڇ\t\xb3\xf7J]\x14ݱ\xbb\xf2\xc8\x1e\xbb*Bv\b\xb8F\xa0Z\xa3\x1c)\xa2\xd5\xf2\xae>\xa84k:\xe6\xb3+\xe0\x12\xf6\x9fYC2\xc7/\xbf*\x96{(\xd9\x03E\xf1\x1fD\x8c\xb7\x86v\x91\xfb\xad\xb8i\x8d\x91y\xefr\x8bG\x1b\xe73\xa3CbU=\x91\x1d;ȵבYV\x94\f\xfbM\x94\xab\x10\xc4\xf8\x9b\xe0\xac\xe97\xe0\u007f\xc0\xa1\x1e5\x9eԚ\x9d\xa6\xfa\x10\x8c3\x9b\xd8\xcc/\x10\xb7a\xefA\xabӐ2\xb3\xcb\xd0*n\x95\u007f\x88\xa0\x17\xbe\xebQ\xe8\x8d_\xa1\xb2m\x9d\x93\x1d\x15P\x9d\x82ssQ̩\x8b\xf5\xd5)\x89\xfeB_\xbdю\xfa\x8bw\xafjF!kYk\xb1\xa1\x16ogU\vՕl~\xbc\xb5\x15\x85\xd2$\x13;LOyC\xa5\x13\xbb\xde\x18\xa8\xa4ˏ\x8fM\xa6\xc9,A5IN*\xc7\xf7\x90Zu\xd6.\b\xd8\xe3B\x05־$.\x8d3\x82Ľ\fq\xd5\xc0\x1cc\xa6\x93\xdb\\\xa8\xc8\xe6\x19\x8b<Z\x1d\xf4ҟyk\xcc\xf4 VW\xc5d\xb5@s\xda\xcd\x1c\u007f\xbb&\xd43\xf9y\x1e\x94e\xa0\xf5\xab,\xafZ]\x17r\xed%\x05\xdc\xee4|5U\x9d\xa6\xad4\x9b0\v\xf4M>\xa2\xca\xf1k\x02\xfd\xc8\xeeѹ\xb7,\xebE\xae\xa8֫x\xad\x05L\xb7\x01\xdcV\xc4y\n\x12\xcb\x06\x19\a\xb3\xef&\xe4\xa0\xc4\xf9IT&\xdb\xe6n\x88\xcb\xffm\x02\xf2\x1f(\x1bo;q\xf9\x03\xd4\xcf?\x1d\x8a\xf2\xe1\xcf\n\xb1\x92\x85k\xda$\xfd\\E\xb3\xcbd`\x8a\x1a.\xef~n\xc7\xe5+\u052e\xb6\xe1\x16Bշ\xddbyX\xed\xacg\xdc\xe0\xb9\f\x1e\xe5\xbb\x17\xb0\xf2\t\xf6\u074c\xc4V\xf0\xa9\x90Y\x89\xdcZ\x84-ty\xab\x06\f\xea\xcb7\xc1\x0f\xec\xac\xe5~\xb2\xe5\xda\xe4\x1fh\xa2\xce\xde\xd4#\xe8\xb2\xc1,\x86\xa5\x9e)\xd3\xdc:\xa3P\f\xba`\xe7\xce!w\x1b@F\x98\x01\x90M\xde2MY\xae\xa5Ȇ\x9f\xabUY<#\xee\x87\xef\xf6FdY\xa9ԛ\xad\xb6g\xc2*\xb5m\xaf\xb3\x89Ȭ\xd7\xcb;\x18\xbcom\x06ꓓ\xf3\x9bQ2\xdbd\f\xcaJ\xdd\xd1$\xc4\x00&\xd8̧\xb5\x15\xe6\xadD%g\xd7\xf8\xcb>\xbd\x86\x1f\xa8\x82sh\xd0#\xdc0\xb7*\xfd\xa6\xfa\xfe\a\xea\x8f\xec\xf3M\xd6\x06\xb6i\xf3\xc7F\xc5\xf2ש?\xbf\x9fn\xc3w3\xb3\x10~\xdb\xd5\x1b\xa5+\xf4\xb7*5Y\xd9iQQH\x8d\xa1\x8fO\x06\xf5iG\xe0t\xfd\x9b)\x1b\x92e\xder\xd5~\v\xcb'ް^)\xffn+\xf5\x8a@\xdfv\x1d\xfc\xf6\xe6\xff2C\xd9\x06\xff\x10\xae\xa2nܢg\xecDF>\xe5\x0fy\xa7\xfa=U\xadp\"z\x16\xe2\xdf\xfc2\xa1t\xed\xe6-}\xd7?\xf11\xf6\xd6+\xbb\xd2 \xc8\x0f\xfb\x8aA\xb2\xd7(\u007fx\xe20\x9c\x9c6`\xb7\xc2뭲\x98Ѽ\xbe$o\xd0\xfbK^\xa2D\xe7\b\xac\x18\xde\xecR\xb1\xa9*.n\xd3\xe2Bkqq\x9b\x16\x17\xa2ŝ| \xc4L\xef$\xad37\xff\x8a\xe9\x048\xf7\xaf\x95\xa1p*u\x98gV,\xbfs\xee\v\x90\xa8{9\x9bM:\a\a\xd7\xd7\xd7\xf5\xeb\xc3z2\xbd8h5\x1a\x8d\x03\fڭ\xb6\x81b\xf7\xecWo+\xe3\x94\xe5U\xb5;\xb0\x0f\x94\xe1_\xfdQ\xc6ϑ\x8d\v\x99\xf9\xbd\xfc\xce\xf7\xae\xbb\xb1\xef\xc1R\xfe\xec\xc8\x1f\xd2\xcb\xfa\xec܇\xe6:\x99\x00\xf6\x91x\xdcFp\x85a\x03\xfd,\xec\x8fX\xbd\xb0\x96_ө7\x1b\x1dt\x90e\xc7\xf4\xaav`t\x80N\xda\xc5J\xe7\xb7\xffiug\x9a\r\xe7\x914K\xba\xb6\xc1\x05R\xf4E\xdd\xca%Ե\x86M|B\x90\x91d\x16&)\xad\xf3\xfe2&\a1j;\xfa\x0e,x\t7n\x87\x8f\x14\x17\x1d\xc2E2\x9f\xe1\xc2\xe3_\\Q\xb0O\xddGO\xb1\xef\xfe\b$\xe4\n\x97f\x0e\xb4M\xaa\x90\x811E)Ʀ\rP\xf7ߣ\xf2\xe7tiF̿5!yMs\x97@1y\xedC\xe2\xa4 \x18.\xd6u\xac\x87\xbeeq\xbc\xc7\xc9\xfc\xc3٧\xddr_eQ4\";,\xf1&=\xb7xO2Ro\xbd5}\x12\xa2\xb3\xa8F^{\xb6n\xa0\xc3\"\xe7\x82\x18\xa0\xdf\xdbxY\x81I+\x90\x03\xc2\x17\xd6\xd5\x0f\xa4\xa3\xf5\uede4\f\xa7\x85-\x9a\x8b,rw߆\xdf@g\xc9\t8w\xd6Y\x0eM'\xde;h0\u007f\xb9Ul\xb1k\x89\r\xf2E2Kw4n}\n-\xab\x90}\xdc\xfc\xd7*ZV\\\xe4_\xa3wQ\xfc\t\x15t\xc2R\xc2\x10{5R\xbel\xebQ\xb1\xba\xe6^_\xc63\xf4\x9b\x1a\x97y5n\x04\xb2\xcd\xeac^M\x92\xd63F\x03\x85\xe6\xdbi1~\x1a\x13\xe8\xaft\x86\xfd#퍟\xcc\xf4\xac\xbfqA\xf8\xb7Ґ\tTvݦ\xde^%\t\xc6X\xcc\xefN2\xe7\x93^d)\u007f!(\xbc\xb8\x98F\x17\xc459\x8cSӼHW\xa4B\xd7/\x93\xe4\x03\xef\xcc\xe2-\\aȓ\x83\xe2\x003\xf2zx\xa7\xdd\xf0\x87\xec[\xa9Nh#\xeb\nt\xf1\xd5;3̱\xf1.FQp\xe1ܕ:\xbcGפ\xfbs\xcdF\xa3\xfcr\x1c\xdfߌf\x1cCJ^*\xf3\xca\"\xf4y\xec\xabI\xa5s/\x99 \x11\xe3\xafG*\x8e }: \x12;ɐ߈\xee8n\x95R\xd7\xc5S\xc0\xaf(藈T\xd8k\r\x81\xf61\xb2DQ\x0e\x9c\n\xb6J\x9a\x02;\x80\x882B\x82\a\xc5i\xb0\xe1b\xc9\vuL\x9d\xd5{\xabr\xb06p\xc2Q\xa4\x95\x96\xf6\t\xae\xf4\xf1⇠\xe1ß\x1f\x85\xff\ty\x1a\x05Zq\x11q\xbc\xe1\x17\x8e\x00f\x02\xb3\v\xef\xb5 \xf0j\x00\x9b\xcf\x01\x97\x11\xae)\xd8\x18&/\x8cdX\x9bE#\x10D|\xd3A`\xfe\x02\xff\xfc\b\xb3l\"\x89\v\x15U\xb7\xa20\xc8\xd7M\x8c\xda;\xb2\x05u\x1f,\xcf\x17T\xd6n\xc1\x8fK_ĲWq8\xc4\xcb\xc7\xfa2VW\xd6`c. \xb2\xfb\x1b\x1e+0\bm;Ii\x14P4\xb7x\x8a\x194֚\xda=`Tn\xb9\xaf\xccS\xa3\xa3\x90SJX*\x89\x11\xc5/\xa7`{ \u007f\x8f\xa2\xd9,\xf2\xf9\xee\"`\\\xfc\b\xc4\xdf\xcc\xc3O\x94\f\x8c\x9b~tݾ\xfe\x86\xb3*\xa2\x88z\xbb\xe9*z\x16\xa6Q |\xf9\x05\xc4,\xdd\b\xc0\xfeD{\xd2I=7\xeda\x84c\f\xceZ\xf1\x0e0(fǆ\xa1\x1e\xcd\xcf\xfc\xec\xf2\x8d\xa9<\xa3=\x80\xd7)*\xa5\x1ep{\xa7%V\xf4\x12\x9e\x8cb\xb7\xb7'Z#+\x85\xba\x96\xb0\xb7\xc7\xc2[\x9c\xd2_a-X\x16\x93\fGf\x97\xa1\x19^\xc2\x17')\x03\xb9/\x92;V\x95\xec\x92\x04\x05\x97AZ)|\xeaƕ`=\xa5\xd0cYw\xcd1i(^B\xf6S\xf8l\x8a\x99䴊j@\xc4\xf3\xa1\xe1\xaa_\xab\xd5\x19\xdd:T\t\x82!Ao\x1a\"x\x18'\x88\x0f\x98R\n\x9aW\xf1\x1e7\xc4M\x8c\xc6y\xbd\x18;\xa8\x83\x8a\xe89\x03\x9d\x99az\xfd\x06\xd8\x19\xad \x15\"\xba\xa8\vE\xd5\r\xfa\xacUԧ\x9c\xe1ժ\xe1\t\xe0\x8c\x0e\x19\x1a\xdf\xdb\xf8&\x83\x8cI\xc0\x8fVB\xf1g\v\x8aȝ\xe1O\xcc'r\x05k!\xdeH\xa7'\x8c4h\xe1\xc9r8\xe2\xab\\9\\Q\x03K\xf5\x94\x9f\xd6>7m\x12\x19\xf3C\n\x8c\x97ٛd\x00\xa8\"\x12\xcdc\xf1f\xb5\xe2\xe7\x05\x1e\x14 \xb0\xe9U\r,\x03:\xd9wK\xe2\x02\xfd\xb1\xb2s _\x8e6\u007fs\xd7\x11$\xc2\xe4#i\xc5\xfc\x12\xf3\xfd\xa4 \ro\xeaf\xb8\xe8X\xe82=\xd14be?41\xdb\xe8q\xc6\v\x8cj\xe9\xfd\x8a\xcf\xc5\xed\x16m\xf4\xdaR\xfd(o\xb1\xe8|(\xfe\x88\xdcLUC\xf4h\xb7b\xb4\x1a\xcc>\xd7\xfe`~5\xb1\xe5GZ\x84KY\xca$i_o\xae\x93\xeb\x00\xe1\x03\xad\xd5\xeb\x8d3\xce\xd1Z\xaf\x91\\\xd0\x05\r\xaf\xc6e\x8c\x82\xe3+\xaa٤\xb0A\x041\xa3\x93\x92\xd5,@\x96,h\xfa\xb1\xa0}\x91\nPW\xb9\x98\xa8ho\x99\xfe(\t\a\x05{9U7\xf8v\xc1T\xe4\n\xad\xf5\x9a\x88K{:\x8cLU\x9a\xba)x<wy\x13\xa7\xff\xa4\xfcD\xec\x13\xeb\x9cHP\xff%\x9a&_\xe2\x9dȜ:j\x97D\xa5_\x9a\xad\x1b\xba\b\xa2\xe5\xdbP\xf8\x02$Qɍ҆7\xdb\xec\xb5S?6}\n\xe1:\xe6(\xae\xa0̠2>ZT\xe8F:!\xb3\xf40LJ\xbd\xc0زh\xb6\xb9\xea\x1blD\xb8t\xbdR\xf8\xe4d\x8a\x87m\x832\t\x8e\xce+)\xca-\x17\xbe\x1fܠX'\xc4\xfd\xd8o\xf8\xc8#\x81\xa4\x11Ol\xe3\xc5@\xb3;\xc9wu\x8a\xd5\xfdb>\r\xc5K\x1a\x1b\xf3\xb7\xd9x\xb9ί\x95\x023\x81\xa3X\xb8\xda\xf2̞\xd1cyĠ\x1e\xdd\xd3A\xb9\xde:\xdf\x16\xd0\x06\xee\x9ao8\xd0\xe5\xafk̀%_\xfa\xfbsʷ\x85\xc2h\x11\xfe\x15'\xd9m\xa7\x14\x8c\xa6?\x9f\xe2{Dʙ\xc6*\xd5\xd5\v\xbdT\xa2\x89UJ{\xe1P\x87\xd7(\xa8\xdd`\\\x9b\x93Y8ſ\xa3\x8cݵ\xbb^\x95\x86\"\xbd\uf4386\xe5F\xf1p\xa7\xd1\xed\x9a\r\xcb\xcb\vGv$G\x03\x80\xf9Ĳ\x10\x995x\x8f\xf2Ut)f\x87\x1eыMM~\xb0iב\xef(\x19\x82\xa6L\xb7\xab\x80\xf1U*\xb1z\xbat\xabd\xd8\xf0\xe3N\xac9\x05\x15\b\xc9\xe2$G\xcaʚ+\b|\xee<\x83j\xbb\xb5q\xae\xdd:\xe6-Ɇ\xf8\xb8\b\xef\xec\u007f6M&\xec|\x83\x1d\x97_\xb9Sڭ\xc8Sv\xa2\xa6z\xe6J\x9f5 \xbfmB\xb21Z\xdco\xfe\xe7\xaf\xff~\x93\xff\xd2\t\x88\x1a\x97\xb3\xab\xd1o\xd8F\x03\xdf\xd8j4\xfe\xa7\xd1(\xfa\xdb:<:n\xffO\xb3ux\xdcn\x1d6\x8f\x8f\x9bP\xbe\xd9l\xb4\xff\xc7i\xfc\x1e\b\x98\xe3K=Е_=Hkp\xff!\xff=J\xfb\xd3x2{|\xef\xe0\xc1\x83{\xce\x03\xe7y2Y\xd0\xc1\xb5S\xe9{N\v\xa6\xa2\x06\xff\x1c9_F\xa3\xf8\xc6\xf9j\fB\x19\x16\xfb\x06\xd6\xf78\x8d\x06\x0er\x9b),\xd9\xc8\xf9\xf6\xe5;g\xc4\xc9P\xe2\xe0^\xc6\x1e\xa6I2\xf3\xe5i\xac\xe7,\xef\xdds\x9c\x03l\xea\xea*\x19\xff\xef[,\xec8\xf1Б[It3I\xa6\xb3\xd4\t\x02g\x9f7\xc9}\xcfq\xae\x92\xc1|\x14\xd5U\xa6\x04X\xf1\x04\xbc\xa7\u07fe\x10\x85\x18\xa20\xa5(\xb0\xcc\x16\t\xaa\xecھ\xb3\xb7'\xd2\xeb\xe1\xd5\xc0\x13\xbf+\xb2\xaf\x02\xf23\x90\xc9@\xf2r.FI/\x1ci\xd0qd\xf5\xb7\x13\x8a\x9bi\xf4h}\x8f\xd8.\x8cZ\xb17\x18\xb7\xe3\xb8s\xa8\x95Φ1l\xfc]\x84N\xb1ͧ\xd0\xeaM\x84c:ۿ\x8ez\x1f\xe2پ\xef\xec\u007f\x9b\xfc\x82\u007f\xaeR\xfc\xf7\xf5\xfe9\xf6\xe4\xef\xa0L%Z\r\xea\x8a\xe3\xf8N8Ƨ,P\xf8\x00(\xcb5\xe1C&9S\xc0I\xea|\x88\x160a\xbd\x05\xceV<%\xd3YV\x1f\xfa\xf5<M\x9ffP\x00\xc0?.#<'rf\tf;\xcf\u07fe՛I\xd0r-ߛB@\x84\xab\a\b\xef\x81\xf3\xfd,\x1eų\x85\x1a>\xc2`Y^\x86\xe7K\xeb\xceˡ3N\x9cYx\xc1}\x89S\xe7\"\xfe\x18\x8d}\x06\x11:/^\xfe\x1d\x13\xb9ޠ\xee\xbc&\xe9*\x1c\x8d\x16\x80\x00\nԂ\xb2p?\x1c;\xbdș\x00eB!\xaa\x8b\x83R-KO\xbb\n4\xe4SE\x9e\n\xc6}4\x02|\x95\x04MŞ\xadV\xce\xfe \xfe\xb8\xefQ\r\xc4\xd4\xf8\x1e\xfdDAe\xec\xc4c\x011\x1a\x9d\x8d\xcf\x01\x14~\xc1/*\"\xf6\xb3h\x04_k\x03=O\xe9\x9c\x16\xfa\x8e~}\xb0\xcf\x01^\a\xa2xJ\x8b\x89\x1f\xd7,\x18M<N+\x9c\x893D\xf5\x9b>\xffm\xf9N\xbd^\u007fp \x87\x87>NB\x96\x82\"\x14\x1eoΘ\x17\"\xb1\x13?\x1a\xc3?ժ\x1c\x9chU\xf79T\x95\xf0>\xdf=}X\\87\xb4\x97t\xb1\aM\xb3\xa0\x19Љoz\x19E3$\x80\xcbd4\xa0\xd1\xfd? \xc5\xe1\x14\xe7\x1c\xba\xf8\xf7o\xbfa\x02\xcdFK\xa7\"T+p*\xd6\nҦM\xcd\xec>\xb5\x03\xcbd\x89K\xdd\xe98\xfb\xb3\xe8fv\xd0O\xd3\xfd5\x8f\rѦf\xb9\xf0\xad\xc1\xfd\xcb(\x1c\xec\xe3ml\x1f\xa0{\xe6\x04ֹ7@\f\xf8\x9b\x8e\xb11\x01\a_\xf1<c\xfcϩS)\xcc(\xa8Qa\x1f\x17\x81\x1amh\xacHc\xd2c`i\xb8\n\x18\t\xce\xdbx\u070f\x80\x9b\xa53\xf8\xa7\aR\x9c\xf3\x0f\xe2\v\xa9s\x19~\x8cPǌ\xc7\x17\xb08\xd294u\x1d\xcf.3صA4\n\x17b\x11]Gr٥\x11L\x18\xfe`f\x80āϫR\xa0\xe4\x834\xba\xb8*\xa67\x8a\xa2+ W\xc2\xd1\xe42\xf4\xf1\"x<\xf2\x9dاʩ>/\xb4\x90\x91\x8d\x89\xb1\xef\xab\xd2\xff\xfe7W\u007f\x00\u0080\x97U>\xe7\xeb\xdd\xfb5m\x85\xd1\xdby\x00\xa5Qo4\x9d\xaa\x13\x1fPI\x18\rTU\x85~\x81\x02\xd2\xd4Ri:5\xa7ҬQ\x03\x9es\xc0mB\x85\n\u0528\x118h\x92s\x15\x00\xe6\xa1\x00\xc5f}\xf5t\xdeK\xf9\xb5\xb2F\x9e1\xd6\xc9N\xfczX\xd9Wi\xfb\x1e\xbeq\xf6Mr\x1dM\x9f\x87\x18\xadZo\x83x\x02\xb5\x04[\r\f\x13\x06$\xbe\xab\xf4\x89\ff\x9f\x17\x16\xeeU\xf73\x0e{\xc6/1\v\xec:\xbc\"\xc4ۣ\xdf\xc1$VD\xba\xe3\xec\xff?\x01\x16aJjK\x1dL\xa4\t\x81\xd4%|d\xe5\x1b\u007f[\x8a\t\xea`\xa1_\xb0\xc4Z/\xc1S\x00\xa9fABa\xaep\x85JWq\xba<\xb3N\xb3\xa8\x1cM\x8e\xe7\xfc\r\xa7s\xd7&\xf6\xa1\xec\xb6>×/\x90\x04+\xff;b)\xc2DpO\x14\xb2\x91\v\x93Ӥ\xac\xb5\xc1\xda0/\xc7\xd8ޑ\xe5\x05\x88<N\xe6\xf0\xd7ڈm\x06Na!\x1dP\xedPZ\x81mWlX\x8b\x82%Ơ*\xd1(\xbf;\xe1f.9NFT\x13\xf53\xe6nc5\xb1\xf7\xd4\xfb\x97\xe1\xf4\xe9\xacҠg\xf7\x80\x91\v\x92$\xfa\x80l\x8e\x1e\xd2\xf4\xd4\x1e\x86\xa6N\xd8\b\xe4@\xb2\xbd\x01\xb6\x05Ez\x93\x89\"cz崊\xb0D\x1e\xbaR\x9cM&\xe7\x0e\xe8\x9eN\xa6|\xaamb\"\x10\x9c\x95\x85\xba\xa5\xa5\x19\xae\x89\xf9\xb7\x11\xf0=rӝ\x8c\"\xdeSt\x01 \x9c9\tpˢ\xad?MshU\xbbb\xb6y\x8b\x81HD\x9fi\x132\xf6V+c_߲\xb1\xa3\x8d:E\xc0\xc2\xd9T\x84\x17.\xe8\xdbU4\xbd\x88* \xde\x16m׀~\xe7\x91S\xb0a\xeb\x93\"\x02\xe9@\xe7\xf4MZd\x9aÄb\x9eZ'\xc8f\xa0]\x92W\xccI\x90\xa9X^\n1k}\xc4P 7\xe4\xef4\xaa\x0f{i2\x9a\xcfP~\xb9\x88j\xfc\x96\x9eCơ\x88e;)\xfd\x15 d\x92\xe0d\xe9ԟ\xa0(\xeb\xdct\xf0\xe1_\x02\xf5M4\x04Eb\x91%\xbc\x03\xba\xe7\x0e\xb2\xa9\xa3Br\x81\xca~C\"\x8a'\x87\x9e\xd4o\xaa\x81\x05,\xa9/\xb44\x80g\xccp\xb2q\xb4\xb8-9t\x84\xe4\f\xa7ɕ6J\xde>P\xba\tɶ\x97\x1f-\xbd\xe7\x8d'\xd2|\x04\xe5ă\x1b9ti\x06a\xad\x85\xe1\xa3\xd2\xc2@\xf7\x9d'\"\xad\xc3\u007fϠ*0S\xfa-\b\xe5\\u\xfb\xc0y6\x8fG\xb3ZF\x91\xa9\xd4:\xe47\"\x99ڥ]\xb6\xe34A\x8e\xd4\xfe\x03\x10\xef`\\c\x8a*\x84sɛ1\x88s\x83ix\xcd\x15\xf9t\xd29)\xaașXQ\x89\x1b<a\xe4\xee촍:\xaa\x12b\x16\x14\xa8\xfe\ah+e\xac\x84\x83x\x8e\xddk\xf8\xf9\xf2\x9c))\x8d\xd51\xf6\x8b\xe5\xba\xc9\f\xe3\x84;\r\xbb\x83\xdfa\x06N\aO?\x15\xee'Sz[\xc6i\xfavaX*\xd8\x1f\xa7Ҩ\xd7\x05\v\xe5\xc3Dg\xff3\xd0\xf9\xf7}\xad\xf4gӋ\x1e\x12\xc0g\xd3\xe9\xc5E\xafG\x85\a\xf1\x94\x9f\u007f7\x80C\xe1&\xcc\xe5(\xe9\u007f\xb8\x8e\xd3\xc8wj\xf8\x89\x911\xa2\xa9J\xa5\xfa\xe9$\x8a\x06Vǲ\xae\xa5\x0epD\x90\xf3\xfa\xc9x@\xc5i\xa3E\x8c\xd9({\x8a\xceD\xa0\xcc^c\r\xd0\xd8A\xd1\xe1\x06\xe4\x06\xeb4\x0f\x8e\x8c\x0e\xbe\x16\xa2\xac\xc00\xd1\x00s\xad\t`\xaae\"\x96j|\xc92H\xd6%X\xa2\xb02\xe6).\f\xed\xa1bF\xe3/l\ftZ\xd1C\xdf\x00\xf3=(\x9e\xa1\x83\x0f08\xbf\xd4H\xf4B\rV\xd0.π\xbc9\r\xb3\x90\xb2*\x0e\x13\x01UQ_\xa5L$V\xf8\x13_\x90\x1a\x8a\xfd\x17\\\x88\xb1\x94L\xa0f\xbb\xf1\xb7}\vK}\xf2\xaau\xf0\xed\xa9\x18}\x95\x17\x82؇\xb3|\xf9\xac\xf8e2\x8d\u007fI\x00\xa5\xb2\xc2D\xfaW:\xfb\x92=\xeeS\x05\xd1\tU@\xe35DԀ4X\xf2s4)X\xccCX\x1c*\x89d\x19\xfcV҄\x16\xb3\xd8XP\xb0\\\xae}YV\xc5\xcb\xf4\xd5\xc2\xf74.\xf1\x15[6\x14O\x98]\xe2\xae\nC\x9fƃ\x88P\xd6\x13|$\xed@\r\x1b(\xd9\x1c\x10\x147.\xb3\x95Ϸ\xcfF\x1f\xc5GI\a\x1e\f\x98\x8d\x8aI\x93s\xc3\x1c\x94_\x80U\xdb\x05\xda\np\x90\xa8\xc8ы\x86h\x18\bG\xa0\xda\f\x16\x12 \xc1\x01\xea\x02f:\xa3\xec\xf9,Ai\x8f\xe6\xcea\a\xe1\x01sjT\xa4\xf0am\x12\xe5DS=\a\vB}\x05\x0f(\x03\x04'\xba\xb2@V\x87\xba\xc89\x10\x8b1\x869͜\x1d\bJ\xb6;\vOq\x04qO۰\xd1+\x0f\xb0\x85\xb9jC\xf6i\xa7#\u007f=\x9cC-\x9d\xb63\xe14\x8b\n/\xc83J\xe9\x855\xb7\xd4h?\xc9B\b\xacA\xd9Yfd\x97\xd4\xe5o_r]\xa8+W\\R\xe7_kOk\xf7*\x1e@sI\x9d\xf9j5\x11\xfb\n\xfc \x00r@R\xbeZ\xaa\xaa\xbc:\x12\x8a\xfc\xed\xabTZb\t\x06\xbd\x16Ik\x85\x13\xb2\xcfY\xa8sĔ\b]\xe7Y\x04\xc2LD\r\x89t\x12\xae\xc9>\x01\xd2\x19FJ\x97`\xefi\xa2\x9c\xeeſ?M\xc8>\xb0\x0f\x14y1\x05\x16\xde\v\xa7J\xe1\xcc\xdem\xa16\xd4<\x18}\xbco\xeb\x81zwa\x01\xbdJL\xfb\x98\x14\xfdI\x814\xf8\x1d\x910Э\xaaM2\x1f\xaa\xbb\xf72~\x92)\xc1\x95\x84\xfb\x06\x1a.hW\xa8\xd2¯\xa4\xae\xb6\x12Tw[FMҠ\x8c\x14`\xd24\x9d\xf0\xd7LG\x9b\xe5$=H괳\x18y\tt\x12\xa5~P\xab\x93\xba\xd8\x17\xb0\xad\xca\xf0ARg\xfd\xfa\x00\xb7\x16\xcfl[\xd4\x1a\x1e\x88n\xdfS\xd9\xddJfT\x00,Ut\x04:(\xd9v\xb5O%\xbe\xfe\x8c\x88\xe9\u009fG\x8e\x80\b\x1f\xba\x14,\x14;\xd2\x1am\x8b@\fZ\x8f\x86\xbf\x9f\x11\u007f\xd4C\xd4>\x87\xf0A\x83D!P\x0e\xf0\x9e\x01U\x90\x02e\x11m\xfc\x8cu2\xdc\x03x\x9a&\x81s\x00\xa4cc}ς4\x13\xb6\xd2lI\xef\xed餁h!C\t\x9e\x97\x1c\xc0\xc4x\x19\xb8\xb5Wɨ\x9c\xff\xaa\xfb\xa2\xa3!K\xea~\x8eǾ\x85%'uSd\u007f\xccp%{6\xd9\x19-Ҝ\xb1M3\xb7\t\xcf~mQd\xe2:o\xc4Q8\x95cQ\xe7\xa2\xf0a\xaa\x1e\xb06\xd9t\x88Oϡ\xe1T\xfb\x14\xd78\xe4\xe3\xeaYEѶ\xa3\xa9+\xc5\xd8P\x8c\xb5\x00\x1b/\x05'\x17\xa1\x89x\x93C)6\x15b\xe3 \xfe\x18\x0f\xe6P\x80_qr\xfe\x01\xda\x1cږq#\xbc\x9eƳY4\x96\xb0@\xa0F\xc3\xe5\x10\xf6\x05|\xb2\v\x0f\x1e`\x8f\x8c@\xa62\xd1*\x84\xea\xec:\xc3\b\xa9\xc4@\xae\xb9\xf6\xef\xba\xf2\x91q]H\xf2U+\x8d\xbc\xa1\x84\x8a\x91^\x86\x83\xe4Z\x9f1\x813c[\xf1|ci\x15I/\xbe\x96/6\x94\x8a\xbdE\x90!hrc\x94\xe58\x82\xb8\x13P\x91\x82\x12\xd9\xebgB\xb912\x93\x9b\xb74\x84\x8e\x18\x8a\x9e\xa9n\\\xbc\x9e\xc6\x17\xb81\xef\xe3\x16\xb4_X\x042Y#\xa8\xa0!\t\xd6\xdb\xe1qC\xf2\xab\a1\x8c\x80si\b\x83\xe8\xc2\xe3\x9a#YAm\x8b\xd8{\xa7\xba\xef7<s\x10t\xad\xe6;\xa1\xaf\x00f\x84FA\xbc\x83F\xfe\xf8qS\xe2G[\xe0\xf6&F<\x90-\x01\x8a\xf5\xc5&\xeb\x83)\xb7ł[\xce\x1f\xad\xfaf\xf5\xdf\x15ѵ\x83V\xd1\xcci\xa8K\xea\x97\xd7a\xbf\x0fk\U00049cef\x10s8\xa8`\xe8O\xc0\x04Zߍ\xbaJ\xa7P\\V\xcfU\x1bf'\u007f\x04\x05\xdc\xd10?g\x00\x1c\xb1\x05\xf9\xcaP\x19\x9b\x9cٗ(\xa3ѐ%\xb4)\xb79LI\x1d~\xfe\x1e\xd6\xf1\x10}\xc9\"c\"LSI].\x1c<D\x00\x8c\xfb\x84pZYR\xed\xdbo8\r\xe7hrC\rq\"\xce\x03!\xb7Et\xb2\xd6x9\xc2A>\xa0\xe0\x11,e\x11H\xea\xd2&\xe0\t\xc8M\x80\f\xead\xc88\xf6A\xf7\xdc\xf7\xbc\x92\xed@0\xe8]\xd9_8\xf8y\x9eΘ\x01&\x99\x92\x17:\xa8\xa7\x8dXӓ\x1c\xad\x88\x1f\xee\xcc\b\x15\x19\x18\xac0\xf6\xd1B\x96\x914\"\x1c\t\x1e\x1f)\xc4M\xe0\x15EW\x13V\\3\x15=\x15\xf9y0\xd9\xef\x00ae\xe6\\\x9cH\xf3\xe8,\x9eAW+^\xa6\x8cl:\xa7\fi\\x\x06(F\x90\x19l\xaf\xc4a\"ޭ\xcc\xfa.\xb9\xa9:\x95z\x84Ԁ\x00\x90\x04o\xaeF\x18$\x04Jt\xd2\xfeet\x15\xa6\xb5\xab\xb8?M\xd2dH/\xb6v\x00\xaa\xcb\xfaj\xe0\xa2nQÄ\xc7\xfb\xa2\x15\xddJ\x9d\x89\x9bj]\xa6\xa0\x9fͨ\xc3J\ue125\x03c\xc0Rt\xd84\x14Gm\xf2\xf8\xb5s/;S\x80\xa2t\xa0\xb0_\x97\r#M\xf7\xf8m\xc4ig>\x1dU>\x13\xaa\xdeg\x00\x03\xa8\x8f;\x92\xd3\xf3Ć\x15l\xdc\xef\xa6$\x8bZ\x1a\x85\xb6\x8dAv\xeb\xc14\xb7\x95]L'\x95\x92\xcdK\xe3'87\xfb\x14\xd0`߷d\xc4~\x02\x8c9E\x97QhCr\x85\xd4ϗI\xc4\x16R\x9b\xaab\xb5\xa9.\xd8yz\xa5\xa5\xdc\x03A\xb5\x96;\\\xaa\t\u007f9\xbe\xce\x11\x93\xa7\xd0\x04\x8c\xb4&\x19\xafR\xb2\xbc\a-{o\xf0\x1d\xc9\xe6\t\t\x86v\xa7\xb1uf\xe6\f\xda\x17\xaa\x18\u007f\x19\xca]\x9cC-0\xa1\n\xac\xc4\xc1\r\xb1\"|\xf7RC\x1dr\xa9\v}\xc0\x98\xa0\xf7e*\x8ch\x1d\a\xf6Q\x90I\xa4\xd8\x02B\x92\xd8A\xf7eo\xfe\xfd\xef\xc1\x8d\x89\xbd\f\x1c\xcd\x1c\xed\xfd\xc8\xc3q\xf6\xc2i\x9f\xa7Km\xa1kϞTM\x06\x99\xfa\xb9\x1cK\xe2\xc8\x17\x90\xfa*\xef\xe8\xf9|\xc2h-۶\xf3%\x18a\x1d\xf1\xd7ʶ\xc7*\xe8\x13\xf9=\x0eP\x18\x0f\x8b9\u007f~\xdf,\x81\xc6\xd7\xd1\x11\x9e\xaa\xd2X{\xc8%\x88=\bo\x01.\x85\xfc\r\xcf\x1eق\xdc\x1b\xc1v\x88\xf6\xb8\xf9$Ɏ\xab\xfb\x97x\xfb\xd5hIWk\xbcr\xca6\xb6\xcb{\x86.\x87B.\x1fk\x04\xa64c(ID\x86\xb5\x96P\xd4\xe3A\xe7\xc5\x0f/\xaf\u008b\xe8\x9d\xe4s\xf5o\x15\xcf|6\x9aO+\x93\xf8&\x1a\xf1\xe4\x05-\xff*\xfc\x10q\xebA\xd3\xe7\x1fbPA\xfdPq\xadm=\xe2~x&W\x97{\xf6\x85\xc1\x89\xf3\f0ۋ\n\xf6\xb9\x1c+\xec\xf3iEfϐ'\x15\xc4\"y\x04(\x06\xc9%\xb5Z)M\x01q\xdd\xc7<\x10Xa\xc3\xec\x17헚n\x86\xfc\xc3\xd8<\xab\xc9y\x97\x93\x11H_\xebCI\xb2!\x14\xf5=\xc8\xcb\xef\xbbR\x1aYK\xd3\"\xbb7%\xbd\xc8\x16S\x05\x8f\xc6\xf5\xac\xf6\x19g?\xbfӰ4F\x16\x18q,G\xe0|!{\"M\xec{\xd8UJ\x86\x9d\xecg/\xdb襛VN\xb2\f\x1c\vX\x98\x9d\xe5c{b\xd2\xc5\xf4\u07bb\x87\xb7'\xee=:\x90\xber\u007f\xb9\x8d\xfe\xf5\xdf_\xff\xfd\xf5\xdf\u007f\xc1\u007f\xff\xff\x00\x00\x00\xff\xfff\xe4:\xb2\x00L\x05\x00")
+var devAssets = vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec\xfdݚ\x1b7\x92(\x8a\xce\xed\xd1S\x84\xd8kܙUI\x16\xc9\xfa\x91T%J\x9f-\xdb\xd3>\xcbV{[\x9e^\xddS\xaeї\xcc\x04\x8b\xa9\"3\xe9\xccd\x15)[\xeb\xe6<Ӿ;W\xfb\x01\xce~\xa5\xf3E\xe0\x1f\x89L\xb2JRw\xcf\xda\xcb=\xa3b\x02\x81@ \x10\x00\x02\x81@`\x1aWl0\xaf\x97\x8b\x7f\xf9|\xff\rG\xc3\xe1\xd9\xd9ɿ\f\xf9\x7f\xee߳\xb3\x93\x93\x7f\x19\x9d\x8e\xcfN\x8e\x8f\x87\xc3\xd3\xd3\x7f\x19\x8eFó\x93\x7f\x81\xe1\xbf\xfc\x1d\xfe[Wu\\\xfe\xcb\xf0\xa3\xebr\x1b\xf7_\xe4\xbf\xdf~;:x\x04\x90\xe5\xc9b\x9d\xb2sX\xae7\x83wգ\x83\xa3\x0f\x1f\x1e=\x7f\xfc\xf5\x9f_\xfd\xfc\xb7\x1f\xbf\x01\x14\x90\x17\x8f\x9e\xcb?,N_<z^g\xf5\x82\xbd\xf8\xed7\xa8\xd9r\xb5\x88k\x06=J\xea\xc1\xe0Ç\xe7G<\xfb\xd1\xf3\xaa\xde.\x18\xd4\xdb\x15\x9b\xf4j\xb6\xa9\x8f\x92\xaa\xea\xbdxtt\x00\xf3\xba^\x9d\x1f\x1d-ٖ\x95wl:H\x8a\xe5\x11+\xb3\xe4\xa8.\x8aE\x85pG%\xabX}\xf4\b\x00nǃ!\xfc\x0ec\x14\x8e\xd1\xf8\f\x93\xbe\xcf\x12\x96W\xec\x1c\xf2\"g\x10\xac\xd6\xd3E\x96@Z,\xe3,\x0f\x1f\x1d\x1c=z\x84\x04G0-\xd2m\x04iv\x1bA\xb5\x8a\xf3\b\xe2\xd5j\xc1\xea\b\x8a\xe9;\x96\xd4\x11d\xb32^\xb2\xe8\xd1|\x14\xc1|\x1c\xc1\xfc8\x82\xf9I\x04\xf3\xd3\b\xe6g\x11\xac\"\x98.\x8a\xe4\xe6\xd7uQ\xb3\bV%\x8b\x1e\xc5\x11\xc4\xd3i\x19A\x9c\x94E\xbe]F\x10\xa7iɪ*\x82iv\x1dA\x92!hR\xa4,z\x94\xb2E\x04\xe9,\x8f\x80-#Ȗ\xd7\x11dy\x15\xc1\xcd4\x8d\xe0\xd7\b\xaa\b\xaax\xb9\x8a\x1eU\xcbx\xb1\x88\xa0\xaa\xcb\xec\x86\xd1\xdf\"\xbf\x8e\xa0ZO\xf1\x9fU\x04u\x1d\xc1m\\F\x8f\xa6\x11\xac#\xc8\"HX^\xb32z\x94b\x15u\x04i\x1aA\xb1\x88`\xbd\x88`\x91E\x8ff\x19[\xa4\x15\xb6vV\x94\xcb\b\x16\xf1\x14\xa9Y\xb0k\x96\xa7ѣ:\x9e.\x90\xcexUgE\x1eA͙Uϊ\xa2\x8e\xa0Ʈ\x8e\xa0.\xf1g\x04u\x1a=\x8a\xcb:K\xb0H\\e)\x95\xcco\xe3*\x82\x94\xd5q\xb6\xa8\xb0\x89S\x96b\xbd\xd7\xeb\x92E0ˮ\x15rD\xca\xca\b\x10+\xfd\xbd.\x8b\xf5*z\xb4d\xf9:\x82<\xbe\x8d\xa0X\u05ebu\x1dA\xb9\x9en#\xa8X\xc2KV\xeb\xe52.\xb7ѣ:[\xb2\b\x96qy\x13A\xbcN\xb3\"\x82\xdb,e\x05\xfc\xf6\b0\xf9:\xcb\xcfax\xf1\b`\x15\xa7i\x96_\x8b\xafiQ\xa6\xac\x14\x1f\xb3\"\xaf\xfbU\xf6\x9e\x9d\xc3h8\xfcW\x99t\x0eY>geVc\xc2-ÆƋ~\xbcȮ\xf3s\xc0\x89r\x91\xe5\xec\xe2\xd1\a\x94\xdd?\xfd\xfc\xc3\xf7\xa7\x90f\xd5j\x11o\xfbe\xb1`@\xb2\x8a\\\x86b\x91\xb2\x12\xa6eqW\xb1\xb2\x82\x83\xa3\x06\xd7\x14\xb7,\xf6p\x96=ja\x13\x18l\x12\x8c\xa1V\v\"ι\x8c\"}؇\x94\x85\x04\xf7\xe7,\xbb\x9e\xd7\xe70\xc2,.\x1a\"\xb3\xaa\xfb4:\xf9\b\xa2\x92\x86\x98\xffJP\xf4Q\xf9 ΧlV`\x17\x1bI\xf1\x8c\x84\xf1W\x95\xf7+O\"TI\x91\xd7\f\xd9\xfc\xc7?^\x98\x9f\x125\xc9\"\xfc\xa6z\xab\x9f\x14\x8bE\xbc\xc2\x11.\x7f\xe9\xae\xecW\xab8\x91\xfdK]\xf2M\x9eB1\x13\xddpp\xa4\xb9@\xdd=\x8b\x97\xd9b{\x0e\x7fae\x1a\xe7\xb1+\x06g\xab\r᎓\x1b\xe4v\x9e\x9e\xc3\x1f\xbe~\x85\xffC\xec\xf3\x91Ft'\xd89-\x16\xa9\x83\xe5xȱp9\xecO\x8b\xba.\x96(c\x98\xfc\xe1\xd1\xfc\x18~\xb3\v\x8cE\u0380\x95e\xc1\x99dQ\xf0\xed\xb7\xaf\x86\xaf\xbe\"\x88\xbb\xb8̳\xfc\xda\a\xf3\xed\x97\xdf\x12\x0f\x06Y>+<\x00\xaf\xf0\x7f\x04\x80ӑ\a`\xf8\xed\xd3o\xbfU\x00\x83\xe5zQg\xe7y=\xef'\xf3l\x91\x06E\x9a\x86\x9d\xf5rA\x85\xdf\xccQ7\x16\xbc\x10\xbd\xa5x\xb1\xda@U,\xb2\xd4d\xef\xa0Z'\t\xab\xaaf%_\x7f\xfb\xed\xf0\xeb\xa7\b\xb3*-\xd1(\xe34[W\xe7p\xcakq\xc7\x00\xa0\xc4\x14\xe59\xfc\xe1շ_\x7f\xf5\xcd+=\xc4\xf3\xa2\\\xc6\v\x18\x8dW\x9b\xa3\xf1x\xb5\x81\x1f\x8a<N\x8a\xe8\x87\"/P\xa4\x18<Ζ\xab\xa2\xac㼶%\xa2/Q\x8eg\xf8?'3[\xc6\xd7\xec\x1c\xfawlz\x93\xd5\xfd\x92\xadX\\g\xf9u\x1f\xc7`\\\xf6\xaf\x91b\x96\xd7A]\xac\"\xf8\xc3\xc9\xc9\t\fW\x1b\xf1\v\t\x89$b\xe7\xeb\xe4d\xb5\t[*[\x16\xef\xff^5U\x7f\xa7\x8a\x8a\xbfO=\x9f\xa1\x12\xbd\xe0\xc0\xe8Ԝ\b\xceaĖ|\xe5)nY9[\x14w\xe7\x10\xaf\xeb\x02嚖|w^\x18\x8d\xda\xc4\x1a5(\xb9$\xf1\xa5ߪG\xce4#\xd0x\x15\x92,\xe7\v\x98\x1e\x1cggg\x17\xf6\xba\xc9\a\xda Y\x97%\xcb\xeb\xe6\x80|\xfa\x15j\xb7&\x0e9\xbd4*\xea+\xa2ﲴ\x9e\xeb\xb5V.H\xc8\xc7\x1d\x8c\x1bB\x9f'q\xbaP\xe3\x82\xdf\xfc\xb3JZ\xd45KMz\x92uY!\x89\xab\"\xe3\x8c\"$\x03\xb9\x96\xa46w\xe4*4\x98gy\xdd\xe8\x10A\xaa\xc58\x9a\xd15\xb8\xa7\xba\x01)\x960?Q\xf8\xf4\x04t\xdc6\x019,\x11\x1c\xe9\xc2~>G\xb9\xea\x9c\xd89\xe8`\xb6ε\xe2\xe0Y\xce4\xe4\xa2Hb\x05\xe94\x9b\xc3\f\xaa:\x95M\x93\x00Ϟ=s\x00:\xaa\xe4\x9cP+\xff\xe06^\xacY\x05\xbf9\x02\xadu6\x87yC/\xf3\x1cl\xf5\xbc}\xe9\x16\\\xee\x97B\x1eŒ\xe5\xaa~u\xb1\")\x9eg5#\xb5\x83\x14\xa6\xbb2^5k\xe3BuW\x94i\x7fZ\xb2\xf8\xe6\x1c\xe8O?^PC\x9f\x1f\x91\xc2\xf5\xe2\xd1\xf3#\xb1\x8bB-\xe5ţ\xdf~\x03\xb6\xa9Y\x9e\x02\ueecex\xe2\xf3#\xbe\xe1\xfa\x9c\xfb\xbf\xe9:[ \x0f>\xa7\r`\xc7\xfe\x7f|r:\xfc\x97\xd1\xf8\xf8\xect|<:;\x1b\xe1\xfe\xff\xc9\xc9\xf0\x7f\xef\xff\xff\x8e\xfb\x7f.|\x15\xdf\xe6\x90,\x98F\x81\x92-\x8a8\x1d\xf0\xddt\xb5\xcar\x0eAF\x82\xdf~\x83\x94Ͳ\\\xef\xfe?|\xf8J\b\x15\xfc\xf6\x1b\f^\xe3t\xf2\xe1\xc3`0@!\x17\x12\x9ef\xb7\x90,⪚\xf4\x84\xf2(T\xc0ދG\x00\xcf\xe7\xa3\x17-8\x9e\x1f\xcdG\x04\x82\x18h,!\x069~q\x00ê\xa82\x9cp\x88\xee\xb8\xcen\xd9\x05a\x15\x85\xb2t\xd2\xc36\xe4\xac\xec\xbdx~\x94f\xb7\x84\x8f\xff\x90\x7fh\xf5|\xf1\x1a\xb74\xf0\xf3<\xab`\x15_3\xc8*Z\xb6\x971M\x0f\x8b\xad`\fK\xa1\xc8\x13fR\n(Uu5x~\xc41=\xb2\xcc$\x8a\x85=\x18\x10;\xaa\xa4\xccV\xb5i$y\x17\xdf\xc6<\xb5\xf7\xe2\xd1m\\B\xb1\xaa+\x98\xa8]]u\x0e\xa3\xe3\b\x8e\x8e\xe0\xe79\x83|\xbd\x9c\xb2\x12\x8a\x19σ\xba\x80\xb4\x8c\xef\x10\x96\xe5\u05f8\xf6\x8e\x87\n\x98'!0\x8b\x939\x950\xd7h\r\x87\x9dZϳ\xe4&gU\xf5\b@\xea\xdc\xc7\x1a\x86'!\xaez\u0380\x98\nIV&\vF\x8bB\x99\xb3\x12)%\xf8W\xf4\t\xb48!F\b\x86\x83\xc1(D\xc4E\x1d#\xa7\r\xbc\x98\x82\x8bF1\x9bU\xac\xa6u\xba\xe4{^\x89nt\x0e\t*\x18wY\xc5\"\xe8\xe3g\xb1ƥQ\xa5\xeau\xe9\x8f\x7f\x18\x0e\x87\x7f\xa4b\x7f(\xaf\xa7P\x94\xf0\x87\xb2\xbc\xbe\x9e\xd2ϸ,\xe3-\xb6\x81\xa0\xb1\xa5Պ\xb1TV\xf4\x13\x12\\\xc1\n\x85\x94%E\x9e>\x02\xa8\xcb8[\x9c\xc3\x19\xa7\xf8K\xdc\xe2^/\x8a;\x04B\xad,\xbe\xc6ʫy\x9c\xa2\xaaW\x97kFp\xffc\xce\xea9+\xa1.\xa0d9J},\x80\x1e\x01\xcc\xef\xe2$a\v?\xf8\xbab0\x8f\xcb\xf4..\x19\x10\x1c+\x89A\x8f\x80\x8f#\x94\xbbs\xf8\xa3\x10\xec?*F\xbez\xf3\x86\x03 \x96\xb8\xaa\xb2\xeb\x1c\x7fao\t\xd8G\x00\xef\xbf\xcbS\xb69\x871{\xa6\n\xbe\xefg\x98\bA\xcaf\xf1zQ\x13\x82\xf1P\xfe\x87\xddV\x17\xabs\xf8\xe3\xe9\xf0_Eu\xc5J\x8d=5\xf4\xb0\xd4*F]\x92\x84qV\x8b\x12X\xe0{6\xab;K|\xb8 ѯ\xe3\xf2\x9a\xd50\x81\xb4H\xd6K\x96׃kV\x7f\xb3`\xf8\xf3\xab\xedwi\xa0\x9a\x1d\xf2\x02\xe2\x13&\x90\xb3;xÿ\x02\x1cA\xe1\x00\xf3\x02\x8e1\xbc\xc0\xf5\x98\x06\x993@\x8d\tO\f\xd1\x7f\xf9_\xf4\xbf\xf4xp;\x1e\xbc\xab>g\x1d\x9d\xeb\xff\x93\xf1\xc9\xf0\xc9\xd8Y\xff\x87'g\xe3\xff\xbd\xfe\xff=\xfe\v\xa4z\x1ep\x13\x8f\xfc\x84\xf4\xf8-\xcd\x1bAR\x17%ڼ\x8b\x15\xaaŬ\xe2p\x00u\xb9\x15\xbf\x80\x8c\x9e\x01\x8e\xbc\x1b\xb6\x85,\xf7@\xe3\x7f\x7f&s\xfb\x80\xab\v?r\x90-\xe1\x1f\xacʢ.p\xf9\x8b\x10Ed\x14\x02 \xd5\xfa\xdc\xc0yyöW\x91\x01\xc1\xf2\xf5\x92\x95\xa8\x87\x9f\xc3,^\xd0\xcc\xcf\xff\xfb@;s\xfaE\x7f?@\x12\xd7\xc9\x1c\x02\xa6\t\xb3\t\x80\x89Q\xd3\xc5#Y\xf4\x83\xc3\x1bZ3^\x15\xabm\xb0\xaa\xd8:-\xe8[\xe2DNd0\x81\xfe(\x82\x1c\x11j\x90\x01_}#\xb1\xe8L\xe0\xf2\x8aWr7\xcf\x16\f\x82\xc3\xc3\f\x9eC\x1e\xf2\xfc\xc1j]\xcd\xcd\x1a.\xb3+Ѣ\x92\xd5\xeb2\xe7`\x17m\x04\xbeYd\t\xf3Q(J\x7f\xc9+\x91m\x1fT\b?@\xdd\xc6*\xe4\xc3\xffC\xbcB\x91q\x93\xb3\x94\xe5uVo\x83ԩ*\xf5!\xa9\xe7Y\x158\x80\x98\xe6\x85-\u05ec\x01[\xae\x99\x0f\x96~\x17ep\xeb\xc2oW\xac\x98\xc1-L&\x13\xe8\xc9\x12=x\t\xb7p\x0e\xceP0\xca\xdd\nA\xf0\xd5U\xb2i\x96\xa7bI\x89\xa0*\xd6e\xc2\"X\xb2z^\xb8L\xf0Ԁ\xb2B\x12\x0e\x13Qf\x80\xc7S\xdb@\"\x8a\xcbkZ\xf4*%ʪ\xebE\x86\x90)x)W\xcas\x8e\xb1\x83h\xae0\x06\x1b\x87\xbe\r<\x9e@\xbe^,\xe0\x8b/\xe0qV\xbd\x8e_\a\x1bo\xf7\xbf\xcfV\xdfS\xad͎\x16\xe4\xf8JU\xabEV\u05ecl\x16\x82\t\xaf\xd8WJ\x1al\x82\xca)U\r\xea2[\x06\xe1\xa0d\xabE\x9c\xb0\xe0\xe8\x97\xea\xf0\xe8:\x82\x1e\xf4\xbcT\x97q~\xcdޢ\x01嚕o\x92x\xc14\vh\xfa\x82\t\x1e\x93\x18\xe3q\x03\ap\x03\xff\n\xa3\x10n\xe0`\x02\xa3\xa15\xfcn|\xb5\xa0I\tg\x19\xdf\xf8\x90yo\xd9-\x1a\x1b\xe5\xa7$B\x81\xf2l[L\xdeÄ\x0em\x18jՑ5üW\xf3\xcaBJ\x89;\xa1d3\b\x16\bz\x99]\r\x8a<\x84\x85\x104\x1cp\x1db&I\xd4s!\xa7FQB\x93X\xa4\xbe\xbf\xda\xd2\x1e\x88\xeb^|\xa2\xe0E\xa9I\x83\x02\xc9U\xe3 \xc7sWU\xd4n\xee\x02&\x0eR\xd4\xfb\xa8H\x18A&\xc9\xc4v5\x86\xc2s\x18\x87\xb2\x01$̋A\x91\x9b%\x16\xba.\xa0L\xd0\xf2'\x12\x8d\xf6\xa9\xdf|\x86\f\x86\x9c\xfb:\x99t\xe5?ςE\x18\x0e\x92\"O\xe2:p\xcbdp\b\xa30lV \xdaV\xb2eq\xcbx\xf3\xecEKP\xac\x98\xa41\xd3\xf2\xe0\xe0\xa9\x04\x8f\xccE\x14wM\x12L\xa2\x0ewt\xb4%\xe7\xd4wޙ\x16\rr\xf5\xdbUɒ\xac\xc2\x1e\xddD\xb0r\x86\xe9\n\xfa8\x90^\xc2\b\x0eᇸ\x9e\x0ff\x8b\xa2(\x03\xfa\xb9(\xae\x83\x8dL_\x15w\x01\xee@[\x01C8\xe2\x19߿\x1e\rC\xe8\xc3*t\x92\xcea\x14v\x10\x8a+\xc0\xd7|G\xe3\x99\xfd\x0e\xa1\xd7\xeb(L\xe7\xb0\x01ͭ\xeeRO\x89\x83E\\\xd5\xdf\tQ\xe8\rza\x043\x98@\x06/&0\x84\x97\x02\xa8ZO\xf1\x80?\xbf\x0e2$70\x8a\x8b1\xdcÒuS9\xc8\xe0\x05\fC\xa8y\xbf7\xb0A\x7f\x02\xc7\x11\xa0\xa0\x1d\x87\xb6\x9aP\x0fJv\xcbʊ\x05\xe1\xe0]\x91\xe5A/\xea\x85p\b\xb3\xf6\xd6\xfeX\xb2Y\xb6\t\xd2\b2w\x8a\xb4\xfa\x8a>\xe2i\x15<\x85>\x82\x1e\xc0\xb1]7/\vP\xe1|{\x8e܀\xa7\xf0R\xcf\x01\xa99\x14\xd5zp\xc4\xe7V\xfc\xef\x03\x9c\xef\x82>0\xa0\xa5fXm\x97\xd3bq\x0ei\xfb2\xc8⊡\x9a\xbb\\\x05\xb3\xb6\xa5\xbanh\x035<\xe7\xfd9\x84s\xa8\xb1sG$\xdc\xe70\v\xea\xf0bGu\xb2#\xeeQ\xe1\b\xfa0\v\xf0\xdf}\xd0\xcf\x16,\xa9\xef\x83~p\n\a\x10\xd4\xf0\x1c\x7f\xbd\x84Y0\x86\x03\xa8Q6\xc7T1\xfe˓v֮Կک\xben-\xb2*\x16ۀ9\xe0\x1d\xd4*\xe1\xab#`;\t\xaa\xb2\xbcA\v2\x92\xb0$E\x15\xd4p\xc0?~\xfc\x0e\x8e`\x1c\xb6bb\x9bU\x03\x93\xa2e\x1c\xc1hȹؗ\xb3\xbc_\xd8\xc88\xd6NR\xf5kY\xf3\x9e&\x86\xb7S\x83SM\x96\x04\xb11ݒ\xe9\xe3\xe2\x91\\0\xfc\x8b\xe2\n&089\xed\x04\x1bi\xa6\xc7Z#\x02\xa8`\x02+8\x82\x13\xc1u`\x8b\x8a\xa9T\x12\x1b\xc1\xcaP\xfe\x8c\xb1\x03Fp\x04\xb1=)tJ\xfb!\xc4p\xd0dn\xbfVh\x11+\xf1\xba¤\xb1Շ\xab\x9dB\x81\x87dZ\x99D&<\xaeBj\xc7h\xf0d8:}z\xb1\xf7Lp@\xff\x1f\x04\x15\xad\xee\xf4\x89D\xed\xa4\xa0X\xe7IS\fp\f\"\xb3ƃ'8\x12\x9f\fN\xcfƧ\xa2\x8e\x9a&\x9b\xe70\xf6\xe4#+\x90\xf8S\x91\xc7\t9\x04\x04\x13\xa5\x06\xa7\xad\xe5ƃq\xa3\xe0\xb3c*ڀ<\xf3\x80>=9~r\xeam*j\r\xaf\xe2<a\v\xa5ʦ\xc7\x03J\x1eTu\xb1B#@|\x1d\xf3-х\x9d\xbf*\xe9\xaf\\\xac\xc3\xd6\n\xde\xd0F)0G\x01\x83\x89B\x14Ae-\xa2\xd8\xcdl\xc0wW\xdf @H\xe0\x95\xad\xef\xb4\xd6\xf6\xb5T\xee\x85\rѨU*PZ\xf7\x95)\\]\x1cr]\xdd\x1dp\xfe\xfd\xbf,z\xa9\xc0/\xb3\xab+\x98\x98h\xddM\x84\xe0\xa0\xf8\x1c\x143Sծ\xe7\xd9{\xad\xe6\xbfoȲ\x02d\xc6\xf0\xb7\xcd<\x8a\xbdC\x98\x00\x1b\x99L4\x18~a@\xb3\xd1@Yo\xf9\x0f3W\x96 lV\x86l<\x96߮ؕުXm\xd0e>\xc0,\xcb\xe9L淖\n\x86\x06\xb0\xf8\xf5\xc1\xa7\xea\x9ajp\xd3\x06\x11\xe7\x15\xaaG\xc1\xd2\xec\xf8\x12\xf9q\t\xcbA\x1c\xc1r0\x85\xab\bʑHJ0)Ť\x9b\rL,$\xaf\xe90;{ςr\x18Fp\xf3\xde\xc9\xff\xba\xa8\x83r\x88\xb80w\xdbZ\xdaL}U,\xa7Y\u0382r\x14\x01\x96\xed\u07fc\x0fC\xf8\xfdw\x18\xea\x19\xbf\x1c^\x0e\xaf\xe0\x00\xca\xd1\xe5\xe8\n\x9e\xe3_\xfe=\xbc\x1c]\x19r\xc1\xc1p\x93y\xa1\x93FN\xd2\xcd\xc6\xf9~o~sN\xe3\x0es\xc0\x0fy`\x02\xc1\r\xee\x05\xf8\xeaP\xc7\xf98 \xac\x11\xaf\x0f\xb5\x0e#\xabO\xb4E\x9c\xd4\x10\xa7\x1c\x8bA\xec\xbad\xd2JG\x95Pւ׃\xdcg\xc8\xfd\x19\\\x19 \xa4\x86R\xf6͆\xd8jeް;\x98`\xaaE\xe1\xcd{\x84l\xa9\x1e\x84Wi\xab\xb0`?\xc6\x11L\x9d\xc9>\xe6\\\x9f\xe2\x9fC\x88\x89\xb30\xbd\x1c]u\"\xd3\xdd\x1e{\xd5rR <\xb5\xc7a\xa8%\xe0\xc6X\xe0\xb1\xfa\xa3\x89֟\x89\x10\x9d\xf0a\xa7\xb9\xa3!{\xd8\xd6\bT\x1dT\xc3\xe1\x04nDc/\x1e\xa9jT\xea\xe8ʶj\xfa\xea!\xbf\x97U\x81\xbd˷\xba|\xafl35'\xdb\xc3\x04z\x8a*\xb4\xee\xa5\xc7\x03\xa3\xf4\xcf2\vΝ\x1c_\xb5\xeb\xdc\x00x\xcd\xedffoNa\x02S\xdc\xe1\xc60\x81\xc38\xa4\xad\xc0\x11\x04\x98\x16\x87R8\x9a\xd3\xec\xa61\x03\a\x1b^\xe4\x00\xa6\x1d\xfa\x83E\xcf+ڻ|\x1erH\x9c\x96\xf1&\x90\x9b\xbc%\xaar\x91If\xe7\x86\xe0\x15\x9e\xa6\xfal`\xe5\xf54(#\xb8n\x0e\t\xb1l\xfed\x00\xf80\xff\xd4D\xc0\xa7\x18\x98@i\x8c\xe6k\x98\xc0\xb5\xf1M\xbc\xf1!,\xaf\xa7o\xe7lӰ\x17ߢ>v\x06/\xa17\xec\xc1\xa1ő\xdbpP\x17o\xf8\xce{t\xa6\xa6-\xe4\xd1\xf8\xf4\xb4\x91\xdfV\xeb*\xa6\xbd \xed\xb8#(\xaf\xa7\x11̫\x85\xb5\xb8p\xcd\xe1\x9a\xff\x99\xf2?\xcbQ\x04\xcbqD\xe2\xce\x1b\xb8\xc4\x05\xe7(\xb8\x8c\xfb\xef\xaf\x0e\xc3_\x82`p\x10\xfe\x12\x1ee\x03\xb6a\x89\xa8\xc0\x98\x01\x96\xc6\"\xbf\x1c\xc3\x04\x96\xa3\xcb\xf1Հ\f\xb4d\x1cP:\xff]F\a&ˑ\xb58@\x12W\fz\xf3j\xd1;\x97I:Ssp^-\x02j㷋\"\xae\x83\xe5\x18\xe7\xf8\b\xec$D|\x84\xee\x81n\xc6Xf\x84\xcd\xc5[PP^O\xbb)@i3\x99-\x86\xb0\xa4ğ5j\xcf\x1a_\x85\x1ej>\x18\x93%\xf2\x97\xcfC\x12\x05~Ud\xbd\x14\x1d\x11\x9a\xd4\xe5d\xfe\xe3\x9d9\xb8\x16\x7f\xa7Fg\xf1B\xa6m\x9e\xa7\f\x92y\\~Y\aÐ\x1fh\xfc\xa1\xa7;H\x97\x93\xfb:\x049\xb1\xac'0q\x10\x8d\x8c\x96\x95p\xa8F\x13\xfew݀\x1e\x87f\xee\xa1\x1akr\x1a\xb2\xa1\x8fC3\xf7p\"'9\xb5\x8f\xf4S\xfc\xa4\x85bm\xf7\x1aEp\x1cz\xe9\xd40\xc7\x11\x9c\x86^\xea4\xcci\x04O\x1a\x96W\xac\x91\x04\u0efc\xc69G\feY\x91ʺ\xb6\xb2\xa6f\xd6\xd4ȲV\xd3r\xc74G\xb3R\xb5p\xa7:\x9c\x14\x96Y\x0e\x13=㔸`Ӵs\xad~Mů0\x82e\xbcQ\xd0\xf1F\xe1\x8b Ł\x1f\xe3|\xbe\xcc\xf2\b\xe6t\x15i\x01\x13\b0\xf5\x10Sq\x00\x8e\xb5(\x1a\xe672\x8cK\xa3Q\nGV\xa1s\x9e\x82\xa6#UAh\x1a\xe0K\x98P\xdd!̱\xbek\xe8c\v\x8f \x85C\xfcz\x0eSx\tg\xb8^\x85\x17Z>\xae\xedR\xb8\xa8\x95\xb2\xd4X\xc0QN\t}\xb8\x969'\xb2\xe29\xea\xa6gC\xcb|a6\a\x8b\x0e==\x95\x1eSG\b\xfe\xb4v\xd6\"n.lz\x12\xd8l\xdf\a\xa5\xe0\xc1\xb5\x9d|-\x92\xa7v\xb2\x9c\x03\xb0\xc7\xc5\xe6a\xb3}O\xd5\x04\x83\x93\xd1\xf8\xe4\xf4\xec\x04\x0ep\xa4\xc2\xe0\xf8\xf4\xc9铳\x11\x1c\xe0P\x84\xc1\xe8\xe9\x10w\xe5\xb4H#\x17\x8e\xb1\xd4ۿ\x86\x11l]D\xe3\xd1\xf8\xec\xc9\xf8\x99D\xf4dt::\x1d\x8f%\xa2\xe1\x93\xf1\xa8\x81\xe7oa\x04\xef]<\xc3ѳ\xe3\xe3c\x85g4z6z\xa6\xd0<;\x1d\x1e\x0fOF\x0e\x9e\xff\xb0\x8dB<5\x18\x8d\xce\xe0\x00\xb6hI;\x8b\xe0tH\x865\x94\xa1m\x18\xc1\x98\x7fb\xee\xfb\xb0\xb5'8\xaf\xed\xc5\\\x8d\x92\x10\xed\xb7\x83\xe1\xc9\xf0\x04%\xb7\xc4\x15f<x6\x86smr\n\xa8\tC\x84=\x82\x11\xfe\x88`<8\xe9^\xc6\xc5\x1a\x91\x98\x03u\x06\x13sMK\xec\x06'rrL䔇\xd6C>\x99\xffkO\xeeA\xc8c.\x98\xa1\xa1k\x80\x14\x9d\xfb\xcd\xf6\xa6\x84zժ?\xed\x10\xe1?5\x11\x90ڄ\xa3bnn\x92\xb4\xb9\x84\xbe頮\x85\"R\xf6\x1c\xa4\n\xe46\x98\xdb\v\xd6\x1c^\xc0\xf1\xd9\x10G7\x9ee\x9c\r\x8d\xa1\x8f\x86I\xca9\xe49V\xb1瀥Ds\x97hA\f\x964\xf7\x90Mn\x0eG\xd0,1zj\x14\x19\xbb\xb9\xe3\x93\x0e\x84\xc1\xf8d\b}\x98\x87\x16b\x05m\xce \xba\xb1VkM\x1d\x9b\xf7/e\x1f\x90|\xbaG\xad\\\xdb\xe3\xa9\xd4\x19\xf0\xaf\x9a\x05\xad\xbc\xa1n\x82\xe7ꤢ\x82\x17\xea\xa0Bt\xdfBL\xe1\x12da\x80,.\x1e)\xe5pA#\x06G\xcb\x02[\x8f\xec\xa8B\x82?\x84\n\xfa\x94Z\x19z(\x0e\xfb\x05\xf4\x15\xd1z|\xa38 '\xd0\\:F\xab\a\xb1\x85\xffA\xe1\x1f\x0f\xfd\x83z\x9e\x90l&\xed\xc2m\x00x\x85\xbb\x89\xc0+\xdc\tL \xd9K\xb8\x93\x05\xcdV~\xaa\xc4T\xb6\x88\xf4I\x03->\xdaLM\xe2w\x80e\x95E\x9b\x04 \xf1\x92/\x90ŭ{\xa6\xef\r\x00\x1f\x82\xef\x9b\b\xec\xf6\x89\xef\x18&\x10\x1b߭{&\x9c\xbf\xcb\xeb\x06R\x94W\\b\x02\x94\r\xdc\x18\x1d\xc1\b\xa7q\\\xbf\xb6d\xd9?\xc2)\x9d\xaf\x1f8\x8bOQ\xc1\x18\n\x99\x15\xab\xdc\"\xe6S\xf8F\x98\\h\xed\xe2\x10[\x1bbk@\xfc\x8dC\xbc\xb7!\xde\x1b\x10\xff\xe1\x13G\xb1\x88\xe1\xcf\xe3\xc1\x18W\x85\x13\x94_\\pF\x83\xd3\xe3'\xa3㧧b=\x1a\x9c<{zz<\xc2E\xf7=\xdf Ȓ\xfd\xc1\xb3\xb3g\xe3\xb33*x\b\xa3\xc1\xd3'g\xc3\xd1\xf0)\x15ĥ\xe4dtzz\xd6,\x87K\xcb\xd9\xc9\xf1\x89\xa8p0\x1e\x9e\fǧ\xcfD1\\y\x9e\x8cǧc*\xd8&\x1848\xfc\xc2!F\x8eaʚ\xa2)H\x1e\x99\xff\xf8\x1d\x1c\xa0 F\x86\xed(\x86\x03\x88\xe1\x10\xa6\xb4V\xb7\r'\xa3\x87\x9c3\xf4\x17؈\xb3\xa7ώ\x87\xc7'\xf0\x126p \xfe\xff\x9c/\xe2'\xd8\xdfϐ\x84'\x83'O\x9f\f\x8f\x9f\xf8\xf0K\xb5\u008b\x7f8|\xfa\xf4\xf4\f^\xea\xf5z\x13\x91\x95\xe38\x84s\x85Vt\x05\xaf\xaf\xad\x0e\xd2\xc1}\xa7z|R\x1e\x9f\xd2\xe1G\xc95\x06Ta\xe0\xa5\xd0\x16\x0e\xa0\x84s\xae\x1b\x98\x87Ue$NpNB\xe8s\x15\xc2\xcb\xc0\x8a-\xb8?{@^\x05\x95>\x1f\x11\xae{\xeb)\xf7\xbf\xe4ّU\xe6G\xe9\xb1g\xab\x13\x1c\xb4\xb36\xf1\xab(\x03\xf9c\xb7\x8b\x9a\x96%^F\x15\x8dh\x8a\xe8\xb2\x015\xeb\xfdr\xb1\xf8\x88\xaa\xcd\xd2\xf7\xa9=\xae\xebRx\xc5X\xee\x1b\n\x1a\x01^\xaf\x17\v\xa3nnR\"\xaf\x9c/\xeb\xba̦\xeb\xdar\xcf\xf9\xe0\xc7\xf0\xfa\xcd\x0e\x1c\xaf\xdf\xf0\x1d?\xdd$\x13\xbb}\xbcm\xb7h\xc7\xfb\xaa\xc8+\xbc\x90\xecb\xaeXm\x93&\x9b\xb7\x13\xd3\xeb7]\xb8ZI܉\xff[\xbf\x87\xe3F\xf9\xb8t\xfb\x9c\xa12\xb3\x91\xbe\x80aW\x17pŰ\x8d\v\x9b\xdd\x14\xbe~\xf3\xf9h\xec\xe8\xe2\x16\xba;8n\xb7E\x19\x96\x06y5\xf8u\x1d/\xb2\xd9֔Ji\xb9\xe4.\xa5\x9cHxi \x84\x97\x86\xa8¹\xfa\x80s\xe5\x1e+\xca:.\xb2\r\x14\x9a\x93pn%\xc0y\x13X\v\x1e\x9c[\t\xdd\x03\x97\xe6@\x96\xfe\xe47\xf6\xb3;\xf8\x89]\x7f\xb3Y\x05\xbd\xe0\xe5\xf9\x7f\xfe\xfe\xcb/\xd5a\x88\x96\xda\xf4xP2\nI!\xca\x1d\x02B`\xfe\xef\xff-\xecEл\xee\x85{\xd5\xdc9k\b\x18\xcf\xf0\xd4.\xe0m\xfe\x98\x88\xf72\xbb\x12b\xd61\xacD%-#Kz\x81\xee+\xbd\xdddxe\r\xffHO[n &?\xdbp\xb0\x8cW\x81\x8fg\xaf\xb58\"\x89\xb9\xc4a\x9ew;\x1e\xd9~\x91s\x9a\x0e\xe7.\xc7\xf7\xea\xc2\xc6Y\x11\x12U\n\xf3l\xab\xa8\xf9\x8fIr\f\xe8cK\x03\x9f\x11\x12l$F\xcf 4\xdfgU\x1d\xdac\xf1%$\x838M\x05!\xe7\x90x=>\x914\x1b\xd5k\x92\xbfd\n\x13H\x06x=\xf3/\xf1B\x9a\x82#Hn1}\n/\x8d\xccs\xb9\x81\xe1\x949\xc4\x02\x94\x86\xa3\xa26x)W\x98\x92\rjV\xd5Ar\x1b\x9a\xa5\x80\xd7e\xfac'\xb78\xae\x00\a\x9c\xd5\bœih\x905\x81\xe4VL\x7fv\xebxNӤ\xae\xf7\xfeɭI\x89\x87\x0e\xe5\x01^2\xee\xfd\x1d^<\xfaXJ>\xec\xa5\xd6\xe0\x15Ts\x8e\x88`UfE\x99\xd5\xdb\xc6lA\xb0>%\x832\x848\xa8{1\xed\x9a\x06\x81\xb7\xaa\x04\x84\xabb\xb5\x85\xa8I\\;\xe6ϰ\x84w4\xd0\\\x0f[Hߴ\x90ݲ\xd4)6\xef\\Ѭ\xe6¹\xcd\xd8\xee~_y\xb8\xdb\xe8p\t\xe4\xf4y\xca\x16\xac\xe6m\xbe\xc4\xe2W\u07be\x90\x85[:\x9a\x97\x94}҉\xe1\xd3vh\x93z݅\x8a\xa8\xcd\x1e\xfddrggW\xb9m\x81\xf3\x06\x83\xba;,\x8d\xeb\xf8u\x91\xb2\x00\x7f8z\x04\xff\x00xKPoߞ\x03\xfe\xddg\xf8ϲ\x05^gy\xf8\x86\xe6\at5b\x95`\xbe³ߖ\xaa(\xebW\xc5r\x15\x971n\xe8\x12\xf5\xd3\xf2lt\xdd\xcdBЀ\\\x91\x8c\xab\x84\xe5x\xd7\u07bf\xe4\x99f\x05\x95\xabq\x041\x1eH\xc6\x03ɼ\b\xa6\x980U\t\xfb5\x06=\xd4\xe8\xfe\x9f\xbc\xa7\xc0\x83ҭ\xcb\xe6\xb0*\xf2\x9f\xf8\xcai\xcb3\x06\xe4X1l\x93;\xb28'D\xbe.dm\xcfȋ\xed{Q\xb7 E\x94P?\x06\xff\xcdXVZF\xb1}\x14l\xd0\xfce\x9a\x1a\x04\xab\f\x81:\xb0VhlN\xe1u\xa93=\xdatj\\^W\xe8e#\xf4\x06\xc9\xf9\x8bV/>\xc9d1\xf4\xb9V\x83X\xee\xe3RW\xb4\x1d\x83\v\xb5\xafH\x99\xe8\f\x8e\xdb\xf4}\x94Ee_\xf2K\x87Ɔ^\x9a#\xd3\xd4\xd73\xd6d\xd3\xec&\x98(\xe1Qz\xaf\xc8{k\xdc\xdbq\xed\xecB\xdf\xed\xbd}\x8b\x93\xce!\xf0\xba2l\x04ގ̌\x9b\x1d\xda\xf6./e\xf0\xbb\xa3\x04\xa8ϔ\U0006242dIʺ\xe1%\x97\t8W<\xe8\x1e \x18\xacA\x19\x83\x90[\xe8ŬƆ\xbc\x80\xfbN\xf8\x87\xc0DX\x83\xa4\xd2\r\xef\xe09,/\xe0\xdd\xe1aؼ\xb7K\xb0\xaa\xcc\xe5\xbb+\xcbc\x95R\xd5\xed\x14\xec\xd7\v\xa0\xed\xc3\x05d&:\xce\x10\xd1\xefT\b\xef\xab*b\x85\x98e\x11\xbc\v}\x83\xe5\x1eV,\x96\xebi7+\xf2V㙂\x88<\xe5[\xach\n\xaa\xd5\xd9-3\xecv\x11di\x04u\xb6d\xadD\x18\x16<]ܭ\x1c{\xa1\xbec,\xaf\xac\xabs\x110\xed^\xab.\x16\xf6(\xbc\bncY\x9e\xe2e!\x16W\f&v\r\xdfĕ\x98\"\x84$d)L K\xad4$\x1c&D\xbf\x9d\x8e\xa44o\xeaQ\xb2\xbd\xf1\xe9\xbc{\xc7[\xa4\xef\xed\x99\xf3\xb1\xa8B)\x8a\x1c\xd4\xda\x14\t͂\xe7\xe8\x8bm\x9c\n\xe7\xd8M\x8b\x8d\xf2\xe8\x15m\x11\xccQM\xf1\xec\xde<\xab\xa4@\xcb\x14\x17Ar\xb9[S\xe1\xc9\xe4Y\x88\xf0b\x86M\x8f;\xaeXv\x91N\xee\xe4\x8at{\x89\xbcGGX\x92As\t\x9fq\xa5l\x1a\x92\xa8oj\xba\xd5\xed&;=&\x89*uX\x01\xc6c\xad\xb5k@\x8d\xb9\xcd\xd9k\xd3t\xa1J[\x9b\x95\xb8\xac\x9b\xf8%;0\n\xcc N(\xa4\xc8\v\xc8R\xc5\n\xbcx\x10X{U\x13T\x8f\x10\xfe\x9f\x10\xbeYQ~\x83D*\xe2(LAckm\xed\xb9\x95~M\x9c歡\xebtn\tQ\vK\x8d\x8b}\x16\x81za\xb5\x83\x1a\xe8\xce\"f4*r7\xe4\x8f\xeb,\xb9Q,\vuwaz\x84\xd3=~Z\xc5䥜\xe6Zot\x85\x85\xb6\xb3'\x1eO&\xdd}A\xf3 L@\xa2\x83>\xa4l\x81\x91\v\x8e ]\xf3\xa07\x110\x98\xd0h\f\xea\x90/Q\x92\x81\xa6\x89\xc92x\xe5|\x99\xb6\x19/J]\xf6\xfb\xf9\x95\xc9<\x9b\x05\x1f\x9c\xf6\xf0+\x7f.\xaefS\xc0\x1euߥ\rᒽ\xc7\xf2\xb4\xb3\xef|\x98\x866@\xb3\x9fL\xc2?\xf8\x86O\xb1\n\x9a\x9d\xf5\xb8ߧ\xfe\xa2`Jj\xb7'\xd4Jc\ny\xbb\xaf\x90\xa8\x85\x8d\xa5\xe2\xa68\xf5\xa7\xd4U\xe9#R]\xab\x92UW#5(\x0fB\xad\xa0\\\xfc't\xe8\xc1K\x0e\x81\x87N\x114K\xfe\xc7\xe5\x10cM\x19\x89\xd4\xd8s\x18j\xf2\x85s\\\x8b:}x\xa8yd\xed\b\xe2-\x9e\x14J\xd1}\xe9\xceQ\xe7z\xc4Q\x8e\xe0\x853\xec\xe4\xf8\xfe\xe0\x8eȝ\n\x923\xc5\a\xae\x9aH\x06\xe3\xd4U\x14\xbeK\xdb\x15\b\xdd[V\xd6\u05cd^\xb3\xb3E\xba\\\x14\\\xd9%\xd5>K=\xd9߈%\x16\x01h\x80\xabka\xeer\xc1\xf7\x15\x9d\x8bE\x93dK\xea.\xbc`-\x82(\x81%K\xf5x5\x852\xb2%\xc6Rv?\x84m\xec\xe8\xe2\x84\xd6?\xbc\xad1\x1a\xd2\xd6\f\xbb\x05\xbb\xa2\xac\xe0H%{UJĻ\xa6\x92\x18MϽ\x1e\xee\xf0%t\xfb慲\xc5]\x8d)?\xd4j\xf8'pM\x13\xb3ۯxxOjh\x18\xbd\xadj\xb6\xb2\xaf\xfe\xf1\xb1\x86\x1dq\a\x13\xf8:\xae\xb1g\xee\x820\x82z\x04\x13]\xf2\xd75\x93v3\xb1D\xd4Ɯ.\xd0P\xdf\xdeA\x1f\xeaѠ\x9e3+\x84\x84\x04y1\xc1\\\xb1Hգ\xc1l\xb1\xae\xe6@\x89j\xe7#g\x00Y\x9eH\xa9G\x83\x9cmjw\x17j\x0e8\xa2\x94\x10\x06!\xf4\x91\x16\xc3\x15\x97\xe0^\xc0\xf8\xc4\xd6\x03\xb3\xea\xdb,\xcfj\xc6\x01,e#Y\xb0\xb8\xfc9[\xb2b]\a\n\x7f\xcd\x13Lۆ\x93\x05\x13\xa8X\xdd(\x88\xcc\x17\x13D\xc3qZ\x01Q\x9f\xde\xc6\v\xbdd9\x8e\xb7>\xc8х\x9bI\x11e\xed\xaa\xc3\xf6\xf0L6\xe3\xb4t\xd4C\x90''\x1ei\xa0\a\x0fr\x98\xc0w\xf9\fY\xb8m\x15\x0fR\x03DW\xebTe\x14Rh\xa7\xdb\xef\xd2KC\x10\x06Yz\xa5\xd9̥\x00\x9d\xef\xea!\x89\x82\x16\n8\xd7H\x886W^\x1al\x04I\xbc\xf2\n\xc7\xefHJ.\x1cj)u(\b\x88+\xf5(\xb4\xd17w\xe6r\x04\xb8\xdc^\x16\xeb\x8a\xfdXdy\x1d`P\xff8#\xf3\x9du\xdcV\xdd^\xc3\x04T\ue838\xcbY\xf9\xe6/\xff&\xc2\xf8\xe1\xe2\xad2\xb5\x88W\xb7׃\xa4dq\xcd\xde\xfc\xe5ߨ\x02\xdb\xe4G\xc1\x90a\x02M\xb8\xc0\xdajJ\x1a\xdfN\xd7\xd7''ç\xc7\xe4\xf3\xf8\xc5\x17\x10\xdceyZ\xdc\r\xaa\xa4,\x16\x8b\xbf\"\x19V\xca߬\xe1\xc3\x1b\x91\x1e\x0f\x84\x1f\x8c\x8aG\x88\xf1zC\xdc\xe9\xb1<\rz\xd5\xedu/\xe4\xa7\x19AO\xc67\xc4\xcdz<\xad\x8aźf:\xb7.V\xbd\b\x86\xea{\xc1f5%\\X\xfaSR/y+/\x87W\x97\xc3+\xdcO\xbfIJ\xc6\xf2W?\xff\x10\xd8\xe3\xd6i\xe7\x04\x1e\aI\x8dw \x91\xc3\xf5r`\xaa\xb6\xc86\xb1\xdf\xf6\x86\x9ci\xa03\x99A\xbc\x1fl\xe865FG\xfd녓\xb5UY\x7fk\x15Y\x13I\xb2\xc8X^\xb7\xa0\xe1\x99\x7fs\xa9\x94\x02\xc0A\x97q]f\x1bu\xbdO\v\xa3ðA\x96\x8b`(\xee\x96\xf6RR\x14\xa9گ\xdc\xf9\xb9dIm\xc9\xf25\xab\xbfBo\xb1,\xbf~Ed\xfe\x84\xc2a\xebl\x97\xba\x81\xd0'\x14\x03\xeck\xe8\x1bxx>FŌt\x8b%t]\xac<\xc0\x18s\xd3{_4/\no|6\xba\xfd\xfa\rF\xfb\xad\x03\xf1R\x8e9JQ)E\x11\xa7\x1c\xbay\x8b\xdb\x04\x9d\xc2\xff\x18\xce\xea\xf6\xbdM^\xfe9/\xf4\x12.Ah\xb9\xf4}\x05\xe7\x94R\xac\"\x01襜(\xfc\t\x03u\x05\xf4Ӎ\xf9\x85i\x03\n\xe4ś\x01/\x9bi\x01W\xb5\xad\xd6\x1a@A\x8bK\x1eB\xbc\xcd1X\x13og\x04\xf8a)\xcfCn\x15\xcdF\x8a'\x063\"\xd8\f5\xaf2d\xdffd$\xe0-\xe7t\xa3\xa7\xb7\xcd\b\x9e\xc3\xc6ؕ\xa68\x122ďx\xb2\x91\xachsa\x02l\x86\xa2\xa2\xcdHV`\x9d\xb7!j$\x1c&D?V\xd3\xc7j\x8cz\x14\x85\x02F\x04Z\xda\xe8\x89G\xd3,A\x12\x96-\x82\xcd\xc8w\x04ˁ\xbby\xaa\x0294\x9d-\xdb\v\xf2\xc7\x19TwP\xefE`\xa8\x89\x11P\xf8\x9e\xc6\x19Q\xc9\b\x81sD\xc4љk\xa4Շ\xa2\x02\xf1\x15\xa2n\x05/51\x18\xa9F`\xd0\xd2\xf5v\x9a\xf1\xb4\b\xac\x9b\xb90\xe1\x94q\x04\xcdK\xbbp\xdeH\xe7\xf7G\xd4\xc9\b\xbd\x84D\xa7\x15>\x1eX%-\x96\xe8\x95/\xb7\x10\x88\x82\x12\x8f\x83\xc0\xbe\b\xed\u038b\xd4T\xbfˀ\x1dEO\x95\xe0\xe4\a\x9b\xc6e\x06\x9e\x11q\xeax&\x1f\x9a4)צ\xb5s\xdb\xc0J\x85\x82mh\x19\x1dyq\xde.\xb3\xf8f_\x1b\xaf\x16_-\xf98\xbc\xc8\xc1\x89\xf7\x8a\xcb\x10%_\x1eJ\x88\xd1\x0f\"\x84J\xaa\x9a\x04\x9a\xcd}\xab\xa6\x80\xd5-\xf57g\xd1`\x13\x9a=\x1f\u0602@\xb8|5q\xe1~H#\xa9\xa4\xdaN\v4\xf7i\xa4=\xca\xeeO\x80s\xe1\x9f\x0f\x15\x13\xe5}\x88A\xd3ge\x92\xb1\xf4\x9a\xba\x8d\xd9\xecg,\xa1\x86\xf3\xb2\r\xe9\xb7\xfc\xd2\xef}1\xf3b\xdd\xe8\xc5\xe2\xe0qO\xf0\xaf\x82N5\xafqQ\xbc\a\x8b\x92b\xb5\xf5W\xe7o\xc6\x1eӽ/p\x8bEB\xd7r\xf2\x13\x8f\xd8JI\x91\x98\x1d\x9bv\x89\xd2\x03\x15A\x8fh\xea\xc9\x1f4<\xf0ˠ\x11?\x89\xca\xdeNJ^\x13\x97\x95\xe0\xa6\x1bop=~\x15A\x05AL\x9bQ\x10G\xce=\xca\r\xeem\xd4!/.\xed\xe7\xdeqb_y\x10:\x00\x1cA\xca/\xdel\x1a1\xf5P\a\xd8\a\x15\xd7\x15|\x98:|A\x1cI\xe6:\xa0\x16d\xd3\xc8\xc35\xbfI\x8bF\xcb\xdfy\x84\x89\x80\xc3H#}\xf9\x9b\xeb\xb4l\xe5\xe5\xb4\x13n\x92\xb0\x1c\xc1\xd2\xe6w\x18\x01+K\x98\xc0\x12\x8exE\a\x84Q\xebu\xac\xe4\x17FF\xa7!\xe5\x88\xc0\xb1ڟQ\x02\x1c\x1b\x00\xa7\x9e\xfc'F\xbe\xb8J\xa7\x9a\x01\x13\x83\xd5:\xf5\x88\n\x84\x16M\x9a\r\x13\xb3\x9d:\xd9.\x04\x87\xa2R\x18\x9cZ\bƈ@\xa3\x15\x1d\xce3\xf7\xe9Ѫٛ\xc6x#\xd5K\x9f\x96\xee!\x11\xe1>\x956&\xc4F\xcd<\x12@Ћ\x06n\x90\x8f\xbeO*\xf6 L\x04\xaeP2C7\xc0F!\xb9\xa1Ϻ\xa6\x05\xa9L\xdeC\xdb3\x86\xc5\x1a&6d`l\xe4\xc4O\x8al\x91\xc1\xc4\xc4\xc1UC\x82\xe2\xbfFW\xe1\xbeQb\xb2`\x1dl:\xe3\xc0\xb8\xca\xf3\xc3\xdbv)\xdcR\xf0\xafpt\xb9\xd9[\x99\xefK\xa3\"Y683n0\xfc\x95⑻?\xd2[<\x1e\xc17\xd4AT]\x1d\x8dW\xd5\x02\xf6\xc1\x0e\xf0\xf6\x0e\x87\xb6\x11\x81i\xcdO}\xbd\x1d\xf7\x8e\xf6ت\xf3\xde\x19\xb1G2^\xacٍ\xb2\x8c\xf8\xba\n-2:zT\xfa\x0f\xa5ǃiVq\x03\x17\xef)\xbc^G\x11\x9d\x14\x13u\xff_\xbe\xbb\n\xd6\xf8\xcf>R\x80\xe3G.\xa5\x8b\xe2\xba\xe9\"ݲ\x9b\x10\x92\xc3c\x007\xb6\x14+2\xf5/\x8ak\x9cͻ\xb7\x14MԸ\x00p\xf4\x02\xdci\xc8'\xd8_\b\xf4\x1cC\xc0\xefM\xac\x8a;Օ\x8b\xe2\x1a&\xb0\xc1\xe9\x9b\xdf\xc56\xf9\x95\x9b\x9b\xcdEq\xadTgO\xab\xc1\xa9\x8a\xef`\x90\xd1]\x1b\xba\xfdTC\x1b\xb3\xad(:\r\x8c\xbc;\xff\xbdih\xa8Զ\x88\xb6-\xfe\x0e\x11x\xe0#\x10]Z~\xa0\xbc<z1\x96[uX\x12\xba\ue5d9w\xad\xa4\x90A\xef|K/ͫk2E\xdeYзv\x9a\x9aZ\xb5w\xc459\x11Y}nR\x03\xbc\x1d\xe2-\x88\xe2.\xc8B\x03\x85p\xe2#O<x\x0e\xef. 4\x9e\xe3\x80\t<\xbb\x80\x1b\xf4}\xb8\x80\x9b~?l\xe2\x82\x03\xb8\xb1\xbc>\x1d\x13\xad\xae\x80\xa3'\x8f?\xab\x86\x11\xd6\xf0\x9c4\x9c\x9b\xc3\xc3\xddUt7\xc8pU\xc0J\xc8\r\U000425f8\xccp\x80\xac\xa5ס\x03\xf9\x0e&\x02\xb1ty\xe4\x85\xf8|\b/\xe0\xf6\x02\xde\xf5\xfbVA)!\xbc\x9c\b\xd4\xde\xf4O\xd4' \b\xb7\xf7\x86-\x8fD\x94\xa1}\x1c\xb5f\xb2\xb8)\b\x1c\xe7\x8eP\xf7#5\xd1\xcc,p+. j4\x03z-\xe0\xc8\x1cfA('+\x1e\xab\xdc+\x8d\xf0\x12\x02F\xf7\xccX\x7f46ue\n`\x8eYf\x0e\x0e\vT\x92/Z\x02\x8c\xb6\x05\xfcFQ\x98\xd1\x1c\x9f\xa2\xa6\x84c\x12WJx)\xda\x15\x90\a\x05\x8f\xd0\x0e\x8d\xf0\x9d\xf7\xdek\xaa\xa5\x88\nᬅ\x13\xa57$\xe8\x1e\xfb\xc7\xce5oո\xc7nľ\xd7\xe17\xec\x8d]'Ƽ\x81\xb1o\xa0|\xa1P\xf6\xf7ũ\x97\xc1\b\xd8fU\xe4L\x1f\xb6\xed\xbb<\xaf\x8a\xbbU\xcb\xfa\xbc2\x05{U\xdc\xfdH3\xa2\xa8\x06\x0f[\xee\xa6\x1e\x88\x11\x1cib\x1e\xb0\xb0O\xffA+\xfb4\xecZ\x8f\x91\x1d\x0f_\f\xf7\xb4/\x99M\v\xc2\x16C\xd0\xc7ۙ\xf6\xaa\xa6]\xa9\xb0̑^\xd5\u00ad\xc0g\x8b\xf2\xd5)\xc5\xe6A\xdd*\v\x9b3\xa9\x92\x12\x9b\"C\x1b\xeb\x92q\x98\xc0Ƅ\xddS\xda[8D\x7f>\xce\xd4f\xa8\xbdr\xfas\xea\xfe\x94s\xa0\xe4F\xdb\x05\xa9\xe6\xe8\x95\xf3b_Yi\xfa\x1b\xf2)8\xb7\xa2~\xb0\xdd{\x8e\xa2L\xf1\x1e\x8b\xbd\xed,\xf7\x9e\xdb\xf86*\b\xe8\xf6\ay\xb2o(\xa02\xffF\xa7\xf4\x8dܟq\x8df\x13\x86\xdc&\a\xffjmA\xafZ\xae\\\xb2U\x15\xa8sR\xb6\xf2t\x990\xd4[\xdb[>\xd7(\x06f\x9e5\x95\x90jcN\xd6\xf0/\xd4ǚ\xd8\x16\xb5!\xa7?_\xc5y\xfay\x8fW\f\xad\\\xdcDv_\fj\xbe\xa4\xb6Q\x9b\xfbM\xd6\xf5\xd0\xd1c\xde;\xf3\xb8\n6\x19\xed\xad2\f\x1fm\xf4Y\xe6tZ柑/\xb9\xb4\fj\x19\x82\\H\xbcH\x8e\xff\xae\xe7?\xb2_L\xef_N\x88\xe5\xe4Z\x9f+3\xb5J\x8b\xcf\xf5\xf5\x03[}ڹ\x02\x11*r\xaa\xb1֡M$\xdf\xcf\xdeG\xbb\x15\xa0&\xe5\xa6\xc3\xc1\xc6\xf45\xd8P8pa\xa4\r*\xee\xf9@\x90\xa8\xcb\x04J\x9d\x1dE\xcd#xT\x1c%Y.#\xf9P\xb3\x8b<\xa7#\xde@\x0f\x95b\x85\x95\x8c\xe96\xb05|d\v\x8e`,\x06\xea\x83\xfb\x853\xf3\x13\xf6\x0eV\xde\xd29\x11\x14k\xbc\xeb\xf4Q]\xd5\x02|\x1cZ\xc8)\xd4a\xaa/\x92Jw\x192\x86\x89~\x85窯e\xcfK\x80>\xd82@\x9e/\x94\xd5%\r\xae\x04H\xea\x0f)\b\x9d\xd5v\xbf<8\xddl\x96p\xfa\x19\xb9 (\n\xc5\xcc\xee7\b\nY\x10\x18\x83\x91&+\xdcG<\xa87?\xa1t\xd0!\xd5\xffcD\xc40\xda|\x94\xb4\xf0c\x1e\xba#m\xa2\x81~+\x16\xfbХMΌ#=\x8e\x1f_\x1d\xfaHI3p\xfa\x84n/\xa9\xd3R\xf2\x89'\xa6N}\xd4\xd15\x9a\x18\xbe\x91־\xdd:-\a\r\xe4Ҍ淏R\x91[\x14G\x9fvܦ\x9e\xfb5\xd2_\xd7q^g\vfc\xdeӇ\xe9F\xdf\xcbu\xce;~U\a\x11\xf6-\xa8z^\xb2j^,R\xdb\x16\xaa\xf4&4\xde\xfd\x1a\x1a`\x977\xdcdFG\x01\rbo\xe0\b~\xfd\b\a!\xee\xa3NO\x8b\xc2\x04\x0e7:\x18\xfa\xeb\xf8\xf5\x85O\xf9\xd6\a\x12\x9aF\x8cU\xd4p\xcd\xd4ٟ\xdb3H\x04~\xe8\xb4i\x89\aTS\xe3bϠ*\xe8y\x0e\x1dsῈc\x91\x94\x82\xaas\xe8\xb8\xfc\x7f\xe0\xc0k\x19\x1f\x0ft\xfa\xe0\xd8\u07b3\x80\xfc&Gm\xa3\xad{\xff\xe7}\f#\xb3\xdc\x06\xf0%\x1c\x1e\xe2\x12}-\xc3п\xdfk\x0ejz\x17\xc8\x1c\xb7\xb8PI\x9fM\xb5\"\xb80͓\xb8\xc6\x10\xa4\xd9b\xa3\x9eJ\xfd\xe8\xc1p\xc9=OG\xa0\xa6\x10\xf2B=ܨG]\x00\xc8\x1d\xf5ps\xb9i\xfa\b\xff\xb3\x8b\xf8}\xe5\xb3!Q\x0f\x94O5hv,\a\xdd\x02\xea9\xb4\xb5%\xb0\xad\xfb\xdf~\xe4\\\xf8\xf6>\xfa\xc0\x83*\xf6\xf6\xec\xbe\xd5\xee߫m\xfdк\u07b7w\xa9~\x05\xddr\xb1W\x80*\xbfٛ\x87\xb6+\xb7\x00\xd4\x06o\x99ra\xe7\xab\xeeP)\x0f\x1fI\x9d\xee\xb0\x12\xbf\xbbn\xd9t}p\xc8\xfb\xc4^\x92\x16\xde\xcf\xe2(\xa9j\xd8_~\xdcN\xf7ɍɥ\x86\xe8\xdc^\xbf\x8d\xcb\xe4\xbb<g\xe5Oq\x9a\xad+\xcf\xdb\xea\x99\xce\xed\xc0\xf1\xe7u-\xa1<8\x8au\xbd\a\x8e7\xb8_\xf92\xbf^0\x0f\x8aJev`\xf8&O\xdbʳ<\xed,\x8d\xdd\x14\xac\xca\xe2\x9d\x1d\xdfE\x81Q\xbe\x19\xc9\xcbȫ\xd8\xf5\xd2~<\x1dd\x9a8s\xee\xfdг\xfc\x8c\x8c\x9a\x02\xba\xf6S\x85\x11\xd4,\xaf\xb0\xea\xc6Q0٭\x04>ጴ*2\xfda\xda+\x91D\xa5\x9f\xa7\x11\xccD\x80s\"\x16\x9d-\xf1\xd8uk\xa7m[cu\xdaqvR6\xcb0䁊\x9cį\xacc\x95\x97\xd9U3\xce\x04'\x92s\xe0\x12\x0eg\x1b\xab$\xc2Gp8\xdb6R\xe1\xaa\xe9\x1e\x80\xf5\v|r\xf20\xabR]pѨ\xdfڂ4\x03Gy\x107\x90\xc99X\xf6)\a\x84\x97:\x85?q\xdd\v\xe1\xdcx\xd4\xfd\x83\xfb\x96\x8a\x14\xb4\xbf\xaaGQd\xca\xdf\"\x10ܕ7\xdb\u05f6g\x9a\r\xfe\xbd875\x00\xfe;\xdb\xda>v\x03\n\x18\"\x84\nз\x93S\x85\xe5\xe9\x02\xdc\xfd\xd7E\xa5\xdcl<\xeb!\xe2\xb5f \xaah\xfb\xa0\x8a\xd4M\xf9\xed\xde\x15i\xf6=@\xd1\xe0e\xd5\x02\xa4P\xedWu\xcb\xf5\x80\xb7\x0f\xb8\x1e\xf0\xdf\xd9֊X\xc4\x03\xff\xbcu\x82\xfe\x84\x9d\x1dOt\x8bQ\xe3\x82\x05\xad\"\xf5\x9d\xca(J\x1e?\xe9-\x9d:5\xa4.\x1c\xdch\";\xf9\xa2e\xef\xfe<\x11ee=\x1a\xd5\xce.q\xd2\xdb&\xfb\xbf6\x17\t\xb1\xabh+\xf17O\x89Qg\x89綾\x02\xcd\xf0vY\x9e(&\x8e\xef{\xe1n<\xaf\x16\x05\x86\x87\xf6bk\xaf\xf4\x10z\xff\xd1\xebB\xfe\xa6f\xab\xafج(\x99\x83Z\x1e\x85\tۏ5IF\xb0RId:]\xc5\xf5\x1cgZX\xd1g/\xeaE\xb0B\xdb\xea\x95\xff\xb5\xe1\x15\x1d\xa9\xd2\xda\xf8\x97^\x04\x81\x81.\xbb\n\xe9<\xa6\xf7'\xc21t\\\x83\xa9\xa4\x9cow5\xec\xcb\x19\x1aM\xfe1\xed\xfa\x93\xa7]\x84\xe3/\x02\xc7\x03\xdb\xf5*\xe6f\xc2?\xaf\x98\xd4\x1e\xb4\xf2\xe0\x152e\x1c?\x81\x97&&GT\xce%\xa5\xf8P\xaa\t\xf7'V.\xb3Zʇ\xf0Z\x1bE\x0ez<\f\x8b|\x84\xfe\x8c\x1b\x83\xbc\xae\x1a\xc4\xee\xd5LK\xec\xf7m\xe8\xf1~\r\x1d\xb6440U\x17\x05\x1b\xca\x16\xefh\xe6\xa5D\xef\xf2g|\x05W\x83\xa4ȓ\xb8V\xed\xb94\x98~\x15ޓ7.W\"H\x88[\x9f\x97;\xb2\xd2O\xd6\xd5.\xe2Z`1\xc3\xca\xca4݊\x11.Ov\xd3\x1eO\xc0\x85\xfb\xe2\x8b\xdd0\x870\xf6\uecbc\xfcqu\xbb_\xd7q\n\x93\x9d\x95\xa8\xa9\xa4\x87\xa3\x7f\xe8\xcc2\xfa\x13g>\x1e\x1eC\x94'\x80\x1a\x13\xf0\xb5\xd2L\xc7*A\xae`\xe5\x9at\xaa\xe1p\x02\xbd\xff\x03\xef\x95\x048eA\x1fj\xf1\xfa\xf6\x98\xbfWs\x88\x93\x18\xcf\x1e\x89\xec\x91'{Ż_~h\x9b\x9aI\xbb\x91\x9a\x81\xba1\xf4\xa1\xb5\xd3^\x98Q\xccj\xb3\x91\x06&\x8d~\x95\x19\xf8\x0f\x0f/\xdcf\xbe\xe2\xed\x18rR\xa9\x9df\xfb\x86|\"\xa3\x06Z\xed\xe6lq\xa0\x057,\xd8V&(wd\xdelrUv\x1aKN\xc3\x11\x11nE\xb1\xf06\xcfᆙ\xa1Z\xfb\xe6\x93Q\xef\xbe\x1dkK\x116k\xd1\xd2\v\x1e\x01;$Z\xda\xe4\xeb\x90hj\xe6.,\xe2\x16\x9a\xba\x0f\ue6b8\xcf<\xd8:협x\x04\x8c\xd84\xc70\xe1g\x96\n\x94\xfc,V8\xc8F\xce\xe8\x1c\xdbÓƠGy\xf0k\x04\xbfY\xe3F\xd9\xd0y%c\xf596\x15\x05\x99\xaaDJ\xec\xa1c8\x80`5\xe6\"@r\x1fF*q$\x12Q\x04\xae|Wp$\xb6.\x8e~\x15WY\xe5(L\x8d-2?f\xdfo\xa2\x94\x83d\xe4\u05f62\x9b\xd5d\xdf_e\xf4{+~#\xcbW\x1b\x98\xc8\xe3\x00\xf1\xff\x81YvD\xba\x15 \xe4\x96 \xb7\xc3H\xfd?\xe1\x00S\x99\xdb\f\x85*\xb7\x1dJE\xce\xe5\xc1W\xec}\x86\xaacLd\xa2\xef\xc26\xdc\xd1\xc1\x99\xaf\vW\x9bA5\xcff\x865a\xb5\x11zE\xa6u[\x80ն\x01\xb7Up\xc6\x1d\x8e\xfd\xe9\x14\xe3\xdbx\x13Ƣ\xdcX\xf2\xfe\xd14\xdeW\x03&\xa4\x86\xfa\xdb%\xa8'\xf7\x11T) \x8eQ\xad!\xb4J \x87\x86\xc8\r\xbd{\x82\xe3=\x04\xc4\xcf\xea\x06c9\xb3\xf4\x0e\xc3h\xd0\xd7E}\x12\xf8\xd9~\x8c\xc4\xea\x89w\xffB[i\x89\xec\xf7\xb3\xff\x8a\x92\xff \xa9\xf2n\xb2\xa5htJ\xc5\x12&\x90\xe3\xf3\x7f\xa6\x80(\xe9\xf0\x89\xc6I\v\a\xe1_!\xff(\xf1\xc0\n\xe1>\xd2!f\xc3\xfb\xc8\x06\\u\t\xc7rϦ\xfd\xd7\x12\x90u\x9e.X\x97\x8e\xd1\x10\v\xfb\x12p\uef07b\xed\x02\x8c5O%\xf1\xf8M:)\xbf\xe2K?\xae_\xe9\xd6J\xa7\xd5\x1fW;)\xa2\xab\bj\x9f]\x7fb\x1b\xf6W\xbe\xa1\xcb\xd5\xd1\f\x8e \xd7I\xa4\xb1Md\xb3\xe1@ꗶ\x16\x85\x1e\tx\xfd\xbc\xa6(\f\xa1Y|\xe4\x16\x1fy\x8boU\xf1mW\xd4\xfe6\xa5\xa5\xab\aI\xaa=\xaf\x96\xc6\\\x83\x9d\xf2\x06\xc5\\c\x9dr\xfa\xe2\xcb1\xff\x1a\xf3\xafc\xfeu|\xb5s.\xb1\xe4o\x13\x81\x8a.d\x18\x89^\xed=\xec0\xf4\xd6\xfd\x06\xea(\x82\xed\xfdJ\x8c\xef]\xc7\xf8\xdeu\x1c\u07fb\x8ec\xac\xa3\xdbԷ(V,ົӵ\xc1jdi\xc6G\x94b(\xd0]x\xf9Uݯ\xb3ٌ\x95,OX\xd5nJ|\xe7\x1b\xfa|U\xa0U\xc0\xdd\xf1\x8f\xec=E\n\x13X\xf2\x01\xd6\xd6.\xcf4k\x84\x1e^\xe2]\xd5\t\x04)\x0e\xa9ԏ\x866 v\xdd\x19\x1c\xc2\xe8\n/\x90\x1c\xd9\xdbw\x81/\xb5l\x94\xcb.f\xfdP\xe4E]\xe4\xccٌu\xec\xc1R\xfe\x840=־\x84\xc9^\x9c\xd7\x13ܻ\xf6ɶ\x8dI~\xbe\xc8\xd9/r\x98b\x9e\xc4\xf0\a\x8d\xa7t\xba\xfd\x1c/\x9f\x9ei\xac\x8aYKQ\xd2tSn\\o\x8e9 \xc6@1B\xb3O\xcd\xe2V\x0e2\xcbz\"پ\xcd]\xc1\vx\xe6\x1c\x86b+\xe1\x00\x8e\xe1\xc8xf\xb9\xb2\xceF\x05\xc5\x15\"v\xd2e\x13*\xbb\xb2\x0f>\xe3A\xcb\xe6bb2\x1dɑLV\x9el\xef\xb0\x1f\x0fa\x14\x8a\x15\xcd\xca\xe7^o\x19\xf4E>\x8d\xda3\xf9\x10<\x91~@\x7f\xf4Y\xb9\xbbS\xae\xd088\x8c$0\xff|\xf8\xb6X\xcav\xe7Y\xceg\xb4\xaf\xb6\x8d\xad\xce\xd9\v=.\xe2\x85C\xb2\x8ab۩̖\x11\xc4\xcdn\xb5t~3\x10\xaa\xa9;\x942\xd1pҋU\x9ay\xb4\x80~#\xb3Y\xc5\xea\v\x13'\x9f\x04K8\xd0\x0f\xd9ǡ\r1\xb2 \xf0!\xfbث\xd8\x11i\xed\xfe\",\xee\xf2\xf8\xa0\xfc\xcf\xe8\xf112\x8c\xfd\x11|oA\xff$|\xef\x1b\x85\x86\xda-\xdf*\xde\xfb\x8f\xde\xfen#C\xebk\xb4\x97\x17\xc9\xd0q#\x19\x92\x1f\x89\x93\xba\xa5T\xf2\xc8\xc4\x02\x13\f\x18\n/}\xaeL\xd0p)\xf8\xc0\xa3\x8e\xa8\nFT\x01\xa2\xdarT\xdb]\xa8\xb6-\xa8\xb6\xa3\x90\xab_\x9f\xd3\xcbe('\x1er1\x9fm\x86\x1e_\x97-em\x87\xdd\x0e/\x12\xe3\xc8p\x9c\x19\xf9=gF\xf7t\x9d\x19\xde\xd7wf\xe88π%3\xfb8\xd5\f?\xa7WͰ\xe1V\xb3\x195\x92\xb6\"\x82\xeev\xf4wr\xb9i\x8ec\x1b*\x82\xef\xf1\x18\xe8\xfb\x9e\xcf;\ag\x9d\x87z\xe7\x8c,/\xe9\xcd\xc8\xe3-\x81\xe8-o\t^\xdf\xf0a\x15\x0e\xad\n\xf7\xack\xf4\xb1\x8dۿ]\x0ft>RU\x91\xe8l\xefQ\xdf\xc3\xf8\xb8\x1dZ\x15\xeeY\xd7\xe8c\x1b\xb7\x7f\xbb\xfe.\xbeU\xfe\xaa\xff\x1f\xec[\xb5k\x1e\x91\xaa\x00\x8f\rЈ0\xfb\xbd\x03\xcd=\x03\xe0%\xf4~\xe8aT\x9b\xef{{u\xc0\xdfŉ\xabQ\xb5\x93\xee\xd3ߒyQ\xa6o\x8au\x99x\xfd\x85)\xa3\xb3\xec\xcfq\x89]\xd1,[SFg\xd9VW\xe7\xb2\xd3˙\xd3\xfcq~΄\xe3#<\x9d\xd3,\xbe.\xf2x\xf1\xa3\xd6-]$\x97\x90\xe2\x83\x04\xa9|\x8c\xa0\v\r\xdfd\x18Ț*u\xfb\xe3\xc4\x14\xe6\x04&\xa0˴<\r\x1d\x01=\x1dL\xa6\x93\x98~un$T;\x9cmD\xe4\xee\x1a\xd4c\vmͬ\xb6\xcbi\xb1x\x83wo\x9c朝t\x97\xf9y\xbbj\x94\xe9%Y\x99,X\xaf\xbb\xe4+\x02\n\xaa\xec\xbd\x15\x90R\x85\x91\xe7\xdb\xf9\xec=\x93\xfb\xfb\x1f\xbf\xb3]\xc9z?\f鬧\xc4S\x9f/\xd5/\x9d\x06C\x18E#\xe0P\xfd\xfd\xc0\xcanW\xc2x\x93U\x7f5\x9f?U\xb7?T\xda \xae\xeb2\xe8\xd5\xf2\xb9\x8c^\xe4\r\xd1%\x1bAp\xb4w\xc2\xea7<FW/\x1a\x86=\xf3y\xab6Z\xfefҲ\xfdt\xb4pfl\x055\xfb\xd1\xf2f=M\xb3\xdb,e2\x98\a\xddN1B\xc3V\xeb\xa9\x1b:\x10g\xd6%z/\x99\x11\xe6l\a\x9a\xae\u0604v̠0RU\xd8\x1b=\x139\xdf\xe7\xd0ˌ\x95p'\xa1\x9f\xdc\xferx\xb8\x8c\xe0]\x04\xb7\xbbwQ*8\xde\xf2\x02\xfa\xfdw<\x16`\xf3\xe1\xbf\xe0\x16\xf7C*\xce^\x1f\xde\xc1\x01\xa4!\xbc\x98\xe8\x98\xc3v)\xcd(\xf1ne\xd8\xfd\xf8\xe0\a\xd3[\xa7\xdf\xcfD\x10\xd7\v\xa0\x98\xa8@\xecu\xa88\x94T<\xd7A\x8cm\xe2\xdbH\xf0\x1cQHP\x9flL\xd9<\xbe͊\xf2\xed\xfb\xa2X~\xcd\x16u\x1cX\xaf\xa87@\xb2[+j\xba\x9bi\xbd\x13\xd4\xc3\xf7\x81z\xfa\x81\xa04\xbb\xd5O\x00\xddfU\x86a\x7f\xeb-\x06\x0e\x9fgi\xca\xf2\xf6\a\x82\xe6,\xbb\x9e\xd7V\xd2]\x96\xd6s+\xa5\xb8e\xe5lQ\xdc\xf5\t%\x7fɨ\xadz\x85\xb07\x1e\x0e\x87\xabM/\xa4\x87\xec\x82p\xb0\x8aK\x96\xd7\xf8f\xbe\xbb\xd3c\xc6\xd3\xe4\xf4\xfeW-\xd4\x05\xf3\x99q\x0fSģJ?S\xf8\x86\x11;\xbe\xe8\x00\x95o\x1d\xd3\xeb߁\x8e7O\xb5\xf1\xe2\xd0\xef\xaeD\x90\r\t\xe2\x01\x1em\xc1\xe84\x81\x88\r\xee\xe6\x8c-\xa8\xcfQw\xeb\xe3\xe5\x85:\xce\x16p\x00\xa7\xbe\xb7Nds]\x11Z\xc4\xdbb]\xbf\x9dұ\xe4\x8fq=\xc7\xf8Z7\xbe\xe7u0]\xa8D\x11\xb0<\x95I\\Ӊ`\x91\xc40i\xa0\xfc\x9e\xc5\x15\xbe\xf7\xbf,\xf2/\xd1\x04\x8f\xc6\x14\xc2H8B\xf3\x16\x92\xf9\xbe\x8e\x9a\x1ax\x1a>\xc0\xbaH\f+\x9a$\x8a\xfe\x8aN\xb7\xec{bhQ~\xc3\xdd\xf2\xa6\xd3\xeb\n\xdb֨P\x80W+\xf2[\xe6\xcfβ\\ߒ\xe7\f\xc1'QMb\xf64%Z\x1c\x93l\xaa\xe8\x9dF\xb3'b\x99#moT\x93|\xc9ѬW\xb4D\x00<\x96\xafU\xcb\xc6(D\x9cIT\x8flH\xce\x1f\x87tX*k\xe2?<mlG)\xd5p\t\xb0\x93\x01>\x911\xcf[)h\v\xed\xb8\xa6j\x97\x10k\xff\x9b\x18\a\x7f\xe5\x11E\xcdXT⦻\xc1\xa6aı\xc1D`\x1d\xac\xf0\x81*Q\x18&0\xb5R\xaby\\\xb2Tdic\x93\xe8\f\x81i\"J\x19\xb2l\x16\x8b\xf5\xd4\x05\xde\xcae\x9e\x8f\x04\x8f\xd0i\xe4\x1d|\x9f\x15e¾.\xe3k\xfe\xf2\xab\xd2]\xd2\xc1,۰\x14~\x17n\xb9\xbb\x8a\xe3D\xdd(\xfc\x85p6\xee*\xfcC\xb1\xae\x18.\x00\u07baO\xf6+\xbe\xae\xbd\x95\x1f\xef*\xfde\x92\xac\x97kR\xccЉ5\x82x\xb1\x9a\xc7\x11$s\x9c٬C\x8fd\xc3\xed\x80\xc9V\x1f\xcfa\x99\x01\x87Չ\xb4\xfcR\u0382\xc53[\xddʅ\xe0Q6}p=\x8a~*=JjX\xc9n])\x91\x85-\xef\v$!\xd1/\xd5\xe3#lY\xbefֻ{-lH\x1a<Х\xcc\xe6\x1eN \x11\xbf5@\xb21\xd3\xe1\x00\x7fn\x8c\xecm3{\xdb\xe5T<X\xd9O(\xb6\xb1\x16@C\x0f\x88\x06\x1e`(\xce\xd3bI\x0f\x91ʇ!\x1c\xd8m7\xec\a'\x8c\rq\x06\xe9欹40Q\xa4>\xd5\x05\x0e\xa74\xdc+),7\x17\x8fL\x9e\xdd\xc0\x81\xd5\x06\x95\xbb\xf5\xe4n\xcd\xc1ΫB\xe1L6p$>\x8d~\xe1\t(\xb4ɶ\x99\xdf58\xbe\xcf\U0009bbf3\xaa\xc6\xe9\xdbR\r\xc4\xfc2\x1e\xee\x83\xe1M]\x92X\xfb0tM\x0eU\x1d'7\x9e[v\x83ͮB\x9e\x8bv\x83\xed\xaeB\x7f\xc6)D\xf8\xfcꙄ[p\xc5\xc0N\xc9 \xbc\x1bS\x99\xb2R>\x1eg\x1e\x006\xc2c\xeaS\xb2p'R\xb2O\xfc\a+\v\v\xa5|\x01\xa1/\\E\xe8\x94ix\xa5f\x92\xed\xd0\xe7<\xf8\x8e{\xd8m\x87\x97\xef\f_\x03˚\xdcE\xcb\x0f\xf1\xe6;\x94\xf6 .\xcbx\xeb\xfa\xb2\x8c\xd4S\x17\xb70\x01\x02\x91\xaeh7|\xb2\xa34K\xfb2\x02\xb6縻\xca\xec!\x1f\xdc(L\xd9\x15y\xe1\xbc\x00c7\x03Taf\xbc@j\x8e/Ͼ\xeaݮ\x16\xfe\xc4\xd2u\xc2ެ\x97>\v\x1d\xe5\x05N\x897\xeb\xa5|\x05\xb5\v1\xa2\\E\xe0b]\xa1-\xaa\xe52\xa8(>Ϫ\xba\xb8.\xe3\xe5WY\xfe\xa6^\xe3\xf4#\x1fȻ\x8d\x17k\xe6\xb9\xd1\xe9)\xf9-\xae\x8c\xb2\x9c~\x12@E\xe2樤P\xea@\xdcc\xf2\xb5\b\xf7\xa4Ϫ\xc5rp\xdc\bY\x9d\xe2\xa6Y?₢\x1bȗ\\\xa0\x0fS\xac:\xe7\x01\xde\x1bһ\x11>\x88\xb3\xcb\r9\xcd\xc0\x01lз\xc5~\x00f\x1fR\xf9+8^\xf6\xa1\xff+9\x9a\x88\\zo\x10\x1dK$tw_\xb12.\x93\xf9V\x04\x1d.\xa6h\x9d\x8c@\xa5\xab\tF\xbd\xda\xd5\x00\xc1}pQ\xd2>7\x99g\x8b\xb4d\xf4\"/U/\xcf\xeay\xa1\x01N\xac\xb66\xab\xb0\xe0\x04\\Y\xc0R\x03\xf1Y\xab<\b\xbe\xcb\xf1t\x01&\x80Ǜ\x8ey4pP\xf2\xcf0H\xbd\x91Dx\xee>\\{%\xda\xeb\x19|\x92\x15\xfb\xa0\xf9\v\xb2ʃ\x83X\xb8\x0f\x827\x18\xfa\xcc\xe3\xed9\xe5(\xa0\x0f\xf1\xfeȨ'ho\xd4\x1c\xa9\x83%+\xaf\x19ϴ\xa3$\xf3\xcdVÞ(\xd2\x15;\xd0\fpy\xe5DX\xa6L\x8f\x9f\x83N\x00\xe0{\xfas\xb1\xb7\x8b\x8c\x1c\xbe\xb5?\a¢\xd2?4B2wN\n+\x9c\xf2Z\xb8\x18+.Nwr\x11\xf1|\x97W\xcc\xc1Dj9L \x1e\x10\xc4[\xfd\x1a\xb8\x99\x02\x1397LE\xea\xaad\xb46٩\x02V(݉\r;\xdd\xd5Jn\x1c0\xa9۟\x86\xceV\u05ec\xacXRW\x8d\x96\x93c\xf7t\xb0!9\xdc\b\x87\xee\xe9`K\xdf\xdb\bҒXS\xe2\xdc8(\xed\xd8\xe8%\x1c\xe0?}D\x82~\xd6\xf8k\x8b\xbf\xf0}\xfe\xc1p8\xda\xd5\xdcl\xba\xc8\xf2k\xdbN\xa1`\xa7\x14\xd8\xd3\xcc\x02\xd8\xfc\x90Yo\xbdc.\xd1N?ʈ\x00\xd4^c\xf3C\xbc1\x1f\x02\x11Ї\x06t\xac\x9d÷>\xdc[\x03\xf7\xd6Ľ\xf5\xe1\xde\x1a\xb8\xb7\x1a\xb7ގ<\x0e\xe44G`r\xe4\xd19\xec\xe3\xc0\xddé\x10\x91\xda6!v{\x82\x0f\xf2\xc1\xfeH6\xb5\xafS\xb6.ȶ\x01\xc2\xdd^\x93\b22\xaa\xa3b\xc5k\xe2D̊\xf2\x9b8\x99\av\xa7\xe1\x14$\xda\x15\xabm\xa3t\xb2\xe3^#\xfdXJ\n\xf7~\x10\xaa!\xefP\xe9$\x87\xec\xc8\xed\xa3\x84\xa9§o\x89N\a\\@K\x9d`\xa0\x94H\xa7\x96\x9b,\xa2\x1d\xfb\xf7\xb7\xe3+\xdf\xf6\x15\xdb\xf5\xe3\"\x16C/\x82$\xd4P\xbc\x82$l+\xa7g\x14\x13&\xb6\ah\xb2\xa3t\x82\xa3\xd2\xf6\xc3mNG`>\x0et\xac\x14]\xe7jog\xbb\x8c}\xbeQ\x9dP\xbb+\xfe\xc2\xfb0\x02r\xad\x1aEP\x8d\xf5Eo\xe7TŜ\xef.\xe0\x1d\xd9;\xa7\x17\xa4C\xbf3\xb2\x10\x97K\x9fze\xdf?C\xbdCN\xba%@\x917\xbap2\xa6%\x8bo\xec\xc4\x0f\x8f\xfc\xbfy\fV\xc231\x05\xcfh\xdb\rL\xacλ\x80\x1bj\xdb;'m\x027F\n\xb2\xaa\xd9\xcc\x1d\r\xbd\xf17\xd4\xdb\"\xbb\x1d{\xb4\xd0\xc7\xf2j\x04ϱO\x7f\xff\x9dzx\x82\xbf\xbf\xf8\x02\a\x17<\xc79>l_\x8e\xb0[C\xe1s\xe2\aB\x8e\xd8R\x8c\xffe\xfd\xbe\x99\xe0yfk\xbf\xf1$GE\xe2$5\x06g\xfb\t\xdc\a\xdb\b\x17\xd0\x1cz\xc8\xd7\x00~\xed\x9a\xec\x1b\xc1\x96\xa7o\x8d\xf4R\xcf8\xe6\xeb\\\xbe\x01\xe83\xa5%\xb8D\xa1eE'l)A\x99\xad\x92\xd2\\K\x922\x82\x84\x96\\}Ҏ8\x0e\b\xd3!\x15\xc7\xdf[\xfb\xcd!\xbe\xf2 \x93\xca\xdd\xd3\xf8\xbf\xe7\v9\x91w\xac\xd08\xd9[K0\xd5ai$F\x8a\x98\xec\xf2n[\xb1\xae\xdd\u009c\xb2\x05\xab\x99[\xc1EK\x1e\r\xc3\xee:~\x96\xa7\xeaT\rw\xbe\x8d\xc0:\x99R\x9a\xaf\xb3\x1ek\xf6\xd1\xea\xa3lk<\xc5\xc8%S\x8e\x95\xbb\xbd0\xfb\xe2@\x99\x10Ș\xaa\x96\xfb\xdfZ\x1e\xfa\x90\x10N(k\xd7r\xdb\xd6PY\x9c\\\x84e{\xb5\x88t0\xcb^\xfdL\xfdp\xaa\xf4\xbf\x04՚n}Q\xb75\x9dҹv\xba!\x17\xb3mh\xb79\x8d\xa9\x94Ɗ\xc3Fj\x91\x87R\x8bT\x87\x9f122UWD\xd2)}O\xcd`\xfaH\xd6\xe0\x94\xae\x1dMQ\x0f\x8d\xe9\xba\xc6\x18\xf1$\xc2\xebY\x0f&\xf3\x82\a\x81P\xf4\x82t\x8aU'!ź\x9f\xe2\x00ŏ\x03\x10\b\t.4њ\xe3\x1b\x99\x84\xa4\xab6\xd8MHHT\xe2\xc1V\x82 ö\xc6\x1b\xd1\xce\xcch\xa2L\xa7\r,;\xfb4Y\xac\xab\x9a\x95\x7fkȜ\xb4\x99¡\xb4EH\b\xc3\xf7\xc4\xd9\xf4\x892\x94\xaa\xea\x0e/v\xd6\xfe\u05f6ڕ\x98\v3\x98\xf9:\x83\xbfn\x9a\xf6\xa8~\xc9/4\x94\xc1\x91o\xc4t\x13\xf5=\x9bՍ\x83\xd1\xee\x89\xc0\xa1\x1a\x05۩\x16^\xb6T\xa4F$z\x91\x9c\xef\x9a\x1aEɟ\xd07ao\x1a#h'3\xf0L*!\xbcl\xabRQ\x9bS\x00\xeb=(\xaeK\xc6ްU\\\xc6\xd4\x7f\xde\x1d\xba<\xfb\xc5\x11/~\xbf\x84\x11\x9c\xc3x\a\xe2\xcf\xd5UF\xa7\x88\x16\x0e\xa8\xbe\x01\xc6B\x8e\xd3\x1dT}\xfe\xdeq\xba\xe1\x014\xbeah.\x12\v_R,\x91\xed\xfb3\xd1\\\xabZ\xe9\xb4'tʍ \x8f\xac\xdbx\xbbn\xb9\b\xcaxe0\xf17\xc2Z\xd4d[\"\xe0\x1d\xf0\x02\x86&R\xe5}@\x85\xba\xaf\r\x8a\x0e\xd9C\xc0\xa9ǗE\xd5b\x81ڐ\xf5i\xb3\x870\xb7\xe1P\xeb\xe9\x0e\x1c_3\xb6bmd\xa4lE\xb7O\xa7\xfc\xd7\x0eT\x7fɪ\xac\xe6\x81\t\x85\x94ċ\xc54Nn\x1av\x18\xf4Ԫ\x05\x10\xaa]Y\xb1\xae\x84\xe5\xc6#\x03\xadBuo\xb1\xb2\x04KV\xfcJH\n\x1eI\x1bN\x84\xba\xa7\xdb\x05\x0e@\x8a\x99!Q\xd6&\x98\x1a\xea\xabо\x94\xe4\x90\xd2)j\xa0\xf8\xda\xc2A˳\xc8\xe04\x9d\xb9\xef\x1a\xe5\x14\x8f\u009d\x89(J\x85\xf02\x98\x8bh\xe8èc\x82ʚ\xdd\x10\x99\xad\x12,\xed\xf73\xf4\x8a4ƛ\x87\x9f|\x92\xba0\x01\x06\xab\x92-\xb2%j\xc9D\x9a\x9d\xb9,\xd2F\x0e}`*\a\x11\x9f\x10\x88\xe6\xa8\f\xfe\xbd[\xc1E\x92~(nY ]\x88\xf8\xec\x11\xf1\x8ad{d&L\xd4O\xb35bb\xd13\xf1\x851\x9b\n6sJ\x8f\x80[\x03\xf3\xf5r\xcaJ\xe8kt<E\x10\xacR\xcdf\xe9\x98\xff\xa2\x93(\xab\xef\xc9RL2XG\x19^vS\x8e\xcb\xeb\x0ev)\xbf\xa9\xdbl)\xb9%\tvf\x9e\xdbl)\x96&\xd5\"\xbd\xe2\x1b\x8ee\xf0\xd2\x03\n\xe7\n\xed\x0e\x8a\x96\xf1\xea\xc78}\xbd^,,\x89w\x8e#6b\xad\xdc\xc8S\x88\xadH\xd8ʄT\x82\xa4\n&\x95@\xe9\xb6\xdd\x0f\xbfA\x8a\x1c\xd0\xf6\xfbar/\xa2l\xcd\"\xff\xf2\xf8\x8a\xefB\x94\xa1Xf\fex\x15A\x95~\xc1J\x84\x8c0\x10\xa4\nC\xba5\xb2\x86&\xdc\xd8\xf0\xddN\xe9=Q\xc3~\x8e\xfd\x9fnt\x98\x05\xe0U\x0f]Cu\xba\xb5\v\xd2&7\xddZ\x05\xb7n\xc1f_\x98\xdd`\xf5\x80\xcd\xfc.\xbe\xa7\xd5m\x90\xa2Dg5+#XfK\x86\x97\x1a\x1a\xcb\x14\u00ad\xcbEs!\xa3\x03Қmj\x9e-\x11\x18\xdb\x1d̴\xcc\xc5r\xca\xc6\f\\\xa9\xd2\xea\x16\x85\xb8b\x1c4\xf4?)\xaaH\xe1\xefv\xffT\xdc\x05eq\xd7|\xb8\xa4\xb8\xe3Gl\x04E\xe7\x8b!\xbfd\xaa\x9aم\x96\n8$Ǩ\xbf\xee0\xa8QI\xe0 /ᏽ?\xc2!\xe0נd+\xda\xe7\x1f\xfd\xd2;\xba\x8e\xe0\x8f\xbd\xde\x1fC8$\x88s\x82p\xfd\\K\xf6c̯|\xe5\xec\x0e~b\xd7\xdflVA\xef\x97\xf2\x97\xfc\xf7K\x8a\xe3%)\xc6k\b\x98|\x85\xc7\xcb\u05fd0R\xc4\xd8e\xffx\xd9\xfbc\xa3\\~\x85\x05T\xda+>Ӫor4\xc2\xc4/\xeb@>\r\xa4z\xc4<\x84\xb6\x99\x82\xf4\xcfY\x9c\xb2\xd29qVe\x7f*\xee**d\xc8BY\xdc\xd1\x15f[Q\xb5\x128\xea\x02&\xf0ۇ\xc8r\x9c\xe1\xd59\xc6\x1b\x9f\xd3Lq\xc9A/\xdf]Q\x14\x81\xe2\xee\U0009d94b\bR\x8b\xc6=j\x93\f\x8e\x83\x97\xf7\x14\xd6\x0e\xa4\x96^b\x9f\xab[\xccp\x99\x19\xc1\xcc\x13w\xa0.nX\x1e\xb8,\x12\xa22X\xc4UM\x8e=\xa8,\x90\xd49\xf7\xf1\xbe\xf9\U000f7d8f!+\x166{Y\xb1@J\xe2E\xc5<\xcd\xfa\xe6\xcf\xdf7[\xa5\x9d\x98\x1atؕ\x11E\x86@\xbd\vɣ\xf6\xf8\xa4\xd9\xc3\x19\x1a\xbd=\xbd\x98\x1d\x1e\xc2s\xbbiM[\xbb[O毧\x15\x9c\\e\xe0\xb1(\xe2=\x110\x82\xd1v\x1f\n4\xbbf\x02X\xc1\xd8=\x02J`\x02~J.\x9c3\x86\x84Z3:v[û\xcet\xf3\xe8n㘳e4\f\x9bT\xda\xcd3\xe2\b$\xaa\xcc>\x95\x7fh\x8a\x10QR\xad\xa7U]f\xf9u\xf0\x0e\x9b\x88\xa3^O\x90=1A\xfe1l\x93\xb5\xa5!kl\xc3\x12>\xfb\xd8¶\xf4\xc95\x06X\xb2\xa74\xeafk\xfa\xbb\xd8Ms\x04K\xee.\xea\xa5\xd0\xd7\xe5\x86\xc4^<ځ\xdd\x1b\xc7\xe3\x9b?\x7f/&\xbdo\xfe\xfc\xad\xf8U\x16w\xf26A\xce7\x1au\x84\r\xbdx\xd4N\xc8б\x0e\x045L\xe4\xac\u0099\xf1͟\xbfu7\x82\xb1\x13\xedA\x94\xad\x05\xfc\xf7tK\xcdW\x18 \xe6W\njK\x8a\x8d:}\f\xc4\xee\x9b!\xce\xc7t\xb45\xc3=w~x\x18\x86>Ghd\x02\xaf#n0N\xaf\xfaUc\xe2\x9d5\xdepB0\x9f\xc6P\x19*\xc3OŝP\x18z\xbf\xe4=\xff\xb3\xf5\x95\xf7t\xe4\x9a\x15o\xf1\xc69];\xaf(\xf4\x04\xfa\x97r\x1d\xa4垬\xf0\xc7ri\xe2\xc9\xc4tąo\x8e\xff\xf9.\xff\xb1,V\xac\xac\xb7\xa2Ԡ&M\xed%\x87\xb94\x12\xaf\x14\xdes\x8b\x8a\x8e\x8b\xb0\x18\xaf\xee-\xbf\xba\x1a\xf0\v\xce\xee\xbd֥\xb8#J\x99\xa8Y\xc4\xf6\xa7\x93k\xdf?E\x9b\xfe\x83J\x1e\xda%\xdf\xf7\xda8O\x87\x95UP\x18\xab\xaa8\x1fֹ?{\x99\xc9\xf9\x18B\x13\xf4\xb2\x90\xecD\xac\xdd5\x7f\xcb\xe2z]2\x8b\x00\x87\xb4\xc15+\x96\xac.\xb7{c{U,\xc4eV\v\xaf\nM\x1e\xc3\x04\x8a\xc1\x8c\x03W\x91\xf9\xe6E\xacc\x94{\x0eRm\xca0\xeaM\x838\xff\xa6_\x97\xfa7\x01\xbf\x1f\x8d\x02{\xf6\x91T\xeeK\xdc\xf7\xf48\bͶ\x1dD%\x05\x7f\x8e\xb7\xbe\x17U3q\x81\x9d[\xc9b\xed\xed\xd1M\xd2\x0f\xebE\x9d}V\xba$\x12:T$f\t/\xef%\x9d\aʲ\xa4%_\xc0;\xb3\xac۪\xe9\xe5\xbb+ϵ\xd7\x1d\xad\xfb\xb1Xl\xaf\x8b\xfc\xefд\xcb\xe1ߵu\xf4\xb8\xbe\xdd,\v\xa1ծ\x1dC\xfb~L\xa2\x86~>Ѽ.Y\\\x7fY&\x0f\b\xb2a\x16\x7f@\x9c\r\xb3\xb8\x19<%h>l\xa9rY\xe0\xec@\xbfR\a\xcfY\x1e\xd4tn\x8dg\xca7\x11|i\xe6\xa4ЇZdl`\x02_\xe2O\na\xfb\x15\xfd\x1aq\xe3\x11%oU\xf2v\x14\xc1{\x91\\\xa9\xe4j;j\x84\xa0\xa0\x8a\xe2:\xce\xc7\xc1\x96\xa2\"\x1c\xc9\x16\xe2\xca\x15\xe7Ud\x82\xbc\x8f\x8c\xc3\xf2\x8dp\x8d\xc7\xd3\xeam\xd8,\tW͐X\xfc\nL\x82\xffT\xf8\xcf\r&\xddlŻ\xb1Hv\x82\xffT\xf8\x0f5\x8e\x9a\x92F\xca[B3t\x90\x8a\xcbC\xfe\xe7(i\x05\u0557\xd4n`\x02#82\x19+\xb8\x1cc\xf0\ru\xf0\x8f;v\xe5\xe9I\x94\f9\xe7\xe0\x10Ɇ\x03$\xd0\f\xdb!_&\xc6\xff\\{\x82\xfd\x84\xa6A:\x17͖\xa85\xdc\x13i\b\x13\xa3\x12\xfcz\xcb\xc3\x00\xdbL\x0e#\xa84,6\xccx%9\xd9ZX\xb6\x1c\xcbȃE\x96\xa8\xb6\x16\xae\xad\xc6uC\xb5\b\x16\xe8\xd8Z7[\x9d\\\xe9\xe4Ժ\xa7\n\x9exG\xad\xbc\xe1㮓7#\x8b7\xa3Nތ,ތ\f\xdeXX\xb6\xa3=xc\xe1ڎ\fތ`\"\x04#ѱ\xc0n\xb6:\xb9ڌ\x1e\xc8\x1b\x7f\xf6~s\x12k\xb8ug0i\a\xc7)L\xc8f\x10\x87\xa2'\x94\x0fm\xa6F\\`_\xc0\xceZ\bZ\xbe\xc5\x1dQ\xb1.)\xa6Pp]f\xa9k\r\x1f\xf2\xe9kh\x1d(\xe1F\xdd\x1e\xc8X4\xa0\x18՞\x1b\a\x97\xe4\xd1\x04W\x17\x9e0\x7f\x1b\xb2\bX\x06\x81\rw\xcd2\xfdE\xb7\x9d!q\xb9\xa7W\xdf-`\xe1\xe8\\\x89\x97o\xe7\xeb\xc5\xe2ի\xff\x11dhM\x18G\x90\x1dGpk\xf2\xa26\x9c\xae\xd3\bXį\x18\xf1\xdd\xe8\xede&\xfd\x9ec\x98\x80\x0e]:\xa5\xafѕ\x05*\xcd\xfc\x89\x05\x9a\xfa@\x8f\xc5'\xb3@g\x16\xa8\xbc\x132\xe3\x17\xa7\x0e H\xa0\x0f1w\x86Ri\x8c\xa7\xbd\x80a\xab,\xac\xb8\xf2\xf0]^e)\vV\xbc\xc1n8\xec)?\xb2\x88\xc9-\xe6@\xbd\x1c\x14ӵ\xbc\xe7\x98o|\x1f\xa8\xf7\x86\xe2\xb6P\xd9v\xcd\xc2\xc36HTpgK\x1ei\xb4\xf2\x87^\xc6*\x9e\xd3\xe6\x98\xf46\xfay\x02\x13\x8a\xc2.\xc25&t\xedp;\x16\x11\x9f\"\xd8rX\xfa\xc9a\xf1\xe7ft\xcc\x03\x1e\xf6a\x83\xe1\xc5\xc7Xv3\xc6O\x8c\x9a~B\xb9'\"wK\xc0[\x04\xde\xe2'\x01o\x11\x18\x17\xc2-\x01oOD\ue69e\tB\f\aT\xb0O\x00\aX#\xb9\xa3\x89\xaf1U͡\xc6#{\xec^\"a\x87\x88\x89\x00\xa9e\xe2\x13\xeb\xf6\xdeC\xbb-\xca\"/\xb2\xb75\xab*\xb6\xa0i斕u\x96\xb0\xaayʂ\x9c}\x93լ\x82\x89H\x01XdU}\x0e\xb2\x8c}\xaf\xe8ֱ\xb2{\xae\x15\x91u\xeb\x1c2\xf3B\xd1\xe6\x1cn\xb1g\x8c\xa4-&\x8d\xae|\xb7\x8b\x06\x15^\x1bRu\x9a\x92`\xb9N\xe0a\x17\xfa0\xbe\x84\xfe\b\xce)\xe1\x85H\xe0\xdf\x1b\x02\xd8h\x80\r\xbc\x10\t\xf8\xad'\x950R\xf7\x12\xea\xbaX\"Gx\xd8Ps\xaa'[Zz;Ϫ\xba\xc1\xaeKո\x05\x9b\xd5\xdf\xe4)//\x13K\xf4Ei\xa4fyV\x9f\xfb\xe3\xd2ʊ\x06\x02\x1dLtR\x82K\x03\xfbS\xbc\x98a\x92\xd0\xc9{\x8b\x9ea\x11S\xb0\xb2⇖\x17Փ\x83r\x03iG}\x83\x05L\x1aX|\xf8\xf1\x9fu\xce\xdf\xe8p\xe1\xa3&^\xbd\xbb\x8a\x94\x13\xb6\xd9\x1a\x83\x9d,\xbdf\x11\xe0|\xb6Cb\x19\x95#p#\x15\v\x9eӿf*\x0e\v\x14o\xb3\x1b\xf1\xbfE3\xa94DȖp\xdd\xff\x15+M\tXL#\x98[\xe4\xce\x19qra\x04S\x9fsw\xf1\x85q\xbb\x06\xe8\x8b \xe7\xccN\xb4\x92>\x98B\xfa\x15\xee\x1a\x8d\xcaW\xae\xedv\xce:\xfb0-\x9cc-\xaa\xca$\xea\x83\xd4\x19\xe6\f\x1e{\xe4\a\xed\x90h\xed\xe1\t\x7f\x9e\x05s<\x99\x0fËG.\xd2E\xc3\xf4\xediT\xca\x16Fs\x9a|\x1c\x94\r\n\xe7L\xb1ͬc\xce\x06(\x0e\x8e*\xf8\xc1\x1aͭU)\x02\ae\xa3$2q\x8f\x82\vo\xc1\x9f\xd8uV\xe4{\x14\xe7\xb4s\xe2\xe1%\x9f\xe0\azj\x83s\t4(\t\xe5\xe5\x9c\rPЯ\xfc-\xfd|\xf5\x1a\xcbU\xb1Z\x15UV3E\x8b\xfb⠞\x84Qd\x8c\txJ7g\f\xf2*\xba\x03\xe5Js\xce\xee\xbe\xe1}\xfa\x9buL\xc3\xdbf\xa6\xd1h\xaeF\x91\x95T\x9eC56R>\x98\xd9l\xe5\xc1\xe0\xce\a\xcd\x19\xc1\xba\xf5raQK\xfe\x1d\u0558<\f\xab\x91rٯ\xc6\xe4\xb3_\x8d\xf0\x92gL@).jCx\x89?Ρ\x9fn0\x03aӭ\xcc\xd8R\xc6V\xd7 x1H\x10\xe5h\xa0\xbc\xdf\x11/\x90\x9b\xfb!y\xa1؎\xfd\xa8ә\xb1T( \x13U\x1f\xa7[ǟST\x10\xbbW\xbfd\xc6\x14\x84cJ\xba\xf1e'p4\xb1\xb2<\xe7\xe8&\xaa\xd1EK\xe5\xe45\x93n}ټ\x8e\xad\xff\x1c\x8e\x1f\xc5sHϼ-tUs\xb5Y\x8c\"`\x8b\x86ر\x11L\x80-F\x03\xbe\x1c\xb11}\x8e\a\xcc@,n\x992z\x95\xf61\xa3\xab^l$\x06\t\xceZ\x13`c\xf5\x19:\x02\xdc\xe15\xa0\xa3\xa4\xb2\xd1\x00\xb5F6\x1eL\xa1\x8f_S\xfe\x15\xdb44\x9e*\x19\r\xef]݆?\xee\x10\xb0\xd1 1\xaa\x1c\xf3\xafр\x02<\xa4\x11l%\x9cʉ9i\xa2T,\xe0ب\xe4\r\x90\f@.\x96`\xf1\x04Y\x1f\x81\xc3P6*IGdc\xfcK<\xc5\x1f\xc4M\xfc\xf1\xc5\x17\x94\xb2\x11 \x1b\xbb\xa5l\xc1;\xee\xc2L#B:\xa5R\x14\x1b7\x8a\x8d\xdb\xf8%\x16?\x9a!'\x9c{\x18\xc6R\xb5m\xe0\xf8B\x98\xf0\u0604\x05͗<\x14\xf5\xa2G\"\xd4\x05R\xf6\xeeգ\x1e}\t=\xc42;b\x00:\x8aeyݡ\xeb\b\x92\xacU\x04\x17{w\xb8\xf0\xb5\x98\x0f\x96\xbaXU\x9c+\xcc\xe8i\x9b_+R\xea\x05\xe4\x0eV͙\xc1\x87\x85\x9f\x0f\xa3\xb6c\xe5\xc7]\xc8Z\x98:lCF\x93S\xf36+\x8d\xd6-=\xabF\xf3\xbcl\xd6\x16}\rW\xa2\xb5:y\x13\xc1,\xae\x84\xd7p<-n\x99i*i#\x1cg\xcc\xe70DA\xf1优\xb8\xbe\xf9\xa0P\x8bʐB\x92\xd0)-\x10\xab\x9dWEe\xf9\x15w\xad\xa45\x86\xea\x026H\x9a\x1e'\x82@\x97\n\x8d\xe71\xfdh\xf7\xa2\x11\xed&\xa8&\x16ъ\xd1\xfe\x97\x8f\x1fc\x11\x17\x11_\xa5+\x98\xe8\ue03e\x16\xd4\xc5`s\xe1e\x02g[\x80\xfdIܣ\xf0\v\xf4{\xbb\xc2I\x17q\x1e\x88$z\xb3h,\xe0\x8e(\xebP `\x83i\xf8\x19X\xf7\xa1kn\xc3&oi~\x1b$\xd4V\\RV(\x86\xf5H\xc9\xecv\x11A=\xf6\xc9jM摅)\xd7&!\x92\xc4\x1a-\xb2\xf5\bG56\xbd\x1e\xc3!\x16=\x80\xfa\xb8c\x82r\xc66\xbc\x14\xf8Ν&\xab\t\x89\xe5)\x9d\xbeyw\x8c\xf8om\xc9\x0e\xe6\r\xd8\xea\x12s酫\xacn\xac\xdf\x02ħ\xd5r\x95֎\f\x01\xa6\xd3*\x16nnn\xa5M\xd7\xd4n#\xa8\xddY\x93k\x8b\x9c\xdfZW\xe4S\x88\xc9d^\xbbqJ\xd4\xd4\xf1Z\xb5n\x8am\xfb\x7f\xacٚu\x18?\x1a;ڹ`e\x04\x05E1s\xf6d|;\xdd`\xe7\x9c\r\xb6U\x1d\x97\"\x83\x1c\xaf\v+J\xbb\xf3\x8c\xfa0\"R`b\x90I\xb6\x85\bh\xfb\xcc7\xb0\xfa|s\xe1\t\xc0\xc07\t\x9bZ\xc0;\xd7L\xb0\x87\x15Y/\bP|\xfc\xfe\xbbA\xef\xc4\xcc\xf9\xe2\v\x10\x13\x83(\xc0\x9b\xebj\x1a\xe0\xf1Mj\x99I\x1b\x1e\x85\x1f<#\x82Z+\"\xd6f\x14\xb1v\xce\xc2{\xed\x96\x1b\xbc\xadZ9[\xd9|\xc56/(\x1a\xf6c2<\x88\x80n\x06q\x95AڨI\x16[\xae\xea\xedy\xe7cM\x0e!\x82\x00~\x96\xd0\xc0\x87|\xa7\x02\x9f\xa1\xb1N\xb8:)&\xbc\xfd\x13l\xbf\x1c\xf0\xc4\x13z\x1c\xafk\xbb\xe1\xb35,\xb3\xbc\x85\x1bH2[\xb0e\x93Z\xe3\xf5\xb46\x05\x0e\v*qt49\xca#\t\xee\xd0\xe7ئ.\xe3\xa4\xfe\xa1\x95\xbc\x96\xcer^㵦\x19e Bè\x84iX\x11&\"\xa9\x89Nl\xf3[\xa1\"\xcc\xfd.\xaf\xf1\xc4K\x97XL\xf34\x82\x92\xfe]\x88\x0f\xfe\xc5-\v\x85\x01;-jRK#\xa8\xd9rE\x0f\xe5\xde\xea\\Th\x97;\x0e\xcb\x1e\x1b\f!Y\x0f\xacS3M\xa0ݭx\xe0\x1d\xfa\xce\xd0d{\xf1f^\x135NN\x02\x82\xb6B\x1a\xfd`k\xe7M&v\xe6\x17_\xa8̍]pc\x11\x8c\fs̈́_\xf1\x18P\xbcxh\x88\xa2\x03K\xeag\x80\x18\xac\xc8=u\x03\x86[\xa0\\H\x06\x13n>\xe4\xfd\x14P\xe74k\x95\xbd\xd8a\x02g\xad\xf6o\xbe\xa0\x05\vK\x1e\f\xc0\x95\xa4A\xd9\x04\xdaa\xe9e3{\xba0z,e\v\xb7\x81V\xbeE\xc9*\u2d6a3ߕn\xb9\xd71Vt\x93-\xd1\xf7\xe0N\xf9\xa9\xb8#\xa1\xf8\x88ۛ;\xa2&]\xfa\xde\x1c\xe8\x9a\x18\x9c\xd3\xe5=Ʃ\x14{\x03HM\x88\x81Q\xff\xc27>\xdc~\xdek\\\x94~\xa8\xb2s\xf4h\xb3\xb1\x8b\xae.V&\xa09\xcc\x1c\x94\xb7tؐ\xa7b\xb9\xd0\x19\xc4\x7f\xa9F\x13~\xb1o\xa7\x9f\\\x8d\xbe\r\xdb\xe0K\r_\xfa\xe1\x15q\x9e\xa1\xe1\f\x1c\x87d\xab\xa4\x93\xb7Z\x02\xed\x10lɛ\x165\x1d\x1f\xd6\xc5j\xe0\xd82q\xa6ǡS\x18ʧdt]X\xbb_\xceT,`\xa6\xf2\x1a˞O*\xbdsX]\xac\xee=\x7f\xad\x96]\x03\xb4u\x84\xda}\xc2\"\xf0m^V\xcb+\xbbg|\xb3\xde\xc7L{\xfb\xcc{\xed\x13ߴ\xa8\xfdC\xbe{\xfa\xf9\xf4\xf3O\v!\rM\xde\xd2\xe2\xcd\xe0\x06\xa4\x8a.\xbc\xe3\xdc=\x87\v/\xf8\x1c\xe4;W\xbb\x80E\xfb\x8c\xa2\x9b\xa8\xf6\x9ej\xe8v;u.\xdfb\x00\xf1\xbad\xec5=\xba\xe2\xbfˋ\xd1\xd9\xcf\xe9:Od<-Q\x99\xbb\xc4\x15\xdfw\xdb\a\xec\x9d\x15R܃`&\xef4Kw\xc4\r>!?\xb6\u07be\x99\x05^\x10\xdbI\xad\xe2\xa1\xc4Fp\b\x9b1?ֈ\xa0\xe2q\xc40q\xab\x12\xdd\xfb\xf7\xf8O\xe5\v\x8d@>1\xadd\x1bP\x9a\xb4j\x83\x95\x86^l\xa3\xbd\xb0\x8d\xae8\x16\xd9\xd06l㽰\x8d\x05m\xd5V`\x1d\xfb\xb1\x1d\xef\x85\xed\xf8J\xb6Pu\u009e\xd2\xc5\xe7\xa2U\xfb]\xf1\x95\xe1K\xb2\xc5\xcf\xd1U\xbb\x1c\xd5ْ\xbd]\u05c9\x92W|\x1fn\xf0V\\p\xfd\x9a\xdc\xf0\x9cG\b1\xbe%\xbc\xa4\xbc\xc1\xbf\xff\xfc\xaa\xe5]98\xd7\x1fm\x1eNT9\xbf\xff\xf3\xe5t\x8aA$\xb0\xbe<^\xba\x17w0ɸ\xc95\x8c\xe0\xb8\x1d!\xbfԜ\xd2{\xb0\xb8\xd8\xf0\x97ay\xd1H?\x9fN\xee\x984S\x19~\xde\x12^\x85\x8cX\xc2D\x14\xf5=\x8a\xe3\xc4\xe3@9x\x87\xc6ߥ\xda\xd9\xea\xa81\x89\x89\u07bc1xxh\v\x12\xe0\xfd\xc8'\x1a+\x9f\xa9\xad֕ե\x85J\xa0q\u07b9x\xbc\xc2M\fE\xb6\\\t~h.\x84\xdc\x12\xda Ӻ\x92\xf8X5\u07bc]\x8a7ƚ[\xd9\xfe\xe8\xbeq\xe5\x8d\xee\xff\x89w\xbb{\xfbɼ\xa0\xfd\x9f\xc1\xcbs\xbc\x9aDp\xe4wE1\xc2\x7f]\x17\xb5\xbck\xde\xfb\xbd'ގ\x8b\xa0\x97\xf5v\xc9\xdc\xf7Eq\xb3^\xd9\x15\xd3-\xc8x%\xe4?=~\xfbC\xbc\xb2\x1fv\xe3\xf5{\x84A\x85gYƫA\xc5j\x8e\x18\xef\xf8\xd4\xc5\xf7\xc5\x1d+_\xc5\xf8\xbax\x04\x99\xedƶ\x8cWݲ\xfc?\x18\xbbI\xe3-\x1f\"NGfƥ'*\xa1\x00\x7f\xf2\xdeO\xc4\xf6\xe50\xf1\x82ӍO\xd1\xdfz\xb0e\xa1Mn\x0e/!\x83\xc3\t\xe4\xfa9\x058\x17ݿ\xb3\x11{\x90\xbf7៛\xe4\x1f\x8a\xbc\x9e\xef\xc7\xf5\xa5\x06ݏ|\xbb\xc0\xfe\xcd B\x06K?&.\xd0\xf4\f.\xb5Ԓ\xbb0j\xf0 ܗ\t{5\xff\x1e\r\xff\x14M\xfe\x1c\x8d\xfd\xbe\xc0\xc7\x1d\xbf\xc5x,--\xd6oH4\xd6\x1a{r\xa9\x06ɠ.ĥ\xb3\xd0Ĵ\x0f\t\xb4\xf0~<\t\x9b\x8f \xe1\xe7l\xf9)H\xf8\xeb\x83H\xc0.\xf8\x1b\x8b\xcb]\x82ǃ\x0f\xed'y\n\xb6E\xf4Hd\xe0\xa4U\x02\xb70\x81\xc3\\\xdc\f\xbb\xbfl\xfdc\x9a3\xeel\x8e\xc4\xc56\xab8O\x89Bj\xe1\xfd\x87\x8f\x81\xa1q\x1d\x8dܥ\xe0\x05\x9c=E\xd7\xe2g\xc3!\x86Id\xc7\xe1\x1e\x13\xcfkj\xe4?\x13ۖ\xc0\xd9\x02}\x18ݛK\u0530\xafw\xaf\x82\x7f\xcf\x06\xa5\x1f'\xd6\x7f*\xd6\xe5\xf8䟩A\x7f\xfa\xb8\x06\xfd\x90\xe5\xeb\x9aU\xffL-\xfa\xe1\xe3Z\xf4\x86%\x05\xde\xe4\xfe'jћ\x8f\xed\xa3\xc5\"\xab\xfeQ\xcd:nm\xd6\xf7\x1f\u05ec/\x97?.ۚ\xe3\x92\x1a/\x7f\\\x1a*P\v\xad\x13\x18\x87\x8ef䐮\x1d\x92\xe9\x16\bo\a\xed\x7f:W\xe9\xf7En\xdc[F\xe2\xf0\xcen\x8a\xb4\xa0\xe2\x80\xd9\xfca6\\\xf5\xdfW0\x81\xf7\xc2߶\xd7\xef\xc19\xf4\x0e{\x11\xbc\x9f\xc3\x04\xfe\xe7\xff\xd4~\x95\xefѩ\xf1l\x88E\x96\xea\x8e+O\xffW8\xb3_c{_\xc1\xa1&g\x96-\x16\xe3\xe0\xfd<\xf4$.wm\x04\xbf\xab\x8a\xd7q\x9d\xddr\x1d\xc6]\xbe\x90!u\xf1ݛ?K5\xa6\x15\x1b\x19Ro\xe3E\xb0@\r\n;\x91\xe1\xb9\v\x0f\x90\xe8ޯ.\xe9\x18Ь\x90\xf31\x1d\xc2\x04\b\x01ό \x1d\xc1D8x\x04\xe9\xd0\xf4\v0H\x84>\x96|\x8e\xc0}\x9e\xf0\x12\x13\xce!\x1dy\x03\xaf\xd1\xebfv\xedH.%)\x02\xc4\x1e\xb8\x96\xaa .za\xd8B\x81\xb7\x1aI6\t\xf5\x8d\xbf.\xb3\x96C\xd1曽k\xe0/\x06\xd6\xc3\bj\xbcn\xed\\UG\xa40᭭Q\xb00\xa1\xb2\xc2\xee\xd0m\xeb\xda~\xb5E\x1fQc\xf9\xe7P\x8f\x9a\x8e\f\x8f\x03\u07b3\x04\x83\x02\x9a\xd6a\xc8\xf1\x8b\xe7~\xa5\x05\xed\x90 ,\x13>\xb5\x1f\x93Mfv\x18ś\xe4tT\x14\xf9\xd1;\xd1{\bA;K\xc9*\xe8c\xab\xf92\xb8\x9af\x8d\x99i]'\xb6\xb7\xfe\xbaN\xec^\xb6A\xd6uB\x06\xc7z\xd8p\xcb\xe0}\xbb\xae\x13E\x85j\v̲<^,\xfc\xa4|\xad\x84ŶF\x91T\x0ff\x8b\x82\u038b\xe8\xeb\xc2Ƞ!\t\x13>4\xcd\f\x14\x1f!Ef2\x17n58-T\"zj\xa9#\x9cjVp\x10\xfe\u06dd;\x88\xed\x04 ڊ\xec\x91\x14+\xbea\xa2\xa4\u058b\x812\r\f\xa2\x05^X\xcc3@U\xab\xbc\xc0<\xd7\x00\xb7Z\xaa{Vz\xf6H\x1ewN\x98\x84y\xc9\xeay\x91\xb6\x85lj\xcc \x9fK\fSCv\xb4\x8d\x8eh\xe3\x92x\x13\x0e\xde>X\f[YQ\xe1\x9e\x1b_\x82eq\x19\x89\xfa\xaaH\xc4\xc7l,\x1e\x1czӈ`\xc5\xcb\a\xf6#]\x04<\xc8r<\xa16c\x134\x8b[\xc4Ќ\xc2\x11\x8a\xc2\xc1&\xb4#sq\xcci\xb1\x8c\xb3\xbc\x053\xf75v\x8e\x10B\x9b^\x81!\b\x85Y\xd7!B\rz\x1bz\xe3.\x11T\xc0C_\x9e\xd9\xf1*\x96\x8dv\x9b\xed\xc0\xfa\xe9\xfb-\x96\v\xac\xac0\xea\xf4\xe6Z\xea+\xb3>>\xd5Yrc\x85\xa3\\Z\x02\x8d2\xca65\x7fR\xdeb\xc27\x94\xea\x90b\x1d\x1a`\xbc\xacb\x06K\x8a\x15ד\x15\xf4\\\x87\xb8j\x15c?\xf1Z\xf8ut\xf1\x1bUV\x15\xbd\x82\xc0\x8e`\x19\x89\x90\v\xf2\xf8\xdd\"\xeaM\xcdV\x95,\xe4\x9c\x14g0\x99@\x13\xda\xed}!\xe5\x83-\xca,'$\x82\xa5\x83\xebq\xe6J\v\xf11X\xee\x92\x16\xe3\xce\x0e>\xe0*Zw\xe4\xa1\xeb2\x13OC<\a\x05\ue0fa\x82#\xd9\xde\x10\xfa}\xe3]\xdd%Ldk,\xcf\xd8\x1bL\xbf\x1c]9\x90\xb8-0V\x86\xc6Ҽ\f\x8cnѫ\xbb\xee7\f_i\xbc\x83\xe3Jٷ\x8d\x18|\r\x91\xe7\x13\x8b\xa7|R\xac\xb6\x9d%}3\x16\x95\xc2\xe1\xe1N\\\xde@~\xeamWB\x11\x81\x9c\xf5zē\x9e\xfc\xf1\x13\xaea\xf8e\xc4\x0f\xc1\xcfd\x11/W\x1d\xa7;\xe6\x98\xe1\xa3\xc5\n%_\xc74\r\xf2\x1c\xe2p\xc5=SD\n\xff#d\x95\x04â\x9e\x97\x7f\xce\v\xbd\x84K\x9e \x90\\\xc19\xa5\xa0\x0f$\a\xbc\xea&\xf3k+\xbc\x92q\xec%2\xbaK\xf3~\x16!\x14\xab\xae\xa5\xb3\xf9<\x93(d\xb4\x93\xbf*,\xd2\r1\x16\xba\xe7\xe3\xd9\xe5\xe8\x8a#\v-\xc8~_\xc3ʺ/\x87\x02\xf4b\x9fu\xef\r\xab\xc9th=\x99\x9d\x9a\x87\xd0[\xf2Ԗ\xbal\x8a\xe7k\xcaF\xbc\xb5\xf7\xb4iw]\xff&\xea\xb26\xaf[\xb9yUHÈ\xef\xc4Z\xe8\x14\xbb2\x7f\xae\x11]V\xd0į\x8c\xd2\x1e\x8d\xa2[\xf0]\xdapG\xf7\xfe\xfbϯvsF\x9e\xc3+\x16\x99<\xfa\xf7\x9f_=\x90M\xff\xfe\xf3\xabnN\x99\xa8\xbd̲\xa8\xf7\xf1\xcb\x04\xb8?\xcbhm\xa0\xc6\xe7\x18\x90\\\xfe\xf2M]\x02\xe3\xa1\xda(q$\x17\x8fL-2-\x12\xd2Q\x84\xf7\xd87\v\x86_A/\xcdn{ᠪ\xb7\v\x86\x1cU\x01,{\xc5*N\xb2z\xdb#\xb6\xf7\xc4|\x04I\\'s\bXY\x16\xa5\xc97\xd4*\x10\xc7\xdbUY\xd4\x05\xae\xd70\x81Wo\u07bc\xc1įY\xb2\x10\xcf\r\rT~\xa4\xcb\x18\xf5\xc2D'+P\x93\xb0\vi\xfd\xea\x801\x99\x84\xa7\xd4\xe2\xa5\xf4\bVeV\x94Y\xbd\xb5\x82A6h\x18\xa0o\x94\xf0\xf80\x8a\xe3\xd1{\xcf\xc0\xe1\f\xfd\xf4X]\x8d\xb9eeE\x17\xcb{\xe3\xc1h88\xee\xc9\xee\x10\x9c\xa2\x17\xeda\xa2~\xbe\xc1+\x11Nw\x89\xac@\xf5\x9b\xfc!z\x8e?\x06\U0009a795\xc6\xf56/R\x8a\x91owS\xe8\xa03*}U\xac\xb6\x92v\x93\xae7\xebi\xb2\x88+nD\x18\xbc\xe5\x1c~\xfb\x16^j\x9e\x12\\\x04\x8a\xf7\xb2\x16J7\x8aL4\b'\xeb|7\x0eu!c%;3\xcbM(*w)3\xaf\xcc:t\xaa\x92\x7fz/meʃ\x1d\x1b\xd7\xe7\x0fqa\xd3qÈ\x04YNz?ܰm$\x12/o\xd8\xf6\xca\xef\xf4\xc0ixK\f\r\xa4\xbb\x05\xafz\x1eW\x06;n\xd8֧\x89,\xe3\x15\xbe\x9b8\xcb6p()A\xc1\x94\x0f\xaa\xd1\x1fz\xae\xbb\x13\x13\x16\xb9\xf4\xa0\xbb\xb2\xf0T.\x1e!\xf8\xfb\xa3\x83\t\xdc\x1a!\x81#\xc1\x92eq\xcb\xda(\xbcaB(]dΒk4\x9e\x9ew\xe0\xcfK\x12%\x8d\x86ܰm彘\"\xfa\xd36\x90!\x0e\xf5⦟H\x8e\x92[\xa30\xc3}L¤\xd2\xee\x1bbG;1<{?r\x1a\xbd!\xb1s\xbaxf+e\x1cԢ\x8d\xe5\x14:\xb7\x958\x91\xff`\xeaDyN\x9eN&f\x9e\xe3?Vl\x1d,}\xce\xff\xa8\xe4\x0f\xed\xed\x11ȭ\x06\t\xba\x8c\x06\xcd<\xd1^\rIr\x1fD\xb8a['\xcc\xfbd\xe2\b\xe7\xab\xc2\x0e)\x0403\xd7\vĠO)p\x17\xa3D4\xeczی\xb7O\xccú6\x98@\xef\x97a/j\xd2\xe0\x0e\x9a\xe6\x8b\x1b\xe9\xf1\x80\xf8@\xa6\xb5\xf4\xf8\xad\xf8\x10Y|\x8bbΌ|\xeb\x17\x01\x8f2\xe8F&\x14\xeea\xae\xa9Cn\x89\xfc\xaeb\x1c\xe5%\a1K_f\xf4\x88Fz\xfcVl\x95\xec\xca\xe5_Qҙ\\\x8d\xf0\xaf\xa21q\x95\xb0\x1c_\xd21\xdb\xe3{q\r\x9e\xc3TE\x04\xc3x`2\\\x18\xba5\xe2\a\x1e)\xbc\x8e_\x9b\xc8S\xb6'v\xbc}\x1dK\xecx\xc1=\x16\xd8\xe9b}\xecǾd\xb1e[\x12\xeb\xe2\xcc=\x18\xa3t\xc5\xf4\x98\xbbn\x0e\xb5\xef\xde;}쇒\xec\xf6\x93\x1bp\xc0\xed)\x11\xd5\\\x98\xfccY!\xc6\xfa\r\x81^\xa6\nb\xe8\xc3\x12u\xd5\xc3\xc3w\xde\aPw\xe3\x14CE\xb4Qր'q]\xb5X\x9e\x96\xf0\x12\x96p\x0e\xeb<e\xb3,g\xa9\xcd\xcb4\xeb榗5t4\"\xd5#\xceg\x8a{'\xa4\xceΙe\x8b\x9a\x95\xba]\xb6h\x9a\xbdDov\x0e~]\xc7y\x9d-\x18'\x85G\xb73%6\x8c`p\x1a\xb6\xb6(\xdb)\x1c\xa6\xf3fCH\xa6\x0f\x95\b\xbaRhˁ:K\xfd\xfdw\x88\xe1\xf1\x04\xe20\x04\x84\xb0(o\x13\x84`j\xe1z,p}\xf1\x05\x1f\x87\x1c\xd3t\x0f\xb9ҔuH\xd3'\xa0\xb5\v{7\xf5\x96@ت\xe8\xe6\x7f\xf9ޤy\xef\xbfloz\xa8o\xedMe\xcc\xfe\x88\x0e\x8d\xe4\xeb\xf8\x1fӧ\xc9^\xfd\x9a|\\ߺ\xaaRS\xeeeN\x82\x8bl\b\x89\x99\xf3\xe1>\x92\x90\xfc3H\xc3'i\xaf\x1d\xce5\x8e \x01koZ\xc6yj\x84\xb3\xcb\xd1\xe2h\x06f\xf8\xbf\xfe\xcf\b\xfe\xef\xff\x8f\xad\x95\xfb\xb40\xf3\xac$\x87\xe70\x0e\xe1\xff\xfe\xff\x98and\xc6(\x84\xff\xeb\xffԚBӘ\xea\x1e\xa9\xf0\x17\xe9\xcb\xd6\xc0\x8f\x1b\xe9\xc7\u009b\x12\x84p\x00c;\"3\xc0v\x1f\xa0\x12&`ŭ\xf7\x84\x92|L\x017JZ\xb1\x1b\x87\x98\xff\xd7\xff\t\x87\xd8\xec\x03B\xa3Ü\xf4\xc7\xf2\x13\x0f>J\xd4-\xf4\r\xc5\x0f\xd6\x06bQ\\\xbf\xfe\xfb\xf7\x01bT\x92\xa0\xa4b\xc0\xa5!\b\xf7\xe9*3\xb6\v۬\x02\xcd\v\xc9\xf2\x96\x16g\xe5]\x96\xff)^,λ\x0e\x10\xbdu\xaa\rU\xc55Q\xae\x81ZOeTp\xe8\xf4|\xa3\xd7*<\x89si3\x86H\xb5^\xee\x9ab+\xe3!\vw\x865\x9f\xb6~\xa8^\xfc8\xab^ǯij:4\xb4bj\\\xbc\xb7&lb\xe9ԃ-\xbc\xd6ь9uH\xbd\xd2d\x0e\xdf\xe3\x1b\x81Ր;\xe8/*r\x8c\xc3\x10\x1c\x82+\xfe\x86\xda\\\x8eNr\xc3\b\xfe\x14Fp+\r9\xd5%A_E\x80\x15\xfd\t\xfa0\xb7\xf4]\x86/\xc4\xc2!08\x90\x95\\\xce\xf1\xdc\xf5\x16U\xda[\x93ຌ\xf3jU\xd8\xcf?.\xe3\xba\xcc6\r\xbf\xfb\xc1\xfbl%.ޥ\xc7\x11\b(\x13\xdb\xfbl\xe53\x85\v\xc7%\xcf\xf0\f\xd51\xab4fX\xa1_\xe4s\x90\\\xe7\xd6bB;\xef\xf7\xd9\xea{\x8e$\x82\xf7٪\x12\xe6\xc2/\xc9@\xbb\f)\x12\f\x97z\x8f\xb9A>6\x99SY\xf4\x14\xcc\xf8a\xab\x89$'$\xef\xf8\xe3+\x1a\t \xf0\xe5\xbb+k\xeb\xfc\xee\xca8\xbc\xf2,4\x88\xdfd\x95q\x85\xbci\x17i\xdcߵB\xd4\xc6\x11.\x00\x8b\"\x82y\xe3}\xcd\xc6Hz\x0e\xc7!,\nsfk\x85<\ta\x9e\x99\x0fϸ\x9e`\x8b\x02\x9e;\x95\nkm\x96\x92\x8b\x11\x1c\"\x86\x17/^ث\b\xd6'GW\x04\xf1\xe52K\xaf\",\x84\xa7\xdf\x1bA\x1f\xe2\xc0\x17\x02\xf8\xa0%B\x96Y\xda\x11\x9cgQ\xec\f\x03\xfc_\x93U\x1bx\x0e~v\x85\x9a/\x9cM\x16\xe7\xf6b\x95q>b\x18\xb7\fq4\x84S\xdb\x12\x1b\xf7+.\x94yL\xc1\xe3\xc3\xfc01\xb1\xd1\xcdx\v\xc6\xc4\xff\x13v\x96S\x80:P\x94\x98ee\xb5\x97&\xef_fd\n\x9d\xb6\xef\xb1I\x9bqڔ\r\xc0o<3]\x8c\x9c\x05#\x02[G\x7faG\xfd\x8bw\xa8\xa2\xd66f\x11\xff\x97n\xfa\xf3\xc9G\xb4=g\x95\u05cdD&\xe09\x8e\xac9e\xabzn\x93FIhۣ\xd3\x01\xc7\xfb\xa7,\x16\x8b5\xbaP\xf0\x1f\xbc\x15X\xa1X\xf0q\x81Dk\x10\xbd\xa1X\xc1K0\fD:\x9d_4/\xe3\xed\x85\xe3\xdf\xe0\xdfS\xf2\xd3\x14$璈;<\xbc\xa2Ŀ\xf0\xf3O~^%L\xf5\xd5W\xdb\xffζb\x19\x92gSR\x85\xe0O%\xb7m\xa4\xec\xe9M\x1db\x18h\xc9k_V\xccI\x12Go\x96iѝ\xb4\xf4q\x06\a\x0e/\xbaCR\xea\xfa*\xa3\xbe\b.ES\xa1\xdd\xean\x17\xf7\x1di\b\\\xb7\xa2#t\xcdť\xccŕ\x19eD\xb2\x8d\vI\xeb\xa1E\xe1\xf5G\x16G\x19\xc12^I\f\xfb\x8b\xd92^\x99\xa2\x11\x8b\x87]Q\x82x\xef\x8a_\x8e@\\\x98j\x8a8\x11Y\xc6V\b\x94\xf8\x1e\a7չ\xd9\n:\xee\x90M\xf1\x1d\xe6\x18\x8d\x13\xe4\x85\x10\xef\xf7\xf2\x87\x80\x0f\xe2\x01\xd2\x01\xd3A\xe7y\\\xec\xbes&F<>\x83\xab\x0e\x02\x15\xb7\xccϿ\x88\x1e壗\xa3\xc1\xc2\xeey\xb2\x18\xcbΆɘ7\x86\xb6\x93\x18\xe1\xd0'k;\xf0\x18L\xd5\xf8\xfc8\xf9\xd0o.\xa3\xe6\xd9e\xea\xf2\aK6Q\x19\xecP\xf8\x8a2eF\xf4q%U\x86L\xd2N\x01&@\xa0{W\xf4\x179{tU\xa5\x80\xf6\xc7\xcd;Ϋ\xf4\x02\xe8\xcc]\xb8\x9ct\xb9r\xdc8\xec1Ǝ\xe7\x90\xd9={$hߑ\xb2s\x8c,k\xbbm\xf0ȩ\xafq\x8e\xec\xadќ_\xe50\xb5+֧Ĳj\x8f\xa8:u7\x8f\x89\xbd\x95{O\x83\xdd\tE\x9c\x02K\xe2\x1e\x99\x83\xbay\xf0+i\\\xb1r\xb9\xae\x99G\x8b\xa1\xf7\x88\xec\xf0\x17\x02X\x8eus-\x15Н\xd1/dq\xbe\x83\x13+\x19/\x87\x87\x9a\x16\xa9\x12\xd6>\xa3*\xaf\x9b\x94\xba\u038d\xb4+ԾR\x83\xa4ȓ\xb8\x16[b$[\x9421cd՝\x9a\x1c/'\xdanH\x8d\xf8\x88Zu\x8b\x0eՎl]3ṅDԬ\xf43ϣ\xd7ɕ\xf3V(\n\xb61\xd6uu0\x84̣\x11\xd0\xee\xdf2u\x88s=\xd3E\xa22\xa3۵\xfbOx4G\xc2\xe4\x98o\xed\xbe4|e#\xba\xa4\xd4y\xeeH\xbb@\xf3Ƙk-\xf4sZ\xb7W8\xf6R\xad\x17F*\xf7\x00\x1e\xfaڂh\x03*\xd7\xe7\x80h\x0e\xe5uO&\xf0]>\xcbr\xf2\x8f\xab\xe7eqG\x9a\xe17\xe8\xea\x17\xf42\x9e\xc5\xf8E\x98\x9e\x11tUr\x01\x85\xe8F\x1c\xeac\x12]\x7f\xb9f\xe5\x1br\xa4V\x97\x1d\x89-\xa1qp\xcd1q\xaa\x0f&p\xc3\xf9\xa7~2\xfeS\xcb\x1f%Qt\x1f\xf9\x16\xfd;\xc9\x048\x14\x05xl\xde\x17\x84)\xe4\x14\xf2^~\aG\xe8W\xce%gW\xf9\xe7\xad\xe5\x1f\xb9\xb7\xb8,\xb1\xe0Q{,\xc1p\xc6w5(\xd9j\x11\xe3\xe3\xb8\xc7o\x05\xfcےE\xd0\xfb\xe5\x97\xff\xf6E/t7\xcd\x1a\x04&pt\xf9\xcb/\xbf\xfc\xe7/\xff헃_\x0e\x7fy\xf9\xcb\xef\xbf\\\xfer\xf5K\xf0K\xf8\xcb\xe0\x97\xdf~\xf9put-\xe9\x107\xa8\xf4\xbd\x95\br\x87\x90\x1c^\n\x03-\x02\xd3ñd>\xa3\xb4Uq\x17\x8cp\xdf\x17\xd2\x1b\xb29\x9c[\xb0\xd6쳙[\x06\xa6u\xb9@;\u0092\xf9\x9f\x9d+ٯb\xe3\xf1\xd7\x1f\xbe\xffS]\xaf~b\xbf\xae\xd5\xd2\xdb>Z$.\x98\b\xe4K~\x15\x89\xa2'\xeb\xd0N\x94\xfa\xc5\x17X͠\xb8ee\x99\xa5\xec\x87lI\x0e\x92\xa17\x95\x8a\xa8~\xfduP\xacX\x1e\xf4\xfe훟{\x11P[(\x98\xaf&\x8f\xc0\t\xb2b\xb5 \xfeO,NY\x19\xf4\xbeL\x12\xb6\xaa{\x9c8\x13g^\xb28\xc5\xd8\xca5K\xe6\xee\xdcaώ\bO\xd0oj\xbaE:\x99\xc0\x89\x86\xd0vo\xa2\xa0\x8e\xebu\xe5\t+\xff\xb8\x92L(Y\xb5*\xf2\x8a\xe1\xf1I\x85[\x89\xf1p\x88y\x15\xb2u8\xe4\xc9X\xc9\xf1\xf0\x04\xb7\xac\xecW\xe0!\xfa\xfc\xb1\x9ay\xbb1̯\x04QV^\x1eC}\x1f9\x900@\xed\xc4\x16{\xa2\x13\"%N\x1b~f\x9bں\x00\xb6{ru\xc4\xe6B\x85\xd7^\xda\xcf|}\x10β(\xcd&\xe9D\xa1\xd5\xcewU\x917\xda\xe9\xb6P\xf0\x83g\xf6p\xf1\xce\x12\xf2\x82>\xc2\xe2=\xe3\xb6\x15\x82yZ\x8f\xc9\xf0\x12\xfe\xdfo\xfe\xfcz\xc0\xa3\xd0p@\xabo>X\x84\xcd\xeb\xe5➄\xe1\xcf#,\xd7N\x11\xb2\x18S|\xa7\xa3\xe6\x1a\xe0x\x99\xff\x84\xc9֡\x0f&\f*\xb6`IMq$U\x81ia\xbc?\x00 \xe4\x88\xc3sd\xaf\x8a\x1cS\xd7\xf1\xe2\xdb2\xbe\xc6B\x9cHwWiq\xcfˣ\x8d\x87E\x9fVF\xff\xfa\xc3\xf7\xffX\x11\x15\xebF^\xfd(\xfd\byE\xd5\xed\xf59\xf4\xe6u\xbd:?:\xba\xbb\xbb\x1b\xdc\x1d\x0f\x8a\xf2\xfah<\x1c\x0e\x8f\xaa\xdb\xeb\x1e\xd7\xc47(\x0e^\xc0ѳgώ6\\Z8\xe8\"\xcbo:@1[\x82\xb6\xe0\xfc\xeb\x0f\xdf#\xf0\xd3#\n)\xb7\x8a\x13\xa6\v\xe4U;\xbd\x94}\xd43\rz\xfa-S\xee\x16ynr\x81#\xfdu\x1d/\xb2\x99\xf9̀\x19\x92Q[\xd80u@\xaa\xfd\x9fgA\xef\xbc\x17F\xa0\x9c21\xcf\xd4Բ\xc6\xd39\x16\xa8\x1d\xdc13\xe4\x1cs\x9b@\x99q_\xa4q{\xceh\xd0`\x1eW\x7f\xbe\xcbե\r^i\b/M%\x11\xf9i\xb1ᒃ\x19/\xb2\xd25\xe6s\xa2B\xd6\t\xe7F#\xcd\x03\xd94\xabVt\xbb\xc0\xb3v\x91\xd4\xe9|aX\x94)\xee\xe6\xc2w~\xee\xee\x18d\xd9K\x8f\xeb\xa8\xcc{\xcbn\xe9.\x9c\xf8t\xee\x01\x89T\xd3\x1d_\xa6\x19\x1b,{6\xaf\xe9~\xca\"\xabj\x96\xb3ҵ\x84S\x01%\x18\x03\x14\fэ\xbd\x9e\xd6\x102\xdb*/ \xa8l[G\x8bk3\x0e\x8c!1\xce~\xa4\xb9;\x80\x97\xdc\xdf\x18Q\\\r\x94h\x9f{R\x8d\xf6\x99s\xe3\xacq\xb7\xb2Z\xb1$\x9be6\x1f\x96\xa2\x8bѯ\x98J\xbc-eX\x19\x05\x1e\x01F(!\v)\xf6\xe0\xe8\nՌ\x1e\xf4\"\xa8\xb2\xeb\\\xa5\x1f\xf3t\f\x98b\xc1\x9f^Ep\x97\xa5xv\x00\x87<\xe9\xec*\x82\xa4X.c\x05\xf4\xe4\x8aFe\x92\xe1\x85\x1b\x95\xfa\xf4*\x92\xcc\xe4\t\xcfЪ\x87\xfb\x10\xee\xc9\\\xadg¹\xba\x17\x81ئ`\x93\xe3E\xc5t\x0f*\xbc\xa1Uš\xfa\xb0<\xbdu9j\x86q\x12\xcc\x1b\xd5\x1b\xf6\xac8\xa8،P4\xb0o\x9d\xc0\a\"\x11F\xa8u\x9fX}_\xddet\xb3Ǽ2\x03I\\1\xe8\xe5\xbds\xb9\x96\b\x16\xa1\xce\xea\xc8V\xefZQa\x06\xa2\xe6\x18\xfeUaP\xbc\x1a\xaa]\xa4\xe6ٿ\xf6\\\xa4\xb3\x0e\xa4\xab\x87\"-;\x90\xa6\n\xa9\xee=\xb3\xb5f\x7f\r\xdb\xd1T\r\xda\xfa\xa3\xbd\xc8Ы:\a#8T\x02\x1e\x1bB\xe3\xb2\\|\xeb!\x83\t\x15\x9d\x94\xf0\xee\xfc\xfdw'\xf3k6\x8b\u05cb\xfa\xc2{\xefչoAs\x8e`\xc6\x17_\b{ʿ\xa2\f\x89\x82\xbd\x9eyP\x90\xb3k\x8a\t$\x8fmpG\x8d\xe58Z\xe4\x84\xc0/C\x1a\xe1\x985\x05\x98s\xccyo\x8c_\xdc\x12+\x9f\x9aM\xf8\xb2\x13\xa8Kx\x92C\x8e!\x86\xeerѲF\xb8\x1d\x8b\x8c!,\x12j\xbb\x9c\x16\x8bVk\x10\xc7y01\x03'8\x87?b\xb2m\xa7\xcc3\x9cy#\xe5\xa9&\xc7#g\xe0C\xc5\xd6\xc6a=bR\xf34\r\xf0P\xd1\x10hG\x109\xf4\x15\xc2Q(\xa2\xffr*\x0e\xcd\vV\xce\\\"\xd1i\x19\xba.\x8b\xf5\xaa\xc1G\t'iu\x91z\xedj\x9f\xb8\x8e\x0e.~n\x9e}\xf0\\\x8c\x82C!]\x17~\xa7\x05\xb5ơ\xf9%xy\x1e\\\xfe\xe7oW\xe1\xcb\xe0\xf2\xf9\x8b\xc9\x7f^\x85\xf8\xeb\xf0\x97>`\xd2\x1f\u0097\xc1\x10\x13\x86\xfdgW\x87\xe1\xcb \n_\x06\xbf\f\xd4\xe7e\xdc\x7f\xffe\xff?\xfe\xf5*|yԬ\x81\xa6\x04\xe1\x87\x14\xaf\x84\x9d\xfc\xfaܲ\xe1\xac\x1a\xc7D\x18\b\xf5G)\xbc\x81|?B^\xf3ڧ\xf47\x9bU\x91\xb3\xbc\xce\xe2\x85[~\xb6O\xf9o\xb3\rKݒ宒\xd2HE\xb9\x96T\xa9\xa1\xc8\v\x86\xaa\nZ'\x97\xf1\x06\xd5\"\xfe;˃\xf1\x90\x80\xcc\x18\xf3a\x83\xb7|\x16\xe26d\xe8\xe1\xc5\xe6\xde{\xfc'\xc6\x7ff\xf8\xcf\n\xff\xc1}y\xef\xff\xf7\xff\xc5\x7f\x97\xf8\x0f\xfe\xff\r\xfe\xf3\x03\xfe\xf3o\xf8\x0f\x1a\x85z?\xe2?\xdf\xe0?\xff\x81\xff\xfc\xad\aW2b\x87Y_h\xe9Uj7f\xcf\xe2\xe6\xd4\xe3\xba]h\xbd\xc23᫩;\xd4\xd3]߲!\x1b\x88\xf5\xd8\x15\\\x17u\xfb\xb8\xde KO\x89\xb8\x82á\xa9\xb0\xe0S\xc1c8\xd4N\xb6\x82\xd2#\x9e\xf2\xfd\xeb\xd1\xd0*\xae\xfa\xb0?>1{\xf1$2\xb1b\xf8\xf9\t\xc5\xd4#-\x19\xce!\x93:\xd11\xba/\x1e\x87>\xad\xb8\xd1ݗO\xe1\x102,t\xe5\x8ej\x16W\x8c\x1e\x1e\x80\x89\xfa\\\x15\x8bm0\x0e#\x95\x90\xac\xa7Y\xe2\x02\x1c\x1b\x00)_\xa4;n\xe6K\xc8,\xc5\x11Vo}t\xb8c\x9e\a\r9wk\xe1\x03\vi8\xb7(R[\xdb\xf4\xbc;\xb6\x89j\xb35R\xa9\x91\xfb\x94$@\xabh\x95\xe5\xfb\x14\xac\xb2\xdc*\xc66\xab}\x8a\xb1\xcd\xca*\x96de\xb2`{QJ\x90v\x9d\xe8\xeb\x84\xedT\xd8y\x02\xcfE\xeb\x8b\xce\xc2/\x91^\xac\xf3d\xaf*9d\xcb$D\x10\xcb\"m\xf4t/\xcb{\xe7\r\x19\xe1u\x17\xebZg\x95\f\x03\v\x88\x97UzY\xde/\xd6u\xcf̞\xa1YMd\x17\xeb\xbaO\x88\xbd\xc7\xdf6ݢd\xe0T\x14̚3*\x9e\bǶ\x0f\xafi=\xf1\xdbN\xfa\xb8E\xaea\x02\xdcL\x02/\xbdF\x11aq\xe0~\xb8m\x90|\xc3\f\xe7\xc45\xa1V\xeb\xc1\xc9ui\xa9H\x9bㆃ.abu\x05\xc1/-xs\x8c6e\nC\xf3\x04\xcb@\x9e\xc4\xd2+\xff\x8d\xc3\xdaj\x91%Lz\xad)?\xe2Q(\x16(u\xb2~˯\x0fI۞\xf4\x91\xc6\xe9\xcb>TĖ\x9b\x1c\xbenZZET\x88\xd7o\x02\xb2\x82\r\xa4\x8a|{\x1d\xe1\x06Ķ\x89\x04\xfbU\x05p\x8d\xa7\x1b_\xd6u\x99M\xd75\vz\xaaPO\x86p\r\xcd=\x05\xb6\xe6Zc\x1eL\xe3\x8a\xfd\x05\xe3\v\x16yU,\xb24\xaeY\xd0\xf4@\xe1\x01\xebd\xa1\xa0\x86\x97P\x0f\xb8\xcb7\x9c[y߉\xbeQF\xdd\xc0 B\x1awt\xf5\xbaGd\x8c\xf6\x8e9\x9a\xb7m\x81$\xf6\xe0\x90\xdfsWI\xf4\xeaFY\xd4V.\xff\xa6\xac\xea\x86\xdd\xfdU\xe7\xe0'O\xa7}\x8cN\xc7O\xca\xe8\xb9K\x80\xa2\xfakv]2RRFO\x87r\x05\xfd\xf1\xbb\xc8\xcb\be\xec\x8c\xcfa$f\xabs\x18\xf2_\x89\xfa\x95\xaa\\\xa6\xd2f\xe704\x84шA\xd5v\xcbY\x8em\v\xba(\xf5M\xf0\x99e\xc2\xeb\xf7\xc5\x18\xc6]q0\xf3\x14\xbc̮x\x05a\b\xf6\x16\xf7\xc2O\x19\x0f\x97\xdeF\xdf\x14\xcd(\xb1\x7f\xaf\\7\xe6\xbe\x18\x0ea\n\aP\xbb\x1a\xbf]\xe5O\xee\x19\xea\xc3k4\x8eNU\xe5aw\xedM\xa9u;d\x19A\x16\xc1\xbb\b\xaa!\xf7ѭF\xe2\xaf8\x91\xffU\xfc\xcd#\xe9}\x98\x1e\xbf5\xaa\x10ט}\xe1\xa3ɍ\x87\xeb\xa0r\xe6\xf4\x14$\xa3\xdf4t\x9eå3R\xbe\x02\x84 <,\xa6\xc6<^IR%\x90\x9a\x17~u\xdd\x0e\xb3sP2\xa6\x127\xe7\x14f\xae\xe1\xf7'\xaa\xb2\x8e.\xab!Lv\xb5\xda5\xebTCx\x0eS\xe5E\xe2mA\x18\xba|\xe2\x96\xed_E\xb1\v\bv\xb0-\x0eC\x1c\x1e\x19\xbf\xf7a1\xb0@D\xc6E\x0f$\xaa\x18l`\xc2\xe3\xeb\xb9>\xb8\xc5\xc0\xf3\x16quY\f\xf8\xc3\xc3\xf2Τ\r\x03\xc0!\xc8o\xefp\x02\x85\xfbl\xbdz\xae\xb9\x18dv$_\xd5\xeew\xb4X\xd3m\x8aw\xb2\x19\xefB\xf8\xf5\xf2\xdd\xd5 \xeb\xf7w>jݠ\x00\x0eA\xd3\xddE\xce\xf8\xde\xe4\xe0p\x1d_\xec\xfb\xe0\xfd=8x\x05\r\xe6\xb57\xf6\n\xee\xd3N\xa0;Z\x1f\xcdzυ\x8d_\xbdoq\x03\xe4fQ\x03O\xa3E$\x8e\xde):\u0bdc,\x8a\xb8\x0e\x96\xfc\xc1\r#\xa5\x18\x18\xc3\xddtN\xf2?;F#a\xb0*V\x81e\x96\xeb\xee\x1a_\xb74\x1a\xb0GwtuŇG\x0eÌ\xe9\xa3\xe5z\xa1t\xff\xb9\x1c*\xaa\xe1%\xfc\x8a\x01\xbc6\xd0\xfd\x96\xf6\xd4sI\xb2s\xd91\xa7os\x86\xa9.\x039\xb9\x84\xaa\xf9A\xdd\b7+\x1e\x1d\xeb\xedX\xa3~\xf6)\x92\xee2\xe5Y\x8d\xbe䲣\x15\xbf8\x8c\xe0+7q\x8a;\x87\x18&\xf0\xa5\xd6\xc6\"\xa8\xa70\x81\xaf̔\x92\xc3p\x9d,\x82\x92\x03\xc8\xcf;\x9e\x8bzY\x04w<\x8f\x7f܈\x1c\x1e\xa9\xf3Fdi\x831\x99\xf8c\xec\xae\xc7\x13\xa8\xa7\xf8\xe3\xf7ߡ\x8e/G2ed\xcc\xc5b\x990u\xc9\b\xf8&\xa1\x17\xe9\x9fa\xafs\xb5\x1bY˜w|\x11I\x11'(TW\xd3\xc0Bs\xbc\x17\x9a\x11G3\xd2hB\xebJ+1@\xb5{gs\xe1\x10;\xe7\xd0h\xa33\xead\x99^\xc3?\xa2\xa4\xcb\xfc\xe5\xd4q\x84\x8a\xa1\x8f\xdd\xf9\x02\xf5\xe1\x10\x7f\x1dN\xe0\xf8lhx{\x95S\x04\x89\x15H,A:5\nJ\xab\xf8\xbc\x82\x04K\xf5\xde\xec%\xe8\xc3x7\x17\xcb\x18\x05\xae\x83\x7ff\x9b\xda+\x86Cj\x9d\xc9:ś;\xe2͝\x81g\xbfF\x89\x8d\xc9\xfd\xdbt\x17\xe3@\xe9h\xd3]g\x9b\xf4\x86训I7rT\xddH麑\xa3\xea\xc6\x16\xb3\x1c&\xbe*\xf8\xde\xea\x01\xe3+\x87>\x9c\xecf\xc1\r\x1fc7]c,ߏ\x9b7|\x9c\xdd\xec\x18gT\x152`D\xec\x98\nv\x8c:9\xad\xb6\x987>N[\xfa\xe8\xae\x15ü[V\xb4\xdd\xfd\xfa\xc4\xcb\xc7O\xd7Ӷ\x85#\x16\xb6\xea\xebi\x10\v,S\x9d45\\\x90\xe3\x92.Ȗ\x11\xc4\xd7\xf4\xeb:\x82xJ\xbf\xa6\x11L1w:(\xa1\x0fq\x19\xc1\xf4\x9a>\xaf\xf1\xf3:\x82\xe9\x94>q\x0e\x89\xa7\xfb\xee\xe5z\x7f\xe8\xf1\x97X\xca\xeb\xe9\xdb9\xdb\x04\xe6殄C\xac\x13\xb7wa;\xd45B]\xef\x82\xc2>\x9d\xf2\xad\xe2\x0eF\xfe\xa9Z\xec`\xe4\xbcZ\xb8\x8c\xc4$\x93\x91\xf3!1m.\xf6\x93\xf1\xa0\x8a`\xc1\x7f-\"\x98\x8f\x88Ux\xd66\x97\xfb\xb7頂>\xe0vn\xc1?\x17Ї\x85qj1\x1fɉy>\x82>\x9f\x98\xb5\xc8\xcfG\xf0\x1c\xfa2ۜ\xb7wwBz\xfcv^-\x90m\xc1|\b\x87\x88\xe0\x00j\"\xfd\x10*\xf1\xb1\xc0\x8f\x05}\xd0x\xe9us\xf1\xfbx\x978.\xe2\x868b\x92%\x8e\vɱ8\xa6_\xb1%\x8e\vŧx\x11\xc14\xa6O\\\xe6\xe2\xf8\x81\xe2\x98\x1e\xbf]\xc4S\xe2D\xbc@\x89Y\xf0\xc6\xc7dh\x88Ň!K{p\xe2O\xc9NyJ\x9a\xf2\x94\xd8\xf2\x14ϥ<\xc5\x14\xa5h\x90D\x06w\xa6s%O\xf1<\x82iB\x9f\t~&\x0e\x9f\xb4<M\xe7R\x9e\xa6\xf3\xa6<M\xe7J\x9e\xa6\xf3\a\xc8S\xb2xK\x1d<G^\xcd\x05\xe3\x12\xfcHć\xe6\xef>\\\xfcR\x04\x8fkՌ7B#N\xc4\xdf<6\xee\xfaG\x90sqP\x9fCu|\x86Q*T\xba\x84\b#Ț\xc6\x1b\x9a\xb9\x87B\xfd߈\xab\x80\x16\x99ALWn\xa6t)\xd7@ \xcaƢl\"n=)\xbb\x84\t4\xb5\x81\xa6\n\xe8>{\x94\xa1\x8dd\x836\xc2\xc6ڒt\xf3\xfc\xcf\xf2\x9eq\xa7\x19\xf3\xb7\x0f\x9c\xe9\xf8\xf7\xc6h\xcd\rd9Ķ\x12Ji\xd3\xd0\\\xfb/o\xae\x1a\xb6\x9d\xaf\xb6\xaf\xe3%\vn\xc2 \xbe\xbc!v\xde\xe8\xfbǍ\xddg\xc2Q \xa8o3\xac\x89q4\xe2ǒİ\x89lڂlW\x17\x10\xc6,\x94h\xb0u{\xb0]خ\x9b\xd6-\xba\xa4\xd2?\xbcz\x19\xbc<\xff%=\xfce\xf0\xf2\x97\xf4\xe0w\xfas\x18\x06/\xcf/\xd97W\x94\x8f\xdf/Յ\x15\xcb:\x8c\xe3\xc1۱ю \x9cY^\xd5q\x9e\xb0b\xc6Of(\\psP\xf2\xc2Ԗn\x8c\xf2\xf5\x91\x986\xf3=n\xfe\xeb\xd1v\x88gL\xad\x8c\xb0Y\x9fxY-\x16\x11\xc4IO\xebݧj\xbb\x06\n,I\xda\x02\x7f\xac{\x1eW\xc1\x94\x0e\xb1\x8e\xfe3\xf8\xc3\xef\xe5\xf5\xf4\x97\xe0\xf7y\xb5\xf8%\b\x8f\x065\xabj\xcc=\xb79\x93\x1e\xbf}U,\x8a\xd2C\xedO\xd7SN\xcb\x1e\x04Z\xa1\x91\b\x97H\x99b\xcaԃ]hĺ\x02\x1e\x11M\xd2s\xbfC\x1b~\x0es=\r\xf4T\xac.a\xd9ze\x19\xc1u\x93|\x7f\x94\nx\t\xa5\xc3+\xd4R%׃\x12\x15\xcd\x12u\xccr@\x9c\x15\xbd\xc1oh\xf4h#\x17\x89\xc4\xc8\xd0R4h\xf0?\xffg\x19\xc1\xff\xfc\x9f\xd7\xf8ϴy\xdb\xebz\xfa\xa3\x11F\x9f\xd7?0#\xeb\x8bS3b\x99`\x9fYh0\xa5\xe8&\xf6Y\x89\xba\xd4pc\xde\xef\x1a<\x89\x9alx\t7p\xae,]H\x15b\xe2\u070e\xe0Z\xfe\xbe\xe6\xc18\xe8\xf7\x94{w\x1f\x1b\x9a\xdfcrD||M\xffNCc\x99E\x16dtr\x91\x19~\xb3\x04^\xc2s\xc8B\xaa/\xd3Y\x84\xe3\x9ag]\xdbYS\xa0\xb8\x8d\x945\xd5Yve\xda\xc7\xe4\xf4\xd4r2)\xe9>_\x18A+\xc4\xf5N\x88)\x87\xb0N!\xad\xdeH\xe3\xf2\xe6\x13\xf5\x85\xa7Y\x9c\x88\x1b8\xe0\x1dQ\x86\x11\xf8ү[ҧ\x1d\x84\xcf\xed=Eӭ\x85\xb6,\xd5\"\x90\xa2!\xc5B`nE\xbcρ\xac\xbb\xc5\x12\xad\xf3$^\xfb\x12\xbdÊϘ\xae\aF\x8c\x16\xde)\xdd<\xef\xfda6\x9c=\x9d\xcd\xc4\x05\x948\xaf\xb3_\xd7\xecn\x9e\xd5<3f\xd3\xf4\x89\xcc\xfcu\x1dc\xe2p8\x9b\xe9\x12\xbf\xae\xe3e\\f9\xc1?\x99\xcdf\xe9\x89\xccz\xbf.e\x15\xba\xc0\x94e\xd7<\xf5tv\x9a&25\xab~\x15\xf4\xcc\xd8I\"QL\x17䤂u\xe2\x7f:5O\xe6,\x8d\x17\xcb\"OE\xa1i\x92\xaal\x8e\tK\xe8j\x17kv\x9b\x15\vVc\xd6\xd3x<ec\x99U\x16w9\xa6Ƨ\xe3x\x1c\xcb\xd4u\xb9\xd8\xde\x15\x05\xe1O\xd9\xf4\xe9Sɇ$NY-+9\x9d=c\xb1$\fc\x92\xd7%[W\x8a\x19C\x9dU$\xb4 \x10\xba\xf1ٳ\x91\xbc\xf4\x93\x14e\xbc\xe0\x8dx2;\x1d\xea\xd4|\xb6\xc0\x87^eMg'\xcfNYjdW\xd9↗\x9b=U\x8cL\xcalY\xd1[\x1c\x7fH\x93\xd1ɱJ\xdf\xc6y\xa3\xf3p\xa0\x9a\xdcz:52t\x89\xa7S+\xe3\xbaX\xa4,/9g\xa6O\x9f\x9e\r\xad\xdc2\xde\x123\x9f\xe1\xff\xac\f\xc6\x04³\x93\xe1\xd0\xce\xf1\x17\xb9\x99\xc77\x19ՒN\x9f\x9c\x99\xb5,\xe3k\x96\xd7$\x8eO\xa7\x0e\xe1\xc5\"\xbbe\xaa\xb6\xd3ӳ\xe9\xd8lpA\x97\xec8\xe3\x9e&\x16!E\x99\xcc3jֳg\xc7\xe3$1\xb2J\x96\xcaʬ\"\x15\t!f\xb1g\xcfΞ\xc4f\x16\x8b\x15\x15Og\xd3\xe4\xa9I\x05ُ%\xefO\x9e\x1e\xa7O\xa7n\xae\xe4\xe4xv2;\x995s\x99?\xb7^\x97\xbf\xae\x8b\xac\x12\xbd\x9a\xb0td\xe4\xea1\xf0\xecd8L\x8fe\x16c\xab\x15\xbf\xd8\xf6\x87\xd9lt\xf2\xcc̨n\xb6ZJ\xa6\x86\xf8dKI\xe3\xd93\xfc\x9f\x99\xce<\xe9Ez\xad\xe5yĞ\xe9\xe19\xcbJ6-3>اc\xfcO\xe6,p|\xe8\x19i6\x8bg\xb2\x03fEɪZ1y<~:\xd5\xe5\xd6ɼ\xcab^Ƙ\b\xae\xe3,\xaf\xa6EY\xf0!\x82\xff\x939\xf3\xa2\xaauEO\x8dy\x11E\x9ecJ\x9f\f\x87F\xa2\x1a\ai\x1c\x9f\x8eU\x8e\xe0\xca\xd3!\xfeO%*\xf9\x7f:\x1cZ\xa9[\xb6X\x14w\x98\x17\xa7\xb3\x99\x92V\xc9D\vͼ\xc8\xd96ewjb\xd5\x19\xb5\xee\xc1\xb3gS9\x87f9F\x89\x17\U0009b927\xc9ib\xe4\\\x13\x1fNp\fI\xcee\xb7E\xb9\x15\xbc\xd6\xe8\xd5X\x9c\r\xd9\xd9S\x89b\x11߲<e%f\xb03v6\x8b\x9d\x8c\xe9b]\xcd\x05\xb2\xe1\xecT\xe5\xde\xe5\x8a\x1fO\x92\x99\x1e\x86\v\xb6,\xf2d\x9e\xcdfE.\xfb[\xcd\xec\v\xd4\xf2\xa4\xfc\xc4i\xfa\x94\x9d\x999z&5\x19Ƴ\xc4d\xc6\xcc\xe9\x8frT/\xeaN\x98ų8\x1d[@\xa2C\xd3c\xfc\x9f\x9d#Z\xf1l\xc8س\xa1\x93\xd5RH\xf7\xd3\xf4,\x19\x999z:\x99\xcd\xe2\xe1\x93\xd8\xca3\xe6\x93\xf1p:\x8e\xed\\=H\x9f>I\xd8\xcc\xce4\xe7\x93'O\x9e>}\xf6̓\xcdڲk\xc6\x16\x12\xf9t\x98\x9c\xa4\xcc\xcc6\x187\x9b͘\xe6\xc1\x92\xc9\x15gh&\xaaF\x1c\x8f\x93\xf4X\xf39\xe7\xa98\xbeU\xbf\x1a\xf3\xbc5\x8a\x97qYp>=55\x03|\x11a\xbd\xb4u\x92\xb3\xb3$\x8dc\v\xc0\\\xf3\x92\xd4\xca\xd2K\xc04>=M\x8f\xad\xccպ\\-\xa8\xe4\xb3\xe3'\xc3tje\x9a\xdds\x9cL\x8f\x9f\x8c\xecls\xc2\x7f2={ʘ\x9d\xbfB\x05ј$f\xf13\x9bjkb?y\x9a\x8e\x92\xc4\xca\xe7S\xbb\x1c\xe8OF\xa7O\xe5p[fin\x0e\x9dѳѳ'\x8agY^\xa3S\xe5R(e3%:ˬ\xaa\xb7eQ)\xbd\x8c\xa9&\x15I\x12\x93'4Ϙʚ\xf2\xf86~W\x18\xb3u\xca\xe2T\xe7m\x95\xa6!k/\x16\xe9\x82.\xec\xf6\xfe0Kg\xa7\xaa\xe3i\x11\x97\x93\xdfph\xa6\xa6e<\xa5\x8e\x9d>ec\xd9A\xe6\xaa\x1e\x9f\x0e\x87V\xb2\xe0\xc9lvb\xe6ȎN\xe3'\xc3Tֻ\x8a\x17̚\xd8\x19cO\xe3\xd8\xccU#\xfe\xe9l\xfa쩑cuP<c\x8c1#\xd7\xea\x9et\xfad\xa8\xd6\xd8U\xbc\x8a\xb7\xf1\xdd<[\t~\xceR\xc9\xcf\x15\x8b\x93\xf9j=\x9b\tn\xc6\xd3g*\xa7\\\xf3\x19\xfd\xe9\xe9\xb1\x1c\x16zbI\x86\x89\x14\xcf\xd5bM}\x9b\xa6\xf10\x95\x9d\xb1*\xeeR\xbd\x16O\x87L\x8f9-\xe5O͞RL4\xc6[YT[\xa5>\xa3\x86\xa3t\x9c\xb2\xd8\xc6j\xce8\x19\x9d=S\xb2S\xc5i\xba`\xaa\xd4\xd3\xe9\xc9\xe9\xe8X\xe5\xa9\xe9/~:|2V\xc9y\xaa뙝\xc4'g\x92\x02kRdO\xa7\xa7OtF5g\v\xa1Y\xcfNU_T\x19\xcbs\x9aQ\xe2\xe1\xe9x\x9c\xaa\xe4\xc5-_ǒ!\xfeO&\xdb\xd3*\x93L\xb5\x86\xf3Y|\xaa\x17){\xae\x1d>\x1d>\x1b\xda9̓\x93\xab\t4Vï9#̞H\xf6ZS\xf2\xc9\xd9ӱZ\xeek\xbeХ\xe3\xe9\x89Z\xa5k\xc6\xd7š\xb9.⮱\xe6\xfd\x9c>\x9d\xceR)\xc9u\xb1\x8c\xebB\xa8\x11\xc7'\x92\x9f\xf6\xec3d\xc3T\"\xd2*%cOǊ\xcfws\x16\xd7|NI\xd9\xf4X\xa5jEN\xafĔZ-\x8b\x1b\xb53T\xaa\x82\xbd\xba\f\x87V\xb2\x1e\x8b1-%\x86߾a0\xdc\xe3\xfd.\v^\xbdwg\x19\xba\xf4\xed!\xd7\xce\x15Z!{\x1d#\x02\x9e-F\xb0\xd8\xd7\x127w,qx\xcc\xf9RT\x16\xcc\xf1di\x8e\x87\x94\xf3\xc1\xc2o\x89\x9bG\x86\x9dBR)@\x11\xc5\xe1<\x82\xc3*\x82\xc3E\xc3^0\xaf\x16\x8e\x19\xeeO\xd5b\x97\x19\xce,\xf4i\xcdp֩'\xb7q̅\xa5\xa5\x12\x7f\x17*:\xd5\a\x1f9\x9f\xc5\x0e\xe5#F\x19\x97\x16\xed\xc48\x06ڦmI\x9e\xed\xfa[j\a\xbdI\\\x11K>F\xc4\x12)b\x89\x12\xb1D\x89\x98\v\x8d'\xc6/\xe5\t,/\x81G\xe5x\xe6;W\xe6a\x99\x17\x88\x98\t(\x8fx\xce(\xbeɆ=\x0fC4\xa1\xcd\a\u05fcd\xe8\xc13O\x84\xc4&~\x89M\x1a\x12\x9b\xec\x96\xd8d_\x89\xb5\xceI\xed~I\f\xfb\xe8h8T\xf2x([\xff\xdf\xe1\x00\x82V\xa1\xb2m\x90\xf3d?\xa1݇\x1e~STP\xd3\xffhjvK\xad8Av\xa9\x11R\xcbO+\xec\xf8\xdb\x16\xc6\x05\x8fq\xbd\xaf\xec.:\xa41X\xa0\x04-P\x82\x16\\\x82\x16\x1d\x92n\x96\xc0\xd13\x98\x1b\x13*\xc9\xeaB\xcb\xea\x82\xcb\xeabp\xadp\x8b:\x0f\x17\x11\x1c\xc6\x11\x1e\xfe\xb8\xd2\xc9\x19\x8f\x17[\x9cĿ\xc2\x04\x06\xcfN\x87'O\"\x99\xf47\xa0@\x1d\xe2\xeb?\xf0k0|\xfa\xf4\xe9\xb1]ԑ\xf6\xef\xe3\x9d\xc7$f\xa1}\xa5\x1d\xdb\xf5Q\xd2-\n\xc4^øE\xd1^Ү\xe9y\x90t\xefO\xcdNi\x97^'\x9c\x82vĢ\xc7x\xe0/\x13e\xe5\tI8\xf8u\xcd\xca\xed\x1b\x82-ʠ\x92\a\x91\n\xc1\x97\x8b\xc5\xfdp|\xb9Xx\xd0\xfcT\x14\xb5y\x93\xceyaـ\xfc\x01\x83\xc80\xf1\x8e\xa6.<\xa0\xe02\xac\x92Ո{\x84\x06\xc0\x1d\x9b\xded\xf5\x0f\xbb\xc0\x96\xc5\xfb\xdd0\xd5N\x90\u0081h\xb4\xc0\n\xe3\x9bGP5{\xd4j\xb0\bZ\x1f\x81`\xde\xc5#\x1d\xf4\xa4\x98\xc1\x9b\xec\xfd\xfb\x05\x0fNؓh{\x86\x06\xd9\xddݪV\x8e\x86\xe7\tWP#\xdeم\x8d\xad\xa3\xef\x1d\x84\x83u\x8eg7o0\xaa\xb8YE\xe8G\xac9$\x8a;\x9d뼕\xcd\veEnNA\x97W2\x16\xaf\xcc\xed\x1e>\n\f\xbbϊ\xe5+3\xac\xe9\xcc[\xad\x18\xb7͌\x81\x87\xfd\xa2-\x96k\xfezJ!<T\xf0s\xf1M\xbf\xf2\"e\x11\x88o\xfc\xb8\xb0\x02\xdf\x143\x90(\xe1\xb1#\x05*æ\xfbm\xa5Ƶ$\xc6\t\x1a\xfd\xcex\x8e\x87\xcf+\xe26\x15\x7f \xc7zeG\x11/\xfcb\xc5'5E\xdf\xff\x12\xa9\x18B\x11\xef\xc8\xf2;\u0601\x84\xc4:.\xdf]\x85F\xf6\x85\xfb\x88\x8f\x19\xb8\x8c\xe3\xd2De\xcdW{\x90?9\xaf\x86\xa0\xe9\xa5\n\x9dm\x92$\xc8\x16В'b\xe0\x11\xf7\xf1\xdf\xc1۷i\\\xc7o\xdfRXd\xf7\x15\x17Y\xfe\x8b/\xa0'\x01{\x90\xe5T4\x94\xfd\xa8p\xc0\xc4\xc6\xd9\xf9ƂM\xa9u\xaf\xce\xf7\x8e\xb1W\xbaU\xbfX\x01\xab\xdbE\xd6\x1d\xe3\xf7\x92\xdaO+\xa5\xb4r|\x02AU\x85\x1c\xa9\x8b>\x9bh5\x87\x84|\xe1?\xd8O\xca,1\xf3\x8f\xa1\xdc\x18+\x9fA\x1a\xe2\xba.\xddH\x03\x8e\x9dbw\x98lC\nr\x8a\xc1g\xf9j\xd9Q\x9d\x04c\xa93\xf1wЌ\xd4ȯ\u05cb\x18\x92DQ\xf3M;L\x1dPLEx\xc9\x19{m\\\xa3\x7f\xfd\x86\x8a\r(0cd\x00S\x04\x04\x17ڮ\xe2\x83-N\x8b5\xa3a\x8e \x9cj4\x8f\x06\x96$#\x13\xa5\xad\x06\x01/\xe9\xf7U\xe8z\xf7aq\xcfͲ.\xacf\x7f\xec\xea\xcbd\x11W\x15K\xff\xc1\xddɇY`Fݬ\xcbl\x19\x84<z~p\xf4\x9f\xbf\xffR\x1d\x1e]\x87\xa1\xa8\xdc~A\xd0\x0e\xbb#\xa6Qj\xd9\xf7YU\xdb\xe3\xd0\xfb\x06 \x15\xc4x\b5\x9e\xba\x12\x1d\xfc1A頩#\x10BǛ7Vկ\x8dX\xa4\x16\x812\xfe\x82\x0e\xda+\v[\xd9\x17\xbb\x88\xb6:^\xf4\xe3OL\x11\xcf]\f\x85\x14\xb45\xc4\x1d\"f\xb0\xbe\a\b\xb5\xa0\xe2\xd3˵D|\x1fѮ\xea킵\bv\x04\xab2+J\x8a\xa9\xef>\xe8\xee\x8b\x7f*\x1f\t=n\x97\xf8\xc7-\x12o<<*\xfbY\x87\xfc\x13ܕ\xc4\xecd0\xb5I\x81\v\x0e\xcb\xcf+\x8b3-ܶ\x9f\xf9\x11\x84\t\xa8\xbb,O\x8b;\x9c\xe7^\x15\xcbպf\xe9\x1b\xaa\xcf\x1e\xa9(\xb2\b#\xc3\xdb\xd2#0\xf6|\xa8ڣۺO/\xf3\xd6\xf9\xbbiWo\xaf\x045\x9fy&3\x1b\xc09r\x89PW\x17\xf7\x1f,\x92\xe2O?Z\x14\xe6\xfb\f\x177D\xbcŶ\xf6ض#xir\x83\xd0PH\xf0\xbc\x86s\x83@\xc1R1\x04,u\x0f^\xfanX\xd3\xfb9jR\xe4\x81}\x10\x9da\x03W\\\xa2j̚'XT]\xe4\xee\xf5䫧<\x82\xb2$B\xe6{j\xf7`T\x92\f\xe7{\x960\xe2\x1b~\xd8\xc5~7@\xfc\x03ٟ\xe59+\xff\xf4\xf3\x0f\xdf\xff\x9d\x99\xaf\xeb\xfd4\xac7\xf1\xed\xc3x\xab\xfe{\xb0=^\xadX\x9e\xb6\xc5͒\x89\xc0\xc1\x9a!ƨn\x9e\xf9j\x9e-Ҡ5\xfe\x13\xef$\x19H\xfd\xdf\x7f\xfa\x8e\x0fx;X\x9eS\xdf\xeb7\x1fSc\x8b6kU\xb8K{6+\xe5\xdc\v,-Z\xd2\t\xe7\xe2\xe7.~gy\xc5ʺ9IO\x19:\xc95\xf8\xce\xc1[\xb8\xc03\xbf\xa2\x92\xf7g\xbca\x85\vx\xed\xdc>\xda\xd2#\xbc\xb2\xd6\x1eُ\x96\x96.ُ\x94\x8f\xed+\xd9\x028\x17?w\xf5Uɖ\xc5-\xdbuσYG\xd6\xf6\x14\xc2w\xa5R\xd7o\x1ar(\x12&\xa5\x86\x02VT\xcaE\x9bx\xb0\xe7@\xc6\x1d\xb2'\x80'\xbe\xe1\xe6\n\xd54\xcb\xd3@\x98%\xe8\xcf\xd7q\x1dۄg͍?7((\xf8\x96\xbb\x7fy\x04KT\x92Ff<M\x91\xb8^\xa5xӦH\xd5\xe3^,\xafYi%l\xb2\xda\xfc\xd6F\x00\xac\xd3d\x9b\xd10s_E\xcff\xfeEE\xf9\xd5/\x88ʧ1%f\xf9\xcd\x1f\x88w\x9b\xe5\xe8\xa7b\xb3\xe57}\x00\xd8\x0f\x8aj\xf3\x85a\f\xf1X2\xdc͑M>]\x9f\x92\x88\x9d\aIA\xf3\xe9\xf2\xdd\xe1\xe1U\xc3\xe4\xd1\x12\xe7ǩ\xc1~\x9b\x143C\x7fp\x1e\xddB\xfd2\xe0_\x9c\xc8\xca\x1f\xba8\xb6܇c\xaa\x0ft\x87\x9b=#\x1e_\xfb\x18\xae\x19\x02(^:\xd7\xf6\"\x03\x87\xf9Flx\xd1\xe0\xa0k\xb04%\x93\xff\xa7ŚW\xa3{\x8bתN\x11:\xfa\xca\xc5a)\xb8X?\xe6\x05\xb2\xfe𢻡\xbb(x\xd4*$|>\xbaw\x8f7\xc6Ȏ\xbe\xaa\xb8\xbd\xa1Mʳ+)\nF\xec1\x7f\x04\xa9\x067}\xd4\r]\xf2\x9c\xe1z\xe1dy\x84\xd1'\x88!\xfc\xf6\x00q\xf1\x8a\xe5\xff2\x12\xe5\x88\t\xbfP\xbdt\xc2\xca\xedO\x9f\xd9\x05\x16\x8d\xf7\xa3\xd0KU>\xf2\x91\xb5[\b]ʛ\x1d\xea\xad\xfb\xc3#\xb7\xf8\x80\x97\xb4Q\\4\xc1,k\xb7\x01jg(\xca\xedd\xd7`n\xe1\xe7\xf3\xbb\xaeJ\xb1\x98\xd7³\x8d\x1aU>V&\n\xcbjC\xf7\x95c\xf3L\xc18\x99h9\xc9{\xecn\xf7L\x15E/\xf0\xfc\xd6t\xde8:\x1b^Ici\xb8\xdf{\xe1\x9d\xc7\x17T\xa3\x1e\x9d\xbeC\xaa\x8e8\xfe.#\x88\xbf\xae\x88Sbpy\xa54%\a\x80g!w=\x19\x8d\xa3%\xdf^W7\xa9\x9d\x13Z9\x94\x8c̤]\xcdX\xa6\xa3\x86\x14\xf9\x8f\x02?\xf8bm=\xa8rod>!\x94\x92\x9d\xed\xa1\xb0\t\xc2:`\x97E7Y\xdd]r\x93y_^\xe6\xe5w+\xe5\xebe\xdb\t\xb9\xfbd\xf7}-\x1e\xcaʥ\x0fW\xe5\x93J\xbe<\x93\x8f\x1d$ϲ\x85\xc3L\x9e\xb2\xdf)g\xd7a\xa7\xc0\xec\x1eu\xaa\n\xad\xc1\xc0Seݞ\x13Ρ\xe7\x80S\x1co\xbe;<\xfc\xc7\x1c\xc5\x0f}ǥB\x1b\xcaL\xa28_\x9a3\xce\x17_\bvt\x1f\x81v\x9d\x84\xdbZ\xfc'?\xfb\xa4\x87\xce}\x03\xe63\x1c?\x9b|\xc4P\xac\x11\xe4\xdcFko\xaa.@\xc6t\xbe\xe7\x914\x01 \xc6/\xbe\xe0\x98Q4\x89\xdb\xf8\xf5&\x9b.(\xea8~\x98\x13\x9de\xea\x10]d>\xf8\x88\xff\tB\xf7>\x88\x96\xf6\xed\xae\xe3\x9c¶\x1b%\xc5r\x15\x97\xb1ဠS\x1an\x03EY\xbfR\xb9\x9d\xf6\xcc{\xf5\xa3\xe8.\xa2\xcd$\xa8i\x90!\xb9\tvJW\xc7sw\x11$\xf1\xaa^\x97\xecS\x9cX\xe1\x9f}N\xacd\xe5\xf6+l\x8aO\x82\"\xc8r\xc2\xd8z\xd4Q\xe4\x12\x94?\x00w)\xbe\xaet\xf3\x1e|h\x15\xe4\xf6Y\xf2e\xef\xed\xdb\"\xa7\x88\x97\xf8\xae\x1eM*\xf9@)*\x92d\xabA\xfb\x1c\xaattȮnEd\x96\xe8:ϙzf\xa4\xb7D\x00\x17QU\x8e\x0f6\f,\xde|\xa0\xb4k\xc6\xc4\x02{\x9a\xd1\x10K\x17\xa9\xaa:s\f\xe9\x135\n\xa0\v\xee\xc8j\x8e\x87]\f[\xae\xec\x93<7\xbe\x8d\xe3\nҁJ̀\xad\rzТ\xda=o\xdfc\x194\xdc!\x9a\x1b+\xbd\xab\x16\xcd6\xa7S\xcf$*wY\x1dܠ\x90\xb0Y\x9b\a\xe4\xbe\x1e\\\x9f\xcf'\xf0\x93\xf9c\xd9ZAs\x1d|ٰ~p'\x9f\xc8HM\xd9\"\xde\xea\xa7.\x88m_c\x9a\x05\xb4.\xe9\xedh\x17N$\xebů\xe5\xe5ng\x02\xd0\x18\xb4N\x12\xd9\x19ߥ\xe8\x8a{xh%\xbef\x9b\xfa\xbb4\x82\xafQ\xa9ϋ\xbb l\xf1\xf2\x16^\xad\x8d-\x14\\*\x8fk\xb8\x82\xabЕ!,\x84\xf1\xb0-\r\xd1\xf2s\xb6|d\xbb\x1c\x05\xe5<\xe0\xf8\x006ciY\x95\v\xc4\x06\xb6\xf3f\x13d\xa6j\x82\xed\xb9\xbbÃ\xf1\xa3\b\xb3\\\x12\x9b\xb45\xbc\xfcB\xb8j\xef\"\xbe\x19\xee\xf6a\x1e\xb4\xef\xa2\xfd\xa0\xadN\xcbNmn\xd7;\xd9\xfa̴\xebHu\x17\x12u\x10\xd8uN\xb8\v\x89\\':V\x91](rG\x92\x1b\x8b\xc7.\x04\x9f̝{\xbdj\xdbD>h\xbe\x15\xe8\xbc\xfb8\xbdy\xff\xc8-b\x9b-\xed\xef\xe4\xa1-\xda\xc8\xedRm\xee\xda.\x8d\xbb\\\xb7\xff)\xfd\xb2\xcd\xf7\x88\xb2\xe6\xfd\x86\b<\xab\x01WEܵ\xa3\x99(\x9e\xb3\xa5\xa5\xad#[\xach0\x81\xf1i\v\xcc7\xea\xc5<\x16\xe3M_z\x96\xae/\x9eD\v#\xcfR\n\x137Q\xd3\x12\xf9\x97Զ\"\"\xdb)\xa5H\xf2Sk\xbeN\x95yU\xe1v=\xd9|\x1d\xac\xa1S\xa92\xbb,Z/\x1b=\xf4\x12\xf4ĭ3\x02\\TT\x06\x9c7\xd7!\x13\xd6\\\xfbt\xba\xbb\x02x\x9a\xdd\xc1\x90O6\xd3\xfdW\xbd\xb1\xf2Io\vx\xad_\x9dwN\x06\xc6\xee\xces/\xa0a\xdbi^:\x19t\xdf<i\x99\xe6\xdcY\xady\xc6w\xae\xfa\xd6\xca\x12\n4\xa1M]\xc5\xd9T\x9d9\x84\xab2\x9b\x0fH}\xea\xc9֯gsW\x9eT\xfc\xa8\xb3%\v\xf9L&l\x02\x15\v\xc2\xe6\xabv\xd9'\xbf,#\xb8Y\xfd\xefk3\x92\x13\xf7\x1f\x10\x17{]\xbe\xb9\xb4\x0fQU\xc3nD;\n\xadX\x98\x1c\xb9\x81\xe7P\xd8M\xd95N\x9c\x91Ra(j\a`\xc7x\xd9g\xc4\xd8c\xc6\xeb\x1f\xf0\xf7\x1e\x0f\xff\x8f<Q\xf9d\x16\xa2\xbd\x0fJ\xbaW\x87Oud\xf2\x19\xe5\xe4\x93\\+k\xf5\xbfG\xec?\xdf1\x96\x1b\x01rj\xfc\x16\xd1\xe1\r\xef\xfb\xc65\x8a=\x9c\xf05zN\xb6\x83\xddp\xf1ߏ\x11\x84\xcb\xe5\xc6\xeb7\x11\x10֦\x7f\xb2\xaa>\xc5\xee\xb6|\x19g0\u1978\xacp\xe3lJfa\"\xbdy\xad\xcdm\xf8\x8cL \xb2I?q\xb7З\xc0{\x97;H9\x18\xc45\x11t\xd8&Y\xf5\x04\xb8\a\xe9\xa9\xea\xd4\x1e\xc1,\xa8\x8d\x13t\xbf\x7f\xb4l\xef\xeb7\x1f\xd9❎\xd2\x0f\xe2B\x97\xaf\xefC8ӊ\xaf\x8b[d\\ns\x1a~\xfd\xc6sJE-\vz\xc8\xddA\x0f\x0eA\n\x9d\xed\xf3\xady\x0f\xe7\xfak\xb7^\xf4_\xfe>\x16\xb5\x80\x0f3\t\xe1\x9fG\x8c\x1bY\xaa]\xae\x1fG\xe71\xd7^\x17\xa8\frvN:\x06!{\xf5\x93w\xfe\x11\xb3O\xb3\xb3Z\xe6\xe5\xe3\xd0\xd7\x10\x8f\xc0Q\x95J\xe2\x8c\x13\xaf{\x8d\xed\xae\xebk\xdd\xf7\xd6\xee5\xd49\xb1|\xc0\xffh\u07b4\xba\xcf\xd8&\x1c\x15\xab-\x04|0;]e\x0e\xeb\x9d}\xb7\xcf%.\x93\xf1\b\xdfk\xe5\xb7\xe7JS\xf7M\"\xc3MJwL(\xaf\xfd\xecۈ{\xdc;\xe8\xb1<5\xec\x1b\xbd\xa8YF\xdcD0}\xe7\xf9\xf1\xe1[\xa3\xf2\xb7oi\xf7\xbdj^U\bCX\xedw)\xc1הTظ:;\xa4\xed\xdcw\xcf[[\xbeSa\xbd\x85\x80\x89\xd9+\x86\xc3\xf7\xa0\xfd\xac\x18~\x97oM\xe3\xa9U`]O\xc6<\xe7H\xba\xabZɨݜ\xd2ֽ\x7f\f\xb3t\xfd\xea\xde\xc6(z\x18\xefB\x0f\xf3\x1aH\tp\a'5M\xf7cf\xf71\xaf\xe7\xa2\x10\xe2\x91r\xad̆\xee\x120\x8d\xc0x1\xa6\xa1\x83\x12\xfc\xb7\xe6L\x12AܼR8m\xcc\x0f\xce\xe4k^\x1f\xa4\xf75{=\xfe^\x8d5\x1b\x9f\xf3\xbc[̲^̊\xe0V?\xe4\xd3P\x1c\t\x85x\xf8\xa7A\xa2 \x80\x10O=\x88\xa7\xa1o)\xb6\xde\x022\x85\xceb\b\xbd\xf4\xa3\x1b&[\xf3\x1a?\xcf!\xa0\xf7S{\xbd\xc8$\xb0i\xfd7ڏ/d\x99Yْ\x95o3üO\t\xd3\xedw\xa9xLK\xa5\xfd\xbaf\xdcy\x98^\xe5\xd4e\xb1\xad\xb7\xb1\x99\x84\xff\x16ky\xb0Ki>W\x8a\x88\x1b.P\xb9\xd6[\x93v\x95\xc0T\xd8\xfc\x9b99\x83\x98/\xc8=Ϊo\xb3<\xabY@\xb9*\xa4\x81\xbe\x85JªϿ]MX\x92o\xf1\xe6R\xb6a\x90\xa5W\x86}\x81`\xbf\xf8\x82\x17\x1aH \xeabׁ\x04\x04\x90 \x00\xff\\X\x19\xb25\xf4\xd7z\\\xb4\x95\x14\x98\x88\xf3}ѫW0i\xf6\x9f\xeb\x01t\xae~E\x06O\xce\xe9_\x99\"]\x1aL\xe3\x12\xfa\xea\x9d;\xe8M\x87_\x19l\xc2\x16\x13\xdd|W\\`\x02ɂ\xc5\xe5\xcf\xfc3p\xf3\xd5xo\xe0\x84\t\x8c\x1a\x99\xb3\x12uY\xf5Y\xd5le\xf4\xed\aS8\a3\x8c\xe2\xdf\xe6\xd9\xc2\x16\xf1\xaab)N\xdbw\x96\xa4DP\x8f\x1a\f\xbe0\x9f>\xaf\x8d\xb7^\x05\x1aZ\t\xee\xa0\x0f\xf5h`\xf69\xd7/F\xbc\xd7C̕D\xd5#%H\x81@\xa2XA\x04\xd4#r\xbc4\x05Ws\x01\x91\x04\xcd\x19A\xb3\b&R\x01.ٯkV\xd5_\xe6ْ\x96\x8fo)\xfb\xf7\xdfe>\x8f~\xf7\xd3\x0e\xa8e\xf1~\x17H\xb1\x13G\xd5\n\xd1\xea\x90U\xb1ZJ\x8e\x9e`FO\xac\xa5iY\xac+۫\x8b\x87naeS[ \xd8\x1f\x8b,\xaf5\x14\xcdq\xec\x96\xe5\xf5\x9bb]&\xcc\xe3-C\xc5\xdeN\xd7\xd7''ç\xc70\x81\xa3\xff\xc1\xa6\xff=\xab\xc5\xd3my|\x9b]\x933\xe9\xbab\xe5\x97\xd7t\x85\xf5%\xf4Gp\x0eC)\x93\xc5\xda\r\xeegP rw\x9b\xb64\x1a\x97hY\x83\xbd\xaf\x12\xe0/\xb5ˉ\xac\x8a\xdeVR\xb4P\xaa\xa3%#\x9b`\xa2\xda\xdf`\x1b/\xa3\xc2|`\xf6 KY^g\xb3\x8cfW\x020\x92\x9c\xb5\x9dJH5\x06΅W\x8b\xecVz4Y\xadl2a\xb0\xc8r\x16\x97;B\xf6!\xe4[\x0e\x19\\\xe2\x1a8\x82\xab\b\xf4/\xfb\xb9\xbb\x88{\x7f\x86\x8d\xda\a\x8b\xe2z\xaf\x9a\x8a\xeb\xc0!0\x10\xf7\xb8e\xfe\xaa\xe9\xde#\xb3\xbe-\xcae\xcc\x19\x8dQ\xbf\x97q\x1d\xf4\x06C\xd6S^W\n\a\x06~6\xe9\xd98\x04\xa9\xe0Уa\x04\x1b\xdb\x19R\"\xc9w!\xe9[X\xfa\x1b\x0f[\x1c\fmlA\x1c\x1e\xb6\x8c<\x18\xab_˺\x13\xa5\xae9\b\al\xb3*r\x96\xd7\xc1\xe0ԃ\xab(\xd3,\x8f\x17\xfbP(@\x03<eP\v\xf89\xf4\xe8\x01\x86\x9e\\\x13\xe3s\xb8\x84\xcb+\xb8j\xee\xf3D\x95I\\\xb3\xeb\xa2\u070e\x86\xfb4B\xd6\x1a\x0e\xa8\x1e\\\xce4\x82\x0e\xec\xe3\x8f\xc5>\xee\xc6>\xfdh\xf4\xd3N\xfc\xc9G\xe3O\x1a\xa3\xc8\xe2\xfc%>\x132{\xf2\x04\xdf\x15\x10o\x93\r\x19\xfd\x1c'\xf1p\x9c\xd0\xcf\xf4l\xfcd\xfc\x94~>;9{2M\xe9\xe7\xd3\xe4\xf4\xecdJ?\xd9\xf1\x93'ɘ~>\x99=\xa1\xe7\v衈i:橣'S\x96\xcczp\xe5\xa1c\xec\xa1#f\xc9\x13\xf6\xd4%i6\x9bN\x9f<u\xa9{\xf64\x9d=\x8d]Bg\xb3gO\x9f\x9d\xb94'\xa7\xd3az꒟\x9c<K\x9e\x9d\xb8-\x99=\x99\x9e\xa5\x8dF%O\xf0\x7fn\xfb\xd2i:}\x9a\x9aM\xa5\x8aY\x1a\xb3ӶVOE\xb3\x8f\x9f\x1dO\x9f<\xa3\x02\xa7\xe3ӓ\xf8\x98~\x9eM\xcf\x14\x9a\xe4\x19K9\aΎ\x9f<;~&\xe8\x8fǧ\xbc\xf6\xe9i2;\x13Ma\xe9\xf4Y\"\x00\xce\xd2\xe3\x11\aH\x9f1Q\x8c=\x99Ƣ\x18{\x92LE\xb3\x9f\x9e\x1c'\x02 NO\x9e\x9dHv\x9e\x8d\x04^\xf6\xe4ٙ\xc0\xfbdz2z\u0089\x8cOOG\x02C\xc2\xceR\xc1\xe4\x94=c\xe9Y[\xb3\x13\xd9\xec\xd1ӱ(p6\x8d\xb1\x00gY\x123Ntr\x96N\x19\xe7\x00;;=\x1dr\xd8Y\xfa4=N\xc4Ϙ\t\xf2fi:\x8cy\xab\x8eG\xf1\xf1)\xa7\xe9\xc9Ir\xf2\x84\xe3\x8dG\xe9\xb3g\x82EOسd\xc8\x01NϦӑ\xa8\xf8Y\x9c<U\xfd\x9a\nɏӘM\x05\xeb\xf1\x7f\x1c\x96\x9e;\x13\x9c\x9d\xaaf?\xc3\xff\xf5\xe0\xca\x1a˿\xae\xe3\xbc\xce\x16l\x9f\xb9U\xc2\xd2\xe4zy\xe5\x99\x198\xc4\xfb{`{\xcf0\x94\xf6H/\xe5\x1e\xac\xf5\xbcdռX\xa4\xfb\xa0U\xc0\xc1%\fN\xe1\xaa\x133Wd\xea\xed>\x88%l\xe0\xc7w{mi6\xb7׃\xb8\xf4N\x8f2\x01\xe22q\xac\x98%N5\x14\xea\xe8\xa78\xcd\xd6U\xcbM\xa6\b\xca\x11L\xa0X\xd7;\x01c\xc4X\xd5qY\x7f\x99_/\xdaB=\xf1\x88\xea\xd5\xed\xf5۸L\xfe<\x9bU\xac\x8e \xc6:X\x9e\u07bb`\x1a\xc3\x04\x82x\x04ϱz\xb4\xbbRJ<\x14\xe4\xc4#\x81<\x8dC\xfaՇ\x18Me\xe9\x8c\xd2\xe09\xd7z~\xfc\x0e^Bo\u0603s\xe8\xe1\x18HT \x96\xa4\xa8\x02*Q\xa9\xa4*\xcbyR2\xb2\xa0F\b5\xb2\xa0F\xaeA*\x8d\xf1v\x9dn\xc7\x0f\xf1\x06^b_\xbc\x84\xde\x0fÈ\x1e\x16\x1e\xc1!\xf4\xbe\xd4?\x8dT\x18\xc2(\x1a\x01\a\xec\xef\rɓ$\xfe\xa1.5ԥ\x86\xaa\xd4P\xe1\xdf\x17\x92'\xfd\a\xb1\xefs\xb7\x82j\x11\f\x13\xa9\a\xd8_F\xc1\x03\xec\xac.\x9c\xf8\x91\xce(}\x04\x1a\xc9\xc8AB\xdfߋ\x06\xda\xf9C\x95\xdf\xca\x1e\xa3\x92!\xa8B\xc9\xd0Ab\xb2\xee\xefҞa4\xec\xf1:\x1b1\x05\xf4l\x00\xa6\x90~\xa7\xd3#s&\xb0\x80\xfe\xac\xd3#c\x16\xb0`ި\xe4H\x8dv\v\xe0\x1b\x91\xc8)\x8b\xcbd`Ӥ\xcd\xf9\u0383\xf9\xcd\xf8\x06b\xc0\x19\xe5\x95Y\xc5m&a-\xca\xe0\xd6\x1d\xadq\x99X\xf7Ց \xbb\xfd\xf7'\xc8(/kk\xb0\xf4^\x04Y\xbc\xbe?=\xba\xb8\xac\xcb\xed\xbc{Qct\xeb\xfdiaV\xf7\x83##\xf7\xa2#ay]\x16\x99w\x19\xd7\xef\x9d\a\xbb\x17@8\xdcc\xf1\v\xe1\b\xc6\x11\xd0b\xb4\xcf\n\xb8c\xa9#t\x9e\xf5\xcei\xf7\xa5\xb1\xf8\x84p\x00ed,=\x94\x00W\xbex\v\x82_\x969\xc1\xac\x06&b\x1b\xff\xe3w\xbc]\xf6z5\x811\x1c\xa8u\xb3\x0f#\xd6?3T\x11ܪ\xef\xd0qn\xafɼ\x82\xbb3\xa9\xe9\x84>\x8a\x10\xe8;ei)\xcaƳ\xde\xdc,pn\xc2\x7fOI|\xf3\xdd\xe3\xf9\xfddQT,\xedy\xe0^Q\x8e\x80F{p\x9f\aͳa\xdf\xd4l\xc5/\xa9\x9b\x90\xf1\xacfe\x13\xf0KL\xe6pӸ\xca*\v\xe0+L\x11H(\xb7_\xacX\xdek\xc2\xfcy\xc5r\v\xce\xd7\x06\x824\x9b0]\xe7\xe9\x82\xd90\x94\x14\x89\x1b\xb7|{l\x01\xbc\x12\x89\xa26\t\xe3!LB\x1a\xb4)h\x1fy\x12ޤpY\xe4E]\xe46\x8d?\x88D\xf3-;o\xff\xef\xf3\xae\x1d}\fnؖ\aD\xbb0\x129\x91h\x80\x15\xf4\xfe7a\x82\xc5\bx\xfaE;G\x02\x89\xcb_\xb1\xf7\x19#U\x98T\xf21\x1c\xc1q\x04#\xfegȭ\x82\xbe\x12cYB\x80\x8ew\x9686K\x9cYU\x9d\xc1\x953\xd0\x06e\x9cf~k\x15\xb6B\x8cEg\xcc\xc9\xdf?QY1\x9b*l\xb4\b\xd1׆N\xe3X\xcdħ\x01\x18\x8bi\x99>\xb66\xdc֚l0\xc92%6\xc6Ԡd\xb7\xac\xac\x1cB\xd5P\xf2\x94\xa3\xf4\xf6b\x1c\xad\xb5;b\xf1\xee)\t\xa1\xfcS\x92\x81f\a\xc3EM\x0eF/\xc3\x156b8}i\x86\xf3O\x03\xd0VZx\xf6\xd0\x01\x1f\x1a\xf0\xb6N\xc1\xf3G\x0e\xfcȀ\x97\x1dJ\x1f[\x1bpk\xc0Y\xca\x01\xcfu\xc8ؚd\x18+8\xcfth؎\x9c\x95\x89\xc5.דyQ\xa6\x9d\x9b[\x82\xf0\xf8wU\xdc\xe1\x9e<\x8b\xc5\x1a[\xd1q\x87p\x16\x88\xa0nB\xd4qy\xcdj\x01\xe1,\xb8\\C\xaf\x06+T\xc7qK]\r\xca\b\xbfG\xf8/\xed.\xabA<\xc4\x15>`\xe8!Y\x05\x88\x11Os\x92uy\xcblx\xfes\x88g\x17\x8d\xdc\x1a\x7f֔\xcbk\x92\t\x94G5բ&^\xd6\xc8טæ\x9e\xaf\x98\xa6\x9a]\xb1\xc5,\x82Y\x04\x1e\x06j\x7f\xf4\x19w\xb5\xe0\xc0\x82{%L\x80\v\xb0\x99)\vq\x18\xd7(\xd0\x02\xb8\x87U`\xff\x92N\xaf\xc9\x16\x01\x94\xe7P\xea{\x10\xf1\xf0\x1c\r\x05\xfa{t\x8e\xf6\x02\xf5\xbd\x1a\xa2径\x03C\xcd\x1a\x86\x91N\x11\x86\x000._\xacF\xcd2\xa3F\x99Q(N\x03\xda|\x92\x85\xf0\x98\xcf\x04\xea12@\x9e\xa2\xf3\v\xb7x\xc4(\x0e\xfc{\xe4E\x86\xe2SF\xb0\xf2\xf9\xa7\x88=\xa5\xde(\x1a;\xca\xc3 \x86\x17R\xcf\v\x8d\xdd\xe5\xca[\r\x97\xc3r\x18\xc1j\x88V\xa3\bV\xa3f}\xff\a\f\xc5vx5r\xf7\x9f|p\xea\xe9\x93\xc6\xf5\x1b1b\xf9\xb8t2\x7f\x16\x83\xb5t\xf6\xa3\x94\xf9i7\xa3\x84R\xcf\xd7\xf7\xdfܔ֞\xaf\xdcg\xbbGuZ\x1a<\xa7B1\xea\x01\xdb=**\xeb19~o*T\x8fܟ\n^T\xd6cv\xed\xfdy\xf1\x0f\xdb\xfe\xb6Q\xf4\x8f\xd8\x027iq3\xcc%5\xcd\xe2\xeb\xa2\xe5\x90S&\x80\x04\xf2,\xac+\x9a\xd4Iv\x1a\x1ex\x11\xac\x8ea\"\xfaԓ\xbb\x84\t\x04\xab\xe1`\x8bS\xc0\xf1`+\xb6\xcet\xe7\x89f\x0eY\x11\xc0\xe6\x1cV\xc3\xc1FϮ\xdbsX\xcay\xd3\x05<n\a\\\x1d\x83\n\x85\x83\xf5\xach\a\xb9*\x8bw\xe2Z\xb5w\x99_a\x00\xa2C\xe8\xbd\xe2\x1f#\xfa\xe03\xd7\xe5\xd8\xfc8\xbe\xfat\x13\x99&J\x03Ȏ\xf8Q\xe5\xf1\xfad\xbag:\xd8|\xba\xe9`\xd30a\x8bj-iS\xb44'\x85ͧ\x9b\x14\xeeI\x8b\xc5\xcc\xfbӳr\xf8\rv\xefl\xf6\xa1œ\xe7\x1b\x88;v\x15\xc6xu\n\x06\xe1\xbe2c½E\xc0&\x97\xee˾\xf60\x04FU\x81C\x11\xdf\x03i\xba\x02\x9b\xa9!\xaa\xc3.I\xfb1SzrI\xa7.#\xcb\xf4{2=\x9dDv\xb5]N\x8b\xeeِ\x838s\xa1 H6\x91\xc3Н\xb0@Ev0g\xbfP>誀_ee\xb2`aPe\xef=\xe0\r\xbfS\x1doGa\xf8\x99\x02\xc9U\xd9\xfbFΛ\xec\xbd\x18\xd9\xfc{ \x8a?`d\x8a`\x0e\xf8\x9f\xa6\xa1uT\xf2ꬮ\x13\x14T\xd9\xfb\x87QP\xa9\xb6\x80\xd1\xd6\xfbP\xd0\xc8q\x8d\x8d\xa2\xf7\\\x13cB=t\xee\xeb6aT+\x8b\xaa2^\x93A\xf2\x9a\x96f\xae\xfc\xffZ֔\x0fGpJ\v\x9fw\xe5\xe9\x1f\x93\xedV\xea\xe4}\xfa\xf9'\xfd\xf3/6П\x94\xe2\xfe\x17\a\\\x81\xfcE\x818\xc06\x92\xbe\x0fXW\xa4v\x92\x91\x9c(\x96E\x9ev7}\xebk{\x80\x06d[\x8a\xe3\xfcx\x18\xe2Fi\x03\x13,\xe5\xcbo0K\x9e n\xf5A݆\x1f\xb8\xe1\xef\x9e<7ܪźo\xe5;\xed\xa9~]\xc7%\xbbgOv\xf4\xa2ۃ\xdf\xdb[\xad~\xa9\xc8r\xf7_Nq\x1f\xf3{u\x99\x91ͦ\x9f\x16wy\xaf\x9bꍯ\x13\xec\xb9\xe2ײ>\x0e#\xde_\xe5\x06\x0e<\xd9ޖ\x9a,6{\xc0\xe8\x18\x8b\xf3Fzk\x8b֫\x7ft{\xfa\xfe\x06\xb5\xb4\xc7i\x8ea\xc56V\x17\x9c\xa7+w\x8a\xae\xd0N]\x05\xa1w*\xe2D\x9a-=\x0e#ϰ\x90 u\x9c\a\xc7C\xe3h\xe6\bFO\x87&%\xf1&\xab\xba\xddD6Y\x15\\\xcbd\x80\xeb\xd6'y8\xbd\xd7B#\xe17\x7f\x8c\xebl\xfa\xa6Dr\x83U\xe2_\xf9f\x8c\xba\xb8\"<n\b\xc4\xfa\x12\xc7b\x94\x12Q\xd9/\xe5\xc2\xf0\x96B\x17\x11hZ,\xe3\x8c\xc72\xd2\xe89\xb8r\x8a\xd5\x1fo\xbd\x15\v\xc0f\xd2.\x12\xf8\xb5\x1aQ\xb5\xa8\xc1nz\xb5\x9e\xca\xd6K\x9b\xc2&\xabެ\xa7iv\x9b\xa5\xccD-\xa8Vya$K\xc3\x04\xae\x8d\xa0\x80=|\x18\xa8({!\xbdQ\x14\xc8*\"A\x8e.\xf7\x8d\x88\xe7'>yо \x14\xb1\xf0\x02:\x18\xebEл\xee\x85\x14# \xe8ѳ\x98\x98D\xd5\xcaj\xf83\x8a\xbdb\x15'Y\xbd\xedEt\xd8gT##\xe8\x0f\xec`\x0e\xbc\xceMV\aa\x1b\x0e\xf9\x1a\x8aF\xf6\xef:R\xbf\a\x9d\x0f\x8fG\xd8\\\x86]K^\xb9\x8c2\xb9\xe4e\xd152c\x15\xd7\xf3\x0e6\xb4\xf2\xe0~\f\xe8h}k\xd3y\xa9\x9f\x11\x12ݾmN\x90\xf3\xab\x10<\x14\xb3\x9f\xf0\x9bK\x1cn\x18\xe2z\xde\x10,>\x9a$\xb7.\xf1\x84J\x80J.\xe1o\xc5%\xf1\x8a\x9dd\x90#C\x12\x19G\xd0\xd24\xccrz\x90(\x1c\xc1\x04\x84\xf3o\xb1ڒ\x80\xe0\xd7P^\xa8}\xfb6\x99\xc7e\xfd\xf6-jӼą}%ZCL\x9a\x00\xb2\xdfU\x13h,x\x87A/\xec*G\x17\x9e\x9d\x06 .S\xaax\t1?\x8a\x9a\"\x82RLѝ߀\x13W\xb0I\x98d\x1e\xaf\x95\xaeR\az\xee\x11\xc0\xedU\xf3b\x1c\xaa\xabj\xb7Q\xd5]V's\b\x8a2\xa3{)z\xfe\x87$\xae\x18\xf4\xa6E]\x17\xcb\u07b9N7a\x00l1\xb5'ÿ^\xb8!\x8f\f.Swlё\x15\x13\x7f\xc0\t\tw4\xa1\xb7\x8ch\x05/\xb4\xc1B\xc3p_\x14\xaa\xcf\x1a\x05\xe2w\xde\x02\x8aӲ@/\xd2wr\xad\x82H\x06\x1c\x12\xb2\x1f\xe34\x95\x171\xed\xba\xf7\xa2\xbd\x83\x14\xbb\xbcU\xfc\xe1\x84!b\x81%E4\xbd\xc1\x93\x11[\xaaa\x82\xd9\xfd8G\xdb\x15f.\xb34]\xb0\x9e\x83C\x8f}\x89\xa9\x17\t\xe5\x98&(a`\x8bz\x82\x92o\xf2\x14i\xa3=\xc8\xf0O\x1alt\xa5\xb6%\x06\x98Sٴd\xf1M\xcbC\\\\R\xebb\xf5YŴ\xff\t\xe4\xb4\x7foA\xed\xdfWR\xfb\xc1\xde\"\xf1\x00a\xed\x7f\x9c\xb4>\x9c\xb8\x86\xc0\x0e?\xa7\xb8\xf6\xf7\x94\xd7\xfe\xc7\b\xec\x82\xcd\xea\x87I\xec\xdfvJ\xec\xe6\xe1\x12\xeb\x942{\x7f\xb8Cb7\xf7\x96\xd8\xcdg\x90X\x87\x80\xae\x06x%\xf6>\xf4\x18\xb2=\xdc9\xc5\x1e\x8f\xdbe\x96\xe5\xe9=\x04֕\xcfȕ\xe2?\r\xff\xe2\n\xec\x9f>Z`\xcb\xecz\xfey%\xf6A\x02\xfb\x91\xf2z_q\xfd\xa4\xaa\xc0G\x8a\xea\u07b4|29\xa5\xe3\xd3{H\xeaC\x05\xf5Ar\xfa\xc1\n\xddeX\x1c,\xc5\xd6P\x9f\xb9I\xde\x14[\xb9\x1b\xb1*5䮵ĨQ\x02w\x8e\xfb\xc2[\x03bO\xaa\xec\x01qϊ\xf6\xa6\xcd\x13L\x97LY\x1b\xb5\xf9\xe2w1\xbf\x8a\xe9\xd5s:\xedݘV\xa8\xd4f\xbe6\xd5Sa\xcc>\x84tcu\xe8\xc5ޝ\xb5\xb9O?m|\xb1+\x1b\xa70\xf2j\xb9\xe7r2\xdf%\xc1Dm\x8b\x9c\x01\f\x138\x13IY\xee&\xc9Q\xa0\x12\xc4\u0604\t\x1c\xbb&(:-\x1f\x91\x17\xa9iZ\x13\x81X\f\x9b\x94cY\xa2H(\x8f\x00\x80L}\xea\x96\xfc\x03\x0ed\xb0\xa4:\x91\x11h\xdc#Q\xac\xc3\xf6\xcc\xdf\xd0\xfbU\x82I\xf7\xaf\x95\x17\x95\xb5(D\xfb\xd5+\xadq\x1ekfw\xad6\xebuD\x16\xa7CT\xf9\xbd\xa9Q\xddv\x7fN\xe8\xe2&=\n\xe1\xfe\x1cQ\xa6҇\xd1\xe0\x98>\x8d\xa4{Q\xf1\xc6=\x18\x8c`\x1b\xc1\xfb\xfbP\xa2\x06مqT\xe0\v\xfb\x88W\x16Lz\xcd\xd1y\xb8\xb1r\x8cA\x9a\xc3\vz/\xf2p\v\xe7v1\xb3\x84\x1e\xc3\b\x8f\xb7\xd4\x0eU\xf5\x97\xf9U[ѝ\xfc\xd1S\xc1úI\x947I\xd5(\x0f\xef\xd1O\xc6D\xf20J\x14\x06\x93\x16\x13\xednj\x9ct\xd3\a`Z\xb6D\xed\x91\t@\x10\x1fy\xc6\x11\xc1\xf4\xbaa@\xc5\xe82蕚\xa7\xae\x11u\xd6\x04f\x9b\x9a\xe5\xb5\vX\xbfo\x00\x96\f\x8f\xb7$ \xff2\xcd\xd8L\xafX\xd7\xd2LL\x91QX٧\x00/ď\x17\x8b^8(\xf2\xa0G\x9e\x11xd\xc8YՋ8CHk\xe3\x10\xe4\x1eA\xdf>\x10]\xdd\xf4\xbaa\f.YR7-\xa9\x16c\x04\x89\xb7Y\x95M\xb3\x057f\xf7\xe6Y\x9a\xb2\\\xe7&\xeb\xb2\xe2\xda$\x1d\xae\xcf\xe3\xac45\xcaپ5+.7\xf0\xa2\xe5\xddDY\xbfo\xa0l\x1e\x8d\xb4i,\xf2\xf0\x90\xf7\x0e\xbf\x8di(\x12\xcd\xeaw \x12\xdb\"\xe2\xfa+*s\x99^Y\b\xbd\xcd\xde\xec\xc6|t\xc9\xee\xae䥗\x94\xa2\f\x1d\x8b\xc7\xc4l\xfcj3\xb0\v\xe1\x7f^\xe6\xd5վ\bﲴFy:\x93\tsF;FJ\xe9\x94\f\xab\xa7\x04d\x9aU\xabE\xbc\x95\xf2\xc9\xdfh\n\x90\x86^^\xe4\xac羒\xc6{\x99\xced\xd4ً\xfdB\xde\xc6nc\xf3\x04\xc5\xd6&\xa7\xd7\x06\xe7\x19\xbd\x92m\xb7\x936,}\x9eua1/-㻿\x06\xd7\x1e}\x93S\xb2\xddE\xc9\xd6O\xc9֡D1\xb8\x9b\x94\xbf\xb5\x90\xc2s\x8d\xcc\x0f\xfeP\x88\nΘU\xbd\xb3\x18\xa7\xaa\x96*w\xab\x80\xc9!E\x90\x143\x11G\x15\x1fϗ\x87GL\xcb\xf0\x95i\x96S\x00\xffY\x19\x00|\xd7\x18\xf6\xf6j\xc4_}\xad0gl\xa3\xcbym\xc3+\x93\xa9\xbey>\x1a\xe4/p\xa8F\x83\xea\x855d\x95\xa0pL#jL߇\xd8K\xeb\xdf\xf6\xa3uk\xd1:\xdaE+\x13\xb4\xdeٴjQRĎlbG-\xc4\xea\xe5\xc3X\\U.\xadH\x8dU\xd7\xf2أ\x85l\x90\xccQ\xf2ӟ\xcd@e\xe0\x0fV&}\xfc\x02y\x9bG|\x87\xc8\xdfs\xe5!(\xb2C7d\xb6\xa2\xed\x86mq\xad\fܠߊ\xa6\x1b\xb6}E\xb1\\'p<n>\x9b\xf38-\xe3\xebk\\\xa9\xad,\x80D>\xb8gϔbWs\x9d\xe5$\b\x13K0\xbc`#\al\xe4\x80I\x02`\xa2\x9d]\xec1\x0e*\x16ܫ8O\xd8\"\b\xdb\x1f\xe27\x19\xb3^\xed\xc9\x16\x8a\xb8\xaaȘ\x80\xc3'\xdd\xde\xc3\xf6\xf6\xea\xd6\x1e\xb6\xb7VW\x02Ë\x8fk\x1f\x89,_\"\x1c\xc1\x94q\xed\x84\xd8F\x80Pi\xf3Qc\xe4GAב\xec\xe6Ry\xd1Z\x9e\xef4\x95\x03\x8cL\x00\xb3\x99\x1f\x1e\xed!`Vgċ\xfa\xbf3gA\x11\xa5\xb9\x18\x86Z\x1c/!0'\x1f8\xb4zDXo\x02s\xc8[ #\x0e\x02Wm\"\xad:\xef0P\x8cx.\xaa\xc7\n\xba\xe4\xbcQvd\x94\x1d]\x85\xae8\b\xc3T\xcbP\xb3\xf9H\vT\x96_\xff\x15\x85\x15{t\xc4\xeb\x88`\x83\x06QW\xf7i.ߠ\xe4\xa0.\xd7lW5\x7fkT\xb3E\x9f\x0e_5\x7f{@5\x04\xe3C\xe6\xe0\"\xf9x\xeb<.\x84\x0e\xbe\xe7\xd0\x13\xba\xbf\x95\xb5\xa4W\x87\xd40{)\xf4h8\x97\xbao\xaf\xed\xc1\xad\xb6qf\xf1@xDe\xee\x90\xeb\xf6f\xa1@F\xd2b,\xc2\x15Ikq\x04\xabB\x05\xaf\x16\x92\x84o\xdd\v\x17b-\xb8\x99\xbd\x92!\xcc2\xcb#X\xc6\x1b{H\xfb\xc7[9\xc4YX\xd6e\xe9W#̩\xb8u\xbb\t\xa0\xfbm\x99Y\xf1\xc2\xf1\x1e\x1d\xff\xc8\xf2\x80.ӑ\xc8gWa\xb8\x0fAK\x8a\xd3\x10 \xd2CMX\b\x87\x96'\xb5\xd7x\x8bH\xe5\xbc`p\xaf\x9d2t\xe6\x15\xa3\x90ظ\xcc\xf20\xbcp0*L\xcf)\x1fl\x99\xe3\xe4.3\x8bu\x92'>\xb6z\xe8\x96X\xbc\xe0-\x03\xc5\xecqz\xe5\x03k\xfc\xfdw[0(=v\xf7\x06\x04\xf15\xf99y\x96q\vq\xa3e\x16\xfa\x89-dZ\x9f\xf1\x0ep\xffJ\xc5ȴ\xae\xe93\x96\xaf{\xd8\a\xbc\x1a\xfb\x837[\xdafқ\x16\xe9ֳ\vw\n\xdci\x03\x05\x92\xae\xac\x0f\x04\xa6\xf3\xd6+O\x0e\xe9w-\xa5(\x8f\xe5\xa9'K\xe8w\xfe\x1c\xb7\"M\xa9g\xde4gM<\xaa}\xe4\x9b\x06\xdb\x14\x91\x0f\x86\xc5R\xdd{\xe2\x17A\b\xfeg}\x17JG\xd9\x17\xab;\x87\x0f\x05\xe4[\x98\xf0\x1f\x83b\xa6\x94_3H\x9bc͒\x85\xe5\xc2\x04\x13\xb3F4:\xad\x97\x81\x91\xffW\x98\xc0\xe3\xa3\xff\f\xf2߫P\xee\xc1d\x1e=%\xb6Ѱ\x7f\x13\xb0\xec\xf7;?\xec62U6\xb3^\xb2\xb8\xb04P\x16\x9cH\xcc0\x91\x98\xc5M\x05\xac\xb0.}#\x0f\xef\xacF\xf2\x00\xd2a\x8bt\xa9\xa1\xe2\x171١mb\xe6\x14oȚ]ޕ7\xf1\xed\x139\xfa\n\xcdPྙޣY\r\xc5&Re]<\xeaХ\x86b\x17\xa7\xb2.\x1eY3\xac\xa9\xb5\xb8\xeb2\xdb\xc0\x04\x0e\x8f\xee\x1a\x9d\x1b\x01E_9<\xfa\xcf\xdc\xcd2\x88\x91\xb1\x86.\xd5|Hk\xb0K~deo]z\xe1ꢋ\x1b\x1c_'\v8NO\xbb\x1b\x1a\xb4R%9\x96A\xb5\xc8\x12c\x8a\xed\x98`i\x8e\xdcw\x86\xb5f\x9b\xddӨ9p\xec<\xbd\f7f,s\xbe\xe2G\xf6\x8f\xdc\xf9ʳ\x88\xf8'0}4J\x99Ft\xf1\xaf\xb3j\x15\xd7\xc9< \\\x91U_\xa4tLc\xda\f\xf9\xd90?\xc6ܪ_\xc2\xee\xad]F\xa8\xc0O<Ր\x11\x92&\n\x9e3T\xd18\x87p\x05W\x91\xb5`s\xb2\xf9Bf\x1dE\xef}\xe0\xa4\x16l,\xfe^~t\x93\xf9x\x03ϟ\xc3\b~\x87\xc7\xdb+猃\x00\xadC\x0eN\xdd\xf6A\xd4m%\xf6\xed\xe7\xa5N1\xddG\"\xca\x03\x86\xa8\xc1\xb03\x18#\x06C\xbf\xd4\x17\xdd\xf4˲\x00\xefabu\x99\xd6\xca:M\xb6\x9b!\xb6\x98ρX5}5\xac\tT\xbb\x89\xddF\"И\xf3\xa9\xc0\xd5n\x8e!Z\x06Y~\xcb\xca:\xe4\xe5\xe5g\xb0\x19\x86\xa2\xbcN\x1a\x85\x9e\xf2#x\x0e\x9ba\bt\xa6=\x8c\x04\x9a\x91(\\\xef\xa3\xd1:\xfb\xfc\xad\xe2\an\x86\xb6\x8a\x1f\xa3\xfb\xf1c;tV\f\x81\xab\xdd\xeeD\xb4(~Py\xf9\x19l\x87\xa1(\xaf\x93|\xfc\xd8\"?\xb6\x82\x1f$B\x84f$\n\xef\xe4\x87\x1c\xab\xa4k\xc0K\x9a\x1a6\x1c\rM\x0e\x1b\x8e\xe9\n\xd0&\xb8\x81\x97\"{3\xa2\x84-\x16\xbb\x14\xa2\xabW\x19\x89\xde\xd9\x00\xf8\xa7\x9d\x8bG-\x92\xaa\xe4\xd4\x10\xd2\v\xb7#y\xf7\x0f5\xd4fdɝI\x82X\xf6\xb9\xdcX\x19#\x991rF\x8e#\xad\xa6\x98\xda\xf2y?\xd94\x87\r\xc1\xf2g\xbe̡C%\xf5\xcb`\x9a\xa9\r\t\xdej>m\xfd|\xdaH\xe9\x1aj\xb1\u070e,0\x97O\xb4\xf8o=|\xe2\x19\x0e\x9f\\)6\xc5ז\xdb\xfbɬ9\x9c\b\xd6哠\xc6ǧ\x1d\xf32\xbd\x99\xe3\xf7t\xe9ط:\x1d\xe7\xd0\xe7\xf4\x9fC\xe6p\xdf\x15\x03\xb7\x8d~\xca\xcc\xd1j\x932qj\xff\xfdw>8m\n'\xbe\xe9ȉ\x1cp<(\xd94\xcbS\xa9\x8f\x90\x92\x12A\xaf\x10G\x8c\xee\x9dp\xe3\bV\xbd\x92\x94\x9fC/\xaf\xfaB}\xe3\x062T\xa5؝\x9dVy\xe0\xee<p9&\xe6w\x15s\x92\x11g\xce*\x17+\xf3BWw\x0et[c~RJ\x00\xceX\xbd\x9c\x8e\xc9\xf1\x1fRS\xef\xf0\x9f\x9c\xff\xcbS\xf9\xbfw=>\xafqP\xf5\x95\xf3\x82\xf4u\xa5b\x13N\xd9<\xbe\xcd8\xc7>8i\x03\xdc\xc4t\x87\x9d)\xe3\xeb\xc0y7\xd2v\\@\x88^\x04*\xa1\xe1\xb6\xd0\x00\xf0\x9eJ\xa9lߡ\x14i\xf0Mۿ\x0el\xa3_u\xf4\x1fD}\x97\xda\aQ\xabp _\r\x97M_yO\xd4W\xd6#8\x93\x89Dg\x1e\xa7;\xa7X\xab\xf6\x03,dE\xe3\x18\x83\x96\xfbF;\x94\xf6\x88eȠt\xd1h\xbc`KĽ;W\xe6N\xed-M\xd2\xe9\x96\xd2Gf\xba9\x15s\xeb\xf5\xef\x13D\xf0;\xa4[wc\xf6\x16\x8b\xef6\x98t\x99`x\xef\x1b\xe9\x9bsN\xe9\xa1>o1\xb3\xb7\xe72\xba\x8f:m1\xb3\xd3\xcd9\xa4\x1b+\x05_=\xdbz\f<^\ueee6\xb9n\xc2[MG-f\xfdv\x069\x87@Ҭ4\xb1\f-\xa10\xba%\v\xbcb(\xc6\r}Dd\x7f\xf4:\x05P\x11-\xe4\x86Q\x04\x11\xf4\xc99[䞃aq\x11蕍\xcdE\x81f\x93V\f\xeb\x95U\xbe\x95\xe5D\xbc\xc5\xf0v\x16y\xda\xeeE\xdef\x96\xdbilk\x18\xef̾\x88T\x1b\xcd,>]\xf0G*\xbd\xe7ޗ\xc3+c\x86\x90֯\xc8\x1c?r\x90ʣ\xa2\xe1^\xb6\xb1\x87\xf7\xa8\x9cd>\xa6W\x85\xf0;bG\x87\xa8\xd40\xcb\xe8%-G<K\x06\xd1nt\x80\xd7\xd6\xc4\x7f\x0e6\xce\xc4%\x92\xb7ּ\x05\xaeU\xe87\x1fF\xae\xec\xfbT\xd9Ǣ\xbda\xab\x10\xb6\xd9e\x90\x1d^\xb3\xcc\x1e\xb6\x16,\x1b\xc9y\xd0D\x15\xe99&4\f\xa9Z\a\xc4܁J\x7f\x90{\xf6\xb5>\xe9P\x88\x1a\x91\xb2\xca\xf8\xba[=\xe3M\xf0jg\xa62\xf1\xbe(\x96\x9d\xca\x04\x02t+\x13\b\xd1T&\xe8\xf3n\xce\xd8\xc2\x02\xa0\x14אl\x02hC\xf0\xd7\x7f\xfe\xe1\aLy\x93\x94Ţ\x15K:]\xf0\xe9G\xe4\xcb\xef\x86J#\xf2u\x8ak\x8f6\x01<\xe6\xe8fy\xafN\x84\xef\U000bbe89\n6\x1f\x88\xf5^\xf9d\t\x8f\x00ql\x1b\x88e_g\x8ft6\\y\xeb\xe3S\xd5\xc2S\xd9\x02\xeb:\x10\x85\x0f\xad:#X\\\x8e\xfc\x99\xa3\xab\x96\x8a\b\xf4\xe7\"0.\xfa\xf0\xd2\xc6\xc1&%|#\xf7\x14\xc6!\xa7\x99\x81{\xcc*\xf4sO\xd1\xf1s\x11\xac\"0\x9a\xb5PӲ^]\xcc&\xd1!-\xe7\xee\xc2\xd8\xe2[\r;T\x8a\xd5Booܐ\x8db\n\xa0\x91c\x8f\xda\xcd(\x84\xcdH\x06M\xd9\f\xc5\x03i\xceC\x8e\x1b\x8f\x13^\xb0i\xebt\xedPGX6C\xb9U\xb6&\xf0\xed(\x84\xad\xaay\xdbR\xf3\xd6W\xf3\xb6M\x9e\x9c\x9a\xb7͚\xd5\xf2\xb9*\xf9\xf4\xc8f\xf1zQ;Sos\xe6\xe5#E\xa5Rm\"\xe2\x8cNU4\x9d\xeb\x9f\xdd΅\x9d\xbb\r5w\x18\x99\xda\xd3\xc3\f^aH\x98\xebĆ\x02\xa7!mQxۮ0\x89u\xb8\xb1A\x10\xca\xe6.\xed\xa91\x0f6Ok\xcd\f\x8fBk\xe9:\xfb\xa8\xa9\x02\x9fOM\xfddꠏ\xe6ϭ\x0e*u-\xda\xeb\x00ӿ\xee8L\x17\x9f(\x1c0\xd1ӻ+;\xaa\x8d\xbc\xaa\xc1\xacH\xd6\xd5~\xc7>\xb6 \xd1\xda\xe6^\xacRR\x8bf1\xf5\xdbO\x0f^\xaf\b\xad{e?\x17\x81zS\x94\xbfE\"\xd7\xff\xb7\xd8ȯ٢\x8e\x83\x10\x0e`0\x1c\x8eC\xb9,4\xa7ٟ\v\xa7\x9a\xc8 F7\xd5\x1a7ԓ\xee\xe3,\xed\xadw\x86\xb0\xd5V\xade9%\xe5\x9a\xef<O\xb32\"YJz\xad>\\5ؤ$\xaa\x9ag3<\xb1\x94q\xad\xc8'OLbp\x00c/sV\xe6\x04\xf2P6h\x05\xc3i\x8e/\x02\xa7l\x96\xfb\x88\xb6ծ\xa1\xbcFz\xe1\xe3\xeao\xea*\xa8\xc0\xd9|!\xc4\xf2\xbfԅ/\xcd׆\xafL\xc6\xd6\ro\xf7\xf6Y\x03\xe5[\xd6̕a\x9a\xbbF\xeel*\xde\xf7F\xc8:[2x\x0e\xa7\xc3aظ4\xbb\x92\xcf\x1e\x8bSM\xab\xc3u\x86}aW\xaa6\x8d\xde\xed\xec\xe1{\xf5\xb2m\x00\xd0͠\x87\xcb\xed\xf9\xd1'\x10θ\xd8%\x0f\x14^\xdab\x03%\xe8\xae[\r;3\xfbb52\x8a\x8e\xaet\x9d\x82\xbf\xa3\b\x16#\a\xd9ȇ\f8\x15\xa8\xf5\x0e\xb9\xf1h52<`WCn2Z\x8d|>\xaf\vQt!\x8a.̢\vQt\xe1-\xda\x1cˢ[\x9d\xfb\xdd\x1f|#x\x88\xac\n/\x1ey\xbaɰ\xf3\xdfslSo\xc9Z\x8c\r\xaf9}FJ\x9b\x1e\x89\x9f2\xe9\x1byD\xec\xce\xdb\xdf\xe5\xb3,\xcf\xeamԾ\x91E\xb0Hhda\xc4O\x9d\xcc\xc3d\xd9>N-\x82\rLB\x1fp?Q\x96n\xcc5tv\x8b\x9a\xe6\xeb\xf5r\xcaJ7\xde,\xd6m\xedh\x89\x98O|\xbb\xbaq1\xb2\xa3\xd6o\x1a'\xf3\xf7\xab\xfb\x1b\xeb\xa8\xddF\xb91\")\xb6\xf5*\x9c?\x80_\x0ft\xc5P\xfa1?IU_tt(b\xc8\xedU\xff\x03\x9d-T\xfd[\xab\xfe\xed}\xeaoX@\xb8\xecw\x9dO\xd9ZPv\x1b\xb5w\x86\x18\xb4\xea[\x9e\xd4,\xe2m\xb1\xae\xcds\x1a\x9e2\xe0\xef\x88u<\x99\xa4\xd2\x17YnFϠI6\xae\xe7t\xc0t\x15A\x06\x13\xe8\x8f\"\xc8\xf9[Q7\x92{Jߜg\v\x06\xc1\xe1a\x06\xcf!\x0fy\xd9\xc1\n\xaf\xe8\xa6\xc7o95o95?\xc6\xf5\x9cWgyA\xcbc\x13,h\xb0\xd4i\xd0\xeeG\x84J\xc6A\x03\xff\x138\x15q)\x02\xfax\xb3^\xca\x06\xd2\xf7wyJ\xbep\xe9\xb1\xd8\xdb\xe6\xa1~\xa4Ff\"\xf4\r\xdd'\xc0\x89,\x8b\xe0\x9dl\x02\x11\xc7\x11\xca$U\xa7N\xba\xe1[\x03\xce\xd16\xfe\x19\x1e\x06\x1c\xfc\x9d\x05n\x14x\xe7\x16\xc0\"\x87\xe8\x8c\\\x97\xd9\xe62\xbb\xba|\xe7\xbdu\xa2\x18\xc0{ɼ\x11i\xb5Xv\xa2\xe2\x88\x01x\x83\x15m|\x16Ӫ(\xeb\x7f\xa3\xb6\x9b\xa4\x19X\x11@\xebv\xf6\x039\x868h<\x81\"\xf82\xbe2\xfa\xefrj\xaaQ\x1f\xc26j\xdeH\x010\xeb\xb1[\xdaP9S\xe7\x12\x03@\xba\x93p\x8btUi\xa0\xbb\x03\xa9\xd7_\x16\xf5\xcee\x8bF[Pt\x02\xfb\xd1ƕ\xb8\xfd\x7f\x009\xea 7\xa6?\xdb}\xa4\x8c\xbc@\xee'f\xfcE\x03\x98\x18\xddJ\x17.\xd2w\xc6k\\<9%1\x8c\xe0\x16\x94`bR\x8ai\xb1\xa8\x9a^\x89\"ѽ\x85\x03\xdd\x10\xb3\x9b\xaa\xcb4\x83C\xe8\xd1\x19D\xfa\xeeJ\x9d\xe5\xcb\xff2\xac\xed\x1c\xd2,r#JɜwN\x8ez$\xc6~C\n\x8c'[\xecפ@\xbeBx\x0e\xb7\xfeP6\xce\x18C\xa2]J\xbdt\x9a\xb4l,Z4%\xd6\x01\xa6 \x83\xecz\xe8\xd2s\x047\x8f<\xf4\x10OWv\xe0\b5:\xf6\x94\x0f\xec\xd2\xcc\f\xb8\xb1[4\xd4#$\xba\xf7t\xe7a\xc7+ۋ\x06x\xa7\x00\xb2\x86c\x1b\xc77\xa0F\xa3\xeb\b/>\xb0ތ\x040gb>uY\xe5\x9e[\xc5\xe0\xa5SN>\x9dr.\xc0\"'\x97\xa7\x9e\v(+\xf3\x03\x9c\xb7\"\xe3\x7fې\xf1\xbf6\xb2\xb0\xdb'Ν\xdc^Q{C(\x19~\x05mw\x8dy\xa6\"Sp\xa9{F3f3^K\x10\xc4\x03\x8b\xa7\x87\x10\x0f\xec\xce\xe0\x1b\xa4\xa9\v6m\x82\xb5\\HG\xf9\x91K=\xae֜R1\xebWr\xfe\x8c \x8f\xd4\x04Hӝ^.\"{\xfe\x8d\x8c\x06\x98o<qD\x0fүyQI\x7fN״$\xb6\r\u074b\xe0\x9f\x8e\xa6\xa4\x94\x04\xa5\x1a\x98{\xba\x1dOR\xad>\"|\x8c3\x03hT\x9bOB\x9af\xfd\x03\x1f0\x92\xc5\xf5#F\x06\xc2OG\xe3\x1bC\x11|\x18\x99\n\x83I\xa9\x89\xb6I\xec=i|%\xcb=\x8c@S\xcc\x01,\x84\x1b\xd3Ȓxg\x8d\x9d\x14&\r\xea\x1c\xe24^\xa9\x8a\xfb0W\x1e\xd4͞qPK\x15\xae\x15\xb5\xd93;^P\x13[\x8aY\xe1<\xba\xe5\xd9R\xac\u058b\x8a\x059yW9G\x9c\xaaد\xeb8\x15\x86\r\xf4\xa6\x1dG\xb0\x1d\xbb\xe6C\x84\x19\xf0\x9b\xe7x\x1b\xd1F\bf\xc4=\x82LpeG zQv\xab\x92\xb72\x19o^\xe50\xa1G\x85\xf5\xf3\x12)\xbd\x93\xb1\x81C,s\x00\xe9\xb6\xe1N\x14lƨ3\x8c\xd0̞\xe6\xb8*\xceY\x1d\xbb\xab(\x12s\xa3*\x9d\xe3\xdc\xcd\xe1\xf1\x9f\v\v\x94\xa8Ym\xa0O\x1ea\x8e\xfa&\xb3\xb7\x94\xbdmf{oJ\x9a\xeb^\x83{_|\x01Y\xf5-\xee\x82Y\x90\xe6a'\xe5T\xe2\xd5g#\xbf\xc3c\xfd\xb1\xc1\xb9\x8b·B\x95\x7f\x9f\xe1\x17\x96\x0eVb3\xca\r|j\xec\xa6H\x8b\x91\xa1\xbc\xefH\x94\xf1B\xd2zɚ\x97z\xa4\xa0オmu\x03eF\xec)\xef\x12\x8a\x99/cժ[֗0\x8a`D\x0f^\x93[G\xbcX\xcd\xe3\bfe&_\xfd\x1a<\xa3\x98\xf87_gU\x8d\x06vn\f\x14\xbb\x7f\xaa\xfe{#\x97þ\xa9Kao\xf7\xc1\xca\xdc\b8\x17Q]=\x1e\xa2\"\x10\xdfr\x8b\xc8`\x14q\xe9\xc5\xdfO#\xa0\x1b[\xb7\xf1\"\xa2^\x93\xdb{\xacI\xfeNE\xfd\xf4\xa4(G_I\xfcb\xe6\xe0\x8c\x14\xefP\xb4\xccE\x01\xb5\x1f\x0e\xb0\xd9\xcfBx\x8e\xe7V\xa7\r\x0f\xc1\x01\xfa\rz\x9c\x04\x91\xb5\xa6BH\xc8\xce\xf9\x1f\x98\xc0\xd0\xeb<\xe8\x19'\x1f\x9c y\xd4h\xb1(\xf0G\x1aMsM\x04\xbf\x92ɢ\x88\xa0\x8a\xa0\x8e`!m\x19\xa6\fA\x90!\t\x17\x80ۀ\xe5\x05\x1c\x1eZ\xbb\xe0B\"\xbd4\x95t\xe4n1\xb0\xdf!\x04\xa8)\xd5~\x11\x90oN\xb9\xebV\xa5\xa5\x9a\xdfr\xe2\xae[\x95\x96i\xce\xec\x05\xed\r\x0fp/\x038\x02\x9d\xeb1\x98-\xbaC\xf7)^\xca>\x80\x80\xca\xea\xc9q\x11\x86\xd0\xd72\x806(8\x02\xeb\xd2\xf7\x06;\xd5J\xd96R\x88|NS\x80BR\r\xee(\xae\x0e\x1cAP\xcb߇*پHO\x8d\x9c@c*\xa9\x06\xb4WSHGЇ\x9bІ\xd8\xc2a\xa3\xa4o[pc\xb0D\x8c\x16\u05ec\x84\xe3\x1a\x0f6\x8e`lw\x02e\x8c\x9c\x8c\xcc1\rP%aǞ\x91K\n\t䥽\x9d+x;i\xe3Z\f6\xa1ˇ\x82\xb7\x92\xdcF\x8a\xc1\xd6\xc9\xf7\xb5\x96\x8f^\xc7?\xc0\x9cL\xbeL\x92\xf5rM\x11\xa8~\xe5\xf3\xde5+\x96\x03\x9c\xa0\xeb\x92\xf1\xb5\x1dϬĤ&f\x83\xb0\xbd\xf9]\xed&U%0[\x8f^\xe3\x1bֈ\x90\xfb\xeb\x00äՁ\xd40\x8apߍߞ\xfbv\x7f\x0f }\x85\x8f\xa2\x82l\xf7\xc5`\xb5q\xbb\x83R\xb7\x9d\x01'\n>$\x82\x82VR\xf1wB\x1d\x8c=(\xd7\t\x17\xb3(\xb2\x15ExUۖ\".\x1f\x84\xe7\x05\x1e\xbb7<\x80\xf8*\xf6\xc87ú\x9bNs֗\xcb\xc6\xfduo*\xa96\x84\x02\x8d{\xd8C\x95x\xaa\x95+\xd4\xfd\xab\xa5\x92\xb2\x16\x89f\xdfj?\xe5K\x8e\xf7i\xaa\xa1\"<\xac\xc5\x12\x81\xd9p[\xefh}U\xb2\x8d\xaeԠ\xa9A\xa8\xdb\x00CoyX\x03$\x02\xb3\x01\xb62t\xef\x06\x18\xaa\xd8\xfdir\a\x9b\x81lߎU:\xda\xfdk\xb7\x95d\x85\b\x87r1\xa3\xa3\xca\t\xf4$\xda\x1e\xbc\x84\r\x9c7OR\xdb\b\xd3\xea\xe2\xfd)\x13e\xf5\x89\x8eD\xb5o\xe5R;\xbd\x7f\xd5T\xf2B9=s4\xfbV+U\xc9\xfbWK%MC\x01%\xb8{\xd9\r\x06F\x0e\x95ƺ\xb9\xe0+\x82\xfc\x1ez\x021\x88\"\xae\x8a\xcc\xfd\x8c<J\xb2ؐ\xb4\xabɛ\x96\xf0,\x03t'(\x03\xadƇ-7/[\xa7E\xacٯ\xfb\x1bN\xcf\xe2\x01\xb44[\xb2\xbc\xa2\xb7\x99\xedG.\x85Z\x8e\x1a\xe0\xb4(\xc9\x10$~\aY(\x0f[\xb8\x96\xae\x80\x94\xa6\xbei1\xb5/C\xde}Y\xf5:~\x1dl̲\x97\xef\xae.\x151Wa3ʁj7\xe9\xc3e\x9c\xa7\xe4W\x7f`\xcd\xe6\r\xe7KEt\xe3\xf2\x99\xaa\xd9\xcc\x01\xabŗ\x96\xe6G\xbb\x8bw$\x1e\x80\x8d\xc9qw\xf1\xce.\rV\x83\x1a(>t\xa0[z\xd1a'\xe8M˻\xab\x8b\x96\xca\xe4\xf6e@\xc73W\xfc\xf8@\xee^\xc2\xf6R\x1c\xc0)\xc5Q\x85{Z\n4\xba\xecʷ\xb5\xc3c\xe6h\xaf\rޝV\xec#\x98ke>\xd2uDP\xb4\xed\xf6\xf2\xc6n\xcfQc3q\n\x9e\xe9\x86\x15r\xbb3i^pV\xfb,\xab\x1b\xd5\x16\xcdJ}\xe8\xce\x13%Q\xac\x13\x85zC\x7f\x02\xbd\x9c\\Vz\xa1\x91*\x1a\"\x13ڐ(\xefe\v\x89LUHx\u0095嬭v\x95\x82T\xa9>\x98\x8f\x82\x17x\xac\xacKY\x1b։\xa5\at\x94:<T\xe2\xca\xd9og\t\x99\xb4\xb3>\xec\xdd\xeb\xed\xfb\x06>\xedp\xa5\x9eo\x17\xd4<H\xc1t\xefB?\xfc\x96\xe0\xb7\x16<\x06ə\xb7\xc0\xafx\x05r\v\xd1\x02ı\xcaM\x83\xdbN\xb1\x8b\xb3\xa4\xcc\xe8h\xa9eX\xaa\x85Ʌ\xdd|Ru\xf0\xbe;\xe4_f\xb7I.ڽ\xf7\xa1\xed>ڽ\xab\xb4\x15\xa7\xe6\x0e\xc9\\\xe8\\Ӡ\xb9\xe2\xf1\xac\xce+\xfd\x86^\x11\fF\xa1w\xd9,V{\xa3\x18\xfa0\xb4\xdd+7¤\x86 \x80\xdc\xfb\xe8A(.\xbd\xa1\xcf\x11\xf7\x05\xad\xb7ₖqyα\f|-\xb34\xa4{\x17R]\xb7\xf3\x16fy\x1a^xo\xa6\x15\xb7\xac\xe4\xe7\v͒?H\x00\xc3\xdf_\x1dG\xb4\x81\xaf\xeb\x90\xcb\x16\xf1\xa1\xdb\U000cc2b5_\xbe\xe3\xc8\xf1\xdex\x9d\xb9{\x86\xc6\t\x88\x1a\xb2(\xcdd/L\xf1\xff\xb7\xb6\x8fU2\xcf\x16i\xc9\xe4*5\x90\xdf斘f\x8c\x8d\x95\xb2\x95\xf0)[\xd5s:\xb0\xb0\xf2\xe9<$\xb5\xcb\xd0a\x88\x86\xe3& Q\xfb\x17_@\x90\xd3\xc8\xe0\tR\xbdu\xf52\xe5\xd3\x16A\x12\x99\x91\xfe\xa9B\xaaFz\t\xa4\x1b82S\xcea\xe8\xd1\xce<\x96 Ÿ\xc4 \x88f\x03\xe4\x1f\xa6\t\x8c\a\x92\xa3\x17\xae#W\xda6\xb6\xdd#\x04\xe4\x9esF\xd5\xd5+\xbc\xfe\xe1\xa7aa;7R\xf3j^\x1a\t2\rV\x84m\xba\xf9H\xbf\xbf\xe0^7\x94B\xebxh\x91\xb6-\xec.\xf3\x8c\x95q\x99̷\xe6d\x9c\x9a\xb3\xb0%\xd4\xdcA}\x18i\xddI\x1bA5g\x11\xaa\xe1\xb2h\x98}\xf4a\x8b\xaa\x1e&\xc6hS\xa9A\xf3X\x85cPM\xfb\xac\x96\x19U\x8b\x7f\n\x913\x8f\"\xf7'>\xa5\xa8b\x91n\x9fwRɺ\x0fTW\x19\vҸ\x8e\x1b\x9dw+\xdf=\xc2\\\xfb^\xa1\xddӊ\xd8C*\xc2\xfbx\x951\xa7\x8b\xf5\xae\x10\xb1ǸB\xcb\xc5_{[5m\v:O\xdeIw\x1c\xeb\xe1܀\xb1\xea \x17=Y\x87\xf4\xdbj\xd6 s\xda\xf1K\x88\x10\xfaf](\x8eǃj\xbd\f8\xaf\xac\xca3\xd7\x7f\x95\xd8(\xc4\xe3\xc2\xf1\x19\x82\xc7\xdc/!\xe4Ÿ\x1b\x10\xfeC\xd4j!Xe\xecMQ\xd6_m\xff\"&C\xd5%\xb8)\xf1t\t'\f\xb7n}\xf9\xdb\xd8\xd4\xc0\xf9N\x04D\t\x92\xce\xdd\t\xf1ǻ\xab\xb6>-\x13G\xbf\xf3\xbbr6\x82Q\x1b\xd3*\xe2\xb8l\xf8\xe8a\xbd\xe7 \xc9h\xbaݥ0\xd1\xcdk\xf3\xe3\x8b\xfdn|1M\xecx\xac\xd1t\xd8\xfb\xe0\xce/H\x9e;\xbdP\xc50\x01\xee\x96Ͻ\x9b\xa0\xbd\xd7\"K\xdcq\x9aӢ\t\x86C\xa9\x98\x832\xb9\xce=d\xfa\xa1\x92\xba\x8b\x16k\xef\x04\x94\xd9V\x17\xacR\xb4\xe1a>/\xa6\xb7˾\xf5\x99,y@\xad\xaa\xb8\xaa\xdbD\xb8\x0f\x05F'ܿ~YX\xd6c \xdbU\xb7\x9dl^F\xf0\x8f\xf9\xe6ł\xaa\x8e\xfdg\xe32\x01\bB\xce\xf28&\x1d\x7f|Vf\xf7\x9b\xe9\xf9X\xe33=!\xef\x9c\xeb\xc9\xe9\x03\xf1\xf3\x8a\xf6\xaa!\xb5\xa1n\x1d(\x05w\t\x1b\x8b\f\x02\x8c`\xdbL\x84+\xaf;\xb7EiQ\xa6\x8c,e\xf4\xc3\xc2\xc1\x1b!\xf9\xa7\xc4L\xb1\xeex\xb0b\xe5r]\xb3\x80\xa7E\x02\x99\xa1\xe2\b.\x18\xa0\x12\xa9\x03J\xa4P0\x14\xa2\x85~\xedA\x8c\xf4;\x10l6\xedR<\t\xe3\xdc\xc8TnŲ\x8dO\x9dֻbmӠP\x927y\xa1\b\xad\xe8\x9bS\xc8s\xcd\xe7q\x8c]\xf5\xa8}W\xddRW&\xeb:\x9c\xc8\n\xc8\x11ZT\xa2j͚\xb5\xb6l\xc5Q\xe2\xbd\xf3\xb9\b\xe9*w\xae\xa2\x8b\xaci\x9dh\xfb3&\x8b8\b\x91\x8e`\xe3BQ\xfa\x7f\xb0\xb2\x88\xa0X\xfb\x00\xd65\x8f\xd2\xeb\xa4\xff\x95G\xecuR\xc5\xc3\xce\xf4{p\xfb\xf0\a/o\xad\xc7.o\xdb\x1e\xba\xa4z\xacI\x8b\xd7,Y\xf2\x90\xa06)+uL\x1b\x8e\xa6\xfb|\xc9\xc7\xf7jp\xcdj\xac\xf5\xf7\u07fb\xfae\xdf\xe6\xc8\xce{@{\xacp\xe9\n\xd1\xfdZ\xc4GOG\x93\x94\x10\xed٠O\x1b\x86\xb9\xb3\xaaO\x1bS\xb9\xbb\x9b\xd6\xf5\x83*+ֺ\x83\xd6\xf5^\x15\xba\x19\x9e\xd5\xd9\x10F\x11\\ ^\x89\xe3\xb3^\x96WY\xca\xfaź\xee\x19\x8a6N9\xce6Y.\xbd\xf6\xdc̟\xdePk\xb2S\xe7\x0f\xf1\x86\xee\xf3Э\xb8e\xd5\x01\xf9\x13K\xd7\t{\xb3^\x86QsW\x92\x87\xfb\xdd9Xƛ˘^\"\x897\x97S\xbd\x85\b#\xe0v\xc6a\x04\xfc}f\xfe\xbb.V\xd2Ǐ'w\x9f/4\x17\x01\xba\xe5\"\xee19G\x03\xc5\n\x9e\v\xacf\t J\x0e'\xc4\x0f\xe7h\t\xc9\xe1\xa7Aﺟ\xd9\x16m\xf0c\x11-\xf1 j\x89%+\xe0Kv\xcbJ|Xa\x90\x14y\x12\xd7\xd8\x04\xb9\xda\x7f\x88\x84\xa8\x11H\xab\x9c\x18&\xc5\xc6nR\xe3\xb7P\xf6R>\xfd\xf5λ&\xc7GR\a\xf2ɵ\xd2A,\xc1\xae\xb2żX\xb3\xbaf\xf7\x13\xeb\xa5H0ՐJ_\xfb\\\xc6\xf2\xa2\x1cWMD\x9c_Gh:u\x14-V\\#\xd1ҕ\x1d\x1e\x86\\q\x10\xbbH\xae!8~X\xf0\x82\xbfJ\xc3I)tn\xa5.g\x16\xa1\xf7\x04\xa7\x93\xac\xed\x90\x1f\x9e\x06\x88\xb7/%+4}\xfa\x1c\xc9\xd9\x0e\xad\xae\xbcˮ\xaf\x17\xf7\xe4\xf6Fs\x9b\xb3~\xa3;\xc2\xe64*X\xa3\b\xaaq\x04\xd517'\xa3\x9e2lt\xc0v\xc8\x03\x15\x93\xb274-\x96\x8a\a\xa3}\xba\xa6\x1a5G~5\xea\xe8\x1c\xab\xf0\x98\xff%\xcb\xf0\x06!)\xc6\xd7\xe6\xf2\x1dW\x05\x87W\xedz%\xe1\x11\x97~\xabc\xec\x11\xabB\xe8k\x0282\x1eM\x82\xeey\xa6\x9b\xf0\x02n\xe09d\x88\xf8\xc6F\f\x88\xeeP\xe2\xbbq\xf0\xdd8\xf8\x9c\x97\xf7\x8d\xdf\u0558\xa6\x9ec8\xe0%\x9b\xac\xf8А\xaa\x82^\xc3\xc2w\xb4\xab1\x1c\xe1/$ֶ\x8bs\xe9~\x0e\xc50\xe4=W\xec+ż\x96>v\xf8E\xa7\x8c\xb2\xcd*\xceӏ\x9f\x11n\xc4\x1d\x8a\xfc\x1f9\x11\x84\xbe\x15\x8a\x8aZ\xa5\xe0\b9\xc9W\x91\xfd\nL\xe0\xe6~\x9c\x9f\xc0\x0e\xbe\xbfgeqޮ+\xaa\xf9ݴ|Wuq]\xc6\xddq\x1e\x15\x94\xdf<<\xcdr9q7-ŔRF \x82\xcf\xc8\xc7\xe6\xe8oi\x1e\x02\xf0\xa2|\xcf^\xcfKV͋\x05\xddg\x9afyn\x83Ra\xbb\xc44\xcb\xedp\b<\xd3\x12/\x8d\xd5x\xaa\x9eK٬d\xbf\xaeY\x9e`L\xa7\x11\x9cK\xa9\xdbx]y-Uc\x9a\xe5\x9cFa\xab\xbc4\xe4g\x9a\xe5\xfc\x98L\xd7L\x97mi:\b0\xd7\xcd4\xf7\xa9\x98\xbf\xf5yoPl7\xd7g\xeb>\x8e\xd0\x1b\xd3F\xea^B\xda\xc0\v\xfd\xe0\x1f\xbd9\x05\xcf\xf5\x93\x7f6\"\xab\xf9x\xee\x9bU\xfc\x15w\xd9\":PC_\xaa\x90&\xbd\v\xb7,\xf7(\xbfi\xa6\xf3\xf0\x06|\xbc\xec\xe9|-5\xad,o\xd8eu\xf7\xf2\x97\x1d\x85씆\xa9\x96\v\xa4\xb5\xc1Vb\xff\x13\x977.\x88~\x90\xaf\xb2\xfcM\xbd\xd6WUT\xc6ǚlKkO^z\xf6\xe4\xaa*kâ\t\x90C\xee\xfe\x04p\x9e\xa8\xea\f\x0e\xb5\xfa\xc0\xee\"F\xcc\x15\xf7\xa7\x853_֦\xba\xc2\xdeW\v\xb7 \xf34\x84\xa8\xf6Y\x15\xbd}\xf8-:\xdf\ab~ل\xe6\xf1\xc8Ǵڔ\xbf\x87\xb8\x00\x8b\xd2\xda\aX\xa3{\xfcx?y\xf0e~p\x17\x03}8\xdau\xed\x12\x9f\xbf\x92'\x85t\b+\xdci<\aܕyZM3\xb8\xaa$\x02\x03C\xc8Q8cK@~\x97/\xb2\x9c\xce\xfb\xe3:\xb6\xee\xf3\xebàG\xceq\x8d\xe1\xb90\xe1U\x989B\x81ǟa㤽\xb2\xcf٫}\x1c\x15\xdcS|\xb9\x1c\xea(1\x9c\x96C\x9c\f\xd3\xfdf\xe9\x14&\x8aٜ\x12:x{'\xb9}a\u008a\x97\x06\x04\x1dfV\"\xe6R\v\xfe\x96\xb4\x9e\x81u(\xe4\xbeu\x84ƀ\x10\x12}\x02鸄Q\xe1\xd0\xf4\xbf\x98\xc0\xadǽ\xb8\x11\n\xc2*a\x9e\x14\xb7\xc8\x06\x1a\xc0\xda\xdehѭm\x84U \xc4\xc25F \xda\xcf\x01\xe3\xf6\xd39`8}\xdf\xde\xf1\xd4!\x92f\xcb#\xe5]\xb8\x0fOo;X\xd91\xa2\xb0\xe5p.F\xcb.\x96\xef\xect\xb9ny;DUn^\xa65]C.\x8dw\xd1\xc4\x14C\xae\x1f\xb6\xb4wyw4\x8e[U\x9dxl\x16\x99]\xee\x81y\xa5\x05@4\xcc\x03\xf4\x17=bT\xdag=\"U\xb58K\x8b\xac\xdbh\xd3C\xaewخ`\x06\xb2\xfb\xd2\xf1\x99O\xa6wU_\xb2\x06\x01eQԦՌ\x8f-L\xc57\xc7\x1d\xfc\x98\xec_/\x8dz=F_wH\x19\x0f՛\xde|;NeWt(\xfb\x00\xa7\xa9\x88\bW^\xc1:\xb69&\xd3\xeebh\xa5X\xfb\t݊\xbad\xec/Y\x95\xd5_\xcejV\n\x1e\xe9ݻu\x81sPZ\x17w\xc5\"\xe2\x0f\xeaڅ^gc\xeb\xdfd\xd3E\x96_\xdb\a\x9f\xedN\xf37\xa6\xfb\x1a\x9ad\xa8u%\x1c\xc1]\x04ַ\xeda#ë8\x9b'\xeaSl\x97\x8e\xdbuc_\xb3}\x10\xaf8\xb7p\xa5-/\xda^,\xfe\b\x16}\x14U\xfdv\xaa\x1e\xc2\xdc\x0f\x8dNG\x92\x7f.㼚\x15\xe5R\x10t\xc7c\x10\xcd\xf9\x1f\xdcd\xdf|\x1a\xb7=\xae\xa48\xfcB\x95ōD\xe4w\xeeKn>\xb3_\x9fs\x9aD5z\xa2\x06\xbd\xfd\xe8\xa8A\x87owս\x87;!\x9e\xb3wz\x12&\x8buU\xb3\xb2sN\x130\x9ffZ\x8b\x00\xdf\x19Ȋu\xf5\x9a\xbb:\xf3\xee\xbc\xd9Dp\xb3\xbd\xef|\xe6\xc6w\xd9\xc7I\xba\xa9\b\xba:\xa0}\xd5J\xfaTk\x9a\x04?\xfe\xaa\x90\x84\x17n\x81\xad\xaf\xc0\xdf|\x05<\xc7U\xaaJ\x93St\xbe\x8cfd\xb6\x8a\xcb\xd8\xf0\x157\x81B\xdb@l\x10c\xa5Zx\xdd}\xc6\a\xaf\x13͂\xcdj_\x9b\xbeg\xb3\x9a\xaf\xcf\x11\x94\xe2\x92R\x03\xe8'̐P\x14\t\x11\xd1\xf1\xf8\x14\xba9\x98&\x90\x88\bg\x14\xa7\x96\x120\x9e\x81\tK\x89\x11\xa1\xb1N\\\x1e*8\x8a\xe5\x81\xf8%\x03\xfe\xe1{\x9e\xfc\xf7\x81\\\xc0.\x1e5\xb8\x1b P \xd6\xe5\x972\xfdH\xae\xd4\xe70\n\x15\x02\xe3\x05\xedO9c\xf2\xe7$\xc4N\t\x7fG\x06\xbf`\xe2p\xe6\x8d\xcaj\x99IE\xcf\r,\x1c\x0f\x98RUq\xed\\e t\xa7WQ\xab\x1d\xb8JR\xf2\x19\xa7u_\xbd;gWQh\xc7\x04\x8b\xdc\xee\x9c]\x11\xc0\x99ZU\xde,+\xab\xfa\x7fċ\x1bg\xac\v\x05bߩ/\x02\x15\xc0\x98\xd2I\x04\xda\xe7Ý\x1bc1\xf9G\x9c>\xdam\x19%\xacI\x9e2#\xa0K{uA\xeb\x8c*#\xb6qQ\xc3\xe2\xddmM\x11\x9b\x1b\xfb\xbeǅ\x05\xa0\xf9&j\xb0\xc8qn\x9a\x1a\xb4ūUQR7\xf9\xca\xe9f8\x18,(IW\xdbi\xa03\x10\xf1\x05\n\xdb|%\xf9\xbb\xccR\x1e\x8bk\x02\x83S8\x80@s\x8e\xf7\xe0`U\xb2E\xb6\x84CH\x1a\x89\x8d\x88d\x1d\xa2\x83\xff\xc9\xfd\r\xc78q%ͭ\xb0u\x15\x92\xe8/|ؗ\x05rǮ\xaa\xaf\xdai1\xac\xb9*6i\xf4\x16\xecZX\xff\xc1|h?\xf0\xd0N\xbd,)\xf2\xd4\x18\xf1\x1b\xef*\xa5G\xb1&es\xf1\x91jО\xc3\xdes%I\\\xa42\xa8Z\x16\xe9\xfe\xc3\xd9h\xb4}\x83\xeb\xbe\xdc\xd3\xc3\xd7\xdb\x19\xc6\xf8u\xee\xd9w\n\x05\xb9\x8ff+\xc1\xc8\bn\v\xe3\xb7OH\x10d)\xf9έ\xb9\x03kr\xac\b\xdbm\xb6\xd2܊\xa0\"\xb4\xb7\x85\x9dH\xf8o\xb3\xa5\rI\x89\x85\x9d\x88\x13\x89\x87\xe7\x9cB{\xd2\xe1\xfa\xd8m\x86\xcec\xbcev>)u\xb7\xd9*\xe4\r\xfc\xe2\v\x84j\\\xfa/\x96-\x05\x8b\xa53\xfc9Ǽ4\x14\xab&\xach\x961/\xbb\x96p\xe0͵X\xa3\xc7$\xcd*\x9a\xbbj\xa2A\xb6[C\xf66[\x12\x03<O\xeas\xfc\x8eq\xc17\x81\xff@A\x05\xad\xa4/\x05\xe1\x1c?\x17\x14%y2\x81*p*\x06N\xe2\xa4ٙ\x94W\xb4\xe5}xd\xe3 W7Kh.\x1eyj\xb1\x04Ё\xe0\xeer\x96\x8c\xb9\x10\x1cGтÍ-)\x04\xe9q\x9b\x144\xc5K\"\xae\xe7%\x8bS\xde\xd7m\xb2\x82+\v\xf2\x86\xf7s\xb1\xea\xa6d\xe5\xa1D\x89\xaeGΛ\x84\xac.Z`\x14!+\"d٪j\xb8\"\xdd\x11\xbbB\x96\xf2\x86$|\xb8a\xf1>{\xa5N\xd5S\xcf\xfb\xce\r/I8?\x96\x88\xec\x1d\xe8\"[\x9e\x83\x15\x85}Y\xa4N\n\x7f\xd1\xd7I\xa4\x01\xe0\xa4\xf1\xe3\xe2s\x97Jxٲ\x80sx8$\x17\x91a\xd7\x1d1\xadJ\xd2\xfe\xf5\xe2Qc\xcd\xe2\xec\xea\xe3\x1f\xbf\n\xe6\xdd?\xf3]\x18vY\xc3D(\xc5qYT\xfem\xf5\x8e\xb24\xa8Dᔱ\xd5}\xca~\xcd؊U\x9fm\x9f\x1e\xf1\xb7_\x90*q\xa6\xfb\xfb\xef\xfa0\xed\x1f\xb4{7N\x98\x8f\x90\xbe\xc6F\x1d e\vV3\xcf\xee\xe9\x9fy\x0fO\xb2\xf8\xf7\xdd\xc0k\xd6|0i\xf8\x8c[\xf7F\x8d;\xf7\xedXb\x8fM\xfb2^u߿K\xe2\x85>\xe0\x8d\xe0\xc66\x8d\x9a\x1el\x8eR\xad\xf6\xbdq\xc9\xe2\xdd!&\x11\ne۳\xeb\rUp\x83\x00\x9dX\x87\xf0\x12\x86pn\x854%\xe8\x81\xc0\xc1ú\xe0\a\x9d\vS\xea\xf3\x89*g\xd2\xe3\r\x81P\xfd\xba\x8e\xcbl\xb6\xbdG\x14\x84\xb6\xd3\xf7\x0e\xa3+\xa2+YR\xf3S\x1c^\x17\xaebw\xc2\x17\xa3d\xf8@.\xb7\x92+4\xd5\"K\x18\xde\xfa\x17\xbc\x9d\xb2\n\x11\xe8\xd7Ӫ\xa4(Y\x04ku$\x92\xe5\x01\xd62@\xd7h\xfec\x1bZ\xdb\x1a\xdeê6\t\xb5\x81\x03\to\x05\xad0\x98^\x16w\x92\xe5\xcd8\x16\xb4\xcfRXU\xfb\x1b\xba&\"!\x87\x0f\xd9\xf1\xaa\xcceN>x\xe1\x85\x03MU\x1eN\x8c>o\xc4%'.\xc0\x04\ue2b2B\x93\xec]\x04\xeb0D!@\x865_ڑT\xae\x8aU\x10:\xce}\x9cÄq\xe7\xde]\x91ן\xf0\x86!\xbe\x06\x89F\xcc\bN\x1a\xe7yďb\x9d\xfa\xbb{\xf2\xa2Q\xbd|v\xb2\xa5w\x8c&I\xa1٥\x9f!G5\xe6\x96\xc0$vC\xacWx\xf7\xa4\xec\x83=\x98Q\xda\xe5\xf5{9 \xc3\xeea\x8b\x11\xfa\xfeQ\xc3v\x9f\xc1*\xc7\xf6g\x1f{\x8d\xa1\xc4\x05\xb1k\xe0=`\x94\xf1\x9c\xf7:\x00\xc4o\x1d2.\x81_\xaa&\x9e\xcb&J\xa1yܘ-\x1e \xdd\x1f\xf6\x92'!)\xdd\xf2dM\x1e\xf6\xads\x83\x80\b\xca\bJu-\xa5\\f\xb91\xb6l\x1fo݂\xdd\xeb!\x0f\xfe\\\xf2R\x97\xd9\x15U\x16\x86\x90\x14y\x9d\xe5kǰ]\xc2s\xaa:\x94\x04\x94n\xfe\v\"2\x94\xa46\xb6Y\x15\x1cL@]\xa8]\xe3\xd7ڽe\b/\xf59\xfb\x1a\x0e8\xae\x03 =\t\x8e\xa0\x8a\xa0Bu\x94ge\xb9\xcc\n\xf1\xc0Ξm>\xb4\x04\x86r\xe6\xc3ź\x9a\xb7+\x1c\x9a\x9d\xfc\x80\x95\xe4iï\x1d\xd3\xef-\xf7\x8d[\xa3\xd4\x15\xeb<\r\x94\xd4\x1ca\x97\xe2\xeeJ\xdf&A6\xada2\x91\x02\xeav\x06\x11\x83\xfa\xc4-\xbc\x100ې\xf0\x8b\x8f\xfd\xbc$\vգ\x17\x8d\x88ٞp\xd9[;\x89\x02V\xdd6l\x92\x05w۷\xd7\tܤ\x88\xb6\xe0\xf6\x00y!\xf9PH.\xdc\x12\x17\xbc\xcf\xfa\x16\x83\xf7\xc2\xfb\xfc\xc2HK7\xdc\xf1\xaf\x89\xff\xc2\xd8\xd0#\xef\xe1ТT0\t\xfaFjc\rm\xe1\xf3\xc6\xe4\xf3\xe6\xef\xc2\xe7\x8d\xcb\xe7\xad\xe0\xf3\xb6\xc1\xe7\xad\xe2\xc3\x16\xfa\xb0}\x18\x9f\x95\x17\x96\xd1Ӓ\xd1N\x05\x0eO7^FolF\xfb\x06\x9dP\xff\xdb\xdc\x1b\xf9$\xc9*\xec\n\xd3\x17\xf2\xa3\\\xb8(%5^\r\xb03\x8cW\x03\xcca)I\t\x9b\x0et\x8e\xbd\x82\xd6\xd3KN\xe1U\xa4\xaa;P\xf8\xc5i\xb5\xbd\x9c\xaa\n(h\x13_\x1b\xe0\\\xed\x03B\xbb\x12E\xd16\xd4L\x9a\xd8a\xdb?2\xa6\x17I\x8f\x144\xfa\xf0<\x9f\xa2\x9dhp\xf9\xa5\xef\xc6\xeey\x19\xaf~\x8c\xd3ה\xcfi\x96\xb2\x16)\xda#1\x81\xcb\x03\xb8\x11\x1c\x1a\xfer\xa72P\x99@\xf7ٷ\xb9\xcbx\xd5\xd8[c\xbdݏ\x96\x19\xae\x89\xe9\xb7]\x8e3+\xacV\x98\x0f9fi\xab\xd6\x06\x92\xe6[)+\xfb\x91c/\x87Eu\xe7^\x80\x80\xd7!\xae\x7f\xac\xdc\xeb\x1f\x97\xb0\x8a\xe4\xff\xc1\x15\x9c\xc3*l\x8d\xa3\xbc\x8a\xd3WE^\xd5q^7Z\xd80\v4(\xd8x\x9f6\xdd\xdbs\vY\x88\x8d0<\xb9`\xa2\xfd\x14\xe9ͺݬ\x82s\x1e=ո\x10\x136#M\x18]\t\xe7\xc0\xe1m\xb6Q\xdc\xeaKzD\x97\xff\x9f\x18\x17\x92?\xd8\x1b\xc6\xe7=\xa4M\x8e\xc0\xfb\x8b9/\xf9X\xde\xd4\xd2\x13\x1cG\xb8\x91J\x14O8w\xc1v\x93\xa6\xc7\xf1\xfd\x87 \x15U\x83P\"\xdaX)\x19kyk\xae\x93_b\ny\x00\xbf\xb0\xa4\xaaI\xa0\xd9gb\xd8\xcb\x04F\xe3\xdbo\x05K\xaa[>_\xa6\xd5mЋ\xe8\xc5*\xb6\xa9\x8f\x92\xea\xb6'\xaf\x9d\xd6\x16\xcc\xffK\xc1\xd4\xf1\xb4/L\x84,\xed\U000fb26a\xd05+T\xac+\xe1}}͊\xb7e\x9cf1\xdd&\x93\xef\xee\x1e\xc1\xe8\xe9P\x17\x1a\xc4\xef\xb3庞ǋN\x93\x9c\x82\n\x92\xa2(\xd3,\x8fk\xf7.\x13\x99\xaa\x8d\\\xbc\x19y\xe0\xd2ѧ\x97\xa7\xb7.\xe8\xa8\t\x1aA\xb2\x19I\xba\x93\xa2\n6#4\xda\xea\xb4*\xcbyZ\xb2\xb5\xe0\xb6\x04\xb7\xb5\xe0(-I`\x82'!\x8c\x1e\xb0\xeb\x15e=\xc7[^\xaby\x96ฮ\xb6C8\xa0\x82\x87\x90\xd0\xef\x84,\xd6HƹX\xee\x12\xeeUMHhZ\xa8jV2\x13\v\xfa\xeb\xd2j\x96$8\x11h\xc8\xeb\xbcX\x16\xb9\x06J\x12+\x9b\xfd\xba\xcex\f\xee\x9a&\x99D\x92\x1fc\x9b\x92\x04釗\x90\xa8\xd7\xf2\xb2<H\xb8\x82\xe7\xe2\x89\x17\xa8\x00\xf6ॱ\xa2\x8e\r\xb2\xb0\xc0\x88\xef^nT++z\xfdO$\x05\x95\xd3\xfe\xbe\xe0H\xb5\x1d\xb9V\xf9K\xae\x00\x89\a\xae\xea2ΫE\\\x8b8\xa4\"gk匮\xa4\x19]\xeb'\xbc\x01N\xa7D\xc0c\"KD\x13\x18\x0f\x87\x91\xc6D\xf3\xf0\xc9\xd3a\x04\xe3\xd3!\xce\xc2$[C\x94\btc\x96\x17\xbf\x95\xec\x0e\xb2\xfc\x96ٷPڥ\x99\xec¶4\xf7\xad\xe6\xe1)\b\xd1\xc5\xf9\x168\xf2ܷZl\x00\xaf\xac\xab\x01\xf6\xcb`\xfc\x96\\\x87x\xa9\x80\x87q\x1d\xe7\xc1\xaa]\xc4Z\x81\x1cA[\xb5K\x8f\xae\v\x85\x8d\xf44¥\xd3Ѝ\xa1J́\x96\xc8q\xa6\x86c\xd2\x14\x98`3\x84CMᘘP%Ț\x039\xf4\x12\xc1\x131*\x93\x90\x87\x0f\xb5g\bMI\x92\b\xd8>\x04+xɋ&\x02\xdd\x11\xb5r\xe8A\xa1\x04ѕ\x14!\x8f\x0fxZ\xd78|\x16H\xb0{{=\x87\v\xaa\xaa\x16\x02\xb8\xe4?0Z\x18\x16\xd5\xe1\xc2\x04\"\x1d\xa8\x8a\x02ZP\xb2o\x8e\xd6\xd1K4ب\x1d,!8=\xfb\xea\rg\xa5sh\x0en\xdc'\xda\xc5\x039\xea\x1f\xa0s`Ik\x83\x06\x93\xe6\x93;\xbb\xea7'\x9a\xfbӠJ\xcbZ\xedy\xebpCS\xe4\xe1Ƙ\x0fwP\xe6fʐ\xf1\x970\x8c`\bW\x96\xa6Ak\xfbb\xca\x03mu,\xec\x04\xd2>\x0fҦ\x1b\x97\x04g\xe0\x1c@cr\\\xe4\xd7\xc3Н\xdf^A\x9f&\x92\x1c\x0e\xb4(t\xe2\x1a]\xd1@\xcd[י\x95\x89\xaa\x0e[W\x9d@\x01\xa2`\xd6!\xf4a5\f;\x97\"\xe3f좈\xd3\xc0\xe6\xc5j\x9e\x8d\xb8ffS\x8f*\xd9b\xc1\x16\xe2\x12\xc6<\x1bwC\xe1\xe5\xacE\\\x0f}Pj\xc0EP\x99\xa3\a\xeb\x16˃j\x12\xa5I6!\xf7;\x10j\xabG\xae6\xdd\x15\x1cZ\x15\x8cu|\xf0W0\x01\x9a>\xe1Pu\x9f\x1a\xf2\xab\xe1\xee.\xc6\xf6y\xfb\x91\v\x9c\xbb\xfe\xabY\xea\x12\xfaϞFp\xfc\x94\xef\xaa\x04\xcb(c\xfclp\x1a\xc1\xc9\xe9\xe0\x14\xae\xb4J0b\xc7]*\x01\xb2\x85\xee\x18\xbf\x8a`%\x15\x02\"\xe1\x9fD\x1b\x18\"\xf8\n\x17\xc4mD\xa3\xcd\\\x17)?l\xd3\x19\xb0\xec\x01A4\x97Xl7\x1cB\x8d=нtR\xff\xadĸ\xc2>\xccU\f\xa7|\x8f%\x93\xf3\xf2\xd3/W;fH9@}Ęb\xf3\x90\xc7\xe6Eimn0\xa5\xf0\xc1T}\xf6\xd5\xcc\x1cXV\xcd\xff\xc0u\xacI\x93\x87S\xcd%\xeb߫x\x8fU\xeb߫\xb8}\xc8.ȋ\xc6\x1e\xb34\xed6\xb6\x9e\x0e\xcd8u\xc1\v8E\u05cdx\x11W71\x9c\x13\xb6\xe7\xd0\x1f\x9d`\xea<\xbe\x8b\xb3\fS\xe3\x1a\x9e\xc3\x18c\x89\xad֬\xac\x8b\x9f\xb2\xa4 \xe8;V\x9e<\r-\xea\xdc)O\x00\xc1\xc4n\xba\x14\x1e\x04\x11\xd57 \xf4\xc2\xdf\x1f\x9d\r#8\xc3\xc5_\x8b}p\t\xa7\xa7\x11\x9c\x9d\u0095\x81LP\xbd\x13ٸ\x81\xeci\x04\xa3\xa7\x16.\xa3\xb5]\xf8\x10\xddh\a:Օ\x1f1@\x04+9\x86@/\x89V\xb26Br\xb6\xcad8\x80\xc1\x99\xca\xe3\\j\x14\xd1\r6\x8a\x8d\x06\xa7\xa1W\xe0\xb15j\x94\x04\x92\f\x9d\x12\xfa&\b\xab\xd0G\xb1\xc1\xa8\xc84\xa1\xa6x\xe6\xe3\xb0\n\x8e\xf8\x02*\xa2\x03\xe2\x10\xa1\x03\x91\x8d1.\x9ah\x1b\xac\xd4Y\x97@'s'Cܶ\xa5\xef\t\xdd!\x8c\x9e\xf0O\xb8r\x19\xdd(9zf\x95\x1c\x0f\x1b%\x8d\xbepJ\x1f\xc2\xe9S\xab\xf4\xc9q\xa3\xb4\xdbQ^\rۖ\xc9\xc0aZcҚ\x16y\xde}\x15\x8d \xba\xf5\x8b=Mg\xbb-gx^g\x85fَ|\a\x12\xc9\bu\x8e\x11?>\xfcF<R\xae\xf7q(\x1a+\xfb\xddsK\xf1\xfe&\xb4\x1f\u07b6\x94\xedoP\xd9NF\xadg\xae\xf4L\xb9Q\x97\x89\xea\xc0\xbc\xb1\xf6\xe1s\x18\x9b\xeeiMڎ\"\xd5\x16\xea\xc7\x7f\x06ͱ\xbb\x7f\x13տm\xba#)\xf7N\x17j\x89p:\x9e\xec5+8pt\xd3$\x84#_/6\xba\xdb\xe9TDz\xe4\xed\xffFwo<\xaa\xebv\x97N\xca;I.9\x0f\x9aJ\xb7\xa3F%\x92\xc6d$\"n\x12\xfdha#[\xf3\xa61\x0e\xdd)\x87\xc8\xf2\x10\xfa\x11\xba\xf3%v\x8d\x87C\xc3V\x16\t\xf3\xcff\xa7\xe5g\xd3e\xf4\xd9Ѣϭ\xea\xb6\xd5\xfb\x8fSt\x9b\x14\x99\x19\rC\x8d\x12\xce\xe0䴱\x9a\xa0\x85\xb6dI\x1d\xe7\xd7\xebE\xdc\x1d@\u0081\xbd\xd7\ns\x04\xc7gbE\xe9;\x93\x0ee]|ι\xf7\xb4k\xee兜\xb6\xfd3ͺ\x8a%\xc7g\xa8`l\"\xe8\xf3_[g*r\xdb\xf0\xb9G\x86S_'-\xff\xb8\xd1\xd2Ee;\x889F\x96\xacLbq\xeb\xbdupH\xa0\a\x8f\x8a\x80\xa6\xf8Eq\x1d\xa8\xb9^\x1f\xa3\x9e\xc0\xe1NM\xec\b\xc6\x1e\x13J\xf8\xe0\xf1\xa5<!\xfbh\nS.a\xf8\x81\xcf\xc9~\xc2\xc1'y\xf7\xcf=\xea\xec\xe30\xfa\xc56\xab@\r\xc6ƒ\xdc\xec\f\xe8ó\xa13jU\xe3?\xf7p\x95\x15\xf9k\xff\xc7\rP/]\x9e<sH\xae\xe2zn\x12\xea\t\xe0Vϝp\x1cƋ\xc6\x14o\xe0\xa78\xcd\xd6U\xe3Yc\xca{\x95\x95\xc9BD\x1cDTo\x13J\b\x8c\x82-O\x12\xea\x9dc\\\xcf\x7fޮ\x98\x11蓻\xd6W\xebE\r\x13\x98\xaeg3V\nF\xc2K\xf9\xfd\xae\xc8\xf2\xa0\xd7\v\x85\x1b\x80,\xcas\x9d\xa0\x8c¸U\xa9wt\\\xaf\xe3\xb2xǒ\xda?\x88Dq\x01\xe3\x8e5AG\xd4\v\xfd\x98\x8b\xc5\xf6\xbaȿ,Y\x87U\xacZ/a\x02\xb1\x03\x83#\x93{\x8d\x0fŵ*\x9d\xb7\xc3y\x1c\x11\xf6=\x18\xb3Ǝ\xbbZ/\xbb\xe8~\xc5\xf2\xba,\xb2\xb4\x9dv\x01\xa8,NˁHq\x9aB!\xd55\x0f\xc30\x92\x17\xbd\x04<9\xc8\xd2\x1d\tQ\xa7\x91%\x93\x02~\x83\x8b.p\xf1\xdf\a\xdc\t|\x84b\xd0ǳ\x18Z<\x048\x8d\xa5\xad\x99\x80'8\xef\x05\xaf\x1f\xc8\xdb\xdf\x1e\xe9{\r\xfb4=k6\xfd½\xebf\xb3@g\x7f\f+\xcc}]\xdf≹\xbf\xec[\xcc\xd19\xef\xa5\xfc\xb4o\x02#\xd8Fp\x06\a\xf0\xbe\xe5\xb8.n\x15z\x81\x84/\x12\xd3*\xe8\xe0_\x83y\x82I\r˭5U\xc1\t\xae\xbe\xfb\xcePx\x96\xa3jpm\xa7h\xcf\x0e\xa3\xe6\xbcBu\x8a\xa9K\x1f\xf1\xe1\xbc\x19\xc8f~\xcb\xe2z]\xb2W\xc5b\xc1q\x1b\x0fK\x14\xaeu`Ɓ+zy^~طFd\xaa#\xa1M\x19\x15S$]\xeaQӫ,\x8d\x12\x89\xdcfu\xb95\xdeJ\x8el\xa2\xdbHU\xe8\n\x8d\xa4\x81\xe3G\xe4m\x1b\x06\x93\xb8\xde\x0f=\xc5\xfb\xa0\x18\x98\xc2bu_\xb3\x8a\x1f\u058b:묇L.\x1a!\xf1\xd5\xf8\xb6Y\xdb>\xfe\xbb\xb9k5\xc0\x99jw\xb4\xe0\xfb,go\xea2˯?{\v\x1c\x8a\xc3=%\xa7\xadY\xbd\xefM\x14\xb2\x88yc\xd2\xee\xa6\xcf\xde\xd2\b\xaa\xf5Ԃǧ\xc7\xf6\xbb\x1eb\x97t\xb6\x1e\xf6\x9d\x91w\x8d\xb8]\xf4,\xe5zڤ\xc8\x04j\xe7\xbeA\xd9;\xfe<\x87\x8f\xffv\x05\x97\xef\x9a]\xe0\xef\x04#r\x90\x1e\x984\xc1\xfe\xaf\xd6\rtշ\xb5/\xf0\xfc\"\xf4Ŷ\xe9\xea\x98O\xd75\xb2\x9eK[\x91\xc5\x1b\xcd0\x81\xde\x7f\xf4vD}rf\xbc\xbf\x7f\aR\xaa\x9b\x81\x0f\xb8\xfe\xb3\f0\xb7\xa7\xec^n\x10\xdf@鼐\xc7\xe3\b\xdbt\b\x19[\xf1\xb2\xf7\x13\xb3]\x82f4\x00\xa3\x1a\xacZE͡\xfa\xc6/m\xf7\x927S\xe2:\xa5\xef\xdf\xc4b\xbf\x9f2#T\x83L\x88\xa0\xfe\xb4%P\xa7?L\xa5\xd1\xe5qar/\xf1\x9ag\xf6%\x8b\x85\xa2\xb6\"\xa5\x93\xeb\xdd\x1f\xad\xb4\t<\xc3蓪o\xf2Ƶ\xa4\xdaTݚ7\x92,-}O-\xce(\xba\x872\xd79\xe5\xc8\xed\xb1\xb1ѵ\x15\xb9\x8b\x87Nd|[\x8c\x9e\xe3\x9fC\x8bC쇓\xb6\r\xba\x97\xd3j\xb3\xfc\xe0q\xa1\x9a\xf4\xe9\a\x88h\x8f\xeaR{lt\xb6E\xbcĦ\xae\xa5\xd2*\"v\x85\xe6\xa81\xb6\xa3\x1d#g\x87\xa0\x98\x88?F\xeeL\"a\xd20X\xf8e\xd0ؿ\x1a\x1bb8\xd2_\xe3\xab\xc8\xdc\x11\xdbYpՠr_a\xd63E\xa74\xcb\xcaT\xa0\xf0-\xff\xf3\x9e\xffy\xa8\xb0kZ\xa0\x8bi\xad\x03@\xdc.\xf7\x1a\x11čh\xaf\x19\x01ཕ7\xf6>\x02i\x1d,\xbf\xe7g\xc9\xef\r^[\xd39I\xa2\xb5]\xf7Ya-\x80fT{ɫ\x0f&Nˀ\xd0f\xdamy\x0e;t\xec\x0f\x9b\v\xf7\xb8\xdd\xce\xd7Fg\xd8\xdfL\xd1b\x8bi4\xc7I\xb7\x9db\xd6yj\xb5O\xac-\xb2\x95F\xf06\x1d\xbaB=\x8a\xac\x93d\x94\xb6\xbeN\xe2o(\xf7\xed\xf8\x0eb\xa2\xe0\xf5\xcaʌ\xd8fhF\xb29\xbc\x81\xe7\"\x80\x9a\xa0csa\xe5\xbe\xe0u\x87\x8a\x04+\x7fk<\xab\xac\xc8\xde\xda\x10/\x90\xd4Pл\xbd\xb0\xc4K\xc9\xe2%U\xaf\xda~\x15\xc1%\xaf\x917T\xcc\x05\xee\xd5>\xdeN\x9c\xdb*\x15\x1cP͋\x16\x8cH\x8d\x1e\xb5(\x1d>`\x9d\x1f=j[|\xacrM\x00^\xd0ܑ[\x05tF\xf4HMo\xad\xd0Nn\xf4ȵ\xcc\xecƭ\xa6\xce&b\x91Ł}\b)Mf\xfbЈTg\f$r\xa8u<\x01\xc1\a_\b\xbf96\xcd۬ʦrX6\f\x9aq\x99\f\xf85\xaeD\x81<\a\xcbM\xc3\xc1\x97,\xb2U\xbbq}\xd7n\rK\xafX*ℭ\x86\x11\xacF\x11\xac\xc6\x11\xa4\xf8\xb8\xf0h\xb7\r;\x1d\xc1\xc4!{\xdcآ9ϧ\xa5#ݨ\xb0\x19pz\x14J\xba\xc4\xfbm\xbcG\xaeK\x16\xd7_\x96\xc9wy\xcd\xcaUA\xfe\x81\x9c\xda0\b\xd2!\xf4\xc18\x88\xe5\t\xe9(4\x8d\xe5`\xe3]\x8d\xad<\xba\xff\xb0\x1a9\x17\x96\xbd\xe1\xb9\bj5v-\b\x8fWC\n\xf9$*\xf1E\xb9\x82\xbd\x9b&\xe0.ml\xb4\v\xc3^\n\x83@\x1fu\xa6<xc:\xe2\xbfC7\xdc:oٮ\xe0F)\x82\xa5\r'\xbd\xd5\xd0\xe9O\xbdv\x13\x1f$\xa1C+\xd0\xc6j\x84\xacX\x8d\xe9\xad\xec\tޅ\x10o\xaa\xaeƗ#\x954\xa2\xa4ǁ\x017j\u008d\xf8\xed!\xcdG\xbb\x1bGm\xebZɪx\xb9Z(^\x86-\x97\x82$T\xd7(\x1a\xb6\x0f\"n\xe2\x90hp0\xe5\x14\x87\xc1\xe6\x19\xc5b\xb0T5Y\xa2\x8a\xf4\xfd\b\x1cIU\xb1.\x13\xc3˷}\xf0)\fvIk\xf0\xf1\xc8w\xaeff*\x8d\xce\v\xe3\xefxkq\xd9S\xf8\xa5~\b\xc2Dr\x01\xa1n0\xef\x06\x05\x8bF,\xb7C\f\xe2xS\xc3\v\x7fW\xa5\x1dW\x88\xb8\x97U\x04)\xbb.\x19\xb5\xf9\x19\x0e\xf2\xc1p\x14\x81\xbe\x11/s\x9b7\xcf\xe32\xd1g3r\xc8\x05\xa1Cؠ\x8e\xcbkF/*e)\xcb묖[\v>\xa7\x0fP\x98̹?\xf5\xeaw\x92nG\xc9k0b\xd7\xe1\xb6zp#[\x893n\x8c\xa2\xa3g\xa8\x0fƎK\xc0|\xb6\xc3$:J\x93\xb5\x84\x83Y\xb6\xa0W\x8e\x1a\x8c2\xe8Vx0^~\x01\x13\xf8\xf3\x14\xd5\xeaA\x82\xecgA\x11F\x06~\xc3\xc4\x11Aq\xef\x93%\xc3n\xb4\x15\xf3R\xdb\x1eQѧ\xe0\xbb\xe83\x90ʟ^\xfa:O\x93D\x85r\xfd\xb7\xb7\x97X}\xf1鏨d\x17\x89J\x9b\xcdoNgH\x89\xc6\x0f\x14+\xe5\x1c\x8a\x01\xfe\xb5\"M\xeb\x92ִ\xe6\t\r\xfd1\xe7U\xa4\xde\xec؊\xfb\x1b\xd1ڛ\xad\xb6do\x9f~\x9a\xf3'5rԨi{\xa0N\xb4)m\xec\xc8?\xfc\x9d\x1b\xfeq\a\x05\xba\xc1]T\xa3[\xc7\xe7\xe9\xb1O@\xfd\xce\x1e\xf24\xf1\xc3\xde\xfd\xab\x9b\xfe\x8f\xeb\xe2Od\x92\x7f\xc0\xb2\xd00K\xee3\xff\xf2\x9a\xf5\x87\xd5&k\x0f.V\xea\xcf\x18m\xa1\xb9\xd2?\u07b5\xd27\x96t\"\xd2~\xba\x8b\xd3\x1d\xe7\xd7\x0f\xf4O\x14ʏ\xaaIiE\x81V\x9a\x0e7\xe1\xae\x1b\x00\x1e\xd2d\x05ǃR\xbc\xe6E0\xa4UE\xd0[\x95,\xc9*j\xb9k1\x92\x9aV\xe7~Y\xabc\x96\xe6\x9d\xc2De\xa9\x1df\x8b\xca\xc4\xefY㶵\xe6O\xccp\x82\xe0\bR\xd7fz\t\xab!\\9\xfauP\xd3{\xa5\xb4\xe3\x1e\x85f\t\xae\xe0f\xc6\xe6\xac\xd6\nZ\x03n\xd5\b\xa6\xa3\xc7\x11_M{z=\xe9\xe95uǊ\xfa\xa1\xf9\xe43\n\x97V\xf6$\x9f\xdeP:\xdf\xcds]\xb6\t\xf33\xa5\x8bݾ\xe2Ԅ\xbc\xb1\\\xb5\xd9hw\x13\x91ޱ\x16\xa5ܱ4:\xcc\xd7\xf3\xd6\x10\x92Mq\x86\x90Q\xb3\x1cJ\xb4\x19\xe5\xbfwi\xce\x06~Ɇ\x0e\xfc\x1c$\xa0\x1d-\xff\xbd\xa7fn\"Q6\x10\xfb&\xa8b\x88\xea\xb2\xfb?{ʋ\xea\x17\xb3\x05\xa2\xb7\x17\xad\xdc||/n\xba͒4\xfb\x1b\xa2\xe4\xea\xfe\r\xe1Eem\n\xd1\xdb\xf6n{|\xafn\xbb_CL\xe1\xbf\x7f[\xacI\xc6;\xa1\x9a\xf8\xdf\xee\x9av\xbd\xa4z\xf2\x1a\xb3\xaba\xfb7\f\x94\x1aj\xa9\x82ȉ\xefAR\xe4u\xb1\xb6.Z\\\x97xjT\xd5qY\x9b\xb6|\x1e\xc05.k\xdcwr\xf2\x97oE\xf17\x98N\x05E\x10\x93\xcb+~\xeeT)\xcfY\x1e\x17%\x15\x87Q\xa98\x8dZQ\xc2\xeb\xf85\xfe\xdcʟ\x99\x8e\xef\x9a\x16\xba\vt*\xef\x0f\xac/\xa0K\xc5XE\x9f\xde\n\xcdp\xee\x1e5\xa1\x1c\x88q+\x1e\tq\xe2\xc5!s\x9f\x9a\xb9\x19M(g\x965\x14\x9bE-\x9a\xa0\xe1\x15^\xe2?\xe7\x9a0\x10\x1cp\xeeTjl\xcf\x1a؆NYb\xddd\x02M\xe4\rCe\xba\x81\x89\xdbe_o,\x97\x99t\xeb\x01\xd9\x1a fh\xcft\x03\x8f9\x01_|\x81%\xe9ckR\x9c\xf0%\x90;\xf7\x82i\xf7\xe5=n\x86\\\xe6\x1d\x9fnݚ\xe8\xa0P\x03\xd2٠\x84\xfa \x17k\x140\xac\x7f\x83R\x892\x86\x1f[\xfb\b&\xf1\x9c\xaf\x18|\xf8\xff\xb3\xf7\xee\xfdm\xdb\xc8\xc2p\xff\xf6\xa7@؍-\xc6\x14M\xc9\xd7Hf\xf2\xa4I/ٓK\x9f8\xddm\x1f\xd7'?J\x84,&\x14\xa9%)[\xaa\xac\xf3\xd9\xdf\xdf\xcc\x00$\xc0\x8b$\xa7\xb7\xdd\xf36{N-\x02\x83\xc1`0\x18\f\x80\xc1@F\xc0\x85h\x06\xf8\x7fm\xfahӗ\xa3\xfc\x9f\x92\xf3\xc6{\x83{Pe\x96\xc9\x1d\xaav\xa7Z\xce\xc9\xd1\xe7\xa8E\x0ebS\xc7\xe5x\x16jW[ox\x92\x05\xc3bC\x12\xdf\xe3\x12i\xd2t>g\x87\xc5\xd5QŹ8\xe4\x11sY\t\xdabSJ\x87\xff\xa2\xe8\xe31C*\x06o\x9aу\xfd\xf0;\xc0\xad\xcd1\x8dX\f$\b\xf1\x05\xe1I\xa2.FV\xbf\xb1\x98g\xb1Txq\xe1\xdea\xc0`\x14\xb2\x00O\xe0\"\xd8.,]\v\x91\xd4\\\x06W\xd0i\xe79y\x97\xe3+\xbc\x1c$\x81\x19V\x1c\xd4\f\x94\x12\n\xd7݀C\x85w\xcaU:W\xec)\vX\x8f\x8dk\x03\\\xcb69\xeb\xdaD#w\\\r\xa8\x8f7\x88K\xe4\xb6K\xd4J\xd8y\xa7Jj\xbbDj>\xa9`\x8f\xd1P+ڊ\xab\x86\x9ez\x99\x1b\xfb\xabc\x16&f\x10\xf9|\xdecA\xe9-\xa6Վ\x82\x15\x1fV\xca\xe5ϳؠzJ%V(m6\xa0_\xfa\t(]H\x00d\xb0\xc8U\x00n\xb4\f$\xa6\xbf#_&q\xea\xc5hZ\xe5\xf9\xc7\x02M\xa0\xa1\xa1\xee\xf0\x98\xab\xe6c\xfd\xa6\x1a\xa5@\xe3\xf4\xcdZN\x97\xfb\xf0fm\x1f26\xef\xaa\xd0\x1f7\xe1.C\xafō\x87\xd7\x1d\xf6\x88\xcdŅ\xfeG\xf0\x9f'.T\xfa\b\xfe\xb3\x0f\b\x1f\xb1E\xb7\xfc\xb0\x8aƪ\x92gb\xdd\xe9\x16\x15\x98\xd5\x17\xd0\xfa7\xd0\xfa\x971ٙ\x81\x9a\x00\xdd\xfe\xb1Ƨ\xb8\\\xf3Z\xbc%\xac\x1aNuȢ\x02\xa3\xa116K\"\xe5X\xecc1\x98\xbbx\x9a\xa1\x0feQ\xfdG\xd9\xf4\a8\x8d\x9b\n\x91\n\xfe\x12\xb0zȹ\xbf_K۔l\xa9\xe1'm\xeb\a\xe9볏\x8a\xb4o \xcb\x15d\x95\x15\x8e\x98\x1c\x1f\xc8\xd9\tf\x93\xe7\xcf\xff\xd9\xc2:/\xd3)\x84t\x93J\x9e>\xf10Q\xc7n\xe5\x12\xa8\x9d\xb9\xb5\xdb\xe9\xb4\xf2҇@\xb4\xbf\x7f\xc5\xdc2\x9e\xea\xfd\x9ap\xa1\\\x7f)\xeb\xd6tZ\x1a\xe6\x00O\x8c\xceG\x04\xd5\x17\\]i\x8aKq ,Y\xc7\xf9\x85\xa0\r\x17]\x95$\xe9\xd9Y\xb3`\xdd\xe2(\xd0\xd3\xd3ŻS\x14\x95A\xdf\xf7D\xb3xP\x0fީ\x01w\xae\xb6x\xf8\f]\xa5*\x87\x7f\xd5\xea\x03]\xb3\f\x1a\xcauj\xca9W\r\x87\xad\xad\x01k3\xcfd\x8f\x98}\xacoj)|R\\\xc7r\x06\x7f\xba\x9f˂\xee\xd6\xe651\xd1b\xc3\xfe\xfa5\x1c\xf9hù\xf9\t{T\x11\x81\x96in\xc3p\x97\rT>6\xfb\xa6\x0f\x99\xcb<\xea\x89\x01\xe9\xf9\x01}y\xfa\x04\x02]\xd1B\xc0}\x84\x00\x86\x0e\x95)C\xe4w(\xbf\xa3\xe7W\xefرG쓅W\xa6?\x95nCk\xddR:\xd2Lg\xb8\v[\xea\x98h:\xcb6_\x00\xa0\xd3p\xaf\xa1S\x06\x18m\xdb\xdf\xccZ\xac\x8d\x9c\xe5qCX\xbc\x80UpBf\xe4\xef89\xdbw\x04\"\xbflM\xe4\xcf\\&\x80\xc8\x02\xb4|\x9b`\xdd5\x01?G\xab\xdf\x05\xc0u\x93P\xc7B\x19\xbd\x8c\xd2\xc0\xe7-\x9f\xa4\xb7\xf2~.\x8ak}\x91aC\x91\x82\x1b\x8aW\x89R8\xe3I\x8a\x17\xb1\xf0\x89[B\xb1\xf6Ed\r\x9bY\xf3X]s\xb3\x9ah\xfcu\x14\xaa\xf4\xe1)~\x9d\x1b\xa86\x1cW\xe5{\xcaX}\xdd\xd6J\xc9\x15B\x9b?n\xe2$\x8e\xe2`ݒL\xb9Ĝ\xaa\xeb-\xed\f\xa9bC_^\xe9F\xb3\x7f\xf8A\xd4\xf5!\xe3i\xcaC\xdcg\x96\xc8\x14\x7f\xc7\xd2{ti\xc7bi\x97\xd6g\xb86\x83u\x9a\xb6\xcd\xc1mOl\x18\xec\xee2n\x0f\xc0dԮ\x98\xa4`\xe2r\x9bOm婯\xb4+\x13\xc3\xc6\xfd\x85\xb4S\x81Q\v&u{\b95*\x05hd\xa7\xb0\xa3\x91v\xec\x05^?\xe6'}\xddb\xe7\xf6\x90\xb5\x91~\xb0{KFtڅ\xb2],\xab\x17햋v\x9b×\xa9T\xcc+T,T*<\xb4\xc3K\xf5H*\xe6e*\x16\xddr\xd1n\xdd\xdb\xd47\x1dz\x83\x04\x97ڰ\xcbpӥ\x04\\s3e\xfdG\xd2v\xc9\xed\x84_\aqd\x87dr]\t{\xa9\x03E\xcdf\xf0\xa4\x19\xbc\xe4\xc6*\xcb\xea\xd2,R\xb5\xa0\x0fx\xa4:\xaf,b!l\x7fe\x15\\\xa2,\x7fM\xaf\x18c\xda\xe1c~ \xa6\xacmohi5\x04s\x95\xe6ӡr\x05\xa0\xbc\t,+Z\xb7\xb8ݴ\xbc\xad?\xdd\xd5x\x90\xa3x\x10\xe0ni\x8eH\xd4/\f+\x91\xfc\x84ux\xbb㔉^\x99\xe5}+\xa9\x18\xe2)\xbenW\xbc\x1a\x1e\xf6\x98\x91\x88#\xa4\xa4ǌ\xd0(\xeb/\x9f\x87\xde,\xf2\x16\x9b\x14\x18\xf7\xaf\xf9gi/\x8beI\x80\xedI\x15\xe3\xfes\xb4\x19\x92\xd0(\xd2R~*2\\\x92[\xc4R\x15(H\xae\x88\xeb\x8d.\x98\x96\x90_Ej\x15Y\x05\f\xbf\xa3\xa0\"\xfa_+\x9e\xf2\x17\xae\xad\xa0\x85\x8a/\x1fVP8\x91\xd2zs\xd2g\x1f\xf7\xf7\xd5J\xf2ޔ;\xb97\x16\x16\xbd\xfcx%\x7f@\xe4\xcf+v\xd5p\xf6\x9eǩ\x11x*S\xea\xbff\x9e/\u07beW\x14\n,\x1d˻\x8c\x95S\xe2 J9<\xfb\x8d\xaf[\xd5Ê-9|\xb7yj\xcf\xd1[N~\xc16>\x91\xa7Ώ\xf04\xab7*{;\x80lDt\xa5C߂\xb9\xa9z,\xe7a(n\xf0)u\xacv\x9f)\xa9\vL]\x98\xec\x1c\xbc\x15+\x06\x1f\xb6\xea9<-Zߴ\x8d\x8f\x05\vB+\xae\xcb5\xd8o\xd6b\xbf/1붑\n\xa2\xa6[l\xfel]o\xed\x03\x84\x1bK\xeb6\x13\x86\xb4\xc2m\xb4y\x97V\xac\xf0\xb0\f$\xe2;A\x8b<Q\xde\x10\x99\xdas0\x9bҹr\x95ej/0M\xbc\xcc\xda\x12\x19\xe7\xe8=\xb0OE%\xd1$c\xe5\xc7\x19\xf1\x11a\x9b\x1e?\f\xae@R[\xcagq.#G̛\xd8\xe7-\xfd\x80[\xdcd!\xd3E\xde\x19\"cD\xdb\x14\x957Z\xc8\xd2Z\b@\xb2\x9d\x8a\xcb-k\x86We7G_\t\x8a\xcdcu[\v\xc7\x16\u061cb\xf8\xe5\x1b\xbfs\xd3,\x0e\x1dDA|=\xb2\xd4\\\xf4|4\vd\xe5%<;g\xc7\xfa\xb0\xaf@\x80\xa5y\xa8Y\x9a\xd0b\xe4\x8ffCb\xa8\xcf\xcez\xcb\x10\x81\xaa/]\x13\xb2n\xf5\xe2\x12\xdbp\x8dn\xaanw\x96\x97\x8c p\xe7\xb0yOuO\xedy\x15b\xc1\xce\x19\x04\x89]\x10Ģ\x0e\xc7\x13\x94\xf0y\xb7\x19\xc7\x13\x14\xf7E\xb7\x8cc\xa5\xa9C\x8a$݅p\xc5\x1dq\x8c\xbb\x80/\x95\x8b\xe2\x88\xf0\t\xf3\x17\x02!\x8e&_\x13K\x1cu\xe5C\xbfB\xacă\xa0\xf5\x92O\x85\x00\xc4\xf6|m\x0fi\xaa\xc8\x01\t1@\xad\x91c\x05\x158\xc7j\xae\f\xca\\P\xa6\xe3\x1f\x00\xdb\x1a\xd1s\xa0\xebq\v\xc1\x96\x16\x83\xa4Z\x9f%!U\x9d \xb3`\xc2\xcb\x0f\xbe\x89\xb4\x17^\xc6-\xf9\xfd\xc1\xf7\x16\x17\x8b\xc9 \x16\xcf\x17\x18\x17\xb3\xc8\xf7\x16\xf0\xa8\xdc\xebX\xfez?\xe3\xa9\xf8\xf9O\xeeG\xf9\xc7\xfb\xf1,\x91\xbf\xbfI\x02\xf1\xeb\x02|\xab\xe1\xb7<\x90\xa4\x8af\x198I\xc4Y,\x1eY$\xce\\\xf3\f\xe8\xe9\xb1f\x13\x11\xfcf\xec\x0f\xf65\xcf~x\xff\xfc\x85\x12\xb6|e\x15(\x16\xf7\xc0\xb0\xa8\"\xf8f\x16\x86?q/\xd9\x1a\x8b,PE\xf5]<Kҭ\xf1 t\x15\xc9\xeb \f\x83\x94\x0f\xe3\xc8\xdf\x1e\x97Z\xa8\x0ee4\xcb\xf8}\xb0!|\r\xa28\xca\xc6ۣ\x01\xe8*\x92\x8b{\xb6\xed\xa2\xa9Y\xef\x83ɖ\xd2\x03\x90\xf5\xe5\x7f\x89#\xfev4Jy\xb6\x0e\x93\xa3\x15\xc5\xf7\x15ߎ\xb6\xa8Z@\x96\xaaN\xd7\b\xbe\x1c2\xf9p\xb1\xd3\\\xf8\x15g2\xfb\x83\xeaNVž\xb8'\xf2\xc5ָ\xd7\x0e\x97\xa6\nd\xa1\xadki\x1eIMU`\x89\xad\xf1o\x1cdMը\x05\xefQ[\xf3\xf8k\xae\b\xcbl_G\xe3\xd0l\xac\x01Jl\x8d\xff\xe23\x98uqO>5\x0e\xe8\xda\n\x00z\x13\xea\xd2&\x88\x8eDL\x88\xc5\xd4T\x86\x1c\xc5\xc9\xc4ñ\xfa\x9e\xe6O\xe3\xa1\xc7\x1e\x0e\xd8C\xce\x1e~\xd7{\xf8\xba\xf7\xf0\x82=\xfcɰj\n \xf0\xe4\xe0\xa1\x7f\xf0pQ\x01ȱ\t$F\xb9b\xdf[\xa4\xccU?\xc5D\xadM\xde\xcf\x06\x83\x84\xdf\x04\xf0FmT\x02ύa\xa5\xd6\x1c\x9c\x9b\x05\x9a\t\b\x81\x98\xff\xff\xeeE3/\xa1)\x9d\x0f\x12\xf9\xfb5\xbcU\v?\x9eM\x93 \xa4\x14\xcc\xf8\xfb,\xe2\xf47\xc4\xefg\xb3\xebY\x9a\xa1\x1d\xc0\xa7\x19\xc7W\x91-f\xbc\x1df\xb1\xf8\xf9&\xbeɓ_\xf0!\xfdfW%r\x9a\xdaE\xb4\xaeo\x99b\x03ٔ\xa9Ze\x19\x9fLa\x17\xa9\xb2)@\xa0-_\xc9\x13\xcb<\xf4\xb0\x96\xceLb\xb1\"vD\x86\x16\x1bmq\b5by\xbd\xf6p\xec%\xcfc\x9f?\xcbZ\x01\xbeL}xZ\x8aS\x85\xf5ѾI^\n\xa2.az\xeb#\xec?Y\xac5R\xd8B\xb4\xa7\x97C\xe62\xad\xa2g\x19\xd0c^\x99\xec)\x1b\x89\xb6\xf5\xb4\xa7\x1d\xe8x*\x80͘\x9a\xe5\xf5\xce\xf6$U\"\xbaR\x19\x19\x1e\xb7\xbc\xf0\x8bTRKn\f\x13/\x83\x18\xf8\xa9\xb6\xb7C\xf8\xaa~\xfdʢ\xac\a\xef\xc38\x85\x9fҤǔ/\x1f\x1e\xb6Ϳ\xbe\xd3\xf2^k_\x17\xda\u05eb\x1es\xf2+/,P؎4\xc2ޭl\x88%Z]\x84\xb0\x91\xae]\x0f\\ɐ\x92ﯺ\xd3\x02\xa0\xc6\xd4`A\xc4|\x93\xf9\xf6wP\x97\xfd\x1d{\xc8:\xe0\x96\xe3\xdb\xf0\xcaF\xa7\xab=\xdbC\x8a&ⷒ*\x99\xebc\x1f\x15\xb3u˷\x17\x16\xf3\xed\t\xfc\xc77\xcb`d\x8a\xfa\xf6w\x90\xfd\x1a\xfes\x01\xffyU\xeeV?\x8f\x19\xbd\xd2\xfa+\x8b/\xe48Yc\x13\t>\xd5\x1d\x9f\x11\x9e~\x9d\xba\xfee\x14\x84!=\x9b(Ft\xcbp\xba\xbeaZ:\xc8a\t\xe4\xb0\nrT\x029\xd2@Қ\x8a\xa0\x9e\x1a\xe5\xfc\x8eW\xc6\xdf;\xdeR\xf5\xafY\xa3\xa97\x96\xd2\x14_YI\xaf/M\xba\xb1\\\xe6U\x1c\x7f\x9aM+\xe5(yC\xd9mh\xae\xaa\xebz,\xf7\xa0C\xc7V?#\x17QM\xbc^\xed\x1d\xea\xe2-\xf6Z\xd6^\xfa6\xad\x1c[\xe6\x95f\x83<\xdb\x16]3\x8a\xc1V(\xaaM%\x84bɤ\xa3\xfcj{\x94\xeb\xd0\f{\xa5\x99\xb1Uo\xe7\b_O\x7f\xabZit\xb6\x047\xd4\a\xcc\b\v\xdf\nK\xba\x01\xcbw\xf7\xa6E,\xaeu4/?\x17\r)\xe1\xbb;\xd6\xe9\xea\x18?n\x8f\xf1\x10\x1fY\x97=\xe0{\x8b\xb7#\xd2\xcc%\"_\xdd\a%\xf5\xb6\xb6\xf8ױM\xee\xddd!;`\x13\xe8\xa8^\xdf\x1f\x95\xdcG\xd0\x11M\xd7#R\xf9\xfe\xc4\x05\xc6?e\xc6\xf7\xaf\r\xd6cƳ׆\x86\xea\xe2\xde4]\xd4\xf3\xe9\x87{!\x12\x9d\x98\u03a2\xe6~\xbc\xdd\xdc\xca\xea~\xd4??\x87\x8cI\xbc\x86\x8c\xf9\x16\xa3^\x8c\xf8\x1f7\x80*\xcaaqo\xbe\x17\xbbf0\x98\x1cG\xa7\xf2\xa7\xed\xf1\x1d\xd5\xe1\xe3G:\xbe\xff\xd7+\nő\b:`<4\xd6Vc<4\xd6-\x1b\xc1\xdeK\xb4\xa9G\xcb\xfa'\xe7\x9f\xf2\x89&\x9fN\xea@\xf2\x89B\xcb|]L\t\xb9گ\x02(\xaa\\\xc9z\x15\xc33\"\xc0\x92\\wk\xf9/d\xa5\xbc)\xe3\xbbR\x06\f\xc0\xeeQ\xae6\x1b\xf3^\x95\x89TtQ\xae\x81\xaa\xcdx3\x83\xa5_\xaeWJ(Pk\xe4\xbaB\xcb|6\xf9~\x92\x0f}-\xe7B\xadt^\xcb \xdc\xfe.D\xbd\x92\x0f\x02\x9e˷\x96\x0f\x92\x96K\xaa\x96#\xe5\xb0Nd\"l%\x9aP\a\xff\xfds\xfa\xe8g\x7f\xff\xa0\f\xe3M\xbe\x9f(\xe6\x11\xaen\x85\x80\x15+\x98\xe9\xa4\xc7:;\xf2|\\\x1f\xa4\xf6,\x1b~\xfe\x1a7K\x16\xf9o\xa6\x9c\x14([\xf7}\xed\x10\x87j\xabYr0Ȳ?@Y/\xe3\x95\xdb\xfa!p\xb85ˊ\xe5犍\x82\xc8\v\xc3z\x02^(HV\x95\xf7q\x87^H\xecR\xb5U\xde\xf2\xfb\xad%\xef\xcd\x02\xb1\xecB*\b\xbd\xc4Yi5\x82\xee\xee\xd2B\xeb\xc3g\xb7\xbci\xb1E$ȄmWT\x94\xf52\x8d\x99[#K-\xe3\xe1O퇓\xf6C\xff\xbdܟ\xb2\x1f\xbe\xfa\x7fF\x9d\xe8\x05i,\b.6\xd1\xec,~y\xf1V\x90\xf8\xb4Z\xe9\x1b/\vn8\xebUs\xd4#\xa3\x12\xf8\xa6^,\xad\x86ц\xd4\xfaDp\x85\x8e\x91i\b<\xc5\xe57\x10\"\x18\xbeZG\x80\xc2\xf5\n\x88\xd6\x03\xb9q\x80\xfaH\x01\xc7+\xc77^\xa88~)CQ\x10\xa8\f,r\t\x19\x85q\x9c $=\xc8\v^\x05\xf0\a\tV\x9c\xa0\x00\xc2b1\x1eeH\x9cry\x8f\xc7\x1f\xf8\x91\x9f\x85H\xdf\x12B/\x8b5\xa3.\x91)\x91ig3+\xb3\xda\xfe\x94\xb9\xa5\x14;\xf1\xa2k^\x03)\x14Y\t\x1a\x94J\xa5\xc4\x04\xa7\x88ߖ\xb7'\xfc\b\x18p\u008fj\x19\xf0\x1b\xf0\xb6\tu\x03o\xb5S\xb8\x95Ym\xbf\xca[Ji\xe2T\x99\xb7\x02\xba\x96\xb7c\xbaҽ\x1dga\xdce\xe2\x18\x8d\xb99\xe9\xfa\xc9\x1a\xbe%-\x1f\xb5\xab鋚\xce8<\xe1Ǭ\x9d\xa3\x06\x96\x16\xbf\x1fa\xf6\xef\xd5K\x8d\xb8\x1b\xbaI9\xc6]\x99eF\xaa]\x04\xdf\xf5\xec.w\x0fB\xd6v\x8e\xef-\xee\xd57\x04\xaf2\xbb\xf3\xf8\xd4)v(}o\xa1o\x13\x8aF\xe5\t\xb0\x9f#\xe5\x91\x16\x86E\x82\xb6RϹ\xb2\xb8O\xbf \n\x1d\x1fۗ\xb0\xf7\xe8\x04Q\xb4\xcd:\xd5~\xc8\x0fS\xf2\xcfZ\xbe\x96;\x01\xe0\x9a\xfa\x80\xd6Z̭\xa7\r\x18\xbf\xa0|Yf!\x99\xabsK\x11@\xccem*\xd8f\xadơD uYRǰ\x03vvrĵ\xe7c\xcb\aIU/TX\x98\x14έBм\x85\x9dů\xe0\x8d\xf3\xe7^\x9a\x1f\xa1\a\xcce\xa7\xac-\xefR\xd2\xc5\x1e\x12Ţ͗\xbe\xb7\xb8\xdaZX\x19\xb5X\xe7?A\x98M\x82\xa2p\tW\xd2l\x1f\xe8\x7f\xc8N\xd7l[o\x90\xcbM\x92Y\xab1N\xcdz\xe4\xa5\xe3\no\xa1\xb4\xae\x10\x88\xd2F@\xbdlTw\x8d\x10\xff>p`Q\xb4\x9b\x1d\xb0S\xc1\x97\x05\xde;\r\xcc\xf2\r\x91\xbco\xd8>3R\xe3\n\xaf\x1cQ\xcf\x14\xa2^\a(\x86G\x0e\xac\x8d\x8dJ\x01\"\x13\xd1\xff;\xf0D9sЕ\xc3-\xe7\x9fT\xa3\x03wt\xca\x00i\x05\xa2\xaa\x15\x10\xael\xc2\x10l\xad\x16\x01\xf8\\\x8d\xd4m(i\xb38\xa8ޭ\xc7R\xd3@\xeaWUo\xa7\xac\xbd\x85%\xbc\xa5\xfa\xa6)\xa14A|\x96\x02W|\x85J\xa6\x8e<\xa0\xd6\x12j\f\x1d\x84+\xdb9\b[\xcb\xff\\A\xffv\x1c\xa56@\xa0\x8e_\xc7\xd55\xb3\xf1g\xf1VwX[\x99e6\xa4\xa51XϬ2o\x11Rc\xad\xba\xc6\xc4wm/2>%߂\x0e?\xb4\xd81\xfc\xa7\x83\xff=\xe4G\x16\xccU\xf0\xeb\xd8b\x8f\xe1?\x9d3\xf8/\x98_\x16\xeb8\xf8\xd1\xed\xe0\xd7\xd1a\x17\xfe\xe0\xa4f\xb1\xcei\x173O\x9c#\x02:~\xdc\xe5'\x16;===\x81\xbf\x87\x9d\xe3\xc3\x13~®ji\u009d\x9e\xd7<\x1b\xc7>\x91vYZqX\xacCq\xf6\xcb\xc9\xc7\xf5ɝ\x86\xf4CGO'\x9b\xbb\x82]&\x1f7@7\xa4\x97\xb1\x83\xc9X\xc1M\x89\x87u\x89'\xb5Żz*\x9a\x04\x9d\x9a\xb4\x12\x1c\xe8\xb2j\xc3p\xbb\xb2!\xb5D\x13\xc8\x12\x82\xae\xeb\xb4o\xf2\xb3?\xb5\xd3\xe4I\xedß\fS\x1d\x0e\xa5\xb1\x90%\x14\xe1`\xa5W\x9c\x97\xfejmi\xbf\xac\xa2\x1a\xb0\f\xd8C\x7f3\"aM<\x10\xc1\xa6\x9a\x90y[\"\x03\xcbgwW~m\x85\xfa%{8\u074cZ]\xd94!\xea=|\xbd\x05紕l=*p\x83ڈH\xdfn\xa8Gd?|\xb5\rEe\x7f\xd9U\xb3\xf0\x05Q>Kc\x82\x1dbJK\xf5\x1d(I)s\xf5\xaco\xf4\xf3\x94\x8aP\x9b\xaa\x95^\xd1Sr\xb2\xca\xdb\xc4\xe7\x19\x8f2\x8bM\xcam\xab\xd2m\xfb\xf1\xc4\vd\x11\xcdy\n\xa1\xbe\xe5\x19\xcc\r\xa6ig\xc1\xf0Sښ\x98U\x98\v\x01S\xf62\xaf>\x82\xbe\x8e\x9e\xd6V\xfc\x13Mnf\xadY\xbb\xa7\x89P\xe0Ԙk\xf6fnj\xd7\x12\xcb,\xbcd\x13\xb8_2ˆ\x16\x9b\xe4\x0f\xa0\xaf̦\n\xd7i%\xb9\x9d\xfa\xf9\x9aI`\xd8B;\xe9\xfe\xd6\xeb\x90m\xa7\xa4r\x87\xfb\xf5\xcaD\xe0\xf4\xb6ǩ\xaa\xab\xfbU\xb2\x9d\xd6\xd2=\xeb\xd7\xe2\xdbJy\x95\xfd\xe1\xd7`\xdcJ\x87\x95=\xda\xd7\xe0\xdbJ\x95\xd5z\xff7k\xb3\\d\xb7QQ\x85|W\x15T1\xd8~O\xf5\xf4\xc3\xfb\xe7\xdbh(d\xea:%U>%\xfbU\x8a\xaah\xba\xd5\xc0\\I\xc5\xcal\x99\xfd/\xfe\xfa\xf7\xbf\xe0\x1fO\x928I\xedq6\t\x7f\xb7:\x9c\x8e㜜\x1c}\xe1п\xf2_\xe7\xb4\xd3\xf9\xa2\xd3=<9\xee\x1evNN:_8\x9d\xce\xd1\xf1\xf1\x17\xcc\xf9#\x180\x83\b\xae_8\xbf\xba\xaer\xe3\xfeC\xfe-\x97\a\x8fv\x18CE\x05\xf7 \x06^\xcaQ\x1av\x18\v\xa2a8\xf3y\x8f%<\x8c=\x9f\x92\x1f\x1d\xacV;\xcb%\xf3\xf9(\x8883\xb2 \v\xb9\xc1V\xab\xafQ\x92\xd8`\x16\x84>\x9c(.\x97\xcc\xfe\x9e\x9e\n\xb4\xdfx\x13\xceV\xab\xe5\x92\xf1\xc8g\xab\xd5ι\x1fܰa襩k\x8c\xb9\xe7\xf3\x84\xa1$\x1aOv\x18;\x1fw\x9e@\xe9\xe7\xf1,\xca\xd8j\xc5\xf8f\xd4\xe7\a\xe3Γ\x9d\xf3\x03?\xb8y\x02\xe4\xe1\xf2\x9dق\xa8\xd5\n\xd0V\xeb\x1c\xc6>g\x13x\x15\x01+\x86\xaa\x0f\xa1\xea\xdb \x1b3\x1b\xcc+P\xabl\xb5z\x96a\xa5l\xb5걼\x15˥\xa8\x80\xea?$\x14yq\xf0\x88\xa7\x9a\x11\xf34\xe1OΡ\xc2'\x02\xd3\xf9\x01~\x9d\x1f@\x8e,*\xf8\xc3X\xd1\x14\x91\xb4\\\xe6\xbe\xc6\xccPz\xc4@d;\x9f;\xfe\xe7A\xc6\xfd?w\xfc\x1f\x1f\x1dV\xc6\x7f\xf7\xe4\xaf\xf1\xff\x1f6\xfeQ\x92\xee1ĩ\x00\r\x16\x1c\x888\x9e\xe6A&\x06\x0e\r\xe9|L\x8a\xa1vǲ\xf8\x03P\x92\x0f\xbab\xa4и{;˦\xb3l\xed\x98WF;A\x17\xc3w\xf38\xfd\x83F\xe6\x1f\xf3o\x12GA\x16'\xbf\xab\x02\xd80\xfe\xbb\xa7'\x95\xf9\xff\xe4\xf0\xf4\xaf\xf1\xff\xe7\x8e\xffƁ~\x91y\xd9,U\x06z\x18D\x9f@G\xb8F\x9a-B\x9e\x8e9\xcf\f|\xd0\xc352>\xcf\x0e\x86ij\xb0q\xc2G\xae\xb1\\2/Myƌ\x04\x96=c\xef\x166\x7fm\x84X\xad\x8c';爣\\\x1a\xc6\x1d\xde\xfe\xca\xc4\ng\xe2%\xd7A\xd4c]g:g\x0e\xfd9}(<\xca\xc2\xd8\xcbz\xf8Ƭ\f8\x90\x06\xf4\x86O\xc2Ct|\xa2\xf4\xdb\xc0\x87ۥG\x8e(8\xe6\x103\xa4\xc7\x0e\x1dg*\"B\x8c\xe2(k\x8f\xbcI\x10.z\x8c=K\x02/\xb4\xd8w<\xbc\xe1Y0\xf4,\x96zQ\xdaNy\x12\x8c\x14\xf84\xf8\x85\xf7X\xa7KHV\x05\xed\xe3\xc3\x12\xf9mA\xbf\xc3:\xc7\xd3\xf96(lo\x1e\xa4lYj\x977H\xe3p&σ\x7fi\x8bp\xd6\x1d\xc7\x11\x0e*Y<\xed\xc9\v\xdf\x14\xf1$\xff̹\xa0\xd6\x15r/\x11\xb5\xe0\xef\x1e\x94\x1a\x97i\x19zэ\xb7\x89\x1a\xb5j\xe8\x13\xf1\xb1\xda9?\xc0\xbe~R7U\xdczI\x04\x8f\xc0\xc8\xc9\xe2\xdb8\xf2\xe3\xd0c)Onx\xc2R\x94A\xcd\xe8\xdblޝ\x8f\xbbO^\xf3I\x9c,\xce\x0f\xc6\xdd'\xa5\xe9\x01\x9bd0\xdf˼\xf64\x8c3ט\xf0\x89\xfd,\f\xe3\xa12]<\x9f%\t\x8f\xb2p\xc1<\xc8\xf12\xee\xb3\xc1\"㩜A\x045\xdb\xe0\xfe\x8e{Sz\xd4)Ujx\x15\xdcp\x16S\xf2g \xbdX\xa8Ⱦ\x02\xd2\xd80':\xf3>\U00048352x\xc2\xd2E\x9a\xf1\xc9g\xd4\xf0\x1a[\x9eZ\xf0\xfb\x9b\x84s\xb5>\x91Ǽ\xc8g\x98\xb7\x1e?H\x96\xf1Dv\xa0\xf8\x93N\xbc0|\xf2&\xcex\x8f\xbd\x1f\a)\x9bzל\x05)\x8b#`\xfb\x8d\x17\x84\xde \xe4,\x88\x98\xcf\a\xb3k6\x89}n\x9f\x1fP\xb9\x9d\xf3t\x98\x04\xd3L\xd5 \x1f\xbd\x1b\x8fR\r\x96&CU\vMfs\xfb\xa3\xd0=\xe7\a\x04t_\x1c\xfe\xa1}\xd3\xfd\xd5X4}\xf8k\x91\xc9i\xbd\x8a\xa7a\xfe\xff\x98~\xf1g\xd9\xffGǇ\xdd\xf2\xfc\x7f\xe8\xfc\xb5\xfe\xffC\xfe\xc1N\xea\xf3\xb7?\xbcy\xcf\\\x06\xf3^\x9f\x1d\x1c\xb0αä\xa3k<\xc2\xf1\xbf\x03\x80/\u07fc\xff\xfa\xdd?\x9e\xbdb\xae\x98Yv\x94Wʼ\xe9\xf8\x82'\x01O[<\xe4\x13\x1ee\xa5\xcdH\x91\n\x9b\xbbϲ,\t\x06\xb3\x8c\xb7\xf6rݲg\xda\xe94\f\xb2֞\xb5g\xf6wV\n\xee \n\xb2\xc0\v\x83_\xf8\xb7PK\xda\xd2\xc23\x12Z<\x1b\x89\x873Y\xc5\xd7\"\xf9\xab\xc5s\xd05\xb0K\xd0\xdaC}\xb6g\xe6e\x91h58#$N\xbd\x90g\xb9\xcf\xf5;9*\x9f\xc7a\x9c\xd8\xdfSfK\x8d\x9f\x0f\x85\x02\x19\xae=`\xe79I\xf9\xd3\xc0A\xa0G\x12$\xba\x99\x9bC^\x06j\x9c+\xc8\xc6Y^i\x12\xbd\xfa'Z\xd5\xda\xf3\x83\x9b=\xc5/\x1f\xa0\xedTc,\xaa\xd8=\x8b\xedA\x9e\n\xcbC\b/\xc1#\x9fb\xc2A\xb6\xa9\xd7-f\xf5\xadk'\xf8\xa6\xfa)w\r\x05\x04P\xa2!\xe5\xe2Q\xc3\xcb\x12cR斄\xcd,\xbd\xd2\fP\x1f\xc5k\x06\x1f!\x94c\xd1\x11\x1fK!\x1d\xe1\x1f\xd5$\x9e\f\x89\xbc\t\xef\xb1\xf4\xf2\xe3\xc7+\xf5\xc9I=\xe2\xd7uY6P,[:Zѵ=\xc1\x1dK\xcb\x14&\x17\x0fm\xf2{\xf9'|\xeb \xd2\x16\xcda\xbe\xc3\x04\x1d(\xe1\x91\xcf\x13\x9e\xf4؞\x97poO\xcfM\xb3$\xfe\xc4{x\x16gմ\xb9\xa7\xb7\x82\x18j\x7f\x13̹\xffb\x96xt!\x01\x13-9*\xac\x12\xf3\xe0\x1f\x1c\x14\xbc\x14\x8eF\xbd\\GX\x15\xb8\x897\x7f\xe1e\x1eƮK{\xa4u\xacZl_y)\xef\x15W\x1eLŽ\xf9\x005\x8fVje\xd6>\x13*(\xb6\x870p\xd5\xf0\xe8\u05f6\xec\xf2|\xa4\x8b_\xba\xa4-\xc4\x18\xacv\xb5\xfd\f\xc6\xdbO\xa5\x1eG\xa9\xec\xb1k\xbdMp\xbaC\xa7'\xbd\x02\xcb7\xc1\x9c\x9e\x8d\xa6\x1bc\xe2x\xec\xbf^\x7f\xf5ު\x17\" \xa5\xb6\x95H\xa4Mr\xa05\x12U\x1b\xe8\x15\x18/j\xa4\x8a\x94g/\xcbna\xac\xa5E\xbd\xe6\x91\xff\x8e\xffk\xc6Ӭ\xb5w\xf0\xe1\x9aL\xef\x0f\xc2T\xf8\xe0M\x83=\vo\x99(\x87x\t\xffWy`\x89\xdb+\xf8v\t\xdcs\xf9{Jpv\xc2\xd3i\x1c\xa5\xfc=\x9fg\xa5\x18\x9b\xf5\n\x95\x1aӨN\xb5\xb1\t\xa0\xccU\xda\xdfo\x86{A\xd4-W\xf50\xb9\x0e\xaa\x99\xdc\x10\xb5Y-נ\x81\xb8\xf2\xa0k\xbd\x1aR+\x06\xdfM*\x02\x8a\xa8_\v\x977\xe0\xf2\x13v1\xcf^\xc4Y\xc6\xfd\xff\xe2\xe8\xc5\xe7Y\xecS\ry\xabJ\xca0\x8e\xd2\x18\x0e\x05\xe3\xebV\x8e\xb3\xa6$\xe6ɑ\xe3\xf9>\x80mQ\xa0*\x99:\x19+\x8b\xed}L\xe3h\xaf\xf06\x96\x1a\xa4d\x05ԶP1\x04>qt\x88\xff$\xcd\b{o\xed$\r\xe0\x8d\x12%\xc4\xd6G\xf6\xf2\x05v\xf6U\xcd\xc3*\x00\x00DV-\x94\xfe\xce\x7f\x86\xfdG\xeb\x9f߷\x8eM\xf6\x7f\xb7\xeb\x94\xec\x7f\xe7\xa8\xdb\xf9\xcb\xfe\xff\xa3\xec\xffo߾y\xf1\xf6ճ\x0f/\xbe\xfeǇ\x8b\xaf\xdf\xfd\xe3\xebw\x1f.\xde?{\xff\xc3\x05s\x99\xa2\xff}~\xf3\x81\xb6_>\xd0\xf6\xcb^\x7f\a\x97\x05?\xbe~\xf5]\x96M\xbf\xf1\x86Y,\xad6\xfd\x92.L/\xca\x15)Q@\xce1\xe6\xcaZ\v\xfel\b[v?\xd2VI\xcbx\x9d\xce'a\xd7\x06$\xef\xdf\x7fo|N\xe9\xc3\xcf.\x1d\f\x938\x8dG\x99\x82`\xe7J]\b\x91\xa9,rE1UM\xcd'\xe18˦Z@\xeaz\rUfk\xa3\xb6ү\xfa¿\xa2\x922\x12\xd0d\xadZ\xcbv\xe8e\xc31k\xf1\xf2\xc4T~IK-3H\xb8\xf7\xa9F/\x8a\xfau\x05\xae\x9a\x15\xb3$\xc4\x1bW\x9eņ^\x18\x0e\xbc\xa1\xa6\xcb\x13\xfe/\xe6ֳ\xb2\bz\xfc\xa0ds\xa8\xf1ˋ[\xd5\x13ti\x11\xca\x1ccn\xbc\xbdx\x8fQ7\xbe\xfd\xfa\xbd!=\xda\xffe\xc7S\x1e\xb5\b\xd8bH\x1e\x98\xcdJuP^\xaf\xee_\xb0\xe4\x11-\xfa\x0ew\x1c[{\xcf\xe3(\xe3Qֆm\x9a=k\x0f\x02\xf9\x05t\x8a|0o\xdf\xde\u07b6\xc1\xd4kϒ\x90G\xb0;\xe9\xef\x99\n\xbdHG\x94p\xcf_\xc0\b\xe3\xc31\x9eb\xbb\xac\xdeN\x03\xaaȞ\xf2\xfc\x05\xec\xcas\xe6\xba\xec\xa8ҁ\x82\xc1\x00[\xe9\xf9\xd5N\x03\xa6\a%L\xd4\xdc\xc8'>H.\xab\xfd[\x18y\xb0;\xa5>\xcb\xf9\xf7\x8b\xb7o\xc0;\x0e\xfeҝ\xe7j\xbf)\x99T\xbeF\xac8ج{\xad=\xb6\xcf\x00\x84\xed\xb3=\xb3\xbcY\xe0M\xa7t@\xd1*\v\x96*\x7f\x8d*\xaflز\xb2eK\xe2\tg|\xe5\x98\xe5\x05\x1bI=bg8\xcd\xdd\x01\x15\x99k\x9f\x1b\x94\xb5\xe1\xebx[\xdb\xd0J\x7f\xa7\xd3j\x87\x97\xf85\x8d\xc3\xf0\x1d\xb6GSS\xe0k\x91\x897\x8f\xb9xR\xa8`\xadb\xf6\xa7ӲH>('¿\x83\x03\xf6,Mg\x13β1\aLl\xec\xa5\x12=n\x17\xa7A4\xa4܄:\xa9\\>Hـ\a\xd15\xa8\xcb[/\xf1\xb9ϲX\xa2\xb3\xf0\xc7؋\xfc\x90'\x00\x81\xc1j\xcb(\xa6^6f~\xccS\x16\xc5\x19\xe3\xf3 \xcdl\r&\x14.\x1f6uqK\xd1\x01\x8d}\x84\xacb.\xf6\x93\x8d\x1f\xfd\xd22\x9c\x1a) 4\x96\xb2\xca#\x11u\xcb3m\xd4o\xd1\vZO`\xf0|\xa2qwW!\x12F8\xfe\xc0\x87 Z\x92J\t\xd3\xf4\xfd\xc0\x95\r2\xeb\x960\x1b\x18X2\xfe\v\xa3\xff\x18c\xe7\xacv\xbe\xf8\xeb\xdf\x1f\xfa/\xbe\xe1I\xe8-\xfe\xcc\xfd\xff\x93\xa3\x93\x93/:\xc7ݓ\xa3\xc3C\xc79>\xfek\xff\xff\x0f\xfcwp\xc0^F`Y\xe19&*Q\x9f\xdf\xf00\x9e\xc2.\x87<n\r\"\xa1i\xe1(.\xa5T\t\xbfsp\x00:\xc9f_\x81{\x9e\xf4\xd5\xf3\x12\xce\xd2q|\x1b\xb18\x82\x13`\x16\x8f\x10\x818\x8aDD\x16ė\x1d\x8eY\x90\x02\x0eR\x18\xdcgq4,f\x89\x84\xa3\xb6IY\x9c\xb0 KsG\x1bL\xc0î\x94\x91\x99d\x03\x92\x8b\xdc\x01\x81Hȑ\x06\x11\x9b\x86ސ\xb3\xdb1\x8f\x00\xfb\x02\xf3\xa1\x1a<V$\x1c\xa9\xbdS\xb3'\x06\xf3\xe1\xdb\x7f|\xfd\xeeճ\x9f>\xbc|\x01\x8b\"\xb1$j\xfb\xfc\xa6-F\xd0^\xbfa\xee\xb4r\"\n\x88\x91\x17\x84\\1 \xf4%\bO\x87ޔ\x7f\xf7\xfe\xf5\xabVZc(QP\x96Vj\xda\t\xc76\xb5\x0ev\x0f\xae-\xb6\xb7\xebM\xa6\xfd=%\xf9\x9c\x92\xc3LK}B\xa9\xd7z\xaaA\xa9\xff\x9aŐ.\x8d/\x9d0\xe8P\xf2\xa8lQ7\x97\r#\xc1\x8b\xfa\xb3\x98\xaf\x16/\xfdV\xc1GS\xb7\x9b\x1e\x88\xb2\xe5I\xa5\x06\xe5\xfa\xd3\b\xa5\x90\x8dϩ\x16U\xd6\x03\xa1\x13\x02x\xa0\x80-\x05\xbd[82\x8c`/\xbc/=\x18r\xef\x05z\xc1\x06\x7f\x15\xbe\x14\x85\xd7EW:6:}\xb6\xc7\xf6+s\xe4\x1e\xd4<\n\xe3\xdb\x1e\xf3fY\xdcgS\xcf\a\xbfV\xe1\xcdr\b\x8e\x18\f,\xb8\xeb$\x9eE~\x8f%\xd7\x03\xafU\xbc\xbao\x9f\x1d\x9b}\x86\xfb\xda=\xf6%缏\xe6p\xdb\v\x83\xebH\xf8\xbd\xd4W\f\xae%=\x16\xc1ns\xc8:\x87\xd3\xf9\x01\xd6\xf8:\x8e\xbcal\xc1\xdf8\x9dzC\xde\xdf\xd3Y\x95\xb3~\x10\xfb\v\xed\xf0F\xf6Z\xd3Y\tz\xea\xb9l\xef|\xdce\xc8hא\x84\x8fF'\x83\x93A_\xf3w9\x83\xa6K\xe7\x18\xe9\x16Ü\xbe\xf1\xa4\xdc\x1e\xe1\xb8Nkb\xb6\xcfZz\x02\xbe\xea\xf8\x94\xed\x11\xdc\x1e\xebɟ\xe9\x9e\t\xeb\x86\u0099Xh\x1at\t٫9Jҏ\xf6\xd4J\xeaw\xa2\xa1\fg\xae\x00\xad\xee@#G\xf6\x81%~p#yBMnKi\xea\xa0\b\xe4bѩ\x97\x88\xee1\xde\x13\x96\xffql\xe7\xcc\x04N\xf5+\x86 \xb7\xa5]Vg\xb3\xd5RT\xf4\x92\xff\xf8Ч\x0eP5\x93\x8ar\x9f\xed\x91\xf3\xc6\u07ba\xa7S\xb5j\xaa\xe8\x90_\n\xae\n\xc6\xd5N\x15\x13\xfa|\x94I\xf6<\xafo<y/\xa6+v\x1b\x84!\x1b\xf0\x9a\tF\x99\xabp\xa4\xe7N$J\xad\xb9*\x89\"\x9e\x00\xa9\xcc\xc5\xfa\xeb5$\xd5A\xb3P\xab\xac\x1d\xc1O\xae\xf9\x9c\xfa\xbdwM\xa7\xd4\x00\xb6W:\x0e\x8d\xe2[\xe6֞\x88m\x92X\xact\xa3\xc0\x02\x14s\t\xb8*\xb2\xa8\x9f!\x0f\xaczX-\xc8\xdfz\x18\x15X\x1b\xec\x15.\x80{\x00I\xc5\xc0\xf7\xafN\xf2\xaa{KU\xb1A-\x92\xf0\x91 \x0fq\x15\xd3V\xeb\xf2\xe9\ue559\xefQ\x12\xffݟ\xfd\xfdݧ\a\x16\xdb\xfb[G\x9d\xe3\x00\xf6o\x90\\\x9e/r\xc4й\xf0g\x9f\xb5\xb0\x1e\xd4\xe8oG\xad\xbd\xa7{\x18\xb6\xd5\x01\x9d\xb2\x8b\xea\xe4))\x92r\xd5 \xd7Q|[\xddg\xd1\x05e6\xf5\xa1#\x1b\xd7\xcf\xc0\xbb|\xad\xe6k\x91\xfd\xaa\xbb]:\xd3h\x17\"\x9d\xdaB\xbc\xe5\nN\xd3]et\xb9E\"/n\x16\xff\x94Y_\xc1c\xf6\xefA\x8e\xc0.\xdbD\x97\x0e\xca$l\xb1t\\_\x8bX\x88\xbb.\x9bE\xe4\xb5Z\xa9\xe3\u05eeՉ\b\xa1G\x04\x90n\xdeݣ\x7f\xf4exΝ\x9a\x95\xf6o\xcd)\x8dn\xa8H\xfe\xaeJ\xd96m\xcdQ\xd2\xe0\xaf\x1b\xe9\xbab,\x8d\xf7\xba]\x95\xfbo&\xa8\xe3\xac\xd8$\xa1\x81\xb6fs\x855\xec\xaeh\x05\x8b\x1d\n\xf3?\xe7lo\x9b\x7fS/\n\x86\xbf\xef\xf5\x9fM\xeb\xff\xe3\xce\xe9Qy\xfd\xdf=\xfa\xeb\xfc\xefO\xf6\xff\xbf\xef\xfd\x9f\xefA\x92\xeeq\xfdG\xc2ӥ\x9d\x8b\xd9\x001\xb1Պ\t\x0f\xe0\xfc\xfaM\xfeI\xa8\xef\x7f/(\x18i\x97\xf9\xb6\xba\x17$n\xfc)\xc5\xfe\x90+~\x84\xf7\x9bě\xf0\xe6k\x8b\xaas<Q{\x91y\xc3O\xec<\x9dzQ\x0e\x1bD\x99\xf1\xa45\f\x83\xe1'\x06\a#l\x04XY\x16㌎\xbb)i<K\x86\xdc<?\x80\x82O\xb4\x06\x8a\v\x94J\xeb\x14:\x10\x93`\xecE\x06\xb4\xb34+\xee~\t\u0088\xb8#\xb0\xb7\x81\b\xd7\xc8\xe2\xeb\xeb\x90c\xe3\xf0q+\xd3x\xa2\x91,U\xb2\x81\xfc\xfbF|\x91\f\x00\x81z\x03\xe5\xec`<\xa9t\x94l\xceQA\x88\xa0V\xef1\xd9k\x12\xe50\x0eCo\x9ar\xdfP\xfa1\xbf\x8eV\xee\xcbr\x7f2\x96\xf7\xe9\xf6]M=\xb7uO\xafe\xe6;\uf5a5\x1b$At\x7f\xb5\xcf7\xf2a˫pԬo\xe3$\x9eeA\xc4\xd3ߨm\n\xc2?\xbdq\xdf{\x897inX\x10\x8dbel\"4\xcfx\x92j\x03\xac\xb82\x88sp\x1b_UL\r9\xe4\xd6(\x878\x99l[7\xc0\xfe6\xb5^\xf04]\xa7>K\x15\v\xf0ߦn:\\ߚ\xe1\x02\xfc\xb7\xa9[\x9c\x18oY\xf7\xfa\xe1)P\xfdY\xf2\xbb\xe1f\xcd5\x8fx\xe2%\xdc/ݬa\xff\x84-\xf3d\x16\xc1h\x85\xbci\x12\xfb3R\xcd\x00`\xa9\xfb(\t\x9fƸz\x91G\xb3\xfe$\x88\x824K\xbc,N\x18\x9fxA\xb8\xedU\x1dhCn\xa4\xab\xec䡺3\x0f\x9c\x01Oz;\xe2s\xb9\x9dr\x11\f\xc2<t<\xae\xa9\xa7\t/\xbf\x8c\xd1/9@L\x13n\x0f\xe5e\x81b埳[\xec\x00\x14h\xb4\x02\xccտ\xf3]\a\x05\x81\xb2\xf3PZ\xf3\xe8\xa8`k\x8b\x15\xc5\nB\xf1Μ\xb8ȳ\xe1ֱb\xa2\xe9\x12O\x82\x9c\xe1\x15*!Q\"k\xcdܟ%Oγ1JZ\x1ep!\x1b?9\xcf|L\xfb\a \xa0D\xff\xc9\xf9A\x96\xd4̀X\xa5*\x8f_\xfc\xf5\xefO\xff7M\xe2Q\x10B\xa4\xd0Q\xfc{-\x037\xae\xff:\x9d\xd2\xfa\xaf{\xd2\xf9k\xfd\xf7\x87\xfc;\x7f\xf0\xe2\xed\xf3\xf7?}\xff5nj?\xd99\x87?,\xf4\xa2k\xd7\xe0\x91X\xacq\xcf\x17\xd3Єg\x1e\x9c\x95&)\xcf\\c\x96\x8d\xdag\x86\x9a\x05^\x7fm\xfe\xafYp\xe3\x1a?\xb6\x7fx\xd6~\x1eO\xa6^\x16\f`\x8d8$\xdf8\xd7x\xf9\xb5\xcb\xfdk\xae\x95\x84\x9b7\xaeq\x13\xf0[\x98@\x14`\xbc+\xe3\xfa\xfc&\x18\xf26~X\xf2FX\x1b\xc3S\xb9\x1d۩A\xe5sҔ\xb0B(\xb0\xd5\x00z\xb3l\x1c'50\xf5\xf7\xda\xe9\x1e\xfb\xc1A\xc43?\xf2\xecA\x1cg0\xbdM\x87~d\x0f\xe3\xc9A\x9epphw\xec\x0e\\_/\xd2\xf2\xcb\xee\xa2\n\\\xf3>\xf9\x1e\a!̮/#\xba\n\x82\xa6\x13e\x12\xe0ƙ\x92\xb1\xcal\xf9\x1e\x14\xae2[\xca\x19\x93t?ΙS/\xe1Q\xf6\x06\xa6y\xe5'̤b\nU\x7f\xeb\a\xb6\x88\xe5~\x13&\xfc+\x15cn9\xa5\x988Y\xed\xccI\xffx\xa8\x9d\xc8\xec\xed~\xf9\xf8\xe4\xd8Q\xcf-kv\x15\xcbu\xd7γ\xcd\xf8Ot\xfc\xdac6ż\x8c_\x8dA\f\xe0\x9f-\xdd\a@\xe9*\x04\xdeƉ߾M\xbci\x8f\x1ckېPT8\xf1\xe6mqq\xac\xe3Ȑ\x05\x05\x1dY҈\xb7\xb9\x10\xd8\x1dM\xa5\xc6A\xc6\xdbx\x1c\xdc\x038\xa4\xac\\>/\xcco\xc0\xeb5\f\xd2LA\xa1\x9eXFq\xc4+\xa5\xa9\x14m\xfc\xa8\x1d\x85G·\xe2\x06\xdc \x0e\xb5\xfd\xe6\x1b\x9ed\xc1\xd0\v\xe5yw\x16O\xd5lqj\xda\x16g\xf4\x9d<\x94\x83\x9e\x8dg\xfa\x8f\xed\xe3\xe9\xbcJ\x95\x10\b\x86v\xbaJ\x97\x1f\xa4\xd3\x10\x9e\xa1\x0f\xa20\x88x{\x10\xc6\xc3O\xf7Ğ˛\x82Y^\xf6s\xfa;\x95\xaaJu\x14\xbe\x03\xe3\xc0\xf7yT\x11\x03\x1cܪs\xb8\x17\xe5q\x19\u00909\xf6q\xda/\x8b.\x85a\x80\x9fRן\xc3I\xbf\x10f\xf5\xc6~\x1ce^\x10\xf1$\x17f5\x17\x96\x13mZ\x17i[@\x9dz\r'7\xfa\xb28\xf3B\x8a\xfe#8S\xec\xfa\xc9;\xd2ފj\xa6\xbe\x0f&At\x9d\xaa\xbb:\x10\xe9A\xb1U\x95\x1d\xc5i\x12Dو\x19\x0f}\x86\u0097\x1a\xac\x15\xf2\x88\xd9_\xe3\x97\t\xd0m\xa4\xe5=R\x05\x85=\xa1\xf3\xbf4\xca\xcb;Ұ\xb8\xbc\xeb\xe7OT\xa1K\x8f\xf1Dh\xa4\xf3\x03\xef\x89Ҙ\xae\xc2\x17\xcd\x06\xa7\x0f\xfco;͒`\n\xeb;E\xb2\x94\x98fH\xa9\xbe\x89E&\xba\x96\x00I\xbe\xc4\u038b%#\xae,+̦\xe5f\xe6\xaf\xc1\xa1\xea\t\xe3I\xe5\xb0FoNU/\xd4\x14Q[\x05\xab\xd2J\xa3\xd64\xae\xae\x91\x856\xa1=\xc1\xf7bG\xb9\xaeaJ\xf9'\xea\xeaZo\xe5r\xc9\xe0\xb5o\xd6\xc2Ke\xccF\x17!\xc32L\x06\x8f\xbd#j\xdc\xf4m\xac\xa0X\fU\x1a\xae\xed\x1bj%h\xa1TI.UQF]EYBU٫\x1cw\x9f\xc4٘'\xa9\xba\xe5\xfe\x0e\x16\xe9\xb8\xd4_\xad\xaa\x82[\xc4\xf18 \rq~@\x86\xe3_K\xa9\xff\xc8\x7f\xca\xc6\xc1\xefVǦ\xf8\x7f\x9d\xeeI%\xfe\x9f\xf3W\xfc\xaf?\xe4\xdf慅\xb6\xcf$\xc3刽!\xf5\xf2H\x7f\xa71\xc8\xcc_\xff\xfem\xff\x95\xa3\xb0\xfd\xf1\xe3\xbf\xd3=q\xca\xf1\x7f\xba\x87'G\x7f\x8d\xff?\xe2\x9f-\x05\xe0\x03EN\xb0}\x9eyA\xb8\x9c\xc6\xf8v\x10\xd9oi\x0fWo\xb9_t\x1e\xdf\r\x969N_:>w\xfbʂO\xf8,\xc3\xff\xec\x8e\xd9\x17\x9e\xadN\x9f\x16\xa3\x9d鼯\xacL\xe2\xa97\f\xb2\x05\xb3\xbb\xc7)\xa3\xf0\xe4\xfd\xf6$\xfe\xa5\xbd\t&\xde\bq\xcb\a\x9f\x82l\x03ت\x81\rv\x10yx\x01x)\n\xf5\x9c&Pf\a\x19\x9f\xd8%\xf0N3\xf8\xfcC\xe8\rx\xb8T#\fR|A%\xa6\xe0 N|\x9e\xb4\x13\xcf\x0ffi\xefp:\xefK\xa7\xe0\x93\xe9\xbc/k\xb1\x8f\x05 \xf0\x95\xa5q\x18\xf8\xecK\xee\xc0\xff\xfa\x85w5\x06\x13\xac\xf6\xa1\xd2g_\x8eF\xa3\xbe\xba\xee\x8fbX\xf4\xafo\xf2\xb2\x8aR\x11\x88F\xfa\xed\xee1\x9f\x94\xa9[ˋ\xbc\v\x9a\xc4\xec\xc8\xec\x93\v0\xb6\xa3\u0091\x12\xa8p\xb9F\x9f\xfe\x0e\x9f\xc8o\x10\xe96|ߗ\x0f\x95\xaeo\"\xf3\xcc\\\x8f\xa87\xe0\xa38\xe1K\xb1\x1f\xd93~\xee\x1e\x0f\xbbFM\xd7!\xedm\x1b\x18I\rW\xab95\xc5X[#\xb2~\x9c-\t\xe8h:\xef\x8b]\b\xf8\xa92\xa7\xad$ w\xa0\x1b\xf5\x8e=\xae\x97\xacx\xdeNǞ\x1f\xdf\xf6\xc0\x95\xbf;\x9dk}pbVdO`%\x92\xbaE5\xb8A\xd1\xc3n\xecˍ\x11TI\x05\x82\xf60\f\xa6=\xb9\xff2\x88\xe7k[-;K\"\xc3M\x96r\x89B\xb2e\xd8\xd0\n\xce\xf4\xe6Z\xc7\xd1\xcf\xf7gh{\xa6J\xc5\xfc\x03\x84\x04Z6)Ӓ\xa2t$\vHN\xa7s\xe6c\x10\x14\x8d\x93]\xb3_\xa3\xaf\x9b\xaaf6.\x91k(\xf8\x9c\xd1h\x1f\u05cc\x14M~H\\\xb0U\x9d\xa9\xda-^\x14\xc5\x19\xee\x04\xe1c\x13\xa0\x8a\x97B\x06;E\xdf\x03[\xaajM\x91G\xdc\xe1[\xd7U5\xf50\xbbH\xaca\x84\xa0\x02t,u\xc3IyL\x00\x7f\xd6\f\x06U.+#\xb3{\\\xa3\x01\x14\"\x91\x11\x1b\xe4\xa3]Ц\x8bH\xb7V\xdf\x1d\x9aڸ\xd9X\xfdV\xe3C+\x85\xdb8\xcbf\x03@\x1b\xb6[4nse\x9fI\xa3(\x86QՆ\t\xe7Ѳ\x813\x1bĆ\xd9BA/\xcbZLLBp\xff+\x1f%\x8f\xf3\x89\x0f\xc5c\xbdf<3\xeb&β\x84\x17\xd3lu\x96/\x8c\x04vF\xff\x8f\x1c\xc6\xfbU$\xc0\x9dN.@\x9d\x13\x18AZ\a\rgI\x1a'=\xa1U>\x83)u\x93ؠv\x12\xa3\t\x17\xa8Q&o\xbcϖs\xa8\r\xe3\xbfSa\xd2\xf6d\x89>\xb7\xb6.\xd0\x1b\x83\x1e_\xae\x1d\xc6g\xa6d\xd3g\n\x8e\xa8Dv\xe3\xb1s\xef\x06\x152\xd84\b\xb6jg\x03\x9a~-ebX->@\xe49\xabf\x8e\x81\xf4\x0f\xfe\xe1r\x14\x84a\x83\xb6Y\xe0D\x942|\xfb\xc8j\x98\xa7\x00\tA,E$CM\xa5\x9c\x98}JV̅t\xecMy\x9bb\x8e\x81\xfc\x0f\x93 \x9d\xb6\xe1\xac9\xddn\x86\\|\xb8N\x02\xbf\x99.\xc8-Ȓ\xfciw\xfa5\x14vK\x04\x82\x88\x8b\x04\xdfK\xc7^\x92x\x8b^\x87u\x1a\xa9\x80h\x15눀\xfc\x82˒\x84\xf5\x1co\u00993\xbc\x1e\xe9u\xe2-\x9a\x91\xc2h]\x8b\x14\x00\x96\x8a\xc5PY\x94lm\xbc\xd8\xd7a|+,\x18\xab\x89 \x02\xc2J\xb1%\xa8\x8a\x15\xad\xacj\x97\\\xb78ťJ\xf9\xffvǴ \xab\xbd!\x7fM\xb6\xb3>s\x13f\xa7)\xb3\xbd9w\r\xeeF\xee\x06\xd1\rOR\xbe\x91\xc1\x12N\xe1\xb12\xf3\x955x\x99ǹ\x02\xadpWϩ\xcdp\x9a\x92\x9b\xf18\xd5\xe4\xf6\xba\xf4ZL\n\xcfB~\xcd#\xbf\xbar/˵\xba\x1aUl\x84#\a\xfe\x97O\xb8\xdaY\xb2\x9c\xb9\xa1<;\xaeؖ\xdd\r\xa6.QV\x9aX:N\x05\x82\xd9魗\r\xc7b\xf5\x87&\xb44\xbd\x9d\xbcֆ\xc5s\u05ec\xc1\x87F+=\x15\x80/\x05`\x9b$JxaA?\x97\x87{\xdd\rXr\xdaj\x19$\xacp\xc2rXǠ:\xb4\xb8\xd3BE{N݂E\xafK\xe9\xc8 \x1a\xf3$Ȫ\x16=\xee(\x91ӌ\xe8h\t\xaaz/\x1c9\x8e\xc6\n\xba{\x9fw\xb3\xae\x8bJ\x8aOR\xef\r\x15\x93$ע'\x8d\x90K\x8dGvW\xdfgqԍ\xa3n\xc9\xd2S\x01\x8f\x1a{\xc8\xf6\x83\x14\x0e\x16\xfd\x82\x9a\xa3*\xe8,\\¹/-\xde1 \x1aM)y7\x14L\x10I8f\x9a,O\x815\f\x96y9&\x8c\xe7\xfe$\x88\xc4${\x06\xcd[\xbbu\x93\xe3\xa9\xd8x\xbd\xaa\xaat\xeaL\xf1Zdb1\xb2\x16[\xb7\x0eٿ\xd1\xfe\xff\xef\x14\x04h\xed\xfe\x7f\xe7\xe4\xb4{\\\x8e\xff\xd9\xedv\xbb\x7f\xed\xff\xff\x11\xff\xc0\x1fP\x86\xa5v1\xfc9\r\x9b<\xb6X\x9edŃ\x8f\xe6\x92\"\xe4'Y\xea\xe6\x19\"\xe0\xaea\x1bf_d\x83\x1b\xa5\xc4\xda\x1f\xc5\t\x85%p;\x16]>w\x11\x83\fJ\x10\x9c\xcb\x1f\xfb\xfbT\x81\b\x12\xf4\xbd\x97d\x04z\x19\\\xf5\t\xed\xa5\x92w\xe5֤\xdd\xdd-W\x02\xb6.{%\xbct(keAtߢ\xb1y\x13!ٽ\xbc\xcai\xff\xc4\x17,\x88\xe0Y\x16\x13\xb2(Z\xfc'\xbe0\xfb\x02\x1f\xa4\xae,\xbaFY\xe0\xf3y\x9a\x05\x11\xae\xb4,q\xd7n)QN\x93xʓ\f\xf1\xca<\x05\xfeR\xe6_\xb9\x94[$\xc86(\xd0+k\x18\xc6\x11/\xb5\xa4\x1aY\x11\x7f\xa6\x18@(\x18-\x10\xca\\\xad\xfa\xc1\xa8\x05\xfa9\x1e\xc1\x85\x8eY\xc8\x1f\xb8\xae\x91ߡ7vw)\xd5\xe6s\xf0\xcdM\x89E\xfe\xa1\v\x81\xfa\x82\x84\xb7\f\xff\xd00\xfb:P\xde\xff\xab\"N\xddu\x18\x0f\xbc\x10Cd\xce3\xc2\xf2!\x8b)\x9e\x91K\x01>\xedi\x12g1\x10c\xcb\x1c\xeb\xcd\x0f\xaf^}\x00\x1fe\xd7x3\vC\xc3\xfa\xe1͋\xaf\xbfy\xf9\xe6\xeb\x17\"\xf5\x87\x9cT뫷o_}\xfd\xec\x8d\xc8\xf8*\x8eC\xeeE\x86\xf5\xe6\x87\xd7_}\xfd.G\x02A\xd7\r\xeb\xe2\xfd\xbb\x97o\xbe\x15\x89T\x97a\xbd\xfd\xea\xef_?\x7f/\x12\x89&\xc3\xfa\xe6\x877\xcf߿|\xfb\xe6\xc3\xf3W\xcf..\\\xe3\x92\xde\xe7a\xf2\x8a\xe2\x95\xd1/^\xabH\xbfQ:\x81\x0f\xb3\xbc\x1f\xf2\xb6\xda\x10\xa2Q溮\xab\xa3_\xe5\xa8H\x9c\xee+E\xc1\xa8E\xbf챗\xbe\xbd\x8d\xbe\x17 -\tk\x9a\xdb\xc9Y\xbfF\xcer\xda@\xe0\xf3\x06\x06\xa3\xd6\xfbŔ\xcb\xef\a\xae\xab\xb0\xd1\\f\xe3$\xbe\xc5\xe8%\x00\x85\xf7uW\"\xb2\xe5,̴\x81\xa6\xb6G\xc1N?\x9b\x1b\xb4\x14\xa8h`\xe6\xe9+9TDvA>\x91k.\xd3\xdb \x1b\x8e\xe1\xd7\xd0K9\x06\xff\xec\x892\xb9\xd8\xf51\xeb&\x0e|\xe6\xc8L]\x04\xb15\xe8B!\x86Q\xdc\x17\x88\xe1\x9bp\x1b\x03!\x8c\x12\x85*\xaaX\x85\x11\x91`\x16\x04\xe4BK\xf94p\xf3|E~e;\x15\xb6\xaf\x14\x89\xcc\aHY$%\xb9\x98\xeaj\xa3\x1e۔\x86\xc1\x90\xbb\xcf`\xabB\x19\x99\x98ZH\xbc\x97\\c<\x1b\xf0\xb6L[\xa3\x88F6\xce\x0f\xee(\xca\xc7q\v^\xb8\x06\x9e\x1c\xfc\xf7\xe5\xcf\xe9ϭ\xabG\x12\xc3\xe5\x7f\xb7\xae\x1e\xfd\xdcj]\xfe\xb7y\xf5\xc8\xfc\xd9<0/;WEؖ\x9f\x0f~>\xb0\x1f=\xbd\xfc9\xf99\xba\xba\xfb\xf9\xe0\xe7G\xad\xa7=\xfb\x8e\xbe\xcdGO\x7f~\xf4\xf3\xc1\xc1\xb5e\x18J\xa8\x97\x9f\xd3}\x91$f'\xcb\xc8u5R&f\x1f\xd7\xed\xec\xee>\xc0\x94K\xe7\xea\xe9\xe5U\x0f\x7f\x17\xcc\x03\xeb\xb15\x8a,\xf8;\xe5\x89\xd0Z\x1f(R\xb0;\x8a$\xd2\"V'Bx\xae\x88\xf0r9\b\"\xbf%\vX\xe8+zeI\x96\xa59Q\x02?\x04\xd7\n\x17\xe8Rjy\xe6jU\x0e\x18\x83\xbbFP\\ha\xfc~EM\xc1ߢ]r\xa6\x05H\x91\x04fq\xc8[\xf4\xd1n\x9b\b~\xa9 ا\xac+,t)>$}\bW\x903\xe1ɵN\r\xfevQ4H\xbfQ\xa6\x93\xb7\xb0ڂ\x02\x1d2i\x14YC97\x04\xa3V\xce#A\xffywwW\x15\xe5<\xffҹ2M)\xd0\xe3 헺\b+\xd3)\x13%\xad\xae\xd9\xd8\x7f\xb2\x85\xd7iMoI좻\x04\xd9\xd8cP\x9aO\xa6\xd9BN\x03\xae\x82z\x85\xb4\xe1\x93Dn\xa9Ɨ\x17\x1f^\xbc}\xf3\xfe\xeb7?\xbc\xfe\xf0\xd5\x0f\xdf~\xfb\x93\n\x90\xabG\x16DK9\xa0z\x9d\x152j\n\x03W&\x1a\xa6\xea\x87,\xf5B\x96\xcc\xf8\xaae\x16c6\x9d\r\xd0\xe1\x15h*\xc5.o\xe5V\x1e\xd8P\x18\x0eY(Ԁ\x83\xae\xa6\xe1/Z\xae\xb0&\x18\xb5\x94\xb9\xaf(\x82\xdd#-\xb2<\xd5N\xc7\xc1(SI\xfa$\xe8\x81.\xb4\x8b\x17\x15\xb4\x11\x91\u05f6\x12s#\x16\xb2\x90\xa1\xb6x\xbd\xde\xecc\xa2\x9dΦ<!\xaf^\xb1L\x97\xe9\xd4tl\fPM\xb9\xe6Rf\x14\x9aN\x14,\x12\x04\x8a\x02\x00&5Y\xae\x9f%\x8b\xa5(QT\"lE\xf80W\x18d\xbd\xc5\xcd\xe5jU\x98\xc5Nn\x16\x17ܩ\xb3\x8d\xa9n\xcf\xf7ECU\x1e\xc3c$\xc1\xa8\xf5\xa0D\x9f\xc2G\xb39\xcb\xd5\xe4\xb5\xdcF8\x1cH\xb3d6\xcc\xe2\xc4ż\xdc腏B|\x14ʤ\x99\x82C)\x1a\xf2\x14\xcab\xc7\x16\xbd\xb2\xbb[J(\xaaT\x05\x0e\r\r\x81\x0f\x9aX\x19'8\x06\b \x9fk\x1e4\x9b\x93\xa6\xc2e\xec\x1ae\xe8\xf4\vLx'\xf6\xed\xa8\x06\x91ȩ\xe2\x11\x19\x86\xf9\x19}\xbbT\x8d\x1fW\xebX\v\xf1V\xad3P\x91\x82\xb5\xa0\x18\xf3\x91\x87\xe0\xe6\xee\xae>-S\xea\xa5s\xe5\xba\xc6ߐ\xe7\x06U*\xd4$\xe6\xf7\xa9*\x9a\xf3$\xbeIn-(\nI\xa4H\x02.'W\r\xe3tUXc\x16UeR-\x92\x8f.\xea~ʒi%\xc8|\x8d\xa0\x82\xcaD\t\xbbBiʻI\xb1k\x11\xc9J\x99 \xc4\xef%\xe9\xbb\x1e\xfd\xb1\x84\xec\xf6\x96\x85\x1c\xf7\x8a\x9f\xab\x15\xe8\xcf`\xa4/b\x8a%Qm\xb2M\x9a\x1e\xff\xbb\xe2a\xcaK`j\xf6\xcal\xc9%\x93ٗ\xbf\xec|\x95\xdd2\x94\xa7\xe8\xe0N%\x15~\x057+\xcc~c\x9e:\x89\x14\xab<\x19\x81\xb0\xbc\xce{\xd02\x869\xd6 j\n\xb9jx\x86i\x9a\xcbBD\xe0ڦ\xb94f)g`\xa3\x0e3\x03\xe7\xb9\x1c\x17X쮂\xda\xc2n\xa2Լ\xc7\f\x8b\x87|\xf2<K\xbe\x87\x14\x17\x91\xdap\xffNT{w\x87)\xe2˼\xccq\\\xc1\xd6\xc4\xf3,\x11C\xd5J\xb3\xe4}\x12L\\\x92\x0f\x05\xceΒ`rwW\x15c\x14\x9d\xdcv\xfc\xef\x9f\xd3\xfd\xbb\x9f\xd3\xfd\xbf\x91\x05\xb9\xb2\xbc$yI\xb7\x1b\xc9\x14VQ\x8ak\x8f\n\xd6 \xe3\x13s\xa9\f\x7fR|b\xb0\x83j\xa0\xa1O\xe3\x1efMX\xec\x00\fi\xc4\xcb\xe0\xcau]\xc2\"\xe8\v\xe4B\xa6\xddYY/\u07be\xfez^\xf4+\xea\xcb\tOS\uf68b\xc9\x13\xef\xb5Bz\x1f?\x87\xb1\xcf],5\xe4x\x1f\xf6\x12\xf2\xae(S\x94t\xc5ߕ5\x1c\xf3\xe1\xa7\xf7\xf1'\x1e=\x8b\xfco!`\x9aϕ\xea\xf2N\xb42\x80!\xa9\x82_`\x84\x18\xeaZ\x0fkl\x19\x17?\xbdy\xff\xec\xc7\x0f_\xbf{gXƳ\x88\x05э\a'\nq\u00820\xe4\xd7^\xc8h]\xc3n\xbd\x94\xa5S>\fF\x01\xf7\rs\x15\x8c\xc0\x8e?\xb03\x9efT\x87Y\x83\xff\xe5\x9b\x7f<{\xf5\xf2Ň\xe7\xdf={\xf7\xec\xf9\xfb\xaf߉\xaa\xa8\xf7\x99\xb8\xb1\x962\xaf\xa8\x1a.0{\xc3\fT\xe0\xaa0oE\x17\x93\x89Xn\xe6ʪ\x19R<\x94=\r\x825\xe1\xfesaW\b\t$T\x00U\\:5-i|\xe8e\x9e\xea\x9fbق\xeb\x18\xb3wyeIY\x12\xa5\x9bĉ\x94 LG\x02\x10,\x03R\x8d\x1f\xc8\x00\xcf\xdf\xc3TU\x83N#\tl\xb1n[\xad,u(g\xb1\x9b3C\x19\n\xee\xe5U\x01\xf6-\xcf2\x9e\xb8ա\x06=\x97\x97\xa6\xabs\xab>v\xa5\x8a\n\xf7\b\x94\x84\xbe^?\xfaI\x16\xc8\x03m _\x06Www`\xb6\xaeʥ\xa4,\x14%\x85\f\xe3\x9f}\xd70\xa4eS;\bhn\xa3\"\x0f\\\xb7ݩT\xe0\xf9\xfe\x1a\xdc\xc1\xa8\xb5\x11\xaf\vx\xd5n\xa4\xe4~m\x0fBϔIH\xf8$\xbe\xe1k\xa8@ńcz#1h\xd2C\xe2\x03\x85*\x10\xcc!\xa7t\xabs\x0f\xca\xe8\xca\xe4o\xc8\x1f\xcf\xf7\x05\x12\x9a[\x85\x16\x87\xf6\xcb\xf4\x1a\"\x84)\xd10\t\xc0m\xbf\x96\xc1\f\x137Ei^\xb1i\x82\x94\xdbNb\x8fZ\x9d\xdb^\xf0t\xe8.\xafy\xd6+\r\x00\x8bG\xb3\tOబ\x87\xcfp\x0e\xe3h\x14\\ϊ\x94\x15\xae\x1aj+j\xa9\xf3\xa16\x02\xa7V\xa5\xfa|m1G\x95\xcc\xe76\xed&\x01ú\x9d\xa3\x93\xb3\xeea\xf7\xb8k.+\x05\xed\x82D\x97\xee\xae\xfe\x16Ĭ\xb0KX\xceCu\xca\xfc\xf0\x81P\x13\x8b>|0\x97*\xeeJv\xab\xa12\xca7\xc1p\xba\r\"?\xbe\xd5w\xb2߽|\xfe_\x17\xdf=\xfb\xe7\x877o?<\x7f\xfb\xfa\xfbg\xef+\xd6N\x15\xe4\uebb1\xb4\xbe;f.K\xaf\x03\xebf\xd7j\xad\x01\x87\x8f\x04\xaaV\x1b&\x14\"I\x1b*\xb0\x9c\x83_\xb6|O\x19\xa7\xbe\x12\x16\x16q\xee\xa7\xccc\t\x1f\xf1\x84cd\xeb\x18\xe6:QȠ\xf1)\xbe\\\x15\x1f\xe5Ћ\x8e\x94A\xbf)\xdd\xe7#\x0f7g\x97x\x10;\x8dCr\x183\x86^\xe2\a\x91\x17\x1a\x16\xbdK\xdb3~\xe1IlX\x93 \xea\xe5\xfc\xb1&\xde\\\xf9\x9a&\x1cߌ\xe8ѦD\xd1p\\\xdci\xf5\x99\xf0\x18\xea\xd7\xdep\\X\x97\x9fh\xbc_~\x12;S\x9f\xae\xee\xee\xb42\x97\x9f\xaeV\xb4\xb3F\xb4\x938\xb8\xcb\x15}\n\xdd$\x9eE\xc2U?=\xef\x19\x8ep\xa6\xeb\x97v\x1c\xca\x1d\x81\xd9h9x\x19\x17π*\xfc2UF\nǼ\x1a\xf5\x02\xd5I\xa0Q\x10f<)Z\x98J\xa8\ai~\xb6\xbe2W\x12qv\x11\xfc\xc2[\xc2W\x03kƟ\x96\xf0)\xc0\x14\xfa\xbd2\xb5\xfe\xb6\xf3\xc1\x82\xca\xd2\xd0\xddy\f\x01|\x13\xa4\xae\x7fh\xa7<\xe4ì\xa5\x967E\xac\xff\x96\x91\xde\\\xf7қkô\xbd,KZ\x14\x95Ű\x04\xbb\xfdl,3\x88\x0e\x91C\x1ff\xbe\xbb\x0f\x83\x01\x8c]]\x86\xedw\xe2\x19c\x92y\x00\xba\xbb{\xd0\x00S>\x03\x00h\xd3\xcc\x03\x89C-\x89\xdbP\xf6\x12\x80q\xe1\xfc \xb9\xbb{\x90\x14\xabE\xfdK\xbcZZ`žK\xf8u\x90f<\x91\xd8Z\xa0\x01\x92֒\xde\xfd\x05\x90\x95)L-|\x17N@a\xefȇ\x9a\xef\xee\f\f\xd0h\xd0\x06\xa8\x18jA:\x04W\x84w\xe0\x9eܒݮ\v\\!PB\xe8\x90U\xb41\x1f\xa4\xf8W\xe6\xc02\x8e~\xb2 J3X\xa2\x83Bӟy6i\x16#\xb8\x8b\xe0:\xf2\xb2Y\u009bwM\xc4꾐xPE\xb2\x16zN\xcb\x13\x9b\xc4=f\xec\x97\xf0\xe2\xa6(\xbat\xa4\xcf\xe3Y\x94\xf5\xe58(\x0fuѮ\x96F:\x11%m\x7fY\xab\x10Q\xa5v:\xc5\xc0\xeaa\xed\x00c\xc9˼R\xb1\xb1\a\xf0\xf8\x1a @\x96\x0eDSsU\xc3W\xc2SB\x04iuM/c\xa4\xc2ȁ\xb9K_\x97Ε=GQ]()\ve\xf2\x9a?p\xe5iP>%-\x8a\xb4\xdd\xdd\xc5\x03\x17\xb7f%Qs|Cm\xc1\x8a\x9d#\x16\x8f\x04\xcbY:\x8eg\xa1\xcf\x06\x9cQy\xe2.\xf7|\x801\xf6e\x9d\xfb\x06\"\xc9\x13\x16\xc0\v\xa2O,7\x9e\xb8\x87\xe62O\xbc\xec^\xd9\xf3s\xf1\xbbse\xcf\xef\ue50fs\xa5\xady\x86\x86\xad]\x06\xab\xe30\xcdoY\fԧ\x14Z0\x8e\u061cQ\xcc:|\xf4@\x80\xd3\xdb\xed\xc6>\xad\x7fW+1%\x88\xe1\x04\xc8^\xc4\x10ڠ\xbc\xf1\x0e9\xae\xaa\xc2'\u07b4F53±\"'\x87I\x10\x81Μ\x04\x11>\x88\xa8\x97\xf1\xf32>\xb6ke\x8aB\xde\x1c\vy\xf3\r\x85|\x95CP\x9ar.'A\x043\xebժFqT\x9aE\x8d\x15F\xb1P\x9e\x94\xd8\x12\x8a\a\xa9\xc1\x18Z6݃l\x99\x12\x82\xfe\xd8s\xd3\xc6K\x13\xadKG\xd1\xf5W\xa2\xfcbc\xf9E^^\x99\x0f,'G`O\xbc\xeb(\xc8f>oFu)q]:Wm巕\xff\xeeh\xe9We\x9a\xa9\xd6+)\tČ2\xbbP-s|\xd6Z\b\x03$\xc0g\xab^M\xf7u\xce\xe6\x0f]י\x1cUE\x97?N\xb9\x94\xbfZf>\xe3Si\xb5\xeb\xfa:I\x1bE\x98\xac\x90\x96\xd9(bB\x96\x9b\xf3\xcb&\x8a\xaf\xaf\x93>\xe0!P\xcb7\xe5(\xa3?}\x11\x91\x13\xad=W\xec\xc8\xd2\x17N\xbb\xf2\xc3\\\xaa\xd4V\xa7\x81b\x8e\x13 (\x1a\xe6\xb2@\rK\xa7\x95\xb9\u0096\xcbԧ\xf9$\x87\xde0\xf4Ti\x0f\xfeۯeNC\xbd\x16\xaemkj/fL\xec\x04\xd4[\b\x8b6E\x91c.\x8b\xdf\xd5J|s\xe9\xdb\vWE\xdd\xf2\xed\x05t\xffje\x96z\xda\x1e\xc7\xf1'\xa15+\x98x\x94%\vs\x89<\xc0\xdf\xf6(\x9f\xa7Ñu\t\x19W\xa6\xd0X\x8a\x80cW\xe8\xe2;\x8b0_\xf4ˇ\xdc\xf8\x80D\frDHBo\x11\xcf2\x18\xab\xf4\x8b\bm\x99}\xf1IK\x02\xac^\xfc6\xfbJ\xc5.\x81QǬ\xd4\f\xe5\xf7ݝ\xd2c%>x\xa3\x8c'\x8d\x8cP\x11n\xe4G\xe0:\xfd{\n\xa14\xcd\xc5\x11\xab\xb4b\xb0Z\xb5\x05\x97\xc1\xfe\xfe\x95֕\xd5Vʝ&%i\xd5o`~EW\x91I\xa8P߿\x87\x81\xa5\x80\xb9\xcao9G\xe7\xc7S\xa3\x96\x92\xbb\xbb\xab\xe5>PK\xe6\xd365E\xce\xc7C\xbcf\xc4\xc6\xde\rg~0\x1aᕜ\xdc\x02ɭ\x13\x98\xb2\x15d\xfb\x06\xbbIq\x16W\xaa\xdb7\xfa,\xe5\xbclĂ\x92\n[\xa6Q\x9a\xeaKB\xe3\xe2\x00\x81=T\x94\x9eޥ\x9c>I\x87\xb9\xea\xc0\x04\v\xacXA\xda\xf3\xd7Atw\xa7\xa7xs\xb1\xb9\x9f\xbe\x8ch\xe2\xc5'sjJ\xee\xee\xfa\xf6\xfc\xbc\x9cj\x16\x05i\xbb\xa5Rқc\xc9'\x95z\xcb%\xe5\xe9\x80L\xd6N\xfb\xa9\x8dq\xf4\x03M&53O\xedLE{\xc7\x12&\x9f2\xf5\xa5O\x81-ɗn\xc4R\xf9\x9d\xba\xa5o\xf4\xcb,\xa5]\xca_h\xb1]\xb9\xf2s%\xcf-h\xaf\x8c\xd7\xec\x8c\x14\xab\xe0\xbb;e\xfdk.\xb5%3\x82\xaf>{\xb7\xe1\x13\xacU\x01\xc7S\xb1\xef\xd0\xfb$\x8fj\x9e\n\x98\xdeڍ\x885\xeb@]\xfdB\xfb\x85OL\xa5\xa4\xc2l+o\xbf0\xd1\x13Wy5\xc1\\jHݚ\xf5i}w\xb6\xb4rb75\x9f#\xd4\xfe\xba\x92c}\b+\x8ah/c\xa3 \xf2\x99\x84`\xc6~\xb2҉\xa8\xa2X\x15\xe4\xa3c\x8ek\xd0\u00adL\x7f\xd1\xff\xa2\x1f\xf5\xfd\x90\xb2P .\xf8\x0fJZQ\a\r\xa0\a\xae[<\n%\f>x4\x8er\xedk\x9e\xc1\x16\xde,\xe3\xf4F\x91\xb6\vb\xd1K\xddPH\xa4\xfc\x13cҢK\xed\xcb(k!*\xc0!w%0\x1e\xb6\xdc$1\xad\x8e\xa3\x95\xfe\x0eEusq\xb1\x95\x82\xe5W\x85\xf5\xed\xaa\xb2\xaf\x12tw\a\xb7M\x14\x93\xd7UF\xc6ݝV\xfb\xdd]\xf7\xd8ɷ\x7f\x84+\xc6M\x90\x8a]\x9c{m\xef\xac\xca\x1bh\xa2\xbf֟b\x7f\x13\xccak \xb5\x9f\xc3\xe5\x19u7T\xcfQ'?\xea\xffᘃk\xa1\xd4'\xe2ۆ\xa3\xc3,\x99M:G\xee\xa5\xf1%\x1f\x0eN\x1f\x9f\x18\x96\xf1\xa5?<\x1b\x9d:\xf0k\xd0\xf5\x8e\xe8\xd7\xe3\xee\xd9\xe9\xb1\a\xbfN;'ã\xc7\b\xd7\xe5\xfeY\x17\xe1\x06\xfc\xe8\xe4\f~y\x1d\xdf9\xf6\xe1\x17?\x1d\x0e8\xe1;\xf3<\x1f\x7fygggC,\xf1\xd8\x1fv\xfdC\xf8ur\xf4\x98\x0f\x10\xdf\xe1٩\xe7\x1d\x1a\xe0Ȉ\x97\xe5Zf=\xbd\xf0\\(P||z蜌\xa0\xe4q\xe7hxzB\xd8N\x8e\xcf\x10\xef\xe9\xe1\xd9\xe1\xe3#L\x1b<\x1e\x9e\"MgG\x83\x93\x93c\xa4\xe4t\xe8\x1dS\x1bG\xfc\xf4\b\xcb\xf2\xee踋\xad\x18\x8dF\xa7]J\x1b\xfa\xbe\x83p\xfeѠ\xd3A,>?;\x13i\xfcH\xfc\x1a>\xee\x1cw\x10\xf3c\x0f\xae3 \x05\x03程-;>s\xce\x0e\xa9\x8d\x1d\xe7\xac;0\xae\x1a[ց\x96uGݣCl\xd9\xe1\xb0;<F\xbcG\xde\xe1)\xf1\xf8\xf8\xe4\xb8K\xbd\x02\uf05e\x0e\xb1\xae\xee\xe3\xe3S,qv\xe2\xf9'\x9c\xfab0<\xc6_\x833\xff\xf11\xf2\xc2?\xe4\xce\x11\xa6\r\x87\x9e\xdf\xf5\xe8\xd7\xd9Q\a{e\xd89\xeeR\x1b=\xff\xf0\xac\xdbA|^\xc7\xe9Pmg\x9d\xd3\xce)Rp\xd8\xe1\x1d\xc4r\xe8w\xce:H\xd5a\xd7\xf1:\x95\x96\xe1~j0|\f\xad:\xea\x1e\xfaG#j\xcb\xc9ىC=r6\"\xcex\xdd\xc1\xe9!\xb6\xc5\xf7\x87\x83c\xec\xc3\xe1\xb1w\xd8\xc5\x12\xa7\xfe\xf1\xd9!\xf6\xc8\xe3\x93\xc3A\x97\xf8;\xec\x9eP/\x1d=\xee\xf8\x87HYw\xd4=>\xf2\x9aeh\x9ce\xe2\xbd2wy\xec\x1c\xf6\x8c/\xb9w\xec@/\x1d;\xdd\x1e\xc8\xf4\xe1\xa8s\x04_N\x0fd\xe3\xf0\xa4shXG\x1d\xf8\xe2#o\xc8=\xc3:r\x1e\xc3W\xf7q\xc7\x1f\xc2\x17`\x19\x1d\x1d\x9f\xf23\xf8:\x83\xbcN\xb7\xe3w\xe1\xab\x03X\x1ew}\x8f\xc3\xd71B\x9e\x0e\xf9\x00\xbe\x8ez0\"\xba\xde\xe3\x11|a}ݓ\xc3\xe1\x89a\x1db\xb9\x93\x91\xe7t\x8f\xe0\v(;;\x1d\x1ev\a\xf0u\n\xe5\x1c\xff\xech\b_\x80\xa5{68>\x1e\x1aV\x17\xb1t\xbc\xa3\xd1\xe9\x11|\x9d@\xde\xe9\xd9!\xd4\xd0E\x9c\xc7]\xcf\x1f>\x86/\xc0y:||Jy\xd0\x06\xefxp6\xf0\xe1\vp\x0e;C\xdf\xef\x18%\x9d\x81\x17\xf7nǜ\x87У\x83\xe3\xc1\x89\x87\xbdwv|vz\x8a2tzv<::\xa4\x9e:>>E)\x81Ӵ\x01\x8d\xc5\xe3\xc1c\x8f$\xf6p\xe8\x1c\x92\xfc\r\x8e;\x87k\xfal\x18\xc7X\xdb1\x7f\xecwGz\xd9\x02\xf3\xe9`x8 \x1d\xf4\xf8\xecL\xc8xg\xd0=!\xcdstz\xf4\x98d\xcay<\x1a\x1c\x97\xe5t2\x8b\x82\b*q\x9cᐆ/\xdc\xc1\x1a\x1c\x92:8\x13Cz4\x92\xb9\x10z\xff16\xfd\xf1c\xf9k8\x1c\x8d$\x9cT\x02g\x0e\xfc\x8f\xf0\x9d\x8d$\xe6\xa33RH\x83\xc3c\x8f\xd2\x06\x872\xf7d\xe08'\x03,;\x1a\x1c\xca\\\x89o\xc0\xe1\x7f\x84\x19\xe8\xa2_\xc3ǣ\x91\xa0O\xd46\x1a\xf1\x13\xfa\xe5y\x00\t\xbf8\x87\xd6\xc9\x16Q\xee\xc9\xc9ɉ\xa4y0\"8ǁvR\x8bNNd+\xa1\x9d\xc6ն\x93\xd4;/\xf2\xe3\t,*jg\xaa\"[9\xdf\x0e&\\\xbe6(<!}Z\xfa\xa99\xae\xfaqw\xd7\x11\xcb\xec4#\x94h\n\xb8]\xc7\xc1t\x80\xfd\xcaK\xb9\xfb\xda\xcb\xc6\xf6(\x8c\xe3\xa4Ւ\xaf\xc2*\x8f\xc2\x1et\xf8\xa1\x90:QgA\x16\xed\x89\x03\xb6D\xa9\x01\x11RB\xcb|\xd4q\x9c\xfd\xce\xf1~\x89\f\xc5u@nي\xb5\"d\fa\xf5\xc6\x13\xb7ӯ߬\x90\xcbi\xb5\xe6$\x80-\xfeR\xe5]j덫\xd0w\xd0=\xde\x17\x15\xec\xef\xb3\xfd\x16\x96\x18\xc6)\xb9!<\x12Y\x8f:\x9d\x83\xc7'\x8e\xb9\xdf5\x1fu\x8e\xcbP\a\xa7\x98qZI\xefPFG.\x9aq\t\xb4\x9c\xf7\b\xb7\xda?\xfb\xb2\x03\xacE\xeff_o\xc5\n6\x16\xca\x1d\xa74\xe1\x91}v\xbc\xea+>\nuݲ\x9eu\x82>\xe19\rּ g_\x93\xa2նB\r\xc2R+ΐQ\xdet\xc8~y\x8b\x1b:nU\xe0~\x89#N\x99-\xf3щSw\xba:\x89\xa3l\x9c\xba\x97\xc6\xdf\xe1:\x96\xf1\rL\x19\xc6k/\x01\x9f\xb0i\x82\xbf\x17\x86e\xfc}\x16\xe1\x7fCH\x9f]\x83\x1b\x17\x9f\x1a\x96\xf1\x16na\x19o\xe2\x1b\xc32^\xf0\xa1Tw\xb3(\x80\xebCxo\xb1g\xf8|\xe8\xf9ܰR>\x8c\xe11ó\x93#\xc7ytxrlw\x8f\x1fu\x1c\x8b\x02\x8f\xc3\x16@ͮfn\x8d\xfbЪ\x1f\xde?\xfff\x16\x86?\xe1F\xf4A\xc7\x01\x13\xfcQ\xc7Y\xad,Qׂ{I}M뫩b/p\"\x8f*H\x1d{\x03J\xdcl#\xfe^J\xf4\xaf\xe1\xb3e^\x15\xc8o9\xffT\xc6}\xba\x05b\x82\x80\xee\x86\xcd\xde\x1c\x9d\xef-Jضk7\"R\xf0\x9c\xb0q<S8yx\xe28\x8fN\xb6\xa6\v՝JW\x15\xdb\xe7\xe3\xea\x1c\xc31\xcf,SD\xea\xc4y\xd49\xfe|\x8cUt\xdbq\xed5\x96K[:q\x84\xa5\xc0\xd6\xd9R\xf6.\b\xbee\xee\x1b\xa9Q`\xac\xa0\xfb,l\xca\xc8,4\b|\xa1\xf3\xa3v\x86\x00\xa9U_\bH\xcd\xe1dAX\xbd\a\x19\x1d왹\x02\xa4\xaa\n\tu\xeb\xc8<\xb4A7\n\xa8\x96\xf1p\xc0\x1er\xc3l\xf9zq]㩭\xccbhd\xe9\x06[\xebg\x7f\xbf\xf7\xb3\xbfo\xf6\xf0\xae\x9a@5\xe4A\xa8\xcf\xff\x16\xb5\x06}\x1f\xb8\x97|3\x03\xfdJN\n\x10#\x80\xfb\xb0Q\x917\xcdu\x85\x0e0\x97\x05\xb4\x9b\xbf\xfa\x8e\x18\xf7\x11Z\xf4Q\xbbc>©^`+`\x9d<\xcdNu\x85S`\xae\xa8\xa2r\x19\xd2\"\x95\x02\x94\\\x81\xc6z;\xe5\xd4\xef\xe2Y\x92Vɑ\x12]ɐ\xe2TS\"\f\x83Tɥ\x0e\x92@\x9a\xed\xb3*q\x15\xb5\xf5\xbf\x15S\x9d\x7fg\xee\x89L2\x93x\x10\"\x8f\x0eT\x1e\x99\x8fԯ{\x19\x1c\xf8Rk\xd8hvP\xf6\xff\xaf\x8d\x8f\xdf\xd1\xf2\xf8=͎\x7fO\x9b\xe3/\x83\xe3\xde\x06\xc7ohm\xfc\x86\xa6\xc6\xff~;\xe3\x0f32` \xfdֳae\x82)\xb2\xea'\xa5\xfa9\xacn\xc2\xc3\xda\xf4\x19\x96F\xb5\x8e\xaf\xc6X\xa9\xb3T\x1a\xe6lm¾\x87i\xf1\xfb\x18l\xbf77;\xff\x0e\xac\xfb]\xac\xb2mh\xfcs\xf9\xfc\xa7Y_\"\xee\x8d\xd9o\xca\x12:\xea\xbf^\x7f\xf5\xbe\xd00⾋7H?,h\xa7\xce\x1b\xa4\xad\x05^\x0e\xc2\xc4'n\x87w\xba\xb9&[\x1c\xc0\xe7\xbe\xf1\xdeȯ\x80\xe4`\x8f5\xa8\xc7\xfb\xc6W5@'\x1a\xd0ɾ\xf1\xba\x06\xe8P\x03:\xdc7\xfe\xab\x04t\xde\xd9\xdd]<q\n0;\x8b\xbf\t\xe6\xdcouM\x1d\xd2u\xdd\x1c\xcc ,y\xa1\xd5j\x13\xb3`\xfb\xad\xe3t\x8f\xfe\xeb\xf5\xb7\uffff\x17ל\xf9\x91\xa3\xfcS\x1aT\xca\xd97\xbe\xafr\xc0y\xfc\xf8\xb8\xd39鞞\x9ej\fS\xd3k;\xc19=<=\xea\x9cu\x8f\xb4R2q\xdf\xf8\xb6\xa6\xc8\xd1\xd9q\xa9\x16L\xa9\xed\x1a\xa7\x84\xb9{\xf4GtN\xfd=\xe90N\xecｐg\x19\xd7/I+\x19e?\x02\xdaˆ\x83g톏~(\xdd\u05fd\r֜K\xbb\x88Kf^zxo\x04?\xae\xee\ue52f\xbb;\rP9\x99\"l\xe2\xfdu\xba\x8d+N\xee\xe9\xc5\xf6,Nd\xaat\x8c)\xae\xec\x80?C<\x15nZ\xd04\u008f\t\xaeBf\xeepL\x9b\xfc\xd6GK\x90/b3\x81\xb7\\p\xae\x14\xa6릢0\xddT,\xb0a8\n@\x94S\b.\x83\nUߥa\t\xde\xd2>\xf7;Wtk\xe4\xa3\xdb\xe9\x7f<onT\xffc\x99\x8c\xbc\xcaV砹\xdc#\f|\xd6D~\x1dc\xae\xb4c<\x97\xfe\bg\x978\x03\x1f\xaa\x06\x9eJg&͗\x01b\xc7iF\xaa\xa8\xf9\x13_\xc8\xdbM*-j\xef\xef\xefK\b\xa5e(\x95-\xf3\xee.?\xa2[\xf5kaJ\x01\x01JW\x1d\x94\x8aeH\x9d~> rO\xb5\x92ܹe&<\xea\xc2\x1dMj\xf1\xfa~\xafA\xa7K\x82se\xb6\xecc\xb3A\xde+\x15\xbb]R\x11\xbfI\xdd5\x00\xfb\x9dfz\xf6\xf7Wj\xe7\x93#\x1dБ\xcf\xf7\xf8\xb5Ikѕ\xa5g\x1f\xbdy\xe5\x86 \xa6\x16\x97\x9b(\xea\x8d\bڳ,\xdcozu\xf7\xd7|/\xf3~x\xf7\x8a\\\x82ćt\x11ē(̠\xdfwwu+\x94ܣ\x10<\xa5B\x9eqYD~k\x91\x15rh\xbc\xca-A\xf1\xa3\x0e.ק\xf4\t\x91\x05y\nk/K\xfc\xec)e\xfef{\x1f\xbdyk9K\u009e\xda8\v\xfeBd\xb7\x9e\xf11\x8d#\xc3Jg\xc3!OS\x02\x12\x1f6\x06\x11\x81\x14\xd3\xe2@\x0e\xe5\xe2O%oe\xaeD\xb6R\xf10\x8e\xd28\xe4v\x18_\xb7\f\xccea\xec\xf9\xe0\xd7*H\x00oV\x95$Sc\x83@\x9dӥ\x9d\xfeY):r\b\x7fn\xa5k0\xd7,\xf8d\xab\xfe\xbf\x1f\xc4}l,\xd5#<\x98\xdd+\xc3K\xef?\xf4\at\x8b\x9fww\xdaD\x87\x92\xd6\xca\v\xab\x85J\x97\x1c\x8a\x9e\x97\xed\x12\xc4\xf4j\xa6T?\x971\xf8\xd5Wܘ\x15\x12\xfb\xf9\x9dW\xfa\x96\xb1\xac\xb0\xc8\x1a\x97\xe6\x02\xdb\x7fq\xb8R\xf5\x89/\xc0\x9d\x19\x86\x17\xa2\xcc\xf3L킑\xbc;\v\xd1=\xe3\x84у\xf0F\xc3\xf9\xb5_\xb4\x03*\xf1\xa9\x12\xbf\xa8D\xe4\x88*\x94\xcbK\x95\nr\x8fr@\xe4\xcarj\b \x8c\xb4e\xa0\xbe0,\x03KY\x88Ҹ\xea\x17 U\"\xa7\xa8\xd4\xfd\xcb镙^N\xaf\\\xf8\x05\xf7\n\xe0\xb08\xdf.BaX\x99[\xa9!z)\x82ר\"\x91Sg>\x95%\x8c\xba\x18\x7fj\xd7SSw)\x1f\xa5\xe8\xa97\x93Wu\x9b\xb8(@R]H\x04\xb6,\xdfxӵ\xe6\x01\f\xa3p\x8d(m\xa4\x88g0,\x1e\xf9\b\rG\xee\xe1\x88.\xa8\xc1\xf7\x95[\xfa\xbe\xbb[\x0e\xe29Oѩ\xbc\x94gc\x8e\xf0&\x10\x98{J\r=YK\xf9ޏ\xa2pt\xd7异\x1a\xb7e\"\x97\xe2m\xc9\x16\x97\xa9E\xb6\x86|\x94Q\x06\x8d\xe99\x15\x05\xa1\x84\xacs\xe7\xee\x0e\xfe>QAą*\u0605B\x01+\xaa\x90=a.\x95\xb4\xba\x0eɟ\xdb1\xcc~\xb5\xb8M>\xb0\"\n\xbbkDqč\x95\x02G\xbc\xac\xb4z\x10\xd3=\x9dA,h\x94с\xca\tk\xc8ɇ\x06^\xfd\xack\x9a\xe2\xbb\xebV\xf3ݦ(I~pc\x98\xfd\x86\xab\xd8\x05\x1e\xc3l\x92i\xba|\xfd|\x1c\x84~K\x12\x93\xe3\xf3|\x1f_\x02\a\x94<\xe2\t\x84n\n\xe0fq\xce\x1cn.%pQ9E\xe1h\x19t\tJ\xef\x8cR\xbf\xad\x03\xfd\r\xfb\xa3\\\r̼x\xbf\xc1\\5\n\n\n1\xfcgߘ\u038d-\x04\n\x1f\x0f0\xb6m\x81\xda\xe1@\xb9\xf8\x8dj>\x97\x8a\x1a\x80\xcf\x13\x05\xa1\x13\x14\x80 \x8ax\x02\xa1\xaf\x10\xb9ȯke\xad\x84\x94zt\x03Q\x1b\xc6m\xe9a0\xc3\xec+\x8a\xa1\x8e\x8c\x12>T,Ȣ\xc87\x97e1\xd8@ۦQ\\~\xdbk3u\x15\xc1\\\xadT\xfat\xfd\x89\x97j.2/!Y\xeb\xd7\xe4~\x1d\xf9\xb4\xbd3\t\xa2\x96\xa6U%N\xabI\x91\xf65EZ\xd4\xf5\xa4Z\x85\xb9\xac\xa6\x11I\xb5\xc4\x12A\xde\xfc>\x049WtG\xbd\x84\x90n:Tko\xd7U\\\xed/e\xb4\xd6\x15\xa0\xd1\xdbP궮j\xa4\xa7\xa1X!\x1c\"\b\x90\xa6\xe5\x9b\xe5\xbc\x06\xba2*\xea\xd4\xce\xca\xd4\xef\x9d\x11K卫\x962\x8d#\xc7E\xfc[\xbc滍\xe95\x0f\xaa\x1ez\xa5\xac:\xe3\xabd6m0\xc0.\xc59\x1a>\xeb\xf3\x1e\xc6`\x11'FO\xbb\xbb3\xa6\xa1\x17D\"\xa2\xcc\b\xb6뀆\x1f\xa2 \x87\xa7\xaf\xdcU\xb5H\x16\xbbg\xa5\xa5\x86v\xf0Oh\xbd)\x98\xb7I\xe0e<G^\xf2\x05\x98\xc9*\xe8\x00\x1e\xd0\xd3\x11_\xbf|\xed]\xcax~\xeb]\xf8\x9e^s\xb1\xb9\xefRNq\x81\xfcҹ\xea\x8b\xe3\xc2\xf2\xc40ÉMq\xbcU\x11\x1d\xcc\xf2\xbd\xf9'n\xd7\\\x02\x0e<J\xbc\xbb\x9b\xad\nۛR\n\x8a/\x8b\x9f\xc5>Ӫ\xe8\x11\xf2kH\xd7]\xed\xafm#V_\xed\xa6\xbb\xbb&\x1e\x8br\xe8G\xeb\x16'\x0f\xad\x1a\xfe\x98\xfaQ\x04\U00054da7\xde\a\xc3On\xc1HȉE\v\x94\x10ﴕ8,6\x11I\\\x86\x9f\xc8e\x16Y\x82\xb5+X鈳\xaf֓\x97Ў\x89\x0e\xba}Q\xa50\xbc1ND/\aFD=\xf8\xcf*\x8f\xfd'\n4\x86\aЭ\xb3\x8a`\x80\x99eS\xd8\xdf7\xb1υ:\x11s\xb2Y\x8aȓG\x1f\x92\x8c)\xf7u\xcb\xcc\x1bP\xa9)\x16\x97\x91\x15\xa5\x1eS\xc8T\xb3\xd1\\W6\xf1\xeeg\xa4(\x1a\xaf\xb6FR\xc2\r&\r='\xd6h\xf2 B]\xbd\x98Bkd\xe1&\x83\x05a\xca\x15bb\x9eY\xd8O1\x8e.;w/\xc8\xfd\x87d;\xf0P\xd1\xec\xd7\xd9\n\x88k\xa3E\x91\x9b]\b\x97\x8b\tJ\x9f\xcc[m9E\xc8\xfd\x95\xfbL\x11?6\xcc\x0f?\xae\x9f\x1c\xe0k\xc0\x93l\xfc\x0e\x96\x9cvg\xab X\xf5\x93I\x9c\x04<\x12+N\xccS\x12\xee\xee\x8c,\x9e\x8a9c\x1a\xccy\x98~\xcf\x13\x1c\xc0\b\xaa%\xddݝ\x1e\xe7g\x18(\x1e\xa6\xbcН\x05C\x80H\x95\x99I\x83\xc4\xc1-\xc0\x8bo\xb7\x94_hV\xbcA\x9a\xe7\xc2\xd7\xdd\xdd\xd1\xfd\xa7BIB\xbe(\xd8\x10m\xed\x83\f\"\x92_O\x96\xf9j@(= \x97VAc@\xae\xba\x8b\x99\x9b.s\xe2\x182\xacRX0\x96?\xe1YZ\x99\xba\x92\xb8K\xe7\n\xf4\xbb\x9a'ԅ|\x9b\xce5\xe4\xe3t\xc6\xe7\x041S\xe29\x02/\x94\xa1s\x13\xa4\xab\xfb\x8e\xa4{\xdd\x1a~\xa0\xb6JjϚ\xbe\xd3\x1a_h\xffR7~F\xe7<ju\xf6\xf3\x81)\x02\a\xe1\xb7z\xe9G\x01\xcfa\x0f\xbaf]\x97\xa0\x06ow\b\x8e\xec֦\x89.?\xdfA\xde\"\xfa\a\xaev\x83\x1b\xcdoS\xefQo\x96\xc5\x14ʒ\x88\xf5\xe6B|o\xaem\xf8\xdd2E\x90\x13\xd5^1\x85\x92h\x955\x88ه28\xe0\xbe!W\xaa|\x00ҷrz0\xcf\x0f'\xe6+3?\x9dRԁ7\u05fe+\xf9ʀw˚\xe6\xee\xae\xcco\x85-\aU}v\xbf\x9e\xba\xbb\x137\xba\x12/Jav\xca\xc9W\xb5\xa9\x8bʓT\xf7Bx\xc0*2tw\xa7\xd0EI\xfd\x1c\xa1k\xe0\xcf\x10F\x87\xb1/z\xdf2\xf6\x05\x9e}\xc3\x14\xc7\xe9\x1bJ0\xc74V\x928]\xcf\xdd\x04\xa9PR\xcf°e<2La\xfc\xb4\xccU\x0e\xa0*\xack\xa3\xa4z.\x8d\xe2\x95X1\btU{UDd\x95Esz\r+\xffi\x8a\x87=d\x7f+]\x9d\x9a\xf8\xe7b6\xf0\x83\x9b\xc0\a\x7f\xa1\\練\xf9@\f\xb6\xeb$\xf0\xe1ӭ\xeb\x0fz\xa1\xdcx\xda\xe9\x81CR\r\xfb5U\xb5\xbe\xf1F\xfe\x94\xaf\xf1\xb9\xf4KZMS\x8b\xad!\"\fT\xc8[\xd5h2=\x9cu>\x13-k\"(h\xf1O7\xc7Ph\f\x9f\xf09\x01\x10\x8a\xa3!\xd07\xda\x16\xdd\xfd\xa31\xac\xd4p\n\xad\xe6x\n\xe5ao\xea\xcay\xebX\vG\xce\xe7\xc5HP̹\x9f\x1a̼\x9f>\xf3<\xf8\xb3L9|Q\xf4\x7f\xad1\x97\x17\xa0\x89\xc6m\x9ex\nߍ\x85\xe8X͔\xbe\xafU\xf89\xf6^\x83\xedFϲ\xff\x9b\x1anM\xdbS[\x99q\x96\xa0\xa4\xf7Y\x16ܯ\xd7fM\x06\xdfo\x11\x1c\xa6Y\xb7}V\xac\x98\xed\x82Ŕ\x95\xdb#]\x90\xb7Vn\r\x93\xe0\xe7\x05\x93\x01\x05\xabӡ\x99\xc0*d\x89\xdcz\xfb\t\xac_\xc3\\\xd6H|\x16OKf\xf1\xca\"q\xeb5\x9a\xc4Ts\xc9&&>\xac1\x8a?\xc3Ȥ\x8a\x1a\xadL4\xb1\xc5T\x9ex\xb70\x11\xa8\xa5\x17f\xbf\xc8\xfc6\t|4,\xb64\x11\xac\x1ce\xc1\a%\xfea\xa3uO0ۛ\xf5%m\xfby\xf6\xfb\xdaN\xdfJl4#\\\xb7\x80\v\x81\x0538\xb7\x88\x7fWsX<\xb0\xff\xef`\v\x8b\x19\x0e\xf0\xac\xac\\\x94\x14\xf5\v2\xb5\xdch1\x93\xcdP5\x98\x91\xb1\xf7\xb3\x97\x17h/\xff\x06\xc6\xf2\xb6\xde/h`\xd9\x17 \xd9~\x93\xfd%\xb2\x1b̰\xda\"V\xadqF\xafrU\xaco\xe1#셼\xfc\x02A\xf1\x14\x0e9\x97\x19D\t\x13\xcf\xf2\xa6\fK\x19\xa6tK\x04\x14\x05\xb6~\xa9\n\xe0N\xb5\x86@d\xa0*+&wLˋ\xad\x88ta\xd2\xd6)\x10\xd16U\x8f\xf8\x1fg)L\xaeHVA\xa1=\x8c\xa7\x8b\x96\f\xe1KI\xf4\xbb\x10IY\x9d\x8a¬\x95Q\xc9S\x14\xd5B\x97\a\xbe\xb9\x94H k\xb5\xa5@|\xc5\xc7\xdeM\x10'2\xfe\xe4w\xc1\xf58\xa4\x89\xb7\xbf-\xe8=6V\xe9uyJ\xa6\xdf\xfd\xea&.:\x89]x#\xee\x8a\xd7%ɏ\xe2U\x10q\x8c\x10\x8ei2r)\xb9\x00#B-I\xb1m\xc9?\fS\xd5\xcbm\x8a#\xed\xbb\xeb\x81\ne\xf9\x87vr=hAd4\xf8\x9fa\x9a-\xfb\xccT\xdf\x0f\xca\xfd\xadr.\xa0\xeb\x8ar\xb0\x15\x9a\xcb\xd0nvm\x99ĳ\x94ÊUwo\x01C;o\xad4\xf5\xf0\xfe\x80\u0084\x90v扁x\x9a[s\xb4\x02\xc9J\xdc\xdf\xd0u]H\xc2\x0fL~\xe2\xec\xee*'\x01\xe5\xc0\xb9\xbb\xbb-:'\xa6ޖ\xd9O\xeb\x12e\x19\xb4\x0f\xb4@\xfc\xe4\u05ecT#J\x8a\xb3A$\xa4\xdd\xe9\xef \xd68\t\xae\x83\xc8\ve\xa1\x1c\x81\xea\\Y\xc5%\xfcE\x15x\xab\x03\xc7\xff\xfd*(\x9ea\xd0oyX\xb6\xca\xc5\xedRm\x1a\xc5\xef\\\x93ww\xa7&!`\xbf\x92\xe2j2٪\xe4\xc3a\x9aBe~\xa0.܅\xfa\x9b%h\x96U\x04\xe8\xc1f\t\xc2a\xb4\xa5\x10i⳻\v\xff-?Yah=gh\x12P\xd3a\xd3x\xda2\xfb\x8d\x1dY\x95\x05\xcb\x11.\xc0f\xdf\xe7!\xcf8\xab\u0080\t\xb3\xa6\xbb\xcce\xb5sր\xaf\xd6w\xcc*\xb7ӈ\x7f\xe6R\xf9hd\xa6X\x02V\xb5F+\xc4Xٟ\xa3\xb0\xdf&\xbe~\x03n\r\xd8o\xa9\xa8\xcb\xcbO\xfbousy)\xaa\xea\xc7\xff;\xe3ɂyY^\xc8XU\x11ٳ`{\\?\xbcT\xd1ٳ\xc0X\xfdM]t\xff\xad\xa5\x8by\x9a\x99v\x1a'\x19\x8cJrb\xf5\x82\bFXϠ%\xb0aeq\xc8\x13/\x1a\xf2\x9e\x81a\x9cAG\x93\x00\x14\xd30\x87~\xb3f\x81&\xe9\x97W\xfd\xba\xea\x80ܖ\x11\x06\x86isM \xe8\xc51z\x17\x11\xc6-\xfc\xd2\xfdĵ\x00^j\xf6\xcaT\xbc\x0f\x1a\xd5k\xa7\x1f<\x01߄v\xdb\\V\x80\xe0U\xc6R\xfc\xad:\x89_\x99\xb5\x8d\x12\x8a\xed\x02W\a\xc8ꕹi\x03\x04\xa8\x1d7nG\xa8u\xe4\x9bEb\xfd]\x93'\x16\xbd\x04\xe0\x8e\xb7<`.\x8f\x8b\xf7誹y\xfc\xbc/=\xf5\xf6\xeb\a\x904 \x9eEñz\x89\x89T\xaexf\x18r\xd6<Y\xda'\x10\xc5;\xc0\xd8\xfd\xb2\xe38\xceQߐye\aC\x11Y\x99\xe6\xaa\xc2=3\xe5I\xf6\x15\x1f\xc5\toQAK\x03\x18\x05I\x9a\xa1{@^i\x1c\xa1{\xae[\x9a}TU\x9a\x87\x97ה/\x8fD\xfc}\xad\x86\xaa\xf7\x9a,m\x14\xf1\x9b\x15\x01\xbb\xd7\xc3S\xf2\xc1u\xb7#\aV\r\x99\xcd\x14!\xe7\x14rVʵ\x1a\x11\xdep\xc0CW+}\xcd3\xd1U\xe9W\x8b\xf7\xde5>\"h\xa4S/2\xd08\xc1\"\xb5LT\xcc\xdbga\xf86\x1b\xf3\x04&\xedԭc-]Ԩ\x816\x97\xba{Ru\xb6/?\xd6\x1c\xba\x15\x98\xcb\x00\x1f\x82P*\x06;\xc0\x96\xe1\xfb\xf2\x9b\xada\xb5ǩ\xcfjہ\xe1\xe5\a\t\xf7>\xad\xd0Y\xb5\x9e\xfc_'2[Y7\xe6rS\xe3\x96a\x8d\x90l#!Bf\xb7\xa3\"\xac\xb6s\xbbq\x01뼊)R̨\x7f{\xa0\xbf\x0f\xf8\xb7\x96n\xa6\xa8\x93\xa1\xb9\\\x97\xdbZ\xa6\x99\x97du\x13\xe0,\xc0\xc7KiH\x18Q,eڰt`s\x89\x7f\xeci\x82\x7f_P\x84y\xb1\x13\x9e\xc5\xd3:\xdc)\xc5\x11\x88g\x99:\x8f\xc8\x1agQ\xa5Nseu\x8f\x1d\xe4\xff\xfd,2R\xc3d\x88Ѯ\xa2\xe7\xfbr\x16\xa8\xf8\xce\xe9*\xa8\xe6\xfeV./%o\x84\xa6\xa9\x1a4\x930s\xe2(\\\xb08\xe2\xf2\xd5\t\xdc\xfb[\x15C\x8b\x86O\xddTݗ\x02\xa4V\xaa\x14\xa47\x16\xea'\xf9U\xbf\xd2j\xfd\xe9\x1f\x91:\xab2C/\xb3\x9d\x19\xfb\x1d,}_\xf0\xcc\v\xc2\xea\xe4\xabd\xde\xff,p\xab\xebZ\xf3o\xa4\xfb\x1ce\x15ߵ\xce\x18\xb9\x8b\xdd\x1c\x9d\xeb\xf4\bz\x82s\x8b\x12\xcaE\x1dJ\xe5\x98ͥ\xe7Ξ.zZ\xac\x00ͯQ;\xf2\xba\xc7M\f\f\xfcc\xf8\xc4\xde\xfc\xf0\"\x00\xc9@\xe1\xd9\xc2\xf7\x8fF\x8f\x97\xd2\n\x85\x16\x8cyo\xcbEhZ\\\x9c\xbc\x18Ƿ\xf2N,\xfc\x96\xe9\xdf\x05~~\xad\x16~\xcbtz\xa9A\xe6\xbcSބ\x1a\xe9|\x1c\x15lԳWu!\x96\xc4{Gsk\x91\xe7\xfa?\x16?\x7f\xb2\xd4(S\xf9ro\xdf\xe8\xedF\x83t\xda7\xf6\v\xd0U\xd5\xe87\x97\xdc\xe5ww:o\xe8\x0e\x8d\xe6XU\xf0\x8d.Rr;\xf3\x92k\x9e\xd9Q\xecs\xe8\x1c\x19\x10\xe9\xbf[S/\x1bߥ7\xd7w\t\x1ffw\xc3 \x19\x86\xdc\xfcہvW\xbd,Θ\x88J\xf2G\x97\x8bW\x89~\xbc\xbb\xe3v\xe8-x\xf2c\x91\xfdS\x9e\xfdS\x9e\xfd\x13f\x7ft\x1d\xe5\x85\x1d\xe9\xc2\x1bq/\xe1i\xf6=$\xf6\xab\x8an\xd33S姻Da\xe5\x19\xa1\x8f\xfb\xfbW\xf9\xd5R\xa1\xfaԖ\x92\xab\xf5\x8f\xaet\xfb\r\xa2\x1b\x9ed4!\xfch* \xb8䧝\xd65Ϭ\xc9g\xf8\xf0\x1a\xb5M\x0f|\xb5qoȞ\xab\x8f\xaa\xe9O\xf8Q=\xe8S>\x0f&^Ʊ2\x11\xd39\x9eE~\xabL\x83H\xf8Ѥ+0咮\x86\xdf,\xe7\xb7\xdb}\xc97\xa5\xa6I\x10U0\xddݕhUW\x81e\xe0~p\xae\xc1\xf6i\x91\x89L\x81\a\xc5\xc5/\b\x00\x80\xb6\x18\x90.2\xed\xf9\xb9\xf0z\xffqw7\a\xb3\xe7Od;\x97%b!\x14\x8c\xc8k\xe78\xcc\xf3\x86<\xc4e>\rz\xc1~G\u0601y\xdd\xfb\x1d\xb5vs\x19\xec\xef\v\xeb\xbf\xdd^I0\xac\xf9\xdc1\v*D\x84m\xf4\xb7GDy֕\xdc.Ί\xc8\xdc鿒\x8c.>L\xe3\xdbVN\xa5t\bG4\xf6\xdcl\v\xb9\xb3\xba\xe6~\x13\xf4B@/\xf6\xc5_G\x14\xfb\t\x8a\x91()\xb3\x8d\x18I\xea|S\x9a\x90\xb0ĢRbQ.\xb1\xd0K\xe0@v\x97\x85\xd2\xc3\x03\xc5^\x817o\x95\xa2\r\t\xa6\xc0\xa4\xbd\x0e\xf7T\xfd\x90#Q4\xd2\xec\xc9\x1f\xf2n>\xfd\xb10\x992-\xc9\xf3\x9e\xfca\xc5\t\x1cF\x7f\xb40f\x9e\xa2\x7f\xc9\xcbA\xd5>ww\xb2й\x9al\xcbTs\xa9&\xd3\xdb]+\xfc\xaf\xd8,\xc1\xdf\xf9#~e\xf4R\xe7h\xb8\xc5C\xc78C\xaa\xcaH\x03\x12\\D\x80\x12\xb3u\xc0R\xa6\ue8a2,؍F\x17W)\x8er,\xd0\xfd\x04u6\x17\xaf\xdc\b\x9f\x12\xf1\x0eZ\x8f\xfeX4\xfd\xcb/\xdc-\xfe\xb1G\x12M_?\xd1\xd7OVYhJ\xdf\xe2Y\xca\x1f{\xe2/\x18\xec\xe3\xc0署!(m\f\xb23\xd7=\xdb\x1cD\xf9\x15\xdcb\xb5\xa2Z\f\xd5G\xa0(\x03C8\xa6\xe3\xf8\xb6\xb1r쾆\xba\xe5\nj]\xf5h\xc8\xd4U\x0f\x19X}٧\xa2\xd9\xeeT\xa7X\xf2'\xc3\xdfE2\tnu\x17cZ\x04R\x15bY\x04o\xcc߱\x13\xb2(-Iu\x1e-K\xe6\xb4V$5ȟj!\x7f\xfa\xb5«R:\x17\"\x8c:\xf1\x15n\x99\xac7j\tH\xb5i\xe7\x1fp\xdfĐY\x05-kǛj\xe2RI\xd2̰\x8c\xdc@\x03\x80\xa8\x14\xc0\xb7\xa1\x1eHQ\v\x95W\v\xbda6\xf3\u009f܍\xcaT\xebE\xb3\xa7}R\xbdE\xf3t\xdbWڹ\x1aw-Qqy<\x97\x15>\x95\x12-+\\\x87Tw\x1b\x9d4g_\xa7TQDu\f\x06\xbc\xd2V۴l\xf1c}\xc9\x12g\x06\xa6\x15d\xe9T*\x99\x03\x9cM\xe8\xfcW;\x85k$̏3e\xf4и2\x97u\x1d,\xceʌ\x1a\xfad\x96\xf0A@\xa5P\xd5\"byS\xa7G(K\xbe\x00gi\xeb\xa9^\xfdFÆC@Pj\xfa)`\x83F\x14\xeb}n\xaa\x1b\x98\xf2\xacq\xc3潊@+o\xf6\xb7\xa7\xb4\uee12\xdb\xe8\xa0\xc9\xfd\xf7\xb8F\x82\xc7\xdeKI\xf60\x9eL\xbd\x84\xbf\x10\xa2\xf4\xbdp\xedlU+6w\xf1\xc6⋷\xcf\x7fx\xfd\xf5\x9b\xf7\x1f\xbe\x7f{\xf1\xf2\xfd˷o><\x7f\xfb\xe6\xfd\xb3\x97o.Lђ\xb1\x98L\xaa\x8c\xd8\xd2s\x02^E\xff\xbe\xbaw\x81\xc9[\xba\xce|\xf4\xe6\xd6\xf2\xf3\xa3AM\x7fm8\xa8\xed\x1a\xfa\n\xb7Ъ-\xa5\xf4\xba;y\xb5\xdb\x17\x9a\x93\xf0\xa6M\x1a\xfd\xa8\xa4\xc1\x88\xc8\x1d\x85CI!\x14\v\x83TԌ\xbf\x9a\xd4\xcf,4\xea\xefHB)S\xd5\xef\xda\x12\xb8\xe9%\xf5\x95\xe2\xf2\x1ay\xd9,\xf1B<r\x95Oܸ\xb9ǄxAKlO\xe2~\xb8\xbc\xb2\x8e\x9a Rv\xef\xd4e\xf5\xda\xc0\x17p\xb8\xd8/\x82\x00\x90\xb2\x82o%4S\xf94&\x87\xdcw\x8dܝ\xc6 \x97\xe6[/\x1b\x8e7\xa8n\x02R+\xa4\x14C\xe6\b5\xed\r?]㲹FUW\x82\xc2PQ\xf5P\xa5\x89\b:I\x11\xc7(j\xab\xc9>\bK恲ڨ\xd6\x1a\x92E\x10\xa2h\xa9\xe9\x18\xeeC= (\xa2zI\x84\xf1+\xb1\xed\xaeDU(E\xfd\x81\xc6`\xac\x11W\x86X\xe9\xc1\x97\xbetZ\xf5\xe5\xf6p:\xe6\xe1M\x10]\x9bK\xedS٥\xfe@\x84\xe9٥mږ\xb9\x92\b\xc7\xd2ۀ\xe4QO\xa9sG \xfct\x1c\x1c\xe2\xd69\xae\xa9D\xf2\xba\xb8er;\x1d丅\xc7\xd4\xcd3ʖG\xb6\x14\\#\fj\xfd\x1c\x94\xcc\xcf\xdb*\xfe\xf5\xba\nS\a3\x90\x97u\xadm\x9e?W\x16\x96\xee\x95N\xca\xeb(k\xde\x0e\x14\x11\x14\xa8~\x98'^\xc8\xf0\t%w\x88\xfch=E\xae\xb5\x96\xb8\v\x86\x9e[\xd6$\x88zy\xb4\x03k\xe2\xcd{y\xb8\x04Z٧\xbd\xcb\"?ϻ\xb2\x10W\xdd\x01\x0e\x04\xc8\x0e\xc8pL/;W\xe7n\xf1\xe5\xe4W\xf9\xa5\xfbl\xfe\xa2\xb4\x06U\xce\xf6殊\xb2\xaf\x1fel`\x06l \xc9\x06\xb8:1\xcb\x1a2\xe4\xd9٪(\xd7\xd1\xcau\xaa弹R\x8e\x02\xd5\t\x9eþ\xa3\x1a\tFq\x1b&\x7f\xfdʎ\xf3\xe7H\x02Q\xe6V{ڈ\xa7h\x89Z\x06\x81\x18\xe6F\xc9iF1\t\"\xc3\xcaY\xb9\x01֛\x1b\x85\xb4`'TyMk\xd8e\xde\x1fE؍U\x15ޛ\x97\xe0;WE\xe4\x93\xd5\x16M\x17⼭\r$ߕ\xaeQ@\"\xe77\xbd\xb4\x96\xf1(\xcd/\xac\x89\x0f\xb1\xed'\xbeT\x9b[8_ʧ\xa9K\x89u\xc10\xed\x1fd\xae|L\xbb()\xddx\xcaoU\x8b\xd9\xea{/\x1b\x7f\xe3\r\xb38Y\xa8b*\xb3/\xb2$\xfe\xc4k\x01\xe4\x93\xe2j*)\x80\xa5hT\xcf>\xb3RD\x80\x17zz]K\xf5+\xb5\xa6\x9e\xef\a\xd1uo\t\x87Ŷӱ\xd0\x11\xbf\xe7Xt\x85\x15\x93`\xef\xa1\xe7\xac\x04\x9a\x1eZ\xf4\xd6(\bC\xfa\xb9Z\t9\xecռ\x1e\xa9\x1cT\xe0\x1e\xb2v\x11Hɬ\xa6C\xaaP=\xe8(C\x9b\x92\xea\xc1\a]S\vF-%_{\xc0[pb\u07bb\x04\xb9\xc6;XW\xd6B\xfdZ\xa1\x1d\x81c\xa5@\"v\"qDTR\x17%\xd8ž\xfa\xe1\b\x18o\xbe\x0eFi\xc4\xc6g\x1c\xeb\"\x8c\x82\xe2\x84-\xaa\xf2\x0e\x15\xc4\x1b]\xec\xfbPE0j-\u0381V\x13\t^`\xc2\x13 \xccD\xea\x16d\\\v\xaf58\xbb9\a.\x98\xf0\x1fWIU\x80Jnppt\x01,2\xe1?n#\xcc\xca\xec\x03ζ\xdb\x02\xb86VB\x17<\x84\xe8\xe1\xd6V\x1f2\xf7\x9baP*\xfb\xd8\x14EN&\xa0\xe2\\Ûe\xb1\xf1\x142{z&\\\x9f\xc7֪\xc9\xde\\\x15\x92\xa7\x90\xdf\xd3\xf3\xfb\xbaS\x94Z\xcb\xdd\x1dTs\xee\x98\xcb\x055\n\x8a\xb7\x17U\x82i\xf8\xacJ\x98\xf4\xba\x01\t\xb6\xba\tI\x16OW\x85\f\x03[,`\x10\xca0\x80[P\xf0\xaaq\xf7T\xbf\xf3\xd88\xb7U\x03\xec\xdeݩ\xeb3\x9a\x00\x03\x01q\x13\xe4\xd97p\xb7\xa0\xf96S~\x00\xe7\xdeϭ\xaci9HjA\x84\x8f\x88b\x9f\xa7n\xa9v8\xf75L\x9coI\x05\xd9<\x82\x1a\xf5\xfb\xb1\x02\x8a.\r\xf9\xe2\x0eUE\x11\xb7\xc49\x138z5\xda\xe6b\xa8\xd6,\aK\xae\xa6^6v\x91dPZ\x01\x1c؊\xb0\xcc`\xb9\x90;\xa4\xc4&\xa3\xc5Yjf\xafv\xf9\n:X\x06\xf0\nç\xea*\xb0G\xcb$\xea`\xd4\xdb\x04H\xbfkA\x15Z\xed\x94gϲ,\t\x06\xb3\x8c\xb7\f\xc0n\xa0\xc67\xd7@\x11jCL\x13\x9b!\xdb\xea\x8dOe\x8aZWR\xdc\xf1\x92\xe4\xcb\x15\xaa\xb9\xb2\xf2\xa9u\xfd(\xd0C\xe5b\xd5r\x0em\x995\x87\xf0\x18\x81?߅(\xb9\xe8C&\xd9\x1c\x18\x91\xdf\xcd\x7f\xa9\xb3\x99\x9aPT\x85\xa9JDYu\xa7\xb3\x04\xe5\xbaF<\xf8ȇpYq-\xf9\b]\xd7\x06\x85\xc6\xcbOW\xc8\f\xf9\xf1@ՅjFO-\xa2A\xa9\x19\xbd:\x12.?]I!\xce\xef\x82QK\xf2\n\xea\xab-\uab2f\xb0W\xe2j\x05Jɫ\xa5l\x95\x0f.\xb8\x82\xabXE\xc5\xf0*\x12i\\\x17\xdf\x0f4\xd5]\x96ZW\xf9\xbdB\xfc\xef\x85\x01\x96\xe3\x16\x16\x19\xe2\x15\xbfkp\x8a\x1cW\xfc]\xddӚ\xb6_Q\xfc\xd5u\xd9\xdbm\xa5\xca\"\xf296\xdc\xfa\xaa\xb18\xe9\x8a\\\xae\xa7s,|\x9e\x81Υ\xec\x96i-5ó\xb0\x1f\xa5]I\xf7\xa07\xd8č\xb3؈`\xe5\xa5c \xb6e\xda\xf3V\xcd\x1b\x05\xf9\x19\xb0=7W\xa6\xbdh\x86Y\x80\x9d\x050ʽ6uB/\x92\xa1_e\u05f5\xd4~4\xfb\x822[t\xf3\xeen)\xa1U\xfb\xd0\xdb\xe2\x01\xd9wEXHQ\xec\xbe\xf2p\x01<_#\x10\x98\xffy\x12\x81\xdd\xf9\xabD\x02\xa5\x00\xe5A[\\HI\xc1\xaf\xdfL&\xbc\x84{\xdbʄ\xb3A(\x1c\x84\xeal\x80ڗ\x90\xff\xc1\xf2\xf3\x95\xb7f\x85\x0e\xb9\x9f';\x03/Y'9h;\x8a\\\xb7Y~\xae\xbd)F\xfb\xb0\x9d\xe3\xb2\xd0\xc8[m\x12K\xbeʗ,\x12\xe9+k\xe35\xeb\x9ag\x9cD\xc5b\x97\x81>\xe4\xacO_}\xfd\xbasy\x89\xac5\x14\xf3\\\xd9,\x92]<Ê2\xf9\\\xbf\b\xe4PNn\xd5/\xa7\v\xbf<\xe9\xe1`\xf6\xa9\x16{~ٹ\xdaw\xe9u\xb2V\x19\x9d=\xf1\xae\xa3 \x9b\xf9<\x17\x1b*\xb6\xb2\x06^R\x9e+UStKr\xe5\x91=\x90*Bv\b\xbcZ\xa0Z\r\x0e\x17\xa2\xfbͤ>ju\xda*\xe7\x8b+\xe0\x12\xf7\xbf\xf3\nIo\xbf\xfcj\x95\xdcCq?P\x80\xff(b\xbc9\xcaE\xee\vq\xd3z\x16e\xee\xe7\xdc\xe2Q\xda\xf9\x95F\x90\x18UO%a\a\x95\xfaz2\xab\x14%\xa3\xfc&\xca\xc4˒`\xee^v,\xc7r,\xdf^\x9c;O\u06dd^'\xff\x10\x8a\xb3\xe8\xd8\xc2/\x10\xa6a\xf3Q\xb7\xe7H\x9b\xd9 l-c\x9f~\x88\xa0\x17\x96ab\xe8\x8d_\xb1d\xdb\xd8'[.@U\t\xae\xf4E\xbd\xa6\xae_\xaf&h\xfa\x8b\xf5\xeaܰj\x90\xa8\xb3\xd7~!!+YjaX\xeb'*g\xbf\xc4\xeaV\xd1?\xe6\xaa\x14\x85R\x17\x93r\x98\x9e\xe6\x8a\x1a;v\xb56PI\x9f\x1e\x1f\x9b&q\x16\xc32Iv*\xc5\xf7\x90\xab\xea\xa2\xde\xc8\\F\xb5\v\xd8\xf2%q\xb99#D\xdc,\x18\xb7\xef\xeam,\xd6\xe4e-T\xb7\xe7\x19\x88<\x1c\x1d\xf8ҟ~kL\xf7 ί\x8a\xc9b\xae\xe2\xb4[8\xfe\xf6u\xac\x97\xf2\xf3\xcamʀݯ\xa6\xbc\xfd\xfdU\xad\xd6^b\xc0\xed\x9ec\xe5]\xd5\xeb\x94\x17\xcd:Κ\xf5&\x1dQ\x95qۈ\xfa\xbcLѕ\xb9l\xa2\xa2\x02\xaaP\x15\xac\x94\x80\xe9e\x04\xf75q\x9e%\xdc[c\xe3@\xf6\xe7\x199\x1e \xfe-\x96L\xe5=w\xcd\\\xfe\xdff \xff\x89\xb6\xf1\xa6\x13\x97?a\xf9\xf9oǢj\xf8\xb3Z\xae\x14\xe1\x9a\xd6Y?\x13\x9e\x8dc_75\f\x9a\xfd\x8c\x9eAW\xa8\re\u00adŪN\xbb\xf5\xf6p>\xb3^R\x85W2x\x94e\\\x1bf\x1fq\x7f\xde&q)\xf8\x94G\xaaDN-b/ty\xaf\n4\xe9\xabVA\x0f\xec\xac\xe4|\xb2\xe1\xda䟸E]\xbc\xa9\x87\xd8e\x85E\fK5S\xa6\x196\xb1P4\xbaf\xe6\xae0w\x13Bb\x98\x86\x90\xb6\xbceZ\xbes-M6\xf8\xbc\xbbk\x8agDtX\xc6 ĝ\x95\x96\xdd\xe9\x1e\x9b:\xaeƽ\xedU\xd1\x11\xc5\xee\xf5\xf236\xbc\xef\xbd\r4D'\xe7\xef\xc38[\xb7\x19T@}\xe6\x96\x10!\x98B5\xbf\xed^au\x97\xa8\xe1\xec\x1a~\x95O\xafm\xa7\x03Kp\n\rz\xb42\xb7X\xf4\xeb\xcb\xf7?q\xfdH>߸ۀx\xc5\xc7څ\xe5\xaf[\xfe\xfcqk\x1b\xba\x9b\x99\x0f\xc4\xe1\xe6\xe5M\xbeV\x18n\\\xd4\x14\xb0I\x1d\xa8\x91\x18A\xc4\xfc\xa7\xbe\x9d\xf4\x04OW\xbf\xdbbC\xaa\xcc{\x8e\xda׳0\v\u058cW\xcc\xff\xbc\x91:A\xd4\xf7\x1d\a\xbf\xff\xf6\x7f\xd3F\xd9\x1a\xff\x10*\x92߸\x05\xcfة\x8c|J\x1f\xf2N\xf5\a,ڢD\xb3O\x7f\xab\xc3\x04ӕ\x9b\xb7\xf8m\xff\xc6\xc7\xd8\x1b\xaf\xec\xca\rAz\xd8W4\x92\xbcF\xe9\xc3\x14\x87\xe1\xe8\xb4\xe1\x1f\xe2\xf5V\t\xa6U\xaf\x0e\xc99x\x7f\xc9K\x94\xe0\x1c\x01\x05\xbd\xf96\x05;y\xc1\xc5}j\\(5.\xeeS\xe3BԸ\x95\x0f\x84\xe8魬u\xd2\xe6ߒ\x9c,W\xbfچ\x82\xaeTq^\x96b\xf9]\x11-Q\xea\x1a\xe3,\x9b\xf6\x0e\x0enoo\xed\xdbC;N\xae\x0f\xba\x8e\xe3\x1c@\xd0\xee|\x1a\xa8w\xcf~sъR\xb2W\xf3ف|\xa04\xff\xea\x1b\x19?GV.l\xe6\x0f\xf2\xbbJ]\x7f-\xed\xeeR\xfe\xec\xc9\x1f\xd2\xcb\xfa\xf2ʺ\t\xd2^a\x80ݠ\x8e[\x8b\xae6l\xa0U\x84\xfd\x11\xa3\xf7\xf2\xaa\xff\x16O\xbdi\xd3AE\xd9tL\x9f\x97v5\x02\xf0\xa4]\x8ct\xac\x14\x7f+o\x1dS\x1eZ\xb3\xb8\xd6ִ@j.\xe9\xefZ-\x91_kX\xa7'\x84\x18Ie\xa1\x8bҪ\xea/\xa3k\x10\xad4Sg`\xa1K\xa8\xf2r\xf8H\xa2/\xf4\x16\xf1,\x83\x81G\xbf\xa8\xa0P\x9f\xaa\x8f^\xae\xbe\x87a\x1c\xf1\x16A\x93\x06\xdadU\xc8\xc0\x98\x02\x8a\xb8YF\xa8\xfa\xef!\xfc\x15^\x9a\x11\xfd_\xea\x90\xeaJs\x9b@1\xd5Շ\xe4IM0\\(\xcbJ\x0f}Kp6\x8a\x85\xfe`{8[\xee\xe5Y\x18\x8d\xa8\x1c\x96x\xdd:\xb7~N\xd2R\xef=5\xfd&BW\x92\x1ay\xed\xb9t\x03=H{\x04\b\x01\xfa͵\x97\x15H\xb4\\\xd9 xa=\xff\x01r\xb4\xda\xfe\x96\x94洰a\xe5\"A>߷\xe1wX\xb3T\f\x9c\xcf^\xb3\x1c\xeaN\xbc\x9f\xb1\x82\xf9˭bþ\x96\x98 _\xc4Y\xba\xe5\xe6\xd6o\xb1ʪU\x1f\xf3\xff\xb5\v\xadR\\\xe4_\xb3\xee\xc2\xf8\x13\x92\xa1\xe5E\x18p\xaf\x8d\x8b\xaf\xf2\xeeQ\xfdr\u0378\x1d\a\x197\xaa٥\xbd\xa1\x06$\x9bv}\xf4\xabIr\xf7\x8c\u0600\xa1\xf9\xb6\x1a\x8c\xbf\xcd\x16\xe8\xaft\x86\xfd3\xf7\x1b\x7f\xb3\xadg\xf5\x8d\v\xe4\x7f)\r\x94@k\xdbi\xeab\x12\xc7ٸ\xee\"\x8b\xcc\xf9M/\xb24\xbf\x10\xe4]_'\xfc\x1a\xb5&\x85q\xea\xe8\x17\xe9\xea\x96\xd0\xf68\x8e?\xd1\xcc,\xde\xc2\x15\x1by\xb2Q\x14`F^\x0f\xef\x1d;ֈ|+\xf3\x13Z^\xba\x02]\x7f\xf5N\x0fs\xac\xbd\x8bQ\x17\\\xb8r\xa5\x0e\xee\xd1u\xf0\xfe\\\xc7q\x9a/\xc7aa\xf0\xee\x04\x06\xe4\xf7\xe4̦\b}&\xf9j\"t\xe5%\x93`D\xbf\xce\xf38\x82\xf8\xc9\xe2Y\xc6\xe2\x11\xbd\x11\xddc\xc6>\xa6\xae\xea\xbb\x00\xf3\xb4\x9bE\xb2vKa`\xf9\x18Y\xb2\xa8\x82.\x0f\xb6\x8a+\x05r\x00\x110\u0082\xbf\xbc\xea\xfbk.\x96\xbcȏ\xa9\x8br\x17y\x0e\x94\xbe\x1d\a!W\xa0\xe5\xfe\x04\x15\xba\xb9\xfe\xd1u,\xef\xe6\xfa'\xe1\x7f\x82\x9eF\xae\x02.\"\x8e;Vm\v\xcc>\x16\xa8\xbd\xd7\x02\xc8\xf7]ߞ\x1f\x10\fU܇\xca y\xa1%\xaf\xcc~]\v\x84\x10\xcf{\x80\xccZ\xc0\x9f\x9fV\xe6Jg\x12\x01\xd5\x15/Ea\x10\xbc\xd6Ko\xa9\x16\xf2\xfb`U\xbd\x90gm\x17\xfc\xb8\xf1E\xac\xf2(\xf6F\x19O\xb4a\x9c_Y3\xacQ\x8d\x90=X\xf3X\x81&h\x9bE\xaa\xf8]۷p\x8a\xe9:+e\xd9\xed\x13+7\xdcW\xa6\xaeQYH)\r*\x15͈\xfa\x97S\xa0>k\xea\x85<˸Ew\x17Ss)~\xb8\xe2o\xe1\xe1' ]\xed\xa6\x1f^\xb7\xb7\xbf\xa7\xac\x96\x00\xc9\xdfn\x9a\U0002ff14\xbb\u0097_`,ҵ\x00\xecO\x95'\x9d\xf2\xe7\xa6M\xfb\x9a\x82\xb3\xb6\xcc\x03\b\x8a\xd9+\xe3\xc8\x1f\xcd/\xfc쪕\xe5yZ}\x1d~ث\x83\xca\x1fp{\xaf$\xb6T\bSF\xb1\xdb\xdd\x15\xb5\xc1Gq-aw\x97\x8c\xb7 ſb\xb7`Y/2\x14\x99]\x86fx\x99\xf1\t%\xe5\x1b\xe4\x96H\ue54a\x14\x97$\xe0\x1be\xa5\xf6\xa9\x1bC\xa25\xf3\x05=\xc0\x1a+,F\x8f\x14\x14?\x85Ϧ\xe8IJk\xe5\x15\x88x>\xd8\xdc\xfc\xd7\xdd\xdd%\xde:\xcc\x13\x84Br]\xd7\x11\xc1\xc3(A|\\\xf3\f\x83\xe6\xb5\xcc'\x8e\xb8\x89\xe1\\\xd9\xf5܁5\xa8\x88\x9e\xe3\xab\xca\f\xd2\xed\xb9\xb5\xe8\xe1\b\xcaCDבPW\\\x93\xcfv+\xff\x94=|w\xe7\x98\x029\xb1C\x86\xc67\u05fe\xc9 c\x12`\x1a\x80\x7f\xb5\xc0\x88\xdc\x05\xffD\x7f\x82V(\rĹtz\x82H\x83%>\x95\x1c\x8e\xe8*W\x85WX\xc12\x7f\xcaO\xa9\x9f\xaaօ\x8c\xf4!\xfcG\xd9o\x92\x01\xa0\xeaD\xb4\xca\xc5\xf9\xdd\x1d=/𨆁\x1ds_㲵\xe8\x91\xef\x96\xe4\x05\xf8c\x15\xe7@\x96lm\xf5\xe6.\x13\"\xb2\xbb\xabʊ\xfe%\xfa\xfbiM\x1a\xdc\xd4-x\xd1+\xb1K\xf7DS\x84\x95\xfc\xd0Do\x83\xc7\x19\r0,\xa5\xd2\x15\\\x89\xdb-J\xeb\x8bJ\x82\x1bs\x99\xb3!\xd7C\xc1\rh\xb3\xbc\x18\xb0\xa7(\x92)%H}\xae,\x7f6\x99\x96\xedG\x1c\x84K\t\xa5\x8b\xb4\xa5V\u05eb\x10\x80\xfc\x80\xdd\xea\xd5\xda\x1e\xa7h\xad\xb7 .\ue4ae\xc6\x15\x8a\x82\xe2+潉a\x83\x10c!'\r\xa3Y\xa0l\x18\xd0\xf8c\x81\xf3\"\x02 \xa9\x04&\n\x96\xa7L+\x8c=\xbff.\xc7\xe2\x9aޮ\xe9\x8a\n\xd0J-\t\xbc,w\x87\x96\x99C#\x99\xe6\xb2\xf8\xbdV\xd3\xff\xa6\xfaD\xcc\x13\xab\x8aI`\xff\u0093\xf8\x1b\xb8\x13YY\x8e\x96!a\xd1/2-G5A\x94\xfc2\x16\xba\x00\x89R2\xcfW\xc3\xeb\xf7\xec\x95S?\xda\xfa\x14\xc6u@Q\\'\xde\x1c\x16\xe3ᢅ7ґ\x99\x8d\x87a\xd2\xea5\xcde\x11ͶR|\xcd\x1e\x11\f]\xb3\x11?:\x99\xc2a\x9b\xdfd\xc1\xe1y%F\xb9%\xe0\a\xee\x1c\xcc:a\xee\a\x96c\x81\x8e\xb4\x16=\xe0\x13\xed\xf1B\xa0٭\xec;\x1bcu\xbf\x98%\x9exIcm\xfe\xa6=^*\xf3k\xad\xc0\xc2\xe0\xa87\xae6<\xb3\xa7Q,\x8f\x18\xf2G\xf7TT\x86\xb9\xaa\xd65\xf1\xe60k~O\x81.\x7f]e\x1a.\xf9\xd2߿\xa7}[k\x8c\xd6\xf1?\xd7$\xdb͔B\xd1\fgI£\u0099\xa6\x04\xd5W\x81^\xe6\xa6I\tJy\xe1P\xc5\xe7Ԕv\x88\xd7zg\xd6v\xf1\x1fhc\xf7ˤ\xef\xbb\x1d-\ri\xdfw;+\xddn\x14\x0fwjd\xb7˸̪qT\x8e\xe4\xa8!ПX\xde\xdd-\xe3;\xaf\x16Q\xad\x98-(\xc2\x17\x9b:\xf4`Ӷ-\xdf\xd22\x9cEt\xbbj9\xef\xb5ZA\xbbS\xd3\xf8Z\xcbб\x82^\xa08\x05\xd5\x18\xc9\xe2$G\xdaʊ+\x885߾\a\xf3\xe9\xb6\xccs\xe5\xd61MIe\x8cO\xea\xf8\x8ei~\x12O\xc9\xf9\x06\b\x97_\x95Sڍ\xcc\xcb\xf7\x89:\xf93Wj\xaf\xb9\x9d\x8dF\xb2\xd6Z\x98o\xbe\xf8\xeb\xdf\xef\xf2/\x9d\x06\x91=\xce&\xe1\xefX\x87\xd3q\x9c\x93\x93\xa3/\x1c\xfaW\xfa\xdb=<:9\xfe\xa2\xd3=<9\xee\x1evNN:_8\x9dN\xa7{\xfc\x05s\xfe\b\x06\xcc\xd2\xccK\xbep~u]\xe5\xc6\xfd\x87\xfc;O\x87I0͞\xec\x1c<z\xb4\xc3\x1e\xb1\xe7\xf1t\x81\a\u05ec54Y\xd7\xe9t\xda]\xa7sľ\xe1a0g\xdfF^\x9a\x02ث`ȣ\x94\xfb\f\xb4M²1g\xaf_\xbeg!%\xef\xb0G\a;\x85zH\xe28\xb3\xe4i\xacɖ;;\x8c\x1d@U\x93I\x1c\xfd\xfd\x02\x80\x19\vFLN%|>\x8d\x93,e\xae\xcb\xf6h\x92\xdc3\x19\x9b\xc4\xfe,\xe4v\x9e)\x11\xb6L\x81\xef\xd9\xeb\x17\x02\x880\x8a\xad\x94\x1c-\xa9E\xc4*I\xdbc\xbb\xbb\"\xdd\xf6&\xbe)~\xb7$\xad\x02\xf3WI|\x9b\xf2\x84]\x87\xf1\xc0\v\x15\xec\xd02\xfbb\x8aq35\x8aV;\xa8v-V\xa87\xb6\xdca̘\xa5\x9c\xa5Y\x12\f3\xa3\x0f\xd81\xb6y\xc2G\xc1\x9cC\x9b.\xf7n\xf9\xe0S\x90\xedYl\xefu\xfc\v\xfc\x99\xa4\xf0߷{W@\xc9?x\xe4\xc7J\t$\x851\x8byQ0A\x13\x11\xb0,W\xc8\x0f\x99ĒY\xc8S\xf6\x89/\xb8\xcf\x06\v\xe8\xad \xc1\xad\xb3\xa2\xfc,\xe5\xcf\xd3\xf4Y\x81\xe5\xe0\x11\xfb\xe7\x98\xc39\x11\xcbb\xc8f\xcf/.\xd4j\xe2\x84\x15\xefM\x01\"\xe4\xd5#\xc0\xf7\x88\xfd\x90\x05a\x90-\xf2\xe6\x03\x0e\xb2\xe5ex\xbe\xd4f/G,\x8aY\xe6]\x13-Aʮ\x83\x1b\x1eY\x84\xc2c/^\xfe\x03\x12\xa9\x9co\xb3\xb7h]ya\xb8`S\n\xd4\x12\xf0\x94\r\xbd\x88\r8\x9bzi\xca}\x1b\xcbB\xa3\U0009a967]+\xf3\xae-,H]A\xbc\xe7!sYC\xd0T\xa0\xec\xee\x8e\xed\xf9\xc1͞\x89%\x80S\xd1\x0e\xfe\x04C%bA$0\xf2\xf02\xbab.~]FW\b\"\xe63\x1e\xee0\xb6\xd2\xd8\xf3\f\xcfiS6\x04\xbf\xbe\x84G̋|\x01\x9e\xe2`\xa2\xc75kZ\x13Di\x8b2\xa1\x87\xb0|Ǣ\xbf]\x8bٶ\xfd\xe8@6o\x14'L\xd8R\x1d\x8bax\xbc\x19q^\x98\xc4,8\x8f\xfa\fv\x87D\xe3D\xad\xaa\xcfa^\b\xee\xf3\xed\xa8\xcd\"\xe0J\xd3^\xe2\xc5\x1e\xe6\xe1\x1a\x06O|\xd31\xe7\x19\xcbb6\x8eC\x1f[\xf7\x7f>\xf1\xc5(\x81>\x8f\x13\xf6\x8fׯH@\x8b\xd6\x02\xd1T\xcae\xad\xd2\bR\xba-\xef\xd9=\xacg\xcfbK\x18\xea\xac\xc7\xf62>\xcf\x0e\x86i\xba\xb7\xa2\xb6\x01\xdb\xf2^\xae}kpo\xcc=\x7f\x0fnc[\x8c\x87\xa6ށ6Qsw\x87\xbf\xf1\x18\x1b\x12\xa0\xf1-\xd3\xd4\xda\xff\x1c\x89J\x99\x17\xb1x\xea\ra\x10\xe4\xad\xf5\xb4\x11\xa9uz\x90\xa58\n\x88\t\xec\"\x88\x86\x9cM\xe24c\x93x\x10\x84\x9c\xfd\x13\xf5B\xca\xc6\xde\rgY0\t\xa2k\x16\xa4錧\xec6\xc8\xc6\x05\xee\xb6\xcfCo!\x06\xd1-\x97\xc3.\xe5S/\x81\x1f\xa4\f@8\xe0yU\f\x94|\x90\xf2\xebI\xbd\xbca\x14]\x81\xb9\xe5\x85ӱg\xb1,\xf1\x82\xd0b\x81\x85\x85S\xb5_p \x83\x1a\x13m\xdfˡ\xff\xe7\x7f\xa8\xf8\xa3\x8e\xe3\x98E\xe1+\xba\u07bd\xd7VF\x18\xcc\xc8\xd0\xf5\x8e\xedt\xd8>\v\x0e\x10\x92=b\x1d\xc7Ɂ~a.\x93[-\xad\x0ek\xb3V\xa7\x8d\x15\x98\xec\x80\xead\x8fX\xab\xe38mDgZ\x8crs\x04\xa4C\x99[Q}v:\x1b\xa4\xf4Z\x99SU\x8c6\xee\x13\xbf\x1d\xb5\xf6\xf2\xb4=Ӵ\xb3\xf8U|˓\xe7\x1eD\xabV\xeb`\xae\xaciw\x97\xed\xb5\xf7ؾ\xfc\xde\xc7OP0{4\xb0`\xaezPh\xd8Kz\x89Yp\x97ш\x10o\x8f\xbe\x9b\x85\xbc%\xd2\x19\xdb\xfb?\x02-\xe0\x94Җ2H\xc4\x0e\xd9g{\xcb=\xb6_\xc0;\x0f\x97\xa2\x83z\x00\xf4\v@\xacT\b\xea\x82}\xb6\xa7\x03\"\v+\xc0-\x84އ\xee2\xf52\x9d:8\xec\x1c\x93=\x84\xeeܶ\x8a\xbd\x8e\xb3\x91\xe6\xbd՞%\x984L\xd3w\xa8R\xc4\x16\xc1\x8e\x00*3\x97\xb9\xac\x83Y+M\xb5A^E\xb1\xbdǝ\x97\x1b/\t\xe2Y\xcanJ\x13qY\x81cXH\x96Φ`\xadp_NX\x8b\x9a!F\xa8Z<\xac\xceN0\x99K\x8dS\b\xd54\xff\x19\x10\xd9PL\xcc=\xf6p\xec%ϲ\x96\x83\xcf\xeeM\xa7R$Q>⩈\x1e\xd21\xf39\f\xb6:Yp.\x1bR\xcc\r\xfb\xfb\x85\xe8M\xa7\xb9\x18\xe3+\xa7\xfb\x80K\xe4\x81+\xc5\xe5tz\xc5\x1e\xb8.+\x16\x9f\xf941\x15\f.`\x93\xb8\x19\x9a\xf0ꜿ\xe0Y\xca\xd0Mw\x1ar\x9aST\x03\xc0\xcbX\x1c\ry\xddԟ\xa6\x15\xb6\xe6\xb3b1y\x8b\x86HF_*\x1d\x12\x99wwڼ\xbeab\x87=\xea\x14\x10\vgS\x11^\xb8\x86\xb6\tO\xaey+\x1e|\xac\x9b\xae\xfb,`\xe7\xacf\xc2V;E\x04\xd2a.S'i\x91\xa97\xd3\xe7#3\x1f'\xa0f\xe2\xc1G\xb4W\xf4N\x90\xa9\x00/\x8d\x98\x95\xda\xe2x\xf0\xb1\xd2\xe4w\x8a\xd4{\x834\x0eg\x19\xd8/\u05fcMo\xe91\xdc\x1c\xe2d\xdbI믆!\xd3\x18:K\x95\xfe\x18LY6\xef\xf1P<\xcb\xf7\x8a\x8f2\x8b-\x8a\x84\xf7\xf1T\x10H[\x1d-\xb4\v\xf2\xec\xef\xd1D1e\xd3c{\xbe\uf590\xc5\xf6BI{\x1fO\xb5\x1e\x8e\u05f6\x16\xa6%\x86GHl\x94\xc4\x13\xa5\x954}\xb08a\x1e\xee\xedU[\x8b\xefyÉ4\x1dA\xb1\xc0\x9f˦\x8b\xbaŪ\x85\xf0â\x85\x90\uec67\"\xadG\x7f/\x03\x7f\xce\x1e\xd2o!(W9\xd9\a\xec\xabY\x10f\xedB\"S\xb9\xea\x90\xdf\xcc\x15\xf5\xe2,\xdbc\x9d\xaeŔ\x7f\a\a\xec\xfd\x98\xb3\b\xa3\nA_\"\x18\xcbb\xe6'\xde-\x15\xa4\xd3IvZW\x902\xa1`nnP\x87\xa1\xbb3;\xb6X]!\xe0l6\x0e\x86\x9f\"\x9e\xa6\xc4\x15\xcf\x0ff@\x9ecU\xe1)SJ\x1a-\xc7\xc8/\x96\xcaƙ\x97\xf1\x1es\xca\x04\xbe\x83\f\xe8\x0e\xea~\x04\x1e\xc6\t\xbe-\xc3:V\x19x\x16\xf9@\x0fk9\xb6-T(\x1d&\xb2\xbd/\x1d\xc7ٳ\x14\xe8/\x93\xeb\x01\b\xc0\x97Ir}=\x18 \xb0\x1f$\xf4\xfc\xbb\x86\xfc\xe0\x80uzl\x18\xc6\xc3O\xb7A\xca-ֆ\xcfx\x16e<\xc9S\xb1|:\xe5\xdc/\x11V\x90\x96\xb2)OXʇq\xe4#8N\xb4\xc0\xb12˞\x8d2\x9e\\\x87\xf1-\x94\x18\xf2(\xf3\xae\xa9\x029\xc1\xb2\xce\xc1\x91F\xe0[ʐ\x1cF\x19 \xad5M{\xac\xab3\x16K|C6HA\x12\xbb\x1d\xf3\x88\xcdR\x18\x18\xcaC\xc5\xc4\xc6_h3\x90u\xf9cKC\xf3Cʙ\xc7\xe0\x01\x06\xf6K\x1bM/X\xc1\n٥\x1e\x907\xa7{l/\xa5\xa5\xf8\x9e\x05Ea\xbd\x8a\x99,\x8b\x99\x97\xa6\xc15.C\x81~\xa1\x85\x88K\xf1\xb4\xc7\xf6\x8e\x9d\x87{%.\rѫ\x96\xdd\xc04\x03\xbe\xca\v!죬\n_\x80\x8f\xe3$\xf8%\x8e\xb2\xbc\xc0T\xfaW\xb2=\xa9\x1e\xf7\xb0\x80 \"\aPt\r\n\xf50\x8e\xd2,\x99\xc1\x96BIy\x88\x1d\x87V,U\x06\xbd\x954\xc5\xc1,&\x160,\x97+K\xc2\xe6\xf12\xad|\xe0\x9b\x8a\x96\xf8\x96v6d\x16\xcb\xc60\xab\xde\xf0$\t|\x8e,\x1b\b=\x92\xf6vX\x05)\xee9\x00*\xaa\\f\xe7>\xdf\x16m\xfa\xe4z\x14\xd7\xc0\xbeOjTt\x9a\xec\x1bҠ\xf4\x02l>]\xc0^\x014\x92\x05\x11\xbdhȂ\x94ya\xc2=\x7f!\x11\"\x9e \xba\xb6X\x90a\xf6,\x8b'\x9e\xe8;F\x0e\xc2>i\xea K\xc1\x9c\xb9ASNT5`\x00\x18D\xd79\xbe,\x9e\xb6L\nރ\xbb\x0e\xb6\xc89\x10\x831\x88z\xc5\xfe\x0ea)fg\xe1)\x0e(v\x94\t\x1b\xbc\U000982f9;Rx,\x9c\xe9 \a\xfbPI\xe7\xa1\xcc\xc0_`\xcf\xe4\x8b^\xc7bKE\xf6\xe3\"\x84\xc0ʴز\x10\xbbؖ\xbf-\xa9u\x1d+\x1fq\xb1M\xbfV\xa6R\xef$\xf0\x99\xcbb\x9b\xf4\xea~,\xe6\x95\xfd\x98\x9e_\x90\r\x92\xf6\xd52/J\xa3#\xc6\xc8\xdfV\x9e\x8aC,\xb6\xb3\xdcf\\\xe5<\xc1\xfd\xb9\x12\xeb\x98\xe8\x12\xb1\xd6\xf9\x8a\x8f\xe2\x84cE\"\x1d\x8dkܟ\xb8\xbb\xc3H\xe9\x12\xed\x8ebʩ^\xfc{I\x8c\xfb\x03{\xd3$\xbeNx\x9a\x0e\xbc$_p\x16\xef\xb6`\x1dy?h4>(\xaf\x03Ur\x0f\x0e؛X\xdf\x1f\x93\xa6?. 5}\x87\"\xcc=?/\x8d6\x1f,w\xf3\x14u\x11܊\x896\xd6f\x1d\x13\x97\xb4\xac\xcdb;\x9fJ`\xb9\xdb\xd5J\xe2\nJK\x19MS\xec\xce\xd14\xd5\xd3a\xcfr\x9a\x1e\xc46\xce,Z^\x9cf\x1c\xac\xfeV\xa7\x1d\xdbb^\x80\xbaZ\xa3G\xb1M\xeb\xeb\x03\x98ZL\xbdnQjt \xc8\xdeɳ\xfb\xadbS!\n&-\x95\x81\f,۾\U000996ef\x1f\x811}\xf6\x91\x9d3\x81\xb1\xcf>\xaaV0\xfd\xc36Wv\x04\x02\xb6\xaf\xf2\xef#\xf0\x0f)\x84\xd5\xe7\x88=\xa2FZ\xach\xe0\x8e\x86U\x88\x02f\xa1l|d\x8fT\u07b3}\xea&\xc1s\x8b\xc5*7V;%L\x19\x89\x802\xa4wwU\xd1\x00\xb6\xe0FI\a\xee\r\x8f\xa6\xa9Y\xa0[\x99\xadB\xca\x19S\xecD\xc0\x85)+\xab\xa2c/\xb2x*צ\xa0\xfeH\xe1J\xf5\xac\xab3\x1c\xa4\x95\xcd6e\xbbMx\xf6+\x83\xa20\xd7i\"\xe6^\"ے\x9f\x8bƳL_z\xf0Ц\xadCxz\xcedڧ\xb8\xc6!\x1fW/\n\x8a\xba\x99\xb2\\\xa9\xe7F\xaeXk\xb8\xf1Rhr\x11\x9a\x88&9\xb0bSa6\xfa\xc1M\xe0ϼ\x90\xec\x1b\x9b\xfd3\bC6\xe08\x11\xde&A\x96\xf1H\xe2\n\"ܸ\x1cya\bOv\xc1\xc1\x03g\x03\x1eƷ:[\x85Q\x9d\xf3\x15\xa4(֙\xab\x8f\xfd\xcf\x1d\xf9\xa0\xb8\xae\xa5\xf8\xca\xea0\x8e\xbc\\b\xa4cϏo\xd5\x1e\x13<Ӧ\x15\xd3҆V\x9d\xf5b)\xf9bBi\x95\xa7\b\xdc\b\x9a\xce5X\x8a#\xd8c\x02\xa4\x06\xa2x\xfdL,n\xb4\xccx~\x81M艦\xa8\x99\xf9\x8d\x8b\xb7Ip\r\x13\xf3\x1eLA{\xb5 =\xb6G+\x82\x16l$\xfd\xcf\xff\xb4\x0eO\x1c\xa9\xaf\x1e\x05\xfb\xb1M\xb9\xd8\x04\x9f_\x9bT2\x94\x05\xf2i\x11\xa8g\xfb{\x96c\xea\x8d\xc0k5\xef\xc4z\xa5\x15\xdbbE\xc1\x1eɖ?yґ\xfcQ\x06xy\x12C\x1dH;\x01\xb9\xea\vt\u0557\xf2k\xe6\xfe\xaa\xfe\xc3Q\xdf\xd9\xff\x9f\x96 \xed\xa0[\xd7s\n\xebb{|\xeb\r\x87<dO\xd9^ΘC\xbf\x05\xa1?\x1ds\x0fvߵ\xb2\xf9\x9a\"ײjn>a\xf6\xaaGP\xbb\xbb\xfa\xf6s\x81\x80\x89)\xc8\xca7*\x03]3[\x92e\xd8\x1a\xdc\t\xed\xc8i\x0eRR\x1c\x98\xe8\xa5<\n\xa2 \xe3ZG\xe8[%\xb6\x1c8p\x88\x90\xf2k\v\x19\x8e#K.\xfb\xf6\x1c氣\xe9\x1c+\xa2D\xe8\adn\x17\xe5d\xa5\xe8r\xc0\x03z Ǉ\xb8\xf2\x1d\x81ؖ{\x02\xa6\xc0ܙ\xceYr=\xf0\x88ǖ\xdd1\xf7L\xb3a:\xe0\xe1\xbdԟ\xe7\x7f\x9c\xa5\x19)\xc0\xb8X\xe4y\f\xd6i!\xad\xf4\xa4F\xabӇ[+\xc2\\\f4U\x18X\xb0CV\x8840\x1c\x04\x9e\x876\x9ei\xbd\xc1\xe8jb\x17WO\x05OEz\x1eL\xd2\xed\x02\xaeb;\x17:R?:\v\xb2\x7f\xbc~\xd52\x8b\xc5ȺsJ\x0fەyײ\x059\xcc\xcdD\x1c&zY\x96\x14\xb4Km\x9a\x9fJ\x9d\x834\x00\x02\x10\xc1\xf9$\x84 !\xb3$\xea\xa5\xc31\x9fxi{\x12\f\x938\x8dG\xf8bk\xeff\x12\x1a\xb4^u\rX[\xb4!\xe1ɞ\xa8eGQ\r\x85\xb9\x99\x8f˔\rf\x19\x12\x9c\u06dd\x9e\xef3\x0f\xa1\xf0\xb0i$\x8e\xda\xe4\xf1ko\xa78S\xf0|\x1f\x0f\x14\xf6lY1\xc8\xf4\x80\xdeFLz\xb3$l})\x96z_\xfe\xe3\xf5+sO\f\x91\xca:OLX\xee\xda\xf9.A[\xb4\xb4\xa2P\xa61\xe6\xb2\ue8e42\x95]'\xd3V\xc3䕧1\xec\x9b=\fh\xb0g\x95l\xc4a\x1c'~\n.\xa3,͵BjUab1\x85\xb4\x93\x1c\xac\x9d(`+S-\xb4\x94s`j\xe53\\\xaa\x18\x7f\x15\xbdN\x11\x93\x93\xeb b.kKś/\xb2\xccG\xdd\xf2\xdc`1\xa9\xe6\x91\t\xda\xeaNQ\xeb\xa4\xcc\t\xb5%\x96b\xf4\xa5-\xee\x82\nkS~\xdd\n,\xe6\xcfQ\x15e<Q\xf9\fZ\xeaZm0$\xa8\xb4$b\x13\xad\xc7\x0eO\x1cv 5/\x18Ib\x06ݓ\xd4\xfc\xcf\xff\xf8s\x9d{\x05:\xec9\x9c\xfbA\x87C\xefyɐ\xba+\x9fBWf\xb9S\x15\x1b$\xb1*9%\x8b\xa3\n \u05eb4\xa3W\xf3\x91\xa3\xedbڮB\x10\xc3z\xe2o)\xbb\xdcV!\x9f\xa0\uf841b\xf3\xb0^\xf3W\xe7\xcd\x06lt\x1d\x1d\xf0\xe5E\x9c\x95\tZ\x02Ճ\xf0\x16 (\x96\xc5\f\xce\x1e\xb1\x1e6\b9\x87\x98\x15l6\x8d\x8b\xe3\xea\xe1\x18n\xbfj5\x99;u\xbf+\x92\xadM\x97;\xdaZ.\x80\xa364f\\ݚ\xd1\x16I(\x86\xed\xaeX\xa8\a~\xefŏ/'\xde5\x7f/\xf5\x9c\xfd:י_\x85\xb3\xa45\r\xe6<\xa4\xces\xbb\xd6\xc4\xfbĩv\xb7c\xd1\x0f\xd1(\xd7>̵\xd6&\x8a\x88\x0eS\xd7\xearξ\xd64qU\x01\x16sQ\xcd<WQ\x85C:\xad(\xf63D^\x8c*\x92Z\x00f\x90\x1cRww\xccQx=\x84\xbc`?f\xe7lX7_*k3\xe6j \x97\xc1~|էd@2ThhH\u058c\xa2\xa1Ɇ5\xf3\xae\xb4FVrk\x91ܛ\xe2\x01/\x9b\xa9BG\xc3x\xce\xe7\x19\xb6W\x9di\xc8\x1a\xc3\x1d\x18q,\x87\xe8,a{\x82L\xec\x99@*&۞\xff\xd1,&z\xe9\xa6U\xb1,]VB\xe6\x15g\xf9;;y\xa7\x8b\xee\xddف\xdb\x13;\xe7\a\xd2W\xee\x7f\x91\xffg\x16\xc7\xe1\xc0K~W\x17\xd0\xf5\xfe\x9f\xceI\xc7\xe9~\xd19\xee\x9e\x1c\x1d\x1e:\xce\xf11\xf8\x7f\x9e8\x9d\xbf\xfc?\xff\x88\x7f\xcb\xe5\x01lf\x80\x93%\xf7Y\x10e1\x83\a\xc0Y\xc2\xd3i\x1c\xa5<\xb5X\x1a\xb3O\x9cO\x19\xbf\xe1\xc9\"\x1b\xe3\xf9\xcd0\x9e\xe6Ο_^Ǒ\x1f\x87^[\xc8\x12{t\xb0Z\xed\x9c\xd39=(Eא\xeeXƓ\x9d\n4(\xa9\u009c\x01\x0f\x03\x1fv\x02E\xcc$\xe6\xf4w\xe44\x8d?)\xaa\x12\xfd\x16'Bx\xc4\xe58\x984\x8a\xa3\xacǢ8\x99x!\xebt\xa7\xf3\x83\xce\xd9t\xce^Ǒ7\x8c\xad\xd7q\x14\xe35%\x00\x15\xb3\uf5dc\xe3'\xd0\xd8\xf6\xc2\xe0:\xeaa}\xfd\x9dU\x95X\xfb:kK\xa2\xd5\xcd\n\xb1:c\xe2\xff\xec3\x13PʐP\xb84\xec8\xd39V;KR\xa8w\x1a\xe3\xc9\xc2\xdaj\xe0\xd5v\xac\x8b̸\xb6h|\xe7x:_[\x0e\xfer\xb8?ĖJCG''\x8d\xa5\xa6^\xc4C\x84\x16\xaf\xb1\x03\x13#\xde\xdf\xd0\xce\xc7\xd8Ή7oKS\xebĹ\x19C\x1a\xac\x11G!l\xd6\xc09\x8cƍc\xc1\r\xc1\x92*E@P<\xe5Q\x13e\x038\x15\xadm\xca\xf8HaW\x8fjq\xd8\xf1t.e\xa3M\x16e\xe7Pt\x86`\xcd\xe3\xe1\xa8\x16_\x06\x01n\xa8\xb7qW\a\x02\x0f\x85\xde4\xe5=&\x7f\x01\x1aa\x81\x82\x93R=\x9a\xb1U\x1d&\x99ϖ*[:9[\x80h@+\x8f\x1e\xa5Xf\xf1\xb4^RK\xc2\\K\x01V\x86\xb1\x90\xda8\x04\xa0\x83o\x13oZ\v>M\xb8\xc6H\xa7_.<Mx\x9b\x8a3v\x1b'~{\x90p\xefS\x8f៶\x17\x865CL\x95\xa4\\\xba\x90\xaf\xa2\x86\x9c\x17\xcaX\x0e\xa21O\x82\xe6\xe1Ha\xfc4\xf9\b\"0\x97\xdaBL\x8ae\xc0\xd9t^&C\xf6\xfc\xf9\x01\xea\xab';\xe7~p\xc3\x02\xdf5Ju\x19Ov\x18\xc3L\xb1F\xa7\xa1f\xb08\x1a\x86\xc1\xf0\x93k`\x00\a՛\xb8\xf03\xfdj\xf1\xd2o\xed\x950\xee\x99}\x96\x15\xa7v\xccվ\x9e\xb2=\xdcK\x13Ca\xaf\x8f\x040v\x0e:\xe1\xc9r\xc9\xec״\x8d\xb3Z1\xf8\x82\x98Nl\xb5:?\xc0\xfc\x02t\xb9d\xc1\x88]g\x9c\xc1{p\xd9,}\x1e\xfb\x9c\x1d9\x0e\x14,\x9a\x82\xda\xc2X.\x19\x8f\x00%V\xa0\xc0\xd7 F\x90\xafq\b\xf8\x8d\xf9\xffwF\x17\x16W+\xf6/\xfaY\x85\x1bz\xc31\xc76<\x87_\xdf\x05\x19\u008f\xe1\xefA\x91\xfe:HS\xc24\xc1_\xb5\x15\x86\xa04^\xf34\xf5\xae\t6\x8c\xaf\xd9D|\x17%\xce\x0f\xfcদCQ\xd7HF\x8f\x8f\x9e\xbcG?\xdb\xf3\x83\xf1\x91HC}@\xbf\x19\x90\x86\x81\x82\x98MpP\xa1\xc8\x02\xd0\xe4I\xfe\x01\x9fcd\b\xf6-p+\x1b\xeb\xd9>f\xe3\x83(\x94\xef\xd7忏3/lΟ&A\x94\x8d\x98\xf1\xd0\xee\x8c\x1e>4\x98\xfd=\xf9wԗ \xb5\xe5\x1a\xc7\xceC\xe3\t\xb2Pa\x05\x0e+\x83\xbc\xed\\C(\xb8R\x15Z\x05\x80\x83\x18\xacWu~Pp\"\x97\xaf\x9d\x1a&\x01\x8b00U\xaas\a\xdaVB)\xb8\xf1\x8eO\xbc\x00\x8e\xfc+\xed\xab/\xa3\xa6\x14d\x9d\x1f(\xbdZ\xf4\xe9\x05\xed$+\x9dJC\xc9\xfe\xfa\x86G\x99\xde\xd7\xe3#\xea\x9d \v\xa9w\xa5\xc0T\x85F\xaf\xa4\x8a\xabFv\xd4&\xab\x03.\xf3\xeb\xa0JIu\xf5W\xe9x\x13g\xbcB\xc6\x1ar\xcab]\xb4\\\x97\xeb2q\xe0gJ\x05\xf8\x9c\xa4\x12\x12\xea\x9aR\x15\x9e\x12\xe5\x9a i%\xea\x9a[\xad\xa2\x8c\xba\x8a\xb2\x84J\a\xa8|\xa1\x17\xbe\xaa{v\n\xe1x\x15_\xab2Q\ue462\x1f\xf4\x16U\x18/\xd9\xfd\x8a\xdf\xf0\xb0\x96\xdd\x1a\x93\x051\x8d|\xd6YP\x19\x9c\xa5q\x91g\n\xed)\xfe|\xf1\u05ff\xbf\xfe\xfd\xf5\xef\xaf\x7f\x7f\xfd\xfb\x0f\xfc\xf7\xff\r\x00\xa3J9\xff\x00d\x05\x00")
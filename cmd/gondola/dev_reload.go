@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.google.com/p/go.exp/fsnotify"
+	"gnd.la/app"
+	"gnd.la/log"
+
+	"gopkgs.com/vfs.v1"
+)
+
+const (
+	// devOverlayScript is the path of the script injected into
+	// the HTML pages served by the project. It shows the build
+	// errors on top of the page and reloads it when the project
+	// is restarted or its templates or assets change.
+	devOverlayScript = "/_gondola_dev_server_overlay.js"
+)
+
+var (
+	// reloadDirNames are the directories, relative to the project,
+	// which contain templates and assets. Changes to them cause the
+	// browsers to reload, without rebuilding the project.
+	reloadDirNames = []string{"tmpl", "assets"}
+	overlayTag     = []byte(`<script type="text/javascript" src="` + devOverlayScript + `"></script>`)
+)
+
+// listenerFile returns a file for a new socket listening on a
+// random port on the loopback interface, as well as its port.
+func listenerFile() (*os.File, int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, 0, err
+	}
+	// The socket is kept open by the returned file
+	defer listener.Close()
+	f, err := listener.(*net.TCPListener).File()
+	if err != nil {
+		return nil, 0, err
+	}
+	return f, listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+// watchDir watches dir and all its subdirectories.
+func watchDir(watcher *fsnotify.Watcher, dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return watcher.Watch(path)
+		}
+		return nil
+	})
+}
+
+// reloadDirs returns the existing directories with
+// templates and assets in the project.
+func (p *Project) reloadDirs() []string {
+	var dirs []string
+	for _, v := range reloadDirNames {
+		dir := filepath.Join(p.dir, v)
+		if st, err := os.Stat(dir); err == nil && st.IsDir() {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+func (p *Project) isReloadFile(name string) bool {
+	for _, v := range p.reloadDirs() {
+		if strings.HasPrefix(name, v+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// fileChanged is called when a template or asset changes. Since
+// the project reloads them by itself when running in debug mode,
+// browsers can be reloaded without rebuilding it. Changes are
+// coalesced, so saving several files at once reloads them only once.
+func (p *Project) fileChanged(name string) {
+	p.Lock()
+	defer p.Unlock()
+	p.changed = append(p.changed, name)
+	if p.reloadTimer != nil {
+		p.reloadTimer.Stop()
+	}
+	p.reloadTimer = time.AfterFunc(100*time.Millisecond, p.reload)
+}
+
+func (p *Project) reload() {
+	p.Lock()
+	defer p.Unlock()
+	styles := true
+	for _, v := range p.changed {
+		if strings.ToLower(filepath.Ext(v)) != ".css" {
+			styles = false
+			break
+		}
+	}
+	log.Infof("%d templates or assets changed, reloading browsers", len(p.changed))
+	p.changed = nil
+	p.reloadTimer = nil
+	p.reloaded = time.Now().UTC()
+	p.stylesOnly = styles
+}
+
+// newProxy returns a proxy to the project running at u
+// which injects the overlay into HTML responses.
+func (p *Project) newProxy(u *url.URL) *httputil.ReverseProxy {
+	proxy := httputil.NewSingleHostReverseProxy(u)
+	director := proxy.Director
+	proxy.Director = func(r *http.Request) {
+		director(r)
+		// Let the transport negotiate the compression, so
+		// responses are transparently decompressed and the
+		// overlay can be injected into them.
+		r.Header.Del("Accept-Encoding")
+	}
+	proxy.ModifyResponse = injectOverlay
+	return proxy
+}
+
+// injectOverlay adds the overlay script to HTML responses, before
+// the last </body> tag or, if there's no </body>, at the end.
+func injectOverlay(resp *http.Response) error {
+	if resp.Request.Method == "HEAD" || resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusNotModified {
+		return nil
+	}
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/html") || resp.Header.Get("Content-Encoding") != "" {
+		return nil
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	pos := bytes.LastIndex(bytes.ToLower(body), []byte("</body>"))
+	if pos < 0 {
+		pos = len(body)
+	}
+	var buf bytes.Buffer
+	buf.Grow(len(body) + len(overlayTag))
+	buf.Write(body[:pos])
+	buf.Write(overlayTag)
+	buf.Write(body[pos:])
+	resp.Body = ioutil.NopCloser(&buf)
+	resp.ContentLength = int64(buf.Len())
+	resp.Header.Set("Content-Length", strconv.Itoa(buf.Len()))
+	return nil
+}
+
+// OverlayHandler serves the overlay script.
+func (p *Project) OverlayHandler(ctx *app.Context) {
+	ctx.Header().Set("Content-Type", "application/javascript")
+	ctx.Header().Set("Cache-Control", "no-cache")
+	for _, v := range []string{"mux.js", "overlay.js"} {
+		data, err := vfs.ReadFile(devAssets, v)
+		if err != nil {
+			panic(fmt.Errorf("error reading %s: %s", v, err))
+		}
+		ctx.Write(data)
+	}
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"go/build"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"gnd.la/loaders"
+	"gnd.la/template/assets"
+	"gnd.la/util/fileutil"
+)
+
+const (
+	// distTemplateCache is the directory, relative to the
+	// -dist directory, where templates are precompiled.
+	distTemplateCache = "template-cache"
+	// distAssets is the directory, relative to the -dist
+	// directory, with the assets listed in the manifest.
+	distAssets = "assets"
+	// commandsPackage must be imported by the app for
+	// running make-assets, otherwise it would start serving.
+	commandsPackage = "gnd.la/commands"
+)
+
+// buildDist builds the main package pkg as a self-contained
+// artifact into opts.Dist. See buildHelp for the details. imports
+// must contain all the packages imported by pkg, as returned by
+// checkImports.
+func buildDist(pkg string, opts *buildOptions, imports map[string]error) error {
+	p, err := importPackage(pkg, opts)
+	if err != nil {
+		return err
+	}
+	if p.Name != "main" {
+		return fmt.Errorf("%s is not a main package", pkg)
+	}
+	dist, err := filepath.Abs(opts.Dist)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dist, 0755); err != nil {
+		return err
+	}
+	goos, goarch := build.Default.GOOS, build.Default.GOARCH
+	var env []string
+	if opts.OS != "" {
+		goos = opts.OS
+		env = append(env, "GOOS="+goos)
+	}
+	if opts.Arch != "" {
+		goarch = opts.Arch
+		env = append(env, "GOARCH="+goarch)
+	}
+	binary, err := binaryName(p, goos)
+	if err != nil {
+		return err
+	}
+	version := opts.Version
+	if version == "" {
+		version = gitOutput(p.Dir, "describe", "--tags", "--always", "--dirty")
+	}
+	release := opts.Release
+	if release == "" {
+		release = gitOutput(p.Dir, "rev-parse", "HEAD")
+	}
+	stamped := *opts
+	for k, v := range map[string]string{"buildVersion": version, "buildRelease": release} {
+		if v != "" {
+			stamped.LDFlags += fmt.Sprintf(" -X %q", "gnd.la/app."+k+"="+v)
+		}
+	}
+	stamped.LDFlags = strings.TrimSpace(stamped.LDFlags)
+	fmt.Printf("building %s (version %q, release %q) for %s/%s\n", binary, version, release, goos, goarch)
+	if err := runGoBuild(pkg, &stamped, env, "-o", filepath.Join(dist, binary)); err != nil {
+		return fmt.Errorf("error building %s: %s", pkg, err)
+	}
+	for _, v := range splitString(opts.Include) {
+		src := filepath.Join(p.Dir, filepath.FromSlash(v))
+		if exists, _ := fileutil.Exists(src); !exists {
+			if opts.Verbose {
+				fmt.Printf("skipping %s, it does not exist\n", v)
+			}
+			continue
+		}
+		dst := filepath.Join(dist, filepath.FromSlash(v))
+		// Remove any files from a previous build
+		if err := os.RemoveAll(dst); err != nil {
+			return err
+		}
+		if err := copyTree(src, dst); err != nil {
+			return fmt.Errorf("error copying %s: %s", v, err)
+		}
+	}
+	if _, ok := imports[commandsPackage]; !ok {
+		fmt.Printf("not precompiling templates, %s does not import %s\n", pkg, commandsPackage)
+	} else if err := precompileDist(p, pkg, dist, binary, opts, env != nil); err != nil {
+		fmt.Printf("warning: could not precompile templates, they will be compiled on first use: %s\n", err)
+	}
+	if assetsDir := filepath.Join(dist, distAssets); fileutil.DirExists(assetsDir) {
+		fs, err := loaders.Dir(assetsDir)
+		if err != nil {
+			return err
+		}
+		manifest, err := assets.WriteManifest(fs, !opts.NoCompress)
+		if err != nil {
+			return fmt.Errorf("error writing assets manifest: %s", err)
+		}
+		fmt.Printf("wrote manifest for %d assets\n", len(manifest.Assets))
+	}
+	if opts.Archive {
+		archive := dist + ".tar.gz"
+		if err := archiveDir(archive, dist); err != nil {
+			return fmt.Errorf("error writing %s: %s", archive, err)
+		}
+		fmt.Printf("wrote %s\n", archive)
+	}
+	return nil
+}
+
+// precompileDist runs the make-assets command of the app, which
+// precompiles its templates and compiles and bundles its assets. When
+// cross compiling, a temporary binary for the host is built for
+// running the command.
+func precompileDist(p *build.Package, pkg string, dist string, binary string, opts *buildOptions, cross bool) error {
+	if cross {
+		host, err := binaryName(p, build.Default.GOOS)
+		if err != nil {
+			return err
+		}
+		binary = ".host-" + host
+		if err := runGoBuild(pkg, opts, nil, "-o", filepath.Join(dist, binary)); err != nil {
+			return err
+		}
+		defer os.Remove(filepath.Join(dist, binary))
+	}
+	cacheDir := filepath.Join(dist, distTemplateCache)
+	if err := os.RemoveAll(cacheDir); err != nil {
+		return err
+	}
+	args := []string{"-template-cache-dir=" + cacheDir, "make-assets"}
+	cmd := exec.Command(filepath.Join(dist, binary), args...)
+	cmd.Dir = dist
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if opts.Print || opts.Verbose {
+		fmt.Printf("running %s %s\n", binary, strings.Join(args, " "))
+	}
+	return cmd.Run()
+}
+
+// gitOutput returns the trimmed output of running git with the
+// given arguments in dir, or the empty string if it fails.
+func gitOutput(dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// copyTree copies the file or directory at src to dst,
+// preserving the permissions.
+func copyTree(src string, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode().Perm())
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		return copyFile(path, target, info.Mode().Perm())
+	})
+}
+
+func copyFile(src string, dst string, perm os.FileMode) error {
+	r, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// archiveDir writes the contents of dir to a tar.gz file at
+// filename, inside a directory named after dir.
+func archiveDir(filename string, dir string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+	base := filepath.Base(dir)
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(filepath.Join(base, rel))
+		if info.IsDir() {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		r, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		_, err = io.Copy(tw, r)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gw.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
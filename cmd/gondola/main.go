@@ -26,7 +26,7 @@ var (
 			Help:    "Create a new Gondola project",
 			Usage:   "<dir>",
 			Func:    newCommand,
-			Options: &newOptions{Template: "hello"},
+			Options: &newOptions{Template: skeletonTemplate, Orm: "sqlite"},
 		},
 		{
 			Name:     "build",
@@ -34,7 +34,7 @@ var (
 			Usage:    "[package-1] [package-2] ... [package-n]",
 			LongHelp: buildHelp,
 			Func:     buildCommand,
-			Options:  &buildOptions{Go: "go"},
+			Options:  &buildOptions{Go: "go", Include: "tmpl,assets,app.conf"},
 		},
 		{
 			Name: "clean",
@@ -84,15 +84,18 @@ type newOptions struct {
 	Template string `help:"Project template to use"`
 	List     bool   `help:"List available project templates"`
 	Gae      bool   `help:"Create an App Engine hybrid project"`
+	Orm      string `help:"ORM backend used by the skeleton template (sqlite, postgres, mysql or none)"`
+	Users    bool   `help:"Include gnd.la/apps/users in the skeleton template"`
 }
 
 func newCommand(args []string, opts *newOptions) error {
-	tmpls, err := getAvailableTemplates()
-	if err != nil {
-		return err
-	}
 	if opts.List {
 		w := tabwriter.NewWriter(os.Stdout, 8, 4, 2, ' ', 0)
+		fmt.Fprintf(w, "%s:\t%s\n", skeletonTemplate, skeletonDescription)
+		tmpls, err := getAvailableTemplates()
+		if err != nil {
+			log.Warningf("error fetching remote templates: %s", err)
+		}
 		for _, v := range tmpls {
 			fmt.Fprintf(w, "%s:\t%s\n", v.Name, v.Description)
 		}
@@ -102,12 +105,24 @@ func newCommand(args []string, opts *newOptions) error {
 		return errors.New("missing directory name")
 	}
 	name := args[0]
+	// Data to pass to templates
+	tmplData := map[string]interface{}{
+		"Port":             10000 + rand.Intn(20001), // random port between 10k and 30k
+		"AppSecret":        stringutil.RandomPrintable(64),
+		"DevSecret":        stringutil.RandomPrintable(64),
+		"AppEncryptionKey": stringutil.RandomPrintable(32),
+		"DevEncryptionKey": stringutil.RandomPrintable(32),
+	}
+	if opts.Template == skeletonTemplate {
+		return newSkeleton(name, opts, tmplData)
+	}
+	tmpls, err := getAvailableTemplates()
+	if err != nil {
+		return err
+	}
 	for _, v := range tmpls {
 		if v.Name == opts.Template {
-			if exists, _ := fileutil.Exists(name); exists && !isEmptyDir(name) {
-				return fmt.Errorf("%s already exists", name)
-			}
-			if err := os.MkdirAll(name, 0755); err != nil {
+			if err := createProjectDir(name); err != nil {
 				return err
 			}
 			r, err := getTemplateReader(v.URL, opts.Gae)
@@ -118,12 +133,6 @@ func newCommand(args []string, opts *newOptions) error {
 			if err != nil {
 				return err
 			}
-			// Data to pass to templates
-			tmplData := map[string]interface{}{
-				"Port":      10000 + rand.Intn(20001), // random port between 10k and 30k
-				"AppSecret": stringutil.RandomPrintable(64),
-				"DevSecret": stringutil.RandomPrintable(64),
-			}
 			for hdr != nil {
 				p := filepath.Join(name, filepath.FromSlash(hdr.Name))
 				info := hdr.FileInfo()
@@ -163,6 +172,15 @@ func newCommand(args []string, opts *newOptions) error {
 			return nil
 		}
 	}
-	available := generic.Map(tmpls, func(t *project.Template) string { return t.Name }).([]string)
+	available := append([]string{skeletonTemplate}, generic.Map(tmpls, func(t *project.Template) string { return t.Name }).([]string)...)
 	return fmt.Errorf("template %s not found, availble ones are: %s", opts.Template, strings.Join(available, ", "))
 }
+
+// createProjectDir creates the directory for a new project,
+// returning an error if it already exists and it's not empty.
+func createProjectDir(name string) error {
+	if exists, _ := fileutil.Exists(name); exists && !isEmptyDir(name) {
+		return fmt.Errorf("%s already exists", name)
+	}
+	return os.MkdirAll(name, 0755)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestSkeleton(t *testing.T, opts *newOptions) string {
+	dir, err := ioutil.TempDir("", "gondola-new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.Template = skeletonTemplate
+	project := filepath.Join(dir, "myproject")
+	if err := newCommand([]string{project}, opts); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return project
+}
+
+func TestNewSkeleton(t *testing.T) {
+	project := newTestSkeleton(t, &newOptions{Orm: "sqlite", Users: true})
+	defer os.RemoveAll(filepath.Dir(project))
+	for p := range skeletonFiles {
+		fp := filepath.Join(project, filepath.FromSlash(p))
+		if _, err := os.Stat(fp); err != nil {
+			t.Errorf("expected file %s was not generated: %s", p, err)
+			continue
+		}
+		if filepath.Ext(p) == ".go" {
+			if _, err := parser.ParseFile(token.NewFileSet(), fp, nil, parser.AllErrors); err != nil {
+				t.Errorf("generated %s does not parse: %s", p, err)
+			}
+		}
+	}
+	data, err := ioutil.ReadFile(filepath.Join(project, "app.conf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db := "sqlite:///data/myproject.db"; !strings.Contains(string(data), db) {
+		t.Errorf("expecting database %s in app.conf:\n%s", db, data)
+	}
+	// The project directory must be empty
+	if err := newCommand([]string{project}, &newOptions{Template: skeletonTemplate}); err == nil {
+		t.Error("expecting an error when generating into an existing project")
+	}
+}
+
+func TestNewSkeletonWithoutOrm(t *testing.T) {
+	project := newTestSkeleton(t, &newOptions{})
+	defer os.RemoveAll(filepath.Dir(project))
+	for _, v := range []string{"main.go", "handlers.go", "tmpl/base.html", "Dockerfile"} {
+		if _, err := os.Stat(filepath.Join(project, filepath.FromSlash(v))); err != nil {
+			t.Errorf("expected file %s was not generated: %s", v, err)
+		}
+	}
+	// Files which only make sense with an ORM are skipped
+	for _, v := range []string{"models.go", "tmpl/notes.html", "tmpl/users-base.html"} {
+		if _, err := os.Stat(filepath.Join(project, filepath.FromSlash(v))); err == nil {
+			t.Errorf("file %s should not be generated without an ORM", v)
+		}
+	}
+	for _, v := range []string{"main.go", "handlers.go"} {
+		if _, err := parser.ParseFile(token.NewFileSet(), filepath.Join(project, v), nil, parser.AllErrors); err != nil {
+			t.Errorf("generated %s does not parse: %s", v, err)
+		}
+	}
+}
+
+func TestNewSkeletonInvalidOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gondola-new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cases := []*newOptions{
+		{Template: skeletonTemplate, Orm: "nosuchorm"},
+		{Template: skeletonTemplate, Users: true},
+	}
+	for _, v := range cases {
+		if err := newCommand([]string{filepath.Join(dir, "invalid")}, v); err == nil {
+			t.Errorf("expecting an error with options %+v", v)
+		}
+	}
+	if err := newCommand(nil, &newOptions{Template: skeletonTemplate}); err == nil {
+		t.Error("expecting an error without a directory name")
+	}
+}
@@ -0,0 +1,370 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"gnd.la/log"
+)
+
+const (
+	// skeletonTemplate is the name of the built-in project template,
+	// which is generated without contacting the server.
+	skeletonTemplate = "skeleton"
+	// skeletonDescription is shown by gondola new -list
+	skeletonDescription = "Built-in project skeleton with templates, assets, models and a Dockerfile"
+)
+
+// skeletonDatabases contains the supported ORM backends for
+// the skeleton, mapped to functions which return the database
+// URL for the given project name and environment.
+var skeletonDatabases = map[string]func(name string, dev bool) string{
+	"sqlite": func(name string, dev bool) string {
+		if dev {
+			return "sqlite://" + name + "-dev.db"
+		}
+		return "sqlite:///data/" + name + ".db"
+	},
+	"postgres": func(name string, dev bool) string {
+		if dev {
+			return fmt.Sprintf("postgres://dbname=%s_dev user=%s", name, name)
+		}
+		return fmt.Sprintf("postgres://dbname=%s user=%s", name, name)
+	},
+	"mysql": func(name string, dev bool) string {
+		if dev {
+			return fmt.Sprintf("mysql://%s:%s@/%s_dev", name, name, name)
+		}
+		return fmt.Sprintf("mysql://%s:%s@/%s", name, name, name)
+	},
+	"none": nil,
+}
+
+func skeletonOrms() []string {
+	var orms []string
+	for k := range skeletonDatabases {
+		orms = append(orms, k)
+	}
+	sort.Strings(orms)
+	return orms
+}
+
+// skeletonFiles contains the files generated by the skeleton template,
+// keyed by their path relative to the project directory. They're executed
+// as text/template templates with [[ and ]] as delimiters, to avoid clashing
+// with the Gondola templates in the project. Files which end up empty
+// after executing them are not written.
+var skeletonFiles = map[string]string{
+	"main.go": `package main
+
+import (
+	"gnd.la/app"
+[[- if .Users ]]
+	"gnd.la/apps/users"
+[[- end ]]
+	"gnd.la/config"
+	"gnd.la/util/pathutil"
+)
+
+func main() {
+	config.MustParse()
+	App := app.New()
+	App.HandleAssets("/assets/", pathutil.Relative("assets"))
+[[- if .Users ]]
+	users.SetType(&User{})
+	App.SetUserFunc(users.Func)
+	App.Include("/users/", users.App, "users-base.html")
+[[- end ]]
+	App.HandleNamed("^/$", IndexHandler, IndexHandlerName)
+[[- if .Orm ]]
+	App.HandleNamed("^/notes/$", NotesHandler, NotesHandlerName)
+[[- end ]]
+	App.MustListenAndServe()
+}
+`,
+	"handlers.go": `package main
+
+import (
+	"gnd.la/app"
+[[- if .Orm ]]
+	"gnd.la/form"
+	"gnd.la/orm"
+[[- end ]]
+)
+
+const (
+	IndexHandlerName = "index"
+[[- if .Orm ]]
+	NotesHandlerName = "notes"
+[[- end ]]
+)
+
+// IndexHandler renders the home page.
+func IndexHandler(ctx *app.Context) {
+	ctx.MustExecute("index.html", nil)
+}
+[[- if .Orm ]]
+
+// NotesHandler lists the stored notes and allows
+// adding new ones.
+func NotesHandler(ctx *app.Context) {
+	note := &Note{}
+	f := form.New(ctx, note)
+	if f.Submitted() && f.IsValid() {
+		ctx.Orm().MustSave(note)
+		ctx.RedirectReverse(false, NotesHandlerName)
+		return
+	}
+	var notes []*Note
+	ctx.Orm().All().Sort("Created", orm.DESC).Limit(20).MustAll(&notes)
+	data := map[string]interface{}{
+		"Notes": notes,
+		"Form":  f,
+	}
+	ctx.MustExecute("notes.html", data)
+}
+[[- end ]]
+`,
+	"models.go": `[[ if .Orm ]]package main
+
+import (
+	"time"
+
+[[ if .Users ]]
+	"gnd.la/apps/users"
+[[- end ]]
+	"gnd.la/orm"
+)
+[[- if .Users ]]
+
+// User is the user type used by gnd.la/apps/users. Add
+// any additional fields for your users here.
+type User struct {
+	users.User
+}
+[[- end ]]
+
+// Note is an example model. Its fields are also
+// used for generating the form in NotesHandler.
+type Note struct {
+	Id      int64     ` + "`" + `orm:",primary_key,auto_increment" form:"-"` + "`" + `
+	Text    string    ` + "`" + `form:",max_length=255,label=Note"` + "`" + `
+	Created time.Time ` + "`" + `orm:",index" form:"-"` + "`" + `
+}
+
+// Save is called by the ORM before saving the note.
+func (n *Note) Save() error {
+	if n.Created.IsZero() {
+		n.Created = time.Now().UTC()
+	}
+	return nil
+}
+
+func init() {
+[[- if .Users ]]
+	orm.Register(&User{}, nil)
+[[- end ]]
+	orm.Register(&Note{}, nil)
+}
+[[ end ]]`,
+	"app.conf": `# Production configuration. Values might be overridden with
+# environment variables (e.g. GONDOLA_PORT=9000) or, for local
+# changes which should not be committed, in local.conf.
+port = [[ .Port ]]
+secret = [[ .AppSecret ]]
+encryption-key = [[ .AppEncryptionKey ]]
+[[- with .Database ]]
+database = [[ . ]]
+[[- end ]]
+`,
+	"dev.conf": `# Development configuration, used by gondola dev.
+debug = true
+template-debug = true
+port = [[ .Port ]]
+secret = [[ .DevSecret ]]
+encryption-key = [[ .DevEncryptionKey ]]
+[[- with .DevDatabase ]]
+database = [[ . ]]
+[[- end ]]
+`,
+	"tmpl/base.html": `{{/*
+  styles: css/style.css
+*/}}
+<!DOCTYPE html>
+<html>
+  <head>
+    <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <title>{{ block "Title" }}[[ .Name ]]{{ end }}</title>
+  </head>
+  <body>
+    <nav class="nav">
+      <a href="{{ reverse "index" }}">[[ .Name ]]</a>
+[[- if .Orm ]]
+      <a href="{{ reverse "notes" }}">Notes</a>
+[[- end ]]
+[[- if .Users ]]
+      {{ if @User }}
+        <a href="{{ reverse "users-sign-out" }}">Sign Out</a>
+      {{ else }}
+        <a href="{{ reverse "users-sign-in" }}">Sign In</a>
+        <a href="{{ reverse "users-sign-up" }}">Sign Up</a>
+      {{ end }}
+[[- end ]]
+    </nav>
+    <main class="content">
+      {{ block "Content" }}{{ end }}
+    </main>
+  </body>
+</html>
+{{ extend }}
+`,
+	"tmpl/index.html": `{{/*
+  extends: base.html
+*/}}
+{{ define "Content" }}
+  <h1>Welcome to [[ .Name ]]</h1>
+  <p>Edit tmpl/index.html to change this page and handlers.go to add new pages.</p>
+{{ end }}
+`,
+	"tmpl/notes.html": `[[ if .Orm ]]{{/*
+  extends: base.html
+*/}}
+{{ define "Title" }}Notes - {{ super }}{{ end }}
+{{ define "Content" }}
+  <h1>Notes</h1>
+  <form method="post" action="{{ reverse "notes" }}">
+    {{ .Form.Render }}
+    <button type="submit">Add</button>
+  </form>
+  <ul class="notes">
+    {{ range .Notes }}
+      <li>{{ .Text }} <small>{{ .Created.Format "2006-01-02 15:04" }}</small></li>
+    {{ else }}
+      <li>There are no notes yet.</li>
+    {{ end }}
+  </ul>
+{{ end }}
+[[ end ]]`,
+	"tmpl/users-base.html": `[[ if .Users ]]{{/*
+  extends: base.html
+*/}}
+{{ define "Content" }}{{ app }}{{ end }}
+[[ end ]]`,
+	"assets/css/style.css": `body {
+    font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif;
+    margin: 0;
+    color: #333;
+}
+
+.nav {
+    padding: 1em 2em;
+    background: #f5f5f5;
+    border-bottom: 1px solid #ddd;
+}
+
+.nav a {
+    margin-right: 1em;
+}
+
+.content {
+    max-width: 960px;
+    margin: 0 auto;
+    padding: 1em 2em;
+}
+`,
+	"Dockerfile": `FROM golang:1 AS build
+WORKDIR /src
+COPY . .
+RUN go build -o /out/[[ .Name ]] . && \
+    cp -r tmpl assets app.conf /out/
+
+FROM debian:stable-slim
+RUN apt-get update && apt-get install -y --no-install-recommends ca-certificates && \
+    rm -rf /var/lib/apt/lists/*
+[[- if eq .Orm "sqlite" ]]
+RUN mkdir /data
+VOLUME /data
+[[- end ]]
+WORKDIR /app
+COPY --from=build /out/ /app/
+EXPOSE [[ .Port ]]
+CMD ["/app/[[ .Name ]]"]
+`,
+	".dockerignore": `.git
+*.db
+dev.conf
+local.conf
+`,
+	".gitignore": `/[[ .Name ]]
+*.db
+local.conf
+`,
+}
+
+// newSkeleton generates the built-in project skeleton in the
+// given directory, creating it if needed.
+func newSkeleton(dir string, opts *newOptions, data map[string]interface{}) error {
+	orm := opts.Orm
+	if orm == "" {
+		orm = "none"
+	}
+	dbFunc, ok := skeletonDatabases[orm]
+	if !ok {
+		return fmt.Errorf("invalid ORM backend %q, available ones are: %s", opts.Orm, strings.Join(skeletonOrms(), ", "))
+	}
+	if dbFunc == nil {
+		if opts.Users {
+			return fmt.Errorf("the users app requires an ORM backend, use -orm to select one")
+		}
+		orm = ""
+	}
+	if err := createProjectDir(dir); err != nil {
+		return err
+	}
+	name := filepath.Base(dir)
+	data["Name"] = name
+	data["Orm"] = orm
+	data["Users"] = opts.Users
+	if dbFunc != nil {
+		data["Database"] = dbFunc(name, false)
+		data["DevDatabase"] = dbFunc(name, true)
+	}
+	for p, text := range skeletonFiles {
+		tmpl, err := template.New(p).Delims("[[", "]]").Parse(text)
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return err
+		}
+		if len(bytes.TrimSpace(buf.Bytes())) == 0 {
+			continue
+		}
+		contents := buf.Bytes()
+		if filepath.Ext(p) == ".go" {
+			formatted, err := format.Source(contents)
+			if err != nil {
+				return fmt.Errorf("error formatting %s: %s", p, err)
+			}
+			contents = formatted
+		}
+		fp := filepath.Join(dir, filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(fp), 0755); err != nil {
+			return err
+		}
+		log.Debugf("writing file %s", fp)
+		if err := ioutil.WriteFile(fp, contents, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
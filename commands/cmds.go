@@ -3,13 +3,25 @@ package commands
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"gnd.la/app"
+	"gnd.la/config"
+	"gnd.la/i18n"
+	"gnd.la/i18n/table"
 	"gnd.la/log"
+	"gnd.la/orm"
+	"gnd.la/template"
 
 	"gopkgs.com/vfs.v1"
 )
@@ -59,6 +71,109 @@ func makeAssets(ctx *app.Context) {
 	}
 }
 
+func templateAudit(ctx *app.Context) {
+	var all bool
+	ctx.ParseParamValue("all", &all)
+	a := ctx.App()
+	if cfg := a.Config(); cfg != nil {
+		cfg.TemplateDebug = false
+	}
+	var templates []*template.Template
+	a.AddTemplateProcessor(func(t *template.Template) (*template.Template, error) {
+		t.AuditEscaping = true
+		templates = append(templates, t)
+		return t, nil
+	})
+	err := vfs.Walk(a.TemplatesFS(), "/", func(fs vfs.VFS, p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || p == "" || p[0] == '.' {
+			return err
+		}
+		if _, err := a.LoadTemplate(p); err != nil {
+			log.Errorf("error loading template %s: %s", p, err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("error listing templates: %s", err)
+	}
+	// Templates included from several others are
+	// audited multiple times, print them just once.
+	seen := make(map[string]bool)
+	count := 0
+	issues := 0
+	for _, t := range templates {
+		for _, v := range t.EscapeAudit() {
+			s := v.String()
+			if seen[s] {
+				continue
+			}
+			seen[s] = true
+			count++
+			if len(v.Issues) > 0 {
+				issues++
+			} else if !all {
+				continue
+			}
+			fmt.Println(s)
+		}
+	}
+	fmt.Printf("%d interpolations audited, %d with issues\n", count, issues)
+}
+
+func printConfig(ctx *app.Context) {
+	var files []string
+	for _, v := range config.Filenames() {
+		if _, err := os.Stat(v); err == nil {
+			files = append(files, v)
+		}
+	}
+	if env := config.Env(); env != "" {
+		fmt.Printf("Environment: %s\n", env)
+	}
+	fmt.Printf("Files: %s\n\n", strings.Join(files, ", "))
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, '\t', 0)
+	fmt.Fprint(w, "NAME\tVALUE\tSOURCE\n")
+	for _, v := range config.Values() {
+		var value string
+		switch x := v.Value.(type) {
+		case *config.URL:
+			if x != nil {
+				// Might contain a password
+				value = x.Redacted()
+			}
+		default:
+			value = fmt.Sprint(v.Value)
+		}
+		if v.Secret {
+			value = "********"
+		}
+		source := v.Source
+		if source == "" {
+			source = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", v.Name, value, source)
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+}
+
+func generateConfig(ctx *app.Context) {
+	var buf bytes.Buffer
+	if err := config.WriteTemplate(&buf); err != nil {
+		panic(err)
+	}
+	var output string
+	ctx.ParseParamValue("o", &output)
+	if output == "" || output == "-" {
+		fmt.Print(buf.String())
+	} else {
+		if err := ioutil.WriteFile(output, buf.Bytes(), 0644); err != nil {
+			panic(err)
+		}
+	}
+}
+
 func printResources(ctx *app.Context) {
 	// TODO: Define an interface in package vfs, so this fails
 	// if the interface is changed or renamed.
@@ -108,14 +223,198 @@ func renderTemplate(ctx *app.Context) {
 	}
 }
 
+var translatableType = reflect.TypeOf(i18n.Translatable(nil))
+
+type translatableField struct {
+	name  string
+	index []int
+}
+
+// translatableFields returns the i18n.Translatable fields in
+// the given struct type, including the ones in nested structs.
+func translatableFields(typ reflect.Type, prefix string, index []int) []*translatableField {
+	var fields []*translatableField
+	for ii := 0; ii < typ.NumField(); ii++ {
+		f := typ.Field(ii)
+		if f.PkgPath != "" {
+			continue
+		}
+		idx := append(append([]int(nil), index...), ii)
+		if f.Type == translatableType {
+			fields = append(fields, &translatableField{name: prefix + f.Name, index: idx})
+			continue
+		}
+		if f.Type.Kind() == reflect.Struct {
+			p := prefix
+			if !f.Anonymous {
+				p += f.Name + "."
+			}
+			fields = append(fields, translatableFields(f.Type, p, idx)...)
+		}
+	}
+	return fields
+}
+
+func untranslated(ctx *app.Context) {
+	var langs string
+	ctx.ParseParamValue("l", &langs)
+	var languages []string
+	if langs != "" {
+		for _, v := range strings.Split(langs, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				languages = append(languages, v)
+			}
+		}
+	} else {
+		languages = table.Registered()
+	}
+	if len(languages) == 0 {
+		panic(fmt.Errorf("no languages to check, use -l to specify them"))
+	}
+	o := ctx.Orm()
+	var tables []*orm.Table
+	if ctx.Count() > 0 {
+		for ii := 0; ii < ctx.Count(); ii++ {
+			name := ctx.IndexValue(ii)
+			tbl := o.NameTable(name)
+			if tbl == nil {
+				panic(fmt.Errorf("no model named %q", name))
+			}
+			tables = append(tables, tbl)
+		}
+	} else {
+		tables = o.Tables()
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, '\t', 0)
+	fmt.Fprint(w, "MODEL\tFIELD\tDEFAULT\tMISSING\n")
+	for _, tbl := range tables {
+		fields := translatableFields(tbl.Type(), "", nil)
+		if len(fields) == 0 {
+			continue
+		}
+		obj := reflect.New(tbl.Type())
+		iter := o.Table(tbl).Iter()
+		for iter.Next(obj.Interface()) {
+			for _, f := range fields {
+				val := obj.Elem().FieldByIndex(f.index).Interface().(i18n.Translatable)
+				if missing := val.Missing(languages...); len(missing) > 0 {
+					fmt.Fprintf(w, "%s\t%s\t%q\t%s\n", tbl.Name(), f.name, val.Default(), strings.Join(missing, ", "))
+				}
+			}
+			obj.Elem().Set(reflect.Zero(tbl.Type()))
+		}
+		if err := iter.Err(); err != nil {
+			panic(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+}
+
+func replayRequest(ctx *app.Context) {
+	var name string
+	ctx.MustParseIndexValue(0, &name)
+	var fromBlobstore bool
+	ctx.ParseParamValue("blobstore", &fromBlobstore)
+	var r io.ReadCloser
+	var err error
+	if fromBlobstore {
+		r, err = ctx.Blobstore().Open(name)
+	} else {
+		r, err = os.Open(name)
+	}
+	if err != nil {
+		panic(err)
+	}
+	rec, err := app.ReadRecordedRequest(r)
+	r.Close()
+	if err != nil {
+		panic(fmt.Errorf("error reading recorded request: %s", err))
+	}
+	req, err := rec.Request()
+	if err != nil {
+		panic(err)
+	}
+	a := ctx.App()
+	// Don't record the request again if it fails
+	if cfg := a.Config(); cfg != nil {
+		cfg.RecordRequests = ""
+	}
+	if rec.BodyTruncated {
+		log.Warningf("the recorded request body was truncated, replaying the first %d bytes", len(rec.Body))
+	}
+	log.Infof("replaying %s %s (recorded at %s with status %d)", rec.Method, rec.RequestURI, rec.Time, rec.StatusCode)
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, req)
+	fmt.Printf("%s %d %s\n", req.Proto, w.Code, http.StatusText(w.Code))
+	w.Header().Write(os.Stdout)
+	fmt.Println()
+	os.Stdout.Write(w.Body.Bytes())
+}
+
+func maintenance(ctx *app.Context) {
+	cfg := ctx.App().Config()
+	if cfg == nil || cfg.MaintenanceFile == "" {
+		panic(errors.New("MaintenanceFile is not set in the app configuration"))
+	}
+	var mode string
+	ctx.ParseIndexValue(0, &mode)
+	switch mode {
+	case "":
+	case "on":
+		data := []byte(time.Now().UTC().Format(time.RFC3339) + "\n")
+		if err := ioutil.WriteFile(cfg.MaintenanceFile, data, 0644); err != nil {
+			panic(err)
+		}
+	case "off":
+		if err := os.Remove(cfg.MaintenanceFile); err != nil && !os.IsNotExist(err) {
+			panic(err)
+		}
+	default:
+		panic(fmt.Errorf("invalid maintenance mode %q, must be on or off", mode))
+	}
+	status := "off"
+	if _, err := os.Stat(cfg.MaintenanceFile); err == nil {
+		status = "on"
+	}
+	fmt.Printf("maintenance mode is %s\n", status)
+}
+
 func init() {
 	Register(catFile, &Options{
 		Help:  "Prints a file from the blobstore to the stdout",
 		Flags: Flags(BoolFlag("meta", false, "Print file metatada instead of file data")),
 	})
+	Register(printConfig, &Options{
+		Name: "config",
+		Help: "Prints the effective configuration values and where they were taken from",
+	})
+	Register(generateConfig, &Options{
+		Help:  "Prints a config file with all the known options commented out, including their defaults and environment variables",
+		Flags: Flags(StringFlag("o", "", "Output file. If empty or -, outputs to stdout")),
+	})
 	Register(makeAssets, &Options{
 		Help: "Pre-compile and bundle all app assets",
 	})
+	Register(templateAudit, &Options{
+		Help:  "Lists the escaping context of the values interpolated in the app HTML templates, flagging raw and mismatched content",
+		Flags: Flags(BoolFlag("all", false, "List all the interpolations, not just the ones with issues")),
+	})
+	Register(untranslated, &Options{
+		Help:  "Lists the model fields of type i18n.Translatable with missing translations",
+		Usage: "[-l languages] [model...]",
+		Flags: Flags(StringFlag("l", "", "Comma separated list of languages to check. If empty, all the languages with a translation table are checked")),
+	})
+	Register(replayRequest, &Options{
+		Help:  "Replays a request recorded with the RecordRequests configuration option, printing the response",
+		Usage: "[-blobstore] <file>",
+		Flags: Flags(BoolFlag("blobstore", false, "Read the recorded request from the app blobstore, using the argument as its id")),
+	})
+	Register(maintenance, &Options{
+		Help:  "Enables or disables maintenance mode in the running app by creating or removing the file set in MaintenanceFile. Without arguments, prints the current mode",
+		Usage: "[on|off]",
+	})
 	Register(printResources, &Options{Name: "_print-resources"})
 	Register(renderTemplate, &Options{
 		Name:  "_render-template",
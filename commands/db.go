@@ -0,0 +1,369 @@
+package commands
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gnd.la/app"
+	"gnd.la/config"
+	"gnd.la/log"
+)
+
+// Database dumps are produced with the native tools for each
+// backend (pg_dump and psql for postgres, mysqldump and mysql for
+// mysql), which must be available in the $PATH, while sqlite
+// databases are copied with VACUUM INTO, which produces a consistent
+// snapshot even while the database is in use. Dumps might be
+// compressed with gzip, which is automatically detected when loading
+// them.
+
+var errNoDatabase = errors.New("the app has no database configured")
+
+func databaseURL(ctx *app.Context) *config.URL {
+	cfg := ctx.App().Config()
+	if cfg == nil || cfg.Database == nil {
+		panic(errNoDatabase)
+	}
+	return cfg.Database
+}
+
+// postgresConnInfo returns the connection string for the
+// postgres tools, in the keyword=value format used by the
+// ORM configuration.
+func postgresConnInfo(u *config.URL) string {
+	info := u.Value
+	keys := make([]string, 0, len(u.Query))
+	for k := range u.Query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		info += " " + k + "=" + u.Query[k]
+	}
+	return info
+}
+
+// mysqlArgs returns the connection arguments for the mysql tools
+// and the password, parsed from a DSN in the form used by the ORM
+// configuration: [user[:password]@][net[(addr)]]/dbname[?params].
+func mysqlArgs(u *config.URL) ([]string, string, error) {
+	dsn := u.Value
+	if p := strings.IndexByte(dsn, '?'); p >= 0 {
+		dsn = dsn[:p]
+	}
+	slash := strings.LastIndexByte(dsn, '/')
+	if slash < 0 || slash == len(dsn)-1 {
+		return nil, "", fmt.Errorf("invalid mysql DSN %q, no database name", u.Value)
+	}
+	dbname := dsn[slash+1:]
+	dsn = dsn[:slash]
+	var args []string
+	var password string
+	if at := strings.LastIndexByte(dsn, '@'); at >= 0 {
+		user := dsn[:at]
+		dsn = dsn[at+1:]
+		if p := strings.IndexByte(user, ':'); p >= 0 {
+			password = user[p+1:]
+			user = user[:p]
+		}
+		args = append(args, "--user="+user)
+	}
+	if p := strings.IndexByte(dsn, '('); p >= 0 && strings.HasSuffix(dsn, ")") {
+		proto, addr := dsn[:p], dsn[p+1:len(dsn)-1]
+		switch proto {
+		case "unix":
+			args = append(args, "--socket="+addr)
+		default:
+			host := addr
+			if h, port, ok := splitHostPort(addr); ok {
+				host = h
+				args = append(args, "--port="+port)
+			}
+			args = append(args, "--host="+host, "--protocol=tcp")
+		}
+	}
+	return append(args, dbname), password, nil
+}
+
+func splitHostPort(addr string) (string, string, bool) {
+	p := strings.LastIndexByte(addr, ':')
+	if p < 0 || strings.HasSuffix(addr, "]") {
+		return "", "", false
+	}
+	return strings.Trim(addr[:p], "[]"), addr[p+1:], true
+}
+
+// databaseCommand returns the command for dumping the
+// database if dump is true or loading it otherwise.
+func databaseCommand(u *config.URL, dump bool) (*exec.Cmd, error) {
+	switch u.Scheme {
+	case "postgres":
+		info := "--dbname=" + postgresConnInfo(u)
+		if dump {
+			return exec.Command("pg_dump", "--no-owner", "--no-privileges", "--clean", "--if-exists", info), nil
+		}
+		return exec.Command("psql", "--quiet", "--single-transaction", "--set=ON_ERROR_STOP=1", info), nil
+	case "mysql":
+		args, password, err := mysqlArgs(u)
+		if err != nil {
+			return nil, err
+		}
+		var cmd *exec.Cmd
+		if dump {
+			cmd = exec.Command("mysqldump", append([]string{"--single-transaction", "--routines"}, args...)...)
+		} else {
+			cmd = exec.Command("mysql", args...)
+		}
+		if password != "" {
+			// Don't pass the password as an argument,
+			// since it would be visible to other users
+			cmd.Env = append(os.Environ(), "MYSQL_PWD="+password)
+		}
+		return cmd, nil
+	}
+	return nil, fmt.Errorf("can't dump or load %s databases", u.Scheme)
+}
+
+func isSqlite(u *config.URL) bool {
+	return u.Scheme == "sqlite" || u.Scheme == "sqlite3"
+}
+
+// dumpSqlite writes a copy of the sqlite database used by
+// the given context to w.
+func dumpSqlite(ctx *app.Context, w io.Writer) error {
+	db := ctx.Orm().SqlDB()
+	if db == nil {
+		return errNoDatabase
+	}
+	dir, err := ioutil.TempDir("", "dump-db")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	p := filepath.Join(dir, "dump.db")
+	if _, err := db.Exec("VACUUM INTO " + db.QuoteString(p)); err != nil {
+		return err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// loadSqlite replaces the sqlite database used by the given
+// context with the one read from r.
+func loadSqlite(ctx *app.Context, u *config.URL, r io.Reader) error {
+	// Write to a temporary file and then replace the database,
+	// so it's not left half written if there's an error.
+	tmp, err := ioutil.TempFile(filepath.Dir(u.Value), filepath.Base(u.Value)+".load")
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tmp, r)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		// Close the connection to the old database, otherwise
+		// it might keep writing to it after it's been replaced.
+		if o, oerr := ctx.App().Orm(); oerr == nil {
+			err = o.Close()
+		}
+	}
+	if err == nil {
+		// Remove the journals for the old database, so they're
+		// not applied to the new one.
+		for _, v := range []string{"-wal", "-shm", "-journal"} {
+			if rerr := os.Remove(u.Value + v); rerr != nil && !os.IsNotExist(rerr) {
+				err = rerr
+				break
+			}
+		}
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), u.Value)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func dumpDatabase(ctx *app.Context, u *config.URL, w io.Writer) error {
+	if isSqlite(u) {
+		return dumpSqlite(ctx, w)
+	}
+	cmd, err := databaseCommand(u, true)
+	if err != nil {
+		return err
+	}
+	cmd.Stdout = w
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error running %s: %s", cmd.Path, err)
+	}
+	return nil
+}
+
+func loadDatabase(ctx *app.Context, u *config.URL, r io.Reader) error {
+	if isSqlite(u) {
+		return loadSqlite(ctx, u, r)
+	}
+	cmd, err := databaseCommand(u, false)
+	if err != nil {
+		return err
+	}
+	cmd.Stdin = r
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error running %s: %s", cmd.Path, err)
+	}
+	return nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func dumpDB(ctx *app.Context) {
+	u := databaseURL(ctx)
+	var output string
+	ctx.ParseIndexValue(0, &output)
+	var compress, toBlobstore bool
+	ctx.ParseParamValue("gzip", &compress)
+	ctx.ParseParamValue("blobstore", &toBlobstore)
+	if strings.HasSuffix(output, ".gz") {
+		compress = true
+	}
+	var w io.WriteCloser
+	var id string
+	switch {
+	case toBlobstore:
+		f, err := ctx.Blobstore().Create()
+		if err != nil {
+			panic(err)
+		}
+		w = f
+		id = f.Id()
+	case output == "" || output == "-":
+		w = nopWriteCloser{os.Stdout}
+	default:
+		f, err := os.Create(output)
+		if err != nil {
+			panic(err)
+		}
+		w = f
+	}
+	out := io.Writer(w)
+	var gz *gzip.Writer
+	if compress {
+		gz = gzip.NewWriter(w)
+		out = gz
+	}
+	err := dumpDatabase(ctx, u, out)
+	if gz != nil {
+		if gerr := gz.Close(); err == nil {
+			err = gerr
+		}
+	}
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		switch {
+		case toBlobstore:
+			ctx.Blobstore().Remove(id)
+		case output != "" && output != "-":
+			os.Remove(output)
+		}
+		panic(err)
+	}
+	if toBlobstore {
+		log.Infof("database dump stored in the blobstore with id %s", id)
+		fmt.Println(id)
+	}
+}
+
+func loadDB(ctx *app.Context) {
+	u := databaseURL(ctx)
+	var yes bool
+	ctx.ParseParamValue("y", &yes)
+	if !yes {
+		panic(errors.New("load-db replaces the database contents, use -y to confirm"))
+	}
+	var input string
+	ctx.ParseIndexValue(0, &input)
+	var fromBlobstore bool
+	ctx.ParseParamValue("blobstore", &fromBlobstore)
+	var r io.ReadCloser
+	switch {
+	case fromBlobstore:
+		if input == "" {
+			panic(errors.New("missing blobstore id"))
+		}
+		f, err := ctx.Blobstore().Open(input)
+		if err != nil {
+			panic(err)
+		}
+		r = f
+	case input == "" || input == "-":
+		r = ioutil.NopCloser(os.Stdin)
+	default:
+		f, err := os.Open(input)
+		if err != nil {
+			panic(err)
+		}
+		r = f
+	}
+	defer r.Close()
+	br := bufio.NewReader(r)
+	in := io.Reader(br)
+	if magic, _ := br.Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			panic(err)
+		}
+		defer gz.Close()
+		in = gz
+	}
+	if err := loadDatabase(ctx, u, in); err != nil {
+		panic(err)
+	}
+	log.Infof("database loaded")
+}
+
+func init() {
+	Register(dumpDB, &Options{
+		Name:  "dump-db",
+		Help:  "Dumps the app database to the given file or to the stdout, using the native tools for postgres and mysql or VACUUM INTO for sqlite",
+		Usage: "[-gzip] [-blobstore] [file]",
+		Flags: Flags(
+			BoolFlag("gzip", false, "Compress the dump with gzip. Enabled by default for files ending with .gz"),
+			BoolFlag("blobstore", false, "Store the dump in the app blobstore, printing its id"),
+		),
+	})
+	Register(loadDB, &Options{
+		Name:  "load-db",
+		Help:  "Loads a dump created by dump-db into the app database, replacing its contents. The app must not be running during a load",
+		Usage: "-y [-blobstore] [file]",
+		Flags: Flags(
+			BoolFlag("y", false, "Confirm replacing the database contents"),
+			BoolFlag("blobstore", false, "Read the dump from the app blobstore, using the argument as its id"),
+		),
+	})
+}
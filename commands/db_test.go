@@ -0,0 +1,177 @@
+package commands
+
+import (
+	"bytes"
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/config"
+	_ "gnd.la/orm/driver/sqlite"
+)
+
+func TestPostgresConnInfo(t *testing.T) {
+	cases := map[string]string{
+		"postgres://dbname=test":                          "dbname=test",
+		"postgres://dbname=test user=foo":                 "dbname=test user=foo",
+		"postgres://dbname=test?sslmode=disable&user=foo": "dbname=test sslmode=disable user=foo",
+		"postgres://dbname=test?user=foo&sslmode=disable": "dbname=test sslmode=disable user=foo",
+	}
+	for k, v := range cases {
+		if info := postgresConnInfo(config.MustParseURL(k)); info != v {
+			t.Errorf("expecting conn info %q for %s, got %q instead", v, k, info)
+		}
+	}
+}
+
+func TestMysqlArgs(t *testing.T) {
+	cases := []struct {
+		dsn      string
+		args     []string
+		password string
+	}{
+		{"mysql:///test", []string{"test"}, ""},
+		{"mysql://foo@/test", []string{"--user=foo", "test"}, ""},
+		{"mysql://foo:bar@/test?charset=utf8", []string{"--user=foo", "test"}, "bar"},
+		{"mysql://foo:b@r@/test", []string{"--user=foo", "test"}, "b@r"},
+		{"mysql://foo@unix(/tmp/mysql.sock)/test", []string{"--user=foo", "--socket=/tmp/mysql.sock", "test"}, ""},
+		{"mysql://foo@tcp(localhost)/test", []string{"--user=foo", "--host=localhost", "--protocol=tcp", "test"}, ""},
+		{"mysql://foo@tcp(localhost:3307)/test", []string{"--user=foo", "--port=3307", "--host=localhost", "--protocol=tcp", "test"}, ""},
+		{"mysql://tcp([::1]:3307)/test", []string{"--port=3307", "--host=::1", "--protocol=tcp", "test"}, ""},
+	}
+	for _, v := range cases {
+		args, password, err := mysqlArgs(config.MustParseURL(v.dsn))
+		if err != nil {
+			t.Errorf("error parsing %s: %s", v.dsn, err)
+			continue
+		}
+		if !reflect.DeepEqual(args, v.args) || password != v.password {
+			t.Errorf("expecting args %q and password %q for %s, got %q and %q instead", v.args, v.password, v.dsn, args, password)
+		}
+	}
+	for _, v := range []string{"mysql://foo@tcp(localhost)", "mysql://foo@tcp(localhost)/"} {
+		if _, _, err := mysqlArgs(config.MustParseURL(v)); err == nil {
+			t.Errorf("expecting an error parsing %s", v)
+		}
+	}
+}
+
+func TestSplitHostPort(t *testing.T) {
+	cases := []struct {
+		addr string
+		host string
+		port string
+		ok   bool
+	}{
+		{"localhost:3306", "localhost", "3306", true},
+		{"127.0.0.1:3306", "127.0.0.1", "3306", true},
+		{"[::1]:3306", "::1", "3306", true},
+		{"localhost", "", "", false},
+		{"[::1]", "", "", false},
+	}
+	for _, v := range cases {
+		host, port, ok := splitHostPort(v.addr)
+		if host != v.host || port != v.port || ok != v.ok {
+			t.Errorf("expecting %q, %q, %v for %s, got %q, %q, %v instead", v.host, v.port, v.ok, v.addr, host, port, ok)
+		}
+	}
+}
+
+func TestDumpSqlite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dump-db-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	u := config.MustParseURL("sqlite://" + filepath.Join(dir, "test.db"))
+	a := app.New()
+	a.Config().Database = u
+	ctx := a.NewContext(nil)
+	defer a.CloseContext(ctx)
+	db := ctx.Orm().SqlDB()
+	for _, v := range []string{
+		"PRAGMA journal_mode = WAL",
+		"CREATE TABLE foo (id INTEGER PRIMARY KEY)",
+		"INSERT INTO foo (id) VALUES (1), (2), (3)",
+	} {
+		if _, err := db.Exec(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var buf bytes.Buffer
+	if err := dumpDatabase(ctx, u, &buf); err != nil {
+		t.Fatal(err)
+	}
+	p := filepath.Join(dir, "dump.db")
+	if err := ioutil.WriteFile(p, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	dump, err := sql.Open("sqlite3", p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dump.Close()
+	var count int
+	if err := dump.QueryRow("SELECT COUNT(*) FROM foo").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Errorf("expecting 3 rows in the dump, got %d", count)
+	}
+}
+
+func TestLoadSqlite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "load-db-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	u := config.MustParseURL("sqlite://" + filepath.Join(dir, "test.db"))
+	a := app.New()
+	a.Config().Database = u
+	ctx := a.NewContext(nil)
+	defer a.CloseContext(ctx)
+	db := ctx.Orm().SqlDB()
+	for _, v := range []string{
+		"PRAGMA journal_mode = WAL",
+		"CREATE TABLE foo (id INTEGER PRIMARY KEY)",
+		"INSERT INTO foo (id) VALUES (1), (2), (3)",
+	} {
+		if _, err := db.Exec(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var buf bytes.Buffer
+	if err := dumpDatabase(ctx, u, &buf); err != nil {
+		t.Fatal(err)
+	}
+	// This row is only in the WAL, it must not end up
+	// in the loaded database.
+	if _, err := db.Exec("INSERT INTO foo (id) VALUES (4)"); err != nil {
+		t.Fatal(err)
+	}
+	if err := loadDatabase(ctx, u, &buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{"-wal", "-shm"} {
+		if _, err := os.Stat(u.Value + v); !os.IsNotExist(err) {
+			t.Errorf("%s file for the old database was not removed", v)
+		}
+	}
+	loaded, err := sql.Open("sqlite3", u.Value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer loaded.Close()
+	var count int
+	if err := loaded.QueryRow("SELECT COUNT(*) FROM foo").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Errorf("expecting 3 rows in the loaded database, got %d", count)
+	}
+}
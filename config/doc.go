@@ -2,5 +2,11 @@
 //
 // Configuration values are defined using a struct, which can be tagged
 // to include default values and help strings. Then, values can be read
-// from a config file or specified in the command line.
+// from a config file, environment variables or the command line. Values
+// might also reference files containing secrets. See Register for
+// the details.
+//
+// The configuration can be reloaded at runtime using Reload, which
+// validates the new values before applying them. See gnd.la/config/reload
+// for reloading it automatically when the config file changes.
 package config
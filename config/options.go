@@ -0,0 +1,134 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gnd.la/util/types"
+)
+
+// Option describes a registered configuration option,
+// as returned by Options.
+type Option struct {
+	// Name is the config key (e.g. log-debug).
+	Name string
+	// Section is the name of the struct type which declares
+	// the option (e.g. gnd.la/app.Config).
+	Section string
+	// Help is the help text from the "help" struct tag.
+	Help string
+	// Type is the Go type of the option (e.g. int).
+	Type string
+	// Default is the default value for the option, formatted as
+	// it would appear in a config file. It's empty for options
+	// without a default value.
+	Default string
+	// Env is the environment variable which overrides
+	// the option (e.g. GONDOLA_LOG_DEBUG).
+	Env string
+	// Flag is the command line flag which overrides
+	// the option (e.g. -log-debug).
+	Flag string
+}
+
+// Options returns all the options from the registered config structs
+// (see Register), in the order they were registered. Options declared
+// by the same struct are returned in the order they were declared.
+func Options() []*Option {
+	var opts []*Option
+	for _, e := range registry {
+		value := reflect.New(e.initial.Type()).Elem()
+		value.Set(e.initial)
+		opts = append(opts, valueOptions(value, typeName(value.Type()))...)
+	}
+	return opts
+}
+
+func valueOptions(value reflect.Value, section string) []*Option {
+	var opts []*Option
+	typ := value.Type()
+	for ii := 0; ii < value.NumField(); ii++ {
+		field := value.Field(ii)
+		if field.Type().Kind() == reflect.Struct {
+			opts = append(opts, valueOptions(field, section)...)
+			continue
+		}
+		sfield := typ.Field(ii)
+		def := sfield.Tag.Get("default")
+		if def == "" {
+			def = formatValue(field)
+		}
+		name := parameterName(sfield.Name)
+		opts = append(opts, &Option{
+			Name:    name,
+			Section: section,
+			Help:    sfield.Tag.Get("help"),
+			Type:    field.Type().String(),
+			Default: def,
+			Env:     envName(sfield.Name),
+			Flag:    "-" + name,
+		})
+	}
+	return opts
+}
+
+func typeName(typ reflect.Type) string {
+	if typ.PkgPath() == "" || typ.Name() == "" {
+		return typ.String()
+	}
+	return typ.PkgPath() + "." + typ.Name()
+}
+
+// formatValue returns the given value formatted as it would
+// appear in a config file, or an empty string for nil values.
+func formatValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return ""
+		}
+	case reflect.Slice:
+		s := make([]string, v.Len())
+		for ii := range s {
+			s[ii] = types.ToString(v.Index(ii).Interface())
+		}
+		return strings.Join(s, ", ")
+	case reflect.Map:
+		s := make([]string, 0, v.Len())
+		for _, key := range v.MapKeys() {
+			s = append(s, types.ToString(key.Interface())+"="+types.ToString(v.MapIndex(key).Interface()))
+		}
+		sort.Strings(s)
+		return strings.Join(s, ", ")
+	}
+	return types.ToString(v.Interface())
+}
+
+// WriteTemplate writes a config file to w with all the registered
+// options (see Options), commented out and set to their default
+// values, including their help text and the environment variables
+// which override them.
+func WriteTemplate(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "# Configuration file, remove the leading # to set an option.\n")
+	fmt.Fprintf(bw, "# Options might be also set with environment variables (%s*)\n", EnvPrefix)
+	fmt.Fprintf(bw, "# or command line flags, which take precedence over this file.\n")
+	section := ""
+	for _, v := range Options() {
+		if v.Section != section {
+			section = v.Section
+			fmt.Fprintf(bw, "\n##\n## %s\n##\n", section)
+		}
+		bw.WriteByte('\n')
+		if v.Help != "" {
+			fmt.Fprintf(bw, "# %s\n", v.Help)
+		}
+		fmt.Fprintf(bw, "# Type: %s, environment variable: %s\n", v.Type, v.Env)
+		fmt.Fprintf(bw, "# %s = %s\n", v.Name, v.Default)
+	}
+	return bw.Flush()
+}
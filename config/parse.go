@@ -4,7 +4,9 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
@@ -16,14 +18,41 @@ import (
 	"gnd.la/util/types"
 )
 
+const (
+	// EnvPrefix is the prefix for the environment variables which
+	// override the config values. See Register for details.
+	EnvPrefix = "GONDOLA_"
+	// secretPrefix is the prefix for values referencing
+	// secret files. See resolveSecret.
+	secretPrefix = "secret://"
+	// LocalName is the name, without the extension, of the
+	// local config file. See Filenames.
+	LocalName = "local"
+	// sourceDefault is the source for values set from
+	// the "default" struct tag.
+	sourceDefault = "default"
+)
+
 var (
 	DefaultFilename = pathutil.Relative("app.conf")
 	configName      *string
+	configEnv       *string
+	// parsed contains the fields parsed by Parse or Reload,
+	// used by Values.
+	parsed fieldMap
+	// flagValues contains the values for the flags defined
+	// by Parse, used by Reload to keep their precedence.
+	flagValues varMap
 )
 
 type fieldValue struct {
 	Value reflect.Value
 	Tag   reflect.StructTag
+	// Source indicates where the value was taken
+	// from, while Secret is true if it was read from
+	// a secret file. See Values.
+	Source string
+	Secret bool
 }
 
 type fieldMap map[string]*fieldValue
@@ -32,7 +61,7 @@ func (f fieldMap) Append(name string, value reflect.Value, tag reflect.StructTag
 	if _, ok := f[name]; ok {
 		return fmt.Errorf("duplicate field name %q", name)
 	}
-	f[name] = &fieldValue{value, tag}
+	f[name] = &fieldValue{Value: value, Tag: tag}
 	return nil
 }
 
@@ -48,10 +77,75 @@ func Filename() string {
 	return *configName
 }
 
+// Env returns the configuration environment (e.g. production), which
+// selects the overlay config file. It's set with the -env command line
+// flag or, if it's not provided, with the GONDOLA_ENV environment variable.
+// See Filenames for details.
+func Env() string {
+	if configEnv != nil && *configEnv != "" {
+		return *configEnv
+	}
+	return os.Getenv(EnvPrefix + "ENV")
+}
+
+// Filenames returns the config files used by Parse, in the order they're
+// read. Values in each file override the ones in the previous files.
+//
+// The first one is the base file, returned by Filename. Then, if Env returns
+// a non-empty string, the file named after the environment in the same
+// directory and with the same extension as the base file. Finally, the local
+// file (LocalName plus the extension), which is intended for overrides which
+// are not checked into version control. e.g. with the default config file
+// and -env=production, the files would be app.conf, production.conf and
+// local.conf.
+//
+// The base file is only required if it was explicitly provided with the
+// -config flag and the local file is always optional, while the environment
+// file must exist when an environment is set.
+func Filenames() []string {
+	base := Filename()
+	if base == "" {
+		return nil
+	}
+	dir := filepath.Dir(base)
+	ext := filepath.Ext(base)
+	files := []string{base}
+	if env := Env(); env != "" {
+		files = append(files, filepath.Join(dir, env+ext))
+	}
+	return append(files, filepath.Join(dir, LocalName+ext))
+}
+
 func parameterName(name string) string {
 	return stringutil.CamelCaseToLower(name, "-")
 }
 
+// envName returns the environment variable name used for
+// overriding the given field. e.g. FooBar => GONDOLA_FOO_BAR.
+func envName(name string) string {
+	return EnvPrefix + strings.ToUpper(strings.Replace(parameterName(name), "-", "_", -1))
+}
+
+// resolveSecret returns the contents of the referenced file if
+// raw takes the form secret:///path/to/secret. Trailing newlines
+// are removed from the file contents. If the file can't be read
+// (e.g. it doesn't exist or it's a directory), an error is returned.
+// Any other values are returned unchanged.
+func resolveSecret(raw string) (string, error) {
+	if !strings.HasPrefix(raw, secretPrefix) {
+		return raw, nil
+	}
+	p := raw[len(secretPrefix):]
+	if !filepath.IsAbs(p) {
+		return "", fmt.Errorf("secret path %q is not absolute", p)
+	}
+	data, err := ioutil.ReadFile(p)
+	if err != nil {
+		return "", fmt.Errorf("error reading secret %s: %s", p, err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
 func hasProvidedConfig() bool {
 	var ret bool
 	flag.Visit(func(f *flag.Flag) {
@@ -165,7 +259,7 @@ func ParseReader(r io.Reader, config interface{}) error {
 	if err != nil {
 		return err
 	}
-	return parseReader(r, fields)
+	return parseReader(r, fields, "")
 }
 
 func parseFile(filename string, fields fieldMap) error {
@@ -174,10 +268,42 @@ func parseFile(filename string, fields fieldMap) error {
 		return err
 	}
 	defer f.Close()
-	return parseReader(f, fields)
+	return parseReader(f, fields, filename)
+}
+
+// parseFiles reads the files returned by Filenames. If
+// requireBase is true, the base file must exist.
+func parseFiles(fields fieldMap, requireBase bool) error {
+	hasEnv := Env() != ""
+	for ii, fn := range Filenames() {
+		if err := parseFile(fn, fields); err != nil {
+			if !os.IsNotExist(err) {
+				return err
+			}
+			if (ii == 0 && requireBase) || (ii == 1 && hasEnv) {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
-func parseReader(r io.Reader, fields fieldMap) error {
+// resolveValue resolves any secrets in raw and parses it
+// into the given field, recording the source of the value.
+func resolveValue(v *fieldValue, raw string, source string) error {
+	value, err := resolveSecret(raw)
+	if err != nil {
+		return err
+	}
+	if err := parseValue(v.Value, value); err != nil {
+		return err
+	}
+	v.Source = source
+	v.Secret = value != raw
+	return nil
+}
+
+func parseReader(r io.Reader, fields fieldMap, source string) error {
 	values, err := stringutil.ParseIni(r)
 	if err != nil {
 		return err
@@ -186,8 +312,7 @@ func parseReader(r io.Reader, fields fieldMap) error {
 	for k, v := range fields {
 		name := parameterName(k)
 		if raw, ok := values[name]; ok && raw != "" {
-			err := parseValue(v.Value, raw)
-			if err != nil {
+			if err := resolveValue(v, raw, source); err != nil {
 				return fmt.Errorf("error parsing config file field %q (struct field %q): %s", name, k, err)
 			}
 		}
@@ -195,6 +320,29 @@ func parseReader(r io.Reader, fields fieldMap) error {
 	return nil
 }
 
+// ParseEnv parses the config values from the environment variables
+// into the given config struct. No signal is emitted. Look at the
+// documentation of Register() for information on the variable names.
+func ParseEnv(config interface{}) error {
+	fields, err := configFields(config)
+	if err != nil {
+		return err
+	}
+	return parseEnv(fields)
+}
+
+func parseEnv(fields fieldMap) error {
+	for k, v := range fields {
+		name := envName(k)
+		if raw := os.Getenv(name); raw != "" {
+			if err := resolveValue(v, raw, "$"+name); err != nil {
+				return fmt.Errorf("error parsing environment variable %s (struct field %q): %s", name, k, err)
+			}
+		}
+	}
+	return nil
+}
+
 func setupFlags(fields fieldMap) (varMap, error) {
 	m := make(varMap)
 	for k, v := range fields {
@@ -251,6 +399,7 @@ func copyFlagValues(fields fieldMap, values varMap) error {
 			continue
 		}
 		val := v.Value
+		var secret bool
 		switch val.Type().Kind() {
 		case reflect.Bool:
 			value := *(values[name].(*bool))
@@ -271,22 +420,34 @@ func copyFlagValues(fields fieldMap, values varMap) error {
 			value := *(values[name].(*float64))
 			val.SetFloat(value)
 		case reflect.String:
-			value := *(values[name].(*string))
+			raw := *(values[name].(*string))
+			value, err := resolveSecret(raw)
+			if err != nil {
+				return err
+			}
 			val.SetString(value)
+			secret = value != raw
 		default:
 			if parser, ok := val.Interface().(input.Parser); ok {
 				if val.Kind() == reflect.Ptr && !val.Elem().IsValid() {
 					val.Set(reflect.New(val.Type().Elem()))
 					parser = val.Interface().(input.Parser)
 				}
-				value := *(values[name].(*string))
+				raw := *(values[name].(*string))
+				value, err := resolveSecret(raw)
+				if err != nil {
+					return err
+				}
 				if err := parser.Parse(value); err != nil {
 					return err
 				}
+				secret = value != raw
 				break
 			}
 			return fmt.Errorf("invalid type in config %s (field %q)", val.Type(), k)
 		}
+		v.Source = "-" + name
+		v.Secret = secret
 	}
 	return nil
 }
@@ -314,12 +475,19 @@ func configFields(config interface{}) (fieldMap, error) {
 }
 
 func configValueFields(value reflect.Value) (fieldMap, error) {
+	return valueFields(value, true)
+}
+
+// valueFields returns the fields in the given struct value, flattening
+// any embedded structs. If defaults is true, the values from the
+// "default" tags are assigned to the fields.
+func valueFields(value reflect.Value, defaults bool) (fieldMap, error) {
 	fields := make(fieldMap)
 	valueType := value.Type()
 	for ii := 0; ii < value.NumField(); ii++ {
 		field := value.Field(ii)
 		if field.Type().Kind() == reflect.Struct {
-			subfields, err := configFields(field.Addr().Interface())
+			subfields, err := valueFields(field, defaults)
 			if err != nil {
 				return nil, err
 			}
@@ -328,18 +496,20 @@ func configValueFields(value reflect.Value) (fieldMap, error) {
 				if err != nil {
 					return nil, err
 				}
+				fields[k].Source = v.Source
 			}
 		} else {
 			sfield := valueType.Field(ii)
-			if def := sfield.Tag.Get("default"); def != "" {
+			err := fields.Append(sfield.Name, field, sfield.Tag)
+			if err != nil {
+				return nil, err
+			}
+			if def := sfield.Tag.Get("default"); defaults && def != "" {
 				err := parseValue(field, def)
 				if err != nil {
 					return nil, fmt.Errorf("error parsing default value for field %q: %s", sfield.Name, err)
 				}
-			}
-			err := fields.Append(sfield.Name, field, sfield.Tag)
-			if err != nil {
-				return nil, err
+				fields[sfield.Name].Source = sourceDefault
 			}
 		}
 	}
@@ -392,6 +562,7 @@ func mapToString(v reflect.Value) string {
 // See those functions for information about adding your own configuration parameters.
 func Parse() error {
 	configName = flag.String("config", DefaultFilename, "Config file name")
+	configEnv = flag.String("env", "", "Config environment (e.g. production), which selects the overlay config file")
 	fields := make(fieldMap)
 	for _, v := range registry {
 		valueFields, err := configValueFields(v.value)
@@ -403,24 +574,33 @@ func Parse() error {
 		}
 	}
 	/* Setup flags before calling flag.Parse() */
-	flagValues, err := setupFlags(fields)
+	var err error
+	flagValues, err = setupFlags(fields)
 	if err != nil {
 		return err
 	}
 	/* Now parse the flags */
 	flag.Parse()
-	/* Read config file first */
-	if fn := Filename(); fn != "" {
-		if err := parseFile(fn, fields); err != nil {
-			if hasProvidedConfig() || !os.IsNotExist(err) {
-				return err
-			}
-		}
+	/* Read config files first */
+	if err := parseFiles(fields, hasProvidedConfig()); err != nil {
+		return err
+	}
+	/* Environment overrides config file */
+	if err := parseEnv(fields); err != nil {
+		return err
 	}
-	/* Command line overrides config file */
+	/* Command line overrides environment and config file */
 	if err := copyFlagValues(fields, flagValues); err != nil {
 		return err
 	}
+	for _, v := range registry {
+		if err := validate(v.value); err != nil {
+			return err
+		}
+	}
+	reloadMu.Lock()
+	parsed = fields
+	reloadMu.Unlock()
 	// Call registry functions
 	for _, v := range registry {
 		if v.f != nil {
@@ -10,7 +10,10 @@ var (
 
 type entry struct {
 	value reflect.Value
-	f     func()
+	// initial holds a copy of value at registration
+	// time, used as the starting point by Reload.
+	initial reflect.Value
+	f       func()
 }
 
 // Register is a shorthand for RegisterFunc(value, nil).
@@ -22,15 +25,30 @@ func Register(value interface{}) {
 //
 // Supported field types include bool, string, u?int(|8|6|32|62) and float(32|64). If
 // any config field type is not supported, Register will panic. Additionally,
-// two struct tags are taken into account. The "help" tag is used when to provide
+// three struct tags are taken into account. The "help" tag is used when to provide
 // a help string to the user when defining command like flags, while the "default"
 // tag is used to provide a default value for the field in case it hasn't been
-// provided as a config key nor a command line flag.
+// provided as a config key nor a command line flag. Fields holding secrets (e.g.
+// keys or passwords) should be tagged with secret:"true", so their values are
+// not displayed (see Value.Secret).
 //
-// The parsing process starts by reading the config file returned by Filename()
-// (which might be overriden by the -config command line flag), and then parses
-// any flags provided in the command line. This means any value in the config
-// file might be overriden by a command line flag.
+// The parsing process starts by reading the config files returned by Filenames()
+// (the base file might be overriden by the -config command line flag, while
+// -env selects the environment overlay), then reads the environment variables
+// and finally parses any flags provided in the command line. This means values
+// are taken, in order of precedence, from command line flags, environment
+// variables and the config files. Use Values to inspect the effective values
+// and where they were taken from.
+//
+// Environment variables are named after the flag, in uppercase, with underscores
+// instead of dashes and prefixed by EnvPrefix. e.g. the Database field can be
+// overriden with GONDOLA_DATABASE. Empty variables are ignored.
+//
+// Additionally, any value taking the form secret:///path/to/file, either in the
+// config file, the environment or the command line, is replaced by the contents
+// of the file, without any trailing newlines, which allows reading secrets from
+// e.g. /run/secrets/. If the file can't be read (e.g. it doesn't exist), parsing
+// the configuration fails.
 //
 // Go's idiomatic camel-cased struct field names are mangled into lowercase words
 // to produce the flag names and config fields. e.g. a field named "FooBar" will
@@ -49,6 +67,10 @@ func Register(value interface{}) {
 //  // This config would define the flags -my-string-value and -my-int-value
 //  // as well as the config file keys my-string-value and my-int-value.
 //
+// If the struct implements Validator, its Validate method is called after
+// parsing the configuration, making Parse fail if it returns an error. The
+// same happens when the configuration is reloaded (see Reload).
+//
 // Note that registering several structs with the same field names will cause
 // Parse to return an error. Gondola itself registers a few flags. To see them
 // all, start your app with the -h flag (e.g. ./myapp -h on Unix or myapp.exe
@@ -58,8 +80,11 @@ func RegisterFunc(value interface{}, f func()) {
 	if err != nil {
 		panic(err)
 	}
+	initial := reflect.New(val.Type()).Elem()
+	initial.Set(val)
 	registry = append(registry, &entry{
-		value: val,
-		f:     f,
+		value:   val,
+		initial: initial,
+		f:       f,
 	})
 }
@@ -0,0 +1,169 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+var (
+	reloadMu sync.Mutex
+	// valuesMu protects the registered structs
+	// while Reload applies the new values.
+	valuesMu sync.RWMutex
+)
+
+// RLock locks the registered configuration structs for reading, blocking
+// Reload from applying new values until RUnlock is called. Code reading
+// several related values which might change while the configuration is
+// reloaded should read them while holding the lock, e.g.
+//
+//	config.RLock()
+//	host, port := Config.Host, Config.Port
+//	config.RUnlock()
+func RLock() {
+	valuesMu.RLock()
+}
+
+// RUnlock undoes a single RLock call.
+func RUnlock() {
+	valuesMu.RUnlock()
+}
+
+// Validator is implemented by config structs which need
+// to validate their values. If Validate returns an error,
+// the configuration is not applied. See Parse and Reload.
+type Validator interface {
+	Validate() error
+}
+
+// Change represents a config value which was changed
+// by Reload.
+type Change struct {
+	// Name is the config key (e.g. log-debug).
+	Name string
+	// Old and New are the previous and current
+	// values of the field.
+	Old interface{}
+	New interface{}
+}
+
+func (c *Change) String() string {
+	return fmt.Sprintf("%s: %v => %v", c.Name, c.Old, c.New)
+}
+
+func validate(value reflect.Value) error {
+	if v, ok := value.Addr().Interface().(Validator); ok {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("invalid %s configuration: %s", value.Type(), err)
+		}
+	}
+	return nil
+}
+
+// Reload parses again all the configurations registered using Register
+// or RegisterFunc, reading the config files returned by Filenames() and
+// the environment variables. Values provided as command line flags keep
+// their precedence. The new values are parsed into copies of the registered
+// structs and, if any of them fails to parse or validate (see Validator),
+// an error is returned and the current configuration is left untouched.
+//
+// Otherwise, the new values are applied to all the changed structs at
+// once, while holding the lock taken by RLock, and then the functions
+// passed to RegisterFunc are called for the structs with changed values.
+// The returned slice contains the changed values, sorted by name. Code
+// reading configuration values concurrently with a reload should use
+// RLock and RUnlock to avoid observing partially updated structs.
+//
+// See gnd.la/config/reload for automatically reloading the configuration
+// when the config file changes.
+func Reload() ([]*Change, error) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	values := make([]reflect.Value, len(registry))
+	fields := make(fieldMap)
+	for ii, v := range registry {
+		val := reflect.New(v.value.Type()).Elem()
+		val.Set(v.initial)
+		valueFields, err := configValueFields(val)
+		if err != nil {
+			return nil, err
+		}
+		for k, f := range valueFields {
+			fields[k] = f
+		}
+		values[ii] = val
+	}
+	if err := parseFiles(fields, true); err != nil {
+		return nil, err
+	}
+	if err := parseEnv(fields); err != nil {
+		return nil, err
+	}
+	if flagValues != nil {
+		if err := copyFlagValues(fields, flagValues); err != nil {
+			return nil, err
+		}
+	}
+	for _, v := range values {
+		if err := validate(v); err != nil {
+			return nil, err
+		}
+	}
+	var changes []*Change
+	changed := make([]bool, len(registry))
+	for ii, v := range registry {
+		c, err := diff(v.value, values[ii])
+		if err != nil {
+			return nil, err
+		}
+		changed[ii] = len(c) > 0
+		changes = append(changes, c...)
+	}
+	valuesMu.Lock()
+	for ii, v := range registry {
+		if changed[ii] {
+			v.value.Set(values[ii])
+		}
+	}
+	parsed = fields
+	valuesMu.Unlock()
+	for ii, v := range registry {
+		if changed[ii] && v.f != nil {
+			v.f()
+		}
+	}
+	sort.Sort(changesByName(changes))
+	return changes, nil
+}
+
+func diff(old reflect.Value, cur reflect.Value) ([]*Change, error) {
+	oldFields, err := valueFields(old, false)
+	if err != nil {
+		return nil, err
+	}
+	curFields, err := valueFields(cur, false)
+	if err != nil {
+		return nil, err
+	}
+	var changes []*Change
+	for k, v := range curFields {
+		o := oldFields[k].Value.Interface()
+		n := v.Value.Interface()
+		if !reflect.DeepEqual(o, n) {
+			changes = append(changes, &Change{
+				Name: parameterName(k),
+				Old:  o,
+				New:  n,
+			})
+		}
+	}
+	return changes, nil
+}
+
+type changesByName []*Change
+
+func (c changesByName) Len() int           { return len(c) }
+func (c changesByName) Less(i, j int) bool { return c[i].Name < c[j].Name }
+func (c changesByName) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
@@ -0,0 +1,119 @@
+// Package reload implements reloading the application configuration
+// when its config file changes, without restarting the application.
+//
+// Use Watch after parsing the configuration to start watching the
+// config files:
+//
+//	config.MustParse()
+//	if _, err := reload.Watch(); err != nil {
+//		panic(err)
+//	}
+//
+// Every time any of the files changes, the configuration is reloaded using
+// config.Reload, which validates the new values before applying them.
+// If there were changes, the functions passed to config.RegisterFunc
+// are called for the changed structs and the CONFIG_CHANGED signal is emitted,
+// so subsystems can react to the new values (e.g. adjusting log levels
+// or reconnecting to a different cache).
+package reload
+
+import (
+	"io"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"gnd.la/config"
+	"gnd.la/loaders"
+	"gnd.la/log"
+	"gnd.la/signal"
+
+	"gopkgs.com/vfs.v1"
+)
+
+const (
+	// CONFIG_CHANGED is emitted after the configuration is reloaded
+	// and some of its values changed. The object is a
+	// []*gnd.la/config.Change with the changed values.
+	CONFIG_CHANGED = "gnd.la/config.changed"
+	// delay is the time waited after a change before
+	// reloading, since editors usually perform several
+	// operations when saving a file.
+	delay = 100 * time.Millisecond
+)
+
+// Watcher watches the config files for changes. Use
+// Watch to create a Watcher.
+type Watcher struct {
+	fs    vfs.VFS
+	names map[string]bool
+	mu    sync.Mutex
+	timer *time.Timer
+}
+
+// Watch starts watching the config files returned by config.Filenames,
+// reloading the configuration every time any of them changes. Errors while
+// reloading (including validation errors) are logged and the previous
+// configuration is kept. Use Close to stop watching the files.
+func Watch() (*Watcher, error) {
+	filename, err := filepath.Abs(config.Filename())
+	if err != nil {
+		return nil, err
+	}
+	fs, err := loaders.Dir(filepath.Dir(filename))
+	if err != nil {
+		return nil, err
+	}
+	// All the config files live in the same directory
+	names := make(map[string]bool)
+	for _, v := range config.Filenames() {
+		names["/"+filepath.Base(v)] = true
+	}
+	w := &Watcher{
+		fs:    fs,
+		names: names,
+	}
+	if err := loaders.Watch(fs, w.changed); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *Watcher) changed(name string, op loaders.Op) {
+	if !w.names[name] {
+		return
+	}
+	log.Debugf("config file %s changed (%s)", name, op)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+	w.timer = time.AfterFunc(delay, w.reload)
+}
+
+func (w *Watcher) reload() {
+	changes, err := config.Reload()
+	if err != nil {
+		log.Errorf("error reloading configuration from %s, keeping previous values: %s", config.Filename(), err)
+		return
+	}
+	if len(changes) == 0 {
+		return
+	}
+	log.Infof("reloaded configuration from %s, %d values changed", config.Filename(), len(changes))
+	signal.Emit(CONFIG_CHANGED, changes)
+}
+
+// Close stops watching the config files.
+func (w *Watcher) Close() error {
+	w.mu.Lock()
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+	w.mu.Unlock()
+	if c, ok := w.fs.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
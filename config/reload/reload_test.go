@@ -0,0 +1,61 @@
+package reload
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gnd.la/config"
+	"gnd.la/signal"
+)
+
+var reloadConfig struct {
+	ReloadValue string
+}
+
+func init() {
+	config.Register(&reloadConfig)
+}
+
+func TestWatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "app.conf")
+	if err := ioutil.WriteFile(filename, []byte("reload-value = foo"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer func(fn string) { config.DefaultFilename = fn }(config.DefaultFilename)
+	config.DefaultFilename = filename
+	if _, err := config.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	ch := make(chan []*config.Change, 1)
+	token := signal.Listen(CONFIG_CHANGED, func(_ string, obj interface{}) {
+		ch <- obj.([]*config.Change)
+	})
+	defer signal.Stop(CONFIG_CHANGED, token)
+	w, err := Watch()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if err := ioutil.WriteFile(filename, []byte("reload-value = bar"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case changes := <-ch:
+		if len(changes) != 1 || changes[0].Name != "reload-value" || changes[0].Old != "foo" || changes[0].New != "bar" {
+			t.Errorf("unexpected changes %v", changes)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("config was not reloaded")
+	}
+	if reloadConfig.ReloadValue != "bar" {
+		t.Errorf("expecting reloaded value \"bar\", got %q", reloadConfig.ReloadValue)
+	}
+}
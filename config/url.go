@@ -3,10 +3,15 @@ package config
 import (
 	"fmt"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 )
 
+// redacted replaces the secrets in URL.Redacted, like
+// net/url.URL.Redacted does.
+const redacted = "xxxxx"
+
 // Map is a conveniency type for representing
 // the query and fragment specified in a configuration pseudo-url.
 type Map map[string]string
@@ -90,6 +95,46 @@ func (u *URL) String() string {
 	return s
 }
 
+var (
+	redactedUserinfo = regexp.MustCompile(`^([^:/@]*):[^@/]+@`)
+	redactedPassword = regexp.MustCompile(`(?i)(password=)[^ &]*`)
+)
+
+// redactedKey returns true iff the query or fragment
+// key might hold a secret, so it should be redacted.
+func redactedKey(key string) bool {
+	key = strings.ToLower(key)
+	return strings.Contains(key, "password") || strings.Contains(key, "secret") ||
+		strings.Contains(key, "token") || key == "encrypt"
+}
+
+// Redacted works like String, but the passwords and other secrets
+// (e.g. user:password@host, password=secret or #encrypt=secret)
+// are replaced with "xxxxx". Use it for logging or displaying
+// the URL.
+func (u *URL) Redacted() string {
+	cpy := *u
+	cpy.Value = redactedUserinfo.ReplaceAllString(cpy.Value, "$1:"+redacted+"@")
+	cpy.Value = redactedPassword.ReplaceAllString(cpy.Value, "${1}"+redacted)
+	cpy.Query = redactMap(u.Query)
+	cpy.Fragment = redactMap(u.Fragment)
+	return cpy.String()
+}
+
+func redactMap(m Map) Map {
+	if len(m) == 0 {
+		return m
+	}
+	r := make(Map, len(m))
+	for k, v := range m {
+		if redactedKey(k) {
+			v = redacted
+		}
+		r[k] = v
+	}
+	return r
+}
+
 func parseURL(u *URL, s string) (*URL, error) {
 	p := strings.Index(s, "://")
 	if p < 0 {
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"sort"
+)
+
+// Value represents an effective configuration value,
+// as returned by Values.
+type Value struct {
+	// Name is the config key (e.g. log-debug).
+	Name string
+	// Value is the current value of the field.
+	Value interface{}
+	// Source indicates where the value was taken from. It
+	// might be a config file name, an environment variable
+	// (e.g. $GONDOLA_LOG_DEBUG), a command line flag (e.g.
+	// -log-debug) or "default" when it comes from the "default"
+	// struct tag. It's empty for values which were not set.
+	Source string
+	// Secret is true if the value was read from a secret
+	// file or its field has the "secret" struct tag. See
+	// Register for details.
+	Secret bool
+}
+
+// Values returns the effective configuration values after
+// merging the config files, the environment variables and the
+// command line flags, sorted by name. If the configuration hasn't
+// been parsed yet, it returns nil.
+func Values() []*Value {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	values := make([]*Value, 0, len(parsed))
+	for k, v := range parsed {
+		values = append(values, &Value{
+			Name:   parameterName(k),
+			Value:  v.Value.Interface(),
+			Source: v.Source,
+			Secret: v.Secret || v.Tag.Get("secret") == "true",
+		})
+	}
+	sort.Sort(valuesByName(values))
+	return values
+}
+
+type valuesByName []*Value
+
+func (v valuesByName) Len() int           { return len(v) }
+func (v valuesByName) Less(i, j int) bool { return v[i].Name < v[j].Name }
+func (v valuesByName) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
@@ -23,11 +23,17 @@ func (e *EncryptSigner) EncryptSign(data []byte) (string, error) {
 // UnsignDecrypt takes an encrypted and signed string, previously returned
 // from EncryptSign, checks its signature and returns the decrypted data.
 // If the signature does not match or the data can't be correctly decrypted
-// an error is returned.
+// an error is returned. When the signature matches one of the Signer's
+// OldKeys and the Encrypter has an old key at the same position, that
+// key is used for decrypting the data, so both keys can be rotated at
+// the same time.
 func (e *EncryptSigner) UnsignDecrypt(data string) ([]byte, error) {
-	enc, err := e.Signer.Unsign(data)
+	enc, idx, err := e.Signer.unsign(data)
 	if err != nil {
 		return nil, err
 	}
+	if idx >= 0 && idx < len(e.Encrypter.OldKeys) {
+		return e.Encrypter.decryptKey(e.Encrypter.OldKeys[idx], enc)
+	}
 	return e.Encrypter.Decrypt(enc)
 }
@@ -30,17 +30,27 @@ type Encrypter struct {
 	// Key is the encryption key. If empty, all public methods
 	// will return ErrNoEncryptionKey.
 	Key []byte
+	// OldKeys are previous encryption keys, which are never used
+	// for encrypting. Since the encrypted data doesn't indicate the
+	// key which was used, they're only used by EncryptSigner, which
+	// decrypts the data with the key at the same position as the
+	// Signer's old key which verified it.
+	OldKeys [][]byte
 }
 
 func (e *Encrypter) getCipher() (cipher.Block, error) {
-	if len(e.Key) == 0 {
+	return e.getCipherKey(e.Key)
+}
+
+func (e *Encrypter) getCipherKey(key []byte) (cipher.Block, error) {
+	if len(key) == 0 {
 		return nil, ErrNoEncryptionKey
 	}
 	cipherer := e.Cipherer
 	if cipherer == nil {
 		cipherer = aes.NewCipher
 	}
-	return cipherer(e.Key)
+	return cipherer(key)
 }
 
 // Encrypt encrypts the given data using the Encrypter's
@@ -62,7 +72,11 @@ func (e *Encrypter) Encrypt(data []byte) ([]byte, error) {
 // Decrypt decrypts the given data using the Encrypter's
 // Cipherer and Key.
 func (e *Encrypter) Decrypt(data []byte) ([]byte, error) {
-	ci, err := e.getCipher()
+	return e.decryptKey(e.Key, data)
+}
+
+func (e *Encrypter) decryptKey(key []byte, data []byte) ([]byte, error) {
+	ci, err := e.getCipherKey(key)
 	if err != nil {
 		return nil, err
 	}
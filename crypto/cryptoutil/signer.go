@@ -42,21 +42,29 @@ type Signer struct {
 	// Salt is prepended to the value to be signed. See the Signer
 	// documentation for security considerations about the salt.
 	Salt []byte
+	// OldKeys are used, after Key, for verifying signatures but never
+	// for signing. This allows rotating the signing key without
+	// invalidating all the values signed with the previous ones at once.
+	OldKeys [][]byte
 }
 
 func (s *Signer) sign(data []byte) ([]byte, error) {
-	if len(s.Key) == 0 {
+	return s.signKey(s.Key, data)
+}
+
+func (s *Signer) signKey(key []byte, data []byte) ([]byte, error) {
+	if len(key) == 0 {
 		return nil, ErrNoSigningKey
 	}
 	var h hash.Hash
 	var err error
 	if s.Hasher != nil {
-		h, err = s.Hasher(s.Key)
+		h, err = s.Hasher(key)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		h = hmac.New(sha1.New, s.Key)
+		h = hmac.New(sha1.New, key)
 	}
 	if len(s.Salt) > 0 {
 		if _, err := h.Write(s.Salt); err != nil {
@@ -82,26 +90,41 @@ func (s *Signer) Sign(data []byte) (string, error) {
 
 // Unsign takes a string, previously returned from Sign, checks
 // that its signature is valid and, in that case, returns the initial
-// data. If the signature is not valid, an error is returned.
+// data. If the signature is not valid, an error is returned. The
+// signature is checked against Key and then against each one of
+// the OldKeys.
 func (s *Signer) Unsign(signed string) ([]byte, error) {
+	data, _, err := s.unsign(signed)
+	return data, err
+}
+
+// unsign works like Unsign, but also returns the index of the key
+// in OldKeys which matched the signature, or -1 if it was Key.
+func (s *Signer) unsign(signed string) ([]byte, int, error) {
 	parts := strings.Split(signed, ":")
 	if len(parts) != 2 {
-		return nil, ErrNotSigned
+		return nil, 0, ErrNotSigned
 	}
 	data, err := base64.Decode(parts[0])
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	signature, err := base64.Decode(parts[1])
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	sign, err := s.sign(data)
-	if err != nil {
-		return nil, err
-	}
-	if len(sign) != len(signature) || subtle.ConstantTimeCompare(sign, signature) != 1 {
-		return nil, ErrTampered
+	for ii := -1; ii < len(s.OldKeys); ii++ {
+		key := s.Key
+		if ii >= 0 {
+			key = s.OldKeys[ii]
+		}
+		sign, err := s.signKey(key, data)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(sign) == len(signature) && subtle.ConstantTimeCompare(sign, signature) == 1 {
+			return data, ii, nil
+		}
 	}
-	return data, nil
+	return nil, 0, ErrTampered
 }
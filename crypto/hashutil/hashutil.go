@@ -8,14 +8,20 @@
 //  - []byte
 //
 // Anything else will panic at runtime.
+//
+// To hash data as it's written (e.g. while copying it somewhere else),
+// without reading it fully into memory, use a Writer. Finally, use
+// Equal for comparing hashes or tokens provided by the user.
 package hashutil
 
 import (
 	"crypto"
+	"crypto/hmac"
 	_ "crypto/md5"
 	_ "crypto/sha1"
 	_ "crypto/sha256"
 	_ "crypto/sha512"
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
 	"hash"
@@ -42,10 +48,14 @@ func _hash(h hash.Hash, src interface{}) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
-func _chash(h crypto.Hash, src interface{}) string {
+func available(h crypto.Hash) {
 	if !h.Available() {
 		panic(fmt.Errorf("Hash %v is not available", h))
 	}
+}
+
+func _chash(h crypto.Hash, src interface{}) string {
+	available(h)
 	return _hash(h.New(), src)
 }
 
@@ -117,3 +127,33 @@ func Fnv64(src interface{}) string {
 func Fnv64a(src interface{}) string {
 	return _hash(fnv.New64a(), src)
 }
+
+// Hmac returns the HMAC of the given data as a string, using the
+// given hash function and key.
+func Hmac(h crypto.Hash, key []byte, src interface{}) string {
+	available(h)
+	return _hash(hmac.New(h.New, key), src)
+}
+
+// HmacSha1 returns the HMAC-SHA1 as a string.
+func HmacSha1(key []byte, src interface{}) string {
+	return Hmac(crypto.SHA1, key, src)
+}
+
+// HmacSha256 returns the HMAC-SHA256 as a string.
+func HmacSha256(key []byte, src interface{}) string {
+	return Hmac(crypto.SHA256, key, src)
+}
+
+// HmacSha512 returns the HMAC-SHA512 as a string.
+func HmacSha512(key []byte, src interface{}) string {
+	return Hmac(crypto.SHA512, key, src)
+}
+
+// Equal returns true iff a and b are equal. The time taken
+// by the comparison depends only on the lengths of a and b,
+// not on their contents, so it's safe to use it for comparing
+// hashes, signatures or tokens provided by the user.
+func Equal(a string, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
@@ -2,6 +2,9 @@ package hashutil
 
 import (
 	"bytes"
+	"crypto"
+	"io"
+	"strings"
 	"testing"
 )
 
@@ -25,3 +28,45 @@ func TestInvalidInput(t *testing.T) {
 	}()
 	Sha1(42)
 }
+
+func TestWriter(t *testing.T) {
+	text := "foobar"
+	w := NewHashWriter(crypto.SHA1)
+	w.Write([]byte(text[:3]))
+	w.Write([]byte(text[3:]))
+	if w.Size() != int64(len(text)) {
+		t.Errorf("expecting size %d, got %d", len(text), w.Size())
+	}
+	if s := w.Sum(); s != Sha1(text) {
+		t.Errorf("expecting hash %s, got %s", Sha1(text), s)
+	}
+	w.Reset()
+	if _, err := io.Copy(w, strings.NewReader(text)); err != nil {
+		t.Fatal(err)
+	}
+	if s := w.Sum(); s != Sha1(text) {
+		t.Errorf("expecting hash %s after reset, got %s", Sha1(text), s)
+	}
+	hw := NewHmacWriter(crypto.SHA256, []byte("key"))
+	io.WriteString(hw, "The quick brown fox jumps over the lazy dog")
+	if s := hw.Sum(); s != HmacSha256([]byte("key"), "The quick brown fox jumps over the lazy dog") {
+		t.Errorf("HMAC writer and HmacSha256 return different values")
+	}
+}
+
+func TestHmac(t *testing.T) {
+	// From https://en.wikipedia.org/wiki/HMAC#Examples
+	const expected = "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"
+	if s := HmacSha256([]byte("key"), "The quick brown fox jumps over the lazy dog"); s != expected {
+		t.Errorf("expecting HMAC-SHA256 %s, got %s", expected, s)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	if !Equal("foo", "foo") {
+		t.Error("expecting equal strings to be Equal")
+	}
+	if Equal("foo", "bar") || Equal("foo", "foobar") || Equal("", "foo") {
+		t.Error("expecting different strings not to be Equal")
+	}
+}
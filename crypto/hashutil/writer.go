@@ -0,0 +1,74 @@
+package hashutil
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"encoding/hex"
+	"hash"
+	"io"
+)
+
+// Writer is an io.Writer which hashes all the data written to it.
+// It's useful for hashing data as it's read or written somewhere
+// else, using io.TeeReader or io.MultiWriter, e.g.
+//
+//  w := hashutil.NewWriter(sha1.New())
+//  if _, err := io.Copy(io.MultiWriter(dst, w), src); err != nil {
+//	return err
+//  }
+//  sum := w.Sum()
+//
+type Writer struct {
+	h    hash.Hash
+	size int64
+}
+
+// NewWriter returns a new Writer which uses the given hash.
+func NewWriter(h hash.Hash) *Writer {
+	return &Writer{h: h}
+}
+
+// NewHashWriter returns a new Writer which uses the given hash
+// function. If the function is not available, it panics.
+func NewHashWriter(h crypto.Hash) *Writer {
+	available(h)
+	return NewWriter(h.New())
+}
+
+// NewHmacWriter returns a new Writer which calculates the HMAC
+// of the data written to it, using the given hash function and key.
+// If the function is not available, it panics.
+func NewHmacWriter(h crypto.Hash, key []byte) *Writer {
+	available(h)
+	return NewWriter(hmac.New(h.New, key))
+}
+
+// Write implements the io.Writer interface.
+func (w *Writer) Write(p []byte) (int, error) {
+	n, err := w.h.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// ReadFrom hashes all the data from r until EOF, returning the
+// number of bytes read and any error other than io.EOF.
+func (w *Writer) ReadFrom(r io.Reader) (int64, error) {
+	// Avoid io.Copy calling ReadFrom recursively
+	return io.Copy(struct{ io.Writer }{w}, r)
+}
+
+// Size returns the number of bytes written so far.
+func (w *Writer) Size() int64 {
+	return w.size
+}
+
+// Sum returns the hash of the data written so far as a string.
+func (w *Writer) Sum() string {
+	return hex.EncodeToString(w.h.Sum(nil))
+}
+
+// Reset discards the data written so far.
+func (w *Writer) Reset() {
+	w.h.Reset()
+	w.size = 0
+}
@@ -27,6 +27,19 @@
 //	}
 //  }
 //
+// Other algorithms can be used by encoding the password with a Hasher,
+// like BcryptHasher or Argon2idHasher. Check always uses the algorithm
+// the password was encoded with, so NeedsRehash can be used after a
+// successful Check for transparently upgrading stored passwords:
+//
+//  hasher := password.Argon2idHasher{}
+//  if user.Password.Check(plain) == nil && user.Password.NeedsRehash(hasher) {
+//	if p, err := password.NewHasher(plain, hasher); err == nil {
+//	    user.Password = p
+//	    o.MustSave(user)
+//	}
+//  }
+//
 // Password objects can also be stored on anything that accepts strings. See
 // the examples to learn how to manually store and verify a password.
 package password
@@ -0,0 +1,372 @@
+package password
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"code.google.com/p/go.crypto/bcrypt"
+	"golang.org/x/crypto/argon2"
+
+	"gnd.la/util/stringutil"
+)
+
+var (
+	// ErrUnknownHasher means the algorithm used for encoding
+	// the password has not been registered with RegisterHasher.
+	ErrUnknownHasher = errors.New("unknown password hasher")
+	// ErrTooLong means the plaintext password is longer
+	// than MaxPasswordLength.
+	ErrTooLong = errors.New("password is too long")
+
+	hashers struct {
+		sync.RWMutex
+		m map[string]Hasher
+	}
+)
+
+// Hasher is the interface implemented by password hashing
+// algorithms. Passwords encoded by a Hasher must start with
+// its name followed by a colon, so Password.Check can find
+// the Hasher which should verify them. Hashers must be
+// registered with RegisterHasher before passwords encoded
+// by them can be verified.
+type Hasher interface {
+	// Name returns the name of the algorithm, which is used
+	// as the first field in the encoded password.
+	Name() string
+	// Hash returns plain encoded as a Password.
+	Hash(plain string) (Password, error)
+	// Valid returns true iff p is a correctly encoded
+	// password for this Hasher.
+	Valid(p Password) bool
+	// Check returns nil iff p is correctly encoded and
+	// matches plain. It must run in constant time.
+	Check(p Password, plain string) error
+	// NeedsRehash returns true iff p was encoded with
+	// weaker parameters than the ones in the Hasher.
+	NeedsRehash(p Password) bool
+}
+
+// RegisterHasher registers a Hasher for checking passwords encoded by
+// it. The Hasher is registered using the value returned by its Name
+// method. Note that the hasher is only used for verifying
+// passwords, the parameters used for encoding new ones are taken
+// from the Hasher passed to Password.NeedsRehash or NewHasher. If
+// there's already a Hasher registered with the same name,
+// RegisterHasher panics.
+func RegisterHasher(h Hasher) {
+	hashers.Lock()
+	defer hashers.Unlock()
+	name := h.Name()
+	if hashers.m == nil {
+		hashers.m = make(map[string]Hasher)
+	}
+	if _, dup := hashers.m[name]; dup {
+		panic(fmt.Errorf("there's already a password hasher named %q", name))
+	}
+	hashers.m[name] = h
+}
+
+func hasherNamed(name string) Hasher {
+	hashers.RLock()
+	h := hashers.m[name]
+	hashers.RUnlock()
+	return h
+}
+
+// NewHasher returns plain encoded by h. If h is nil,
+// it's equivalent to New.
+func NewHasher(plain string, h Hasher) (Password, error) {
+	if len(plain) > MaxPasswordLength {
+		return Password(""), ErrTooLong
+	}
+	if h == nil {
+		return New(plain), nil
+	}
+	return h.Hash(plain)
+}
+
+// PBKDF2Hasher is the Hasher which implements the format used by New
+// and NewOptions, hashing passwords with PBKDF2. Its zero value uses
+// DefaultHash and DefaultRounds. Since the name of the algorithm is
+// the hash name (e.g. sha256), a PBKDF2Hasher is registered for each
+// supported Hash.
+type PBKDF2Hasher struct {
+	// Algorithm is the Hash used with PBKDF2. If it's
+	// zero, DefaultHash is used.
+	Algorithm Hash
+	// Rounds is the number of PBKDF2 iterations. If it's
+	// <= 0, DefaultRounds is used.
+	Rounds int
+}
+
+func (h PBKDF2Hasher) options() *Options {
+	return &Options{Hash: h.Algorithm, Rounds: h.Rounds}
+}
+
+// Name implements the Hasher interface.
+func (h PBKDF2Hasher) Name() string {
+	if h.Algorithm == Hash(0) {
+		return DefaultHash.Name()
+	}
+	return h.Algorithm.Name()
+}
+
+// Hash implements the Hasher interface.
+func (h PBKDF2Hasher) Hash(plain string) (Password, error) {
+	if len(plain) > MaxPasswordLength {
+		return Password(""), ErrTooLong
+	}
+	return NewOptions(plain, h.options()), nil
+}
+
+// Valid implements the Hasher interface.
+func (h PBKDF2Hasher) Valid(p Password) bool {
+	_, _, _, _, err := p.validate()
+	return err == nil
+}
+
+// Check implements the Hasher interface.
+func (h PBKDF2Hasher) Check(p Password, plain string) error {
+	if len(plain) > MaxPasswordLength {
+		return ErrNoMatch
+	}
+	decoded, hash, salt, rounds, err := p.validate()
+	if err != nil {
+		// This does not affect the time-constness of the function
+		// since an invalid Password string will always return at
+		// this point, regardless of the input.
+		return err
+	}
+	if subtle.ConstantTimeCompare(decoded, hash.RawHash(salt, plain, rounds)) != 1 {
+		return ErrNoMatch
+	}
+	return nil
+}
+
+// NeedsRehash implements the Hasher interface.
+func (h PBKDF2Hasher) NeedsRehash(p Password) bool {
+	hash, err := p.Hash()
+	if err != nil || hash.Name() != h.Name() {
+		return true
+	}
+	rounds, err := p.Rounds()
+	if err != nil {
+		return true
+	}
+	min := h.Rounds
+	if min <= 0 {
+		min = DefaultRounds
+	}
+	return rounds < min
+}
+
+// BcryptHasher hashes passwords using bcrypt. Passwords are
+// encoded as bcrypt:<bcrypt hash>. If Cost is zero,
+// bcrypt.DefaultCost is used.
+//
+// Note that bcrypt only uses the first 72 bytes of
+// the password.
+type BcryptHasher struct {
+	Cost int
+}
+
+func (h BcryptHasher) cost() int {
+	if h.Cost > 0 {
+		return h.Cost
+	}
+	return bcrypt.DefaultCost
+}
+
+func (h BcryptHasher) hashed(p Password) []byte {
+	s := string(p)
+	prefix := h.Name() + ":"
+	if !strings.HasPrefix(s, prefix) {
+		return nil
+	}
+	return []byte(s[len(prefix):])
+}
+
+// Name implements the Hasher interface.
+func (h BcryptHasher) Name() string {
+	return "bcrypt"
+}
+
+// Hash implements the Hasher interface.
+func (h BcryptHasher) Hash(plain string) (Password, error) {
+	if len(plain) > MaxPasswordLength {
+		return Password(""), ErrTooLong
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(plain), h.cost())
+	if err != nil {
+		return Password(""), err
+	}
+	return Password(h.Name() + ":" + string(hashed)), nil
+}
+
+// Valid implements the Hasher interface.
+func (h BcryptHasher) Valid(p Password) bool {
+	hashed := h.hashed(p)
+	if hashed == nil {
+		return false
+	}
+	_, err := bcrypt.Cost(hashed)
+	return err == nil
+}
+
+// Check implements the Hasher interface.
+func (h BcryptHasher) Check(p Password, plain string) error {
+	if len(plain) > MaxPasswordLength {
+		return ErrNoMatch
+	}
+	hashed := h.hashed(p)
+	if hashed == nil {
+		return ErrInvalidFieldCount
+	}
+	err := bcrypt.CompareHashAndPassword(hashed, []byte(plain))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return ErrNoMatch
+	}
+	return err
+}
+
+// NeedsRehash implements the Hasher interface.
+func (h BcryptHasher) NeedsRehash(p Password) bool {
+	hashed := h.hashed(p)
+	if hashed == nil {
+		return true
+	}
+	cost, err := bcrypt.Cost(hashed)
+	return err != nil || cost < h.cost()
+}
+
+const (
+	// DefaultArgon2Time is the default number of passes
+	// used by Argon2idHasher.
+	DefaultArgon2Time = 1
+	// DefaultArgon2Memory is the default memory in KiB
+	// used by Argon2idHasher.
+	DefaultArgon2Memory = 64 * 1024
+	// DefaultArgon2Threads is the default number of threads
+	// used by Argon2idHasher.
+	DefaultArgon2Threads = 4
+
+	argon2KeyLength  = 32
+	argon2SaltLength = 16
+)
+
+// Argon2idHasher hashes passwords using argon2id. Passwords are encoded
+// as argon2id:time:memory:threads:salt:hex(hash). Any zero field is
+// replaced by its default value (DefaultArgon2Time, DefaultArgon2Memory
+// and DefaultArgon2Threads).
+type Argon2idHasher struct {
+	// Time is the number of passes over the memory.
+	Time uint32
+	// Memory is the memory used, in KiB.
+	Memory uint32
+	// Threads is the number of threads used.
+	Threads uint8
+}
+
+func (h Argon2idHasher) params() (uint32, uint32, uint8) {
+	t, m, p := h.Time, h.Memory, h.Threads
+	if t == 0 {
+		t = DefaultArgon2Time
+	}
+	if m == 0 {
+		m = DefaultArgon2Memory
+	}
+	if p == 0 {
+		p = DefaultArgon2Threads
+	}
+	return t, m, p
+}
+
+func (h Argon2idHasher) decode(p Password) (time uint32, memory uint32, threads uint8, salt string, hashed []byte, err error) {
+	fields := strings.Split(string(p), ":")
+	if len(fields) != 6 || fields[0] != h.Name() {
+		err = ErrInvalidFieldCount
+		return
+	}
+	var values [3]uint64
+	for ii, bits := range []int{32, 32, 8} {
+		if values[ii], err = strconv.ParseUint(fields[ii+1], 10, bits); err != nil || values[ii] == 0 {
+			err = ErrInvalidRoundCount
+			return
+		}
+	}
+	time, memory, threads = uint32(values[0]), uint32(values[1]), uint8(values[2])
+	salt = fields[4]
+	if len(salt) != argon2SaltLength {
+		err = ErrInvalidSaltLength
+		return
+	}
+	if hashed, err = hex.DecodeString(fields[5]); err != nil {
+		err = ErrInvalidHex
+		return
+	}
+	if len(hashed) != argon2KeyLength {
+		err = ErrInvalidHashedLength
+	}
+	return
+}
+
+// Name implements the Hasher interface.
+func (h Argon2idHasher) Name() string {
+	return "argon2id"
+}
+
+// Hash implements the Hasher interface.
+func (h Argon2idHasher) Hash(plain string) (Password, error) {
+	if len(plain) > MaxPasswordLength {
+		return Password(""), ErrTooLong
+	}
+	t, m, p := h.params()
+	salt := stringutil.Random(argon2SaltLength)
+	hashed := argon2.IDKey([]byte(plain), []byte(salt), t, m, p, argon2KeyLength)
+	return Password(fmt.Sprintf("%s:%d:%d:%d:%s:%s", h.Name(), t, m, p, salt, hex.EncodeToString(hashed))), nil
+}
+
+// Valid implements the Hasher interface.
+func (h Argon2idHasher) Valid(p Password) bool {
+	_, _, _, _, _, err := h.decode(p)
+	return err == nil
+}
+
+// Check implements the Hasher interface.
+func (h Argon2idHasher) Check(p Password, plain string) error {
+	if len(plain) > MaxPasswordLength {
+		return ErrNoMatch
+	}
+	t, m, threads, salt, hashed, err := h.decode(p)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(hashed, argon2.IDKey([]byte(plain), []byte(salt), t, m, threads, argon2KeyLength)) != 1 {
+		return ErrNoMatch
+	}
+	return nil
+}
+
+// NeedsRehash implements the Hasher interface.
+func (h Argon2idHasher) NeedsRehash(p Password) bool {
+	t, m, threads, _, _, err := h.decode(p)
+	if err != nil {
+		return true
+	}
+	ht, hm, hthreads := h.params()
+	return t < ht || m < hm || threads < hthreads
+}
+
+func init() {
+	for _, v := range []Hash{SHA1, SHA224, SHA256, SHA384, SHA512} {
+		RegisterHasher(PBKDF2Hasher{Algorithm: v})
+	}
+	RegisterHasher(BcryptHasher{})
+	RegisterHasher(Argon2idHasher{})
+}
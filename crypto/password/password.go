@@ -1,7 +1,6 @@
 package password
 
 import (
-	"crypto/subtle"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -107,33 +106,45 @@ func (p Password) String() string {
 	return string(p)
 }
 
+func (p Password) hasher() Hasher {
+	return hasherNamed(p.field(0))
+}
+
 // IsValid returns true iff the password is a correctly encoded password.
-// This means it has a hash that is available and the salt and hashed
-// data have the same length as the hash output.
+// This means it was encoded by a registered Hasher and, for passwords
+// created by New or NewOptions, it has a hash that is available and the
+// salt and hashed data have the same length as the hash output.
 func (p Password) IsValid() bool {
-	_, _, _, _, err := p.validate()
-	return err == nil
+	h := p.hasher()
+	return h != nil && h.Valid(p)
 }
 
 // Check returns nil if the password could be verified without
 // any errors and it matches the provided plain text password.
-// This function performs a constant time comparison,
-// so it's not vulnerable to timing attacks.
+// The Hasher used for verifying the password is determined
+// from its encoding. This function performs a constant time
+// comparison, so it's not vulnerable to timing attacks.
 func (p Password) Check(plain string) error {
-	if len(plain) > MaxPasswordLength {
-		return ErrNoMatch
-	}
-	decoded, hash, salt, rounds, err := p.validate()
-	if err != nil {
-		// This does not affect the time-constness of the function
-		// since an invalid Password string will always return at
-		// this point, regardless of the input.
-		return err
+	h := p.hasher()
+	if h == nil {
+		if strings.Count(string(p), ":") == 0 {
+			return ErrInvalidFieldCount
+		}
+		return ErrUnknownHasher
 	}
-	if subtle.ConstantTimeCompare(decoded, hash.RawHash(salt, plain, rounds)) != 1 {
-		return ErrNoMatch
+	return h.Check(p, plain)
+}
+
+// NeedsRehash returns true iff the password should be encoded
+// again using h, because it was encoded by a different algorithm
+// or h uses stronger parameters. Since the plaintext password is
+// required for encoding it again, this is usually checked right
+// after a successful Check. If h is nil, PBKDF2Hasher{} is used.
+func (p Password) NeedsRehash(h Hasher) bool {
+	if h == nil {
+		h = PBKDF2Hasher{}
 	}
-	return nil
+	return p.field(0) != h.Name() || h.NeedsRehash(p)
 }
 
 // Matches is a shorthand for Check(plain) == nil. Id est,
@@ -29,3 +29,54 @@ func TestInvalidPasswords(t *testing.T) {
 		}
 	}
 }
+
+func TestHashers(t *testing.T) {
+	pw := "gondola"
+	hashers := []Hasher{
+		PBKDF2Hasher{},
+		PBKDF2Hasher{Algorithm: SHA512, Rounds: 1000},
+		BcryptHasher{Cost: 4},
+		Argon2idHasher{Memory: 1024},
+	}
+	for _, h := range hashers {
+		p, err := NewHasher(pw, h)
+		if err != nil {
+			t.Fatalf("error encoding password with %s: %s", h.Name(), err)
+		}
+		if !p.IsValid() {
+			t.Errorf("password %q encoded with %s is not valid", p, h.Name())
+		}
+		if err := p.Check(pw); err != nil {
+			t.Errorf("error verifying password %q using %s: %s", p, h.Name(), err)
+		}
+		if err := p.Check(pw + "1"); err != ErrNoMatch {
+			t.Errorf("expecting ErrNoMatch with %s, got %v", h.Name(), err)
+		}
+		if p.NeedsRehash(h) {
+			t.Errorf("password %q encoded with %s needs rehash", p, h.Name())
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	p := New("gondola")
+	cases := []struct {
+		hasher Hasher
+		rehash bool
+	}{
+		{nil, false},
+		{PBKDF2Hasher{}, false},
+		{PBKDF2Hasher{Rounds: DefaultRounds * 2}, true},
+		{PBKDF2Hasher{Algorithm: SHA512}, true},
+		{BcryptHasher{}, true},
+		{Argon2idHasher{}, true},
+	}
+	for _, v := range cases {
+		if r := p.NeedsRehash(v.hasher); r != v.rehash {
+			t.Errorf("expecting NeedsRehash(%v) = %v, got %v", v.hasher, v.rehash, r)
+		}
+	}
+	if err := Password("foo:bar").Check("bar"); err != ErrUnknownHasher {
+		t.Errorf("expecting ErrUnknownHasher, got %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+// Package totp implements time-based one-time passwords (TOTP) as
+// described in RFC 6238, compatible with the most common authenticator
+// applications.
+//
+// Secrets are represented as base32 encoded strings without padding,
+// which is the format expected by authenticator apps. Use NewSecret
+// to generate a secret and URI to obtain the provisioning URI which
+// can be encoded as a QR code.
+//
+//  secret := totp.NewSecret()
+//  uri := totp.URI(secret, "Example", "alice@example.com")
+//  // Later, when the user enters a code
+//  if step, ok := totp.Verify(secret, code, time.Now(), 1); ok && step > lastStep {
+//	// Valid code which hasn't been used before
+//  }
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits is the number of digits in the generated codes.
+	Digits = 6
+	// Period is the time step for generating codes.
+	Period = 30 * time.Second
+	// SecretSize is the size in bytes of the secrets
+	// generated by NewSecret.
+	SecretSize = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewSecret returns a new random secret, encoded in base32.
+func NewSecret() string {
+	b := make([]byte, SecretSize)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return encoding.EncodeToString(b)
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.Replace(secret, " ", "", -1))
+	secret = strings.TrimRight(secret, "=")
+	return encoding.DecodeString(secret)
+}
+
+// Step returns the time step corresponding to t.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+func generate(key []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for ii := 0; ii < Digits; ii++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", Digits, value%mod)
+}
+
+// Code returns the code for the given secret at the given time.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return generate(key, Step(t)), nil
+}
+
+// Verify checks if code is valid for the given secret at time t,
+// accepting codes up to skew steps before or after it to allow for
+// clock drift. If the code is valid, it returns the step it was
+// generated for. Callers should store the step and reject codes for
+// steps which are not greater than the last used one, to prevent
+// codes from being used more than once.
+func Verify(secret string, code string, t time.Time, skew int) (int64, bool) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+	code = strings.Replace(code, " ", "", -1)
+	if len(code) != Digits {
+		return 0, false
+	}
+	step := Step(t)
+	var found int64
+	ok := false
+	// Check every step, so the time taken does
+	// not depend on which one matches.
+	for ii := -int64(skew); ii <= int64(skew); ii++ {
+		if subtle.ConstantTimeCompare([]byte(generate(key, step+ii)), []byte(code)) == 1 {
+			found = step + ii
+			ok = true
+		}
+	}
+	return found, ok
+}
+
+// URI returns the otpauth:// URI for provisioning the given secret
+// into an authenticator app, usually encoded as a QR code. issuer
+// is the name of the site or organization and account identifies
+// the user (e.g. its username or email).
+func URI(secret string, issuer string, account string) string {
+	label := account
+	if issuer != "" {
+		label = issuer + ":" + account
+	}
+	values := url.Values{}
+	values.Set("secret", secret)
+	if issuer != "" {
+		values.Set("issuer", issuer)
+	}
+	values.Set("digits", fmt.Sprintf("%d", Digits))
+	values.Set("period", fmt.Sprintf("%d", int(Period/time.Second)))
+	u := &url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + label,
+		RawQuery: values.Encode(),
+	}
+	return u.String()
+}
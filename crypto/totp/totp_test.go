@@ -0,0 +1,60 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCode(t *testing.T) {
+	// Test vectors from RFC 6238, using the last 6 digits
+	secret := encoding.EncodeToString([]byte("12345678901234567890"))
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, v := range cases {
+		c, err := Code(secret, time.Unix(v.unix, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c != v.code {
+			t.Errorf("expecting code %s at %d, got %s", v.code, v.unix, c)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	secret := NewSecret()
+	now := time.Now()
+	prev, err := Code(secret, now.Add(-Period))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if step, ok := Verify(secret, prev, now, 1); !ok || step != Step(now)-1 {
+		t.Errorf("expecting valid code for step %d, got %v (step %d)", Step(now)-1, ok, step)
+	}
+	if _, ok := Verify(secret, prev, now, 0); ok {
+		t.Error("previous code should not be valid without skew")
+	}
+	if _, ok := Verify(secret, "abcdef", now, 1); ok {
+		t.Error("invalid code was accepted")
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("JBSWY3DPEHPK3PXP", "Example", "alice@example.com")
+	if !strings.HasPrefix(uri, "otpauth://totp/Example:alice@example.com?") {
+		t.Errorf("unexpected URI %s", uri)
+	}
+	if !strings.Contains(uri, "secret=JBSWY3DPEHPK3PXP") || !strings.Contains(uri, "issuer=Example") {
+		t.Errorf("URI %s does not contain secret and issuer", uri)
+	}
+}
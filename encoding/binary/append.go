@@ -0,0 +1,305 @@
+package binary
+
+import (
+	"io"
+	"math"
+)
+
+// AppendTo appends the binary representation of data to dst and
+// returns the extended buffer. Data must be a fixed-size value or
+// a slice of fixed-size values, or a pointer to such data, like in
+// Write. When dst has enough capacity no allocations are made, so
+// protocol code can reuse its buffers between messages. On error,
+// dst is returned unmodified.
+func AppendTo(dst []byte, order *ByteOrder, data interface{}) ([]byte, error) {
+	// Fast path for basic types and slices of basic types
+	switch v := data.(type) {
+	case int8:
+		return append(dst, byte(v)), nil
+	case *int8:
+		return append(dst, byte(*v)), nil
+	case uint8:
+		return append(dst, v), nil
+	case *uint8:
+		return append(dst, *v), nil
+	case int16:
+		return appendUint16(dst, order, uint16(v)), nil
+	case *int16:
+		return appendUint16(dst, order, uint16(*v)), nil
+	case uint16:
+		return appendUint16(dst, order, v), nil
+	case *uint16:
+		return appendUint16(dst, order, *v), nil
+	case int32:
+		return appendUint32(dst, order, uint32(v)), nil
+	case *int32:
+		return appendUint32(dst, order, uint32(*v)), nil
+	case uint32:
+		return appendUint32(dst, order, v), nil
+	case *uint32:
+		return appendUint32(dst, order, *v), nil
+	case int64:
+		return appendUint64(dst, order, uint64(v)), nil
+	case *int64:
+		return appendUint64(dst, order, uint64(*v)), nil
+	case uint64:
+		return appendUint64(dst, order, v), nil
+	case *uint64:
+		return appendUint64(dst, order, *v), nil
+	case float32:
+		return appendUint32(dst, order, math.Float32bits(v)), nil
+	case *float32:
+		return appendUint32(dst, order, math.Float32bits(*v)), nil
+	case float64:
+		return appendUint64(dst, order, math.Float64bits(v)), nil
+	case *float64:
+		return appendUint64(dst, order, math.Float64bits(*v)), nil
+	case []int8:
+		dst, b := grow(dst, len(v))
+		for ii, x := range v {
+			b[ii] = byte(x)
+		}
+		return dst, nil
+	case []uint8:
+		return append(dst, v...), nil
+	case []int16:
+		dst, b := grow(dst, 2*len(v))
+		for ii, x := range v {
+			order.PutUint16(b[2*ii:], uint16(x))
+		}
+		return dst, nil
+	case []uint16:
+		dst, b := grow(dst, 2*len(v))
+		for ii, x := range v {
+			order.PutUint16(b[2*ii:], x)
+		}
+		return dst, nil
+	case []int32:
+		dst, b := grow(dst, 4*len(v))
+		for ii, x := range v {
+			order.PutUint32(b[4*ii:], uint32(x))
+		}
+		return dst, nil
+	case []uint32:
+		dst, b := grow(dst, 4*len(v))
+		for ii, x := range v {
+			order.PutUint32(b[4*ii:], x)
+		}
+		return dst, nil
+	case []int64:
+		dst, b := grow(dst, 8*len(v))
+		for ii, x := range v {
+			order.PutUint64(b[8*ii:], uint64(x))
+		}
+		return dst, nil
+	case []uint64:
+		dst, b := grow(dst, 8*len(v))
+		for ii, x := range v {
+			order.PutUint64(b[8*ii:], x)
+		}
+		return dst, nil
+	}
+
+	// Fallback to Write, appending to dst.
+	w := sliceWriter{buf: dst}
+	if err := Write(&w, order, data); err != nil {
+		return dst, err
+	}
+	return w.buf, nil
+}
+
+// Consume decodes data from the start of src and returns the
+// remaining bytes. Data must be a pointer to a fixed-size value
+// or a slice of fixed-size values, like in Read. If src is too
+// short, io.ErrUnexpectedEOF is returned.
+func Consume(src []byte, order *ByteOrder, data interface{}) ([]byte, error) {
+	// Fast path for basic types and slices of basic types
+	switch v := data.(type) {
+	case *int8:
+		if len(src) < 1 {
+			return src, io.ErrUnexpectedEOF
+		}
+		*v = int8(src[0])
+		return src[1:], nil
+	case *uint8:
+		if len(src) < 1 {
+			return src, io.ErrUnexpectedEOF
+		}
+		*v = src[0]
+		return src[1:], nil
+	case *int16:
+		if len(src) < 2 {
+			return src, io.ErrUnexpectedEOF
+		}
+		*v = int16(order.Uint16(src))
+		return src[2:], nil
+	case *uint16:
+		if len(src) < 2 {
+			return src, io.ErrUnexpectedEOF
+		}
+		*v = order.Uint16(src)
+		return src[2:], nil
+	case *int32:
+		if len(src) < 4 {
+			return src, io.ErrUnexpectedEOF
+		}
+		*v = int32(order.Uint32(src))
+		return src[4:], nil
+	case *uint32:
+		if len(src) < 4 {
+			return src, io.ErrUnexpectedEOF
+		}
+		*v = order.Uint32(src)
+		return src[4:], nil
+	case *int64:
+		if len(src) < 8 {
+			return src, io.ErrUnexpectedEOF
+		}
+		*v = int64(order.Uint64(src))
+		return src[8:], nil
+	case *uint64:
+		if len(src) < 8 {
+			return src, io.ErrUnexpectedEOF
+		}
+		*v = order.Uint64(src)
+		return src[8:], nil
+	case *float32:
+		if len(src) < 4 {
+			return src, io.ErrUnexpectedEOF
+		}
+		*v = math.Float32frombits(order.Uint32(src))
+		return src[4:], nil
+	case *float64:
+		if len(src) < 8 {
+			return src, io.ErrUnexpectedEOF
+		}
+		*v = math.Float64frombits(order.Uint64(src))
+		return src[8:], nil
+	case []int8:
+		if len(src) < len(v) {
+			return src, io.ErrUnexpectedEOF
+		}
+		for ii := range v {
+			v[ii] = int8(src[ii])
+		}
+		return src[len(v):], nil
+	case []uint8:
+		if len(src) < len(v) {
+			return src, io.ErrUnexpectedEOF
+		}
+		return src[copy(v, src):], nil
+	case []int16:
+		if len(src) < 2*len(v) {
+			return src, io.ErrUnexpectedEOF
+		}
+		for ii := range v {
+			v[ii] = int16(order.Uint16(src[2*ii:]))
+		}
+		return src[2*len(v):], nil
+	case []uint16:
+		if len(src) < 2*len(v) {
+			return src, io.ErrUnexpectedEOF
+		}
+		for ii := range v {
+			v[ii] = order.Uint16(src[2*ii:])
+		}
+		return src[2*len(v):], nil
+	case []int32:
+		if len(src) < 4*len(v) {
+			return src, io.ErrUnexpectedEOF
+		}
+		for ii := range v {
+			v[ii] = int32(order.Uint32(src[4*ii:]))
+		}
+		return src[4*len(v):], nil
+	case []uint32:
+		if len(src) < 4*len(v) {
+			return src, io.ErrUnexpectedEOF
+		}
+		for ii := range v {
+			v[ii] = order.Uint32(src[4*ii:])
+		}
+		return src[4*len(v):], nil
+	case []int64:
+		if len(src) < 8*len(v) {
+			return src, io.ErrUnexpectedEOF
+		}
+		for ii := range v {
+			v[ii] = int64(order.Uint64(src[8*ii:]))
+		}
+		return src[8*len(v):], nil
+	case []uint64:
+		if len(src) < 8*len(v) {
+			return src, io.ErrUnexpectedEOF
+		}
+		for ii := range v {
+			v[ii] = order.Uint64(src[8*ii:])
+		}
+		return src[8*len(v):], nil
+	}
+
+	// Fallback to Read, decoding from src.
+	r := sliceReader{buf: src}
+	if err := Read(&r, order, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return src, err
+	}
+	return r.buf, nil
+}
+
+// grow extends dst by n bytes, returning the extended
+// slice and the n bytes which were added to it.
+func grow(dst []byte, n int) ([]byte, []byte) {
+	l := len(dst)
+	if l+n > cap(dst) {
+		buf := make([]byte, l, 2*cap(dst)+n)
+		copy(buf, dst)
+		dst = buf
+	}
+	dst = dst[:l+n]
+	return dst, dst[l:]
+}
+
+func appendUint16(dst []byte, order *ByteOrder, v uint16) []byte {
+	dst, b := grow(dst, 2)
+	order.PutUint16(b, v)
+	return dst
+}
+
+func appendUint32(dst []byte, order *ByteOrder, v uint32) []byte {
+	dst, b := grow(dst, 4)
+	order.PutUint32(b, v)
+	return dst
+}
+
+func appendUint64(dst []byte, order *ByteOrder, v uint64) []byte {
+	dst, b := grow(dst, 8)
+	order.PutUint64(b, v)
+	return dst
+}
+
+// sliceWriter is an io.Writer which appends to a slice.
+type sliceWriter struct {
+	buf []byte
+}
+
+func (w *sliceWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	return len(p), nil
+}
+
+// sliceReader is an io.Reader which reads from a slice.
+type sliceReader struct {
+	buf []byte
+}
+
+func (r *sliceReader) Read(p []byte) (int, error) {
+	if len(r.buf) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
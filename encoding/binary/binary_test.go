@@ -856,3 +856,160 @@ func BenchmarkReadBigEndian(b *testing.B) {
 func BenchmarkReadLittleEndian(b *testing.B) {
 	benchmarkReadByteOrder(b, LittleEndian)
 }
+
+func TestDecoderLimits(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, BigEndian, []uint32{1, 2, 3, 4}); err != nil {
+		t.Fatal(err)
+	}
+	dec := NewDecoder(bytes.NewReader(buf.Bytes()), BigEndian)
+	dec.MaxSize = 12
+	v := make([]uint32, 2)
+	if err := dec.Decode(v); err != nil || v[0] != 1 || v[1] != 2 {
+		t.Fatalf("unexpected decoded value %v (error %v)", v, err)
+	}
+	if err := dec.Decode(v); err != ErrTooLarge {
+		t.Errorf("expecting ErrTooLarge, got %v", err)
+	}
+	if n := dec.Decoded(); n != 8 {
+		t.Errorf("expecting 8 decoded bytes, got %d", n)
+	}
+	var nested [1][1][1][1]uint8
+	dec = NewDecoder(bytes.NewReader([]byte{1}), BigEndian)
+	dec.MaxDepth = 3
+	if err := dec.Decode(&nested); err != ErrTooDeep {
+		t.Errorf("expecting ErrTooDeep, got %v", err)
+	}
+	dec.MaxDepth = 4
+	if err := dec.Decode(&nested); err != nil || nested[0][0][0][0] != 1 {
+		t.Errorf("unexpected decoded value %v (error %v)", nested, err)
+	}
+	// A corrupt length prefix must not allocate the reported size
+	prefix := make([]byte, MaxVarintLen64)
+	n := PutUvarint(prefix, 1<<40)
+	dec = NewDecoder(bytes.NewReader(append(prefix[:n], "short"...)), BigEndian)
+	if _, err := dec.DecodeBytes(); err != io.ErrUnexpectedEOF {
+		t.Errorf("expecting io.ErrUnexpectedEOF, got %v", err)
+	}
+	dec = NewDecoder(bytes.NewReader(append(prefix[:n], "short"...)), BigEndian)
+	dec.MaxSize = 1024
+	if _, err := dec.DecodeBytes(); err != ErrTooLarge {
+		t.Errorf("expecting ErrTooLarge, got %v", err)
+	}
+	n = PutUvarint(prefix, 5)
+	dec = NewDecoder(bytes.NewReader(append(prefix[:n], "hello"...)), BigEndian)
+	if b, err := dec.DecodeBytes(); err != nil || string(b) != "hello" {
+		t.Errorf("expecting hello, got %q (error %v)", b, err)
+	}
+	// Endless varint
+	dec = NewDecoder(bytes.NewReader(bytes.Repeat([]byte{0x80}, 100)), BigEndian)
+	if _, err := dec.DecodeUvarint(); err != overflow {
+		t.Errorf("expecting overflow, got %v", err)
+	}
+}
+
+func TestAppendConsume(t *testing.T) {
+	for _, tc := range []struct {
+		order *ByteOrder
+		b     []byte
+	}{
+		{BigEndian, big},
+		{LittleEndian, little},
+	} {
+		prefix := []byte{0xff}
+		buf, err := AppendTo(prefix, tc.order, &s)
+		checkResult(t, "AppendTo", tc.order, err, buf, append([]byte{0xff}, tc.b...))
+		var s2 Struct
+		rest, err := Consume(buf[1:], tc.order, &s2)
+		checkResult(t, "Consume", tc.order, err, s2, s)
+		if len(rest) != 0 {
+			t.Errorf("Consume %v left %d bytes", tc.order, len(rest))
+		}
+	}
+	// Reusing a buffer with enough capacity
+	buf := make([]byte, 0, 64)
+	buf, err := AppendTo(buf, BigEndian, int16(0x0102))
+	if err == nil {
+		buf, err = AppendTo(buf, BigEndian, res)
+	}
+	checkResult(t, "AppendTo", BigEndian, err, buf, append([]byte{1, 2}, src...))
+	if cap(buf) != 64 {
+		t.Errorf("AppendTo reallocated the buffer, cap = %d", cap(buf))
+	}
+	var i16 int16
+	ints := make([]int32, 2)
+	rest, err := Consume(buf, BigEndian, &i16)
+	if err == nil {
+		rest, err = Consume(rest, BigEndian, ints)
+	}
+	checkResult(t, "Consume", BigEndian, err, ints, res)
+	if i16 != 0x0102 || len(rest) != 0 {
+		t.Errorf("Consume: have %x with %d bytes left, want 0102 with 0 bytes left", i16, len(rest))
+	}
+	var s2 Struct
+	if _, err := Consume(big[:len(big)-1], BigEndian, &s2); err != io.ErrUnexpectedEOF {
+		t.Errorf("expecting io.ErrUnexpectedEOF when consuming a short buffer, got %v", err)
+	}
+	if _, err := AppendTo(nil, BigEndian, nil); err == nil {
+		t.Error("expecting error when appending nil")
+	}
+}
+
+func BenchmarkAppendStruct(b *testing.B) {
+	b.SetBytes(int64(len(big)))
+	buf := make([]byte, 0, len(big))
+	var t interface{} = &s
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf, _ = AppendTo(buf[:0], BigEndian, t)
+	}
+}
+
+func BenchmarkConsumeStruct(b *testing.B) {
+	b.SetBytes(int64(len(big)))
+	var t Struct
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Consume(big, BigEndian, &t)
+	}
+	b.StopTimer()
+	if !reflect.DeepEqual(s, t) {
+		b.Fatal("no match")
+	}
+}
+
+func BenchmarkAppendInts(b *testing.B) {
+	b.SetBytes(2 * (1 + 2 + 4 + 8))
+	buf := make([]byte, 0, 30)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf, _ = AppendTo(buf[:0], BigEndian, s.Int8)
+		buf, _ = AppendTo(buf, BigEndian, s.Int16)
+		buf, _ = AppendTo(buf, BigEndian, s.Int32)
+		buf, _ = AppendTo(buf, BigEndian, s.Int64)
+		buf, _ = AppendTo(buf, BigEndian, s.Uint8)
+		buf, _ = AppendTo(buf, BigEndian, s.Uint16)
+		buf, _ = AppendTo(buf, BigEndian, s.Uint32)
+		buf, _ = AppendTo(buf, BigEndian, s.Uint64)
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, big[:30]) {
+		b.Fatalf("first half doesn't match: %x %x", buf, big[:30])
+	}
+}
+
+func BenchmarkConsumeInts(b *testing.B) {
+	var ls Struct
+	b.SetBytes(2 * (1 + 2 + 4 + 8))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rest, _ := Consume(big, BigEndian, &ls.Int8)
+		rest, _ = Consume(rest, BigEndian, &ls.Int16)
+		rest, _ = Consume(rest, BigEndian, &ls.Int32)
+		rest, _ = Consume(rest, BigEndian, &ls.Int64)
+		rest, _ = Consume(rest, BigEndian, &ls.Uint8)
+		rest, _ = Consume(rest, BigEndian, &ls.Uint16)
+		rest, _ = Consume(rest, BigEndian, &ls.Uint32)
+		Consume(rest, BigEndian, &ls.Uint64)
+	}
+}
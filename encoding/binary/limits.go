@@ -0,0 +1,189 @@
+package binary
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+)
+
+// DefaultMaxDepth is the maximum nesting of arrays, slices and
+// structs in the types decoded by a Decoder when its MaxDepth
+// field is zero.
+const DefaultMaxDepth = 32
+
+// maxPreallocation is the maximum number of bytes allocated
+// upfront for a length prefixed value. Larger values are
+// read incrementally, so a corrupt length can't make the
+// Decoder allocate more memory than the data available.
+const maxPreallocation = 64 * 1024
+
+var (
+	// ErrTooLarge is returned by a Decoder when the decoded data
+	// would exceed its MaxSize.
+	ErrTooLarge = errors.New("binary: decoded data exceeds the size limit")
+	// ErrTooDeep is returned by a Decoder when the type to decode
+	// nests arrays, slices or structs deeper than its MaxDepth.
+	ErrTooDeep = errors.New("binary: decoded type exceeds the nesting limit")
+)
+
+// Decoder reads and decodes data from an input stream, like Read,
+// while enforcing limits on the decoded data, so it can be safely
+// used with untrusted input (e.g. data received from the network).
+// Limits are checked before reading or allocating anything, so
+// malformed input produces an error rather than huge allocations.
+type Decoder struct {
+	r     io.Reader
+	order *ByteOrder
+	n     int64
+	// MaxSize is the maximum number of bytes decoded in total
+	// by the Decoder. Zero means no limit.
+	MaxSize int64
+	// MaxDepth is the maximum nesting of arrays, slices and structs
+	// in the decoded types. Zero means DefaultMaxDepth, while a
+	// negative value disables the limit.
+	MaxDepth int
+}
+
+// NewDecoder returns a new Decoder which reads from r,
+// using the given byte order.
+func NewDecoder(r io.Reader, order *ByteOrder) *Decoder {
+	return &Decoder{r: r, order: order}
+}
+
+// Decoded returns the number of bytes decoded so far.
+func (d *Decoder) Decoded() int64 {
+	return d.n
+}
+
+// Decode reads structured binary data into data, which must be a
+// pointer to a fixed-size value or a slice of fixed-size values.
+// See Read for the details. If the data exceeds any of the Decoder
+// limits, ErrTooLarge or ErrTooDeep are returned without reading
+// anything from the input.
+func (d *Decoder) Decode(data interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(data))
+	if !v.IsValid() {
+		return errors.New("binary: can't decode into nil")
+	}
+	if err := d.checkDepth(v.Type()); err != nil {
+		return err
+	}
+	size, err := dataSize(v)
+	if err != nil {
+		return errors.New("binary: " + err.Error())
+	}
+	if err := d.reserve(int64(size)); err != nil {
+		return err
+	}
+	return Read(d.r, d.order, data)
+}
+
+// DecodeUvarint reads an unsigned varint.
+func (d *Decoder) DecodeUvarint() (uint64, error) {
+	br := byteReader{d}
+	return ReadUvarint(&br)
+}
+
+// DecodeVarint reads a signed varint.
+func (d *Decoder) DecodeVarint() (int64, error) {
+	br := byteReader{d}
+	return ReadVarint(&br)
+}
+
+// DecodeBytes reads a byte slice prefixed by its length, encoded
+// as an unsigned varint. If the length exceeds the Decoder MaxSize,
+// ErrTooLarge is returned without reading the data. Otherwise,
+// large values are read incrementally, so a corrupt length returns
+// io.ErrUnexpectedEOF after reading all the available data, rather
+// than allocating the memory for the whole value upfront.
+func (d *Decoder) DecodeBytes() ([]byte, error) {
+	n, err := d.DecodeUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(^uint(0)>>1) {
+		return nil, ErrTooLarge
+	}
+	if err := d.reserve(int64(n)); err != nil {
+		return nil, err
+	}
+	if n <= maxPreallocation {
+		b := make([]byte, n)
+		if _, err := io.ReadFull(d.r, b); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		return b, nil
+	}
+	var buf bytes.Buffer
+	buf.Grow(maxPreallocation)
+	if _, err := io.CopyN(&buf, d.r, int64(n)); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// reserve accounts for n bytes to be decoded, returning
+// ErrTooLarge if they would exceed MaxSize.
+func (d *Decoder) reserve(n int64) error {
+	if n < 0 || (d.MaxSize > 0 && n > d.MaxSize-d.n) {
+		return ErrTooLarge
+	}
+	d.n += n
+	return nil
+}
+
+func (d *Decoder) checkDepth(typ reflect.Type) error {
+	max := d.MaxDepth
+	if max == 0 {
+		max = DefaultMaxDepth
+	}
+	if max > 0 && typeDepth(typ, max+1) > max {
+		return ErrTooDeep
+	}
+	return nil
+}
+
+// typeDepth returns the nesting of arrays, slices and structs in
+// typ, stopping when it reaches limit.
+func typeDepth(typ reflect.Type, limit int) int {
+	if limit <= 0 {
+		return 0
+	}
+	switch typ.Kind() {
+	case reflect.Array, reflect.Slice:
+		return 1 + typeDepth(typ.Elem(), limit-1)
+	case reflect.Struct:
+		depth := 0
+		for ii := 0; ii < typ.NumField(); ii++ {
+			if fd := typeDepth(typ.Field(ii).Type, limit-1); fd > depth {
+				depth = fd
+			}
+		}
+		return 1 + depth
+	}
+	return 0
+}
+
+// byteReader implements io.ByteReader on top of a Decoder,
+// accounting for the read bytes.
+type byteReader struct {
+	d *Decoder
+}
+
+func (r *byteReader) ReadByte() (byte, error) {
+	if err := r.d.reserve(1); err != nil {
+		return 0, err
+	}
+	var b [1]byte
+	if _, err := io.ReadFull(r.d.r, b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
@@ -103,10 +103,15 @@ func Varint(buf []byte) (int64, int) {
 var overflow = errors.New("binary: varint overflows a 64-bit integer")
 
 // ReadUvarint reads an encoded unsigned integer from r and returns it as a uint64.
+// It stops reading and returns an error after MaxVarintLen64 bytes without
+// finding the end of the varint, so malformed input can't make it read forever.
 func ReadUvarint(r io.ByteReader) (uint64, error) {
 	var x uint64
 	var s uint
 	for i := 0; ; i++ {
+		if i == MaxVarintLen64 {
+			return 0, overflow
+		}
 		b, err := r.ReadByte()
 		if err != nil {
 			return x, err
@@ -0,0 +1,35 @@
+// Package cbor provides a codec implementation using CBOR
+// (Concise Binary Object Representation, RFC 7049).
+//
+// To enable it in your app, import it like:
+//
+//  import (
+//	_ "gnd.la/encoding/codec/cbor"
+//  )
+package cbor
+
+import (
+	gocodec "github.com/ugorji/go/codec"
+	"gnd.la/encoding/codec"
+)
+
+var (
+	cborCodec = &codec.Codec{Encode: cborMarshal, Decode: cborUnmarshal, Binary: true}
+	handle    = &gocodec.CborHandle{}
+)
+
+func cborMarshal(in interface{}) ([]byte, error) {
+	var b []byte
+	enc := gocodec.NewEncoderBytes(&b, handle)
+	err := enc.Encode(in)
+	return b, err
+}
+
+func cborUnmarshal(data []byte, out interface{}) error {
+	dec := gocodec.NewDecoderBytes(data, handle)
+	return dec.Decode(out)
+}
+
+func init() {
+	codec.Register("cbor", cborCodec)
+}
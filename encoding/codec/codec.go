@@ -6,6 +6,10 @@
 //
 // This package provides the "gob" and "json" codecs, which encode
 // the data using encoding/gob and encoding/json, respectivelly.
+// Additionally, the "msgpack" and "cbor" codecs, which produce
+// smaller payloads than gob while keeping binary data as is
+// (unlike JSON), are available by importing gnd.la/encoding/codec/msgpack
+// and gnd.la/encoding/codec/cbor.
 // Check gnd.la/cache and gnd.la/orm to learn how to use codecs with
 // Gondola's cache and ORM.
 //
@@ -14,12 +18,15 @@
 package codec
 
 import (
+	"sort"
+
 	"gnd.la/util/structs"
 )
 
 var (
 	codecs  = map[string]*Codec{}
 	imports = map[string]string{
+		"cbor":    "gnd.la/encoding/codec/cbor",
 		"msgpack": "gnd.la/encoding/codec/msgpack",
 	}
 )
@@ -65,3 +72,20 @@ func FromTag(t *structs.Tag) *Codec {
 func RequiredImport(name string) string {
 	return imports[name]
 }
+
+// Names returns the names of the registered codecs, as well as the
+// names of the codecs which can be registered by importing their
+// package (see RequiredImport), sorted alphabetically.
+func Names() []string {
+	var names []string
+	for k := range codecs {
+		names = append(names, k)
+	}
+	for k := range imports {
+		if codecs[k] == nil {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -30,5 +30,9 @@ func msgpackUnmarshal(data []byte, out interface{}) error {
 }
 
 func init() {
+	// Use the bin and str types from the current msgpack
+	// spec, so []byte and string values are kept apart.
+	handle.WriteExt = true
+	handle.RawToString = true
 	codec.Register("msgpack", msgpackCodec)
 }
@@ -0,0 +1,206 @@
+// Package aes provides a pipe which encrypts data using AES-256-GCM,
+// so sensitive values (e.g. in ORM fields or in a shared cache) are
+// stored encrypted.
+//
+// To enable it in your app, import it like:
+//
+//  import (
+//	_ "gnd.la/encoding/pipe/aes"
+//  )
+//
+// And then use the "aes" pipe (e.g. pipe=aes). The encryption keys
+// are read from the configuration, using the following keys:
+//
+//  pipe-keys: Comma separated list of id=secret pairs (e.g. 2015=foo,2016=bar)
+//  pipe-key-id: The id of the key used for encrypting new data
+//
+// The AES key for each id is derived from its secret, which can't contain
+// commas nor equal signs. If there's just one key, pipe-key-id might be
+// omitted. Encrypted data is prefixed by its key id, so it's decrypted
+// using the key it was encrypted with. This allows rotating the keys by
+// adding a new one and setting it as the current key, while keeping the
+// previous ones until all the data encrypted with them has been rewritten
+// or has expired. Keys might also be set by calling SetKeys.
+package aes
+
+import (
+	caes "crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"gnd.la/config"
+	"gnd.la/encoding/pipe"
+)
+
+const maxKeyIdLength = 255
+
+var (
+	// ErrNoKeys is returned when trying to encrypt or decrypt
+	// data without having any keys configured.
+	ErrNoKeys = errors.New("no keys configured for the aes pipe, set pipe-keys in your config")
+	// ErrInvalidData is returned when the data can't be decrypted,
+	// either because it has been tampered with or because it wasn't
+	// encrypted by this pipe.
+	ErrInvalidData = errors.New("invalid encrypted data")
+
+	pipeConfig = &Config{}
+	current    struct {
+		sync.RWMutex
+		keys *keyring
+	}
+)
+
+// Config represents the configuration for the aes pipe.
+// See the package documentation for the configuration keys.
+type Config struct {
+	PipeKeys  map[string]string `help:"Keys used by the aes pipe, as id=secret pairs separated by commas" secret:"true"`
+	PipeKeyId string            `help:"Id of the key in pipe-keys used for encrypting data with the aes pipe"`
+}
+
+// Validate implements the gnd.la/config.Validator interface.
+func (c *Config) Validate() error {
+	_, err := newKeyring(c.PipeKeys, c.PipeKeyId)
+	return err
+}
+
+type keyring struct {
+	ciphers map[string]cipher.AEAD
+	id      string
+}
+
+func newKeyring(keys map[string]string, id string) (*keyring, error) {
+	if len(keys) == 0 {
+		if id != "" {
+			return nil, fmt.Errorf("aes pipe key id %q specified without any keys", id)
+		}
+		return nil, nil
+	}
+	if id == "" {
+		if len(keys) > 1 {
+			return nil, errors.New("multiple aes pipe keys specified without a key id")
+		}
+		for k := range keys {
+			id = k
+		}
+	}
+	if _, ok := keys[id]; !ok {
+		return nil, fmt.Errorf("aes pipe key id %q does not exist", id)
+	}
+	k := &keyring{
+		ciphers: make(map[string]cipher.AEAD, len(keys)),
+		id:      id,
+	}
+	for kid, secret := range keys {
+		if kid == "" || len(kid) > maxKeyIdLength {
+			return nil, fmt.Errorf("invalid aes pipe key id %q, it must have between 1 and %d bytes", kid, maxKeyIdLength)
+		}
+		if secret == "" {
+			return nil, fmt.Errorf("empty secret for aes pipe key %q", kid)
+		}
+		sum := sha256.Sum256([]byte(secret))
+		block, err := caes.NewCipher(sum[:])
+		if err != nil {
+			return nil, err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		k.ciphers[kid] = aead
+	}
+	return k, nil
+}
+
+// SetKeys sets the keys used by the pipe, replacing the ones
+// from the configuration. keys maps key ids to their secrets,
+// while id indicates the key used for encrypting new data. If
+// there's just one key, id might be empty.
+func SetKeys(keys map[string]string, id string) error {
+	k, err := newKeyring(keys, id)
+	if err != nil {
+		return err
+	}
+	current.Lock()
+	current.keys = k
+	current.Unlock()
+	return nil
+}
+
+func currentKeys() (*keyring, error) {
+	current.RLock()
+	k := current.keys
+	current.RUnlock()
+	if k == nil {
+		return nil, ErrNoKeys
+	}
+	return k, nil
+}
+
+// aesEncode encrypts the data with the current key. The output
+// contains the key id length as a byte, the key id, the nonce and
+// the sealed data. The key id is also used as additional data.
+func aesEncode(b []byte) ([]byte, error) {
+	k, err := currentKeys()
+	if err != nil {
+		return nil, err
+	}
+	aead := k.ciphers[k.id]
+	prefix := 1 + len(k.id)
+	ns := aead.NonceSize()
+	out := make([]byte, prefix+ns, prefix+ns+len(b)+aead.Overhead())
+	out[0] = byte(len(k.id))
+	copy(out[1:], k.id)
+	nonce := out[prefix:]
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(out, nonce, b, out[1:prefix]), nil
+}
+
+func aesDecode(b []byte) ([]byte, error) {
+	k, err := currentKeys()
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, ErrInvalidData
+	}
+	prefix := 1 + int(b[0])
+	if len(b) < prefix {
+		return nil, ErrInvalidData
+	}
+	id := string(b[1:prefix])
+	aead := k.ciphers[id]
+	if aead == nil {
+		return nil, fmt.Errorf("data was encrypted with unknown aes pipe key %q", id)
+	}
+	ns := aead.NonceSize()
+	if len(b) < prefix+ns {
+		return nil, ErrInvalidData
+	}
+	data, err := aead.Open(nil, b[prefix:prefix+ns], b[prefix+ns:], b[1:prefix])
+	if err != nil {
+		return nil, ErrInvalidData
+	}
+	return data, nil
+}
+
+func init() {
+	pipe.Register("aes", &pipe.Pipe{
+		Encode: aesEncode,
+		Decode: aesDecode,
+	})
+	config.RegisterFunc(pipeConfig, func() {
+		// Don't overwrite the keys set with SetKeys when
+		// there are no keys in the configuration.
+		if len(pipeConfig.PipeKeys) > 0 {
+			// Keys were already checked by Validate
+			SetKeys(pipeConfig.PipeKeys, pipeConfig.PipeKeyId)
+		}
+	})
+}
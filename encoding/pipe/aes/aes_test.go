@@ -0,0 +1,106 @@
+package aes
+
+import (
+	"bytes"
+	"testing"
+
+	"gnd.la/encoding/pipe"
+)
+
+func TestEncrypt(t *testing.T) {
+	p := pipe.Get("aes")
+	data := []byte("The quick brown fox jumps over the lazy dog")
+	if err := SetKeys(nil, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Encode(data); err != ErrNoKeys {
+		t.Errorf("expecting ErrNoKeys, got %v", err)
+	}
+	if err := SetKeys(map[string]string{"1": "foo"}, ""); err != nil {
+		t.Fatal(err)
+	}
+	enc1, err := p.Encode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(enc1, data) {
+		t.Error("encrypted data contains the plaintext")
+	}
+	// Rotate the key
+	if err := SetKeys(map[string]string{"1": "foo", "2": "bar"}, "2"); err != nil {
+		t.Fatal(err)
+	}
+	enc2, err := p.Encode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enc2[0] != 1 || enc2[1] != '2' {
+		t.Errorf("expecting data encrypted with key 2, got prefix %q", enc2[:2])
+	}
+	for _, v := range [][]byte{enc1, enc2} {
+		dec, err := p.Decode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(dec, data) {
+			t.Errorf("expecting %q, got %q", data, dec)
+		}
+	}
+	enc2[len(enc2)-1] ^= 1
+	if _, err := p.Decode(enc2); err != ErrInvalidData {
+		t.Errorf("expecting ErrInvalidData for tampered data, got %v", err)
+	}
+	for _, v := range [][]byte{nil, {}, {5, '2'}} {
+		if _, err := p.Decode(v); err != ErrInvalidData {
+			t.Errorf("expecting ErrInvalidData for %v, got %v", v, err)
+		}
+	}
+	// Remove the old key
+	if err := SetKeys(map[string]string{"2": "bar"}, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Decode(enc1); err == nil {
+		t.Error("expecting an error when decrypting with a removed key")
+	}
+	for _, v := range []struct {
+		keys map[string]string
+		id   string
+	}{
+		{nil, "1"},
+		{map[string]string{"1": "foo", "2": "bar"}, ""},
+		{map[string]string{"1": "foo"}, "2"},
+		{map[string]string{"1": ""}, "1"},
+	} {
+		if err := SetKeys(v.keys, v.id); err == nil {
+			t.Errorf("expecting an error setting keys %v with id %q", v.keys, v.id)
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	if err := SetKeys(map[string]string{"1": "foo"}, ""); err != nil {
+		t.Fatal(err)
+	}
+	p := pipe.Get("zlib|aes")
+	if p == nil {
+		t.Fatal("chained pipe is nil")
+	}
+	data := bytes.Repeat([]byte("gondola"), 100)
+	enc, err := p.Encode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) >= len(data) {
+		t.Errorf("expecting compressed data, got %d bytes from %d", len(enc), len(data))
+	}
+	dec, err := p.Decode(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dec, data) {
+		t.Error("decoded data does not match the original")
+	}
+	if pipe.Get("zlib|nonexistent") != nil {
+		t.Error("expecting nil pipe when chaining an unknown pipe")
+	}
+}
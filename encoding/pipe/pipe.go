@@ -5,16 +5,37 @@
 // any value bigger than 100 bytes using the default zlib
 // compression level. To tell compressed and uncompressed
 // data apart, it prepends a byte to its output (0 for
-// uncompressed, 1 for compressed).
+// uncompressed, 1 for compressed). Additionally, the "zstd"
+// and "aes" pipes, for compressing with Zstandard and encrypting
+// with AES-GCM, are available by importing gnd.la/encoding/pipe/zstd
+// and gnd.la/encoding/pipe/aes.
+//
+// Pipes can be chained by separating their names with |. e.g.
+// the following ORM field is compressed and then encrypted:
+//
+//  Data []byte `orm:",codec=gob,pipe=zstd|aes"`
 package pipe
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+
 	"gnd.la/util/structs"
 )
 
+const (
+	// Separator is used to separate the names
+	// of chained pipes (e.g. zstd|aes).
+	Separator = "|"
+)
+
 var (
 	registry = map[string]*Pipe{}
+	imports  = map[string]string{
+		"aes":  "gnd.la/encoding/pipe/aes",
+		"zstd": "gnd.la/encoding/pipe/zstd",
+	}
 )
 
 // Pipe represents a codec pipe, which can encode and decode data
@@ -39,19 +60,86 @@ func Register(name string, p *Pipe) {
 }
 
 // Get returns the Pipe with the give name, or nil if there's no such Pipe.
+// If name contains several pipe names separated by Separator, the
+// returned Pipe passes the data trough all of them in the given order
+// when encoding and in the reverse order when decoding. If any of them
+// does not exist, nil is returned.
 func Get(name string) *Pipe {
-	return registry[name]
+	if !strings.Contains(name, Separator) {
+		return registry[name]
+	}
+	var pipes []*Pipe
+	for _, v := range strings.Split(name, Separator) {
+		p := registry[v]
+		if p == nil {
+			return nil
+		}
+		pipes = append(pipes, p)
+	}
+	return chain(pipes)
+}
+
+func chain(pipes []*Pipe) *Pipe {
+	return &Pipe{
+		Encode: func(data []byte) ([]byte, error) {
+			var err error
+			for _, v := range pipes {
+				if len(data) == 0 {
+					break
+				}
+				if data, err = v.Encode(data); err != nil {
+					return nil, err
+				}
+			}
+			return data, nil
+		},
+		Decode: func(data []byte) ([]byte, error) {
+			var err error
+			for ii := len(pipes) - 1; ii >= 0; ii-- {
+				if len(data) == 0 {
+					break
+				}
+				if data, err = pipes[ii].Decode(data); err != nil {
+					return nil, err
+				}
+			}
+			return data, nil
+		},
+	}
+}
+
+// Names returns the names of the registered pipes, as well as
+// the names of the pipes which can be registered by importing
+// their package (see RequiredImport), sorted alphabetically.
+func Names() []string {
+	var names []string
+	for k := range registry {
+		names = append(names, k)
+	}
+	for k := range imports {
+		if registry[k] == nil {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	return names
 }
 
 // FromTag returns the pipe for a given field tag.
 func FromTag(t *structs.Tag) *Pipe {
-	return registry[t.PipeName()]
+	return Get(t.PipeName())
 }
 
 // RequiredImport returns the import required
 // for using the pipe with the given name, or
 // the empty string if the pipe is not known.
+// For chained pipes, the import for the first
+// pipe which is not registered is returned.
 func RequiredImport(name string) string {
-	// no pipes require imports for now
+	for _, v := range strings.Split(name, Separator) {
+		if registry[v] == nil {
+			return imports[v]
+		}
+	}
 	return ""
 }
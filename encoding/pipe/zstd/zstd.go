@@ -0,0 +1,57 @@
+// Package zstd provides a pipe which compresses data using Zstandard.
+// Values shorter than 100 bytes or which would take more space when
+// compressed are stored uncompressed. Note that Zstandard compresses
+// faster and better than zlib in most cases.
+//
+// To enable it in your app, import it like:
+//
+//  import (
+//	_ "gnd.la/encoding/pipe/zstd"
+//  )
+//
+// And then use the "zstd" pipe (e.g. pipe=zstd).
+package zstd
+
+import (
+	"bytes"
+
+	"github.com/klauspost/compress/zstd"
+	"gnd.la/encoding/pipe"
+)
+
+const minCompress = 100
+
+var (
+	// magic is the Zstandard frame magic number.
+	magic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+	encoder, _ = zstd.NewWriter(nil)
+	decoder, _ = zstd.NewReader(nil)
+)
+
+func zstdEncode(b []byte) ([]byte, error) {
+	if len(b) >= minCompress {
+		if out := encoder.EncodeAll(b, nil); len(out) < len(b) {
+			return out, nil
+		}
+	}
+	return b, nil
+}
+
+func zstdDecode(b []byte) ([]byte, error) {
+	if !bytes.HasPrefix(b, magic) {
+		// Stored uncompressed
+		return b, nil
+	}
+	if data, err := decoder.DecodeAll(b, nil); err == nil {
+		return data, nil
+	}
+	return b, nil
+}
+
+func init() {
+	pipe.Register("zstd", &pipe.Pipe{
+		Encode: zstdEncode,
+		Decode: zstdDecode,
+	})
+}
@@ -0,0 +1,98 @@
+package form
+
+import (
+	"bytes"
+	"strconv"
+	"time"
+
+	"gnd.la/html"
+	"gnd.la/i18n"
+)
+
+const (
+	// submitTimeName is the name of the hidden input with the
+	// signed time when the form was rendered.
+	submitTimeName = "gondola_form_ts"
+	submitTimeSalt = "gnd.la/form.submit-time-salt"
+)
+
+// writeAntispam writes the honeypot and submit time inputs,
+// if enabled in the form options. They're only written once,
+// regardless of how many times the form is rendered.
+func (f *Form) writeAntispam(buf *bytes.Buffer) error {
+	if f.options == nil || f.antispamWritten {
+		return nil
+	}
+	if name := f.options.Honeypot; name != "" {
+		// Hide the input from humans, using CSS rather than
+		// type="hidden", since bots might skip hidden inputs.
+		f.openTag(buf, "div", html.Attrs{"style": "display:none", "aria-hidden": "true"})
+		f.openTag(buf, "input", html.Attrs{
+			"type":         "text",
+			"name":         name,
+			"value":        "",
+			"tabindex":     "-1",
+			"autocomplete": "off",
+		})
+		f.closeTag(buf, "div")
+	}
+	if f.options.MinSubmitTime > 0 {
+		signer, err := f.ctx.App().Signer([]byte(submitTimeSalt))
+		if err != nil {
+			return err
+		}
+		now := strconv.FormatInt(time.Now().UnixNano(), 10)
+		signed, err := signer.Sign([]byte(now))
+		if err != nil {
+			return err
+		}
+		f.openTag(buf, "input", html.Attrs{
+			"type":  "hidden",
+			"name":  submitTimeName,
+			"value": signed,
+		})
+	}
+	f.antispamWritten = true
+	return nil
+}
+
+// validateAntispam checks the honeypot and submit time
+// inputs, if enabled in the form options. The submit time
+// must be between MinSubmitTime and MaxSubmitTime.
+func (f *Form) validateAntispam() error {
+	if f.options == nil {
+		return nil
+	}
+	if name := f.options.Honeypot; name != "" && f.ctx.FormValue(name) != "" {
+		return i18n.Errorfc("form", "invalid form submission")
+	}
+	if f.options.MinSubmitTime > 0 {
+		signer, err := f.ctx.App().Signer([]byte(submitTimeSalt))
+		if err != nil {
+			return err
+		}
+		data, err := signer.Unsign(f.ctx.FormValue(submitTimeName))
+		if err != nil {
+			return i18n.Errorfc("form", "invalid form submission")
+		}
+		ts, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return i18n.Errorfc("form", "invalid form submission")
+		}
+		elapsed := time.Since(time.Unix(0, ts))
+		if elapsed < 0 {
+			return i18n.Errorfc("form", "invalid form submission")
+		}
+		if elapsed < f.options.MinSubmitTime {
+			return i18n.Errorfc("form", "the form was submitted too fast, please try again")
+		}
+		maxTime := f.options.MaxSubmitTime
+		if maxTime <= 0 {
+			maxTime = DefaultMaxSubmitTime
+		}
+		if elapsed > maxTime {
+			return i18n.Errorfc("form", "the form has expired, please submit it again")
+		}
+	}
+	return nil
+}
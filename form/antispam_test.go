@@ -0,0 +1,96 @@
+package form
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+	"gnd.la/util/stringutil"
+)
+
+func antispamApp() *app.App {
+	a := app.New()
+	a.Config().Secret = stringutil.Random(32)
+	a.Handle("^/$", func(ctx *app.Context) {
+		var fields struct {
+			Name string
+		}
+		f := NewOpts(ctx, &Options{
+			DisableCSRF:   true,
+			Honeypot:      "website",
+			MinSubmitTime: time.Second,
+			MaxSubmitTime: time.Hour,
+		}, &fields)
+		if err := f.validateAntispam(); err != nil {
+			ctx.WriteString(err.Error())
+			return
+		}
+		ctx.WriteString("ok")
+	})
+	return a
+}
+
+func signedSubmitTime(t *testing.T, a *app.App, ts time.Time) string {
+	signer, err := a.Signer([]byte(submitTimeSalt))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := signer.Sign([]byte(strconv.FormatInt(ts.UnixNano(), 10)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestAntispam(t *testing.T) {
+	a := antispamApp()
+	tt := tester.New(t, a)
+	now := time.Now()
+	submit := func(ts time.Time) *tester.Request {
+		return tt.Form("/", map[string]interface{}{
+			"Name":         "foo",
+			submitTimeName: signedSubmitTime(t, a, ts),
+		})
+	}
+	submit(now.Add(-time.Minute)).Expect("ok")
+	submit(now).Expect("the form was submitted too fast, please try again")
+	submit(now.Add(-2 * time.Hour)).Expect("the form has expired, please submit it again")
+	submit(now.Add(time.Hour)).Expect("invalid form submission")
+	tt.Form("/", map[string]interface{}{
+		"Name":         "foo",
+		"website":      "http://www.example.com",
+		submitTimeName: signedSubmitTime(t, a, now.Add(-time.Minute)),
+	}).Expect("invalid form submission")
+	tt.Form("/", map[string]interface{}{
+		"Name":         "foo",
+		submitTimeName: strconv.FormatInt(now.Add(-time.Minute).UnixNano(), 10),
+	}).Expect("invalid form submission")
+}
+
+func TestAntispamDefaultMaxSubmitTime(t *testing.T) {
+	a := app.New()
+	a.Config().Secret = stringutil.Random(32)
+	a.Handle("^/$", func(ctx *app.Context) {
+		f := NewOpts(ctx, &Options{DisableCSRF: true, MinSubmitTime: time.Second})
+		if err := f.validateAntispam(); err != nil {
+			ctx.WriteString(err.Error())
+			return
+		}
+		ctx.WriteString("ok")
+	})
+	tt := tester.New(t, a)
+	for _, v := range []struct {
+		age    time.Duration
+		expect string
+	}{
+		{time.Hour, "ok"},
+		{DefaultMaxSubmitTime - time.Minute, "ok"},
+		{DefaultMaxSubmitTime + time.Minute, "the form has expired, please submit it again"},
+	} {
+		tt.Form("/", map[string]interface{}{
+			submitTimeName: signedSubmitTime(t, a, time.Now().Add(-v.age)),
+		}).Expect(v.expect)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"gnd.la/app"
+	"gnd.la/app/cookies"
 	"gnd.la/crypto/cryptoutil"
 	"gnd.la/i18n"
 	"gnd.la/util/stringutil"
@@ -16,13 +17,19 @@ const (
 	csrfSalt          = "gnd.la/form.csrf-salt"
 	randomSaltLength  = 32
 	randomValueLength = 64
+	// CSRFCookieName is the name of the cookie which stores the token
+	// which binds the CSRF values to the browser session.
+	CSRFCookieName    = "gondola-csrf"
+	csrfTokenLength   = 32
+	csrfTokenCacheKey = "gnd.la/form.csrf-token"
 )
 
 // csrf implements CSRF protection using signed and encrypted values,
 // following the given algorithm.
 //
-// 1 - Generate two random strings, join them by ':' and encrypt-sign them
-// with the App EncryptSigner using csrfSalt to obtain CSRFA.
+// 1 - Generate two random strings, join them by ':' along with the session token
+// (see csrfSessionToken) and encrypt-sign them with the App EncryptSigner using
+// csrfSalt to obtain CSRFA.
 // 2 - Generate another random string. EncryptSign using the first random string
 // in step 1 as salt to obtain CSRFB
 // 3 - Reverse random string in step 2. EncryptSign using the second random string
@@ -51,12 +58,17 @@ func (c *csrf) ValidateGondolaCSRFA(ctx *app.Context) error {
 		return c.error(ctx, err)
 	}
 	parts := strings.Split(string(val), ":")
-	if len(parts) != 2 {
+	if len(parts) != 3 {
 		return c.error(ctx, nil)
 	}
 	if len(parts[0]) < randomSaltLength || len(parts[1]) < randomSaltLength {
 		return c.error(ctx, nil)
 	}
+	// Check that the values were generated for this browser
+	token, err := csrfSessionToken(ctx, false)
+	if err != nil || subtle.ConstantTimeCompare([]byte(token), []byte(parts[2])) != 1 {
+		return c.error(ctx, nil)
+	}
 	c.salt1 = parts[0]
 	c.salt2 = parts[1]
 	return nil
@@ -107,7 +119,11 @@ func (c *csrf) generate(ctx *app.Context) (*csrf, error) {
 	if err != nil {
 		return nil, err
 	}
-	c.GondolaCSRFA, err = esA.EncryptSign([]byte(salt1 + ":" + salt2))
+	token, err := csrfSessionToken(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	c.GondolaCSRFA, err = esA.EncryptSign([]byte(salt1 + ":" + salt2 + ":" + token))
 	if err != nil {
 		return nil, err
 	}
@@ -152,18 +168,59 @@ func csrfEncryptSigner(ctx *app.Context, salt string) (*cryptoutil.EncryptSigner
 		if cfg == nil || cfg.Secret == "" {
 			return nil, errors.New("can't generate CSRF tokens, App configuration has no Secret")
 		}
+		var oldKeys [][]byte
 		if len(cfg.EncryptionKey) > 0 {
 			key = []byte(cfg.EncryptionKey)
 		} else {
-			s := sha256.New()
-			s.Write([]byte(cfg.Secret))
-			key = s.Sum(nil)
+			key = csrfSecretKey(cfg.Secret)
+			// Derive the old keys from the old secrets, so
+			// the tokens are still valid while rotating them
+			for _, v := range cfg.OldSecrets {
+				oldKeys = append(oldKeys, csrfSecretKey(v))
+			}
 		}
-		encrypter = &cryptoutil.Encrypter{Cipherer: a.Cipherer, Key: key}
+		encrypter = &cryptoutil.Encrypter{Cipherer: a.Cipherer, Key: key, OldKeys: oldKeys}
 	}
 	return &cryptoutil.EncryptSigner{Encrypter: encrypter, Signer: signer}, nil
 }
 
+// csrfSecretKey derives an encryption key from the given secret.
+func csrfSecretKey(secret string) []byte {
+	s := sha256.New()
+	s.Write([]byte(secret))
+	return s.Sum(nil)
+}
+
+// csrfSessionToken returns the random token which identifies the browser
+// session, stored in a signed cookie. If create is true and the browser
+// has no token, a new one is generated and sent to the client. Tokens are
+// cached in the context, so all the forms in the same response use the same
+// token.
+func csrfSessionToken(ctx *app.Context, create bool) (string, error) {
+	if token, ok := ctx.Get(csrfTokenCacheKey).(string); ok {
+		return token, nil
+	}
+	var token string
+	if err := ctx.Cookies().GetSecure(CSRFCookieName, &token); err == nil && len(token) >= csrfTokenLength {
+		ctx.Set(csrfTokenCacheKey, token)
+		return token, nil
+	}
+	if !create {
+		return "", errors.New("no CSRF session token")
+	}
+	token = stringutil.Random(csrfTokenLength)
+	opts := &cookies.Options{Path: "/", Expires: cookies.Permanent, HttpOnly: true}
+	if o := ctx.App().CookieOptions; o != nil {
+		opts.Domain = o.Domain
+		opts.Secure = o.Secure
+	}
+	if err := ctx.Cookies().SetSecureOpts(CSRFCookieName, token, opts); err != nil {
+		return "", err
+	}
+	ctx.Set(csrfTokenCacheKey, token)
+	return token, nil
+}
+
 func newCSRF(f *Form) (*csrf, error) {
 	c := &csrf{}
 	if !f.Submitted() {
@@ -0,0 +1,51 @@
+package form
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"gnd.la/form/input"
+	"gnd.la/i18n"
+	"gnd.la/util/types"
+)
+
+const (
+	// dateFormat is the format used by <input type="date">
+	dateFormat = "2006-01-02"
+)
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// validateDate parses the value submitted for a DATE
+// field backed by a time.Time.
+func (f *Form) validateDate(field *Field, label string, inp string) error {
+	inp = strings.TrimSpace(inp)
+	if inp == "" {
+		if !field.Tag().Optional() {
+			return input.RequiredInputError(label)
+		}
+		field.value.Set(reflect.ValueOf(time.Time{}))
+		return nil
+	}
+	t, err := time.Parse(dateFormat, inp)
+	if err != nil {
+		return i18n.Errorfc("form", "%q is not a valid date", inp)
+	}
+	field.value.Set(reflect.ValueOf(t))
+	return nil
+}
+
+// fieldStringValue returns the value of the field
+// formatted for the value attribute of an input.
+func fieldStringValue(field *Field) string {
+	if t, ok := field.Value().(time.Time); ok {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(dateFormat)
+	}
+	return types.ToString(field.Value())
+}
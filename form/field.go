@@ -15,14 +15,17 @@ type Field struct {
 	Placeholder i18n.String
 	Help        i18n.String
 
-	id     string
-	prefix string
-	addons []*AddOn
-	value  reflect.Value
-	s      *structs.Struct
-	sval   reflect.Value
-	pos    int
-	err    error
+	id      string
+	prefix  string
+	addons  []*AddOn
+	value   reflect.Value
+	s       *structs.Struct
+	sval    reflect.Value
+	pos     int
+	err     error
+	rows    []*formsetRow
+	widget  Widget
+	choices ChoicesFunc
 }
 
 func (f *Field) String() string {
@@ -55,3 +58,17 @@ func (f *Field) HasAddOns() bool {
 func (f *Field) Err() error {
 	return f.err
 }
+
+// Rows returns the forms for each element in a FORMSET
+// field, or nil if the field is not a FORMSET or it has
+// not been validated nor rendered yet.
+func (f *Field) Rows() []*Form {
+	if len(f.rows) == 0 {
+		return nil
+	}
+	forms := make([]*Form, len(f.rows))
+	for ii, v := range f.rows {
+		forms[ii] = v.form
+	}
+	return forms
+}
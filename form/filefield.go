@@ -0,0 +1,220 @@
+package form
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"gnd.la/app"
+	"gnd.la/form/input"
+	"gnd.la/html"
+	"gnd.la/i18n"
+)
+
+const (
+	// fileFieldSalt is used for signing the blob ids sent
+	// back to the client when the form is redisplayed.
+	fileFieldSalt = "gnd.la/form.file-field-salt"
+	// fileFieldBlobName is the name of the hidden input with
+	// the signed blob id, relative to the field name.
+	fileFieldBlobName = "_blob"
+	// sniffLength is the number of bytes used for
+	// detecting the content type of an upload.
+	sniffLength = 512
+)
+
+var (
+	fileFieldType = reflect.TypeOf(FileField(""))
+)
+
+// FileField represents a file uploaded using a form which is stored in the
+// App blobstore. Its value is the blob id, so it can be saved using the ORM
+// as any other string. When the form is validated, the uploaded file is
+// checked and streamed into the blobstore. The following tag options can be
+// used to validate the upload:
+//
+//  - accept: The allowed content types, separated by |. A wildcard might be
+//  used for the subtype, e.g. accept=image/*|application/pdf.
+//  - max_size: Maximum size of the file in bytes.
+//
+// If the form fails validation after the file has been stored, the blob id
+// is sent back to the client in a signed hidden input, so the user doesn't
+// need to upload the file again. The same happens when rendering a form for
+// a struct which already has a file. Note that this means files might be
+// stored for forms which are never successfully submitted.
+type FileField string
+
+// FileFieldMeta is the metadata stored in the blobstore
+// for each file uploaded using a FileField.
+type FileFieldMeta struct {
+	Filename    string
+	ContentType string
+	Size        int64
+}
+
+// IsEmpty returns true iff there's no file
+// associated with the field.
+func (f FileField) IsEmpty() bool {
+	return f == ""
+}
+
+// Id returns the blob id for the file.
+func (f FileField) Id() string {
+	return string(f)
+}
+
+// Meta returns the metadata stored for the file.
+func (f FileField) Meta(ctx *app.Context) (*FileFieldMeta, error) {
+	r, err := ctx.Blobstore().Open(f.Id())
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	var meta FileFieldMeta
+	if err := r.GetMeta(&meta); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+// accepts returns true iff the given content
+// type is accepted by the field.
+func (f *Form) accepts(field *Field, contentType string) bool {
+	accept := field.Tag().Value("accept")
+	if accept == "" {
+		return true
+	}
+	if p := strings.IndexByte(contentType, ';'); p >= 0 {
+		contentType = contentType[:p]
+	}
+	contentType = strings.TrimSpace(contentType)
+	for _, v := range strings.Split(accept, "|") {
+		if v == contentType {
+			return true
+		}
+		if strings.HasSuffix(v, "/*") && strings.HasPrefix(contentType, v[:len(v)-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+func fileSize(file multipart.File) (int64, error) {
+	size, err := file.Seek(0, 2)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := file.Seek(0, 0); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// validateFileField checks the file uploaded for the given field and stores
+// it in the blobstore. If no file was uploaded, the blob id is taken from
+// the signed hidden input. Otherwise, the current field value is kept.
+func (f *Form) validateFileField(field *Field, label string) error {
+	file, header, err := f.ctx.R.FormFile(field.HTMLName)
+	if err != nil {
+		if id := f.signedBlobId(field); id != "" {
+			field.value.SetString(id)
+		}
+		if field.Value().(FileField).IsEmpty() && !field.Tag().Optional() {
+			return input.RequiredInputError(label)
+		}
+		return nil
+	}
+	defer file.Close()
+	size, err := fileSize(file)
+	if err != nil {
+		return err
+	}
+	if maxSize, ok := field.Tag().IntValue("max_size"); ok && size > int64(maxSize) {
+		return i18n.Errorfc("form", "%s is too big (maximum size is %d bytes)", label, maxSize)
+	}
+	buf := make([]byte, sniffLength)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return err
+	}
+	buf = buf[:n]
+	contentType := http.DetectContentType(buf)
+	if ct := header.Header.Get("Content-Type"); ct != "" && strings.HasPrefix(contentType, "application/octet-stream") {
+		// Sniffing failed, trust the client
+		contentType = ct
+	}
+	if !f.accepts(field, contentType) {
+		return i18n.Errorfc("form", "%s has an invalid file type (%s)", label, contentType)
+	}
+	w, err := f.ctx.Blobstore().Create()
+	if err != nil {
+		return err
+	}
+	meta := &FileFieldMeta{
+		Filename:    header.Filename,
+		ContentType: contentType,
+		Size:        size,
+	}
+	if err := w.SetMeta(meta); err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, io.MultiReader(bytes.NewReader(buf), file)); err != nil {
+		w.Close()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	field.value.SetString(w.Id())
+	return nil
+}
+
+// signedBlobId returns the blob id sent in the hidden input for
+// the given field, or the empty string if there's no id or its
+// signature is not valid.
+func (f *Form) signedBlobId(field *Field) string {
+	value := f.ctx.FormValue(field.HTMLName + "." + fileFieldBlobName)
+	if value == "" {
+		return ""
+	}
+	signer, err := f.ctx.App().Signer([]byte(fileFieldSalt))
+	if err != nil {
+		return ""
+	}
+	id, err := signer.Unsign(value)
+	if err != nil {
+		return ""
+	}
+	return string(id)
+}
+
+// writeFileFieldValue writes the hidden input with the signed blob id
+// and the name of the current file, if the field has any.
+func (f *Form) writeFileFieldValue(buf *bytes.Buffer, field *Field) error {
+	value := field.Value().(FileField)
+	if value.IsEmpty() {
+		return nil
+	}
+	signer, err := f.ctx.App().Signer([]byte(fileFieldSalt))
+	if err != nil {
+		return err
+	}
+	signed, err := signer.Sign([]byte(value.Id()))
+	if err != nil {
+		return err
+	}
+	f.openTag(buf, "input", html.Attrs{
+		"type":  "hidden",
+		"name":  field.HTMLName + "." + fileFieldBlobName,
+		"value": signed,
+	})
+	if meta, err := value.Meta(f.ctx); err == nil && meta.Filename != "" {
+		f.openTag(buf, "span", html.Attrs{"class": "current-file"})
+		buf.WriteString(html.Escape(meta.Filename))
+		f.closeTag(buf, "span")
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package form
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/config"
+	"gnd.la/util/stringutil"
+)
+
+var pngData = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 8)...)
+
+type fileFieldUpload struct {
+	Image FileField `form:",accept=image/*|application/pdf,max_size=32"`
+}
+
+func fileFieldApp(t *testing.T) (*app.App, func()) {
+	dir, err := ioutil.TempDir("", "form-filefield")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := app.New()
+	a.Logger = nil
+	a.Config().Secret = stringutil.Random(32)
+	a.Config().Blobstore = config.MustParseURL("file://" + dir)
+	a.Handle("^/$", func(ctx *app.Context) {
+		var upload fileFieldUpload
+		if id := ctx.FormValue("current"); id != "" {
+			upload.Image = FileField(id)
+		}
+		f := NewOpts(ctx, &Options{DisableCSRF: true}, &upload)
+		if ctx.R.Method == "POST" {
+			if !f.IsValid() {
+				for _, v := range f.Errors() {
+					fmt.Fprintf(ctx, "error: %s", v.Err)
+				}
+				return
+			}
+			ctx.WriteString(upload.Image.Id())
+			return
+		}
+		html, err := f.Render()
+		if err != nil {
+			panic(err)
+		}
+		ctx.WriteString(string(html))
+	})
+	return a, func() { os.RemoveAll(dir) }
+}
+
+func postFileField(a *app.App, filename string, data []byte, fields map[string]string) string {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for k, v := range fields {
+		w.WriteField(k, v)
+	}
+	if data != nil {
+		fw, _ := w.CreateFormFile("image", filename)
+		fw.Write(data)
+	}
+	w.Close()
+	r, _ := http.NewRequest("POST", "http://localhost/", &buf)
+	r.Header.Set("Content-Type", w.FormDataContentType())
+	rec := httptest.NewRecorder()
+	a.ServeHTTP(rec, r)
+	return rec.Body.String()
+}
+
+func TestFileFieldUpload(t *testing.T) {
+	a, cleanup := fileFieldApp(t)
+	defer cleanup()
+	id := postFileField(a, "image.png", pngData, nil)
+	if strings.HasPrefix(id, "error") || id == "" {
+		t.Fatalf("unexpected upload response %q", id)
+	}
+	ctx := a.NewContext(nil)
+	defer a.CloseContext(ctx)
+	meta, err := FileField(id).Meta(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.Filename != "image.png" || meta.ContentType != "image/png" || meta.Size != int64(len(pngData)) {
+		t.Errorf("unexpected file metadata %+v", meta)
+	}
+	r, err := ctx.Blobstore().Open(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil || !bytes.Equal(data, pngData) {
+		t.Errorf("stored file does not match upload (err %v)", err)
+	}
+	if resp := postFileField(a, "doc.pdf", []byte("%PDF-1.4"), nil); strings.HasPrefix(resp, "error") {
+		t.Errorf("expecting PDF to be accepted, got %q", resp)
+	}
+	for _, v := range []struct {
+		data   []byte
+		expect string
+	}{
+		{[]byte("hello"), "invalid file type (text/plain; charset=utf-8)"},
+		{append(pngData, bytes.Repeat([]byte{0}, 32)...), "is too big (maximum size is 32 bytes)"},
+		{nil, "required"},
+	} {
+		if resp := postFileField(a, "file", v.data, nil); !strings.HasPrefix(resp, "error") || !strings.Contains(resp, v.expect) {
+			t.Errorf("expecting error containing %q, got %q", v.expect, resp)
+		}
+	}
+}
+
+func TestFileFieldSignedBlob(t *testing.T) {
+	a, cleanup := fileFieldApp(t)
+	defer cleanup()
+	id := postFileField(a, "image.png", pngData, nil)
+	r, _ := http.NewRequest("GET", "http://localhost/?current="+id, nil)
+	rec := httptest.NewRecorder()
+	a.ServeHTTP(rec, r)
+	body := rec.Body.String()
+	if !strings.Contains(body, `<span class="current-file">image.png</span>`) {
+		t.Errorf("rendered form does not show the current file:\n%s", body)
+	}
+	signer, err := a.Signer([]byte(fileFieldSalt))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := signer.Sign([]byte(id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body, fmt.Sprintf(`name="image._blob" type="hidden" value="%s"`, signed)) {
+		t.Errorf("rendered form does not include the signed blob id:\n%s", body)
+	}
+	// Submitting without a file keeps the signed one
+	if resp := postFileField(a, "", nil, map[string]string{"image._blob": signed}); resp != id {
+		t.Errorf("expecting blob id %q, got %q", id, resp)
+	}
+	if resp := postFileField(a, "", nil, map[string]string{"image._blob": id}); !strings.Contains(resp, "required") {
+		t.Errorf("expecting unsigned blob id to be rejected, got %q", resp)
+	}
+}
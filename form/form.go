@@ -6,6 +6,7 @@ import (
 	"html/template"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"gnd.la/app"
 	"gnd.la/crypto/password"
@@ -41,6 +42,11 @@ type Form struct {
 	// form has been rendered or validated has no effect.
 	DisableCSRF bool
 	hasCSRF     bool
+	// namePrefix is prepended to the HTML name of
+	// every field. It's used by formset rows.
+	namePrefix      string
+	antispamWritten bool
+	antispamErr     error
 }
 
 func (f *Form) validate() {
@@ -48,6 +54,12 @@ func (f *Form) validate() {
 		panic(err)
 	}
 	for _, v := range f.fields {
+		if v.Type == FORMSET {
+			if err := f.validateFormset(v); err != nil {
+				v.err = i18n.TranslatedError(err, f.ctx)
+			}
+			continue
+		}
 		inp := f.ctx.FormValue(v.HTMLName)
 		label := v.Label.TranslatedString(f.ctx)
 		if f.NamelessErrors {
@@ -72,7 +84,12 @@ func (f *Form) validate() {
 				continue
 			}
 		}
-		if v.Type == FILE {
+		if v.Type == FILE && v.value.Type() == fileFieldType {
+			if err := f.validateFileField(v, label); err != nil {
+				v.err = i18n.TranslatedError(err, f.ctx)
+				continue
+			}
+		} else if v.Type == FILE {
 			file, header, err := f.ctx.R.FormFile(v.HTMLName)
 			if err != nil && !v.Tag().Optional() {
 				v.err = input.RequiredInputError(label)
@@ -82,6 +99,11 @@ func (f *Form) validate() {
 				value := File([]interface{}{file, header})
 				v.value.Set(reflect.ValueOf(value))
 			}
+		} else if v.Type == DATE && v.value.Type() == timeType {
+			if err := f.validateDate(v, label, inp); err != nil {
+				v.err = i18n.TranslatedError(err, f.ctx)
+				continue
+			}
 		} else {
 			if err := input.InputNamed(label, inp, v.SettableValue(), v.Tag(), true); err != nil {
 				v.err = i18n.TranslatedError(err, f.ctx)
@@ -103,6 +125,9 @@ func (f *Form) validate() {
 			continue
 		}
 	}
+	if err := f.validateAntispam(); err != nil {
+		f.antispamErr = i18n.TranslatedError(err, f.ctx)
+	}
 }
 
 func (f *Form) makeField(name string) (*Field, error) {
@@ -133,15 +158,30 @@ func (f *Form) makeField(name string) (*Field, error) {
 	tag := s.Tags[idx]
 	label := tag.Value("label")
 	if label == "" {
-		label = stringutil.CamelCaseToWords(name, " ")
+		// Nested fields use just their own name
+		label = stringutil.CamelCaseToWords(name[strings.LastIndex(name, ".")+1:], " ")
 	}
 	var typ Type
-	if tag.Has("hidden") {
+	var widget Widget
+	if w := tag.Value("widget"); w != "" {
+		if t, ok := widgetTypes[w]; ok {
+			typ = t
+		} else if widget = registeredWidget(w); widget == nil {
+			return nil, fmt.Errorf("field %q uses unknown widget %q", name, w)
+		}
+	}
+	if typ != 0 {
+		// Type set by the widget option
+	} else if tag.Has("hidden") {
 		typ = HIDDEN
 	} else if tag.Has("radio") {
 		typ = RADIO
 	} else if tag.Has("select") {
 		typ = SELECT
+	} else if s.Types[idx] == fileFieldType {
+		typ = FILE
+	} else if s.Types[idx] == timeType {
+		typ = DATE
 	} else {
 		switch s.Types[idx].Kind() {
 		case reflect.Func:
@@ -173,14 +213,28 @@ func (f *Form) makeField(name string) (*Field, error) {
 				typ = FILE
 				break
 			}
+			if isFormsetType(s.Types[idx]) {
+				typ = FORMSET
+				break
+			}
 			return nil, fmt.Errorf("field %q has invalid type %v", name, s.Types[idx])
 		}
 	}
+	if f.options != nil && f.options.Widgets[name] != nil {
+		widget = f.options.Widgets[name]
+	}
 	// Check if the struct implements the ChoicesProvider interface
+	var choices ChoicesFunc
 	if typ == RADIO || typ == SELECT {
-		container := sval.Addr().Interface()
-		if _, ok := container.(ChoicesProvider); !ok {
-			return nil, fmt.Errorf("field %q requires choices, but %T does not implement ChoicesProvider", name, container)
+		if f.options != nil && f.options.Choices[name] != nil {
+			choices = f.options.Choices[name]
+		} else {
+			container := sval.Addr().Interface()
+			provider, ok := container.(ChoicesProvider)
+			if !ok {
+				return nil, fmt.Errorf("field %q requires choices, but %T does not implement ChoicesProvider", name, container)
+			}
+			choices = provider.FieldChoices
 		}
 	}
 	htmlName := f.toHTMLName(name)
@@ -191,11 +245,13 @@ func (f *Form) makeField(name string) (*Field, error) {
 		Label:       i18n.String(label),
 		Placeholder: i18n.String(tag.Value("placeholder")),
 		Help:        i18n.String(tag.Value("help")),
-		id:          htmlName,
+		id:          strings.Replace(htmlName, ".", "_", -1),
 		value:       fieldValue,
 		s:           s,
 		sval:        sval,
 		pos:         idx,
+		widget:      widget,
+		choices:     choices,
 	}
 	return field, nil
 }
@@ -227,6 +283,9 @@ func (f *Form) appendVal(val interface{}) error {
 	if err != nil {
 		return err
 	}
+	if err := checkTags(val); err != nil {
+		return err
+	}
 	s, err := structs.NewStruct(val, formTags)
 	if err != nil {
 		return err
@@ -237,6 +296,9 @@ func (f *Form) appendVal(val interface{}) error {
 }
 
 func (f *Form) valid() bool {
+	if f.antispamErr != nil {
+		return false
+	}
 	for _, f := range f.fields {
 		if f.err != nil {
 			return false
@@ -249,8 +311,43 @@ func (f *Form) HasErrors() bool {
 	return f.Submitted() && !f.IsValid()
 }
 
+// Errors returns the validation errors for the form fields, translated
+// to the current language. Note that the form must have been validated
+// (e.g. by calling IsValid), otherwise the returned Errors will be empty.
+// Errors which are not associated with any field (e.g. from the anti-bot
+// checks, see Options.Honeypot and Options.MinSubmitTime) have an empty
+// Field.
+func (f *Form) Errors() input.Errors {
+	var errs input.Errors
+	if f.antispamErr != nil {
+		errs = append(errs, &input.FieldError{Err: f.antispamErr})
+	}
+	for _, v := range f.fields {
+		if v.err != nil {
+			errs = append(errs, &input.FieldError{Field: v.Name, Err: i18n.TranslatedError(v.err, f.ctx)})
+		}
+		// Include the errors in each formset row, using
+		// the row index in the field name (e.g. Lines.0.Name).
+		for _, row := range v.rows {
+			for _, e := range row.form.Errors() {
+				name := v.Name + "." + strconv.Itoa(row.index) + "." + e.Field
+				errs = append(errs, &input.FieldError{Field: name, Err: e.Err})
+			}
+		}
+	}
+	return errs
+}
+
+// Submitted returns true iff the form has been submitted, either
+// with a POST, PUT or PATCH request (including POST requests with
+// an overridden method, see gnd.la/app.App.SetMethodOverride) or
+// with a non-empty "submitted" parameter.
 func (f *Form) Submitted() bool {
-	return f.ctx.R.Method == "POST" || f.ctx.FormValue("submitted") != ""
+	switch f.ctx.R.Method {
+	case "POST", "PUT", "PATCH":
+		return true
+	}
+	return f.ctx.FormValue("submitted") != ""
 }
 
 func (f *Form) IsValid() bool {
@@ -317,9 +414,8 @@ func (f *Form) prepareFieldAttributes(field *Field, attrs html.Attrs, pos int) e
 }
 
 func (f *Form) fieldChoices(field *Field) []*Choice {
-	// The type was asserted on form creation
-	provider := field.sval.Addr().Interface().(ChoicesProvider)
-	return provider.FieldChoices(f.ctx, field)
+	// The choices were set on form creation
+	return field.choices(f.ctx, field)
 }
 
 func (f *Form) beginInput(buf *bytes.Buffer, field *Field, pos int) error {
@@ -357,6 +453,31 @@ func (f *Form) endInput(buf *bytes.Buffer, field *Field, pos int) error {
 }
 
 func (f *Form) writeField(buf *bytes.Buffer, field *Field) error {
+	if field.Type == FORMSET {
+		return f.writeFormset(buf, field)
+	}
+	if field.widget != nil {
+		closed := field.Type != CHECKBOX
+		if field.Type != HIDDEN {
+			label := field.Label.TranslatedString(f.ctx)
+			if err := f.writeLabel(buf, field, field.Id(), label, closed, -1); err != nil {
+				return err
+			}
+		}
+		if err := f.beginInput(buf, field, -1); err != nil {
+			return err
+		}
+		if err := f.writeWidget(buf, field); err != nil {
+			return err
+		}
+		if !closed {
+			label := field.Label.TranslatedString(f.ctx)
+			if err := f.endLabel(buf, field, label, -1); err != nil {
+				return err
+			}
+		}
+		return f.endInput(buf, field, -1)
+	}
 	var closed bool
 	if field.Type != HIDDEN {
 		closed = field.Type != CHECKBOX
@@ -375,8 +496,13 @@ func (f *Form) writeField(buf *bytes.Buffer, field *Field) error {
 		err = f.writeInput(buf, "email", field)
 	case HIDDEN:
 		err = f.writeInput(buf, "hidden", field)
+	case DATE:
+		err = f.writeInput(buf, "date", field)
 	case FILE:
 		err = f.writeInput(buf, "file", field)
+		if err == nil && field.value.Type() == fileFieldType {
+			err = f.writeFileFieldValue(buf, field)
+		}
 	case TEXTAREA:
 		attrs := html.Attrs{
 			"id":   field.Id(),
@@ -517,8 +643,8 @@ func (f *Form) writeInput(buf *bytes.Buffer, itype string, field *Field) error {
 		if t, ok := types.IsTrue(field.value.Interface()); t && ok {
 			attrs["checked"] = "checked"
 		}
-	case TEXT, PASSWORD, EMAIL, HIDDEN:
-		attrs["value"] = html.Escape(types.ToString(field.Value()))
+	case TEXT, PASSWORD, EMAIL, HIDDEN, DATE:
+		attrs["value"] = html.Escape(fieldStringValue(field))
 		if field.Placeholder != "" {
 			attrs["placeholder"] = html.Escape(field.Placeholder.TranslatedString(f.ctx))
 		}
@@ -580,7 +706,13 @@ func (f *Form) renderField(buf *bytes.Buffer, field *Field) (err error) {
 }
 
 func (f *Form) toHTMLName(name string) string {
-	return stringutil.CamelCaseToLower(name, "_")
+	// Convert each component independently, so nested
+	// fields are named e.g. address.zip_code.
+	parts := strings.Split(name, ".")
+	for ii, v := range parts {
+		parts[ii] = stringutil.CamelCaseToLower(v, "_")
+	}
+	return f.namePrefix + strings.Join(parts, ".")
 }
 
 func (f *Form) render(fields []*Field) (template.HTML, error) {
@@ -594,6 +726,9 @@ func (f *Form) render(fields []*Field) (template.HTML, error) {
 			break
 		}
 	}
+	if err == nil {
+		err = f.writeAntispam(&buf)
+	}
 	return template.HTML(buf.String()), err
 }
 
@@ -715,6 +850,15 @@ func NewOpts(ctx *app.Context, opts *Options, values ...interface{}) *Form {
 		renderer: r,
 		options:  opts,
 	}
+	if opts != nil {
+		form.DisableCSRF = opts.DisableCSRF
+	}
+	if !form.DisableCSRF && ctx.R != nil {
+		// Send the session token as soon as possible, since
+		// the form might be rendered after the headers have
+		// been sent. Errors will be reported when rendering.
+		csrfSessionToken(ctx, true)
+	}
 	for _, v := range values {
 		err := form.appendVal(v)
 		if err != nil {
@@ -0,0 +1,249 @@
+package form
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"gnd.la/html"
+	"gnd.la/i18n"
+)
+
+const (
+	// formsetCountName is the name of the hidden input with the
+	// number of rows in a formset, relative to the formset name.
+	formsetCountName = "_count"
+	// formsetDeleteName is the name of the checkbox used for deleting
+	// a row from a formset, relative to the row prefix.
+	formsetDeleteName = "_delete"
+	// maxFormsetRows limits the number of rows accepted
+	// in a formset, to avoid abuse.
+	maxFormsetRows = 1000
+)
+
+// isFormsetType returns true iff t is a slice of structs
+// or a slice of pointers to structs.
+func isFormsetType(t reflect.Type) bool {
+	if t.Kind() != reflect.Slice {
+		return false
+	}
+	elem := t.Elem()
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	return elem.Kind() == reflect.Struct && elem != fileType
+}
+
+// formsetRow is a row in a formset, represented
+// by a form which uses a prefix for its fields.
+type formsetRow struct {
+	form  *Form
+	index int
+	// extra indicates that the row was added for
+	// the user to fill it, so it doesn't contain
+	// any data.
+	extra bool
+}
+
+func (f *Form) formsetPrefix(field *Field, index int) string {
+	return field.HTMLName + "." + strconv.Itoa(index) + "."
+}
+
+func (f *Form) newFormsetRow(field *Field, elem reflect.Value, index int) (*formsetRow, error) {
+	sub := &Form{
+		ctx:            f.ctx,
+		renderer:       f.renderer,
+		namePrefix:     f.formsetPrefix(field, index),
+		NamelessErrors: f.NamelessErrors,
+		DisableCSRF:    true,
+	}
+	if err := sub.appendVal(elem.Interface()); err != nil {
+		return nil, err
+	}
+	if err := sub.makeFields(sub.structs[0].QNames); err != nil {
+		return nil, err
+	}
+	sub.SetId(f.id)
+	return &formsetRow{form: sub, index: index}, nil
+}
+
+// formsetElem returns a pointer to the struct in the ii-th
+// element of the formset slice, allocating it if needed.
+func formsetElem(slice reflect.Value, ii int) reflect.Value {
+	elem := slice.Index(ii)
+	if elem.Kind() == reflect.Ptr {
+		if elem.IsNil() {
+			elem.Set(reflect.New(elem.Type().Elem()))
+		}
+		return elem
+	}
+	return elem.Addr()
+}
+
+func formsetStructType(field *Field) reflect.Type {
+	elem := field.value.Type().Elem()
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	return elem
+}
+
+// formsetExtra returns the number of empty rows rendered
+// for adding new elements. It defaults to 1 and can be
+// changed with the extra tag option.
+func formsetExtra(field *Field) int {
+	if extra, ok := field.Tag().IntValue("extra"); ok && extra >= 0 {
+		return extra
+	}
+	return 1
+}
+
+// formsetRows returns the rows for the given formset field. If the
+// formset hasn't been submitted, the rows are generated from the
+// current slice value.
+func (f *Form) formsetRows(field *Field) ([]*formsetRow, error) {
+	if field.rows != nil {
+		return field.rows, nil
+	}
+	var rows []*formsetRow
+	slice := field.value
+	for ii := 0; ii < slice.Len(); ii++ {
+		row, err := f.newFormsetRow(field, formsetElem(slice, ii), ii)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	field.rows = rows
+	return rows, nil
+}
+
+// validateFormset parses the submitted rows for the given formset
+// field, ignoring the deleted and blank ones, and validates each one
+// of them. The formset value is replaced with the parsed rows.
+func (f *Form) validateFormset(field *Field) error {
+	// The count comes from the client, so it can't be trusted. An
+	// invalid or negative count means no rows were submitted.
+	count, err := strconv.Atoi(f.ctx.FormValue(field.HTMLName + "." + formsetCountName))
+	if err != nil || count < 0 {
+		count = 0
+	}
+	if count > maxFormsetRows {
+		count = maxFormsetRows
+	}
+	typ := formsetStructType(field)
+	isPtr := field.value.Type().Elem().Kind() == reflect.Ptr
+	slice := reflect.MakeSlice(field.value.Type(), 0, count)
+	var rows []*formsetRow
+	valid := true
+	for ii := 0; ii < count; ii++ {
+		prefix := f.formsetPrefix(field, ii)
+		if f.ctx.FormValue(prefix+formsetDeleteName) != "" {
+			continue
+		}
+		elem := reflect.New(typ)
+		row, err := f.newFormsetRow(field, elem, ii)
+		if err != nil {
+			return err
+		}
+		if row.form.isBlank() {
+			continue
+		}
+		if !row.form.IsValid() {
+			valid = false
+		}
+		rows = append(rows, row)
+		if isPtr {
+			slice = reflect.Append(slice, elem)
+		} else {
+			slice = reflect.Append(slice, elem.Elem())
+		}
+	}
+	field.value.Set(slice)
+	field.rows = rows
+	if rows == nil {
+		field.rows = []*formsetRow{}
+	}
+	if !valid {
+		return i18n.Errorfc("form", "please, correct the errors in %s", field.Label.TranslatedString(f.ctx))
+	}
+	if len(rows) == 0 && field.Tag().Required() {
+		return i18n.Errorfc("form", "%s requires at least one element", field.Label.TranslatedString(f.ctx))
+	}
+	return nil
+}
+
+// isBlank returns true iff none of the form
+// fields have been submitted with a value.
+func (f *Form) isBlank() bool {
+	for _, v := range f.fields {
+		if v.Type == FORMSET {
+			if f.ctx.FormValue(v.HTMLName+"."+formsetCountName) != "" {
+				return false
+			}
+			continue
+		}
+		if f.ctx.FormValue(v.HTMLName) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *Form) writeFormset(buf *bytes.Buffer, field *Field) error {
+	rows, err := f.formsetRows(field)
+	if err != nil {
+		return err
+	}
+	next := 0
+	if len(rows) > 0 {
+		next = rows[len(rows)-1].index + 1
+	}
+	all := append([]*formsetRow(nil), rows...)
+	typ := formsetStructType(field)
+	for ii := 0; ii < formsetExtra(field); ii++ {
+		row, err := f.newFormsetRow(field, reflect.New(typ), next)
+		if err != nil {
+			return err
+		}
+		row.extra = true
+		all = append(all, row)
+		next++
+	}
+	f.openTag(buf, "fieldset", html.Attrs{"id": field.Id(), "class": "formset"})
+	f.openTag(buf, "legend", nil)
+	buf.WriteString(html.Escape(field.Label.TranslatedString(f.ctx)))
+	f.closeTag(buf, "legend")
+	f.openTag(buf, "input", html.Attrs{
+		"type":  "hidden",
+		"name":  field.HTMLName + "." + formsetCountName,
+		"value": strconv.Itoa(next),
+	})
+	for _, row := range all {
+		f.openTag(buf, "div", html.Attrs{
+			"class":      "formset-row",
+			"data-index": strconv.Itoa(row.index),
+		})
+		fields, err := row.form.render(row.form.fields)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(string(fields))
+		if !row.extra {
+			id := fmt.Sprintf("%s_%d%s", field.Id(), row.index, formsetDeleteName)
+			f.openTag(buf, "label", html.Attrs{"for": id, "class": "formset-delete"})
+			f.openTag(buf, "input", html.Attrs{
+				"id":    id,
+				"type":  "checkbox",
+				"name":  row.form.namePrefix + formsetDeleteName,
+				"value": "1",
+			})
+			buf.WriteString(html.Escape(i18n.Sprintfc(f.ctx, "form", "Remove")))
+			f.closeTag(buf, "label")
+		}
+		f.closeTag(buf, "div")
+	}
+	f.closeTag(buf, "fieldset")
+	return nil
+}
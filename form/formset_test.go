@@ -0,0 +1,120 @@
+package form
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+)
+
+type formsetLine struct {
+	Name     string
+	Quantity int `form:",optional"`
+}
+
+type formsetOrder struct {
+	Title   string
+	Address struct {
+		ZipCode string
+	}
+	Lines []*formsetLine `form:",required,extra=2"`
+}
+
+func formsetApp() *app.App {
+	a := app.New()
+	a.Handle("^/$", func(ctx *app.Context) {
+		order := &formsetOrder{Lines: []*formsetLine{{Name: "existing", Quantity: 3}}}
+		f := NewOpts(ctx, &Options{DisableCSRF: true}, order)
+		if f.Submitted() {
+			if !f.IsValid() {
+				for _, v := range f.Errors() {
+					fmt.Fprintf(ctx, "%s: error\n", v.Field)
+				}
+				return
+			}
+			fmt.Fprintf(ctx, "%s %s", order.Title, order.Address.ZipCode)
+			for _, v := range order.Lines {
+				fmt.Fprintf(ctx, " %s=%d", v.Name, v.Quantity)
+			}
+			return
+		}
+		html, err := f.Render()
+		if err != nil {
+			panic(err)
+		}
+		ctx.WriteString(string(html))
+	})
+	return a
+}
+
+func TestFormsetRender(t *testing.T) {
+	r, _ := http.NewRequest("GET", "http://localhost/", nil)
+	w := httptest.NewRecorder()
+	formsetApp().ServeHTTP(w, r)
+	body := w.Body.String()
+	for _, v := range []string{
+		`name="address.zip_code"`,
+		`_address_zip_code"`,
+		`name="lines._count" type="hidden" value="3"`,
+		`data-index="0"`,
+		`name="lines.0.name"`,
+		`>existing</textarea>`,
+		`name="lines.0._delete"`,
+		`name="lines.2.quantity"`,
+	} {
+		if !strings.Contains(body, v) {
+			t.Errorf("rendered formset does not contain %s:\n%s", v, body)
+		}
+	}
+	// Only existing rows can be deleted
+	if strings.Contains(body, `name="lines.1._delete"`) {
+		t.Error("extra rows should not have a delete checkbox")
+	}
+	if label := "Zip Code"; !strings.Contains(body, label) {
+		t.Errorf("nested field should be labeled %q", label)
+	}
+}
+
+func TestFormsetSubmit(t *testing.T) {
+	tt := tester.New(t, formsetApp())
+	tt.Form("/", map[string]interface{}{
+		"title":            "order",
+		"address.zip_code": "12345",
+		"lines._count":     "4",
+		"lines.0.name":     "first",
+		"lines.0.quantity": "1",
+		"lines.1.name":     "deleted",
+		"lines.1._delete":  "1",
+		"lines.3.name":     "last",
+		"lines.3.quantity": "2",
+	}).Expect("order 12345 first=1 last=2")
+	// Errors in rows include the row index
+	tt.Form("/", map[string]interface{}{
+		"title":            "order",
+		"address.zip_code": "12345",
+		"lines._count":     "2",
+		"lines.0.name":     "first",
+		"lines.1.quantity": "foo",
+	}).Expect("Lines: error\nLines.1.Name: error\nLines.1.Quantity: error\n")
+	// Blank and deleted rows don't count as elements
+	tt.Form("/", map[string]interface{}{
+		"title":            "order",
+		"address.zip_code": "12345",
+		"lines._count":     "2",
+		"lines.0.name":     "deleted",
+		"lines.0._delete":  "1",
+	}).Expect("Lines: error\n")
+	// Invalid and negative counts are treated as no rows
+	for _, v := range []string{"-1", "foo"} {
+		tt.Form("/", map[string]interface{}{
+			"title":            "order",
+			"address.zip_code": "12345",
+			"lines._count":     v,
+			"lines.0.name":     "first",
+		}).Expect("Lines: error\n")
+	}
+}
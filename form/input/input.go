@@ -36,6 +36,9 @@ func RequiredInputError(name string) error {
 //  - min_length: Sets the minimum length for the input.
 //  - alphanumeric: Requires the input to be only letters and numbers
 //
+// Non-empty inputs are also checked with the validators in the tag (e.g. min,
+// max, email, regexp...). See RegisterValidator for the available ones.
+//
 // Finally, the required parameter indicates if the value should be considered required
 // or optional in absence of the "required" and "optional" tag fields.
 func InputNamed(name string, input string, out interface{}, tag *structs.Tag, required bool) error {
@@ -68,6 +71,11 @@ func InputNamed(name string, input string, out interface{}, tag *structs.Tag, re
 			}
 			return i18n.Errorfc("form", "must be alphanumeric")
 		}
+		if input != "" {
+			if err := ValidateNamed(name, v.Interface(), tag); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -0,0 +1,407 @@
+package input
+
+import (
+	"bytes"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gnd.la/i18n"
+	"gnd.la/util/structs"
+	"gnd.la/util/types"
+)
+
+var (
+	validatorsMu   sync.RWMutex
+	validators     = map[string]Validator{}
+	validatorNames []string
+	regexpCache    struct {
+		sync.RWMutex
+		exprs map[string]*regexp.Regexp
+	}
+	validateTags = []string{"form", "gondola"}
+)
+
+// Validator is a function which validates a value using the
+// argument specified in the struct tag (e.g. for min=5, arg
+// would be "5"). Validators are only called with non-empty
+// values. See RegisterValidator.
+type Validator func(value interface{}, arg string) error
+
+// RegisterValidator registers a new validator, which will be used
+// for any field with a tag including the given name, in addition
+// to the built-in ones:
+//
+//  - min: For numbers, the minimum value. For strings, slices and maps, the minimum length.
+//  - max: For numbers, the maximum value. For strings, slices and maps, the maximum length.
+//  - len: The exact length for strings, slices and maps.
+//  - email: Requires a valid email address. Use novalidate to disable this check.
+//  - url: Requires a valid absolute http or https URL.
+//  - regexp: Requires the value to match the given regular expression. Use single quotes for expressions containing commas
+//    and escape backslashes, since they're also the tag escape character (e.g. regexp='^\\d+$').
+//  - oneof: Requires the value to be one of the values separated by |, e.g. oneof=red|green|blue.
+//
+// Errors returned from validators should not include the field name,
+// since it's prepended to the error message when available. Registering
+// a validator with an already registered name will panic.
+func RegisterValidator(name string, v Validator) {
+	validatorsMu.Lock()
+	defer validatorsMu.Unlock()
+	if _, ok := validators[name]; ok {
+		panic(fmt.Errorf("there's already a validator named %q", name))
+	}
+	validators[name] = v
+	validatorNames = append(validatorNames, name)
+}
+
+// ValidatorNames returns the names of the registered
+// validators, in registration order.
+func ValidatorNames() []string {
+	validatorsMu.RLock()
+	defer validatorsMu.RUnlock()
+	return append([]string(nil), validatorNames...)
+}
+
+// FieldError represents a validation error in a
+// struct field.
+type FieldError struct {
+	// Field is the name of the field in the struct.
+	Field string
+	// Err is the validation error.
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+// Errors is a collection of validation errors. Errors
+// is encoded to JSON as an object mapping the field names
+// to their error messages.
+type Errors []*FieldError
+
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for ii, v := range e {
+		msgs[ii] = v.Field + ": " + v.Err.Error()
+	}
+	return strings.Join(msgs, ", ")
+}
+
+// Field returns the error for the given field, or nil
+// if there's no error for it.
+func (e Errors) Field(name string) error {
+	for _, v := range e {
+		if v.Field == name {
+			return v.Err
+		}
+	}
+	return nil
+}
+
+// Translate returns a copy of e with its errors
+// translated using the given language.
+func (e Errors) Translate(lang i18n.Languager) Errors {
+	errs := make(Errors, len(e))
+	for ii, v := range e {
+		errs[ii] = &FieldError{Field: v.Field, Err: i18n.TranslatedError(v.Err, lang)}
+	}
+	return errs
+}
+
+func (e Errors) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for ii, v := range e {
+		if ii > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(strconv.Quote(v.Field))
+		buf.WriteByte(':')
+		buf.WriteString(strconv.Quote(v.Err.Error()))
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// ValidateNamed validates the given value using the validators specified in
+// the tag. See RegisterValidator for the available validators. If name is
+// non-empty, it will be included in the returned error. Empty values are not
+// validated, use the required tag option (and InputNamed or ValidateStruct) to
+// make sure a value is present.
+func ValidateNamed(name string, value interface{}, tag *structs.Tag) error {
+	if tag == nil || isEmpty(value) {
+		return nil
+	}
+	validatorsMu.RLock()
+	defer validatorsMu.RUnlock()
+	for _, v := range validatorNames {
+		if !tag.Has(v) || (v == "email" && tag.Has("novalidate")) {
+			continue
+		}
+		if err := validators[v](value, tag.Value(v)); err != nil {
+			if name != "" {
+				return i18n.Errorfc("form", "%s: %s", name, err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateStruct validates all the fields in the given struct pointer using
+// their form or gondola tags. In addition to the validators (see
+// RegisterValidator), it also checks the required, max_length, min_length and
+// alphanumeric options as well as any validation functions (see
+// gnd.la/util/structs.Validate), which will be called with the given args.
+// If any of the fields doesn't pass validation, an Errors is returned.
+func ValidateStruct(obj interface{}, args ...interface{}) error {
+	s, err := structs.NewStruct(obj, validateTags)
+	if err != nil {
+		return err
+	}
+	val := reflect.ValueOf(obj)
+	for val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	var errs Errors
+	for ii, name := range s.QNames {
+		tag := s.Tags[ii]
+		fval := fieldByIndex(val, s.Indexes[ii])
+		if !fval.IsValid() || !fval.CanInterface() {
+			continue
+		}
+		value := fval.Interface()
+		if err := validateValue(name, value, tag); err != nil {
+			errs = append(errs, &FieldError{Field: name, Err: err})
+			continue
+		}
+		if err := structs.Validate(obj, name, args...); err != nil {
+			errs = append(errs, &FieldError{Field: name, Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateValue(name string, value interface{}, tag *structs.Tag) error {
+	if isEmpty(value) {
+		if tag.Required() {
+			return RequiredInputError(name)
+		}
+		return nil
+	}
+	if s, ok := value.(string); ok {
+		if maxlen, ok := tag.MaxLength(); ok && len(s) > maxlen {
+			return i18n.Errorfc("form", "%s is too long (maximum length is %d)", name, maxlen)
+		}
+		if minlen, ok := tag.MinLength(); ok && len(s) < minlen {
+			return i18n.Errorfc("form", "%s is too short (minimum length is %d)", name, minlen)
+		}
+		if tag.Alphanumeric() && !alphanumericRe.MatchString(s) {
+			return i18n.Errorfc("form", "%s must be alphanumeric", name)
+		}
+	}
+	return ValidateNamed(name, value, tag)
+}
+
+func fieldByIndex(v reflect.Value, indexes []int) reflect.Value {
+	for _, idx := range indexes {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		}
+		v = v.Field(idx)
+	}
+	return v
+}
+
+func isEmpty(value interface{}) bool {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+// length returns the length of strings, slices and maps. The
+// second return value is false for values without length.
+func length(value interface{}) (int, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String:
+		return len([]rune(v.String())), true
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len(), true
+	}
+	return 0, false
+}
+
+// compareNumber returns -1, 0 or 1 if value is less, equal or greater than
+// the number in arg. The second return value is false if value is not a number.
+func compareNumber(value interface{}, arg string) (int, bool, error) {
+	v := reflect.ValueOf(value)
+	limit, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid numeric validation argument %q: %s", arg, err)
+	}
+	var f float64
+	switch types.Kind(v.Kind()) {
+	case types.Int:
+		f = float64(v.Int())
+	case types.Uint:
+		f = float64(v.Uint())
+	case types.Float:
+		f = v.Float()
+	default:
+		return 0, false, nil
+	}
+	switch {
+	case f < limit:
+		return -1, true, nil
+	case f > limit:
+		return 1, true, nil
+	}
+	return 0, true, nil
+}
+
+func validateMin(value interface{}, arg string) error {
+	cmp, ok, err := compareNumber(value, arg)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if cmp < 0 {
+			return i18n.Errorfc("form", "must be at least %s", arg)
+		}
+		return nil
+	}
+	if l, ok := length(value); ok {
+		min, err := strconv.Atoi(arg)
+		if err != nil {
+			return fmt.Errorf("invalid min argument %q: %s", arg, err)
+		}
+		if l < min {
+			return i18n.Errorfc("form", "must have at least %d characters or elements", min)
+		}
+	}
+	return nil
+}
+
+func validateMax(value interface{}, arg string) error {
+	cmp, ok, err := compareNumber(value, arg)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if cmp > 0 {
+			return i18n.Errorfc("form", "must be at most %s", arg)
+		}
+		return nil
+	}
+	if l, ok := length(value); ok {
+		max, err := strconv.Atoi(arg)
+		if err != nil {
+			return fmt.Errorf("invalid max argument %q: %s", arg, err)
+		}
+		if l > max {
+			return i18n.Errorfc("form", "must have at most %d characters or elements", max)
+		}
+	}
+	return nil
+}
+
+func validateLen(value interface{}, arg string) error {
+	n, err := strconv.Atoi(arg)
+	if err != nil {
+		return fmt.Errorf("invalid len argument %q: %s", arg, err)
+	}
+	if l, ok := length(value); ok && l != n {
+		return i18n.Errorfc("form", "must have exactly %d characters or elements", n)
+	}
+	return nil
+}
+
+func validateEmail(value interface{}, arg string) error {
+	s := types.ToString(value)
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Address != s || !strings.Contains(s[strings.LastIndex(s, "@")+1:], ".") {
+		return i18n.Errorfc("form", "%q is not a valid email address", s)
+	}
+	return nil
+}
+
+func validateURL(value interface{}, arg string) error {
+	s := types.ToString(value)
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return i18n.Errorfc("form", "%q is not a valid URL", s)
+	}
+	return nil
+}
+
+func compileRegexp(expr string) (*regexp.Regexp, error) {
+	regexpCache.RLock()
+	re := regexpCache.exprs[expr]
+	regexpCache.RUnlock()
+	if re != nil {
+		return re, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	regexpCache.Lock()
+	if regexpCache.exprs == nil {
+		regexpCache.exprs = make(map[string]*regexp.Regexp)
+	}
+	regexpCache.exprs[expr] = re
+	regexpCache.Unlock()
+	return re, nil
+}
+
+func validateRegexp(value interface{}, arg string) error {
+	re, err := compileRegexp(arg)
+	if err != nil {
+		return fmt.Errorf("invalid regexp %q: %s", arg, err)
+	}
+	if !re.MatchString(types.ToString(value)) {
+		return i18n.Errorfc("form", "invalid format")
+	}
+	return nil
+}
+
+func validateOneOf(value interface{}, arg string) error {
+	s := types.ToString(value)
+	choices := strings.Split(arg, "|")
+	for _, v := range choices {
+		if v == s {
+			return nil
+		}
+	}
+	return i18n.Errorfc("form", "must be one of %s", strings.Join(choices, ", "))
+}
+
+func init() {
+	RegisterValidator("min", validateMin)
+	RegisterValidator("max", validateMax)
+	RegisterValidator("len", validateLen)
+	RegisterValidator("email", validateEmail)
+	RegisterValidator("url", validateURL)
+	RegisterValidator("regexp", validateRegexp)
+	RegisterValidator("oneof", validateOneOf)
+}
@@ -0,0 +1,97 @@
+package input
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"gnd.la/util/structs"
+)
+
+type ValidateCase struct {
+	Value interface{}
+	Tag   string
+	Valid bool
+}
+
+func TestValidate(t *testing.T) {
+	cases := []ValidateCase{
+		{5, "min=1", true},
+		{0, "min=1", false},
+		{11.5, "max=10", false},
+		{uint(10), "max=10", true},
+		{"foo", "min=3", true},
+		{"fo", "min=3", false},
+		{[]int{1, 2, 3}, "max=2", false},
+		{"abcde", "len=5", true},
+		{"abcd", "len=5", false},
+		{"alberto@example.com", "email", true},
+		{"alberto", "email", false},
+		{"alberto", "email,novalidate", true},
+		{"http://www.example.com/foo", "url", true},
+		{"www.example.com", "url", false},
+		{"ab12", "regexp='^[a-z]+[0-9]+$'", true},
+		{"12ab", "regexp='^[a-z]+[0-9]+$'", false},
+		{"123", `regexp='^\\d+$'`, true},
+		{"12a", `regexp='^\\d+$'`, false},
+		{"a,b", `regexp='^\\w,\\w$'`, true},
+		{"green", "oneof=red|green|blue", true},
+		{"yellow", "oneof=red|green|blue", false},
+		{"", "email,min=3", true},
+	}
+	for _, v := range cases {
+		err := ValidateNamed("", v.Value, structs.MustParseTag(","+v.Tag))
+		if v.Valid && err != nil {
+			t.Errorf("expecting %v to be valid with %q, got error %s", v.Value, v.Tag, err)
+		} else if !v.Valid && err == nil {
+			t.Errorf("expecting %v to be invalid with %q", v.Value, v.Tag)
+		}
+	}
+}
+
+type validateStruct struct {
+	Name  string `form:",required,max_length=5"`
+	Email string `form:",email"`
+	Age   int    `form:",min=18,even"`
+	Code  string
+}
+
+func (v *validateStruct) ValidateCode() error {
+	if v.Code == "bad" {
+		return errors.New("bad code")
+	}
+	return nil
+}
+
+func TestValidateStruct(t *testing.T) {
+	RegisterValidator("even", func(value interface{}, arg string) error {
+		if value.(int)%2 != 0 {
+			return errors.New("must be even")
+		}
+		return nil
+	})
+	if err := ValidateStruct(&validateStruct{Name: "foo", Email: "foo@example.com", Age: 20}); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	err := ValidateStruct(&validateStruct{Email: "foo", Age: 19, Code: "bad"})
+	errs, ok := err.(Errors)
+	if !ok {
+		t.Fatalf("expecting Errors, got %T (%v)", err, err)
+	}
+	for _, v := range []string{"Name", "Email", "Age", "Code"} {
+		if errs.Field(v) == nil {
+			t.Errorf("expecting error for field %s", v)
+		}
+	}
+	data, err := json.Marshal(errs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m map[string]string
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatal(err)
+	}
+	if len(m) != 4 || m["Code"] != "bad code" {
+		t.Errorf("unexpected JSON encoding %s", string(data))
+	}
+}
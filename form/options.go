@@ -1,5 +1,13 @@
 package form
 
+import (
+	"time"
+)
+
+// DefaultMaxSubmitTime is the MaxSubmitTime used
+// when Options.MaxSubmitTime is zero.
+var DefaultMaxSubmitTime = 24 * time.Hour
+
 // Options specify the Form options at creation time.
 type Options struct {
 	// Render is the type which renders the form. If Renderer
@@ -13,4 +21,31 @@ type Options struct {
 	// Fields lists the struct fields to include in the form. If empty,
 	// all exported fields are included.
 	Fields []string
+	// Widgets overrides the widget used for rendering the input
+	// of the given fields, keyed by their names in the struct.
+	// It takes precedence over the widget tag option.
+	Widgets map[string]Widget
+	// Choices provides the choices for RADIO and SELECT fields, keyed
+	// by their names in the struct. Fields with a ChoicesFunc don't
+	// require their struct to implement ChoicesProvider.
+	Choices map[string]ChoicesFunc
+	// DisableCSRF disables the CSRF protection for the form.
+	// See also Form.DisableCSRF.
+	DisableCSRF bool
+	// Honeypot is the name of an input which is hidden from humans
+	// and must be left empty. Bots will usually fill it, causing the
+	// form to fail validation. Use a name which looks like a legitimate
+	// field (e.g. "website") which is not used by any other field.
+	// If empty, no honeypot is added.
+	Honeypot string
+	// MinSubmitTime is the minimum time which must pass between the
+	// form being rendered and submitted. Submissions which happen faster
+	// are rejected, since they're most likely coming from bots. If zero,
+	// the time is not checked.
+	MinSubmitTime time.Duration
+	// MaxSubmitTime is the maximum time which might pass between the
+	// form being rendered and submitted, so the signed render time
+	// can't be reused forever. It's only checked when MinSubmitTime
+	// is non-zero. If zero, DefaultMaxSubmitTime is used.
+	MaxSubmitTime time.Duration
 }
@@ -0,0 +1,92 @@
+package form
+
+import (
+	"io"
+
+	"gnd.la/html"
+)
+
+// PlainRenderer implements a Renderer which generates plain HTML without
+// depending on any frontend framework. Each field is wrapped in a
+// <div class="field">, which also has the error class when the field
+// is not valid. Errors and help messages are written into a <span> with
+// the error and help classes, respectively. Set the classes in the
+// PlainRenderer fields to use different ones.
+type PlainRenderer struct {
+	// FieldClass is the class used for the <div> wrapping each field.
+	// If empty, "field" is used.
+	FieldClass string
+	// ErrorClass is the class used for fields with errors and their
+	// error messages. If empty, "error" is used.
+	ErrorClass string
+	// HelpClass is the class used for the help messages. If empty,
+	// "help" is used.
+	HelpClass string
+}
+
+func (r *PlainRenderer) class(c string, def string) string {
+	if c != "" {
+		return c
+	}
+	return def
+}
+
+func (r *PlainRenderer) BeginField(w io.Writer, field *Field) error {
+	class := r.class(r.FieldClass, "field")
+	if field.Err() != nil {
+		class += " " + r.class(r.ErrorClass, "error")
+	}
+	div := html.Div()
+	div.Attrs = html.Attrs{"class": class}
+	div.Open = true
+	_, err := div.WriteTo(w)
+	return err
+}
+
+func (r *PlainRenderer) BeginLabel(w io.Writer, field *Field, label string, pos int) error {
+	return nil
+}
+
+func (r *PlainRenderer) LabelAttributes(field *Field, pos int) (html.Attrs, error) {
+	return nil, nil
+}
+
+func (r *PlainRenderer) EndLabel(w io.Writer, field *Field, pos int) error {
+	return nil
+}
+
+func (r *PlainRenderer) BeginInput(w io.Writer, field *Field, placeholder string, pos int) error {
+	return nil
+}
+
+func (r *PlainRenderer) FieldAttributes(field *Field, pos int) (html.Attrs, error) {
+	return nil, nil
+}
+
+func (r *PlainRenderer) EndInput(w io.Writer, field *Field, pos int) error {
+	return nil
+}
+
+func (r *PlainRenderer) WriteAddOn(w io.Writer, field *Field, addon *AddOn) error {
+	_, err := addon.Node.WriteTo(w)
+	return err
+}
+
+func (r *PlainRenderer) WriteError(w io.Writer, field *Field, err error) error {
+	span := html.Span(html.Text(err.Error()))
+	span.Attrs = html.Attrs{"class": r.class(r.ErrorClass, "error")}
+	_, werr := span.WriteTo(w)
+	return werr
+}
+
+func (r *PlainRenderer) WriteHelp(w io.Writer, field *Field, help string) error {
+	span := html.Span(html.Text(help))
+	span.Attrs = html.Attrs{"class": r.class(r.HelpClass, "help")}
+	_, err := span.WriteTo(w)
+	return err
+}
+
+func (r *PlainRenderer) EndField(w io.Writer, field *Field) error {
+	_, err := io.WriteString(w, "</div>")
+	return err
+}
@@ -0,0 +1,90 @@
+package form
+
+import (
+	"reflect"
+	"sync"
+
+	"gnd.la/form/input"
+	"gnd.la/util/structs"
+)
+
+var (
+	// tagOptions contains the options accepted in the struct
+	// tags of the form fields, in addition to the validators
+	// registered in gnd.la/form/input.
+	tagOptions = []*structs.TagOption{
+		{Name: "inline"},
+		{Name: "label", Type: structs.StringOption},
+		{Name: "help", Type: structs.StringOption},
+		{Name: "placeholder", Type: structs.StringOption},
+		{Name: "widget", Type: structs.StringOption},
+		{Name: "hidden"},
+		{Name: "radio"},
+		{Name: "select"},
+		{Name: "multiple"},
+		{Name: "password"},
+		{Name: "singleline"},
+		{Name: "line"},
+		{Name: "novalidate"},
+		{Name: "optional"},
+		{Name: "required"},
+		{Name: "alphanumeric"},
+		{Name: "length", Type: structs.IntOption},
+		{Name: "min_length", Type: structs.IntOption},
+		{Name: "max_length", Type: structs.IntOption},
+		{Name: "rows", Type: structs.IntOption},
+		{Name: "extra", Type: structs.IntOption},
+		{Name: "accept", Type: structs.StringOption},
+		{Name: "max_size", Type: structs.IntOption},
+	}
+	tagConflicts = [][]string{
+		{"optional", "required"},
+		{"widget", "hidden", "radio", "select"},
+	}
+	checkedTypes struct {
+		sync.RWMutex
+		types map[reflect.Type]bool
+	}
+)
+
+func tagSchema() *structs.TagSchema {
+	schema := &structs.TagSchema{
+		Options:   tagOptions,
+		Conflicts: tagConflicts,
+	}
+	for _, v := range input.ValidatorNames() {
+		found := false
+		for _, o := range tagOptions {
+			if o.Name == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			schema.Options = append(schema.Options, &structs.TagOption{Name: v, Type: structs.AnyOption})
+		}
+	}
+	return schema
+}
+
+// checkTags validates the struct tags in the type of val
+// the first time it's used in a form.
+func checkTags(val interface{}) error {
+	typ := reflect.TypeOf(val)
+	checkedTypes.RLock()
+	checked := checkedTypes.types[typ]
+	checkedTypes.RUnlock()
+	if checked {
+		return nil
+	}
+	if err := structs.ValidateTags(typ, formTags, tagSchema()); err != nil && err != structs.ErrNoStruct {
+		return err
+	}
+	checkedTypes.Lock()
+	if checkedTypes.types == nil {
+		checkedTypes.types = make(map[reflect.Type]bool)
+	}
+	checkedTypes.types[typ] = true
+	checkedTypes.Unlock()
+	return nil
+}
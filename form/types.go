@@ -21,6 +21,11 @@ const (
 	SELECT
 	// <input type="file">
 	FILE
+	// <fieldset> containing a form for each
+	// element in a slice of structs.
+	FORMSET
+	// <input type="date">
+	DATE
 )
 
 // HasChoices returns wheter the type has multiple
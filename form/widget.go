@@ -0,0 +1,161 @@
+package form
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+
+	"gnd.la/app"
+	"gnd.la/html"
+	"gnd.la/orm"
+)
+
+var (
+	widgetsMu sync.RWMutex
+	widgets   = map[string]Widget{}
+	// widgetTypes maps the widgets names which
+	// correspond to built-in field types.
+	widgetTypes = map[string]Type{
+		"text":     TEXT,
+		"password": PASSWORD,
+		"email":    EMAIL,
+		"hidden":   HIDDEN,
+		"textarea": TEXTAREA,
+		"checkbox": CHECKBOX,
+		"radio":    RADIO,
+		"select":   SELECT,
+		"date":     DATE,
+	}
+)
+
+// Widget is the interface implemented by types which write
+// the input for a field, overriding the default one for its
+// Type. Note that the field Type is still used for parsing
+// the submitted value. See RegisterWidget and Options.Widgets
+// to learn how to use a Widget.
+type Widget interface {
+	// WriteInput writes the input for the given field. attrs contains
+	// the id and name attributes which must be used by the input, as well
+	// as the attributes returned by the form Renderer.
+	WriteInput(ctx *app.Context, w io.Writer, field *Field, attrs html.Attrs) error
+}
+
+// WidgetFunc is an adapter which allows using a function
+// as a Widget.
+type WidgetFunc func(ctx *app.Context, w io.Writer, field *Field, attrs html.Attrs) error
+
+// WriteInput calls fn(ctx, w, field, attrs)
+func (fn WidgetFunc) WriteInput(ctx *app.Context, w io.Writer, field *Field, attrs html.Attrs) error {
+	return fn(ctx, w, field, attrs)
+}
+
+// RegisterWidget registers a widget with the given name, so it can be
+// used from the struct tags with the widget option e.g.
+//
+//  Color string `form:",widget=colorpicker"`
+//
+// The following names are reserved for the built-in types and select
+// them without the need for registering a widget: text, password, email,
+// hidden, textarea, checkbox, radio, select and date. Registering a
+// widget with a reserved or already registered name will panic.
+func RegisterWidget(name string, widget Widget) {
+	widgetsMu.Lock()
+	defer widgetsMu.Unlock()
+	if _, ok := widgetTypes[name]; ok {
+		panic(fmt.Errorf("widget name %q is reserved", name))
+	}
+	if _, ok := widgets[name]; ok {
+		panic(fmt.Errorf("there's already a widget named %q", name))
+	}
+	widgets[name] = widget
+}
+
+func registeredWidget(name string) Widget {
+	widgetsMu.RLock()
+	defer widgetsMu.RUnlock()
+	return widgets[name]
+}
+
+type templateWidget string
+
+func (t templateWidget) WriteInput(ctx *app.Context, w io.Writer, field *Field, attrs html.Attrs) error {
+	tmpl, err := ctx.App().LoadTemplate(string(t))
+	if err != nil {
+		return err
+	}
+	data := map[string]interface{}{
+		"Field": field,
+		"Attrs": attrs,
+		"Value": field.Value(),
+	}
+	if field.Type.HasChoices() {
+		data["Choices"] = field.choices(ctx, field)
+	}
+	return tmpl.ExecuteTo(w, ctx, data)
+}
+
+// TemplateWidget returns a Widget which renders the given template
+// using the App template loader. The template receives a map with the
+// following keys:
+//
+//  - Field: The *Field being rendered.
+//  - Attrs: The html.Attrs which must be used for the input.
+//  - Value: The current field value.
+//  - Choices: For RADIO and SELECT fields, the []*Choice for the field.
+func TemplateWidget(name string) Widget {
+	return templateWidget(name)
+}
+
+// ChoicesFunc is a function which returns the choices for a field.
+// It can be used to provide the choices for a field without implementing
+// ChoicesProvider. See Options.Choices.
+type ChoicesFunc func(ctx *app.Context, field *Field) []*Choice
+
+// QueryChoices returns a ChoicesFunc which generates a choice for each
+// object returned by the given query. obj must be a pointer to the model
+// type returned by the query, while name and value are the names of its
+// fields used for the name and the value of each choice. If the query
+// returns an error, the ChoicesFunc will panic.
+func QueryChoices(q *orm.Query, obj interface{}, name string, value string) ChoicesFunc {
+	typ := reflect.TypeOf(obj)
+	if typ.Kind() != reflect.Ptr || typ.Elem().Kind() != reflect.Struct {
+		panic(fmt.Errorf("obj must be a pointer to struct, not %T", obj))
+	}
+	typ = typ.Elem()
+	for _, v := range []string{name, value} {
+		if _, ok := typ.FieldByName(v); !ok {
+			panic(fmt.Errorf("type %s has no field named %q", typ, v))
+		}
+	}
+	return func(ctx *app.Context, field *Field) []*Choice {
+		var choices []*Choice
+		iter := q.Iter()
+		for {
+			out := reflect.New(typ)
+			if !iter.Next(out.Interface()) {
+				break
+			}
+			elem := out.Elem()
+			choices = append(choices, &Choice{
+				Name:  fmt.Sprint(elem.FieldByName(name).Interface()),
+				Value: elem.FieldByName(value).Interface(),
+			})
+		}
+		if err := iter.Err(); err != nil {
+			panic(err)
+		}
+		return choices
+	}
+}
+
+func (f *Form) writeWidget(buf io.Writer, field *Field) error {
+	attrs := html.Attrs{
+		"id":   field.Id(),
+		"name": field.HTMLName,
+	}
+	if err := f.prepareFieldAttributes(field, attrs, -1); err != nil {
+		return err
+	}
+	return field.widget.WriteInput(f.ctx, buf, field, attrs)
+}
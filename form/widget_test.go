@@ -0,0 +1,186 @@
+package form
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/html"
+
+	"gopkgs.com/vfs.v1"
+)
+
+type widgetEvent struct {
+	Title string `form:",widget=textarea"`
+	Color string `form:",widget=test-color"`
+	Day   time.Time
+	Until time.Time `form:",optional"`
+	Room  int       `form:",select"`
+	Notes string    `form:",optional"`
+}
+
+func init() {
+	RegisterWidget("test-color", WidgetFunc(func(ctx *app.Context, w io.Writer, field *Field, attrs html.Attrs) error {
+		attrs["type"] = "color"
+		attrs["value"] = fmt.Sprint(field.Value())
+		_, err := html.El("input", attrs).WriteTo(w)
+		return err
+	}))
+}
+
+func roomChoices(ctx *app.Context, field *Field) []*Choice {
+	return []*Choice{{Name: "Small", Value: 1}, {Name: "Large", Value: 2}}
+}
+
+// widgetRequest runs the given function as the handler for a
+// request with the given form values, returning its output.
+func widgetRequest(t *testing.T, values url.Values, fn func(ctx *app.Context)) string {
+	a := app.New()
+	a.Logger = nil
+	fs, err := vfs.Map(map[string]*vfs.File{
+		"notes.html": &vfs.File{Data: []byte(`<textarea class="notes" name="{{ .Attrs.name }}">{{ .Value }}</textarea>`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.SetTemplatesFS(fs)
+	a.Handle("^/$", fn)
+	var r *http.Request
+	if values != nil {
+		r, _ = http.NewRequest("POST", "http://localhost/", strings.NewReader(values.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else {
+		r, _ = http.NewRequest("GET", "http://localhost/", nil)
+	}
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	return w.Body.String()
+}
+
+func newWidgetForm(ctx *app.Context, event *widgetEvent, renderer Renderer) *Form {
+	return NewOpts(ctx, &Options{
+		Renderer:    renderer,
+		DisableCSRF: true,
+		Widgets:     map[string]Widget{"Notes": TemplateWidget("notes.html")},
+		Choices:     map[string]ChoicesFunc{"Room": roomChoices},
+	}, event)
+}
+
+func TestWidgetsRender(t *testing.T) {
+	body := widgetRequest(t, nil, func(ctx *app.Context) {
+		event := &widgetEvent{
+			Color: "#ff0000",
+			Day:   time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC),
+			Room:  2,
+			Notes: "bring snacks",
+		}
+		html, err := newWidgetForm(ctx, event, nil).Render()
+		if err != nil {
+			panic(err)
+		}
+		ctx.WriteString(string(html))
+	})
+	for _, v := range []string{
+		`name="title"></textarea>`,
+		`name="color" type="color" value="#ff0000"`,
+		`name="day" type="date" value="2026-10-16"`,
+		`name="until" type="date" value=""`,
+		`<option selected="selected" value="2">Large</option>`,
+		`<textarea class="notes" name="notes">bring snacks</textarea>`,
+	} {
+		if !strings.Contains(body, v) {
+			t.Errorf("rendered form does not contain %s:\n%s", v, body)
+		}
+	}
+}
+
+func TestWidgetsSubmit(t *testing.T) {
+	submit := func(values url.Values) string {
+		return widgetRequest(t, values, func(ctx *app.Context) {
+			var event widgetEvent
+			f := newWidgetForm(ctx, &event, nil)
+			if !f.IsValid() {
+				for _, v := range f.Errors() {
+					fmt.Fprintf(ctx, "%s: %s\n", v.Field, v.Err)
+				}
+				return
+			}
+			fmt.Fprintf(ctx, "%s %s %s %v %d", event.Title, event.Color, event.Day.Format(dateFormat), event.Until.IsZero(), event.Room)
+		})
+	}
+	values := url.Values{
+		"title": {"party"},
+		"color": {"#00ff00"},
+		"day":   {"2026-12-31"},
+		"room":  {"1"},
+	}
+	if s := submit(values); s != "party #00ff00 2026-12-31 true 1" {
+		t.Errorf("unexpected submission result %q", s)
+	}
+	values.Set("day", "31/12/2026")
+	values.Set("room", "3")
+	s := submit(values)
+	for _, v := range []string{`Day: "31/12/2026" is not a valid date`, "Room: 3 is not a valid choice"} {
+		if !strings.Contains(s, v) {
+			t.Errorf("expecting error %q, got %q", v, s)
+		}
+	}
+	values.Del("day")
+	values.Set("room", "1")
+	if s := submit(values); !strings.HasPrefix(s, "Day:") || strings.Count(s, "\n") != 1 {
+		t.Errorf("expecting just a required error for Day, got %q", s)
+	}
+}
+
+func TestRegisterWidgetPanics(t *testing.T) {
+	for _, v := range []string{"date", "test-color"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expecting a panic registering widget %q", v)
+				}
+			}()
+			RegisterWidget(v, TemplateWidget("foo.html"))
+		}()
+	}
+	body := widgetRequest(t, nil, func(ctx *app.Context) {
+		var bad struct {
+			Name string `form:",widget=missing"`
+		}
+		defer func() {
+			ctx.WriteString(fmt.Sprint(recover()))
+		}()
+		New(ctx, &bad)
+	})
+	if !strings.Contains(body, `unknown widget "missing"`) {
+		t.Errorf("expecting unknown widget error, got %q", body)
+	}
+}
+
+func TestPlainRenderer(t *testing.T) {
+	body := widgetRequest(t, url.Values{"title": {"party"}}, func(ctx *app.Context) {
+		var event widgetEvent
+		f := newWidgetForm(ctx, &event, &PlainRenderer{ErrorClass: "invalid"})
+		f.IsValid()
+		html, err := f.RenderOnly("Title", "Day")
+		if err != nil {
+			panic(err)
+		}
+		ctx.WriteString(string(html))
+	})
+	for _, v := range []string{
+		`<div class="field"><label`,
+		`<div class="field invalid"><label`,
+		`<span class="invalid">`,
+	} {
+		if !strings.Contains(body, v) {
+			t.Errorf("rendered form does not contain %s:\n%s", v, body)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package bootstrap5
+
+import (
+	"fmt"
+
+	"gnd.la/template/assets"
+
+	"gopkgs.com/semver.v1"
+)
+
+const (
+	bootstrapCSSFmt = "//cdn.jsdelivr.net/npm/bootstrap@%s/dist/css/bootstrap.min.css"
+	bootstrapJSFmt  = "//cdn.jsdelivr.net/npm/bootstrap@%s/dist/js/bootstrap.bundle.min.js"
+)
+
+func bootstrapParser(m *assets.Manager, version string, options assets.Options) ([]*assets.Asset, error) {
+	bsVersion, err := semver.Parse(version)
+	if err != nil || bsVersion.Major != 5 || bsVersion.PreRelease != "" || bsVersion.Build != "" {
+		return nil, fmt.Errorf("invalid bootstrap version %q, must be in 5.x.y form", version)
+	}
+	as := []*assets.Asset{
+		assets.CSS(fmt.Sprintf(bootstrapCSSFmt, version)),
+	}
+	if !options.BoolOpt("nojs") {
+		as = append(as, assets.Script(fmt.Sprintf(bootstrapJSFmt, version)))
+	}
+	return as, nil
+}
+
+func init() {
+	assets.Register("bootstrap5", assets.SingleParser(bootstrapParser))
+}
@@ -0,0 +1,22 @@
+// Package bootstrap5 implements some helper functions intended
+// to be used with version 5 of the Bootstrap front-end framework.
+// See https://getbootstrap.com for more details. For Bootstrap 3,
+// use gnd.la/frontend/bootstrap.
+//
+// This package defines the "bootstrap5" asset, which serves bootstrap
+// from https://www.jsdelivr.com. It receives a single argument with the
+// desired bootstrap version, which must be 5.x.y. e.g.
+//
+//  bootstrap5: 5.3.0
+//
+// This asset also supports the following options:
+//
+//  nojs (bool): disables loading bootstrap's javascript bundle
+//  e.g. bootstrap5|nojs: 5.3.0
+//
+// See gnd.la/template and gnd.la/template/assets for more information
+// about template functions and the assets pipeline.
+//
+// Importing this package will also register FormRenderer as the default
+// gnd.la/form renderer.
+package bootstrap5
@@ -0,0 +1,146 @@
+package bootstrap5
+
+import (
+	"io"
+
+	"gnd.la/form"
+	"gnd.la/html"
+)
+
+// FormRenderer implements a gnd.la/form renderer using
+// bootstrap 5.
+type FormRenderer struct {
+}
+
+func (r *FormRenderer) isCheck(field *form.Field) bool {
+	return field.Type == form.CHECKBOX || field.Type == form.RADIO
+}
+
+func (r *FormRenderer) BeginField(w io.Writer, field *form.Field) error {
+	class := "mb-3"
+	if field.Type == form.CHECKBOX {
+		class += " form-check"
+	}
+	div := html.Div()
+	div.Attrs = html.Attrs{"class": class}
+	div.Open = true
+	_, err := div.WriteTo(w)
+	return err
+}
+
+func (r *FormRenderer) BeginLabel(w io.Writer, field *form.Field, label string, pos int) error {
+	if field.Type == form.RADIO && pos >= 0 {
+		div := html.Div()
+		div.Attrs = html.Attrs{"class": "form-check"}
+		div.Open = true
+		_, err := div.WriteTo(w)
+		return err
+	}
+	return nil
+}
+
+func (r *FormRenderer) LabelAttributes(field *form.Field, pos int) (html.Attrs, error) {
+	if r.isCheck(field) {
+		if field.Type == form.RADIO && pos < 0 {
+			// Label for the whole radio group
+			return html.Attrs{"class": "form-label"}, nil
+		}
+		return html.Attrs{"class": "form-check-label"}, nil
+	}
+	return html.Attrs{"class": "form-label"}, nil
+}
+
+func (r *FormRenderer) EndLabel(w io.Writer, field *form.Field, pos int) error {
+	if field.Type == form.RADIO && pos >= 0 {
+		_, err := io.WriteString(w, "</div>")
+		return err
+	}
+	return nil
+}
+
+func (r *FormRenderer) BeginInput(w io.Writer, field *form.Field, placeholder string, pos int) error {
+	var err error
+	if field.HasAddOns() && pos == -1 {
+		class := "input-group"
+		if field.Err() != nil {
+			class += " has-validation"
+		}
+		div := html.Div()
+		div.Attrs = html.Attrs{"class": class}
+		div.Open = true
+		_, err = div.WriteTo(w)
+	}
+	return err
+}
+
+func (r *FormRenderer) FieldAttributes(field *form.Field, pos int) (html.Attrs, error) {
+	var class string
+	switch {
+	case r.isCheck(field):
+		class = "form-check-input"
+	case field.Type == form.SELECT:
+		if pos != -1 {
+			// <option>
+			return nil, nil
+		}
+		class = "form-select"
+	case field.Type == form.HIDDEN:
+		return nil, nil
+	default:
+		class = "form-control"
+	}
+	if field.Err() != nil {
+		class += " is-invalid"
+	}
+	return html.Attrs{"class": class}, nil
+}
+
+func (r *FormRenderer) EndInput(w io.Writer, field *form.Field, pos int) error {
+	var err error
+	if field.HasAddOns() && pos == -1 {
+		_, err = io.WriteString(w, "</div>")
+	}
+	return err
+}
+
+func (r *FormRenderer) WriteAddOn(w io.Writer, field *form.Field, addon *form.AddOn) error {
+	node := addon.Node
+	if node.Type == html.TypeText {
+		node = &html.Node{
+			Tag:      "span",
+			Attrs:    html.Attrs{"class": "input-group-text"},
+			Children: addon.Node,
+		}
+	}
+	_, err := node.WriteTo(w)
+	return err
+}
+
+func (r *FormRenderer) WriteError(w io.Writer, field *form.Field, err error) error {
+	// Use d-block, since the error is not always a sibling
+	// of the input (e.g. radios or inputs with addons).
+	div := html.Div(html.Text(err.Error()))
+	div.Attrs = html.Attrs{"class": "invalid-feedback d-block"}
+	_, werr := div.WriteTo(w)
+	return werr
+}
+
+func (r *FormRenderer) WriteHelp(w io.Writer, field *form.Field, help string) error {
+	div := html.Div(html.Text(help))
+	div.Attrs = html.Attrs{"class": "form-text"}
+	_, err := div.WriteTo(w)
+	return err
+}
+
+func (r *FormRenderer) EndField(w io.Writer, field *form.Field) error {
+	_, err := io.WriteString(w, "</div>")
+	return err
+}
+
+func newRenderer() form.Renderer {
+	return &FormRenderer{}
+}
+
+func init() {
+	form.SetDefaultRenderer(newRenderer)
+}
@@ -0,0 +1,59 @@
+package bootstrap5
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/form"
+)
+
+type testSignup struct {
+	Name     string `form:",help=Your full name"`
+	Plan     int    `form:",radio"`
+	Accepted bool   `form:",optional"`
+}
+
+func (s *testSignup) FieldChoices(ctx *app.Context, field *form.Field) []*form.Choice {
+	return []*form.Choice{{Name: "Free", Value: 1}, {Name: "Pro", Value: 2}}
+}
+
+func TestFormRenderer(t *testing.T) {
+	a := app.New()
+	a.Logger = nil
+	a.Handle("^/$", func(ctx *app.Context) {
+		var signup testSignup
+		f := form.NewOpts(ctx, &form.Options{DisableCSRF: true}, &signup)
+		f.IsValid()
+		html, err := f.Render()
+		if err != nil {
+			panic(err)
+		}
+		ctx.WriteString(string(html))
+	})
+	values := url.Values{"plan": {"1"}}
+	r, _ := http.NewRequest("POST", "http://localhost/", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	body := w.Body.String()
+	for _, v := range []string{
+		`<div class="mb-3"><label class="form-label"`,
+		`class="form-control is-invalid"`,
+		`<div class="invalid-feedback d-block">`,
+		`<div class="form-text">Your full name</div>`,
+		`<div class="form-check"><label class="form-check-label"`,
+		`class="form-check-input"`,
+		`<div class="mb-3 form-check">`,
+	} {
+		if !strings.Contains(body, v) {
+			t.Errorf("rendered form does not contain %s:\n%s", v, body)
+		}
+	}
+	if strings.Contains(body, "form-check-input is-invalid") {
+		t.Errorf("valid fields should not be marked as invalid:\n%s", body)
+	}
+}
@@ -2,6 +2,7 @@ package html
 
 import (
 	"io"
+	"sort"
 	"strings"
 
 	"gnd.la/util/types"
@@ -50,64 +51,37 @@ func (a Attrs) WriteTo(w io.Writer) (int, error) {
 	return a.writeTo(w)
 }
 
+// keys returns the attribute names sorted, so
+// the output is always the same.
+func (a Attrs) keys() []string {
+	keys := make([]string, 0, len(a))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func (a Attrs) writeTo(w io.Writer) (int, error) {
 	t := 0
-	for k, v := range a {
-		_, err := w.Write([]byte{' '})
+	for _, k := range a.keys() {
+		c, err := w.Write([]byte(" " + k + "=\"" + Escape(a[k]) + "\""))
 		if err != nil {
-			return 0, err
-		}
-		t += 1
-		c, err := w.Write([]byte(k))
-		if err != nil {
-			return 0, err
+			return t, err
 		}
 		t += c
-		_, err = w.Write([]byte{'=', '"'})
-		if err != nil {
-			return 0, err
-		}
-		t += 1
-		c, err = w.Write([]byte(Escape(v)))
-		if err != nil {
-			return 0, err
-		}
-		t += c
-		_, err = w.Write([]byte{'"'})
-		if err != nil {
-			return 0, err
-		}
 	}
 	return t, nil
 }
 
 func (a Attrs) writeToStringWriter(w stringWriter) (int, error) {
 	t := 0
-	for k, v := range a {
-		_, err := w.WriteString(" ")
-		if err != nil {
-			return 0, err
-		}
-		t += 1
-		c, err := w.WriteString(k)
-		if err != nil {
-			return 0, err
-		}
-		t += c
-		_, err = w.WriteString("=\"")
-		if err != nil {
-			return 0, err
-		}
-		t += 1
-		c, err = w.WriteString(Escape(v))
+	for _, k := range a.keys() {
+		c, err := w.WriteString(" " + k + "=\"" + Escape(a[k]) + "\"")
 		if err != nil {
-			return 0, err
+			return t, err
 		}
 		t += c
-		_, err = w.WriteString("\"")
-		if err != nil {
-			return 0, err
-		}
 	}
 	return t, nil
 }
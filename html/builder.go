@@ -0,0 +1,137 @@
+package html
+
+import (
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+
+	"gnd.la/util/types"
+)
+
+// Classes represents a set of conditional classes. When passed
+// to El, the keys with a true value are added to the element
+// class attribute, in alphabetical order.
+//
+//  El("li", Classes{"active": isActive, "disabled": !enabled}, item.Name)
+type Classes map[string]bool
+
+func (c Classes) names() []string {
+	var names []string
+	for k, v := range c {
+		if v && k != "" {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// El returns a new element with the given tag. The rest of the
+// arguments are interpreted according to their type:
+//
+//  - nil values are ignored
+//  - *Node values are appended as children (including their siblings)
+//  - Attrs values are set as attributes, overwriting any previous ones
+//  - Classes values add their enabled classes to the class attribute
+//  - template.HTML values are appended as text without escaping them
+//  - Any other value is converted to a string and appended as escaped text
+//
+// Void elements (e.g. br or img) are automatically rendered
+// without a closing tag. El is intended for building small HTML
+// fragments in handlers without defining a template, e.g.:
+//
+//  El("a", Attrs{"href": u}, Classes{"active": active}, title).WriteTo(ctx)
+//
+// Since attribute values are always escaped when rendering and
+// text is escaped by El, user provided data can be safely used
+// as an argument.
+func El(tag string, args ...interface{}) *Node {
+	n := &Node{
+		Type: TypeTag,
+		Tag:  tag,
+		Open: voidElements[strings.ToLower(tag)],
+	}
+	for _, v := range args {
+		switch x := v.(type) {
+		case nil:
+		case *Node:
+			if x != nil {
+				n.Append(x)
+			}
+		case Attrs:
+			for k, val := range x {
+				n.SetAttr(k, val)
+			}
+		case Classes:
+			for _, c := range x.names() {
+				n.AddClass(c)
+			}
+		case template.HTML:
+			n.Append(Text(string(x)))
+		default:
+			n.Append(EscapedText(types.ToString(x)))
+		}
+	}
+	return n
+}
+
+// EscapedText returns a text node with the given text, escaping
+// it. Note that nodes created with Text are rendered verbatim.
+func EscapedText(text string) *Node {
+	return Text(Escape(text))
+}
+
+// Textf is a shorthand for EscapedText(fmt.Sprintf(format, args...)).
+func Textf(format string, args ...interface{}) *Node {
+	return EscapedText(fmt.Sprintf(format, args...))
+}
+
+// Fragment links the given nodes as siblings and returns the
+// first one, so they can be rendered together or appended to
+// another node in a single call. nil nodes are ignored.
+func Fragment(nodes ...*Node) *Node {
+	var first, last *Node
+	for _, v := range nodes {
+		if v == nil {
+			continue
+		}
+		if first == nil {
+			first = v
+		} else {
+			last.Next = v
+		}
+		last = v
+		for last.Next != nil {
+			last = last.Next
+		}
+	}
+	return first
+}
+
+// AppendChildren appends the given nodes to n and returns n.
+// nil nodes are ignored.
+func (n *Node) AppendChildren(nodes ...*Node) *Node {
+	if f := Fragment(nodes...); f != nil {
+		n.Append(f)
+	}
+	return n
+}
+
+// ClassIf adds the given class to n only if cond is true.
+// It returns n.
+func (n *Node) ClassIf(cls string, cond bool) *Node {
+	if cond {
+		n.AddClass(cls)
+	}
+	return n
+}
+
+// AttrIf sets the given attribute only if cond is true.
+// It returns n.
+func (n *Node) AttrIf(name string, value interface{}, cond bool) *Node {
+	if cond {
+		n.SetAttr(name, value)
+	}
+	return n
+}
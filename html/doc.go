@@ -1,3 +1,8 @@
 // Package html provides some basic data structures for
 // declaring HTML elements using Go code.
+//
+// Besides the functions for creating specific elements (e.g. Div
+// or A), El provides a generic builder which accepts attributes,
+// conditional classes, children and text, escaping the latter.
+// Nodes can be rendered directly to an io.Writer using WriteTo.
 package html
@@ -1,6 +1,8 @@
 package html
 
 import (
+	"bytes"
+	"html/template"
 	"testing"
 )
 
@@ -27,3 +29,22 @@ func TestTree(t *testing.T) {
 func TestAttr(t *testing.T) {
 	testHTML(t, Div().AddClass("error").SetAttr("id", "foo"), t2)
 }
+
+func TestEl(t *testing.T) {
+	active := true
+	n := El("ul", Attrs{"id": "menu"},
+		El("li", Classes{"active": active, "disabled": !active, "item": true}, El("a", Attrs{"href": "/?a=1&b=2"}, "<Home>")),
+		El("li", Classes{"item": true}, template.HTML("<b>raw</b>"), nil),
+	)
+	testHTML(t, n, `<ul id="menu"><li class="active item"><a href="/?a=1&amp;b=2">&lt;Home&gt;</a></li><li class="item"><b>raw</b></li></ul>`)
+	testHTML(t, El("p", "a", El("br"), 42), `<p>a<br>42</p>`)
+	testHTML(t, Div().ClassIf("foo", false).ClassIf("bar", true).AttrIf("title", `"x"`, true), `<div class="bar" title="&#34;x&#34;"></div>`)
+	testHTML(t, Span().AppendChildren(Textf("%d < %d", 1, 2), nil, Fragment(Text("a"), Text("b"))), `<span>1 &lt; 2ab</span>`)
+	var buf bytes.Buffer
+	if _, err := El("div", Attrs{"b": "2", "a": "1"}).WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != `<div a="1" b="2"></div>` {
+		t.Errorf("unexpected output %q", s)
+	}
+}
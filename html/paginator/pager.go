@@ -1,6 +1,10 @@
 package paginator
 
-import "fmt"
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
 
 // Pager represents an interface which returns the URL
 // for the given page number. Note that page numbers are
@@ -26,3 +30,21 @@ func Fmt(format string, base string) Pager {
 		return fmt.Sprintf(format, base, page)
 	})
 }
+
+// Query returns a Pager which generates the URLs by setting
+// the given query parameter to the page number in u, preserving
+// the rest of its query parameters (e.g. filters or sorting
+// options). The parameter is omitted for the first page.
+func Query(u *url.URL, param string) Pager {
+	return pager(func(page int) string {
+		cpy := *u
+		values := cpy.Query()
+		if page > 1 {
+			values.Set(param, strconv.Itoa(page))
+		} else {
+			values.Del(param)
+		}
+		cpy.RawQuery = values.Encode()
+		return cpy.String()
+	})
+}
@@ -88,6 +88,109 @@ func (p *Paginator) appendNode(parent *html.Node, page int, flags PageFlags) {
 	parent.AppendChild(node)
 }
 
+// Page represents a page in the paginator window, as
+// returned by Paginator.Pages.
+type Page struct {
+	// Number is the page number (1-indexed). It's
+	// zero for separators.
+	Number int
+	// URL is the URL for the page, as returned by the
+	// Pager. It's empty for separators.
+	URL string
+	// Current is true iff this is the current page.
+	Current bool
+	// Separator is true for the gaps between the
+	// boundaries and the pages around the current one.
+	Separator bool
+}
+
+// window returns the page numbers shown by the paginator,
+// taking into account its Offset and its Flags. Separators
+// are represented by -1.
+func (p *Paginator) window() []int {
+	var pages []int
+	left := p.Current - p.Offset
+	if left < 1 {
+		left = 1
+	}
+	if left > 1 && p.Flags&FlagNoBoundaries == 0 {
+		pages = append(pages, 1)
+		if left > 2 {
+			pages = append(pages, -1)
+		}
+	}
+	right := p.Current + p.Offset
+	if right > p.Count {
+		right = p.Count
+	}
+	if right < p.Current {
+		right = p.Current
+	}
+	for ii := left; ii <= right; ii++ {
+		pages = append(pages, ii)
+	}
+	if right < p.Count && p.Flags&FlagNoBoundaries == 0 {
+		if right < p.Count-1 {
+			pages = append(pages, -1)
+		}
+		pages = append(pages, p.Count)
+	}
+	return pages
+}
+
+// Pages returns the pages in the paginator window, which includes
+// the pages around the current one and, unless FlagNoBoundaries is
+// set, the first and the last ones. It's intended for templates which
+// render the paginator by themselves, rather than using a Renderer e.g.
+//
+//  {{ range .Paginator.Pages }}
+//	{{ if .Separator }}&hellip;{{ else if .Current }}<b>{{ .Number }}</b>{{ else }}<a href="{{ .URL }}">{{ .Number }}</a>{{ end }}
+//  {{ end }}
+func (p *Paginator) Pages() []*Page {
+	window := p.window()
+	pages := make([]*Page, len(window))
+	for ii, v := range window {
+		if v < 0 {
+			pages[ii] = &Page{Separator: true}
+			continue
+		}
+		pages[ii] = &Page{
+			Number:  v,
+			URL:     p.Pager.URL(v),
+			Current: v == p.Current,
+		}
+	}
+	return pages
+}
+
+// HasPrevious returns true iff there's a page before the current one.
+func (p *Paginator) HasPrevious() bool {
+	return p.Current > 1
+}
+
+// HasNext returns true iff there's a page after the current one.
+func (p *Paginator) HasNext() bool {
+	return p.Current < p.Count
+}
+
+// PreviousURL returns the URL for the previous page, or an
+// empty string if the current page is the first one.
+func (p *Paginator) PreviousURL() string {
+	if !p.HasPrevious() {
+		return ""
+	}
+	return p.Pager.URL(p.Current - 1)
+}
+
+// NextURL returns the URL for the next page, or an
+// empty string if the current page is the last one.
+func (p *Paginator) NextURL() string {
+	if !p.HasNext() {
+		return ""
+	}
+	return p.Pager.URL(p.Current + 1)
+}
+
 // Render renders the Paginator as HTML. It's usually
 // called from a template e.g.
 //
@@ -111,32 +214,15 @@ func (p *Paginator) Render() template.HTML {
 		}
 		p.appendNode(parent, p.Current-1, flags)
 	}
-	left := p.Current - p.Offset
-	if left < 1 {
-		left = 1
-	}
-	if left > 1 && p.Flags&FlagNoBoundaries == 0 {
-		p.appendNode(parent, 1, 0)
-		if left > 2 {
-			p.appendNode(parent, -1, PageSeparator|PageDisabled)
-		}
-	}
-	for ; left < p.Current; left++ {
-		p.appendNode(parent, left, 0)
-	}
-	p.appendNode(parent, p.Current, PageCurrent)
-	right := p.Current + p.Offset
-	if right > p.Count {
-		right = p.Count
-	}
-	for jj := p.Current + 1; jj <= right; jj++ {
-		p.appendNode(parent, jj, 0)
-	}
-	if right < p.Count && p.Flags&FlagNoBoundaries == 0 {
-		if right < p.Count-1 {
+	for _, v := range p.window() {
+		switch {
+		case v < 0:
 			p.appendNode(parent, -1, PageSeparator|PageDisabled)
+		case v == p.Current:
+			p.appendNode(parent, v, PageCurrent)
+		default:
+			p.appendNode(parent, v, 0)
 		}
-		p.appendNode(parent, p.Count, 0)
 	}
 	if p.Flags&FlagNoPrevNext == 0 {
 		flags = PageNext
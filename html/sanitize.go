@@ -0,0 +1,484 @@
+package html
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+	"sync"
+)
+
+var (
+	// CommentsPolicy allows basic formatting, links and lists. It's
+	// intended for short user provided content, like comments. Links
+	// get rel="nofollow" added to them.
+	CommentsPolicy = &Policy{
+		Elements: map[string][]string{
+			"a":          {"href", "title"},
+			"b":          nil,
+			"blockquote": {"cite"},
+			"br":         nil,
+			"code":       nil,
+			"em":         nil,
+			"i":          nil,
+			"li":         nil,
+			"ol":         nil,
+			"p":          nil,
+			"pre":        nil,
+			"s":          nil,
+			"strong":     nil,
+			"u":          nil,
+			"ul":         nil,
+		},
+		Protocols:   []string{"http", "https", "mailto"},
+		RelNoFollow: true,
+	}
+	// RichTextPolicy allows everything in CommentsPolicy, plus
+	// headings, images, tables and most other elements used for
+	// formatting text, like the output of WYSIWYG editors. Scripts,
+	// styles, forms and embedded content are never allowed.
+	RichTextPolicy = &Policy{
+		Elements: map[string][]string{
+			"a":          {"href"},
+			"abbr":       nil,
+			"b":          nil,
+			"blockquote": {"cite"},
+			"br":         nil,
+			"caption":    nil,
+			"code":       nil,
+			"dd":         nil,
+			"del":        nil,
+			"div":        nil,
+			"dl":         nil,
+			"dt":         nil,
+			"em":         nil,
+			"figcaption": nil,
+			"figure":     nil,
+			"h1":         nil,
+			"h2":         nil,
+			"h3":         nil,
+			"h4":         nil,
+			"h5":         nil,
+			"h6":         nil,
+			"hr":         nil,
+			"i":          nil,
+			"img":        {"src", "alt", "width", "height"},
+			"ins":        nil,
+			"li":         nil,
+			"mark":       nil,
+			"ol":         {"start"},
+			"p":          nil,
+			"pre":        nil,
+			"q":          {"cite"},
+			"s":          nil,
+			"small":      nil,
+			"span":       nil,
+			"strong":     nil,
+			"sub":        nil,
+			"sup":        nil,
+			"table":      nil,
+			"tbody":      nil,
+			"td":         {"colspan", "rowspan"},
+			"tfoot":      nil,
+			"th":         {"colspan", "rowspan"},
+			"thead":      nil,
+			"tr":         nil,
+			"u":          nil,
+			"ul":         nil,
+		},
+		Attributes:  []string{"title"},
+		Protocols:   []string{"http", "https", "mailto"},
+		RelNoFollow: true,
+	}
+
+	policies = struct {
+		sync.RWMutex
+		m map[string]*Policy
+	}{
+		m: map[string]*Policy{
+			"comments":  CommentsPolicy,
+			"rich_text": RichTextPolicy,
+		},
+	}
+
+	// urlAttributes are the attributes which contain URLs, which
+	// are checked against the Protocols in the Policy.
+	urlAttributes = map[string]bool{
+		"action":     true,
+		"background": true,
+		"cite":       true,
+		"formaction": true,
+		"href":       true,
+		"longdesc":   true,
+		"poster":     true,
+		"src":        true,
+		"usemap":     true,
+	}
+	// dropContent contains the elements which have their
+	// content removed too when they're not allowed, since
+	// it's not meant to be displayed as text.
+	dropContent = map[string]bool{
+		"iframe":   true,
+		"noembed":  true,
+		"noframes": true,
+		"noscript": true,
+		"object":   true,
+		"script":   true,
+		"style":    true,
+		"template": true,
+		"title":    true,
+		"xmp":      true,
+	}
+	voidElements = map[string]bool{
+		"area":   true,
+		"base":   true,
+		"br":     true,
+		"col":    true,
+		"embed":  true,
+		"hr":     true,
+		"img":    true,
+		"input":  true,
+		"link":   true,
+		"meta":   true,
+		"param":  true,
+		"source": true,
+		"track":  true,
+		"wbr":    true,
+	}
+)
+
+// Policy indicates the elements, attributes and URL protocols
+// allowed by Sanitize. Names are case insensitive, but must be
+// specified in lowercase.
+type Policy struct {
+	// Elements maps the allowed elements to the attributes
+	// allowed in each one of them.
+	Elements map[string][]string
+	// Attributes lists the attributes allowed in all the
+	// allowed elements.
+	Attributes []string
+	// Protocols lists the URL schemes allowed in attributes
+	// containing URLs (e.g. href or src). Relative URLs are
+	// always allowed.
+	Protocols []string
+	// RelNoFollow adds rel="nofollow" to links, so search
+	// engines ignore them.
+	RelNoFollow bool
+}
+
+func (p *Policy) allowsAttribute(element string, attr string) bool {
+	for _, v := range p.Attributes {
+		if v == attr {
+			return true
+		}
+	}
+	for _, v := range p.Elements[element] {
+		if v == attr {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) allowsURL(u string) bool {
+	// Browsers ignore whitespace and control characters in
+	// the scheme, so remove them before looking for it.
+	u = strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, u)
+	colon := strings.IndexByte(u, ':')
+	if colon < 0 || strings.IndexAny(u[:colon], "/?#") >= 0 {
+		// Relative URL
+		return true
+	}
+	scheme := strings.ToLower(u[:colon])
+	for _, v := range p.Protocols {
+		if v == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// RegisterPolicy registers a Policy with the given name, so it can
+// be used from templates with the sanitize function. The policies
+// "comments" (CommentsPolicy) and "rich_text" (RichTextPolicy) are
+// always available. If there's already a Policy with the same name,
+// it panics.
+func RegisterPolicy(name string, p *Policy) {
+	policies.Lock()
+	defer policies.Unlock()
+	if _, ok := policies.m[name]; ok {
+		panic(fmt.Errorf("there's already a sanitizer policy named %q", name))
+	}
+	policies.m[name] = p
+}
+
+// PolicyNamed returns the Policy registered with the given
+// name, or nil if there's no such Policy. See RegisterPolicy.
+func PolicyNamed(name string) *Policy {
+	policies.RLock()
+	defer policies.RUnlock()
+	return policies.m[name]
+}
+
+// Sanitize returns the given untrusted HTML, keeping only the
+// elements, attributes and URLs allowed by the policy. Elements
+// which are not allowed are removed, but their text is kept, with
+// the exception of elements with content which is not meant to be
+// displayed, like scripts or styles. Comments are always removed.
+// Text is escaped as needed and unclosed elements are closed, so
+// the output is always well formed and can't affect the rest
+// of the page. If policy is nil, CommentsPolicy is used.
+func Sanitize(input string, policy *Policy) string {
+	if policy == nil {
+		policy = CommentsPolicy
+	}
+	s := &sanitizer{input: input, policy: policy}
+	s.sanitize()
+	return s.buf.String()
+}
+
+type sanitizer struct {
+	input  string
+	pos    int
+	policy *Policy
+	buf    bytes.Buffer
+	open   []string
+}
+
+type attribute struct {
+	name  string
+	value string
+}
+
+func (s *sanitizer) sanitize() {
+	for s.pos < len(s.input) {
+		lt := strings.IndexByte(s.input[s.pos:], '<')
+		if lt < 0 {
+			s.text(s.input[s.pos:])
+			break
+		}
+		s.text(s.input[s.pos : s.pos+lt])
+		s.pos += lt
+		if !s.tag() {
+			// Not a tag, output the < as text
+			s.buf.WriteString("&lt;")
+			s.pos++
+		}
+	}
+	for ii := len(s.open) - 1; ii >= 0; ii-- {
+		s.closeTag(s.open[ii])
+	}
+}
+
+func (s *sanitizer) text(t string) {
+	s.buf.WriteString(html.EscapeString(html.UnescapeString(t)))
+}
+
+// skipTo advances the position after the next occurrence
+// of sep, or to the end of the input if there's none.
+func (s *sanitizer) skipTo(sep string) {
+	if p := strings.Index(s.input[s.pos:], sep); p >= 0 {
+		s.pos += p + len(sep)
+	} else {
+		s.pos = len(s.input)
+	}
+}
+
+// skipToEnd advances the position after the end tag for
+// the given element name, which is matched case insensitively.
+func (s *sanitizer) skipToEnd(name string) {
+	for {
+		p := strings.Index(s.input[s.pos:], "</")
+		if p < 0 {
+			s.pos = len(s.input)
+			return
+		}
+		s.pos += p + 2
+		rem := s.input[s.pos:]
+		if len(rem) >= len(name) && strings.EqualFold(rem[:len(name)], name) {
+			s.skipTo(">")
+			return
+		}
+	}
+}
+
+// tag parses the tag at the current position, which is
+// always a <. If there's no tag, it returns false without
+// changing the position.
+func (s *sanitizer) tag() bool {
+	rem := s.input[s.pos:]
+	switch {
+	case strings.HasPrefix(rem, "<!--"):
+		s.pos += 4
+		s.skipTo("-->")
+		return true
+	case strings.HasPrefix(rem, "<!") || strings.HasPrefix(rem, "<?"):
+		// Doctype, CDATA or processing instruction
+		s.skipTo(">")
+		return true
+	case strings.HasPrefix(rem, "</"):
+		if len(rem) < 3 || !isLetter(rem[2]) {
+			return false
+		}
+		end := strings.IndexByte(rem, '>')
+		if end < 0 {
+			return false
+		}
+		name := strings.ToLower(readName(rem[2:end]))
+		s.pos += end + 1
+		s.endTag(name)
+		return true
+	}
+	if len(rem) < 2 || !isLetter(rem[1]) {
+		return false
+	}
+	name, attrs, n, ok := parseStartTag(rem)
+	if !ok {
+		return false
+	}
+	s.pos += n
+	s.startTag(name, attrs)
+	return true
+}
+
+func (s *sanitizer) startTag(name string, attrs []attribute) {
+	if _, ok := s.policy.Elements[name]; !ok {
+		if dropContent[name] {
+			s.skipToEnd(name)
+		}
+		return
+	}
+	s.buf.WriteByte('<')
+	s.buf.WriteString(name)
+	isLink := name == "a" && s.policy.RelNoFollow
+	hasHref := false
+	seen := make(map[string]bool, len(attrs))
+	for _, v := range attrs {
+		if seen[v.name] || !s.policy.allowsAttribute(name, v.name) || (isLink && v.name == "rel") {
+			continue
+		}
+		seen[v.name] = true
+		if urlAttributes[v.name] && !s.policy.allowsURL(v.value) {
+			continue
+		}
+		if v.name == "href" {
+			hasHref = true
+		}
+		writeAttribute(&s.buf, v.name, v.value)
+	}
+	if isLink && hasHref {
+		writeAttribute(&s.buf, "rel", "nofollow")
+	}
+	s.buf.WriteByte('>')
+	if !voidElements[name] {
+		s.open = append(s.open, name)
+	}
+}
+
+func (s *sanitizer) endTag(name string) {
+	for ii := len(s.open) - 1; ii >= 0; ii-- {
+		if s.open[ii] == name {
+			// Close any elements opened inside this one
+			for jj := len(s.open) - 1; jj >= ii; jj-- {
+				s.closeTag(s.open[jj])
+			}
+			s.open = s.open[:ii]
+			return
+		}
+	}
+	// Not open or not allowed, ignore it
+}
+
+func (s *sanitizer) closeTag(name string) {
+	s.buf.WriteString("</")
+	s.buf.WriteString(name)
+	s.buf.WriteByte('>')
+}
+
+func writeAttribute(buf *bytes.Buffer, name string, value string) {
+	buf.WriteByte(' ')
+	buf.WriteString(name)
+	buf.WriteString("=\"")
+	buf.WriteString(html.EscapeString(value))
+	buf.WriteByte('"')
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// readName returns the tag or attribute name at the start of s.
+func readName(s string) string {
+	for ii := 0; ii < len(s); ii++ {
+		if c := s[ii]; isSpace(c) || c == '/' || c == '>' || c == '=' {
+			return s[:ii]
+		}
+	}
+	return s
+}
+
+// parseStartTag parses the start tag at the beginning of s, returning
+// its lowercased name, its attributes and the number of bytes it takes.
+// If the tag is not terminated, ok is false.
+func parseStartTag(s string) (name string, attrs []attribute, n int, ok bool) {
+	name = readName(s[1:])
+	p := 1 + len(name)
+	name = strings.ToLower(name)
+	for p < len(s) {
+		c := s[p]
+		switch {
+		case c == '>':
+			return name, attrs, p + 1, true
+		case isSpace(c) || c == '/':
+			p++
+			continue
+		}
+		attr := readName(s[p:])
+		if attr == "" {
+			// Stray =
+			attr = "="
+		}
+		p += len(attr)
+		for p < len(s) && isSpace(s[p]) {
+			p++
+		}
+		var value string
+		if p < len(s) && s[p] == '=' {
+			p++
+			for p < len(s) && isSpace(s[p]) {
+				p++
+			}
+			if p >= len(s) {
+				break
+			}
+			if q := s[p]; q == '"' || q == '\'' {
+				end := strings.IndexByte(s[p+1:], q)
+				if end < 0 {
+					return "", nil, 0, false
+				}
+				value = s[p+1 : p+1+end]
+				p += end + 2
+			} else {
+				start := p
+				for p < len(s) && !isSpace(s[p]) && s[p] != '>' {
+					p++
+				}
+				value = s[start:p]
+			}
+		}
+		attrs = append(attrs, attribute{
+			name:  strings.ToLower(attr),
+			value: html.UnescapeString(value),
+		})
+	}
+	return "", nil, 0, false
+}
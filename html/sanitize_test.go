@@ -0,0 +1,82 @@
+package html
+
+import (
+	"testing"
+)
+
+type sanitizeTest struct {
+	input    string
+	expected string
+}
+
+var (
+	commentsTests = []sanitizeTest{
+		{"plain text", "plain text"},
+		{"1 < 2 & 3 > 2", "1 &lt; 2 &amp; 3 &gt; 2"},
+		{"&lt;b&gt; &amp;amp;", "&lt;b&gt; &amp;amp;"},
+		{"<b>bold</b> <I>italic</I>", "<b>bold</b> <i>italic</i>"},
+		{"<p>unclosed <b>tags", "<p>unclosed <b>tags</b></p>"},
+		{"<p><b>misnested</p></b>", "<p><b>misnested</b></p>"},
+		{"</b>stray end tag", "stray end tag"},
+		{"<div class=\"x\">not allowed</div>", "not allowed"},
+		{"<b onclick=\"alert(1)\">attrs</b>", "<b>attrs</b>"},
+		{"<script>alert(1)</script>text", "text"},
+		{"<SCRIPT type=\"text/javascript\">alert(1)</sCrIpT>text", "text"},
+		{"<style>body { display: none }</style>text", "text"},
+		{"<!-- comment -->text<!-- unclosed", "text"},
+		{"<!DOCTYPE html>text", "text"},
+		{"<a href=\"http://example.com\" rel=\"author\">link</a>", "<a href=\"http://example.com\" rel=\"nofollow\">link</a>"},
+		{"<a href=\"/relative?a=1&amp;b=2\">link</a>", "<a href=\"/relative?a=1&amp;b=2\" rel=\"nofollow\">link</a>"},
+		{"<a href=\"javascript:alert(1)\">link</a>", "<a>link</a>"},
+		{"<a href=\"JaVa\tScRiPt:alert(1)\">link</a>", "<a>link</a>"},
+		{"<a href=\"javascript&#58;alert(1)\">link</a>", "<a>link</a>"},
+		{"<a href='data:text/html,foo'>link</a>", "<a>link</a>"},
+		{"<a href=mailto:foo@example.com title=Foo>mail</a>", "<a href=\"mailto:foo@example.com\" title=\"Foo\" rel=\"nofollow\">mail</a>"},
+		{"<a title=\"&quot;><script>\">x</a>", "<a title=\"&#34;&gt;&lt;script&gt;\">x</a>"},
+		{"<a href=\"/a\" href=\"javascript:x\">dup</a>", "<a href=\"/a\" rel=\"nofollow\">dup</a>"},
+		{"<b <i>broken", "<b>broken</b>"},
+		{"<b title=\"unterminated>text", "&lt;b title=&#34;unterminated&gt;text"},
+		{"a <3 b", "a &lt;3 b"},
+		{"<br/>line<br>", "<br>line<br>"},
+		{"<img src=\"/foo.png\">", ""},
+	}
+	richTextTests = []sanitizeTest{
+		{"<h1 title=\"t\">Title</h1>", "<h1 title=\"t\">Title</h1>"},
+		{"<img src=\"/foo.png\" alt=\"Foo\" onerror=\"alert(1)\">", "<img src=\"/foo.png\" alt=\"Foo\">"},
+		{"<img src=\"javascript:alert(1)\">", "<img>"},
+		{"<table><tr><td colspan=2>cell</td></tr></table>", "<table><tr><td colspan=\"2\">cell</td></tr></table>"},
+		{"<iframe src=\"http://example.com\">fallback</iframe>text", "text"},
+		{"<form action=\"/x\"><input name=\"q\"></form>", ""},
+	}
+)
+
+func testSanitize(t *testing.T, policy *Policy, tests []sanitizeTest) {
+	for _, v := range tests {
+		if s := Sanitize(v.input, policy); s != v.expected {
+			t.Errorf("sanitizing %q: expecting %q, got %q", v.input, v.expected, s)
+		}
+	}
+}
+
+func TestSanitizeComments(t *testing.T) {
+	testSanitize(t, nil, commentsTests)
+}
+
+func TestSanitizeRichText(t *testing.T) {
+	testSanitize(t, PolicyNamed("rich_text"), richTextTests)
+}
+
+func TestSanitizeCustomPolicy(t *testing.T) {
+	p := &Policy{
+		Elements:  map[string][]string{"a": {"href"}},
+		Protocols: []string{"ftp"},
+	}
+	RegisterPolicy("test", p)
+	if PolicyNamed("test") != p {
+		t.Error("registered policy not returned by PolicyNamed")
+	}
+	testSanitize(t, p, []sanitizeTest{
+		{"<a href=\"ftp://example.com\" rel=\"me\"><b>ftp</b></a>", "<a href=\"ftp://example.com\">ftp</a>"},
+		{"<a href=\"http://example.com\">http</a>", "<a>http</a>"},
+	})
+}
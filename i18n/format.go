@@ -0,0 +1,229 @@
+package i18n
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatNumber formats the number n using the decimal and group separators
+// for the language returned by lang. If decimals is negative, up to 3 decimal
+// digits are used, omitting any trailing zeros.
+//
+//  FormatNumber(lang, 1234.5, 2) => "1,234.50" in English, "1.234,50" in Spanish
+func FormatNumber(lang Languager, n float64, decimals int) string {
+	s, neg := formatDecimal(GetLocale(lang), n, decimals)
+	if neg {
+		return "-" + s
+	}
+	return s
+}
+
+// FormatPercent formats the ratio n as a percentage using the conventions
+// for the language returned by lang. Note that n is multiplied by 100, so
+// 0.25 is formatted as 25%. The decimals argument works like in FormatNumber.
+func FormatPercent(lang Languager, n float64, decimals int) string {
+	locale := GetLocale(lang)
+	s, neg := formatDecimal(locale, n*100, decimals)
+	return applyNumberPattern(locale.Percent, s, neg)
+}
+
+// FormatCurrency formats the given amount in the given currency, which must
+// be an ISO 4217 code (e.g. "USD" or "EUR") using the conventions for the
+// language returned by lang. The number of decimal digits is determined by
+// the currency.
+//
+//  FormatCurrency(lang, 1234.5, "EUR") => "€1,234.50" in English, "1.234,50 €" in Spanish
+func FormatCurrency(lang Languager, amount float64, currency string) string {
+	currency = strings.ToUpper(currency)
+	digits, ok := currencyDigits[currency]
+	if !ok {
+		digits = 2
+	}
+	symbol := currencySymbols[currency]
+	if symbol == "" {
+		symbol = currency
+	}
+	locale := GetLocale(lang)
+	s, neg := formatDecimal(locale, amount, digits)
+	return strings.Replace(applyNumberPattern(locale.Currency, s, neg), "¤", symbol, -1)
+}
+
+// FormatDate formats the date in t using the given style and the
+// conventions for the language returned by lang.
+func FormatDate(lang Languager, t time.Time, style DateStyle) string {
+	locale := GetLocale(lang)
+	return formatDatePattern(locale, t, locale.DateFormats[styleIndex(style)])
+}
+
+// FormatTime formats the time of the day in t using the given style and the
+// conventions for the language returned by lang.
+func FormatTime(lang Languager, t time.Time, style DateStyle) string {
+	locale := GetLocale(lang)
+	return formatDatePattern(locale, t, locale.TimeFormats[styleIndex(style)])
+}
+
+// FormatDateTime formats both the date and the time in t using the given style
+// and the conventions for the language returned by lang.
+func FormatDateTime(lang Languager, t time.Time, style DateStyle) string {
+	locale := GetLocale(lang)
+	idx := styleIndex(style)
+	date := formatDatePattern(locale, t, locale.DateFormats[idx])
+	tm := formatDatePattern(locale, t, locale.TimeFormats[idx])
+	return strings.Replace(strings.Replace(locale.DateTime, "{1}", date, 1), "{0}", tm, 1)
+}
+
+func styleIndex(style DateStyle) int {
+	if style < DateShort || style > DateFull {
+		return int(DateMedium)
+	}
+	return int(style)
+}
+
+// formatDecimal returns the absolute value of n formatted with
+// the locale separators and whether n is negative, after rounding.
+func formatDecimal(locale *Locale, n float64, decimals int) (string, bool) {
+	if math.IsNaN(n) {
+		return "NaN", false
+	}
+	neg := n < 0
+	n = math.Abs(n)
+	if math.IsInf(n, 0) {
+		return "∞", neg
+	}
+	var s string
+	if decimals < 0 {
+		s = strconv.FormatFloat(n, 'f', 3, 64)
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	} else {
+		s = strconv.FormatFloat(n, 'f', decimals, 64)
+	}
+	if neg && strings.Trim(s, "0.") == "" {
+		// Rounded to zero, don't output -0
+		neg = false
+	}
+	intPart := s
+	fracPart := ""
+	if p := strings.IndexByte(s, '.'); p >= 0 {
+		intPart = s[:p]
+		fracPart = s[p+1:]
+	}
+	var buf bytes.Buffer
+	for ii := 0; ii < len(intPart); ii++ {
+		if ii > 0 && (len(intPart)-ii)%3 == 0 {
+			buf.WriteString(locale.Group)
+		}
+		buf.WriteByte(intPart[ii])
+	}
+	if fracPart != "" {
+		buf.WriteString(locale.Decimal)
+		buf.WriteString(fracPart)
+	}
+	return buf.String(), neg
+}
+
+// applyNumberPattern replaces the number placeholder in a
+// CLDR pattern (e.g. #,##0.00) with the already formatted
+// number.
+func applyNumberPattern(pattern string, number string, neg bool) string {
+	start := strings.IndexAny(pattern, "#0")
+	if start < 0 {
+		return pattern
+	}
+	end := strings.LastIndexAny(pattern, "#0,.") + 1
+	s := pattern[:start] + number + pattern[end:]
+	if neg {
+		return "-" + s
+	}
+	return s
+}
+
+func padNumber(n int, width int) string {
+	s := strconv.Itoa(n)
+	for len(s) < width {
+		s = "0" + s
+	}
+	return s
+}
+
+// formatDatePattern formats t using a CLDR date pattern e.g. "EEEE, d MMMM y".
+func formatDatePattern(locale *Locale, t time.Time, pattern string) string {
+	var buf bytes.Buffer
+	runes := []rune(pattern)
+	for ii := 0; ii < len(runes); ii++ {
+		c := runes[ii]
+		if c == '\'' {
+			if ii+1 < len(runes) && runes[ii+1] == '\'' {
+				buf.WriteRune('\'')
+				ii++
+				continue
+			}
+			for ii++; ii < len(runes) && runes[ii] != '\''; ii++ {
+				buf.WriteRune(runes[ii])
+			}
+			continue
+		}
+		if !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') {
+			buf.WriteRune(c)
+			continue
+		}
+		count := 1
+		for ii+1 < len(runes) && runes[ii+1] == c {
+			count++
+			ii++
+		}
+		switch c {
+		case 'y':
+			if count == 2 {
+				buf.WriteString(padNumber(t.Year()%100, 2))
+			} else {
+				buf.WriteString(padNumber(t.Year(), count))
+			}
+		case 'M', 'L':
+			switch {
+			case count >= 4:
+				buf.WriteString(locale.Months[t.Month()-1])
+			case count == 3:
+				buf.WriteString(locale.ShortMonths[t.Month()-1])
+			default:
+				buf.WriteString(padNumber(int(t.Month()), count))
+			}
+		case 'd':
+			buf.WriteString(padNumber(t.Day(), count))
+		case 'E':
+			if count >= 4 {
+				buf.WriteString(locale.Days[t.Weekday()])
+			} else {
+				buf.WriteString(locale.ShortDays[t.Weekday()])
+			}
+		case 'a':
+			if t.Hour() < 12 {
+				buf.WriteString(locale.AM)
+			} else {
+				buf.WriteString(locale.PM)
+			}
+		case 'h':
+			h := t.Hour() % 12
+			if h == 0 {
+				h = 12
+			}
+			buf.WriteString(padNumber(h, count))
+		case 'H':
+			buf.WriteString(padNumber(t.Hour(), count))
+		case 'm':
+			buf.WriteString(padNumber(t.Minute(), count))
+		case 's':
+			buf.WriteString(padNumber(t.Second(), count))
+		case 'z':
+			buf.WriteString(t.Format("MST"))
+		default:
+			// Unsupported field, write it as is
+			for jj := 0; jj < count; jj++ {
+				buf.WriteRune(c)
+			}
+		}
+	}
+	return buf.String()
+}
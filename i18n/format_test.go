@@ -0,0 +1,82 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+)
+
+type testLanguage string
+
+func (t testLanguage) Language() string { return string(t) }
+
+func TestFormatNumber(t *testing.T) {
+	cases := []struct {
+		lang     string
+		n        float64
+		decimals int
+		expect   string
+	}{
+		{"en", 1234567.891, 2, "1,234,567.89"},
+		{"en", 1234.5, -1, "1,234.5"},
+		{"en", 999, 0, "999"},
+		{"en", -1000, -1, "-1,000"},
+		{"en", -0.001, 2, "0.00"},
+		{"es", 1234.5, 2, "1.234,50"},
+		{"de_AT", 1234.5, 2, "1.234,50"},
+		{"fr", 1234567.5, 1, "1\u202f234\u202f567,5"},
+		{"xx", 1234.5, 2, "1,234.50"},
+	}
+	for _, v := range cases {
+		if s := FormatNumber(testLanguage(v.lang), v.n, v.decimals); s != v.expect {
+			t.Errorf("FormatNumber(%s, %v, %d) = %q, want %q", v.lang, v.n, v.decimals, s, v.expect)
+		}
+	}
+}
+
+func TestFormatPercentCurrency(t *testing.T) {
+	cases := []struct {
+		s      string
+		expect string
+	}{
+		{FormatPercent(testLanguage("en"), 0.256, 0), "26%"},
+		{FormatPercent(testLanguage("es"), 0.256, 1), "25,6\u00a0%"},
+		{FormatCurrency(testLanguage("en"), 1234.5, "USD"), "$1,234.50"},
+		{FormatCurrency(testLanguage("en"), -3, "eur"), "-€3.00"},
+		{FormatCurrency(testLanguage("es"), 1234.5, "EUR"), "1.234,50\u00a0€"},
+		{FormatCurrency(testLanguage("pt_BR"), 10, "BRL"), "R$\u00a010,00"},
+		{FormatCurrency(testLanguage("ja"), 1500, "JPY"), "¥1,500"},
+		{FormatCurrency(testLanguage("en"), 2, "CHF"), "CHF2.00"},
+	}
+	for _, v := range cases {
+		if v.s != v.expect {
+			t.Errorf("expecting %q, got %q", v.expect, v.s)
+		}
+	}
+}
+
+func TestFormatDate(t *testing.T) {
+	tm := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	cases := []struct {
+		s      string
+		expect string
+	}{
+		{FormatDate(testLanguage("en"), tm, DateShort), "1/2/06"},
+		{FormatDate(testLanguage("en"), tm, DateMedium), "Jan 2, 2006"},
+		{FormatDate(testLanguage("en"), tm, DateFull), "Monday, January 2, 2006"},
+		{FormatDate(testLanguage("en_GB"), tm, DateShort), "02/01/2006"},
+		{FormatDate(testLanguage("es"), tm, DateLong), "2 de enero de 2006"},
+		{FormatDate(testLanguage("de"), tm, DateFull), "Montag, 2. Januar 2006"},
+		{FormatDate(testLanguage("ru"), tm, DateLong), "2 января 2006 г."},
+		{FormatDate(testLanguage("ja"), tm, DateFull), "2006年1月2日月曜日"},
+		{FormatTime(testLanguage("en"), tm, DateShort), "3:04 PM"},
+		{FormatTime(testLanguage("fr"), tm, DateMedium), "15:04:05"},
+		{FormatTime(testLanguage("en"), tm, DateLong), "3:04:05 PM UTC"},
+		{FormatDateTime(testLanguage("en"), tm, DateMedium), "Jan 2, 2006, 3:04:05 PM"},
+		{FormatDateTime(testLanguage("fr"), tm, DateShort), "02/01/2006 15:04"},
+	}
+	for _, v := range cases {
+		if v.s != v.expect {
+			t.Errorf("expecting %q, got %q", v.expect, v.s)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// DateStyle indicates the length of a formatted date or time.
+// See FormatDate and FormatTime.
+type DateStyle int
+
+const (
+	// DateShort is the shortest style, usually numeric only
+	// e.g. 1/2/06 or 3:04 PM in English.
+	DateShort DateStyle = iota
+	// DateMedium uses abbreviated month names
+	// e.g. Jan 2, 2006 or 3:04:05 PM in English.
+	DateMedium
+	// DateLong uses complete month names
+	// e.g. January 2, 2006 or 3:04:05 PM MST in English.
+	DateLong
+	// DateFull also includes the day of the week
+	// e.g. Monday, January 2, 2006 in English.
+	DateFull
+)
+
+func (s DateStyle) String() string {
+	switch s {
+	case DateShort:
+		return "short"
+	case DateMedium:
+		return "medium"
+	case DateLong:
+		return "long"
+	case DateFull:
+		return "full"
+	}
+	return fmt.Sprintf("DateStyle(%d)", int(s))
+}
+
+// ParseDateStyle returns the DateStyle with the given name, which
+// must be one of "short", "medium", "long" or "full".
+func ParseDateStyle(name string) (DateStyle, error) {
+	switch strings.ToLower(name) {
+	case "short":
+		return DateShort, nil
+	case "medium":
+		return DateMedium, nil
+	case "long":
+		return DateLong, nil
+	case "full":
+		return DateFull, nil
+	}
+	return DateShort, fmt.Errorf("invalid date style %q", name)
+}
+
+// Locale contains the data required for formatting numbers,
+// currency amounts and dates in a given language. Patterns
+// use the syntax defined by the Unicode CLDR, see
+// http://www.unicode.org/reports/tr35/tr35-numbers.html and
+// http://www.unicode.org/reports/tr35/tr35-dates.html.
+type Locale struct {
+	// Decimal is the decimal separator.
+	Decimal string
+	// Group is the separator for groups of 3 digits.
+	Group string
+	// Percent is the pattern used for percentages e.g. "#,##0%".
+	Percent string
+	// Currency is the pattern used for currency amounts e.g. "¤#,##0.00".
+	// The ¤ character is replaced by the currency symbol.
+	Currency string
+	// DateFormats contains the date patterns, indexed by DateStyle.
+	DateFormats [4]string
+	// TimeFormats contains the time patterns, indexed by DateStyle.
+	TimeFormats [4]string
+	// DateTime is the pattern used to join a date and a time, where
+	// {1} is replaced by the date and {0} by the time.
+	DateTime string
+	// Months contains the month names, starting with January.
+	Months [12]string
+	// ShortMonths contains the abbreviated month names, starting with January.
+	ShortMonths [12]string
+	// Days contains the day names, starting with Sunday.
+	Days [7]string
+	// ShortDays contains the abbreviated day names, starting with Sunday.
+	ShortDays [7]string
+	// AM and PM are the day periods used with 12 hour times.
+	AM string
+	PM string
+}
+
+var registeredLocales struct {
+	sync.RWMutex
+	locales map[string]*Locale
+}
+
+func normalizeLocaleName(lang string) string {
+	lang = strings.Replace(lang, "-", "_", -1)
+	if len(lang) == 5 {
+		return strings.ToLower(lang[:2]) + "_" + strings.ToUpper(lang[3:])
+	}
+	return strings.ToLower(lang)
+}
+
+// RegisterLocale registers the Locale for the given language, which
+// might be either a language code like "es" or a language and country
+// code like "es_MX". Registering a locale for a language which already
+// has one causes a panic.
+func RegisterLocale(lang string, locale *Locale) {
+	name := normalizeLocaleName(lang)
+	registeredLocales.Lock()
+	defer registeredLocales.Unlock()
+	if registeredLocales.locales == nil {
+		registeredLocales.locales = make(map[string]*Locale)
+	}
+	if _, ok := registeredLocales.locales[name]; ok {
+		panic(fmt.Errorf("duplicate locale for language %q", name))
+	}
+	if _, ok := locales[name]; ok {
+		panic(fmt.Errorf("duplicate locale for language %q", name))
+	}
+	registeredLocales.locales[name] = locale
+}
+
+func findLocale(name string) *Locale {
+	registeredLocales.RLock()
+	l := registeredLocales.locales[name]
+	registeredLocales.RUnlock()
+	if l != nil {
+		return l
+	}
+	return locales[name]
+}
+
+// GetLocale returns the Locale for the language returned by lang. If there's
+// no Locale for the language and country (e.g. "es_MX"), the one for the
+// language (e.g. "es") is returned. If there's no Locale for the language
+// either, the English one is returned.
+func GetLocale(lang Languager) *Locale {
+	if lang != nil {
+		name := normalizeLocaleName(lang.Language())
+		if l := findLocale(name); l != nil {
+			return l
+		}
+		if len(name) > 2 {
+			if l := findLocale(name[:2]); l != nil {
+				return l
+			}
+		}
+	}
+	return locales["en"]
+}
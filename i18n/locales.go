@@ -0,0 +1,236 @@
+package i18n
+
+const (
+	nbsp       = "\u00a0"
+	narrowNbsp = "\u202f"
+)
+
+var (
+	enMonths      = [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+	enShortMonths = [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+	enDays        = [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+	enShortDays   = [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+	esMonths = [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"}
+	esDays   = [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"}
+
+	ptMonths      = [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"}
+	ptShortMonths = [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."}
+	ptDays        = [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"}
+	ptShortDays   = [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."}
+
+	cjkMonths = [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"}
+
+	// locales contains the data from the Unicode CLDR
+	// for the most common languages.
+	locales = map[string]*Locale{
+		"en": {
+			Decimal:     ".",
+			Group:       ",",
+			Percent:     "#,##0%",
+			Currency:    "¤#,##0.00",
+			DateFormats: [4]string{"M/d/yy", "MMM d, y", "MMMM d, y", "EEEE, MMMM d, y"},
+			TimeFormats: [4]string{"h:mm a", "h:mm:ss a", "h:mm:ss a z", "h:mm:ss a z"},
+			DateTime:    "{1}, {0}",
+			Months:      enMonths,
+			ShortMonths: enShortMonths,
+			Days:        enDays,
+			ShortDays:   enShortDays,
+			AM:          "AM",
+			PM:          "PM",
+		},
+		"en_GB": {
+			Decimal:     ".",
+			Group:       ",",
+			Percent:     "#,##0%",
+			Currency:    "¤#,##0.00",
+			DateFormats: [4]string{"dd/MM/y", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+			TimeFormats: [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss z"},
+			DateTime:    "{1}, {0}",
+			Months:      enMonths,
+			ShortMonths: enShortMonths,
+			Days:        enDays,
+			ShortDays:   enShortDays,
+			AM:          "am",
+			PM:          "pm",
+		},
+		"es": {
+			Decimal:     ",",
+			Group:       ".",
+			Percent:     "#,##0" + nbsp + "%",
+			Currency:    "#,##0.00" + nbsp + "¤",
+			DateFormats: [4]string{"d/M/yy", "d MMM y", "d 'de' MMMM 'de' y", "EEEE, d 'de' MMMM 'de' y"},
+			TimeFormats: [4]string{"H:mm", "H:mm:ss", "H:mm:ss z", "H:mm:ss z"},
+			DateTime:    "{1}, {0}",
+			Months:      esMonths,
+			ShortMonths: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+			Days:        esDays,
+			ShortDays:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+			AM:          "a." + nbsp + "m.",
+			PM:          "p." + nbsp + "m.",
+		},
+		"fr": {
+			Decimal:     ",",
+			Group:       narrowNbsp,
+			Percent:     "#,##0" + narrowNbsp + "%",
+			Currency:    "#,##0.00" + nbsp + "¤",
+			DateFormats: [4]string{"dd/MM/y", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+			TimeFormats: [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss z"},
+			DateTime:    "{1} {0}",
+			Months:      [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+			ShortMonths: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+			Days:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+			ShortDays:   [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+			AM:          "AM",
+			PM:          "PM",
+		},
+		"de": {
+			Decimal:     ",",
+			Group:       ".",
+			Percent:     "#,##0" + nbsp + "%",
+			Currency:    "#,##0.00" + nbsp + "¤",
+			DateFormats: [4]string{"dd.MM.yy", "dd.MM.y", "d. MMMM y", "EEEE, d. MMMM y"},
+			TimeFormats: [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss z"},
+			DateTime:    "{1}, {0}",
+			Months:      [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+			ShortMonths: [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+			Days:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+			ShortDays:   [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+			AM:          "AM",
+			PM:          "PM",
+		},
+		"it": {
+			Decimal:     ",",
+			Group:       ".",
+			Percent:     "#,##0%",
+			Currency:    "#,##0.00" + nbsp + "¤",
+			DateFormats: [4]string{"dd/MM/yy", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+			TimeFormats: [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss z"},
+			DateTime:    "{1}, {0}",
+			Months:      [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+			ShortMonths: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+			Days:        [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+			ShortDays:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+			AM:          "AM",
+			PM:          "PM",
+		},
+		"pt": {
+			Decimal:     ",",
+			Group:       ".",
+			Percent:     "#,##0%",
+			Currency:    "¤" + nbsp + "#,##0.00",
+			DateFormats: [4]string{"dd/MM/y", "d 'de' MMM 'de' y", "d 'de' MMMM 'de' y", "EEEE, d 'de' MMMM 'de' y"},
+			TimeFormats: [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss z"},
+			DateTime:    "{1} {0}",
+			Months:      ptMonths,
+			ShortMonths: ptShortMonths,
+			Days:        ptDays,
+			ShortDays:   ptShortDays,
+			AM:          "AM",
+			PM:          "PM",
+		},
+		"pt_PT": {
+			Decimal:     ",",
+			Group:       nbsp,
+			Percent:     "#,##0%",
+			Currency:    "#,##0.00" + nbsp + "¤",
+			DateFormats: [4]string{"dd/MM/yy", "dd/MM/y", "d 'de' MMMM 'de' y", "EEEE, d 'de' MMMM 'de' y"},
+			TimeFormats: [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss z"},
+			DateTime:    "{1}, {0}",
+			Months:      ptMonths,
+			ShortMonths: ptShortMonths,
+			Days:        ptDays,
+			ShortDays:   ptShortDays,
+			AM:          "da manhã",
+			PM:          "da tarde",
+		},
+		"nl": {
+			Decimal:     ",",
+			Group:       ".",
+			Percent:     "#,##0%",
+			Currency:    "¤" + nbsp + "#,##0.00",
+			DateFormats: [4]string{"dd-MM-y", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+			TimeFormats: [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss z"},
+			DateTime:    "{1} {0}",
+			Months:      [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+			ShortMonths: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+			Days:        [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+			ShortDays:   [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+			AM:          "a.m.",
+			PM:          "p.m.",
+		},
+		"ru": {
+			Decimal:     ",",
+			Group:       nbsp,
+			Percent:     "#,##0" + nbsp + "%",
+			Currency:    "#,##0.00" + nbsp + "¤",
+			DateFormats: [4]string{"dd.MM.y", "d MMM y 'г'.", "d MMMM y 'г'.", "EEEE, d MMMM y 'г'."},
+			TimeFormats: [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss z"},
+			DateTime:    "{1}, {0}",
+			// Russian uses the genitive case for months in dates
+			Months:      [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+			ShortMonths: [12]string{"янв.", "февр.", "мар.", "апр.", "мая", "июн.", "июл.", "авг.", "сент.", "окт.", "нояб.", "дек."},
+			Days:        [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+			ShortDays:   [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+			AM:          "AM",
+			PM:          "PM",
+		},
+		"ja": {
+			Decimal:     ".",
+			Group:       ",",
+			Percent:     "#,##0%",
+			Currency:    "¤#,##0.00",
+			DateFormats: [4]string{"y/MM/dd", "y/MM/dd", "y年M月d日", "y年M月d日EEEE"},
+			TimeFormats: [4]string{"H:mm", "H:mm:ss", "H:mm:ss z", "H時mm分ss秒 z"},
+			DateTime:    "{1} {0}",
+			Months:      cjkMonths,
+			ShortMonths: cjkMonths,
+			Days:        [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+			ShortDays:   [7]string{"日", "月", "火", "水", "木", "金", "土"},
+			AM:          "午前",
+			PM:          "午後",
+		},
+		"zh": {
+			Decimal:     ".",
+			Group:       ",",
+			Percent:     "#,##0%",
+			Currency:    "¤#,##0.00",
+			DateFormats: [4]string{"y/M/d", "y年M月d日", "y年M月d日", "y年M月d日EEEE"},
+			TimeFormats: [4]string{"HH:mm", "HH:mm:ss", "z HH:mm:ss", "z HH:mm:ss"},
+			DateTime:    "{1} {0}",
+			Months:      [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+			ShortMonths: cjkMonths,
+			Days:        [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+			ShortDays:   [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+			AM:          "上午",
+			PM:          "下午",
+		},
+	}
+
+	// currencySymbols contains the symbols for the most
+	// common currencies. Currencies not listed here are
+	// formatted using their ISO 4217 code.
+	currencySymbols = map[string]string{
+		"USD": "$",
+		"EUR": "€",
+		"GBP": "£",
+		"JPY": "¥",
+		"CNY": "¥",
+		"BRL": "R$",
+		"RUB": "₽",
+		"INR": "₹",
+		"KRW": "₩",
+	}
+
+	// currencyDigits contains the number of fraction digits
+	// for currencies which don't use 2.
+	currencyDigits = map[string]int{
+		"JPY": 0,
+		"KRW": 0,
+		"CLP": 0,
+		"ISK": 0,
+		"BHD": 3,
+		"KWD": 3,
+		"OMR": 3,
+	}
+)
@@ -69,4 +69,9 @@ func testing2(ctx app.Context) {
 	/// This is a var string declared via cast
 	t2 := i18n.String("Testing var string")
 	fmt.Println(t1, t2)
+	// This is not part of the translation comment.
+	//
+	// Translators: This comment uses the xgettext style
+	// and continues in this line.
+	ctx.T("Translators comment")
 }
@@ -0,0 +1,17 @@
+/* A JavaScript file with translatable strings */
+function gettext(msgid) {
+    return msgid;
+}
+
+var re = /gettext("not a string")/g;
+
+// Translators: the title of the dialog
+var title = gettext("Dialog title");
+var button = pgettext("button", 'Open');
+
+/// Number of selected items
+/// in the list
+var items = ngettext("One item", "%d " + "items", count);
+var files = npgettext("files", "One file", "%d files", count);
+var escaped = gettext("Line with \"quotes\"\nand éscapes");
+var ignored = gettext(variable);
@@ -0,0 +1 @@
+var a=gettext("Minified");
@@ -6,28 +6,49 @@ import (
 	"strings"
 )
 
+// translatorComment returns the translator comment from the given
+// comment lines, which must not include the comment markers. Lines
+// starting with / are part of the translator comment (e.g. /// in Go),
+// as well as the line starting with Translators: and all the lines
+// following it, as in xgettext.
+func translatorComment(texts []string) string {
+	var lines []string
+	translators := false
+	for _, text := range texts {
+		text = strings.TrimSpace(text)
+		if text == "" || text == "/" {
+			continue
+		}
+		if !translators && strings.HasPrefix(strings.ToLower(text), "translators:") {
+			translators = true
+		}
+		if translators {
+			lines = append(lines, text)
+			continue
+		}
+		if text[0] != '/' {
+			continue
+		}
+		lines = append(lines, strings.TrimSpace(text[1:]))
+	}
+	return strings.Join(lines, "\n")
+}
+
 func comments(fset *token.FileSet, f *ast.File, pos *token.Position) string {
 	for _, v := range f.Comments {
 		end := fset.Position(v.End())
 		if end.Filename == pos.Filename && end.Line == pos.Line-1 {
-			var lines []string
+			var texts []string
 			for _, c := range v.List {
 				text := c.Text
 				if strings.HasPrefix(text, "//") {
 					text = text[2:]
 				} else if strings.HasPrefix(text, "/*") {
-					text = text[2 : len(text)-4]
+					text = strings.TrimSuffix(text[2:], "*/")
 				}
-				text = strings.TrimSpace(text)
-				if text == "" || text == "/" || text[0] != '/' {
-					continue
-				}
-				text = strings.TrimSpace(text[1:])
-				lines = append(lines, text)
-			}
-			if len(lines) > 0 {
-				return strings.Join(lines, "\n")
+				texts = append(texts, text)
 			}
+			return translatorComment(texts)
 		}
 	}
 	return ""
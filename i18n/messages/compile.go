@@ -31,7 +31,7 @@ func Compile(filename string, translations []*po.Po, opts *CompileOptions) error
 	}
 	for _, v := range translations {
 		table := poToTable(v, defaultContext)
-		form, err := funcFromFormula(v.Attrs["Plural-Forms"])
+		form, err := funcFromFormula(v.Attrs["Plural-Forms"], v.Attrs["Language"])
 		if err != nil {
 			return err
 		}
@@ -8,6 +8,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"text/template/parse"
 
@@ -17,6 +19,18 @@ import (
 	"gnd.la/log"
 )
 
+var (
+	templateCommentRe = regexp.MustCompile(`\{\{-?\s*/\*([\s\S]*?)\*/\s*-?\}\}`)
+	// skippedDirs contains the names of the directories which
+	// are not traversed while extracting messages, since they
+	// usually contain third party code.
+	skippedDirs = map[string]bool{
+		"node_modules":     true,
+		"bower_components": true,
+		"vendor":           true,
+	}
+)
+
 func DefaultFunctions() []*Function {
 	return []*Function{
 		// Singular functions without context
@@ -47,6 +61,11 @@ func DefaultFunctions() []*Function {
 		{Name: "gnd.la/i18n.NewErrornc", Context: true, Plural: true},
 		{Name: "gnd.la/app.Context.Tnc", Context: true, Plural: true},
 		{Name: "tnc", Template: true, Context: true, Plural: true},
+		// JavaScript functions, using the gettext names
+		{Name: "gettext", JavaScript: true},
+		{Name: "pgettext", JavaScript: true, Context: true},
+		{Name: "ngettext", JavaScript: true, Plural: true},
+		{Name: "npgettext", JavaScript: true, Context: true, Plural: true},
 	}
 }
 
@@ -104,6 +123,10 @@ func extract(messages messageMap, dir string, opts *ExtractOptions) error {
 		name := v.Name()
 		p := filepath.Join(dir, name)
 		if v.IsDir() {
+			if skippedDirs[name] {
+				log.Debugf("Skipping directory %s", p)
+				continue
+			}
 			if !pkgutil.IsPackage(p) {
 				if err := extract(messages, p, opts); err != nil {
 					return err
@@ -111,19 +134,28 @@ func extract(messages messageMap, dir string, opts *ExtractOptions) error {
 			}
 			continue
 		}
+		var err error
 		switch strings.ToLower(filepath.Ext(name)) {
 		// TODO: text and strings files
 		case ".html", ".txt":
-			if err := extractTemplateMessages(messages, p, opts); err != nil {
-				return err
-			}
+			err = extractTemplateMessages(messages, p, opts)
 		case ".go":
-			if err := extractGoMessages(messages, p, opts); err != nil {
-				return err
+			err = extractGoMessages(messages, p, opts)
+		case ".js":
+			// Skip minified files, they're usually third party
+			// libraries and they lose the translator comments.
+			if strings.HasSuffix(strings.ToLower(name), ".min.js") {
+				continue
 			}
+			err = extractJSMessages(messages, p, opts)
 		case ".po", ".pot":
 			// Do nothing
 		}
+		if err != nil {
+			// Don't let a single file prevent extracting
+			// the messages from the rest of them.
+			log.Errorf("error extracting messages from %s: %s", p, err)
+		}
 	}
 	return nil
 }
@@ -137,7 +169,7 @@ func extractGoMessages(messages messageMap, path string, opts *ExtractOptions) e
 	}
 	if opts != nil {
 		for _, v := range opts.Functions {
-			if v.Template {
+			if v.Template || v.JavaScript {
 				continue
 			}
 			if err := extractGoFunc(messages, fset, f, v); err != nil {
@@ -284,6 +316,7 @@ func extractTemplateMessages(messages messageMap, path string, opts *ExtractOpti
 		}
 	}
 	text := string(b)
+	comments := templateComments(text)
 	treeSet, err := templateutil.Parse(path, text)
 	if err != nil {
 		return err
@@ -292,70 +325,45 @@ func extractTemplateMessages(messages messageMap, path string, opts *ExtractOpti
 		if err := templateutil.ReplaceTranslatableBlocks(v, "t"); err != nil {
 			return err
 		}
-		templateutil.WalkTree(v, func(n, p parse.Node) {
-			var fname string
-			switch n.Type() {
-			case parse.NodeIdentifier:
-				fname = n.(*parse.IdentifierNode).Ident
-			case parse.NodeField:
-				ident := n.(*parse.FieldNode).Ident
-				if len(ident) > 1 {
-					fname = ident[len(ident)-1]
+		tree := v
+		templateutil.WalkTree(tree, func(n, p parse.Node) {
+			if err != nil {
+				return
+			}
+			pipe, ok := n.(*parse.PipeNode)
+			if !ok {
+				return
+			}
+			for ii, cmd := range pipe.Cmds {
+				if len(cmd.Args) == 0 {
+					continue
 				}
-			case parse.NodeVariable:
-				ident := n.(*parse.VariableNode).Ident
-				if len(ident) > 1 {
-					fname = ident[len(ident)-1]
+				f := funcs[templateFuncName(cmd.Args[0])]
+				if f == nil {
+					continue
 				}
-			}
-			if fname != "" {
-				f := funcs[fname]
-				if f != nil {
-					count := 1
-					if f.Context {
-						count++
-					}
-					if f.Plural {
-						count++
-					}
-					cmd := p.(*parse.CommandNode)
-					// First argument is the function name
-					if c := len(cmd.Args) - 1; c != count {
-						log.Debugf("Skipping function %s (%v) - want %d arguments, got %d", f.Name, n.Position(), count, c)
-						return
-					}
-					var s []string
-					for ii := 1; ii < len(cmd.Args); ii++ {
-						if sn, ok := cmd.Args[ii].(*parse.StringNode); ok {
-							s = append(s, sn.Text)
-						} else {
-							log.Debugf("Skipping function %s (%v) - non-string argument at position %d", f.Name, n.Position(), ii)
-							return
-						}
-					}
-					message := &Message{}
-					switch len(s) {
-					case 1:
-						message.Singular = s[0]
-					case 2:
-						if f.Context {
-							message.Context = s[0]
-							message.Singular = s[1]
-						} else {
-							message.Singular = s[0]
-							message.Plural = s[1]
-						}
-					case 3:
-						message.Context = s[0]
-						message.Singular = s[1]
-						message.Plural = s[2]
-					}
-					// TODO: The line number doesn't match exactly because of the
-					// prepended variables
-					pos := templatePosition(path, text, n)
-					if err = messages.Add(message, pos, ""); err != nil {
-						return
+				// First argument is the function name
+				args := cmd.Args[1:len(cmd.Args):len(cmd.Args)]
+				if ii > 0 {
+					// Piped value from the previous command, passed
+					// as the last argument.
+					var piped parse.Node = pipe.Cmds[ii-1]
+					if prev := pipe.Cmds[ii-1]; len(prev.Args) == 1 {
+						piped = prev.Args[0]
 					}
+					args = append(args, piped)
+				}
+				message := templateMessage(f, args, cmd)
+				if message == nil {
+					continue
+				}
+				pos := templatePosition(tree, cmd)
+				comment := comments[pos.Line]
+				if comment == "" {
+					comment = comments[pos.Line-1]
+				}
+				if err = messages.Add(message, pos, comment); err != nil {
+					return
 				}
 			}
 		})
@@ -363,9 +371,93 @@ func extractTemplateMessages(messages messageMap, path string, opts *ExtractOpti
 	return err
 }
 
-func templatePosition(name string, text string, n parse.Node) *token.Position {
-	return &token.Position{
-		Filename: name,
-		Line:     strings.Count(text[:int(n.Position())], "\n") + 1,
+// templateFuncName returns the name of the function called by
+// the given node, which might be an identifier, a field or a
+// variable method.
+func templateFuncName(n parse.Node) string {
+	switch x := n.(type) {
+	case *parse.IdentifierNode:
+		return x.Ident
+	case *parse.FieldNode:
+		if len(x.Ident) > 1 {
+			return x.Ident[len(x.Ident)-1]
+		}
+	case *parse.VariableNode:
+		if len(x.Ident) > 1 {
+			return x.Ident[len(x.Ident)-1]
+		}
+	}
+	return ""
+}
+
+// templateMessage returns the message for a call to the given
+// translation function with the given arguments, or nil if the
+// arguments are not valid.
+func templateMessage(f *Function, args []parse.Node, cmd *parse.CommandNode) *Message {
+	count := 1
+	if f.Context {
+		count++
+	}
+	if f.Plural {
+		count++
+	}
+	want := count
+	if f.Plural {
+		// Plural functions receive the number as the last argument
+		want++
+	}
+	if c := len(args); c != want {
+		log.Debugf("Skipping function %s (%v) - want %d arguments, got %d", f.Name, cmd, want, c)
+		return nil
+	}
+	s := make([]string, count)
+	for ii := range s {
+		sn, ok := args[ii].(*parse.StringNode)
+		if !ok {
+			log.Debugf("Skipping function %s (%v) - non-string argument at position %d", f.Name, cmd, ii+1)
+			return nil
+		}
+		s[ii] = sn.Text
+	}
+	message := &Message{}
+	if f.Context {
+		message.Context = s[0]
+		s = s[1:]
+	}
+	message.Singular = s[0]
+	if f.Plural {
+		message.Plural = s[1]
+	}
+	return message
+}
+
+func templatePosition(tree *parse.Tree, n parse.Node) *token.Position {
+	// location is in the form name:line:col
+	location, _ := tree.ErrorContext(n)
+	pos := &token.Position{Filename: location}
+	if p := strings.LastIndex(location, ":"); p >= 0 {
+		location = location[:p]
+		if p := strings.LastIndex(location, ":"); p >= 0 {
+			pos.Filename = location[:p]
+			pos.Line, _ = strconv.Atoi(location[p+1:])
+		}
+	}
+	return pos
+}
+
+// templateComments returns the translator comments in the given template
+// text, keyed by the line where each comment ends. Template comments use
+// the same rules as Go comments (see translatorComment), e.g.
+//
+//  {{/* Translators: the greeting shown in the header */}}
+//  {{/*/ The greeting shown in the header */}}
+func templateComments(text string) map[int]string {
+	comments := make(map[int]string)
+	for _, m := range templateCommentRe.FindAllStringSubmatchIndex(text, -1) {
+		if comment := translatorComment([]string{text[m[2]:m[3]]}); comment != "" {
+			line := strings.Count(text[:m[1]], "\n") + 1
+			comments[line] = comment
+		}
 	}
+	return comments
 }
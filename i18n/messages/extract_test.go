@@ -5,7 +5,10 @@ import (
 	"flag"
 	"gnd.la/log"
 	"io/ioutil"
+	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"testing"
 )
 
@@ -41,3 +44,40 @@ func TestExtract(t *testing.T) {
 func init() {
 	flag.Parse()
 }
+
+func TestExtractSkipsErrorsAndVendorDirs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "messages")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"good.js":                   `gettext("good");`,
+		"broken.js":                 `gettext("broken"); var s = "unterminated;`,
+		"node_modules/lib/lib.js":   `gettext("node_modules");`,
+		"static/vendor/vendor.js":   `gettext("vendor");`,
+		"static/app/app.js":         `gettext("app");`,
+		"bower_components/bower.js": `gettext("bower");`,
+	}
+	for k, v := range files {
+		p := filepath.Join(dir, filepath.FromSlash(k))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(v), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m, err := Extract(dir, DefaultExtractOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found []string
+	for _, v := range m {
+		found = append(found, v.Singular)
+	}
+	sort.Strings(found)
+	if exp := []string{"app", "good"}; !reflect.DeepEqual(found, exp) {
+		t.Errorf("expecting messages %v, got %v", exp, found)
+	}
+}
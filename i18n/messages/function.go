@@ -5,6 +5,8 @@ type Function struct {
 	Name string
 	// Wheter the function is a template function
 	Template bool
+	// Wheter the function is a JavaScript function
+	JavaScript bool
 	// Wheter the function has a context argument
 	Context bool
 	// Wheter the function has a plural form argument
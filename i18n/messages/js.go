@@ -0,0 +1,357 @@
+package messages
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gnd.la/log"
+)
+
+type jsTokenType int
+
+const (
+	jsIdent jsTokenType = iota + 1
+	jsString
+	jsNumber
+	jsPunct
+	// jsOther represents regexps and template literals
+	jsOther
+)
+
+type jsToken struct {
+	typ   jsTokenType
+	value string
+	line  int
+}
+
+// jsScanner implements a very simple JavaScript tokenizer, which
+// only handles what's required for extracting the translatable
+// strings. It also keeps track of the comments, since they might
+// contain comments for the translators.
+type jsScanner struct {
+	src    string
+	pos    int
+	line   int
+	tokens []*jsToken
+	// comments contains the comment groups, keyed by the line
+	// where they end.
+	comments map[int][]string
+	// lastComment is the line where the last line comment ended
+	lastComment int
+}
+
+func (s *jsScanner) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", s.line, fmt.Sprintf(format, args...))
+}
+
+func (s *jsScanner) advance(n int) string {
+	text := s.src[s.pos : s.pos+n]
+	s.line += strings.Count(text, "\n")
+	s.pos += n
+	return text
+}
+
+func (s *jsScanner) prevAllowsDivision() bool {
+	if len(s.tokens) == 0 {
+		return false
+	}
+	prev := s.tokens[len(s.tokens)-1]
+	switch prev.typ {
+	case jsIdent:
+		switch prev.value {
+		case "return", "typeof", "instanceof", "in", "of", "new", "delete", "void", "throw", "case", "do", "else":
+			return false
+		}
+		return true
+	case jsString, jsNumber, jsOther:
+		return true
+	case jsPunct:
+		return prev.value == ")" || prev.value == "]" || prev.value == "}"
+	}
+	return false
+}
+
+func (s *jsScanner) addComment(startLine int, text string, line bool) {
+	if line && s.lastComment == startLine-1 {
+		// Continues the previous group of line comments
+		texts := s.comments[s.lastComment]
+		delete(s.comments, s.lastComment)
+		s.comments[s.line] = append(texts, text)
+	} else {
+		s.comments[s.line] = []string{text}
+	}
+	if line {
+		s.lastComment = s.line
+	} else {
+		s.lastComment = 0
+	}
+}
+
+// quoted returns the length of the quoted string or template
+// literal at the current position.
+func (s *jsScanner) quoted() (int, error) {
+	q := s.src[s.pos]
+	for ii := s.pos + 1; ii < len(s.src); ii++ {
+		switch s.src[ii] {
+		case '\\':
+			ii++
+		case q:
+			return ii + 1 - s.pos, nil
+		case '\n':
+			if q != '`' {
+				return 0, s.errorf("unterminated string")
+			}
+		}
+	}
+	return 0, s.errorf("unterminated string")
+}
+
+func (s *jsScanner) regexp() (int, error) {
+	class := false
+	for ii := s.pos + 1; ii < len(s.src); ii++ {
+		switch s.src[ii] {
+		case '\\':
+			ii++
+		case '[':
+			class = true
+		case ']':
+			class = false
+		case '/':
+			if !class {
+				// Flags
+				ii++
+				for ii < len(s.src) && isJSIdentByte(s.src[ii]) {
+					ii++
+				}
+				return ii - s.pos, nil
+			}
+		case '\n':
+			return 0, s.errorf("unterminated regular expression")
+		}
+	}
+	return 0, s.errorf("unterminated regular expression")
+}
+
+func isJSIdentByte(b byte) bool {
+	return b == '_' || b == '$' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9') || b >= utf8.RuneSelf
+}
+
+func (s *jsScanner) scan() error {
+	for s.pos < len(s.src) {
+		c := s.src[s.pos]
+		line := s.line
+		switch {
+		case c == '\n' || c == ' ' || c == '\t' || c == '\r':
+			s.advance(1)
+		case strings.HasPrefix(s.src[s.pos:], "//"):
+			end := strings.IndexByte(s.src[s.pos:], '\n')
+			if end < 0 {
+				end = len(s.src) - s.pos
+			}
+			text := s.advance(end)
+			s.addComment(line, text[2:], true)
+		case strings.HasPrefix(s.src[s.pos:], "/*"):
+			end := strings.Index(s.src[s.pos+2:], "*/")
+			if end < 0 {
+				return s.errorf("unterminated comment")
+			}
+			text := s.advance(end + 4)
+			s.addComment(line, text[2:len(text)-2], false)
+		case c == '"' || c == '\'' || c == '`':
+			n, err := s.quoted()
+			if err != nil {
+				return err
+			}
+			text := s.advance(n)
+			typ := jsString
+			if c == '`' && strings.Contains(text, "${") {
+				typ = jsOther
+			}
+			s.tokens = append(s.tokens, &jsToken{typ: typ, value: text, line: line})
+		case c == '/' && !s.prevAllowsDivision():
+			n, err := s.regexp()
+			if err != nil {
+				return err
+			}
+			s.tokens = append(s.tokens, &jsToken{typ: jsOther, value: s.advance(n), line: line})
+		case c >= '0' && c <= '9':
+			n := 1
+			for s.pos+n < len(s.src) && (isJSIdentByte(s.src[s.pos+n]) || s.src[s.pos+n] == '.') {
+				n++
+			}
+			s.tokens = append(s.tokens, &jsToken{typ: jsNumber, value: s.advance(n), line: line})
+		case isJSIdentByte(c):
+			n := 1
+			for s.pos+n < len(s.src) && isJSIdentByte(s.src[s.pos+n]) {
+				n++
+			}
+			s.tokens = append(s.tokens, &jsToken{typ: jsIdent, value: s.advance(n), line: line})
+		default:
+			s.tokens = append(s.tokens, &jsToken{typ: jsPunct, value: s.advance(1), line: line})
+		}
+	}
+	return nil
+}
+
+// unquoteJS returns the value of a JavaScript string literal,
+// including its quotes.
+func unquoteJS(lit string) (string, error) {
+	if len(lit) < 2 {
+		return "", fmt.Errorf("invalid string literal %s", lit)
+	}
+	s := lit[1 : len(lit)-1]
+	var buf bytes.Buffer
+	for ii := 0; ii < len(s); ii++ {
+		c := s[ii]
+		if c != '\\' {
+			buf.WriteByte(c)
+			continue
+		}
+		ii++
+		if ii >= len(s) {
+			return "", fmt.Errorf("invalid string literal %s", lit)
+		}
+		switch e := s[ii]; e {
+		case 'n':
+			buf.WriteByte('\n')
+		case 't':
+			buf.WriteByte('\t')
+		case 'r':
+			buf.WriteByte('\r')
+		case 'b':
+			buf.WriteByte('\b')
+		case 'f':
+			buf.WriteByte('\f')
+		case 'v':
+			buf.WriteByte('\v')
+		case '0':
+			buf.WriteByte(0)
+		case '\n':
+			// Line continuation
+		case 'x', 'u':
+			n := 2
+			if e == 'u' {
+				n = 4
+			}
+			if ii+n >= len(s) {
+				return "", fmt.Errorf("invalid escape sequence in string literal %s", lit)
+			}
+			r, err := strconv.ParseUint(s[ii+1:ii+1+n], 16, 32)
+			if err != nil {
+				return "", fmt.Errorf("invalid escape sequence in string literal %s", lit)
+			}
+			buf.WriteRune(rune(r))
+			ii += n
+		default:
+			buf.WriteByte(e)
+		}
+	}
+	return buf.String(), nil
+}
+
+// jsConcatenatedString returns the string starting at the token with the given
+// index, which might be a concatenation of several literals, and the
+// index of the next token after the string.
+func jsConcatenatedString(tokens []*jsToken, idx int) (string, int, bool) {
+	var parts []string
+	for {
+		if idx >= len(tokens) || tokens[idx].typ != jsString {
+			return "", idx, false
+		}
+		s, err := unquoteJS(tokens[idx].value)
+		if err != nil {
+			return "", idx, false
+		}
+		parts = append(parts, s)
+		idx++
+		if idx >= len(tokens) || tokens[idx].value != "+" {
+			break
+		}
+		idx++
+	}
+	return strings.Join(parts, ""), idx, true
+}
+
+func extractJSMessages(messages messageMap, path string, opts *ExtractOptions) error {
+	log.Debugf("Extracting messages from JavaScript file %s", path)
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	funcs := make(map[string]*Function)
+	if opts != nil {
+		for _, v := range opts.Functions {
+			if v.JavaScript {
+				funcs[v.Name] = v
+			}
+		}
+	}
+	if len(funcs) == 0 {
+		return nil
+	}
+	s := &jsScanner{src: string(b), line: 1, comments: make(map[int][]string)}
+	if err := s.scan(); err != nil {
+		return fmt.Errorf("error parsing JavaScript file %s: %s", path, err)
+	}
+	tokens := s.tokens
+	for ii, tok := range tokens {
+		if tok.typ != jsIdent {
+			continue
+		}
+		f := funcs[tok.value]
+		if f == nil || ii+1 >= len(tokens) || tokens[ii+1].value != "(" {
+			continue
+		}
+		if ii > 0 && tokens[ii-1].value == "function" {
+			// Function declaration
+			continue
+		}
+		count := 1
+		if f.Context {
+			count++
+		}
+		if f.Plural {
+			count++
+		}
+		var args []string
+		next := ii + 2
+		for len(args) < count {
+			if len(args) > 0 {
+				if next >= len(tokens) || tokens[next].value != "," {
+					break
+				}
+				next++
+			}
+			arg, n, ok := jsConcatenatedString(tokens, next)
+			if !ok {
+				break
+			}
+			args = append(args, arg)
+			next = n
+		}
+		if len(args) != count || next >= len(tokens) || (tokens[next].value != "," && tokens[next].value != ")") || args[count-1] == "" {
+			log.Debugf("Skipping function %s (%s:%d) - arguments are not literals", f.Name, path, tok.line)
+			continue
+		}
+		message := &Message{}
+		if f.Context {
+			message.Context = args[0]
+			args = args[1:]
+		}
+		message.Singular = args[0]
+		if f.Plural {
+			message.Plural = args[1]
+		}
+		pos := &token.Position{Filename: path, Line: tok.line}
+		comment := translatorComment(s.comments[tok.line-1])
+		if err := messages.Add(message, pos, comment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -15,6 +15,19 @@ var (
 	newLine = []byte{'\n'}
 )
 
+// potHeader is written at the top of the .pot files. Plural-Forms is
+// not included, since the translation compiler uses the default ones
+// for each language when they're not present.
+const potHeader = `#, fuzzy
+msgid ""
+msgstr ""
+"Project-Id-Version: \n"
+"Language: \n"
+"MIME-Version: 1.0\n"
+"Content-Type: text/plain; charset=UTF-8\n"
+"Content-Transfer-Encoding: 8bit\n"
+`
+
 func writeString(w io.Writer, prefix, str string) error {
 	quoted := fmt.Sprintf("%q", str)
 	if len(quoted)+len(prefix)+2 < maxLineLength {
@@ -104,8 +117,18 @@ func writeSuffixLines(w io.Writer, prefix, suffix, str string) error {
 	return err
 }
 
+// Write writes the given messages as a .pot file, including
+// the header.
 func Write(w io.Writer, messages []*Message) error {
+	if _, err := io.WriteString(w, potHeader); err != nil {
+		return err
+	}
 	for ii, m := range messages {
+		if ii == 0 {
+			if _, err := w.Write(newLine); err != nil {
+				return err
+			}
+		}
 		if m.TranslatorComment != "" {
 			if err := writeLines(w, "# ", m.TranslatorComment); err != nil {
 				return err
@@ -0,0 +1,400 @@
+package table
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PluralForms represents a parsed Plural-Forms header from a
+// .po file, e.g. "nplurals=2; plural=(n != 1);".
+type PluralForms struct {
+	// N is the number of plural forms.
+	N int
+	// Expr is the plural expression, in C syntax.
+	Expr string
+	root node
+}
+
+// Formula returns a Formula which evaluates the
+// plural expression.
+func (p *PluralForms) Formula() Formula {
+	root := p.root
+	return func(n int) int {
+		return root.eval(n)
+	}
+}
+
+// GoCode returns the body of a Go function with the signature
+// func(n int) int which evaluates the plural expression.
+func (p *PluralForms) GoCode() string {
+	return goStmt(p.root)
+}
+
+func (p *PluralForms) String() string {
+	return fmt.Sprintf("nplurals=%d; plural=%s;", p.N, p.Expr)
+}
+
+// ParsePluralForms parses a Plural-Forms header, as found in .po files.
+// Any expression using the operators available in C is supported,
+// including nested conditional expressions.
+func ParsePluralForms(text string) (*PluralForms, error) {
+	form := strings.TrimSpace(strings.ToLower(strings.Replace(text, "\\\n", "", -1)))
+	if !strings.HasPrefix(form, "nplurals") {
+		return nil, fmt.Errorf("invalid Plural-Forms %q, not starting with nplurals=", text)
+	}
+	form = strings.TrimSpace(form[8:])
+	if !strings.HasPrefix(form, "=") {
+		return nil, fmt.Errorf("invalid Plural-Forms %q, not starting with nplurals=", text)
+	}
+	form = form[1:]
+	sep := strings.Index(form, ";")
+	if sep == -1 {
+		return nil, fmt.Errorf("invalid Plural-Forms %q, can't find number of plurals", text)
+	}
+	nplurals, err := strconv.Atoi(strings.TrimSpace(form[:sep]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Plural-Forms %q, error parsing nplurals: %s", text, err)
+	}
+	if nplurals < 1 {
+		return nil, fmt.Errorf("invalid Plural-Forms %q, nplurals must be at least 1", text)
+	}
+	form = strings.TrimSpace(form[sep+1:])
+	if !strings.HasPrefix(form, "plural") {
+		return nil, fmt.Errorf("invalid plural formula %q, not starting with plural=", form)
+	}
+	form = strings.TrimSpace(form[6:])
+	if !strings.HasPrefix(form, "=") {
+		return nil, fmt.Errorf("invalid plural formula %q, not starting with plural=", form)
+	}
+	expr := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(form[1:]), ";"))
+	root, err := parseExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	return &PluralForms{N: nplurals, Expr: expr, root: root}, nil
+}
+
+// ParseFormula parses a Plural-Forms header and returns its Formula.
+// See ParsePluralForms for more details.
+func ParseFormula(text string) (Formula, error) {
+	pf, err := ParsePluralForms(text)
+	if err != nil {
+		return nil, err
+	}
+	return pf.Formula(), nil
+}
+
+// node is a node in the AST of a plural expression
+type node interface {
+	eval(n int) int
+	// isBool returns true iff the node produces a
+	// boolean value in Go.
+	isBool() bool
+	goInt() string
+	goBool() string
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func goIntFromBool(n node) string {
+	return fmt.Sprintf("func() int {\nif %s {\nreturn 1\n}\nreturn 0\n}()", n.goBool())
+}
+
+func goStmt(n node) string {
+	if t, ok := n.(*ternaryNode); ok {
+		return fmt.Sprintf("if %s {\n%s}\n%s", t.cond.goBool(), goStmt(t.a), goStmt(t.b))
+	}
+	if n.isBool() {
+		return fmt.Sprintf("if %s {\nreturn 1\n}\nreturn 0\n", n.goBool())
+	}
+	return fmt.Sprintf("return %s\n", n.goInt())
+}
+
+type varNode struct{}
+
+func (v varNode) eval(n int) int { return n }
+func (v varNode) isBool() bool   { return false }
+func (v varNode) goInt() string  { return "n" }
+func (v varNode) goBool() string { return "(n != 0)" }
+
+type intNode int
+
+func (v intNode) eval(n int) int { return int(v) }
+func (v intNode) isBool() bool   { return false }
+func (v intNode) goInt() string  { return strconv.Itoa(int(v)) }
+func (v intNode) goBool() string { return strconv.FormatBool(v != 0) }
+
+type notNode struct{ x node }
+
+func (v *notNode) eval(n int) int { return boolToInt(v.x.eval(n) == 0) }
+func (v *notNode) isBool() bool   { return true }
+func (v *notNode) goInt() string  { return goIntFromBool(v) }
+func (v *notNode) goBool() string { return "!" + v.x.goBool() }
+
+type negNode struct{ x node }
+
+func (v *negNode) eval(n int) int { return -v.x.eval(n) }
+func (v *negNode) isBool() bool   { return false }
+func (v *negNode) goInt() string  { return "(-" + v.x.goInt() + ")" }
+func (v *negNode) goBool() string { return "(" + v.goInt() + " != 0)" }
+
+type binaryNode struct {
+	op   string
+	a, b node
+}
+
+func (v *binaryNode) eval(n int) int {
+	switch v.op {
+	case "||":
+		return boolToInt(v.a.eval(n) != 0 || v.b.eval(n) != 0)
+	case "&&":
+		return boolToInt(v.a.eval(n) != 0 && v.b.eval(n) != 0)
+	}
+	a, b := v.a.eval(n), v.b.eval(n)
+	switch v.op {
+	case "==":
+		return boolToInt(a == b)
+	case "!=":
+		return boolToInt(a != b)
+	case "<":
+		return boolToInt(a < b)
+	case "<=":
+		return boolToInt(a <= b)
+	case ">":
+		return boolToInt(a > b)
+	case ">=":
+		return boolToInt(a >= b)
+	case "+":
+		return a + b
+	case "-":
+		return a - b
+	case "*":
+		return a * b
+	case "/":
+		if b == 0 {
+			return 0
+		}
+		return a / b
+	case "%":
+		if b == 0 {
+			return 0
+		}
+		return a % b
+	}
+	panic("unreachable")
+}
+
+func (v *binaryNode) isBool() bool {
+	switch v.op {
+	case "+", "-", "*", "/", "%":
+		return false
+	}
+	return true
+}
+
+func (v *binaryNode) goInt() string {
+	if v.isBool() {
+		return goIntFromBool(v)
+	}
+	return "(" + v.a.goInt() + " " + v.op + " " + v.b.goInt() + ")"
+}
+
+func (v *binaryNode) goBool() string {
+	switch v.op {
+	case "||", "&&":
+		return "(" + v.a.goBool() + " " + v.op + " " + v.b.goBool() + ")"
+	case "+", "-", "*", "/", "%":
+		return "(" + v.goInt() + " != 0)"
+	}
+	return "(" + v.a.goInt() + " " + v.op + " " + v.b.goInt() + ")"
+}
+
+type ternaryNode struct {
+	cond node
+	a, b node
+}
+
+func (v *ternaryNode) eval(n int) int {
+	if v.cond.eval(n) != 0 {
+		return v.a.eval(n)
+	}
+	return v.b.eval(n)
+}
+
+func (v *ternaryNode) isBool() bool { return false }
+
+func (v *ternaryNode) goInt() string {
+	return fmt.Sprintf("func() int {\n%s}()", goStmt(v))
+}
+
+func (v *ternaryNode) goBool() string { return "(" + v.goInt() + " != 0)" }
+
+// parser implements a recursive descent parser
+// for C expressions using only n as a variable.
+type parser struct {
+	s   string
+	pos int
+}
+
+func parseExpr(s string) (node, error) {
+	p := &parser{s: s}
+	nd, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos < len(p.s) {
+		return nil, p.errorf("unexpected %q", p.s[p.pos:])
+	}
+	return nd, nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("error parsing plural expression %q at offset %d: %s", p.s, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t' || p.s[p.pos] == '\n' || p.s[p.pos] == '\r') {
+		p.pos++
+	}
+}
+
+// accept consumes the first of the given operators
+// found at the current position and returns it.
+func (p *parser) accept(ops ...string) string {
+	p.skipSpaces()
+	for _, v := range ops {
+		if strings.HasPrefix(p.s[p.pos:], v) {
+			// Don't confuse < with <=, = with ==, etc...
+			next := p.pos + len(v)
+			if len(v) == 1 && next < len(p.s) && p.s[next] == '=' && v != "=" && strings.IndexByte("<>!", v[0]) >= 0 {
+				continue
+			}
+			p.pos = next
+			return v
+		}
+	}
+	return ""
+}
+
+func (p *parser) ternary() (node, error) {
+	cond, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.accept("?") == "" {
+		return cond, nil
+	}
+	a, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	if p.accept(":") == "" {
+		return nil, p.errorf("expecting :")
+	}
+	b, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	return &ternaryNode{cond: cond, a: a, b: b}, nil
+}
+
+func (p *parser) binary(next func() (node, error), ops ...string) (node, error) {
+	a, err := next()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.accept(ops...)
+		if op == "" {
+			return a, nil
+		}
+		b, err := next()
+		if err != nil {
+			return nil, err
+		}
+		a = &binaryNode{op: op, a: a, b: b}
+	}
+}
+
+func (p *parser) or() (node, error) {
+	return p.binary(p.and, "||")
+}
+
+func (p *parser) and() (node, error) {
+	return p.binary(p.equality, "&&")
+}
+
+func (p *parser) equality() (node, error) {
+	return p.binary(p.relational, "==", "!=")
+}
+
+func (p *parser) relational() (node, error) {
+	return p.binary(p.additive, "<=", ">=", "<", ">")
+}
+
+func (p *parser) additive() (node, error) {
+	return p.binary(p.multiplicative, "+", "-")
+}
+
+func (p *parser) multiplicative() (node, error) {
+	return p.binary(p.unary, "*", "/", "%")
+}
+
+func (p *parser) unary() (node, error) {
+	switch p.accept("!", "-") {
+	case "!":
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{x}, nil
+	case "-":
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &negNode{x}, nil
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (node, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.s) {
+		return nil, p.errorf("unexpected end of expression")
+	}
+	c := p.s[p.pos]
+	switch {
+	case c == 'n':
+		p.pos++
+		return varNode{}, nil
+	case c >= '0' && c <= '9':
+		start := p.pos
+		for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+			p.pos++
+		}
+		val, err := strconv.Atoi(p.s[start:p.pos])
+		if err != nil {
+			return nil, p.errorf("invalid number: %s", err)
+		}
+		return intNode(val), nil
+	case c == '(':
+		p.pos++
+		x, err := p.ternary()
+		if err != nil {
+			return nil, err
+		}
+		if p.accept(")") == "" {
+			return nil, p.errorf("expecting )")
+		}
+		return x, nil
+	}
+	return nil, p.errorf("unexpected %q", c)
+}
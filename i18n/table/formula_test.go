@@ -0,0 +1,78 @@
+package table
+
+import (
+	goparser "go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestPluralForms(t *testing.T) {
+	cases := []struct {
+		lang     string
+		nplurals int
+		expect   map[int]int
+	}{
+		{"en", 2, map[int]int{0: 1, 1: 0, 2: 1, 11: 1}},
+		{"fr", 2, map[int]int{0: 0, 1: 0, 2: 1}},
+		{"ja", 1, map[int]int{0: 0, 1: 0, 5: 0}},
+		{"ru", 3, map[int]int{1: 0, 21: 0, 11: 2, 2: 1, 4: 1, 22: 1, 12: 2, 5: 2, 0: 2, 111: 2}},
+		{"pl", 3, map[int]int{1: 0, 21: 2, 2: 1, 24: 1, 12: 2, 5: 2, 0: 2}},
+		{"cs", 3, map[int]int{1: 0, 2: 1, 4: 1, 5: 2, 0: 2}},
+		{"ar", 6, map[int]int{0: 0, 1: 1, 2: 2, 3: 3, 10: 3, 11: 4, 99: 4, 100: 5, 102: 5, 103: 3}},
+		{"es_ES", 2, map[int]int{1: 0, 2: 1}},
+		{"pt-br", 2, map[int]int{0: 0, 1: 0, 2: 1}},
+		{"xx", 2, map[int]int{1: 0, 2: 1}},
+	}
+	for _, v := range cases {
+		pf := LanguagePluralForms(v.lang)
+		if pf.N != v.nplurals {
+			t.Errorf("expecting %d plurals for %s, got %d", v.nplurals, v.lang, pf.N)
+		}
+		formula := pf.Formula()
+		for n, exp := range v.expect {
+			if idx := formula(n); idx != exp {
+				t.Errorf("expecting index %d for n = %d in %s, got %d", exp, n, v.lang, idx)
+			}
+		}
+		code := "package p\nfunc f(n int) int {\n" + pf.GoCode() + "}\n"
+		if _, err := goparser.ParseFile(token.NewFileSet(), "", code, 0); err != nil {
+			t.Errorf("invalid Go code for %s: %s\n%s", v.lang, err, code)
+		}
+	}
+}
+
+func TestParsePluralForms(t *testing.T) {
+	valid := map[string]map[int]int{
+		"nplurals=2; plural=n == 1 ? 0 : 1;":                {1: 0, 2: 1},
+		"nplurals = 2; plural = (n != 1)":                   {1: 0, 0: 1},
+		"nplurals=3; plural=(n==1) ? 0 : ((n>=2) ? 1 : 2);": {1: 0, 3: 1, 0: 2},
+		"nplurals=2; plural=!(n == 1);":                     {1: 0, 7: 1},
+		"nplurals=2; plural=n%2;":                           {2: 0, 3: 1},
+	}
+	for k, v := range valid {
+		formula, err := ParseFormula(k)
+		if err != nil {
+			t.Errorf("error parsing %q: %s", k, err)
+			continue
+		}
+		for n, exp := range v {
+			if idx := formula(n); idx != exp {
+				t.Errorf("expecting index %d for n = %d in %q, got %d", exp, n, k, idx)
+			}
+		}
+	}
+	invalid := []string{
+		"",
+		"plural=n != 1;",
+		"nplurals=x; plural=n != 1;",
+		"nplurals=2; plural=n != ;",
+		"nplurals=2; plural=(n != 1;",
+		"nplurals=2; plural=n ? 1;",
+		"nplurals=2; plural=m != 1;",
+	}
+	for _, v := range invalid {
+		if _, err := ParsePluralForms(v); err == nil {
+			t.Errorf("expecting an error parsing %q", v)
+		}
+	}
+}
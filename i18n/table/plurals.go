@@ -0,0 +1,103 @@
+package table
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	// pluralForms contains the Plural-Forms for the most
+	// common languages, as used by GNU gettext.
+	pluralForms = map[string]string{
+		// One form
+		"ja": "nplurals=1; plural=0;",
+		"ko": "nplurals=1; plural=0;",
+		"zh": "nplurals=1; plural=0;",
+		"vi": "nplurals=1; plural=0;",
+		"th": "nplurals=1; plural=0;",
+		"id": "nplurals=1; plural=0;",
+		// Two forms, singular used for one only
+		"en": "nplurals=2; plural=(n != 1);",
+		"de": "nplurals=2; plural=(n != 1);",
+		"nl": "nplurals=2; plural=(n != 1);",
+		"sv": "nplurals=2; plural=(n != 1);",
+		"da": "nplurals=2; plural=(n != 1);",
+		"no": "nplurals=2; plural=(n != 1);",
+		"nb": "nplurals=2; plural=(n != 1);",
+		"fi": "nplurals=2; plural=(n != 1);",
+		"et": "nplurals=2; plural=(n != 1);",
+		"es": "nplurals=2; plural=(n != 1);",
+		"it": "nplurals=2; plural=(n != 1);",
+		"pt": "nplurals=2; plural=(n != 1);",
+		"ca": "nplurals=2; plural=(n != 1);",
+		"el": "nplurals=2; plural=(n != 1);",
+		"hu": "nplurals=2; plural=(n != 1);",
+		"bg": "nplurals=2; plural=(n != 1);",
+		"he": "nplurals=2; plural=(n != 1);",
+		"tr": "nplurals=2; plural=(n > 1);",
+		// Two forms, singular used for zero and one
+		"fr":    "nplurals=2; plural=(n > 1);",
+		"pt_BR": "nplurals=2; plural=(n > 1);",
+		// Three forms
+		"ru": "nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+		"uk": "nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+		"be": "nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+		"sr": "nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+		"hr": "nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+		"pl": "nplurals=3; plural=(n==1 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+		"cs": "nplurals=3; plural=(n==1) ? 0 : (n>=2 && n<=4) ? 1 : 2;",
+		"sk": "nplurals=3; plural=(n==1) ? 0 : (n>=2 && n<=4) ? 1 : 2;",
+		"ro": "nplurals=3; plural=(n==1 ? 0 : (n==0 || (n%100 > 0 && n%100 < 20)) ? 1 : 2);",
+		"lt": "nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && (n%100<10 || n%100>=20) ? 1 : 2);",
+		"lv": "nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n != 0 ? 1 : 2);",
+		// Four forms
+		"sl": "nplurals=4; plural=(n%100==1 ? 0 : n%100==2 ? 1 : n%100==3 || n%100==4 ? 2 : 3);",
+		// Five forms
+		"ga": "nplurals=5; plural=(n==1 ? 0 : n==2 ? 1 : n<7 ? 2 : n<11 ? 3 : 4);",
+		// Six forms
+		"ar": "nplurals=6; plural=(n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : n%100>=3 && n%100<=10 ? 3 : n%100>=11 ? 4 : 5);",
+	}
+	parsedPluralForms struct {
+		sync.RWMutex
+		forms map[string]*PluralForms
+	}
+)
+
+// LanguagePluralForms returns the default Plural-Forms for the given
+// language, which might be either a language code like "ru" or a language
+// and country code like "pt_BR". If there are no known Plural-Forms for the
+// language, the ones used for English are returned.
+func LanguagePluralForms(lang string) *PluralForms {
+	lang = strings.Replace(lang, "-", "_", -1)
+	if len(lang) == 5 {
+		lang = strings.ToLower(lang[:2]) + "_" + strings.ToUpper(lang[3:])
+	} else {
+		lang = strings.ToLower(lang)
+	}
+	key := lang
+	if _, ok := pluralForms[key]; !ok {
+		if len(key) > 2 {
+			key = key[:2]
+		}
+		if _, ok := pluralForms[key]; !ok {
+			key = "en"
+		}
+	}
+	parsedPluralForms.RLock()
+	pf := parsedPluralForms.forms[key]
+	parsedPluralForms.RUnlock()
+	if pf == nil {
+		var err error
+		pf, err = ParsePluralForms(pluralForms[key])
+		if err != nil {
+			panic(err)
+		}
+		parsedPluralForms.Lock()
+		if parsedPluralForms.forms == nil {
+			parsedPluralForms.forms = make(map[string]*PluralForms)
+		}
+		parsedPluralForms.forms[key] = pf
+		parsedPluralForms.Unlock()
+	}
+	return pf
+}
@@ -141,7 +141,7 @@ func getSkippingCache(key string) *Table {
 			t.formula = d.formula
 		}
 		if t.formula == nil {
-			t.formula = defaultFormula
+			t.formula = LanguagePluralForms(key).Formula()
 		}
 		mu.Lock()
 		decoded[key] = t
@@ -151,8 +151,9 @@ func getSkippingCache(key string) *Table {
 	return nil
 }
 
+// defaultFormula is the formula used for English
 func defaultFormula(n int) int {
-	if n != 0 {
+	if n != 1 {
 		return 1
 	}
 	return 0
@@ -8,6 +8,8 @@ import (
 	"strings"
 )
 
+// Formula is a function which returns the index of the
+// plural form which should be used for the number n.
 type Formula func(n int) int
 
 type Table struct {
@@ -26,8 +28,8 @@ func (t *Table) Singular(ctx string, msg string) string {
 func (t *Table) Plural(ctx string, singular string, plural string, n int) string {
 	k := Key(ctx, singular, plural)
 	if tr := t.translations[k]; tr != nil {
-		ii := t.formula(n)
-		if ii < len(tr) {
+		ii := t.PluralIndex(n)
+		if ii >= 0 && ii < len(tr) && tr[ii] != "" {
 			return tr[ii]
 		}
 	}
@@ -37,6 +39,15 @@ func (t *Table) Plural(ctx string, singular string, plural string, n int) string
 	return plural
 }
 
+// PluralIndex returns the index of the plural form which
+// should be used for the number n, using the table formula.
+func (t *Table) PluralIndex(n int) int {
+	if t.formula == nil {
+		return defaultFormula(n)
+	}
+	return t.formula(n)
+}
+
 func (t *Table) Encode() (string, error) {
 	var buf bytes.Buffer
 	w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
@@ -0,0 +1,107 @@
+package i18n
+
+import (
+	"sort"
+)
+
+// Translatable stores a value in several languages, using the language
+// code as the key. The empty key holds the default value, which is used
+// when there's no value for the requested language. Translatable can be
+// used as a model field, stored in a JSON column. e.g.
+//
+//  type Article struct {
+//	Id    int64            `orm:",primary_key,auto_increment"`
+//	Title i18n.Translatable `orm:",codec=json"`
+//  }
+//
+// Then, use Value to retrieve the value for the current language:
+//
+//  title := article.Title.Value(ctx)
+//
+// In templates executed by gnd.la/app, use the translated function:
+//
+//  {{ translated .Title }}
+//
+// Use the untranslated command in gnd.la/commands to list entries with
+// missing translations.
+type Translatable map[string]string
+
+// Value returns the value for the language returned by lang. If there's
+// no value for the language and country (e.g. "es_MX"), the value for the
+// language (e.g. "es") is returned. If there's no value for the language
+// either, the default value is returned.
+func (t Translatable) Value(lang Languager) string {
+	if lang != nil {
+		if code := lang.Language(); code != "" {
+			if v, ok := t[code]; ok && v != "" {
+				return v
+			}
+			if len(code) > 2 {
+				if v, ok := t[code[:2]]; ok && v != "" {
+					return v
+				}
+			}
+		}
+	}
+	return t[""]
+}
+
+// Default returns the default value.
+func (t Translatable) Default() string {
+	return t[""]
+}
+
+// Set sets the value for the given language. If lang is empty,
+// the default value is set. Setting an empty value removes the
+// value for the language.
+func (t Translatable) Set(lang string, value string) {
+	if value == "" {
+		delete(t, lang)
+		return
+	}
+	t[lang] = value
+}
+
+// Has returns true iff there's a non-empty value for the
+// given language. Note that this function doesn't perform
+// any fallback, so it will return false for "es_MX" if there's
+// only a value for "es".
+func (t Translatable) Has(lang string) bool {
+	return t[lang] != ""
+}
+
+// Languages returns the languages with a value, sorted
+// alphabetically. The default value is not included.
+func (t Translatable) Languages() []string {
+	var langs []string
+	for k, v := range t {
+		if k != "" && v != "" {
+			langs = append(langs, k)
+		}
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// Missing returns the languages from the given ones which
+// don't have a value. Values for a language without country
+// are used as fallbacks, so "es_MX" is not missing if there's
+// a value for "es".
+func (t Translatable) Missing(languages ...string) []string {
+	var missing []string
+	for _, v := range languages {
+		if t.Has(v) {
+			continue
+		}
+		if len(v) > 2 && t.Has(v[:2]) {
+			continue
+		}
+		missing = append(missing, v)
+	}
+	return missing
+}
+
+// String returns the default value.
+func (t Translatable) String() string {
+	return t.Default()
+}
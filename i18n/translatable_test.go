@@ -0,0 +1,34 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTranslatable(t *testing.T) {
+	tr := Translatable{}
+	tr.Set("", "Color")
+	tr.Set("en_GB", "Colour")
+	tr.Set("es", "Color")
+	tr.Set("fr", "Couleur")
+	tr.Set("fr", "")
+	cases := map[string]string{
+		"":      "Color",
+		"en":    "Color",
+		"en_GB": "Colour",
+		"es_MX": "Color",
+		"fr":    "Color",
+	}
+	for k, v := range cases {
+		if s := tr.Value(testLanguage(k)); s != v {
+			t.Errorf("expecting %q for %q, got %q", v, k, s)
+		}
+	}
+	if langs := tr.Languages(); !reflect.DeepEqual(langs, []string{"en_GB", "es"}) {
+		t.Errorf("unexpected languages %v", langs)
+	}
+	missing := tr.Missing("en_GB", "es_AR", "fr", "pt_BR")
+	if !reflect.DeepEqual(missing, []string{"fr", "pt_BR"}) {
+		t.Errorf("unexpected missing languages %v", missing)
+	}
+}
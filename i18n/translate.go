@@ -1,5 +1,9 @@
 package i18n
 
+import (
+	"gnd.la/i18n/table"
+)
+
 // T returns the given string translated into the language
 // returned by lang.
 func T(lang Languager, str string) string {
@@ -15,6 +19,22 @@ func Tn(lang Languager, singular string, plural string, n int) string {
 	return Tnc(lang, "", singular, plural, n)
 }
 
+// PluralIndex returns the index of the plural form which should be used
+// for the number n in the language returned by lang. The index is
+// determined by the Plural-Forms in the translation table for the language
+// or, if there's no table, by the default Plural-Forms for the language
+// (see gnd.la/i18n/table.LanguagePluralForms).
+func PluralIndex(lang Languager, n int) int {
+	if translations := getTable(lang); translations != nil {
+		return translations.PluralIndex(n)
+	}
+	code := "en"
+	if lang != nil {
+		code = lang.Language()
+	}
+	return table.LanguagePluralForms(code).Formula()(n)
+}
+
 // Tc works like T, but accepts an additional context argument, to allow
 // differentiating strings with the same singular form but different
 // translation depending on the context.
@@ -1,8 +1,182 @@
 package runtimeutil
 
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// debugFileEnvVar is the environment variable which might be used
+// to indicate the path to the detached debug file for the running
+// binary. See debugFileName.
+const debugFileEnvVar = "GONDOLA_DEBUG_FILE"
+
 type debugFile interface {
 	Symtab() ([]byte, error)
 	Pclntab() ([]byte, error)
 	TextAddr() uint64
+	// DebugLink returns the file name and the CRC32 stored in the
+	// .gnu_debuglink section, if any. See objcopy --add-gnu-debuglink.
+	DebugLink() (string, uint32)
 	Close() error
 }
+
+var debugFilePath struct {
+	sync.Once
+	path string
+}
+
+// debugFileName returns the path to the file which should be used for reading
+// the symbol tables of the running binary. If the binary has been stripped,
+// it looks for a detached debug file, which should be a copy of the binary
+// before stripping it, in the following locations:
+//
+//  - The path in the GONDOLA_DEBUG_FILE environment variable.
+//  - The file indicated by the .gnu_debuglink section (added with
+//    objcopy --add-gnu-debuglink), in the binary directory, its .debug
+//    subdirectory and /usr/lib/debug, as gdb does. Its CRC32 must match.
+//  - {binary}.debug, {dir}/.debug/{binary}.debug and /usr/lib/debug/{binary}.debug.
+//
+// If no suitable file is found, the path to the running binary is returned.
+// The result is cached after the first call.
+func debugFileName() string {
+	debugFilePath.Do(func() {
+		debugFilePath.path = findDebugFile(os.Args[0])
+	})
+	return debugFilePath.path
+}
+
+func findDebugFile(binary string) string {
+	if abs, err := filepath.Abs(binary); err == nil {
+		binary = abs
+	}
+	f, err := openDebugPath(binary)
+	if err != nil {
+		return binary
+	}
+	defer f.Close()
+	if hasTables(f) {
+		return binary
+	}
+	if p := os.Getenv(debugFileEnvVar); p != "" && isDebugFileFor(p, f, 0) {
+		return p
+	}
+	dir, base := filepath.Split(binary)
+	if link, crc := f.DebugLink(); link != "" {
+		for _, v := range debugFileDirs(dir) {
+			if p := filepath.Join(v, link); isDebugFileFor(p, f, crc) {
+				return p
+			}
+		}
+	}
+	for _, v := range debugFileDirs(dir) {
+		if p := filepath.Join(v, base+".debug"); isDebugFileFor(p, f, 0) {
+			return p
+		}
+	}
+	return binary
+}
+
+// debugFileDirs returns the directories where detached debug
+// files are searched for a binary in the given directory.
+func debugFileDirs(dir string) []string {
+	return []string{
+		dir,
+		filepath.Join(dir, ".debug"),
+		filepath.Join("/usr/lib/debug", dir),
+	}
+}
+
+// isDebugFileFor returns true iff p is a valid debug file for
+// the binary bin. If crc is non-zero, the file CRC32 must match
+// it. Otherwise, the text address of both files must match.
+func isDebugFileFor(p string, bin debugFile, crc uint32) bool {
+	if crc != 0 && fileCRC(p) != crc {
+		return false
+	}
+	f, err := openDebugPath(p)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	return hasTables(f) && (crc != 0 || f.TextAddr() == bin.TextAddr())
+}
+
+// hasTables returns true iff f includes a pclntab with actual
+// data. The symtab is not checked, since Go 1.3 and later always
+// produce an empty one (all the symbol information is in the pclntab).
+func hasTables(f debugFile) bool {
+	pclntab, err := f.Pclntab()
+	if err != nil || len(pclntab) == 0 {
+		return false
+	}
+	// Sections without data (e.g. SHT_NOBITS in ELF)
+	// are returned as zeroes.
+	header := pclntab
+	if len(header) > 8 {
+		header = header[:8]
+	}
+	return !bytes.Equal(header, make([]byte, len(header)))
+}
+
+func fileCRC(p string) uint32 {
+	f, err := os.Open(p)
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+	h := crc32.NewIEEE()
+	if _, err := io.Copy(h, f); err != nil {
+		return 0
+	}
+	return h.Sum32()
+}
+
+func openDebugPath(p string) (debugFile, error) {
+	fp, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	f, err := openDebugFile(fp)
+	if err != nil {
+		fp.Close()
+		return nil, err
+	}
+	return &closingFile{debugFile: f, fp: fp}, nil
+}
+
+// closingFile closes the underlying *os.File
+// when the debugFile is closed.
+type closingFile struct {
+	debugFile
+	fp *os.File
+}
+
+func (f *closingFile) Close() error {
+	err := f.debugFile.Close()
+	if ferr := f.fp.Close(); err == nil {
+		err = ferr
+	}
+	return err
+}
+
+// parseDebugLink parses the contents of a .gnu_debuglink section,
+// which contains a NUL terminated file name, padded to 4 bytes,
+// followed by the CRC32 of the debug file.
+func parseDebugLink(data []byte, order binary.ByteOrder) (string, uint32, error) {
+	nul := bytes.IndexByte(data, 0)
+	if nul <= 0 {
+		return "", 0, errors.New("invalid debug link")
+	}
+	name := string(data[:nul])
+	pos := (nul + 4) &^ 3
+	if pos+4 > len(data) {
+		return "", 0, errors.New("debug link has no CRC")
+	}
+	return name, order.Uint32(data[pos:]), nil
+}
@@ -30,6 +30,12 @@ func (f *file) TextAddr() uint64 {
 	return f.Section("__text").Addr
 }
 
+func (f *file) DebugLink() (string, uint32) {
+	// Mach-O has no debug links, dSYM bundles
+	// don't include the Go symbol tables.
+	return "", 0
+}
+
 func openDebugFile(r io.ReaderAt) (debugFile, error) {
 	f, err := macho.NewFile(r)
 	if err != nil {
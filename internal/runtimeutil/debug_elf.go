@@ -17,6 +17,11 @@ func (f *file) section(name string) ([]byte, error) {
 	if s == nil {
 		return nil, fmt.Errorf("no section name %q", name)
 	}
+	if s.Type == elf.SHT_NOBITS {
+		// Detached debug files produced by objcopy
+		// --only-keep-debug don't include the data.
+		return nil, fmt.Errorf("section %q has no data", name)
+	}
 	return s.Data()
 }
 
@@ -32,6 +37,18 @@ func (f *file) TextAddr() uint64 {
 	return f.Section(".text").Addr
 }
 
+func (f *file) DebugLink() (string, uint32) {
+	data, err := f.section(".gnu_debuglink")
+	if err != nil {
+		return "", 0
+	}
+	name, crc, err := parseDebugLink(data, f.ByteOrder)
+	if err != nil {
+		return "", 0
+	}
+	return name, crc
+}
+
 func openDebugFile(r io.ReaderAt) (debugFile, error) {
 	f, err := elf.NewFile(r)
 	if err != nil {
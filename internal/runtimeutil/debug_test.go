@@ -0,0 +1,84 @@
+package runtimeutil
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type testDebugFile struct {
+	symtab  []byte
+	pclntab []byte
+	err     error
+}
+
+func (f *testDebugFile) Symtab() ([]byte, error)     { return f.symtab, f.err }
+func (f *testDebugFile) Pclntab() ([]byte, error)    { return f.pclntab, f.err }
+func (f *testDebugFile) TextAddr() uint64            { return 0 }
+func (f *testDebugFile) DebugLink() (string, uint32) { return "", 0 }
+func (f *testDebugFile) Close() error                { return nil }
+
+func TestHasTables(t *testing.T) {
+	pclntab := []byte{0xf1, 0xff, 0xff, 0xff, 0, 0, 1, 8, 0, 0}
+	cases := []struct {
+		file   *testDebugFile
+		expect bool
+	}{
+		{&testDebugFile{pclntab: pclntab}, true},
+		{&testDebugFile{symtab: []byte{1}, pclntab: pclntab}, true},
+		{&testDebugFile{}, false},
+		{&testDebugFile{pclntab: make([]byte, len(pclntab))}, false},
+		{&testDebugFile{pclntab: pclntab, err: errors.New("no section")}, false},
+	}
+	for _, v := range cases {
+		if h := hasTables(v.file); h != v.expect {
+			t.Errorf("expecting hasTables() = %v with symtab %v and pclntab %v, got %v", v.expect, v.file.symtab, v.file.pclntab, h)
+		}
+	}
+}
+
+func TestRunningBinaryTables(t *testing.T) {
+	binary, err := filepath.Abs(os.Args[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := openDebugPath(binary)
+	if err != nil {
+		t.Skipf("can't open running binary: %s", err)
+	}
+	defer f.Close()
+	if !hasTables(f) {
+		t.Fatal("running binary has no tables")
+	}
+	if p := findDebugFile(binary); p != binary {
+		t.Errorf("expecting debug file %q, got %q", binary, p)
+	}
+	if !isDebugFileFor(binary, f, 0) {
+		t.Error("binary is not a debug file for itself")
+	}
+	if !isDebugFileFor(binary, f, fileCRC(binary)) {
+		t.Error("binary is not a debug file for itself with matching CRC")
+	}
+	if _, err := makeTable(f); err != nil {
+		t.Errorf("error making table: %s", err)
+	}
+}
+
+func TestParseDebugLink(t *testing.T) {
+	data := append([]byte("foo.debug\x00\x00\x00"), 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(data[12:], 0xdeadbeef)
+	name, crc, err := parseDebugLink(data, binary.LittleEndian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "foo.debug" || crc != 0xdeadbeef {
+		t.Errorf("expecting foo.debug and 0xdeadbeef, got %q and %#x", name, crc)
+	}
+	for _, v := range [][]byte{nil, []byte("\x00\x00\x00\x00"), []byte("foo.debug\x00\x00\x00")} {
+		if _, _, err := parseDebugLink(v, binary.LittleEndian); err == nil {
+			t.Errorf("expecting an error parsing debug link %q", v)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package runtimeutil
+
+import (
+	"debug/pe"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+type file struct {
+	*pe.File
+}
+
+// symbol returns the symbol with the given name. On Windows,
+// the symtab and pclntab aren't stored in their own sections,
+// so they need to be found using the symbols which mark their
+// start and end.
+func (f *file) symbol(name string) (*pe.Symbol, error) {
+	for _, s := range f.Symbols {
+		if s.Name != name {
+			continue
+		}
+		if s.SectionNumber <= 0 || int(s.SectionNumber) > len(f.Sections) {
+			return nil, fmt.Errorf("symbol %s has invalid section number %d", name, s.SectionNumber)
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("no symbol named %q", name)
+}
+
+func (f *file) table(start string, end string) ([]byte, error) {
+	ssym, err := f.symbol(start)
+	if err != nil {
+		return nil, err
+	}
+	esym, err := f.symbol(end)
+	if err != nil {
+		return nil, err
+	}
+	if ssym.SectionNumber != esym.SectionNumber {
+		return nil, fmt.Errorf("symbols %s and %s are in different sections", start, end)
+	}
+	data, err := f.Sections[ssym.SectionNumber-1].Data()
+	if err != nil {
+		return nil, err
+	}
+	if ssym.Value > esym.Value || int(esym.Value) > len(data) {
+		return nil, fmt.Errorf("invalid bounds for %s (%d-%d)", start, ssym.Value, esym.Value)
+	}
+	return data[ssym.Value:esym.Value], nil
+}
+
+// goTable returns the table delimited by the given symbols,
+// trying also the names used by Go 1.3 and earlier.
+func (f *file) goTable(name string) ([]byte, error) {
+	data, err := f.table("runtime."+name, "runtime.e"+name)
+	if err != nil {
+		if data, err2 := f.table(name, "e"+name); err2 == nil {
+			return data, nil
+		}
+	}
+	return data, err
+}
+
+func (f *file) Symtab() ([]byte, error) {
+	data, err := f.goTable("symtab")
+	if err != nil {
+		// Newer linkers don't emit the symtab symbols, since
+		// the symbols can be read from the pclntab alone.
+		if _, serr := f.symbol("runtime.pclntab"); serr == nil {
+			return nil, nil
+		}
+	}
+	return data, err
+}
+
+func (f *file) Pclntab() ([]byte, error) {
+	return f.goTable("pclntab")
+}
+
+func (f *file) TextAddr() uint64 {
+	var base uint64
+	switch oh := f.OptionalHeader.(type) {
+	case *pe.OptionalHeader32:
+		base = uint64(oh.ImageBase)
+	case *pe.OptionalHeader64:
+		base = oh.ImageBase
+	}
+	if s := f.Section(".text"); s != nil {
+		return base + uint64(s.VirtualAddress)
+	}
+	return base
+}
+
+func (f *file) DebugLink() (string, uint32) {
+	// objcopy from binutils might also add a
+	// .gnu_debuglink section to PE binaries.
+	s := f.Section(".gnu_debuglink")
+	if s == nil {
+		return "", 0
+	}
+	data, err := s.Data()
+	if err != nil {
+		return "", 0
+	}
+	name, crc, err := parseDebugLink(data, binary.LittleEndian)
+	if err != nil {
+		return "", 0
+	}
+	return name, crc
+}
+
+func openDebugFile(r io.ReaderAt) (debugFile, error) {
+	f, err := pe.NewFile(r)
+	if err != nil {
+		return nil, err
+	}
+	return &file{f}, nil
+}
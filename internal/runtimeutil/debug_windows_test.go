@@ -0,0 +1,67 @@
+package runtimeutil
+
+import (
+	"debug/pe"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPESymbols(t *testing.T) {
+	f := &file{&pe.File{
+		Sections: []*pe.Section{&pe.Section{}, &pe.Section{}},
+		Symbols: []*pe.Symbol{
+			{Name: "runtime.symtab", SectionNumber: 1},
+			{Name: "runtime.esymtab", SectionNumber: 2},
+			{Name: "runtime.pclntab", SectionNumber: 0},
+			{Name: "runtime.epclntab", SectionNumber: 3},
+		},
+	}}
+	if s, err := f.symbol("runtime.symtab"); err != nil || s.SectionNumber != 1 {
+		t.Errorf("expecting symbol in section 1, got %v (err %v)", s, err)
+	}
+	for _, v := range []string{"runtime.pclntab", "runtime.epclntab", "missing"} {
+		if _, err := f.symbol(v); err == nil {
+			t.Errorf("expecting an error looking up symbol %s", v)
+		}
+	}
+	if _, err := f.table("runtime.symtab", "runtime.esymtab"); err == nil {
+		t.Error("expecting an error with table bounds in different sections")
+	}
+	if _, err := f.Pclntab(); err == nil {
+		t.Error("expecting an error with invalid pclntab symbols")
+	}
+}
+
+func TestPERunningBinary(t *testing.T) {
+	binary, err := filepath.Abs(os.Args[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := os.Open(binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	df, err := openDebugFile(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := df.(*file)
+	if pclntab, err := f.Pclntab(); err != nil || len(pclntab) == 0 {
+		t.Errorf("expecting pclntab, got %d bytes (err %v)", len(pclntab), err)
+	}
+	if _, err := f.Symtab(); err != nil {
+		t.Errorf("error reading symtab: %s", err)
+	}
+	text := f.Section(".text")
+	if text == nil {
+		t.Fatal("binary has no .text section")
+	}
+	if addr := f.TextAddr(); addr <= uint64(text.VirtualAddress) {
+		t.Errorf("expecting text address above the image base, got %#x", addr)
+	}
+	if link, _ := f.DebugLink(); link != "" {
+		t.Errorf("expecting no debug link, got %q", link)
+	}
+}
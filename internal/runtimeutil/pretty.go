@@ -1,11 +1,10 @@
-// +build !windows,!appengine
+// +build !appengine
 
 package runtimeutil
 
 import (
 	"debug/gosym"
 	"fmt"
-	"os"
 	"reflect"
 	"runtime"
 	"sort"
@@ -27,10 +26,9 @@ func (s syms) Swap(i, j int) {
 }
 
 func makeTable(f debugFile) (*gosym.Table, error) {
-	symdat, err := f.Symtab()
-	if err != nil {
-		return nil, err
-	}
+	// The symtab is empty or missing in recent Go versions
+	// and the pclntab is enough for building the table.
+	symdat, _ := f.Symtab()
 	pclndat, err := f.Pclntab()
 	if err != nil {
 		return nil, err
@@ -66,12 +64,7 @@ func prettyStack(lines []string, _html bool) (ret []string) {
 	if len(lines) <= 1 {
 		return lines
 	}
-	fp, err := os.Open(os.Args[0])
-	if err != nil {
-		return lines
-	}
-	defer fp.Close()
-	f, err := openDebugFile(fp)
+	f, err := openDebugPath(debugFileName())
 	if err != nil {
 		return lines
 	}
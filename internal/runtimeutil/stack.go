@@ -0,0 +1,85 @@
+package runtimeutil
+
+import (
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Frame represents a function call in a goroutine stack.
+type Frame struct {
+	// PC is the return address of the call.
+	PC uintptr
+	// Function is the fully qualified name of the function.
+	Function string
+	// File and Line indicate the location of the call.
+	File string
+	Line int
+}
+
+// Location returns the frame location, formatted as
+// in the values returned by FormatCaller.
+func (f *Frame) Location() string {
+	return fmt.Sprintf("%s, line %d", f.File, f.Line)
+}
+
+// IsStd returns true iff the frame corresponds to a function
+// in the Go standard library or the runtime.
+func (f *Frame) IsStd() bool {
+	root := filepath.ToSlash(runtime.GOROOT())
+	return root != "" && strings.HasPrefix(filepath.ToSlash(f.File), root+"/")
+}
+
+// SourceHTML returns the source around the frame location using
+// FormatSourceHTML, with count lines before and after it.
+func (f *Frame) SourceHTML(count int) (template.HTML, error) {
+	return FormatSourceHTML(f.File, f.Line, count, true, true)
+}
+
+// Stack returns the frames in the current goroutine stack. The skip
+// argument indicates how many frames should be omitted, with 0
+// identifying the caller of Stack.
+func Stack(skip int) []*Frame {
+	pcs := make([]uintptr, 64)
+	for {
+		// Skip runtime.Callers and Stack
+		n := runtime.Callers(skip+2, pcs)
+		if n < len(pcs) {
+			pcs = pcs[:n]
+			break
+		}
+		pcs = make([]uintptr, len(pcs)*2)
+	}
+	frames := make([]*Frame, 0, len(pcs))
+	for _, pc := range pcs {
+		// pc is the return address, use the call
+		// instruction for finding the function and line.
+		fn := runtime.FuncForPC(pc - 1)
+		if fn == nil {
+			continue
+		}
+		file, line := fn.FileLine(pc - 1)
+		frames = append(frames, &Frame{
+			PC:       pc,
+			Function: fn.Name(),
+			File:     file,
+			Line:     line,
+		})
+	}
+	return frames
+}
+
+// FormatGoroutines returns the stacks of all the running
+// goroutines, as formatted by runtime.Stack.
+func FormatGoroutines() string {
+	buf := make([]byte, 64*1024)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return string(buf[:n])
+		}
+		buf = make([]byte, len(buf)*2)
+	}
+}
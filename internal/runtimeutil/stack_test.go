@@ -0,0 +1,46 @@
+package runtimeutil
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	frames := Stack(0)
+	if len(frames) < 2 {
+		t.Fatalf("expecting at least 2 frames, got %d", len(frames))
+	}
+	f := frames[0]
+	if !strings.HasSuffix(f.Function, ".TestStack") || filepath.Base(f.File) != "stack_test.go" {
+		t.Errorf("expecting TestStack in stack_test.go as first frame, got %s in %s", f.Function, f.File)
+	}
+	if f.IsStd() {
+		t.Errorf("%s is not in the standard library", f.Function)
+	}
+	if loc := f.Location(); !strings.HasSuffix(loc, "stack_test.go, line 10") {
+		t.Errorf("unexpected location %q", loc)
+	}
+	if code, err := f.SourceHTML(1); err != nil || !strings.Contains(string(code), "Stack") {
+		t.Errorf("unexpected source %q (err %v)", code, err)
+	}
+	caller := frames[1]
+	if caller.Function != "testing.tRunner" || !caller.IsStd() {
+		t.Errorf("expecting testing.tRunner from the standard library as second frame, got %s in %s", caller.Function, caller.File)
+	}
+	if skipped := Stack(1); skipped[0].Function != caller.Function {
+		t.Errorf("expecting %s as first frame when skipping 1, got %s", caller.Function, skipped[0].Function)
+	}
+}
+
+func TestFormatGoroutines(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		<-done
+	}()
+	s := FormatGoroutines()
+	if strings.Count(s, "goroutine ") < 2 || !strings.Contains(s, "TestFormatGoroutines") {
+		t.Errorf("unexpected goroutines %q", s)
+	}
+}
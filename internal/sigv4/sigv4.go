@@ -0,0 +1,185 @@
+// Package sigv4 implements the AWS Signature Version 4 signing
+// process, which is used for authenticating the requests to AWS
+// and compatible APIs (e.g. S3, SES).
+package sigv4
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Algorithm is the algorithm name included in the
+	// Authorization header of the signed requests.
+	Algorithm = "AWS4-HMAC-SHA256"
+	// UnsignedPayload might be used as the payload hash
+	// for the requests with unsigned bodies (only S3).
+	UnsignedPayload = "UNSIGNED-PAYLOAD"
+	// EmptyPayload is the payload hash for an empty body.
+	EmptyPayload = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	timeFormat = "20060102T150405Z"
+	dateFormat = "20060102"
+)
+
+// Signer signs requests for the given service and region
+// using the provided credentials.
+type Signer struct {
+	// Region is the region used for signing (e.g. us-east-1).
+	Region string
+	// Service is the service name used for signing (e.g. s3).
+	Service   string
+	AccessKey string
+	SecretKey string
+	// SessionToken is only required when using temporary
+	// credentials.
+	SessionToken string
+}
+
+// PayloadHash returns the hex encoded SHA256 of the given
+// data, to be used as the payload hash of a request.
+func PayloadHash(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// EncodeURI encodes s as required by the signature, escaping
+// all the characters but the unreserved ones. If path is
+// true, slashes are not escaped.
+func EncodeURI(s string, path bool) string {
+	var buf []byte
+	for ii := 0; ii < len(s); ii++ {
+		c := s[ii]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (path && c == '/') {
+			buf = append(buf, c)
+			continue
+		}
+		buf = append(buf, '%', "0123456789ABCDEF"[c>>4], "0123456789ABCDEF"[c&15])
+	}
+	return string(buf)
+}
+
+// CanonicalQuery returns the query string for the given
+// values, sorted and encoded as required by the signature.
+func CanonicalQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var params []string
+	for _, k := range keys {
+		vals := append([]string(nil), values[k]...)
+		sort.Strings(vals)
+		for _, v := range vals {
+			params = append(params, EncodeURI(k, false)+"="+EncodeURI(v, false))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// canonicalHeaders returns the canonical headers and the signed
+// headers list. All the headers set in the request are signed.
+func canonicalHeaders(req *http.Request) (string, string) {
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		values := make([]string, len(v))
+		for ii, s := range v {
+			values[ii] = strings.Join(strings.Fields(s), " ")
+		}
+		headers[strings.ToLower(k)] = strings.Join(values, ",")
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var buf []byte
+	for _, v := range names {
+		buf = append(buf, v...)
+		buf = append(buf, ':')
+		buf = append(buf, headers[v]...)
+		buf = append(buf, '\n')
+	}
+	return string(buf), strings.Join(names, ";")
+}
+
+func canonicalPath(u *url.URL) string {
+	if u.Path == "" {
+		return "/"
+	}
+	return EncodeURI(u.Path, true)
+}
+
+func (s *Signer) scope(t time.Time) string {
+	return t.Format(dateFormat) + "/" + s.Region + "/" + s.Service + "/aws4_request"
+}
+
+func (s *Signer) signature(t time.Time, canonical string) string {
+	toSign := Algorithm + "\n" + t.Format(timeFormat) + "\n" + s.scope(t) + "\n" + PayloadHash([]byte(canonical))
+	key := hmacSHA256([]byte("AWS4"+s.SecretKey), t.Format(dateFormat))
+	key = hmacSHA256(key, s.Region)
+	key = hmacSHA256(key, s.Service)
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, toSign))
+}
+
+// Sign adds the Authorization header to the request, using the
+// given payload hash (see PayloadHash). All the headers set in
+// the request at this point are signed, so any additional ones
+// (e.g. X-Amz-Content-Sha256 for S3) must be set before calling
+// Sign.
+func (s *Signer) Sign(req *http.Request, payload string, t time.Time) {
+	t = t.UTC()
+	req.Header.Set("X-Amz-Date", t.Format(timeFormat))
+	if s.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.SessionToken)
+	}
+	headers, signed := canonicalHeaders(req)
+	canonical := req.Method + "\n" +
+		canonicalPath(req.URL) + "\n" +
+		CanonicalQuery(req.URL.Query()) + "\n" +
+		headers + "\n" +
+		signed + "\n" +
+		payload
+	sig := s.signature(t, canonical)
+	req.Header.Set("Authorization", Algorithm+" Credential="+s.AccessKey+"/"+s.scope(t)+
+		", SignedHeaders="+signed+", Signature="+sig)
+}
+
+// Presign returns the given request URL with the signature
+// in the query string, valid for the given duration.
+func (s *Signer) Presign(req *http.Request, expiry time.Duration, t time.Time) string {
+	t = t.UTC()
+	q := req.URL.Query()
+	q.Set("X-Amz-Algorithm", Algorithm)
+	q.Set("X-Amz-Credential", s.AccessKey+"/"+s.scope(t))
+	q.Set("X-Amz-Date", t.Format(timeFormat))
+	q.Set("X-Amz-Expires", strconv.Itoa(int(expiry/time.Second)))
+	q.Set("X-Amz-SignedHeaders", "host")
+	if s.SessionToken != "" {
+		q.Set("X-Amz-Security-Token", s.SessionToken)
+	}
+	req.URL.RawQuery = CanonicalQuery(q)
+	canonical := req.Method + "\n" +
+		canonicalPath(req.URL) + "\n" +
+		req.URL.RawQuery + "\n" +
+		"host:" + req.URL.Host + "\n\n" +
+		"host\n" +
+		UnsignedPayload
+	return req.URL.String() + "&X-Amz-Signature=" + s.signature(t, canonical)
+}
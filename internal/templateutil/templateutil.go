@@ -156,7 +156,10 @@ func Parse(name string, text string) (map[string]*parse.Tree, error) {
 				continue
 			}
 			if m := varNotDefinedRe.FindStringSubmatch(err.Error()); m != nil {
-				prepend := fmt.Sprintf("{{ $%s := . }}\n", m[1])
+				// Don't add a newline, so line numbers
+				// in the parsed trees match the original
+				// text.
+				prepend := fmt.Sprintf("{{ $%s := . }}", m[1])
 				text = prepend + defineRe.ReplaceAllString(text, "$0"+strings.Replace(prepend, "$", "$$", -1))
 				continue
 			}
@@ -0,0 +1,302 @@
+package loaders
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkgs.com/vfs.v1"
+)
+
+type archiveEntry struct {
+	info os.FileInfo
+	// open returns a reader for the uncompressed
+	// file data. It's nil for directories.
+	open func() (io.ReadCloser, error)
+	data []byte
+}
+
+type dirInfo struct {
+	name    string
+	modTime time.Time
+}
+
+func (d *dirInfo) Name() string       { return d.name }
+func (d *dirInfo) Size() int64        { return 0 }
+func (d *dirInfo) Mode() os.FileMode  { return os.ModeDir | 0755 }
+func (d *dirInfo) ModTime() time.Time { return d.modTime }
+func (d *dirInfo) IsDir() bool        { return true }
+func (d *dirInfo) Sys() interface{}   { return nil }
+
+// archiveLoader implements a read-only loader which keeps an index
+// of the files in an archive and decompresses each file the first time
+// it's opened.
+type archiveLoader struct {
+	name     string
+	closer   io.Closer
+	mu       sync.Mutex
+	entries  map[string]*archiveEntry
+	children map[string][]string
+}
+
+func newArchiveLoader(name string) *archiveLoader {
+	a := &archiveLoader{
+		name:     name,
+		entries:  make(map[string]*archiveEntry),
+		children: make(map[string][]string),
+	}
+	a.entries[""] = &archiveEntry{info: &dirInfo{name: "/"}}
+	return a
+}
+
+func (a *archiveLoader) add(name string, info os.FileInfo, open func() (io.ReadCloser, error)) {
+	name = cleanPath(name)
+	if name == "" {
+		return
+	}
+	if info.IsDir() {
+		open = nil
+	}
+	if e := a.entries[name]; e != nil {
+		// Directory already created implicitly
+		e.info = info
+		e.open = open
+		return
+	}
+	a.entries[name] = &archiveEntry{info: info, open: open}
+	// Create any missing parent directories
+	for {
+		parent := path.Dir(name)
+		if parent == "." {
+			parent = ""
+		}
+		a.children[parent] = append(a.children[parent], name)
+		if a.entries[parent] != nil {
+			break
+		}
+		a.entries[parent] = &archiveEntry{info: &dirInfo{name: path.Base(parent), modTime: info.ModTime()}}
+		name = parent
+	}
+}
+
+func (a *archiveLoader) entry(op string, p string) (*archiveEntry, error) {
+	if e := a.entries[cleanPath(p)]; e != nil {
+		return e, nil
+	}
+	return nil, &os.PathError{Op: op, Path: p, Err: os.ErrNotExist}
+}
+
+func (a *archiveLoader) Open(p string) (vfs.RFile, error) {
+	e, err := a.entry("open", p)
+	if err != nil {
+		return nil, err
+	}
+	if e.open == nil {
+		return nil, &os.PathError{Op: "open", Path: p, Err: fmt.Errorf("%s is a directory", p)}
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if e.data == nil {
+		r, err := e.open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return nil, err
+		}
+		e.data = data
+	}
+	return &memFile{Reader: bytes.NewReader(e.data)}, nil
+}
+
+func (a *archiveLoader) OpenFile(p string, flag int, perm os.FileMode) (vfs.WFile, error) {
+	if isWrite(flag) {
+		return nil, readOnlyError("open", p)
+	}
+	f, err := a.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	return &readOnlyFile{f}, nil
+}
+
+func (a *archiveLoader) Lstat(p string) (os.FileInfo, error) {
+	return a.Stat(p)
+}
+
+func (a *archiveLoader) Stat(p string) (os.FileInfo, error) {
+	e, err := a.entry("stat", p)
+	if err != nil {
+		return nil, err
+	}
+	return e.info, nil
+}
+
+func (a *archiveLoader) ReadDir(p string) ([]os.FileInfo, error) {
+	e, err := a.entry("readdir", p)
+	if err != nil {
+		return nil, err
+	}
+	if e.open != nil {
+		return nil, &os.PathError{Op: "readdir", Path: p, Err: fmt.Errorf("%s is not a directory", p)}
+	}
+	names := a.children[cleanPath(p)]
+	infos := make([]os.FileInfo, len(names))
+	for ii, v := range names {
+		infos[ii] = a.entries[v].info
+	}
+	sort.Sort(byName(infos))
+	return infos, nil
+}
+
+func (a *archiveLoader) Mkdir(p string, perm os.FileMode) error {
+	return readOnlyError("mkdir", p)
+}
+
+func (a *archiveLoader) Remove(p string) error {
+	return readOnlyError("remove", p)
+}
+
+func (a *archiveLoader) IsStatic() bool {
+	return true
+}
+
+// Close closes the underlying archive file, if the loader
+// was created with Archive.
+func (a *archiveLoader) Close() error {
+	if a.closer != nil {
+		return a.closer.Close()
+	}
+	return nil
+}
+
+func (a *archiveLoader) String() string {
+	return a.name
+}
+
+// Zip returns a read-only loader which serves the files in the
+// zip archive read from r, which must have the given size. Only the
+// archive index is read by Zip, while each file is decompressed the
+// first time it's opened and then kept in memory.
+func Zip(r io.ReaderAt, size int64) (vfs.VFS, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	a := newArchiveLoader("zip")
+	for _, v := range zr.File {
+		a.add(v.Name, v.FileInfo(), v.Open)
+	}
+	return a, nil
+}
+
+// TarGzip returns a read-only loader which serves the files in the
+// tar.gz archive read from r, which must have the given size. The
+// archive is read once by TarGzip to build its index, without keeping
+// the file contents. Then, each file is decompressed the first time it's
+// opened and kept in memory.
+func TarGzip(r io.ReaderAt, size int64) (vfs.VFS, error) {
+	tr, closer, err := openTarGzip(r, size)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	a := newArchiveLoader("tar.gz")
+	for ii := 0; ; ii++ {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if info := hdr.FileInfo(); !info.Mode().IsRegular() && !info.IsDir() {
+			continue
+		}
+		pos := ii
+		a.add(hdr.Name, hdr.FileInfo(), func() (io.ReadCloser, error) {
+			return openTarEntry(r, size, pos)
+		})
+	}
+	return a, nil
+}
+
+// Archive opens the archive at the given filename and returns a loader
+// for it, using Zip or TarGzip depending on the file extension, which must
+// be either .zip, .tar.gz or .tgz. The archive file is kept open until
+// the returned loader is closed (it implements io.Closer).
+func Archive(filename string) (vfs.VFS, error) {
+	var open func(io.ReaderAt, int64) (vfs.VFS, error)
+	lower := strings.ToLower(filename)
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		open = Zip
+	case strings.HasSuffix(lower, ".tar.gz") || strings.HasSuffix(lower, ".tgz"):
+		open = TarGzip
+	default:
+		return nil, fmt.Errorf("unknown archive type for %s", filename)
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	st, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	fs, err := open(f, st.Size())
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	a := fs.(*archiveLoader)
+	a.name = filename
+	a.closer = f
+	return a, nil
+}
+
+func openTarGzip(r io.ReaderAt, size int64) (*tar.Reader, io.Closer, error) {
+	gr, err := gzip.NewReader(io.NewSectionReader(r, 0, size))
+	if err != nil {
+		return nil, nil, err
+	}
+	return tar.NewReader(gr), gr, nil
+}
+
+type tarEntryReader struct {
+	io.Reader
+	io.Closer
+}
+
+// openTarEntry returns a reader for the entry at the given
+// position in the archive. Since gzip streams can't be seeked,
+// the archive must be decompressed up to the entry.
+func openTarEntry(r io.ReaderAt, size int64, pos int) (io.ReadCloser, error) {
+	tr, closer, err := openTarGzip(r, size)
+	if err != nil {
+		return nil, err
+	}
+	for ii := 0; ii <= pos; ii++ {
+		if _, err := tr.Next(); err != nil {
+			closer.Close()
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+	return &tarEntryReader{Reader: tr, Closer: closer}, nil
+}
@@ -0,0 +1,126 @@
+package loaders
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkgs.com/vfs.v1"
+)
+
+var archiveFiles = map[string]string{
+	"a.html":       "A",
+	"sub/b.html":   "B",
+	"sub/c/d.html": "D",
+}
+
+func makeZip(t *testing.T) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for k, v := range archiveFiles {
+		f, err := w.Create(k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Write([]byte(v))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func makeTarGzip(t *testing.T) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	w := tar.NewWriter(gw)
+	w.WriteHeader(&tar.Header{Name: "sub/", Typeflag: tar.TypeDir, Mode: 0755})
+	for k, v := range archiveFiles {
+		if err := w.WriteHeader(&tar.Header{Name: k, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(v))}); err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(v))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func testArchive(t *testing.T, fs vfs.VFS) {
+	for k, v := range archiveFiles {
+		// Read twice, to test the cached data
+		for ii := 0; ii < 2; ii++ {
+			data, err := vfs.ReadFile(fs, "/"+k)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			if string(data) != v {
+				t.Errorf("expecting %q for %s, got %q", v, k, string(data))
+			}
+		}
+	}
+	infos, err := fs.ReadDir("/sub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 2 || infos[0].Name() != "b.html" || !infos[1].IsDir() {
+		t.Errorf("unexpected entries in /sub: %v", infos)
+	}
+	if _, err := fs.Stat("/nonexistent"); !os.IsNotExist(err) {
+		t.Errorf("expecting not exist error, got %v", err)
+	}
+	if _, err := fs.Open("/sub"); err == nil {
+		t.Error("expecting an error opening a directory")
+	}
+}
+
+func TestZip(t *testing.T) {
+	data := makeZip(t)
+	fs, err := Zip(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testArchive(t, fs)
+}
+
+func TestTarGzip(t *testing.T) {
+	data := makeTarGzip(t)
+	fs, err := TarGzip(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testArchive(t, fs)
+}
+
+func TestArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "loaders-archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "files.tgz")
+	if err := ioutil.WriteFile(filename, makeTarGzip(t), 0644); err != nil {
+		t.Fatal(err)
+	}
+	fs, err := Archive(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.(interface {
+		Close() error
+	}).Close()
+	testArchive(t, fs)
+	if _, err := Archive(filepath.Join(dir, "files.rar")); err == nil {
+		t.Error("expecting an error with an unknown archive type")
+	}
+}
@@ -0,0 +1,160 @@
+package loaders
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"gopkgs.com/vfs.v1"
+)
+
+type chainLoader struct {
+	loaders []vfs.VFS
+}
+
+// Chain returns a loader which tries each one of the given loaders in
+// order when loading a file, returning the first one found. Operations
+// which modify the filesystem (creating files or directories and removing
+// files) are routed to the first loader which is writable. This allows
+// overriding some of the templates or assets provided by e.g. a reusable
+// app by providing them in the primary loader, while inheriting the rest
+// from the fallbacks.
+func Chain(primary vfs.VFS, fallback ...vfs.VFS) vfs.VFS {
+	return &chainLoader{loaders: append([]vfs.VFS{primary}, fallback...)}
+}
+
+func (c *chainLoader) Open(p string) (vfs.RFile, error) {
+	var first error
+	for _, v := range c.loaders {
+		f, err := v.Open(p)
+		if err == nil {
+			return f, nil
+		}
+		if first == nil {
+			first = err
+		}
+	}
+	return nil, first
+}
+
+func (c *chainLoader) OpenFile(p string, flag int, perm os.FileMode) (vfs.WFile, error) {
+	if !isWrite(flag) {
+		var first error
+		for _, v := range c.loaders {
+			f, err := v.OpenFile(p, flag, perm)
+			if err == nil {
+				return f, nil
+			}
+			if first == nil {
+				first = err
+			}
+		}
+		return nil, first
+	}
+	var f vfs.WFile
+	err := c.write("open", p, func(fs vfs.VFS) (err error) {
+		f, err = fs.OpenFile(p, flag, perm)
+		return err
+	})
+	return f, err
+}
+
+func (c *chainLoader) Lstat(p string) (os.FileInfo, error) {
+	return c.stat(p, vfs.VFS.Lstat)
+}
+
+func (c *chainLoader) Stat(p string) (os.FileInfo, error) {
+	return c.stat(p, vfs.VFS.Stat)
+}
+
+func (c *chainLoader) stat(p string, f func(vfs.VFS, string) (os.FileInfo, error)) (os.FileInfo, error) {
+	var first error
+	for _, v := range c.loaders {
+		info, err := f(v, p)
+		if err == nil {
+			return info, nil
+		}
+		if first == nil {
+			first = err
+		}
+	}
+	return nil, first
+}
+
+// ReadDir returns the entries of the given directory in all the
+// loaders. When an entry is present in several loaders, the first
+// one takes precedence.
+func (c *chainLoader) ReadDir(p string) ([]os.FileInfo, error) {
+	var first error
+	var infos []os.FileInfo
+	found := false
+	seen := make(map[string]bool)
+	for _, v := range c.loaders {
+		entries, err := v.ReadDir(p)
+		if err != nil {
+			if first == nil {
+				first = err
+			}
+			continue
+		}
+		found = true
+		for _, e := range entries {
+			if !seen[e.Name()] {
+				seen[e.Name()] = true
+				infos = append(infos, e)
+			}
+		}
+	}
+	if !found {
+		return nil, first
+	}
+	sort.Sort(byName(infos))
+	return infos, nil
+}
+
+func (c *chainLoader) Mkdir(p string, perm os.FileMode) error {
+	return c.write("mkdir", p, func(fs vfs.VFS) error {
+		return fs.Mkdir(p, perm)
+	})
+}
+
+func (c *chainLoader) Remove(p string) error {
+	return c.write("remove", p, func(fs vfs.VFS) error {
+		return fs.Remove(p)
+	})
+}
+
+// write calls f with the first loader which doesn't
+// return an error indicating that it's read-only.
+func (c *chainLoader) write(op string, p string, f func(vfs.VFS) error) error {
+	for _, v := range c.loaders {
+		if err := f(v); !isReadOnlyError(err) {
+			return err
+		}
+	}
+	return readOnlyError(op, p)
+}
+
+// IsStatic returns true iff all the loaders in the chain are static.
+func (c *chainLoader) IsStatic() bool {
+	for _, v := range c.loaders {
+		if !IsStatic(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chainLoader) String() string {
+	names := make([]string, len(c.loaders))
+	for ii, v := range c.loaders {
+		names[ii] = v.String()
+	}
+	return "chain(" + strings.Join(names, ", ") + ")"
+}
+
+type byName []os.FileInfo
+
+func (b byName) Len() int           { return len(b) }
+func (b byName) Less(i, j int) bool { return b[i].Name() < b[j].Name() }
+func (b byName) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
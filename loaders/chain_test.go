@@ -0,0 +1,54 @@
+package loaders
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"gopkgs.com/vfs.v1"
+)
+
+func TestChain(t *testing.T) {
+	primary, err := vfs.Map(map[string]*vfs.File{
+		"a.html": &vfs.File{Data: []byte("local A")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fallback := FS(fstest.MapFS{
+		"a.html": &fstest.MapFile{Data: []byte("A")},
+		"b.html": &fstest.MapFile{Data: []byte("B")},
+	})
+	// Put the read-only loader first, to check that
+	// writes are routed to the writable one.
+	chain := Chain(fallback, primary)
+	overlay := Chain(primary, fallback)
+	for k, v := range map[string]string{"a.html": "local A", "b.html": "B"} {
+		data, err := vfs.ReadFile(overlay, k)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if string(data) != v {
+			t.Errorf("expecting %q for %s, got %q", v, k, string(data))
+		}
+	}
+	infos, err := overlay.ReadDir("/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 2 {
+		t.Errorf("expecting 2 entries, got %d", len(infos))
+	}
+	if err := vfs.WriteFile(chain, "c.html", []byte("C"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := vfs.ReadFile(primary, "c.html"); err != nil || string(data) != "C" {
+		t.Errorf("expecting c.html in the writable loader, got %q (err %v)", string(data), err)
+	}
+	if IsStatic(chain) {
+		t.Error("chain with non-static loaders should not be static")
+	}
+	if err := vfs.WriteFile(Chain(fallback), "c.html", nil, 0644); err == nil {
+		t.Error("expecting an error when writing to a read-only chain")
+	}
+}
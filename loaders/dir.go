@@ -0,0 +1,60 @@
+package loaders
+
+import (
+	"io"
+	"sync"
+
+	"gnd.la/log"
+
+	"gopkgs.com/vfs.v1"
+)
+
+type dirLoader struct {
+	vfs.VFS
+	dir      string
+	mu       sync.Mutex
+	watchers []io.Closer
+}
+
+// Dir returns a loader for the files in the given directory. It works
+// like vfs.FS, but the returned loader also implements Watchable. Changes
+// are detected using the OS notification mechanism when it's available,
+// falling back to polling the directory every DefaultPollInterval.
+// Use Close to stop watching the directory.
+func Dir(dir string) (vfs.VFS, error) {
+	fs, err := vfs.FS(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &dirLoader{VFS: fs, dir: dir}, nil
+}
+
+func (d *dirLoader) Watch(fn func(name string, op Op)) error {
+	var w io.Closer
+	nw, err := newNotifyWatcher(d.dir, fn)
+	if err == nil {
+		w = nw
+	} else {
+		log.Debugf("can't watch %s for changes (%s), polling every %s", d.dir, err, DefaultPollInterval)
+		w = newPollWatcher(d.VFS, DefaultPollInterval, fn)
+	}
+	d.mu.Lock()
+	d.watchers = append(d.watchers, w)
+	d.mu.Unlock()
+	return nil
+}
+
+// Close stops all the watchers started with Watch.
+func (d *dirLoader) Close() error {
+	d.mu.Lock()
+	watchers := d.watchers
+	d.watchers = nil
+	d.mu.Unlock()
+	var err error
+	for _, v := range watchers {
+		if cerr := v.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
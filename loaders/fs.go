@@ -0,0 +1,103 @@
+package loaders
+
+import (
+	"bytes"
+	"io/fs"
+	"io/ioutil"
+	"os"
+
+	"gopkgs.com/vfs.v1"
+)
+
+type fsLoader struct {
+	fsys fs.FS
+}
+
+// FS returns a read-only loader backed by the given fs.FS. Its main
+// purpose is compiling templates and assets into the binary using
+// go:embed, e.g.
+//
+//  //go:embed tmpl
+//  var tmplFS embed.FS
+//
+//  sub, _ := fs.Sub(tmplFS, "tmpl")
+//  App.SetTemplatesFS(loaders.FS(sub))
+//
+// The returned loader is Static, so templates loaded from it are
+// always cached.
+func FS(fsys fs.FS) vfs.VFS {
+	return &fsLoader{fsys: fsys}
+}
+
+func (l *fsLoader) name(p string) string {
+	if name := cleanPath(p); name != "" {
+		return name
+	}
+	return "."
+}
+
+func (l *fsLoader) Open(p string) (vfs.RFile, error) {
+	f, err := l.fsys.Open(l.name(p))
+	if err != nil {
+		return nil, err
+	}
+	if rf, ok := f.(vfs.RFile); ok {
+		return rf, nil
+	}
+	// File doesn't support seeking, read it into memory
+	data, err := ioutil.ReadAll(f)
+	f.Close()
+	if err != nil {
+		return nil, err
+	}
+	return &memFile{Reader: bytes.NewReader(data)}, nil
+}
+
+func (l *fsLoader) OpenFile(p string, flag int, perm os.FileMode) (vfs.WFile, error) {
+	if isWrite(flag) {
+		return nil, readOnlyError("open", p)
+	}
+	f, err := l.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	return &readOnlyFile{f}, nil
+}
+
+func (l *fsLoader) Lstat(p string) (os.FileInfo, error) {
+	return l.Stat(p)
+}
+
+func (l *fsLoader) Stat(p string) (os.FileInfo, error) {
+	return fs.Stat(l.fsys, l.name(p))
+}
+
+func (l *fsLoader) ReadDir(p string) ([]os.FileInfo, error) {
+	entries, err := fs.ReadDir(l.fsys, l.name(p))
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]os.FileInfo, len(entries))
+	for ii, v := range entries {
+		if infos[ii], err = v.Info(); err != nil {
+			return nil, err
+		}
+	}
+	return infos, nil
+}
+
+func (l *fsLoader) Mkdir(p string, perm os.FileMode) error {
+	return readOnlyError("mkdir", p)
+}
+
+func (l *fsLoader) Remove(p string) error {
+	return readOnlyError("remove", p)
+}
+
+func (l *fsLoader) IsStatic() bool {
+	return true
+}
+
+func (l *fsLoader) String() string {
+	return "io/fs loader"
+}
@@ -0,0 +1,43 @@
+package loaders
+
+import (
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"gopkgs.com/vfs.v1"
+)
+
+func TestFS(t *testing.T) {
+	fs := FS(fstest.MapFS{
+		"a.html":       &fstest.MapFile{Data: []byte("A")},
+		"sub/b.html":   &fstest.MapFile{Data: []byte("B")},
+		"sub/c/d.html": &fstest.MapFile{Data: []byte("D")},
+	})
+	for k, v := range map[string]string{"/a.html": "A", "sub/b.html": "B", "/sub/c/../c/d.html": "D"} {
+		data, err := vfs.ReadFile(fs, k)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if string(data) != v {
+			t.Errorf("expecting %q for %s, got %q", v, k, string(data))
+		}
+	}
+	infos, err := fs.ReadDir("/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 2 {
+		t.Errorf("expecting 2 entries in root, got %d", len(infos))
+	}
+	if st, err := fs.Stat("/sub"); err != nil || !st.IsDir() {
+		t.Errorf("expecting /sub to be a directory, got %v (err %v)", st, err)
+	}
+	if _, err := fs.OpenFile("/a.html", os.O_WRONLY|os.O_TRUNC, 0644); err == nil {
+		t.Error("expecting an error when opening a file for writing")
+	}
+	if !IsStatic(fs) {
+		t.Error("io/fs loader should be static")
+	}
+}
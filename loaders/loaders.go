@@ -0,0 +1,83 @@
+// Package loaders implements additional virtual filesystems which
+// can be used for loading templates and assets.
+//
+// All the loaders in this package implement the vfs.VFS interface
+// from gopkgs.com/vfs.v1, so they might be passed to e.g.
+// gnd.la/app.App.SetTemplatesFS or gnd.la/template/assets.New like
+// any other vfs.VFS.
+package loaders
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path"
+	"strings"
+
+	"gopkgs.com/vfs.v1"
+)
+
+var (
+	// ErrReadOnly is returned when trying to modify
+	// a read-only loader.
+	ErrReadOnly = errors.New("read-only loader")
+)
+
+// Static is implemented by loaders which might indicate that their
+// contents never change (e.g. files embedded into the binary). Templates
+// loaded from a static loader are always cached, even in TemplateDebug
+// mode.
+type Static interface {
+	IsStatic() bool
+}
+
+// IsStatic returns true iff fs implements Static and
+// its contents never change.
+func IsStatic(fs vfs.VFS) bool {
+	st, ok := fs.(Static)
+	return ok && st.IsStatic()
+}
+
+func readOnlyError(op string, p string) error {
+	return &os.PathError{Op: op, Path: p, Err: ErrReadOnly}
+}
+
+// isReadOnlyError returns true iff err indicates that
+// a filesystem can't be written to.
+func isReadOnlyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if pe, ok := err.(*os.PathError); ok {
+		err = pe.Err
+	}
+	return err == ErrReadOnly || os.IsPermission(err)
+}
+
+func isWrite(flag int) bool {
+	return flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_APPEND) != 0
+}
+
+// cleanPath returns p cleaned and without the leading slash,
+// which is the format used by io/fs and archives.
+func cleanPath(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
+
+type memFile struct {
+	*bytes.Reader
+}
+
+func (f *memFile) Close() error {
+	return nil
+}
+
+// readOnlyFile wraps a vfs.RFile to implement
+// vfs.WFile, returning an error on writes.
+type readOnlyFile struct {
+	vfs.RFile
+}
+
+func (f *readOnlyFile) Write(p []byte) (int, error) {
+	return 0, ErrReadOnly
+}
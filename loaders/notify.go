@@ -0,0 +1,91 @@
+// +build !appengine
+
+package loaders
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.google.com/p/go.exp/fsnotify"
+)
+
+type notifyWatcher struct {
+	dir     string
+	watcher *fsnotify.Watcher
+	fn      func(name string, op Op)
+}
+
+func newNotifyWatcher(dir string, fn func(name string, op Op)) (*notifyWatcher, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	w := &notifyWatcher{dir: dir, watcher: watcher, fn: fn}
+	// Notifications are not recursive in
+	// all platforms, watch every directory.
+	if err := w.watchTree(dir); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+	go w.run()
+	return w, nil
+}
+
+func (w *notifyWatcher) watchTree(root string) error {
+	return filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return w.watcher.Watch(p)
+		}
+		return nil
+	})
+}
+
+func (w *notifyWatcher) run() {
+	for {
+		select {
+		case ev, ok := <-w.watcher.Event:
+			if !ok {
+				return
+			}
+			w.event(ev)
+		case _, ok := <-w.watcher.Error:
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+func (w *notifyWatcher) event(ev *fsnotify.FileEvent) {
+	var op Op
+	switch {
+	case ev.IsCreate():
+		if info, err := os.Stat(ev.Name); err == nil && info.IsDir() {
+			w.watchTree(ev.Name)
+			return
+		}
+		op = Create
+	case ev.IsModify():
+		op = Write
+	case ev.IsDelete() || ev.IsRename():
+		op = Remove
+	default:
+		return
+	}
+	rel, err := filepath.Rel(w.dir, ev.Name)
+	if err != nil {
+		return
+	}
+	w.fn("/"+filepath.ToSlash(rel), op)
+}
+
+func (w *notifyWatcher) Close() error {
+	return w.watcher.Close()
+}
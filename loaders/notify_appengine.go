@@ -0,0 +1,12 @@
+// +build appengine
+
+package loaders
+
+import (
+	"errors"
+	"io"
+)
+
+func newNotifyWatcher(dir string, fn func(name string, op Op)) (io.Closer, error) {
+	return nil, errors.New("change notifications are not supported on App Engine")
+}
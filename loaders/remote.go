@@ -0,0 +1,353 @@
+package loaders
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"gnd.la/internal/sigv4"
+
+	"gopkgs.com/vfs.v1"
+)
+
+// RemoteOptions specify the options for Remote. All
+// of them are optional.
+type RemoteOptions struct {
+	// CacheDir is the directory where the fetched files are cached.
+	// If empty, a directory inside os.TempDir() is used.
+	CacheDir string
+	// MaxAge is the time a cached file is used without revalidating
+	// it with the server. If zero, files are revalidated (using ETag
+	// and Last-Modified) every time they're opened.
+	MaxAge time.Duration
+	// Client is the HTTP client used for fetching the files. If
+	// nil, http.DefaultClient is used.
+	Client *http.Client
+	// Header includes any additional headers which should be
+	// sent with the requests (e.g. Authorization).
+	Header http.Header
+	// AccessKey, SecretKey and SessionToken are the AWS
+	// credentials used for signing the requests to s3 URLs.
+	// If AccessKey and SecretKey are empty, they're read
+	// from the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+	// AWS_SESSION_TOKEN environment variables. If there are
+	// no credentials, requests are not signed.
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+}
+
+type remoteMeta struct {
+	ETag         string    `json:"etag"`
+	LastModified string    `json:"last_modified"`
+	Size         int64     `json:"size"`
+	Fetched      time.Time `json:"fetched"`
+}
+
+type remoteInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+func (r *remoteInfo) Name() string       { return r.name }
+func (r *remoteInfo) Size() int64        { return r.size }
+func (r *remoteInfo) Mode() os.FileMode  { return 0444 }
+func (r *remoteInfo) ModTime() time.Time { return r.modTime }
+func (r *remoteInfo) IsDir() bool        { return false }
+func (r *remoteInfo) Sys() interface{}   { return nil }
+
+type remoteLoader struct {
+	base   *url.URL
+	opts   RemoteOptions
+	client *http.Client
+	signer *sigv4.Signer
+	// mu protects metas. Note that it's not held
+	// while fetching files, so the cached files
+	// are always written atomically.
+	mu    sync.Mutex
+	metas map[string]*remoteMeta
+}
+
+// Remote returns a read-only loader which fetches its files from the given
+// base URL, which might use the http, https or s3 schemes. S3 URLs take the
+// form s3://bucket/prefix and they're fetched from the bucket HTTPS endpoint.
+// Requests to S3 are signed (using AWS Signature Version 4) with the credentials
+// in RemoteOptions or the environment. If there are none, the bucket must be
+// public. Append ?region=name to use a region other than us-east-1.
+//
+// Fetched files are cached on disk and revalidated using their ETag and
+// Last-Modified headers, which is also used as their modification time.
+// If the server can't be reached, the cached version is used. Note that
+// since HTTP doesn't provide directory listings, ReadDir is not supported.
+func Remote(baseURL string, opts *RemoteOptions) (vfs.VFS, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	r := &remoteLoader{
+		metas: make(map[string]*remoteMeta),
+	}
+	if opts != nil {
+		r.opts = *opts
+	}
+	switch u.Scheme {
+	case "http", "https":
+	case "s3":
+		region := u.Query().Get("region")
+		if region == "" {
+			region = "us-east-1"
+		}
+		host := u.Host + ".s3.amazonaws.com"
+		if region != "us-east-1" {
+			host = u.Host + ".s3-" + region + ".amazonaws.com"
+		}
+		u = &url.URL{Scheme: "https", Host: host, Path: u.Path}
+		accessKey, secretKey, sessionToken := r.opts.AccessKey, r.opts.SecretKey, r.opts.SessionToken
+		if accessKey == "" && secretKey == "" {
+			accessKey = os.Getenv("AWS_ACCESS_KEY_ID")
+			secretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+			sessionToken = os.Getenv("AWS_SESSION_TOKEN")
+		}
+		if accessKey != "" && secretKey != "" {
+			r.signer = &sigv4.Signer{
+				Region:       region,
+				Service:      "s3",
+				AccessKey:    accessKey,
+				SecretKey:    secretKey,
+				SessionToken: sessionToken,
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported remote loader scheme %q", u.Scheme)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	r.base = u
+	if r.opts.CacheDir == "" {
+		sum := md5.Sum([]byte(u.String()))
+		r.opts.CacheDir = filepath.Join(os.TempDir(), "gondola-remote-"+hex.EncodeToString(sum[:8]))
+	}
+	if err := os.MkdirAll(r.opts.CacheDir, 0755); err != nil {
+		return nil, err
+	}
+	r.client = r.opts.Client
+	if r.client == nil {
+		r.client = http.DefaultClient
+	}
+	return r, nil
+}
+
+func (r *remoteLoader) cachePath(name string) string {
+	sum := md5.Sum([]byte(name))
+	return filepath.Join(r.opts.CacheDir, hex.EncodeToString(sum[:]))
+}
+
+// loadMeta returns the metadata for the given file, if it's cached.
+// The returned value must not be modified, since it might be shared.
+func (r *remoteLoader) loadMeta(name string) *remoteMeta {
+	r.mu.Lock()
+	m := r.metas[name]
+	r.mu.Unlock()
+	if m != nil {
+		return m
+	}
+	data, err := ioutil.ReadFile(r.cachePath(name) + ".json")
+	if err != nil {
+		return nil
+	}
+	if json.Unmarshal(data, &m) != nil || m == nil {
+		return nil
+	}
+	r.mu.Lock()
+	r.metas[name] = m
+	r.mu.Unlock()
+	return m
+}
+
+func (r *remoteLoader) saveMeta(name string, m *remoteMeta) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(r.cachePath(name)+".json", data); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.metas[name] = m
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *remoteLoader) forget(name string) {
+	r.mu.Lock()
+	delete(r.metas, name)
+	r.mu.Unlock()
+	p := r.cachePath(name)
+	os.Remove(p)
+	os.Remove(p + ".json")
+}
+
+// writeFileAtomic writes the data to a temporary file in the
+// same directory and then renames it, so concurrent readers
+// never see a partially written file.
+func writeFileAtomic(p string, data []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(p), "tmp-")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), p)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// fetch makes sure the file with the given name is in the cache
+// and up to date, returning its metadata. No locks are held while
+// the file is being fetched, so several files might be fetched
+// at the same time.
+func (r *remoteLoader) fetch(op string, p string) (string, *remoteMeta, error) {
+	name := cleanPath(p)
+	if name == "" {
+		return "", nil, &os.PathError{Op: op, Path: p, Err: fmt.Errorf("%s is a directory", p)}
+	}
+	m := r.loadMeta(name)
+	if m != nil && r.opts.MaxAge > 0 && time.Since(m.Fetched) < r.opts.MaxAge {
+		return name, m, nil
+	}
+	u := *r.base
+	u.Path = r.base.Path + "/" + name
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return "", nil, err
+	}
+	for k, v := range r.opts.Header {
+		req.Header[k] = v
+	}
+	if m != nil {
+		if m.ETag != "" {
+			req.Header.Set("If-None-Match", m.ETag)
+		}
+		if m.LastModified != "" {
+			req.Header.Set("If-Modified-Since", m.LastModified)
+		}
+	}
+	if r.signer != nil {
+		req.Header.Set("X-Amz-Content-Sha256", sigv4.EmptyPayload)
+		r.signer.Sign(req, sigv4.EmptyPayload, time.Now())
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		if m != nil {
+			// Server unreachable, use the cached file
+			return name, m, nil
+		}
+		return "", nil, err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotModified && m != nil:
+		updated := *m
+		updated.Fetched = time.Now()
+		return name, &updated, r.saveMeta(name, &updated)
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden:
+		// S3 returns 403 for non-existing keys when
+		// the bucket can't be listed.
+		r.forget(name)
+		return "", nil, &os.PathError{Op: op, Path: p, Err: os.ErrNotExist}
+	case resp.StatusCode != http.StatusOK:
+		if m != nil {
+			return name, m, nil
+		}
+		return "", nil, fmt.Errorf("error fetching %s: %s", u.String(), resp.Status)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := writeFileAtomic(r.cachePath(name), data); err != nil {
+		return "", nil, err
+	}
+	m = &remoteMeta{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Size:         int64(len(data)),
+		Fetched:      time.Now(),
+	}
+	return name, m, r.saveMeta(name, m)
+}
+
+func (r *remoteLoader) Open(p string) (vfs.RFile, error) {
+	name, _, err := r.fetch("open", p)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(r.cachePath(name))
+	if err != nil {
+		return nil, err
+	}
+	return &memFile{Reader: bytes.NewReader(data)}, nil
+}
+
+func (r *remoteLoader) OpenFile(p string, flag int, perm os.FileMode) (vfs.WFile, error) {
+	if isWrite(flag) {
+		return nil, readOnlyError("open", p)
+	}
+	f, err := r.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	return &readOnlyFile{f}, nil
+}
+
+func (r *remoteLoader) Lstat(p string) (os.FileInfo, error) {
+	return r.Stat(p)
+}
+
+func (r *remoteLoader) Stat(p string) (os.FileInfo, error) {
+	if cleanPath(p) == "" {
+		return &dirInfo{name: "/"}, nil
+	}
+	name, m, err := r.fetch("stat", p)
+	if err != nil {
+		return nil, err
+	}
+	modTime := m.Fetched
+	if t, err := http.ParseTime(m.LastModified); err == nil {
+		modTime = t
+	}
+	return &remoteInfo{name: path.Base(name), size: m.Size, modTime: modTime}, nil
+}
+
+func (r *remoteLoader) ReadDir(p string) ([]os.FileInfo, error) {
+	return nil, &os.PathError{Op: "readdir", Path: p, Err: fmt.Errorf("remote loader does not support listing directories")}
+}
+
+func (r *remoteLoader) Mkdir(p string, perm os.FileMode) error {
+	return readOnlyError("mkdir", p)
+}
+
+func (r *remoteLoader) Remove(p string) error {
+	return readOnlyError("remove", p)
+}
+
+func (r *remoteLoader) String() string {
+	return "remote:" + r.base.String()
+}
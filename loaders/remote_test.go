@@ -0,0 +1,159 @@
+package loaders
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gopkgs.com/vfs.v1"
+)
+
+func TestRemote(t *testing.T) {
+	modTime := time.Date(2014, 1, 2, 3, 4, 5, 0, time.UTC)
+	var requests, notModified int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path != "/tmpl/a.html" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"a"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"a"`)
+		w.Header().Set("Last-Modified", modTime.Format(http.TimeFormat))
+		w.Write([]byte("A"))
+	}))
+	defer srv.Close()
+	dir, err := ioutil.TempDir("", "loaders-remote")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, err := Remote(srv.URL+"/tmpl/", &RemoteOptions{CacheDir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for ii := 0; ii < 2; ii++ {
+		data, err := vfs.ReadFile(fs, "/a.html")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "A" {
+			t.Errorf("expecting \"A\", got %q", string(data))
+		}
+	}
+	if n := atomic.LoadInt32(&notModified); n != 1 {
+		t.Errorf("expecting 1 revalidation, got %d", n)
+	}
+	st, err := fs.Stat("/a.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !st.ModTime().Equal(modTime) || st.Size() != 1 {
+		t.Errorf("unexpected file info, mod time %v and size %d", st.ModTime(), st.Size())
+	}
+	if _, err := fs.Open("/b.html"); !os.IsNotExist(err) {
+		t.Errorf("expecting not exist error, got %v", err)
+	}
+	// Cached files are used when the server is down
+	srv.Close()
+	if data, err := vfs.ReadFile(fs, "/a.html"); err != nil || string(data) != "A" {
+		t.Errorf("expecting cached file, got %q (err %v)", string(data), err)
+	}
+	// With MaxAge, the server is not contacted
+	cached, err := Remote(srv.URL+"/tmpl", &RemoteOptions{CacheDir: dir, MaxAge: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := atomic.LoadInt32(&requests)
+	if _, err := vfs.ReadFile(cached, "/a.html"); err != nil {
+		t.Error(err)
+	}
+	if after := atomic.LoadInt32(&requests); after != before {
+		t.Errorf("expecting no requests with MaxAge, got %d", after-before)
+	}
+}
+
+func TestRemoteS3(t *testing.T) {
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		if r.URL.Path != "/prefix/a.html" || r.Header.Get("X-Amz-Content-Sha256") == "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte("A"))
+	}))
+	defer srv.Close()
+	dir, err := ioutil.TempDir("", "loaders-remote")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, err := Remote("s3://bucket/prefix?region=eu-west-1", &RemoteOptions{
+		CacheDir:  dir,
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := fs.(*remoteLoader)
+	if r.base.Host != "bucket.s3-eu-west-1.amazonaws.com" {
+		t.Errorf("unexpected S3 host %q", r.base.Host)
+	}
+	// Send the requests to the test server
+	u, _ := url.Parse(srv.URL + "/prefix")
+	r.base = u
+	if data, err := vfs.ReadFile(fs, "/a.html"); err != nil || string(data) != "A" {
+		t.Fatalf("expecting \"A\", got %q (err %v)", string(data), err)
+	}
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=access/") || !strings.Contains(auth, "/eu-west-1/s3/aws4_request") {
+		t.Errorf("expecting signed request, got Authorization %q", auth)
+	}
+}
+
+func TestRemoteConcurrent(t *testing.T) {
+	block := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow.html" {
+			<-block
+		}
+		w.Write([]byte(r.URL.Path))
+	}))
+	defer srv.Close()
+	defer close(block)
+	dir, err := ioutil.TempDir("", "loaders-remote")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, err := Remote(srv.URL, &RemoteOptions{CacheDir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go vfs.ReadFile(fs, "/slow.html")
+	// Fetching another file must not wait for the slow one
+	done := make(chan error, 1)
+	go func() {
+		_, err := vfs.ReadFile(fs, "/fast.html")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Error(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("fetching a file blocked while fetching another one")
+	}
+}
@@ -0,0 +1,142 @@
+package loaders
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkgs.com/vfs.v1"
+)
+
+// Op represents a change in a file, as
+// reported to the functions passed to Watch.
+type Op int
+
+const (
+	// Create indicates that the file was created.
+	Create Op = 1 << iota
+	// Write indicates that the file was modified.
+	Write
+	// Remove indicates that the file was removed or renamed.
+	Remove
+)
+
+func (o Op) String() string {
+	var ops []string
+	if o&Create != 0 {
+		ops = append(ops, "create")
+	}
+	if o&Write != 0 {
+		ops = append(ops, "write")
+	}
+	if o&Remove != 0 {
+		ops = append(ops, "remove")
+	}
+	if len(ops) == 0 {
+		return fmt.Sprintf("Op(%d)", int(o))
+	}
+	return strings.Join(ops, "|")
+}
+
+// DefaultPollInterval is the interval used for
+// polling for changes when the OS doesn't support
+// change notifications.
+var DefaultPollInterval = time.Second
+
+// Watchable is implemented by loaders which support notifications
+// when their files change.
+type Watchable interface {
+	// Watch starts watching for changes in the loader, calling fn with
+	// the name of the file (using the same format as the paths accepted by
+	// vfs.VFS) and the operation every time a file changes. Functions passed
+	// to Watch might be called concurrently.
+	Watch(fn func(name string, op Op)) error
+}
+
+// Watch starts watching for changes in fs, calling fn every time a
+// file changes. If fs does not implement Watchable, an error is returned.
+func Watch(fs vfs.VFS, fn func(name string, op Op)) error {
+	if w, ok := fs.(Watchable); ok {
+		return w.Watch(fn)
+	}
+	return fmt.Errorf("loader %s does not support watching", fs)
+}
+
+type fileState struct {
+	size    int64
+	modTime time.Time
+}
+
+// pollWatcher detects changes in a vfs.VFS by
+// walking it periodically.
+type pollWatcher struct {
+	fs       vfs.VFS
+	interval time.Duration
+	fn       func(name string, op Op)
+	stop     chan struct{}
+	once     sync.Once
+	files    map[string]fileState
+}
+
+func newPollWatcher(fs vfs.VFS, interval time.Duration, fn func(name string, op Op)) *pollWatcher {
+	w := &pollWatcher{
+		fs:       fs,
+		interval: interval,
+		fn:       fn,
+		stop:     make(chan struct{}),
+	}
+	w.files = w.scan()
+	go w.run()
+	return w
+}
+
+func (w *pollWatcher) scan() map[string]fileState {
+	files := make(map[string]fileState)
+	vfs.Walk(w.fs, "/", func(_ vfs.VFS, p string, info os.FileInfo, err error) error {
+		if err == nil && info != nil && !info.IsDir() {
+			files["/"+cleanPath(p)] = fileState{info.Size(), info.ModTime()}
+		}
+		return nil
+	})
+	return files
+}
+
+func (w *pollWatcher) run() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			w.check()
+		}
+	}
+}
+
+func (w *pollWatcher) check() {
+	files := w.scan()
+	for k, v := range files {
+		prev, ok := w.files[k]
+		if !ok {
+			w.fn(k, Create)
+		} else if prev != v {
+			w.fn(k, Write)
+		}
+	}
+	for k := range w.files {
+		if _, ok := files[k]; !ok {
+			w.fn(k, Remove)
+		}
+	}
+	w.files = files
+}
+
+func (w *pollWatcher) Close() error {
+	w.once.Do(func() {
+		close(w.stop)
+	})
+	return nil
+}
@@ -0,0 +1,73 @@
+package loaders
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatchDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "loaders-watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	prev := DefaultPollInterval
+	DefaultPollInterval = 10 * time.Millisecond
+	defer func() { DefaultPollInterval = prev }()
+	fs, err := Dir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.(*dirLoader).Close()
+	type event struct {
+		name string
+		op   Op
+	}
+	events := make(chan event, 16)
+	if err := Watch(fs, func(name string, op Op) {
+		events <- event{name, op}
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "a.html"), []byte("A"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case ev := <-events:
+		if ev.name != "/a.html" || ev.op&(Create|Write) == 0 {
+			t.Errorf("unexpected event %s on %s", ev.op, ev.name)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("no event received after creating a file")
+	}
+}
+
+func TestPollWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "loaders-poll")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a := filepath.Join(dir, "a.html")
+	ioutil.WriteFile(a, []byte("A"), 0644)
+	fs, err := Dir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ops := make(map[string]Op)
+	w := newPollWatcher(fs, time.Hour, func(name string, op Op) {
+		ops[name] |= op
+	})
+	defer w.Close()
+	ioutil.WriteFile(a, []byte("AA"), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "b.html"), []byte("B"), 0644)
+	w.check()
+	os.Remove(a)
+	w.check()
+	if ops["/a.html"] != Write|Remove || ops["/b.html"] != Create {
+		t.Errorf("unexpected ops %v", ops)
+	}
+}
@@ -5,15 +5,30 @@ import (
 	"gnd.la/net/mail"
 )
 
-var logConfig struct {
-	LogDebug bool
-}
+var (
+	logConfig struct {
+		LogDebug bool
+	}
+	// isDebug and hasAdminWriter track the changes made
+	// to Std, so they can be adjusted when the configuration
+	// is reloaded.
+	isDebug        bool
+	hasAdminWriter bool
+)
 
 func init() {
 	config.RegisterFunc(&logConfig, func() {
 		if logConfig.LogDebug {
 			Std.SetLevel(LDebug)
+			isDebug = true
 		} else {
+			if isDebug {
+				Std.SetLevel(LDefault)
+				isDebug = false
+			}
+			if hasAdminWriter {
+				return
+			}
 			// Check if we should send errors to the admin email
 			admin := mail.AdminEmail()
 			from := mail.DefaultFrom()
@@ -21,6 +36,7 @@ func init() {
 			if admin != "" && server != "" {
 				writer := NewSmtpWriter(LError, server, from, admin)
 				Std.AddWriter(writer)
+				hasAdminWriter = true
 			}
 		}
 	})
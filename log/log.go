@@ -154,6 +154,11 @@ func (l *Logger) RemoveWriters() {
 	l.writers = nil
 }
 
+// Writers returns the writers added to the Logger.
+func (l *Logger) Writers() []Writer {
+	return append([]Writer(nil), l.writers...)
+}
+
 // Write is a generic low-level interface to a Logger. By using the calldepth
 // parameters, wrappers can define their own functions which correctly obtain
 // the PC for the callers (otherwise, all the calls to the logging would appear
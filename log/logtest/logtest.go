@@ -0,0 +1,168 @@
+// Package logtest implements helpers for testing the messages
+// logged by an application.
+//
+// Capture redirects the standard logger (which is also the default
+// logger used by gnd.la/app.App and returned from Context.Logger)
+// to a Recorder until the test finishes, so tests can check what
+// was logged without parsing stderr. A typical usage is:
+//
+//	func TestSomething(t *testing.T) {
+//		rec := logtest.Capture(t)
+//		DoSomething()
+//		if !rec.Contains("something done") {
+//			t.Errorf("expecting something done to be logged, got:\n%s", rec)
+//		}
+//		if c := rec.CountAtLevel(log.LError); c != 0 {
+//			t.Errorf("expecting no errors, got %d", c)
+//		}
+//	}
+//
+// Apps using their own *log.Logger might capture it with
+// CaptureLogger.
+package logtest
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	"gnd.la/log"
+)
+
+// Entry is a message recorded by a Recorder.
+type Entry struct {
+	Level   log.LLevel
+	Message string
+}
+
+func (e *Entry) String() string {
+	return "[" + e.Level.String() + "] " + e.Message
+}
+
+// Recorder is a gnd.la/log.Writer which keeps the logged
+// messages in memory. Recorders are safe for concurrent use.
+type Recorder struct {
+	mu      sync.Mutex
+	entries []*Entry
+	restore func()
+}
+
+// Capture redirects the messages logged with the standard logger
+// (log.Std) at any level to a new Recorder, restoring the logger
+// when the test finishes. Tests using Capture can't run in parallel.
+func Capture(t testing.TB) *Recorder {
+	return CaptureLogger(t, log.Std)
+}
+
+// CaptureLogger works like Capture, but redirects the given
+// logger rather than the standard one.
+func CaptureLogger(t testing.TB, logger *log.Logger) *Recorder {
+	r := &Recorder{}
+	writers := logger.Writers()
+	flags := logger.Flags()
+	level := logger.Level()
+	logger.RemoveWriters()
+	logger.AddWriter(r)
+	// Record just the messages, the level is
+	// received in Write.
+	logger.SetFlags(0)
+	logger.SetLevel(log.LDebug)
+	var once sync.Once
+	r.restore = func() {
+		once.Do(func() {
+			logger.RemoveWriters()
+			for _, v := range writers {
+				logger.AddWriter(v)
+			}
+			logger.SetFlags(flags)
+			logger.SetLevel(level)
+		})
+	}
+	t.Cleanup(r.restore)
+	return r
+}
+
+// Write implements the gnd.la/log.Writer interface.
+func (r *Recorder) Write(level log.LLevel, flags int, b []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, &Entry{
+		Level:   level,
+		Message: strings.TrimSuffix(string(b), "\n"),
+	})
+	return len(b), nil
+}
+
+// Level implements the gnd.la/log.Writer interface. Recorders
+// receive the messages at all levels.
+func (r *Recorder) Level() log.LLevel {
+	return log.LDebug
+}
+
+// Entries returns the recorded entries, in the
+// order they were logged.
+func (r *Recorder) Entries() []*Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Entry(nil), r.entries...)
+}
+
+// Contains returns true iff any recorded message
+// contains s.
+func (r *Recorder) Contains(s string) bool {
+	for _, v := range r.Entries() {
+		if strings.Contains(v.Message, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAtLevel returns true iff any message recorded
+// at the given level contains s.
+func (r *Recorder) ContainsAtLevel(level log.LLevel, s string) bool {
+	for _, v := range r.Entries() {
+		if v.Level == level && strings.Contains(v.Message, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// CountAtLevel returns the number of messages
+// recorded at the given level.
+func (r *Recorder) CountAtLevel(level log.LLevel) int {
+	count := 0
+	for _, v := range r.Entries() {
+		if v.Level == level {
+			count++
+		}
+	}
+	return count
+}
+
+// Reset removes all the recorded entries.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = nil
+}
+
+// Restore restores the captured logger before the test
+// finishes. Messages logged after calling Restore are not
+// recorded.
+func (r *Recorder) Restore() {
+	r.restore()
+}
+
+// String returns the recorded entries, one per line,
+// prefixed by their level.
+func (r *Recorder) String() string {
+	var buf bytes.Buffer
+	for _, v := range r.Entries() {
+		buf.WriteString(v.String())
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
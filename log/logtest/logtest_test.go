@@ -0,0 +1,51 @@
+package logtest
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"gnd.la/log"
+)
+
+func TestCapture(t *testing.T) {
+	level := log.Level()
+	rec := Capture(t)
+	log.Debugf("debug %d", 1)
+	log.Info("info")
+	log.Errorln("error")
+	log.Errorf("error %d", 2)
+	if !rec.Contains("debug 1") || !rec.Contains("info") {
+		t.Errorf("expecting debug and info messages, got:\n%s", rec)
+	}
+	if !rec.ContainsAtLevel(log.LError, "error 2") || rec.ContainsAtLevel(log.LInfo, "error") {
+		t.Errorf("invalid levels in recorded messages:\n%s", rec)
+	}
+	if c := rec.CountAtLevel(log.LError); c != 2 {
+		t.Errorf("expecting 2 errors, got %d", c)
+	}
+	if e := rec.Entries()[2]; e.Message != "error" {
+		t.Errorf("expecting message \"error\", got %q", e.Message)
+	}
+	rec.Reset()
+	if len(rec.Entries()) != 0 {
+		t.Error("expecting no entries after Reset")
+	}
+	rec.Restore()
+	log.Debug("not recorded")
+	if rec.Contains("not recorded") {
+		t.Error("expecting no recorded messages after Restore")
+	}
+	if log.Level() != level {
+		t.Errorf("expecting level %s after Restore, got %s", level, log.Level())
+	}
+}
+
+func TestCaptureLogger(t *testing.T) {
+	logger := log.New(log.NewIOWriter(ioutil.Discard, log.LNone), log.LstdFlags, log.LError)
+	rec := CaptureLogger(t, logger)
+	logger.Warning("warning")
+	log.Warning("standard")
+	if !rec.ContainsAtLevel(log.LWarning, "warning") || rec.Contains("standard") {
+		t.Errorf("invalid recorded messages:\n%s", rec)
+	}
+}
@@ -0,0 +1,18 @@
+// Package metrics implements counters, gauges and histograms which
+// can be exposed in the Prometheus text format.
+//
+// Metrics are identified by their name and might have any number of
+// labels, which are declared when the metric is created. Values for
+// all the labels must be provided, in the same order, when updating
+// the metric. e.g.
+//
+//  var logins = metrics.NewCounter("myapp_logins_total", "Number of logins", "method")
+//  ...
+//  logins.Inc("password")
+//
+// Gondola registers its own metrics in the Default registry, prefixed
+// with gondola_, instrumenting the requests served by gnd.la/app, the
+// queries executed by gnd.la/orm, the cache operations and the tasks
+// run by gnd.la/tasks. Use gnd.la/app.App.HandleMetrics to expose
+// them over HTTP.
+package metrics
@@ -0,0 +1,294 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	counterType   = "counter"
+	gaugeType     = "gauge"
+	histogramType = "histogram"
+)
+
+var (
+	// DefaultBuckets are the default upper bounds for
+	// the histogram buckets, suitable for durations
+	// expressed in seconds.
+	DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+	nameRe  = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// Metric is the interface implemented by Counter, Gauge and
+// Histogram.
+type Metric interface {
+	// Name returns the metric name.
+	Name() string
+	// Help returns the metric description.
+	Help() string
+	// Labels returns the metric label names.
+	Labels() []string
+	write(w *writer)
+}
+
+type desc struct {
+	name   string
+	help   string
+	labels []string
+}
+
+func newDesc(name string, help string, labels []string) desc {
+	if !nameRe.MatchString(name) {
+		panic(fmt.Errorf("invalid metric name %q", name))
+	}
+	for _, v := range labels {
+		if !labelRe.MatchString(v) || strings.HasPrefix(v, "__") {
+			panic(fmt.Errorf("invalid label name %q in metric %s", v, name))
+		}
+	}
+	return desc{name: name, help: help, labels: labels}
+}
+
+func (d *desc) Name() string {
+	return d.name
+}
+
+func (d *desc) Help() string {
+	return d.help
+}
+
+func (d *desc) Labels() []string {
+	return d.labels
+}
+
+func (d *desc) key(values []string) string {
+	if len(values) != len(d.labels) {
+		panic(fmt.Errorf("metric %s has %d labels, %d values provided", d.name, len(d.labels), len(values)))
+	}
+	return strings.Join(values, "\xff")
+}
+
+type sample struct {
+	values []string
+	value  float64
+}
+
+// samples holds the values for a Counter or a Gauge,
+// indexed by their label values.
+type samples struct {
+	mu   sync.Mutex
+	data map[string]*sample
+}
+
+func (s *samples) update(d *desc, values []string, f func(v float64) float64) {
+	key := d.key(values)
+	s.mu.Lock()
+	sm := s.data[key]
+	if sm == nil {
+		if s.data == nil {
+			s.data = make(map[string]*sample)
+		}
+		sm = &sample{values: append([]string(nil), values...)}
+		s.data[key] = sm
+	}
+	sm.value = f(sm.value)
+	s.mu.Unlock()
+}
+
+func (s *samples) value(d *desc, values []string) float64 {
+	key := d.key(values)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sm := s.data[key]; sm != nil {
+		return sm.value
+	}
+	return 0
+}
+
+func (s *samples) sorted() []*sample {
+	s.mu.Lock()
+	keys := make([]string, 0, len(s.data))
+	for k := range s.data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	list := make([]*sample, len(keys))
+	for ii, k := range keys {
+		sm := *s.data[k]
+		list[ii] = &sm
+	}
+	s.mu.Unlock()
+	return list
+}
+
+func (s *samples) write(w *writer, d *desc, typ string) {
+	w.header(d, typ)
+	for _, v := range s.sorted() {
+		w.sample(d.name, d.labels, v.values, "", v.value)
+	}
+}
+
+// Counter is a metric which can only increase. Use
+// NewCounter or Registry.NewCounter to create a Counter.
+type Counter struct {
+	desc
+	samples samples
+}
+
+// Add increments the counter for the given label values
+// by v, which must not be negative.
+func (c *Counter) Add(v float64, values ...string) {
+	if v < 0 {
+		panic(fmt.Errorf("can't decrease counter %s", c.name))
+	}
+	c.samples.update(&c.desc, values, func(cur float64) float64 { return cur + v })
+}
+
+// Inc increments the counter for the given label values by 1.
+func (c *Counter) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+// Value returns the current value of the counter for the
+// given label values.
+func (c *Counter) Value(values ...string) float64 {
+	return c.samples.value(&c.desc, values)
+}
+
+func (c *Counter) write(w *writer) {
+	c.samples.write(w, &c.desc, counterType)
+}
+
+// Gauge is a metric which might increase or decrease. Use
+// NewGauge or Registry.NewGauge to create a Gauge.
+type Gauge struct {
+	desc
+	samples samples
+}
+
+// Set sets the gauge for the given label values to v.
+func (g *Gauge) Set(v float64, values ...string) {
+	g.samples.update(&g.desc, values, func(float64) float64 { return v })
+}
+
+// Add adds v, which might be negative, to the gauge for
+// the given label values.
+func (g *Gauge) Add(v float64, values ...string) {
+	g.samples.update(&g.desc, values, func(cur float64) float64 { return cur + v })
+}
+
+// Inc increments the gauge for the given label values by 1.
+func (g *Gauge) Inc(values ...string) {
+	g.Add(1, values...)
+}
+
+// Dec decrements the gauge for the given label values by 1.
+func (g *Gauge) Dec(values ...string) {
+	g.Add(-1, values...)
+}
+
+// Value returns the current value of the gauge for the
+// given label values.
+func (g *Gauge) Value(values ...string) float64 {
+	return g.samples.value(&g.desc, values)
+}
+
+func (g *Gauge) write(w *writer) {
+	g.samples.write(w, &g.desc, gaugeType)
+}
+
+type histogramSample struct {
+	values []string
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// Histogram counts the observed values in configurable
+// buckets. Use NewHistogram or Registry.NewHistogram to
+// create a Histogram.
+type Histogram struct {
+	desc
+	buckets []float64
+	mu      sync.Mutex
+	data    map[string]*histogramSample
+}
+
+// Buckets returns the upper bounds of the histogram buckets.
+func (h *Histogram) Buckets() []float64 {
+	return h.buckets
+}
+
+// Observe adds v to the histogram for the given label values.
+func (h *Histogram) Observe(v float64, values ...string) {
+	key := h.key(values)
+	idx := sort.SearchFloat64s(h.buckets, v)
+	h.mu.Lock()
+	hs := h.data[key]
+	if hs == nil {
+		hs = &histogramSample{
+			values: append([]string(nil), values...),
+			counts: make([]uint64, len(h.buckets)),
+		}
+		h.data[key] = hs
+	}
+	if idx < len(hs.counts) {
+		hs.counts[idx]++
+	}
+	hs.sum += v
+	hs.count++
+	h.mu.Unlock()
+}
+
+// Count returns the number of observed values and their
+// sum for the given label values.
+func (h *Histogram) Count(values ...string) (uint64, float64) {
+	key := h.key(values)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if hs := h.data[key]; hs != nil {
+		return hs.count, hs.sum
+	}
+	return 0, 0
+}
+
+func (h *Histogram) sorted() []*histogramSample {
+	h.mu.Lock()
+	keys := make([]string, 0, len(h.data))
+	for k := range h.data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	list := make([]*histogramSample, len(keys))
+	for ii, k := range keys {
+		hs := *h.data[k]
+		hs.counts = append([]uint64(nil), hs.counts...)
+		list[ii] = &hs
+	}
+	h.mu.Unlock()
+	return list
+}
+
+func (h *Histogram) write(w *writer) {
+	w.header(&h.desc, histogramType)
+	labels := append(append([]string(nil), h.labels...), "le")
+	for _, v := range h.sorted() {
+		values := append(append([]string(nil), v.values...), "")
+		var cumulative uint64
+		for ii, b := range h.buckets {
+			cumulative += v.counts[ii]
+			values[len(values)-1] = formatFloat(b)
+			w.sample(h.name, labels, values, "_bucket", float64(cumulative))
+		}
+		values[len(values)-1] = formatFloat(math.Inf(1))
+		w.sample(h.name, labels, values, "_bucket", float64(v.count))
+		w.sample(h.name, h.labels, v.values, "_sum", v.sum)
+		w.sample(h.name, h.labels, v.values, "_count", float64(v.count))
+	}
+}
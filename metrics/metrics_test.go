@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("requests_total", "Number of requests", "route", "status")
+	g := r.NewGauge("running", "Running \\ things\nnow")
+	h := r.NewHistogram("duration_seconds", "", []float64{0.1, 1}, "route")
+	c.Inc("home", "200")
+	c.Add(2, "home", "200")
+	c.Inc(`say "hi"`, "404")
+	g.Inc()
+	g.Inc()
+	g.Dec()
+	h.Observe(0.05, "home")
+	h.Observe(0.5, "home")
+	h.Observe(5, "home")
+	var buf bytes.Buffer
+	if err := r.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := `# TYPE duration_seconds histogram
+duration_seconds_bucket{route="home",le="0.1"} 1
+duration_seconds_bucket{route="home",le="1"} 2
+duration_seconds_bucket{route="home",le="+Inf"} 3
+duration_seconds_sum{route="home"} 5.55
+duration_seconds_count{route="home"} 3
+# HELP requests_total Number of requests
+# TYPE requests_total counter
+requests_total{route="home",status="200"} 3
+requests_total{route="say \"hi\"",status="404"} 1
+# HELP running Running \\ things\nnow
+# TYPE running gauge
+running 1
+`
+	if s := buf.String(); s != expected {
+		t.Errorf("expecting output:\n%s\ngot:\n%s", expected, s)
+	}
+	if v := c.Value("home", "200"); v != 3 {
+		t.Errorf("expecting counter value 3, got %v", v)
+	}
+	if n, sum := h.Count("home"); n != 3 || sum != 5.55 {
+		t.Errorf("expecting count 3 and sum 5.55, got %d and %v", n, sum)
+	}
+}
+
+func expectPanic(t *testing.T, what string, f func()) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expecting a panic with %s", what)
+		}
+	}()
+	f()
+}
+
+func TestPanics(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("total", "", "a")
+	expectPanic(t, "duplicate metric", func() { r.NewGauge("total", "") })
+	expectPanic(t, "invalid name", func() { r.NewGauge("1total", "") })
+	expectPanic(t, "invalid label", func() { r.NewGauge("gauge", "", "a-b") })
+	expectPanic(t, "wrong number of labels", func() { c.Inc() })
+	expectPanic(t, "decreasing counter", func() { c.Add(-1, "a") })
+	expectPanic(t, "le label", func() { r.NewHistogram("histogram", "", nil, "le") })
+	expectPanic(t, "unsorted buckets", func() { r.NewHistogram("histogram", "", []float64{2, 1}) })
+}
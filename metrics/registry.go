@@ -0,0 +1,181 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ContentType is the content type of the Prometheus
+// text format, as written by Registry.Write.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Default is the default Registry, used by NewCounter,
+// NewGauge and NewHistogram.
+var Default = NewRegistry()
+
+// Registry holds a set of metrics, identified by their names.
+type Registry struct {
+	mu      sync.RWMutex
+	metrics map[string]Metric
+}
+
+// NewRegistry returns a new empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{metrics: make(map[string]Metric)}
+}
+
+// Register adds the given metric to the Registry. If there's
+// already a metric with the same name, it panics.
+func (r *Registry) Register(m Metric) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.metrics[m.Name()]; ok {
+		panic(fmt.Errorf("duplicate metric %s", m.Name()))
+	}
+	r.metrics[m.Name()] = m
+}
+
+// Metric returns the registered metric with the given
+// name, or nil if there's no such metric.
+func (r *Registry) Metric(name string) Metric {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.metrics[name]
+}
+
+// NewCounter creates and registers a new Counter. See Register
+// for the conditions which might cause it to panic.
+func (r *Registry) NewCounter(name string, help string, labels ...string) *Counter {
+	c := &Counter{desc: newDesc(name, help, labels)}
+	r.Register(c)
+	return c
+}
+
+// NewGauge creates and registers a new Gauge. See Register
+// for the conditions which might cause it to panic.
+func (r *Registry) NewGauge(name string, help string, labels ...string) *Gauge {
+	g := &Gauge{desc: newDesc(name, help, labels)}
+	r.Register(g)
+	return g
+}
+
+// NewHistogram creates and registers a new Histogram with the
+// given bucket upper bounds, which must be sorted in increasing
+// order. If buckets is nil, DefaultBuckets is used. See Register
+// for the conditions which might cause it to panic.
+func (r *Registry) NewHistogram(name string, help string, buckets []float64, labels ...string) *Histogram {
+	if buckets == nil {
+		buckets = DefaultBuckets
+	}
+	if !sort.Float64sAreSorted(buckets) {
+		panic(fmt.Errorf("buckets for histogram %s are not sorted", name))
+	}
+	for _, v := range labels {
+		if v == "le" {
+			panic(fmt.Errorf("histogram %s can't use the label le", name))
+		}
+	}
+	h := &Histogram{
+		desc:    newDesc(name, help, labels),
+		buckets: append([]float64(nil), buckets...),
+		data:    make(map[string]*histogramSample),
+	}
+	r.Register(h)
+	return h
+}
+
+// Write writes all the metrics in the Registry, sorted by name,
+// to w using the Prometheus text format.
+func (r *Registry) Write(w io.Writer) error {
+	r.mu.RLock()
+	names := make([]string, 0, len(r.metrics))
+	for k := range r.metrics {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	list := make([]Metric, len(names))
+	for ii, v := range names {
+		list[ii] = r.metrics[v]
+	}
+	r.mu.RUnlock()
+	mw := &writer{w: bufio.NewWriter(w)}
+	for _, v := range list {
+		v.write(mw)
+	}
+	return mw.w.Flush()
+}
+
+// NewCounter is a shorthand for Default.NewCounter.
+func NewCounter(name string, help string, labels ...string) *Counter {
+	return Default.NewCounter(name, help, labels...)
+}
+
+// NewGauge is a shorthand for Default.NewGauge.
+func NewGauge(name string, help string, labels ...string) *Gauge {
+	return Default.NewGauge(name, help, labels...)
+}
+
+// NewHistogram is a shorthand for Default.NewHistogram.
+func NewHistogram(name string, help string, buckets []float64, labels ...string) *Histogram {
+	return Default.NewHistogram(name, help, buckets, labels...)
+}
+
+// Write is a shorthand for Default.Write.
+func Write(w io.Writer) error {
+	return Default.Write(w)
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+type writer struct {
+	w *bufio.Writer
+}
+
+func (w *writer) header(d *desc, typ string) {
+	if d.help != "" {
+		fmt.Fprintf(w.w, "# HELP %s %s\n", d.name, helpEscaper.Replace(d.help))
+	}
+	fmt.Fprintf(w.w, "# TYPE %s %s\n", d.name, typ)
+}
+
+func (w *writer) sample(name string, labels []string, values []string, suffix string, value float64) {
+	w.w.WriteString(name)
+	w.w.WriteString(suffix)
+	if len(labels) > 0 {
+		w.w.WriteByte('{')
+		for ii, v := range labels {
+			if ii > 0 {
+				w.w.WriteByte(',')
+			}
+			w.w.WriteString(v)
+			w.w.WriteString(`="`)
+			w.w.WriteString(labelEscaper.Replace(values[ii]))
+			w.w.WriteByte('"')
+		}
+		w.w.WriteByte('}')
+	}
+	w.w.WriteByte(' ')
+	w.w.WriteString(formatFloat(value))
+	w.w.WriteByte('\n')
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
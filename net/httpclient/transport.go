@@ -1,9 +1,12 @@
 package httpclient
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
 	"time"
+
+	"gnd.la/tracing"
 )
 
 // Transport is the interface used as a transport by *Client.
@@ -51,7 +54,7 @@ func NewTransport(ctx Context) Transport {
 }
 
 func newTransport(ctx Context) *transport {
-	tr := &transport{}
+	tr := &transport{ctx: ctx}
 	rt := newRoundTripper(ctx, tr)
 	tr.transport = rt
 	return tr
@@ -61,10 +64,14 @@ type transport struct {
 	userAgent string
 	timeout   time.Duration
 	transport http.RoundTripper
+	// ctx is used for obtaining the parent tracing
+	// span when the request doesn't carry one.
+	ctx Context
 }
 
 func (t *transport) clone(ctx Context) *transport {
 	tc := *t
+	tc.ctx = ctx
 	tc.transport = newRoundTripper(ctx, &tc)
 	return &tc
 }
@@ -91,5 +98,36 @@ func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
 			req.Header.Add("User-Agent", t.userAgent)
 		}
 	}
+	if tracing.Enabled() && req.Header != nil {
+		return t.tracedRoundTrip(req)
+	}
 	return t.transport.RoundTrip(req)
 }
+
+// tracedRoundTrip performs the roundtrip in a tracing span,
+// propagating the trace context to the remote server. The parent
+// span is taken from the request context or, if it carries none,
+// from the request of the Context the Client was created with.
+func (t *transport) tracedRoundTrip(req *http.Request) (*http.Response, error) {
+	parent := req.Context()
+	if tracing.FromContext(parent) == nil && t.ctx != nil {
+		if r := t.ctx.Request(); r != nil {
+			parent = r.Context()
+		}
+	}
+	span := tracing.Start(parent, "HTTP "+req.Method, tracing.Client).
+		SetAttribute("http.method", req.Method).
+		SetAttribute("http.url", req.URL.String())
+	defer span.End()
+	tracing.Inject(req.Header, span)
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		span.SetError(err)
+		return nil, err
+	}
+	span.SetAttribute("http.status_code", resp.StatusCode)
+	if resp.StatusCode >= 500 {
+		span.SetError(fmt.Errorf("status code %d", resp.StatusCode))
+	}
+	return resp, nil
+}
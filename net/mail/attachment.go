@@ -0,0 +1,165 @@
+package mail
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+var errAttachmentRead = errors.New("attachment reader has already been read")
+
+// AttachmentTooLargeError is returned when an attachment exceeds the
+// maximum size. See MaxAttachmentSize.
+type AttachmentTooLargeError struct {
+	// Name is the name of the attachment.
+	Name string
+	// Limit is the maximum attachment size in bytes.
+	Limit int64
+}
+
+func (e *AttachmentTooLargeError) Error() string {
+	return fmt.Sprintf("attachment %q exceeds the maximum size of %d bytes", e.Name, e.Limit)
+}
+
+// MaxAttachmentSize returns the maximum size in bytes for each
+// attachment. Messages with an attachment larger than this size
+// won't be sent and will return an *AttachmentTooLargeError.
+// Use the configuration file key max_attachment_size or the
+// command line flag -max-attachment-size to change it.
+// Zero means no limit.
+func MaxAttachmentSize() int64 {
+	return Config.MaxAttachmentSize
+}
+
+// NewReaderAttachment returns a new attachment which reads its data
+// from r when the message is sent, without loading it into memory. If
+// r implements io.Closer, it's closed after reading it. Note that r can
+// only be read once, so the returned attachment can't be sent multiple
+// times unless its Load method is called first. The ContentType is
+// derived from the name or, if that's not possible, from the data.
+func NewReaderAttachment(name string, r io.Reader) *Attachment {
+	read := false
+	return NewOpenerAttachment(name, func() (io.ReadCloser, error) {
+		if read {
+			return nil, errAttachmentRead
+		}
+		read = true
+		if rc, ok := r.(io.ReadCloser); ok {
+			return rc, nil
+		}
+		return ioutil.NopCloser(r), nil
+	})
+}
+
+// NewFileAttachment returns a new attachment which reads its data from
+// the given file when the message is sent, without loading it into memory.
+// The attachment name is set to the base name of the file. If the file
+// does not exist or it's larger than MaxAttachmentSize(), an error is
+// returned.
+func NewFileAttachment(filename string) (*Attachment, error) {
+	st, err := os.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	if st.IsDir() {
+		return nil, fmt.Errorf("%s is a directory", filename)
+	}
+	name := filepath.Base(filename)
+	if max := MaxAttachmentSize(); max > 0 && st.Size() > max {
+		return nil, &AttachmentTooLargeError{Name: name, Limit: max}
+	}
+	return NewOpenerAttachment(name, func() (io.ReadCloser, error) {
+		return os.Open(filename)
+	}), nil
+}
+
+// NewOpenerAttachment returns a new attachment which calls open to read
+// its data when the message is sent. Use this function to attach data from
+// other sources (e.g. see gnd.la/app.Context.BlobstoreAttachment). The
+// ContentType is derived from the name or, if that's not possible, from
+// the data.
+func NewOpenerAttachment(name string, open func() (io.ReadCloser, error)) *Attachment {
+	return &Attachment{
+		Name:        name,
+		ContentType: mime.TypeByExtension(path.Ext(name)),
+		Open:        open,
+	}
+}
+
+// Load reads the attachment data into memory, storing it into the Data
+// field, and detects its ContentType if it was empty. After calling Load,
+// Open is set to nil. If the attachment exceeds MaxAttachmentSize(),
+// an *AttachmentTooLargeError is returned.
+func (a *Attachment) Load() error {
+	contentType, r, err := a.reader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	a.ContentType = contentType
+	a.Data = data
+	a.Open = nil
+	return nil
+}
+
+// reader returns the attachment content type, detecting it if
+// required, and a reader for its data which enforces the size limit.
+func (a *Attachment) reader() (string, io.ReadCloser, error) {
+	var rc io.ReadCloser
+	if a.Open != nil {
+		var err error
+		if rc, err = a.Open(); err != nil {
+			return "", nil, err
+		}
+	} else {
+		rc = ioutil.NopCloser(bytes.NewReader(a.Data))
+	}
+	var r io.Reader = rc
+	contentType := a.ContentType
+	if contentType == "" {
+		br := bufio.NewReaderSize(rc, 512)
+		// Errors will be returned again by Read
+		head, _ := br.Peek(512)
+		contentType = http.DetectContentType(head)
+		r = br
+	}
+	if max := MaxAttachmentSize(); max > 0 {
+		r = &limitedReader{r: r, name: a.Name, limit: max}
+	}
+	return contentType, &readCloser{Reader: r, Closer: rc}, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// limitedReader returns an *AttachmentTooLargeError
+// once it has read more than limit bytes.
+type limitedReader struct {
+	r     io.Reader
+	name  string
+	n     int64
+	limit int64
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	if r.n > r.limit {
+		return n, &AttachmentTooLargeError{Name: r.name, Limit: r.limit}
+	}
+	return n, err
+}
@@ -0,0 +1,142 @@
+// +build !appengine
+
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/mail"
+	"sync"
+	"time"
+
+	"gnd.la/config"
+)
+
+var (
+	backends = map[string]BackendOpener{}
+	// Backends are cached by their URL, since
+	// they're safe for concurrent use.
+	openBackends struct {
+		sync.RWMutex
+		backends map[string]Backend
+	}
+	httpClient = &http.Client{Timeout: 30 * time.Second}
+)
+
+// Envelope contains a message ready to be delivered by a Backend.
+type Envelope struct {
+	// From is the sender address. It's never empty.
+	From string
+	// To, Cc and Bcc contain the recipients, with any
+	// references to the Admin pseudo-address already
+	// expanded.
+	To, Cc, Bcc []string
+	// Message is the message to be sent.
+	Message *Message
+}
+
+// Recipients returns all the recipients for the message,
+// including the Cc and Bcc ones.
+func (e *Envelope) Recipients() []string {
+	var addrs []string
+	addrs = append(addrs, e.To...)
+	addrs = append(addrs, e.Cc...)
+	addrs = append(addrs, e.Bcc...)
+	return addrs
+}
+
+// Bytes returns the message encoded as MIME, as it would
+// be sent over SMTP.
+func (e *Envelope) Bytes() ([]byte, error) {
+	return buildMessage(e.From, e.To, e.Cc, e.Bcc, e.Message)
+}
+
+// Backend is the interface implemented by the mail delivery backends.
+// Backends must be safe for concurrent use by multiple goroutines.
+type Backend interface {
+	// Send delivers the message in the given envelope.
+	Send(env *Envelope) error
+}
+
+// BackendOpener is a function which returns a new Backend from
+// its URL. See RegisterBackend.
+type BackendOpener func(url *config.URL) (Backend, error)
+
+// RegisterBackend registers a new mail delivery backend, which
+// will be used for sending messages when the server address (see
+// DefaultServer) is a URL with the given scheme. If there's already
+// a backend with the same scheme, RegisterBackend panics. This
+// function is not thread safe, as it's only intended to be used
+// from the main goroutine. The following backends are included:
+//
+//  ses://{region}[#access_key={key}&secret_key={secret}] - Amazon SES
+//  sendgrid://{api_key} - SendGrid
+//  mailgun://{domain}#api_key={key}[&region=eu] - Mailgun
+//  postmark://{server_token} - Postmark
+//  console:// - prints the messages to the standard output
+//  file://{directory} - stores the messages in a maildir
+//
+// If the credentials for SES are omitted, they're read from the
+// AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables.
+func RegisterBackend(scheme string, opener BackendOpener) {
+	if _, ok := backends[scheme]; ok {
+		panic(fmt.Errorf("duplicate mail backend %q", scheme))
+	}
+	backends[scheme] = opener
+}
+
+func openBackend(server string) (Backend, error) {
+	openBackends.RLock()
+	backend := openBackends.backends[server]
+	openBackends.RUnlock()
+	if backend != nil {
+		return backend, nil
+	}
+	u, err := config.ParseURL(server)
+	if err != nil {
+		return nil, err
+	}
+	opener := backends[u.Scheme]
+	if opener == nil {
+		return nil, fmt.Errorf("unknown mail backend %q - did you forget an import?", u.Scheme)
+	}
+	backend, err = opener(u)
+	if err != nil {
+		return nil, fmt.Errorf("error opening mail backend %q: %s", u.Scheme, err)
+	}
+	openBackends.Lock()
+	if openBackends.backends == nil {
+		openBackends.backends = make(map[string]Backend)
+	}
+	openBackends.backends[server] = backend
+	openBackends.Unlock()
+	return backend, nil
+}
+
+// splitAddress returns the name and the email from an address
+// in the form "Name <email>". If the address can't be parsed,
+// it's returned as the email.
+func splitAddress(addr string) (string, string) {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return "", addr
+	}
+	return a.Name, a.Address
+}
+
+// doRequest sends the given request using the backend HTTP client,
+// returning a *BackendError if the response status code is not 2xx.
+func doRequest(name string, req *http.Request) error {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return &BackendError{Backend: name, StatusCode: resp.StatusCode, Message: string(bytes.TrimSpace(body))}
+	}
+	return nil
+}
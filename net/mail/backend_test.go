@@ -0,0 +1,184 @@
+// +build !appengine
+
+package mail
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"gnd.la/internal/sigv4"
+)
+
+func TestMaildirBackend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "maildir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	msg := &Message{
+		Server:   "file://" + dir,
+		From:     "sender@example.com",
+		To:       "receiver@example.com",
+		Subject:  "Hello",
+		TextBody: "Hello world",
+	}
+	for ii := 0; ii < 2; ii++ {
+		if err := Send(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files, err := ioutil.ReadDir(filepath.Join(dir, "new"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expecting 2 messages in maildir, got %d", len(files))
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "new", files[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(data); !strings.Contains(s, "Subject: Hello\r\n") || !strings.Contains(s, "Hello world") {
+		t.Errorf("invalid message stored in maildir: %q", s)
+	}
+}
+
+func TestUnknownBackend(t *testing.T) {
+	msg := &Message{
+		Server:   "doesnotexist://foo",
+		From:     "sender@example.com",
+		To:       "receiver@example.com",
+		TextBody: "Hello world",
+	}
+	if err := Send(msg); err == nil {
+		t.Error("expecting an error with an unknown backend")
+	}
+}
+
+func TestPostmarkBackend(t *testing.T) {
+	var m postmarkMessage
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Postmark-Server-Token") != "token" {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+	b := &postmarkBackend{endpoint: srv.URL, token: "token"}
+	env := &Envelope{
+		From: "sender@example.com",
+		To:   []string{"a@example.com", "b@example.com"},
+		Message: &Message{
+			Subject:  "Hello",
+			HTMLBody: `<img src="cid:logo">`,
+			Attachments: []*Attachment{
+				{Name: "logo.png", ContentType: "image/png", Data: []byte("png"), ContentID: "logo"},
+			},
+		},
+	}
+	if err := b.Send(env); err != nil {
+		t.Fatal(err)
+	}
+	if m.To != "a@example.com, b@example.com" || m.Subject != "Hello" {
+		t.Errorf("invalid message %+v", m)
+	}
+	if len(m.Attachments) != 1 || m.Attachments[0].ContentID != "cid:logo" {
+		t.Errorf("invalid attachments %+v", m.Attachments)
+	}
+	b.token = "invalid"
+	if err := b.Send(env); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("expecting an error with status code 401, got %v", err)
+	}
+}
+
+func TestSESSignature(t *testing.T) {
+	// Credentials from the AWS documentation examples
+	b := &sesBackend{
+		signer: sigv4.Signer{
+			Region:    "us-east-1",
+			Service:   sesService,
+			AccessKey: "AKIDEXAMPLE",
+			SecretKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		},
+	}
+	req, err := http.NewRequest("POST", "https://email.us-east-1.amazonaws.com/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	b.sign(req, "Action=SendRawEmail", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+	auth := req.Header.Get("Authorization")
+	prefix := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/ses/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature="
+	if !strings.HasPrefix(auth, prefix) || len(auth) != len(prefix)+64 {
+		t.Errorf("invalid Authorization header %q", auth)
+	}
+	if d := req.Header.Get("X-Amz-Date"); d != "20150830T123600Z" {
+		t.Errorf("invalid X-Amz-Date %q", d)
+	}
+}
+
+func TestSESBackend(t *testing.T) {
+	var form url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), sigv4.Algorithm+" Credential=AKIDEXAMPLE/") {
+			http.Error(w, "invalid signature", http.StatusForbidden)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		form = r.PostForm
+	}))
+	defer srv.Close()
+	b := &sesBackend{
+		endpoint: srv.URL,
+		signer: sigv4.Signer{
+			Region:    "us-east-1",
+			Service:   sesService,
+			AccessKey: "AKIDEXAMPLE",
+			SecretKey: "secret",
+		},
+	}
+	env := &Envelope{
+		From: "sender@example.com",
+		To:   []string{"to@example.com"},
+		Cc:   []string{"cc@example.com"},
+		Bcc:  []string{"bcc@example.com"},
+		Message: &Message{
+			Subject:  "Hello",
+			TextBody: "Hello world",
+		},
+	}
+	if err := b.Send(env); err != nil {
+		t.Fatal(err)
+	}
+	var dests []string
+	for ii := 1; form.Get("Destinations.member."+strconv.Itoa(ii)) != ""; ii++ {
+		dests = append(dests, form.Get("Destinations.member."+strconv.Itoa(ii)))
+	}
+	if exp := env.Recipients(); !reflect.DeepEqual(dests, exp) {
+		t.Errorf("expecting destinations %v, got %v", exp, dests)
+	}
+	raw, err := base64.StdEncoding.DecodeString(form.Get("RawMessage.Data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(raw); !strings.Contains(s, "Cc: cc@example.com\r\n") || strings.Contains(s, "bcc@example.com") {
+		t.Errorf("invalid raw message %q", s)
+	}
+}
@@ -0,0 +1,92 @@
+package mail
+
+import (
+	"fmt"
+	"net"
+	"net/textproto"
+	"strings"
+)
+
+// Bounce classifies the errors returned when sending a message.
+// See ClassifyBounce.
+type Bounce int
+
+const (
+	// NoBounce indicates that the message was sent.
+	NoBounce Bounce = iota
+	// SoftBounce indicates a transient failure (e.g. a network error,
+	// a full mailbox or a greylisting server). Sending the message
+	// again later might succeed.
+	SoftBounce
+	// HardBounce indicates a permanent failure (e.g. the recipient
+	// does not exist or the message was rejected). Sending the message
+	// again won't succeed.
+	HardBounce
+)
+
+func (b Bounce) String() string {
+	switch b {
+	case NoBounce:
+		return "none"
+	case SoftBounce:
+		return "soft"
+	case HardBounce:
+		return "hard"
+	}
+	return fmt.Sprintf("Bounce(%d)", int(b))
+}
+
+// BackendError is returned by the HTTP based backends when
+// the provider API returns an unsuccessful response.
+type BackendError struct {
+	// Backend is the name of the backend (e.g. SES).
+	Backend string
+	// StatusCode is the HTTP status code returned by the API.
+	StatusCode int
+	// Message is the response body returned by the API.
+	Message string
+}
+
+func (e *BackendError) Error() string {
+	return fmt.Sprintf("error sending message via %s (status code %d): %s", e.Backend, e.StatusCode, e.Message)
+}
+
+// ClassifyBounce returns the Bounce type for an error returned
+// by Send. Errors from SMTP servers are classified according to
+// their reply code (4xx are soft bounces while 5xx are hard bounces,
+// except the ones indicating that the mailbox is full), while errors
+// returned by HTTP based backends are classified by their status code
+// (429 and 5xx are soft bounces, any other one is a hard bounce).
+// Errors caused by invalid messages (e.g. without recipients or with
+// attachments exceeding MaxAttachmentSize) are
+// hard bounces, while network errors and any other unknown errors
+// are considered soft bounces.
+func ClassifyBounce(err error) Bounce {
+	switch err {
+	case errNoMessage, errNoDestinataries, errNoBody, errNoFrom:
+		return HardBounce
+	}
+	switch x := err.(type) {
+	case nil:
+		return NoBounce
+	case *textproto.Error:
+		if x.Code >= 500 && x.Code < 600 {
+			// 552 and 5.2.2 indicate that the mailbox is full
+			if x.Code == 552 || strings.HasPrefix(x.Msg, "5.2.2") {
+				return SoftBounce
+			}
+			return HardBounce
+		}
+		return SoftBounce
+	case *AttachmentTooLargeError:
+		return HardBounce
+	case *BackendError:
+		if x.StatusCode == 429 || x.StatusCode >= 500 {
+			return SoftBounce
+		}
+		return HardBounce
+	case net.Error:
+		return SoftBounce
+	}
+	return SoftBounce
+}
@@ -11,6 +11,9 @@ var Config struct {
 	MailServer  string `default:"localhost:25" help:"Default mail server used by gnd.la/net/mail"`
 	DefaultFrom string `help:"Default From address when sending emails"`
 	AdminEmail  string `help:"When running in non-debug mode, any error messages will be emailed to this adddress"`
+	// MaxAttachmentSize is the maximum size in bytes for
+	// each attachment. Zero means no limit.
+	MaxAttachmentSize int64 `help:"Maximum size in bytes for each email attachment, 0 means no limit"`
 }
 
 func init() {
@@ -0,0 +1,80 @@
+// +build !appengine
+
+package mail
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"gnd.la/config"
+)
+
+var maildirCounter uint64
+
+// consoleBackend prints the messages to the standard
+// output. It's intended to be used during development.
+type consoleBackend struct {
+}
+
+func (b *consoleBackend) Send(env *Envelope) error {
+	data, err := env.Bytes()
+	if err != nil {
+		return err
+	}
+	printer("%s\n", string(data))
+	return nil
+}
+
+// maildirBackend stores the messages in a maildir, so
+// they can be inspected with any mail client during
+// development.
+type maildirBackend struct {
+	dir string
+}
+
+func (b *maildirBackend) Send(env *Envelope) error {
+	data, err := env.Bytes()
+	if err != nil {
+		return err
+	}
+	host, _ := os.Hostname()
+	host = strings.NewReplacer("/", "\\057", ":", "\\072").Replace(host)
+	name := fmt.Sprintf("%d.M%dP%dQ%d.%s", time.Now().Unix(), time.Now().Nanosecond()/1000,
+		os.Getpid(), atomic.AddUint64(&maildirCounter, 1), host)
+	tmp := filepath.Join(b.dir, "tmp", name)
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(b.dir, "new", name))
+}
+
+func consoleOpener(u *config.URL) (Backend, error) {
+	return &consoleBackend{}, nil
+}
+
+func maildirOpener(u *config.URL) (Backend, error) {
+	if u.Value == "" {
+		return nil, errors.New("no directory specified, use file://{directory}")
+	}
+	dir, err := filepath.Abs(u.Value)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(dir, v), 0755); err != nil {
+			return nil, err
+		}
+	}
+	return &maildirBackend{dir: dir}, nil
+}
+
+func init() {
+	RegisterBackend("console", consoleOpener)
+	RegisterBackend("file", maildirOpener)
+}
@@ -0,0 +1,246 @@
+package mail
+
+import (
+	"bytes"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	cssCommentRe  = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	cssSelectorRe = regexp.MustCompile(`^([a-zA-Z][a-zA-Z0-9-]*|\*)?((?:[#.][a-zA-Z_-][a-zA-Z0-9_-]*)*)$`)
+)
+
+type cssDeclaration struct {
+	property string
+	value    string
+}
+
+// cssSelector represents a simple selector, which
+// might include a tag name, an id and several classes.
+type cssSelector struct {
+	tag     string
+	id      string
+	classes []string
+}
+
+func (s *cssSelector) specificity() int {
+	sp := len(s.classes) * 10
+	if s.id != "" {
+		sp += 100
+	}
+	if s.tag != "" {
+		sp++
+	}
+	return sp
+}
+
+func (s *cssSelector) matches(tok *htmlToken) bool {
+	if s.tag != "" && s.tag != tok.tag {
+		return false
+	}
+	if s.id != "" {
+		if id, _ := tok.attr("id"); id != s.id {
+			return false
+		}
+	}
+	if len(s.classes) > 0 {
+		class, _ := tok.attr("class")
+		classes := strings.Fields(class)
+	Classes:
+		for _, v := range s.classes {
+			for _, c := range classes {
+				if c == v {
+					continue Classes
+				}
+			}
+			return false
+		}
+	}
+	return true
+}
+
+func parseCSSSelector(s string) *cssSelector {
+	m := cssSelectorRe.FindStringSubmatch(s)
+	if m == nil || (m[1] == "" && m[2] == "") {
+		return nil
+	}
+	sel := &cssSelector{}
+	if m[1] != "*" {
+		sel.tag = strings.ToLower(m[1])
+	}
+	rest := m[2]
+	for rest != "" {
+		end := strings.IndexAny(rest[1:], "#.") + 1
+		if end == 0 {
+			end = len(rest)
+		}
+		if rest[0] == '#' {
+			sel.id = rest[1:end]
+		} else {
+			sel.classes = append(sel.classes, rest[1:end])
+		}
+		rest = rest[end:]
+	}
+	return sel
+}
+
+type cssRule struct {
+	selector     *cssSelector
+	declarations []cssDeclaration
+	order        int
+}
+
+type cssRules []*cssRule
+
+func (r cssRules) Len() int      { return len(r) }
+func (r cssRules) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r cssRules) Less(i, j int) bool {
+	si, sj := r[i].selector.specificity(), r[j].selector.specificity()
+	if si != sj {
+		return si < sj
+	}
+	return r[i].order < r[j].order
+}
+
+func parseCSSDeclarations(s string) []cssDeclaration {
+	var decls []cssDeclaration
+	for _, v := range strings.Split(s, ";") {
+		sep := strings.IndexByte(v, ':')
+		if sep < 0 {
+			continue
+		}
+		prop := strings.ToLower(strings.TrimSpace(v[:sep]))
+		value := strings.TrimSpace(v[sep+1:])
+		if prop == "" || value == "" {
+			continue
+		}
+		decls = append(decls, cssDeclaration{property: prop, value: value})
+	}
+	return decls
+}
+
+// parseCSSRules parses the rules in the given stylesheet. Rules with
+// selectors which can't be inlined (e.g. descendant selectors or
+// pseudo-classes) and at-rules (e.g. @media) are ignored.
+func parseCSSRules(css string, rules cssRules) cssRules {
+	css = cssCommentRe.ReplaceAllString(css, "")
+	for {
+		css = strings.TrimSpace(css)
+		if css == "" {
+			break
+		}
+		open := strings.IndexByte(css, '{')
+		if open < 0 {
+			break
+		}
+		if css[0] == '@' {
+			// Skip the whole at-rule, including nested blocks
+			if semi := strings.IndexByte(css, ';'); semi >= 0 && semi < open {
+				css = css[semi+1:]
+				continue
+			}
+			depth := 0
+			end := len(css)
+			for ii := open; ii < len(css); ii++ {
+				if css[ii] == '{' {
+					depth++
+				} else if css[ii] == '}' {
+					depth--
+					if depth == 0 {
+						end = ii + 1
+						break
+					}
+				}
+			}
+			css = css[end:]
+			continue
+		}
+		end := strings.IndexByte(css[open:], '}')
+		if end < 0 {
+			break
+		}
+		end += open
+		decls := parseCSSDeclarations(css[open+1 : end])
+		for _, v := range strings.Split(css[:open], ",") {
+			if sel := parseCSSSelector(strings.TrimSpace(v)); sel != nil && len(decls) > 0 {
+				rules = append(rules, &cssRule{selector: sel, declarations: decls, order: len(rules)})
+			}
+		}
+		css = css[end+1:]
+	}
+	return rules
+}
+
+// InlineCSS moves the rules declared in the <style> elements of the
+// given HTML document to style attributes in the elements matched by
+// them, since most email clients ignore stylesheets. Declarations
+// already present in the style attribute take precedence over the
+// ones in the stylesheets. Only simple selectors (e.g. p, .class,
+// a#id or td.class1.class2) are supported, rules using any other
+// selectors are left in the <style> element, which is not removed.
+func InlineCSS(s string) string {
+	tokens := tokenizeHTML(s)
+	var rules cssRules
+	for ii, tok := range tokens {
+		if tok.typ == htmlStartTag && tok.tag == "style" && ii+1 < len(tokens) && tokens[ii+1].typ == htmlText {
+			rules = parseCSSRules(tokens[ii+1].raw, rules)
+		}
+	}
+	if len(rules) == 0 {
+		return s
+	}
+	sort.Stable(rules)
+	var buf bytes.Buffer
+	head := false
+	for _, tok := range tokens {
+		if tok.tag == "head" {
+			head = tok.typ == htmlStartTag
+		}
+		if (tok.typ == htmlStartTag || tok.typ == htmlSelfClosingTag) && !head {
+			if inlineCSSRules(tok, rules) {
+				buf.WriteString(tok.String())
+				continue
+			}
+		}
+		buf.WriteString(tok.raw)
+	}
+	return buf.String()
+}
+
+func inlineCSSRules(tok *htmlToken, rules cssRules) bool {
+	var decls []cssDeclaration
+	for _, r := range rules {
+		if r.selector.matches(tok) {
+			decls = append(decls, r.declarations...)
+		}
+	}
+	if len(decls) == 0 {
+		return false
+	}
+	style, _ := tok.attr("style")
+	decls = append(decls, parseCSSDeclarations(style)...)
+	// Later declarations override the previous ones, but
+	// keep the position of the first one.
+	var props []string
+	values := make(map[string]string)
+	for _, v := range decls {
+		if _, ok := values[v.property]; !ok {
+			props = append(props, v.property)
+		}
+		values[v.property] = v.value
+	}
+	var buf bytes.Buffer
+	for ii, v := range props {
+		if ii > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(v)
+		buf.WriteString(": ")
+		buf.WriteString(values[v])
+		buf.WriteByte(';')
+	}
+	tok.setAttr("style", buf.String())
+	return true
+}
@@ -0,0 +1,224 @@
+// +build !appengine
+
+package mail
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	dkimKeys struct {
+		sync.RWMutex
+		keys map[string]*dkimKey
+	}
+	// dkimHeaders are the headers included in the signature,
+	// when they're present in the message.
+	dkimHeaders = []string{"From", "Reply-To", "To", "Cc", "Subject", "Date", "Message-ID", "MIME-Version", "Content-Type"}
+	// Changed for tests
+	dkimNow = time.Now
+)
+
+type dkimKey struct {
+	selector string
+	key      *rsa.PrivateKey
+}
+
+// SetDKIM sets the selector and the private key used for signing with
+// DKIM the messages sent from the given domain via SMTP. The domain is
+// matched against the domain of the From address, and it's also used as
+// the signing domain. The public key must be published in a TXT record
+// at {selector}._domainkey.{domain}. Setting a nil key disables DKIM
+// signing for the domain. See also ParseDKIMKey.
+func SetDKIM(domain string, selector string, key *rsa.PrivateKey) {
+	domain = strings.ToLower(domain)
+	dkimKeys.Lock()
+	defer dkimKeys.Unlock()
+	if key == nil {
+		delete(dkimKeys.keys, domain)
+		return
+	}
+	if dkimKeys.keys == nil {
+		dkimKeys.keys = make(map[string]*dkimKey)
+	}
+	dkimKeys.keys[domain] = &dkimKey{selector: selector, key: key}
+}
+
+// ParseDKIMKey parses a PEM encoded RSA private key, either in PKCS#1
+// (BEGIN RSA PRIVATE KEY) or PKCS#8 (BEGIN PRIVATE KEY) format, which
+// can be used with SetDKIM.
+func ParseDKIMKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := k.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("DKIM key must be an RSA key, not %T", k)
+	}
+	return key, nil
+}
+
+func dkimKeyFor(from string) (string, *dkimKey) {
+	addr, err := mail.ParseAddress(from)
+	if err != nil {
+		return "", nil
+	}
+	at := strings.LastIndex(addr.Address, "@")
+	if at < 0 {
+		return "", nil
+	}
+	domain := strings.ToLower(addr.Address[at+1:])
+	dkimKeys.RLock()
+	key := dkimKeys.keys[domain]
+	dkimKeys.RUnlock()
+	return domain, key
+}
+
+// signDKIM signs the message using the DKIM key for the domain of the
+// from address, if any, returning the message with the DKIM-Signature
+// header prepended. If there's no key for the domain, the message is
+// returned unmodified.
+func signDKIM(from string, data []byte) ([]byte, error) {
+	domain, key := dkimKeyFor(from)
+	if key == nil {
+		return data, nil
+	}
+	header, body := splitMessage(data)
+	bodyHash := sha256.Sum256(dkimCanonicalBody(body))
+	fields := parseHeaderFields(header)
+	var signed []string
+	h := sha256.New()
+	for _, name := range dkimHeaders {
+		lower := strings.ToLower(name)
+		for _, f := range fields {
+			if strings.ToLower(f.name) == lower {
+				h.Write([]byte(dkimCanonicalHeader(f.raw)))
+				signed = append(signed, lower)
+				break
+			}
+		}
+	}
+	sig := fmt.Sprintf("DKIM-Signature: v=1; a=rsa-sha256; c=relaxed/relaxed; d=%s; s=%s;\r\n\tt=%d; h=%s;\r\n\tbh=%s;\r\n\tb=",
+		domain, key.selector, dkimNow().Unix(), strings.Join(signed, ":"), base64.StdEncoding.EncodeToString(bodyHash[:]))
+	h.Write([]byte(strings.TrimSuffix(dkimCanonicalHeader(sig), "\r\n")))
+	b, err := rsa.SignPKCS1v15(rand.Reader, key.key, crypto.SHA256, h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(sig)
+	buf.WriteString(base64.StdEncoding.EncodeToString(b))
+	buf.WriteString("\r\n")
+	buf.Write(data)
+	return buf.Bytes(), nil
+}
+
+type headerField struct {
+	name string
+	// raw contains the whole field, including
+	// the continuation lines and the final CRLF.
+	raw string
+}
+
+// splitMessage returns the header and the body of the given message.
+// The returned header includes the final CRLF of the last field, but
+// not the empty line separating it from the body.
+func splitMessage(data []byte) (string, []byte) {
+	if p := bytes.Index(data, []byte("\r\n\r\n")); p >= 0 {
+		return string(data[:p+2]), data[p+4:]
+	}
+	return string(data), nil
+}
+
+func parseHeaderFields(header string) []*headerField {
+	var fields []*headerField
+	for _, line := range strings.SplitAfter(header, "\r\n") {
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(fields) > 0 {
+			fields[len(fields)-1].raw += line
+			continue
+		}
+		name := line
+		if colon := strings.IndexByte(line, ':'); colon >= 0 {
+			name = line[:colon]
+		}
+		fields = append(fields, &headerField{name: strings.TrimSpace(name), raw: line})
+	}
+	return fields
+}
+
+func isWSP(c byte) bool {
+	return c == ' ' || c == '\t'
+}
+
+// compactWSP replaces every sequence of whitespace with a single space.
+func compactWSP(s string) string {
+	var buf bytes.Buffer
+	space := false
+	for ii := 0; ii < len(s); ii++ {
+		if isWSP(s[ii]) {
+			space = true
+			continue
+		}
+		if space {
+			buf.WriteByte(' ')
+			space = false
+		}
+		buf.WriteByte(s[ii])
+	}
+	if space {
+		buf.WriteByte(' ')
+	}
+	return buf.String()
+}
+
+// dkimCanonicalHeader returns the header field canonicalized
+// using the relaxed algorithm defined in RFC 6376, section 3.4.2.
+func dkimCanonicalHeader(field string) string {
+	field = strings.Replace(field, "\r\n", "", -1)
+	colon := strings.IndexByte(field, ':')
+	if colon < 0 {
+		return strings.ToLower(strings.TrimSpace(field)) + ":\r\n"
+	}
+	name := strings.ToLower(strings.TrimRight(field[:colon], " \t"))
+	value := strings.Trim(compactWSP(field[colon+1:]), " ")
+	return name + ":" + value + "\r\n"
+}
+
+// dkimCanonicalBody returns the body canonicalized using the
+// relaxed algorithm defined in RFC 6376, section 3.4.4.
+func dkimCanonicalBody(body []byte) []byte {
+	lines := strings.Split(string(body), "\r\n")
+	for ii, v := range lines {
+		lines[ii] = strings.TrimRight(compactWSP(v), " ")
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return []byte(strings.Join(lines, "\r\n") + "\r\n")
+}
@@ -0,0 +1,111 @@
+// +build !appengine
+
+package mail
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDKIMCanonicalization(t *testing.T) {
+	// Examples from RFC 6376, section 3.4.5
+	if h := dkimCanonicalHeader("A: X\r\n"); h != "a:X\r\n" {
+		t.Errorf("invalid canonical header %q", h)
+	}
+	if h := dkimCanonicalHeader("B : Y\t\r\n\tZ  \r\n"); h != "b:Y Z\r\n" {
+		t.Errorf("invalid canonical header %q", h)
+	}
+	if b := dkimCanonicalBody([]byte(" C \r\nD \t E\r\n\r\n\r\n")); string(b) != " C\r\nD E\r\n" {
+		t.Errorf("invalid canonical body %q", string(b))
+	}
+	if b := dkimCanonicalBody([]byte("\r\n\r\n")); len(b) != 0 {
+		t.Errorf("invalid canonical empty body %q", string(b))
+	}
+}
+
+func TestDKIMSign(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetDKIM("Example.com", "test", key)
+	defer SetDKIM("example.com", "", nil)
+	p := printer
+	defer func() {
+		printer = p
+	}()
+	var res string
+	printer = func(format string, args ...interface{}) (int, error) {
+		res = fmt.Sprintf(format, args...)
+		return len(res), nil
+	}
+	dkimNow = func() time.Time { return time.Unix(1400000000, 0) }
+	defer func() {
+		dkimNow = time.Now
+	}()
+	msg := &Message{
+		Server:   "echo",
+		From:     "Sender <sender@example.com>",
+		To:       "receiver@example.com",
+		Subject:  "Hello",
+		TextBody: "Hello  world \n\n",
+	}
+	if err := Send(msg); err != nil {
+		t.Fatal(err)
+	}
+	header, body := splitMessage([]byte(res))
+	fields := parseHeaderFields(header)
+	if len(fields) == 0 || fields[0].name != "DKIM-Signature" {
+		t.Fatalf("message does not start with DKIM-Signature: %q", res)
+	}
+	sig := fields[0].raw
+	tags := make(map[string]string)
+	for _, v := range strings.Split(strings.TrimPrefix(dkimCanonicalHeader(sig), "dkim-signature:"), ";") {
+		if eq := strings.IndexByte(v, '='); eq >= 0 {
+			tags[strings.TrimSpace(v[:eq])] = strings.TrimSpace(v[eq+1:])
+		}
+	}
+	if tags["d"] != "example.com" || tags["s"] != "test" || tags["t"] != "1400000000" || tags["h"] != "from:to:subject:mime-version:content-type" {
+		t.Errorf("invalid DKIM tags %v", tags)
+	}
+	bodyHash := sha256.Sum256(dkimCanonicalBody(body))
+	if bh := base64.StdEncoding.EncodeToString(bodyHash[:]); tags["bh"] != bh {
+		t.Errorf("invalid body hash %q, expecting %q", tags["bh"], bh)
+	}
+	// Verify the signature
+	h := sha256.New()
+	for _, name := range strings.Split(tags["h"], ":") {
+		for _, f := range fields[1:] {
+			if strings.ToLower(f.name) == name {
+				h.Write([]byte(dkimCanonicalHeader(f.raw)))
+				break
+			}
+		}
+	}
+	b := strings.Index(sig, "\tb=")
+	unsigned := sig[:b+3] + "\r\n"
+	h.Write([]byte(strings.TrimSuffix(dkimCanonicalHeader(unsigned), "\r\n")))
+	signature, err := base64.StdEncoding.DecodeString(tags["b"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, h.Sum(nil), signature); err != nil {
+		t.Errorf("invalid DKIM signature: %s", err)
+	}
+	// Messages from other domains are not signed
+	msg.From = "sender@example.org"
+	if err := Send(msg); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains([]byte(res), []byte("DKIM-Signature")) {
+		t.Errorf("message from other domain was signed")
+	}
+}
@@ -0,0 +1,218 @@
+package mail
+
+import (
+	"bytes"
+	"html"
+	"strings"
+)
+
+type htmlTokenType int
+
+const (
+	htmlText htmlTokenType = iota
+	htmlStartTag
+	htmlEndTag
+	htmlSelfClosingTag
+	// comments, doctypes and processing instructions
+	htmlOther
+)
+
+type htmlAttr struct {
+	key   string
+	value string
+}
+
+// htmlToken is a token in an HTML document. This is a
+// small and forgiving tokenizer, which only handles
+// what's required for generating emails.
+type htmlToken struct {
+	typ   htmlTokenType
+	raw   string
+	tag   string
+	attrs []htmlAttr
+}
+
+func (t *htmlToken) attr(key string) (string, bool) {
+	for _, v := range t.attrs {
+		if v.key == key {
+			return v.value, true
+		}
+	}
+	return "", false
+}
+
+func (t *htmlToken) setAttr(key string, value string) {
+	for ii, v := range t.attrs {
+		if v.key == key {
+			t.attrs[ii].value = value
+			return
+		}
+	}
+	t.attrs = append(t.attrs, htmlAttr{key: key, value: value})
+}
+
+// String returns the token as HTML. Tags are regenerated from
+// their attributes, everything else is returned verbatim.
+func (t *htmlToken) String() string {
+	if t.typ != htmlStartTag && t.typ != htmlSelfClosingTag {
+		return t.raw
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('<')
+	buf.WriteString(t.tag)
+	for _, v := range t.attrs {
+		buf.WriteByte(' ')
+		buf.WriteString(v.key)
+		buf.WriteString(`="`)
+		buf.WriteString(html.EscapeString(v.value))
+		buf.WriteByte('"')
+	}
+	if t.typ == htmlSelfClosingTag {
+		buf.WriteString(" /")
+	}
+	buf.WriteByte('>')
+	return buf.String()
+}
+
+// htmlRawTextTags are the tags which contain text which
+// must not be parsed as HTML.
+var htmlRawTextTags = map[string]bool{
+	"script": true,
+	"style":  true,
+}
+
+func tokenizeHTML(s string) []*htmlToken {
+	var tokens []*htmlToken
+	for len(s) > 0 {
+		lt := strings.IndexByte(s, '<')
+		if lt != 0 {
+			if lt < 0 {
+				lt = len(s)
+			}
+			tokens = append(tokens, &htmlToken{typ: htmlText, raw: s[:lt]})
+			s = s[lt:]
+			continue
+		}
+		if strings.HasPrefix(s, "<!--") {
+			end := strings.Index(s, "-->")
+			if end < 0 {
+				end = len(s)
+			} else {
+				end += 3
+			}
+			tokens = append(tokens, &htmlToken{typ: htmlOther, raw: s[:end]})
+			s = s[end:]
+			continue
+		}
+		if len(s) > 1 && (s[1] == '!' || s[1] == '?') {
+			end := strings.IndexByte(s, '>') + 1
+			if end <= 0 {
+				end = len(s)
+			}
+			tokens = append(tokens, &htmlToken{typ: htmlOther, raw: s[:end]})
+			s = s[end:]
+			continue
+		}
+		tok, n := parseHTMLTag(s)
+		if tok == nil {
+			// Not a tag, just a < character
+			tokens = append(tokens, &htmlToken{typ: htmlText, raw: s[:1]})
+			s = s[1:]
+			continue
+		}
+		tokens = append(tokens, tok)
+		s = s[n:]
+		if tok.typ == htmlStartTag && htmlRawTextTags[tok.tag] {
+			end := strings.Index(strings.ToLower(s), "</"+tok.tag)
+			if end < 0 {
+				end = len(s)
+			}
+			if end > 0 {
+				tokens = append(tokens, &htmlToken{typ: htmlText, raw: s[:end]})
+				s = s[end:]
+			}
+		}
+	}
+	return tokens
+}
+
+func isHTMLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// parseHTMLTag parses the tag at the start of s, returning the
+// token and its length. If s doesn't start with a tag, it returns
+// nil.
+func parseHTMLTag(s string) (*htmlToken, int) {
+	pos := 1
+	typ := htmlStartTag
+	if pos < len(s) && s[pos] == '/' {
+		typ = htmlEndTag
+		pos++
+	}
+	start := pos
+	for pos < len(s) && !isHTMLSpace(s[pos]) && s[pos] != '>' && s[pos] != '/' {
+		pos++
+	}
+	if pos == start {
+		return nil, 0
+	}
+	tok := &htmlToken{typ: typ, tag: strings.ToLower(s[start:pos])}
+	for {
+		for pos < len(s) && isHTMLSpace(s[pos]) {
+			pos++
+		}
+		if pos >= len(s) {
+			return nil, 0
+		}
+		if s[pos] == '>' {
+			pos++
+			break
+		}
+		if s[pos] == '/' {
+			pos++
+			if pos < len(s) && s[pos] == '>' {
+				if tok.typ == htmlStartTag {
+					tok.typ = htmlSelfClosingTag
+				}
+				pos++
+				break
+			}
+			continue
+		}
+		keyStart := pos
+		for pos < len(s) && !isHTMLSpace(s[pos]) && s[pos] != '>' && s[pos] != '=' && s[pos] != '/' {
+			pos++
+		}
+		attr := htmlAttr{key: strings.ToLower(s[keyStart:pos])}
+		for pos < len(s) && isHTMLSpace(s[pos]) {
+			pos++
+		}
+		if pos < len(s) && s[pos] == '=' {
+			pos++
+			for pos < len(s) && isHTMLSpace(s[pos]) {
+				pos++
+			}
+			if pos < len(s) && (s[pos] == '"' || s[pos] == '\'') {
+				q := s[pos]
+				end := strings.IndexByte(s[pos+1:], q)
+				if end < 0 {
+					return nil, 0
+				}
+				attr.value = html.UnescapeString(s[pos+1 : pos+1+end])
+				pos += end + 2
+			} else {
+				valueStart := pos
+				for pos < len(s) && !isHTMLSpace(s[pos]) && s[pos] != '>' {
+					pos++
+				}
+				attr.value = html.UnescapeString(s[valueStart:pos])
+			}
+		}
+		if attr.key != "" {
+			tok.attrs = append(tok.attrs, attr)
+		}
+	}
+	tok.raw = s[:pos]
+	return tok, pos
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"mime"
+	"net/http"
 	"net/mail"
 	"path"
 
@@ -60,11 +61,20 @@ func MustParseAddressList(s string) []string {
 type Headers map[string]string
 
 // Attachment represents an email attachment.
-// See the conveniency function NewAttachment.
+// See the conveniency functions NewAttachment,
+// NewReaderAttachment and NewFileAttachment.
 type Attachment struct {
-	Name        string
+	Name string
+	// ContentType is the attachment MIME type. If empty,
+	// it's detected from the attachment data when the
+	// message is sent.
 	ContentType string
 	Data        []byte
+	// Open, if non-nil, is called to read the attachment data
+	// when the message is sent, rather than using Data. This
+	// allows sending large attachments without loading them
+	// into memory. See also Load.
+	Open func() (io.ReadCloser, error) `json:"-"`
 	// ContentID is used to reference attachments from
 	// the message HTML body. Note that attachments with
 	// a non-empty ContentID which is referenced from the
@@ -75,7 +85,8 @@ type Attachment struct {
 }
 
 // NewAttachment returns a new attachment which can be included in the
-// Message passed to Send(). The ContentType is derived from the file name.
+// Message passed to Send(). The ContentType is derived from the file name
+// or, if that's not possible, from the data itself.
 func NewAttachment(filename string, r io.Reader) (*Attachment, error) {
 	data, err := ioutil.ReadAll(r)
 	if err != nil {
@@ -87,7 +98,7 @@ func NewAttachment(filename string, r io.Reader) (*Attachment, error) {
 	}
 	contentType := mime.TypeByExtension(path.Ext(filename))
 	if contentType == "" {
-		contentType = "application/octet-stream"
+		contentType = http.DetectContentType(data)
 	}
 	return &Attachment{Name: filename, ContentType: contentType, Data: data}, nil
 }
@@ -132,28 +143,42 @@ type Message struct {
 // further information.
 // This function does not work on App Engine. Use gnd.la/app.Context.SendMail.
 func Send(msg *Message) error {
+	to, cc, bcc, err := validateMessage(msg)
+	if err != nil {
+		return err
+	}
+	return sendMail(to, cc, bcc, msg)
+}
+
+// ValidateMessage returns an error if the given message can't be sent
+// because it has no body, no destinataries or they can't be parsed.
+func ValidateMessage(msg *Message) error {
+	_, _, _, err := validateMessage(msg)
+	return err
+}
+
+func validateMessage(msg *Message) (to []string, cc []string, bcc []string, err error) {
 	if msg == nil {
-		return errNoMessage
+		err = errNoMessage
+		return
 	}
 	if msg.TextBody == "" && msg.HTMLBody == "" {
-		return errNoBody
+		err = errNoBody
+		return
 	}
-	to, err := parseDestinataries(msg.To, "To")
-	if err != nil {
-		return err
+	if to, err = parseDestinataries(msg.To, "To"); err != nil {
+		return
 	}
-	cc, err := parseDestinataries(msg.Cc, "Cc")
-	if err != nil {
-		return err
+	if cc, err = parseDestinataries(msg.Cc, "Cc"); err != nil {
+		return
 	}
-	bcc, err := parseDestinataries(msg.Bcc, "Bcc")
-	if err != nil {
-		return err
+	if bcc, err = parseDestinataries(msg.Bcc, "Bcc"); err != nil {
+		return
 	}
 	if len(to) == 0 && len(cc) == 0 && len(bcc) == 0 {
-		return errNoDestinataries
+		err = errNoDestinataries
 	}
-	return sendMail(to, cc, bcc, msg)
+	return
 }
 
 // DefaultServer returns the default mail server address.
@@ -164,7 +189,9 @@ func Send(msg *Message) error {
 // prefix the username with "cram?" - witout quotes, otherwise PLAIN
 // authentication is used. Additionally, the special value "echo" can be
 // used for testing, and will cause the email to be printed
-// to the standard output, rather than sent. The following are valid examples
+// to the standard output, rather than sent. Messages might also be delivered
+// using a Backend, by specifying a URL whose scheme names the backend (see
+// RegisterBackend for the available ones). The following are valid examples
 // of server addresses.
 //
 //  - localhost
@@ -172,6 +199,11 @@ func Send(msg *Message) error {
 //  - user@gmail.com:patata@smtp.gmail.com
 //  - cram?pepe:12345@example.com
 //  - echo
+//  - ses://us-east-1
+//  - file:///tmp/maildir
+//
+// Messages sent via SMTP are signed with DKIM when there's a key for
+// the domain of the From address. See SetDKIM for more information.
 //
 // The default server value is localhost:25.
 func DefaultServer() string {
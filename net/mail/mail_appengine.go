@@ -54,6 +54,9 @@ func sendMail(to []string, cc []string, bcc []string, msg *Message) error {
 		Headers:  make(nmail.Header),
 	}
 	for _, v := range msg.Attachments {
+		if err := v.Load(); err != nil {
+			return err
+		}
 		gaeMsg.Attachments = append(gaeMsg.Attachments, mail.Attachment{
 			Name:      v.Name,
 			Data:      v.Data,
@@ -4,11 +4,13 @@ package mail
 
 import (
 	"bytes"
+	"crypto/tls"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
+	"net"
 	"net/smtp"
 	"net/textproto"
 	"strings"
@@ -22,23 +24,33 @@ var (
 )
 
 func joinAddrs(addrs []string) (string, error) {
+	values, err := expandAddrs(addrs)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(values, ", "), nil
+}
+
+// expandAddrs replaces the Admin pseudo-address with the
+// addresses returned by AdminEmail().
+func expandAddrs(addrs []string) ([]string, error) {
 	var values []string
 	for _, v := range addrs {
 		if v == Admin {
 			addr := AdminEmail()
 			if addr == "" {
-				return "", errNoAdminEmail
+				return nil, errNoAdminEmail
 			}
 			addrs, err := ParseAddressList(addr)
 			if err != nil {
-				return "", fmt.Errorf("invalid admin address %q: %s", addr, err)
+				return nil, fmt.Errorf("invalid admin address %q: %s", addr, err)
 			}
 			values = append(values, addrs...)
 			continue
 		}
 		values = append(values, v)
 	}
-	return strings.Join(values, ", "), nil
+	return values, nil
 }
 
 func makeBoundary() string {
@@ -57,6 +69,23 @@ func sendMail(to []string, cc []string, bcc []string, msg *Message) error {
 	if from == "" {
 		return errNoFrom
 	}
+	if strings.Contains(server, "://") {
+		backend, err := openBackend(server)
+		if err != nil {
+			return err
+		}
+		env := &Envelope{From: from, Message: msg}
+		if env.To, err = expandAddrs(to); err != nil {
+			return err
+		}
+		if env.Cc, err = expandAddrs(cc); err != nil {
+			return err
+		}
+		if env.Bcc, err = expandAddrs(bcc); err != nil {
+			return err
+		}
+		return backend.Send(env)
+	}
 	var auth smtp.Auth
 	cram, username, password, server := parseServer(server)
 	if username != "" || password != "" {
@@ -66,7 +95,99 @@ func sendMail(to []string, cc []string, bcc []string, msg *Message) error {
 			auth = smtp.PlainAuth("", username, password, server)
 		}
 	}
+	if _, key := dkimKeyFor(from); key == nil && server != "echo" {
+		// Stream the message directly to the server
+		return sendSMTP(server, auth, from, smtpRecipients(to, cc, bcc), func(w io.Writer) error {
+			return writeMessage(w, from, to, cc, bcc, msg)
+		})
+	}
+	// The message must be buffered, since the DKIM signature
+	// is computed from the whole body and goes into the header.
+	data, err := buildMessage(from, to, cc, bcc, msg)
+	if err != nil {
+		return err
+	}
+	if data, err = signDKIM(from, data); err != nil {
+		return err
+	}
+	if server == "echo" {
+		printer(string(data))
+		return nil
+	}
+	return sendSMTP(server, auth, from, smtpRecipients(to, cc, bcc), func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+// smtpRecipients returns all the addresses the message must
+// be delivered to, since Cc and Bcc recipients also need their
+// own RCPT command.
+func smtpRecipients(to []string, cc []string, bcc []string) []string {
+	rcpt := make([]string, 0, len(to)+len(cc)+len(bcc))
+	rcpt = append(rcpt, to...)
+	rcpt = append(rcpt, cc...)
+	return append(rcpt, bcc...)
+}
+
+// sendSMTP works like smtp.SendMail, but the message is written to
+// the connection by the write function. If write returns an error,
+// the connection is closed without finishing the message, so a
+// partial message is never delivered.
+func sendSMTP(addr string, auth smtp.Auth, from string, to []string, write func(w io.Writer) error) error {
+	c, err := smtp.Dial(addr)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		host, _, _ := net.SplitHostPort(addr)
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := c.Extension("AUTH"); ok {
+			if err := c.Auth(auth); err != nil {
+				return err
+			}
+		}
+	}
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	for _, v := range to {
+		if err := c.Rcpt(v); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if err := write(w); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// buildMessage returns the given message encoded as MIME, ready
+// to be sent.
+func buildMessage(from string, to []string, cc []string, bcc []string, msg *Message) ([]byte, error) {
 	var buf bytes.Buffer
+	if err := writeMessage(&buf, from, to, cc, bcc, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeMessage writes the given message encoded as MIME to w. Attachment
+// data is streamed to w, so it's never completely loaded into memory
+// unless the Attachment itself contains it.
+func writeMessage(w io.Writer, from string, to []string, cc []string, bcc []string, msg *Message) error {
 	headers := msg.Headers
 	if headers == nil {
 		headers = make(Headers)
@@ -98,13 +219,13 @@ func sendMail(to []string, cc []string, bcc []string, msg *Message) error {
 		}
 	}
 	for k, v := range headers {
-		buf.WriteString(fmt.Sprintf("%s: %s\r\n", k, v))
+		fmt.Fprintf(w, "%s: %s\r\n", k, v)
 	}
-	buf.WriteString("MIME-Version: 1.0\r\n")
-	mw := multipart.NewWriter(&buf)
+	io.WriteString(w, "MIME-Version: 1.0\r\n")
+	mw := multipart.NewWriter(w)
 	mw.SetBoundary(makeBoundary())
 	// Create a multipart mixed first
-	fmt.Fprintf(&buf, "Content-Type: multipart/mixed;\r\n\tboundary=%q\r\n\r\n", mw.Boundary())
+	fmt.Fprintf(w, "Content-Type: multipart/mixed;\r\n\tboundary=%q\r\n\r\n", mw.Boundary())
 	var bodyWriter *multipart.Writer
 	if msg.TextBody != "" && msg.HTMLBody != "" {
 		boundary := makeBoundary()
@@ -169,17 +290,11 @@ func sendMail(to []string, cc []string, bcc []string, msg *Message) error {
 		thw.Write(crlf)
 		for _, v := range htmlAttachments {
 			attachmentHeader := make(textproto.MIMEHeader)
-			attachmentHeader.Set("Content-Type", v.ContentType)
 			attachmentHeader.Set("Content-Disposition", "inline")
 			attachmentHeader.Set("Content-ID", fmt.Sprintf("<%s>", v.ContentID))
-			attachmentHeader.Set("Content-Transfer-Encoding", "base64")
-			aw, err := htmlWriter.CreatePart(attachmentHeader)
-			if err != nil {
+			if err := writeAttachment(htmlWriter, attachmentHeader, v); err != nil {
 				return err
 			}
-			b := make([]byte, base64.StdEncoding.EncodedLen(len(v.Data)))
-			base64.StdEncoding.Encode(b, v.Data)
-			aw.Write(b)
 		}
 		if htmlWriter != bodyWriter {
 			if err := htmlWriter.Close(); err != nil {
@@ -197,25 +312,34 @@ func sendMail(to []string, cc []string, bcc []string, msg *Message) error {
 			continue
 		}
 		attachmentHeader := make(textproto.MIMEHeader)
-		attachmentHeader.Set("Content-Type", v.ContentType)
 		attachmentHeader.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", v.Name))
-		attachmentHeader.Set("Content-Transfer-Encoding", "base64")
-		aw, err := mw.CreatePart(attachmentHeader)
-		if err != nil {
+		if err := writeAttachment(mw, attachmentHeader, v); err != nil {
 			return err
 		}
-		b := make([]byte, base64.StdEncoding.EncodedLen(len(v.Data)))
-		base64.StdEncoding.Encode(b, v.Data)
-		aw.Write(b)
 	}
-	if err := mw.Close(); err != nil {
+	return mw.Close()
+}
+
+// writeAttachment creates a new part in mw with the given header and
+// writes the attachment data to it, encoded as base64. The Content-Type
+// and Content-Transfer-Encoding headers are set by this function.
+func writeAttachment(mw *multipart.Writer, header textproto.MIMEHeader, a *Attachment) error {
+	contentType, r, err := a.reader()
+	if err != nil {
 		return err
 	}
-	if server == "echo" {
-		printer(buf.String())
-		return nil
+	defer r.Close()
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Transfer-Encoding", "base64")
+	aw, err := mw.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	enc := base64.NewEncoder(base64.StdEncoding, aw)
+	if _, err := io.Copy(enc, r); err != nil {
+		return err
 	}
-	return smtp.SendMail(server, auth, from, to, buf.Bytes())
+	return enc.Close()
 }
 
 func parseServer(server string) (bool, string, string, string) {
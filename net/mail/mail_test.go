@@ -1,11 +1,15 @@
 package mail
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net/textproto"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 	"text/template"
 )
@@ -151,3 +155,179 @@ func TestSendEmail(t *testing.T) {
 		}
 	}
 }
+
+func TestHTMLToText(t *testing.T) {
+	cases := []struct {
+		html string
+		text string
+	}{
+		{"<p>Hello <b>world</b>!</p>", "Hello world!"},
+		{"<html><head><title>Title</title><style>p { color: red; }</style></head><body><h1>Header</h1><p>First\n   paragraph</p><p>Second</p></body></html>", "Header\n\nFirst paragraph\n\nSecond"},
+		{"Line 1<br>Line 2<br/>Line 3", "Line 1\nLine 2\nLine 3"},
+		{"<ul><li>One</li><li>Two</li></ul>", "* One\n* Two"},
+		{`Visit <a href="http://www.example.com">our site</a>.`, "Visit our site (http://www.example.com)."},
+		{`<a href="#top">Top</a> <a href="mailto:a@example.com">Mail</a>`, "Top Mail"},
+		{`<img src="cid:logo.png" alt="Logo"> &amp; &lt;more&gt;&nbsp;`, "Logo & <more>"},
+		{"<pre>a\n  b</pre><p>c</p>", "a\n  b\n\nc"},
+		{"<table><tr><td>A</td><td>B</td></tr><tr><td>C</td><td>D</td></tr></table>", "A B\nC D"},
+		{"1 < 2", "1 < 2"},
+	}
+	for _, v := range cases {
+		if text := HTMLToText(v.html); text != v.text {
+			t.Errorf("expecting text %q from %q, got %q", v.text, v.html, text)
+		}
+	}
+}
+
+func TestInlineCSS(t *testing.T) {
+	cases := []struct {
+		html   string
+		result string
+	}{
+		{
+			"<style>p { color: red; }</style><p>Hello</p>",
+			`<style>p { color: red; }</style><p style="color: red;">Hello</p>`,
+		},
+		{
+			"<style>.a { color: red; font-weight: bold } p { color: blue }</style><p class=\"a\">A</p><p>B</p>",
+			"<style>.a { color: red; font-weight: bold } p { color: blue }</style><p class=\"a\" style=\"color: red; font-weight: bold;\">A</p><p style=\"color: blue;\">B</p>",
+		},
+		{
+			"<style>#b { color: red } p.a, div { color: blue }</style><p id=\"b\" class=\"a\" style=\"color: green\">A</p><div>B</div>",
+			"<style>#b { color: red } p.a, div { color: blue }</style><p id=\"b\" class=\"a\" style=\"color: green;\">A</p><div style=\"color: blue;\">B</div>",
+		},
+		{
+			"<style>@media (max-width: 600px) { p { color: red } } div p { color: red } /* comment */ a:hover { color: red }</style><p>A</p>",
+			"<style>@media (max-width: 600px) { p { color: red } } div p { color: red } /* comment */ a:hover { color: red }</style><p>A</p>",
+		},
+		{
+			"<html><head><title>T</title><style>title, img { border: 0 }</style></head><body><img src=\"a.png\"/></body></html>",
+			"<html><head><title>T</title><style>title, img { border: 0 }</style></head><body><img src=\"a.png\" style=\"border: 0;\" /></body></html>",
+		},
+	}
+	for _, v := range cases {
+		if result := InlineCSS(v.html); result != v.result {
+			t.Errorf("expecting %q from %q, got %q", v.result, v.html, result)
+		}
+	}
+}
+
+type testTemplateContext struct {
+	body        string
+	contentType string
+	msg         *Message
+}
+
+func (c *testTemplateContext) RenderTemplate(name string, data interface{}) (string, string, error) {
+	return c.body, c.contentType, nil
+}
+
+func (c *testTemplateContext) LoadAsset(name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join("testdata", name))
+}
+
+func (c *testTemplateContext) SendMail(template string, data interface{}, msg *Message) error {
+	c.msg = msg
+	return nil
+}
+
+func TestSendTemplate(t *testing.T) {
+	ctx := &testTemplateContext{
+		body:        "<html><head><title>Hello\n lenna</title><style>p { margin: 0 }</style></head><body><p>Hi</p><img src=\"cid:lenna.jpg\" alt=\"Lenna\"></body></html>",
+		contentType: "text/html; charset=utf-8",
+	}
+	if err := SendTemplate(ctx, "test.html", nil, &Message{To: "test@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	msg := ctx.msg
+	if msg.Subject != "Hello lenna" {
+		t.Errorf("expecting subject %q, got %q", "Hello lenna", msg.Subject)
+	}
+	if msg.TextBody != "Hi\n\nLenna" {
+		t.Errorf("expecting text body %q, got %q", "Hi\n\nLenna", msg.TextBody)
+	}
+	if !strings.Contains(msg.HTMLBody, `<p style="margin: 0;">`) {
+		t.Errorf("CSS was not inlined in %q", msg.HTMLBody)
+	}
+	if len(msg.Attachments) != 1 {
+		t.Fatalf("expecting 1 attachment, got %d", len(msg.Attachments))
+	}
+	if a := msg.Attachments[0]; a.ContentID != "lenna.jpg" || a.ContentType != "image/jpeg" || len(a.Data) == 0 {
+		t.Errorf("invalid attachment %s (%s, %s, %d bytes)", a.Name, a.ContentID, a.ContentType, len(a.Data))
+	}
+	ctx.contentType = "text/plain"
+	ctx.body = "Hello"
+	if err := SendTemplate(ctx, "test.txt", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if ctx.msg.TextBody != "Hello" || ctx.msg.HTMLBody != "" {
+		t.Errorf("invalid text message %+v", ctx.msg)
+	}
+}
+
+func TestClassifyBounce(t *testing.T) {
+	cases := []struct {
+		err    error
+		bounce Bounce
+	}{
+		{nil, NoBounce},
+		{&textproto.Error{Code: 421, Msg: "4.7.0 Try again later"}, SoftBounce},
+		{&textproto.Error{Code: 550, Msg: "5.1.1 User unknown"}, HardBounce},
+		{&textproto.Error{Code: 552, Msg: "5.2.2 Mailbox full"}, SoftBounce},
+		{&textproto.Error{Code: 554, Msg: "5.2.2 Mailbox full"}, SoftBounce},
+		{&BackendError{Backend: "test", StatusCode: 429}, SoftBounce},
+		{&BackendError{Backend: "test", StatusCode: 503}, SoftBounce},
+		{&BackendError{Backend: "test", StatusCode: 400}, HardBounce},
+		{errNoDestinataries, HardBounce},
+		{errors.New("unknown"), SoftBounce},
+	}
+	for _, v := range cases {
+		if b := ClassifyBounce(v.err); b != v.bounce {
+			t.Errorf("expecting %s bounce for %v, got %s", v.bounce, v.err, b)
+		}
+	}
+}
+
+func TestAttachments(t *testing.T) {
+	c := Config.MaxAttachmentSize
+	defer func() {
+		Config.MaxAttachmentSize = c
+	}()
+	a := NewReaderAttachment("lenna", strings.NewReader("\x89PNG\x0D\x0A\x1A\x0A"))
+	if err := a.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if a.ContentType != "image/png" || len(a.Data) != 8 || a.Open != nil {
+		t.Errorf("invalid loaded attachment %+v", a)
+	}
+	a = NewReaderAttachment("file.txt", strings.NewReader("hello"))
+	if a.ContentType != "text/plain; charset=utf-8" {
+		t.Errorf("expecting content type from extension, got %q", a.ContentType)
+	}
+	if err := a.Load(); err != nil {
+		t.Fatal(err)
+	}
+	a = NewReaderAttachment("file.txt", strings.NewReader("hello"))
+	a.Open()
+	if err := a.Load(); err != errAttachmentRead {
+		t.Errorf("expecting error %v when reading twice, got %v", errAttachmentRead, err)
+	}
+	f, err := NewFileAttachment(filepath.Join("testdata", "lenna.jpg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Name != "lenna.jpg" || f.ContentType != "image/jpeg" {
+		t.Errorf("invalid file attachment %+v", f)
+	}
+	Config.MaxAttachmentSize = 1024
+	if _, err := NewFileAttachment(filepath.Join("testdata", "lenna.jpg")); err == nil {
+		t.Error("expecting an error with a file larger than MaxAttachmentSize")
+	}
+	err = f.Load()
+	if _, ok := err.(*AttachmentTooLargeError); !ok {
+		t.Errorf("expecting *AttachmentTooLargeError, got %v", err)
+	}
+	if b := ClassifyBounce(err); b != HardBounce {
+		t.Errorf("expecting hard bounce for %v, got %s", err, b)
+	}
+}
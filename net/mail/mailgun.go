@@ -0,0 +1,75 @@
+// +build !appengine
+
+package mail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"gnd.la/config"
+)
+
+type mailgunBackend struct {
+	endpoint string
+	apiKey   string
+}
+
+func (b *mailgunBackend) Send(env *Envelope) error {
+	data, err := env.Bytes()
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	if err := w.WriteField("to", strings.Join(env.Recipients(), ", ")); err != nil {
+		return err
+	}
+	fw, err := w.CreateFormFile("message", "message.eml")
+	if err != nil {
+		return err
+	}
+	if _, err := fw.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", b.endpoint, &buf)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth("api", b.apiKey)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	return doRequest("Mailgun", req)
+}
+
+func mailgunOpener(u *config.URL) (Backend, error) {
+	domain := strings.Trim(u.Value, "/")
+	if domain == "" {
+		return nil, errors.New("no domain specified, use mailgun://{domain}#api_key={key}")
+	}
+	apiKey := u.Fragment.Get("api_key")
+	if apiKey == "" {
+		return nil, errors.New("no API key specified, use mailgun://{domain}#api_key={key}")
+	}
+	host := "api.mailgun.net"
+	switch region := u.Fragment.Get("region"); region {
+	case "", "us":
+	case "eu":
+		host = "api.eu.mailgun.net"
+	default:
+		return nil, fmt.Errorf("invalid Mailgun region %q", region)
+	}
+	return &mailgunBackend{
+		endpoint: fmt.Sprintf("https://%s/v3/%s/messages.mime", host, domain),
+		apiKey:   apiKey,
+	}, nil
+}
+
+func init() {
+	RegisterBackend("mailgun", mailgunOpener)
+}
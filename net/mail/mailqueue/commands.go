@@ -0,0 +1,93 @@
+package mailqueue
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"gnd.la/app"
+	"gnd.la/commands"
+	"gnd.la/orm"
+)
+
+func recipients(value interface{}) string {
+	switch x := destinataries(value).(type) {
+	case []string:
+		return strings.Join(x, ", ")
+	case string:
+		return x
+	}
+	return ""
+}
+
+func mailQueue(ctx *app.Context) {
+	var failed bool
+	ctx.ParseParamValue("f", &failed)
+	status := Pending
+	if failed {
+		status = Failed
+	}
+	iter := ctx.Orm().Query(orm.Eq("Status", status)).Sort("Created", orm.ASC).Iter()
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, '\t', tabwriter.Debug)
+	fmt.Fprint(w, "ID\tCreated\tTo\tSubject\tAttempts\tNext Attempt\tBounce\tLast Error\n")
+	count := 0
+	for {
+		item := new(QueuedMessage)
+		if !iter.Next(item) {
+			break
+		}
+		var to, subject string
+		if msg := item.Message; msg != nil {
+			to = recipients(msg.To)
+			subject = msg.Subject
+		}
+		var next string
+		if item.Status == Pending {
+			next = item.NextAttempt.Local().Format("2006-01-02 15:04:05")
+		}
+		var bounce string
+		if item.Attempts > 0 {
+			bounce = item.Bounce.String()
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%s\t%s\t%s\n", item.Id, item.Created.Local().Format("2006-01-02 15:04:05"),
+			to, subject, item.Attempts, next, bounce, item.LastError)
+		count++
+	}
+	if err := iter.Err(); err != nil {
+		panic(err)
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%d %s messages\n", count, status)
+}
+
+func mailQueueFlush(ctx *app.Context) {
+	sent, failed, err := Flush(ctx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%d messages sent, %d failed\n", sent, failed)
+}
+
+func mailQueueRetry(ctx *app.Context) {
+	count, err := Retry(ctx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%d failed messages marked as pending\n", count)
+}
+
+func init() {
+	commands.Register(mailQueue, &commands.Options{
+		Help:  "Lists the messages in the outgoing mail queue",
+		Flags: commands.Flags(commands.BoolFlag("f", false, "List failed messages rather than pending ones")),
+	})
+	commands.Register(mailQueueFlush, &commands.Options{
+		Help: "Sends the pending messages in the outgoing mail queue immediately",
+	})
+	commands.Register(mailQueueRetry, &commands.Options{
+		Help: "Marks the failed messages in the outgoing mail queue as pending, so they're sent again",
+	})
+}
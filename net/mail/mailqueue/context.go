@@ -0,0 +1,37 @@
+package mailqueue
+
+import (
+	"gnd.la/app"
+	"gnd.la/net/mail"
+)
+
+// queueContext implements gnd.la/net/mail.TemplateContext,
+// enqueuing the messages rather than sending them.
+type queueContext struct {
+	*app.Context
+}
+
+func (c *queueContext) SendMail(template string, data interface{}, msg *mail.Message) error {
+	return SendMail(c.Context, template, data, msg)
+}
+
+// context provider for the contexts created to
+// process the queue. Since they receive no
+// parameters, the provider is just a dummy one.
+type contextProvider byte
+
+func (c contextProvider) Count() int {
+	return 0
+}
+
+func (c contextProvider) Arg(i int) string {
+	return ""
+}
+
+func (c contextProvider) Param(name string) string {
+	return ""
+}
+
+func (c contextProvider) Params() []string {
+	return nil
+}
@@ -0,0 +1,351 @@
+// Package mailqueue implements an outgoing mail queue, stored using the
+// App's ORM and processed by a task (see gnd.la/tasks). Enqueuing messages
+// rather than sending them directly avoids blocking the request while the
+// message is delivered and allows retrying the messages which failed to
+// be sent due to transient errors.
+//
+// To use the queue, first start its task when initializing your app:
+//
+//  mailqueue.Start(App, nil)
+//
+// Then, use the functions in this package instead of their counterparts
+// in gnd.la/app.Context or gnd.la/net/mail:
+//
+//  mailqueue.SendMail(ctx, "welcome.txt", data, &mail.Message{To: user.Email})
+//
+// Messages which fail with a soft bounce (see gnd.la/net/mail.ClassifyBounce)
+// are retried with exponential backoff, while messages which fail with a hard
+// bounce or exceed the maximum number of attempts are marked as failed. Use the
+// mail-queue command to inspect the queue and the mail-queue-flush command to
+// send all the pending messages immediately.
+package mailqueue
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/net/mail"
+	"gnd.la/orm"
+	"gnd.la/tasks"
+)
+
+const taskName = "gnd.la/net/mail/mailqueue"
+
+// Status indicates the status of a queued message.
+type Status int
+
+const (
+	// Pending messages are waiting to be sent.
+	Pending Status = iota
+	// Failed messages couldn't be sent and won't be retried.
+	Failed
+)
+
+func (s Status) String() string {
+	switch s {
+	case Pending:
+		return "pending"
+	case Failed:
+		return "failed"
+	}
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+// QueuedMessage represents a message stored in the queue. Messages
+// are removed from the queue after they're successfully sent.
+type QueuedMessage struct {
+	Id       int64         `orm:",primary_key,auto_increment"`
+	Message  *mail.Message `orm:",codec=json"`
+	Status   Status        `orm:",index"`
+	Attempts int
+	// Bounce and LastError correspond to the latest
+	// failed attempt, if any.
+	Bounce      mail.Bounce
+	LastError   string
+	Created     time.Time
+	NextAttempt time.Time `orm:",index"`
+}
+
+// Options specify the options for processing the queue. See Start.
+type Options struct {
+	// Interval is the interval for checking the queue for pending
+	// messages. If zero, it defaults to 1 minute.
+	Interval time.Duration
+	// MaxAttempts is the maximum number of attempts for sending a
+	// message. If zero, it defaults to 10.
+	MaxAttempts int
+	// InitialBackoff is the time to wait before retrying a message
+	// for the first time. The time is doubled after every failed
+	// attempt. If zero, it defaults to 1 minute.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum time to wait between attempts. If
+	// zero, it defaults to 6 hours.
+	MaxBackoff time.Duration
+	// BatchSize is the maximum number of messages sent on every
+	// run of the task. If zero, it defaults to 100.
+	BatchSize int
+}
+
+func (o *Options) interval() time.Duration {
+	if o != nil && o.Interval > 0 {
+		return o.Interval
+	}
+	return time.Minute
+}
+
+func (o *Options) maxAttempts() int {
+	if o != nil && o.MaxAttempts > 0 {
+		return o.MaxAttempts
+	}
+	return 10
+}
+
+func (o *Options) batchSize() int {
+	if o != nil && o.BatchSize > 0 {
+		return o.BatchSize
+	}
+	return 100
+}
+
+// backoff returns the time to wait before the next attempt
+// after the given number of failed attempts.
+func (o *Options) backoff(attempts int) time.Duration {
+	initial := time.Minute
+	max := 6 * time.Hour
+	if o != nil {
+		if o.InitialBackoff > 0 {
+			initial = o.InitialBackoff
+		}
+		if o.MaxBackoff > 0 {
+			max = o.MaxBackoff
+		}
+	}
+	d := initial
+	for ii := 1; ii < attempts && d < max; ii++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
+
+var (
+	// options used by the queue task, set by Start
+	queueOptions *Options
+	started      bool
+	// mu serializes the processing of the queue, since Flush
+	// might be called while the task is sending messages.
+	mu sync.Mutex
+)
+
+// Start registers and schedules the task which processes the queue
+// for the given App, using the given options (which might be nil).
+// The returned task might be used to stop or resume the queue
+// processing. Note that Start must be called only once.
+func Start(a *app.App, opts *Options) *tasks.Task {
+	queueOptions = opts
+	started = true
+	return tasks.Schedule(a, processQueue, &tasks.Options{Name: taskName, MaxInstances: 1}, opts.interval(), true)
+}
+
+// Enqueue adds the given message to the queue. Note that the message is
+// validated before being added to the queue, so errors caused by missing
+// recipients or bodies are returned immediately.
+func Enqueue(ctx *app.Context, msg *mail.Message) error {
+	if err := mail.ValidateMessage(msg); err != nil {
+		return err
+	}
+	// The Context is only valid while the current
+	// request is being served and it's set again
+	// by gnd.la/app.Context.SendMail.
+	m := *msg
+	m.Context = nil
+	// Attachments which are read when the message is
+	// sent must be loaded, so they can be stored.
+	m.Attachments = make([]*mail.Attachment, len(msg.Attachments))
+	for ii, v := range msg.Attachments {
+		a := *v
+		if a.Open != nil {
+			if err := a.Load(); err != nil {
+				return err
+			}
+		}
+		m.Attachments[ii] = &a
+	}
+	now := time.Now().UTC()
+	item := &QueuedMessage{
+		Message:     &m,
+		Status:      Pending,
+		Created:     now,
+		NextAttempt: now,
+	}
+	if _, err := ctx.Orm().Insert(item); err != nil {
+		return err
+	}
+	wakeUp(ctx.App())
+	return nil
+}
+
+// SendMail works like gnd.la/app.Context.SendMail, but enqueues
+// the message rather than sending it.
+func SendMail(ctx *app.Context, template string, data interface{}, msg *mail.Message) error {
+	if template != "" {
+		if msg == nil {
+			msg = &mail.Message{}
+		}
+		body, contentType, err := ctx.RenderTemplate(template, data)
+		if err != nil {
+			return err
+		}
+		if strings.Contains(contentType, "/html") {
+			msg.HTMLBody = body
+		} else {
+			msg.TextBody = body
+		}
+	}
+	return Enqueue(ctx, msg)
+}
+
+// SendTemplate works like gnd.la/net/mail.SendTemplate, but enqueues
+// the message rather than sending it.
+func SendTemplate(ctx *app.Context, template string, data interface{}, msg *mail.Message) error {
+	return mail.SendTemplate(&queueContext{ctx}, template, data, msg)
+}
+
+// PendingCount returns the number of messages waiting to be sent.
+func PendingCount(ctx *app.Context) (uint64, error) {
+	o := ctx.Orm()
+	return o.Count(o.TypeTable(reflect.TypeOf(QueuedMessage{})), orm.Eq("Status", Pending))
+}
+
+// Flush tries to send the pending messages immediately, regardless
+// of their next scheduled attempt. At most Options.BatchSize messages
+// are sent. It returns the number of sent messages and the number of
+// messages which failed to be sent.
+func Flush(ctx *app.Context) (int, int, error) {
+	return process(ctx, queueOptions, true)
+}
+
+// Retry marks all the failed messages as pending and resets
+// their number of attempts, so they're sent again. It returns
+// the number of messages marked as pending.
+func Retry(ctx *app.Context) (int, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	var items []*QueuedMessage
+	iter := ctx.Orm().Query(orm.Eq("Status", Failed)).Iter()
+	for {
+		item := new(QueuedMessage)
+		if !iter.Next(item) {
+			break
+		}
+		items = append(items, item)
+	}
+	if err := iter.Err(); err != nil {
+		return 0, err
+	}
+	now := time.Now().UTC()
+	for _, v := range items {
+		v.Status = Pending
+		v.Attempts = 0
+		v.NextAttempt = now
+		if _, err := ctx.Orm().Save(v); err != nil {
+			return 0, err
+		}
+	}
+	return len(items), nil
+}
+
+func processQueue(ctx *app.Context) {
+	sent, failed, err := process(ctx, queueOptions, false)
+	if err != nil {
+		ctx.Logger().Errorf("error processing mail queue: %s", err)
+		return
+	}
+	if sent > 0 || failed > 0 {
+		ctx.Logger().Infof("processed mail queue: %d messages sent, %d failed", sent, failed)
+	}
+}
+
+func process(ctx *app.Context, opts *Options, all bool) (int, int, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	now := time.Now().UTC()
+	q := orm.Eq("Status", Pending)
+	if !all {
+		q = orm.And(q, orm.Lte("NextAttempt", now))
+	}
+	var items []*QueuedMessage
+	iter := ctx.Orm().Query(q).Sort("NextAttempt", orm.ASC).Limit(opts.batchSize()).Iter()
+	for {
+		item := new(QueuedMessage)
+		if !iter.Next(item) {
+			break
+		}
+		items = append(items, item)
+	}
+	if err := iter.Err(); err != nil {
+		return 0, 0, err
+	}
+	sent := 0
+	failed := 0
+	for _, v := range items {
+		if err := sendItem(ctx, opts, v); err != nil {
+			failed++
+			ctx.Logger().Warningf("error sending queued message %d (attempt %d, %s bounce): %s", v.Id, v.Attempts, v.Bounce, err)
+		} else {
+			sent++
+		}
+	}
+	return sent, failed, nil
+}
+
+func sendItem(ctx *app.Context, opts *Options, item *QueuedMessage) error {
+	msg := item.Message
+	if msg == nil {
+		msg = &mail.Message{}
+	}
+	msg.To = destinataries(msg.To)
+	msg.Cc = destinataries(msg.Cc)
+	msg.Bcc = destinataries(msg.Bcc)
+	err := ctx.SendMail("", nil, msg)
+	if err == nil {
+		return ctx.Orm().Delete(item)
+	}
+	item.Attempts++
+	item.Bounce = mail.ClassifyBounce(err)
+	item.LastError = err.Error()
+	if item.Bounce == mail.HardBounce || item.Attempts >= opts.maxAttempts() {
+		item.Status = Failed
+	} else {
+		item.NextAttempt = time.Now().UTC().Add(opts.backoff(item.Attempts))
+	}
+	// Don't store the Context set by SendMail
+	msg.Context = nil
+	if _, serr := ctx.Orm().Save(item); serr != nil {
+		ctx.Logger().Errorf("error updating queued message %d: %s", item.Id, serr)
+	}
+	return err
+}
+
+// destinataries converts the recipients decoded from JSON, which
+// might be a []interface{}, back to the types accepted by gnd.la/net/mail.
+func destinataries(value interface{}) interface{} {
+	if values, ok := value.([]interface{}); ok {
+		addrs := make([]string, len(values))
+		for ii, v := range values {
+			addrs[ii] = fmt.Sprint(v)
+		}
+		return addrs
+	}
+	return value
+}
+
+func init() {
+	orm.Register(&QueuedMessage{}, nil)
+}
@@ -0,0 +1,13 @@
+// +build appengine
+
+package mailqueue
+
+import (
+	"gnd.la/app"
+)
+
+// wakeUp is a no-op on App Engine, since requests can't
+// spawn background goroutines. The queue is processed
+// when the task runs.
+func wakeUp(a *app.App) {
+}
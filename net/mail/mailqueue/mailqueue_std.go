@@ -0,0 +1,24 @@
+// +build !appengine
+
+package mailqueue
+
+import (
+	"gnd.la/app"
+	"gnd.la/tasks"
+)
+
+// wakeUp starts processing the queue in the background, so
+// enqueued messages are sent as soon as possible.
+func wakeUp(a *app.App) {
+	if !started {
+		return
+	}
+	go func() {
+		ctx := a.NewContext(contextProvider(0))
+		defer a.CloseContext(ctx)
+		if _, err := tasks.Run(ctx, taskName); err != nil {
+			// Most likely, the task is already running
+			ctx.Logger().Debugf("not processing mail queue: %s", err)
+		}
+	}()
+}
@@ -0,0 +1,124 @@
+package mailqueue
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/config"
+	"gnd.la/net/mail"
+	_ "gnd.la/orm/driver/sqlite"
+)
+
+// countingBackend records how many times each
+// message has been sent, by subject.
+type countingBackend struct {
+	sync.Mutex
+	sent map[string]int
+}
+
+func (b *countingBackend) Send(env *mail.Envelope) error {
+	time.Sleep(10 * time.Millisecond)
+	b.Lock()
+	b.sent[env.Message.Subject]++
+	b.Unlock()
+	return nil
+}
+
+var testBackend = &countingBackend{sent: make(map[string]int)}
+
+func init() {
+	mail.RegisterBackend("mailqueue-test", func(url *config.URL) (mail.Backend, error) {
+		return testBackend, nil
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	cases := []struct {
+		opts     *Options
+		attempts int
+		expect   time.Duration
+	}{
+		{nil, 1, time.Minute},
+		{nil, 2, 2 * time.Minute},
+		{nil, 5, 16 * time.Minute},
+		{nil, 100, 6 * time.Hour},
+		{&Options{InitialBackoff: time.Second, MaxBackoff: 10 * time.Second}, 1, time.Second},
+		{&Options{InitialBackoff: time.Second, MaxBackoff: 10 * time.Second}, 4, 8 * time.Second},
+		{&Options{InitialBackoff: time.Second, MaxBackoff: 10 * time.Second}, 5, 10 * time.Second},
+	}
+	for _, v := range cases {
+		if d := v.opts.backoff(v.attempts); d != v.expect {
+			t.Errorf("expecting backoff %s after %d attempts with options %+v, got %s", v.expect, v.attempts, v.opts, d)
+		}
+	}
+}
+
+func TestDestinataries(t *testing.T) {
+	cases := []struct {
+		value  interface{}
+		expect interface{}
+	}{
+		{nil, nil},
+		{"a@example.com", "a@example.com"},
+		{[]string{"a@example.com"}, []string{"a@example.com"}},
+		{[]interface{}{"a@example.com", "b@example.com"}, []string{"a@example.com", "b@example.com"}},
+	}
+	for _, v := range cases {
+		if d := destinataries(v.value); !reflect.DeepEqual(d, v.expect) {
+			t.Errorf("expecting %v from %v, got %v", v.expect, v.value, d)
+		}
+	}
+}
+
+func TestConcurrentFlush(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mailqueue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a := app.New()
+	a.Logger = nil
+	a.Config().Database = config.MustParseURL("sqlite://" + filepath.Join(dir, "queue.db"))
+	ctx := a.NewContext(nil)
+	defer a.CloseContext(ctx)
+	subjects := []string{"a", "b", "c", "d", "e"}
+	for _, v := range subjects {
+		msg := &mail.Message{
+			Server:   "mailqueue-test://",
+			From:     "from@example.com",
+			To:       "to@example.com",
+			Subject:  v,
+			TextBody: v,
+		}
+		if err := Enqueue(ctx, msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var wg sync.WaitGroup
+	for ii := 0; ii < 4; ii++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fctx := a.NewContext(contextProvider(0))
+			defer a.CloseContext(fctx)
+			if _, _, err := Flush(fctx); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	for _, v := range subjects {
+		if n := testBackend.sent[v]; n != 1 {
+			t.Errorf("expecting message %q to be sent once, sent %d times", v, n)
+		}
+	}
+	if n, err := PendingCount(ctx); err != nil || n != 0 {
+		t.Errorf("expecting no pending messages, got %d (err %v)", n, err)
+	}
+}
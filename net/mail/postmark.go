@@ -0,0 +1,108 @@
+// +build !appengine
+
+package mail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+
+	"gnd.la/config"
+)
+
+type postmarkHeader struct {
+	Name  string
+	Value string
+}
+
+type postmarkAttachment struct {
+	Name        string
+	Content     string
+	ContentType string
+	ContentID   string `json:",omitempty"`
+}
+
+type postmarkMessage struct {
+	From        string
+	To          string
+	Cc          string                `json:",omitempty"`
+	Bcc         string                `json:",omitempty"`
+	ReplyTo     string                `json:",omitempty"`
+	Subject     string                `json:",omitempty"`
+	TextBody    string                `json:",omitempty"`
+	HtmlBody    string                `json:",omitempty"`
+	Headers     []*postmarkHeader     `json:",omitempty"`
+	Attachments []*postmarkAttachment `json:",omitempty"`
+}
+
+type postmarkBackend struct {
+	endpoint string
+	token    string
+}
+
+func (b *postmarkBackend) Send(env *Envelope) error {
+	msg := env.Message
+	m := &postmarkMessage{
+		From:     env.From,
+		To:       strings.Join(env.To, ", "),
+		Cc:       strings.Join(env.Cc, ", "),
+		Bcc:      strings.Join(env.Bcc, ", "),
+		ReplyTo:  msg.ReplyTo,
+		Subject:  msg.Subject,
+		TextBody: msg.TextBody,
+		HtmlBody: msg.HTMLBody,
+	}
+	var keys []string
+	for k := range msg.Headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		m.Headers = append(m.Headers, &postmarkHeader{Name: k, Value: msg.Headers[k]})
+	}
+	for _, v := range msg.Attachments {
+		if err := v.Load(); err != nil {
+			return err
+		}
+		a := &postmarkAttachment{
+			Name:        v.Name,
+			Content:     base64.StdEncoding.EncodeToString(v.Data),
+			ContentType: v.ContentType,
+		}
+		if v.ContentID != "" && strings.Contains(msg.HTMLBody, "cid:"+v.ContentID) {
+			a.ContentID = "cid:" + v.ContentID
+		}
+		m.Attachments = append(m.Attachments, a)
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", b.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Postmark-Server-Token", b.token)
+	return doRequest("Postmark", req)
+}
+
+func postmarkOpener(u *config.URL) (Backend, error) {
+	token := strings.Trim(u.Value, "/")
+	if token == "" {
+		return nil, errors.New("no server token specified, use postmark://{server_token}")
+	}
+	return &postmarkBackend{
+		endpoint: "https://api.postmarkapp.com/email",
+		token:    token,
+	}, nil
+}
+
+func init() {
+	RegisterBackend("postmark", postmarkOpener)
+}
@@ -0,0 +1,135 @@
+// +build !appengine
+
+package mail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"gnd.la/config"
+)
+
+type sendgridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type sendgridPersonalization struct {
+	To  []*sendgridAddress `json:"to,omitempty"`
+	Cc  []*sendgridAddress `json:"cc,omitempty"`
+	Bcc []*sendgridAddress `json:"bcc,omitempty"`
+}
+
+type sendgridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sendgridAttachment struct {
+	Content     string `json:"content"`
+	Type        string `json:"type,omitempty"`
+	Filename    string `json:"filename"`
+	Disposition string `json:"disposition,omitempty"`
+	ContentID   string `json:"content_id,omitempty"`
+}
+
+type sendgridMessage struct {
+	Personalizations []*sendgridPersonalization `json:"personalizations"`
+	From             *sendgridAddress           `json:"from"`
+	ReplyTo          *sendgridAddress           `json:"reply_to,omitempty"`
+	Subject          string                     `json:"subject,omitempty"`
+	Content          []*sendgridContent         `json:"content"`
+	Attachments      []*sendgridAttachment      `json:"attachments,omitempty"`
+	Headers          map[string]string          `json:"headers,omitempty"`
+}
+
+type sendgridBackend struct {
+	endpoint string
+	apiKey   string
+}
+
+func sendgridAddresses(addrs []string) []*sendgridAddress {
+	var res []*sendgridAddress
+	for _, v := range addrs {
+		name, email := splitAddress(v)
+		res = append(res, &sendgridAddress{Email: email, Name: name})
+	}
+	return res
+}
+
+func (b *sendgridBackend) Send(env *Envelope) error {
+	msg := env.Message
+	name, email := splitAddress(env.From)
+	m := &sendgridMessage{
+		Personalizations: []*sendgridPersonalization{{
+			To:  sendgridAddresses(env.To),
+			Cc:  sendgridAddresses(env.Cc),
+			Bcc: sendgridAddresses(env.Bcc),
+		}},
+		From:    &sendgridAddress{Email: email, Name: name},
+		Subject: msg.Subject,
+	}
+	if msg.ReplyTo != "" {
+		name, email := splitAddress(msg.ReplyTo)
+		m.ReplyTo = &sendgridAddress{Email: email, Name: name}
+	}
+	// SendGrid requires text/plain to be the first one
+	if msg.TextBody != "" {
+		m.Content = append(m.Content, &sendgridContent{Type: "text/plain", Value: msg.TextBody})
+	}
+	if msg.HTMLBody != "" {
+		m.Content = append(m.Content, &sendgridContent{Type: "text/html", Value: msg.HTMLBody})
+	}
+	for _, v := range msg.Attachments {
+		if err := v.Load(); err != nil {
+			return err
+		}
+		a := &sendgridAttachment{
+			Content:     base64.StdEncoding.EncodeToString(v.Data),
+			Type:        v.ContentType,
+			Filename:    v.Name,
+			Disposition: "attachment",
+		}
+		if v.ContentID != "" && strings.Contains(msg.HTMLBody, "cid:"+v.ContentID) {
+			a.Disposition = "inline"
+			a.ContentID = v.ContentID
+		}
+		m.Attachments = append(m.Attachments, a)
+	}
+	if len(msg.Headers) > 0 {
+		m.Headers = make(map[string]string)
+		for k, v := range msg.Headers {
+			m.Headers[k] = v
+		}
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", b.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+b.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+	return doRequest("SendGrid", req)
+}
+
+func sendgridOpener(u *config.URL) (Backend, error) {
+	apiKey := strings.Trim(u.Value, "/")
+	if apiKey == "" {
+		return nil, errors.New("no API key specified, use sendgrid://{api_key}")
+	}
+	return &sendgridBackend{
+		endpoint: "https://api.sendgrid.com/v3/mail/send",
+		apiKey:   apiKey,
+	}, nil
+}
+
+func init() {
+	RegisterBackend("sendgrid", sendgridOpener)
+}
@@ -0,0 +1,85 @@
+// +build !appengine
+
+package mail
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gnd.la/config"
+	"gnd.la/internal/sigv4"
+)
+
+const sesService = "ses"
+
+type sesBackend struct {
+	endpoint string
+	signer   sigv4.Signer
+}
+
+func (b *sesBackend) Send(env *Envelope) error {
+	// The Bcc recipients are only included in the destinations,
+	// otherwise SES would send the header to all the recipients.
+	data, err := buildMessage(env.From, env.To, env.Cc, nil, env.Message)
+	if err != nil {
+		return err
+	}
+	values := url.Values{
+		"Action":          {"SendRawEmail"},
+		"Version":         {"2010-12-01"},
+		"Source":          {env.From},
+		"RawMessage.Data": {base64.StdEncoding.EncodeToString(data)},
+	}
+	for ii, v := range env.Recipients() {
+		values.Set("Destinations.member."+strconv.Itoa(ii+1), v)
+	}
+	body := values.Encode()
+	req, err := http.NewRequest("POST", b.endpoint, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	b.sign(req, body, time.Now())
+	return doRequest("SES", req)
+}
+
+// sign signs the request using AWS Signature Version 4.
+func (b *sesBackend) sign(req *http.Request, body string, now time.Time) {
+	b.signer.Sign(req, sigv4.PayloadHash([]byte(body)), now)
+}
+
+func sesOpener(u *config.URL) (Backend, error) {
+	region := strings.Trim(u.Value, "/")
+	if region == "" {
+		return nil, errors.New("no region specified, use ses://{region}")
+	}
+	b := &sesBackend{
+		endpoint: fmt.Sprintf("https://email.%s.amazonaws.com/", region),
+		signer: sigv4.Signer{
+			Region:    region,
+			Service:   sesService,
+			AccessKey: u.Fragment.Get("access_key"),
+			SecretKey: u.Fragment.Get("secret_key"),
+		},
+	}
+	if b.signer.AccessKey == "" && b.signer.SecretKey == "" {
+		b.signer.AccessKey = os.Getenv("AWS_ACCESS_KEY_ID")
+		b.signer.SecretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		b.signer.SessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+	if b.signer.AccessKey == "" || b.signer.SecretKey == "" {
+		return nil, errors.New("no AWS credentials provided")
+	}
+	return b, nil
+}
+
+func init() {
+	RegisterBackend("ses", sesOpener)
+}
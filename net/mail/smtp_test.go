@@ -0,0 +1,139 @@
+// +build !appengine
+
+package mail
+
+import (
+	"bufio"
+	"net"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testSMTPServer accepts a single connection and returns the
+// received message data over the returned channel. If the
+// client disconnects before finishing the message, the empty
+// string is sent. The addresses received in RCPT commands are
+// sent over the second channel once the message is finished.
+func testSMTPServer(t *testing.T) (string, chan string, chan []string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch := make(chan string, 1)
+	rcpts := make(chan []string, 1)
+	go func() {
+		defer ln.Close()
+		conn, err := ln.Accept()
+		if err != nil {
+			ch <- ""
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		reply := func(s string) {
+			conn.Write([]byte(s + "\r\n"))
+		}
+		reply("220 localhost ESMTP")
+		var rcpt []string
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				ch <- ""
+				return
+			}
+			cmd := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "RCPT TO:"):
+				addr := strings.TrimSpace(line)[len("RCPT TO:"):]
+				rcpt = append(rcpt, strings.Trim(addr, "<>"))
+				reply("250 ok")
+			case strings.HasPrefix(cmd, "DATA"):
+				reply("354 go ahead")
+				var data []string
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						ch <- ""
+						return
+					}
+					if line == ".\r\n" {
+						break
+					}
+					data = append(data, line)
+				}
+				reply("250 ok")
+				ch <- strings.Join(data, "")
+				rcpts <- rcpt
+			case strings.HasPrefix(cmd, "QUIT"):
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+	return ln.Addr().String(), ch, rcpts
+}
+
+func TestSMTPStreaming(t *testing.T) {
+	c := Config.MaxAttachmentSize
+	defer func() {
+		Config.MaxAttachmentSize = c
+	}()
+	addr, ch, _ := testSMTPServer(t)
+	a, err := NewFileAttachment(filepath.Join("testdata", "lenna.jpg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &Message{
+		Server:      addr,
+		From:        "sender@example.com",
+		To:          "receiver@example.com",
+		TextBody:    "Hello",
+		Attachments: []*Attachment{a},
+	}
+	if err := Send(msg); err != nil {
+		t.Fatal(err)
+	}
+	data := <-ch
+	if !strings.Contains(data, "filename=\"lenna.jpg\"") || !strings.Contains(data, "Content-Type: image/jpeg") {
+		t.Errorf("attachment not found in message %q", data)
+	}
+	// Attachment exceeding the limit must abort the message
+	Config.MaxAttachmentSize = 1024
+	addr, ch, _ = testSMTPServer(t)
+	msg.Server = addr
+	err = Send(msg)
+	if _, ok := err.(*AttachmentTooLargeError); !ok {
+		t.Errorf("expecting *AttachmentTooLargeError, got %v", err)
+	}
+	if data := <-ch; data != "" {
+		t.Errorf("partial message was delivered: %q", data)
+	}
+}
+
+func TestSMTPRecipients(t *testing.T) {
+	addr, ch, rcpts := testSMTPServer(t)
+	msg := &Message{
+		Server:   addr,
+		From:     "sender@example.com",
+		To:       "to@example.com",
+		Cc:       []string{"cc1@example.com", "cc2@example.com"},
+		Bcc:      "bcc@example.com",
+		TextBody: "Hello",
+	}
+	if err := Send(msg); err != nil {
+		t.Fatal(err)
+	}
+	if data := <-ch; data == "" {
+		t.Fatal("message was not delivered")
+	}
+	exp := []string{"to@example.com", "cc1@example.com", "cc2@example.com", "bcc@example.com"}
+	if got := <-rcpts; !reflect.DeepEqual(got, exp) {
+		t.Errorf("expecting recipients %v, got %v", exp, got)
+	}
+}
@@ -0,0 +1,103 @@
+package mail
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	cidRe   = regexp.MustCompile(`cid:([^"'\s)>]+)`)
+	titleRe = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+)
+
+// TemplateContext is the interface required by SendTemplate for
+// rendering templates, loading the assets referenced from them
+// and sending the message. *gnd.la/app.Context implements this
+// interface.
+type TemplateContext interface {
+	// RenderTemplate executes the template with the given name and
+	// data, returning its output and its content type.
+	RenderTemplate(name string, data interface{}) (string, string, error)
+	// LoadAsset opens the asset with the given name.
+	LoadAsset(name string) (io.ReadCloser, error)
+	// SendMail sends the given message. SendTemplate always
+	// calls it with an empty template and nil data.
+	SendMail(template string, data interface{}, msg *Message) error
+}
+
+// SendTemplate renders the given template with the provided data and
+// sends its output using msg (which might be nil) as the base message. If
+// the template content type is HTML, the message is prepared as follows:
+//
+//  - CSS rules from <style> elements are inlined (see InlineCSS).
+//  - If msg has no TextBody, a text alternative is generated (see HTMLToText).
+//  - If msg has no Subject, the contents of the <title> element are used.
+//  - Images referenced as cid:name (e.g. <img src="cid:img/logo.png">) are
+//    loaded from the assets and attached with the appropriate ContentID,
+//    unless msg already includes an attachment with that ContentID.
+//
+// Otherwise, the template output is used as the message TextBody. Other
+// Message fields are never altered.
+func SendTemplate(ctx TemplateContext, template string, data interface{}, msg *Message) error {
+	if msg == nil {
+		msg = &Message{}
+	}
+	body, contentType, err := ctx.RenderTemplate(template, data)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(contentType, "/html") {
+		msg.TextBody = body
+		return ctx.SendMail("", nil, msg)
+	}
+	msg.HTMLBody = InlineCSS(body)
+	if msg.TextBody == "" {
+		msg.TextBody = HTMLToText(body)
+	}
+	if msg.Subject == "" {
+		if m := titleRe.FindStringSubmatch(body); m != nil {
+			msg.Subject = strings.Join(strings.Fields(HTMLToText(m[1])), " ")
+		}
+	}
+	if err := attachContentIDs(ctx, msg); err != nil {
+		return err
+	}
+	return ctx.SendMail("", nil, msg)
+}
+
+func attachContentIDs(ctx TemplateContext, msg *Message) error {
+	attached := make(map[string]bool)
+	for _, v := range msg.Attachments {
+		if v.ContentID != "" {
+			attached[v.ContentID] = true
+		}
+	}
+	for _, m := range cidRe.FindAllStringSubmatch(msg.HTMLBody, -1) {
+		name := m[1]
+		if attached[name] {
+			continue
+		}
+		f, err := ctx.LoadAsset(name)
+		if err != nil {
+			return fmt.Errorf("error loading asset %s referenced as cid:%s: %s", name, name, err)
+		}
+		data, err := ioutil.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("error reading asset %s: %s", name, err)
+		}
+		msg.Attachments = append(msg.Attachments, &Attachment{
+			Name:        path.Base(name),
+			ContentType: mime.TypeByExtension(path.Ext(name)),
+			Data:        data,
+			ContentID:   name,
+		})
+		attached[name] = true
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package mail
+
+import (
+	"bytes"
+	"html"
+	"strings"
+)
+
+var (
+	// htmlSkippedTags are the tags which content is never
+	// included in the text version of an HTML email.
+	htmlSkippedTags = map[string]bool{
+		"head":     true,
+		"title":    true,
+		"style":    true,
+		"script":   true,
+		"noscript": true,
+	}
+	// htmlBlockTags are the tags which start a new line
+	// in the text version of an HTML email.
+	htmlBlockTags = map[string]bool{
+		"address":    true,
+		"article":    true,
+		"aside":      true,
+		"blockquote": true,
+		"center":     true,
+		"div":        true,
+		"dl":         true,
+		"dt":         true,
+		"dd":         true,
+		"footer":     true,
+		"form":       true,
+		"header":     true,
+		"li":         true,
+		"main":       true,
+		"nav":        true,
+		"ol":         true,
+		"section":    true,
+		"table":      true,
+		"tr":         true,
+		"ul":         true,
+	}
+	// htmlParagraphTags are the tags which are separated
+	// by a blank line in the text version of an HTML email.
+	htmlParagraphTags = map[string]bool{
+		"h1":  true,
+		"h2":  true,
+		"h3":  true,
+		"h4":  true,
+		"h5":  true,
+		"h6":  true,
+		"hr":  true,
+		"p":   true,
+		"pre": true,
+	}
+)
+
+type textWriter struct {
+	buf bytes.Buffer
+	// newlines is the number of pending newlines
+	newlines int
+	// space indicates if there's a pending space
+	space bool
+}
+
+func (w *textWriter) breakLine(count int) {
+	if count > w.newlines {
+		w.newlines = count
+	}
+	w.space = false
+}
+
+func (w *textWriter) flush() {
+	if w.buf.Len() > 0 {
+		if w.newlines > 0 {
+			w.buf.WriteString(strings.Repeat("\n", w.newlines))
+		} else if w.space {
+			w.buf.WriteByte(' ')
+		}
+	}
+	w.newlines = 0
+	w.space = false
+}
+
+func (w *textWriter) writeRaw(s string) {
+	w.flush()
+	w.buf.WriteString(s)
+}
+
+func (w *textWriter) writeText(s string) {
+	for ii, v := range strings.Fields(s) {
+		if ii > 0 || (len(s) > 0 && isHTMLSpace(s[0])) {
+			w.space = true
+		}
+		w.flush()
+		w.buf.WriteString(v)
+	}
+	if len(s) > 0 && isHTMLSpace(s[len(s)-1]) {
+		w.space = true
+	}
+}
+
+// HTMLToText returns a plain text version of the given HTML
+// document, suitable for using it as the text alternative of
+// an HTML email. Block level elements are separated by newlines,
+// links are followed by their URL between parenthesis, images
+// are replaced by their alt text and list items are prefixed
+// by an asterisk. The contents of the document <head> are
+// not included.
+func HTMLToText(s string) string {
+	var w textWriter
+	skip := 0
+	pre := 0
+	var links []string
+	for _, tok := range tokenizeHTML(s) {
+		switch tok.typ {
+		case htmlText:
+			if skip > 0 {
+				continue
+			}
+			text := html.UnescapeString(tok.raw)
+			if pre > 0 {
+				w.writeRaw(text)
+			} else {
+				w.writeText(text)
+			}
+		case htmlStartTag, htmlSelfClosingTag:
+			if htmlSkippedTags[tok.tag] {
+				if tok.typ == htmlStartTag {
+					skip++
+				}
+				continue
+			}
+			if skip > 0 {
+				continue
+			}
+			switch {
+			case htmlParagraphTags[tok.tag]:
+				w.breakLine(2)
+			case htmlBlockTags[tok.tag]:
+				w.breakLine(1)
+			}
+			switch tok.tag {
+			case "br":
+				w.flush()
+				w.buf.WriteByte('\n')
+			case "hr":
+				w.writeRaw(strings.Repeat("-", 20))
+				w.breakLine(2)
+			case "li":
+				w.writeRaw("* ")
+			case "td", "th":
+				w.space = true
+			case "img":
+				if alt, _ := tok.attr("alt"); alt != "" {
+					w.writeText(alt)
+				}
+			case "pre":
+				if tok.typ == htmlStartTag {
+					pre++
+				}
+			case "a":
+				if tok.typ == htmlStartTag {
+					href, _ := tok.attr("href")
+					links = append(links, href)
+				}
+			}
+		case htmlEndTag:
+			if htmlSkippedTags[tok.tag] {
+				if skip > 0 {
+					skip--
+				}
+				continue
+			}
+			if skip > 0 {
+				continue
+			}
+			switch {
+			case htmlParagraphTags[tok.tag]:
+				w.breakLine(2)
+			case htmlBlockTags[tok.tag]:
+				w.breakLine(1)
+			}
+			switch tok.tag {
+			case "pre":
+				if pre > 0 {
+					pre--
+				}
+			case "a":
+				if len(links) > 0 {
+					href := links[len(links)-1]
+					links = links[:len(links)-1]
+					if href != "" && !strings.HasPrefix(href, "#") && !strings.HasPrefix(href, "mailto:") {
+						w.space = true
+						w.writeRaw("(" + href + ")")
+					}
+				}
+			}
+		}
+	}
+	return strings.TrimSpace(w.buf.String())
+}
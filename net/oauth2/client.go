@@ -3,6 +3,7 @@ package oauth2
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -50,6 +51,10 @@ type Client struct {
 	// empty, it defaults to ",". Note that some provides use ","
 	// (e.g. Facebook), while others use an space " " (e.g. Google).
 	ScopeSeparator string
+	// PKCE enables Proof Key for Code Exchange (RFC 7636) in Handler.
+	// Most providers support it and it's required by some of them
+	// for public clients.
+	PKCE bool
 }
 
 // New returns a new oAuth 2 Client. The authorization parameter
@@ -119,6 +124,14 @@ func (c *Client) Clone(ctx httpclient.Context) *Client {
 // Authorization returns the URL for requesting authorization from the user. Note that most
 // providers require redirectURI to be registered with them.
 func (c *Client) Authorization(redirectURI string, scopes []string, state string) string {
+	return c.AuthorizationWithVerifier(redirectURI, scopes, state, "")
+}
+
+// AuthorizationWithVerifier works like Authorization, but also sends the PKCE
+// challenge derived from the given verifier, which must be later passed to
+// ExchangeWithVerifier. See NewVerifier. If verifier is empty, it's equivalent
+// to Authorization.
+func (c *Client) AuthorizationWithVerifier(redirectURI string, scopes []string, state string, verifier string) string {
 	data := make(url.Values)
 	data.Set("client_id", c.Id)
 	data.Set("redirect_uri", redirectURI)
@@ -133,20 +146,56 @@ func (c *Client) Authorization(redirectURI string, scopes []string, state string
 		data.Set("scope", strings.Join(scopes, sep))
 	}
 	data.Set("state", state)
+	if verifier != "" {
+		data.Set("code_challenge", Challenge(verifier))
+		data.Set("code_challenge_method", "S256")
+	}
 	return urlutil.AppendQuery(c.AuthorizationURL, data)
 }
 
 // Exchange exchanges the given code for a *Token. Note that redirectURI must
 // match the value used in Authorization().
 func (c *Client) Exchange(redirectURI string, code string) (*Token, error) {
+	return c.ExchangeWithVerifier(redirectURI, code, "")
+}
+
+// ExchangeWithVerifier works like Exchange, but also sends the PKCE verifier
+// used in AuthorizationWithVerifier.
+func (c *Client) ExchangeWithVerifier(redirectURI string, code string, verifier string) (*Token, error) {
 	data := make(url.Values)
 	data.Set("client_id", c.Id)
 	data.Set("client_secret", c.Secret)
 	data.Set("redirect_uri", redirectURI)
 	data.Set("code", code)
+	if verifier != "" {
+		data.Set("code_verifier", verifier)
+	}
 	for k, v := range c.ExchangeParameters {
 		data.Set(k, v)
 	}
+	return c.token(data)
+}
+
+// Refresh obtains a new *Token using the given refresh token. If the
+// provider doesn't return a new refresh token, the returned *Token
+// keeps the one used in this request.
+func (c *Client) Refresh(refresh string) (*Token, error) {
+	data := make(url.Values)
+	data.Set("client_id", c.Id)
+	data.Set("client_secret", c.Secret)
+	data.Set("grant_type", "refresh_token")
+	data.Set("refresh_token", refresh)
+	tok, err := c.token(data)
+	if err != nil {
+		return nil, err
+	}
+	if tok.Refresh == "" {
+		tok.Refresh = refresh
+	}
+	return tok, nil
+}
+
+func (c *Client) token(data url.Values) (*Token, error) {
 	resp, err := c.client().PostForm(c.ExchangeURL, data)
 	if err != nil {
 		return nil, err
@@ -177,6 +226,27 @@ func (c *Client) do(f func(string, url.Values) (*httpclient.Response, error), u
 	return resp, nil
 }
 
+// GetBearer sends a GET request to the given URL, including the access
+// token in the Authorization header rather than in the query string. This
+// is the method required by e.g. OpenID Connect userinfo endpoints.
+func (c *Client) GetBearer(u string, accessToken string) (*httpclient.Response, error) {
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.responseHasError(resp) {
+		defer resp.Close()
+		return nil, c.decodeError(resp)
+	}
+	return resp, nil
+}
+
 // Get sends an oAuth 2 GET request.
 func (c *Client) Get(u string, form url.Values, accessToken string) (*httpclient.Response, error) {
 	return c.do(c.client().GetForm, u, form, accessToken)
@@ -3,4 +3,6 @@
 // When possible, users should opt to use the provider specific packages
 // in gnd.la/social rather than using this package directly, since they
 // build on top on this one and provide more provider-specific functionality.
+// For signing in users with any oAuth 2 or OpenID Connect provider, see
+// gnd.la/social.OAuthProvider.
 package oauth2
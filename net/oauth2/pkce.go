@@ -0,0 +1,21 @@
+package oauth2
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+
+	"gnd.la/util/stringutil"
+)
+
+// NewVerifier returns a new random PKCE code verifier. See
+// Client.AuthorizationWithVerifier and Client.ExchangeWithVerifier.
+func NewVerifier() string {
+	return stringutil.Random(64)
+}
+
+// Challenge returns the S256 PKCE code challenge for the
+// given verifier.
+func Challenge(verifier string) string {
+	h := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
@@ -0,0 +1,17 @@
+package oauth2
+
+import (
+	"testing"
+)
+
+func TestChallenge(t *testing.T) {
+	// base64url(sha256(verifier)) without padding
+	verifier := "dBjftJeZ4CVP-mJ92K1ggMsgSP4lMd8-xnk8fEt8dDk"
+	expected := "PaBqmoJ5nfB272bsCyM-NieBAS7KgfRojXf_uIKbTXk"
+	if c := Challenge(verifier); c != expected {
+		t.Errorf("expecting challenge %q, got %q", expected, c)
+	}
+	if v := NewVerifier(); len(v) < 43 || len(v) > 128 {
+		t.Errorf("invalid verifier length %d", len(v))
+	}
+}
@@ -0,0 +1,379 @@
+package orm
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"gnd.la/orm/driver"
+	"gnd.la/orm/index"
+	"gnd.la/orm/query"
+)
+
+// AuditTable is the table where the audit trail
+// of the models registered with Options.Audit
+// is stored.
+const AuditTable = "gondola_audit_history"
+
+// Actions recorded in the audit trail.
+const (
+	AuditInsert = "insert"
+	AuditUpdate = "update"
+	AuditDelete = "delete"
+)
+
+var auditEntryType = reflect.TypeOf(AuditEntry{})
+
+// AuditEntry represents a change to an object of a model registered
+// with Options.Audit. Use Orm.History to retrieve the entries for a
+// given object and Orm.AsOf to reconstruct it at a point in time.
+type AuditEntry struct {
+	Id int64 `orm:",primary_key,auto_increment"`
+	// Model is the name of the changed model.
+	Model string `orm:",max_length=255"`
+	// ObjectId is the primary key of the changed
+	// object, formatted as a string.
+	ObjectId string `orm:",max_length=255"`
+	// Action is one of AuditInsert, AuditUpdate
+	// or AuditDelete.
+	Action string `orm:",max_length=16"`
+	// Actor identifies who made the change, as set
+	// by Orm.WithActor. It might be empty.
+	Actor     string    `orm:",max_length=255"`
+	Timestamp time.Time `orm:",index"`
+	// Changes is a JSON object with the changed fields,
+	// see Diff.
+	Changes string
+}
+
+// AuditChange is the change to a field of an object, with
+// its JSON encoded values. Old is nil for inserted objects,
+// while New is nil for deleted ones.
+type AuditChange struct {
+	Old json.RawMessage `json:"old,omitempty"`
+	New json.RawMessage `json:"new,omitempty"`
+}
+
+// Diff returns the changes in the entry, keyed by the qualified
+// field names. Inserts and deletes include all the fields, while
+// updates only include the ones which changed.
+func (e *AuditEntry) Diff() (map[string]*AuditChange, error) {
+	var changes map[string]*AuditChange
+	if err := json.Unmarshal([]byte(e.Changes), &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// WithActor returns a copy of the Orm which records the given
+// actor in the audit trail of the changes it makes. See
+// Options.Audit.
+func (o *Orm) WithActor(actor string) *Orm {
+	return o.WithActorFunc(func() string { return actor })
+}
+
+// WithActorFunc works like WithActor, but the actor is obtained
+// by calling f only when an audit entry is recorded. This is used
+// by gnd.la/app.Context.Orm, so the current user is only looked up
+// when it's required.
+func (o *Orm) WithActorFunc(f func() string) *Orm {
+	cpy := *o
+	cpy.actor = f
+	return &cpy
+}
+
+// History returns the audit trail for the given object, which must
+// be of a model registered with Options.Audit and have its primary
+// key set. Entries are sorted from the oldest to the newest.
+func (o *Orm) History(obj interface{}) ([]*AuditEntry, error) {
+	return o.history(obj, nil)
+}
+
+// AsOf reconstructs the given object as it was at the given time, using
+// its audit trail. Obj must be a pointer to a model registered with
+// Options.Audit and have its primary key set. The returned boolean is
+// false if the object didn't exist at that time. For objects inserted
+// before enabling the audit trail, the changes are applied on top of
+// the current contents of obj. Note that changes made without going
+// through the Orm (e.g. using raw SQL) are not recorded in the audit
+// trail and won't be reflected in the result.
+func (o *Orm) AsOf(obj interface{}, t time.Time) (bool, error) {
+	val := reflect.ValueOf(obj)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return false, fmt.Errorf("AsOf requires a non-nil pointer, not %T", obj)
+	}
+	entries, err := o.history(obj, Lte("Timestamp", t))
+	if err != nil {
+		return false, err
+	}
+	m, _ := o.model(obj)
+	elem := val.Elem()
+	found := false
+	for _, v := range entries {
+		changes, err := v.Diff()
+		if err != nil {
+			return false, fmt.Errorf("invalid audit entry %d: %s", v.Id, err)
+		}
+		switch v.Action {
+		case AuditInsert:
+			elem.Set(reflect.Zero(elem.Type()))
+			found = true
+		case AuditDelete:
+			found = false
+			continue
+		default:
+			found = true
+		}
+		for name, c := range changes {
+			idx, ok := m.fields.QNameMap[name]
+			if !ok || c.New == nil {
+				// Removed from the model
+				continue
+			}
+			fval := o.fieldByIndexCreating(val, m.fields.Indexes[idx])
+			if err := json.Unmarshal(c.New, fval.Addr().Interface()); err != nil {
+				return false, fmt.Errorf("can't restore field %s from audit entry %d: %s", name, v.Id, err)
+			}
+		}
+	}
+	return found, nil
+}
+
+func (o *Orm) history(obj interface{}, q query.Q) ([]*AuditEntry, error) {
+	o, m, err := o.routeModel(obj)
+	if err != nil {
+		return nil, err
+	}
+	if !m.audited() {
+		return nil, fmt.Errorf("model %s is not audited", m.name)
+	}
+	_, pkVal := o.primaryKey(m.fields, obj)
+	cond := And(Eq("Model", m.name), Eq("ObjectId", auditObjectId(pkVal)))
+	if q != nil {
+		cond = And(cond, q)
+	}
+	var entries []*AuditEntry
+	if err := o.Query(cond).Sort("Id", ASC).All(&entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// audited returns true iff the changes to the
+// model are recorded in the audit trail.
+func (m *model) audited() bool {
+	return m.options != nil && m.options.Audit
+}
+
+// registerAuditLocked registers the AuditEntry model when there
+// are audited models. globalRegistry must be locked.
+func (o *Orm) registerAuditLocked() error {
+	if _, ok := globalRegistry.types[o.tags][auditEntryType]; ok {
+		return nil
+	}
+	for _, v := range globalRegistry.names[o.tags] {
+		if v.audited() {
+			_, err := o.registerLocked((*AuditEntry)(nil), &Options{
+				Table:    AuditTable,
+				Indexes:  index.Indexes(index.New("Model", "ObjectId")),
+				Database: o.database,
+			})
+			return err
+		}
+	}
+	return nil
+}
+
+// auditSnapshot is the JSON encoded value of
+// each field in an object, by qualified name.
+type auditSnapshot map[string]json.RawMessage
+
+// auditSnapshots returns the snapshots of the objects from m matching
+// q, keyed by their primary key, as well as the primary key values.
+func (o *Orm) auditSnapshots(m *model, q query.Q) (map[string]auditSnapshot, []interface{}, error) {
+	iter := o.conn.Query(m, q, nil, -1, -1)
+	snapshots := make(map[string]auditSnapshot)
+	var pks []interface{}
+	for {
+		obj := reflect.New(m.Type())
+		if !iter.Next(obj.Interface()) {
+			break
+		}
+		snap, err := o.auditSnapshot(m, obj)
+		if err != nil {
+			iter.Close()
+			return nil, nil, err
+		}
+		_, pkVal := o.primaryKey(m.fields, obj.Interface())
+		snapshots[auditObjectId(pkVal)] = snap
+		pks = append(pks, pkVal.Interface())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, nil, err
+	}
+	return snapshots, pks, iter.Close()
+}
+
+// auditExcluded returns true iff the field at the given index is
+// excluded from the audit trail, because its json tag is "-".
+func auditExcluded(m *model, idx int) bool {
+	field := m.Type().FieldByIndex(m.fields.Indexes[idx])
+	return field.Tag.Get("json") == "-"
+}
+
+func (o *Orm) auditSnapshot(m *model, val reflect.Value) (auditSnapshot, error) {
+	snap := make(auditSnapshot, len(m.fields.QNames))
+	for ii, name := range m.fields.QNames {
+		if auditExcluded(m, ii) {
+			continue
+		}
+		fval := o.fieldByIndex(val, m.fields.Indexes[ii])
+		if !fval.IsValid() {
+			snap[name] = json.RawMessage("null")
+			continue
+		}
+		data, err := json.Marshal(fval.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("can't encode field %s for the audit trail: %s", name, err)
+		}
+		snap[name] = data
+	}
+	return snap, nil
+}
+
+// auditTransaction runs f, which makes a change and records it in
+// the audit trail, inside a transaction. If o is already in a
+// transaction or the driver doesn't support them, f is run directly.
+func (o *Orm) auditTransaction(f func(o *Orm) (Result, error)) (Result, error) {
+	if o.inTransaction() || o.driver.Capabilities()&driver.CAP_TRANSACTION == 0 {
+		return f(o)
+	}
+	var res Result
+	err := o.Transaction(func(o *Orm) error {
+		var err error
+		res, err = f(o)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// auditInsert calls f, which inserts obj, and records its insertion.
+func (o *Orm) auditInsert(m *model, obj interface{}, f func(o *Orm) (Result, error)) (Result, error) {
+	return o.auditTransaction(func(o *Orm) (Result, error) {
+		res, err := f(o)
+		if err != nil {
+			return nil, err
+		}
+		snap, err := o.auditSnapshot(m, reflect.ValueOf(obj))
+		if err != nil {
+			return nil, err
+		}
+		changes := make(map[string]*AuditChange, len(snap))
+		for k, v := range snap {
+			changes[k] = &AuditChange{New: v}
+		}
+		_, pkVal := o.primaryKey(m.fields, obj)
+		if err := o.recordAudit(m, AuditInsert, auditObjectId(pkVal), changes); err != nil {
+			return nil, err
+		}
+		return res, nil
+	})
+}
+
+// auditUpdate calls f, which updates the objects from m matching
+// q, and records the changes made to each one of them.
+func (o *Orm) auditUpdate(m *model, q query.Q, f func(o *Orm) (Result, error)) (Result, error) {
+	return o.auditTransaction(func(o *Orm) (Result, error) {
+		before, pks, err := o.auditSnapshots(m, q)
+		if err != nil {
+			return nil, err
+		}
+		res, err := f(o)
+		if err != nil || len(pks) == 0 {
+			return res, err
+		}
+		after, _, err := o.auditSnapshots(m, In(m.fields.QNames[m.fields.PrimaryKey], pks))
+		if err != nil {
+			return nil, err
+		}
+		for id, prev := range before {
+			cur, ok := after[id]
+			if !ok {
+				// Primary key changed, can't track it
+				continue
+			}
+			changes := make(map[string]*AuditChange)
+			for k, v := range cur {
+				if old := prev[k]; string(old) != string(v) {
+					changes[k] = &AuditChange{Old: old, New: v}
+				}
+			}
+			if len(changes) == 0 {
+				continue
+			}
+			if err := o.recordAudit(m, AuditUpdate, id, changes); err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	})
+}
+
+// auditDelete calls f, which deletes the objects from m
+// matching q, and records the deletion of each one of them.
+func (o *Orm) auditDelete(m *model, q query.Q, f func(o *Orm) (Result, error)) (Result, error) {
+	return o.auditTransaction(func(o *Orm) (Result, error) {
+		before, _, err := o.auditSnapshots(m, q)
+		if err != nil {
+			return nil, err
+		}
+		res, err := f(o)
+		if err != nil {
+			return res, err
+		}
+		for id, prev := range before {
+			changes := make(map[string]*AuditChange, len(prev))
+			for k, v := range prev {
+				changes[k] = &AuditChange{Old: v}
+			}
+			if err := o.recordAudit(m, AuditDelete, id, changes); err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	})
+}
+
+func (o *Orm) recordAudit(m *model, action string, id string, changes map[string]*AuditChange) error {
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+	entry := &AuditEntry{
+		Model:     m.name,
+		ObjectId:  id,
+		Action:    action,
+		Timestamp: time.Now().UTC(),
+		Changes:   string(data),
+	}
+	if o.actor != nil {
+		entry.Actor = o.actor()
+	}
+	am, err := o.model(entry)
+	if err != nil {
+		return err
+	}
+	_, err = o.insert(am, entry)
+	return err
+}
+
+func auditObjectId(pk reflect.Value) string {
+	if !pk.IsValid() {
+		return ""
+	}
+	return fmt.Sprint(pk.Interface())
+}
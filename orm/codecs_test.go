@@ -3,6 +3,10 @@ package orm
 import (
 	"reflect"
 	"testing"
+
+	_ "gnd.la/encoding/codec/cbor"
+	_ "gnd.la/encoding/codec/msgpack"
+	"gnd.la/i18n"
 )
 
 type InvalidCodec1 struct {
@@ -27,6 +31,32 @@ type GobEncoded struct {
 	Rects []Rect `orm:",codec=gob"`
 }
 
+// Binary codecs must keep []byte and string values apart
+
+type Blob struct {
+	Name string
+	Data []byte
+}
+
+type MsgpackEncoded struct {
+	Id    int64  `orm:",primary_key,auto_increment"`
+	Blobs []Blob `orm:",codec=msgpack"`
+}
+
+type CborEncoded struct {
+	Id    int64  `orm:",primary_key,auto_increment"`
+	Blobs []Blob `orm:",codec=cbor"`
+}
+
+type TranslatableEncoded struct {
+	Id    int64             `orm:",primary_key,auto_increment"`
+	Title i18n.Translatable `orm:",codec=json"`
+}
+
+type testLanguage string
+
+func (t testLanguage) Language() string { return string(t) }
+
 func testInvalidCodecs(t *testing.T, o *Orm) {
 	for _, v := range []interface{}{&InvalidCodec1{}} {
 		_, err := o.Register(v, nil)
@@ -66,3 +96,57 @@ func testCodecs(t *testing.T, o *Orm) {
 		t.Errorf("invalid gob decoded field. Want %v, got %v.", rects, g2.Rects)
 	}
 }
+
+func testBinaryCodecs(t *testing.T, o *Orm) {
+	o.mustRegister((*MsgpackEncoded)(nil), nil)
+	o.mustRegister((*CborEncoded)(nil), nil)
+	o.mustInitialize()
+	blobs := []Blob{{Name: "empty", Data: []byte{}}, {Name: "binary", Data: []byte{0, 1, 0xfe, 0xff}}}
+	m1 := &MsgpackEncoded{Blobs: blobs}
+	o.MustSave(m1)
+	var m2 *MsgpackEncoded
+	if _, err := o.One(Eq("Id", m1.Id), &m2); err != nil {
+		t.Error(err)
+	} else if m2 == nil {
+		t.Error("m2 is nil")
+	} else if !reflect.DeepEqual(m2.Blobs, blobs) {
+		t.Errorf("invalid msgpack decoded field. Want %v, got %v.", blobs, m2.Blobs)
+	}
+	c1 := &CborEncoded{Blobs: blobs}
+	o.MustSave(c1)
+	var c2 *CborEncoded
+	if _, err := o.One(Eq("Id", c1.Id), &c2); err != nil {
+		t.Error(err)
+	} else if c2 == nil {
+		t.Error("c2 is nil")
+	} else if !reflect.DeepEqual(c2.Blobs, blobs) {
+		t.Errorf("invalid CBOR decoded field. Want %v, got %v.", blobs, c2.Blobs)
+	}
+}
+
+func testTranslatable(t *testing.T, o *Orm) {
+	o.mustRegister((*TranslatableEncoded)(nil), nil)
+	o.mustInitialize()
+	if tables := o.Tables(); len(tables) != 1 || tables[0].Type() != reflect.TypeOf(TranslatableEncoded{}) {
+		t.Errorf("expecting Tables() to return only TranslatableEncoded, got %d tables", len(tables))
+	}
+	t1 := &TranslatableEncoded{Title: i18n.Translatable{"": "Hello", "es": "Hola"}}
+	o.MustSave(t1)
+	var t2 *TranslatableEncoded
+	_, err := o.One(Eq("Id", t1.Id), &t2)
+	if err != nil {
+		t.Error(err)
+	} else if t2 == nil {
+		t.Error("t2 is nil")
+	} else {
+		if !reflect.DeepEqual(t2.Title, t1.Title) {
+			t.Errorf("invalid Translatable field. Want %v, got %v.", t1.Title, t2.Title)
+		}
+		if v := t2.Title.Value(testLanguage("es_ES")); v != "Hola" {
+			t.Errorf("expecting Hola for es_ES, got %q", v)
+		}
+		if v := t2.Title.Value(testLanguage("fr")); v != "Hello" {
+			t.Errorf("expecting Hello for fr, got %q", v)
+		}
+	}
+}
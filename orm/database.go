@@ -0,0 +1,285 @@
+package orm
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"gnd.la/config"
+)
+
+// DefaultDatabase is the name of the database opened with New
+// (e.g. the one configured in gnd.la/app.App). Models without
+// an explicit Options.Database are registered in it.
+const DefaultDatabase = "default"
+
+var databaseRegistry struct {
+	sync.RWMutex
+	urls map[string]*config.URL
+}
+
+// CrossDatabaseError is returned when an operation started from
+// a transaction in one database tries to use another one. Since
+// transactions can't span several databases, the operation is
+// rejected rather than executed outside of the transaction.
+type CrossDatabaseError struct {
+	// Transaction is the database with the running transaction.
+	Transaction string
+	// Database is the database the operation was routed to.
+	Database string
+}
+
+func (e *CrossDatabaseError) Error() string {
+	return fmt.Sprintf("can't use database %q from a transaction in database %q, transactions can't span multiple databases",
+		e.Database, e.Transaction)
+}
+
+// RegisterDatabase registers an additional database with the given
+// name, which is opened and initialized alongside the default one
+// when calling Initialize on the latter. Models are routed to it by
+// setting Options.Database to the same name, while Orm.Using selects
+// it for individual operations. RegisterDatabase should be called
+// from an init() function and it panics if the name is empty,
+// DefaultDatabase or it was already registered.
+func RegisterDatabase(name string, url *config.URL) {
+	if name == "" || name == DefaultDatabase {
+		panic(fmt.Errorf("invalid database name %q", name))
+	}
+	databaseRegistry.Lock()
+	defer databaseRegistry.Unlock()
+	if _, ok := databaseRegistry.urls[name]; ok {
+		panic(fmt.Errorf("there's already a database named %q", name))
+	}
+	if databaseRegistry.urls == nil {
+		databaseRegistry.urls = make(map[string]*config.URL)
+	}
+	databaseRegistry.urls[name] = url
+}
+
+func isRegisteredDatabase(name string) bool {
+	if name == DefaultDatabase {
+		return true
+	}
+	databaseRegistry.RLock()
+	defer databaseRegistry.RUnlock()
+	_, ok := databaseRegistry.urls[name]
+	return ok
+}
+
+// databases contains the open databases, shared
+// by all the Orm instances opened from the same
+// default one.
+type databases struct {
+	sync.RWMutex
+	orms map[string]*Orm
+}
+
+func (d *databases) get(name string) *Orm {
+	d.RLock()
+	defer d.RUnlock()
+	return d.orms[name]
+}
+
+func (d *databases) set(name string, o *Orm) {
+	d.Lock()
+	defer d.Unlock()
+	d.orms[name] = o
+}
+
+// modelType returns the model for the given type from the open
+// databases, preferring the default one. If the type is not registered
+// in the default database but it's registered in several other ones,
+// an error is returned, since the database can't be determined.
+func (d *databases) modelType(t reflect.Type) (*model, error) {
+	d.RLock()
+	defer d.RUnlock()
+	if o := d.orms[DefaultDatabase]; o != nil {
+		if m := o.typeRegistry[t]; m != nil {
+			return m, nil
+		}
+	}
+	var found *model
+	var names []string
+	for k, v := range d.orms {
+		if m := v.typeRegistry[t]; m != nil {
+			found = m
+			names = append(names, k)
+		}
+	}
+	if len(names) > 1 {
+		sort.Strings(names)
+		return nil, fmt.Errorf("type %v is registered in databases %q, use Orm.Using() to select one of them", t, names)
+	}
+	return found, nil
+}
+
+// Database returns the name of the database this Orm
+// is connected to. See RegisterDatabase.
+func (o *Orm) Database() string {
+	return o.database
+}
+
+// Databases returns the names of the databases available
+// for Using, sorted alphabetically.
+func (o *Orm) Databases() []string {
+	o.databases.RLock()
+	defer o.databases.RUnlock()
+	names := make([]string, 0, len(o.databases.orms))
+	for k := range o.databases.orms {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Using returns an Orm which executes all its operations in the
+// database with the given name, rather than routing each model to
+// the database it was registered in. Operating on a model which
+// wasn't registered in that database returns an error. To use the
+// same model in several databases, register it once for each one
+// of them, e.g.
+//
+//	orm.Register(&Event{}, nil)
+//	orm.Register(&Event{}, &orm.Options{Database: "legacy"})
+//	...
+//	// Reads from the legacy database
+//	o.Using("legacy").Query(orm.Eq("Id", 42)).One(&event)
+//
+// Note that Table objects belong to a single database, use
+// TypeTable or NameTable on the returned Orm when required.
+//
+// When called on an Orm running a transaction, the returned Orm rejects
+// all its operations with a *CrossDatabaseError unless name refers to
+// the database running the transaction. Using panics if there's no
+// database with the given name.
+func (o *Orm) Using(name string) *Orm {
+	if name == "" {
+		name = DefaultDatabase
+	}
+	if name == o.database && o.crossTx == "" {
+		if o.pinned {
+			return o
+		}
+		cpy := *o
+		cpy.pinned = true
+		return &cpy
+	}
+	db := o.databases.get(name)
+	if db == nil {
+		panic(fmt.Errorf("no database named %q - did you forget to call RegisterDatabase() or Initialize()?", name))
+	}
+	cpy := *db
+	cpy.actor = o.actor
+	cpy.identity = o.identity
+	cpy.ctx = o.ctx
+	cpy.pinned = true
+	cpy.crossTx = o.crossTx
+	cpy.signals = o.signals
+	if o.inTransaction() {
+		cpy.crossTx = o.database
+	}
+	return &cpy
+}
+
+// route returns the Orm which should be used for operating
+// on the given model, according to the database it was
+// registered in.
+func (o *Orm) route(m *model) (*Orm, error) {
+	if o.crossTx != "" {
+		return nil, &CrossDatabaseError{Transaction: o.crossTx, Database: o.database}
+	}
+	name := m.database
+	if name == o.database {
+		return o, nil
+	}
+	if o.pinned {
+		return nil, fmt.Errorf("model %q is not registered in database %q", m.name, o.database)
+	}
+	if o.inTransaction() {
+		return nil, &CrossDatabaseError{Transaction: o.database, Database: name}
+	}
+	db := o.databases.get(name)
+	if db == nil {
+		return nil, fmt.Errorf("model %q uses database %q, which is not open", m.name, name)
+	}
+	cpy := *db
+	cpy.actor = o.actor
+	cpy.identity = o.identity
+	cpy.ctx = o.ctx
+	return &cpy, nil
+}
+
+// routeModel returns the model for obj and the
+// Orm for operating on it. See route.
+func (o *Orm) routeModel(obj interface{}) (*Orm, *model, error) {
+	m, err := o.model(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	ro, err := o.route(m)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ro, m, nil
+}
+
+// openDatabases opens and initializes the databases registered
+// with RegisterDatabase which haven't been opened yet. It's only
+// called when initializing the default database.
+func (o *Orm) openDatabases() error {
+	if o.database != DefaultDatabase {
+		return nil
+	}
+	databaseRegistry.RLock()
+	urls := make(map[string]*config.URL, len(databaseRegistry.urls))
+	for k, v := range databaseRegistry.urls {
+		urls[k] = v
+	}
+	databaseRegistry.RUnlock()
+	for name, url := range urls {
+		if o.databases.get(name) != nil {
+			continue
+		}
+		db, err := open(url, name, o.databases)
+		if err != nil {
+			return fmt.Errorf("error opening database %q: %s", name, err)
+		}
+		if o.logger != nil {
+			db.SetLogger(o.logger)
+		}
+		if err := db.initialize(); err != nil {
+			db.Close()
+			return fmt.Errorf("error initializing database %q: %s", name, err)
+		}
+		o.databases.set(name, db)
+	}
+	return nil
+}
+
+// closeDatabases closes the databases opened by openDatabases.
+func (o *Orm) closeDatabases() error {
+	if o.database != DefaultDatabase {
+		return nil
+	}
+	o.databases.Lock()
+	defer o.databases.Unlock()
+	var err error
+	for k, v := range o.databases.orms {
+		if k == DefaultDatabase {
+			continue
+		}
+		if cerr := v.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+		delete(o.databases.orms, k)
+	}
+	return err
+}
+
+func optionsDatabase(opts *Options) string {
+	if opts != nil && opts.Database != "" {
+		return opts.Database
+	}
+	return DefaultDatabase
+}
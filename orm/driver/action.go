@@ -0,0 +1,72 @@
+package driver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReferentialAction indicates what happens to the rows referencing
+// another row when the latter is deleted.
+type ReferentialAction int
+
+const (
+	// NoAction leaves the enforcement to the backend, which
+	// usually rejects deleting referenced rows.
+	NoAction ReferentialAction = iota
+	// Cascade deletes the referencing rows too.
+	Cascade
+	// SetNull sets the referencing fields to NULL.
+	SetNull
+	// Restrict fails to delete rows which are referenced.
+	Restrict
+)
+
+// ReferentialActionNames returns the names accepted by
+// ParseReferentialAction.
+func ReferentialActionNames() []string {
+	return []string{"cascade", "set_null", "restrict"}
+}
+
+// ParseReferentialAction parses an action from its name (cascade,
+// set_null or restrict), as used in the on_delete struct tag option.
+func ParseReferentialAction(s string) (ReferentialAction, error) {
+	switch strings.ToLower(s) {
+	case "":
+		return NoAction, nil
+	case "cascade":
+		return Cascade, nil
+	case "set_null":
+		return SetNull, nil
+	case "restrict":
+		return Restrict, nil
+	}
+	return NoAction, fmt.Errorf("invalid referential action %q", s)
+}
+
+// SQL returns the action as used in SQL DDL (e.g. SET NULL), or an
+// empty string for NoAction.
+func (a ReferentialAction) SQL() string {
+	switch a {
+	case Cascade:
+		return "CASCADE"
+	case SetNull:
+		return "SET NULL"
+	case Restrict:
+		return "RESTRICT"
+	}
+	return ""
+}
+
+func (a ReferentialAction) String() string {
+	switch a {
+	case NoAction:
+		return "no action"
+	case Cascade:
+		return "cascade"
+	case SetNull:
+		return "set_null"
+	case Restrict:
+		return "restrict"
+	}
+	return fmt.Sprintf("unknown action %d", int(a))
+}
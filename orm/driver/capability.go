@@ -31,4 +31,10 @@ const (
 	CAP_DEFAULTS
 	// Can have database level defaults for TEXT fields (unbounded strings).
 	CAP_DEFAULTS_TEXT
+	// Enforces foreign keys, including their ON DELETE actions. Drivers
+	// without this capability have the actions emulated by the ORM.
+	CAP_FOREIGN_KEYS
+	// Supports full-text search, using orm.Match conditions
+	// and sorting the results by their relevance.
+	CAP_SEARCH
 )
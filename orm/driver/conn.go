@@ -1,6 +1,8 @@
 package driver
 
 import (
+	"context"
+
 	"gnd.la/orm/operation"
 	"gnd.la/orm/query"
 )
@@ -16,3 +18,13 @@ type Conn interface {
 	Delete(m Model, q query.Q) (Result, error)
 	Connection() interface{}
 }
+
+// ContextConn is implemented by the Conns which can run their
+// operations using a context.Context, which is used for cancelling
+// them (e.g. when a query timeout expires).
+type ContextConn interface {
+	Conn
+	// WithContext returns a Conn which runs its operations
+	// using ctx. The receiver is not modified.
+	WithContext(ctx context.Context) Conn
+}
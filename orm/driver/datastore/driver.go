@@ -173,6 +173,9 @@ func (d *Driver) makeQuery(m driver.Model, q query.Q, sort []driver.Sort, limit
 		return nil, err
 	}
 	for _, v := range sort {
+		if _, ok := v.(driver.SearchRank); ok {
+			return nil, fmt.Errorf("datastore does not support sorting by search rank")
+		}
 		field := v.Field()
 		if v.Direction() == driver.DESC {
 			field = "-" + field
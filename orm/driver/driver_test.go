@@ -20,3 +20,18 @@ func TestZero(t *testing.T) {
 		}
 	}
 }
+
+func TestRetryPolicyDelay(t *testing.T) {
+	p := &RetryPolicy{Backoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for retry, max := range []time.Duration{0, 100, 200, 400, 800, 1000, 1000} {
+		if retry == 0 {
+			continue
+		}
+		max *= time.Millisecond
+		for ii := 0; ii < 10; ii++ {
+			if d := p.Delay(retry); d < max/2 || d > max {
+				t.Errorf("delay for retry %d = %s, expecting [%s, %s]", retry, d, max/2, max)
+			}
+		}
+	}
+}
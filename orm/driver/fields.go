@@ -9,6 +9,9 @@ import (
 type Reference struct {
 	Model Model
 	Field string
+	// OnDelete is the action taken when the
+	// referenced row is deleted.
+	OnDelete ReferentialAction
 }
 
 type Fields struct {
@@ -24,6 +27,9 @@ type Fields struct {
 	PrimaryKey int
 	// True if the primary key is an integer type with auto_increment
 	AutoincrementPk bool
+	// The generator for the primary key values, declared
+	// with the id tag option
+	IDGenerator IDGenerator
 	// The fields which make the composite primary key, if any
 	CompositePrimaryKey []int
 	// Model methods called by the ORM
@@ -0,0 +1,93 @@
+package driver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IDGenerator indicates how the values for a primary key are
+// generated by the ORM before inserting an object. Generators
+// are declared using the id struct tag option, while integer
+// keys assigned by the database use auto_increment instead.
+type IDGenerator int
+
+const (
+	// NoIDGenerator leaves the primary key value as is.
+	NoIDGenerator IDGenerator = iota
+	// UUIDv4 generates random UUIDs, as defined in RFC 4122,
+	// for string fields.
+	UUIDv4
+	// UUIDv7 generates time ordered UUIDs, as defined in RFC 9562,
+	// for string fields. They sort in creation order, which makes
+	// them friendlier to indexes than UUIDv4.
+	UUIDv7
+	// ULID generates time ordered Universally Unique Lexicographically
+	// Sortable Identifiers, encoded as 26 character strings.
+	ULID
+	// Snowflake generates time ordered 64 bit integers, made from
+	// a timestamp, a node number and a sequence number, for int64
+	// and uint64 fields.
+	Snowflake
+)
+
+// IDGeneratorNames returns the names accepted by
+// ParseIDGenerator.
+func IDGeneratorNames() []string {
+	return []string{"uuid4", "uuid7", "ulid", "snowflake"}
+}
+
+// ParseIDGenerator parses a generator from its name (uuid4, uuid7,
+// ulid or snowflake), as used in the id struct tag option.
+func ParseIDGenerator(s string) (IDGenerator, error) {
+	switch strings.ToLower(s) {
+	case "":
+		return NoIDGenerator, nil
+	case "uuid4":
+		return UUIDv4, nil
+	case "uuid7":
+		return UUIDv7, nil
+	case "ulid":
+		return ULID, nil
+	case "snowflake":
+		return Snowflake, nil
+	}
+	return NoIDGenerator, fmt.Errorf("invalid id generator %q", s)
+}
+
+// IsString returns true iff the generator produces strings.
+func (g IDGenerator) IsString() bool {
+	return g == UUIDv4 || g == UUIDv7 || g == ULID
+}
+
+// IsUUID returns true iff the generator produces UUIDs.
+func (g IDGenerator) IsUUID() bool {
+	return g == UUIDv4 || g == UUIDv7
+}
+
+// Length returns the length of the strings produced by the
+// generator, or zero for generators which don't produce strings.
+func (g IDGenerator) Length() int {
+	switch g {
+	case UUIDv4, UUIDv7:
+		return 36
+	case ULID:
+		return 26
+	}
+	return 0
+}
+
+func (g IDGenerator) String() string {
+	switch g {
+	case NoIDGenerator:
+		return "none"
+	case UUIDv4:
+		return "uuid4"
+	case UUIDv7:
+		return "uuid7"
+	case ULID:
+		return "ulid"
+	case Snowflake:
+		return "snowflake"
+	}
+	return fmt.Sprintf("unknown id generator %d", int(g))
+}
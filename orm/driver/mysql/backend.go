@@ -15,7 +15,7 @@ import (
 	"gnd.la/util/structs"
 	"gnd.la/util/types"
 
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/go-sql-driver/mysql"
 )
 
 const placeholders = "?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?"
@@ -67,8 +67,12 @@ func (b *Backend) DefineField(db *sql.DB, m driver.Model, table *sql.Table, fiel
 		refTable := ref.References.Table()
 		refField := ref.References.Field()
 		fkName := db.QuoteIdentifier(fmt.Sprintf("%s_%s_%s_%s", m.Table(), field.Name, refTable, refField))
-		cons = append(cons, fmt.Sprintf("FOREIGN KEY %s(%s) REFERENCES %s(%s)", fkName, db.QuoteIdentifier(field.Name),
-			db.QuoteIdentifier(refTable), db.QuoteIdentifier(refField)))
+		fk := fmt.Sprintf("FOREIGN KEY %s(%s) REFERENCES %s(%s)", fkName, db.QuoteIdentifier(field.Name),
+			db.QuoteIdentifier(refTable), db.QuoteIdentifier(refField))
+		if action := ref.OnDelete.SQL(); action != "" {
+			fk += " ON DELETE " + action
+		}
+		cons = append(cons, fk)
 	}
 	return strings.Replace(def, "AUTOINCREMENT", "AUTO_INCREMENT", -1), cons, nil
 }
@@ -100,6 +104,24 @@ func (b *Backend) HasIndex(db *sql.DB, m driver.Model, idx *index.Index, name st
 	return has, nil
 }
 
+func (b *Backend) CreateSearchIndex(db *sql.DB, m driver.Model, name string, fields []string) error {
+	quoted := make([]string, len(fields))
+	for ii, v := range fields {
+		quoted[ii] = db.QuoteIdentifier(v)
+	}
+	_, err := db.Exec(fmt.Sprintf("CREATE FULLTEXT INDEX %s ON %s (%s)", name, db.QuoteIdentifier(m.Table()), strings.Join(quoted, ", ")))
+	return err
+}
+
+func (b *Backend) Match(m driver.Model, name string, fields []string, placeholder string) (string, error) {
+	return b.Rank(m, name, fields, placeholder)
+}
+
+func (b *Backend) Rank(m driver.Model, name string, fields []string, placeholder string) (string, error) {
+	// MATCH evaluates to the relevance, which is zero for non matching rows
+	return fmt.Sprintf("MATCH (%s) AGAINST (%s IN NATURAL LANGUAGE MODE)", strings.Join(fields, ", "), placeholder), nil
+}
+
 func (b *Backend) FieldType(typ reflect.Type, t *structs.Tag) (string, error) {
 	if c := codec.FromTag(t); c != nil {
 		if c.Binary || t.PipeName() != "" {
@@ -156,6 +178,41 @@ func (b *Backend) FieldType(typ reflect.Type, t *structs.Tag) (string, error) {
 	return "", fmt.Errorf("can't map field type %v to a database type", typ)
 }
 
+func (b *Backend) TimeFieldType(opts *driver.TimeOptions) (string, error) {
+	// MySQL has no DATETIME type with time zone, but
+	// values are always stored as UTC.
+	switch opts.Precision {
+	case driver.Millisecond:
+		return "DATETIME(3)", nil
+	case driver.Microsecond:
+		return "DATETIME(6)", nil
+	}
+	return "DATETIME", nil
+}
+
+func (b *Backend) TimePrecision(requested driver.TimePrecision) driver.TimePrecision {
+	// DATETIME without fractional seconds rounds the values rather
+	// than truncating them, so the ORM must truncate them first
+	if requested == driver.DefaultTimePrecision {
+		return driver.Second
+	}
+	return requested
+}
+
+func (b *Backend) Transience(err error) driver.Transience {
+	if err == mysql.ErrInvalidConn {
+		return driver.Disconnected
+	}
+	if me, ok := err.(*mysql.MySQLError); ok {
+		switch me.Number {
+		case 1205, 1213:
+			// ER_LOCK_WAIT_TIMEOUT, ER_LOCK_DEADLOCK
+			return driver.Aborted
+		}
+	}
+	return driver.NotTransient
+}
+
 func (b *Backend) Transforms() []reflect.Type {
 	return transformedTypes
 }
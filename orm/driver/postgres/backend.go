@@ -15,12 +15,18 @@ import (
 	"gnd.la/orm/index"
 	"gnd.la/util/structs"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 const placeholders = "$1 ,$2 ,$3 ,$4 ,$5 ,$6 ,$7 ,$8 ,$9 ,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29,$30,$31,$32"
 
 var (
+	// SearchConfig is the text search configuration used for
+	// full-text search queries and indexes (e.g. 'english' or
+	// 'simple'). Note that changing it requires recreating the
+	// existing search indexes.
+	SearchConfig = "english"
+
 	postgresBackend  = &Backend{}
 	transformedTypes = []reflect.Type{
 		reflect.TypeOf((*time.Time)(nil)),
@@ -96,6 +102,34 @@ func (b *Backend) HasIndex(db *sql.DB, m driver.Model, idx *index.Index, name st
 	return exists != 0, err
 }
 
+func (b *Backend) CreateSearchIndex(db *sql.DB, m driver.Model, name string, fields []string) error {
+	quoted := make([]string, len(fields))
+	for ii, v := range fields {
+		quoted[ii] = db.QuoteIdentifier(v)
+	}
+	_, err := db.Exec(fmt.Sprintf("CREATE INDEX %s ON %s USING GIN (%s)", name, db.QuoteIdentifier(m.Table()), b.tsvector(quoted)))
+	return err
+}
+
+func (b *Backend) Match(m driver.Model, name string, fields []string, placeholder string) (string, error) {
+	return fmt.Sprintf("%s @@ plainto_tsquery('%s', %s)", b.tsvector(fields), SearchConfig, placeholder), nil
+}
+
+func (b *Backend) Rank(m driver.Model, name string, fields []string, placeholder string) (string, error) {
+	return fmt.Sprintf("ts_rank(%s, plainto_tsquery('%s', %s))", b.tsvector(fields), SearchConfig, placeholder), nil
+}
+
+// tsvector returns the tsvector expression for the given quoted fields.
+// Note that indexes are only used when the expression in the
+// query is the same one used in the index.
+func (b *Backend) tsvector(fields []string) string {
+	values := make([]string, len(fields))
+	for ii, v := range fields {
+		values[ii] = "coalesce(" + v + ", '')"
+	}
+	return fmt.Sprintf("to_tsvector('%s', %s)", SearchConfig, strings.Join(values, " || ' ' || "))
+}
+
 func (b *Backend) FieldType(typ reflect.Type, t *structs.Tag) (string, error) {
 	if c := codec.FromTag(t); c != nil {
 		// TODO: Use type JSON on Postgresql >= 9.2 for JSON encoded fields
@@ -164,6 +198,56 @@ func (b *Backend) FieldType(typ reflect.Type, t *structs.Tag) (string, error) {
 	return "", fmt.Errorf("can't map field type %v to a database type", typ)
 }
 
+func (b *Backend) IDFieldType(typ reflect.Type, t *structs.Tag, gen driver.IDGenerator) (string, error) {
+	if gen.IsUUID() {
+		return "UUID", nil
+	}
+	if gen.IsString() {
+		return fmt.Sprintf("CHAR (%d)", gen.Length()), nil
+	}
+	return b.FieldType(typ, t)
+}
+
+func (b *Backend) TimeFieldType(opts *driver.TimeOptions) (string, error) {
+	if opts.TimeZone {
+		return "TIMESTAMP WITH TIME ZONE", nil
+	}
+	return "TIMESTAMP WITHOUT TIME ZONE", nil
+}
+
+func (b *Backend) TimePrecision(requested driver.TimePrecision) driver.TimePrecision {
+	if requested == driver.DefaultTimePrecision {
+		return driver.Microsecond
+	}
+	return requested
+}
+
+func (b *Backend) Transience(err error) driver.Transience {
+	if pe, ok := err.(*pq.Error); ok {
+		switch pe.Code {
+		case "40001", "40P01", "55P03":
+			// serialization_failure, deadlock_detected, lock_not_available
+			return driver.Aborted
+		case "57P01", "57P02", "57P03":
+			// admin_shutdown, crash_shutdown, cannot_connect_now
+			return driver.Disconnected
+		}
+		if pe.Code.Class() == "08" {
+			// connection_exception
+			return driver.Disconnected
+		}
+	}
+	return driver.NotTransient
+}
+
+func (b *Backend) SetStatementTimeout(tx *sql.DB, timeout time.Duration) error {
+	// SET LOCAL only lasts until the end of the transaction, so
+	// it doesn't leak into other uses of the pooled connection.
+	ms := (timeout + time.Millisecond - 1) / time.Millisecond
+	_, err := tx.Exec(fmt.Sprintf("SET LOCAL statement_timeout = %d", ms))
+	return err
+}
+
 func (b *Backend) Transforms() []reflect.Type {
 	return transformedTypes
 }
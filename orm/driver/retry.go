@@ -0,0 +1,68 @@
+package driver
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Transience classifies the errors returned by the drivers
+// according to whether the failed operation might be retried.
+type Transience int
+
+const (
+	// NotTransient errors won't go away by retrying.
+	NotTransient Transience = iota
+	// Aborted errors (e.g. deadlocks or serialization failures)
+	// guarantee that the failed statement or transaction had no
+	// effect, so it might be safely retried.
+	Aborted
+	// Disconnected errors (e.g. connection resets) don't guarantee
+	// whether the statement was applied, so only idempotent
+	// statements might be safely retried.
+	Disconnected
+)
+
+func (t Transience) String() string {
+	switch t {
+	case NotTransient:
+		return "not transient"
+	case Aborted:
+		return "aborted"
+	case Disconnected:
+		return "disconnected"
+	}
+	return "unknown transience"
+}
+
+// RetryPolicy indicates how drivers retry the statements and the
+// transactions which fail with transient errors. Retries are waited
+// for using exponential backoff with jitter.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including
+	// the first one. Values lower than 2 disable retries.
+	MaxAttempts int
+	// Backoff is the base delay before the first retry, which
+	// is doubled for every subsequent retry.
+	Backoff time.Duration
+	// MaxBackoff is the maximum delay between retries. Zero
+	// means no limit.
+	MaxBackoff time.Duration
+}
+
+// Delay returns the delay before the given retry, starting at 1.
+// The returned value is randomly picked from [d/2, d], where d is
+// the backoff for the retry.
+func (p *RetryPolicy) Delay(retry int) time.Duration {
+	d := p.Backoff
+	for ii := 1; ii < retry && (p.MaxBackoff <= 0 || d < p.MaxBackoff); ii++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
@@ -1,5 +1,9 @@
 package driver
 
+import (
+	"gnd.la/orm/query"
+)
+
 type SortDirection int
 
 const (
@@ -12,3 +16,14 @@ type Sort interface {
 	Field() string
 	Direction() SortDirection
 }
+
+// SearchRank is implemented by the Sort values which order the
+// results by their relevance for a full-text search, from the most
+// relevant to the least one. Drivers with the CAP_SEARCH capability
+// must handle it.
+type SearchRank interface {
+	Sort
+	// Match returns the search text and the fields
+	// used for computing the relevance.
+	Match() *query.Match
+}
@@ -237,6 +237,9 @@ func (b *SqlBackend) DefineField(db *DB, m driver.Model, table *Table, f *Field)
 	if ref := f.Constraint(ConstraintForeignKey); ref != nil {
 		s += fmt.Sprintf(" REFERENCES %s(%s)",
 			db.QuoteIdentifier(ref.References.Table()), db.QuoteIdentifier(ref.References.Field()))
+		if action := ref.OnDelete.SQL(); action != "" {
+			s += " ON DELETE " + action
+		}
 	}
 	return s, nil, nil
 }
@@ -2,19 +2,30 @@ package sql
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"errors"
 	"hash/crc32"
 	"strings"
 	"sync"
+	"time"
 
 	"gnd.la/internal"
+	"gnd.la/metrics"
 	"gnd.la/orm/driver"
+	"gnd.la/tracing"
 )
 
 var (
 	ErrNoRows           = sql.ErrNoRows
 	ErrFuncNotSupported = errors.New("function not supported")
+
+	queriesTotal = metrics.NewCounter("gondola_orm_queries_total",
+		"Number of SQL queries executed, by backend", "backend")
+	queryErrors = metrics.NewCounter("gondola_orm_query_errors_total",
+		"Number of SQL queries which returned an error, by backend", "backend")
+	queryDuration = metrics.NewHistogram("gondola_orm_query_duration_seconds",
+		"Time spent executing SQL queries, by backend", nil, "backend")
 )
 
 type Queryier interface {
@@ -29,6 +40,9 @@ type Executor interface {
 type queryExecutor interface {
 	Queryier
 	Executor
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
 }
 
 type cacheEntry struct {
@@ -36,6 +50,13 @@ type cacheEntry struct {
 	stmt *sql.Stmt
 }
 
+// stmtCache is shared by a DB and its copies (e.g.
+// the ones used for transactions).
+type stmtCache struct {
+	mu      sync.RWMutex
+	entries map[uint32]cacheEntry
+}
+
 type DB struct {
 	// database/sql.DB
 	sqlDb *sql.DB
@@ -47,8 +68,10 @@ type DB struct {
 	conn                 queryExecutor
 	driver               *Driver
 	replacesPlaceholders bool
-	mu                   sync.RWMutex
-	cache                map[uint32]cacheEntry
+	cache                *stmtCache
+	// context used for running the statements, might
+	// be nil. See Driver.WithContext.
+	ctx context.Context
 }
 
 func (d *DB) replacePlaceholders(query string) string {
@@ -87,12 +110,20 @@ func (d *DB) Exec(query string, args ...interface{}) (sql.Result, error) {
 		query = d.replacePlaceholders(query)
 	}
 	d.driver.debugq(query, args)
-	if len(args) > 0 {
-		if stmt := d.preparedStmt(query); stmt != nil {
-			return stmt.Exec(args...)
+	span := d.startSpan(query)
+	started := time.Now()
+	var res sql.Result
+	err := d.retry(query, func() error {
+		var err error
+		if stmt := d.stmt(query, args); stmt != nil {
+			res, err = stmt.ExecContext(d.context(), args...)
+		} else {
+			res, err = d.conn.ExecContext(d.context(), query, args...)
 		}
-	}
-	return d.conn.Exec(query, args...)
+		return err
+	})
+	d.observe(started, span, err)
+	return res, err
 }
 
 func (d *DB) Query(query string, args ...interface{}) (*sql.Rows, error) {
@@ -100,12 +131,20 @@ func (d *DB) Query(query string, args ...interface{}) (*sql.Rows, error) {
 		query = d.replacePlaceholders(query)
 	}
 	d.driver.debugq(query, args)
-	if len(args) > 0 {
-		if stmt := d.preparedStmt(query); stmt != nil {
-			return stmt.Query(args...)
+	span := d.startSpan(query)
+	started := time.Now()
+	var rows *sql.Rows
+	err := d.retry(query, func() error {
+		var err error
+		if stmt := d.stmt(query, args); stmt != nil {
+			rows, err = stmt.QueryContext(d.context(), args...)
+		} else {
+			rows, err = d.conn.QueryContext(d.context(), query, args...)
 		}
-	}
-	return d.conn.Query(query, args...)
+		return err
+	})
+	d.observe(started, span, err)
+	return rows, err
 }
 
 func (d *DB) QueryRow(query string, args ...interface{}) *sql.Row {
@@ -114,12 +153,57 @@ func (d *DB) QueryRow(query string, args ...interface{}) *sql.Row {
 	}
 	query = d.replacePlaceholders(query)
 	d.driver.debugq(query, args)
+	span := d.startSpan(query)
+	started := time.Now()
+	var row *sql.Row
+	if stmt := d.stmt(query, args); stmt != nil {
+		row = stmt.QueryRowContext(d.context(), args...)
+	} else {
+		row = d.conn.QueryRowContext(d.context(), query, args...)
+	}
+	// Errors are deferred until Scan is called,
+	// so they're not counted for QueryRow.
+	d.observe(started, span, nil)
+	return row
+}
+
+// stmt returns the prepared statement for the given query,
+// if it should be executed using one, or nil otherwise.
+func (d *DB) stmt(query string, args []interface{}) *sql.Stmt {
 	if len(args) > 0 {
-		if stmt := d.preparedStmt(query); stmt != nil {
-			return stmt.QueryRow(args...)
-		}
+		return d.preparedStmt(query)
+	}
+	return nil
+}
+
+// startSpan starts a tracing span for the given query, as a child
+// of the span carried by the DB context, if any. See gnd.la/tracing.
+func (d *DB) startSpan(query string) *tracing.Span {
+	if !tracing.Enabled() {
+		return nil
+	}
+	name := query
+	if idx := strings.IndexByte(name, ' '); idx > 0 {
+		name = name[:idx]
+	}
+	return tracing.Start(d.ctx, "SQL "+strings.ToUpper(name), tracing.Client).
+		SetAttribute("db.system", d.driver.backend.Name()).
+		SetAttribute("db.statement", query)
+}
+
+// observe records an executed query in the metrics
+// exported via gnd.la/metrics and ends its tracing span.
+func (d *DB) observe(started time.Time, span *tracing.Span, err error) {
+	if err != sql.ErrNoRows {
+		span.SetError(err)
+	}
+	span.End()
+	backend := d.driver.backend.Name()
+	queriesTotal.Inc(backend)
+	queryDuration.Observe(time.Since(started).Seconds(), backend)
+	if err != nil && err != sql.ErrNoRows {
+		queryErrors.Inc(backend)
 	}
-	return d.conn.QueryRow(query, args...)
 }
 
 func (d *DB) Begin() (*DB, error) {
@@ -183,9 +267,9 @@ func (d *DB) quoteWith(s string, q byte) string {
 
 func (d *DB) preparedStmt(s string) *sql.Stmt {
 	key := crc32.ChecksumIEEE(internal.StringToBytes(s))
-	d.mu.RLock()
-	cached, ok := d.cache[key]
-	d.mu.RUnlock()
+	d.cache.mu.RLock()
+	cached, ok := d.cache.entries[key]
+	d.cache.mu.RUnlock()
 	if ok && cached.sql == s {
 		if d.tx != nil {
 			return d.tx.Stmt(cached.stmt)
@@ -197,12 +281,12 @@ func (d *DB) preparedStmt(s string) *sql.Stmt {
 		// Let the non-prepared method report the error
 		return nil
 	}
-	d.mu.Lock()
-	if d.cache == nil {
-		d.cache = make(map[uint32]cacheEntry)
+	d.cache.mu.Lock()
+	if d.cache.entries == nil {
+		d.cache.entries = make(map[uint32]cacheEntry)
 	}
-	d.cache[key] = cacheEntry{sql: s, stmt: stmt}
-	d.mu.Unlock()
+	d.cache.entries[key] = cacheEntry{sql: s, stmt: stmt}
+	d.cache.mu.Unlock()
 	if d.tx != nil {
 		return d.tx.Stmt(stmt)
 	}
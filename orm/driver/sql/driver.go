@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"gnd.la/app/profile"
 	"gnd.la/config"
@@ -27,10 +28,15 @@ var (
 )
 
 type Driver struct {
-	db         *DB
-	logger     *log.Logger
-	backend    Backend
-	transforms map[reflect.Type]struct{}
+	db          *DB
+	logger      *log.Logger
+	backend     Backend
+	transforms  map[reflect.Type]struct{}
+	timeOptions driver.TimeOptions
+	retryPolicy *driver.RetryPolicy
+	// server side timeout for statements in transactions,
+	// see SetStatementTimeout.
+	statementTimeout time.Duration
 }
 
 func (d *Driver) Check() error {
@@ -88,6 +94,9 @@ func (d *Driver) createIndexes(m driver.Model) error {
 }
 
 func (d *Driver) createIndex(m driver.Model, idx *index.Index, name string) error {
+	if IsSearchIndex(idx) {
+		return d.createSearchIndex(m, idx, name)
+	}
 	has, err := d.backend.HasIndex(d.db, m, idx, name)
 	if err != nil {
 		return err
@@ -101,9 +110,9 @@ func (d *Driver) createIndex(m driver.Model, idx *index.Index, name string) erro
 	if idx.Unique {
 		buf.WriteString("UNIQUE ")
 	}
-	buf.WriteString("INDEX ")
+	buf.WriteString("INDEX \"")
 	buf.WriteString(name)
-	buf.WriteString(" ON \"")
+	buf.WriteString("\" ON \"")
 	buf.WriteString(m.Table())
 	buf.WriteString("\" (")
 	fields := m.Fields()
@@ -146,6 +155,9 @@ func (d *Driver) indexName(m driver.Model, idx *index.Index) (string, error) {
 			buf.WriteString("_desc")
 		}
 	}
+	if IsSearchIndex(idx) {
+		buf.WriteString("_search")
+	}
 	s := buf.String()
 	putBuffer(buf)
 	return s, nil
@@ -416,6 +428,9 @@ func (d *Driver) saveParameters(m driver.Model, data interface{}) (reflect.Value
 			ft := f.Type()
 			var fval interface{}
 			if _, ok := d.transforms[ft]; ok {
+				if f, err = d.normalizeTime(f, fields.Tags[ii]); err != nil {
+					return val, nil, nil, err
+				}
 				fval, err = d.backend.TransformOutValue(f)
 				if err != nil {
 					return val, nil, nil, err
@@ -518,7 +533,7 @@ func (d *Driver) outValues(m driver.Model, out interface{}) (reflect.Value, *dri
 	for ii, v := range fields.Indexes {
 		field := d.fieldByIndex(val, v, true)
 		tag := fields.Tags[ii]
-		s := newScanner(&field, tag, d.backend)
+		s := newScanner(&field, tag, d.backend, d.timeOptions.Location)
 		scanners[ii] = s
 		values[ii] = s
 	}
@@ -547,7 +562,13 @@ func (d *Driver) makeTable(m driver.Model) (*Table, error) {
 	for ii, v := range names {
 		typ := ftypes[ii]
 		tag := tags[ii]
-		ft, err := d.backend.FieldType(typ, tag)
+		var ft string
+		var err error
+		if gen := fieldIDGenerator(fields, ii); gen != driver.NoIDGenerator {
+			ft, err = d.idFieldType(typ, tag, gen)
+		} else {
+			ft, err = d.fieldType(typ, tag)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -598,6 +619,7 @@ func (d *Driver) makeTable(m driver.Model) (*Table, error) {
 			field.Constraints = append(field.Constraints, &Constraint{
 				Type:       ConstraintForeignKey,
 				References: MakeReference(ref.Model.Table(), fk),
+				OnDelete:   ref.OnDelete,
 			})
 		}
 		dbFields[ii] = field
@@ -717,7 +739,11 @@ func (d *Driver) condition(buf *bytes.Buffer, params *[]interface{}, m driver.Mo
 			}
 			jj := len(*params) + begin
 			for ii := 0; ii < vLen; ii++ {
-				*params = append(*params, value.Index(ii).Interface())
+				param, err := d.conditionParam(value.Index(ii).Interface())
+				if err != nil {
+					return err
+				}
+				*params = append(*params, param)
 				buf.WriteString(d.backend.Placeholder(jj))
 				buf.WriteByte(',')
 				jj++
@@ -727,6 +753,8 @@ func (d *Driver) condition(buf *bytes.Buffer, params *[]interface{}, m driver.Mo
 			return fmt.Errorf("argument for IN must be slice or array or query.Subquery (field %s)", x.Field.Field)
 		}
 		buf.WriteByte(')')
+	case *query.Match:
+		err = d.search(buf, params, m, x, false, begin)
 	case *query.And:
 		err = d.conditions(buf, params, m, x.Conditions, " AND ", begin)
 	case *query.Or:
@@ -755,14 +783,30 @@ func (d *Driver) clause(buf *bytes.Buffer, params *[]interface{}, m driver.Model
 			fmt.Fprintf(buf, format, dbName, "("+string(sq)+")")
 			return nil
 		}
+		param, err := d.conditionParam(f.Value)
+		if err != nil {
+			return err
+		}
 		fmt.Fprintf(buf, format, dbName, d.backend.Placeholder(len(*params)+begin))
-		*params = append(*params, f.Value)
+		*params = append(*params, param)
 		return nil
 	}
 	fmt.Fprintf(buf, format, dbName)
 	return nil
 }
 
+// conditionParam returns the parameter for comparing against the
+// given value, transforming it when the backend stores its type
+// in a different representation (e.g. time.Time as an integer).
+func (d *Driver) conditionParam(value interface{}) (interface{}, error) {
+	if d.transforms != nil {
+		if _, ok := d.transforms[reflect.TypeOf(value)]; ok {
+			return d.backend.TransformOutValue(reflect.ValueOf(value))
+		}
+	}
+	return value, nil
+}
+
 func (d *Driver) conditions(buf *bytes.Buffer, params *[]interface{}, m driver.Model, q []query.Q, sep string, begin int) error {
 	buf.WriteByte('(')
 	for _, v := range q {
@@ -852,6 +896,13 @@ func (d *Driver) Select(fields []string, quote bool, m driver.Model, q query.Q,
 	if len(sort) > 0 {
 		buf.WriteString(" ORDER BY ")
 		for _, v := range sort {
+			if r, ok := v.(driver.SearchRank); ok {
+				if err := d.search(buf, &params, m, r.Match(), true, 0); err != nil {
+					return nil, nil, err
+				}
+				buf.WriteString(" DESC,")
+				continue
+			}
 			dbName, _, err := m.Map(v.Field())
 			if err != nil {
 				return nil, nil, err
@@ -883,6 +934,10 @@ func (d *Driver) Begin() (driver.Tx, error) {
 	drv := *d
 	drv.db = tx
 	tx.driver = &drv
+	if err := drv.setStatementTimeout(); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 	return &drv, nil
 }
 
@@ -894,15 +949,34 @@ func (d *Driver) Rollback() error {
 	return d.db.Rollback()
 }
 
+func (d *Driver) Savepoint(name string) error {
+	_, err := d.db.Exec("SAVEPOINT " + d.db.QuoteIdentifier(name))
+	return err
+}
+
+func (d *Driver) RollbackTo(name string) error {
+	_, err := d.db.Exec("ROLLBACK TO SAVEPOINT " + d.db.QuoteIdentifier(name))
+	return err
+}
+
+func (d *Driver) Release(name string) error {
+	_, err := d.db.Exec("RELEASE SAVEPOINT " + d.db.QuoteIdentifier(name))
+	return err
+}
+
 func (d *Driver) Transaction(f func(driver.Driver) error) error {
 	return nil
 }
 
 func (d *Driver) Capabilities() driver.Capability {
-	return driver.CAP_JOIN | driver.CAP_OR | driver.CAP_TRANSACTION | driver.CAP_BEGIN |
+	caps := driver.CAP_JOIN | driver.CAP_OR | driver.CAP_TRANSACTION | driver.CAP_BEGIN |
 		driver.CAP_AUTO_ID | driver.CAP_AUTO_INCREMENT | driver.CAP_PK |
 		driver.CAP_COMPOSITE_PK | driver.CAP_UNIQUE | driver.CAP_DEFAULTS |
-		d.backend.Capabilities()
+		driver.CAP_FOREIGN_KEYS | d.backend.Capabilities()
+	if _, ok := d.backend.(Searcher); ok {
+		caps |= driver.CAP_SEARCH
+	}
+	return caps
 }
 
 func (d *Driver) HasFunc(fname string, retType reflect.Type) bool {
@@ -934,14 +1008,17 @@ func NewDriver(b Backend, url *config.URL) (*Driver, error) {
 		}
 	}
 	driver := &Driver{backend: b, transforms: transforms}
-	driver.db = &DB{sqlDb: conn, conn: conn, driver: driver, replacesPlaceholders: b.Placeholder(0) != "?"}
+	driver.db = &DB{sqlDb: conn, conn: conn, driver: driver, replacesPlaceholders: b.Placeholder(0) != "?", cache: &stmtCache{}}
 	return driver, nil
 }
 
 // Assume s is quoted
 func unquote(s string) string {
-	p := strings.Index(s, ".")
-	return s[p+2 : len(s)-1]
+	// The table name might contain dots (e.g. gnd.la_apps_users_...)
+	if p := strings.LastIndex(s, "\".\""); p >= 0 {
+		return s[p+3 : len(s)-1]
+	}
+	return s[1 : len(s)-1]
 }
 
 func fieldHasDefault(m driver.Model, f *Field) bool {
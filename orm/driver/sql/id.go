@@ -0,0 +1,46 @@
+package sql
+
+import (
+	"fmt"
+	"reflect"
+
+	"gnd.la/orm/driver"
+	"gnd.la/util/structs"
+)
+
+// IDBackend is implemented by the Backends which use specific
+// column types for the primary keys generated by the ORM (see
+// driver.IDGenerator). Backends which don't implement it use
+// CHAR columns for string IDs and FieldType for the rest.
+type IDBackend interface {
+	// IDFieldType returns the database type for the primary
+	// keys using the given generator, as well as for the fields
+	// referencing them.
+	IDFieldType(typ reflect.Type, tag *structs.Tag, gen driver.IDGenerator) (string, error)
+}
+
+func (d *Driver) idFieldType(typ reflect.Type, tag *structs.Tag, gen driver.IDGenerator) (string, error) {
+	if ib, ok := d.backend.(IDBackend); ok {
+		return ib.IDFieldType(typ, tag, gen)
+	}
+	if gen.IsString() {
+		return fmt.Sprintf("CHAR (%d)", gen.Length()), nil
+	}
+	return d.backend.FieldType(typ, tag)
+}
+
+// fieldIDGenerator returns the generator for the field at idx, which
+// is either its own or the one used by the primary key it references,
+// so both fields use the same type.
+func fieldIDGenerator(fields *driver.Fields, idx int) driver.IDGenerator {
+	if idx == fields.PrimaryKey {
+		return fields.IDGenerator
+	}
+	if ref := fields.References[fields.QNames[idx]]; ref != nil {
+		rf := ref.Model.Fields()
+		if n, ok := rf.QNameMap[ref.Field]; ok && n == rf.PrimaryKey {
+			return rf.IDGenerator
+		}
+	}
+	return driver.NoIDGenerator
+}
@@ -0,0 +1,129 @@
+package sql
+
+import (
+	"context"
+	sqldriver "database/sql/driver"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"gnd.la/metrics"
+	"gnd.la/orm/driver"
+)
+
+var (
+	queryRetries = metrics.NewCounter("gondola_orm_query_retries_total",
+		"Number of SQL queries retried after a transient error, by backend", "backend")
+)
+
+// TransientClassifier is implemented by the Backends which can
+// classify their errors as transient, so they might be retried
+// according to the driver.RetryPolicy.
+type TransientClassifier interface {
+	// Transience returns the transience of the given error,
+	// which is never nil.
+	Transience(err error) driver.Transience
+}
+
+// IsConnectionError returns true iff err indicates that the
+// connection to the database was lost (e.g. it was reset by
+// the server). Backends might use it from Transience.
+func IsConnectionError(err error) bool {
+	switch err {
+	case context.Canceled, context.DeadlineExceeded:
+		// DeadlineExceeded implements net.Error
+		return false
+	case sqldriver.ErrBadConn, io.EOF, io.ErrUnexpectedEOF:
+		return true
+	}
+	if oe, ok := err.(*net.OpError); ok {
+		err = oe.Err
+	}
+	if se, ok := err.(*os.SyscallError); ok {
+		err = se.Err
+	}
+	switch err {
+	case syscall.ECONNRESET, syscall.ECONNABORTED, syscall.EPIPE:
+		return true
+	}
+	_, ok := err.(net.Error)
+	return ok
+}
+
+// SetRetryPolicy sets the policy used for retrying statements and
+// transactions which fail with transient errors. A nil policy
+// disables retries, which is the default. See driver.RetryPolicy.
+func (d *Driver) SetRetryPolicy(p *driver.RetryPolicy) {
+	if p != nil {
+		cp := *p
+		p = &cp
+	}
+	d.retryPolicy = p
+}
+
+// RetryPolicy returns the policy used for retrying statements
+// and transactions, or nil if retries are disabled.
+func (d *Driver) RetryPolicy() *driver.RetryPolicy {
+	return d.retryPolicy
+}
+
+// Transience classifies the given error, using the Backend
+// when it implements TransientClassifier.
+func (d *Driver) Transience(err error) driver.Transience {
+	if err == nil {
+		return driver.NotTransient
+	}
+	if c, ok := d.backend.(TransientClassifier); ok {
+		if t := c.Transience(err); t != driver.NotTransient {
+			return t
+		}
+	}
+	if IsConnectionError(err) {
+		return driver.Disconnected
+	}
+	return driver.NotTransient
+}
+
+// retry calls f, which executes the given query, retrying it when it
+// fails with a transient error according to the driver RetryPolicy.
+// Statements are not retried inside transactions, since errors abort
+// the whole transaction, and statements with an unknown outcome are
+// only retried when they're idempotent.
+func (d *DB) retry(query string, f func() error) error {
+	err := f()
+	policy := d.driver.retryPolicy
+	if err == nil || d.tx != nil || policy == nil {
+		return err
+	}
+	for attempt := 1; attempt < policy.MaxAttempts; attempt++ {
+		if d.ctx != nil && d.ctx.Err() != nil {
+			// Cancelled or timed out
+			return err
+		}
+		switch d.driver.Transience(err) {
+		case driver.Aborted:
+		case driver.Disconnected:
+			if !isIdempotent(query) {
+				return err
+			}
+		default:
+			return err
+		}
+		time.Sleep(policy.Delay(attempt))
+		queryRetries.Inc(d.driver.backend.Name())
+		if err = f(); err == nil {
+			break
+		}
+	}
+	return err
+}
+
+// isIdempotent returns true iff running the given
+// query multiple times has the same effect.
+func isIdempotent(query string) bool {
+	query = strings.TrimSpace(query)
+	return len(query) >= 6 && strings.EqualFold(query[:6], "SELECT")
+}
@@ -21,10 +21,23 @@ type scanner struct {
 	Tag     *structs.Tag
 	Nil     bool
 	Backend Backend
+	// Location for the loaded time.Time values,
+	// nil means leaving them as returned by the Backend.
+	Location *time.Location
 }
 
-// Always assume the type is right
 func (s *scanner) Scan(src interface{}) error {
+	if err := s.scan(src); err != nil {
+		return err
+	}
+	if s.Location != nil && !s.Nil && s.Out.Type() == timeType {
+		s.Out.Set(reflect.ValueOf(s.Out.Interface().(time.Time).In(s.Location)))
+	}
+	return nil
+}
+
+// Always assume the type is right
+func (s *scanner) scan(src interface{}) error {
 	switch x := src.(type) {
 	case nil:
 		// Assign zero to the type
@@ -59,14 +72,15 @@ func (s *scanner) Scan(src interface{}) error {
 	return fmt.Errorf("can't scan value %v (%T)", src, src)
 }
 
-func newScanner(val *reflect.Value, t *structs.Tag, backend Backend) *scanner {
+func newScanner(val *reflect.Value, t *structs.Tag, backend Backend, loc *time.Location) *scanner {
 	if x := scannerPool.Get(); x != nil {
 		s := x.(*scanner)
 		s.Out = val
 		s.Tag = t
 		s.Nil = false
 		s.Backend = backend
+		s.Location = loc
 		return s
 	}
-	return &scanner{Out: val, Tag: t, Backend: backend}
+	return &scanner{Out: val, Tag: t, Backend: backend, Location: loc}
 }
@@ -0,0 +1,124 @@
+package sql
+
+import (
+	"bytes"
+	"fmt"
+
+	"gnd.la/orm/driver"
+	"gnd.la/orm/index"
+	"gnd.la/orm/query"
+)
+
+// Searcher is implemented by the Backends which support full-text
+// search. Backends implementing it get the driver.CAP_SEARCH capability.
+type Searcher interface {
+	// CreateSearchIndex creates the full-text search index with the given name
+	// over the given fields, which are received as unquoted column names. It's
+	// only called when HasIndex returns false for the index.
+	CreateSearchIndex(db *DB, m driver.Model, name string, fields []string) error
+	// Match returns a condition which is true for the rows matching the search
+	// text, passed as a parameter with the given placeholder. Fields are quoted
+	// and include the table name.
+	Match(m driver.Model, name string, fields []string, placeholder string) (string, error)
+	// Rank returns an expression which evaluates to the relevance of each row
+	// for the search text. Higher values must indicate more relevant rows. The
+	// rest of the arguments are the same as in Match.
+	Rank(m driver.Model, name string, fields []string, placeholder string) (string, error)
+}
+
+// IsSearchIndex returns true iff the given index is
+// a full-text search index.
+func IsSearchIndex(idx *index.Index) bool {
+	val, _ := idx.Get(index.SEARCH).(bool)
+	return val
+}
+
+func (d *Driver) searcher() (Searcher, error) {
+	if s, ok := d.backend.(Searcher); ok {
+		return s, nil
+	}
+	return nil, fmt.Errorf("%s does not support full-text search", d.backend.Name())
+}
+
+func (d *Driver) createSearchIndex(m driver.Model, idx *index.Index, name string) error {
+	s, err := d.searcher()
+	if err != nil {
+		return err
+	}
+	has, err := d.backend.HasIndex(d.db, m, idx, name)
+	if err != nil || has {
+		return err
+	}
+	fields := m.Fields()
+	names := make([]string, len(idx.Fields))
+	for ii, v := range idx.Fields {
+		dbName, _, err := fields.Map(v)
+		if err != nil {
+			return err
+		}
+		names[ii] = dbName
+	}
+	return s.CreateSearchIndex(d.db, m, name, names)
+}
+
+// searchIndex returns the full-text search index for the given model,
+// declared by its fields with the search tag option.
+func (d *Driver) searchIndex(m driver.Model) (*index.Index, string, error) {
+	for _, v := range m.Indexes() {
+		if IsSearchIndex(v) {
+			name, err := d.indexName(m, v)
+			return v, name, err
+		}
+	}
+	return nil, "", fmt.Errorf("%v has no searchable fields", m.Type())
+}
+
+// search writes the condition or the ranking expression (when rank is true)
+// for the given match to buf, adding the search text to params.
+func (d *Driver) search(buf *bytes.Buffer, params *[]interface{}, m driver.Model, match *query.Match, rank bool, begin int) error {
+	s, err := d.searcher()
+	if err != nil {
+		return err
+	}
+	idx, name, err := d.searchIndex(m)
+	if err != nil {
+		return err
+	}
+	fields := match.Fields
+	if len(fields) == 0 {
+		fields = idx.Fields
+	}
+	dbNames := make([]string, len(fields))
+	for ii, v := range fields {
+		if !searchable(idx, v) {
+			return fmt.Errorf("field %s in %v is not searchable", v, m.Type())
+		}
+		dbName, _, err := m.Map(v)
+		if err != nil {
+			return err
+		}
+		dbNames[ii] = dbName
+	}
+	placeholder := d.backend.Placeholder(len(*params) + begin)
+	var expr string
+	if rank {
+		expr, err = s.Rank(m, name, dbNames, placeholder)
+	} else {
+		expr, err = s.Match(m, name, dbNames, placeholder)
+	}
+	if err != nil {
+		return err
+	}
+	buf.WriteString(expr)
+	*params = append(*params, match.Text)
+	return nil
+}
+
+func searchable(idx *index.Index, field string) bool {
+	for _, v := range idx.Fields {
+		if v == field {
+			return true
+		}
+	}
+	return false
+}
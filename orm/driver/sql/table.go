@@ -95,6 +95,8 @@ func (f *Field) Copy() *Field {
 type Constraint struct {
 	Type       ConstraintType
 	References Reference
+	// OnDelete is only used by foreign keys.
+	OnDelete driver.ReferentialAction
 }
 
 func (c *Constraint) String() string {
@@ -106,6 +108,9 @@ func (c *Constraint) String() string {
 	case ConstraintPrimaryKey:
 		return "PRIMARY_KEY"
 	case ConstraintForeignKey:
+		if c.OnDelete != driver.NoAction {
+			return fmt.Sprintf("FOREIGN_KEY %s ON_DELETE %s", string(c.References), c.OnDelete)
+		}
 		return fmt.Sprintf("FOREIGN_KEY %s", string(c.References))
 	}
 	return fmt.Sprintf("unknown constraint type %d", int(c.Type))
@@ -0,0 +1,90 @@
+package sql
+
+import (
+	"reflect"
+	"time"
+
+	"gnd.la/encoding/codec"
+	"gnd.la/orm/driver"
+	"gnd.la/util/structs"
+)
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// TimeBackend is implemented by the Backends which support storing
+// times using different precisions or column types. Backends which
+// don't implement it use FieldType for time.Time fields and their
+// values are not truncated.
+type TimeBackend interface {
+	// TimeFieldType returns the database type for the
+	// time.Time fields with the given options.
+	TimeFieldType(opts *driver.TimeOptions) (string, error)
+	// TimePrecision returns the precision actually used by the
+	// backend for storing times with the requested precision.
+	TimePrecision(requested driver.TimePrecision) driver.TimePrecision
+}
+
+// SetTimeOptions sets the default options used for storing and
+// loading time.Time fields. See driver.TimeOptions for the details.
+func (d *Driver) SetTimeOptions(opts *driver.TimeOptions) {
+	d.timeOptions = *opts
+}
+
+// TimeOptions returns the default options used for storing
+// and loading time.Time fields.
+func (d *Driver) TimeOptions() *driver.TimeOptions {
+	opts := d.timeOptions
+	return &opts
+}
+
+func (d *Driver) timePrecision(requested driver.TimePrecision) driver.TimePrecision {
+	if tb, ok := d.backend.(TimeBackend); ok {
+		return tb.TimePrecision(requested)
+	}
+	return requested
+}
+
+func isTimeField(typ reflect.Type, tag *structs.Tag) bool {
+	return (typ == timeType || typ == reflect.PtrTo(timeType)) && codec.FromTag(tag) == nil
+}
+
+// fieldType returns the database type for the given field,
+// taking into account the time options for time.Time fields.
+func (d *Driver) fieldType(typ reflect.Type, tag *structs.Tag) (string, error) {
+	if tb, ok := d.backend.(TimeBackend); ok && isTimeField(typ, tag) {
+		opts, err := d.timeOptions.WithTag(tag)
+		if err != nil {
+			return "", err
+		}
+		return tb.TimeFieldType(opts)
+	}
+	return d.backend.FieldType(typ, tag)
+}
+
+// normalizeTime returns the value which should be stored for the
+// field f when it's a non-zero time.Time, as returned by
+// driver.TimePrecision.Normalize. If possible, the normalized
+// value is also assigned to the field, so the object in memory
+// is equal to the one loaded from the database.
+func (d *Driver) normalizeTime(f reflect.Value, tag *structs.Tag) (reflect.Value, error) {
+	v := driver.Direct(f)
+	if !v.IsValid() || v.Type() != timeType {
+		return f, nil
+	}
+	t := v.Interface().(time.Time)
+	if t.IsZero() {
+		return f, nil
+	}
+	opts, err := d.timeOptions.WithTag(tag)
+	if err != nil {
+		return f, err
+	}
+	normalized := reflect.ValueOf(d.timePrecision(opts.Precision).Normalize(t))
+	if v.CanSet() {
+		v.Set(normalized)
+		return f, nil
+	}
+	return normalized, nil
+}
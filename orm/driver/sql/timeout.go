@@ -0,0 +1,63 @@
+package sql
+
+import (
+	"context"
+	"time"
+
+	"gnd.la/orm/driver"
+)
+
+// StatementTimeouter is implemented by the Backends which can
+// limit the duration of the statements on the server side (e.g.
+// postgres, using statement_timeout).
+type StatementTimeouter interface {
+	// SetStatementTimeout limits the duration of the statements
+	// executed in the given transaction.
+	SetStatementTimeout(tx *DB, timeout time.Duration) error
+}
+
+// WithContext returns a copy of the driver which runs its
+// statements using ctx, so they're cancelled when ctx is done.
+// It implements driver.ContextConn.
+func (d *Driver) WithContext(ctx context.Context) driver.Conn {
+	drv := *d
+	db := *d.db
+	db.ctx = ctx
+	drv.db = &db
+	return &drv
+}
+
+// SetStatementTimeout sets the maximum duration for the statements
+// executed in transactions, which is enforced by the database server
+// when the Backend implements StatementTimeouter. This is used in
+// addition to the context deadlines, so long statements are also
+// stopped when the client can't cancel them. Zero disables it,
+// which is the default.
+func (d *Driver) SetStatementTimeout(timeout time.Duration) {
+	d.statementTimeout = timeout
+}
+
+// StatementTimeout returns the timeout set with SetStatementTimeout.
+func (d *Driver) StatementTimeout() time.Duration {
+	return d.statementTimeout
+}
+
+// setStatementTimeout applies the statement timeout to
+// the transaction the driver is running in.
+func (d *Driver) setStatementTimeout() error {
+	if d.statementTimeout <= 0 {
+		return nil
+	}
+	if st, ok := d.backend.(StatementTimeouter); ok {
+		return st.SetStatementTimeout(d.db, d.statementTimeout)
+	}
+	return nil
+}
+
+// context returns the context for running statements.
+func (d *DB) context() context.Context {
+	if d.ctx != nil {
+		return d.ctx
+	}
+	return context.Background()
+}
@@ -15,7 +15,7 @@ import (
 	"gnd.la/util/stringutil"
 	"gnd.la/util/structs"
 
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/mattn/go-sqlite3"
 )
 
 var (
@@ -91,7 +91,9 @@ func (b *Backend) Inspect(db *sql.DB, m driver.Model) (*sql.Table, error) {
 			return nil, err
 		}
 		field := fieldsByName[from]
-		field.Constraints = append(field.Constraints, &sql.Constraint{Type: sql.ConstraintForeignKey, References: sql.MakeReference(table, to)})
+		// NO ACTION is not a valid name, which maps to driver.NoAction
+		action, _ := driver.ParseReferentialAction(strings.Replace(onDelete, " ", "_", -1))
+		field.Constraints = append(field.Constraints, &sql.Constraint{Type: sql.ConstraintForeignKey, References: sql.MakeReference(table, to), OnDelete: action})
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -103,7 +105,16 @@ func (b *Backend) Inspect(db *sql.DB, m driver.Model) (*sql.Table, error) {
 }
 
 func (b *Backend) HasIndex(db *sql.DB, m driver.Model, idx *index.Index, name string) (bool, error) {
-	rows, err := db.Query(fmt.Sprintf("PRAGMA index_info(%s)", name))
+	if sql.IsSearchIndex(idx) {
+		// Search indexes are FTS5 virtual tables
+		var exists int
+		err := db.QueryRow("SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = ?", name).Scan(&exists)
+		if err == sql.ErrNoRows {
+			err = nil
+		}
+		return exists != 0, err
+	}
+	rows, err := db.Query(fmt.Sprintf("PRAGMA index_info(%s)", db.QuoteString(name)))
 	if err != nil {
 		return false, err
 	}
@@ -112,6 +123,65 @@ func (b *Backend) HasIndex(db *sql.DB, m driver.Model, idx *index.Index, name st
 	return has, nil
 }
 
+// CreateSearchIndex creates an external content FTS5 table for the given
+// fields, plus the triggers which keep it up to date, and indexes the
+// existing rows. Note that SQLite must be compiled with FTS5 support
+// (for github.com/mattn/go-sqlite3, use the sqlite_fts5 build tag).
+func (b *Backend) CreateSearchIndex(db *sql.DB, m driver.Model, name string, fields []string) error {
+	table := db.QuoteIdentifier(m.Table())
+	fts := db.QuoteIdentifier(name)
+	columns := make([]string, len(fields))
+	newValues := make([]string, len(fields))
+	oldValues := make([]string, len(fields))
+	for ii, v := range fields {
+		columns[ii] = db.QuoteIdentifier(v)
+		newValues[ii] = "new." + columns[ii]
+		oldValues[ii] = "old." + columns[ii]
+	}
+	cols := strings.Join(columns, ", ")
+	insert := fmt.Sprintf("INSERT INTO %s(rowid, %s) VALUES (new.rowid, %s);", fts, cols, strings.Join(newValues, ", "))
+	remove := fmt.Sprintf("INSERT INTO %s(%s, rowid, %s) VALUES ('delete', old.rowid, %s);", fts, fts, cols, strings.Join(oldValues, ", "))
+	stmts := []string{
+		fmt.Sprintf("CREATE VIRTUAL TABLE %s USING fts5(%s, content=%s)", fts, cols, db.QuoteString(m.Table())),
+		fmt.Sprintf("CREATE TRIGGER %s AFTER INSERT ON %s BEGIN %s END", db.QuoteIdentifier(name+"_insert"), table, insert),
+		fmt.Sprintf("CREATE TRIGGER %s AFTER DELETE ON %s BEGIN %s END", db.QuoteIdentifier(name+"_delete"), table, remove),
+		fmt.Sprintf("CREATE TRIGGER %s AFTER UPDATE ON %s BEGIN %s %s END", db.QuoteIdentifier(name+"_update"), table, remove, insert),
+		fmt.Sprintf("INSERT INTO %s(%s) VALUES ('rebuild')", fts, fts),
+	}
+	for _, v := range stmts {
+		if _, err := db.Exec(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Backend) Match(m driver.Model, name string, fields []string, placeholder string) (string, error) {
+	return fmt.Sprintf("%s.rowid IN (SELECT rowid FROM %s WHERE %s)", quote(m.Table()), quote(name), b.ftsMatch(name, fields, placeholder)), nil
+}
+
+func (b *Backend) Rank(m driver.Model, name string, fields []string, placeholder string) (string, error) {
+	// FTS5 rank is lower for the most relevant rows, so negate it
+	return fmt.Sprintf("(SELECT -rank FROM %s WHERE %s AND rowid = %s.rowid)", quote(name), b.ftsMatch(name, fields, placeholder), quote(m.Table())), nil
+}
+
+// ftsMatch returns the FTS5 MATCH expression for the search text,
+// limiting the search to the given fields using a column filter.
+// The text is quoted as an FTS5 string, so any characters in it
+// are matched literally rather than interpreted as query syntax.
+func (b *Backend) ftsMatch(name string, fields []string, placeholder string) string {
+	columns := make([]string, len(fields))
+	for ii, v := range fields {
+		// Fields are in the form "table"."column"
+		columns[ii] = strings.Trim(v[strings.LastIndex(v, ".")+1:], "\"")
+	}
+	return fmt.Sprintf("%s MATCH '{%s} : \"' || replace(%s, '\"', '\"\"') || '\"'", quote(name), strings.Join(columns, " "), placeholder)
+}
+
+func quote(s string) string {
+	return "\"" + s + "\""
+}
+
 func (b *Backend) DefineField(db *sql.DB, m driver.Model, table *sql.Table, field *sql.Field) (string, []string, error) {
 	if field.HasOption(sql.OptionAutoIncrement) {
 		if field.Constraint(sql.ConstraintPrimaryKey) == nil {
@@ -197,6 +267,26 @@ func (b *Backend) FieldType(typ reflect.Type, t *structs.Tag) (string, error) {
 	return "", fmt.Errorf("can't map field type %v to a database type", typ)
 }
 
+func (b *Backend) TimeFieldType(opts *driver.TimeOptions) (string, error) {
+	return "INTEGER", nil
+}
+
+// TimePrecision always returns driver.Second, since
+// times are stored as seconds since the Unix epoch.
+func (b *Backend) TimePrecision(requested driver.TimePrecision) driver.TimePrecision {
+	return driver.Second
+}
+
+func (b *Backend) Transience(err error) driver.Transience {
+	if se, ok := err.(sqlite3.Error); ok {
+		switch se.Code {
+		case sqlite3.ErrBusy, sqlite3.ErrLocked:
+			return driver.Aborted
+		}
+	}
+	return driver.NotTransient
+}
+
 func (b *Backend) Transforms() []reflect.Type {
 	return transformedTypes
 }
@@ -238,13 +328,15 @@ func (b *Backend) canAddField(f *sql.Field) bool {
 }
 
 func sqliteOpener(url *config.URL) (driver.Driver, error) {
-	drv, err := sql.NewDriver(sqliteBackend, url)
-	if err == nil {
-		if _, err := drv.DB().Exec("PRAGMA foreign_keys = on"); err != nil {
-			return nil, err
-		}
+	// foreign_keys is a per connection setting, so it must be
+	// enabled in the DSN. Otherwise, only the first connection
+	// in the pool would enforce them.
+	u := *url
+	u.Query = config.Map{"_foreign_keys": "1"}
+	for k, v := range url.Query {
+		u.Query[k] = v
 	}
-	return drv, err
+	return sql.NewDriver(sqliteBackend, &u)
 }
 
 func init() {
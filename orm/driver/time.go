@@ -0,0 +1,131 @@
+package driver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gnd.la/util/structs"
+)
+
+// TimePrecision indicates the precision used for storing
+// time.Time values. See TimeOptions.
+type TimePrecision int
+
+const (
+	// DefaultTimePrecision uses the highest precision
+	// supported by the backend.
+	DefaultTimePrecision TimePrecision = iota
+	// Second truncates times to seconds.
+	Second
+	// Millisecond truncates times to milliseconds.
+	Millisecond
+	// Microsecond truncates times to microseconds.
+	Microsecond
+)
+
+// TimePrecisionNames returns the names accepted by
+// ParseTimePrecision.
+func TimePrecisionNames() []string {
+	return []string{"second", "millisecond", "microsecond"}
+}
+
+// ParseTimePrecision parses a precision from its name (second,
+// millisecond or microsecond), as used in the time_precision struct
+// tag option.
+func ParseTimePrecision(s string) (TimePrecision, error) {
+	switch strings.ToLower(s) {
+	case "":
+		return DefaultTimePrecision, nil
+	case "second":
+		return Second, nil
+	case "millisecond":
+		return Millisecond, nil
+	case "microsecond":
+		return Microsecond, nil
+	}
+	return DefaultTimePrecision, fmt.Errorf("invalid time precision %q", s)
+}
+
+// Duration returns the duration which times are truncated
+// to, or zero for DefaultTimePrecision.
+func (p TimePrecision) Duration() time.Duration {
+	switch p {
+	case Second:
+		return time.Second
+	case Millisecond:
+		return time.Millisecond
+	case Microsecond:
+		return time.Microsecond
+	}
+	return 0
+}
+
+// Normalize returns t as it should be stored with this precision:
+// converted to UTC, truncated and without a monotonic clock reading.
+// DefaultTimePrecision doesn't truncate the time.
+func (p TimePrecision) Normalize(t time.Time) time.Time {
+	// Round(0) strips the monotonic clock reading
+	t = t.Round(0).UTC()
+	if d := p.Duration(); d > 0 {
+		t = t.Truncate(d)
+	}
+	return t
+}
+
+func (p TimePrecision) String() string {
+	switch p {
+	case DefaultTimePrecision:
+		return "default"
+	case Second:
+		return "second"
+	case Millisecond:
+		return "millisecond"
+	case Microsecond:
+		return "microsecond"
+	}
+	return fmt.Sprintf("unknown precision %d", int(p))
+}
+
+// TimeOptions indicates how drivers store and load time.Time values.
+// Times are always stored as UTC, truncated to the precision, so a
+// saved time.Time compares equal (using ==) to the one loaded back,
+// regardless of the driver.
+//
+// The ORM defaults might be changed with Orm.SetTimeOptions, while
+// the struct tags in each field take precedence over them:
+//
+//  - time_precision=second|millisecond|microsecond sets the Precision.
+//  - time_zone sets TimeZone.
+type TimeOptions struct {
+	// Precision is the precision used for storing times. When
+	// it's higher than the one supported by the backend, the
+	// latter is used instead.
+	Precision TimePrecision
+	// TimeZone makes the backends which support it (e.g. postgres)
+	// use a column type with time zone. Note that values are still
+	// stored as UTC.
+	TimeZone bool
+	// Location is the time zone for the times loaded from the
+	// database. If nil, times are returned in UTC.
+	Location *time.Location
+}
+
+// WithTag returns a copy of the options, overridden by the
+// time options in the given tag, if any.
+func (o *TimeOptions) WithTag(tag *structs.Tag) (*TimeOptions, error) {
+	opts := *o
+	if tag != nil {
+		if p := tag.Value("time_precision"); p != "" {
+			precision, err := ParseTimePrecision(p)
+			if err != nil {
+				return nil, err
+			}
+			opts.Precision = precision
+		}
+		if tag.Has("time_zone") {
+			opts.TimeZone = true
+		}
+	}
+	return &opts, nil
+}
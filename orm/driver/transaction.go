@@ -15,3 +15,16 @@ type Tx interface {
 	Commit() error
 	Rollback() error
 }
+
+// Savepointer is implemented by transactions which support
+// savepoints, which the ORM uses for nested transactions.
+type Savepointer interface {
+	// Savepoint creates a new savepoint with the given name.
+	Savepoint(name string) error
+	// RollbackTo rolls back the changes made after
+	// the savepoint with the given name.
+	RollbackTo(name string) error
+	// Release removes the savepoint with the given name,
+	// keeping the changes made after it.
+	Release(name string) error
+}
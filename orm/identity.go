@@ -0,0 +1,178 @@
+package orm
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+type identityKey struct {
+	model *model
+	pk    string
+}
+
+// IdentityMap caches the objects loaded by primary key using
+// Orm.Get, so looking up the same object several times returns
+// the same instance without querying the database again. It's
+// intended to be short lived (e.g. for the duration of a request,
+// like the one used by gnd.la/app.Context.Orm) and it's safe for
+// concurrent use.
+//
+// Inserting, updating or deleting objects of a model through an
+// Orm using the IdentityMap evicts all the cached objects of that
+// model, but changes made from other Orm instances won't be noticed.
+type IdentityMap struct {
+	mu      sync.Mutex
+	objects map[identityKey]reflect.Value
+}
+
+// NewIdentityMap returns a new empty IdentityMap.
+func NewIdentityMap() *IdentityMap {
+	return &IdentityMap{objects: make(map[identityKey]reflect.Value)}
+}
+
+// Len returns the number of cached objects.
+func (im *IdentityMap) Len() int {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	return len(im.objects)
+}
+
+// Clear removes all the cached objects.
+func (im *IdentityMap) Clear() {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	im.objects = make(map[identityKey]reflect.Value)
+}
+
+func (im *IdentityMap) get(key identityKey) (reflect.Value, bool) {
+	if im == nil {
+		return reflect.Value{}, false
+	}
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	val, ok := im.objects[key]
+	return val, ok
+}
+
+func (im *IdentityMap) set(key identityKey, val reflect.Value) {
+	if im == nil {
+		return
+	}
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	im.objects[key] = val
+}
+
+// evict removes all the cached objects of the given model.
+func (im *IdentityMap) evict(m *model) {
+	if im == nil {
+		return
+	}
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	for k := range im.objects {
+		if k.model == m {
+			delete(im.objects, k)
+		}
+	}
+}
+
+// evictDeleted evicts the objects of m as well as the
+// ones which might be changed by the ON DELETE actions
+// of its referrers, since the database might apply them.
+func (im *IdentityMap) evictDeleted(m *model, seen map[*model]bool) {
+	if im == nil || seen[m] {
+		return
+	}
+	if seen == nil {
+		seen = make(map[*model]bool)
+	}
+	seen[m] = true
+	im.evict(m)
+	for _, v := range m.referrers {
+		im.evictDeleted(v.model, seen)
+	}
+}
+
+// WithIdentityMap returns a copy of the Orm which caches the objects
+// loaded with Get in the given IdentityMap. Pass nil to disable it.
+func (o *Orm) WithIdentityMap(im *IdentityMap) *Orm {
+	cpy := *o
+	cpy.identity = im
+	return &cpy
+}
+
+// IdentityMap returns the IdentityMap used by the Orm, or
+// nil if there's none. See WithIdentityMap.
+func (o *Orm) IdentityMap() *IdentityMap {
+	return o.identity
+}
+
+// Get loads the object of the out type with the given primary
+// key, returning true iff it was found. The model must have a
+// non-composite primary key.
+//
+// If out is a pointer to a pointer (e.g. **User) and the Orm has an
+// IdentityMap, repeated lookups of the same object return the same
+// instance without querying the database. If out is just a pointer,
+// the cached object is copied into it. The IdentityMap is not used
+// when running in a transaction.
+func (o *Orm) Get(pk interface{}, out interface{}) (bool, error) {
+	val := reflect.ValueOf(out)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return false, fmt.Errorf("Get requires a non-nil pointer, not %T", out)
+	}
+	o, m, err := o.routeModel(out)
+	if err != nil {
+		return false, err
+	}
+	if m.fields.PrimaryKey < 0 {
+		return false, fmt.Errorf("model %q does not have a non-composite primary key", m.name)
+	}
+	im := o.identity
+	if o.inTransaction() {
+		im = nil
+	}
+	key := identityKey{model: m, pk: fmt.Sprint(pk)}
+	if obj, ok := im.get(key); ok {
+		setIdentity(val, obj)
+		return true, nil
+	}
+	obj := reflect.New(m.Type())
+	pkName := m.fields.QNames[m.fields.PrimaryKey]
+	found, err := o.Table(tableWithModel(m)).Filter(Eq(pkName, pk)).One(obj.Interface())
+	if err != nil || !found {
+		return false, err
+	}
+	im.set(key, obj)
+	setIdentity(val, obj)
+	return true, nil
+}
+
+// MustGet works like Get, but panics if there's an error.
+func (o *Orm) MustGet(pk interface{}, out interface{}) bool {
+	found, err := o.Get(pk, out)
+	if err != nil {
+		panic(err)
+	}
+	return found
+}
+
+// setIdentity stores obj, which is a pointer to a model
+// object, into out, which might be either a pointer to
+// a pointer or a pointer to the object.
+func setIdentity(out reflect.Value, obj reflect.Value) {
+	out = out.Elem()
+	if out.Kind() == reflect.Ptr {
+		for out.Type() != obj.Type() {
+			if out.IsNil() {
+				out.Set(reflect.New(out.Type().Elem()))
+			}
+			out = out.Elem()
+		}
+		out.Set(obj)
+		return
+	}
+	out.Set(obj.Elem())
+}
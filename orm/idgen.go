@@ -0,0 +1,188 @@
+package orm
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"gnd.la/orm/driver"
+)
+
+const (
+	snowflakeNodeBits     = 10
+	snowflakeSequenceBits = 12
+	// MaxSnowflakeNode is the maximum node number
+	// accepted by SetSnowflakeNode.
+	MaxSnowflakeNode = 1<<snowflakeNodeBits - 1
+	maxSnowflakeSeq  = 1<<snowflakeSequenceBits - 1
+
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+var (
+	// snowflakeEpoch is the start of the timestamps in
+	// the snowflake IDs, 2015-01-01 00:00:00 UTC. This
+	// leaves room for ~69 years worth of IDs.
+	snowflakeEpoch = time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	snowflake struct {
+		sync.Mutex
+		node     int64
+		last     int64
+		sequence int64
+	}
+)
+
+func init() {
+	// Default to a node derived from the hostname and the pid, so
+	// processes sharing a database are unlikely to collide. Apps
+	// running several processes should use SetSnowflakeNode.
+	hostname, _ := os.Hostname()
+	h := crc32.ChecksumIEEE([]byte(hostname + ":" + strconv.Itoa(os.Getpid())))
+	snowflake.node = int64(h % (MaxSnowflakeNode + 1))
+}
+
+// SetSnowflakeNode sets the node number used when generating
+// IDs for the primary keys tagged with id=snowflake. Each process
+// inserting into the same tables must use a different node, from
+// 0 to MaxSnowflakeNode. If it's not set, a node is derived from
+// the hostname and the process id.
+func SetSnowflakeNode(node int) error {
+	if node < 0 || node > MaxSnowflakeNode {
+		return fmt.Errorf("invalid snowflake node %d, must be in [0, %d]", node, MaxSnowflakeNode)
+	}
+	snowflake.Lock()
+	snowflake.node = int64(node)
+	snowflake.Unlock()
+	return nil
+}
+
+// setGeneratedID sets the field pointed by val to a new
+// ID produced by the given generator.
+func setGeneratedID(gen driver.IDGenerator, val reflect.Value) error {
+	if gen == driver.Snowflake {
+		id := newSnowflakeID()
+		switch val.Kind() {
+		case reflect.Int64:
+			val.SetInt(id)
+		case reflect.Uint64:
+			val.SetUint(uint64(id))
+		default:
+			return fmt.Errorf("can't assign a snowflake ID to a %s", val.Type())
+		}
+		return nil
+	}
+	var id string
+	var err error
+	switch gen {
+	case driver.UUIDv4:
+		id, err = newUUIDv4()
+	case driver.UUIDv7:
+		id, err = newUUIDv7()
+	case driver.ULID:
+		id, err = newULID()
+	default:
+		return fmt.Errorf("unknown id generator %s", gen)
+	}
+	if err != nil {
+		return err
+	}
+	val.SetString(id)
+	return nil
+}
+
+func newUUIDv4() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return formatUUID(b), nil
+}
+
+func newUUIDv7() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[6:]); err != nil {
+		return "", err
+	}
+	putTimestamp48(b[:6], time.Now())
+	b[6] = (b[6] & 0x0f) | 0x70
+	b[8] = (b[8] & 0x3f) | 0x80
+	return formatUUID(b), nil
+}
+
+func formatUUID(b [16]byte) string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], b[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], b[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], b[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], b[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], b[10:])
+	return string(buf[:])
+}
+
+func newULID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[6:]); err != nil {
+		return "", err
+	}
+	putTimestamp48(b[:6], time.Now())
+	// 128 bits encoded in groups of 5 bits, most
+	// significant first, with 2 bits of padding.
+	var buf [26]byte
+	hi := uint64(b[0])<<56 | uint64(b[1])<<48 | uint64(b[2])<<40 | uint64(b[3])<<32 |
+		uint64(b[4])<<24 | uint64(b[5])<<16 | uint64(b[6])<<8 | uint64(b[7])
+	lo := uint64(b[8])<<56 | uint64(b[9])<<48 | uint64(b[10])<<40 | uint64(b[11])<<32 |
+		uint64(b[12])<<24 | uint64(b[13])<<16 | uint64(b[14])<<8 | uint64(b[15])
+	for ii := len(buf) - 1; ii >= 0; ii-- {
+		buf[ii] = crockfordAlphabet[lo&0x1f]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(buf[:]), nil
+}
+
+// putTimestamp48 stores the milliseconds since the Unix
+// epoch in t as a 48 bit big endian integer.
+func putTimestamp48(b []byte, t time.Time) {
+	ms := uint64(t.UnixNano() / int64(time.Millisecond))
+	for ii := 5; ii >= 0; ii-- {
+		b[ii] = byte(ms)
+		ms >>= 8
+	}
+}
+
+func newSnowflakeID() int64 {
+	snowflake.Lock()
+	defer snowflake.Unlock()
+	now := int64(time.Since(snowflakeEpoch) / time.Millisecond)
+	if now < snowflake.last {
+		// Clock went backwards, keep using the last timestamp
+		now = snowflake.last
+	}
+	if now == snowflake.last {
+		snowflake.sequence = (snowflake.sequence + 1) & maxSnowflakeSeq
+		if snowflake.sequence == 0 {
+			// Sequence exhausted, wait for the next millisecond
+			for now <= snowflake.last {
+				time.Sleep(100 * time.Microsecond)
+				now = int64(time.Since(snowflakeEpoch) / time.Millisecond)
+			}
+		}
+	} else {
+		snowflake.sequence = 0
+	}
+	snowflake.last = now
+	return now<<(snowflakeNodeBits+snowflakeSequenceBits) | snowflake.node<<snowflakeSequenceBits | snowflake.sequence
+}
@@ -16,3 +16,13 @@ const (
 	//	index.New("A", "B", "C").Set(index.DESC, "A", "B")
 	DESC
 )
+
+const (
+	// SEARCH marks the index as a full-text search index, used
+	// by the orm.Match conditions. Models usually declare them
+	// using the "search" tag option in their fields, rather than
+	// setting this option explicitly. e.g.
+	//
+	//	index.New("Title", "Body").Set(index.SEARCH, true)
+	SEARCH = DESC + 1
+)
@@ -17,6 +17,8 @@ type Interface interface {
 	Query(q query.Q) *Query
 	One(q query.Q, out ...interface{}) (bool, error)
 	MustOne(q query.Q, out ...interface{}) bool
+	Get(pk interface{}, out interface{}) (bool, error)
+	MustGet(pk interface{}, out interface{}) bool
 	All() *Query
 	Insert(obj interface{}) (Result, error)
 	MustInsert(obj interface{}) Result
@@ -30,4 +32,5 @@ type Interface interface {
 	Delete(obj interface{}) error
 	MustDelete(obj interface{})
 	Begin() (*Tx, error)
+	EmitOnCommit(name string, object interface{})
 }
@@ -1,6 +1,8 @@
 package orm
 
 import (
+	"context"
+
 	"gnd.la/orm/driver"
 )
 
@@ -8,7 +10,8 @@ type Iter struct {
 	q     *Query
 	limit int
 	driver.Iter
-	err error
+	cancel context.CancelFunc
+	err    error
 }
 
 // Next advances the iter to the next result,
@@ -30,7 +33,10 @@ func (i *Iter) Next(out ...interface{}) bool {
 				i.q.methods = append(i.q.methods, cur.model.fields.Methods)
 			}
 		}
-		i.Iter = i.q.exec(i.limit)
+		if i.err = i.q.route(); i.err != nil {
+			return false
+		}
+		i.Iter, i.cancel = i.q.exec(i.limit)
 	}
 	ok := i.Iter.Next(out...)
 	if ok {
@@ -88,6 +94,7 @@ func (i *Iter) Close() error {
 		ierr := i.Iter.Err()
 		err := i.Iter.Close()
 		i.Iter = nil
+		i.cancel()
 		if ierr != nil {
 			i.err = ierr
 		}
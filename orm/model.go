@@ -33,8 +33,19 @@ func (j JoinType) String() string {
 }
 
 type reference struct {
-	model string
-	field string
+	model    string
+	field    string
+	onDelete driver.ReferentialAction
+}
+
+// referrer represents a field in another model which references
+// a model, with a referential action. It's used for emulating the
+// actions when the driver doesn't enforce them.
+type referrer struct {
+	model    *model
+	field    string
+	ref      string
+	onDelete driver.ReferentialAction
 }
 
 type model struct {
@@ -44,9 +55,23 @@ type model struct {
 	table           string
 	fields          *driver.Fields
 	tags            string
+	database        string
 	references      map[string]*reference
+	polymorphic     map[string]*polymorphicReference
 	modelReferences map[*model][]*join
 	namedReferences map[string]*model
+	referrers       []*referrer
+}
+
+// addReferrer adds r to the model referrers, unless
+// it was already added by a previous initialization.
+func (m *model) addReferrer(r *referrer) {
+	for _, v := range m.referrers {
+		if *v == *r {
+			return
+		}
+	}
+	m.referrers = append(m.referrers, r)
 }
 
 func (m *model) Type() reflect.Type {
@@ -67,7 +92,11 @@ func (m *model) Indexes() []*index.Index {
 		indexes = append(indexes, m.options.Indexes...)
 	}
 	// Add indexes declared in the fields
+	var search []string
 	for ii, v := range m.fields.Tags {
+		if v.Has("search") {
+			search = append(search, m.fields.QNames[ii])
+		}
 		if v.Has("index") {
 			dir := v.Value("index")
 			if dir == "" || dir == "asc" || dir == "both" {
@@ -86,6 +115,14 @@ func (m *model) Indexes() []*index.Index {
 			}
 		}
 	}
+	// Polymorphic references are queried by both fields
+	for k, v := range m.polymorphic {
+		indexes = append(indexes, index.New(k, v.id))
+	}
+	if len(search) > 0 {
+		idx := &index.Index{Fields: search}
+		indexes = append(indexes, idx.Set(index.SEARCH, true))
+	}
 	return indexes
 }
 
@@ -0,0 +1,113 @@
+package orm
+
+import (
+	"fmt"
+	"reflect"
+
+	"gnd.la/orm/driver"
+	"gnd.la/orm/operation"
+	"gnd.la/orm/query"
+)
+
+// ReferencedError is returned when deleting rows which are still
+// referenced by a field declared with on_delete=restrict and
+// the driver doesn't enforce foreign keys.
+type ReferencedError struct {
+	// Model is the name of the model which was being deleted.
+	Model string
+	// Referrer is the name of the model with the references.
+	Referrer string
+	// Field is the name of the field with the references.
+	Field string
+}
+
+func (e *ReferencedError) Error() string {
+	return fmt.Sprintf("can't delete from %s, it's still referenced by %s.%s", e.Model, e.Referrer, e.Field)
+}
+
+// emulatesOnDelete returns true iff deleting from m requires the
+// ORM to emulate the ON DELETE actions of its referrers.
+func (o *Orm) emulatesOnDelete(m *model) bool {
+	return len(m.referrers) > 0 && o.driver.Capabilities()&driver.CAP_FOREIGN_KEYS == 0
+}
+
+// deleteEmulatingOnDelete deletes the rows from m matching q, applying
+// the ON DELETE actions first. When the driver supports transactions
+// and the ORM is not already in one, it uses a new transaction.
+func (o *Orm) deleteEmulatingOnDelete(m *model, q query.Q) (Result, error) {
+	if o.inTransaction() || o.driver.Capabilities()&driver.CAP_TRANSACTION == 0 {
+		if err := o.applyOnDelete(m, q); err != nil {
+			return nil, err
+		}
+		return o.conn.Delete(m, q)
+	}
+	var res Result
+	err := o.Transaction(func(o *Orm) error {
+		if err := o.applyOnDelete(m, q); err != nil {
+			return err
+		}
+		var err error
+		res, err = o.conn.Delete(m, q)
+		return err
+	})
+	return res, err
+}
+
+// applyOnDelete applies the ON DELETE actions of the models
+// referencing the rows from m matching q.
+func (o *Orm) applyOnDelete(m *model, q query.Q) error {
+	for _, r := range m.referrers {
+		values, err := o.fieldValues(m, q, r.ref)
+		if err != nil {
+			return err
+		}
+		if len(values) == 0 {
+			continue
+		}
+		cond := In(r.field, values)
+		switch r.onDelete {
+		case driver.Restrict:
+			exists, err := o.conn.Exists(r.model, cond)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return &ReferencedError{Model: m.name, Referrer: r.model.name, Field: r.field}
+			}
+		case driver.Cascade:
+			if _, err := o.delete(r.model, cond); err != nil {
+				return err
+			}
+		case driver.SetNull:
+			ops := []*operation.Operation{operation.Set(r.field, nil)}
+			if _, err := o.conn.Operate(r.model, cond, ops); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fieldValues returns the values of the given field in
+// the rows from m matching q.
+func (o *Orm) fieldValues(m *model, q query.Q, field string) ([]interface{}, error) {
+	idx, ok := m.fields.QNameMap[field]
+	if !ok {
+		return nil, fmt.Errorf("model %s has no field %q", m.name, field)
+	}
+	iter := o.conn.Query(m, q, nil, -1, -1)
+	var values []interface{}
+	for {
+		obj := reflect.New(m.Type())
+		if !iter.Next(obj.Interface()) {
+			break
+		}
+		if val := o.fieldByIndex(obj, m.fields.Indexes[idx]); val.IsValid() {
+			values = append(values, val.Interface())
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return values, iter.Close()
+}
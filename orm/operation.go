@@ -15,7 +15,22 @@ func (o *Orm) Operate(table *Table, q query.Q, ops ...*operation.Operation) (Res
 	if len(ops) == 0 {
 		return nil, errNoOperations
 	}
-	return o.conn.Operate(table.model, q, ops)
+	m := table.model.model
+	o, err := o.route(m)
+	if err != nil {
+		return nil, err
+	}
+	o.identity.evict(m)
+	if m.audited() {
+		return o.auditUpdate(m, q, func(o *Orm) (Result, error) {
+			conn, cancel := o.timeoutConn(0)
+			defer cancel()
+			return conn.Operate(table.model, q, ops)
+		})
+	}
+	conn, cancel := o.timeoutConn(0)
+	defer cancel()
+	return conn.Operate(table.model, q, ops)
 }
 
 func (o *Orm) MustOperate(table *Table, q query.Q, ops ...*operation.Operation) Result {
@@ -31,4 +31,19 @@ type Options struct {
 	// defined in both the a field tag and using this field, an
 	// error will be returned when registering the model.
 	PrimaryKey []string
+	// Audit enables recording every insert, update and delete of
+	// the model objects made through the Orm in the audit trail,
+	// with the actor (see Orm.WithActor), the time and the changed
+	// fields. Each change and its audit entry are saved in the same
+	// transaction, when the driver supports them. Fields with a
+	// json:"-" tag (e.g. secrets) are not recorded, so Orm.AsOf
+	// doesn't restore them. Audited models must have a non-composite
+	// primary key. See AuditEntry, Orm.History and Orm.AsOf.
+	Audit bool
+	// Database is the name of the database where the model
+	// is stored, registered with RegisterDatabase. If empty,
+	// DefaultDatabase is used. Operations on the model are
+	// routed to its database, unless an Orm returned from
+	// Orm.Using is used.
+	Database string
 }
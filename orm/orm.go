@@ -1,10 +1,13 @@
 package orm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"gnd.la/app/profile"
 	"gnd.la/config"
@@ -28,6 +31,8 @@ var (
 	// indicate that they want the transaction to be rolled back without
 	// returning any error from Orm.Transaction.
 	Rollback = errors.New("transaction rolled back")
+	// savepointCount is used to generate unique savepoint names.
+	savepointCount uint64
 )
 
 const (
@@ -43,6 +48,26 @@ type Orm struct {
 	logger       *log.Logger
 	tags         string
 	typeRegistry typeRegistry
+	queryTimeout time.Duration
+	// ctx is the base context for the operations,
+	// might be nil. See WithContext.
+	ctx context.Context
+	// actor returns the actor recorded in the audit trail
+	actor func() string
+	// identity caches the objects loaded by Get
+	identity *IdentityMap
+	// signals emitted with EmitOnCommit, non-nil
+	// iff the Orm is running a transaction
+	signals *txSignals
+	// database is the name of the database, see RegisterDatabase
+	database  string
+	databases *databases
+	// pinned is true when the Orm was returned by Using, so
+	// models are not routed to the database they belong to
+	pinned bool
+	// crossTx is the database running the transaction this
+	// Orm was obtained from using Using, if any
+	crossTx string
 	// these fields are non-nil iff the ORM driver uses database/sql
 	db *sql.DB
 }
@@ -110,9 +135,11 @@ func (o *Orm) All() *Query {
 // Insert saves an object into its collection. Its
 // type must be previously registered as a model. If the model
 // has an integer primary key with auto_increment, it will be
-// be populated with the database assigned id.
+// be populated with the database assigned id. Empty primary
+// keys with an id generator (e.g. `orm:",primary_key,id=uuid7"`)
+// are assigned a new id before inserting the object.
 func (o *Orm) Insert(obj interface{}) (Result, error) {
-	m, err := o.model(obj)
+	o, m, err := o.routeModel(obj)
 	if err != nil {
 		return nil, err
 	}
@@ -146,6 +173,21 @@ func (o *Orm) insert(m *model, obj interface{}) (Result, error) {
 			return nil, fmt.Errorf("can't set primary key field %q. Please, insert a %v rather than a %v", pkName, reflect.PtrTo(typ), typ)
 		}
 	}
+	if f.IDGenerator != driver.NoIDGenerator {
+		name, val := o.primaryKey(f, obj)
+		if driver.IsZero(val) {
+			if !val.CanSet() {
+				typ := reflect.TypeOf(obj)
+				return nil, fmt.Errorf("can't set primary key field %q. Please, insert a %v rather than a %v", name, reflect.PtrTo(typ), typ)
+			}
+			if err := setGeneratedID(f.IDGenerator, val); err != nil {
+				return nil, err
+			}
+			if o.logger != nil {
+				o.logger.Debugf("Setting primary key %q to %v on model %v", name, val.Interface(), m.Type())
+			}
+		}
+	}
 	if f.Defaults != nil {
 		val := reflect.ValueOf(obj)
 		for k, v := range f.Defaults {
@@ -170,23 +212,31 @@ func (o *Orm) insert(m *model, obj interface{}) (Result, error) {
 			}
 		}
 	}
-	res, err := o.conn.Insert(m, obj)
-	if err == nil && pkVal.IsValid() && pkVal.Int() == 0 {
-		id, err := res.LastInsertId()
-		if err == nil && id != 0 {
-			if o.logger != nil {
-				o.logger.Debugf("Setting primary key %q to %d on model %v", pkName, id, m.Type())
+	insert := func(o *Orm) (Result, error) {
+		conn, cancel := o.timeoutConn(0)
+		res, err := conn.Insert(m, obj)
+		cancel()
+		if err == nil && pkVal.IsValid() && pkVal.Int() == 0 {
+			id, err := res.LastInsertId()
+			if err == nil && id != 0 {
+				if o.logger != nil {
+					o.logger.Debugf("Setting primary key %q to %d on model %v", pkName, id, m.Type())
+				}
+				pkVal.SetInt(id)
+			} else if err != nil && o.logger != nil {
+				o.logger.Errorf("could not obtain last insert id: %s", err)
 			}
-			pkVal.SetInt(id)
-		} else if err != nil && o.logger != nil {
-			o.logger.Errorf("could not obtain last insert id: %s", err)
 		}
+		return res, err
 	}
-	return res, err
+	if m.audited() {
+		return o.auditInsert(m, obj, insert)
+	}
+	return insert(o)
 }
 
 func (o *Orm) Update(q query.Q, obj interface{}) (Result, error) {
-	m, err := o.model(obj)
+	o, m, err := o.routeModel(obj)
 	if err != nil {
 		return nil, err
 	}
@@ -210,7 +260,17 @@ func (o *Orm) update(m *model, q query.Q, obj interface{}) (Result, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("update", m.name).End()
 	}
-	return o.conn.Update(m, q, obj)
+	o.identity.evict(m)
+	if m.audited() {
+		return o.auditUpdate(m, q, func(o *Orm) (Result, error) {
+			conn, cancel := o.timeoutConn(0)
+			defer cancel()
+			return conn.Update(m, q, obj)
+		})
+	}
+	conn, cancel := o.timeoutConn(0)
+	defer cancel()
+	return conn.Update(m, q, obj)
 }
 
 // Upsert tries to perform an update with the given query
@@ -219,7 +279,7 @@ func (o *Orm) update(m *model, q query.Q, obj interface{}) (Result, error) {
 // this operation in just one query, but most require two
 // trips to the database.
 func (o *Orm) Upsert(q query.Q, obj interface{}) (Result, error) {
-	m, err := o.model(obj)
+	o, m, err := o.routeModel(obj)
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +290,10 @@ func (o *Orm) Upsert(q query.Q, obj interface{}) (Result, error) {
 		if profile.On && profile.Profiling() {
 			defer profile.Start(orm).Note("upsert", "").End()
 		}
-		return o.conn.Upsert(m, q, obj)
+		o.identity.evict(m)
+		conn, cancel := o.timeoutConn(0)
+		defer cancel()
+		return conn.Upsert(m, q, obj)
 	}
 	res, err := o.update(m, q, obj)
 	if err != nil {
@@ -265,7 +328,7 @@ func (o *Orm) MustUpsert(q query.Q, obj interface{}) Result {
 // the composite key is non-zero, an update will be tried
 // before performing an insert.
 func (o *Orm) Save(obj interface{}) (Result, error) {
-	m, err := o.model(obj)
+	o, m, err := o.routeModel(obj)
 	if err != nil {
 		return nil, err
 	}
@@ -335,6 +398,10 @@ func (o *Orm) save(m *model, obj interface{}) (Result, error) {
 // DeleteFrom removes all objects from the given table matching
 // the query.
 func (o *Orm) DeleteFrom(t *Table, q query.Q) (Result, error) {
+	o, err := o.route(t.model.model)
+	if err != nil {
+		return nil, err
+	}
 	return o.delete(t.model.model, q)
 }
 
@@ -342,7 +409,7 @@ func (o *Orm) DeleteFrom(t *Table, q query.Q) (Result, error) {
 // previously registered as a table and must have a primary key,
 // either simple or composite.
 func (o *Orm) Delete(obj interface{}) error {
-	m, err := o.model(obj)
+	o, m, err := o.routeModel(obj)
 	if err != nil {
 		return err
 	}
@@ -382,13 +449,31 @@ func (o *Orm) delete(m *model, q query.Q) (Result, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("delete", m.name).End()
 	}
-	return o.conn.Delete(m, q)
+	o.identity.evictDeleted(m, nil)
+	if m.audited() {
+		return o.auditDelete(m, q, func(o *Orm) (Result, error) {
+			return o.deleteUnaudited(m, q)
+		})
+	}
+	return o.deleteUnaudited(m, q)
+}
+
+func (o *Orm) deleteUnaudited(m *model, q query.Q) (Result, error) {
+	if o.emulatesOnDelete(m) {
+		return o.deleteEmulatingOnDelete(m, q)
+	}
+	conn, cancel := o.timeoutConn(0)
+	defer cancel()
+	return conn.Delete(m, q)
 }
 
 // Begin starts a new transaction. If the driver does
 // not support transactions, Begin will return a fake
 // transaction.
 func (o *Orm) Begin() (*Tx, error) {
+	if o.crossTx != "" {
+		return nil, &CrossDatabaseError{Transaction: o.crossTx, Database: o.database}
+	}
 	caps := o.driver.Capabilities()
 	if caps&driver.CAP_BEGIN == 0 {
 		if caps&driver.CAP_TRANSACTION == 0 {
@@ -405,6 +490,7 @@ func (o *Orm) Begin() (*Tx, error) {
 	}
 	cpy := *o
 	cpy.conn = tx
+	cpy.signals = &txSignals{}
 	return &Tx{
 		Orm: cpy,
 		o:   o,
@@ -430,44 +516,102 @@ func (o *Orm) MustBegin() *Tx {
 // error will be returned from Transaction. If no errors are returned
 // from f, the transaction is commited and the only error that might be
 // returned from Transaction will be one produced while committing.
+//
+// When called from a transaction, Transaction uses a savepoint if the
+// driver supports them, so only the changes made by f are rolled back
+// in case of error, while the enclosing transaction continues.
+// Otherwise, the whole transaction might be retried if it fails
+// with a transient error. See SetRetryPolicy.
 func (o *Orm) Transaction(f func(o *Orm) error) error {
+	if o.crossTx != "" {
+		return &CrossDatabaseError{Transaction: o.crossTx, Database: o.database}
+	}
 	caps := o.driver.Capabilities()
 	if caps&driver.CAP_TRANSACTION == 0 {
 		return fmt.Errorf("ORM driver %T does not support transactions", o.driver)
 	}
-	if caps&driver.CAP_BEGIN != 0 {
-		tx, err := o.Begin()
-		if err != nil {
-			return err
+	if o.inTransaction() {
+		if sp, ok := o.conn.(driver.Savepointer); ok {
+			return o.savepointTransaction(sp, f)
 		}
-		defer tx.Close()
-		if err := f(&tx.Orm); err != nil {
-			if err == Rollback {
-				err = tx.Rollback()
+	}
+	if caps&driver.CAP_BEGIN != 0 {
+		return o.retryTransaction(func() (bool, error) {
+			tx, err := o.Begin()
+			if err != nil {
+				return false, err
 			}
-			return err
-		}
-		return tx.Commit()
+			defer tx.Close()
+			if err := f(&tx.Orm); err != nil {
+				if err == Rollback {
+					err = tx.Rollback()
+				}
+				return false, err
+			}
+			return true, tx.Commit()
+		})
 	}
+	signals := &txSignals{}
 	err := o.driver.Transaction(func(d driver.Driver) error {
+		signals.discard()
 		oc := *o
 		oc.conn = d
+		oc.signals = signals
 		return f(&oc)
 	})
+	if err != nil {
+		signals.discard()
+	} else {
+		signals.emit()
+	}
 	if err == Rollback {
 		err = nil
 	}
 	return err
 }
 
+// savepointTransaction runs f inside a savepoint, for
+// transactions started from another transaction.
+func (o *Orm) savepointTransaction(sp driver.Savepointer, f func(o *Orm) error) error {
+	name := fmt.Sprintf("gondola_%d", atomic.AddUint64(&savepointCount, 1))
+	if err := sp.Savepoint(name); err != nil {
+		return err
+	}
+	pending := o.signals.len()
+	if err := f(o); err != nil {
+		if rerr := sp.RollbackTo(name); rerr != nil {
+			return rerr
+		}
+		// Discard the signals emitted after the savepoint
+		o.signals.truncate(pending)
+		if err == Rollback {
+			err = nil
+		}
+		return err
+	}
+	return sp.Release(name)
+}
+
+// inTransaction returns true iff the Orm is running
+// inside a transaction.
+func (o *Orm) inTransaction() bool {
+	return o.conn != driver.Conn(o.driver)
+}
+
 // Close closes the database connection. Since the ORM
 // is thread safe and does its own connection pooling
 // you should tipycally never call this function. Instead,
 // create a ORM instance when starting up your application
-// and always use it.
+// and always use it. Closing the ORM for DefaultDatabase also
+// closes the ones opened for the databases registered with
+// RegisterDatabase.
 func (o *Orm) Close() error {
 	if o.driver != nil {
+		dbErr := o.closeDatabases()
 		err := o.driver.Close()
+		if err == nil {
+			err = dbErr
+		}
 		o.driver = nil
 		return err
 	}
@@ -529,6 +673,12 @@ func (o *Orm) models(objs []interface{}, q query.Q, sort []driver.Sort, jt JoinT
 	if jm.model == nil {
 		return nil, nil, errNoModel
 	}
+	db := jm.model.database
+	for k := range models {
+		if k.database != db {
+			return nil, nil, fmt.Errorf("can't join %s from database %q with %s from database %q", k.name, k.database, jm.model.name, db)
+		}
+	}
 	if q != nil {
 		if err := jm.joinWithQuery(q, jt, models, &methods); err != nil {
 			return nil, nil, err
@@ -596,8 +746,19 @@ func (o *Orm) compileTimeInterfaceTest() Interface {
 }
 
 // Open creates a new ORM using the specified
-// configuration URL.
+// configuration URL. The returned ORM uses DefaultDatabase,
+// see RegisterDatabase for using several databases.
 func New(url *config.URL) (*Orm, error) {
+	dbs := &databases{orms: make(map[string]*Orm)}
+	o, err := open(url, DefaultDatabase, dbs)
+	if err != nil {
+		return nil, err
+	}
+	dbs.orms[DefaultDatabase] = o
+	return o, nil
+}
+
+func open(url *config.URL, database string, dbs *databases) (*Orm, error) {
 	name := url.Scheme
 	opener := driver.Get(name)
 	if opener == nil {
@@ -614,6 +775,10 @@ func New(url *config.URL) (*Orm, error) {
 		return nil, err
 	}
 	tags := strings.Join(drv.Tags(), "-")
+	if database != DefaultDatabase {
+		// Keep the models of each database apart
+		tags += "@" + database
+	}
 	globalRegistry.RLock()
 	typeRegistry := globalRegistry.types[tags].clone()
 	globalRegistry.RUnlock()
@@ -622,6 +787,8 @@ func New(url *config.URL) (*Orm, error) {
 		driver:       drv,
 		tags:         tags,
 		typeRegistry: typeRegistry,
+		database:     database,
+		databases:    dbs,
 	}
 	if db, ok := drv.Connection().(*sql.DB); ok {
 		o.db = db
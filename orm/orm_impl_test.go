@@ -2,13 +2,18 @@ package orm
 
 import (
 	"bytes"
+	"context"
 	"flag"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
 	"gnd.la/config"
 	"gnd.la/log"
 	"gnd.la/orm/driver"
+	"gnd.la/orm/operation"
+	"gnd.la/signal"
 )
 
 // Interface for testing.B and testing.T
@@ -55,6 +60,11 @@ func runBenchmark(b *testing.B, f func(*testing.B, *Orm)) {
 	f(b, orm)
 }
 
+type Sharded struct {
+	Id    int64 `orm:",primary_key,auto_increment"`
+	Value string
+}
+
 type AutoIncrement struct {
 	Id int64 `orm:",primary_key,auto_increment"`
 	// Must have another field, otherwise there are
@@ -66,11 +76,32 @@ type BadAutoIncrement struct {
 	Id string `orm:",primary_key,auto_increment"`
 }
 
+type UUIDID struct {
+	Id    string `orm:",primary_key,id=uuid7"`
+	Value string
+}
+
+type SnowflakeID struct {
+	Id    int64 `orm:",primary_key,id=snowflake"`
+	Value string
+}
+
+type BadIDGenerator struct {
+	Id int64 `orm:",primary_key,id=ulid"`
+}
+
 type Timestamp struct {
 	Id        int64 `orm:",primary_key,auto_increment"`
 	Timestamp time.Time
 }
 
+type PreciseTimestamp struct {
+	Id          int64 `orm:",primary_key,auto_increment"`
+	Default     time.Time
+	Millisecond time.Time `orm:",time_precision=millisecond"`
+	Zoned       time.Time `orm:",time_zone"`
+}
+
 type Object struct {
 	Id     int64 `orm:",primary_key,auto_increment"`
 	Value  string
@@ -118,6 +149,14 @@ type PartialDefaulter struct {
 	Val2 string `orm:",default=Gondola"`
 }
 
+type Audited struct {
+	Id    int64 `orm:",primary_key,auto_increment"`
+	Name  string
+	Value int
+	// Not recorded in the audit trail
+	Secret string `json:"-"`
+}
+
 type Defaulter struct {
 	Id   int64     `orm:",primary_key,auto_increment"`
 	Val1 string    `orm:",default=Gondola"` // When using MySQL, this default is set by the ORM.
@@ -134,6 +173,7 @@ func clearRegistry(o *Orm) {
 	// Clear registry
 	globalRegistry.names = make(map[string]nameRegistry)
 	globalRegistry.types = make(map[string]typeRegistry)
+	globalRegistry.polymorphic = make(map[string]map[string]*Polymorphic)
 	if o != nil {
 		o.typeRegistry = globalRegistry.types[o.tags]
 	}
@@ -204,6 +244,55 @@ func testBadAutoincrement(t *testing.T, o *Orm) {
 	}
 }
 
+func testIDGenerators(t *testing.T, o *Orm) {
+	o.mustRegister((*UUIDID)(nil), &Options{
+		Table: "test_uuid_id",
+	})
+	o.mustRegister((*SnowflakeID)(nil), &Options{
+		Table: "test_snowflake_id",
+	})
+	o.mustInitialize()
+	u1 := &UUIDID{Value: "first"}
+	u2 := &UUIDID{Value: "second"}
+	o.MustSave(u1)
+	o.MustSave(u2)
+	if len(u1.Id) != 36 || u1.Id[14] != '7' {
+		t.Errorf("invalid UUIDv7 %q", u1.Id)
+	}
+	if u1.Id == u2.Id {
+		t.Errorf("duplicate generated id %q", u1.Id)
+	}
+	var u UUIDID
+	if _, err := o.One(Eq("Id", u2.Id), &u); err != nil {
+		t.Error(err)
+	} else if u.Value != "second" {
+		t.Errorf("expecting value second, got %q", u.Value)
+	}
+	s1 := &SnowflakeID{Value: "first"}
+	s2 := &SnowflakeID{Value: "second"}
+	o.MustSave(s1)
+	o.MustSave(s2)
+	if s1.Id <= 0 || s2.Id <= s1.Id {
+		t.Errorf("expecting increasing snowflake ids, got %d and %d", s1.Id, s2.Id)
+	}
+	var s SnowflakeID
+	if _, err := o.One(Eq("Id", s1.Id), &s); err != nil {
+		t.Error(err)
+	} else if s.Value != "first" {
+		t.Errorf("expecting value first, got %q", s.Value)
+	}
+}
+
+func testBadIDGenerator(t *testing.T, o *Orm) {
+	_, err := o.Register((*BadIDGenerator)(nil), nil)
+	if err == nil {
+		err = o.Initialize()
+	}
+	if err == nil {
+		t.Error("expecting an error when using BadIDGenerator")
+	}
+}
+
 func testTime(t *testing.T, o *Orm) {
 	o.mustRegister((*Timestamp)(nil), nil)
 	o.mustInitialize()
@@ -233,6 +322,53 @@ func testTime(t *testing.T, o *Orm) {
 	}
 }
 
+func testTimeOptions(t *testing.T, o *Orm) {
+	opts := o.TimeOptions()
+	if opts == nil {
+		t.Log("skipping time options test")
+		return
+	}
+	defer o.SetTimeOptions(opts)
+	loc := time.FixedZone("Test", 3600)
+	if err := o.SetTimeOptions(&driver.TimeOptions{Location: loc}); err != nil {
+		t.Fatal(err)
+	}
+	table := o.mustRegister((*PreciseTimestamp)(nil), &Options{
+		Table: "test_time_options",
+	})
+	o.mustInitialize()
+	// time.Now() includes a monotonic clock reading and nanoseconds
+	now := time.Now()
+	ts := &PreciseTimestamp{Default: now, Millisecond: now, Zoned: now}
+	o.MustSave(ts)
+	if ts.Millisecond.Nanosecond()%int(time.Millisecond) != 0 {
+		t.Errorf("expecting time truncated to milliseconds, got %v", ts.Millisecond)
+	}
+	var loaded *PreciseTimestamp
+	if !o.Table(table).Filter(Eq("Id", ts.Id)).MustOne(&loaded) {
+		t.Fatal("saved timestamp not found")
+	}
+	for _, v := range []struct {
+		name   string
+		saved  time.Time
+		loaded time.Time
+	}{
+		{"Default", ts.Default, loaded.Default},
+		{"Millisecond", ts.Millisecond, loaded.Millisecond},
+		{"Zoned", ts.Zoned, loaded.Zoned},
+	} {
+		if !v.saved.Equal(v.loaded) {
+			t.Errorf("%s: saved %v, loaded %v", v.name, v.saved, v.loaded)
+		}
+		if v.loaded.Location() != loc {
+			t.Errorf("%s: expecting location %v, got %v", v.name, loc, v.loaded.Location())
+		}
+		if v.saved != v.loaded.UTC() {
+			t.Errorf("%s: saved %#v != loaded %#v", v.name, v.saved, v.loaded.UTC())
+		}
+	}
+}
+
 func testSaveDelete(t *testing.T, o *Orm) {
 	SaveTable := o.mustRegister((*Object)(nil), &Options{
 		Table: "test_save",
@@ -428,6 +564,79 @@ func testFuncTransactions(t *testing.T, o *Orm) {
 	}
 }
 
+func testRetryTransactions(t *testing.T, o *Orm) {
+	if o.Driver().Capabilities()&driver.CAP_BEGIN == 0 || o.SetRetryPolicy(&driver.RetryPolicy{MaxAttempts: 3}) != nil {
+		t.Log("skipping retry transactions test")
+		return
+	}
+	defer o.SetRetryPolicy(nil)
+	table := o.mustRegister((*AutoIncrement)(nil), &Options{
+		Table: "test_transactions_retry",
+	})
+	o.mustInitialize()
+	attempts := 0
+	if err := o.Transaction(func(o *Orm) error {
+		attempts++
+		o.MustSave(&AutoIncrement{})
+		if attempts == 1 {
+			// Transient error, the transaction is retried
+			return io.ErrUnexpectedEOF
+		}
+		return nil
+	}); err != nil {
+		t.Error(err)
+	}
+	if attempts != 2 {
+		t.Errorf("expecting 2 attempts, got %d", attempts)
+	}
+	if c := o.Table(table).MustCount(); c != 1 {
+		t.Errorf("expecting 1 object after retrying, got %d", c)
+	}
+	attempts = 0
+	if err := o.Transaction(func(o *Orm) error {
+		attempts++
+		return io.ErrUnexpectedEOF
+	}); err != io.ErrUnexpectedEOF {
+		t.Errorf("expecting io.ErrUnexpectedEOF, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expecting 3 attempts, got %d", attempts)
+	}
+}
+
+func testQueryTimeout(t *testing.T, o *Orm) {
+	if o.SetQueryTimeout(0) != nil {
+		t.Log("skipping query timeout test")
+		return
+	}
+	table := o.mustRegister((*AutoIncrement)(nil), &Options{
+		Table: "test_query_timeout",
+	})
+	o.mustInitialize()
+	o.MustSave(&AutoIncrement{})
+	if _, err := o.Table(table).Timeout(time.Nanosecond).Count(); err != context.DeadlineExceeded {
+		t.Errorf("expecting context.DeadlineExceeded from Count, got %v", err)
+	}
+	var objs []*AutoIncrement
+	if err := o.Table(table).Timeout(time.Nanosecond).All(&objs); err != context.DeadlineExceeded {
+		t.Errorf("expecting context.DeadlineExceeded from All, got %v", err)
+	}
+	if err := o.Table(table).Timeout(time.Minute).All(&objs); err != nil || len(objs) != 1 {
+		t.Errorf("expecting 1 object with a long timeout, got %d (error %v)", len(objs), err)
+	}
+	if err := o.SetQueryTimeout(time.Nanosecond); err != nil {
+		t.Fatal(err)
+	}
+	defer o.SetQueryTimeout(0)
+	if _, err := o.Save(&AutoIncrement{}); err != context.DeadlineExceeded {
+		t.Errorf("expecting context.DeadlineExceeded from Save, got %v", err)
+	}
+	// Per query timeouts override the default one
+	if c, err := o.Table(table).Timeout(time.Minute).Count(); err != nil || c != 1 {
+		t.Errorf("expecting 1 object with a long timeout, got %d (error %v)", c, err)
+	}
+}
+
 func testCompositePrimaryKey(t *testing.T, o *Orm) {
 	if o.Driver().Capabilities()&driver.CAP_COMPOSITE_PK == 0 {
 		t.Log("skipping composite pk test")
@@ -653,6 +862,294 @@ func testSaveUnchanged(t *testing.T, o *Orm) {
 	}
 }
 
+func testAudit(t *testing.T, o *Orm) {
+	o.mustRegister((*Audited)(nil), &Options{
+		Table: "test_audited",
+		Audit: true,
+	})
+	o.mustInitialize()
+	ao := o.WithActor("tester")
+	obj := &Audited{Name: "gondola", Value: 1, Secret: "foo"}
+	ao.MustSave(obj)
+	obj.Value = 2
+	obj.Secret = "bar"
+	ao.MustSave(obj)
+	entries, err := o.History(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expecting 2 audit entries, got %d", len(entries))
+	}
+	if entries[0].Action != AuditInsert || entries[1].Action != AuditUpdate {
+		t.Errorf("expecting insert and update, got %s and %s", entries[0].Action, entries[1].Action)
+	}
+	if entries[1].Actor != "tester" {
+		t.Errorf("expecting actor tester, got %q", entries[1].Actor)
+	}
+	inserted, err := entries[0].Diff()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := inserted["Secret"]; ok {
+		t.Errorf("field with json:\"-\" recorded in the audit trail: %s", entries[0].Changes)
+	}
+	diff, err := entries[1].Diff()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := diff["Value"]; len(diff) != 1 || c == nil || string(c.Old) != "1" || string(c.New) != "2" {
+		t.Errorf("invalid update diff %s", entries[1].Changes)
+	}
+	past := &Audited{Id: obj.Id}
+	if found, err := o.AsOf(past, entries[0].Timestamp.Add(-time.Hour)); err != nil || found {
+		t.Errorf("expecting object not found before its insertion, got %v, %v", found, err)
+	}
+	if entries[1].Timestamp.After(entries[0].Timestamp) {
+		past = &Audited{Id: obj.Id}
+		if found, err := o.AsOf(past, entries[0].Timestamp); err != nil || !found || past.Value != 1 {
+			t.Errorf("expecting Value = 1 after insertion, got %+v, %v, %v", past, found, err)
+		}
+	}
+	o.MustDelete(obj)
+	now := time.Now()
+	entries, err = o.History(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 || entries[2].Action != AuditDelete {
+		t.Errorf("expecting a delete as the last of 3 audit entries, got %d entries", len(entries))
+	}
+	if len(entries) == 3 && entries[2].Timestamp.After(entries[1].Timestamp) {
+		past = &Audited{Id: obj.Id}
+		if found, err := o.AsOf(past, entries[1].Timestamp); err != nil || !found || past.Name != "gondola" || past.Value != 2 {
+			t.Errorf("expecting %+v after update, got %+v, %v, %v", obj, past, found, err)
+		}
+	}
+	if found, err := o.AsOf(&Audited{Id: obj.Id}, now.Add(time.Hour)); err != nil || found {
+		t.Errorf("expecting object not found after deleting it, got %v, %v", found, err)
+	}
+}
+
+func testDatabases(t *testing.T, o *Orm) {
+	if o.Database() != DefaultDatabase {
+		t.Fatalf("expecting database %q, got %q", DefaultDatabase, o.Database())
+	}
+	table := o.mustRegister((*AutoIncrement)(nil), &Options{
+		Table: "test_databases",
+	})
+	o.mustInitialize()
+	// Use a second database backed by the same connection
+	other := *o
+	other.database = "other"
+	other.tags = o.tags + "@other"
+	other.typeRegistry = nil
+	o.databases.set(other.database, &other)
+	defer func() {
+		o.databases.Lock()
+		delete(o.databases.orms, other.database)
+		o.databases.Unlock()
+	}()
+	otherTable := other.mustRegister((*AutoIncrement)(nil), &Options{
+		Table:    "test_databases_other",
+		Database: other.database,
+	})
+	other.mustRegister((*Sharded)(nil), &Options{
+		Table:    "test_databases_sharded_other",
+		Database: other.database,
+	})
+	other.mustInitialize()
+	third := *o
+	third.database = "third"
+	third.tags = o.tags + "@third"
+	third.typeRegistry = nil
+	o.databases.set(third.database, &third)
+	defer func() {
+		o.databases.Lock()
+		delete(o.databases.orms, third.database)
+		o.databases.Unlock()
+	}()
+	third.mustRegister((*Sharded)(nil), &Options{
+		Table:    "test_databases_sharded_third",
+		Database: third.database,
+	})
+	third.mustInitialize()
+	// Sharded is only registered in other and third
+	if _, err := o.Save(&Sharded{Value: "ambiguous"}); err == nil || !strings.Contains(err.Error(), "Using") {
+		t.Errorf("expecting an error pointing to Using() with an ambiguous database, got %v", err)
+	}
+	if _, err := o.Using(third.database).Save(&Sharded{Value: "third"}); err != nil {
+		t.Error(err)
+	}
+	obj := &AutoIncrement{Value: "other"}
+	o.Using(other.database).MustSave(obj)
+	if c := o.Table(table).MustCount(); c != 0 {
+		t.Errorf("expecting 0 objects in the default database, got %d", c)
+	}
+	// Routed to other by the model in otherTable
+	if c := o.Table(otherTable).MustCount(); c != 1 {
+		t.Errorf("expecting 1 object in the other database, got %d", c)
+	}
+	if _, err := o.Using(DefaultDatabase).Count(otherTable, nil); err == nil {
+		t.Error("expecting an error when using a table from another database")
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expecting a panic when using a non-existent database")
+			}
+		}()
+		o.Using("non-existent")
+	}()
+	if o.Driver().Capabilities()&driver.CAP_TRANSACTION == 0 {
+		t.Log("skipping cross database transactions test")
+		return
+	}
+	if err := o.Transaction(func(o *Orm) error {
+		if _, err := o.Count(otherTable, nil); err == nil {
+			t.Error("expecting an error when routing to another database from a transaction")
+		}
+		_, err := o.Using(other.database).Save(&AutoIncrement{})
+		return err
+	}); err == nil {
+		t.Error("expecting an error when using another database from a transaction")
+	} else if _, ok := err.(*CrossDatabaseError); !ok {
+		t.Errorf("expecting a *CrossDatabaseError, got %T: %s", err, err)
+	}
+}
+
+func testIdentityMap(t *testing.T, o *Orm) {
+	o.mustRegister((*AutoIncrement)(nil), &Options{
+		Table: "test_identity_map",
+	})
+	o.mustInitialize()
+	obj := &AutoIncrement{Value: "gondola"}
+	o.MustSave(obj)
+	im := NewIdentityMap()
+	io := o.WithIdentityMap(im)
+	var first, second *AutoIncrement
+	if !io.MustGet(obj.Id, &first) || !io.MustGet(obj.Id, &second) {
+		t.Fatalf("object with id %d not found", obj.Id)
+	}
+	if first != second {
+		t.Error("expecting the same instance from the identity map")
+	}
+	if im.Len() != 1 {
+		t.Errorf("expecting 1 object in the identity map, got %d", im.Len())
+	}
+	var cpy AutoIncrement
+	if !io.MustGet(obj.Id, &cpy) || cpy.Value != "gondola" {
+		t.Errorf("invalid object copied from the identity map %+v", cpy)
+	}
+	// Updating through io must evict the object
+	first.Value = "updated"
+	io.MustSave(first)
+	if im.Len() != 0 {
+		t.Errorf("expecting an empty identity map after saving, got %d objects", im.Len())
+	}
+	var third *AutoIncrement
+	if !io.MustGet(obj.Id, &third) || third == first || third.Value != "updated" {
+		t.Errorf("expecting a new instance with the updated value, got %+v", third)
+	}
+	io.MustDelete(third)
+	if io.MustGet(obj.Id, &cpy) {
+		t.Error("expecting deleted object not to be found")
+	}
+	if o.MustGet(-1, &cpy) {
+		t.Error("expecting non-existent object not to be found")
+	}
+}
+
+func testEmitOnCommit(t *testing.T, o *Orm) {
+	if o.Driver().Capabilities()&driver.CAP_BEGIN == 0 {
+		t.Log("skipping emit on commit test")
+		return
+	}
+	o.mustRegister((*AutoIncrement)(nil), &Options{
+		Table: "test_emit_on_commit",
+	})
+	o.mustInitialize()
+	const name = "gnd.la/orm.test-emit-on-commit"
+	var emitted []interface{}
+	tok := signal.Listen(name, func(_ string, obj interface{}) {
+		emitted = append(emitted, obj)
+	})
+	defer signal.Stop(name, tok)
+	tx := o.MustBegin()
+	tx.MustSave(&AutoIncrement{})
+	tx.EmitOnCommit(name, 1)
+	if len(emitted) != 0 {
+		t.Error("signal emitted before committing")
+	}
+	tx.MustCommit()
+	if len(emitted) != 1 || emitted[0] != 1 {
+		t.Errorf("expecting signal emitted after committing, got %v", emitted)
+	}
+	emitted = nil
+	tx = o.MustBegin()
+	tx.EmitOnCommit(name, 2)
+	tx.MustRollback()
+	if len(emitted) != 0 {
+		t.Errorf("expecting no signals after rolling back, got %v", emitted)
+	}
+	if err := o.Transaction(func(o *Orm) error {
+		o.EmitOnCommit(name, 3)
+		if _, ok := o.conn.(driver.Savepointer); ok {
+			return o.Transaction(func(o *Orm) error {
+				o.EmitOnCommit(name, 4)
+				return Rollback
+			})
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(emitted) != 1 || emitted[0] != 3 {
+		t.Errorf("expecting just the signal emitted outside of the savepoint, got %v", emitted)
+	}
+	emitted = nil
+	o.EmitOnCommit(name, 5)
+	if len(emitted) != 1 {
+		t.Error("expecting signal emitted immediately outside of a transaction")
+	}
+}
+
+type Dotted struct {
+	Id    int64  `orm:",primary_key,auto_increment"`
+	Name  string `orm:",index"`
+	Count int
+}
+
+func testDottedTable(t *testing.T, o *Orm) {
+	// Tables for models in packages like gnd.la/apps/users
+	// have dots in their names.
+	opts := &Options{Table: "gnd.la_test_dotted"}
+	o.mustRegister((*Dotted)(nil), opts)
+	o.mustInitialize()
+	// Initializing again must find the existing index
+	// rather than trying to create it again.
+	clearRegistry(o)
+	tbl := o.mustRegister((*Dotted)(nil), opts)
+	if err := o.Initialize(); err != nil {
+		t.Fatalf("error initializing table with dots again: %s", err)
+	}
+	obj := &Dotted{Name: "gondola", Count: 1}
+	o.MustInsert(obj)
+	q := Eq("Id", obj.Id)
+	o.MustOperate(tbl, q, operation.Add("Count", 2))
+	var out Dotted
+	if !o.MustOne(q, &out) || out.Count != 3 {
+		t.Errorf("expecting Count = 3 after adding, got %+v", out)
+	}
+	o.MustOperate(tbl, q, operation.Set("Count", operation.Field("Id")))
+	if !o.MustOne(q, &out) || int64(out.Count) != obj.Id {
+		t.Errorf("expecting Count = %d after setting it from Id, got %+v", obj.Id, out)
+	}
+	if !o.MustOne(Eq("Name", "gondola"), &out) {
+		t.Error("object not found by its indexed field")
+	}
+}
+
 func runAllTests(t *testing.T, o opener) {
 	orm, data := o.Open(t)
 	defer o.Close(data)
@@ -663,8 +1160,11 @@ func runAllTests(t *testing.T, o opener) {
 func testOrm(t *testing.T, o *Orm) {
 	tests := []func(*testing.T, *Orm){
 		testCodecs,
+		testBinaryCodecs,
+		testTranslatable,
 		testAutoIncrement,
 		testTime,
+		testTimeOptions,
 		testSaveDelete,
 		testLoadSaveMethods,
 		testLoadSaveMethodsErrors,
@@ -672,12 +1172,23 @@ func testOrm(t *testing.T, o *Orm) {
 		testInnerPointer,
 		testTransactions,
 		testFuncTransactions,
+		testRetryTransactions,
+		testQueryTimeout,
 		testCompositePrimaryKey,
 		testReferences,
+		testOnDelete,
+		testPolymorphic,
+		testBadPolymorphic,
+		testSearch,
 		testQueryAll,
 		testDefaults,
 		testMigrations,
 		testSaveUnchanged,
+		testAudit,
+		testDatabases,
+		testIdentityMap,
+		testEmitOnCommit,
+		testDottedTable,
 	}
 	for _, v := range tests {
 		clearRegistry(o)
@@ -12,10 +12,42 @@ func TestBadAutoincrement(t *testing.T) {
 	runTest(t, testBadAutoincrement)
 }
 
+func TestIDGenerators(t *testing.T) {
+	runTest(t, testIDGenerators)
+}
+
+func TestBadIDGenerator(t *testing.T) {
+	runTest(t, testBadIDGenerator)
+}
+
+func TestAudit(t *testing.T) {
+	runTest(t, testAudit)
+}
+
+func TestDatabases(t *testing.T) {
+	runTest(t, testDatabases)
+}
+
+func TestIdentityMap(t *testing.T) {
+	runTest(t, testIdentityMap)
+}
+
+func TestEmitOnCommit(t *testing.T) {
+	runTest(t, testEmitOnCommit)
+}
+
+func TestDottedTable(t *testing.T) {
+	runTest(t, testDottedTable)
+}
+
 func TestTime(t *testing.T) {
 	runTest(t, testTime)
 }
 
+func TestTimeOptions(t *testing.T) {
+	runTest(t, testTimeOptions)
+}
+
 func TestSaveDelete(t *testing.T) {
 	runTest(t, testSaveDelete)
 }
@@ -36,6 +68,14 @@ func TestFuncTransactions(t *testing.T) {
 	runTest(t, testFuncTransactions)
 }
 
+func TestRetryTransactions(t *testing.T) {
+	runTest(t, testRetryTransactions)
+}
+
+func TestQueryTimeout(t *testing.T) {
+	runTest(t, testQueryTimeout)
+}
+
 func TestQueryAll(t *testing.T) {
 	runTest(t, testQueryAll)
 }
@@ -48,6 +88,22 @@ func TestReferences(t *testing.T) {
 	runTest(t, testReferences)
 }
 
+func TestOnDelete(t *testing.T) {
+	runTest(t, testOnDelete)
+}
+
+func TestPolymorphic(t *testing.T) {
+	runTest(t, testPolymorphic)
+}
+
+func TestBadPolymorphic(t *testing.T) {
+	runTest(t, testBadPolymorphic)
+}
+
+func TestSearch(t *testing.T) {
+	runTest(t, testSearch)
+}
+
 func TestInvalidCodecs(t *testing.T) {
 	runTest(t, testInvalidCodecs)
 }
@@ -56,6 +112,10 @@ func TestCodecs(t *testing.T) {
 	runTest(t, testCodecs)
 }
 
+func TestBinaryCodecs(t *testing.T) {
+	runTest(t, testBinaryCodecs)
+}
+
 func TestLoadSaveMethods(t *testing.T) {
 	runTest(t, testLoadSaveMethods)
 }
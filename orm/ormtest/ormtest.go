@@ -0,0 +1,181 @@
+// Package ormtest implements a harness for tests which use the ORM.
+//
+// A Harness opens a single ORM and initializes the registered models
+// once, then runs each test inside a transaction which is rolled back
+// when the test finishes. This way, tests start from the same state
+// without recreating the schema every time. Transactions started from
+// the test (using Orm.Transaction) use savepoints, so they might still
+// be rolled back independently.
+//
+// A typical usage is:
+//
+//  var harness *ormtest.Harness
+//
+//  func TestMain(m *testing.M) {
+//	harness = ormtest.MustNew("sqlite:///tmp/test.db")
+//	code := m.Run()
+//	harness.Close()
+//	os.Exit(code)
+//  }
+//
+//  func TestSomething(t *testing.T) {
+//	harness.Run(t, func(o *orm.Orm) {
+//	    ormtest.MustLoadFile(t, o, "testdata/users.json")
+//	    ...
+//	})
+//  }
+package ormtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"testing"
+
+	"gnd.la/config"
+	"gnd.la/orm"
+)
+
+// Harness runs tests using the same *orm.Orm, rolling
+// back the changes made by each test. See Harness.Run.
+type Harness struct {
+	orm *orm.Orm
+}
+
+// New opens a new ORM with the given URL and initializes the
+// models registered so far. Register your models before
+// calling New.
+func New(url string) (*Harness, error) {
+	u, err := config.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	o, err := orm.New(u)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Initialize(); err != nil {
+		o.Close()
+		return nil, err
+	}
+	return &Harness{orm: o}, nil
+}
+
+// MustNew works like New, but panics if there's an error.
+func MustNew(url string) *Harness {
+	h, err := New(url)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// Orm returns the ORM used by the Harness. Note that changes made
+// using it directly, rather than from Run, are not rolled back.
+func (h *Harness) Orm() *orm.Orm {
+	return h.orm
+}
+
+// Close closes the ORM used by the Harness.
+func (h *Harness) Close() error {
+	return h.orm.Close()
+}
+
+// Run calls f with an *orm.Orm running inside a transaction,
+// which is rolled back after f returns (or panics). If the
+// transaction can't be started, the test fails.
+func (h *Harness) Run(t testing.TB, f func(o *orm.Orm)) {
+	tx, err := h.orm.Begin()
+	if err != nil {
+		t.Fatalf("error starting test transaction: %s", err)
+	}
+	defer tx.Close()
+	f(&tx.Orm)
+}
+
+// Load inserts the given objects. Each one might be either a
+// pointer to a model struct or a slice of them.
+func Load(o *orm.Orm, objs ...interface{}) error {
+	for _, v := range objs {
+		val := reflect.ValueOf(v)
+		if val.Kind() == reflect.Slice {
+			for ii := 0; ii < val.Len(); ii++ {
+				if err := Load(o, val.Index(ii).Interface()); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if _, err := o.Insert(v); err != nil {
+			return fmt.Errorf("error loading %T: %s", v, err)
+		}
+	}
+	return nil
+}
+
+// LoadJSON inserts the fixtures read from r, which must be a JSON
+// object mapping model names (as returned by orm.Table.Name) to
+// lists of objects. e.g.
+//
+//  {"User": [{"Id": 1, "Username": "alice"}, {"Id": 2, "Username": "bob"}]}
+//
+// Models are loaded in their order of appearance in the fixture.
+func LoadJSON(o *orm.Orm, r io.Reader) error {
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("fixtures must be a JSON object, not %v", tok)
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name := tok.(string)
+		table := o.NameTable(name)
+		if table == nil {
+			return fmt.Errorf("no model named %q", name)
+		}
+		var items []json.RawMessage
+		if err := dec.Decode(&items); err != nil {
+			return fmt.Errorf("error decoding fixtures for %s: %s", name, err)
+		}
+		for _, v := range items {
+			obj := reflect.New(table.Type())
+			if err := json.Unmarshal(v, obj.Interface()); err != nil {
+				return fmt.Errorf("error decoding fixture for %s: %s", name, err)
+			}
+			if err := Load(o, obj.Interface()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// LoadFile works like LoadJSON, but reads the fixtures
+// from the given file.
+func LoadFile(o *orm.Orm, filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := LoadJSON(o, f); err != nil {
+		return fmt.Errorf("error loading fixtures from %s: %s", filename, err)
+	}
+	return nil
+}
+
+// MustLoadFile works like LoadFile, but fails the
+// test if there's an error.
+func MustLoadFile(t testing.TB, o *orm.Orm, filename string) {
+	if err := LoadFile(o, filename); err != nil {
+		t.Fatal(err)
+	}
+}
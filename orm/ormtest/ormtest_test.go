@@ -0,0 +1,57 @@
+package ormtest
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"gnd.la/orm"
+	_ "gnd.la/orm/driver/sqlite"
+)
+
+type Fixture struct {
+	Id   int64 `orm:",primary_key,auto_increment"`
+	Name string
+}
+
+func init() {
+	orm.Register((*Fixture)(nil), &orm.Options{Name: "Fixture", Table: "ormtest_fixture"})
+}
+
+func TestHarness(t *testing.T) {
+	f, err := ioutil.TempFile("", "ormtest-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	h, err := New("sqlite://" + f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+	table := h.Orm().NameTable("Fixture")
+	h.Run(t, func(o *orm.Orm) {
+		if err := LoadJSON(o, strings.NewReader(`{"Fixture": [{"Name": "a"}, {"Name": "b"}]}`)); err != nil {
+			t.Fatal(err)
+		}
+		// Nested transactions use savepoints
+		err := o.Transaction(func(o *orm.Orm) error {
+			if err := Load(o, &Fixture{Name: "c"}); err != nil {
+				return err
+			}
+			return errors.New("rollback to savepoint")
+		})
+		if err == nil {
+			t.Error("expecting an error from the nested transaction")
+		}
+		if c := o.Table(table).MustCount(); c != 2 {
+			t.Errorf("expecting 2 fixtures inside the test, got %d", c)
+		}
+	})
+	if c := h.Orm().Table(table).MustCount(); c != 0 {
+		t.Errorf("expecting 0 fixtures after the test, got %d", c)
+	}
+}
@@ -0,0 +1,328 @@
+package orm
+
+import (
+	"fmt"
+	"reflect"
+
+	"gnd.la/orm/driver"
+)
+
+// Polymorphic represents a group of models which might be referenced
+// from a polymorphic reference, which uses two fields: one storing
+// the discriminator of the referenced model and another one storing
+// its primary key. Groups are registered with Orm.RegisterPolymorphic,
+// while the references are declared using the polymorphic option in
+// the struct tag of the discriminator field, indicating the group and
+// the field with the primary key. e.g.
+//
+//  type Comment struct {
+//	Id         int64 `orm:",primary_key,auto_increment"`
+//	TargetType string `orm:",polymorphic=Commentable(TargetId)"`
+//	TargetId   int64
+//	Text       string
+//  }
+//
+//  o.RegisterPolymorphic("Commentable", map[string]*orm.Table{
+//	"post":  postTable,
+//	"photo": photoTable,
+//  })
+//
+// Note that the database can't enforce polymorphic references, so
+// deleting a referenced object leaves the references dangling. See
+// Orm.SetPolymorphic and Orm.ResolvePolymorphic for working with them.
+type Polymorphic struct {
+	name   string
+	models map[string]*model
+	pkType reflect.Type
+}
+
+// Name returns the name of the group.
+func (p *Polymorphic) Name() string {
+	return p.name
+}
+
+// Discriminators returns the discriminators in this
+// group, mapped to their tables.
+func (p *Polymorphic) Discriminators() map[string]*Table {
+	tables := make(map[string]*Table, len(p.models))
+	for k, v := range p.models {
+		tables[k] = tableWithModel(v)
+	}
+	return tables
+}
+
+// discriminator returns the discriminator for m,
+// or an empty string if m is not in the group.
+func (p *Polymorphic) discriminator(m *model) string {
+	for k, v := range p.models {
+		if v == m {
+			return k
+		}
+	}
+	return ""
+}
+
+// polymorphicReference is a polymorphic reference declared in
+// a model, keyed by the field storing the discriminator.
+type polymorphicReference struct {
+	name  string
+	id    string
+	group *Polymorphic
+}
+
+// RegisterPolymorphic registers a group of models, which might be
+// referenced from polymorphic references declared in other models.
+// The tables map each discriminator, which is the value stored in the
+// database, to the table of the model it represents. All the models
+// must have been registered in this ORM and they must have a
+// non-composite primary key of the same type.
+//
+// Groups must be registered before calling Initialize, which checks
+// that the polymorphic references point to valid groups. See
+// Polymorphic for an example.
+func (o *Orm) RegisterPolymorphic(name string, tables map[string]*Table) error {
+	if name == "" {
+		return fmt.Errorf("polymorphic group without name")
+	}
+	if len(tables) == 0 {
+		return fmt.Errorf("polymorphic group %q without models", name)
+	}
+	globalRegistry.Lock()
+	defer globalRegistry.Unlock()
+	groups := globalRegistry.polymorphic[o.tags]
+	if groups == nil {
+		groups = make(map[string]*Polymorphic)
+		globalRegistry.polymorphic[o.tags] = groups
+	}
+	if _, ok := groups[name]; ok {
+		return fmt.Errorf("duplicate polymorphic group %q", name)
+	}
+	p := &Polymorphic{
+		name:   name,
+		models: make(map[string]*model, len(tables)),
+	}
+	for k, v := range tables {
+		if k == "" {
+			return fmt.Errorf("polymorphic group %q has an empty discriminator", name)
+		}
+		if v == nil || v.model == nil {
+			return fmt.Errorf("polymorphic group %q has a nil table for %q", name, k)
+		}
+		m := v.model.model
+		if m.tags != o.tags {
+			return fmt.Errorf("model %q in polymorphic group %q is not registered in this ORM", m.name, name)
+		}
+		if prev := p.discriminator(m); prev != "" {
+			return fmt.Errorf("model %q appears twice in polymorphic group %q (%q and %q)", m.name, name, prev, k)
+		}
+		pk := m.fields.PrimaryKey
+		if pk < 0 {
+			return fmt.Errorf("model %q in polymorphic group %q does not have a non-composite primary key", m.name, name)
+		}
+		pkType := m.fields.Types[pk]
+		if p.pkType == nil {
+			p.pkType = pkType
+		} else if p.pkType != pkType {
+			return fmt.Errorf("type mismatch in polymorphic group %q: primary key of %q is of type %s, expecting %s", name, m.name, pkType, p.pkType)
+		}
+		p.models[k] = m
+	}
+	groups[name] = p
+	return nil
+}
+
+// Polymorphic returns the polymorphic group with the given
+// name, or nil if there's no such group.
+func (o *Orm) Polymorphic(name string) *Polymorphic {
+	globalRegistry.RLock()
+	defer globalRegistry.RUnlock()
+	return globalRegistry.polymorphic[o.tags][name]
+}
+
+// polymorphicReferences returns the polymorphic references declared
+// in the given fields, checking that their id fields exist.
+func polymorphicReferences(fields *driver.Fields) (map[string]*polymorphicReference, error) {
+	var refs map[string]*polymorphicReference
+	for ii, v := range fields.Tags {
+		value := v.Value("polymorphic")
+		if value == "" {
+			continue
+		}
+		name := fields.QNames[ii]
+		m := referencesRe.FindStringSubmatch(value)
+		if len(m) != 4 || m[0] != value || m[3] == "" {
+			return nil, fmt.Errorf("field %q has invalid polymorphic %q. Must be in the form polymorphic=Group(IdField)", name, value)
+		}
+		if fields.Types[ii].Kind() != reflect.String {
+			return nil, fmt.Errorf("polymorphic field %q in struct %s must be of string type", name, fields.Type)
+		}
+		if _, ok := fields.QNameMap[m[3]]; !ok {
+			return nil, fmt.Errorf("polymorphic field %q references non-existent field %q in struct %s", name, m[3], fields.Type)
+		}
+		if refs == nil {
+			refs = make(map[string]*polymorphicReference)
+		}
+		refs[name] = &polymorphicReference{name: m[1], id: m[3]}
+	}
+	return refs, nil
+}
+
+// resolvePolymorphic resolves the polymorphic references declared
+// in m to their groups. The global registry must be locked.
+func (o *Orm) resolvePolymorphic(m *model) error {
+	for k, v := range m.polymorphic {
+		group := globalRegistry.polymorphic[o.tags][v.name]
+		if group == nil {
+			return fmt.Errorf("can't find polymorphic group %q referenced from field %q in model %q", v.name, k, m.name)
+		}
+		_, idType, err := m.fields.Map(v.id)
+		if err != nil {
+			return err
+		}
+		if idType != group.pkType {
+			return fmt.Errorf("type mismatch: primary keys in polymorphic group %q are of type %s, field %q in model %q is of type %s",
+				group.name, group.pkType, v.id, m.name, idType)
+		}
+		v.group = group
+	}
+	return nil
+}
+
+// polymorphicReference returns the reference declared
+// by field in m, which must have been resolved.
+func (m *model) polymorphicReference(field string) (*polymorphicReference, error) {
+	ref := m.polymorphic[field]
+	if ref == nil {
+		return nil, fmt.Errorf("field %q in model %q is not a polymorphic reference", field, m.name)
+	}
+	if ref.group == nil {
+		return nil, fmt.Errorf("polymorphic reference %q in model %q is not resolved, did you call Initialize?", field, m.name)
+	}
+	return ref, nil
+}
+
+// SetPolymorphic sets the polymorphic reference declared by field
+// (the one storing the discriminator) in obj to point to target.
+// If target is nil, the reference is cleared. Note that obj is
+// not saved.
+func (o *Orm) SetPolymorphic(obj interface{}, field string, target interface{}) error {
+	m, err := o.model(obj)
+	if err != nil {
+		return err
+	}
+	ref, err := m.polymorphicReference(field)
+	if err != nil {
+		return err
+	}
+	val := driver.Direct(reflect.ValueOf(obj))
+	typeVal := o.fieldByIndexCreating(val, m.fields.Indexes[m.fields.QNameMap[field]])
+	idVal := o.fieldByIndexCreating(val, m.fields.Indexes[m.fields.QNameMap[ref.id]])
+	if target == nil {
+		typeVal.SetString("")
+		idVal.Set(reflect.Zero(idVal.Type()))
+		return nil
+	}
+	tm, err := o.model(target)
+	if err != nil {
+		return err
+	}
+	disc := ref.group.discriminator(tm)
+	if disc == "" {
+		return fmt.Errorf("model %q is not in polymorphic group %q", tm.name, ref.group.name)
+	}
+	_, pk := o.primaryKey(tm.fields, target)
+	typeVal.SetString(disc)
+	idVal.Set(pk)
+	return nil
+}
+
+// LoadPolymorphic returns the object referenced by the polymorphic
+// reference declared by field in obj, or nil if the reference is
+// empty or the referenced object doesn't exist. To load the references
+// from multiple objects, use ResolvePolymorphic.
+func (o *Orm) LoadPolymorphic(obj interface{}, field string) (interface{}, error) {
+	objs := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(obj)), 1, 1)
+	objs.Index(0).Set(reflect.ValueOf(obj))
+	res, err := o.ResolvePolymorphic(objs.Interface(), field)
+	if err != nil {
+		return nil, err
+	}
+	return res[0], nil
+}
+
+// ResolvePolymorphic loads the objects referenced by the polymorphic
+// reference declared by field (the one storing the discriminator) in
+// each element of objs, which must be a slice of models. Objects are
+// loaded in batches, using a query for each referenced model. The
+// returned slice contains the referenced object (as a pointer to the
+// model type) for each element in objs, or nil for the elements with
+// an empty reference or whose referenced object doesn't exist.
+func (o *Orm) ResolvePolymorphic(objs interface{}, field string) ([]interface{}, error) {
+	val := reflect.ValueOf(objs)
+	if val.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("objs must be a slice, not %T", objs)
+	}
+	m, err := o.model(reflect.Zero(val.Type().Elem()).Interface())
+	if err != nil {
+		return nil, err
+	}
+	ref, err := m.polymorphicReference(field)
+	if err != nil {
+		return nil, err
+	}
+	typeIndexes := m.fields.Indexes[m.fields.QNameMap[field]]
+	idIndexes := m.fields.Indexes[m.fields.QNameMap[ref.id]]
+	count := val.Len()
+	discs := make([]string, count)
+	ids := make([]interface{}, count)
+	pending := make(map[string][]interface{})
+	for ii := 0; ii < count; ii++ {
+		elem := driver.Direct(val.Index(ii))
+		if !elem.IsValid() {
+			continue
+		}
+		typeVal := o.fieldByIndex(elem, typeIndexes)
+		idVal := o.fieldByIndex(elem, idIndexes)
+		if !typeVal.IsValid() || !idVal.IsValid() || typeVal.String() == "" {
+			continue
+		}
+		disc := typeVal.String()
+		if ref.group.models[disc] == nil {
+			return nil, fmt.Errorf("unknown discriminator %q in polymorphic group %q", disc, ref.group.name)
+		}
+		discs[ii] = disc
+		ids[ii] = idVal.Interface()
+		pending[disc] = append(pending[disc], ids[ii])
+	}
+	loaded := make(map[string]map[interface{}]interface{}, len(pending))
+	for disc, values := range pending {
+		target := ref.group.models[disc]
+		pk := target.fields.PrimaryKey
+		objects := make(map[interface{}]interface{}, len(values))
+		conn, cancel := o.timeoutConn(0)
+		iter := conn.Query(target, In(target.fields.QNames[pk], values), nil, -1, -1)
+		for {
+			obj := reflect.New(target.Type())
+			if !iter.Next(obj.Interface()) {
+				break
+			}
+			objects[o.fieldByIndex(obj, target.fields.Indexes[pk]).Interface()] = obj.Interface()
+		}
+		err := iter.Err()
+		if cerr := iter.Close(); err == nil {
+			err = cerr
+		}
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		loaded[disc] = objects
+	}
+	res := make([]interface{}, count)
+	for ii, disc := range discs {
+		if disc != "" {
+			res[ii] = loaded[disc][ids[ii]]
+		}
+	}
+	return res, nil
+}
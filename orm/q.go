@@ -76,6 +76,20 @@ func In(field string, value interface{}) query.Q {
 	}
 }
 
+// Match returns a condition which matches the rows containing the
+// given text in any of the fields, using the full-text search support
+// from the driver (see driver.CAP_SEARCH). If no fields are provided,
+// the fields declared as searchable in the model (using the "search"
+// option in their struct tags) are used. The interpretation of the
+// search text is backend dependent and some backends (e.g. MySQL)
+// require the fields to be the same ones declared as searchable.
+func Match(text string, fields ...string) query.Q {
+	return &query.Match{
+		Text:   text,
+		Fields: fields,
+	}
+}
+
 func And(qs ...query.Q) query.Q {
 	return &query.And{
 		Combinator: query.Combinator{
@@ -1,11 +1,13 @@
 package orm
 
 import (
+	"context"
 	"fmt"
 	"gnd.la/app/profile"
 	"gnd.la/orm/driver"
 	"gnd.la/orm/query"
 	"reflect"
+	"time"
 )
 
 type Query struct {
@@ -17,13 +19,25 @@ type Query struct {
 	sort    []driver.Sort
 	limit   int
 	offset  int
+	timeout time.Duration
 	err     error
 }
 
 func (q *Query) ensureTable(f string) error {
 	if q.model == nil {
-		fmt.Errorf("no table selected, set one with Table() before calling %s()", f)
+		return fmt.Errorf("no table selected, set one with Table() before calling %s()", f)
 	}
+	return q.route()
+}
+
+// route sets the Orm for executing the query, according
+// to the database of its model. See Orm.route.
+func (q *Query) route() error {
+	o, err := q.orm.route(q.model.model)
+	if err != nil {
+		return err
+	}
+	q.orm = o
 	return nil
 }
 
@@ -88,6 +102,26 @@ func (q *Query) Sort(field string, dir Sort) *Query {
 	return q
 }
 
+// SortByRank sorts the results of this query by their relevance
+// for the given search text, from the most relevant to the least
+// one. See Match for the meaning of text and fields. It's usually
+// used together with a Match condition with the same arguments.
+func (q *Query) SortByRank(text string, fields ...string) *Query {
+	q.sort = append(q.sort, &searchRank{
+		match: &query.Match{Text: text, Fields: fields},
+	})
+	return q
+}
+
+// Timeout sets the maximum duration for executing this query,
+// overriding the ORM default (see Orm.SetQueryTimeout). When
+// iterating over the results, it includes the time spent until
+// the Iter is closed. Zero uses the ORM default.
+func (q *Query) Timeout(timeout time.Duration) *Query {
+	q.timeout = timeout
+	return q
+}
+
 // One fetches the first result for this query. The first
 // return value indicates if a result was found.
 func (q *Query) One(out ...interface{}) (bool, error) {
@@ -122,7 +156,9 @@ func (q *Query) Exists() (bool, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("exists", q.model.String()).End()
 	}
-	return q.orm.driver.Exists(q.model, q.q)
+	conn, cancel := q.orm.timeoutConn(q.timeout)
+	defer cancel()
+	return conn.Exists(q.model, q.q)
 }
 
 // Iter returns an Iter object which lets you
@@ -193,7 +229,9 @@ func (q *Query) Count() (uint64, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("count", q.model.String()).End()
 	}
-	return q.orm.driver.Count(q.model, q.q, q.limit, q.offset)
+	conn, cancel := q.orm.timeoutConn(q.timeout)
+	defer cancel()
+	return conn.Count(q.model, q.q, q.limit, q.offset)
 }
 
 // MustCount works like Count, but panics if there's an error.
@@ -208,13 +246,14 @@ func (q *Query) MustCount() uint64 {
 // Clone returns a copy of the query.
 func (q *Query) Clone() *Query {
 	return &Query{
-		orm:    q.orm,
-		model:  q.model,
-		q:      q.q,
-		sort:   q.sort,
-		limit:  q.limit,
-		offset: q.offset,
-		err:    q.err,
+		orm:     q.orm,
+		model:   q.model,
+		q:       q.q,
+		sort:    q.sort,
+		limit:   q.limit,
+		offset:  q.offset,
+		timeout: q.timeout,
+		err:     q.err,
 	}
 }
 
@@ -226,11 +265,12 @@ func (q *Query) iter(limit int) *Iter {
 	}
 }
 
-func (q *Query) exec(limit int) driver.Iter {
+func (q *Query) exec(limit int) (driver.Iter, context.CancelFunc) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("query", q.model.String()).End()
 	}
-	return q.orm.conn.Query(q.model, q.q, q.sort, limit, q.offset)
+	conn, cancel := q.orm.timeoutConn(q.timeout)
+	return conn.Query(q.model, q.q, q.sort, limit, q.offset), cancel
 }
 
 // Field is a conveniency function which returns a reference to a field
@@ -257,3 +297,19 @@ func (s *querySort) Field() string {
 func (s *querySort) Direction() driver.SortDirection {
 	return s.dir
 }
+
+type searchRank struct {
+	match *query.Match
+}
+
+func (s *searchRank) Field() string {
+	return s.match.FieldName()
+}
+
+func (s *searchRank) Direction() driver.SortDirection {
+	return driver.DESC
+}
+
+func (s *searchRank) Match() *query.Match {
+	return s.match
+}
@@ -144,6 +144,30 @@ func (o *Operator) String() string {
 	return qDesc(&o.Field, o.Operator+" ")
 }
 
+// Match matches the rows which contain the search text in
+// any of the given fields, using the full-text search support
+// from the database. If Fields is empty, all the fields declared
+// as searchable in the model are used.
+type Match struct {
+	Text   string
+	Fields []string
+}
+
+func (m *Match) FieldName() string {
+	if len(m.Fields) > 0 {
+		return m.Fields[0]
+	}
+	return ""
+}
+
+func (m *Match) SubQ() []Q {
+	return nil
+}
+
+func (m *Match) String() string {
+	return fmt.Sprintf("MATCH(%s, %q)", strings.Join(m.Fields, ", "), m.Text)
+}
+
 func combDesc(c *Combinator, w string) string {
 	qs := make([]string, len(c.Conditions))
 	for ii, v := range c.Conditions {
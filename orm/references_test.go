@@ -26,6 +26,23 @@ type TimedEvent struct {
 	Name  string
 }
 
+type Author struct {
+	Id   int64 `orm:",primary_key,auto_increment"`
+	Name string
+}
+
+type Book struct {
+	Id     int64 `orm:",primary_key,auto_increment"`
+	Author int64 `orm:",references=Author,on_delete=cascade"`
+	Editor int64 `orm:",references=Author,on_delete=set_null"`
+	Title  string
+}
+
+type Contract struct {
+	Id     int64 `orm:",primary_key,auto_increment"`
+	Author int64 `orm:",references=Author,on_delete=restrict"`
+}
+
 var (
 	eventNames = []string{"E1", "E2", "E3"}
 	eventCount = len(eventNames)
@@ -226,3 +243,166 @@ func testReferences(t *testing.T, o *Orm) {
 		t.Error("expecting an error when violating FK")
 	}
 }
+
+func testOnDelete(t *testing.T, o *Orm) {
+	authorTable, err := o.Register((*Author)(nil), &Options{
+		Table: "test_on_delete_author",
+		Name:  "Author",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bookTable, err := o.Register((*Book)(nil), &Options{
+		Table: "test_on_delete_book",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	contractTable, err := o.Register((*Contract)(nil), &Options{
+		Table: "test_on_delete_contract",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	a1 := &Author{Name: "A1"}
+	a2 := &Author{Name: "A2"}
+	o.MustInsert(a1)
+	o.MustInsert(a2)
+	o.MustInsert(&Book{Author: a1.Id, Editor: a2.Id, Title: "B1"})
+	o.MustInsert(&Book{Author: a2.Id, Editor: a1.Id, Title: "B2"})
+	o.MustInsert(&Contract{Author: a2.Id})
+	// Deleting a1 removes B1 and sets the editor of B2 to NULL
+	o.MustDelete(a1)
+	if c := o.Table(bookTable).MustCount(); c != 1 {
+		t.Errorf("expecting 1 book after deleting, got %d", c)
+	}
+	var book *Book
+	if !o.Table(bookTable).MustOne(&book) || book.Title != "B2" || book.Editor != 0 {
+		t.Errorf("expecting B2 without an editor, got %+v", book)
+	}
+	// a2 is referenced by a contract
+	if err := o.Delete(a2); err == nil {
+		t.Error("expecting an error when deleting a referenced author")
+	}
+	if c := o.Table(authorTable).MustCount(); c != 1 {
+		t.Errorf("expecting 1 author after failed delete, got %d", c)
+	}
+	if _, err := o.DeleteFrom(contractTable, nil); err != nil {
+		t.Fatal(err)
+	}
+	o.MustDelete(a2)
+	if c := o.Table(bookTable).MustCount(); c != 0 {
+		t.Errorf("expecting 0 books after deleting all authors, got %d", c)
+	}
+}
+
+type Post struct {
+	Id    int64 `orm:",primary_key,auto_increment"`
+	Title string
+}
+
+type Photo struct {
+	Id  int64 `orm:",primary_key,auto_increment"`
+	URL string
+}
+
+type Comment struct {
+	Id         int64  `orm:",primary_key,auto_increment"`
+	TargetType string `orm:",polymorphic=Commentable(TargetId)"`
+	TargetId   int64
+	Text       string
+}
+
+type BadComment struct {
+	Id         int64  `orm:",primary_key,auto_increment"`
+	TargetType string `orm:",polymorphic=Commentable(TargetId)"`
+	TargetId   string
+}
+
+func testPolymorphic(t *testing.T, o *Orm) {
+	postTable := o.mustRegister((*Post)(nil), &Options{
+		Table: "test_polymorphic_post",
+	})
+	photoTable := o.mustRegister((*Photo)(nil), &Options{
+		Table: "test_polymorphic_photo",
+	})
+	commentTable := o.mustRegister((*Comment)(nil), &Options{
+		Table: "test_polymorphic_comment",
+	})
+	if err := o.RegisterPolymorphic("Commentable", map[string]*Table{"post": postTable, "photo": postTable}); err == nil {
+		t.Error("expecting an error when registering the same model twice in a group")
+	}
+	if err := o.RegisterPolymorphic("Commentable", map[string]*Table{"post": postTable, "photo": photoTable}); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.RegisterPolymorphic("Commentable", map[string]*Table{"post": postTable}); err == nil {
+		t.Error("expecting an error when registering a duplicate group")
+	}
+	o.mustInitialize()
+	post := &Post{Title: "Post"}
+	photo := &Photo{URL: "http://example.com/photo.jpg"}
+	o.MustInsert(post)
+	o.MustInsert(photo)
+	comments := []*Comment{{Text: "C1"}, {Text: "C2"}, {Text: "C3"}, {Text: "C4"}}
+	for ii, v := range []interface{}{post, photo, post, nil} {
+		if err := o.SetPolymorphic(comments[ii], "TargetType", v); err != nil {
+			t.Fatal(err)
+		}
+		o.MustInsert(comments[ii])
+	}
+	if comments[1].TargetType != "photo" || comments[1].TargetId != photo.Id {
+		t.Errorf("expecting reference to photo %d, got %s %d", photo.Id, comments[1].TargetType, comments[1].TargetId)
+	}
+	var loaded []*Comment
+	o.Table(commentTable).Sort("Id", ASC).MustAll(&loaded)
+	targets, err := o.ResolvePolymorphic(loaded, "TargetType")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targets) != 4 {
+		t.Fatalf("expecting 4 targets, got %d", len(targets))
+	}
+	if p, ok := targets[0].(*Post); !ok || p.Id != post.Id {
+		t.Errorf("expecting post %d, got %+v", post.Id, targets[0])
+	}
+	if p, ok := targets[1].(*Photo); !ok || p.Id != photo.Id {
+		t.Errorf("expecting photo %d, got %+v", photo.Id, targets[1])
+	}
+	if p, ok := targets[2].(*Post); !ok || p.Id != post.Id {
+		t.Errorf("expecting post %d, got %+v", post.Id, targets[2])
+	}
+	if targets[3] != nil {
+		t.Errorf("expecting nil target, got %+v", targets[3])
+	}
+	target, err := o.LoadPolymorphic(loaded[1], "TargetType")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, ok := target.(*Photo); !ok || p.URL != photo.URL {
+		t.Errorf("expecting photo %+v, got %+v", photo, target)
+	}
+	if _, err := o.ResolvePolymorphic(loaded, "Text"); err == nil {
+		t.Error("expecting an error when resolving a non-polymorphic field")
+	}
+}
+
+func testBadPolymorphic(t *testing.T, o *Orm) {
+	postTable := o.mustRegister((*Post)(nil), &Options{
+		Table: "test_bad_polymorphic_post",
+	})
+	o.mustRegister((*BadComment)(nil), &Options{
+		Table: "test_bad_polymorphic_comment",
+	})
+	if err := o.Initialize(); err == nil {
+		t.Error("expecting an error when initializing a reference to a missing group")
+	}
+	if err := o.RegisterPolymorphic("Commentable", map[string]*Table{"post": postTable}); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Initialize(); err == nil {
+		t.Error("expecting an error when initializing a reference with a mismatched id type")
+	}
+}
@@ -40,6 +40,44 @@ type pending struct {
 var (
 	timeType     = reflect.TypeOf(time.Time{})
 	referencesRe = regexp.MustCompile("([\\w\\.]+)(\\((\\w+)\\))?")
+	// tagSchema contains the options accepted in
+	// the struct tags of the models.
+	tagSchema = &structs.TagSchema{
+		Options: []*structs.TagOption{
+			{Name: "inline"},
+			{Name: "primary_key"},
+			{Name: "auto_increment"},
+			{Name: "id", Type: structs.StringOption, Values: driver.IDGeneratorNames},
+			{Name: "unique"},
+			{Name: "index", Type: structs.AnyOption},
+			{Name: "search"},
+			{Name: "time_precision", Type: structs.StringOption, Values: driver.TimePrecisionNames},
+			{Name: "time_zone"},
+			{Name: "notnull"},
+			{Name: "omitempty"},
+			{Name: "notomitempty"},
+			{Name: "nullempty"},
+			{Name: "notnullempty"},
+			{Name: "raw"},
+			{Name: "inet"},
+			{Name: "macaddr"},
+			{Name: "default", Type: structs.StringOption},
+			{Name: "references", Type: structs.StringOption},
+			{Name: "on_delete", Type: structs.StringOption, Values: driver.ReferentialActionNames},
+			{Name: "polymorphic", Type: structs.StringOption},
+			{Name: "length", Type: structs.IntOption},
+			{Name: "max_length", Type: structs.IntOption},
+			{Name: "codec", Type: structs.StringOption, Values: codec.Names},
+			{Name: "pipe", Type: structs.StringOption, Values: pipe.Names, Separator: pipe.Separator},
+		},
+		Conflicts: [][]string{
+			{"omitempty", "notomitempty"},
+			{"nullempty", "notnullempty"},
+			{"length", "max_length"},
+			{"inet", "macaddr"},
+			{"auto_increment", "id"},
+		},
+	}
 
 	globalRegistry struct {
 		sync.RWMutex
@@ -47,6 +85,8 @@ var (
 		// using the driver tags as the key.
 		names map[string]nameRegistry
 		types map[string]typeRegistry
+		// polymorphic groups, by driver tags and name
+		polymorphic map[string]map[string]*Polymorphic
 	}
 
 	// models registered via orm.Register, need to be
@@ -93,6 +133,9 @@ func (o *Orm) Register(t interface{}, opts *Options) (*Table, error) {
 }
 
 func (o *Orm) registerLocked(t interface{}, opts *Options) (*Table, error) {
+	if err := structs.ValidateTags(t, o.dtags(), tagSchema); err != nil && err != structs.ErrNoStruct {
+		return nil, err
+	}
 	s, err := structs.NewStruct(t, o.dtags())
 	if err != nil {
 		switch err {
@@ -126,6 +169,10 @@ func (o *Orm) registerLocked(t interface{}, opts *Options) (*Table, error) {
 	if err != nil {
 		return nil, err
 	}
+	polymorphic, err := polymorphicReferences(fields)
+	if err != nil {
+		return nil, err
+	}
 	var name string
 	if opts != nil && opts.Name != "" {
 		name = opts.Name
@@ -148,14 +195,22 @@ func (o *Orm) registerLocked(t interface{}, opts *Options) (*Table, error) {
 			}
 		}
 	}
+	if opts != nil && opts.Database != "" && opts.Database != o.database {
+		return nil, fmt.Errorf("model %q uses database %q, can't register it in database %q", name, opts.Database, o.database)
+	}
+	if opts != nil && opts.Audit && fields.PrimaryKey < 0 {
+		return nil, fmt.Errorf("audited model %q must have a non-composite primary key", name)
+	}
 	model := &model{
-		fields:     fields,
-		name:       name,
-		shortName:  s.Type.Name(),
-		references: references,
-		options:    opts,
-		table:      table,
-		tags:       o.tags,
+		fields:      fields,
+		name:        name,
+		shortName:   s.Type.Name(),
+		references:  references,
+		polymorphic: polymorphic,
+		options:     opts,
+		table:       table,
+		tags:        o.tags,
+		database:    o.database,
 	}
 	names[table] = model
 	types[s.Type] = model
@@ -168,6 +223,12 @@ func (o *Orm) initializePending() error {
 	pendingRegistry.RLock()
 	defer pendingRegistry.RUnlock()
 	for _, v := range pendingRegistry.pending {
+		if db := optionsDatabase(v.opts); db != o.database {
+			if !isRegisteredDatabase(db) {
+				return fmt.Errorf("model %v uses database %q, which was not registered with RegisterDatabase()", v.typ, db)
+			}
+			continue
+		}
 		if _, err := o.registerLocked(v.typ, v.opts); err != nil {
 			return err
 		}
@@ -185,13 +246,26 @@ func (o *Orm) initializePending() error {
 // indexes required by the registered models. You MUST call it
 // AFTER all the models have been registered and BEFORE starting
 // to use the ORM for queries for each ORM type.
+//
+// When called on the ORM for DefaultDatabase, Initialize also opens
+// and initializes the databases registered with RegisterDatabase.
 func (o *Orm) Initialize() error {
+	if err := o.initialize(); err != nil {
+		return err
+	}
+	return o.openDatabases()
+}
+
+func (o *Orm) initialize() error {
 	globalRegistry.Lock()
 	defer globalRegistry.Unlock()
 	signal.Emit(WILL_INITIALIZE, o)
 	if err := o.initializePending(); err != nil {
 		return err
 	}
+	if err := o.registerAuditLocked(); err != nil {
+		return err
+	}
 	nr := globalRegistry.names[o.tags]
 	// Resolve references
 	names := make(map[string]*model)
@@ -232,8 +306,17 @@ func (o *Orm) Initialize() error {
 						r.field, referenced.name, fkt, k, v.name, ft)
 				}
 				v.fields.References[k] = &driver.Reference{
-					Model: referenced,
-					Field: r.field,
+					Model:    referenced,
+					Field:    r.field,
+					OnDelete: r.onDelete,
+				}
+				if r.onDelete != driver.NoAction {
+					referenced.addReferrer(&referrer{
+						model:    v,
+						field:    k,
+						ref:      r.field,
+						onDelete: r.onDelete,
+					})
 				}
 				if v.modelReferences == nil {
 					v.modelReferences = make(map[*model][]*join)
@@ -262,6 +345,11 @@ func (o *Orm) Initialize() error {
 			}
 		}
 	}
+	for _, v := range nr {
+		if err := o.resolvePolymorphic(v); err != nil {
+			return err
+		}
+	}
 	models := make([]driver.Model, 0, len(nr))
 	for _, v := range nr {
 		models = append(models, v)
@@ -308,6 +396,9 @@ func (o *Orm) fields(table string, s *structs.Struct) (*driver.Fields, map[strin
 				return nil, nil, fmt.Errorf("field %q has pipe %s but no codec - only encoded types can use pipes", v, pn)
 			}
 			if pipe.FromTag(ftag) == nil {
+				if imp := pipe.RequiredImport(pn); imp != "" {
+					return nil, nil, fmt.Errorf("please import %q to use the pipe %q", imp, pn)
+				}
 				return nil, nil, fmt.Errorf("can't find ORM pipe %q. Perhaps you missed an import?", pn)
 			}
 		}
@@ -328,6 +419,28 @@ func (o *Orm) fields(table string, s *structs.Struct) (*driver.Fields, map[strin
 			}
 			fields.AutoincrementPk = fields.PrimaryKey == ii
 		}
+		if ftag.Has("id") {
+			gen, err := driver.ParseIDGenerator(ftag.Value("id"))
+			if err != nil {
+				return nil, nil, fmt.Errorf("field %q in struct %s has invalid id: %s", v, s.Type, err)
+			}
+			if fields.PrimaryKey != ii {
+				return nil, nil, fmt.Errorf("field %q in struct %s has an id generator, but it's not the primary_key", v, s.Type)
+			}
+			if gen.IsString() && t.Kind() != reflect.String {
+				return nil, nil, fmt.Errorf("id=%s field %q in struct %s must be of string type", gen, v, s.Type)
+			}
+			if gen == driver.Snowflake && t.Kind() != reflect.Int64 && t.Kind() != reflect.Uint64 {
+				return nil, nil, fmt.Errorf("id=%s field %q in struct %s must be of int64 or uint64 type", gen, v, s.Type)
+			}
+			fields.IDGenerator = gen
+		}
+		if (ftag.Has("time_precision") || ftag.Has("time_zone")) && t != timeType && t != reflect.PtrTo(timeType) {
+			return nil, nil, fmt.Errorf("field %q in struct %s has time options, but it's not a time.Time", v, s.Type)
+		}
+		if ftag.Has("search") && t.Kind() != reflect.String {
+			return nil, nil, fmt.Errorf("search field %q in struct %s must be of string type", v, s.Type)
+		}
 		if ref := ftag.Value("references"); ref != "" {
 			m := referencesRe.FindStringSubmatch(ref)
 			if len(m) != 4 {
@@ -336,7 +449,16 @@ func (o *Orm) fields(table string, s *structs.Struct) (*driver.Fields, map[strin
 			if references == nil {
 				references = make(map[string]*reference)
 			}
-			references[v] = &reference{model: m[1], field: m[3]}
+			onDelete, err := driver.ParseReferentialAction(ftag.Value("on_delete"))
+			if err != nil {
+				return nil, nil, fmt.Errorf("field %q has invalid on_delete: %s", v, err)
+			}
+			if onDelete == driver.SetNull && ftag.Has("notnull") {
+				return nil, nil, fmt.Errorf("field %q can't use on_delete=set_null because it's notnull", v)
+			}
+			references[v] = &reference{model: m[1], field: m[3], onDelete: onDelete}
+		} else if ftag.Has("on_delete") {
+			return nil, nil, fmt.Errorf("field %q has on_delete but no references", v)
 		}
 	}
 	if err := o.setFieldsDefaults(fields); err != nil {
@@ -427,6 +549,13 @@ func (o *Orm) model(obj interface{}) (*model, error) {
 		t = t.Elem()
 	}
 	model := o.typeRegistry[t]
+	if model == nil && o.databases != nil {
+		// Registered in another database
+		var err error
+		if model, err = o.databases.modelType(t); err != nil {
+			return nil, err
+		}
+	}
 	if model == nil {
 		return nil, fmt.Errorf("no model registered for type %v with tags %q", t, o.tags)
 	}
@@ -454,6 +583,17 @@ func (o *Orm) NameTable(name string) *Table {
 	return nil
 }
 
+// Tables returns all the tables registered in the
+// Orm, sorted by their model name.
+func (o *Orm) Tables() []*Table {
+	tables := make([]*Table, 0, len(o.typeRegistry))
+	for _, v := range o.typeRegistry {
+		tables = append(tables, tableWithModel(v))
+	}
+	sort.Sort(tablesByName(tables))
+	return tables
+}
+
 // TypeTable returns the Table for the given type, or
 // nil if there's no such table.
 func (o *Orm) TypeTable(typ reflect.Type) *Table {
@@ -513,4 +653,5 @@ func isText(f *driver.Fields, idx int) bool {
 func init() {
 	globalRegistry.names = make(map[string]nameRegistry)
 	globalRegistry.types = make(map[string]typeRegistry)
+	globalRegistry.polymorphic = make(map[string]map[string]*Polymorphic)
 }
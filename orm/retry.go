@@ -0,0 +1,76 @@
+package orm
+
+import (
+	"fmt"
+	"time"
+
+	"gnd.la/orm/driver"
+)
+
+// retryDriver is implemented by the drivers which support
+// retrying operations after transient errors (e.g. the sql driver).
+type retryDriver interface {
+	SetRetryPolicy(p *driver.RetryPolicy)
+	RetryPolicy() *driver.RetryPolicy
+	Transience(err error) driver.Transience
+}
+
+// SetRetryPolicy sets the policy for retrying the operations which
+// fail because of transient errors, like deadlocks, serialization
+// failures or lost connections. Retries are disabled by default.
+//
+// When enabled, statements executed outside of a transaction are
+// retried when they fail with an error which guarantees that they had
+// no effect (driver.Aborted) or, for idempotent statements (like
+// queries), when the connection was lost (driver.Disconnected). Whole
+// transactions started with Transaction are retried too, by calling
+// the function again, so it must be safe to call it multiple times.
+//
+// If the driver doesn't support retries, an error is returned.
+func (o *Orm) SetRetryPolicy(p *driver.RetryPolicy) error {
+	rd, ok := o.driver.(retryDriver)
+	if !ok {
+		return fmt.Errorf("driver %T does not support retries", o.driver)
+	}
+	rd.SetRetryPolicy(p)
+	return nil
+}
+
+// RetryPolicy returns the policy for retrying the operations
+// which fail because of transient errors, or nil if retries
+// are disabled or not supported by the driver.
+func (o *Orm) RetryPolicy() *driver.RetryPolicy {
+	if rd, ok := o.driver.(retryDriver); ok {
+		return rd.RetryPolicy()
+	}
+	return nil
+}
+
+// retryTransaction calls tx, which runs a whole transaction, retrying
+// it according to the RetryPolicy. tx must return whether the
+// error happened while committing, since its outcome is unknown
+// when the connection is lost at that point.
+func (o *Orm) retryTransaction(tx func() (bool, error)) error {
+	committing, err := tx()
+	rd, ok := o.driver.(retryDriver)
+	if err == nil || !ok || rd.RetryPolicy() == nil {
+		return err
+	}
+	policy := rd.RetryPolicy()
+	for attempt := 1; attempt < policy.MaxAttempts; attempt++ {
+		switch rd.Transience(err) {
+		case driver.Aborted:
+		case driver.Disconnected:
+			if committing {
+				return err
+			}
+		default:
+			return err
+		}
+		time.Sleep(policy.Delay(attempt))
+		if committing, err = tx(); err == nil {
+			break
+		}
+	}
+	return err
+}
@@ -0,0 +1,79 @@
+package orm
+
+import (
+	"strings"
+	"testing"
+
+	"gnd.la/orm/driver"
+)
+
+type Article struct {
+	Id    int64  `orm:",primary_key,auto_increment"`
+	Title string `orm:",search"`
+	Body  string `orm:",search"`
+	Views int
+}
+
+func testSearch(t *testing.T, o *Orm) {
+	if o.Driver().Capabilities()&driver.CAP_SEARCH == 0 {
+		t.Log("skipping full-text search test")
+		return
+	}
+	table, err := o.Register((*Article)(nil), &Options{
+		Table: "test_search_article",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Initialize(); err != nil {
+		if strings.Contains(err.Error(), "no such module") {
+			t.Logf("skipping full-text search test: %s", err)
+			return
+		}
+		t.Fatal(err)
+	}
+	o.MustInsert(&Article{Title: "A gondola in Venice", Body: "A gondola is a traditional rowing boat"})
+	o.MustInsert(&Article{Title: "Trains", Body: "Trains are faster than boats, but less romantic than a gondola"})
+	o.MustInsert(&Article{Title: "Cooking", Body: "How to cook pasta"})
+	var articles []*Article
+	if err := o.Query(Match("gondola")).All(&articles); err != nil {
+		t.Fatal(err)
+	}
+	if len(articles) != 2 {
+		t.Errorf("expecting 2 articles matching gondola, got %d", len(articles))
+	}
+	articles = nil
+	if err := o.Query(Match("gondola", "Title")).All(&articles); err != nil {
+		t.Fatal(err)
+	}
+	if len(articles) != 1 || articles[0].Title != "A gondola in Venice" {
+		t.Errorf("expecting 1 article with gondola in the title, got %+v", articles)
+	}
+	if err := o.Query(Match("gondola", "Views")).All(&articles); err == nil {
+		t.Error("expecting an error when searching a non-searchable field")
+	}
+	var article *Article
+	if _, err := o.Query(Match("pasta")).SortByRank("pasta").One(&article); err != nil {
+		t.Fatal(err)
+	}
+	if article == nil || article.Title != "Cooking" {
+		t.Errorf("expecting Cooking as the most relevant article for pasta, got %+v", article)
+	}
+	// Characters with a special meaning in the query syntax
+	// of some backends must be matched literally
+	for _, v := range []string{`gondola"`, "gondola)", "Title:pasta", "NOT gondola", "gondola*"} {
+		if _, err := o.Query(Match(v)).Table(table).Count(); err != nil {
+			t.Errorf("error searching %q: %s", v, err)
+		}
+	}
+	// Updates and deletions must be reflected in the index
+	article.Body = "How to cook a gondola"
+	o.MustSave(article)
+	if c := o.Query(Match("pasta")).Table(table).MustCount(); c != 0 {
+		t.Errorf("expecting no articles matching pasta after updating, got %d", c)
+	}
+	o.MustDelete(article)
+	if c := o.Query(Match("gondola")).Table(table).MustCount(); c != 2 {
+		t.Errorf("expecting 2 articles matching gondola after deleting, got %d", c)
+	}
+}
@@ -1,6 +1,8 @@
 package orm
 
 import (
+	"reflect"
+
 	"gnd.la/orm/query"
 )
 
@@ -8,6 +10,47 @@ type Table struct {
 	model *joinModel
 }
 
+// Name returns the name of the model used to create the
+// Table. For joined tables, it returns the name of the
+// first model in the join.
+func (t *Table) Name() string {
+	return t.model.model.name
+}
+
+// Type returns the type of the model used to create the
+// Table. For joined tables, it returns the type of the
+// first model in the join.
+func (t *Table) Type() reflect.Type {
+	return t.model.model.Type()
+}
+
+// Fields returns the qualified names of the fields in the
+// model used to create the Table (e.g. Foo.Bar for embedded
+// structs), in the same order they were declared.
+func (t *Table) Fields() []string {
+	return append([]string(nil), t.model.model.fields.QNames...)
+}
+
+// FieldType returns the type of the field with the given
+// qualified name, or nil if there's no such field.
+func (t *Table) FieldType(field string) reflect.Type {
+	if idx, ok := t.model.model.fields.QNameMap[field]; ok {
+		return t.model.model.fields.Types[idx]
+	}
+	return nil
+}
+
+// PrimaryKey returns the qualified name of the field which acts as
+// the primary key for the model used to create the Table. If the
+// model has no primary key or it uses a composite one, an empty
+// string is returned.
+func (t *Table) PrimaryKey() string {
+	if f := t.model.model.fields; f.PrimaryKey >= 0 {
+		return f.QNames[f.PrimaryKey]
+	}
+	return ""
+}
+
 func (t *Table) Join(table *Table, q query.Q, jt JoinType) (*Table, error) {
 	join := t.model.clone()
 	if _, err := join.joinWith(table.model.model, q, jt); err != nil {
@@ -40,3 +83,9 @@ func (t *Table) Skip() *Table {
 func tableWithModel(m *model) *Table {
 	return &Table{model: &joinModel{model: m}}
 }
+
+type tablesByName []*Table
+
+func (t tablesByName) Len() int           { return len(t) }
+func (t tablesByName) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+func (t tablesByName) Less(i, j int) bool { return t[i].Name() < t[j].Name() }
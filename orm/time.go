@@ -0,0 +1,39 @@
+package orm
+
+import (
+	"fmt"
+
+	"gnd.la/orm/driver"
+)
+
+// timeOptionsDriver is implemented by the drivers which
+// support driver.TimeOptions (e.g. the sql driver).
+type timeOptionsDriver interface {
+	SetTimeOptions(opts *driver.TimeOptions)
+	TimeOptions() *driver.TimeOptions
+}
+
+// SetTimeOptions sets the default options used by this ORM for
+// storing and loading time.Time fields, which might be overridden
+// per field using struct tags. See driver.TimeOptions for the
+// available options. Since they might affect the column types,
+// they should be set before calling Initialize. If the driver
+// doesn't support time options, an error is returned.
+func (o *Orm) SetTimeOptions(opts *driver.TimeOptions) error {
+	drv, ok := o.driver.(timeOptionsDriver)
+	if !ok {
+		return fmt.Errorf("driver %T does not support time options", o.driver)
+	}
+	drv.SetTimeOptions(opts)
+	return nil
+}
+
+// TimeOptions returns the default options used by this ORM for
+// storing and loading time.Time fields, or nil if the driver
+// doesn't support them.
+func (o *Orm) TimeOptions() *driver.TimeOptions {
+	if drv, ok := o.driver.(timeOptionsDriver); ok {
+		return drv.TimeOptions()
+	}
+	return nil
+}
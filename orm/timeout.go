@@ -0,0 +1,87 @@
+package orm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gnd.la/orm/driver"
+)
+
+// statementTimeoutDriver is implemented by the drivers which can
+// enforce timeouts on the server side (e.g. the sql driver).
+type statementTimeoutDriver interface {
+	SetStatementTimeout(timeout time.Duration)
+}
+
+// SetQueryTimeout sets the default timeout for the operations executed
+// by this ORM. Operations taking longer are cancelled and return an error
+// (usually context.DeadlineExceeded), rather than holding a connection
+// from the pool indefinitely. Queries might override it using
+// Query.Timeout. Zero disables the timeout, which is the default.
+//
+// Timeouts are implemented using context deadlines, which the database
+// drivers usually enforce by cancelling the statement on the server.
+// Additionally, drivers which support it also set a server side timeout
+// for the statements executed in transactions started from this ORM
+// (e.g. using statement_timeout in postgres).
+//
+// If the driver doesn't support timeouts, an error is returned. Note that
+// the timeout is not propagated to transactions which have been already
+// started.
+func (o *Orm) SetQueryTimeout(timeout time.Duration) error {
+	if _, ok := o.conn.(driver.ContextConn); !ok {
+		return fmt.Errorf("driver %T does not support query timeouts", o.driver)
+	}
+	if timeout < 0 {
+		timeout = 0
+	}
+	if st, ok := o.driver.(statementTimeoutDriver); ok && !o.inTransaction() {
+		st.SetStatementTimeout(timeout)
+	}
+	o.queryTimeout = timeout
+	return nil
+}
+
+// QueryTimeout returns the default timeout for the operations
+// executed by this ORM. See SetQueryTimeout.
+func (o *Orm) QueryTimeout() time.Duration {
+	return o.queryTimeout
+}
+
+// WithContext returns a copy of the Orm which runs its operations
+// using ctx, which might carry values used by the driver (e.g. the
+// parent span for gnd.la/tracing). Timeouts set with SetQueryTimeout
+// or Query.Timeout are derived from ctx. If the driver doesn't
+// support contexts (see driver.ContextConn), ctx is ignored.
+func (o *Orm) WithContext(ctx context.Context) *Orm {
+	cpy := *o
+	cpy.ctx = ctx
+	return &cpy
+}
+
+// timeoutConn returns the Conn for running an operation with the given
+// timeout, or the default one if it's zero, and the function which must
+// be called after the operation finishes. If there's no timeout nor a
+// context set with WithContext or the driver doesn't support them, it
+// returns o.conn.
+func (o *Orm) timeoutConn(timeout time.Duration) (driver.Conn, context.CancelFunc) {
+	if timeout <= 0 {
+		timeout = o.queryTimeout
+	}
+	if cc, ok := o.conn.(driver.ContextConn); ok {
+		parent := o.ctx
+		if parent == nil {
+			if timeout <= 0 {
+				return o.conn, func() {}
+			}
+			parent = context.Background()
+		}
+		if timeout > 0 {
+			ctx, cancel := context.WithTimeout(parent, timeout)
+			return cc.WithContext(ctx), cancel
+		}
+		return cc.WithContext(parent), func() {}
+	}
+	return o.conn, func() {}
+}
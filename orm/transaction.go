@@ -1,7 +1,10 @@
 package orm
 
 import (
+	"sync"
+
 	"gnd.la/orm/driver"
+	"gnd.la/signal"
 )
 
 var (
@@ -26,6 +29,8 @@ func (t *Tx) Begin() (*Tx, error) {
 
 // Commit commits the current transaction. If the transaction
 // was already committed or rolled back, it returns ErrFinished.
+// Once committed, the signals enqueued with EmitOnCommit are
+// emitted.
 func (t *Tx) Commit() error {
 	if t.done {
 		return ErrFinished
@@ -38,6 +43,7 @@ func (t *Tx) Commit() error {
 		return err
 	}
 	t.done = true
+	t.signals.emit()
 	return nil
 }
 
@@ -48,8 +54,9 @@ func (t *Tx) MustCommit() {
 	}
 }
 
-// Rollback rolls back the current transaction. If the transaction
-// was already committed or rolled back, it returns ErrFinished.
+// Rollback rolls back the current transaction, discarding the
+// signals enqueued with EmitOnCommit. If the transaction was
+// already committed or rolled back, it returns ErrFinished.
 func (t *Tx) Rollback() error {
 	if t.done {
 		return ErrFinished
@@ -57,6 +64,7 @@ func (t *Tx) Rollback() error {
 	if t.logger != nil {
 		t.logger.Debug("Rolling back transaction")
 	}
+	t.signals.discard()
 	err := t.tx.Rollback()
 	if err != nil {
 		return err
@@ -90,6 +98,80 @@ func (t *Tx) Close() {
 	}
 }
 
+// EmitOnCommit emits the given signal (see gnd.la/signal.Emit) once
+// the current transaction is committed. If it's rolled back instead,
+// the signal is discarded, so listeners (e.g. invalidating caches or
+// sending emails) don't act on changes which were never persisted.
+// Signals emitted from a function passed to Transaction which uses
+// a savepoint are also discarded when the savepoint is rolled back.
+// When the Orm is not running a transaction, the signal is emitted
+// immediately.
+func (o *Orm) EmitOnCommit(name string, object interface{}) {
+	if o.signals == nil {
+		signal.Emit(name, object)
+		return
+	}
+	o.signals.add(name, object)
+}
+
+type pendingSignal struct {
+	name   string
+	object interface{}
+}
+
+// txSignals are the signals waiting for a
+// transaction to be committed.
+type txSignals struct {
+	mu      sync.Mutex
+	pending []*pendingSignal
+}
+
+func (s *txSignals) add(name string, object interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = append(s.pending, &pendingSignal{name, object})
+}
+
+func (s *txSignals) len() int {
+	if s == nil {
+		return 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.pending)
+}
+
+// truncate discards the signals added
+// after the first n ones.
+func (s *txSignals) truncate(n int) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n < len(s.pending) {
+		s.pending = s.pending[:n]
+	}
+}
+
+func (s *txSignals) discard() {
+	s.truncate(0)
+}
+
+// emit emits and removes the pending signals.
+func (s *txSignals) emit() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+	for _, v := range pending {
+		signal.Emit(v.name, v.object)
+	}
+}
+
 func (t *Tx) compileTimeInterfaceTest() Interface {
 	return t
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"gnd.la/app/profile"
 	"gnd.la/internal/runtimeutil"
 	"gnd.la/log"
 )
@@ -68,6 +69,9 @@ func Stop(name string, t *Token) {
 
 // Emit calls all the listeners for the given signal.
 func Emit(name string, object interface{}) {
+	if profile.On && profile.Profiling() {
+		defer profile.Start("signal").Notef("emit", "%s with %T object", name, object).End()
+	}
 	log.Debugf("Emitting signal %s with %T object", name, object)
 	if rec := signals[name]; rec != nil {
 		params := []reflect.Value{reflect.ValueOf(name), reflect.ValueOf(object)}
@@ -0,0 +1,319 @@
+package social
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"gnd.la/net/oauth2"
+)
+
+const (
+	OAuthGoogle   = "google"
+	OAuthGithub   = "github"
+	OAuthFacebook = "facebook"
+
+	githubUserURL = "https://api.github.com/user"
+)
+
+// OAuthUser contains the information about a user
+// obtained from an OAuthProvider.
+type OAuthUser struct {
+	// Id is the user id in the provider. It's always
+	// a string, even if the provider uses numeric ids.
+	Id            string
+	Username      string
+	Name          string
+	Email         string
+	EmailVerified bool
+	ImageURL      string
+	// Data contains the raw data returned by the provider.
+	Data map[string]interface{}
+}
+
+// OAuthProvider represents an oAuth 2 (or OpenID Connect) identity
+// provider which supports the authorization code flow. Presets for the
+// most common providers are available as GoogleOAuth, GithubOAuth and
+// FacebookOAuth, while others can be defined by users and made available
+// to other packages with RegisterOAuthProvider.
+type OAuthProvider struct {
+	// Name is the provider name, which must be unique. It's usually
+	// lowercase, since it might be used in URLs.
+	Name string
+	// Title is the provider name, as shown to users.
+	Title string
+	// AuthorizationURL is the URL for requesting the user authorization.
+	AuthorizationURL string
+	// TokenURL is the URL for exchanging a code or refreshing a token.
+	TokenURL string
+	// UserInfoURL is the URL for retrieving the user information, which
+	// is requested with the token in the Authorization header.
+	UserInfoURL string
+	// Scopes are the default scopes requested by this provider. They
+	// should include at least the ones required for accessing UserInfoURL.
+	Scopes []string
+	// ScopeSeparator is copied to oauth2.Client.ScopeSeparator.
+	ScopeSeparator string
+	// AuthorizationParameters is copied to oauth2.Client.AuthorizationParameters.
+	AuthorizationParameters map[string]string
+	// ExchangeParameters is copied to oauth2.Client.ExchangeParameters.
+	ExchangeParameters map[string]string
+	// PKCE indicates if the provider supports PKCE.
+	PKCE bool
+	// Map maps the data returned from UserInfoURL to an *OAuthUser. If
+	// it's nil, the data is mapped using the OpenID Connect standard
+	// claims.
+	Map func(data map[string]interface{}) *OAuthUser
+	// Fetch, if non-nil, is used to retrieve the user information
+	// rather than requesting UserInfoURL. It might call FetchData to
+	// request additional endpoints.
+	Fetch func(client *oauth2.Client, token *oauth2.Token) (*OAuthUser, error)
+}
+
+// Client returns a new *oauth2.Client for this provider, using
+// the given application id and secret.
+func (p *OAuthProvider) Client(id string, secret string) *oauth2.Client {
+	c := oauth2.New(p.AuthorizationURL, p.TokenURL)
+	c.Id = id
+	c.Secret = secret
+	c.ScopeSeparator = p.ScopeSeparator
+	c.AuthorizationParameters = p.AuthorizationParameters
+	c.ExchangeParameters = p.ExchangeParameters
+	c.PKCE = p.PKCE
+	return c
+}
+
+// User returns the information about the user which
+// authorized the given token.
+func (p *OAuthProvider) User(client *oauth2.Client, token *oauth2.Token) (*OAuthUser, error) {
+	if p.Fetch != nil {
+		return p.Fetch(client, token)
+	}
+	data, err := FetchData(client, p.UserInfoURL, token)
+	if err != nil {
+		return nil, err
+	}
+	m := p.Map
+	if m == nil {
+		m = MapOpenID
+	}
+	user := m(data)
+	if user.Id == "" {
+		return nil, fmt.Errorf("no user id returned by %s", p.Name)
+	}
+	user.Data = data
+	return user, nil
+}
+
+// FetchData requests the given URL using the token and decodes
+// the response as a JSON object.
+func FetchData(client *oauth2.Client, u string, token *oauth2.Token) (map[string]interface{}, error) {
+	resp, err := client.GetBearer(u, token.Key)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+	var data map[string]interface{}
+	dec := json.NewDecoder(resp.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil {
+		return nil, fmt.Errorf("error decoding user data: %s", err)
+	}
+	return data, nil
+}
+
+// MapOpenID maps the OpenID Connect standard claims
+// to an *OAuthUser.
+func MapOpenID(data map[string]interface{}) *OAuthUser {
+	return &OAuthUser{
+		Id:            claim(data, "sub"),
+		Username:      claim(data, "preferred_username"),
+		Name:          claim(data, "name"),
+		Email:         claim(data, "email"),
+		EmailVerified: claim(data, "email_verified") == "true",
+		ImageURL:      claim(data, "picture"),
+	}
+}
+
+// claim returns the given value from the data as a
+// string, or the empty string if it's not present.
+func claim(data map[string]interface{}, key string) string {
+	switch x := data[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return x
+	case json.Number:
+		return x.String()
+	case bool:
+		if x {
+			return "true"
+		}
+		return "false"
+	case float64, int, int64:
+		return fmt.Sprint(x)
+	}
+	return ""
+}
+
+var (
+	oauthProviders struct {
+		sync.RWMutex
+		byName map[string]*OAuthProvider
+	}
+)
+
+// RegisterOAuthProvider registers an OAuthProvider, making it available
+// via OAuthProviderNamed and OAuthProviders. The presets are registered
+// by default. Registering two providers with the same name will panic.
+func RegisterOAuthProvider(p *OAuthProvider) {
+	if p.Name == "" {
+		panic(fmt.Errorf("can't register OAuthProvider without name"))
+	}
+	oauthProviders.Lock()
+	defer oauthProviders.Unlock()
+	if oauthProviders.byName == nil {
+		oauthProviders.byName = make(map[string]*OAuthProvider)
+	}
+	if _, ok := oauthProviders.byName[p.Name]; ok {
+		panic(fmt.Errorf("duplicate OAuthProvider named %q", p.Name))
+	}
+	oauthProviders.byName[p.Name] = p
+}
+
+// OAuthProviderNamed returns the registered OAuthProvider with
+// the given name, or nil if there's no such provider.
+func OAuthProviderNamed(name string) *OAuthProvider {
+	oauthProviders.RLock()
+	defer oauthProviders.RUnlock()
+	return oauthProviders.byName[name]
+}
+
+// OAuthProviders returns all the registered OAuthProviders,
+// sorted by name.
+func OAuthProviders() []*OAuthProvider {
+	oauthProviders.RLock()
+	defer oauthProviders.RUnlock()
+	providers := make([]*OAuthProvider, 0, len(oauthProviders.byName))
+	for _, v := range oauthProviders.byName {
+		providers = append(providers, v)
+	}
+	sort.Sort(oauthProvidersByName(providers))
+	return providers
+}
+
+type oauthProvidersByName []*OAuthProvider
+
+func (o oauthProvidersByName) Len() int           { return len(o) }
+func (o oauthProvidersByName) Less(i, j int) bool { return o[i].Name < o[j].Name }
+func (o oauthProvidersByName) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
+
+var (
+	// GoogleOAuth is the OAuthProvider for Google, using OpenID Connect.
+	GoogleOAuth = &OAuthProvider{
+		Name:             OAuthGoogle,
+		Title:            "Google",
+		AuthorizationURL: "https://accounts.google.com/o/oauth2/v2/auth",
+		TokenURL:         "https://oauth2.googleapis.com/token",
+		UserInfoURL:      "https://openidconnect.googleapis.com/v1/userinfo",
+		Scopes:           []string{"openid", "email", "profile"},
+		ScopeSeparator:   " ",
+		AuthorizationParameters: map[string]string{
+			"response_type": "code",
+			"access_type":   "offline",
+		},
+		ExchangeParameters: map[string]string{
+			"grant_type": "authorization_code",
+		},
+		PKCE: true,
+	}
+	// GithubOAuth is the OAuthProvider for GitHub. If the user has no
+	// public email, its primary verified one is requested.
+	GithubOAuth = &OAuthProvider{
+		Name:             OAuthGithub,
+		Title:            "GitHub",
+		AuthorizationURL: "https://github.com/login/oauth/authorize",
+		TokenURL:         "https://github.com/login/oauth/access_token",
+		UserInfoURL:      githubUserURL,
+		Scopes:           []string{"read:user", "user:email"},
+		ScopeSeparator:   " ",
+		PKCE:             true,
+		Map:              mapGithub,
+		Fetch:            fetchGithub,
+	}
+	// FacebookOAuth is the OAuthProvider for Facebook.
+	FacebookOAuth = &OAuthProvider{
+		Name:             OAuthFacebook,
+		Title:            "Facebook",
+		AuthorizationURL: "https://www.facebook.com/dialog/oauth",
+		TokenURL:         "https://graph.facebook.com/oauth/access_token",
+		UserInfoURL:      "https://graph.facebook.com/me?fields=id,name,email,picture.type(large)",
+		Scopes:           []string{"email", "public_profile"},
+		PKCE:             true,
+		Map:              mapFacebook,
+	}
+)
+
+func mapGithub(data map[string]interface{}) *OAuthUser {
+	return &OAuthUser{
+		Id:       claim(data, "id"),
+		Username: claim(data, "login"),
+		Name:     claim(data, "name"),
+		Email:    claim(data, "email"),
+		ImageURL: claim(data, "avatar_url"),
+	}
+}
+
+func fetchGithub(client *oauth2.Client, token *oauth2.Token) (*OAuthUser, error) {
+	data, err := FetchData(client, githubUserURL, token)
+	if err != nil {
+		return nil, err
+	}
+	user := mapGithub(data)
+	user.Data = data
+	// The email in the profile is the public one, if any
+	resp, err := client.GetBearer(githubUserURL+"/emails", token.Key)
+	if err != nil {
+		return user, nil
+	}
+	defer resp.Close()
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := resp.DecodeJSON(&emails); err != nil {
+		return user, nil
+	}
+	for _, v := range emails {
+		if v.Primary && v.Verified {
+			user.Email = v.Email
+			user.EmailVerified = true
+			break
+		}
+	}
+	return user, nil
+}
+
+func mapFacebook(data map[string]interface{}) *OAuthUser {
+	user := &OAuthUser{
+		Id:    claim(data, "id"),
+		Name:  claim(data, "name"),
+		Email: claim(data, "email"),
+		// Facebook doesn't say whether the email has been
+		// verified, so EmailVerified is always false.
+	}
+	if picture, ok := data["picture"].(map[string]interface{}); ok {
+		if pd, ok := picture["data"].(map[string]interface{}); ok {
+			user.ImageURL = claim(pd, "url")
+		}
+	}
+	return user
+}
+
+func init() {
+	RegisterOAuthProvider(GoogleOAuth)
+	RegisterOAuthProvider(GithubOAuth)
+	RegisterOAuthProvider(FacebookOAuth)
+}
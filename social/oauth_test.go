@@ -0,0 +1,55 @@
+package social
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func decodeData(t *testing.T, s string) map[string]interface{} {
+	var data map[string]interface{}
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestMapOpenID(t *testing.T) {
+	data := decodeData(t, `{"sub": "1234", "name": "Alice", "email": "alice@example.com", "email_verified": true}`)
+	user := MapOpenID(data)
+	if user.Id != "1234" || user.Name != "Alice" || user.Email != "alice@example.com" || !user.EmailVerified {
+		t.Errorf("unexpected user %+v", user)
+	}
+}
+
+func TestMapGithub(t *testing.T) {
+	data := decodeData(t, `{"id": 12345678901, "login": "alice", "email": null}`)
+	user := mapGithub(data)
+	if user.Id != "12345678901" || user.Username != "alice" || user.Email != "" {
+		t.Errorf("unexpected user %+v", user)
+	}
+}
+
+func TestMapFacebook(t *testing.T) {
+	data := decodeData(t, `{"id": "42", "name": "Alice", "email": "alice@example.com", "picture": {"data": {"url": "https://example.com/a.jpg"}}}`)
+	user := mapFacebook(data)
+	if user.Id != "42" || user.Email != "alice@example.com" || user.ImageURL != "https://example.com/a.jpg" || user.EmailVerified {
+		t.Errorf("unexpected user %+v", user)
+	}
+}
+
+func TestRegisterOAuthProvider(t *testing.T) {
+	for _, v := range []string{OAuthGoogle, OAuthGithub, OAuthFacebook} {
+		if OAuthProviderNamed(v) == nil {
+			t.Errorf("provider %q is not registered", v)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("expecting a panic when registering a duplicate provider")
+		}
+	}()
+	RegisterOAuthProvider(&OAuthProvider{Name: OAuthGoogle})
+}
@@ -12,9 +12,19 @@ import (
 
 	"gnd.la/app"
 	"gnd.la/internal/runtimeutil"
+	"gnd.la/metrics"
 	"gnd.la/signal"
 )
 
+var (
+	runsTotal = metrics.NewCounter("gondola_task_runs_total",
+		"Number of task runs, by task and result (ok, panic or skipped)", "task", "result")
+	runningTasks = metrics.NewGauge("gondola_tasks_running",
+		"Number of running task instances, by task", "task")
+	taskDuration = metrics.NewHistogram("gondola_task_duration_seconds",
+		"Time spent running tasks, by task", []float64{.1, .5, 1, 5, 10, 30, 60, 300, 900, 3600}, "task")
+)
+
 var running struct {
 	sync.Mutex
 	tasks map[*Task]int
@@ -139,6 +149,12 @@ func afterTask(ctx *app.Context, task *Task, started time.Time, terr *error) {
 		*terr = errors.New(buf.String())
 	}
 	end := time.Now()
+	result := "ok"
+	if *terr != nil {
+		result = "panic"
+	}
+	runsTotal.Inc(name, result)
+	taskDuration.Observe(end.Sub(started).Seconds(), name)
 	running.Lock()
 	defer running.Unlock()
 	c := running.tasks[task] - 1
@@ -147,6 +163,7 @@ func afterTask(ctx *app.Context, task *Task, started time.Time, terr *error) {
 	} else {
 		delete(running.tasks, task)
 	}
+	runningTasks.Set(float64(c), name)
 	ctx.Logger().Infof("Finished task %s (%d instances now running) at %v (took %v)", name, c, end, end.Sub(started))
 }
 
@@ -156,6 +173,7 @@ func numberOfInstances(task *Task) (int, error) {
 	c := running.tasks[task]
 	if task.Options != nil && task.Options.MaxInstances > 0 {
 		if c >= task.Options.MaxInstances {
+			runsTotal.Inc(task.Name(), "skipped")
 			return 0, fmt.Errorf("not starting task %s because it's already running %d instances", task.Name(), c)
 		}
 	}
@@ -164,6 +182,7 @@ func numberOfInstances(task *Task) (int, error) {
 	}
 	c++
 	running.tasks[task] = c
+	runningTasks.Set(float64(c), task.Name())
 	return c, nil
 }
 
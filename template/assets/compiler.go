@@ -3,11 +3,11 @@ package assets
 import (
 	"bytes"
 	"fmt"
+	"hash/fnv"
 	"io"
 	"path"
 	"strings"
 
-	"gnd.la/crypto/hashutil"
 	"gnd.la/log"
 )
 
@@ -41,23 +41,24 @@ func Compile(m *Manager, name string, typ Type, opts Options) (string, error) {
 	if compiler == nil {
 		return name, nil
 	}
-	f, err := m.Load(name)
+	sum, err := m.sum(name, fnv.New32a())
 	if err != nil {
 		return "", err
 	}
-	defer f.Close()
-	seeker, err := Seeker(f)
-	fnv := hashutil.Fnv32a(seeker)
-	out := fmt.Sprintf("%s.gen.%s.%s", name, fnv, typ.Ext())
+	out := fmt.Sprintf("%s.gen.%s.%s", name, sum, typ.Ext())
 	if o, _ := m.Load(out); o != nil {
 		o.Close()
 		log.Debugf("%s already compiled to %s", name, out)
 		return out, nil
 	}
-	seeker.Seek(0, 0)
+	f, err := m.Load(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
 	var buf bytes.Buffer
 	log.Debugf("compiling %s to %s", name, out)
-	if err := compiler.Compile(&buf, seeker, opts); err != nil {
+	if err := compiler.Compile(&buf, f, opts); err != nil {
 		return "", err
 	}
 	w, err := m.Create(out, true)
@@ -1,7 +1,9 @@
 package assets
 
 import (
+	"mime"
 	"net/http"
+	"path"
 	"time"
 
 	"gnd.la/internal/httpserve"
@@ -15,7 +17,21 @@ import (
 func (m *Manager) Handler() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		p := m.Path(r.URL)
-		f, err := m.Load(p)
+		name := p
+		if asset := m.Manifest().asset(p); asset != nil && asset.Gzip {
+			header := w.Header()
+			header.Add("Vary", "Accept-Encoding")
+			if acceptsGzip(r.Header.Get("Accept-Encoding")) {
+				// Set the type of the uncompressed asset, otherwise
+				// http.ServeContent would use application/x-gzip.
+				if ctype := mime.TypeByExtension(path.Ext(p)); ctype != "" {
+					header.Set("Content-Type", ctype)
+				}
+				header.Set("Content-Encoding", "gzip")
+				name = p + ".gz"
+			}
+		}
+		f, err := m.Load(name)
 		if err != nil {
 			log.Warningf("error serving %s: %s", r.URL, err)
 			return
@@ -26,7 +42,7 @@ func (m *Manager) Handler() http.HandlerFunc {
 			return
 		}
 		var modtime time.Time
-		if st, err := m.VFS().Stat(p); err == nil {
+		if st, err := m.VFS().Stat(name); err == nil {
 			modtime = st.ModTime()
 		}
 		if r.URL.RawQuery != "" {
@@ -1,14 +1,19 @@
 package assets
 
 import (
+	"hash"
+	"hash/adler32"
 	"io"
 	"net/url"
 	"os"
 	"path"
 	"runtime"
+	"strings"
 	"sync"
 
 	"gnd.la/crypto/hashutil"
+	"gnd.la/loaders"
+	"gnd.la/log"
 	"gnd.la/net/urlutil"
 
 	"gopkgs.com/vfs.v1"
@@ -19,6 +24,8 @@ type Manager struct {
 	prefix       string
 	prefixLength int
 	cache        map[string]string
+	manifest     *Manifest
+	listeners    []func(name string)
 	mutex        sync.RWMutex
 }
 
@@ -27,6 +34,13 @@ func New(fs vfs.VFS, prefix string) *Manager {
 	m.cache = make(map[string]string)
 	m.fs = fs
 	m.SetPrefix(prefix)
+	if fs != nil {
+		manifest, err := LoadManifest(fs)
+		if err != nil && !vfs.IsNotExist(err) {
+			log.Warningf("error loading asset manifest: %s", err)
+		}
+		m.manifest = manifest
+	}
 	runtime.SetFinalizer(m, func(manager *Manager) {
 		manager.Close()
 	})
@@ -34,15 +48,28 @@ func New(fs vfs.VFS, prefix string) *Manager {
 }
 
 func (m *Manager) hash(name string) (string, error) {
-	f, err := m.Load(name)
+	h, err := m.sum(name, adler32.New())
 	if err != nil {
 		return "", err
 	}
-	h := hashutil.Adler32(f)
-	f.Close()
 	return h[:6], nil
 }
 
+// sum returns the hash of the given asset using h, streaming
+// its contents rather than reading them into memory.
+func (m *Manager) sum(name string, h hash.Hash) (string, error) {
+	f, err := m.Load(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	w := hashutil.NewWriter(h)
+	if _, err := io.Copy(w, f); err != nil {
+		return "", err
+	}
+	return w.Sum(), nil
+}
+
 func (m *Manager) VFS() vfs.VFS {
 	return m.fs
 }
@@ -89,6 +116,11 @@ func (m *Manager) URL(name string) string {
 	}
 	m.mutex.RLock()
 	h, ok := m.cache[name]
+	if !ok {
+		if asset := m.manifest.asset(name); asset != nil {
+			h, ok = asset.Hash, true
+		}
+	}
 	m.mutex.RUnlock()
 	if !ok {
 		h, _ = m.hash(name)
@@ -103,6 +135,38 @@ func (m *Manager) URL(name string) string {
 	return clean
 }
 
+// Watch starts watching the Manager's VFS for changes, so the asset
+// hashes used by URL are recalculated when the assets change. The
+// VFS must implement loaders.Watchable. Since the assets are expected
+// to change, the manifest is ignored after calling Watch.
+func (m *Manager) Watch() error {
+	m.mutex.Lock()
+	m.manifest = nil
+	m.mutex.Unlock()
+	return loaders.Watch(m.fs, func(name string, op loaders.Op) {
+		m.mutex.Lock()
+		delete(m.cache, path.Clean(name))
+		delete(m.cache, strings.TrimPrefix(path.Clean(name), "/"))
+		listeners := m.listeners
+		m.mutex.Unlock()
+		for _, v := range listeners {
+			v(name)
+		}
+	})
+}
+
+// OnChange adds a function which is called with the name of
+// the asset every time an asset changes, after the asset hashes
+// have been invalidated. Changes are only detected after calling
+// Watch. Functions might be called concurrently.
+func (m *Manager) OnChange(f func(name string)) {
+	m.mutex.Lock()
+	// Always copy, since the watcher calls the
+	// listeners without holding the lock.
+	m.listeners = append(m.listeners[:len(m.listeners):len(m.listeners)], f)
+	m.mutex.Unlock()
+}
+
 func (m *Manager) Prefix() string {
 	return m.prefix
 }
@@ -115,6 +179,13 @@ func (m *Manager) SetPrefix(prefix string) {
 	m.prefixLength = len(prefix)
 }
 
+// Manifest returns the asset manifest loaded by the Manager, if any.
+func (m *Manager) Manifest() *Manifest {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.manifest
+}
+
 func (m *Manager) Close() error {
 	return nil
 }
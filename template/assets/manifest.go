@@ -0,0 +1,187 @@
+package assets
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"gopkgs.com/vfs.v1"
+)
+
+const (
+	// ManifestName is the name of the asset manifest, which is
+	// stored at the root of the assets VFS. See WriteManifest.
+	ManifestName = "assets-manifest.json"
+)
+
+var (
+	// compressibleExtensions are the extensions of the assets
+	// which are precompressed by WriteManifest.
+	compressibleExtensions = map[string]bool{
+		".css":  true,
+		".js":   true,
+		".json": true,
+		".map":  true,
+		".svg":  true,
+		".html": true,
+		".htm":  true,
+		".txt":  true,
+		".xml":  true,
+		".ico":  true,
+		".ttf":  true,
+		".otf":  true,
+		".eot":  true,
+		".wasm": true,
+	}
+)
+
+// Manifest contains the precomputed information about the assets,
+// usually written when building an app for deployment. When a
+// Manager finds a manifest, it uses the hashes from it rather than
+// computing them and serves the precompressed assets to the clients
+// which support them.
+type Manifest struct {
+	Assets map[string]*ManifestAsset `json:"assets"`
+}
+
+// ManifestAsset contains the information about an asset in
+// a Manifest.
+type ManifestAsset struct {
+	// Hash is the fingerprint of the asset, used in its URL.
+	Hash string `json:"hash"`
+	Size int64  `json:"size"`
+	// Gzip indicates if there's a gzip compressed version
+	// of the asset, with the .gz extension.
+	Gzip bool `json:"gzip,omitempty"`
+}
+
+func (m *Manifest) asset(name string) *ManifestAsset {
+	if m == nil {
+		return nil
+	}
+	return m.Assets[manifestKey(name)]
+}
+
+func manifestKey(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
+
+// LoadManifest loads the manifest from the given VFS. If
+// there's no manifest, it returns nil and an error which
+// satisfies os.IsNotExist.
+func LoadManifest(fs vfs.VFS) (*Manifest, error) {
+	f, err := fs.Open(ManifestName)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var m *Manifest
+	if err := json.NewDecoder(f).Decode(&m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WriteManifest fingerprints all the assets in the given VFS and
+// writes the manifest to it. If compress is true, compressible assets
+// (e.g. scripts and stylesheets) are also compressed with gzip, storing
+// them with the .gz extension, as long as that makes them smaller.
+// Since the manifest uses the hashes of the asset contents, it should
+// be written after the assets are compiled and bundled.
+func WriteManifest(fs vfs.VFS, compress bool) (*Manifest, error) {
+	m := &Manager{fs: fs}
+	manifest := &Manifest{Assets: make(map[string]*ManifestAsset)}
+	err := vfs.Walk(fs, "/", func(fs vfs.VFS, p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		name := manifestKey(p)
+		if name == ManifestName || path.Base(name)[0] == '.' {
+			return nil
+		}
+		if ext := path.Ext(name); ext == ".gz" && m.Has(strings.TrimSuffix(name, ext)) {
+			// Previously compressed version
+			return nil
+		}
+		h, err := m.hash(name)
+		if err != nil {
+			return err
+		}
+		asset := &ManifestAsset{Hash: h, Size: info.Size()}
+		if compress && compressibleExtensions[strings.ToLower(path.Ext(name))] {
+			compressed, err := compressAsset(m, name, info.Size())
+			if err != nil {
+				return err
+			}
+			asset.Gzip = compressed
+		}
+		manifest.Assets[name] = asset
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := vfs.WriteFile(fs, ManifestName, data, 0644); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// compressAsset writes the gzip compressed version of the given
+// asset, returning false without writing it if compressing the
+// asset doesn't make it smaller.
+func compressAsset(m *Manager, name string, size int64) (bool, error) {
+	f, err := m.Load(name)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	var buf bytes.Buffer
+	w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return false, err
+	}
+	if _, err := io.Copy(w, f); err != nil {
+		return false, err
+	}
+	if err := w.Close(); err != nil {
+		return false, err
+	}
+	gzName := name + ".gz"
+	if int64(buf.Len()) >= size {
+		// Remove any stale compressed version
+		if m.Has(gzName) {
+			if err := m.fs.Remove(gzName); err != nil {
+				return false, err
+			}
+		}
+		return false, nil
+	}
+	return true, vfs.WriteFile(m.fs, gzName, buf.Bytes(), 0644)
+}
+
+// acceptsGzip returns true iff the given Accept-Encoding
+// header value includes gzip.
+func acceptsGzip(header string) bool {
+	for _, v := range strings.Split(header, ",") {
+		parts := strings.Split(v, ";")
+		if strings.TrimSpace(parts[0]) != "gzip" {
+			continue
+		}
+		for _, p := range parts[1:] {
+			if q := strings.Replace(p, " ", "", -1); q == "q=0" || q == "q=0.0" || q == "q=0.00" || q == "q=0.000" {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package assets
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gopkgs.com/vfs.v1"
+)
+
+var (
+	manifestStyle = strings.Repeat("body { color: red; }\n", 100)
+	manifestFiles = map[string]*vfs.File{
+		"css/style.css": &vfs.File{Data: []byte(manifestStyle)},
+		"js/tiny.js":    &vfs.File{Data: []byte("a")},
+		"img/logo.png":  &vfs.File{Data: []byte(strings.Repeat("png", 100))},
+		".hidden":       &vfs.File{Data: []byte("hidden")},
+	}
+)
+
+func TestWriteManifest(t *testing.T) {
+	fs, err := vfs.Map(manifestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest, err := WriteManifest(fs, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(manifest.Assets) != 3 {
+		t.Errorf("expecting 3 assets in the manifest, got %d", len(manifest.Assets))
+	}
+	m := &Manager{fs: fs}
+	for k, v := range map[string]bool{
+		"css/style.css": true,
+		"js/tiny.js":    false,
+		"img/logo.png":  false,
+	} {
+		asset := manifest.Assets[k]
+		if asset == nil {
+			t.Errorf("asset %s not in manifest", k)
+			continue
+		}
+		if h, _ := m.hash(k); asset.Hash != h {
+			t.Errorf("expecting hash %q for %s, got %q", h, k, asset.Hash)
+		}
+		if asset.Size != int64(len(manifestFiles[k].Data)) {
+			t.Errorf("expecting size %d for %s, got %d", len(manifestFiles[k].Data), k, asset.Size)
+		}
+		if asset.Gzip != v || m.Has(k+".gz") != v {
+			t.Errorf("expecting gzip = %v for %s, got %v", v, k, asset.Gzip)
+		}
+	}
+	data, err := vfs.ReadFile(fs, "css/style.css.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uncompressed, err := ioutil.ReadAll(r); err != nil || string(uncompressed) != manifestStyle {
+		t.Errorf("invalid compressed asset (err %v)", err)
+	}
+	loaded, err := LoadManifest(fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.Assets) != len(manifest.Assets) || *loaded.asset("/css/style.css") != *manifest.Assets["css/style.css"] {
+		t.Errorf("loaded manifest %+v does not match written one %+v", loaded, manifest)
+	}
+	// Writing it again must skip the compressed assets
+	manifest, err = WriteManifest(fs, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(manifest.Assets) != 3 || manifest.Assets["css/style.css.gz"] != nil {
+		t.Errorf("unexpected assets in rewritten manifest %+v", manifest.Assets)
+	}
+}
+
+func TestLoadMissingManifest(t *testing.T) {
+	fs, err := vfs.Map(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadManifest(fs); !vfs.IsNotExist(err) {
+		t.Errorf("expecting a not exist error, got %v", err)
+	}
+}
+
+func TestManifestHandler(t *testing.T) {
+	fs, err := vfs.Map(manifestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := WriteManifest(fs, true); err != nil {
+		t.Fatal(err)
+	}
+	m := New(fs, "/assets/")
+	for _, v := range []struct {
+		path     string
+		encoding string
+		gzip     bool
+	}{
+		{"/assets/css/style.css", "gzip, deflate", true},
+		{"/assets/css/style.css", "deflate", false},
+		{"/assets/css/style.css", "gzip;q=0", false},
+		{"/assets/js/tiny.js", "gzip", false},
+	} {
+		req, _ := http.NewRequest("GET", v.path, nil)
+		req.Header.Set("Accept-Encoding", v.encoding)
+		w := httptest.NewRecorder()
+		m.Handler()(w, req)
+		if gz := w.Header().Get("Content-Encoding") == "gzip"; gz != v.gzip {
+			t.Errorf("expecting gzip = %v for %s with Accept-Encoding %q, got %v", v.gzip, v.path, v.encoding, gz)
+			continue
+		}
+		if v.gzip {
+			if ctype := w.Header().Get("Content-Type"); !strings.HasPrefix(ctype, "text/css") {
+				t.Errorf("expecting text/css Content-Type for compressed %s, got %q", v.path, ctype)
+			}
+			r, err := gzip.NewReader(w.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if data, err := ioutil.ReadAll(r); err != nil || string(data) != manifestStyle {
+				t.Errorf("invalid compressed response for %s (err %v)", v.path, err)
+			}
+		}
+	}
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	cases := map[string]bool{
+		"":                       false,
+		"gzip":                   true,
+		"gzip, deflate":          true,
+		"deflate, gzip":          true,
+		"deflate,gzip;q=0.5":     true,
+		"gzip;q=1.0, identity":   true,
+		"gzip;q=0":               false,
+		"gzip; q=0.000":          false,
+		"gzip;q=0, deflate":      false,
+		"deflate, br":            false,
+		"x-gzip":                 false,
+		"identity;q=0, gzip":     true,
+		"gzip;level=1;q=0.0":     false,
+		"  gzip  ;  q=0.8  , br": true,
+	}
+	for k, v := range cases {
+		if r := acceptsGzip(k); r != v {
+			t.Errorf("expecting acceptsGzip(%q) = %v, got %v", k, v, r)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+package template
+
+import (
+	"fmt"
+	"html/template"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template/parse"
+)
+
+const escaperPrefix = "html_template_"
+
+var (
+	// escaperContexts maps the escapers added by html/template
+	// to the context they're used in.
+	escaperContexts = map[string]string{
+		"commentescaper":  "Comment",
+		"cssescaper":      "CSSString",
+		"cssvaluefilter":  "CSS",
+		"htmlescaper":     "HTML",
+		"htmlnamefilter":  "AttrName",
+		"jsregexpescaper": "JSRegexp",
+		"jsstrescaper":    "JSString",
+		"jsvalescaper":    "JS",
+		"rcdataescaper":   "RCDATA",
+		"urlescaper":      "URL",
+		"urlfilter":       "URL",
+		"urlnormalizer":   "URL",
+	}
+	// safeTypeContexts maps the types which bypass escaping
+	// to the contexts they're meant to be used in.
+	safeTypeContexts = map[reflect.Type][]string{
+		reflect.TypeOf(template.CSS("")):      {"CSS", "CSSString"},
+		reflect.TypeOf(template.HTML("")):     {"HTML", "RCDATA"},
+		reflect.TypeOf(template.HTMLAttr("")): {"AttrName"},
+		reflect.TypeOf(template.JS("")):       {"JS"},
+		reflect.TypeOf(template.JSStr("")):    {"JSString"},
+		reflect.TypeOf(template.URL("")):      {"URL"},
+	}
+	// manualEscaperContexts maps the builtin escaping functions
+	// to the contexts they're appropriate for.
+	manualEscaperContexts = map[string][]string{
+		"html":     {"HTML", "RCDATA", "Attr"},
+		"js":       {"JS", "JSString", "JSRegexp"},
+		"urlquery": {"URL"},
+	}
+)
+
+// Interpolation represents a value interpolated into the output of
+// an HTML template, as recorded when Template.AuditEscaping is enabled.
+type Interpolation struct {
+	// Template is the name of the template which contains the action.
+	Template string
+	// Location is the position of the action, as file:line:col.
+	Location string
+	// Action is the action, without the escaping functions
+	// added by the contextual escaper.
+	Action string
+	// Context is the escaping context for the action. It's one of
+	// HTML, RCDATA, Attr, AttrName, JS, JSString, JSRegexp, CSS,
+	// CSSString, URL or Comment.
+	Context string
+	// InAttr is true when the action appears inside an HTML attribute
+	// value (e.g. an URL in an href attribute).
+	InAttr bool
+	// Escapers are the functions which escape the action output.
+	Escapers []string
+	// Issues contains the potential problems found with the
+	// action, like raw or mismatched content types.
+	Issues []string
+}
+
+func (i *Interpolation) String() string {
+	ctx := i.Context
+	if i.InAttr && ctx != "Attr" {
+		ctx += " (attribute)"
+	}
+	s := fmt.Sprintf("%s: %s in %s context", i.Location, i.Action, ctx)
+	if len(i.Issues) > 0 {
+		s += ": " + strings.Join(i.Issues, "; ")
+	}
+	return s
+}
+
+// EscapeAudit returns the interpolations recorded while compiling
+// the template, sorted by location. It returns nil unless
+// AuditEscaping was enabled before calling Compile. Note that an
+// action might appear several times if its template is called
+// from different contexts.
+func (t *Template) EscapeAudit() []*Interpolation {
+	return t.audit
+}
+
+func (t *Template) auditEscaping() {
+	seen := make(map[string]bool)
+	var audit []*Interpolation
+	for name, tr := range t.trees {
+		orig := name
+		if p := strings.Index(name, "$htmltemplate"); p >= 0 {
+			// Mangled tree generated by html/template, which
+			// has no text. Use the unmangled one for locations.
+			orig = name[:p-1]
+			if tr = t.trees[orig]; tr == nil {
+				continue
+			}
+		}
+		if strings.HasPrefix(orig, "_gondola") {
+			continue
+		}
+		t.walkTree(t.trees[name].Root, func(n *parse.ActionNode) {
+			in := t.interpolation(orig, tr, n)
+			if in == nil {
+				return
+			}
+			key := in.Location + in.Action + in.Context
+			if !seen[key] {
+				seen[key] = true
+				audit = append(audit, in)
+			}
+		})
+	}
+	sort.Sort(interpolationsByLocation(audit))
+	t.audit = audit
+}
+
+func (t *Template) walkTree(n parse.Node, f func(*parse.ActionNode)) {
+	switch x := n.(type) {
+	case *parse.ActionNode:
+		f(x)
+	case *parse.ListNode:
+		if x != nil {
+			for _, v := range x.Nodes {
+				t.walkTree(v, f)
+			}
+		}
+	case *parse.IfNode:
+		t.walkTree(x.List, f)
+		t.walkTree(x.ElseList, f)
+	case *parse.RangeNode:
+		t.walkTree(x.List, f)
+		t.walkTree(x.ElseList, f)
+	case *parse.WithNode:
+		t.walkTree(x.List, f)
+		t.walkTree(x.ElseList, f)
+	}
+}
+
+func (t *Template) interpolation(name string, tr *parse.Tree, n *parse.ActionNode) *Interpolation {
+	if n.Pipe == nil || len(n.Pipe.Decl) > 0 {
+		return nil
+	}
+	cmds := n.Pipe.Cmds
+	var escapers []string
+	for len(cmds) > 0 {
+		id, ok := cmds[len(cmds)-1].Args[0].(*parse.IdentifierNode)
+		if !ok || !strings.HasPrefix(id.Ident, escaperPrefix) {
+			break
+		}
+		escapers = append([]string{strings.TrimPrefix(id.Ident, escaperPrefix)}, escapers...)
+		cmds = cmds[:len(cmds)-1]
+	}
+	if len(escapers) == 0 || len(cmds) == 0 {
+		// Not escaped (e.g. not an HTML template)
+		return nil
+	}
+	if id, ok := cmds[0].Args[0].(*parse.IdentifierNode); ok && strings.HasPrefix(id.Ident, "_gondola") {
+		// Generated by gondola (e.g. assets)
+		return nil
+	}
+	in := &Interpolation{
+		Template: name,
+		Action:   actionString(cmds),
+		Escapers: escapers,
+	}
+	for _, v := range escapers {
+		switch v {
+		case "attrescaper", "nospaceescaper":
+			in.InAttr = true
+		default:
+			if ctx := escaperContexts[v]; ctx != "" && in.Context == "" {
+				in.Context = ctx
+			}
+		}
+	}
+	if in.Context == "" {
+		in.Context = "Attr"
+	}
+	in.Location, _ = tr.ErrorContext(n)
+	if file, line, col, ok := splitErrorContext(in.Location); ok {
+		// Adjust for the prepended varNop nodes
+		in.Location = fmt.Sprintf("%s:%d:%d", file, line, col-t.offsets[tr][line])
+	}
+	last := cmds[len(cmds)-1]
+	if id, ok := last.Args[0].(*parse.IdentifierNode); ok {
+		if contexts := manualEscaperContexts[id.Ident]; contexts != nil {
+			if !containsContext(contexts, in) {
+				in.Issues = append(in.Issues, fmt.Sprintf("%s escaping is not appropriate for this context", id.Ident))
+			}
+		} else if typ := t.funcResultType(id.Ident); typ != nil {
+			if contexts := safeTypeContexts[typ]; contexts != nil {
+				if containsContext(contexts, in) {
+					in.Issues = append(in.Issues, fmt.Sprintf("raw %s returned by %s is not escaped", typ, id.Ident))
+				} else {
+					in.Issues = append(in.Issues, fmt.Sprintf("%s returns %s, which does not match this context", id.Ident, typ))
+				}
+			}
+		}
+	}
+	return in
+}
+
+// funcResultType returns the type of the first value
+// returned by the function with the given name, or
+// nil if there's no such function.
+func (t *Template) funcResultType(name string) reflect.Type {
+	fn := t.funcMap[name]
+	if fn == nil {
+		return nil
+	}
+	typ := reflect.TypeOf(fn.f)
+	if typ == nil || typ.Kind() != reflect.Func || typ.NumOut() == 0 {
+		return nil
+	}
+	return typ.Out(0)
+}
+
+func containsContext(contexts []string, in *Interpolation) bool {
+	for _, v := range contexts {
+		if v == in.Context {
+			return true
+		}
+	}
+	return false
+}
+
+func actionString(cmds []*parse.CommandNode) string {
+	s := make([]string, len(cmds))
+	for ii, v := range cmds {
+		s[ii] = v.String()
+	}
+	return leftDelim + " " + strings.Join(s, " | ") + " " + rightDelim
+}
+
+type interpolationsByLocation []*Interpolation
+
+func (i interpolationsByLocation) Len() int {
+	return len(i)
+}
+
+func (i interpolationsByLocation) Less(a, b int) bool {
+	fa, la, ca, _ := splitErrorContext(i[a].Location)
+	fb, lb, cb, _ := splitErrorContext(i[b].Location)
+	if fa != fb {
+		return fa < fb
+	}
+	if la != lb {
+		return la < lb
+	}
+	if ca != cb {
+		return ca < cb
+	}
+	return i[a].Context < i[b].Context
+}
+
+func (i interpolationsByLocation) Swap(a, b int) {
+	i[a], i[b] = i[b], i[a]
+}
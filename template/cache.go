@@ -0,0 +1,412 @@
+package template
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"html/template"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"text/template/parse"
+
+	"gnd.la/log"
+	"gnd.la/template/assets"
+)
+
+// Increment these when the format of the cached
+// data or the way it's generated changes.
+const (
+	assetsCacheVersion  = 2
+	programCacheVersion = 1
+)
+
+type cachedAssets struct {
+	Top    []byte
+	Bottom []byte
+	// Local contains the names of the local assets
+	// (including bundles) referenced by Top and Bottom.
+	Local []string
+}
+
+type cachedInst struct {
+	Op  opcode
+	Val valType
+}
+
+type cachedContext struct {
+	PC  int
+	Pos parse.Pos
+}
+
+type cachedProgram struct {
+	Funcs    []string
+	Strings  []string
+	Values   []interface{}
+	Bs       [][]byte
+	Code     map[string][]cachedInst
+	Context  map[string][]cachedContext
+	Deferred bool
+}
+
+func init() {
+	// Types which might be produced by pure
+	// functions evaluated at compile time.
+	for _, v := range []interface{}{
+		CSS(""), HTML(""), HTMLAttr(""), JS(""), JSStr(""), URL(""),
+		template.CSS(""), template.HTML(""), template.HTMLAttr(""),
+		template.JS(""), template.JSStr(""), template.URL(""),
+	} {
+		gob.Register(v)
+	}
+}
+
+// assetsCacheKey returns the key used for storing the result of the
+// asset pipeline in t.CacheDir. The key covers the asset declarations
+// in t, its hooks and its children, the contents of the local assets,
+// the prefix of the assets managers (which might point to a CDN), the
+// template variables (only when there are template assets) and the
+// debug mode, so any change invalidates the cached data.
+func (t *Template) assetsCacheKey() (string, error) {
+	h := sha1.New()
+	fmt.Fprintf(h, "%d\n%v\n", assetsCacheVersion, t.Debug)
+	hasTemplates, err := t.hashAssets(h)
+	if err != nil {
+		return "", err
+	}
+	if hasTemplates {
+		// Might contain values without a stable representation,
+		// which just causes the cache to be missed.
+		fmt.Fprintf(h, "%v\n", t.vars)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (t *Template) hashAssets(h hash.Hash) (bool, error) {
+	hasTemplates := false
+	for _, g := range t.assetGroups {
+		fmt.Fprintf(h, "%v\n%s\n", g.Options, g.Manager.Prefix())
+		for _, a := range g.Assets {
+			fmt.Fprintf(h, "%s\n%d\n%d\n%+v\n%v\n%s\n", a.Name, a.Type, a.Position, a.Condition, a.Attributes, a.HTML)
+			var f io.ReadCloser
+			if a.IsTemplate() {
+				hasTemplates = true
+				f, _ = t.fs.Open(a.TemplateName())
+			} else if !a.IsRemote() && g.Manager.Has(a.Name) {
+				var err error
+				if f, err = g.Manager.Load(a.Name); err != nil {
+					return false, err
+				}
+			}
+			if f != nil {
+				_, err := io.Copy(h, f)
+				f.Close()
+				if err != nil {
+					return false, err
+				}
+			}
+		}
+	}
+	for _, v := range t.hooks {
+		ht, err := v.Template.hashAssets(h)
+		if err != nil {
+			return false, err
+		}
+		hasTemplates = hasTemplates || ht
+	}
+	for _, v := range t.children {
+		ht, err := v.hashAssets(h)
+		if err != nil {
+			return false, err
+		}
+		hasTemplates = hasTemplates || ht
+	}
+	return hasTemplates, nil
+}
+
+func (t *Template) assetsCachePath(key string) string {
+	return filepath.Join(t.CacheDir, "assets-"+key+".gob")
+}
+
+// assetManagers returns the assets managers used by
+// t, its hooks and its children.
+func (t *Template) assetManagers(managers []*assets.Manager) []*assets.Manager {
+	add := func(m *assets.Manager) {
+		if m == nil {
+			return
+		}
+		for _, v := range managers {
+			if v == m {
+				return
+			}
+		}
+		managers = append(managers, m)
+	}
+	add(t.AssetsManager)
+	for _, g := range t.assetGroups {
+		add(g.Manager)
+	}
+	for _, v := range t.hooks {
+		managers = v.Template.assetManagers(managers)
+	}
+	for _, v := range t.children {
+		managers = v.assetManagers(managers)
+	}
+	return managers
+}
+
+// hasLocalAssets returns true iff all the given assets are
+// available from any of the assets managers used by t.
+func (t *Template) hasLocalAssets(names []string) bool {
+	managers := t.assetManagers(nil)
+	for _, name := range names {
+		found := false
+		for _, m := range managers {
+			if m.Has(name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// loadCachedAssets loads the asset pipeline results from t.CacheDir,
+// returning true if they were found and all the local assets they
+// reference (e.g. bundles) are available.
+func (t *Template) loadCachedAssets(key string) bool {
+	data, err := ioutil.ReadFile(t.assetsCachePath(key))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warningf("error reading cached assets for template %s: %s", t.name, err)
+		}
+		return false
+	}
+	var cached cachedAssets
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&cached); err != nil {
+		log.Warningf("error decoding cached assets for template %s: %s", t.name, err)
+		return false
+	}
+	if !t.hasLocalAssets(cached.Local) {
+		log.Debugf("cached assets for template %s reference missing assets, ignoring them", t.name)
+		return false
+	}
+	log.Debugf("using cached assets for template %s", t.name)
+	t.topAssets = cached.Top
+	t.bottomAssets = cached.Bottom
+	return true
+}
+
+// storeCachedAssets writes the asset pipeline results to t.CacheDir.
+// local contains the names of the local assets referenced by them.
+// Errors are only logged, since the cache is just an optimization.
+func (t *Template) storeCachedAssets(key string, local []string) {
+	cached := &cachedAssets{Top: t.topAssets, Bottom: t.bottomAssets, Local: local}
+	t.storeCached(t.assetsCachePath(key), "assets", cached)
+}
+
+// storeCached writes the gob encoded value to the given path
+// in t.CacheDir. what is only used for logging errors.
+func (t *Template) storeCached(p string, what string, value interface{}) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+		log.Warningf("error encoding cached %s for template %s: %s", what, t.name, err)
+		return
+	}
+	if err := os.MkdirAll(t.CacheDir, 0755); err != nil {
+		log.Warningf("error creating template cache directory: %s", err)
+		return
+	}
+	// Write to a temporary file and then rename it, so
+	// other processes never see a partially written file.
+	f, err := ioutil.TempFile(t.CacheDir, "tmp-"+what+"-")
+	if err != nil {
+		log.Warningf("error creating cached %s for template %s: %s", what, t.name, err)
+		return
+	}
+	_, err = f.Write(buf.Bytes())
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), p)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		log.Warningf("error writing cached %s for template %s: %s", what, t.name, err)
+	}
+}
+
+// programCacheKey returns the key used for storing the compiled
+// program in t.CacheDir. The key covers the parsed trees, the
+// template sources (which determine the positions used in error
+// messages), the assets, the names and types of the available
+// functions and the options which alter the compilation. Note
+// that pure functions might be evaluated at compile time, so
+// changing their implementation requires clearing t.CacheDir.
+func (t *Template) programCacheKey() (string, error) {
+	h := sha1.New()
+	fmt.Fprintf(h, "%d\n%s\n%s\n%v\n", programCacheVersion, t.root, t.contentType, t.tmpl.DropComments)
+	fmt.Fprintf(h, "%d\n%s\n%d\n%s\n", len(t.topAssets), t.topAssets, len(t.bottomAssets), t.bottomAssets)
+	funcs := make([]string, 0, len(t.funcMap))
+	for k := range t.funcMap {
+		funcs = append(funcs, k)
+	}
+	sort.Strings(funcs)
+	for _, v := range funcs {
+		info := t.funcMap[v]
+		fmt.Fprintf(h, "%s\n%s\n%d\n", v, reflect.TypeOf(info.f), info.traits)
+	}
+	trees := make([]string, 0, len(t.trees))
+	for k := range t.trees {
+		// The unnamed tree is added by html/template and might
+		// point to any of the others, so it's not stable.
+		if k != "" {
+			trees = append(trees, k)
+		}
+	}
+	sort.Strings(trees)
+	for _, v := range trees {
+		fmt.Fprintf(h, "%s\n", v)
+		if tr := t.trees[v]; tr != nil && tr.Root != nil {
+			fmt.Fprintf(h, "%s\n%s\n", tr.ParseName, tr.Root.String())
+		}
+	}
+	if err := t.hashSources(h); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (t *Template) hashSources(h hash.Hash) error {
+	for _, v := range t.loaded {
+		f, err := t.fs.Open(v)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%s\n", v)
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	for _, v := range t.hooks {
+		if err := v.Template.hashSources(h); err != nil {
+			return err
+		}
+	}
+	for _, v := range t.children {
+		if err := v.hashSources(h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *Template) programCachePath(key string) string {
+	return filepath.Join(t.CacheDir, "program-"+key+".gob")
+}
+
+// loadCachedProgram loads the compiled program from t.CacheDir,
+// returning nil if it wasn't found or it couldn't be used.
+func (t *Template) loadCachedProgram(key string) *program {
+	data, err := ioutil.ReadFile(t.programCachePath(key))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warningf("error reading cached program for template %s: %s", t.name, err)
+		}
+		return nil
+	}
+	var cached cachedProgram
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&cached); err != nil {
+		log.Warningf("error decoding cached program for template %s: %s", t.name, err)
+		return nil
+	}
+	p := &program{
+		tmpl:     t,
+		strings:  cached.Strings,
+		bs:       cached.Bs,
+		code:     make(map[string][]inst, len(cached.Code)),
+		context:  make(map[string][]*context, len(cached.Context)),
+		deferred: cached.Deferred,
+	}
+	for _, v := range cached.Funcs {
+		info := t.funcMap[v]
+		if info == nil {
+			log.Debugf("cached program for template %s uses missing function %q, ignoring it", t.name, v)
+			return nil
+		}
+		p.funcs = append(p.funcs, newFn(info, v))
+	}
+	for _, v := range cached.Strings {
+		p.rstrings = append(p.rstrings, reflect.ValueOf(v))
+	}
+	for _, v := range cached.Values {
+		p.values = append(p.values, reflect.ValueOf(v))
+	}
+	for k, v := range cached.Code {
+		code := make([]inst, len(v))
+		for ii, c := range v {
+			code[ii] = inst{op: c.Op, val: c.Val}
+		}
+		p.code[k] = code
+	}
+	for k, v := range cached.Context {
+		ctx := make([]*context, len(v))
+		for ii, c := range v {
+			// Only the position is used, for error messages
+			ctx[ii] = &context{pc: c.PC, node: &parse.TextNode{NodeType: parse.NodeText, Pos: c.Pos}}
+		}
+		p.context[k] = ctx
+	}
+	log.Debugf("using cached program for template %s", t.name)
+	return p
+}
+
+// storeCachedProgram writes the compiled program to t.CacheDir.
+// Programs containing values which can't be encoded (e.g. results
+// of pure functions with unregistered types) are not cached.
+func (t *Template) storeCachedProgram(key string, p *program) {
+	cached := &cachedProgram{
+		Strings:  p.strings,
+		Bs:       p.bs,
+		Code:     make(map[string][]cachedInst, len(p.code)),
+		Context:  make(map[string][]cachedContext, len(p.context)),
+		Deferred: p.deferred,
+	}
+	for _, v := range p.funcs {
+		cached.Funcs = append(cached.Funcs, v.name)
+	}
+	for _, v := range p.values {
+		var val interface{}
+		if v.IsValid() {
+			val = v.Interface()
+		}
+		cached.Values = append(cached.Values, val)
+	}
+	for k, v := range p.code {
+		code := make([]cachedInst, len(v))
+		for ii, c := range v {
+			code[ii] = cachedInst{Op: c.op, Val: c.val}
+		}
+		cached.Code[k] = code
+	}
+	for k, v := range p.context {
+		ctx := make([]cachedContext, len(v))
+		for ii, c := range v {
+			ctx[ii] = cachedContext{PC: c.pc, Pos: c.node.Position()}
+		}
+		cached.Context[k] = ctx
+	}
+	t.storeCached(t.programCachePath(key), "program", cached)
+}
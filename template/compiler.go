@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strings"
 	"text/template/parse"
+	"time"
 
 	"gnd.la/internal/runtimeutil"
 	"gnd.la/util/stringutil"
@@ -216,6 +217,13 @@ type State struct {
 	res       []reflect.Value // used for storing return values in fast paths
 	resPtr    *reflect.Value
 	context   reflect.Value
+	fragments []fragment
+	streaming bool
+	deferred  []*deferredBlock
+	// used for enforcing the execution limits
+	depth      int
+	iterations int
+	deadline   time.Time
 }
 
 func newState(p *program, w *bytes.Buffer) *State {
@@ -223,16 +231,19 @@ func newState(p *program, w *bytes.Buffer) *State {
 		s.reset()
 		s.p = p
 		s.w = w
+		s.startClock()
 		return s
 	}
 	res := make([]reflect.Value, 1)
 	resPtr := &res[0]
-	return &State{
+	s := &State{
 		p:      p,
 		w:      w,
 		res:    res,
 		resPtr: resPtr,
 	}
+	s.startClock()
+	return s
 }
 
 // Exported methods
@@ -260,6 +271,11 @@ func (s *State) reset() {
 	s.marks = s.marks[:0]
 	s.dot = s.dot[:0]
 	s.iterators = s.iterators[:0]
+	s.fragments = s.fragments[:0]
+	s.streaming = false
+	s.deferred = s.deferred[:0]
+	s.depth = 0
+	s.iterations = 0
 }
 
 func (s *State) formatTreeErr(name string, tr *parse.Tree, node parse.Node, err error) error {
@@ -573,6 +589,9 @@ func (s *State) execute(tmpl string, ns string, dot reflect.Value) (err error) {
 			iter := s.iterators[p]
 			next, idx, val := iter.Next()
 			if next {
+				if err := s.nextIteration(); err != nil {
+					return s.formatErr(pc, tmpl, err)
+				}
 				s.stack = append(s.stack, idx, val)
 			} else {
 				s.iterators = s.iterators[:p]
@@ -605,10 +624,20 @@ func (s *State) execute(tmpl string, ns string, dot reflect.Value) (err error) {
 			if len(s.stack) > 0 {
 				dupDot = s.stack[len(s.stack)-1]
 			}
-			err := s.execute(name, ns, dupDot)
-			if err != nil {
-				// execute already returns the formatted error
-				return err
+			// Blocks inside a {{ cache }} are rendered in place,
+			// otherwise the cached fragment would contain the
+			// placeholder without the deferred output.
+			if s.streaming && len(s.fragments) == 0 && isDeferredTemplate(name) {
+				s.deferTemplate(name, ns, dupDot)
+			} else {
+				if err := s.enterTemplate(name); err != nil {
+					return s.formatErr(pc, tmpl, err)
+				}
+				if err := s.execute(name, ns, dupDot); err != nil {
+					// execute already returns the formatted error
+					return err
+				}
+				s.depth--
 			}
 			s.vars = s.vars[:mark]
 		case opVAL:
@@ -861,6 +890,8 @@ type program struct {
 	bs       [][]byte
 	code     map[string][]inst
 	context  map[string][]*context
+	// true iff there are any {{ defer }} blocks
+	deferred bool
 	// used only during compilation
 	s *scratch
 }
@@ -1382,6 +1413,9 @@ func compileTemplate(p *program, tmpl *Template) error {
 		}
 		p.code[k] = p.s.buf
 		p.context[k] = p.s.ctx
+		if isDeferredTemplate(k) {
+			p.deferred = true
+		}
 		p.s = nil
 	}
 	return nil
@@ -1391,11 +1425,25 @@ func newProgram(tmpl *Template) (*program, error) {
 	if strings.Contains(tmpl.contentType, "html") {
 		tmpl.addHtmlEscaping()
 	}
+	var cacheKey string
+	if tmpl.CacheDir != "" {
+		key, err := tmpl.programCacheKey()
+		if err != nil {
+			return nil, err
+		}
+		if p := tmpl.loadCachedProgram(key); p != nil {
+			return p, nil
+		}
+		cacheKey = key
+	}
 	p := &program{tmpl: tmpl, code: make(map[string][]inst), context: make(map[string][]*context)}
 	if err := compileTemplate(p, tmpl); err != nil {
 		return nil, err
 	}
 	p.stitch()
+	if cacheKey != "" {
+		tmpl.storeCachedProgram(cacheKey, p)
+	}
 	return p, nil
 }
 
@@ -0,0 +1,167 @@
+package template
+
+import (
+	"fmt"
+	"text/template/parse"
+
+	"gnd.la/cache"
+	"gnd.la/internal/templateutil"
+)
+
+const (
+	beginCacheName     = "cache"
+	endCacheName       = "endcache"
+	beginCacheFuncName = "_gondola_cacheBegin"
+	endCacheFuncName   = "_gondola_cacheEnd"
+	fragmentKeyPrefix  = "gondola:fragment:"
+)
+
+// cacher is implemented by template contexts which provide a
+// cache for storing fragments (e.g. *gnd.la/app.Context).
+type cacher interface {
+	Cache() *cache.Cache
+}
+
+type fragment struct {
+	cache   *cache.Cache
+	key     string
+	timeout int
+	pos     int
+}
+
+// cacheBegin is called at the start of a {{ cache }} block. If the
+// fragment is found in the cache, it's written to the output and
+// cacheBegin returns false, so the block is skipped. Otherwise, it
+// records the current output position and returns true.
+func cacheBegin(s *State, ctx interface{}, key string, timeout int) bool {
+	var c *cache.Cache
+	if cc, ok := ctx.(cacher); ok {
+		c = cc.Cache()
+	}
+	key = fragmentKeyPrefix + key
+	if c != nil {
+		if data, err := c.GetBytes(key); err == nil {
+			s.w.Write(data)
+			return false
+		}
+	}
+	s.fragments = append(s.fragments, fragment{
+		cache:   c,
+		key:     key,
+		timeout: timeout,
+		pos:     s.w.Len(),
+	})
+	return true
+}
+
+// cacheEnd is called at the end of a {{ cache }} block which was not
+// found in the cache, and stores the output generated by the block.
+// It always returns an empty string.
+func cacheEnd(s *State) string {
+	p := len(s.fragments) - 1
+	f := s.fragments[p]
+	s.fragments = s.fragments[:p]
+	if f.cache != nil {
+		out := s.w.Bytes()[f.pos:]
+		// The buffer is reused, so the data must
+		// be copied before passing it to the cache.
+		data := make([]byte, len(out))
+		copy(data, out)
+		f.cache.SetBytes(f.key, data, f.timeout)
+	}
+	return ""
+}
+
+func isCacheBlock(n parse.Node) (*parse.ActionNode, bool) {
+	if an, ok := n.(*parse.ActionNode); ok && len(an.Pipe.Decl) == 0 && len(an.Pipe.Cmds) == 1 {
+		args := an.Pipe.Cmds[0].Args
+		if len(args) > 0 {
+			if id, ok := args[0].(*parse.IdentifierNode); ok && id.Ident == beginCacheName {
+				return an, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// replaceCacheBlocks replaces {{ cache "key" timeout }} ... {{ endcache }}
+// blocks with an {{ if }} node which calls cacheBegin and cacheEnd.
+func replaceCacheBlocks(name string, treeMap map[string]*parse.Tree) error {
+	var err error
+	for _, tr := range treeMap {
+		templateutil.WalkTree(tr, func(n, p parse.Node) {
+			if err != nil {
+				return
+			}
+			list, ok := n.(*parse.ListNode)
+			if !ok {
+				return
+			}
+			list.Nodes, err = replaceListCacheBlocks(tr, list.Nodes)
+		})
+		if err != nil {
+			return fmt.Errorf("error in %s: %s", name, err)
+		}
+	}
+	return nil
+}
+
+func replaceListCacheBlocks(tr *parse.Tree, nodes []parse.Node) ([]parse.Node, error) {
+	for ii := 0; ii < len(nodes); ii++ {
+		an, ok := isCacheBlock(nodes[ii])
+		if !ok {
+			if templateutil.IsPseudoFunction(nodes[ii], endCacheName) {
+				loc, _ := tr.ErrorContext(nodes[ii])
+				return nil, fmt.Errorf("%s: {{ %s }} without {{ %s }}", loc, endCacheName, beginCacheName)
+			}
+			continue
+		}
+		if args := an.Pipe.Cmds[0].Args; len(args) != 3 {
+			loc, _ := tr.ErrorContext(an)
+			return nil, fmt.Errorf("%s: {{ %s }} requires 2 arguments (key and timeout), %d given", loc, beginCacheName, len(args)-1)
+		}
+		depth := 0
+		end := -1
+		for jj := ii + 1; jj < len(nodes); jj++ {
+			if _, ok := isCacheBlock(nodes[jj]); ok {
+				depth++
+			} else if templateutil.IsPseudoFunction(nodes[jj], endCacheName) {
+				if depth == 0 {
+					end = jj
+					break
+				}
+				depth--
+			}
+		}
+		if end < 0 {
+			loc, _ := tr.ErrorContext(an)
+			return nil, fmt.Errorf("%s: unterminated {{ %s }}, missing {{ %s }}", loc, beginCacheName, endCacheName)
+		}
+		pos := an.Position()
+		// Nested blocks are replaced when the walk
+		// reaches the list of the new if node. The
+		// original action nodes are reused, since they
+		// carry a reference to their tree.
+		endAction := nodes[end].(*parse.ActionNode)
+		endAction.Pipe.Cmds[0].Args[0] = parse.NewIdentifier(endCacheFuncName).SetPos(endAction.Position())
+		body := append([]parse.Node(nil), nodes[ii+1:end]...)
+		body = append(body, endAction)
+		an.Pipe.Cmds[0].Args[0] = parse.NewIdentifier(beginCacheFuncName).SetPos(pos)
+		ifNode := &parse.IfNode{
+			BranchNode: parse.BranchNode{
+				NodeType: parse.NodeIf,
+				Pos:      pos,
+				Pipe:     an.Pipe,
+				List: &parse.ListNode{
+					NodeType: parse.NodeList,
+					Pos:      pos,
+					Nodes:    body,
+				},
+			},
+		}
+		repl := append([]parse.Node(nil), nodes[:ii]...)
+		repl = append(repl, ifNode)
+		nodes = append(repl, nodes[end+1:]...)
+	}
+	return nodes, nil
+}
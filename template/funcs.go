@@ -288,6 +288,16 @@ func toHtml(s string) template.HTML {
 	return template.HTML(strings.Replace(html.Escape(s), "\n", "<br>", -1))
 }
 
+func sanitize(s string, policy ...string) (template.HTML, error) {
+	var p *html.Policy
+	if len(policy) > 0 {
+		if p = html.PolicyNamed(policy[0]); p == nil {
+			return "", fmt.Errorf("unknown sanitizer policy %q", policy[0])
+		}
+	}
+	return template.HTML(html.Sanitize(s, p)), nil
+}
+
 func getVar(s *State, name string) interface{} {
 	v, ok := s.Var(name)
 	if !ok || !v.IsValid() {
@@ -380,6 +390,14 @@ var templateFuncs = makeFuncMap(FuncMap{
 	// Converts plain text to HTML by escaping it and replacing
 	// newlines with <br> tags.
 	"#to_html": toHtml,
+	// Sanitizes untrusted HTML using the given policy name, removing
+	// anything not allowed by it (see gnd.la/html.Sanitize). If no policy
+	// is provided, "comments" is used. The "rich_text" policy is also
+	// available and more can be added with gnd.la/html.RegisterPolicy.
+	//
+	//  {{ sanitize .Comment }}
+	//  {{ sanitize .Post.Body "rich_text" }}
+	"#sanitize": sanitize,
 
 	// !state manipulation functions
 
@@ -403,6 +421,38 @@ var templateFuncs = makeFuncMap(FuncMap{
 
 	// !Pseudo-functions which act as custom tags
 	"extend": nop,
+	// Inside a template which redefines another one (e.g. a block
+	// overridden by a template which extends another), it calls
+	// the previous definition of the template with the same dot.
+	//
+	//  {{ define "title" }}{{ super }} - Subsection{{ end }}
+	"super": nop,
+	// Caches the output of the block until the matching {{ endcache }}
+	// using the given key and timeout (in seconds, 0 means no expiration).
+	// The cache is obtained from the template context, so it only works
+	// in templates executed with a context with a Cache() method (e.g.
+	// templates executed by gnd.la/app).
+	//
+	//  {{ cache "sidebar" 300 }}...{{ endcache }}
+	beginCacheName: nop,
+	endCacheName:   nop,
+	// Defers rendering the block (up to the matching {{ enddefer }})
+	// until the rest of the template has been sent to the client,
+	// so slow blocks don't delay the rest of the page. It only has
+	// effect when the template is executed with an io.Writer which
+	// implements http.Flusher (like *gnd.la/app.Context) and the
+	// block is in an HTML text context. Otherwise, it's rendered
+	// in place. A placeholder <div> is rendered until the block
+	// output is sent.
+	//
+	//  {{ defer }}{{ range .SlowQuery }}...{{ end }}{{ enddefer }}
+	beginDeferName: nop,
+	endDeferName:   nop,
+	// !Used internally to implement {{ cache }}
+	"@!" + beginCacheFuncName: cacheBegin,
+	"@" + endCacheFuncName:    cacheEnd,
+	// !Used internally to implement {{ include }} with arguments
+	"#" + includeArgsFuncName: _map,
 	// !Used to make the parser parse undefined
 	// variables, since we allow variable
 	// inheritance to subtemplates
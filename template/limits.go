@@ -0,0 +1,55 @@
+package template
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultMaxDepth is the maximum nesting of {{ template }} and
+// {{ include }} calls used for templates which don't set MaxDepth.
+const DefaultMaxDepth = 10000
+
+func (t *Template) maxDepth() int {
+	if t.MaxDepth == 0 {
+		return DefaultMaxDepth
+	}
+	return t.MaxDepth
+}
+
+// startClock sets the deadline for the current execution,
+// if the template has a Timeout.
+func (s *State) startClock() {
+	s.deadline = time.Time{}
+	if s.p != nil && s.p.tmpl != nil && s.p.tmpl.Timeout > 0 {
+		s.deadline = time.Now().Add(s.p.tmpl.Timeout)
+	}
+}
+
+// checkDeadline returns an error if the execution
+// has taken longer than the template Timeout.
+func (s *State) checkDeadline() error {
+	if !s.deadline.IsZero() && time.Now().After(s.deadline) {
+		return fmt.Errorf("template execution exceeded its timeout of %s", s.p.tmpl.Timeout)
+	}
+	return nil
+}
+
+// enterTemplate must be called before executing a
+// nested template, to enforce the depth limit.
+func (s *State) enterTemplate(name string) error {
+	s.depth++
+	if max := s.p.tmpl.maxDepth(); max > 0 && s.depth > max {
+		return fmt.Errorf("maximum template depth of %d exceeded calling %q, it's probably recursive", max, name)
+	}
+	return s.checkDeadline()
+}
+
+// nextIteration must be called for every {{ range }}
+// iteration, to enforce the iterations limit.
+func (s *State) nextIteration() error {
+	s.iterations++
+	if max := s.p.tmpl.MaxIterations; max > 0 && s.iterations > max {
+		return fmt.Errorf("maximum number of loop iterations (%d) exceeded", max)
+	}
+	return s.checkDeadline()
+}
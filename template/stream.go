@@ -0,0 +1,209 @@
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template/parse"
+
+	"gnd.la/html"
+	"gnd.la/internal/templateutil"
+)
+
+const (
+	beginDeferName = "defer"
+	endDeferName   = "enddefer"
+	deferMarker    = "#defer"
+)
+
+type deferredBlock struct {
+	id   int
+	name string
+	ns   string
+	dot  reflect.Value
+	vars []variable
+}
+
+// isDeferredTemplate returns true iff the template with the given
+// name was generated from a {{ defer }} block. Blocks which are not
+// used in an HTML text context are mangled by html/template, and
+// they're always rendered in place.
+func isDeferredTemplate(name string) bool {
+	return strings.Contains(name, deferMarker) && !strings.Contains(name, "$htmltemplate")
+}
+
+// deferTemplate records the template generated from a {{ defer }} block,
+// so it's executed after the rest of the template has been sent to the
+// client, and writes a placeholder which is replaced by its output.
+func (s *State) deferTemplate(name string, ns string, dot reflect.Value) {
+	id := len(s.deferred) + 1
+	s.deferred = append(s.deferred, &deferredBlock{
+		id:   id,
+		name: name,
+		ns:   ns,
+		dot:  dot,
+		vars: append([]variable(nil), s.vars...),
+	})
+	fmt.Fprintf(s.w, `<div id="gondola-defer-%d" hidden></div>`, id)
+}
+
+// stream works like execute, but the templates generated from {{ defer }}
+// blocks are executed after the rest of the template. emit is called
+// with the main output first and then with the output of each deferred
+// block, identified by its id.
+func (p *program) stream(w *bytes.Buffer, name string, data interface{}, context interface{}, vars VarMap, emit func(id int, w *bytes.Buffer) error) error {
+	s := newState(p, w)
+	defer putState(s)
+	s.streaming = true
+	s.context = reflect.ValueOf(context)
+	s.pushVar("Vars", reflect.ValueOf(vars))
+	if err := s.execute(name, "", reflect.ValueOf(data)); err != nil {
+		return err
+	}
+	if err := emit(0, w); err != nil {
+		return err
+	}
+	// Deferred blocks might contain other deferred blocks,
+	// so len(s.deferred) might change while iterating.
+	for ii := 0; ii < len(s.deferred); ii++ {
+		d := s.deferred[ii]
+		w.Reset()
+		s.vars = append(s.vars[:0], d.vars...)
+		if err := s.execute(d.name, d.ns, d.dot); err != nil {
+			return err
+		}
+		if err := emit(d.id, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executeStream executes the template sending its output to w as soon as
+// possible, flushing it before and after executing each {{ defer }} block.
+// The output of each deferred block is sent inside a <template> element,
+// followed by a small script which moves it to its placeholder.
+func (t *Template) executeStream(w io.Writer, f http.Flusher, data interface{}, context interface{}, vars VarMap) error {
+	buf := getBuffer()
+	defer putBuffer(buf)
+	var out bytes.Buffer
+	return t.prog.stream(buf, t.root, data, context, vars, func(id int, b *bytes.Buffer) error {
+		if t.Minify {
+			out.Reset()
+			if err := html.Minify(&out, bytes.NewReader(b.Bytes())); err != nil {
+				return err
+			}
+			b = &out
+		}
+		if id == 0 {
+			if rw, ok := w.(http.ResponseWriter); ok {
+				// Content-Length is unknown, since
+				// the response is sent in chunks.
+				rw.Header().Set("Content-Type", t.contentType)
+			}
+			if _, err := w.Write(b.Bytes()); err != nil {
+				return err
+			}
+		} else {
+			sid := strconv.Itoa(id)
+			if _, err := io.WriteString(w, `<template id="gondola-deferred-`+sid+`">`); err != nil {
+				return err
+			}
+			if _, err := w.Write(b.Bytes()); err != nil {
+				return err
+			}
+			script := `</template><script>(function(){var t=document.getElementById("gondola-deferred-` + sid + `"),` +
+				`p=document.getElementById("gondola-defer-` + sid + `");if(p){p.parentNode.replaceChild(t.content,p);}` +
+				`t.parentNode.removeChild(t);})();</script>`
+			if _, err := io.WriteString(w, script); err != nil {
+				return err
+			}
+		}
+		f.Flush()
+		return nil
+	})
+}
+
+// replaceDeferBlocks replaces {{ defer }} ... {{ enddefer }} blocks with
+// a {{ template }} node which calls a new template containing the block.
+func replaceDeferBlocks(name string, treeMap map[string]*parse.Tree) error {
+	count := 0
+	pending := make([]*parse.Tree, 0, len(treeMap))
+	for _, tr := range treeMap {
+		pending = append(pending, tr)
+	}
+	for len(pending) > 0 {
+		tr := pending[0]
+		pending = pending[1:]
+		var err error
+		templateutil.WalkTree(tr, func(n, p parse.Node) {
+			if err != nil {
+				return
+			}
+			list, ok := n.(*parse.ListNode)
+			if !ok {
+				return
+			}
+			var trees []*parse.Tree
+			list.Nodes, trees, err = replaceListDeferBlocks(tr, name, list.Nodes, &count)
+			for _, v := range trees {
+				treeMap[v.Name] = v
+				pending = append(pending, v)
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("error in %s: %s", name, err)
+		}
+	}
+	return nil
+}
+
+func replaceListDeferBlocks(tr *parse.Tree, name string, nodes []parse.Node, count *int) ([]parse.Node, []*parse.Tree, error) {
+	var trees []*parse.Tree
+	for ii := 0; ii < len(nodes); ii++ {
+		if templateutil.IsPseudoFunction(nodes[ii], endDeferName) {
+			loc, _ := tr.ErrorContext(nodes[ii])
+			return nil, nil, fmt.Errorf("%s: {{ %s }} without {{ %s }}", loc, endDeferName, beginDeferName)
+		}
+		if !templateutil.IsPseudoFunction(nodes[ii], beginDeferName) {
+			continue
+		}
+		depth := 0
+		end := -1
+		for jj := ii + 1; jj < len(nodes); jj++ {
+			if templateutil.IsPseudoFunction(nodes[jj], beginDeferName) {
+				depth++
+			} else if templateutil.IsPseudoFunction(nodes[jj], endDeferName) {
+				if depth == 0 {
+					end = jj
+					break
+				}
+				depth--
+			}
+		}
+		if end < 0 {
+			loc, _ := tr.ErrorContext(nodes[ii])
+			return nil, nil, fmt.Errorf("%s: unterminated {{ %s }}, missing {{ %s }}", loc, beginDeferName, endDeferName)
+		}
+		*count++
+		pos := nodes[ii].Position()
+		// Copy the tree, so the new one shares its
+		// text and errors are correctly reported.
+		dt := *tr
+		dt.Name = name + deferMarker + strconv.Itoa(*count)
+		dt.Root = &parse.ListNode{
+			NodeType: parse.NodeList,
+			Pos:      pos,
+			Nodes:    append([]parse.Node(nil), nodes[ii+1:end]...),
+		}
+		trees = append(trees, &dt)
+		repl := append([]parse.Node(nil), nodes[:ii]...)
+		repl = append(repl, templateutil.TemplateNode(dt.Name, pos))
+		nodes = append(repl, nodes[end+1:]...)
+	}
+	return nodes, trees, nil
+}
@@ -16,11 +16,13 @@ import (
 	"strconv"
 	"strings"
 	"text/template/parse"
+	"time"
 
 	"gnd.la/app/profile"
 	"gnd.la/html"
 	itemplate "gnd.la/internal/template"
 	"gnd.la/internal/templateutil"
+	"gnd.la/loaders"
 	"gnd.la/log"
 	"gnd.la/template/assets"
 	"gnd.la/util/pathutil"
@@ -29,6 +31,17 @@ import (
 	"gopkgs.com/vfs.v1"
 )
 
+// FuncMap maps names to template functions. Names might be
+// prefixed with the following characters, which alter how the
+// function is called:
+//
+//  # - pure function, might be evaluated at compile time when its arguments are constant
+//  ! - the context passed to ExecuteContext is passed as the first argument
+//  @ - the current *State is passed as the first argument (internal use only)
+//
+// Functions which receive a type registered with AddContextType
+// (e.g. *gnd.la/app.Context) as their first argument are detected
+// as context functions, so they don't need to be prefixed with !.
 type FuncMap map[string]interface{}
 
 type funcTrait int
@@ -64,11 +77,42 @@ func makeFuncMap(fns FuncMap) funcMap {
 				break
 			}
 		}
+		if info.traits&funcTraitContext == 0 && isContextFunc(v) {
+			info.traits |= funcTraitContext
+		}
 		f[k] = info
 	}
 	return f
 }
 
+// AddContextType registers the type of a context passed to
+// ExecuteContext (e.g. *gnd.la/app.Context), so functions which
+// receive it as their first argument are automatically detected
+// as context functions. typ might be either a reflect.Type or a
+// value of the type. Note that the type must be registered before
+// registering any functions which use it.
+func AddContextType(typ interface{}) {
+	t, ok := typ.(reflect.Type)
+	if !ok {
+		t = reflect.TypeOf(typ)
+	}
+	contextTypes = append(contextTypes, t)
+}
+
+func isContextFunc(fn interface{}) bool {
+	t := reflect.TypeOf(fn)
+	if t == nil || t.Kind() != reflect.Func || t.NumIn() == 0 {
+		return false
+	}
+	in := t.In(0)
+	for _, v := range contextTypes {
+		if in == v {
+			return true
+		}
+	}
+	return false
+}
+
 func (f funcMap) asFuncMap() FuncMap {
 	fns := make(FuncMap, len(f))
 	for k, v := range f {
@@ -143,6 +187,7 @@ const (
 	nsSep                 = "."
 	nsMark                = "|"
 	varNop                = "_gondola_var_nop"
+	includeArgsFuncName   = "_gondola_include_args"
 )
 
 var (
@@ -152,10 +197,13 @@ var (
 	keyRe                    = regexp.MustCompile(`(?s:\s*([\w\-_])+?(:|\|))`)
 	defineRe                 = regexp.MustCompile(`(\{\{\s*?define.*?\}\})`)
 	blockRe                  = regexp.MustCompile(`\{\{\s*block\s*("[\w\-_]+")\s*?(.*?)\s*?\}\}`)
+	includeRe                = regexp.MustCompile(`\{\{(-?)\s*include\s+("[^"]*")(.*?)\s*(-?)\}\}`)
+	includeArgRe             = regexp.MustCompile(`^([A-Za-z_]\w*)=(.+)$`)
 	undefinedVariableRe      = regexp.MustCompile("(\\d+): undefined variable \"\\$(\\w+)\"")
 	topTree                  = compileTree(topBoilerplate)
 	bottomTree               = compileTree(bottomBoilerplate)
 	templatePrepend          = fmt.Sprintf("{{ $%s := %s }}", varsKey, varNop)
+	contextTypes             []reflect.Type
 )
 
 type Hook struct {
@@ -185,6 +233,36 @@ type Template struct {
 	hooks         []*Hook
 	children      []*Template
 	loaded        []string
+	superNodes    map[*parse.TemplateNode]bool
+
+	// CacheDir, if non-empty, indicates a directory where the
+	// results of the asset pipeline (compiling, bundling, CDN
+	// resolution, etc...) and the compiled program are persisted
+	// when the template is compiled. They're keyed by a hash of
+	// the asset declarations, the template sources and their
+	// contents, so the same template compiled again (e.g. after
+	// restarting the app) reuses them.
+	CacheDir string
+	// AuditEscaping enables recording the escaping context of
+	// every interpolated value when the template is compiled.
+	// See EscapeAudit.
+	AuditEscaping bool
+	audit         []*Interpolation
+
+	// MaxDepth limits the nesting of {{ template }} and {{ include }}
+	// calls, so a template which recurses indefinitely returns an
+	// error rather than exhausting the stack. If zero, DefaultMaxDepth
+	// is used. Negative values disable the limit.
+	MaxDepth int
+	// MaxIterations limits the total number of {{ range }} iterations
+	// in a single execution of the template. Zero or negative values
+	// disable the limit.
+	MaxIterations int
+	// Timeout limits the time spent executing the template. It's
+	// checked when entering nested templates and on every {{ range }}
+	// iteration, so it won't interrupt a slow function call. Zero
+	// or negative values disable the limit.
+	Timeout time.Duration
 }
 
 func (t *Template) init() {
@@ -321,6 +399,9 @@ func (t *Template) Compile() error {
 		return err
 	}
 	t.prog = prog
+	if t.AuditEscaping {
+		t.auditEscaping()
+	}
 	return nil
 }
 
@@ -499,6 +580,17 @@ func sortGroups(groups [][]*assets.Group) (err error) {
 }
 
 func (t *Template) prepareAssets() error {
+	var cacheKey string
+	if t.CacheDir != "" {
+		key, err := t.assetsCacheKey()
+		if err != nil {
+			return err
+		}
+		if t.loadCachedAssets(key) {
+			return nil
+		}
+		cacheKey = key
+	}
 	groups, err := t.preparedAssetsGroups(t.vars, t, nil)
 	if err != nil {
 		return err
@@ -508,6 +600,7 @@ func (t *Template) prepareAssets() error {
 	}
 	var top bytes.Buffer
 	var bottom bytes.Buffer
+	var local []string
 	for _, group := range groups {
 		// Only bundle and use CDNs in non-debug mode
 		if !t.Debug {
@@ -551,6 +644,9 @@ func (t *Template) prepareAssets() error {
 		}
 		for _, g := range group {
 			for _, v := range g.Assets {
+				if cacheKey != "" && !v.IsRemote() && g.Manager.Has(v.Name) {
+					local = append(local, v.Name)
+				}
 				switch v.Position {
 				case assets.Top:
 					if err := assets.RenderTo(&top, g.Manager, v); err != nil {
@@ -570,6 +666,9 @@ func (t *Template) prepareAssets() error {
 	}
 	t.topAssets = top.Bytes()
 	t.bottomAssets = bottom.Bytes()
+	if cacheKey != "" {
+		t.storeCachedAssets(cacheKey, local)
+	}
 	return nil
 }
 
@@ -790,6 +889,18 @@ func (t *Template) load(name string, included bool, from string) error {
 	if err != nil {
 		return err
 	}
+	// Replace {{ include }} with {{ template }}, loading
+	// the included templates.
+	var includes []string
+	s, includes, err = replaceIncludes(name, s)
+	if err != nil {
+		return err
+	}
+	for _, v := range includes {
+		if err := t.load(v, true, name); err != nil {
+			return err
+		}
+	}
 	// The $Vars definition must be present at parse
 	// time, because otherwise the parser will throw an
 	// error when it finds a variable which wasn't
@@ -846,7 +957,14 @@ func (t *Template) load(name string, included bool, from string) error {
 	if err := t.replaceExtendTag(name, treeMap, from); err != nil {
 		return err
 	}
+	if err := replaceCacheBlocks(name, treeMap); err != nil {
+		return err
+	}
+	if err := replaceDeferBlocks(name, treeMap); err != nil {
+		return err
+	}
 	var renames map[string]string
+	var supers map[string]string
 	for k, v := range treeMap {
 		v.Root.Nodes = t.removeVarNopNodes(v, v.Root.Nodes)
 		if _, contains := t.trees[k]; contains {
@@ -854,18 +972,29 @@ func (t *Template) load(name string, included bool, from string) error {
 			// Redefinition of a template, which is allowed
 			// by gondola templates. Just rename this
 			// template and change any template
-			// nodes referring to it in the final sweep
+			// nodes referring to it (or to any of its
+			// previous definitions) in the final sweep.
+			// The previous definition is kept, so it can
+			// be called using {{ super }}.
 			if renames == nil {
 				renames = make(map[string]string)
+				supers = make(map[string]string)
 			}
-			fk := k
+			var prev []string
 			for {
+				prev = append(prev, k)
 				k += "_"
-				if len(renames[fk]) < len(k) {
-					renames[fk] = k
+				if _, contains := t.trees[k]; !contains {
 					break
 				}
 			}
+			for _, p := range prev {
+				renames[p] = k
+			}
+			supers[k] = prev[len(prev)-1]
+		}
+		if err := t.replaceSuperTag(name, k, v, supers[k]); err != nil {
+			return err
 		}
 		err := t.AddParseTree(k, v)
 		if err != nil {
@@ -921,6 +1050,32 @@ func (t *Template) replaceExtendTag(name string, treeMap map[string]*parse.Tree,
 	return err
 }
 
+// replaceSuperTag replaces any {{ super }} tags in the tree, defined as
+// name in the file from, with a call to the previous definition of the
+// template, which is named by prev. If the tree doesn't redefine a
+// template, prev is empty and using {{ super }} is an error.
+func (t *Template) replaceSuperTag(from string, name string, tr *parse.Tree, prev string) error {
+	var err error
+	templateutil.WalkTree(tr, func(n, p parse.Node) {
+		if err != nil || !templateutil.IsPseudoFunction(n, "super") {
+			return
+		}
+		if prev == "" {
+			loc, _ := tr.ErrorContext(n)
+			err = fmt.Errorf("%s: {{ super }} used in %q, which does not override any template", loc, strings.TrimRight(name, "_"))
+			return
+		}
+		log.Debugf("replacing {{ super }} in %q from %q with %q", name, from, prev)
+		node := templateutil.TemplateNode(prev, n.Position())
+		if t.superNodes == nil {
+			t.superNodes = make(map[*parse.TemplateNode]bool)
+		}
+		t.superNodes[node] = true
+		err = templateutil.ReplaceNode(n, p, node)
+	})
+	return err
+}
+
 // removeVarNopNodes removes any nodes of the form
 // {{ $Something := _gondola_nop }}
 // They are used to fool the parser and letting us parse
@@ -979,6 +1134,10 @@ func (t *Template) assetFunc(arg string) (string, error) {
 func (t *Template) renameTemplates(renames map[string]string) {
 	t.walkTrees(parse.NodeTemplate, func(n parse.Node) {
 		node := n.(*parse.TemplateNode)
+		if t.superNodes[node] {
+			// Must keep pointing to the previous definition
+			return
+		}
 		if rename, ok := renames[node.Name]; ok {
 			node.Name = rename
 		}
@@ -1094,6 +1253,11 @@ func (t *Template) ExecuteContext(w io.Writer, data interface{}, context interfa
 		// Other templates, like asset templates, are ended by the deferred call.
 		ev.AutoEnd()
 	}
+	if t.prog.deferred {
+		if f, ok := w.(http.Flusher); ok {
+			return t.executeStream(w, f, data, context, vars)
+		}
+	}
 	buf := getBuffer()
 	err := t.prog.execute(buf, t.root, data, context, vars)
 	if err != nil {
@@ -1135,8 +1299,9 @@ func AddFuncs(f FuncMap) {
 
 // DefaultVFS returns a VFS which loads templates from
 // the tmpl directory, relative to the application binary.
+// The returned VFS implements loaders.Watchable.
 func DefaultVFS() vfs.VFS {
-	fs, err := vfs.FS(pathutil.Relative("tmpl"))
+	fs, err := loaders.Dir(pathutil.Relative("tmpl"))
 	if err != nil {
 		// Very unlikely, since FS only fails when
 		// os.Getwd() fails.
@@ -1266,6 +1431,96 @@ func replaceBlocks(name string, s string) (string, error) {
 	return s, nil
 }
 
+// replaceIncludes replaces {{ include "name" key=value ... }} with
+// {{ template "name" ... }}, returning the names of the included
+// templates. Without arguments, the included template receives the
+// current dot. Otherwise, it receives a map with the arguments as
+// its dot, so partials can be reused with different data e.g.
+//
+//  {{ include "card.html" item=. compact=true }}
+//
+// makes {{ .item }} and {{ .compact }} available in card.html.
+func replaceIncludes(name string, s string) (string, []string, error) {
+	var includes []string
+	for m := includeRe.FindStringSubmatchIndex(s); m != nil; m = includeRe.FindStringSubmatchIndex(s) {
+		all := s[m[0]:m[1]]
+		quoted := s[m[4]:m[5]]
+		included, err := strconv.Unquote(quoted)
+		if err != nil || included == "" {
+			return "", nil, fmt.Errorf("%s: invalid {{ include }} tag %q, template name is not correctly quoted", errorContext(name, s, m[0]), all)
+		}
+		fields, err := splitIncludeArgs(s[m[6]:m[7]])
+		if err != nil {
+			return "", nil, fmt.Errorf("%s: invalid {{ include }} tag %q: %s", errorContext(name, s, m[0]), all, err)
+		}
+		data := "."
+		if len(fields) > 0 {
+			args := []string{includeArgsFuncName}
+			for _, v := range fields {
+				am := includeArgRe.FindStringSubmatch(v)
+				if am == nil {
+					return "", nil, fmt.Errorf("%s: invalid {{ include }} tag %q, argument %q must have the form name=value", errorContext(name, s, m[0]), all, v)
+				}
+				args = append(args, strconv.Quote(am[1]), "("+am[2]+")")
+			}
+			data = "(" + strings.Join(args, " ") + ")"
+		}
+		includes = append(includes, included)
+		s = fmt.Sprintf("%s{{%s template %s %s %s}}%s", s[:m[0]], s[m[2]:m[3]], quoted, data, s[m[8]:m[9]], s[m[1]:])
+	}
+	return s, includes, nil
+}
+
+// splitIncludeArgs splits the arguments to {{ include }} at
+// spaces, ignoring the ones in quotes or parentheses.
+func splitIncludeArgs(s string) ([]string, error) {
+	var fields []string
+	var quote byte
+	depth := 0
+	start := -1
+	for ii := 0; ii < len(s); ii++ {
+		c := s[ii]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' {
+				ii++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return nil, errors.New("unbalanced parentheses")
+			}
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if depth == 0 {
+				if start >= 0 {
+					fields = append(fields, s[start:ii])
+					start = -1
+				}
+				continue
+			}
+		}
+		if start < 0 {
+			start = ii
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("unterminated quoted string")
+	}
+	if depth != 0 {
+		return nil, errors.New("unbalanced parentheses")
+	}
+	if start >= 0 {
+		fields = append(fields, s[start:])
+	}
+	return fields, nil
+}
+
 // splitErrorContext returns the error context as (file, line, column, ok)
 func splitErrorContext(loc string) (string, int, int, bool) {
 	p := strings.SplitN(loc, ":", 2)
@@ -2,13 +2,19 @@ package template
 
 import (
 	"bytes"
+	"encoding/gob"
+	"errors"
 	"fmt"
 	"html/template"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"gnd.la/cache"
+	"gnd.la/config"
 	"gnd.la/template/assets"
 
 	"gopkgs.com/vfs.v1"
@@ -46,6 +52,8 @@ var (
 		{"{{ to_upper .foo }}", map[string]string{"foo": "bar"}, "BAR"},
 		{"{{ join .chars .sep }}", map[string]interface{}{"chars": []string{"a", "b", "c"}, "sep": ","}, "a,b,c"},
 		{"{{ to_html .s }}", map[string]string{"s": "<foo\nbar"}, "&lt;foo<br>bar"},
+		{"{{ sanitize .s }}", map[string]string{"s": "<b onclick=\"x\">foo</b><script>bar</script>"}, "<b>foo</b>"},
+		{"{{ sanitize .s \"rich_text\" }}", map[string]string{"s": "<h1>foo</h1><img src=\"/a.png\">"}, "<h1>foo</h1><img src=\"/a.png\">"},
 		{"{{ mul 2 1.1 }}", nil, "2.2"},
 		{"{{ mulf 2 1.1 }}", nil, "2.2"},
 		{"{{ muli 2 1.1 }}", nil, "2"},
@@ -372,6 +380,63 @@ func TestTemplateNoPipeMissedInstructions(t *testing.T) {
 	}
 }
 
+type cacheContext struct {
+	c *cache.Cache
+}
+
+func (c *cacheContext) Cache() *cache.Cache {
+	return c.c
+}
+
+func TestCacheBlock(t *testing.T) {
+	c, err := cache.New(config.MustParseURL("memory://#prefix=fragment-test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := &cacheContext{c}
+	tmpl := parseNamedText(t, "cache.html", "<p>{{ cache \"frag\" 0 }}{{ . }}{{ cache \"inner\" 0 }}-{{ . }}{{ endcache }}{{ endcache }}</p>", nil, "")
+	if tmpl == nil {
+		return
+	}
+	for _, v := range []int{1, 2} {
+		var buf bytes.Buffer
+		if err := tmpl.ExecuteContext(&buf, v, ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+		// Second execution must return the cached fragment
+		if expected := "<p>1-1</p>"; buf.String() != expected {
+			t.Errorf("expecting %q, got %q instead", expected, buf.String())
+		}
+	}
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteContext(&buf, 3, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "<p>3-3</p>"; buf.String() != expected {
+		t.Errorf("expecting %q without cache, got %q instead", expected, buf.String())
+	}
+	// Deferred blocks inside a cached fragment are rendered in place
+	deferred := parseNamedText(t, "defer.html", "<p>{{ cache \"deferred\" 0 }}{{ defer }}<b>{{ . }}</b>{{ enddefer }}{{ endcache }}</p>", nil, "")
+	if deferred == nil {
+		return
+	}
+	for _, v := range []int{1, 2} {
+		var f flushRecorder
+		if err := deferred.ExecuteContext(&f, v, ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+		if expected := "<p><b>1</b></p>"; strings.Join(f.chunks, "")+f.String() != expected {
+			t.Errorf("expecting %q, got %q instead", expected, f.chunks)
+		}
+	}
+	for _, v := range []string{"{{ cache \"a\" 0 }}", "{{ endcache }}", "{{ cache \"a\" }}{{ endcache }}"} {
+		fs, _ := vfs.Map(map[string]*vfs.File{"bad.html": &vfs.File{Data: []byte(v)}})
+		if err := New(fs, nil).Parse("bad.html"); err == nil {
+			t.Errorf("expecting an error parsing %q", v)
+		}
+	}
+}
+
 func BenchmarkRange(b *testing.B) {
 	benchmarkTemplate(b, rangeTests())
 }
@@ -379,3 +444,410 @@ func BenchmarkRange(b *testing.B) {
 func BenchmarkRangeGo(b *testing.B) {
 	benchmarkHTMLTemplate(b, rangeTests())
 }
+
+func TestInheritance(t *testing.T) {
+	fs, _ := vfs.Map(map[string]*vfs.File{
+		"base.html":  &vfs.File{Data: []byte(`<title>{{ block "title" }}Site{{ end }}</title>{{ block "content" }}<p>base</p>{{ end }}{{ extend }}`)},
+		"page.html":  &vfs.File{Data: []byte("{{/*\n  extends: base.html\n*/}}{{ define \"title\" }}{{ .Title }} - {{ super }}{{ end }}")},
+		"child.html": &vfs.File{Data: []byte("{{/*\n  extends: page.html\n*/}}{{ define \"title\" }}[{{ super }}]{{ end }}{{ define \"content\" }}<p>{{ .Title }}</p>{{ super }}{{ end }}")},
+		"bad.html":   &vfs.File{Data: []byte(`{{ define "foo" }}{{ super }}{{ end }}`)},
+	})
+	data := map[string]string{"Title": "<Page>"}
+	for _, v := range []struct {
+		name     string
+		expected string
+	}{
+		{"base.html", "<title>Site</title><p>base</p>"},
+		{"page.html", "<title>&lt;Page&gt; - Site</title><p>base</p>"},
+		{"child.html", "<title>[&lt;Page&gt; - Site]</title><p>&lt;Page&gt;</p><p>base</p>"},
+	} {
+		tmpl := New(fs, nil)
+		if err := tmpl.Parse(v.name); err != nil {
+			t.Errorf("error parsing %s: %s", v.name, err)
+			continue
+		}
+		if err := tmpl.Compile(); err != nil {
+			t.Errorf("error compiling %s: %s", v.name, err)
+			continue
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			t.Errorf("error executing %s: %s", v.name, err)
+			continue
+		}
+		if buf.String() != v.expected {
+			t.Errorf("expecting %q executing %s, got %q", v.expected, v.name, buf.String())
+		}
+	}
+	if err := New(fs, nil).Parse("bad.html"); err == nil {
+		t.Error("expecting an error when using {{ super }} without overriding")
+	}
+}
+
+func TestAssetsCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "template-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, _ := vfs.Map(map[string]*vfs.File{
+		"page.html": &vfs.File{Data: []byte("{{/*\n  styles: style.css\n*/}}<html><head></head><body></body></html>")},
+		"style.css": &vfs.File{Data: []byte("body { color: red; }")},
+	})
+	prefix := "/assets/"
+	execute := func() string {
+		tmpl := New(fs, assets.New(fs, prefix))
+		tmpl.CacheDir = dir
+		if err := tmpl.Parse("page.html"); err != nil {
+			t.Fatal(err)
+		}
+		if err := tmpl.Compile(); err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, nil); err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+	cached := func() []string {
+		files, err := filepath.Glob(filepath.Join(dir, "assets-*"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return files
+	}
+	if s := execute(); !strings.Contains(s, "style.css") {
+		t.Errorf("expecting style.css in output, got %q", s)
+	}
+	files := cached()
+	if len(files) != 1 {
+		t.Fatalf("expecting 1 cached file, got %v", files)
+	}
+	// Replace the cached data and check it's used
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&cachedAssets{Top: []byte("<!-- cached -->")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(files[0], buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if s := execute(); !strings.Contains(s, "<!-- cached -->") {
+		t.Errorf("expecting cached assets in output, got %q", s)
+	}
+	// Changing the asset must invalidate the cache
+	if err := vfs.WriteFile(fs, "style.css", []byte("body { color: blue; }"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if s := execute(); strings.Contains(s, "<!-- cached -->") || !strings.Contains(s, "style.css") {
+		t.Errorf("expecting fresh assets in output, got %q", s)
+	}
+	if files := cached(); len(files) != 2 {
+		t.Errorf("expecting 2 cached files, got %v", files)
+	}
+	// Changing the prefix (e.g. to use a CDN) must invalidate the cache
+	prefix = "/static/"
+	if s := execute(); !strings.Contains(s, prefix+"style.css") {
+		t.Errorf("expecting assets from %s in output, got %q", prefix, s)
+	}
+	files = cached()
+	if len(files) != 3 {
+		t.Fatalf("expecting 3 cached files, got %v", files)
+	}
+	// Cached data referencing missing assets (e.g. a bundle
+	// from another build) must be ignored
+	for _, v := range files {
+		buf.Reset()
+		cached := &cachedAssets{Top: []byte("<!-- missing -->"), Local: []string{"bundle-missing.css"}}
+		if err := gob.NewEncoder(&buf).Encode(cached); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(v, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if s := execute(); strings.Contains(s, "<!-- missing -->") || !strings.Contains(s, "style.css") {
+		t.Errorf("expecting fresh assets in output, got %q", s)
+	}
+}
+
+func TestProgramCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "template-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, _ := vfs.Map(map[string]*vfs.File{
+		"page.html": &vfs.File{Data: []byte(`<p>Hello {{ .Name }} {{ add 1 2 }} {{ "<b>" }}</p>{{ if .Fail }}{{ fail }}{{ end }}`)},
+	})
+	execute := func(data map[string]interface{}) (string, error) {
+		tmpl := New(fs, nil)
+		tmpl.CacheDir = dir
+		tmpl.Funcs(FuncMap{
+			"add":  func(a, b int) int { return a + b },
+			"fail": func() (string, error) { return "", errors.New("failed") },
+		})
+		if err := tmpl.Parse("page.html"); err != nil {
+			t.Fatal(err)
+		}
+		if err := tmpl.Compile(); err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		err := tmpl.Execute(&buf, data)
+		return buf.String(), err
+	}
+	cached := func() []string {
+		files, err := filepath.Glob(filepath.Join(dir, "program-*"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return files
+	}
+	const expect = "<p>Hello gondola 3 &lt;b&gt;</p>"
+	data := map[string]interface{}{"Name": "gondola"}
+	if s, err := execute(data); err != nil || s != expect {
+		t.Fatalf("expecting %q, got %q (error %v)", expect, s, err)
+	}
+	_, expectErr := execute(map[string]interface{}{"Fail": true})
+	if expectErr == nil {
+		t.Fatal("expecting an error")
+	}
+	files := cached()
+	if len(files) != 1 {
+		t.Fatalf("expecting 1 cached program, got %v", files)
+	}
+	// Using the cached program must produce the same
+	// output and error messages.
+	if s, err := execute(data); err != nil || s != expect {
+		t.Errorf("expecting %q from cached program, got %q (error %v)", expect, s, err)
+	}
+	if _, err := execute(map[string]interface{}{"Fail": true}); err == nil || err.Error() != expectErr.Error() {
+		t.Errorf("expecting error %q from cached program, got %v", expectErr, err)
+	}
+	// Modify the cached program and check it's used
+	data2, err := ioutil.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var prog cachedProgram
+	if err := gob.NewDecoder(bytes.NewReader(data2)).Decode(&prog); err != nil {
+		t.Fatal(err)
+	}
+	for ii, v := range prog.Bs {
+		prog.Bs[ii] = bytes.Replace(v, []byte("Hello"), []byte("Cached"), -1)
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&prog); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(files[0], buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := execute(data); !strings.Contains(s, "Cached") {
+		t.Errorf("expecting output from cached program, got %q", s)
+	}
+	// Changing the source must invalidate the cache
+	if err := vfs.WriteFile(fs, "page.html", []byte("<p>Bye {{ .Name }}</p>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := execute(data); err != nil || s != "<p>Bye gondola</p>" {
+		t.Errorf("expecting fresh output, got %q (error %v)", s, err)
+	}
+	if files := cached(); len(files) != 2 {
+		t.Errorf("expecting 2 cached programs, got %v", files)
+	}
+}
+
+func TestEscapeAudit(t *testing.T) {
+	text := `<a href="/foo?q={{ .Q }}" title="{{ .Title }}">{{ .Title }}</a>
+<p>{{ to_html .Body }}</p><script>var x = {{ json .Data }}; var s = "{{ .S | html }}";</script>
+<div>{{ json .Data }}</div>`
+	fs, _ := vfs.Map(map[string]*vfs.File{"audit.html": &vfs.File{Data: []byte(text)}})
+	tmpl := New(fs, nil)
+	tmpl.AuditEscaping = true
+	if err := tmpl.Parse("audit.html"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpl.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct {
+		action  string
+		context string
+		attr    bool
+		issues  int
+	}{
+		{"{{ .Q }}", "URL", true, 0},
+		{"{{ .Title }}", "Attr", true, 0},
+		{"{{ .Title }}", "HTML", false, 0},
+		{"{{ to_html .Body }}", "HTML", false, 1},
+		{"{{ json .Data }}", "JS", false, 1},
+		{"{{ .S | html }}", "JSString", false, 1},
+		{"{{ json .Data }}", "HTML", false, 1},
+	}
+	audit := tmpl.EscapeAudit()
+	if len(audit) != len(expected) {
+		t.Fatalf("expecting %d interpolations, got %d: %v", len(expected), len(audit), audit)
+	}
+	for ii, v := range expected {
+		in := audit[ii]
+		t.Log(in)
+		if in.Action != v.action || in.Context != v.context || in.InAttr != v.attr || len(in.Issues) != v.issues {
+			t.Errorf("expecting %s in %s context (attr %v) with %d issues, got %s", v.action, v.context, v.attr, v.issues, in)
+		}
+	}
+	if !strings.HasPrefix(audit[3].Location, "audit.html:2:") {
+		t.Errorf("unexpected location %q", audit[3].Location)
+	}
+}
+
+type funcContext struct {
+	greeting string
+}
+
+func TestContextFuncs(t *testing.T) {
+	AddContextType((*funcContext)(nil))
+	fs, _ := vfs.Map(map[string]*vfs.File{"greet.html": &vfs.File{Data: []byte(`{{ greet "Gopher" }}`)}})
+	tmpl := New(fs, nil)
+	tmpl.Funcs(FuncMap{"greet": func(ctx *funcContext, name string) string {
+		return ctx.greeting + ", " + name
+	}})
+	if err := tmpl.Parse("greet.html"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpl.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteContext(&buf, nil, &funcContext{"Hello"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "Hello, Gopher"; buf.String() != expected {
+		t.Errorf("expecting %q, got %q", expected, buf.String())
+	}
+}
+
+type flushRecorder struct {
+	bytes.Buffer
+	chunks []string
+}
+
+func (f *flushRecorder) Flush() {
+	f.chunks = append(f.chunks, f.String())
+	f.Reset()
+}
+
+func TestDefer(t *testing.T) {
+	text := `<p>{{ .A }}</p>{{ $x := "var" }}{{ defer }}<b>{{ .B }} {{ $x }}</b>{{ defer }}<i>{{ .A }}</i>{{ enddefer }}{{ enddefer }}<p>end</p>`
+	tmpl := parseText(t, text)
+	if tmpl == nil {
+		return
+	}
+	data := map[string]string{"A": "a", "B": "<b>"}
+	// Without flushing, blocks are rendered in place
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "<p>a</p><b>&lt;b&gt; var</b><i>a</i><p>end</p>"; buf.String() != expected {
+		t.Errorf("expecting %q, got %q", expected, buf.String())
+	}
+	var f flushRecorder
+	if err := tmpl.Execute(&f, data); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.chunks) != 3 {
+		t.Fatalf("expecting 3 chunks, got %d: %q", len(f.chunks), f.chunks)
+	}
+	if expected := `<p>a</p><div id="gondola-defer-1" hidden></div><p>end</p>`; f.chunks[0] != expected {
+		t.Errorf("expecting first chunk %q, got %q", expected, f.chunks[0])
+	}
+	if !strings.HasPrefix(f.chunks[1], `<template id="gondola-deferred-1"><b>&lt;b&gt; var</b><div id="gondola-defer-2" hidden></div></template><script>`) {
+		t.Errorf("unexpected second chunk %q", f.chunks[1])
+	}
+	if !strings.HasPrefix(f.chunks[2], `<template id="gondola-deferred-2"><i>a</i></template>`) {
+		t.Errorf("unexpected third chunk %q", f.chunks[2])
+	}
+	for _, v := range []string{"{{ defer }}", "{{ enddefer }}", "{{ defer }}{{ defer }}{{ enddefer }}"} {
+		fs, _ := vfs.Map(map[string]*vfs.File{"bad.html": &vfs.File{Data: []byte(v)}})
+		if err := New(fs, nil).Parse("bad.html"); err == nil {
+			t.Errorf("expecting an error parsing %q", v)
+		}
+	}
+}
+
+func TestInclude(t *testing.T) {
+	fs, _ := vfs.Map(map[string]*vfs.File{
+		"list.html":   &vfs.File{Data: []byte(`{{ range .Items }}{{ include "card.html" item=. compact=(eq . "b") }}{{ end }}{{ include "footer.html" }}`)},
+		"card.html":   &vfs.File{Data: []byte(`<div{{ if .compact }} class="compact"{{ end }}>{{ .item }}</div>`)},
+		"footer.html": &vfs.File{Data: []byte(`<p>{{ len .Items }}</p>`)},
+		"bad.html":    &vfs.File{Data: []byte(`{{ include "card.html" item }}`)},
+	})
+	tmpl := New(fs, nil)
+	if err := tmpl.Parse("list.html"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpl.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]interface{}{"Items": []string{"a", "b"}}); err != nil {
+		t.Fatal(err)
+	}
+	if expected := `<div>a</div><div class="compact">b</div><p>2</p>`; buf.String() != expected {
+		t.Errorf("expecting %q, got %q", expected, buf.String())
+	}
+	if err := New(fs, nil).Parse("bad.html"); err == nil {
+		t.Error("expecting an error when passing an argument without a name to {{ include }}")
+	}
+}
+
+func TestLimits(t *testing.T) {
+	fs, _ := vfs.Map(map[string]*vfs.File{
+		"recursive.html": &vfs.File{Data: []byte(`{{ define "r" }}{{ template "r" . }}{{ end }}{{ template "r" . }}`)},
+		"loop.html":      &vfs.File{Data: []byte(`{{ range . }}{{ range . }}{{ sleep }}{{ end }}{{ end }}`)},
+	})
+	data := [][]int{{1, 2, 3}, {4, 5, 6}}
+	for _, v := range []struct {
+		name   string
+		setup  func(*Template)
+		errMsg string
+	}{
+		{"recursive.html", nil, "recursive.html:1:"},
+		{"recursive.html", func(tmpl *Template) { tmpl.MaxDepth = 10 }, "depth of 10"},
+		{"loop.html", func(tmpl *Template) { tmpl.MaxIterations = 5 }, "iterations (5)"},
+		{"loop.html", func(tmpl *Template) { tmpl.Timeout = time.Millisecond }, "timeout"},
+	} {
+		tmpl := New(fs, nil)
+		tmpl.Funcs(FuncMap{"sleep": func() string {
+			time.Sleep(time.Millisecond)
+			return ""
+		}})
+		if v.setup != nil {
+			v.setup(tmpl)
+		}
+		if err := tmpl.Parse(v.name); err != nil {
+			t.Fatal(err)
+		}
+		if err := tmpl.Compile(); err != nil {
+			t.Fatal(err)
+		}
+		err := tmpl.Execute(ioutil.Discard, data)
+		if err == nil || !strings.Contains(err.Error(), v.errMsg) {
+			t.Errorf("expecting an error containing %q executing %s, got %v", v.errMsg, v.name, err)
+		}
+	}
+	// Without limits, the loop must succeed
+	tmpl := New(fs, nil)
+	tmpl.Funcs(FuncMap{"sleep": func() string { return "" }})
+	if err := tmpl.Parse("loop.html"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpl.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpl.Execute(ioutil.Discard, data); err != nil {
+		t.Error(err)
+	}
+}
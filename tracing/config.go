@@ -0,0 +1,67 @@
+package tracing
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gnd.la/config"
+)
+
+// Configure enables tracing using the given configuration, which
+// takes the form:
+//
+//  otlp://host:port/path?service=name&sample=ratio
+//
+// Use otlps:// to send the spans over HTTPS. If the path is omitted,
+// /v1/traces is used. The service name defaults to "gondola" and the
+// sample ratio (see SetSampleRatio) to 1. Any fragment options are
+// sent as request headers to the collector. e.g.
+//
+//  otlps://collector.example.com?service=myapp&sample=0.1#Authorization=Bearer%20secret
+//
+// If u is nil, tracing is disabled.
+func Configure(u *config.URL) error {
+	if u == nil {
+		SetExporter(nil)
+		return nil
+	}
+	var scheme string
+	switch u.Scheme {
+	case "otlp":
+		scheme = "http"
+	case "otlps":
+		scheme = "https"
+	default:
+		return fmt.Errorf("unknown tracing scheme %q", u.Scheme)
+	}
+	if u.Value == "" {
+		return fmt.Errorf("no tracing collector address in %s", u)
+	}
+	endpoint := scheme + "://" + u.Value
+	if !strings.Contains(u.Value, "/") {
+		endpoint += "/v1/traces"
+	}
+	service := u.Query.Get("service")
+	if service == "" {
+		service = "gondola"
+	}
+	ratio := 1.0
+	if s := u.Query.Get("sample"); s != "" {
+		r, err := strconv.ParseFloat(s, 64)
+		if err != nil || r < 0 || r > 1 {
+			return fmt.Errorf("invalid sample ratio %q, must be between 0 and 1", s)
+		}
+		ratio = r
+	}
+	exporter := NewOTLP(endpoint, service)
+	for k, v := range u.Fragment {
+		if exporter.Header == nil {
+			exporter.Header = make(map[string][]string)
+		}
+		exporter.Header.Set(k, v)
+	}
+	SetSampleRatio(ratio)
+	SetExporter(exporter)
+	return nil
+}
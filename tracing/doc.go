@@ -0,0 +1,26 @@
+// Package tracing implements distributed tracing compatible with
+// OpenTelemetry, propagating the trace context using the W3C
+// traceparent and tracestate headers and exporting the spans
+// to a collector using OTLP over HTTP.
+//
+// Tracing is disabled by default. It's enabled by setting an Exporter
+// (see SetExporter) or, more commonly, by setting the Tracing field
+// in the gnd.la/app configuration (see Configure for its format).
+// When enabled, Gondola creates spans for the requests handled by
+// gnd.la/app, the SQL queries executed by gnd.la/orm, the cache
+// operations, the rendered templates and the outgoing requests made
+// with gnd.la/net/httpclient, which also propagate the trace context.
+//
+// Spans are propagated using a context.Context (see NewContext and
+// FromContext), so a span started with a context which carries another
+// span becomes its child. When serving a request, gnd.la/app stores its
+// span in the context of the *http.Request, which is also used by the
+// Orm and the Cache returned by the *app.Context. Use Start and Span.End
+// for adding your own spans:
+//
+//  span := tracing.Start(ctx.R.Context(), "compute-totals", tracing.Internal)
+//  defer span.End()
+//
+// When tracing is disabled, Start returns a nil *Span, which can be
+// safely used.
+package tracing
@@ -0,0 +1,227 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	otlpMaxBatch    = 512
+	otlpMaxQueue    = 4096
+	otlpInterval    = 5 * time.Second
+	otlpStatusError = 2
+	scopeName       = "gnd.la/tracing"
+)
+
+// OTLP is an Exporter which sends the spans in batches to an
+// OpenTelemetry collector, using OTLP over HTTP with JSON encoding.
+// Use NewOTLP to create an OTLP exporter.
+type OTLP struct {
+	// Endpoint is the full URL which spans are sent to
+	// (e.g. http://localhost:4318/v1/traces).
+	Endpoint string
+	// Service is the service name, sent as the
+	// service.name resource attribute.
+	Service string
+	// Header contains additional headers sent with
+	// each request (e.g. for authentication).
+	Header http.Header
+	// ErrorFunc, if non-nil, is called with any
+	// errors while exporting the spans.
+	ErrorFunc func(error)
+	client    *http.Client
+	mu        sync.Mutex
+	queue     []*Span
+	flush     chan struct{}
+	once      sync.Once
+}
+
+// NewOTLP returns a new OTLP exporter which sends the
+// spans to the given endpoint using the given service name.
+// Spans are sent every 5 seconds or when 512 spans are
+// waiting, whatever happens first.
+func NewOTLP(endpoint string, service string) *OTLP {
+	return &OTLP{
+		Endpoint: endpoint,
+		Service:  service,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		flush:    make(chan struct{}, 1),
+	}
+}
+
+// Export implements the Exporter interface. If too many
+// spans are waiting to be sent, the span is dropped.
+func (o *OTLP) Export(span *Span) {
+	o.once.Do(func() { go o.loop() })
+	o.mu.Lock()
+	if len(o.queue) < otlpMaxQueue {
+		o.queue = append(o.queue, span)
+	}
+	full := len(o.queue) >= otlpMaxBatch
+	o.mu.Unlock()
+	if full {
+		select {
+		case o.flush <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Flush sends all the spans waiting to be exported.
+func (o *OTLP) Flush() error {
+	for {
+		o.mu.Lock()
+		batch := o.queue
+		if len(batch) > otlpMaxBatch {
+			batch = batch[:otlpMaxBatch]
+		}
+		o.queue = o.queue[len(batch):]
+		o.mu.Unlock()
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := o.send(batch); err != nil {
+			return err
+		}
+	}
+}
+
+func (o *OTLP) loop() {
+	ticker := time.NewTicker(otlpInterval)
+	for {
+		select {
+		case <-ticker.C:
+		case <-o.flush:
+		}
+		if err := o.Flush(); err != nil && o.ErrorFunc != nil {
+			o.ErrorFunc(err)
+		}
+	}
+}
+
+func (o *OTLP) send(spans []*Span) error {
+	data, err := json.Marshal(otlpRequest(o.Service, spans))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", o.Endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	for k, v := range o.Header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := o.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("exporting %d spans to %s returned status %d", len(spans), o.Endpoint, resp.StatusCode)
+	}
+	return nil
+}
+
+type otlpKeyValue struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+func otlpValue(v interface{}) map[string]interface{} {
+	switch x := v.(type) {
+	case string:
+		return map[string]interface{}{"stringValue": x}
+	case bool:
+		return map[string]interface{}{"boolValue": x}
+	case int:
+		return map[string]interface{}{"intValue": strconv.FormatInt(int64(x), 10)}
+	case int32:
+		return map[string]interface{}{"intValue": strconv.FormatInt(int64(x), 10)}
+	case int64:
+		return map[string]interface{}{"intValue": strconv.FormatInt(x, 10)}
+	case uint:
+		return map[string]interface{}{"intValue": strconv.FormatUint(uint64(x), 10)}
+	case uint32:
+		return map[string]interface{}{"intValue": strconv.FormatUint(uint64(x), 10)}
+	case uint64:
+		return map[string]interface{}{"intValue": strconv.FormatUint(x, 10)}
+	case float32:
+		return map[string]interface{}{"doubleValue": float64(x)}
+	case float64:
+		return map[string]interface{}{"doubleValue": x}
+	}
+	return map[string]interface{}{"stringValue": fmt.Sprint(v)}
+}
+
+func otlpAttributes(attrs map[string]interface{}) []otlpKeyValue {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	kvs := make([]otlpKeyValue, len(keys))
+	for ii, k := range keys {
+		kvs[ii] = otlpKeyValue{Key: k, Value: otlpValue(attrs[k])}
+	}
+	return kvs
+}
+
+func otlpTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// otlpRequest returns an ExportTraceServiceRequest, as defined
+// by the OTLP protocol, for the given spans.
+func otlpRequest(service string, spans []*Span) map[string]interface{} {
+	var list []map[string]interface{}
+	for _, v := range spans {
+		s := map[string]interface{}{
+			"traceId":           v.Context.TraceID.String(),
+			"spanId":            v.Context.SpanID.String(),
+			"name":              v.Name,
+			"kind":              int(v.Kind),
+			"startTimeUnixNano": otlpTime(v.StartTime),
+			"endTimeUnixNano":   otlpTime(v.EndTime),
+			"attributes":        otlpAttributes(v.Attributes),
+		}
+		if v.Parent.IsValid() {
+			s["parentSpanId"] = v.Parent.String()
+		}
+		if v.Context.State != "" {
+			s["traceState"] = v.Context.State
+		}
+		if v.Error != "" {
+			s["status"] = map[string]interface{}{"code": otlpStatusError, "message": v.Error}
+		}
+		list = append(list, s)
+	}
+	return map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": otlpAttributes(map[string]interface{}{"service.name": service}),
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]interface{}{"name": scopeName},
+						"spans": list,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,190 @@
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Kind indicates the relationship between a span and its
+// parent and children, with the same values used by OTLP.
+type Kind int
+
+const (
+	// Internal spans represent operations which don't
+	// cross a process boundary (e.g. template rendering).
+	Internal Kind = iota + 1
+	// Server spans represent incoming requests.
+	Server
+	// Client spans represent outgoing requests, including
+	// the ones to the database or the cache.
+	Client
+)
+
+var (
+	errInvalidTraceparent = errors.New("invalid traceparent")
+)
+
+// TraceID identifies a trace.
+type TraceID [16]byte
+
+// IsValid returns true iff the TraceID is not all zeroes.
+func (t TraceID) IsValid() bool {
+	return t != TraceID{}
+}
+
+// String returns the TraceID encoded as lowercase hex.
+func (t TraceID) String() string {
+	return hex.EncodeToString(t[:])
+}
+
+// SpanID identifies a span within a trace.
+type SpanID [8]byte
+
+// IsValid returns true iff the SpanID is not all zeroes.
+func (s SpanID) IsValid() bool {
+	return s != SpanID{}
+}
+
+// String returns the SpanID encoded as lowercase hex.
+func (s SpanID) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+// SpanContext contains the information which is propagated
+// across process boundaries.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	// Sampled indicates if the span is recorded and
+	// exported.
+	Sampled bool
+	// State is the vendor specific tracestate header,
+	// which is propagated unmodified.
+	State string
+}
+
+// IsValid returns true iff both the TraceID and the SpanID
+// are valid.
+func (c SpanContext) IsValid() bool {
+	return c.TraceID.IsValid() && c.SpanID.IsValid()
+}
+
+// Traceparent returns the SpanContext encoded as a
+// W3C traceparent header value.
+func (c SpanContext) Traceparent() string {
+	flags := "00"
+	if c.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", c.TraceID, c.SpanID, flags)
+}
+
+// ParseTraceparent parses a W3C traceparent header value
+// (e.g. 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01).
+func ParseTraceparent(s string) (SpanContext, error) {
+	var c SpanContext
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" {
+		return c, errInvalidTraceparent
+	}
+	if parts[0] == "00" && len(parts) != 4 {
+		return c, errInvalidTraceparent
+	}
+	if len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return c, errInvalidTraceparent
+	}
+	if _, err := hex.Decode(c.TraceID[:], []byte(parts[1])); err != nil {
+		return c, errInvalidTraceparent
+	}
+	if _, err := hex.Decode(c.SpanID[:], []byte(parts[2])); err != nil {
+		return c, errInvalidTraceparent
+	}
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil {
+		return c, errInvalidTraceparent
+	}
+	if !c.IsValid() {
+		return c, errInvalidTraceparent
+	}
+	c.Sampled = flags[0]&1 != 0
+	return c, nil
+}
+
+// Span represents an operation within a trace. Spans are created
+// with Start and StartRemote and must be ended by calling End. All
+// the Span methods can be safely called on a nil *Span, which is
+// returned when tracing is disabled.
+type Span struct {
+	Name       string
+	Kind       Kind
+	Context    SpanContext
+	Parent     SpanID
+	StartTime  time.Time
+	EndTime    time.Time
+	Attributes map[string]interface{}
+	// Error is the error message, if the
+	// operation failed.
+	Error string
+}
+
+// SetName changes the span name.
+func (s *Span) SetName(name string) *Span {
+	if s != nil {
+		s.Name = name
+	}
+	return s
+}
+
+// SetAttribute sets an attribute in the span. Values should be
+// strings, bools, integers or floats, other types are converted
+// to strings when the span is exported.
+func (s *Span) SetAttribute(key string, value interface{}) *Span {
+	if s != nil {
+		if s.Attributes == nil {
+			s.Attributes = make(map[string]interface{})
+		}
+		s.Attributes[key] = value
+	}
+	return s
+}
+
+// SetError marks the span as failed with the given error. If
+// err is nil, this function does nothing.
+func (s *Span) SetError(err error) *Span {
+	if s != nil && err != nil {
+		s.Error = err.Error()
+	}
+	return s
+}
+
+// End ends the span and, if it's sampled, sends it to the
+// exporter.
+func (s *Span) End() {
+	if s == nil || !s.EndTime.IsZero() {
+		return
+	}
+	s.EndTime = time.Now()
+	if s.Context.Sampled {
+		export(s)
+	}
+}
+
+func newTraceID() TraceID {
+	var t TraceID
+	for !t.IsValid() {
+		rand.Read(t[:])
+	}
+	return t
+}
+
+func newSpanID() SpanID {
+	var s SpanID
+	for !s.IsValid() {
+		rand.Read(s[:])
+	}
+	return s
+}
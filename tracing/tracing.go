@@ -0,0 +1,179 @@
+package tracing
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// TraceparentHeader is the W3C header used for
+	// propagating the trace context.
+	TraceparentHeader = "traceparent"
+	// TracestateHeader is the W3C header used for
+	// propagating vendor specific trace data.
+	TracestateHeader = "tracestate"
+)
+
+// Exporter is the interface implemented by the types which
+// export finished spans. Export is called from the goroutine
+// which ended the span, so it shouldn't block.
+type Exporter interface {
+	Export(span *Span)
+}
+
+var (
+	enabled int32
+	state   struct {
+		sync.RWMutex
+		exporter Exporter
+		ratio    float64
+		rnd      *rand.Rand
+	}
+)
+
+// spanKey is the key used for storing the
+// span in a context.Context.
+type spanKey struct{}
+
+func init() {
+	state.ratio = 1
+	state.rnd = rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+// SetExporter sets the Exporter used for the finished spans.
+// Tracing is enabled iff the Exporter is non-nil.
+func SetExporter(exporter Exporter) {
+	state.Lock()
+	state.exporter = exporter
+	state.Unlock()
+	var e int32
+	if exporter != nil {
+		e = 1
+	}
+	atomic.StoreInt32(&enabled, e)
+}
+
+// SetSampleRatio sets the ratio of the traces which are sampled,
+// between 0 (none) and 1 (all, the default). Note that the ratio
+// is only used for traces started by this process, incoming requests
+// with a trace context use the sampling decision of the caller.
+func SetSampleRatio(ratio float64) {
+	state.Lock()
+	state.ratio = ratio
+	state.Unlock()
+}
+
+// Enabled returns true iff tracing has been enabled
+// by setting an Exporter.
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) != 0
+}
+
+// Start starts a new span with the given name and kind. If ctx carries
+// a span (see NewContext), it's used as the parent. Otherwise, a new
+// trace is started. ctx might be nil. If tracing is not enabled, it
+// returns nil.
+func Start(ctx context.Context, name string, kind Kind) *Span {
+	if !Enabled() {
+		return nil
+	}
+	var parent SpanContext
+	if cur := FromContext(ctx); cur != nil {
+		parent = cur.Context
+	}
+	return start(name, kind, parent)
+}
+
+// StartRemote works like Start, but uses the given parent, which
+// usually comes from an incoming request (see Extract), rather than
+// a span carried by a context. If parent is not valid, a new trace
+// is started.
+func StartRemote(name string, kind Kind, parent SpanContext) *Span {
+	if !Enabled() {
+		return nil
+	}
+	return start(name, kind, parent)
+}
+
+func start(name string, kind Kind, parent SpanContext) *Span {
+	sp := &Span{
+		Name:      name,
+		Kind:      kind,
+		StartTime: time.Now(),
+	}
+	if parent.IsValid() {
+		sp.Context = parent
+		sp.Parent = parent.SpanID
+	} else {
+		sp.Context.TraceID = newTraceID()
+		sp.Context.Sampled = sample()
+	}
+	sp.Context.SpanID = newSpanID()
+	return sp
+}
+
+// NewContext returns a copy of parent which carries the given
+// span, so it's used as the parent of the spans started with
+// the returned context. If span is nil, parent is returned.
+func NewContext(parent context.Context, span *Span) context.Context {
+	if span == nil {
+		return parent
+	}
+	if parent == nil {
+		parent = context.Background()
+	}
+	return context.WithValue(parent, spanKey{}, span)
+}
+
+// FromContext returns the span carried by ctx, or nil
+// if ctx is nil or it carries no span.
+func FromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	sp, _ := ctx.Value(spanKey{}).(*Span)
+	return sp
+}
+
+// Extract returns the trace context sent in the given
+// headers. If the headers contain no valid trace context,
+// it returns an invalid SpanContext.
+func Extract(h http.Header) SpanContext {
+	c, err := ParseTraceparent(h.Get(TraceparentHeader))
+	if err != nil {
+		return SpanContext{}
+	}
+	c.State = h.Get(TracestateHeader)
+	return c
+}
+
+// Inject sets the trace context headers for the given span. If
+// span is nil, this function does nothing.
+func Inject(h http.Header, span *Span) {
+	if span == nil {
+		return
+	}
+	h.Set(TraceparentHeader, span.Context.Traceparent())
+	if span.Context.State != "" {
+		h.Set(TracestateHeader, span.Context.State)
+	}
+}
+
+func sample() bool {
+	state.Lock()
+	defer state.Unlock()
+	return state.ratio >= 1 || state.rnd.Float64() < state.ratio
+}
+
+func export(s *Span) {
+	state.RLock()
+	exporter := state.exporter
+	state.RUnlock()
+	if exporter != nil {
+		exporter.Export(s)
+	}
+}
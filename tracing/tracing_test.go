@@ -0,0 +1,165 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gnd.la/config"
+)
+
+type testExporter struct {
+	spans []*Span
+}
+
+func (e *testExporter) Export(span *Span) {
+	e.spans = append(e.spans, span)
+}
+
+func TestTraceparent(t *testing.T) {
+	const tp = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	c, err := ParseTraceparent(tp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Sampled || c.TraceID.String() != "4bf92f3577b34da6a3ce929d0e0e4736" || c.SpanID.String() != "00f067aa0ba902b7" {
+		t.Errorf("unexpected span context %+v", c)
+	}
+	if s := c.Traceparent(); s != tp {
+		t.Errorf("expecting %q, got %q", tp, s)
+	}
+	for _, v := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"00-4bf92f3577b34da6a3ce929d0e0e473x-00f067aa0ba902b7-01",
+	} {
+		if _, err := ParseTraceparent(v); err == nil {
+			t.Errorf("expecting an error parsing %q", v)
+		}
+	}
+	// Future versions might add fields
+	if _, err := ParseTraceparent(tp[2:] + "-extra"); err == nil {
+		t.Error("expecting an error for invalid version")
+	}
+	if _, err := ParseTraceparent("01" + tp[2:] + "-extra"); err != nil {
+		t.Errorf("expecting no error for future version, got %s", err)
+	}
+}
+
+func TestSpans(t *testing.T) {
+	if sp := Start(nil, "disabled", Internal); sp != nil {
+		t.Fatal("expecting a nil span with tracing disabled")
+	}
+	exp := &testExporter{}
+	SetExporter(exp)
+	defer SetExporter(nil)
+	h := http.Header{}
+	h.Set(TraceparentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	h.Set(TracestateHeader, "vendor=value")
+	root := StartRemote("request", Server, Extract(h))
+	ctx := NewContext(context.Background(), root)
+	if FromContext(ctx) != root {
+		t.Error("root span is not carried by its context")
+	}
+	child := Start(ctx, "query", Client)
+	if FromContext(ctx) != root || FromContext(NewContext(ctx, child)) != child {
+		t.Error("child span was not propagated using its own context")
+	}
+	if FromContext(nil) != nil || FromContext(context.Background()) != nil {
+		t.Error("expecting no span without a context carrying it")
+	}
+	out := http.Header{}
+	Inject(out, child)
+	if out.Get(TraceparentHeader) != child.Context.Traceparent() || out.Get(TracestateHeader) != "vendor=value" {
+		t.Errorf("unexpected injected headers %v", out)
+	}
+	// Spans started in other goroutines with the
+	// same context are also children of root.
+	done := make(chan *Span)
+	go func() {
+		sp := Start(ctx, "background", Internal)
+		sp.End()
+		done <- sp
+	}()
+	bg := <-done
+	child.SetError(errors.New("failed")).End()
+	root.End()
+	if len(exp.spans) != 3 {
+		t.Fatalf("expecting 3 exported spans, got %d", len(exp.spans))
+	}
+	if root.Context.TraceID.String() != "4bf92f3577b34da6a3ce929d0e0e4736" || root.Parent.String() != "00f067aa0ba902b7" {
+		t.Errorf("root span does not continue the remote trace: %+v", root.Context)
+	}
+	for _, v := range []*Span{child, bg} {
+		if v.Context.TraceID != root.Context.TraceID || v.Parent != root.Context.SpanID {
+			t.Errorf("span %s is not a child of root", v.Name)
+		}
+	}
+	if child.Error != "failed" {
+		t.Errorf("expecting error \"failed\", got %q", child.Error)
+	}
+	if sp := Start(nil, "new", Internal); sp.Parent.IsValid() || sp.Context.TraceID == root.Context.TraceID {
+		t.Error("span without a parent did not start a new trace")
+	}
+	SetSampleRatio(0)
+	defer SetSampleRatio(1)
+	Start(nil, "unsampled", Internal).End()
+	if len(exp.spans) != 3 {
+		t.Error("unsampled span was exported")
+	}
+}
+
+func TestOTLP(t *testing.T) {
+	var received map[string]interface{}
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		auth = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+	var u config.URL
+	if err := u.Parse(strings.Replace(server.URL, "http://", "otlp://", 1) + "?service=test#Authorization=secret"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Configure(&u); err != nil {
+		t.Fatal(err)
+	}
+	defer SetExporter(nil)
+	sp := Start(nil, "test", Internal).SetAttribute("count", 3).SetAttribute("ok", true)
+	sp.End()
+	if err := state.exporter.(*OTLP).Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if auth != "secret" {
+		t.Errorf("expecting Authorization header \"secret\", got %q", auth)
+	}
+	rs := received["resourceSpans"].([]interface{})[0].(map[string]interface{})
+	spans := rs["scopeSpans"].([]interface{})[0].(map[string]interface{})["spans"].([]interface{})
+	if len(spans) != 1 {
+		t.Fatalf("expecting 1 span, got %d", len(spans))
+	}
+	s := spans[0].(map[string]interface{})
+	if s["name"] != "test" || s["traceId"] != sp.Context.TraceID.String() || s["spanId"] != sp.Context.SpanID.String() {
+		t.Errorf("unexpected span %v", s)
+	}
+	attrs := s["attributes"].([]interface{})
+	if len(attrs) != 2 || attrs[0].(map[string]interface{})["value"].(map[string]interface{})["intValue"] != "3" {
+		t.Errorf("unexpected attributes %v", attrs)
+	}
+	if err := Configure(&config.URL{Scheme: "zipkin", Value: "localhost"}); err == nil {
+		t.Error("expecting an error with an unknown scheme")
+	}
+}
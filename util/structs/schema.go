@@ -0,0 +1,323 @@
+package structs
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// OptionType indicates the kind of value accepted
+// by a struct tag option. See TagOption.
+type OptionType int
+
+const (
+	// FlagOption is used for options which don't accept
+	// a value (e.g. omitempty).
+	FlagOption OptionType = iota
+	// IntOption is used for options which require an
+	// integer value (e.g. max_length=10).
+	IntOption
+	// StringOption is used for options which require
+	// a non-empty value (e.g. codec=json).
+	StringOption
+	// AnyOption is used for options which might be used
+	// with or without a value (e.g. index or index=desc).
+	AnyOption
+)
+
+// TagOption represents an option allowed in a struct tag.
+type TagOption struct {
+	// Name is the option name.
+	Name string
+	// Type indicates the value accepted by the option.
+	Type OptionType
+	// Values, if non-nil, returns the valid values for
+	// the option (e.g. the names of the registered codecs).
+	Values func() []string
+	// Separator, if non-empty, indicates that the option accepts
+	// several values separated by it, each one of them checked
+	// against Values (e.g. chained pipes).
+	Separator string
+}
+
+// TagSchema describes the options allowed in the struct
+// tags used by a package. See ValidateTags.
+type TagSchema struct {
+	// Options lists the allowed options.
+	Options []*TagOption
+	// Conflicts contains groups of options which
+	// can't be used together in the same field.
+	Conflicts [][]string
+}
+
+func (s *TagSchema) option(name string) *TagOption {
+	for _, v := range s.Options {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+func (s *TagSchema) names() []string {
+	names := make([]string, len(s.Options))
+	for ii, v := range s.Options {
+		names[ii] = v.Name
+	}
+	return names
+}
+
+// TagError represents an error found by ValidateTags.
+type TagError struct {
+	// Type is the validated struct type.
+	Type reflect.Type
+	// Field is the qualified name of the field (e.g. Foo.Bar).
+	Field string
+	// Tag is the key of the struct tag with the error (e.g. orm).
+	Tag string
+	// Option is the option with the error. It might be empty if
+	// the tag can't be parsed.
+	Option string
+	// Pos is the position of the error in the tag value.
+	Pos int
+	// Err is the error description.
+	Err error
+}
+
+func (e *TagError) Error() string {
+	return fmt.Sprintf("field %s in struct %s, tag %s at position %d: %s", e.Field, e.Type, e.Tag, e.Pos, e.Err)
+}
+
+// TagErrors is returned from ValidateTags when any
+// errors are found. It contains at least one error.
+type TagErrors []*TagError
+
+func (e TagErrors) Error() string {
+	var buf bytes.Buffer
+	for ii, v := range e {
+		if ii > 0 {
+			buf.WriteString("; ")
+		}
+		buf.WriteString(v.Error())
+	}
+	return buf.String()
+}
+
+// ValidateTags checks the struct tags in the given type (either a
+// reflect.Type or a value of the type) against the given schema,
+// reporting unknown options (suggesting the most similar one, if
+// any), options with invalid values (e.g. codec names which are not
+// registered) and options which conflict with each other. As in
+// NewStruct, tags lists the tag keys to look for, in order of
+// preference. Inner structs are checked too. Packages which parse
+// struct tags (like gnd.la/orm and gnd.la/form) call this function
+// when a type is registered or first used, so errors are detected
+// as early as possible. If any errors are found, the returned error
+// is of type TagErrors.
+func ValidateTags(t interface{}, tags []string, schema *TagSchema) error {
+	var typ reflect.Type
+	if tt, ok := t.(reflect.Type); ok {
+		typ = tt
+	} else {
+		typ = reflect.TypeOf(t)
+	}
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return ErrNoStruct
+	}
+	var errs TagErrors
+	validateTags(typ, typ, tags, schema, "", make(map[reflect.Type]bool), &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateTags(root reflect.Type, typ reflect.Type, tags []string, schema *TagSchema, qprefix string, seen map[reflect.Type]bool, errs *TagErrors) {
+	seen[typ] = true
+	defer delete(seen, typ)
+	n := typ.NumField()
+	for ii := 0; ii < n; ii++ {
+		field := typ.Field(ii)
+		if field.PkgPath != "" {
+			// Unexported
+			continue
+		}
+		qname := qprefix + field.Name
+		var key, value string
+		for _, v := range tags {
+			if value = field.Tag.Get(v); value != "" {
+				key = v
+				break
+			}
+		}
+		tagError := func(opt string, pos int, format string, args ...interface{}) {
+			*errs = append(*errs, &TagError{
+				Type:   root,
+				Field:  qname,
+				Tag:    key,
+				Option: opt,
+				Pos:    pos,
+				Err:    fmt.Errorf(format, args...),
+			})
+		}
+		pos := make(map[string]int)
+		name, values, err := splitFields(value, pos)
+		if err != nil {
+			tagError("", 0, "%s", err)
+			continue
+		}
+		if name == "-" {
+			continue
+		}
+		for _, opt := range sortedOptions(values, pos) {
+			if opt == "" {
+				continue
+			}
+			val := values[opt]
+			p := pos[opt]
+			o := schema.option(opt)
+			if o == nil {
+				if s := suggest(opt, schema.names()); s != "" {
+					tagError(opt, p, "unknown option %q (did you mean %q?)", opt, s)
+				} else {
+					tagError(opt, p, "unknown option %q", opt)
+				}
+				continue
+			}
+			switch o.Type {
+			case FlagOption:
+				if val != "" {
+					tagError(opt, p, "option %q does not accept a value", opt)
+					continue
+				}
+			case IntOption:
+				if _, err := strconv.Atoi(val); err != nil {
+					tagError(opt, p, "option %q requires an integer value, not %q", opt, val)
+					continue
+				}
+			case StringOption:
+				if val == "" {
+					tagError(opt, p, "option %q requires a value", opt)
+					continue
+				}
+			}
+			if o.Values != nil && val != "" {
+				valid := o.Values()
+				vals := []string{val}
+				if o.Separator != "" {
+					vals = strings.Split(val, o.Separator)
+				}
+				for _, v := range vals {
+					if contains(valid, v) {
+						continue
+					}
+					if s := suggest(v, valid); s != "" {
+						tagError(opt, p, "invalid %s %q (did you mean %q?)", opt, v, s)
+					} else {
+						tagError(opt, p, "invalid %s %q. Perhaps you missed an import?", opt, v)
+					}
+				}
+			}
+		}
+		for _, group := range schema.Conflicts {
+			var found []string
+			for _, v := range group {
+				if _, ok := values[v]; ok {
+					found = append(found, v)
+				}
+			}
+			if len(found) > 1 {
+				last := found[len(found)-1]
+				tagError(last, pos[last], "options %q and %q can't be used together", found[0], last)
+			}
+		}
+		t := field.Type
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() == reflect.Struct && !seen[t] && decompose(t, &Tag{name, values}) {
+			validateTags(root, t, tags, schema, qname+".", seen, errs)
+		}
+	}
+}
+
+// sortedOptions returns the option names sorted by
+// their position in the tag.
+func sortedOptions(values map[string]string, pos map[string]int) []string {
+	opts := make([]string, 0, len(values))
+	for k := range values {
+		opts = append(opts, k)
+	}
+	sort.Sort(&optionsByPos{opts, pos})
+	return opts
+}
+
+type optionsByPos struct {
+	opts []string
+	pos  map[string]int
+}
+
+func (o *optionsByPos) Len() int           { return len(o.opts) }
+func (o *optionsByPos) Less(i, j int) bool { return o.pos[o.opts[i]] < o.pos[o.opts[j]] }
+func (o *optionsByPos) Swap(i, j int)      { o.opts[i], o.opts[j] = o.opts[j], o.opts[i] }
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// suggest returns the string in candidates most similar
+// to s, or the empty string if none of them is similar enough.
+func suggest(s string, candidates []string) string {
+	best := ""
+	// Allow one edit for every 3 characters
+	bestDist := len(s)/3 + 1
+	for _, v := range candidates {
+		if d := distance(s, v); d < bestDist || (best == "" && d == bestDist) {
+			best = v
+			bestDist = d
+		}
+	}
+	return best
+}
+
+// distance returns the Levenshtein distance between a and b.
+func distance(a string, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for jj := range prev {
+		prev[jj] = jj
+	}
+	for ii := 1; ii <= len(a); ii++ {
+		cur[0] = ii
+		for jj := 1; jj <= len(b); jj++ {
+			cost := 1
+			if a[ii-1] == b[jj-1] {
+				cost = 0
+			}
+			cur[jj] = minInt(prev[jj]+1, cur[jj-1]+1, prev[jj-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
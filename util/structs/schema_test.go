@@ -0,0 +1,89 @@
+package structs
+
+import (
+	"strings"
+	"testing"
+)
+
+var testSchema = &TagSchema{
+	Options: []*TagOption{
+		{Name: "inline"},
+		{Name: "omitempty"},
+		{Name: "notomitempty"},
+		{Name: "index", Type: AnyOption},
+		{Name: "max_length", Type: IntOption},
+		{Name: "codec", Type: StringOption, Values: func() []string { return []string{"gob", "json"} }},
+	},
+	Conflicts: [][]string{
+		{"omitempty", "notomitempty"},
+	},
+}
+
+type validInner struct {
+	Foo string `test:",omitempty"`
+}
+
+type validTags struct {
+	Id         int64  `test:"id,index"`
+	Name       string `test:",index=desc,max_length=10"`
+	Data       []int  `test:",codec=json"`
+	Ignored    string `test:"-,foo"`
+	Inner      validInner
+	Inline     *validInner `test:",inline"`
+	unexported string      `test:",bar"`
+}
+
+type invalidInner struct {
+	Bar string `test:",omitempy"`
+}
+
+type invalidTags struct {
+	A     string `test:",unknown"`
+	B     string `test:",max_length=ten"`
+	C     []int  `test:",codec=jsno"`
+	D     []int  `test:",codec=xml"`
+	E     string `test:",omitempty,notomitempty"`
+	F     string `test:",inline=yes"`
+	G     string `test:",codec"`
+	H     string `test:",inv'alid"`
+	Inner invalidInner
+}
+
+func TestValidateTags(t *testing.T) {
+	if err := ValidateTags(&validTags{}, []string{"test"}, testSchema); err != nil {
+		t.Errorf("unexpected error validating tags: %s", err)
+	}
+	if err := ValidateTags(42, []string{"test"}, testSchema); err != ErrNoStruct {
+		t.Errorf("expecting ErrNoStruct, got %v", err)
+	}
+	err := ValidateTags(invalidTags{}, []string{"test"}, testSchema)
+	errs, ok := err.(TagErrors)
+	if !ok {
+		t.Fatalf("expecting TagErrors, got %T (%v)", err, err)
+	}
+	expected := []struct {
+		field  string
+		option string
+		pos    int
+		err    string
+	}{
+		{"A", "unknown", 1, "unknown option \"unknown\""},
+		{"B", "max_length", 1, "requires an integer value"},
+		{"C", "codec", 1, "did you mean \"json\"?"},
+		{"D", "codec", 1, "Perhaps you missed an import?"},
+		{"E", "notomitempty", 11, "can't be used together"},
+		{"F", "inline", 1, "does not accept a value"},
+		{"G", "codec", 1, "requires a value"},
+		{"H", "", 0, "illegal character"},
+		{"Inner.Bar", "omitempy", 1, "did you mean \"omitempty\"?"},
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expecting %d errors, got %d: %s", len(expected), len(errs), errs)
+	}
+	for ii, v := range expected {
+		e := errs[ii]
+		if e.Field != v.field || e.Option != v.option || e.Pos != v.pos || e.Tag != "test" || !strings.Contains(e.Error(), v.err) {
+			t.Errorf("expecting error in field %s, option %q at %d containing %q, got %+v (%s)", v.field, v.option, v.pos, v.err, e, e)
+		}
+	}
+}
@@ -72,7 +72,9 @@ func (t *Tag) IsEmpty() bool {
 	return t.name == "" && len(t.values) == 0
 }
 
-func splitFields(tag string) (string, map[string]string, error) {
+// splitFields parses the given tag. If pos is non-nil, the position
+// of each option in the tag is stored in it.
+func splitFields(tag string, pos map[string]int) (string, map[string]string, error) {
 	const (
 		stateKey = iota
 		stateValue
@@ -82,6 +84,7 @@ func splitFields(tag string) (string, map[string]string, error) {
 	hasName := false
 	var name string
 	var key string
+	var start int
 	var prevState int
 	state := stateKey
 	var buf bytes.Buffer
@@ -96,9 +99,11 @@ func splitFields(tag string) (string, map[string]string, error) {
 				if hasName {
 					if key != "" {
 						values[key] = buf.String()
+						setPos(pos, key, start)
 						key = ""
 					} else {
 						values[buf.String()] = ""
+						setPos(pos, buf.String(), start)
 					}
 				} else {
 					name = buf.String()
@@ -106,6 +111,7 @@ func splitFields(tag string) (string, map[string]string, error) {
 				}
 				buf.Reset()
 				state = stateKey
+				start = ii + 1
 			} else {
 				buf.WriteByte(v)
 			}
@@ -118,6 +124,7 @@ func splitFields(tag string) (string, map[string]string, error) {
 				}
 			} else if state == stateValueQuoted {
 				values[key] = buf.String()
+				setPos(pos, key, start)
 				key = ""
 				buf.Reset()
 				state = stateKey
@@ -130,6 +137,7 @@ func splitFields(tag string) (string, map[string]string, error) {
 		case '\\':
 			if state == stateEscape {
 				buf.WriteByte(v)
+				state = prevState
 			} else {
 				prevState = state
 				state = stateEscape
@@ -154,22 +162,30 @@ func splitFields(tag string) (string, map[string]string, error) {
 		if k := buf.String(); k != "" {
 			if hasName {
 				values[k] = ""
+				setPos(pos, k, start)
 			} else {
 				name = k
 			}
 		}
 	case stateValue:
 		values[key] = buf.String()
+		setPos(pos, key, start)
 	default:
 		return "", nil, fmt.Errorf("unexpected end at %d", len(tag))
 	}
 	return name, values, nil
 }
 
+func setPos(pos map[string]int, key string, start int) {
+	if pos != nil {
+		pos[key] = start
+	}
+}
+
 // ParseTag parses a Gondola style struct tag field from
 // the given tag string.
 func ParseTag(tag string) (*Tag, error) {
-	name, values, err := splitFields(tag)
+	name, values, err := splitFields(tag, nil)
 	if err != nil {
 		return nil, err
 	}
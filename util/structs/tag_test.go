@@ -28,6 +28,11 @@ var (
 			&Tag{name: "foo", values: map[string]string{"label": "bar", "help": "let's rock"}},
 			"",
 		},
+		{
+			"foo,required,regexp='^\\\\d+,\\\\w$'",
+			&Tag{name: "foo", values: map[string]string{"regexp": "^\\d+,\\w$", "required": ""}},
+			"",
+		},
 		{
 			"-",
 			&Tag{name: "-", values: map[string]string{}},